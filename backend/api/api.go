@@ -7,14 +7,19 @@ package api
 
 import (
 	"context"
+	"errors"
+	"os"
 
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/app/server/binding"
 	"github.com/cloudwego/hertz/pkg/app/server/render"
+	"google.golang.org/grpc"
 
 	"github.com/coze-dev/coze-loop/backend/api/handler/coze/loop/apis"
+	"github.com/coze-dev/coze-loop/backend/api/otlp"
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/external/audit"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
@@ -42,6 +47,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/storage"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/js_conv"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
 )
 
 func Init(
@@ -61,13 +67,14 @@ func Init(
 	ckDB ck.Provider,
 	translater i18n.ITranslater,
 	plainLimiterFactory limiter.IPlainRateLimiterFactory,
+	kms dkms.IDKMS,
 ) (*apis.APIHandler, error) {
 	foundationHandler, err := apis.InitFoundationHandler(idgen, db, batchObjectStorage, configFactory)
 	if err != nil {
 		return nil, err
 	}
 
-	llmHandler, err := apis.InitLLMHandler(ctx, idgen, db, cmdable, configFactory, limiterFactory, loauth.NewLocalAuthService(foundationHandler.AuthService))
+	llmHandler, err := apis.InitLLMHandler(ctx, idgen, db, cmdable, configFactory, limiterFactory, kms, loauth.NewLocalAuthService(foundationHandler.AuthService))
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +143,7 @@ func Init(
 		storage.NewTraceStorageProvider(),
 		loexpt.NewLocalExperimentService(evaluationHandler.IExperimentApplication),
 		processor.TaskProcessor{},
+		objectStorage,
 		0,
 	)
 	if err != nil {
@@ -145,6 +153,9 @@ func Init(
 		return nil, err
 	}
 	observabilityHandler.RunAsync(ctx)
+	if err = evaluationHandler.RunExptTemplateScheduleTask(ctx); err != nil {
+		return nil, err
+	}
 
 	return &apis.APIHandler{
 		PromptHandler:        promptHandler,
@@ -167,5 +178,19 @@ func Start(handler *apis.APIHandler) {
 
 	register(h, handler)
 
+	// OTLP/gRPC服务随hertz一起退出
+	otlpServer := otlp.NewServer(handler)
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		otlp.Shutdown(ctx, otlpServer)
+	})
+	go startOTLP(otlpServer)
+
 	h.Spin()
 }
+
+// startOTLP 启动OTLP/gRPC trace接收服务，监听地址可通过COZE_LOOP_OTLP_GRPC_ADDR指定，默认:4317
+func startOTLP(s *grpc.Server) {
+	if err := otlp.Serve(s, os.Getenv("COZE_LOOP_OTLP_GRPC_ADDR")); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		logs.Error("otlp grpc receiver exited, err: %v", err)
+	}
+}
@@ -182,3 +182,9 @@ func ValidateDatasetItems(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// ExportDataset .
+// @router /api/data/v1/datasets/:dataset_id/export [POST]
+func ExportDataset(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localDataSvc.ExportDataset)
+}
@@ -324,3 +324,9 @@ func UpdateExperimentTemplateMeta(ctx context.Context, c *app.RequestContext) {
 func CheckExperimentTemplateName(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, localExptSvc.CheckExperimentTemplateName)
 }
+
+// CompareExperimentSignificance .
+// @router /api/evaluation/v1/experiments/aggr_results/compare [POST]
+func CompareExperimentSignificance(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localExptSvc.CompareExperimentSignificance)
+}
@@ -26,3 +26,27 @@ func GetSpace(ctx context.Context, c *app.RequestContext) {
 func ListUserSpaces(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, localSpaceClient.ListUserSpaces)
 }
+
+// ListSpaceMembers .
+// @router /api/foundation/v1/spaces/:space_id/members/list [POST]
+func ListSpaceMembers(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localSpaceClient.ListSpaceMembers)
+}
+
+// AddSpaceMember .
+// @router /api/foundation/v1/spaces/:space_id/members [POST]
+func AddSpaceMember(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localSpaceClient.AddSpaceMember)
+}
+
+// RemoveSpaceMember .
+// @router /api/foundation/v1/spaces/:space_id/members/:user_id [DELETE]
+func RemoveSpaceMember(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localSpaceClient.RemoveSpaceMember)
+}
+
+// UpdateSpaceMemberRole .
+// @router /api/foundation/v1/spaces/:space_id/members/:user_id [PUT]
+func UpdateSpaceMemberRole(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, localSpaceClient.UpdateSpaceMemberRole)
+}
@@ -26,3 +26,27 @@ func GetModel(ctx context.Context, c *app.RequestContext) {
 func ListModels(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, llmManageSvc.ListModels)
 }
+
+// CreateModel .
+// @router /api/llm/v1/models [POST]
+func CreateModel(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, llmManageSvc.CreateModel)
+}
+
+// UpdateModel .
+// @router /api/llm/v1/models/:model_id [PUT]
+func UpdateModel(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, llmManageSvc.UpdateModel)
+}
+
+// DeleteModel .
+// @router /api/llm/v1/models/:model_id [DELETE]
+func DeleteModel(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, llmManageSvc.DeleteModel)
+}
+
+// UpdateModelStatus .
+// @router /api/llm/v1/models/:model_id/status [POST]
+func UpdateModelStatus(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, llmManageSvc.UpdateModelStatus)
+}
@@ -25,3 +25,69 @@ func GetMetrics(ctx context.Context, c *app.RequestContext) {
 func GetDrillDownValues(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, observabilityMetricClient.GetDrillDownValues)
 }
+
+// CreateAlertRule .
+// @router /api/observability/v1/metrics/alert_rules [POST]
+func CreateAlertRule(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.CreateAlertRule)
+}
+
+// UpdateAlertRule .
+// @router /api/observability/v1/metrics/alert_rules/:rule_id [PUT]
+func UpdateAlertRule(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.UpdateAlertRule)
+}
+
+// DeleteAlertRule .
+// @router /api/observability/v1/metrics/alert_rules/:rule_id [DELETE]
+func DeleteAlertRule(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.DeleteAlertRule)
+}
+
+// ListAlertRules .
+// @router /api/observability/v1/metrics/alert_rules/list [POST]
+func ListAlertRules(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.ListAlertRules)
+}
+
+// QueryAggregations .
+// @router /api/observability/v1/metrics/aggregations [POST]
+func QueryAggregations(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.QueryAggregations)
+}
+
+// CreateDashboard .
+// @router /api/observability/v1/metrics/dashboards [POST]
+func CreateDashboard(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.CreateDashboard)
+}
+
+// UpdateDashboard .
+// @router /api/observability/v1/metrics/dashboards/:dashboard_id [PUT]
+func UpdateDashboard(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.UpdateDashboard)
+}
+
+// DeleteDashboard .
+// @router /api/observability/v1/metrics/dashboards/:dashboard_id [DELETE]
+func DeleteDashboard(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.DeleteDashboard)
+}
+
+// GetDashboard .
+// @router /api/observability/v1/metrics/dashboards/:dashboard_id [GET]
+func GetDashboard(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.GetDashboard)
+}
+
+// ListDashboards .
+// @router /api/observability/v1/metrics/dashboards/list [POST]
+func ListDashboards(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.ListDashboards)
+}
+
+// RenderDashboard .
+// @router /api/observability/v1/metrics/dashboards/:dashboard_id/render [POST]
+func RenderDashboard(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityMetricClient.RenderDashboard)
+}
@@ -178,3 +178,45 @@ func GetTrajectoryConfig(ctx context.Context, c *app.RequestContext) {
 func ListTrajectory(ctx context.Context, c *app.RequestContext) {
 	invokeAndRender(ctx, c, observabilityClient.ListTrajectory)
 }
+
+// UpsertRetentionPolicy .
+// @router /api/observability/v1/traces/retention_policy [POST]
+func UpsertRetentionPolicy(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.UpsertRetentionPolicy)
+}
+
+// GetRetentionPolicy .
+// @router /api/observability/v1/traces/retention_policy [GET]
+func GetRetentionPolicy(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.GetRetentionPolicy)
+}
+
+// DiffTraces .
+// @router /api/observability/v1/traces/diff [POST]
+func DiffTraces(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.DiffTraces)
+}
+
+// ListSessions .
+// @router /api/observability/v1/sessions/list [POST]
+func ListSessions(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.ListSessions)
+}
+
+// GetSession .
+// @router /api/observability/v1/sessions/get [POST]
+func GetSession(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.GetSession)
+}
+
+// ExportTracesToFile .
+// @router /api/observability/v1/traces/export_to_file [POST]
+func ExportTracesToFile(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.ExportTracesToFile)
+}
+
+// GetTraceExportJob .
+// @router /api/observability/v1/traces/export_jobs/:job_id [GET]
+func GetTraceExportJob(ctx context.Context, c *app.RequestContext) {
+	invokeAndRender(ctx, c, observabilityClient.GetTraceExportJob)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/external/audit"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
@@ -144,6 +145,7 @@ func InitLLMHandler(
 	cmdable redis.Cmdable,
 	configFactory conf.IConfigLoaderFactory,
 	limiterFactory limiter.IRateLimiterFactory,
+	kms dkms.IDKMS,
 	authClient authservice.Client,
 ) (*LLMHandler, error) {
 	wire.Build(
@@ -223,6 +225,7 @@ func InitObservabilityHandler(
 	storageProvider storage.IStorageProvider,
 	experimentClient experimentservice.Client,
 	taskProcessor task_processor.TaskProcessor,
+	objectStorage fileserver.ObjectStorage,
 	aid int32,
 ) (*ObservabilityHandler, error) {
 	wire.Build(
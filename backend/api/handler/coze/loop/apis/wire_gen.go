@@ -12,6 +12,7 @@ import (
 	"github.com/cloudwego/kitex/pkg/endpoint"
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/external/audit"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
@@ -108,12 +109,12 @@ func InitPromptHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Pr
 	return promptHandler, nil
 }
 
-func InitLLMHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Provider, cmdable redis.Cmdable, configFactory conf.IConfigLoaderFactory, limiterFactory limiter.IRateLimiterFactory, authClient authservice.Client) (*LLMHandler, error) {
-	llmManageService, err := application3.InitManageApplication(ctx, configFactory, authClient)
+func InitLLMHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Provider, cmdable redis.Cmdable, configFactory conf.IConfigLoaderFactory, limiterFactory limiter.IRateLimiterFactory, kms dkms.IDKMS, authClient authservice.Client) (*LLMHandler, error) {
+	llmManageService, err := application3.InitManageApplication(ctx, idgen2, configFactory, db2, kms, authClient)
 	if err != nil {
 		return nil, err
 	}
-	llmRuntimeService, err := application3.InitRuntimeApplication(ctx, idgen2, configFactory, db2, cmdable, limiterFactory)
+	llmRuntimeService, err := application3.InitRuntimeApplication(ctx, idgen2, configFactory, db2, cmdable, limiterFactory, kms)
 	if err != nil {
 		return nil, err
 	}
@@ -162,8 +163,8 @@ func InitDataHandler(ctx context.Context, idgen2 idgen.IIDGenerator, db2 db.Prov
 	return dataHandler, nil
 }
 
-func InitObservabilityHandler(ctx context.Context, db2 db.Provider, ckDb ck.Provider, meter metrics.Meter, mqFactory mq.IFactory, configFactory conf.IConfigLoaderFactory, idgen2 idgen.IIDGenerator, benefit2 benefit.IBenefitService, fileClient fileservice.Client, authCli authservice.Client, userClient userservice.Client, evalClient evaluatorservice.Client, evalSetClient evaluationsetservice.Client, tagClient tagservice.Client, limiterFactory limiter.IRateLimiterFactory, datasetClient datasetservice.Client, redis2 redis.Cmdable, persistentCmdable redis.PersistentCmdable, storageProvider storage.IStorageProvider, experimentClient experimentservice.Client, taskProcessor processor.TaskProcessor, aid int32) (*ObservabilityHandler, error) {
-	iTraceApplication, err := application6.InitTraceApplication(db2, ckDb, redis2, persistentCmdable, meter, mqFactory, configFactory, idgen2, fileClient, benefit2, authCli, userClient, evalClient, evalSetClient, tagClient, datasetClient)
+func InitObservabilityHandler(ctx context.Context, db2 db.Provider, ckDb ck.Provider, meter metrics.Meter, mqFactory mq.IFactory, configFactory conf.IConfigLoaderFactory, idgen2 idgen.IIDGenerator, benefit2 benefit.IBenefitService, fileClient fileservice.Client, authCli authservice.Client, userClient userservice.Client, evalClient evaluatorservice.Client, evalSetClient evaluationsetservice.Client, tagClient tagservice.Client, limiterFactory limiter.IRateLimiterFactory, datasetClient datasetservice.Client, redis2 redis.Cmdable, persistentCmdable redis.PersistentCmdable, storageProvider storage.IStorageProvider, experimentClient experimentservice.Client, taskProcessor processor.TaskProcessor, objectStorage fileserver.ObjectStorage, aid int32) (*ObservabilityHandler, error) {
+	iTraceApplication, err := application6.InitTraceApplication(db2, ckDb, redis2, persistentCmdable, meter, mqFactory, configFactory, idgen2, fileClient, benefit2, authCli, userClient, evalClient, evalSetClient, tagClient, datasetClient, objectStorage)
 	if err != nil {
 		return nil, err
 	}
@@ -179,7 +180,7 @@ func InitObservabilityHandler(ctx context.Context, db2 db.Provider, ckDb ck.Prov
 	if err != nil {
 		return nil, err
 	}
-	iMetricApplication, err := application6.InitMetricApplication(ckDb, storageProvider, configFactory, fileClient, benefit2, authCli, idgen2)
+	iMetricApplication, err := application6.InitMetricApplication(ckDb, storageProvider, configFactory, fileClient, benefit2, authCli, idgen2, db2, userClient)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlp
+
+import (
+	"context"
+	"net"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/coze-dev/coze-loop/backend/api/handler/coze/loop/apis"
+	"github.com/coze-dev/coze-loop/backend/api/router/coze/loop/apis/middleware"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/pkg/ctxcache"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	DefaultAddr = ":4317"
+
+	// 与HTTP上报保持一致的请求头，gRPC metadata的key均为小写
+	metadataAuthorization = "authorization"
+	metadataWorkspaceID   = "cozeloop-workspace-id"
+
+	maxRecvMsgSize = 20 * 1024 * 1024
+)
+
+// TraceReceiver 实现OTLP/gRPC的TraceService/Export，鉴权与空间解析和HTTP的OtelIngestTraces一致
+type TraceReceiver struct {
+	coltracepb.UnimplementedTraceServiceServer
+
+	handler *apis.APIHandler
+}
+
+func NewTraceReceiver(handler *apis.APIHandler) *TraceReceiver {
+	return &TraceReceiver{handler: handler}
+}
+
+func (r *TraceReceiver) Export(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	ctx = ctxcache.Init(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	user, err := middleware.VerifyPatToken(ctx, r.handler, firstMetadata(md, metadataAuthorization))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	ctx = session.WithCtxUser(ctx, user)
+
+	resp, err := r.handler.OtelExportTraces(ctx, firstMetadata(md, metadataWorkspaceID), req)
+	if err != nil {
+		logs.CtxError(ctx, "otlp grpc export traces failed, err: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, nil
+}
+
+func firstMetadata(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// NewServer 创建注册了TraceService的OTLP/gRPC服务
+func NewServer(handler *apis.APIHandler) *grpc.Server {
+	s := grpc.NewServer(grpc.MaxRecvMsgSize(maxRecvMsgSize))
+	coltracepb.RegisterTraceServiceServer(s, NewTraceReceiver(handler))
+	return s
+}
+
+// Serve 在addr上启动OTLP/gRPC接收服务，阻塞直到服务退出
+func Serve(s *grpc.Server, addr string) error {
+	if addr == "" {
+		addr = DefaultAddr
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	logs.Info("otlp grpc receiver listening on %s", addr)
+	return s.Serve(lis)
+}
+
+// Shutdown 优雅关闭OTLP/gRPC服务，等待进行中的请求处理完成；ctx超时后强制关闭
+func Shutdown(ctx context.Context, s *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.Stop()
+		<-done
+	}
+}
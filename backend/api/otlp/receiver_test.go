@@ -0,0 +1,138 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/coze-dev/coze-loop/backend/api/handler/coze/loop/apis"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/authn"
+	duser "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/domain/user"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/user"
+	"github.com/coze-dev/coze-loop/backend/modules/foundation/application/mocks"
+	obapp "github.com/coze-dev/coze-loop/backend/modules/observability/application"
+)
+
+type fakeOpenAPIApp struct {
+	obapp.IObservabilityOpenAPIApplication
+
+	workspaceID string
+	userID      string
+	err         error
+}
+
+func (f *fakeOpenAPIApp) OtelExportTraces(ctx context.Context, workspaceID string, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	f.workspaceID = workspaceID
+	f.userID = session.UserIDInCtxOrEmpty(ctx)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func TestTraceReceiver_Export(t *testing.T) {
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		setupMocks func(as *mocks.MockAuthNService, us *mocks.MockUserService)
+		exportErr  error
+		wantCode   codes.Code
+	}{
+		{
+			name: "export with pat token and workspace header",
+			md:   metadata.Pairs("authorization", "Bearer valid_token", "cozeloop-workspace-id", "123"),
+			setupMocks: func(as *mocks.MockAuthNService, us *mocks.MockUserService) {
+				as.EXPECT().VerifyToken(gomock.Any(), &authn.VerifyTokenRequest{Token: "valid_token"}).
+					Return(&authn.VerifyTokenResponse{Valid: gptr.Of(true), UserID: gptr.Of("user123")}, nil)
+				us.EXPECT().GetUserInfo(gomock.Any(), gomock.Any()).
+					Return(&user.GetUserInfoResponse{UserInfo: &duser.UserInfoDetail{UserID: gptr.Of("user123")}}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "missing authorization",
+			md:       metadata.Pairs("cozeloop-workspace-id", "123"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "invalid token",
+			md:   metadata.Pairs("authorization", "Bearer invalid_token"),
+			setupMocks: func(as *mocks.MockAuthNService, us *mocks.MockUserService) {
+				as.EXPECT().VerifyToken(gomock.Any(), gomock.Any()).
+					Return(&authn.VerifyTokenResponse{Valid: gptr.Of(false)}, nil)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "ingest failed",
+			md:   metadata.Pairs("authorization", "Bearer valid_token", "cozeloop-workspace-id", "123"),
+			setupMocks: func(as *mocks.MockAuthNService, us *mocks.MockUserService) {
+				as.EXPECT().VerifyToken(gomock.Any(), gomock.Any()).
+					Return(&authn.VerifyTokenResponse{Valid: gptr.Of(true), UserID: gptr.Of("user123")}, nil)
+				us.EXPECT().GetUserInfo(gomock.Any(), gomock.Any()).
+					Return(&user.GetUserInfoResponse{UserInfo: &duser.UserInfoDetail{UserID: gptr.Of("user123")}}, nil)
+			},
+			exportErr: assert.AnError,
+			wantCode:  codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			as := mocks.NewMockAuthNService(ctrl)
+			us := mocks.NewMockUserService(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(as, us)
+			}
+			app := &fakeOpenAPIApp{err: tt.exportErr}
+			handler := &apis.APIHandler{
+				FoundationHandler: &apis.FoundationHandler{
+					AuthNService: as,
+					UserService:  us,
+				},
+				ObservabilityHandler: &apis.ObservabilityHandler{
+					IObservabilityOpenAPIApplication: app,
+				},
+			}
+
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			resp, err := NewTraceReceiver(handler).Export(ctx, &coltracepb.ExportTraceServiceRequest{})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.NotNil(t, resp)
+				assert.Equal(t, "123", app.workspaceID)
+				assert.Equal(t, "user123", app.userID)
+			}
+		})
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	s := NewServer(&apis.APIHandler{})
+	served := make(chan error, 1)
+	go func() { served <- Serve(s, "127.0.0.1:0") }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	Shutdown(ctx, s)
+
+	select {
+	case <-served:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after Shutdown")
+	}
+}
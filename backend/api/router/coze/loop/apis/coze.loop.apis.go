@@ -41,6 +41,7 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 				_datasets.POST("/batch_get", append(_batchgetdatasetsMw(handler), apis.BatchGetDatasets)...)
 				_datasets.DELETE("/:dataset_id", append(_dataset_idMw(handler), apis.DeleteDataset)...)
 				_dataset_id := _datasets.Group("/:dataset_id", _dataset_idMw(handler)...)
+				_dataset_id.POST("/export", append(_exportdatasetMw(handler), apis.ExportDataset)...)
 				_dataset_id.POST("/import", append(_importdatasetMw(handler), apis.ImportDataset)...)
 				_dataset_id.POST("/io_jobs", append(_listdatasetiojobsMw(handler), apis.ListDatasetIOJobs)...)
 				_dataset_id.GET("/schema", append(_getdatasetschemaMw(handler), apis.GetDatasetSchema)...)
@@ -261,6 +262,7 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					{
 						_aggr_results := _experiments.Group("/aggr_results", _aggr_resultsMw(handler)...)
 						_aggr_results.POST("/batch_get", append(_batchgetexperimentaggrresultMw(handler), apis.BatchGetExperimentAggrResult)...)
+						_aggr_results.POST("/compare", append(_compareexperimentsignificanceMw(handler), apis.CompareExperimentSignificance)...)
 					}
 					{
 						_insight_analysis_records0 := _experiments.Group("/insight_analysis_records", _insight_analysis_records0Mw(handler)...)
@@ -284,7 +286,16 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 				{
 					_spaces := _v12.Group("/spaces", _spacesMw(handler)...)
 					_spaces.POST("/list", append(_listuserspacesMw(handler), apis.ListUserSpaces)...)
-					_spaces.GET("/:space_id", append(_getspaceMw(handler), apis.GetSpace)...)
+					_spaces.GET("/:space_id", append(_space_idMw(handler), apis.GetSpace)...)
+					_space_id := _spaces.Group("/:space_id", _space_idMw(handler)...)
+					_space_id.POST("/members", append(_membersMw(handler), apis.AddSpaceMember)...)
+					_members := _space_id.Group("/members", _membersMw(handler)...)
+					_members.DELETE("/:user_id", append(_removespacememberMw(handler), apis.RemoveSpaceMember)...)
+					_members.PUT("/:user_id", append(_updatespacememberroleMw(handler), apis.UpdateSpaceMemberRole)...)
+					{
+						_members0 := _space_id.Group("/members", _members0Mw(handler)...)
+						_members0.POST("/list", append(_listspacemembersMw(handler), apis.ListSpaceMembers)...)
+					}
 				}
 				{
 					_users := _v12.Group("/users", _usersMw(handler)...)
@@ -304,10 +315,16 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 			_llm := _api.Group("/llm", _llmMw(handler)...)
 			{
 				_v13 := _llm.Group("/v1", _v13Mw(handler)...)
+				_v13.POST("/models", append(_modelsMw(handler), apis.CreateModel)...)
+				_models := _v13.Group("/models", _modelsMw(handler)...)
+				_models.DELETE("/:model_id", append(_model_idMw(handler), apis.DeleteModel)...)
+				_model_id := _models.Group("/:model_id", _model_idMw(handler)...)
+				_model_id.POST("/status", append(_updatemodelstatusMw(handler), apis.UpdateModelStatus)...)
+				_models.PUT("/:model_id", append(_updatemodelMw(handler), apis.UpdateModel)...)
 				{
-					_models := _v13.Group("/models", _modelsMw(handler)...)
-					_models.POST("/list", append(_listmodelsMw(handler), apis.ListModels)...)
-					_models.POST("/:model_id", append(_getmodelMw(handler), apis.GetModel)...)
+					_models0 := _v13.Group("/models", _models0Mw(handler)...)
+					_models0.POST("/list", append(_listmodelsMw(handler), apis.ListModels)...)
+					_models0.POST("/:model_id", append(_getmodelMw(handler), apis.GetModel)...)
 				}
 			}
 		}
@@ -336,9 +353,28 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 				}
 				{
 					_metrics := _v14.Group("/metrics", _metricsMw(handler)...)
+					_metrics.POST("/aggregations", append(_queryaggregationsMw(handler), apis.QueryAggregations)...)
+					_metrics.POST("/alert_rules", append(_alert_rulesMw(handler), apis.CreateAlertRule)...)
+					_alert_rules := _metrics.Group("/alert_rules", _alert_rulesMw(handler)...)
+					_alert_rules.POST("/list", append(_listalertrulesMw(handler), apis.ListAlertRules)...)
+					_alert_rules.DELETE("/:rule_id", append(_deletealertruleMw(handler), apis.DeleteAlertRule)...)
+					_alert_rules.PUT("/:rule_id", append(_updatealertruleMw(handler), apis.UpdateAlertRule)...)
+					_metrics.POST("/dashboards", append(_dashboardsMw(handler), apis.CreateDashboard)...)
+					_dashboards := _metrics.Group("/dashboards", _dashboardsMw(handler)...)
+					_dashboards.DELETE("/:dashboard_id", append(_dashboard_idMw(handler), apis.DeleteDashboard)...)
+					_dashboard_id := _dashboards.Group("/:dashboard_id", _dashboard_idMw(handler)...)
+					_dashboard_id.POST("/render", append(_renderdashboardMw(handler), apis.RenderDashboard)...)
+					_dashboards.GET("/:dashboard_id", append(_getdashboardMw(handler), apis.GetDashboard)...)
+					_dashboards.PUT("/:dashboard_id", append(_updatedashboardMw(handler), apis.UpdateDashboard)...)
+					_dashboards.POST("/list", append(_listdashboardsMw(handler), apis.ListDashboards)...)
 					_metrics.POST("/drill_down_values", append(_getdrilldownvaluesMw(handler), apis.GetDrillDownValues)...)
 					_metrics.POST("/list", append(_getmetricsMw(handler), apis.GetMetrics)...)
 				}
+				{
+					_sessions := _v14.Group("/sessions", _sessionsMw(handler)...)
+					_sessions.POST("/get", append(_getsessionMw(handler), apis.GetSession)...)
+					_sessions.POST("/list", append(_listsessionsMw(handler), apis.ListSessions)...)
+				}
 				{
 					_spans := _v14.Group("/spans", _spansMw(handler)...)
 					_spans.POST("/list", append(_listspansMw(handler), apis.ListSpans)...)
@@ -356,14 +392,22 @@ func Register(r *server.Hertz, handler *apis.APIHandler) {
 					_traces := _v14.Group("/traces", _tracesMw(handler)...)
 					_traces.POST("/batch_get_advance_info", append(_batchgettracesadvanceinfoMw(handler), apis.BatchGetTracesAdvanceInfo)...)
 					_traces.POST("/change_eval_score", append(_changeevaluatorscoreMw(handler), apis.ChangeEvaluatorScore)...)
+					_traces.POST("/diff", append(_difftracesMw(handler), apis.DiffTraces)...)
 					_traces.POST("/export_to_dataset", append(_exporttracestodatasetMw(handler), apis.ExportTracesToDataset)...)
+					_traces.POST("/export_to_file", append(_exporttracestofileMw(handler), apis.ExportTracesToFile)...)
 					_traces.GET("/meta_info", append(_gettracesmetainfoMw(handler), apis.GetTracesMetaInfo)...)
 					_traces.POST("/preview_export_to_dataset", append(_previewexporttracestodatasetMw(handler), apis.PreviewExportTracesToDataset)...)
+					_traces.GET("/retention_policy", append(_getretentionpolicyMw(handler), apis.GetRetentionPolicy)...)
+					_traces.POST("/retention_policy", append(_upsertretentionpolicyMw(handler), apis.UpsertRetentionPolicy)...)
 					_traces.POST("/search_tree", append(_searchtracetreeMw(handler), apis.SearchTraceTree)...)
 					_traces.GET("/:trace_id", append(_gettraceMw(handler), apis.GetTrace)...)
 					_traces.POST("/trajectory", append(_listtrajectoryMw(handler), apis.ListTrajectory)...)
 					_traces.GET("/trajectory_config", append(_gettrajectoryconfigMw(handler), apis.GetTrajectoryConfig)...)
 					_traces.POST("/trajectory_config", append(_upserttrajectoryconfigMw(handler), apis.UpsertTrajectoryConfig)...)
+					{
+						_export_jobs := _traces.Group("/export_jobs", _export_jobsMw(handler)...)
+						_export_jobs.GET("/:job_id", append(_gettraceexportjobMw(handler), apis.GetTraceExportJob)...)
+					}
 				}
 			}
 		}
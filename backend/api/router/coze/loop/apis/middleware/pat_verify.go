@@ -19,51 +19,51 @@ import (
 
 func PatTokenVerifyMW(handler *apis.APIHandler) app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
-		authHeader := c.GetHeader("Authorization")
-
-		if len(authHeader) == 0 {
-			_ = c.Error(errorx.New("authorization header is empty"))
-			c.Abort()
-			return
-		}
-
-		token := strings.TrimPrefix(string(authHeader), "Bearer ")
-		verifyRes, err := handler.VerifyToken(ctx, &authn.VerifyTokenRequest{
-			Token: token,
-		})
+		u, err := VerifyPatToken(ctx, handler, string(c.GetHeader("Authorization")))
 		if err != nil {
 			_ = c.Error(err)
 			c.Abort()
 			return
 		}
-		if verifyRes.Valid == nil || !*verifyRes.Valid || len(verifyRes.GetUserID()) == 0 {
-			_ = c.Error(errorx.New("invalid pat token"))
-			c.Abort()
-			return
-		}
 
-		userID := verifyRes.GetUserID()
-		resp, err := handler.GetUserInfo(ctx, &user.GetUserInfoRequest{
-			UserID: gptr.Of(userID),
-		})
-		if err != nil {
-			_ = c.Error(err)
-			c.Abort()
-			return
-		}
+		ctx = session.WithCtxUser(ctx, u)
 
-		if resp.GetUserInfo() == nil {
-			_ = c.Error(errorx.New("user not found"))
-			c.Abort()
-			return
-		}
+		c.Next(ctx)
+	}
+}
 
-		ctx = session.WithCtxUser(ctx, &session.User{
-			ID:    userID,
-			Name:  resp.GetUserInfo().GetName(),
-			Email: resp.GetUserInfo().GetEmail(),
-		})
+// VerifyPatToken 校验Authorization中的个人访问令牌并返回对应用户，HTTP与gRPC入口共用
+func VerifyPatToken(ctx context.Context, handler *apis.APIHandler, authHeader string) (*session.User, error) {
+	if len(authHeader) == 0 {
+		return nil, errorx.New("authorization header is empty")
+	}
 
-		c.Next(ctx)
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+	verifyRes, err := handler.VerifyToken(ctx, &authn.VerifyTokenRequest{
+		Token: token,
+	})
+	if err != nil {
+		return nil, err
 	}
+	if verifyRes.Valid == nil || !*verifyRes.Valid || len(verifyRes.GetUserID()) == 0 {
+		return nil, errorx.New("invalid pat token")
+	}
+
+	userID := verifyRes.GetUserID()
+	resp, err := handler.GetUserInfo(ctx, &user.GetUserInfoRequest{
+		UserID: gptr.Of(userID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetUserInfo() == nil {
+		return nil, errorx.New("user not found")
+	}
+
+	return &session.User{
+		ID:    userID,
+		Name:  resp.GetUserInfo().GetName(),
+		Email: resp.GetUserInfo().GetEmail(),
+	}, nil
 }
@@ -23,6 +23,8 @@ import (
 	"github.com/coze-dev/coze-loop/backend/api/handler/coze/loop/apis"
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms/local"
 	"github.com/coze-dev/coze-loop/backend/infra/external/audit"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
 	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
@@ -54,7 +56,7 @@ func main() {
 		panic(err)
 	}
 
-	handler, err := api.Init(ctx, c.idgen, c.db, c.redis, c.redis, c.cfgFactory, c.mqFactory, c.objectStorage, c.batchObjectStorage, c.benefitSvc, c.auditClient, c.metric, c.limiterFactory, c.ckDb, c.translater, c.plainLimiterFactory)
+	handler, err := api.Init(ctx, c.idgen, c.db, c.redis, c.redis, c.cfgFactory, c.mqFactory, c.objectStorage, c.batchObjectStorage, c.benefitSvc, c.auditClient, c.metric, c.limiterFactory, c.ckDb, c.translater, c.plainLimiterFactory, c.kms)
 	if err != nil {
 		panic(err)
 	}
@@ -143,6 +145,7 @@ type component struct {
 	ckDb                ck.Provider
 	translater          i18n.ITranslater
 	plainLimiterFactory limiter.IPlainRateLimiterFactory
+	kms                 dkms.IDKMS
 }
 
 func initTracer(handler *apis.APIHandler) error {
@@ -261,6 +264,11 @@ func newComponent(ctx context.Context) (*component, error) {
 		return nil, err
 	}
 
+	kms, err := local.NewLocalDKMS(getDKMSMasterKey())
+	if err != nil {
+		return nil, err
+	}
+
 	return &component{
 		idgen:               idgenerator,
 		db:                  db,
@@ -276,6 +284,7 @@ func newComponent(ctx context.Context) (*component, error) {
 		ckDb:                ckDb,
 		translater:          translater,
 		plainLimiterFactory: dist.NewPlainLimiterFactory(cmdable),
+		kms:                 kms,
 	}, nil
 }
 
@@ -359,6 +368,10 @@ func getOssBucket() string {
 	return os.Getenv("COZE_LOOP_OSS_BUCKET")
 }
 
+func getDKMSMasterKey() string {
+	return os.Getenv("COZE_LOOP_DKMS_MASTER_KEY")
+}
+
 func getOssForcePathStyle() *bool {
 	if getOssDomain() == "coze-loop-minio" {
 		return gptr.Of(true)
@@ -70,3 +70,33 @@ func backoffFn(ctx context.Context, fn func() error, policy *backoff.Exponential
 func RetryWithMaxTimes(ctx context.Context, max int, fn func() error) error {
 	return backoff.Retry(fn, backoff.WithMaxRetries(&backoff.ZeroBackOff{}, uint64(max)))
 }
+
+// RetryWithMaxAttempts 指数退避重试，fn 最多执行 maxAttempts 次；fn 返回 Permanent 包装的错误时立即停止重试
+func RetryWithMaxAttempts(ctx context.Context, maxAttempts int, initialInterval, maxInterval time.Duration, fn func() error) error {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	policy := backoff.NewExponentialBackOff()
+	policy.MaxElapsedTime = 0 // 由最大尝试次数控制
+	if initialInterval > 0 {
+		policy.InitialInterval = initialInterval
+	} else {
+		policy.InitialInterval = defaultRetryInterval
+	}
+	if maxInterval > 0 {
+		policy.MaxInterval = maxInterval
+	}
+
+	ctxWithCancel, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	return backoff.Retry(fn, backoff.WithContext(backoff.WithMaxRetries(policy, uint64(maxAttempts-1)), ctxWithCancel))
+}
+
+// Permanent 包装不可重试的错误，Retry 系列函数遇到该错误时立即返回原始错误
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return backoff.Permanent(err)
+}
@@ -65,3 +65,38 @@ func TestRetryWithMaxTimes(t *testing.T) {
 		assert.Equal(t, 4, count)
 	})
 }
+
+func TestRetryWithMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("test max attempts", func(t *testing.T) {
+		var count int
+		err := RetryWithMaxAttempts(ctx, 3, time.Millisecond, time.Millisecond, func() error {
+			count++
+			return fmt.Errorf("error")
+		})
+		assert.NotNil(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("test permanent error", func(t *testing.T) {
+		var count int
+		permanentErr := fmt.Errorf("permanent")
+		err := RetryWithMaxAttempts(ctx, 3, time.Millisecond, time.Millisecond, func() error {
+			count++
+			return Permanent(permanentErr)
+		})
+		assert.Equal(t, permanentErr, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("test success", func(t *testing.T) {
+		var count int
+		err := RetryWithMaxAttempts(ctx, 0, time.Millisecond, 0, func() error {
+			count++
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
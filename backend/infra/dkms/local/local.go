@@ -0,0 +1,84 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
+)
+
+// localKMS 基于本地主密钥的 IDKMS 实现。
+// 每个 dataKey 由主密钥派生出独立的 AES-256 密钥，使用 AES-GCM 加密，密文格式为 base64(nonce | ciphertext)。
+type localKMS struct {
+	masterKey []byte
+}
+
+var _ dkms.IDKMS = (*localKMS)(nil)
+
+func NewLocalDKMS(masterKey string) (dkms.IDKMS, error) {
+	if masterKey == "" {
+		return nil, errors.New("dkms master key is empty")
+	}
+	return &localKMS{masterKey: []byte(masterKey)}, nil
+}
+
+// Encrypt 空字符串不加密，直接返回空字符串
+func (k *localKMS) Encrypt(ctx context.Context, dataKey, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	aead, err := k.newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.WithMessage(err, "generate nonce")
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(dataKey))
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (k *localKMS) Decrypt(ctx context.Context, dataKey, ciphertext string) (string, error) {
+	if ciphertext == "" {
+		return "", nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", errors.WithMessage(err, "decode ciphertext")
+	}
+	aead, err := k.newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("ciphertext is too short")
+	}
+	nonce, data := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, data, []byte(dataKey))
+	if err != nil {
+		return "", errors.WithMessage(err, "decrypt ciphertext")
+	}
+	return string(plaintext), nil
+}
+
+func (k *localKMS) newAEAD(dataKey string) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, k.masterKey)
+	mac.Write([]byte(dataKey))
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
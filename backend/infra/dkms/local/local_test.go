@@ -0,0 +1,65 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalKMS(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewLocalDKMS("")
+	assert.Error(t, err)
+
+	kms, err := NewLocalDKMS("master-key")
+	require.NoError(t, err)
+
+	t.Run("round trip", func(t *testing.T) {
+		ciphertext, err := kms.Encrypt(ctx, "data-key", "sk-123456")
+		require.NoError(t, err)
+		assert.NotEqual(t, "sk-123456", ciphertext)
+
+		// nonce 随机，相同明文的密文不同
+		another, err := kms.Encrypt(ctx, "data-key", "sk-123456")
+		require.NoError(t, err)
+		assert.NotEqual(t, ciphertext, another)
+
+		plaintext, err := kms.Decrypt(ctx, "data-key", ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, "sk-123456", plaintext)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		ciphertext, err := kms.Encrypt(ctx, "data-key", "")
+		require.NoError(t, err)
+		assert.Empty(t, ciphertext)
+		plaintext, err := kms.Decrypt(ctx, "data-key", "")
+		require.NoError(t, err)
+		assert.Empty(t, plaintext)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		ciphertext, err := kms.Encrypt(ctx, "data-key", "sk-123456")
+		require.NoError(t, err)
+
+		_, err = kms.Decrypt(ctx, "other-data-key", ciphertext)
+		assert.Error(t, err)
+
+		other, err := NewLocalDKMS("other-master-key")
+		require.NoError(t, err)
+		_, err = other.Decrypt(ctx, "data-key", ciphertext)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid ciphertext", func(t *testing.T) {
+		_, err := kms.Decrypt(ctx, "data-key", "not base64!")
+		assert.Error(t, err)
+		_, err = kms.Decrypt(ctx, "data-key", "YWJj")
+		assert.Error(t, err)
+	})
+}
@@ -18,6 +18,7 @@ type Client interface {
 	GetDataset(ctx context.Context, req *dataset.GetDatasetRequest, callOptions ...callopt.Option) (r *dataset.GetDatasetResponse, err error)
 	BatchGetDatasets(ctx context.Context, req *dataset.BatchGetDatasetsRequest, callOptions ...callopt.Option) (r *dataset.BatchGetDatasetsResponse, err error)
 	ImportDataset(ctx context.Context, req *dataset.ImportDatasetRequest, callOptions ...callopt.Option) (r *dataset.ImportDatasetResponse, err error)
+	ExportDataset(ctx context.Context, req *dataset.ExportDatasetRequest, callOptions ...callopt.Option) (r *dataset.ExportDatasetResponse, err error)
 	GetDatasetIOJob(ctx context.Context, req *dataset.GetDatasetIOJobRequest, callOptions ...callopt.Option) (r *dataset.GetDatasetIOJobResponse, err error)
 	ListDatasetIOJobs(ctx context.Context, req *dataset.ListDatasetIOJobsRequest, callOptions ...callopt.Option) (r *dataset.ListDatasetIOJobsResponse, err error)
 	CreateDatasetVersion(ctx context.Context, req *dataset.CreateDatasetVersionRequest, callOptions ...callopt.Option) (r *dataset.CreateDatasetVersionResponse, err error)
@@ -103,6 +104,11 @@ func (p *kDatasetServiceClient) ImportDataset(ctx context.Context, req *dataset.
 	return p.kClient.ImportDataset(ctx, req)
 }
 
+func (p *kDatasetServiceClient) ExportDataset(ctx context.Context, req *dataset.ExportDatasetRequest, callOptions ...callopt.Option) (r *dataset.ExportDatasetResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportDataset(ctx, req)
+}
+
 func (p *kDatasetServiceClient) GetDatasetIOJob(ctx context.Context, req *dataset.GetDatasetIOJobRequest, callOptions ...callopt.Option) (r *dataset.GetDatasetIOJobResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetDatasetIOJob(ctx, req)
@@ -63,6 +63,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ExportDataset": kitex.NewMethodInfo(
+		exportDatasetHandler,
+		newDatasetServiceExportDatasetArgs,
+		newDatasetServiceExportDatasetResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"GetDatasetIOJob": kitex.NewMethodInfo(
 		getDatasetIOJobHandler,
 		newDatasetServiceGetDatasetIOJobArgs,
@@ -362,6 +369,25 @@ func newDatasetServiceImportDatasetResult() interface{} {
 	return dataset.NewDatasetServiceImportDatasetResult()
 }
 
+func exportDatasetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*dataset.DatasetServiceExportDatasetArgs)
+	realResult := result.(*dataset.DatasetServiceExportDatasetResult)
+	success, err := handler.(dataset.DatasetService).ExportDataset(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newDatasetServiceExportDatasetArgs() interface{} {
+	return dataset.NewDatasetServiceExportDatasetArgs()
+}
+
+func newDatasetServiceExportDatasetResult() interface{} {
+	return dataset.NewDatasetServiceExportDatasetResult()
+}
+
 func getDatasetIOJobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*dataset.DatasetServiceGetDatasetIOJobArgs)
 	realResult := result.(*dataset.DatasetServiceGetDatasetIOJobResult)
@@ -805,6 +831,16 @@ func (p *kClient) ImportDataset(ctx context.Context, req *dataset.ImportDatasetR
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ExportDataset(ctx context.Context, req *dataset.ExportDatasetRequest) (r *dataset.ExportDatasetResponse, err error) {
+	var _args dataset.DatasetServiceExportDatasetArgs
+	_args.Req = req
+	var _result dataset.DatasetServiceExportDatasetResult
+	if err = p.c.Call(ctx, "ExportDataset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetDatasetIOJob(ctx context.Context, req *dataset.GetDatasetIOJobRequest) (r *dataset.GetDatasetIOJobResponse, err error) {
 	var _args dataset.DatasetServiceGetDatasetIOJobArgs
 	_args.Req = req
@@ -13,6 +13,10 @@ import (
 type Client interface {
 	GetSpace(ctx context.Context, request *space.GetSpaceRequest, callOptions ...callopt.Option) (r *space.GetSpaceResponse, err error)
 	ListUserSpaces(ctx context.Context, request *space.ListUserSpaceRequest, callOptions ...callopt.Option) (r *space.ListUserSpaceResponse, err error)
+	ListSpaceMembers(ctx context.Context, request *space.ListSpaceMembersRequest, callOptions ...callopt.Option) (r *space.ListSpaceMembersResponse, err error)
+	AddSpaceMember(ctx context.Context, request *space.AddSpaceMemberRequest, callOptions ...callopt.Option) (r *space.AddSpaceMemberResponse, err error)
+	RemoveSpaceMember(ctx context.Context, request *space.RemoveSpaceMemberRequest, callOptions ...callopt.Option) (r *space.RemoveSpaceMemberResponse, err error)
+	UpdateSpaceMemberRole(ctx context.Context, request *space.UpdateSpaceMemberRoleRequest, callOptions ...callopt.Option) (r *space.UpdateSpaceMemberRoleResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -53,3 +57,23 @@ func (p *kFoundationSpaceServiceClient) ListUserSpaces(ctx context.Context, requ
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ListUserSpaces(ctx, request)
 }
+
+func (p *kFoundationSpaceServiceClient) ListSpaceMembers(ctx context.Context, request *space.ListSpaceMembersRequest, callOptions ...callopt.Option) (r *space.ListSpaceMembersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSpaceMembers(ctx, request)
+}
+
+func (p *kFoundationSpaceServiceClient) AddSpaceMember(ctx context.Context, request *space.AddSpaceMemberRequest, callOptions ...callopt.Option) (r *space.AddSpaceMemberResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddSpaceMember(ctx, request)
+}
+
+func (p *kFoundationSpaceServiceClient) RemoveSpaceMember(ctx context.Context, request *space.RemoveSpaceMemberRequest, callOptions ...callopt.Option) (r *space.RemoveSpaceMemberResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveSpaceMember(ctx, request)
+}
+
+func (p *kFoundationSpaceServiceClient) UpdateSpaceMemberRole(ctx context.Context, request *space.UpdateSpaceMemberRoleRequest, callOptions ...callopt.Option) (r *space.UpdateSpaceMemberRoleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateSpaceMemberRole(ctx, request)
+}
@@ -28,6 +28,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListSpaceMembers": kitex.NewMethodInfo(
+		listSpaceMembersHandler,
+		newSpaceServiceListSpaceMembersArgs,
+		newSpaceServiceListSpaceMembersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AddSpaceMember": kitex.NewMethodInfo(
+		addSpaceMemberHandler,
+		newSpaceServiceAddSpaceMemberArgs,
+		newSpaceServiceAddSpaceMemberResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RemoveSpaceMember": kitex.NewMethodInfo(
+		removeSpaceMemberHandler,
+		newSpaceServiceRemoveSpaceMemberArgs,
+		newSpaceServiceRemoveSpaceMemberResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateSpaceMemberRole": kitex.NewMethodInfo(
+		updateSpaceMemberRoleHandler,
+		newSpaceServiceUpdateSpaceMemberRoleArgs,
+		newSpaceServiceUpdateSpaceMemberRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -99,6 +127,82 @@ func newSpaceServiceListUserSpacesResult() interface{} {
 	return space.NewSpaceServiceListUserSpacesResult()
 }
 
+func listSpaceMembersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*space.SpaceServiceListSpaceMembersArgs)
+	realResult := result.(*space.SpaceServiceListSpaceMembersResult)
+	success, err := handler.(space.SpaceService).ListSpaceMembers(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newSpaceServiceListSpaceMembersArgs() interface{} {
+	return space.NewSpaceServiceListSpaceMembersArgs()
+}
+
+func newSpaceServiceListSpaceMembersResult() interface{} {
+	return space.NewSpaceServiceListSpaceMembersResult()
+}
+
+func addSpaceMemberHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*space.SpaceServiceAddSpaceMemberArgs)
+	realResult := result.(*space.SpaceServiceAddSpaceMemberResult)
+	success, err := handler.(space.SpaceService).AddSpaceMember(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newSpaceServiceAddSpaceMemberArgs() interface{} {
+	return space.NewSpaceServiceAddSpaceMemberArgs()
+}
+
+func newSpaceServiceAddSpaceMemberResult() interface{} {
+	return space.NewSpaceServiceAddSpaceMemberResult()
+}
+
+func removeSpaceMemberHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*space.SpaceServiceRemoveSpaceMemberArgs)
+	realResult := result.(*space.SpaceServiceRemoveSpaceMemberResult)
+	success, err := handler.(space.SpaceService).RemoveSpaceMember(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newSpaceServiceRemoveSpaceMemberArgs() interface{} {
+	return space.NewSpaceServiceRemoveSpaceMemberArgs()
+}
+
+func newSpaceServiceRemoveSpaceMemberResult() interface{} {
+	return space.NewSpaceServiceRemoveSpaceMemberResult()
+}
+
+func updateSpaceMemberRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*space.SpaceServiceUpdateSpaceMemberRoleArgs)
+	realResult := result.(*space.SpaceServiceUpdateSpaceMemberRoleResult)
+	success, err := handler.(space.SpaceService).UpdateSpaceMemberRole(ctx, realArg.Request)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newSpaceServiceUpdateSpaceMemberRoleArgs() interface{} {
+	return space.NewSpaceServiceUpdateSpaceMemberRoleArgs()
+}
+
+func newSpaceServiceUpdateSpaceMemberRoleResult() interface{} {
+	return space.NewSpaceServiceUpdateSpaceMemberRoleResult()
+}
+
 type kClient struct {
 	c  client.Client
 	sc client.Streaming
@@ -130,3 +234,43 @@ func (p *kClient) ListUserSpaces(ctx context.Context, request *space.ListUserSpa
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ListSpaceMembers(ctx context.Context, request *space.ListSpaceMembersRequest) (r *space.ListSpaceMembersResponse, err error) {
+	var _args space.SpaceServiceListSpaceMembersArgs
+	_args.Request = request
+	var _result space.SpaceServiceListSpaceMembersResult
+	if err = p.c.Call(ctx, "ListSpaceMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddSpaceMember(ctx context.Context, request *space.AddSpaceMemberRequest) (r *space.AddSpaceMemberResponse, err error) {
+	var _args space.SpaceServiceAddSpaceMemberArgs
+	_args.Request = request
+	var _result space.SpaceServiceAddSpaceMemberResult
+	if err = p.c.Call(ctx, "AddSpaceMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveSpaceMember(ctx context.Context, request *space.RemoveSpaceMemberRequest) (r *space.RemoveSpaceMemberResponse, err error) {
+	var _args space.SpaceServiceRemoveSpaceMemberArgs
+	_args.Request = request
+	var _result space.SpaceServiceRemoveSpaceMemberResult
+	if err = p.c.Call(ctx, "RemoveSpaceMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateSpaceMemberRole(ctx context.Context, request *space.UpdateSpaceMemberRoleRequest) (r *space.UpdateSpaceMemberRoleResponse, err error) {
+	var _args space.SpaceServiceUpdateSpaceMemberRoleArgs
+	_args.Request = request
+	var _result space.SpaceServiceUpdateSpaceMemberRoleResult
+	if err = p.c.Call(ctx, "UpdateSpaceMemberRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
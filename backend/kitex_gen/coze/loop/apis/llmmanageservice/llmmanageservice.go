@@ -28,6 +28,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateModel": kitex.NewMethodInfo(
+		createModelHandler,
+		newLLMManageServiceCreateModelArgs,
+		newLLMManageServiceCreateModelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateModel": kitex.NewMethodInfo(
+		updateModelHandler,
+		newLLMManageServiceUpdateModelArgs,
+		newLLMManageServiceUpdateModelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteModel": kitex.NewMethodInfo(
+		deleteModelHandler,
+		newLLMManageServiceDeleteModelArgs,
+		newLLMManageServiceDeleteModelResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateModelStatus": kitex.NewMethodInfo(
+		updateModelStatusHandler,
+		newLLMManageServiceUpdateModelStatusArgs,
+		newLLMManageServiceUpdateModelStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -99,6 +127,82 @@ func newLLMManageServiceGetModelResult() interface{} {
 	return manage.NewLLMManageServiceGetModelResult()
 }
 
+func createModelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.LLMManageServiceCreateModelArgs)
+	realResult := result.(*manage.LLMManageServiceCreateModelResult)
+	success, err := handler.(manage.LLMManageService).CreateModel(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMManageServiceCreateModelArgs() interface{} {
+	return manage.NewLLMManageServiceCreateModelArgs()
+}
+
+func newLLMManageServiceCreateModelResult() interface{} {
+	return manage.NewLLMManageServiceCreateModelResult()
+}
+
+func updateModelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.LLMManageServiceUpdateModelArgs)
+	realResult := result.(*manage.LLMManageServiceUpdateModelResult)
+	success, err := handler.(manage.LLMManageService).UpdateModel(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMManageServiceUpdateModelArgs() interface{} {
+	return manage.NewLLMManageServiceUpdateModelArgs()
+}
+
+func newLLMManageServiceUpdateModelResult() interface{} {
+	return manage.NewLLMManageServiceUpdateModelResult()
+}
+
+func deleteModelHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.LLMManageServiceDeleteModelArgs)
+	realResult := result.(*manage.LLMManageServiceDeleteModelResult)
+	success, err := handler.(manage.LLMManageService).DeleteModel(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMManageServiceDeleteModelArgs() interface{} {
+	return manage.NewLLMManageServiceDeleteModelArgs()
+}
+
+func newLLMManageServiceDeleteModelResult() interface{} {
+	return manage.NewLLMManageServiceDeleteModelResult()
+}
+
+func updateModelStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*manage.LLMManageServiceUpdateModelStatusArgs)
+	realResult := result.(*manage.LLMManageServiceUpdateModelStatusResult)
+	success, err := handler.(manage.LLMManageService).UpdateModelStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newLLMManageServiceUpdateModelStatusArgs() interface{} {
+	return manage.NewLLMManageServiceUpdateModelStatusArgs()
+}
+
+func newLLMManageServiceUpdateModelStatusResult() interface{} {
+	return manage.NewLLMManageServiceUpdateModelStatusResult()
+}
+
 type kClient struct {
 	c  client.Client
 	sc client.Streaming
@@ -130,3 +234,43 @@ func (p *kClient) GetModel(ctx context.Context, req *manage.GetModelRequest) (r
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateModel(ctx context.Context, req *manage.CreateModelRequest) (r *manage.CreateModelResponse, err error) {
+	var _args manage.LLMManageServiceCreateModelArgs
+	_args.Req = req
+	var _result manage.LLMManageServiceCreateModelResult
+	if err = p.c.Call(ctx, "CreateModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateModel(ctx context.Context, req *manage.UpdateModelRequest) (r *manage.UpdateModelResponse, err error) {
+	var _args manage.LLMManageServiceUpdateModelArgs
+	_args.Req = req
+	var _result manage.LLMManageServiceUpdateModelResult
+	if err = p.c.Call(ctx, "UpdateModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteModel(ctx context.Context, req *manage.DeleteModelRequest) (r *manage.DeleteModelResponse, err error) {
+	var _args manage.LLMManageServiceDeleteModelArgs
+	_args.Req = req
+	var _result manage.LLMManageServiceDeleteModelResult
+	if err = p.c.Call(ctx, "DeleteModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateModelStatus(ctx context.Context, req *manage.UpdateModelStatusRequest) (r *manage.UpdateModelStatusResponse, err error) {
+	var _args manage.LLMManageServiceUpdateModelStatusArgs
+	_args.Req = req
+	var _result manage.LLMManageServiceUpdateModelStatusResult
+	if err = p.c.Call(ctx, "UpdateModelStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
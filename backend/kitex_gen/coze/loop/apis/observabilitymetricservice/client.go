@@ -14,6 +14,17 @@ type Client interface {
 	GetMetrics(ctx context.Context, req *metric.GetMetricsRequest, callOptions ...callopt.Option) (r *metric.GetMetricsResponse, err error)
 	GetDrillDownValues(ctx context.Context, req *metric.GetDrillDownValuesRequest, callOptions ...callopt.Option) (r *metric.GetDrillDownValuesResponse, err error)
 	TraverseMetrics(ctx context.Context, req *metric.TraverseMetricsRequest, callOptions ...callopt.Option) (r *metric.TraverseMetricsResponse, err error)
+	QueryAggregations(ctx context.Context, req *metric.QueryAggregationsRequest, callOptions ...callopt.Option) (r *metric.QueryAggregationsResponse, err error)
+	CreateAlertRule(ctx context.Context, req *metric.CreateAlertRuleRequest, callOptions ...callopt.Option) (r *metric.CreateAlertRuleResponse, err error)
+	UpdateAlertRule(ctx context.Context, req *metric.UpdateAlertRuleRequest, callOptions ...callopt.Option) (r *metric.UpdateAlertRuleResponse, err error)
+	DeleteAlertRule(ctx context.Context, req *metric.DeleteAlertRuleRequest, callOptions ...callopt.Option) (r *metric.DeleteAlertRuleResponse, err error)
+	ListAlertRules(ctx context.Context, req *metric.ListAlertRulesRequest, callOptions ...callopt.Option) (r *metric.ListAlertRulesResponse, err error)
+	CreateDashboard(ctx context.Context, req *metric.CreateDashboardRequest, callOptions ...callopt.Option) (r *metric.CreateDashboardResponse, err error)
+	UpdateDashboard(ctx context.Context, req *metric.UpdateDashboardRequest, callOptions ...callopt.Option) (r *metric.UpdateDashboardResponse, err error)
+	DeleteDashboard(ctx context.Context, req *metric.DeleteDashboardRequest, callOptions ...callopt.Option) (r *metric.DeleteDashboardResponse, err error)
+	GetDashboard(ctx context.Context, req *metric.GetDashboardRequest, callOptions ...callopt.Option) (r *metric.GetDashboardResponse, err error)
+	ListDashboards(ctx context.Context, req *metric.ListDashboardsRequest, callOptions ...callopt.Option) (r *metric.ListDashboardsResponse, err error)
+	RenderDashboard(ctx context.Context, req *metric.RenderDashboardRequest, callOptions ...callopt.Option) (r *metric.RenderDashboardResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +70,58 @@ func (p *kObservabilityMetricServiceClient) TraverseMetrics(ctx context.Context,
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.TraverseMetrics(ctx, req)
 }
+
+func (p *kObservabilityMetricServiceClient) QueryAggregations(ctx context.Context, req *metric.QueryAggregationsRequest, callOptions ...callopt.Option) (r *metric.QueryAggregationsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.QueryAggregations(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) CreateAlertRule(ctx context.Context, req *metric.CreateAlertRuleRequest, callOptions ...callopt.Option) (r *metric.CreateAlertRuleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAlertRule(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) UpdateAlertRule(ctx context.Context, req *metric.UpdateAlertRuleRequest, callOptions ...callopt.Option) (r *metric.UpdateAlertRuleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAlertRule(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) DeleteAlertRule(ctx context.Context, req *metric.DeleteAlertRuleRequest, callOptions ...callopt.Option) (r *metric.DeleteAlertRuleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAlertRule(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) ListAlertRules(ctx context.Context, req *metric.ListAlertRulesRequest, callOptions ...callopt.Option) (r *metric.ListAlertRulesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAlertRules(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) CreateDashboard(ctx context.Context, req *metric.CreateDashboardRequest, callOptions ...callopt.Option) (r *metric.CreateDashboardResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateDashboard(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) UpdateDashboard(ctx context.Context, req *metric.UpdateDashboardRequest, callOptions ...callopt.Option) (r *metric.UpdateDashboardResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateDashboard(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) DeleteDashboard(ctx context.Context, req *metric.DeleteDashboardRequest, callOptions ...callopt.Option) (r *metric.DeleteDashboardResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteDashboard(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) GetDashboard(ctx context.Context, req *metric.GetDashboardRequest, callOptions ...callopt.Option) (r *metric.GetDashboardResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDashboard(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) ListDashboards(ctx context.Context, req *metric.ListDashboardsRequest, callOptions ...callopt.Option) (r *metric.ListDashboardsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListDashboards(ctx, req)
+}
+
+func (p *kObservabilityMetricServiceClient) RenderDashboard(ctx context.Context, req *metric.RenderDashboardRequest, callOptions ...callopt.Option) (r *metric.RenderDashboardResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RenderDashboard(ctx, req)
+}
@@ -34,6 +34,13 @@ type Client interface {
 	UpsertTrajectoryConfig(ctx context.Context, req *trace.UpsertTrajectoryConfigRequest, callOptions ...callopt.Option) (r *trace.UpsertTrajectoryConfigResponse, err error)
 	GetTrajectoryConfig(ctx context.Context, req *trace.GetTrajectoryConfigRequest, callOptions ...callopt.Option) (r *trace.GetTrajectoryConfigResponse, err error)
 	ListTrajectory(ctx context.Context, req *trace.ListTrajectoryRequest, callOptions ...callopt.Option) (r *trace.ListTrajectoryResponse, err error)
+	UpsertRetentionPolicy(ctx context.Context, req *trace.UpsertRetentionPolicyRequest, callOptions ...callopt.Option) (r *trace.UpsertRetentionPolicyResponse, err error)
+	GetRetentionPolicy(ctx context.Context, req *trace.GetRetentionPolicyRequest, callOptions ...callopt.Option) (r *trace.GetRetentionPolicyResponse, err error)
+	DiffTraces(ctx context.Context, req *trace.DiffTracesRequest, callOptions ...callopt.Option) (r *trace.DiffTracesResponse, err error)
+	ListSessions(ctx context.Context, req *trace.ListSessionsRequest, callOptions ...callopt.Option) (r *trace.ListSessionsResponse, err error)
+	GetSession(ctx context.Context, req *trace.GetSessionRequest, callOptions ...callopt.Option) (r *trace.GetSessionResponse, err error)
+	ExportTracesToFile(ctx context.Context, req *trace.ExportTracesToFileRequest, callOptions ...callopt.Option) (r *trace.ExportTracesToFileResponse, err error)
+	GetTraceExportJob(ctx context.Context, req *trace.GetTraceExportJobRequest, callOptions ...callopt.Option) (r *trace.GetTraceExportJobResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -179,3 +186,38 @@ func (p *kObservabilityTraceServiceClient) ListTrajectory(ctx context.Context, r
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ListTrajectory(ctx, req)
 }
+
+func (p *kObservabilityTraceServiceClient) UpsertRetentionPolicy(ctx context.Context, req *trace.UpsertRetentionPolicyRequest, callOptions ...callopt.Option) (r *trace.UpsertRetentionPolicyResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpsertRetentionPolicy(ctx, req)
+}
+
+func (p *kObservabilityTraceServiceClient) GetRetentionPolicy(ctx context.Context, req *trace.GetRetentionPolicyRequest, callOptions ...callopt.Option) (r *trace.GetRetentionPolicyResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetRetentionPolicy(ctx, req)
+}
+
+func (p *kObservabilityTraceServiceClient) DiffTraces(ctx context.Context, req *trace.DiffTracesRequest, callOptions ...callopt.Option) (r *trace.DiffTracesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DiffTraces(ctx, req)
+}
+
+func (p *kObservabilityTraceServiceClient) ListSessions(ctx context.Context, req *trace.ListSessionsRequest, callOptions ...callopt.Option) (r *trace.ListSessionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSessions(ctx, req)
+}
+
+func (p *kObservabilityTraceServiceClient) GetSession(ctx context.Context, req *trace.GetSessionRequest, callOptions ...callopt.Option) (r *trace.GetSessionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSession(ctx, req)
+}
+
+func (p *kObservabilityTraceServiceClient) ExportTracesToFile(ctx context.Context, req *trace.ExportTracesToFileRequest, callOptions ...callopt.Option) (r *trace.ExportTracesToFileResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportTracesToFile(ctx, req)
+}
+
+func (p *kObservabilityTraceServiceClient) GetTraceExportJob(ctx context.Context, req *trace.GetTraceExportJobRequest, callOptions ...callopt.Option) (r *trace.GetTraceExportJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTraceExportJob(ctx, req)
+}
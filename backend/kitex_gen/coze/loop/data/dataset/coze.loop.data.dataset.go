@@ -6778,77 +6778,144 @@ func (p *ImportDatasetResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type GetDatasetIOJobRequest struct {
-	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
-	JobID       int64      `thrift:"job_id,2,required" frugal:"2,required,i64" json:"job_id" path:"job_id,required" `
-	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+type ExportDatasetRequest struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	// 导出的版本，为空时导出草稿
+	VersionID *int64 `thrift:"version_id,3,optional" frugal:"3,optional,i64" json:"version_id" form:"version_id" query:"version_id"`
+	// 导出的目标文件
+	File *dataset_job.DatasetIOFile `thrift:"file,4,optional" frugal:"4,optional,dataset_job.DatasetIOFile" form:"file" json:"file,omitempty" query:"file"`
+	// source 为数据集字段，target 为文件列名；为空时导出全部字段
+	FieldMappings []*dataset_job.FieldMapping `thrift:"field_mappings,5,optional" frugal:"5,optional,list<dataset_job.FieldMapping>" form:"field_mappings" json:"field_mappings,omitempty" query:"field_mappings"`
+	/*base*/
+	Base *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewGetDatasetIOJobRequest() *GetDatasetIOJobRequest {
-	return &GetDatasetIOJobRequest{}
+func NewExportDatasetRequest() *ExportDatasetRequest {
+	return &ExportDatasetRequest{}
 }
 
-func (p *GetDatasetIOJobRequest) InitDefault() {
+func (p *ExportDatasetRequest) InitDefault() {
 }
 
-var GetDatasetIOJobRequest_WorkspaceID_DEFAULT int64
+var ExportDatasetRequest_WorkspaceID_DEFAULT int64
 
-func (p *GetDatasetIOJobRequest) GetWorkspaceID() (v int64) {
+func (p *ExportDatasetRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return GetDatasetIOJobRequest_WorkspaceID_DEFAULT
+		return ExportDatasetRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *GetDatasetIOJobRequest) GetJobID() (v int64) {
+func (p *ExportDatasetRequest) GetDatasetID() (v int64) {
 	if p != nil {
-		return p.JobID
+		return p.DatasetID
 	}
 	return
 }
 
-var GetDatasetIOJobRequest_Base_DEFAULT *base.Base
+var ExportDatasetRequest_VersionID_DEFAULT int64
 
-func (p *GetDatasetIOJobRequest) GetBase() (v *base.Base) {
+func (p *ExportDatasetRequest) GetVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetVersionID() {
+		return ExportDatasetRequest_VersionID_DEFAULT
+	}
+	return *p.VersionID
+}
+
+var ExportDatasetRequest_File_DEFAULT *dataset_job.DatasetIOFile
+
+func (p *ExportDatasetRequest) GetFile() (v *dataset_job.DatasetIOFile) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFile() {
+		return ExportDatasetRequest_File_DEFAULT
+	}
+	return p.File
+}
+
+var ExportDatasetRequest_FieldMappings_DEFAULT []*dataset_job.FieldMapping
+
+func (p *ExportDatasetRequest) GetFieldMappings() (v []*dataset_job.FieldMapping) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldMappings() {
+		return ExportDatasetRequest_FieldMappings_DEFAULT
+	}
+	return p.FieldMappings
+}
+
+var ExportDatasetRequest_Base_DEFAULT *base.Base
+
+func (p *ExportDatasetRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return GetDatasetIOJobRequest_Base_DEFAULT
+		return ExportDatasetRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *GetDatasetIOJobRequest) SetWorkspaceID(val *int64) {
+func (p *ExportDatasetRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *GetDatasetIOJobRequest) SetJobID(val int64) {
-	p.JobID = val
+func (p *ExportDatasetRequest) SetDatasetID(val int64) {
+	p.DatasetID = val
 }
-func (p *GetDatasetIOJobRequest) SetBase(val *base.Base) {
+func (p *ExportDatasetRequest) SetVersionID(val *int64) {
+	p.VersionID = val
+}
+func (p *ExportDatasetRequest) SetFile(val *dataset_job.DatasetIOFile) {
+	p.File = val
+}
+func (p *ExportDatasetRequest) SetFieldMappings(val []*dataset_job.FieldMapping) {
+	p.FieldMappings = val
+}
+func (p *ExportDatasetRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_GetDatasetIOJobRequest = map[int16]string{
+var fieldIDToName_ExportDatasetRequest = map[int16]string{
 	1:   "workspace_id",
-	2:   "job_id",
+	2:   "dataset_id",
+	3:   "version_id",
+	4:   "file",
+	5:   "field_mappings",
 	255: "Base",
 }
 
-func (p *GetDatasetIOJobRequest) IsSetWorkspaceID() bool {
+func (p *ExportDatasetRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *GetDatasetIOJobRequest) IsSetBase() bool {
+func (p *ExportDatasetRequest) IsSetVersionID() bool {
+	return p.VersionID != nil
+}
+
+func (p *ExportDatasetRequest) IsSetFile() bool {
+	return p.File != nil
+}
+
+func (p *ExportDatasetRequest) IsSetFieldMappings() bool {
+	return p.FieldMappings != nil
+}
+
+func (p *ExportDatasetRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetDatasetIOJobRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExportDatasetRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetJobID bool = false
+	var issetDatasetID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -6877,7 +6944,31 @@ func (p *GetDatasetIOJobRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetJobID = true
+				issetDatasetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -6902,7 +6993,7 @@ func (p *GetDatasetIOJobRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetJobID {
+	if !issetDatasetID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
@@ -6912,7 +7003,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetIOJobRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportDatasetRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6921,10 +7012,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetDatasetIOJobRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExportDatasetRequest[fieldId]))
 }
 
-func (p *GetDatasetIOJobRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExportDatasetRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -6935,7 +7026,7 @@ func (p *GetDatasetIOJobRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *GetDatasetIOJobRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExportDatasetRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -6943,10 +7034,52 @@ func (p *GetDatasetIOJobRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.JobID = _field
+	p.DatasetID = _field
 	return nil
 }
-func (p *GetDatasetIOJobRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *ExportDatasetRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.VersionID = _field
+	return nil
+}
+func (p *ExportDatasetRequest) ReadField4(iprot thrift.TProtocol) error {
+	_field := dataset_job.NewDatasetIOFile()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.File = _field
+	return nil
+}
+func (p *ExportDatasetRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset_job.FieldMapping, 0, size)
+	values := make([]dataset_job.FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldMappings = _field
+	return nil
+}
+func (p *ExportDatasetRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -6955,9 +7088,9 @@ func (p *GetDatasetIOJobRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetDatasetIOJobRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExportDatasetRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDatasetIOJobRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ExportDatasetRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6969,6 +7102,18 @@ func (p *GetDatasetIOJobRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -6991,7 +7136,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetDatasetIOJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExportDatasetRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -7009,11 +7154,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetDatasetIOJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("job_id", thrift.I64, 2); err != nil {
+func (p *ExportDatasetRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.JobID); err != nil {
+	if err := oprot.WriteI64(p.DatasetID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7025,7 +7170,69 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetDatasetIOJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ExportDatasetRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersionID() {
+		if err = oprot.WriteFieldBegin("version_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.VersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExportDatasetRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFile() {
+		if err = oprot.WriteFieldBegin("file", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.File.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExportDatasetRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldMappings() {
+		if err = oprot.WriteFieldBegin("field_mappings", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldMappings)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldMappings {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExportDatasetRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -7044,15 +7251,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *GetDatasetIOJobRequest) String() string {
+func (p *ExportDatasetRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDatasetIOJobRequest(%+v)", *p)
+	return fmt.Sprintf("ExportDatasetRequest(%+v)", *p)
 
 }
 
-func (p *GetDatasetIOJobRequest) DeepEqual(ano *GetDatasetIOJobRequest) bool {
+func (p *ExportDatasetRequest) DeepEqual(ano *ExportDatasetRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7061,7 +7268,16 @@ func (p *GetDatasetIOJobRequest) DeepEqual(ano *GetDatasetIOJobRequest) bool {
 	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.JobID) {
+	if !p.Field2DeepEqual(ano.DatasetID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.VersionID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.File) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.FieldMappings) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -7070,7 +7286,7 @@ func (p *GetDatasetIOJobRequest) DeepEqual(ano *GetDatasetIOJobRequest) bool {
 	return true
 }
 
-func (p *GetDatasetIOJobRequest) Field1DeepEqual(src *int64) bool {
+func (p *ExportDatasetRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -7082,77 +7298,109 @@ func (p *GetDatasetIOJobRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *GetDatasetIOJobRequest) Field2DeepEqual(src int64) bool {
+func (p *ExportDatasetRequest) Field2DeepEqual(src int64) bool {
 
-	if p.JobID != src {
+	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *GetDatasetIOJobRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *ExportDatasetRequest) Field3DeepEqual(src *int64) bool {
 
-	if !p.Base.DeepEqual(src) {
+	if p.VersionID == src {
+		return true
+	} else if p.VersionID == nil || src == nil {
+		return false
+	}
+	if *p.VersionID != *src {
 		return false
 	}
 	return true
 }
+func (p *ExportDatasetRequest) Field4DeepEqual(src *dataset_job.DatasetIOFile) bool {
 
-type GetDatasetIOJobResponse struct {
-	Job      *dataset_job.DatasetIOJob `thrift:"job,1,optional" frugal:"1,optional,dataset_job.DatasetIOJob" form:"job" json:"job,omitempty" query:"job"`
-	BaseResp *base.BaseResp            `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
-}
-
-func NewGetDatasetIOJobResponse() *GetDatasetIOJobResponse {
-	return &GetDatasetIOJobResponse{}
+	if !p.File.DeepEqual(src) {
+		return false
+	}
+	return true
 }
+func (p *ExportDatasetRequest) Field5DeepEqual(src []*dataset_job.FieldMapping) bool {
 
-func (p *GetDatasetIOJobResponse) InitDefault() {
-}
+	if len(p.FieldMappings) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldMappings {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExportDatasetRequest) Field255DeepEqual(src *base.Base) bool {
 
-var GetDatasetIOJobResponse_Job_DEFAULT *dataset_job.DatasetIOJob
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
-func (p *GetDatasetIOJobResponse) GetJob() (v *dataset_job.DatasetIOJob) {
+type ExportDatasetResponse struct {
+	JobID    *int64         `thrift:"job_id,1,optional" frugal:"1,optional,i64" json:"job_id" form:"job_id" query:"job_id"`
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewExportDatasetResponse() *ExportDatasetResponse {
+	return &ExportDatasetResponse{}
+}
+
+func (p *ExportDatasetResponse) InitDefault() {
+}
+
+var ExportDatasetResponse_JobID_DEFAULT int64
+
+func (p *ExportDatasetResponse) GetJobID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetJob() {
-		return GetDatasetIOJobResponse_Job_DEFAULT
+	if !p.IsSetJobID() {
+		return ExportDatasetResponse_JobID_DEFAULT
 	}
-	return p.Job
+	return *p.JobID
 }
 
-var GetDatasetIOJobResponse_BaseResp_DEFAULT *base.BaseResp
+var ExportDatasetResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *GetDatasetIOJobResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *ExportDatasetResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return GetDatasetIOJobResponse_BaseResp_DEFAULT
+		return ExportDatasetResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *GetDatasetIOJobResponse) SetJob(val *dataset_job.DatasetIOJob) {
-	p.Job = val
+func (p *ExportDatasetResponse) SetJobID(val *int64) {
+	p.JobID = val
 }
-func (p *GetDatasetIOJobResponse) SetBaseResp(val *base.BaseResp) {
+func (p *ExportDatasetResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_GetDatasetIOJobResponse = map[int16]string{
-	1:   "job",
+var fieldIDToName_ExportDatasetResponse = map[int16]string{
+	1:   "job_id",
 	255: "BaseResp",
 }
 
-func (p *GetDatasetIOJobResponse) IsSetJob() bool {
-	return p.Job != nil
+func (p *ExportDatasetResponse) IsSetJobID() bool {
+	return p.JobID != nil
 }
 
-func (p *GetDatasetIOJobResponse) IsSetBaseResp() bool {
+func (p *ExportDatasetResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetDatasetIOJobResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExportDatasetResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7171,7 +7419,7 @@ func (p *GetDatasetIOJobResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7205,7 +7453,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetIOJobResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportDatasetResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7215,15 +7463,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetDatasetIOJobResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := dataset_job.NewDatasetIOJob()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExportDatasetResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Job = _field
+	p.JobID = _field
 	return nil
 }
-func (p *GetDatasetIOJobResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *ExportDatasetResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -7232,9 +7483,9 @@ func (p *GetDatasetIOJobResponse) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetDatasetIOJobResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExportDatasetResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDatasetIOJobResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ExportDatasetResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7264,12 +7515,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetDatasetIOJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetJob() {
-		if err = oprot.WriteFieldBegin("job", thrift.STRUCT, 1); err != nil {
+func (p *ExportDatasetResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJobID() {
+		if err = oprot.WriteFieldBegin("job_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Job.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.JobID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7282,7 +7533,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetDatasetIOJobResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ExportDatasetResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7299,21 +7550,21 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *GetDatasetIOJobResponse) String() string {
+func (p *ExportDatasetResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDatasetIOJobResponse(%+v)", *p)
+	return fmt.Sprintf("ExportDatasetResponse(%+v)", *p)
 
 }
 
-func (p *GetDatasetIOJobResponse) DeepEqual(ano *GetDatasetIOJobResponse) bool {
+func (p *ExportDatasetResponse) DeepEqual(ano *ExportDatasetResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Job) {
+	if !p.Field1DeepEqual(ano.JobID) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
@@ -7322,14 +7573,19 @@ func (p *GetDatasetIOJobResponse) DeepEqual(ano *GetDatasetIOJobResponse) bool {
 	return true
 }
 
-func (p *GetDatasetIOJobResponse) Field1DeepEqual(src *dataset_job.DatasetIOJob) bool {
+func (p *ExportDatasetResponse) Field1DeepEqual(src *int64) bool {
 
-	if !p.Job.DeepEqual(src) {
+	if p.JobID == src {
+		return true
+	} else if p.JobID == nil || src == nil {
+		return false
+	}
+	if *p.JobID != *src {
 		return false
 	}
 	return true
 }
-func (p *GetDatasetIOJobResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *ExportDatasetResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -7337,119 +7593,77 @@ func (p *GetDatasetIOJobResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type ListDatasetIOJobsRequest struct {
-	WorkspaceID *int64                  `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	DatasetID   int64                   `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	Types       []dataset_job.JobType   `thrift:"types,3,optional" frugal:"3,optional,list<JobType>" form:"types" json:"types,omitempty" query:"types"`
-	Statuses    []dataset_job.JobStatus `thrift:"statuses,4,optional" frugal:"4,optional,list<JobStatus>" form:"statuses" json:"statuses,omitempty" query:"statuses"`
-	Base        *base.Base              `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+type GetDatasetIOJobRequest struct {
+	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
+	JobID       int64      `thrift:"job_id,2,required" frugal:"2,required,i64" json:"job_id" path:"job_id,required" `
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewListDatasetIOJobsRequest() *ListDatasetIOJobsRequest {
-	return &ListDatasetIOJobsRequest{}
+func NewGetDatasetIOJobRequest() *GetDatasetIOJobRequest {
+	return &GetDatasetIOJobRequest{}
 }
 
-func (p *ListDatasetIOJobsRequest) InitDefault() {
+func (p *GetDatasetIOJobRequest) InitDefault() {
 }
 
-var ListDatasetIOJobsRequest_WorkspaceID_DEFAULT int64
+var GetDatasetIOJobRequest_WorkspaceID_DEFAULT int64
 
-func (p *ListDatasetIOJobsRequest) GetWorkspaceID() (v int64) {
+func (p *GetDatasetIOJobRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return ListDatasetIOJobsRequest_WorkspaceID_DEFAULT
+		return GetDatasetIOJobRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *ListDatasetIOJobsRequest) GetDatasetID() (v int64) {
+func (p *GetDatasetIOJobRequest) GetJobID() (v int64) {
 	if p != nil {
-		return p.DatasetID
+		return p.JobID
 	}
 	return
 }
 
-var ListDatasetIOJobsRequest_Types_DEFAULT []dataset_job.JobType
-
-func (p *ListDatasetIOJobsRequest) GetTypes() (v []dataset_job.JobType) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTypes() {
-		return ListDatasetIOJobsRequest_Types_DEFAULT
-	}
-	return p.Types
-}
-
-var ListDatasetIOJobsRequest_Statuses_DEFAULT []dataset_job.JobStatus
-
-func (p *ListDatasetIOJobsRequest) GetStatuses() (v []dataset_job.JobStatus) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetStatuses() {
-		return ListDatasetIOJobsRequest_Statuses_DEFAULT
-	}
-	return p.Statuses
-}
-
-var ListDatasetIOJobsRequest_Base_DEFAULT *base.Base
+var GetDatasetIOJobRequest_Base_DEFAULT *base.Base
 
-func (p *ListDatasetIOJobsRequest) GetBase() (v *base.Base) {
+func (p *GetDatasetIOJobRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return ListDatasetIOJobsRequest_Base_DEFAULT
+		return GetDatasetIOJobRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *ListDatasetIOJobsRequest) SetWorkspaceID(val *int64) {
+func (p *GetDatasetIOJobRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *ListDatasetIOJobsRequest) SetDatasetID(val int64) {
-	p.DatasetID = val
-}
-func (p *ListDatasetIOJobsRequest) SetTypes(val []dataset_job.JobType) {
-	p.Types = val
-}
-func (p *ListDatasetIOJobsRequest) SetStatuses(val []dataset_job.JobStatus) {
-	p.Statuses = val
+func (p *GetDatasetIOJobRequest) SetJobID(val int64) {
+	p.JobID = val
 }
-func (p *ListDatasetIOJobsRequest) SetBase(val *base.Base) {
+func (p *GetDatasetIOJobRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_ListDatasetIOJobsRequest = map[int16]string{
+var fieldIDToName_GetDatasetIOJobRequest = map[int16]string{
 	1:   "workspace_id",
-	2:   "dataset_id",
-	3:   "types",
-	4:   "statuses",
+	2:   "job_id",
 	255: "Base",
 }
 
-func (p *ListDatasetIOJobsRequest) IsSetWorkspaceID() bool {
+func (p *GetDatasetIOJobRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *ListDatasetIOJobsRequest) IsSetTypes() bool {
-	return p.Types != nil
-}
-
-func (p *ListDatasetIOJobsRequest) IsSetStatuses() bool {
-	return p.Statuses != nil
-}
-
-func (p *ListDatasetIOJobsRequest) IsSetBase() bool {
+func (p *GetDatasetIOJobRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *ListDatasetIOJobsRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetDatasetIOJobRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetDatasetID bool = false
+	var issetJobID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -7478,23 +7692,7 @@ func (p *ListDatasetIOJobsRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetDatasetID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
+				issetJobID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -7519,7 +7717,7 @@ func (p *ListDatasetIOJobsRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetDatasetID {
+	if !issetJobID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
@@ -7529,7 +7727,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetIOJobsRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetIOJobRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7538,10 +7736,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListDatasetIOJobsRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetDatasetIOJobRequest[fieldId]))
 }
 
-func (p *ListDatasetIOJobsRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetDatasetIOJobRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -7552,7 +7750,7 @@ func (p *ListDatasetIOJobsRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *ListDatasetIOJobsRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetDatasetIOJobRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -7560,56 +7758,10 @@ func (p *ListDatasetIOJobsRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.DatasetID = _field
-	return nil
-}
-func (p *ListDatasetIOJobsRequest) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]dataset_job.JobType, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem dataset_job.JobType
-		if v, err := iprot.ReadI32(); err != nil {
-			return err
-		} else {
-			_elem = dataset_job.JobType(v)
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Types = _field
-	return nil
-}
-func (p *ListDatasetIOJobsRequest) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]dataset_job.JobStatus, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem dataset_job.JobStatus
-		if v, err := iprot.ReadI32(); err != nil {
-			return err
-		} else {
-			_elem = dataset_job.JobStatus(v)
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Statuses = _field
+	p.JobID = _field
 	return nil
 }
-func (p *ListDatasetIOJobsRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *GetDatasetIOJobRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -7618,9 +7770,9 @@ func (p *ListDatasetIOJobsRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ListDatasetIOJobsRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetIOJobRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasetIOJobsRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetDatasetIOJobRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7632,14 +7784,6 @@ func (p *ListDatasetIOJobsRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -7662,7 +7806,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListDatasetIOJobsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetIOJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -7680,11 +7824,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ListDatasetIOJobsRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
+func (p *GetDatasetIOJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.DatasetID); err != nil {
+	if err := oprot.WriteI64(p.JobID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7696,59 +7840,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ListDatasetIOJobsRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTypes() {
-		if err = oprot.WriteFieldBegin("types", thrift.LIST, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.I32, len(p.Types)); err != nil {
-			return err
-		}
-		for _, v := range p.Types {
-			if err := oprot.WriteI32(int32(v)); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ListDatasetIOJobsRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatuses() {
-		if err = oprot.WriteFieldBegin("statuses", thrift.LIST, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.I32, len(p.Statuses)); err != nil {
-			return err
-		}
-		for _, v := range p.Statuses {
-			if err := oprot.WriteI32(int32(v)); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ListDatasetIOJobsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetIOJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -7767,15 +7859,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ListDatasetIOJobsRequest) String() string {
+func (p *GetDatasetIOJobRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDatasetIOJobsRequest(%+v)", *p)
+	return fmt.Sprintf("GetDatasetIOJobRequest(%+v)", *p)
 
 }
 
-func (p *ListDatasetIOJobsRequest) DeepEqual(ano *ListDatasetIOJobsRequest) bool {
+func (p *GetDatasetIOJobRequest) DeepEqual(ano *GetDatasetIOJobRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7784,13 +7876,7 @@ func (p *ListDatasetIOJobsRequest) DeepEqual(ano *ListDatasetIOJobsRequest) bool
 	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.DatasetID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Types) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.Statuses) {
+	if !p.Field2DeepEqual(ano.JobID) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -7799,7 +7885,7 @@ func (p *ListDatasetIOJobsRequest) DeepEqual(ano *ListDatasetIOJobsRequest) bool
 	return true
 }
 
-func (p *ListDatasetIOJobsRequest) Field1DeepEqual(src *int64) bool {
+func (p *GetDatasetIOJobRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -7811,40 +7897,14 @@ func (p *ListDatasetIOJobsRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *ListDatasetIOJobsRequest) Field2DeepEqual(src int64) bool {
-
-	if p.DatasetID != src {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetIOJobsRequest) Field3DeepEqual(src []dataset_job.JobType) bool {
-
-	if len(p.Types) != len(src) {
-		return false
-	}
-	for i, v := range p.Types {
-		_src := src[i]
-		if v != _src {
-			return false
-		}
-	}
-	return true
-}
-func (p *ListDatasetIOJobsRequest) Field4DeepEqual(src []dataset_job.JobStatus) bool {
+func (p *GetDatasetIOJobRequest) Field2DeepEqual(src int64) bool {
 
-	if len(p.Statuses) != len(src) {
+	if p.JobID != src {
 		return false
 	}
-	for i, v := range p.Statuses {
-		_src := src[i]
-		if v != _src {
-			return false
-		}
-	}
 	return true
 }
-func (p *ListDatasetIOJobsRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *GetDatasetIOJobRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -7852,62 +7912,62 @@ func (p *ListDatasetIOJobsRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type ListDatasetIOJobsResponse struct {
-	Jobs     []*dataset_job.DatasetIOJob `thrift:"jobs,1,optional" frugal:"1,optional,list<dataset_job.DatasetIOJob>" form:"jobs" json:"jobs,omitempty" query:"jobs"`
-	BaseResp *base.BaseResp              `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+type GetDatasetIOJobResponse struct {
+	Job      *dataset_job.DatasetIOJob `thrift:"job,1,optional" frugal:"1,optional,dataset_job.DatasetIOJob" form:"job" json:"job,omitempty" query:"job"`
+	BaseResp *base.BaseResp            `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewListDatasetIOJobsResponse() *ListDatasetIOJobsResponse {
-	return &ListDatasetIOJobsResponse{}
+func NewGetDatasetIOJobResponse() *GetDatasetIOJobResponse {
+	return &GetDatasetIOJobResponse{}
 }
 
-func (p *ListDatasetIOJobsResponse) InitDefault() {
+func (p *GetDatasetIOJobResponse) InitDefault() {
 }
 
-var ListDatasetIOJobsResponse_Jobs_DEFAULT []*dataset_job.DatasetIOJob
+var GetDatasetIOJobResponse_Job_DEFAULT *dataset_job.DatasetIOJob
 
-func (p *ListDatasetIOJobsResponse) GetJobs() (v []*dataset_job.DatasetIOJob) {
+func (p *GetDatasetIOJobResponse) GetJob() (v *dataset_job.DatasetIOJob) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetJobs() {
-		return ListDatasetIOJobsResponse_Jobs_DEFAULT
+	if !p.IsSetJob() {
+		return GetDatasetIOJobResponse_Job_DEFAULT
 	}
-	return p.Jobs
+	return p.Job
 }
 
-var ListDatasetIOJobsResponse_BaseResp_DEFAULT *base.BaseResp
+var GetDatasetIOJobResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *ListDatasetIOJobsResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *GetDatasetIOJobResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return ListDatasetIOJobsResponse_BaseResp_DEFAULT
+		return GetDatasetIOJobResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *ListDatasetIOJobsResponse) SetJobs(val []*dataset_job.DatasetIOJob) {
-	p.Jobs = val
+func (p *GetDatasetIOJobResponse) SetJob(val *dataset_job.DatasetIOJob) {
+	p.Job = val
 }
-func (p *ListDatasetIOJobsResponse) SetBaseResp(val *base.BaseResp) {
+func (p *GetDatasetIOJobResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_ListDatasetIOJobsResponse = map[int16]string{
-	1:   "jobs",
+var fieldIDToName_GetDatasetIOJobResponse = map[int16]string{
+	1:   "job",
 	255: "BaseResp",
 }
 
-func (p *ListDatasetIOJobsResponse) IsSetJobs() bool {
-	return p.Jobs != nil
+func (p *GetDatasetIOJobResponse) IsSetJob() bool {
+	return p.Job != nil
 }
 
-func (p *ListDatasetIOJobsResponse) IsSetBaseResp() bool {
+func (p *GetDatasetIOJobResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *ListDatasetIOJobsResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetDatasetIOJobResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7926,7 +7986,7 @@ func (p *ListDatasetIOJobsResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7960,7 +8020,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetIOJobsResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetIOJobResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7970,30 +8030,15 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListDatasetIOJobsResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*dataset_job.DatasetIOJob, 0, size)
-	values := make([]dataset_job.DatasetIOJob, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *GetDatasetIOJobResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := dataset_job.NewDatasetIOJob()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Jobs = _field
+	p.Job = _field
 	return nil
 }
-func (p *ListDatasetIOJobsResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *GetDatasetIOJobResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -8002,9 +8047,9 @@ func (p *ListDatasetIOJobsResponse) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ListDatasetIOJobsResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetIOJobResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasetIOJobsResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetDatasetIOJobResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8034,20 +8079,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListDatasetIOJobsResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetJobs() {
-		if err = oprot.WriteFieldBegin("jobs", thrift.LIST, 1); err != nil {
+func (p *GetDatasetIOJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJob() {
+		if err = oprot.WriteFieldBegin("job", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Jobs)); err != nil {
-			return err
-		}
-		for _, v := range p.Jobs {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := p.Job.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8060,7 +8097,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ListDatasetIOJobsResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetIOJobResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8077,21 +8114,21 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ListDatasetIOJobsResponse) String() string {
+func (p *GetDatasetIOJobResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDatasetIOJobsResponse(%+v)", *p)
+	return fmt.Sprintf("GetDatasetIOJobResponse(%+v)", *p)
 
 }
 
-func (p *ListDatasetIOJobsResponse) DeepEqual(ano *ListDatasetIOJobsResponse) bool {
+func (p *GetDatasetIOJobResponse) DeepEqual(ano *GetDatasetIOJobResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Jobs) {
+	if !p.Field1DeepEqual(ano.Job) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
@@ -8100,20 +8137,14 @@ func (p *ListDatasetIOJobsResponse) DeepEqual(ano *ListDatasetIOJobsResponse) bo
 	return true
 }
 
-func (p *ListDatasetIOJobsResponse) Field1DeepEqual(src []*dataset_job.DatasetIOJob) bool {
+func (p *GetDatasetIOJobResponse) Field1DeepEqual(src *dataset_job.DatasetIOJob) bool {
 
-	if len(p.Jobs) != len(src) {
+	if !p.Job.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.Jobs {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *ListDatasetIOJobsResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *GetDatasetIOJobResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -8121,183 +8152,116 @@ func (p *ListDatasetIOJobsResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type ListDatasetVersionsRequest struct {
-	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	// 根据版本号模糊匹配
-	VersionLike *string `thrift:"version_like,3,optional" frugal:"3,optional,string" form:"version_like" json:"version_like,omitempty" query:"version_like"`
-	/* pagination */
-	PageNumber *int32 `thrift:"page_number,100,optional" frugal:"100,optional,i32" form:"page_number" json:"page_number,omitempty" query:"page_number"`
-	// 分页大小(0, 200]，默认为 20
-	PageSize *int32 `thrift:"page_size,101,optional" frugal:"101,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
-	// 与 page 同时提供时，优先使用 cursor
-	PageToken *string            `thrift:"page_token,102,optional" frugal:"102,optional,string" form:"page_token" json:"page_token,omitempty" query:"page_token"`
-	OrderBys  []*dataset.OrderBy `thrift:"order_bys,103,optional" frugal:"103,optional,list<dataset.OrderBy>" form:"order_bys" json:"order_bys,omitempty" query:"order_bys"`
-	Base      *base.Base         `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
-}
-
-func NewListDatasetVersionsRequest() *ListDatasetVersionsRequest {
-	return &ListDatasetVersionsRequest{}
-}
+type ListDatasetIOJobsRequest struct {
+	WorkspaceID *int64                  `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	DatasetID   int64                   `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	Types       []dataset_job.JobType   `thrift:"types,3,optional" frugal:"3,optional,list<JobType>" form:"types" json:"types,omitempty" query:"types"`
+	Statuses    []dataset_job.JobStatus `thrift:"statuses,4,optional" frugal:"4,optional,list<JobStatus>" form:"statuses" json:"statuses,omitempty" query:"statuses"`
+	Base        *base.Base              `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
 
-func (p *ListDatasetVersionsRequest) InitDefault() {
+func NewListDatasetIOJobsRequest() *ListDatasetIOJobsRequest {
+	return &ListDatasetIOJobsRequest{}
 }
 
-var ListDatasetVersionsRequest_WorkspaceID_DEFAULT int64
+func (p *ListDatasetIOJobsRequest) InitDefault() {
+}
 
-func (p *ListDatasetVersionsRequest) GetWorkspaceID() (v int64) {
+var ListDatasetIOJobsRequest_WorkspaceID_DEFAULT int64
+
+func (p *ListDatasetIOJobsRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return ListDatasetVersionsRequest_WorkspaceID_DEFAULT
+		return ListDatasetIOJobsRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *ListDatasetVersionsRequest) GetDatasetID() (v int64) {
+func (p *ListDatasetIOJobsRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-var ListDatasetVersionsRequest_VersionLike_DEFAULT string
-
-func (p *ListDatasetVersionsRequest) GetVersionLike() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetVersionLike() {
-		return ListDatasetVersionsRequest_VersionLike_DEFAULT
-	}
-	return *p.VersionLike
-}
-
-var ListDatasetVersionsRequest_PageNumber_DEFAULT int32
-
-func (p *ListDatasetVersionsRequest) GetPageNumber() (v int32) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetPageNumber() {
-		return ListDatasetVersionsRequest_PageNumber_DEFAULT
-	}
-	return *p.PageNumber
-}
-
-var ListDatasetVersionsRequest_PageSize_DEFAULT int32
-
-func (p *ListDatasetVersionsRequest) GetPageSize() (v int32) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetPageSize() {
-		return ListDatasetVersionsRequest_PageSize_DEFAULT
-	}
-	return *p.PageSize
-}
-
-var ListDatasetVersionsRequest_PageToken_DEFAULT string
+var ListDatasetIOJobsRequest_Types_DEFAULT []dataset_job.JobType
 
-func (p *ListDatasetVersionsRequest) GetPageToken() (v string) {
+func (p *ListDatasetIOJobsRequest) GetTypes() (v []dataset_job.JobType) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPageToken() {
-		return ListDatasetVersionsRequest_PageToken_DEFAULT
+	if !p.IsSetTypes() {
+		return ListDatasetIOJobsRequest_Types_DEFAULT
 	}
-	return *p.PageToken
+	return p.Types
 }
 
-var ListDatasetVersionsRequest_OrderBys_DEFAULT []*dataset.OrderBy
+var ListDatasetIOJobsRequest_Statuses_DEFAULT []dataset_job.JobStatus
 
-func (p *ListDatasetVersionsRequest) GetOrderBys() (v []*dataset.OrderBy) {
+func (p *ListDatasetIOJobsRequest) GetStatuses() (v []dataset_job.JobStatus) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetOrderBys() {
-		return ListDatasetVersionsRequest_OrderBys_DEFAULT
+	if !p.IsSetStatuses() {
+		return ListDatasetIOJobsRequest_Statuses_DEFAULT
 	}
-	return p.OrderBys
+	return p.Statuses
 }
 
-var ListDatasetVersionsRequest_Base_DEFAULT *base.Base
+var ListDatasetIOJobsRequest_Base_DEFAULT *base.Base
 
-func (p *ListDatasetVersionsRequest) GetBase() (v *base.Base) {
+func (p *ListDatasetIOJobsRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return ListDatasetVersionsRequest_Base_DEFAULT
+		return ListDatasetIOJobsRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *ListDatasetVersionsRequest) SetWorkspaceID(val *int64) {
+func (p *ListDatasetIOJobsRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *ListDatasetVersionsRequest) SetDatasetID(val int64) {
+func (p *ListDatasetIOJobsRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *ListDatasetVersionsRequest) SetVersionLike(val *string) {
-	p.VersionLike = val
-}
-func (p *ListDatasetVersionsRequest) SetPageNumber(val *int32) {
-	p.PageNumber = val
-}
-func (p *ListDatasetVersionsRequest) SetPageSize(val *int32) {
-	p.PageSize = val
-}
-func (p *ListDatasetVersionsRequest) SetPageToken(val *string) {
-	p.PageToken = val
+func (p *ListDatasetIOJobsRequest) SetTypes(val []dataset_job.JobType) {
+	p.Types = val
 }
-func (p *ListDatasetVersionsRequest) SetOrderBys(val []*dataset.OrderBy) {
-	p.OrderBys = val
+func (p *ListDatasetIOJobsRequest) SetStatuses(val []dataset_job.JobStatus) {
+	p.Statuses = val
 }
-func (p *ListDatasetVersionsRequest) SetBase(val *base.Base) {
+func (p *ListDatasetIOJobsRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_ListDatasetVersionsRequest = map[int16]string{
+var fieldIDToName_ListDatasetIOJobsRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	3:   "version_like",
-	100: "page_number",
-	101: "page_size",
-	102: "page_token",
-	103: "order_bys",
+	3:   "types",
+	4:   "statuses",
 	255: "Base",
 }
 
-func (p *ListDatasetVersionsRequest) IsSetWorkspaceID() bool {
+func (p *ListDatasetIOJobsRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *ListDatasetVersionsRequest) IsSetVersionLike() bool {
-	return p.VersionLike != nil
-}
-
-func (p *ListDatasetVersionsRequest) IsSetPageNumber() bool {
-	return p.PageNumber != nil
-}
-
-func (p *ListDatasetVersionsRequest) IsSetPageSize() bool {
-	return p.PageSize != nil
-}
-
-func (p *ListDatasetVersionsRequest) IsSetPageToken() bool {
-	return p.PageToken != nil
+func (p *ListDatasetIOJobsRequest) IsSetTypes() bool {
+	return p.Types != nil
 }
 
-func (p *ListDatasetVersionsRequest) IsSetOrderBys() bool {
-	return p.OrderBys != nil
+func (p *ListDatasetIOJobsRequest) IsSetStatuses() bool {
+	return p.Statuses != nil
 }
 
-func (p *ListDatasetVersionsRequest) IsSetBase() bool {
+func (p *ListDatasetIOJobsRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *ListDatasetVersionsRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListDatasetIOJobsRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
@@ -8334,40 +8298,16 @@ func (p *ListDatasetVersionsRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 100:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField100(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 101:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField101(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 102:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField102(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 103:
+		case 4:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField103(iprot); err != nil {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -8404,7 +8344,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetVersionsRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetIOJobsRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8413,10 +8353,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListDatasetVersionsRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListDatasetIOJobsRequest[fieldId]))
 }
 
-func (p *ListDatasetVersionsRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListDatasetIOJobsRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -8427,7 +8367,7 @@ func (p *ListDatasetVersionsRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *ListDatasetVersionsRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *ListDatasetIOJobsRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -8438,63 +8378,42 @@ func (p *ListDatasetVersionsRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.DatasetID = _field
 	return nil
 }
-func (p *ListDatasetVersionsRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *ListDatasetIOJobsRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.VersionLike = _field
-	return nil
-}
-func (p *ListDatasetVersionsRequest) ReadField100(iprot thrift.TProtocol) error {
+	_field := make([]dataset_job.JobType, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PageNumber = _field
-	return nil
-}
-func (p *ListDatasetVersionsRequest) ReadField101(iprot thrift.TProtocol) error {
+		var _elem dataset_job.JobType
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = dataset_job.JobType(v)
+		}
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
+		_field = append(_field, _elem)
 	}
-	p.PageSize = _field
-	return nil
-}
-func (p *ListDatasetVersionsRequest) ReadField102(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.PageToken = _field
+	p.Types = _field
 	return nil
 }
-func (p *ListDatasetVersionsRequest) ReadField103(iprot thrift.TProtocol) error {
+func (p *ListDatasetIOJobsRequest) ReadField4(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*dataset.OrderBy, 0, size)
-	values := make([]dataset.OrderBy, size)
+	_field := make([]dataset_job.JobStatus, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
 
-		if err := _elem.Read(iprot); err != nil {
+		var _elem dataset_job.JobStatus
+		if v, err := iprot.ReadI32(); err != nil {
 			return err
+		} else {
+			_elem = dataset_job.JobStatus(v)
 		}
 
 		_field = append(_field, _elem)
@@ -8502,10 +8421,10 @@ func (p *ListDatasetVersionsRequest) ReadField103(iprot thrift.TProtocol) error
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.OrderBys = _field
+	p.Statuses = _field
 	return nil
 }
-func (p *ListDatasetVersionsRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListDatasetIOJobsRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -8514,9 +8433,9 @@ func (p *ListDatasetVersionsRequest) ReadField255(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ListDatasetVersionsRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetIOJobsRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasetVersionsRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasetIOJobsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8532,24 +8451,12 @@ func (p *ListDatasetVersionsRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField101(oprot); err != nil {
-			fieldId = 101
-			goto WriteFieldError
-		}
-		if err = p.writeField102(oprot); err != nil {
-			fieldId = 102
-			goto WriteFieldError
-		}
-		if err = p.writeField103(oprot); err != nil {
-			fieldId = 103
-			goto WriteFieldError
-		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
 			goto WriteFieldError
 		}
 	}
@@ -8570,7 +8477,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListDatasetVersionsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetIOJobsRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -8588,7 +8495,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ListDatasetVersionsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetIOJobsRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8604,66 +8511,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ListDatasetVersionsRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersionLike() {
-		if err = oprot.WriteFieldBegin("version_like", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.VersionLike); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ListDatasetVersionsRequest) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageNumber() {
-		if err = oprot.WriteFieldBegin("page_number", thrift.I32, 100); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.PageNumber); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
-}
-func (p *ListDatasetVersionsRequest) writeField101(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 101); err != nil {
+func (p *ListDatasetIOJobsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypes() {
+		if err = oprot.WriteFieldBegin("types", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.PageSize); err != nil {
+		if err := oprot.WriteListBegin(thrift.I32, len(p.Types)); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
-}
-func (p *ListDatasetVersionsRequest) writeField102(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageToken() {
-		if err = oprot.WriteFieldBegin("page_token", thrift.STRING, 102); err != nil {
-			goto WriteFieldBeginError
+		for _, v := range p.Types {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return err
+			}
 		}
-		if err := oprot.WriteString(*p.PageToken); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8672,20 +8533,20 @@ func (p *ListDatasetVersionsRequest) writeField102(oprot thrift.TProtocol) (err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ListDatasetVersionsRequest) writeField103(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOrderBys() {
-		if err = oprot.WriteFieldBegin("order_bys", thrift.LIST, 103); err != nil {
+func (p *ListDatasetIOJobsRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatuses() {
+		if err = oprot.WriteFieldBegin("statuses", thrift.LIST, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OrderBys)); err != nil {
+		if err := oprot.WriteListBegin(thrift.I32, len(p.Statuses)); err != nil {
 			return err
 		}
-		for _, v := range p.OrderBys {
-			if err := v.Write(oprot); err != nil {
+		for _, v := range p.Statuses {
+			if err := oprot.WriteI32(int32(v)); err != nil {
 				return err
 			}
 		}
@@ -8698,11 +8559,11 @@ func (p *ListDatasetVersionsRequest) writeField103(oprot thrift.TProtocol) (err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 103 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ListDatasetVersionsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetIOJobsRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -8721,15 +8582,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ListDatasetVersionsRequest) String() string {
+func (p *ListDatasetIOJobsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDatasetVersionsRequest(%+v)", *p)
+	return fmt.Sprintf("ListDatasetIOJobsRequest(%+v)", *p)
 
 }
 
-func (p *ListDatasetVersionsRequest) DeepEqual(ano *ListDatasetVersionsRequest) bool {
+func (p *ListDatasetIOJobsRequest) DeepEqual(ano *ListDatasetIOJobsRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -8741,19 +8602,10 @@ func (p *ListDatasetVersionsRequest) DeepEqual(ano *ListDatasetVersionsRequest)
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.VersionLike) {
-		return false
-	}
-	if !p.Field100DeepEqual(ano.PageNumber) {
-		return false
-	}
-	if !p.Field101DeepEqual(ano.PageSize) {
-		return false
-	}
-	if !p.Field102DeepEqual(ano.PageToken) {
+	if !p.Field3DeepEqual(ano.Types) {
 		return false
 	}
-	if !p.Field103DeepEqual(ano.OrderBys) {
+	if !p.Field4DeepEqual(ano.Statuses) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -8762,7 +8614,7 @@ func (p *ListDatasetVersionsRequest) DeepEqual(ano *ListDatasetVersionsRequest)
 	return true
 }
 
-func (p *ListDatasetVersionsRequest) Field1DeepEqual(src *int64) bool {
+func (p *ListDatasetIOJobsRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -8774,75 +8626,40 @@ func (p *ListDatasetVersionsRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *ListDatasetVersionsRequest) Field2DeepEqual(src int64) bool {
+func (p *ListDatasetIOJobsRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *ListDatasetVersionsRequest) Field3DeepEqual(src *string) bool {
-
-	if p.VersionLike == src {
-		return true
-	} else if p.VersionLike == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.VersionLike, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetVersionsRequest) Field100DeepEqual(src *int32) bool {
-
-	if p.PageNumber == src {
-		return true
-	} else if p.PageNumber == nil || src == nil {
-		return false
-	}
-	if *p.PageNumber != *src {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetVersionsRequest) Field101DeepEqual(src *int32) bool {
-
-	if p.PageSize == src {
-		return true
-	} else if p.PageSize == nil || src == nil {
-		return false
-	}
-	if *p.PageSize != *src {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetVersionsRequest) Field102DeepEqual(src *string) bool {
+func (p *ListDatasetIOJobsRequest) Field3DeepEqual(src []dataset_job.JobType) bool {
 
-	if p.PageToken == src {
-		return true
-	} else if p.PageToken == nil || src == nil {
+	if len(p.Types) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.PageToken, *src) != 0 {
-		return false
+	for i, v := range p.Types {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
-func (p *ListDatasetVersionsRequest) Field103DeepEqual(src []*dataset.OrderBy) bool {
+func (p *ListDatasetIOJobsRequest) Field4DeepEqual(src []dataset_job.JobStatus) bool {
 
-	if len(p.OrderBys) != len(src) {
+	if len(p.Statuses) != len(src) {
 		return false
 	}
-	for i, v := range p.OrderBys {
+	for i, v := range p.Statuses {
 		_src := src[i]
-		if !v.DeepEqual(_src) {
+		if v != _src {
 			return false
 		}
 	}
 	return true
 }
-func (p *ListDatasetVersionsRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *ListDatasetIOJobsRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -8850,105 +8667,62 @@ func (p *ListDatasetVersionsRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type ListDatasetVersionsResponse struct {
-	Versions []*dataset.DatasetVersion `thrift:"versions,1,optional" frugal:"1,optional,list<dataset.DatasetVersion>" form:"versions" json:"versions,omitempty" query:"versions"`
-	/* pagination */
-	NextPageToken *string        `thrift:"next_page_token,100,optional" frugal:"100,optional,string" form:"next_page_token" json:"next_page_token,omitempty" query:"next_page_token"`
-	Total         *int64         `thrift:"total,101,optional" frugal:"101,optional,i64" json:"total" form:"total" query:"total"`
-	BaseResp      *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
-}
-
-func NewListDatasetVersionsResponse() *ListDatasetVersionsResponse {
-	return &ListDatasetVersionsResponse{}
-}
-
-func (p *ListDatasetVersionsResponse) InitDefault() {
+type ListDatasetIOJobsResponse struct {
+	Jobs     []*dataset_job.DatasetIOJob `thrift:"jobs,1,optional" frugal:"1,optional,list<dataset_job.DatasetIOJob>" form:"jobs" json:"jobs,omitempty" query:"jobs"`
+	BaseResp *base.BaseResp              `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-var ListDatasetVersionsResponse_Versions_DEFAULT []*dataset.DatasetVersion
-
-func (p *ListDatasetVersionsResponse) GetVersions() (v []*dataset.DatasetVersion) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetVersions() {
-		return ListDatasetVersionsResponse_Versions_DEFAULT
-	}
-	return p.Versions
+func NewListDatasetIOJobsResponse() *ListDatasetIOJobsResponse {
+	return &ListDatasetIOJobsResponse{}
 }
 
-var ListDatasetVersionsResponse_NextPageToken_DEFAULT string
-
-func (p *ListDatasetVersionsResponse) GetNextPageToken() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetNextPageToken() {
-		return ListDatasetVersionsResponse_NextPageToken_DEFAULT
-	}
-	return *p.NextPageToken
+func (p *ListDatasetIOJobsResponse) InitDefault() {
 }
 
-var ListDatasetVersionsResponse_Total_DEFAULT int64
+var ListDatasetIOJobsResponse_Jobs_DEFAULT []*dataset_job.DatasetIOJob
 
-func (p *ListDatasetVersionsResponse) GetTotal() (v int64) {
+func (p *ListDatasetIOJobsResponse) GetJobs() (v []*dataset_job.DatasetIOJob) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTotal() {
-		return ListDatasetVersionsResponse_Total_DEFAULT
+	if !p.IsSetJobs() {
+		return ListDatasetIOJobsResponse_Jobs_DEFAULT
 	}
-	return *p.Total
+	return p.Jobs
 }
 
-var ListDatasetVersionsResponse_BaseResp_DEFAULT *base.BaseResp
+var ListDatasetIOJobsResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *ListDatasetVersionsResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *ListDatasetIOJobsResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return ListDatasetVersionsResponse_BaseResp_DEFAULT
+		return ListDatasetIOJobsResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *ListDatasetVersionsResponse) SetVersions(val []*dataset.DatasetVersion) {
-	p.Versions = val
-}
-func (p *ListDatasetVersionsResponse) SetNextPageToken(val *string) {
-	p.NextPageToken = val
-}
-func (p *ListDatasetVersionsResponse) SetTotal(val *int64) {
-	p.Total = val
+func (p *ListDatasetIOJobsResponse) SetJobs(val []*dataset_job.DatasetIOJob) {
+	p.Jobs = val
 }
-func (p *ListDatasetVersionsResponse) SetBaseResp(val *base.BaseResp) {
+func (p *ListDatasetIOJobsResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_ListDatasetVersionsResponse = map[int16]string{
-	1:   "versions",
-	100: "next_page_token",
-	101: "total",
-	255: "BaseResp",
-}
-
-func (p *ListDatasetVersionsResponse) IsSetVersions() bool {
-	return p.Versions != nil
-}
-
-func (p *ListDatasetVersionsResponse) IsSetNextPageToken() bool {
-	return p.NextPageToken != nil
+var fieldIDToName_ListDatasetIOJobsResponse = map[int16]string{
+	1:   "jobs",
+	255: "BaseResp",
 }
 
-func (p *ListDatasetVersionsResponse) IsSetTotal() bool {
-	return p.Total != nil
+func (p *ListDatasetIOJobsResponse) IsSetJobs() bool {
+	return p.Jobs != nil
 }
 
-func (p *ListDatasetVersionsResponse) IsSetBaseResp() bool {
+func (p *ListDatasetIOJobsResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *ListDatasetVersionsResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListDatasetIOJobsResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8974,22 +8748,6 @@ func (p *ListDatasetVersionsResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 100:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField100(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 101:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField101(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -9017,7 +8775,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetVersionsResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetIOJobsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9027,13 +8785,13 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListDatasetVersionsResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListDatasetIOJobsResponse) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*dataset.DatasetVersion, 0, size)
-	values := make([]dataset.DatasetVersion, size)
+	_field := make([]*dataset_job.DatasetIOJob, 0, size)
+	values := make([]dataset_job.DatasetIOJob, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -9047,32 +8805,10 @@ func (p *ListDatasetVersionsResponse) ReadField1(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Versions = _field
-	return nil
-}
-func (p *ListDatasetVersionsResponse) ReadField100(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.NextPageToken = _field
-	return nil
-}
-func (p *ListDatasetVersionsResponse) ReadField101(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Total = _field
+	p.Jobs = _field
 	return nil
 }
-func (p *ListDatasetVersionsResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListDatasetIOJobsResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -9081,9 +8817,9 @@ func (p *ListDatasetVersionsResponse) ReadField255(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ListDatasetVersionsResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetIOJobsResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasetVersionsResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasetIOJobsResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9091,14 +8827,6 @@ func (p *ListDatasetVersionsResponse) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
-			goto WriteFieldError
-		}
-		if err = p.writeField101(oprot); err != nil {
-			fieldId = 101
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -9121,15 +8849,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListDatasetVersionsResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersions() {
-		if err = oprot.WriteFieldBegin("versions", thrift.LIST, 1); err != nil {
+func (p *ListDatasetIOJobsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJobs() {
+		if err = oprot.WriteFieldBegin("jobs", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Versions)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Jobs)); err != nil {
 			return err
 		}
-		for _, v := range p.Versions {
+		for _, v := range p.Jobs {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -9147,43 +8875,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ListDatasetVersionsResponse) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNextPageToken() {
-		if err = oprot.WriteFieldBegin("next_page_token", thrift.STRING, 100); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.NextPageToken); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
-}
-func (p *ListDatasetVersionsResponse) writeField101(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTotal() {
-		if err = oprot.WriteFieldBegin("total", thrift.I64, 101); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.Total); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
-}
-func (p *ListDatasetVersionsResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetIOJobsResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9200,27 +8892,21 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ListDatasetVersionsResponse) String() string {
+func (p *ListDatasetIOJobsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDatasetVersionsResponse(%+v)", *p)
+	return fmt.Sprintf("ListDatasetIOJobsResponse(%+v)", *p)
 
 }
 
-func (p *ListDatasetVersionsResponse) DeepEqual(ano *ListDatasetVersionsResponse) bool {
+func (p *ListDatasetIOJobsResponse) DeepEqual(ano *ListDatasetIOJobsResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Versions) {
-		return false
-	}
-	if !p.Field100DeepEqual(ano.NextPageToken) {
-		return false
-	}
-	if !p.Field101DeepEqual(ano.Total) {
+	if !p.Field1DeepEqual(ano.Jobs) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
@@ -9229,12 +8915,12 @@ func (p *ListDatasetVersionsResponse) DeepEqual(ano *ListDatasetVersionsResponse
 	return true
 }
 
-func (p *ListDatasetVersionsResponse) Field1DeepEqual(src []*dataset.DatasetVersion) bool {
+func (p *ListDatasetIOJobsResponse) Field1DeepEqual(src []*dataset_job.DatasetIOJob) bool {
 
-	if len(p.Versions) != len(src) {
+	if len(p.Jobs) != len(src) {
 		return false
 	}
-	for i, v := range p.Versions {
+	for i, v := range p.Jobs {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -9242,31 +8928,7 @@ func (p *ListDatasetVersionsResponse) Field1DeepEqual(src []*dataset.DatasetVers
 	}
 	return true
 }
-func (p *ListDatasetVersionsResponse) Field100DeepEqual(src *string) bool {
-
-	if p.NextPageToken == src {
-		return true
-	} else if p.NextPageToken == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.NextPageToken, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetVersionsResponse) Field101DeepEqual(src *int64) bool {
-
-	if p.Total == src {
-		return true
-	} else if p.Total == nil || src == nil {
-		return false
-	}
-	if *p.Total != *src {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetVersionsResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *ListDatasetIOJobsResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -9274,99 +8936,186 @@ func (p *ListDatasetVersionsResponse) Field255DeepEqual(src *base.BaseResp) bool
 	return true
 }
 
-type GetDatasetVersionRequest struct {
-	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
-	VersionID   int64  `thrift:"version_id,2,required" frugal:"2,required,i64" json:"version_id" path:"version_id,required" `
-	// 是否返回已删除的数据，默认不返回
-	WithDeleted *bool      `thrift:"with_deleted,10,optional" frugal:"10,optional,bool" json:"with_deleted,omitempty" query:"with_deleted"`
-	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+type ListDatasetVersionsRequest struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	// 根据版本号模糊匹配
+	VersionLike *string `thrift:"version_like,3,optional" frugal:"3,optional,string" form:"version_like" json:"version_like,omitempty" query:"version_like"`
+	/* pagination */
+	PageNumber *int32 `thrift:"page_number,100,optional" frugal:"100,optional,i32" form:"page_number" json:"page_number,omitempty" query:"page_number"`
+	// 分页大小(0, 200]，默认为 20
+	PageSize *int32 `thrift:"page_size,101,optional" frugal:"101,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
+	// 与 page 同时提供时，优先使用 cursor
+	PageToken *string            `thrift:"page_token,102,optional" frugal:"102,optional,string" form:"page_token" json:"page_token,omitempty" query:"page_token"`
+	OrderBys  []*dataset.OrderBy `thrift:"order_bys,103,optional" frugal:"103,optional,list<dataset.OrderBy>" form:"order_bys" json:"order_bys,omitempty" query:"order_bys"`
+	Base      *base.Base         `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewGetDatasetVersionRequest() *GetDatasetVersionRequest {
-	return &GetDatasetVersionRequest{}
+func NewListDatasetVersionsRequest() *ListDatasetVersionsRequest {
+	return &ListDatasetVersionsRequest{}
 }
 
-func (p *GetDatasetVersionRequest) InitDefault() {
+func (p *ListDatasetVersionsRequest) InitDefault() {
 }
 
-var GetDatasetVersionRequest_WorkspaceID_DEFAULT int64
+var ListDatasetVersionsRequest_WorkspaceID_DEFAULT int64
 
-func (p *GetDatasetVersionRequest) GetWorkspaceID() (v int64) {
+func (p *ListDatasetVersionsRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return GetDatasetVersionRequest_WorkspaceID_DEFAULT
+		return ListDatasetVersionsRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *GetDatasetVersionRequest) GetVersionID() (v int64) {
+func (p *ListDatasetVersionsRequest) GetDatasetID() (v int64) {
 	if p != nil {
-		return p.VersionID
+		return p.DatasetID
 	}
 	return
 }
 
-var GetDatasetVersionRequest_WithDeleted_DEFAULT bool
+var ListDatasetVersionsRequest_VersionLike_DEFAULT string
 
-func (p *GetDatasetVersionRequest) GetWithDeleted() (v bool) {
+func (p *ListDatasetVersionsRequest) GetVersionLike() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetWithDeleted() {
-		return GetDatasetVersionRequest_WithDeleted_DEFAULT
+	if !p.IsSetVersionLike() {
+		return ListDatasetVersionsRequest_VersionLike_DEFAULT
 	}
-	return *p.WithDeleted
+	return *p.VersionLike
 }
 
-var GetDatasetVersionRequest_Base_DEFAULT *base.Base
+var ListDatasetVersionsRequest_PageNumber_DEFAULT int32
 
-func (p *GetDatasetVersionRequest) GetBase() (v *base.Base) {
+func (p *ListDatasetVersionsRequest) GetPageNumber() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBase() {
-		return GetDatasetVersionRequest_Base_DEFAULT
+	if !p.IsSetPageNumber() {
+		return ListDatasetVersionsRequest_PageNumber_DEFAULT
 	}
-	return p.Base
-}
-func (p *GetDatasetVersionRequest) SetWorkspaceID(val *int64) {
-	p.WorkspaceID = val
-}
-func (p *GetDatasetVersionRequest) SetVersionID(val int64) {
-	p.VersionID = val
-}
-func (p *GetDatasetVersionRequest) SetWithDeleted(val *bool) {
-	p.WithDeleted = val
-}
-func (p *GetDatasetVersionRequest) SetBase(val *base.Base) {
-	p.Base = val
-}
-
-var fieldIDToName_GetDatasetVersionRequest = map[int16]string{
-	1:   "workspace_id",
-	2:   "version_id",
-	10:  "with_deleted",
-	255: "Base",
+	return *p.PageNumber
 }
 
-func (p *GetDatasetVersionRequest) IsSetWorkspaceID() bool {
-	return p.WorkspaceID != nil
-}
+var ListDatasetVersionsRequest_PageSize_DEFAULT int32
 
-func (p *GetDatasetVersionRequest) IsSetWithDeleted() bool {
-	return p.WithDeleted != nil
-}
+func (p *ListDatasetVersionsRequest) GetPageSize() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageSize() {
+		return ListDatasetVersionsRequest_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
 
-func (p *GetDatasetVersionRequest) IsSetBase() bool {
+var ListDatasetVersionsRequest_PageToken_DEFAULT string
+
+func (p *ListDatasetVersionsRequest) GetPageToken() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageToken() {
+		return ListDatasetVersionsRequest_PageToken_DEFAULT
+	}
+	return *p.PageToken
+}
+
+var ListDatasetVersionsRequest_OrderBys_DEFAULT []*dataset.OrderBy
+
+func (p *ListDatasetVersionsRequest) GetOrderBys() (v []*dataset.OrderBy) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOrderBys() {
+		return ListDatasetVersionsRequest_OrderBys_DEFAULT
+	}
+	return p.OrderBys
+}
+
+var ListDatasetVersionsRequest_Base_DEFAULT *base.Base
+
+func (p *ListDatasetVersionsRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ListDatasetVersionsRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ListDatasetVersionsRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *ListDatasetVersionsRequest) SetDatasetID(val int64) {
+	p.DatasetID = val
+}
+func (p *ListDatasetVersionsRequest) SetVersionLike(val *string) {
+	p.VersionLike = val
+}
+func (p *ListDatasetVersionsRequest) SetPageNumber(val *int32) {
+	p.PageNumber = val
+}
+func (p *ListDatasetVersionsRequest) SetPageSize(val *int32) {
+	p.PageSize = val
+}
+func (p *ListDatasetVersionsRequest) SetPageToken(val *string) {
+	p.PageToken = val
+}
+func (p *ListDatasetVersionsRequest) SetOrderBys(val []*dataset.OrderBy) {
+	p.OrderBys = val
+}
+func (p *ListDatasetVersionsRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_ListDatasetVersionsRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "dataset_id",
+	3:   "version_like",
+	100: "page_number",
+	101: "page_size",
+	102: "page_token",
+	103: "order_bys",
+	255: "Base",
+}
+
+func (p *ListDatasetVersionsRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *ListDatasetVersionsRequest) IsSetVersionLike() bool {
+	return p.VersionLike != nil
+}
+
+func (p *ListDatasetVersionsRequest) IsSetPageNumber() bool {
+	return p.PageNumber != nil
+}
+
+func (p *ListDatasetVersionsRequest) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *ListDatasetVersionsRequest) IsSetPageToken() bool {
+	return p.PageToken != nil
+}
+
+func (p *ListDatasetVersionsRequest) IsSetOrderBys() bool {
+	return p.OrderBys != nil
+}
+
+func (p *ListDatasetVersionsRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetDatasetVersionRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListDatasetVersionsRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetVersionID bool = false
+	var issetDatasetID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9395,13 +9144,45 @@ func (p *GetDatasetVersionRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetVersionID = true
+				issetDatasetID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 10:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField10(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 100:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 101:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField101(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 102:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField102(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 103:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField103(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -9428,7 +9209,7 @@ func (p *GetDatasetVersionRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetVersionID {
+	if !issetDatasetID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
@@ -9438,7 +9219,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetVersionRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetVersionsRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9447,10 +9228,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetDatasetVersionRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListDatasetVersionsRequest[fieldId]))
 }
 
-func (p *GetDatasetVersionRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListDatasetVersionsRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -9461,7 +9242,7 @@ func (p *GetDatasetVersionRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *GetDatasetVersionRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *ListDatasetVersionsRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -9469,21 +9250,77 @@ func (p *GetDatasetVersionRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.VersionID = _field
+	p.DatasetID = _field
 	return nil
 }
-func (p *GetDatasetVersionRequest) ReadField10(iprot thrift.TProtocol) error {
+func (p *ListDatasetVersionsRequest) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.WithDeleted = _field
+	p.VersionLike = _field
 	return nil
 }
-func (p *GetDatasetVersionRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListDatasetVersionsRequest) ReadField100(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageNumber = _field
+	return nil
+}
+func (p *ListDatasetVersionsRequest) ReadField101(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *ListDatasetVersionsRequest) ReadField102(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageToken = _field
+	return nil
+}
+func (p *ListDatasetVersionsRequest) ReadField103(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset.OrderBy, 0, size)
+	values := make([]dataset.OrderBy, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.OrderBys = _field
+	return nil
+}
+func (p *ListDatasetVersionsRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -9492,9 +9329,9 @@ func (p *GetDatasetVersionRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetDatasetVersionRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetVersionsRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDatasetVersionRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasetVersionsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9506,8 +9343,24 @@ func (p *GetDatasetVersionRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+		if err = p.writeField101(oprot); err != nil {
+			fieldId = 101
+			goto WriteFieldError
+		}
+		if err = p.writeField102(oprot); err != nil {
+			fieldId = 102
+			goto WriteFieldError
+		}
+		if err = p.writeField103(oprot); err != nil {
+			fieldId = 103
 			goto WriteFieldError
 		}
 		if err = p.writeField255(oprot); err != nil {
@@ -9532,7 +9385,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetDatasetVersionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetVersionsRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -9550,11 +9403,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetDatasetVersionRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("version_id", thrift.I64, 2); err != nil {
+func (p *ListDatasetVersionsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.VersionID); err != nil {
+	if err := oprot.WriteI64(p.DatasetID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9566,12 +9419,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetDatasetVersionRequest) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWithDeleted() {
-		if err = oprot.WriteFieldBegin("with_deleted", thrift.BOOL, 10); err != nil {
+func (p *ListDatasetVersionsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersionLike() {
+		if err = oprot.WriteFieldBegin("version_like", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.WithDeleted); err != nil {
+		if err := oprot.WriteString(*p.VersionLike); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -9580,11 +9433,91 @@ func (p *GetDatasetVersionRequest) writeField10(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *GetDatasetVersionRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetVersionsRequest) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageNumber() {
+		if err = oprot.WriteFieldBegin("page_number", thrift.I32, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageNumber); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+func (p *ListDatasetVersionsRequest) writeField101(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 101); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+}
+func (p *ListDatasetVersionsRequest) writeField102(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageToken() {
+		if err = oprot.WriteFieldBegin("page_token", thrift.STRING, 102); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PageToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
+}
+func (p *ListDatasetVersionsRequest) writeField103(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrderBys() {
+		if err = oprot.WriteFieldBegin("order_bys", thrift.LIST, 103); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OrderBys)); err != nil {
+			return err
+		}
+		for _, v := range p.OrderBys {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
+}
+func (p *ListDatasetVersionsRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -9603,15 +9536,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *GetDatasetVersionRequest) String() string {
+func (p *ListDatasetVersionsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDatasetVersionRequest(%+v)", *p)
+	return fmt.Sprintf("ListDatasetVersionsRequest(%+v)", *p)
 
 }
 
-func (p *GetDatasetVersionRequest) DeepEqual(ano *GetDatasetVersionRequest) bool {
+func (p *ListDatasetVersionsRequest) DeepEqual(ano *ListDatasetVersionsRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -9620,10 +9553,22 @@ func (p *GetDatasetVersionRequest) DeepEqual(ano *GetDatasetVersionRequest) bool
 	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.VersionID) {
+	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.WithDeleted) {
+	if !p.Field3DeepEqual(ano.VersionLike) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.PageNumber) {
+		return false
+	}
+	if !p.Field101DeepEqual(ano.PageSize) {
+		return false
+	}
+	if !p.Field102DeepEqual(ano.PageToken) {
+		return false
+	}
+	if !p.Field103DeepEqual(ano.OrderBys) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -9632,7 +9577,7 @@ func (p *GetDatasetVersionRequest) DeepEqual(ano *GetDatasetVersionRequest) bool
 	return true
 }
 
-func (p *GetDatasetVersionRequest) Field1DeepEqual(src *int64) bool {
+func (p *ListDatasetVersionsRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -9644,26 +9589,75 @@ func (p *GetDatasetVersionRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *GetDatasetVersionRequest) Field2DeepEqual(src int64) bool {
+func (p *ListDatasetVersionsRequest) Field2DeepEqual(src int64) bool {
 
-	if p.VersionID != src {
+	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *GetDatasetVersionRequest) Field10DeepEqual(src *bool) bool {
+func (p *ListDatasetVersionsRequest) Field3DeepEqual(src *string) bool {
 
-	if p.WithDeleted == src {
+	if p.VersionLike == src {
 		return true
-	} else if p.WithDeleted == nil || src == nil {
+	} else if p.VersionLike == nil || src == nil {
 		return false
 	}
-	if *p.WithDeleted != *src {
+	if strings.Compare(*p.VersionLike, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *GetDatasetVersionRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *ListDatasetVersionsRequest) Field100DeepEqual(src *int32) bool {
+
+	if p.PageNumber == src {
+		return true
+	} else if p.PageNumber == nil || src == nil {
+		return false
+	}
+	if *p.PageNumber != *src {
+		return false
+	}
+	return true
+}
+func (p *ListDatasetVersionsRequest) Field101DeepEqual(src *int32) bool {
+
+	if p.PageSize == src {
+		return true
+	} else if p.PageSize == nil || src == nil {
+		return false
+	}
+	if *p.PageSize != *src {
+		return false
+	}
+	return true
+}
+func (p *ListDatasetVersionsRequest) Field102DeepEqual(src *string) bool {
+
+	if p.PageToken == src {
+		return true
+	} else if p.PageToken == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PageToken, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListDatasetVersionsRequest) Field103DeepEqual(src []*dataset.OrderBy) bool {
+
+	if len(p.OrderBys) != len(src) {
+		return false
+	}
+	for i, v := range p.OrderBys {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListDatasetVersionsRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -9671,83 +9665,105 @@ func (p *GetDatasetVersionRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type GetDatasetVersionResponse struct {
-	Version  *dataset.DatasetVersion `thrift:"version,1,optional" frugal:"1,optional,dataset.DatasetVersion" form:"version" json:"version,omitempty" query:"version"`
-	Dataset  *dataset.Dataset        `thrift:"dataset,2,optional" frugal:"2,optional,dataset.Dataset" form:"dataset" json:"dataset,omitempty" query:"dataset"`
-	BaseResp *base.BaseResp          `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+type ListDatasetVersionsResponse struct {
+	Versions []*dataset.DatasetVersion `thrift:"versions,1,optional" frugal:"1,optional,list<dataset.DatasetVersion>" form:"versions" json:"versions,omitempty" query:"versions"`
+	/* pagination */
+	NextPageToken *string        `thrift:"next_page_token,100,optional" frugal:"100,optional,string" form:"next_page_token" json:"next_page_token,omitempty" query:"next_page_token"`
+	Total         *int64         `thrift:"total,101,optional" frugal:"101,optional,i64" json:"total" form:"total" query:"total"`
+	BaseResp      *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewGetDatasetVersionResponse() *GetDatasetVersionResponse {
-	return &GetDatasetVersionResponse{}
+func NewListDatasetVersionsResponse() *ListDatasetVersionsResponse {
+	return &ListDatasetVersionsResponse{}
 }
 
-func (p *GetDatasetVersionResponse) InitDefault() {
+func (p *ListDatasetVersionsResponse) InitDefault() {
 }
 
-var GetDatasetVersionResponse_Version_DEFAULT *dataset.DatasetVersion
+var ListDatasetVersionsResponse_Versions_DEFAULT []*dataset.DatasetVersion
 
-func (p *GetDatasetVersionResponse) GetVersion() (v *dataset.DatasetVersion) {
+func (p *ListDatasetVersionsResponse) GetVersions() (v []*dataset.DatasetVersion) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetVersion() {
-		return GetDatasetVersionResponse_Version_DEFAULT
+	if !p.IsSetVersions() {
+		return ListDatasetVersionsResponse_Versions_DEFAULT
 	}
-	return p.Version
+	return p.Versions
 }
 
-var GetDatasetVersionResponse_Dataset_DEFAULT *dataset.Dataset
+var ListDatasetVersionsResponse_NextPageToken_DEFAULT string
 
-func (p *GetDatasetVersionResponse) GetDataset() (v *dataset.Dataset) {
+func (p *ListDatasetVersionsResponse) GetNextPageToken() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDataset() {
-		return GetDatasetVersionResponse_Dataset_DEFAULT
+	if !p.IsSetNextPageToken() {
+		return ListDatasetVersionsResponse_NextPageToken_DEFAULT
 	}
-	return p.Dataset
+	return *p.NextPageToken
 }
 
-var GetDatasetVersionResponse_BaseResp_DEFAULT *base.BaseResp
+var ListDatasetVersionsResponse_Total_DEFAULT int64
 
-func (p *GetDatasetVersionResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *ListDatasetVersionsResponse) GetTotal() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTotal() {
+		return ListDatasetVersionsResponse_Total_DEFAULT
+	}
+	return *p.Total
+}
+
+var ListDatasetVersionsResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ListDatasetVersionsResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return GetDatasetVersionResponse_BaseResp_DEFAULT
+		return ListDatasetVersionsResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *GetDatasetVersionResponse) SetVersion(val *dataset.DatasetVersion) {
-	p.Version = val
+func (p *ListDatasetVersionsResponse) SetVersions(val []*dataset.DatasetVersion) {
+	p.Versions = val
 }
-func (p *GetDatasetVersionResponse) SetDataset(val *dataset.Dataset) {
-	p.Dataset = val
+func (p *ListDatasetVersionsResponse) SetNextPageToken(val *string) {
+	p.NextPageToken = val
 }
-func (p *GetDatasetVersionResponse) SetBaseResp(val *base.BaseResp) {
+func (p *ListDatasetVersionsResponse) SetTotal(val *int64) {
+	p.Total = val
+}
+func (p *ListDatasetVersionsResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_GetDatasetVersionResponse = map[int16]string{
-	1:   "version",
-	2:   "dataset",
-	255: "BaseResp",
+var fieldIDToName_ListDatasetVersionsResponse = map[int16]string{
+	1:   "versions",
+	100: "next_page_token",
+	101: "total",
+	255: "BaseResp",
 }
 
-func (p *GetDatasetVersionResponse) IsSetVersion() bool {
-	return p.Version != nil
+func (p *ListDatasetVersionsResponse) IsSetVersions() bool {
+	return p.Versions != nil
 }
 
-func (p *GetDatasetVersionResponse) IsSetDataset() bool {
-	return p.Dataset != nil
+func (p *ListDatasetVersionsResponse) IsSetNextPageToken() bool {
+	return p.NextPageToken != nil
 }
 
-func (p *GetDatasetVersionResponse) IsSetBaseResp() bool {
+func (p *ListDatasetVersionsResponse) IsSetTotal() bool {
+	return p.Total != nil
+}
+
+func (p *ListDatasetVersionsResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetDatasetVersionResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListDatasetVersionsResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9766,16 +9782,24 @@ func (p *GetDatasetVersionResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField2(iprot); err != nil {
+		case 100:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 101:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField101(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -9808,7 +9832,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetVersionResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetVersionsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9818,23 +9842,52 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetDatasetVersionResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := dataset.NewDatasetVersion()
-	if err := _field.Read(iprot); err != nil {
+func (p *ListDatasetVersionsResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.Version = _field
+	_field := make([]*dataset.DatasetVersion, 0, size)
+	values := make([]dataset.DatasetVersion, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Versions = _field
 	return nil
 }
-func (p *GetDatasetVersionResponse) ReadField2(iprot thrift.TProtocol) error {
-	_field := dataset.NewDataset()
-	if err := _field.Read(iprot); err != nil {
+func (p *ListDatasetVersionsResponse) ReadField100(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Dataset = _field
+	p.NextPageToken = _field
 	return nil
 }
-func (p *GetDatasetVersionResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListDatasetVersionsResponse) ReadField101(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *ListDatasetVersionsResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -9843,9 +9896,9 @@ func (p *GetDatasetVersionResponse) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetDatasetVersionResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetVersionsResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDatasetVersionResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasetVersionsResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9853,8 +9906,12 @@ func (p *GetDatasetVersionResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+		if err = p.writeField101(oprot); err != nil {
+			fieldId = 101
 			goto WriteFieldError
 		}
 		if err = p.writeField255(oprot); err != nil {
@@ -9879,12 +9936,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetDatasetVersionResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersion() {
-		if err = oprot.WriteFieldBegin("version", thrift.STRUCT, 1); err != nil {
+func (p *ListDatasetVersionsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersions() {
+		if err = oprot.WriteFieldBegin("versions", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Version.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Versions)); err != nil {
+			return err
+		}
+		for _, v := range p.Versions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -9897,12 +9962,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetDatasetVersionResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDataset() {
-		if err = oprot.WriteFieldBegin("dataset", thrift.STRUCT, 2); err != nil {
+func (p *ListDatasetVersionsResponse) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextPageToken() {
+		if err = oprot.WriteFieldBegin("next_page_token", thrift.STRING, 100); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Dataset.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.NextPageToken); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -9911,11 +9976,29 @@ func (p *GetDatasetVersionResponse) writeField2(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
 }
-func (p *GetDatasetVersionResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetVersionsResponse) writeField101(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotal() {
+		if err = oprot.WriteFieldBegin("total", thrift.I64, 101); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Total); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+}
+func (p *ListDatasetVersionsResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9932,24 +10015,27 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *GetDatasetVersionResponse) String() string {
+func (p *ListDatasetVersionsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDatasetVersionResponse(%+v)", *p)
+	return fmt.Sprintf("ListDatasetVersionsResponse(%+v)", *p)
 
 }
 
-func (p *GetDatasetVersionResponse) DeepEqual(ano *GetDatasetVersionResponse) bool {
+func (p *ListDatasetVersionsResponse) DeepEqual(ano *ListDatasetVersionsResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Version) {
+	if !p.Field1DeepEqual(ano.Versions) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Dataset) {
+	if !p.Field100DeepEqual(ano.NextPageToken) {
+		return false
+	}
+	if !p.Field101DeepEqual(ano.Total) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
@@ -9958,21 +10044,44 @@ func (p *GetDatasetVersionResponse) DeepEqual(ano *GetDatasetVersionResponse) bo
 	return true
 }
 
-func (p *GetDatasetVersionResponse) Field1DeepEqual(src *dataset.DatasetVersion) bool {
+func (p *ListDatasetVersionsResponse) Field1DeepEqual(src []*dataset.DatasetVersion) bool {
 
-	if !p.Version.DeepEqual(src) {
+	if len(p.Versions) != len(src) {
 		return false
 	}
+	for i, v := range p.Versions {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
-func (p *GetDatasetVersionResponse) Field2DeepEqual(src *dataset.Dataset) bool {
+func (p *ListDatasetVersionsResponse) Field100DeepEqual(src *string) bool {
 
-	if !p.Dataset.DeepEqual(src) {
+	if p.NextPageToken == src {
+		return true
+	} else if p.NextPageToken == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextPageToken, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *GetDatasetVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *ListDatasetVersionsResponse) Field101DeepEqual(src *int64) bool {
+
+	if p.Total == src {
+		return true
+	} else if p.Total == nil || src == nil {
+		return false
+	}
+	if *p.Total != *src {
+		return false
+	}
+	return true
+}
+func (p *ListDatasetVersionsResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -9980,64 +10089,99 @@ func (p *GetDatasetVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type VersionedDataset struct {
-	Version *dataset.DatasetVersion `thrift:"version,1,optional" frugal:"1,optional,dataset.DatasetVersion" form:"version" json:"version,omitempty" query:"version"`
-	Dataset *dataset.Dataset        `thrift:"dataset,2,optional" frugal:"2,optional,dataset.Dataset" form:"dataset" json:"dataset,omitempty" query:"dataset"`
+type GetDatasetVersionRequest struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
+	VersionID   int64  `thrift:"version_id,2,required" frugal:"2,required,i64" json:"version_id" path:"version_id,required" `
+	// 是否返回已删除的数据，默认不返回
+	WithDeleted *bool      `thrift:"with_deleted,10,optional" frugal:"10,optional,bool" json:"with_deleted,omitempty" query:"with_deleted"`
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewVersionedDataset() *VersionedDataset {
-	return &VersionedDataset{}
+func NewGetDatasetVersionRequest() *GetDatasetVersionRequest {
+	return &GetDatasetVersionRequest{}
 }
 
-func (p *VersionedDataset) InitDefault() {
+func (p *GetDatasetVersionRequest) InitDefault() {
 }
 
-var VersionedDataset_Version_DEFAULT *dataset.DatasetVersion
+var GetDatasetVersionRequest_WorkspaceID_DEFAULT int64
 
-func (p *VersionedDataset) GetVersion() (v *dataset.DatasetVersion) {
+func (p *GetDatasetVersionRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetVersion() {
-		return VersionedDataset_Version_DEFAULT
+	if !p.IsSetWorkspaceID() {
+		return GetDatasetVersionRequest_WorkspaceID_DEFAULT
 	}
-	return p.Version
+	return *p.WorkspaceID
 }
 
-var VersionedDataset_Dataset_DEFAULT *dataset.Dataset
+func (p *GetDatasetVersionRequest) GetVersionID() (v int64) {
+	if p != nil {
+		return p.VersionID
+	}
+	return
+}
 
-func (p *VersionedDataset) GetDataset() (v *dataset.Dataset) {
+var GetDatasetVersionRequest_WithDeleted_DEFAULT bool
+
+func (p *GetDatasetVersionRequest) GetWithDeleted() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDataset() {
-		return VersionedDataset_Dataset_DEFAULT
+	if !p.IsSetWithDeleted() {
+		return GetDatasetVersionRequest_WithDeleted_DEFAULT
 	}
-	return p.Dataset
-}
-func (p *VersionedDataset) SetVersion(val *dataset.DatasetVersion) {
-	p.Version = val
-}
-func (p *VersionedDataset) SetDataset(val *dataset.Dataset) {
-	p.Dataset = val
+	return *p.WithDeleted
 }
 
-var fieldIDToName_VersionedDataset = map[int16]string{
-	1: "version",
-	2: "dataset",
-}
+var GetDatasetVersionRequest_Base_DEFAULT *base.Base
 
-func (p *VersionedDataset) IsSetVersion() bool {
-	return p.Version != nil
+func (p *GetDatasetVersionRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return GetDatasetVersionRequest_Base_DEFAULT
+	}
+	return p.Base
 }
-
-func (p *VersionedDataset) IsSetDataset() bool {
-	return p.Dataset != nil
+func (p *GetDatasetVersionRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *GetDatasetVersionRequest) SetVersionID(val int64) {
+	p.VersionID = val
+}
+func (p *GetDatasetVersionRequest) SetWithDeleted(val *bool) {
+	p.WithDeleted = val
+}
+func (p *GetDatasetVersionRequest) SetBase(val *base.Base) {
+	p.Base = val
 }
 
-func (p *VersionedDataset) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_GetDatasetVersionRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "version_id",
+	10:  "with_deleted",
+	255: "Base",
+}
+
+func (p *GetDatasetVersionRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *GetDatasetVersionRequest) IsSetWithDeleted() bool {
+	return p.WithDeleted != nil
+}
+
+func (p *GetDatasetVersionRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetDatasetVersionRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -10054,7 +10198,7 @@ func (p *VersionedDataset) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10062,10 +10206,27 @@ func (p *VersionedDataset) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -10082,13 +10243,17 @@ func (p *VersionedDataset) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetVersionID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VersionedDataset[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetVersionRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10096,28 +10261,55 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetDatasetVersionRequest[fieldId]))
 }
 
-func (p *VersionedDataset) ReadField1(iprot thrift.TProtocol) error {
-	_field := dataset.NewDatasetVersion()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetDatasetVersionRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Version = _field
+	p.WorkspaceID = _field
 	return nil
 }
-func (p *VersionedDataset) ReadField2(iprot thrift.TProtocol) error {
-	_field := dataset.NewDataset()
+func (p *GetDatasetVersionRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VersionID = _field
+	return nil
+}
+func (p *GetDatasetVersionRequest) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WithDeleted = _field
+	return nil
+}
+func (p *GetDatasetVersionRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Dataset = _field
+	p.Base = _field
 	return nil
 }
 
-func (p *VersionedDataset) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetVersionRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("VersionedDataset"); err != nil {
+	if err = oprot.WriteStructBegin("GetDatasetVersionRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10129,6 +10321,14 @@ func (p *VersionedDataset) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10147,12 +10347,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VersionedDataset) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersion() {
-		if err = oprot.WriteFieldBegin("version", thrift.STRUCT, 1); err != nil {
+func (p *GetDatasetVersionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Version.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10165,12 +10365,28 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *VersionedDataset) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDataset() {
-		if err = oprot.WriteFieldBegin("dataset", thrift.STRUCT, 2); err != nil {
+func (p *GetDatasetVersionRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("version_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetDatasetVersionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWithDeleted() {
+		if err = oprot.WriteFieldBegin("with_deleted", thrift.BOOL, 10); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Dataset.Write(oprot); err != nil {
+		if err := oprot.WriteBool(*p.WithDeleted); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10179,142 +10395,176 @@ func (p *VersionedDataset) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *GetDatasetVersionRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *VersionedDataset) String() string {
+func (p *GetDatasetVersionRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VersionedDataset(%+v)", *p)
+	return fmt.Sprintf("GetDatasetVersionRequest(%+v)", *p)
 
 }
 
-func (p *VersionedDataset) DeepEqual(ano *VersionedDataset) bool {
+func (p *GetDatasetVersionRequest) DeepEqual(ano *GetDatasetVersionRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Version) {
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Dataset) {
+	if !p.Field2DeepEqual(ano.VersionID) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.WithDeleted) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
 		return false
 	}
 	return true
 }
 
-func (p *VersionedDataset) Field1DeepEqual(src *dataset.DatasetVersion) bool {
+func (p *GetDatasetVersionRequest) Field1DeepEqual(src *int64) bool {
 
-	if !p.Version.DeepEqual(src) {
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
 		return false
 	}
 	return true
 }
-func (p *VersionedDataset) Field2DeepEqual(src *dataset.Dataset) bool {
+func (p *GetDatasetVersionRequest) Field2DeepEqual(src int64) bool {
 
-	if !p.Dataset.DeepEqual(src) {
+	if p.VersionID != src {
 		return false
 	}
 	return true
 }
+func (p *GetDatasetVersionRequest) Field10DeepEqual(src *bool) bool {
 
-type BatchGetDatasetVersionsRequest struct {
-	WorkspaceID *int64  `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" path:"workspace_id" `
-	VersionIds  []int64 `thrift:"version_ids,2,required" frugal:"2,required,list<i64>" json:"version_ids" form:"version_ids,required" query:"version_ids,required"`
-	// 是否返回已删除的数据，默认不返回
-	WithDeleted *bool      `thrift:"with_deleted,10,optional" frugal:"10,optional,bool" form:"with_deleted" json:"with_deleted,omitempty" query:"with_deleted"`
-	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	if p.WithDeleted == src {
+		return true
+	} else if p.WithDeleted == nil || src == nil {
+		return false
+	}
+	if *p.WithDeleted != *src {
+		return false
+	}
+	return true
 }
+func (p *GetDatasetVersionRequest) Field255DeepEqual(src *base.Base) bool {
 
-func NewBatchGetDatasetVersionsRequest() *BatchGetDatasetVersionsRequest {
-	return &BatchGetDatasetVersionsRequest{}
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func (p *BatchGetDatasetVersionsRequest) InitDefault() {
+type GetDatasetVersionResponse struct {
+	Version  *dataset.DatasetVersion `thrift:"version,1,optional" frugal:"1,optional,dataset.DatasetVersion" form:"version" json:"version,omitempty" query:"version"`
+	Dataset  *dataset.Dataset        `thrift:"dataset,2,optional" frugal:"2,optional,dataset.Dataset" form:"dataset" json:"dataset,omitempty" query:"dataset"`
+	BaseResp *base.BaseResp          `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-var BatchGetDatasetVersionsRequest_WorkspaceID_DEFAULT int64
-
-func (p *BatchGetDatasetVersionsRequest) GetWorkspaceID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetWorkspaceID() {
-		return BatchGetDatasetVersionsRequest_WorkspaceID_DEFAULT
-	}
-	return *p.WorkspaceID
+func NewGetDatasetVersionResponse() *GetDatasetVersionResponse {
+	return &GetDatasetVersionResponse{}
 }
 
-func (p *BatchGetDatasetVersionsRequest) GetVersionIds() (v []int64) {
-	if p != nil {
-		return p.VersionIds
-	}
-	return
+func (p *GetDatasetVersionResponse) InitDefault() {
 }
 
-var BatchGetDatasetVersionsRequest_WithDeleted_DEFAULT bool
+var GetDatasetVersionResponse_Version_DEFAULT *dataset.DatasetVersion
 
-func (p *BatchGetDatasetVersionsRequest) GetWithDeleted() (v bool) {
+func (p *GetDatasetVersionResponse) GetVersion() (v *dataset.DatasetVersion) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetWithDeleted() {
-		return BatchGetDatasetVersionsRequest_WithDeleted_DEFAULT
+	if !p.IsSetVersion() {
+		return GetDatasetVersionResponse_Version_DEFAULT
 	}
-	return *p.WithDeleted
+	return p.Version
 }
 
-var BatchGetDatasetVersionsRequest_Base_DEFAULT *base.Base
+var GetDatasetVersionResponse_Dataset_DEFAULT *dataset.Dataset
 
-func (p *BatchGetDatasetVersionsRequest) GetBase() (v *base.Base) {
+func (p *GetDatasetVersionResponse) GetDataset() (v *dataset.Dataset) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBase() {
-		return BatchGetDatasetVersionsRequest_Base_DEFAULT
+	if !p.IsSetDataset() {
+		return GetDatasetVersionResponse_Dataset_DEFAULT
 	}
-	return p.Base
+	return p.Dataset
 }
-func (p *BatchGetDatasetVersionsRequest) SetWorkspaceID(val *int64) {
-	p.WorkspaceID = val
+
+var GetDatasetVersionResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *GetDatasetVersionResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return GetDatasetVersionResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
 }
-func (p *BatchGetDatasetVersionsRequest) SetVersionIds(val []int64) {
-	p.VersionIds = val
+func (p *GetDatasetVersionResponse) SetVersion(val *dataset.DatasetVersion) {
+	p.Version = val
 }
-func (p *BatchGetDatasetVersionsRequest) SetWithDeleted(val *bool) {
-	p.WithDeleted = val
+func (p *GetDatasetVersionResponse) SetDataset(val *dataset.Dataset) {
+	p.Dataset = val
 }
-func (p *BatchGetDatasetVersionsRequest) SetBase(val *base.Base) {
-	p.Base = val
+func (p *GetDatasetVersionResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
 }
 
-var fieldIDToName_BatchGetDatasetVersionsRequest = map[int16]string{
-	1:   "workspace_id",
-	2:   "version_ids",
-	10:  "with_deleted",
-	255: "Base",
+var fieldIDToName_GetDatasetVersionResponse = map[int16]string{
+	1:   "version",
+	2:   "dataset",
+	255: "BaseResp",
 }
 
-func (p *BatchGetDatasetVersionsRequest) IsSetWorkspaceID() bool {
-	return p.WorkspaceID != nil
+func (p *GetDatasetVersionResponse) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *BatchGetDatasetVersionsRequest) IsSetWithDeleted() bool {
-	return p.WithDeleted != nil
+func (p *GetDatasetVersionResponse) IsSetDataset() bool {
+	return p.Dataset != nil
 }
 
-func (p *BatchGetDatasetVersionsRequest) IsSetBase() bool {
-	return p.Base != nil
+func (p *GetDatasetVersionResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *BatchGetDatasetVersionsRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetDatasetVersionResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetVersionIds bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -10331,7 +10581,7 @@ func (p *BatchGetDatasetVersionsRequest) Read(iprot thrift.TProtocol) (err error
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10339,19 +10589,10 @@ func (p *BatchGetDatasetVersionsRequest) Read(iprot thrift.TProtocol) (err error
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetVersionIds = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -10376,17 +10617,13 @@ func (p *BatchGetDatasetVersionsRequest) Read(iprot thrift.TProtocol) (err error
 		goto ReadStructEndError
 	}
 
-	if !issetVersionIds {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetVersionsRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetVersionResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10394,67 +10631,36 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchGetDatasetVersionsRequest[fieldId]))
-}
-
-func (p *BatchGetDatasetVersionsRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.WorkspaceID = _field
-	return nil
 }
-func (p *BatchGetDatasetVersionsRequest) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *GetDatasetVersionResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := dataset.NewDatasetVersion()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.VersionIds = _field
+	p.Version = _field
 	return nil
 }
-func (p *BatchGetDatasetVersionsRequest) ReadField10(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+func (p *GetDatasetVersionResponse) ReadField2(iprot thrift.TProtocol) error {
+	_field := dataset.NewDataset()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.WithDeleted = _field
+	p.Dataset = _field
 	return nil
 }
-func (p *BatchGetDatasetVersionsRequest) ReadField255(iprot thrift.TProtocol) error {
-	_field := base.NewBase()
+func (p *GetDatasetVersionResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Base = _field
+	p.BaseResp = _field
 	return nil
 }
 
-func (p *BatchGetDatasetVersionsRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetVersionResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetDatasetVersionsRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetDatasetVersionResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10466,10 +10672,6 @@ func (p *BatchGetDatasetVersionsRequest) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -10492,12 +10694,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchGetDatasetVersionsRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWorkspaceID() {
-		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+func (p *GetDatasetVersionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+		if err := p.Version.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10510,36 +10712,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchGetDatasetVersionsRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("version_ids", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.I64, len(p.VersionIds)); err != nil {
-		return err
-	}
-	for _, v := range p.VersionIds {
-		if err := oprot.WriteI64(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *BatchGetDatasetVersionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWithDeleted() {
-		if err = oprot.WriteFieldBegin("with_deleted", thrift.BOOL, 10); err != nil {
+func (p *GetDatasetVersionResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDataset() {
+		if err = oprot.WriteFieldBegin("dataset", thrift.STRUCT, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.WithDeleted); err != nil {
+		if err := p.Dataset.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10548,21 +10726,19 @@ func (p *BatchGetDatasetVersionsRequest) writeField10(oprot thrift.TProtocol) (e
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *BatchGetDatasetVersionsRequest) writeField255(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBase() {
-		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Base.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetDatasetVersionResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -10571,136 +10747,110 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchGetDatasetVersionsRequest) String() string {
+func (p *GetDatasetVersionResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchGetDatasetVersionsRequest(%+v)", *p)
+	return fmt.Sprintf("GetDatasetVersionResponse(%+v)", *p)
 
 }
 
-func (p *BatchGetDatasetVersionsRequest) DeepEqual(ano *BatchGetDatasetVersionsRequest) bool {
+func (p *GetDatasetVersionResponse) DeepEqual(ano *GetDatasetVersionResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.WorkspaceID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.VersionIds) {
+	if !p.Field1DeepEqual(ano.Version) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.WithDeleted) {
+	if !p.Field2DeepEqual(ano.Dataset) {
 		return false
 	}
-	if !p.Field255DeepEqual(ano.Base) {
+	if !p.Field255DeepEqual(ano.BaseResp) {
 		return false
 	}
 	return true
 }
 
-func (p *BatchGetDatasetVersionsRequest) Field1DeepEqual(src *int64) bool {
+func (p *GetDatasetVersionResponse) Field1DeepEqual(src *dataset.DatasetVersion) bool {
 
-	if p.WorkspaceID == src {
-		return true
-	} else if p.WorkspaceID == nil || src == nil {
-		return false
-	}
-	if *p.WorkspaceID != *src {
+	if !p.Version.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *BatchGetDatasetVersionsRequest) Field2DeepEqual(src []int64) bool {
+func (p *GetDatasetVersionResponse) Field2DeepEqual(src *dataset.Dataset) bool {
 
-	if len(p.VersionIds) != len(src) {
+	if !p.Dataset.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.VersionIds {
-		_src := src[i]
-		if v != _src {
-			return false
-		}
-	}
 	return true
 }
-func (p *BatchGetDatasetVersionsRequest) Field10DeepEqual(src *bool) bool {
+func (p *GetDatasetVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
-	if p.WithDeleted == src {
-		return true
-	} else if p.WithDeleted == nil || src == nil {
-		return false
-	}
-	if *p.WithDeleted != *src {
+	if !p.BaseResp.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *BatchGetDatasetVersionsRequest) Field255DeepEqual(src *base.Base) bool {
 
-	if !p.Base.DeepEqual(src) {
-		return false
-	}
-	return true
+type VersionedDataset struct {
+	Version *dataset.DatasetVersion `thrift:"version,1,optional" frugal:"1,optional,dataset.DatasetVersion" form:"version" json:"version,omitempty" query:"version"`
+	Dataset *dataset.Dataset        `thrift:"dataset,2,optional" frugal:"2,optional,dataset.Dataset" form:"dataset" json:"dataset,omitempty" query:"dataset"`
 }
 
-type BatchGetDatasetVersionsResponse struct {
-	VersionedDataset []*VersionedDataset `thrift:"versioned_dataset,1,optional" frugal:"1,optional,list<VersionedDataset>" form:"versioned_dataset" json:"versioned_dataset,omitempty" query:"versioned_dataset"`
-	BaseResp         *base.BaseResp      `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
-}
-
-func NewBatchGetDatasetVersionsResponse() *BatchGetDatasetVersionsResponse {
-	return &BatchGetDatasetVersionsResponse{}
+func NewVersionedDataset() *VersionedDataset {
+	return &VersionedDataset{}
 }
 
-func (p *BatchGetDatasetVersionsResponse) InitDefault() {
+func (p *VersionedDataset) InitDefault() {
 }
 
-var BatchGetDatasetVersionsResponse_VersionedDataset_DEFAULT []*VersionedDataset
+var VersionedDataset_Version_DEFAULT *dataset.DatasetVersion
 
-func (p *BatchGetDatasetVersionsResponse) GetVersionedDataset() (v []*VersionedDataset) {
+func (p *VersionedDataset) GetVersion() (v *dataset.DatasetVersion) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetVersionedDataset() {
-		return BatchGetDatasetVersionsResponse_VersionedDataset_DEFAULT
+	if !p.IsSetVersion() {
+		return VersionedDataset_Version_DEFAULT
 	}
-	return p.VersionedDataset
+	return p.Version
 }
 
-var BatchGetDatasetVersionsResponse_BaseResp_DEFAULT *base.BaseResp
+var VersionedDataset_Dataset_DEFAULT *dataset.Dataset
 
-func (p *BatchGetDatasetVersionsResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *VersionedDataset) GetDataset() (v *dataset.Dataset) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBaseResp() {
-		return BatchGetDatasetVersionsResponse_BaseResp_DEFAULT
+	if !p.IsSetDataset() {
+		return VersionedDataset_Dataset_DEFAULT
 	}
-	return p.BaseResp
+	return p.Dataset
 }
-func (p *BatchGetDatasetVersionsResponse) SetVersionedDataset(val []*VersionedDataset) {
-	p.VersionedDataset = val
+func (p *VersionedDataset) SetVersion(val *dataset.DatasetVersion) {
+	p.Version = val
 }
-func (p *BatchGetDatasetVersionsResponse) SetBaseResp(val *base.BaseResp) {
-	p.BaseResp = val
+func (p *VersionedDataset) SetDataset(val *dataset.Dataset) {
+	p.Dataset = val
 }
 
-var fieldIDToName_BatchGetDatasetVersionsResponse = map[int16]string{
-	1:   "versioned_dataset",
-	255: "BaseResp",
+var fieldIDToName_VersionedDataset = map[int16]string{
+	1: "version",
+	2: "dataset",
 }
 
-func (p *BatchGetDatasetVersionsResponse) IsSetVersionedDataset() bool {
-	return p.VersionedDataset != nil
+func (p *VersionedDataset) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *BatchGetDatasetVersionsResponse) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *VersionedDataset) IsSetDataset() bool {
+	return p.Dataset != nil
 }
 
-func (p *BatchGetDatasetVersionsResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *VersionedDataset) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10719,16 +10869,16 @@ func (p *BatchGetDatasetVersionsResponse) Read(iprot thrift.TProtocol) (err erro
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 255:
+		case 2:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField255(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -10753,7 +10903,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetVersionsResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VersionedDataset[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10763,41 +10913,26 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *BatchGetDatasetVersionsResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*VersionedDataset, 0, size)
-	values := make([]VersionedDataset, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *VersionedDataset) ReadField1(iprot thrift.TProtocol) error {
+	_field := dataset.NewDatasetVersion()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.VersionedDataset = _field
+	p.Version = _field
 	return nil
 }
-func (p *BatchGetDatasetVersionsResponse) ReadField255(iprot thrift.TProtocol) error {
-	_field := base.NewBaseResp()
+func (p *VersionedDataset) ReadField2(iprot thrift.TProtocol) error {
+	_field := dataset.NewDataset()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.BaseResp = _field
+	p.Dataset = _field
 	return nil
 }
 
-func (p *BatchGetDatasetVersionsResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *VersionedDataset) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetDatasetVersionsResponse"); err != nil {
+	if err = oprot.WriteStructBegin("VersionedDataset"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10805,8 +10940,8 @@ func (p *BatchGetDatasetVersionsResponse) Write(oprot thrift.TProtocol) (err err
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
 			goto WriteFieldError
 		}
 	}
@@ -10827,20 +10962,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchGetDatasetVersionsResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersionedDataset() {
-		if err = oprot.WriteFieldBegin("versioned_dataset", thrift.LIST, 1); err != nil {
+func (p *VersionedDataset) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VersionedDataset)); err != nil {
-			return err
-		}
-		for _, v := range p.VersionedDataset {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := p.Version.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10853,173 +10980,156 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchGetDatasetVersionsResponse) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.BaseResp.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *VersionedDataset) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDataset() {
+		if err = oprot.WriteFieldBegin("dataset", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Dataset.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *BatchGetDatasetVersionsResponse) String() string {
+func (p *VersionedDataset) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchGetDatasetVersionsResponse(%+v)", *p)
+	return fmt.Sprintf("VersionedDataset(%+v)", *p)
 
 }
 
-func (p *BatchGetDatasetVersionsResponse) DeepEqual(ano *BatchGetDatasetVersionsResponse) bool {
+func (p *VersionedDataset) DeepEqual(ano *VersionedDataset) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.VersionedDataset) {
+	if !p.Field1DeepEqual(ano.Version) {
 		return false
 	}
-	if !p.Field255DeepEqual(ano.BaseResp) {
+	if !p.Field2DeepEqual(ano.Dataset) {
 		return false
 	}
 	return true
 }
 
-func (p *BatchGetDatasetVersionsResponse) Field1DeepEqual(src []*VersionedDataset) bool {
+func (p *VersionedDataset) Field1DeepEqual(src *dataset.DatasetVersion) bool {
 
-	if len(p.VersionedDataset) != len(src) {
+	if !p.Version.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.VersionedDataset {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *BatchGetDatasetVersionsResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *VersionedDataset) Field2DeepEqual(src *dataset.Dataset) bool {
 
-	if !p.BaseResp.DeepEqual(src) {
+	if !p.Dataset.DeepEqual(src) {
 		return false
 	}
 	return true
 }
 
-type CreateDatasetVersionRequest struct {
-	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	// 展示的版本号，SemVer2 三段式，需要大于上一版本
-	Version string     `thrift:"version,3,required" frugal:"3,required,string" form:"version,required" json:"version,required" query:"version,required"`
-	Desc    *string    `thrift:"desc,4,optional" frugal:"4,optional,string" form:"desc" json:"desc,omitempty" query:"desc"`
-	Base    *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+type BatchGetDatasetVersionsRequest struct {
+	WorkspaceID *int64  `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" path:"workspace_id" `
+	VersionIds  []int64 `thrift:"version_ids,2,required" frugal:"2,required,list<i64>" json:"version_ids" form:"version_ids,required" query:"version_ids,required"`
+	// 是否返回已删除的数据，默认不返回
+	WithDeleted *bool      `thrift:"with_deleted,10,optional" frugal:"10,optional,bool" form:"with_deleted" json:"with_deleted,omitempty" query:"with_deleted"`
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewCreateDatasetVersionRequest() *CreateDatasetVersionRequest {
-	return &CreateDatasetVersionRequest{}
+func NewBatchGetDatasetVersionsRequest() *BatchGetDatasetVersionsRequest {
+	return &BatchGetDatasetVersionsRequest{}
 }
 
-func (p *CreateDatasetVersionRequest) InitDefault() {
+func (p *BatchGetDatasetVersionsRequest) InitDefault() {
 }
 
-var CreateDatasetVersionRequest_WorkspaceID_DEFAULT int64
+var BatchGetDatasetVersionsRequest_WorkspaceID_DEFAULT int64
 
-func (p *CreateDatasetVersionRequest) GetWorkspaceID() (v int64) {
+func (p *BatchGetDatasetVersionsRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return CreateDatasetVersionRequest_WorkspaceID_DEFAULT
+		return BatchGetDatasetVersionsRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *CreateDatasetVersionRequest) GetDatasetID() (v int64) {
-	if p != nil {
-		return p.DatasetID
-	}
-	return
-}
-
-func (p *CreateDatasetVersionRequest) GetVersion() (v string) {
+func (p *BatchGetDatasetVersionsRequest) GetVersionIds() (v []int64) {
 	if p != nil {
-		return p.Version
+		return p.VersionIds
 	}
 	return
 }
 
-var CreateDatasetVersionRequest_Desc_DEFAULT string
+var BatchGetDatasetVersionsRequest_WithDeleted_DEFAULT bool
 
-func (p *CreateDatasetVersionRequest) GetDesc() (v string) {
+func (p *BatchGetDatasetVersionsRequest) GetWithDeleted() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDesc() {
-		return CreateDatasetVersionRequest_Desc_DEFAULT
+	if !p.IsSetWithDeleted() {
+		return BatchGetDatasetVersionsRequest_WithDeleted_DEFAULT
 	}
-	return *p.Desc
+	return *p.WithDeleted
 }
 
-var CreateDatasetVersionRequest_Base_DEFAULT *base.Base
+var BatchGetDatasetVersionsRequest_Base_DEFAULT *base.Base
 
-func (p *CreateDatasetVersionRequest) GetBase() (v *base.Base) {
+func (p *BatchGetDatasetVersionsRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return CreateDatasetVersionRequest_Base_DEFAULT
+		return BatchGetDatasetVersionsRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *CreateDatasetVersionRequest) SetWorkspaceID(val *int64) {
+func (p *BatchGetDatasetVersionsRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *CreateDatasetVersionRequest) SetDatasetID(val int64) {
-	p.DatasetID = val
-}
-func (p *CreateDatasetVersionRequest) SetVersion(val string) {
-	p.Version = val
+func (p *BatchGetDatasetVersionsRequest) SetVersionIds(val []int64) {
+	p.VersionIds = val
 }
-func (p *CreateDatasetVersionRequest) SetDesc(val *string) {
-	p.Desc = val
+func (p *BatchGetDatasetVersionsRequest) SetWithDeleted(val *bool) {
+	p.WithDeleted = val
 }
-func (p *CreateDatasetVersionRequest) SetBase(val *base.Base) {
+func (p *BatchGetDatasetVersionsRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_CreateDatasetVersionRequest = map[int16]string{
+var fieldIDToName_BatchGetDatasetVersionsRequest = map[int16]string{
 	1:   "workspace_id",
-	2:   "dataset_id",
-	3:   "version",
-	4:   "desc",
+	2:   "version_ids",
+	10:  "with_deleted",
 	255: "Base",
 }
 
-func (p *CreateDatasetVersionRequest) IsSetWorkspaceID() bool {
+func (p *BatchGetDatasetVersionsRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *CreateDatasetVersionRequest) IsSetDesc() bool {
-	return p.Desc != nil
+func (p *BatchGetDatasetVersionsRequest) IsSetWithDeleted() bool {
+	return p.WithDeleted != nil
 }
 
-func (p *CreateDatasetVersionRequest) IsSetBase() bool {
+func (p *BatchGetDatasetVersionsRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *CreateDatasetVersionRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetVersionsRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetDatasetID bool = false
-	var issetVersion bool = false
+	var issetVersionIds bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -11044,26 +11154,17 @@ func (p *CreateDatasetVersionRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetDatasetID = true
+				issetVersionIds = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetVersion = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -11090,22 +11191,17 @@ func (p *CreateDatasetVersionRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetDatasetID {
+	if !issetVersionIds {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetVersion {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateDatasetVersionRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetVersionsRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11114,10 +11210,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreateDatasetVersionRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchGetDatasetVersionsRequest[fieldId]))
 }
 
-func (p *CreateDatasetVersionRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetVersionsRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -11128,40 +11224,41 @@ func (p *CreateDatasetVersionRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *CreateDatasetVersionRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *BatchGetDatasetVersionsRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.DatasetID = _field
-	return nil
-}
-func (p *CreateDatasetVersionRequest) ReadField3(iprot thrift.TProtocol) error {
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Version = _field
+	p.VersionIds = _field
 	return nil
 }
-func (p *CreateDatasetVersionRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetVersionsRequest) ReadField10(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Desc = _field
+	p.WithDeleted = _field
 	return nil
 }
-func (p *CreateDatasetVersionRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetVersionsRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -11170,9 +11267,9 @@ func (p *CreateDatasetVersionRequest) ReadField255(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CreateDatasetVersionRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetVersionsRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateDatasetVersionRequest"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetDatasetVersionsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11184,12 +11281,8 @@ func (p *CreateDatasetVersionRequest) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
 			goto WriteFieldError
 		}
 		if err = p.writeField255(oprot); err != nil {
@@ -11214,7 +11307,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateDatasetVersionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetVersionsRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -11232,27 +11325,19 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *CreateDatasetVersionRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
+func (p *BatchGetDatasetVersionsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("version_ids", thrift.LIST, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.DatasetID); err != nil {
+	if err := oprot.WriteListBegin(thrift.I64, len(p.VersionIds)); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *CreateDatasetVersionRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("version", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
+	for _, v := range p.VersionIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
 	}
-	if err := oprot.WriteString(p.Version); err != nil {
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -11260,16 +11345,16 @@ func (p *CreateDatasetVersionRequest) writeField3(oprot thrift.TProtocol) (err e
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *CreateDatasetVersionRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDesc() {
-		if err = oprot.WriteFieldBegin("desc", thrift.STRING, 4); err != nil {
+func (p *BatchGetDatasetVersionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWithDeleted() {
+		if err = oprot.WriteFieldBegin("with_deleted", thrift.BOOL, 10); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Desc); err != nil {
+		if err := oprot.WriteBool(*p.WithDeleted); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11278,11 +11363,11 @@ func (p *CreateDatasetVersionRequest) writeField4(oprot thrift.TProtocol) (err e
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
-func (p *CreateDatasetVersionRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetVersionsRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -11301,15 +11386,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *CreateDatasetVersionRequest) String() string {
+func (p *BatchGetDatasetVersionsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateDatasetVersionRequest(%+v)", *p)
+	return fmt.Sprintf("BatchGetDatasetVersionsRequest(%+v)", *p)
 
 }
 
-func (p *CreateDatasetVersionRequest) DeepEqual(ano *CreateDatasetVersionRequest) bool {
+func (p *BatchGetDatasetVersionsRequest) DeepEqual(ano *BatchGetDatasetVersionsRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11318,13 +11403,10 @@ func (p *CreateDatasetVersionRequest) DeepEqual(ano *CreateDatasetVersionRequest
 	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.DatasetID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Version) {
+	if !p.Field2DeepEqual(ano.VersionIds) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.Desc) {
+	if !p.Field10DeepEqual(ano.WithDeleted) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -11333,7 +11415,7 @@ func (p *CreateDatasetVersionRequest) DeepEqual(ano *CreateDatasetVersionRequest
 	return true
 }
 
-func (p *CreateDatasetVersionRequest) Field1DeepEqual(src *int64) bool {
+func (p *BatchGetDatasetVersionsRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -11345,33 +11427,32 @@ func (p *CreateDatasetVersionRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *CreateDatasetVersionRequest) Field2DeepEqual(src int64) bool {
+func (p *BatchGetDatasetVersionsRequest) Field2DeepEqual(src []int64) bool {
 
-	if p.DatasetID != src {
+	if len(p.VersionIds) != len(src) {
 		return false
 	}
-	return true
-}
-func (p *CreateDatasetVersionRequest) Field3DeepEqual(src string) bool {
-
-	if strings.Compare(p.Version, src) != 0 {
-		return false
+	for i, v := range p.VersionIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
-func (p *CreateDatasetVersionRequest) Field4DeepEqual(src *string) bool {
+func (p *BatchGetDatasetVersionsRequest) Field10DeepEqual(src *bool) bool {
 
-	if p.Desc == src {
+	if p.WithDeleted == src {
 		return true
-	} else if p.Desc == nil || src == nil {
+	} else if p.WithDeleted == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Desc, *src) != 0 {
+	if *p.WithDeleted != *src {
 		return false
 	}
 	return true
 }
-func (p *CreateDatasetVersionRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *BatchGetDatasetVersionsRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -11379,62 +11460,62 @@ func (p *CreateDatasetVersionRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type CreateDatasetVersionResponse struct {
-	ID       *int64         `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
-	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+type BatchGetDatasetVersionsResponse struct {
+	VersionedDataset []*VersionedDataset `thrift:"versioned_dataset,1,optional" frugal:"1,optional,list<VersionedDataset>" form:"versioned_dataset" json:"versioned_dataset,omitempty" query:"versioned_dataset"`
+	BaseResp         *base.BaseResp      `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewCreateDatasetVersionResponse() *CreateDatasetVersionResponse {
-	return &CreateDatasetVersionResponse{}
+func NewBatchGetDatasetVersionsResponse() *BatchGetDatasetVersionsResponse {
+	return &BatchGetDatasetVersionsResponse{}
 }
 
-func (p *CreateDatasetVersionResponse) InitDefault() {
+func (p *BatchGetDatasetVersionsResponse) InitDefault() {
 }
 
-var CreateDatasetVersionResponse_ID_DEFAULT int64
+var BatchGetDatasetVersionsResponse_VersionedDataset_DEFAULT []*VersionedDataset
 
-func (p *CreateDatasetVersionResponse) GetID() (v int64) {
+func (p *BatchGetDatasetVersionsResponse) GetVersionedDataset() (v []*VersionedDataset) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetID() {
-		return CreateDatasetVersionResponse_ID_DEFAULT
+	if !p.IsSetVersionedDataset() {
+		return BatchGetDatasetVersionsResponse_VersionedDataset_DEFAULT
 	}
-	return *p.ID
+	return p.VersionedDataset
 }
 
-var CreateDatasetVersionResponse_BaseResp_DEFAULT *base.BaseResp
+var BatchGetDatasetVersionsResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *CreateDatasetVersionResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *BatchGetDatasetVersionsResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return CreateDatasetVersionResponse_BaseResp_DEFAULT
+		return BatchGetDatasetVersionsResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *CreateDatasetVersionResponse) SetID(val *int64) {
-	p.ID = val
+func (p *BatchGetDatasetVersionsResponse) SetVersionedDataset(val []*VersionedDataset) {
+	p.VersionedDataset = val
 }
-func (p *CreateDatasetVersionResponse) SetBaseResp(val *base.BaseResp) {
+func (p *BatchGetDatasetVersionsResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_CreateDatasetVersionResponse = map[int16]string{
-	1:   "id",
+var fieldIDToName_BatchGetDatasetVersionsResponse = map[int16]string{
+	1:   "versioned_dataset",
 	255: "BaseResp",
 }
 
-func (p *CreateDatasetVersionResponse) IsSetID() bool {
-	return p.ID != nil
+func (p *BatchGetDatasetVersionsResponse) IsSetVersionedDataset() bool {
+	return p.VersionedDataset != nil
 }
 
-func (p *CreateDatasetVersionResponse) IsSetBaseResp() bool {
+func (p *BatchGetDatasetVersionsResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *CreateDatasetVersionResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetVersionsResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11453,7 +11534,7 @@ func (p *CreateDatasetVersionResponse) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -11487,7 +11568,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateDatasetVersionResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetVersionsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11497,18 +11578,30 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateDatasetVersionResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetVersionsResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*VersionedDataset, 0, size)
+	values := make([]VersionedDataset, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.ID = _field
+	p.VersionedDataset = _field
 	return nil
 }
-func (p *CreateDatasetVersionResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetVersionsResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -11517,9 +11610,9 @@ func (p *CreateDatasetVersionResponse) ReadField255(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *CreateDatasetVersionResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetVersionsResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateDatasetVersionResponse"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetDatasetVersionsResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11549,12 +11642,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateDatasetVersionResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *BatchGetDatasetVersionsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersionedDataset() {
+		if err = oprot.WriteFieldBegin("versioned_dataset", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ID); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.VersionedDataset)); err != nil {
+			return err
+		}
+		for _, v := range p.VersionedDataset {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11567,7 +11668,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *CreateDatasetVersionResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetVersionsResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11584,21 +11685,21 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *CreateDatasetVersionResponse) String() string {
+func (p *BatchGetDatasetVersionsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateDatasetVersionResponse(%+v)", *p)
+	return fmt.Sprintf("BatchGetDatasetVersionsResponse(%+v)", *p)
 
 }
 
-func (p *CreateDatasetVersionResponse) DeepEqual(ano *CreateDatasetVersionResponse) bool {
+func (p *BatchGetDatasetVersionsResponse) DeepEqual(ano *BatchGetDatasetVersionsResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ID) {
+	if !p.Field1DeepEqual(ano.VersionedDataset) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
@@ -11607,19 +11708,20 @@ func (p *CreateDatasetVersionResponse) DeepEqual(ano *CreateDatasetVersionRespon
 	return true
 }
 
-func (p *CreateDatasetVersionResponse) Field1DeepEqual(src *int64) bool {
+func (p *BatchGetDatasetVersionsResponse) Field1DeepEqual(src []*VersionedDataset) bool {
 
-	if p.ID == src {
-		return true
-	} else if p.ID == nil || src == nil {
+	if len(p.VersionedDataset) != len(src) {
 		return false
 	}
-	if *p.ID != *src {
-		return false
+	for i, v := range p.VersionedDataset {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *CreateDatasetVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *BatchGetDatasetVersionsResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -11627,100 +11729,112 @@ func (p *CreateDatasetVersionResponse) Field255DeepEqual(src *base.BaseResp) boo
 	return true
 }
 
-type UpdateDatasetSchemaRequest struct {
+type CreateDatasetVersionRequest struct {
 	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
 	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	// fieldSchema.key 为空时：插入新的一列
-	// fieldSchema.key 不为空时：更新对应的列
-	Fields []*dataset.FieldSchema `thrift:"fields,3,optional" frugal:"3,optional,list<dataset.FieldSchema>" form:"fields" json:"fields,omitempty" query:"fields"`
-	Base   *base.Base             `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	// 展示的版本号，SemVer2 三段式，需要大于上一版本
+	Version string     `thrift:"version,3,required" frugal:"3,required,string" form:"version,required" json:"version,required" query:"version,required"`
+	Desc    *string    `thrift:"desc,4,optional" frugal:"4,optional,string" form:"desc" json:"desc,omitempty" query:"desc"`
+	Base    *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewUpdateDatasetSchemaRequest() *UpdateDatasetSchemaRequest {
-	return &UpdateDatasetSchemaRequest{}
+func NewCreateDatasetVersionRequest() *CreateDatasetVersionRequest {
+	return &CreateDatasetVersionRequest{}
 }
 
-func (p *UpdateDatasetSchemaRequest) InitDefault() {
+func (p *CreateDatasetVersionRequest) InitDefault() {
 }
 
-var UpdateDatasetSchemaRequest_WorkspaceID_DEFAULT int64
+var CreateDatasetVersionRequest_WorkspaceID_DEFAULT int64
 
-func (p *UpdateDatasetSchemaRequest) GetWorkspaceID() (v int64) {
+func (p *CreateDatasetVersionRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return UpdateDatasetSchemaRequest_WorkspaceID_DEFAULT
+		return CreateDatasetVersionRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *UpdateDatasetSchemaRequest) GetDatasetID() (v int64) {
+func (p *CreateDatasetVersionRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-var UpdateDatasetSchemaRequest_Fields_DEFAULT []*dataset.FieldSchema
+func (p *CreateDatasetVersionRequest) GetVersion() (v string) {
+	if p != nil {
+		return p.Version
+	}
+	return
+}
 
-func (p *UpdateDatasetSchemaRequest) GetFields() (v []*dataset.FieldSchema) {
+var CreateDatasetVersionRequest_Desc_DEFAULT string
+
+func (p *CreateDatasetVersionRequest) GetDesc() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFields() {
-		return UpdateDatasetSchemaRequest_Fields_DEFAULT
+	if !p.IsSetDesc() {
+		return CreateDatasetVersionRequest_Desc_DEFAULT
 	}
-	return p.Fields
+	return *p.Desc
 }
 
-var UpdateDatasetSchemaRequest_Base_DEFAULT *base.Base
+var CreateDatasetVersionRequest_Base_DEFAULT *base.Base
 
-func (p *UpdateDatasetSchemaRequest) GetBase() (v *base.Base) {
+func (p *CreateDatasetVersionRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return UpdateDatasetSchemaRequest_Base_DEFAULT
+		return CreateDatasetVersionRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *UpdateDatasetSchemaRequest) SetWorkspaceID(val *int64) {
+func (p *CreateDatasetVersionRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *UpdateDatasetSchemaRequest) SetDatasetID(val int64) {
+func (p *CreateDatasetVersionRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *UpdateDatasetSchemaRequest) SetFields(val []*dataset.FieldSchema) {
-	p.Fields = val
+func (p *CreateDatasetVersionRequest) SetVersion(val string) {
+	p.Version = val
 }
-func (p *UpdateDatasetSchemaRequest) SetBase(val *base.Base) {
+func (p *CreateDatasetVersionRequest) SetDesc(val *string) {
+	p.Desc = val
+}
+func (p *CreateDatasetVersionRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_UpdateDatasetSchemaRequest = map[int16]string{
+var fieldIDToName_CreateDatasetVersionRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	3:   "fields",
+	3:   "version",
+	4:   "desc",
 	255: "Base",
 }
 
-func (p *UpdateDatasetSchemaRequest) IsSetWorkspaceID() bool {
+func (p *CreateDatasetVersionRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *UpdateDatasetSchemaRequest) IsSetFields() bool {
-	return p.Fields != nil
+func (p *CreateDatasetVersionRequest) IsSetDesc() bool {
+	return p.Desc != nil
 }
 
-func (p *UpdateDatasetSchemaRequest) IsSetBase() bool {
+func (p *CreateDatasetVersionRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *UpdateDatasetSchemaRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *CreateDatasetVersionRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
+	var issetVersion bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -11754,10 +11868,19 @@ func (p *UpdateDatasetSchemaRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetVersion = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -11786,13 +11909,18 @@ func (p *UpdateDatasetSchemaRequest) Read(iprot thrift.TProtocol) (err error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetVersion {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateDatasetSchemaRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateDatasetVersionRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11801,10 +11929,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateDatasetSchemaRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreateDatasetVersionRequest[fieldId]))
 }
 
-func (p *UpdateDatasetSchemaRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *CreateDatasetVersionRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -11815,7 +11943,7 @@ func (p *UpdateDatasetSchemaRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *UpdateDatasetSchemaRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *CreateDatasetVersionRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -11826,30 +11954,29 @@ func (p *UpdateDatasetSchemaRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.DatasetID = _field
 	return nil
 }
-func (p *UpdateDatasetSchemaRequest) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *CreateDatasetVersionRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	_field := make([]*dataset.FieldSchema, 0, size)
-	values := make([]dataset.FieldSchema, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.Version = _field
+	return nil
+}
+func (p *CreateDatasetVersionRequest) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Fields = _field
+	p.Desc = _field
 	return nil
 }
-func (p *UpdateDatasetSchemaRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *CreateDatasetVersionRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -11858,9 +11985,9 @@ func (p *UpdateDatasetSchemaRequest) ReadField255(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UpdateDatasetSchemaRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *CreateDatasetVersionRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateDatasetSchemaRequest"); err != nil {
+	if err = oprot.WriteStructBegin("CreateDatasetVersionRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11876,6 +12003,10 @@ func (p *UpdateDatasetSchemaRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -11898,7 +12029,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateDatasetSchemaRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CreateDatasetVersionRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -11916,7 +12047,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdateDatasetSchemaRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CreateDatasetVersionRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11932,20 +12063,28 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UpdateDatasetSchemaRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFields() {
-		if err = oprot.WriteFieldBegin("fields", thrift.LIST, 3); err != nil {
+func (p *CreateDatasetVersionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("version", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Version); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *CreateDatasetVersionRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDesc() {
+		if err = oprot.WriteFieldBegin("desc", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Fields)); err != nil {
-			return err
-		}
-		for _, v := range p.Fields {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.Desc); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11954,11 +12093,11 @@ func (p *UpdateDatasetSchemaRequest) writeField3(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *UpdateDatasetSchemaRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *CreateDatasetVersionRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -11977,15 +12116,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *UpdateDatasetSchemaRequest) String() string {
+func (p *CreateDatasetVersionRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateDatasetSchemaRequest(%+v)", *p)
+	return fmt.Sprintf("CreateDatasetVersionRequest(%+v)", *p)
 
 }
 
-func (p *UpdateDatasetSchemaRequest) DeepEqual(ano *UpdateDatasetSchemaRequest) bool {
+func (p *CreateDatasetVersionRequest) DeepEqual(ano *CreateDatasetVersionRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -11997,7 +12136,10 @@ func (p *UpdateDatasetSchemaRequest) DeepEqual(ano *UpdateDatasetSchemaRequest)
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Fields) {
+	if !p.Field3DeepEqual(ano.Version) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Desc) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -12006,7 +12148,7 @@ func (p *UpdateDatasetSchemaRequest) DeepEqual(ano *UpdateDatasetSchemaRequest)
 	return true
 }
 
-func (p *UpdateDatasetSchemaRequest) Field1DeepEqual(src *int64) bool {
+func (p *CreateDatasetVersionRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -12018,27 +12160,33 @@ func (p *UpdateDatasetSchemaRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *UpdateDatasetSchemaRequest) Field2DeepEqual(src int64) bool {
+func (p *CreateDatasetVersionRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *UpdateDatasetSchemaRequest) Field3DeepEqual(src []*dataset.FieldSchema) bool {
+func (p *CreateDatasetVersionRequest) Field3DeepEqual(src string) bool {
 
-	if len(p.Fields) != len(src) {
+	if strings.Compare(p.Version, src) != 0 {
 		return false
 	}
-	for i, v := range p.Fields {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	return true
+}
+func (p *CreateDatasetVersionRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Desc == src {
+		return true
+	} else if p.Desc == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Desc, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *UpdateDatasetSchemaRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *CreateDatasetVersionRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -12046,41 +12194,62 @@ func (p *UpdateDatasetSchemaRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type UpdateDatasetSchemaResponse struct {
+type CreateDatasetVersionResponse struct {
+	ID       *int64         `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
 	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewUpdateDatasetSchemaResponse() *UpdateDatasetSchemaResponse {
-	return &UpdateDatasetSchemaResponse{}
+func NewCreateDatasetVersionResponse() *CreateDatasetVersionResponse {
+	return &CreateDatasetVersionResponse{}
 }
 
-func (p *UpdateDatasetSchemaResponse) InitDefault() {
+func (p *CreateDatasetVersionResponse) InitDefault() {
 }
 
-var UpdateDatasetSchemaResponse_BaseResp_DEFAULT *base.BaseResp
+var CreateDatasetVersionResponse_ID_DEFAULT int64
 
-func (p *UpdateDatasetSchemaResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *CreateDatasetVersionResponse) GetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return CreateDatasetVersionResponse_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var CreateDatasetVersionResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *CreateDatasetVersionResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return UpdateDatasetSchemaResponse_BaseResp_DEFAULT
+		return CreateDatasetVersionResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *UpdateDatasetSchemaResponse) SetBaseResp(val *base.BaseResp) {
+func (p *CreateDatasetVersionResponse) SetID(val *int64) {
+	p.ID = val
+}
+func (p *CreateDatasetVersionResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_UpdateDatasetSchemaResponse = map[int16]string{
+var fieldIDToName_CreateDatasetVersionResponse = map[int16]string{
+	1:   "id",
 	255: "BaseResp",
 }
 
-func (p *UpdateDatasetSchemaResponse) IsSetBaseResp() bool {
+func (p *CreateDatasetVersionResponse) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *CreateDatasetVersionResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *UpdateDatasetSchemaResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *CreateDatasetVersionResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12098,6 +12267,14 @@ func (p *UpdateDatasetSchemaResponse) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -12125,7 +12302,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateDatasetSchemaResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateDatasetVersionResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12135,7 +12312,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateDatasetSchemaResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *CreateDatasetVersionResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *CreateDatasetVersionResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -12144,12 +12332,16 @@ func (p *UpdateDatasetSchemaResponse) ReadField255(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UpdateDatasetSchemaResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CreateDatasetVersionResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateDatasetSchemaResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CreateDatasetVersionResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -12172,7 +12364,25 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateDatasetSchemaResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *CreateDatasetVersionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateDatasetVersionResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12189,27 +12399,42 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *UpdateDatasetSchemaResponse) String() string {
+func (p *CreateDatasetVersionResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateDatasetSchemaResponse(%+v)", *p)
+	return fmt.Sprintf("CreateDatasetVersionResponse(%+v)", *p)
 
 }
 
-func (p *UpdateDatasetSchemaResponse) DeepEqual(ano *UpdateDatasetSchemaResponse) bool {
+func (p *CreateDatasetVersionResponse) DeepEqual(ano *CreateDatasetVersionResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
 		return false
 	}
 	return true
 }
 
-func (p *UpdateDatasetSchemaResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *CreateDatasetVersionResponse) Field1DeepEqual(src *int64) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if *p.ID != *src {
+		return false
+	}
+	return true
+}
+func (p *CreateDatasetVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -12217,96 +12442,97 @@ func (p *UpdateDatasetSchemaResponse) Field255DeepEqual(src *base.BaseResp) bool
 	return true
 }
 
-type GetDatasetSchemaRequest struct {
-	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
+type UpdateDatasetSchemaRequest struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
 	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	// 是否获取已经删除的列，默认不返回
-	WithDeleted *bool      `thrift:"with_deleted,10,optional" frugal:"10,optional,bool" json:"with_deleted,omitempty" query:"with_deleted"`
-	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	// fieldSchema.key 为空时：插入新的一列
+	// fieldSchema.key 不为空时：更新对应的列
+	Fields []*dataset.FieldSchema `thrift:"fields,3,optional" frugal:"3,optional,list<dataset.FieldSchema>" form:"fields" json:"fields,omitempty" query:"fields"`
+	Base   *base.Base             `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewGetDatasetSchemaRequest() *GetDatasetSchemaRequest {
-	return &GetDatasetSchemaRequest{}
+func NewUpdateDatasetSchemaRequest() *UpdateDatasetSchemaRequest {
+	return &UpdateDatasetSchemaRequest{}
 }
 
-func (p *GetDatasetSchemaRequest) InitDefault() {
+func (p *UpdateDatasetSchemaRequest) InitDefault() {
 }
 
-var GetDatasetSchemaRequest_WorkspaceID_DEFAULT int64
+var UpdateDatasetSchemaRequest_WorkspaceID_DEFAULT int64
 
-func (p *GetDatasetSchemaRequest) GetWorkspaceID() (v int64) {
+func (p *UpdateDatasetSchemaRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return GetDatasetSchemaRequest_WorkspaceID_DEFAULT
+		return UpdateDatasetSchemaRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *GetDatasetSchemaRequest) GetDatasetID() (v int64) {
+func (p *UpdateDatasetSchemaRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-var GetDatasetSchemaRequest_WithDeleted_DEFAULT bool
+var UpdateDatasetSchemaRequest_Fields_DEFAULT []*dataset.FieldSchema
 
-func (p *GetDatasetSchemaRequest) GetWithDeleted() (v bool) {
+func (p *UpdateDatasetSchemaRequest) GetFields() (v []*dataset.FieldSchema) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetWithDeleted() {
-		return GetDatasetSchemaRequest_WithDeleted_DEFAULT
+	if !p.IsSetFields() {
+		return UpdateDatasetSchemaRequest_Fields_DEFAULT
 	}
-	return *p.WithDeleted
+	return p.Fields
 }
 
-var GetDatasetSchemaRequest_Base_DEFAULT *base.Base
+var UpdateDatasetSchemaRequest_Base_DEFAULT *base.Base
 
-func (p *GetDatasetSchemaRequest) GetBase() (v *base.Base) {
+func (p *UpdateDatasetSchemaRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return GetDatasetSchemaRequest_Base_DEFAULT
+		return UpdateDatasetSchemaRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *GetDatasetSchemaRequest) SetWorkspaceID(val *int64) {
+func (p *UpdateDatasetSchemaRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *GetDatasetSchemaRequest) SetDatasetID(val int64) {
+func (p *UpdateDatasetSchemaRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *GetDatasetSchemaRequest) SetWithDeleted(val *bool) {
-	p.WithDeleted = val
+func (p *UpdateDatasetSchemaRequest) SetFields(val []*dataset.FieldSchema) {
+	p.Fields = val
 }
-func (p *GetDatasetSchemaRequest) SetBase(val *base.Base) {
+func (p *UpdateDatasetSchemaRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_GetDatasetSchemaRequest = map[int16]string{
+var fieldIDToName_UpdateDatasetSchemaRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	10:  "with_deleted",
+	3:   "fields",
 	255: "Base",
 }
 
-func (p *GetDatasetSchemaRequest) IsSetWorkspaceID() bool {
+func (p *UpdateDatasetSchemaRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *GetDatasetSchemaRequest) IsSetWithDeleted() bool {
-	return p.WithDeleted != nil
+func (p *UpdateDatasetSchemaRequest) IsSetFields() bool {
+	return p.Fields != nil
 }
 
-func (p *GetDatasetSchemaRequest) IsSetBase() bool {
+func (p *UpdateDatasetSchemaRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetDatasetSchemaRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetSchemaRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
@@ -12342,9 +12568,9 @@ func (p *GetDatasetSchemaRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 10:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField10(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -12381,7 +12607,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetSchemaRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateDatasetSchemaRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12390,10 +12616,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetDatasetSchemaRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateDatasetSchemaRequest[fieldId]))
 }
 
-func (p *GetDatasetSchemaRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetSchemaRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -12404,7 +12630,7 @@ func (p *GetDatasetSchemaRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *GetDatasetSchemaRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetSchemaRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -12415,18 +12641,30 @@ func (p *GetDatasetSchemaRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.DatasetID = _field
 	return nil
 }
-func (p *GetDatasetSchemaRequest) ReadField10(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetSchemaRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset.FieldSchema, 0, size)
+	values := make([]dataset.FieldSchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.WithDeleted = _field
+	p.Fields = _field
 	return nil
 }
-func (p *GetDatasetSchemaRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetSchemaRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -12435,9 +12673,9 @@ func (p *GetDatasetSchemaRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetDatasetSchemaRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetSchemaRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDatasetSchemaRequest"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateDatasetSchemaRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12449,8 +12687,8 @@ func (p *GetDatasetSchemaRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
 			goto WriteFieldError
 		}
 		if err = p.writeField255(oprot); err != nil {
@@ -12475,7 +12713,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetDatasetSchemaRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetSchemaRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -12493,7 +12731,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetDatasetSchemaRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetSchemaRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12509,12 +12747,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetDatasetSchemaRequest) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWithDeleted() {
-		if err = oprot.WriteFieldBegin("with_deleted", thrift.BOOL, 10); err != nil {
+func (p *UpdateDatasetSchemaRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFields() {
+		if err = oprot.WriteFieldBegin("fields", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.WithDeleted); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Fields)); err != nil {
+			return err
+		}
+		for _, v := range p.Fields {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12523,11 +12769,11 @@ func (p *GetDatasetSchemaRequest) writeField10(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *GetDatasetSchemaRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetSchemaRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -12546,15 +12792,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *GetDatasetSchemaRequest) String() string {
+func (p *UpdateDatasetSchemaRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDatasetSchemaRequest(%+v)", *p)
+	return fmt.Sprintf("UpdateDatasetSchemaRequest(%+v)", *p)
 
 }
 
-func (p *GetDatasetSchemaRequest) DeepEqual(ano *GetDatasetSchemaRequest) bool {
+func (p *UpdateDatasetSchemaRequest) DeepEqual(ano *UpdateDatasetSchemaRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -12566,7 +12812,7 @@ func (p *GetDatasetSchemaRequest) DeepEqual(ano *GetDatasetSchemaRequest) bool {
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.WithDeleted) {
+	if !p.Field3DeepEqual(ano.Fields) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -12575,7 +12821,7 @@ func (p *GetDatasetSchemaRequest) DeepEqual(ano *GetDatasetSchemaRequest) bool {
 	return true
 }
 
-func (p *GetDatasetSchemaRequest) Field1DeepEqual(src *int64) bool {
+func (p *UpdateDatasetSchemaRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -12587,26 +12833,27 @@ func (p *GetDatasetSchemaRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *GetDatasetSchemaRequest) Field2DeepEqual(src int64) bool {
+func (p *UpdateDatasetSchemaRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *GetDatasetSchemaRequest) Field10DeepEqual(src *bool) bool {
+func (p *UpdateDatasetSchemaRequest) Field3DeepEqual(src []*dataset.FieldSchema) bool {
 
-	if p.WithDeleted == src {
-		return true
-	} else if p.WithDeleted == nil || src == nil {
+	if len(p.Fields) != len(src) {
 		return false
 	}
-	if *p.WithDeleted != *src {
-		return false
+	for i, v := range p.Fields {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *GetDatasetSchemaRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *UpdateDatasetSchemaRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -12614,62 +12861,41 @@ func (p *GetDatasetSchemaRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type GetDatasetSchemaResponse struct {
-	Fields   []*dataset.FieldSchema `thrift:"fields,1,optional" frugal:"1,optional,list<dataset.FieldSchema>" form:"fields" json:"fields,omitempty" query:"fields"`
-	BaseResp *base.BaseResp         `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
-}
-
-func NewGetDatasetSchemaResponse() *GetDatasetSchemaResponse {
-	return &GetDatasetSchemaResponse{}
+type UpdateDatasetSchemaResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func (p *GetDatasetSchemaResponse) InitDefault() {
+func NewUpdateDatasetSchemaResponse() *UpdateDatasetSchemaResponse {
+	return &UpdateDatasetSchemaResponse{}
 }
 
-var GetDatasetSchemaResponse_Fields_DEFAULT []*dataset.FieldSchema
-
-func (p *GetDatasetSchemaResponse) GetFields() (v []*dataset.FieldSchema) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetFields() {
-		return GetDatasetSchemaResponse_Fields_DEFAULT
-	}
-	return p.Fields
+func (p *UpdateDatasetSchemaResponse) InitDefault() {
 }
 
-var GetDatasetSchemaResponse_BaseResp_DEFAULT *base.BaseResp
+var UpdateDatasetSchemaResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *GetDatasetSchemaResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *UpdateDatasetSchemaResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return GetDatasetSchemaResponse_BaseResp_DEFAULT
+		return UpdateDatasetSchemaResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *GetDatasetSchemaResponse) SetFields(val []*dataset.FieldSchema) {
-	p.Fields = val
-}
-func (p *GetDatasetSchemaResponse) SetBaseResp(val *base.BaseResp) {
+func (p *UpdateDatasetSchemaResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_GetDatasetSchemaResponse = map[int16]string{
-	1:   "fields",
+var fieldIDToName_UpdateDatasetSchemaResponse = map[int16]string{
 	255: "BaseResp",
 }
 
-func (p *GetDatasetSchemaResponse) IsSetFields() bool {
-	return p.Fields != nil
-}
-
-func (p *GetDatasetSchemaResponse) IsSetBaseResp() bool {
+func (p *UpdateDatasetSchemaResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetDatasetSchemaResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetSchemaResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12687,14 +12913,6 @@ func (p *GetDatasetSchemaResponse) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -12722,7 +12940,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetSchemaResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateDatasetSchemaResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12732,30 +12950,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetDatasetSchemaResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*dataset.FieldSchema, 0, size)
-	values := make([]dataset.FieldSchema, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Fields = _field
-	return nil
-}
-func (p *GetDatasetSchemaResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetSchemaResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -12764,16 +12959,12 @@ func (p *GetDatasetSchemaResponse) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetDatasetSchemaResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetSchemaResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDatasetSchemaResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateDatasetSchemaResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -12796,33 +12987,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetDatasetSchemaResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFields() {
-		if err = oprot.WriteFieldBegin("fields", thrift.LIST, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Fields)); err != nil {
-			return err
-		}
-		for _, v := range p.Fields {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *GetDatasetSchemaResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetSchemaResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12839,43 +13004,27 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *GetDatasetSchemaResponse) String() string {
+func (p *UpdateDatasetSchemaResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDatasetSchemaResponse(%+v)", *p)
+	return fmt.Sprintf("UpdateDatasetSchemaResponse(%+v)", *p)
 
 }
 
-func (p *GetDatasetSchemaResponse) DeepEqual(ano *GetDatasetSchemaResponse) bool {
+func (p *UpdateDatasetSchemaResponse) DeepEqual(ano *UpdateDatasetSchemaResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Fields) {
-		return false
-	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
 		return false
 	}
 	return true
 }
 
-func (p *GetDatasetSchemaResponse) Field1DeepEqual(src []*dataset.FieldSchema) bool {
-
-	if len(p.Fields) != len(src) {
-		return false
-	}
-	for i, v := range p.Fields {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-func (p *GetDatasetSchemaResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *UpdateDatasetSchemaResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -12883,173 +13032,99 @@ func (p *GetDatasetSchemaResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type ValidateDatasetItemsReq struct {
-	WorkspaceID *int64                 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	Items       []*dataset.DatasetItem `thrift:"items,2,optional" frugal:"2,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
-	// 添加到已有数据集时提供
-	DatasetID *int64 `thrift:"dataset_id,3,optional" frugal:"3,optional,i64" json:"dataset_id" path:"dataset_id" `
-	// 新建数据集并添加数据时提供
-	DatasetCategory *dataset.DatasetCategory `thrift:"dataset_category,4,optional" frugal:"4,optional,DatasetCategory" form:"dataset_category" json:"dataset_category,omitempty" query:"dataset_category"`
-	// 新建数据集并添加数据时，必须提供；添加到已有数据集时，如非空，则覆盖已有 schema 用于校验
-	DatasetFields []*dataset.FieldSchema `thrift:"dataset_fields,5,optional" frugal:"5,optional,list<dataset.FieldSchema>" form:"dataset_fields" json:"dataset_fields,omitempty" query:"dataset_fields"`
-	// 添加到已有数据集时，现有数据条数，做容量校验时不做考虑，仅考虑提供 items 数量是否超限
-	IgnoreCurrentItemCount *bool      `thrift:"ignore_current_item_count,10,optional" frugal:"10,optional,bool" form:"ignore_current_item_count" json:"ignore_current_item_count,omitempty" query:"ignore_current_item_count"`
-	Base                   *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+type GetDatasetSchemaRequest struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
+	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	// 是否获取已经删除的列，默认不返回
+	WithDeleted *bool      `thrift:"with_deleted,10,optional" frugal:"10,optional,bool" json:"with_deleted,omitempty" query:"with_deleted"`
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewValidateDatasetItemsReq() *ValidateDatasetItemsReq {
-	return &ValidateDatasetItemsReq{}
+func NewGetDatasetSchemaRequest() *GetDatasetSchemaRequest {
+	return &GetDatasetSchemaRequest{}
 }
 
-func (p *ValidateDatasetItemsReq) InitDefault() {
+func (p *GetDatasetSchemaRequest) InitDefault() {
 }
 
-var ValidateDatasetItemsReq_WorkspaceID_DEFAULT int64
+var GetDatasetSchemaRequest_WorkspaceID_DEFAULT int64
 
-func (p *ValidateDatasetItemsReq) GetWorkspaceID() (v int64) {
+func (p *GetDatasetSchemaRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return ValidateDatasetItemsReq_WorkspaceID_DEFAULT
+		return GetDatasetSchemaRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-var ValidateDatasetItemsReq_Items_DEFAULT []*dataset.DatasetItem
-
-func (p *ValidateDatasetItemsReq) GetItems() (v []*dataset.DatasetItem) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetItems() {
-		return ValidateDatasetItemsReq_Items_DEFAULT
+func (p *GetDatasetSchemaRequest) GetDatasetID() (v int64) {
+	if p != nil {
+		return p.DatasetID
 	}
-	return p.Items
+	return
 }
 
-var ValidateDatasetItemsReq_DatasetID_DEFAULT int64
+var GetDatasetSchemaRequest_WithDeleted_DEFAULT bool
 
-func (p *ValidateDatasetItemsReq) GetDatasetID() (v int64) {
+func (p *GetDatasetSchemaRequest) GetWithDeleted() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDatasetID() {
-		return ValidateDatasetItemsReq_DatasetID_DEFAULT
+	if !p.IsSetWithDeleted() {
+		return GetDatasetSchemaRequest_WithDeleted_DEFAULT
 	}
-	return *p.DatasetID
+	return *p.WithDeleted
 }
 
-var ValidateDatasetItemsReq_DatasetCategory_DEFAULT dataset.DatasetCategory
+var GetDatasetSchemaRequest_Base_DEFAULT *base.Base
 
-func (p *ValidateDatasetItemsReq) GetDatasetCategory() (v dataset.DatasetCategory) {
+func (p *GetDatasetSchemaRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDatasetCategory() {
-		return ValidateDatasetItemsReq_DatasetCategory_DEFAULT
+	if !p.IsSetBase() {
+		return GetDatasetSchemaRequest_Base_DEFAULT
 	}
-	return *p.DatasetCategory
+	return p.Base
+}
+func (p *GetDatasetSchemaRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *GetDatasetSchemaRequest) SetDatasetID(val int64) {
+	p.DatasetID = val
+}
+func (p *GetDatasetSchemaRequest) SetWithDeleted(val *bool) {
+	p.WithDeleted = val
+}
+func (p *GetDatasetSchemaRequest) SetBase(val *base.Base) {
+	p.Base = val
 }
 
-var ValidateDatasetItemsReq_DatasetFields_DEFAULT []*dataset.FieldSchema
+var fieldIDToName_GetDatasetSchemaRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "dataset_id",
+	10:  "with_deleted",
+	255: "Base",
+}
 
-func (p *ValidateDatasetItemsReq) GetDatasetFields() (v []*dataset.FieldSchema) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetDatasetFields() {
-		return ValidateDatasetItemsReq_DatasetFields_DEFAULT
-	}
-	return p.DatasetFields
+func (p *GetDatasetSchemaRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
 }
 
-var ValidateDatasetItemsReq_IgnoreCurrentItemCount_DEFAULT bool
+func (p *GetDatasetSchemaRequest) IsSetWithDeleted() bool {
+	return p.WithDeleted != nil
+}
 
-func (p *ValidateDatasetItemsReq) GetIgnoreCurrentItemCount() (v bool) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetIgnoreCurrentItemCount() {
-		return ValidateDatasetItemsReq_IgnoreCurrentItemCount_DEFAULT
-	}
-	return *p.IgnoreCurrentItemCount
-}
-
-var ValidateDatasetItemsReq_Base_DEFAULT *base.Base
-
-func (p *ValidateDatasetItemsReq) GetBase() (v *base.Base) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetBase() {
-		return ValidateDatasetItemsReq_Base_DEFAULT
-	}
-	return p.Base
-}
-func (p *ValidateDatasetItemsReq) SetWorkspaceID(val *int64) {
-	p.WorkspaceID = val
-}
-func (p *ValidateDatasetItemsReq) SetItems(val []*dataset.DatasetItem) {
-	p.Items = val
-}
-func (p *ValidateDatasetItemsReq) SetDatasetID(val *int64) {
-	p.DatasetID = val
-}
-func (p *ValidateDatasetItemsReq) SetDatasetCategory(val *dataset.DatasetCategory) {
-	p.DatasetCategory = val
-}
-func (p *ValidateDatasetItemsReq) SetDatasetFields(val []*dataset.FieldSchema) {
-	p.DatasetFields = val
-}
-func (p *ValidateDatasetItemsReq) SetIgnoreCurrentItemCount(val *bool) {
-	p.IgnoreCurrentItemCount = val
-}
-func (p *ValidateDatasetItemsReq) SetBase(val *base.Base) {
-	p.Base = val
-}
-
-var fieldIDToName_ValidateDatasetItemsReq = map[int16]string{
-	1:   "workspace_id",
-	2:   "items",
-	3:   "dataset_id",
-	4:   "dataset_category",
-	5:   "dataset_fields",
-	10:  "ignore_current_item_count",
-	255: "Base",
-}
-
-func (p *ValidateDatasetItemsReq) IsSetWorkspaceID() bool {
-	return p.WorkspaceID != nil
-}
-
-func (p *ValidateDatasetItemsReq) IsSetItems() bool {
-	return p.Items != nil
-}
-
-func (p *ValidateDatasetItemsReq) IsSetDatasetID() bool {
-	return p.DatasetID != nil
-}
-
-func (p *ValidateDatasetItemsReq) IsSetDatasetCategory() bool {
-	return p.DatasetCategory != nil
-}
-
-func (p *ValidateDatasetItemsReq) IsSetDatasetFields() bool {
-	return p.DatasetFields != nil
-}
-
-func (p *ValidateDatasetItemsReq) IsSetIgnoreCurrentItemCount() bool {
-	return p.IgnoreCurrentItemCount != nil
-}
-
-func (p *ValidateDatasetItemsReq) IsSetBase() bool {
+func (p *GetDatasetSchemaRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *ValidateDatasetItemsReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetDatasetSchemaRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetDatasetID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -13074,34 +13149,11 @@ func (p *ValidateDatasetItemsReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField5(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetDatasetID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -13134,13 +13186,17 @@ func (p *ValidateDatasetItemsReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetDatasetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateDatasetItemsReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetSchemaRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13148,9 +13204,11 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetDatasetSchemaRequest[fieldId]))
 }
 
-func (p *ValidateDatasetItemsReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetDatasetSchemaRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -13161,76 +13219,18 @@ func (p *ValidateDatasetItemsReq) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *ValidateDatasetItemsReq) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*dataset.DatasetItem, 0, size)
-	values := make([]dataset.DatasetItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Items = _field
-	return nil
-}
-func (p *ValidateDatasetItemsReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *GetDatasetSchemaRequest) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
 	p.DatasetID = _field
 	return nil
 }
-func (p *ValidateDatasetItemsReq) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *dataset.DatasetCategory
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		tmp := dataset.DatasetCategory(v)
-		_field = &tmp
-	}
-	p.DatasetCategory = _field
-	return nil
-}
-func (p *ValidateDatasetItemsReq) ReadField5(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*dataset.FieldSchema, 0, size)
-	values := make([]dataset.FieldSchema, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.DatasetFields = _field
-	return nil
-}
-func (p *ValidateDatasetItemsReq) ReadField10(iprot thrift.TProtocol) error {
+func (p *GetDatasetSchemaRequest) ReadField10(iprot thrift.TProtocol) error {
 
 	var _field *bool
 	if v, err := iprot.ReadBool(); err != nil {
@@ -13238,10 +13238,10 @@ func (p *ValidateDatasetItemsReq) ReadField10(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.IgnoreCurrentItemCount = _field
+	p.WithDeleted = _field
 	return nil
 }
-func (p *ValidateDatasetItemsReq) ReadField255(iprot thrift.TProtocol) error {
+func (p *GetDatasetSchemaRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -13250,9 +13250,9 @@ func (p *ValidateDatasetItemsReq) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ValidateDatasetItemsReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetSchemaRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ValidateDatasetItemsReq"); err != nil {
+	if err = oprot.WriteStructBegin("GetDatasetSchemaRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13264,18 +13264,6 @@ func (p *ValidateDatasetItemsReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 		if err = p.writeField10(oprot); err != nil {
 			fieldId = 10
 			goto WriteFieldError
@@ -13302,7 +13290,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ValidateDatasetItemsReq) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetSchemaRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -13320,25 +13308,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ValidateDatasetItemsReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItems() {
-		if err = oprot.WriteFieldBegin("items", thrift.LIST, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
-			return err
-		}
-		for _, v := range p.Items {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetDatasetSchemaRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DatasetID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -13346,12 +13324,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ValidateDatasetItemsReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDatasetID() {
-		if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 3); err != nil {
+func (p *GetDatasetSchemaRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWithDeleted() {
+		if err = oprot.WriteFieldBegin("with_deleted", thrift.BOOL, 10); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.DatasetID); err != nil {
+		if err := oprot.WriteBool(*p.WithDeleted); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13360,16 +13338,16 @@ func (p *ValidateDatasetItemsReq) writeField3(oprot thrift.TProtocol) (err error
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
-func (p *ValidateDatasetItemsReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDatasetCategory() {
-		if err = oprot.WriteFieldBegin("dataset_category", thrift.I32, 4); err != nil {
+func (p *GetDatasetSchemaRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.DatasetCategory)); err != nil {
+		if err := p.Base.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13378,82 +13356,20 @@ func (p *ValidateDatasetItemsReq) writeField4(oprot thrift.TProtocol) (err error
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ValidateDatasetItemsReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDatasetFields() {
-		if err = oprot.WriteFieldBegin("dataset_fields", thrift.LIST, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.DatasetFields)); err != nil {
-			return err
-		}
-		for _, v := range p.DatasetFields {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *ValidateDatasetItemsReq) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIgnoreCurrentItemCount() {
-		if err = oprot.WriteFieldBegin("ignore_current_item_count", thrift.BOOL, 10); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBool(*p.IgnoreCurrentItemCount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
-}
-func (p *ValidateDatasetItemsReq) writeField255(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBase() {
-		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Base.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ValidateDatasetItemsReq) String() string {
+func (p *GetDatasetSchemaRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ValidateDatasetItemsReq(%+v)", *p)
+	return fmt.Sprintf("GetDatasetSchemaRequest(%+v)", *p)
 
 }
 
-func (p *ValidateDatasetItemsReq) DeepEqual(ano *ValidateDatasetItemsReq) bool {
+func (p *GetDatasetSchemaRequest) DeepEqual(ano *GetDatasetSchemaRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -13462,19 +13378,10 @@ func (p *ValidateDatasetItemsReq) DeepEqual(ano *ValidateDatasetItemsReq) bool {
 	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Items) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.DatasetID) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.DatasetCategory) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.DatasetFields) {
+	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.IgnoreCurrentItemCount) {
+	if !p.Field10DeepEqual(ano.WithDeleted) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -13483,7 +13390,7 @@ func (p *ValidateDatasetItemsReq) DeepEqual(ano *ValidateDatasetItemsReq) bool {
 	return true
 }
 
-func (p *ValidateDatasetItemsReq) Field1DeepEqual(src *int64) bool {
+func (p *GetDatasetSchemaRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -13495,69 +13402,26 @@ func (p *ValidateDatasetItemsReq) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *ValidateDatasetItemsReq) Field2DeepEqual(src []*dataset.DatasetItem) bool {
-
-	if len(p.Items) != len(src) {
-		return false
-	}
-	for i, v := range p.Items {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-func (p *ValidateDatasetItemsReq) Field3DeepEqual(src *int64) bool {
-
-	if p.DatasetID == src {
-		return true
-	} else if p.DatasetID == nil || src == nil {
-		return false
-	}
-	if *p.DatasetID != *src {
-		return false
-	}
-	return true
-}
-func (p *ValidateDatasetItemsReq) Field4DeepEqual(src *dataset.DatasetCategory) bool {
-
-	if p.DatasetCategory == src {
-		return true
-	} else if p.DatasetCategory == nil || src == nil {
-		return false
-	}
-	if *p.DatasetCategory != *src {
-		return false
-	}
-	return true
-}
-func (p *ValidateDatasetItemsReq) Field5DeepEqual(src []*dataset.FieldSchema) bool {
+func (p *GetDatasetSchemaRequest) Field2DeepEqual(src int64) bool {
 
-	if len(p.DatasetFields) != len(src) {
+	if p.DatasetID != src {
 		return false
 	}
-	for i, v := range p.DatasetFields {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *ValidateDatasetItemsReq) Field10DeepEqual(src *bool) bool {
+func (p *GetDatasetSchemaRequest) Field10DeepEqual(src *bool) bool {
 
-	if p.IgnoreCurrentItemCount == src {
+	if p.WithDeleted == src {
 		return true
-	} else if p.IgnoreCurrentItemCount == nil || src == nil {
+	} else if p.WithDeleted == nil || src == nil {
 		return false
 	}
-	if *p.IgnoreCurrentItemCount != *src {
+	if *p.WithDeleted != *src {
 		return false
 	}
 	return true
 }
-func (p *ValidateDatasetItemsReq) Field255DeepEqual(src *base.Base) bool {
+func (p *GetDatasetSchemaRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -13565,84 +13429,62 @@ func (p *ValidateDatasetItemsReq) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type ValidateDatasetItemsResp struct {
-	// 合法的 item 索引，与 ValidateCreateDatasetItemsReq.items 中的索引对应
-	ValidItemIndices []int32                   `thrift:"valid_item_indices,1,optional" frugal:"1,optional,list<i32>" form:"valid_item_indices" json:"valid_item_indices,omitempty" query:"valid_item_indices"`
-	Errors           []*dataset.ItemErrorGroup `thrift:"errors,2,optional" frugal:"2,optional,list<dataset.ItemErrorGroup>" form:"errors" json:"errors,omitempty" query:"errors"`
-	BaseResp         *base.BaseResp            `thrift:"baseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"baseResp" json:"baseResp,omitempty" query:"baseResp"`
-}
-
-func NewValidateDatasetItemsResp() *ValidateDatasetItemsResp {
-	return &ValidateDatasetItemsResp{}
+type GetDatasetSchemaResponse struct {
+	Fields   []*dataset.FieldSchema `thrift:"fields,1,optional" frugal:"1,optional,list<dataset.FieldSchema>" form:"fields" json:"fields,omitempty" query:"fields"`
+	BaseResp *base.BaseResp         `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func (p *ValidateDatasetItemsResp) InitDefault() {
+func NewGetDatasetSchemaResponse() *GetDatasetSchemaResponse {
+	return &GetDatasetSchemaResponse{}
 }
 
-var ValidateDatasetItemsResp_ValidItemIndices_DEFAULT []int32
-
-func (p *ValidateDatasetItemsResp) GetValidItemIndices() (v []int32) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetValidItemIndices() {
-		return ValidateDatasetItemsResp_ValidItemIndices_DEFAULT
-	}
-	return p.ValidItemIndices
+func (p *GetDatasetSchemaResponse) InitDefault() {
 }
 
-var ValidateDatasetItemsResp_Errors_DEFAULT []*dataset.ItemErrorGroup
+var GetDatasetSchemaResponse_Fields_DEFAULT []*dataset.FieldSchema
 
-func (p *ValidateDatasetItemsResp) GetErrors() (v []*dataset.ItemErrorGroup) {
+func (p *GetDatasetSchemaResponse) GetFields() (v []*dataset.FieldSchema) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetErrors() {
-		return ValidateDatasetItemsResp_Errors_DEFAULT
+	if !p.IsSetFields() {
+		return GetDatasetSchemaResponse_Fields_DEFAULT
 	}
-	return p.Errors
+	return p.Fields
 }
 
-var ValidateDatasetItemsResp_BaseResp_DEFAULT *base.BaseResp
+var GetDatasetSchemaResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *ValidateDatasetItemsResp) GetBaseResp() (v *base.BaseResp) {
+func (p *GetDatasetSchemaResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return ValidateDatasetItemsResp_BaseResp_DEFAULT
+		return GetDatasetSchemaResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *ValidateDatasetItemsResp) SetValidItemIndices(val []int32) {
-	p.ValidItemIndices = val
-}
-func (p *ValidateDatasetItemsResp) SetErrors(val []*dataset.ItemErrorGroup) {
-	p.Errors = val
+func (p *GetDatasetSchemaResponse) SetFields(val []*dataset.FieldSchema) {
+	p.Fields = val
 }
-func (p *ValidateDatasetItemsResp) SetBaseResp(val *base.BaseResp) {
+func (p *GetDatasetSchemaResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_ValidateDatasetItemsResp = map[int16]string{
-	1:   "valid_item_indices",
-	2:   "errors",
-	255: "baseResp",
-}
-
-func (p *ValidateDatasetItemsResp) IsSetValidItemIndices() bool {
-	return p.ValidItemIndices != nil
+var fieldIDToName_GetDatasetSchemaResponse = map[int16]string{
+	1:   "fields",
+	255: "BaseResp",
 }
 
-func (p *ValidateDatasetItemsResp) IsSetErrors() bool {
-	return p.Errors != nil
+func (p *GetDatasetSchemaResponse) IsSetFields() bool {
+	return p.Fields != nil
 }
 
-func (p *ValidateDatasetItemsResp) IsSetBaseResp() bool {
+func (p *GetDatasetSchemaResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *ValidateDatasetItemsResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetDatasetSchemaResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -13668,14 +13510,6 @@ func (p *ValidateDatasetItemsResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -13703,7 +13537,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateDatasetItemsResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetSchemaResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13713,36 +13547,13 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ValidateDatasetItemsResp) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]int32, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem int32
-		if v, err := iprot.ReadI32(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.ValidItemIndices = _field
-	return nil
-}
-func (p *ValidateDatasetItemsResp) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetDatasetSchemaResponse) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*dataset.ItemErrorGroup, 0, size)
-	values := make([]dataset.ItemErrorGroup, size)
+	_field := make([]*dataset.FieldSchema, 0, size)
+	values := make([]dataset.FieldSchema, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -13756,10 +13567,10 @@ func (p *ValidateDatasetItemsResp) ReadField2(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Errors = _field
+	p.Fields = _field
 	return nil
 }
-func (p *ValidateDatasetItemsResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *GetDatasetSchemaResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -13768,9 +13579,9 @@ func (p *ValidateDatasetItemsResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ValidateDatasetItemsResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetSchemaResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ValidateDatasetItemsResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetDatasetSchemaResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13778,10 +13589,6 @@ func (p *ValidateDatasetItemsResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -13804,16 +13611,16 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ValidateDatasetItemsResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetValidItemIndices() {
-		if err = oprot.WriteFieldBegin("valid_item_indices", thrift.LIST, 1); err != nil {
+func (p *GetDatasetSchemaResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFields() {
+		if err = oprot.WriteFieldBegin("fields", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.I32, len(p.ValidItemIndices)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Fields)); err != nil {
 			return err
 		}
-		for _, v := range p.ValidItemIndices {
-			if err := oprot.WriteI32(v); err != nil {
+		for _, v := range p.Fields {
+			if err := v.Write(oprot); err != nil {
 				return err
 			}
 		}
@@ -13830,43 +13637,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ValidateDatasetItemsResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetErrors() {
-		if err = oprot.WriteFieldBegin("errors", thrift.LIST, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Errors)); err != nil {
-			return err
-		}
-		for _, v := range p.Errors {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetDatasetSchemaResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *ValidateDatasetItemsResp) writeField255(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseResp() {
-		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 255); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.BaseResp.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -13875,24 +13654,21 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ValidateDatasetItemsResp) String() string {
+func (p *GetDatasetSchemaResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ValidateDatasetItemsResp(%+v)", *p)
+	return fmt.Sprintf("GetDatasetSchemaResponse(%+v)", *p)
 
 }
 
-func (p *ValidateDatasetItemsResp) DeepEqual(ano *ValidateDatasetItemsResp) bool {
+func (p *GetDatasetSchemaResponse) DeepEqual(ano *GetDatasetSchemaResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ValidItemIndices) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Errors) {
+	if !p.Field1DeepEqual(ano.Fields) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
@@ -13901,25 +13677,12 @@ func (p *ValidateDatasetItemsResp) DeepEqual(ano *ValidateDatasetItemsResp) bool
 	return true
 }
 
-func (p *ValidateDatasetItemsResp) Field1DeepEqual(src []int32) bool {
-
-	if len(p.ValidItemIndices) != len(src) {
-		return false
-	}
-	for i, v := range p.ValidItemIndices {
-		_src := src[i]
-		if v != _src {
-			return false
-		}
-	}
-	return true
-}
-func (p *ValidateDatasetItemsResp) Field2DeepEqual(src []*dataset.ItemErrorGroup) bool {
+func (p *GetDatasetSchemaResponse) Field1DeepEqual(src []*dataset.FieldSchema) bool {
 
-	if len(p.Errors) != len(src) {
+	if len(p.Fields) != len(src) {
 		return false
 	}
-	for i, v := range p.Errors {
+	for i, v := range p.Fields {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -13927,7 +13690,7 @@ func (p *ValidateDatasetItemsResp) Field2DeepEqual(src []*dataset.ItemErrorGroup
 	}
 	return true
 }
-func (p *ValidateDatasetItemsResp) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *GetDatasetSchemaResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -13935,142 +13698,173 @@ func (p *ValidateDatasetItemsResp) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type BatchCreateDatasetItemsRequest struct {
+type ValidateDatasetItemsReq struct {
 	WorkspaceID *int64                 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	DatasetID   int64                  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	Items       []*dataset.DatasetItem `thrift:"items,3,optional" frugal:"3,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
-	// items 中存在无效数据时，默认不会写入任何数据；设置 skipInvalidItems=true 会跳过无效数据，写入有效数据
-	SkipInvalidItems *bool `thrift:"skip_invalid_items,10,optional" frugal:"10,optional,bool" form:"skip_invalid_items" json:"skip_invalid_items,omitempty" query:"skip_invalid_items"`
-	// 批量写入 items 如果超出数据集容量限制，默认不会写入任何数据；设置 partialAdd=true 会写入不超出容量限制的前 N 条
-	AllowPartialAdd *bool      `thrift:"allow_partial_add,11,optional" frugal:"11,optional,bool" form:"allow_partial_add" json:"allow_partial_add,omitempty" query:"allow_partial_add"`
-	Base            *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	Items       []*dataset.DatasetItem `thrift:"items,2,optional" frugal:"2,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
+	// 添加到已有数据集时提供
+	DatasetID *int64 `thrift:"dataset_id,3,optional" frugal:"3,optional,i64" json:"dataset_id" path:"dataset_id" `
+	// 新建数据集并添加数据时提供
+	DatasetCategory *dataset.DatasetCategory `thrift:"dataset_category,4,optional" frugal:"4,optional,DatasetCategory" form:"dataset_category" json:"dataset_category,omitempty" query:"dataset_category"`
+	// 新建数据集并添加数据时，必须提供；添加到已有数据集时，如非空，则覆盖已有 schema 用于校验
+	DatasetFields []*dataset.FieldSchema `thrift:"dataset_fields,5,optional" frugal:"5,optional,list<dataset.FieldSchema>" form:"dataset_fields" json:"dataset_fields,omitempty" query:"dataset_fields"`
+	// 添加到已有数据集时，现有数据条数，做容量校验时不做考虑，仅考虑提供 items 数量是否超限
+	IgnoreCurrentItemCount *bool      `thrift:"ignore_current_item_count,10,optional" frugal:"10,optional,bool" form:"ignore_current_item_count" json:"ignore_current_item_count,omitempty" query:"ignore_current_item_count"`
+	Base                   *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewBatchCreateDatasetItemsRequest() *BatchCreateDatasetItemsRequest {
-	return &BatchCreateDatasetItemsRequest{}
+func NewValidateDatasetItemsReq() *ValidateDatasetItemsReq {
+	return &ValidateDatasetItemsReq{}
 }
 
-func (p *BatchCreateDatasetItemsRequest) InitDefault() {
+func (p *ValidateDatasetItemsReq) InitDefault() {
 }
 
-var BatchCreateDatasetItemsRequest_WorkspaceID_DEFAULT int64
+var ValidateDatasetItemsReq_WorkspaceID_DEFAULT int64
 
-func (p *BatchCreateDatasetItemsRequest) GetWorkspaceID() (v int64) {
+func (p *ValidateDatasetItemsReq) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return BatchCreateDatasetItemsRequest_WorkspaceID_DEFAULT
+		return ValidateDatasetItemsReq_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *BatchCreateDatasetItemsRequest) GetDatasetID() (v int64) {
-	if p != nil {
-		return p.DatasetID
+var ValidateDatasetItemsReq_Items_DEFAULT []*dataset.DatasetItem
+
+func (p *ValidateDatasetItemsReq) GetItems() (v []*dataset.DatasetItem) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetItems() {
+		return ValidateDatasetItemsReq_Items_DEFAULT
+	}
+	return p.Items
 }
 
-var BatchCreateDatasetItemsRequest_Items_DEFAULT []*dataset.DatasetItem
+var ValidateDatasetItemsReq_DatasetID_DEFAULT int64
 
-func (p *BatchCreateDatasetItemsRequest) GetItems() (v []*dataset.DatasetItem) {
+func (p *ValidateDatasetItemsReq) GetDatasetID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetItems() {
-		return BatchCreateDatasetItemsRequest_Items_DEFAULT
+	if !p.IsSetDatasetID() {
+		return ValidateDatasetItemsReq_DatasetID_DEFAULT
 	}
-	return p.Items
+	return *p.DatasetID
 }
 
-var BatchCreateDatasetItemsRequest_SkipInvalidItems_DEFAULT bool
+var ValidateDatasetItemsReq_DatasetCategory_DEFAULT dataset.DatasetCategory
 
-func (p *BatchCreateDatasetItemsRequest) GetSkipInvalidItems() (v bool) {
+func (p *ValidateDatasetItemsReq) GetDatasetCategory() (v dataset.DatasetCategory) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetSkipInvalidItems() {
-		return BatchCreateDatasetItemsRequest_SkipInvalidItems_DEFAULT
+	if !p.IsSetDatasetCategory() {
+		return ValidateDatasetItemsReq_DatasetCategory_DEFAULT
 	}
-	return *p.SkipInvalidItems
+	return *p.DatasetCategory
 }
 
-var BatchCreateDatasetItemsRequest_AllowPartialAdd_DEFAULT bool
+var ValidateDatasetItemsReq_DatasetFields_DEFAULT []*dataset.FieldSchema
 
-func (p *BatchCreateDatasetItemsRequest) GetAllowPartialAdd() (v bool) {
+func (p *ValidateDatasetItemsReq) GetDatasetFields() (v []*dataset.FieldSchema) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAllowPartialAdd() {
-		return BatchCreateDatasetItemsRequest_AllowPartialAdd_DEFAULT
+	if !p.IsSetDatasetFields() {
+		return ValidateDatasetItemsReq_DatasetFields_DEFAULT
 	}
-	return *p.AllowPartialAdd
+	return p.DatasetFields
 }
 
-var BatchCreateDatasetItemsRequest_Base_DEFAULT *base.Base
+var ValidateDatasetItemsReq_IgnoreCurrentItemCount_DEFAULT bool
 
-func (p *BatchCreateDatasetItemsRequest) GetBase() (v *base.Base) {
+func (p *ValidateDatasetItemsReq) GetIgnoreCurrentItemCount() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetIgnoreCurrentItemCount() {
+		return ValidateDatasetItemsReq_IgnoreCurrentItemCount_DEFAULT
+	}
+	return *p.IgnoreCurrentItemCount
+}
+
+var ValidateDatasetItemsReq_Base_DEFAULT *base.Base
+
+func (p *ValidateDatasetItemsReq) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return BatchCreateDatasetItemsRequest_Base_DEFAULT
+		return ValidateDatasetItemsReq_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *BatchCreateDatasetItemsRequest) SetWorkspaceID(val *int64) {
+func (p *ValidateDatasetItemsReq) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *BatchCreateDatasetItemsRequest) SetDatasetID(val int64) {
+func (p *ValidateDatasetItemsReq) SetItems(val []*dataset.DatasetItem) {
+	p.Items = val
+}
+func (p *ValidateDatasetItemsReq) SetDatasetID(val *int64) {
 	p.DatasetID = val
 }
-func (p *BatchCreateDatasetItemsRequest) SetItems(val []*dataset.DatasetItem) {
-	p.Items = val
+func (p *ValidateDatasetItemsReq) SetDatasetCategory(val *dataset.DatasetCategory) {
+	p.DatasetCategory = val
 }
-func (p *BatchCreateDatasetItemsRequest) SetSkipInvalidItems(val *bool) {
-	p.SkipInvalidItems = val
+func (p *ValidateDatasetItemsReq) SetDatasetFields(val []*dataset.FieldSchema) {
+	p.DatasetFields = val
 }
-func (p *BatchCreateDatasetItemsRequest) SetAllowPartialAdd(val *bool) {
-	p.AllowPartialAdd = val
+func (p *ValidateDatasetItemsReq) SetIgnoreCurrentItemCount(val *bool) {
+	p.IgnoreCurrentItemCount = val
 }
-func (p *BatchCreateDatasetItemsRequest) SetBase(val *base.Base) {
+func (p *ValidateDatasetItemsReq) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_BatchCreateDatasetItemsRequest = map[int16]string{
+var fieldIDToName_ValidateDatasetItemsReq = map[int16]string{
 	1:   "workspace_id",
-	2:   "dataset_id",
-	3:   "items",
-	10:  "skip_invalid_items",
-	11:  "allow_partial_add",
+	2:   "items",
+	3:   "dataset_id",
+	4:   "dataset_category",
+	5:   "dataset_fields",
+	10:  "ignore_current_item_count",
 	255: "Base",
 }
 
-func (p *BatchCreateDatasetItemsRequest) IsSetWorkspaceID() bool {
+func (p *ValidateDatasetItemsReq) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *BatchCreateDatasetItemsRequest) IsSetItems() bool {
+func (p *ValidateDatasetItemsReq) IsSetItems() bool {
 	return p.Items != nil
 }
 
-func (p *BatchCreateDatasetItemsRequest) IsSetSkipInvalidItems() bool {
-	return p.SkipInvalidItems != nil
+func (p *ValidateDatasetItemsReq) IsSetDatasetID() bool {
+	return p.DatasetID != nil
 }
 
-func (p *BatchCreateDatasetItemsRequest) IsSetAllowPartialAdd() bool {
-	return p.AllowPartialAdd != nil
+func (p *ValidateDatasetItemsReq) IsSetDatasetCategory() bool {
+	return p.DatasetCategory != nil
 }
 
-func (p *BatchCreateDatasetItemsRequest) IsSetBase() bool {
+func (p *ValidateDatasetItemsReq) IsSetDatasetFields() bool {
+	return p.DatasetFields != nil
+}
+
+func (p *ValidateDatasetItemsReq) IsSetIgnoreCurrentItemCount() bool {
+	return p.IgnoreCurrentItemCount != nil
+}
+
+func (p *ValidateDatasetItemsReq) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *BatchCreateDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ValidateDatasetItemsReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetDatasetID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -14095,34 +13889,41 @@ func (p *BatchCreateDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetDatasetID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 10:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField10(iprot); err != nil {
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 11:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -14148,17 +13949,13 @@ func (p *BatchCreateDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error
 		goto ReadStructEndError
 	}
 
-	if !issetDatasetID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateDatasetItemsRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateDatasetItemsReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14166,11 +13963,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchCreateDatasetItemsRequest[fieldId]))
 }
 
-func (p *BatchCreateDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ValidateDatasetItemsReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -14181,18 +13976,7 @@ func (p *BatchCreateDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) erro
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *BatchCreateDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.DatasetID = _field
-	return nil
-}
-func (p *BatchCreateDatasetItemsRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *ValidateDatasetItemsReq) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -14215,18 +13999,53 @@ func (p *BatchCreateDatasetItemsRequest) ReadField3(iprot thrift.TProtocol) erro
 	p.Items = _field
 	return nil
 }
-func (p *BatchCreateDatasetItemsRequest) ReadField10(iprot thrift.TProtocol) error {
+func (p *ValidateDatasetItemsReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.SkipInvalidItems = _field
+	p.DatasetID = _field
 	return nil
 }
-func (p *BatchCreateDatasetItemsRequest) ReadField11(iprot thrift.TProtocol) error {
+func (p *ValidateDatasetItemsReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *dataset.DatasetCategory
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := dataset.DatasetCategory(v)
+		_field = &tmp
+	}
+	p.DatasetCategory = _field
+	return nil
+}
+func (p *ValidateDatasetItemsReq) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset.FieldSchema, 0, size)
+	values := make([]dataset.FieldSchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.DatasetFields = _field
+	return nil
+}
+func (p *ValidateDatasetItemsReq) ReadField10(iprot thrift.TProtocol) error {
 
 	var _field *bool
 	if v, err := iprot.ReadBool(); err != nil {
@@ -14234,10 +14053,10 @@ func (p *BatchCreateDatasetItemsRequest) ReadField11(iprot thrift.TProtocol) err
 	} else {
 		_field = &v
 	}
-	p.AllowPartialAdd = _field
+	p.IgnoreCurrentItemCount = _field
 	return nil
 }
-func (p *BatchCreateDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *ValidateDatasetItemsReq) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -14246,9 +14065,9 @@ func (p *BatchCreateDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *BatchCreateDatasetItemsRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ValidateDatasetItemsReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchCreateDatasetItemsRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ValidateDatasetItemsReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14264,12 +14083,16 @@ func (p *BatchCreateDatasetItemsRequest) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
 			goto WriteFieldError
 		}
 		if err = p.writeField255(oprot); err != nil {
@@ -14294,7 +14117,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchCreateDatasetItemsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ValidateDatasetItemsReq) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -14312,25 +14135,9 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchCreateDatasetItemsRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.DatasetID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *BatchCreateDatasetItemsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *ValidateDatasetItemsReq) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetItems() {
-		if err = oprot.WriteFieldBegin("items", thrift.LIST, 3); err != nil {
+		if err = oprot.WriteFieldBegin("items", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
 		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
@@ -14349,17 +14156,35 @@ func (p *BatchCreateDatasetItemsRequest) writeField3(oprot thrift.TProtocol) (er
 		}
 	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ValidateDatasetItemsReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDatasetID() {
+		if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DatasetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
 WriteFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *BatchCreateDatasetItemsRequest) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSkipInvalidItems() {
-		if err = oprot.WriteFieldBegin("skip_invalid_items", thrift.BOOL, 10); err != nil {
+func (p *ValidateDatasetItemsReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDatasetCategory() {
+		if err = oprot.WriteFieldBegin("dataset_category", thrift.I32, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.SkipInvalidItems); err != nil {
+		if err := oprot.WriteI32(int32(*p.DatasetCategory)); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -14368,16 +14193,24 @@ func (p *BatchCreateDatasetItemsRequest) writeField10(oprot thrift.TProtocol) (e
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *BatchCreateDatasetItemsRequest) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAllowPartialAdd() {
-		if err = oprot.WriteFieldBegin("allow_partial_add", thrift.BOOL, 11); err != nil {
+func (p *ValidateDatasetItemsReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDatasetFields() {
+		if err = oprot.WriteFieldBegin("dataset_fields", thrift.LIST, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.AllowPartialAdd); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.DatasetFields)); err != nil {
+			return err
+		}
+		for _, v := range p.DatasetFields {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -14386,11 +14219,29 @@ func (p *BatchCreateDatasetItemsRequest) writeField11(oprot thrift.TProtocol) (e
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *BatchCreateDatasetItemsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ValidateDatasetItemsReq) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIgnoreCurrentItemCount() {
+		if err = oprot.WriteFieldBegin("ignore_current_item_count", thrift.BOOL, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IgnoreCurrentItemCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ValidateDatasetItemsReq) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -14409,15 +14260,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchCreateDatasetItemsRequest) String() string {
+func (p *ValidateDatasetItemsReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchCreateDatasetItemsRequest(%+v)", *p)
+	return fmt.Sprintf("ValidateDatasetItemsReq(%+v)", *p)
 
 }
 
-func (p *BatchCreateDatasetItemsRequest) DeepEqual(ano *BatchCreateDatasetItemsRequest) bool {
+func (p *ValidateDatasetItemsReq) DeepEqual(ano *ValidateDatasetItemsReq) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -14426,16 +14277,19 @@ func (p *BatchCreateDatasetItemsRequest) DeepEqual(ano *BatchCreateDatasetItemsR
 	if !p.Field1DeepEqual(ano.WorkspaceID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.DatasetID) {
+	if !p.Field2DeepEqual(ano.Items) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Items) {
+	if !p.Field3DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.SkipInvalidItems) {
+	if !p.Field4DeepEqual(ano.DatasetCategory) {
 		return false
 	}
-	if !p.Field11DeepEqual(ano.AllowPartialAdd) {
+	if !p.Field5DeepEqual(ano.DatasetFields) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.IgnoreCurrentItemCount) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -14444,7 +14298,7 @@ func (p *BatchCreateDatasetItemsRequest) DeepEqual(ano *BatchCreateDatasetItemsR
 	return true
 }
 
-func (p *BatchCreateDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
+func (p *ValidateDatasetItemsReq) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -14456,14 +14310,7 @@ func (p *BatchCreateDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *BatchCreateDatasetItemsRequest) Field2DeepEqual(src int64) bool {
-
-	if p.DatasetID != src {
-		return false
-	}
-	return true
-}
-func (p *BatchCreateDatasetItemsRequest) Field3DeepEqual(src []*dataset.DatasetItem) bool {
+func (p *ValidateDatasetItemsReq) Field2DeepEqual(src []*dataset.DatasetItem) bool {
 
 	if len(p.Items) != len(src) {
 		return false
@@ -14476,117 +14323,141 @@ func (p *BatchCreateDatasetItemsRequest) Field3DeepEqual(src []*dataset.DatasetI
 	}
 	return true
 }
-func (p *BatchCreateDatasetItemsRequest) Field10DeepEqual(src *bool) bool {
+func (p *ValidateDatasetItemsReq) Field3DeepEqual(src *int64) bool {
 
-	if p.SkipInvalidItems == src {
+	if p.DatasetID == src {
 		return true
-	} else if p.SkipInvalidItems == nil || src == nil {
+	} else if p.DatasetID == nil || src == nil {
 		return false
 	}
-	if *p.SkipInvalidItems != *src {
+	if *p.DatasetID != *src {
 		return false
 	}
 	return true
 }
-func (p *BatchCreateDatasetItemsRequest) Field11DeepEqual(src *bool) bool {
+func (p *ValidateDatasetItemsReq) Field4DeepEqual(src *dataset.DatasetCategory) bool {
 
-	if p.AllowPartialAdd == src {
+	if p.DatasetCategory == src {
 		return true
-	} else if p.AllowPartialAdd == nil || src == nil {
+	} else if p.DatasetCategory == nil || src == nil {
 		return false
 	}
-	if *p.AllowPartialAdd != *src {
+	if *p.DatasetCategory != *src {
 		return false
 	}
 	return true
 }
-func (p *BatchCreateDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *ValidateDatasetItemsReq) Field5DeepEqual(src []*dataset.FieldSchema) bool {
 
-	if !p.Base.DeepEqual(src) {
+	if len(p.DatasetFields) != len(src) {
 		return false
 	}
+	for i, v := range p.DatasetFields {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
+func (p *ValidateDatasetItemsReq) Field10DeepEqual(src *bool) bool {
 
-type BatchCreateDatasetItemsResponse struct {
-	// key: item 在 items 中的索引
-	AddedItems map[int64]int64           `thrift:"added_items,1,optional" frugal:"1,optional,map<i64:i64>" json:"added_items" form:"added_items" query:"added_items"`
-	Errors     []*dataset.ItemErrorGroup `thrift:"errors,2,optional" frugal:"2,optional,list<dataset.ItemErrorGroup>" form:"errors" json:"errors,omitempty" query:"errors"`
-	/* base */
-	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
-}
-
-func NewBatchCreateDatasetItemsResponse() *BatchCreateDatasetItemsResponse {
-	return &BatchCreateDatasetItemsResponse{}
+	if p.IgnoreCurrentItemCount == src {
+		return true
+	} else if p.IgnoreCurrentItemCount == nil || src == nil {
+		return false
+	}
+	if *p.IgnoreCurrentItemCount != *src {
+		return false
+	}
+	return true
 }
+func (p *ValidateDatasetItemsReq) Field255DeepEqual(src *base.Base) bool {
 
-func (p *BatchCreateDatasetItemsResponse) InitDefault() {
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-var BatchCreateDatasetItemsResponse_AddedItems_DEFAULT map[int64]int64
+type ValidateDatasetItemsResp struct {
+	// 合法的 item 索引，与 ValidateCreateDatasetItemsReq.items 中的索引对应
+	ValidItemIndices []int32                   `thrift:"valid_item_indices,1,optional" frugal:"1,optional,list<i32>" form:"valid_item_indices" json:"valid_item_indices,omitempty" query:"valid_item_indices"`
+	Errors           []*dataset.ItemErrorGroup `thrift:"errors,2,optional" frugal:"2,optional,list<dataset.ItemErrorGroup>" form:"errors" json:"errors,omitempty" query:"errors"`
+	BaseResp         *base.BaseResp            `thrift:"baseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"baseResp" json:"baseResp,omitempty" query:"baseResp"`
+}
 
-func (p *BatchCreateDatasetItemsResponse) GetAddedItems() (v map[int64]int64) {
+func NewValidateDatasetItemsResp() *ValidateDatasetItemsResp {
+	return &ValidateDatasetItemsResp{}
+}
+
+func (p *ValidateDatasetItemsResp) InitDefault() {
+}
+
+var ValidateDatasetItemsResp_ValidItemIndices_DEFAULT []int32
+
+func (p *ValidateDatasetItemsResp) GetValidItemIndices() (v []int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAddedItems() {
-		return BatchCreateDatasetItemsResponse_AddedItems_DEFAULT
+	if !p.IsSetValidItemIndices() {
+		return ValidateDatasetItemsResp_ValidItemIndices_DEFAULT
 	}
-	return p.AddedItems
+	return p.ValidItemIndices
 }
 
-var BatchCreateDatasetItemsResponse_Errors_DEFAULT []*dataset.ItemErrorGroup
+var ValidateDatasetItemsResp_Errors_DEFAULT []*dataset.ItemErrorGroup
 
-func (p *BatchCreateDatasetItemsResponse) GetErrors() (v []*dataset.ItemErrorGroup) {
+func (p *ValidateDatasetItemsResp) GetErrors() (v []*dataset.ItemErrorGroup) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetErrors() {
-		return BatchCreateDatasetItemsResponse_Errors_DEFAULT
+		return ValidateDatasetItemsResp_Errors_DEFAULT
 	}
 	return p.Errors
 }
 
-var BatchCreateDatasetItemsResponse_BaseResp_DEFAULT *base.BaseResp
+var ValidateDatasetItemsResp_BaseResp_DEFAULT *base.BaseResp
 
-func (p *BatchCreateDatasetItemsResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *ValidateDatasetItemsResp) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return BatchCreateDatasetItemsResponse_BaseResp_DEFAULT
+		return ValidateDatasetItemsResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *BatchCreateDatasetItemsResponse) SetAddedItems(val map[int64]int64) {
-	p.AddedItems = val
+func (p *ValidateDatasetItemsResp) SetValidItemIndices(val []int32) {
+	p.ValidItemIndices = val
 }
-func (p *BatchCreateDatasetItemsResponse) SetErrors(val []*dataset.ItemErrorGroup) {
+func (p *ValidateDatasetItemsResp) SetErrors(val []*dataset.ItemErrorGroup) {
 	p.Errors = val
 }
-func (p *BatchCreateDatasetItemsResponse) SetBaseResp(val *base.BaseResp) {
+func (p *ValidateDatasetItemsResp) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_BatchCreateDatasetItemsResponse = map[int16]string{
-	1:   "added_items",
+var fieldIDToName_ValidateDatasetItemsResp = map[int16]string{
+	1:   "valid_item_indices",
 	2:   "errors",
-	255: "BaseResp",
+	255: "baseResp",
 }
 
-func (p *BatchCreateDatasetItemsResponse) IsSetAddedItems() bool {
-	return p.AddedItems != nil
+func (p *ValidateDatasetItemsResp) IsSetValidItemIndices() bool {
+	return p.ValidItemIndices != nil
 }
 
-func (p *BatchCreateDatasetItemsResponse) IsSetErrors() bool {
+func (p *ValidateDatasetItemsResp) IsSetErrors() bool {
 	return p.Errors != nil
 }
 
-func (p *BatchCreateDatasetItemsResponse) IsSetBaseResp() bool {
+func (p *ValidateDatasetItemsResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *BatchCreateDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ValidateDatasetItemsResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -14605,7 +14476,7 @@ func (p *BatchCreateDatasetItemsResponse) Read(iprot thrift.TProtocol) (err erro
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -14647,7 +14518,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateDatasetItemsResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ValidateDatasetItemsResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14657,36 +14528,30 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *BatchCreateDatasetItemsResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
+func (p *ValidateDatasetItemsResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make(map[int64]int64, size)
+	_field := make([]int32, 0, size)
 	for i := 0; i < size; i++ {
-		var _key int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
 
-		var _val int64
-		if v, err := iprot.ReadI64(); err != nil {
+		var _elem int32
+		if v, err := iprot.ReadI32(); err != nil {
 			return err
 		} else {
-			_val = v
+			_elem = v
 		}
 
-		_field[_key] = _val
+		_field = append(_field, _elem)
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.AddedItems = _field
+	p.ValidItemIndices = _field
 	return nil
 }
-func (p *BatchCreateDatasetItemsResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *ValidateDatasetItemsResp) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -14709,7 +14574,7 @@ func (p *BatchCreateDatasetItemsResponse) ReadField2(iprot thrift.TProtocol) err
 	p.Errors = _field
 	return nil
 }
-func (p *BatchCreateDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *ValidateDatasetItemsResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -14718,9 +14583,9 @@ func (p *BatchCreateDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *BatchCreateDatasetItemsResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ValidateDatasetItemsResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchCreateDatasetItemsResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ValidateDatasetItemsResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14754,23 +14619,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchCreateDatasetItemsResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAddedItems() {
-		if err = oprot.WriteFieldBegin("added_items", thrift.MAP, 1); err != nil {
+func (p *ValidateDatasetItemsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValidItemIndices() {
+		if err = oprot.WriteFieldBegin("valid_item_indices", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.I64, thrift.I64, len(p.AddedItems)); err != nil {
+		if err := oprot.WriteListBegin(thrift.I32, len(p.ValidItemIndices)); err != nil {
 			return err
 		}
-		for k, v := range p.AddedItems {
-			if err := oprot.WriteI64(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteI64(v); err != nil {
+		for _, v := range p.ValidItemIndices {
+			if err := oprot.WriteI32(v); err != nil {
 				return err
 			}
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -14783,7 +14645,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchCreateDatasetItemsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ValidateDatasetItemsResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErrors() {
 		if err = oprot.WriteFieldBegin("errors", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
@@ -14809,15 +14671,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *BatchCreateDatasetItemsResponse) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.BaseResp.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ValidateDatasetItemsResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -14826,21 +14690,21 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchCreateDatasetItemsResponse) String() string {
+func (p *ValidateDatasetItemsResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchCreateDatasetItemsResponse(%+v)", *p)
+	return fmt.Sprintf("ValidateDatasetItemsResp(%+v)", *p)
 
 }
 
-func (p *BatchCreateDatasetItemsResponse) DeepEqual(ano *BatchCreateDatasetItemsResponse) bool {
+func (p *ValidateDatasetItemsResp) DeepEqual(ano *ValidateDatasetItemsResp) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.AddedItems) {
+	if !p.Field1DeepEqual(ano.ValidItemIndices) {
 		return false
 	}
 	if !p.Field2DeepEqual(ano.Errors) {
@@ -14852,20 +14716,20 @@ func (p *BatchCreateDatasetItemsResponse) DeepEqual(ano *BatchCreateDatasetItems
 	return true
 }
 
-func (p *BatchCreateDatasetItemsResponse) Field1DeepEqual(src map[int64]int64) bool {
+func (p *ValidateDatasetItemsResp) Field1DeepEqual(src []int32) bool {
 
-	if len(p.AddedItems) != len(src) {
+	if len(p.ValidItemIndices) != len(src) {
 		return false
 	}
-	for k, v := range p.AddedItems {
-		_src := src[k]
+	for i, v := range p.ValidItemIndices {
+		_src := src[i]
 		if v != _src {
 			return false
 		}
 	}
 	return true
 }
-func (p *BatchCreateDatasetItemsResponse) Field2DeepEqual(src []*dataset.ItemErrorGroup) bool {
+func (p *ValidateDatasetItemsResp) Field2DeepEqual(src []*dataset.ItemErrorGroup) bool {
 
 	if len(p.Errors) != len(src) {
 		return false
@@ -14878,7 +14742,7 @@ func (p *BatchCreateDatasetItemsResponse) Field2DeepEqual(src []*dataset.ItemErr
 	}
 	return true
 }
-func (p *BatchCreateDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *ValidateDatasetItemsResp) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -14886,134 +14750,142 @@ func (p *BatchCreateDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp)
 	return true
 }
 
-type UpdateDatasetItemRequest struct {
-	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	ItemID      int64  `thrift:"item_id,3,required" frugal:"3,required,i64" json:"item_id" path:"item_id,required" `
-	// 单轮数据内容，当数据集为单轮时，写入此处的值
-	Data []*dataset.FieldData `thrift:"data,4,optional" frugal:"4,optional,list<dataset.FieldData>" form:"data" json:"data,omitempty" query:"data"`
-	// 多轮对话数据内容，当数据集为多轮对话时，写入此处的值
-	RepeatedData []*dataset.ItemData `thrift:"repeated_data,5,optional" frugal:"5,optional,list<dataset.ItemData>" form:"repeated_data" json:"repeated_data,omitempty" query:"repeated_data"`
-	Base         *base.Base          `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+type BatchCreateDatasetItemsRequest struct {
+	WorkspaceID *int64                 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	DatasetID   int64                  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	Items       []*dataset.DatasetItem `thrift:"items,3,optional" frugal:"3,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
+	// items 中存在无效数据时，默认不会写入任何数据；设置 skipInvalidItems=true 会跳过无效数据，写入有效数据
+	SkipInvalidItems *bool `thrift:"skip_invalid_items,10,optional" frugal:"10,optional,bool" form:"skip_invalid_items" json:"skip_invalid_items,omitempty" query:"skip_invalid_items"`
+	// 批量写入 items 如果超出数据集容量限制，默认不会写入任何数据；设置 partialAdd=true 会写入不超出容量限制的前 N 条
+	AllowPartialAdd *bool      `thrift:"allow_partial_add,11,optional" frugal:"11,optional,bool" form:"allow_partial_add" json:"allow_partial_add,omitempty" query:"allow_partial_add"`
+	Base            *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewUpdateDatasetItemRequest() *UpdateDatasetItemRequest {
-	return &UpdateDatasetItemRequest{}
+func NewBatchCreateDatasetItemsRequest() *BatchCreateDatasetItemsRequest {
+	return &BatchCreateDatasetItemsRequest{}
 }
 
-func (p *UpdateDatasetItemRequest) InitDefault() {
+func (p *BatchCreateDatasetItemsRequest) InitDefault() {
 }
 
-var UpdateDatasetItemRequest_WorkspaceID_DEFAULT int64
+var BatchCreateDatasetItemsRequest_WorkspaceID_DEFAULT int64
 
-func (p *UpdateDatasetItemRequest) GetWorkspaceID() (v int64) {
+func (p *BatchCreateDatasetItemsRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return UpdateDatasetItemRequest_WorkspaceID_DEFAULT
+		return BatchCreateDatasetItemsRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *UpdateDatasetItemRequest) GetDatasetID() (v int64) {
+func (p *BatchCreateDatasetItemsRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-func (p *UpdateDatasetItemRequest) GetItemID() (v int64) {
-	if p != nil {
-		return p.ItemID
+var BatchCreateDatasetItemsRequest_Items_DEFAULT []*dataset.DatasetItem
+
+func (p *BatchCreateDatasetItemsRequest) GetItems() (v []*dataset.DatasetItem) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetItems() {
+		return BatchCreateDatasetItemsRequest_Items_DEFAULT
+	}
+	return p.Items
 }
 
-var UpdateDatasetItemRequest_Data_DEFAULT []*dataset.FieldData
+var BatchCreateDatasetItemsRequest_SkipInvalidItems_DEFAULT bool
 
-func (p *UpdateDatasetItemRequest) GetData() (v []*dataset.FieldData) {
+func (p *BatchCreateDatasetItemsRequest) GetSkipInvalidItems() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetData() {
-		return UpdateDatasetItemRequest_Data_DEFAULT
+	if !p.IsSetSkipInvalidItems() {
+		return BatchCreateDatasetItemsRequest_SkipInvalidItems_DEFAULT
 	}
-	return p.Data
+	return *p.SkipInvalidItems
 }
 
-var UpdateDatasetItemRequest_RepeatedData_DEFAULT []*dataset.ItemData
+var BatchCreateDatasetItemsRequest_AllowPartialAdd_DEFAULT bool
 
-func (p *UpdateDatasetItemRequest) GetRepeatedData() (v []*dataset.ItemData) {
+func (p *BatchCreateDatasetItemsRequest) GetAllowPartialAdd() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRepeatedData() {
-		return UpdateDatasetItemRequest_RepeatedData_DEFAULT
+	if !p.IsSetAllowPartialAdd() {
+		return BatchCreateDatasetItemsRequest_AllowPartialAdd_DEFAULT
 	}
-	return p.RepeatedData
+	return *p.AllowPartialAdd
 }
 
-var UpdateDatasetItemRequest_Base_DEFAULT *base.Base
+var BatchCreateDatasetItemsRequest_Base_DEFAULT *base.Base
 
-func (p *UpdateDatasetItemRequest) GetBase() (v *base.Base) {
+func (p *BatchCreateDatasetItemsRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return UpdateDatasetItemRequest_Base_DEFAULT
+		return BatchCreateDatasetItemsRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *UpdateDatasetItemRequest) SetWorkspaceID(val *int64) {
+func (p *BatchCreateDatasetItemsRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *UpdateDatasetItemRequest) SetDatasetID(val int64) {
+func (p *BatchCreateDatasetItemsRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *UpdateDatasetItemRequest) SetItemID(val int64) {
-	p.ItemID = val
+func (p *BatchCreateDatasetItemsRequest) SetItems(val []*dataset.DatasetItem) {
+	p.Items = val
 }
-func (p *UpdateDatasetItemRequest) SetData(val []*dataset.FieldData) {
-	p.Data = val
+func (p *BatchCreateDatasetItemsRequest) SetSkipInvalidItems(val *bool) {
+	p.SkipInvalidItems = val
 }
-func (p *UpdateDatasetItemRequest) SetRepeatedData(val []*dataset.ItemData) {
-	p.RepeatedData = val
+func (p *BatchCreateDatasetItemsRequest) SetAllowPartialAdd(val *bool) {
+	p.AllowPartialAdd = val
 }
-func (p *UpdateDatasetItemRequest) SetBase(val *base.Base) {
+func (p *BatchCreateDatasetItemsRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_UpdateDatasetItemRequest = map[int16]string{
+var fieldIDToName_BatchCreateDatasetItemsRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	3:   "item_id",
-	4:   "data",
-	5:   "repeated_data",
+	3:   "items",
+	10:  "skip_invalid_items",
+	11:  "allow_partial_add",
 	255: "Base",
 }
 
-func (p *UpdateDatasetItemRequest) IsSetWorkspaceID() bool {
+func (p *BatchCreateDatasetItemsRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *UpdateDatasetItemRequest) IsSetData() bool {
-	return p.Data != nil
+func (p *BatchCreateDatasetItemsRequest) IsSetItems() bool {
+	return p.Items != nil
 }
 
-func (p *UpdateDatasetItemRequest) IsSetRepeatedData() bool {
-	return p.RepeatedData != nil
+func (p *BatchCreateDatasetItemsRequest) IsSetSkipInvalidItems() bool {
+	return p.SkipInvalidItems != nil
 }
 
-func (p *UpdateDatasetItemRequest) IsSetBase() bool {
+func (p *BatchCreateDatasetItemsRequest) IsSetAllowPartialAdd() bool {
+	return p.AllowPartialAdd != nil
+}
+
+func (p *BatchCreateDatasetItemsRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *UpdateDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchCreateDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
-	var issetItemID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -15047,25 +14919,24 @@ func (p *UpdateDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField5(iprot); err != nil {
+		case 11:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField11(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -15096,18 +14967,13 @@ func (p *UpdateDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetItemID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateDatasetItemRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateDatasetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15116,10 +14982,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateDatasetItemRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchCreateDatasetItemsRequest[fieldId]))
 }
 
-func (p *UpdateDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchCreateDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -15130,7 +14996,7 @@ func (p *UpdateDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *UpdateDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *BatchCreateDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -15141,24 +15007,13 @@ func (p *UpdateDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.DatasetID = _field
 	return nil
 }
-func (p *UpdateDatasetItemRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ItemID = _field
-	return nil
-}
-func (p *UpdateDatasetItemRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *BatchCreateDatasetItemsRequest) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*dataset.FieldData, 0, size)
-	values := make([]dataset.FieldData, size)
+	_field := make([]*dataset.DatasetItem, 0, size)
+	values := make([]dataset.DatasetItem, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -15172,33 +15027,32 @@ func (p *UpdateDatasetItemRequest) ReadField4(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Data = _field
+	p.Items = _field
 	return nil
 }
-func (p *UpdateDatasetItemRequest) ReadField5(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *BatchCreateDatasetItemsRequest) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*dataset.ItemData, 0, size)
-	values := make([]dataset.ItemData, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.SkipInvalidItems = _field
+	return nil
+}
+func (p *BatchCreateDatasetItemsRequest) ReadField11(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.RepeatedData = _field
+	p.AllowPartialAdd = _field
 	return nil
 }
-func (p *UpdateDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchCreateDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -15207,9 +15061,9 @@ func (p *UpdateDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UpdateDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchCreateDatasetItemsRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateDatasetItemRequest"); err != nil {
+	if err = oprot.WriteStructBegin("BatchCreateDatasetItemsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15225,12 +15079,12 @@ func (p *UpdateDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
 			goto WriteFieldError
 		}
 		if err = p.writeField255(oprot); err != nil {
@@ -15255,7 +15109,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateDatasetItemRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BatchCreateDatasetItemsRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -15273,7 +15127,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdateDatasetItemRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *BatchCreateDatasetItemsRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15289,31 +15143,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UpdateDatasetItemRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("item_id", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ItemID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *UpdateDatasetItemRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetData() {
-		if err = oprot.WriteFieldBegin("data", thrift.LIST, 4); err != nil {
+func (p *BatchCreateDatasetItemsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItems() {
+		if err = oprot.WriteFieldBegin("items", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
 			return err
 		}
-		for _, v := range p.Data {
+		for _, v := range p.Items {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -15327,24 +15165,34 @@ func (p *UpdateDatasetItemRequest) writeField4(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *UpdateDatasetItemRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRepeatedData() {
-		if err = oprot.WriteFieldBegin("repeated_data", thrift.LIST, 5); err != nil {
+func (p *BatchCreateDatasetItemsRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSkipInvalidItems() {
+		if err = oprot.WriteFieldBegin("skip_invalid_items", thrift.BOOL, 10); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RepeatedData)); err != nil {
+		if err := oprot.WriteBool(*p.SkipInvalidItems); err != nil {
 			return err
 		}
-		for _, v := range p.RepeatedData {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *BatchCreateDatasetItemsRequest) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAllowPartialAdd() {
+		if err = oprot.WriteFieldBegin("allow_partial_add", thrift.BOOL, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.AllowPartialAdd); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -15353,11 +15201,11 @@ func (p *UpdateDatasetItemRequest) writeField5(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
-func (p *UpdateDatasetItemRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *BatchCreateDatasetItemsRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -15376,15 +15224,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *UpdateDatasetItemRequest) String() string {
+func (p *BatchCreateDatasetItemsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateDatasetItemRequest(%+v)", *p)
+	return fmt.Sprintf("BatchCreateDatasetItemsRequest(%+v)", *p)
 
 }
 
-func (p *UpdateDatasetItemRequest) DeepEqual(ano *UpdateDatasetItemRequest) bool {
+func (p *BatchCreateDatasetItemsRequest) DeepEqual(ano *BatchCreateDatasetItemsRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -15396,13 +15244,13 @@ func (p *UpdateDatasetItemRequest) DeepEqual(ano *UpdateDatasetItemRequest) bool
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ItemID) {
+	if !p.Field3DeepEqual(ano.Items) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.Data) {
+	if !p.Field10DeepEqual(ano.SkipInvalidItems) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.RepeatedData) {
+	if !p.Field11DeepEqual(ano.AllowPartialAdd) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -15411,7 +15259,7 @@ func (p *UpdateDatasetItemRequest) DeepEqual(ano *UpdateDatasetItemRequest) bool
 	return true
 }
 
-func (p *UpdateDatasetItemRequest) Field1DeepEqual(src *int64) bool {
+func (p *BatchCreateDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -15423,47 +15271,51 @@ func (p *UpdateDatasetItemRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *UpdateDatasetItemRequest) Field2DeepEqual(src int64) bool {
+func (p *BatchCreateDatasetItemsRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *UpdateDatasetItemRequest) Field3DeepEqual(src int64) bool {
+func (p *BatchCreateDatasetItemsRequest) Field3DeepEqual(src []*dataset.DatasetItem) bool {
 
-	if p.ItemID != src {
+	if len(p.Items) != len(src) {
 		return false
 	}
+	for i, v := range p.Items {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
-func (p *UpdateDatasetItemRequest) Field4DeepEqual(src []*dataset.FieldData) bool {
+func (p *BatchCreateDatasetItemsRequest) Field10DeepEqual(src *bool) bool {
 
-	if len(p.Data) != len(src) {
+	if p.SkipInvalidItems == src {
+		return true
+	} else if p.SkipInvalidItems == nil || src == nil {
 		return false
 	}
-	for i, v := range p.Data {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if *p.SkipInvalidItems != *src {
+		return false
 	}
 	return true
 }
-func (p *UpdateDatasetItemRequest) Field5DeepEqual(src []*dataset.ItemData) bool {
+func (p *BatchCreateDatasetItemsRequest) Field11DeepEqual(src *bool) bool {
 
-	if len(p.RepeatedData) != len(src) {
+	if p.AllowPartialAdd == src {
+		return true
+	} else if p.AllowPartialAdd == nil || src == nil {
 		return false
 	}
-	for i, v := range p.RepeatedData {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if *p.AllowPartialAdd != *src {
+		return false
 	}
 	return true
 }
-func (p *UpdateDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *BatchCreateDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -15471,41 +15323,85 @@ func (p *UpdateDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type UpdateDatasetItemResponse struct {
+type BatchCreateDatasetItemsResponse struct {
+	// key: item 在 items 中的索引
+	AddedItems map[int64]int64           `thrift:"added_items,1,optional" frugal:"1,optional,map<i64:i64>" json:"added_items" form:"added_items" query:"added_items"`
+	Errors     []*dataset.ItemErrorGroup `thrift:"errors,2,optional" frugal:"2,optional,list<dataset.ItemErrorGroup>" form:"errors" json:"errors,omitempty" query:"errors"`
+	/* base */
 	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewUpdateDatasetItemResponse() *UpdateDatasetItemResponse {
-	return &UpdateDatasetItemResponse{}
+func NewBatchCreateDatasetItemsResponse() *BatchCreateDatasetItemsResponse {
+	return &BatchCreateDatasetItemsResponse{}
 }
 
-func (p *UpdateDatasetItemResponse) InitDefault() {
+func (p *BatchCreateDatasetItemsResponse) InitDefault() {
 }
 
-var UpdateDatasetItemResponse_BaseResp_DEFAULT *base.BaseResp
+var BatchCreateDatasetItemsResponse_AddedItems_DEFAULT map[int64]int64
 
-func (p *UpdateDatasetItemResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *BatchCreateDatasetItemsResponse) GetAddedItems() (v map[int64]int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAddedItems() {
+		return BatchCreateDatasetItemsResponse_AddedItems_DEFAULT
+	}
+	return p.AddedItems
+}
+
+var BatchCreateDatasetItemsResponse_Errors_DEFAULT []*dataset.ItemErrorGroup
+
+func (p *BatchCreateDatasetItemsResponse) GetErrors() (v []*dataset.ItemErrorGroup) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetErrors() {
+		return BatchCreateDatasetItemsResponse_Errors_DEFAULT
+	}
+	return p.Errors
+}
+
+var BatchCreateDatasetItemsResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *BatchCreateDatasetItemsResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return UpdateDatasetItemResponse_BaseResp_DEFAULT
+		return BatchCreateDatasetItemsResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *UpdateDatasetItemResponse) SetBaseResp(val *base.BaseResp) {
+func (p *BatchCreateDatasetItemsResponse) SetAddedItems(val map[int64]int64) {
+	p.AddedItems = val
+}
+func (p *BatchCreateDatasetItemsResponse) SetErrors(val []*dataset.ItemErrorGroup) {
+	p.Errors = val
+}
+func (p *BatchCreateDatasetItemsResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_UpdateDatasetItemResponse = map[int16]string{
+var fieldIDToName_BatchCreateDatasetItemsResponse = map[int16]string{
+	1:   "added_items",
+	2:   "errors",
 	255: "BaseResp",
 }
 
-func (p *UpdateDatasetItemResponse) IsSetBaseResp() bool {
+func (p *BatchCreateDatasetItemsResponse) IsSetAddedItems() bool {
+	return p.AddedItems != nil
+}
+
+func (p *BatchCreateDatasetItemsResponse) IsSetErrors() bool {
+	return p.Errors != nil
+}
+
+func (p *BatchCreateDatasetItemsResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *UpdateDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchCreateDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -15523,6 +15419,22 @@ func (p *UpdateDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -15550,7 +15462,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateDatasetItemResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateDatasetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15560,7 +15472,59 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchCreateDatasetItemsResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]int64, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.AddedItems = _field
+	return nil
+}
+func (p *BatchCreateDatasetItemsResponse) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset.ItemErrorGroup, 0, size)
+	values := make([]dataset.ItemErrorGroup, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Errors = _field
+	return nil
+}
+func (p *BatchCreateDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -15569,12 +15533,20 @@ func (p *UpdateDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UpdateDatasetItemResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchCreateDatasetItemsResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateDatasetItemResponse"); err != nil {
+	if err = oprot.WriteStructBegin("BatchCreateDatasetItemsResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -15597,7 +15569,62 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateDatasetItemResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *BatchCreateDatasetItemsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAddedItems() {
+		if err = oprot.WriteFieldBegin("added_items", thrift.MAP, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.I64, len(p.AddedItems)); err != nil {
+			return err
+		}
+		for k, v := range p.AddedItems {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *BatchCreateDatasetItemsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrors() {
+		if err = oprot.WriteFieldBegin("errors", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Errors)); err != nil {
+			return err
+		}
+		for _, v := range p.Errors {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *BatchCreateDatasetItemsResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15614,114 +15641,190 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *UpdateDatasetItemResponse) String() string {
+func (p *BatchCreateDatasetItemsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateDatasetItemResponse(%+v)", *p)
+	return fmt.Sprintf("BatchCreateDatasetItemsResponse(%+v)", *p)
 
 }
 
-func (p *UpdateDatasetItemResponse) DeepEqual(ano *UpdateDatasetItemResponse) bool {
+func (p *BatchCreateDatasetItemsResponse) DeepEqual(ano *BatchCreateDatasetItemsResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
+	if !p.Field1DeepEqual(ano.AddedItems) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Errors) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
 		return false
 	}
 	return true
 }
 
-func (p *UpdateDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *BatchCreateDatasetItemsResponse) Field1DeepEqual(src map[int64]int64) bool {
 
-	if !p.BaseResp.DeepEqual(src) {
+	if len(p.AddedItems) != len(src) {
 		return false
 	}
+	for k, v := range p.AddedItems {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
+	}
 	return true
 }
+func (p *BatchCreateDatasetItemsResponse) Field2DeepEqual(src []*dataset.ItemErrorGroup) bool {
 
-type DeleteDatasetItemRequest struct {
-	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
-	DatasetID   int64      `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	ItemID      int64      `thrift:"item_id,3,required" frugal:"3,required,i64" json:"item_id" path:"item_id,required" `
-	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	if len(p.Errors) != len(src) {
+		return false
+	}
+	for i, v := range p.Errors {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
+func (p *BatchCreateDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
-func NewDeleteDatasetItemRequest() *DeleteDatasetItemRequest {
-	return &DeleteDatasetItemRequest{}
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func (p *DeleteDatasetItemRequest) InitDefault() {
+type UpdateDatasetItemRequest struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	ItemID      int64  `thrift:"item_id,3,required" frugal:"3,required,i64" json:"item_id" path:"item_id,required" `
+	// 单轮数据内容，当数据集为单轮时，写入此处的值
+	Data []*dataset.FieldData `thrift:"data,4,optional" frugal:"4,optional,list<dataset.FieldData>" form:"data" json:"data,omitempty" query:"data"`
+	// 多轮对话数据内容，当数据集为多轮对话时，写入此处的值
+	RepeatedData []*dataset.ItemData `thrift:"repeated_data,5,optional" frugal:"5,optional,list<dataset.ItemData>" form:"repeated_data" json:"repeated_data,omitempty" query:"repeated_data"`
+	Base         *base.Base          `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-var DeleteDatasetItemRequest_WorkspaceID_DEFAULT int64
+func NewUpdateDatasetItemRequest() *UpdateDatasetItemRequest {
+	return &UpdateDatasetItemRequest{}
+}
 
-func (p *DeleteDatasetItemRequest) GetWorkspaceID() (v int64) {
+func (p *UpdateDatasetItemRequest) InitDefault() {
+}
+
+var UpdateDatasetItemRequest_WorkspaceID_DEFAULT int64
+
+func (p *UpdateDatasetItemRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return DeleteDatasetItemRequest_WorkspaceID_DEFAULT
+		return UpdateDatasetItemRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *DeleteDatasetItemRequest) GetDatasetID() (v int64) {
+func (p *UpdateDatasetItemRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-func (p *DeleteDatasetItemRequest) GetItemID() (v int64) {
+func (p *UpdateDatasetItemRequest) GetItemID() (v int64) {
 	if p != nil {
 		return p.ItemID
 	}
 	return
 }
 
-var DeleteDatasetItemRequest_Base_DEFAULT *base.Base
+var UpdateDatasetItemRequest_Data_DEFAULT []*dataset.FieldData
 
-func (p *DeleteDatasetItemRequest) GetBase() (v *base.Base) {
+func (p *UpdateDatasetItemRequest) GetData() (v []*dataset.FieldData) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetData() {
+		return UpdateDatasetItemRequest_Data_DEFAULT
+	}
+	return p.Data
+}
+
+var UpdateDatasetItemRequest_RepeatedData_DEFAULT []*dataset.ItemData
+
+func (p *UpdateDatasetItemRequest) GetRepeatedData() (v []*dataset.ItemData) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRepeatedData() {
+		return UpdateDatasetItemRequest_RepeatedData_DEFAULT
+	}
+	return p.RepeatedData
+}
+
+var UpdateDatasetItemRequest_Base_DEFAULT *base.Base
+
+func (p *UpdateDatasetItemRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return DeleteDatasetItemRequest_Base_DEFAULT
+		return UpdateDatasetItemRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *DeleteDatasetItemRequest) SetWorkspaceID(val *int64) {
+func (p *UpdateDatasetItemRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *DeleteDatasetItemRequest) SetDatasetID(val int64) {
+func (p *UpdateDatasetItemRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *DeleteDatasetItemRequest) SetItemID(val int64) {
+func (p *UpdateDatasetItemRequest) SetItemID(val int64) {
 	p.ItemID = val
 }
-func (p *DeleteDatasetItemRequest) SetBase(val *base.Base) {
+func (p *UpdateDatasetItemRequest) SetData(val []*dataset.FieldData) {
+	p.Data = val
+}
+func (p *UpdateDatasetItemRequest) SetRepeatedData(val []*dataset.ItemData) {
+	p.RepeatedData = val
+}
+func (p *UpdateDatasetItemRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_DeleteDatasetItemRequest = map[int16]string{
+var fieldIDToName_UpdateDatasetItemRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
 	3:   "item_id",
+	4:   "data",
+	5:   "repeated_data",
 	255: "Base",
 }
 
-func (p *DeleteDatasetItemRequest) IsSetWorkspaceID() bool {
+func (p *UpdateDatasetItemRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *DeleteDatasetItemRequest) IsSetBase() bool {
+func (p *UpdateDatasetItemRequest) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *UpdateDatasetItemRequest) IsSetRepeatedData() bool {
+	return p.RepeatedData != nil
+}
+
+func (p *UpdateDatasetItemRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *DeleteDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
@@ -15767,6 +15870,22 @@ func (p *DeleteDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -15803,7 +15922,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteDatasetItemRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateDatasetItemRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15812,10 +15931,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteDatasetItemRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateDatasetItemRequest[fieldId]))
 }
 
-func (p *DeleteDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -15826,7 +15945,7 @@ func (p *DeleteDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *DeleteDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -15837,7 +15956,7 @@ func (p *DeleteDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.DatasetID = _field
 	return nil
 }
-func (p *DeleteDatasetItemRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetItemRequest) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -15848,7 +15967,53 @@ func (p *DeleteDatasetItemRequest) ReadField3(iprot thrift.TProtocol) error {
 	p.ItemID = _field
 	return nil
 }
-func (p *DeleteDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetItemRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset.FieldData, 0, size)
+	values := make([]dataset.FieldData, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+func (p *UpdateDatasetItemRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset.ItemData, 0, size)
+	values := make([]dataset.ItemData, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RepeatedData = _field
+	return nil
+}
+func (p *UpdateDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -15857,9 +16022,9 @@ func (p *DeleteDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DeleteDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteDatasetItemRequest"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateDatasetItemRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15875,6 +16040,14 @@ func (p *DeleteDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -15897,7 +16070,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteDatasetItemRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetItemRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -15915,7 +16088,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *DeleteDatasetItemRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetItemRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15931,7 +16104,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *DeleteDatasetItemRequest) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetItemRequest) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("item_id", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15947,7 +16120,59 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *DeleteDatasetItemRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetItemRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetData() {
+		if err = oprot.WriteFieldBegin("data", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
+			return err
+		}
+		for _, v := range p.Data {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *UpdateDatasetItemRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRepeatedData() {
+		if err = oprot.WriteFieldBegin("repeated_data", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RepeatedData)); err != nil {
+			return err
+		}
+		for _, v := range p.RepeatedData {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *UpdateDatasetItemRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -15966,15 +16191,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *DeleteDatasetItemRequest) String() string {
+func (p *UpdateDatasetItemRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteDatasetItemRequest(%+v)", *p)
+	return fmt.Sprintf("UpdateDatasetItemRequest(%+v)", *p)
 
 }
 
-func (p *DeleteDatasetItemRequest) DeepEqual(ano *DeleteDatasetItemRequest) bool {
+func (p *UpdateDatasetItemRequest) DeepEqual(ano *UpdateDatasetItemRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -15989,13 +16214,19 @@ func (p *DeleteDatasetItemRequest) DeepEqual(ano *DeleteDatasetItemRequest) bool
 	if !p.Field3DeepEqual(ano.ItemID) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Data) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.RepeatedData) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.Base) {
 		return false
 	}
 	return true
 }
 
-func (p *DeleteDatasetItemRequest) Field1DeepEqual(src *int64) bool {
+func (p *UpdateDatasetItemRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -16007,63 +16238,89 @@ func (p *DeleteDatasetItemRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *DeleteDatasetItemRequest) Field2DeepEqual(src int64) bool {
+func (p *UpdateDatasetItemRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *DeleteDatasetItemRequest) Field3DeepEqual(src int64) bool {
+func (p *UpdateDatasetItemRequest) Field3DeepEqual(src int64) bool {
 
 	if p.ItemID != src {
 		return false
 	}
 	return true
 }
-func (p *DeleteDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *UpdateDatasetItemRequest) Field4DeepEqual(src []*dataset.FieldData) bool {
 
-	if !p.Base.DeepEqual(src) {
+	if len(p.Data) != len(src) {
 		return false
 	}
+	for i, v := range p.Data {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
+func (p *UpdateDatasetItemRequest) Field5DeepEqual(src []*dataset.ItemData) bool {
 
-type DeleteDatasetItemResponse struct {
-	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
-}
-
-func NewDeleteDatasetItemResponse() *DeleteDatasetItemResponse {
-	return &DeleteDatasetItemResponse{}
-}
+	if len(p.RepeatedData) != len(src) {
+		return false
+	}
+	for i, v := range p.RepeatedData {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *UpdateDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
 
-func (p *DeleteDatasetItemResponse) InitDefault() {
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-var DeleteDatasetItemResponse_BaseResp_DEFAULT *base.BaseResp
+type UpdateDatasetItemResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
 
-func (p *DeleteDatasetItemResponse) GetBaseResp() (v *base.BaseResp) {
+func NewUpdateDatasetItemResponse() *UpdateDatasetItemResponse {
+	return &UpdateDatasetItemResponse{}
+}
+
+func (p *UpdateDatasetItemResponse) InitDefault() {
+}
+
+var UpdateDatasetItemResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *UpdateDatasetItemResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return DeleteDatasetItemResponse_BaseResp_DEFAULT
+		return UpdateDatasetItemResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *DeleteDatasetItemResponse) SetBaseResp(val *base.BaseResp) {
+func (p *UpdateDatasetItemResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_DeleteDatasetItemResponse = map[int16]string{
+var fieldIDToName_UpdateDatasetItemResponse = map[int16]string{
 	255: "BaseResp",
 }
 
-func (p *DeleteDatasetItemResponse) IsSetBaseResp() bool {
+func (p *UpdateDatasetItemResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *DeleteDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -16108,7 +16365,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteDatasetItemResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateDatasetItemResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16118,7 +16375,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *UpdateDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -16127,9 +16384,9 @@ func (p *DeleteDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DeleteDatasetItemResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetItemResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteDatasetItemResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateDatasetItemResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16155,7 +16412,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteDatasetItemResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *UpdateDatasetItemResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -16172,15 +16429,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *DeleteDatasetItemResponse) String() string {
+func (p *UpdateDatasetItemResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteDatasetItemResponse(%+v)", *p)
+	return fmt.Sprintf("UpdateDatasetItemResponse(%+v)", *p)
 
 }
 
-func (p *DeleteDatasetItemResponse) DeepEqual(ano *DeleteDatasetItemResponse) bool {
+func (p *UpdateDatasetItemResponse) DeepEqual(ano *UpdateDatasetItemResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -16192,7 +16449,7 @@ func (p *DeleteDatasetItemResponse) DeepEqual(ano *DeleteDatasetItemResponse) bo
 	return true
 }
 
-func (p *DeleteDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *UpdateDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -16200,98 +16457,90 @@ func (p *DeleteDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type BatchDeleteDatasetItemsRequest struct {
-	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+type DeleteDatasetItemRequest struct {
+	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
 	DatasetID   int64      `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	ItemIds     []int64    `thrift:"item_ids,3,optional" frugal:"3,optional,list<i64>" json:"item_ids" form:"item_ids" query:"item_ids"`
+	ItemID      int64      `thrift:"item_id,3,required" frugal:"3,required,i64" json:"item_id" path:"item_id,required" `
 	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewBatchDeleteDatasetItemsRequest() *BatchDeleteDatasetItemsRequest {
-	return &BatchDeleteDatasetItemsRequest{}
+func NewDeleteDatasetItemRequest() *DeleteDatasetItemRequest {
+	return &DeleteDatasetItemRequest{}
 }
 
-func (p *BatchDeleteDatasetItemsRequest) InitDefault() {
+func (p *DeleteDatasetItemRequest) InitDefault() {
 }
 
-var BatchDeleteDatasetItemsRequest_WorkspaceID_DEFAULT int64
+var DeleteDatasetItemRequest_WorkspaceID_DEFAULT int64
 
-func (p *BatchDeleteDatasetItemsRequest) GetWorkspaceID() (v int64) {
+func (p *DeleteDatasetItemRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return BatchDeleteDatasetItemsRequest_WorkspaceID_DEFAULT
+		return DeleteDatasetItemRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *BatchDeleteDatasetItemsRequest) GetDatasetID() (v int64) {
+func (p *DeleteDatasetItemRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-var BatchDeleteDatasetItemsRequest_ItemIds_DEFAULT []int64
-
-func (p *BatchDeleteDatasetItemsRequest) GetItemIds() (v []int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetItemIds() {
-		return BatchDeleteDatasetItemsRequest_ItemIds_DEFAULT
+func (p *DeleteDatasetItemRequest) GetItemID() (v int64) {
+	if p != nil {
+		return p.ItemID
 	}
-	return p.ItemIds
+	return
 }
 
-var BatchDeleteDatasetItemsRequest_Base_DEFAULT *base.Base
+var DeleteDatasetItemRequest_Base_DEFAULT *base.Base
 
-func (p *BatchDeleteDatasetItemsRequest) GetBase() (v *base.Base) {
+func (p *DeleteDatasetItemRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return BatchDeleteDatasetItemsRequest_Base_DEFAULT
+		return DeleteDatasetItemRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *BatchDeleteDatasetItemsRequest) SetWorkspaceID(val *int64) {
+func (p *DeleteDatasetItemRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *BatchDeleteDatasetItemsRequest) SetDatasetID(val int64) {
+func (p *DeleteDatasetItemRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *BatchDeleteDatasetItemsRequest) SetItemIds(val []int64) {
-	p.ItemIds = val
+func (p *DeleteDatasetItemRequest) SetItemID(val int64) {
+	p.ItemID = val
 }
-func (p *BatchDeleteDatasetItemsRequest) SetBase(val *base.Base) {
+func (p *DeleteDatasetItemRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_BatchDeleteDatasetItemsRequest = map[int16]string{
+var fieldIDToName_DeleteDatasetItemRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	3:   "item_ids",
+	3:   "item_id",
 	255: "Base",
 }
 
-func (p *BatchDeleteDatasetItemsRequest) IsSetWorkspaceID() bool {
+func (p *DeleteDatasetItemRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *BatchDeleteDatasetItemsRequest) IsSetItemIds() bool {
-	return p.ItemIds != nil
-}
-
-func (p *BatchDeleteDatasetItemsRequest) IsSetBase() bool {
+func (p *DeleteDatasetItemRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *BatchDeleteDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
+	var issetItemID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -16325,10 +16574,11 @@ func (p *BatchDeleteDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetItemID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -16357,13 +16607,18 @@ func (p *BatchDeleteDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetItemID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchDeleteDatasetItemsRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteDatasetItemRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16372,10 +16627,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchDeleteDatasetItemsRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteDatasetItemRequest[fieldId]))
 }
 
-func (p *BatchDeleteDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *DeleteDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -16386,7 +16641,7 @@ func (p *BatchDeleteDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) erro
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *BatchDeleteDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *DeleteDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -16397,30 +16652,18 @@ func (p *BatchDeleteDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) erro
 	p.DatasetID = _field
 	return nil
 }
-func (p *BatchDeleteDatasetItemsRequest) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+func (p *DeleteDatasetItemRequest) ReadField3(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.ItemIds = _field
+	p.ItemID = _field
 	return nil
 }
-func (p *BatchDeleteDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *DeleteDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -16429,9 +16672,9 @@ func (p *BatchDeleteDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *BatchDeleteDatasetItemsRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchDeleteDatasetItemsRequest"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteDatasetItemRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16469,7 +16712,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchDeleteDatasetItemsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DeleteDatasetItemRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -16487,7 +16730,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchDeleteDatasetItemsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *DeleteDatasetItemRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -16503,25 +16746,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *BatchDeleteDatasetItemsRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItemIds() {
-		if err = oprot.WriteFieldBegin("item_ids", thrift.LIST, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.I64, len(p.ItemIds)); err != nil {
-			return err
-		}
-		for _, v := range p.ItemIds {
-			if err := oprot.WriteI64(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *DeleteDatasetItemRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("item_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ItemID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -16529,7 +16762,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *BatchDeleteDatasetItemsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *DeleteDatasetItemRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -16548,15 +16781,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchDeleteDatasetItemsRequest) String() string {
+func (p *DeleteDatasetItemRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchDeleteDatasetItemsRequest(%+v)", *p)
+	return fmt.Sprintf("DeleteDatasetItemRequest(%+v)", *p)
 
 }
 
-func (p *BatchDeleteDatasetItemsRequest) DeepEqual(ano *BatchDeleteDatasetItemsRequest) bool {
+func (p *DeleteDatasetItemRequest) DeepEqual(ano *DeleteDatasetItemRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -16568,7 +16801,7 @@ func (p *BatchDeleteDatasetItemsRequest) DeepEqual(ano *BatchDeleteDatasetItemsR
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ItemIds) {
+	if !p.Field3DeepEqual(ano.ItemID) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -16577,7 +16810,7 @@ func (p *BatchDeleteDatasetItemsRequest) DeepEqual(ano *BatchDeleteDatasetItemsR
 	return true
 }
 
-func (p *BatchDeleteDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
+func (p *DeleteDatasetItemRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -16589,27 +16822,21 @@ func (p *BatchDeleteDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *BatchDeleteDatasetItemsRequest) Field2DeepEqual(src int64) bool {
+func (p *DeleteDatasetItemRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *BatchDeleteDatasetItemsRequest) Field3DeepEqual(src []int64) bool {
+func (p *DeleteDatasetItemRequest) Field3DeepEqual(src int64) bool {
 
-	if len(p.ItemIds) != len(src) {
+	if p.ItemID != src {
 		return false
 	}
-	for i, v := range p.ItemIds {
-		_src := src[i]
-		if v != _src {
-			return false
-		}
-	}
 	return true
 }
-func (p *BatchDeleteDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *DeleteDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -16617,41 +16844,41 @@ func (p *BatchDeleteDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool
 	return true
 }
 
-type BatchDeleteDatasetItemsResponse struct {
+type DeleteDatasetItemResponse struct {
 	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewBatchDeleteDatasetItemsResponse() *BatchDeleteDatasetItemsResponse {
-	return &BatchDeleteDatasetItemsResponse{}
+func NewDeleteDatasetItemResponse() *DeleteDatasetItemResponse {
+	return &DeleteDatasetItemResponse{}
 }
 
-func (p *BatchDeleteDatasetItemsResponse) InitDefault() {
+func (p *DeleteDatasetItemResponse) InitDefault() {
 }
 
-var BatchDeleteDatasetItemsResponse_BaseResp_DEFAULT *base.BaseResp
+var DeleteDatasetItemResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *BatchDeleteDatasetItemsResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *DeleteDatasetItemResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return BatchDeleteDatasetItemsResponse_BaseResp_DEFAULT
+		return DeleteDatasetItemResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *BatchDeleteDatasetItemsResponse) SetBaseResp(val *base.BaseResp) {
+func (p *DeleteDatasetItemResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_BatchDeleteDatasetItemsResponse = map[int16]string{
+var fieldIDToName_DeleteDatasetItemResponse = map[int16]string{
 	255: "BaseResp",
 }
 
-func (p *BatchDeleteDatasetItemsResponse) IsSetBaseResp() bool {
+func (p *DeleteDatasetItemResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *BatchDeleteDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -16696,7 +16923,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchDeleteDatasetItemsResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteDatasetItemResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16706,7 +16933,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *BatchDeleteDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *DeleteDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -16715,9 +16942,9 @@ func (p *BatchDeleteDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *BatchDeleteDatasetItemsResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteDatasetItemResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchDeleteDatasetItemsResponse"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteDatasetItemResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16743,7 +16970,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchDeleteDatasetItemsResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *DeleteDatasetItemResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -16760,15 +16987,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchDeleteDatasetItemsResponse) String() string {
+func (p *DeleteDatasetItemResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchDeleteDatasetItemsResponse(%+v)", *p)
+	return fmt.Sprintf("DeleteDatasetItemResponse(%+v)", *p)
 
 }
 
-func (p *BatchDeleteDatasetItemsResponse) DeepEqual(ano *BatchDeleteDatasetItemsResponse) bool {
+func (p *DeleteDatasetItemResponse) DeepEqual(ano *DeleteDatasetItemResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -16780,7 +17007,7 @@ func (p *BatchDeleteDatasetItemsResponse) DeepEqual(ano *BatchDeleteDatasetItems
 	return true
 }
 
-func (p *BatchDeleteDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *DeleteDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -16788,161 +17015,95 @@ func (p *BatchDeleteDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp)
 	return true
 }
 
-type ListDatasetItemsRequest struct {
-	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	/* pagination */
-	PageNumber *int32 `thrift:"page_number,100,optional" frugal:"100,optional,i32" form:"page_number" json:"page_number,omitempty" query:"page_number"`
-	// 分页大小(0, 200]，默认为 20
-	PageSize *int32 `thrift:"page_size,101,optional" frugal:"101,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
-	// 与 page 同时提供时，优先使用 cursor
-	PageToken *string            `thrift:"page_token,102,optional" frugal:"102,optional,string" form:"page_token" json:"page_token,omitempty" query:"page_token"`
-	OrderBys  []*dataset.OrderBy `thrift:"order_bys,103,optional" frugal:"103,optional,list<dataset.OrderBy>" form:"order_bys" json:"order_bys,omitempty" query:"order_bys"`
-	Base      *base.Base         `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+type BatchDeleteDatasetItemsRequest struct {
+	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	DatasetID   int64      `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	ItemIds     []int64    `thrift:"item_ids,3,optional" frugal:"3,optional,list<i64>" json:"item_ids" form:"item_ids" query:"item_ids"`
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewListDatasetItemsRequest() *ListDatasetItemsRequest {
-	return &ListDatasetItemsRequest{}
+func NewBatchDeleteDatasetItemsRequest() *BatchDeleteDatasetItemsRequest {
+	return &BatchDeleteDatasetItemsRequest{}
 }
 
-func (p *ListDatasetItemsRequest) InitDefault() {
+func (p *BatchDeleteDatasetItemsRequest) InitDefault() {
 }
 
-var ListDatasetItemsRequest_WorkspaceID_DEFAULT int64
+var BatchDeleteDatasetItemsRequest_WorkspaceID_DEFAULT int64
 
-func (p *ListDatasetItemsRequest) GetWorkspaceID() (v int64) {
+func (p *BatchDeleteDatasetItemsRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return ListDatasetItemsRequest_WorkspaceID_DEFAULT
+		return BatchDeleteDatasetItemsRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *ListDatasetItemsRequest) GetDatasetID() (v int64) {
+func (p *BatchDeleteDatasetItemsRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-var ListDatasetItemsRequest_PageNumber_DEFAULT int32
-
-func (p *ListDatasetItemsRequest) GetPageNumber() (v int32) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetPageNumber() {
-		return ListDatasetItemsRequest_PageNumber_DEFAULT
-	}
-	return *p.PageNumber
-}
-
-var ListDatasetItemsRequest_PageSize_DEFAULT int32
-
-func (p *ListDatasetItemsRequest) GetPageSize() (v int32) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetPageSize() {
-		return ListDatasetItemsRequest_PageSize_DEFAULT
-	}
-	return *p.PageSize
-}
-
-var ListDatasetItemsRequest_PageToken_DEFAULT string
-
-func (p *ListDatasetItemsRequest) GetPageToken() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetPageToken() {
-		return ListDatasetItemsRequest_PageToken_DEFAULT
-	}
-	return *p.PageToken
-}
-
-var ListDatasetItemsRequest_OrderBys_DEFAULT []*dataset.OrderBy
+var BatchDeleteDatasetItemsRequest_ItemIds_DEFAULT []int64
 
-func (p *ListDatasetItemsRequest) GetOrderBys() (v []*dataset.OrderBy) {
+func (p *BatchDeleteDatasetItemsRequest) GetItemIds() (v []int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetOrderBys() {
-		return ListDatasetItemsRequest_OrderBys_DEFAULT
+	if !p.IsSetItemIds() {
+		return BatchDeleteDatasetItemsRequest_ItemIds_DEFAULT
 	}
-	return p.OrderBys
+	return p.ItemIds
 }
 
-var ListDatasetItemsRequest_Base_DEFAULT *base.Base
+var BatchDeleteDatasetItemsRequest_Base_DEFAULT *base.Base
 
-func (p *ListDatasetItemsRequest) GetBase() (v *base.Base) {
+func (p *BatchDeleteDatasetItemsRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return ListDatasetItemsRequest_Base_DEFAULT
+		return BatchDeleteDatasetItemsRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *ListDatasetItemsRequest) SetWorkspaceID(val *int64) {
+func (p *BatchDeleteDatasetItemsRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *ListDatasetItemsRequest) SetDatasetID(val int64) {
+func (p *BatchDeleteDatasetItemsRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *ListDatasetItemsRequest) SetPageNumber(val *int32) {
-	p.PageNumber = val
-}
-func (p *ListDatasetItemsRequest) SetPageSize(val *int32) {
-	p.PageSize = val
-}
-func (p *ListDatasetItemsRequest) SetPageToken(val *string) {
-	p.PageToken = val
-}
-func (p *ListDatasetItemsRequest) SetOrderBys(val []*dataset.OrderBy) {
-	p.OrderBys = val
+func (p *BatchDeleteDatasetItemsRequest) SetItemIds(val []int64) {
+	p.ItemIds = val
 }
-func (p *ListDatasetItemsRequest) SetBase(val *base.Base) {
+func (p *BatchDeleteDatasetItemsRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_ListDatasetItemsRequest = map[int16]string{
+var fieldIDToName_BatchDeleteDatasetItemsRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	100: "page_number",
-	101: "page_size",
-	102: "page_token",
-	103: "order_bys",
+	3:   "item_ids",
 	255: "Base",
 }
 
-func (p *ListDatasetItemsRequest) IsSetWorkspaceID() bool {
+func (p *BatchDeleteDatasetItemsRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *ListDatasetItemsRequest) IsSetPageNumber() bool {
-	return p.PageNumber != nil
-}
-
-func (p *ListDatasetItemsRequest) IsSetPageSize() bool {
-	return p.PageSize != nil
-}
-
-func (p *ListDatasetItemsRequest) IsSetPageToken() bool {
-	return p.PageToken != nil
-}
-
-func (p *ListDatasetItemsRequest) IsSetOrderBys() bool {
-	return p.OrderBys != nil
+func (p *BatchDeleteDatasetItemsRequest) IsSetItemIds() bool {
+	return p.ItemIds != nil
 }
 
-func (p *ListDatasetItemsRequest) IsSetBase() bool {
+func (p *BatchDeleteDatasetItemsRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *ListDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchDeleteDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
@@ -16978,33 +17139,9 @@ func (p *ListDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 100:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField100(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 101:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField101(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 102:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField102(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 103:
+		case 3:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField103(iprot); err != nil {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -17041,7 +17178,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetItemsRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchDeleteDatasetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17050,10 +17187,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListDatasetItemsRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchDeleteDatasetItemsRequest[fieldId]))
 }
 
-func (p *ListDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchDeleteDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -17064,7 +17201,7 @@ func (p *ListDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *ListDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *BatchDeleteDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -17075,52 +17212,19 @@ func (p *ListDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.DatasetID = _field
 	return nil
 }
-func (p *ListDatasetItemsRequest) ReadField100(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PageNumber = _field
-	return nil
-}
-func (p *ListDatasetItemsRequest) ReadField101(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PageSize = _field
-	return nil
-}
-func (p *ListDatasetItemsRequest) ReadField102(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PageToken = _field
-	return nil
-}
-func (p *ListDatasetItemsRequest) ReadField103(iprot thrift.TProtocol) error {
+func (p *BatchDeleteDatasetItemsRequest) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*dataset.OrderBy, 0, size)
-	values := make([]dataset.OrderBy, size)
+	_field := make([]int64, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
 
-		if err := _elem.Read(iprot); err != nil {
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
 			return err
+		} else {
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -17128,10 +17232,10 @@ func (p *ListDatasetItemsRequest) ReadField103(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.OrderBys = _field
+	p.ItemIds = _field
 	return nil
 }
-func (p *ListDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchDeleteDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -17140,9 +17244,9 @@ func (p *ListDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ListDatasetItemsRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchDeleteDatasetItemsRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasetItemsRequest"); err != nil {
+	if err = oprot.WriteStructBegin("BatchDeleteDatasetItemsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17154,20 +17258,8 @@ func (p *ListDatasetItemsRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
-			goto WriteFieldError
-		}
-		if err = p.writeField101(oprot); err != nil {
-			fieldId = 101
-			goto WriteFieldError
-		}
-		if err = p.writeField102(oprot); err != nil {
-			fieldId = 102
-			goto WriteFieldError
-		}
-		if err = p.writeField103(oprot); err != nil {
-			fieldId = 103
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
 			goto WriteFieldError
 		}
 		if err = p.writeField255(oprot); err != nil {
@@ -17192,7 +17284,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListDatasetItemsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BatchDeleteDatasetItemsRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -17210,7 +17302,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ListDatasetItemsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *BatchDeleteDatasetItemsRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -17226,70 +17318,16 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ListDatasetItemsRequest) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageNumber() {
-		if err = oprot.WriteFieldBegin("page_number", thrift.I32, 100); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.PageNumber); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
-}
-func (p *ListDatasetItemsRequest) writeField101(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageSize() {
-		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 101); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.PageSize); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
-}
-func (p *ListDatasetItemsRequest) writeField102(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPageToken() {
-		if err = oprot.WriteFieldBegin("page_token", thrift.STRING, 102); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.PageToken); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
-}
-func (p *ListDatasetItemsRequest) writeField103(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOrderBys() {
-		if err = oprot.WriteFieldBegin("order_bys", thrift.LIST, 103); err != nil {
+func (p *BatchDeleteDatasetItemsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemIds() {
+		if err = oprot.WriteFieldBegin("item_ids", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OrderBys)); err != nil {
+		if err := oprot.WriteListBegin(thrift.I64, len(p.ItemIds)); err != nil {
 			return err
 		}
-		for _, v := range p.OrderBys {
-			if err := v.Write(oprot); err != nil {
+		for _, v := range p.ItemIds {
+			if err := oprot.WriteI64(v); err != nil {
 				return err
 			}
 		}
@@ -17302,11 +17340,11 @@ func (p *ListDatasetItemsRequest) writeField103(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 103 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ListDatasetItemsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *BatchDeleteDatasetItemsRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -17325,15 +17363,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ListDatasetItemsRequest) String() string {
+func (p *BatchDeleteDatasetItemsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDatasetItemsRequest(%+v)", *p)
+	return fmt.Sprintf("BatchDeleteDatasetItemsRequest(%+v)", *p)
 
 }
 
-func (p *ListDatasetItemsRequest) DeepEqual(ano *ListDatasetItemsRequest) bool {
+func (p *BatchDeleteDatasetItemsRequest) DeepEqual(ano *BatchDeleteDatasetItemsRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -17345,16 +17383,7 @@ func (p *ListDatasetItemsRequest) DeepEqual(ano *ListDatasetItemsRequest) bool {
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field100DeepEqual(ano.PageNumber) {
-		return false
-	}
-	if !p.Field101DeepEqual(ano.PageSize) {
-		return false
-	}
-	if !p.Field102DeepEqual(ano.PageToken) {
-		return false
-	}
-	if !p.Field103DeepEqual(ano.OrderBys) {
+	if !p.Field3DeepEqual(ano.ItemIds) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -17363,7 +17392,7 @@ func (p *ListDatasetItemsRequest) DeepEqual(ano *ListDatasetItemsRequest) bool {
 	return true
 }
 
-func (p *ListDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
+func (p *BatchDeleteDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -17375,63 +17404,27 @@ func (p *ListDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *ListDatasetItemsRequest) Field2DeepEqual(src int64) bool {
+func (p *BatchDeleteDatasetItemsRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *ListDatasetItemsRequest) Field100DeepEqual(src *int32) bool {
-
-	if p.PageNumber == src {
-		return true
-	} else if p.PageNumber == nil || src == nil {
-		return false
-	}
-	if *p.PageNumber != *src {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetItemsRequest) Field101DeepEqual(src *int32) bool {
-
-	if p.PageSize == src {
-		return true
-	} else if p.PageSize == nil || src == nil {
-		return false
-	}
-	if *p.PageSize != *src {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetItemsRequest) Field102DeepEqual(src *string) bool {
-
-	if p.PageToken == src {
-		return true
-	} else if p.PageToken == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.PageToken, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetItemsRequest) Field103DeepEqual(src []*dataset.OrderBy) bool {
+func (p *BatchDeleteDatasetItemsRequest) Field3DeepEqual(src []int64) bool {
 
-	if len(p.OrderBys) != len(src) {
+	if len(p.ItemIds) != len(src) {
 		return false
 	}
-	for i, v := range p.OrderBys {
+	for i, v := range p.ItemIds {
 		_src := src[i]
-		if !v.DeepEqual(_src) {
+		if v != _src {
 			return false
 		}
 	}
 	return true
 }
-func (p *ListDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *BatchDeleteDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -17439,126 +17432,41 @@ func (p *ListDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type ListDatasetItemsResponse struct {
-	Items []*dataset.DatasetItem `thrift:"items,1,optional" frugal:"1,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
-	/* pagination */
-	NextPageToken *string        `thrift:"next_page_token,100,optional" frugal:"100,optional,string" form:"next_page_token" json:"next_page_token,omitempty" query:"next_page_token"`
-	Total         *int64         `thrift:"total,101,optional" frugal:"101,optional,i64" json:"total" form:"total" query:"total"`
-	FilterTotal   *int64         `thrift:"filter_total,102,optional" frugal:"102,optional,i64" json:"filter_total" form:"filter_total" query:"filter_total"`
-	BaseResp      *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
-}
-
-func NewListDatasetItemsResponse() *ListDatasetItemsResponse {
-	return &ListDatasetItemsResponse{}
-}
-
-func (p *ListDatasetItemsResponse) InitDefault() {
-}
-
-var ListDatasetItemsResponse_Items_DEFAULT []*dataset.DatasetItem
-
-func (p *ListDatasetItemsResponse) GetItems() (v []*dataset.DatasetItem) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetItems() {
-		return ListDatasetItemsResponse_Items_DEFAULT
-	}
-	return p.Items
-}
-
-var ListDatasetItemsResponse_NextPageToken_DEFAULT string
-
-func (p *ListDatasetItemsResponse) GetNextPageToken() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetNextPageToken() {
-		return ListDatasetItemsResponse_NextPageToken_DEFAULT
-	}
-	return *p.NextPageToken
+type BatchDeleteDatasetItemsResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-var ListDatasetItemsResponse_Total_DEFAULT int64
-
-func (p *ListDatasetItemsResponse) GetTotal() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTotal() {
-		return ListDatasetItemsResponse_Total_DEFAULT
-	}
-	return *p.Total
+func NewBatchDeleteDatasetItemsResponse() *BatchDeleteDatasetItemsResponse {
+	return &BatchDeleteDatasetItemsResponse{}
 }
 
-var ListDatasetItemsResponse_FilterTotal_DEFAULT int64
-
-func (p *ListDatasetItemsResponse) GetFilterTotal() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetFilterTotal() {
-		return ListDatasetItemsResponse_FilterTotal_DEFAULT
-	}
-	return *p.FilterTotal
+func (p *BatchDeleteDatasetItemsResponse) InitDefault() {
 }
 
-var ListDatasetItemsResponse_BaseResp_DEFAULT *base.BaseResp
+var BatchDeleteDatasetItemsResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *ListDatasetItemsResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *BatchDeleteDatasetItemsResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return ListDatasetItemsResponse_BaseResp_DEFAULT
+		return BatchDeleteDatasetItemsResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *ListDatasetItemsResponse) SetItems(val []*dataset.DatasetItem) {
-	p.Items = val
-}
-func (p *ListDatasetItemsResponse) SetNextPageToken(val *string) {
-	p.NextPageToken = val
-}
-func (p *ListDatasetItemsResponse) SetTotal(val *int64) {
-	p.Total = val
-}
-func (p *ListDatasetItemsResponse) SetFilterTotal(val *int64) {
-	p.FilterTotal = val
-}
-func (p *ListDatasetItemsResponse) SetBaseResp(val *base.BaseResp) {
+func (p *BatchDeleteDatasetItemsResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_ListDatasetItemsResponse = map[int16]string{
-	1:   "items",
-	100: "next_page_token",
-	101: "total",
-	102: "filter_total",
+var fieldIDToName_BatchDeleteDatasetItemsResponse = map[int16]string{
 	255: "BaseResp",
 }
 
-func (p *ListDatasetItemsResponse) IsSetItems() bool {
-	return p.Items != nil
-}
-
-func (p *ListDatasetItemsResponse) IsSetNextPageToken() bool {
-	return p.NextPageToken != nil
-}
-
-func (p *ListDatasetItemsResponse) IsSetTotal() bool {
-	return p.Total != nil
-}
-
-func (p *ListDatasetItemsResponse) IsSetFilterTotal() bool {
-	return p.FilterTotal != nil
-}
-
-func (p *ListDatasetItemsResponse) IsSetBaseResp() bool {
+func (p *BatchDeleteDatasetItemsResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *ListDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchDeleteDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -17576,41 +17484,9 @@ func (p *ListDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 100:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField100(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 101:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField101(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 102:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField102(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField255(iprot); err != nil {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -17635,7 +17511,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetItemsResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchDeleteDatasetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17645,63 +17521,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListDatasetItemsResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*dataset.DatasetItem, 0, size)
-	values := make([]dataset.DatasetItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Items = _field
-	return nil
-}
-func (p *ListDatasetItemsResponse) ReadField100(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.NextPageToken = _field
-	return nil
-}
-func (p *ListDatasetItemsResponse) ReadField101(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Total = _field
-	return nil
-}
-func (p *ListDatasetItemsResponse) ReadField102(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.FilterTotal = _field
-	return nil
-}
-func (p *ListDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchDeleteDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -17710,28 +17530,12 @@ func (p *ListDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ListDatasetItemsResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchDeleteDatasetItemsResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasetItemsResponse"); err != nil {
+	if err = oprot.WriteStructBegin("BatchDeleteDatasetItemsResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField100(oprot); err != nil {
-			fieldId = 100
-			goto WriteFieldError
-		}
-		if err = p.writeField101(oprot); err != nil {
-			fieldId = 101
-			goto WriteFieldError
-		}
-		if err = p.writeField102(oprot); err != nil {
-			fieldId = 102
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -17754,87 +17558,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListDatasetItemsResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItems() {
-		if err = oprot.WriteFieldBegin("items", thrift.LIST, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
-			return err
-		}
-		for _, v := range p.Items {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ListDatasetItemsResponse) writeField100(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNextPageToken() {
-		if err = oprot.WriteFieldBegin("next_page_token", thrift.STRING, 100); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.NextPageToken); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
-}
-func (p *ListDatasetItemsResponse) writeField101(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTotal() {
-		if err = oprot.WriteFieldBegin("total", thrift.I64, 101); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.Total); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
-}
-func (p *ListDatasetItemsResponse) writeField102(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilterTotal() {
-		if err = oprot.WriteFieldBegin("filter_total", thrift.I64, 102); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.FilterTotal); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
-}
-func (p *ListDatasetItemsResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *BatchDeleteDatasetItemsResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -17851,230 +17575,157 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ListDatasetItemsResponse) String() string {
+func (p *BatchDeleteDatasetItemsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDatasetItemsResponse(%+v)", *p)
+	return fmt.Sprintf("BatchDeleteDatasetItemsResponse(%+v)", *p)
 
 }
 
-func (p *ListDatasetItemsResponse) DeepEqual(ano *ListDatasetItemsResponse) bool {
+func (p *BatchDeleteDatasetItemsResponse) DeepEqual(ano *BatchDeleteDatasetItemsResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Items) {
-		return false
-	}
-	if !p.Field100DeepEqual(ano.NextPageToken) {
-		return false
-	}
-	if !p.Field101DeepEqual(ano.Total) {
-		return false
-	}
-	if !p.Field102DeepEqual(ano.FilterTotal) {
-		return false
-	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
 		return false
 	}
 	return true
 }
 
-func (p *ListDatasetItemsResponse) Field1DeepEqual(src []*dataset.DatasetItem) bool {
+func (p *BatchDeleteDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
-	if len(p.Items) != len(src) {
+	if !p.BaseResp.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.Items {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *ListDatasetItemsResponse) Field100DeepEqual(src *string) bool {
 
-	if p.NextPageToken == src {
-		return true
-	} else if p.NextPageToken == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.NextPageToken, *src) != 0 {
-		return false
-	}
-	return true
+type ListDatasetItemsRequest struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	/* pagination */
+	PageNumber *int32 `thrift:"page_number,100,optional" frugal:"100,optional,i32" form:"page_number" json:"page_number,omitempty" query:"page_number"`
+	// 分页大小(0, 200]，默认为 20
+	PageSize *int32 `thrift:"page_size,101,optional" frugal:"101,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
+	// 与 page 同时提供时，优先使用 cursor
+	PageToken *string            `thrift:"page_token,102,optional" frugal:"102,optional,string" form:"page_token" json:"page_token,omitempty" query:"page_token"`
+	OrderBys  []*dataset.OrderBy `thrift:"order_bys,103,optional" frugal:"103,optional,list<dataset.OrderBy>" form:"order_bys" json:"order_bys,omitempty" query:"order_bys"`
+	Base      *base.Base         `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
-func (p *ListDatasetItemsResponse) Field101DeepEqual(src *int64) bool {
 
-	if p.Total == src {
-		return true
-	} else if p.Total == nil || src == nil {
-		return false
-	}
-	if *p.Total != *src {
-		return false
-	}
-	return true
+func NewListDatasetItemsRequest() *ListDatasetItemsRequest {
+	return &ListDatasetItemsRequest{}
 }
-func (p *ListDatasetItemsResponse) Field102DeepEqual(src *int64) bool {
 
-	if p.FilterTotal == src {
-		return true
-	} else if p.FilterTotal == nil || src == nil {
-		return false
-	}
-	if *p.FilterTotal != *src {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp) bool {
-
-	if !p.BaseResp.DeepEqual(src) {
-		return false
-	}
-	return true
-}
-
-type ListDatasetItemsByVersionRequest struct {
-	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
-	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	VersionID   int64  `thrift:"version_id,3,required" frugal:"3,required,i64" json:"version_id" path:"version_id,required" `
-	/* pagination */
-	PageNumber *int32 `thrift:"page_number,100,optional" frugal:"100,optional,i32" form:"page_number" json:"page_number,omitempty" query:"page_number"`
-	// 分页大小(0, 200]，默认为 20
-	PageSize *int32 `thrift:"page_size,101,optional" frugal:"101,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
-	// 与 page 同时提供时，优先使用 cursor
-	PageToken *string            `thrift:"page_token,102,optional" frugal:"102,optional,string" form:"page_token" json:"page_token,omitempty" query:"page_token"`
-	OrderBys  []*dataset.OrderBy `thrift:"order_bys,103,optional" frugal:"103,optional,list<dataset.OrderBy>" form:"order_bys" json:"order_bys,omitempty" query:"order_bys"`
-	Base      *base.Base         `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
-}
-
-func NewListDatasetItemsByVersionRequest() *ListDatasetItemsByVersionRequest {
-	return &ListDatasetItemsByVersionRequest{}
-}
-
-func (p *ListDatasetItemsByVersionRequest) InitDefault() {
+func (p *ListDatasetItemsRequest) InitDefault() {
 }
 
-var ListDatasetItemsByVersionRequest_WorkspaceID_DEFAULT int64
+var ListDatasetItemsRequest_WorkspaceID_DEFAULT int64
 
-func (p *ListDatasetItemsByVersionRequest) GetWorkspaceID() (v int64) {
+func (p *ListDatasetItemsRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return ListDatasetItemsByVersionRequest_WorkspaceID_DEFAULT
+		return ListDatasetItemsRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *ListDatasetItemsByVersionRequest) GetDatasetID() (v int64) {
+func (p *ListDatasetItemsRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-func (p *ListDatasetItemsByVersionRequest) GetVersionID() (v int64) {
-	if p != nil {
-		return p.VersionID
-	}
-	return
-}
-
-var ListDatasetItemsByVersionRequest_PageNumber_DEFAULT int32
+var ListDatasetItemsRequest_PageNumber_DEFAULT int32
 
-func (p *ListDatasetItemsByVersionRequest) GetPageNumber() (v int32) {
+func (p *ListDatasetItemsRequest) GetPageNumber() (v int32) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetPageNumber() {
-		return ListDatasetItemsByVersionRequest_PageNumber_DEFAULT
+		return ListDatasetItemsRequest_PageNumber_DEFAULT
 	}
 	return *p.PageNumber
 }
 
-var ListDatasetItemsByVersionRequest_PageSize_DEFAULT int32
+var ListDatasetItemsRequest_PageSize_DEFAULT int32
 
-func (p *ListDatasetItemsByVersionRequest) GetPageSize() (v int32) {
+func (p *ListDatasetItemsRequest) GetPageSize() (v int32) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetPageSize() {
-		return ListDatasetItemsByVersionRequest_PageSize_DEFAULT
+		return ListDatasetItemsRequest_PageSize_DEFAULT
 	}
 	return *p.PageSize
 }
 
-var ListDatasetItemsByVersionRequest_PageToken_DEFAULT string
+var ListDatasetItemsRequest_PageToken_DEFAULT string
 
-func (p *ListDatasetItemsByVersionRequest) GetPageToken() (v string) {
+func (p *ListDatasetItemsRequest) GetPageToken() (v string) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetPageToken() {
-		return ListDatasetItemsByVersionRequest_PageToken_DEFAULT
+		return ListDatasetItemsRequest_PageToken_DEFAULT
 	}
 	return *p.PageToken
 }
 
-var ListDatasetItemsByVersionRequest_OrderBys_DEFAULT []*dataset.OrderBy
+var ListDatasetItemsRequest_OrderBys_DEFAULT []*dataset.OrderBy
 
-func (p *ListDatasetItemsByVersionRequest) GetOrderBys() (v []*dataset.OrderBy) {
+func (p *ListDatasetItemsRequest) GetOrderBys() (v []*dataset.OrderBy) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetOrderBys() {
-		return ListDatasetItemsByVersionRequest_OrderBys_DEFAULT
+		return ListDatasetItemsRequest_OrderBys_DEFAULT
 	}
 	return p.OrderBys
 }
 
-var ListDatasetItemsByVersionRequest_Base_DEFAULT *base.Base
+var ListDatasetItemsRequest_Base_DEFAULT *base.Base
 
-func (p *ListDatasetItemsByVersionRequest) GetBase() (v *base.Base) {
+func (p *ListDatasetItemsRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return ListDatasetItemsByVersionRequest_Base_DEFAULT
+		return ListDatasetItemsRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *ListDatasetItemsByVersionRequest) SetWorkspaceID(val *int64) {
+func (p *ListDatasetItemsRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *ListDatasetItemsByVersionRequest) SetDatasetID(val int64) {
+func (p *ListDatasetItemsRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *ListDatasetItemsByVersionRequest) SetVersionID(val int64) {
-	p.VersionID = val
-}
-func (p *ListDatasetItemsByVersionRequest) SetPageNumber(val *int32) {
+func (p *ListDatasetItemsRequest) SetPageNumber(val *int32) {
 	p.PageNumber = val
 }
-func (p *ListDatasetItemsByVersionRequest) SetPageSize(val *int32) {
+func (p *ListDatasetItemsRequest) SetPageSize(val *int32) {
 	p.PageSize = val
 }
-func (p *ListDatasetItemsByVersionRequest) SetPageToken(val *string) {
+func (p *ListDatasetItemsRequest) SetPageToken(val *string) {
 	p.PageToken = val
 }
-func (p *ListDatasetItemsByVersionRequest) SetOrderBys(val []*dataset.OrderBy) {
+func (p *ListDatasetItemsRequest) SetOrderBys(val []*dataset.OrderBy) {
 	p.OrderBys = val
 }
-func (p *ListDatasetItemsByVersionRequest) SetBase(val *base.Base) {
+func (p *ListDatasetItemsRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_ListDatasetItemsByVersionRequest = map[int16]string{
+var fieldIDToName_ListDatasetItemsRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	3:   "version_id",
 	100: "page_number",
 	101: "page_size",
 	102: "page_token",
@@ -18082,35 +17733,34 @@ var fieldIDToName_ListDatasetItemsByVersionRequest = map[int16]string{
 	255: "Base",
 }
 
-func (p *ListDatasetItemsByVersionRequest) IsSetWorkspaceID() bool {
+func (p *ListDatasetItemsRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *ListDatasetItemsByVersionRequest) IsSetPageNumber() bool {
+func (p *ListDatasetItemsRequest) IsSetPageNumber() bool {
 	return p.PageNumber != nil
 }
 
-func (p *ListDatasetItemsByVersionRequest) IsSetPageSize() bool {
+func (p *ListDatasetItemsRequest) IsSetPageSize() bool {
 	return p.PageSize != nil
 }
 
-func (p *ListDatasetItemsByVersionRequest) IsSetPageToken() bool {
+func (p *ListDatasetItemsRequest) IsSetPageToken() bool {
 	return p.PageToken != nil
 }
 
-func (p *ListDatasetItemsByVersionRequest) IsSetOrderBys() bool {
+func (p *ListDatasetItemsRequest) IsSetOrderBys() bool {
 	return p.OrderBys != nil
 }
 
-func (p *ListDatasetItemsByVersionRequest) IsSetBase() bool {
+func (p *ListDatasetItemsRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *ListDatasetItemsByVersionRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
-	var issetVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -18143,15 +17793,6 @@ func (p *ListDatasetItemsByVersionRequest) Read(iprot thrift.TProtocol) (err err
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetVersionID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		case 100:
 			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField100(iprot); err != nil {
@@ -18209,18 +17850,13 @@ func (p *ListDatasetItemsByVersionRequest) Read(iprot thrift.TProtocol) (err err
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetVersionID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetItemsByVersionRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18229,10 +17865,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListDatasetItemsByVersionRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListDatasetItemsRequest[fieldId]))
 }
 
-func (p *ListDatasetItemsByVersionRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -18243,7 +17879,7 @@ func (p *ListDatasetItemsByVersionRequest) ReadField1(iprot thrift.TProtocol) er
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -18254,18 +17890,7 @@ func (p *ListDatasetItemsByVersionRequest) ReadField2(iprot thrift.TProtocol) er
 	p.DatasetID = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.VersionID = _field
-	return nil
-}
-func (p *ListDatasetItemsByVersionRequest) ReadField100(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsRequest) ReadField100(iprot thrift.TProtocol) error {
 
 	var _field *int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -18276,7 +17901,7 @@ func (p *ListDatasetItemsByVersionRequest) ReadField100(iprot thrift.TProtocol)
 	p.PageNumber = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionRequest) ReadField101(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsRequest) ReadField101(iprot thrift.TProtocol) error {
 
 	var _field *int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -18287,7 +17912,7 @@ func (p *ListDatasetItemsByVersionRequest) ReadField101(iprot thrift.TProtocol)
 	p.PageSize = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionRequest) ReadField102(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsRequest) ReadField102(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -18298,7 +17923,7 @@ func (p *ListDatasetItemsByVersionRequest) ReadField102(iprot thrift.TProtocol)
 	p.PageToken = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionRequest) ReadField103(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsRequest) ReadField103(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -18321,7 +17946,7 @@ func (p *ListDatasetItemsByVersionRequest) ReadField103(iprot thrift.TProtocol)
 	p.OrderBys = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -18330,9 +17955,9 @@ func (p *ListDatasetItemsByVersionRequest) ReadField255(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ListDatasetItemsByVersionRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasetItemsByVersionRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasetItemsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18344,10 +17969,6 @@ func (p *ListDatasetItemsByVersionRequest) Write(oprot thrift.TProtocol) (err er
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 		if err = p.writeField100(oprot); err != nil {
 			fieldId = 100
 			goto WriteFieldError
@@ -18386,7 +18007,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListDatasetItemsByVersionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -18404,7 +18025,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -18420,23 +18041,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("version_id", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.VersionID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ListDatasetItemsByVersionRequest) writeField100(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsRequest) writeField100(oprot thrift.TProtocol) (err error) {
 	if p.IsSetPageNumber() {
 		if err = oprot.WriteFieldBegin("page_number", thrift.I32, 100); err != nil {
 			goto WriteFieldBeginError
@@ -18454,7 +18059,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionRequest) writeField101(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsRequest) writeField101(oprot thrift.TProtocol) (err error) {
 	if p.IsSetPageSize() {
 		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 101); err != nil {
 			goto WriteFieldBeginError
@@ -18472,7 +18077,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionRequest) writeField102(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsRequest) writeField102(oprot thrift.TProtocol) (err error) {
 	if p.IsSetPageToken() {
 		if err = oprot.WriteFieldBegin("page_token", thrift.STRING, 102); err != nil {
 			goto WriteFieldBeginError
@@ -18490,7 +18095,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionRequest) writeField103(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsRequest) writeField103(oprot thrift.TProtocol) (err error) {
 	if p.IsSetOrderBys() {
 		if err = oprot.WriteFieldBegin("order_bys", thrift.LIST, 103); err != nil {
 			goto WriteFieldBeginError
@@ -18516,7 +18121,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -18535,15 +18140,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ListDatasetItemsByVersionRequest) String() string {
+func (p *ListDatasetItemsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDatasetItemsByVersionRequest(%+v)", *p)
+	return fmt.Sprintf("ListDatasetItemsRequest(%+v)", *p)
 
 }
 
-func (p *ListDatasetItemsByVersionRequest) DeepEqual(ano *ListDatasetItemsByVersionRequest) bool {
+func (p *ListDatasetItemsRequest) DeepEqual(ano *ListDatasetItemsRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -18555,9 +18160,6 @@ func (p *ListDatasetItemsByVersionRequest) DeepEqual(ano *ListDatasetItemsByVers
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.VersionID) {
-		return false
-	}
 	if !p.Field100DeepEqual(ano.PageNumber) {
 		return false
 	}
@@ -18576,7 +18178,7 @@ func (p *ListDatasetItemsByVersionRequest) DeepEqual(ano *ListDatasetItemsByVers
 	return true
 }
 
-func (p *ListDatasetItemsByVersionRequest) Field1DeepEqual(src *int64) bool {
+func (p *ListDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -18588,21 +18190,14 @@ func (p *ListDatasetItemsByVersionRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionRequest) Field2DeepEqual(src int64) bool {
+func (p *ListDatasetItemsRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionRequest) Field3DeepEqual(src int64) bool {
-
-	if p.VersionID != src {
-		return false
-	}
-	return true
-}
-func (p *ListDatasetItemsByVersionRequest) Field100DeepEqual(src *int32) bool {
+func (p *ListDatasetItemsRequest) Field100DeepEqual(src *int32) bool {
 
 	if p.PageNumber == src {
 		return true
@@ -18614,7 +18209,7 @@ func (p *ListDatasetItemsByVersionRequest) Field100DeepEqual(src *int32) bool {
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionRequest) Field101DeepEqual(src *int32) bool {
+func (p *ListDatasetItemsRequest) Field101DeepEqual(src *int32) bool {
 
 	if p.PageSize == src {
 		return true
@@ -18626,7 +18221,7 @@ func (p *ListDatasetItemsByVersionRequest) Field101DeepEqual(src *int32) bool {
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionRequest) Field102DeepEqual(src *string) bool {
+func (p *ListDatasetItemsRequest) Field102DeepEqual(src *string) bool {
 
 	if p.PageToken == src {
 		return true
@@ -18638,7 +18233,7 @@ func (p *ListDatasetItemsByVersionRequest) Field102DeepEqual(src *string) bool {
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionRequest) Field103DeepEqual(src []*dataset.OrderBy) bool {
+func (p *ListDatasetItemsRequest) Field103DeepEqual(src []*dataset.OrderBy) bool {
 
 	if len(p.OrderBys) != len(src) {
 		return false
@@ -18651,7 +18246,7 @@ func (p *ListDatasetItemsByVersionRequest) Field103DeepEqual(src []*dataset.Orde
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *ListDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -18659,98 +18254,98 @@ func (p *ListDatasetItemsByVersionRequest) Field255DeepEqual(src *base.Base) boo
 	return true
 }
 
-type ListDatasetItemsByVersionResponse struct {
+type ListDatasetItemsResponse struct {
 	Items []*dataset.DatasetItem `thrift:"items,1,optional" frugal:"1,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
 	/* pagination */
-	NextPageToken *string        `thrift:"next_page_token,100,optional" frugal:"100,optional,string" json:"next_page_token" form:"next_page_token" query:"next_page_token"`
+	NextPageToken *string        `thrift:"next_page_token,100,optional" frugal:"100,optional,string" form:"next_page_token" json:"next_page_token,omitempty" query:"next_page_token"`
 	Total         *int64         `thrift:"total,101,optional" frugal:"101,optional,i64" json:"total" form:"total" query:"total"`
 	FilterTotal   *int64         `thrift:"filter_total,102,optional" frugal:"102,optional,i64" json:"filter_total" form:"filter_total" query:"filter_total"`
 	BaseResp      *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewListDatasetItemsByVersionResponse() *ListDatasetItemsByVersionResponse {
-	return &ListDatasetItemsByVersionResponse{}
+func NewListDatasetItemsResponse() *ListDatasetItemsResponse {
+	return &ListDatasetItemsResponse{}
 }
 
-func (p *ListDatasetItemsByVersionResponse) InitDefault() {
+func (p *ListDatasetItemsResponse) InitDefault() {
 }
 
-var ListDatasetItemsByVersionResponse_Items_DEFAULT []*dataset.DatasetItem
+var ListDatasetItemsResponse_Items_DEFAULT []*dataset.DatasetItem
 
-func (p *ListDatasetItemsByVersionResponse) GetItems() (v []*dataset.DatasetItem) {
+func (p *ListDatasetItemsResponse) GetItems() (v []*dataset.DatasetItem) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetItems() {
-		return ListDatasetItemsByVersionResponse_Items_DEFAULT
+		return ListDatasetItemsResponse_Items_DEFAULT
 	}
 	return p.Items
 }
 
-var ListDatasetItemsByVersionResponse_NextPageToken_DEFAULT string
+var ListDatasetItemsResponse_NextPageToken_DEFAULT string
 
-func (p *ListDatasetItemsByVersionResponse) GetNextPageToken() (v string) {
+func (p *ListDatasetItemsResponse) GetNextPageToken() (v string) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetNextPageToken() {
-		return ListDatasetItemsByVersionResponse_NextPageToken_DEFAULT
+		return ListDatasetItemsResponse_NextPageToken_DEFAULT
 	}
 	return *p.NextPageToken
 }
 
-var ListDatasetItemsByVersionResponse_Total_DEFAULT int64
+var ListDatasetItemsResponse_Total_DEFAULT int64
 
-func (p *ListDatasetItemsByVersionResponse) GetTotal() (v int64) {
+func (p *ListDatasetItemsResponse) GetTotal() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetTotal() {
-		return ListDatasetItemsByVersionResponse_Total_DEFAULT
+		return ListDatasetItemsResponse_Total_DEFAULT
 	}
 	return *p.Total
 }
 
-var ListDatasetItemsByVersionResponse_FilterTotal_DEFAULT int64
+var ListDatasetItemsResponse_FilterTotal_DEFAULT int64
 
-func (p *ListDatasetItemsByVersionResponse) GetFilterTotal() (v int64) {
+func (p *ListDatasetItemsResponse) GetFilterTotal() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetFilterTotal() {
-		return ListDatasetItemsByVersionResponse_FilterTotal_DEFAULT
+		return ListDatasetItemsResponse_FilterTotal_DEFAULT
 	}
 	return *p.FilterTotal
 }
 
-var ListDatasetItemsByVersionResponse_BaseResp_DEFAULT *base.BaseResp
+var ListDatasetItemsResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *ListDatasetItemsByVersionResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *ListDatasetItemsResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return ListDatasetItemsByVersionResponse_BaseResp_DEFAULT
+		return ListDatasetItemsResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *ListDatasetItemsByVersionResponse) SetItems(val []*dataset.DatasetItem) {
+func (p *ListDatasetItemsResponse) SetItems(val []*dataset.DatasetItem) {
 	p.Items = val
 }
-func (p *ListDatasetItemsByVersionResponse) SetNextPageToken(val *string) {
+func (p *ListDatasetItemsResponse) SetNextPageToken(val *string) {
 	p.NextPageToken = val
 }
-func (p *ListDatasetItemsByVersionResponse) SetTotal(val *int64) {
+func (p *ListDatasetItemsResponse) SetTotal(val *int64) {
 	p.Total = val
 }
-func (p *ListDatasetItemsByVersionResponse) SetFilterTotal(val *int64) {
+func (p *ListDatasetItemsResponse) SetFilterTotal(val *int64) {
 	p.FilterTotal = val
 }
-func (p *ListDatasetItemsByVersionResponse) SetBaseResp(val *base.BaseResp) {
+func (p *ListDatasetItemsResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_ListDatasetItemsByVersionResponse = map[int16]string{
+var fieldIDToName_ListDatasetItemsResponse = map[int16]string{
 	1:   "items",
 	100: "next_page_token",
 	101: "total",
@@ -18758,27 +18353,27 @@ var fieldIDToName_ListDatasetItemsByVersionResponse = map[int16]string{
 	255: "BaseResp",
 }
 
-func (p *ListDatasetItemsByVersionResponse) IsSetItems() bool {
+func (p *ListDatasetItemsResponse) IsSetItems() bool {
 	return p.Items != nil
 }
 
-func (p *ListDatasetItemsByVersionResponse) IsSetNextPageToken() bool {
+func (p *ListDatasetItemsResponse) IsSetNextPageToken() bool {
 	return p.NextPageToken != nil
 }
 
-func (p *ListDatasetItemsByVersionResponse) IsSetTotal() bool {
+func (p *ListDatasetItemsResponse) IsSetTotal() bool {
 	return p.Total != nil
 }
 
-func (p *ListDatasetItemsByVersionResponse) IsSetFilterTotal() bool {
+func (p *ListDatasetItemsResponse) IsSetFilterTotal() bool {
 	return p.FilterTotal != nil
 }
 
-func (p *ListDatasetItemsByVersionResponse) IsSetBaseResp() bool {
+func (p *ListDatasetItemsResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *ListDatasetItemsByVersionResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -18855,7 +18450,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetItemsByVersionResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18865,7 +18460,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListDatasetItemsByVersionResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsResponse) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -18888,7 +18483,7 @@ func (p *ListDatasetItemsByVersionResponse) ReadField1(iprot thrift.TProtocol) e
 	p.Items = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionResponse) ReadField100(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsResponse) ReadField100(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -18899,7 +18494,7 @@ func (p *ListDatasetItemsByVersionResponse) ReadField100(iprot thrift.TProtocol)
 	p.NextPageToken = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionResponse) ReadField101(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsResponse) ReadField101(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -18910,7 +18505,7 @@ func (p *ListDatasetItemsByVersionResponse) ReadField101(iprot thrift.TProtocol)
 	p.Total = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionResponse) ReadField102(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsResponse) ReadField102(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -18921,7 +18516,7 @@ func (p *ListDatasetItemsByVersionResponse) ReadField102(iprot thrift.TProtocol)
 	p.FilterTotal = _field
 	return nil
 }
-func (p *ListDatasetItemsByVersionResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -18930,9 +18525,9 @@ func (p *ListDatasetItemsByVersionResponse) ReadField255(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ListDatasetItemsByVersionResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasetItemsByVersionResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasetItemsResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18974,7 +18569,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListDatasetItemsByVersionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetItems() {
 		if err = oprot.WriteFieldBegin("items", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
@@ -19000,7 +18595,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionResponse) writeField100(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsResponse) writeField100(oprot thrift.TProtocol) (err error) {
 	if p.IsSetNextPageToken() {
 		if err = oprot.WriteFieldBegin("next_page_token", thrift.STRING, 100); err != nil {
 			goto WriteFieldBeginError
@@ -19018,7 +18613,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionResponse) writeField101(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsResponse) writeField101(oprot thrift.TProtocol) (err error) {
 	if p.IsSetTotal() {
 		if err = oprot.WriteFieldBegin("total", thrift.I64, 101); err != nil {
 			goto WriteFieldBeginError
@@ -19036,7 +18631,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionResponse) writeField102(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsResponse) writeField102(oprot thrift.TProtocol) (err error) {
 	if p.IsSetFilterTotal() {
 		if err = oprot.WriteFieldBegin("filter_total", thrift.I64, 102); err != nil {
 			goto WriteFieldBeginError
@@ -19054,7 +18649,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
 }
-func (p *ListDatasetItemsByVersionResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -19071,15 +18666,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ListDatasetItemsByVersionResponse) String() string {
+func (p *ListDatasetItemsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListDatasetItemsByVersionResponse(%+v)", *p)
+	return fmt.Sprintf("ListDatasetItemsResponse(%+v)", *p)
 
 }
 
-func (p *ListDatasetItemsByVersionResponse) DeepEqual(ano *ListDatasetItemsByVersionResponse) bool {
+func (p *ListDatasetItemsResponse) DeepEqual(ano *ListDatasetItemsResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -19103,7 +18698,7 @@ func (p *ListDatasetItemsByVersionResponse) DeepEqual(ano *ListDatasetItemsByVer
 	return true
 }
 
-func (p *ListDatasetItemsByVersionResponse) Field1DeepEqual(src []*dataset.DatasetItem) bool {
+func (p *ListDatasetItemsResponse) Field1DeepEqual(src []*dataset.DatasetItem) bool {
 
 	if len(p.Items) != len(src) {
 		return false
@@ -19116,7 +18711,7 @@ func (p *ListDatasetItemsByVersionResponse) Field1DeepEqual(src []*dataset.Datas
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionResponse) Field100DeepEqual(src *string) bool {
+func (p *ListDatasetItemsResponse) Field100DeepEqual(src *string) bool {
 
 	if p.NextPageToken == src {
 		return true
@@ -19128,7 +18723,7 @@ func (p *ListDatasetItemsByVersionResponse) Field100DeepEqual(src *string) bool
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionResponse) Field101DeepEqual(src *int64) bool {
+func (p *ListDatasetItemsResponse) Field101DeepEqual(src *int64) bool {
 
 	if p.Total == src {
 		return true
@@ -19140,7 +18735,7 @@ func (p *ListDatasetItemsByVersionResponse) Field101DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionResponse) Field102DeepEqual(src *int64) bool {
+func (p *ListDatasetItemsResponse) Field102DeepEqual(src *int64) bool {
 
 	if p.FilterTotal == src {
 		return true
@@ -19152,7 +18747,7 @@ func (p *ListDatasetItemsByVersionResponse) Field102DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *ListDatasetItemsByVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *ListDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -19160,90 +18755,177 @@ func (p *ListDatasetItemsByVersionResponse) Field255DeepEqual(src *base.BaseResp
 	return true
 }
 
-type GetDatasetItemRequest struct {
-	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
-	DatasetID   int64      `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	ItemID      int64      `thrift:"item_id,3,required" frugal:"3,required,i64" json:"item_id" path:"item_id,required" `
-	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+type ListDatasetItemsByVersionRequest struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	DatasetID   int64  `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	VersionID   int64  `thrift:"version_id,3,required" frugal:"3,required,i64" json:"version_id" path:"version_id,required" `
+	/* pagination */
+	PageNumber *int32 `thrift:"page_number,100,optional" frugal:"100,optional,i32" form:"page_number" json:"page_number,omitempty" query:"page_number"`
+	// 分页大小(0, 200]，默认为 20
+	PageSize *int32 `thrift:"page_size,101,optional" frugal:"101,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
+	// 与 page 同时提供时，优先使用 cursor
+	PageToken *string            `thrift:"page_token,102,optional" frugal:"102,optional,string" form:"page_token" json:"page_token,omitempty" query:"page_token"`
+	OrderBys  []*dataset.OrderBy `thrift:"order_bys,103,optional" frugal:"103,optional,list<dataset.OrderBy>" form:"order_bys" json:"order_bys,omitempty" query:"order_bys"`
+	Base      *base.Base         `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewGetDatasetItemRequest() *GetDatasetItemRequest {
-	return &GetDatasetItemRequest{}
+func NewListDatasetItemsByVersionRequest() *ListDatasetItemsByVersionRequest {
+	return &ListDatasetItemsByVersionRequest{}
 }
 
-func (p *GetDatasetItemRequest) InitDefault() {
+func (p *ListDatasetItemsByVersionRequest) InitDefault() {
 }
 
-var GetDatasetItemRequest_WorkspaceID_DEFAULT int64
+var ListDatasetItemsByVersionRequest_WorkspaceID_DEFAULT int64
 
-func (p *GetDatasetItemRequest) GetWorkspaceID() (v int64) {
+func (p *ListDatasetItemsByVersionRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return GetDatasetItemRequest_WorkspaceID_DEFAULT
+		return ListDatasetItemsByVersionRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *GetDatasetItemRequest) GetDatasetID() (v int64) {
+func (p *ListDatasetItemsByVersionRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-func (p *GetDatasetItemRequest) GetItemID() (v int64) {
+func (p *ListDatasetItemsByVersionRequest) GetVersionID() (v int64) {
 	if p != nil {
-		return p.ItemID
+		return p.VersionID
 	}
 	return
 }
 
-var GetDatasetItemRequest_Base_DEFAULT *base.Base
+var ListDatasetItemsByVersionRequest_PageNumber_DEFAULT int32
 
-func (p *GetDatasetItemRequest) GetBase() (v *base.Base) {
+func (p *ListDatasetItemsByVersionRequest) GetPageNumber() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageNumber() {
+		return ListDatasetItemsByVersionRequest_PageNumber_DEFAULT
+	}
+	return *p.PageNumber
+}
+
+var ListDatasetItemsByVersionRequest_PageSize_DEFAULT int32
+
+func (p *ListDatasetItemsByVersionRequest) GetPageSize() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageSize() {
+		return ListDatasetItemsByVersionRequest_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var ListDatasetItemsByVersionRequest_PageToken_DEFAULT string
+
+func (p *ListDatasetItemsByVersionRequest) GetPageToken() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageToken() {
+		return ListDatasetItemsByVersionRequest_PageToken_DEFAULT
+	}
+	return *p.PageToken
+}
+
+var ListDatasetItemsByVersionRequest_OrderBys_DEFAULT []*dataset.OrderBy
+
+func (p *ListDatasetItemsByVersionRequest) GetOrderBys() (v []*dataset.OrderBy) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOrderBys() {
+		return ListDatasetItemsByVersionRequest_OrderBys_DEFAULT
+	}
+	return p.OrderBys
+}
+
+var ListDatasetItemsByVersionRequest_Base_DEFAULT *base.Base
+
+func (p *ListDatasetItemsByVersionRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return GetDatasetItemRequest_Base_DEFAULT
+		return ListDatasetItemsByVersionRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *GetDatasetItemRequest) SetWorkspaceID(val *int64) {
+func (p *ListDatasetItemsByVersionRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *GetDatasetItemRequest) SetDatasetID(val int64) {
+func (p *ListDatasetItemsByVersionRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *GetDatasetItemRequest) SetItemID(val int64) {
-	p.ItemID = val
+func (p *ListDatasetItemsByVersionRequest) SetVersionID(val int64) {
+	p.VersionID = val
 }
-func (p *GetDatasetItemRequest) SetBase(val *base.Base) {
+func (p *ListDatasetItemsByVersionRequest) SetPageNumber(val *int32) {
+	p.PageNumber = val
+}
+func (p *ListDatasetItemsByVersionRequest) SetPageSize(val *int32) {
+	p.PageSize = val
+}
+func (p *ListDatasetItemsByVersionRequest) SetPageToken(val *string) {
+	p.PageToken = val
+}
+func (p *ListDatasetItemsByVersionRequest) SetOrderBys(val []*dataset.OrderBy) {
+	p.OrderBys = val
+}
+func (p *ListDatasetItemsByVersionRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_GetDatasetItemRequest = map[int16]string{
+var fieldIDToName_ListDatasetItemsByVersionRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	3:   "item_id",
+	3:   "version_id",
+	100: "page_number",
+	101: "page_size",
+	102: "page_token",
+	103: "order_bys",
 	255: "Base",
 }
 
-func (p *GetDatasetItemRequest) IsSetWorkspaceID() bool {
+func (p *ListDatasetItemsByVersionRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *GetDatasetItemRequest) IsSetBase() bool {
+func (p *ListDatasetItemsByVersionRequest) IsSetPageNumber() bool {
+	return p.PageNumber != nil
+}
+
+func (p *ListDatasetItemsByVersionRequest) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *ListDatasetItemsByVersionRequest) IsSetPageToken() bool {
+	return p.PageToken != nil
+}
+
+func (p *ListDatasetItemsByVersionRequest) IsSetOrderBys() bool {
+	return p.OrderBys != nil
+}
+
+func (p *ListDatasetItemsByVersionRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *GetDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsByVersionRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
-	var issetItemID bool = false
+	var issetVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -19281,7 +18963,39 @@ func (p *GetDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
+				issetVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 100:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 101:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField101(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 102:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField102(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 103:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField103(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -19311,7 +19025,7 @@ func (p *GetDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetItemID {
+	if !issetVersionID {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
@@ -19321,7 +19035,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetItemRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetItemsByVersionRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19330,10 +19044,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetDatasetItemRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListDatasetItemsByVersionRequest[fieldId]))
 }
 
-func (p *GetDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsByVersionRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -19344,7 +19058,7 @@ func (p *GetDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *GetDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsByVersionRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -19355,7 +19069,7 @@ func (p *GetDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.DatasetID = _field
 	return nil
 }
-func (p *GetDatasetItemRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsByVersionRequest) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -19363,10 +19077,66 @@ func (p *GetDatasetItemRequest) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ItemID = _field
+	p.VersionID = _field
 	return nil
 }
-func (p *GetDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsByVersionRequest) ReadField100(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageNumber = _field
+	return nil
+}
+func (p *ListDatasetItemsByVersionRequest) ReadField101(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *ListDatasetItemsByVersionRequest) ReadField102(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageToken = _field
+	return nil
+}
+func (p *ListDatasetItemsByVersionRequest) ReadField103(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset.OrderBy, 0, size)
+	values := make([]dataset.OrderBy, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.OrderBys = _field
+	return nil
+}
+func (p *ListDatasetItemsByVersionRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -19375,9 +19145,9 @@ func (p *GetDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsByVersionRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDatasetItemRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasetItemsByVersionRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19393,6 +19163,22 @@ func (p *GetDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+		if err = p.writeField101(oprot); err != nil {
+			fieldId = 101
+			goto WriteFieldError
+		}
+		if err = p.writeField102(oprot); err != nil {
+			fieldId = 102
+			goto WriteFieldError
+		}
+		if err = p.writeField103(oprot); err != nil {
+			fieldId = 103
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -19415,7 +19201,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetDatasetItemRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsByVersionRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -19433,7 +19219,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetDatasetItemRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsByVersionRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -19449,11 +19235,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetDatasetItemRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("item_id", thrift.I64, 3); err != nil {
+func (p *ListDatasetItemsByVersionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("version_id", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ItemID); err != nil {
+	if err := oprot.WriteI64(p.VersionID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -19465,7 +19251,87 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *GetDatasetItemRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsByVersionRequest) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageNumber() {
+		if err = oprot.WriteFieldBegin("page_number", thrift.I32, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageNumber); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+func (p *ListDatasetItemsByVersionRequest) writeField101(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 101); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+}
+func (p *ListDatasetItemsByVersionRequest) writeField102(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageToken() {
+		if err = oprot.WriteFieldBegin("page_token", thrift.STRING, 102); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PageToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
+}
+func (p *ListDatasetItemsByVersionRequest) writeField103(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrderBys() {
+		if err = oprot.WriteFieldBegin("order_bys", thrift.LIST, 103); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OrderBys)); err != nil {
+			return err
+		}
+		for _, v := range p.OrderBys {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 103 end error: ", p), err)
+}
+func (p *ListDatasetItemsByVersionRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -19484,15 +19350,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *GetDatasetItemRequest) String() string {
+func (p *ListDatasetItemsByVersionRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDatasetItemRequest(%+v)", *p)
+	return fmt.Sprintf("ListDatasetItemsByVersionRequest(%+v)", *p)
 
 }
 
-func (p *GetDatasetItemRequest) DeepEqual(ano *GetDatasetItemRequest) bool {
+func (p *ListDatasetItemsByVersionRequest) DeepEqual(ano *ListDatasetItemsByVersionRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -19504,7 +19370,19 @@ func (p *GetDatasetItemRequest) DeepEqual(ano *GetDatasetItemRequest) bool {
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ItemID) {
+	if !p.Field3DeepEqual(ano.VersionID) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.PageNumber) {
+		return false
+	}
+	if !p.Field101DeepEqual(ano.PageSize) {
+		return false
+	}
+	if !p.Field102DeepEqual(ano.PageToken) {
+		return false
+	}
+	if !p.Field103DeepEqual(ano.OrderBys) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -19513,7 +19391,7 @@ func (p *GetDatasetItemRequest) DeepEqual(ano *GetDatasetItemRequest) bool {
 	return true
 }
 
-func (p *GetDatasetItemRequest) Field1DeepEqual(src *int64) bool {
+func (p *ListDatasetItemsByVersionRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -19525,84 +19403,197 @@ func (p *GetDatasetItemRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *GetDatasetItemRequest) Field2DeepEqual(src int64) bool {
+func (p *ListDatasetItemsByVersionRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *GetDatasetItemRequest) Field3DeepEqual(src int64) bool {
+func (p *ListDatasetItemsByVersionRequest) Field3DeepEqual(src int64) bool {
 
-	if p.ItemID != src {
+	if p.VersionID != src {
 		return false
 	}
 	return true
 }
-func (p *GetDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *ListDatasetItemsByVersionRequest) Field100DeepEqual(src *int32) bool {
 
-	if !p.Base.DeepEqual(src) {
+	if p.PageNumber == src {
+		return true
+	} else if p.PageNumber == nil || src == nil {
+		return false
+	}
+	if *p.PageNumber != *src {
 		return false
 	}
 	return true
 }
+func (p *ListDatasetItemsByVersionRequest) Field101DeepEqual(src *int32) bool {
 
-type GetDatasetItemResponse struct {
-	Item     *dataset.DatasetItem `thrift:"item,1,optional" frugal:"1,optional,dataset.DatasetItem" form:"item" json:"item,omitempty" query:"item"`
-	BaseResp *base.BaseResp       `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
-}
-
-func NewGetDatasetItemResponse() *GetDatasetItemResponse {
-	return &GetDatasetItemResponse{}
-}
-
-func (p *GetDatasetItemResponse) InitDefault() {
-}
-
-var GetDatasetItemResponse_Item_DEFAULT *dataset.DatasetItem
-
-func (p *GetDatasetItemResponse) GetItem() (v *dataset.DatasetItem) {
-	if p == nil {
-		return
+	if p.PageSize == src {
+		return true
+	} else if p.PageSize == nil || src == nil {
+		return false
 	}
-	if !p.IsSetItem() {
-		return GetDatasetItemResponse_Item_DEFAULT
+	if *p.PageSize != *src {
+		return false
 	}
-	return p.Item
+	return true
 }
+func (p *ListDatasetItemsByVersionRequest) Field102DeepEqual(src *string) bool {
 
-var GetDatasetItemResponse_BaseResp_DEFAULT *base.BaseResp
-
-func (p *GetDatasetItemResponse) GetBaseResp() (v *base.BaseResp) {
-	if p == nil {
-		return
+	if p.PageToken == src {
+		return true
+	} else if p.PageToken == nil || src == nil {
+		return false
 	}
-	if !p.IsSetBaseResp() {
-		return GetDatasetItemResponse_BaseResp_DEFAULT
+	if strings.Compare(*p.PageToken, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListDatasetItemsByVersionRequest) Field103DeepEqual(src []*dataset.OrderBy) bool {
+
+	if len(p.OrderBys) != len(src) {
+		return false
+	}
+	for i, v := range p.OrderBys {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListDatasetItemsByVersionRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListDatasetItemsByVersionResponse struct {
+	Items []*dataset.DatasetItem `thrift:"items,1,optional" frugal:"1,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
+	/* pagination */
+	NextPageToken *string        `thrift:"next_page_token,100,optional" frugal:"100,optional,string" json:"next_page_token" form:"next_page_token" query:"next_page_token"`
+	Total         *int64         `thrift:"total,101,optional" frugal:"101,optional,i64" json:"total" form:"total" query:"total"`
+	FilterTotal   *int64         `thrift:"filter_total,102,optional" frugal:"102,optional,i64" json:"filter_total" form:"filter_total" query:"filter_total"`
+	BaseResp      *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewListDatasetItemsByVersionResponse() *ListDatasetItemsByVersionResponse {
+	return &ListDatasetItemsByVersionResponse{}
+}
+
+func (p *ListDatasetItemsByVersionResponse) InitDefault() {
+}
+
+var ListDatasetItemsByVersionResponse_Items_DEFAULT []*dataset.DatasetItem
+
+func (p *ListDatasetItemsByVersionResponse) GetItems() (v []*dataset.DatasetItem) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItems() {
+		return ListDatasetItemsByVersionResponse_Items_DEFAULT
+	}
+	return p.Items
+}
+
+var ListDatasetItemsByVersionResponse_NextPageToken_DEFAULT string
+
+func (p *ListDatasetItemsByVersionResponse) GetNextPageToken() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetNextPageToken() {
+		return ListDatasetItemsByVersionResponse_NextPageToken_DEFAULT
+	}
+	return *p.NextPageToken
+}
+
+var ListDatasetItemsByVersionResponse_Total_DEFAULT int64
+
+func (p *ListDatasetItemsByVersionResponse) GetTotal() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTotal() {
+		return ListDatasetItemsByVersionResponse_Total_DEFAULT
+	}
+	return *p.Total
+}
+
+var ListDatasetItemsByVersionResponse_FilterTotal_DEFAULT int64
+
+func (p *ListDatasetItemsByVersionResponse) GetFilterTotal() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilterTotal() {
+		return ListDatasetItemsByVersionResponse_FilterTotal_DEFAULT
+	}
+	return *p.FilterTotal
+}
+
+var ListDatasetItemsByVersionResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ListDatasetItemsByVersionResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return ListDatasetItemsByVersionResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *GetDatasetItemResponse) SetItem(val *dataset.DatasetItem) {
-	p.Item = val
+func (p *ListDatasetItemsByVersionResponse) SetItems(val []*dataset.DatasetItem) {
+	p.Items = val
 }
-func (p *GetDatasetItemResponse) SetBaseResp(val *base.BaseResp) {
+func (p *ListDatasetItemsByVersionResponse) SetNextPageToken(val *string) {
+	p.NextPageToken = val
+}
+func (p *ListDatasetItemsByVersionResponse) SetTotal(val *int64) {
+	p.Total = val
+}
+func (p *ListDatasetItemsByVersionResponse) SetFilterTotal(val *int64) {
+	p.FilterTotal = val
+}
+func (p *ListDatasetItemsByVersionResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_GetDatasetItemResponse = map[int16]string{
-	1:   "item",
+var fieldIDToName_ListDatasetItemsByVersionResponse = map[int16]string{
+	1:   "items",
+	100: "next_page_token",
+	101: "total",
+	102: "filter_total",
 	255: "BaseResp",
 }
 
-func (p *GetDatasetItemResponse) IsSetItem() bool {
-	return p.Item != nil
+func (p *ListDatasetItemsByVersionResponse) IsSetItems() bool {
+	return p.Items != nil
 }
 
-func (p *GetDatasetItemResponse) IsSetBaseResp() bool {
+func (p *ListDatasetItemsByVersionResponse) IsSetNextPageToken() bool {
+	return p.NextPageToken != nil
+}
+
+func (p *ListDatasetItemsByVersionResponse) IsSetTotal() bool {
+	return p.Total != nil
+}
+
+func (p *ListDatasetItemsByVersionResponse) IsSetFilterTotal() bool {
+	return p.FilterTotal != nil
+}
+
+func (p *ListDatasetItemsByVersionResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsByVersionResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -19621,13 +19612,37 @@ func (p *GetDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 100:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField100(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 101:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField101(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 102:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField102(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -19655,7 +19670,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetItemResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListDatasetItemsByVersionResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19665,15 +19680,63 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetDatasetItemResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := dataset.NewDatasetItem()
-	if err := _field.Read(iprot); err != nil {
+func (p *ListDatasetItemsByVersionResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.Item = _field
+	_field := make([]*dataset.DatasetItem, 0, size)
+	values := make([]dataset.DatasetItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Items = _field
 	return nil
 }
-func (p *GetDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListDatasetItemsByVersionResponse) ReadField100(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextPageToken = _field
+	return nil
+}
+func (p *ListDatasetItemsByVersionResponse) ReadField101(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *ListDatasetItemsByVersionResponse) ReadField102(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FilterTotal = _field
+	return nil
+}
+func (p *ListDatasetItemsByVersionResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -19682,9 +19745,9 @@ func (p *GetDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetDatasetItemResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsByVersionResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDatasetItemResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasetItemsByVersionResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19692,6 +19755,18 @@ func (p *GetDatasetItemResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField100(oprot); err != nil {
+			fieldId = 100
+			goto WriteFieldError
+		}
+		if err = p.writeField101(oprot); err != nil {
+			fieldId = 101
+			goto WriteFieldError
+		}
+		if err = p.writeField102(oprot); err != nil {
+			fieldId = 102
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -19714,12 +19789,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetDatasetItemResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItem() {
-		if err = oprot.WriteFieldBegin("item", thrift.STRUCT, 1); err != nil {
+func (p *ListDatasetItemsByVersionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItems() {
+		if err = oprot.WriteFieldBegin("items", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Item.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
+			return err
+		}
+		for _, v := range p.Items {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -19732,7 +19815,61 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetDatasetItemResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListDatasetItemsByVersionResponse) writeField100(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextPageToken() {
+		if err = oprot.WriteFieldBegin("next_page_token", thrift.STRING, 100); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextPageToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 100 end error: ", p), err)
+}
+func (p *ListDatasetItemsByVersionResponse) writeField101(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotal() {
+		if err = oprot.WriteFieldBegin("total", thrift.I64, 101); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Total); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+}
+func (p *ListDatasetItemsByVersionResponse) writeField102(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilterTotal() {
+		if err = oprot.WriteFieldBegin("filter_total", thrift.I64, 102); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.FilterTotal); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
+}
+func (p *ListDatasetItemsByVersionResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -19749,21 +19886,30 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *GetDatasetItemResponse) String() string {
+func (p *ListDatasetItemsByVersionResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetDatasetItemResponse(%+v)", *p)
+	return fmt.Sprintf("ListDatasetItemsByVersionResponse(%+v)", *p)
 
 }
 
-func (p *GetDatasetItemResponse) DeepEqual(ano *GetDatasetItemResponse) bool {
+func (p *ListDatasetItemsByVersionResponse) DeepEqual(ano *ListDatasetItemsByVersionResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Item) {
+	if !p.Field1DeepEqual(ano.Items) {
+		return false
+	}
+	if !p.Field100DeepEqual(ano.NextPageToken) {
+		return false
+	}
+	if !p.Field101DeepEqual(ano.Total) {
+		return false
+	}
+	if !p.Field102DeepEqual(ano.FilterTotal) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
@@ -19772,14 +19918,56 @@ func (p *GetDatasetItemResponse) DeepEqual(ano *GetDatasetItemResponse) bool {
 	return true
 }
 
-func (p *GetDatasetItemResponse) Field1DeepEqual(src *dataset.DatasetItem) bool {
+func (p *ListDatasetItemsByVersionResponse) Field1DeepEqual(src []*dataset.DatasetItem) bool {
 
-	if !p.Item.DeepEqual(src) {
+	if len(p.Items) != len(src) {
+		return false
+	}
+	for i, v := range p.Items {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListDatasetItemsByVersionResponse) Field100DeepEqual(src *string) bool {
+
+	if p.NextPageToken == src {
+		return true
+	} else if p.NextPageToken == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextPageToken, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *GetDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *ListDatasetItemsByVersionResponse) Field101DeepEqual(src *int64) bool {
+
+	if p.Total == src {
+		return true
+	} else if p.Total == nil || src == nil {
+		return false
+	}
+	if *p.Total != *src {
+		return false
+	}
+	return true
+}
+func (p *ListDatasetItemsByVersionResponse) Field102DeepEqual(src *int64) bool {
+
+	if p.FilterTotal == src {
+		return true
+	} else if p.FilterTotal == nil || src == nil {
+		return false
+	}
+	if *p.FilterTotal != *src {
+		return false
+	}
+	return true
+}
+func (p *ListDatasetItemsByVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -19787,90 +19975,90 @@ func (p *GetDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type BatchGetDatasetItemsRequest struct {
-	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+type GetDatasetItemRequest struct {
+	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" query:"workspace_id" `
 	DatasetID   int64      `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	ItemIds     []int64    `thrift:"item_ids,3,required" frugal:"3,required,list<i64>" json:"item_ids" form:"item_ids,required" query:"item_ids,required"`
+	ItemID      int64      `thrift:"item_id,3,required" frugal:"3,required,i64" json:"item_id" path:"item_id,required" `
 	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewBatchGetDatasetItemsRequest() *BatchGetDatasetItemsRequest {
-	return &BatchGetDatasetItemsRequest{}
+func NewGetDatasetItemRequest() *GetDatasetItemRequest {
+	return &GetDatasetItemRequest{}
 }
 
-func (p *BatchGetDatasetItemsRequest) InitDefault() {
+func (p *GetDatasetItemRequest) InitDefault() {
 }
 
-var BatchGetDatasetItemsRequest_WorkspaceID_DEFAULT int64
+var GetDatasetItemRequest_WorkspaceID_DEFAULT int64
 
-func (p *BatchGetDatasetItemsRequest) GetWorkspaceID() (v int64) {
+func (p *GetDatasetItemRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return BatchGetDatasetItemsRequest_WorkspaceID_DEFAULT
+		return GetDatasetItemRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *BatchGetDatasetItemsRequest) GetDatasetID() (v int64) {
+func (p *GetDatasetItemRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-func (p *BatchGetDatasetItemsRequest) GetItemIds() (v []int64) {
+func (p *GetDatasetItemRequest) GetItemID() (v int64) {
 	if p != nil {
-		return p.ItemIds
+		return p.ItemID
 	}
 	return
 }
 
-var BatchGetDatasetItemsRequest_Base_DEFAULT *base.Base
+var GetDatasetItemRequest_Base_DEFAULT *base.Base
 
-func (p *BatchGetDatasetItemsRequest) GetBase() (v *base.Base) {
+func (p *GetDatasetItemRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return BatchGetDatasetItemsRequest_Base_DEFAULT
+		return GetDatasetItemRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *BatchGetDatasetItemsRequest) SetWorkspaceID(val *int64) {
+func (p *GetDatasetItemRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *BatchGetDatasetItemsRequest) SetDatasetID(val int64) {
+func (p *GetDatasetItemRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *BatchGetDatasetItemsRequest) SetItemIds(val []int64) {
-	p.ItemIds = val
+func (p *GetDatasetItemRequest) SetItemID(val int64) {
+	p.ItemID = val
 }
-func (p *BatchGetDatasetItemsRequest) SetBase(val *base.Base) {
+func (p *GetDatasetItemRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_BatchGetDatasetItemsRequest = map[int16]string{
+var fieldIDToName_GetDatasetItemRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	3:   "item_ids",
+	3:   "item_id",
 	255: "Base",
 }
 
-func (p *BatchGetDatasetItemsRequest) IsSetWorkspaceID() bool {
+func (p *GetDatasetItemRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *BatchGetDatasetItemsRequest) IsSetBase() bool {
+func (p *GetDatasetItemRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *BatchGetDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
-	var issetItemIds bool = false
+	var issetItemID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -19904,11 +20092,11 @@ func (p *BatchGetDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetItemIds = true
+				issetItemID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -19938,7 +20126,7 @@ func (p *BatchGetDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetItemIds {
+	if !issetItemID {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
@@ -19948,7 +20136,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetItemsRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetItemRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19957,10 +20145,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchGetDatasetItemsRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetDatasetItemRequest[fieldId]))
 }
 
-func (p *BatchGetDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -19971,7 +20159,7 @@ func (p *BatchGetDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *BatchGetDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -19982,30 +20170,18 @@ func (p *BatchGetDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.DatasetID = _field
 	return nil
 }
-func (p *BatchGetDatasetItemsRequest) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+func (p *GetDatasetItemRequest) ReadField3(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.ItemIds = _field
+	p.ItemID = _field
 	return nil
 }
-func (p *BatchGetDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *GetDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -20014,9 +20190,9 @@ func (p *BatchGetDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *BatchGetDatasetItemsRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetDatasetItemsRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetDatasetItemRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -20054,7 +20230,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetItemRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -20072,7 +20248,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchGetDatasetItemsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetItemRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -20088,19 +20264,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *BatchGetDatasetItemsRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("item_ids", thrift.LIST, 3); err != nil {
+func (p *GetDatasetItemRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("item_id", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.I64, len(p.ItemIds)); err != nil {
-		return err
-	}
-	for _, v := range p.ItemIds {
-		if err := oprot.WriteI64(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteI64(p.ItemID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -20112,7 +20280,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *BatchGetDatasetItemsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetItemRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -20131,15 +20299,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsRequest) String() string {
+func (p *GetDatasetItemRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchGetDatasetItemsRequest(%+v)", *p)
+	return fmt.Sprintf("GetDatasetItemRequest(%+v)", *p)
 
 }
 
-func (p *BatchGetDatasetItemsRequest) DeepEqual(ano *BatchGetDatasetItemsRequest) bool {
+func (p *GetDatasetItemRequest) DeepEqual(ano *GetDatasetItemRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -20151,7 +20319,7 @@ func (p *BatchGetDatasetItemsRequest) DeepEqual(ano *BatchGetDatasetItemsRequest
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ItemIds) {
+	if !p.Field3DeepEqual(ano.ItemID) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -20160,7 +20328,7 @@ func (p *BatchGetDatasetItemsRequest) DeepEqual(ano *BatchGetDatasetItemsRequest
 	return true
 }
 
-func (p *BatchGetDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
+func (p *GetDatasetItemRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -20172,27 +20340,21 @@ func (p *BatchGetDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *BatchGetDatasetItemsRequest) Field2DeepEqual(src int64) bool {
+func (p *GetDatasetItemRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *BatchGetDatasetItemsRequest) Field3DeepEqual(src []int64) bool {
+func (p *GetDatasetItemRequest) Field3DeepEqual(src int64) bool {
 
-	if len(p.ItemIds) != len(src) {
+	if p.ItemID != src {
 		return false
 	}
-	for i, v := range p.ItemIds {
-		_src := src[i]
-		if v != _src {
-			return false
-		}
-	}
 	return true
 }
-func (p *BatchGetDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *GetDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -20200,62 +20362,62 @@ func (p *BatchGetDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type BatchGetDatasetItemsResponse struct {
-	Items    []*dataset.DatasetItem `thrift:"items,1,optional" frugal:"1,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
-	BaseResp *base.BaseResp         `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+type GetDatasetItemResponse struct {
+	Item     *dataset.DatasetItem `thrift:"item,1,optional" frugal:"1,optional,dataset.DatasetItem" form:"item" json:"item,omitempty" query:"item"`
+	BaseResp *base.BaseResp       `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewBatchGetDatasetItemsResponse() *BatchGetDatasetItemsResponse {
-	return &BatchGetDatasetItemsResponse{}
+func NewGetDatasetItemResponse() *GetDatasetItemResponse {
+	return &GetDatasetItemResponse{}
 }
 
-func (p *BatchGetDatasetItemsResponse) InitDefault() {
+func (p *GetDatasetItemResponse) InitDefault() {
 }
 
-var BatchGetDatasetItemsResponse_Items_DEFAULT []*dataset.DatasetItem
+var GetDatasetItemResponse_Item_DEFAULT *dataset.DatasetItem
 
-func (p *BatchGetDatasetItemsResponse) GetItems() (v []*dataset.DatasetItem) {
+func (p *GetDatasetItemResponse) GetItem() (v *dataset.DatasetItem) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetItems() {
-		return BatchGetDatasetItemsResponse_Items_DEFAULT
+	if !p.IsSetItem() {
+		return GetDatasetItemResponse_Item_DEFAULT
 	}
-	return p.Items
+	return p.Item
 }
 
-var BatchGetDatasetItemsResponse_BaseResp_DEFAULT *base.BaseResp
+var GetDatasetItemResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *BatchGetDatasetItemsResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *GetDatasetItemResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return BatchGetDatasetItemsResponse_BaseResp_DEFAULT
+		return GetDatasetItemResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *BatchGetDatasetItemsResponse) SetItems(val []*dataset.DatasetItem) {
-	p.Items = val
+func (p *GetDatasetItemResponse) SetItem(val *dataset.DatasetItem) {
+	p.Item = val
 }
-func (p *BatchGetDatasetItemsResponse) SetBaseResp(val *base.BaseResp) {
+func (p *GetDatasetItemResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_BatchGetDatasetItemsResponse = map[int16]string{
-	1:   "items",
+var fieldIDToName_GetDatasetItemResponse = map[int16]string{
+	1:   "item",
 	255: "BaseResp",
 }
 
-func (p *BatchGetDatasetItemsResponse) IsSetItems() bool {
-	return p.Items != nil
+func (p *GetDatasetItemResponse) IsSetItem() bool {
+	return p.Item != nil
 }
 
-func (p *BatchGetDatasetItemsResponse) IsSetBaseResp() bool {
+func (p *GetDatasetItemResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *BatchGetDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -20274,7 +20436,7 @@ func (p *BatchGetDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -20308,7 +20470,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetItemsResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetDatasetItemResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -20318,30 +20480,15 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*dataset.DatasetItem, 0, size)
-	values := make([]dataset.DatasetItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *GetDatasetItemResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := dataset.NewDatasetItem()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Items = _field
+	p.Item = _field
 	return nil
 }
-func (p *BatchGetDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *GetDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -20350,9 +20497,9 @@ func (p *BatchGetDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *BatchGetDatasetItemsResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetItemResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetDatasetItemsResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetDatasetItemResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -20382,20 +20529,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItems() {
-		if err = oprot.WriteFieldBegin("items", thrift.LIST, 1); err != nil {
+func (p *GetDatasetItemResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItem() {
+		if err = oprot.WriteFieldBegin("item", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
-			return err
-		}
-		for _, v := range p.Items {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := p.Item.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -20408,7 +20547,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchGetDatasetItemsResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *GetDatasetItemResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -20425,21 +20564,21 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsResponse) String() string {
+func (p *GetDatasetItemResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchGetDatasetItemsResponse(%+v)", *p)
+	return fmt.Sprintf("GetDatasetItemResponse(%+v)", *p)
 
 }
 
-func (p *BatchGetDatasetItemsResponse) DeepEqual(ano *BatchGetDatasetItemsResponse) bool {
+func (p *GetDatasetItemResponse) DeepEqual(ano *GetDatasetItemResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Items) {
+	if !p.Field1DeepEqual(ano.Item) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
@@ -20448,20 +20587,14 @@ func (p *BatchGetDatasetItemsResponse) DeepEqual(ano *BatchGetDatasetItemsRespon
 	return true
 }
 
-func (p *BatchGetDatasetItemsResponse) Field1DeepEqual(src []*dataset.DatasetItem) bool {
+func (p *GetDatasetItemResponse) Field1DeepEqual(src *dataset.DatasetItem) bool {
 
-	if len(p.Items) != len(src) {
+	if !p.Item.DeepEqual(src) {
 		return false
 	}
-	for i, v := range p.Items {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *BatchGetDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *GetDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -20469,102 +20602,89 @@ func (p *BatchGetDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp) boo
 	return true
 }
 
-type BatchGetDatasetItemsByVersionRequest struct {
+type BatchGetDatasetItemsRequest struct {
 	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
 	DatasetID   int64      `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
-	VersionID   int64      `thrift:"version_id,3,required" frugal:"3,required,i64" json:"version_id" path:"version_id,required" `
-	ItemIds     []int64    `thrift:"item_ids,4,required" frugal:"4,required,list<i64>" json:"item_ids" form:"item_ids,required" query:"item_ids,required"`
+	ItemIds     []int64    `thrift:"item_ids,3,required" frugal:"3,required,list<i64>" json:"item_ids" form:"item_ids,required" query:"item_ids,required"`
 	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewBatchGetDatasetItemsByVersionRequest() *BatchGetDatasetItemsByVersionRequest {
-	return &BatchGetDatasetItemsByVersionRequest{}
+func NewBatchGetDatasetItemsRequest() *BatchGetDatasetItemsRequest {
+	return &BatchGetDatasetItemsRequest{}
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) InitDefault() {
+func (p *BatchGetDatasetItemsRequest) InitDefault() {
 }
 
-var BatchGetDatasetItemsByVersionRequest_WorkspaceID_DEFAULT int64
+var BatchGetDatasetItemsRequest_WorkspaceID_DEFAULT int64
 
-func (p *BatchGetDatasetItemsByVersionRequest) GetWorkspaceID() (v int64) {
+func (p *BatchGetDatasetItemsRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return BatchGetDatasetItemsByVersionRequest_WorkspaceID_DEFAULT
+		return BatchGetDatasetItemsRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) GetDatasetID() (v int64) {
+func (p *BatchGetDatasetItemsRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) GetVersionID() (v int64) {
-	if p != nil {
-		return p.VersionID
-	}
-	return
-}
-
-func (p *BatchGetDatasetItemsByVersionRequest) GetItemIds() (v []int64) {
+func (p *BatchGetDatasetItemsRequest) GetItemIds() (v []int64) {
 	if p != nil {
 		return p.ItemIds
 	}
 	return
 }
 
-var BatchGetDatasetItemsByVersionRequest_Base_DEFAULT *base.Base
+var BatchGetDatasetItemsRequest_Base_DEFAULT *base.Base
 
-func (p *BatchGetDatasetItemsByVersionRequest) GetBase() (v *base.Base) {
+func (p *BatchGetDatasetItemsRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return BatchGetDatasetItemsByVersionRequest_Base_DEFAULT
+		return BatchGetDatasetItemsRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *BatchGetDatasetItemsByVersionRequest) SetWorkspaceID(val *int64) {
+func (p *BatchGetDatasetItemsRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *BatchGetDatasetItemsByVersionRequest) SetDatasetID(val int64) {
+func (p *BatchGetDatasetItemsRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *BatchGetDatasetItemsByVersionRequest) SetVersionID(val int64) {
-	p.VersionID = val
-}
-func (p *BatchGetDatasetItemsByVersionRequest) SetItemIds(val []int64) {
+func (p *BatchGetDatasetItemsRequest) SetItemIds(val []int64) {
 	p.ItemIds = val
 }
-func (p *BatchGetDatasetItemsByVersionRequest) SetBase(val *base.Base) {
+func (p *BatchGetDatasetItemsRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_BatchGetDatasetItemsByVersionRequest = map[int16]string{
+var fieldIDToName_BatchGetDatasetItemsRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
-	3:   "version_id",
-	4:   "item_ids",
+	3:   "item_ids",
 	255: "Base",
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) IsSetWorkspaceID() bool {
+func (p *BatchGetDatasetItemsRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) IsSetBase() bool {
+func (p *BatchGetDatasetItemsRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
-	var issetVersionID bool = false
 	var issetItemIds bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
@@ -20599,17 +20719,8 @@ func (p *BatchGetDatasetItemsByVersionRequest) Read(iprot thrift.TProtocol) (err
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetVersionID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 				issetItemIds = true
@@ -20642,13 +20753,8 @@ func (p *BatchGetDatasetItemsByVersionRequest) Read(iprot thrift.TProtocol) (err
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetVersionID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
 	if !issetItemIds {
-		fieldId = 4
+		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
 	return nil
@@ -20657,7 +20763,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetItemsByVersionRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetItemsRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -20666,10 +20772,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchGetDatasetItemsByVersionRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchGetDatasetItemsRequest[fieldId]))
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -20680,7 +20786,7 @@ func (p *BatchGetDatasetItemsByVersionRequest) ReadField1(iprot thrift.TProtocol
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *BatchGetDatasetItemsByVersionRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -20691,18 +20797,7 @@ func (p *BatchGetDatasetItemsByVersionRequest) ReadField2(iprot thrift.TProtocol
 	p.DatasetID = _field
 	return nil
 }
-func (p *BatchGetDatasetItemsByVersionRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.VersionID = _field
-	return nil
-}
-func (p *BatchGetDatasetItemsByVersionRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsRequest) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -20725,7 +20820,7 @@ func (p *BatchGetDatasetItemsByVersionRequest) ReadField4(iprot thrift.TProtocol
 	p.ItemIds = _field
 	return nil
 }
-func (p *BatchGetDatasetItemsByVersionRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -20734,9 +20829,9 @@ func (p *BatchGetDatasetItemsByVersionRequest) ReadField255(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetDatasetItemsByVersionRequest"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetDatasetItemsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -20752,10 +20847,6 @@ func (p *BatchGetDatasetItemsByVersionRequest) Write(oprot thrift.TProtocol) (er
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -20778,7 +20869,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -20796,7 +20887,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchGetDatasetItemsByVersionRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -20812,24 +20903,8 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *BatchGetDatasetItemsByVersionRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("version_id", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.VersionID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *BatchGetDatasetItemsByVersionRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("item_ids", thrift.LIST, 4); err != nil {
+func (p *BatchGetDatasetItemsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("item_ids", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := oprot.WriteListBegin(thrift.I64, len(p.ItemIds)); err != nil {
@@ -20848,11 +20923,11 @@ func (p *BatchGetDatasetItemsByVersionRequest) writeField4(oprot thrift.TProtoco
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *BatchGetDatasetItemsByVersionRequest) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
 			goto WriteFieldBeginError
@@ -20871,15 +20946,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) String() string {
+func (p *BatchGetDatasetItemsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchGetDatasetItemsByVersionRequest(%+v)", *p)
+	return fmt.Sprintf("BatchGetDatasetItemsRequest(%+v)", *p)
 
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) DeepEqual(ano *BatchGetDatasetItemsByVersionRequest) bool {
+func (p *BatchGetDatasetItemsRequest) DeepEqual(ano *BatchGetDatasetItemsRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -20891,10 +20966,7 @@ func (p *BatchGetDatasetItemsByVersionRequest) DeepEqual(ano *BatchGetDatasetIte
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.VersionID) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.ItemIds) {
+	if !p.Field3DeepEqual(ano.ItemIds) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.Base) {
@@ -20903,7 +20975,7 @@ func (p *BatchGetDatasetItemsByVersionRequest) DeepEqual(ano *BatchGetDatasetIte
 	return true
 }
 
-func (p *BatchGetDatasetItemsByVersionRequest) Field1DeepEqual(src *int64) bool {
+func (p *BatchGetDatasetItemsRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -20915,21 +20987,14 @@ func (p *BatchGetDatasetItemsByVersionRequest) Field1DeepEqual(src *int64) bool
 	}
 	return true
 }
-func (p *BatchGetDatasetItemsByVersionRequest) Field2DeepEqual(src int64) bool {
+func (p *BatchGetDatasetItemsRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *BatchGetDatasetItemsByVersionRequest) Field3DeepEqual(src int64) bool {
-
-	if p.VersionID != src {
-		return false
-	}
-	return true
-}
-func (p *BatchGetDatasetItemsByVersionRequest) Field4DeepEqual(src []int64) bool {
+func (p *BatchGetDatasetItemsRequest) Field3DeepEqual(src []int64) bool {
 
 	if len(p.ItemIds) != len(src) {
 		return false
@@ -20942,7 +21007,7 @@ func (p *BatchGetDatasetItemsByVersionRequest) Field4DeepEqual(src []int64) bool
 	}
 	return true
 }
-func (p *BatchGetDatasetItemsByVersionRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *BatchGetDatasetItemsRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -20950,62 +21015,62 @@ func (p *BatchGetDatasetItemsByVersionRequest) Field255DeepEqual(src *base.Base)
 	return true
 }
 
-type BatchGetDatasetItemsByVersionResponse struct {
+type BatchGetDatasetItemsResponse struct {
 	Items    []*dataset.DatasetItem `thrift:"items,1,optional" frugal:"1,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
 	BaseResp *base.BaseResp         `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewBatchGetDatasetItemsByVersionResponse() *BatchGetDatasetItemsByVersionResponse {
-	return &BatchGetDatasetItemsByVersionResponse{}
+func NewBatchGetDatasetItemsResponse() *BatchGetDatasetItemsResponse {
+	return &BatchGetDatasetItemsResponse{}
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) InitDefault() {
+func (p *BatchGetDatasetItemsResponse) InitDefault() {
 }
 
-var BatchGetDatasetItemsByVersionResponse_Items_DEFAULT []*dataset.DatasetItem
+var BatchGetDatasetItemsResponse_Items_DEFAULT []*dataset.DatasetItem
 
-func (p *BatchGetDatasetItemsByVersionResponse) GetItems() (v []*dataset.DatasetItem) {
+func (p *BatchGetDatasetItemsResponse) GetItems() (v []*dataset.DatasetItem) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetItems() {
-		return BatchGetDatasetItemsByVersionResponse_Items_DEFAULT
+		return BatchGetDatasetItemsResponse_Items_DEFAULT
 	}
 	return p.Items
 }
 
-var BatchGetDatasetItemsByVersionResponse_BaseResp_DEFAULT *base.BaseResp
+var BatchGetDatasetItemsResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *BatchGetDatasetItemsByVersionResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *BatchGetDatasetItemsResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return BatchGetDatasetItemsByVersionResponse_BaseResp_DEFAULT
+		return BatchGetDatasetItemsResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *BatchGetDatasetItemsByVersionResponse) SetItems(val []*dataset.DatasetItem) {
+func (p *BatchGetDatasetItemsResponse) SetItems(val []*dataset.DatasetItem) {
 	p.Items = val
 }
-func (p *BatchGetDatasetItemsByVersionResponse) SetBaseResp(val *base.BaseResp) {
+func (p *BatchGetDatasetItemsResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_BatchGetDatasetItemsByVersionResponse = map[int16]string{
+var fieldIDToName_BatchGetDatasetItemsResponse = map[int16]string{
 	1:   "items",
 	255: "BaseResp",
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) IsSetItems() bool {
+func (p *BatchGetDatasetItemsResponse) IsSetItems() bool {
 	return p.Items != nil
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) IsSetBaseResp() bool {
+func (p *BatchGetDatasetItemsResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -21058,7 +21123,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetItemsByVersionResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetItemsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -21068,7 +21133,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsResponse) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -21091,7 +21156,7 @@ func (p *BatchGetDatasetItemsByVersionResponse) ReadField1(iprot thrift.TProtoco
 	p.Items = _field
 	return nil
 }
-func (p *BatchGetDatasetItemsByVersionResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -21100,9 +21165,9 @@ func (p *BatchGetDatasetItemsByVersionResponse) ReadField255(iprot thrift.TProto
 	return nil
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetDatasetItemsByVersionResponse"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetDatasetItemsResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -21132,7 +21197,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetItems() {
 		if err = oprot.WriteFieldBegin("items", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
@@ -21158,7 +21223,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchGetDatasetItemsByVersionResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -21175,15 +21240,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) String() string {
+func (p *BatchGetDatasetItemsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchGetDatasetItemsByVersionResponse(%+v)", *p)
+	return fmt.Sprintf("BatchGetDatasetItemsResponse(%+v)", *p)
 
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) DeepEqual(ano *BatchGetDatasetItemsByVersionResponse) bool {
+func (p *BatchGetDatasetItemsResponse) DeepEqual(ano *BatchGetDatasetItemsResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -21198,7 +21263,7 @@ func (p *BatchGetDatasetItemsByVersionResponse) DeepEqual(ano *BatchGetDatasetIt
 	return true
 }
 
-func (p *BatchGetDatasetItemsByVersionResponse) Field1DeepEqual(src []*dataset.DatasetItem) bool {
+func (p *BatchGetDatasetItemsResponse) Field1DeepEqual(src []*dataset.DatasetItem) bool {
 
 	if len(p.Items) != len(src) {
 		return false
@@ -21211,7 +21276,7 @@ func (p *BatchGetDatasetItemsByVersionResponse) Field1DeepEqual(src []*dataset.D
 	}
 	return true
 }
-func (p *BatchGetDatasetItemsByVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *BatchGetDatasetItemsResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -21219,77 +21284,103 @@ func (p *BatchGetDatasetItemsByVersionResponse) Field255DeepEqual(src *base.Base
 	return true
 }
 
-type ClearDatasetItemRequest struct {
+type BatchGetDatasetItemsByVersionRequest struct {
 	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
 	DatasetID   int64      `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	VersionID   int64      `thrift:"version_id,3,required" frugal:"3,required,i64" json:"version_id" path:"version_id,required" `
+	ItemIds     []int64    `thrift:"item_ids,4,required" frugal:"4,required,list<i64>" json:"item_ids" form:"item_ids,required" query:"item_ids,required"`
 	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func NewClearDatasetItemRequest() *ClearDatasetItemRequest {
-	return &ClearDatasetItemRequest{}
+func NewBatchGetDatasetItemsByVersionRequest() *BatchGetDatasetItemsByVersionRequest {
+	return &BatchGetDatasetItemsByVersionRequest{}
 }
 
-func (p *ClearDatasetItemRequest) InitDefault() {
+func (p *BatchGetDatasetItemsByVersionRequest) InitDefault() {
 }
 
-var ClearDatasetItemRequest_WorkspaceID_DEFAULT int64
+var BatchGetDatasetItemsByVersionRequest_WorkspaceID_DEFAULT int64
 
-func (p *ClearDatasetItemRequest) GetWorkspaceID() (v int64) {
+func (p *BatchGetDatasetItemsByVersionRequest) GetWorkspaceID() (v int64) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetWorkspaceID() {
-		return ClearDatasetItemRequest_WorkspaceID_DEFAULT
+		return BatchGetDatasetItemsByVersionRequest_WorkspaceID_DEFAULT
 	}
 	return *p.WorkspaceID
 }
 
-func (p *ClearDatasetItemRequest) GetDatasetID() (v int64) {
+func (p *BatchGetDatasetItemsByVersionRequest) GetDatasetID() (v int64) {
 	if p != nil {
 		return p.DatasetID
 	}
 	return
 }
 
-var ClearDatasetItemRequest_Base_DEFAULT *base.Base
+func (p *BatchGetDatasetItemsByVersionRequest) GetVersionID() (v int64) {
+	if p != nil {
+		return p.VersionID
+	}
+	return
+}
 
-func (p *ClearDatasetItemRequest) GetBase() (v *base.Base) {
+func (p *BatchGetDatasetItemsByVersionRequest) GetItemIds() (v []int64) {
+	if p != nil {
+		return p.ItemIds
+	}
+	return
+}
+
+var BatchGetDatasetItemsByVersionRequest_Base_DEFAULT *base.Base
+
+func (p *BatchGetDatasetItemsByVersionRequest) GetBase() (v *base.Base) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBase() {
-		return ClearDatasetItemRequest_Base_DEFAULT
+		return BatchGetDatasetItemsByVersionRequest_Base_DEFAULT
 	}
 	return p.Base
 }
-func (p *ClearDatasetItemRequest) SetWorkspaceID(val *int64) {
+func (p *BatchGetDatasetItemsByVersionRequest) SetWorkspaceID(val *int64) {
 	p.WorkspaceID = val
 }
-func (p *ClearDatasetItemRequest) SetDatasetID(val int64) {
+func (p *BatchGetDatasetItemsByVersionRequest) SetDatasetID(val int64) {
 	p.DatasetID = val
 }
-func (p *ClearDatasetItemRequest) SetBase(val *base.Base) {
+func (p *BatchGetDatasetItemsByVersionRequest) SetVersionID(val int64) {
+	p.VersionID = val
+}
+func (p *BatchGetDatasetItemsByVersionRequest) SetItemIds(val []int64) {
+	p.ItemIds = val
+}
+func (p *BatchGetDatasetItemsByVersionRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
 
-var fieldIDToName_ClearDatasetItemRequest = map[int16]string{
+var fieldIDToName_BatchGetDatasetItemsByVersionRequest = map[int16]string{
 	1:   "workspace_id",
 	2:   "dataset_id",
+	3:   "version_id",
+	4:   "item_ids",
 	255: "Base",
 }
 
-func (p *ClearDatasetItemRequest) IsSetWorkspaceID() bool {
+func (p *BatchGetDatasetItemsByVersionRequest) IsSetWorkspaceID() bool {
 	return p.WorkspaceID != nil
 }
 
-func (p *ClearDatasetItemRequest) IsSetBase() bool {
+func (p *BatchGetDatasetItemsByVersionRequest) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *ClearDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsByVersionRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetDatasetID bool = false
+	var issetVersionID bool = false
+	var issetItemIds bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -21322,6 +21413,24 @@ func (p *ClearDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetItemIds = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -21347,13 +21456,23 @@ func (p *ClearDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetVersionID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetItemIds {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClearDatasetItemRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetItemsByVersionRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -21362,10 +21481,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ClearDatasetItemRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchGetDatasetItemsByVersionRequest[fieldId]))
 }
 
-func (p *ClearDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsByVersionRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -21376,7 +21495,7 @@ func (p *ClearDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.WorkspaceID = _field
 	return nil
 }
-func (p *ClearDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsByVersionRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -21387,7 +21506,41 @@ func (p *ClearDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
 	p.DatasetID = _field
 	return nil
 }
-func (p *ClearDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsByVersionRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VersionID = _field
+	return nil
+}
+func (p *BatchGetDatasetItemsByVersionRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ItemIds = _field
+	return nil
+}
+func (p *BatchGetDatasetItemsByVersionRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -21396,9 +21549,9 @@ func (p *ClearDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ClearDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsByVersionRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ClearDatasetItemRequest"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetDatasetItemsByVersionRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -21410,6 +21563,14 @@ func (p *ClearDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -21432,7 +21593,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ClearDatasetItemRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsByVersionRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetWorkspaceID() {
 		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
@@ -21450,7 +21611,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ClearDatasetItemRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsByVersionRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -21466,34 +21627,74 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ClearDatasetItemRequest) writeField255(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBase() {
-		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Base.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *BatchGetDatasetItemsByVersionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("version_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *BatchGetDatasetItemsByVersionRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("item_ids", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.ItemIds)); err != nil {
+		return err
+	}
+	for _, v := range p.ItemIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *BatchGetDatasetItemsByVersionRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ClearDatasetItemRequest) String() string {
+func (p *BatchGetDatasetItemsByVersionRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ClearDatasetItemRequest(%+v)", *p)
+	return fmt.Sprintf("BatchGetDatasetItemsByVersionRequest(%+v)", *p)
 
 }
 
-func (p *ClearDatasetItemRequest) DeepEqual(ano *ClearDatasetItemRequest) bool {
+func (p *BatchGetDatasetItemsByVersionRequest) DeepEqual(ano *BatchGetDatasetItemsByVersionRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -21505,13 +21706,19 @@ func (p *ClearDatasetItemRequest) DeepEqual(ano *ClearDatasetItemRequest) bool {
 	if !p.Field2DeepEqual(ano.DatasetID) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.VersionID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ItemIds) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.Base) {
 		return false
 	}
 	return true
 }
 
-func (p *ClearDatasetItemRequest) Field1DeepEqual(src *int64) bool {
+func (p *BatchGetDatasetItemsByVersionRequest) Field1DeepEqual(src *int64) bool {
 
 	if p.WorkspaceID == src {
 		return true
@@ -21523,14 +21730,34 @@ func (p *ClearDatasetItemRequest) Field1DeepEqual(src *int64) bool {
 	}
 	return true
 }
-func (p *ClearDatasetItemRequest) Field2DeepEqual(src int64) bool {
+func (p *BatchGetDatasetItemsByVersionRequest) Field2DeepEqual(src int64) bool {
 
 	if p.DatasetID != src {
 		return false
 	}
 	return true
 }
-func (p *ClearDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
+func (p *BatchGetDatasetItemsByVersionRequest) Field3DeepEqual(src int64) bool {
+
+	if p.VersionID != src {
+		return false
+	}
+	return true
+}
+func (p *BatchGetDatasetItemsByVersionRequest) Field4DeepEqual(src []int64) bool {
+
+	if len(p.ItemIds) != len(src) {
+		return false
+	}
+	for i, v := range p.ItemIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *BatchGetDatasetItemsByVersionRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
 		return false
@@ -21538,41 +21765,62 @@ func (p *ClearDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
 	return true
 }
 
-type ClearDatasetItemResponse struct {
-	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+type BatchGetDatasetItemsByVersionResponse struct {
+	Items    []*dataset.DatasetItem `thrift:"items,1,optional" frugal:"1,optional,list<dataset.DatasetItem>" form:"items" json:"items,omitempty" query:"items"`
+	BaseResp *base.BaseResp         `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
 }
 
-func NewClearDatasetItemResponse() *ClearDatasetItemResponse {
-	return &ClearDatasetItemResponse{}
+func NewBatchGetDatasetItemsByVersionResponse() *BatchGetDatasetItemsByVersionResponse {
+	return &BatchGetDatasetItemsByVersionResponse{}
 }
 
-func (p *ClearDatasetItemResponse) InitDefault() {
+func (p *BatchGetDatasetItemsByVersionResponse) InitDefault() {
 }
 
-var ClearDatasetItemResponse_BaseResp_DEFAULT *base.BaseResp
+var BatchGetDatasetItemsByVersionResponse_Items_DEFAULT []*dataset.DatasetItem
 
-func (p *ClearDatasetItemResponse) GetBaseResp() (v *base.BaseResp) {
+func (p *BatchGetDatasetItemsByVersionResponse) GetItems() (v []*dataset.DatasetItem) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetItems() {
+		return BatchGetDatasetItemsByVersionResponse_Items_DEFAULT
+	}
+	return p.Items
+}
+
+var BatchGetDatasetItemsByVersionResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *BatchGetDatasetItemsByVersionResponse) GetBaseResp() (v *base.BaseResp) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetBaseResp() {
-		return ClearDatasetItemResponse_BaseResp_DEFAULT
+		return BatchGetDatasetItemsByVersionResponse_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
-func (p *ClearDatasetItemResponse) SetBaseResp(val *base.BaseResp) {
+func (p *BatchGetDatasetItemsByVersionResponse) SetItems(val []*dataset.DatasetItem) {
+	p.Items = val
+}
+func (p *BatchGetDatasetItemsByVersionResponse) SetBaseResp(val *base.BaseResp) {
 	p.BaseResp = val
 }
 
-var fieldIDToName_ClearDatasetItemResponse = map[int16]string{
+var fieldIDToName_BatchGetDatasetItemsByVersionResponse = map[int16]string{
+	1:   "items",
 	255: "BaseResp",
 }
 
-func (p *ClearDatasetItemResponse) IsSetBaseResp() bool {
+func (p *BatchGetDatasetItemsByVersionResponse) IsSetItems() bool {
+	return p.Items != nil
+}
+
+func (p *BatchGetDatasetItemsByVersionResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *ClearDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsByVersionResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -21590,6 +21838,14 @@ func (p *ClearDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -21617,7 +21873,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClearDatasetItemResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetDatasetItemsByVersionResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -21627,7 +21883,30 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ClearDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *BatchGetDatasetItemsByVersionResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset.DatasetItem, 0, size)
+	values := make([]dataset.DatasetItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Items = _field
+	return nil
+}
+func (p *BatchGetDatasetItemsByVersionResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -21636,12 +21915,16 @@ func (p *ClearDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ClearDatasetItemResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsByVersionResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ClearDatasetItemResponse"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetDatasetItemsByVersionResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -21664,7 +21947,33 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ClearDatasetItemResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *BatchGetDatasetItemsByVersionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItems() {
+		if err = oprot.WriteFieldBegin("items", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
+			return err
+		}
+		for _, v := range p.Items {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *BatchGetDatasetItemsByVersionResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -21681,27 +21990,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ClearDatasetItemResponse) String() string {
+func (p *BatchGetDatasetItemsByVersionResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ClearDatasetItemResponse(%+v)", *p)
+	return fmt.Sprintf("BatchGetDatasetItemsByVersionResponse(%+v)", *p)
 
 }
 
-func (p *ClearDatasetItemResponse) DeepEqual(ano *ClearDatasetItemResponse) bool {
+func (p *BatchGetDatasetItemsByVersionResponse) DeepEqual(ano *BatchGetDatasetItemsByVersionResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
+	if !p.Field1DeepEqual(ano.Items) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
 		return false
 	}
 	return true
 }
 
-func (p *ClearDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *BatchGetDatasetItemsByVersionResponse) Field1DeepEqual(src []*dataset.DatasetItem) bool {
+
+	if len(p.Items) != len(src) {
+		return false
+	}
+	for i, v := range p.Items {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *BatchGetDatasetItemsByVersionResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -21709,42 +22034,534 @@ func (p *ClearDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type DatasetService interface {
-	/* Dataset */
-	// 新增数据集
-	CreateDataset(ctx context.Context, req *CreateDatasetRequest) (r *CreateDatasetResponse, err error)
-	// 修改数据集
-	UpdateDataset(ctx context.Context, req *UpdateDatasetRequest) (r *UpdateDatasetResponse, err error)
-	// 删除数据集
-	DeleteDataset(ctx context.Context, req *DeleteDatasetRequest) (r *DeleteDatasetResponse, err error)
-	// 获取数据集列表
-	ListDatasets(ctx context.Context, req *ListDatasetsRequest) (r *ListDatasetsResponse, err error)
-	// 数据集当前信息（不包括数据）
-	GetDataset(ctx context.Context, req *GetDatasetRequest) (r *GetDatasetResponse, err error)
-	// 批量获取数据集
-	BatchGetDatasets(ctx context.Context, req *BatchGetDatasetsRequest) (r *BatchGetDatasetsResponse, err error)
-	// 导入数据
-	ImportDataset(ctx context.Context, req *ImportDatasetRequest) (r *ImportDatasetResponse, err error)
-	// 任务(导入、导出、转换)详情
-	GetDatasetIOJob(ctx context.Context, req *GetDatasetIOJobRequest) (r *GetDatasetIOJobResponse, err error)
-	// 数据集任务列表
-	ListDatasetIOJobs(ctx context.Context, req *ListDatasetIOJobsRequest) (r *ListDatasetIOJobsResponse, err error)
-	/* Dataset Version */
-	// 生成一个新版本
-	CreateDatasetVersion(ctx context.Context, req *CreateDatasetVersionRequest) (r *CreateDatasetVersionResponse, err error)
-	// 版本列表
-	ListDatasetVersions(ctx context.Context, req *ListDatasetVersionsRequest) (r *ListDatasetVersionsResponse, err error)
-	// 获取指定版本的数据集详情
-	GetDatasetVersion(ctx context.Context, req *GetDatasetVersionRequest) (r *GetDatasetVersionResponse, err error)
-	// 批量获取指定版本的数据集详情
-	BatchGetDatasetVersions(ctx context.Context, req *BatchGetDatasetVersionsRequest) (r *BatchGetDatasetVersionsResponse, err error)
-	/* Dataset Schema */
-	// 获取数据集当前的 schema
-	GetDatasetSchema(ctx context.Context, req *GetDatasetSchemaRequest) (r *GetDatasetSchemaResponse, err error)
-	// 覆盖更新 schema
-	UpdateDatasetSchema(ctx context.Context, req *UpdateDatasetSchemaRequest) (r *UpdateDatasetSchemaResponse, err error)
-	/* Dataset Item */
-	// 校验数据
+type ClearDatasetItemRequest struct {
+	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	DatasetID   int64      `thrift:"dataset_id,2,required" frugal:"2,required,i64" json:"dataset_id" path:"dataset_id,required" `
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewClearDatasetItemRequest() *ClearDatasetItemRequest {
+	return &ClearDatasetItemRequest{}
+}
+
+func (p *ClearDatasetItemRequest) InitDefault() {
+}
+
+var ClearDatasetItemRequest_WorkspaceID_DEFAULT int64
+
+func (p *ClearDatasetItemRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return ClearDatasetItemRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+func (p *ClearDatasetItemRequest) GetDatasetID() (v int64) {
+	if p != nil {
+		return p.DatasetID
+	}
+	return
+}
+
+var ClearDatasetItemRequest_Base_DEFAULT *base.Base
+
+func (p *ClearDatasetItemRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ClearDatasetItemRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ClearDatasetItemRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *ClearDatasetItemRequest) SetDatasetID(val int64) {
+	p.DatasetID = val
+}
+func (p *ClearDatasetItemRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_ClearDatasetItemRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "dataset_id",
+	255: "Base",
+}
+
+func (p *ClearDatasetItemRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *ClearDatasetItemRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ClearDatasetItemRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetDatasetID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDatasetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetDatasetID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClearDatasetItemRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ClearDatasetItemRequest[fieldId]))
+}
+
+func (p *ClearDatasetItemRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ClearDatasetItemRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DatasetID = _field
+	return nil
+}
+func (p *ClearDatasetItemRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ClearDatasetItemRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClearDatasetItemRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ClearDatasetItemRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ClearDatasetItemRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.DatasetID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ClearDatasetItemRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ClearDatasetItemRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClearDatasetItemRequest(%+v)", *p)
+
+}
+
+func (p *ClearDatasetItemRequest) DeepEqual(ano *ClearDatasetItemRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.DatasetID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ClearDatasetItemRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *ClearDatasetItemRequest) Field2DeepEqual(src int64) bool {
+
+	if p.DatasetID != src {
+		return false
+	}
+	return true
+}
+func (p *ClearDatasetItemRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ClearDatasetItemResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewClearDatasetItemResponse() *ClearDatasetItemResponse {
+	return &ClearDatasetItemResponse{}
+}
+
+func (p *ClearDatasetItemResponse) InitDefault() {
+}
+
+var ClearDatasetItemResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ClearDatasetItemResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return ClearDatasetItemResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ClearDatasetItemResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ClearDatasetItemResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *ClearDatasetItemResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ClearDatasetItemResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClearDatasetItemResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ClearDatasetItemResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ClearDatasetItemResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClearDatasetItemResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ClearDatasetItemResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ClearDatasetItemResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClearDatasetItemResponse(%+v)", *p)
+
+}
+
+func (p *ClearDatasetItemResponse) DeepEqual(ano *ClearDatasetItemResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ClearDatasetItemResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type DatasetService interface {
+	/* Dataset */
+	// 新增数据集
+	CreateDataset(ctx context.Context, req *CreateDatasetRequest) (r *CreateDatasetResponse, err error)
+	// 修改数据集
+	UpdateDataset(ctx context.Context, req *UpdateDatasetRequest) (r *UpdateDatasetResponse, err error)
+	// 删除数据集
+	DeleteDataset(ctx context.Context, req *DeleteDatasetRequest) (r *DeleteDatasetResponse, err error)
+	// 获取数据集列表
+	ListDatasets(ctx context.Context, req *ListDatasetsRequest) (r *ListDatasetsResponse, err error)
+	// 数据集当前信息（不包括数据）
+	GetDataset(ctx context.Context, req *GetDatasetRequest) (r *GetDatasetResponse, err error)
+	// 批量获取数据集
+	BatchGetDatasets(ctx context.Context, req *BatchGetDatasetsRequest) (r *BatchGetDatasetsResponse, err error)
+	// 导入数据
+	ImportDataset(ctx context.Context, req *ImportDatasetRequest) (r *ImportDatasetResponse, err error)
+	// 导出数据到文件
+	ExportDataset(ctx context.Context, req *ExportDatasetRequest) (r *ExportDatasetResponse, err error)
+	// 任务(导入、导出、转换)详情
+	GetDatasetIOJob(ctx context.Context, req *GetDatasetIOJobRequest) (r *GetDatasetIOJobResponse, err error)
+	// 数据集任务列表
+	ListDatasetIOJobs(ctx context.Context, req *ListDatasetIOJobsRequest) (r *ListDatasetIOJobsResponse, err error)
+	/* Dataset Version */
+	// 生成一个新版本
+	CreateDatasetVersion(ctx context.Context, req *CreateDatasetVersionRequest) (r *CreateDatasetVersionResponse, err error)
+	// 版本列表
+	ListDatasetVersions(ctx context.Context, req *ListDatasetVersionsRequest) (r *ListDatasetVersionsResponse, err error)
+	// 获取指定版本的数据集详情
+	GetDatasetVersion(ctx context.Context, req *GetDatasetVersionRequest) (r *GetDatasetVersionResponse, err error)
+	// 批量获取指定版本的数据集详情
+	BatchGetDatasetVersions(ctx context.Context, req *BatchGetDatasetVersionsRequest) (r *BatchGetDatasetVersionsResponse, err error)
+	/* Dataset Schema */
+	// 获取数据集当前的 schema
+	GetDatasetSchema(ctx context.Context, req *GetDatasetSchemaRequest) (r *GetDatasetSchemaResponse, err error)
+	// 覆盖更新 schema
+	UpdateDatasetSchema(ctx context.Context, req *UpdateDatasetSchemaRequest) (r *UpdateDatasetSchemaResponse, err error)
+	/* Dataset Item */
+	// 校验数据
 	ValidateDatasetItems(ctx context.Context, req *ValidateDatasetItemsReq) (r *ValidateDatasetItemsResp, err error)
 	// 批量新增数据
 	BatchCreateDatasetItems(ctx context.Context, req *BatchCreateDatasetItemsRequest) (r *BatchCreateDatasetItemsResponse, err error)
@@ -21857,6 +22674,15 @@ func (p *DatasetServiceClient) ImportDataset(ctx context.Context, req *ImportDat
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *DatasetServiceClient) ExportDataset(ctx context.Context, req *ExportDatasetRequest) (r *ExportDatasetResponse, err error) {
+	var _args DatasetServiceExportDatasetArgs
+	_args.Req = req
+	var _result DatasetServiceExportDatasetResult
+	if err = p.Client_().Call(ctx, "ExportDataset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *DatasetServiceClient) GetDatasetIOJob(ctx context.Context, req *GetDatasetIOJobRequest) (r *GetDatasetIOJobResponse, err error) {
 	var _args DatasetServiceGetDatasetIOJobArgs
 	_args.Req = req
@@ -22056,6 +22882,7 @@ func NewDatasetServiceProcessor(handler DatasetService) *DatasetServiceProcessor
 	self.AddToProcessorMap("GetDataset", &datasetServiceProcessorGetDataset{handler: handler})
 	self.AddToProcessorMap("BatchGetDatasets", &datasetServiceProcessorBatchGetDatasets{handler: handler})
 	self.AddToProcessorMap("ImportDataset", &datasetServiceProcessorImportDataset{handler: handler})
+	self.AddToProcessorMap("ExportDataset", &datasetServiceProcessorExportDataset{handler: handler})
 	self.AddToProcessorMap("GetDatasetIOJob", &datasetServiceProcessorGetDatasetIOJob{handler: handler})
 	self.AddToProcessorMap("ListDatasetIOJobs", &datasetServiceProcessorListDatasetIOJobs{handler: handler})
 	self.AddToProcessorMap("CreateDatasetVersion", &datasetServiceProcessorCreateDatasetVersion{handler: handler})
@@ -22431,6 +23258,54 @@ func (p *datasetServiceProcessorImportDataset) Process(ctx context.Context, seqI
 	return true, err
 }
 
+type datasetServiceProcessorExportDataset struct {
+	handler DatasetService
+}
+
+func (p *datasetServiceProcessorExportDataset) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DatasetServiceExportDatasetArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExportDataset", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DatasetServiceExportDatasetResult{}
+	var retval *ExportDatasetResponse
+	if retval, err2 = p.handler.ExportDataset(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportDataset: "+err2.Error())
+		oprot.WriteMessageBegin("ExportDataset", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExportDataset", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
 type datasetServiceProcessorGetDatasetIOJob struct {
 	handler DatasetService
 }
@@ -23181,7 +24056,103 @@ func (p *datasetServiceProcessorGetDatasetItem) Process(ctx context.Context, seq
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetDatasetItem", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("GetDatasetItem", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type datasetServiceProcessorBatchGetDatasetItems struct {
+	handler DatasetService
+}
+
+func (p *datasetServiceProcessorBatchGetDatasetItems) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DatasetServiceBatchGetDatasetItemsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchGetDatasetItems", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DatasetServiceBatchGetDatasetItemsResult{}
+	var retval *BatchGetDatasetItemsResponse
+	if retval, err2 = p.handler.BatchGetDatasetItems(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetDatasetItems: "+err2.Error())
+		oprot.WriteMessageBegin("BatchGetDatasetItems", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchGetDatasetItems", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type datasetServiceProcessorBatchGetDatasetItemsByVersion struct {
+	handler DatasetService
+}
+
+func (p *datasetServiceProcessorBatchGetDatasetItemsByVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DatasetServiceBatchGetDatasetItemsByVersionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchGetDatasetItemsByVersion", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := DatasetServiceBatchGetDatasetItemsByVersionResult{}
+	var retval *BatchGetDatasetItemsByVersionResponse
+	if retval, err2 = p.handler.BatchGetDatasetItemsByVersion(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetDatasetItemsByVersion: "+err2.Error())
+		oprot.WriteMessageBegin("BatchGetDatasetItemsByVersion", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchGetDatasetItemsByVersion", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -23199,16 +24170,16 @@ func (p *datasetServiceProcessorGetDatasetItem) Process(ctx context.Context, seq
 	return true, err
 }
 
-type datasetServiceProcessorBatchGetDatasetItems struct {
+type datasetServiceProcessorClearDatasetItem struct {
 	handler DatasetService
 }
 
-func (p *datasetServiceProcessorBatchGetDatasetItems) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DatasetServiceBatchGetDatasetItemsArgs{}
+func (p *datasetServiceProcessorClearDatasetItem) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := DatasetServiceClearDatasetItemArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BatchGetDatasetItems", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ClearDatasetItem", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -23217,11 +24188,11 @@ func (p *datasetServiceProcessorBatchGetDatasetItems) Process(ctx context.Contex
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := DatasetServiceBatchGetDatasetItemsResult{}
-	var retval *BatchGetDatasetItemsResponse
-	if retval, err2 = p.handler.BatchGetDatasetItems(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetDatasetItems: "+err2.Error())
-		oprot.WriteMessageBegin("BatchGetDatasetItems", thrift.EXCEPTION, seqId)
+	result := DatasetServiceClearDatasetItemResult{}
+	var retval *ClearDatasetItemResponse
+	if retval, err2 = p.handler.ClearDatasetItem(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ClearDatasetItem: "+err2.Error())
+		oprot.WriteMessageBegin("ClearDatasetItem", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -23229,7 +24200,7 @@ func (p *datasetServiceProcessorBatchGetDatasetItems) Process(ctx context.Contex
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("BatchGetDatasetItems", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ClearDatasetItem", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -23241,143 +24212,391 @@ func (p *datasetServiceProcessorBatchGetDatasetItems) Process(ctx context.Contex
 	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
 		err = err2
 	}
-	if err != nil {
-		return
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type DatasetServiceCreateDatasetArgs struct {
+	Req *CreateDatasetRequest `thrift:"req,1" frugal:"1,default,CreateDatasetRequest"`
+}
+
+func NewDatasetServiceCreateDatasetArgs() *DatasetServiceCreateDatasetArgs {
+	return &DatasetServiceCreateDatasetArgs{}
+}
+
+func (p *DatasetServiceCreateDatasetArgs) InitDefault() {
+}
+
+var DatasetServiceCreateDatasetArgs_Req_DEFAULT *CreateDatasetRequest
+
+func (p *DatasetServiceCreateDatasetArgs) GetReq() (v *CreateDatasetRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return DatasetServiceCreateDatasetArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *DatasetServiceCreateDatasetArgs) SetReq(val *CreateDatasetRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_DatasetServiceCreateDatasetArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *DatasetServiceCreateDatasetArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DatasetServiceCreateDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceCreateDatasetArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DatasetServiceCreateDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateDatasetRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *DatasetServiceCreateDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateDataset_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DatasetServiceCreateDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DatasetServiceCreateDatasetArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DatasetServiceCreateDatasetArgs(%+v)", *p)
+
+}
+
+func (p *DatasetServiceCreateDatasetArgs) DeepEqual(ano *DatasetServiceCreateDatasetArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *DatasetServiceCreateDatasetArgs) Field1DeepEqual(src *CreateDatasetRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type DatasetServiceCreateDatasetResult struct {
+	Success *CreateDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,CreateDatasetResponse"`
+}
+
+func NewDatasetServiceCreateDatasetResult() *DatasetServiceCreateDatasetResult {
+	return &DatasetServiceCreateDatasetResult{}
+}
+
+func (p *DatasetServiceCreateDatasetResult) InitDefault() {
+}
+
+var DatasetServiceCreateDatasetResult_Success_DEFAULT *CreateDatasetResponse
+
+func (p *DatasetServiceCreateDatasetResult) GetSuccess() (v *CreateDatasetResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return DatasetServiceCreateDatasetResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *DatasetServiceCreateDatasetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateDatasetResponse)
+}
+
+var fieldIDToName_DatasetServiceCreateDatasetResult = map[int16]string{
+	0: "success",
+}
+
+func (p *DatasetServiceCreateDatasetResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DatasetServiceCreateDatasetResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type datasetServiceProcessorBatchGetDatasetItemsByVersion struct {
-	handler DatasetService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceCreateDatasetResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *datasetServiceProcessorBatchGetDatasetItemsByVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DatasetServiceBatchGetDatasetItemsByVersionArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BatchGetDatasetItemsByVersion", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DatasetServiceCreateDatasetResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateDatasetResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DatasetServiceBatchGetDatasetItemsByVersionResult{}
-	var retval *BatchGetDatasetItemsByVersionResponse
-	if retval, err2 = p.handler.BatchGetDatasetItemsByVersion(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetDatasetItemsByVersion: "+err2.Error())
-		oprot.WriteMessageBegin("BatchGetDatasetItemsByVersion", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("BatchGetDatasetItemsByVersion", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *DatasetServiceCreateDatasetResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateDataset_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type datasetServiceProcessorClearDatasetItem struct {
-	handler DatasetService
+func (p *DatasetServiceCreateDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *datasetServiceProcessorClearDatasetItem) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := DatasetServiceClearDatasetItemArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ClearDatasetItem", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DatasetServiceCreateDatasetResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("DatasetServiceCreateDatasetResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := DatasetServiceClearDatasetItemResult{}
-	var retval *ClearDatasetItemResponse
-	if retval, err2 = p.handler.ClearDatasetItem(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ClearDatasetItem: "+err2.Error())
-		oprot.WriteMessageBegin("ClearDatasetItem", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ClearDatasetItem", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *DatasetServiceCreateDatasetResult) DeepEqual(ano *DatasetServiceCreateDatasetResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *DatasetServiceCreateDatasetResult) Field0DeepEqual(src *CreateDatasetResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type DatasetServiceCreateDatasetArgs struct {
-	Req *CreateDatasetRequest `thrift:"req,1" frugal:"1,default,CreateDatasetRequest"`
+type DatasetServiceUpdateDatasetArgs struct {
+	Req *UpdateDatasetRequest `thrift:"req,1" frugal:"1,default,UpdateDatasetRequest"`
 }
 
-func NewDatasetServiceCreateDatasetArgs() *DatasetServiceCreateDatasetArgs {
-	return &DatasetServiceCreateDatasetArgs{}
+func NewDatasetServiceUpdateDatasetArgs() *DatasetServiceUpdateDatasetArgs {
+	return &DatasetServiceUpdateDatasetArgs{}
 }
 
-func (p *DatasetServiceCreateDatasetArgs) InitDefault() {
+func (p *DatasetServiceUpdateDatasetArgs) InitDefault() {
 }
 
-var DatasetServiceCreateDatasetArgs_Req_DEFAULT *CreateDatasetRequest
+var DatasetServiceUpdateDatasetArgs_Req_DEFAULT *UpdateDatasetRequest
 
-func (p *DatasetServiceCreateDatasetArgs) GetReq() (v *CreateDatasetRequest) {
+func (p *DatasetServiceUpdateDatasetArgs) GetReq() (v *UpdateDatasetRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return DatasetServiceCreateDatasetArgs_Req_DEFAULT
+		return DatasetServiceUpdateDatasetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DatasetServiceCreateDatasetArgs) SetReq(val *CreateDatasetRequest) {
+func (p *DatasetServiceUpdateDatasetArgs) SetReq(val *UpdateDatasetRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_DatasetServiceCreateDatasetArgs = map[int16]string{
+var fieldIDToName_DatasetServiceUpdateDatasetArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DatasetServiceCreateDatasetArgs) IsSetReq() bool {
+func (p *DatasetServiceUpdateDatasetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DatasetServiceCreateDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceUpdateDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23422,7 +24641,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceCreateDatasetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceUpdateDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23432,8 +24651,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceCreateDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateDatasetRequest()
+func (p *DatasetServiceUpdateDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateDatasetRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23441,9 +24660,9 @@ func (p *DatasetServiceCreateDatasetArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *DatasetServiceCreateDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceUpdateDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateDataset_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateDataset_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -23469,7 +24688,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceCreateDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceUpdateDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -23486,15 +24705,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DatasetServiceCreateDatasetArgs) String() string {
+func (p *DatasetServiceUpdateDatasetArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceCreateDatasetArgs(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceUpdateDatasetArgs(%+v)", *p)
 
 }
 
-func (p *DatasetServiceCreateDatasetArgs) DeepEqual(ano *DatasetServiceCreateDatasetArgs) bool {
+func (p *DatasetServiceUpdateDatasetArgs) DeepEqual(ano *DatasetServiceUpdateDatasetArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -23506,7 +24725,7 @@ func (p *DatasetServiceCreateDatasetArgs) DeepEqual(ano *DatasetServiceCreateDat
 	return true
 }
 
-func (p *DatasetServiceCreateDatasetArgs) Field1DeepEqual(src *CreateDatasetRequest) bool {
+func (p *DatasetServiceUpdateDatasetArgs) Field1DeepEqual(src *UpdateDatasetRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -23514,41 +24733,41 @@ func (p *DatasetServiceCreateDatasetArgs) Field1DeepEqual(src *CreateDatasetRequ
 	return true
 }
 
-type DatasetServiceCreateDatasetResult struct {
-	Success *CreateDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,CreateDatasetResponse"`
+type DatasetServiceUpdateDatasetResult struct {
+	Success *UpdateDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateDatasetResponse"`
 }
 
-func NewDatasetServiceCreateDatasetResult() *DatasetServiceCreateDatasetResult {
-	return &DatasetServiceCreateDatasetResult{}
+func NewDatasetServiceUpdateDatasetResult() *DatasetServiceUpdateDatasetResult {
+	return &DatasetServiceUpdateDatasetResult{}
 }
 
-func (p *DatasetServiceCreateDatasetResult) InitDefault() {
+func (p *DatasetServiceUpdateDatasetResult) InitDefault() {
 }
 
-var DatasetServiceCreateDatasetResult_Success_DEFAULT *CreateDatasetResponse
+var DatasetServiceUpdateDatasetResult_Success_DEFAULT *UpdateDatasetResponse
 
-func (p *DatasetServiceCreateDatasetResult) GetSuccess() (v *CreateDatasetResponse) {
+func (p *DatasetServiceUpdateDatasetResult) GetSuccess() (v *UpdateDatasetResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return DatasetServiceCreateDatasetResult_Success_DEFAULT
+		return DatasetServiceUpdateDatasetResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DatasetServiceCreateDatasetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateDatasetResponse)
+func (p *DatasetServiceUpdateDatasetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateDatasetResponse)
 }
 
-var fieldIDToName_DatasetServiceCreateDatasetResult = map[int16]string{
+var fieldIDToName_DatasetServiceUpdateDatasetResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DatasetServiceCreateDatasetResult) IsSetSuccess() bool {
+func (p *DatasetServiceUpdateDatasetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DatasetServiceCreateDatasetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceUpdateDatasetResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23593,7 +24812,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceCreateDatasetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceUpdateDatasetResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23602,9 +24821,9 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-
-func (p *DatasetServiceCreateDatasetResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateDatasetResponse()
+
+func (p *DatasetServiceUpdateDatasetResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateDatasetResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23612,9 +24831,9 @@ func (p *DatasetServiceCreateDatasetResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *DatasetServiceCreateDatasetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceUpdateDatasetResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateDataset_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateDataset_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -23640,7 +24859,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceCreateDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceUpdateDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -23659,15 +24878,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DatasetServiceCreateDatasetResult) String() string {
+func (p *DatasetServiceUpdateDatasetResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceCreateDatasetResult(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceUpdateDatasetResult(%+v)", *p)
 
 }
 
-func (p *DatasetServiceCreateDatasetResult) DeepEqual(ano *DatasetServiceCreateDatasetResult) bool {
+func (p *DatasetServiceUpdateDatasetResult) DeepEqual(ano *DatasetServiceUpdateDatasetResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -23679,7 +24898,7 @@ func (p *DatasetServiceCreateDatasetResult) DeepEqual(ano *DatasetServiceCreateD
 	return true
 }
 
-func (p *DatasetServiceCreateDatasetResult) Field0DeepEqual(src *CreateDatasetResponse) bool {
+func (p *DatasetServiceUpdateDatasetResult) Field0DeepEqual(src *UpdateDatasetResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -23687,41 +24906,41 @@ func (p *DatasetServiceCreateDatasetResult) Field0DeepEqual(src *CreateDatasetRe
 	return true
 }
 
-type DatasetServiceUpdateDatasetArgs struct {
-	Req *UpdateDatasetRequest `thrift:"req,1" frugal:"1,default,UpdateDatasetRequest"`
+type DatasetServiceDeleteDatasetArgs struct {
+	Req *DeleteDatasetRequest `thrift:"req,1" frugal:"1,default,DeleteDatasetRequest"`
 }
 
-func NewDatasetServiceUpdateDatasetArgs() *DatasetServiceUpdateDatasetArgs {
-	return &DatasetServiceUpdateDatasetArgs{}
+func NewDatasetServiceDeleteDatasetArgs() *DatasetServiceDeleteDatasetArgs {
+	return &DatasetServiceDeleteDatasetArgs{}
 }
 
-func (p *DatasetServiceUpdateDatasetArgs) InitDefault() {
+func (p *DatasetServiceDeleteDatasetArgs) InitDefault() {
 }
 
-var DatasetServiceUpdateDatasetArgs_Req_DEFAULT *UpdateDatasetRequest
+var DatasetServiceDeleteDatasetArgs_Req_DEFAULT *DeleteDatasetRequest
 
-func (p *DatasetServiceUpdateDatasetArgs) GetReq() (v *UpdateDatasetRequest) {
+func (p *DatasetServiceDeleteDatasetArgs) GetReq() (v *DeleteDatasetRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return DatasetServiceUpdateDatasetArgs_Req_DEFAULT
+		return DatasetServiceDeleteDatasetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DatasetServiceUpdateDatasetArgs) SetReq(val *UpdateDatasetRequest) {
+func (p *DatasetServiceDeleteDatasetArgs) SetReq(val *DeleteDatasetRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_DatasetServiceUpdateDatasetArgs = map[int16]string{
+var fieldIDToName_DatasetServiceDeleteDatasetArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DatasetServiceUpdateDatasetArgs) IsSetReq() bool {
+func (p *DatasetServiceDeleteDatasetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DatasetServiceUpdateDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceDeleteDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23766,7 +24985,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceUpdateDatasetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceDeleteDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23776,8 +24995,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceUpdateDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateDatasetRequest()
+func (p *DatasetServiceDeleteDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteDatasetRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23785,9 +25004,9 @@ func (p *DatasetServiceUpdateDatasetArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *DatasetServiceUpdateDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceDeleteDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateDataset_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteDataset_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -23813,7 +25032,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceUpdateDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceDeleteDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -23830,15 +25049,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DatasetServiceUpdateDatasetArgs) String() string {
+func (p *DatasetServiceDeleteDatasetArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceUpdateDatasetArgs(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceDeleteDatasetArgs(%+v)", *p)
 
 }
 
-func (p *DatasetServiceUpdateDatasetArgs) DeepEqual(ano *DatasetServiceUpdateDatasetArgs) bool {
+func (p *DatasetServiceDeleteDatasetArgs) DeepEqual(ano *DatasetServiceDeleteDatasetArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -23850,7 +25069,7 @@ func (p *DatasetServiceUpdateDatasetArgs) DeepEqual(ano *DatasetServiceUpdateDat
 	return true
 }
 
-func (p *DatasetServiceUpdateDatasetArgs) Field1DeepEqual(src *UpdateDatasetRequest) bool {
+func (p *DatasetServiceDeleteDatasetArgs) Field1DeepEqual(src *DeleteDatasetRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -23858,41 +25077,41 @@ func (p *DatasetServiceUpdateDatasetArgs) Field1DeepEqual(src *UpdateDatasetRequ
 	return true
 }
 
-type DatasetServiceUpdateDatasetResult struct {
-	Success *UpdateDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateDatasetResponse"`
+type DatasetServiceDeleteDatasetResult struct {
+	Success *DeleteDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteDatasetResponse"`
 }
 
-func NewDatasetServiceUpdateDatasetResult() *DatasetServiceUpdateDatasetResult {
-	return &DatasetServiceUpdateDatasetResult{}
+func NewDatasetServiceDeleteDatasetResult() *DatasetServiceDeleteDatasetResult {
+	return &DatasetServiceDeleteDatasetResult{}
 }
 
-func (p *DatasetServiceUpdateDatasetResult) InitDefault() {
+func (p *DatasetServiceDeleteDatasetResult) InitDefault() {
 }
 
-var DatasetServiceUpdateDatasetResult_Success_DEFAULT *UpdateDatasetResponse
+var DatasetServiceDeleteDatasetResult_Success_DEFAULT *DeleteDatasetResponse
 
-func (p *DatasetServiceUpdateDatasetResult) GetSuccess() (v *UpdateDatasetResponse) {
+func (p *DatasetServiceDeleteDatasetResult) GetSuccess() (v *DeleteDatasetResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return DatasetServiceUpdateDatasetResult_Success_DEFAULT
+		return DatasetServiceDeleteDatasetResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DatasetServiceUpdateDatasetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateDatasetResponse)
+func (p *DatasetServiceDeleteDatasetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteDatasetResponse)
 }
 
-var fieldIDToName_DatasetServiceUpdateDatasetResult = map[int16]string{
+var fieldIDToName_DatasetServiceDeleteDatasetResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DatasetServiceUpdateDatasetResult) IsSetSuccess() bool {
+func (p *DatasetServiceDeleteDatasetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DatasetServiceUpdateDatasetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceDeleteDatasetResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23937,7 +25156,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceUpdateDatasetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceDeleteDatasetResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23947,8 +25166,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceUpdateDatasetResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateDatasetResponse()
+func (p *DatasetServiceDeleteDatasetResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteDatasetResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23956,9 +25175,9 @@ func (p *DatasetServiceUpdateDatasetResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *DatasetServiceUpdateDatasetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceDeleteDatasetResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateDataset_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteDataset_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -23984,7 +25203,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceUpdateDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceDeleteDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -24003,15 +25222,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DatasetServiceUpdateDatasetResult) String() string {
+func (p *DatasetServiceDeleteDatasetResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceUpdateDatasetResult(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceDeleteDatasetResult(%+v)", *p)
 
 }
 
-func (p *DatasetServiceUpdateDatasetResult) DeepEqual(ano *DatasetServiceUpdateDatasetResult) bool {
+func (p *DatasetServiceDeleteDatasetResult) DeepEqual(ano *DatasetServiceDeleteDatasetResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24023,7 +25242,7 @@ func (p *DatasetServiceUpdateDatasetResult) DeepEqual(ano *DatasetServiceUpdateD
 	return true
 }
 
-func (p *DatasetServiceUpdateDatasetResult) Field0DeepEqual(src *UpdateDatasetResponse) bool {
+func (p *DatasetServiceDeleteDatasetResult) Field0DeepEqual(src *DeleteDatasetResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -24031,41 +25250,41 @@ func (p *DatasetServiceUpdateDatasetResult) Field0DeepEqual(src *UpdateDatasetRe
 	return true
 }
 
-type DatasetServiceDeleteDatasetArgs struct {
-	Req *DeleteDatasetRequest `thrift:"req,1" frugal:"1,default,DeleteDatasetRequest"`
+type DatasetServiceListDatasetsArgs struct {
+	Req *ListDatasetsRequest `thrift:"req,1" frugal:"1,default,ListDatasetsRequest"`
 }
 
-func NewDatasetServiceDeleteDatasetArgs() *DatasetServiceDeleteDatasetArgs {
-	return &DatasetServiceDeleteDatasetArgs{}
+func NewDatasetServiceListDatasetsArgs() *DatasetServiceListDatasetsArgs {
+	return &DatasetServiceListDatasetsArgs{}
 }
 
-func (p *DatasetServiceDeleteDatasetArgs) InitDefault() {
+func (p *DatasetServiceListDatasetsArgs) InitDefault() {
 }
 
-var DatasetServiceDeleteDatasetArgs_Req_DEFAULT *DeleteDatasetRequest
+var DatasetServiceListDatasetsArgs_Req_DEFAULT *ListDatasetsRequest
 
-func (p *DatasetServiceDeleteDatasetArgs) GetReq() (v *DeleteDatasetRequest) {
+func (p *DatasetServiceListDatasetsArgs) GetReq() (v *ListDatasetsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return DatasetServiceDeleteDatasetArgs_Req_DEFAULT
+		return DatasetServiceListDatasetsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DatasetServiceDeleteDatasetArgs) SetReq(val *DeleteDatasetRequest) {
+func (p *DatasetServiceListDatasetsArgs) SetReq(val *ListDatasetsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_DatasetServiceDeleteDatasetArgs = map[int16]string{
+var fieldIDToName_DatasetServiceListDatasetsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DatasetServiceDeleteDatasetArgs) IsSetReq() bool {
+func (p *DatasetServiceListDatasetsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DatasetServiceDeleteDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceListDatasetsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24110,7 +25329,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceDeleteDatasetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceListDatasetsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24120,8 +25339,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceDeleteDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteDatasetRequest()
+func (p *DatasetServiceListDatasetsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListDatasetsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24129,9 +25348,9 @@ func (p *DatasetServiceDeleteDatasetArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *DatasetServiceDeleteDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceListDatasetsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteDataset_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasets_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24157,7 +25376,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceDeleteDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceListDatasetsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -24174,15 +25393,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DatasetServiceDeleteDatasetArgs) String() string {
+func (p *DatasetServiceListDatasetsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceDeleteDatasetArgs(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceListDatasetsArgs(%+v)", *p)
 
 }
 
-func (p *DatasetServiceDeleteDatasetArgs) DeepEqual(ano *DatasetServiceDeleteDatasetArgs) bool {
+func (p *DatasetServiceListDatasetsArgs) DeepEqual(ano *DatasetServiceListDatasetsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24194,7 +25413,7 @@ func (p *DatasetServiceDeleteDatasetArgs) DeepEqual(ano *DatasetServiceDeleteDat
 	return true
 }
 
-func (p *DatasetServiceDeleteDatasetArgs) Field1DeepEqual(src *DeleteDatasetRequest) bool {
+func (p *DatasetServiceListDatasetsArgs) Field1DeepEqual(src *ListDatasetsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -24202,41 +25421,41 @@ func (p *DatasetServiceDeleteDatasetArgs) Field1DeepEqual(src *DeleteDatasetRequ
 	return true
 }
 
-type DatasetServiceDeleteDatasetResult struct {
-	Success *DeleteDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteDatasetResponse"`
+type DatasetServiceListDatasetsResult struct {
+	Success *ListDatasetsResponse `thrift:"success,0,optional" frugal:"0,optional,ListDatasetsResponse"`
 }
 
-func NewDatasetServiceDeleteDatasetResult() *DatasetServiceDeleteDatasetResult {
-	return &DatasetServiceDeleteDatasetResult{}
+func NewDatasetServiceListDatasetsResult() *DatasetServiceListDatasetsResult {
+	return &DatasetServiceListDatasetsResult{}
 }
 
-func (p *DatasetServiceDeleteDatasetResult) InitDefault() {
+func (p *DatasetServiceListDatasetsResult) InitDefault() {
 }
 
-var DatasetServiceDeleteDatasetResult_Success_DEFAULT *DeleteDatasetResponse
+var DatasetServiceListDatasetsResult_Success_DEFAULT *ListDatasetsResponse
 
-func (p *DatasetServiceDeleteDatasetResult) GetSuccess() (v *DeleteDatasetResponse) {
+func (p *DatasetServiceListDatasetsResult) GetSuccess() (v *ListDatasetsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return DatasetServiceDeleteDatasetResult_Success_DEFAULT
+		return DatasetServiceListDatasetsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DatasetServiceDeleteDatasetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteDatasetResponse)
+func (p *DatasetServiceListDatasetsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListDatasetsResponse)
 }
 
-var fieldIDToName_DatasetServiceDeleteDatasetResult = map[int16]string{
+var fieldIDToName_DatasetServiceListDatasetsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DatasetServiceDeleteDatasetResult) IsSetSuccess() bool {
+func (p *DatasetServiceListDatasetsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DatasetServiceDeleteDatasetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceListDatasetsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24281,7 +25500,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceDeleteDatasetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceListDatasetsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24291,8 +25510,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceDeleteDatasetResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteDatasetResponse()
+func (p *DatasetServiceListDatasetsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListDatasetsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24300,9 +25519,9 @@ func (p *DatasetServiceDeleteDatasetResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *DatasetServiceDeleteDatasetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceListDatasetsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteDataset_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListDatasets_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24328,7 +25547,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceDeleteDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceListDatasetsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -24347,15 +25566,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DatasetServiceDeleteDatasetResult) String() string {
+func (p *DatasetServiceListDatasetsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceDeleteDatasetResult(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceListDatasetsResult(%+v)", *p)
 
 }
 
-func (p *DatasetServiceDeleteDatasetResult) DeepEqual(ano *DatasetServiceDeleteDatasetResult) bool {
+func (p *DatasetServiceListDatasetsResult) DeepEqual(ano *DatasetServiceListDatasetsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24367,7 +25586,7 @@ func (p *DatasetServiceDeleteDatasetResult) DeepEqual(ano *DatasetServiceDeleteD
 	return true
 }
 
-func (p *DatasetServiceDeleteDatasetResult) Field0DeepEqual(src *DeleteDatasetResponse) bool {
+func (p *DatasetServiceListDatasetsResult) Field0DeepEqual(src *ListDatasetsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -24375,41 +25594,41 @@ func (p *DatasetServiceDeleteDatasetResult) Field0DeepEqual(src *DeleteDatasetRe
 	return true
 }
 
-type DatasetServiceListDatasetsArgs struct {
-	Req *ListDatasetsRequest `thrift:"req,1" frugal:"1,default,ListDatasetsRequest"`
+type DatasetServiceGetDatasetArgs struct {
+	Req *GetDatasetRequest `thrift:"req,1" frugal:"1,default,GetDatasetRequest"`
 }
 
-func NewDatasetServiceListDatasetsArgs() *DatasetServiceListDatasetsArgs {
-	return &DatasetServiceListDatasetsArgs{}
+func NewDatasetServiceGetDatasetArgs() *DatasetServiceGetDatasetArgs {
+	return &DatasetServiceGetDatasetArgs{}
 }
 
-func (p *DatasetServiceListDatasetsArgs) InitDefault() {
+func (p *DatasetServiceGetDatasetArgs) InitDefault() {
 }
 
-var DatasetServiceListDatasetsArgs_Req_DEFAULT *ListDatasetsRequest
+var DatasetServiceGetDatasetArgs_Req_DEFAULT *GetDatasetRequest
 
-func (p *DatasetServiceListDatasetsArgs) GetReq() (v *ListDatasetsRequest) {
+func (p *DatasetServiceGetDatasetArgs) GetReq() (v *GetDatasetRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return DatasetServiceListDatasetsArgs_Req_DEFAULT
+		return DatasetServiceGetDatasetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DatasetServiceListDatasetsArgs) SetReq(val *ListDatasetsRequest) {
+func (p *DatasetServiceGetDatasetArgs) SetReq(val *GetDatasetRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_DatasetServiceListDatasetsArgs = map[int16]string{
+var fieldIDToName_DatasetServiceGetDatasetArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DatasetServiceListDatasetsArgs) IsSetReq() bool {
+func (p *DatasetServiceGetDatasetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DatasetServiceListDatasetsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceGetDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24454,7 +25673,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceListDatasetsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceGetDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24464,8 +25683,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceListDatasetsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListDatasetsRequest()
+func (p *DatasetServiceGetDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetDatasetRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24473,9 +25692,9 @@ func (p *DatasetServiceListDatasetsArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *DatasetServiceListDatasetsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceGetDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasets_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetDataset_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24501,7 +25720,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceListDatasetsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceGetDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -24518,15 +25737,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DatasetServiceListDatasetsArgs) String() string {
+func (p *DatasetServiceGetDatasetArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceListDatasetsArgs(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceGetDatasetArgs(%+v)", *p)
 
 }
 
-func (p *DatasetServiceListDatasetsArgs) DeepEqual(ano *DatasetServiceListDatasetsArgs) bool {
+func (p *DatasetServiceGetDatasetArgs) DeepEqual(ano *DatasetServiceGetDatasetArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24538,7 +25757,7 @@ func (p *DatasetServiceListDatasetsArgs) DeepEqual(ano *DatasetServiceListDatase
 	return true
 }
 
-func (p *DatasetServiceListDatasetsArgs) Field1DeepEqual(src *ListDatasetsRequest) bool {
+func (p *DatasetServiceGetDatasetArgs) Field1DeepEqual(src *GetDatasetRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -24546,41 +25765,41 @@ func (p *DatasetServiceListDatasetsArgs) Field1DeepEqual(src *ListDatasetsReques
 	return true
 }
 
-type DatasetServiceListDatasetsResult struct {
-	Success *ListDatasetsResponse `thrift:"success,0,optional" frugal:"0,optional,ListDatasetsResponse"`
+type DatasetServiceGetDatasetResult struct {
+	Success *GetDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,GetDatasetResponse"`
 }
 
-func NewDatasetServiceListDatasetsResult() *DatasetServiceListDatasetsResult {
-	return &DatasetServiceListDatasetsResult{}
+func NewDatasetServiceGetDatasetResult() *DatasetServiceGetDatasetResult {
+	return &DatasetServiceGetDatasetResult{}
 }
 
-func (p *DatasetServiceListDatasetsResult) InitDefault() {
+func (p *DatasetServiceGetDatasetResult) InitDefault() {
 }
 
-var DatasetServiceListDatasetsResult_Success_DEFAULT *ListDatasetsResponse
+var DatasetServiceGetDatasetResult_Success_DEFAULT *GetDatasetResponse
 
-func (p *DatasetServiceListDatasetsResult) GetSuccess() (v *ListDatasetsResponse) {
+func (p *DatasetServiceGetDatasetResult) GetSuccess() (v *GetDatasetResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return DatasetServiceListDatasetsResult_Success_DEFAULT
+		return DatasetServiceGetDatasetResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DatasetServiceListDatasetsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListDatasetsResponse)
+func (p *DatasetServiceGetDatasetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetDatasetResponse)
 }
 
-var fieldIDToName_DatasetServiceListDatasetsResult = map[int16]string{
+var fieldIDToName_DatasetServiceGetDatasetResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DatasetServiceListDatasetsResult) IsSetSuccess() bool {
+func (p *DatasetServiceGetDatasetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DatasetServiceListDatasetsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceGetDatasetResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24625,7 +25844,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceListDatasetsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceGetDatasetResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24635,8 +25854,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceListDatasetsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListDatasetsResponse()
+func (p *DatasetServiceGetDatasetResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetDatasetResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24644,9 +25863,9 @@ func (p *DatasetServiceListDatasetsResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *DatasetServiceListDatasetsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceGetDatasetResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListDatasets_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetDataset_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24672,7 +25891,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceListDatasetsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceGetDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -24691,15 +25910,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DatasetServiceListDatasetsResult) String() string {
+func (p *DatasetServiceGetDatasetResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceListDatasetsResult(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceGetDatasetResult(%+v)", *p)
 
 }
 
-func (p *DatasetServiceListDatasetsResult) DeepEqual(ano *DatasetServiceListDatasetsResult) bool {
+func (p *DatasetServiceGetDatasetResult) DeepEqual(ano *DatasetServiceGetDatasetResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24711,7 +25930,7 @@ func (p *DatasetServiceListDatasetsResult) DeepEqual(ano *DatasetServiceListData
 	return true
 }
 
-func (p *DatasetServiceListDatasetsResult) Field0DeepEqual(src *ListDatasetsResponse) bool {
+func (p *DatasetServiceGetDatasetResult) Field0DeepEqual(src *GetDatasetResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -24719,41 +25938,41 @@ func (p *DatasetServiceListDatasetsResult) Field0DeepEqual(src *ListDatasetsResp
 	return true
 }
 
-type DatasetServiceGetDatasetArgs struct {
-	Req *GetDatasetRequest `thrift:"req,1" frugal:"1,default,GetDatasetRequest"`
+type DatasetServiceBatchGetDatasetsArgs struct {
+	Req *BatchGetDatasetsRequest `thrift:"req,1" frugal:"1,default,BatchGetDatasetsRequest"`
 }
 
-func NewDatasetServiceGetDatasetArgs() *DatasetServiceGetDatasetArgs {
-	return &DatasetServiceGetDatasetArgs{}
+func NewDatasetServiceBatchGetDatasetsArgs() *DatasetServiceBatchGetDatasetsArgs {
+	return &DatasetServiceBatchGetDatasetsArgs{}
 }
 
-func (p *DatasetServiceGetDatasetArgs) InitDefault() {
+func (p *DatasetServiceBatchGetDatasetsArgs) InitDefault() {
 }
 
-var DatasetServiceGetDatasetArgs_Req_DEFAULT *GetDatasetRequest
+var DatasetServiceBatchGetDatasetsArgs_Req_DEFAULT *BatchGetDatasetsRequest
 
-func (p *DatasetServiceGetDatasetArgs) GetReq() (v *GetDatasetRequest) {
+func (p *DatasetServiceBatchGetDatasetsArgs) GetReq() (v *BatchGetDatasetsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return DatasetServiceGetDatasetArgs_Req_DEFAULT
+		return DatasetServiceBatchGetDatasetsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DatasetServiceGetDatasetArgs) SetReq(val *GetDatasetRequest) {
+func (p *DatasetServiceBatchGetDatasetsArgs) SetReq(val *BatchGetDatasetsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_DatasetServiceGetDatasetArgs = map[int16]string{
+var fieldIDToName_DatasetServiceBatchGetDatasetsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DatasetServiceGetDatasetArgs) IsSetReq() bool {
+func (p *DatasetServiceBatchGetDatasetsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DatasetServiceGetDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceBatchGetDatasetsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24798,7 +26017,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceGetDatasetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceBatchGetDatasetsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24808,8 +26027,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceGetDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetDatasetRequest()
+func (p *DatasetServiceBatchGetDatasetsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetDatasetsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24817,9 +26036,9 @@ func (p *DatasetServiceGetDatasetArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *DatasetServiceGetDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceBatchGetDatasetsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDataset_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetDatasets_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24845,7 +26064,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceGetDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceBatchGetDatasetsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -24862,15 +26081,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DatasetServiceGetDatasetArgs) String() string {
+func (p *DatasetServiceBatchGetDatasetsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceGetDatasetArgs(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceBatchGetDatasetsArgs(%+v)", *p)
 
 }
 
-func (p *DatasetServiceGetDatasetArgs) DeepEqual(ano *DatasetServiceGetDatasetArgs) bool {
+func (p *DatasetServiceBatchGetDatasetsArgs) DeepEqual(ano *DatasetServiceBatchGetDatasetsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24882,7 +26101,7 @@ func (p *DatasetServiceGetDatasetArgs) DeepEqual(ano *DatasetServiceGetDatasetAr
 	return true
 }
 
-func (p *DatasetServiceGetDatasetArgs) Field1DeepEqual(src *GetDatasetRequest) bool {
+func (p *DatasetServiceBatchGetDatasetsArgs) Field1DeepEqual(src *BatchGetDatasetsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -24890,41 +26109,41 @@ func (p *DatasetServiceGetDatasetArgs) Field1DeepEqual(src *GetDatasetRequest) b
 	return true
 }
 
-type DatasetServiceGetDatasetResult struct {
-	Success *GetDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,GetDatasetResponse"`
+type DatasetServiceBatchGetDatasetsResult struct {
+	Success *BatchGetDatasetsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetDatasetsResponse"`
 }
 
-func NewDatasetServiceGetDatasetResult() *DatasetServiceGetDatasetResult {
-	return &DatasetServiceGetDatasetResult{}
+func NewDatasetServiceBatchGetDatasetsResult() *DatasetServiceBatchGetDatasetsResult {
+	return &DatasetServiceBatchGetDatasetsResult{}
 }
 
-func (p *DatasetServiceGetDatasetResult) InitDefault() {
+func (p *DatasetServiceBatchGetDatasetsResult) InitDefault() {
 }
 
-var DatasetServiceGetDatasetResult_Success_DEFAULT *GetDatasetResponse
+var DatasetServiceBatchGetDatasetsResult_Success_DEFAULT *BatchGetDatasetsResponse
 
-func (p *DatasetServiceGetDatasetResult) GetSuccess() (v *GetDatasetResponse) {
+func (p *DatasetServiceBatchGetDatasetsResult) GetSuccess() (v *BatchGetDatasetsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return DatasetServiceGetDatasetResult_Success_DEFAULT
+		return DatasetServiceBatchGetDatasetsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DatasetServiceGetDatasetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetDatasetResponse)
+func (p *DatasetServiceBatchGetDatasetsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetDatasetsResponse)
 }
 
-var fieldIDToName_DatasetServiceGetDatasetResult = map[int16]string{
+var fieldIDToName_DatasetServiceBatchGetDatasetsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DatasetServiceGetDatasetResult) IsSetSuccess() bool {
+func (p *DatasetServiceBatchGetDatasetsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DatasetServiceGetDatasetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceBatchGetDatasetsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24969,7 +26188,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceGetDatasetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceBatchGetDatasetsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24979,8 +26198,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceGetDatasetResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetDatasetResponse()
+func (p *DatasetServiceBatchGetDatasetsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetDatasetsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24988,9 +26207,9 @@ func (p *DatasetServiceGetDatasetResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *DatasetServiceGetDatasetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceBatchGetDatasetsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetDataset_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetDatasets_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25016,7 +26235,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceGetDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceBatchGetDatasetsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -25035,15 +26254,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DatasetServiceGetDatasetResult) String() string {
+func (p *DatasetServiceBatchGetDatasetsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceGetDatasetResult(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceBatchGetDatasetsResult(%+v)", *p)
 
 }
 
-func (p *DatasetServiceGetDatasetResult) DeepEqual(ano *DatasetServiceGetDatasetResult) bool {
+func (p *DatasetServiceBatchGetDatasetsResult) DeepEqual(ano *DatasetServiceBatchGetDatasetsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25055,7 +26274,7 @@ func (p *DatasetServiceGetDatasetResult) DeepEqual(ano *DatasetServiceGetDataset
 	return true
 }
 
-func (p *DatasetServiceGetDatasetResult) Field0DeepEqual(src *GetDatasetResponse) bool {
+func (p *DatasetServiceBatchGetDatasetsResult) Field0DeepEqual(src *BatchGetDatasetsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -25063,41 +26282,41 @@ func (p *DatasetServiceGetDatasetResult) Field0DeepEqual(src *GetDatasetResponse
 	return true
 }
 
-type DatasetServiceBatchGetDatasetsArgs struct {
-	Req *BatchGetDatasetsRequest `thrift:"req,1" frugal:"1,default,BatchGetDatasetsRequest"`
+type DatasetServiceImportDatasetArgs struct {
+	Req *ImportDatasetRequest `thrift:"req,1" frugal:"1,default,ImportDatasetRequest"`
 }
 
-func NewDatasetServiceBatchGetDatasetsArgs() *DatasetServiceBatchGetDatasetsArgs {
-	return &DatasetServiceBatchGetDatasetsArgs{}
+func NewDatasetServiceImportDatasetArgs() *DatasetServiceImportDatasetArgs {
+	return &DatasetServiceImportDatasetArgs{}
 }
 
-func (p *DatasetServiceBatchGetDatasetsArgs) InitDefault() {
+func (p *DatasetServiceImportDatasetArgs) InitDefault() {
 }
 
-var DatasetServiceBatchGetDatasetsArgs_Req_DEFAULT *BatchGetDatasetsRequest
+var DatasetServiceImportDatasetArgs_Req_DEFAULT *ImportDatasetRequest
 
-func (p *DatasetServiceBatchGetDatasetsArgs) GetReq() (v *BatchGetDatasetsRequest) {
+func (p *DatasetServiceImportDatasetArgs) GetReq() (v *ImportDatasetRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return DatasetServiceBatchGetDatasetsArgs_Req_DEFAULT
+		return DatasetServiceImportDatasetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DatasetServiceBatchGetDatasetsArgs) SetReq(val *BatchGetDatasetsRequest) {
+func (p *DatasetServiceImportDatasetArgs) SetReq(val *ImportDatasetRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_DatasetServiceBatchGetDatasetsArgs = map[int16]string{
+var fieldIDToName_DatasetServiceImportDatasetArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DatasetServiceBatchGetDatasetsArgs) IsSetReq() bool {
+func (p *DatasetServiceImportDatasetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DatasetServiceBatchGetDatasetsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceImportDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25142,7 +26361,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceBatchGetDatasetsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceImportDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25152,8 +26371,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceBatchGetDatasetsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetDatasetsRequest()
+func (p *DatasetServiceImportDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewImportDatasetRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25161,9 +26380,9 @@ func (p *DatasetServiceBatchGetDatasetsArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *DatasetServiceBatchGetDatasetsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceImportDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetDatasets_args"); err != nil {
+	if err = oprot.WriteStructBegin("ImportDataset_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25189,7 +26408,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceBatchGetDatasetsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceImportDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -25206,15 +26425,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DatasetServiceBatchGetDatasetsArgs) String() string {
+func (p *DatasetServiceImportDatasetArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceBatchGetDatasetsArgs(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceImportDatasetArgs(%+v)", *p)
 
 }
 
-func (p *DatasetServiceBatchGetDatasetsArgs) DeepEqual(ano *DatasetServiceBatchGetDatasetsArgs) bool {
+func (p *DatasetServiceImportDatasetArgs) DeepEqual(ano *DatasetServiceImportDatasetArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25226,7 +26445,7 @@ func (p *DatasetServiceBatchGetDatasetsArgs) DeepEqual(ano *DatasetServiceBatchG
 	return true
 }
 
-func (p *DatasetServiceBatchGetDatasetsArgs) Field1DeepEqual(src *BatchGetDatasetsRequest) bool {
+func (p *DatasetServiceImportDatasetArgs) Field1DeepEqual(src *ImportDatasetRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -25234,41 +26453,41 @@ func (p *DatasetServiceBatchGetDatasetsArgs) Field1DeepEqual(src *BatchGetDatase
 	return true
 }
 
-type DatasetServiceBatchGetDatasetsResult struct {
-	Success *BatchGetDatasetsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetDatasetsResponse"`
+type DatasetServiceImportDatasetResult struct {
+	Success *ImportDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,ImportDatasetResponse"`
 }
 
-func NewDatasetServiceBatchGetDatasetsResult() *DatasetServiceBatchGetDatasetsResult {
-	return &DatasetServiceBatchGetDatasetsResult{}
+func NewDatasetServiceImportDatasetResult() *DatasetServiceImportDatasetResult {
+	return &DatasetServiceImportDatasetResult{}
 }
 
-func (p *DatasetServiceBatchGetDatasetsResult) InitDefault() {
+func (p *DatasetServiceImportDatasetResult) InitDefault() {
 }
 
-var DatasetServiceBatchGetDatasetsResult_Success_DEFAULT *BatchGetDatasetsResponse
+var DatasetServiceImportDatasetResult_Success_DEFAULT *ImportDatasetResponse
 
-func (p *DatasetServiceBatchGetDatasetsResult) GetSuccess() (v *BatchGetDatasetsResponse) {
+func (p *DatasetServiceImportDatasetResult) GetSuccess() (v *ImportDatasetResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return DatasetServiceBatchGetDatasetsResult_Success_DEFAULT
+		return DatasetServiceImportDatasetResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DatasetServiceBatchGetDatasetsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetDatasetsResponse)
+func (p *DatasetServiceImportDatasetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ImportDatasetResponse)
 }
 
-var fieldIDToName_DatasetServiceBatchGetDatasetsResult = map[int16]string{
+var fieldIDToName_DatasetServiceImportDatasetResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DatasetServiceBatchGetDatasetsResult) IsSetSuccess() bool {
+func (p *DatasetServiceImportDatasetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DatasetServiceBatchGetDatasetsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceImportDatasetResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25313,7 +26532,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceBatchGetDatasetsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceImportDatasetResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25323,8 +26542,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceBatchGetDatasetsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetDatasetsResponse()
+func (p *DatasetServiceImportDatasetResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewImportDatasetResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25332,9 +26551,9 @@ func (p *DatasetServiceBatchGetDatasetsResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *DatasetServiceBatchGetDatasetsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceImportDatasetResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetDatasets_result"); err != nil {
+	if err = oprot.WriteStructBegin("ImportDataset_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25360,7 +26579,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceBatchGetDatasetsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceImportDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -25379,15 +26598,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DatasetServiceBatchGetDatasetsResult) String() string {
+func (p *DatasetServiceImportDatasetResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceBatchGetDatasetsResult(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceImportDatasetResult(%+v)", *p)
 
 }
 
-func (p *DatasetServiceBatchGetDatasetsResult) DeepEqual(ano *DatasetServiceBatchGetDatasetsResult) bool {
+func (p *DatasetServiceImportDatasetResult) DeepEqual(ano *DatasetServiceImportDatasetResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25399,7 +26618,7 @@ func (p *DatasetServiceBatchGetDatasetsResult) DeepEqual(ano *DatasetServiceBatc
 	return true
 }
 
-func (p *DatasetServiceBatchGetDatasetsResult) Field0DeepEqual(src *BatchGetDatasetsResponse) bool {
+func (p *DatasetServiceImportDatasetResult) Field0DeepEqual(src *ImportDatasetResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -25407,41 +26626,41 @@ func (p *DatasetServiceBatchGetDatasetsResult) Field0DeepEqual(src *BatchGetData
 	return true
 }
 
-type DatasetServiceImportDatasetArgs struct {
-	Req *ImportDatasetRequest `thrift:"req,1" frugal:"1,default,ImportDatasetRequest"`
+type DatasetServiceExportDatasetArgs struct {
+	Req *ExportDatasetRequest `thrift:"req,1" frugal:"1,default,ExportDatasetRequest"`
 }
 
-func NewDatasetServiceImportDatasetArgs() *DatasetServiceImportDatasetArgs {
-	return &DatasetServiceImportDatasetArgs{}
+func NewDatasetServiceExportDatasetArgs() *DatasetServiceExportDatasetArgs {
+	return &DatasetServiceExportDatasetArgs{}
 }
 
-func (p *DatasetServiceImportDatasetArgs) InitDefault() {
+func (p *DatasetServiceExportDatasetArgs) InitDefault() {
 }
 
-var DatasetServiceImportDatasetArgs_Req_DEFAULT *ImportDatasetRequest
+var DatasetServiceExportDatasetArgs_Req_DEFAULT *ExportDatasetRequest
 
-func (p *DatasetServiceImportDatasetArgs) GetReq() (v *ImportDatasetRequest) {
+func (p *DatasetServiceExportDatasetArgs) GetReq() (v *ExportDatasetRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return DatasetServiceImportDatasetArgs_Req_DEFAULT
+		return DatasetServiceExportDatasetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *DatasetServiceImportDatasetArgs) SetReq(val *ImportDatasetRequest) {
+func (p *DatasetServiceExportDatasetArgs) SetReq(val *ExportDatasetRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_DatasetServiceImportDatasetArgs = map[int16]string{
+var fieldIDToName_DatasetServiceExportDatasetArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *DatasetServiceImportDatasetArgs) IsSetReq() bool {
+func (p *DatasetServiceExportDatasetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DatasetServiceImportDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceExportDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25486,7 +26705,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceImportDatasetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceExportDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25496,8 +26715,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceImportDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewImportDatasetRequest()
+func (p *DatasetServiceExportDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExportDatasetRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25505,9 +26724,9 @@ func (p *DatasetServiceImportDatasetArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *DatasetServiceImportDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceExportDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImportDataset_args"); err != nil {
+	if err = oprot.WriteStructBegin("ExportDataset_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25533,7 +26752,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceImportDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceExportDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -25550,15 +26769,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DatasetServiceImportDatasetArgs) String() string {
+func (p *DatasetServiceExportDatasetArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceImportDatasetArgs(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceExportDatasetArgs(%+v)", *p)
 
 }
 
-func (p *DatasetServiceImportDatasetArgs) DeepEqual(ano *DatasetServiceImportDatasetArgs) bool {
+func (p *DatasetServiceExportDatasetArgs) DeepEqual(ano *DatasetServiceExportDatasetArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25570,7 +26789,7 @@ func (p *DatasetServiceImportDatasetArgs) DeepEqual(ano *DatasetServiceImportDat
 	return true
 }
 
-func (p *DatasetServiceImportDatasetArgs) Field1DeepEqual(src *ImportDatasetRequest) bool {
+func (p *DatasetServiceExportDatasetArgs) Field1DeepEqual(src *ExportDatasetRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -25578,41 +26797,41 @@ func (p *DatasetServiceImportDatasetArgs) Field1DeepEqual(src *ImportDatasetRequ
 	return true
 }
 
-type DatasetServiceImportDatasetResult struct {
-	Success *ImportDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,ImportDatasetResponse"`
+type DatasetServiceExportDatasetResult struct {
+	Success *ExportDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,ExportDatasetResponse"`
 }
 
-func NewDatasetServiceImportDatasetResult() *DatasetServiceImportDatasetResult {
-	return &DatasetServiceImportDatasetResult{}
+func NewDatasetServiceExportDatasetResult() *DatasetServiceExportDatasetResult {
+	return &DatasetServiceExportDatasetResult{}
 }
 
-func (p *DatasetServiceImportDatasetResult) InitDefault() {
+func (p *DatasetServiceExportDatasetResult) InitDefault() {
 }
 
-var DatasetServiceImportDatasetResult_Success_DEFAULT *ImportDatasetResponse
+var DatasetServiceExportDatasetResult_Success_DEFAULT *ExportDatasetResponse
 
-func (p *DatasetServiceImportDatasetResult) GetSuccess() (v *ImportDatasetResponse) {
+func (p *DatasetServiceExportDatasetResult) GetSuccess() (v *ExportDatasetResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return DatasetServiceImportDatasetResult_Success_DEFAULT
+		return DatasetServiceExportDatasetResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *DatasetServiceImportDatasetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ImportDatasetResponse)
+func (p *DatasetServiceExportDatasetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportDatasetResponse)
 }
 
-var fieldIDToName_DatasetServiceImportDatasetResult = map[int16]string{
+var fieldIDToName_DatasetServiceExportDatasetResult = map[int16]string{
 	0: "success",
 }
 
-func (p *DatasetServiceImportDatasetResult) IsSetSuccess() bool {
+func (p *DatasetServiceExportDatasetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DatasetServiceImportDatasetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceExportDatasetResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25657,7 +26876,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceImportDatasetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DatasetServiceExportDatasetResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25667,8 +26886,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DatasetServiceImportDatasetResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewImportDatasetResponse()
+func (p *DatasetServiceExportDatasetResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExportDatasetResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25676,9 +26895,9 @@ func (p *DatasetServiceImportDatasetResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *DatasetServiceImportDatasetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceExportDatasetResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ImportDataset_result"); err != nil {
+	if err = oprot.WriteStructBegin("ExportDataset_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25704,7 +26923,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DatasetServiceImportDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *DatasetServiceExportDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -25723,15 +26942,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *DatasetServiceImportDatasetResult) String() string {
+func (p *DatasetServiceExportDatasetResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DatasetServiceImportDatasetResult(%+v)", *p)
+	return fmt.Sprintf("DatasetServiceExportDatasetResult(%+v)", *p)
 
 }
 
-func (p *DatasetServiceImportDatasetResult) DeepEqual(ano *DatasetServiceImportDatasetResult) bool {
+func (p *DatasetServiceExportDatasetResult) DeepEqual(ano *DatasetServiceExportDatasetResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25743,7 +26962,7 @@ func (p *DatasetServiceImportDatasetResult) DeepEqual(ano *DatasetServiceImportD
 	return true
 }
 
-func (p *DatasetServiceImportDatasetResult) Field0DeepEqual(src *ImportDatasetResponse) bool {
+func (p *DatasetServiceExportDatasetResult) Field0DeepEqual(src *ExportDatasetResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -313,6 +313,37 @@ func (p *ImportDatasetResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *ExportDatasetRequest) IsValid() error {
+	if p.WorkspaceID == nil {
+		return fmt.Errorf("field WorkspaceID not_nil rule failed")
+	}
+	if *p.WorkspaceID <= int64(0) {
+		return fmt.Errorf("field WorkspaceID gt rule failed, current value: %v", *p.WorkspaceID)
+	}
+	if p.DatasetID <= int64(0) {
+		return fmt.Errorf("field DatasetID gt rule failed, current value: %v", p.DatasetID)
+	}
+	if p.File == nil {
+		return fmt.Errorf("field File not_nil rule failed")
+	}
+	if err := p.File.IsValid(); err != nil {
+		return fmt.Errorf("field File not valid, %w", err)
+	}
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExportDatasetResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
 func (p *GetDatasetIOJobRequest) IsValid() error {
 	if p.WorkspaceID == nil {
 		return fmt.Errorf("field WorkspaceID not_nil rule failed")
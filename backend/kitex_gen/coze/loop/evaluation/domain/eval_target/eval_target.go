@@ -32,6 +32,8 @@ const (
 
 	AccessProtocolFaasHTTPOld = "faas_http_old"
 
+	AccessProtocolHTTP = "http"
+
 	HTTPMethodGet = "get"
 
 	HTTPMethodPost = "post"
@@ -53,6 +55,8 @@ const (
 	EvalTargetType_CustomRPCServer EvalTargetType = 6
 	// 火山智能体Agentkit
 	EvalTargetType_VolcengineAgentAgentkit EvalTargetType = 7
+	// OpenAI兼容的/v1/chat/completions接口
+	EvalTargetType_OpenAIChat EvalTargetType = 8
 )
 
 func (p EvalTargetType) String() string {
@@ -71,6 +75,8 @@ func (p EvalTargetType) String() string {
 		return "CustomRPCServer"
 	case EvalTargetType_VolcengineAgentAgentkit:
 		return "VolcengineAgentAgentkit"
+	case EvalTargetType_OpenAIChat:
+		return "OpenAIChat"
 	}
 	return "<UNSET>"
 }
@@ -91,6 +97,8 @@ func EvalTargetTypeFromString(s string) (EvalTargetType, error) {
 		return EvalTargetType_CustomRPCServer, nil
 	case "VolcengineAgentAgentkit":
 		return EvalTargetType_VolcengineAgentAgentkit, nil
+	case "OpenAIChat":
+		return EvalTargetType_OpenAIChat, nil
 	}
 	return EvalTargetType(0), fmt.Errorf("not a valid EvalTargetType string")
 }
@@ -1441,6 +1449,8 @@ type EvalTargetContent struct {
 	VolcengineAgent *VolcengineAgent `thrift:"volcengine_agent,104,optional" frugal:"104,optional,VolcengineAgent" form:"volcengine_agent" json:"volcengine_agent,omitempty" query:"volcengine_agent"`
 	// EvalTargetType=6 时，传参此字段。 评测对象为 CustomRPCServer 时, 需要设置 CustomRPCServer 信息
 	CustomRPCServer *CustomRPCServer `thrift:"custom_rpc_server,105,optional" frugal:"105,optional,CustomRPCServer" form:"custom_rpc_server" json:"custom_rpc_server,omitempty" query:"custom_rpc_server"`
+	// EvalTargetType=8 时，传参此字段。 评测对象为 OpenAIChat 时, 需要设置 OpenAIChat 信息
+	OpenaiChat *OpenAIChat `thrift:"openai_chat,106,optional" frugal:"106,optional,OpenAIChat" form:"openai_chat" json:"openai_chat,omitempty" query:"openai_chat"`
 }
 
 func NewEvalTargetContent() *EvalTargetContent {
@@ -1545,6 +1555,18 @@ func (p *EvalTargetContent) GetCustomRPCServer() (v *CustomRPCServer) {
 	}
 	return p.CustomRPCServer
 }
+
+var EvalTargetContent_OpenaiChat_DEFAULT *OpenAIChat
+
+func (p *EvalTargetContent) GetOpenaiChat() (v *OpenAIChat) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOpenaiChat() {
+		return EvalTargetContent_OpenaiChat_DEFAULT
+	}
+	return p.OpenaiChat
+}
 func (p *EvalTargetContent) SetInputSchemas(val []*common.ArgsSchema) {
 	p.InputSchemas = val
 }
@@ -1569,6 +1591,9 @@ func (p *EvalTargetContent) SetVolcengineAgent(val *VolcengineAgent) {
 func (p *EvalTargetContent) SetCustomRPCServer(val *CustomRPCServer) {
 	p.CustomRPCServer = val
 }
+func (p *EvalTargetContent) SetOpenaiChat(val *OpenAIChat) {
+	p.OpenaiChat = val
+}
 
 var fieldIDToName_EvalTargetContent = map[int16]string{
 	1:   "input_schemas",
@@ -1579,6 +1604,7 @@ var fieldIDToName_EvalTargetContent = map[int16]string{
 	103: "coze_workflow",
 	104: "volcengine_agent",
 	105: "custom_rpc_server",
+	106: "openai_chat",
 }
 
 func (p *EvalTargetContent) IsSetInputSchemas() bool {
@@ -1613,6 +1639,10 @@ func (p *EvalTargetContent) IsSetCustomRPCServer() bool {
 	return p.CustomRPCServer != nil
 }
 
+func (p *EvalTargetContent) IsSetOpenaiChat() bool {
+	return p.OpenaiChat != nil
+}
+
 func (p *EvalTargetContent) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1695,6 +1725,14 @@ func (p *EvalTargetContent) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 106:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField106(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1821,6 +1859,14 @@ func (p *EvalTargetContent) ReadField105(iprot thrift.TProtocol) error {
 	p.CustomRPCServer = _field
 	return nil
 }
+func (p *EvalTargetContent) ReadField106(iprot thrift.TProtocol) error {
+	_field := NewOpenAIChat()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.OpenaiChat = _field
+	return nil
+}
 
 func (p *EvalTargetContent) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1860,6 +1906,10 @@ func (p *EvalTargetContent) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 105
 			goto WriteFieldError
 		}
+		if err = p.writeField106(oprot); err != nil {
+			fieldId = 106
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2038,6 +2088,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 105 end error: ", p), err)
 }
+func (p *EvalTargetContent) writeField106(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOpenaiChat() {
+		if err = oprot.WriteFieldBegin("openai_chat", thrift.STRUCT, 106); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.OpenaiChat.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 106 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 106 end error: ", p), err)
+}
 
 func (p *EvalTargetContent) String() string {
 	if p == nil {
@@ -2077,6 +2145,9 @@ func (p *EvalTargetContent) DeepEqual(ano *EvalTargetContent) bool {
 	if !p.Field105DeepEqual(ano.CustomRPCServer) {
 		return false
 	}
+	if !p.Field106DeepEqual(ano.OpenaiChat) {
+		return false
+	}
 	return true
 }
 
@@ -2153,6 +2224,13 @@ func (p *EvalTargetContent) Field105DeepEqual(src *CustomRPCServer) bool {
 	}
 	return true
 }
+func (p *EvalTargetContent) Field106DeepEqual(src *OpenAIChat) bool {
+
+	if !p.OpenaiChat.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 type CustomRPCServer struct {
 	// 应用ID
@@ -2186,8 +2264,12 @@ type CustomRPCServer struct {
 	// 执行超时时间，单位ms
 	Timeout *int64 `thrift:"timeout,22,optional" frugal:"22,optional,i64" form:"timeout" json:"timeout,omitempty" query:"timeout"`
 	// 异步执行超时时间，单位ms
-	AsyncTimeout *int64            `thrift:"async_timeout,23,optional" frugal:"23,optional,i64" form:"async_timeout" json:"async_timeout,omitempty" query:"async_timeout"`
-	Ext          map[string]string `thrift:"ext,50,optional" frugal:"50,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+	AsyncTimeout *int64 `thrift:"async_timeout,23,optional" frugal:"23,optional,i64" form:"async_timeout" json:"async_timeout,omitempty" query:"async_timeout"`
+	// access_protocol=http时的服务地址，与各http_info中的path拼接为请求url
+	Endpoint *string `thrift:"endpoint,24,optional" frugal:"24,optional,string" form:"endpoint" json:"endpoint,omitempty" query:"endpoint"`
+	// access_protocol=http时附加的请求头，例如鉴权信息
+	Headers map[string]string `thrift:"headers,25,optional" frugal:"25,optional,map<string:string>" form:"headers" json:"headers,omitempty" query:"headers"`
+	Ext     map[string]string `thrift:"ext,50,optional" frugal:"50,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
 }
 
 func NewCustomRPCServer() *CustomRPCServer {
@@ -2401,6 +2483,30 @@ func (p *CustomRPCServer) GetAsyncTimeout() (v int64) {
 	return *p.AsyncTimeout
 }
 
+var CustomRPCServer_Endpoint_DEFAULT string
+
+func (p *CustomRPCServer) GetEndpoint() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEndpoint() {
+		return CustomRPCServer_Endpoint_DEFAULT
+	}
+	return *p.Endpoint
+}
+
+var CustomRPCServer_Headers_DEFAULT map[string]string
+
+func (p *CustomRPCServer) GetHeaders() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHeaders() {
+		return CustomRPCServer_Headers_DEFAULT
+	}
+	return p.Headers
+}
+
 var CustomRPCServer_Ext_DEFAULT map[string]string
 
 func (p *CustomRPCServer) GetExt() (v map[string]string) {
@@ -2463,6 +2569,12 @@ func (p *CustomRPCServer) SetTimeout(val *int64) {
 func (p *CustomRPCServer) SetAsyncTimeout(val *int64) {
 	p.AsyncTimeout = val
 }
+func (p *CustomRPCServer) SetEndpoint(val *string) {
+	p.Endpoint = val
+}
+func (p *CustomRPCServer) SetHeaders(val map[string]string) {
+	p.Headers = val
+}
 func (p *CustomRPCServer) SetExt(val map[string]string) {
 	p.Ext = val
 }
@@ -2485,6 +2597,8 @@ var fieldIDToName_CustomRPCServer = map[int16]string{
 	21: "exec_env",
 	22: "timeout",
 	23: "async_timeout",
+	24: "endpoint",
+	25: "headers",
 	50: "ext",
 }
 
@@ -2556,6 +2670,14 @@ func (p *CustomRPCServer) IsSetAsyncTimeout() bool {
 	return p.AsyncTimeout != nil
 }
 
+func (p *CustomRPCServer) IsSetEndpoint() bool {
+	return p.Endpoint != nil
+}
+
+func (p *CustomRPCServer) IsSetHeaders() bool {
+	return p.Headers != nil
+}
+
 func (p *CustomRPCServer) IsSetExt() bool {
 	return p.Ext != nil
 }
@@ -2714,6 +2836,22 @@ func (p *CustomRPCServer) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 24:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField24(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 25:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField25(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 50:
 			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField50(iprot); err != nil {
@@ -2938,6 +3076,46 @@ func (p *CustomRPCServer) ReadField23(iprot thrift.TProtocol) error {
 	p.AsyncTimeout = _field
 	return nil
 }
+func (p *CustomRPCServer) ReadField24(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Endpoint = _field
+	return nil
+}
+func (p *CustomRPCServer) ReadField25(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Headers = _field
+	return nil
+}
 func (p *CustomRPCServer) ReadField50(iprot thrift.TProtocol) error {
 	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
@@ -3042,6 +3220,14 @@ func (p *CustomRPCServer) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 23
 			goto WriteFieldError
 		}
+		if err = p.writeField24(oprot); err != nil {
+			fieldId = 24
+			goto WriteFieldError
+		}
+		if err = p.writeField25(oprot); err != nil {
+			fieldId = 25
+			goto WriteFieldError
+		}
 		if err = p.writeField50(oprot); err != nil {
 			fieldId = 50
 			goto WriteFieldError
@@ -3378,6 +3564,53 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
 }
+func (p *CustomRPCServer) writeField24(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndpoint() {
+		if err = oprot.WriteFieldBegin("endpoint", thrift.STRING, 24); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Endpoint); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
+}
+func (p *CustomRPCServer) writeField25(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeaders() {
+		if err = oprot.WriteFieldBegin("headers", thrift.MAP, 25); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Headers)); err != nil {
+			return err
+		}
+		for k, v := range p.Headers {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 25 end error: ", p), err)
+}
 func (p *CustomRPCServer) writeField50(oprot thrift.TProtocol) (err error) {
 	if p.IsSetExt() {
 		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 50); err != nil {
@@ -3473,6 +3706,12 @@ func (p *CustomRPCServer) DeepEqual(ano *CustomRPCServer) bool {
 	if !p.Field23DeepEqual(ano.AsyncTimeout) {
 		return false
 	}
+	if !p.Field24DeepEqual(ano.Endpoint) {
+		return false
+	}
+	if !p.Field25DeepEqual(ano.Headers) {
+		return false
+	}
 	if !p.Field50DeepEqual(ano.Ext) {
 		return false
 	}
@@ -3664,6 +3903,31 @@ func (p *CustomRPCServer) Field23DeepEqual(src *int64) bool {
 	}
 	return true
 }
+func (p *CustomRPCServer) Field24DeepEqual(src *string) bool {
+
+	if p.Endpoint == src {
+		return true
+	} else if p.Endpoint == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Endpoint, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CustomRPCServer) Field25DeepEqual(src map[string]string) bool {
+
+	if len(p.Headers) != len(src) {
+		return false
+	}
+	for k, v := range p.Headers {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
 func (p *CustomRPCServer) Field50DeepEqual(src map[string]string) bool {
 
 	if len(p.Ext) != len(src) {
@@ -3912,18 +4176,816 @@ func (p *CustomEvalTarget) ReadField10(iprot thrift.TProtocol) error {
 			_val = v
 		}
 
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Ext = _field
+	return nil
+}
+
+func (p *CustomEvalTarget) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CustomEvalTarget"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CustomEvalTarget) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CustomEvalTarget) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CustomEvalTarget) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvatarURL() {
+		if err = oprot.WriteFieldBegin("avatar_url", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.AvatarURL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *CustomEvalTarget) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExt() {
+		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+			return err
+		}
+		for k, v := range p.Ext {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *CustomEvalTarget) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CustomEvalTarget(%+v)", *p)
+
+}
+
+func (p *CustomEvalTarget) DeepEqual(ano *CustomEvalTarget) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.AvatarURL) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Ext) {
+		return false
+	}
+	return true
+}
+
+func (p *CustomEvalTarget) Field1DeepEqual(src *string) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CustomEvalTarget) Field2DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CustomEvalTarget) Field3DeepEqual(src *string) bool {
+
+	if p.AvatarURL == src {
+		return true
+	} else if p.AvatarURL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.AvatarURL, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CustomEvalTarget) Field10DeepEqual(src map[string]string) bool {
+
+	if len(p.Ext) != len(src) {
+		return false
+	}
+	for k, v := range p.Ext {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type HTTPInfo struct {
+	Method *HTTPMethod `thrift:"method,1,optional" frugal:"1,optional,string" form:"method" json:"method,omitempty" query:"method"`
+	Path   *string     `thrift:"path,2,optional" frugal:"2,optional,string" form:"path" json:"path,omitempty" query:"path"`
+}
+
+func NewHTTPInfo() *HTTPInfo {
+	return &HTTPInfo{}
+}
+
+func (p *HTTPInfo) InitDefault() {
+}
+
+var HTTPInfo_Method_DEFAULT HTTPMethod
+
+func (p *HTTPInfo) GetMethod() (v HTTPMethod) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMethod() {
+		return HTTPInfo_Method_DEFAULT
+	}
+	return *p.Method
+}
+
+var HTTPInfo_Path_DEFAULT string
+
+func (p *HTTPInfo) GetPath() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPath() {
+		return HTTPInfo_Path_DEFAULT
+	}
+	return *p.Path
+}
+func (p *HTTPInfo) SetMethod(val *HTTPMethod) {
+	p.Method = val
+}
+func (p *HTTPInfo) SetPath(val *string) {
+	p.Path = val
+}
+
+var fieldIDToName_HTTPInfo = map[int16]string{
+	1: "method",
+	2: "path",
+}
+
+func (p *HTTPInfo) IsSetMethod() bool {
+	return p.Method != nil
+}
+
+func (p *HTTPInfo) IsSetPath() bool {
+	return p.Path != nil
+}
+
+func (p *HTTPInfo) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *HTTPInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *HTTPMethod
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Method = _field
+	return nil
+}
+func (p *HTTPInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Path = _field
+	return nil
+}
+
+func (p *HTTPInfo) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HTTPInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *HTTPInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMethod() {
+		if err = oprot.WriteFieldBegin("method", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Method); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *HTTPInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPath() {
+		if err = oprot.WriteFieldBegin("path", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Path); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *HTTPInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HTTPInfo(%+v)", *p)
+
+}
+
+func (p *HTTPInfo) DeepEqual(ano *HTTPInfo) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Method) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Path) {
+		return false
+	}
+	return true
+}
+
+func (p *HTTPInfo) Field1DeepEqual(src *HTTPMethod) bool {
+
+	if p.Method == src {
+		return true
+	} else if p.Method == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Method, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HTTPInfo) Field2DeepEqual(src *string) bool {
+
+	if p.Path == src {
+		return true
+	} else if p.Path == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Path, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// OpenAI兼容的Chat接口，按消息模板把评测集字段渲染为chat messages后调用{base_url}/chat/completions
+type OpenAIChat struct {
+	// 例如https://api.openai.com/v1
+	BaseURL *string `thrift:"base_url,1,optional" frugal:"1,optional,string" form:"base_url" json:"base_url,omitempty" query:"base_url"`
+	Model   *string `thrift:"model,2,optional" frugal:"2,optional,string" form:"model" json:"model,omitempty" query:"model"`
+	// 请求头，例如Authorization，不对外返回
+	Headers map[string]string `thrift:"headers,3,optional" frugal:"3,optional,map<string:string>" form:"headers" json:"headers,omitempty" query:"headers"`
+	// 消息模板，content中的{{var}}会作为评测对象的输入字段
+	MessageTemplates []*OpenAIChatMessageTemplate `thrift:"message_templates,4,optional" frugal:"4,optional,list<OpenAIChatMessageTemplate>" form:"message_templates" json:"message_templates,omitempty" query:"message_templates"`
+	// 是否流式调用，流式调用时记录首包耗时
+	Stream *bool `thrift:"stream,5,optional" frugal:"5,optional,bool" form:"stream" json:"stream,omitempty" query:"stream"`
+	// 执行超时时间，单位ms
+	Timeout *int64 `thrift:"timeout,6,optional" frugal:"6,optional,i64" form:"timeout" json:"timeout,omitempty" query:"timeout"`
+}
+
+func NewOpenAIChat() *OpenAIChat {
+	return &OpenAIChat{}
+}
+
+func (p *OpenAIChat) InitDefault() {
+}
+
+var OpenAIChat_BaseURL_DEFAULT string
+
+func (p *OpenAIChat) GetBaseURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseURL() {
+		return OpenAIChat_BaseURL_DEFAULT
+	}
+	return *p.BaseURL
+}
+
+var OpenAIChat_Model_DEFAULT string
+
+func (p *OpenAIChat) GetModel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModel() {
+		return OpenAIChat_Model_DEFAULT
+	}
+	return *p.Model
+}
+
+var OpenAIChat_Headers_DEFAULT map[string]string
+
+func (p *OpenAIChat) GetHeaders() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHeaders() {
+		return OpenAIChat_Headers_DEFAULT
+	}
+	return p.Headers
+}
+
+var OpenAIChat_MessageTemplates_DEFAULT []*OpenAIChatMessageTemplate
+
+func (p *OpenAIChat) GetMessageTemplates() (v []*OpenAIChatMessageTemplate) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMessageTemplates() {
+		return OpenAIChat_MessageTemplates_DEFAULT
+	}
+	return p.MessageTemplates
+}
+
+var OpenAIChat_Stream_DEFAULT bool
+
+func (p *OpenAIChat) GetStream() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStream() {
+		return OpenAIChat_Stream_DEFAULT
+	}
+	return *p.Stream
+}
+
+var OpenAIChat_Timeout_DEFAULT int64
+
+func (p *OpenAIChat) GetTimeout() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTimeout() {
+		return OpenAIChat_Timeout_DEFAULT
+	}
+	return *p.Timeout
+}
+func (p *OpenAIChat) SetBaseURL(val *string) {
+	p.BaseURL = val
+}
+func (p *OpenAIChat) SetModel(val *string) {
+	p.Model = val
+}
+func (p *OpenAIChat) SetHeaders(val map[string]string) {
+	p.Headers = val
+}
+func (p *OpenAIChat) SetMessageTemplates(val []*OpenAIChatMessageTemplate) {
+	p.MessageTemplates = val
+}
+func (p *OpenAIChat) SetStream(val *bool) {
+	p.Stream = val
+}
+func (p *OpenAIChat) SetTimeout(val *int64) {
+	p.Timeout = val
+}
+
+var fieldIDToName_OpenAIChat = map[int16]string{
+	1: "base_url",
+	2: "model",
+	3: "headers",
+	4: "message_templates",
+	5: "stream",
+	6: "timeout",
+}
+
+func (p *OpenAIChat) IsSetBaseURL() bool {
+	return p.BaseURL != nil
+}
+
+func (p *OpenAIChat) IsSetModel() bool {
+	return p.Model != nil
+}
+
+func (p *OpenAIChat) IsSetHeaders() bool {
+	return p.Headers != nil
+}
+
+func (p *OpenAIChat) IsSetMessageTemplates() bool {
+	return p.MessageTemplates != nil
+}
+
+func (p *OpenAIChat) IsSetStream() bool {
+	return p.Stream != nil
+}
+
+func (p *OpenAIChat) IsSetTimeout() bool {
+	return p.Timeout != nil
+}
+
+func (p *OpenAIChat) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OpenAIChat[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *OpenAIChat) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseURL = _field
+	return nil
+}
+func (p *OpenAIChat) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Model = _field
+	return nil
+}
+func (p *OpenAIChat) ReadField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Headers = _field
+	return nil
+}
+func (p *OpenAIChat) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*OpenAIChatMessageTemplate, 0, size)
+	values := make([]OpenAIChatMessageTemplate, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.MessageTemplates = _field
+	return nil
+}
+func (p *OpenAIChat) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Stream = _field
+	return nil
+}
+func (p *OpenAIChat) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Ext = _field
+	p.Timeout = _field
 	return nil
 }
 
-func (p *CustomEvalTarget) Write(oprot thrift.TProtocol) (err error) {
+func (p *OpenAIChat) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CustomEvalTarget"); err != nil {
+	if err = oprot.WriteStructBegin("OpenAIChat"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3939,8 +5001,16 @@ func (p *CustomEvalTarget) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
 			goto WriteFieldError
 		}
 	}
@@ -3961,12 +5031,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CustomEvalTarget) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *OpenAIChat) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseURL() {
+		if err = oprot.WriteFieldBegin("base_url", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ID); err != nil {
+		if err := oprot.WriteString(*p.BaseURL); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3979,12 +5049,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *CustomEvalTarget) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+func (p *OpenAIChat) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModel() {
+		if err = oprot.WriteFieldBegin("model", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Name); err != nil {
+		if err := oprot.WriteString(*p.Model); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3997,12 +5067,23 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *CustomEvalTarget) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAvatarURL() {
-		if err = oprot.WriteFieldBegin("avatar_url", thrift.STRING, 3); err != nil {
+func (p *OpenAIChat) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeaders() {
+		if err = oprot.WriteFieldBegin("headers", thrift.MAP, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.AvatarURL); err != nil {
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Headers)); err != nil {
+			return err
+		}
+		for k, v := range p.Headers {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4015,23 +5096,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *CustomEvalTarget) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExt() {
-		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 10); err != nil {
+func (p *OpenAIChat) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessageTemplates() {
+		if err = oprot.WriteFieldBegin("message_templates", thrift.LIST, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.MessageTemplates)); err != nil {
 			return err
 		}
-		for k, v := range p.Ext {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
+		for _, v := range p.MessageTemplates {
+			if err := v.Write(oprot); err != nil {
 				return err
 			}
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4040,146 +5118,214 @@ func (p *CustomEvalTarget) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *OpenAIChat) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStream() {
+		if err = oprot.WriteFieldBegin("stream", thrift.BOOL, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Stream); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *OpenAIChat) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeout() {
+		if err = oprot.WriteFieldBegin("timeout", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Timeout); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *CustomEvalTarget) String() string {
+func (p *OpenAIChat) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CustomEvalTarget(%+v)", *p)
+	return fmt.Sprintf("OpenAIChat(%+v)", *p)
 
 }
 
-func (p *CustomEvalTarget) DeepEqual(ano *CustomEvalTarget) bool {
+func (p *OpenAIChat) DeepEqual(ano *OpenAIChat) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ID) {
+	if !p.Field1DeepEqual(ano.BaseURL) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Name) {
+	if !p.Field2DeepEqual(ano.Model) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.AvatarURL) {
+	if !p.Field3DeepEqual(ano.Headers) {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.Ext) {
+	if !p.Field4DeepEqual(ano.MessageTemplates) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Stream) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Timeout) {
 		return false
 	}
 	return true
 }
 
-func (p *CustomEvalTarget) Field1DeepEqual(src *string) bool {
+func (p *OpenAIChat) Field1DeepEqual(src *string) bool {
 
-	if p.ID == src {
+	if p.BaseURL == src {
 		return true
-	} else if p.ID == nil || src == nil {
+	} else if p.BaseURL == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.ID, *src) != 0 {
+	if strings.Compare(*p.BaseURL, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *CustomEvalTarget) Field2DeepEqual(src *string) bool {
+func (p *OpenAIChat) Field2DeepEqual(src *string) bool {
 
-	if p.Name == src {
+	if p.Model == src {
 		return true
-	} else if p.Name == nil || src == nil {
+	} else if p.Model == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Name, *src) != 0 {
+	if strings.Compare(*p.Model, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *CustomEvalTarget) Field3DeepEqual(src *string) bool {
+func (p *OpenAIChat) Field3DeepEqual(src map[string]string) bool {
 
-	if p.AvatarURL == src {
-		return true
-	} else if p.AvatarURL == nil || src == nil {
+	if len(p.Headers) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.AvatarURL, *src) != 0 {
-		return false
+	for k, v := range p.Headers {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
 	}
 	return true
 }
-func (p *CustomEvalTarget) Field10DeepEqual(src map[string]string) bool {
+func (p *OpenAIChat) Field4DeepEqual(src []*OpenAIChatMessageTemplate) bool {
 
-	if len(p.Ext) != len(src) {
+	if len(p.MessageTemplates) != len(src) {
 		return false
 	}
-	for k, v := range p.Ext {
-		_src := src[k]
-		if strings.Compare(v, _src) != 0 {
+	for i, v := range p.MessageTemplates {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
 			return false
 		}
 	}
 	return true
 }
+func (p *OpenAIChat) Field5DeepEqual(src *bool) bool {
 
-type HTTPInfo struct {
-	Method *HTTPMethod `thrift:"method,1,optional" frugal:"1,optional,string" form:"method" json:"method,omitempty" query:"method"`
-	Path   *string     `thrift:"path,2,optional" frugal:"2,optional,string" form:"path" json:"path,omitempty" query:"path"`
+	if p.Stream == src {
+		return true
+	} else if p.Stream == nil || src == nil {
+		return false
+	}
+	if *p.Stream != *src {
+		return false
+	}
+	return true
 }
+func (p *OpenAIChat) Field6DeepEqual(src *int64) bool {
 
-func NewHTTPInfo() *HTTPInfo {
-	return &HTTPInfo{}
+	if p.Timeout == src {
+		return true
+	} else if p.Timeout == nil || src == nil {
+		return false
+	}
+	if *p.Timeout != *src {
+		return false
+	}
+	return true
 }
 
-func (p *HTTPInfo) InitDefault() {
+type OpenAIChatMessageTemplate struct {
+	// system/user/assistant
+	Role    *string `thrift:"role,1,optional" frugal:"1,optional,string" form:"role" json:"role,omitempty" query:"role"`
+	Content *string `thrift:"content,2,optional" frugal:"2,optional,string" form:"content" json:"content,omitempty" query:"content"`
 }
 
-var HTTPInfo_Method_DEFAULT HTTPMethod
+func NewOpenAIChatMessageTemplate() *OpenAIChatMessageTemplate {
+	return &OpenAIChatMessageTemplate{}
+}
 
-func (p *HTTPInfo) GetMethod() (v HTTPMethod) {
+func (p *OpenAIChatMessageTemplate) InitDefault() {
+}
+
+var OpenAIChatMessageTemplate_Role_DEFAULT string
+
+func (p *OpenAIChatMessageTemplate) GetRole() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetMethod() {
-		return HTTPInfo_Method_DEFAULT
+	if !p.IsSetRole() {
+		return OpenAIChatMessageTemplate_Role_DEFAULT
 	}
-	return *p.Method
+	return *p.Role
 }
 
-var HTTPInfo_Path_DEFAULT string
+var OpenAIChatMessageTemplate_Content_DEFAULT string
 
-func (p *HTTPInfo) GetPath() (v string) {
+func (p *OpenAIChatMessageTemplate) GetContent() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPath() {
-		return HTTPInfo_Path_DEFAULT
+	if !p.IsSetContent() {
+		return OpenAIChatMessageTemplate_Content_DEFAULT
 	}
-	return *p.Path
+	return *p.Content
 }
-func (p *HTTPInfo) SetMethod(val *HTTPMethod) {
-	p.Method = val
+func (p *OpenAIChatMessageTemplate) SetRole(val *string) {
+	p.Role = val
 }
-func (p *HTTPInfo) SetPath(val *string) {
-	p.Path = val
+func (p *OpenAIChatMessageTemplate) SetContent(val *string) {
+	p.Content = val
 }
 
-var fieldIDToName_HTTPInfo = map[int16]string{
-	1: "method",
-	2: "path",
+var fieldIDToName_OpenAIChatMessageTemplate = map[int16]string{
+	1: "role",
+	2: "content",
 }
 
-func (p *HTTPInfo) IsSetMethod() bool {
-	return p.Method != nil
+func (p *OpenAIChatMessageTemplate) IsSetRole() bool {
+	return p.Role != nil
 }
 
-func (p *HTTPInfo) IsSetPath() bool {
-	return p.Path != nil
+func (p *OpenAIChatMessageTemplate) IsSetContent() bool {
+	return p.Content != nil
 }
 
-func (p *HTTPInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *OpenAIChatMessageTemplate) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4232,7 +5378,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OpenAIChatMessageTemplate[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4242,18 +5388,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *HTTPInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *OpenAIChatMessageTemplate) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *HTTPMethod
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Method = _field
+	p.Role = _field
 	return nil
 }
-func (p *HTTPInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *OpenAIChatMessageTemplate) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4261,13 +5407,13 @@ func (p *HTTPInfo) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Path = _field
+	p.Content = _field
 	return nil
 }
 
-func (p *HTTPInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *OpenAIChatMessageTemplate) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("HTTPInfo"); err != nil {
+	if err = oprot.WriteStructBegin("OpenAIChatMessageTemplate"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4297,12 +5443,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *HTTPInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMethod() {
-		if err = oprot.WriteFieldBegin("method", thrift.STRING, 1); err != nil {
+func (p *OpenAIChatMessageTemplate) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRole() {
+		if err = oprot.WriteFieldBegin("role", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Method); err != nil {
+		if err := oprot.WriteString(*p.Role); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4315,12 +5461,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *HTTPInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPath() {
-		if err = oprot.WriteFieldBegin("path", thrift.STRING, 2); err != nil {
+func (p *OpenAIChatMessageTemplate) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContent() {
+		if err = oprot.WriteFieldBegin("content", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Path); err != nil {
+		if err := oprot.WriteString(*p.Content); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4334,49 +5480,49 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *HTTPInfo) String() string {
+func (p *OpenAIChatMessageTemplate) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("HTTPInfo(%+v)", *p)
+	return fmt.Sprintf("OpenAIChatMessageTemplate(%+v)", *p)
 
 }
 
-func (p *HTTPInfo) DeepEqual(ano *HTTPInfo) bool {
+func (p *OpenAIChatMessageTemplate) DeepEqual(ano *OpenAIChatMessageTemplate) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Method) {
+	if !p.Field1DeepEqual(ano.Role) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Path) {
+	if !p.Field2DeepEqual(ano.Content) {
 		return false
 	}
 	return true
 }
 
-func (p *HTTPInfo) Field1DeepEqual(src *HTTPMethod) bool {
+func (p *OpenAIChatMessageTemplate) Field1DeepEqual(src *string) bool {
 
-	if p.Method == src {
+	if p.Role == src {
 		return true
-	} else if p.Method == nil || src == nil {
+	} else if p.Role == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Method, *src) != 0 {
+	if strings.Compare(*p.Role, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *HTTPInfo) Field2DeepEqual(src *string) bool {
+func (p *OpenAIChatMessageTemplate) Field2DeepEqual(src *string) bool {
 
-	if p.Path == src {
+	if p.Content == src {
 		return true
-	} else if p.Path == nil || src == nil {
+	} else if p.Content == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Path, *src) != 0 {
+	if strings.Compare(*p.Content, *src) != 0 {
 		return false
 	}
 	return true
@@ -9654,6 +10800,8 @@ type EvalTargetUsage struct {
 	InputTokens  int64 `thrift:"input_tokens,1" frugal:"1,default,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
 	OutputTokens int64 `thrift:"output_tokens,2" frugal:"2,default,i64" json:"output_tokens" form:"output_tokens" query:"output_tokens"`
 	TotalTokens  int64 `thrift:"total_tokens,3" frugal:"3,default,i64" json:"total_tokens" form:"total_tokens" query:"total_tokens"`
+	// 按模型价格表计算的费用
+	TotalCost *float64 `thrift:"total_cost,4,optional" frugal:"4,optional,double" form:"total_cost" json:"total_cost,omitempty" query:"total_cost"`
 }
 
 func NewEvalTargetUsage() *EvalTargetUsage {
@@ -9683,6 +10831,18 @@ func (p *EvalTargetUsage) GetTotalTokens() (v int64) {
 	}
 	return
 }
+
+var EvalTargetUsage_TotalCost_DEFAULT float64
+
+func (p *EvalTargetUsage) GetTotalCost() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTotalCost() {
+		return EvalTargetUsage_TotalCost_DEFAULT
+	}
+	return *p.TotalCost
+}
 func (p *EvalTargetUsage) SetInputTokens(val int64) {
 	p.InputTokens = val
 }
@@ -9692,11 +10852,19 @@ func (p *EvalTargetUsage) SetOutputTokens(val int64) {
 func (p *EvalTargetUsage) SetTotalTokens(val int64) {
 	p.TotalTokens = val
 }
+func (p *EvalTargetUsage) SetTotalCost(val *float64) {
+	p.TotalCost = val
+}
 
 var fieldIDToName_EvalTargetUsage = map[int16]string{
 	1: "input_tokens",
 	2: "output_tokens",
 	3: "total_tokens",
+	4: "total_cost",
+}
+
+func (p *EvalTargetUsage) IsSetTotalCost() bool {
+	return p.TotalCost != nil
 }
 
 func (p *EvalTargetUsage) Read(iprot thrift.TProtocol) (err error) {
@@ -9741,6 +10909,14 @@ func (p *EvalTargetUsage) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -9803,6 +10979,17 @@ func (p *EvalTargetUsage) ReadField3(iprot thrift.TProtocol) error {
 	p.TotalTokens = _field
 	return nil
 }
+func (p *EvalTargetUsage) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TotalCost = _field
+	return nil
+}
 
 func (p *EvalTargetUsage) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -9822,6 +11009,10 @@ func (p *EvalTargetUsage) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9888,6 +11079,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *EvalTargetUsage) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotalCost() {
+		if err = oprot.WriteFieldBegin("total_cost", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.TotalCost); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *EvalTargetUsage) String() string {
 	if p == nil {
@@ -9912,6 +11121,9 @@ func (p *EvalTargetUsage) DeepEqual(ano *EvalTargetUsage) bool {
 	if !p.Field3DeepEqual(ano.TotalTokens) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.TotalCost) {
+		return false
+	}
 	return true
 }
 
@@ -9936,6 +11148,18 @@ func (p *EvalTargetUsage) Field3DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *EvalTargetUsage) Field4DeepEqual(src *float64) bool {
+
+	if p.TotalCost == src {
+		return true
+	} else if p.TotalCost == nil || src == nil {
+		return false
+	}
+	if *p.TotalCost != *src {
+		return false
+	}
+	return true
+}
 
 type EvalTargetRunError struct {
 	Code    *int32  `thrift:"code,1,optional" frugal:"1,optional,i32" json:"code" form:"code" query:"code"`
@@ -73,6 +73,11 @@ func (p *EvalTargetContent) IsValid() error {
 			return fmt.Errorf("field CustomRPCServer not valid, %w", err)
 		}
 	}
+	if p.OpenaiChat != nil {
+		if err := p.OpenaiChat.IsValid(); err != nil {
+			return fmt.Errorf("field OpenaiChat not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *CustomRPCServer) IsValid() error {
@@ -104,6 +109,12 @@ func (p *CustomEvalTarget) IsValid() error {
 func (p *HTTPInfo) IsValid() error {
 	return nil
 }
+func (p *OpenAIChat) IsValid() error {
+	return nil
+}
+func (p *OpenAIChatMessageTemplate) IsValid() error {
+	return nil
+}
 func (p *VolcengineAgent) IsValid() error {
 	if p.BaseInfo != nil {
 		if err := p.BaseInfo.IsValid(); err != nil {
@@ -936,6 +936,20 @@ func (p *EvalTargetContent) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 106:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField106(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -1078,6 +1092,18 @@ func (p *EvalTargetContent) FastReadField105(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *EvalTargetContent) FastReadField106(buf []byte) (int, error) {
+	offset := 0
+	_field := NewOpenAIChat()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.OpenaiChat = _field
+	return offset, nil
+}
+
 func (p *EvalTargetContent) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -1093,6 +1119,7 @@ func (p *EvalTargetContent) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) i
 		offset += p.fastWriteField103(buf[offset:], w)
 		offset += p.fastWriteField104(buf[offset:], w)
 		offset += p.fastWriteField105(buf[offset:], w)
+		offset += p.fastWriteField106(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -1109,6 +1136,7 @@ func (p *EvalTargetContent) BLength() int {
 		l += p.field103Length()
 		l += p.field104Length()
 		l += p.field105Length()
+		l += p.field106Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -1200,6 +1228,15 @@ func (p *EvalTargetContent) fastWriteField105(buf []byte, w thrift.NocopyWriter)
 	return offset
 }
 
+func (p *EvalTargetContent) fastWriteField106(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOpenaiChat() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 106)
+		offset += p.OpenaiChat.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *EvalTargetContent) field1Length() int {
 	l := 0
 	if p.IsSetInputSchemas() {
@@ -1280,6 +1317,15 @@ func (p *EvalTargetContent) field105Length() int {
 	return l
 }
 
+func (p *EvalTargetContent) field106Length() int {
+	l := 0
+	if p.IsSetOpenaiChat() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.OpenaiChat.BLength()
+	}
+	return l
+}
+
 func (p *EvalTargetContent) DeepCopy(s interface{}) error {
 	src, ok := s.(*EvalTargetContent)
 	if !ok {
@@ -1369,6 +1415,15 @@ func (p *EvalTargetContent) DeepCopy(s interface{}) error {
 	}
 	p.CustomRPCServer = _customRPCServer
 
+	var _openaiChat *OpenAIChat
+	if src.OpenaiChat != nil {
+		_openaiChat = &OpenAIChat{}
+		if err := _openaiChat.DeepCopy(src.OpenaiChat); err != nil {
+			return err
+		}
+	}
+	p.OpenaiChat = _openaiChat
+
 	return nil
 }
 
@@ -1627,6 +1682,34 @@ func (p *CustomRPCServer) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 24:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField24(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 25:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField25(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 50:
 			if fieldTypeId == thrift.MAP {
 				l, err = p.FastReadField50(buf[offset:])
@@ -1899,6 +1982,52 @@ func (p *CustomRPCServer) FastReadField23(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *CustomRPCServer) FastReadField24(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Endpoint = _field
+	return offset, nil
+}
+
+func (p *CustomRPCServer) FastReadField25(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.Headers = _field
+	return offset, nil
+}
+
 func (p *CustomRPCServer) FastReadField50(buf []byte) (int, error) {
 	offset := 0
 
@@ -1955,6 +2084,8 @@ func (p *CustomRPCServer) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int
 		offset += p.fastWriteField18(buf[offset:], w)
 		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField21(buf[offset:], w)
+		offset += p.fastWriteField24(buf[offset:], w)
+		offset += p.fastWriteField25(buf[offset:], w)
 		offset += p.fastWriteField50(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
@@ -1981,6 +2112,8 @@ func (p *CustomRPCServer) BLength() int {
 		l += p.field21Length()
 		l += p.field22Length()
 		l += p.field23Length()
+		l += p.field24Length()
+		l += p.field25Length()
 		l += p.field50Length()
 	}
 	l += thrift.Binary.FieldStopLength()
@@ -2147,6 +2280,32 @@ func (p *CustomRPCServer) fastWriteField23(buf []byte, w thrift.NocopyWriter) in
 	return offset
 }
 
+func (p *CustomRPCServer) fastWriteField24(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEndpoint() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 24)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Endpoint)
+	}
+	return offset
+}
+
+func (p *CustomRPCServer) fastWriteField25(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetHeaders() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 25)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Headers {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	}
+	return offset
+}
+
 func (p *CustomRPCServer) fastWriteField50(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetExt() {
@@ -2321,6 +2480,30 @@ func (p *CustomRPCServer) field23Length() int {
 	return l
 }
 
+func (p *CustomRPCServer) field24Length() int {
+	l := 0
+	if p.IsSetEndpoint() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Endpoint)
+	}
+	return l
+}
+
+func (p *CustomRPCServer) field25Length() int {
+	l := 0
+	if p.IsSetHeaders() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Headers {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
 func (p *CustomRPCServer) field50Length() int {
 	l := 0
 	if p.IsSetExt() {
@@ -2462,6 +2645,31 @@ func (p *CustomRPCServer) DeepCopy(s interface{}) error {
 		p.AsyncTimeout = &tmp
 	}
 
+	if src.Endpoint != nil {
+		var tmp string
+		if *src.Endpoint != "" {
+			tmp = kutils.StringDeepCopy(*src.Endpoint)
+		}
+		p.Endpoint = &tmp
+	}
+
+	if src.Headers != nil {
+		p.Headers = make(map[string]string, len(src.Headers))
+		for key, val := range src.Headers {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val string
+			if val != "" {
+				_val = kutils.StringDeepCopy(val)
+			}
+
+			p.Headers[_key] = _val
+		}
+	}
+
 	if src.Ext != nil {
 		p.Ext = make(map[string]string, len(src.Ext))
 		for key, val := range src.Ext {
@@ -2587,7 +2795,523 @@ func (p *CustomEvalTarget) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *CustomEvalTarget) FastReadField2(buf []byte) (int, error) {
+func (p *CustomEvalTarget) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Name = _field
+	return offset, nil
+}
+
+func (p *CustomEvalTarget) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.AvatarURL = _field
+	return offset, nil
+}
+
+func (p *CustomEvalTarget) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.Ext = _field
+	return offset, nil
+}
+
+func (p *CustomEvalTarget) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CustomEvalTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CustomEvalTarget) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field10Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CustomEvalTarget) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ID)
+	}
+	return offset
+}
+
+func (p *CustomEvalTarget) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	}
+	return offset
+}
+
+func (p *CustomEvalTarget) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAvatarURL() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.AvatarURL)
+	}
+	return offset
+}
+
+func (p *CustomEvalTarget) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 10)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Ext {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *CustomEvalTarget) field1Length() int {
+	l := 0
+	if p.IsSetID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ID)
+	}
+	return l
+}
+
+func (p *CustomEvalTarget) field2Length() int {
+	l := 0
+	if p.IsSetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
+	}
+	return l
+}
+
+func (p *CustomEvalTarget) field3Length() int {
+	l := 0
+	if p.IsSetAvatarURL() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.AvatarURL)
+	}
+	return l
+}
+
+func (p *CustomEvalTarget) field10Length() int {
+	l := 0
+	if p.IsSetExt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Ext {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *CustomEvalTarget) DeepCopy(s interface{}) error {
+	src, ok := s.(*CustomEvalTarget)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ID != nil {
+		var tmp string
+		if *src.ID != "" {
+			tmp = kutils.StringDeepCopy(*src.ID)
+		}
+		p.ID = &tmp
+	}
+
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
+
+	if src.AvatarURL != nil {
+		var tmp string
+		if *src.AvatarURL != "" {
+			tmp = kutils.StringDeepCopy(*src.AvatarURL)
+		}
+		p.AvatarURL = &tmp
+	}
+
+	if src.Ext != nil {
+		p.Ext = make(map[string]string, len(src.Ext))
+		for key, val := range src.Ext {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val string
+			if val != "" {
+				_val = kutils.StringDeepCopy(val)
+			}
+
+			p.Ext[_key] = _val
+		}
+	}
+
+	return nil
+}
+
+func (p *HTTPInfo) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPInfo[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *HTTPInfo) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *HTTPMethod
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Method = _field
+	return offset, nil
+}
+
+func (p *HTTPInfo) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Path = _field
+	return offset, nil
+}
+
+func (p *HTTPInfo) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *HTTPInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *HTTPInfo) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *HTTPInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMethod() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Method)
+	}
+	return offset
+}
+
+func (p *HTTPInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPath() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Path)
+	}
+	return offset
+}
+
+func (p *HTTPInfo) field1Length() int {
+	l := 0
+	if p.IsSetMethod() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Method)
+	}
+	return l
+}
+
+func (p *HTTPInfo) field2Length() int {
+	l := 0
+	if p.IsSetPath() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Path)
+	}
+	return l
+}
+
+func (p *HTTPInfo) DeepCopy(s interface{}) error {
+	src, ok := s.(*HTTPInfo)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Method != nil {
+		tmp := *src.Method
+		p.Method = &tmp
+	}
+
+	if src.Path != nil {
+		var tmp string
+		if *src.Path != "" {
+			tmp = kutils.StringDeepCopy(*src.Path)
+		}
+		p.Path = &tmp
+	}
+
+	return nil
+}
+
+func (p *OpenAIChat) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OpenAIChat[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *OpenAIChat) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -2597,11 +3321,11 @@ func (p *CustomEvalTarget) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Name = _field
+	p.BaseURL = _field
 	return offset, nil
 }
 
-func (p *CustomEvalTarget) FastReadField3(buf []byte) (int, error) {
+func (p *OpenAIChat) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -2611,11 +3335,11 @@ func (p *CustomEvalTarget) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.AvatarURL = _field
+	p.Model = _field
 	return offset, nil
 }
 
-func (p *CustomEvalTarget) FastReadField10(buf []byte) (int, error) {
+func (p *OpenAIChat) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
@@ -2643,73 +3367,121 @@ func (p *CustomEvalTarget) FastReadField10(buf []byte) (int, error) {
 
 		_field[_key] = _val
 	}
-	p.Ext = _field
+	p.Headers = _field
 	return offset, nil
 }
 
-func (p *CustomEvalTarget) FastWrite(buf []byte) int {
+func (p *OpenAIChat) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*OpenAIChatMessageTemplate, 0, size)
+	values := make([]OpenAIChatMessageTemplate, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.MessageTemplates = _field
+	return offset, nil
+}
+
+func (p *OpenAIChat) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Stream = _field
+	return offset, nil
+}
+
+func (p *OpenAIChat) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Timeout = _field
+	return offset, nil
+}
+
+func (p *OpenAIChat) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CustomEvalTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OpenAIChat) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *CustomEvalTarget) BLength() int {
+func (p *OpenAIChat) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field10Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *CustomEvalTarget) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OpenAIChat) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetID() {
+	if p.IsSetBaseURL() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ID)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.BaseURL)
 	}
 	return offset
 }
 
-func (p *CustomEvalTarget) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *OpenAIChat) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
+	if p.IsSetModel() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
-	}
-	return offset
-}
-
-func (p *CustomEvalTarget) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetAvatarURL() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.AvatarURL)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Model)
 	}
 	return offset
 }
 
-func (p *CustomEvalTarget) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+func (p *OpenAIChat) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 10)
+	if p.IsSetHeaders() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 3)
 		mapBeginOffset := offset
 		offset += thrift.Binary.MapBeginLength()
 		var length int
-		for k, v := range p.Ext {
+		for k, v := range p.Headers {
 			length++
 			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
 			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
@@ -2719,39 +3491,64 @@ func (p *CustomEvalTarget) fastWriteField10(buf []byte, w thrift.NocopyWriter) i
 	return offset
 }
 
-func (p *CustomEvalTarget) field1Length() int {
-	l := 0
-	if p.IsSetID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ID)
+func (p *OpenAIChat) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMessageTemplates() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.MessageTemplates {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
-	return l
+	return offset
 }
 
-func (p *CustomEvalTarget) field2Length() int {
+func (p *OpenAIChat) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetStream() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 5)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Stream)
+	}
+	return offset
+}
+
+func (p *OpenAIChat) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTimeout() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Timeout)
+	}
+	return offset
+}
+
+func (p *OpenAIChat) field1Length() int {
 	l := 0
-	if p.IsSetName() {
+	if p.IsSetBaseURL() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
+		l += thrift.Binary.StringLengthNocopy(*p.BaseURL)
 	}
 	return l
 }
 
-func (p *CustomEvalTarget) field3Length() int {
+func (p *OpenAIChat) field2Length() int {
 	l := 0
-	if p.IsSetAvatarURL() {
+	if p.IsSetModel() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.AvatarURL)
+		l += thrift.Binary.StringLengthNocopy(*p.Model)
 	}
 	return l
 }
 
-func (p *CustomEvalTarget) field10Length() int {
+func (p *OpenAIChat) field3Length() int {
 	l := 0
-	if p.IsSetExt() {
+	if p.IsSetHeaders() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.Ext {
+		for k, v := range p.Headers {
 			_, _ = k, v
 
 			l += thrift.Binary.StringLengthNocopy(k)
@@ -2761,39 +3558,62 @@ func (p *CustomEvalTarget) field10Length() int {
 	return l
 }
 
-func (p *CustomEvalTarget) DeepCopy(s interface{}) error {
-	src, ok := s.(*CustomEvalTarget)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+func (p *OpenAIChat) field4Length() int {
+	l := 0
+	if p.IsSetMessageTemplates() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.MessageTemplates {
+			_ = v
+			l += v.BLength()
+		}
 	}
+	return l
+}
 
-	if src.ID != nil {
-		var tmp string
-		if *src.ID != "" {
-			tmp = kutils.StringDeepCopy(*src.ID)
-		}
-		p.ID = &tmp
+func (p *OpenAIChat) field5Length() int {
+	l := 0
+	if p.IsSetStream() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *OpenAIChat) field6Length() int {
+	l := 0
+	if p.IsSetTimeout() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *OpenAIChat) DeepCopy(s interface{}) error {
+	src, ok := s.(*OpenAIChat)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Name != nil {
+	if src.BaseURL != nil {
 		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
+		if *src.BaseURL != "" {
+			tmp = kutils.StringDeepCopy(*src.BaseURL)
 		}
-		p.Name = &tmp
+		p.BaseURL = &tmp
 	}
 
-	if src.AvatarURL != nil {
+	if src.Model != nil {
 		var tmp string
-		if *src.AvatarURL != "" {
-			tmp = kutils.StringDeepCopy(*src.AvatarURL)
+		if *src.Model != "" {
+			tmp = kutils.StringDeepCopy(*src.Model)
 		}
-		p.AvatarURL = &tmp
+		p.Model = &tmp
 	}
 
-	if src.Ext != nil {
-		p.Ext = make(map[string]string, len(src.Ext))
-		for key, val := range src.Ext {
+	if src.Headers != nil {
+		p.Headers = make(map[string]string, len(src.Headers))
+		for key, val := range src.Headers {
 			var _key string
 			if key != "" {
 				_key = kutils.StringDeepCopy(key)
@@ -2804,14 +3624,39 @@ func (p *CustomEvalTarget) DeepCopy(s interface{}) error {
 				_val = kutils.StringDeepCopy(val)
 			}
 
-			p.Ext[_key] = _val
+			p.Headers[_key] = _val
+		}
+	}
+
+	if src.MessageTemplates != nil {
+		p.MessageTemplates = make([]*OpenAIChatMessageTemplate, 0, len(src.MessageTemplates))
+		for _, elem := range src.MessageTemplates {
+			var _elem *OpenAIChatMessageTemplate
+			if elem != nil {
+				_elem = &OpenAIChatMessageTemplate{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.MessageTemplates = append(p.MessageTemplates, _elem)
 		}
 	}
 
+	if src.Stream != nil {
+		tmp := *src.Stream
+		p.Stream = &tmp
+	}
+
+	if src.Timeout != nil {
+		tmp := *src.Timeout
+		p.Timeout = &tmp
+	}
+
 	return nil
 }
 
-func (p *HTTPInfo) FastRead(buf []byte) (int, error) {
+func (p *OpenAIChatMessageTemplate) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2869,26 +3714,26 @@ func (p *HTTPInfo) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPInfo[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OpenAIChatMessageTemplate[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *HTTPInfo) FastReadField1(buf []byte) (int, error) {
+func (p *OpenAIChatMessageTemplate) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *HTTPMethod
+	var _field *string
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Method = _field
+	p.Role = _field
 	return offset, nil
 }
 
-func (p *HTTPInfo) FastReadField2(buf []byte) (int, error) {
+func (p *OpenAIChatMessageTemplate) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -2898,15 +3743,15 @@ func (p *HTTPInfo) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Path = _field
+	p.Content = _field
 	return offset, nil
 }
 
-func (p *HTTPInfo) FastWrite(buf []byte) int {
+func (p *OpenAIChatMessageTemplate) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *HTTPInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *OpenAIChatMessageTemplate) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -2916,7 +3761,7 @@ func (p *HTTPInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
-func (p *HTTPInfo) BLength() int {
+func (p *OpenAIChatMessageTemplate) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2926,59 +3771,62 @@ func (p *HTTPInfo) BLength() int {
 	return l
 }
 
-func (p *HTTPInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *OpenAIChatMessageTemplate) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetMethod() {
+	if p.IsSetRole() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Method)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Role)
 	}
 	return offset
 }
 
-func (p *HTTPInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *OpenAIChatMessageTemplate) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPath() {
+	if p.IsSetContent() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Path)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Content)
 	}
 	return offset
 }
 
-func (p *HTTPInfo) field1Length() int {
+func (p *OpenAIChatMessageTemplate) field1Length() int {
 	l := 0
-	if p.IsSetMethod() {
+	if p.IsSetRole() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Method)
+		l += thrift.Binary.StringLengthNocopy(*p.Role)
 	}
 	return l
 }
 
-func (p *HTTPInfo) field2Length() int {
+func (p *OpenAIChatMessageTemplate) field2Length() int {
 	l := 0
-	if p.IsSetPath() {
+	if p.IsSetContent() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Path)
+		l += thrift.Binary.StringLengthNocopy(*p.Content)
 	}
 	return l
 }
 
-func (p *HTTPInfo) DeepCopy(s interface{}) error {
-	src, ok := s.(*HTTPInfo)
+func (p *OpenAIChatMessageTemplate) DeepCopy(s interface{}) error {
+	src, ok := s.(*OpenAIChatMessageTemplate)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Method != nil {
-		tmp := *src.Method
-		p.Method = &tmp
+	if src.Role != nil {
+		var tmp string
+		if *src.Role != "" {
+			tmp = kutils.StringDeepCopy(*src.Role)
+		}
+		p.Role = &tmp
 	}
 
-	if src.Path != nil {
+	if src.Content != nil {
 		var tmp string
-		if *src.Path != "" {
-			tmp = kutils.StringDeepCopy(*src.Path)
+		if *src.Content != "" {
+			tmp = kutils.StringDeepCopy(*src.Content)
 		}
-		p.Path = &tmp
+		p.Content = &tmp
 	}
 
 	return nil
@@ -6849,6 +7697,20 @@ func (p *EvalTargetUsage) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -6909,6 +7771,20 @@ func (p *EvalTargetUsage) FastReadField3(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *EvalTargetUsage) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TotalCost = _field
+	return offset, nil
+}
+
 func (p *EvalTargetUsage) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -6919,6 +7795,7 @@ func (p *EvalTargetUsage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -6930,6 +7807,7 @@ func (p *EvalTargetUsage) BLength() int {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -6956,6 +7834,15 @@ func (p *EvalTargetUsage) fastWriteField3(buf []byte, w thrift.NocopyWriter) int
 	return offset
 }
 
+func (p *EvalTargetUsage) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotalCost() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.TotalCost)
+	}
+	return offset
+}
+
 func (p *EvalTargetUsage) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -6977,6 +7864,15 @@ func (p *EvalTargetUsage) field3Length() int {
 	return l
 }
 
+func (p *EvalTargetUsage) field4Length() int {
+	l := 0
+	if p.IsSetTotalCost() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
 func (p *EvalTargetUsage) DeepCopy(s interface{}) error {
 	src, ok := s.(*EvalTargetUsage)
 	if !ok {
@@ -6989,6 +7885,11 @@ func (p *EvalTargetUsage) DeepCopy(s interface{}) error {
 
 	p.TotalTokens = src.TotalTokens
 
+	if src.TotalCost != nil {
+		tmp := *src.TotalCost
+		p.TotalCost = &tmp
+	}
+
 	return nil
 }
 
@@ -46,6 +46,8 @@ const (
 
 	AccessProtocolFaasHTTPOld = "faas_http_old"
 
+	EvaluatorAccessProtocolHTTP = "http"
+
 	EvaluatorVersionTypeLatest = "Latest"
 
 	EvaluatorVersionTypeBuiltinVisible = "BuiltinVisible"
@@ -2000,8 +2002,16 @@ type CustomRPCEvaluator struct {
 	Cluster        *string                 `thrift:"cluster,4,optional" frugal:"4,optional,string" form:"cluster" json:"cluster,omitempty" query:"cluster"`
 	// 执行http信息
 	InvokeHTTPInfo *EvaluatorHTTPInfo `thrift:"invoke_http_info,5,optional" frugal:"5,optional,EvaluatorHTTPInfo" form:"invoke_http_info" json:"invoke_http_info,omitempty" query:"invoke_http_info"`
+	// access_protocol为http时的服务地址，例如https://example.com/evaluator
+	Endpoint *string `thrift:"endpoint,6,optional" frugal:"6,optional,string" form:"endpoint" json:"endpoint,omitempty" query:"endpoint"`
+	// 请求头，用于鉴权，例如Authorization
+	Headers map[string]string `thrift:"headers,7,optional" frugal:"7,optional,map<string:string>" form:"headers" json:"headers,omitempty" query:"headers"`
+	// 健康检查http信息，配置后校验评估器时会探测该接口
+	HealthCheckHTTPInfo *EvaluatorHTTPInfo `thrift:"health_check_http_info,8,optional" frugal:"8,optional,EvaluatorHTTPInfo" form:"health_check_http_info" json:"health_check_http_info,omitempty" query:"health_check_http_info"`
 	// ms
 	Timeout *int64 `thrift:"timeout,10,optional" frugal:"10,optional,i64" form:"timeout" json:"timeout,omitempty" query:"timeout"`
+	// 调用失败时的最大重试次数
+	MaxRetries *int32 `thrift:"max_retries,13,optional" frugal:"13,optional,i32" form:"max_retries" json:"max_retries,omitempty" query:"max_retries"`
 	// 自定义评估器的限流配置
 	RateLimit *common.RateLimit `thrift:"rate_limit,11,optional" frugal:"11,optional,common.RateLimit" form:"rate_limit" json:"rate_limit,omitempty" query:"rate_limit"`
 	// extra fields
@@ -2070,6 +2080,42 @@ func (p *CustomRPCEvaluator) GetInvokeHTTPInfo() (v *EvaluatorHTTPInfo) {
 	return p.InvokeHTTPInfo
 }
 
+var CustomRPCEvaluator_Endpoint_DEFAULT string
+
+func (p *CustomRPCEvaluator) GetEndpoint() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEndpoint() {
+		return CustomRPCEvaluator_Endpoint_DEFAULT
+	}
+	return *p.Endpoint
+}
+
+var CustomRPCEvaluator_Headers_DEFAULT map[string]string
+
+func (p *CustomRPCEvaluator) GetHeaders() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHeaders() {
+		return CustomRPCEvaluator_Headers_DEFAULT
+	}
+	return p.Headers
+}
+
+var CustomRPCEvaluator_HealthCheckHTTPInfo_DEFAULT *EvaluatorHTTPInfo
+
+func (p *CustomRPCEvaluator) GetHealthCheckHTTPInfo() (v *EvaluatorHTTPInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHealthCheckHTTPInfo() {
+		return CustomRPCEvaluator_HealthCheckHTTPInfo_DEFAULT
+	}
+	return p.HealthCheckHTTPInfo
+}
+
 var CustomRPCEvaluator_Timeout_DEFAULT int64
 
 func (p *CustomRPCEvaluator) GetTimeout() (v int64) {
@@ -2082,6 +2128,18 @@ func (p *CustomRPCEvaluator) GetTimeout() (v int64) {
 	return *p.Timeout
 }
 
+var CustomRPCEvaluator_MaxRetries_DEFAULT int32
+
+func (p *CustomRPCEvaluator) GetMaxRetries() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMaxRetries() {
+		return CustomRPCEvaluator_MaxRetries_DEFAULT
+	}
+	return *p.MaxRetries
+}
+
 var CustomRPCEvaluator_RateLimit_DEFAULT *common.RateLimit
 
 func (p *CustomRPCEvaluator) GetRateLimit() (v *common.RateLimit) {
@@ -2120,9 +2178,21 @@ func (p *CustomRPCEvaluator) SetCluster(val *string) {
 func (p *CustomRPCEvaluator) SetInvokeHTTPInfo(val *EvaluatorHTTPInfo) {
 	p.InvokeHTTPInfo = val
 }
+func (p *CustomRPCEvaluator) SetEndpoint(val *string) {
+	p.Endpoint = val
+}
+func (p *CustomRPCEvaluator) SetHeaders(val map[string]string) {
+	p.Headers = val
+}
+func (p *CustomRPCEvaluator) SetHealthCheckHTTPInfo(val *EvaluatorHTTPInfo) {
+	p.HealthCheckHTTPInfo = val
+}
 func (p *CustomRPCEvaluator) SetTimeout(val *int64) {
 	p.Timeout = val
 }
+func (p *CustomRPCEvaluator) SetMaxRetries(val *int32) {
+	p.MaxRetries = val
+}
 func (p *CustomRPCEvaluator) SetRateLimit(val *common.RateLimit) {
 	p.RateLimit = val
 }
@@ -2136,7 +2206,11 @@ var fieldIDToName_CustomRPCEvaluator = map[int16]string{
 	3:  "service_name",
 	4:  "cluster",
 	5:  "invoke_http_info",
+	6:  "endpoint",
+	7:  "headers",
+	8:  "health_check_http_info",
 	10: "timeout",
+	13: "max_retries",
 	11: "rate_limit",
 	12: "ext",
 }
@@ -2157,10 +2231,26 @@ func (p *CustomRPCEvaluator) IsSetInvokeHTTPInfo() bool {
 	return p.InvokeHTTPInfo != nil
 }
 
+func (p *CustomRPCEvaluator) IsSetEndpoint() bool {
+	return p.Endpoint != nil
+}
+
+func (p *CustomRPCEvaluator) IsSetHeaders() bool {
+	return p.Headers != nil
+}
+
+func (p *CustomRPCEvaluator) IsSetHealthCheckHTTPInfo() bool {
+	return p.HealthCheckHTTPInfo != nil
+}
+
 func (p *CustomRPCEvaluator) IsSetTimeout() bool {
 	return p.Timeout != nil
 }
 
+func (p *CustomRPCEvaluator) IsSetMaxRetries() bool {
+	return p.MaxRetries != nil
+}
+
 func (p *CustomRPCEvaluator) IsSetRateLimit() bool {
 	return p.RateLimit != nil
 }
@@ -2229,6 +2319,30 @@ func (p *CustomRPCEvaluator) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 10:
 			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField10(iprot); err != nil {
@@ -2237,6 +2351,14 @@ func (p *CustomRPCEvaluator) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 13:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 11:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField11(iprot); err != nil {
@@ -2340,6 +2462,54 @@ func (p *CustomRPCEvaluator) ReadField5(iprot thrift.TProtocol) error {
 	p.InvokeHTTPInfo = _field
 	return nil
 }
+func (p *CustomRPCEvaluator) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Endpoint = _field
+	return nil
+}
+func (p *CustomRPCEvaluator) ReadField7(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Headers = _field
+	return nil
+}
+func (p *CustomRPCEvaluator) ReadField8(iprot thrift.TProtocol) error {
+	_field := NewEvaluatorHTTPInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.HealthCheckHTTPInfo = _field
+	return nil
+}
 func (p *CustomRPCEvaluator) ReadField10(iprot thrift.TProtocol) error {
 
 	var _field *int64
@@ -2351,6 +2521,17 @@ func (p *CustomRPCEvaluator) ReadField10(iprot thrift.TProtocol) error {
 	p.Timeout = _field
 	return nil
 }
+func (p *CustomRPCEvaluator) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxRetries = _field
+	return nil
+}
 func (p *CustomRPCEvaluator) ReadField11(iprot thrift.TProtocol) error {
 	_field := common.NewRateLimit()
 	if err := _field.Read(iprot); err != nil {
@@ -2415,10 +2596,26 @@ func (p *CustomRPCEvaluator) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 		if err = p.writeField10(oprot); err != nil {
 			fieldId = 10
 			goto WriteFieldError
 		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
 		if err = p.writeField11(oprot); err != nil {
 			fieldId = 11
 			goto WriteFieldError
@@ -2533,6 +2730,71 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
+func (p *CustomRPCEvaluator) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndpoint() {
+		if err = oprot.WriteFieldBegin("endpoint", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Endpoint); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *CustomRPCEvaluator) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeaders() {
+		if err = oprot.WriteFieldBegin("headers", thrift.MAP, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Headers)); err != nil {
+			return err
+		}
+		for k, v := range p.Headers {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *CustomRPCEvaluator) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHealthCheckHTTPInfo() {
+		if err = oprot.WriteFieldBegin("health_check_http_info", thrift.STRUCT, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.HealthCheckHTTPInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
 func (p *CustomRPCEvaluator) writeField10(oprot thrift.TProtocol) (err error) {
 	if p.IsSetTimeout() {
 		if err = oprot.WriteFieldBegin("timeout", thrift.I64, 10); err != nil {
@@ -2551,6 +2813,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
+func (p *CustomRPCEvaluator) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxRetries() {
+		if err = oprot.WriteFieldBegin("max_retries", thrift.I32, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxRetries); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
 func (p *CustomRPCEvaluator) writeField11(oprot thrift.TProtocol) (err error) {
 	if p.IsSetRateLimit() {
 		if err = oprot.WriteFieldBegin("rate_limit", thrift.STRUCT, 11); err != nil {
@@ -2628,9 +2908,21 @@ func (p *CustomRPCEvaluator) DeepEqual(ano *CustomRPCEvaluator) bool {
 	if !p.Field5DeepEqual(ano.InvokeHTTPInfo) {
 		return false
 	}
+	if !p.Field6DeepEqual(ano.Endpoint) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Headers) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.HealthCheckHTTPInfo) {
+		return false
+	}
 	if !p.Field10DeepEqual(ano.Timeout) {
 		return false
 	}
+	if !p.Field13DeepEqual(ano.MaxRetries) {
+		return false
+	}
 	if !p.Field11DeepEqual(ano.RateLimit) {
 		return false
 	}
@@ -2690,6 +2982,38 @@ func (p *CustomRPCEvaluator) Field5DeepEqual(src *EvaluatorHTTPInfo) bool {
 	}
 	return true
 }
+func (p *CustomRPCEvaluator) Field6DeepEqual(src *string) bool {
+
+	if p.Endpoint == src {
+		return true
+	} else if p.Endpoint == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Endpoint, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *CustomRPCEvaluator) Field7DeepEqual(src map[string]string) bool {
+
+	if len(p.Headers) != len(src) {
+		return false
+	}
+	for k, v := range p.Headers {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *CustomRPCEvaluator) Field8DeepEqual(src *EvaluatorHTTPInfo) bool {
+
+	if !p.HealthCheckHTTPInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *CustomRPCEvaluator) Field10DeepEqual(src *int64) bool {
 
 	if p.Timeout == src {
@@ -2702,6 +3026,18 @@ func (p *CustomRPCEvaluator) Field10DeepEqual(src *int64) bool {
 	}
 	return true
 }
+func (p *CustomRPCEvaluator) Field13DeepEqual(src *int32) bool {
+
+	if p.MaxRetries == src {
+		return true
+	} else if p.MaxRetries == nil || src == nil {
+		return false
+	}
+	if *p.MaxRetries != *src {
+		return false
+	}
+	return true
+}
 func (p *CustomRPCEvaluator) Field11DeepEqual(src *common.RateLimit) bool {
 
 	if !p.RateLimit.DeepEqual(src) {
@@ -9732,6 +10068,8 @@ type EvaluatorResult_ struct {
 	Score      *float64    `thrift:"score,1,optional" frugal:"1,optional,double" form:"score" json:"score,omitempty" query:"score"`
 	Correction *Correction `thrift:"correction,2,optional" frugal:"2,optional,Correction" form:"correction" json:"correction,omitempty" query:"correction"`
 	Reasoning  *string     `thrift:"reasoning,3,optional" frugal:"3,optional,string" form:"reasoning" json:"reasoning,omitempty" query:"reasoning"`
+	// 多维度评估结果
+	Dimensions []*EvaluatorDimensionResult_ `thrift:"dimensions,4,optional" frugal:"4,optional,list<EvaluatorDimensionResult_>" form:"dimensions" json:"dimensions,omitempty" query:"dimensions"`
 }
 
 func NewEvaluatorResult_() *EvaluatorResult_ {
@@ -9776,6 +10114,18 @@ func (p *EvaluatorResult_) GetReasoning() (v string) {
 	}
 	return *p.Reasoning
 }
+
+var EvaluatorResult__Dimensions_DEFAULT []*EvaluatorDimensionResult_
+
+func (p *EvaluatorResult_) GetDimensions() (v []*EvaluatorDimensionResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDimensions() {
+		return EvaluatorResult__Dimensions_DEFAULT
+	}
+	return p.Dimensions
+}
 func (p *EvaluatorResult_) SetScore(val *float64) {
 	p.Score = val
 }
@@ -9785,11 +10135,15 @@ func (p *EvaluatorResult_) SetCorrection(val *Correction) {
 func (p *EvaluatorResult_) SetReasoning(val *string) {
 	p.Reasoning = val
 }
+func (p *EvaluatorResult_) SetDimensions(val []*EvaluatorDimensionResult_) {
+	p.Dimensions = val
+}
 
 var fieldIDToName_EvaluatorResult_ = map[int16]string{
 	1: "score",
 	2: "correction",
 	3: "reasoning",
+	4: "dimensions",
 }
 
 func (p *EvaluatorResult_) IsSetScore() bool {
@@ -9804,6 +10158,10 @@ func (p *EvaluatorResult_) IsSetReasoning() bool {
 	return p.Reasoning != nil
 }
 
+func (p *EvaluatorResult_) IsSetDimensions() bool {
+	return p.Dimensions != nil
+}
+
 func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9846,13 +10204,21 @@ func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
 		}
 	}
 	if err = iprot.ReadStructEnd(); err != nil {
@@ -9905,6 +10271,29 @@ func (p *EvaluatorResult_) ReadField3(iprot thrift.TProtocol) error {
 	p.Reasoning = _field
 	return nil
 }
+func (p *EvaluatorResult_) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluatorDimensionResult_, 0, size)
+	values := make([]EvaluatorDimensionResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Dimensions = _field
+	return nil
+}
 
 func (p *EvaluatorResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -9924,6 +10313,10 @@ func (p *EvaluatorResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9996,6 +10389,32 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *EvaluatorResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDimensions() {
+		if err = oprot.WriteFieldBegin("dimensions", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Dimensions)); err != nil {
+			return err
+		}
+		for _, v := range p.Dimensions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *EvaluatorResult_) String() string {
 	if p == nil {
@@ -10020,6 +10439,9 @@ func (p *EvaluatorResult_) DeepEqual(ano *EvaluatorResult_) bool {
 	if !p.Field3DeepEqual(ano.Reasoning) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Dimensions) {
+		return false
+	}
 	return true
 }
 
@@ -10054,6 +10476,432 @@ func (p *EvaluatorResult_) Field3DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *EvaluatorResult_) Field4DeepEqual(src []*EvaluatorDimensionResult_) bool {
+
+	if len(p.Dimensions) != len(src) {
+		return false
+	}
+	for i, v := range p.Dimensions {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 评估器单个维度的评估结果
+type EvaluatorDimensionResult_ struct {
+	Name      *string  `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Score     *float64 `thrift:"score,2,optional" frugal:"2,optional,double" form:"score" json:"score,omitempty" query:"score"`
+	Label     *string  `thrift:"label,3,optional" frugal:"3,optional,string" form:"label" json:"label,omitempty" query:"label"`
+	Reasoning *string  `thrift:"reasoning,4,optional" frugal:"4,optional,string" form:"reasoning" json:"reasoning,omitempty" query:"reasoning"`
+}
+
+func NewEvaluatorDimensionResult_() *EvaluatorDimensionResult_ {
+	return &EvaluatorDimensionResult_{}
+}
+
+func (p *EvaluatorDimensionResult_) InitDefault() {
+}
+
+var EvaluatorDimensionResult__Name_DEFAULT string
+
+func (p *EvaluatorDimensionResult_) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return EvaluatorDimensionResult__Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var EvaluatorDimensionResult__Score_DEFAULT float64
+
+func (p *EvaluatorDimensionResult_) GetScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScore() {
+		return EvaluatorDimensionResult__Score_DEFAULT
+	}
+	return *p.Score
+}
+
+var EvaluatorDimensionResult__Label_DEFAULT string
+
+func (p *EvaluatorDimensionResult_) GetLabel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabel() {
+		return EvaluatorDimensionResult__Label_DEFAULT
+	}
+	return *p.Label
+}
+
+var EvaluatorDimensionResult__Reasoning_DEFAULT string
+
+func (p *EvaluatorDimensionResult_) GetReasoning() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReasoning() {
+		return EvaluatorDimensionResult__Reasoning_DEFAULT
+	}
+	return *p.Reasoning
+}
+func (p *EvaluatorDimensionResult_) SetName(val *string) {
+	p.Name = val
+}
+func (p *EvaluatorDimensionResult_) SetScore(val *float64) {
+	p.Score = val
+}
+func (p *EvaluatorDimensionResult_) SetLabel(val *string) {
+	p.Label = val
+}
+func (p *EvaluatorDimensionResult_) SetReasoning(val *string) {
+	p.Reasoning = val
+}
+
+var fieldIDToName_EvaluatorDimensionResult_ = map[int16]string{
+	1: "name",
+	2: "score",
+	3: "label",
+	4: "reasoning",
+}
+
+func (p *EvaluatorDimensionResult_) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *EvaluatorDimensionResult_) IsSetScore() bool {
+	return p.Score != nil
+}
+
+func (p *EvaluatorDimensionResult_) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *EvaluatorDimensionResult_) IsSetReasoning() bool {
+	return p.Reasoning != nil
+}
+
+func (p *EvaluatorDimensionResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorDimensionResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorDimensionResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *EvaluatorDimensionResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Score = _field
+	return nil
+}
+func (p *EvaluatorDimensionResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Label = _field
+	return nil
+}
+func (p *EvaluatorDimensionResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Reasoning = _field
+	return nil
+}
+
+func (p *EvaluatorDimensionResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorDimensionResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorDimensionResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorDimensionResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScore() {
+		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Score); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorDimensionResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Label); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorDimensionResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReasoning() {
+		if err = oprot.WriteFieldBegin("reasoning", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Reasoning); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *EvaluatorDimensionResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorDimensionResult_(%+v)", *p)
+
+}
+
+func (p *EvaluatorDimensionResult_) DeepEqual(ano *EvaluatorDimensionResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Score) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Label) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Reasoning) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorDimensionResult_) Field1DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorDimensionResult_) Field2DeepEqual(src *float64) bool {
+
+	if p.Score == src {
+		return true
+	} else if p.Score == nil || src == nil {
+		return false
+	}
+	if *p.Score != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorDimensionResult_) Field3DeepEqual(src *string) bool {
+
+	if p.Label == src {
+		return true
+	} else if p.Label == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Label, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorDimensionResult_) Field4DeepEqual(src *string) bool {
+
+	if p.Reasoning == src {
+		return true
+	} else if p.Reasoning == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Reasoning, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type EvaluatorUsage struct {
 	InputTokens  *int64 `thrift:"input_tokens,1,optional" frugal:"1,optional,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
@@ -49,6 +49,11 @@ func (p *CustomRPCEvaluator) IsValid() error {
 			return fmt.Errorf("field InvokeHTTPInfo not valid, %w", err)
 		}
 	}
+	if p.HealthCheckHTTPInfo != nil {
+		if err := p.HealthCheckHTTPInfo.IsValid(); err != nil {
+			return fmt.Errorf("field HealthCheckHTTPInfo not valid, %w", err)
+		}
+	}
 	if p.RateLimit != nil {
 		if err := p.RateLimit.IsValid(); err != nil {
 			return fmt.Errorf("field RateLimit not valid, %w", err)
@@ -195,6 +200,9 @@ func (p *EvaluatorResult_) IsValid() error {
 	}
 	return nil
 }
+func (p *EvaluatorDimensionResult_) IsValid() error {
+	return nil
+}
 func (p *EvaluatorUsage) IsValid() error {
 	return nil
 }
@@ -17,6 +17,10 @@ import (
 )
 
 const (
+	ExptScheduleTriggerTypeCron = "cron"
+
+	ExptScheduleTriggerTypeTargetChange = "target_change"
+
 	PromptUserQueryFieldKey = "builtin_prompt_user_query"
 
 	ColumnEvalTargetNameActualOutput = "actual_output"
@@ -31,6 +35,42 @@ const (
 
 	ColumnEvalTargetNameEvaluatorTotalTokens = "eval_target_total_tokens"
 
+	SignificanceTestTypeBootstrap = "Bootstrap"
+
+	SignificanceTestTypePairedTTest = "PairedTTest"
+
+	SignificanceTestTypeWilcoxon = "Wilcoxon"
+
+	SignificanceTestTypeMcNemar = "McNemar"
+
+	ComparisonVerdictNoSignificantChange = "NoSignificantChange"
+
+	ComparisonVerdictImprovement = "Improvement"
+
+	ComparisonVerdictRegression = "Regression"
+
+	ComparisonVerdictInsufficientData = "InsufficientData"
+
+	ExptGateRuleTypeEvaluatorScore = "EvaluatorScore"
+
+	ExptGateRuleTypeFailedTurnRate = "FailedTurnRate"
+
+	ExptGateRuleTypeNoRegression = "NoRegression"
+
+	ExptGateOperatorGTE = "gte"
+
+	ExptGateOperatorGT = "gt"
+
+	ExptGateOperatorLTE = "lte"
+
+	ExptGateOperatorLT = "lt"
+
+	ExptGateStatusPass = "Pass"
+
+	ExptGateStatusFail = "Fail"
+
+	ExptGateStatusError = "Error"
+
 	ExptResultExportTypeCSV = "CSV"
 
 	CSVExportStatusUnknown = "Unknown"
@@ -948,6 +988,23 @@ func (p *DataType) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type ExptScheduleTriggerType = string
+
+// 显著性检验方法
+type SignificanceTestType = string
+
+// 实验对比结论
+type ComparisonVerdict = string
+
+// 门禁规则类型
+type ExptGateRuleType = string
+
+// 门禁比较符
+type ExptGateOperator = string
+
+// 门禁结论
+type ExptGateStatus = string
+
 type ExptResultExportType = string
 
 type CSVExportStatus = string
@@ -995,6 +1052,9 @@ type Experiment struct {
 	// 评估器得分加权配置
 	ScoreWeightConfig   *ExptScoreWeight `thrift:"score_weight_config,61,optional" frugal:"61,optional,ExptScoreWeight" form:"score_weight_config" json:"score_weight_config,omitempty" query:"score_weight_config"`
 	EnableWeightedScore *bool            `thrift:"enable_weighted_score,62,optional" frugal:"62,optional,bool" form:"enable_weighted_score" json:"enable_weighted_score,omitempty" query:"enable_weighted_score"`
+	// 门禁规则及实验完成时计算的门禁结论
+	GateConfig  *ExptGateConfig  `thrift:"gate_config,63,optional" frugal:"63,optional,ExptGateConfig" form:"gate_config" json:"gate_config,omitempty" query:"gate_config"`
+	GateVerdict *ExptGateVerdict `thrift:"gate_verdict,64,optional" frugal:"64,optional,ExptGateVerdict" form:"gate_verdict" json:"gate_verdict,omitempty" query:"gate_verdict"`
 }
 
 func NewExperiment() *Experiment {
@@ -1375,6 +1435,30 @@ func (p *Experiment) GetEnableWeightedScore() (v bool) {
 	}
 	return *p.EnableWeightedScore
 }
+
+var Experiment_GateConfig_DEFAULT *ExptGateConfig
+
+func (p *Experiment) GetGateConfig() (v *ExptGateConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateConfig() {
+		return Experiment_GateConfig_DEFAULT
+	}
+	return p.GateConfig
+}
+
+var Experiment_GateVerdict_DEFAULT *ExptGateVerdict
+
+func (p *Experiment) GetGateVerdict() (v *ExptGateVerdict) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateVerdict() {
+		return Experiment_GateVerdict_DEFAULT
+	}
+	return p.GateVerdict
+}
 func (p *Experiment) SetID(val *int64) {
 	p.ID = val
 }
@@ -1468,6 +1552,12 @@ func (p *Experiment) SetScoreWeightConfig(val *ExptScoreWeight) {
 func (p *Experiment) SetEnableWeightedScore(val *bool) {
 	p.EnableWeightedScore = val
 }
+func (p *Experiment) SetGateConfig(val *ExptGateConfig) {
+	p.GateConfig = val
+}
+func (p *Experiment) SetGateVerdict(val *ExptGateVerdict) {
+	p.GateVerdict = val
+}
 
 var fieldIDToName_Experiment = map[int16]string{
 	1:  "id",
@@ -1501,6 +1591,8 @@ var fieldIDToName_Experiment = map[int16]string{
 	60: "expt_template_meta",
 	61: "score_weight_config",
 	62: "enable_weighted_score",
+	63: "gate_config",
+	64: "gate_verdict",
 }
 
 func (p *Experiment) IsSetID() bool {
@@ -1627,6 +1719,14 @@ func (p *Experiment) IsSetEnableWeightedScore() bool {
 	return p.EnableWeightedScore != nil
 }
 
+func (p *Experiment) IsSetGateConfig() bool {
+	return p.GateConfig != nil
+}
+
+func (p *Experiment) IsSetGateVerdict() bool {
+	return p.GateVerdict != nil
+}
+
 func (p *Experiment) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1893,6 +1993,22 @@ func (p *Experiment) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 63:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField63(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 64:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField64(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2290,6 +2406,22 @@ func (p *Experiment) ReadField62(iprot thrift.TProtocol) error {
 	p.EnableWeightedScore = _field
 	return nil
 }
+func (p *Experiment) ReadField63(iprot thrift.TProtocol) error {
+	_field := NewExptGateConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateConfig = _field
+	return nil
+}
+func (p *Experiment) ReadField64(iprot thrift.TProtocol) error {
+	_field := NewExptGateVerdict()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateVerdict = _field
+	return nil
+}
 
 func (p *Experiment) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2421,6 +2553,14 @@ func (p *Experiment) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 62
 			goto WriteFieldError
 		}
+		if err = p.writeField63(oprot); err != nil {
+			fieldId = 63
+			goto WriteFieldError
+		}
+		if err = p.writeField64(oprot); err != nil {
+			fieldId = 64
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3029,6 +3169,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 62 end error: ", p), err)
 }
+func (p *Experiment) writeField63(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateConfig() {
+		if err = oprot.WriteFieldBegin("gate_config", thrift.STRUCT, 63); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 63 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 63 end error: ", p), err)
+}
+func (p *Experiment) writeField64(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateVerdict() {
+		if err = oprot.WriteFieldBegin("gate_verdict", thrift.STRUCT, 64); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateVerdict.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 64 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 64 end error: ", p), err)
+}
 
 func (p *Experiment) String() string {
 	if p == nil {
@@ -3137,6 +3313,12 @@ func (p *Experiment) DeepEqual(ano *Experiment) bool {
 	if !p.Field62DeepEqual(ano.EnableWeightedScore) {
 		return false
 	}
+	if !p.Field63DeepEqual(ano.GateConfig) {
+		return false
+	}
+	if !p.Field64DeepEqual(ano.GateVerdict) {
+		return false
+	}
 	return true
 }
 
@@ -3476,6 +3658,20 @@ func (p *Experiment) Field62DeepEqual(src *bool) bool {
 	}
 	return true
 }
+func (p *Experiment) Field63DeepEqual(src *ExptGateConfig) bool {
+
+	if !p.GateConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field64DeepEqual(src *ExptGateVerdict) bool {
+
+	if !p.GateVerdict.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 // 实验模板基础信息
 type ExptTemplateMeta struct {
@@ -5501,12 +5697,14 @@ func (p *ExptScoreWeight) Field2DeepEqual(src map[int64]float64) bool {
 }
 
 type ExptTemplate struct {
-	Meta               *ExptTemplateMeta `thrift:"meta,1,optional" frugal:"1,optional,ExptTemplateMeta" form:"meta" json:"meta,omitempty" query:"meta"`
-	TripleConfig       *ExptTuple        `thrift:"triple_config,2,optional" frugal:"2,optional,ExptTuple" form:"triple_config" json:"triple_config,omitempty" query:"triple_config"`
-	FieldMappingConfig *ExptFieldMapping `thrift:"field_mapping_config,3,optional" frugal:"3,optional,ExptFieldMapping" form:"field_mapping_config" json:"field_mapping_config,omitempty" query:"field_mapping_config"`
-	ScoreWeightConfig  *ExptScoreWeight  `thrift:"score_weight_config,4,optional" frugal:"4,optional,ExptScoreWeight" form:"score_weight_config" json:"score_weight_config,omitempty" query:"score_weight_config"`
-	ExptInfo           *ExptInfo         `thrift:"expt_info,5,optional" frugal:"5,optional,ExptInfo" form:"expt_info" json:"expt_info,omitempty" query:"expt_info"`
-	BaseInfo           *common.BaseInfo  `thrift:"base_info,255,optional" frugal:"255,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	Meta               *ExptTemplateMeta     `thrift:"meta,1,optional" frugal:"1,optional,ExptTemplateMeta" form:"meta" json:"meta,omitempty" query:"meta"`
+	TripleConfig       *ExptTuple            `thrift:"triple_config,2,optional" frugal:"2,optional,ExptTuple" form:"triple_config" json:"triple_config,omitempty" query:"triple_config"`
+	FieldMappingConfig *ExptFieldMapping     `thrift:"field_mapping_config,3,optional" frugal:"3,optional,ExptFieldMapping" form:"field_mapping_config" json:"field_mapping_config,omitempty" query:"field_mapping_config"`
+	ScoreWeightConfig  *ExptScoreWeight      `thrift:"score_weight_config,4,optional" frugal:"4,optional,ExptScoreWeight" form:"score_weight_config" json:"score_weight_config,omitempty" query:"score_weight_config"`
+	ExptInfo           *ExptInfo             `thrift:"expt_info,5,optional" frugal:"5,optional,ExptInfo" form:"expt_info" json:"expt_info,omitempty" query:"expt_info"`
+	ScheduleConfig     *ExptTemplateSchedule `thrift:"schedule_config,6,optional" frugal:"6,optional,ExptTemplateSchedule" form:"schedule_config" json:"schedule_config,omitempty" query:"schedule_config"`
+	GateConfig         *ExptGateConfig       `thrift:"gate_config,7,optional" frugal:"7,optional,ExptGateConfig" form:"gate_config" json:"gate_config,omitempty" query:"gate_config"`
+	BaseInfo           *common.BaseInfo      `thrift:"base_info,255,optional" frugal:"255,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
 }
 
 func NewExptTemplate() *ExptTemplate {
@@ -5576,6 +5774,30 @@ func (p *ExptTemplate) GetExptInfo() (v *ExptInfo) {
 	return p.ExptInfo
 }
 
+var ExptTemplate_ScheduleConfig_DEFAULT *ExptTemplateSchedule
+
+func (p *ExptTemplate) GetScheduleConfig() (v *ExptTemplateSchedule) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScheduleConfig() {
+		return ExptTemplate_ScheduleConfig_DEFAULT
+	}
+	return p.ScheduleConfig
+}
+
+var ExptTemplate_GateConfig_DEFAULT *ExptGateConfig
+
+func (p *ExptTemplate) GetGateConfig() (v *ExptGateConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateConfig() {
+		return ExptTemplate_GateConfig_DEFAULT
+	}
+	return p.GateConfig
+}
+
 var ExptTemplate_BaseInfo_DEFAULT *common.BaseInfo
 
 func (p *ExptTemplate) GetBaseInfo() (v *common.BaseInfo) {
@@ -5602,6 +5824,12 @@ func (p *ExptTemplate) SetScoreWeightConfig(val *ExptScoreWeight) {
 func (p *ExptTemplate) SetExptInfo(val *ExptInfo) {
 	p.ExptInfo = val
 }
+func (p *ExptTemplate) SetScheduleConfig(val *ExptTemplateSchedule) {
+	p.ScheduleConfig = val
+}
+func (p *ExptTemplate) SetGateConfig(val *ExptGateConfig) {
+	p.GateConfig = val
+}
 func (p *ExptTemplate) SetBaseInfo(val *common.BaseInfo) {
 	p.BaseInfo = val
 }
@@ -5612,6 +5840,8 @@ var fieldIDToName_ExptTemplate = map[int16]string{
 	3:   "field_mapping_config",
 	4:   "score_weight_config",
 	5:   "expt_info",
+	6:   "schedule_config",
+	7:   "gate_config",
 	255: "base_info",
 }
 
@@ -5635,6 +5865,14 @@ func (p *ExptTemplate) IsSetExptInfo() bool {
 	return p.ExptInfo != nil
 }
 
+func (p *ExptTemplate) IsSetScheduleConfig() bool {
+	return p.ScheduleConfig != nil
+}
+
+func (p *ExptTemplate) IsSetGateConfig() bool {
+	return p.GateConfig != nil
+}
+
 func (p *ExptTemplate) IsSetBaseInfo() bool {
 	return p.BaseInfo != nil
 }
@@ -5697,6 +5935,22 @@ func (p *ExptTemplate) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -5774,6 +6028,22 @@ func (p *ExptTemplate) ReadField5(iprot thrift.TProtocol) error {
 	p.ExptInfo = _field
 	return nil
 }
+func (p *ExptTemplate) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewExptTemplateSchedule()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ScheduleConfig = _field
+	return nil
+}
+func (p *ExptTemplate) ReadField7(iprot thrift.TProtocol) error {
+	_field := NewExptGateConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateConfig = _field
+	return nil
+}
 func (p *ExptTemplate) ReadField255(iprot thrift.TProtocol) error {
 	_field := common.NewBaseInfo()
 	if err := _field.Read(iprot); err != nil {
@@ -5809,6 +6079,14 @@ func (p *ExptTemplate) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -5921,6 +6199,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
+func (p *ExptTemplate) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScheduleConfig() {
+		if err = oprot.WriteFieldBegin("schedule_config", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ScheduleConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExptTemplate) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateConfig() {
+		if err = oprot.WriteFieldBegin("gate_config", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 func (p *ExptTemplate) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBaseInfo() {
 		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 255); err != nil {
@@ -5969,6 +6283,12 @@ func (p *ExptTemplate) DeepEqual(ano *ExptTemplate) bool {
 	if !p.Field5DeepEqual(ano.ExptInfo) {
 		return false
 	}
+	if !p.Field6DeepEqual(ano.ScheduleConfig) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.GateConfig) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.BaseInfo) {
 		return false
 	}
@@ -6010,6 +6330,20 @@ func (p *ExptTemplate) Field5DeepEqual(src *ExptInfo) bool {
 	}
 	return true
 }
+func (p *ExptTemplate) Field6DeepEqual(src *ExptTemplateSchedule) bool {
+
+	if !p.ScheduleConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptTemplate) Field7DeepEqual(src *ExptGateConfig) bool {
+
+	if !p.GateConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *ExptTemplate) Field255DeepEqual(src *common.BaseInfo) bool {
 
 	if !p.BaseInfo.DeepEqual(src) {
@@ -6018,84 +6352,153 @@ func (p *ExptTemplate) Field255DeepEqual(src *common.BaseInfo) bool {
 	return true
 }
 
-type ExptInfo struct {
-	CreatedExptCount *int64      `thrift:"created_expt_count,1,optional" frugal:"1,optional,i64" form:"created_expt_count" json:"created_expt_count,omitempty" query:"created_expt_count"`
-	LatestExptID     *int64      `thrift:"latest_expt_id,2,optional" frugal:"2,optional,i64" json:"latest_expt_id" form:"latest_expt_id" query:"latest_expt_id"`
-	LatestExptStatus *ExptStatus `thrift:"latest_expt_status,3,optional" frugal:"3,optional,ExptStatus" form:"latest_expt_status" json:"latest_expt_status,omitempty" query:"latest_expt_status"`
+// 实验模板调度配置
+type ExptTemplateSchedule struct {
+	Enabled *bool `thrift:"enabled,1,optional" frugal:"1,optional,bool" form:"enabled" json:"enabled,omitempty" query:"enabled"`
+	// 5 段 cron 表达式（分 时 日 月 周），为空时不按时间触发
+	Cron *string `thrift:"cron,2,optional" frugal:"2,optional,string" form:"cron" json:"cron,omitempty" query:"cron"`
+	// cron 使用的时区，如 Asia/Shanghai，为空时使用服务所在时区
+	Timezone *string `thrift:"timezone,3,optional" frugal:"3,optional,string" form:"timezone" json:"timezone,omitempty" query:"timezone"`
+	// 评测对象为 Prompt 时，提交版本或标签指向的版本变化后自动运行
+	TriggerOnTargetChange *bool `thrift:"trigger_on_target_change,4,optional" frugal:"4,optional,bool" form:"trigger_on_target_change" json:"trigger_on_target_change,omitempty" query:"trigger_on_target_change"`
+	// 跟踪的 Prompt 标签，为空时跟踪最新提交版本
+	PromptLabel *string `thrift:"prompt_label,5,optional" frugal:"5,optional,string" form:"prompt_label" json:"prompt_label,omitempty" query:"prompt_label"`
+	// 保留的调度实验数量，超出后删除最早的调度实验，0 表示不清理
+	RetentionCount *int32 `thrift:"retention_count,6,optional" frugal:"6,optional,i32" form:"retention_count" json:"retention_count,omitempty" query:"retention_count"`
 }
 
-func NewExptInfo() *ExptInfo {
-	return &ExptInfo{}
+func NewExptTemplateSchedule() *ExptTemplateSchedule {
+	return &ExptTemplateSchedule{}
 }
 
-func (p *ExptInfo) InitDefault() {
+func (p *ExptTemplateSchedule) InitDefault() {
 }
 
-var ExptInfo_CreatedExptCount_DEFAULT int64
+var ExptTemplateSchedule_Enabled_DEFAULT bool
 
-func (p *ExptInfo) GetCreatedExptCount() (v int64) {
+func (p *ExptTemplateSchedule) GetEnabled() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCreatedExptCount() {
-		return ExptInfo_CreatedExptCount_DEFAULT
+	if !p.IsSetEnabled() {
+		return ExptTemplateSchedule_Enabled_DEFAULT
 	}
-	return *p.CreatedExptCount
+	return *p.Enabled
 }
 
-var ExptInfo_LatestExptID_DEFAULT int64
+var ExptTemplateSchedule_Cron_DEFAULT string
 
-func (p *ExptInfo) GetLatestExptID() (v int64) {
+func (p *ExptTemplateSchedule) GetCron() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetLatestExptID() {
-		return ExptInfo_LatestExptID_DEFAULT
+	if !p.IsSetCron() {
+		return ExptTemplateSchedule_Cron_DEFAULT
 	}
-	return *p.LatestExptID
+	return *p.Cron
 }
 
-var ExptInfo_LatestExptStatus_DEFAULT ExptStatus
+var ExptTemplateSchedule_Timezone_DEFAULT string
 
-func (p *ExptInfo) GetLatestExptStatus() (v ExptStatus) {
+func (p *ExptTemplateSchedule) GetTimezone() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetLatestExptStatus() {
-		return ExptInfo_LatestExptStatus_DEFAULT
+	if !p.IsSetTimezone() {
+		return ExptTemplateSchedule_Timezone_DEFAULT
 	}
-	return *p.LatestExptStatus
-}
-func (p *ExptInfo) SetCreatedExptCount(val *int64) {
-	p.CreatedExptCount = val
-}
-func (p *ExptInfo) SetLatestExptID(val *int64) {
-	p.LatestExptID = val
-}
-func (p *ExptInfo) SetLatestExptStatus(val *ExptStatus) {
-	p.LatestExptStatus = val
+	return *p.Timezone
 }
 
-var fieldIDToName_ExptInfo = map[int16]string{
-	1: "created_expt_count",
-	2: "latest_expt_id",
-	3: "latest_expt_status",
-}
+var ExptTemplateSchedule_TriggerOnTargetChange_DEFAULT bool
 
-func (p *ExptInfo) IsSetCreatedExptCount() bool {
-	return p.CreatedExptCount != nil
+func (p *ExptTemplateSchedule) GetTriggerOnTargetChange() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTriggerOnTargetChange() {
+		return ExptTemplateSchedule_TriggerOnTargetChange_DEFAULT
+	}
+	return *p.TriggerOnTargetChange
 }
 
-func (p *ExptInfo) IsSetLatestExptID() bool {
-	return p.LatestExptID != nil
-}
+var ExptTemplateSchedule_PromptLabel_DEFAULT string
 
-func (p *ExptInfo) IsSetLatestExptStatus() bool {
-	return p.LatestExptStatus != nil
+func (p *ExptTemplateSchedule) GetPromptLabel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPromptLabel() {
+		return ExptTemplateSchedule_PromptLabel_DEFAULT
+	}
+	return *p.PromptLabel
 }
 
-func (p *ExptInfo) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
+var ExptTemplateSchedule_RetentionCount_DEFAULT int32
+
+func (p *ExptTemplateSchedule) GetRetentionCount() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRetentionCount() {
+		return ExptTemplateSchedule_RetentionCount_DEFAULT
+	}
+	return *p.RetentionCount
+}
+func (p *ExptTemplateSchedule) SetEnabled(val *bool) {
+	p.Enabled = val
+}
+func (p *ExptTemplateSchedule) SetCron(val *string) {
+	p.Cron = val
+}
+func (p *ExptTemplateSchedule) SetTimezone(val *string) {
+	p.Timezone = val
+}
+func (p *ExptTemplateSchedule) SetTriggerOnTargetChange(val *bool) {
+	p.TriggerOnTargetChange = val
+}
+func (p *ExptTemplateSchedule) SetPromptLabel(val *string) {
+	p.PromptLabel = val
+}
+func (p *ExptTemplateSchedule) SetRetentionCount(val *int32) {
+	p.RetentionCount = val
+}
+
+var fieldIDToName_ExptTemplateSchedule = map[int16]string{
+	1: "enabled",
+	2: "cron",
+	3: "timezone",
+	4: "trigger_on_target_change",
+	5: "prompt_label",
+	6: "retention_count",
+}
+
+func (p *ExptTemplateSchedule) IsSetEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *ExptTemplateSchedule) IsSetCron() bool {
+	return p.Cron != nil
+}
+
+func (p *ExptTemplateSchedule) IsSetTimezone() bool {
+	return p.Timezone != nil
+}
+
+func (p *ExptTemplateSchedule) IsSetTriggerOnTargetChange() bool {
+	return p.TriggerOnTargetChange != nil
+}
+
+func (p *ExptTemplateSchedule) IsSetPromptLabel() bool {
+	return p.PromptLabel != nil
+}
+
+func (p *ExptTemplateSchedule) IsSetRetentionCount() bool {
+	return p.RetentionCount != nil
+}
+
+func (p *ExptTemplateSchedule) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
 	var fieldId int16
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
@@ -6113,7 +6516,7 @@ func (p *ExptInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6121,7 +6524,7 @@ func (p *ExptInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6129,13 +6532,37 @@ func (p *ExptInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6155,7 +6582,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptTemplateSchedule[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6165,44 +6592,76 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExptInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptTemplateSchedule) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.CreatedExptCount = _field
+	p.Enabled = _field
 	return nil
 }
-func (p *ExptInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptTemplateSchedule) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.LatestExptID = _field
+	p.Cron = _field
 	return nil
 }
-func (p *ExptInfo) ReadField3(iprot thrift.TProtocol) error {
+func (p *ExptTemplateSchedule) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *ExptStatus
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Timezone = _field
+	return nil
+}
+func (p *ExptTemplateSchedule) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TriggerOnTargetChange = _field
+	return nil
+}
+func (p *ExptTemplateSchedule) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PromptLabel = _field
+	return nil
+}
+func (p *ExptTemplateSchedule) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		tmp := ExptStatus(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.LatestExptStatus = _field
+	p.RetentionCount = _field
 	return nil
 }
 
-func (p *ExptInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptTemplateSchedule) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptInfo"); err != nil {
+	if err = oprot.WriteStructBegin("ExptTemplateSchedule"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6218,6 +6677,18 @@ func (p *ExptInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6236,12 +6707,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCreatedExptCount() {
-		if err = oprot.WriteFieldBegin("created_expt_count", thrift.I64, 1); err != nil {
+func (p *ExptTemplateSchedule) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnabled() {
+		if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.CreatedExptCount); err != nil {
+		if err := oprot.WriteBool(*p.Enabled); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6254,12 +6725,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLatestExptID() {
-		if err = oprot.WriteFieldBegin("latest_expt_id", thrift.I64, 2); err != nil {
+func (p *ExptTemplateSchedule) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCron() {
+		if err = oprot.WriteFieldBegin("cron", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.LatestExptID); err != nil {
+		if err := oprot.WriteString(*p.Cron); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6272,12 +6743,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLatestExptStatus() {
-		if err = oprot.WriteFieldBegin("latest_expt_status", thrift.I32, 3); err != nil {
+func (p *ExptTemplateSchedule) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimezone() {
+		if err = oprot.WriteFieldBegin("timezone", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.LatestExptStatus)); err != nil {
+		if err := oprot.WriteString(*p.Timezone); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6290,126 +6761,311 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *ExptTemplateSchedule) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTriggerOnTargetChange() {
+		if err = oprot.WriteFieldBegin("trigger_on_target_change", thrift.BOOL, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.TriggerOnTargetChange); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptTemplateSchedule) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPromptLabel() {
+		if err = oprot.WriteFieldBegin("prompt_label", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PromptLabel); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptTemplateSchedule) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetentionCount() {
+		if err = oprot.WriteFieldBegin("retention_count", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.RetentionCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
-func (p *ExptInfo) String() string {
+func (p *ExptTemplateSchedule) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptInfo(%+v)", *p)
+	return fmt.Sprintf("ExptTemplateSchedule(%+v)", *p)
 
 }
 
-func (p *ExptInfo) DeepEqual(ano *ExptInfo) bool {
+func (p *ExptTemplateSchedule) DeepEqual(ano *ExptTemplateSchedule) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.CreatedExptCount) {
+	if !p.Field1DeepEqual(ano.Enabled) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.LatestExptID) {
+	if !p.Field2DeepEqual(ano.Cron) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.LatestExptStatus) {
+	if !p.Field3DeepEqual(ano.Timezone) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TriggerOnTargetChange) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.PromptLabel) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.RetentionCount) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptInfo) Field1DeepEqual(src *int64) bool {
+func (p *ExptTemplateSchedule) Field1DeepEqual(src *bool) bool {
 
-	if p.CreatedExptCount == src {
+	if p.Enabled == src {
 		return true
-	} else if p.CreatedExptCount == nil || src == nil {
+	} else if p.Enabled == nil || src == nil {
 		return false
 	}
-	if *p.CreatedExptCount != *src {
+	if *p.Enabled != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptInfo) Field2DeepEqual(src *int64) bool {
+func (p *ExptTemplateSchedule) Field2DeepEqual(src *string) bool {
 
-	if p.LatestExptID == src {
+	if p.Cron == src {
 		return true
-	} else if p.LatestExptID == nil || src == nil {
+	} else if p.Cron == nil || src == nil {
 		return false
 	}
-	if *p.LatestExptID != *src {
+	if strings.Compare(*p.Cron, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptInfo) Field3DeepEqual(src *ExptStatus) bool {
+func (p *ExptTemplateSchedule) Field3DeepEqual(src *string) bool {
 
-	if p.LatestExptStatus == src {
+	if p.Timezone == src {
 		return true
-	} else if p.LatestExptStatus == nil || src == nil {
+	} else if p.Timezone == nil || src == nil {
 		return false
 	}
-	if *p.LatestExptStatus != *src {
+	if strings.Compare(*p.Timezone, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *ExptTemplateSchedule) Field4DeepEqual(src *bool) bool {
 
-type TokenUsage struct {
-	InputTokens  *int64 `thrift:"input_tokens,1,optional" frugal:"1,optional,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
-	OutputTokens *int64 `thrift:"output_tokens,2,optional" frugal:"2,optional,i64" json:"output_tokens" form:"output_tokens" query:"output_tokens"`
+	if p.TriggerOnTargetChange == src {
+		return true
+	} else if p.TriggerOnTargetChange == nil || src == nil {
+		return false
+	}
+	if *p.TriggerOnTargetChange != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptTemplateSchedule) Field5DeepEqual(src *string) bool {
 
-func NewTokenUsage() *TokenUsage {
-	return &TokenUsage{}
+	if p.PromptLabel == src {
+		return true
+	} else if p.PromptLabel == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PromptLabel, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ExptTemplateSchedule) Field6DeepEqual(src *int32) bool {
 
-func (p *TokenUsage) InitDefault() {
+	if p.RetentionCount == src {
+		return true
+	} else if p.RetentionCount == nil || src == nil {
+		return false
+	}
+	if *p.RetentionCount != *src {
+		return false
+	}
+	return true
 }
 
-var TokenUsage_InputTokens_DEFAULT int64
+// 实验模板调度记录
+type ExptScheduleRecord struct {
+	TriggerType   *ExptScheduleTriggerType `thrift:"trigger_type,1,optional" frugal:"1,optional,string" form:"trigger_type" json:"trigger_type,omitempty" query:"trigger_type"`
+	FireAt        *int64                   `thrift:"fire_at,2,optional" frugal:"2,optional,i64" json:"fire_at" form:"fire_at" query:"fire_at"`
+	TargetVersion *string                  `thrift:"target_version,3,optional" frugal:"3,optional,string" form:"target_version" json:"target_version,omitempty" query:"target_version"`
+	ExptID        *int64                   `thrift:"expt_id,4,optional" frugal:"4,optional,i64" json:"expt_id" form:"expt_id" query:"expt_id"`
+	Error         *string                  `thrift:"error,5,optional" frugal:"5,optional,string" form:"error" json:"error,omitempty" query:"error"`
+	// 实验已因超出保留数量被删除
+	Purged *bool `thrift:"purged,6,optional" frugal:"6,optional,bool" form:"purged" json:"purged,omitempty" query:"purged"`
+}
 
-func (p *TokenUsage) GetInputTokens() (v int64) {
+func NewExptScheduleRecord() *ExptScheduleRecord {
+	return &ExptScheduleRecord{}
+}
+
+func (p *ExptScheduleRecord) InitDefault() {
+}
+
+var ExptScheduleRecord_TriggerType_DEFAULT ExptScheduleTriggerType
+
+func (p *ExptScheduleRecord) GetTriggerType() (v ExptScheduleTriggerType) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetInputTokens() {
-		return TokenUsage_InputTokens_DEFAULT
+	if !p.IsSetTriggerType() {
+		return ExptScheduleRecord_TriggerType_DEFAULT
 	}
-	return *p.InputTokens
+	return *p.TriggerType
 }
 
-var TokenUsage_OutputTokens_DEFAULT int64
+var ExptScheduleRecord_FireAt_DEFAULT int64
 
-func (p *TokenUsage) GetOutputTokens() (v int64) {
+func (p *ExptScheduleRecord) GetFireAt() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetOutputTokens() {
-		return TokenUsage_OutputTokens_DEFAULT
+	if !p.IsSetFireAt() {
+		return ExptScheduleRecord_FireAt_DEFAULT
 	}
-	return *p.OutputTokens
-}
-func (p *TokenUsage) SetInputTokens(val *int64) {
-	p.InputTokens = val
-}
-func (p *TokenUsage) SetOutputTokens(val *int64) {
-	p.OutputTokens = val
+	return *p.FireAt
 }
 
-var fieldIDToName_TokenUsage = map[int16]string{
-	1: "input_tokens",
-	2: "output_tokens",
-}
+var ExptScheduleRecord_TargetVersion_DEFAULT string
 
-func (p *TokenUsage) IsSetInputTokens() bool {
-	return p.InputTokens != nil
+func (p *ExptScheduleRecord) GetTargetVersion() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetVersion() {
+		return ExptScheduleRecord_TargetVersion_DEFAULT
+	}
+	return *p.TargetVersion
 }
 
-func (p *TokenUsage) IsSetOutputTokens() bool {
-	return p.OutputTokens != nil
-}
+var ExptScheduleRecord_ExptID_DEFAULT int64
 
-func (p *TokenUsage) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptScheduleRecord) GetExptID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExptID() {
+		return ExptScheduleRecord_ExptID_DEFAULT
+	}
+	return *p.ExptID
+}
+
+var ExptScheduleRecord_Error_DEFAULT string
+
+func (p *ExptScheduleRecord) GetError() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetError() {
+		return ExptScheduleRecord_Error_DEFAULT
+	}
+	return *p.Error
+}
+
+var ExptScheduleRecord_Purged_DEFAULT bool
+
+func (p *ExptScheduleRecord) GetPurged() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPurged() {
+		return ExptScheduleRecord_Purged_DEFAULT
+	}
+	return *p.Purged
+}
+func (p *ExptScheduleRecord) SetTriggerType(val *ExptScheduleTriggerType) {
+	p.TriggerType = val
+}
+func (p *ExptScheduleRecord) SetFireAt(val *int64) {
+	p.FireAt = val
+}
+func (p *ExptScheduleRecord) SetTargetVersion(val *string) {
+	p.TargetVersion = val
+}
+func (p *ExptScheduleRecord) SetExptID(val *int64) {
+	p.ExptID = val
+}
+func (p *ExptScheduleRecord) SetError(val *string) {
+	p.Error = val
+}
+func (p *ExptScheduleRecord) SetPurged(val *bool) {
+	p.Purged = val
+}
+
+var fieldIDToName_ExptScheduleRecord = map[int16]string{
+	1: "trigger_type",
+	2: "fire_at",
+	3: "target_version",
+	4: "expt_id",
+	5: "error",
+	6: "purged",
+}
+
+func (p *ExptScheduleRecord) IsSetTriggerType() bool {
+	return p.TriggerType != nil
+}
+
+func (p *ExptScheduleRecord) IsSetFireAt() bool {
+	return p.FireAt != nil
+}
+
+func (p *ExptScheduleRecord) IsSetTargetVersion() bool {
+	return p.TargetVersion != nil
+}
+
+func (p *ExptScheduleRecord) IsSetExptID() bool {
+	return p.ExptID != nil
+}
+
+func (p *ExptScheduleRecord) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *ExptScheduleRecord) IsSetPurged() bool {
+	return p.Purged != nil
+}
+
+func (p *ExptScheduleRecord) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6428,7 +7084,7 @@ func (p *TokenUsage) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6443,6 +7099,38 @@ func (p *TokenUsage) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6462,7 +7150,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TokenUsage[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptScheduleRecord[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6472,7 +7160,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TokenUsage) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptScheduleRecord) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *ExptScheduleTriggerType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TriggerType = _field
+	return nil
+}
+func (p *ExptScheduleRecord) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -6480,10 +7179,21 @@ func (p *TokenUsage) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.InputTokens = _field
+	p.FireAt = _field
 	return nil
 }
-func (p *TokenUsage) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptScheduleRecord) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetVersion = _field
+	return nil
+}
+func (p *ExptScheduleRecord) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -6491,13 +7201,35 @@ func (p *TokenUsage) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.OutputTokens = _field
+	p.ExptID = _field
 	return nil
 }
+func (p *ExptScheduleRecord) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *TokenUsage) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Error = _field
+	return nil
+}
+func (p *ExptScheduleRecord) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Purged = _field
+	return nil
+}
+
+func (p *ExptScheduleRecord) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TokenUsage"); err != nil {
+	if err = oprot.WriteStructBegin("ExptScheduleRecord"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6509,6 +7241,22 @@ func (p *TokenUsage) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6527,12 +7275,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TokenUsage) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetInputTokens() {
-		if err = oprot.WriteFieldBegin("input_tokens", thrift.I64, 1); err != nil {
+func (p *ExptScheduleRecord) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTriggerType() {
+		if err = oprot.WriteFieldBegin("trigger_type", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.InputTokens); err != nil {
+		if err := oprot.WriteString(*p.TriggerType); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6545,12 +7293,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *TokenUsage) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOutputTokens() {
-		if err = oprot.WriteFieldBegin("output_tokens", thrift.I64, 2); err != nil {
+func (p *ExptScheduleRecord) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFireAt() {
+		if err = oprot.WriteFieldBegin("fire_at", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.OutputTokens); err != nil {
+		if err := oprot.WriteI64(*p.FireAt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6563,237 +7311,307 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *ExptScheduleRecord) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetVersion() {
+		if err = oprot.WriteFieldBegin("target_version", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExptScheduleRecord) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptID() {
+		if err = oprot.WriteFieldBegin("expt_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExptID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptScheduleRecord) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Error); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExptScheduleRecord) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPurged() {
+		if err = oprot.WriteFieldBegin("purged", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Purged); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
-func (p *TokenUsage) String() string {
+func (p *ExptScheduleRecord) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TokenUsage(%+v)", *p)
+	return fmt.Sprintf("ExptScheduleRecord(%+v)", *p)
 
 }
 
-func (p *TokenUsage) DeepEqual(ano *TokenUsage) bool {
+func (p *ExptScheduleRecord) DeepEqual(ano *ExptScheduleRecord) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.InputTokens) {
+	if !p.Field1DeepEqual(ano.TriggerType) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.OutputTokens) {
+	if !p.Field2DeepEqual(ano.FireAt) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TargetVersion) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ExptID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Error) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Purged) {
 		return false
 	}
 	return true
 }
 
-func (p *TokenUsage) Field1DeepEqual(src *int64) bool {
+func (p *ExptScheduleRecord) Field1DeepEqual(src *ExptScheduleTriggerType) bool {
 
-	if p.InputTokens == src {
+	if p.TriggerType == src {
 		return true
-	} else if p.InputTokens == nil || src == nil {
+	} else if p.TriggerType == nil || src == nil {
 		return false
 	}
-	if *p.InputTokens != *src {
+	if strings.Compare(*p.TriggerType, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TokenUsage) Field2DeepEqual(src *int64) bool {
+func (p *ExptScheduleRecord) Field2DeepEqual(src *int64) bool {
 
-	if p.OutputTokens == src {
+	if p.FireAt == src {
 		return true
-	} else if p.OutputTokens == nil || src == nil {
+	} else if p.FireAt == nil || src == nil {
 		return false
 	}
-	if *p.OutputTokens != *src {
+	if *p.FireAt != *src {
 		return false
 	}
 	return true
 }
+func (p *ExptScheduleRecord) Field3DeepEqual(src *string) bool {
 
-type ExptStatistics struct {
-	EvaluatorAggregateResults []*EvaluatorAggregateResult_ `thrift:"evaluator_aggregate_results,1,optional" frugal:"1,optional,list<EvaluatorAggregateResult_>" form:"evaluator_aggregate_results" json:"evaluator_aggregate_results,omitempty" query:"evaluator_aggregate_results"`
-	TokenUsage                *TokenUsage                  `thrift:"token_usage,2,optional" frugal:"2,optional,TokenUsage" form:"token_usage" json:"token_usage,omitempty" query:"token_usage"`
-	CreditCost                *float64                     `thrift:"credit_cost,3,optional" frugal:"3,optional,double" form:"credit_cost" json:"credit_cost,omitempty" query:"credit_cost"`
-	PendingTurnCnt            *int32                       `thrift:"pending_turn_cnt,4,optional" frugal:"4,optional,i32" form:"pending_turn_cnt" json:"pending_turn_cnt,omitempty" query:"pending_turn_cnt"`
-	SuccessTurnCnt            *int32                       `thrift:"success_turn_cnt,5,optional" frugal:"5,optional,i32" form:"success_turn_cnt" json:"success_turn_cnt,omitempty" query:"success_turn_cnt"`
-	FailTurnCnt               *int32                       `thrift:"fail_turn_cnt,6,optional" frugal:"6,optional,i32" form:"fail_turn_cnt" json:"fail_turn_cnt,omitempty" query:"fail_turn_cnt"`
-	TerminatedTurnCnt         *int32                       `thrift:"terminated_turn_cnt,7,optional" frugal:"7,optional,i32" form:"terminated_turn_cnt" json:"terminated_turn_cnt,omitempty" query:"terminated_turn_cnt"`
-	ProcessingTurnCnt         *int32                       `thrift:"processing_turn_cnt,8,optional" frugal:"8,optional,i32" form:"processing_turn_cnt" json:"processing_turn_cnt,omitempty" query:"processing_turn_cnt"`
+	if p.TargetVersion == src {
+		return true
+	} else if p.TargetVersion == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TargetVersion, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ExptScheduleRecord) Field4DeepEqual(src *int64) bool {
 
-func NewExptStatistics() *ExptStatistics {
-	return &ExptStatistics{}
+	if p.ExptID == src {
+		return true
+	} else if p.ExptID == nil || src == nil {
+		return false
+	}
+	if *p.ExptID != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptScheduleRecord) Field5DeepEqual(src *string) bool {
 
-func (p *ExptStatistics) InitDefault() {
-}
-
-var ExptStatistics_EvaluatorAggregateResults_DEFAULT []*EvaluatorAggregateResult_
-
-func (p *ExptStatistics) GetEvaluatorAggregateResults() (v []*EvaluatorAggregateResult_) {
-	if p == nil {
-		return
+	if p.Error == src {
+		return true
+	} else if p.Error == nil || src == nil {
+		return false
 	}
-	if !p.IsSetEvaluatorAggregateResults() {
-		return ExptStatistics_EvaluatorAggregateResults_DEFAULT
+	if strings.Compare(*p.Error, *src) != 0 {
+		return false
 	}
-	return p.EvaluatorAggregateResults
+	return true
 }
+func (p *ExptScheduleRecord) Field6DeepEqual(src *bool) bool {
 
-var ExptStatistics_TokenUsage_DEFAULT *TokenUsage
-
-func (p *ExptStatistics) GetTokenUsage() (v *TokenUsage) {
-	if p == nil {
-		return
+	if p.Purged == src {
+		return true
+	} else if p.Purged == nil || src == nil {
+		return false
 	}
-	if !p.IsSetTokenUsage() {
-		return ExptStatistics_TokenUsage_DEFAULT
+	if *p.Purged != *src {
+		return false
 	}
-	return p.TokenUsage
+	return true
 }
 
-var ExptStatistics_CreditCost_DEFAULT float64
+type ExptInfo struct {
+	CreatedExptCount *int64                `thrift:"created_expt_count,1,optional" frugal:"1,optional,i64" form:"created_expt_count" json:"created_expt_count,omitempty" query:"created_expt_count"`
+	LatestExptID     *int64                `thrift:"latest_expt_id,2,optional" frugal:"2,optional,i64" json:"latest_expt_id" form:"latest_expt_id" query:"latest_expt_id"`
+	LatestExptStatus *ExptStatus           `thrift:"latest_expt_status,3,optional" frugal:"3,optional,ExptStatus" form:"latest_expt_status" json:"latest_expt_status,omitempty" query:"latest_expt_status"`
+	ScheduleHistory  []*ExptScheduleRecord `thrift:"schedule_history,4,optional" frugal:"4,optional,list<ExptScheduleRecord>" form:"schedule_history" json:"schedule_history,omitempty" query:"schedule_history"`
+	// 调度最近一次观察到的 Prompt 提交版本
+	LastTargetVersion *string `thrift:"last_target_version,5,optional" frugal:"5,optional,string" form:"last_target_version" json:"last_target_version,omitempty" query:"last_target_version"`
+}
 
-func (p *ExptStatistics) GetCreditCost() (v float64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetCreditCost() {
-		return ExptStatistics_CreditCost_DEFAULT
-	}
-	return *p.CreditCost
+func NewExptInfo() *ExptInfo {
+	return &ExptInfo{}
 }
 
-var ExptStatistics_PendingTurnCnt_DEFAULT int32
+func (p *ExptInfo) InitDefault() {
+}
 
-func (p *ExptStatistics) GetPendingTurnCnt() (v int32) {
+var ExptInfo_CreatedExptCount_DEFAULT int64
+
+func (p *ExptInfo) GetCreatedExptCount() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPendingTurnCnt() {
-		return ExptStatistics_PendingTurnCnt_DEFAULT
+	if !p.IsSetCreatedExptCount() {
+		return ExptInfo_CreatedExptCount_DEFAULT
 	}
-	return *p.PendingTurnCnt
+	return *p.CreatedExptCount
 }
 
-var ExptStatistics_SuccessTurnCnt_DEFAULT int32
+var ExptInfo_LatestExptID_DEFAULT int64
 
-func (p *ExptStatistics) GetSuccessTurnCnt() (v int32) {
+func (p *ExptInfo) GetLatestExptID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetSuccessTurnCnt() {
-		return ExptStatistics_SuccessTurnCnt_DEFAULT
+	if !p.IsSetLatestExptID() {
+		return ExptInfo_LatestExptID_DEFAULT
 	}
-	return *p.SuccessTurnCnt
+	return *p.LatestExptID
 }
 
-var ExptStatistics_FailTurnCnt_DEFAULT int32
+var ExptInfo_LatestExptStatus_DEFAULT ExptStatus
 
-func (p *ExptStatistics) GetFailTurnCnt() (v int32) {
+func (p *ExptInfo) GetLatestExptStatus() (v ExptStatus) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFailTurnCnt() {
-		return ExptStatistics_FailTurnCnt_DEFAULT
+	if !p.IsSetLatestExptStatus() {
+		return ExptInfo_LatestExptStatus_DEFAULT
 	}
-	return *p.FailTurnCnt
+	return *p.LatestExptStatus
 }
 
-var ExptStatistics_TerminatedTurnCnt_DEFAULT int32
+var ExptInfo_ScheduleHistory_DEFAULT []*ExptScheduleRecord
 
-func (p *ExptStatistics) GetTerminatedTurnCnt() (v int32) {
+func (p *ExptInfo) GetScheduleHistory() (v []*ExptScheduleRecord) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTerminatedTurnCnt() {
-		return ExptStatistics_TerminatedTurnCnt_DEFAULT
+	if !p.IsSetScheduleHistory() {
+		return ExptInfo_ScheduleHistory_DEFAULT
 	}
-	return *p.TerminatedTurnCnt
+	return p.ScheduleHistory
 }
 
-var ExptStatistics_ProcessingTurnCnt_DEFAULT int32
+var ExptInfo_LastTargetVersion_DEFAULT string
 
-func (p *ExptStatistics) GetProcessingTurnCnt() (v int32) {
+func (p *ExptInfo) GetLastTargetVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetProcessingTurnCnt() {
-		return ExptStatistics_ProcessingTurnCnt_DEFAULT
+	if !p.IsSetLastTargetVersion() {
+		return ExptInfo_LastTargetVersion_DEFAULT
 	}
-	return *p.ProcessingTurnCnt
-}
-func (p *ExptStatistics) SetEvaluatorAggregateResults(val []*EvaluatorAggregateResult_) {
-	p.EvaluatorAggregateResults = val
-}
-func (p *ExptStatistics) SetTokenUsage(val *TokenUsage) {
-	p.TokenUsage = val
-}
-func (p *ExptStatistics) SetCreditCost(val *float64) {
-	p.CreditCost = val
-}
-func (p *ExptStatistics) SetPendingTurnCnt(val *int32) {
-	p.PendingTurnCnt = val
-}
-func (p *ExptStatistics) SetSuccessTurnCnt(val *int32) {
-	p.SuccessTurnCnt = val
-}
-func (p *ExptStatistics) SetFailTurnCnt(val *int32) {
-	p.FailTurnCnt = val
+	return *p.LastTargetVersion
 }
-func (p *ExptStatistics) SetTerminatedTurnCnt(val *int32) {
-	p.TerminatedTurnCnt = val
+func (p *ExptInfo) SetCreatedExptCount(val *int64) {
+	p.CreatedExptCount = val
 }
-func (p *ExptStatistics) SetProcessingTurnCnt(val *int32) {
-	p.ProcessingTurnCnt = val
+func (p *ExptInfo) SetLatestExptID(val *int64) {
+	p.LatestExptID = val
 }
-
-var fieldIDToName_ExptStatistics = map[int16]string{
-	1: "evaluator_aggregate_results",
-	2: "token_usage",
-	3: "credit_cost",
-	4: "pending_turn_cnt",
-	5: "success_turn_cnt",
-	6: "fail_turn_cnt",
-	7: "terminated_turn_cnt",
-	8: "processing_turn_cnt",
+func (p *ExptInfo) SetLatestExptStatus(val *ExptStatus) {
+	p.LatestExptStatus = val
 }
-
-func (p *ExptStatistics) IsSetEvaluatorAggregateResults() bool {
-	return p.EvaluatorAggregateResults != nil
+func (p *ExptInfo) SetScheduleHistory(val []*ExptScheduleRecord) {
+	p.ScheduleHistory = val
 }
-
-func (p *ExptStatistics) IsSetTokenUsage() bool {
-	return p.TokenUsage != nil
+func (p *ExptInfo) SetLastTargetVersion(val *string) {
+	p.LastTargetVersion = val
 }
 
-func (p *ExptStatistics) IsSetCreditCost() bool {
-	return p.CreditCost != nil
+var fieldIDToName_ExptInfo = map[int16]string{
+	1: "created_expt_count",
+	2: "latest_expt_id",
+	3: "latest_expt_status",
+	4: "schedule_history",
+	5: "last_target_version",
 }
 
-func (p *ExptStatistics) IsSetPendingTurnCnt() bool {
-	return p.PendingTurnCnt != nil
+func (p *ExptInfo) IsSetCreatedExptCount() bool {
+	return p.CreatedExptCount != nil
 }
 
-func (p *ExptStatistics) IsSetSuccessTurnCnt() bool {
-	return p.SuccessTurnCnt != nil
+func (p *ExptInfo) IsSetLatestExptID() bool {
+	return p.LatestExptID != nil
 }
 
-func (p *ExptStatistics) IsSetFailTurnCnt() bool {
-	return p.FailTurnCnt != nil
+func (p *ExptInfo) IsSetLatestExptStatus() bool {
+	return p.LatestExptStatus != nil
 }
 
-func (p *ExptStatistics) IsSetTerminatedTurnCnt() bool {
-	return p.TerminatedTurnCnt != nil
+func (p *ExptInfo) IsSetScheduleHistory() bool {
+	return p.ScheduleHistory != nil
 }
 
-func (p *ExptStatistics) IsSetProcessingTurnCnt() bool {
-	return p.ProcessingTurnCnt != nil
+func (p *ExptInfo) IsSetLastTargetVersion() bool {
+	return p.LastTargetVersion != nil
 }
 
-func (p *ExptStatistics) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptInfo) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6812,7 +7630,7 @@ func (p *ExptStatistics) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6820,7 +7638,7 @@ func (p *ExptStatistics) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6828,7 +7646,7 @@ func (p *ExptStatistics) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6836,7 +7654,7 @@ func (p *ExptStatistics) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6844,37 +7662,13 @@ func (p *ExptStatistics) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6894,7 +7688,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptStatistics[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6904,13 +7698,47 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExptStatistics) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CreatedExptCount = _field
+	return nil
+}
+func (p *ExptInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LatestExptID = _field
+	return nil
+}
+func (p *ExptInfo) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *ExptStatus
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := ExptStatus(v)
+		_field = &tmp
+	}
+	p.LatestExptStatus = _field
+	return nil
+}
+func (p *ExptInfo) ReadField4(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*EvaluatorAggregateResult_, 0, size)
-	values := make([]EvaluatorAggregateResult_, size)
+	_field := make([]*ExptScheduleRecord, 0, size)
+	values := make([]ExptScheduleRecord, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -6924,88 +7752,25 @@ func (p *ExptStatistics) ReadField1(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.EvaluatorAggregateResults = _field
-	return nil
-}
-func (p *ExptStatistics) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewTokenUsage()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.TokenUsage = _field
+	p.ScheduleHistory = _field
 	return nil
 }
-func (p *ExptStatistics) ReadField3(iprot thrift.TProtocol) error {
+func (p *ExptInfo) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.CreditCost = _field
+	p.LastTargetVersion = _field
 	return nil
 }
-func (p *ExptStatistics) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PendingTurnCnt = _field
-	return nil
-}
-func (p *ExptStatistics) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.SuccessTurnCnt = _field
-	return nil
-}
-func (p *ExptStatistics) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.FailTurnCnt = _field
-	return nil
-}
-func (p *ExptStatistics) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.TerminatedTurnCnt = _field
-	return nil
-}
-func (p *ExptStatistics) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ProcessingTurnCnt = _field
-	return nil
-}
-
-func (p *ExptStatistics) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptStatistics"); err != nil {
-		goto WriteStructBeginError
+func (p *ExptInfo) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptInfo"); err != nil {
+		goto WriteStructBeginError
 	}
 	if p != nil {
 		if err = p.writeField1(oprot); err != nil {
@@ -7028,18 +7793,6 @@ func (p *ExptStatistics) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7058,20 +7811,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptStatistics) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorAggregateResults() {
-		if err = oprot.WriteFieldBegin("evaluator_aggregate_results", thrift.LIST, 1); err != nil {
+func (p *ExptInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedExptCount() {
+		if err = oprot.WriteFieldBegin("created_expt_count", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorAggregateResults)); err != nil {
-			return err
-		}
-		for _, v := range p.EvaluatorAggregateResults {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteI64(*p.CreatedExptCount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7084,12 +7829,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptStatistics) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTokenUsage() {
-		if err = oprot.WriteFieldBegin("token_usage", thrift.STRUCT, 2); err != nil {
+func (p *ExptInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLatestExptID() {
+		if err = oprot.WriteFieldBegin("latest_expt_id", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.TokenUsage.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.LatestExptID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7102,12 +7847,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExptStatistics) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCreditCost() {
-		if err = oprot.WriteFieldBegin("credit_cost", thrift.DOUBLE, 3); err != nil {
+func (p *ExptInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLatestExptStatus() {
+		if err = oprot.WriteFieldBegin("latest_expt_status", thrift.I32, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.CreditCost); err != nil {
+		if err := oprot.WriteI32(int32(*p.LatestExptStatus)); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7120,12 +7865,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptStatistics) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPendingTurnCnt() {
-		if err = oprot.WriteFieldBegin("pending_turn_cnt", thrift.I32, 4); err != nil {
+func (p *ExptInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScheduleHistory() {
+		if err = oprot.WriteFieldBegin("schedule_history", thrift.LIST, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.PendingTurnCnt); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ScheduleHistory)); err != nil {
+			return err
+		}
+		for _, v := range p.ScheduleHistory {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7138,12 +7891,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ExptStatistics) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccessTurnCnt() {
-		if err = oprot.WriteFieldBegin("success_turn_cnt", thrift.I32, 5); err != nil {
+func (p *ExptInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastTargetVersion() {
+		if err = oprot.WriteFieldBegin("last_target_version", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.SuccessTurnCnt); err != nil {
+		if err := oprot.WriteString(*p.LastTargetVersion); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7156,251 +7909,157 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *ExptStatistics) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFailTurnCnt() {
-		if err = oprot.WriteFieldBegin("fail_turn_cnt", thrift.I32, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.FailTurnCnt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-func (p *ExptStatistics) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTerminatedTurnCnt() {
-		if err = oprot.WriteFieldBegin("terminated_turn_cnt", thrift.I32, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.TerminatedTurnCnt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-func (p *ExptStatistics) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProcessingTurnCnt() {
-		if err = oprot.WriteFieldBegin("processing_turn_cnt", thrift.I32, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.ProcessingTurnCnt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
 
-func (p *ExptStatistics) String() string {
+func (p *ExptInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptStatistics(%+v)", *p)
+	return fmt.Sprintf("ExptInfo(%+v)", *p)
 
 }
 
-func (p *ExptStatistics) DeepEqual(ano *ExptStatistics) bool {
+func (p *ExptInfo) DeepEqual(ano *ExptInfo) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvaluatorAggregateResults) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.TokenUsage) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.CreditCost) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.PendingTurnCnt) {
+	if !p.Field1DeepEqual(ano.CreatedExptCount) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.SuccessTurnCnt) {
+	if !p.Field2DeepEqual(ano.LatestExptID) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.FailTurnCnt) {
+	if !p.Field3DeepEqual(ano.LatestExptStatus) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.TerminatedTurnCnt) {
+	if !p.Field4DeepEqual(ano.ScheduleHistory) {
 		return false
 	}
-	if !p.Field8DeepEqual(ano.ProcessingTurnCnt) {
+	if !p.Field5DeepEqual(ano.LastTargetVersion) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptStatistics) Field1DeepEqual(src []*EvaluatorAggregateResult_) bool {
+func (p *ExptInfo) Field1DeepEqual(src *int64) bool {
 
-	if len(p.EvaluatorAggregateResults) != len(src) {
+	if p.CreatedExptCount == src {
+		return true
+	} else if p.CreatedExptCount == nil || src == nil {
 		return false
 	}
-	for i, v := range p.EvaluatorAggregateResults {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-func (p *ExptStatistics) Field2DeepEqual(src *TokenUsage) bool {
-
-	if !p.TokenUsage.DeepEqual(src) {
+	if *p.CreatedExptCount != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptStatistics) Field3DeepEqual(src *float64) bool {
+func (p *ExptInfo) Field2DeepEqual(src *int64) bool {
 
-	if p.CreditCost == src {
+	if p.LatestExptID == src {
 		return true
-	} else if p.CreditCost == nil || src == nil {
+	} else if p.LatestExptID == nil || src == nil {
 		return false
 	}
-	if *p.CreditCost != *src {
+	if *p.LatestExptID != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptStatistics) Field4DeepEqual(src *int32) bool {
+func (p *ExptInfo) Field3DeepEqual(src *ExptStatus) bool {
 
-	if p.PendingTurnCnt == src {
+	if p.LatestExptStatus == src {
 		return true
-	} else if p.PendingTurnCnt == nil || src == nil {
+	} else if p.LatestExptStatus == nil || src == nil {
 		return false
 	}
-	if *p.PendingTurnCnt != *src {
+	if *p.LatestExptStatus != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptStatistics) Field5DeepEqual(src *int32) bool {
+func (p *ExptInfo) Field4DeepEqual(src []*ExptScheduleRecord) bool {
 
-	if p.SuccessTurnCnt == src {
-		return true
-	} else if p.SuccessTurnCnt == nil || src == nil {
+	if len(p.ScheduleHistory) != len(src) {
 		return false
 	}
-	if *p.SuccessTurnCnt != *src {
-		return false
+	for i, v := range p.ScheduleHistory {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *ExptStatistics) Field6DeepEqual(src *int32) bool {
+func (p *ExptInfo) Field5DeepEqual(src *string) bool {
 
-	if p.FailTurnCnt == src {
+	if p.LastTargetVersion == src {
 		return true
-	} else if p.FailTurnCnt == nil || src == nil {
+	} else if p.LastTargetVersion == nil || src == nil {
 		return false
 	}
-	if *p.FailTurnCnt != *src {
+	if strings.Compare(*p.LastTargetVersion, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptStatistics) Field7DeepEqual(src *int32) bool {
 
-	if p.TerminatedTurnCnt == src {
-		return true
-	} else if p.TerminatedTurnCnt == nil || src == nil {
-		return false
-	}
-	if *p.TerminatedTurnCnt != *src {
-		return false
-	}
-	return true
+type TokenUsage struct {
+	InputTokens  *int64 `thrift:"input_tokens,1,optional" frugal:"1,optional,i64" json:"input_tokens" form:"input_tokens" query:"input_tokens"`
+	OutputTokens *int64 `thrift:"output_tokens,2,optional" frugal:"2,optional,i64" json:"output_tokens" form:"output_tokens" query:"output_tokens"`
 }
-func (p *ExptStatistics) Field8DeepEqual(src *int32) bool {
 
-	if p.ProcessingTurnCnt == src {
-		return true
-	} else if p.ProcessingTurnCnt == nil || src == nil {
-		return false
-	}
-	if *p.ProcessingTurnCnt != *src {
-		return false
-	}
-	return true
+func NewTokenUsage() *TokenUsage {
+	return &TokenUsage{}
 }
 
-type EvaluatorFmtResult_ struct {
-	Name  *string  `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	Score *float64 `thrift:"score,2,optional" frugal:"2,optional,double" form:"score" json:"score,omitempty" query:"score"`
-}
-
-func NewEvaluatorFmtResult_() *EvaluatorFmtResult_ {
-	return &EvaluatorFmtResult_{}
-}
-
-func (p *EvaluatorFmtResult_) InitDefault() {
+func (p *TokenUsage) InitDefault() {
 }
 
-var EvaluatorFmtResult__Name_DEFAULT string
+var TokenUsage_InputTokens_DEFAULT int64
 
-func (p *EvaluatorFmtResult_) GetName() (v string) {
+func (p *TokenUsage) GetInputTokens() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetName() {
-		return EvaluatorFmtResult__Name_DEFAULT
+	if !p.IsSetInputTokens() {
+		return TokenUsage_InputTokens_DEFAULT
 	}
-	return *p.Name
+	return *p.InputTokens
 }
 
-var EvaluatorFmtResult__Score_DEFAULT float64
+var TokenUsage_OutputTokens_DEFAULT int64
 
-func (p *EvaluatorFmtResult_) GetScore() (v float64) {
+func (p *TokenUsage) GetOutputTokens() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetScore() {
-		return EvaluatorFmtResult__Score_DEFAULT
+	if !p.IsSetOutputTokens() {
+		return TokenUsage_OutputTokens_DEFAULT
 	}
-	return *p.Score
+	return *p.OutputTokens
 }
-func (p *EvaluatorFmtResult_) SetName(val *string) {
-	p.Name = val
+func (p *TokenUsage) SetInputTokens(val *int64) {
+	p.InputTokens = val
 }
-func (p *EvaluatorFmtResult_) SetScore(val *float64) {
-	p.Score = val
+func (p *TokenUsage) SetOutputTokens(val *int64) {
+	p.OutputTokens = val
 }
 
-var fieldIDToName_EvaluatorFmtResult_ = map[int16]string{
-	1: "name",
-	2: "score",
+var fieldIDToName_TokenUsage = map[int16]string{
+	1: "input_tokens",
+	2: "output_tokens",
 }
 
-func (p *EvaluatorFmtResult_) IsSetName() bool {
-	return p.Name != nil
+func (p *TokenUsage) IsSetInputTokens() bool {
+	return p.InputTokens != nil
 }
 
-func (p *EvaluatorFmtResult_) IsSetScore() bool {
-	return p.Score != nil
+func (p *TokenUsage) IsSetOutputTokens() bool {
+	return p.OutputTokens != nil
 }
 
-func (p *EvaluatorFmtResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *TokenUsage) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7419,7 +8078,7 @@ func (p *EvaluatorFmtResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7427,7 +8086,7 @@ func (p *EvaluatorFmtResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7453,7 +8112,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFmtResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TokenUsage[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7463,32 +8122,32 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvaluatorFmtResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *TokenUsage) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Name = _field
+	p.InputTokens = _field
 	return nil
 }
-func (p *EvaluatorFmtResult_) ReadField2(iprot thrift.TProtocol) error {
+func (p *TokenUsage) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Score = _field
+	p.OutputTokens = _field
 	return nil
 }
 
-func (p *EvaluatorFmtResult_) Write(oprot thrift.TProtocol) (err error) {
+func (p *TokenUsage) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorFmtResult"); err != nil {
+	if err = oprot.WriteStructBegin("TokenUsage"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7518,12 +8177,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorFmtResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+func (p *TokenUsage) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputTokens() {
+		if err = oprot.WriteFieldBegin("input_tokens", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Name); err != nil {
+		if err := oprot.WriteI64(*p.InputTokens); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7536,12 +8195,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorFmtResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScore() {
-		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 2); err != nil {
+func (p *TokenUsage) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputTokens() {
+		if err = oprot.WriteFieldBegin("output_tokens", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.Score); err != nil {
+		if err := oprot.WriteI64(*p.OutputTokens); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7555,348 +8214,238 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *EvaluatorFmtResult_) String() string {
+func (p *TokenUsage) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorFmtResult_(%+v)", *p)
+	return fmt.Sprintf("TokenUsage(%+v)", *p)
 
 }
 
-func (p *EvaluatorFmtResult_) DeepEqual(ano *EvaluatorFmtResult_) bool {
+func (p *TokenUsage) DeepEqual(ano *TokenUsage) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Name) {
+	if !p.Field1DeepEqual(ano.InputTokens) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Score) {
+	if !p.Field2DeepEqual(ano.OutputTokens) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorFmtResult_) Field1DeepEqual(src *string) bool {
+func (p *TokenUsage) Field1DeepEqual(src *int64) bool {
 
-	if p.Name == src {
+	if p.InputTokens == src {
 		return true
-	} else if p.Name == nil || src == nil {
+	} else if p.InputTokens == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Name, *src) != 0 {
+	if *p.InputTokens != *src {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorFmtResult_) Field2DeepEqual(src *float64) bool {
+func (p *TokenUsage) Field2DeepEqual(src *int64) bool {
 
-	if p.Score == src {
+	if p.OutputTokens == src {
 		return true
-	} else if p.Score == nil || src == nil {
+	} else if p.OutputTokens == nil || src == nil {
 		return false
 	}
-	if *p.Score != *src {
+	if *p.OutputTokens != *src {
 		return false
 	}
 	return true
 }
 
-type TargetFieldMapping struct {
-	FromEvalSet []*FieldMapping `thrift:"from_eval_set,1,optional" frugal:"1,optional,list<FieldMapping>" form:"from_eval_set" json:"from_eval_set,omitempty" query:"from_eval_set"`
+type ExptStatistics struct {
+	EvaluatorAggregateResults []*EvaluatorAggregateResult_ `thrift:"evaluator_aggregate_results,1,optional" frugal:"1,optional,list<EvaluatorAggregateResult_>" form:"evaluator_aggregate_results" json:"evaluator_aggregate_results,omitempty" query:"evaluator_aggregate_results"`
+	TokenUsage                *TokenUsage                  `thrift:"token_usage,2,optional" frugal:"2,optional,TokenUsage" form:"token_usage" json:"token_usage,omitempty" query:"token_usage"`
+	CreditCost                *float64                     `thrift:"credit_cost,3,optional" frugal:"3,optional,double" form:"credit_cost" json:"credit_cost,omitempty" query:"credit_cost"`
+	PendingTurnCnt            *int32                       `thrift:"pending_turn_cnt,4,optional" frugal:"4,optional,i32" form:"pending_turn_cnt" json:"pending_turn_cnt,omitempty" query:"pending_turn_cnt"`
+	SuccessTurnCnt            *int32                       `thrift:"success_turn_cnt,5,optional" frugal:"5,optional,i32" form:"success_turn_cnt" json:"success_turn_cnt,omitempty" query:"success_turn_cnt"`
+	FailTurnCnt               *int32                       `thrift:"fail_turn_cnt,6,optional" frugal:"6,optional,i32" form:"fail_turn_cnt" json:"fail_turn_cnt,omitempty" query:"fail_turn_cnt"`
+	TerminatedTurnCnt         *int32                       `thrift:"terminated_turn_cnt,7,optional" frugal:"7,optional,i32" form:"terminated_turn_cnt" json:"terminated_turn_cnt,omitempty" query:"terminated_turn_cnt"`
+	ProcessingTurnCnt         *int32                       `thrift:"processing_turn_cnt,8,optional" frugal:"8,optional,i32" form:"processing_turn_cnt" json:"processing_turn_cnt,omitempty" query:"processing_turn_cnt"`
 }
 
-func NewTargetFieldMapping() *TargetFieldMapping {
-	return &TargetFieldMapping{}
+func NewExptStatistics() *ExptStatistics {
+	return &ExptStatistics{}
 }
 
-func (p *TargetFieldMapping) InitDefault() {
+func (p *ExptStatistics) InitDefault() {
 }
 
-var TargetFieldMapping_FromEvalSet_DEFAULT []*FieldMapping
+var ExptStatistics_EvaluatorAggregateResults_DEFAULT []*EvaluatorAggregateResult_
 
-func (p *TargetFieldMapping) GetFromEvalSet() (v []*FieldMapping) {
+func (p *ExptStatistics) GetEvaluatorAggregateResults() (v []*EvaluatorAggregateResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFromEvalSet() {
-		return TargetFieldMapping_FromEvalSet_DEFAULT
+	if !p.IsSetEvaluatorAggregateResults() {
+		return ExptStatistics_EvaluatorAggregateResults_DEFAULT
 	}
-	return p.FromEvalSet
-}
-func (p *TargetFieldMapping) SetFromEvalSet(val []*FieldMapping) {
-	p.FromEvalSet = val
+	return p.EvaluatorAggregateResults
 }
 
-var fieldIDToName_TargetFieldMapping = map[int16]string{
-	1: "from_eval_set",
-}
+var ExptStatistics_TokenUsage_DEFAULT *TokenUsage
 
-func (p *TargetFieldMapping) IsSetFromEvalSet() bool {
-	return p.FromEvalSet != nil
+func (p *ExptStatistics) GetTokenUsage() (v *TokenUsage) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTokenUsage() {
+		return ExptStatistics_TokenUsage_DEFAULT
+	}
+	return p.TokenUsage
 }
 
-func (p *TargetFieldMapping) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
+var ExptStatistics_CreditCost_DEFAULT float64
 
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *ExptStatistics) GetCreditCost() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCreditCost() {
+		return ExptStatistics_CreditCost_DEFAULT
 	}
+	return *p.CreditCost
+}
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
+var ExptStatistics_PendingTurnCnt_DEFAULT int32
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+func (p *ExptStatistics) GetPendingTurnCnt() (v int32) {
+	if p == nil {
+		return
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if !p.IsSetPendingTurnCnt() {
+		return ExptStatistics_PendingTurnCnt_DEFAULT
 	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TargetFieldMapping[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	return *p.PendingTurnCnt
 }
 
-func (p *TargetFieldMapping) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*FieldMapping, 0, size)
-	values := make([]FieldMapping, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+var ExptStatistics_SuccessTurnCnt_DEFAULT int32
 
-		_field = append(_field, _elem)
+func (p *ExptStatistics) GetSuccessTurnCnt() (v int32) {
+	if p == nil {
+		return
 	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
+	if !p.IsSetSuccessTurnCnt() {
+		return ExptStatistics_SuccessTurnCnt_DEFAULT
 	}
-	p.FromEvalSet = _field
-	return nil
+	return *p.SuccessTurnCnt
 }
 
-func (p *TargetFieldMapping) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("TargetFieldMapping"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+var ExptStatistics_FailTurnCnt_DEFAULT int32
+
+func (p *ExptStatistics) GetFailTurnCnt() (v int32) {
+	if p == nil {
+		return
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if !p.IsSetFailTurnCnt() {
+		return ExptStatistics_FailTurnCnt_DEFAULT
 	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+	return *p.FailTurnCnt
 }
 
-func (p *TargetFieldMapping) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFromEvalSet() {
-		if err = oprot.WriteFieldBegin("from_eval_set", thrift.LIST, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromEvalSet)); err != nil {
-			return err
-		}
-		for _, v := range p.FromEvalSet {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
+var ExptStatistics_TerminatedTurnCnt_DEFAULT int32
 
-func (p *TargetFieldMapping) String() string {
+func (p *ExptStatistics) GetTerminatedTurnCnt() (v int32) {
 	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("TargetFieldMapping(%+v)", *p)
-
-}
-
-func (p *TargetFieldMapping) DeepEqual(ano *TargetFieldMapping) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
+		return
 	}
-	if !p.Field1DeepEqual(ano.FromEvalSet) {
-		return false
+	if !p.IsSetTerminatedTurnCnt() {
+		return ExptStatistics_TerminatedTurnCnt_DEFAULT
 	}
-	return true
+	return *p.TerminatedTurnCnt
 }
 
-func (p *TargetFieldMapping) Field1DeepEqual(src []*FieldMapping) bool {
+var ExptStatistics_ProcessingTurnCnt_DEFAULT int32
 
-	if len(p.FromEvalSet) != len(src) {
-		return false
+func (p *ExptStatistics) GetProcessingTurnCnt() (v int32) {
+	if p == nil {
+		return
 	}
-	for i, v := range p.FromEvalSet {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if !p.IsSetProcessingTurnCnt() {
+		return ExptStatistics_ProcessingTurnCnt_DEFAULT
 	}
-	return true
+	return *p.ProcessingTurnCnt
 }
-
-type EvaluatorFieldMapping struct {
-	EvaluatorVersionID     int64                             `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
-	FromEvalSet            []*FieldMapping                   `thrift:"from_eval_set,2,optional" frugal:"2,optional,list<FieldMapping>" form:"from_eval_set" json:"from_eval_set,omitempty" query:"from_eval_set"`
-	FromTarget             []*FieldMapping                   `thrift:"from_target,3,optional" frugal:"3,optional,list<FieldMapping>" form:"from_target" json:"from_target,omitempty" query:"from_target"`
-	EvaluatorIDVersionItem *evaluator.EvaluatorIDVersionItem `thrift:"evaluator_id_version_item,4,optional" frugal:"4,optional,evaluator.EvaluatorIDVersionItem" form:"evaluator_id_version_item" json:"evaluator_id_version_item,omitempty" query:"evaluator_id_version_item"`
+func (p *ExptStatistics) SetEvaluatorAggregateResults(val []*EvaluatorAggregateResult_) {
+	p.EvaluatorAggregateResults = val
 }
-
-func NewEvaluatorFieldMapping() *EvaluatorFieldMapping {
-	return &EvaluatorFieldMapping{}
+func (p *ExptStatistics) SetTokenUsage(val *TokenUsage) {
+	p.TokenUsage = val
 }
-
-func (p *EvaluatorFieldMapping) InitDefault() {
+func (p *ExptStatistics) SetCreditCost(val *float64) {
+	p.CreditCost = val
 }
-
-func (p *EvaluatorFieldMapping) GetEvaluatorVersionID() (v int64) {
-	if p != nil {
-		return p.EvaluatorVersionID
-	}
-	return
+func (p *ExptStatistics) SetPendingTurnCnt(val *int32) {
+	p.PendingTurnCnt = val
 }
-
-var EvaluatorFieldMapping_FromEvalSet_DEFAULT []*FieldMapping
-
-func (p *EvaluatorFieldMapping) GetFromEvalSet() (v []*FieldMapping) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetFromEvalSet() {
-		return EvaluatorFieldMapping_FromEvalSet_DEFAULT
-	}
-	return p.FromEvalSet
+func (p *ExptStatistics) SetSuccessTurnCnt(val *int32) {
+	p.SuccessTurnCnt = val
 }
-
-var EvaluatorFieldMapping_FromTarget_DEFAULT []*FieldMapping
-
-func (p *EvaluatorFieldMapping) GetFromTarget() (v []*FieldMapping) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetFromTarget() {
-		return EvaluatorFieldMapping_FromTarget_DEFAULT
-	}
-	return p.FromTarget
+func (p *ExptStatistics) SetFailTurnCnt(val *int32) {
+	p.FailTurnCnt = val
+}
+func (p *ExptStatistics) SetTerminatedTurnCnt(val *int32) {
+	p.TerminatedTurnCnt = val
+}
+func (p *ExptStatistics) SetProcessingTurnCnt(val *int32) {
+	p.ProcessingTurnCnt = val
 }
 
-var EvaluatorFieldMapping_EvaluatorIDVersionItem_DEFAULT *evaluator.EvaluatorIDVersionItem
-
-func (p *EvaluatorFieldMapping) GetEvaluatorIDVersionItem() (v *evaluator.EvaluatorIDVersionItem) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEvaluatorIDVersionItem() {
-		return EvaluatorFieldMapping_EvaluatorIDVersionItem_DEFAULT
-	}
-	return p.EvaluatorIDVersionItem
+var fieldIDToName_ExptStatistics = map[int16]string{
+	1: "evaluator_aggregate_results",
+	2: "token_usage",
+	3: "credit_cost",
+	4: "pending_turn_cnt",
+	5: "success_turn_cnt",
+	6: "fail_turn_cnt",
+	7: "terminated_turn_cnt",
+	8: "processing_turn_cnt",
 }
-func (p *EvaluatorFieldMapping) SetEvaluatorVersionID(val int64) {
-	p.EvaluatorVersionID = val
+
+func (p *ExptStatistics) IsSetEvaluatorAggregateResults() bool {
+	return p.EvaluatorAggregateResults != nil
 }
-func (p *EvaluatorFieldMapping) SetFromEvalSet(val []*FieldMapping) {
-	p.FromEvalSet = val
+
+func (p *ExptStatistics) IsSetTokenUsage() bool {
+	return p.TokenUsage != nil
 }
-func (p *EvaluatorFieldMapping) SetFromTarget(val []*FieldMapping) {
-	p.FromTarget = val
+
+func (p *ExptStatistics) IsSetCreditCost() bool {
+	return p.CreditCost != nil
 }
-func (p *EvaluatorFieldMapping) SetEvaluatorIDVersionItem(val *evaluator.EvaluatorIDVersionItem) {
-	p.EvaluatorIDVersionItem = val
+
+func (p *ExptStatistics) IsSetPendingTurnCnt() bool {
+	return p.PendingTurnCnt != nil
 }
 
-var fieldIDToName_EvaluatorFieldMapping = map[int16]string{
-	1: "evaluator_version_id",
-	2: "from_eval_set",
-	3: "from_target",
-	4: "evaluator_id_version_item",
+func (p *ExptStatistics) IsSetSuccessTurnCnt() bool {
+	return p.SuccessTurnCnt != nil
 }
 
-func (p *EvaluatorFieldMapping) IsSetFromEvalSet() bool {
-	return p.FromEvalSet != nil
+func (p *ExptStatistics) IsSetFailTurnCnt() bool {
+	return p.FailTurnCnt != nil
 }
 
-func (p *EvaluatorFieldMapping) IsSetFromTarget() bool {
-	return p.FromTarget != nil
+func (p *ExptStatistics) IsSetTerminatedTurnCnt() bool {
+	return p.TerminatedTurnCnt != nil
 }
 
-func (p *EvaluatorFieldMapping) IsSetEvaluatorIDVersionItem() bool {
-	return p.EvaluatorIDVersionItem != nil
+func (p *ExptStatistics) IsSetProcessingTurnCnt() bool {
+	return p.ProcessingTurnCnt != nil
 }
 
-func (p *EvaluatorFieldMapping) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptStatistics) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetEvaluatorVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -7913,16 +8462,15 @@ func (p *EvaluatorFieldMapping) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7930,7 +8478,7 @@ func (p *EvaluatorFieldMapping) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7938,13 +8486,45 @@ func (p *EvaluatorFieldMapping) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7958,17 +8538,13 @@ func (p *EvaluatorFieldMapping) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetEvaluatorVersionID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFieldMapping[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptStatistics[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7976,28 +8552,15 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvaluatorFieldMapping[fieldId]))
 }
 
-func (p *EvaluatorFieldMapping) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.EvaluatorVersionID = _field
-	return nil
-}
-func (p *EvaluatorFieldMapping) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptStatistics) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*FieldMapping, 0, size)
-	values := make([]FieldMapping, size)
+	_field := make([]*EvaluatorAggregateResult_, 0, size)
+	values := make([]EvaluatorAggregateResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -8011,44 +8574,87 @@ func (p *EvaluatorFieldMapping) ReadField2(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.FromEvalSet = _field
+	p.EvaluatorAggregateResults = _field
 	return nil
 }
-func (p *EvaluatorFieldMapping) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *ExptStatistics) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewTokenUsage()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	_field := make([]*FieldMapping, 0, size)
-	values := make([]FieldMapping, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
+	p.TokenUsage = _field
+	return nil
+}
+func (p *ExptStatistics) ReadField3(iprot thrift.TProtocol) error {
 
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CreditCost = _field
+	return nil
+}
+func (p *ExptStatistics) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	p.PendingTurnCnt = _field
+	return nil
+}
+func (p *ExptStatistics) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.FromTarget = _field
+	p.SuccessTurnCnt = _field
 	return nil
 }
-func (p *EvaluatorFieldMapping) ReadField4(iprot thrift.TProtocol) error {
-	_field := evaluator.NewEvaluatorIDVersionItem()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExptStatistics) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvaluatorIDVersionItem = _field
+	p.FailTurnCnt = _field
 	return nil
 }
+func (p *ExptStatistics) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *EvaluatorFieldMapping) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TerminatedTurnCnt = _field
+	return nil
+}
+func (p *ExptStatistics) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ProcessingTurnCnt = _field
+	return nil
+}
+
+func (p *ExptStatistics) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorFieldMapping"); err != nil {
+	if err = oprot.WriteStructBegin("ExptStatistics"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8068,6 +8674,22 @@ func (p *EvaluatorFieldMapping) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8086,31 +8708,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorFieldMapping) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *EvaluatorFieldMapping) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFromEvalSet() {
-		if err = oprot.WriteFieldBegin("from_eval_set", thrift.LIST, 2); err != nil {
+func (p *ExptStatistics) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorAggregateResults() {
+		if err = oprot.WriteFieldBegin("evaluator_aggregate_results", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromEvalSet)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorAggregateResults)); err != nil {
 			return err
 		}
-		for _, v := range p.FromEvalSet {
+		for _, v := range p.EvaluatorAggregateResults {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -8124,24 +8730,34 @@ func (p *EvaluatorFieldMapping) writeField2(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorFieldMapping) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFromTarget() {
-		if err = oprot.WriteFieldBegin("from_target", thrift.LIST, 3); err != nil {
+func (p *ExptStatistics) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTokenUsage() {
+		if err = oprot.WriteFieldBegin("token_usage", thrift.STRUCT, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromTarget)); err != nil {
+		if err := p.TokenUsage.Write(oprot); err != nil {
 			return err
 		}
-		for _, v := range p.FromTarget {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptStatistics) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreditCost() {
+		if err = oprot.WriteFieldBegin("credit_cost", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CreditCost); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8154,12 +8770,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvaluatorFieldMapping) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorIDVersionItem() {
-		if err = oprot.WriteFieldBegin("evaluator_id_version_item", thrift.STRUCT, 4); err != nil {
+func (p *ExptStatistics) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPendingTurnCnt() {
+		if err = oprot.WriteFieldBegin("pending_turn_cnt", thrift.I32, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.EvaluatorIDVersionItem.Write(oprot); err != nil {
+		if err := oprot.WriteI32(*p.PendingTurnCnt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8172,62 +8788,126 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-
-func (p *EvaluatorFieldMapping) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("EvaluatorFieldMapping(%+v)", *p)
-
-}
-
-func (p *EvaluatorFieldMapping) DeepEqual(ano *EvaluatorFieldMapping) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.FromEvalSet) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.FromTarget) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.EvaluatorIDVersionItem) {
-		return false
+func (p *ExptStatistics) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccessTurnCnt() {
+		if err = oprot.WriteFieldBegin("success_turn_cnt", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.SuccessTurnCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return true
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-
-func (p *EvaluatorFieldMapping) Field1DeepEqual(src int64) bool {
-
-	if p.EvaluatorVersionID != src {
-		return false
+func (p *ExptStatistics) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFailTurnCnt() {
+		if err = oprot.WriteFieldBegin("fail_turn_cnt", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.FailTurnCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return true
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *EvaluatorFieldMapping) Field2DeepEqual(src []*FieldMapping) bool {
-
-	if len(p.FromEvalSet) != len(src) {
-		return false
+func (p *ExptStatistics) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTerminatedTurnCnt() {
+		if err = oprot.WriteFieldBegin("terminated_turn_cnt", thrift.I32, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.TerminatedTurnCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	for i, v := range p.FromEvalSet {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExptStatistics) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProcessingTurnCnt() {
+		if err = oprot.WriteFieldBegin("processing_turn_cnt", thrift.I32, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ProcessingTurnCnt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ExptStatistics) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptStatistics(%+v)", *p)
+
+}
+
+func (p *ExptStatistics) DeepEqual(ano *ExptStatistics) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorAggregateResults) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TokenUsage) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CreditCost) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.PendingTurnCnt) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.SuccessTurnCnt) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.FailTurnCnt) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.TerminatedTurnCnt) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ProcessingTurnCnt) {
+		return false
+	}
 	return true
 }
-func (p *EvaluatorFieldMapping) Field3DeepEqual(src []*FieldMapping) bool {
 
-	if len(p.FromTarget) != len(src) {
+func (p *ExptStatistics) Field1DeepEqual(src []*EvaluatorAggregateResult_) bool {
+
+	if len(p.EvaluatorAggregateResults) != len(src) {
 		return false
 	}
-	for i, v := range p.FromTarget {
+	for i, v := range p.EvaluatorAggregateResults {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -8235,91 +8915,142 @@ func (p *EvaluatorFieldMapping) Field3DeepEqual(src []*FieldMapping) bool {
 	}
 	return true
 }
-func (p *EvaluatorFieldMapping) Field4DeepEqual(src *evaluator.EvaluatorIDVersionItem) bool {
+func (p *ExptStatistics) Field2DeepEqual(src *TokenUsage) bool {
 
-	if !p.EvaluatorIDVersionItem.DeepEqual(src) {
+	if !p.TokenUsage.DeepEqual(src) {
 		return false
 	}
 	return true
 }
+func (p *ExptStatistics) Field3DeepEqual(src *float64) bool {
 
-type FieldMapping struct {
-	FieldName     *string `thrift:"field_name,1,optional" frugal:"1,optional,string" form:"field_name" json:"field_name,omitempty" query:"field_name"`
-	ConstValue    *string `thrift:"const_value,2,optional" frugal:"2,optional,string" form:"const_value" json:"const_value,omitempty" query:"const_value"`
-	FromFieldName *string `thrift:"from_field_name,3,optional" frugal:"3,optional,string" form:"from_field_name" json:"from_field_name,omitempty" query:"from_field_name"`
+	if p.CreditCost == src {
+		return true
+	} else if p.CreditCost == nil || src == nil {
+		return false
+	}
+	if *p.CreditCost != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptStatistics) Field4DeepEqual(src *int32) bool {
 
-func NewFieldMapping() *FieldMapping {
-	return &FieldMapping{}
+	if p.PendingTurnCnt == src {
+		return true
+	} else if p.PendingTurnCnt == nil || src == nil {
+		return false
+	}
+	if *p.PendingTurnCnt != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptStatistics) Field5DeepEqual(src *int32) bool {
 
-func (p *FieldMapping) InitDefault() {
+	if p.SuccessTurnCnt == src {
+		return true
+	} else if p.SuccessTurnCnt == nil || src == nil {
+		return false
+	}
+	if *p.SuccessTurnCnt != *src {
+		return false
+	}
+	return true
 }
+func (p *ExptStatistics) Field6DeepEqual(src *int32) bool {
 
-var FieldMapping_FieldName_DEFAULT string
+	if p.FailTurnCnt == src {
+		return true
+	} else if p.FailTurnCnt == nil || src == nil {
+		return false
+	}
+	if *p.FailTurnCnt != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptStatistics) Field7DeepEqual(src *int32) bool {
 
-func (p *FieldMapping) GetFieldName() (v string) {
-	if p == nil {
-		return
+	if p.TerminatedTurnCnt == src {
+		return true
+	} else if p.TerminatedTurnCnt == nil || src == nil {
+		return false
 	}
-	if !p.IsSetFieldName() {
-		return FieldMapping_FieldName_DEFAULT
+	if *p.TerminatedTurnCnt != *src {
+		return false
 	}
-	return *p.FieldName
+	return true
 }
+func (p *ExptStatistics) Field8DeepEqual(src *int32) bool {
 
-var FieldMapping_ConstValue_DEFAULT string
+	if p.ProcessingTurnCnt == src {
+		return true
+	} else if p.ProcessingTurnCnt == nil || src == nil {
+		return false
+	}
+	if *p.ProcessingTurnCnt != *src {
+		return false
+	}
+	return true
+}
 
-func (p *FieldMapping) GetConstValue() (v string) {
+type EvaluatorFmtResult_ struct {
+	Name  *string  `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Score *float64 `thrift:"score,2,optional" frugal:"2,optional,double" form:"score" json:"score,omitempty" query:"score"`
+}
+
+func NewEvaluatorFmtResult_() *EvaluatorFmtResult_ {
+	return &EvaluatorFmtResult_{}
+}
+
+func (p *EvaluatorFmtResult_) InitDefault() {
+}
+
+var EvaluatorFmtResult__Name_DEFAULT string
+
+func (p *EvaluatorFmtResult_) GetName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetConstValue() {
-		return FieldMapping_ConstValue_DEFAULT
+	if !p.IsSetName() {
+		return EvaluatorFmtResult__Name_DEFAULT
 	}
-	return *p.ConstValue
+	return *p.Name
 }
 
-var FieldMapping_FromFieldName_DEFAULT string
+var EvaluatorFmtResult__Score_DEFAULT float64
 
-func (p *FieldMapping) GetFromFieldName() (v string) {
+func (p *EvaluatorFmtResult_) GetScore() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFromFieldName() {
-		return FieldMapping_FromFieldName_DEFAULT
+	if !p.IsSetScore() {
+		return EvaluatorFmtResult__Score_DEFAULT
 	}
-	return *p.FromFieldName
-}
-func (p *FieldMapping) SetFieldName(val *string) {
-	p.FieldName = val
-}
-func (p *FieldMapping) SetConstValue(val *string) {
-	p.ConstValue = val
+	return *p.Score
 }
-func (p *FieldMapping) SetFromFieldName(val *string) {
-	p.FromFieldName = val
+func (p *EvaluatorFmtResult_) SetName(val *string) {
+	p.Name = val
 }
-
-var fieldIDToName_FieldMapping = map[int16]string{
-	1: "field_name",
-	2: "const_value",
-	3: "from_field_name",
+func (p *EvaluatorFmtResult_) SetScore(val *float64) {
+	p.Score = val
 }
 
-func (p *FieldMapping) IsSetFieldName() bool {
-	return p.FieldName != nil
+var fieldIDToName_EvaluatorFmtResult_ = map[int16]string{
+	1: "name",
+	2: "score",
 }
 
-func (p *FieldMapping) IsSetConstValue() bool {
-	return p.ConstValue != nil
+func (p *EvaluatorFmtResult_) IsSetName() bool {
+	return p.Name != nil
 }
 
-func (p *FieldMapping) IsSetFromFieldName() bool {
-	return p.FromFieldName != nil
+func (p *EvaluatorFmtResult_) IsSetScore() bool {
+	return p.Score != nil
 }
 
-func (p *FieldMapping) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorFmtResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8346,21 +9077,13 @@ func (p *FieldMapping) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8380,7 +9103,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FieldMapping[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFmtResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8390,7 +9113,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FieldMapping) ReadField1(iprot thrift.TProtocol) error {
+func (p *EvaluatorFmtResult_) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -8398,35 +9121,24 @@ func (p *FieldMapping) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.FieldName = _field
+	p.Name = _field
 	return nil
 }
-func (p *FieldMapping) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorFmtResult_) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ConstValue = _field
+	p.Score = _field
 	return nil
 }
-func (p *FieldMapping) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.FromFieldName = _field
-	return nil
-}
-
-func (p *FieldMapping) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorFmtResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FieldMapping"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorFmtResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8438,10 +9150,6 @@ func (p *FieldMapping) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8460,12 +9168,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FieldMapping) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFieldName() {
-		if err = oprot.WriteFieldBegin("field_name", thrift.STRING, 1); err != nil {
+func (p *EvaluatorFmtResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.FieldName); err != nil {
+		if err := oprot.WriteString(*p.Name); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8478,12 +9186,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *FieldMapping) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetConstValue() {
-		if err = oprot.WriteFieldBegin("const_value", thrift.STRING, 2); err != nil {
+func (p *EvaluatorFmtResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScore() {
+		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ConstValue); err != nil {
+		if err := oprot.WriteDouble(*p.Score); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8496,144 +9204,90 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *FieldMapping) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFromFieldName() {
-		if err = oprot.WriteFieldBegin("from_field_name", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.FromFieldName); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
 
-func (p *FieldMapping) String() string {
+func (p *EvaluatorFmtResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FieldMapping(%+v)", *p)
+	return fmt.Sprintf("EvaluatorFmtResult_(%+v)", *p)
 
 }
 
-func (p *FieldMapping) DeepEqual(ano *FieldMapping) bool {
+func (p *EvaluatorFmtResult_) DeepEqual(ano *EvaluatorFmtResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.FieldName) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.ConstValue) {
+	if !p.Field1DeepEqual(ano.Name) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.FromFieldName) {
+	if !p.Field2DeepEqual(ano.Score) {
 		return false
 	}
 	return true
 }
 
-func (p *FieldMapping) Field1DeepEqual(src *string) bool {
-
-	if p.FieldName == src {
-		return true
-	} else if p.FieldName == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.FieldName, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *FieldMapping) Field2DeepEqual(src *string) bool {
+func (p *EvaluatorFmtResult_) Field1DeepEqual(src *string) bool {
 
-	if p.ConstValue == src {
+	if p.Name == src {
 		return true
-	} else if p.ConstValue == nil || src == nil {
+	} else if p.Name == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.ConstValue, *src) != 0 {
+	if strings.Compare(*p.Name, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *FieldMapping) Field3DeepEqual(src *string) bool {
+func (p *EvaluatorFmtResult_) Field2DeepEqual(src *float64) bool {
 
-	if p.FromFieldName == src {
+	if p.Score == src {
 		return true
-	} else if p.FromFieldName == nil || src == nil {
+	} else if p.Score == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.FromFieldName, *src) != 0 {
+	if *p.Score != *src {
 		return false
 	}
 	return true
 }
 
-type ExptFilterOption struct {
-	FuzzyName *string  `thrift:"fuzzy_name,1,optional" frugal:"1,optional,string" form:"fuzzy_name" json:"fuzzy_name,omitempty" query:"fuzzy_name"`
-	Filters   *Filters `thrift:"filters,10,optional" frugal:"10,optional,Filters" form:"filters" json:"filters,omitempty" query:"filters"`
-}
-
-func NewExptFilterOption() *ExptFilterOption {
-	return &ExptFilterOption{}
+type TargetFieldMapping struct {
+	FromEvalSet []*FieldMapping `thrift:"from_eval_set,1,optional" frugal:"1,optional,list<FieldMapping>" form:"from_eval_set" json:"from_eval_set,omitempty" query:"from_eval_set"`
 }
 
-func (p *ExptFilterOption) InitDefault() {
+func NewTargetFieldMapping() *TargetFieldMapping {
+	return &TargetFieldMapping{}
 }
 
-var ExptFilterOption_FuzzyName_DEFAULT string
-
-func (p *ExptFilterOption) GetFuzzyName() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetFuzzyName() {
-		return ExptFilterOption_FuzzyName_DEFAULT
-	}
-	return *p.FuzzyName
+func (p *TargetFieldMapping) InitDefault() {
 }
 
-var ExptFilterOption_Filters_DEFAULT *Filters
+var TargetFieldMapping_FromEvalSet_DEFAULT []*FieldMapping
 
-func (p *ExptFilterOption) GetFilters() (v *Filters) {
+func (p *TargetFieldMapping) GetFromEvalSet() (v []*FieldMapping) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFilters() {
-		return ExptFilterOption_Filters_DEFAULT
+	if !p.IsSetFromEvalSet() {
+		return TargetFieldMapping_FromEvalSet_DEFAULT
 	}
-	return p.Filters
-}
-func (p *ExptFilterOption) SetFuzzyName(val *string) {
-	p.FuzzyName = val
-}
-func (p *ExptFilterOption) SetFilters(val *Filters) {
-	p.Filters = val
+	return p.FromEvalSet
 }
-
-var fieldIDToName_ExptFilterOption = map[int16]string{
-	1:  "fuzzy_name",
-	10: "filters",
+func (p *TargetFieldMapping) SetFromEvalSet(val []*FieldMapping) {
+	p.FromEvalSet = val
 }
 
-func (p *ExptFilterOption) IsSetFuzzyName() bool {
-	return p.FuzzyName != nil
+var fieldIDToName_TargetFieldMapping = map[int16]string{
+	1: "from_eval_set",
 }
 
-func (p *ExptFilterOption) IsSetFilters() bool {
-	return p.Filters != nil
+func (p *TargetFieldMapping) IsSetFromEvalSet() bool {
+	return p.FromEvalSet != nil
 }
 
-func (p *ExptFilterOption) Read(iprot thrift.TProtocol) (err error) {
+func (p *TargetFieldMapping) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8652,21 +9306,13 @@ func (p *ExptFilterOption) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 10:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8686,7 +9332,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptFilterOption[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TargetFieldMapping[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8696,29 +9342,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExptFilterOption) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TargetFieldMapping) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.FuzzyName = _field
-	return nil
-}
-func (p *ExptFilterOption) ReadField10(iprot thrift.TProtocol) error {
-	_field := NewFilters()
-	if err := _field.Read(iprot); err != nil {
+	_field := make([]*FieldMapping, 0, size)
+	values := make([]FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Filters = _field
+	p.FromEvalSet = _field
 	return nil
 }
 
-func (p *ExptFilterOption) Write(oprot thrift.TProtocol) (err error) {
+func (p *TargetFieldMapping) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptFilterOption"); err != nil {
+	if err = oprot.WriteStructBegin("TargetFieldMapping"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8726,10 +9376,6 @@ func (p *ExptFilterOption) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8748,30 +9394,20 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptFilterOption) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFuzzyName() {
-		if err = oprot.WriteFieldBegin("fuzzy_name", thrift.STRING, 1); err != nil {
+func (p *TargetFieldMapping) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromEvalSet() {
+		if err = oprot.WriteFieldBegin("from_eval_set", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.FuzzyName); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromEvalSet)); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ExptFilterOption) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilters() {
-		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 10); err != nil {
-			goto WriteFieldBeginError
+		for _, v := range p.FromEvalSet {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
 		}
-		if err := p.Filters.Write(oprot); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8780,133 +9416,137 @@ func (p *ExptFilterOption) writeField10(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExptFilterOption) String() string {
+func (p *TargetFieldMapping) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptFilterOption(%+v)", *p)
+	return fmt.Sprintf("TargetFieldMapping(%+v)", *p)
 
 }
 
-func (p *ExptFilterOption) DeepEqual(ano *ExptFilterOption) bool {
+func (p *TargetFieldMapping) DeepEqual(ano *TargetFieldMapping) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.FuzzyName) {
-		return false
-	}
-	if !p.Field10DeepEqual(ano.Filters) {
+	if !p.Field1DeepEqual(ano.FromEvalSet) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptFilterOption) Field1DeepEqual(src *string) bool {
+func (p *TargetFieldMapping) Field1DeepEqual(src []*FieldMapping) bool {
 
-	if p.FuzzyName == src {
-		return true
-	} else if p.FuzzyName == nil || src == nil {
+	if len(p.FromEvalSet) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.FuzzyName, *src) != 0 {
-		return false
+	for i, v := range p.FromEvalSet {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *ExptFilterOption) Field10DeepEqual(src *Filters) bool {
 
-	if !p.Filters.DeepEqual(src) {
-		return false
-	}
-	return true
+type EvaluatorFieldMapping struct {
+	EvaluatorVersionID     int64                             `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
+	FromEvalSet            []*FieldMapping                   `thrift:"from_eval_set,2,optional" frugal:"2,optional,list<FieldMapping>" form:"from_eval_set" json:"from_eval_set,omitempty" query:"from_eval_set"`
+	FromTarget             []*FieldMapping                   `thrift:"from_target,3,optional" frugal:"3,optional,list<FieldMapping>" form:"from_target" json:"from_target,omitempty" query:"from_target"`
+	EvaluatorIDVersionItem *evaluator.EvaluatorIDVersionItem `thrift:"evaluator_id_version_item,4,optional" frugal:"4,optional,evaluator.EvaluatorIDVersionItem" form:"evaluator_id_version_item" json:"evaluator_id_version_item,omitempty" query:"evaluator_id_version_item"`
 }
 
-type ItemSystemInfo struct {
-	RunState *ItemRunState `thrift:"run_state,1,optional" frugal:"1,optional,ItemRunState" form:"run_state" json:"run_state,omitempty" query:"run_state"`
-	LogID    *string       `thrift:"log_id,2,optional" frugal:"2,optional,string" form:"log_id" json:"log_id,omitempty" query:"log_id"`
-	Error    *RunError     `thrift:"error,3,optional" frugal:"3,optional,RunError" form:"error" json:"error,omitempty" query:"error"`
+func NewEvaluatorFieldMapping() *EvaluatorFieldMapping {
+	return &EvaluatorFieldMapping{}
 }
 
-func NewItemSystemInfo() *ItemSystemInfo {
-	return &ItemSystemInfo{}
+func (p *EvaluatorFieldMapping) InitDefault() {
 }
 
-func (p *ItemSystemInfo) InitDefault() {
+func (p *EvaluatorFieldMapping) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
+	}
+	return
 }
 
-var ItemSystemInfo_RunState_DEFAULT ItemRunState
+var EvaluatorFieldMapping_FromEvalSet_DEFAULT []*FieldMapping
 
-func (p *ItemSystemInfo) GetRunState() (v ItemRunState) {
+func (p *EvaluatorFieldMapping) GetFromEvalSet() (v []*FieldMapping) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRunState() {
-		return ItemSystemInfo_RunState_DEFAULT
+	if !p.IsSetFromEvalSet() {
+		return EvaluatorFieldMapping_FromEvalSet_DEFAULT
 	}
-	return *p.RunState
+	return p.FromEvalSet
 }
 
-var ItemSystemInfo_LogID_DEFAULT string
+var EvaluatorFieldMapping_FromTarget_DEFAULT []*FieldMapping
 
-func (p *ItemSystemInfo) GetLogID() (v string) {
+func (p *EvaluatorFieldMapping) GetFromTarget() (v []*FieldMapping) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetLogID() {
-		return ItemSystemInfo_LogID_DEFAULT
+	if !p.IsSetFromTarget() {
+		return EvaluatorFieldMapping_FromTarget_DEFAULT
 	}
-	return *p.LogID
+	return p.FromTarget
 }
 
-var ItemSystemInfo_Error_DEFAULT *RunError
+var EvaluatorFieldMapping_EvaluatorIDVersionItem_DEFAULT *evaluator.EvaluatorIDVersionItem
 
-func (p *ItemSystemInfo) GetError() (v *RunError) {
+func (p *EvaluatorFieldMapping) GetEvaluatorIDVersionItem() (v *evaluator.EvaluatorIDVersionItem) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetError() {
-		return ItemSystemInfo_Error_DEFAULT
+	if !p.IsSetEvaluatorIDVersionItem() {
+		return EvaluatorFieldMapping_EvaluatorIDVersionItem_DEFAULT
 	}
-	return p.Error
+	return p.EvaluatorIDVersionItem
 }
-func (p *ItemSystemInfo) SetRunState(val *ItemRunState) {
-	p.RunState = val
+func (p *EvaluatorFieldMapping) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
 }
-func (p *ItemSystemInfo) SetLogID(val *string) {
-	p.LogID = val
+func (p *EvaluatorFieldMapping) SetFromEvalSet(val []*FieldMapping) {
+	p.FromEvalSet = val
 }
-func (p *ItemSystemInfo) SetError(val *RunError) {
-	p.Error = val
+func (p *EvaluatorFieldMapping) SetFromTarget(val []*FieldMapping) {
+	p.FromTarget = val
+}
+func (p *EvaluatorFieldMapping) SetEvaluatorIDVersionItem(val *evaluator.EvaluatorIDVersionItem) {
+	p.EvaluatorIDVersionItem = val
 }
 
-var fieldIDToName_ItemSystemInfo = map[int16]string{
-	1: "run_state",
-	2: "log_id",
-	3: "error",
+var fieldIDToName_EvaluatorFieldMapping = map[int16]string{
+	1: "evaluator_version_id",
+	2: "from_eval_set",
+	3: "from_target",
+	4: "evaluator_id_version_item",
 }
 
-func (p *ItemSystemInfo) IsSetRunState() bool {
-	return p.RunState != nil
+func (p *EvaluatorFieldMapping) IsSetFromEvalSet() bool {
+	return p.FromEvalSet != nil
 }
 
-func (p *ItemSystemInfo) IsSetLogID() bool {
-	return p.LogID != nil
+func (p *EvaluatorFieldMapping) IsSetFromTarget() bool {
+	return p.FromTarget != nil
 }
 
-func (p *ItemSystemInfo) IsSetError() bool {
-	return p.Error != nil
+func (p *EvaluatorFieldMapping) IsSetEvaluatorIDVersionItem() bool {
+	return p.EvaluatorIDVersionItem != nil
 }
 
-func (p *ItemSystemInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorFieldMapping) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetEvaluatorVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -8923,15 +9563,16 @@ func (p *ItemSystemInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8939,13 +9580,21 @@ func (p *ItemSystemInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8959,13 +9608,17 @@ func (p *ItemSystemInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ItemSystemInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFieldMapping[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8973,43 +9626,79 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvaluatorFieldMapping[fieldId]))
 }
 
-func (p *ItemSystemInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *EvaluatorFieldMapping) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *ItemRunState
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		tmp := ItemRunState(v)
-		_field = &tmp
+		_field = v
 	}
-	p.RunState = _field
+	p.EvaluatorVersionID = _field
 	return nil
 }
-func (p *ItemSystemInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorFieldMapping) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldMapping, 0, size)
+	values := make([]FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.LogID = _field
+	p.FromEvalSet = _field
 	return nil
 }
-func (p *ItemSystemInfo) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewRunError()
+func (p *EvaluatorFieldMapping) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldMapping, 0, size)
+	values := make([]FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FromTarget = _field
+	return nil
+}
+func (p *EvaluatorFieldMapping) ReadField4(iprot thrift.TProtocol) error {
+	_field := evaluator.NewEvaluatorIDVersionItem()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Error = _field
+	p.EvaluatorIDVersionItem = _field
 	return nil
 }
 
-func (p *ItemSystemInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorFieldMapping) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ItemSystemInfo"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorFieldMapping"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9025,6 +9714,10 @@ func (p *ItemSystemInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9043,12 +9736,36 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ItemSystemInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRunState() {
-		if err = oprot.WriteFieldBegin("run_state", thrift.I32, 1); err != nil {
+func (p *EvaluatorFieldMapping) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorFieldMapping) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromEvalSet() {
+		if err = oprot.WriteFieldBegin("from_eval_set", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.RunState)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromEvalSet)); err != nil {
+			return err
+		}
+		for _, v := range p.FromEvalSet {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -9057,16 +9774,24 @@ func (p *ItemSystemInfo) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ItemSystemInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLogID() {
-		if err = oprot.WriteFieldBegin("log_id", thrift.STRING, 2); err != nil {
+func (p *EvaluatorFieldMapping) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromTarget() {
+		if err = oprot.WriteFieldBegin("from_target", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.LogID); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FromTarget)); err != nil {
+			return err
+		}
+		for _, v := range p.FromTarget {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -9075,16 +9800,16 @@ func (p *ItemSystemInfo) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ItemSystemInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetError() {
-		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 3); err != nil {
+func (p *EvaluatorFieldMapping) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorIDVersionItem() {
+		if err = oprot.WriteFieldBegin("evaluator_id_version_item", thrift.STRUCT, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Error.Write(oprot); err != nil {
+		if err := p.EvaluatorIDVersionItem.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -9093,119 +9818,160 @@ func (p *ItemSystemInfo) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ItemSystemInfo) String() string {
+func (p *EvaluatorFieldMapping) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ItemSystemInfo(%+v)", *p)
+	return fmt.Sprintf("EvaluatorFieldMapping(%+v)", *p)
 
 }
 
-func (p *ItemSystemInfo) DeepEqual(ano *ItemSystemInfo) bool {
+func (p *EvaluatorFieldMapping) DeepEqual(ano *EvaluatorFieldMapping) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.RunState) {
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.LogID) {
+	if !p.Field2DeepEqual(ano.FromEvalSet) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Error) {
+	if !p.Field3DeepEqual(ano.FromTarget) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluatorIDVersionItem) {
 		return false
 	}
 	return true
 }
 
-func (p *ItemSystemInfo) Field1DeepEqual(src *ItemRunState) bool {
+func (p *EvaluatorFieldMapping) Field1DeepEqual(src int64) bool {
 
-	if p.RunState == src {
-		return true
-	} else if p.RunState == nil || src == nil {
+	if p.EvaluatorVersionID != src {
 		return false
 	}
-	if *p.RunState != *src {
+	return true
+}
+func (p *EvaluatorFieldMapping) Field2DeepEqual(src []*FieldMapping) bool {
+
+	if len(p.FromEvalSet) != len(src) {
 		return false
 	}
+	for i, v := range p.FromEvalSet {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
-func (p *ItemSystemInfo) Field2DeepEqual(src *string) bool {
+func (p *EvaluatorFieldMapping) Field3DeepEqual(src []*FieldMapping) bool {
 
-	if p.LogID == src {
-		return true
-	} else if p.LogID == nil || src == nil {
+	if len(p.FromTarget) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.LogID, *src) != 0 {
-		return false
+	for i, v := range p.FromTarget {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *ItemSystemInfo) Field3DeepEqual(src *RunError) bool {
+func (p *EvaluatorFieldMapping) Field4DeepEqual(src *evaluator.EvaluatorIDVersionItem) bool {
 
-	if !p.Error.DeepEqual(src) {
+	if !p.EvaluatorIDVersionItem.DeepEqual(src) {
 		return false
 	}
 	return true
 }
 
-type ExptColumnEvaluator struct {
-	ExperimentID     int64              `thrift:"experiment_id,1,required" frugal:"1,required,i64" json:"experiment_id" form:"experiment_id,required" query:"experiment_id,required"`
-	ColumnEvaluators []*ColumnEvaluator `thrift:"column_evaluators,2,optional" frugal:"2,optional,list<ColumnEvaluator>" form:"column_evaluators" json:"column_evaluators,omitempty" query:"column_evaluators"`
+type FieldMapping struct {
+	FieldName     *string `thrift:"field_name,1,optional" frugal:"1,optional,string" form:"field_name" json:"field_name,omitempty" query:"field_name"`
+	ConstValue    *string `thrift:"const_value,2,optional" frugal:"2,optional,string" form:"const_value" json:"const_value,omitempty" query:"const_value"`
+	FromFieldName *string `thrift:"from_field_name,3,optional" frugal:"3,optional,string" form:"from_field_name" json:"from_field_name,omitempty" query:"from_field_name"`
 }
 
-func NewExptColumnEvaluator() *ExptColumnEvaluator {
-	return &ExptColumnEvaluator{}
+func NewFieldMapping() *FieldMapping {
+	return &FieldMapping{}
 }
 
-func (p *ExptColumnEvaluator) InitDefault() {
+func (p *FieldMapping) InitDefault() {
 }
 
-func (p *ExptColumnEvaluator) GetExperimentID() (v int64) {
-	if p != nil {
-		return p.ExperimentID
+var FieldMapping_FieldName_DEFAULT string
+
+func (p *FieldMapping) GetFieldName() (v string) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetFieldName() {
+		return FieldMapping_FieldName_DEFAULT
+	}
+	return *p.FieldName
 }
 
-var ExptColumnEvaluator_ColumnEvaluators_DEFAULT []*ColumnEvaluator
+var FieldMapping_ConstValue_DEFAULT string
 
-func (p *ExptColumnEvaluator) GetColumnEvaluators() (v []*ColumnEvaluator) {
+func (p *FieldMapping) GetConstValue() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetColumnEvaluators() {
-		return ExptColumnEvaluator_ColumnEvaluators_DEFAULT
+	if !p.IsSetConstValue() {
+		return FieldMapping_ConstValue_DEFAULT
 	}
-	return p.ColumnEvaluators
+	return *p.ConstValue
 }
-func (p *ExptColumnEvaluator) SetExperimentID(val int64) {
-	p.ExperimentID = val
+
+var FieldMapping_FromFieldName_DEFAULT string
+
+func (p *FieldMapping) GetFromFieldName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFromFieldName() {
+		return FieldMapping_FromFieldName_DEFAULT
+	}
+	return *p.FromFieldName
 }
-func (p *ExptColumnEvaluator) SetColumnEvaluators(val []*ColumnEvaluator) {
-	p.ColumnEvaluators = val
+func (p *FieldMapping) SetFieldName(val *string) {
+	p.FieldName = val
+}
+func (p *FieldMapping) SetConstValue(val *string) {
+	p.ConstValue = val
+}
+func (p *FieldMapping) SetFromFieldName(val *string) {
+	p.FromFieldName = val
 }
 
-var fieldIDToName_ExptColumnEvaluator = map[int16]string{
-	1: "experiment_id",
-	2: "column_evaluators",
+var fieldIDToName_FieldMapping = map[int16]string{
+	1: "field_name",
+	2: "const_value",
+	3: "from_field_name",
 }
 
-func (p *ExptColumnEvaluator) IsSetColumnEvaluators() bool {
-	return p.ColumnEvaluators != nil
+func (p *FieldMapping) IsSetFieldName() bool {
+	return p.FieldName != nil
 }
 
-func (p *ExptColumnEvaluator) Read(iprot thrift.TProtocol) (err error) {
+func (p *FieldMapping) IsSetConstValue() bool {
+	return p.ConstValue != nil
+}
+
+func (p *FieldMapping) IsSetFromFieldName() bool {
+	return p.FromFieldName != nil
+}
+
+func (p *FieldMapping) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExperimentID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9222,22 +9988,29 @@ func (p *ExptColumnEvaluator) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetExperimentID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -9251,17 +10024,13 @@ func (p *ExptColumnEvaluator) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetExperimentID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnEvaluator[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FieldMapping[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9269,48 +10038,45 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptColumnEvaluator[fieldId]))
 }
 
-func (p *ExptColumnEvaluator) ReadField1(iprot thrift.TProtocol) error {
+func (p *FieldMapping) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ExperimentID = _field
+	p.FieldName = _field
 	return nil
 }
-func (p *ExptColumnEvaluator) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *FieldMapping) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*ColumnEvaluator, 0, size)
-	values := make([]ColumnEvaluator, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.ConstValue = _field
+	return nil
+}
+func (p *FieldMapping) ReadField3(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.ColumnEvaluators = _field
+	p.FromFieldName = _field
 	return nil
 }
 
-func (p *ExptColumnEvaluator) Write(oprot thrift.TProtocol) (err error) {
+func (p *FieldMapping) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptColumnEvaluator"); err != nil {
+	if err = oprot.WriteStructBegin("FieldMapping"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9322,6 +10088,10 @@ func (p *ExptColumnEvaluator) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9340,15 +10110,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptColumnEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExperimentID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *FieldMapping) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldName() {
+		if err = oprot.WriteFieldBegin("field_name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FieldName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -9356,20 +10128,30 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptColumnEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetColumnEvaluators() {
-		if err = oprot.WriteFieldBegin("column_evaluators", thrift.LIST, 2); err != nil {
+func (p *FieldMapping) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConstValue() {
+		if err = oprot.WriteFieldBegin("const_value", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ColumnEvaluators)); err != nil {
+		if err := oprot.WriteString(*p.ConstValue); err != nil {
 			return err
 		}
-		for _, v := range p.ColumnEvaluators {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *FieldMapping) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromFieldName() {
+		if err = oprot.WriteFieldBegin("from_field_name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FromFieldName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -9378,194 +10160,132 @@ func (p *ExptColumnEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ExptColumnEvaluator) String() string {
+func (p *FieldMapping) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptColumnEvaluator(%+v)", *p)
+	return fmt.Sprintf("FieldMapping(%+v)", *p)
 
 }
 
-func (p *ExptColumnEvaluator) DeepEqual(ano *ExptColumnEvaluator) bool {
+func (p *FieldMapping) DeepEqual(ano *FieldMapping) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ExperimentID) {
+	if !p.Field1DeepEqual(ano.FieldName) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.ColumnEvaluators) {
+	if !p.Field2DeepEqual(ano.ConstValue) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.FromFieldName) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptColumnEvaluator) Field1DeepEqual(src int64) bool {
+func (p *FieldMapping) Field1DeepEqual(src *string) bool {
 
-	if p.ExperimentID != src {
+	if p.FieldName == src {
+		return true
+	} else if p.FieldName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.FieldName, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExptColumnEvaluator) Field2DeepEqual(src []*ColumnEvaluator) bool {
+func (p *FieldMapping) Field2DeepEqual(src *string) bool {
 
-	if len(p.ColumnEvaluators) != len(src) {
+	if p.ConstValue == src {
+		return true
+	} else if p.ConstValue == nil || src == nil {
 		return false
 	}
-	for i, v := range p.ColumnEvaluators {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.ConstValue, *src) != 0 {
+		return false
 	}
 	return true
 }
+func (p *FieldMapping) Field3DeepEqual(src *string) bool {
 
-type ColumnEvaluator struct {
-	EvaluatorVersionID int64                   `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
-	EvaluatorID        int64                   `thrift:"evaluator_id,2,required" frugal:"2,required,i64" json:"evaluator_id" form:"evaluator_id,required" query:"evaluator_id,required"`
-	EvaluatorType      evaluator.EvaluatorType `thrift:"evaluator_type,3,required" frugal:"3,required,EvaluatorType" form:"evaluator_type,required" json:"evaluator_type,required" query:"evaluator_type,required"`
-	Name               *string                 `thrift:"name,4,optional" frugal:"4,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	Version            *string                 `thrift:"version,5,optional" frugal:"5,optional,string" form:"version" json:"version,omitempty" query:"version"`
-	Description        *string                 `thrift:"description,6,optional" frugal:"6,optional,string" form:"description" json:"description,omitempty" query:"description"`
-	Builtin            *bool                   `thrift:"builtin,7,optional" frugal:"7,optional,bool" form:"builtin" json:"builtin,omitempty" query:"builtin"`
-}
-
-func NewColumnEvaluator() *ColumnEvaluator {
-	return &ColumnEvaluator{}
-}
-
-func (p *ColumnEvaluator) InitDefault() {
-}
-
-func (p *ColumnEvaluator) GetEvaluatorVersionID() (v int64) {
-	if p != nil {
-		return p.EvaluatorVersionID
+	if p.FromFieldName == src {
+		return true
+	} else if p.FromFieldName == nil || src == nil {
+		return false
 	}
-	return
-}
-
-func (p *ColumnEvaluator) GetEvaluatorID() (v int64) {
-	if p != nil {
-		return p.EvaluatorID
+	if strings.Compare(*p.FromFieldName, *src) != 0 {
+		return false
 	}
-	return
+	return true
 }
 
-func (p *ColumnEvaluator) GetEvaluatorType() (v evaluator.EvaluatorType) {
-	if p != nil {
-		return p.EvaluatorType
-	}
-	return
+type ExptFilterOption struct {
+	FuzzyName *string  `thrift:"fuzzy_name,1,optional" frugal:"1,optional,string" form:"fuzzy_name" json:"fuzzy_name,omitempty" query:"fuzzy_name"`
+	Filters   *Filters `thrift:"filters,10,optional" frugal:"10,optional,Filters" form:"filters" json:"filters,omitempty" query:"filters"`
 }
 
-var ColumnEvaluator_Name_DEFAULT string
-
-func (p *ColumnEvaluator) GetName() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetName() {
-		return ColumnEvaluator_Name_DEFAULT
-	}
-	return *p.Name
+func NewExptFilterOption() *ExptFilterOption {
+	return &ExptFilterOption{}
 }
 
-var ColumnEvaluator_Version_DEFAULT string
-
-func (p *ColumnEvaluator) GetVersion() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetVersion() {
-		return ColumnEvaluator_Version_DEFAULT
-	}
-	return *p.Version
+func (p *ExptFilterOption) InitDefault() {
 }
 
-var ColumnEvaluator_Description_DEFAULT string
+var ExptFilterOption_FuzzyName_DEFAULT string
 
-func (p *ColumnEvaluator) GetDescription() (v string) {
+func (p *ExptFilterOption) GetFuzzyName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDescription() {
-		return ColumnEvaluator_Description_DEFAULT
+	if !p.IsSetFuzzyName() {
+		return ExptFilterOption_FuzzyName_DEFAULT
 	}
-	return *p.Description
+	return *p.FuzzyName
 }
 
-var ColumnEvaluator_Builtin_DEFAULT bool
+var ExptFilterOption_Filters_DEFAULT *Filters
 
-func (p *ColumnEvaluator) GetBuiltin() (v bool) {
+func (p *ExptFilterOption) GetFilters() (v *Filters) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetBuiltin() {
-		return ColumnEvaluator_Builtin_DEFAULT
+	if !p.IsSetFilters() {
+		return ExptFilterOption_Filters_DEFAULT
 	}
-	return *p.Builtin
-}
-func (p *ColumnEvaluator) SetEvaluatorVersionID(val int64) {
-	p.EvaluatorVersionID = val
-}
-func (p *ColumnEvaluator) SetEvaluatorID(val int64) {
-	p.EvaluatorID = val
-}
-func (p *ColumnEvaluator) SetEvaluatorType(val evaluator.EvaluatorType) {
-	p.EvaluatorType = val
-}
-func (p *ColumnEvaluator) SetName(val *string) {
-	p.Name = val
-}
-func (p *ColumnEvaluator) SetVersion(val *string) {
-	p.Version = val
-}
-func (p *ColumnEvaluator) SetDescription(val *string) {
-	p.Description = val
-}
-func (p *ColumnEvaluator) SetBuiltin(val *bool) {
-	p.Builtin = val
+	return p.Filters
 }
-
-var fieldIDToName_ColumnEvaluator = map[int16]string{
-	1: "evaluator_version_id",
-	2: "evaluator_id",
-	3: "evaluator_type",
-	4: "name",
-	5: "version",
-	6: "description",
-	7: "builtin",
+func (p *ExptFilterOption) SetFuzzyName(val *string) {
+	p.FuzzyName = val
 }
-
-func (p *ColumnEvaluator) IsSetName() bool {
-	return p.Name != nil
+func (p *ExptFilterOption) SetFilters(val *Filters) {
+	p.Filters = val
 }
 
-func (p *ColumnEvaluator) IsSetVersion() bool {
-	return p.Version != nil
+var fieldIDToName_ExptFilterOption = map[int16]string{
+	1:  "fuzzy_name",
+	10: "filters",
 }
 
-func (p *ColumnEvaluator) IsSetDescription() bool {
-	return p.Description != nil
+func (p *ExptFilterOption) IsSetFuzzyName() bool {
+	return p.FuzzyName != nil
 }
 
-func (p *ColumnEvaluator) IsSetBuiltin() bool {
-	return p.Builtin != nil
+func (p *ExptFilterOption) IsSetFilters() bool {
+	return p.Filters != nil
 }
 
-func (p *ColumnEvaluator) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptFilterOption) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetEvaluatorVersionID bool = false
-	var issetEvaluatorID bool = false
-	var issetEvaluatorType bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9582,59 +10302,16 @@ func (p *ColumnEvaluator) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetEvaluatorVersionID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetEvaluatorID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetEvaluatorType = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 7:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField7(iprot); err != nil {
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -9653,27 +10330,13 @@ func (p *ColumnEvaluator) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetEvaluatorVersionID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluatorID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluatorType {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvaluator[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptFilterOption[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9681,91 +10344,31 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ColumnEvaluator[fieldId]))
 }
 
-func (p *ColumnEvaluator) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptFilterOption) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.EvaluatorVersionID = _field
+	p.FuzzyName = _field
 	return nil
 }
-func (p *ColumnEvaluator) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *ExptFilterOption) ReadField10(iprot thrift.TProtocol) error {
+	_field := NewFilters()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
-	}
-	p.EvaluatorID = _field
-	return nil
-}
-func (p *ColumnEvaluator) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field evaluator.EvaluatorType
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = evaluator.EvaluatorType(v)
-	}
-	p.EvaluatorType = _field
-	return nil
-}
-func (p *ColumnEvaluator) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Name = _field
-	return nil
-}
-func (p *ColumnEvaluator) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Version = _field
-	return nil
-}
-func (p *ColumnEvaluator) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Description = _field
-	return nil
-}
-func (p *ColumnEvaluator) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
 	}
-	p.Builtin = _field
+	p.Filters = _field
 	return nil
 }
 
-func (p *ColumnEvaluator) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptFilterOption) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ColumnEvaluator"); err != nil {
+	if err = oprot.WriteStructBegin("ExptFilterOption"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9773,28 +10376,8 @@ func (p *ColumnEvaluator) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
 			goto WriteFieldError
 		}
 	}
@@ -9815,96 +10398,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ColumnEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ColumnEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvaluatorID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *ColumnEvaluator) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_type", thrift.I32, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(int32(p.EvaluatorType)); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ColumnEvaluator) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Name); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ColumnEvaluator) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersion() {
-		if err = oprot.WriteFieldBegin("version", thrift.STRING, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Version); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *ColumnEvaluator) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 6); err != nil {
+func (p *ExptFilterOption) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFuzzyName() {
+		if err = oprot.WriteFieldBegin("fuzzy_name", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Description); err != nil {
+		if err := oprot.WriteString(*p.FuzzyName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -9913,16 +10412,16 @@ func (p *ColumnEvaluator) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ColumnEvaluator) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBuiltin() {
-		if err = oprot.WriteFieldBegin("builtin", thrift.BOOL, 7); err != nil {
+func (p *ExptFilterOption) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 10); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.Builtin); err != nil {
+		if err := p.Filters.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -9931,175 +10430,131 @@ func (p *ColumnEvaluator) writeField7(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *ColumnEvaluator) String() string {
+func (p *ExptFilterOption) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnEvaluator(%+v)", *p)
+	return fmt.Sprintf("ExptFilterOption(%+v)", *p)
 
 }
 
-func (p *ColumnEvaluator) DeepEqual(ano *ColumnEvaluator) bool {
+func (p *ExptFilterOption) DeepEqual(ano *ExptFilterOption) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.EvaluatorID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.EvaluatorType) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.Name) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.Version) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.Description) {
+	if !p.Field1DeepEqual(ano.FuzzyName) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.Builtin) {
+	if !p.Field10DeepEqual(ano.Filters) {
 		return false
 	}
 	return true
 }
 
-func (p *ColumnEvaluator) Field1DeepEqual(src int64) bool {
+func (p *ExptFilterOption) Field1DeepEqual(src *string) bool {
 
-	if p.EvaluatorVersionID != src {
+	if p.FuzzyName == src {
+		return true
+	} else if p.FuzzyName == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *ColumnEvaluator) Field2DeepEqual(src int64) bool {
-
-	if p.EvaluatorID != src {
+	if strings.Compare(*p.FuzzyName, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ColumnEvaluator) Field3DeepEqual(src evaluator.EvaluatorType) bool {
+func (p *ExptFilterOption) Field10DeepEqual(src *Filters) bool {
 
-	if p.EvaluatorType != src {
+	if !p.Filters.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *ColumnEvaluator) Field4DeepEqual(src *string) bool {
 
-	if p.Name == src {
-		return true
-	} else if p.Name == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Name, *src) != 0 {
-		return false
-	}
-	return true
+type ItemSystemInfo struct {
+	RunState *ItemRunState `thrift:"run_state,1,optional" frugal:"1,optional,ItemRunState" form:"run_state" json:"run_state,omitempty" query:"run_state"`
+	LogID    *string       `thrift:"log_id,2,optional" frugal:"2,optional,string" form:"log_id" json:"log_id,omitempty" query:"log_id"`
+	Error    *RunError     `thrift:"error,3,optional" frugal:"3,optional,RunError" form:"error" json:"error,omitempty" query:"error"`
 }
-func (p *ColumnEvaluator) Field5DeepEqual(src *string) bool {
 
-	if p.Version == src {
-		return true
-	} else if p.Version == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Version, *src) != 0 {
-		return false
-	}
-	return true
+func NewItemSystemInfo() *ItemSystemInfo {
+	return &ItemSystemInfo{}
 }
-func (p *ColumnEvaluator) Field6DeepEqual(src *string) bool {
 
-	if p.Description == src {
-		return true
-	} else if p.Description == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Description, *src) != 0 {
-		return false
-	}
-	return true
+func (p *ItemSystemInfo) InitDefault() {
 }
-func (p *ColumnEvaluator) Field7DeepEqual(src *bool) bool {
 
-	if p.Builtin == src {
-		return true
-	} else if p.Builtin == nil || src == nil {
-		return false
+var ItemSystemInfo_RunState_DEFAULT ItemRunState
+
+func (p *ItemSystemInfo) GetRunState() (v ItemRunState) {
+	if p == nil {
+		return
 	}
-	if *p.Builtin != *src {
-		return false
+	if !p.IsSetRunState() {
+		return ItemSystemInfo_RunState_DEFAULT
 	}
-	return true
-}
-
-type ExptColumnEvalTarget struct {
-	ExperimentID      *int64              `thrift:"experiment_id,1,optional" frugal:"1,optional,i64" json:"experiment_id" form:"experiment_id" query:"experiment_id"`
-	ColumnEvalTargets []*ColumnEvalTarget `thrift:"column_eval_targets,2,optional" frugal:"2,optional,list<ColumnEvalTarget>" form:"column_eval_targets" json:"column_eval_targets,omitempty" query:"column_eval_targets"`
-}
-
-func NewExptColumnEvalTarget() *ExptColumnEvalTarget {
-	return &ExptColumnEvalTarget{}
-}
-
-func (p *ExptColumnEvalTarget) InitDefault() {
+	return *p.RunState
 }
 
-var ExptColumnEvalTarget_ExperimentID_DEFAULT int64
+var ItemSystemInfo_LogID_DEFAULT string
 
-func (p *ExptColumnEvalTarget) GetExperimentID() (v int64) {
+func (p *ItemSystemInfo) GetLogID() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExperimentID() {
-		return ExptColumnEvalTarget_ExperimentID_DEFAULT
+	if !p.IsSetLogID() {
+		return ItemSystemInfo_LogID_DEFAULT
 	}
-	return *p.ExperimentID
+	return *p.LogID
 }
 
-var ExptColumnEvalTarget_ColumnEvalTargets_DEFAULT []*ColumnEvalTarget
+var ItemSystemInfo_Error_DEFAULT *RunError
 
-func (p *ExptColumnEvalTarget) GetColumnEvalTargets() (v []*ColumnEvalTarget) {
+func (p *ItemSystemInfo) GetError() (v *RunError) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetColumnEvalTargets() {
-		return ExptColumnEvalTarget_ColumnEvalTargets_DEFAULT
+	if !p.IsSetError() {
+		return ItemSystemInfo_Error_DEFAULT
 	}
-	return p.ColumnEvalTargets
+	return p.Error
 }
-func (p *ExptColumnEvalTarget) SetExperimentID(val *int64) {
-	p.ExperimentID = val
+func (p *ItemSystemInfo) SetRunState(val *ItemRunState) {
+	p.RunState = val
 }
-func (p *ExptColumnEvalTarget) SetColumnEvalTargets(val []*ColumnEvalTarget) {
-	p.ColumnEvalTargets = val
+func (p *ItemSystemInfo) SetLogID(val *string) {
+	p.LogID = val
+}
+func (p *ItemSystemInfo) SetError(val *RunError) {
+	p.Error = val
 }
 
-var fieldIDToName_ExptColumnEvalTarget = map[int16]string{
-	1: "experiment_id",
-	2: "column_eval_targets",
+var fieldIDToName_ItemSystemInfo = map[int16]string{
+	1: "run_state",
+	2: "log_id",
+	3: "error",
 }
 
-func (p *ExptColumnEvalTarget) IsSetExperimentID() bool {
-	return p.ExperimentID != nil
+func (p *ItemSystemInfo) IsSetRunState() bool {
+	return p.RunState != nil
 }
 
-func (p *ExptColumnEvalTarget) IsSetColumnEvalTargets() bool {
-	return p.ColumnEvalTargets != nil
+func (p *ItemSystemInfo) IsSetLogID() bool {
+	return p.LogID != nil
 }
 
-func (p *ExptColumnEvalTarget) Read(iprot thrift.TProtocol) (err error) {
+func (p *ItemSystemInfo) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *ItemSystemInfo) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10118,7 +10573,7 @@ func (p *ExptColumnEvalTarget) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -10126,13 +10581,21 @@ func (p *ExptColumnEvalTarget) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -10152,7 +10615,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnEvalTarget[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ItemSystemInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10162,44 +10625,41 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExptColumnEvalTarget) ReadField1(iprot thrift.TProtocol) error {
+func (p *ItemSystemInfo) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *ItemRunState
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = &v
+		tmp := ItemRunState(v)
+		_field = &tmp
 	}
-	p.ExperimentID = _field
+	p.RunState = _field
 	return nil
 }
-func (p *ExptColumnEvalTarget) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*ColumnEvalTarget, 0, size)
-	values := make([]ColumnEvalTarget, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *ItemSystemInfo) ReadField2(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	p.LogID = _field
+	return nil
+}
+func (p *ItemSystemInfo) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewRunError()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.ColumnEvalTargets = _field
+	p.Error = _field
 	return nil
 }
 
-func (p *ExptColumnEvalTarget) Write(oprot thrift.TProtocol) (err error) {
+func (p *ItemSystemInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptColumnEvalTarget"); err != nil {
+	if err = oprot.WriteStructBegin("ItemSystemInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10211,6 +10671,10 @@ func (p *ExptColumnEvalTarget) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10229,12 +10693,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptColumnEvalTarget) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExperimentID() {
-		if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
+func (p *ItemSystemInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRunState() {
+		if err = oprot.WriteFieldBegin("run_state", thrift.I32, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ExperimentID); err != nil {
+		if err := oprot.WriteI32(int32(*p.RunState)); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10247,20 +10711,30 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExptColumnEvalTarget) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetColumnEvalTargets() {
-		if err = oprot.WriteFieldBegin("column_eval_targets", thrift.LIST, 2); err != nil {
+func (p *ItemSystemInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLogID() {
+		if err = oprot.WriteFieldBegin("log_id", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ColumnEvalTargets)); err != nil {
+		if err := oprot.WriteString(*p.LogID); err != nil {
 			return err
 		}
-		for _, v := range p.ColumnEvalTargets {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ItemSystemInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Error.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10269,202 +10743,119 @@ func (p *ExptColumnEvalTarget) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ExptColumnEvalTarget) String() string {
+func (p *ItemSystemInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptColumnEvalTarget(%+v)", *p)
+	return fmt.Sprintf("ItemSystemInfo(%+v)", *p)
 
 }
 
-func (p *ExptColumnEvalTarget) DeepEqual(ano *ExptColumnEvalTarget) bool {
+func (p *ItemSystemInfo) DeepEqual(ano *ItemSystemInfo) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ExperimentID) {
+	if !p.Field1DeepEqual(ano.RunState) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.ColumnEvalTargets) {
+	if !p.Field2DeepEqual(ano.LogID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Error) {
 		return false
 	}
 	return true
 }
 
-func (p *ExptColumnEvalTarget) Field1DeepEqual(src *int64) bool {
+func (p *ItemSystemInfo) Field1DeepEqual(src *ItemRunState) bool {
 
-	if p.ExperimentID == src {
+	if p.RunState == src {
 		return true
-	} else if p.ExperimentID == nil || src == nil {
+	} else if p.RunState == nil || src == nil {
 		return false
 	}
-	if *p.ExperimentID != *src {
+	if *p.RunState != *src {
 		return false
 	}
 	return true
 }
-func (p *ExptColumnEvalTarget) Field2DeepEqual(src []*ColumnEvalTarget) bool {
+func (p *ItemSystemInfo) Field2DeepEqual(src *string) bool {
 
-	if len(p.ColumnEvalTargets) != len(src) {
+	if p.LogID == src {
+		return true
+	} else if p.LogID == nil || src == nil {
 		return false
 	}
-	for i, v := range p.ColumnEvalTargets {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.LogID, *src) != 0 {
+		return false
 	}
 	return true
 }
+func (p *ItemSystemInfo) Field3DeepEqual(src *RunError) bool {
 
-type ColumnEvalTarget struct {
-	Name        *string             `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	Description *string             `thrift:"description,2,optional" frugal:"2,optional,string" form:"description" json:"description,omitempty" query:"description"`
-	Label       *string             `thrift:"label,3,optional" frugal:"3,optional,string" form:"label" json:"label,omitempty" query:"label"`
-	ContentType *common.ContentType `thrift:"content_type,4,optional" frugal:"4,optional,string" form:"content_type" json:"content_type,omitempty" query:"content_type"`
-	TextSchema  *string             `thrift:"text_schema,5,optional" frugal:"5,optional,string" form:"text_schema" json:"text_schema,omitempty" query:"text_schema"`
-	SchemaKey   *dataset.SchemaKey  `thrift:"schema_key,6,optional" frugal:"6,optional,SchemaKey" form:"schema_key" json:"schema_key,omitempty" query:"schema_key"`
+	if !p.Error.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewColumnEvalTarget() *ColumnEvalTarget {
-	return &ColumnEvalTarget{}
+type ExptColumnEvaluator struct {
+	ExperimentID     int64              `thrift:"experiment_id,1,required" frugal:"1,required,i64" json:"experiment_id" form:"experiment_id,required" query:"experiment_id,required"`
+	ColumnEvaluators []*ColumnEvaluator `thrift:"column_evaluators,2,optional" frugal:"2,optional,list<ColumnEvaluator>" form:"column_evaluators" json:"column_evaluators,omitempty" query:"column_evaluators"`
 }
 
-func (p *ColumnEvalTarget) InitDefault() {
+func NewExptColumnEvaluator() *ExptColumnEvaluator {
+	return &ExptColumnEvaluator{}
 }
 
-var ColumnEvalTarget_Name_DEFAULT string
+func (p *ExptColumnEvaluator) InitDefault() {
+}
 
-func (p *ColumnEvalTarget) GetName() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetName() {
-		return ColumnEvalTarget_Name_DEFAULT
-	}
-	return *p.Name
-}
-
-var ColumnEvalTarget_Description_DEFAULT string
-
-func (p *ColumnEvalTarget) GetDescription() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetDescription() {
-		return ColumnEvalTarget_Description_DEFAULT
-	}
-	return *p.Description
-}
-
-var ColumnEvalTarget_Label_DEFAULT string
-
-func (p *ColumnEvalTarget) GetLabel() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetLabel() {
-		return ColumnEvalTarget_Label_DEFAULT
-	}
-	return *p.Label
-}
-
-var ColumnEvalTarget_ContentType_DEFAULT common.ContentType
-
-func (p *ColumnEvalTarget) GetContentType() (v common.ContentType) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetContentType() {
-		return ColumnEvalTarget_ContentType_DEFAULT
-	}
-	return *p.ContentType
-}
-
-var ColumnEvalTarget_TextSchema_DEFAULT string
-
-func (p *ColumnEvalTarget) GetTextSchema() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTextSchema() {
-		return ColumnEvalTarget_TextSchema_DEFAULT
+func (p *ExptColumnEvaluator) GetExperimentID() (v int64) {
+	if p != nil {
+		return p.ExperimentID
 	}
-	return *p.TextSchema
+	return
 }
 
-var ColumnEvalTarget_SchemaKey_DEFAULT dataset.SchemaKey
+var ExptColumnEvaluator_ColumnEvaluators_DEFAULT []*ColumnEvaluator
 
-func (p *ColumnEvalTarget) GetSchemaKey() (v dataset.SchemaKey) {
+func (p *ExptColumnEvaluator) GetColumnEvaluators() (v []*ColumnEvaluator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetSchemaKey() {
-		return ColumnEvalTarget_SchemaKey_DEFAULT
+	if !p.IsSetColumnEvaluators() {
+		return ExptColumnEvaluator_ColumnEvaluators_DEFAULT
 	}
-	return *p.SchemaKey
-}
-func (p *ColumnEvalTarget) SetName(val *string) {
-	p.Name = val
-}
-func (p *ColumnEvalTarget) SetDescription(val *string) {
-	p.Description = val
-}
-func (p *ColumnEvalTarget) SetLabel(val *string) {
-	p.Label = val
-}
-func (p *ColumnEvalTarget) SetContentType(val *common.ContentType) {
-	p.ContentType = val
-}
-func (p *ColumnEvalTarget) SetTextSchema(val *string) {
-	p.TextSchema = val
-}
-func (p *ColumnEvalTarget) SetSchemaKey(val *dataset.SchemaKey) {
-	p.SchemaKey = val
-}
-
-var fieldIDToName_ColumnEvalTarget = map[int16]string{
-	1: "name",
-	2: "description",
-	3: "label",
-	4: "content_type",
-	5: "text_schema",
-	6: "schema_key",
-}
-
-func (p *ColumnEvalTarget) IsSetName() bool {
-	return p.Name != nil
-}
-
-func (p *ColumnEvalTarget) IsSetDescription() bool {
-	return p.Description != nil
+	return p.ColumnEvaluators
 }
-
-func (p *ColumnEvalTarget) IsSetLabel() bool {
-	return p.Label != nil
+func (p *ExptColumnEvaluator) SetExperimentID(val int64) {
+	p.ExperimentID = val
 }
-
-func (p *ColumnEvalTarget) IsSetContentType() bool {
-	return p.ContentType != nil
+func (p *ExptColumnEvaluator) SetColumnEvaluators(val []*ColumnEvaluator) {
+	p.ColumnEvaluators = val
 }
 
-func (p *ColumnEvalTarget) IsSetTextSchema() bool {
-	return p.TextSchema != nil
+var fieldIDToName_ExptColumnEvaluator = map[int16]string{
+	1: "experiment_id",
+	2: "column_evaluators",
 }
 
-func (p *ColumnEvalTarget) IsSetSchemaKey() bool {
-	return p.SchemaKey != nil
+func (p *ExptColumnEvaluator) IsSetColumnEvaluators() bool {
+	return p.ColumnEvaluators != nil
 }
 
-func (p *ColumnEvalTarget) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptColumnEvaluator) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetExperimentID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -10481,53 +10872,22 @@ func (p *ColumnEvalTarget) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetExperimentID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -10541,13 +10901,17 @@ func (p *ColumnEvalTarget) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetExperimentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvalTarget[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnEvaluator[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10555,79 +10919,48 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptColumnEvaluator[fieldId]))
 }
 
-func (p *ColumnEvalTarget) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Name = _field
-	return nil
-}
-func (p *ColumnEvalTarget) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptColumnEvaluator) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Description = _field
+	p.ExperimentID = _field
 	return nil
 }
-func (p *ColumnEvalTarget) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *ExptColumnEvaluator) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Label = _field
-	return nil
-}
-func (p *ColumnEvalTarget) ReadField4(iprot thrift.TProtocol) error {
+	_field := make([]*ColumnEvaluator, 0, size)
+	values := make([]ColumnEvaluator, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *common.ContentType
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ContentType = _field
-	return nil
-}
-func (p *ColumnEvalTarget) ReadField5(iprot thrift.TProtocol) error {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+		_field = append(_field, _elem)
 	}
-	p.TextSchema = _field
-	return nil
-}
-func (p *ColumnEvalTarget) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *dataset.SchemaKey
-	if v, err := iprot.ReadI32(); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		tmp := dataset.SchemaKey(v)
-		_field = &tmp
 	}
-	p.SchemaKey = _field
+	p.ColumnEvaluators = _field
 	return nil
 }
 
-func (p *ColumnEvalTarget) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptColumnEvaluator) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ColumnEvalTarget"); err != nil {
+	if err = oprot.WriteStructBegin("ExptColumnEvaluator"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10639,22 +10972,6 @@ func (p *ColumnEvalTarget) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10673,102 +10990,36 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ColumnEvalTarget) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Name); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ColumnEvalTarget) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Description); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ExptColumnEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *ColumnEvalTarget) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLabel() {
-		if err = oprot.WriteFieldBegin("label", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Label); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := oprot.WriteI64(p.ExperimentID); err != nil {
+		return err
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ColumnEvalTarget) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetContentType() {
-		if err = oprot.WriteFieldBegin("content_type", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.ContentType); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ColumnEvalTarget) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTextSchema() {
-		if err = oprot.WriteFieldBegin("text_schema", thrift.STRING, 5); err != nil {
+func (p *ExptColumnEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnEvaluators() {
+		if err = oprot.WriteFieldBegin("column_evaluators", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.TextSchema); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ColumnEvaluators)); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *ColumnEvalTarget) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSchemaKey() {
-		if err = oprot.WriteFieldBegin("schema_key", thrift.I32, 6); err != nil {
-			goto WriteFieldBeginError
+		for _, v := range p.ColumnEvaluators {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
 		}
-		if err := oprot.WriteI32(int32(*p.SchemaKey)); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -10777,262 +11028,194 @@ func (p *ColumnEvalTarget) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ColumnEvalTarget) String() string {
+func (p *ExptColumnEvaluator) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnEvalTarget(%+v)", *p)
+	return fmt.Sprintf("ExptColumnEvaluator(%+v)", *p)
 
 }
 
-func (p *ColumnEvalTarget) DeepEqual(ano *ColumnEvalTarget) bool {
+func (p *ExptColumnEvaluator) DeepEqual(ano *ExptColumnEvaluator) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Name) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.Description) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Label) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.ContentType) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.TextSchema) {
+	if !p.Field1DeepEqual(ano.ExperimentID) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.SchemaKey) {
+	if !p.Field2DeepEqual(ano.ColumnEvaluators) {
 		return false
 	}
 	return true
 }
 
-func (p *ColumnEvalTarget) Field1DeepEqual(src *string) bool {
+func (p *ExptColumnEvaluator) Field1DeepEqual(src int64) bool {
 
-	if p.Name == src {
-		return true
-	} else if p.Name == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Name, *src) != 0 {
+	if p.ExperimentID != src {
 		return false
 	}
 	return true
 }
-func (p *ColumnEvalTarget) Field2DeepEqual(src *string) bool {
+func (p *ExptColumnEvaluator) Field2DeepEqual(src []*ColumnEvaluator) bool {
 
-	if p.Description == src {
-		return true
-	} else if p.Description == nil || src == nil {
+	if len(p.ColumnEvaluators) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.Description, *src) != 0 {
-		return false
+	for i, v := range p.ColumnEvaluators {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *ColumnEvalTarget) Field3DeepEqual(src *string) bool {
 
-	if p.Label == src {
-		return true
-	} else if p.Label == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Label, *src) != 0 {
-		return false
-	}
-	return true
+type ColumnEvaluator struct {
+	EvaluatorVersionID int64                   `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
+	EvaluatorID        int64                   `thrift:"evaluator_id,2,required" frugal:"2,required,i64" json:"evaluator_id" form:"evaluator_id,required" query:"evaluator_id,required"`
+	EvaluatorType      evaluator.EvaluatorType `thrift:"evaluator_type,3,required" frugal:"3,required,EvaluatorType" form:"evaluator_type,required" json:"evaluator_type,required" query:"evaluator_type,required"`
+	Name               *string                 `thrift:"name,4,optional" frugal:"4,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Version            *string                 `thrift:"version,5,optional" frugal:"5,optional,string" form:"version" json:"version,omitempty" query:"version"`
+	Description        *string                 `thrift:"description,6,optional" frugal:"6,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	Builtin            *bool                   `thrift:"builtin,7,optional" frugal:"7,optional,bool" form:"builtin" json:"builtin,omitempty" query:"builtin"`
 }
-func (p *ColumnEvalTarget) Field4DeepEqual(src *common.ContentType) bool {
 
-	if p.ContentType == src {
-		return true
-	} else if p.ContentType == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.ContentType, *src) != 0 {
-		return false
-	}
-	return true
+func NewColumnEvaluator() *ColumnEvaluator {
+	return &ColumnEvaluator{}
 }
-func (p *ColumnEvalTarget) Field5DeepEqual(src *string) bool {
 
-	if p.TextSchema == src {
-		return true
-	} else if p.TextSchema == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.TextSchema, *src) != 0 {
-		return false
-	}
-	return true
+func (p *ColumnEvaluator) InitDefault() {
 }
-func (p *ColumnEvalTarget) Field6DeepEqual(src *dataset.SchemaKey) bool {
 
-	if p.SchemaKey == src {
-		return true
-	} else if p.SchemaKey == nil || src == nil {
-		return false
-	}
-	if *p.SchemaKey != *src {
-		return false
+func (p *ColumnEvaluator) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
 	}
-	return true
-}
-
-type ColumnEvalSetField struct {
-	Key         *string             `thrift:"key,1,optional" frugal:"1,optional,string" form:"key" json:"key,omitempty" query:"key"`
-	Name        *string             `thrift:"name,2,optional" frugal:"2,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	Description *string             `thrift:"description,3,optional" frugal:"3,optional,string" form:"description" json:"description,omitempty" query:"description"`
-	ContentType *common.ContentType `thrift:"content_type,4,optional" frugal:"4,optional,string" form:"content_type" json:"content_type,omitempty" query:"content_type"`
-	//    5: optional datasetv3.FieldDisplayFormat DefaultDisplayFormat
-	TextSchema *string            `thrift:"text_schema,6,optional" frugal:"6,optional,string" form:"text_schema" json:"text_schema,omitempty" query:"text_schema"`
-	SchemaKey  *dataset.SchemaKey `thrift:"schema_key,7,optional" frugal:"7,optional,SchemaKey" form:"schema_key" json:"schema_key,omitempty" query:"schema_key"`
+	return
 }
 
-func NewColumnEvalSetField() *ColumnEvalSetField {
-	return &ColumnEvalSetField{}
+func (p *ColumnEvaluator) GetEvaluatorID() (v int64) {
+	if p != nil {
+		return p.EvaluatorID
+	}
+	return
 }
 
-func (p *ColumnEvalSetField) InitDefault() {
+func (p *ColumnEvaluator) GetEvaluatorType() (v evaluator.EvaluatorType) {
+	if p != nil {
+		return p.EvaluatorType
+	}
+	return
 }
 
-var ColumnEvalSetField_Key_DEFAULT string
+var ColumnEvaluator_Name_DEFAULT string
 
-func (p *ColumnEvalSetField) GetKey() (v string) {
+func (p *ColumnEvaluator) GetName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetKey() {
-		return ColumnEvalSetField_Key_DEFAULT
+	if !p.IsSetName() {
+		return ColumnEvaluator_Name_DEFAULT
 	}
-	return *p.Key
+	return *p.Name
 }
 
-var ColumnEvalSetField_Name_DEFAULT string
+var ColumnEvaluator_Version_DEFAULT string
 
-func (p *ColumnEvalSetField) GetName() (v string) {
+func (p *ColumnEvaluator) GetVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetName() {
-		return ColumnEvalSetField_Name_DEFAULT
+	if !p.IsSetVersion() {
+		return ColumnEvaluator_Version_DEFAULT
 	}
-	return *p.Name
+	return *p.Version
 }
 
-var ColumnEvalSetField_Description_DEFAULT string
+var ColumnEvaluator_Description_DEFAULT string
 
-func (p *ColumnEvalSetField) GetDescription() (v string) {
+func (p *ColumnEvaluator) GetDescription() (v string) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetDescription() {
-		return ColumnEvalSetField_Description_DEFAULT
+		return ColumnEvaluator_Description_DEFAULT
 	}
 	return *p.Description
 }
 
-var ColumnEvalSetField_ContentType_DEFAULT common.ContentType
+var ColumnEvaluator_Builtin_DEFAULT bool
 
-func (p *ColumnEvalSetField) GetContentType() (v common.ContentType) {
+func (p *ColumnEvaluator) GetBuiltin() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetContentType() {
-		return ColumnEvalSetField_ContentType_DEFAULT
+	if !p.IsSetBuiltin() {
+		return ColumnEvaluator_Builtin_DEFAULT
 	}
-	return *p.ContentType
+	return *p.Builtin
 }
-
-var ColumnEvalSetField_TextSchema_DEFAULT string
-
-func (p *ColumnEvalSetField) GetTextSchema() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTextSchema() {
-		return ColumnEvalSetField_TextSchema_DEFAULT
-	}
-	return *p.TextSchema
+func (p *ColumnEvaluator) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
 }
-
-var ColumnEvalSetField_SchemaKey_DEFAULT dataset.SchemaKey
-
-func (p *ColumnEvalSetField) GetSchemaKey() (v dataset.SchemaKey) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetSchemaKey() {
-		return ColumnEvalSetField_SchemaKey_DEFAULT
-	}
-	return *p.SchemaKey
+func (p *ColumnEvaluator) SetEvaluatorID(val int64) {
+	p.EvaluatorID = val
 }
-func (p *ColumnEvalSetField) SetKey(val *string) {
-	p.Key = val
+func (p *ColumnEvaluator) SetEvaluatorType(val evaluator.EvaluatorType) {
+	p.EvaluatorType = val
 }
-func (p *ColumnEvalSetField) SetName(val *string) {
+func (p *ColumnEvaluator) SetName(val *string) {
 	p.Name = val
 }
-func (p *ColumnEvalSetField) SetDescription(val *string) {
-	p.Description = val
-}
-func (p *ColumnEvalSetField) SetContentType(val *common.ContentType) {
-	p.ContentType = val
-}
-func (p *ColumnEvalSetField) SetTextSchema(val *string) {
-	p.TextSchema = val
+func (p *ColumnEvaluator) SetVersion(val *string) {
+	p.Version = val
 }
-func (p *ColumnEvalSetField) SetSchemaKey(val *dataset.SchemaKey) {
-	p.SchemaKey = val
+func (p *ColumnEvaluator) SetDescription(val *string) {
+	p.Description = val
 }
-
-var fieldIDToName_ColumnEvalSetField = map[int16]string{
-	1: "key",
-	2: "name",
-	3: "description",
-	4: "content_type",
-	6: "text_schema",
-	7: "schema_key",
+func (p *ColumnEvaluator) SetBuiltin(val *bool) {
+	p.Builtin = val
 }
 
-func (p *ColumnEvalSetField) IsSetKey() bool {
-	return p.Key != nil
+var fieldIDToName_ColumnEvaluator = map[int16]string{
+	1: "evaluator_version_id",
+	2: "evaluator_id",
+	3: "evaluator_type",
+	4: "name",
+	5: "version",
+	6: "description",
+	7: "builtin",
 }
 
-func (p *ColumnEvalSetField) IsSetName() bool {
+func (p *ColumnEvaluator) IsSetName() bool {
 	return p.Name != nil
 }
 
-func (p *ColumnEvalSetField) IsSetDescription() bool {
-	return p.Description != nil
-}
-
-func (p *ColumnEvalSetField) IsSetContentType() bool {
-	return p.ContentType != nil
+func (p *ColumnEvaluator) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *ColumnEvalSetField) IsSetTextSchema() bool {
-	return p.TextSchema != nil
+func (p *ColumnEvaluator) IsSetDescription() bool {
+	return p.Description != nil
 }
 
-func (p *ColumnEvalSetField) IsSetSchemaKey() bool {
-	return p.SchemaKey != nil
+func (p *ColumnEvaluator) IsSetBuiltin() bool {
+	return p.Builtin != nil
 }
 
-func (p *ColumnEvalSetField) Read(iprot thrift.TProtocol) (err error) {
+func (p *ColumnEvaluator) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetEvaluatorVersionID bool = false
+	var issetEvaluatorID bool = false
+	var issetEvaluatorType bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -11049,26 +11232,29 @@ func (p *ColumnEvalSetField) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorType = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -11080,6 +11266,14 @@ func (p *ColumnEvalSetField) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 6:
 			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField6(iprot); err != nil {
@@ -11089,7 +11283,7 @@ func (p *ColumnEvalSetField) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 7:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -11109,13 +11303,27 @@ func (p *ColumnEvalSetField) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorType {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvalSetField[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvaluator[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11123,31 +11331,44 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ColumnEvaluator[fieldId]))
 }
 
-func (p *ColumnEvalSetField) ReadField1(iprot thrift.TProtocol) error {
+func (p *ColumnEvaluator) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Key = _field
+	p.EvaluatorVersionID = _field
 	return nil
 }
-func (p *ColumnEvalSetField) ReadField2(iprot thrift.TProtocol) error {
+func (p *ColumnEvaluator) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Name = _field
+	p.EvaluatorID = _field
 	return nil
 }
-func (p *ColumnEvalSetField) ReadField3(iprot thrift.TProtocol) error {
+func (p *ColumnEvaluator) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field evaluator.EvaluatorType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = evaluator.EvaluatorType(v)
+	}
+	p.EvaluatorType = _field
+	return nil
+}
+func (p *ColumnEvaluator) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -11155,21 +11376,21 @@ func (p *ColumnEvalSetField) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Description = _field
+	p.Name = _field
 	return nil
 }
-func (p *ColumnEvalSetField) ReadField4(iprot thrift.TProtocol) error {
+func (p *ColumnEvaluator) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *common.ContentType
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ContentType = _field
+	p.Version = _field
 	return nil
 }
-func (p *ColumnEvalSetField) ReadField6(iprot thrift.TProtocol) error {
+func (p *ColumnEvaluator) ReadField6(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -11177,25 +11398,24 @@ func (p *ColumnEvalSetField) ReadField6(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.TextSchema = _field
+	p.Description = _field
 	return nil
 }
-func (p *ColumnEvalSetField) ReadField7(iprot thrift.TProtocol) error {
+func (p *ColumnEvaluator) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field *dataset.SchemaKey
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
-		tmp := dataset.SchemaKey(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.SchemaKey = _field
+	p.Builtin = _field
 	return nil
 }
 
-func (p *ColumnEvalSetField) Write(oprot thrift.TProtocol) (err error) {
+func (p *ColumnEvaluator) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ColumnEvalSetField"); err != nil {
+	if err = oprot.WriteStructBegin("ColumnEvaluator"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11215,6 +11435,10 @@ func (p *ColumnEvalSetField) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 		if err = p.writeField6(oprot); err != nil {
 			fieldId = 6
 			goto WriteFieldError
@@ -11241,17 +11465,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ColumnEvalSetField) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKey() {
-		if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Key); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ColumnEvaluator) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -11259,17 +11481,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ColumnEvalSetField) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Name); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ColumnEvaluator) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -11277,30 +11497,28 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ColumnEvalSetField) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Description); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
+func (p *ColumnEvaluator) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_type", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.EvaluatorType)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
 WriteFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ColumnEvalSetField) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetContentType() {
-		if err = oprot.WriteFieldBegin("content_type", thrift.STRING, 4); err != nil {
+func (p *ColumnEvaluator) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ContentType); err != nil {
+		if err := oprot.WriteString(*p.Name); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11313,12 +11531,30 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ColumnEvalSetField) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTextSchema() {
-		if err = oprot.WriteFieldBegin("text_schema", thrift.STRING, 6); err != nil {
+func (p *ColumnEvaluator) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.TextSchema); err != nil {
+		if err := oprot.WriteString(*p.Version); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ColumnEvaluator) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11331,12 +11567,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *ColumnEvalSetField) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSchemaKey() {
-		if err = oprot.WriteFieldBegin("schema_key", thrift.I32, 7); err != nil {
+func (p *ColumnEvaluator) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBuiltin() {
+		if err = oprot.WriteFieldBegin("builtin", thrift.BOOL, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.SchemaKey)); err != nil {
+		if err := oprot.WriteBool(*p.Builtin); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11350,228 +11586,172 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *ColumnEvalSetField) String() string {
+func (p *ColumnEvaluator) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnEvalSetField(%+v)", *p)
+	return fmt.Sprintf("ColumnEvaluator(%+v)", *p)
 
 }
 
-func (p *ColumnEvalSetField) DeepEqual(ano *ColumnEvalSetField) bool {
+func (p *ColumnEvaluator) DeepEqual(ano *ColumnEvaluator) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Key) {
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Name) {
+	if !p.Field2DeepEqual(ano.EvaluatorID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Description) {
+	if !p.Field3DeepEqual(ano.EvaluatorType) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.ContentType) {
+	if !p.Field4DeepEqual(ano.Name) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.TextSchema) {
+	if !p.Field5DeepEqual(ano.Version) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.SchemaKey) {
+	if !p.Field6DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Builtin) {
 		return false
 	}
 	return true
 }
 
-func (p *ColumnEvalSetField) Field1DeepEqual(src *string) bool {
+func (p *ColumnEvaluator) Field1DeepEqual(src int64) bool {
 
-	if p.Key == src {
-		return true
-	} else if p.Key == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Key, *src) != 0 {
+	if p.EvaluatorVersionID != src {
 		return false
 	}
 	return true
 }
-func (p *ColumnEvalSetField) Field2DeepEqual(src *string) bool {
+func (p *ColumnEvaluator) Field2DeepEqual(src int64) bool {
 
-	if p.Name == src {
-		return true
-	} else if p.Name == nil || src == nil {
+	if p.EvaluatorID != src {
 		return false
 	}
-	if strings.Compare(*p.Name, *src) != 0 {
+	return true
+}
+func (p *ColumnEvaluator) Field3DeepEqual(src evaluator.EvaluatorType) bool {
+
+	if p.EvaluatorType != src {
 		return false
 	}
 	return true
 }
-func (p *ColumnEvalSetField) Field3DeepEqual(src *string) bool {
+func (p *ColumnEvaluator) Field4DeepEqual(src *string) bool {
 
-	if p.Description == src {
+	if p.Name == src {
 		return true
-	} else if p.Description == nil || src == nil {
+	} else if p.Name == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Description, *src) != 0 {
+	if strings.Compare(*p.Name, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ColumnEvalSetField) Field4DeepEqual(src *common.ContentType) bool {
+func (p *ColumnEvaluator) Field5DeepEqual(src *string) bool {
 
-	if p.ContentType == src {
+	if p.Version == src {
 		return true
-	} else if p.ContentType == nil || src == nil {
+	} else if p.Version == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.ContentType, *src) != 0 {
+	if strings.Compare(*p.Version, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ColumnEvalSetField) Field6DeepEqual(src *string) bool {
+func (p *ColumnEvaluator) Field6DeepEqual(src *string) bool {
 
-	if p.TextSchema == src {
+	if p.Description == src {
 		return true
-	} else if p.TextSchema == nil || src == nil {
+	} else if p.Description == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.TextSchema, *src) != 0 {
+	if strings.Compare(*p.Description, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ColumnEvalSetField) Field7DeepEqual(src *dataset.SchemaKey) bool {
+func (p *ColumnEvaluator) Field7DeepEqual(src *bool) bool {
 
-	if p.SchemaKey == src {
+	if p.Builtin == src {
 		return true
-	} else if p.SchemaKey == nil || src == nil {
+	} else if p.Builtin == nil || src == nil {
 		return false
 	}
-	if *p.SchemaKey != *src {
+	if *p.Builtin != *src {
 		return false
 	}
 	return true
 }
 
-type ItemResult_ struct {
-	ItemID int64 `thrift:"item_id,1,required" frugal:"1,required,i64" json:"item_id" form:"item_id,required" query:"item_id,required"`
-	// row粒度实验结果详情
-	TurnResults []*TurnResult_    `thrift:"turn_results,2,optional" frugal:"2,optional,list<TurnResult_>" form:"turn_results" json:"turn_results,omitempty" query:"turn_results"`
-	SystemInfo  *ItemSystemInfo   `thrift:"system_info,3,optional" frugal:"3,optional,ItemSystemInfo" form:"system_info" json:"system_info,omitempty" query:"system_info"`
-	ItemIndex   *int64            `thrift:"item_index,4,optional" frugal:"4,optional,i64" json:"item_index" form:"item_index" query:"item_index"`
-	Ext         map[string]string `thrift:"ext,5,optional" frugal:"5,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
-}
-
-func NewItemResult_() *ItemResult_ {
-	return &ItemResult_{}
-}
-
-func (p *ItemResult_) InitDefault() {
-}
-
-func (p *ItemResult_) GetItemID() (v int64) {
-	if p != nil {
-		return p.ItemID
-	}
-	return
+type ExptColumnEvalTarget struct {
+	ExperimentID      *int64              `thrift:"experiment_id,1,optional" frugal:"1,optional,i64" json:"experiment_id" form:"experiment_id" query:"experiment_id"`
+	ColumnEvalTargets []*ColumnEvalTarget `thrift:"column_eval_targets,2,optional" frugal:"2,optional,list<ColumnEvalTarget>" form:"column_eval_targets" json:"column_eval_targets,omitempty" query:"column_eval_targets"`
 }
 
-var ItemResult__TurnResults_DEFAULT []*TurnResult_
-
-func (p *ItemResult_) GetTurnResults() (v []*TurnResult_) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTurnResults() {
-		return ItemResult__TurnResults_DEFAULT
-	}
-	return p.TurnResults
+func NewExptColumnEvalTarget() *ExptColumnEvalTarget {
+	return &ExptColumnEvalTarget{}
 }
 
-var ItemResult__SystemInfo_DEFAULT *ItemSystemInfo
-
-func (p *ItemResult_) GetSystemInfo() (v *ItemSystemInfo) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetSystemInfo() {
-		return ItemResult__SystemInfo_DEFAULT
-	}
-	return p.SystemInfo
+func (p *ExptColumnEvalTarget) InitDefault() {
 }
 
-var ItemResult__ItemIndex_DEFAULT int64
+var ExptColumnEvalTarget_ExperimentID_DEFAULT int64
 
-func (p *ItemResult_) GetItemIndex() (v int64) {
+func (p *ExptColumnEvalTarget) GetExperimentID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetItemIndex() {
-		return ItemResult__ItemIndex_DEFAULT
+	if !p.IsSetExperimentID() {
+		return ExptColumnEvalTarget_ExperimentID_DEFAULT
 	}
-	return *p.ItemIndex
+	return *p.ExperimentID
 }
 
-var ItemResult__Ext_DEFAULT map[string]string
+var ExptColumnEvalTarget_ColumnEvalTargets_DEFAULT []*ColumnEvalTarget
 
-func (p *ItemResult_) GetExt() (v map[string]string) {
+func (p *ExptColumnEvalTarget) GetColumnEvalTargets() (v []*ColumnEvalTarget) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExt() {
-		return ItemResult__Ext_DEFAULT
+	if !p.IsSetColumnEvalTargets() {
+		return ExptColumnEvalTarget_ColumnEvalTargets_DEFAULT
 	}
-	return p.Ext
-}
-func (p *ItemResult_) SetItemID(val int64) {
-	p.ItemID = val
-}
-func (p *ItemResult_) SetTurnResults(val []*TurnResult_) {
-	p.TurnResults = val
-}
-func (p *ItemResult_) SetSystemInfo(val *ItemSystemInfo) {
-	p.SystemInfo = val
-}
-func (p *ItemResult_) SetItemIndex(val *int64) {
-	p.ItemIndex = val
-}
-func (p *ItemResult_) SetExt(val map[string]string) {
-	p.Ext = val
+	return p.ColumnEvalTargets
 }
-
-var fieldIDToName_ItemResult_ = map[int16]string{
-	1: "item_id",
-	2: "turn_results",
-	3: "system_info",
-	4: "item_index",
-	5: "ext",
+func (p *ExptColumnEvalTarget) SetExperimentID(val *int64) {
+	p.ExperimentID = val
 }
-
-func (p *ItemResult_) IsSetTurnResults() bool {
-	return p.TurnResults != nil
+func (p *ExptColumnEvalTarget) SetColumnEvalTargets(val []*ColumnEvalTarget) {
+	p.ColumnEvalTargets = val
 }
 
-func (p *ItemResult_) IsSetSystemInfo() bool {
-	return p.SystemInfo != nil
+var fieldIDToName_ExptColumnEvalTarget = map[int16]string{
+	1: "experiment_id",
+	2: "column_eval_targets",
 }
 
-func (p *ItemResult_) IsSetItemIndex() bool {
-	return p.ItemIndex != nil
+func (p *ExptColumnEvalTarget) IsSetExperimentID() bool {
+	return p.ExperimentID != nil
 }
 
-func (p *ItemResult_) IsSetExt() bool {
-	return p.Ext != nil
+func (p *ExptColumnEvalTarget) IsSetColumnEvalTargets() bool {
+	return p.ColumnEvalTargets != nil
 }
 
-func (p *ItemResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptColumnEvalTarget) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetItemID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -11592,7 +11772,6 @@ func (p *ItemResult_) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -11604,32 +11783,8 @@ func (p *ItemResult_) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
@@ -11641,17 +11796,13 @@ func (p *ItemResult_) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetItemID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ItemResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnEvalTarget[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11659,28 +11810,26 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ItemResult_[fieldId]))
 }
 
-func (p *ItemResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptColumnEvalTarget) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ItemID = _field
+	p.ExperimentID = _field
 	return nil
 }
-func (p *ItemResult_) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptColumnEvalTarget) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*TurnResult_, 0, size)
-	values := make([]TurnResult_, size)
+	_field := make([]*ColumnEvalTarget, 0, size)
+	values := make([]ColumnEvalTarget, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -11694,61 +11843,13 @@ func (p *ItemResult_) ReadField2(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.TurnResults = _field
-	return nil
-}
-func (p *ItemResult_) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewItemSystemInfo()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.SystemInfo = _field
-	return nil
-}
-func (p *ItemResult_) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ItemIndex = _field
-	return nil
-}
-func (p *ItemResult_) ReadField5(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Ext = _field
+	p.ColumnEvalTargets = _field
 	return nil
 }
 
-func (p *ItemResult_) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptColumnEvalTarget) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ItemResult"); err != nil {
+	if err = oprot.WriteStructBegin("ExptColumnEvalTarget"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11760,18 +11861,6 @@ func (p *ItemResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -11790,72 +11879,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ItemResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("item_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ItemID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ItemResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTurnResults() {
-		if err = oprot.WriteFieldBegin("turn_results", thrift.LIST, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TurnResults)); err != nil {
-			return err
-		}
-		for _, v := range p.TurnResults {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *ItemResult_) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSystemInfo() {
-		if err = oprot.WriteFieldBegin("system_info", thrift.STRUCT, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.SystemInfo.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ItemResult_) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetItemIndex() {
-		if err = oprot.WriteFieldBegin("item_index", thrift.I64, 4); err != nil {
+func (p *ExptColumnEvalTarget) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExperimentID() {
+		if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ItemIndex); err != nil {
+		if err := oprot.WriteI64(*p.ExperimentID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11864,27 +11893,24 @@ func (p *ItemResult_) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ItemResult_) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExt() {
-		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 5); err != nil {
+func (p *ExptColumnEvalTarget) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnEvalTargets() {
+		if err = oprot.WriteFieldBegin("column_eval_targets", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ColumnEvalTargets)); err != nil {
 			return err
 		}
-		for k, v := range p.Ext {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
+		for _, v := range p.ColumnEvalTargets {
+			if err := v.Write(oprot); err != nil {
 				return err
 			}
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -11893,56 +11919,52 @@ func (p *ItemResult_) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ItemResult_) String() string {
+func (p *ExptColumnEvalTarget) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ItemResult_(%+v)", *p)
+	return fmt.Sprintf("ExptColumnEvalTarget(%+v)", *p)
 
 }
 
-func (p *ItemResult_) DeepEqual(ano *ItemResult_) bool {
+func (p *ExptColumnEvalTarget) DeepEqual(ano *ExptColumnEvalTarget) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ItemID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.TurnResults) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.SystemInfo) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.ItemIndex) {
+	if !p.Field1DeepEqual(ano.ExperimentID) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.Ext) {
+	if !p.Field2DeepEqual(ano.ColumnEvalTargets) {
 		return false
 	}
 	return true
 }
 
-func (p *ItemResult_) Field1DeepEqual(src int64) bool {
+func (p *ExptColumnEvalTarget) Field1DeepEqual(src *int64) bool {
 
-	if p.ItemID != src {
+	if p.ExperimentID == src {
+		return true
+	} else if p.ExperimentID == nil || src == nil {
+		return false
+	}
+	if *p.ExperimentID != *src {
 		return false
 	}
 	return true
 }
-func (p *ItemResult_) Field2DeepEqual(src []*TurnResult_) bool {
+func (p *ExptColumnEvalTarget) Field2DeepEqual(src []*ColumnEvalTarget) bool {
 
-	if len(p.TurnResults) != len(src) {
+	if len(p.ColumnEvalTargets) != len(src) {
 		return false
 	}
-	for i, v := range p.TurnResults {
+	for i, v := range p.ColumnEvalTargets {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -11950,109 +11972,147 @@ func (p *ItemResult_) Field2DeepEqual(src []*TurnResult_) bool {
 	}
 	return true
 }
-func (p *ItemResult_) Field3DeepEqual(src *ItemSystemInfo) bool {
 
-	if !p.SystemInfo.DeepEqual(src) {
-		return false
-	}
-	return true
+type ColumnEvalTarget struct {
+	Name        *string             `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Description *string             `thrift:"description,2,optional" frugal:"2,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	Label       *string             `thrift:"label,3,optional" frugal:"3,optional,string" form:"label" json:"label,omitempty" query:"label"`
+	ContentType *common.ContentType `thrift:"content_type,4,optional" frugal:"4,optional,string" form:"content_type" json:"content_type,omitempty" query:"content_type"`
+	TextSchema  *string             `thrift:"text_schema,5,optional" frugal:"5,optional,string" form:"text_schema" json:"text_schema,omitempty" query:"text_schema"`
+	SchemaKey   *dataset.SchemaKey  `thrift:"schema_key,6,optional" frugal:"6,optional,SchemaKey" form:"schema_key" json:"schema_key,omitempty" query:"schema_key"`
 }
-func (p *ItemResult_) Field4DeepEqual(src *int64) bool {
 
-	if p.ItemIndex == src {
-		return true
-	} else if p.ItemIndex == nil || src == nil {
-		return false
-	}
-	if *p.ItemIndex != *src {
-		return false
-	}
-	return true
+func NewColumnEvalTarget() *ColumnEvalTarget {
+	return &ColumnEvalTarget{}
 }
-func (p *ItemResult_) Field5DeepEqual(src map[string]string) bool {
 
-	if len(p.Ext) != len(src) {
-		return false
-	}
-	for k, v := range p.Ext {
-		_src := src[k]
-		if strings.Compare(v, _src) != 0 {
-			return false
-		}
-	}
-	return true
+func (p *ColumnEvalTarget) InitDefault() {
 }
 
-// 行级结果 可能包含多个实验
-type TurnResult_ struct {
-	TurnID int64 `thrift:"turn_id,1" frugal:"1,default,i64" json:"turn_id" form:"turn_id" query:"turn_id"`
-	// 参与对比的实验序列，对于单报告序列长度为1
-	ExperimentResults []*ExperimentResult_ `thrift:"experiment_results,2,optional" frugal:"2,optional,list<ExperimentResult_>" form:"experiment_results" json:"experiment_results,omitempty" query:"experiment_results"`
-	TurnIndex         *int64               `thrift:"turn_index,3,optional" frugal:"3,optional,i64" json:"turn_index" form:"turn_index" query:"turn_index"`
+var ColumnEvalTarget_Name_DEFAULT string
+
+func (p *ColumnEvalTarget) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return ColumnEvalTarget_Name_DEFAULT
+	}
+	return *p.Name
 }
 
-func NewTurnResult_() *TurnResult_ {
-	return &TurnResult_{}
+var ColumnEvalTarget_Description_DEFAULT string
+
+func (p *ColumnEvalTarget) GetDescription() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDescription() {
+		return ColumnEvalTarget_Description_DEFAULT
+	}
+	return *p.Description
 }
 
-func (p *TurnResult_) InitDefault() {
+var ColumnEvalTarget_Label_DEFAULT string
+
+func (p *ColumnEvalTarget) GetLabel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabel() {
+		return ColumnEvalTarget_Label_DEFAULT
+	}
+	return *p.Label
 }
 
-func (p *TurnResult_) GetTurnID() (v int64) {
-	if p != nil {
-		return p.TurnID
+var ColumnEvalTarget_ContentType_DEFAULT common.ContentType
+
+func (p *ColumnEvalTarget) GetContentType() (v common.ContentType) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetContentType() {
+		return ColumnEvalTarget_ContentType_DEFAULT
+	}
+	return *p.ContentType
 }
 
-var TurnResult__ExperimentResults_DEFAULT []*ExperimentResult_
+var ColumnEvalTarget_TextSchema_DEFAULT string
 
-func (p *TurnResult_) GetExperimentResults() (v []*ExperimentResult_) {
+func (p *ColumnEvalTarget) GetTextSchema() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetExperimentResults() {
-		return TurnResult__ExperimentResults_DEFAULT
+	if !p.IsSetTextSchema() {
+		return ColumnEvalTarget_TextSchema_DEFAULT
 	}
-	return p.ExperimentResults
+	return *p.TextSchema
 }
 
-var TurnResult__TurnIndex_DEFAULT int64
+var ColumnEvalTarget_SchemaKey_DEFAULT dataset.SchemaKey
 
-func (p *TurnResult_) GetTurnIndex() (v int64) {
+func (p *ColumnEvalTarget) GetSchemaKey() (v dataset.SchemaKey) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTurnIndex() {
-		return TurnResult__TurnIndex_DEFAULT
+	if !p.IsSetSchemaKey() {
+		return ColumnEvalTarget_SchemaKey_DEFAULT
 	}
-	return *p.TurnIndex
+	return *p.SchemaKey
 }
-func (p *TurnResult_) SetTurnID(val int64) {
-	p.TurnID = val
+func (p *ColumnEvalTarget) SetName(val *string) {
+	p.Name = val
 }
-func (p *TurnResult_) SetExperimentResults(val []*ExperimentResult_) {
-	p.ExperimentResults = val
+func (p *ColumnEvalTarget) SetDescription(val *string) {
+	p.Description = val
 }
-func (p *TurnResult_) SetTurnIndex(val *int64) {
-	p.TurnIndex = val
+func (p *ColumnEvalTarget) SetLabel(val *string) {
+	p.Label = val
+}
+func (p *ColumnEvalTarget) SetContentType(val *common.ContentType) {
+	p.ContentType = val
+}
+func (p *ColumnEvalTarget) SetTextSchema(val *string) {
+	p.TextSchema = val
+}
+func (p *ColumnEvalTarget) SetSchemaKey(val *dataset.SchemaKey) {
+	p.SchemaKey = val
 }
 
-var fieldIDToName_TurnResult_ = map[int16]string{
-	1: "turn_id",
-	2: "experiment_results",
-	3: "turn_index",
+var fieldIDToName_ColumnEvalTarget = map[int16]string{
+	1: "name",
+	2: "description",
+	3: "label",
+	4: "content_type",
+	5: "text_schema",
+	6: "schema_key",
 }
 
-func (p *TurnResult_) IsSetExperimentResults() bool {
-	return p.ExperimentResults != nil
+func (p *ColumnEvalTarget) IsSetName() bool {
+	return p.Name != nil
 }
 
-func (p *TurnResult_) IsSetTurnIndex() bool {
-	return p.TurnIndex != nil
+func (p *ColumnEvalTarget) IsSetDescription() bool {
+	return p.Description != nil
 }
 
-func (p *TurnResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *ColumnEvalTarget) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *ColumnEvalTarget) IsSetContentType() bool {
+	return p.ContentType != nil
+}
+
+func (p *ColumnEvalTarget) IsSetTextSchema() bool {
+	return p.TextSchema != nil
+}
+
+func (p *ColumnEvalTarget) IsSetSchemaKey() bool {
+	return p.SchemaKey != nil
+}
+
+func (p *ColumnEvalTarget) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -12071,7 +12131,7 @@ func (p *TurnResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12079,7 +12139,7 @@ func (p *TurnResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12087,13 +12147,37 @@ func (p *TurnResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -12113,7 +12197,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvalTarget[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12123,55 +12207,77 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TurnResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *ColumnEvalTarget) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.TurnID = _field
+	p.Name = _field
 	return nil
 }
-func (p *TurnResult_) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *ColumnEvalTarget) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]*ExperimentResult_, 0, size)
-	values := make([]ExperimentResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.Description = _field
+	return nil
+}
+func (p *ColumnEvalTarget) ReadField3(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	p.Label = _field
+	return nil
+}
+func (p *ColumnEvalTarget) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *common.ContentType
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.ExperimentResults = _field
+	p.ContentType = _field
 	return nil
 }
-func (p *TurnResult_) ReadField3(iprot thrift.TProtocol) error {
+func (p *ColumnEvalTarget) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.TurnIndex = _field
+	p.TextSchema = _field
 	return nil
 }
+func (p *ColumnEvalTarget) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *TurnResult_) Write(oprot thrift.TProtocol) (err error) {
+	var _field *dataset.SchemaKey
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := dataset.SchemaKey(v)
+		_field = &tmp
+	}
+	p.SchemaKey = _field
+	return nil
+}
+
+func (p *ColumnEvalTarget) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TurnResult"); err != nil {
+	if err = oprot.WriteStructBegin("ColumnEvalTarget"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12187,6 +12293,18 @@ func (p *TurnResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12205,15 +12323,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TurnResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.TurnID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ColumnEvalTarget) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -12221,20 +12341,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *TurnResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExperimentResults() {
-		if err = oprot.WriteFieldBegin("experiment_results", thrift.LIST, 2); err != nil {
+func (p *ColumnEvalTarget) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ExperimentResults)); err != nil {
-			return err
-		}
-		for _, v := range p.ExperimentResults {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.Description); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12247,12 +12359,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *TurnResult_) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTurnIndex() {
-		if err = oprot.WriteFieldBegin("turn_index", thrift.I64, 3); err != nil {
+func (p *ColumnEvalTarget) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TurnIndex); err != nil {
+		if err := oprot.WriteString(*p.Label); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12265,148 +12377,375 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *ColumnEvalTarget) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContentType() {
+		if err = oprot.WriteFieldBegin("content_type", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ContentType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ColumnEvalTarget) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTextSchema() {
+		if err = oprot.WriteFieldBegin("text_schema", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TextSchema); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ColumnEvalTarget) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSchemaKey() {
+		if err = oprot.WriteFieldBegin("schema_key", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.SchemaKey)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
-func (p *TurnResult_) String() string {
+func (p *ColumnEvalTarget) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TurnResult_(%+v)", *p)
+	return fmt.Sprintf("ColumnEvalTarget(%+v)", *p)
 
 }
 
-func (p *TurnResult_) DeepEqual(ano *TurnResult_) bool {
+func (p *ColumnEvalTarget) DeepEqual(ano *ColumnEvalTarget) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TurnID) {
+	if !p.Field1DeepEqual(ano.Name) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.ExperimentResults) {
+	if !p.Field2DeepEqual(ano.Description) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.TurnIndex) {
+	if !p.Field3DeepEqual(ano.Label) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ContentType) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.TextSchema) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.SchemaKey) {
 		return false
 	}
 	return true
 }
 
-func (p *TurnResult_) Field1DeepEqual(src int64) bool {
+func (p *ColumnEvalTarget) Field1DeepEqual(src *string) bool {
 
-	if p.TurnID != src {
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *TurnResult_) Field2DeepEqual(src []*ExperimentResult_) bool {
+func (p *ColumnEvalTarget) Field2DeepEqual(src *string) bool {
 
-	if len(p.ExperimentResults) != len(src) {
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
 		return false
 	}
-	for i, v := range p.ExperimentResults {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *TurnResult_) Field3DeepEqual(src *int64) bool {
+func (p *ColumnEvalTarget) Field3DeepEqual(src *string) bool {
 
-	if p.TurnIndex == src {
+	if p.Label == src {
 		return true
-	} else if p.TurnIndex == nil || src == nil {
+	} else if p.Label == nil || src == nil {
 		return false
 	}
-	if *p.TurnIndex != *src {
+	if strings.Compare(*p.Label, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *ColumnEvalTarget) Field4DeepEqual(src *common.ContentType) bool {
 
-type ExperimentResult_ struct {
-	ExperimentID int64                  `thrift:"experiment_id,1,required" frugal:"1,required,i64" json:"experiment_id" form:"experiment_id,required" query:"experiment_id,required"`
-	Payload      *ExperimentTurnPayload `thrift:"payload,2,optional" frugal:"2,optional,ExperimentTurnPayload" form:"payload" json:"payload,omitempty" query:"payload"`
+	if p.ContentType == src {
+		return true
+	} else if p.ContentType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ContentType, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ColumnEvalTarget) Field5DeepEqual(src *string) bool {
 
-func NewExperimentResult_() *ExperimentResult_ {
-	return &ExperimentResult_{}
+	if p.TextSchema == src {
+		return true
+	} else if p.TextSchema == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TextSchema, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ColumnEvalTarget) Field6DeepEqual(src *dataset.SchemaKey) bool {
 
-func (p *ExperimentResult_) InitDefault() {
+	if p.SchemaKey == src {
+		return true
+	} else if p.SchemaKey == nil || src == nil {
+		return false
+	}
+	if *p.SchemaKey != *src {
+		return false
+	}
+	return true
 }
 
-func (p *ExperimentResult_) GetExperimentID() (v int64) {
-	if p != nil {
-		return p.ExperimentID
-	}
-	return
+type ColumnEvalSetField struct {
+	Key         *string             `thrift:"key,1,optional" frugal:"1,optional,string" form:"key" json:"key,omitempty" query:"key"`
+	Name        *string             `thrift:"name,2,optional" frugal:"2,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Description *string             `thrift:"description,3,optional" frugal:"3,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	ContentType *common.ContentType `thrift:"content_type,4,optional" frugal:"4,optional,string" form:"content_type" json:"content_type,omitempty" query:"content_type"`
+	//    5: optional datasetv3.FieldDisplayFormat DefaultDisplayFormat
+	TextSchema *string            `thrift:"text_schema,6,optional" frugal:"6,optional,string" form:"text_schema" json:"text_schema,omitempty" query:"text_schema"`
+	SchemaKey  *dataset.SchemaKey `thrift:"schema_key,7,optional" frugal:"7,optional,SchemaKey" form:"schema_key" json:"schema_key,omitempty" query:"schema_key"`
 }
 
-var ExperimentResult__Payload_DEFAULT *ExperimentTurnPayload
+func NewColumnEvalSetField() *ColumnEvalSetField {
+	return &ColumnEvalSetField{}
+}
 
-func (p *ExperimentResult_) GetPayload() (v *ExperimentTurnPayload) {
+func (p *ColumnEvalSetField) InitDefault() {
+}
+
+var ColumnEvalSetField_Key_DEFAULT string
+
+func (p *ColumnEvalSetField) GetKey() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPayload() {
-		return ExperimentResult__Payload_DEFAULT
+	if !p.IsSetKey() {
+		return ColumnEvalSetField_Key_DEFAULT
 	}
-	return p.Payload
-}
-func (p *ExperimentResult_) SetExperimentID(val int64) {
-	p.ExperimentID = val
-}
-func (p *ExperimentResult_) SetPayload(val *ExperimentTurnPayload) {
-	p.Payload = val
+	return *p.Key
 }
 
-var fieldIDToName_ExperimentResult_ = map[int16]string{
-	1: "experiment_id",
-	2: "payload",
-}
+var ColumnEvalSetField_Name_DEFAULT string
 
-func (p *ExperimentResult_) IsSetPayload() bool {
-	return p.Payload != nil
+func (p *ColumnEvalSetField) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return ColumnEvalSetField_Name_DEFAULT
+	}
+	return *p.Name
 }
 
-func (p *ExperimentResult_) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetExperimentID bool = false
+var ColumnEvalSetField_Description_DEFAULT string
 
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *ColumnEvalSetField) GetDescription() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDescription() {
+		return ColumnEvalSetField_Description_DEFAULT
 	}
+	return *p.Description
+}
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
+var ColumnEvalSetField_ContentType_DEFAULT common.ContentType
+
+func (p *ColumnEvalSetField) GetContentType() (v common.ContentType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetContentType() {
+		return ColumnEvalSetField_ContentType_DEFAULT
+	}
+	return *p.ContentType
+}
+
+var ColumnEvalSetField_TextSchema_DEFAULT string
+
+func (p *ColumnEvalSetField) GetTextSchema() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTextSchema() {
+		return ColumnEvalSetField_TextSchema_DEFAULT
+	}
+	return *p.TextSchema
+}
+
+var ColumnEvalSetField_SchemaKey_DEFAULT dataset.SchemaKey
+
+func (p *ColumnEvalSetField) GetSchemaKey() (v dataset.SchemaKey) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSchemaKey() {
+		return ColumnEvalSetField_SchemaKey_DEFAULT
+	}
+	return *p.SchemaKey
+}
+func (p *ColumnEvalSetField) SetKey(val *string) {
+	p.Key = val
+}
+func (p *ColumnEvalSetField) SetName(val *string) {
+	p.Name = val
+}
+func (p *ColumnEvalSetField) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *ColumnEvalSetField) SetContentType(val *common.ContentType) {
+	p.ContentType = val
+}
+func (p *ColumnEvalSetField) SetTextSchema(val *string) {
+	p.TextSchema = val
+}
+func (p *ColumnEvalSetField) SetSchemaKey(val *dataset.SchemaKey) {
+	p.SchemaKey = val
+}
+
+var fieldIDToName_ColumnEvalSetField = map[int16]string{
+	1: "key",
+	2: "name",
+	3: "description",
+	4: "content_type",
+	6: "text_schema",
+	7: "schema_key",
+}
+
+func (p *ColumnEvalSetField) IsSetKey() bool {
+	return p.Key != nil
+}
+
+func (p *ColumnEvalSetField) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *ColumnEvalSetField) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *ColumnEvalSetField) IsSetContentType() bool {
+	return p.ContentType != nil
+}
+
+func (p *ColumnEvalSetField) IsSetTextSchema() bool {
+	return p.TextSchema != nil
+}
+
+func (p *ColumnEvalSetField) IsSetSchemaKey() bool {
+	return p.SchemaKey != nil
+}
+
+func (p *ColumnEvalSetField) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
 			break
 		}
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetExperimentID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -12420,17 +12759,13 @@ func (p *ExperimentResult_) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetExperimentID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvalSetField[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12438,33 +12773,79 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExperimentResult_[fieldId]))
 }
 
-func (p *ExperimentResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *ColumnEvalSetField) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ExperimentID = _field
+	p.Key = _field
 	return nil
 }
-func (p *ExperimentResult_) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewExperimentTurnPayload()
-	if err := _field.Read(iprot); err != nil {
+func (p *ColumnEvalSetField) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Payload = _field
+	p.Name = _field
 	return nil
 }
+func (p *ColumnEvalSetField) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *ExperimentResult_) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *ColumnEvalSetField) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *common.ContentType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ContentType = _field
+	return nil
+}
+func (p *ColumnEvalSetField) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TextSchema = _field
+	return nil
+}
+func (p *ColumnEvalSetField) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *dataset.SchemaKey
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := dataset.SchemaKey(v)
+		_field = &tmp
+	}
+	p.SchemaKey = _field
+	return nil
+}
+
+func (p *ColumnEvalSetField) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExperimentResult"); err != nil {
+	if err = oprot.WriteStructBegin("ColumnEvalSetField"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12476,6 +12857,22 @@ func (p *ExperimentResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12494,28 +12891,30 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ExperimentID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ExperimentResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPayload() {
-		if err = oprot.WriteFieldBegin("payload", thrift.STRUCT, 2); err != nil {
+func (p *ColumnEvalSetField) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKey() {
+		if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Payload.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.Key); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ColumnEvalSetField) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12528,124 +12927,301 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *ColumnEvalSetField) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ColumnEvalSetField) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContentType() {
+		if err = oprot.WriteFieldBegin("content_type", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ContentType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ColumnEvalSetField) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTextSchema() {
+		if err = oprot.WriteFieldBegin("text_schema", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TextSchema); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ColumnEvalSetField) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSchemaKey() {
+		if err = oprot.WriteFieldBegin("schema_key", thrift.I32, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.SchemaKey)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
-func (p *ExperimentResult_) String() string {
+func (p *ColumnEvalSetField) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentResult_(%+v)", *p)
+	return fmt.Sprintf("ColumnEvalSetField(%+v)", *p)
 
 }
 
-func (p *ExperimentResult_) DeepEqual(ano *ExperimentResult_) bool {
+func (p *ColumnEvalSetField) DeepEqual(ano *ColumnEvalSetField) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ExperimentID) {
+	if !p.Field1DeepEqual(ano.Key) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Payload) {
+	if !p.Field2DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ContentType) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.TextSchema) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.SchemaKey) {
 		return false
 	}
 	return true
 }
 
-func (p *ExperimentResult_) Field1DeepEqual(src int64) bool {
+func (p *ColumnEvalSetField) Field1DeepEqual(src *string) bool {
 
-	if p.ExperimentID != src {
+	if p.Key == src {
+		return true
+	} else if p.Key == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Key, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExperimentResult_) Field2DeepEqual(src *ExperimentTurnPayload) bool {
+func (p *ColumnEvalSetField) Field2DeepEqual(src *string) bool {
 
-	if !p.Payload.DeepEqual(src) {
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *ColumnEvalSetField) Field3DeepEqual(src *string) bool {
 
-type TurnSystemInfo struct {
-	TurnRunState *TurnRunState `thrift:"turn_run_state,1,optional" frugal:"1,optional,TurnRunState" form:"turn_run_state" json:"turn_run_state,omitempty" query:"turn_run_state"`
-	LogID        *string       `thrift:"log_id,2,optional" frugal:"2,optional,string" form:"log_id" json:"log_id,omitempty" query:"log_id"`
-	Error        *RunError     `thrift:"error,3,optional" frugal:"3,optional,RunError" form:"error" json:"error,omitempty" query:"error"`
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ColumnEvalSetField) Field4DeepEqual(src *common.ContentType) bool {
 
-func NewTurnSystemInfo() *TurnSystemInfo {
-	return &TurnSystemInfo{}
+	if p.ContentType == src {
+		return true
+	} else if p.ContentType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ContentType, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ColumnEvalSetField) Field6DeepEqual(src *string) bool {
 
-func (p *TurnSystemInfo) InitDefault() {
+	if p.TextSchema == src {
+		return true
+	} else if p.TextSchema == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TextSchema, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ColumnEvalSetField) Field7DeepEqual(src *dataset.SchemaKey) bool {
 
-var TurnSystemInfo_TurnRunState_DEFAULT TurnRunState
+	if p.SchemaKey == src {
+		return true
+	} else if p.SchemaKey == nil || src == nil {
+		return false
+	}
+	if *p.SchemaKey != *src {
+		return false
+	}
+	return true
+}
 
-func (p *TurnSystemInfo) GetTurnRunState() (v TurnRunState) {
+type ItemResult_ struct {
+	ItemID int64 `thrift:"item_id,1,required" frugal:"1,required,i64" json:"item_id" form:"item_id,required" query:"item_id,required"`
+	// row粒度实验结果详情
+	TurnResults []*TurnResult_    `thrift:"turn_results,2,optional" frugal:"2,optional,list<TurnResult_>" form:"turn_results" json:"turn_results,omitempty" query:"turn_results"`
+	SystemInfo  *ItemSystemInfo   `thrift:"system_info,3,optional" frugal:"3,optional,ItemSystemInfo" form:"system_info" json:"system_info,omitempty" query:"system_info"`
+	ItemIndex   *int64            `thrift:"item_index,4,optional" frugal:"4,optional,i64" json:"item_index" form:"item_index" query:"item_index"`
+	Ext         map[string]string `thrift:"ext,5,optional" frugal:"5,optional,map<string:string>" form:"ext" json:"ext,omitempty" query:"ext"`
+}
+
+func NewItemResult_() *ItemResult_ {
+	return &ItemResult_{}
+}
+
+func (p *ItemResult_) InitDefault() {
+}
+
+func (p *ItemResult_) GetItemID() (v int64) {
+	if p != nil {
+		return p.ItemID
+	}
+	return
+}
+
+var ItemResult__TurnResults_DEFAULT []*TurnResult_
+
+func (p *ItemResult_) GetTurnResults() (v []*TurnResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTurnRunState() {
-		return TurnSystemInfo_TurnRunState_DEFAULT
+	if !p.IsSetTurnResults() {
+		return ItemResult__TurnResults_DEFAULT
 	}
-	return *p.TurnRunState
+	return p.TurnResults
 }
 
-var TurnSystemInfo_LogID_DEFAULT string
+var ItemResult__SystemInfo_DEFAULT *ItemSystemInfo
 
-func (p *TurnSystemInfo) GetLogID() (v string) {
+func (p *ItemResult_) GetSystemInfo() (v *ItemSystemInfo) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetLogID() {
-		return TurnSystemInfo_LogID_DEFAULT
+	if !p.IsSetSystemInfo() {
+		return ItemResult__SystemInfo_DEFAULT
 	}
-	return *p.LogID
+	return p.SystemInfo
 }
 
-var TurnSystemInfo_Error_DEFAULT *RunError
+var ItemResult__ItemIndex_DEFAULT int64
 
-func (p *TurnSystemInfo) GetError() (v *RunError) {
+func (p *ItemResult_) GetItemIndex() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetError() {
-		return TurnSystemInfo_Error_DEFAULT
+	if !p.IsSetItemIndex() {
+		return ItemResult__ItemIndex_DEFAULT
 	}
-	return p.Error
+	return *p.ItemIndex
 }
-func (p *TurnSystemInfo) SetTurnRunState(val *TurnRunState) {
-	p.TurnRunState = val
+
+var ItemResult__Ext_DEFAULT map[string]string
+
+func (p *ItemResult_) GetExt() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExt() {
+		return ItemResult__Ext_DEFAULT
+	}
+	return p.Ext
 }
-func (p *TurnSystemInfo) SetLogID(val *string) {
-	p.LogID = val
+func (p *ItemResult_) SetItemID(val int64) {
+	p.ItemID = val
 }
-func (p *TurnSystemInfo) SetError(val *RunError) {
-	p.Error = val
+func (p *ItemResult_) SetTurnResults(val []*TurnResult_) {
+	p.TurnResults = val
 }
-
-var fieldIDToName_TurnSystemInfo = map[int16]string{
-	1: "turn_run_state",
-	2: "log_id",
-	3: "error",
+func (p *ItemResult_) SetSystemInfo(val *ItemSystemInfo) {
+	p.SystemInfo = val
+}
+func (p *ItemResult_) SetItemIndex(val *int64) {
+	p.ItemIndex = val
+}
+func (p *ItemResult_) SetExt(val map[string]string) {
+	p.Ext = val
 }
 
-func (p *TurnSystemInfo) IsSetTurnRunState() bool {
-	return p.TurnRunState != nil
+var fieldIDToName_ItemResult_ = map[int16]string{
+	1: "item_id",
+	2: "turn_results",
+	3: "system_info",
+	4: "item_index",
+	5: "ext",
 }
 
-func (p *TurnSystemInfo) IsSetLogID() bool {
-	return p.LogID != nil
+func (p *ItemResult_) IsSetTurnResults() bool {
+	return p.TurnResults != nil
 }
 
-func (p *TurnSystemInfo) IsSetError() bool {
-	return p.Error != nil
+func (p *ItemResult_) IsSetSystemInfo() bool {
+	return p.SystemInfo != nil
 }
 
-func (p *TurnSystemInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *ItemResult_) IsSetItemIndex() bool {
+	return p.ItemIndex != nil
+}
+
+func (p *ItemResult_) IsSetExt() bool {
+	return p.Ext != nil
+}
+
+func (p *ItemResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetItemID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -12662,15 +13238,16 @@ func (p *TurnSystemInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetItemID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12685,6 +13262,22 @@ func (p *TurnSystemInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -12698,13 +13291,17 @@ func (p *TurnSystemInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetItemID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnSystemInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ItemResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12712,43 +13309,96 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ItemResult_[fieldId]))
 }
 
-func (p *TurnSystemInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *ItemResult_) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *TurnRunState
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		tmp := TurnRunState(v)
-		_field = &tmp
+		_field = v
 	}
-	p.TurnRunState = _field
+	p.ItemID = _field
 	return nil
 }
-func (p *TurnSystemInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *ItemResult_) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TurnResult_, 0, size)
+	values := make([]TurnResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.TurnResults = _field
+	return nil
+}
+func (p *ItemResult_) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewItemSystemInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.SystemInfo = _field
+	return nil
+}
+func (p *ItemResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.LogID = _field
+	p.ItemIndex = _field
 	return nil
 }
-func (p *TurnSystemInfo) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewRunError()
-	if err := _field.Read(iprot); err != nil {
+func (p *ItemResult_) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
 		return err
 	}
-	p.Error = _field
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Ext = _field
 	return nil
 }
 
-func (p *TurnSystemInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *ItemResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TurnSystemInfo"); err != nil {
+	if err = oprot.WriteStructBegin("ItemResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12764,6 +13414,14 @@ func (p *TurnSystemInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -12782,12 +13440,36 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TurnSystemInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTurnRunState() {
-		if err = oprot.WriteFieldBegin("turn_run_state", thrift.I32, 1); err != nil {
+func (p *ItemResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("item_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ItemID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ItemResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnResults() {
+		if err = oprot.WriteFieldBegin("turn_results", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.TurnRunState)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TurnResults)); err != nil {
+			return err
+		}
+		for _, v := range p.TurnResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12796,16 +13478,16 @@ func (p *TurnSystemInfo) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *TurnSystemInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLogID() {
-		if err = oprot.WriteFieldBegin("log_id", thrift.STRING, 2); err != nil {
+func (p *ItemResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSystemInfo() {
+		if err = oprot.WriteFieldBegin("system_info", thrift.STRUCT, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.LogID); err != nil {
+		if err := p.SystemInfo.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12814,16 +13496,16 @@ func (p *TurnSystemInfo) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *TurnSystemInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetError() {
-		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 3); err != nil {
+func (p *ItemResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetItemIndex() {
+		if err = oprot.WriteFieldBegin("item_index", thrift.I64, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Error.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.ItemIndex); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -12832,140 +13514,197 @@ func (p *TurnSystemInfo) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ItemResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExt() {
+		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Ext)); err != nil {
+			return err
+		}
+		for k, v := range p.Ext {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *TurnSystemInfo) String() string {
+func (p *ItemResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TurnSystemInfo(%+v)", *p)
+	return fmt.Sprintf("ItemResult_(%+v)", *p)
 
 }
 
-func (p *TurnSystemInfo) DeepEqual(ano *TurnSystemInfo) bool {
+func (p *ItemResult_) DeepEqual(ano *ItemResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TurnRunState) {
+	if !p.Field1DeepEqual(ano.ItemID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.LogID) {
+	if !p.Field2DeepEqual(ano.TurnResults) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Error) {
+	if !p.Field3DeepEqual(ano.SystemInfo) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ItemIndex) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Ext) {
 		return false
 	}
 	return true
 }
 
-func (p *TurnSystemInfo) Field1DeepEqual(src *TurnRunState) bool {
+func (p *ItemResult_) Field1DeepEqual(src int64) bool {
 
-	if p.TurnRunState == src {
-		return true
-	} else if p.TurnRunState == nil || src == nil {
+	if p.ItemID != src {
 		return false
 	}
-	if *p.TurnRunState != *src {
+	return true
+}
+func (p *ItemResult_) Field2DeepEqual(src []*TurnResult_) bool {
+
+	if len(p.TurnResults) != len(src) {
 		return false
 	}
+	for i, v := range p.TurnResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
-func (p *TurnSystemInfo) Field2DeepEqual(src *string) bool {
+func (p *ItemResult_) Field3DeepEqual(src *ItemSystemInfo) bool {
 
-	if p.LogID == src {
+	if !p.SystemInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ItemResult_) Field4DeepEqual(src *int64) bool {
+
+	if p.ItemIndex == src {
 		return true
-	} else if p.LogID == nil || src == nil {
+	} else if p.ItemIndex == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.LogID, *src) != 0 {
+	if *p.ItemIndex != *src {
 		return false
 	}
 	return true
 }
-func (p *TurnSystemInfo) Field3DeepEqual(src *RunError) bool {
+func (p *ItemResult_) Field5DeepEqual(src map[string]string) bool {
 
-	if !p.Error.DeepEqual(src) {
+	if len(p.Ext) != len(src) {
 		return false
 	}
+	for k, v := range p.Ext {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
 	return true
 }
 
-type RunError struct {
-	Code    int64   `thrift:"code,1,required" frugal:"1,required,i64" json:"code" form:"code,required" query:"code,required"`
-	Message *string `thrift:"message,2,optional" frugal:"2,optional,string" form:"message" json:"message,omitempty" query:"message"`
-	Detail  *string `thrift:"detail,3,optional" frugal:"3,optional,string" form:"detail" json:"detail,omitempty" query:"detail"`
+// 行级结果 可能包含多个实验
+type TurnResult_ struct {
+	TurnID int64 `thrift:"turn_id,1" frugal:"1,default,i64" json:"turn_id" form:"turn_id" query:"turn_id"`
+	// 参与对比的实验序列，对于单报告序列长度为1
+	ExperimentResults []*ExperimentResult_ `thrift:"experiment_results,2,optional" frugal:"2,optional,list<ExperimentResult_>" form:"experiment_results" json:"experiment_results,omitempty" query:"experiment_results"`
+	TurnIndex         *int64               `thrift:"turn_index,3,optional" frugal:"3,optional,i64" json:"turn_index" form:"turn_index" query:"turn_index"`
 }
 
-func NewRunError() *RunError {
-	return &RunError{}
+func NewTurnResult_() *TurnResult_ {
+	return &TurnResult_{}
 }
 
-func (p *RunError) InitDefault() {
+func (p *TurnResult_) InitDefault() {
 }
 
-func (p *RunError) GetCode() (v int64) {
+func (p *TurnResult_) GetTurnID() (v int64) {
 	if p != nil {
-		return p.Code
+		return p.TurnID
 	}
 	return
 }
 
-var RunError_Message_DEFAULT string
+var TurnResult__ExperimentResults_DEFAULT []*ExperimentResult_
 
-func (p *RunError) GetMessage() (v string) {
+func (p *TurnResult_) GetExperimentResults() (v []*ExperimentResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetMessage() {
-		return RunError_Message_DEFAULT
+	if !p.IsSetExperimentResults() {
+		return TurnResult__ExperimentResults_DEFAULT
 	}
-	return *p.Message
+	return p.ExperimentResults
 }
 
-var RunError_Detail_DEFAULT string
+var TurnResult__TurnIndex_DEFAULT int64
 
-func (p *RunError) GetDetail() (v string) {
+func (p *TurnResult_) GetTurnIndex() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDetail() {
-		return RunError_Detail_DEFAULT
+	if !p.IsSetTurnIndex() {
+		return TurnResult__TurnIndex_DEFAULT
 	}
-	return *p.Detail
+	return *p.TurnIndex
 }
-func (p *RunError) SetCode(val int64) {
-	p.Code = val
+func (p *TurnResult_) SetTurnID(val int64) {
+	p.TurnID = val
 }
-func (p *RunError) SetMessage(val *string) {
-	p.Message = val
+func (p *TurnResult_) SetExperimentResults(val []*ExperimentResult_) {
+	p.ExperimentResults = val
 }
-func (p *RunError) SetDetail(val *string) {
-	p.Detail = val
+func (p *TurnResult_) SetTurnIndex(val *int64) {
+	p.TurnIndex = val
 }
 
-var fieldIDToName_RunError = map[int16]string{
-	1: "code",
-	2: "message",
-	3: "detail",
+var fieldIDToName_TurnResult_ = map[int16]string{
+	1: "turn_id",
+	2: "experiment_results",
+	3: "turn_index",
 }
 
-func (p *RunError) IsSetMessage() bool {
-	return p.Message != nil
+func (p *TurnResult_) IsSetExperimentResults() bool {
+	return p.ExperimentResults != nil
 }
 
-func (p *RunError) IsSetDetail() bool {
-	return p.Detail != nil
+func (p *TurnResult_) IsSetTurnIndex() bool {
+	return p.TurnIndex != nil
 }
 
-func (p *RunError) Read(iprot thrift.TProtocol) (err error) {
+func (p *TurnResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetCode bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -12986,12 +13725,11 @@ func (p *RunError) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCode = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -12999,7 +13737,7 @@ func (p *RunError) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13019,17 +13757,13 @@ func (p *RunError) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetCode {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunError[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13037,11 +13771,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RunError[fieldId]))
 }
 
-func (p *RunError) ReadField1(iprot thrift.TProtocol) error {
+func (p *TurnResult_) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -13049,35 +13781,47 @@ func (p *RunError) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Code = _field
+	p.TurnID = _field
 	return nil
 }
-func (p *RunError) ReadField2(iprot thrift.TProtocol) error {
+func (p *TurnResult_) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExperimentResult_, 0, size)
+	values := make([]ExperimentResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Message = _field
+	p.ExperimentResults = _field
 	return nil
 }
-func (p *RunError) ReadField3(iprot thrift.TProtocol) error {
+func (p *TurnResult_) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Detail = _field
+	p.TurnIndex = _field
 	return nil
 }
 
-func (p *RunError) Write(oprot thrift.TProtocol) (err error) {
+func (p *TurnResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RunError"); err != nil {
+	if err = oprot.WriteStructBegin("TurnResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13111,11 +13855,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RunError) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("code", thrift.I64, 1); err != nil {
+func (p *TurnResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.Code); err != nil {
+	if err := oprot.WriteI64(p.TurnID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13127,12 +13871,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *RunError) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMessage() {
-		if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+func (p *TurnResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExperimentResults() {
+		if err = oprot.WriteFieldBegin("experiment_results", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Message); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ExperimentResults)); err != nil {
+			return err
+		}
+		for _, v := range p.ExperimentResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13145,12 +13897,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *RunError) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDetail() {
-		if err = oprot.WriteFieldBegin("detail", thrift.STRING, 3); err != nil {
+func (p *TurnResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnIndex() {
+		if err = oprot.WriteFieldBegin("turn_index", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Detail); err != nil {
+		if err := oprot.WriteI64(*p.TurnIndex); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13164,101 +13916,115 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *RunError) String() string {
+func (p *TurnResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RunError(%+v)", *p)
+	return fmt.Sprintf("TurnResult_(%+v)", *p)
 
 }
 
-func (p *RunError) DeepEqual(ano *RunError) bool {
+func (p *TurnResult_) DeepEqual(ano *TurnResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Code) {
+	if !p.Field1DeepEqual(ano.TurnID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Message) {
+	if !p.Field2DeepEqual(ano.ExperimentResults) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Detail) {
+	if !p.Field3DeepEqual(ano.TurnIndex) {
 		return false
 	}
 	return true
 }
 
-func (p *RunError) Field1DeepEqual(src int64) bool {
+func (p *TurnResult_) Field1DeepEqual(src int64) bool {
 
-	if p.Code != src {
+	if p.TurnID != src {
 		return false
 	}
 	return true
 }
-func (p *RunError) Field2DeepEqual(src *string) bool {
+func (p *TurnResult_) Field2DeepEqual(src []*ExperimentResult_) bool {
 
-	if p.Message == src {
-		return true
-	} else if p.Message == nil || src == nil {
+	if len(p.ExperimentResults) != len(src) {
 		return false
 	}
-	if strings.Compare(*p.Message, *src) != 0 {
-		return false
+	for i, v := range p.ExperimentResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *RunError) Field3DeepEqual(src *string) bool {
+func (p *TurnResult_) Field3DeepEqual(src *int64) bool {
 
-	if p.Detail == src {
+	if p.TurnIndex == src {
 		return true
-	} else if p.Detail == nil || src == nil {
+	} else if p.TurnIndex == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Detail, *src) != 0 {
+	if *p.TurnIndex != *src {
 		return false
 	}
 	return true
 }
 
-type TurnEvalSet struct {
-	Turn *eval_set.Turn `thrift:"turn,1" frugal:"1,default,eval_set.Turn" form:"turn" json:"turn" query:"turn"`
+type ExperimentResult_ struct {
+	ExperimentID int64                  `thrift:"experiment_id,1,required" frugal:"1,required,i64" json:"experiment_id" form:"experiment_id,required" query:"experiment_id,required"`
+	Payload      *ExperimentTurnPayload `thrift:"payload,2,optional" frugal:"2,optional,ExperimentTurnPayload" form:"payload" json:"payload,omitempty" query:"payload"`
 }
 
-func NewTurnEvalSet() *TurnEvalSet {
-	return &TurnEvalSet{}
+func NewExperimentResult_() *ExperimentResult_ {
+	return &ExperimentResult_{}
 }
 
-func (p *TurnEvalSet) InitDefault() {
+func (p *ExperimentResult_) InitDefault() {
 }
 
-var TurnEvalSet_Turn_DEFAULT *eval_set.Turn
-
-func (p *TurnEvalSet) GetTurn() (v *eval_set.Turn) {
+func (p *ExperimentResult_) GetExperimentID() (v int64) {
+	if p != nil {
+		return p.ExperimentID
+	}
+	return
+}
+
+var ExperimentResult__Payload_DEFAULT *ExperimentTurnPayload
+
+func (p *ExperimentResult_) GetPayload() (v *ExperimentTurnPayload) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTurn() {
-		return TurnEvalSet_Turn_DEFAULT
+	if !p.IsSetPayload() {
+		return ExperimentResult__Payload_DEFAULT
 	}
-	return p.Turn
+	return p.Payload
 }
-func (p *TurnEvalSet) SetTurn(val *eval_set.Turn) {
-	p.Turn = val
+func (p *ExperimentResult_) SetExperimentID(val int64) {
+	p.ExperimentID = val
+}
+func (p *ExperimentResult_) SetPayload(val *ExperimentTurnPayload) {
+	p.Payload = val
 }
 
-var fieldIDToName_TurnEvalSet = map[int16]string{
-	1: "turn",
+var fieldIDToName_ExperimentResult_ = map[int16]string{
+	1: "experiment_id",
+	2: "payload",
 }
 
-func (p *TurnEvalSet) IsSetTurn() bool {
-	return p.Turn != nil
+func (p *ExperimentResult_) IsSetPayload() bool {
+	return p.Payload != nil
 }
 
-func (p *TurnEvalSet) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetExperimentID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -13275,10 +14041,19 @@ func (p *TurnEvalSet) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetExperimentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -13295,13 +14070,17 @@ func (p *TurnEvalSet) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetExperimentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnEvalSet[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13309,20 +14088,33 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExperimentResult_[fieldId]))
 }
 
-func (p *TurnEvalSet) ReadField1(iprot thrift.TProtocol) error {
-	_field := eval_set.NewTurn()
+func (p *ExperimentResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExperimentID = _field
+	return nil
+}
+func (p *ExperimentResult_) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewExperimentTurnPayload()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Turn = _field
+	p.Payload = _field
 	return nil
 }
 
-func (p *TurnEvalSet) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TurnEvalSet"); err != nil {
+	if err = oprot.WriteStructBegin("ExperimentResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13330,6 +14122,10 @@ func (p *TurnEvalSet) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -13348,11 +14144,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TurnEvalSet) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("turn", thrift.STRUCT, 1); err != nil {
+func (p *ExperimentResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Turn.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.ExperimentID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13364,70 +14160,140 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *ExperimentResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPayload() {
+		if err = oprot.WriteFieldBegin("payload", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Payload.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 
-func (p *TurnEvalSet) String() string {
+func (p *ExperimentResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TurnEvalSet(%+v)", *p)
+	return fmt.Sprintf("ExperimentResult_(%+v)", *p)
 
 }
 
-func (p *TurnEvalSet) DeepEqual(ano *TurnEvalSet) bool {
+func (p *ExperimentResult_) DeepEqual(ano *ExperimentResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Turn) {
+	if !p.Field1DeepEqual(ano.ExperimentID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Payload) {
 		return false
 	}
 	return true
 }
 
-func (p *TurnEvalSet) Field1DeepEqual(src *eval_set.Turn) bool {
+func (p *ExperimentResult_) Field1DeepEqual(src int64) bool {
 
-	if !p.Turn.DeepEqual(src) {
+	if p.ExperimentID != src {
 		return false
 	}
 	return true
 }
+func (p *ExperimentResult_) Field2DeepEqual(src *ExperimentTurnPayload) bool {
 
-type TurnTargetOutput struct {
-	EvalTargetRecord *eval_target.EvalTargetRecord `thrift:"eval_target_record,1,optional" frugal:"1,optional,eval_target.EvalTargetRecord" form:"eval_target_record" json:"eval_target_record,omitempty" query:"eval_target_record"`
+	if !p.Payload.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewTurnTargetOutput() *TurnTargetOutput {
-	return &TurnTargetOutput{}
+type TurnSystemInfo struct {
+	TurnRunState *TurnRunState `thrift:"turn_run_state,1,optional" frugal:"1,optional,TurnRunState" form:"turn_run_state" json:"turn_run_state,omitempty" query:"turn_run_state"`
+	LogID        *string       `thrift:"log_id,2,optional" frugal:"2,optional,string" form:"log_id" json:"log_id,omitempty" query:"log_id"`
+	Error        *RunError     `thrift:"error,3,optional" frugal:"3,optional,RunError" form:"error" json:"error,omitempty" query:"error"`
 }
 
-func (p *TurnTargetOutput) InitDefault() {
+func NewTurnSystemInfo() *TurnSystemInfo {
+	return &TurnSystemInfo{}
 }
 
-var TurnTargetOutput_EvalTargetRecord_DEFAULT *eval_target.EvalTargetRecord
+func (p *TurnSystemInfo) InitDefault() {
+}
 
-func (p *TurnTargetOutput) GetEvalTargetRecord() (v *eval_target.EvalTargetRecord) {
+var TurnSystemInfo_TurnRunState_DEFAULT TurnRunState
+
+func (p *TurnSystemInfo) GetTurnRunState() (v TurnRunState) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvalTargetRecord() {
-		return TurnTargetOutput_EvalTargetRecord_DEFAULT
+	if !p.IsSetTurnRunState() {
+		return TurnSystemInfo_TurnRunState_DEFAULT
 	}
-	return p.EvalTargetRecord
+	return *p.TurnRunState
 }
-func (p *TurnTargetOutput) SetEvalTargetRecord(val *eval_target.EvalTargetRecord) {
-	p.EvalTargetRecord = val
+
+var TurnSystemInfo_LogID_DEFAULT string
+
+func (p *TurnSystemInfo) GetLogID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLogID() {
+		return TurnSystemInfo_LogID_DEFAULT
+	}
+	return *p.LogID
 }
 
-var fieldIDToName_TurnTargetOutput = map[int16]string{
-	1: "eval_target_record",
+var TurnSystemInfo_Error_DEFAULT *RunError
+
+func (p *TurnSystemInfo) GetError() (v *RunError) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetError() {
+		return TurnSystemInfo_Error_DEFAULT
+	}
+	return p.Error
+}
+func (p *TurnSystemInfo) SetTurnRunState(val *TurnRunState) {
+	p.TurnRunState = val
+}
+func (p *TurnSystemInfo) SetLogID(val *string) {
+	p.LogID = val
+}
+func (p *TurnSystemInfo) SetError(val *RunError) {
+	p.Error = val
 }
 
-func (p *TurnTargetOutput) IsSetEvalTargetRecord() bool {
-	return p.EvalTargetRecord != nil
+var fieldIDToName_TurnSystemInfo = map[int16]string{
+	1: "turn_run_state",
+	2: "log_id",
+	3: "error",
 }
 
-func (p *TurnTargetOutput) Read(iprot thrift.TProtocol) (err error) {
+func (p *TurnSystemInfo) IsSetTurnRunState() bool {
+	return p.TurnRunState != nil
+}
+
+func (p *TurnSystemInfo) IsSetLogID() bool {
+	return p.LogID != nil
+}
+
+func (p *TurnSystemInfo) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *TurnSystemInfo) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -13446,13 +14312,29 @@ func (p *TurnTargetOutput) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -13472,7 +14354,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnTargetOutput[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnSystemInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13482,18 +14364,41 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TurnTargetOutput) ReadField1(iprot thrift.TProtocol) error {
-	_field := eval_target.NewEvalTargetRecord()
+func (p *TurnSystemInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *TurnRunState
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := TurnRunState(v)
+		_field = &tmp
+	}
+	p.TurnRunState = _field
+	return nil
+}
+func (p *TurnSystemInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LogID = _field
+	return nil
+}
+func (p *TurnSystemInfo) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewRunError()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.EvalTargetRecord = _field
+	p.Error = _field
 	return nil
 }
 
-func (p *TurnTargetOutput) Write(oprot thrift.TProtocol) (err error) {
+func (p *TurnSystemInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TurnTargetOutput"); err != nil {
+	if err = oprot.WriteStructBegin("TurnSystemInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13501,8 +14406,16 @@ func (p *TurnTargetOutput) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
 	}
 	if err = oprot.WriteStructEnd(); err != nil {
@@ -13519,12 +14432,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TurnTargetOutput) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvalTargetRecord() {
-		if err = oprot.WriteFieldBegin("eval_target_record", thrift.STRUCT, 1); err != nil {
+func (p *TurnSystemInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurnRunState() {
+		if err = oprot.WriteFieldBegin("turn_run_state", thrift.I32, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.EvalTargetRecord.Write(oprot); err != nil {
+		if err := oprot.WriteI32(int32(*p.TurnRunState)); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13537,85 +14450,172 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *TurnSystemInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLogID() {
+		if err = oprot.WriteFieldBegin("log_id", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.LogID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *TurnSystemInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Error.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
-func (p *TurnTargetOutput) String() string {
+func (p *TurnSystemInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TurnTargetOutput(%+v)", *p)
+	return fmt.Sprintf("TurnSystemInfo(%+v)", *p)
 
 }
 
-func (p *TurnTargetOutput) DeepEqual(ano *TurnTargetOutput) bool {
+func (p *TurnSystemInfo) DeepEqual(ano *TurnSystemInfo) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvalTargetRecord) {
+	if !p.Field1DeepEqual(ano.TurnRunState) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.LogID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Error) {
 		return false
 	}
 	return true
 }
 
-func (p *TurnTargetOutput) Field1DeepEqual(src *eval_target.EvalTargetRecord) bool {
+func (p *TurnSystemInfo) Field1DeepEqual(src *TurnRunState) bool {
 
-	if !p.EvalTargetRecord.DeepEqual(src) {
+	if p.TurnRunState == src {
+		return true
+	} else if p.TurnRunState == nil || src == nil {
+		return false
+	}
+	if *p.TurnRunState != *src {
 		return false
 	}
 	return true
 }
+func (p *TurnSystemInfo) Field2DeepEqual(src *string) bool {
 
-type TurnEvaluatorOutput struct {
-	EvaluatorRecords map[int64]*evaluator.EvaluatorRecord `thrift:"evaluator_records,1" frugal:"1,default,map<i64:evaluator.EvaluatorRecord>" json:"evaluator_records" form:"evaluator_records" query:"evaluator_records"`
-	// 加权汇总得分
-	WeightedScore *float64 `thrift:"weighted_score,11,optional" frugal:"11,optional,double" json:"weighted_score" form:"weighted_score" query:"weighted_score"`
+	if p.LogID == src {
+		return true
+	} else if p.LogID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.LogID, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *TurnSystemInfo) Field3DeepEqual(src *RunError) bool {
 
-func NewTurnEvaluatorOutput() *TurnEvaluatorOutput {
-	return &TurnEvaluatorOutput{}
+	if !p.Error.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func (p *TurnEvaluatorOutput) InitDefault() {
+type RunError struct {
+	Code    int64   `thrift:"code,1,required" frugal:"1,required,i64" json:"code" form:"code,required" query:"code,required"`
+	Message *string `thrift:"message,2,optional" frugal:"2,optional,string" form:"message" json:"message,omitempty" query:"message"`
+	Detail  *string `thrift:"detail,3,optional" frugal:"3,optional,string" form:"detail" json:"detail,omitempty" query:"detail"`
 }
 
-func (p *TurnEvaluatorOutput) GetEvaluatorRecords() (v map[int64]*evaluator.EvaluatorRecord) {
+func NewRunError() *RunError {
+	return &RunError{}
+}
+
+func (p *RunError) InitDefault() {
+}
+
+func (p *RunError) GetCode() (v int64) {
 	if p != nil {
-		return p.EvaluatorRecords
+		return p.Code
 	}
 	return
 }
 
-var TurnEvaluatorOutput_WeightedScore_DEFAULT float64
+var RunError_Message_DEFAULT string
 
-func (p *TurnEvaluatorOutput) GetWeightedScore() (v float64) {
+func (p *RunError) GetMessage() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetWeightedScore() {
-		return TurnEvaluatorOutput_WeightedScore_DEFAULT
+	if !p.IsSetMessage() {
+		return RunError_Message_DEFAULT
 	}
-	return *p.WeightedScore
+	return *p.Message
 }
-func (p *TurnEvaluatorOutput) SetEvaluatorRecords(val map[int64]*evaluator.EvaluatorRecord) {
-	p.EvaluatorRecords = val
+
+var RunError_Detail_DEFAULT string
+
+func (p *RunError) GetDetail() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDetail() {
+		return RunError_Detail_DEFAULT
+	}
+	return *p.Detail
 }
-func (p *TurnEvaluatorOutput) SetWeightedScore(val *float64) {
-	p.WeightedScore = val
+func (p *RunError) SetCode(val int64) {
+	p.Code = val
+}
+func (p *RunError) SetMessage(val *string) {
+	p.Message = val
+}
+func (p *RunError) SetDetail(val *string) {
+	p.Detail = val
 }
 
-var fieldIDToName_TurnEvaluatorOutput = map[int16]string{
-	1:  "evaluator_records",
-	11: "weighted_score",
+var fieldIDToName_RunError = map[int16]string{
+	1: "code",
+	2: "message",
+	3: "detail",
 }
 
-func (p *TurnEvaluatorOutput) IsSetWeightedScore() bool {
-	return p.WeightedScore != nil
+func (p *RunError) IsSetMessage() bool {
+	return p.Message != nil
 }
 
-func (p *TurnEvaluatorOutput) Read(iprot thrift.TProtocol) (err error) {
+func (p *RunError) IsSetDetail() bool {
+	return p.Detail != nil
+}
+
+func (p *RunError) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetCode bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -13632,16 +14632,25 @@ func (p *TurnEvaluatorOutput) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetCode = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 11:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField11(iprot); err != nil {
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -13660,13 +14669,17 @@ func (p *TurnEvaluatorOutput) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetCode {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnEvaluatorOutput[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunError[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13674,52 +14687,47 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RunError[fieldId]))
 }
 
-func (p *TurnEvaluatorOutput) ReadField1(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
+func (p *RunError) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	_field := make(map[int64]*evaluator.EvaluatorRecord, size)
-	values := make([]evaluator.EvaluatorRecord, size)
-	for i := 0; i < size; i++ {
-		var _key int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if err := _val.Read(iprot); err != nil {
-			return err
-		}
+	p.Code = _field
+	return nil
+}
+func (p *RunError) ReadField2(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvaluatorRecords = _field
+	p.Message = _field
 	return nil
 }
-func (p *TurnEvaluatorOutput) ReadField11(iprot thrift.TProtocol) error {
+func (p *RunError) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.WeightedScore = _field
+	p.Detail = _field
 	return nil
 }
 
-func (p *TurnEvaluatorOutput) Write(oprot thrift.TProtocol) (err error) {
+func (p *RunError) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TurnEvaluatorOutput"); err != nil {
+	if err = oprot.WriteStructBegin("RunError"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13727,8 +14735,12 @@ func (p *TurnEvaluatorOutput) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
 			goto WriteFieldError
 		}
 	}
@@ -13749,22 +14761,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TurnEvaluatorOutput) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_records", thrift.MAP, 1); err != nil {
+func (p *RunError) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteMapBegin(thrift.I64, thrift.STRUCT, len(p.EvaluatorRecords)); err != nil {
-		return err
-	}
-	for k, v := range p.EvaluatorRecords {
-		if err := oprot.WriteI64(k); err != nil {
-			return err
-		}
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteMapEnd(); err != nil {
+	if err := oprot.WriteI64(p.Code); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -13776,12 +14777,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *TurnEvaluatorOutput) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWeightedScore() {
-		if err = oprot.WriteFieldBegin("weighted_score", thrift.DOUBLE, 11); err != nil {
+func (p *RunError) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.WeightedScore); err != nil {
+		if err := oprot.WriteString(*p.Message); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -13790,87 +14791,122 @@ func (p *TurnEvaluatorOutput) writeField11(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RunError) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDetail() {
+		if err = oprot.WriteFieldBegin("detail", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Detail); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TurnEvaluatorOutput) String() string {
+func (p *RunError) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TurnEvaluatorOutput(%+v)", *p)
+	return fmt.Sprintf("RunError(%+v)", *p)
 
 }
 
-func (p *TurnEvaluatorOutput) DeepEqual(ano *TurnEvaluatorOutput) bool {
+func (p *RunError) DeepEqual(ano *RunError) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvaluatorRecords) {
+	if !p.Field1DeepEqual(ano.Code) {
 		return false
 	}
-	if !p.Field11DeepEqual(ano.WeightedScore) {
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Detail) {
 		return false
 	}
 	return true
 }
 
-func (p *TurnEvaluatorOutput) Field1DeepEqual(src map[int64]*evaluator.EvaluatorRecord) bool {
+func (p *RunError) Field1DeepEqual(src int64) bool {
 
-	if len(p.EvaluatorRecords) != len(src) {
+	if p.Code != src {
 		return false
 	}
-	for k, v := range p.EvaluatorRecords {
-		_src := src[k]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	return true
+}
+func (p *RunError) Field2DeepEqual(src *string) bool {
+
+	if p.Message == src {
+		return true
+	} else if p.Message == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Message, *src) != 0 {
+		return false
 	}
 	return true
 }
-func (p *TurnEvaluatorOutput) Field11DeepEqual(src *float64) bool {
+func (p *RunError) Field3DeepEqual(src *string) bool {
 
-	if p.WeightedScore == src {
+	if p.Detail == src {
 		return true
-	} else if p.WeightedScore == nil || src == nil {
+	} else if p.Detail == nil || src == nil {
 		return false
 	}
-	if *p.WeightedScore != *src {
+	if strings.Compare(*p.Detail, *src) != 0 {
 		return false
 	}
 	return true
 }
 
-type TurnAnnotateResult_ struct {
-	// tag_key_id -> annotate_record
-	AnnotateRecords map[int64]*AnnotateRecord `thrift:"annotate_records,1" frugal:"1,default,map<i64:AnnotateRecord>" json:"annotate_records" form:"annotate_records" query:"annotate_records"`
+type TurnEvalSet struct {
+	Turn *eval_set.Turn `thrift:"turn,1" frugal:"1,default,eval_set.Turn" form:"turn" json:"turn" query:"turn"`
 }
 
-func NewTurnAnnotateResult_() *TurnAnnotateResult_ {
-	return &TurnAnnotateResult_{}
+func NewTurnEvalSet() *TurnEvalSet {
+	return &TurnEvalSet{}
 }
 
-func (p *TurnAnnotateResult_) InitDefault() {
+func (p *TurnEvalSet) InitDefault() {
 }
 
-func (p *TurnAnnotateResult_) GetAnnotateRecords() (v map[int64]*AnnotateRecord) {
-	if p != nil {
-		return p.AnnotateRecords
+var TurnEvalSet_Turn_DEFAULT *eval_set.Turn
+
+func (p *TurnEvalSet) GetTurn() (v *eval_set.Turn) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetTurn() {
+		return TurnEvalSet_Turn_DEFAULT
+	}
+	return p.Turn
 }
-func (p *TurnAnnotateResult_) SetAnnotateRecords(val map[int64]*AnnotateRecord) {
-	p.AnnotateRecords = val
+func (p *TurnEvalSet) SetTurn(val *eval_set.Turn) {
+	p.Turn = val
 }
 
-var fieldIDToName_TurnAnnotateResult_ = map[int16]string{
-	1: "annotate_records",
+var fieldIDToName_TurnEvalSet = map[int16]string{
+	1: "turn",
 }
 
-func (p *TurnAnnotateResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *TurnEvalSet) IsSetTurn() bool {
+	return p.Turn != nil
+}
+
+func (p *TurnEvalSet) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -13889,7 +14925,7 @@ func (p *TurnAnnotateResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -13915,7 +14951,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnAnnotateResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnEvalSet[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13925,39 +14961,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TurnAnnotateResult_) ReadField1(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[int64]*AnnotateRecord, size)
-	values := make([]AnnotateRecord, size)
-	for i := 0; i < size; i++ {
-		var _key int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if err := _val.Read(iprot); err != nil {
-			return err
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+func (p *TurnEvalSet) ReadField1(iprot thrift.TProtocol) error {
+	_field := eval_set.NewTurn()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.AnnotateRecords = _field
+	p.Turn = _field
 	return nil
 }
 
-func (p *TurnAnnotateResult_) Write(oprot thrift.TProtocol) (err error) {
+func (p *TurnEvalSet) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TurnAnnotateResult"); err != nil {
+	if err = oprot.WriteStructBegin("TurnEvalSet"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13983,22 +14998,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TurnAnnotateResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("annotate_records", thrift.MAP, 1); err != nil {
+func (p *TurnEvalSet) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("turn", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteMapBegin(thrift.I64, thrift.STRUCT, len(p.AnnotateRecords)); err != nil {
-		return err
-	}
-	for k, v := range p.AnnotateRecords {
-		if err := oprot.WriteI64(k); err != nil {
-			return err
-		}
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteMapEnd(); err != nil {
+	if err := p.Turn.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -14011,224 +15015,255 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TurnAnnotateResult_) String() string {
+func (p *TurnEvalSet) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TurnAnnotateResult_(%+v)", *p)
+	return fmt.Sprintf("TurnEvalSet(%+v)", *p)
 
 }
 
-func (p *TurnAnnotateResult_) DeepEqual(ano *TurnAnnotateResult_) bool {
+func (p *TurnEvalSet) DeepEqual(ano *TurnEvalSet) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.AnnotateRecords) {
+	if !p.Field1DeepEqual(ano.Turn) {
 		return false
 	}
 	return true
 }
 
-func (p *TurnAnnotateResult_) Field1DeepEqual(src map[int64]*AnnotateRecord) bool {
+func (p *TurnEvalSet) Field1DeepEqual(src *eval_set.Turn) bool {
 
-	if len(p.AnnotateRecords) != len(src) {
+	if !p.Turn.DeepEqual(src) {
 		return false
 	}
-	for k, v := range p.AnnotateRecords {
-		_src := src[k]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
 
-type AnnotateRecord struct {
-	AnnotateRecordID *int64 `thrift:"annotate_record_id,1,optional" frugal:"1,optional,i64" json:"annotate_record_id" form:"annotate_record_id" query:"annotate_record_id"`
-	// 标签ID
-	TagKeyID          *int64              `thrift:"tag_key_id,2,optional" frugal:"2,optional,i64" json:"tag_key_id" form:"tag_key_id" query:"tag_key_id"`
-	Score             *string             `thrift:"score,3,optional" frugal:"3,optional,string" form:"score" json:"score,omitempty" query:"score"`
-	BooleanOption     *string             `thrift:"boolean_option,4,optional" frugal:"4,optional,string" form:"boolean_option" json:"boolean_option,omitempty" query:"boolean_option"`
-	CategoricalOption *string             `thrift:"categorical_option,5,optional" frugal:"5,optional,string" form:"categorical_option" json:"categorical_option,omitempty" query:"categorical_option"`
-	PlainText         *string             `thrift:"plain_text,6,optional" frugal:"6,optional,string" form:"plain_text" json:"plain_text,omitempty" query:"plain_text"`
-	TagContentType    *tag.TagContentType `thrift:"tag_content_type,7,optional" frugal:"7,optional,string" form:"tag_content_type" json:"tag_content_type,omitempty" query:"tag_content_type"`
-	// 标签选项值ID
-	TagValueID *int64 `thrift:"tag_value_id,8,optional" frugal:"8,optional,i64" json:"tag_value_id" form:"tag_value_id" query:"tag_value_id"`
+type TurnTargetOutput struct {
+	EvalTargetRecord *eval_target.EvalTargetRecord `thrift:"eval_target_record,1,optional" frugal:"1,optional,eval_target.EvalTargetRecord" form:"eval_target_record" json:"eval_target_record,omitempty" query:"eval_target_record"`
 }
 
-func NewAnnotateRecord() *AnnotateRecord {
-	return &AnnotateRecord{}
+func NewTurnTargetOutput() *TurnTargetOutput {
+	return &TurnTargetOutput{}
 }
 
-func (p *AnnotateRecord) InitDefault() {
+func (p *TurnTargetOutput) InitDefault() {
 }
 
-var AnnotateRecord_AnnotateRecordID_DEFAULT int64
+var TurnTargetOutput_EvalTargetRecord_DEFAULT *eval_target.EvalTargetRecord
 
-func (p *AnnotateRecord) GetAnnotateRecordID() (v int64) {
+func (p *TurnTargetOutput) GetEvalTargetRecord() (v *eval_target.EvalTargetRecord) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAnnotateRecordID() {
-		return AnnotateRecord_AnnotateRecordID_DEFAULT
+	if !p.IsSetEvalTargetRecord() {
+		return TurnTargetOutput_EvalTargetRecord_DEFAULT
 	}
-	return *p.AnnotateRecordID
+	return p.EvalTargetRecord
 }
-
-var AnnotateRecord_TagKeyID_DEFAULT int64
-
-func (p *AnnotateRecord) GetTagKeyID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTagKeyID() {
-		return AnnotateRecord_TagKeyID_DEFAULT
-	}
-	return *p.TagKeyID
+func (p *TurnTargetOutput) SetEvalTargetRecord(val *eval_target.EvalTargetRecord) {
+	p.EvalTargetRecord = val
 }
 
-var AnnotateRecord_Score_DEFAULT string
+var fieldIDToName_TurnTargetOutput = map[int16]string{
+	1: "eval_target_record",
+}
 
-func (p *AnnotateRecord) GetScore() (v string) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetScore() {
-		return AnnotateRecord_Score_DEFAULT
-	}
-	return *p.Score
+func (p *TurnTargetOutput) IsSetEvalTargetRecord() bool {
+	return p.EvalTargetRecord != nil
 }
 
-var AnnotateRecord_BooleanOption_DEFAULT string
+func (p *TurnTargetOutput) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *AnnotateRecord) GetBooleanOption() (v string) {
-	if p == nil {
-		return
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if !p.IsSetBooleanOption() {
-		return AnnotateRecord_BooleanOption_DEFAULT
-	}
-	return *p.BooleanOption
-}
 
-var AnnotateRecord_CategoricalOption_DEFAULT string
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func (p *AnnotateRecord) GetCategoricalOption() (v string) {
-	if p == nil {
-		return
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if !p.IsSetCategoricalOption() {
-		return AnnotateRecord_CategoricalOption_DEFAULT
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return *p.CategoricalOption
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnTargetOutput[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-var AnnotateRecord_PlainText_DEFAULT string
+func (p *TurnTargetOutput) ReadField1(iprot thrift.TProtocol) error {
+	_field := eval_target.NewEvalTargetRecord()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvalTargetRecord = _field
+	return nil
+}
 
-func (p *AnnotateRecord) GetPlainText() (v string) {
-	if p == nil {
-		return
+func (p *TurnTargetOutput) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TurnTargetOutput"); err != nil {
+		goto WriteStructBeginError
 	}
-	if !p.IsSetPlainText() {
-		return AnnotateRecord_PlainText_DEFAULT
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	return *p.PlainText
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-var AnnotateRecord_TagContentType_DEFAULT tag.TagContentType
+func (p *TurnTargetOutput) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvalTargetRecord() {
+		if err = oprot.WriteFieldBegin("eval_target_record", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvalTargetRecord.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
 
-func (p *AnnotateRecord) GetTagContentType() (v tag.TagContentType) {
+func (p *TurnTargetOutput) String() string {
 	if p == nil {
-		return
-	}
-	if !p.IsSetTagContentType() {
-		return AnnotateRecord_TagContentType_DEFAULT
+		return "<nil>"
 	}
-	return *p.TagContentType
-}
+	return fmt.Sprintf("TurnTargetOutput(%+v)", *p)
 
-var AnnotateRecord_TagValueID_DEFAULT int64
+}
 
-func (p *AnnotateRecord) GetTagValueID() (v int64) {
-	if p == nil {
-		return
+func (p *TurnTargetOutput) DeepEqual(ano *TurnTargetOutput) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if !p.IsSetTagValueID() {
-		return AnnotateRecord_TagValueID_DEFAULT
+	if !p.Field1DeepEqual(ano.EvalTargetRecord) {
+		return false
 	}
-	return *p.TagValueID
-}
-func (p *AnnotateRecord) SetAnnotateRecordID(val *int64) {
-	p.AnnotateRecordID = val
-}
-func (p *AnnotateRecord) SetTagKeyID(val *int64) {
-	p.TagKeyID = val
-}
-func (p *AnnotateRecord) SetScore(val *string) {
-	p.Score = val
-}
-func (p *AnnotateRecord) SetBooleanOption(val *string) {
-	p.BooleanOption = val
-}
-func (p *AnnotateRecord) SetCategoricalOption(val *string) {
-	p.CategoricalOption = val
-}
-func (p *AnnotateRecord) SetPlainText(val *string) {
-	p.PlainText = val
-}
-func (p *AnnotateRecord) SetTagContentType(val *tag.TagContentType) {
-	p.TagContentType = val
-}
-func (p *AnnotateRecord) SetTagValueID(val *int64) {
-	p.TagValueID = val
+	return true
 }
 
-var fieldIDToName_AnnotateRecord = map[int16]string{
-	1: "annotate_record_id",
-	2: "tag_key_id",
-	3: "score",
-	4: "boolean_option",
-	5: "categorical_option",
-	6: "plain_text",
-	7: "tag_content_type",
-	8: "tag_value_id",
-}
+func (p *TurnTargetOutput) Field1DeepEqual(src *eval_target.EvalTargetRecord) bool {
 
-func (p *AnnotateRecord) IsSetAnnotateRecordID() bool {
-	return p.AnnotateRecordID != nil
+	if !p.EvalTargetRecord.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func (p *AnnotateRecord) IsSetTagKeyID() bool {
-	return p.TagKeyID != nil
+type TurnEvaluatorOutput struct {
+	EvaluatorRecords map[int64]*evaluator.EvaluatorRecord `thrift:"evaluator_records,1" frugal:"1,default,map<i64:evaluator.EvaluatorRecord>" json:"evaluator_records" form:"evaluator_records" query:"evaluator_records"`
+	// 加权汇总得分
+	WeightedScore *float64 `thrift:"weighted_score,11,optional" frugal:"11,optional,double" json:"weighted_score" form:"weighted_score" query:"weighted_score"`
 }
 
-func (p *AnnotateRecord) IsSetScore() bool {
-	return p.Score != nil
+func NewTurnEvaluatorOutput() *TurnEvaluatorOutput {
+	return &TurnEvaluatorOutput{}
 }
 
-func (p *AnnotateRecord) IsSetBooleanOption() bool {
-	return p.BooleanOption != nil
+func (p *TurnEvaluatorOutput) InitDefault() {
 }
 
-func (p *AnnotateRecord) IsSetCategoricalOption() bool {
-	return p.CategoricalOption != nil
+func (p *TurnEvaluatorOutput) GetEvaluatorRecords() (v map[int64]*evaluator.EvaluatorRecord) {
+	if p != nil {
+		return p.EvaluatorRecords
+	}
+	return
 }
 
-func (p *AnnotateRecord) IsSetPlainText() bool {
-	return p.PlainText != nil
+var TurnEvaluatorOutput_WeightedScore_DEFAULT float64
+
+func (p *TurnEvaluatorOutput) GetWeightedScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWeightedScore() {
+		return TurnEvaluatorOutput_WeightedScore_DEFAULT
+	}
+	return *p.WeightedScore
+}
+func (p *TurnEvaluatorOutput) SetEvaluatorRecords(val map[int64]*evaluator.EvaluatorRecord) {
+	p.EvaluatorRecords = val
+}
+func (p *TurnEvaluatorOutput) SetWeightedScore(val *float64) {
+	p.WeightedScore = val
 }
 
-func (p *AnnotateRecord) IsSetTagContentType() bool {
-	return p.TagContentType != nil
+var fieldIDToName_TurnEvaluatorOutput = map[int16]string{
+	1:  "evaluator_records",
+	11: "weighted_score",
 }
 
-func (p *AnnotateRecord) IsSetTagValueID() bool {
-	return p.TagValueID != nil
+func (p *TurnEvaluatorOutput) IsSetWeightedScore() bool {
+	return p.WeightedScore != nil
 }
 
-func (p *AnnotateRecord) Read(iprot thrift.TProtocol) (err error) {
+func (p *TurnEvaluatorOutput) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -14247,71 +15282,23 @@ func (p *AnnotateRecord) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
+		case 11:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField11(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
@@ -14329,7 +15316,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AnnotateRecord[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnEvaluatorOutput[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14339,98 +15326,50 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AnnotateRecord) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.AnnotateRecordID = _field
-	return nil
-}
-func (p *AnnotateRecord) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.TagKeyID = _field
-	return nil
-}
-func (p *AnnotateRecord) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Score = _field
-	return nil
-}
-func (p *AnnotateRecord) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TurnEvaluatorOutput) ReadField1(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.BooleanOption = _field
-	return nil
-}
-func (p *AnnotateRecord) ReadField5(iprot thrift.TProtocol) error {
+	_field := make(map[int64]*evaluator.EvaluatorRecord, size)
+	values := make([]evaluator.EvaluatorRecord, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.CategoricalOption = _field
-	return nil
-}
-func (p *AnnotateRecord) ReadField6(iprot thrift.TProtocol) error {
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+		_field[_key] = _val
 	}
-	p.PlainText = _field
-	return nil
-}
-func (p *AnnotateRecord) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field *tag.TagContentType
-	if v, err := iprot.ReadString(); err != nil {
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.TagContentType = _field
+	p.EvaluatorRecords = _field
 	return nil
 }
-func (p *AnnotateRecord) ReadField8(iprot thrift.TProtocol) error {
+func (p *TurnEvaluatorOutput) ReadField11(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.TagValueID = _field
+	p.WeightedScore = _field
 	return nil
 }
 
-func (p *AnnotateRecord) Write(oprot thrift.TProtocol) (err error) {
+func (p *TurnEvaluatorOutput) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AnnotateRecord"); err != nil {
+	if err = oprot.WriteStructBegin("TurnEvaluatorOutput"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14438,32 +15377,8 @@ func (p *AnnotateRecord) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
 			goto WriteFieldError
 		}
 	}
@@ -14484,30 +15399,39 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AnnotateRecord) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAnnotateRecordID() {
-		if err = oprot.WriteFieldBegin("annotate_record_id", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.AnnotateRecordID); err != nil {
+func (p *TurnEvaluatorOutput) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_records", thrift.MAP, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteMapBegin(thrift.I64, thrift.STRUCT, len(p.EvaluatorRecords)); err != nil {
+		return err
+	}
+	for k, v := range p.EvaluatorRecords {
+		if err := oprot.WriteI64(k); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err := v.Write(oprot); err != nil {
+			return err
 		}
 	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
 WriteFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AnnotateRecord) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTagKeyID() {
-		if err = oprot.WriteFieldBegin("tag_key_id", thrift.I64, 2); err != nil {
+func (p *TurnEvaluatorOutput) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeightedScore() {
+		if err = oprot.WriteFieldBegin("weighted_score", thrift.DOUBLE, 11); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TagKeyID); err != nil {
+		if err := oprot.WriteDouble(*p.WeightedScore); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -14516,106 +15440,3417 @@ func (p *AnnotateRecord) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
-func (p *AnnotateRecord) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScore() {
-		if err = oprot.WriteFieldBegin("score", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Score); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+func (p *TurnEvaluatorOutput) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return fmt.Sprintf("TurnEvaluatorOutput(%+v)", *p)
+
 }
-func (p *AnnotateRecord) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBooleanOption() {
-		if err = oprot.WriteFieldBegin("boolean_option", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.BooleanOption); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+func (p *TurnEvaluatorOutput) DeepEqual(ano *TurnEvaluatorOutput) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *AnnotateRecord) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCategoricalOption() {
-		if err = oprot.WriteFieldBegin("categorical_option", thrift.STRING, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.CategoricalOption); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if !p.Field1DeepEqual(ano.EvaluatorRecords) {
+		return false
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	if !p.Field11DeepEqual(ano.WeightedScore) {
+		return false
+	}
+	return true
 }
-func (p *AnnotateRecord) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPlainText() {
-		if err = oprot.WriteFieldBegin("plain_text", thrift.STRING, 6); err != nil {
-			goto WriteFieldBeginError
+
+func (p *TurnEvaluatorOutput) Field1DeepEqual(src map[int64]*evaluator.EvaluatorRecord) bool {
+
+	if len(p.EvaluatorRecords) != len(src) {
+		return false
+	}
+	for k, v := range p.EvaluatorRecords {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
 		}
-		if err := oprot.WriteString(*p.PlainText); err != nil {
+	}
+	return true
+}
+func (p *TurnEvaluatorOutput) Field11DeepEqual(src *float64) bool {
+
+	if p.WeightedScore == src {
+		return true
+	} else if p.WeightedScore == nil || src == nil {
+		return false
+	}
+	if *p.WeightedScore != *src {
+		return false
+	}
+	return true
+}
+
+type TurnAnnotateResult_ struct {
+	// tag_key_id -> annotate_record
+	AnnotateRecords map[int64]*AnnotateRecord `thrift:"annotate_records,1" frugal:"1,default,map<i64:AnnotateRecord>" json:"annotate_records" form:"annotate_records" query:"annotate_records"`
+}
+
+func NewTurnAnnotateResult_() *TurnAnnotateResult_ {
+	return &TurnAnnotateResult_{}
+}
+
+func (p *TurnAnnotateResult_) InitDefault() {
+}
+
+func (p *TurnAnnotateResult_) GetAnnotateRecords() (v map[int64]*AnnotateRecord) {
+	if p != nil {
+		return p.AnnotateRecords
+	}
+	return
+}
+func (p *TurnAnnotateResult_) SetAnnotateRecords(val map[int64]*AnnotateRecord) {
+	p.AnnotateRecords = val
+}
+
+var fieldIDToName_TurnAnnotateResult_ = map[int16]string{
+	1: "annotate_records",
+}
+
+func (p *TurnAnnotateResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnAnnotateResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TurnAnnotateResult_) ReadField1(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]*AnnotateRecord, size)
+	values := make([]AnnotateRecord, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
 			return err
+		} else {
+			_key = v
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
 		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
 	}
+	p.AnnotateRecords = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *AnnotateRecord) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTagContentType() {
-		if err = oprot.WriteFieldBegin("tag_content_type", thrift.STRING, 7); err != nil {
-			goto WriteFieldBeginError
+
+func (p *TurnAnnotateResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TurnAnnotateResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
-		if err := oprot.WriteString(*p.TagContentType); err != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TurnAnnotateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("annotate_records", thrift.MAP, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteMapBegin(thrift.I64, thrift.STRUCT, len(p.AnnotateRecords)); err != nil {
+		return err
+	}
+	for k, v := range p.AnnotateRecords {
+		if err := oprot.WriteI64(k); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err := v.Write(oprot); err != nil {
+			return err
 		}
 	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AnnotateRecord) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTagValueID() {
-		if err = oprot.WriteFieldBegin("tag_value_id", thrift.I64, 8); err != nil {
-			goto WriteFieldBeginError
+
+func (p *TurnAnnotateResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TurnAnnotateResult_(%+v)", *p)
+
+}
+
+func (p *TurnAnnotateResult_) DeepEqual(ano *TurnAnnotateResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.AnnotateRecords) {
+		return false
+	}
+	return true
+}
+
+func (p *TurnAnnotateResult_) Field1DeepEqual(src map[int64]*AnnotateRecord) bool {
+
+	if len(p.AnnotateRecords) != len(src) {
+		return false
+	}
+	for k, v := range p.AnnotateRecords {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
 		}
-		if err := oprot.WriteI64(*p.TagValueID); err != nil {
+	}
+	return true
+}
+
+type AnnotateRecord struct {
+	AnnotateRecordID *int64 `thrift:"annotate_record_id,1,optional" frugal:"1,optional,i64" json:"annotate_record_id" form:"annotate_record_id" query:"annotate_record_id"`
+	// 标签ID
+	TagKeyID          *int64              `thrift:"tag_key_id,2,optional" frugal:"2,optional,i64" json:"tag_key_id" form:"tag_key_id" query:"tag_key_id"`
+	Score             *string             `thrift:"score,3,optional" frugal:"3,optional,string" form:"score" json:"score,omitempty" query:"score"`
+	BooleanOption     *string             `thrift:"boolean_option,4,optional" frugal:"4,optional,string" form:"boolean_option" json:"boolean_option,omitempty" query:"boolean_option"`
+	CategoricalOption *string             `thrift:"categorical_option,5,optional" frugal:"5,optional,string" form:"categorical_option" json:"categorical_option,omitempty" query:"categorical_option"`
+	PlainText         *string             `thrift:"plain_text,6,optional" frugal:"6,optional,string" form:"plain_text" json:"plain_text,omitempty" query:"plain_text"`
+	TagContentType    *tag.TagContentType `thrift:"tag_content_type,7,optional" frugal:"7,optional,string" form:"tag_content_type" json:"tag_content_type,omitempty" query:"tag_content_type"`
+	// 标签选项值ID
+	TagValueID *int64 `thrift:"tag_value_id,8,optional" frugal:"8,optional,i64" json:"tag_value_id" form:"tag_value_id" query:"tag_value_id"`
+}
+
+func NewAnnotateRecord() *AnnotateRecord {
+	return &AnnotateRecord{}
+}
+
+func (p *AnnotateRecord) InitDefault() {
+}
+
+var AnnotateRecord_AnnotateRecordID_DEFAULT int64
+
+func (p *AnnotateRecord) GetAnnotateRecordID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAnnotateRecordID() {
+		return AnnotateRecord_AnnotateRecordID_DEFAULT
+	}
+	return *p.AnnotateRecordID
+}
+
+var AnnotateRecord_TagKeyID_DEFAULT int64
+
+func (p *AnnotateRecord) GetTagKeyID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTagKeyID() {
+		return AnnotateRecord_TagKeyID_DEFAULT
+	}
+	return *p.TagKeyID
+}
+
+var AnnotateRecord_Score_DEFAULT string
+
+func (p *AnnotateRecord) GetScore() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScore() {
+		return AnnotateRecord_Score_DEFAULT
+	}
+	return *p.Score
+}
+
+var AnnotateRecord_BooleanOption_DEFAULT string
+
+func (p *AnnotateRecord) GetBooleanOption() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBooleanOption() {
+		return AnnotateRecord_BooleanOption_DEFAULT
+	}
+	return *p.BooleanOption
+}
+
+var AnnotateRecord_CategoricalOption_DEFAULT string
+
+func (p *AnnotateRecord) GetCategoricalOption() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCategoricalOption() {
+		return AnnotateRecord_CategoricalOption_DEFAULT
+	}
+	return *p.CategoricalOption
+}
+
+var AnnotateRecord_PlainText_DEFAULT string
+
+func (p *AnnotateRecord) GetPlainText() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPlainText() {
+		return AnnotateRecord_PlainText_DEFAULT
+	}
+	return *p.PlainText
+}
+
+var AnnotateRecord_TagContentType_DEFAULT tag.TagContentType
+
+func (p *AnnotateRecord) GetTagContentType() (v tag.TagContentType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTagContentType() {
+		return AnnotateRecord_TagContentType_DEFAULT
+	}
+	return *p.TagContentType
+}
+
+var AnnotateRecord_TagValueID_DEFAULT int64
+
+func (p *AnnotateRecord) GetTagValueID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTagValueID() {
+		return AnnotateRecord_TagValueID_DEFAULT
+	}
+	return *p.TagValueID
+}
+func (p *AnnotateRecord) SetAnnotateRecordID(val *int64) {
+	p.AnnotateRecordID = val
+}
+func (p *AnnotateRecord) SetTagKeyID(val *int64) {
+	p.TagKeyID = val
+}
+func (p *AnnotateRecord) SetScore(val *string) {
+	p.Score = val
+}
+func (p *AnnotateRecord) SetBooleanOption(val *string) {
+	p.BooleanOption = val
+}
+func (p *AnnotateRecord) SetCategoricalOption(val *string) {
+	p.CategoricalOption = val
+}
+func (p *AnnotateRecord) SetPlainText(val *string) {
+	p.PlainText = val
+}
+func (p *AnnotateRecord) SetTagContentType(val *tag.TagContentType) {
+	p.TagContentType = val
+}
+func (p *AnnotateRecord) SetTagValueID(val *int64) {
+	p.TagValueID = val
+}
+
+var fieldIDToName_AnnotateRecord = map[int16]string{
+	1: "annotate_record_id",
+	2: "tag_key_id",
+	3: "score",
+	4: "boolean_option",
+	5: "categorical_option",
+	6: "plain_text",
+	7: "tag_content_type",
+	8: "tag_value_id",
+}
+
+func (p *AnnotateRecord) IsSetAnnotateRecordID() bool {
+	return p.AnnotateRecordID != nil
+}
+
+func (p *AnnotateRecord) IsSetTagKeyID() bool {
+	return p.TagKeyID != nil
+}
+
+func (p *AnnotateRecord) IsSetScore() bool {
+	return p.Score != nil
+}
+
+func (p *AnnotateRecord) IsSetBooleanOption() bool {
+	return p.BooleanOption != nil
+}
+
+func (p *AnnotateRecord) IsSetCategoricalOption() bool {
+	return p.CategoricalOption != nil
+}
+
+func (p *AnnotateRecord) IsSetPlainText() bool {
+	return p.PlainText != nil
+}
+
+func (p *AnnotateRecord) IsSetTagContentType() bool {
+	return p.TagContentType != nil
+}
+
+func (p *AnnotateRecord) IsSetTagValueID() bool {
+	return p.TagValueID != nil
+}
+
+func (p *AnnotateRecord) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AnnotateRecord[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AnnotateRecord) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AnnotateRecordID = _field
+	return nil
+}
+func (p *AnnotateRecord) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TagKeyID = _field
+	return nil
+}
+func (p *AnnotateRecord) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Score = _field
+	return nil
+}
+func (p *AnnotateRecord) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BooleanOption = _field
+	return nil
+}
+func (p *AnnotateRecord) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CategoricalOption = _field
+	return nil
+}
+func (p *AnnotateRecord) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PlainText = _field
+	return nil
+}
+func (p *AnnotateRecord) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *tag.TagContentType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TagContentType = _field
+	return nil
+}
+func (p *AnnotateRecord) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TagValueID = _field
+	return nil
+}
+
+func (p *AnnotateRecord) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AnnotateRecord"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AnnotateRecord) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnnotateRecordID() {
+		if err = oprot.WriteFieldBegin("annotate_record_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.AnnotateRecordID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AnnotateRecord) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTagKeyID() {
+		if err = oprot.WriteFieldBegin("tag_key_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TagKeyID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AnnotateRecord) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScore() {
+		if err = oprot.WriteFieldBegin("score", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Score); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *AnnotateRecord) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBooleanOption() {
+		if err = oprot.WriteFieldBegin("boolean_option", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.BooleanOption); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *AnnotateRecord) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCategoricalOption() {
+		if err = oprot.WriteFieldBegin("categorical_option", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CategoricalOption); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *AnnotateRecord) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlainText() {
+		if err = oprot.WriteFieldBegin("plain_text", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PlainText); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *AnnotateRecord) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTagContentType() {
+		if err = oprot.WriteFieldBegin("tag_content_type", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TagContentType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *AnnotateRecord) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTagValueID() {
+		if err = oprot.WriteFieldBegin("tag_value_id", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TagValueID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *AnnotateRecord) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnnotateRecord(%+v)", *p)
+
+}
+
+func (p *AnnotateRecord) DeepEqual(ano *AnnotateRecord) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.AnnotateRecordID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TagKeyID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Score) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.BooleanOption) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.CategoricalOption) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.PlainText) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.TagContentType) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.TagValueID) {
+		return false
+	}
+	return true
+}
+
+func (p *AnnotateRecord) Field1DeepEqual(src *int64) bool {
+
+	if p.AnnotateRecordID == src {
+		return true
+	} else if p.AnnotateRecordID == nil || src == nil {
+		return false
+	}
+	if *p.AnnotateRecordID != *src {
+		return false
+	}
+	return true
+}
+func (p *AnnotateRecord) Field2DeepEqual(src *int64) bool {
+
+	if p.TagKeyID == src {
+		return true
+	} else if p.TagKeyID == nil || src == nil {
+		return false
+	}
+	if *p.TagKeyID != *src {
+		return false
+	}
+	return true
+}
+func (p *AnnotateRecord) Field3DeepEqual(src *string) bool {
+
+	if p.Score == src {
+		return true
+	} else if p.Score == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Score, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AnnotateRecord) Field4DeepEqual(src *string) bool {
+
+	if p.BooleanOption == src {
+		return true
+	} else if p.BooleanOption == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.BooleanOption, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AnnotateRecord) Field5DeepEqual(src *string) bool {
+
+	if p.CategoricalOption == src {
+		return true
+	} else if p.CategoricalOption == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CategoricalOption, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AnnotateRecord) Field6DeepEqual(src *string) bool {
+
+	if p.PlainText == src {
+		return true
+	} else if p.PlainText == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PlainText, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AnnotateRecord) Field7DeepEqual(src *tag.TagContentType) bool {
+
+	if p.TagContentType == src {
+		return true
+	} else if p.TagContentType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TagContentType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AnnotateRecord) Field8DeepEqual(src *int64) bool {
+
+	if p.TagValueID == src {
+		return true
+	} else if p.TagValueID == nil || src == nil {
+		return false
+	}
+	if *p.TagValueID != *src {
+		return false
+	}
+	return true
+}
+
+// 实际行级payload
+type ExperimentTurnPayload struct {
+	TurnID int64 `thrift:"turn_id,1" frugal:"1,default,i64" json:"turn_id" form:"turn_id" query:"turn_id"`
+	// 评测数据集数据
+	EvalSet *TurnEvalSet `thrift:"eval_set,2,optional" frugal:"2,optional,TurnEvalSet" form:"eval_set" json:"eval_set,omitempty" query:"eval_set"`
+	// 评测对象结果
+	TargetOutput *TurnTargetOutput `thrift:"target_output,3,optional" frugal:"3,optional,TurnTargetOutput" form:"target_output" json:"target_output,omitempty" query:"target_output"`
+	// 评测规则执行结果
+	EvaluatorOutput *TurnEvaluatorOutput `thrift:"evaluator_output,4,optional" frugal:"4,optional,TurnEvaluatorOutput" form:"evaluator_output" json:"evaluator_output,omitempty" query:"evaluator_output"`
+	// 评测系统相关数据日志、error
+	SystemInfo *TurnSystemInfo `thrift:"system_info,5,optional" frugal:"5,optional,TurnSystemInfo" form:"system_info" json:"system_info,omitempty" query:"system_info"`
+	// 人工标注结果结果
+	AnnotateResult_ *TurnAnnotateResult_ `thrift:"annotate_result,6,optional" frugal:"6,optional,TurnAnnotateResult_" form:"annotate_result" json:"annotate_result,omitempty" query:"annotate_result"`
+	// 轨迹分析结果
+	TrajectoryAnalysisResult_ *TrajectoryAnalysisResult_ `thrift:"trajectory_analysis_result,7,optional" frugal:"7,optional,TrajectoryAnalysisResult_" form:"trajectory_analysis_result" json:"trajectory_analysis_result,omitempty" query:"trajectory_analysis_result"`
+}
+
+func NewExperimentTurnPayload() *ExperimentTurnPayload {
+	return &ExperimentTurnPayload{}
+}
+
+func (p *ExperimentTurnPayload) InitDefault() {
+}
+
+func (p *ExperimentTurnPayload) GetTurnID() (v int64) {
+	if p != nil {
+		return p.TurnID
+	}
+	return
+}
+
+var ExperimentTurnPayload_EvalSet_DEFAULT *TurnEvalSet
+
+func (p *ExperimentTurnPayload) GetEvalSet() (v *TurnEvalSet) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvalSet() {
+		return ExperimentTurnPayload_EvalSet_DEFAULT
+	}
+	return p.EvalSet
+}
+
+var ExperimentTurnPayload_TargetOutput_DEFAULT *TurnTargetOutput
+
+func (p *ExperimentTurnPayload) GetTargetOutput() (v *TurnTargetOutput) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetOutput() {
+		return ExperimentTurnPayload_TargetOutput_DEFAULT
+	}
+	return p.TargetOutput
+}
+
+var ExperimentTurnPayload_EvaluatorOutput_DEFAULT *TurnEvaluatorOutput
+
+func (p *ExperimentTurnPayload) GetEvaluatorOutput() (v *TurnEvaluatorOutput) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorOutput() {
+		return ExperimentTurnPayload_EvaluatorOutput_DEFAULT
+	}
+	return p.EvaluatorOutput
+}
+
+var ExperimentTurnPayload_SystemInfo_DEFAULT *TurnSystemInfo
+
+func (p *ExperimentTurnPayload) GetSystemInfo() (v *TurnSystemInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSystemInfo() {
+		return ExperimentTurnPayload_SystemInfo_DEFAULT
+	}
+	return p.SystemInfo
+}
+
+var ExperimentTurnPayload_AnnotateResult__DEFAULT *TurnAnnotateResult_
+
+func (p *ExperimentTurnPayload) GetAnnotateResult_() (v *TurnAnnotateResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAnnotateResult_() {
+		return ExperimentTurnPayload_AnnotateResult__DEFAULT
+	}
+	return p.AnnotateResult_
+}
+
+var ExperimentTurnPayload_TrajectoryAnalysisResult__DEFAULT *TrajectoryAnalysisResult_
+
+func (p *ExperimentTurnPayload) GetTrajectoryAnalysisResult_() (v *TrajectoryAnalysisResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTrajectoryAnalysisResult_() {
+		return ExperimentTurnPayload_TrajectoryAnalysisResult__DEFAULT
+	}
+	return p.TrajectoryAnalysisResult_
+}
+func (p *ExperimentTurnPayload) SetTurnID(val int64) {
+	p.TurnID = val
+}
+func (p *ExperimentTurnPayload) SetEvalSet(val *TurnEvalSet) {
+	p.EvalSet = val
+}
+func (p *ExperimentTurnPayload) SetTargetOutput(val *TurnTargetOutput) {
+	p.TargetOutput = val
+}
+func (p *ExperimentTurnPayload) SetEvaluatorOutput(val *TurnEvaluatorOutput) {
+	p.EvaluatorOutput = val
+}
+func (p *ExperimentTurnPayload) SetSystemInfo(val *TurnSystemInfo) {
+	p.SystemInfo = val
+}
+func (p *ExperimentTurnPayload) SetAnnotateResult_(val *TurnAnnotateResult_) {
+	p.AnnotateResult_ = val
+}
+func (p *ExperimentTurnPayload) SetTrajectoryAnalysisResult_(val *TrajectoryAnalysisResult_) {
+	p.TrajectoryAnalysisResult_ = val
+}
+
+var fieldIDToName_ExperimentTurnPayload = map[int16]string{
+	1: "turn_id",
+	2: "eval_set",
+	3: "target_output",
+	4: "evaluator_output",
+	5: "system_info",
+	6: "annotate_result",
+	7: "trajectory_analysis_result",
+}
+
+func (p *ExperimentTurnPayload) IsSetEvalSet() bool {
+	return p.EvalSet != nil
+}
+
+func (p *ExperimentTurnPayload) IsSetTargetOutput() bool {
+	return p.TargetOutput != nil
+}
+
+func (p *ExperimentTurnPayload) IsSetEvaluatorOutput() bool {
+	return p.EvaluatorOutput != nil
+}
+
+func (p *ExperimentTurnPayload) IsSetSystemInfo() bool {
+	return p.SystemInfo != nil
+}
+
+func (p *ExperimentTurnPayload) IsSetAnnotateResult_() bool {
+	return p.AnnotateResult_ != nil
+}
+
+func (p *ExperimentTurnPayload) IsSetTrajectoryAnalysisResult_() bool {
+	return p.TrajectoryAnalysisResult_ != nil
+}
+
+func (p *ExperimentTurnPayload) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentTurnPayload[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExperimentTurnPayload) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TurnID = _field
+	return nil
+}
+func (p *ExperimentTurnPayload) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewTurnEvalSet()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvalSet = _field
+	return nil
+}
+func (p *ExperimentTurnPayload) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewTurnTargetOutput()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TargetOutput = _field
+	return nil
+}
+func (p *ExperimentTurnPayload) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewTurnEvaluatorOutput()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvaluatorOutput = _field
+	return nil
+}
+func (p *ExperimentTurnPayload) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewTurnSystemInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.SystemInfo = _field
+	return nil
+}
+func (p *ExperimentTurnPayload) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewTurnAnnotateResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.AnnotateResult_ = _field
+	return nil
+}
+func (p *ExperimentTurnPayload) ReadField7(iprot thrift.TProtocol) error {
+	_field := NewTrajectoryAnalysisResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TrajectoryAnalysisResult_ = _field
+	return nil
+}
+
+func (p *ExperimentTurnPayload) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExperimentTurnPayload"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExperimentTurnPayload) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TurnID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExperimentTurnPayload) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvalSet() {
+		if err = oprot.WriteFieldBegin("eval_set", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvalSet.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExperimentTurnPayload) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetOutput() {
+		if err = oprot.WriteFieldBegin("target_output", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.TargetOutput.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExperimentTurnPayload) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorOutput() {
+		if err = oprot.WriteFieldBegin("evaluator_output", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvaluatorOutput.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExperimentTurnPayload) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSystemInfo() {
+		if err = oprot.WriteFieldBegin("system_info", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.SystemInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExperimentTurnPayload) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnnotateResult_() {
+		if err = oprot.WriteFieldBegin("annotate_result", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.AnnotateResult_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExperimentTurnPayload) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTrajectoryAnalysisResult_() {
+		if err = oprot.WriteFieldBegin("trajectory_analysis_result", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.TrajectoryAnalysisResult_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *ExperimentTurnPayload) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExperimentTurnPayload(%+v)", *p)
+
+}
+
+func (p *ExperimentTurnPayload) DeepEqual(ano *ExperimentTurnPayload) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TurnID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvalSet) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.TargetOutput) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluatorOutput) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.SystemInfo) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.AnnotateResult_) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.TrajectoryAnalysisResult_) {
+		return false
+	}
+	return true
+}
+
+func (p *ExperimentTurnPayload) Field1DeepEqual(src int64) bool {
+
+	if p.TurnID != src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentTurnPayload) Field2DeepEqual(src *TurnEvalSet) bool {
+
+	if !p.EvalSet.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExperimentTurnPayload) Field3DeepEqual(src *TurnTargetOutput) bool {
+
+	if !p.TargetOutput.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExperimentTurnPayload) Field4DeepEqual(src *TurnEvaluatorOutput) bool {
+
+	if !p.EvaluatorOutput.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExperimentTurnPayload) Field5DeepEqual(src *TurnSystemInfo) bool {
+
+	if !p.SystemInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExperimentTurnPayload) Field6DeepEqual(src *TurnAnnotateResult_) bool {
+
+	if !p.AnnotateResult_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExperimentTurnPayload) Field7DeepEqual(src *TrajectoryAnalysisResult_) bool {
+
+	if !p.TrajectoryAnalysisResult_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TrajectoryAnalysisResult_ struct {
+	RecordID *int64                 `thrift:"record_id,1,optional" frugal:"1,optional,i64" json:"record_id" form:"record_id" query:"record_id"`
+	Status   *InsightAnalysisStatus `thrift:"Status,2,optional" frugal:"2,optional,string" form:"Status" json:"Status,omitempty" query:"Status"`
+}
+
+func NewTrajectoryAnalysisResult_() *TrajectoryAnalysisResult_ {
+	return &TrajectoryAnalysisResult_{}
+}
+
+func (p *TrajectoryAnalysisResult_) InitDefault() {
+}
+
+var TrajectoryAnalysisResult__RecordID_DEFAULT int64
+
+func (p *TrajectoryAnalysisResult_) GetRecordID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRecordID() {
+		return TrajectoryAnalysisResult__RecordID_DEFAULT
+	}
+	return *p.RecordID
+}
+
+var TrajectoryAnalysisResult__Status_DEFAULT InsightAnalysisStatus
+
+func (p *TrajectoryAnalysisResult_) GetStatus() (v InsightAnalysisStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return TrajectoryAnalysisResult__Status_DEFAULT
+	}
+	return *p.Status
+}
+func (p *TrajectoryAnalysisResult_) SetRecordID(val *int64) {
+	p.RecordID = val
+}
+func (p *TrajectoryAnalysisResult_) SetStatus(val *InsightAnalysisStatus) {
+	p.Status = val
+}
+
+var fieldIDToName_TrajectoryAnalysisResult_ = map[int16]string{
+	1: "record_id",
+	2: "Status",
+}
+
+func (p *TrajectoryAnalysisResult_) IsSetRecordID() bool {
+	return p.RecordID != nil
+}
+
+func (p *TrajectoryAnalysisResult_) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *TrajectoryAnalysisResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TrajectoryAnalysisResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TrajectoryAnalysisResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RecordID = _field
+	return nil
+}
+func (p *TrajectoryAnalysisResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *InsightAnalysisStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+
+func (p *TrajectoryAnalysisResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TrajectoryAnalysisResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TrajectoryAnalysisResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecordID() {
+		if err = oprot.WriteFieldBegin("record_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RecordID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *TrajectoryAnalysisResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("Status", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TrajectoryAnalysisResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TrajectoryAnalysisResult_(%+v)", *p)
+
+}
+
+func (p *TrajectoryAnalysisResult_) DeepEqual(ano *TrajectoryAnalysisResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RecordID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Status) {
+		return false
+	}
+	return true
+}
+
+func (p *TrajectoryAnalysisResult_) Field1DeepEqual(src *int64) bool {
+
+	if p.RecordID == src {
+		return true
+	} else if p.RecordID == nil || src == nil {
+		return false
+	}
+	if *p.RecordID != *src {
+		return false
+	}
+	return true
+}
+func (p *TrajectoryAnalysisResult_) Field2DeepEqual(src *InsightAnalysisStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type KeywordSearch struct {
+	Keyword      *string        `thrift:"keyword,1,optional" frugal:"1,optional,string" form:"keyword" json:"keyword,omitempty" query:"keyword"`
+	FilterFields []*FilterField `thrift:"filter_fields,2,optional" frugal:"2,optional,list<FilterField>" form:"filter_fields" json:"filter_fields,omitempty" query:"filter_fields"`
+}
+
+func NewKeywordSearch() *KeywordSearch {
+	return &KeywordSearch{}
+}
+
+func (p *KeywordSearch) InitDefault() {
+}
+
+var KeywordSearch_Keyword_DEFAULT string
+
+func (p *KeywordSearch) GetKeyword() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKeyword() {
+		return KeywordSearch_Keyword_DEFAULT
+	}
+	return *p.Keyword
+}
+
+var KeywordSearch_FilterFields_DEFAULT []*FilterField
+
+func (p *KeywordSearch) GetFilterFields() (v []*FilterField) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilterFields() {
+		return KeywordSearch_FilterFields_DEFAULT
+	}
+	return p.FilterFields
+}
+func (p *KeywordSearch) SetKeyword(val *string) {
+	p.Keyword = val
+}
+func (p *KeywordSearch) SetFilterFields(val []*FilterField) {
+	p.FilterFields = val
+}
+
+var fieldIDToName_KeywordSearch = map[int16]string{
+	1: "keyword",
+	2: "filter_fields",
+}
+
+func (p *KeywordSearch) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *KeywordSearch) IsSetFilterFields() bool {
+	return p.FilterFields != nil
+}
+
+func (p *KeywordSearch) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KeywordSearch[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *KeywordSearch) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Keyword = _field
+	return nil
+}
+func (p *KeywordSearch) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FilterField, 0, size)
+	values := make([]FilterField, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FilterFields = _field
+	return nil
+}
+
+func (p *KeywordSearch) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("KeywordSearch"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *KeywordSearch) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyword() {
+		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Keyword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *KeywordSearch) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilterFields() {
+		if err = oprot.WriteFieldBegin("filter_fields", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FilterFields)); err != nil {
+			return err
+		}
+		for _, v := range p.FilterFields {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *KeywordSearch) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KeywordSearch(%+v)", *p)
+
+}
+
+func (p *KeywordSearch) DeepEqual(ano *KeywordSearch) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Keyword) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.FilterFields) {
+		return false
+	}
+	return true
+}
+
+func (p *KeywordSearch) Field1DeepEqual(src *string) bool {
+
+	if p.Keyword == src {
+		return true
+	} else if p.Keyword == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Keyword, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *KeywordSearch) Field2DeepEqual(src []*FilterField) bool {
+
+	if len(p.FilterFields) != len(src) {
+		return false
+	}
+	for i, v := range p.FilterFields {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ExperimentFilter struct {
+	Filters       *Filters       `thrift:"filters,1,optional" frugal:"1,optional,Filters" form:"filters" json:"filters,omitempty" query:"filters"`
+	KeywordSearch *KeywordSearch `thrift:"keyword_search,2,optional" frugal:"2,optional,KeywordSearch" form:"keyword_search" json:"keyword_search,omitempty" query:"keyword_search"`
+}
+
+func NewExperimentFilter() *ExperimentFilter {
+	return &ExperimentFilter{}
+}
+
+func (p *ExperimentFilter) InitDefault() {
+}
+
+var ExperimentFilter_Filters_DEFAULT *Filters
+
+func (p *ExperimentFilter) GetFilters() (v *Filters) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilters() {
+		return ExperimentFilter_Filters_DEFAULT
+	}
+	return p.Filters
+}
+
+var ExperimentFilter_KeywordSearch_DEFAULT *KeywordSearch
+
+func (p *ExperimentFilter) GetKeywordSearch() (v *KeywordSearch) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKeywordSearch() {
+		return ExperimentFilter_KeywordSearch_DEFAULT
+	}
+	return p.KeywordSearch
+}
+func (p *ExperimentFilter) SetFilters(val *Filters) {
+	p.Filters = val
+}
+func (p *ExperimentFilter) SetKeywordSearch(val *KeywordSearch) {
+	p.KeywordSearch = val
+}
+
+var fieldIDToName_ExperimentFilter = map[int16]string{
+	1: "filters",
+	2: "keyword_search",
+}
+
+func (p *ExperimentFilter) IsSetFilters() bool {
+	return p.Filters != nil
+}
+
+func (p *ExperimentFilter) IsSetKeywordSearch() bool {
+	return p.KeywordSearch != nil
+}
+
+func (p *ExperimentFilter) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentFilter[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExperimentFilter) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFilters()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filters = _field
+	return nil
+}
+func (p *ExperimentFilter) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewKeywordSearch()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.KeywordSearch = _field
+	return nil
+}
+
+func (p *ExperimentFilter) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExperimentFilter"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExperimentFilter) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExperimentFilter) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeywordSearch() {
+		if err = oprot.WriteFieldBegin("keyword_search", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.KeywordSearch.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExperimentFilter) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExperimentFilter(%+v)", *p)
+
+}
+
+func (p *ExperimentFilter) DeepEqual(ano *ExperimentFilter) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Filters) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.KeywordSearch) {
+		return false
+	}
+	return true
+}
+
+func (p *ExperimentFilter) Field1DeepEqual(src *Filters) bool {
+
+	if !p.Filters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExperimentFilter) Field2DeepEqual(src *KeywordSearch) bool {
+
+	if !p.KeywordSearch.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 实验模板筛选器，字段设计复用实验的 Filters / KeywordSearch 能力
+type ExperimentTemplateFilter struct {
+	Filters       *Filters       `thrift:"filters,1,optional" frugal:"1,optional,Filters" form:"filters" json:"filters,omitempty" query:"filters"`
+	KeywordSearch *KeywordSearch `thrift:"keyword_search,2,optional" frugal:"2,optional,KeywordSearch" form:"keyword_search" json:"keyword_search,omitempty" query:"keyword_search"`
+}
+
+func NewExperimentTemplateFilter() *ExperimentTemplateFilter {
+	return &ExperimentTemplateFilter{}
+}
+
+func (p *ExperimentTemplateFilter) InitDefault() {
+}
+
+var ExperimentTemplateFilter_Filters_DEFAULT *Filters
+
+func (p *ExperimentTemplateFilter) GetFilters() (v *Filters) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilters() {
+		return ExperimentTemplateFilter_Filters_DEFAULT
+	}
+	return p.Filters
+}
+
+var ExperimentTemplateFilter_KeywordSearch_DEFAULT *KeywordSearch
+
+func (p *ExperimentTemplateFilter) GetKeywordSearch() (v *KeywordSearch) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKeywordSearch() {
+		return ExperimentTemplateFilter_KeywordSearch_DEFAULT
+	}
+	return p.KeywordSearch
+}
+func (p *ExperimentTemplateFilter) SetFilters(val *Filters) {
+	p.Filters = val
+}
+func (p *ExperimentTemplateFilter) SetKeywordSearch(val *KeywordSearch) {
+	p.KeywordSearch = val
+}
+
+var fieldIDToName_ExperimentTemplateFilter = map[int16]string{
+	1: "filters",
+	2: "keyword_search",
+}
+
+func (p *ExperimentTemplateFilter) IsSetFilters() bool {
+	return p.Filters != nil
+}
+
+func (p *ExperimentTemplateFilter) IsSetKeywordSearch() bool {
+	return p.KeywordSearch != nil
+}
+
+func (p *ExperimentTemplateFilter) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentTemplateFilter[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExperimentTemplateFilter) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFilters()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filters = _field
+	return nil
+}
+func (p *ExperimentTemplateFilter) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewKeywordSearch()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.KeywordSearch = _field
+	return nil
+}
+
+func (p *ExperimentTemplateFilter) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExperimentTemplateFilter"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExperimentTemplateFilter) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExperimentTemplateFilter) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeywordSearch() {
+		if err = oprot.WriteFieldBegin("keyword_search", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.KeywordSearch.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExperimentTemplateFilter) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExperimentTemplateFilter(%+v)", *p)
+
+}
+
+func (p *ExperimentTemplateFilter) DeepEqual(ano *ExperimentTemplateFilter) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Filters) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.KeywordSearch) {
+		return false
+	}
+	return true
+}
+
+func (p *ExperimentTemplateFilter) Field1DeepEqual(src *Filters) bool {
+
+	if !p.Filters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExperimentTemplateFilter) Field2DeepEqual(src *KeywordSearch) bool {
+
+	if !p.KeywordSearch.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type Filters struct {
+	FilterConditions []*FilterCondition `thrift:"filter_conditions,1,optional" frugal:"1,optional,list<FilterCondition>" form:"filter_conditions" json:"filter_conditions,omitempty" query:"filter_conditions"`
+	LogicOp          *FilterLogicOp     `thrift:"logic_op,2,optional" frugal:"2,optional,FilterLogicOp" form:"logic_op" json:"logic_op,omitempty" query:"logic_op"`
+}
+
+func NewFilters() *Filters {
+	return &Filters{}
+}
+
+func (p *Filters) InitDefault() {
+}
+
+var Filters_FilterConditions_DEFAULT []*FilterCondition
+
+func (p *Filters) GetFilterConditions() (v []*FilterCondition) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilterConditions() {
+		return Filters_FilterConditions_DEFAULT
+	}
+	return p.FilterConditions
+}
+
+var Filters_LogicOp_DEFAULT FilterLogicOp
+
+func (p *Filters) GetLogicOp() (v FilterLogicOp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLogicOp() {
+		return Filters_LogicOp_DEFAULT
+	}
+	return *p.LogicOp
+}
+func (p *Filters) SetFilterConditions(val []*FilterCondition) {
+	p.FilterConditions = val
+}
+func (p *Filters) SetLogicOp(val *FilterLogicOp) {
+	p.LogicOp = val
+}
+
+var fieldIDToName_Filters = map[int16]string{
+	1: "filter_conditions",
+	2: "logic_op",
+}
+
+func (p *Filters) IsSetFilterConditions() bool {
+	return p.FilterConditions != nil
+}
+
+func (p *Filters) IsSetLogicOp() bool {
+	return p.LogicOp != nil
+}
+
+func (p *Filters) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Filters[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Filters) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FilterCondition, 0, size)
+	values := make([]FilterCondition, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FilterConditions = _field
+	return nil
+}
+func (p *Filters) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *FilterLogicOp
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := FilterLogicOp(v)
+		_field = &tmp
+	}
+	p.LogicOp = _field
+	return nil
+}
+
+func (p *Filters) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Filters"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Filters) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilterConditions() {
+		if err = oprot.WriteFieldBegin("filter_conditions", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FilterConditions)); err != nil {
+			return err
+		}
+		for _, v := range p.FilterConditions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Filters) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLogicOp() {
+		if err = oprot.WriteFieldBegin("logic_op", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.LogicOp)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *Filters) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Filters(%+v)", *p)
+
+}
+
+func (p *Filters) DeepEqual(ano *Filters) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.FilterConditions) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.LogicOp) {
+		return false
+	}
+	return true
+}
+
+func (p *Filters) Field1DeepEqual(src []*FilterCondition) bool {
+
+	if len(p.FilterConditions) != len(src) {
+		return false
+	}
+	for i, v := range p.FilterConditions {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *Filters) Field2DeepEqual(src *FilterLogicOp) bool {
+
+	if p.LogicOp == src {
+		return true
+	} else if p.LogicOp == nil || src == nil {
+		return false
+	}
+	if *p.LogicOp != *src {
+		return false
+	}
+	return true
+}
+
+type FilterField struct {
+	FieldType FieldType `thrift:"field_type,1,required" frugal:"1,required,FieldType" form:"field_type,required" json:"field_type,required" query:"field_type,required"`
+	// 二级key放此字段里
+	FieldKey *string `thrift:"field_key,2,optional" frugal:"2,optional,string" form:"field_key" json:"field_key,omitempty" query:"field_key"`
+}
+
+func NewFilterField() *FilterField {
+	return &FilterField{}
+}
+
+func (p *FilterField) InitDefault() {
+}
+
+func (p *FilterField) GetFieldType() (v FieldType) {
+	if p != nil {
+		return p.FieldType
+	}
+	return
+}
+
+var FilterField_FieldKey_DEFAULT string
+
+func (p *FilterField) GetFieldKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldKey() {
+		return FilterField_FieldKey_DEFAULT
+	}
+	return *p.FieldKey
+}
+func (p *FilterField) SetFieldType(val FieldType) {
+	p.FieldType = val
+}
+func (p *FilterField) SetFieldKey(val *string) {
+	p.FieldKey = val
+}
+
+var fieldIDToName_FilterField = map[int16]string{
+	1: "field_type",
+	2: "field_key",
+}
+
+func (p *FilterField) IsSetFieldKey() bool {
+	return p.FieldKey != nil
+}
+
+func (p *FilterField) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetFieldType bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFieldType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetFieldType {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterField[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FilterField[fieldId]))
+}
+
+func (p *FilterField) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field FieldType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = FieldType(v)
+	}
+	p.FieldType = _field
+	return nil
+}
+func (p *FilterField) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FieldKey = _field
+	return nil
+}
+
+func (p *FilterField) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FilterField"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FilterField) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field_type", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.FieldType)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *FilterField) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldKey() {
+		if err = oprot.WriteFieldBegin("field_key", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FieldKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *FilterField) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FilterField(%+v)", *p)
+
+}
+
+func (p *FilterField) DeepEqual(ano *FilterField) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.FieldType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.FieldKey) {
+		return false
+	}
+	return true
+}
+
+func (p *FilterField) Field1DeepEqual(src FieldType) bool {
+
+	if p.FieldType != src {
+		return false
+	}
+	return true
+}
+func (p *FilterField) Field2DeepEqual(src *string) bool {
+
+	if p.FieldKey == src {
+		return true
+	} else if p.FieldKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.FieldKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+// 字段过滤器
+type FilterCondition struct {
+	// 过滤字段，比如评估器ID
+	Field *FilterField `thrift:"field,1" frugal:"1,default,FilterField" form:"field" json:"field" query:"field"`
+	// 操作符，比如等于、包含、大于、小于等
+	Operator FilterOperatorType `thrift:"operator,2" frugal:"2,default,FilterOperatorType" form:"operator" json:"operator" query:"operator"`
+	// 操作值;支持多种类型的操作值；
+	Value        string        `thrift:"value,3" frugal:"3,default,string" form:"value" json:"value" query:"value"`
+	SourceTarget *SourceTarget `thrift:"source_target,4,optional" frugal:"4,optional,SourceTarget" form:"source_target" json:"source_target,omitempty" query:"source_target"`
+}
+
+func NewFilterCondition() *FilterCondition {
+	return &FilterCondition{}
+}
+
+func (p *FilterCondition) InitDefault() {
+}
+
+var FilterCondition_Field_DEFAULT *FilterField
+
+func (p *FilterCondition) GetField() (v *FilterField) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetField() {
+		return FilterCondition_Field_DEFAULT
+	}
+	return p.Field
+}
+
+func (p *FilterCondition) GetOperator() (v FilterOperatorType) {
+	if p != nil {
+		return p.Operator
+	}
+	return
+}
+
+func (p *FilterCondition) GetValue() (v string) {
+	if p != nil {
+		return p.Value
+	}
+	return
+}
+
+var FilterCondition_SourceTarget_DEFAULT *SourceTarget
+
+func (p *FilterCondition) GetSourceTarget() (v *SourceTarget) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSourceTarget() {
+		return FilterCondition_SourceTarget_DEFAULT
+	}
+	return p.SourceTarget
+}
+func (p *FilterCondition) SetField(val *FilterField) {
+	p.Field = val
+}
+func (p *FilterCondition) SetOperator(val FilterOperatorType) {
+	p.Operator = val
+}
+func (p *FilterCondition) SetValue(val string) {
+	p.Value = val
+}
+func (p *FilterCondition) SetSourceTarget(val *SourceTarget) {
+	p.SourceTarget = val
+}
+
+var fieldIDToName_FilterCondition = map[int16]string{
+	1: "field",
+	2: "operator",
+	3: "value",
+	4: "source_target",
+}
+
+func (p *FilterCondition) IsSetField() bool {
+	return p.Field != nil
+}
+
+func (p *FilterCondition) IsSetSourceTarget() bool {
+	return p.SourceTarget != nil
+}
+
+func (p *FilterCondition) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterCondition[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FilterCondition) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFilterField()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Field = _field
+	return nil
+}
+func (p *FilterCondition) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field FilterOperatorType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = FilterOperatorType(v)
+	}
+	p.Operator = _field
+	return nil
+}
+func (p *FilterCondition) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Value = _field
+	return nil
+}
+func (p *FilterCondition) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewSourceTarget()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.SourceTarget = _field
+	return nil
+}
+
+func (p *FilterCondition) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FilterCondition"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FilterCondition) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Field.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *FilterCondition) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("operator", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.Operator)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *FilterCondition) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("value", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Value); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *FilterCondition) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceTarget() {
+		if err = oprot.WriteFieldBegin("source_target", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.SourceTarget.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -14624,308 +18859,125 @@ func (p *AnnotateRecord) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *AnnotateRecord) String() string {
+func (p *FilterCondition) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnnotateRecord(%+v)", *p)
+	return fmt.Sprintf("FilterCondition(%+v)", *p)
 
 }
 
-func (p *AnnotateRecord) DeepEqual(ano *AnnotateRecord) bool {
+func (p *FilterCondition) DeepEqual(ano *FilterCondition) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.AnnotateRecordID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.TagKeyID) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Score) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.BooleanOption) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.CategoricalOption) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.PlainText) {
-		return false
-	}
-	if !p.Field7DeepEqual(ano.TagContentType) {
-		return false
-	}
-	if !p.Field8DeepEqual(ano.TagValueID) {
-		return false
-	}
-	return true
-}
-
-func (p *AnnotateRecord) Field1DeepEqual(src *int64) bool {
-
-	if p.AnnotateRecordID == src {
-		return true
-	} else if p.AnnotateRecordID == nil || src == nil {
-		return false
-	}
-	if *p.AnnotateRecordID != *src {
-		return false
-	}
-	return true
-}
-func (p *AnnotateRecord) Field2DeepEqual(src *int64) bool {
-
-	if p.TagKeyID == src {
-		return true
-	} else if p.TagKeyID == nil || src == nil {
+	if !p.Field1DeepEqual(ano.Field) {
 		return false
 	}
-	if *p.TagKeyID != *src {
+	if !p.Field2DeepEqual(ano.Operator) {
 		return false
 	}
-	return true
-}
-func (p *AnnotateRecord) Field3DeepEqual(src *string) bool {
-
-	if p.Score == src {
-		return true
-	} else if p.Score == nil || src == nil {
+	if !p.Field3DeepEqual(ano.Value) {
 		return false
 	}
-	if strings.Compare(*p.Score, *src) != 0 {
+	if !p.Field4DeepEqual(ano.SourceTarget) {
 		return false
 	}
 	return true
 }
-func (p *AnnotateRecord) Field4DeepEqual(src *string) bool {
 
-	if p.BooleanOption == src {
-		return true
-	} else if p.BooleanOption == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.BooleanOption, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *AnnotateRecord) Field5DeepEqual(src *string) bool {
+func (p *FilterCondition) Field1DeepEqual(src *FilterField) bool {
 
-	if p.CategoricalOption == src {
-		return true
-	} else if p.CategoricalOption == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.CategoricalOption, *src) != 0 {
+	if !p.Field.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *AnnotateRecord) Field6DeepEqual(src *string) bool {
+func (p *FilterCondition) Field2DeepEqual(src FilterOperatorType) bool {
 
-	if p.PlainText == src {
-		return true
-	} else if p.PlainText == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.PlainText, *src) != 0 {
+	if p.Operator != src {
 		return false
 	}
 	return true
 }
-func (p *AnnotateRecord) Field7DeepEqual(src *tag.TagContentType) bool {
+func (p *FilterCondition) Field3DeepEqual(src string) bool {
 
-	if p.TagContentType == src {
-		return true
-	} else if p.TagContentType == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.TagContentType, *src) != 0 {
+	if strings.Compare(p.Value, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *AnnotateRecord) Field8DeepEqual(src *int64) bool {
+func (p *FilterCondition) Field4DeepEqual(src *SourceTarget) bool {
 
-	if p.TagValueID == src {
-		return true
-	} else if p.TagValueID == nil || src == nil {
-		return false
-	}
-	if *p.TagValueID != *src {
+	if !p.SourceTarget.DeepEqual(src) {
 		return false
 	}
 	return true
 }
 
-// 实际行级payload
-type ExperimentTurnPayload struct {
-	TurnID int64 `thrift:"turn_id,1" frugal:"1,default,i64" json:"turn_id" form:"turn_id" query:"turn_id"`
-	// 评测数据集数据
-	EvalSet *TurnEvalSet `thrift:"eval_set,2,optional" frugal:"2,optional,TurnEvalSet" form:"eval_set" json:"eval_set,omitempty" query:"eval_set"`
-	// 评测对象结果
-	TargetOutput *TurnTargetOutput `thrift:"target_output,3,optional" frugal:"3,optional,TurnTargetOutput" form:"target_output" json:"target_output,omitempty" query:"target_output"`
-	// 评测规则执行结果
-	EvaluatorOutput *TurnEvaluatorOutput `thrift:"evaluator_output,4,optional" frugal:"4,optional,TurnEvaluatorOutput" form:"evaluator_output" json:"evaluator_output,omitempty" query:"evaluator_output"`
-	// 评测系统相关数据日志、error
-	SystemInfo *TurnSystemInfo `thrift:"system_info,5,optional" frugal:"5,optional,TurnSystemInfo" form:"system_info" json:"system_info,omitempty" query:"system_info"`
-	// 人工标注结果结果
-	AnnotateResult_ *TurnAnnotateResult_ `thrift:"annotate_result,6,optional" frugal:"6,optional,TurnAnnotateResult_" form:"annotate_result" json:"annotate_result,omitempty" query:"annotate_result"`
-	// 轨迹分析结果
-	TrajectoryAnalysisResult_ *TrajectoryAnalysisResult_ `thrift:"trajectory_analysis_result,7,optional" frugal:"7,optional,TrajectoryAnalysisResult_" form:"trajectory_analysis_result" json:"trajectory_analysis_result,omitempty" query:"trajectory_analysis_result"`
-}
-
-func NewExperimentTurnPayload() *ExperimentTurnPayload {
-	return &ExperimentTurnPayload{}
-}
-
-func (p *ExperimentTurnPayload) InitDefault() {
-}
-
-func (p *ExperimentTurnPayload) GetTurnID() (v int64) {
-	if p != nil {
-		return p.TurnID
-	}
-	return
-}
-
-var ExperimentTurnPayload_EvalSet_DEFAULT *TurnEvalSet
-
-func (p *ExperimentTurnPayload) GetEvalSet() (v *TurnEvalSet) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEvalSet() {
-		return ExperimentTurnPayload_EvalSet_DEFAULT
-	}
-	return p.EvalSet
-}
-
-var ExperimentTurnPayload_TargetOutput_DEFAULT *TurnTargetOutput
-
-func (p *ExperimentTurnPayload) GetTargetOutput() (v *TurnTargetOutput) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTargetOutput() {
-		return ExperimentTurnPayload_TargetOutput_DEFAULT
-	}
-	return p.TargetOutput
+type SourceTarget struct {
+	EvalTargetType  *eval_target.EvalTargetType `thrift:"eval_target_type,1,optional" frugal:"1,optional,EvalTargetType" form:"eval_target_type" json:"eval_target_type,omitempty" query:"eval_target_type"`
+	SourceTargetIds []string                    `thrift:"source_target_ids,3,optional" frugal:"3,optional,list<string>" form:"source_target_ids" json:"source_target_ids,omitempty" query:"source_target_ids"`
 }
 
-var ExperimentTurnPayload_EvaluatorOutput_DEFAULT *TurnEvaluatorOutput
-
-func (p *ExperimentTurnPayload) GetEvaluatorOutput() (v *TurnEvaluatorOutput) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEvaluatorOutput() {
-		return ExperimentTurnPayload_EvaluatorOutput_DEFAULT
-	}
-	return p.EvaluatorOutput
+func NewSourceTarget() *SourceTarget {
+	return &SourceTarget{}
 }
 
-var ExperimentTurnPayload_SystemInfo_DEFAULT *TurnSystemInfo
-
-func (p *ExperimentTurnPayload) GetSystemInfo() (v *TurnSystemInfo) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetSystemInfo() {
-		return ExperimentTurnPayload_SystemInfo_DEFAULT
-	}
-	return p.SystemInfo
+func (p *SourceTarget) InitDefault() {
 }
 
-var ExperimentTurnPayload_AnnotateResult__DEFAULT *TurnAnnotateResult_
+var SourceTarget_EvalTargetType_DEFAULT eval_target.EvalTargetType
 
-func (p *ExperimentTurnPayload) GetAnnotateResult_() (v *TurnAnnotateResult_) {
+func (p *SourceTarget) GetEvalTargetType() (v eval_target.EvalTargetType) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAnnotateResult_() {
-		return ExperimentTurnPayload_AnnotateResult__DEFAULT
+	if !p.IsSetEvalTargetType() {
+		return SourceTarget_EvalTargetType_DEFAULT
 	}
-	return p.AnnotateResult_
+	return *p.EvalTargetType
 }
 
-var ExperimentTurnPayload_TrajectoryAnalysisResult__DEFAULT *TrajectoryAnalysisResult_
+var SourceTarget_SourceTargetIds_DEFAULT []string
 
-func (p *ExperimentTurnPayload) GetTrajectoryAnalysisResult_() (v *TrajectoryAnalysisResult_) {
+func (p *SourceTarget) GetSourceTargetIds() (v []string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTrajectoryAnalysisResult_() {
-		return ExperimentTurnPayload_TrajectoryAnalysisResult__DEFAULT
+	if !p.IsSetSourceTargetIds() {
+		return SourceTarget_SourceTargetIds_DEFAULT
 	}
-	return p.TrajectoryAnalysisResult_
-}
-func (p *ExperimentTurnPayload) SetTurnID(val int64) {
-	p.TurnID = val
-}
-func (p *ExperimentTurnPayload) SetEvalSet(val *TurnEvalSet) {
-	p.EvalSet = val
-}
-func (p *ExperimentTurnPayload) SetTargetOutput(val *TurnTargetOutput) {
-	p.TargetOutput = val
-}
-func (p *ExperimentTurnPayload) SetEvaluatorOutput(val *TurnEvaluatorOutput) {
-	p.EvaluatorOutput = val
-}
-func (p *ExperimentTurnPayload) SetSystemInfo(val *TurnSystemInfo) {
-	p.SystemInfo = val
-}
-func (p *ExperimentTurnPayload) SetAnnotateResult_(val *TurnAnnotateResult_) {
-	p.AnnotateResult_ = val
-}
-func (p *ExperimentTurnPayload) SetTrajectoryAnalysisResult_(val *TrajectoryAnalysisResult_) {
-	p.TrajectoryAnalysisResult_ = val
-}
-
-var fieldIDToName_ExperimentTurnPayload = map[int16]string{
-	1: "turn_id",
-	2: "eval_set",
-	3: "target_output",
-	4: "evaluator_output",
-	5: "system_info",
-	6: "annotate_result",
-	7: "trajectory_analysis_result",
-}
-
-func (p *ExperimentTurnPayload) IsSetEvalSet() bool {
-	return p.EvalSet != nil
-}
-
-func (p *ExperimentTurnPayload) IsSetTargetOutput() bool {
-	return p.TargetOutput != nil
+	return p.SourceTargetIds
 }
-
-func (p *ExperimentTurnPayload) IsSetEvaluatorOutput() bool {
-	return p.EvaluatorOutput != nil
+func (p *SourceTarget) SetEvalTargetType(val *eval_target.EvalTargetType) {
+	p.EvalTargetType = val
+}
+func (p *SourceTarget) SetSourceTargetIds(val []string) {
+	p.SourceTargetIds = val
 }
 
-func (p *ExperimentTurnPayload) IsSetSystemInfo() bool {
-	return p.SystemInfo != nil
+var fieldIDToName_SourceTarget = map[int16]string{
+	1: "eval_target_type",
+	3: "source_target_ids",
 }
 
-func (p *ExperimentTurnPayload) IsSetAnnotateResult_() bool {
-	return p.AnnotateResult_ != nil
+func (p *SourceTarget) IsSetEvalTargetType() bool {
+	return p.EvalTargetType != nil
 }
 
-func (p *ExperimentTurnPayload) IsSetTrajectoryAnalysisResult_() bool {
-	return p.TrajectoryAnalysisResult_ != nil
+func (p *SourceTarget) IsSetSourceTargetIds() bool {
+	return p.SourceTargetIds != nil
 }
 
-func (p *ExperimentTurnPayload) Read(iprot thrift.TProtocol) (err error) {
+func (p *SourceTarget) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -14944,61 +18996,21 @@ func (p *ExperimentTurnPayload) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -15018,7 +19030,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentTurnPayload[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SourceTarget[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15028,69 +19040,45 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentTurnPayload) ReadField1(iprot thrift.TProtocol) error {
+func (p *SourceTarget) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *eval_target.EvalTargetType
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
-	}
-	p.TurnID = _field
-	return nil
-}
-func (p *ExperimentTurnPayload) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewTurnEvalSet()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.EvalSet = _field
-	return nil
-}
-func (p *ExperimentTurnPayload) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewTurnTargetOutput()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.TargetOutput = _field
-	return nil
-}
-func (p *ExperimentTurnPayload) ReadField4(iprot thrift.TProtocol) error {
-	_field := NewTurnEvaluatorOutput()
-	if err := _field.Read(iprot); err != nil {
-		return err
+		tmp := eval_target.EvalTargetType(v)
+		_field = &tmp
 	}
-	p.EvaluatorOutput = _field
+	p.EvalTargetType = _field
 	return nil
 }
-func (p *ExperimentTurnPayload) ReadField5(iprot thrift.TProtocol) error {
-	_field := NewTurnSystemInfo()
-	if err := _field.Read(iprot); err != nil {
+func (p *SourceTarget) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.SystemInfo = _field
-	return nil
-}
-func (p *ExperimentTurnPayload) ReadField6(iprot thrift.TProtocol) error {
-	_field := NewTurnAnnotateResult_()
-	if err := _field.Read(iprot); err != nil {
-		return err
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
 	}
-	p.AnnotateResult_ = _field
-	return nil
-}
-func (p *ExperimentTurnPayload) ReadField7(iprot thrift.TProtocol) error {
-	_field := NewTrajectoryAnalysisResult_()
-	if err := _field.Read(iprot); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.TrajectoryAnalysisResult_ = _field
+	p.SourceTargetIds = _field
 	return nil
 }
 
-func (p *ExperimentTurnPayload) Write(oprot thrift.TProtocol) (err error) {
+func (p *SourceTarget) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExperimentTurnPayload"); err != nil {
+	if err = oprot.WriteStructBegin("SourceTarget"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15098,30 +19086,10 @@ func (p *ExperimentTurnPayload) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 		if err = p.writeField3(oprot); err != nil {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -15140,100 +19108,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentTurnPayload) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("turn_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.TurnID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *ExperimentTurnPayload) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvalSet() {
-		if err = oprot.WriteFieldBegin("eval_set", thrift.STRUCT, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.EvalSet.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *ExperimentTurnPayload) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetOutput() {
-		if err = oprot.WriteFieldBegin("target_output", thrift.STRUCT, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.TargetOutput.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *ExperimentTurnPayload) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorOutput() {
-		if err = oprot.WriteFieldBegin("evaluator_output", thrift.STRUCT, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.EvaluatorOutput.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ExperimentTurnPayload) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSystemInfo() {
-		if err = oprot.WriteFieldBegin("system_info", thrift.STRUCT, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.SystemInfo.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *ExperimentTurnPayload) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAnnotateResult_() {
-		if err = oprot.WriteFieldBegin("annotate_result", thrift.STRUCT, 6); err != nil {
+func (p *SourceTarget) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvalTargetType() {
+		if err = oprot.WriteFieldBegin("eval_target_type", thrift.I32, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.AnnotateResult_.Write(oprot); err != nil {
+		if err := oprot.WriteI32(int32(*p.EvalTargetType)); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -15242,16 +19122,24 @@ func (p *ExperimentTurnPayload) writeField6(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExperimentTurnPayload) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTrajectoryAnalysisResult_() {
-		if err = oprot.WriteFieldBegin("trajectory_analysis_result", thrift.STRUCT, 7); err != nil {
+func (p *SourceTarget) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceTargetIds() {
+		if err = oprot.WriteFieldBegin("source_target_ids", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.TrajectoryAnalysisResult_.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.SourceTargetIds)); err != nil {
+			return err
+		}
+		for _, v := range p.SourceTargetIds {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -15260,157 +19148,218 @@ func (p *ExperimentTurnPayload) writeField7(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ExperimentTurnPayload) String() string {
+func (p *SourceTarget) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentTurnPayload(%+v)", *p)
+	return fmt.Sprintf("SourceTarget(%+v)", *p)
 
 }
 
-func (p *ExperimentTurnPayload) DeepEqual(ano *ExperimentTurnPayload) bool {
+func (p *SourceTarget) DeepEqual(ano *SourceTarget) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TurnID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.EvalSet) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.TargetOutput) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.EvaluatorOutput) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.SystemInfo) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.AnnotateResult_) {
+	if !p.Field1DeepEqual(ano.EvalTargetType) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.TrajectoryAnalysisResult_) {
+	if !p.Field3DeepEqual(ano.SourceTargetIds) {
 		return false
 	}
 	return true
 }
 
-func (p *ExperimentTurnPayload) Field1DeepEqual(src int64) bool {
+func (p *SourceTarget) Field1DeepEqual(src *eval_target.EvalTargetType) bool {
 
-	if p.TurnID != src {
+	if p.EvalTargetType == src {
+		return true
+	} else if p.EvalTargetType == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *ExperimentTurnPayload) Field2DeepEqual(src *TurnEvalSet) bool {
-
-	if !p.EvalSet.DeepEqual(src) {
+	if *p.EvalTargetType != *src {
 		return false
 	}
 	return true
 }
-func (p *ExperimentTurnPayload) Field3DeepEqual(src *TurnTargetOutput) bool {
+func (p *SourceTarget) Field3DeepEqual(src []string) bool {
 
-	if !p.TargetOutput.DeepEqual(src) {
+	if len(p.SourceTargetIds) != len(src) {
 		return false
 	}
+	for i, v := range p.SourceTargetIds {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
 	return true
 }
-func (p *ExperimentTurnPayload) Field4DeepEqual(src *TurnEvaluatorOutput) bool {
 
-	if !p.EvaluatorOutput.DeepEqual(src) {
-		return false
-	}
-	return true
+// 实验粒度聚合结果
+type ExptAggregateResult_ struct {
+	ExperimentID     int64                                `thrift:"experiment_id,1,required" frugal:"1,required,i64" json:"experiment_id" form:"experiment_id,required" query:"experiment_id,required"`
+	EvaluatorResults map[int64]*EvaluatorAggregateResult_ `thrift:"evaluator_results,2,optional" frugal:"2,optional,map<i64:EvaluatorAggregateResult_>" json:"evaluator_results" form:"evaluator_results" query:"evaluator_results"`
+	Status           *ExptAggregateCalculateStatus        `thrift:"status,3,optional" frugal:"3,optional,ExptAggregateCalculateStatus" form:"status" json:"status,omitempty" query:"status"`
+	// tag_key_id -> result
+	AnnotationResults     map[int64]*AnnotationAggregateResult_ `thrift:"annotation_results,4,optional" frugal:"4,optional,map<i64:AnnotationAggregateResult_>" json:"annotation_results" form:"annotation_results" query:"annotation_results"`
+	EvalTargetAggrResult_ *EvalTargetAggregateResult_           `thrift:"eval_target_aggr_result,5,optional" frugal:"5,optional,EvalTargetAggregateResult_" form:"eval_target_aggr_result" json:"eval_target_aggr_result,omitempty" query:"eval_target_aggr_result"`
+	// timestamp in seconds
+	UpdateTime      *int64               `thrift:"update_time,6,optional" frugal:"6,optional,i64" form:"update_time" json:"update_time,omitempty" query:"update_time"`
+	WeightedResults []*AggregatorResult_ `thrift:"weighted_results,10,optional" frugal:"10,optional,list<AggregatorResult_>" json:"weighted_results" form:"weighted_results" query:"weighted_results"`
 }
-func (p *ExperimentTurnPayload) Field5DeepEqual(src *TurnSystemInfo) bool {
 
-	if !p.SystemInfo.DeepEqual(src) {
-		return false
-	}
-	return true
+func NewExptAggregateResult_() *ExptAggregateResult_ {
+	return &ExptAggregateResult_{}
 }
-func (p *ExperimentTurnPayload) Field6DeepEqual(src *TurnAnnotateResult_) bool {
 
-	if !p.AnnotateResult_.DeepEqual(src) {
-		return false
+func (p *ExptAggregateResult_) InitDefault() {
+}
+
+func (p *ExptAggregateResult_) GetExperimentID() (v int64) {
+	if p != nil {
+		return p.ExperimentID
 	}
-	return true
+	return
 }
-func (p *ExperimentTurnPayload) Field7DeepEqual(src *TrajectoryAnalysisResult_) bool {
 
-	if !p.TrajectoryAnalysisResult_.DeepEqual(src) {
-		return false
+var ExptAggregateResult__EvaluatorResults_DEFAULT map[int64]*EvaluatorAggregateResult_
+
+func (p *ExptAggregateResult_) GetEvaluatorResults() (v map[int64]*EvaluatorAggregateResult_) {
+	if p == nil {
+		return
 	}
-	return true
+	if !p.IsSetEvaluatorResults() {
+		return ExptAggregateResult__EvaluatorResults_DEFAULT
+	}
+	return p.EvaluatorResults
 }
 
-type TrajectoryAnalysisResult_ struct {
-	RecordID *int64                 `thrift:"record_id,1,optional" frugal:"1,optional,i64" json:"record_id" form:"record_id" query:"record_id"`
-	Status   *InsightAnalysisStatus `thrift:"Status,2,optional" frugal:"2,optional,string" form:"Status" json:"Status,omitempty" query:"Status"`
+var ExptAggregateResult__Status_DEFAULT ExptAggregateCalculateStatus
+
+func (p *ExptAggregateResult_) GetStatus() (v ExptAggregateCalculateStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return ExptAggregateResult__Status_DEFAULT
+	}
+	return *p.Status
 }
 
-func NewTrajectoryAnalysisResult_() *TrajectoryAnalysisResult_ {
-	return &TrajectoryAnalysisResult_{}
+var ExptAggregateResult__AnnotationResults_DEFAULT map[int64]*AnnotationAggregateResult_
+
+func (p *ExptAggregateResult_) GetAnnotationResults() (v map[int64]*AnnotationAggregateResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAnnotationResults() {
+		return ExptAggregateResult__AnnotationResults_DEFAULT
+	}
+	return p.AnnotationResults
 }
 
-func (p *TrajectoryAnalysisResult_) InitDefault() {
+var ExptAggregateResult__EvalTargetAggrResult__DEFAULT *EvalTargetAggregateResult_
+
+func (p *ExptAggregateResult_) GetEvalTargetAggrResult_() (v *EvalTargetAggregateResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvalTargetAggrResult_() {
+		return ExptAggregateResult__EvalTargetAggrResult__DEFAULT
+	}
+	return p.EvalTargetAggrResult_
 }
 
-var TrajectoryAnalysisResult__RecordID_DEFAULT int64
+var ExptAggregateResult__UpdateTime_DEFAULT int64
 
-func (p *TrajectoryAnalysisResult_) GetRecordID() (v int64) {
+func (p *ExptAggregateResult_) GetUpdateTime() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetRecordID() {
-		return TrajectoryAnalysisResult__RecordID_DEFAULT
+	if !p.IsSetUpdateTime() {
+		return ExptAggregateResult__UpdateTime_DEFAULT
 	}
-	return *p.RecordID
+	return *p.UpdateTime
 }
 
-var TrajectoryAnalysisResult__Status_DEFAULT InsightAnalysisStatus
+var ExptAggregateResult__WeightedResults_DEFAULT []*AggregatorResult_
 
-func (p *TrajectoryAnalysisResult_) GetStatus() (v InsightAnalysisStatus) {
+func (p *ExptAggregateResult_) GetWeightedResults() (v []*AggregatorResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetStatus() {
-		return TrajectoryAnalysisResult__Status_DEFAULT
+	if !p.IsSetWeightedResults() {
+		return ExptAggregateResult__WeightedResults_DEFAULT
 	}
-	return *p.Status
+	return p.WeightedResults
 }
-func (p *TrajectoryAnalysisResult_) SetRecordID(val *int64) {
-	p.RecordID = val
+func (p *ExptAggregateResult_) SetExperimentID(val int64) {
+	p.ExperimentID = val
 }
-func (p *TrajectoryAnalysisResult_) SetStatus(val *InsightAnalysisStatus) {
+func (p *ExptAggregateResult_) SetEvaluatorResults(val map[int64]*EvaluatorAggregateResult_) {
+	p.EvaluatorResults = val
+}
+func (p *ExptAggregateResult_) SetStatus(val *ExptAggregateCalculateStatus) {
 	p.Status = val
 }
+func (p *ExptAggregateResult_) SetAnnotationResults(val map[int64]*AnnotationAggregateResult_) {
+	p.AnnotationResults = val
+}
+func (p *ExptAggregateResult_) SetEvalTargetAggrResult_(val *EvalTargetAggregateResult_) {
+	p.EvalTargetAggrResult_ = val
+}
+func (p *ExptAggregateResult_) SetUpdateTime(val *int64) {
+	p.UpdateTime = val
+}
+func (p *ExptAggregateResult_) SetWeightedResults(val []*AggregatorResult_) {
+	p.WeightedResults = val
+}
 
-var fieldIDToName_TrajectoryAnalysisResult_ = map[int16]string{
-	1: "record_id",
-	2: "Status",
+var fieldIDToName_ExptAggregateResult_ = map[int16]string{
+	1:  "experiment_id",
+	2:  "evaluator_results",
+	3:  "status",
+	4:  "annotation_results",
+	5:  "eval_target_aggr_result",
+	6:  "update_time",
+	10: "weighted_results",
 }
 
-func (p *TrajectoryAnalysisResult_) IsSetRecordID() bool {
-	return p.RecordID != nil
+func (p *ExptAggregateResult_) IsSetEvaluatorResults() bool {
+	return p.EvaluatorResults != nil
 }
 
-func (p *TrajectoryAnalysisResult_) IsSetStatus() bool {
+func (p *ExptAggregateResult_) IsSetStatus() bool {
 	return p.Status != nil
 }
 
-func (p *TrajectoryAnalysisResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptAggregateResult_) IsSetAnnotationResults() bool {
+	return p.AnnotationResults != nil
+}
+
+func (p *ExptAggregateResult_) IsSetEvalTargetAggrResult_() bool {
+	return p.EvalTargetAggrResult_ != nil
+}
+
+func (p *ExptAggregateResult_) IsSetUpdateTime() bool {
+	return p.UpdateTime != nil
+}
+
+func (p *ExptAggregateResult_) IsSetWeightedResults() bool {
+	return p.WeightedResults != nil
+}
+
+func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetExperimentID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -15428,15 +19377,56 @@ func (p *TrajectoryAnalysisResult_) Read(iprot thrift.TProtocol) (err error) {
 		switch fieldId {
 		case 1:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExperimentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
+		case 10:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField10(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -15455,13 +19445,17 @@ func (p *TrajectoryAnalysisResult_) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetExperimentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TrajectoryAnalysisResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptAggregateResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15469,69 +19463,302 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptAggregateResult_[fieldId]))
 }
 
-func (p *TrajectoryAnalysisResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.RecordID = _field
+	p.ExperimentID = _field
 	return nil
 }
-func (p *TrajectoryAnalysisResult_) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]*EvaluatorAggregateResult_, size)
+	values := make([]EvaluatorAggregateResult_, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field *InsightAnalysisStatus
-	if v, err := iprot.ReadString(); err != nil {
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorResults = _field
+	return nil
+}
+func (p *ExptAggregateResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *ExptAggregateCalculateStatus
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := ExptAggregateCalculateStatus(v)
+		_field = &tmp
+	}
+	p.Status = _field
+	return nil
+}
+func (p *ExptAggregateResult_) ReadField4(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[int64]*AnnotationAggregateResult_, size)
+	values := make([]AnnotationAggregateResult_, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.AnnotationResults = _field
+	return nil
+}
+func (p *ExptAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewEvalTargetAggregateResult_()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.EvalTargetAggrResult_ = _field
+	return nil
+}
+func (p *ExptAggregateResult_) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Status = _field
+	p.UpdateTime = _field
+	return nil
+}
+func (p *ExptAggregateResult_) ReadField10(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.WeightedResults = _field
+	return nil
+}
+
+func (p *ExptAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptAggregateResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExptAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExperimentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorResults() {
+		if err = oprot.WriteFieldBegin("evaluator_results", thrift.MAP, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.STRUCT, len(p.EvaluatorResults)); err != nil {
+			return err
+		}
+		for k, v := range p.EvaluatorResults {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptAggregateResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Status)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-func (p *TrajectoryAnalysisResult_) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("TrajectoryAnalysisResult"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
+func (p *ExptAggregateResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnnotationResults() {
+		if err = oprot.WriteFieldBegin("annotation_results", thrift.MAP, 4); err != nil {
+			goto WriteFieldBeginError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
+		if err := oprot.WriteMapBegin(thrift.I64, thrift.STRUCT, len(p.AnnotationResults)); err != nil {
+			return err
+		}
+		for k, v := range p.AnnotationResults {
+			if err := oprot.WriteI64(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptAggregateResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvalTargetAggrResult_() {
+		if err = oprot.WriteFieldBegin("eval_target_aggr_result", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.EvalTargetAggrResult_.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-
-func (p *TrajectoryAnalysisResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRecordID() {
-		if err = oprot.WriteFieldBegin("record_id", thrift.I64, 1); err != nil {
+func (p *ExptAggregateResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdateTime() {
+		if err = oprot.WriteFieldBegin("update_time", thrift.I64, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.RecordID); err != nil {
+		if err := oprot.WriteI64(*p.UpdateTime); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -15540,16 +19767,24 @@ func (p *TrajectoryAnalysisResult_) writeField1(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *TrajectoryAnalysisResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("Status", thrift.STRING, 2); err != nil {
+func (p *ExptAggregateResult_) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeightedResults() {
+		if err = oprot.WriteFieldBegin("weighted_results", thrift.LIST, 10); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Status); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.WeightedResults)); err != nil {
+			return err
+		}
+		for _, v := range p.WeightedResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -15558,115 +19793,288 @@ func (p *TrajectoryAnalysisResult_) writeField2(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *TrajectoryAnalysisResult_) String() string {
+func (p *ExptAggregateResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TrajectoryAnalysisResult_(%+v)", *p)
+	return fmt.Sprintf("ExptAggregateResult_(%+v)", *p)
 
 }
 
-func (p *TrajectoryAnalysisResult_) DeepEqual(ano *TrajectoryAnalysisResult_) bool {
+func (p *ExptAggregateResult_) DeepEqual(ano *ExptAggregateResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.RecordID) {
+	if !p.Field1DeepEqual(ano.ExperimentID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Status) {
+	if !p.Field2DeepEqual(ano.EvaluatorResults) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.AnnotationResults) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.EvalTargetAggrResult_) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.UpdateTime) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.WeightedResults) {
 		return false
 	}
 	return true
 }
 
-func (p *TrajectoryAnalysisResult_) Field1DeepEqual(src *int64) bool {
+func (p *ExptAggregateResult_) Field1DeepEqual(src int64) bool {
 
-	if p.RecordID == src {
+	if p.ExperimentID != src {
+		return false
+	}
+	return true
+}
+func (p *ExptAggregateResult_) Field2DeepEqual(src map[int64]*EvaluatorAggregateResult_) bool {
+
+	if len(p.EvaluatorResults) != len(src) {
+		return false
+	}
+	for k, v := range p.EvaluatorResults {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptAggregateResult_) Field3DeepEqual(src *ExptAggregateCalculateStatus) bool {
+
+	if p.Status == src {
 		return true
-	} else if p.RecordID == nil || src == nil {
+	} else if p.Status == nil || src == nil {
 		return false
 	}
-	if *p.RecordID != *src {
+	if *p.Status != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptAggregateResult_) Field4DeepEqual(src map[int64]*AnnotationAggregateResult_) bool {
+
+	if len(p.AnnotationResults) != len(src) {
+		return false
+	}
+	for k, v := range p.AnnotationResults {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ExptAggregateResult_) Field5DeepEqual(src *EvalTargetAggregateResult_) bool {
+
+	if !p.EvalTargetAggrResult_.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExptAggregateResult_) Field6DeepEqual(src *int64) bool {
+
+	if p.UpdateTime == src {
+		return true
+	} else if p.UpdateTime == nil || src == nil {
+		return false
+	}
+	if *p.UpdateTime != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptAggregateResult_) Field10DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.WeightedResults) != len(src) {
 		return false
 	}
-	return true
+	for i, v := range p.WeightedResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type EvalTargetAggregateResult_ struct {
+	TargetID        *int64               `thrift:"target_id,1,optional" frugal:"1,optional,i64" json:"target_id" form:"target_id" query:"target_id"`
+	TargetVersionID *int64               `thrift:"target_version_id,2,optional" frugal:"2,optional,i64" json:"target_version_id" form:"target_version_id" query:"target_version_id"`
+	Latency         []*AggregatorResult_ `thrift:"latency,5,optional" frugal:"5,optional,list<AggregatorResult_>" form:"latency" json:"latency,omitempty" query:"latency"`
+	InputTokens     []*AggregatorResult_ `thrift:"input_tokens,6,optional" frugal:"6,optional,list<AggregatorResult_>" form:"input_tokens" json:"input_tokens,omitempty" query:"input_tokens"`
+	OutputTokens    []*AggregatorResult_ `thrift:"output_tokens,7,optional" frugal:"7,optional,list<AggregatorResult_>" form:"output_tokens" json:"output_tokens,omitempty" query:"output_tokens"`
+	TotalTokens     []*AggregatorResult_ `thrift:"total_tokens,8,optional" frugal:"8,optional,list<AggregatorResult_>" form:"total_tokens" json:"total_tokens,omitempty" query:"total_tokens"`
+	TotalCost       []*AggregatorResult_ `thrift:"total_cost,9,optional" frugal:"9,optional,list<AggregatorResult_>" form:"total_cost" json:"total_cost,omitempty" query:"total_cost"`
+}
+
+func NewEvalTargetAggregateResult_() *EvalTargetAggregateResult_ {
+	return &EvalTargetAggregateResult_{}
+}
+
+func (p *EvalTargetAggregateResult_) InitDefault() {
+}
+
+var EvalTargetAggregateResult__TargetID_DEFAULT int64
+
+func (p *EvalTargetAggregateResult_) GetTargetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetID() {
+		return EvalTargetAggregateResult__TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var EvalTargetAggregateResult__TargetVersionID_DEFAULT int64
+
+func (p *EvalTargetAggregateResult_) GetTargetVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetVersionID() {
+		return EvalTargetAggregateResult__TargetVersionID_DEFAULT
+	}
+	return *p.TargetVersionID
+}
+
+var EvalTargetAggregateResult__Latency_DEFAULT []*AggregatorResult_
+
+func (p *EvalTargetAggregateResult_) GetLatency() (v []*AggregatorResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLatency() {
+		return EvalTargetAggregateResult__Latency_DEFAULT
+	}
+	return p.Latency
 }
-func (p *TrajectoryAnalysisResult_) Field2DeepEqual(src *InsightAnalysisStatus) bool {
 
-	if p.Status == src {
-		return true
-	} else if p.Status == nil || src == nil {
-		return false
+var EvalTargetAggregateResult__InputTokens_DEFAULT []*AggregatorResult_
+
+func (p *EvalTargetAggregateResult_) GetInputTokens() (v []*AggregatorResult_) {
+	if p == nil {
+		return
 	}
-	if strings.Compare(*p.Status, *src) != 0 {
-		return false
+	if !p.IsSetInputTokens() {
+		return EvalTargetAggregateResult__InputTokens_DEFAULT
 	}
-	return true
-}
-
-type KeywordSearch struct {
-	Keyword      *string        `thrift:"keyword,1,optional" frugal:"1,optional,string" form:"keyword" json:"keyword,omitempty" query:"keyword"`
-	FilterFields []*FilterField `thrift:"filter_fields,2,optional" frugal:"2,optional,list<FilterField>" form:"filter_fields" json:"filter_fields,omitempty" query:"filter_fields"`
+	return p.InputTokens
 }
 
-func NewKeywordSearch() *KeywordSearch {
-	return &KeywordSearch{}
-}
+var EvalTargetAggregateResult__OutputTokens_DEFAULT []*AggregatorResult_
 
-func (p *KeywordSearch) InitDefault() {
+func (p *EvalTargetAggregateResult_) GetOutputTokens() (v []*AggregatorResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputTokens() {
+		return EvalTargetAggregateResult__OutputTokens_DEFAULT
+	}
+	return p.OutputTokens
 }
 
-var KeywordSearch_Keyword_DEFAULT string
+var EvalTargetAggregateResult__TotalTokens_DEFAULT []*AggregatorResult_
 
-func (p *KeywordSearch) GetKeyword() (v string) {
+func (p *EvalTargetAggregateResult_) GetTotalTokens() (v []*AggregatorResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetKeyword() {
-		return KeywordSearch_Keyword_DEFAULT
+	if !p.IsSetTotalTokens() {
+		return EvalTargetAggregateResult__TotalTokens_DEFAULT
 	}
-	return *p.Keyword
+	return p.TotalTokens
 }
 
-var KeywordSearch_FilterFields_DEFAULT []*FilterField
+var EvalTargetAggregateResult__TotalCost_DEFAULT []*AggregatorResult_
 
-func (p *KeywordSearch) GetFilterFields() (v []*FilterField) {
+func (p *EvalTargetAggregateResult_) GetTotalCost() (v []*AggregatorResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFilterFields() {
-		return KeywordSearch_FilterFields_DEFAULT
+	if !p.IsSetTotalCost() {
+		return EvalTargetAggregateResult__TotalCost_DEFAULT
 	}
-	return p.FilterFields
+	return p.TotalCost
 }
-func (p *KeywordSearch) SetKeyword(val *string) {
-	p.Keyword = val
+func (p *EvalTargetAggregateResult_) SetTargetID(val *int64) {
+	p.TargetID = val
 }
-func (p *KeywordSearch) SetFilterFields(val []*FilterField) {
-	p.FilterFields = val
+func (p *EvalTargetAggregateResult_) SetTargetVersionID(val *int64) {
+	p.TargetVersionID = val
+}
+func (p *EvalTargetAggregateResult_) SetLatency(val []*AggregatorResult_) {
+	p.Latency = val
+}
+func (p *EvalTargetAggregateResult_) SetInputTokens(val []*AggregatorResult_) {
+	p.InputTokens = val
+}
+func (p *EvalTargetAggregateResult_) SetOutputTokens(val []*AggregatorResult_) {
+	p.OutputTokens = val
+}
+func (p *EvalTargetAggregateResult_) SetTotalTokens(val []*AggregatorResult_) {
+	p.TotalTokens = val
+}
+func (p *EvalTargetAggregateResult_) SetTotalCost(val []*AggregatorResult_) {
+	p.TotalCost = val
 }
 
-var fieldIDToName_KeywordSearch = map[int16]string{
-	1: "keyword",
-	2: "filter_fields",
+var fieldIDToName_EvalTargetAggregateResult_ = map[int16]string{
+	1: "target_id",
+	2: "target_version_id",
+	5: "latency",
+	6: "input_tokens",
+	7: "output_tokens",
+	8: "total_tokens",
+	9: "total_cost",
 }
 
-func (p *KeywordSearch) IsSetKeyword() bool {
-	return p.Keyword != nil
+func (p *EvalTargetAggregateResult_) IsSetTargetID() bool {
+	return p.TargetID != nil
 }
 
-func (p *KeywordSearch) IsSetFilterFields() bool {
-	return p.FilterFields != nil
+func (p *EvalTargetAggregateResult_) IsSetTargetVersionID() bool {
+	return p.TargetVersionID != nil
 }
 
-func (p *KeywordSearch) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvalTargetAggregateResult_) IsSetLatency() bool {
+	return p.Latency != nil
+}
+
+func (p *EvalTargetAggregateResult_) IsSetInputTokens() bool {
+	return p.InputTokens != nil
+}
+
+func (p *EvalTargetAggregateResult_) IsSetOutputTokens() bool {
+	return p.OutputTokens != nil
+}
+
+func (p *EvalTargetAggregateResult_) IsSetTotalTokens() bool {
+	return p.TotalTokens != nil
+}
+
+func (p *EvalTargetAggregateResult_) IsSetTotalCost() bool {
+	return p.TotalCost != nil
+}
+
+func (p *EvalTargetAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -15685,7 +20093,7 @@ func (p *KeywordSearch) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -15693,13 +20101,53 @@ func (p *KeywordSearch) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -15719,7 +20167,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_KeywordSearch[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvalTargetAggregateResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15729,24 +20177,127 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *KeywordSearch) ReadField1(iprot thrift.TProtocol) error {
+func (p *EvalTargetAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetVersionID = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Latency = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.InputTokens = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.OutputTokens = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Keyword = _field
+	p.TotalTokens = _field
 	return nil
 }
-func (p *KeywordSearch) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvalTargetAggregateResult_) ReadField9(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*FilterField, 0, size)
-	values := make([]FilterField, size)
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -15760,13 +20311,13 @@ func (p *KeywordSearch) ReadField2(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.FilterFields = _field
+	p.TotalCost = _field
 	return nil
 }
 
-func (p *KeywordSearch) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvalTargetAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("KeywordSearch"); err != nil {
+	if err = oprot.WriteStructBegin("EvalTargetAggregateResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15778,6 +20329,26 @@ func (p *KeywordSearch) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -15796,12 +20367,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *KeywordSearch) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeyword() {
-		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 1); err != nil {
+func (p *EvalTargetAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetID() {
+		if err = oprot.WriteFieldBegin("target_id", thrift.I64, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Keyword); err != nil {
+		if err := oprot.WriteI64(*p.TargetID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -15814,20 +20385,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *KeywordSearch) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilterFields() {
-		if err = oprot.WriteFieldBegin("filter_fields", thrift.LIST, 2); err != nil {
+func (p *EvalTargetAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetVersionID() {
+		if err = oprot.WriteFieldBegin("target_version_id", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FilterFields)); err != nil {
-			return err
-		}
-		for _, v := range p.FilterFields {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteI64(*p.TargetVersionID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -15840,229 +20403,98 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *KeywordSearch) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("KeywordSearch(%+v)", *p)
-
-}
-
-func (p *KeywordSearch) DeepEqual(ano *KeywordSearch) bool {
-	if p == ano {
-		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.Keyword) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.FilterFields) {
-		return false
-	}
-	return true
-}
-
-func (p *KeywordSearch) Field1DeepEqual(src *string) bool {
-
-	if p.Keyword == src {
-		return true
-	} else if p.Keyword == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Keyword, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *KeywordSearch) Field2DeepEqual(src []*FilterField) bool {
-
-	if len(p.FilterFields) != len(src) {
-		return false
-	}
-	for i, v := range p.FilterFields {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-
-type ExperimentFilter struct {
-	Filters       *Filters       `thrift:"filters,1,optional" frugal:"1,optional,Filters" form:"filters" json:"filters,omitempty" query:"filters"`
-	KeywordSearch *KeywordSearch `thrift:"keyword_search,2,optional" frugal:"2,optional,KeywordSearch" form:"keyword_search" json:"keyword_search,omitempty" query:"keyword_search"`
-}
-
-func NewExperimentFilter() *ExperimentFilter {
-	return &ExperimentFilter{}
-}
-
-func (p *ExperimentFilter) InitDefault() {
-}
-
-var ExperimentFilter_Filters_DEFAULT *Filters
-
-func (p *ExperimentFilter) GetFilters() (v *Filters) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetFilters() {
-		return ExperimentFilter_Filters_DEFAULT
-	}
-	return p.Filters
-}
-
-var ExperimentFilter_KeywordSearch_DEFAULT *KeywordSearch
-
-func (p *ExperimentFilter) GetKeywordSearch() (v *KeywordSearch) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetKeywordSearch() {
-		return ExperimentFilter_KeywordSearch_DEFAULT
-	}
-	return p.KeywordSearch
-}
-func (p *ExperimentFilter) SetFilters(val *Filters) {
-	p.Filters = val
-}
-func (p *ExperimentFilter) SetKeywordSearch(val *KeywordSearch) {
-	p.KeywordSearch = val
-}
-
-var fieldIDToName_ExperimentFilter = map[int16]string{
-	1: "filters",
-	2: "keyword_search",
-}
-
-func (p *ExperimentFilter) IsSetFilters() bool {
-	return p.Filters != nil
-}
-
-func (p *ExperimentFilter) IsSetKeywordSearch() bool {
-	return p.KeywordSearch != nil
-}
-
-func (p *ExperimentFilter) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
+func (p *EvalTargetAggregateResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLatency() {
+		if err = oprot.WriteFieldBegin("latency", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
 		}
-		if fieldTypeId == thrift.STOP {
-			break
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Latency)); err != nil {
+			return err
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
+		for _, v := range p.Latency {
+			if err := v.Write(oprot); err != nil {
+				return err
 			}
 		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentFilter[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *ExperimentFilter) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewFilters()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Filters = _field
-	return nil
-}
-func (p *ExperimentFilter) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewKeywordSearch()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.KeywordSearch = _field
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-
-func (p *ExperimentFilter) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("ExperimentFilter"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
+func (p *EvalTargetAggregateResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputTokens() {
+		if err = oprot.WriteFieldBegin("input_tokens", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InputTokens)); err != nil {
+			return err
+		}
+		for _, v := range p.InputTokens {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvalTargetAggregateResult_) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputTokens() {
+		if err = oprot.WriteFieldBegin("output_tokens", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OutputTokens)); err != nil {
+			return err
+		}
+		for _, v := range p.OutputTokens {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-
-func (p *ExperimentFilter) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilters() {
-		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 1); err != nil {
+func (p *EvalTargetAggregateResult_) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotalTokens() {
+		if err = oprot.WriteFieldBegin("total_tokens", thrift.LIST, 8); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Filters.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TotalTokens)); err != nil {
+			return err
+		}
+		for _, v := range p.TotalTokens {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -16071,16 +20503,24 @@ func (p *ExperimentFilter) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
-func (p *ExperimentFilter) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeywordSearch() {
-		if err = oprot.WriteFieldBegin("keyword_search", thrift.STRUCT, 2); err != nil {
+func (p *EvalTargetAggregateResult_) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotalCost() {
+		if err = oprot.WriteFieldBegin("total_cost", thrift.LIST, 9); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.KeywordSearch.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TotalCost)); err != nil {
+			return err
+		}
+		for _, v := range p.TotalCost {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -16089,108 +20529,254 @@ func (p *ExperimentFilter) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *ExperimentFilter) String() string {
+func (p *EvalTargetAggregateResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentFilter(%+v)", *p)
+	return fmt.Sprintf("EvalTargetAggregateResult_(%+v)", *p)
 
 }
 
-func (p *ExperimentFilter) DeepEqual(ano *ExperimentFilter) bool {
+func (p *EvalTargetAggregateResult_) DeepEqual(ano *EvalTargetAggregateResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Filters) {
+	if !p.Field1DeepEqual(ano.TargetID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.KeywordSearch) {
+	if !p.Field2DeepEqual(ano.TargetVersionID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Latency) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.InputTokens) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.OutputTokens) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.TotalTokens) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.TotalCost) {
 		return false
 	}
 	return true
 }
 
-func (p *ExperimentFilter) Field1DeepEqual(src *Filters) bool {
+func (p *EvalTargetAggregateResult_) Field1DeepEqual(src *int64) bool {
 
-	if !p.Filters.DeepEqual(src) {
+	if p.TargetID == src {
+		return true
+	} else if p.TargetID == nil || src == nil {
+		return false
+	}
+	if *p.TargetID != *src {
 		return false
 	}
 	return true
 }
-func (p *ExperimentFilter) Field2DeepEqual(src *KeywordSearch) bool {
+func (p *EvalTargetAggregateResult_) Field2DeepEqual(src *int64) bool {
 
-	if !p.KeywordSearch.DeepEqual(src) {
+	if p.TargetVersionID == src {
+		return true
+	} else if p.TargetVersionID == nil || src == nil {
+		return false
+	}
+	if *p.TargetVersionID != *src {
 		return false
 	}
 	return true
 }
+func (p *EvalTargetAggregateResult_) Field5DeepEqual(src []*AggregatorResult_) bool {
 
-// 实验模板筛选器，字段设计复用实验的 Filters / KeywordSearch 能力
-type ExperimentTemplateFilter struct {
-	Filters       *Filters       `thrift:"filters,1,optional" frugal:"1,optional,Filters" form:"filters" json:"filters,omitempty" query:"filters"`
-	KeywordSearch *KeywordSearch `thrift:"keyword_search,2,optional" frugal:"2,optional,KeywordSearch" form:"keyword_search" json:"keyword_search,omitempty" query:"keyword_search"`
+	if len(p.Latency) != len(src) {
+		return false
+	}
+	for i, v := range p.Latency {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
+func (p *EvalTargetAggregateResult_) Field6DeepEqual(src []*AggregatorResult_) bool {
 
-func NewExperimentTemplateFilter() *ExperimentTemplateFilter {
-	return &ExperimentTemplateFilter{}
+	if len(p.InputTokens) != len(src) {
+		return false
+	}
+	for i, v := range p.InputTokens {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalTargetAggregateResult_) Field7DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.OutputTokens) != len(src) {
+		return false
+	}
+	for i, v := range p.OutputTokens {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalTargetAggregateResult_) Field8DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.TotalTokens) != len(src) {
+		return false
+	}
+	for i, v := range p.TotalTokens {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalTargetAggregateResult_) Field9DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.TotalCost) != len(src) {
+		return false
+	}
+	for i, v := range p.TotalCost {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 评估器版本粒度聚合结果
+type EvaluatorAggregateResult_ struct {
+	EvaluatorVersionID int64                `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
+	AggregatorResults  []*AggregatorResult_ `thrift:"aggregator_results,2,optional" frugal:"2,optional,list<AggregatorResult_>" form:"aggregator_results" json:"aggregator_results,omitempty" query:"aggregator_results"`
+	Name               *string              `thrift:"name,3,optional" frugal:"3,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Version            *string              `thrift:"version,4,optional" frugal:"4,optional,string" form:"version" json:"version,omitempty" query:"version"`
+	// 多维度评估器各维度的聚合结果
+	DimensionResults []*EvaluatorDimensionAggregateResult_ `thrift:"dimension_results,5,optional" frugal:"5,optional,list<EvaluatorDimensionAggregateResult_>" form:"dimension_results" json:"dimension_results,omitempty" query:"dimension_results"`
+}
+
+func NewEvaluatorAggregateResult_() *EvaluatorAggregateResult_ {
+	return &EvaluatorAggregateResult_{}
+}
+
+func (p *EvaluatorAggregateResult_) InitDefault() {
+}
+
+func (p *EvaluatorAggregateResult_) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
+	}
+	return
+}
+
+var EvaluatorAggregateResult__AggregatorResults_DEFAULT []*AggregatorResult_
+
+func (p *EvaluatorAggregateResult_) GetAggregatorResults() (v []*AggregatorResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAggregatorResults() {
+		return EvaluatorAggregateResult__AggregatorResults_DEFAULT
+	}
+	return p.AggregatorResults
 }
 
-func (p *ExperimentTemplateFilter) InitDefault() {
+var EvaluatorAggregateResult__Name_DEFAULT string
+
+func (p *EvaluatorAggregateResult_) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return EvaluatorAggregateResult__Name_DEFAULT
+	}
+	return *p.Name
 }
 
-var ExperimentTemplateFilter_Filters_DEFAULT *Filters
+var EvaluatorAggregateResult__Version_DEFAULT string
 
-func (p *ExperimentTemplateFilter) GetFilters() (v *Filters) {
+func (p *EvaluatorAggregateResult_) GetVersion() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFilters() {
-		return ExperimentTemplateFilter_Filters_DEFAULT
+	if !p.IsSetVersion() {
+		return EvaluatorAggregateResult__Version_DEFAULT
 	}
-	return p.Filters
+	return *p.Version
 }
 
-var ExperimentTemplateFilter_KeywordSearch_DEFAULT *KeywordSearch
+var EvaluatorAggregateResult__DimensionResults_DEFAULT []*EvaluatorDimensionAggregateResult_
 
-func (p *ExperimentTemplateFilter) GetKeywordSearch() (v *KeywordSearch) {
+func (p *EvaluatorAggregateResult_) GetDimensionResults() (v []*EvaluatorDimensionAggregateResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetKeywordSearch() {
-		return ExperimentTemplateFilter_KeywordSearch_DEFAULT
+	if !p.IsSetDimensionResults() {
+		return EvaluatorAggregateResult__DimensionResults_DEFAULT
 	}
-	return p.KeywordSearch
+	return p.DimensionResults
 }
-func (p *ExperimentTemplateFilter) SetFilters(val *Filters) {
-	p.Filters = val
+func (p *EvaluatorAggregateResult_) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
 }
-func (p *ExperimentTemplateFilter) SetKeywordSearch(val *KeywordSearch) {
-	p.KeywordSearch = val
+func (p *EvaluatorAggregateResult_) SetAggregatorResults(val []*AggregatorResult_) {
+	p.AggregatorResults = val
+}
+func (p *EvaluatorAggregateResult_) SetName(val *string) {
+	p.Name = val
+}
+func (p *EvaluatorAggregateResult_) SetVersion(val *string) {
+	p.Version = val
+}
+func (p *EvaluatorAggregateResult_) SetDimensionResults(val []*EvaluatorDimensionAggregateResult_) {
+	p.DimensionResults = val
 }
 
-var fieldIDToName_ExperimentTemplateFilter = map[int16]string{
-	1: "filters",
-	2: "keyword_search",
+var fieldIDToName_EvaluatorAggregateResult_ = map[int16]string{
+	1: "evaluator_version_id",
+	2: "aggregator_results",
+	3: "name",
+	4: "version",
+	5: "dimension_results",
 }
 
-func (p *ExperimentTemplateFilter) IsSetFilters() bool {
-	return p.Filters != nil
+func (p *EvaluatorAggregateResult_) IsSetAggregatorResults() bool {
+	return p.AggregatorResults != nil
 }
 
-func (p *ExperimentTemplateFilter) IsSetKeywordSearch() bool {
-	return p.KeywordSearch != nil
+func (p *EvaluatorAggregateResult_) IsSetName() bool {
+	return p.Name != nil
 }
 
-func (p *ExperimentTemplateFilter) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorAggregateResult_) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *EvaluatorAggregateResult_) IsSetDimensionResults() bool {
+	return p.DimensionResults != nil
+}
+
+func (p *EvaluatorAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetEvaluatorVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -16207,21 +20793,46 @@ func (p *ExperimentTemplateFilter) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -16235,13 +20846,17 @@ func (p *ExperimentTemplateFilter) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentTemplateFilter[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorAggregateResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16249,63 +20864,200 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvaluatorAggregateResult_[fieldId]))
 }
 
-func (p *ExperimentTemplateFilter) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewFilters()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Filters = _field
+	p.EvaluatorVersionID = _field
 	return nil
 }
-func (p *ExperimentTemplateFilter) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewKeywordSearch()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AggregatorResults = _field
+	return nil
+}
+func (p *EvaluatorAggregateResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *EvaluatorAggregateResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Version = _field
+	return nil
+}
+func (p *EvaluatorAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluatorDimensionAggregateResult_, 0, size)
+	values := make([]EvaluatorDimensionAggregateResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.DimensionResults = _field
+	return nil
+}
+
+func (p *EvaluatorAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorAggregateResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
 		return err
 	}
-	p.KeywordSearch = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAggregatorResults() {
+		if err = oprot.WriteFieldBegin("aggregator_results", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AggregatorResults)); err != nil {
+			return err
+		}
+		for _, v := range p.AggregatorResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *ExperimentTemplateFilter) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("ExperimentTemplateFilter"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
+func (p *EvaluatorAggregateResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-func (p *ExperimentTemplateFilter) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilters() {
-		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 1); err != nil {
+func (p *EvaluatorAggregateResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Filters.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.Version); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -16314,16 +21066,24 @@ func (p *ExperimentTemplateFilter) writeField1(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ExperimentTemplateFilter) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeywordSearch() {
-		if err = oprot.WriteFieldBegin("keyword_search", thrift.STRUCT, 2); err != nil {
+func (p *EvaluatorAggregateResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDimensionResults() {
+		if err = oprot.WriteFieldBegin("dimension_results", thrift.LIST, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.KeywordSearch.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.DimensionResults)); err != nil {
+			return err
+		}
+		for _, v := range p.DimensionResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -16332,105 +21092,158 @@ func (p *ExperimentTemplateFilter) writeField2(oprot thrift.TProtocol) (err erro
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *ExperimentTemplateFilter) String() string {
+func (p *EvaluatorAggregateResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentTemplateFilter(%+v)", *p)
+	return fmt.Sprintf("EvaluatorAggregateResult_(%+v)", *p)
 
 }
 
-func (p *ExperimentTemplateFilter) DeepEqual(ano *ExperimentTemplateFilter) bool {
+func (p *EvaluatorAggregateResult_) DeepEqual(ano *EvaluatorAggregateResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Filters) {
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.KeywordSearch) {
+	if !p.Field2DeepEqual(ano.AggregatorResults) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Version) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.DimensionResults) {
 		return false
 	}
 	return true
 }
 
-func (p *ExperimentTemplateFilter) Field1DeepEqual(src *Filters) bool {
+func (p *EvaluatorAggregateResult_) Field1DeepEqual(src int64) bool {
 
-	if !p.Filters.DeepEqual(src) {
+	if p.EvaluatorVersionID != src {
 		return false
 	}
 	return true
 }
-func (p *ExperimentTemplateFilter) Field2DeepEqual(src *KeywordSearch) bool {
+func (p *EvaluatorAggregateResult_) Field2DeepEqual(src []*AggregatorResult_) bool {
 
-	if !p.KeywordSearch.DeepEqual(src) {
+	if len(p.AggregatorResults) != len(src) {
 		return false
 	}
+	for i, v := range p.AggregatorResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
+func (p *EvaluatorAggregateResult_) Field3DeepEqual(src *string) bool {
 
-type Filters struct {
-	FilterConditions []*FilterCondition `thrift:"filter_conditions,1,optional" frugal:"1,optional,list<FilterCondition>" form:"filter_conditions" json:"filter_conditions,omitempty" query:"filter_conditions"`
-	LogicOp          *FilterLogicOp     `thrift:"logic_op,2,optional" frugal:"2,optional,FilterLogicOp" form:"logic_op" json:"logic_op,omitempty" query:"logic_op"`
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorAggregateResult_) Field4DeepEqual(src *string) bool {
 
-func NewFilters() *Filters {
-	return &Filters{}
+	if p.Version == src {
+		return true
+	} else if p.Version == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Version, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorAggregateResult_) Field5DeepEqual(src []*EvaluatorDimensionAggregateResult_) bool {
 
-func (p *Filters) InitDefault() {
+	if len(p.DimensionResults) != len(src) {
+		return false
+	}
+	for i, v := range p.DimensionResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
 
-var Filters_FilterConditions_DEFAULT []*FilterCondition
+// 评估器单个维度的聚合结果
+type EvaluatorDimensionAggregateResult_ struct {
+	Name              *string              `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	AggregatorResults []*AggregatorResult_ `thrift:"aggregator_results,2,optional" frugal:"2,optional,list<AggregatorResult_>" form:"aggregator_results" json:"aggregator_results,omitempty" query:"aggregator_results"`
+}
 
-func (p *Filters) GetFilterConditions() (v []*FilterCondition) {
+func NewEvaluatorDimensionAggregateResult_() *EvaluatorDimensionAggregateResult_ {
+	return &EvaluatorDimensionAggregateResult_{}
+}
+
+func (p *EvaluatorDimensionAggregateResult_) InitDefault() {
+}
+
+var EvaluatorDimensionAggregateResult__Name_DEFAULT string
+
+func (p *EvaluatorDimensionAggregateResult_) GetName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFilterConditions() {
-		return Filters_FilterConditions_DEFAULT
+	if !p.IsSetName() {
+		return EvaluatorDimensionAggregateResult__Name_DEFAULT
 	}
-	return p.FilterConditions
+	return *p.Name
 }
 
-var Filters_LogicOp_DEFAULT FilterLogicOp
+var EvaluatorDimensionAggregateResult__AggregatorResults_DEFAULT []*AggregatorResult_
 
-func (p *Filters) GetLogicOp() (v FilterLogicOp) {
+func (p *EvaluatorDimensionAggregateResult_) GetAggregatorResults() (v []*AggregatorResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetLogicOp() {
-		return Filters_LogicOp_DEFAULT
+	if !p.IsSetAggregatorResults() {
+		return EvaluatorDimensionAggregateResult__AggregatorResults_DEFAULT
 	}
-	return *p.LogicOp
+	return p.AggregatorResults
 }
-func (p *Filters) SetFilterConditions(val []*FilterCondition) {
-	p.FilterConditions = val
+func (p *EvaluatorDimensionAggregateResult_) SetName(val *string) {
+	p.Name = val
 }
-func (p *Filters) SetLogicOp(val *FilterLogicOp) {
-	p.LogicOp = val
+func (p *EvaluatorDimensionAggregateResult_) SetAggregatorResults(val []*AggregatorResult_) {
+	p.AggregatorResults = val
 }
 
-var fieldIDToName_Filters = map[int16]string{
-	1: "filter_conditions",
-	2: "logic_op",
+var fieldIDToName_EvaluatorDimensionAggregateResult_ = map[int16]string{
+	1: "name",
+	2: "aggregator_results",
 }
 
-func (p *Filters) IsSetFilterConditions() bool {
-	return p.FilterConditions != nil
+func (p *EvaluatorDimensionAggregateResult_) IsSetName() bool {
+	return p.Name != nil
 }
 
-func (p *Filters) IsSetLogicOp() bool {
-	return p.LogicOp != nil
+func (p *EvaluatorDimensionAggregateResult_) IsSetAggregatorResults() bool {
+	return p.AggregatorResults != nil
 }
 
-func (p *Filters) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorDimensionAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -16449,7 +21262,7 @@ func (p *Filters) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -16457,7 +21270,7 @@ func (p *Filters) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -16483,7 +21296,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Filters[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorDimensionAggregateResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16493,13 +21306,24 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *Filters) ReadField1(iprot thrift.TProtocol) error {
+func (p *EvaluatorDimensionAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *EvaluatorDimensionAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*FilterCondition, 0, size)
-	values := make([]FilterCondition, size)
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -16513,25 +21337,13 @@ func (p *Filters) ReadField1(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.FilterConditions = _field
-	return nil
-}
-func (p *Filters) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *FilterLogicOp
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		tmp := FilterLogicOp(v)
-		_field = &tmp
-	}
-	p.LogicOp = _field
+	p.AggregatorResults = _field
 	return nil
 }
 
-func (p *Filters) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorDimensionAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Filters"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorDimensionAggregateResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16561,20 +21373,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Filters) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilterConditions() {
-		if err = oprot.WriteFieldBegin("filter_conditions", thrift.LIST, 1); err != nil {
+func (p *EvaluatorDimensionAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FilterConditions)); err != nil {
-			return err
-		}
-		for _, v := range p.FilterConditions {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.Name); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -16587,12 +21391,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Filters) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLogicOp() {
-		if err = oprot.WriteFieldBegin("logic_op", thrift.I32, 2); err != nil {
+func (p *EvaluatorDimensionAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAggregatorResults() {
+		if err = oprot.WriteFieldBegin("aggregator_results", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.LogicOp)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AggregatorResults)); err != nil {
+			return err
+		}
+		for _, v := range p.AggregatorResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -16606,35 +21418,47 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *Filters) String() string {
+func (p *EvaluatorDimensionAggregateResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Filters(%+v)", *p)
+	return fmt.Sprintf("EvaluatorDimensionAggregateResult_(%+v)", *p)
 
 }
 
-func (p *Filters) DeepEqual(ano *Filters) bool {
+func (p *EvaluatorDimensionAggregateResult_) DeepEqual(ano *EvaluatorDimensionAggregateResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.FilterConditions) {
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.AggregatorResults) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorDimensionAggregateResult_) Field1DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.LogicOp) {
+	if strings.Compare(*p.Name, *src) != 0 {
 		return false
 	}
 	return true
 }
+func (p *EvaluatorDimensionAggregateResult_) Field2DeepEqual(src []*AggregatorResult_) bool {
 
-func (p *Filters) Field1DeepEqual(src []*FilterCondition) bool {
-
-	if len(p.FilterConditions) != len(src) {
+	if len(p.AggregatorResults) != len(src) {
 		return false
 	}
-	for i, v := range p.FilterConditions {
+	for i, v := range p.AggregatorResults {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -16642,70 +21466,151 @@ func (p *Filters) Field1DeepEqual(src []*FilterCondition) bool {
 	}
 	return true
 }
-func (p *Filters) Field2DeepEqual(src *FilterLogicOp) bool {
 
-	if p.LogicOp == src {
-		return true
-	} else if p.LogicOp == nil || src == nil {
-		return false
+type SignificanceTestResult_ struct {
+	TestType    *SignificanceTestType `thrift:"test_type,1,optional" frugal:"1,optional,string" form:"test_type" json:"test_type,omitempty" query:"test_type"`
+	Statistic   *float64              `thrift:"statistic,2,optional" frugal:"2,optional,double" form:"statistic" json:"statistic,omitempty" query:"statistic"`
+	PValue      *float64              `thrift:"p_value,3,optional" frugal:"3,optional,double" form:"p_value" json:"p_value,omitempty" query:"p_value"`
+	Significant *bool                 `thrift:"significant,4,optional" frugal:"4,optional,bool" form:"significant" json:"significant,omitempty" query:"significant"`
+	// 仅 Bootstrap 返回
+	CiLower *float64 `thrift:"ci_lower,5,optional" frugal:"5,optional,double" form:"ci_lower" json:"ci_lower,omitempty" query:"ci_lower"`
+	// 仅 Bootstrap 返回
+	CiUpper *float64 `thrift:"ci_upper,6,optional" frugal:"6,optional,double" form:"ci_upper" json:"ci_upper,omitempty" query:"ci_upper"`
+}
+
+func NewSignificanceTestResult_() *SignificanceTestResult_ {
+	return &SignificanceTestResult_{}
+}
+
+func (p *SignificanceTestResult_) InitDefault() {
+}
+
+var SignificanceTestResult__TestType_DEFAULT SignificanceTestType
+
+func (p *SignificanceTestResult_) GetTestType() (v SignificanceTestType) {
+	if p == nil {
+		return
 	}
-	if *p.LogicOp != *src {
-		return false
+	if !p.IsSetTestType() {
+		return SignificanceTestResult__TestType_DEFAULT
 	}
-	return true
+	return *p.TestType
 }
 
-type FilterField struct {
-	FieldType FieldType `thrift:"field_type,1,required" frugal:"1,required,FieldType" form:"field_type,required" json:"field_type,required" query:"field_type,required"`
-	// 二级key放此字段里
-	FieldKey *string `thrift:"field_key,2,optional" frugal:"2,optional,string" form:"field_key" json:"field_key,omitempty" query:"field_key"`
+var SignificanceTestResult__Statistic_DEFAULT float64
+
+func (p *SignificanceTestResult_) GetStatistic() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatistic() {
+		return SignificanceTestResult__Statistic_DEFAULT
+	}
+	return *p.Statistic
 }
 
-func NewFilterField() *FilterField {
-	return &FilterField{}
+var SignificanceTestResult__PValue_DEFAULT float64
+
+func (p *SignificanceTestResult_) GetPValue() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPValue() {
+		return SignificanceTestResult__PValue_DEFAULT
+	}
+	return *p.PValue
 }
 
-func (p *FilterField) InitDefault() {
+var SignificanceTestResult__Significant_DEFAULT bool
+
+func (p *SignificanceTestResult_) GetSignificant() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSignificant() {
+		return SignificanceTestResult__Significant_DEFAULT
+	}
+	return *p.Significant
 }
 
-func (p *FilterField) GetFieldType() (v FieldType) {
-	if p != nil {
-		return p.FieldType
+var SignificanceTestResult__CiLower_DEFAULT float64
+
+func (p *SignificanceTestResult_) GetCiLower() (v float64) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetCiLower() {
+		return SignificanceTestResult__CiLower_DEFAULT
+	}
+	return *p.CiLower
 }
 
-var FilterField_FieldKey_DEFAULT string
+var SignificanceTestResult__CiUpper_DEFAULT float64
 
-func (p *FilterField) GetFieldKey() (v string) {
+func (p *SignificanceTestResult_) GetCiUpper() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFieldKey() {
-		return FilterField_FieldKey_DEFAULT
+	if !p.IsSetCiUpper() {
+		return SignificanceTestResult__CiUpper_DEFAULT
 	}
-	return *p.FieldKey
+	return *p.CiUpper
 }
-func (p *FilterField) SetFieldType(val FieldType) {
-	p.FieldType = val
+func (p *SignificanceTestResult_) SetTestType(val *SignificanceTestType) {
+	p.TestType = val
 }
-func (p *FilterField) SetFieldKey(val *string) {
-	p.FieldKey = val
+func (p *SignificanceTestResult_) SetStatistic(val *float64) {
+	p.Statistic = val
+}
+func (p *SignificanceTestResult_) SetPValue(val *float64) {
+	p.PValue = val
+}
+func (p *SignificanceTestResult_) SetSignificant(val *bool) {
+	p.Significant = val
+}
+func (p *SignificanceTestResult_) SetCiLower(val *float64) {
+	p.CiLower = val
+}
+func (p *SignificanceTestResult_) SetCiUpper(val *float64) {
+	p.CiUpper = val
 }
 
-var fieldIDToName_FilterField = map[int16]string{
-	1: "field_type",
-	2: "field_key",
+var fieldIDToName_SignificanceTestResult_ = map[int16]string{
+	1: "test_type",
+	2: "statistic",
+	3: "p_value",
+	4: "significant",
+	5: "ci_lower",
+	6: "ci_upper",
 }
 
-func (p *FilterField) IsSetFieldKey() bool {
-	return p.FieldKey != nil
+func (p *SignificanceTestResult_) IsSetTestType() bool {
+	return p.TestType != nil
 }
 
-func (p *FilterField) Read(iprot thrift.TProtocol) (err error) {
+func (p *SignificanceTestResult_) IsSetStatistic() bool {
+	return p.Statistic != nil
+}
+
+func (p *SignificanceTestResult_) IsSetPValue() bool {
+	return p.PValue != nil
+}
+
+func (p *SignificanceTestResult_) IsSetSignificant() bool {
+	return p.Significant != nil
+}
+
+func (p *SignificanceTestResult_) IsSetCiLower() bool {
+	return p.CiLower != nil
+}
+
+func (p *SignificanceTestResult_) IsSetCiUpper() bool {
+	return p.CiUpper != nil
+}
+
+func (p *SignificanceTestResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetFieldType bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -16722,22 +21627,53 @@ func (p *FilterField) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetFieldType = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -16751,17 +21687,13 @@ func (p *FilterField) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetFieldType {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterField[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SignificanceTestResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -16769,36 +21701,78 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FilterField[fieldId]))
 }
 
-func (p *FilterField) ReadField1(iprot thrift.TProtocol) error {
+func (p *SignificanceTestResult_) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field FieldType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *SignificanceTestType
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = FieldType(v)
+		_field = &v
 	}
-	p.FieldType = _field
+	p.TestType = _field
 	return nil
 }
-func (p *FilterField) ReadField2(iprot thrift.TProtocol) error {
+func (p *SignificanceTestResult_) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.FieldKey = _field
+	p.Statistic = _field
 	return nil
 }
+func (p *SignificanceTestResult_) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *FilterField) Write(oprot thrift.TProtocol) (err error) {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PValue = _field
+	return nil
+}
+func (p *SignificanceTestResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Significant = _field
+	return nil
+}
+func (p *SignificanceTestResult_) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CiLower = _field
+	return nil
+}
+func (p *SignificanceTestResult_) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CiUpper = _field
+	return nil
+}
+
+func (p *SignificanceTestResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FilterField"); err != nil {
+	if err = oprot.WriteStructBegin("SignificanceTestResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -16806,50 +21780,140 @@ func (p *FilterField) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SignificanceTestResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTestType() {
+		if err = oprot.WriteFieldBegin("test_type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TestType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *SignificanceTestResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatistic() {
+		if err = oprot.WriteFieldBegin("statistic", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Statistic); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *SignificanceTestResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPValue() {
+		if err = oprot.WriteFieldBegin("p_value", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
 	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-func (p *FilterField) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("field_type", thrift.I32, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(int32(p.FieldType)); err != nil {
-		return err
+func (p *SignificanceTestResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSignificant() {
+		if err = oprot.WriteFieldBegin("significant", thrift.BOOL, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Significant); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *SignificanceTestResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCiLower() {
+		if err = oprot.WriteFieldBegin("ci_lower", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.CiLower); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *FilterField) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFieldKey() {
-		if err = oprot.WriteFieldBegin("field_key", thrift.STRING, 2); err != nil {
+func (p *SignificanceTestResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCiUpper() {
+		if err = oprot.WriteFieldBegin("ci_upper", thrift.DOUBLE, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.FieldKey); err != nil {
+		if err := oprot.WriteDouble(*p.CiUpper); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -16858,140 +21922,298 @@ func (p *FilterField) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *FilterField) String() string {
+func (p *SignificanceTestResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FilterField(%+v)", *p)
+	return fmt.Sprintf("SignificanceTestResult_(%+v)", *p)
 
 }
 
-func (p *FilterField) DeepEqual(ano *FilterField) bool {
+func (p *SignificanceTestResult_) DeepEqual(ano *SignificanceTestResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.FieldType) {
+	if !p.Field1DeepEqual(ano.TestType) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.FieldKey) {
+	if !p.Field2DeepEqual(ano.Statistic) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.PValue) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Significant) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.CiLower) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.CiUpper) {
 		return false
 	}
 	return true
 }
 
-func (p *FilterField) Field1DeepEqual(src FieldType) bool {
+func (p *SignificanceTestResult_) Field1DeepEqual(src *SignificanceTestType) bool {
 
-	if p.FieldType != src {
+	if p.TestType == src {
+		return true
+	} else if p.TestType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TestType, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *FilterField) Field2DeepEqual(src *string) bool {
+func (p *SignificanceTestResult_) Field2DeepEqual(src *float64) bool {
 
-	if p.FieldKey == src {
+	if p.Statistic == src {
 		return true
-	} else if p.FieldKey == nil || src == nil {
+	} else if p.Statistic == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.FieldKey, *src) != 0 {
+	if *p.Statistic != *src {
 		return false
 	}
 	return true
 }
+func (p *SignificanceTestResult_) Field3DeepEqual(src *float64) bool {
 
-// 字段过滤器
-type FilterCondition struct {
-	// 过滤字段，比如评估器ID
-	Field *FilterField `thrift:"field,1" frugal:"1,default,FilterField" form:"field" json:"field" query:"field"`
-	// 操作符，比如等于、包含、大于、小于等
-	Operator FilterOperatorType `thrift:"operator,2" frugal:"2,default,FilterOperatorType" form:"operator" json:"operator" query:"operator"`
-	// 操作值;支持多种类型的操作值；
-	Value        string        `thrift:"value,3" frugal:"3,default,string" form:"value" json:"value" query:"value"`
-	SourceTarget *SourceTarget `thrift:"source_target,4,optional" frugal:"4,optional,SourceTarget" form:"source_target" json:"source_target,omitempty" query:"source_target"`
+	if p.PValue == src {
+		return true
+	} else if p.PValue == nil || src == nil {
+		return false
+	}
+	if *p.PValue != *src {
+		return false
+	}
+	return true
 }
+func (p *SignificanceTestResult_) Field4DeepEqual(src *bool) bool {
 
-func NewFilterCondition() *FilterCondition {
-	return &FilterCondition{}
+	if p.Significant == src {
+		return true
+	} else if p.Significant == nil || src == nil {
+		return false
+	}
+	if *p.Significant != *src {
+		return false
+	}
+	return true
 }
+func (p *SignificanceTestResult_) Field5DeepEqual(src *float64) bool {
 
-func (p *FilterCondition) InitDefault() {
+	if p.CiLower == src {
+		return true
+	} else if p.CiLower == nil || src == nil {
+		return false
+	}
+	if *p.CiLower != *src {
+		return false
+	}
+	return true
 }
+func (p *SignificanceTestResult_) Field6DeepEqual(src *float64) bool {
 
-var FilterCondition_Field_DEFAULT *FilterField
+	if p.CiUpper == src {
+		return true
+	} else if p.CiUpper == nil || src == nil {
+		return false
+	}
+	if *p.CiUpper != *src {
+		return false
+	}
+	return true
+}
 
-func (p *FilterCondition) GetField() (v *FilterField) {
+// 评估器版本粒度的实验对比结果，得分按 item_id + turn_id 配对
+type EvaluatorComparisonResult_ struct {
+	EvaluatorVersionID int64    `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
+	PairedCount        *int64   `thrift:"paired_count,2,optional" frugal:"2,optional,i64" form:"paired_count" json:"paired_count,omitempty" query:"paired_count"`
+	BaseMean           *float64 `thrift:"base_mean,3,optional" frugal:"3,optional,double" form:"base_mean" json:"base_mean,omitempty" query:"base_mean"`
+	CompareMean        *float64 `thrift:"compare_mean,4,optional" frugal:"4,optional,double" form:"compare_mean" json:"compare_mean,omitempty" query:"compare_mean"`
+	// compare_mean - base_mean
+	MeanDiff *float64 `thrift:"mean_diff,5,optional" frugal:"5,optional,double" form:"mean_diff" json:"mean_diff,omitempty" query:"mean_diff"`
+	// 得分均为 0/1 时使用 McNemar 检验
+	Binary      *bool                      `thrift:"binary,6,optional" frugal:"6,optional,bool" form:"binary" json:"binary,omitempty" query:"binary"`
+	TestResults []*SignificanceTestResult_ `thrift:"test_results,7,optional" frugal:"7,optional,list<SignificanceTestResult_>" form:"test_results" json:"test_results,omitempty" query:"test_results"`
+	Verdict     *ComparisonVerdict         `thrift:"verdict,8,optional" frugal:"8,optional,string" form:"verdict" json:"verdict,omitempty" query:"verdict"`
+}
+
+func NewEvaluatorComparisonResult_() *EvaluatorComparisonResult_ {
+	return &EvaluatorComparisonResult_{}
+}
+
+func (p *EvaluatorComparisonResult_) InitDefault() {
+}
+
+func (p *EvaluatorComparisonResult_) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
+	}
+	return
+}
+
+var EvaluatorComparisonResult__PairedCount_DEFAULT int64
+
+func (p *EvaluatorComparisonResult_) GetPairedCount() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetField() {
-		return FilterCondition_Field_DEFAULT
+	if !p.IsSetPairedCount() {
+		return EvaluatorComparisonResult__PairedCount_DEFAULT
 	}
-	return p.Field
+	return *p.PairedCount
 }
 
-func (p *FilterCondition) GetOperator() (v FilterOperatorType) {
-	if p != nil {
-		return p.Operator
+var EvaluatorComparisonResult__BaseMean_DEFAULT float64
+
+func (p *EvaluatorComparisonResult_) GetBaseMean() (v float64) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetBaseMean() {
+		return EvaluatorComparisonResult__BaseMean_DEFAULT
+	}
+	return *p.BaseMean
 }
 
-func (p *FilterCondition) GetValue() (v string) {
-	if p != nil {
-		return p.Value
+var EvaluatorComparisonResult__CompareMean_DEFAULT float64
+
+func (p *EvaluatorComparisonResult_) GetCompareMean() (v float64) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetCompareMean() {
+		return EvaluatorComparisonResult__CompareMean_DEFAULT
+	}
+	return *p.CompareMean
 }
 
-var FilterCondition_SourceTarget_DEFAULT *SourceTarget
+var EvaluatorComparisonResult__MeanDiff_DEFAULT float64
 
-func (p *FilterCondition) GetSourceTarget() (v *SourceTarget) {
+func (p *EvaluatorComparisonResult_) GetMeanDiff() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetSourceTarget() {
-		return FilterCondition_SourceTarget_DEFAULT
+	if !p.IsSetMeanDiff() {
+		return EvaluatorComparisonResult__MeanDiff_DEFAULT
 	}
-	return p.SourceTarget
+	return *p.MeanDiff
 }
-func (p *FilterCondition) SetField(val *FilterField) {
-	p.Field = val
+
+var EvaluatorComparisonResult__Binary_DEFAULT bool
+
+func (p *EvaluatorComparisonResult_) GetBinary() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBinary() {
+		return EvaluatorComparisonResult__Binary_DEFAULT
+	}
+	return *p.Binary
 }
-func (p *FilterCondition) SetOperator(val FilterOperatorType) {
-	p.Operator = val
+
+var EvaluatorComparisonResult__TestResults_DEFAULT []*SignificanceTestResult_
+
+func (p *EvaluatorComparisonResult_) GetTestResults() (v []*SignificanceTestResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTestResults() {
+		return EvaluatorComparisonResult__TestResults_DEFAULT
+	}
+	return p.TestResults
 }
-func (p *FilterCondition) SetValue(val string) {
-	p.Value = val
+
+var EvaluatorComparisonResult__Verdict_DEFAULT ComparisonVerdict
+
+func (p *EvaluatorComparisonResult_) GetVerdict() (v ComparisonVerdict) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetVerdict() {
+		return EvaluatorComparisonResult__Verdict_DEFAULT
+	}
+	return *p.Verdict
 }
-func (p *FilterCondition) SetSourceTarget(val *SourceTarget) {
-	p.SourceTarget = val
+func (p *EvaluatorComparisonResult_) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *EvaluatorComparisonResult_) SetPairedCount(val *int64) {
+	p.PairedCount = val
+}
+func (p *EvaluatorComparisonResult_) SetBaseMean(val *float64) {
+	p.BaseMean = val
+}
+func (p *EvaluatorComparisonResult_) SetCompareMean(val *float64) {
+	p.CompareMean = val
+}
+func (p *EvaluatorComparisonResult_) SetMeanDiff(val *float64) {
+	p.MeanDiff = val
+}
+func (p *EvaluatorComparisonResult_) SetBinary(val *bool) {
+	p.Binary = val
+}
+func (p *EvaluatorComparisonResult_) SetTestResults(val []*SignificanceTestResult_) {
+	p.TestResults = val
+}
+func (p *EvaluatorComparisonResult_) SetVerdict(val *ComparisonVerdict) {
+	p.Verdict = val
 }
 
-var fieldIDToName_FilterCondition = map[int16]string{
-	1: "field",
-	2: "operator",
-	3: "value",
-	4: "source_target",
+var fieldIDToName_EvaluatorComparisonResult_ = map[int16]string{
+	1: "evaluator_version_id",
+	2: "paired_count",
+	3: "base_mean",
+	4: "compare_mean",
+	5: "mean_diff",
+	6: "binary",
+	7: "test_results",
+	8: "verdict",
 }
 
-func (p *FilterCondition) IsSetField() bool {
-	return p.Field != nil
+func (p *EvaluatorComparisonResult_) IsSetPairedCount() bool {
+	return p.PairedCount != nil
 }
 
-func (p *FilterCondition) IsSetSourceTarget() bool {
-	return p.SourceTarget != nil
+func (p *EvaluatorComparisonResult_) IsSetBaseMean() bool {
+	return p.BaseMean != nil
 }
 
-func (p *FilterCondition) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorComparisonResult_) IsSetCompareMean() bool {
+	return p.CompareMean != nil
+}
+
+func (p *EvaluatorComparisonResult_) IsSetMeanDiff() bool {
+	return p.MeanDiff != nil
+}
+
+func (p *EvaluatorComparisonResult_) IsSetBinary() bool {
+	return p.Binary != nil
+}
+
+func (p *EvaluatorComparisonResult_) IsSetTestResults() bool {
+	return p.TestResults != nil
+}
+
+func (p *EvaluatorComparisonResult_) IsSetVerdict() bool {
+	return p.Verdict != nil
+}
+
+func (p *EvaluatorComparisonResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetEvaluatorVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -17008,32 +22230,65 @@ func (p *FilterCondition) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField4(iprot); err != nil {
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -17052,13 +22307,17 @@ func (p *FilterCondition) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterCondition[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorComparisonResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17066,50 +22325,114 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvaluatorComparisonResult_[fieldId]))
 }
 
-func (p *FilterCondition) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewFilterField()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorComparisonResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Field = _field
+	p.EvaluatorVersionID = _field
 	return nil
 }
-func (p *FilterCondition) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorComparisonResult_) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field FilterOperatorType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = FilterOperatorType(v)
+		_field = &v
 	}
-	p.Operator = _field
+	p.PairedCount = _field
 	return nil
 }
-func (p *FilterCondition) ReadField3(iprot thrift.TProtocol) error {
+func (p *EvaluatorComparisonResult_) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Value = _field
+	p.BaseMean = _field
 	return nil
 }
-func (p *FilterCondition) ReadField4(iprot thrift.TProtocol) error {
-	_field := NewSourceTarget()
-	if err := _field.Read(iprot); err != nil {
+func (p *EvaluatorComparisonResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.SourceTarget = _field
+	p.CompareMean = _field
 	return nil
 }
+func (p *EvaluatorComparisonResult_) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *FilterCondition) Write(oprot thrift.TProtocol) (err error) {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MeanDiff = _field
+	return nil
+}
+func (p *EvaluatorComparisonResult_) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Binary = _field
+	return nil
+}
+func (p *EvaluatorComparisonResult_) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SignificanceTestResult_, 0, size)
+	values := make([]SignificanceTestResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.TestResults = _field
+	return nil
+}
+func (p *EvaluatorComparisonResult_) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *ComparisonVerdict
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Verdict = _field
+	return nil
+}
+
+func (p *EvaluatorComparisonResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FilterCondition"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorComparisonResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17129,6 +22452,22 @@ func (p *FilterCondition) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -17147,11 +22486,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FilterCondition) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("field", thrift.STRUCT, 1); err != nil {
+func (p *EvaluatorComparisonResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Field.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -17163,15 +22502,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *FilterCondition) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("operator", thrift.I32, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(int32(p.Operator)); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *EvaluatorComparisonResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPairedCount() {
+		if err = oprot.WriteFieldBegin("paired_count", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PairedCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -17179,15 +22520,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *FilterCondition) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("value", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Value); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *EvaluatorComparisonResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseMean() {
+		if err = oprot.WriteFieldBegin("base_mean", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.BaseMean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -17195,141 +22538,303 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *FilterCondition) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSourceTarget() {
-		if err = oprot.WriteFieldBegin("source_target", thrift.STRUCT, 4); err != nil {
+func (p *EvaluatorComparisonResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCompareMean() {
+		if err = oprot.WriteFieldBegin("compare_mean", thrift.DOUBLE, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.SourceTarget.Write(oprot); err != nil {
+		if err := oprot.WriteDouble(*p.CompareMean); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *EvaluatorComparisonResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMeanDiff() {
+		if err = oprot.WriteFieldBegin("mean_diff", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.MeanDiff); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvaluatorComparisonResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBinary() {
+		if err = oprot.WriteFieldBegin("binary", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Binary); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvaluatorComparisonResult_) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTestResults() {
+		if err = oprot.WriteFieldBegin("test_results", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TestResults)); err != nil {
+			return err
+		}
+		for _, v := range p.TestResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvaluatorComparisonResult_) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerdict() {
+		if err = oprot.WriteFieldBegin("verdict", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Verdict); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
 			goto WriteFieldEndError
 		}
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *EvaluatorComparisonResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorComparisonResult_(%+v)", *p)
+
+}
+
+func (p *EvaluatorComparisonResult_) DeepEqual(ano *EvaluatorComparisonResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PairedCount) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.BaseMean) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CompareMean) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.MeanDiff) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Binary) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.TestResults) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Verdict) {
+		return false
+	}
+	return true
 }
 
-func (p *FilterCondition) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("FilterCondition(%+v)", *p)
+func (p *EvaluatorComparisonResult_) Field1DeepEqual(src int64) bool {
 
+	if p.EvaluatorVersionID != src {
+		return false
+	}
+	return true
 }
+func (p *EvaluatorComparisonResult_) Field2DeepEqual(src *int64) bool {
 
-func (p *FilterCondition) DeepEqual(ano *FilterCondition) bool {
-	if p == ano {
+	if p.PairedCount == src {
 		return true
-	} else if p == nil || ano == nil {
+	} else if p.PairedCount == nil || src == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Field) {
+	if *p.PairedCount != *src {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Operator) {
+	return true
+}
+func (p *EvaluatorComparisonResult_) Field3DeepEqual(src *float64) bool {
+
+	if p.BaseMean == src {
+		return true
+	} else if p.BaseMean == nil || src == nil {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Value) {
+	if *p.BaseMean != *src {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.SourceTarget) {
+	return true
+}
+func (p *EvaluatorComparisonResult_) Field4DeepEqual(src *float64) bool {
+
+	if p.CompareMean == src {
+		return true
+	} else if p.CompareMean == nil || src == nil {
+		return false
+	}
+	if *p.CompareMean != *src {
 		return false
 	}
 	return true
 }
+func (p *EvaluatorComparisonResult_) Field5DeepEqual(src *float64) bool {
 
-func (p *FilterCondition) Field1DeepEqual(src *FilterField) bool {
-
-	if !p.Field.DeepEqual(src) {
+	if p.MeanDiff == src {
+		return true
+	} else if p.MeanDiff == nil || src == nil {
+		return false
+	}
+	if *p.MeanDiff != *src {
 		return false
 	}
 	return true
 }
-func (p *FilterCondition) Field2DeepEqual(src FilterOperatorType) bool {
+func (p *EvaluatorComparisonResult_) Field6DeepEqual(src *bool) bool {
 
-	if p.Operator != src {
+	if p.Binary == src {
+		return true
+	} else if p.Binary == nil || src == nil {
+		return false
+	}
+	if *p.Binary != *src {
 		return false
 	}
 	return true
 }
-func (p *FilterCondition) Field3DeepEqual(src string) bool {
+func (p *EvaluatorComparisonResult_) Field7DeepEqual(src []*SignificanceTestResult_) bool {
 
-	if strings.Compare(p.Value, src) != 0 {
+	if len(p.TestResults) != len(src) {
 		return false
 	}
+	for i, v := range p.TestResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
 	return true
 }
-func (p *FilterCondition) Field4DeepEqual(src *SourceTarget) bool {
+func (p *EvaluatorComparisonResult_) Field8DeepEqual(src *ComparisonVerdict) bool {
 
-	if !p.SourceTarget.DeepEqual(src) {
+	if p.Verdict == src {
+		return true
+	} else if p.Verdict == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Verdict, *src) != 0 {
 		return false
 	}
 	return true
 }
 
-type SourceTarget struct {
-	EvalTargetType  *eval_target.EvalTargetType `thrift:"eval_target_type,1,optional" frugal:"1,optional,EvalTargetType" form:"eval_target_type" json:"eval_target_type,omitempty" query:"eval_target_type"`
-	SourceTargetIds []string                    `thrift:"source_target_ids,3,optional" frugal:"3,optional,list<string>" form:"source_target_ids" json:"source_target_ids,omitempty" query:"source_target_ids"`
+// 对比实验相对基准实验的显著性检验结果
+type ExptComparisonResult_ struct {
+	BaseExperimentID    int64                         `thrift:"base_experiment_id,1,required" frugal:"1,required,i64" json:"base_experiment_id" form:"base_experiment_id,required" query:"base_experiment_id,required"`
+	CompareExperimentID int64                         `thrift:"compare_experiment_id,2,required" frugal:"2,required,i64" json:"compare_experiment_id" form:"compare_experiment_id,required" query:"compare_experiment_id,required"`
+	EvaluatorResults    []*EvaluatorComparisonResult_ `thrift:"evaluator_results,3,optional" frugal:"3,optional,list<EvaluatorComparisonResult_>" form:"evaluator_results" json:"evaluator_results,omitempty" query:"evaluator_results"`
 }
 
-func NewSourceTarget() *SourceTarget {
-	return &SourceTarget{}
+func NewExptComparisonResult_() *ExptComparisonResult_ {
+	return &ExptComparisonResult_{}
 }
 
-func (p *SourceTarget) InitDefault() {
+func (p *ExptComparisonResult_) InitDefault() {
 }
 
-var SourceTarget_EvalTargetType_DEFAULT eval_target.EvalTargetType
-
-func (p *SourceTarget) GetEvalTargetType() (v eval_target.EvalTargetType) {
-	if p == nil {
-		return
+func (p *ExptComparisonResult_) GetBaseExperimentID() (v int64) {
+	if p != nil {
+		return p.BaseExperimentID
 	}
-	if !p.IsSetEvalTargetType() {
-		return SourceTarget_EvalTargetType_DEFAULT
+	return
+}
+
+func (p *ExptComparisonResult_) GetCompareExperimentID() (v int64) {
+	if p != nil {
+		return p.CompareExperimentID
 	}
-	return *p.EvalTargetType
+	return
 }
 
-var SourceTarget_SourceTargetIds_DEFAULT []string
+var ExptComparisonResult__EvaluatorResults_DEFAULT []*EvaluatorComparisonResult_
 
-func (p *SourceTarget) GetSourceTargetIds() (v []string) {
+func (p *ExptComparisonResult_) GetEvaluatorResults() (v []*EvaluatorComparisonResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetSourceTargetIds() {
-		return SourceTarget_SourceTargetIds_DEFAULT
+	if !p.IsSetEvaluatorResults() {
+		return ExptComparisonResult__EvaluatorResults_DEFAULT
 	}
-	return p.SourceTargetIds
+	return p.EvaluatorResults
 }
-func (p *SourceTarget) SetEvalTargetType(val *eval_target.EvalTargetType) {
-	p.EvalTargetType = val
+func (p *ExptComparisonResult_) SetBaseExperimentID(val int64) {
+	p.BaseExperimentID = val
 }
-func (p *SourceTarget) SetSourceTargetIds(val []string) {
-	p.SourceTargetIds = val
+func (p *ExptComparisonResult_) SetCompareExperimentID(val int64) {
+	p.CompareExperimentID = val
 }
-
-var fieldIDToName_SourceTarget = map[int16]string{
-	1: "eval_target_type",
-	3: "source_target_ids",
+func (p *ExptComparisonResult_) SetEvaluatorResults(val []*EvaluatorComparisonResult_) {
+	p.EvaluatorResults = val
 }
 
-func (p *SourceTarget) IsSetEvalTargetType() bool {
-	return p.EvalTargetType != nil
+var fieldIDToName_ExptComparisonResult_ = map[int16]string{
+	1: "base_experiment_id",
+	2: "compare_experiment_id",
+	3: "evaluator_results",
 }
 
-func (p *SourceTarget) IsSetSourceTargetIds() bool {
-	return p.SourceTargetIds != nil
+func (p *ExptComparisonResult_) IsSetEvaluatorResults() bool {
+	return p.EvaluatorResults != nil
 }
 
-func (p *SourceTarget) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptComparisonResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetBaseExperimentID bool = false
+	var issetCompareExperimentID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -17346,10 +22851,20 @@ func (p *SourceTarget) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetBaseExperimentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCompareExperimentID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -17374,13 +22889,22 @@ func (p *SourceTarget) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetBaseExperimentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCompareExperimentID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SourceTarget[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptComparisonResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17388,33 +22912,45 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptComparisonResult_[fieldId]))
 }
 
-func (p *SourceTarget) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptComparisonResult_) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *eval_target.EvalTargetType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		tmp := eval_target.EvalTargetType(v)
-		_field = &tmp
+		_field = v
 	}
-	p.EvalTargetType = _field
+	p.BaseExperimentID = _field
 	return nil
 }
-func (p *SourceTarget) ReadField3(iprot thrift.TProtocol) error {
+func (p *ExptComparisonResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CompareExperimentID = _field
+	return nil
+}
+func (p *ExptComparisonResult_) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]string, 0, size)
+	_field := make([]*EvaluatorComparisonResult_, 0, size)
+	values := make([]EvaluatorComparisonResult_, size)
 	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
 			return err
-		} else {
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -17422,13 +22958,13 @@ func (p *SourceTarget) ReadField3(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.SourceTargetIds = _field
+	p.EvaluatorResults = _field
 	return nil
 }
 
-func (p *SourceTarget) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptComparisonResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SourceTarget"); err != nil {
+	if err = oprot.WriteStructBegin("ExptComparisonResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17436,6 +22972,10 @@ func (p *SourceTarget) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 		if err = p.writeField3(oprot); err != nil {
 			fieldId = 3
 			goto WriteFieldError
@@ -17458,17 +22998,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SourceTarget) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvalTargetType() {
-		if err = oprot.WriteFieldBegin("eval_target_type", thrift.I32, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(int32(*p.EvalTargetType)); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ExptComparisonResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_experiment_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.BaseExperimentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -17476,16 +23014,32 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *SourceTarget) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSourceTargetIds() {
-		if err = oprot.WriteFieldBegin("source_target_ids", thrift.LIST, 3); err != nil {
+func (p *ExptComparisonResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("compare_experiment_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CompareExperimentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptComparisonResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorResults() {
+		if err = oprot.WriteFieldBegin("evaluator_results", thrift.LIST, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.SourceTargetIds)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EvaluatorResults)); err != nil {
 			return err
 		}
-		for _, v := range p.SourceTargetIds {
-			if err := oprot.WriteString(v); err != nil {
+		for _, v := range p.EvaluatorResults {
+			if err := v.Write(oprot); err != nil {
 				return err
 			}
 		}
@@ -17503,213 +23057,250 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SourceTarget) String() string {
+func (p *ExptComparisonResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SourceTarget(%+v)", *p)
+	return fmt.Sprintf("ExptComparisonResult_(%+v)", *p)
 
 }
 
-func (p *SourceTarget) DeepEqual(ano *SourceTarget) bool {
+func (p *ExptComparisonResult_) DeepEqual(ano *ExptComparisonResult_) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvalTargetType) {
+	if !p.Field1DeepEqual(ano.BaseExperimentID) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.SourceTargetIds) {
+	if !p.Field2DeepEqual(ano.CompareExperimentID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluatorResults) {
 		return false
 	}
 	return true
 }
 
-func (p *SourceTarget) Field1DeepEqual(src *eval_target.EvalTargetType) bool {
+func (p *ExptComparisonResult_) Field1DeepEqual(src int64) bool {
 
-	if p.EvalTargetType == src {
-		return true
-	} else if p.EvalTargetType == nil || src == nil {
+	if p.BaseExperimentID != src {
 		return false
 	}
-	if *p.EvalTargetType != *src {
+	return true
+}
+func (p *ExptComparisonResult_) Field2DeepEqual(src int64) bool {
+
+	if p.CompareExperimentID != src {
 		return false
 	}
 	return true
 }
-func (p *SourceTarget) Field3DeepEqual(src []string) bool {
+func (p *ExptComparisonResult_) Field3DeepEqual(src []*EvaluatorComparisonResult_) bool {
 
-	if len(p.SourceTargetIds) != len(src) {
+	if len(p.EvaluatorResults) != len(src) {
 		return false
 	}
-	for i, v := range p.SourceTargetIds {
+	for i, v := range p.EvaluatorResults {
 		_src := src[i]
-		if strings.Compare(v, _src) != 0 {
+		if !v.DeepEqual(_src) {
 			return false
 		}
 	}
 	return true
 }
 
-// 实验粒度聚合结果
-type ExptAggregateResult_ struct {
-	ExperimentID     int64                                `thrift:"experiment_id,1,required" frugal:"1,required,i64" json:"experiment_id" form:"experiment_id,required" query:"experiment_id,required"`
-	EvaluatorResults map[int64]*EvaluatorAggregateResult_ `thrift:"evaluator_results,2,optional" frugal:"2,optional,map<i64:EvaluatorAggregateResult_>" json:"evaluator_results" form:"evaluator_results" query:"evaluator_results"`
-	Status           *ExptAggregateCalculateStatus        `thrift:"status,3,optional" frugal:"3,optional,ExptAggregateCalculateStatus" form:"status" json:"status,omitempty" query:"status"`
-	// tag_key_id -> result
-	AnnotationResults     map[int64]*AnnotationAggregateResult_ `thrift:"annotation_results,4,optional" frugal:"4,optional,map<i64:AnnotationAggregateResult_>" json:"annotation_results" form:"annotation_results" query:"annotation_results"`
-	EvalTargetAggrResult_ *EvalTargetAggregateResult_           `thrift:"eval_target_aggr_result,5,optional" frugal:"5,optional,EvalTargetAggregateResult_" form:"eval_target_aggr_result" json:"eval_target_aggr_result,omitempty" query:"eval_target_aggr_result"`
-	// timestamp in seconds
-	UpdateTime      *int64               `thrift:"update_time,6,optional" frugal:"6,optional,i64" form:"update_time" json:"update_time,omitempty" query:"update_time"`
-	WeightedResults []*AggregatorResult_ `thrift:"weighted_results,10,optional" frugal:"10,optional,list<AggregatorResult_>" json:"weighted_results" form:"weighted_results" query:"weighted_results"`
+type ExptGateRule struct {
+	RuleType *ExptGateRuleType `thrift:"rule_type,1,optional" frugal:"1,optional,string" form:"rule_type" json:"rule_type,omitempty" query:"rule_type"`
+	Name     *string           `thrift:"name,2,optional" frugal:"2,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	// EvaluatorScore
+	EvaluatorVersionID *int64 `thrift:"evaluator_version_id,3,optional" frugal:"3,optional,i64" json:"evaluator_version_id" form:"evaluator_version_id" query:"evaluator_version_id"`
+	// 默认 gte
+	Operator *ExptGateOperator `thrift:"operator,4,optional" frugal:"4,optional,string" form:"operator" json:"operator,omitempty" query:"operator"`
+	// EvaluatorScore 为得分阈值；FailedTurnRate 为未成功 turn 占比上限，取值 [0, 1]
+	Threshold *float64 `thrift:"threshold,5,optional" frugal:"5,optional,double" form:"threshold" json:"threshold,omitempty" query:"threshold"`
+	// NoRegression
+	BaselineExperimentID *int64 `thrift:"baseline_experiment_id,6,optional" frugal:"6,optional,i64" json:"baseline_experiment_id" form:"baseline_experiment_id" query:"baseline_experiment_id"`
+	// 为空时对比两个实验共同的全部评估器版本
+	EvaluatorVersionIds []int64 `thrift:"evaluator_version_ids,7,optional" frugal:"7,optional,list<i64>" json:"evaluator_version_ids" form:"evaluator_version_ids" query:"evaluator_version_ids"`
+	// 默认 0.95
+	ConfidenceLevel *float64 `thrift:"confidence_level,8,optional" frugal:"8,optional,double" form:"confidence_level" json:"confidence_level,omitempty" query:"confidence_level"`
 }
 
-func NewExptAggregateResult_() *ExptAggregateResult_ {
-	return &ExptAggregateResult_{}
+func NewExptGateRule() *ExptGateRule {
+	return &ExptGateRule{}
 }
 
-func (p *ExptAggregateResult_) InitDefault() {
+func (p *ExptGateRule) InitDefault() {
 }
 
-func (p *ExptAggregateResult_) GetExperimentID() (v int64) {
-	if p != nil {
-		return p.ExperimentID
+var ExptGateRule_RuleType_DEFAULT ExptGateRuleType
+
+func (p *ExptGateRule) GetRuleType() (v ExptGateRuleType) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetRuleType() {
+		return ExptGateRule_RuleType_DEFAULT
+	}
+	return *p.RuleType
 }
 
-var ExptAggregateResult__EvaluatorResults_DEFAULT map[int64]*EvaluatorAggregateResult_
+var ExptGateRule_Name_DEFAULT string
 
-func (p *ExptAggregateResult_) GetEvaluatorResults() (v map[int64]*EvaluatorAggregateResult_) {
+func (p *ExptGateRule) GetName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvaluatorResults() {
-		return ExptAggregateResult__EvaluatorResults_DEFAULT
+	if !p.IsSetName() {
+		return ExptGateRule_Name_DEFAULT
 	}
-	return p.EvaluatorResults
+	return *p.Name
 }
 
-var ExptAggregateResult__Status_DEFAULT ExptAggregateCalculateStatus
+var ExptGateRule_EvaluatorVersionID_DEFAULT int64
 
-func (p *ExptAggregateResult_) GetStatus() (v ExptAggregateCalculateStatus) {
+func (p *ExptGateRule) GetEvaluatorVersionID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetStatus() {
-		return ExptAggregateResult__Status_DEFAULT
+	if !p.IsSetEvaluatorVersionID() {
+		return ExptGateRule_EvaluatorVersionID_DEFAULT
 	}
-	return *p.Status
+	return *p.EvaluatorVersionID
 }
 
-var ExptAggregateResult__AnnotationResults_DEFAULT map[int64]*AnnotationAggregateResult_
+var ExptGateRule_Operator_DEFAULT ExptGateOperator
 
-func (p *ExptAggregateResult_) GetAnnotationResults() (v map[int64]*AnnotationAggregateResult_) {
+func (p *ExptGateRule) GetOperator() (v ExptGateOperator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAnnotationResults() {
-		return ExptAggregateResult__AnnotationResults_DEFAULT
+	if !p.IsSetOperator() {
+		return ExptGateRule_Operator_DEFAULT
 	}
-	return p.AnnotationResults
+	return *p.Operator
+}
+
+var ExptGateRule_Threshold_DEFAULT float64
+
+func (p *ExptGateRule) GetThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetThreshold() {
+		return ExptGateRule_Threshold_DEFAULT
+	}
+	return *p.Threshold
 }
 
-var ExptAggregateResult__EvalTargetAggrResult__DEFAULT *EvalTargetAggregateResult_
+var ExptGateRule_BaselineExperimentID_DEFAULT int64
 
-func (p *ExptAggregateResult_) GetEvalTargetAggrResult_() (v *EvalTargetAggregateResult_) {
+func (p *ExptGateRule) GetBaselineExperimentID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetEvalTargetAggrResult_() {
-		return ExptAggregateResult__EvalTargetAggrResult__DEFAULT
+	if !p.IsSetBaselineExperimentID() {
+		return ExptGateRule_BaselineExperimentID_DEFAULT
 	}
-	return p.EvalTargetAggrResult_
+	return *p.BaselineExperimentID
 }
 
-var ExptAggregateResult__UpdateTime_DEFAULT int64
+var ExptGateRule_EvaluatorVersionIds_DEFAULT []int64
 
-func (p *ExptAggregateResult_) GetUpdateTime() (v int64) {
+func (p *ExptGateRule) GetEvaluatorVersionIds() (v []int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetUpdateTime() {
-		return ExptAggregateResult__UpdateTime_DEFAULT
+	if !p.IsSetEvaluatorVersionIds() {
+		return ExptGateRule_EvaluatorVersionIds_DEFAULT
 	}
-	return *p.UpdateTime
+	return p.EvaluatorVersionIds
 }
 
-var ExptAggregateResult__WeightedResults_DEFAULT []*AggregatorResult_
+var ExptGateRule_ConfidenceLevel_DEFAULT float64
 
-func (p *ExptAggregateResult_) GetWeightedResults() (v []*AggregatorResult_) {
+func (p *ExptGateRule) GetConfidenceLevel() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetWeightedResults() {
-		return ExptAggregateResult__WeightedResults_DEFAULT
+	if !p.IsSetConfidenceLevel() {
+		return ExptGateRule_ConfidenceLevel_DEFAULT
 	}
-	return p.WeightedResults
+	return *p.ConfidenceLevel
 }
-func (p *ExptAggregateResult_) SetExperimentID(val int64) {
-	p.ExperimentID = val
+func (p *ExptGateRule) SetRuleType(val *ExptGateRuleType) {
+	p.RuleType = val
 }
-func (p *ExptAggregateResult_) SetEvaluatorResults(val map[int64]*EvaluatorAggregateResult_) {
-	p.EvaluatorResults = val
+func (p *ExptGateRule) SetName(val *string) {
+	p.Name = val
 }
-func (p *ExptAggregateResult_) SetStatus(val *ExptAggregateCalculateStatus) {
-	p.Status = val
+func (p *ExptGateRule) SetEvaluatorVersionID(val *int64) {
+	p.EvaluatorVersionID = val
 }
-func (p *ExptAggregateResult_) SetAnnotationResults(val map[int64]*AnnotationAggregateResult_) {
-	p.AnnotationResults = val
+func (p *ExptGateRule) SetOperator(val *ExptGateOperator) {
+	p.Operator = val
 }
-func (p *ExptAggregateResult_) SetEvalTargetAggrResult_(val *EvalTargetAggregateResult_) {
-	p.EvalTargetAggrResult_ = val
+func (p *ExptGateRule) SetThreshold(val *float64) {
+	p.Threshold = val
 }
-func (p *ExptAggregateResult_) SetUpdateTime(val *int64) {
-	p.UpdateTime = val
+func (p *ExptGateRule) SetBaselineExperimentID(val *int64) {
+	p.BaselineExperimentID = val
 }
-func (p *ExptAggregateResult_) SetWeightedResults(val []*AggregatorResult_) {
-	p.WeightedResults = val
+func (p *ExptGateRule) SetEvaluatorVersionIds(val []int64) {
+	p.EvaluatorVersionIds = val
+}
+func (p *ExptGateRule) SetConfidenceLevel(val *float64) {
+	p.ConfidenceLevel = val
 }
 
-var fieldIDToName_ExptAggregateResult_ = map[int16]string{
-	1:  "experiment_id",
-	2:  "evaluator_results",
-	3:  "status",
-	4:  "annotation_results",
-	5:  "eval_target_aggr_result",
-	6:  "update_time",
-	10: "weighted_results",
+var fieldIDToName_ExptGateRule = map[int16]string{
+	1: "rule_type",
+	2: "name",
+	3: "evaluator_version_id",
+	4: "operator",
+	5: "threshold",
+	6: "baseline_experiment_id",
+	7: "evaluator_version_ids",
+	8: "confidence_level",
 }
 
-func (p *ExptAggregateResult_) IsSetEvaluatorResults() bool {
-	return p.EvaluatorResults != nil
+func (p *ExptGateRule) IsSetRuleType() bool {
+	return p.RuleType != nil
 }
 
-func (p *ExptAggregateResult_) IsSetStatus() bool {
-	return p.Status != nil
+func (p *ExptGateRule) IsSetName() bool {
+	return p.Name != nil
 }
 
-func (p *ExptAggregateResult_) IsSetAnnotationResults() bool {
-	return p.AnnotationResults != nil
+func (p *ExptGateRule) IsSetEvaluatorVersionID() bool {
+	return p.EvaluatorVersionID != nil
 }
 
-func (p *ExptAggregateResult_) IsSetEvalTargetAggrResult_() bool {
-	return p.EvalTargetAggrResult_ != nil
+func (p *ExptGateRule) IsSetOperator() bool {
+	return p.Operator != nil
 }
 
-func (p *ExptAggregateResult_) IsSetUpdateTime() bool {
-	return p.UpdateTime != nil
+func (p *ExptGateRule) IsSetThreshold() bool {
+	return p.Threshold != nil
 }
 
-func (p *ExptAggregateResult_) IsSetWeightedResults() bool {
-	return p.WeightedResults != nil
+func (p *ExptGateRule) IsSetBaselineExperimentID() bool {
+	return p.BaselineExperimentID != nil
 }
 
-func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptGateRule) IsSetEvaluatorVersionIds() bool {
+	return p.EvaluatorVersionIds != nil
+}
+
+func (p *ExptGateRule) IsSetConfidenceLevel() bool {
+	return p.ConfidenceLevel != nil
+}
+
+func (p *ExptGateRule) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExperimentID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -17726,16 +23317,15 @@ func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetExperimentID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -17743,7 +23333,7 @@ func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -17751,7 +23341,7 @@ func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -17759,7 +23349,7 @@ func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -17774,9 +23364,17 @@ func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 10:
+		case 7:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField10(iprot); err != nil {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -17795,17 +23393,13 @@ func (p *ExptAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetExperimentID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptAggregateResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGateRule[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -17813,100 +23407,64 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExptAggregateResult_[fieldId]))
 }
 
-func (p *ExptAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptGateRule) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *ExptGateRuleType
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ExperimentID = _field
+	p.RuleType = _field
 	return nil
 }
-func (p *ExptAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[int64]*EvaluatorAggregateResult_, size)
-	values := make([]EvaluatorAggregateResult_, size)
-	for i := 0; i < size; i++ {
-		var _key int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if err := _val.Read(iprot); err != nil {
-			return err
-		}
+func (p *ExptGateRule) ReadField2(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvaluatorResults = _field
+	p.Name = _field
 	return nil
 }
-func (p *ExptAggregateResult_) ReadField3(iprot thrift.TProtocol) error {
+func (p *ExptGateRule) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *ExptAggregateCalculateStatus
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		tmp := ExptAggregateCalculateStatus(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.Status = _field
+	p.EvaluatorVersionID = _field
 	return nil
 }
-func (p *ExptAggregateResult_) ReadField4(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[int64]*AnnotationAggregateResult_, size)
-	values := make([]AnnotationAggregateResult_, size)
-	for i := 0; i < size; i++ {
-		var _key int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if err := _val.Read(iprot); err != nil {
-			return err
-		}
+func (p *ExptGateRule) ReadField4(iprot thrift.TProtocol) error {
 
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	var _field *ExptGateOperator
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.AnnotationResults = _field
+	p.Operator = _field
 	return nil
 }
-func (p *ExptAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
-	_field := NewEvalTargetAggregateResult_()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExptGateRule) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.EvalTargetAggrResult_ = _field
+	p.Threshold = _field
 	return nil
 }
-func (p *ExptAggregateResult_) ReadField6(iprot thrift.TProtocol) error {
+func (p *ExptGateRule) ReadField6(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -17914,22 +23472,22 @@ func (p *ExptAggregateResult_) ReadField6(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.UpdateTime = _field
+	p.BaselineExperimentID = _field
 	return nil
 }
-func (p *ExptAggregateResult_) ReadField10(iprot thrift.TProtocol) error {
+func (p *ExptGateRule) ReadField7(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
+	_field := make([]int64, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
 
-		if err := _elem.Read(iprot); err != nil {
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
 			return err
+		} else {
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -17937,13 +23495,24 @@ func (p *ExptAggregateResult_) ReadField10(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.WeightedResults = _field
+	p.EvaluatorVersionIds = _field
 	return nil
 }
+func (p *ExptGateRule) ReadField8(iprot thrift.TProtocol) error {
 
-func (p *ExptAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ConfidenceLevel = _field
+	return nil
+}
+
+func (p *ExptGateRule) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExptAggregateResult"); err != nil {
+	if err = oprot.WriteStructBegin("ExptGateRule"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -17971,8 +23540,12 @@ func (p *ExptAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
 			goto WriteFieldError
 		}
 	}
@@ -17993,39 +23566,84 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExptAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("experiment_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
+func (p *ExptGateRule) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleType() {
+		if err = oprot.WriteFieldBegin("rule_type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RuleType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err := oprot.WriteI64(p.ExperimentID); err != nil {
-		return err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExptGateRule) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExptGateRule) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionID() {
+		if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EvaluatorVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExptAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvaluatorResults() {
-		if err = oprot.WriteFieldBegin("evaluator_results", thrift.MAP, 2); err != nil {
+func (p *ExptGateRule) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOperator() {
+		if err = oprot.WriteFieldBegin("operator", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.I64, thrift.STRUCT, len(p.EvaluatorResults)); err != nil {
+		if err := oprot.WriteString(*p.Operator); err != nil {
 			return err
 		}
-		for k, v := range p.EvaluatorResults {
-			if err := oprot.WriteI64(k); err != nil {
-				return err
-			}
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExptGateRule) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetThreshold() {
+		if err = oprot.WriteFieldBegin("threshold", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Threshold); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -18034,16 +23652,16 @@ func (p *ExptAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *ExptAggregateResult_) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.I32, 3); err != nil {
+func (p *ExptGateRule) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaselineExperimentID() {
+		if err = oprot.WriteFieldBegin("baseline_experiment_id", thrift.I64, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.Status)); err != nil {
+		if err := oprot.WriteI64(*p.BaselineExperimentID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -18052,27 +23670,24 @@ func (p *ExptAggregateResult_) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *ExptAggregateResult_) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAnnotationResults() {
-		if err = oprot.WriteFieldBegin("annotation_results", thrift.MAP, 4); err != nil {
+func (p *ExptGateRule) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionIds() {
+		if err = oprot.WriteFieldBegin("evaluator_version_ids", thrift.LIST, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.I64, thrift.STRUCT, len(p.AnnotationResults)); err != nil {
+		if err := oprot.WriteListBegin(thrift.I64, len(p.EvaluatorVersionIds)); err != nil {
 			return err
 		}
-		for k, v := range p.AnnotationResults {
-			if err := oprot.WriteI64(k); err != nil {
-				return err
-			}
-			if err := v.Write(oprot); err != nil {
+		for _, v := range p.EvaluatorVersionIds {
+			if err := oprot.WriteI64(v); err != nil {
 				return err
 			}
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -18081,16 +23696,16 @@ func (p *ExptAggregateResult_) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *ExptAggregateResult_) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEvalTargetAggrResult_() {
-		if err = oprot.WriteFieldBegin("eval_target_aggr_result", thrift.STRUCT, 5); err != nil {
+func (p *ExptGateRule) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfidenceLevel() {
+		if err = oprot.WriteFieldBegin("confidence_level", thrift.DOUBLE, 8); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.EvalTargetAggrResult_.Write(oprot); err != nil {
+		if err := oprot.WriteDouble(*p.ConfidenceLevel); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -18099,37 +23714,301 @@ func (p *ExptAggregateResult_) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
-func (p *ExptAggregateResult_) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUpdateTime() {
-		if err = oprot.WriteFieldBegin("update_time", thrift.I64, 6); err != nil {
-			goto WriteFieldBeginError
+
+func (p *ExptGateRule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExptGateRule(%+v)", *p)
+
+}
+
+func (p *ExptGateRule) DeepEqual(ano *ExptGateRule) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.RuleType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Operator) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Threshold) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.BaselineExperimentID) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.EvaluatorVersionIds) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ConfidenceLevel) {
+		return false
+	}
+	return true
+}
+
+func (p *ExptGateRule) Field1DeepEqual(src *ExptGateRuleType) bool {
+
+	if p.RuleType == src {
+		return true
+	} else if p.RuleType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.RuleType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptGateRule) Field2DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptGateRule) Field3DeepEqual(src *int64) bool {
+
+	if p.EvaluatorVersionID == src {
+		return true
+	} else if p.EvaluatorVersionID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptGateRule) Field4DeepEqual(src *ExptGateOperator) bool {
+
+	if p.Operator == src {
+		return true
+	} else if p.Operator == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Operator, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExptGateRule) Field5DeepEqual(src *float64) bool {
+
+	if p.Threshold == src {
+		return true
+	} else if p.Threshold == nil || src == nil {
+		return false
+	}
+	if *p.Threshold != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptGateRule) Field6DeepEqual(src *int64) bool {
+
+	if p.BaselineExperimentID == src {
+		return true
+	} else if p.BaselineExperimentID == nil || src == nil {
+		return false
+	}
+	if *p.BaselineExperimentID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExptGateRule) Field7DeepEqual(src []int64) bool {
+
+	if len(p.EvaluatorVersionIds) != len(src) {
+		return false
+	}
+	for i, v := range p.EvaluatorVersionIds {
+		_src := src[i]
+		if v != _src {
+			return false
 		}
-		if err := oprot.WriteI64(*p.UpdateTime); err != nil {
+	}
+	return true
+}
+func (p *ExptGateRule) Field8DeepEqual(src *float64) bool {
+
+	if p.ConfidenceLevel == src {
+		return true
+	} else if p.ConfidenceLevel == nil || src == nil {
+		return false
+	}
+	if *p.ConfidenceLevel != *src {
+		return false
+	}
+	return true
+}
+
+// 实验门禁配置，实验完成时逐条规则计算结论
+type ExptGateConfig struct {
+	Rules []*ExptGateRule `thrift:"rules,1,optional" frugal:"1,optional,list<ExptGateRule>" form:"rules" json:"rules,omitempty" query:"rules"`
+}
+
+func NewExptGateConfig() *ExptGateConfig {
+	return &ExptGateConfig{}
+}
+
+func (p *ExptGateConfig) InitDefault() {
+}
+
+var ExptGateConfig_Rules_DEFAULT []*ExptGateRule
+
+func (p *ExptGateConfig) GetRules() (v []*ExptGateRule) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRules() {
+		return ExptGateConfig_Rules_DEFAULT
+	}
+	return p.Rules
+}
+func (p *ExptGateConfig) SetRules(val []*ExptGateRule) {
+	p.Rules = val
+}
+
+var fieldIDToName_ExptGateConfig = map[int16]string{
+	1: "rules",
+}
+
+func (p *ExptGateConfig) IsSetRules() bool {
+	return p.Rules != nil
+}
+
+func (p *ExptGateConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGateConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExptGateConfig) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExptGateRule, 0, size)
+	values := make([]ExptGateRule, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Rules = _field
+	return nil
+}
+
+func (p *ExptGateConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExptGateConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
 	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *ExptAggregateResult_) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetWeightedResults() {
-		if err = oprot.WriteFieldBegin("weighted_results", thrift.LIST, 10); err != nil {
+
+func (p *ExptGateConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRules() {
+		if err = oprot.WriteFieldBegin("rules", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.WeightedResults)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Rules)); err != nil {
 			return err
 		}
-		for _, v := range p.WeightedResults {
+		for _, v := range p.Rules {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -18143,119 +24022,37 @@ func (p *ExptAggregateResult_) writeField10(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExptAggregateResult_) String() string {
+func (p *ExptGateConfig) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExptAggregateResult_(%+v)", *p)
+	return fmt.Sprintf("ExptGateConfig(%+v)", *p)
 
 }
 
-func (p *ExptAggregateResult_) DeepEqual(ano *ExptAggregateResult_) bool {
+func (p *ExptGateConfig) DeepEqual(ano *ExptGateConfig) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ExperimentID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.EvaluatorResults) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.Status) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.AnnotationResults) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.EvalTargetAggrResult_) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.UpdateTime) {
-		return false
-	}
-	if !p.Field10DeepEqual(ano.WeightedResults) {
-		return false
-	}
-	return true
-}
-
-func (p *ExptAggregateResult_) Field1DeepEqual(src int64) bool {
-
-	if p.ExperimentID != src {
-		return false
-	}
-	return true
-}
-func (p *ExptAggregateResult_) Field2DeepEqual(src map[int64]*EvaluatorAggregateResult_) bool {
-
-	if len(p.EvaluatorResults) != len(src) {
-		return false
-	}
-	for k, v := range p.EvaluatorResults {
-		_src := src[k]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-func (p *ExptAggregateResult_) Field3DeepEqual(src *ExptAggregateCalculateStatus) bool {
-
-	if p.Status == src {
-		return true
-	} else if p.Status == nil || src == nil {
-		return false
-	}
-	if *p.Status != *src {
-		return false
-	}
-	return true
-}
-func (p *ExptAggregateResult_) Field4DeepEqual(src map[int64]*AnnotationAggregateResult_) bool {
-
-	if len(p.AnnotationResults) != len(src) {
-		return false
-	}
-	for k, v := range p.AnnotationResults {
-		_src := src[k]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-func (p *ExptAggregateResult_) Field5DeepEqual(src *EvalTargetAggregateResult_) bool {
-
-	if !p.EvalTargetAggrResult_.DeepEqual(src) {
+	if !p.Field1DeepEqual(ano.Rules) {
 		return false
 	}
 	return true
 }
-func (p *ExptAggregateResult_) Field6DeepEqual(src *int64) bool {
 
-	if p.UpdateTime == src {
-		return true
-	} else if p.UpdateTime == nil || src == nil {
-		return false
-	}
-	if *p.UpdateTime != *src {
-		return false
-	}
-	return true
-}
-func (p *ExptAggregateResult_) Field10DeepEqual(src []*AggregatorResult_) bool {
+func (p *ExptGateConfig) Field1DeepEqual(src []*ExptGateRule) bool {
 
-	if len(p.WeightedResults) != len(src) {
+	if len(p.Rules) != len(src) {
 		return false
 	}
-	for i, v := range p.WeightedResults {
+	for i, v := range p.Rules {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -18264,146 +24061,105 @@ func (p *ExptAggregateResult_) Field10DeepEqual(src []*AggregatorResult_) bool {
 	return true
 }
 
-type EvalTargetAggregateResult_ struct {
-	TargetID        *int64               `thrift:"target_id,1,optional" frugal:"1,optional,i64" json:"target_id" form:"target_id" query:"target_id"`
-	TargetVersionID *int64               `thrift:"target_version_id,2,optional" frugal:"2,optional,i64" json:"target_version_id" form:"target_version_id" query:"target_version_id"`
-	Latency         []*AggregatorResult_ `thrift:"latency,5,optional" frugal:"5,optional,list<AggregatorResult_>" form:"latency" json:"latency,omitempty" query:"latency"`
-	InputTokens     []*AggregatorResult_ `thrift:"input_tokens,6,optional" frugal:"6,optional,list<AggregatorResult_>" form:"input_tokens" json:"input_tokens,omitempty" query:"input_tokens"`
-	OutputTokens    []*AggregatorResult_ `thrift:"output_tokens,7,optional" frugal:"7,optional,list<AggregatorResult_>" form:"output_tokens" json:"output_tokens,omitempty" query:"output_tokens"`
-	TotalTokens     []*AggregatorResult_ `thrift:"total_tokens,8,optional" frugal:"8,optional,list<AggregatorResult_>" form:"total_tokens" json:"total_tokens,omitempty" query:"total_tokens"`
-}
-
-func NewEvalTargetAggregateResult_() *EvalTargetAggregateResult_ {
-	return &EvalTargetAggregateResult_{}
-}
-
-func (p *EvalTargetAggregateResult_) InitDefault() {
+type ExptGateRuleVerdict struct {
+	Rule   *ExptGateRule   `thrift:"rule,1,optional" frugal:"1,optional,ExptGateRule" form:"rule" json:"rule,omitempty" query:"rule"`
+	Status *ExptGateStatus `thrift:"status,2,optional" frugal:"2,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	// EvaluatorScore 为平均分；FailedTurnRate 为未成功占比；NoRegression 为显著退化的评估器数
+	ActualValue *float64 `thrift:"actual_value,3,optional" frugal:"3,optional,double" form:"actual_value" json:"actual_value,omitempty" query:"actual_value"`
+	Message     *string  `thrift:"message,4,optional" frugal:"4,optional,string" form:"message" json:"message,omitempty" query:"message"`
 }
 
-var EvalTargetAggregateResult__TargetID_DEFAULT int64
-
-func (p *EvalTargetAggregateResult_) GetTargetID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTargetID() {
-		return EvalTargetAggregateResult__TargetID_DEFAULT
-	}
-	return *p.TargetID
+func NewExptGateRuleVerdict() *ExptGateRuleVerdict {
+	return &ExptGateRuleVerdict{}
 }
 
-var EvalTargetAggregateResult__TargetVersionID_DEFAULT int64
-
-func (p *EvalTargetAggregateResult_) GetTargetVersionID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTargetVersionID() {
-		return EvalTargetAggregateResult__TargetVersionID_DEFAULT
-	}
-	return *p.TargetVersionID
+func (p *ExptGateRuleVerdict) InitDefault() {
 }
 
-var EvalTargetAggregateResult__Latency_DEFAULT []*AggregatorResult_
+var ExptGateRuleVerdict_Rule_DEFAULT *ExptGateRule
 
-func (p *EvalTargetAggregateResult_) GetLatency() (v []*AggregatorResult_) {
+func (p *ExptGateRuleVerdict) GetRule() (v *ExptGateRule) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetLatency() {
-		return EvalTargetAggregateResult__Latency_DEFAULT
+	if !p.IsSetRule() {
+		return ExptGateRuleVerdict_Rule_DEFAULT
 	}
-	return p.Latency
+	return p.Rule
 }
 
-var EvalTargetAggregateResult__InputTokens_DEFAULT []*AggregatorResult_
+var ExptGateRuleVerdict_Status_DEFAULT ExptGateStatus
 
-func (p *EvalTargetAggregateResult_) GetInputTokens() (v []*AggregatorResult_) {
+func (p *ExptGateRuleVerdict) GetStatus() (v ExptGateStatus) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetInputTokens() {
-		return EvalTargetAggregateResult__InputTokens_DEFAULT
+	if !p.IsSetStatus() {
+		return ExptGateRuleVerdict_Status_DEFAULT
 	}
-	return p.InputTokens
+	return *p.Status
 }
 
-var EvalTargetAggregateResult__OutputTokens_DEFAULT []*AggregatorResult_
+var ExptGateRuleVerdict_ActualValue_DEFAULT float64
 
-func (p *EvalTargetAggregateResult_) GetOutputTokens() (v []*AggregatorResult_) {
+func (p *ExptGateRuleVerdict) GetActualValue() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetOutputTokens() {
-		return EvalTargetAggregateResult__OutputTokens_DEFAULT
+	if !p.IsSetActualValue() {
+		return ExptGateRuleVerdict_ActualValue_DEFAULT
 	}
-	return p.OutputTokens
+	return *p.ActualValue
 }
 
-var EvalTargetAggregateResult__TotalTokens_DEFAULT []*AggregatorResult_
+var ExptGateRuleVerdict_Message_DEFAULT string
 
-func (p *EvalTargetAggregateResult_) GetTotalTokens() (v []*AggregatorResult_) {
+func (p *ExptGateRuleVerdict) GetMessage() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTotalTokens() {
-		return EvalTargetAggregateResult__TotalTokens_DEFAULT
+	if !p.IsSetMessage() {
+		return ExptGateRuleVerdict_Message_DEFAULT
 	}
-	return p.TotalTokens
-}
-func (p *EvalTargetAggregateResult_) SetTargetID(val *int64) {
-	p.TargetID = val
-}
-func (p *EvalTargetAggregateResult_) SetTargetVersionID(val *int64) {
-	p.TargetVersionID = val
-}
-func (p *EvalTargetAggregateResult_) SetLatency(val []*AggregatorResult_) {
-	p.Latency = val
-}
-func (p *EvalTargetAggregateResult_) SetInputTokens(val []*AggregatorResult_) {
-	p.InputTokens = val
+	return *p.Message
 }
-func (p *EvalTargetAggregateResult_) SetOutputTokens(val []*AggregatorResult_) {
-	p.OutputTokens = val
+func (p *ExptGateRuleVerdict) SetRule(val *ExptGateRule) {
+	p.Rule = val
 }
-func (p *EvalTargetAggregateResult_) SetTotalTokens(val []*AggregatorResult_) {
-	p.TotalTokens = val
+func (p *ExptGateRuleVerdict) SetStatus(val *ExptGateStatus) {
+	p.Status = val
 }
-
-var fieldIDToName_EvalTargetAggregateResult_ = map[int16]string{
-	1: "target_id",
-	2: "target_version_id",
-	5: "latency",
-	6: "input_tokens",
-	7: "output_tokens",
-	8: "total_tokens",
+func (p *ExptGateRuleVerdict) SetActualValue(val *float64) {
+	p.ActualValue = val
 }
-
-func (p *EvalTargetAggregateResult_) IsSetTargetID() bool {
-	return p.TargetID != nil
+func (p *ExptGateRuleVerdict) SetMessage(val *string) {
+	p.Message = val
 }
 
-func (p *EvalTargetAggregateResult_) IsSetTargetVersionID() bool {
-	return p.TargetVersionID != nil
+var fieldIDToName_ExptGateRuleVerdict = map[int16]string{
+	1: "rule",
+	2: "status",
+	3: "actual_value",
+	4: "message",
 }
 
-func (p *EvalTargetAggregateResult_) IsSetLatency() bool {
-	return p.Latency != nil
+func (p *ExptGateRuleVerdict) IsSetRule() bool {
+	return p.Rule != nil
 }
 
-func (p *EvalTargetAggregateResult_) IsSetInputTokens() bool {
-	return p.InputTokens != nil
+func (p *ExptGateRuleVerdict) IsSetStatus() bool {
+	return p.Status != nil
 }
 
-func (p *EvalTargetAggregateResult_) IsSetOutputTokens() bool {
-	return p.OutputTokens != nil
+func (p *ExptGateRuleVerdict) IsSetActualValue() bool {
+	return p.ActualValue != nil
 }
 
-func (p *EvalTargetAggregateResult_) IsSetTotalTokens() bool {
-	return p.TotalTokens != nil
+func (p *ExptGateRuleVerdict) IsSetMessage() bool {
+	return p.Message != nil
 }
 
-func (p *EvalTargetAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptGateRuleVerdict) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -18416,54 +24172,38 @@ func (p *EvalTargetAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 		if err != nil {
 			goto ReadFieldBeginError
 		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField5(iprot); err != nil {
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 6:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField6(iprot); err != nil {
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 7:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField7(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 8:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField8(iprot); err != nil {
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -18488,7 +24228,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvalTargetAggregateResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGateRuleVerdict[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -18498,124 +24238,51 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvalTargetAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *ExptGateRuleVerdict) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExptGateRule()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.TargetID = _field
+	p.Rule = _field
 	return nil
 }
-func (p *EvalTargetAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptGateRuleVerdict) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *ExptGateStatus
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.TargetVersionID = _field
-	return nil
-}
-func (p *EvalTargetAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Latency = _field
-	return nil
-}
-func (p *EvalTargetAggregateResult_) ReadField6(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.InputTokens = _field
+	p.Status = _field
 	return nil
 }
-func (p *EvalTargetAggregateResult_) ReadField7(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *ExptGateRuleVerdict) ReadField3(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.OutputTokens = _field
+	p.ActualValue = _field
 	return nil
 }
-func (p *EvalTargetAggregateResult_) ReadField8(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *ExptGateRuleVerdict) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.TotalTokens = _field
+	p.Message = _field
 	return nil
 }
 
-func (p *EvalTargetAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptGateRuleVerdict) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvalTargetAggregateResult"); err != nil {
+	if err = oprot.WriteStructBegin("ExptGateRuleVerdict"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -18627,20 +24294,12 @@ func (p *EvalTargetAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
 			goto WriteFieldError
 		}
 	}
@@ -18661,12 +24320,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvalTargetAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetID() {
-		if err = oprot.WriteFieldBegin("target_id", thrift.I64, 1); err != nil {
+func (p *ExptGateRuleVerdict) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRule() {
+		if err = oprot.WriteFieldBegin("rule", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TargetID); err != nil {
+		if err := p.Rule.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -18679,12 +24338,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvalTargetAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetVersionID() {
-		if err = oprot.WriteFieldBegin("target_version_id", thrift.I64, 2); err != nil {
+func (p *ExptGateRuleVerdict) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TargetVersionID); err != nil {
+		if err := oprot.WriteString(*p.Status); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -18697,72 +24356,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvalTargetAggregateResult_) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLatency() {
-		if err = oprot.WriteFieldBegin("latency", thrift.LIST, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Latency)); err != nil {
-			return err
-		}
-		for _, v := range p.Latency {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *EvalTargetAggregateResult_) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetInputTokens() {
-		if err = oprot.WriteFieldBegin("input_tokens", thrift.LIST, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InputTokens)); err != nil {
-			return err
-		}
-		for _, v := range p.InputTokens {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-func (p *EvalTargetAggregateResult_) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOutputTokens() {
-		if err = oprot.WriteFieldBegin("output_tokens", thrift.LIST, 7); err != nil {
+func (p *ExptGateRuleVerdict) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualValue() {
+		if err = oprot.WriteFieldBegin("actual_value", thrift.DOUBLE, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OutputTokens)); err != nil {
-			return err
-		}
-		for _, v := range p.OutputTokens {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteDouble(*p.ActualValue); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -18771,24 +24370,16 @@ func (p *EvalTargetAggregateResult_) writeField7(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvalTargetAggregateResult_) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTotalTokens() {
-		if err = oprot.WriteFieldBegin("total_tokens", thrift.LIST, 8); err != nil {
+func (p *ExptGateRuleVerdict) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TotalTokens)); err != nil {
-			return err
-		}
-		for _, v := range p.TotalTokens {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.Message); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -18797,216 +24388,165 @@ func (p *EvalTargetAggregateResult_) writeField8(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *EvalTargetAggregateResult_) String() string {
+func (p *ExptGateRuleVerdict) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvalTargetAggregateResult_(%+v)", *p)
+	return fmt.Sprintf("ExptGateRuleVerdict(%+v)", *p)
 
 }
 
-func (p *EvalTargetAggregateResult_) DeepEqual(ano *EvalTargetAggregateResult_) bool {
+func (p *ExptGateRuleVerdict) DeepEqual(ano *ExptGateRuleVerdict) bool {
 	if p == ano {
 		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.TargetID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.TargetVersionID) {
+	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.Latency) {
+	if !p.Field1DeepEqual(ano.Rule) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.InputTokens) {
+	if !p.Field2DeepEqual(ano.Status) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.OutputTokens) {
+	if !p.Field3DeepEqual(ano.ActualValue) {
 		return false
 	}
-	if !p.Field8DeepEqual(ano.TotalTokens) {
+	if !p.Field4DeepEqual(ano.Message) {
 		return false
 	}
 	return true
 }
 
-func (p *EvalTargetAggregateResult_) Field1DeepEqual(src *int64) bool {
+func (p *ExptGateRuleVerdict) Field1DeepEqual(src *ExptGateRule) bool {
 
-	if p.TargetID == src {
-		return true
-	} else if p.TargetID == nil || src == nil {
-		return false
-	}
-	if *p.TargetID != *src {
+	if !p.Rule.DeepEqual(src) {
 		return false
 	}
 	return true
 }
-func (p *EvalTargetAggregateResult_) Field2DeepEqual(src *int64) bool {
+func (p *ExptGateRuleVerdict) Field2DeepEqual(src *ExptGateStatus) bool {
 
-	if p.TargetVersionID == src {
+	if p.Status == src {
 		return true
-	} else if p.TargetVersionID == nil || src == nil {
+	} else if p.Status == nil || src == nil {
 		return false
 	}
-	if *p.TargetVersionID != *src {
+	if strings.Compare(*p.Status, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvalTargetAggregateResult_) Field5DeepEqual(src []*AggregatorResult_) bool {
+func (p *ExptGateRuleVerdict) Field3DeepEqual(src *float64) bool {
 
-	if len(p.Latency) != len(src) {
+	if p.ActualValue == src {
+		return true
+	} else if p.ActualValue == nil || src == nil {
 		return false
 	}
-	for i, v := range p.Latency {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-func (p *EvalTargetAggregateResult_) Field6DeepEqual(src []*AggregatorResult_) bool {
-
-	if len(p.InputTokens) != len(src) {
+	if *p.ActualValue != *src {
 		return false
 	}
-	for i, v := range p.InputTokens {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
-func (p *EvalTargetAggregateResult_) Field7DeepEqual(src []*AggregatorResult_) bool {
+func (p *ExptGateRuleVerdict) Field4DeepEqual(src *string) bool {
 
-	if len(p.OutputTokens) != len(src) {
+	if p.Message == src {
+		return true
+	} else if p.Message == nil || src == nil {
 		return false
 	}
-	for i, v := range p.OutputTokens {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-func (p *EvalTargetAggregateResult_) Field8DeepEqual(src []*AggregatorResult_) bool {
-
-	if len(p.TotalTokens) != len(src) {
+	if strings.Compare(*p.Message, *src) != 0 {
 		return false
 	}
-	for i, v := range p.TotalTokens {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
 
-// 评估器版本粒度聚合结果
-type EvaluatorAggregateResult_ struct {
-	EvaluatorVersionID int64                `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
-	AggregatorResults  []*AggregatorResult_ `thrift:"aggregator_results,2,optional" frugal:"2,optional,list<AggregatorResult_>" form:"aggregator_results" json:"aggregator_results,omitempty" query:"aggregator_results"`
-	Name               *string              `thrift:"name,3,optional" frugal:"3,optional,string" form:"name" json:"name,omitempty" query:"name"`
-	Version            *string              `thrift:"version,4,optional" frugal:"4,optional,string" form:"version" json:"version,omitempty" query:"version"`
-}
-
-func NewEvaluatorAggregateResult_() *EvaluatorAggregateResult_ {
-	return &EvaluatorAggregateResult_{}
+type ExptGateVerdict struct {
+	// 任一规则 Fail 则为 Fail；无 Fail 且存在 Error 时为 Error
+	Status       *ExptGateStatus        `thrift:"status,1,optional" frugal:"1,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	RuleVerdicts []*ExptGateRuleVerdict `thrift:"rule_verdicts,2,optional" frugal:"2,optional,list<ExptGateRuleVerdict>" form:"rule_verdicts" json:"rule_verdicts,omitempty" query:"rule_verdicts"`
+	// 毫秒时间戳
+	EvaluatedAt *int64 `thrift:"evaluated_at,3,optional" frugal:"3,optional,i64" json:"evaluated_at" form:"evaluated_at" query:"evaluated_at"`
 }
 
-func (p *EvaluatorAggregateResult_) InitDefault() {
+func NewExptGateVerdict() *ExptGateVerdict {
+	return &ExptGateVerdict{}
 }
 
-func (p *EvaluatorAggregateResult_) GetEvaluatorVersionID() (v int64) {
-	if p != nil {
-		return p.EvaluatorVersionID
-	}
-	return
+func (p *ExptGateVerdict) InitDefault() {
 }
 
-var EvaluatorAggregateResult__AggregatorResults_DEFAULT []*AggregatorResult_
+var ExptGateVerdict_Status_DEFAULT ExptGateStatus
 
-func (p *EvaluatorAggregateResult_) GetAggregatorResults() (v []*AggregatorResult_) {
+func (p *ExptGateVerdict) GetStatus() (v ExptGateStatus) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAggregatorResults() {
-		return EvaluatorAggregateResult__AggregatorResults_DEFAULT
+	if !p.IsSetStatus() {
+		return ExptGateVerdict_Status_DEFAULT
 	}
-	return p.AggregatorResults
+	return *p.Status
 }
 
-var EvaluatorAggregateResult__Name_DEFAULT string
+var ExptGateVerdict_RuleVerdicts_DEFAULT []*ExptGateRuleVerdict
 
-func (p *EvaluatorAggregateResult_) GetName() (v string) {
+func (p *ExptGateVerdict) GetRuleVerdicts() (v []*ExptGateRuleVerdict) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetName() {
-		return EvaluatorAggregateResult__Name_DEFAULT
+	if !p.IsSetRuleVerdicts() {
+		return ExptGateVerdict_RuleVerdicts_DEFAULT
 	}
-	return *p.Name
+	return p.RuleVerdicts
 }
 
-var EvaluatorAggregateResult__Version_DEFAULT string
+var ExptGateVerdict_EvaluatedAt_DEFAULT int64
 
-func (p *EvaluatorAggregateResult_) GetVersion() (v string) {
+func (p *ExptGateVerdict) GetEvaluatedAt() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetVersion() {
-		return EvaluatorAggregateResult__Version_DEFAULT
+	if !p.IsSetEvaluatedAt() {
+		return ExptGateVerdict_EvaluatedAt_DEFAULT
 	}
-	return *p.Version
+	return *p.EvaluatedAt
 }
-func (p *EvaluatorAggregateResult_) SetEvaluatorVersionID(val int64) {
-	p.EvaluatorVersionID = val
-}
-func (p *EvaluatorAggregateResult_) SetAggregatorResults(val []*AggregatorResult_) {
-	p.AggregatorResults = val
+func (p *ExptGateVerdict) SetStatus(val *ExptGateStatus) {
+	p.Status = val
 }
-func (p *EvaluatorAggregateResult_) SetName(val *string) {
-	p.Name = val
+func (p *ExptGateVerdict) SetRuleVerdicts(val []*ExptGateRuleVerdict) {
+	p.RuleVerdicts = val
 }
-func (p *EvaluatorAggregateResult_) SetVersion(val *string) {
-	p.Version = val
+func (p *ExptGateVerdict) SetEvaluatedAt(val *int64) {
+	p.EvaluatedAt = val
 }
 
-var fieldIDToName_EvaluatorAggregateResult_ = map[int16]string{
-	1: "evaluator_version_id",
-	2: "aggregator_results",
-	3: "name",
-	4: "version",
+var fieldIDToName_ExptGateVerdict = map[int16]string{
+	1: "status",
+	2: "rule_verdicts",
+	3: "evaluated_at",
 }
 
-func (p *EvaluatorAggregateResult_) IsSetAggregatorResults() bool {
-	return p.AggregatorResults != nil
+func (p *ExptGateVerdict) IsSetStatus() bool {
+	return p.Status != nil
 }
 
-func (p *EvaluatorAggregateResult_) IsSetName() bool {
-	return p.Name != nil
+func (p *ExptGateVerdict) IsSetRuleVerdicts() bool {
+	return p.RuleVerdicts != nil
 }
 
-func (p *EvaluatorAggregateResult_) IsSetVersion() bool {
-	return p.Version != nil
+func (p *ExptGateVerdict) IsSetEvaluatedAt() bool {
+	return p.EvaluatedAt != nil
 }
 
-func (p *EvaluatorAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExptGateVerdict) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetEvaluatorVersionID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -19023,11 +24563,10 @@ func (p *EvaluatorAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -19040,21 +24579,13 @@ func (p *EvaluatorAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -19068,17 +24599,13 @@ func (p *EvaluatorAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetEvaluatorVersionID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorAggregateResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGateVerdict[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -19086,28 +24613,26 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_EvaluatorAggregateResult_[fieldId]))
 }
 
-func (p *EvaluatorAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExptGateVerdict) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *ExptGateStatus
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.EvaluatorVersionID = _field
+	p.Status = _field
 	return nil
 }
-func (p *EvaluatorAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExptGateVerdict) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
+	_field := make([]*ExptGateRuleVerdict, 0, size)
+	values := make([]ExptGateRuleVerdict, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -19121,35 +24646,24 @@ func (p *EvaluatorAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.AggregatorResults = _field
-	return nil
-}
-func (p *EvaluatorAggregateResult_) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Name = _field
+	p.RuleVerdicts = _field
 	return nil
 }
-func (p *EvaluatorAggregateResult_) ReadField4(iprot thrift.TProtocol) error {
+func (p *ExptGateVerdict) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Version = _field
+	p.EvaluatedAt = _field
 	return nil
 }
 
-func (p *EvaluatorAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExptGateVerdict) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvaluatorAggregateResult"); err != nil {
+	if err = oprot.WriteStructBegin("ExptGateVerdict"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -19165,10 +24679,6 @@ func (p *EvaluatorAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -19187,15 +24697,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvaluatorAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ExptGateVerdict) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -19203,15 +24715,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvaluatorAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAggregatorResults() {
-		if err = oprot.WriteFieldBegin("aggregator_results", thrift.LIST, 2); err != nil {
+func (p *ExptGateVerdict) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleVerdicts() {
+		if err = oprot.WriteFieldBegin("rule_verdicts", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AggregatorResults)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RuleVerdicts)); err != nil {
 			return err
 		}
-		for _, v := range p.AggregatorResults {
+		for _, v := range p.RuleVerdicts {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -19229,12 +24741,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvaluatorAggregateResult_) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+func (p *ExptGateVerdict) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatedAt() {
+		if err = oprot.WriteFieldBegin("evaluated_at", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Name); err != nil {
+		if err := oprot.WriteI64(*p.EvaluatedAt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -19247,67 +24759,51 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *EvaluatorAggregateResult_) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetVersion() {
-		if err = oprot.WriteFieldBegin("version", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Version); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
 
-func (p *EvaluatorAggregateResult_) String() string {
+func (p *ExptGateVerdict) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvaluatorAggregateResult_(%+v)", *p)
+	return fmt.Sprintf("ExptGateVerdict(%+v)", *p)
 
 }
 
-func (p *EvaluatorAggregateResult_) DeepEqual(ano *EvaluatorAggregateResult_) bool {
+func (p *ExptGateVerdict) DeepEqual(ano *ExptGateVerdict) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.AggregatorResults) {
+	if !p.Field1DeepEqual(ano.Status) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Name) {
+	if !p.Field2DeepEqual(ano.RuleVerdicts) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.Version) {
+	if !p.Field3DeepEqual(ano.EvaluatedAt) {
 		return false
 	}
 	return true
 }
 
-func (p *EvaluatorAggregateResult_) Field1DeepEqual(src int64) bool {
+func (p *ExptGateVerdict) Field1DeepEqual(src *ExptGateStatus) bool {
 
-	if p.EvaluatorVersionID != src {
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvaluatorAggregateResult_) Field2DeepEqual(src []*AggregatorResult_) bool {
+func (p *ExptGateVerdict) Field2DeepEqual(src []*ExptGateRuleVerdict) bool {
 
-	if len(p.AggregatorResults) != len(src) {
+	if len(p.RuleVerdicts) != len(src) {
 		return false
 	}
-	for i, v := range p.AggregatorResults {
+	for i, v := range p.RuleVerdicts {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -19315,26 +24811,14 @@ func (p *EvaluatorAggregateResult_) Field2DeepEqual(src []*AggregatorResult_) bo
 	}
 	return true
 }
-func (p *EvaluatorAggregateResult_) Field3DeepEqual(src *string) bool {
-
-	if p.Name == src {
-		return true
-	} else if p.Name == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.Name, *src) != 0 {
-		return false
-	}
-	return true
-}
-func (p *EvaluatorAggregateResult_) Field4DeepEqual(src *string) bool {
+func (p *ExptGateVerdict) Field3DeepEqual(src *int64) bool {
 
-	if p.Version == src {
+	if p.EvaluatedAt == src {
 		return true
-	} else if p.Version == nil || src == nil {
+	} else if p.EvaluatedAt == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Version, *src) != 0 {
+	if *p.EvaluatedAt != *src {
 		return false
 	}
 	return true
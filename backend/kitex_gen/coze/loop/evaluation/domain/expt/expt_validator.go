@@ -62,6 +62,16 @@ func (p *Experiment) IsValid() error {
 			return fmt.Errorf("field ScoreWeightConfig not valid, %w", err)
 		}
 	}
+	if p.GateConfig != nil {
+		if err := p.GateConfig.IsValid(); err != nil {
+			return fmt.Errorf("field GateConfig not valid, %w", err)
+		}
+	}
+	if p.GateVerdict != nil {
+		if err := p.GateVerdict.IsValid(); err != nil {
+			return fmt.Errorf("field GateVerdict not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *ExptTemplateMeta) IsValid() error {
@@ -122,6 +132,16 @@ func (p *ExptTemplate) IsValid() error {
 			return fmt.Errorf("field ExptInfo not valid, %w", err)
 		}
 	}
+	if p.ScheduleConfig != nil {
+		if err := p.ScheduleConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ScheduleConfig not valid, %w", err)
+		}
+	}
+	if p.GateConfig != nil {
+		if err := p.GateConfig.IsValid(); err != nil {
+			return fmt.Errorf("field GateConfig not valid, %w", err)
+		}
+	}
 	if p.BaseInfo != nil {
 		if err := p.BaseInfo.IsValid(); err != nil {
 			return fmt.Errorf("field BaseInfo not valid, %w", err)
@@ -129,6 +149,12 @@ func (p *ExptTemplate) IsValid() error {
 	}
 	return nil
 }
+func (p *ExptTemplateSchedule) IsValid() error {
+	return nil
+}
+func (p *ExptScheduleRecord) IsValid() error {
+	return nil
+}
 func (p *ExptInfo) IsValid() error {
 	return nil
 }
@@ -347,6 +373,35 @@ func (p *EvalTargetAggregateResult_) IsValid() error {
 func (p *EvaluatorAggregateResult_) IsValid() error {
 	return nil
 }
+func (p *EvaluatorDimensionAggregateResult_) IsValid() error {
+	return nil
+}
+func (p *SignificanceTestResult_) IsValid() error {
+	return nil
+}
+func (p *EvaluatorComparisonResult_) IsValid() error {
+	return nil
+}
+func (p *ExptComparisonResult_) IsValid() error {
+	return nil
+}
+func (p *ExptGateRule) IsValid() error {
+	return nil
+}
+func (p *ExptGateConfig) IsValid() error {
+	return nil
+}
+func (p *ExptGateRuleVerdict) IsValid() error {
+	if p.Rule != nil {
+		if err := p.Rule.IsValid(); err != nil {
+			return fmt.Errorf("field Rule not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExptGateVerdict) IsValid() error {
+	return nil
+}
 func (p *AnnotationAggregateResult_) IsValid() error {
 	return nil
 }
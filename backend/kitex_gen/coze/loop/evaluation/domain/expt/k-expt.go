@@ -488,6 +488,34 @@ func (p *Experiment) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 63:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField63(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 64:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField64(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -973,6 +1001,30 @@ func (p *Experiment) FastReadField62(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *Experiment) FastReadField63(buf []byte) (int, error) {
+	offset := 0
+	_field := NewExptGateConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.GateConfig = _field
+	return offset, nil
+}
+
+func (p *Experiment) FastReadField64(buf []byte) (int, error) {
+	offset := 0
+	_field := NewExptGateVerdict()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.GateVerdict = _field
+	return offset, nil
+}
+
 func (p *Experiment) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -1011,6 +1063,8 @@ func (p *Experiment) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 		offset += p.fastWriteField51(buf[offset:], w)
 		offset += p.fastWriteField60(buf[offset:], w)
 		offset += p.fastWriteField61(buf[offset:], w)
+		offset += p.fastWriteField63(buf[offset:], w)
+		offset += p.fastWriteField64(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -1050,6 +1104,8 @@ func (p *Experiment) BLength() int {
 		l += p.field60Length()
 		l += p.field61Length()
 		l += p.field62Length()
+		l += p.field63Length()
+		l += p.field64Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -1362,6 +1418,24 @@ func (p *Experiment) fastWriteField62(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
+func (p *Experiment) fastWriteField63(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGateConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 63)
+		offset += p.GateConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Experiment) fastWriteField64(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGateVerdict() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 64)
+		offset += p.GateVerdict.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *Experiment) field1Length() int {
 	l := 0
 	if p.IsSetID() {
@@ -1655,6 +1729,24 @@ func (p *Experiment) field62Length() int {
 	return l
 }
 
+func (p *Experiment) field63Length() int {
+	l := 0
+	if p.IsSetGateConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.GateConfig.BLength()
+	}
+	return l
+}
+
+func (p *Experiment) field64Length() int {
+	l := 0
+	if p.IsSetGateVerdict() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.GateVerdict.BLength()
+	}
+	return l
+}
+
 func (p *Experiment) DeepCopy(s interface{}) error {
 	src, ok := s.(*Experiment)
 	if !ok {
@@ -1897,6 +1989,24 @@ func (p *Experiment) DeepCopy(s interface{}) error {
 		p.EnableWeightedScore = &tmp
 	}
 
+	var _gateConfig *ExptGateConfig
+	if src.GateConfig != nil {
+		_gateConfig = &ExptGateConfig{}
+		if err := _gateConfig.DeepCopy(src.GateConfig); err != nil {
+			return err
+		}
+	}
+	p.GateConfig = _gateConfig
+
+	var _gateVerdict *ExptGateVerdict
+	if src.GateVerdict != nil {
+		_gateVerdict = &ExptGateVerdict{}
+		if err := _gateVerdict.DeepCopy(src.GateVerdict); err != nil {
+			return err
+		}
+	}
+	p.GateVerdict = _gateVerdict
+
 	return nil
 }
 
@@ -3451,6 +3561,34 @@ func (p *ExptTemplate) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -3543,6 +3681,30 @@ func (p *ExptTemplate) FastReadField5(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *ExptTemplate) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+	_field := NewExptTemplateSchedule()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ScheduleConfig = _field
+	return offset, nil
+}
+
+func (p *ExptTemplate) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+	_field := NewExptGateConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.GateConfig = _field
+	return offset, nil
+}
+
 func (p *ExptTemplate) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := common.NewBaseInfo()
@@ -3567,6 +3729,8 @@ func (p *ExptTemplate) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
@@ -3581,6 +3745,8 @@ func (p *ExptTemplate) BLength() int {
 		l += p.field3Length()
 		l += p.field4Length()
 		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
@@ -3632,6 +3798,24 @@ func (p *ExptTemplate) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
+func (p *ExptTemplate) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetScheduleConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 6)
+		offset += p.ScheduleConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExptTemplate) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGateConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 7)
+		offset += p.GateConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *ExptTemplate) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBaseInfo() {
@@ -3686,6 +3870,24 @@ func (p *ExptTemplate) field5Length() int {
 	return l
 }
 
+func (p *ExptTemplate) field6Length() int {
+	l := 0
+	if p.IsSetScheduleConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ScheduleConfig.BLength()
+	}
+	return l
+}
+
+func (p *ExptTemplate) field7Length() int {
+	l := 0
+	if p.IsSetGateConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.GateConfig.BLength()
+	}
+	return l
+}
+
 func (p *ExptTemplate) field255Length() int {
 	l := 0
 	if p.IsSetBaseInfo() {
@@ -3746,6 +3948,24 @@ func (p *ExptTemplate) DeepCopy(s interface{}) error {
 	}
 	p.ExptInfo = _exptInfo
 
+	var _scheduleConfig *ExptTemplateSchedule
+	if src.ScheduleConfig != nil {
+		_scheduleConfig = &ExptTemplateSchedule{}
+		if err := _scheduleConfig.DeepCopy(src.ScheduleConfig); err != nil {
+			return err
+		}
+	}
+	p.ScheduleConfig = _scheduleConfig
+
+	var _gateConfig *ExptGateConfig
+	if src.GateConfig != nil {
+		_gateConfig = &ExptGateConfig{}
+		if err := _gateConfig.DeepCopy(src.GateConfig); err != nil {
+			return err
+		}
+	}
+	p.GateConfig = _gateConfig
+
 	var _baseInfo *common.BaseInfo
 	if src.BaseInfo != nil {
 		_baseInfo = &common.BaseInfo{}
@@ -3758,7 +3978,7 @@ func (p *ExptTemplate) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExptInfo) FastRead(buf []byte) (int, error) {
+func (p *ExptTemplateSchedule) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -3776,7 +3996,7 @@ func (p *ExptInfo) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3790,7 +4010,7 @@ func (p *ExptInfo) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3804,7 +4024,7 @@ func (p *ExptInfo) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -3817,6 +4037,48 @@ func (p *ExptInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -3830,160 +4092,284 @@ func (p *ExptInfo) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInfo[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptTemplateSchedule[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExptInfo) FastReadField1(buf []byte) (int, error) {
+func (p *ExptTemplateSchedule) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.CreatedExptCount = _field
+	p.Enabled = _field
 	return offset, nil
 }
 
-func (p *ExptInfo) FastReadField2(buf []byte) (int, error) {
+func (p *ExptTemplateSchedule) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.LatestExptID = _field
+	p.Cron = _field
 	return offset, nil
 }
 
-func (p *ExptInfo) FastReadField3(buf []byte) (int, error) {
+func (p *ExptTemplateSchedule) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *ExptStatus
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-
-		tmp := ExptStatus(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.LatestExptStatus = _field
+	p.Timezone = _field
 	return offset, nil
 }
 
-func (p *ExptInfo) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *ExptInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptTemplateSchedule) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
 
-func (p *ExptInfo) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-		l += p.field2Length()
-		l += p.field3Length()
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	l += thrift.Binary.FieldStopLength()
-	return l
+	p.TriggerOnTargetChange = _field
+	return offset, nil
 }
 
-func (p *ExptInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptTemplateSchedule) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-	if p.IsSetCreatedExptCount() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.CreatedExptCount)
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PromptLabel = _field
+	return offset, nil
+}
+
+func (p *ExptTemplateSchedule) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.RetentionCount = _field
+	return offset, nil
+}
+
+func (p *ExptTemplateSchedule) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExptTemplateSchedule) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ExptInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptTemplateSchedule) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExptTemplateSchedule) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetLatestExptID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.LatestExptID)
+	if p.IsSetEnabled() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 1)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Enabled)
 	}
 	return offset
 }
 
-func (p *ExptInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptTemplateSchedule) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetLatestExptStatus() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.LatestExptStatus))
+	if p.IsSetCron() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Cron)
 	}
 	return offset
 }
 
-func (p *ExptInfo) field1Length() int {
+func (p *ExptTemplateSchedule) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTimezone() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Timezone)
+	}
+	return offset
+}
+
+func (p *ExptTemplateSchedule) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTriggerOnTargetChange() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.TriggerOnTargetChange)
+	}
+	return offset
+}
+
+func (p *ExptTemplateSchedule) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPromptLabel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PromptLabel)
+	}
+	return offset
+}
+
+func (p *ExptTemplateSchedule) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRetentionCount() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.RetentionCount)
+	}
+	return offset
+}
+
+func (p *ExptTemplateSchedule) field1Length() int {
 	l := 0
-	if p.IsSetCreatedExptCount() {
+	if p.IsSetEnabled() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.BoolLength()
 	}
 	return l
 }
 
-func (p *ExptInfo) field2Length() int {
+func (p *ExptTemplateSchedule) field2Length() int {
 	l := 0
-	if p.IsSetLatestExptID() {
+	if p.IsSetCron() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.StringLengthNocopy(*p.Cron)
 	}
 	return l
 }
 
-func (p *ExptInfo) field3Length() int {
+func (p *ExptTemplateSchedule) field3Length() int {
 	l := 0
-	if p.IsSetLatestExptStatus() {
+	if p.IsSetTimezone() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Timezone)
+	}
+	return l
+}
+
+func (p *ExptTemplateSchedule) field4Length() int {
+	l := 0
+	if p.IsSetTriggerOnTargetChange() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *ExptTemplateSchedule) field5Length() int {
+	l := 0
+	if p.IsSetPromptLabel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PromptLabel)
+	}
+	return l
+}
+
+func (p *ExptTemplateSchedule) field6Length() int {
+	l := 0
+	if p.IsSetRetentionCount() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *ExptInfo) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExptInfo)
+func (p *ExptTemplateSchedule) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptTemplateSchedule)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.CreatedExptCount != nil {
-		tmp := *src.CreatedExptCount
-		p.CreatedExptCount = &tmp
+	if src.Enabled != nil {
+		tmp := *src.Enabled
+		p.Enabled = &tmp
 	}
 
-	if src.LatestExptID != nil {
-		tmp := *src.LatestExptID
-		p.LatestExptID = &tmp
+	if src.Cron != nil {
+		var tmp string
+		if *src.Cron != "" {
+			tmp = kutils.StringDeepCopy(*src.Cron)
+		}
+		p.Cron = &tmp
 	}
 
-	if src.LatestExptStatus != nil {
-		tmp := *src.LatestExptStatus
-		p.LatestExptStatus = &tmp
+	if src.Timezone != nil {
+		var tmp string
+		if *src.Timezone != "" {
+			tmp = kutils.StringDeepCopy(*src.Timezone)
+		}
+		p.Timezone = &tmp
+	}
+
+	if src.TriggerOnTargetChange != nil {
+		tmp := *src.TriggerOnTargetChange
+		p.TriggerOnTargetChange = &tmp
+	}
+
+	if src.PromptLabel != nil {
+		var tmp string
+		if *src.PromptLabel != "" {
+			tmp = kutils.StringDeepCopy(*src.PromptLabel)
+		}
+		p.PromptLabel = &tmp
+	}
+
+	if src.RetentionCount != nil {
+		tmp := *src.RetentionCount
+		p.RetentionCount = &tmp
 	}
 
 	return nil
 }
 
-func (p *TokenUsage) FastRead(buf []byte) (int, error) {
+func (p *ExptScheduleRecord) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4001,7 +4387,7 @@ func (p *TokenUsage) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4028,6 +4414,62 @@ func (p *TokenUsage) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -4041,12 +4483,26 @@ func (p *TokenUsage) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TokenUsage[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptScheduleRecord[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TokenUsage) FastReadField1(buf []byte) (int, error) {
+func (p *ExptScheduleRecord) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *ExptScheduleTriggerType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TriggerType = _field
+	return offset, nil
+}
+
+func (p *ExptScheduleRecord) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -4056,11 +4512,25 @@ func (p *TokenUsage) FastReadField1(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.InputTokens = _field
+	p.FireAt = _field
 	return offset, nil
 }
 
-func (p *TokenUsage) FastReadField2(buf []byte) (int, error) {
+func (p *ExptScheduleRecord) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TargetVersion = _field
+	return offset, nil
+}
+
+func (p *ExptScheduleRecord) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -4070,90 +4540,224 @@ func (p *TokenUsage) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.OutputTokens = _field
+	p.ExptID = _field
 	return offset, nil
 }
 
-func (p *TokenUsage) FastWrite(buf []byte) int {
+func (p *ExptScheduleRecord) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Error = _field
+	return offset, nil
+}
+
+func (p *ExptScheduleRecord) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Purged = _field
+	return offset, nil
+}
+
+func (p *ExptScheduleRecord) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TokenUsage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptScheduleRecord) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *TokenUsage) BLength() int {
+func (p *ExptScheduleRecord) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *TokenUsage) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptScheduleRecord) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetInputTokens() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.InputTokens)
+	if p.IsSetTriggerType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TriggerType)
 	}
 	return offset
 }
 
-func (p *TokenUsage) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptScheduleRecord) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetOutputTokens() {
+	if p.IsSetFireAt() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.OutputTokens)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.FireAt)
 	}
 	return offset
 }
 
-func (p *TokenUsage) field1Length() int {
+func (p *ExptScheduleRecord) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TargetVersion)
+	}
+	return offset
+}
+
+func (p *ExptScheduleRecord) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExptID)
+	}
+	return offset
+}
+
+func (p *ExptScheduleRecord) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetError() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Error)
+	}
+	return offset
+}
+
+func (p *ExptScheduleRecord) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPurged() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 6)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Purged)
+	}
+	return offset
+}
+
+func (p *ExptScheduleRecord) field1Length() int {
 	l := 0
-	if p.IsSetInputTokens() {
+	if p.IsSetTriggerType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.TriggerType)
+	}
+	return l
+}
+
+func (p *ExptScheduleRecord) field2Length() int {
+	l := 0
+	if p.IsSetFireAt() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *TokenUsage) field2Length() int {
+func (p *ExptScheduleRecord) field3Length() int {
 	l := 0
-	if p.IsSetOutputTokens() {
+	if p.IsSetTargetVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.TargetVersion)
+	}
+	return l
+}
+
+func (p *ExptScheduleRecord) field4Length() int {
+	l := 0
+	if p.IsSetExptID() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *TokenUsage) DeepCopy(s interface{}) error {
-	src, ok := s.(*TokenUsage)
+func (p *ExptScheduleRecord) field5Length() int {
+	l := 0
+	if p.IsSetError() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Error)
+	}
+	return l
+}
+
+func (p *ExptScheduleRecord) field6Length() int {
+	l := 0
+	if p.IsSetPurged() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *ExptScheduleRecord) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptScheduleRecord)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.InputTokens != nil {
-		tmp := *src.InputTokens
-		p.InputTokens = &tmp
+	if src.TriggerType != nil {
+		tmp := *src.TriggerType
+		p.TriggerType = &tmp
 	}
 
-	if src.OutputTokens != nil {
-		tmp := *src.OutputTokens
-		p.OutputTokens = &tmp
+	if src.FireAt != nil {
+		tmp := *src.FireAt
+		p.FireAt = &tmp
+	}
+
+	if src.TargetVersion != nil {
+		var tmp string
+		if *src.TargetVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.TargetVersion)
+		}
+		p.TargetVersion = &tmp
+	}
+
+	if src.ExptID != nil {
+		tmp := *src.ExptID
+		p.ExptID = &tmp
+	}
+
+	if src.Error != nil {
+		var tmp string
+		if *src.Error != "" {
+			tmp = kutils.StringDeepCopy(*src.Error)
+		}
+		p.Error = &tmp
+	}
+
+	if src.Purged != nil {
+		tmp := *src.Purged
+		p.Purged = &tmp
 	}
 
 	return nil
 }
 
-func (p *ExptStatistics) FastRead(buf []byte) (int, error) {
+func (p *ExptInfo) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4171,7 +4775,7 @@ func (p *ExptStatistics) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4185,7 +4789,7 @@ func (p *ExptStatistics) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4199,7 +4803,7 @@ func (p *ExptStatistics) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4213,7 +4817,7 @@ func (p *ExptStatistics) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4227,7 +4831,7 @@ func (p *ExptStatistics) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4240,48 +4844,6 @@ func (p *ExptStatistics) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField7(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 8:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField8(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -4295,153 +4857,112 @@ func (p *ExptStatistics) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptStatistics[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptInfo[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExptStatistics) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*EvaluatorAggregateResult_, 0, size)
-	values := make([]EvaluatorAggregateResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.EvaluatorAggregateResults = _field
-	return offset, nil
-}
-
-func (p *ExptStatistics) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-	_field := NewTokenUsage()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.TokenUsage = _field
-	return offset, nil
-}
-
-func (p *ExptStatistics) FastReadField3(buf []byte) (int, error) {
+func (p *ExptInfo) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.CreditCost = _field
+	p.CreatedExptCount = _field
 	return offset, nil
 }
 
-func (p *ExptStatistics) FastReadField4(buf []byte) (int, error) {
+func (p *ExptInfo) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.PendingTurnCnt = _field
+	p.LatestExptID = _field
 	return offset, nil
 }
 
-func (p *ExptStatistics) FastReadField5(buf []byte) (int, error) {
+func (p *ExptInfo) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
+	var _field *ExptStatus
 	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+
+		tmp := ExptStatus(v)
+		_field = &tmp
 	}
-	p.SuccessTurnCnt = _field
+	p.LatestExptStatus = _field
 	return offset, nil
 }
 
-func (p *ExptStatistics) FastReadField6(buf []byte) (int, error) {
+func (p *ExptInfo) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.FailTurnCnt = _field
-	return offset, nil
-}
-
-func (p *ExptStatistics) FastReadField7(buf []byte) (int, error) {
-	offset := 0
+	_field := make([]*ExptScheduleRecord, 0, size)
+	values := make([]ExptScheduleRecord, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
+		_field = append(_field, _elem)
 	}
-	p.TerminatedTurnCnt = _field
+	p.ScheduleHistory = _field
 	return offset, nil
 }
 
-func (p *ExptStatistics) FastReadField8(buf []byte) (int, error) {
+func (p *ExptInfo) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int32
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.ProcessingTurnCnt = _field
+	p.LastTargetVersion = _field
 	return offset, nil
 }
 
-func (p *ExptStatistics) FastWrite(buf []byte) int {
+func (p *ExptInfo) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExptStatistics) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
-		offset += p.fastWriteField8(buf[offset:], w)
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ExptStatistics) BLength() int {
+func (p *ExptInfo) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4449,233 +4970,160 @@ func (p *ExptStatistics) BLength() int {
 		l += p.field3Length()
 		l += p.field4Length()
 		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
-		l += p.field8Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ExptStatistics) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetEvaluatorAggregateResults() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.EvaluatorAggregateResults {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *ExptStatistics) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTokenUsage() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.TokenUsage.FastWriteNocopy(buf[offset:], w)
-	}
-	return offset
-}
-
-func (p *ExptStatistics) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetCreditCost() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.CreditCost)
-	}
-	return offset
-}
-
-func (p *ExptStatistics) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetPendingTurnCnt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.PendingTurnCnt)
+	if p.IsSetCreatedExptCount() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.CreatedExptCount)
 	}
 	return offset
 }
 
-func (p *ExptStatistics) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSuccessTurnCnt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.SuccessTurnCnt)
+	if p.IsSetLatestExptID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.LatestExptID)
 	}
 	return offset
 }
 
-func (p *ExptStatistics) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFailTurnCnt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.FailTurnCnt)
+	if p.IsSetLatestExptStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.LatestExptStatus))
 	}
 	return offset
 }
 
-func (p *ExptStatistics) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptInfo) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTerminatedTurnCnt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 7)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.TerminatedTurnCnt)
+	if p.IsSetScheduleHistory() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ScheduleHistory {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ExptStatistics) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptInfo) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetProcessingTurnCnt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 8)
-		offset += thrift.Binary.WriteI32(buf[offset:], *p.ProcessingTurnCnt)
+	if p.IsSetLastTargetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LastTargetVersion)
 	}
 	return offset
 }
 
-func (p *ExptStatistics) field1Length() int {
+func (p *ExptInfo) field1Length() int {
 	l := 0
-	if p.IsSetEvaluatorAggregateResults() {
+	if p.IsSetCreatedExptCount() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.EvaluatorAggregateResults {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *ExptStatistics) field2Length() int {
+func (p *ExptInfo) field2Length() int {
 	l := 0
-	if p.IsSetTokenUsage() {
+	if p.IsSetLatestExptID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.TokenUsage.BLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *ExptStatistics) field3Length() int {
+func (p *ExptInfo) field3Length() int {
 	l := 0
-	if p.IsSetCreditCost() {
+	if p.IsSetLatestExptStatus() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *ExptStatistics) field4Length() int {
+func (p *ExptInfo) field4Length() int {
 	l := 0
-	if p.IsSetPendingTurnCnt() {
+	if p.IsSetScheduleHistory() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ScheduleHistory {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ExptStatistics) field5Length() int {
+func (p *ExptInfo) field5Length() int {
 	l := 0
-	if p.IsSetSuccessTurnCnt() {
+	if p.IsSetLastTargetVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.StringLengthNocopy(*p.LastTargetVersion)
 	}
 	return l
 }
 
-func (p *ExptStatistics) field6Length() int {
-	l := 0
-	if p.IsSetFailTurnCnt() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+func (p *ExptInfo) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptInfo)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
-	return l
-}
 
-func (p *ExptStatistics) field7Length() int {
-	l := 0
-	if p.IsSetTerminatedTurnCnt() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+	if src.CreatedExptCount != nil {
+		tmp := *src.CreatedExptCount
+		p.CreatedExptCount = &tmp
 	}
-	return l
-}
 
-func (p *ExptStatistics) field8Length() int {
-	l := 0
-	if p.IsSetProcessingTurnCnt() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+	if src.LatestExptID != nil {
+		tmp := *src.LatestExptID
+		p.LatestExptID = &tmp
 	}
-	return l
-}
 
-func (p *ExptStatistics) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExptStatistics)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+	if src.LatestExptStatus != nil {
+		tmp := *src.LatestExptStatus
+		p.LatestExptStatus = &tmp
 	}
 
-	if src.EvaluatorAggregateResults != nil {
-		p.EvaluatorAggregateResults = make([]*EvaluatorAggregateResult_, 0, len(src.EvaluatorAggregateResults))
-		for _, elem := range src.EvaluatorAggregateResults {
-			var _elem *EvaluatorAggregateResult_
+	if src.ScheduleHistory != nil {
+		p.ScheduleHistory = make([]*ExptScheduleRecord, 0, len(src.ScheduleHistory))
+		for _, elem := range src.ScheduleHistory {
+			var _elem *ExptScheduleRecord
 			if elem != nil {
-				_elem = &EvaluatorAggregateResult_{}
+				_elem = &ExptScheduleRecord{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.EvaluatorAggregateResults = append(p.EvaluatorAggregateResults, _elem)
+			p.ScheduleHistory = append(p.ScheduleHistory, _elem)
 		}
 	}
 
-	var _tokenUsage *TokenUsage
-	if src.TokenUsage != nil {
-		_tokenUsage = &TokenUsage{}
-		if err := _tokenUsage.DeepCopy(src.TokenUsage); err != nil {
-			return err
+	if src.LastTargetVersion != nil {
+		var tmp string
+		if *src.LastTargetVersion != "" {
+			tmp = kutils.StringDeepCopy(*src.LastTargetVersion)
 		}
-	}
-	p.TokenUsage = _tokenUsage
-
-	if src.CreditCost != nil {
-		tmp := *src.CreditCost
-		p.CreditCost = &tmp
-	}
-
-	if src.PendingTurnCnt != nil {
-		tmp := *src.PendingTurnCnt
-		p.PendingTurnCnt = &tmp
-	}
-
-	if src.SuccessTurnCnt != nil {
-		tmp := *src.SuccessTurnCnt
-		p.SuccessTurnCnt = &tmp
-	}
-
-	if src.FailTurnCnt != nil {
-		tmp := *src.FailTurnCnt
-		p.FailTurnCnt = &tmp
-	}
-
-	if src.TerminatedTurnCnt != nil {
-		tmp := *src.TerminatedTurnCnt
-		p.TerminatedTurnCnt = &tmp
-	}
-
-	if src.ProcessingTurnCnt != nil {
-		tmp := *src.ProcessingTurnCnt
-		p.ProcessingTurnCnt = &tmp
+		p.LastTargetVersion = &tmp
 	}
 
 	return nil
 }
 
-func (p *EvaluatorFmtResult_) FastRead(buf []byte) (int, error) {
+func (p *TokenUsage) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4693,7 +5141,7 @@ func (p *EvaluatorFmtResult_) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4707,7 +5155,7 @@ func (p *EvaluatorFmtResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -4733,54 +5181,54 @@ func (p *EvaluatorFmtResult_) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFmtResult_[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TokenUsage[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvaluatorFmtResult_) FastReadField1(buf []byte) (int, error) {
+func (p *TokenUsage) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Name = _field
+	p.InputTokens = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFmtResult_) FastReadField2(buf []byte) (int, error) {
+func (p *TokenUsage) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *float64
-	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Score = _field
+	p.OutputTokens = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFmtResult_) FastWrite(buf []byte) int {
+func (p *TokenUsage) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorFmtResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TokenUsage) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorFmtResult_) BLength() int {
+func (p *TokenUsage) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4790,65 +5238,62 @@ func (p *EvaluatorFmtResult_) BLength() int {
 	return l
 }
 
-func (p *EvaluatorFmtResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TokenUsage) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	if p.IsSetInputTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.InputTokens)
 	}
 	return offset
 }
 
-func (p *EvaluatorFmtResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *TokenUsage) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetScore() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 2)
-		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Score)
+	if p.IsSetOutputTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.OutputTokens)
 	}
 	return offset
 }
 
-func (p *EvaluatorFmtResult_) field1Length() int {
+func (p *TokenUsage) field1Length() int {
 	l := 0
-	if p.IsSetName() {
+	if p.IsSetInputTokens() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorFmtResult_) field2Length() int {
+func (p *TokenUsage) field2Length() int {
 	l := 0
-	if p.IsSetScore() {
+	if p.IsSetOutputTokens() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.DoubleLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorFmtResult_) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorFmtResult_)
+func (p *TokenUsage) DeepCopy(s interface{}) error {
+	src, ok := s.(*TokenUsage)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Name != nil {
-		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
-		}
-		p.Name = &tmp
+	if src.InputTokens != nil {
+		tmp := *src.InputTokens
+		p.InputTokens = &tmp
 	}
 
-	if src.Score != nil {
-		tmp := *src.Score
-		p.Score = &tmp
+	if src.OutputTokens != nil {
+		tmp := *src.OutputTokens
+		p.OutputTokens = &tmp
 	}
 
 	return nil
 }
 
-func (p *TargetFieldMapping) FastRead(buf []byte) (int, error) {
+func (p *ExptStatistics) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4879,150 +5324,41 @@ func (p *TargetFieldMapping) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		default:
-			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-			offset += l
-			if err != nil {
-				goto SkipFieldError
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
 			}
-		}
-	}
-
-	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TargetFieldMapping[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-}
-
-func (p *TargetFieldMapping) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*FieldMapping, 0, size)
-	values := make([]FieldMapping, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.FromEvalSet = _field
-	return offset, nil
-}
-
-func (p *TargetFieldMapping) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *TargetFieldMapping) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
-
-func (p *TargetFieldMapping) BLength() int {
-	l := 0
-	if p != nil {
-		l += p.field1Length()
-	}
-	l += thrift.Binary.FieldStopLength()
-	return l
-}
-
-func (p *TargetFieldMapping) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetFromEvalSet() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.FromEvalSet {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *TargetFieldMapping) field1Length() int {
-	l := 0
-	if p.IsSetFromEvalSet() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.FromEvalSet {
-			_ = v
-			l += v.BLength()
-		}
-	}
-	return l
-}
-
-func (p *TargetFieldMapping) DeepCopy(s interface{}) error {
-	src, ok := s.(*TargetFieldMapping)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
-	}
-
-	if src.FromEvalSet != nil {
-		p.FromEvalSet = make([]*FieldMapping, 0, len(src.FromEvalSet))
-		for _, elem := range src.FromEvalSet {
-			var _elem *FieldMapping
-			if elem != nil {
-				_elem = &FieldMapping{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
 				}
 			}
-
-			p.FromEvalSet = append(p.FromEvalSet, _elem)
-		}
-	}
-
-	return nil
-}
-
-func (p *EvaluatorFieldMapping) FastRead(buf []byte) (int, error) {
-
-	var err error
-	var offset int
-	var l int
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetEvaluatorVersionID bool = false
-	for {
-		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
-		offset += l
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorVersionID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -5030,9 +5366,9 @@ func (p *EvaluatorFieldMapping) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField2(buf[offset:])
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5044,9 +5380,9 @@ func (p *EvaluatorFieldMapping) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField3(buf[offset:])
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5058,9 +5394,23 @@ func (p *EvaluatorFieldMapping) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField4(buf[offset:])
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField8(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -5081,36 +5431,16 @@ func (p *EvaluatorFieldMapping) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetEvaluatorVersionID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFieldMapping[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptStatistics[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EvaluatorFieldMapping[fieldId]))
-}
-
-func (p *EvaluatorFieldMapping) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = v
-	}
-	p.EvaluatorVersionID = _field
-	return offset, nil
 }
 
-func (p *EvaluatorFieldMapping) FastReadField2(buf []byte) (int, error) {
+func (p *ExptStatistics) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -5118,8 +5448,8 @@ func (p *EvaluatorFieldMapping) FastReadField2(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*FieldMapping, 0, size)
-	values := make([]FieldMapping, size)
+	_field := make([]*EvaluatorAggregateResult_, 0, size)
+	values := make([]EvaluatorAggregateResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -5131,90 +5461,150 @@ func (p *EvaluatorFieldMapping) FastReadField2(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.FromEvalSet = _field
+	p.EvaluatorAggregateResults = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFieldMapping) FastReadField3(buf []byte) (int, error) {
+func (p *ExptStatistics) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	_field := NewTokenUsage()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
 	}
-	_field := make([]*FieldMapping, 0, size)
-	values := make([]FieldMapping, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.TokenUsage = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *ExptStatistics) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.FromTarget = _field
+	p.CreditCost = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFieldMapping) FastReadField4(buf []byte) (int, error) {
+func (p *ExptStatistics) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	_field := evaluator.NewEvaluatorIDVersionItem()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.EvaluatorIDVersionItem = _field
+	p.PendingTurnCnt = _field
 	return offset, nil
 }
 
-func (p *EvaluatorFieldMapping) FastWrite(buf []byte) int {
+func (p *ExptStatistics) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.SuccessTurnCnt = _field
+	return offset, nil
+}
+
+func (p *ExptStatistics) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FailTurnCnt = _field
+	return offset, nil
+}
+
+func (p *ExptStatistics) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TerminatedTurnCnt = _field
+	return offset, nil
+}
+
+func (p *ExptStatistics) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ProcessingTurnCnt = _field
+	return offset, nil
+}
+
+func (p *ExptStatistics) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorFieldMapping) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptStatistics) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorFieldMapping) BLength() int {
+func (p *ExptStatistics) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorFieldMapping) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
-	return offset
-}
-
-func (p *EvaluatorFieldMapping) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptStatistics) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFromEvalSet() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	if p.IsSetEvaluatorAggregateResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.FromEvalSet {
+		for _, v := range p.EvaluatorAggregateResults {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -5223,44 +5613,75 @@ func (p *EvaluatorFieldMapping) fastWriteField2(buf []byte, w thrift.NocopyWrite
 	return offset
 }
 
-func (p *EvaluatorFieldMapping) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptStatistics) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFromTarget() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.FromTarget {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetTokenUsage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.TokenUsage.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvaluatorFieldMapping) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptStatistics) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorIDVersionItem() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
-		offset += p.EvaluatorIDVersionItem.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetCreditCost() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.CreditCost)
 	}
 	return offset
 }
 
-func (p *EvaluatorFieldMapping) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
+func (p *ExptStatistics) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPendingTurnCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 4)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PendingTurnCnt)
+	}
+	return offset
 }
 
-func (p *EvaluatorFieldMapping) field2Length() int {
+func (p *ExptStatistics) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccessTurnCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.SuccessTurnCnt)
+	}
+	return offset
+}
+
+func (p *ExptStatistics) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFailTurnCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.FailTurnCnt)
+	}
+	return offset
+}
+
+func (p *ExptStatistics) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTerminatedTurnCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 7)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.TerminatedTurnCnt)
+	}
+	return offset
+}
+
+func (p *ExptStatistics) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetProcessingTurnCnt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 8)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.ProcessingTurnCnt)
+	}
+	return offset
+}
+
+func (p *ExptStatistics) field1Length() int {
 	l := 0
-	if p.IsSetFromEvalSet() {
+	if p.IsSetEvaluatorAggregateResults() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.FromEvalSet {
+		for _, v := range p.EvaluatorAggregateResults {
 			_ = v
 			l += v.BLength()
 		}
@@ -5268,79 +5689,133 @@ func (p *EvaluatorFieldMapping) field2Length() int {
 	return l
 }
 
-func (p *EvaluatorFieldMapping) field3Length() int {
+func (p *ExptStatistics) field2Length() int {
 	l := 0
-	if p.IsSetFromTarget() {
+	if p.IsSetTokenUsage() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.FromTarget {
-			_ = v
-			l += v.BLength()
-		}
+		l += p.TokenUsage.BLength()
 	}
 	return l
 }
 
-func (p *EvaluatorFieldMapping) field4Length() int {
+func (p *ExptStatistics) field3Length() int {
 	l := 0
-	if p.IsSetEvaluatorIDVersionItem() {
+	if p.IsSetCreditCost() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvaluatorIDVersionItem.BLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvaluatorFieldMapping) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorFieldMapping)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+func (p *ExptStatistics) field4Length() int {
+	l := 0
+	if p.IsSetPendingTurnCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
 	}
+	return l
+}
 
-	p.EvaluatorVersionID = src.EvaluatorVersionID
-
-	if src.FromEvalSet != nil {
-		p.FromEvalSet = make([]*FieldMapping, 0, len(src.FromEvalSet))
-		for _, elem := range src.FromEvalSet {
-			var _elem *FieldMapping
-			if elem != nil {
-				_elem = &FieldMapping{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.FromEvalSet = append(p.FromEvalSet, _elem)
-		}
+func (p *ExptStatistics) field5Length() int {
+	l := 0
+	if p.IsSetSuccessTurnCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
 	}
+	return l
+}
 
-	if src.FromTarget != nil {
-		p.FromTarget = make([]*FieldMapping, 0, len(src.FromTarget))
-		for _, elem := range src.FromTarget {
-			var _elem *FieldMapping
+func (p *ExptStatistics) field6Length() int {
+	l := 0
+	if p.IsSetFailTurnCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ExptStatistics) field7Length() int {
+	l := 0
+	if p.IsSetTerminatedTurnCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ExptStatistics) field8Length() int {
+	l := 0
+	if p.IsSetProcessingTurnCnt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ExptStatistics) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptStatistics)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.EvaluatorAggregateResults != nil {
+		p.EvaluatorAggregateResults = make([]*EvaluatorAggregateResult_, 0, len(src.EvaluatorAggregateResults))
+		for _, elem := range src.EvaluatorAggregateResults {
+			var _elem *EvaluatorAggregateResult_
 			if elem != nil {
-				_elem = &FieldMapping{}
+				_elem = &EvaluatorAggregateResult_{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.FromTarget = append(p.FromTarget, _elem)
+			p.EvaluatorAggregateResults = append(p.EvaluatorAggregateResults, _elem)
 		}
 	}
 
-	var _evaluatorIDVersionItem *evaluator.EvaluatorIDVersionItem
-	if src.EvaluatorIDVersionItem != nil {
-		_evaluatorIDVersionItem = &evaluator.EvaluatorIDVersionItem{}
-		if err := _evaluatorIDVersionItem.DeepCopy(src.EvaluatorIDVersionItem); err != nil {
+	var _tokenUsage *TokenUsage
+	if src.TokenUsage != nil {
+		_tokenUsage = &TokenUsage{}
+		if err := _tokenUsage.DeepCopy(src.TokenUsage); err != nil {
 			return err
 		}
 	}
-	p.EvaluatorIDVersionItem = _evaluatorIDVersionItem
+	p.TokenUsage = _tokenUsage
+
+	if src.CreditCost != nil {
+		tmp := *src.CreditCost
+		p.CreditCost = &tmp
+	}
+
+	if src.PendingTurnCnt != nil {
+		tmp := *src.PendingTurnCnt
+		p.PendingTurnCnt = &tmp
+	}
+
+	if src.SuccessTurnCnt != nil {
+		tmp := *src.SuccessTurnCnt
+		p.SuccessTurnCnt = &tmp
+	}
+
+	if src.FailTurnCnt != nil {
+		tmp := *src.FailTurnCnt
+		p.FailTurnCnt = &tmp
+	}
+
+	if src.TerminatedTurnCnt != nil {
+		tmp := *src.TerminatedTurnCnt
+		p.TerminatedTurnCnt = &tmp
+	}
+
+	if src.ProcessingTurnCnt != nil {
+		tmp := *src.ProcessingTurnCnt
+		p.ProcessingTurnCnt = &tmp
+	}
 
 	return nil
 }
 
-func (p *FieldMapping) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorFmtResult_) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5372,7 +5847,7 @@ func (p *FieldMapping) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5385,20 +5860,6 @@ func (p *FieldMapping) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField3(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -5412,26 +5873,12 @@ func (p *FieldMapping) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FieldMapping[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFmtResult_[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *FieldMapping) FastReadField1(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.FieldName = _field
-	return offset, nil
-}
-
-func (p *FieldMapping) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorFmtResult_) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -5441,138 +5888,107 @@ func (p *FieldMapping) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.ConstValue = _field
+	p.Name = _field
 	return offset, nil
 }
 
-func (p *FieldMapping) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorFmtResult_) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.FromFieldName = _field
+	p.Score = _field
 	return offset, nil
 }
 
-func (p *FieldMapping) FastWrite(buf []byte) int {
+func (p *EvaluatorFmtResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *FieldMapping) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorFmtResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *FieldMapping) BLength() int {
+func (p *EvaluatorFmtResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *FieldMapping) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorFmtResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFieldName() {
+	if p.IsSetName() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FieldName)
-	}
-	return offset
-}
-
-func (p *FieldMapping) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetConstValue() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ConstValue)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
 	}
 	return offset
 }
 
-func (p *FieldMapping) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorFmtResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFromFieldName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FromFieldName)
+	if p.IsSetScore() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 2)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Score)
 	}
 	return offset
 }
 
-func (p *FieldMapping) field1Length() int {
-	l := 0
-	if p.IsSetFieldName() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.FieldName)
-	}
-	return l
-}
-
-func (p *FieldMapping) field2Length() int {
+func (p *EvaluatorFmtResult_) field1Length() int {
 	l := 0
-	if p.IsSetConstValue() {
+	if p.IsSetName() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ConstValue)
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
 	}
 	return l
 }
 
-func (p *FieldMapping) field3Length() int {
+func (p *EvaluatorFmtResult_) field2Length() int {
 	l := 0
-	if p.IsSetFromFieldName() {
+	if p.IsSetScore() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.FromFieldName)
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *FieldMapping) DeepCopy(s interface{}) error {
-	src, ok := s.(*FieldMapping)
+func (p *EvaluatorFmtResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorFmtResult_)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.FieldName != nil {
-		var tmp string
-		if *src.FieldName != "" {
-			tmp = kutils.StringDeepCopy(*src.FieldName)
-		}
-		p.FieldName = &tmp
-	}
-
-	if src.ConstValue != nil {
+	if src.Name != nil {
 		var tmp string
-		if *src.ConstValue != "" {
-			tmp = kutils.StringDeepCopy(*src.ConstValue)
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
 		}
-		p.ConstValue = &tmp
+		p.Name = &tmp
 	}
 
-	if src.FromFieldName != nil {
-		var tmp string
-		if *src.FromFieldName != "" {
-			tmp = kutils.StringDeepCopy(*src.FromFieldName)
-		}
-		p.FromFieldName = &tmp
+	if src.Score != nil {
+		tmp := *src.Score
+		p.Score = &tmp
 	}
 
 	return nil
 }
 
-func (p *ExptFilterOption) FastRead(buf []byte) (int, error) {
+func (p *TargetFieldMapping) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5590,7 +6006,7 @@ func (p *ExptFilterOption) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5603,20 +6019,6 @@ func (p *ExptFilterOption) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 10:
-			if fieldTypeId == thrift.STRUCT {
-				l, err = p.FastReadField10(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -5630,130 +6032,119 @@ func (p *ExptFilterOption) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptFilterOption[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TargetFieldMapping[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExptFilterOption) FastReadField1(buf []byte) (int, error) {
+func (p *TargetFieldMapping) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.FuzzyName = _field
+	_field := make([]*FieldMapping, 0, size)
+	values := make([]FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FromEvalSet = _field
 	return offset, nil
 }
 
-func (p *ExptFilterOption) FastReadField10(buf []byte) (int, error) {
-	offset := 0
-	_field := NewFilters()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-	}
-	p.Filters = _field
-	return offset, nil
-}
-
-func (p *ExptFilterOption) FastWrite(buf []byte) int {
+func (p *TargetFieldMapping) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExptFilterOption) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TargetFieldMapping) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ExptFilterOption) BLength() int {
+func (p *TargetFieldMapping) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
-		l += p.field10Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ExptFilterOption) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetFuzzyName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FuzzyName)
-	}
-	return offset
-}
-
-func (p *ExptFilterOption) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+func (p *TargetFieldMapping) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFilters() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 10)
-		offset += p.Filters.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetFromEvalSet() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FromEvalSet {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ExptFilterOption) field1Length() int {
-	l := 0
-	if p.IsSetFuzzyName() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.FuzzyName)
-	}
-	return l
-}
-
-func (p *ExptFilterOption) field10Length() int {
+func (p *TargetFieldMapping) field1Length() int {
 	l := 0
-	if p.IsSetFilters() {
+	if p.IsSetFromEvalSet() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Filters.BLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FromEvalSet {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ExptFilterOption) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExptFilterOption)
+func (p *TargetFieldMapping) DeepCopy(s interface{}) error {
+	src, ok := s.(*TargetFieldMapping)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.FuzzyName != nil {
-		var tmp string
-		if *src.FuzzyName != "" {
-			tmp = kutils.StringDeepCopy(*src.FuzzyName)
-		}
-		p.FuzzyName = &tmp
-	}
+	if src.FromEvalSet != nil {
+		p.FromEvalSet = make([]*FieldMapping, 0, len(src.FromEvalSet))
+		for _, elem := range src.FromEvalSet {
+			var _elem *FieldMapping
+			if elem != nil {
+				_elem = &FieldMapping{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
 
-	var _filters *Filters
-	if src.Filters != nil {
-		_filters = &Filters{}
-		if err := _filters.DeepCopy(src.Filters); err != nil {
-			return err
+			p.FromEvalSet = append(p.FromEvalSet, _elem)
 		}
 	}
-	p.Filters = _filters
 
 	return nil
 }
 
-func (p *ItemSystemInfo) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorFieldMapping) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetEvaluatorVersionID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -5765,12 +6156,13 @@ func (p *ItemSystemInfo) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorVersionID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -5779,7 +6171,7 @@ func (p *ItemSystemInfo) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5793,7 +6185,7 @@ func (p *ItemSystemInfo) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -5806,6 +6198,20 @@ func (p *ItemSystemInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -5815,176 +6221,272 @@ func (p *ItemSystemInfo) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ItemSystemInfo[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorFieldMapping[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EvaluatorFieldMapping[fieldId]))
 }
 
-func (p *ItemSystemInfo) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorFieldMapping) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *ItemRunState
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
+	return offset, nil
+}
 
-		tmp := ItemRunState(v)
-		_field = &tmp
+func (p *EvaluatorFieldMapping) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
 	}
-	p.RunState = _field
+	_field := make([]*FieldMapping, 0, size)
+	values := make([]FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FromEvalSet = _field
 	return offset, nil
 }
 
-func (p *ItemSystemInfo) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorFieldMapping) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.LogID = _field
+	_field := make([]*FieldMapping, 0, size)
+	values := make([]FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FromTarget = _field
 	return offset, nil
 }
 
-func (p *ItemSystemInfo) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorFieldMapping) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRunError()
+	_field := evaluator.NewEvaluatorIDVersionItem()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 	}
-	p.Error = _field
+	p.EvaluatorIDVersionItem = _field
 	return offset, nil
 }
 
-func (p *ItemSystemInfo) FastWrite(buf []byte) int {
+func (p *EvaluatorFieldMapping) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ItemSystemInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorFieldMapping) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ItemSystemInfo) BLength() int {
+func (p *EvaluatorFieldMapping) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ItemSystemInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorFieldMapping) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetRunState() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.RunState))
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
 	return offset
 }
 
-func (p *ItemSystemInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorFieldMapping) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetLogID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LogID)
+	if p.IsSetFromEvalSet() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FromEvalSet {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ItemSystemInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorFieldMapping) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetError() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-		offset += p.Error.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetFromTarget() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FromTarget {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ItemSystemInfo) field1Length() int {
-	l := 0
-	if p.IsSetRunState() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+func (p *EvaluatorFieldMapping) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorIDVersionItem() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.EvaluatorIDVersionItem.FastWriteNocopy(buf[offset:], w)
 	}
-	return l
+	return offset
 }
 
-func (p *ItemSystemInfo) field2Length() int {
+func (p *EvaluatorFieldMapping) field1Length() int {
 	l := 0
-	if p.IsSetLogID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.LogID)
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ItemSystemInfo) field3Length() int {
+func (p *EvaluatorFieldMapping) field2Length() int {
 	l := 0
-	if p.IsSetError() {
+	if p.IsSetFromEvalSet() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Error.BLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FromEvalSet {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ItemSystemInfo) DeepCopy(s interface{}) error {
-	src, ok := s.(*ItemSystemInfo)
+func (p *EvaluatorFieldMapping) field3Length() int {
+	l := 0
+	if p.IsSetFromTarget() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FromTarget {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorFieldMapping) field4Length() int {
+	l := 0
+	if p.IsSetEvaluatorIDVersionItem() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvaluatorIDVersionItem.BLength()
+	}
+	return l
+}
+
+func (p *EvaluatorFieldMapping) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorFieldMapping)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.RunState != nil {
-		tmp := *src.RunState
-		p.RunState = &tmp
+	p.EvaluatorVersionID = src.EvaluatorVersionID
+
+	if src.FromEvalSet != nil {
+		p.FromEvalSet = make([]*FieldMapping, 0, len(src.FromEvalSet))
+		for _, elem := range src.FromEvalSet {
+			var _elem *FieldMapping
+			if elem != nil {
+				_elem = &FieldMapping{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.FromEvalSet = append(p.FromEvalSet, _elem)
+		}
 	}
 
-	if src.LogID != nil {
-		var tmp string
-		if *src.LogID != "" {
-			tmp = kutils.StringDeepCopy(*src.LogID)
+	if src.FromTarget != nil {
+		p.FromTarget = make([]*FieldMapping, 0, len(src.FromTarget))
+		for _, elem := range src.FromTarget {
+			var _elem *FieldMapping
+			if elem != nil {
+				_elem = &FieldMapping{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.FromTarget = append(p.FromTarget, _elem)
 		}
-		p.LogID = &tmp
 	}
 
-	var _error *RunError
-	if src.Error != nil {
-		_error = &RunError{}
-		if err := _error.DeepCopy(src.Error); err != nil {
+	var _evaluatorIDVersionItem *evaluator.EvaluatorIDVersionItem
+	if src.EvaluatorIDVersionItem != nil {
+		_evaluatorIDVersionItem = &evaluator.EvaluatorIDVersionItem{}
+		if err := _evaluatorIDVersionItem.DeepCopy(src.EvaluatorIDVersionItem); err != nil {
 			return err
 		}
 	}
-	p.Error = _error
+	p.EvaluatorIDVersionItem = _evaluatorIDVersionItem
 
 	return nil
 }
 
-func (p *ExptColumnEvaluator) FastRead(buf []byte) (int, error) {
+func (p *FieldMapping) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExperimentID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -5996,13 +6498,12 @@ func (p *ExptColumnEvaluator) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExperimentID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6011,7 +6512,7 @@ func (p *ExptColumnEvaluator) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -6024,6 +6525,20 @@ func (p *ExptColumnEvaluator) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -6033,163 +6548,177 @@ func (p *ExptColumnEvaluator) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetExperimentID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnEvaluator[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FieldMapping[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExptColumnEvaluator[fieldId]))
 }
 
-func (p *ExptColumnEvaluator) FastReadField1(buf []byte) (int, error) {
+func (p *FieldMapping) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.ExperimentID = _field
+	p.FieldName = _field
 	return offset, nil
 }
 
-func (p *ExptColumnEvaluator) FastReadField2(buf []byte) (int, error) {
+func (p *FieldMapping) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*ColumnEvaluator, 0, size)
-	values := make([]ColumnEvaluator, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.ConstValue = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *FieldMapping) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.ColumnEvaluators = _field
+	p.FromFieldName = _field
 	return offset, nil
 }
 
-func (p *ExptColumnEvaluator) FastWrite(buf []byte) int {
+func (p *FieldMapping) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExptColumnEvaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *FieldMapping) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ExptColumnEvaluator) BLength() int {
+func (p *FieldMapping) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ExptColumnEvaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *FieldMapping) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExperimentID)
+	if p.IsSetFieldName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FieldName)
+	}
 	return offset
 }
 
-func (p *ExptColumnEvaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *FieldMapping) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetColumnEvaluators() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.ColumnEvaluators {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetConstValue() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ConstValue)
 	}
 	return offset
 }
 
-func (p *ExptColumnEvaluator) field1Length() int {
+func (p *FieldMapping) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFromFieldName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FromFieldName)
+	}
+	return offset
+}
+
+func (p *FieldMapping) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetFieldName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FieldName)
+	}
 	return l
 }
 
-func (p *ExptColumnEvaluator) field2Length() int {
+func (p *FieldMapping) field2Length() int {
 	l := 0
-	if p.IsSetColumnEvaluators() {
+	if p.IsSetConstValue() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ColumnEvaluators {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.ConstValue)
 	}
 	return l
 }
 
-func (p *ExptColumnEvaluator) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExptColumnEvaluator)
+func (p *FieldMapping) field3Length() int {
+	l := 0
+	if p.IsSetFromFieldName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FromFieldName)
+	}
+	return l
+}
+
+func (p *FieldMapping) DeepCopy(s interface{}) error {
+	src, ok := s.(*FieldMapping)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.ExperimentID = src.ExperimentID
+	if src.FieldName != nil {
+		var tmp string
+		if *src.FieldName != "" {
+			tmp = kutils.StringDeepCopy(*src.FieldName)
+		}
+		p.FieldName = &tmp
+	}
 
-	if src.ColumnEvaluators != nil {
-		p.ColumnEvaluators = make([]*ColumnEvaluator, 0, len(src.ColumnEvaluators))
-		for _, elem := range src.ColumnEvaluators {
-			var _elem *ColumnEvaluator
-			if elem != nil {
-				_elem = &ColumnEvaluator{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+	if src.ConstValue != nil {
+		var tmp string
+		if *src.ConstValue != "" {
+			tmp = kutils.StringDeepCopy(*src.ConstValue)
+		}
+		p.ConstValue = &tmp
+	}
 
-			p.ColumnEvaluators = append(p.ColumnEvaluators, _elem)
+	if src.FromFieldName != nil {
+		var tmp string
+		if *src.FromFieldName != "" {
+			tmp = kutils.StringDeepCopy(*src.FromFieldName)
 		}
+		p.FromFieldName = &tmp
 	}
 
 	return nil
 }
 
-func (p *ColumnEvaluator) FastRead(buf []byte) (int, error) {
+func (p *ExptFilterOption) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetEvaluatorVersionID bool = false
-	var issetEvaluatorID bool = false
-	var issetEvaluatorType bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -6201,28 +6730,12 @@ func (p *ColumnEvaluator) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorVersionID = true
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-				issetEvaluatorID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6230,14 +6743,13 @@ func (p *ColumnEvaluator) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField3(buf[offset:])
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField10(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorType = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6245,23 +6757,156 @@ func (p *ColumnEvaluator) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
 			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField5(buf[offset:])
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptFilterOption[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExptFilterOption) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FuzzyName = _field
+	return offset, nil
+}
+
+func (p *ExptFilterOption) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+	_field := NewFilters()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Filters = _field
+	return offset, nil
+}
+
+func (p *ExptFilterOption) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExptFilterOption) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExptFilterOption) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field10Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExptFilterOption) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFuzzyName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FuzzyName)
+	}
+	return offset
+}
+
+func (p *ExptFilterOption) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 10)
+		offset += p.Filters.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExptFilterOption) field1Length() int {
+	l := 0
+	if p.IsSetFuzzyName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FuzzyName)
+	}
+	return l
+}
+
+func (p *ExptFilterOption) field10Length() int {
+	l := 0
+	if p.IsSetFilters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Filters.BLength()
+	}
+	return l
+}
+
+func (p *ExptFilterOption) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptFilterOption)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.FuzzyName != nil {
+		var tmp string
+		if *src.FuzzyName != "" {
+			tmp = kutils.StringDeepCopy(*src.FuzzyName)
+		}
+		p.FuzzyName = &tmp
+	}
+
+	var _filters *Filters
+	if src.Filters != nil {
+		_filters = &Filters{}
+		if err := _filters.DeepCopy(src.Filters); err != nil {
+			return err
+		}
+	}
+	p.Filters = _filters
+
+	return nil
+}
+
+func (p *ItemSystemInfo) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6273,9 +6918,9 @@ func (p *ColumnEvaluator) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
+		case 2:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField6(buf[offset:])
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6287,9 +6932,9 @@ func (p *ColumnEvaluator) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 7:
-			if fieldTypeId == thrift.BOOL {
-				l, err = p.FastReadField7(buf[offset:])
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -6310,329 +6955,176 @@ func (p *ColumnEvaluator) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetEvaluatorVersionID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluatorID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluatorType {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvaluator[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ItemSystemInfo[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ColumnEvaluator[fieldId]))
 }
 
-func (p *ColumnEvaluator) FastReadField1(buf []byte) (int, error) {
+func (p *ItemSystemInfo) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *ItemRunState
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+
+		tmp := ItemRunState(v)
+		_field = &tmp
 	}
-	p.EvaluatorVersionID = _field
+	p.RunState = _field
 	return offset, nil
 }
 
-func (p *ColumnEvaluator) FastReadField2(buf []byte) (int, error) {
+func (p *ItemSystemInfo) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.EvaluatorID = _field
+	p.LogID = _field
 	return offset, nil
 }
 
-func (p *ColumnEvaluator) FastReadField3(buf []byte) (int, error) {
+func (p *ItemSystemInfo) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-
-	var _field evaluator.EvaluatorType
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	_field := NewRunError()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-
-		_field = evaluator.EvaluatorType(v)
 	}
-	p.EvaluatorType = _field
+	p.Error = _field
 	return offset, nil
 }
 
-func (p *ColumnEvaluator) FastReadField4(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Name = _field
-	return offset, nil
-}
-
-func (p *ColumnEvaluator) FastReadField5(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Version = _field
-	return offset, nil
-}
-
-func (p *ColumnEvaluator) FastReadField6(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Description = _field
-	return offset, nil
-}
-
-func (p *ColumnEvaluator) FastReadField7(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *bool
-	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Builtin = _field
-	return offset, nil
-}
-
-func (p *ColumnEvaluator) FastWrite(buf []byte) int {
+func (p *ItemSystemInfo) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ColumnEvaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ItemSystemInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ColumnEvaluator) BLength() int {
+func (p *ItemSystemInfo) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ColumnEvaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
-	return offset
-}
-
-func (p *ColumnEvaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorID)
-	return offset
-}
-
-func (p *ColumnEvaluator) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
-	offset += thrift.Binary.WriteI32(buf[offset:], int32(p.EvaluatorType))
-	return offset
-}
-
-func (p *ColumnEvaluator) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
-	}
-	return offset
-}
-
-func (p *ColumnEvaluator) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *ItemSystemInfo) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
+	if p.IsSetRunState() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.RunState))
 	}
 	return offset
 }
 
-func (p *ColumnEvaluator) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *ItemSystemInfo) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDescription() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+	if p.IsSetLogID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.LogID)
 	}
 	return offset
 }
 
-func (p *ColumnEvaluator) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+func (p *ItemSystemInfo) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetBuiltin() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
-		offset += thrift.Binary.WriteBool(buf[offset:], *p.Builtin)
+	if p.IsSetError() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.Error.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *ColumnEvaluator) field1Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *ColumnEvaluator) field2Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
-	return l
-}
-
-func (p *ColumnEvaluator) field3Length() int {
-	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I32Length()
-	return l
-}
-
-func (p *ColumnEvaluator) field4Length() int {
-	l := 0
-	if p.IsSetName() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
-	}
-	return l
-}
-
-func (p *ColumnEvaluator) field5Length() int {
+func (p *ItemSystemInfo) field1Length() int {
 	l := 0
-	if p.IsSetVersion() {
+	if p.IsSetRunState() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Version)
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *ColumnEvaluator) field6Length() int {
+func (p *ItemSystemInfo) field2Length() int {
 	l := 0
-	if p.IsSetDescription() {
+	if p.IsSetLogID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Description)
+		l += thrift.Binary.StringLengthNocopy(*p.LogID)
 	}
 	return l
 }
 
-func (p *ColumnEvaluator) field7Length() int {
+func (p *ItemSystemInfo) field3Length() int {
 	l := 0
-	if p.IsSetBuiltin() {
+	if p.IsSetError() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.BoolLength()
+		l += p.Error.BLength()
 	}
 	return l
 }
 
-func (p *ColumnEvaluator) DeepCopy(s interface{}) error {
-	src, ok := s.(*ColumnEvaluator)
+func (p *ItemSystemInfo) DeepCopy(s interface{}) error {
+	src, ok := s.(*ItemSystemInfo)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.EvaluatorVersionID = src.EvaluatorVersionID
-
-	p.EvaluatorID = src.EvaluatorID
-
-	p.EvaluatorType = src.EvaluatorType
-
-	if src.Name != nil {
-		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
-		}
-		p.Name = &tmp
+	if src.RunState != nil {
+		tmp := *src.RunState
+		p.RunState = &tmp
 	}
 
-	if src.Version != nil {
+	if src.LogID != nil {
 		var tmp string
-		if *src.Version != "" {
-			tmp = kutils.StringDeepCopy(*src.Version)
+		if *src.LogID != "" {
+			tmp = kutils.StringDeepCopy(*src.LogID)
 		}
-		p.Version = &tmp
+		p.LogID = &tmp
 	}
 
-	if src.Description != nil {
-		var tmp string
-		if *src.Description != "" {
-			tmp = kutils.StringDeepCopy(*src.Description)
+	var _error *RunError
+	if src.Error != nil {
+		_error = &RunError{}
+		if err := _error.DeepCopy(src.Error); err != nil {
+			return err
 		}
-		p.Description = &tmp
-	}
-
-	if src.Builtin != nil {
-		tmp := *src.Builtin
-		p.Builtin = &tmp
 	}
+	p.Error = _error
 
 	return nil
 }
 
-func (p *ExptColumnEvalTarget) FastRead(buf []byte) (int, error) {
+func (p *ExptColumnEvaluator) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetExperimentID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -6650,6 +7142,7 @@ func (p *ExptColumnEvalTarget) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetExperimentID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6680,30 +7173,36 @@ func (p *ExptColumnEvalTarget) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetExperimentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnEvalTarget[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnEvaluator[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExptColumnEvaluator[fieldId]))
 }
 
-func (p *ExptColumnEvalTarget) FastReadField1(buf []byte) (int, error) {
+func (p *ExptColumnEvaluator) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
 	p.ExperimentID = _field
 	return offset, nil
 }
 
-func (p *ExptColumnEvalTarget) FastReadField2(buf []byte) (int, error) {
+func (p *ExptColumnEvaluator) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -6711,8 +7210,8 @@ func (p *ExptColumnEvalTarget) FastReadField2(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*ColumnEvalTarget, 0, size)
-	values := make([]ColumnEvalTarget, size)
+	_field := make([]*ColumnEvaluator, 0, size)
+	values := make([]ColumnEvaluator, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -6724,15 +7223,15 @@ func (p *ExptColumnEvalTarget) FastReadField2(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.ColumnEvalTargets = _field
+	p.ColumnEvaluators = _field
 	return offset, nil
 }
 
-func (p *ExptColumnEvalTarget) FastWrite(buf []byte) int {
+func (p *ExptColumnEvaluator) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExptColumnEvalTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptColumnEvaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -6742,7 +7241,7 @@ func (p *ExptColumnEvalTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter
 	return offset
 }
 
-func (p *ExptColumnEvalTarget) BLength() int {
+func (p *ExptColumnEvaluator) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -6752,23 +7251,21 @@ func (p *ExptColumnEvalTarget) BLength() int {
 	return l
 }
 
-func (p *ExptColumnEvalTarget) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptColumnEvaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExperimentID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExperimentID)
 	return offset
 }
 
-func (p *ExptColumnEvalTarget) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptColumnEvaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetColumnEvalTargets() {
+	if p.IsSetColumnEvaluators() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.ColumnEvalTargets {
+		for _, v := range p.ColumnEvaluators {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -6777,21 +7274,19 @@ func (p *ExptColumnEvalTarget) fastWriteField2(buf []byte, w thrift.NocopyWriter
 	return offset
 }
 
-func (p *ExptColumnEvalTarget) field1Length() int {
+func (p *ExptColumnEvaluator) field1Length() int {
 	l := 0
-	if p.IsSetExperimentID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ExptColumnEvalTarget) field2Length() int {
+func (p *ExptColumnEvaluator) field2Length() int {
 	l := 0
-	if p.IsSetColumnEvalTargets() {
+	if p.IsSetColumnEvaluators() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ColumnEvalTargets {
+		for _, v := range p.ColumnEvaluators {
 			_ = v
 			l += v.BLength()
 		}
@@ -6799,42 +7294,42 @@ func (p *ExptColumnEvalTarget) field2Length() int {
 	return l
 }
 
-func (p *ExptColumnEvalTarget) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExptColumnEvalTarget)
+func (p *ExptColumnEvaluator) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptColumnEvaluator)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.ExperimentID != nil {
-		tmp := *src.ExperimentID
-		p.ExperimentID = &tmp
-	}
+	p.ExperimentID = src.ExperimentID
 
-	if src.ColumnEvalTargets != nil {
-		p.ColumnEvalTargets = make([]*ColumnEvalTarget, 0, len(src.ColumnEvalTargets))
-		for _, elem := range src.ColumnEvalTargets {
-			var _elem *ColumnEvalTarget
+	if src.ColumnEvaluators != nil {
+		p.ColumnEvaluators = make([]*ColumnEvaluator, 0, len(src.ColumnEvaluators))
+		for _, elem := range src.ColumnEvaluators {
+			var _elem *ColumnEvaluator
 			if elem != nil {
-				_elem = &ColumnEvalTarget{}
+				_elem = &ColumnEvaluator{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.ColumnEvalTargets = append(p.ColumnEvalTargets, _elem)
+			p.ColumnEvaluators = append(p.ColumnEvaluators, _elem)
 		}
 	}
 
 	return nil
 }
 
-func (p *ColumnEvalTarget) FastRead(buf []byte) (int, error) {
+func (p *ColumnEvaluator) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetEvaluatorVersionID bool = false
+	var issetEvaluatorID bool = false
+	var issetEvaluatorType bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -6846,12 +7341,13 @@ func (p *ColumnEvalTarget) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorVersionID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6860,12 +7356,13 @@ func (p *ColumnEvalTarget) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6874,12 +7371,13 @@ func (p *ColumnEvalTarget) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorType = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -6916,7 +7414,7 @@ func (p *ColumnEvalTarget) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 6:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
@@ -6929,6 +7427,20 @@ func (p *ColumnEvalTarget) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -6938,44 +7450,75 @@ func (p *ColumnEvalTarget) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorType {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvalTarget[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvaluator[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ColumnEvaluator[fieldId]))
 }
 
-func (p *ColumnEvalTarget) FastReadField1(buf []byte) (int, error) {
+func (p *ColumnEvaluator) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.Name = _field
+	p.EvaluatorVersionID = _field
 	return offset, nil
 }
 
-func (p *ColumnEvalTarget) FastReadField2(buf []byte) (int, error) {
+func (p *ColumnEvaluator) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.Description = _field
+	p.EvaluatorID = _field
 	return offset, nil
 }
 
-func (p *ColumnEvalTarget) FastReadField3(buf []byte) (int, error) {
+func (p *ColumnEvaluator) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field evaluator.EvaluatorType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		_field = evaluator.EvaluatorType(v)
+	}
+	p.EvaluatorType = _field
+	return offset, nil
+}
+
+func (p *ColumnEvaluator) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -6985,25 +7528,25 @@ func (p *ColumnEvalTarget) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Label = _field
+	p.Name = _field
 	return offset, nil
 }
 
-func (p *ColumnEvalTarget) FastReadField4(buf []byte) (int, error) {
+func (p *ColumnEvaluator) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *common.ContentType
+	var _field *string
 	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.ContentType = _field
+	p.Version = _field
 	return offset, nil
 }
 
-func (p *ColumnEvalTarget) FastReadField5(buf []byte) (int, error) {
+func (p *ColumnEvaluator) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -7013,35 +7556,34 @@ func (p *ColumnEvalTarget) FastReadField5(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.TextSchema = _field
+	p.Description = _field
 	return offset, nil
 }
 
-func (p *ColumnEvalTarget) FastReadField6(buf []byte) (int, error) {
+func (p *ColumnEvaluator) FastReadField7(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *dataset.SchemaKey
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-
-		tmp := dataset.SchemaKey(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.SchemaKey = _field
+	p.Builtin = _field
 	return offset, nil
 }
 
-func (p *ColumnEvalTarget) FastWrite(buf []byte) int {
+func (p *ColumnEvaluator) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ColumnEvalTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvaluator) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
@@ -7051,7 +7593,7 @@ func (p *ColumnEvalTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) in
 	return offset
 }
 
-func (p *ColumnEvalTarget) BLength() int {
+func (p *ColumnEvaluator) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -7060,125 +7602,138 @@ func (p *ColumnEvalTarget) BLength() int {
 		l += p.field4Length()
 		l += p.field5Length()
 		l += p.field6Length()
+		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ColumnEvalTarget) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvaluator) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
 	return offset
 }
 
-func (p *ColumnEvalTarget) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvaluator) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDescription() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorID)
 	return offset
 }
 
-func (p *ColumnEvalTarget) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvaluator) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetLabel() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Label)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+	offset += thrift.Binary.WriteI32(buf[offset:], int32(p.EvaluatorType))
 	return offset
 }
 
-func (p *ColumnEvalTarget) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvaluator) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetContentType() {
+	if p.IsSetName() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ContentType)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
 	}
 	return offset
 }
 
-func (p *ColumnEvalTarget) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvaluator) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTextSchema() {
+	if p.IsSetVersion() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TextSchema)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
 	}
 	return offset
 }
 
-func (p *ColumnEvalTarget) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvaluator) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSchemaKey() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.SchemaKey))
+	if p.IsSetDescription() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
 	}
 	return offset
 }
 
-func (p *ColumnEvalTarget) field1Length() int {
-	l := 0
-	if p.IsSetName() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
+func (p *ColumnEvaluator) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBuiltin() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 7)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Builtin)
 	}
+	return offset
+}
+
+func (p *ColumnEvaluator) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ColumnEvalTarget) field2Length() int {
+func (p *ColumnEvaluator) field2Length() int {
 	l := 0
-	if p.IsSetDescription() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Description)
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *ColumnEvalTarget) field3Length() int {
+func (p *ColumnEvaluator) field3Length() int {
 	l := 0
-	if p.IsSetLabel() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Label)
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I32Length()
 	return l
 }
 
-func (p *ColumnEvalTarget) field4Length() int {
+func (p *ColumnEvaluator) field4Length() int {
 	l := 0
-	if p.IsSetContentType() {
+	if p.IsSetName() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.ContentType)
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
 	}
 	return l
 }
 
-func (p *ColumnEvalTarget) field5Length() int {
+func (p *ColumnEvaluator) field5Length() int {
 	l := 0
-	if p.IsSetTextSchema() {
+	if p.IsSetVersion() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.TextSchema)
+		l += thrift.Binary.StringLengthNocopy(*p.Version)
 	}
 	return l
 }
 
-func (p *ColumnEvalTarget) field6Length() int {
+func (p *ColumnEvaluator) field6Length() int {
 	l := 0
-	if p.IsSetSchemaKey() {
+	if p.IsSetDescription() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.StringLengthNocopy(*p.Description)
 	}
 	return l
 }
 
-func (p *ColumnEvalTarget) DeepCopy(s interface{}) error {
-	src, ok := s.(*ColumnEvalTarget)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+func (p *ColumnEvaluator) field7Length() int {
+	l := 0
+	if p.IsSetBuiltin() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *ColumnEvaluator) DeepCopy(s interface{}) error {
+	src, ok := s.(*ColumnEvaluator)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
+	p.EvaluatorVersionID = src.EvaluatorVersionID
+
+	p.EvaluatorID = src.EvaluatorID
+
+	p.EvaluatorType = src.EvaluatorType
+
 	if src.Name != nil {
 		var tmp string
 		if *src.Name != "" {
@@ -7187,6 +7742,14 @@ func (p *ColumnEvalTarget) DeepCopy(s interface{}) error {
 		p.Name = &tmp
 	}
 
+	if src.Version != nil {
+		var tmp string
+		if *src.Version != "" {
+			tmp = kutils.StringDeepCopy(*src.Version)
+		}
+		p.Version = &tmp
+	}
+
 	if src.Description != nil {
 		var tmp string
 		if *src.Description != "" {
@@ -7195,36 +7758,217 @@ func (p *ColumnEvalTarget) DeepCopy(s interface{}) error {
 		p.Description = &tmp
 	}
 
-	if src.Label != nil {
-		var tmp string
-		if *src.Label != "" {
-			tmp = kutils.StringDeepCopy(*src.Label)
+	if src.Builtin != nil {
+		tmp := *src.Builtin
+		p.Builtin = &tmp
+	}
+
+	return nil
+}
+
+func (p *ExptColumnEvalTarget) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
 		}
-		p.Label = &tmp
 	}
 
-	if src.ContentType != nil {
-		tmp := *src.ContentType
-		p.ContentType = &tmp
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptColumnEvalTarget[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExptColumnEvalTarget) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
+	p.ExperimentID = _field
+	return offset, nil
+}
 
-	if src.TextSchema != nil {
-		var tmp string
-		if *src.TextSchema != "" {
-			tmp = kutils.StringDeepCopy(*src.TextSchema)
+func (p *ExptColumnEvalTarget) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*ColumnEvalTarget, 0, size)
+	values := make([]ColumnEvalTarget, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
 		}
-		p.TextSchema = &tmp
+
+		_field = append(_field, _elem)
 	}
+	p.ColumnEvalTargets = _field
+	return offset, nil
+}
 
-	if src.SchemaKey != nil {
-		tmp := *src.SchemaKey
-		p.SchemaKey = &tmp
+func (p *ExptColumnEvalTarget) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExptColumnEvalTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExptColumnEvalTarget) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExptColumnEvalTarget) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExperimentID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ExperimentID)
+	}
+	return offset
+}
+
+func (p *ExptColumnEvalTarget) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetColumnEvalTargets() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ColumnEvalTargets {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ExptColumnEvalTarget) field1Length() int {
+	l := 0
+	if p.IsSetExperimentID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ExptColumnEvalTarget) field2Length() int {
+	l := 0
+	if p.IsSetColumnEvalTargets() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ColumnEvalTargets {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ExptColumnEvalTarget) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptColumnEvalTarget)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ExperimentID != nil {
+		tmp := *src.ExperimentID
+		p.ExperimentID = &tmp
+	}
+
+	if src.ColumnEvalTargets != nil {
+		p.ColumnEvalTargets = make([]*ColumnEvalTarget, 0, len(src.ColumnEvalTargets))
+		for _, elem := range src.ColumnEvalTargets {
+			var _elem *ColumnEvalTarget
+			if elem != nil {
+				_elem = &ColumnEvalTarget{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ColumnEvalTargets = append(p.ColumnEvalTargets, _elem)
+		}
 	}
 
 	return nil
 }
 
-func (p *ColumnEvalSetField) FastRead(buf []byte) (int, error) {
+func (p *ColumnEvalTarget) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -7297,9 +8041,9 @@ func (p *ColumnEvalSetField) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 6:
+		case 5:
 			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField6(buf[offset:])
+				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -7311,9 +8055,9 @@ func (p *ColumnEvalSetField) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 7:
+		case 6:
 			if fieldTypeId == thrift.I32 {
-				l, err = p.FastReadField7(buf[offset:])
+				l, err = p.FastReadField6(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -7338,12 +8082,12 @@ func (p *ColumnEvalSetField) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvalSetField[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvalTarget[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ColumnEvalSetField) FastReadField1(buf []byte) (int, error) {
+func (p *ColumnEvalTarget) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -7353,11 +8097,11 @@ func (p *ColumnEvalSetField) FastReadField1(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Key = _field
+	p.Name = _field
 	return offset, nil
 }
 
-func (p *ColumnEvalSetField) FastReadField2(buf []byte) (int, error) {
+func (p *ColumnEvalTarget) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -7367,11 +8111,11 @@ func (p *ColumnEvalSetField) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Name = _field
+	p.Description = _field
 	return offset, nil
 }
 
-func (p *ColumnEvalSetField) FastReadField3(buf []byte) (int, error) {
+func (p *ColumnEvalTarget) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -7381,11 +8125,11 @@ func (p *ColumnEvalSetField) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.Description = _field
+	p.Label = _field
 	return offset, nil
 }
 
-func (p *ColumnEvalSetField) FastReadField4(buf []byte) (int, error) {
+func (p *ColumnEvalTarget) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *common.ContentType
@@ -7399,7 +8143,7 @@ func (p *ColumnEvalSetField) FastReadField4(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *ColumnEvalSetField) FastReadField6(buf []byte) (int, error) {
+func (p *ColumnEvalTarget) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *string
@@ -7413,7 +8157,7 @@ func (p *ColumnEvalSetField) FastReadField6(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *ColumnEvalSetField) FastReadField7(buf []byte) (int, error) {
+func (p *ColumnEvalTarget) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *dataset.SchemaKey
@@ -7429,66 +8173,66 @@ func (p *ColumnEvalSetField) FastReadField7(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *ColumnEvalSetField) FastWrite(buf []byte) int {
+func (p *ColumnEvalTarget) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ColumnEvalSetField) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ColumnEvalSetField) BLength() int {
+func (p *ColumnEvalTarget) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
 		l += p.field6Length()
-		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ColumnEvalSetField) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalTarget) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetKey() {
+	if p.IsSetName() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
 	}
 	return offset
 }
 
-func (p *ColumnEvalSetField) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalTarget) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetName() {
+	if p.IsSetDescription() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
 	}
 	return offset
 }
 
-func (p *ColumnEvalSetField) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalTarget) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDescription() {
+	if p.IsSetLabel() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Label)
 	}
 	return offset
 }
 
-func (p *ColumnEvalSetField) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalTarget) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetContentType() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
@@ -7497,52 +8241,52 @@ func (p *ColumnEvalSetField) fastWriteField4(buf []byte, w thrift.NocopyWriter)
 	return offset
 }
 
-func (p *ColumnEvalSetField) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalTarget) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetTextSchema() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
 		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TextSchema)
 	}
 	return offset
 }
 
-func (p *ColumnEvalSetField) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalTarget) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSchemaKey() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 7)
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 6)
 		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.SchemaKey))
 	}
 	return offset
 }
 
-func (p *ColumnEvalSetField) field1Length() int {
+func (p *ColumnEvalTarget) field1Length() int {
 	l := 0
-	if p.IsSetKey() {
+	if p.IsSetName() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Key)
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
 	}
 	return l
 }
 
-func (p *ColumnEvalSetField) field2Length() int {
+func (p *ColumnEvalTarget) field2Length() int {
 	l := 0
-	if p.IsSetName() {
+	if p.IsSetDescription() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
+		l += thrift.Binary.StringLengthNocopy(*p.Description)
 	}
 	return l
 }
 
-func (p *ColumnEvalSetField) field3Length() int {
+func (p *ColumnEvalTarget) field3Length() int {
 	l := 0
-	if p.IsSetDescription() {
+	if p.IsSetLabel() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Description)
+		l += thrift.Binary.StringLengthNocopy(*p.Label)
 	}
 	return l
 }
 
-func (p *ColumnEvalSetField) field4Length() int {
+func (p *ColumnEvalTarget) field4Length() int {
 	l := 0
 	if p.IsSetContentType() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7551,7 +8295,7 @@ func (p *ColumnEvalSetField) field4Length() int {
 	return l
 }
 
-func (p *ColumnEvalSetField) field6Length() int {
+func (p *ColumnEvalTarget) field5Length() int {
 	l := 0
 	if p.IsSetTextSchema() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7560,7 +8304,7 @@ func (p *ColumnEvalSetField) field6Length() int {
 	return l
 }
 
-func (p *ColumnEvalSetField) field7Length() int {
+func (p *ColumnEvalTarget) field6Length() int {
 	l := 0
 	if p.IsSetSchemaKey() {
 		l += thrift.Binary.FieldBeginLength()
@@ -7569,20 +8313,12 @@ func (p *ColumnEvalSetField) field7Length() int {
 	return l
 }
 
-func (p *ColumnEvalSetField) DeepCopy(s interface{}) error {
-	src, ok := s.(*ColumnEvalSetField)
+func (p *ColumnEvalTarget) DeepCopy(s interface{}) error {
+	src, ok := s.(*ColumnEvalTarget)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.Key != nil {
-		var tmp string
-		if *src.Key != "" {
-			tmp = kutils.StringDeepCopy(*src.Key)
-		}
-		p.Key = &tmp
-	}
-
 	if src.Name != nil {
 		var tmp string
 		if *src.Name != "" {
@@ -7599,6 +8335,14 @@ func (p *ColumnEvalSetField) DeepCopy(s interface{}) error {
 		p.Description = &tmp
 	}
 
+	if src.Label != nil {
+		var tmp string
+		if *src.Label != "" {
+			tmp = kutils.StringDeepCopy(*src.Label)
+		}
+		p.Label = &tmp
+	}
+
 	if src.ContentType != nil {
 		tmp := *src.ContentType
 		p.ContentType = &tmp
@@ -7620,14 +8364,13 @@ func (p *ColumnEvalSetField) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ItemResult_) FastRead(buf []byte) (int, error) {
+func (p *ColumnEvalSetField) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -7639,13 +8382,12 @@ func (p *ItemResult_) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetItemID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -7654,7 +8396,7 @@ func (p *ItemResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -7668,7 +8410,7 @@ func (p *ItemResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -7682,7 +8424,7 @@ func (p *ItemResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -7695,9 +8437,23 @@ func (p *ItemResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 5:
-			if fieldTypeId == thrift.MAP {
-				l, err = p.FastReadField5(buf[offset:])
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField7(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -7718,323 +8474,300 @@ func (p *ItemResult_) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetItemID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ItemResult_[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ColumnEvalSetField[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ItemResult_[fieldId]))
 }
 
-func (p *ItemResult_) FastReadField1(buf []byte) (int, error) {
+func (p *ColumnEvalSetField) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.ItemID = _field
+	p.Key = _field
 	return offset, nil
 }
 
-func (p *ItemResult_) FastReadField2(buf []byte) (int, error) {
+func (p *ColumnEvalSetField) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*TurnResult_, 0, size)
-	values := make([]TurnResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.TurnResults = _field
+	p.Name = _field
 	return offset, nil
 }
 
-func (p *ItemResult_) FastReadField3(buf []byte) (int, error) {
+func (p *ColumnEvalSetField) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-	_field := NewItemSystemInfo()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.SystemInfo = _field
+	p.Description = _field
 	return offset, nil
 }
 
-func (p *ItemResult_) FastReadField4(buf []byte) (int, error) {
+func (p *ColumnEvalSetField) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *common.ContentType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.ItemIndex = _field
+	p.ContentType = _field
 	return offset, nil
 }
 
-func (p *ItemResult_) FastReadField5(buf []byte) (int, error) {
+func (p *ColumnEvalSetField) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
+	p.TextSchema = _field
+	return offset, nil
+}
 
-		var _val string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_val = v
-		}
+func (p *ColumnEvalSetField) FastReadField7(buf []byte) (int, error) {
+	offset := 0
 
-		_field[_key] = _val
+	var _field *dataset.SchemaKey
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := dataset.SchemaKey(v)
+		_field = &tmp
 	}
-	p.Ext = _field
+	p.SchemaKey = _field
 	return offset, nil
 }
 
-func (p *ItemResult_) FastWrite(buf []byte) int {
+func (p *ColumnEvalSetField) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ItemResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalSetField) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ItemResult_) BLength() int {
+func (p *ColumnEvalSetField) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
-		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ItemResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalSetField) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
+	if p.IsSetKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Key)
+	}
 	return offset
 }
 
-func (p *ItemResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalSetField) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTurnResults() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.TurnResults {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
 	}
 	return offset
 }
 
-func (p *ItemResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalSetField) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSystemInfo() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-		offset += p.SystemInfo.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetDescription() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Description)
 	}
 	return offset
 }
 
-func (p *ItemResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalSetField) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetItemIndex() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemIndex)
+	if p.IsSetContentType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ContentType)
 	}
 	return offset
 }
 
-func (p *ItemResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *ColumnEvalSetField) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 5)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.Ext {
-			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	if p.IsSetTextSchema() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TextSchema)
 	}
 	return offset
 }
 
-func (p *ItemResult_) field1Length() int {
+func (p *ColumnEvalSetField) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSchemaKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 7)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.SchemaKey))
+	}
+	return offset
+}
+
+func (p *ColumnEvalSetField) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Key)
+	}
 	return l
 }
 
-func (p *ItemResult_) field2Length() int {
+func (p *ColumnEvalSetField) field2Length() int {
 	l := 0
-	if p.IsSetTurnResults() {
+	if p.IsSetName() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.TurnResults {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
 	}
 	return l
 }
 
-func (p *ItemResult_) field3Length() int {
+func (p *ColumnEvalSetField) field3Length() int {
 	l := 0
-	if p.IsSetSystemInfo() {
+	if p.IsSetDescription() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.SystemInfo.BLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Description)
 	}
 	return l
 }
 
-func (p *ItemResult_) field4Length() int {
+func (p *ColumnEvalSetField) field4Length() int {
 	l := 0
-	if p.IsSetItemIndex() {
+	if p.IsSetContentType() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.StringLengthNocopy(*p.ContentType)
 	}
 	return l
 }
 
-func (p *ItemResult_) field5Length() int {
+func (p *ColumnEvalSetField) field6Length() int {
 	l := 0
-	if p.IsSetExt() {
+	if p.IsSetTextSchema() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.Ext {
-			_, _ = k, v
+		l += thrift.Binary.StringLengthNocopy(*p.TextSchema)
+	}
+	return l
+}
 
-			l += thrift.Binary.StringLengthNocopy(k)
-			l += thrift.Binary.StringLengthNocopy(v)
-		}
+func (p *ColumnEvalSetField) field7Length() int {
+	l := 0
+	if p.IsSetSchemaKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
 	}
 	return l
 }
 
-func (p *ItemResult_) DeepCopy(s interface{}) error {
-	src, ok := s.(*ItemResult_)
+func (p *ColumnEvalSetField) DeepCopy(s interface{}) error {
+	src, ok := s.(*ColumnEvalSetField)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.ItemID = src.ItemID
-
-	if src.TurnResults != nil {
-		p.TurnResults = make([]*TurnResult_, 0, len(src.TurnResults))
-		for _, elem := range src.TurnResults {
-			var _elem *TurnResult_
-			if elem != nil {
-				_elem = &TurnResult_{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.TurnResults = append(p.TurnResults, _elem)
+	if src.Key != nil {
+		var tmp string
+		if *src.Key != "" {
+			tmp = kutils.StringDeepCopy(*src.Key)
 		}
+		p.Key = &tmp
 	}
 
-	var _systemInfo *ItemSystemInfo
-	if src.SystemInfo != nil {
-		_systemInfo = &ItemSystemInfo{}
-		if err := _systemInfo.DeepCopy(src.SystemInfo); err != nil {
-			return err
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
 		}
+		p.Name = &tmp
 	}
-	p.SystemInfo = _systemInfo
 
-	if src.ItemIndex != nil {
-		tmp := *src.ItemIndex
-		p.ItemIndex = &tmp
+	if src.Description != nil {
+		var tmp string
+		if *src.Description != "" {
+			tmp = kutils.StringDeepCopy(*src.Description)
+		}
+		p.Description = &tmp
 	}
 
-	if src.Ext != nil {
-		p.Ext = make(map[string]string, len(src.Ext))
-		for key, val := range src.Ext {
-			var _key string
-			if key != "" {
-				_key = kutils.StringDeepCopy(key)
-			}
-
-			var _val string
-			if val != "" {
-				_val = kutils.StringDeepCopy(val)
-			}
+	if src.ContentType != nil {
+		tmp := *src.ContentType
+		p.ContentType = &tmp
+	}
 
-			p.Ext[_key] = _val
+	if src.TextSchema != nil {
+		var tmp string
+		if *src.TextSchema != "" {
+			tmp = kutils.StringDeepCopy(*src.TextSchema)
 		}
+		p.TextSchema = &tmp
+	}
+
+	if src.SchemaKey != nil {
+		tmp := *src.SchemaKey
+		p.SchemaKey = &tmp
 	}
 
 	return nil
 }
 
-func (p *TurnResult_) FastRead(buf []byte) (int, error) {
+func (p *ItemResult_) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetItemID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -8052,6 +8785,7 @@ func (p *TurnResult_) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetItemID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -8074,7 +8808,7 @@ func (p *TurnResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -8087,6 +8821,34 @@ func (p *TurnResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -8096,16 +8858,22 @@ func (p *TurnResult_) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetItemID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnResult_[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ItemResult_[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ItemResult_[fieldId]))
 }
 
-func (p *TurnResult_) FastReadField1(buf []byte) (int, error) {
+func (p *ItemResult_) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -8115,11 +8883,11 @@ func (p *TurnResult_) FastReadField1(buf []byte) (int, error) {
 		offset += l
 		_field = v
 	}
-	p.TurnID = _field
+	p.ItemID = _field
 	return offset, nil
 }
 
-func (p *TurnResult_) FastReadField2(buf []byte) (int, error) {
+func (p *ItemResult_) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -8127,8 +8895,8 @@ func (p *TurnResult_) FastReadField2(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*ExperimentResult_, 0, size)
-	values := make([]ExperimentResult_, size)
+	_field := make([]*TurnResult_, 0, size)
+	values := make([]TurnResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -8140,11 +8908,23 @@ func (p *TurnResult_) FastReadField2(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.ExperimentResults = _field
+	p.TurnResults = _field
 	return offset, nil
 }
 
-func (p *TurnResult_) FastReadField3(buf []byte) (int, error) {
+func (p *ItemResult_) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := NewItemSystemInfo()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.SystemInfo = _field
+	return offset, nil
+}
+
+func (p *ItemResult_) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -8154,51 +8934,87 @@ func (p *TurnResult_) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.TurnIndex = _field
+	p.ItemIndex = _field
 	return offset, nil
 }
 
-func (p *TurnResult_) FastWrite(buf []byte) int {
-	return p.FastWriteNocopy(buf, nil)
-}
-
-func (p *TurnResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ItemResult_) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField2(buf[offset:], w)
-	}
-	offset += thrift.Binary.WriteFieldStop(buf[offset:])
-	return offset
-}
 
-func (p *TurnResult_) BLength() int {
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.Ext = _field
+	return offset, nil
+}
+
+func (p *ItemResult_) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ItemResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ItemResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *TurnResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ItemResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.TurnID)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ItemID)
 	return offset
 }
 
-func (p *TurnResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ItemResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetExperimentResults() {
+	if p.IsSetTurnResults() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.ExperimentResults {
+		for _, v := range p.TurnResults {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -8207,28 +9023,54 @@ func (p *TurnResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
-func (p *TurnResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ItemResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTurnIndex() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.TurnIndex)
+	if p.IsSetSystemInfo() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.SystemInfo.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *TurnResult_) field1Length() int {
+func (p *ItemResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetItemIndex() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ItemIndex)
+	}
+	return offset
+}
+
+func (p *ItemResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 5)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.Ext {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *ItemResult_) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *TurnResult_) field2Length() int {
+func (p *ItemResult_) field2Length() int {
 	l := 0
-	if p.IsSetExperimentResults() {
+	if p.IsSetTurnResults() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.ExperimentResults {
+		for _, v := range p.TurnResults {
 			_ = v
 			l += v.BLength()
 		}
@@ -8236,54 +9078,103 @@ func (p *TurnResult_) field2Length() int {
 	return l
 }
 
-func (p *TurnResult_) field3Length() int {
+func (p *ItemResult_) field3Length() int {
 	l := 0
-	if p.IsSetTurnIndex() {
+	if p.IsSetSystemInfo() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.SystemInfo.BLength()
+	}
+	return l
+}
+
+func (p *ItemResult_) field4Length() int {
+	l := 0
+	if p.IsSetItemIndex() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *TurnResult_) DeepCopy(s interface{}) error {
-	src, ok := s.(*TurnResult_)
+func (p *ItemResult_) field5Length() int {
+	l := 0
+	if p.IsSetExt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.Ext {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *ItemResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*ItemResult_)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.TurnID = src.TurnID
+	p.ItemID = src.ItemID
 
-	if src.ExperimentResults != nil {
-		p.ExperimentResults = make([]*ExperimentResult_, 0, len(src.ExperimentResults))
-		for _, elem := range src.ExperimentResults {
-			var _elem *ExperimentResult_
+	if src.TurnResults != nil {
+		p.TurnResults = make([]*TurnResult_, 0, len(src.TurnResults))
+		for _, elem := range src.TurnResults {
+			var _elem *TurnResult_
 			if elem != nil {
-				_elem = &ExperimentResult_{}
+				_elem = &TurnResult_{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.ExperimentResults = append(p.ExperimentResults, _elem)
+			p.TurnResults = append(p.TurnResults, _elem)
 		}
 	}
 
-	if src.TurnIndex != nil {
-		tmp := *src.TurnIndex
-		p.TurnIndex = &tmp
+	var _systemInfo *ItemSystemInfo
+	if src.SystemInfo != nil {
+		_systemInfo = &ItemSystemInfo{}
+		if err := _systemInfo.DeepCopy(src.SystemInfo); err != nil {
+			return err
+		}
+	}
+	p.SystemInfo = _systemInfo
+
+	if src.ItemIndex != nil {
+		tmp := *src.ItemIndex
+		p.ItemIndex = &tmp
+	}
+
+	if src.Ext != nil {
+		p.Ext = make(map[string]string, len(src.Ext))
+		for key, val := range src.Ext {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val string
+			if val != "" {
+				_val = kutils.StringDeepCopy(val)
+			}
+
+			p.Ext[_key] = _val
+		}
 	}
 
 	return nil
 }
 
-func (p *ExperimentResult_) FastRead(buf []byte) (int, error) {
+func (p *TurnResult_) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExperimentID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -8301,7 +9192,6 @@ func (p *ExperimentResult_) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExperimentID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -8310,7 +9200,7 @@ func (p *ExperimentResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -8323,6 +9213,20 @@ func (p *ExperimentResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -8332,22 +9236,16 @@ func (p *ExperimentResult_) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetExperimentID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentResult_[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TurnResult_[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExperimentResult_[fieldId]))
 }
 
-func (p *ExperimentResult_) FastReadField1(buf []byte) (int, error) {
+func (p *TurnResult_) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -8357,47 +9255,289 @@ func (p *ExperimentResult_) FastReadField1(buf []byte) (int, error) {
 		offset += l
 		_field = v
 	}
-	p.ExperimentID = _field
+	p.TurnID = _field
 	return offset, nil
 }
 
-func (p *ExperimentResult_) FastReadField2(buf []byte) (int, error) {
+func (p *TurnResult_) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExperimentTurnPayload()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*ExperimentResult_, 0, size)
+	values := make([]ExperimentResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ExperimentResults = _field
+	return offset, nil
+}
+
+func (p *TurnResult_) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Payload = _field
+	p.TurnIndex = _field
 	return offset, nil
 }
 
-func (p *ExperimentResult_) FastWrite(buf []byte) int {
+func (p *TurnResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TurnResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ExperimentResult_) BLength() int {
+func (p *TurnResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ExperimentResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TurnResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.TurnID)
+	return offset
+}
+
+func (p *TurnResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetExperimentResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ExperimentResults {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *TurnResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTurnIndex() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TurnIndex)
+	}
+	return offset
+}
+
+func (p *TurnResult_) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *TurnResult_) field2Length() int {
+	l := 0
+	if p.IsSetExperimentResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ExperimentResults {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *TurnResult_) field3Length() int {
+	l := 0
+	if p.IsSetTurnIndex() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *TurnResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*TurnResult_)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.TurnID = src.TurnID
+
+	if src.ExperimentResults != nil {
+		p.ExperimentResults = make([]*ExperimentResult_, 0, len(src.ExperimentResults))
+		for _, elem := range src.ExperimentResults {
+			var _elem *ExperimentResult_
+			if elem != nil {
+				_elem = &ExperimentResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ExperimentResults = append(p.ExperimentResults, _elem)
+		}
+	}
+
+	if src.TurnIndex != nil {
+		tmp := *src.TurnIndex
+		p.TurnIndex = &tmp
+	}
+
+	return nil
+}
+
+func (p *ExperimentResult_) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetExperimentID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExperimentID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetExperimentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentResult_[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExperimentResult_[fieldId]))
+}
+
+func (p *ExperimentResult_) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExperimentID = _field
+	return offset, nil
+}
+
+func (p *ExperimentResult_) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewExperimentTurnPayload()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Payload = _field
+	return offset, nil
+}
+
+func (p *ExperimentResult_) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentResult_) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.ExperimentID)
@@ -11021,12 +12161,2190 @@ func (p *ExperimentTemplateFilter) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentTemplateFilter[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentTemplateFilter) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewFilters()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Filters = _field
+	return offset, nil
+}
+
+func (p *ExperimentTemplateFilter) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewKeywordSearch()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.KeywordSearch = _field
+	return offset, nil
+}
+
+func (p *ExperimentTemplateFilter) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentTemplateFilter) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentTemplateFilter) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentTemplateFilter) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Filters.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentTemplateFilter) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetKeywordSearch() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+		offset += p.KeywordSearch.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentTemplateFilter) field1Length() int {
+	l := 0
+	if p.IsSetFilters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Filters.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentTemplateFilter) field2Length() int {
+	l := 0
+	if p.IsSetKeywordSearch() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.KeywordSearch.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentTemplateFilter) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentTemplateFilter)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _filters *Filters
+	if src.Filters != nil {
+		_filters = &Filters{}
+		if err := _filters.DeepCopy(src.Filters); err != nil {
+			return err
+		}
+	}
+	p.Filters = _filters
+
+	var _keywordSearch *KeywordSearch
+	if src.KeywordSearch != nil {
+		_keywordSearch = &KeywordSearch{}
+		if err := _keywordSearch.DeepCopy(src.KeywordSearch); err != nil {
+			return err
+		}
+	}
+	p.KeywordSearch = _keywordSearch
+
+	return nil
+}
+
+func (p *Filters) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Filters[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *Filters) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*FilterCondition, 0, size)
+	values := make([]FilterCondition, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FilterConditions = _field
+	return offset, nil
+}
+
+func (p *Filters) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *FilterLogicOp
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := FilterLogicOp(v)
+		_field = &tmp
+	}
+	p.LogicOp = _field
+	return offset, nil
+}
+
+func (p *Filters) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *Filters) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *Filters) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *Filters) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilterConditions() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FilterConditions {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *Filters) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLogicOp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.LogicOp))
+	}
+	return offset
+}
+
+func (p *Filters) field1Length() int {
+	l := 0
+	if p.IsSetFilterConditions() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FilterConditions {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *Filters) field2Length() int {
+	l := 0
+	if p.IsSetLogicOp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *Filters) DeepCopy(s interface{}) error {
+	src, ok := s.(*Filters)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.FilterConditions != nil {
+		p.FilterConditions = make([]*FilterCondition, 0, len(src.FilterConditions))
+		for _, elem := range src.FilterConditions {
+			var _elem *FilterCondition
+			if elem != nil {
+				_elem = &FilterCondition{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.FilterConditions = append(p.FilterConditions, _elem)
+		}
+	}
+
+	if src.LogicOp != nil {
+		tmp := *src.LogicOp
+		p.LogicOp = &tmp
+	}
+
+	return nil
+}
+
+func (p *FilterField) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetFieldType bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetFieldType = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetFieldType {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterField[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_FilterField[fieldId]))
+}
+
+func (p *FilterField) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field FieldType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		_field = FieldType(v)
+	}
+	p.FieldType = _field
+	return offset, nil
+}
+
+func (p *FilterField) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FieldKey = _field
+	return offset, nil
+}
+
+func (p *FilterField) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *FilterField) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *FilterField) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *FilterField) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+	offset += thrift.Binary.WriteI32(buf[offset:], int32(p.FieldType))
+	return offset
+}
+
+func (p *FilterField) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldKey() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FieldKey)
+	}
+	return offset
+}
+
+func (p *FilterField) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I32Length()
+	return l
+}
+
+func (p *FilterField) field2Length() int {
+	l := 0
+	if p.IsSetFieldKey() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FieldKey)
+	}
+	return l
+}
+
+func (p *FilterField) DeepCopy(s interface{}) error {
+	src, ok := s.(*FilterField)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.FieldType = src.FieldType
+
+	if src.FieldKey != nil {
+		var tmp string
+		if *src.FieldKey != "" {
+			tmp = kutils.StringDeepCopy(*src.FieldKey)
+		}
+		p.FieldKey = &tmp
+	}
+
+	return nil
+}
+
+func (p *FilterCondition) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterCondition[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *FilterCondition) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewFilterField()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Field = _field
+	return offset, nil
+}
+
+func (p *FilterCondition) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field FilterOperatorType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		_field = FilterOperatorType(v)
+	}
+	p.Operator = _field
+	return offset, nil
+}
+
+func (p *FilterCondition) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Value = _field
+	return offset, nil
+}
+
+func (p *FilterCondition) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := NewSourceTarget()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.SourceTarget = _field
+	return offset, nil
+}
+
+func (p *FilterCondition) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *FilterCondition) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *FilterCondition) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *FilterCondition) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Field.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *FilterCondition) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+	offset += thrift.Binary.WriteI32(buf[offset:], int32(p.Operator))
+	return offset
+}
+
+func (p *FilterCondition) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Value)
+	return offset
+}
+
+func (p *FilterCondition) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSourceTarget() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.SourceTarget.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *FilterCondition) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Field.BLength()
+	return l
+}
+
+func (p *FilterCondition) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I32Length()
+	return l
+}
+
+func (p *FilterCondition) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Value)
+	return l
+}
+
+func (p *FilterCondition) field4Length() int {
+	l := 0
+	if p.IsSetSourceTarget() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.SourceTarget.BLength()
+	}
+	return l
+}
+
+func (p *FilterCondition) DeepCopy(s interface{}) error {
+	src, ok := s.(*FilterCondition)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _field *FilterField
+	if src.Field != nil {
+		_field = &FilterField{}
+		if err := _field.DeepCopy(src.Field); err != nil {
+			return err
+		}
+	}
+	p.Field = _field
+
+	p.Operator = src.Operator
+
+	if src.Value != "" {
+		p.Value = kutils.StringDeepCopy(src.Value)
+	}
+
+	var _sourceTarget *SourceTarget
+	if src.SourceTarget != nil {
+		_sourceTarget = &SourceTarget{}
+		if err := _sourceTarget.DeepCopy(src.SourceTarget); err != nil {
+			return err
+		}
+	}
+	p.SourceTarget = _sourceTarget
+
+	return nil
+}
+
+func (p *SourceTarget) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SourceTarget[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *SourceTarget) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *eval_target.EvalTargetType
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := eval_target.EvalTargetType(v)
+		_field = &tmp
+	}
+	p.EvalTargetType = _field
+	return offset, nil
+}
+
+func (p *SourceTarget) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.SourceTargetIds = _field
+	return offset, nil
+}
+
+func (p *SourceTarget) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *SourceTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *SourceTarget) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *SourceTarget) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvalTargetType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.EvalTargetType))
+	}
+	return offset
+}
+
+func (p *SourceTarget) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSourceTargetIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.SourceTargetIds {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+	}
+	return offset
+}
+
+func (p *SourceTarget) field1Length() int {
+	l := 0
+	if p.IsSetEvalTargetType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *SourceTarget) field3Length() int {
+	l := 0
+	if p.IsSetSourceTargetIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.SourceTargetIds {
+			_ = v
+			l += thrift.Binary.StringLengthNocopy(v)
+		}
+	}
+	return l
+}
+
+func (p *SourceTarget) DeepCopy(s interface{}) error {
+	src, ok := s.(*SourceTarget)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.EvalTargetType != nil {
+		tmp := *src.EvalTargetType
+		p.EvalTargetType = &tmp
+	}
+
+	if src.SourceTargetIds != nil {
+		p.SourceTargetIds = make([]string, 0, len(src.SourceTargetIds))
+		for _, elem := range src.SourceTargetIds {
+			var _elem string
+			if elem != "" {
+				_elem = kutils.StringDeepCopy(elem)
+			}
+			p.SourceTargetIds = append(p.SourceTargetIds, _elem)
+		}
+	}
+
+	return nil
+}
+
+func (p *ExptAggregateResult_) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetExperimentID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExperimentID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.MAP {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetExperimentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptAggregateResult_[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExptAggregateResult_[fieldId]))
+}
+
+func (p *ExptAggregateResult_) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExperimentID = _field
+	return offset, nil
+}
+
+func (p *ExptAggregateResult_) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[int64]*EvaluatorAggregateResult_, size)
+	values := make([]EvaluatorAggregateResult_, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if l, err := _val.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field[_key] = _val
+	}
+	p.EvaluatorResults = _field
+	return offset, nil
+}
+
+func (p *ExptAggregateResult_) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *ExptAggregateCalculateStatus
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+
+		tmp := ExptAggregateCalculateStatus(v)
+		_field = &tmp
+	}
+	p.Status = _field
+	return offset, nil
+}
+
+func (p *ExptAggregateResult_) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make(map[int64]*AnnotationAggregateResult_, size)
+	values := make([]AnnotationAggregateResult_, size)
+	for i := 0; i < size; i++ {
+		var _key int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if l, err := _val.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field[_key] = _val
+	}
+	p.AnnotationResults = _field
+	return offset, nil
+}
+
+func (p *ExptAggregateResult_) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+	_field := NewEvalTargetAggregateResult_()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.EvalTargetAggrResult_ = _field
+	return offset, nil
+}
+
+func (p *ExptAggregateResult_) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.UpdateTime = _field
+	return offset, nil
+}
+
+func (p *ExptAggregateResult_) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.WeightedResults = _field
+	return offset, nil
+}
+
+func (p *ExptAggregateResult_) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExptAggregateResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExptAggregateResult_) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field10Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExptAggregateResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExperimentID)
+	return offset
+}
+
+func (p *ExptAggregateResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 2)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.EvaluatorResults {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], k)
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ExptAggregateResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
+		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.Status))
+	}
+	return offset
+}
+
+func (p *ExptAggregateResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAnnotationResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.AnnotationResults {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], k)
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ExptAggregateResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvalTargetAggrResult_() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 5)
+		offset += p.EvalTargetAggrResult_.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExptAggregateResult_) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetUpdateTime() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.UpdateTime)
+	}
+	return offset
+}
+
+func (p *ExptAggregateResult_) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetWeightedResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 10)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.WeightedResults {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *ExptAggregateResult_) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExptAggregateResult_) field2Length() int {
+	l := 0
+	if p.IsSetEvaluatorResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.EvaluatorResults {
+			_, _ = k, v
+
+			l += thrift.Binary.I64Length()
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ExptAggregateResult_) field3Length() int {
+	l := 0
+	if p.IsSetStatus() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ExptAggregateResult_) field4Length() int {
+	l := 0
+	if p.IsSetAnnotationResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.AnnotationResults {
+			_, _ = k, v
+
+			l += thrift.Binary.I64Length()
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ExptAggregateResult_) field5Length() int {
+	l := 0
+	if p.IsSetEvalTargetAggrResult_() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.EvalTargetAggrResult_.BLength()
+	}
+	return l
+}
+
+func (p *ExptAggregateResult_) field6Length() int {
+	l := 0
+	if p.IsSetUpdateTime() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *ExptAggregateResult_) field10Length() int {
+	l := 0
+	if p.IsSetWeightedResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.WeightedResults {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *ExptAggregateResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptAggregateResult_)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.ExperimentID = src.ExperimentID
+
+	if src.EvaluatorResults != nil {
+		p.EvaluatorResults = make(map[int64]*EvaluatorAggregateResult_, len(src.EvaluatorResults))
+		for key, val := range src.EvaluatorResults {
+			var _key int64
+			_key = key
+
+			var _val *EvaluatorAggregateResult_
+			if val != nil {
+				_val = &EvaluatorAggregateResult_{}
+				if err := _val.DeepCopy(val); err != nil {
+					return err
+				}
+			}
+
+			p.EvaluatorResults[_key] = _val
+		}
+	}
+
+	if src.Status != nil {
+		tmp := *src.Status
+		p.Status = &tmp
+	}
+
+	if src.AnnotationResults != nil {
+		p.AnnotationResults = make(map[int64]*AnnotationAggregateResult_, len(src.AnnotationResults))
+		for key, val := range src.AnnotationResults {
+			var _key int64
+			_key = key
+
+			var _val *AnnotationAggregateResult_
+			if val != nil {
+				_val = &AnnotationAggregateResult_{}
+				if err := _val.DeepCopy(val); err != nil {
+					return err
+				}
+			}
+
+			p.AnnotationResults[_key] = _val
+		}
+	}
+
+	var _evalTargetAggrResult_ *EvalTargetAggregateResult_
+	if src.EvalTargetAggrResult_ != nil {
+		_evalTargetAggrResult_ = &EvalTargetAggregateResult_{}
+		if err := _evalTargetAggrResult_.DeepCopy(src.EvalTargetAggrResult_); err != nil {
+			return err
+		}
+	}
+	p.EvalTargetAggrResult_ = _evalTargetAggrResult_
+
+	if src.UpdateTime != nil {
+		tmp := *src.UpdateTime
+		p.UpdateTime = &tmp
+	}
+
+	if src.WeightedResults != nil {
+		p.WeightedResults = make([]*AggregatorResult_, 0, len(src.WeightedResults))
+		for _, elem := range src.WeightedResults {
+			var _elem *AggregatorResult_
+			if elem != nil {
+				_elem = &AggregatorResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.WeightedResults = append(p.WeightedResults, _elem)
+		}
+	}
+
+	return nil
+}
+
+func (p *EvalTargetAggregateResult_) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvalTargetAggregateResult_[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EvalTargetAggregateResult_) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TargetID = _field
+	return offset, nil
+}
+
+func (p *EvalTargetAggregateResult_) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TargetVersionID = _field
+	return offset, nil
+}
+
+func (p *EvalTargetAggregateResult_) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Latency = _field
+	return offset, nil
+}
+
+func (p *EvalTargetAggregateResult_) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.InputTokens = _field
+	return offset, nil
+}
+
+func (p *EvalTargetAggregateResult_) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.OutputTokens = _field
+	return offset, nil
+}
+
+func (p *EvalTargetAggregateResult_) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.TotalTokens = _field
+	return offset, nil
+}
+
+func (p *EvalTargetAggregateResult_) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.TotalCost = _field
+	return offset, nil
+}
+
+func (p *EvalTargetAggregateResult_) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EvalTargetAggregateResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EvalTargetAggregateResult_) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EvalTargetAggregateResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TargetID)
+	}
+	return offset
+}
+
+func (p *EvalTargetAggregateResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTargetVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TargetVersionID)
+	}
+	return offset
+}
+
+func (p *EvalTargetAggregateResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLatency() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Latency {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvalTargetAggregateResult_) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetInputTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 6)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.InputTokens {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvalTargetAggregateResult_) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOutputTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 7)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.OutputTokens {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvalTargetAggregateResult_) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotalTokens() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 8)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.TotalTokens {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvalTargetAggregateResult_) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotalCost() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 9)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.TotalCost {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvalTargetAggregateResult_) field1Length() int {
+	l := 0
+	if p.IsSetTargetID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvalTargetAggregateResult_) field2Length() int {
+	l := 0
+	if p.IsSetTargetVersionID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EvalTargetAggregateResult_) field5Length() int {
+	l := 0
+	if p.IsSetLatency() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Latency {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvalTargetAggregateResult_) field6Length() int {
+	l := 0
+	if p.IsSetInputTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.InputTokens {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvalTargetAggregateResult_) field7Length() int {
+	l := 0
+	if p.IsSetOutputTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.OutputTokens {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvalTargetAggregateResult_) field8Length() int {
+	l := 0
+	if p.IsSetTotalTokens() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.TotalTokens {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvalTargetAggregateResult_) field9Length() int {
+	l := 0
+	if p.IsSetTotalCost() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.TotalCost {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvalTargetAggregateResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvalTargetAggregateResult_)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.TargetID != nil {
+		tmp := *src.TargetID
+		p.TargetID = &tmp
+	}
+
+	if src.TargetVersionID != nil {
+		tmp := *src.TargetVersionID
+		p.TargetVersionID = &tmp
+	}
+
+	if src.Latency != nil {
+		p.Latency = make([]*AggregatorResult_, 0, len(src.Latency))
+		for _, elem := range src.Latency {
+			var _elem *AggregatorResult_
+			if elem != nil {
+				_elem = &AggregatorResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Latency = append(p.Latency, _elem)
+		}
+	}
+
+	if src.InputTokens != nil {
+		p.InputTokens = make([]*AggregatorResult_, 0, len(src.InputTokens))
+		for _, elem := range src.InputTokens {
+			var _elem *AggregatorResult_
+			if elem != nil {
+				_elem = &AggregatorResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.InputTokens = append(p.InputTokens, _elem)
+		}
+	}
+
+	if src.OutputTokens != nil {
+		p.OutputTokens = make([]*AggregatorResult_, 0, len(src.OutputTokens))
+		for _, elem := range src.OutputTokens {
+			var _elem *AggregatorResult_
+			if elem != nil {
+				_elem = &AggregatorResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.OutputTokens = append(p.OutputTokens, _elem)
+		}
+	}
+
+	if src.TotalTokens != nil {
+		p.TotalTokens = make([]*AggregatorResult_, 0, len(src.TotalTokens))
+		for _, elem := range src.TotalTokens {
+			var _elem *AggregatorResult_
+			if elem != nil {
+				_elem = &AggregatorResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.TotalTokens = append(p.TotalTokens, _elem)
+		}
+	}
+
+	if src.TotalCost != nil {
+		p.TotalCost = make([]*AggregatorResult_, 0, len(src.TotalCost))
+		for _, elem := range src.TotalCost {
+			var _elem *AggregatorResult_
+			if elem != nil {
+				_elem = &AggregatorResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.TotalCost = append(p.TotalCost, _elem)
+		}
+	}
+
+	return nil
+}
+
+func (p *EvaluatorAggregateResult_) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetEvaluatorVersionID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorVersionID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11035,7 +14353,7 @@ func (p *ExperimentTemplateFilter) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11048,6 +14366,48 @@ func (p *ExperimentTemplateFilter) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -11057,127 +14417,309 @@ func (p *ExperimentTemplateFilter) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentTemplateFilter[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorAggregateResult_[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EvaluatorAggregateResult_[fieldId]))
 }
 
-func (p *ExperimentTemplateFilter) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorAggregateResult_) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFilters()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.Filters = _field
+	p.EvaluatorVersionID = _field
 	return offset, nil
 }
 
-func (p *ExperimentTemplateFilter) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorAggregateResult_) FastReadField2(buf []byte) (int, error) {
 	offset := 0
-	_field := NewKeywordSearch()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.AggregatorResults = _field
+	return offset, nil
+}
+
+func (p *EvaluatorAggregateResult_) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.KeywordSearch = _field
+	p.Name = _field
 	return offset, nil
 }
 
-func (p *ExperimentTemplateFilter) FastWrite(buf []byte) int {
+func (p *EvaluatorAggregateResult_) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Version = _field
+	return offset, nil
+}
+
+func (p *EvaluatorAggregateResult_) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluatorDimensionAggregateResult_, 0, size)
+	values := make([]EvaluatorDimensionAggregateResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.DimensionResults = _field
+	return offset, nil
+}
+
+func (p *EvaluatorAggregateResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentTemplateFilter) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorAggregateResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ExperimentTemplateFilter) BLength() int {
+func (p *EvaluatorAggregateResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ExperimentTemplateFilter) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorAggregateResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFilters() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-		offset += p.Filters.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
+	return offset
+}
+
+func (p *EvaluatorAggregateResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAggregatorResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.AggregatorResults {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *ExperimentTemplateFilter) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorAggregateResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetKeywordSearch() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
-		offset += p.KeywordSearch.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
 	}
 	return offset
 }
 
-func (p *ExperimentTemplateFilter) field1Length() int {
+func (p *EvaluatorAggregateResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVersion() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
+	}
+	return offset
+}
+
+func (p *EvaluatorAggregateResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDimensionResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.DimensionResults {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorAggregateResult_) field1Length() int {
 	l := 0
-	if p.IsSetFilters() {
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *EvaluatorAggregateResult_) field2Length() int {
+	l := 0
+	if p.IsSetAggregatorResults() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.Filters.BLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.AggregatorResults {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorAggregateResult_) field3Length() int {
+	l := 0
+	if p.IsSetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
+	}
+	return l
+}
+
+func (p *EvaluatorAggregateResult_) field4Length() int {
+	l := 0
+	if p.IsSetVersion() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Version)
+	}
+	return l
+}
+
+func (p *EvaluatorAggregateResult_) field5Length() int {
+	l := 0
+	if p.IsSetDimensionResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.DimensionResults {
+			_ = v
+			l += v.BLength()
+		}
 	}
 	return l
 }
 
-func (p *ExperimentTemplateFilter) field2Length() int {
-	l := 0
-	if p.IsSetKeywordSearch() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.KeywordSearch.BLength()
+func (p *EvaluatorAggregateResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorAggregateResult_)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.EvaluatorVersionID = src.EvaluatorVersionID
+
+	if src.AggregatorResults != nil {
+		p.AggregatorResults = make([]*AggregatorResult_, 0, len(src.AggregatorResults))
+		for _, elem := range src.AggregatorResults {
+			var _elem *AggregatorResult_
+			if elem != nil {
+				_elem = &AggregatorResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.AggregatorResults = append(p.AggregatorResults, _elem)
+		}
 	}
-	return l
-}
 
-func (p *ExperimentTemplateFilter) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentTemplateFilter)
-	if !ok {
-		return fmt.Errorf("%T's type not matched %T", s, p)
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
 	}
 
-	var _filters *Filters
-	if src.Filters != nil {
-		_filters = &Filters{}
-		if err := _filters.DeepCopy(src.Filters); err != nil {
-			return err
+	if src.Version != nil {
+		var tmp string
+		if *src.Version != "" {
+			tmp = kutils.StringDeepCopy(*src.Version)
 		}
+		p.Version = &tmp
 	}
-	p.Filters = _filters
 
-	var _keywordSearch *KeywordSearch
-	if src.KeywordSearch != nil {
-		_keywordSearch = &KeywordSearch{}
-		if err := _keywordSearch.DeepCopy(src.KeywordSearch); err != nil {
-			return err
+	if src.DimensionResults != nil {
+		p.DimensionResults = make([]*EvaluatorDimensionAggregateResult_, 0, len(src.DimensionResults))
+		for _, elem := range src.DimensionResults {
+			var _elem *EvaluatorDimensionAggregateResult_
+			if elem != nil {
+				_elem = &EvaluatorDimensionAggregateResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.DimensionResults = append(p.DimensionResults, _elem)
 		}
 	}
-	p.KeywordSearch = _keywordSearch
 
 	return nil
 }
 
-func (p *Filters) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorDimensionAggregateResult_) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -11195,7 +14737,7 @@ func (p *Filters) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11209,7 +14751,7 @@ func (p *Filters) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11235,12 +14777,26 @@ func (p *Filters) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Filters[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorDimensionAggregateResult_[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *Filters) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorDimensionAggregateResult_) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Name = _field
+	return offset, nil
+}
+
+func (p *EvaluatorDimensionAggregateResult_) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -11248,8 +14804,8 @@ func (p *Filters) FastReadField1(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*FilterCondition, 0, size)
-	values := make([]FilterCondition, size)
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -11261,31 +14817,15 @@ func (p *Filters) FastReadField1(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.FilterConditions = _field
-	return offset, nil
-}
-
-func (p *Filters) FastReadField2(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *FilterLogicOp
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-
-		tmp := FilterLogicOp(v)
-		_field = &tmp
-	}
-	p.LogicOp = _field
+	p.AggregatorResults = _field
 	return offset, nil
 }
 
-func (p *Filters) FastWrite(buf []byte) int {
+func (p *EvaluatorDimensionAggregateResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *Filters) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorDimensionAggregateResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -11295,7 +14835,7 @@ func (p *Filters) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
-func (p *Filters) BLength() int {
+func (p *EvaluatorDimensionAggregateResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -11305,14 +14845,23 @@ func (p *Filters) BLength() int {
 	return l
 }
 
-func (p *Filters) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorDimensionAggregateResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFilterConditions() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
+	}
+	return offset
+}
+
+func (p *EvaluatorDimensionAggregateResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAggregatorResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.FilterConditions {
+		for _, v := range p.AggregatorResults {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -11321,21 +14870,21 @@ func (p *Filters) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
-func (p *Filters) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetLogicOp() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.LogicOp))
+func (p *EvaluatorDimensionAggregateResult_) field1Length() int {
+	l := 0
+	if p.IsSetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
 	}
-	return offset
+	return l
 }
 
-func (p *Filters) field1Length() int {
+func (p *EvaluatorDimensionAggregateResult_) field2Length() int {
 	l := 0
-	if p.IsSetFilterConditions() {
+	if p.IsSetAggregatorResults() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.FilterConditions {
+		for _, v := range p.AggregatorResults {
 			_ = v
 			l += v.BLength()
 		}
@@ -11343,52 +14892,45 @@ func (p *Filters) field1Length() int {
 	return l
 }
 
-func (p *Filters) field2Length() int {
-	l := 0
-	if p.IsSetLogicOp() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
-	return l
-}
-
-func (p *Filters) DeepCopy(s interface{}) error {
-	src, ok := s.(*Filters)
+func (p *EvaluatorDimensionAggregateResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorDimensionAggregateResult_)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.FilterConditions != nil {
-		p.FilterConditions = make([]*FilterCondition, 0, len(src.FilterConditions))
-		for _, elem := range src.FilterConditions {
-			var _elem *FilterCondition
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
+
+	if src.AggregatorResults != nil {
+		p.AggregatorResults = make([]*AggregatorResult_, 0, len(src.AggregatorResults))
+		for _, elem := range src.AggregatorResults {
+			var _elem *AggregatorResult_
 			if elem != nil {
-				_elem = &FilterCondition{}
+				_elem = &AggregatorResult_{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.FilterConditions = append(p.FilterConditions, _elem)
+			p.AggregatorResults = append(p.AggregatorResults, _elem)
 		}
 	}
 
-	if src.LogicOp != nil {
-		tmp := *src.LogicOp
-		p.LogicOp = &tmp
-	}
-
 	return nil
 }
 
-func (p *FilterField) FastRead(buf []byte) (int, error) {
+func (p *SignificanceTestResult_) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetFieldType bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -11400,13 +14942,12 @@ func (p *FilterField) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetFieldType = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11415,7 +14956,7 @@ func (p *FilterField) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11428,6 +14969,62 @@ func (p *FilterField) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -11437,132 +15034,286 @@ func (p *FilterField) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetFieldType {
-		fieldId = 1
-		goto RequiredFieldNotSetError
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SignificanceTestResult_[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *SignificanceTestResult_) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *SignificanceTestType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TestType = _field
+	return offset, nil
+}
+
+func (p *SignificanceTestResult_) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Statistic = _field
+	return offset, nil
+}
+
+func (p *SignificanceTestResult_) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
+	p.PValue = _field
 	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterField[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_FilterField[fieldId]))
 }
 
-func (p *FilterField) FastReadField1(buf []byte) (int, error) {
+func (p *SignificanceTestResult_) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	var _field FieldType
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
+	}
+	p.Significant = _field
+	return offset, nil
+}
 
-		_field = FieldType(v)
+func (p *SignificanceTestResult_) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	p.FieldType = _field
+	p.CiLower = _field
 	return offset, nil
 }
 
-func (p *FilterField) FastReadField2(buf []byte) (int, error) {
+func (p *SignificanceTestResult_) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.FieldKey = _field
+	p.CiUpper = _field
 	return offset, nil
 }
 
-func (p *FilterField) FastWrite(buf []byte) int {
+func (p *SignificanceTestResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *FilterField) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *SignificanceTestResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *FilterField) BLength() int {
+func (p *SignificanceTestResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *FilterField) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *SignificanceTestResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
-	offset += thrift.Binary.WriteI32(buf[offset:], int32(p.FieldType))
+	if p.IsSetTestType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.TestType)
+	}
 	return offset
 }
 
-func (p *FilterField) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *SignificanceTestResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFieldKey() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FieldKey)
+	if p.IsSetStatistic() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 2)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Statistic)
 	}
 	return offset
 }
 
-func (p *FilterField) field1Length() int {
+func (p *SignificanceTestResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPValue() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.PValue)
+	}
+	return offset
+}
+
+func (p *SignificanceTestResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSignificant() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Significant)
+	}
+	return offset
+}
+
+func (p *SignificanceTestResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCiLower() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.CiLower)
+	}
+	return offset
+}
+
+func (p *SignificanceTestResult_) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCiUpper() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 6)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.CiUpper)
+	}
+	return offset
+}
+
+func (p *SignificanceTestResult_) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I32Length()
+	if p.IsSetTestType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.TestType)
+	}
 	return l
 }
 
-func (p *FilterField) field2Length() int {
+func (p *SignificanceTestResult_) field2Length() int {
 	l := 0
-	if p.IsSetFieldKey() {
+	if p.IsSetStatistic() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.FieldKey)
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *FilterField) DeepCopy(s interface{}) error {
-	src, ok := s.(*FilterField)
+func (p *SignificanceTestResult_) field3Length() int {
+	l := 0
+	if p.IsSetPValue() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *SignificanceTestResult_) field4Length() int {
+	l := 0
+	if p.IsSetSignificant() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *SignificanceTestResult_) field5Length() int {
+	l := 0
+	if p.IsSetCiLower() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *SignificanceTestResult_) field6Length() int {
+	l := 0
+	if p.IsSetCiUpper() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *SignificanceTestResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*SignificanceTestResult_)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.FieldType = src.FieldType
+	if src.TestType != nil {
+		tmp := *src.TestType
+		p.TestType = &tmp
+	}
 
-	if src.FieldKey != nil {
-		var tmp string
-		if *src.FieldKey != "" {
-			tmp = kutils.StringDeepCopy(*src.FieldKey)
-		}
-		p.FieldKey = &tmp
+	if src.Statistic != nil {
+		tmp := *src.Statistic
+		p.Statistic = &tmp
+	}
+
+	if src.PValue != nil {
+		tmp := *src.PValue
+		p.PValue = &tmp
+	}
+
+	if src.Significant != nil {
+		tmp := *src.Significant
+		p.Significant = &tmp
+	}
+
+	if src.CiLower != nil {
+		tmp := *src.CiLower
+		p.CiLower = &tmp
+	}
+
+	if src.CiUpper != nil {
+		tmp := *src.CiUpper
+		p.CiUpper = &tmp
 	}
 
 	return nil
 }
 
-func (p *FilterCondition) FastRead(buf []byte) (int, error) {
+func (p *EvaluatorComparisonResult_) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetEvaluatorVersionID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -11574,12 +15325,13 @@ func (p *FilterCondition) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorVersionID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11588,7 +15340,7 @@ func (p *FilterCondition) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11602,7 +15354,7 @@ func (p *FilterCondition) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11616,7 +15368,7 @@ func (p *FilterCondition) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -11629,6 +15381,62 @@ func (p *FilterCondition) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -11638,196 +15446,396 @@ func (p *FilterCondition) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorComparisonResult_[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EvaluatorComparisonResult_[fieldId]))
+}
+
+func (p *EvaluatorComparisonResult_) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
+	return offset, nil
+}
+
+func (p *EvaluatorComparisonResult_) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PairedCount = _field
+	return offset, nil
+}
+
+func (p *EvaluatorComparisonResult_) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.BaseMean = _field
 	return offset, nil
-ReadFieldBeginError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterCondition[fieldId]), err)
-SkipFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *FilterCondition) FastReadField1(buf []byte) (int, error) {
+func (p *EvaluatorComparisonResult_) FastReadField4(buf []byte) (int, error) {
 	offset := 0
-	_field := NewFilterField()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.Field = _field
+	p.CompareMean = _field
 	return offset, nil
 }
 
-func (p *FilterCondition) FastReadField2(buf []byte) (int, error) {
+func (p *EvaluatorComparisonResult_) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
-	var _field FilterOperatorType
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-
-		_field = FilterOperatorType(v)
+		_field = &v
 	}
-	p.Operator = _field
+	p.MeanDiff = _field
 	return offset, nil
 }
 
-func (p *FilterCondition) FastReadField3(buf []byte) (int, error) {
+func (p *EvaluatorComparisonResult_) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
-	var _field string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.Value = _field
+	p.Binary = _field
 	return offset, nil
 }
 
-func (p *FilterCondition) FastReadField4(buf []byte) (int, error) {
+func (p *EvaluatorComparisonResult_) FastReadField7(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSourceTarget()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*SignificanceTestResult_, 0, size)
+	values := make([]SignificanceTestResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.TestResults = _field
+	return offset, nil
+}
+
+func (p *EvaluatorComparisonResult_) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *ComparisonVerdict
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.SourceTarget = _field
+	p.Verdict = _field
 	return offset, nil
 }
 
-func (p *FilterCondition) FastWrite(buf []byte) int {
+func (p *EvaluatorComparisonResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *FilterCondition) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorComparisonResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *FilterCondition) BLength() int {
+func (p *EvaluatorComparisonResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *FilterCondition) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorComparisonResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
-	offset += p.Field.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
 	return offset
 }
 
-func (p *FilterCondition) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorComparisonResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
-	offset += thrift.Binary.WriteI32(buf[offset:], int32(p.Operator))
+	if p.IsSetPairedCount() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.PairedCount)
+	}
 	return offset
 }
 
-func (p *FilterCondition) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorComparisonResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Value)
+	if p.IsSetBaseMean() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.BaseMean)
+	}
 	return offset
 }
 
-func (p *FilterCondition) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *EvaluatorComparisonResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSourceTarget() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
-		offset += p.SourceTarget.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetCompareMean() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 4)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.CompareMean)
 	}
 	return offset
 }
 
-func (p *FilterCondition) field1Length() int {
+func (p *EvaluatorComparisonResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMeanDiff() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.MeanDiff)
+	}
+	return offset
+}
+
+func (p *EvaluatorComparisonResult_) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBinary() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 6)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Binary)
+	}
+	return offset
+}
+
+func (p *EvaluatorComparisonResult_) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTestResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 7)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.TestResults {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *EvaluatorComparisonResult_) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetVerdict() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 8)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Verdict)
+	}
+	return offset
+}
+
+func (p *EvaluatorComparisonResult_) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
-	l += p.Field.BLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *FilterCondition) field2Length() int {
+func (p *EvaluatorComparisonResult_) field2Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I32Length()
+	if p.IsSetPairedCount() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
 	return l
 }
 
-func (p *FilterCondition) field3Length() int {
+func (p *EvaluatorComparisonResult_) field3Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.StringLengthNocopy(p.Value)
+	if p.IsSetBaseMean() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
 	return l
 }
 
-func (p *FilterCondition) field4Length() int {
+func (p *EvaluatorComparisonResult_) field4Length() int {
 	l := 0
-	if p.IsSetSourceTarget() {
+	if p.IsSetCompareMean() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.SourceTarget.BLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *FilterCondition) DeepCopy(s interface{}) error {
-	src, ok := s.(*FilterCondition)
+func (p *EvaluatorComparisonResult_) field5Length() int {
+	l := 0
+	if p.IsSetMeanDiff() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *EvaluatorComparisonResult_) field6Length() int {
+	l := 0
+	if p.IsSetBinary() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *EvaluatorComparisonResult_) field7Length() int {
+	l := 0
+	if p.IsSetTestResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.TestResults {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *EvaluatorComparisonResult_) field8Length() int {
+	l := 0
+	if p.IsSetVerdict() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Verdict)
+	}
+	return l
+}
+
+func (p *EvaluatorComparisonResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*EvaluatorComparisonResult_)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _field *FilterField
-	if src.Field != nil {
-		_field = &FilterField{}
-		if err := _field.DeepCopy(src.Field); err != nil {
-			return err
-		}
+	p.EvaluatorVersionID = src.EvaluatorVersionID
+
+	if src.PairedCount != nil {
+		tmp := *src.PairedCount
+		p.PairedCount = &tmp
 	}
-	p.Field = _field
 
-	p.Operator = src.Operator
+	if src.BaseMean != nil {
+		tmp := *src.BaseMean
+		p.BaseMean = &tmp
+	}
 
-	if src.Value != "" {
-		p.Value = kutils.StringDeepCopy(src.Value)
+	if src.CompareMean != nil {
+		tmp := *src.CompareMean
+		p.CompareMean = &tmp
 	}
 
-	var _sourceTarget *SourceTarget
-	if src.SourceTarget != nil {
-		_sourceTarget = &SourceTarget{}
-		if err := _sourceTarget.DeepCopy(src.SourceTarget); err != nil {
-			return err
+	if src.MeanDiff != nil {
+		tmp := *src.MeanDiff
+		p.MeanDiff = &tmp
+	}
+
+	if src.Binary != nil {
+		tmp := *src.Binary
+		p.Binary = &tmp
+	}
+
+	if src.TestResults != nil {
+		p.TestResults = make([]*SignificanceTestResult_, 0, len(src.TestResults))
+		for _, elem := range src.TestResults {
+			var _elem *SignificanceTestResult_
+			if elem != nil {
+				_elem = &SignificanceTestResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.TestResults = append(p.TestResults, _elem)
 		}
 	}
-	p.SourceTarget = _sourceTarget
+
+	if src.Verdict != nil {
+		tmp := *src.Verdict
+		p.Verdict = &tmp
+	}
 
 	return nil
 }
 
-func (p *SourceTarget) FastRead(buf []byte) (int, error) {
+func (p *ExptComparisonResult_) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetBaseExperimentID bool = false
+	var issetCompareExperimentID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -11839,12 +15847,28 @@ func (p *SourceTarget) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetBaseExperimentID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetCompareExperimentID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -11875,32 +15899,55 @@ func (p *SourceTarget) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetBaseExperimentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCompareExperimentID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SourceTarget[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptComparisonResult_[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExptComparisonResult_[fieldId]))
 }
 
-func (p *SourceTarget) FastReadField1(buf []byte) (int, error) {
+func (p *ExptComparisonResult_) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *eval_target.EvalTargetType
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
+	}
+	p.BaseExperimentID = _field
+	return offset, nil
+}
 
-		tmp := eval_target.EvalTargetType(v)
-		_field = &tmp
+func (p *ExptComparisonResult_) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
 	}
-	p.EvalTargetType = _field
+	p.CompareExperimentID = _field
 	return offset, nil
 }
 
-func (p *SourceTarget) FastReadField3(buf []byte) (int, error) {
+func (p *ExptComparisonResult_) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -11908,126 +15955,141 @@ func (p *SourceTarget) FastReadField3(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]string, 0, size)
+	_field := make([]*EvaluatorComparisonResult_, 0, size)
+	values := make([]EvaluatorComparisonResult_, size)
 	for i := 0; i < size; i++ {
-		var _elem string
-		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.SourceTargetIds = _field
+	p.EvaluatorResults = _field
 	return offset, nil
 }
 
-func (p *SourceTarget) FastWrite(buf []byte) int {
+func (p *ExptComparisonResult_) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *SourceTarget) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptComparisonResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *SourceTarget) BLength() int {
+func (p *ExptComparisonResult_) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
+		l += p.field2Length()
 		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *SourceTarget) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptComparisonResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvalTargetType() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 1)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.EvalTargetType))
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.BaseExperimentID)
 	return offset
 }
 
-func (p *SourceTarget) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptComparisonResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSourceTargetIds() {
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.CompareExperimentID)
+	return offset
+}
+
+func (p *ExptComparisonResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorResults() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.SourceTargetIds {
+		for _, v := range p.EvaluatorResults {
 			length++
-			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
+			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
 	return offset
 }
 
-func (p *SourceTarget) field1Length() int {
+func (p *ExptComparisonResult_) field1Length() int {
 	l := 0
-	if p.IsSetEvalTargetType() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *SourceTarget) field3Length() int {
+func (p *ExptComparisonResult_) field2Length() int {
 	l := 0
-	if p.IsSetSourceTargetIds() {
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExptComparisonResult_) field3Length() int {
+	l := 0
+	if p.IsSetEvaluatorResults() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.SourceTargetIds {
+		for _, v := range p.EvaluatorResults {
 			_ = v
-			l += thrift.Binary.StringLengthNocopy(v)
+			l += v.BLength()
 		}
 	}
 	return l
 }
 
-func (p *SourceTarget) DeepCopy(s interface{}) error {
-	src, ok := s.(*SourceTarget)
+func (p *ExptComparisonResult_) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptComparisonResult_)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.EvalTargetType != nil {
-		tmp := *src.EvalTargetType
-		p.EvalTargetType = &tmp
-	}
+	p.BaseExperimentID = src.BaseExperimentID
 
-	if src.SourceTargetIds != nil {
-		p.SourceTargetIds = make([]string, 0, len(src.SourceTargetIds))
-		for _, elem := range src.SourceTargetIds {
-			var _elem string
-			if elem != "" {
-				_elem = kutils.StringDeepCopy(elem)
+	p.CompareExperimentID = src.CompareExperimentID
+
+	if src.EvaluatorResults != nil {
+		p.EvaluatorResults = make([]*EvaluatorComparisonResult_, 0, len(src.EvaluatorResults))
+		for _, elem := range src.EvaluatorResults {
+			var _elem *EvaluatorComparisonResult_
+			if elem != nil {
+				_elem = &EvaluatorComparisonResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
 			}
-			p.SourceTargetIds = append(p.SourceTargetIds, _elem)
+
+			p.EvaluatorResults = append(p.EvaluatorResults, _elem)
 		}
 	}
 
 	return nil
 }
 
-func (p *ExptAggregateResult_) FastRead(buf []byte) (int, error) {
+func (p *ExptGateRule) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetExperimentID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -12039,13 +16101,12 @@ func (p *ExptAggregateResult_) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExperimentID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -12054,7 +16115,7 @@ func (p *ExptAggregateResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -12068,7 +16129,7 @@ func (p *ExptAggregateResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -12082,7 +16143,7 @@ func (p *ExptAggregateResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
@@ -12096,7 +16157,7 @@ func (p *ExptAggregateResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 5:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField5(buf[offset:])
 				offset += l
 				if err != nil {
@@ -12123,9 +16184,23 @@ func (p *ExptAggregateResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 10:
+		case 7:
 			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField10(buf[offset:])
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField8(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -12146,130 +16221,86 @@ func (p *ExptAggregateResult_) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetExperimentID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptAggregateResult_[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGateRule[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExptAggregateResult_[fieldId]))
 }
 
-func (p *ExptAggregateResult_) FastReadField1(buf []byte) (int, error) {
+func (p *ExptGateRule) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *ExptGateRuleType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.ExperimentID = _field
+	p.RuleType = _field
 	return offset, nil
 }
 
-func (p *ExptAggregateResult_) FastReadField2(buf []byte) (int, error) {
+func (p *ExptGateRule) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make(map[int64]*EvaluatorAggregateResult_, size)
-	values := make([]EvaluatorAggregateResult_, size)
-	for i := 0; i < size; i++ {
-		var _key int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if l, err := _val.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field[_key] = _val
-	}
-	p.EvaluatorResults = _field
+	p.Name = _field
 	return offset, nil
 }
 
-func (p *ExptAggregateResult_) FastReadField3(buf []byte) (int, error) {
+func (p *ExptGateRule) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *ExptAggregateCalculateStatus
-	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-
-		tmp := ExptAggregateCalculateStatus(v)
-		_field = &tmp
+		_field = &v
 	}
-	p.Status = _field
+	p.EvaluatorVersionID = _field
 	return offset, nil
 }
 
-func (p *ExptAggregateResult_) FastReadField4(buf []byte) (int, error) {
+func (p *ExptGateRule) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *ExptGateOperator
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make(map[int64]*AnnotationAggregateResult_, size)
-	values := make([]AnnotationAggregateResult_, size)
-	for i := 0; i < size; i++ {
-		var _key int64
-		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-			_key = v
-		}
-
-		_val := &values[i]
-		_val.InitDefault()
-		if l, err := _val.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field[_key] = _val
-	}
-	p.AnnotationResults = _field
+	p.Operator = _field
 	return offset, nil
 }
 
-func (p *ExptAggregateResult_) FastReadField5(buf []byte) (int, error) {
+func (p *ExptGateRule) FastReadField5(buf []byte) (int, error) {
 	offset := 0
-	_field := NewEvalTargetAggregateResult_()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = &v
 	}
-	p.EvalTargetAggrResult_ = _field
+	p.Threshold = _field
 	return offset, nil
 }
 
-func (p *ExptAggregateResult_) FastReadField6(buf []byte) (int, error) {
+func (p *ExptGateRule) FastReadField6(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -12279,11 +16310,11 @@ func (p *ExptAggregateResult_) FastReadField6(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.UpdateTime = _field
+	p.BaselineExperimentID = _field
 	return offset, nil
 }
 
-func (p *ExptAggregateResult_) FastReadField10(buf []byte) (int, error) {
+func (p *ExptGateRule) FastReadField7(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -12291,43 +16322,57 @@ func (p *ExptAggregateResult_) FastReadField10(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
+	_field := make([]int64, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.WeightedResults = _field
+	p.EvaluatorVersionIds = _field
 	return offset, nil
 }
 
-func (p *ExptAggregateResult_) FastWrite(buf []byte) int {
+func (p *ExptGateRule) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ConfidenceLevel = _field
+	return offset, nil
+}
+
+func (p *ExptGateRule) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExptAggregateResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRule) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField10(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *ExptAggregateResult_) BLength() int {
+func (p *ExptGateRule) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -12336,255 +16381,372 @@ func (p *ExptAggregateResult_) BLength() int {
 		l += p.field4Length()
 		l += p.field5Length()
 		l += p.field6Length()
-		l += p.field10Length()
+		l += p.field7Length()
+		l += p.field8Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *ExptAggregateResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRule) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExperimentID)
+	if p.IsSetRuleType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.RuleType)
+	}
 	return offset
 }
 
-func (p *ExptAggregateResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRule) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvaluatorResults() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 2)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.EvaluatorResults {
-			length++
-			offset += thrift.Binary.WriteI64(buf[offset:], k)
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.STRUCT, length)
+	if p.IsSetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
 	}
 	return offset
 }
 
-func (p *ExptAggregateResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetStatus() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 3)
-		offset += thrift.Binary.WriteI32(buf[offset:], int32(*p.Status))
+func (p *ExptGateRule) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorVersionID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatorVersionID)
 	}
 	return offset
 }
 
-func (p *ExptAggregateResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRule) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetAnnotationResults() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 4)
-		mapBeginOffset := offset
-		offset += thrift.Binary.MapBeginLength()
-		var length int
-		for k, v := range p.AnnotationResults {
-			length++
-			offset += thrift.Binary.WriteI64(buf[offset:], k)
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.I64, thrift.STRUCT, length)
+	if p.IsSetOperator() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Operator)
 	}
 	return offset
 }
 
-func (p *ExptAggregateResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRule) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEvalTargetAggrResult_() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 5)
-		offset += p.EvalTargetAggrResult_.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetThreshold() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Threshold)
 	}
 	return offset
 }
 
-func (p *ExptAggregateResult_) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRule) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetUpdateTime() {
+	if p.IsSetBaselineExperimentID() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 6)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.UpdateTime)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.BaselineExperimentID)
 	}
 	return offset
 }
 
-func (p *ExptAggregateResult_) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRule) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetWeightedResults() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 10)
+	if p.IsSetEvaluatorVersionIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 7)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.WeightedResults {
+		for _, v := range p.EvaluatorVersionIds {
 			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
 	}
 	return offset
 }
 
-func (p *ExptAggregateResult_) field1Length() int {
+func (p *ExptGateRule) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetConfidenceLevel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 8)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.ConfidenceLevel)
+	}
+	return offset
+}
+
+func (p *ExptGateRule) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetRuleType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.RuleType)
+	}
 	return l
 }
 
-func (p *ExptAggregateResult_) field2Length() int {
+func (p *ExptGateRule) field2Length() int {
 	l := 0
-	if p.IsSetEvaluatorResults() {
+	if p.IsSetName() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.EvaluatorResults {
-			_, _ = k, v
-
-			l += thrift.Binary.I64Length()
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.Name)
 	}
 	return l
 }
 
-func (p *ExptAggregateResult_) field3Length() int {
+func (p *ExptGateRule) field3Length() int {
 	l := 0
-	if p.IsSetStatus() {
+	if p.IsSetEvaluatorVersionID() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I32Length()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *ExptAggregateResult_) field4Length() int {
+func (p *ExptGateRule) field4Length() int {
 	l := 0
-	if p.IsSetAnnotationResults() {
+	if p.IsSetOperator() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.MapBeginLength()
-		for k, v := range p.AnnotationResults {
-			_, _ = k, v
-
-			l += thrift.Binary.I64Length()
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.Operator)
 	}
 	return l
 }
 
-func (p *ExptAggregateResult_) field5Length() int {
+func (p *ExptGateRule) field5Length() int {
 	l := 0
-	if p.IsSetEvalTargetAggrResult_() {
+	if p.IsSetThreshold() {
 		l += thrift.Binary.FieldBeginLength()
-		l += p.EvalTargetAggrResult_.BLength()
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *ExptAggregateResult_) field6Length() int {
+func (p *ExptGateRule) field6Length() int {
 	l := 0
-	if p.IsSetUpdateTime() {
+	if p.IsSetBaselineExperimentID() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *ExptAggregateResult_) field10Length() int {
+func (p *ExptGateRule) field7Length() int {
 	l := 0
-	if p.IsSetWeightedResults() {
+	if p.IsSetEvaluatorVersionIds() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.WeightedResults {
-			_ = v
-			l += v.BLength()
-		}
+		l +=
+			thrift.Binary.I64Length() * len(p.EvaluatorVersionIds)
 	}
 	return l
 }
 
-func (p *ExptAggregateResult_) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExptAggregateResult_)
+func (p *ExptGateRule) field8Length() int {
+	l := 0
+	if p.IsSetConfidenceLevel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *ExptGateRule) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptGateRule)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.ExperimentID = src.ExperimentID
+	if src.RuleType != nil {
+		tmp := *src.RuleType
+		p.RuleType = &tmp
+	}
 
-	if src.EvaluatorResults != nil {
-		p.EvaluatorResults = make(map[int64]*EvaluatorAggregateResult_, len(src.EvaluatorResults))
-		for key, val := range src.EvaluatorResults {
-			var _key int64
-			_key = key
+	if src.Name != nil {
+		var tmp string
+		if *src.Name != "" {
+			tmp = kutils.StringDeepCopy(*src.Name)
+		}
+		p.Name = &tmp
+	}
 
-			var _val *EvaluatorAggregateResult_
-			if val != nil {
-				_val = &EvaluatorAggregateResult_{}
-				if err := _val.DeepCopy(val); err != nil {
-					return err
-				}
-			}
+	if src.EvaluatorVersionID != nil {
+		tmp := *src.EvaluatorVersionID
+		p.EvaluatorVersionID = &tmp
+	}
 
-			p.EvaluatorResults[_key] = _val
+	if src.Operator != nil {
+		tmp := *src.Operator
+		p.Operator = &tmp
+	}
+
+	if src.Threshold != nil {
+		tmp := *src.Threshold
+		p.Threshold = &tmp
+	}
+
+	if src.BaselineExperimentID != nil {
+		tmp := *src.BaselineExperimentID
+		p.BaselineExperimentID = &tmp
+	}
+
+	if src.EvaluatorVersionIds != nil {
+		p.EvaluatorVersionIds = make([]int64, 0, len(src.EvaluatorVersionIds))
+		for _, elem := range src.EvaluatorVersionIds {
+			var _elem int64
+			_elem = elem
+			p.EvaluatorVersionIds = append(p.EvaluatorVersionIds, _elem)
 		}
 	}
 
-	if src.Status != nil {
-		tmp := *src.Status
-		p.Status = &tmp
+	if src.ConfidenceLevel != nil {
+		tmp := *src.ConfidenceLevel
+		p.ConfidenceLevel = &tmp
 	}
 
-	if src.AnnotationResults != nil {
-		p.AnnotationResults = make(map[int64]*AnnotationAggregateResult_, len(src.AnnotationResults))
-		for key, val := range src.AnnotationResults {
-			var _key int64
-			_key = key
+	return nil
+}
 
-			var _val *AnnotationAggregateResult_
-			if val != nil {
-				_val = &AnnotationAggregateResult_{}
-				if err := _val.DeepCopy(val); err != nil {
-					return err
+func (p *ExptGateConfig) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
 				}
 			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
 
-			p.AnnotationResults[_key] = _val
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGateConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExptGateConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*ExptGateRule, 0, size)
+	values := make([]ExptGateRule, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
 		}
+
+		_field = append(_field, _elem)
 	}
+	p.Rules = _field
+	return offset, nil
+}
 
-	var _evalTargetAggrResult_ *EvalTargetAggregateResult_
-	if src.EvalTargetAggrResult_ != nil {
-		_evalTargetAggrResult_ = &EvalTargetAggregateResult_{}
-		if err := _evalTargetAggrResult_.DeepCopy(src.EvalTargetAggrResult_); err != nil {
-			return err
+func (p *ExptGateConfig) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExptGateConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExptGateConfig) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExptGateConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetRules() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.Rules {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	}
-	p.EvalTargetAggrResult_ = _evalTargetAggrResult_
+	return offset
+}
 
-	if src.UpdateTime != nil {
-		tmp := *src.UpdateTime
-		p.UpdateTime = &tmp
+func (p *ExptGateConfig) field1Length() int {
+	l := 0
+	if p.IsSetRules() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.Rules {
+			_ = v
+			l += v.BLength()
+		}
 	}
+	return l
+}
 
-	if src.WeightedResults != nil {
-		p.WeightedResults = make([]*AggregatorResult_, 0, len(src.WeightedResults))
-		for _, elem := range src.WeightedResults {
-			var _elem *AggregatorResult_
+func (p *ExptGateConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptGateConfig)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Rules != nil {
+		p.Rules = make([]*ExptGateRule, 0, len(src.Rules))
+		for _, elem := range src.Rules {
+			var _elem *ExptGateRule
 			if elem != nil {
-				_elem = &AggregatorResult_{}
+				_elem = &ExptGateRule{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.WeightedResults = append(p.WeightedResults, _elem)
+			p.Rules = append(p.Rules, _elem)
 		}
 	}
 
 	return nil
 }
 
-func (p *EvalTargetAggregateResult_) FastRead(buf []byte) (int, error) {
+func (p *ExptGateRuleVerdict) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12602,7 +16764,7 @@ func (p *EvalTargetAggregateResult_) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -12616,7 +16778,7 @@ func (p *EvalTargetAggregateResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
@@ -12629,37 +16791,9 @@ func (p *EvalTargetAggregateResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField5(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField6(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
-		case 7:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField7(buf[offset:])
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -12671,9 +16805,9 @@ func (p *EvalTargetAggregateResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 8:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField8(buf[offset:])
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
@@ -12698,410 +16832,208 @@ func (p *EvalTargetAggregateResult_) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvalTargetAggregateResult_[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGateRuleVerdict[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *EvalTargetAggregateResult_) FastReadField1(buf []byte) (int, error) {
+func (p *ExptGateRuleVerdict) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_field := NewExptGateRule()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
 	}
-	p.TargetID = _field
+	p.Rule = _field
 	return offset, nil
 }
 
-func (p *EvalTargetAggregateResult_) FastReadField2(buf []byte) (int, error) {
+func (p *ExptGateRuleVerdict) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *ExptGateStatus
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.TargetVersionID = _field
-	return offset, nil
-}
-
-func (p *EvalTargetAggregateResult_) FastReadField5(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.Latency = _field
-	return offset, nil
-}
-
-func (p *EvalTargetAggregateResult_) FastReadField6(buf []byte) (int, error) {
-	offset := 0
-
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
-		return offset, err
-	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.InputTokens = _field
+	p.Status = _field
 	return offset, nil
 }
 
-func (p *EvalTargetAggregateResult_) FastReadField7(buf []byte) (int, error) {
+func (p *ExptGateRuleVerdict) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.OutputTokens = _field
+	p.ActualValue = _field
 	return offset, nil
 }
 
-func (p *EvalTargetAggregateResult_) FastReadField8(buf []byte) (int, error) {
+func (p *ExptGateRuleVerdict) FastReadField4(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
-
-		_field = append(_field, _elem)
-	}
-	p.TotalTokens = _field
+	p.Message = _field
 	return offset, nil
 }
 
-func (p *EvalTargetAggregateResult_) FastWrite(buf []byte) int {
+func (p *ExptGateRuleVerdict) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvalTargetAggregateResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRuleVerdict) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField5(buf[offset:], w)
-		offset += p.fastWriteField6(buf[offset:], w)
-		offset += p.fastWriteField7(buf[offset:], w)
-		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvalTargetAggregateResult_) BLength() int {
+func (p *ExptGateRuleVerdict) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
-		l += p.field5Length()
-		l += p.field6Length()
-		l += p.field7Length()
-		l += p.field8Length()
+		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvalTargetAggregateResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTargetID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.TargetID)
-	}
-	return offset
-}
-
-func (p *EvalTargetAggregateResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetTargetVersionID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.TargetVersionID)
-	}
-	return offset
-}
-
-func (p *EvalTargetAggregateResult_) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetLatency() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.Latency {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
-	return offset
-}
-
-func (p *EvalTargetAggregateResult_) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRuleVerdict) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetInputTokens() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 6)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.InputTokens {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetRule() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Rule.FastWriteNocopy(buf[offset:], w)
 	}
 	return offset
 }
 
-func (p *EvalTargetAggregateResult_) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRuleVerdict) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetOutputTokens() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 7)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.OutputTokens {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Status)
 	}
 	return offset
 }
 
-func (p *EvalTargetAggregateResult_) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateRuleVerdict) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTotalTokens() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 8)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.TotalTokens {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	if p.IsSetActualValue() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 3)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.ActualValue)
 	}
 	return offset
 }
 
-func (p *EvalTargetAggregateResult_) field1Length() int {
-	l := 0
-	if p.IsSetTargetID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
-	return l
-}
-
-func (p *EvalTargetAggregateResult_) field2Length() int {
-	l := 0
-	if p.IsSetTargetVersionID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+func (p *ExptGateRuleVerdict) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetMessage() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Message)
 	}
-	return l
+	return offset
 }
 
-func (p *EvalTargetAggregateResult_) field5Length() int {
+func (p *ExptGateRuleVerdict) field1Length() int {
 	l := 0
-	if p.IsSetLatency() {
+	if p.IsSetRule() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.Latency {
-			_ = v
-			l += v.BLength()
-		}
+		l += p.Rule.BLength()
 	}
 	return l
 }
 
-func (p *EvalTargetAggregateResult_) field6Length() int {
+func (p *ExptGateRuleVerdict) field2Length() int {
 	l := 0
-	if p.IsSetInputTokens() {
+	if p.IsSetStatus() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.InputTokens {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.Status)
 	}
 	return l
 }
 
-func (p *EvalTargetAggregateResult_) field7Length() int {
+func (p *ExptGateRuleVerdict) field3Length() int {
 	l := 0
-	if p.IsSetOutputTokens() {
+	if p.IsSetActualValue() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.OutputTokens {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.DoubleLength()
 	}
 	return l
 }
 
-func (p *EvalTargetAggregateResult_) field8Length() int {
+func (p *ExptGateRuleVerdict) field4Length() int {
 	l := 0
-	if p.IsSetTotalTokens() {
+	if p.IsSetMessage() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.TotalTokens {
-			_ = v
-			l += v.BLength()
-		}
+		l += thrift.Binary.StringLengthNocopy(*p.Message)
 	}
 	return l
 }
 
-func (p *EvalTargetAggregateResult_) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvalTargetAggregateResult_)
+func (p *ExptGateRuleVerdict) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptGateRuleVerdict)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.TargetID != nil {
-		tmp := *src.TargetID
-		p.TargetID = &tmp
-	}
-
-	if src.TargetVersionID != nil {
-		tmp := *src.TargetVersionID
-		p.TargetVersionID = &tmp
-	}
-
-	if src.Latency != nil {
-		p.Latency = make([]*AggregatorResult_, 0, len(src.Latency))
-		for _, elem := range src.Latency {
-			var _elem *AggregatorResult_
-			if elem != nil {
-				_elem = &AggregatorResult_{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.Latency = append(p.Latency, _elem)
+	var _rule *ExptGateRule
+	if src.Rule != nil {
+		_rule = &ExptGateRule{}
+		if err := _rule.DeepCopy(src.Rule); err != nil {
+			return err
 		}
 	}
+	p.Rule = _rule
 
-	if src.InputTokens != nil {
-		p.InputTokens = make([]*AggregatorResult_, 0, len(src.InputTokens))
-		for _, elem := range src.InputTokens {
-			var _elem *AggregatorResult_
-			if elem != nil {
-				_elem = &AggregatorResult_{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.InputTokens = append(p.InputTokens, _elem)
-		}
+	if src.Status != nil {
+		tmp := *src.Status
+		p.Status = &tmp
 	}
 
-	if src.OutputTokens != nil {
-		p.OutputTokens = make([]*AggregatorResult_, 0, len(src.OutputTokens))
-		for _, elem := range src.OutputTokens {
-			var _elem *AggregatorResult_
-			if elem != nil {
-				_elem = &AggregatorResult_{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.OutputTokens = append(p.OutputTokens, _elem)
-		}
+	if src.ActualValue != nil {
+		tmp := *src.ActualValue
+		p.ActualValue = &tmp
 	}
 
-	if src.TotalTokens != nil {
-		p.TotalTokens = make([]*AggregatorResult_, 0, len(src.TotalTokens))
-		for _, elem := range src.TotalTokens {
-			var _elem *AggregatorResult_
-			if elem != nil {
-				_elem = &AggregatorResult_{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
-
-			p.TotalTokens = append(p.TotalTokens, _elem)
+	if src.Message != nil {
+		var tmp string
+		if *src.Message != "" {
+			tmp = kutils.StringDeepCopy(*src.Message)
 		}
+		p.Message = &tmp
 	}
 
 	return nil
 }
 
-func (p *EvaluatorAggregateResult_) FastRead(buf []byte) (int, error) {
+func (p *ExptGateVerdict) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetEvaluatorVersionID bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -13113,13 +17045,12 @@ func (p *EvaluatorAggregateResult_) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorVersionID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -13142,7 +17073,7 @@ func (p *EvaluatorAggregateResult_) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
@@ -13155,20 +17086,6 @@ func (p *EvaluatorAggregateResult_) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				l, err = p.FastReadField4(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -13178,36 +17095,30 @@ func (p *EvaluatorAggregateResult_) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetEvaluatorVersionID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorAggregateResult_[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExptGateVerdict[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_EvaluatorAggregateResult_[fieldId]))
 }
 
-func (p *EvaluatorAggregateResult_) FastReadField1(buf []byte) (int, error) {
+func (p *ExptGateVerdict) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field *ExptGateStatus
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.EvaluatorVersionID = _field
+	p.Status = _field
 	return offset, nil
 }
 
-func (p *EvaluatorAggregateResult_) FastReadField2(buf []byte) (int, error) {
+func (p *ExptGateVerdict) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -13215,8 +17126,8 @@ func (p *EvaluatorAggregateResult_) FastReadField2(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
+	_field := make([]*ExptGateRuleVerdict, 0, size)
+	values := make([]ExptGateRuleVerdict, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -13228,81 +17139,67 @@ func (p *EvaluatorAggregateResult_) FastReadField2(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.AggregatorResults = _field
-	return offset, nil
-}
-
-func (p *EvaluatorAggregateResult_) FastReadField3(buf []byte) (int, error) {
-	offset := 0
-
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
-		return offset, err
-	} else {
-		offset += l
-		_field = &v
-	}
-	p.Name = _field
+	p.RuleVerdicts = _field
 	return offset, nil
 }
 
-func (p *EvaluatorAggregateResult_) FastReadField4(buf []byte) (int, error) {
+func (p *ExptGateVerdict) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
 		_field = &v
 	}
-	p.Version = _field
+	p.EvaluatedAt = _field
 	return offset, nil
 }
 
-func (p *EvaluatorAggregateResult_) FastWrite(buf []byte) int {
+func (p *ExptGateVerdict) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EvaluatorAggregateResult_) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateVerdict) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
-		offset += p.fastWriteField3(buf[offset:], w)
-		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EvaluatorAggregateResult_) BLength() int {
+func (p *ExptGateVerdict) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
-		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *EvaluatorAggregateResult_) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateVerdict) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
+	if p.IsSetStatus() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Status)
+	}
 	return offset
 }
 
-func (p *EvaluatorAggregateResult_) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateVerdict) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetAggregatorResults() {
+	if p.IsSetRuleVerdicts() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.AggregatorResults {
+		for _, v := range p.RuleVerdicts {
 			length++
 			offset += v.FastWriteNocopy(buf[offset:], w)
 		}
@@ -13311,37 +17208,30 @@ func (p *EvaluatorAggregateResult_) fastWriteField2(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *EvaluatorAggregateResult_) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
-	offset := 0
-	if p.IsSetName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 3)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Name)
-	}
-	return offset
-}
-
-func (p *EvaluatorAggregateResult_) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExptGateVerdict) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetVersion() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.Version)
+	if p.IsSetEvaluatedAt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EvaluatedAt)
 	}
 	return offset
 }
 
-func (p *EvaluatorAggregateResult_) field1Length() int {
+func (p *ExptGateVerdict) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetStatus() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.Status)
+	}
 	return l
 }
 
-func (p *EvaluatorAggregateResult_) field2Length() int {
+func (p *ExptGateVerdict) field2Length() int {
 	l := 0
-	if p.IsSetAggregatorResults() {
+	if p.IsSetRuleVerdicts() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.AggregatorResults {
+		for _, v := range p.RuleVerdicts {
 			_ = v
 			l += v.BLength()
 		}
@@ -13349,61 +17239,44 @@ func (p *EvaluatorAggregateResult_) field2Length() int {
 	return l
 }
 
-func (p *EvaluatorAggregateResult_) field3Length() int {
-	l := 0
-	if p.IsSetName() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Name)
-	}
-	return l
-}
-
-func (p *EvaluatorAggregateResult_) field4Length() int {
+func (p *ExptGateVerdict) field3Length() int {
 	l := 0
-	if p.IsSetVersion() {
+	if p.IsSetEvaluatedAt() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.Version)
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *EvaluatorAggregateResult_) DeepCopy(s interface{}) error {
-	src, ok := s.(*EvaluatorAggregateResult_)
+func (p *ExptGateVerdict) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExptGateVerdict)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.EvaluatorVersionID = src.EvaluatorVersionID
+	if src.Status != nil {
+		tmp := *src.Status
+		p.Status = &tmp
+	}
 
-	if src.AggregatorResults != nil {
-		p.AggregatorResults = make([]*AggregatorResult_, 0, len(src.AggregatorResults))
-		for _, elem := range src.AggregatorResults {
-			var _elem *AggregatorResult_
+	if src.RuleVerdicts != nil {
+		p.RuleVerdicts = make([]*ExptGateRuleVerdict, 0, len(src.RuleVerdicts))
+		for _, elem := range src.RuleVerdicts {
+			var _elem *ExptGateRuleVerdict
 			if elem != nil {
-				_elem = &AggregatorResult_{}
+				_elem = &ExptGateRuleVerdict{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.AggregatorResults = append(p.AggregatorResults, _elem)
-		}
-	}
-
-	if src.Name != nil {
-		var tmp string
-		if *src.Name != "" {
-			tmp = kutils.StringDeepCopy(*src.Name)
+			p.RuleVerdicts = append(p.RuleVerdicts, _elem)
 		}
-		p.Name = &tmp
 	}
 
-	if src.Version != nil {
-		var tmp string
-		if *src.Version != "" {
-			tmp = kutils.StringDeepCopy(*src.Version)
-		}
-		p.Version = &tmp
+	if src.EvaluatedAt != nil {
+		tmp := *src.EvaluatedAt
+		p.EvaluatedAt = &tmp
 	}
 
 	return nil
@@ -3437,6 +3437,8 @@ type EvaluatorResult_ struct {
 	Score      *float64    `thrift:"score,1,optional" frugal:"1,optional,double" form:"score" json:"score,omitempty" query:"score"`
 	Reasoning  *string     `thrift:"reasoning,2,optional" frugal:"2,optional,string" form:"reasoning" json:"reasoning,omitempty" query:"reasoning"`
 	Correction *Correction `thrift:"correction,3,optional" frugal:"3,optional,Correction" form:"correction" json:"correction,omitempty" query:"correction"`
+	// 多维度评估结果
+	Dimensions []*EvaluatorDimensionResult_ `thrift:"dimensions,4,optional" frugal:"4,optional,list<EvaluatorDimensionResult_>" form:"dimensions" json:"dimensions,omitempty" query:"dimensions"`
 }
 
 func NewEvaluatorResult_() *EvaluatorResult_ {
@@ -3481,6 +3483,18 @@ func (p *EvaluatorResult_) GetCorrection() (v *Correction) {
 	}
 	return p.Correction
 }
+
+var EvaluatorResult__Dimensions_DEFAULT []*EvaluatorDimensionResult_
+
+func (p *EvaluatorResult_) GetDimensions() (v []*EvaluatorDimensionResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDimensions() {
+		return EvaluatorResult__Dimensions_DEFAULT
+	}
+	return p.Dimensions
+}
 func (p *EvaluatorResult_) SetScore(val *float64) {
 	p.Score = val
 }
@@ -3490,11 +3504,15 @@ func (p *EvaluatorResult_) SetReasoning(val *string) {
 func (p *EvaluatorResult_) SetCorrection(val *Correction) {
 	p.Correction = val
 }
+func (p *EvaluatorResult_) SetDimensions(val []*EvaluatorDimensionResult_) {
+	p.Dimensions = val
+}
 
 var fieldIDToName_EvaluatorResult_ = map[int16]string{
 	1: "score",
 	2: "reasoning",
 	3: "correction",
+	4: "dimensions",
 }
 
 func (p *EvaluatorResult_) IsSetScore() bool {
@@ -3509,6 +3527,10 @@ func (p *EvaluatorResult_) IsSetCorrection() bool {
 	return p.Correction != nil
 }
 
+func (p *EvaluatorResult_) IsSetDimensions() bool {
+	return p.Dimensions != nil
+}
+
 func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3551,6 +3573,14 @@ func (p *EvaluatorResult_) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3610,6 +3640,29 @@ func (p *EvaluatorResult_) ReadField3(iprot thrift.TProtocol) error {
 	p.Correction = _field
 	return nil
 }
+func (p *EvaluatorResult_) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluatorDimensionResult_, 0, size)
+	values := make([]EvaluatorDimensionResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Dimensions = _field
+	return nil
+}
 
 func (p *EvaluatorResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -3629,6 +3682,10 @@ func (p *EvaluatorResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3701,6 +3758,32 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *EvaluatorResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDimensions() {
+		if err = oprot.WriteFieldBegin("dimensions", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Dimensions)); err != nil {
+			return err
+		}
+		for _, v := range p.Dimensions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
 func (p *EvaluatorResult_) String() string {
 	if p == nil {
@@ -3725,6 +3808,9 @@ func (p *EvaluatorResult_) DeepEqual(ano *EvaluatorResult_) bool {
 	if !p.Field3DeepEqual(ano.Correction) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Dimensions) {
+		return false
+	}
 	return true
 }
 
@@ -3759,6 +3845,432 @@ func (p *EvaluatorResult_) Field3DeepEqual(src *Correction) bool {
 	}
 	return true
 }
+func (p *EvaluatorResult_) Field4DeepEqual(src []*EvaluatorDimensionResult_) bool {
+
+	if len(p.Dimensions) != len(src) {
+		return false
+	}
+	for i, v := range p.Dimensions {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 评估器单个维度的评估结果
+type EvaluatorDimensionResult_ struct {
+	Name      *string  `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	Score     *float64 `thrift:"score,2,optional" frugal:"2,optional,double" form:"score" json:"score,omitempty" query:"score"`
+	Label     *string  `thrift:"label,3,optional" frugal:"3,optional,string" form:"label" json:"label,omitempty" query:"label"`
+	Reasoning *string  `thrift:"reasoning,4,optional" frugal:"4,optional,string" form:"reasoning" json:"reasoning,omitempty" query:"reasoning"`
+}
+
+func NewEvaluatorDimensionResult_() *EvaluatorDimensionResult_ {
+	return &EvaluatorDimensionResult_{}
+}
+
+func (p *EvaluatorDimensionResult_) InitDefault() {
+}
+
+var EvaluatorDimensionResult__Name_DEFAULT string
+
+func (p *EvaluatorDimensionResult_) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return EvaluatorDimensionResult__Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var EvaluatorDimensionResult__Score_DEFAULT float64
+
+func (p *EvaluatorDimensionResult_) GetScore() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScore() {
+		return EvaluatorDimensionResult__Score_DEFAULT
+	}
+	return *p.Score
+}
+
+var EvaluatorDimensionResult__Label_DEFAULT string
+
+func (p *EvaluatorDimensionResult_) GetLabel() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabel() {
+		return EvaluatorDimensionResult__Label_DEFAULT
+	}
+	return *p.Label
+}
+
+var EvaluatorDimensionResult__Reasoning_DEFAULT string
+
+func (p *EvaluatorDimensionResult_) GetReasoning() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReasoning() {
+		return EvaluatorDimensionResult__Reasoning_DEFAULT
+	}
+	return *p.Reasoning
+}
+func (p *EvaluatorDimensionResult_) SetName(val *string) {
+	p.Name = val
+}
+func (p *EvaluatorDimensionResult_) SetScore(val *float64) {
+	p.Score = val
+}
+func (p *EvaluatorDimensionResult_) SetLabel(val *string) {
+	p.Label = val
+}
+func (p *EvaluatorDimensionResult_) SetReasoning(val *string) {
+	p.Reasoning = val
+}
+
+var fieldIDToName_EvaluatorDimensionResult_ = map[int16]string{
+	1: "name",
+	2: "score",
+	3: "label",
+	4: "reasoning",
+}
+
+func (p *EvaluatorDimensionResult_) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *EvaluatorDimensionResult_) IsSetScore() bool {
+	return p.Score != nil
+}
+
+func (p *EvaluatorDimensionResult_) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *EvaluatorDimensionResult_) IsSetReasoning() bool {
+	return p.Reasoning != nil
+}
+
+func (p *EvaluatorDimensionResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorDimensionResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvaluatorDimensionResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *EvaluatorDimensionResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Score = _field
+	return nil
+}
+func (p *EvaluatorDimensionResult_) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Label = _field
+	return nil
+}
+func (p *EvaluatorDimensionResult_) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Reasoning = _field
+	return nil
+}
+
+func (p *EvaluatorDimensionResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvaluatorDimensionResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvaluatorDimensionResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvaluatorDimensionResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScore() {
+		if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Score); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvaluatorDimensionResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Label); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *EvaluatorDimensionResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReasoning() {
+		if err = oprot.WriteFieldBegin("reasoning", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Reasoning); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *EvaluatorDimensionResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorDimensionResult_(%+v)", *p)
+
+}
+
+func (p *EvaluatorDimensionResult_) DeepEqual(ano *EvaluatorDimensionResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Score) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Label) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Reasoning) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorDimensionResult_) Field1DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorDimensionResult_) Field2DeepEqual(src *float64) bool {
+
+	if p.Score == src {
+		return true
+	} else if p.Score == nil || src == nil {
+		return false
+	}
+	if *p.Score != *src {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorDimensionResult_) Field3DeepEqual(src *string) bool {
+
+	if p.Label == src {
+		return true
+	} else if p.Label == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Label, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorDimensionResult_) Field4DeepEqual(src *string) bool {
+
+	if p.Reasoning == src {
+		return true
+	} else if p.Reasoning == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Reasoning, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type Correction struct {
 	Score     *float64 `thrift:"score,1,optional" frugal:"1,optional,double" form:"score" json:"score,omitempty" query:"score"`
@@ -100,6 +100,9 @@ func (p *EvaluatorResult_) IsValid() error {
 	}
 	return nil
 }
+func (p *EvaluatorDimensionResult_) IsValid() error {
+	return nil
+}
 func (p *Correction) IsValid() error {
 	return nil
 }
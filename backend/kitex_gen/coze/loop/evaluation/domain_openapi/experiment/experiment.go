@@ -65,6 +65,26 @@ const (
 
 	TurnRunStateTerminal = "terminal"
 
+	ExperimentGateRuleTypeEvaluatorScore = "evaluator_score"
+
+	ExperimentGateRuleTypeFailedTurnRate = "failed_turn_rate"
+
+	ExperimentGateRuleTypeNoRegression = "no_regression"
+
+	ExperimentGateOperatorGTE = "gte"
+
+	ExperimentGateOperatorGT = "gt"
+
+	ExperimentGateOperatorLTE = "lte"
+
+	ExperimentGateOperatorLT = "lt"
+
+	ExperimentGateStatusPass = "pass"
+
+	ExperimentGateStatusFail = "fail"
+
+	ExperimentGateStatusError = "error"
+
 	ColumnEvalTargetNameActualOutput = "actual_output"
 
 	ColumnEvalTargetNameTrajectory = "trajectory"
@@ -144,6 +164,16 @@ type ItemRunState = string
 
 type TurnRunState = string
 
+// 评测实验
+// 门禁规则类型
+type ExperimentGateRuleType = string
+
+// 门禁比较符
+type ExperimentGateOperator = string
+
+// 门禁结论
+type ExperimentGateStatus = string
+
 // ===============================
 // 筛选能力结构（与 domain/expt.thrift 结构一致）
 // ===============================
@@ -1339,6 +1369,8 @@ type EvaluatorAggregateResult_ struct {
 	Name               *string              `thrift:"name,3,optional" frugal:"3,optional,string" form:"name" json:"name,omitempty" query:"name"`
 	Version            *string              `thrift:"version,4,optional" frugal:"4,optional,string" form:"version" json:"version,omitempty" query:"version"`
 	AggregatorResults  []*AggregatorResult_ `thrift:"aggregator_results,20,optional" frugal:"20,optional,list<AggregatorResult_>" form:"aggregator_results" json:"aggregator_results,omitempty" query:"aggregator_results"`
+	// 多维度评估器各维度的聚合结果
+	DimensionResults []*EvaluatorDimensionAggregateResult_ `thrift:"dimension_results,21,optional" frugal:"21,optional,list<EvaluatorDimensionAggregateResult_>" form:"dimension_results" json:"dimension_results,omitempty" query:"dimension_results"`
 }
 
 func NewEvaluatorAggregateResult_() *EvaluatorAggregateResult_ {
@@ -1407,6 +1439,18 @@ func (p *EvaluatorAggregateResult_) GetAggregatorResults() (v []*AggregatorResul
 	}
 	return p.AggregatorResults
 }
+
+var EvaluatorAggregateResult__DimensionResults_DEFAULT []*EvaluatorDimensionAggregateResult_
+
+func (p *EvaluatorAggregateResult_) GetDimensionResults() (v []*EvaluatorDimensionAggregateResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDimensionResults() {
+		return EvaluatorAggregateResult__DimensionResults_DEFAULT
+	}
+	return p.DimensionResults
+}
 func (p *EvaluatorAggregateResult_) SetEvaluatorID(val *int64) {
 	p.EvaluatorID = val
 }
@@ -1422,6 +1466,9 @@ func (p *EvaluatorAggregateResult_) SetVersion(val *string) {
 func (p *EvaluatorAggregateResult_) SetAggregatorResults(val []*AggregatorResult_) {
 	p.AggregatorResults = val
 }
+func (p *EvaluatorAggregateResult_) SetDimensionResults(val []*EvaluatorDimensionAggregateResult_) {
+	p.DimensionResults = val
+}
 
 var fieldIDToName_EvaluatorAggregateResult_ = map[int16]string{
 	1:  "evaluator_id",
@@ -1429,6 +1476,7 @@ var fieldIDToName_EvaluatorAggregateResult_ = map[int16]string{
 	3:  "name",
 	4:  "version",
 	20: "aggregator_results",
+	21: "dimension_results",
 }
 
 func (p *EvaluatorAggregateResult_) IsSetEvaluatorID() bool {
@@ -1451,6 +1499,10 @@ func (p *EvaluatorAggregateResult_) IsSetAggregatorResults() bool {
 	return p.AggregatorResults != nil
 }
 
+func (p *EvaluatorAggregateResult_) IsSetDimensionResults() bool {
+	return p.DimensionResults != nil
+}
+
 func (p *EvaluatorAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1509,6 +1561,14 @@ func (p *EvaluatorAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 21:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField21(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1605,6 +1665,29 @@ func (p *EvaluatorAggregateResult_) ReadField20(iprot thrift.TProtocol) error {
 	p.AggregatorResults = _field
 	return nil
 }
+func (p *EvaluatorAggregateResult_) ReadField21(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluatorDimensionAggregateResult_, 0, size)
+	values := make([]EvaluatorDimensionAggregateResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.DimensionResults = _field
+	return nil
+}
 
 func (p *EvaluatorAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1632,6 +1715,10 @@ func (p *EvaluatorAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 20
 			goto WriteFieldError
 		}
+		if err = p.writeField21(oprot); err != nil {
+			fieldId = 21
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1748,6 +1835,32 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 20 end error: ", p), err)
 }
+func (p *EvaluatorAggregateResult_) writeField21(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDimensionResults() {
+		if err = oprot.WriteFieldBegin("dimension_results", thrift.LIST, 21); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.DimensionResults)); err != nil {
+			return err
+		}
+		for _, v := range p.DimensionResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
+}
 
 func (p *EvaluatorAggregateResult_) String() string {
 	if p == nil {
@@ -1778,6 +1891,9 @@ func (p *EvaluatorAggregateResult_) DeepEqual(ano *EvaluatorAggregateResult_) bo
 	if !p.Field20DeepEqual(ano.AggregatorResults) {
 		return false
 	}
+	if !p.Field21DeepEqual(ano.DimensionResults) {
+		return false
+	}
 	return true
 }
 
@@ -1842,147 +1958,77 @@ func (p *EvaluatorAggregateResult_) Field20DeepEqual(src []*AggregatorResult_) b
 	}
 	return true
 }
+func (p *EvaluatorAggregateResult_) Field21DeepEqual(src []*EvaluatorDimensionAggregateResult_) bool {
 
-type EvalTargetAggregateResult_ struct {
-	TargetID        *int64               `thrift:"target_id,1,optional" frugal:"1,optional,i64" form:"target_id" json:"target_id,string,omitempty" query:"target_id"`
-	TargetVersionID *int64               `thrift:"target_version_id,2,optional" frugal:"2,optional,i64" form:"target_version_id" json:"target_version_id,string,omitempty" query:"target_version_id"`
-	Latency         []*AggregatorResult_ `thrift:"latency,5,optional" frugal:"5,optional,list<AggregatorResult_>" form:"latency" json:"latency,omitempty" query:"latency"`
-	InputTokens     []*AggregatorResult_ `thrift:"input_tokens,6,optional" frugal:"6,optional,list<AggregatorResult_>" form:"input_tokens" json:"input_tokens,omitempty" query:"input_tokens"`
-	OutputTokens    []*AggregatorResult_ `thrift:"output_tokens,7,optional" frugal:"7,optional,list<AggregatorResult_>" form:"output_tokens" json:"output_tokens,omitempty" query:"output_tokens"`
-	TotalTokens     []*AggregatorResult_ `thrift:"total_tokens,8,optional" frugal:"8,optional,list<AggregatorResult_>" form:"total_tokens" json:"total_tokens,omitempty" query:"total_tokens"`
-}
-
-func NewEvalTargetAggregateResult_() *EvalTargetAggregateResult_ {
-	return &EvalTargetAggregateResult_{}
-}
-
-func (p *EvalTargetAggregateResult_) InitDefault() {
-}
-
-var EvalTargetAggregateResult__TargetID_DEFAULT int64
-
-func (p *EvalTargetAggregateResult_) GetTargetID() (v int64) {
-	if p == nil {
-		return
+	if len(p.DimensionResults) != len(src) {
+		return false
 	}
-	if !p.IsSetTargetID() {
-		return EvalTargetAggregateResult__TargetID_DEFAULT
+	for i, v := range p.DimensionResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
-	return *p.TargetID
+	return true
 }
 
-var EvalTargetAggregateResult__TargetVersionID_DEFAULT int64
-
-func (p *EvalTargetAggregateResult_) GetTargetVersionID() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetTargetVersionID() {
-		return EvalTargetAggregateResult__TargetVersionID_DEFAULT
-	}
-	return *p.TargetVersionID
+// 评估器单个维度的聚合结果
+type EvaluatorDimensionAggregateResult_ struct {
+	Name              *string              `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	AggregatorResults []*AggregatorResult_ `thrift:"aggregator_results,2,optional" frugal:"2,optional,list<AggregatorResult_>" form:"aggregator_results" json:"aggregator_results,omitempty" query:"aggregator_results"`
 }
 
-var EvalTargetAggregateResult__Latency_DEFAULT []*AggregatorResult_
-
-func (p *EvalTargetAggregateResult_) GetLatency() (v []*AggregatorResult_) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetLatency() {
-		return EvalTargetAggregateResult__Latency_DEFAULT
-	}
-	return p.Latency
+func NewEvaluatorDimensionAggregateResult_() *EvaluatorDimensionAggregateResult_ {
+	return &EvaluatorDimensionAggregateResult_{}
 }
 
-var EvalTargetAggregateResult__InputTokens_DEFAULT []*AggregatorResult_
-
-func (p *EvalTargetAggregateResult_) GetInputTokens() (v []*AggregatorResult_) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetInputTokens() {
-		return EvalTargetAggregateResult__InputTokens_DEFAULT
-	}
-	return p.InputTokens
+func (p *EvaluatorDimensionAggregateResult_) InitDefault() {
 }
 
-var EvalTargetAggregateResult__OutputTokens_DEFAULT []*AggregatorResult_
+var EvaluatorDimensionAggregateResult__Name_DEFAULT string
 
-func (p *EvalTargetAggregateResult_) GetOutputTokens() (v []*AggregatorResult_) {
+func (p *EvaluatorDimensionAggregateResult_) GetName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetOutputTokens() {
-		return EvalTargetAggregateResult__OutputTokens_DEFAULT
+	if !p.IsSetName() {
+		return EvaluatorDimensionAggregateResult__Name_DEFAULT
 	}
-	return p.OutputTokens
+	return *p.Name
 }
 
-var EvalTargetAggregateResult__TotalTokens_DEFAULT []*AggregatorResult_
+var EvaluatorDimensionAggregateResult__AggregatorResults_DEFAULT []*AggregatorResult_
 
-func (p *EvalTargetAggregateResult_) GetTotalTokens() (v []*AggregatorResult_) {
+func (p *EvaluatorDimensionAggregateResult_) GetAggregatorResults() (v []*AggregatorResult_) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTotalTokens() {
-		return EvalTargetAggregateResult__TotalTokens_DEFAULT
+	if !p.IsSetAggregatorResults() {
+		return EvaluatorDimensionAggregateResult__AggregatorResults_DEFAULT
 	}
-	return p.TotalTokens
-}
-func (p *EvalTargetAggregateResult_) SetTargetID(val *int64) {
-	p.TargetID = val
-}
-func (p *EvalTargetAggregateResult_) SetTargetVersionID(val *int64) {
-	p.TargetVersionID = val
-}
-func (p *EvalTargetAggregateResult_) SetLatency(val []*AggregatorResult_) {
-	p.Latency = val
-}
-func (p *EvalTargetAggregateResult_) SetInputTokens(val []*AggregatorResult_) {
-	p.InputTokens = val
-}
-func (p *EvalTargetAggregateResult_) SetOutputTokens(val []*AggregatorResult_) {
-	p.OutputTokens = val
-}
-func (p *EvalTargetAggregateResult_) SetTotalTokens(val []*AggregatorResult_) {
-	p.TotalTokens = val
-}
-
-var fieldIDToName_EvalTargetAggregateResult_ = map[int16]string{
-	1: "target_id",
-	2: "target_version_id",
-	5: "latency",
-	6: "input_tokens",
-	7: "output_tokens",
-	8: "total_tokens",
-}
-
-func (p *EvalTargetAggregateResult_) IsSetTargetID() bool {
-	return p.TargetID != nil
+	return p.AggregatorResults
 }
-
-func (p *EvalTargetAggregateResult_) IsSetTargetVersionID() bool {
-	return p.TargetVersionID != nil
+func (p *EvaluatorDimensionAggregateResult_) SetName(val *string) {
+	p.Name = val
 }
-
-func (p *EvalTargetAggregateResult_) IsSetLatency() bool {
-	return p.Latency != nil
+func (p *EvaluatorDimensionAggregateResult_) SetAggregatorResults(val []*AggregatorResult_) {
+	p.AggregatorResults = val
 }
 
-func (p *EvalTargetAggregateResult_) IsSetInputTokens() bool {
-	return p.InputTokens != nil
+var fieldIDToName_EvaluatorDimensionAggregateResult_ = map[int16]string{
+	1: "name",
+	2: "aggregator_results",
 }
 
-func (p *EvalTargetAggregateResult_) IsSetOutputTokens() bool {
-	return p.OutputTokens != nil
+func (p *EvaluatorDimensionAggregateResult_) IsSetName() bool {
+	return p.Name != nil
 }
 
-func (p *EvalTargetAggregateResult_) IsSetTotalTokens() bool {
-	return p.TotalTokens != nil
+func (p *EvaluatorDimensionAggregateResult_) IsSetAggregatorResults() bool {
+	return p.AggregatorResults != nil
 }
 
-func (p *EvalTargetAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *EvaluatorDimensionAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2001,7 +2047,7 @@ func (p *EvalTargetAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2009,40 +2055,8 @@ func (p *EvalTargetAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
 			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField8(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -2067,7 +2081,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvalTargetAggregateResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvaluatorDimensionAggregateResult_[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2077,29 +2091,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *EvalTargetAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.TargetID = _field
-	return nil
-}
-func (p *EvalTargetAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
+func (p *EvaluatorDimensionAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.TargetVersionID = _field
+	p.Name = _field
 	return nil
 }
-func (p *EvalTargetAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
+func (p *EvaluatorDimensionAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -2119,82 +2122,13 @@ func (p *EvalTargetAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Latency = _field
-	return nil
-}
-func (p *EvalTargetAggregateResult_) ReadField6(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.InputTokens = _field
-	return nil
-}
-func (p *EvalTargetAggregateResult_) ReadField7(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.OutputTokens = _field
-	return nil
-}
-func (p *EvalTargetAggregateResult_) ReadField8(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*AggregatorResult_, 0, size)
-	values := make([]AggregatorResult_, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.TotalTokens = _field
+	p.AggregatorResults = _field
 	return nil
 }
 
-func (p *EvalTargetAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
+func (p *EvaluatorDimensionAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EvalTargetAggregateResult"); err != nil {
+	if err = oprot.WriteStructBegin("EvaluatorDimensionAggregateResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2206,22 +2140,6 @@ func (p *EvalTargetAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2240,12 +2158,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EvalTargetAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetID() {
-		if err = oprot.WriteFieldBegin("target_id", thrift.I64, 1); err != nil {
+func (p *EvaluatorDimensionAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TargetID); err != nil {
+		if err := oprot.WriteString(*p.Name); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2258,12 +2176,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvalTargetAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTargetVersionID() {
-		if err = oprot.WriteFieldBegin("target_version_id", thrift.I64, 2); err != nil {
+func (p *EvaluatorDimensionAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAggregatorResults() {
+		if err = oprot.WriteFieldBegin("aggregator_results", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TargetVersionID); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AggregatorResults)); err != nil {
+			return err
+		}
+		for _, v := range p.AggregatorResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2276,46 +2202,1709 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvalTargetAggregateResult_) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLatency() {
-		if err = oprot.WriteFieldBegin("latency", thrift.LIST, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Latency)); err != nil {
-			return err
-		}
-		for _, v := range p.Latency {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
+
+func (p *EvaluatorDimensionAggregateResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvaluatorDimensionAggregateResult_(%+v)", *p)
+
+}
+
+func (p *EvaluatorDimensionAggregateResult_) DeepEqual(ano *EvaluatorDimensionAggregateResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.AggregatorResults) {
+		return false
+	}
+	return true
+}
+
+func (p *EvaluatorDimensionAggregateResult_) Field1DeepEqual(src *string) bool {
+
+	if p.Name == src {
+		return true
+	} else if p.Name == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Name, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *EvaluatorDimensionAggregateResult_) Field2DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.AggregatorResults) != len(src) {
+		return false
+	}
+	for i, v := range p.AggregatorResults {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
+	}
+	return true
+}
+
+type EvalTargetAggregateResult_ struct {
+	TargetID        *int64               `thrift:"target_id,1,optional" frugal:"1,optional,i64" form:"target_id" json:"target_id,string,omitempty" query:"target_id"`
+	TargetVersionID *int64               `thrift:"target_version_id,2,optional" frugal:"2,optional,i64" form:"target_version_id" json:"target_version_id,string,omitempty" query:"target_version_id"`
+	Latency         []*AggregatorResult_ `thrift:"latency,5,optional" frugal:"5,optional,list<AggregatorResult_>" form:"latency" json:"latency,omitempty" query:"latency"`
+	InputTokens     []*AggregatorResult_ `thrift:"input_tokens,6,optional" frugal:"6,optional,list<AggregatorResult_>" form:"input_tokens" json:"input_tokens,omitempty" query:"input_tokens"`
+	OutputTokens    []*AggregatorResult_ `thrift:"output_tokens,7,optional" frugal:"7,optional,list<AggregatorResult_>" form:"output_tokens" json:"output_tokens,omitempty" query:"output_tokens"`
+	TotalTokens     []*AggregatorResult_ `thrift:"total_tokens,8,optional" frugal:"8,optional,list<AggregatorResult_>" form:"total_tokens" json:"total_tokens,omitempty" query:"total_tokens"`
+}
+
+func NewEvalTargetAggregateResult_() *EvalTargetAggregateResult_ {
+	return &EvalTargetAggregateResult_{}
+}
+
+func (p *EvalTargetAggregateResult_) InitDefault() {
+}
+
+var EvalTargetAggregateResult__TargetID_DEFAULT int64
+
+func (p *EvalTargetAggregateResult_) GetTargetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetID() {
+		return EvalTargetAggregateResult__TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var EvalTargetAggregateResult__TargetVersionID_DEFAULT int64
+
+func (p *EvalTargetAggregateResult_) GetTargetVersionID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTargetVersionID() {
+		return EvalTargetAggregateResult__TargetVersionID_DEFAULT
+	}
+	return *p.TargetVersionID
+}
+
+var EvalTargetAggregateResult__Latency_DEFAULT []*AggregatorResult_
+
+func (p *EvalTargetAggregateResult_) GetLatency() (v []*AggregatorResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLatency() {
+		return EvalTargetAggregateResult__Latency_DEFAULT
+	}
+	return p.Latency
+}
+
+var EvalTargetAggregateResult__InputTokens_DEFAULT []*AggregatorResult_
+
+func (p *EvalTargetAggregateResult_) GetInputTokens() (v []*AggregatorResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInputTokens() {
+		return EvalTargetAggregateResult__InputTokens_DEFAULT
+	}
+	return p.InputTokens
+}
+
+var EvalTargetAggregateResult__OutputTokens_DEFAULT []*AggregatorResult_
+
+func (p *EvalTargetAggregateResult_) GetOutputTokens() (v []*AggregatorResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputTokens() {
+		return EvalTargetAggregateResult__OutputTokens_DEFAULT
+	}
+	return p.OutputTokens
+}
+
+var EvalTargetAggregateResult__TotalTokens_DEFAULT []*AggregatorResult_
+
+func (p *EvalTargetAggregateResult_) GetTotalTokens() (v []*AggregatorResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTotalTokens() {
+		return EvalTargetAggregateResult__TotalTokens_DEFAULT
+	}
+	return p.TotalTokens
+}
+func (p *EvalTargetAggregateResult_) SetTargetID(val *int64) {
+	p.TargetID = val
+}
+func (p *EvalTargetAggregateResult_) SetTargetVersionID(val *int64) {
+	p.TargetVersionID = val
+}
+func (p *EvalTargetAggregateResult_) SetLatency(val []*AggregatorResult_) {
+	p.Latency = val
+}
+func (p *EvalTargetAggregateResult_) SetInputTokens(val []*AggregatorResult_) {
+	p.InputTokens = val
+}
+func (p *EvalTargetAggregateResult_) SetOutputTokens(val []*AggregatorResult_) {
+	p.OutputTokens = val
+}
+func (p *EvalTargetAggregateResult_) SetTotalTokens(val []*AggregatorResult_) {
+	p.TotalTokens = val
+}
+
+var fieldIDToName_EvalTargetAggregateResult_ = map[int16]string{
+	1: "target_id",
+	2: "target_version_id",
+	5: "latency",
+	6: "input_tokens",
+	7: "output_tokens",
+	8: "total_tokens",
+}
+
+func (p *EvalTargetAggregateResult_) IsSetTargetID() bool {
+	return p.TargetID != nil
+}
+
+func (p *EvalTargetAggregateResult_) IsSetTargetVersionID() bool {
+	return p.TargetVersionID != nil
+}
+
+func (p *EvalTargetAggregateResult_) IsSetLatency() bool {
+	return p.Latency != nil
+}
+
+func (p *EvalTargetAggregateResult_) IsSetInputTokens() bool {
+	return p.InputTokens != nil
+}
+
+func (p *EvalTargetAggregateResult_) IsSetOutputTokens() bool {
+	return p.OutputTokens != nil
+}
+
+func (p *EvalTargetAggregateResult_) IsSetTotalTokens() bool {
+	return p.TotalTokens != nil
+}
+
+func (p *EvalTargetAggregateResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EvalTargetAggregateResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EvalTargetAggregateResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetVersionID = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Latency = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.InputTokens = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.OutputTokens = _field
+	return nil
+}
+func (p *EvalTargetAggregateResult_) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AggregatorResult_, 0, size)
+	values := make([]AggregatorResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.TotalTokens = _field
+	return nil
+}
+
+func (p *EvalTargetAggregateResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EvalTargetAggregateResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EvalTargetAggregateResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetID() {
+		if err = oprot.WriteFieldBegin("target_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TargetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EvalTargetAggregateResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetVersionID() {
+		if err = oprot.WriteFieldBegin("target_version_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TargetVersionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *EvalTargetAggregateResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLatency() {
+		if err = oprot.WriteFieldBegin("latency", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Latency)); err != nil {
+			return err
+		}
+		for _, v := range p.Latency {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *EvalTargetAggregateResult_) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputTokens() {
+		if err = oprot.WriteFieldBegin("input_tokens", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InputTokens)); err != nil {
+			return err
+		}
+		for _, v := range p.InputTokens {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *EvalTargetAggregateResult_) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputTokens() {
+		if err = oprot.WriteFieldBegin("output_tokens", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OutputTokens)); err != nil {
+			return err
+		}
+		for _, v := range p.OutputTokens {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *EvalTargetAggregateResult_) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotalTokens() {
+		if err = oprot.WriteFieldBegin("total_tokens", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TotalTokens)); err != nil {
+			return err
+		}
+		for _, v := range p.TotalTokens {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *EvalTargetAggregateResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EvalTargetAggregateResult_(%+v)", *p)
+
+}
+
+func (p *EvalTargetAggregateResult_) DeepEqual(ano *EvalTargetAggregateResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TargetID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TargetVersionID) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Latency) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.InputTokens) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.OutputTokens) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.TotalTokens) {
+		return false
+	}
+	return true
+}
+
+func (p *EvalTargetAggregateResult_) Field1DeepEqual(src *int64) bool {
+
+	if p.TargetID == src {
+		return true
+	} else if p.TargetID == nil || src == nil {
+		return false
+	}
+	if *p.TargetID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvalTargetAggregateResult_) Field2DeepEqual(src *int64) bool {
+
+	if p.TargetVersionID == src {
+		return true
+	} else if p.TargetVersionID == nil || src == nil {
+		return false
+	}
+	if *p.TargetVersionID != *src {
+		return false
+	}
+	return true
+}
+func (p *EvalTargetAggregateResult_) Field5DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.Latency) != len(src) {
+		return false
+	}
+	for i, v := range p.Latency {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalTargetAggregateResult_) Field6DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.InputTokens) != len(src) {
+		return false
+	}
+	for i, v := range p.InputTokens {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalTargetAggregateResult_) Field7DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.OutputTokens) != len(src) {
+		return false
+	}
+	for i, v := range p.OutputTokens {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *EvalTargetAggregateResult_) Field8DeepEqual(src []*AggregatorResult_) bool {
+
+	if len(p.TotalTokens) != len(src) {
+		return false
+	}
+	for i, v := range p.TotalTokens {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+// 一种聚合器类型的聚合结果
+type AggregatorResult_ struct {
+	AggregatorType *AggregatorType `thrift:"aggregator_type,1,optional" frugal:"1,optional,string" form:"aggregator_type" json:"aggregator_type,omitempty" query:"aggregator_type"`
+	Data           *AggregateData  `thrift:"data,2,optional" frugal:"2,optional,AggregateData" form:"data" json:"data,omitempty" query:"data"`
+}
+
+func NewAggregatorResult_() *AggregatorResult_ {
+	return &AggregatorResult_{}
+}
+
+func (p *AggregatorResult_) InitDefault() {
+}
+
+var AggregatorResult__AggregatorType_DEFAULT AggregatorType
+
+func (p *AggregatorResult_) GetAggregatorType() (v AggregatorType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAggregatorType() {
+		return AggregatorResult__AggregatorType_DEFAULT
+	}
+	return *p.AggregatorType
+}
+
+var AggregatorResult__Data_DEFAULT *AggregateData
+
+func (p *AggregatorResult_) GetData() (v *AggregateData) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetData() {
+		return AggregatorResult__Data_DEFAULT
+	}
+	return p.Data
+}
+func (p *AggregatorResult_) SetAggregatorType(val *AggregatorType) {
+	p.AggregatorType = val
+}
+func (p *AggregatorResult_) SetData(val *AggregateData) {
+	p.Data = val
+}
+
+var fieldIDToName_AggregatorResult_ = map[int16]string{
+	1: "aggregator_type",
+	2: "data",
+}
+
+func (p *AggregatorResult_) IsSetAggregatorType() bool {
+	return p.AggregatorType != nil
+}
+
+func (p *AggregatorResult_) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregatorResult_[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AggregatorResult_) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *AggregatorType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AggregatorType = _field
+	return nil
+}
+func (p *AggregatorResult_) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewAggregateData()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Data = _field
+	return nil
+}
+
+func (p *AggregatorResult_) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AggregatorResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AggregatorResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAggregatorType() {
+		if err = oprot.WriteFieldBegin("aggregator_type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.AggregatorType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AggregatorResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetData() {
+		if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Data.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AggregatorResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AggregatorResult_(%+v)", *p)
+
+}
+
+func (p *AggregatorResult_) DeepEqual(ano *AggregatorResult_) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.AggregatorType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Data) {
+		return false
+	}
+	return true
+}
+
+func (p *AggregatorResult_) Field1DeepEqual(src *AggregatorType) bool {
+
+	if p.AggregatorType == src {
+		return true
+	} else if p.AggregatorType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.AggregatorType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AggregatorResult_) Field2DeepEqual(src *AggregateData) bool {
+
+	if !p.Data.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type AggregateData struct {
+	DataType          *DataType          `thrift:"data_type,1,optional" frugal:"1,optional,string" form:"data_type" json:"data_type,omitempty" query:"data_type"`
+	Value             *float64           `thrift:"value,2,optional" frugal:"2,optional,double" form:"value" json:"value,omitempty" query:"value"`
+	ScoreDistribution *ScoreDistribution `thrift:"score_distribution,3,optional" frugal:"3,optional,ScoreDistribution" form:"score_distribution" json:"score_distribution,omitempty" query:"score_distribution"`
+}
+
+func NewAggregateData() *AggregateData {
+	return &AggregateData{}
+}
+
+func (p *AggregateData) InitDefault() {
+}
+
+var AggregateData_DataType_DEFAULT DataType
+
+func (p *AggregateData) GetDataType() (v DataType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDataType() {
+		return AggregateData_DataType_DEFAULT
+	}
+	return *p.DataType
+}
+
+var AggregateData_Value_DEFAULT float64
+
+func (p *AggregateData) GetValue() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetValue() {
+		return AggregateData_Value_DEFAULT
+	}
+	return *p.Value
+}
+
+var AggregateData_ScoreDistribution_DEFAULT *ScoreDistribution
+
+func (p *AggregateData) GetScoreDistribution() (v *ScoreDistribution) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScoreDistribution() {
+		return AggregateData_ScoreDistribution_DEFAULT
+	}
+	return p.ScoreDistribution
+}
+func (p *AggregateData) SetDataType(val *DataType) {
+	p.DataType = val
+}
+func (p *AggregateData) SetValue(val *float64) {
+	p.Value = val
+}
+func (p *AggregateData) SetScoreDistribution(val *ScoreDistribution) {
+	p.ScoreDistribution = val
+}
+
+var fieldIDToName_AggregateData = map[int16]string{
+	1: "data_type",
+	2: "value",
+	3: "score_distribution",
+}
+
+func (p *AggregateData) IsSetDataType() bool {
+	return p.DataType != nil
+}
+
+func (p *AggregateData) IsSetValue() bool {
+	return p.Value != nil
+}
+
+func (p *AggregateData) IsSetScoreDistribution() bool {
+	return p.ScoreDistribution != nil
+}
+
+func (p *AggregateData) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregateData[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AggregateData) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *DataType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DataType = _field
+	return nil
+}
+func (p *AggregateData) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Value = _field
+	return nil
+}
+func (p *AggregateData) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewScoreDistribution()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ScoreDistribution = _field
+	return nil
+}
+
+func (p *AggregateData) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AggregateData"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AggregateData) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDataType() {
+		if err = oprot.WriteFieldBegin("data_type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DataType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AggregateData) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err = oprot.WriteFieldBegin("value", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Value); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AggregateData) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScoreDistribution() {
+		if err = oprot.WriteFieldBegin("score_distribution", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ScoreDistribution.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AggregateData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AggregateData(%+v)", *p)
+
+}
+
+func (p *AggregateData) DeepEqual(ano *AggregateData) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.DataType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Value) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ScoreDistribution) {
+		return false
+	}
+	return true
+}
+
+func (p *AggregateData) Field1DeepEqual(src *DataType) bool {
+
+	if p.DataType == src {
+		return true
+	} else if p.DataType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.DataType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AggregateData) Field2DeepEqual(src *float64) bool {
+
+	if p.Value == src {
+		return true
+	} else if p.Value == nil || src == nil {
+		return false
+	}
+	if *p.Value != *src {
+		return false
+	}
+	return true
+}
+func (p *AggregateData) Field3DeepEqual(src *ScoreDistribution) bool {
+
+	if !p.ScoreDistribution.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ScoreDistribution struct {
+	ScoreDistributionItems []*ScoreDistributionItem `thrift:"score_distribution_items,1,optional" frugal:"1,optional,list<ScoreDistributionItem>" form:"score_distribution_items" json:"score_distribution_items,omitempty" query:"score_distribution_items"`
+}
+
+func NewScoreDistribution() *ScoreDistribution {
+	return &ScoreDistribution{}
+}
+
+func (p *ScoreDistribution) InitDefault() {
+}
+
+var ScoreDistribution_ScoreDistributionItems_DEFAULT []*ScoreDistributionItem
+
+func (p *ScoreDistribution) GetScoreDistributionItems() (v []*ScoreDistributionItem) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScoreDistributionItems() {
+		return ScoreDistribution_ScoreDistributionItems_DEFAULT
+	}
+	return p.ScoreDistributionItems
+}
+func (p *ScoreDistribution) SetScoreDistributionItems(val []*ScoreDistributionItem) {
+	p.ScoreDistributionItems = val
+}
+
+var fieldIDToName_ScoreDistribution = map[int16]string{
+	1: "score_distribution_items",
+}
+
+func (p *ScoreDistribution) IsSetScoreDistributionItems() bool {
+	return p.ScoreDistributionItems != nil
+}
+
+func (p *ScoreDistribution) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistribution[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ScoreDistribution) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ScoreDistributionItem, 0, size)
+	values := make([]ScoreDistributionItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ScoreDistributionItems = _field
+	return nil
+}
+
+func (p *ScoreDistribution) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ScoreDistribution"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ScoreDistribution) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScoreDistributionItems() {
+		if err = oprot.WriteFieldBegin("score_distribution_items", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ScoreDistributionItems)); err != nil {
+			return err
+		}
+		for _, v := range p.ScoreDistributionItems {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ScoreDistribution) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ScoreDistribution(%+v)", *p)
+
+}
+
+func (p *ScoreDistribution) DeepEqual(ano *ScoreDistribution) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ScoreDistributionItems) {
+		return false
+	}
+	return true
+}
+
+func (p *ScoreDistribution) Field1DeepEqual(src []*ScoreDistributionItem) bool {
+
+	if len(p.ScoreDistributionItems) != len(src) {
+		return false
+	}
+	for i, v := range p.ScoreDistributionItems {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type ScoreDistributionItem struct {
+	Score      *string  `thrift:"score,1,optional" frugal:"1,optional,string" form:"score" json:"score,omitempty" query:"score"`
+	Count      *int64   `thrift:"count,2,optional" frugal:"2,optional,i64" json:"count" form:"count" query:"count"`
+	Percentage *float64 `thrift:"percentage,3,optional" frugal:"3,optional,double" form:"percentage" json:"percentage,omitempty" query:"percentage"`
+}
+
+func NewScoreDistributionItem() *ScoreDistributionItem {
+	return &ScoreDistributionItem{}
+}
+
+func (p *ScoreDistributionItem) InitDefault() {
+}
+
+var ScoreDistributionItem_Score_DEFAULT string
+
+func (p *ScoreDistributionItem) GetScore() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScore() {
+		return ScoreDistributionItem_Score_DEFAULT
+	}
+	return *p.Score
+}
+
+var ScoreDistributionItem_Count_DEFAULT int64
+
+func (p *ScoreDistributionItem) GetCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCount() {
+		return ScoreDistributionItem_Count_DEFAULT
+	}
+	return *p.Count
+}
+
+var ScoreDistributionItem_Percentage_DEFAULT float64
+
+func (p *ScoreDistributionItem) GetPercentage() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPercentage() {
+		return ScoreDistributionItem_Percentage_DEFAULT
+	}
+	return *p.Percentage
+}
+func (p *ScoreDistributionItem) SetScore(val *string) {
+	p.Score = val
+}
+func (p *ScoreDistributionItem) SetCount(val *int64) {
+	p.Count = val
+}
+func (p *ScoreDistributionItem) SetPercentage(val *float64) {
+	p.Percentage = val
+}
+
+var fieldIDToName_ScoreDistributionItem = map[int16]string{
+	1: "score",
+	2: "count",
+	3: "percentage",
+}
+
+func (p *ScoreDistributionItem) IsSetScore() bool {
+	return p.Score != nil
+}
+
+func (p *ScoreDistributionItem) IsSetCount() bool {
+	return p.Count != nil
+}
+
+func (p *ScoreDistributionItem) IsSetPercentage() bool {
+	return p.Percentage != nil
+}
+
+func (p *ScoreDistributionItem) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistributionItem[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ScoreDistributionItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Score = _field
+	return nil
+}
+func (p *ScoreDistributionItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Count = _field
+	return nil
+}
+func (p *ScoreDistributionItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Percentage = _field
+	return nil
+}
+
+func (p *ScoreDistributionItem) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ScoreDistributionItem"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
 		}
 	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *EvalTargetAggregateResult_) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetInputTokens() {
-		if err = oprot.WriteFieldBegin("input_tokens", thrift.LIST, 6); err != nil {
+
+func (p *ScoreDistributionItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScore() {
+		if err = oprot.WriteFieldBegin("score", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InputTokens)); err != nil {
-			return err
-		}
-		for _, v := range p.InputTokens {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteString(*p.Score); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2324,24 +3913,16 @@ func (p *EvalTargetAggregateResult_) writeField6(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EvalTargetAggregateResult_) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOutputTokens() {
-		if err = oprot.WriteFieldBegin("output_tokens", thrift.LIST, 7); err != nil {
+func (p *ScoreDistributionItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCount() {
+		if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OutputTokens)); err != nil {
-			return err
-		}
-		for _, v := range p.OutputTokens {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteI64(*p.Count); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2350,24 +3931,16 @@ func (p *EvalTargetAggregateResult_) writeField7(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *EvalTargetAggregateResult_) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTotalTokens() {
-		if err = oprot.WriteFieldBegin("total_tokens", thrift.LIST, 8); err != nil {
+func (p *ScoreDistributionItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPercentage() {
+		if err = oprot.WriteFieldBegin("percentage", thrift.DOUBLE, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TotalTokens)); err != nil {
-			return err
-		}
-		for _, v := range p.TotalTokens {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteDouble(*p.Percentage); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2376,180 +3949,194 @@ func (p *EvalTargetAggregateResult_) writeField8(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *EvalTargetAggregateResult_) String() string {
+func (p *ScoreDistributionItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EvalTargetAggregateResult_(%+v)", *p)
+	return fmt.Sprintf("ScoreDistributionItem(%+v)", *p)
 
 }
 
-func (p *EvalTargetAggregateResult_) DeepEqual(ano *EvalTargetAggregateResult_) bool {
+func (p *ScoreDistributionItem) DeepEqual(ano *ScoreDistributionItem) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.TargetID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.TargetVersionID) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.Latency) {
-		return false
-	}
-	if !p.Field6DeepEqual(ano.InputTokens) {
+	if !p.Field1DeepEqual(ano.Score) {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.OutputTokens) {
+	if !p.Field2DeepEqual(ano.Count) {
 		return false
 	}
-	if !p.Field8DeepEqual(ano.TotalTokens) {
+	if !p.Field3DeepEqual(ano.Percentage) {
 		return false
 	}
 	return true
 }
 
-func (p *EvalTargetAggregateResult_) Field1DeepEqual(src *int64) bool {
+func (p *ScoreDistributionItem) Field1DeepEqual(src *string) bool {
 
-	if p.TargetID == src {
+	if p.Score == src {
 		return true
-	} else if p.TargetID == nil || src == nil {
+	} else if p.Score == nil || src == nil {
 		return false
 	}
-	if *p.TargetID != *src {
+	if strings.Compare(*p.Score, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EvalTargetAggregateResult_) Field2DeepEqual(src *int64) bool {
+func (p *ScoreDistributionItem) Field2DeepEqual(src *int64) bool {
 
-	if p.TargetVersionID == src {
+	if p.Count == src {
 		return true
-	} else if p.TargetVersionID == nil || src == nil {
+	} else if p.Count == nil || src == nil {
 		return false
 	}
-	if *p.TargetVersionID != *src {
+	if *p.Count != *src {
 		return false
 	}
 	return true
 }
-func (p *EvalTargetAggregateResult_) Field5DeepEqual(src []*AggregatorResult_) bool {
+func (p *ScoreDistributionItem) Field3DeepEqual(src *float64) bool {
 
-	if len(p.Latency) != len(src) {
+	if p.Percentage == src {
+		return true
+	} else if p.Percentage == nil || src == nil {
 		return false
 	}
-	for i, v := range p.Latency {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if *p.Percentage != *src {
+		return false
 	}
 	return true
 }
-func (p *EvalTargetAggregateResult_) Field6DeepEqual(src []*AggregatorResult_) bool {
 
-	if len(p.InputTokens) != len(src) {
-		return false
-	}
-	for i, v := range p.InputTokens {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
+// 实验统计
+type ExperimentStatistics struct {
+	PendingTurnCount    *int32 `thrift:"pending_turn_count,1,optional" frugal:"1,optional,i32" form:"pending_turn_count" json:"pending_turn_count,omitempty" query:"pending_turn_count"`
+	SuccessTurnCount    *int32 `thrift:"success_turn_count,2,optional" frugal:"2,optional,i32" form:"success_turn_count" json:"success_turn_count,omitempty" query:"success_turn_count"`
+	FailedTurnCount     *int32 `thrift:"failed_turn_count,3,optional" frugal:"3,optional,i32" form:"failed_turn_count" json:"failed_turn_count,omitempty" query:"failed_turn_count"`
+	TerminatedTurnCount *int32 `thrift:"terminated_turn_count,4,optional" frugal:"4,optional,i32" form:"terminated_turn_count" json:"terminated_turn_count,omitempty" query:"terminated_turn_count"`
+	ProcessingTurnCount *int32 `thrift:"processing_turn_count,5,optional" frugal:"5,optional,i32" form:"processing_turn_count" json:"processing_turn_count,omitempty" query:"processing_turn_count"`
 }
-func (p *EvalTargetAggregateResult_) Field7DeepEqual(src []*AggregatorResult_) bool {
 
-	if len(p.OutputTokens) != len(src) {
-		return false
-	}
-	for i, v := range p.OutputTokens {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
+func NewExperimentStatistics() *ExperimentStatistics {
+	return &ExperimentStatistics{}
 }
-func (p *EvalTargetAggregateResult_) Field8DeepEqual(src []*AggregatorResult_) bool {
 
-	if len(p.TotalTokens) != len(src) {
-		return false
+func (p *ExperimentStatistics) InitDefault() {
+}
+
+var ExperimentStatistics_PendingTurnCount_DEFAULT int32
+
+func (p *ExperimentStatistics) GetPendingTurnCount() (v int32) {
+	if p == nil {
+		return
 	}
-	for i, v := range p.TotalTokens {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if !p.IsSetPendingTurnCount() {
+		return ExperimentStatistics_PendingTurnCount_DEFAULT
 	}
-	return true
+	return *p.PendingTurnCount
 }
 
-// 一种聚合器类型的聚合结果
-type AggregatorResult_ struct {
-	AggregatorType *AggregatorType `thrift:"aggregator_type,1,optional" frugal:"1,optional,string" form:"aggregator_type" json:"aggregator_type,omitempty" query:"aggregator_type"`
-	Data           *AggregateData  `thrift:"data,2,optional" frugal:"2,optional,AggregateData" form:"data" json:"data,omitempty" query:"data"`
-}
+var ExperimentStatistics_SuccessTurnCount_DEFAULT int32
 
-func NewAggregatorResult_() *AggregatorResult_ {
-	return &AggregatorResult_{}
+func (p *ExperimentStatistics) GetSuccessTurnCount() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccessTurnCount() {
+		return ExperimentStatistics_SuccessTurnCount_DEFAULT
+	}
+	return *p.SuccessTurnCount
 }
 
-func (p *AggregatorResult_) InitDefault() {
+var ExperimentStatistics_FailedTurnCount_DEFAULT int32
+
+func (p *ExperimentStatistics) GetFailedTurnCount() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFailedTurnCount() {
+		return ExperimentStatistics_FailedTurnCount_DEFAULT
+	}
+	return *p.FailedTurnCount
 }
 
-var AggregatorResult__AggregatorType_DEFAULT AggregatorType
+var ExperimentStatistics_TerminatedTurnCount_DEFAULT int32
 
-func (p *AggregatorResult_) GetAggregatorType() (v AggregatorType) {
+func (p *ExperimentStatistics) GetTerminatedTurnCount() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetAggregatorType() {
-		return AggregatorResult__AggregatorType_DEFAULT
+	if !p.IsSetTerminatedTurnCount() {
+		return ExperimentStatistics_TerminatedTurnCount_DEFAULT
 	}
-	return *p.AggregatorType
+	return *p.TerminatedTurnCount
 }
 
-var AggregatorResult__Data_DEFAULT *AggregateData
+var ExperimentStatistics_ProcessingTurnCount_DEFAULT int32
 
-func (p *AggregatorResult_) GetData() (v *AggregateData) {
+func (p *ExperimentStatistics) GetProcessingTurnCount() (v int32) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetData() {
-		return AggregatorResult__Data_DEFAULT
+	if !p.IsSetProcessingTurnCount() {
+		return ExperimentStatistics_ProcessingTurnCount_DEFAULT
 	}
-	return p.Data
+	return *p.ProcessingTurnCount
 }
-func (p *AggregatorResult_) SetAggregatorType(val *AggregatorType) {
-	p.AggregatorType = val
+func (p *ExperimentStatistics) SetPendingTurnCount(val *int32) {
+	p.PendingTurnCount = val
+}
+func (p *ExperimentStatistics) SetSuccessTurnCount(val *int32) {
+	p.SuccessTurnCount = val
+}
+func (p *ExperimentStatistics) SetFailedTurnCount(val *int32) {
+	p.FailedTurnCount = val
+}
+func (p *ExperimentStatistics) SetTerminatedTurnCount(val *int32) {
+	p.TerminatedTurnCount = val
+}
+func (p *ExperimentStatistics) SetProcessingTurnCount(val *int32) {
+	p.ProcessingTurnCount = val
+}
+
+var fieldIDToName_ExperimentStatistics = map[int16]string{
+	1: "pending_turn_count",
+	2: "success_turn_count",
+	3: "failed_turn_count",
+	4: "terminated_turn_count",
+	5: "processing_turn_count",
 }
-func (p *AggregatorResult_) SetData(val *AggregateData) {
-	p.Data = val
+
+func (p *ExperimentStatistics) IsSetPendingTurnCount() bool {
+	return p.PendingTurnCount != nil
 }
 
-var fieldIDToName_AggregatorResult_ = map[int16]string{
-	1: "aggregator_type",
-	2: "data",
+func (p *ExperimentStatistics) IsSetSuccessTurnCount() bool {
+	return p.SuccessTurnCount != nil
 }
 
-func (p *AggregatorResult_) IsSetAggregatorType() bool {
-	return p.AggregatorType != nil
+func (p *ExperimentStatistics) IsSetFailedTurnCount() bool {
+	return p.FailedTurnCount != nil
 }
 
-func (p *AggregatorResult_) IsSetData() bool {
-	return p.Data != nil
+func (p *ExperimentStatistics) IsSetTerminatedTurnCount() bool {
+	return p.TerminatedTurnCount != nil
 }
 
-func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentStatistics) IsSetProcessingTurnCount() bool {
+	return p.ProcessingTurnCount != nil
+}
+
+func (p *ExperimentStatistics) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2568,7 +4155,7 @@ func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2576,13 +4163,37 @@ func (p *AggregatorResult_) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2602,7 +4213,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregatorResult_[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentStatistics[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2612,29 +4223,65 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AggregatorResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExperimentStatistics) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *AggregatorType
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.AggregatorType = _field
+	p.PendingTurnCount = _field
 	return nil
 }
-func (p *AggregatorResult_) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewAggregateData()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExperimentStatistics) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Data = _field
+	p.SuccessTurnCount = _field
 	return nil
 }
+func (p *ExperimentStatistics) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *AggregatorResult_) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FailedTurnCount = _field
+	return nil
+}
+func (p *ExperimentStatistics) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TerminatedTurnCount = _field
+	return nil
+}
+func (p *ExperimentStatistics) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ProcessingTurnCount = _field
+	return nil
+}
+
+func (p *ExperimentStatistics) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AggregatorResult"); err != nil {
+	if err = oprot.WriteStructBegin("ExperimentStatistics"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2646,6 +4293,18 @@ func (p *AggregatorResult_) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2664,12 +4323,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AggregatorResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAggregatorType() {
-		if err = oprot.WriteFieldBegin("aggregator_type", thrift.STRING, 1); err != nil {
+func (p *ExperimentStatistics) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPendingTurnCount() {
+		if err = oprot.WriteFieldBegin("pending_turn_count", thrift.I32, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.AggregatorType); err != nil {
+		if err := oprot.WriteI32(*p.PendingTurnCount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2682,12 +4341,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AggregatorResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetData() {
-		if err = oprot.WriteFieldBegin("data", thrift.STRUCT, 2); err != nil {
+func (p *ExperimentStatistics) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccessTurnCount() {
+		if err = oprot.WriteFieldBegin("success_turn_count", thrift.I32, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Data.Write(oprot); err != nil {
+		if err := oprot.WriteI32(*p.SuccessTurnCount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2700,127 +4359,341 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *ExperimentStatistics) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFailedTurnCount() {
+		if err = oprot.WriteFieldBegin("failed_turn_count", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.FailedTurnCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExperimentStatistics) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTerminatedTurnCount() {
+		if err = oprot.WriteFieldBegin("terminated_turn_count", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.TerminatedTurnCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExperimentStatistics) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProcessingTurnCount() {
+		if err = oprot.WriteFieldBegin("processing_turn_count", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ProcessingTurnCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
-func (p *AggregatorResult_) String() string {
+func (p *ExperimentStatistics) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AggregatorResult_(%+v)", *p)
+	return fmt.Sprintf("ExperimentStatistics(%+v)", *p)
+
+}
+
+func (p *ExperimentStatistics) DeepEqual(ano *ExperimentStatistics) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.PendingTurnCount) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.SuccessTurnCount) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.FailedTurnCount) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TerminatedTurnCount) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ProcessingTurnCount) {
+		return false
+	}
+	return true
+}
+
+func (p *ExperimentStatistics) Field1DeepEqual(src *int32) bool {
 
+	if p.PendingTurnCount == src {
+		return true
+	} else if p.PendingTurnCount == nil || src == nil {
+		return false
+	}
+	if *p.PendingTurnCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentStatistics) Field2DeepEqual(src *int32) bool {
+
+	if p.SuccessTurnCount == src {
+		return true
+	} else if p.SuccessTurnCount == nil || src == nil {
+		return false
+	}
+	if *p.SuccessTurnCount != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentStatistics) Field3DeepEqual(src *int32) bool {
+
+	if p.FailedTurnCount == src {
+		return true
+	} else if p.FailedTurnCount == nil || src == nil {
+		return false
+	}
+	if *p.FailedTurnCount != *src {
+		return false
+	}
+	return true
 }
+func (p *ExperimentStatistics) Field4DeepEqual(src *int32) bool {
 
-func (p *AggregatorResult_) DeepEqual(ano *AggregatorResult_) bool {
-	if p == ano {
+	if p.TerminatedTurnCount == src {
 		return true
-	} else if p == nil || ano == nil {
-		return false
-	}
-	if !p.Field1DeepEqual(ano.AggregatorType) {
+	} else if p.TerminatedTurnCount == nil || src == nil {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Data) {
+	if *p.TerminatedTurnCount != *src {
 		return false
 	}
 	return true
 }
+func (p *ExperimentStatistics) Field5DeepEqual(src *int32) bool {
 
-func (p *AggregatorResult_) Field1DeepEqual(src *AggregatorType) bool {
-
-	if p.AggregatorType == src {
+	if p.ProcessingTurnCount == src {
 		return true
-	} else if p.AggregatorType == nil || src == nil {
+	} else if p.ProcessingTurnCount == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.AggregatorType, *src) != 0 {
+	if *p.ProcessingTurnCount != *src {
 		return false
 	}
 	return true
 }
-func (p *AggregatorResult_) Field2DeepEqual(src *AggregateData) bool {
 
-	if !p.Data.DeepEqual(src) {
-		return false
+type ExperimentGateRule struct {
+	RuleType *ExperimentGateRuleType `thrift:"rule_type,1,optional" frugal:"1,optional,string" form:"rule_type" json:"rule_type,omitempty" query:"rule_type"`
+	Name     *string                 `thrift:"name,2,optional" frugal:"2,optional,string" form:"name" json:"name,omitempty" query:"name"`
+	// evaluator_score 规则的评估器，需包含在实验评估器中
+	EvaluatorID *int64  `thrift:"evaluator_id,3,optional" frugal:"3,optional,i64" json:"evaluator_id" form:"evaluator_id" query:"evaluator_id"`
+	Version     *string `thrift:"version,4,optional" frugal:"4,optional,string" form:"version" json:"version,omitempty" query:"version"`
+	// 默认 gte
+	Operator *ExperimentGateOperator `thrift:"operator,5,optional" frugal:"5,optional,string" form:"operator" json:"operator,omitempty" query:"operator"`
+	// evaluator_score 为得分阈值；failed_turn_rate 为未成功 turn 占比上限，取值 [0, 1]
+	Threshold *float64 `thrift:"threshold,6,optional" frugal:"6,optional,double" form:"threshold" json:"threshold,omitempty" query:"threshold"`
+	// no_regression 规则的基准实验，对比两个实验共同的全部评估器
+	BaselineExperimentID *int64 `thrift:"baseline_experiment_id,7,optional" frugal:"7,optional,i64" json:"baseline_experiment_id" form:"baseline_experiment_id" query:"baseline_experiment_id"`
+	// 默认 0.95
+	ConfidenceLevel *float64 `thrift:"confidence_level,8,optional" frugal:"8,optional,double" form:"confidence_level" json:"confidence_level,omitempty" query:"confidence_level"`
+}
+
+func NewExperimentGateRule() *ExperimentGateRule {
+	return &ExperimentGateRule{}
+}
+
+func (p *ExperimentGateRule) InitDefault() {
+}
+
+var ExperimentGateRule_RuleType_DEFAULT ExperimentGateRuleType
+
+func (p *ExperimentGateRule) GetRuleType() (v ExperimentGateRuleType) {
+	if p == nil {
+		return
 	}
-	return true
+	if !p.IsSetRuleType() {
+		return ExperimentGateRule_RuleType_DEFAULT
+	}
+	return *p.RuleType
 }
 
-type AggregateData struct {
-	DataType          *DataType          `thrift:"data_type,1,optional" frugal:"1,optional,string" form:"data_type" json:"data_type,omitempty" query:"data_type"`
-	Value             *float64           `thrift:"value,2,optional" frugal:"2,optional,double" form:"value" json:"value,omitempty" query:"value"`
-	ScoreDistribution *ScoreDistribution `thrift:"score_distribution,3,optional" frugal:"3,optional,ScoreDistribution" form:"score_distribution" json:"score_distribution,omitempty" query:"score_distribution"`
+var ExperimentGateRule_Name_DEFAULT string
+
+func (p *ExperimentGateRule) GetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetName() {
+		return ExperimentGateRule_Name_DEFAULT
+	}
+	return *p.Name
 }
 
-func NewAggregateData() *AggregateData {
-	return &AggregateData{}
+var ExperimentGateRule_EvaluatorID_DEFAULT int64
+
+func (p *ExperimentGateRule) GetEvaluatorID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorID() {
+		return ExperimentGateRule_EvaluatorID_DEFAULT
+	}
+	return *p.EvaluatorID
 }
 
-func (p *AggregateData) InitDefault() {
+var ExperimentGateRule_Version_DEFAULT string
+
+func (p *ExperimentGateRule) GetVersion() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetVersion() {
+		return ExperimentGateRule_Version_DEFAULT
+	}
+	return *p.Version
 }
 
-var AggregateData_DataType_DEFAULT DataType
+var ExperimentGateRule_Operator_DEFAULT ExperimentGateOperator
 
-func (p *AggregateData) GetDataType() (v DataType) {
+func (p *ExperimentGateRule) GetOperator() (v ExperimentGateOperator) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDataType() {
-		return AggregateData_DataType_DEFAULT
+	if !p.IsSetOperator() {
+		return ExperimentGateRule_Operator_DEFAULT
 	}
-	return *p.DataType
+	return *p.Operator
 }
 
-var AggregateData_Value_DEFAULT float64
+var ExperimentGateRule_Threshold_DEFAULT float64
 
-func (p *AggregateData) GetValue() (v float64) {
+func (p *ExperimentGateRule) GetThreshold() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetValue() {
-		return AggregateData_Value_DEFAULT
+	if !p.IsSetThreshold() {
+		return ExperimentGateRule_Threshold_DEFAULT
 	}
-	return *p.Value
+	return *p.Threshold
 }
 
-var AggregateData_ScoreDistribution_DEFAULT *ScoreDistribution
+var ExperimentGateRule_BaselineExperimentID_DEFAULT int64
 
-func (p *AggregateData) GetScoreDistribution() (v *ScoreDistribution) {
+func (p *ExperimentGateRule) GetBaselineExperimentID() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetScoreDistribution() {
-		return AggregateData_ScoreDistribution_DEFAULT
+	if !p.IsSetBaselineExperimentID() {
+		return ExperimentGateRule_BaselineExperimentID_DEFAULT
 	}
-	return p.ScoreDistribution
+	return *p.BaselineExperimentID
 }
-func (p *AggregateData) SetDataType(val *DataType) {
-	p.DataType = val
+
+var ExperimentGateRule_ConfidenceLevel_DEFAULT float64
+
+func (p *ExperimentGateRule) GetConfidenceLevel() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetConfidenceLevel() {
+		return ExperimentGateRule_ConfidenceLevel_DEFAULT
+	}
+	return *p.ConfidenceLevel
 }
-func (p *AggregateData) SetValue(val *float64) {
-	p.Value = val
+func (p *ExperimentGateRule) SetRuleType(val *ExperimentGateRuleType) {
+	p.RuleType = val
 }
-func (p *AggregateData) SetScoreDistribution(val *ScoreDistribution) {
-	p.ScoreDistribution = val
+func (p *ExperimentGateRule) SetName(val *string) {
+	p.Name = val
+}
+func (p *ExperimentGateRule) SetEvaluatorID(val *int64) {
+	p.EvaluatorID = val
+}
+func (p *ExperimentGateRule) SetVersion(val *string) {
+	p.Version = val
+}
+func (p *ExperimentGateRule) SetOperator(val *ExperimentGateOperator) {
+	p.Operator = val
+}
+func (p *ExperimentGateRule) SetThreshold(val *float64) {
+	p.Threshold = val
+}
+func (p *ExperimentGateRule) SetBaselineExperimentID(val *int64) {
+	p.BaselineExperimentID = val
+}
+func (p *ExperimentGateRule) SetConfidenceLevel(val *float64) {
+	p.ConfidenceLevel = val
 }
 
-var fieldIDToName_AggregateData = map[int16]string{
-	1: "data_type",
-	2: "value",
-	3: "score_distribution",
+var fieldIDToName_ExperimentGateRule = map[int16]string{
+	1: "rule_type",
+	2: "name",
+	3: "evaluator_id",
+	4: "version",
+	5: "operator",
+	6: "threshold",
+	7: "baseline_experiment_id",
+	8: "confidence_level",
 }
 
-func (p *AggregateData) IsSetDataType() bool {
-	return p.DataType != nil
+func (p *ExperimentGateRule) IsSetRuleType() bool {
+	return p.RuleType != nil
 }
 
-func (p *AggregateData) IsSetValue() bool {
-	return p.Value != nil
+func (p *ExperimentGateRule) IsSetName() bool {
+	return p.Name != nil
 }
 
-func (p *AggregateData) IsSetScoreDistribution() bool {
-	return p.ScoreDistribution != nil
+func (p *ExperimentGateRule) IsSetEvaluatorID() bool {
+	return p.EvaluatorID != nil
 }
 
-func (p *AggregateData) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentGateRule) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *ExperimentGateRule) IsSetOperator() bool {
+	return p.Operator != nil
+}
+
+func (p *ExperimentGateRule) IsSetThreshold() bool {
+	return p.Threshold != nil
+}
+
+func (p *ExperimentGateRule) IsSetBaselineExperimentID() bool {
+	return p.BaselineExperimentID != nil
+}
+
+func (p *ExperimentGateRule) IsSetConfidenceLevel() bool {
+	return p.ConfidenceLevel != nil
+}
+
+func (p *ExperimentGateRule) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2847,7 +4720,7 @@ func (p *AggregateData) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.DOUBLE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2855,13 +4728,53 @@ func (p *AggregateData) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2881,7 +4794,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregateData[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentGateRule[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2891,18 +4804,62 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AggregateData) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExperimentGateRule) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *DataType
+	var _field *ExperimentGateRuleType
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.DataType = _field
+	p.RuleType = _field
 	return nil
 }
-func (p *AggregateData) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExperimentGateRule) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *ExperimentGateRule) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EvaluatorID = _field
+	return nil
+}
+func (p *ExperimentGateRule) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Version = _field
+	return nil
+}
+func (p *ExperimentGateRule) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *ExperimentGateOperator
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Operator = _field
+	return nil
+}
+func (p *ExperimentGateRule) ReadField6(iprot thrift.TProtocol) error {
 
 	var _field *float64
 	if v, err := iprot.ReadDouble(); err != nil {
@@ -2910,21 +4867,35 @@ func (p *AggregateData) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Value = _field
+	p.Threshold = _field
 	return nil
 }
-func (p *AggregateData) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewScoreDistribution()
-	if err := _field.Read(iprot); err != nil {
+func (p *ExperimentGateRule) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaselineExperimentID = _field
+	return nil
+}
+func (p *ExperimentGateRule) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.ScoreDistribution = _field
+	p.ConfidenceLevel = _field
 	return nil
 }
 
-func (p *AggregateData) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentGateRule) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AggregateData"); err != nil {
+	if err = oprot.WriteStructBegin("ExperimentGateRule"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2936,8 +4907,28 @@ func (p *AggregateData) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
 			goto WriteFieldError
 		}
 	}
@@ -2958,12 +4949,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AggregateData) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDataType() {
-		if err = oprot.WriteFieldBegin("data_type", thrift.STRING, 1); err != nil {
+func (p *ExperimentGateRule) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleType() {
+		if err = oprot.WriteFieldBegin("rule_type", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.DataType); err != nil {
+		if err := oprot.WriteString(*p.RuleType); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2976,12 +4967,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AggregateData) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetValue() {
-		if err = oprot.WriteFieldBegin("value", thrift.DOUBLE, 2); err != nil {
+func (p *ExperimentGateRule) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.Value); err != nil {
+		if err := oprot.WriteString(*p.Name); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2994,12 +4985,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *AggregateData) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScoreDistribution() {
-		if err = oprot.WriteFieldBegin("score_distribution", thrift.STRUCT, 3); err != nil {
+func (p *ExperimentGateRule) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorID() {
+		if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.ScoreDistribution.Write(oprot); err != nil {
+		if err := oprot.WriteI64(*p.EvaluatorID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3012,100 +5003,270 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *ExperimentGateRule) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Version); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExperimentGateRule) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOperator() {
+		if err = oprot.WriteFieldBegin("operator", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Operator); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExperimentGateRule) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetThreshold() {
+		if err = oprot.WriteFieldBegin("threshold", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Threshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExperimentGateRule) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaselineExperimentID() {
+		if err = oprot.WriteFieldBegin("baseline_experiment_id", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.BaselineExperimentID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExperimentGateRule) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfidenceLevel() {
+		if err = oprot.WriteFieldBegin("confidence_level", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.ConfidenceLevel); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
 
-func (p *AggregateData) String() string {
+func (p *ExperimentGateRule) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AggregateData(%+v)", *p)
+	return fmt.Sprintf("ExperimentGateRule(%+v)", *p)
 
 }
 
-func (p *AggregateData) DeepEqual(ano *AggregateData) bool {
+func (p *ExperimentGateRule) DeepEqual(ano *ExperimentGateRule) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.DataType) {
+	if !p.Field1DeepEqual(ano.RuleType) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Value) {
+	if !p.Field2DeepEqual(ano.Name) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.ScoreDistribution) {
+	if !p.Field3DeepEqual(ano.EvaluatorID) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Version) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Operator) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Threshold) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.BaselineExperimentID) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ConfidenceLevel) {
 		return false
 	}
 	return true
 }
 
-func (p *AggregateData) Field1DeepEqual(src *DataType) bool {
+func (p *ExperimentGateRule) Field1DeepEqual(src *ExperimentGateRuleType) bool {
 
-	if p.DataType == src {
+	if p.RuleType == src {
 		return true
-	} else if p.DataType == nil || src == nil {
+	} else if p.RuleType == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.DataType, *src) != 0 {
+	if strings.Compare(*p.RuleType, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *AggregateData) Field2DeepEqual(src *float64) bool {
+func (p *ExperimentGateRule) Field2DeepEqual(src *string) bool {
 
-	if p.Value == src {
+	if p.Name == src {
 		return true
-	} else if p.Value == nil || src == nil {
+	} else if p.Name == nil || src == nil {
 		return false
 	}
-	if *p.Value != *src {
+	if strings.Compare(*p.Name, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *AggregateData) Field3DeepEqual(src *ScoreDistribution) bool {
+func (p *ExperimentGateRule) Field3DeepEqual(src *int64) bool {
 
-	if !p.ScoreDistribution.DeepEqual(src) {
+	if p.EvaluatorID == src {
+		return true
+	} else if p.EvaluatorID == nil || src == nil {
+		return false
+	}
+	if *p.EvaluatorID != *src {
 		return false
 	}
 	return true
 }
+func (p *ExperimentGateRule) Field4DeepEqual(src *string) bool {
 
-type ScoreDistribution struct {
-	ScoreDistributionItems []*ScoreDistributionItem `thrift:"score_distribution_items,1,optional" frugal:"1,optional,list<ScoreDistributionItem>" form:"score_distribution_items" json:"score_distribution_items,omitempty" query:"score_distribution_items"`
+	if p.Version == src {
+		return true
+	} else if p.Version == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Version, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ExperimentGateRule) Field5DeepEqual(src *ExperimentGateOperator) bool {
 
-func NewScoreDistribution() *ScoreDistribution {
-	return &ScoreDistribution{}
+	if p.Operator == src {
+		return true
+	} else if p.Operator == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Operator, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *ExperimentGateRule) Field6DeepEqual(src *float64) bool {
 
-func (p *ScoreDistribution) InitDefault() {
+	if p.Threshold == src {
+		return true
+	} else if p.Threshold == nil || src == nil {
+		return false
+	}
+	if *p.Threshold != *src {
+		return false
+	}
+	return true
 }
+func (p *ExperimentGateRule) Field7DeepEqual(src *int64) bool {
 
-var ScoreDistribution_ScoreDistributionItems_DEFAULT []*ScoreDistributionItem
+	if p.BaselineExperimentID == src {
+		return true
+	} else if p.BaselineExperimentID == nil || src == nil {
+		return false
+	}
+	if *p.BaselineExperimentID != *src {
+		return false
+	}
+	return true
+}
+func (p *ExperimentGateRule) Field8DeepEqual(src *float64) bool {
 
-func (p *ScoreDistribution) GetScoreDistributionItems() (v []*ScoreDistributionItem) {
+	if p.ConfidenceLevel == src {
+		return true
+	} else if p.ConfidenceLevel == nil || src == nil {
+		return false
+	}
+	if *p.ConfidenceLevel != *src {
+		return false
+	}
+	return true
+}
+
+type ExperimentGateConfig struct {
+	Rules []*ExperimentGateRule `thrift:"rules,1,optional" frugal:"1,optional,list<ExperimentGateRule>" form:"rules" json:"rules,omitempty" query:"rules"`
+}
+
+func NewExperimentGateConfig() *ExperimentGateConfig {
+	return &ExperimentGateConfig{}
+}
+
+func (p *ExperimentGateConfig) InitDefault() {
+}
+
+var ExperimentGateConfig_Rules_DEFAULT []*ExperimentGateRule
+
+func (p *ExperimentGateConfig) GetRules() (v []*ExperimentGateRule) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetScoreDistributionItems() {
-		return ScoreDistribution_ScoreDistributionItems_DEFAULT
+	if !p.IsSetRules() {
+		return ExperimentGateConfig_Rules_DEFAULT
 	}
-	return p.ScoreDistributionItems
+	return p.Rules
 }
-func (p *ScoreDistribution) SetScoreDistributionItems(val []*ScoreDistributionItem) {
-	p.ScoreDistributionItems = val
+func (p *ExperimentGateConfig) SetRules(val []*ExperimentGateRule) {
+	p.Rules = val
 }
 
-var fieldIDToName_ScoreDistribution = map[int16]string{
-	1: "score_distribution_items",
+var fieldIDToName_ExperimentGateConfig = map[int16]string{
+	1: "rules",
 }
 
-func (p *ScoreDistribution) IsSetScoreDistributionItems() bool {
-	return p.ScoreDistributionItems != nil
+func (p *ExperimentGateConfig) IsSetRules() bool {
+	return p.Rules != nil
 }
 
-func (p *ScoreDistribution) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentGateConfig) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3150,7 +5311,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistribution[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentGateConfig[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3160,13 +5321,13 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ScoreDistribution) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExperimentGateConfig) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*ScoreDistributionItem, 0, size)
-	values := make([]ScoreDistributionItem, size)
+	_field := make([]*ExperimentGateRule, 0, size)
+	values := make([]ExperimentGateRule, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -3180,13 +5341,13 @@ func (p *ScoreDistribution) ReadField1(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.ScoreDistributionItems = _field
+	p.Rules = _field
 	return nil
 }
 
-func (p *ScoreDistribution) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentGateConfig) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ScoreDistribution"); err != nil {
+	if err = oprot.WriteStructBegin("ExperimentGateConfig"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3212,15 +5373,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ScoreDistribution) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScoreDistributionItems() {
-		if err = oprot.WriteFieldBegin("score_distribution_items", thrift.LIST, 1); err != nil {
+func (p *ExperimentGateConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRules() {
+		if err = oprot.WriteFieldBegin("rules", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ScoreDistributionItems)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Rules)); err != nil {
 			return err
 		}
-		for _, v := range p.ScoreDistributionItems {
+		for _, v := range p.Rules {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -3239,32 +5400,32 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ScoreDistribution) String() string {
+func (p *ExperimentGateConfig) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ScoreDistribution(%+v)", *p)
+	return fmt.Sprintf("ExperimentGateConfig(%+v)", *p)
 
 }
 
-func (p *ScoreDistribution) DeepEqual(ano *ScoreDistribution) bool {
+func (p *ExperimentGateConfig) DeepEqual(ano *ExperimentGateConfig) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ScoreDistributionItems) {
+	if !p.Field1DeepEqual(ano.Rules) {
 		return false
 	}
 	return true
 }
 
-func (p *ScoreDistribution) Field1DeepEqual(src []*ScoreDistributionItem) bool {
+func (p *ExperimentGateConfig) Field1DeepEqual(src []*ExperimentGateRule) bool {
 
-	if len(p.ScoreDistributionItems) != len(src) {
+	if len(p.Rules) != len(src) {
 		return false
 	}
-	for i, v := range p.ScoreDistributionItems {
+	for i, v := range p.Rules {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -3273,83 +5434,104 @@ func (p *ScoreDistribution) Field1DeepEqual(src []*ScoreDistributionItem) bool {
 	return true
 }
 
-type ScoreDistributionItem struct {
-	Score      *string  `thrift:"score,1,optional" frugal:"1,optional,string" form:"score" json:"score,omitempty" query:"score"`
-	Count      *int64   `thrift:"count,2,optional" frugal:"2,optional,i64" json:"count" form:"count" query:"count"`
-	Percentage *float64 `thrift:"percentage,3,optional" frugal:"3,optional,double" form:"percentage" json:"percentage,omitempty" query:"percentage"`
+type ExperimentGateRuleVerdict struct {
+	Rule        *ExperimentGateRule   `thrift:"rule,1,optional" frugal:"1,optional,ExperimentGateRule" form:"rule" json:"rule,omitempty" query:"rule"`
+	Status      *ExperimentGateStatus `thrift:"status,2,optional" frugal:"2,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	ActualValue *float64              `thrift:"actual_value,3,optional" frugal:"3,optional,double" form:"actual_value" json:"actual_value,omitempty" query:"actual_value"`
+	Message     *string               `thrift:"message,4,optional" frugal:"4,optional,string" form:"message" json:"message,omitempty" query:"message"`
 }
 
-func NewScoreDistributionItem() *ScoreDistributionItem {
-	return &ScoreDistributionItem{}
+func NewExperimentGateRuleVerdict() *ExperimentGateRuleVerdict {
+	return &ExperimentGateRuleVerdict{}
 }
 
-func (p *ScoreDistributionItem) InitDefault() {
+func (p *ExperimentGateRuleVerdict) InitDefault() {
 }
 
-var ScoreDistributionItem_Score_DEFAULT string
+var ExperimentGateRuleVerdict_Rule_DEFAULT *ExperimentGateRule
 
-func (p *ScoreDistributionItem) GetScore() (v string) {
+func (p *ExperimentGateRuleVerdict) GetRule() (v *ExperimentGateRule) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetScore() {
-		return ScoreDistributionItem_Score_DEFAULT
+	if !p.IsSetRule() {
+		return ExperimentGateRuleVerdict_Rule_DEFAULT
 	}
-	return *p.Score
+	return p.Rule
 }
 
-var ScoreDistributionItem_Count_DEFAULT int64
+var ExperimentGateRuleVerdict_Status_DEFAULT ExperimentGateStatus
 
-func (p *ScoreDistributionItem) GetCount() (v int64) {
+func (p *ExperimentGateRuleVerdict) GetStatus() (v ExperimentGateStatus) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetCount() {
-		return ScoreDistributionItem_Count_DEFAULT
+	if !p.IsSetStatus() {
+		return ExperimentGateRuleVerdict_Status_DEFAULT
 	}
-	return *p.Count
+	return *p.Status
 }
 
-var ScoreDistributionItem_Percentage_DEFAULT float64
+var ExperimentGateRuleVerdict_ActualValue_DEFAULT float64
 
-func (p *ScoreDistributionItem) GetPercentage() (v float64) {
+func (p *ExperimentGateRuleVerdict) GetActualValue() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetPercentage() {
-		return ScoreDistributionItem_Percentage_DEFAULT
+	if !p.IsSetActualValue() {
+		return ExperimentGateRuleVerdict_ActualValue_DEFAULT
 	}
-	return *p.Percentage
+	return *p.ActualValue
 }
-func (p *ScoreDistributionItem) SetScore(val *string) {
-	p.Score = val
+
+var ExperimentGateRuleVerdict_Message_DEFAULT string
+
+func (p *ExperimentGateRuleVerdict) GetMessage() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMessage() {
+		return ExperimentGateRuleVerdict_Message_DEFAULT
+	}
+	return *p.Message
 }
-func (p *ScoreDistributionItem) SetCount(val *int64) {
-	p.Count = val
+func (p *ExperimentGateRuleVerdict) SetRule(val *ExperimentGateRule) {
+	p.Rule = val
 }
-func (p *ScoreDistributionItem) SetPercentage(val *float64) {
-	p.Percentage = val
+func (p *ExperimentGateRuleVerdict) SetStatus(val *ExperimentGateStatus) {
+	p.Status = val
+}
+func (p *ExperimentGateRuleVerdict) SetActualValue(val *float64) {
+	p.ActualValue = val
+}
+func (p *ExperimentGateRuleVerdict) SetMessage(val *string) {
+	p.Message = val
+}
+
+var fieldIDToName_ExperimentGateRuleVerdict = map[int16]string{
+	1: "rule",
+	2: "status",
+	3: "actual_value",
+	4: "message",
 }
 
-var fieldIDToName_ScoreDistributionItem = map[int16]string{
-	1: "score",
-	2: "count",
-	3: "percentage",
+func (p *ExperimentGateRuleVerdict) IsSetRule() bool {
+	return p.Rule != nil
 }
 
-func (p *ScoreDistributionItem) IsSetScore() bool {
-	return p.Score != nil
+func (p *ExperimentGateRuleVerdict) IsSetStatus() bool {
+	return p.Status != nil
 }
 
-func (p *ScoreDistributionItem) IsSetCount() bool {
-	return p.Count != nil
+func (p *ExperimentGateRuleVerdict) IsSetActualValue() bool {
+	return p.ActualValue != nil
 }
 
-func (p *ScoreDistributionItem) IsSetPercentage() bool {
-	return p.Percentage != nil
+func (p *ExperimentGateRuleVerdict) IsSetMessage() bool {
+	return p.Message != nil
 }
 
-func (p *ScoreDistributionItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentGateRuleVerdict) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3368,7 +5550,7 @@ func (p *ScoreDistributionItem) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3376,7 +5558,7 @@ func (p *ScoreDistributionItem) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3391,6 +5573,14 @@ func (p *ScoreDistributionItem) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3410,7 +5600,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScoreDistributionItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentGateRuleVerdict[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3420,43 +5610,51 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ScoreDistributionItem) ReadField1(iprot thrift.TProtocol) error {
+func (p *ExperimentGateRuleVerdict) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExperimentGateRule()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Rule = _field
+	return nil
+}
+func (p *ExperimentGateRuleVerdict) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field *ExperimentGateStatus
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Score = _field
+	p.Status = _field
 	return nil
 }
-func (p *ScoreDistributionItem) ReadField2(iprot thrift.TProtocol) error {
+func (p *ExperimentGateRuleVerdict) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Count = _field
+	p.ActualValue = _field
 	return nil
 }
-func (p *ScoreDistributionItem) ReadField3(iprot thrift.TProtocol) error {
+func (p *ExperimentGateRuleVerdict) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Percentage = _field
+	p.Message = _field
 	return nil
 }
 
-func (p *ScoreDistributionItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentGateRuleVerdict) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ScoreDistributionItem"); err != nil {
+	if err = oprot.WriteStructBegin("ExperimentGateRuleVerdict"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3472,6 +5670,10 @@ func (p *ScoreDistributionItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3490,12 +5692,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ScoreDistributionItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScore() {
-		if err = oprot.WriteFieldBegin("score", thrift.STRING, 1); err != nil {
+func (p *ExperimentGateRuleVerdict) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRule() {
+		if err = oprot.WriteFieldBegin("rule", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Score); err != nil {
+		if err := p.Rule.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3508,12 +5710,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ScoreDistributionItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCount() {
-		if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
+func (p *ExperimentGateRuleVerdict) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.Count); err != nil {
+		if err := oprot.WriteString(*p.Status); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3526,12 +5728,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ScoreDistributionItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPercentage() {
-		if err = oprot.WriteFieldBegin("percentage", thrift.DOUBLE, 3); err != nil {
+func (p *ExperimentGateRuleVerdict) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualValue() {
+		if err = oprot.WriteFieldBegin("actual_value", thrift.DOUBLE, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteDouble(*p.Percentage); err != nil {
+		if err := oprot.WriteDouble(*p.ActualValue); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3544,190 +5746,176 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *ExperimentGateRuleVerdict) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Message); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
-func (p *ScoreDistributionItem) String() string {
+func (p *ExperimentGateRuleVerdict) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ScoreDistributionItem(%+v)", *p)
+	return fmt.Sprintf("ExperimentGateRuleVerdict(%+v)", *p)
 
 }
 
-func (p *ScoreDistributionItem) DeepEqual(ano *ScoreDistributionItem) bool {
+func (p *ExperimentGateRuleVerdict) DeepEqual(ano *ExperimentGateRuleVerdict) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Score) {
+	if !p.Field1DeepEqual(ano.Rule) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.Count) {
+	if !p.Field2DeepEqual(ano.Status) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Percentage) {
+	if !p.Field3DeepEqual(ano.ActualValue) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Message) {
 		return false
 	}
 	return true
 }
 
-func (p *ScoreDistributionItem) Field1DeepEqual(src *string) bool {
+func (p *ExperimentGateRuleVerdict) Field1DeepEqual(src *ExperimentGateRule) bool {
 
-	if p.Score == src {
+	if !p.Rule.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExperimentGateRuleVerdict) Field2DeepEqual(src *ExperimentGateStatus) bool {
+
+	if p.Status == src {
 		return true
-	} else if p.Score == nil || src == nil {
+	} else if p.Status == nil || src == nil {
 		return false
 	}
-	if strings.Compare(*p.Score, *src) != 0 {
+	if strings.Compare(*p.Status, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ScoreDistributionItem) Field2DeepEqual(src *int64) bool {
+func (p *ExperimentGateRuleVerdict) Field3DeepEqual(src *float64) bool {
 
-	if p.Count == src {
+	if p.ActualValue == src {
 		return true
-	} else if p.Count == nil || src == nil {
+	} else if p.ActualValue == nil || src == nil {
 		return false
 	}
-	if *p.Count != *src {
+	if *p.ActualValue != *src {
 		return false
 	}
 	return true
 }
-func (p *ScoreDistributionItem) Field3DeepEqual(src *float64) bool {
+func (p *ExperimentGateRuleVerdict) Field4DeepEqual(src *string) bool {
 
-	if p.Percentage == src {
+	if p.Message == src {
 		return true
-	} else if p.Percentage == nil || src == nil {
+	} else if p.Message == nil || src == nil {
 		return false
 	}
-	if *p.Percentage != *src {
+	if strings.Compare(*p.Message, *src) != 0 {
 		return false
 	}
 	return true
 }
 
-// 实验统计
-type ExperimentStatistics struct {
-	PendingTurnCount    *int32 `thrift:"pending_turn_count,1,optional" frugal:"1,optional,i32" form:"pending_turn_count" json:"pending_turn_count,omitempty" query:"pending_turn_count"`
-	SuccessTurnCount    *int32 `thrift:"success_turn_count,2,optional" frugal:"2,optional,i32" form:"success_turn_count" json:"success_turn_count,omitempty" query:"success_turn_count"`
-	FailedTurnCount     *int32 `thrift:"failed_turn_count,3,optional" frugal:"3,optional,i32" form:"failed_turn_count" json:"failed_turn_count,omitempty" query:"failed_turn_count"`
-	TerminatedTurnCount *int32 `thrift:"terminated_turn_count,4,optional" frugal:"4,optional,i32" form:"terminated_turn_count" json:"terminated_turn_count,omitempty" query:"terminated_turn_count"`
-	ProcessingTurnCount *int32 `thrift:"processing_turn_count,5,optional" frugal:"5,optional,i32" form:"processing_turn_count" json:"processing_turn_count,omitempty" query:"processing_turn_count"`
-}
-
-func NewExperimentStatistics() *ExperimentStatistics {
-	return &ExperimentStatistics{}
-}
-
-func (p *ExperimentStatistics) InitDefault() {
+type ExperimentGateVerdict struct {
+	Status       *ExperimentGateStatus        `thrift:"status,1,optional" frugal:"1,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	RuleVerdicts []*ExperimentGateRuleVerdict `thrift:"rule_verdicts,2,optional" frugal:"2,optional,list<ExperimentGateRuleVerdict>" form:"rule_verdicts" json:"rule_verdicts,omitempty" query:"rule_verdicts"`
+	// 毫秒时间戳
+	EvaluatedAt *int64 `thrift:"evaluated_at,3,optional" frugal:"3,optional,i64" json:"evaluated_at" form:"evaluated_at" query:"evaluated_at"`
 }
 
-var ExperimentStatistics_PendingTurnCount_DEFAULT int32
-
-func (p *ExperimentStatistics) GetPendingTurnCount() (v int32) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetPendingTurnCount() {
-		return ExperimentStatistics_PendingTurnCount_DEFAULT
-	}
-	return *p.PendingTurnCount
+func NewExperimentGateVerdict() *ExperimentGateVerdict {
+	return &ExperimentGateVerdict{}
 }
 
-var ExperimentStatistics_SuccessTurnCount_DEFAULT int32
-
-func (p *ExperimentStatistics) GetSuccessTurnCount() (v int32) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetSuccessTurnCount() {
-		return ExperimentStatistics_SuccessTurnCount_DEFAULT
-	}
-	return *p.SuccessTurnCount
+func (p *ExperimentGateVerdict) InitDefault() {
 }
 
-var ExperimentStatistics_FailedTurnCount_DEFAULT int32
+var ExperimentGateVerdict_Status_DEFAULT ExperimentGateStatus
 
-func (p *ExperimentStatistics) GetFailedTurnCount() (v int32) {
+func (p *ExperimentGateVerdict) GetStatus() (v ExperimentGateStatus) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFailedTurnCount() {
-		return ExperimentStatistics_FailedTurnCount_DEFAULT
+	if !p.IsSetStatus() {
+		return ExperimentGateVerdict_Status_DEFAULT
 	}
-	return *p.FailedTurnCount
+	return *p.Status
 }
 
-var ExperimentStatistics_TerminatedTurnCount_DEFAULT int32
+var ExperimentGateVerdict_RuleVerdicts_DEFAULT []*ExperimentGateRuleVerdict
 
-func (p *ExperimentStatistics) GetTerminatedTurnCount() (v int32) {
+func (p *ExperimentGateVerdict) GetRuleVerdicts() (v []*ExperimentGateRuleVerdict) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTerminatedTurnCount() {
-		return ExperimentStatistics_TerminatedTurnCount_DEFAULT
+	if !p.IsSetRuleVerdicts() {
+		return ExperimentGateVerdict_RuleVerdicts_DEFAULT
 	}
-	return *p.TerminatedTurnCount
+	return p.RuleVerdicts
 }
 
-var ExperimentStatistics_ProcessingTurnCount_DEFAULT int32
+var ExperimentGateVerdict_EvaluatedAt_DEFAULT int64
 
-func (p *ExperimentStatistics) GetProcessingTurnCount() (v int32) {
+func (p *ExperimentGateVerdict) GetEvaluatedAt() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetProcessingTurnCount() {
-		return ExperimentStatistics_ProcessingTurnCount_DEFAULT
+	if !p.IsSetEvaluatedAt() {
+		return ExperimentGateVerdict_EvaluatedAt_DEFAULT
 	}
-	return *p.ProcessingTurnCount
-}
-func (p *ExperimentStatistics) SetPendingTurnCount(val *int32) {
-	p.PendingTurnCount = val
-}
-func (p *ExperimentStatistics) SetSuccessTurnCount(val *int32) {
-	p.SuccessTurnCount = val
-}
-func (p *ExperimentStatistics) SetFailedTurnCount(val *int32) {
-	p.FailedTurnCount = val
+	return *p.EvaluatedAt
 }
-func (p *ExperimentStatistics) SetTerminatedTurnCount(val *int32) {
-	p.TerminatedTurnCount = val
-}
-func (p *ExperimentStatistics) SetProcessingTurnCount(val *int32) {
-	p.ProcessingTurnCount = val
+func (p *ExperimentGateVerdict) SetStatus(val *ExperimentGateStatus) {
+	p.Status = val
 }
-
-var fieldIDToName_ExperimentStatistics = map[int16]string{
-	1: "pending_turn_count",
-	2: "success_turn_count",
-	3: "failed_turn_count",
-	4: "terminated_turn_count",
-	5: "processing_turn_count",
+func (p *ExperimentGateVerdict) SetRuleVerdicts(val []*ExperimentGateRuleVerdict) {
+	p.RuleVerdicts = val
 }
-
-func (p *ExperimentStatistics) IsSetPendingTurnCount() bool {
-	return p.PendingTurnCount != nil
+func (p *ExperimentGateVerdict) SetEvaluatedAt(val *int64) {
+	p.EvaluatedAt = val
 }
 
-func (p *ExperimentStatistics) IsSetSuccessTurnCount() bool {
-	return p.SuccessTurnCount != nil
+var fieldIDToName_ExperimentGateVerdict = map[int16]string{
+	1: "status",
+	2: "rule_verdicts",
+	3: "evaluated_at",
 }
 
-func (p *ExperimentStatistics) IsSetFailedTurnCount() bool {
-	return p.FailedTurnCount != nil
+func (p *ExperimentGateVerdict) IsSetStatus() bool {
+	return p.Status != nil
 }
 
-func (p *ExperimentStatistics) IsSetTerminatedTurnCount() bool {
-	return p.TerminatedTurnCount != nil
+func (p *ExperimentGateVerdict) IsSetRuleVerdicts() bool {
+	return p.RuleVerdicts != nil
 }
 
-func (p *ExperimentStatistics) IsSetProcessingTurnCount() bool {
-	return p.ProcessingTurnCount != nil
+func (p *ExperimentGateVerdict) IsSetEvaluatedAt() bool {
+	return p.EvaluatedAt != nil
 }
 
-func (p *ExperimentStatistics) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentGateVerdict) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3746,7 +5934,7 @@ func (p *ExperimentStatistics) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3754,7 +5942,7 @@ func (p *ExperimentStatistics) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3762,29 +5950,13 @@ func (p *ExperimentStatistics) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3797,82 +5969,72 @@ func (p *ExperimentStatistics) Read(iprot thrift.TProtocol) (err error) {
 	if err = iprot.ReadStructEnd(); err != nil {
 		goto ReadStructEndError
 	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentStatistics[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *ExperimentStatistics) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PendingTurnCount = _field
-	return nil
-}
-func (p *ExperimentStatistics) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.SuccessTurnCount = _field
+
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentGateVerdict[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *ExperimentStatistics) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *ExperimentGateVerdict) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *ExperimentGateStatus
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.FailedTurnCount = _field
+	p.Status = _field
 	return nil
 }
-func (p *ExperimentStatistics) ReadField4(iprot thrift.TProtocol) error {
+func (p *ExperimentGateVerdict) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ExperimentGateRuleVerdict, 0, size)
+	values := make([]ExperimentGateRuleVerdict, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.TerminatedTurnCount = _field
+	p.RuleVerdicts = _field
 	return nil
 }
-func (p *ExperimentStatistics) ReadField5(iprot thrift.TProtocol) error {
+func (p *ExperimentGateVerdict) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ProcessingTurnCount = _field
+	p.EvaluatedAt = _field
 	return nil
 }
 
-func (p *ExperimentStatistics) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentGateVerdict) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExperimentStatistics"); err != nil {
+	if err = oprot.WriteStructBegin("ExperimentGateVerdict"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3888,14 +6050,6 @@ func (p *ExperimentStatistics) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3914,12 +6068,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentStatistics) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPendingTurnCount() {
-		if err = oprot.WriteFieldBegin("pending_turn_count", thrift.I32, 1); err != nil {
+func (p *ExperimentGateVerdict) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.PendingTurnCount); err != nil {
+		if err := oprot.WriteString(*p.Status); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3932,12 +6086,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ExperimentStatistics) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccessTurnCount() {
-		if err = oprot.WriteFieldBegin("success_turn_count", thrift.I32, 2); err != nil {
+func (p *ExperimentGateVerdict) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRuleVerdicts() {
+		if err = oprot.WriteFieldBegin("rule_verdicts", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.SuccessTurnCount); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RuleVerdicts)); err != nil {
+			return err
+		}
+		for _, v := range p.RuleVerdicts {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3950,12 +6112,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ExperimentStatistics) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFailedTurnCount() {
-		if err = oprot.WriteFieldBegin("failed_turn_count", thrift.I32, 3); err != nil {
+func (p *ExperimentGateVerdict) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatedAt() {
+		if err = oprot.WriteFieldBegin("evaluated_at", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.FailedTurnCount); err != nil {
+		if err := oprot.WriteI64(*p.EvaluatedAt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3968,137 +6130,71 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ExperimentStatistics) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTerminatedTurnCount() {
-		if err = oprot.WriteFieldBegin("terminated_turn_count", thrift.I32, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.TerminatedTurnCount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *ExperimentStatistics) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProcessingTurnCount() {
-		if err = oprot.WriteFieldBegin("processing_turn_count", thrift.I32, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.ProcessingTurnCount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
 
-func (p *ExperimentStatistics) String() string {
+func (p *ExperimentGateVerdict) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentStatistics(%+v)", *p)
+	return fmt.Sprintf("ExperimentGateVerdict(%+v)", *p)
 
 }
 
-func (p *ExperimentStatistics) DeepEqual(ano *ExperimentStatistics) bool {
+func (p *ExperimentGateVerdict) DeepEqual(ano *ExperimentGateVerdict) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.PendingTurnCount) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.SuccessTurnCount) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.FailedTurnCount) {
-		return false
-	}
-	if !p.Field4DeepEqual(ano.TerminatedTurnCount) {
-		return false
-	}
-	if !p.Field5DeepEqual(ano.ProcessingTurnCount) {
+	if !p.Field1DeepEqual(ano.Status) {
 		return false
 	}
-	return true
-}
-
-func (p *ExperimentStatistics) Field1DeepEqual(src *int32) bool {
-
-	if p.PendingTurnCount == src {
-		return true
-	} else if p.PendingTurnCount == nil || src == nil {
+	if !p.Field2DeepEqual(ano.RuleVerdicts) {
 		return false
 	}
-	if *p.PendingTurnCount != *src {
+	if !p.Field3DeepEqual(ano.EvaluatedAt) {
 		return false
 	}
 	return true
 }
-func (p *ExperimentStatistics) Field2DeepEqual(src *int32) bool {
 
-	if p.SuccessTurnCount == src {
-		return true
-	} else if p.SuccessTurnCount == nil || src == nil {
-		return false
-	}
-	if *p.SuccessTurnCount != *src {
-		return false
-	}
-	return true
-}
-func (p *ExperimentStatistics) Field3DeepEqual(src *int32) bool {
+func (p *ExperimentGateVerdict) Field1DeepEqual(src *ExperimentGateStatus) bool {
 
-	if p.FailedTurnCount == src {
+	if p.Status == src {
 		return true
-	} else if p.FailedTurnCount == nil || src == nil {
+	} else if p.Status == nil || src == nil {
 		return false
 	}
-	if *p.FailedTurnCount != *src {
+	if strings.Compare(*p.Status, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *ExperimentStatistics) Field4DeepEqual(src *int32) bool {
+func (p *ExperimentGateVerdict) Field2DeepEqual(src []*ExperimentGateRuleVerdict) bool {
 
-	if p.TerminatedTurnCount == src {
-		return true
-	} else if p.TerminatedTurnCount == nil || src == nil {
+	if len(p.RuleVerdicts) != len(src) {
 		return false
 	}
-	if *p.TerminatedTurnCount != *src {
-		return false
+	for i, v := range p.RuleVerdicts {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
 	}
 	return true
 }
-func (p *ExperimentStatistics) Field5DeepEqual(src *int32) bool {
+func (p *ExperimentGateVerdict) Field3DeepEqual(src *int64) bool {
 
-	if p.ProcessingTurnCount == src {
+	if p.EvaluatedAt == src {
 		return true
-	} else if p.ProcessingTurnCount == nil || src == nil {
+	} else if p.EvaluatedAt == nil || src == nil {
 		return false
 	}
-	if *p.ProcessingTurnCount != *src {
+	if *p.EvaluatedAt != *src {
 		return false
 	}
 	return true
 }
 
-// 评测实验
 type Experiment struct {
 	// 基本信息
 	ID          *int64  `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
@@ -4121,7 +6217,11 @@ type Experiment struct {
 	EvalTarget            *eval_target.EvalTarget  `thrift:"eval_target,34,optional" frugal:"34,optional,eval_target.EvalTarget" form:"eval_target" json:"eval_target,omitempty" query:"eval_target"`
 	// 统计信息
 	ExptStats *ExperimentStatistics `thrift:"expt_stats,50,optional" frugal:"50,optional,ExperimentStatistics" form:"expt_stats" json:"expt_stats,omitempty" query:"expt_stats"`
-	BaseInfo  *common.BaseInfo      `thrift:"base_info,100,optional" frugal:"100,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	// 门禁信息
+	GateConfig *ExperimentGateConfig `thrift:"gate_config,60,optional" frugal:"60,optional,ExperimentGateConfig" form:"gate_config" json:"gate_config,omitempty" query:"gate_config"`
+	// 实验完成后计算，CI 可据此阻断发布
+	GateVerdict *ExperimentGateVerdict `thrift:"gate_verdict,61,optional" frugal:"61,optional,ExperimentGateVerdict" form:"gate_verdict" json:"gate_verdict,omitempty" query:"gate_verdict"`
+	BaseInfo    *common.BaseInfo       `thrift:"base_info,100,optional" frugal:"100,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
 }
 
 func NewExperiment() *Experiment {
@@ -4287,6 +6387,30 @@ func (p *Experiment) GetExptStats() (v *ExperimentStatistics) {
 	return p.ExptStats
 }
 
+var Experiment_GateConfig_DEFAULT *ExperimentGateConfig
+
+func (p *Experiment) GetGateConfig() (v *ExperimentGateConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateConfig() {
+		return Experiment_GateConfig_DEFAULT
+	}
+	return p.GateConfig
+}
+
+var Experiment_GateVerdict_DEFAULT *ExperimentGateVerdict
+
+func (p *Experiment) GetGateVerdict() (v *ExperimentGateVerdict) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateVerdict() {
+		return Experiment_GateVerdict_DEFAULT
+	}
+	return p.GateVerdict
+}
+
 var Experiment_BaseInfo_DEFAULT *common.BaseInfo
 
 func (p *Experiment) GetBaseInfo() (v *common.BaseInfo) {
@@ -4337,6 +6461,12 @@ func (p *Experiment) SetEvalTarget(val *eval_target.EvalTarget) {
 func (p *Experiment) SetExptStats(val *ExperimentStatistics) {
 	p.ExptStats = val
 }
+func (p *Experiment) SetGateConfig(val *ExperimentGateConfig) {
+	p.GateConfig = val
+}
+func (p *Experiment) SetGateVerdict(val *ExperimentGateVerdict) {
+	p.GateVerdict = val
+}
 func (p *Experiment) SetBaseInfo(val *common.BaseInfo) {
 	p.BaseInfo = val
 }
@@ -4355,6 +6485,8 @@ var fieldIDToName_Experiment = map[int16]string{
 	33:  "eval_set",
 	34:  "eval_target",
 	50:  "expt_stats",
+	60:  "gate_config",
+	61:  "gate_verdict",
 	100: "base_info",
 }
 
@@ -4410,6 +6542,14 @@ func (p *Experiment) IsSetExptStats() bool {
 	return p.ExptStats != nil
 }
 
+func (p *Experiment) IsSetGateConfig() bool {
+	return p.GateConfig != nil
+}
+
+func (p *Experiment) IsSetGateVerdict() bool {
+	return p.GateVerdict != nil
+}
+
 func (p *Experiment) IsSetBaseInfo() bool {
 	return p.BaseInfo != nil
 }
@@ -4536,6 +6676,22 @@ func (p *Experiment) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 60:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField60(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 61:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField61(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 100:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField100(iprot); err != nil {
@@ -4713,6 +6869,22 @@ func (p *Experiment) ReadField50(iprot thrift.TProtocol) error {
 	p.ExptStats = _field
 	return nil
 }
+func (p *Experiment) ReadField60(iprot thrift.TProtocol) error {
+	_field := NewExperimentGateConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateConfig = _field
+	return nil
+}
+func (p *Experiment) ReadField61(iprot thrift.TProtocol) error {
+	_field := NewExperimentGateVerdict()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateVerdict = _field
+	return nil
+}
 func (p *Experiment) ReadField100(iprot thrift.TProtocol) error {
 	_field := common.NewBaseInfo()
 	if err := _field.Read(iprot); err != nil {
@@ -4780,6 +6952,14 @@ func (p *Experiment) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 50
 			goto WriteFieldError
 		}
+		if err = p.writeField60(oprot); err != nil {
+			fieldId = 60
+			goto WriteFieldError
+		}
+		if err = p.writeField61(oprot); err != nil {
+			fieldId = 61
+			goto WriteFieldError
+		}
 		if err = p.writeField100(oprot); err != nil {
 			fieldId = 100
 			goto WriteFieldError
@@ -5044,6 +7224,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 50 end error: ", p), err)
 }
+func (p *Experiment) writeField60(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateConfig() {
+		if err = oprot.WriteFieldBegin("gate_config", thrift.STRUCT, 60); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 60 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 60 end error: ", p), err)
+}
+func (p *Experiment) writeField61(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateVerdict() {
+		if err = oprot.WriteFieldBegin("gate_verdict", thrift.STRUCT, 61); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateVerdict.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 61 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 61 end error: ", p), err)
+}
 func (p *Experiment) writeField100(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBaseInfo() {
 		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 100); err != nil {
@@ -5116,6 +7332,12 @@ func (p *Experiment) DeepEqual(ano *Experiment) bool {
 	if !p.Field50DeepEqual(ano.ExptStats) {
 		return false
 	}
+	if !p.Field60DeepEqual(ano.GateConfig) {
+		return false
+	}
+	if !p.Field61DeepEqual(ano.GateVerdict) {
+		return false
+	}
 	if !p.Field100DeepEqual(ano.BaseInfo) {
 		return false
 	}
@@ -5254,6 +7476,20 @@ func (p *Experiment) Field50DeepEqual(src *ExperimentStatistics) bool {
 	}
 	return true
 }
+func (p *Experiment) Field60DeepEqual(src *ExperimentGateConfig) bool {
+
+	if !p.GateConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Experiment) Field61DeepEqual(src *ExperimentGateVerdict) bool {
+
+	if !p.GateVerdict.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *Experiment) Field100DeepEqual(src *common.BaseInfo) bool {
 
 	if !p.BaseInfo.DeepEqual(src) {
@@ -36,6 +36,9 @@ func (p *TokenUsage) IsValid() error {
 func (p *EvaluatorAggregateResult_) IsValid() error {
 	return nil
 }
+func (p *EvaluatorDimensionAggregateResult_) IsValid() error {
+	return nil
+}
 func (p *EvalTargetAggregateResult_) IsValid() error {
 	return nil
 }
@@ -64,6 +67,23 @@ func (p *ScoreDistributionItem) IsValid() error {
 func (p *ExperimentStatistics) IsValid() error {
 	return nil
 }
+func (p *ExperimentGateRule) IsValid() error {
+	return nil
+}
+func (p *ExperimentGateConfig) IsValid() error {
+	return nil
+}
+func (p *ExperimentGateRuleVerdict) IsValid() error {
+	if p.Rule != nil {
+		if err := p.Rule.IsValid(); err != nil {
+			return fmt.Errorf("field Rule not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *ExperimentGateVerdict) IsValid() error {
+	return nil
+}
 func (p *Experiment) IsValid() error {
 	if p.TargetRuntimeParam != nil {
 		if err := p.TargetRuntimeParam.IsValid(); err != nil {
@@ -90,6 +110,16 @@ func (p *Experiment) IsValid() error {
 			return fmt.Errorf("field ExptStats not valid, %w", err)
 		}
 	}
+	if p.GateConfig != nil {
+		if err := p.GateConfig.IsValid(); err != nil {
+			return fmt.Errorf("field GateConfig not valid, %w", err)
+		}
+	}
+	if p.GateVerdict != nil {
+		if err := p.GateVerdict.IsValid(); err != nil {
+			return fmt.Errorf("field GateVerdict not valid, %w", err)
+		}
+	}
 	if p.BaseInfo != nil {
 		if err := p.BaseInfo.IsValid(); err != nil {
 			return fmt.Errorf("field BaseInfo not valid, %w", err)
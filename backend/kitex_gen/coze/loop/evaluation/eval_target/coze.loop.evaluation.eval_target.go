@@ -342,6 +342,10 @@ type CreateEvalTargetParam struct {
 	Region *eval_target.Region `thrift:"region,7,optional" frugal:"7,optional,string" form:"region" json:"region,omitempty" query:"region"`
 	// 有环境限制需要填充这个字段
 	Env *string `thrift:"env,8,optional" frugal:"8,optional,string" form:"env" json:"env,omitempty" query:"env"`
+	// type=CustomRPCServer且access_protocol=http时，通过这个字段注册服务信息
+	CustomRPCServer *eval_target.CustomRPCServer `thrift:"custom_rpc_server,9,optional" frugal:"9,optional,eval_target.CustomRPCServer" form:"custom_rpc_server" json:"custom_rpc_server,omitempty" query:"custom_rpc_server"`
+	// type=OpenAIChat时，通过这个字段注册接口信息
+	OpenaiChat *eval_target.OpenAIChat `thrift:"openai_chat,10,optional" frugal:"10,optional,eval_target.OpenAIChat" form:"openai_chat" json:"openai_chat,omitempty" query:"openai_chat"`
 }
 
 func NewCreateEvalTargetParam() *CreateEvalTargetParam {
@@ -446,6 +450,30 @@ func (p *CreateEvalTargetParam) GetEnv() (v string) {
 	}
 	return *p.Env
 }
+
+var CreateEvalTargetParam_CustomRPCServer_DEFAULT *eval_target.CustomRPCServer
+
+func (p *CreateEvalTargetParam) GetCustomRPCServer() (v *eval_target.CustomRPCServer) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCustomRPCServer() {
+		return CreateEvalTargetParam_CustomRPCServer_DEFAULT
+	}
+	return p.CustomRPCServer
+}
+
+var CreateEvalTargetParam_OpenaiChat_DEFAULT *eval_target.OpenAIChat
+
+func (p *CreateEvalTargetParam) GetOpenaiChat() (v *eval_target.OpenAIChat) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOpenaiChat() {
+		return CreateEvalTargetParam_OpenaiChat_DEFAULT
+	}
+	return p.OpenaiChat
+}
 func (p *CreateEvalTargetParam) SetSourceTargetID(val *string) {
 	p.SourceTargetID = val
 }
@@ -470,16 +498,24 @@ func (p *CreateEvalTargetParam) SetRegion(val *eval_target.Region) {
 func (p *CreateEvalTargetParam) SetEnv(val *string) {
 	p.Env = val
 }
+func (p *CreateEvalTargetParam) SetCustomRPCServer(val *eval_target.CustomRPCServer) {
+	p.CustomRPCServer = val
+}
+func (p *CreateEvalTargetParam) SetOpenaiChat(val *eval_target.OpenAIChat) {
+	p.OpenaiChat = val
+}
 
 var fieldIDToName_CreateEvalTargetParam = map[int16]string{
-	1: "source_target_id",
-	2: "source_target_version",
-	3: "eval_target_type",
-	4: "bot_info_type",
-	5: "bot_publish_version",
-	6: "custom_eval_target",
-	7: "region",
-	8: "env",
+	1:  "source_target_id",
+	2:  "source_target_version",
+	3:  "eval_target_type",
+	4:  "bot_info_type",
+	5:  "bot_publish_version",
+	6:  "custom_eval_target",
+	7:  "region",
+	8:  "env",
+	9:  "custom_rpc_server",
+	10: "openai_chat",
 }
 
 func (p *CreateEvalTargetParam) IsSetSourceTargetID() bool {
@@ -514,6 +550,14 @@ func (p *CreateEvalTargetParam) IsSetEnv() bool {
 	return p.Env != nil
 }
 
+func (p *CreateEvalTargetParam) IsSetCustomRPCServer() bool {
+	return p.CustomRPCServer != nil
+}
+
+func (p *CreateEvalTargetParam) IsSetOpenaiChat() bool {
+	return p.OpenaiChat != nil
+}
+
 func (p *CreateEvalTargetParam) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -596,6 +640,22 @@ func (p *CreateEvalTargetParam) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -712,6 +772,22 @@ func (p *CreateEvalTargetParam) ReadField8(iprot thrift.TProtocol) error {
 	p.Env = _field
 	return nil
 }
+func (p *CreateEvalTargetParam) ReadField9(iprot thrift.TProtocol) error {
+	_field := eval_target.NewCustomRPCServer()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.CustomRPCServer = _field
+	return nil
+}
+func (p *CreateEvalTargetParam) ReadField10(iprot thrift.TProtocol) error {
+	_field := eval_target.NewOpenAIChat()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.OpenaiChat = _field
+	return nil
+}
 
 func (p *CreateEvalTargetParam) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -751,6 +827,14 @@ func (p *CreateEvalTargetParam) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 8
 			goto WriteFieldError
 		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -913,6 +997,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
+func (p *CreateEvalTargetParam) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCustomRPCServer() {
+		if err = oprot.WriteFieldBegin("custom_rpc_server", thrift.STRUCT, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.CustomRPCServer.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *CreateEvalTargetParam) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOpenaiChat() {
+		if err = oprot.WriteFieldBegin("openai_chat", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.OpenaiChat.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
 
 func (p *CreateEvalTargetParam) String() string {
 	if p == nil {
@@ -952,6 +1072,12 @@ func (p *CreateEvalTargetParam) DeepEqual(ano *CreateEvalTargetParam) bool {
 	if !p.Field8DeepEqual(ano.Env) {
 		return false
 	}
+	if !p.Field9DeepEqual(ano.CustomRPCServer) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.OpenaiChat) {
+		return false
+	}
 	return true
 }
 
@@ -1046,6 +1172,20 @@ func (p *CreateEvalTargetParam) Field8DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *CreateEvalTargetParam) Field9DeepEqual(src *eval_target.CustomRPCServer) bool {
+
+	if !p.CustomRPCServer.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateEvalTargetParam) Field10DeepEqual(src *eval_target.OpenAIChat) bool {
+
+	if !p.OpenaiChat.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 type CreateEvalTargetResponse struct {
 	ID        *int64         `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
@@ -40,6 +40,16 @@ func (p *CreateEvalTargetParam) IsValid() error {
 			return fmt.Errorf("field CustomEvalTarget not valid, %w", err)
 		}
 	}
+	if p.CustomRPCServer != nil {
+		if err := p.CustomRPCServer.IsValid(); err != nil {
+			return fmt.Errorf("field CustomRPCServer not valid, %w", err)
+		}
+	}
+	if p.OpenaiChat != nil {
+		if err := p.OpenaiChat.IsValid(); err != nil {
+			return fmt.Errorf("field OpenaiChat not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *CreateEvalTargetResponse) IsValid() error {
@@ -390,6 +390,34 @@ func (p *CreateEvalTargetParam) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -522,6 +550,30 @@ func (p *CreateEvalTargetParam) FastReadField8(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *CreateEvalTargetParam) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_target.NewCustomRPCServer()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.CustomRPCServer = _field
+	return offset, nil
+}
+
+func (p *CreateEvalTargetParam) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+	_field := eval_target.NewOpenAIChat()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.OpenaiChat = _field
+	return offset, nil
+}
+
 func (p *CreateEvalTargetParam) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -537,6 +589,8 @@ func (p *CreateEvalTargetParam) FastWriteNocopy(buf []byte, w thrift.NocopyWrite
 		offset += p.fastWriteField6(buf[offset:], w)
 		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -553,6 +607,8 @@ func (p *CreateEvalTargetParam) BLength() int {
 		l += p.field6Length()
 		l += p.field7Length()
 		l += p.field8Length()
+		l += p.field9Length()
+		l += p.field10Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -630,6 +686,24 @@ func (p *CreateEvalTargetParam) fastWriteField8(buf []byte, w thrift.NocopyWrite
 	return offset
 }
 
+func (p *CreateEvalTargetParam) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCustomRPCServer() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 9)
+		offset += p.CustomRPCServer.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateEvalTargetParam) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetOpenaiChat() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 10)
+		offset += p.OpenaiChat.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *CreateEvalTargetParam) field1Length() int {
 	l := 0
 	if p.IsSetSourceTargetID() {
@@ -702,6 +776,24 @@ func (p *CreateEvalTargetParam) field8Length() int {
 	return l
 }
 
+func (p *CreateEvalTargetParam) field9Length() int {
+	l := 0
+	if p.IsSetCustomRPCServer() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.CustomRPCServer.BLength()
+	}
+	return l
+}
+
+func (p *CreateEvalTargetParam) field10Length() int {
+	l := 0
+	if p.IsSetOpenaiChat() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.OpenaiChat.BLength()
+	}
+	return l
+}
+
 func (p *CreateEvalTargetParam) DeepCopy(s interface{}) error {
 	src, ok := s.(*CreateEvalTargetParam)
 	if !ok {
@@ -764,6 +856,24 @@ func (p *CreateEvalTargetParam) DeepCopy(s interface{}) error {
 		p.Env = &tmp
 	}
 
+	var _customRPCServer *eval_target.CustomRPCServer
+	if src.CustomRPCServer != nil {
+		_customRPCServer = &eval_target.CustomRPCServer{}
+		if err := _customRPCServer.DeepCopy(src.CustomRPCServer); err != nil {
+			return err
+		}
+	}
+	p.CustomRPCServer = _customRPCServer
+
+	var _openaiChat *eval_target.OpenAIChat
+	if src.OpenaiChat != nil {
+		_openaiChat = &eval_target.OpenAIChat{}
+		if err := _openaiChat.DeepCopy(src.OpenaiChat); err != nil {
+			return err
+		}
+	}
+	p.OpenaiChat = _openaiChat
+
 	return nil
 }
 
@@ -52,8 +52,10 @@ type CreateExperimentRequest struct {
 	EvaluatorScoreWeights map[int64]float64 `thrift:"evaluator_score_weights,42,optional" frugal:"42,optional,map<i64:double>" json:"evaluator_score_weights" form:"evaluator_score_weights" `
 	ExptTemplateID        *int64            `thrift:"expt_template_id,43,optional" frugal:"43,optional,i64" json:"expt_template_id" form:"expt_template_id" `
 	ItemRetryNum          *int32            `thrift:"item_retry_num,45,optional" frugal:"45,optional,i32" form:"item_retry_num" json:"item_retry_num,omitempty"`
-	Session               *common.Session   `thrift:"session,200,optional" frugal:"200,optional,common.Session" form:"session" json:"session,omitempty" query:"session"`
-	Base                  *base.Base        `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	// 门禁规则，实验完成时计算门禁结论
+	GateConfig *expt.ExptGateConfig `thrift:"gate_config,46,optional" frugal:"46,optional,expt.ExptGateConfig" form:"gate_config" json:"gate_config,omitempty"`
+	Session    *common.Session      `thrift:"session,200,optional" frugal:"200,optional,common.Session" form:"session" json:"session,omitempty" query:"session"`
+	Base       *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
 func NewCreateExperimentRequest() *CreateExperimentRequest {
@@ -334,6 +336,18 @@ func (p *CreateExperimentRequest) GetItemRetryNum() (v int32) {
 	return *p.ItemRetryNum
 }
 
+var CreateExperimentRequest_GateConfig_DEFAULT *expt.ExptGateConfig
+
+func (p *CreateExperimentRequest) GetGateConfig() (v *expt.ExptGateConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateConfig() {
+		return CreateExperimentRequest_GateConfig_DEFAULT
+	}
+	return p.GateConfig
+}
+
 var CreateExperimentRequest_Session_DEFAULT *common.Session
 
 func (p *CreateExperimentRequest) GetSession() (v *common.Session) {
@@ -426,6 +440,9 @@ func (p *CreateExperimentRequest) SetExptTemplateID(val *int64) {
 func (p *CreateExperimentRequest) SetItemRetryNum(val *int32) {
 	p.ItemRetryNum = val
 }
+func (p *CreateExperimentRequest) SetGateConfig(val *expt.ExptGateConfig) {
+	p.GateConfig = val
+}
 func (p *CreateExperimentRequest) SetSession(val *common.Session) {
 	p.Session = val
 }
@@ -457,6 +474,7 @@ var fieldIDToName_CreateExperimentRequest = map[int16]string{
 	42:  "evaluator_score_weights",
 	43:  "expt_template_id",
 	45:  "item_retry_num",
+	46:  "gate_config",
 	200: "session",
 	255: "Base",
 }
@@ -549,6 +567,10 @@ func (p *CreateExperimentRequest) IsSetItemRetryNum() bool {
 	return p.ItemRetryNum != nil
 }
 
+func (p *CreateExperimentRequest) IsSetGateConfig() bool {
+	return p.GateConfig != nil
+}
+
 func (p *CreateExperimentRequest) IsSetSession() bool {
 	return p.Session != nil
 }
@@ -761,6 +783,14 @@ func (p *CreateExperimentRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 46:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField46(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 200:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField200(iprot); err != nil {
@@ -1112,6 +1142,14 @@ func (p *CreateExperimentRequest) ReadField45(iprot thrift.TProtocol) error {
 	p.ItemRetryNum = _field
 	return nil
 }
+func (p *CreateExperimentRequest) ReadField46(iprot thrift.TProtocol) error {
+	_field := expt.NewExptGateConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateConfig = _field
+	return nil
+}
 func (p *CreateExperimentRequest) ReadField200(iprot thrift.TProtocol) error {
 	_field := common.NewSession()
 	if err := _field.Read(iprot); err != nil {
@@ -1227,6 +1265,10 @@ func (p *CreateExperimentRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 45
 			goto WriteFieldError
 		}
+		if err = p.writeField46(oprot); err != nil {
+			fieldId = 46
+			goto WriteFieldError
+		}
 		if err = p.writeField200(oprot); err != nil {
 			fieldId = 200
 			goto WriteFieldError
@@ -1700,6 +1742,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 45 end error: ", p), err)
 }
+func (p *CreateExperimentRequest) writeField46(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateConfig() {
+		if err = oprot.WriteFieldBegin("gate_config", thrift.STRUCT, 46); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 46 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 46 end error: ", p), err)
+}
 func (p *CreateExperimentRequest) writeField200(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSession() {
 		if err = oprot.WriteFieldBegin("session", thrift.STRUCT, 200); err != nil {
@@ -1820,6 +1880,9 @@ func (p *CreateExperimentRequest) DeepEqual(ano *CreateExperimentRequest) bool {
 	if !p.Field45DeepEqual(ano.ItemRetryNum) {
 		return false
 	}
+	if !p.Field46DeepEqual(ano.GateConfig) {
+		return false
+	}
 	if !p.Field200DeepEqual(ano.Session) {
 		return false
 	}
@@ -2089,6 +2152,13 @@ func (p *CreateExperimentRequest) Field45DeepEqual(src *int32) bool {
 	}
 	return true
 }
+func (p *CreateExperimentRequest) Field46DeepEqual(src *expt.ExptGateConfig) bool {
+
+	if !p.GateConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *CreateExperimentRequest) Field200DeepEqual(src *common.Session) bool {
 
 	if !p.Session.DeepEqual(src) {
@@ -2366,12 +2436,14 @@ type SubmitExperimentRequest struct {
 	// 补充的评估器id+version关联评估器方式，和evaluator_version_ids共同使用，兼容老逻辑
 	EvaluatorIDVersionList []*evaluator.EvaluatorIDVersionItem `thrift:"evaluator_id_version_list,40,optional" frugal:"40,optional,list<evaluator.EvaluatorIDVersionItem>" form:"evaluator_id_version_list" json:"evaluator_id_version_list,omitempty"`
 	// 是否启用评估器得分加权汇总，以及各评估器的权重配置（key 为 evaluator_version_id，value 为权重）
-	EnableWeightedScore *bool             `thrift:"enable_weighted_score,41,optional" frugal:"41,optional,bool" json:"enable_weighted_score" form:"enable_weighted_score" `
-	ExptTemplateID      *int64            `thrift:"expt_template_id,42,optional" frugal:"42,optional,i64" json:"expt_template_id" form:"expt_template_id" `
-	ItemRetryNum        *int32            `thrift:"item_retry_num,45,optional" frugal:"45,optional,i32" form:"item_retry_num" json:"item_retry_num,omitempty"`
-	Ext                 map[string]string `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty"`
-	Session             *common.Session   `thrift:"session,200,optional" frugal:"200,optional,common.Session" form:"session" json:"session,omitempty" query:"session"`
-	Base                *base.Base        `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	EnableWeightedScore *bool  `thrift:"enable_weighted_score,41,optional" frugal:"41,optional,bool" json:"enable_weighted_score" form:"enable_weighted_score" `
+	ExptTemplateID      *int64 `thrift:"expt_template_id,42,optional" frugal:"42,optional,i64" json:"expt_template_id" form:"expt_template_id" `
+	ItemRetryNum        *int32 `thrift:"item_retry_num,45,optional" frugal:"45,optional,i32" form:"item_retry_num" json:"item_retry_num,omitempty"`
+	// 门禁规则，实验完成时计算门禁结论
+	GateConfig *expt.ExptGateConfig `thrift:"gate_config,46,optional" frugal:"46,optional,expt.ExptGateConfig" form:"gate_config" json:"gate_config,omitempty"`
+	Ext        map[string]string    `thrift:"ext,100,optional" frugal:"100,optional,map<string:string>" form:"ext" json:"ext,omitempty"`
+	Session    *common.Session      `thrift:"session,200,optional" frugal:"200,optional,common.Session" form:"session" json:"session,omitempty" query:"session"`
+	Base       *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
 func NewSubmitExperimentRequest() *SubmitExperimentRequest {
@@ -2640,6 +2712,18 @@ func (p *SubmitExperimentRequest) GetItemRetryNum() (v int32) {
 	return *p.ItemRetryNum
 }
 
+var SubmitExperimentRequest_GateConfig_DEFAULT *expt.ExptGateConfig
+
+func (p *SubmitExperimentRequest) GetGateConfig() (v *expt.ExptGateConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateConfig() {
+		return SubmitExperimentRequest_GateConfig_DEFAULT
+	}
+	return p.GateConfig
+}
+
 var SubmitExperimentRequest_Ext_DEFAULT map[string]string
 
 func (p *SubmitExperimentRequest) GetExt() (v map[string]string) {
@@ -2741,6 +2825,9 @@ func (p *SubmitExperimentRequest) SetExptTemplateID(val *int64) {
 func (p *SubmitExperimentRequest) SetItemRetryNum(val *int32) {
 	p.ItemRetryNum = val
 }
+func (p *SubmitExperimentRequest) SetGateConfig(val *expt.ExptGateConfig) {
+	p.GateConfig = val
+}
 func (p *SubmitExperimentRequest) SetExt(val map[string]string) {
 	p.Ext = val
 }
@@ -2774,6 +2861,7 @@ var fieldIDToName_SubmitExperimentRequest = map[int16]string{
 	41:  "enable_weighted_score",
 	42:  "expt_template_id",
 	45:  "item_retry_num",
+	46:  "gate_config",
 	100: "ext",
 	200: "session",
 	255: "Base",
@@ -2863,6 +2951,10 @@ func (p *SubmitExperimentRequest) IsSetItemRetryNum() bool {
 	return p.ItemRetryNum != nil
 }
 
+func (p *SubmitExperimentRequest) IsSetGateConfig() bool {
+	return p.GateConfig != nil
+}
+
 func (p *SubmitExperimentRequest) IsSetExt() bool {
 	return p.Ext != nil
 }
@@ -3071,6 +3163,14 @@ func (p *SubmitExperimentRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 46:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField46(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 100:
 			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField100(iprot); err != nil {
@@ -3401,6 +3501,14 @@ func (p *SubmitExperimentRequest) ReadField45(iprot thrift.TProtocol) error {
 	p.ItemRetryNum = _field
 	return nil
 }
+func (p *SubmitExperimentRequest) ReadField46(iprot thrift.TProtocol) error {
+	_field := expt.NewExptGateConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateConfig = _field
+	return nil
+}
 func (p *SubmitExperimentRequest) ReadField100(iprot thrift.TProtocol) error {
 	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
@@ -3541,6 +3649,10 @@ func (p *SubmitExperimentRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 45
 			goto WriteFieldError
 		}
+		if err = p.writeField46(oprot); err != nil {
+			fieldId = 46
+			goto WriteFieldError
+		}
 		if err = p.writeField100(oprot); err != nil {
 			fieldId = 100
 			goto WriteFieldError
@@ -3989,6 +4101,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 45 end error: ", p), err)
 }
+func (p *SubmitExperimentRequest) writeField46(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateConfig() {
+		if err = oprot.WriteFieldBegin("gate_config", thrift.STRUCT, 46); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 46 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 46 end error: ", p), err)
+}
 func (p *SubmitExperimentRequest) writeField100(oprot thrift.TProtocol) (err error) {
 	if p.IsSetExt() {
 		if err = oprot.WriteFieldBegin("ext", thrift.MAP, 100); err != nil {
@@ -4135,6 +4265,9 @@ func (p *SubmitExperimentRequest) DeepEqual(ano *SubmitExperimentRequest) bool {
 	if !p.Field45DeepEqual(ano.ItemRetryNum) {
 		return false
 	}
+	if !p.Field46DeepEqual(ano.GateConfig) {
+		return false
+	}
 	if !p.Field100DeepEqual(ano.Ext) {
 		return false
 	}
@@ -4394,6 +4527,13 @@ func (p *SubmitExperimentRequest) Field45DeepEqual(src *int32) bool {
 	}
 	return true
 }
+func (p *SubmitExperimentRequest) Field46DeepEqual(src *expt.ExptGateConfig) bool {
+
+	if !p.GateConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *SubmitExperimentRequest) Field100DeepEqual(src map[string]string) bool {
 
 	if len(p.Ext) != len(src) {
@@ -12984,7 +13124,857 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentAggrResultResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchGetExperimentAggrResultResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BatchGetExperimentAggrResultResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*expt.ExptAggregateResult_, 0, size)
+	values := make([]expt.ExptAggregateResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ExptAggregateResult_ = _field
+	return nil
+}
+func (p *BatchGetExperimentAggrResultResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *BatchGetExperimentAggrResultResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetExperimentAggrResultResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BatchGetExperimentAggrResultResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExptAggregateResult_() {
+		if err = oprot.WriteFieldBegin("expt_aggregate_result", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ExptAggregateResult_)); err != nil {
+			return err
+		}
+		for _, v := range p.ExptAggregateResult_ {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *BatchGetExperimentAggrResultResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *BatchGetExperimentAggrResultResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchGetExperimentAggrResultResponse(%+v)", *p)
+
+}
+
+func (p *BatchGetExperimentAggrResultResponse) DeepEqual(ano *BatchGetExperimentAggrResultResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ExptAggregateResult_) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *BatchGetExperimentAggrResultResponse) Field1DeepEqual(src []*expt.ExptAggregateResult_) bool {
+
+	if len(p.ExptAggregateResult_) != len(src) {
+		return false
+	}
+	for i, v := range p.ExptAggregateResult_ {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *BatchGetExperimentAggrResultResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type CompareExperimentSignificanceRequest struct {
+	WorkspaceID          int64   `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
+	BaseExperimentID     int64   `thrift:"base_experiment_id,2,required" frugal:"2,required,i64" json:"base_experiment_id" form:"base_experiment_id,required" `
+	CompareExperimentIds []int64 `thrift:"compare_experiment_ids,3,required" frugal:"3,required,list<i64>" json:"compare_experiment_ids" form:"compare_experiment_ids,required" `
+	// 为空时对比全部共同评估器
+	EvaluatorVersionIds []int64 `thrift:"evaluator_version_ids,4,optional" frugal:"4,optional,list<i64>" json:"evaluator_version_ids" form:"evaluator_version_ids" `
+	// 默认 0.95
+	ConfidenceLevel *float64   `thrift:"confidence_level,5,optional" frugal:"5,optional,double" form:"confidence_level" json:"confidence_level,omitempty"`
+	Base            *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewCompareExperimentSignificanceRequest() *CompareExperimentSignificanceRequest {
+	return &CompareExperimentSignificanceRequest{}
+}
+
+func (p *CompareExperimentSignificanceRequest) InitDefault() {
+}
+
+func (p *CompareExperimentSignificanceRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *CompareExperimentSignificanceRequest) GetBaseExperimentID() (v int64) {
+	if p != nil {
+		return p.BaseExperimentID
+	}
+	return
+}
+
+func (p *CompareExperimentSignificanceRequest) GetCompareExperimentIds() (v []int64) {
+	if p != nil {
+		return p.CompareExperimentIds
+	}
+	return
+}
+
+var CompareExperimentSignificanceRequest_EvaluatorVersionIds_DEFAULT []int64
+
+func (p *CompareExperimentSignificanceRequest) GetEvaluatorVersionIds() (v []int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEvaluatorVersionIds() {
+		return CompareExperimentSignificanceRequest_EvaluatorVersionIds_DEFAULT
+	}
+	return p.EvaluatorVersionIds
+}
+
+var CompareExperimentSignificanceRequest_ConfidenceLevel_DEFAULT float64
+
+func (p *CompareExperimentSignificanceRequest) GetConfidenceLevel() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetConfidenceLevel() {
+		return CompareExperimentSignificanceRequest_ConfidenceLevel_DEFAULT
+	}
+	return *p.ConfidenceLevel
+}
+
+var CompareExperimentSignificanceRequest_Base_DEFAULT *base.Base
+
+func (p *CompareExperimentSignificanceRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return CompareExperimentSignificanceRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *CompareExperimentSignificanceRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *CompareExperimentSignificanceRequest) SetBaseExperimentID(val int64) {
+	p.BaseExperimentID = val
+}
+func (p *CompareExperimentSignificanceRequest) SetCompareExperimentIds(val []int64) {
+	p.CompareExperimentIds = val
+}
+func (p *CompareExperimentSignificanceRequest) SetEvaluatorVersionIds(val []int64) {
+	p.EvaluatorVersionIds = val
+}
+func (p *CompareExperimentSignificanceRequest) SetConfidenceLevel(val *float64) {
+	p.ConfidenceLevel = val
+}
+func (p *CompareExperimentSignificanceRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_CompareExperimentSignificanceRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "base_experiment_id",
+	3:   "compare_experiment_ids",
+	4:   "evaluator_version_ids",
+	5:   "confidence_level",
+	255: "Base",
+}
+
+func (p *CompareExperimentSignificanceRequest) IsSetEvaluatorVersionIds() bool {
+	return p.EvaluatorVersionIds != nil
+}
+
+func (p *CompareExperimentSignificanceRequest) IsSetConfidenceLevel() bool {
+	return p.ConfidenceLevel != nil
+}
+
+func (p *CompareExperimentSignificanceRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CompareExperimentSignificanceRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetBaseExperimentID bool = false
+	var issetCompareExperimentIds bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseExperimentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCompareExperimentIds = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetBaseExperimentID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCompareExperimentIds {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentSignificanceRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CompareExperimentSignificanceRequest[fieldId]))
+}
+
+func (p *CompareExperimentSignificanceRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *CompareExperimentSignificanceRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.BaseExperimentID = _field
+	return nil
+}
+func (p *CompareExperimentSignificanceRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.CompareExperimentIds = _field
+	return nil
+}
+func (p *CompareExperimentSignificanceRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EvaluatorVersionIds = _field
+	return nil
+}
+func (p *CompareExperimentSignificanceRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ConfidenceLevel = _field
+	return nil
+}
+func (p *CompareExperimentSignificanceRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *CompareExperimentSignificanceRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CompareExperimentSignificanceRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CompareExperimentSignificanceRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CompareExperimentSignificanceRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_experiment_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.BaseExperimentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CompareExperimentSignificanceRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("compare_experiment_ids", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.CompareExperimentIds)); err != nil {
+		return err
+	}
+	for _, v := range p.CompareExperimentIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *CompareExperimentSignificanceRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvaluatorVersionIds() {
+		if err = oprot.WriteFieldBegin("evaluator_version_ids", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.EvaluatorVersionIds)); err != nil {
+			return err
+		}
+		for _, v := range p.EvaluatorVersionIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *CompareExperimentSignificanceRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConfidenceLevel() {
+		if err = oprot.WriteFieldBegin("confidence_level", thrift.DOUBLE, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.ConfidenceLevel); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *CompareExperimentSignificanceRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *CompareExperimentSignificanceRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompareExperimentSignificanceRequest(%+v)", *p)
+
+}
+
+func (p *CompareExperimentSignificanceRequest) DeepEqual(ano *CompareExperimentSignificanceRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.BaseExperimentID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CompareExperimentIds) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EvaluatorVersionIds) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ConfidenceLevel) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *CompareExperimentSignificanceRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *CompareExperimentSignificanceRequest) Field2DeepEqual(src int64) bool {
+
+	if p.BaseExperimentID != src {
+		return false
+	}
+	return true
+}
+func (p *CompareExperimentSignificanceRequest) Field3DeepEqual(src []int64) bool {
+
+	if len(p.CompareExperimentIds) != len(src) {
+		return false
+	}
+	for i, v := range p.CompareExperimentIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *CompareExperimentSignificanceRequest) Field4DeepEqual(src []int64) bool {
+
+	if len(p.EvaluatorVersionIds) != len(src) {
+		return false
+	}
+	for i, v := range p.EvaluatorVersionIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *CompareExperimentSignificanceRequest) Field5DeepEqual(src *float64) bool {
+
+	if p.ConfidenceLevel == src {
+		return true
+	} else if p.ConfidenceLevel == nil || src == nil {
+		return false
+	}
+	if *p.ConfidenceLevel != *src {
+		return false
+	}
+	return true
+}
+func (p *CompareExperimentSignificanceRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type CompareExperimentSignificanceResponse struct {
+	ComparisonResults []*expt.ExptComparisonResult_ `thrift:"comparison_results,1,optional" frugal:"1,optional,list<expt.ExptComparisonResult_>" form:"comparison_results" json:"comparison_results,omitempty"`
+	BaseResp          *base.BaseResp                `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewCompareExperimentSignificanceResponse() *CompareExperimentSignificanceResponse {
+	return &CompareExperimentSignificanceResponse{}
+}
+
+func (p *CompareExperimentSignificanceResponse) InitDefault() {
+}
+
+var CompareExperimentSignificanceResponse_ComparisonResults_DEFAULT []*expt.ExptComparisonResult_
+
+func (p *CompareExperimentSignificanceResponse) GetComparisonResults() (v []*expt.ExptComparisonResult_) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetComparisonResults() {
+		return CompareExperimentSignificanceResponse_ComparisonResults_DEFAULT
+	}
+	return p.ComparisonResults
+}
+
+var CompareExperimentSignificanceResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *CompareExperimentSignificanceResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return CompareExperimentSignificanceResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CompareExperimentSignificanceResponse) SetComparisonResults(val []*expt.ExptComparisonResult_) {
+	p.ComparisonResults = val
+}
+func (p *CompareExperimentSignificanceResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_CompareExperimentSignificanceResponse = map[int16]string{
+	1:   "comparison_results",
+	255: "BaseResp",
+}
+
+func (p *CompareExperimentSignificanceResponse) IsSetComparisonResults() bool {
+	return p.ComparisonResults != nil
+}
+
+func (p *CompareExperimentSignificanceResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CompareExperimentSignificanceResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentSignificanceResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12994,13 +13984,13 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *BatchGetExperimentAggrResultResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *CompareExperimentSignificanceResponse) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*expt.ExptAggregateResult_, 0, size)
-	values := make([]expt.ExptAggregateResult_, size)
+	_field := make([]*expt.ExptComparisonResult_, 0, size)
+	values := make([]expt.ExptComparisonResult_, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -13014,10 +14004,10 @@ func (p *BatchGetExperimentAggrResultResponse) ReadField1(iprot thrift.TProtocol
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.ExptAggregateResult_ = _field
+	p.ComparisonResults = _field
 	return nil
 }
-func (p *BatchGetExperimentAggrResultResponse) ReadField255(iprot thrift.TProtocol) error {
+func (p *CompareExperimentSignificanceResponse) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -13026,9 +14016,9 @@ func (p *BatchGetExperimentAggrResultResponse) ReadField255(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *BatchGetExperimentAggrResultResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentSignificanceResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetExperimentAggrResultResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CompareExperimentSignificanceResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13058,15 +14048,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchGetExperimentAggrResultResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetExptAggregateResult_() {
-		if err = oprot.WriteFieldBegin("expt_aggregate_result", thrift.LIST, 1); err != nil {
+func (p *CompareExperimentSignificanceResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetComparisonResults() {
+		if err = oprot.WriteFieldBegin("comparison_results", thrift.LIST, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ExptAggregateResult_)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ComparisonResults)); err != nil {
 			return err
 		}
-		for _, v := range p.ExptAggregateResult_ {
+		for _, v := range p.ComparisonResults {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -13084,7 +14074,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *BatchGetExperimentAggrResultResponse) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *CompareExperimentSignificanceResponse) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13101,21 +14091,21 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *BatchGetExperimentAggrResultResponse) String() string {
+func (p *CompareExperimentSignificanceResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("BatchGetExperimentAggrResultResponse(%+v)", *p)
+	return fmt.Sprintf("CompareExperimentSignificanceResponse(%+v)", *p)
 
 }
 
-func (p *BatchGetExperimentAggrResultResponse) DeepEqual(ano *BatchGetExperimentAggrResultResponse) bool {
+func (p *CompareExperimentSignificanceResponse) DeepEqual(ano *CompareExperimentSignificanceResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.ExptAggregateResult_) {
+	if !p.Field1DeepEqual(ano.ComparisonResults) {
 		return false
 	}
 	if !p.Field255DeepEqual(ano.BaseResp) {
@@ -13124,12 +14114,12 @@ func (p *BatchGetExperimentAggrResultResponse) DeepEqual(ano *BatchGetExperiment
 	return true
 }
 
-func (p *BatchGetExperimentAggrResultResponse) Field1DeepEqual(src []*expt.ExptAggregateResult_) bool {
+func (p *CompareExperimentSignificanceResponse) Field1DeepEqual(src []*expt.ExptComparisonResult_) bool {
 
-	if len(p.ExptAggregateResult_) != len(src) {
+	if len(p.ComparisonResults) != len(src) {
 		return false
 	}
-	for i, v := range p.ExptAggregateResult_ {
+	for i, v := range p.ComparisonResults {
 		_src := src[i]
 		if !v.DeepEqual(_src) {
 			return false
@@ -13137,7 +14127,7 @@ func (p *BatchGetExperimentAggrResultResponse) Field1DeepEqual(src []*expt.ExptA
 	}
 	return true
 }
-func (p *BatchGetExperimentAggrResultResponse) Field255DeepEqual(src *base.BaseResp) bool {
+func (p *CompareExperimentSignificanceResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
 	if !p.BaseResp.DeepEqual(src) {
 		return false
@@ -17254,9 +18244,13 @@ type CreateExperimentTemplateRequest struct {
 	// 默认评估器并发数（不在 ExptTemplate 结构中，保留在顶层）
 	DefaultEvaluatorsConcurNum *int32 `thrift:"default_evaluators_concur_num,21,optional" frugal:"21,optional,i32" form:"default_evaluators_concur_num" json:"default_evaluators_concur_num,omitempty"`
 	// 调度配置（不在 ExptTemplate 结构中，保留在顶层）
-	ScheduleCron *string         `thrift:"schedule_cron,22,optional" frugal:"22,optional,string" form:"schedule_cron" json:"schedule_cron,omitempty"`
-	Session      *common.Session `thrift:"session,200,optional" frugal:"200,optional,common.Session" form:"session" json:"session,omitempty" query:"session"`
-	Base         *base.Base      `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	ScheduleCron *string `thrift:"schedule_cron,22,optional" frugal:"22,optional,string" form:"schedule_cron" json:"schedule_cron,omitempty"`
+	// 完整调度配置，设置后忽略 schedule_cron
+	ScheduleConfig *expt.ExptTemplateSchedule `thrift:"schedule_config,23,optional" frugal:"23,optional,expt.ExptTemplateSchedule" form:"schedule_config" json:"schedule_config,omitempty"`
+	// 门禁规则，基于模板创建的实验沿用该配置
+	GateConfig *expt.ExptGateConfig `thrift:"gate_config,24,optional" frugal:"24,optional,expt.ExptGateConfig" form:"gate_config" json:"gate_config,omitempty"`
+	Session    *common.Session      `thrift:"session,200,optional" frugal:"200,optional,common.Session" form:"session" json:"session,omitempty" query:"session"`
+	Base       *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
 func NewCreateExperimentTemplateRequest() *CreateExperimentTemplateRequest {
@@ -17345,6 +18339,30 @@ func (p *CreateExperimentTemplateRequest) GetScheduleCron() (v string) {
 	return *p.ScheduleCron
 }
 
+var CreateExperimentTemplateRequest_ScheduleConfig_DEFAULT *expt.ExptTemplateSchedule
+
+func (p *CreateExperimentTemplateRequest) GetScheduleConfig() (v *expt.ExptTemplateSchedule) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScheduleConfig() {
+		return CreateExperimentTemplateRequest_ScheduleConfig_DEFAULT
+	}
+	return p.ScheduleConfig
+}
+
+var CreateExperimentTemplateRequest_GateConfig_DEFAULT *expt.ExptGateConfig
+
+func (p *CreateExperimentTemplateRequest) GetGateConfig() (v *expt.ExptGateConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateConfig() {
+		return CreateExperimentTemplateRequest_GateConfig_DEFAULT
+	}
+	return p.GateConfig
+}
+
 var CreateExperimentTemplateRequest_Session_DEFAULT *common.Session
 
 func (p *CreateExperimentTemplateRequest) GetSession() (v *common.Session) {
@@ -17389,6 +18407,12 @@ func (p *CreateExperimentTemplateRequest) SetDefaultEvaluatorsConcurNum(val *int
 func (p *CreateExperimentTemplateRequest) SetScheduleCron(val *string) {
 	p.ScheduleCron = val
 }
+func (p *CreateExperimentTemplateRequest) SetScheduleConfig(val *expt.ExptTemplateSchedule) {
+	p.ScheduleConfig = val
+}
+func (p *CreateExperimentTemplateRequest) SetGateConfig(val *expt.ExptGateConfig) {
+	p.GateConfig = val
+}
 func (p *CreateExperimentTemplateRequest) SetSession(val *common.Session) {
 	p.Session = val
 }
@@ -17404,6 +18428,8 @@ var fieldIDToName_CreateExperimentTemplateRequest = map[int16]string{
 	20:  "create_eval_target_param",
 	21:  "default_evaluators_concur_num",
 	22:  "schedule_cron",
+	23:  "schedule_config",
+	24:  "gate_config",
 	200: "session",
 	255: "Base",
 }
@@ -17432,6 +18458,14 @@ func (p *CreateExperimentTemplateRequest) IsSetScheduleCron() bool {
 	return p.ScheduleCron != nil
 }
 
+func (p *CreateExperimentTemplateRequest) IsSetScheduleConfig() bool {
+	return p.ScheduleConfig != nil
+}
+
+func (p *CreateExperimentTemplateRequest) IsSetGateConfig() bool {
+	return p.GateConfig != nil
+}
+
 func (p *CreateExperimentTemplateRequest) IsSetSession() bool {
 	return p.Session != nil
 }
@@ -17516,6 +18550,22 @@ func (p *CreateExperimentTemplateRequest) Read(iprot thrift.TProtocol) (err erro
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 23:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField23(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 24:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField24(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 200:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField200(iprot); err != nil {
@@ -17632,6 +18682,22 @@ func (p *CreateExperimentTemplateRequest) ReadField22(iprot thrift.TProtocol) er
 	p.ScheduleCron = _field
 	return nil
 }
+func (p *CreateExperimentTemplateRequest) ReadField23(iprot thrift.TProtocol) error {
+	_field := expt.NewExptTemplateSchedule()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ScheduleConfig = _field
+	return nil
+}
+func (p *CreateExperimentTemplateRequest) ReadField24(iprot thrift.TProtocol) error {
+	_field := expt.NewExptGateConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateConfig = _field
+	return nil
+}
 func (p *CreateExperimentTemplateRequest) ReadField200(iprot thrift.TProtocol) error {
 	_field := common.NewSession()
 	if err := _field.Read(iprot); err != nil {
@@ -17683,6 +18749,14 @@ func (p *CreateExperimentTemplateRequest) Write(oprot thrift.TProtocol) (err err
 			fieldId = 22
 			goto WriteFieldError
 		}
+		if err = p.writeField23(oprot); err != nil {
+			fieldId = 23
+			goto WriteFieldError
+		}
+		if err = p.writeField24(oprot); err != nil {
+			fieldId = 24
+			goto WriteFieldError
+		}
 		if err = p.writeField200(oprot); err != nil {
 			fieldId = 200
 			goto WriteFieldError
@@ -17833,6 +18907,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
 }
+func (p *CreateExperimentTemplateRequest) writeField23(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScheduleConfig() {
+		if err = oprot.WriteFieldBegin("schedule_config", thrift.STRUCT, 23); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ScheduleConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
+}
+func (p *CreateExperimentTemplateRequest) writeField24(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateConfig() {
+		if err = oprot.WriteFieldBegin("gate_config", thrift.STRUCT, 24); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
+}
 func (p *CreateExperimentTemplateRequest) writeField200(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSession() {
 		if err = oprot.WriteFieldBegin("session", thrift.STRUCT, 200); err != nil {
@@ -17905,6 +19015,12 @@ func (p *CreateExperimentTemplateRequest) DeepEqual(ano *CreateExperimentTemplat
 	if !p.Field22DeepEqual(ano.ScheduleCron) {
 		return false
 	}
+	if !p.Field23DeepEqual(ano.ScheduleConfig) {
+		return false
+	}
+	if !p.Field24DeepEqual(ano.GateConfig) {
+		return false
+	}
 	if !p.Field200DeepEqual(ano.Session) {
 		return false
 	}
@@ -17973,6 +19089,20 @@ func (p *CreateExperimentTemplateRequest) Field22DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *CreateExperimentTemplateRequest) Field23DeepEqual(src *expt.ExptTemplateSchedule) bool {
+
+	if !p.ScheduleConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateExperimentTemplateRequest) Field24DeepEqual(src *expt.ExptGateConfig) bool {
+
+	if !p.GateConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *CreateExperimentTemplateRequest) Field200DeepEqual(src *common.Session) bool {
 
 	if !p.Session.DeepEqual(src) {
@@ -19465,8 +20595,12 @@ type UpdateExperimentTemplateRequest struct {
 	// 默认评估器并发数（不在 ExptTemplate 结构中，保留在顶层）
 	DefaultEvaluatorsConcurNum *int32 `thrift:"default_evaluators_concur_num,21,optional" frugal:"21,optional,i32" form:"default_evaluators_concur_num" json:"default_evaluators_concur_num,omitempty"`
 	// 调度配置（不在 ExptTemplate 结构中，保留在顶层）
-	ScheduleCron *string    `thrift:"schedule_cron,22,optional" frugal:"22,optional,string" form:"schedule_cron" json:"schedule_cron,omitempty"`
-	Base         *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	ScheduleCron *string `thrift:"schedule_cron,22,optional" frugal:"22,optional,string" form:"schedule_cron" json:"schedule_cron,omitempty"`
+	// 完整调度配置，设置后忽略 schedule_cron
+	ScheduleConfig *expt.ExptTemplateSchedule `thrift:"schedule_config,23,optional" frugal:"23,optional,expt.ExptTemplateSchedule" form:"schedule_config" json:"schedule_config,omitempty"`
+	// 门禁规则，基于模板创建的实验沿用该配置
+	GateConfig *expt.ExptGateConfig `thrift:"gate_config,24,optional" frugal:"24,optional,expt.ExptGateConfig" form:"gate_config" json:"gate_config,omitempty"`
+	Base       *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
 func NewUpdateExperimentTemplateRequest() *UpdateExperimentTemplateRequest {
@@ -19562,6 +20696,30 @@ func (p *UpdateExperimentTemplateRequest) GetScheduleCron() (v string) {
 	return *p.ScheduleCron
 }
 
+var UpdateExperimentTemplateRequest_ScheduleConfig_DEFAULT *expt.ExptTemplateSchedule
+
+func (p *UpdateExperimentTemplateRequest) GetScheduleConfig() (v *expt.ExptTemplateSchedule) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetScheduleConfig() {
+		return UpdateExperimentTemplateRequest_ScheduleConfig_DEFAULT
+	}
+	return p.ScheduleConfig
+}
+
+var UpdateExperimentTemplateRequest_GateConfig_DEFAULT *expt.ExptGateConfig
+
+func (p *UpdateExperimentTemplateRequest) GetGateConfig() (v *expt.ExptGateConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateConfig() {
+		return UpdateExperimentTemplateRequest_GateConfig_DEFAULT
+	}
+	return p.GateConfig
+}
+
 var UpdateExperimentTemplateRequest_Base_DEFAULT *base.Base
 
 func (p *UpdateExperimentTemplateRequest) GetBase() (v *base.Base) {
@@ -19597,6 +20755,12 @@ func (p *UpdateExperimentTemplateRequest) SetDefaultEvaluatorsConcurNum(val *int
 func (p *UpdateExperimentTemplateRequest) SetScheduleCron(val *string) {
 	p.ScheduleCron = val
 }
+func (p *UpdateExperimentTemplateRequest) SetScheduleConfig(val *expt.ExptTemplateSchedule) {
+	p.ScheduleConfig = val
+}
+func (p *UpdateExperimentTemplateRequest) SetGateConfig(val *expt.ExptGateConfig) {
+	p.GateConfig = val
+}
 func (p *UpdateExperimentTemplateRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
@@ -19610,6 +20774,8 @@ var fieldIDToName_UpdateExperimentTemplateRequest = map[int16]string{
 	20:  "create_eval_target_param",
 	21:  "default_evaluators_concur_num",
 	22:  "schedule_cron",
+	23:  "schedule_config",
+	24:  "gate_config",
 	255: "Base",
 }
 
@@ -19637,6 +20803,14 @@ func (p *UpdateExperimentTemplateRequest) IsSetScheduleCron() bool {
 	return p.ScheduleCron != nil
 }
 
+func (p *UpdateExperimentTemplateRequest) IsSetScheduleConfig() bool {
+	return p.ScheduleConfig != nil
+}
+
+func (p *UpdateExperimentTemplateRequest) IsSetGateConfig() bool {
+	return p.GateConfig != nil
+}
+
 func (p *UpdateExperimentTemplateRequest) IsSetBase() bool {
 	return p.Base != nil
 }
@@ -19727,6 +20901,22 @@ func (p *UpdateExperimentTemplateRequest) Read(iprot thrift.TProtocol) (err erro
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 23:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField23(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 24:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField24(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -19851,6 +21041,22 @@ func (p *UpdateExperimentTemplateRequest) ReadField22(iprot thrift.TProtocol) er
 	p.ScheduleCron = _field
 	return nil
 }
+func (p *UpdateExperimentTemplateRequest) ReadField23(iprot thrift.TProtocol) error {
+	_field := expt.NewExptTemplateSchedule()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ScheduleConfig = _field
+	return nil
+}
+func (p *UpdateExperimentTemplateRequest) ReadField24(iprot thrift.TProtocol) error {
+	_field := expt.NewExptGateConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateConfig = _field
+	return nil
+}
 func (p *UpdateExperimentTemplateRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
@@ -19898,6 +21104,14 @@ func (p *UpdateExperimentTemplateRequest) Write(oprot thrift.TProtocol) (err err
 			fieldId = 22
 			goto WriteFieldError
 		}
+		if err = p.writeField23(oprot); err != nil {
+			fieldId = 23
+			goto WriteFieldError
+		}
+		if err = p.writeField24(oprot); err != nil {
+			fieldId = 24
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -20060,6 +21274,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
 }
+func (p *UpdateExperimentTemplateRequest) writeField23(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScheduleConfig() {
+		if err = oprot.WriteFieldBegin("schedule_config", thrift.STRUCT, 23); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ScheduleConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 23 end error: ", p), err)
+}
+func (p *UpdateExperimentTemplateRequest) writeField24(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateConfig() {
+		if err = oprot.WriteFieldBegin("gate_config", thrift.STRUCT, 24); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 24 end error: ", p), err)
+}
 func (p *UpdateExperimentTemplateRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
@@ -20117,6 +21367,12 @@ func (p *UpdateExperimentTemplateRequest) DeepEqual(ano *UpdateExperimentTemplat
 	if !p.Field22DeepEqual(ano.ScheduleCron) {
 		return false
 	}
+	if !p.Field23DeepEqual(ano.ScheduleConfig) {
+		return false
+	}
+	if !p.Field24DeepEqual(ano.GateConfig) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.Base) {
 		return false
 	}
@@ -20189,6 +21445,20 @@ func (p *UpdateExperimentTemplateRequest) Field22DeepEqual(src *string) bool {
 	}
 	return true
 }
+func (p *UpdateExperimentTemplateRequest) Field23DeepEqual(src *expt.ExptTemplateSchedule) bool {
+
+	if !p.ScheduleConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *UpdateExperimentTemplateRequest) Field24DeepEqual(src *expt.ExptGateConfig) bool {
+
+	if !p.GateConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *UpdateExperimentTemplateRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
@@ -33641,6 +34911,8 @@ type ExperimentService interface {
 	CalculateExperimentAggrResult_(ctx context.Context, req *CalculateExperimentAggrResultRequest) (r *CalculateExperimentAggrResultResponse, err error)
 
 	BatchGetExperimentAggrResult_(ctx context.Context, req *BatchGetExperimentAggrResultRequest) (r *BatchGetExperimentAggrResultResponse, err error)
+	// 按 item/turn 配对对比实验结果并进行显著性检验
+	CompareExperimentSignificance(ctx context.Context, req *CompareExperimentSignificanceRequest) (r *CompareExperimentSignificanceResponse, err error)
 	// 在线实验
 	InvokeExperiment(ctx context.Context, req *InvokeExperimentRequest) (r *InvokeExperimentResponse, err error)
 
@@ -33854,6 +35126,15 @@ func (p *ExperimentServiceClient) BatchGetExperimentAggrResult_(ctx context.Cont
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *ExperimentServiceClient) CompareExperimentSignificance(ctx context.Context, req *CompareExperimentSignificanceRequest) (r *CompareExperimentSignificanceResponse, err error) {
+	var _args ExperimentServiceCompareExperimentSignificanceArgs
+	_args.Req = req
+	var _result ExperimentServiceCompareExperimentSignificanceResult
+	if err = p.Client_().Call(ctx, "CompareExperimentSignificance", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *ExperimentServiceClient) InvokeExperiment(ctx context.Context, req *InvokeExperimentRequest) (r *InvokeExperimentResponse, err error) {
 	var _args ExperimentServiceInvokeExperimentArgs
 	_args.Req = req
@@ -34115,6 +35396,7 @@ func NewExperimentServiceProcessor(handler ExperimentService) *ExperimentService
 	self.AddToProcessorMap("BatchGetExperimentResult", &experimentServiceProcessorBatchGetExperimentResult_{handler: handler})
 	self.AddToProcessorMap("CalculateExperimentAggrResult", &experimentServiceProcessorCalculateExperimentAggrResult_{handler: handler})
 	self.AddToProcessorMap("BatchGetExperimentAggrResult", &experimentServiceProcessorBatchGetExperimentAggrResult_{handler: handler})
+	self.AddToProcessorMap("CompareExperimentSignificance", &experimentServiceProcessorCompareExperimentSignificance{handler: handler})
 	self.AddToProcessorMap("InvokeExperiment", &experimentServiceProcessorInvokeExperiment{handler: handler})
 	self.AddToProcessorMap("FinishExperiment", &experimentServiceProcessorFinishExperiment{handler: handler})
 	self.AddToProcessorMap("ListExperimentStats", &experimentServiceProcessorListExperimentStats{handler: handler})
@@ -34880,6 +36162,54 @@ func (p *experimentServiceProcessorBatchGetExperimentAggrResult_) Process(ctx co
 	return true, err
 }
 
+type experimentServiceProcessorCompareExperimentSignificance struct {
+	handler ExperimentService
+}
+
+func (p *experimentServiceProcessorCompareExperimentSignificance) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ExperimentServiceCompareExperimentSignificanceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CompareExperimentSignificance", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ExperimentServiceCompareExperimentSignificanceResult{}
+	var retval *CompareExperimentSignificanceResponse
+	if retval, err2 = p.handler.CompareExperimentSignificance(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CompareExperimentSignificance: "+err2.Error())
+		oprot.WriteMessageBegin("CompareExperimentSignificance", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CompareExperimentSignificance", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
 type experimentServiceProcessorInvokeExperiment struct {
 	handler ExperimentService
 }
@@ -36894,7 +38224,351 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceSubmitExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExperimentServiceSubmitExperimentArgs(%+v)", *p)
+
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) DeepEqual(ano *ExperimentServiceSubmitExperimentArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *ExperimentServiceSubmitExperimentArgs) Field1DeepEqual(src *SubmitExperimentRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ExperimentServiceSubmitExperimentResult struct {
+	Success *SubmitExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,SubmitExperimentResponse"`
+}
+
+func NewExperimentServiceSubmitExperimentResult() *ExperimentServiceSubmitExperimentResult {
+	return &ExperimentServiceSubmitExperimentResult{}
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) InitDefault() {
+}
+
+var ExperimentServiceSubmitExperimentResult_Success_DEFAULT *SubmitExperimentResponse
+
+func (p *ExperimentServiceSubmitExperimentResult) GetSuccess() (v *SubmitExperimentResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return ExperimentServiceSubmitExperimentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *ExperimentServiceSubmitExperimentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SubmitExperimentResponse)
+}
+
+var fieldIDToName_ExperimentServiceSubmitExperimentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitExperimentResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitExperiment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExperimentServiceSubmitExperimentResult(%+v)", *p)
+
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) DeepEqual(ano *ExperimentServiceSubmitExperimentResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *ExperimentServiceSubmitExperimentResult) Field0DeepEqual(src *SubmitExperimentResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ExperimentServiceBatchGetExperimentsArgs struct {
+	Req *BatchGetExperimentsRequest `thrift:"req,1" frugal:"1,default,BatchGetExperimentsRequest"`
+}
+
+func NewExperimentServiceBatchGetExperimentsArgs() *ExperimentServiceBatchGetExperimentsArgs {
+	return &ExperimentServiceBatchGetExperimentsArgs{}
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) InitDefault() {
+}
+
+var ExperimentServiceBatchGetExperimentsArgs_Req_DEFAULT *BatchGetExperimentsRequest
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) GetReq() (v *BatchGetExperimentsRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return ExperimentServiceBatchGetExperimentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *ExperimentServiceBatchGetExperimentsArgs) SetReq(val *BatchGetExperimentsRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_ExperimentServiceBatchGetExperimentsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetExperimentsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetExperiments_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExperimentServiceBatchGetExperimentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -36911,15 +38585,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) String() string {
+func (p *ExperimentServiceBatchGetExperimentsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceSubmitExperimentArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceBatchGetExperimentsArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) DeepEqual(ano *ExperimentServiceSubmitExperimentArgs) bool {
+func (p *ExperimentServiceBatchGetExperimentsArgs) DeepEqual(ano *ExperimentServiceBatchGetExperimentsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -36931,7 +38605,7 @@ func (p *ExperimentServiceSubmitExperimentArgs) DeepEqual(ano *ExperimentService
 	return true
 }
 
-func (p *ExperimentServiceSubmitExperimentArgs) Field1DeepEqual(src *SubmitExperimentRequest) bool {
+func (p *ExperimentServiceBatchGetExperimentsArgs) Field1DeepEqual(src *BatchGetExperimentsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -36939,41 +38613,41 @@ func (p *ExperimentServiceSubmitExperimentArgs) Field1DeepEqual(src *SubmitExper
 	return true
 }
 
-type ExperimentServiceSubmitExperimentResult struct {
-	Success *SubmitExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,SubmitExperimentResponse"`
+type ExperimentServiceBatchGetExperimentsResult struct {
+	Success *BatchGetExperimentsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetExperimentsResponse"`
 }
 
-func NewExperimentServiceSubmitExperimentResult() *ExperimentServiceSubmitExperimentResult {
-	return &ExperimentServiceSubmitExperimentResult{}
+func NewExperimentServiceBatchGetExperimentsResult() *ExperimentServiceBatchGetExperimentsResult {
+	return &ExperimentServiceBatchGetExperimentsResult{}
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) InitDefault() {
+func (p *ExperimentServiceBatchGetExperimentsResult) InitDefault() {
 }
 
-var ExperimentServiceSubmitExperimentResult_Success_DEFAULT *SubmitExperimentResponse
+var ExperimentServiceBatchGetExperimentsResult_Success_DEFAULT *BatchGetExperimentsResponse
 
-func (p *ExperimentServiceSubmitExperimentResult) GetSuccess() (v *SubmitExperimentResponse) {
+func (p *ExperimentServiceBatchGetExperimentsResult) GetSuccess() (v *BatchGetExperimentsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceSubmitExperimentResult_Success_DEFAULT
+		return ExperimentServiceBatchGetExperimentsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceSubmitExperimentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SubmitExperimentResponse)
+func (p *ExperimentServiceBatchGetExperimentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetExperimentsResponse)
 }
 
-var fieldIDToName_ExperimentServiceSubmitExperimentResult = map[int16]string{
+var fieldIDToName_ExperimentServiceBatchGetExperimentsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) IsSetSuccess() bool {
+func (p *ExperimentServiceBatchGetExperimentsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -37018,7 +38692,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceSubmitExperimentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -37028,8 +38702,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitExperimentResponse()
+func (p *ExperimentServiceBatchGetExperimentsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetExperimentsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -37037,9 +38711,9 @@ func (p *ExperimentServiceSubmitExperimentResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitExperiment_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetExperiments_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -37065,7 +38739,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -37084,15 +38758,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) String() string {
+func (p *ExperimentServiceBatchGetExperimentsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceSubmitExperimentResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceBatchGetExperimentsResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) DeepEqual(ano *ExperimentServiceSubmitExperimentResult) bool {
+func (p *ExperimentServiceBatchGetExperimentsResult) DeepEqual(ano *ExperimentServiceBatchGetExperimentsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -37104,7 +38778,7 @@ func (p *ExperimentServiceSubmitExperimentResult) DeepEqual(ano *ExperimentServi
 	return true
 }
 
-func (p *ExperimentServiceSubmitExperimentResult) Field0DeepEqual(src *SubmitExperimentResponse) bool {
+func (p *ExperimentServiceBatchGetExperimentsResult) Field0DeepEqual(src *BatchGetExperimentsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -37112,41 +38786,41 @@ func (p *ExperimentServiceSubmitExperimentResult) Field0DeepEqual(src *SubmitExp
 	return true
 }
 
-type ExperimentServiceBatchGetExperimentsArgs struct {
-	Req *BatchGetExperimentsRequest `thrift:"req,1" frugal:"1,default,BatchGetExperimentsRequest"`
+type ExperimentServiceListExperimentsArgs struct {
+	Req *ListExperimentsRequest `thrift:"req,1" frugal:"1,default,ListExperimentsRequest"`
 }
 
-func NewExperimentServiceBatchGetExperimentsArgs() *ExperimentServiceBatchGetExperimentsArgs {
-	return &ExperimentServiceBatchGetExperimentsArgs{}
+func NewExperimentServiceListExperimentsArgs() *ExperimentServiceListExperimentsArgs {
+	return &ExperimentServiceListExperimentsArgs{}
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) InitDefault() {
+func (p *ExperimentServiceListExperimentsArgs) InitDefault() {
 }
 
-var ExperimentServiceBatchGetExperimentsArgs_Req_DEFAULT *BatchGetExperimentsRequest
+var ExperimentServiceListExperimentsArgs_Req_DEFAULT *ListExperimentsRequest
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) GetReq() (v *BatchGetExperimentsRequest) {
+func (p *ExperimentServiceListExperimentsArgs) GetReq() (v *ListExperimentsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceBatchGetExperimentsArgs_Req_DEFAULT
+		return ExperimentServiceListExperimentsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceBatchGetExperimentsArgs) SetReq(val *BatchGetExperimentsRequest) {
+func (p *ExperimentServiceListExperimentsArgs) SetReq(val *ListExperimentsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceBatchGetExperimentsArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceListExperimentsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) IsSetReq() bool {
+func (p *ExperimentServiceListExperimentsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceListExperimentsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -37191,7 +38865,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -37201,8 +38875,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetExperimentsRequest()
+func (p *ExperimentServiceListExperimentsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListExperimentsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -37210,9 +38884,9 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) ReadField1(iprot thrift.TProt
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceListExperimentsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetExperiments_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListExperiments_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -37238,7 +38912,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceListExperimentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -37255,15 +38929,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) String() string {
+func (p *ExperimentServiceListExperimentsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceBatchGetExperimentsArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceListExperimentsArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) DeepEqual(ano *ExperimentServiceBatchGetExperimentsArgs) bool {
+func (p *ExperimentServiceListExperimentsArgs) DeepEqual(ano *ExperimentServiceListExperimentsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -37275,7 +38949,7 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) DeepEqual(ano *ExperimentServ
 	return true
 }
 
-func (p *ExperimentServiceBatchGetExperimentsArgs) Field1DeepEqual(src *BatchGetExperimentsRequest) bool {
+func (p *ExperimentServiceListExperimentsArgs) Field1DeepEqual(src *ListExperimentsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -37283,41 +38957,41 @@ func (p *ExperimentServiceBatchGetExperimentsArgs) Field1DeepEqual(src *BatchGet
 	return true
 }
 
-type ExperimentServiceBatchGetExperimentsResult struct {
-	Success *BatchGetExperimentsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetExperimentsResponse"`
+type ExperimentServiceListExperimentsResult struct {
+	Success *ListExperimentsResponse `thrift:"success,0,optional" frugal:"0,optional,ListExperimentsResponse"`
 }
 
-func NewExperimentServiceBatchGetExperimentsResult() *ExperimentServiceBatchGetExperimentsResult {
-	return &ExperimentServiceBatchGetExperimentsResult{}
+func NewExperimentServiceListExperimentsResult() *ExperimentServiceListExperimentsResult {
+	return &ExperimentServiceListExperimentsResult{}
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) InitDefault() {
+func (p *ExperimentServiceListExperimentsResult) InitDefault() {
 }
 
-var ExperimentServiceBatchGetExperimentsResult_Success_DEFAULT *BatchGetExperimentsResponse
+var ExperimentServiceListExperimentsResult_Success_DEFAULT *ListExperimentsResponse
 
-func (p *ExperimentServiceBatchGetExperimentsResult) GetSuccess() (v *BatchGetExperimentsResponse) {
+func (p *ExperimentServiceListExperimentsResult) GetSuccess() (v *ListExperimentsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceBatchGetExperimentsResult_Success_DEFAULT
+		return ExperimentServiceListExperimentsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceBatchGetExperimentsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetExperimentsResponse)
+func (p *ExperimentServiceListExperimentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListExperimentsResponse)
 }
 
-var fieldIDToName_ExperimentServiceBatchGetExperimentsResult = map[int16]string{
+var fieldIDToName_ExperimentServiceListExperimentsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) IsSetSuccess() bool {
+func (p *ExperimentServiceListExperimentsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceListExperimentsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -37362,7 +39036,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -37372,8 +39046,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetExperimentsResponse()
+func (p *ExperimentServiceListExperimentsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListExperimentsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -37381,9 +39055,9 @@ func (p *ExperimentServiceBatchGetExperimentsResult) ReadField0(iprot thrift.TPr
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceListExperimentsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetExperiments_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListExperiments_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -37409,7 +39083,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceListExperimentsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -37428,15 +39102,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) String() string {
+func (p *ExperimentServiceListExperimentsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceBatchGetExperimentsResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceListExperimentsResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) DeepEqual(ano *ExperimentServiceBatchGetExperimentsResult) bool {
+func (p *ExperimentServiceListExperimentsResult) DeepEqual(ano *ExperimentServiceListExperimentsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -37448,7 +39122,7 @@ func (p *ExperimentServiceBatchGetExperimentsResult) DeepEqual(ano *ExperimentSe
 	return true
 }
 
-func (p *ExperimentServiceBatchGetExperimentsResult) Field0DeepEqual(src *BatchGetExperimentsResponse) bool {
+func (p *ExperimentServiceListExperimentsResult) Field0DeepEqual(src *ListExperimentsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -37456,41 +39130,41 @@ func (p *ExperimentServiceBatchGetExperimentsResult) Field0DeepEqual(src *BatchG
 	return true
 }
 
-type ExperimentServiceListExperimentsArgs struct {
-	Req *ListExperimentsRequest `thrift:"req,1" frugal:"1,default,ListExperimentsRequest"`
+type ExperimentServiceUpdateExperimentArgs struct {
+	Req *UpdateExperimentRequest `thrift:"req,1" frugal:"1,default,UpdateExperimentRequest"`
 }
 
-func NewExperimentServiceListExperimentsArgs() *ExperimentServiceListExperimentsArgs {
-	return &ExperimentServiceListExperimentsArgs{}
+func NewExperimentServiceUpdateExperimentArgs() *ExperimentServiceUpdateExperimentArgs {
+	return &ExperimentServiceUpdateExperimentArgs{}
 }
 
-func (p *ExperimentServiceListExperimentsArgs) InitDefault() {
+func (p *ExperimentServiceUpdateExperimentArgs) InitDefault() {
 }
 
-var ExperimentServiceListExperimentsArgs_Req_DEFAULT *ListExperimentsRequest
+var ExperimentServiceUpdateExperimentArgs_Req_DEFAULT *UpdateExperimentRequest
 
-func (p *ExperimentServiceListExperimentsArgs) GetReq() (v *ListExperimentsRequest) {
+func (p *ExperimentServiceUpdateExperimentArgs) GetReq() (v *UpdateExperimentRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceListExperimentsArgs_Req_DEFAULT
+		return ExperimentServiceUpdateExperimentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceListExperimentsArgs) SetReq(val *ListExperimentsRequest) {
+func (p *ExperimentServiceUpdateExperimentArgs) SetReq(val *UpdateExperimentRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceListExperimentsArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceUpdateExperimentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceListExperimentsArgs) IsSetReq() bool {
+func (p *ExperimentServiceUpdateExperimentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceListExperimentsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceUpdateExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -37535,7 +39209,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -37545,8 +39219,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceListExperimentsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListExperimentsRequest()
+func (p *ExperimentServiceUpdateExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateExperimentRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -37554,9 +39228,9 @@ func (p *ExperimentServiceListExperimentsArgs) ReadField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceUpdateExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListExperiments_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateExperiment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -37582,7 +39256,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceListExperimentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceUpdateExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -37599,15 +39273,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceListExperimentsArgs) String() string {
+func (p *ExperimentServiceUpdateExperimentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceListExperimentsArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceUpdateExperimentArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceListExperimentsArgs) DeepEqual(ano *ExperimentServiceListExperimentsArgs) bool {
+func (p *ExperimentServiceUpdateExperimentArgs) DeepEqual(ano *ExperimentServiceUpdateExperimentArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -37619,7 +39293,7 @@ func (p *ExperimentServiceListExperimentsArgs) DeepEqual(ano *ExperimentServiceL
 	return true
 }
 
-func (p *ExperimentServiceListExperimentsArgs) Field1DeepEqual(src *ListExperimentsRequest) bool {
+func (p *ExperimentServiceUpdateExperimentArgs) Field1DeepEqual(src *UpdateExperimentRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -37627,41 +39301,41 @@ func (p *ExperimentServiceListExperimentsArgs) Field1DeepEqual(src *ListExperime
 	return true
 }
 
-type ExperimentServiceListExperimentsResult struct {
-	Success *ListExperimentsResponse `thrift:"success,0,optional" frugal:"0,optional,ListExperimentsResponse"`
+type ExperimentServiceUpdateExperimentResult struct {
+	Success *UpdateExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateExperimentResponse"`
 }
 
-func NewExperimentServiceListExperimentsResult() *ExperimentServiceListExperimentsResult {
-	return &ExperimentServiceListExperimentsResult{}
+func NewExperimentServiceUpdateExperimentResult() *ExperimentServiceUpdateExperimentResult {
+	return &ExperimentServiceUpdateExperimentResult{}
 }
 
-func (p *ExperimentServiceListExperimentsResult) InitDefault() {
+func (p *ExperimentServiceUpdateExperimentResult) InitDefault() {
 }
 
-var ExperimentServiceListExperimentsResult_Success_DEFAULT *ListExperimentsResponse
+var ExperimentServiceUpdateExperimentResult_Success_DEFAULT *UpdateExperimentResponse
 
-func (p *ExperimentServiceListExperimentsResult) GetSuccess() (v *ListExperimentsResponse) {
+func (p *ExperimentServiceUpdateExperimentResult) GetSuccess() (v *UpdateExperimentResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceListExperimentsResult_Success_DEFAULT
+		return ExperimentServiceUpdateExperimentResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceListExperimentsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListExperimentsResponse)
+func (p *ExperimentServiceUpdateExperimentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateExperimentResponse)
 }
 
-var fieldIDToName_ExperimentServiceListExperimentsResult = map[int16]string{
+var fieldIDToName_ExperimentServiceUpdateExperimentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceListExperimentsResult) IsSetSuccess() bool {
+func (p *ExperimentServiceUpdateExperimentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceListExperimentsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceUpdateExperimentResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -37706,7 +39380,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -37716,8 +39390,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceListExperimentsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListExperimentsResponse()
+func (p *ExperimentServiceUpdateExperimentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateExperimentResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -37725,9 +39399,9 @@ func (p *ExperimentServiceListExperimentsResult) ReadField0(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *ExperimentServiceListExperimentsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceUpdateExperimentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListExperiments_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateExperiment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -37753,7 +39427,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceListExperimentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceUpdateExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -37772,15 +39446,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceListExperimentsResult) String() string {
+func (p *ExperimentServiceUpdateExperimentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceListExperimentsResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceUpdateExperimentResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceListExperimentsResult) DeepEqual(ano *ExperimentServiceListExperimentsResult) bool {
+func (p *ExperimentServiceUpdateExperimentResult) DeepEqual(ano *ExperimentServiceUpdateExperimentResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -37792,7 +39466,7 @@ func (p *ExperimentServiceListExperimentsResult) DeepEqual(ano *ExperimentServic
 	return true
 }
 
-func (p *ExperimentServiceListExperimentsResult) Field0DeepEqual(src *ListExperimentsResponse) bool {
+func (p *ExperimentServiceUpdateExperimentResult) Field0DeepEqual(src *UpdateExperimentResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -37800,41 +39474,41 @@ func (p *ExperimentServiceListExperimentsResult) Field0DeepEqual(src *ListExperi
 	return true
 }
 
-type ExperimentServiceUpdateExperimentArgs struct {
-	Req *UpdateExperimentRequest `thrift:"req,1" frugal:"1,default,UpdateExperimentRequest"`
+type ExperimentServiceDeleteExperimentArgs struct {
+	Req *DeleteExperimentRequest `thrift:"req,1" frugal:"1,default,DeleteExperimentRequest"`
 }
 
-func NewExperimentServiceUpdateExperimentArgs() *ExperimentServiceUpdateExperimentArgs {
-	return &ExperimentServiceUpdateExperimentArgs{}
+func NewExperimentServiceDeleteExperimentArgs() *ExperimentServiceDeleteExperimentArgs {
+	return &ExperimentServiceDeleteExperimentArgs{}
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) InitDefault() {
+func (p *ExperimentServiceDeleteExperimentArgs) InitDefault() {
 }
 
-var ExperimentServiceUpdateExperimentArgs_Req_DEFAULT *UpdateExperimentRequest
+var ExperimentServiceDeleteExperimentArgs_Req_DEFAULT *DeleteExperimentRequest
 
-func (p *ExperimentServiceUpdateExperimentArgs) GetReq() (v *UpdateExperimentRequest) {
+func (p *ExperimentServiceDeleteExperimentArgs) GetReq() (v *DeleteExperimentRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceUpdateExperimentArgs_Req_DEFAULT
+		return ExperimentServiceDeleteExperimentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceUpdateExperimentArgs) SetReq(val *UpdateExperimentRequest) {
+func (p *ExperimentServiceDeleteExperimentArgs) SetReq(val *DeleteExperimentRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceUpdateExperimentArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceDeleteExperimentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) IsSetReq() bool {
+func (p *ExperimentServiceDeleteExperimentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceDeleteExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -37879,7 +39553,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -37889,8 +39563,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateExperimentRequest()
+func (p *ExperimentServiceDeleteExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteExperimentRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -37898,9 +39572,9 @@ func (p *ExperimentServiceUpdateExperimentArgs) ReadField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceDeleteExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateExperiment_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteExperiment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -37926,7 +39600,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceDeleteExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -37943,15 +39617,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) String() string {
+func (p *ExperimentServiceDeleteExperimentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceUpdateExperimentArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceDeleteExperimentArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) DeepEqual(ano *ExperimentServiceUpdateExperimentArgs) bool {
+func (p *ExperimentServiceDeleteExperimentArgs) DeepEqual(ano *ExperimentServiceDeleteExperimentArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -37963,7 +39637,7 @@ func (p *ExperimentServiceUpdateExperimentArgs) DeepEqual(ano *ExperimentService
 	return true
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) Field1DeepEqual(src *UpdateExperimentRequest) bool {
+func (p *ExperimentServiceDeleteExperimentArgs) Field1DeepEqual(src *DeleteExperimentRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -37971,41 +39645,41 @@ func (p *ExperimentServiceUpdateExperimentArgs) Field1DeepEqual(src *UpdateExper
 	return true
 }
 
-type ExperimentServiceUpdateExperimentResult struct {
-	Success *UpdateExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateExperimentResponse"`
+type ExperimentServiceDeleteExperimentResult struct {
+	Success *DeleteExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteExperimentResponse"`
 }
 
-func NewExperimentServiceUpdateExperimentResult() *ExperimentServiceUpdateExperimentResult {
-	return &ExperimentServiceUpdateExperimentResult{}
+func NewExperimentServiceDeleteExperimentResult() *ExperimentServiceDeleteExperimentResult {
+	return &ExperimentServiceDeleteExperimentResult{}
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) InitDefault() {
+func (p *ExperimentServiceDeleteExperimentResult) InitDefault() {
 }
 
-var ExperimentServiceUpdateExperimentResult_Success_DEFAULT *UpdateExperimentResponse
+var ExperimentServiceDeleteExperimentResult_Success_DEFAULT *DeleteExperimentResponse
 
-func (p *ExperimentServiceUpdateExperimentResult) GetSuccess() (v *UpdateExperimentResponse) {
+func (p *ExperimentServiceDeleteExperimentResult) GetSuccess() (v *DeleteExperimentResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceUpdateExperimentResult_Success_DEFAULT
+		return ExperimentServiceDeleteExperimentResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceUpdateExperimentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateExperimentResponse)
+func (p *ExperimentServiceDeleteExperimentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteExperimentResponse)
 }
 
-var fieldIDToName_ExperimentServiceUpdateExperimentResult = map[int16]string{
+var fieldIDToName_ExperimentServiceDeleteExperimentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) IsSetSuccess() bool {
+func (p *ExperimentServiceDeleteExperimentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceDeleteExperimentResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -38050,7 +39724,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -38060,8 +39734,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateExperimentResponse()
+func (p *ExperimentServiceDeleteExperimentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteExperimentResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -38069,9 +39743,9 @@ func (p *ExperimentServiceUpdateExperimentResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceDeleteExperimentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateExperiment_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteExperiment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -38097,7 +39771,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceDeleteExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -38116,15 +39790,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) String() string {
+func (p *ExperimentServiceDeleteExperimentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceUpdateExperimentResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceDeleteExperimentResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) DeepEqual(ano *ExperimentServiceUpdateExperimentResult) bool {
+func (p *ExperimentServiceDeleteExperimentResult) DeepEqual(ano *ExperimentServiceDeleteExperimentResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -38136,7 +39810,7 @@ func (p *ExperimentServiceUpdateExperimentResult) DeepEqual(ano *ExperimentServi
 	return true
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) Field0DeepEqual(src *UpdateExperimentResponse) bool {
+func (p *ExperimentServiceDeleteExperimentResult) Field0DeepEqual(src *DeleteExperimentResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -38144,41 +39818,41 @@ func (p *ExperimentServiceUpdateExperimentResult) Field0DeepEqual(src *UpdateExp
 	return true
 }
 
-type ExperimentServiceDeleteExperimentArgs struct {
-	Req *DeleteExperimentRequest `thrift:"req,1" frugal:"1,default,DeleteExperimentRequest"`
+type ExperimentServiceBatchDeleteExperimentsArgs struct {
+	Req *BatchDeleteExperimentsRequest `thrift:"req,1" frugal:"1,default,BatchDeleteExperimentsRequest"`
 }
 
-func NewExperimentServiceDeleteExperimentArgs() *ExperimentServiceDeleteExperimentArgs {
-	return &ExperimentServiceDeleteExperimentArgs{}
+func NewExperimentServiceBatchDeleteExperimentsArgs() *ExperimentServiceBatchDeleteExperimentsArgs {
+	return &ExperimentServiceBatchDeleteExperimentsArgs{}
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) InitDefault() {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) InitDefault() {
 }
 
-var ExperimentServiceDeleteExperimentArgs_Req_DEFAULT *DeleteExperimentRequest
+var ExperimentServiceBatchDeleteExperimentsArgs_Req_DEFAULT *BatchDeleteExperimentsRequest
 
-func (p *ExperimentServiceDeleteExperimentArgs) GetReq() (v *DeleteExperimentRequest) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) GetReq() (v *BatchDeleteExperimentsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceDeleteExperimentArgs_Req_DEFAULT
+		return ExperimentServiceBatchDeleteExperimentsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceDeleteExperimentArgs) SetReq(val *DeleteExperimentRequest) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) SetReq(val *BatchDeleteExperimentsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceDeleteExperimentArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) IsSetReq() bool {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -38223,7 +39897,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -38233,8 +39907,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteExperimentRequest()
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchDeleteExperimentsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -38242,9 +39916,9 @@ func (p *ExperimentServiceDeleteExperimentArgs) ReadField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteExperiment_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchDeleteExperiments_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -38270,7 +39944,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -38287,15 +39961,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) String() string {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceDeleteExperimentArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceBatchDeleteExperimentsArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) DeepEqual(ano *ExperimentServiceDeleteExperimentArgs) bool {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepEqual(ano *ExperimentServiceBatchDeleteExperimentsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -38307,7 +39981,7 @@ func (p *ExperimentServiceDeleteExperimentArgs) DeepEqual(ano *ExperimentService
 	return true
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) Field1DeepEqual(src *DeleteExperimentRequest) bool {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) Field1DeepEqual(src *BatchDeleteExperimentsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -38315,41 +39989,41 @@ func (p *ExperimentServiceDeleteExperimentArgs) Field1DeepEqual(src *DeleteExper
 	return true
 }
 
-type ExperimentServiceDeleteExperimentResult struct {
-	Success *DeleteExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteExperimentResponse"`
+type ExperimentServiceBatchDeleteExperimentsResult struct {
+	Success *BatchDeleteExperimentsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchDeleteExperimentsResponse"`
 }
 
-func NewExperimentServiceDeleteExperimentResult() *ExperimentServiceDeleteExperimentResult {
-	return &ExperimentServiceDeleteExperimentResult{}
+func NewExperimentServiceBatchDeleteExperimentsResult() *ExperimentServiceBatchDeleteExperimentsResult {
+	return &ExperimentServiceBatchDeleteExperimentsResult{}
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) InitDefault() {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) InitDefault() {
 }
 
-var ExperimentServiceDeleteExperimentResult_Success_DEFAULT *DeleteExperimentResponse
+var ExperimentServiceBatchDeleteExperimentsResult_Success_DEFAULT *BatchDeleteExperimentsResponse
 
-func (p *ExperimentServiceDeleteExperimentResult) GetSuccess() (v *DeleteExperimentResponse) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) GetSuccess() (v *BatchDeleteExperimentsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceDeleteExperimentResult_Success_DEFAULT
+		return ExperimentServiceBatchDeleteExperimentsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceDeleteExperimentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteExperimentResponse)
+func (p *ExperimentServiceBatchDeleteExperimentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchDeleteExperimentsResponse)
 }
 
-var fieldIDToName_ExperimentServiceDeleteExperimentResult = map[int16]string{
+var fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) IsSetSuccess() bool {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -38394,7 +40068,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -38404,8 +40078,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteExperimentResponse()
+func (p *ExperimentServiceBatchDeleteExperimentsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchDeleteExperimentsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -38413,9 +40087,9 @@ func (p *ExperimentServiceDeleteExperimentResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteExperiment_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchDeleteExperiments_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -38441,7 +40115,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -38460,15 +40134,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) String() string {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceDeleteExperimentResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceBatchDeleteExperimentsResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) DeepEqual(ano *ExperimentServiceDeleteExperimentResult) bool {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepEqual(ano *ExperimentServiceBatchDeleteExperimentsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -38480,7 +40154,7 @@ func (p *ExperimentServiceDeleteExperimentResult) DeepEqual(ano *ExperimentServi
 	return true
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) Field0DeepEqual(src *DeleteExperimentResponse) bool {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) Field0DeepEqual(src *BatchDeleteExperimentsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -38488,41 +40162,41 @@ func (p *ExperimentServiceDeleteExperimentResult) Field0DeepEqual(src *DeleteExp
 	return true
 }
 
-type ExperimentServiceBatchDeleteExperimentsArgs struct {
-	Req *BatchDeleteExperimentsRequest `thrift:"req,1" frugal:"1,default,BatchDeleteExperimentsRequest"`
+type ExperimentServiceCloneExperimentArgs struct {
+	Req *CloneExperimentRequest `thrift:"req,1" frugal:"1,default,CloneExperimentRequest"`
 }
 
-func NewExperimentServiceBatchDeleteExperimentsArgs() *ExperimentServiceBatchDeleteExperimentsArgs {
-	return &ExperimentServiceBatchDeleteExperimentsArgs{}
+func NewExperimentServiceCloneExperimentArgs() *ExperimentServiceCloneExperimentArgs {
+	return &ExperimentServiceCloneExperimentArgs{}
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) InitDefault() {
+func (p *ExperimentServiceCloneExperimentArgs) InitDefault() {
 }
 
-var ExperimentServiceBatchDeleteExperimentsArgs_Req_DEFAULT *BatchDeleteExperimentsRequest
+var ExperimentServiceCloneExperimentArgs_Req_DEFAULT *CloneExperimentRequest
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) GetReq() (v *BatchDeleteExperimentsRequest) {
+func (p *ExperimentServiceCloneExperimentArgs) GetReq() (v *CloneExperimentRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceBatchDeleteExperimentsArgs_Req_DEFAULT
+		return ExperimentServiceCloneExperimentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) SetReq(val *BatchDeleteExperimentsRequest) {
+func (p *ExperimentServiceCloneExperimentArgs) SetReq(val *CloneExperimentRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceCloneExperimentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) IsSetReq() bool {
+func (p *ExperimentServiceCloneExperimentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCloneExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -38567,7 +40241,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -38577,8 +40251,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchDeleteExperimentsRequest()
+func (p *ExperimentServiceCloneExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCloneExperimentRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -38586,9 +40260,9 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) ReadField1(iprot thrift.TP
 	return nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCloneExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchDeleteExperiments_args"); err != nil {
+	if err = oprot.WriteStructBegin("CloneExperiment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -38614,7 +40288,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCloneExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -38631,15 +40305,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) String() string {
+func (p *ExperimentServiceCloneExperimentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceBatchDeleteExperimentsArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceCloneExperimentArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepEqual(ano *ExperimentServiceBatchDeleteExperimentsArgs) bool {
+func (p *ExperimentServiceCloneExperimentArgs) DeepEqual(ano *ExperimentServiceCloneExperimentArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -38651,7 +40325,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepEqual(ano *ExperimentS
 	return true
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) Field1DeepEqual(src *BatchDeleteExperimentsRequest) bool {
+func (p *ExperimentServiceCloneExperimentArgs) Field1DeepEqual(src *CloneExperimentRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -38659,41 +40333,41 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) Field1DeepEqual(src *Batch
 	return true
 }
 
-type ExperimentServiceBatchDeleteExperimentsResult struct {
-	Success *BatchDeleteExperimentsResponse `thrift:"success,0,optional" frugal:"0,optional,BatchDeleteExperimentsResponse"`
+type ExperimentServiceCloneExperimentResult struct {
+	Success *CloneExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,CloneExperimentResponse"`
 }
 
-func NewExperimentServiceBatchDeleteExperimentsResult() *ExperimentServiceBatchDeleteExperimentsResult {
-	return &ExperimentServiceBatchDeleteExperimentsResult{}
+func NewExperimentServiceCloneExperimentResult() *ExperimentServiceCloneExperimentResult {
+	return &ExperimentServiceCloneExperimentResult{}
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) InitDefault() {
+func (p *ExperimentServiceCloneExperimentResult) InitDefault() {
 }
 
-var ExperimentServiceBatchDeleteExperimentsResult_Success_DEFAULT *BatchDeleteExperimentsResponse
+var ExperimentServiceCloneExperimentResult_Success_DEFAULT *CloneExperimentResponse
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) GetSuccess() (v *BatchDeleteExperimentsResponse) {
+func (p *ExperimentServiceCloneExperimentResult) GetSuccess() (v *CloneExperimentResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceBatchDeleteExperimentsResult_Success_DEFAULT
+		return ExperimentServiceCloneExperimentResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceBatchDeleteExperimentsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchDeleteExperimentsResponse)
+func (p *ExperimentServiceCloneExperimentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CloneExperimentResponse)
 }
 
-var fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult = map[int16]string{
+var fieldIDToName_ExperimentServiceCloneExperimentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) IsSetSuccess() bool {
+func (p *ExperimentServiceCloneExperimentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCloneExperimentResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -38738,7 +40412,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -38748,8 +40422,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchDeleteExperimentsResponse()
+func (p *ExperimentServiceCloneExperimentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCloneExperimentResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -38757,9 +40431,9 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) ReadField0(iprot thrift.
 	return nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCloneExperimentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchDeleteExperiments_result"); err != nil {
+	if err = oprot.WriteStructBegin("CloneExperiment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -38785,7 +40459,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCloneExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -38804,15 +40478,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) String() string {
+func (p *ExperimentServiceCloneExperimentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceBatchDeleteExperimentsResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceCloneExperimentResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepEqual(ano *ExperimentServiceBatchDeleteExperimentsResult) bool {
+func (p *ExperimentServiceCloneExperimentResult) DeepEqual(ano *ExperimentServiceCloneExperimentResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -38824,7 +40498,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepEqual(ano *Experimen
 	return true
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) Field0DeepEqual(src *BatchDeleteExperimentsResponse) bool {
+func (p *ExperimentServiceCloneExperimentResult) Field0DeepEqual(src *CloneExperimentResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -38832,41 +40506,41 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) Field0DeepEqual(src *Bat
 	return true
 }
 
-type ExperimentServiceCloneExperimentArgs struct {
-	Req *CloneExperimentRequest `thrift:"req,1" frugal:"1,default,CloneExperimentRequest"`
+type ExperimentServiceRunExperimentArgs struct {
+	Req *RunExperimentRequest `thrift:"req,1" frugal:"1,default,RunExperimentRequest"`
 }
 
-func NewExperimentServiceCloneExperimentArgs() *ExperimentServiceCloneExperimentArgs {
-	return &ExperimentServiceCloneExperimentArgs{}
+func NewExperimentServiceRunExperimentArgs() *ExperimentServiceRunExperimentArgs {
+	return &ExperimentServiceRunExperimentArgs{}
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) InitDefault() {
+func (p *ExperimentServiceRunExperimentArgs) InitDefault() {
 }
 
-var ExperimentServiceCloneExperimentArgs_Req_DEFAULT *CloneExperimentRequest
+var ExperimentServiceRunExperimentArgs_Req_DEFAULT *RunExperimentRequest
 
-func (p *ExperimentServiceCloneExperimentArgs) GetReq() (v *CloneExperimentRequest) {
+func (p *ExperimentServiceRunExperimentArgs) GetReq() (v *RunExperimentRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceCloneExperimentArgs_Req_DEFAULT
+		return ExperimentServiceRunExperimentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceCloneExperimentArgs) SetReq(val *CloneExperimentRequest) {
+func (p *ExperimentServiceRunExperimentArgs) SetReq(val *RunExperimentRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceCloneExperimentArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceRunExperimentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) IsSetReq() bool {
+func (p *ExperimentServiceRunExperimentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRunExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -38911,7 +40585,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -38921,8 +40595,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCloneExperimentRequest()
+func (p *ExperimentServiceRunExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRunExperimentRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -38930,9 +40604,9 @@ func (p *ExperimentServiceCloneExperimentArgs) ReadField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRunExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CloneExperiment_args"); err != nil {
+	if err = oprot.WriteStructBegin("RunExperiment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -38958,7 +40632,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRunExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -38975,15 +40649,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) String() string {
+func (p *ExperimentServiceRunExperimentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceCloneExperimentArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceRunExperimentArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) DeepEqual(ano *ExperimentServiceCloneExperimentArgs) bool {
+func (p *ExperimentServiceRunExperimentArgs) DeepEqual(ano *ExperimentServiceRunExperimentArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -38995,7 +40669,7 @@ func (p *ExperimentServiceCloneExperimentArgs) DeepEqual(ano *ExperimentServiceC
 	return true
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) Field1DeepEqual(src *CloneExperimentRequest) bool {
+func (p *ExperimentServiceRunExperimentArgs) Field1DeepEqual(src *RunExperimentRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -39003,41 +40677,41 @@ func (p *ExperimentServiceCloneExperimentArgs) Field1DeepEqual(src *CloneExperim
 	return true
 }
 
-type ExperimentServiceCloneExperimentResult struct {
-	Success *CloneExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,CloneExperimentResponse"`
+type ExperimentServiceRunExperimentResult struct {
+	Success *RunExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,RunExperimentResponse"`
 }
 
-func NewExperimentServiceCloneExperimentResult() *ExperimentServiceCloneExperimentResult {
-	return &ExperimentServiceCloneExperimentResult{}
+func NewExperimentServiceRunExperimentResult() *ExperimentServiceRunExperimentResult {
+	return &ExperimentServiceRunExperimentResult{}
 }
 
-func (p *ExperimentServiceCloneExperimentResult) InitDefault() {
+func (p *ExperimentServiceRunExperimentResult) InitDefault() {
 }
 
-var ExperimentServiceCloneExperimentResult_Success_DEFAULT *CloneExperimentResponse
+var ExperimentServiceRunExperimentResult_Success_DEFAULT *RunExperimentResponse
 
-func (p *ExperimentServiceCloneExperimentResult) GetSuccess() (v *CloneExperimentResponse) {
+func (p *ExperimentServiceRunExperimentResult) GetSuccess() (v *RunExperimentResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceCloneExperimentResult_Success_DEFAULT
+		return ExperimentServiceRunExperimentResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceCloneExperimentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CloneExperimentResponse)
+func (p *ExperimentServiceRunExperimentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RunExperimentResponse)
 }
 
-var fieldIDToName_ExperimentServiceCloneExperimentResult = map[int16]string{
+var fieldIDToName_ExperimentServiceRunExperimentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceCloneExperimentResult) IsSetSuccess() bool {
+func (p *ExperimentServiceRunExperimentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceCloneExperimentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRunExperimentResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -39082,7 +40756,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -39092,8 +40766,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceCloneExperimentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCloneExperimentResponse()
+func (p *ExperimentServiceRunExperimentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRunExperimentResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -39101,9 +40775,9 @@ func (p *ExperimentServiceCloneExperimentResult) ReadField0(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *ExperimentServiceCloneExperimentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRunExperimentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CloneExperiment_result"); err != nil {
+	if err = oprot.WriteStructBegin("RunExperiment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -39129,7 +40803,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceCloneExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRunExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -39148,15 +40822,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceCloneExperimentResult) String() string {
+func (p *ExperimentServiceRunExperimentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceCloneExperimentResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceRunExperimentResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceCloneExperimentResult) DeepEqual(ano *ExperimentServiceCloneExperimentResult) bool {
+func (p *ExperimentServiceRunExperimentResult) DeepEqual(ano *ExperimentServiceRunExperimentResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -39168,7 +40842,7 @@ func (p *ExperimentServiceCloneExperimentResult) DeepEqual(ano *ExperimentServic
 	return true
 }
 
-func (p *ExperimentServiceCloneExperimentResult) Field0DeepEqual(src *CloneExperimentResponse) bool {
+func (p *ExperimentServiceRunExperimentResult) Field0DeepEqual(src *RunExperimentResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -39176,41 +40850,41 @@ func (p *ExperimentServiceCloneExperimentResult) Field0DeepEqual(src *CloneExper
 	return true
 }
 
-type ExperimentServiceRunExperimentArgs struct {
-	Req *RunExperimentRequest `thrift:"req,1" frugal:"1,default,RunExperimentRequest"`
+type ExperimentServiceRetryExperimentArgs struct {
+	Req *RetryExperimentRequest `thrift:"req,1" frugal:"1,default,RetryExperimentRequest"`
 }
 
-func NewExperimentServiceRunExperimentArgs() *ExperimentServiceRunExperimentArgs {
-	return &ExperimentServiceRunExperimentArgs{}
+func NewExperimentServiceRetryExperimentArgs() *ExperimentServiceRetryExperimentArgs {
+	return &ExperimentServiceRetryExperimentArgs{}
 }
 
-func (p *ExperimentServiceRunExperimentArgs) InitDefault() {
+func (p *ExperimentServiceRetryExperimentArgs) InitDefault() {
 }
 
-var ExperimentServiceRunExperimentArgs_Req_DEFAULT *RunExperimentRequest
+var ExperimentServiceRetryExperimentArgs_Req_DEFAULT *RetryExperimentRequest
 
-func (p *ExperimentServiceRunExperimentArgs) GetReq() (v *RunExperimentRequest) {
+func (p *ExperimentServiceRetryExperimentArgs) GetReq() (v *RetryExperimentRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceRunExperimentArgs_Req_DEFAULT
+		return ExperimentServiceRetryExperimentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceRunExperimentArgs) SetReq(val *RunExperimentRequest) {
+func (p *ExperimentServiceRetryExperimentArgs) SetReq(val *RetryExperimentRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceRunExperimentArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceRetryExperimentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceRunExperimentArgs) IsSetReq() bool {
+func (p *ExperimentServiceRetryExperimentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceRunExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRetryExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -39255,7 +40929,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -39265,8 +40939,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceRunExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRunExperimentRequest()
+func (p *ExperimentServiceRetryExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRetryExperimentRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -39274,9 +40948,9 @@ func (p *ExperimentServiceRunExperimentArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ExperimentServiceRunExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRetryExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RunExperiment_args"); err != nil {
+	if err = oprot.WriteStructBegin("RetryExperiment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -39302,7 +40976,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceRunExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRetryExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -39319,15 +40993,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceRunExperimentArgs) String() string {
+func (p *ExperimentServiceRetryExperimentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceRunExperimentArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceRetryExperimentArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceRunExperimentArgs) DeepEqual(ano *ExperimentServiceRunExperimentArgs) bool {
+func (p *ExperimentServiceRetryExperimentArgs) DeepEqual(ano *ExperimentServiceRetryExperimentArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -39339,7 +41013,7 @@ func (p *ExperimentServiceRunExperimentArgs) DeepEqual(ano *ExperimentServiceRun
 	return true
 }
 
-func (p *ExperimentServiceRunExperimentArgs) Field1DeepEqual(src *RunExperimentRequest) bool {
+func (p *ExperimentServiceRetryExperimentArgs) Field1DeepEqual(src *RetryExperimentRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -39347,41 +41021,41 @@ func (p *ExperimentServiceRunExperimentArgs) Field1DeepEqual(src *RunExperimentR
 	return true
 }
 
-type ExperimentServiceRunExperimentResult struct {
-	Success *RunExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,RunExperimentResponse"`
+type ExperimentServiceRetryExperimentResult struct {
+	Success *RetryExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,RetryExperimentResponse"`
 }
 
-func NewExperimentServiceRunExperimentResult() *ExperimentServiceRunExperimentResult {
-	return &ExperimentServiceRunExperimentResult{}
+func NewExperimentServiceRetryExperimentResult() *ExperimentServiceRetryExperimentResult {
+	return &ExperimentServiceRetryExperimentResult{}
 }
 
-func (p *ExperimentServiceRunExperimentResult) InitDefault() {
+func (p *ExperimentServiceRetryExperimentResult) InitDefault() {
 }
 
-var ExperimentServiceRunExperimentResult_Success_DEFAULT *RunExperimentResponse
+var ExperimentServiceRetryExperimentResult_Success_DEFAULT *RetryExperimentResponse
 
-func (p *ExperimentServiceRunExperimentResult) GetSuccess() (v *RunExperimentResponse) {
+func (p *ExperimentServiceRetryExperimentResult) GetSuccess() (v *RetryExperimentResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceRunExperimentResult_Success_DEFAULT
+		return ExperimentServiceRetryExperimentResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceRunExperimentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RunExperimentResponse)
+func (p *ExperimentServiceRetryExperimentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RetryExperimentResponse)
 }
 
-var fieldIDToName_ExperimentServiceRunExperimentResult = map[int16]string{
+var fieldIDToName_ExperimentServiceRetryExperimentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceRunExperimentResult) IsSetSuccess() bool {
+func (p *ExperimentServiceRetryExperimentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceRunExperimentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRetryExperimentResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -39426,7 +41100,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -39436,8 +41110,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceRunExperimentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRunExperimentResponse()
+func (p *ExperimentServiceRetryExperimentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRetryExperimentResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -39445,9 +41119,9 @@ func (p *ExperimentServiceRunExperimentResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *ExperimentServiceRunExperimentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRetryExperimentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RunExperiment_result"); err != nil {
+	if err = oprot.WriteStructBegin("RetryExperiment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -39473,7 +41147,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceRunExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceRetryExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -39492,15 +41166,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceRunExperimentResult) String() string {
+func (p *ExperimentServiceRetryExperimentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceRunExperimentResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceRetryExperimentResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceRunExperimentResult) DeepEqual(ano *ExperimentServiceRunExperimentResult) bool {
+func (p *ExperimentServiceRetryExperimentResult) DeepEqual(ano *ExperimentServiceRetryExperimentResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -39512,7 +41186,7 @@ func (p *ExperimentServiceRunExperimentResult) DeepEqual(ano *ExperimentServiceR
 	return true
 }
 
-func (p *ExperimentServiceRunExperimentResult) Field0DeepEqual(src *RunExperimentResponse) bool {
+func (p *ExperimentServiceRetryExperimentResult) Field0DeepEqual(src *RetryExperimentResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -39520,41 +41194,41 @@ func (p *ExperimentServiceRunExperimentResult) Field0DeepEqual(src *RunExperimen
 	return true
 }
 
-type ExperimentServiceRetryExperimentArgs struct {
-	Req *RetryExperimentRequest `thrift:"req,1" frugal:"1,default,RetryExperimentRequest"`
+type ExperimentServiceKillExperimentArgs struct {
+	Req *KillExperimentRequest `thrift:"req,1" frugal:"1,default,KillExperimentRequest"`
 }
 
-func NewExperimentServiceRetryExperimentArgs() *ExperimentServiceRetryExperimentArgs {
-	return &ExperimentServiceRetryExperimentArgs{}
+func NewExperimentServiceKillExperimentArgs() *ExperimentServiceKillExperimentArgs {
+	return &ExperimentServiceKillExperimentArgs{}
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) InitDefault() {
+func (p *ExperimentServiceKillExperimentArgs) InitDefault() {
 }
 
-var ExperimentServiceRetryExperimentArgs_Req_DEFAULT *RetryExperimentRequest
+var ExperimentServiceKillExperimentArgs_Req_DEFAULT *KillExperimentRequest
 
-func (p *ExperimentServiceRetryExperimentArgs) GetReq() (v *RetryExperimentRequest) {
+func (p *ExperimentServiceKillExperimentArgs) GetReq() (v *KillExperimentRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceRetryExperimentArgs_Req_DEFAULT
+		return ExperimentServiceKillExperimentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceRetryExperimentArgs) SetReq(val *RetryExperimentRequest) {
+func (p *ExperimentServiceKillExperimentArgs) SetReq(val *KillExperimentRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceRetryExperimentArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceKillExperimentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) IsSetReq() bool {
+func (p *ExperimentServiceKillExperimentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceKillExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -39599,7 +41273,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -39609,8 +41283,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRetryExperimentRequest()
+func (p *ExperimentServiceKillExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewKillExperimentRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -39618,9 +41292,9 @@ func (p *ExperimentServiceRetryExperimentArgs) ReadField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceKillExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RetryExperiment_args"); err != nil {
+	if err = oprot.WriteStructBegin("KillExperiment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -39646,7 +41320,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceKillExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -39663,15 +41337,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) String() string {
+func (p *ExperimentServiceKillExperimentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceRetryExperimentArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceKillExperimentArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) DeepEqual(ano *ExperimentServiceRetryExperimentArgs) bool {
+func (p *ExperimentServiceKillExperimentArgs) DeepEqual(ano *ExperimentServiceKillExperimentArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -39683,7 +41357,7 @@ func (p *ExperimentServiceRetryExperimentArgs) DeepEqual(ano *ExperimentServiceR
 	return true
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) Field1DeepEqual(src *RetryExperimentRequest) bool {
+func (p *ExperimentServiceKillExperimentArgs) Field1DeepEqual(src *KillExperimentRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -39691,41 +41365,41 @@ func (p *ExperimentServiceRetryExperimentArgs) Field1DeepEqual(src *RetryExperim
 	return true
 }
 
-type ExperimentServiceRetryExperimentResult struct {
-	Success *RetryExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,RetryExperimentResponse"`
+type ExperimentServiceKillExperimentResult struct {
+	Success *KillExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,KillExperimentResponse"`
 }
 
-func NewExperimentServiceRetryExperimentResult() *ExperimentServiceRetryExperimentResult {
-	return &ExperimentServiceRetryExperimentResult{}
+func NewExperimentServiceKillExperimentResult() *ExperimentServiceKillExperimentResult {
+	return &ExperimentServiceKillExperimentResult{}
 }
 
-func (p *ExperimentServiceRetryExperimentResult) InitDefault() {
+func (p *ExperimentServiceKillExperimentResult) InitDefault() {
 }
 
-var ExperimentServiceRetryExperimentResult_Success_DEFAULT *RetryExperimentResponse
+var ExperimentServiceKillExperimentResult_Success_DEFAULT *KillExperimentResponse
 
-func (p *ExperimentServiceRetryExperimentResult) GetSuccess() (v *RetryExperimentResponse) {
+func (p *ExperimentServiceKillExperimentResult) GetSuccess() (v *KillExperimentResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceRetryExperimentResult_Success_DEFAULT
+		return ExperimentServiceKillExperimentResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceRetryExperimentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RetryExperimentResponse)
+func (p *ExperimentServiceKillExperimentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*KillExperimentResponse)
 }
 
-var fieldIDToName_ExperimentServiceRetryExperimentResult = map[int16]string{
+var fieldIDToName_ExperimentServiceKillExperimentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceRetryExperimentResult) IsSetSuccess() bool {
+func (p *ExperimentServiceKillExperimentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceRetryExperimentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceKillExperimentResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -39770,7 +41444,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -39780,8 +41454,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceRetryExperimentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRetryExperimentResponse()
+func (p *ExperimentServiceKillExperimentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewKillExperimentResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -39789,9 +41463,9 @@ func (p *ExperimentServiceRetryExperimentResult) ReadField0(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *ExperimentServiceRetryExperimentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceKillExperimentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RetryExperiment_result"); err != nil {
+	if err = oprot.WriteStructBegin("KillExperiment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -39817,7 +41491,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceRetryExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceKillExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -39836,15 +41510,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceRetryExperimentResult) String() string {
+func (p *ExperimentServiceKillExperimentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceRetryExperimentResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceKillExperimentResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceRetryExperimentResult) DeepEqual(ano *ExperimentServiceRetryExperimentResult) bool {
+func (p *ExperimentServiceKillExperimentResult) DeepEqual(ano *ExperimentServiceKillExperimentResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -39856,7 +41530,7 @@ func (p *ExperimentServiceRetryExperimentResult) DeepEqual(ano *ExperimentServic
 	return true
 }
 
-func (p *ExperimentServiceRetryExperimentResult) Field0DeepEqual(src *RetryExperimentResponse) bool {
+func (p *ExperimentServiceKillExperimentResult) Field0DeepEqual(src *KillExperimentResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -39864,41 +41538,41 @@ func (p *ExperimentServiceRetryExperimentResult) Field0DeepEqual(src *RetryExper
 	return true
 }
 
-type ExperimentServiceKillExperimentArgs struct {
-	Req *KillExperimentRequest `thrift:"req,1" frugal:"1,default,KillExperimentRequest"`
+type ExperimentServiceBatchGetExperimentResultArgs struct {
+	Req *BatchGetExperimentResultRequest `thrift:"req,1" frugal:"1,default,BatchGetExperimentResultRequest"`
 }
 
-func NewExperimentServiceKillExperimentArgs() *ExperimentServiceKillExperimentArgs {
-	return &ExperimentServiceKillExperimentArgs{}
+func NewExperimentServiceBatchGetExperimentResultArgs() *ExperimentServiceBatchGetExperimentResultArgs {
+	return &ExperimentServiceBatchGetExperimentResultArgs{}
 }
 
-func (p *ExperimentServiceKillExperimentArgs) InitDefault() {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) InitDefault() {
 }
 
-var ExperimentServiceKillExperimentArgs_Req_DEFAULT *KillExperimentRequest
+var ExperimentServiceBatchGetExperimentResultArgs_Req_DEFAULT *BatchGetExperimentResultRequest
 
-func (p *ExperimentServiceKillExperimentArgs) GetReq() (v *KillExperimentRequest) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) GetReq() (v *BatchGetExperimentResultRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceKillExperimentArgs_Req_DEFAULT
+		return ExperimentServiceBatchGetExperimentResultArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceKillExperimentArgs) SetReq(val *KillExperimentRequest) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) SetReq(val *BatchGetExperimentResultRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceKillExperimentArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceKillExperimentArgs) IsSetReq() bool {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceKillExperimentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -39943,7 +41617,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -39953,8 +41627,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceKillExperimentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewKillExperimentRequest()
+func (p *ExperimentServiceBatchGetExperimentResultArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetExperimentResultRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -39962,9 +41636,9 @@ func (p *ExperimentServiceKillExperimentArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ExperimentServiceKillExperimentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("KillExperiment_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetExperimentResult_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -39990,7 +41664,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceKillExperimentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -40007,15 +41681,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceKillExperimentArgs) String() string {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceKillExperimentArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceBatchGetExperimentResultArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceKillExperimentArgs) DeepEqual(ano *ExperimentServiceKillExperimentArgs) bool {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepEqual(ano *ExperimentServiceBatchGetExperimentResultArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -40027,7 +41701,7 @@ func (p *ExperimentServiceKillExperimentArgs) DeepEqual(ano *ExperimentServiceKi
 	return true
 }
 
-func (p *ExperimentServiceKillExperimentArgs) Field1DeepEqual(src *KillExperimentRequest) bool {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) Field1DeepEqual(src *BatchGetExperimentResultRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -40035,41 +41709,41 @@ func (p *ExperimentServiceKillExperimentArgs) Field1DeepEqual(src *KillExperimen
 	return true
 }
 
-type ExperimentServiceKillExperimentResult struct {
-	Success *KillExperimentResponse `thrift:"success,0,optional" frugal:"0,optional,KillExperimentResponse"`
+type ExperimentServiceBatchGetExperimentResultResult struct {
+	Success *BatchGetExperimentResultResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetExperimentResultResponse"`
 }
 
-func NewExperimentServiceKillExperimentResult() *ExperimentServiceKillExperimentResult {
-	return &ExperimentServiceKillExperimentResult{}
+func NewExperimentServiceBatchGetExperimentResultResult() *ExperimentServiceBatchGetExperimentResultResult {
+	return &ExperimentServiceBatchGetExperimentResultResult{}
 }
 
-func (p *ExperimentServiceKillExperimentResult) InitDefault() {
+func (p *ExperimentServiceBatchGetExperimentResultResult) InitDefault() {
 }
 
-var ExperimentServiceKillExperimentResult_Success_DEFAULT *KillExperimentResponse
+var ExperimentServiceBatchGetExperimentResultResult_Success_DEFAULT *BatchGetExperimentResultResponse
 
-func (p *ExperimentServiceKillExperimentResult) GetSuccess() (v *KillExperimentResponse) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) GetSuccess() (v *BatchGetExperimentResultResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceKillExperimentResult_Success_DEFAULT
+		return ExperimentServiceBatchGetExperimentResultResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceKillExperimentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*KillExperimentResponse)
+func (p *ExperimentServiceBatchGetExperimentResultResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetExperimentResultResponse)
 }
 
-var fieldIDToName_ExperimentServiceKillExperimentResult = map[int16]string{
+var fieldIDToName_ExperimentServiceBatchGetExperimentResultResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceKillExperimentResult) IsSetSuccess() bool {
+func (p *ExperimentServiceBatchGetExperimentResultResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceKillExperimentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -40114,7 +41788,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -40124,8 +41798,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceKillExperimentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewKillExperimentResponse()
+func (p *ExperimentServiceBatchGetExperimentResultResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetExperimentResultResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -40133,9 +41807,9 @@ func (p *ExperimentServiceKillExperimentResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *ExperimentServiceKillExperimentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("KillExperiment_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetExperimentResult_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -40161,7 +41835,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceKillExperimentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -40180,15 +41854,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceKillExperimentResult) String() string {
+func (p *ExperimentServiceBatchGetExperimentResultResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceKillExperimentResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceBatchGetExperimentResultResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceKillExperimentResult) DeepEqual(ano *ExperimentServiceKillExperimentResult) bool {
+func (p *ExperimentServiceBatchGetExperimentResultResult) DeepEqual(ano *ExperimentServiceBatchGetExperimentResultResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -40200,7 +41874,7 @@ func (p *ExperimentServiceKillExperimentResult) DeepEqual(ano *ExperimentService
 	return true
 }
 
-func (p *ExperimentServiceKillExperimentResult) Field0DeepEqual(src *KillExperimentResponse) bool {
+func (p *ExperimentServiceBatchGetExperimentResultResult) Field0DeepEqual(src *BatchGetExperimentResultResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -40208,41 +41882,41 @@ func (p *ExperimentServiceKillExperimentResult) Field0DeepEqual(src *KillExperim
 	return true
 }
 
-type ExperimentServiceBatchGetExperimentResultArgs struct {
-	Req *BatchGetExperimentResultRequest `thrift:"req,1" frugal:"1,default,BatchGetExperimentResultRequest"`
+type ExperimentServiceCalculateExperimentAggrResultArgs struct {
+	Req *CalculateExperimentAggrResultRequest `thrift:"req,1" frugal:"1,default,CalculateExperimentAggrResultRequest"`
 }
 
-func NewExperimentServiceBatchGetExperimentResultArgs() *ExperimentServiceBatchGetExperimentResultArgs {
-	return &ExperimentServiceBatchGetExperimentResultArgs{}
+func NewExperimentServiceCalculateExperimentAggrResultArgs() *ExperimentServiceCalculateExperimentAggrResultArgs {
+	return &ExperimentServiceCalculateExperimentAggrResultArgs{}
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) InitDefault() {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) InitDefault() {
 }
 
-var ExperimentServiceBatchGetExperimentResultArgs_Req_DEFAULT *BatchGetExperimentResultRequest
+var ExperimentServiceCalculateExperimentAggrResultArgs_Req_DEFAULT *CalculateExperimentAggrResultRequest
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) GetReq() (v *BatchGetExperimentResultRequest) {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) GetReq() (v *CalculateExperimentAggrResultRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceBatchGetExperimentResultArgs_Req_DEFAULT
+		return ExperimentServiceCalculateExperimentAggrResultArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceBatchGetExperimentResultArgs) SetReq(val *BatchGetExperimentResultRequest) {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) SetReq(val *CalculateExperimentAggrResultRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceCalculateExperimentAggrResultArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) IsSetReq() bool {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -40287,7 +41961,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -40297,8 +41971,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetExperimentResultRequest()
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCalculateExperimentAggrResultRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -40306,9 +41980,9 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) ReadField1(iprot thrift.
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetExperimentResult_args"); err != nil {
+	if err = oprot.WriteStructBegin("CalculateExperimentAggrResult_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -40334,7 +42008,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -40351,15 +42025,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) String() string {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceBatchGetExperimentResultArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceCalculateExperimentAggrResultArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepEqual(ano *ExperimentServiceBatchGetExperimentResultArgs) bool {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) DeepEqual(ano *ExperimentServiceCalculateExperimentAggrResultArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -40371,7 +42045,7 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepEqual(ano *Experimen
 	return true
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) Field1DeepEqual(src *BatchGetExperimentResultRequest) bool {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) Field1DeepEqual(src *CalculateExperimentAggrResultRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -40379,41 +42053,41 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) Field1DeepEqual(src *Bat
 	return true
 }
 
-type ExperimentServiceBatchGetExperimentResultResult struct {
-	Success *BatchGetExperimentResultResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetExperimentResultResponse"`
+type ExperimentServiceCalculateExperimentAggrResultResult struct {
+	Success *CalculateExperimentAggrResultResponse `thrift:"success,0,optional" frugal:"0,optional,CalculateExperimentAggrResultResponse"`
 }
 
-func NewExperimentServiceBatchGetExperimentResultResult() *ExperimentServiceBatchGetExperimentResultResult {
-	return &ExperimentServiceBatchGetExperimentResultResult{}
+func NewExperimentServiceCalculateExperimentAggrResultResult() *ExperimentServiceCalculateExperimentAggrResultResult {
+	return &ExperimentServiceCalculateExperimentAggrResultResult{}
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) InitDefault() {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) InitDefault() {
 }
 
-var ExperimentServiceBatchGetExperimentResultResult_Success_DEFAULT *BatchGetExperimentResultResponse
+var ExperimentServiceCalculateExperimentAggrResultResult_Success_DEFAULT *CalculateExperimentAggrResultResponse
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) GetSuccess() (v *BatchGetExperimentResultResponse) {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) GetSuccess() (v *CalculateExperimentAggrResultResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceBatchGetExperimentResultResult_Success_DEFAULT
+		return ExperimentServiceCalculateExperimentAggrResultResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceBatchGetExperimentResultResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetExperimentResultResponse)
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CalculateExperimentAggrResultResponse)
 }
 
-var fieldIDToName_ExperimentServiceBatchGetExperimentResultResult = map[int16]string{
+var fieldIDToName_ExperimentServiceCalculateExperimentAggrResultResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) IsSetSuccess() bool {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -40458,7 +42132,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -40468,8 +42142,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetExperimentResultResponse()
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCalculateExperimentAggrResultResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -40477,9 +42151,9 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) ReadField0(iprot thrif
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetExperimentResult_result"); err != nil {
+	if err = oprot.WriteStructBegin("CalculateExperimentAggrResult_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -40505,7 +42179,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -40524,15 +42198,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) String() string {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceBatchGetExperimentResultResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceCalculateExperimentAggrResultResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) DeepEqual(ano *ExperimentServiceBatchGetExperimentResultResult) bool {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) DeepEqual(ano *ExperimentServiceCalculateExperimentAggrResultResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -40544,7 +42218,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) DeepEqual(ano *Experim
 	return true
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) Field0DeepEqual(src *BatchGetExperimentResultResponse) bool {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) Field0DeepEqual(src *CalculateExperimentAggrResultResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -40552,41 +42226,41 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) Field0DeepEqual(src *B
 	return true
 }
 
-type ExperimentServiceCalculateExperimentAggrResultArgs struct {
-	Req *CalculateExperimentAggrResultRequest `thrift:"req,1" frugal:"1,default,CalculateExperimentAggrResultRequest"`
+type ExperimentServiceBatchGetExperimentAggrResultArgs struct {
+	Req *BatchGetExperimentAggrResultRequest `thrift:"req,1" frugal:"1,default,BatchGetExperimentAggrResultRequest"`
 }
 
-func NewExperimentServiceCalculateExperimentAggrResultArgs() *ExperimentServiceCalculateExperimentAggrResultArgs {
-	return &ExperimentServiceCalculateExperimentAggrResultArgs{}
+func NewExperimentServiceBatchGetExperimentAggrResultArgs() *ExperimentServiceBatchGetExperimentAggrResultArgs {
+	return &ExperimentServiceBatchGetExperimentAggrResultArgs{}
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) InitDefault() {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) InitDefault() {
 }
 
-var ExperimentServiceCalculateExperimentAggrResultArgs_Req_DEFAULT *CalculateExperimentAggrResultRequest
+var ExperimentServiceBatchGetExperimentAggrResultArgs_Req_DEFAULT *BatchGetExperimentAggrResultRequest
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) GetReq() (v *CalculateExperimentAggrResultRequest) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) GetReq() (v *BatchGetExperimentAggrResultRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceCalculateExperimentAggrResultArgs_Req_DEFAULT
+		return ExperimentServiceBatchGetExperimentAggrResultArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) SetReq(val *CalculateExperimentAggrResultRequest) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) SetReq(val *BatchGetExperimentAggrResultRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceCalculateExperimentAggrResultArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) IsSetReq() bool {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -40631,7 +42305,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -40641,8 +42315,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCalculateExperimentAggrResultRequest()
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetExperimentAggrResultRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -40650,9 +42324,9 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) ReadField1(iprot th
 	return nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CalculateExperimentAggrResult_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetExperimentAggrResult_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -40678,7 +42352,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -40695,15 +42369,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) String() string {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceCalculateExperimentAggrResultArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceBatchGetExperimentAggrResultArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) DeepEqual(ano *ExperimentServiceCalculateExperimentAggrResultArgs) bool {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepEqual(ano *ExperimentServiceBatchGetExperimentAggrResultArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -40715,7 +42389,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) DeepEqual(ano *Expe
 	return true
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) Field1DeepEqual(src *CalculateExperimentAggrResultRequest) bool {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) Field1DeepEqual(src *BatchGetExperimentAggrResultRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -40723,41 +42397,41 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) Field1DeepEqual(src
 	return true
 }
 
-type ExperimentServiceCalculateExperimentAggrResultResult struct {
-	Success *CalculateExperimentAggrResultResponse `thrift:"success,0,optional" frugal:"0,optional,CalculateExperimentAggrResultResponse"`
+type ExperimentServiceBatchGetExperimentAggrResultResult struct {
+	Success *BatchGetExperimentAggrResultResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetExperimentAggrResultResponse"`
 }
 
-func NewExperimentServiceCalculateExperimentAggrResultResult() *ExperimentServiceCalculateExperimentAggrResultResult {
-	return &ExperimentServiceCalculateExperimentAggrResultResult{}
+func NewExperimentServiceBatchGetExperimentAggrResultResult() *ExperimentServiceBatchGetExperimentAggrResultResult {
+	return &ExperimentServiceBatchGetExperimentAggrResultResult{}
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) InitDefault() {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) InitDefault() {
 }
 
-var ExperimentServiceCalculateExperimentAggrResultResult_Success_DEFAULT *CalculateExperimentAggrResultResponse
+var ExperimentServiceBatchGetExperimentAggrResultResult_Success_DEFAULT *BatchGetExperimentAggrResultResponse
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) GetSuccess() (v *CalculateExperimentAggrResultResponse) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) GetSuccess() (v *BatchGetExperimentAggrResultResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceCalculateExperimentAggrResultResult_Success_DEFAULT
+		return ExperimentServiceBatchGetExperimentAggrResultResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CalculateExperimentAggrResultResponse)
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetExperimentAggrResultResponse)
 }
 
-var fieldIDToName_ExperimentServiceCalculateExperimentAggrResultResult = map[int16]string{
+var fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) IsSetSuccess() bool {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -40802,7 +42476,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -40812,8 +42486,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCalculateExperimentAggrResultResponse()
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetExperimentAggrResultResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -40821,9 +42495,9 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) ReadField0(iprot
 	return nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CalculateExperimentAggrResult_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchGetExperimentAggrResult_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -40849,7 +42523,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -40868,15 +42542,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) String() string {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceCalculateExperimentAggrResultResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceBatchGetExperimentAggrResultResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) DeepEqual(ano *ExperimentServiceCalculateExperimentAggrResultResult) bool {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepEqual(ano *ExperimentServiceBatchGetExperimentAggrResultResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -40888,7 +42562,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) DeepEqual(ano *Ex
 	return true
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) Field0DeepEqual(src *CalculateExperimentAggrResultResponse) bool {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) Field0DeepEqual(src *BatchGetExperimentAggrResultResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -40896,41 +42570,41 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) Field0DeepEqual(s
 	return true
 }
 
-type ExperimentServiceBatchGetExperimentAggrResultArgs struct {
-	Req *BatchGetExperimentAggrResultRequest `thrift:"req,1" frugal:"1,default,BatchGetExperimentAggrResultRequest"`
+type ExperimentServiceCompareExperimentSignificanceArgs struct {
+	Req *CompareExperimentSignificanceRequest `thrift:"req,1" frugal:"1,default,CompareExperimentSignificanceRequest"`
 }
 
-func NewExperimentServiceBatchGetExperimentAggrResultArgs() *ExperimentServiceBatchGetExperimentAggrResultArgs {
-	return &ExperimentServiceBatchGetExperimentAggrResultArgs{}
+func NewExperimentServiceCompareExperimentSignificanceArgs() *ExperimentServiceCompareExperimentSignificanceArgs {
+	return &ExperimentServiceCompareExperimentSignificanceArgs{}
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) InitDefault() {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) InitDefault() {
 }
 
-var ExperimentServiceBatchGetExperimentAggrResultArgs_Req_DEFAULT *BatchGetExperimentAggrResultRequest
+var ExperimentServiceCompareExperimentSignificanceArgs_Req_DEFAULT *CompareExperimentSignificanceRequest
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) GetReq() (v *BatchGetExperimentAggrResultRequest) {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) GetReq() (v *CompareExperimentSignificanceRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return ExperimentServiceBatchGetExperimentAggrResultArgs_Req_DEFAULT
+		return ExperimentServiceCompareExperimentSignificanceArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) SetReq(val *BatchGetExperimentAggrResultRequest) {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) SetReq(val *CompareExperimentSignificanceRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs = map[int16]string{
+var fieldIDToName_ExperimentServiceCompareExperimentSignificanceArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) IsSetReq() bool {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -40975,7 +42649,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCompareExperimentSignificanceArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -40985,8 +42659,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetExperimentAggrResultRequest()
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCompareExperimentSignificanceRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -40994,9 +42668,9 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) ReadField1(iprot thr
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetExperimentAggrResult_args"); err != nil {
+	if err = oprot.WriteStructBegin("CompareExperimentSignificance_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -41022,7 +42696,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -41039,15 +42713,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) String() string {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceBatchGetExperimentAggrResultArgs(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceCompareExperimentSignificanceArgs(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepEqual(ano *ExperimentServiceBatchGetExperimentAggrResultArgs) bool {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) DeepEqual(ano *ExperimentServiceCompareExperimentSignificanceArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -41059,7 +42733,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepEqual(ano *Exper
 	return true
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) Field1DeepEqual(src *BatchGetExperimentAggrResultRequest) bool {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) Field1DeepEqual(src *CompareExperimentSignificanceRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -41067,41 +42741,41 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) Field1DeepEqual(src
 	return true
 }
 
-type ExperimentServiceBatchGetExperimentAggrResultResult struct {
-	Success *BatchGetExperimentAggrResultResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetExperimentAggrResultResponse"`
+type ExperimentServiceCompareExperimentSignificanceResult struct {
+	Success *CompareExperimentSignificanceResponse `thrift:"success,0,optional" frugal:"0,optional,CompareExperimentSignificanceResponse"`
 }
 
-func NewExperimentServiceBatchGetExperimentAggrResultResult() *ExperimentServiceBatchGetExperimentAggrResultResult {
-	return &ExperimentServiceBatchGetExperimentAggrResultResult{}
+func NewExperimentServiceCompareExperimentSignificanceResult() *ExperimentServiceCompareExperimentSignificanceResult {
+	return &ExperimentServiceCompareExperimentSignificanceResult{}
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) InitDefault() {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) InitDefault() {
 }
 
-var ExperimentServiceBatchGetExperimentAggrResultResult_Success_DEFAULT *BatchGetExperimentAggrResultResponse
+var ExperimentServiceCompareExperimentSignificanceResult_Success_DEFAULT *CompareExperimentSignificanceResponse
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) GetSuccess() (v *BatchGetExperimentAggrResultResponse) {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) GetSuccess() (v *CompareExperimentSignificanceResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return ExperimentServiceBatchGetExperimentAggrResultResult_Success_DEFAULT
+		return ExperimentServiceCompareExperimentSignificanceResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetExperimentAggrResultResponse)
+func (p *ExperimentServiceCompareExperimentSignificanceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CompareExperimentSignificanceResponse)
 }
 
-var fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult = map[int16]string{
+var fieldIDToName_ExperimentServiceCompareExperimentSignificanceResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) IsSetSuccess() bool {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -41146,7 +42820,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCompareExperimentSignificanceResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -41156,8 +42830,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetExperimentAggrResultResponse()
+func (p *ExperimentServiceCompareExperimentSignificanceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCompareExperimentSignificanceResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -41165,9 +42839,9 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) ReadField0(iprot t
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetExperimentAggrResult_result"); err != nil {
+	if err = oprot.WriteStructBegin("CompareExperimentSignificance_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -41193,7 +42867,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -41212,15 +42886,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) String() string {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ExperimentServiceBatchGetExperimentAggrResultResult(%+v)", *p)
+	return fmt.Sprintf("ExperimentServiceCompareExperimentSignificanceResult(%+v)", *p)
 
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepEqual(ano *ExperimentServiceBatchGetExperimentAggrResultResult) bool {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) DeepEqual(ano *ExperimentServiceCompareExperimentSignificanceResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -41232,7 +42906,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepEqual(ano *Exp
 	return true
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) Field0DeepEqual(src *BatchGetExperimentAggrResultResponse) bool {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) Field0DeepEqual(src *CompareExperimentSignificanceResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
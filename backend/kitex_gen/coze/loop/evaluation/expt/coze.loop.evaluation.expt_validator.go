@@ -37,6 +37,11 @@ func (p *CreateExperimentRequest) IsValid() error {
 			return fmt.Errorf("field TargetRuntimeParam not valid, %w", err)
 		}
 	}
+	if p.GateConfig != nil {
+		if err := p.GateConfig.IsValid(); err != nil {
+			return fmt.Errorf("field GateConfig not valid, %w", err)
+		}
+	}
 	if p.Session != nil {
 		if err := p.Session.IsValid(); err != nil {
 			return fmt.Errorf("field Session not valid, %w", err)
@@ -78,6 +83,11 @@ func (p *SubmitExperimentRequest) IsValid() error {
 			return fmt.Errorf("field TargetRuntimeParam not valid, %w", err)
 		}
 	}
+	if p.GateConfig != nil {
+		if err := p.GateConfig.IsValid(); err != nil {
+			return fmt.Errorf("field GateConfig not valid, %w", err)
+		}
+	}
 	if p.Session != nil {
 		if err := p.Session.IsValid(); err != nil {
 			return fmt.Errorf("field Session not valid, %w", err)
@@ -299,6 +309,22 @@ func (p *BatchGetExperimentAggrResultResponse) IsValid() error {
 	}
 	return nil
 }
+func (p *CompareExperimentSignificanceRequest) IsValid() error {
+	if p.Base != nil {
+		if err := p.Base.IsValid(); err != nil {
+			return fmt.Errorf("field Base not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *CompareExperimentSignificanceResponse) IsValid() error {
+	if p.BaseResp != nil {
+		if err := p.BaseResp.IsValid(); err != nil {
+			return fmt.Errorf("field BaseResp not valid, %w", err)
+		}
+	}
+	return nil
+}
 func (p *CalculateExperimentAggrResultRequest) IsValid() error {
 	if p.Base != nil {
 		if err := p.Base.IsValid(); err != nil {
@@ -426,6 +452,16 @@ func (p *CreateExperimentTemplateRequest) IsValid() error {
 			return fmt.Errorf("field CreateEvalTargetParam not valid, %w", err)
 		}
 	}
+	if p.ScheduleConfig != nil {
+		if err := p.ScheduleConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ScheduleConfig not valid, %w", err)
+		}
+	}
+	if p.GateConfig != nil {
+		if err := p.GateConfig.IsValid(); err != nil {
+			return fmt.Errorf("field GateConfig not valid, %w", err)
+		}
+	}
 	if p.Session != nil {
 		if err := p.Session.IsValid(); err != nil {
 			return fmt.Errorf("field Session not valid, %w", err)
@@ -514,6 +550,16 @@ func (p *UpdateExperimentTemplateRequest) IsValid() error {
 			return fmt.Errorf("field CreateEvalTargetParam not valid, %w", err)
 		}
 	}
+	if p.ScheduleConfig != nil {
+		if err := p.ScheduleConfig.IsValid(); err != nil {
+			return fmt.Errorf("field ScheduleConfig not valid, %w", err)
+		}
+	}
+	if p.GateConfig != nil {
+		if err := p.GateConfig.IsValid(); err != nil {
+			return fmt.Errorf("field GateConfig not valid, %w", err)
+		}
+	}
 	if p.Base != nil {
 		if err := p.Base.IsValid(); err != nil {
 			return fmt.Errorf("field Base not valid, %w", err)
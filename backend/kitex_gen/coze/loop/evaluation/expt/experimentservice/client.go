@@ -26,6 +26,7 @@ type Client interface {
 	BatchGetExperimentResult_(ctx context.Context, req *expt.BatchGetExperimentResultRequest, callOptions ...callopt.Option) (r *expt.BatchGetExperimentResultResponse, err error)
 	CalculateExperimentAggrResult_(ctx context.Context, req *expt.CalculateExperimentAggrResultRequest, callOptions ...callopt.Option) (r *expt.CalculateExperimentAggrResultResponse, err error)
 	BatchGetExperimentAggrResult_(ctx context.Context, req *expt.BatchGetExperimentAggrResultRequest, callOptions ...callopt.Option) (r *expt.BatchGetExperimentAggrResultResponse, err error)
+	CompareExperimentSignificance(ctx context.Context, req *expt.CompareExperimentSignificanceRequest, callOptions ...callopt.Option) (r *expt.CompareExperimentSignificanceResponse, err error)
 	InvokeExperiment(ctx context.Context, req *expt.InvokeExperimentRequest, callOptions ...callopt.Option) (r *expt.InvokeExperimentResponse, err error)
 	FinishExperiment(ctx context.Context, req *expt.FinishExperimentRequest, callOptions ...callopt.Option) (r *expt.FinishExperimentResponse, err error)
 	ListExperimentStats(ctx context.Context, req *expt.ListExperimentStatsRequest, callOptions ...callopt.Option) (r *expt.ListExperimentStatsResponse, err error)
@@ -157,6 +158,11 @@ func (p *kExperimentServiceClient) BatchGetExperimentAggrResult_(ctx context.Con
 	return p.kClient.BatchGetExperimentAggrResult_(ctx, req)
 }
 
+func (p *kExperimentServiceClient) CompareExperimentSignificance(ctx context.Context, req *expt.CompareExperimentSignificanceRequest, callOptions ...callopt.Option) (r *expt.CompareExperimentSignificanceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CompareExperimentSignificance(ctx, req)
+}
+
 func (p *kExperimentServiceClient) InvokeExperiment(ctx context.Context, req *expt.InvokeExperimentRequest, callOptions ...callopt.Option) (r *expt.InvokeExperimentResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.InvokeExperiment(ctx, req)
@@ -118,6 +118,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CompareExperimentSignificance": kitex.NewMethodInfo(
+		compareExperimentSignificanceHandler,
+		newExperimentServiceCompareExperimentSignificanceArgs,
+		newExperimentServiceCompareExperimentSignificanceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"InvokeExperiment": kitex.NewMethodInfo(
 		invokeExperimentHandler,
 		newExperimentServiceInvokeExperimentArgs,
@@ -611,6 +618,25 @@ func newExperimentServiceBatchGetExperimentAggrResultResult() interface{} {
 	return expt.NewExperimentServiceBatchGetExperimentAggrResultResult()
 }
 
+func compareExperimentSignificanceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*expt.ExperimentServiceCompareExperimentSignificanceArgs)
+	realResult := result.(*expt.ExperimentServiceCompareExperimentSignificanceResult)
+	success, err := handler.(expt.ExperimentService).CompareExperimentSignificance(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newExperimentServiceCompareExperimentSignificanceArgs() interface{} {
+	return expt.NewExperimentServiceCompareExperimentSignificanceArgs()
+}
+
+func newExperimentServiceCompareExperimentSignificanceResult() interface{} {
+	return expt.NewExperimentServiceCompareExperimentSignificanceResult()
+}
+
 func invokeExperimentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*expt.ExperimentServiceInvokeExperimentArgs)
 	realResult := result.(*expt.ExperimentServiceInvokeExperimentResult)
@@ -1248,6 +1274,16 @@ func (p *kClient) BatchGetExperimentAggrResult_(ctx context.Context, req *expt.B
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CompareExperimentSignificance(ctx context.Context, req *expt.CompareExperimentSignificanceRequest) (r *expt.CompareExperimentSignificanceResponse, err error) {
+	var _args expt.ExperimentServiceCompareExperimentSignificanceArgs
+	_args.Req = req
+	var _result expt.ExperimentServiceCompareExperimentSignificanceResult
+	if err = p.c.Call(ctx, "CompareExperimentSignificance", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) InvokeExperiment(ctx context.Context, req *expt.InvokeExperimentRequest) (r *expt.InvokeExperimentResponse, err error) {
 	var _args expt.ExperimentServiceInvokeExperimentArgs
 	_args.Req = req
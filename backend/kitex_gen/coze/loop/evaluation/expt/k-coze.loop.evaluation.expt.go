@@ -380,6 +380,20 @@ func (p *CreateExperimentRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 46:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField46(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 200:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField200(buf[offset:])
@@ -802,6 +816,18 @@ func (p *CreateExperimentRequest) FastReadField45(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *CreateExperimentRequest) FastReadField46(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptGateConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.GateConfig = _field
+	return offset, nil
+}
+
 func (p *CreateExperimentRequest) FastReadField200(buf []byte) (int, error) {
 	offset := 0
 	_field := common.NewSession()
@@ -856,6 +882,7 @@ func (p *CreateExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWri
 		offset += p.fastWriteField33(buf[offset:], w)
 		offset += p.fastWriteField40(buf[offset:], w)
 		offset += p.fastWriteField42(buf[offset:], w)
+		offset += p.fastWriteField46(buf[offset:], w)
 		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -889,6 +916,7 @@ func (p *CreateExperimentRequest) BLength() int {
 		l += p.field42Length()
 		l += p.field43Length()
 		l += p.field45Length()
+		l += p.field46Length()
 		l += p.field200Length()
 		l += p.field255Length()
 	}
@@ -1130,6 +1158,15 @@ func (p *CreateExperimentRequest) fastWriteField45(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
+func (p *CreateExperimentRequest) fastWriteField46(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGateConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 46)
+		offset += p.GateConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *CreateExperimentRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSession() {
@@ -1365,6 +1402,15 @@ func (p *CreateExperimentRequest) field45Length() int {
 	return l
 }
 
+func (p *CreateExperimentRequest) field46Length() int {
+	l := 0
+	if p.IsSetGateConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.GateConfig.BLength()
+	}
+	return l
+}
+
 func (p *CreateExperimentRequest) field200Length() int {
 	l := 0
 	if p.IsSetSession() {
@@ -1554,6 +1600,15 @@ func (p *CreateExperimentRequest) DeepCopy(s interface{}) error {
 		p.ItemRetryNum = &tmp
 	}
 
+	var _gateConfig *expt.ExptGateConfig
+	if src.GateConfig != nil {
+		_gateConfig = &expt.ExptGateConfig{}
+		if err := _gateConfig.DeepCopy(src.GateConfig); err != nil {
+			return err
+		}
+	}
+	p.GateConfig = _gateConfig
+
 	var _session *common.Session
 	if src.Session != nil {
 		_session = &common.Session{}
@@ -2072,6 +2127,20 @@ func (p *SubmitExperimentRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 46:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField46(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 100:
 			if fieldTypeId == thrift.MAP {
 				l, err = p.FastReadField100(buf[offset:])
@@ -2476,6 +2545,18 @@ func (p *SubmitExperimentRequest) FastReadField45(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *SubmitExperimentRequest) FastReadField46(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptGateConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.GateConfig = _field
+	return offset, nil
+}
+
 func (p *SubmitExperimentRequest) FastReadField100(buf []byte) (int, error) {
 	offset := 0
 
@@ -2561,6 +2642,7 @@ func (p *SubmitExperimentRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWri
 		offset += p.fastWriteField32(buf[offset:], w)
 		offset += p.fastWriteField33(buf[offset:], w)
 		offset += p.fastWriteField40(buf[offset:], w)
+		offset += p.fastWriteField46(buf[offset:], w)
 		offset += p.fastWriteField100(buf[offset:], w)
 		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
@@ -2594,6 +2676,7 @@ func (p *SubmitExperimentRequest) BLength() int {
 		l += p.field41Length()
 		l += p.field42Length()
 		l += p.field45Length()
+		l += p.field46Length()
 		l += p.field100Length()
 		l += p.field200Length()
 		l += p.field255Length()
@@ -2819,6 +2902,15 @@ func (p *SubmitExperimentRequest) fastWriteField45(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
+func (p *SubmitExperimentRequest) fastWriteField46(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGateConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 46)
+		offset += p.GateConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *SubmitExperimentRequest) fastWriteField100(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetExt() {
@@ -3060,6 +3152,15 @@ func (p *SubmitExperimentRequest) field45Length() int {
 	return l
 }
 
+func (p *SubmitExperimentRequest) field46Length() int {
+	l := 0
+	if p.IsSetGateConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.GateConfig.BLength()
+	}
+	return l
+}
+
 func (p *SubmitExperimentRequest) field100Length() int {
 	l := 0
 	if p.IsSetExt() {
@@ -3251,6 +3352,15 @@ func (p *SubmitExperimentRequest) DeepCopy(s interface{}) error {
 		p.ItemRetryNum = &tmp
 	}
 
+	var _gateConfig *expt.ExptGateConfig
+	if src.GateConfig != nil {
+		_gateConfig = &expt.ExptGateConfig{}
+		if err := _gateConfig.DeepCopy(src.GateConfig); err != nil {
+			return err
+		}
+	}
+	p.GateConfig = _gateConfig
+
 	if src.Ext != nil {
 		p.Ext = make(map[string]string, len(src.Ext))
 		for key, val := range src.Ext {
@@ -9687,7 +9797,7 @@ func (p *BatchGetExperimentAggrResultResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *CalculateExperimentAggrResultRequest) FastRead(buf []byte) (int, error) {
+func (p *CompareExperimentSignificanceRequest) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -9695,7 +9805,8 @@ func (p *CalculateExperimentAggrResultRequest) FastRead(buf []byte) (int, error)
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetWorkspaceID bool = false
-	var issetExptID bool = false
+	var issetBaseExperimentID bool = false
+	var issetCompareExperimentIds bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -9728,7 +9839,50 @@ func (p *CalculateExperimentAggrResultRequest) FastRead(buf []byte) (int, error)
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetExptID = true
+				issetBaseExperimentID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetCompareExperimentIds = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -9764,22 +9918,27 @@ func (p *CalculateExperimentAggrResultRequest) FastRead(buf []byte) (int, error)
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetExptID {
+	if !issetBaseExperimentID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetCompareExperimentIds {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CalculateExperimentAggrResultRequest[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentSignificanceRequest[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CalculateExperimentAggrResultRequest[fieldId]))
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CompareExperimentSignificanceRequest[fieldId]))
 }
 
-func (p *CalculateExperimentAggrResultRequest) FastReadField1(buf []byte) (int, error) {
+func (p *CompareExperimentSignificanceRequest) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -9793,7 +9952,7 @@ func (p *CalculateExperimentAggrResultRequest) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *CalculateExperimentAggrResultRequest) FastReadField2(buf []byte) (int, error) {
+func (p *CompareExperimentSignificanceRequest) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field int64
@@ -9803,11 +9962,73 @@ func (p *CalculateExperimentAggrResultRequest) FastReadField2(buf []byte) (int,
 		offset += l
 		_field = v
 	}
-	p.ExptID = _field
+	p.BaseExperimentID = _field
 	return offset, nil
 }
 
-func (p *CalculateExperimentAggrResultRequest) FastReadField255(buf []byte) (int, error) {
+func (p *CompareExperimentSignificanceRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.CompareExperimentIds = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentSignificanceRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+		var _elem int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.EvaluatorVersionIds = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentSignificanceRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ConfidenceLevel = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentSignificanceRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
@@ -9819,47 +10040,92 @@ func (p *CalculateExperimentAggrResultRequest) FastReadField255(buf []byte) (int
 	return offset, nil
 }
 
-func (p *CalculateExperimentAggrResultRequest) FastWrite(buf []byte) int {
+func (p *CompareExperimentSignificanceRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *CalculateExperimentAggrResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentSignificanceRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *CalculateExperimentAggrResultRequest) BLength() int {
+func (p *CompareExperimentSignificanceRequest) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *CalculateExperimentAggrResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentSignificanceRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
 	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
 	return offset
 }
 
-func (p *CalculateExperimentAggrResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentSignificanceRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.BaseExperimentID)
 	return offset
 }
 
-func (p *CalculateExperimentAggrResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+func (p *CompareExperimentSignificanceRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.CompareExperimentIds {
+		length++
+		offset += thrift.Binary.WriteI64(buf[offset:], v)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	return offset
+}
+
+func (p *CompareExperimentSignificanceRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEvaluatorVersionIds() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.EvaluatorVersionIds {
+			length++
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.I64, length)
+	}
+	return offset
+}
+
+func (p *CompareExperimentSignificanceRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetConfidenceLevel() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 5)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.ConfidenceLevel)
+	}
+	return offset
+}
+
+func (p *CompareExperimentSignificanceRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
@@ -9868,21 +10134,50 @@ func (p *CalculateExperimentAggrResultRequest) fastWriteField255(buf []byte, w t
 	return offset
 }
 
-func (p *CalculateExperimentAggrResultRequest) field1Length() int {
+func (p *CompareExperimentSignificanceRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *CalculateExperimentAggrResultRequest) field2Length() int {
+func (p *CompareExperimentSignificanceRequest) field2Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *CalculateExperimentAggrResultRequest) field255Length() int {
+func (p *CompareExperimentSignificanceRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	l +=
+		thrift.Binary.I64Length() * len(p.CompareExperimentIds)
+	return l
+}
+
+func (p *CompareExperimentSignificanceRequest) field4Length() int {
+	l := 0
+	if p.IsSetEvaluatorVersionIds() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		l +=
+			thrift.Binary.I64Length() * len(p.EvaluatorVersionIds)
+	}
+	return l
+}
+
+func (p *CompareExperimentSignificanceRequest) field5Length() int {
+	l := 0
+	if p.IsSetConfidenceLevel() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *CompareExperimentSignificanceRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
 		l += thrift.Binary.FieldBeginLength()
@@ -9891,15 +10186,38 @@ func (p *CalculateExperimentAggrResultRequest) field255Length() int {
 	return l
 }
 
-func (p *CalculateExperimentAggrResultRequest) DeepCopy(s interface{}) error {
-	src, ok := s.(*CalculateExperimentAggrResultRequest)
+func (p *CompareExperimentSignificanceRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*CompareExperimentSignificanceRequest)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
 	p.WorkspaceID = src.WorkspaceID
 
-	p.ExptID = src.ExptID
+	p.BaseExperimentID = src.BaseExperimentID
+
+	if src.CompareExperimentIds != nil {
+		p.CompareExperimentIds = make([]int64, 0, len(src.CompareExperimentIds))
+		for _, elem := range src.CompareExperimentIds {
+			var _elem int64
+			_elem = elem
+			p.CompareExperimentIds = append(p.CompareExperimentIds, _elem)
+		}
+	}
+
+	if src.EvaluatorVersionIds != nil {
+		p.EvaluatorVersionIds = make([]int64, 0, len(src.EvaluatorVersionIds))
+		for _, elem := range src.EvaluatorVersionIds {
+			var _elem int64
+			_elem = elem
+			p.EvaluatorVersionIds = append(p.EvaluatorVersionIds, _elem)
+		}
+	}
+
+	if src.ConfidenceLevel != nil {
+		tmp := *src.ConfidenceLevel
+		p.ConfidenceLevel = &tmp
+	}
 
 	var _base *base.Base
 	if src.Base != nil {
@@ -9913,7 +10231,433 @@ func (p *CalculateExperimentAggrResultRequest) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *CalculateExperimentAggrResultResponse) FastRead(buf []byte) (int, error) {
+func (p *CompareExperimentSignificanceResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompareExperimentSignificanceResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *CompareExperimentSignificanceResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*expt.ExptComparisonResult_, 0, size)
+	values := make([]expt.ExptComparisonResult_, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.ComparisonResults = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentSignificanceResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *CompareExperimentSignificanceResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CompareExperimentSignificanceResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CompareExperimentSignificanceResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CompareExperimentSignificanceResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetComparisonResults() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.ComparisonResults {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *CompareExperimentSignificanceResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+	offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *CompareExperimentSignificanceResponse) field1Length() int {
+	l := 0
+	if p.IsSetComparisonResults() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.ComparisonResults {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *CompareExperimentSignificanceResponse) field255Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseResp.BLength()
+	return l
+}
+
+func (p *CompareExperimentSignificanceResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*CompareExperimentSignificanceResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ComparisonResults != nil {
+		p.ComparisonResults = make([]*expt.ExptComparisonResult_, 0, len(src.ComparisonResults))
+		for _, elem := range src.ComparisonResults {
+			var _elem *expt.ExptComparisonResult_
+			if elem != nil {
+				_elem = &expt.ExptComparisonResult_{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.ComparisonResults = append(p.ComparisonResults, _elem)
+		}
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *CalculateExperimentAggrResultRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetExptID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetExptID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExptID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CalculateExperimentAggrResultRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_CalculateExperimentAggrResultRequest[fieldId]))
+}
+
+func (p *CalculateExperimentAggrResultRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *CalculateExperimentAggrResultRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.ExptID = _field
+	return offset, nil
+}
+
+func (p *CalculateExperimentAggrResultRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *CalculateExperimentAggrResultRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *CalculateExperimentAggrResultRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *CalculateExperimentAggrResultRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *CalculateExperimentAggrResultRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *CalculateExperimentAggrResultRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.ExptID)
+	return offset
+}
+
+func (p *CalculateExperimentAggrResultRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CalculateExperimentAggrResultRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CalculateExperimentAggrResultRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *CalculateExperimentAggrResultRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *CalculateExperimentAggrResultRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*CalculateExperimentAggrResultRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.ExptID = src.ExptID
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *CalculateExperimentAggrResultResponse) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -12793,6 +13537,34 @@ func (p *CreateExperimentTemplateRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 23:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField23(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 24:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField24(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 200:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField200(buf[offset:])
@@ -12935,6 +13707,30 @@ func (p *CreateExperimentTemplateRequest) FastReadField22(buf []byte) (int, erro
 	return offset, nil
 }
 
+func (p *CreateExperimentTemplateRequest) FastReadField23(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptTemplateSchedule()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ScheduleConfig = _field
+	return offset, nil
+}
+
+func (p *CreateExperimentTemplateRequest) FastReadField24(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptGateConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.GateConfig = _field
+	return offset, nil
+}
+
 func (p *CreateExperimentTemplateRequest) FastReadField200(buf []byte) (int, error) {
 	offset := 0
 	_field := common.NewSession()
@@ -12973,6 +13769,8 @@ func (p *CreateExperimentTemplateRequest) FastWriteNocopy(buf []byte, w thrift.N
 		offset += p.fastWriteField12(buf[offset:], w)
 		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField22(buf[offset:], w)
+		offset += p.fastWriteField23(buf[offset:], w)
+		offset += p.fastWriteField24(buf[offset:], w)
 		offset += p.fastWriteField200(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
@@ -12990,6 +13788,8 @@ func (p *CreateExperimentTemplateRequest) BLength() int {
 		l += p.field20Length()
 		l += p.field21Length()
 		l += p.field22Length()
+		l += p.field23Length()
+		l += p.field24Length()
 		l += p.field200Length()
 		l += p.field255Length()
 	}
@@ -13058,6 +13858,24 @@ func (p *CreateExperimentTemplateRequest) fastWriteField22(buf []byte, w thrift.
 	return offset
 }
 
+func (p *CreateExperimentTemplateRequest) fastWriteField23(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetScheduleConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 23)
+		offset += p.ScheduleConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *CreateExperimentTemplateRequest) fastWriteField24(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGateConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 24)
+		offset += p.GateConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *CreateExperimentTemplateRequest) fastWriteField200(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSession() {
@@ -13137,6 +13955,24 @@ func (p *CreateExperimentTemplateRequest) field22Length() int {
 	return l
 }
 
+func (p *CreateExperimentTemplateRequest) field23Length() int {
+	l := 0
+	if p.IsSetScheduleConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ScheduleConfig.BLength()
+	}
+	return l
+}
+
+func (p *CreateExperimentTemplateRequest) field24Length() int {
+	l := 0
+	if p.IsSetGateConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.GateConfig.BLength()
+	}
+	return l
+}
+
 func (p *CreateExperimentTemplateRequest) field200Length() int {
 	l := 0
 	if p.IsSetSession() {
@@ -13212,6 +14048,24 @@ func (p *CreateExperimentTemplateRequest) DeepCopy(s interface{}) error {
 		p.ScheduleCron = &tmp
 	}
 
+	var _scheduleConfig *expt.ExptTemplateSchedule
+	if src.ScheduleConfig != nil {
+		_scheduleConfig = &expt.ExptTemplateSchedule{}
+		if err := _scheduleConfig.DeepCopy(src.ScheduleConfig); err != nil {
+			return err
+		}
+	}
+	p.ScheduleConfig = _scheduleConfig
+
+	var _gateConfig *expt.ExptGateConfig
+	if src.GateConfig != nil {
+		_gateConfig = &expt.ExptGateConfig{}
+		if err := _gateConfig.DeepCopy(src.GateConfig); err != nil {
+			return err
+		}
+	}
+	p.GateConfig = _gateConfig
+
 	var _session *common.Session
 	if src.Session != nil {
 		_session = &common.Session{}
@@ -14439,6 +15293,34 @@ func (p *UpdateExperimentTemplateRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 23:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField23(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 24:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField24(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -14586,6 +15468,30 @@ func (p *UpdateExperimentTemplateRequest) FastReadField22(buf []byte) (int, erro
 	return offset, nil
 }
 
+func (p *UpdateExperimentTemplateRequest) FastReadField23(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptTemplateSchedule()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ScheduleConfig = _field
+	return offset, nil
+}
+
+func (p *UpdateExperimentTemplateRequest) FastReadField24(buf []byte) (int, error) {
+	offset := 0
+	_field := expt.NewExptGateConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.GateConfig = _field
+	return offset, nil
+}
+
 func (p *UpdateExperimentTemplateRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
@@ -14613,6 +15519,8 @@ func (p *UpdateExperimentTemplateRequest) FastWriteNocopy(buf []byte, w thrift.N
 		offset += p.fastWriteField12(buf[offset:], w)
 		offset += p.fastWriteField20(buf[offset:], w)
 		offset += p.fastWriteField22(buf[offset:], w)
+		offset += p.fastWriteField23(buf[offset:], w)
+		offset += p.fastWriteField24(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
@@ -14630,6 +15538,8 @@ func (p *UpdateExperimentTemplateRequest) BLength() int {
 		l += p.field20Length()
 		l += p.field21Length()
 		l += p.field22Length()
+		l += p.field23Length()
+		l += p.field24Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
@@ -14704,6 +15614,24 @@ func (p *UpdateExperimentTemplateRequest) fastWriteField22(buf []byte, w thrift.
 	return offset
 }
 
+func (p *UpdateExperimentTemplateRequest) fastWriteField23(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetScheduleConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 23)
+		offset += p.ScheduleConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpdateExperimentTemplateRequest) fastWriteField24(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGateConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 24)
+		offset += p.GateConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *UpdateExperimentTemplateRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
@@ -14781,6 +15709,24 @@ func (p *UpdateExperimentTemplateRequest) field22Length() int {
 	return l
 }
 
+func (p *UpdateExperimentTemplateRequest) field23Length() int {
+	l := 0
+	if p.IsSetScheduleConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ScheduleConfig.BLength()
+	}
+	return l
+}
+
+func (p *UpdateExperimentTemplateRequest) field24Length() int {
+	l := 0
+	if p.IsSetGateConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.GateConfig.BLength()
+	}
+	return l
+}
+
 func (p *UpdateExperimentTemplateRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
@@ -14849,6 +15795,24 @@ func (p *UpdateExperimentTemplateRequest) DeepCopy(s interface{}) error {
 		p.ScheduleCron = &tmp
 	}
 
+	var _scheduleConfig *expt.ExptTemplateSchedule
+	if src.ScheduleConfig != nil {
+		_scheduleConfig = &expt.ExptTemplateSchedule{}
+		if err := _scheduleConfig.DeepCopy(src.ScheduleConfig); err != nil {
+			return err
+		}
+	}
+	p.ScheduleConfig = _scheduleConfig
+
+	var _gateConfig *expt.ExptGateConfig
+	if src.GateConfig != nil {
+		_gateConfig = &expt.ExptGateConfig{}
+		if err := _gateConfig.DeepCopy(src.GateConfig); err != nil {
+			return err
+		}
+	}
+	p.GateConfig = _gateConfig
+
 	var _base *base.Base
 	if src.Base != nil {
 		_base = &base.Base{}
@@ -25689,14 +26653,248 @@ func (p *ExperimentServiceListExperimentsResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceListExperimentsResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceListExperimentsResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListExperimentsResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceListExperimentsResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceListExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceListExperimentsResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceListExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExperimentServiceListExperimentsResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *ExperimentServiceListExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceListExperimentsResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ListExperimentsResponse
+	if src.Success != nil {
+		_success = &ListExperimentsResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewUpdateExperimentRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *ExperimentServiceUpdateExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExperimentArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *UpdateExperimentRequest
+	if src.Req != nil {
+		_req = &UpdateExperimentRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *ExperimentServiceUpdateExperimentResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceUpdateExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListExperimentsResponse()
+	_field := NewUpdateExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -25706,11 +26904,11 @@ func (p *ExperimentServiceListExperimentsResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceUpdateExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceListExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -25719,7 +26917,7 @@ func (p *ExperimentServiceListExperimentsResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsResult) BLength() int {
+func (p *ExperimentServiceUpdateExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -25728,7 +26926,7 @@ func (p *ExperimentServiceListExperimentsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceUpdateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -25737,7 +26935,7 @@ func (p *ExperimentServiceListExperimentsResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceListExperimentsResult) field0Length() int {
+func (p *ExperimentServiceUpdateExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -25746,15 +26944,15 @@ func (p *ExperimentServiceListExperimentsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceListExperimentsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceListExperimentsResult)
+func (p *ExperimentServiceUpdateExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceUpdateExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListExperimentsResponse
+	var _success *UpdateExperimentResponse
 	if src.Success != nil {
-		_success = &ListExperimentsResponse{}
+		_success = &UpdateExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -25764,7 +26962,7 @@ func (p *ExperimentServiceListExperimentsResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -25808,14 +27006,14 @@ func (p *ExperimentServiceUpdateExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateExperimentRequest()
+	_field := NewDeleteExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -25825,11 +27023,11 @@ func (p *ExperimentServiceUpdateExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -25838,7 +27036,7 @@ func (p *ExperimentServiceUpdateExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) BLength() int {
+func (p *ExperimentServiceDeleteExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -25847,29 +27045,29 @@ func (p *ExperimentServiceUpdateExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) field1Length() int {
+func (p *ExperimentServiceDeleteExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateExperimentArgs)
+func (p *ExperimentServiceDeleteExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateExperimentRequest
+	var _req *DeleteExperimentRequest
 	if src.Req != nil {
-		_req = &UpdateExperimentRequest{}
+		_req = &DeleteExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -25879,7 +27077,7 @@ func (p *ExperimentServiceUpdateExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -25923,14 +27121,14 @@ func (p *ExperimentServiceUpdateExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceUpdateExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceDeleteExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateExperimentResponse()
+	_field := NewDeleteExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -25940,11 +27138,11 @@ func (p *ExperimentServiceUpdateExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceDeleteExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -25953,7 +27151,7 @@ func (p *ExperimentServiceUpdateExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) BLength() int {
+func (p *ExperimentServiceDeleteExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -25962,7 +27160,7 @@ func (p *ExperimentServiceUpdateExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceDeleteExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -25971,7 +27169,7 @@ func (p *ExperimentServiceUpdateExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) field0Length() int {
+func (p *ExperimentServiceDeleteExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -25980,15 +27178,15 @@ func (p *ExperimentServiceUpdateExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceUpdateExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceUpdateExperimentResult)
+func (p *ExperimentServiceDeleteExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceDeleteExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateExperimentResponse
+	var _success *DeleteExperimentResponse
 	if src.Success != nil {
-		_success = &UpdateExperimentResponse{}
+		_success = &DeleteExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -25998,7 +27196,7 @@ func (p *ExperimentServiceUpdateExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -26042,14 +27240,14 @@ func (p *ExperimentServiceDeleteExperimentArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteExperimentRequest()
+	_field := NewBatchDeleteExperimentsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -26059,11 +27257,11 @@ func (p *ExperimentServiceDeleteExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -26072,7 +27270,7 @@ func (p *ExperimentServiceDeleteExperimentArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) BLength() int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -26081,29 +27279,29 @@ func (p *ExperimentServiceDeleteExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) field1Length() int {
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteExperimentArgs)
+func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteExperimentRequest
+	var _req *BatchDeleteExperimentsRequest
 	if src.Req != nil {
-		_req = &DeleteExperimentRequest{}
+		_req = &BatchDeleteExperimentsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -26113,7 +27311,7 @@ func (p *ExperimentServiceDeleteExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -26157,14 +27355,14 @@ func (p *ExperimentServiceDeleteExperimentResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceDeleteExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteExperimentResponse()
+	_field := NewBatchDeleteExperimentsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -26174,11 +27372,11 @@ func (p *ExperimentServiceDeleteExperimentResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -26187,7 +27385,7 @@ func (p *ExperimentServiceDeleteExperimentResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) BLength() int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -26196,7 +27394,7 @@ func (p *ExperimentServiceDeleteExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -26205,7 +27403,7 @@ func (p *ExperimentServiceDeleteExperimentResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) field0Length() int {
+func (p *ExperimentServiceBatchDeleteExperimentsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -26214,15 +27412,15 @@ func (p *ExperimentServiceDeleteExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceDeleteExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceDeleteExperimentResult)
+func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteExperimentResponse
+	var _success *BatchDeleteExperimentsResponse
 	if src.Success != nil {
-		_success = &DeleteExperimentResponse{}
+		_success = &BatchDeleteExperimentsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -26232,7 +27430,7 @@ func (p *ExperimentServiceDeleteExperimentResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -26276,14 +27474,14 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDeleteExperimentsRequest()
+	_field := NewCloneExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -26293,11 +27491,11 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastReadField1(buf []byte)
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCloneExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -26306,7 +27504,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) BLength() int {
+func (p *ExperimentServiceCloneExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -26315,29 +27513,29 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) field1Length() int {
+func (p *ExperimentServiceCloneExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsArgs)
+func (p *ExperimentServiceCloneExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCloneExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchDeleteExperimentsRequest
+	var _req *CloneExperimentRequest
 	if src.Req != nil {
-		_req = &BatchDeleteExperimentsRequest{}
+		_req = &CloneExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -26347,7 +27545,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsArgs) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -26391,14 +27589,14 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchDeleteExperimentsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCloneExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchDeleteExperimentsResponse()
+	_field := NewCloneExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -26408,11 +27606,11 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) FastReadField0(buf []byt
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCloneExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -26421,7 +27619,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) BLength() int {
+func (p *ExperimentServiceCloneExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -26430,7 +27628,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCloneExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -26439,7 +27637,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) fastWriteField0(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) field0Length() int {
+func (p *ExperimentServiceCloneExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -26448,15 +27646,15 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchDeleteExperimentsResult)
+func (p *ExperimentServiceCloneExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCloneExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchDeleteExperimentsResponse
+	var _success *CloneExperimentResponse
 	if src.Success != nil {
-		_success = &BatchDeleteExperimentsResponse{}
+		_success = &CloneExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -26466,7 +27664,7 @@ func (p *ExperimentServiceBatchDeleteExperimentsResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -26510,14 +27708,14 @@ func (p *ExperimentServiceCloneExperimentArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCloneExperimentRequest()
+	_field := NewRunExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -26527,11 +27725,11 @@ func (p *ExperimentServiceCloneExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRunExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -26540,7 +27738,7 @@ func (p *ExperimentServiceCloneExperimentArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) BLength() int {
+func (p *ExperimentServiceRunExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -26549,29 +27747,29 @@ func (p *ExperimentServiceCloneExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) field1Length() int {
+func (p *ExperimentServiceRunExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCloneExperimentArgs)
+func (p *ExperimentServiceRunExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRunExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CloneExperimentRequest
+	var _req *RunExperimentRequest
 	if src.Req != nil {
-		_req = &CloneExperimentRequest{}
+		_req = &RunExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -26581,7 +27779,7 @@ func (p *ExperimentServiceCloneExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -26625,14 +27823,14 @@ func (p *ExperimentServiceCloneExperimentResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCloneExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceRunExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCloneExperimentResponse()
+	_field := NewRunExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -26642,11 +27840,11 @@ func (p *ExperimentServiceCloneExperimentResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRunExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCloneExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -26655,7 +27853,7 @@ func (p *ExperimentServiceCloneExperimentResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentResult) BLength() int {
+func (p *ExperimentServiceRunExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -26664,7 +27862,7 @@ func (p *ExperimentServiceCloneExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRunExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -26673,7 +27871,7 @@ func (p *ExperimentServiceCloneExperimentResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceCloneExperimentResult) field0Length() int {
+func (p *ExperimentServiceRunExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -26682,15 +27880,15 @@ func (p *ExperimentServiceCloneExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceCloneExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCloneExperimentResult)
+func (p *ExperimentServiceRunExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRunExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CloneExperimentResponse
+	var _success *RunExperimentResponse
 	if src.Success != nil {
-		_success = &CloneExperimentResponse{}
+		_success = &RunExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -26700,7 +27898,7 @@ func (p *ExperimentServiceCloneExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -26744,14 +27942,14 @@ func (p *ExperimentServiceRunExperimentArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRunExperimentRequest()
+	_field := NewRetryExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -26761,11 +27959,11 @@ func (p *ExperimentServiceRunExperimentArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRetryExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRunExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -26774,7 +27972,7 @@ func (p *ExperimentServiceRunExperimentArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentArgs) BLength() int {
+func (p *ExperimentServiceRetryExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -26783,29 +27981,29 @@ func (p *ExperimentServiceRunExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentArgs) field1Length() int {
+func (p *ExperimentServiceRetryExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRunExperimentArgs)
+func (p *ExperimentServiceRetryExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRetryExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *RunExperimentRequest
+	var _req *RetryExperimentRequest
 	if src.Req != nil {
-		_req = &RunExperimentRequest{}
+		_req = &RetryExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -26815,7 +28013,7 @@ func (p *ExperimentServiceRunExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -26859,14 +28057,14 @@ func (p *ExperimentServiceRunExperimentResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRunExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceRetryExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRunExperimentResponse()
+	_field := NewRetryExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -26876,11 +28074,11 @@ func (p *ExperimentServiceRunExperimentResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceRetryExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRunExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -26889,7 +28087,7 @@ func (p *ExperimentServiceRunExperimentResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentResult) BLength() int {
+func (p *ExperimentServiceRetryExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -26898,7 +28096,7 @@ func (p *ExperimentServiceRunExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceRetryExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -26907,7 +28105,7 @@ func (p *ExperimentServiceRunExperimentResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceRunExperimentResult) field0Length() int {
+func (p *ExperimentServiceRetryExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -26916,15 +28114,15 @@ func (p *ExperimentServiceRunExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceRunExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRunExperimentResult)
+func (p *ExperimentServiceRetryExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceRetryExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *RunExperimentResponse
+	var _success *RetryExperimentResponse
 	if src.Success != nil {
-		_success = &RunExperimentResponse{}
+		_success = &RetryExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -26934,7 +28132,7 @@ func (p *ExperimentServiceRunExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -26978,14 +28176,14 @@ func (p *ExperimentServiceRetryExperimentArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRetryExperimentRequest()
+	_field := NewKillExperimentRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -26995,11 +28193,11 @@ func (p *ExperimentServiceRetryExperimentArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceKillExperimentArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -27008,7 +28206,7 @@ func (p *ExperimentServiceRetryExperimentArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) BLength() int {
+func (p *ExperimentServiceKillExperimentArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -27017,29 +28215,29 @@ func (p *ExperimentServiceRetryExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) field1Length() int {
+func (p *ExperimentServiceKillExperimentArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRetryExperimentArgs)
+func (p *ExperimentServiceKillExperimentArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceKillExperimentArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *RetryExperimentRequest
+	var _req *KillExperimentRequest
 	if src.Req != nil {
-		_req = &RetryExperimentRequest{}
+		_req = &KillExperimentRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -27049,7 +28247,7 @@ func (p *ExperimentServiceRetryExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -27093,14 +28291,14 @@ func (p *ExperimentServiceRetryExperimentResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceRetryExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceKillExperimentResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewRetryExperimentResponse()
+	_field := NewKillExperimentResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -27110,11 +28308,11 @@ func (p *ExperimentServiceRetryExperimentResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceKillExperimentResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceRetryExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -27123,7 +28321,7 @@ func (p *ExperimentServiceRetryExperimentResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentResult) BLength() int {
+func (p *ExperimentServiceKillExperimentResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -27132,7 +28330,7 @@ func (p *ExperimentServiceRetryExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceKillExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -27141,7 +28339,7 @@ func (p *ExperimentServiceRetryExperimentResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *ExperimentServiceRetryExperimentResult) field0Length() int {
+func (p *ExperimentServiceKillExperimentResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -27150,15 +28348,15 @@ func (p *ExperimentServiceRetryExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceRetryExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceRetryExperimentResult)
+func (p *ExperimentServiceKillExperimentResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceKillExperimentResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *RetryExperimentResponse
+	var _success *KillExperimentResponse
 	if src.Success != nil {
-		_success = &RetryExperimentResponse{}
+		_success = &KillExperimentResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -27168,7 +28366,7 @@ func (p *ExperimentServiceRetryExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -27212,14 +28410,14 @@ func (p *ExperimentServiceKillExperimentArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewKillExperimentRequest()
+	_field := NewBatchGetExperimentResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -27229,11 +28427,11 @@ func (p *ExperimentServiceKillExperimentArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceKillExperimentArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -27242,7 +28440,7 @@ func (p *ExperimentServiceKillExperimentArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentArgs) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -27251,29 +28449,29 @@ func (p *ExperimentServiceKillExperimentArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentArgs) field1Length() int {
+func (p *ExperimentServiceBatchGetExperimentResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceKillExperimentArgs)
+func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *KillExperimentRequest
+	var _req *BatchGetExperimentResultRequest
 	if src.Req != nil {
-		_req = &KillExperimentRequest{}
+		_req = &BatchGetExperimentResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -27283,7 +28481,7 @@ func (p *ExperimentServiceKillExperimentArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -27327,14 +28525,14 @@ func (p *ExperimentServiceKillExperimentResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceKillExperimentResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewKillExperimentResponse()
+	_field := NewBatchGetExperimentResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -27344,11 +28542,11 @@ func (p *ExperimentServiceKillExperimentResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceKillExperimentResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -27357,7 +28555,7 @@ func (p *ExperimentServiceKillExperimentResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentResult) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -27366,7 +28564,7 @@ func (p *ExperimentServiceKillExperimentResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -27375,7 +28573,7 @@ func (p *ExperimentServiceKillExperimentResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *ExperimentServiceKillExperimentResult) field0Length() int {
+func (p *ExperimentServiceBatchGetExperimentResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -27384,15 +28582,15 @@ func (p *ExperimentServiceKillExperimentResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceKillExperimentResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceKillExperimentResult)
+func (p *ExperimentServiceBatchGetExperimentResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *KillExperimentResponse
+	var _success *BatchGetExperimentResultResponse
 	if src.Success != nil {
-		_success = &KillExperimentResponse{}
+		_success = &BatchGetExperimentResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -27402,7 +28600,7 @@ func (p *ExperimentServiceKillExperimentResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -27446,14 +28644,14 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) FastRead(buf []byte) (in
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentResultRequest()
+	_field := NewCalculateExperimentAggrResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -27463,11 +28661,11 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) FastReadField1(buf []byt
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -27476,7 +28674,7 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) FastWriteNocopy(buf []by
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) BLength() int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -27485,29 +28683,29 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) field1Length() int {
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentResultArgs)
+func (p *ExperimentServiceCalculateExperimentAggrResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCalculateExperimentAggrResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetExperimentResultRequest
+	var _req *CalculateExperimentAggrResultRequest
 	if src.Req != nil {
-		_req = &BatchGetExperimentResultRequest{}
+		_req = &CalculateExperimentAggrResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -27517,7 +28715,7 @@ func (p *ExperimentServiceBatchGetExperimentResultArgs) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -27561,14 +28759,14 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) FastRead(buf []byte) (
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentResultResponse()
+	_field := NewCalculateExperimentAggrResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -27578,11 +28776,11 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) FastReadField0(buf []b
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -27591,7 +28789,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) FastWriteNocopy(buf []
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) BLength() int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -27600,7 +28798,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -27609,7 +28807,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) fastWriteField0(buf []
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) field0Length() int {
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -27618,15 +28816,15 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) field0Length() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentResultResult)
+func (p *ExperimentServiceCalculateExperimentAggrResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCalculateExperimentAggrResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetExperimentResultResponse
+	var _success *CalculateExperimentAggrResultResponse
 	if src.Success != nil {
-		_success = &BatchGetExperimentResultResponse{}
+		_success = &CalculateExperimentAggrResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -27636,7 +28834,7 @@ func (p *ExperimentServiceBatchGetExperimentResultResult) DeepCopy(s interface{}
 	return nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -27680,14 +28878,14 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastRead(buf []byte
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCalculateExperimentAggrResultRequest()
+	_field := NewBatchGetExperimentAggrResultRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -27697,11 +28895,11 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastReadField1(buf
 	return offset, nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -27710,7 +28908,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -27719,29 +28917,29 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) field1Length() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCalculateExperimentAggrResultArgs)
+func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CalculateExperimentAggrResultRequest
+	var _req *BatchGetExperimentAggrResultRequest
 	if src.Req != nil {
-		_req = &CalculateExperimentAggrResultRequest{}
+		_req = &BatchGetExperimentAggrResultRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -27751,7 +28949,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultArgs) DeepCopy(s interfac
 	return nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -27795,14 +28993,14 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastRead(buf []by
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCalculateExperimentAggrResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCalculateExperimentAggrResultResponse()
+	_field := NewBatchGetExperimentAggrResultResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -27812,11 +29010,11 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastReadField0(bu
 	return offset, nil
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -27825,7 +29023,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) FastWriteNocopy(b
 	return offset
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) BLength() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -27834,7 +29032,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -27843,7 +29041,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) fastWriteField0(b
 	return offset
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) field0Length() int {
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -27852,15 +29050,15 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) field0Length() in
 	return l
 }
 
-func (p *ExperimentServiceCalculateExperimentAggrResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceCalculateExperimentAggrResultResult)
+func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CalculateExperimentAggrResultResponse
+	var _success *BatchGetExperimentAggrResultResponse
 	if src.Success != nil {
-		_success = &CalculateExperimentAggrResultResponse{}
+		_success = &BatchGetExperimentAggrResultResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -27870,7 +29068,7 @@ func (p *ExperimentServiceCalculateExperimentAggrResultResult) DeepCopy(s interf
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -27914,14 +29112,14 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastRead(buf []byte)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCompareExperimentSignificanceArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastReadField1(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentAggrResultRequest()
+	_field := NewCompareExperimentSignificanceRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -27931,11 +29129,11 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastReadField1(buf [
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -27944,7 +29142,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) FastWriteNocopy(buf
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) BLength() int {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -27953,29 +29151,29 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) field1Length() int {
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultArgs)
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCompareExperimentSignificanceArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetExperimentAggrResultRequest
+	var _req *CompareExperimentSignificanceRequest
 	if src.Req != nil {
-		_req = &BatchGetExperimentAggrResultRequest{}
+		_req = &CompareExperimentSignificanceRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -27985,7 +29183,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultArgs) DeepCopy(s interface
 	return nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastRead(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -28029,14 +29227,14 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastRead(buf []byt
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceBatchGetExperimentAggrResultResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExperimentServiceCompareExperimentSignificanceResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastReadField0(buf []byte) (int, error) {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetExperimentAggrResultResponse()
+	_field := NewCompareExperimentSignificanceResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -28046,11 +29244,11 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastReadField0(buf
 	return offset, nil
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWrite(buf []byte) int {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -28059,7 +29257,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) FastWriteNocopy(bu
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) BLength() int {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -28068,7 +29266,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) BLength() int {
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -28077,7 +29275,7 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) fastWriteField0(bu
 	return offset
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) field0Length() int {
+func (p *ExperimentServiceCompareExperimentSignificanceResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -28086,15 +29284,15 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) field0Length() int
 	return l
 }
 
-func (p *ExperimentServiceBatchGetExperimentAggrResultResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*ExperimentServiceBatchGetExperimentAggrResultResult)
+func (p *ExperimentServiceCompareExperimentSignificanceResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExperimentServiceCompareExperimentSignificanceResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetExperimentAggrResultResponse
+	var _success *CompareExperimentSignificanceResponse
 	if src.Success != nil {
-		_success = &BatchGetExperimentAggrResultResponse{}
+		_success = &CompareExperimentSignificanceResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -34074,6 +35272,14 @@ func (p *ExperimentServiceBatchGetExperimentAggrResultResult) GetResult() interf
 	return p.Success
 }
 
+func (p *ExperimentServiceCompareExperimentSignificanceArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *ExperimentServiceCompareExperimentSignificanceResult) GetResult() interface{} {
+	return p.Success
+}
+
 func (p *ExperimentServiceInvokeExperimentArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
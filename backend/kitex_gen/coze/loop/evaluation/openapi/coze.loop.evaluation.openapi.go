@@ -14825,7 +14825,9 @@ type SubmitExperimentOApiRequest struct {
 	ItemConcurNum      *int32               `thrift:"item_concur_num,20,optional" frugal:"20,optional,i32" form:"item_concur_num" json:"item_concur_num,omitempty"`
 	TargetRuntimeParam *common.RuntimeParam `thrift:"target_runtime_param,22,optional" frugal:"22,optional,common.RuntimeParam" form:"target_runtime_param" json:"target_runtime_param,omitempty"`
 	ItemRetryNum       *int32               `thrift:"item_retry_num,45,optional" frugal:"45,optional,i32" form:"item_retry_num" json:"item_retry_num,omitempty"`
-	Base               *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+	// 门禁规则，实验完成后计算门禁结论，可通过获取实验详情接口查询
+	GateConfig *experiment.ExperimentGateConfig `thrift:"gate_config,46,optional" frugal:"46,optional,experiment.ExperimentGateConfig" form:"gate_config" json:"gate_config,omitempty"`
+	Base       *base.Base                       `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
 func NewSubmitExperimentOApiRequest() *SubmitExperimentOApiRequest {
@@ -14967,6 +14969,18 @@ func (p *SubmitExperimentOApiRequest) GetItemRetryNum() (v int32) {
 	return *p.ItemRetryNum
 }
 
+var SubmitExperimentOApiRequest_GateConfig_DEFAULT *experiment.ExperimentGateConfig
+
+func (p *SubmitExperimentOApiRequest) GetGateConfig() (v *experiment.ExperimentGateConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGateConfig() {
+		return SubmitExperimentOApiRequest_GateConfig_DEFAULT
+	}
+	return p.GateConfig
+}
+
 var SubmitExperimentOApiRequest_Base_DEFAULT *base.Base
 
 func (p *SubmitExperimentOApiRequest) GetBase() (v *base.Base) {
@@ -15011,6 +15025,9 @@ func (p *SubmitExperimentOApiRequest) SetTargetRuntimeParam(val *common.RuntimeP
 func (p *SubmitExperimentOApiRequest) SetItemRetryNum(val *int32) {
 	p.ItemRetryNum = val
 }
+func (p *SubmitExperimentOApiRequest) SetGateConfig(val *experiment.ExperimentGateConfig) {
+	p.GateConfig = val
+}
 func (p *SubmitExperimentOApiRequest) SetBase(val *base.Base) {
 	p.Base = val
 }
@@ -15027,6 +15044,7 @@ var fieldIDToName_SubmitExperimentOApiRequest = map[int16]string{
 	20:  "item_concur_num",
 	22:  "target_runtime_param",
 	45:  "item_retry_num",
+	46:  "gate_config",
 	255: "Base",
 }
 
@@ -15074,6 +15092,10 @@ func (p *SubmitExperimentOApiRequest) IsSetItemRetryNum() bool {
 	return p.ItemRetryNum != nil
 }
 
+func (p *SubmitExperimentOApiRequest) IsSetGateConfig() bool {
+	return p.GateConfig != nil
+}
+
 func (p *SubmitExperimentOApiRequest) IsSetBase() bool {
 	return p.Base != nil
 }
@@ -15184,6 +15206,14 @@ func (p *SubmitExperimentOApiRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 46:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField46(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -15354,6 +15384,14 @@ func (p *SubmitExperimentOApiRequest) ReadField45(iprot thrift.TProtocol) error
 	p.ItemRetryNum = _field
 	return nil
 }
+func (p *SubmitExperimentOApiRequest) ReadField46(iprot thrift.TProtocol) error {
+	_field := experiment.NewExperimentGateConfig()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.GateConfig = _field
+	return nil
+}
 func (p *SubmitExperimentOApiRequest) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBase()
 	if err := _field.Read(iprot); err != nil {
@@ -15413,6 +15451,10 @@ func (p *SubmitExperimentOApiRequest) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 45
 			goto WriteFieldError
 		}
+		if err = p.writeField46(oprot); err != nil {
+			fieldId = 46
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -15649,6 +15691,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 45 end error: ", p), err)
 }
+func (p *SubmitExperimentOApiRequest) writeField46(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGateConfig() {
+		if err = oprot.WriteFieldBegin("gate_config", thrift.STRUCT, 46); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.GateConfig.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 46 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 46 end error: ", p), err)
+}
 func (p *SubmitExperimentOApiRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBase() {
 		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
@@ -15715,6 +15775,9 @@ func (p *SubmitExperimentOApiRequest) DeepEqual(ano *SubmitExperimentOApiRequest
 	if !p.Field45DeepEqual(ano.ItemRetryNum) {
 		return false
 	}
+	if !p.Field46DeepEqual(ano.GateConfig) {
+		return false
+	}
 	if !p.Field255DeepEqual(ano.Base) {
 		return false
 	}
@@ -15835,6 +15898,13 @@ func (p *SubmitExperimentOApiRequest) Field45DeepEqual(src *int32) bool {
 	}
 	return true
 }
+func (p *SubmitExperimentOApiRequest) Field46DeepEqual(src *experiment.ExperimentGateConfig) bool {
+
+	if !p.GateConfig.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 func (p *SubmitExperimentOApiRequest) Field255DeepEqual(src *base.Base) bool {
 
 	if !p.Base.DeepEqual(src) {
@@ -446,6 +446,11 @@ func (p *SubmitExperimentOApiRequest) IsValid() error {
 			return fmt.Errorf("field TargetRuntimeParam not valid, %w", err)
 		}
 	}
+	if p.GateConfig != nil {
+		if err := p.GateConfig.IsValid(); err != nil {
+			return fmt.Errorf("field GateConfig not valid, %w", err)
+		}
+	}
 	if p.Base != nil {
 		if err := p.Base.IsValid(); err != nil {
 			return fmt.Errorf("field Base not valid, %w", err)
@@ -10666,6 +10666,20 @@ func (p *SubmitExperimentOApiRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 46:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField46(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				l, err = p.FastReadField255(buf[offset:])
@@ -10866,6 +10880,18 @@ func (p *SubmitExperimentOApiRequest) FastReadField45(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *SubmitExperimentOApiRequest) FastReadField46(buf []byte) (int, error) {
+	offset := 0
+	_field := experiment.NewExperimentGateConfig()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.GateConfig = _field
+	return offset, nil
+}
+
 func (p *SubmitExperimentOApiRequest) FastReadField255(buf []byte) (int, error) {
 	offset := 0
 	_field := base.NewBase()
@@ -10896,6 +10922,7 @@ func (p *SubmitExperimentOApiRequest) FastWriteNocopy(buf []byte, w thrift.Nocop
 		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField8(buf[offset:], w)
 		offset += p.fastWriteField22(buf[offset:], w)
+		offset += p.fastWriteField46(buf[offset:], w)
 		offset += p.fastWriteField255(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
@@ -10916,6 +10943,7 @@ func (p *SubmitExperimentOApiRequest) BLength() int {
 		l += p.field20Length()
 		l += p.field22Length()
 		l += p.field45Length()
+		l += p.field46Length()
 		l += p.field255Length()
 	}
 	l += thrift.Binary.FieldStopLength()
@@ -11035,6 +11063,15 @@ func (p *SubmitExperimentOApiRequest) fastWriteField45(buf []byte, w thrift.Noco
 	return offset
 }
 
+func (p *SubmitExperimentOApiRequest) fastWriteField46(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetGateConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 46)
+		offset += p.GateConfig.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *SubmitExperimentOApiRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetBase() {
@@ -11151,6 +11188,15 @@ func (p *SubmitExperimentOApiRequest) field45Length() int {
 	return l
 }
 
+func (p *SubmitExperimentOApiRequest) field46Length() int {
+	l := 0
+	if p.IsSetGateConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.GateConfig.BLength()
+	}
+	return l
+}
+
 func (p *SubmitExperimentOApiRequest) field255Length() int {
 	l := 0
 	if p.IsSetBase() {
@@ -11263,6 +11309,15 @@ func (p *SubmitExperimentOApiRequest) DeepCopy(s interface{}) error {
 		p.ItemRetryNum = &tmp
 	}
 
+	var _gateConfig *experiment.ExperimentGateConfig
+	if src.GateConfig != nil {
+		_gateConfig = &experiment.ExperimentGateConfig{}
+		if err := _gateConfig.DeepCopy(src.GateConfig); err != nil {
+			return err
+		}
+	}
+	p.GateConfig = _gateConfig
+
 	var _base *base.Base
 	if src.Base != nil {
 		_base = &base.Base{}
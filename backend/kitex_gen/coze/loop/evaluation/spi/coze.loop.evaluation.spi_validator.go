@@ -170,6 +170,9 @@ func (p *InvokeEvaluatorOutputData) IsValid() error {
 func (p *InvokeEvaluatorResult_) IsValid() error {
 	return nil
 }
+func (p *InvokeEvaluatorDimensionResult_) IsValid() error {
+	return nil
+}
 func (p *InvokeEvaluatorUsage) IsValid() error {
 	return nil
 }
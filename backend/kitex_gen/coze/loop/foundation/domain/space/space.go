@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/domain/user"
 	"strings"
 )
 
@@ -66,6 +67,68 @@ func (p *SpaceType) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+// 空间成员角色，值越小权限越大
+type SpaceRoleType int64
+
+const (
+	SpaceRoleType_Undefined SpaceRoleType = 0
+	// 所有者
+	SpaceRoleType_Owner SpaceRoleType = 1
+	// 管理员，可管理成员
+	SpaceRoleType_Admin SpaceRoleType = 2
+	// 编辑者，可创建、编辑、删除资源
+	SpaceRoleType_Editor SpaceRoleType = 3
+	// 查看者，只读
+	SpaceRoleType_Viewer SpaceRoleType = 4
+)
+
+func (p SpaceRoleType) String() string {
+	switch p {
+	case SpaceRoleType_Undefined:
+		return "Undefined"
+	case SpaceRoleType_Owner:
+		return "Owner"
+	case SpaceRoleType_Admin:
+		return "Admin"
+	case SpaceRoleType_Editor:
+		return "Editor"
+	case SpaceRoleType_Viewer:
+		return "Viewer"
+	}
+	return "<UNSET>"
+}
+
+func SpaceRoleTypeFromString(s string) (SpaceRoleType, error) {
+	switch s {
+	case "Undefined":
+		return SpaceRoleType_Undefined, nil
+	case "Owner":
+		return SpaceRoleType_Owner, nil
+	case "Admin":
+		return SpaceRoleType_Admin, nil
+	case "Editor":
+		return SpaceRoleType_Editor, nil
+	case "Viewer":
+		return SpaceRoleType_Viewer, nil
+	}
+	return SpaceRoleType(0), fmt.Errorf("not a valid SpaceRoleType string")
+}
+
+func SpaceRoleTypePtr(v SpaceRoleType) *SpaceRoleType { return &v }
+func (p *SpaceRoleType) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = SpaceRoleType(result.Int64)
+	return
+}
+
+func (p *SpaceRoleType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 // 空间
 type Space struct {
 	// 空间ID
@@ -792,3 +855,413 @@ func (p *Space) Field16DeepEqual(src *string) bool {
 	}
 	return true
 }
+
+// 空间成员
+type SpaceMember struct {
+	// 用户ID
+	UserID *string `thrift:"user_id,1,optional" frugal:"1,optional,string" form:"user_id" json:"user_id,omitempty" query:"user_id"`
+	// 成员角色
+	RoleType *SpaceRoleType `thrift:"role_type,2,optional" frugal:"2,optional,SpaceRoleType" form:"role_type" json:"role_type,omitempty" query:"role_type"`
+	// 用户信息
+	UserInfo *user.UserInfoDetail `thrift:"user_info,3,optional" frugal:"3,optional,user.UserInfoDetail" form:"user_info" json:"user_info,omitempty" query:"user_info"`
+	// 加入时间
+	JoinAt *int64 `thrift:"join_at,4,optional" frugal:"4,optional,i64" json:"join_at" form:"join_at" query:"join_at"`
+}
+
+func NewSpaceMember() *SpaceMember {
+	return &SpaceMember{}
+}
+
+func (p *SpaceMember) InitDefault() {
+}
+
+var SpaceMember_UserID_DEFAULT string
+
+func (p *SpaceMember) GetUserID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUserID() {
+		return SpaceMember_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var SpaceMember_RoleType_DEFAULT SpaceRoleType
+
+func (p *SpaceMember) GetRoleType() (v SpaceRoleType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRoleType() {
+		return SpaceMember_RoleType_DEFAULT
+	}
+	return *p.RoleType
+}
+
+var SpaceMember_UserInfo_DEFAULT *user.UserInfoDetail
+
+func (p *SpaceMember) GetUserInfo() (v *user.UserInfoDetail) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUserInfo() {
+		return SpaceMember_UserInfo_DEFAULT
+	}
+	return p.UserInfo
+}
+
+var SpaceMember_JoinAt_DEFAULT int64
+
+func (p *SpaceMember) GetJoinAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetJoinAt() {
+		return SpaceMember_JoinAt_DEFAULT
+	}
+	return *p.JoinAt
+}
+func (p *SpaceMember) SetUserID(val *string) {
+	p.UserID = val
+}
+func (p *SpaceMember) SetRoleType(val *SpaceRoleType) {
+	p.RoleType = val
+}
+func (p *SpaceMember) SetUserInfo(val *user.UserInfoDetail) {
+	p.UserInfo = val
+}
+func (p *SpaceMember) SetJoinAt(val *int64) {
+	p.JoinAt = val
+}
+
+var fieldIDToName_SpaceMember = map[int16]string{
+	1: "user_id",
+	2: "role_type",
+	3: "user_info",
+	4: "join_at",
+}
+
+func (p *SpaceMember) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *SpaceMember) IsSetRoleType() bool {
+	return p.RoleType != nil
+}
+
+func (p *SpaceMember) IsSetUserInfo() bool {
+	return p.UserInfo != nil
+}
+
+func (p *SpaceMember) IsSetJoinAt() bool {
+	return p.JoinAt != nil
+}
+
+func (p *SpaceMember) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceMember[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SpaceMember) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *SpaceMember) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *SpaceRoleType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := SpaceRoleType(v)
+		_field = &tmp
+	}
+	p.RoleType = _field
+	return nil
+}
+func (p *SpaceMember) ReadField3(iprot thrift.TProtocol) error {
+	_field := user.NewUserInfoDetail()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.UserInfo = _field
+	return nil
+}
+func (p *SpaceMember) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.JoinAt = _field
+	return nil
+}
+
+func (p *SpaceMember) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SpaceMember"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SpaceMember) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *SpaceMember) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoleType() {
+		if err = oprot.WriteFieldBegin("role_type", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.RoleType)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *SpaceMember) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserInfo() {
+		if err = oprot.WriteFieldBegin("user_info", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.UserInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *SpaceMember) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJoinAt() {
+		if err = oprot.WriteFieldBegin("join_at", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.JoinAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SpaceMember) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpaceMember(%+v)", *p)
+
+}
+
+func (p *SpaceMember) DeepEqual(ano *SpaceMember) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.RoleType) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.UserInfo) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.JoinAt) {
+		return false
+	}
+	return true
+}
+
+func (p *SpaceMember) Field1DeepEqual(src *string) bool {
+
+	if p.UserID == src {
+		return true
+	} else if p.UserID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.UserID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SpaceMember) Field2DeepEqual(src *SpaceRoleType) bool {
+
+	if p.RoleType == src {
+		return true
+	} else if p.RoleType == nil || src == nil {
+		return false
+	}
+	if *p.RoleType != *src {
+		return false
+	}
+	return true
+}
+func (p *SpaceMember) Field3DeepEqual(src *user.UserInfoDetail) bool {
+
+	if !p.UserInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *SpaceMember) Field4DeepEqual(src *int64) bool {
+
+	if p.JoinAt == src {
+		return true
+	} else if p.JoinAt == nil || src == nil {
+		return false
+	}
+	if *p.JoinAt != *src {
+		return false
+	}
+	return true
+}
@@ -24,3 +24,11 @@ var (
 func (p *Space) IsValid() error {
 	return nil
 }
+func (p *SpaceMember) IsValid() error {
+	if p.UserInfo != nil {
+		if err := p.UserInfo.IsValid(); err != nil {
+			return fmt.Errorf("field UserInfo not valid, %w", err)
+		}
+	}
+	return nil
+}
@@ -1249,231 +1249,4179 @@ func (p *ListUserSpaceResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
+// 空间成员列表
+type ListSpaceMembersRequest struct {
+	SpaceID int64 `thrift:"space_id,1,required" frugal:"1,required,i64" json:"space_id" path:"space_id,required" `
+	// 分页数量
+	PageSize *int32 `thrift:"page_size,101,optional" frugal:"101,optional,i32" form:"page_size" json:"page_size,omitempty" query:"page_size"`
+	// 当前请求页码
+	PageNumber *int32     `thrift:"page_number,102,optional" frugal:"102,optional,i32" form:"page_number" json:"page_number,omitempty" query:"page_number"`
+	Base       *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewListSpaceMembersRequest() *ListSpaceMembersRequest {
+	return &ListSpaceMembersRequest{}
+}
+
+func (p *ListSpaceMembersRequest) InitDefault() {
+}
+
+func (p *ListSpaceMembersRequest) GetSpaceID() (v int64) {
+	if p != nil {
+		return p.SpaceID
+	}
+	return
+}
+
+var ListSpaceMembersRequest_PageSize_DEFAULT int32
+
+func (p *ListSpaceMembersRequest) GetPageSize() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageSize() {
+		return ListSpaceMembersRequest_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var ListSpaceMembersRequest_PageNumber_DEFAULT int32
+
+func (p *ListSpaceMembersRequest) GetPageNumber() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageNumber() {
+		return ListSpaceMembersRequest_PageNumber_DEFAULT
+	}
+	return *p.PageNumber
+}
+
+var ListSpaceMembersRequest_Base_DEFAULT *base.Base
+
+func (p *ListSpaceMembersRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ListSpaceMembersRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ListSpaceMembersRequest) SetSpaceID(val int64) {
+	p.SpaceID = val
+}
+func (p *ListSpaceMembersRequest) SetPageSize(val *int32) {
+	p.PageSize = val
+}
+func (p *ListSpaceMembersRequest) SetPageNumber(val *int32) {
+	p.PageNumber = val
+}
+func (p *ListSpaceMembersRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_ListSpaceMembersRequest = map[int16]string{
+	1:   "space_id",
+	101: "page_size",
+	102: "page_number",
+	255: "Base",
+}
+
+func (p *ListSpaceMembersRequest) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *ListSpaceMembersRequest) IsSetPageNumber() bool {
+	return p.PageNumber != nil
+}
+
+func (p *ListSpaceMembersRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListSpaceMembersRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSpaceID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 101:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField101(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 102:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField102(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSpaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSpaceMembersRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListSpaceMembersRequest[fieldId]))
+}
+
+func (p *ListSpaceMembersRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpaceID = _field
+	return nil
+}
+func (p *ListSpaceMembersRequest) ReadField101(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *ListSpaceMembersRequest) ReadField102(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageNumber = _field
+	return nil
+}
+func (p *ListSpaceMembersRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ListSpaceMembersRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSpaceMembersRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField101(oprot); err != nil {
+			fieldId = 101
+			goto WriteFieldError
+		}
+		if err = p.writeField102(oprot); err != nil {
+			fieldId = 102
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListSpaceMembersRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("space_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SpaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListSpaceMembersRequest) writeField101(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 101); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 101 end error: ", p), err)
+}
+func (p *ListSpaceMembersRequest) writeField102(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageNumber() {
+		if err = oprot.WriteFieldBegin("page_number", thrift.I32, 102); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageNumber); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 102 end error: ", p), err)
+}
+func (p *ListSpaceMembersRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListSpaceMembersRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSpaceMembersRequest(%+v)", *p)
+
+}
+
+func (p *ListSpaceMembersRequest) DeepEqual(ano *ListSpaceMembersRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SpaceID) {
+		return false
+	}
+	if !p.Field101DeepEqual(ano.PageSize) {
+		return false
+	}
+	if !p.Field102DeepEqual(ano.PageNumber) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ListSpaceMembersRequest) Field1DeepEqual(src int64) bool {
+
+	if p.SpaceID != src {
+		return false
+	}
+	return true
+}
+func (p *ListSpaceMembersRequest) Field101DeepEqual(src *int32) bool {
+
+	if p.PageSize == src {
+		return true
+	} else if p.PageSize == nil || src == nil {
+		return false
+	}
+	if *p.PageSize != *src {
+		return false
+	}
+	return true
+}
+func (p *ListSpaceMembersRequest) Field102DeepEqual(src *int32) bool {
+
+	if p.PageNumber == src {
+		return true
+	} else if p.PageNumber == nil || src == nil {
+		return false
+	}
+	if *p.PageNumber != *src {
+		return false
+	}
+	return true
+}
+func (p *ListSpaceMembersRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListSpaceMembersResponse struct {
+	// 成员列表
+	Members []*space.SpaceMember `thrift:"members,1,optional" frugal:"1,optional,list<space.SpaceMember>" form:"members" json:"members,omitempty" query:"members"`
+	// 成员总数
+	Total    *int32         `thrift:"total,2,optional" frugal:"2,optional,i32" form:"total" json:"total,omitempty" query:"total"`
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewListSpaceMembersResponse() *ListSpaceMembersResponse {
+	return &ListSpaceMembersResponse{}
+}
+
+func (p *ListSpaceMembersResponse) InitDefault() {
+}
+
+var ListSpaceMembersResponse_Members_DEFAULT []*space.SpaceMember
+
+func (p *ListSpaceMembersResponse) GetMembers() (v []*space.SpaceMember) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMembers() {
+		return ListSpaceMembersResponse_Members_DEFAULT
+	}
+	return p.Members
+}
+
+var ListSpaceMembersResponse_Total_DEFAULT int32
+
+func (p *ListSpaceMembersResponse) GetTotal() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTotal() {
+		return ListSpaceMembersResponse_Total_DEFAULT
+	}
+	return *p.Total
+}
+
+var ListSpaceMembersResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ListSpaceMembersResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return ListSpaceMembersResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListSpaceMembersResponse) SetMembers(val []*space.SpaceMember) {
+	p.Members = val
+}
+func (p *ListSpaceMembersResponse) SetTotal(val *int32) {
+	p.Total = val
+}
+func (p *ListSpaceMembersResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ListSpaceMembersResponse = map[int16]string{
+	1:   "members",
+	2:   "total",
+	255: "BaseResp",
+}
+
+func (p *ListSpaceMembersResponse) IsSetMembers() bool {
+	return p.Members != nil
+}
+
+func (p *ListSpaceMembersResponse) IsSetTotal() bool {
+	return p.Total != nil
+}
+
+func (p *ListSpaceMembersResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListSpaceMembersResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSpaceMembersResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListSpaceMembersResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*space.SpaceMember, 0, size)
+	values := make([]space.SpaceMember, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Members = _field
+	return nil
+}
+func (p *ListSpaceMembersResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *ListSpaceMembersResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ListSpaceMembersResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSpaceMembersResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListSpaceMembersResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMembers() {
+		if err = oprot.WriteFieldBegin("members", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
+			return err
+		}
+		for _, v := range p.Members {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListSpaceMembersResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotal() {
+		if err = oprot.WriteFieldBegin("total", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Total); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ListSpaceMembersResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListSpaceMembersResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSpaceMembersResponse(%+v)", *p)
+
+}
+
+func (p *ListSpaceMembersResponse) DeepEqual(ano *ListSpaceMembersResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Members) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Total) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ListSpaceMembersResponse) Field1DeepEqual(src []*space.SpaceMember) bool {
+
+	if len(p.Members) != len(src) {
+		return false
+	}
+	for i, v := range p.Members {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListSpaceMembersResponse) Field2DeepEqual(src *int32) bool {
+
+	if p.Total == src {
+		return true
+	} else if p.Total == nil || src == nil {
+		return false
+	}
+	if *p.Total != *src {
+		return false
+	}
+	return true
+}
+func (p *ListSpaceMembersResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 邀请空间成员
+type AddSpaceMemberRequest struct {
+	SpaceID int64 `thrift:"space_id,1,required" frugal:"1,required,i64" json:"space_id" path:"space_id,required" `
+	// 被邀请的用户ID
+	UserID string `thrift:"user_id,2,required" frugal:"2,required,string" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	// 成员角色，不可为 Owner
+	RoleType space.SpaceRoleType `thrift:"role_type,3,required" frugal:"3,required,SpaceRoleType" form:"role_type,required" json:"role_type,required" query:"role_type,required"`
+	Base     *base.Base          `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewAddSpaceMemberRequest() *AddSpaceMemberRequest {
+	return &AddSpaceMemberRequest{}
+}
+
+func (p *AddSpaceMemberRequest) InitDefault() {
+}
+
+func (p *AddSpaceMemberRequest) GetSpaceID() (v int64) {
+	if p != nil {
+		return p.SpaceID
+	}
+	return
+}
+
+func (p *AddSpaceMemberRequest) GetUserID() (v string) {
+	if p != nil {
+		return p.UserID
+	}
+	return
+}
+
+func (p *AddSpaceMemberRequest) GetRoleType() (v space.SpaceRoleType) {
+	if p != nil {
+		return p.RoleType
+	}
+	return
+}
+
+var AddSpaceMemberRequest_Base_DEFAULT *base.Base
+
+func (p *AddSpaceMemberRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return AddSpaceMemberRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *AddSpaceMemberRequest) SetSpaceID(val int64) {
+	p.SpaceID = val
+}
+func (p *AddSpaceMemberRequest) SetUserID(val string) {
+	p.UserID = val
+}
+func (p *AddSpaceMemberRequest) SetRoleType(val space.SpaceRoleType) {
+	p.RoleType = val
+}
+func (p *AddSpaceMemberRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_AddSpaceMemberRequest = map[int16]string{
+	1:   "space_id",
+	2:   "user_id",
+	3:   "role_type",
+	255: "Base",
+}
+
+func (p *AddSpaceMemberRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *AddSpaceMemberRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSpaceID bool = false
+	var issetUserID bool = false
+	var issetRoleType bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSpaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRoleType {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddSpaceMemberRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AddSpaceMemberRequest[fieldId]))
+}
+
+func (p *AddSpaceMemberRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpaceID = _field
+	return nil
+}
+func (p *AddSpaceMemberRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *AddSpaceMemberRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field space.SpaceRoleType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = space.SpaceRoleType(v)
+	}
+	p.RoleType = _field
+	return nil
+}
+func (p *AddSpaceMemberRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *AddSpaceMemberRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddSpaceMemberRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddSpaceMemberRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("space_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SpaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AddSpaceMemberRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AddSpaceMemberRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_type", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.RoleType)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *AddSpaceMemberRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *AddSpaceMemberRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddSpaceMemberRequest(%+v)", *p)
+
+}
+
+func (p *AddSpaceMemberRequest) DeepEqual(ano *AddSpaceMemberRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SpaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RoleType) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *AddSpaceMemberRequest) Field1DeepEqual(src int64) bool {
+
+	if p.SpaceID != src {
+		return false
+	}
+	return true
+}
+func (p *AddSpaceMemberRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.UserID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AddSpaceMemberRequest) Field3DeepEqual(src space.SpaceRoleType) bool {
+
+	if p.RoleType != src {
+		return false
+	}
+	return true
+}
+func (p *AddSpaceMemberRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type AddSpaceMemberResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewAddSpaceMemberResponse() *AddSpaceMemberResponse {
+	return &AddSpaceMemberResponse{}
+}
+
+func (p *AddSpaceMemberResponse) InitDefault() {
+}
+
+var AddSpaceMemberResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *AddSpaceMemberResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return AddSpaceMemberResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *AddSpaceMemberResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_AddSpaceMemberResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *AddSpaceMemberResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AddSpaceMemberResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddSpaceMemberResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AddSpaceMemberResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AddSpaceMemberResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddSpaceMemberResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddSpaceMemberResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *AddSpaceMemberResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddSpaceMemberResponse(%+v)", *p)
+
+}
+
+func (p *AddSpaceMemberResponse) DeepEqual(ano *AddSpaceMemberResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *AddSpaceMemberResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 移除空间成员
+type RemoveSpaceMemberRequest struct {
+	SpaceID int64      `thrift:"space_id,1,required" frugal:"1,required,i64" json:"space_id" path:"space_id,required" `
+	UserID  string     `thrift:"user_id,2,required" frugal:"2,required,string" json:"user_id,required" path:"user_id,required"`
+	Base    *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewRemoveSpaceMemberRequest() *RemoveSpaceMemberRequest {
+	return &RemoveSpaceMemberRequest{}
+}
+
+func (p *RemoveSpaceMemberRequest) InitDefault() {
+}
+
+func (p *RemoveSpaceMemberRequest) GetSpaceID() (v int64) {
+	if p != nil {
+		return p.SpaceID
+	}
+	return
+}
+
+func (p *RemoveSpaceMemberRequest) GetUserID() (v string) {
+	if p != nil {
+		return p.UserID
+	}
+	return
+}
+
+var RemoveSpaceMemberRequest_Base_DEFAULT *base.Base
+
+func (p *RemoveSpaceMemberRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return RemoveSpaceMemberRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RemoveSpaceMemberRequest) SetSpaceID(val int64) {
+	p.SpaceID = val
+}
+func (p *RemoveSpaceMemberRequest) SetUserID(val string) {
+	p.UserID = val
+}
+func (p *RemoveSpaceMemberRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_RemoveSpaceMemberRequest = map[int16]string{
+	1:   "space_id",
+	2:   "user_id",
+	255: "Base",
+}
+
+func (p *RemoveSpaceMemberRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RemoveSpaceMemberRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSpaceID bool = false
+	var issetUserID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSpaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RemoveSpaceMemberRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RemoveSpaceMemberRequest[fieldId]))
+}
+
+func (p *RemoveSpaceMemberRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpaceID = _field
+	return nil
+}
+func (p *RemoveSpaceMemberRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *RemoveSpaceMemberRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *RemoveSpaceMemberRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveSpaceMemberRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RemoveSpaceMemberRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("space_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SpaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RemoveSpaceMemberRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RemoveSpaceMemberRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RemoveSpaceMemberRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveSpaceMemberRequest(%+v)", *p)
+
+}
+
+func (p *RemoveSpaceMemberRequest) DeepEqual(ano *RemoveSpaceMemberRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SpaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *RemoveSpaceMemberRequest) Field1DeepEqual(src int64) bool {
+
+	if p.SpaceID != src {
+		return false
+	}
+	return true
+}
+func (p *RemoveSpaceMemberRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.UserID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RemoveSpaceMemberRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RemoveSpaceMemberResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewRemoveSpaceMemberResponse() *RemoveSpaceMemberResponse {
+	return &RemoveSpaceMemberResponse{}
+}
+
+func (p *RemoveSpaceMemberResponse) InitDefault() {
+}
+
+var RemoveSpaceMemberResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *RemoveSpaceMemberResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return RemoveSpaceMemberResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *RemoveSpaceMemberResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_RemoveSpaceMemberResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *RemoveSpaceMemberResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RemoveSpaceMemberResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RemoveSpaceMemberResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RemoveSpaceMemberResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *RemoveSpaceMemberResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveSpaceMemberResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RemoveSpaceMemberResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *RemoveSpaceMemberResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveSpaceMemberResponse(%+v)", *p)
+
+}
+
+func (p *RemoveSpaceMemberResponse) DeepEqual(ano *RemoveSpaceMemberResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *RemoveSpaceMemberResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 修改空间成员角色
+type UpdateSpaceMemberRoleRequest struct {
+	SpaceID int64  `thrift:"space_id,1,required" frugal:"1,required,i64" json:"space_id" path:"space_id,required" `
+	UserID  string `thrift:"user_id,2,required" frugal:"2,required,string" json:"user_id,required" path:"user_id,required"`
+	// 新角色，不可为 Owner
+	RoleType space.SpaceRoleType `thrift:"role_type,3,required" frugal:"3,required,SpaceRoleType" form:"role_type,required" json:"role_type,required" query:"role_type,required"`
+	Base     *base.Base          `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewUpdateSpaceMemberRoleRequest() *UpdateSpaceMemberRoleRequest {
+	return &UpdateSpaceMemberRoleRequest{}
+}
+
+func (p *UpdateSpaceMemberRoleRequest) InitDefault() {
+}
+
+func (p *UpdateSpaceMemberRoleRequest) GetSpaceID() (v int64) {
+	if p != nil {
+		return p.SpaceID
+	}
+	return
+}
+
+func (p *UpdateSpaceMemberRoleRequest) GetUserID() (v string) {
+	if p != nil {
+		return p.UserID
+	}
+	return
+}
+
+func (p *UpdateSpaceMemberRoleRequest) GetRoleType() (v space.SpaceRoleType) {
+	if p != nil {
+		return p.RoleType
+	}
+	return
+}
+
+var UpdateSpaceMemberRoleRequest_Base_DEFAULT *base.Base
+
+func (p *UpdateSpaceMemberRoleRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return UpdateSpaceMemberRoleRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateSpaceMemberRoleRequest) SetSpaceID(val int64) {
+	p.SpaceID = val
+}
+func (p *UpdateSpaceMemberRoleRequest) SetUserID(val string) {
+	p.UserID = val
+}
+func (p *UpdateSpaceMemberRoleRequest) SetRoleType(val space.SpaceRoleType) {
+	p.RoleType = val
+}
+func (p *UpdateSpaceMemberRoleRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_UpdateSpaceMemberRoleRequest = map[int16]string{
+	1:   "space_id",
+	2:   "user_id",
+	3:   "role_type",
+	255: "Base",
+}
+
+func (p *UpdateSpaceMemberRoleRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateSpaceMemberRoleRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSpaceID bool = false
+	var issetUserID bool = false
+	var issetRoleType bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSpaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRoleType {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSpaceMemberRoleRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateSpaceMemberRoleRequest[fieldId]))
+}
+
+func (p *UpdateSpaceMemberRoleRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpaceID = _field
+	return nil
+}
+func (p *UpdateSpaceMemberRoleRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *UpdateSpaceMemberRoleRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field space.SpaceRoleType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = space.SpaceRoleType(v)
+	}
+	p.RoleType = _field
+	return nil
+}
+func (p *UpdateSpaceMemberRoleRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *UpdateSpaceMemberRoleRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateSpaceMemberRoleRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateSpaceMemberRoleRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("space_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SpaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateSpaceMemberRoleRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UpdateSpaceMemberRoleRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_type", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.RoleType)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UpdateSpaceMemberRoleRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateSpaceMemberRoleRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateSpaceMemberRoleRequest(%+v)", *p)
+
+}
+
+func (p *UpdateSpaceMemberRoleRequest) DeepEqual(ano *UpdateSpaceMemberRoleRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SpaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RoleType) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateSpaceMemberRoleRequest) Field1DeepEqual(src int64) bool {
+
+	if p.SpaceID != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateSpaceMemberRoleRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.UserID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateSpaceMemberRoleRequest) Field3DeepEqual(src space.SpaceRoleType) bool {
+
+	if p.RoleType != src {
+		return false
+	}
+	return true
+}
+func (p *UpdateSpaceMemberRoleRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type UpdateSpaceMemberRoleResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewUpdateSpaceMemberRoleResponse() *UpdateSpaceMemberRoleResponse {
+	return &UpdateSpaceMemberRoleResponse{}
+}
+
+func (p *UpdateSpaceMemberRoleResponse) InitDefault() {
+}
+
+var UpdateSpaceMemberRoleResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *UpdateSpaceMemberRoleResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return UpdateSpaceMemberRoleResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateSpaceMemberRoleResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_UpdateSpaceMemberRoleResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *UpdateSpaceMemberRoleResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateSpaceMemberRoleResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSpaceMemberRoleResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateSpaceMemberRoleResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *UpdateSpaceMemberRoleResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateSpaceMemberRoleResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateSpaceMemberRoleResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateSpaceMemberRoleResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateSpaceMemberRoleResponse(%+v)", *p)
+
+}
+
+func (p *UpdateSpaceMemberRoleResponse) DeepEqual(ano *UpdateSpaceMemberRoleResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateSpaceMemberRoleResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
 type SpaceService interface {
 	// 查询空间信息
 	GetSpace(ctx context.Context, request *GetSpaceRequest) (r *GetSpaceResponse, err error)
 	// 空间列表
 	ListUserSpaces(ctx context.Context, request *ListUserSpaceRequest) (r *ListUserSpaceResponse, err error)
+	// 空间成员列表
+	ListSpaceMembers(ctx context.Context, request *ListSpaceMembersRequest) (r *ListSpaceMembersResponse, err error)
+	// 邀请空间成员
+	AddSpaceMember(ctx context.Context, request *AddSpaceMemberRequest) (r *AddSpaceMemberResponse, err error)
+	// 移除空间成员
+	RemoveSpaceMember(ctx context.Context, request *RemoveSpaceMemberRequest) (r *RemoveSpaceMemberResponse, err error)
+	// 修改空间成员角色
+	UpdateSpaceMemberRole(ctx context.Context, request *UpdateSpaceMemberRoleRequest) (r *UpdateSpaceMemberRoleResponse, err error)
+}
+
+type SpaceServiceClient struct {
+	c thrift.TClient
+}
+
+func NewSpaceServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *SpaceServiceClient {
+	return &SpaceServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewSpaceServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *SpaceServiceClient {
+	return &SpaceServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewSpaceServiceClient(c thrift.TClient) *SpaceServiceClient {
+	return &SpaceServiceClient{
+		c: c,
+	}
+}
+
+func (p *SpaceServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *SpaceServiceClient) GetSpace(ctx context.Context, request *GetSpaceRequest) (r *GetSpaceResponse, err error) {
+	var _args SpaceServiceGetSpaceArgs
+	_args.Request = request
+	var _result SpaceServiceGetSpaceResult
+	if err = p.Client_().Call(ctx, "GetSpace", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SpaceServiceClient) ListUserSpaces(ctx context.Context, request *ListUserSpaceRequest) (r *ListUserSpaceResponse, err error) {
+	var _args SpaceServiceListUserSpacesArgs
+	_args.Request = request
+	var _result SpaceServiceListUserSpacesResult
+	if err = p.Client_().Call(ctx, "ListUserSpaces", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SpaceServiceClient) ListSpaceMembers(ctx context.Context, request *ListSpaceMembersRequest) (r *ListSpaceMembersResponse, err error) {
+	var _args SpaceServiceListSpaceMembersArgs
+	_args.Request = request
+	var _result SpaceServiceListSpaceMembersResult
+	if err = p.Client_().Call(ctx, "ListSpaceMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SpaceServiceClient) AddSpaceMember(ctx context.Context, request *AddSpaceMemberRequest) (r *AddSpaceMemberResponse, err error) {
+	var _args SpaceServiceAddSpaceMemberArgs
+	_args.Request = request
+	var _result SpaceServiceAddSpaceMemberResult
+	if err = p.Client_().Call(ctx, "AddSpaceMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SpaceServiceClient) RemoveSpaceMember(ctx context.Context, request *RemoveSpaceMemberRequest) (r *RemoveSpaceMemberResponse, err error) {
+	var _args SpaceServiceRemoveSpaceMemberArgs
+	_args.Request = request
+	var _result SpaceServiceRemoveSpaceMemberResult
+	if err = p.Client_().Call(ctx, "RemoveSpaceMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SpaceServiceClient) UpdateSpaceMemberRole(ctx context.Context, request *UpdateSpaceMemberRoleRequest) (r *UpdateSpaceMemberRoleResponse, err error) {
+	var _args SpaceServiceUpdateSpaceMemberRoleArgs
+	_args.Request = request
+	var _result SpaceServiceUpdateSpaceMemberRoleResult
+	if err = p.Client_().Call(ctx, "UpdateSpaceMemberRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type SpaceServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      SpaceService
+}
+
+func (p *SpaceServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *SpaceServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *SpaceServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewSpaceServiceProcessor(handler SpaceService) *SpaceServiceProcessor {
+	self := &SpaceServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetSpace", &spaceServiceProcessorGetSpace{handler: handler})
+	self.AddToProcessorMap("ListUserSpaces", &spaceServiceProcessorListUserSpaces{handler: handler})
+	self.AddToProcessorMap("ListSpaceMembers", &spaceServiceProcessorListSpaceMembers{handler: handler})
+	self.AddToProcessorMap("AddSpaceMember", &spaceServiceProcessorAddSpaceMember{handler: handler})
+	self.AddToProcessorMap("RemoveSpaceMember", &spaceServiceProcessorRemoveSpaceMember{handler: handler})
+	self.AddToProcessorMap("UpdateSpaceMemberRole", &spaceServiceProcessorUpdateSpaceMemberRole{handler: handler})
+	return self
+}
+func (p *SpaceServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type spaceServiceProcessorGetSpace struct {
+	handler SpaceService
+}
+
+func (p *spaceServiceProcessorGetSpace) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SpaceServiceGetSpaceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetSpace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SpaceServiceGetSpaceResult{}
+	var retval *GetSpaceResponse
+	if retval, err2 = p.handler.GetSpace(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSpace: "+err2.Error())
+		oprot.WriteMessageBegin("GetSpace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetSpace", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type spaceServiceProcessorListUserSpaces struct {
+	handler SpaceService
+}
+
+func (p *spaceServiceProcessorListUserSpaces) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SpaceServiceListUserSpacesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListUserSpaces", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SpaceServiceListUserSpacesResult{}
+	var retval *ListUserSpaceResponse
+	if retval, err2 = p.handler.ListUserSpaces(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListUserSpaces: "+err2.Error())
+		oprot.WriteMessageBegin("ListUserSpaces", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListUserSpaces", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type spaceServiceProcessorListSpaceMembers struct {
+	handler SpaceService
+}
+
+func (p *spaceServiceProcessorListSpaceMembers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SpaceServiceListSpaceMembersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListSpaceMembers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SpaceServiceListSpaceMembersResult{}
+	var retval *ListSpaceMembersResponse
+	if retval, err2 = p.handler.ListSpaceMembers(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSpaceMembers: "+err2.Error())
+		oprot.WriteMessageBegin("ListSpaceMembers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListSpaceMembers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type spaceServiceProcessorAddSpaceMember struct {
+	handler SpaceService
+}
+
+func (p *spaceServiceProcessorAddSpaceMember) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SpaceServiceAddSpaceMemberArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AddSpaceMember", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SpaceServiceAddSpaceMemberResult{}
+	var retval *AddSpaceMemberResponse
+	if retval, err2 = p.handler.AddSpaceMember(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddSpaceMember: "+err2.Error())
+		oprot.WriteMessageBegin("AddSpaceMember", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AddSpaceMember", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type spaceServiceProcessorRemoveSpaceMember struct {
+	handler SpaceService
+}
+
+func (p *spaceServiceProcessorRemoveSpaceMember) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SpaceServiceRemoveSpaceMemberArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RemoveSpaceMember", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SpaceServiceRemoveSpaceMemberResult{}
+	var retval *RemoveSpaceMemberResponse
+	if retval, err2 = p.handler.RemoveSpaceMember(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RemoveSpaceMember: "+err2.Error())
+		oprot.WriteMessageBegin("RemoveSpaceMember", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RemoveSpaceMember", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type spaceServiceProcessorUpdateSpaceMemberRole struct {
+	handler SpaceService
+}
+
+func (p *spaceServiceProcessorUpdateSpaceMemberRole) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SpaceServiceUpdateSpaceMemberRoleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateSpaceMemberRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SpaceServiceUpdateSpaceMemberRoleResult{}
+	var retval *UpdateSpaceMemberRoleResponse
+	if retval, err2 = p.handler.UpdateSpaceMemberRole(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateSpaceMemberRole: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateSpaceMemberRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateSpaceMemberRole", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type SpaceServiceGetSpaceArgs struct {
+	Request *GetSpaceRequest `thrift:"request,1" frugal:"1,default,GetSpaceRequest"`
+}
+
+func NewSpaceServiceGetSpaceArgs() *SpaceServiceGetSpaceArgs {
+	return &SpaceServiceGetSpaceArgs{}
+}
+
+func (p *SpaceServiceGetSpaceArgs) InitDefault() {
+}
+
+var SpaceServiceGetSpaceArgs_Request_DEFAULT *GetSpaceRequest
+
+func (p *SpaceServiceGetSpaceArgs) GetRequest() (v *GetSpaceRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return SpaceServiceGetSpaceArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *SpaceServiceGetSpaceArgs) SetRequest(val *GetSpaceRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_SpaceServiceGetSpaceArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SpaceServiceGetSpaceArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SpaceServiceGetSpaceArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceGetSpaceArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SpaceServiceGetSpaceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetSpaceRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SpaceServiceGetSpaceArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetSpace_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SpaceServiceGetSpaceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SpaceServiceGetSpaceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpaceServiceGetSpaceArgs(%+v)", *p)
+
+}
+
+func (p *SpaceServiceGetSpaceArgs) DeepEqual(ano *SpaceServiceGetSpaceArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *SpaceServiceGetSpaceArgs) Field1DeepEqual(src *GetSpaceRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type SpaceServiceGetSpaceResult struct {
+	Success *GetSpaceResponse `thrift:"success,0,optional" frugal:"0,optional,GetSpaceResponse"`
+}
+
+func NewSpaceServiceGetSpaceResult() *SpaceServiceGetSpaceResult {
+	return &SpaceServiceGetSpaceResult{}
+}
+
+func (p *SpaceServiceGetSpaceResult) InitDefault() {
+}
+
+var SpaceServiceGetSpaceResult_Success_DEFAULT *GetSpaceResponse
+
+func (p *SpaceServiceGetSpaceResult) GetSuccess() (v *GetSpaceResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return SpaceServiceGetSpaceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SpaceServiceGetSpaceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetSpaceResponse)
+}
+
+var fieldIDToName_SpaceServiceGetSpaceResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SpaceServiceGetSpaceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SpaceServiceGetSpaceResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceGetSpaceResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SpaceServiceGetSpaceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetSpaceResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SpaceServiceGetSpaceResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetSpace_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SpaceServiceGetSpaceResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SpaceServiceGetSpaceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpaceServiceGetSpaceResult(%+v)", *p)
+
+}
+
+func (p *SpaceServiceGetSpaceResult) DeepEqual(ano *SpaceServiceGetSpaceResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *SpaceServiceGetSpaceResult) Field0DeepEqual(src *GetSpaceResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type SpaceServiceListUserSpacesArgs struct {
+	Request *ListUserSpaceRequest `thrift:"request,1" frugal:"1,default,ListUserSpaceRequest"`
+}
+
+func NewSpaceServiceListUserSpacesArgs() *SpaceServiceListUserSpacesArgs {
+	return &SpaceServiceListUserSpacesArgs{}
+}
+
+func (p *SpaceServiceListUserSpacesArgs) InitDefault() {
+}
+
+var SpaceServiceListUserSpacesArgs_Request_DEFAULT *ListUserSpaceRequest
+
+func (p *SpaceServiceListUserSpacesArgs) GetRequest() (v *ListUserSpaceRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return SpaceServiceListUserSpacesArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *SpaceServiceListUserSpacesArgs) SetRequest(val *ListUserSpaceRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_SpaceServiceListUserSpacesArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SpaceServiceListUserSpacesArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SpaceServiceListUserSpacesArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceListUserSpacesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SpaceServiceListUserSpacesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListUserSpaceRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SpaceServiceListUserSpacesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListUserSpaces_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SpaceServiceListUserSpacesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SpaceServiceListUserSpacesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpaceServiceListUserSpacesArgs(%+v)", *p)
+
+}
+
+func (p *SpaceServiceListUserSpacesArgs) DeepEqual(ano *SpaceServiceListUserSpacesArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *SpaceServiceListUserSpacesArgs) Field1DeepEqual(src *ListUserSpaceRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type SpaceServiceListUserSpacesResult struct {
+	Success *ListUserSpaceResponse `thrift:"success,0,optional" frugal:"0,optional,ListUserSpaceResponse"`
+}
+
+func NewSpaceServiceListUserSpacesResult() *SpaceServiceListUserSpacesResult {
+	return &SpaceServiceListUserSpacesResult{}
+}
+
+func (p *SpaceServiceListUserSpacesResult) InitDefault() {
+}
+
+var SpaceServiceListUserSpacesResult_Success_DEFAULT *ListUserSpaceResponse
+
+func (p *SpaceServiceListUserSpacesResult) GetSuccess() (v *ListUserSpaceResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return SpaceServiceListUserSpacesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SpaceServiceListUserSpacesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListUserSpaceResponse)
+}
+
+var fieldIDToName_SpaceServiceListUserSpacesResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SpaceServiceListUserSpacesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SpaceServiceListUserSpacesResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceListUserSpacesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SpaceServiceListUserSpacesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListUserSpaceResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SpaceServiceListUserSpacesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListUserSpaces_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SpaceServiceListUserSpacesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SpaceServiceListUserSpacesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpaceServiceListUserSpacesResult(%+v)", *p)
+
+}
+
+func (p *SpaceServiceListUserSpacesResult) DeepEqual(ano *SpaceServiceListUserSpacesResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *SpaceServiceListUserSpacesResult) Field0DeepEqual(src *ListUserSpaceResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type SpaceServiceListSpaceMembersArgs struct {
+	Request *ListSpaceMembersRequest `thrift:"request,1" frugal:"1,default,ListSpaceMembersRequest"`
+}
+
+func NewSpaceServiceListSpaceMembersArgs() *SpaceServiceListSpaceMembersArgs {
+	return &SpaceServiceListSpaceMembersArgs{}
+}
+
+func (p *SpaceServiceListSpaceMembersArgs) InitDefault() {
+}
+
+var SpaceServiceListSpaceMembersArgs_Request_DEFAULT *ListSpaceMembersRequest
+
+func (p *SpaceServiceListSpaceMembersArgs) GetRequest() (v *ListSpaceMembersRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRequest() {
+		return SpaceServiceListSpaceMembersArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+func (p *SpaceServiceListSpaceMembersArgs) SetRequest(val *ListSpaceMembersRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_SpaceServiceListSpaceMembersArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SpaceServiceListSpaceMembersArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SpaceServiceListSpaceMembersArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceListSpaceMembersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SpaceServiceListSpaceMembersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListSpaceMembersRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SpaceServiceListSpaceMembersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSpaceMembers_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SpaceServiceListSpaceMembersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SpaceServiceListSpaceMembersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpaceServiceListSpaceMembersArgs(%+v)", *p)
+
+}
+
+func (p *SpaceServiceListSpaceMembersArgs) DeepEqual(ano *SpaceServiceListSpaceMembersArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
+}
+
+func (p *SpaceServiceListSpaceMembersArgs) Field1DeepEqual(src *ListSpaceMembersRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type SpaceServiceListSpaceMembersResult struct {
+	Success *ListSpaceMembersResponse `thrift:"success,0,optional" frugal:"0,optional,ListSpaceMembersResponse"`
+}
+
+func NewSpaceServiceListSpaceMembersResult() *SpaceServiceListSpaceMembersResult {
+	return &SpaceServiceListSpaceMembersResult{}
+}
+
+func (p *SpaceServiceListSpaceMembersResult) InitDefault() {
+}
+
+var SpaceServiceListSpaceMembersResult_Success_DEFAULT *ListSpaceMembersResponse
+
+func (p *SpaceServiceListSpaceMembersResult) GetSuccess() (v *ListSpaceMembersResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return SpaceServiceListSpaceMembersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SpaceServiceListSpaceMembersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListSpaceMembersResponse)
+}
+
+var fieldIDToName_SpaceServiceListSpaceMembersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SpaceServiceListSpaceMembersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SpaceServiceListSpaceMembersResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceListSpaceMembersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SpaceServiceListSpaceMembersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListSpaceMembersResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SpaceServiceListSpaceMembersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSpaceMembers_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SpaceServiceListSpaceMembersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-type SpaceServiceClient struct {
-	c thrift.TClient
+func (p *SpaceServiceListSpaceMembersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpaceServiceListSpaceMembersResult(%+v)", *p)
+
 }
 
-func NewSpaceServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *SpaceServiceClient {
-	return &SpaceServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *SpaceServiceListSpaceMembersResult) DeepEqual(ano *SpaceServiceListSpaceMembersResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
+	return true
 }
 
-func NewSpaceServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *SpaceServiceClient {
-	return &SpaceServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+func (p *SpaceServiceListSpaceMembersResult) Field0DeepEqual(src *ListSpaceMembersResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
+	return true
 }
 
-func NewSpaceServiceClient(c thrift.TClient) *SpaceServiceClient {
-	return &SpaceServiceClient{
-		c: c,
-	}
+type SpaceServiceAddSpaceMemberArgs struct {
+	Request *AddSpaceMemberRequest `thrift:"request,1" frugal:"1,default,AddSpaceMemberRequest"`
 }
 
-func (p *SpaceServiceClient) Client_() thrift.TClient {
-	return p.c
+func NewSpaceServiceAddSpaceMemberArgs() *SpaceServiceAddSpaceMemberArgs {
+	return &SpaceServiceAddSpaceMemberArgs{}
 }
 
-func (p *SpaceServiceClient) GetSpace(ctx context.Context, request *GetSpaceRequest) (r *GetSpaceResponse, err error) {
-	var _args SpaceServiceGetSpaceArgs
-	_args.Request = request
-	var _result SpaceServiceGetSpaceResult
-	if err = p.Client_().Call(ctx, "GetSpace", &_args, &_result); err != nil {
+func (p *SpaceServiceAddSpaceMemberArgs) InitDefault() {
+}
+
+var SpaceServiceAddSpaceMemberArgs_Request_DEFAULT *AddSpaceMemberRequest
+
+func (p *SpaceServiceAddSpaceMemberArgs) GetRequest() (v *AddSpaceMemberRequest) {
+	if p == nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	if !p.IsSetRequest() {
+		return SpaceServiceAddSpaceMemberArgs_Request_DEFAULT
+	}
+	return p.Request
 }
-func (p *SpaceServiceClient) ListUserSpaces(ctx context.Context, request *ListUserSpaceRequest) (r *ListUserSpaceResponse, err error) {
-	var _args SpaceServiceListUserSpacesArgs
-	_args.Request = request
-	var _result SpaceServiceListUserSpacesResult
-	if err = p.Client_().Call(ctx, "ListUserSpaces", &_args, &_result); err != nil {
-		return
+func (p *SpaceServiceAddSpaceMemberArgs) SetRequest(val *AddSpaceMemberRequest) {
+	p.Request = val
+}
+
+var fieldIDToName_SpaceServiceAddSpaceMemberArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SpaceServiceAddSpaceMemberArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SpaceServiceAddSpaceMemberArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceAddSpaceMemberArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type SpaceServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      SpaceService
+func (p *SpaceServiceAddSpaceMemberArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAddSpaceMemberRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
 }
 
-func (p *SpaceServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *SpaceServiceAddSpaceMemberArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddSpaceMember_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SpaceServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *SpaceServiceAddSpaceMemberArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SpaceServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *SpaceServiceAddSpaceMemberArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpaceServiceAddSpaceMemberArgs(%+v)", *p)
+
 }
 
-func NewSpaceServiceProcessor(handler SpaceService) *SpaceServiceProcessor {
-	self := &SpaceServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetSpace", &spaceServiceProcessorGetSpace{handler: handler})
-	self.AddToProcessorMap("ListUserSpaces", &spaceServiceProcessorListUserSpaces{handler: handler})
-	return self
+func (p *SpaceServiceAddSpaceMemberArgs) DeepEqual(ano *SpaceServiceAddSpaceMemberArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Request) {
+		return false
+	}
+	return true
 }
-func (p *SpaceServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+
+func (p *SpaceServiceAddSpaceMemberArgs) Field1DeepEqual(src *AddSpaceMemberRequest) bool {
+
+	if !p.Request.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type SpaceServiceAddSpaceMemberResult struct {
+	Success *AddSpaceMemberResponse `thrift:"success,0,optional" frugal:"0,optional,AddSpaceMemberResponse"`
+}
+
+func NewSpaceServiceAddSpaceMemberResult() *SpaceServiceAddSpaceMemberResult {
+	return &SpaceServiceAddSpaceMemberResult{}
+}
+
+func (p *SpaceServiceAddSpaceMemberResult) InitDefault() {
+}
+
+var SpaceServiceAddSpaceMemberResult_Success_DEFAULT *AddSpaceMemberResponse
+
+func (p *SpaceServiceAddSpaceMemberResult) GetSuccess() (v *AddSpaceMemberResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return SpaceServiceAddSpaceMemberResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SpaceServiceAddSpaceMemberResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddSpaceMemberResponse)
+}
+
+var fieldIDToName_SpaceServiceAddSpaceMemberResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SpaceServiceAddSpaceMemberResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SpaceServiceAddSpaceMemberResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
 
-type spaceServiceProcessorGetSpace struct {
-	handler SpaceService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceAddSpaceMemberResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *spaceServiceProcessorGetSpace) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SpaceServiceGetSpaceArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetSpace", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *SpaceServiceAddSpaceMemberResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAddSpaceMemberResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := SpaceServiceGetSpaceResult{}
-	var retval *GetSpaceResponse
-	if retval, err2 = p.handler.GetSpace(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSpace: "+err2.Error())
-		oprot.WriteMessageBegin("GetSpace", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetSpace", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *SpaceServiceAddSpaceMemberResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddSpaceMember_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type spaceServiceProcessorListUserSpaces struct {
-	handler SpaceService
+func (p *SpaceServiceAddSpaceMemberResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *spaceServiceProcessorListUserSpaces) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SpaceServiceListUserSpacesArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListUserSpaces", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *SpaceServiceAddSpaceMemberResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("SpaceServiceAddSpaceMemberResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := SpaceServiceListUserSpacesResult{}
-	var retval *ListUserSpaceResponse
-	if retval, err2 = p.handler.ListUserSpaces(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListUserSpaces: "+err2.Error())
-		oprot.WriteMessageBegin("ListUserSpaces", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListUserSpaces", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *SpaceServiceAddSpaceMemberResult) DeepEqual(ano *SpaceServiceAddSpaceMemberResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *SpaceServiceAddSpaceMemberResult) Field0DeepEqual(src *AddSpaceMemberResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type SpaceServiceGetSpaceArgs struct {
-	Request *GetSpaceRequest `thrift:"request,1" frugal:"1,default,GetSpaceRequest"`
+type SpaceServiceRemoveSpaceMemberArgs struct {
+	Request *RemoveSpaceMemberRequest `thrift:"request,1" frugal:"1,default,RemoveSpaceMemberRequest"`
 }
 
-func NewSpaceServiceGetSpaceArgs() *SpaceServiceGetSpaceArgs {
-	return &SpaceServiceGetSpaceArgs{}
+func NewSpaceServiceRemoveSpaceMemberArgs() *SpaceServiceRemoveSpaceMemberArgs {
+	return &SpaceServiceRemoveSpaceMemberArgs{}
 }
 
-func (p *SpaceServiceGetSpaceArgs) InitDefault() {
+func (p *SpaceServiceRemoveSpaceMemberArgs) InitDefault() {
 }
 
-var SpaceServiceGetSpaceArgs_Request_DEFAULT *GetSpaceRequest
+var SpaceServiceRemoveSpaceMemberArgs_Request_DEFAULT *RemoveSpaceMemberRequest
 
-func (p *SpaceServiceGetSpaceArgs) GetRequest() (v *GetSpaceRequest) {
+func (p *SpaceServiceRemoveSpaceMemberArgs) GetRequest() (v *RemoveSpaceMemberRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return SpaceServiceGetSpaceArgs_Request_DEFAULT
+		return SpaceServiceRemoveSpaceMemberArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *SpaceServiceGetSpaceArgs) SetRequest(val *GetSpaceRequest) {
+func (p *SpaceServiceRemoveSpaceMemberArgs) SetRequest(val *RemoveSpaceMemberRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_SpaceServiceGetSpaceArgs = map[int16]string{
+var fieldIDToName_SpaceServiceRemoveSpaceMemberArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SpaceServiceGetSpaceArgs) IsSetRequest() bool {
+func (p *SpaceServiceRemoveSpaceMemberArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SpaceServiceGetSpaceArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceRemoveSpaceMemberArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1518,7 +5466,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceGetSpaceArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceRemoveSpaceMemberArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1528,8 +5476,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SpaceServiceGetSpaceArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetSpaceRequest()
+func (p *SpaceServiceRemoveSpaceMemberArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRemoveSpaceMemberRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1537,9 +5485,9 @@ func (p *SpaceServiceGetSpaceArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SpaceServiceGetSpaceArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceRemoveSpaceMemberArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSpace_args"); err != nil {
+	if err = oprot.WriteStructBegin("RemoveSpaceMember_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1565,7 +5513,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SpaceServiceGetSpaceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceRemoveSpaceMemberArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1582,15 +5530,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SpaceServiceGetSpaceArgs) String() string {
+func (p *SpaceServiceRemoveSpaceMemberArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SpaceServiceGetSpaceArgs(%+v)", *p)
+	return fmt.Sprintf("SpaceServiceRemoveSpaceMemberArgs(%+v)", *p)
 
 }
 
-func (p *SpaceServiceGetSpaceArgs) DeepEqual(ano *SpaceServiceGetSpaceArgs) bool {
+func (p *SpaceServiceRemoveSpaceMemberArgs) DeepEqual(ano *SpaceServiceRemoveSpaceMemberArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1602,7 +5550,7 @@ func (p *SpaceServiceGetSpaceArgs) DeepEqual(ano *SpaceServiceGetSpaceArgs) bool
 	return true
 }
 
-func (p *SpaceServiceGetSpaceArgs) Field1DeepEqual(src *GetSpaceRequest) bool {
+func (p *SpaceServiceRemoveSpaceMemberArgs) Field1DeepEqual(src *RemoveSpaceMemberRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -1610,41 +5558,41 @@ func (p *SpaceServiceGetSpaceArgs) Field1DeepEqual(src *GetSpaceRequest) bool {
 	return true
 }
 
-type SpaceServiceGetSpaceResult struct {
-	Success *GetSpaceResponse `thrift:"success,0,optional" frugal:"0,optional,GetSpaceResponse"`
+type SpaceServiceRemoveSpaceMemberResult struct {
+	Success *RemoveSpaceMemberResponse `thrift:"success,0,optional" frugal:"0,optional,RemoveSpaceMemberResponse"`
 }
 
-func NewSpaceServiceGetSpaceResult() *SpaceServiceGetSpaceResult {
-	return &SpaceServiceGetSpaceResult{}
+func NewSpaceServiceRemoveSpaceMemberResult() *SpaceServiceRemoveSpaceMemberResult {
+	return &SpaceServiceRemoveSpaceMemberResult{}
 }
 
-func (p *SpaceServiceGetSpaceResult) InitDefault() {
+func (p *SpaceServiceRemoveSpaceMemberResult) InitDefault() {
 }
 
-var SpaceServiceGetSpaceResult_Success_DEFAULT *GetSpaceResponse
+var SpaceServiceRemoveSpaceMemberResult_Success_DEFAULT *RemoveSpaceMemberResponse
 
-func (p *SpaceServiceGetSpaceResult) GetSuccess() (v *GetSpaceResponse) {
+func (p *SpaceServiceRemoveSpaceMemberResult) GetSuccess() (v *RemoveSpaceMemberResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return SpaceServiceGetSpaceResult_Success_DEFAULT
+		return SpaceServiceRemoveSpaceMemberResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SpaceServiceGetSpaceResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetSpaceResponse)
+func (p *SpaceServiceRemoveSpaceMemberResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RemoveSpaceMemberResponse)
 }
 
-var fieldIDToName_SpaceServiceGetSpaceResult = map[int16]string{
+var fieldIDToName_SpaceServiceRemoveSpaceMemberResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SpaceServiceGetSpaceResult) IsSetSuccess() bool {
+func (p *SpaceServiceRemoveSpaceMemberResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SpaceServiceGetSpaceResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceRemoveSpaceMemberResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1689,7 +5637,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceGetSpaceResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceRemoveSpaceMemberResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1699,8 +5647,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SpaceServiceGetSpaceResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetSpaceResponse()
+func (p *SpaceServiceRemoveSpaceMemberResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRemoveSpaceMemberResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1708,9 +5656,9 @@ func (p *SpaceServiceGetSpaceResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SpaceServiceGetSpaceResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceRemoveSpaceMemberResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSpace_result"); err != nil {
+	if err = oprot.WriteStructBegin("RemoveSpaceMember_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1736,7 +5684,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SpaceServiceGetSpaceResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceRemoveSpaceMemberResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1755,15 +5703,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SpaceServiceGetSpaceResult) String() string {
+func (p *SpaceServiceRemoveSpaceMemberResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SpaceServiceGetSpaceResult(%+v)", *p)
+	return fmt.Sprintf("SpaceServiceRemoveSpaceMemberResult(%+v)", *p)
 
 }
 
-func (p *SpaceServiceGetSpaceResult) DeepEqual(ano *SpaceServiceGetSpaceResult) bool {
+func (p *SpaceServiceRemoveSpaceMemberResult) DeepEqual(ano *SpaceServiceRemoveSpaceMemberResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1775,7 +5723,7 @@ func (p *SpaceServiceGetSpaceResult) DeepEqual(ano *SpaceServiceGetSpaceResult)
 	return true
 }
 
-func (p *SpaceServiceGetSpaceResult) Field0DeepEqual(src *GetSpaceResponse) bool {
+func (p *SpaceServiceRemoveSpaceMemberResult) Field0DeepEqual(src *RemoveSpaceMemberResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1783,41 +5731,41 @@ func (p *SpaceServiceGetSpaceResult) Field0DeepEqual(src *GetSpaceResponse) bool
 	return true
 }
 
-type SpaceServiceListUserSpacesArgs struct {
-	Request *ListUserSpaceRequest `thrift:"request,1" frugal:"1,default,ListUserSpaceRequest"`
+type SpaceServiceUpdateSpaceMemberRoleArgs struct {
+	Request *UpdateSpaceMemberRoleRequest `thrift:"request,1" frugal:"1,default,UpdateSpaceMemberRoleRequest"`
 }
 
-func NewSpaceServiceListUserSpacesArgs() *SpaceServiceListUserSpacesArgs {
-	return &SpaceServiceListUserSpacesArgs{}
+func NewSpaceServiceUpdateSpaceMemberRoleArgs() *SpaceServiceUpdateSpaceMemberRoleArgs {
+	return &SpaceServiceUpdateSpaceMemberRoleArgs{}
 }
 
-func (p *SpaceServiceListUserSpacesArgs) InitDefault() {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) InitDefault() {
 }
 
-var SpaceServiceListUserSpacesArgs_Request_DEFAULT *ListUserSpaceRequest
+var SpaceServiceUpdateSpaceMemberRoleArgs_Request_DEFAULT *UpdateSpaceMemberRoleRequest
 
-func (p *SpaceServiceListUserSpacesArgs) GetRequest() (v *ListUserSpaceRequest) {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) GetRequest() (v *UpdateSpaceMemberRoleRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetRequest() {
-		return SpaceServiceListUserSpacesArgs_Request_DEFAULT
+		return SpaceServiceUpdateSpaceMemberRoleArgs_Request_DEFAULT
 	}
 	return p.Request
 }
-func (p *SpaceServiceListUserSpacesArgs) SetRequest(val *ListUserSpaceRequest) {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) SetRequest(val *UpdateSpaceMemberRoleRequest) {
 	p.Request = val
 }
 
-var fieldIDToName_SpaceServiceListUserSpacesArgs = map[int16]string{
+var fieldIDToName_SpaceServiceUpdateSpaceMemberRoleArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SpaceServiceListUserSpacesArgs) IsSetRequest() bool {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SpaceServiceListUserSpacesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1862,7 +5810,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceListUserSpacesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceUpdateSpaceMemberRoleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1872,8 +5820,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SpaceServiceListUserSpacesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListUserSpaceRequest()
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateSpaceMemberRoleRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1881,9 +5829,9 @@ func (p *SpaceServiceListUserSpacesArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *SpaceServiceListUserSpacesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListUserSpaces_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSpaceMemberRole_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1909,7 +5857,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SpaceServiceListUserSpacesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1926,15 +5874,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SpaceServiceListUserSpacesArgs) String() string {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SpaceServiceListUserSpacesArgs(%+v)", *p)
+	return fmt.Sprintf("SpaceServiceUpdateSpaceMemberRoleArgs(%+v)", *p)
 
 }
 
-func (p *SpaceServiceListUserSpacesArgs) DeepEqual(ano *SpaceServiceListUserSpacesArgs) bool {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) DeepEqual(ano *SpaceServiceUpdateSpaceMemberRoleArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1946,7 +5894,7 @@ func (p *SpaceServiceListUserSpacesArgs) DeepEqual(ano *SpaceServiceListUserSpac
 	return true
 }
 
-func (p *SpaceServiceListUserSpacesArgs) Field1DeepEqual(src *ListUserSpaceRequest) bool {
+func (p *SpaceServiceUpdateSpaceMemberRoleArgs) Field1DeepEqual(src *UpdateSpaceMemberRoleRequest) bool {
 
 	if !p.Request.DeepEqual(src) {
 		return false
@@ -1954,41 +5902,41 @@ func (p *SpaceServiceListUserSpacesArgs) Field1DeepEqual(src *ListUserSpaceReque
 	return true
 }
 
-type SpaceServiceListUserSpacesResult struct {
-	Success *ListUserSpaceResponse `thrift:"success,0,optional" frugal:"0,optional,ListUserSpaceResponse"`
+type SpaceServiceUpdateSpaceMemberRoleResult struct {
+	Success *UpdateSpaceMemberRoleResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateSpaceMemberRoleResponse"`
 }
 
-func NewSpaceServiceListUserSpacesResult() *SpaceServiceListUserSpacesResult {
-	return &SpaceServiceListUserSpacesResult{}
+func NewSpaceServiceUpdateSpaceMemberRoleResult() *SpaceServiceUpdateSpaceMemberRoleResult {
+	return &SpaceServiceUpdateSpaceMemberRoleResult{}
 }
 
-func (p *SpaceServiceListUserSpacesResult) InitDefault() {
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) InitDefault() {
 }
 
-var SpaceServiceListUserSpacesResult_Success_DEFAULT *ListUserSpaceResponse
+var SpaceServiceUpdateSpaceMemberRoleResult_Success_DEFAULT *UpdateSpaceMemberRoleResponse
 
-func (p *SpaceServiceListUserSpacesResult) GetSuccess() (v *ListUserSpaceResponse) {
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) GetSuccess() (v *UpdateSpaceMemberRoleResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return SpaceServiceListUserSpacesResult_Success_DEFAULT
+		return SpaceServiceUpdateSpaceMemberRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SpaceServiceListUserSpacesResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListUserSpaceResponse)
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateSpaceMemberRoleResponse)
 }
 
-var fieldIDToName_SpaceServiceListUserSpacesResult = map[int16]string{
+var fieldIDToName_SpaceServiceUpdateSpaceMemberRoleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SpaceServiceListUserSpacesResult) IsSetSuccess() bool {
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SpaceServiceListUserSpacesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2033,7 +5981,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceListUserSpacesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpaceServiceUpdateSpaceMemberRoleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2043,8 +5991,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SpaceServiceListUserSpacesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListUserSpaceResponse()
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateSpaceMemberRoleResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2052,9 +6000,9 @@ func (p *SpaceServiceListUserSpacesResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *SpaceServiceListUserSpacesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListUserSpaces_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSpaceMemberRole_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2080,7 +6028,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SpaceServiceListUserSpacesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2099,15 +6047,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SpaceServiceListUserSpacesResult) String() string {
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SpaceServiceListUserSpacesResult(%+v)", *p)
+	return fmt.Sprintf("SpaceServiceUpdateSpaceMemberRoleResult(%+v)", *p)
 
 }
 
-func (p *SpaceServiceListUserSpacesResult) DeepEqual(ano *SpaceServiceListUserSpacesResult) bool {
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) DeepEqual(ano *SpaceServiceUpdateSpaceMemberRoleResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2119,7 +6067,7 @@ func (p *SpaceServiceListUserSpacesResult) DeepEqual(ano *SpaceServiceListUserSp
 	return true
 }
 
-func (p *SpaceServiceListUserSpacesResult) Field0DeepEqual(src *ListUserSpaceResponse) bool {
+func (p *SpaceServiceUpdateSpaceMemberRoleResult) Field0DeepEqual(src *UpdateSpaceMemberRoleResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
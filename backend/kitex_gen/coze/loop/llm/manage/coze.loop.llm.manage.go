@@ -490,7 +490,8 @@ func (p *Filter) Field4DeepEqual(src []manage.AbilityEnum) bool {
 type ListModelsRequest struct {
 	WorkspaceID *int64           `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
 	Scenario    *common.Scenario `thrift:"scenario,2,optional" frugal:"2,optional,string" form:"scenario" json:"scenario,omitempty" query:"scenario"`
-	Filter      *Filter          `thrift:"filter,3,optional" frugal:"3,optional,Filter" form:"filter" json:"filter,omitempty" query:"filter"`
+	// 未指定statuses时不返回不可用的模型
+	Filter *Filter `thrift:"filter,3,optional" frugal:"3,optional,Filter" form:"filter" json:"filter,omitempty" query:"filter"`
 	// 是否为预置模型
 	PresetModel *bool      `thrift:"preset_model,4,optional" frugal:"4,optional,bool" form:"preset_model" json:"preset_model,omitempty" query:"preset_model"`
 	Cookie      *string    `thrift:"cookie,100,optional" frugal:"100,optional,string" header:"cookie" json:"cookie,omitempty"`
@@ -2568,230 +2569,4054 @@ func (p *GetModelResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
+type CreateModelRequest struct {
+	WorkspaceID *int64        `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	Model       *manage.Model `thrift:"model,2,optional" frugal:"2,optional,manage.Model" form:"model" json:"model,omitempty" query:"model"`
+	Base        *base.Base    `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewCreateModelRequest() *CreateModelRequest {
+	return &CreateModelRequest{}
+}
+
+func (p *CreateModelRequest) InitDefault() {
+}
+
+var CreateModelRequest_WorkspaceID_DEFAULT int64
+
+func (p *CreateModelRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return CreateModelRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var CreateModelRequest_Model_DEFAULT *manage.Model
+
+func (p *CreateModelRequest) GetModel() (v *manage.Model) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModel() {
+		return CreateModelRequest_Model_DEFAULT
+	}
+	return p.Model
+}
+
+var CreateModelRequest_Base_DEFAULT *base.Base
+
+func (p *CreateModelRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return CreateModelRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *CreateModelRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *CreateModelRequest) SetModel(val *manage.Model) {
+	p.Model = val
+}
+func (p *CreateModelRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_CreateModelRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "model",
+	255: "Base",
+}
+
+func (p *CreateModelRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *CreateModelRequest) IsSetModel() bool {
+	return p.Model != nil
+}
+
+func (p *CreateModelRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateModelRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateModelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateModelRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *CreateModelRequest) ReadField2(iprot thrift.TProtocol) error {
+	_field := manage.NewModel()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Model = _field
+	return nil
+}
+func (p *CreateModelRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *CreateModelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateModelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateModelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateModelRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModel() {
+		if err = oprot.WriteFieldBegin("model", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Model.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CreateModelRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *CreateModelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateModelRequest(%+v)", *p)
+
+}
+
+func (p *CreateModelRequest) DeepEqual(ano *CreateModelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Model) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateModelRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *CreateModelRequest) Field2DeepEqual(src *manage.Model) bool {
+
+	if !p.Model.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateModelRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type CreateModelResponse struct {
+	ModelID  *int64         `thrift:"model_id,1,optional" frugal:"1,optional,i64" json:"model_id" form:"model_id" query:"model_id"`
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewCreateModelResponse() *CreateModelResponse {
+	return &CreateModelResponse{}
+}
+
+func (p *CreateModelResponse) InitDefault() {
+}
+
+var CreateModelResponse_ModelID_DEFAULT int64
+
+func (p *CreateModelResponse) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return CreateModelResponse_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var CreateModelResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *CreateModelResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return CreateModelResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CreateModelResponse) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *CreateModelResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_CreateModelResponse = map[int16]string{
+	1:   "model_id",
+	255: "BaseResp",
+}
+
+func (p *CreateModelResponse) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *CreateModelResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateModelResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateModelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateModelResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *CreateModelResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *CreateModelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateModelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateModelResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateModelResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *CreateModelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateModelResponse(%+v)", *p)
+
+}
+
+func (p *CreateModelResponse) DeepEqual(ano *CreateModelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateModelResponse) Field1DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *CreateModelResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type UpdateModelRequest struct {
+	WorkspaceID *int64 `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	ModelID     *int64 `thrift:"model_id,2,optional" frugal:"2,optional,i64" json:"model_id" path:"model_id" `
+	// protocol_config中的密钥为空时保留原值
+	Model *manage.Model `thrift:"model,3,optional" frugal:"3,optional,manage.Model" form:"model" json:"model,omitempty" query:"model"`
+	Base  *base.Base    `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewUpdateModelRequest() *UpdateModelRequest {
+	return &UpdateModelRequest{}
+}
+
+func (p *UpdateModelRequest) InitDefault() {
+}
+
+var UpdateModelRequest_WorkspaceID_DEFAULT int64
+
+func (p *UpdateModelRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return UpdateModelRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var UpdateModelRequest_ModelID_DEFAULT int64
+
+func (p *UpdateModelRequest) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return UpdateModelRequest_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var UpdateModelRequest_Model_DEFAULT *manage.Model
+
+func (p *UpdateModelRequest) GetModel() (v *manage.Model) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModel() {
+		return UpdateModelRequest_Model_DEFAULT
+	}
+	return p.Model
+}
+
+var UpdateModelRequest_Base_DEFAULT *base.Base
+
+func (p *UpdateModelRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return UpdateModelRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateModelRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *UpdateModelRequest) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *UpdateModelRequest) SetModel(val *manage.Model) {
+	p.Model = val
+}
+func (p *UpdateModelRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_UpdateModelRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "model_id",
+	3:   "model",
+	255: "Base",
+}
+
+func (p *UpdateModelRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *UpdateModelRequest) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *UpdateModelRequest) IsSetModel() bool {
+	return p.Model != nil
+}
+
+func (p *UpdateModelRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateModelRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateModelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateModelRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField3(iprot thrift.TProtocol) error {
+	_field := manage.NewModel()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Model = _field
+	return nil
+}
+func (p *UpdateModelRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *UpdateModelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateModelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModel() {
+		if err = oprot.WriteFieldBegin("model", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Model.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UpdateModelRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateModelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateModelRequest(%+v)", *p)
+
+}
+
+func (p *UpdateModelRequest) DeepEqual(ano *UpdateModelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Model) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateModelRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field3DeepEqual(src *manage.Model) bool {
+
+	if !p.Model.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type UpdateModelResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewUpdateModelResponse() *UpdateModelResponse {
+	return &UpdateModelResponse{}
+}
+
+func (p *UpdateModelResponse) InitDefault() {
+}
+
+var UpdateModelResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *UpdateModelResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return UpdateModelResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateModelResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_UpdateModelResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *UpdateModelResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateModelResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateModelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateModelResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *UpdateModelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateModelResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateModelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateModelResponse(%+v)", *p)
+
+}
+
+func (p *UpdateModelResponse) DeepEqual(ano *UpdateModelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateModelResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type DeleteModelRequest struct {
+	WorkspaceID *int64     `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	ModelID     *int64     `thrift:"model_id,2,optional" frugal:"2,optional,i64" json:"model_id" path:"model_id" `
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewDeleteModelRequest() *DeleteModelRequest {
+	return &DeleteModelRequest{}
+}
+
+func (p *DeleteModelRequest) InitDefault() {
+}
+
+var DeleteModelRequest_WorkspaceID_DEFAULT int64
+
+func (p *DeleteModelRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return DeleteModelRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var DeleteModelRequest_ModelID_DEFAULT int64
+
+func (p *DeleteModelRequest) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return DeleteModelRequest_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var DeleteModelRequest_Base_DEFAULT *base.Base
+
+func (p *DeleteModelRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return DeleteModelRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *DeleteModelRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *DeleteModelRequest) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *DeleteModelRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_DeleteModelRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "model_id",
+	255: "Base",
+}
+
+func (p *DeleteModelRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *DeleteModelRequest) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *DeleteModelRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DeleteModelRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteModelRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteModelRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *DeleteModelRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *DeleteModelRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *DeleteModelRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteModelRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteModelRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DeleteModelRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *DeleteModelRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *DeleteModelRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteModelRequest(%+v)", *p)
+
+}
+
+func (p *DeleteModelRequest) DeepEqual(ano *DeleteModelRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteModelRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *DeleteModelRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *DeleteModelRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type DeleteModelResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewDeleteModelResponse() *DeleteModelResponse {
+	return &DeleteModelResponse{}
+}
+
+func (p *DeleteModelResponse) InitDefault() {
+}
+
+var DeleteModelResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *DeleteModelResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return DeleteModelResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeleteModelResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_DeleteModelResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *DeleteModelResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteModelResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteModelResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteModelResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *DeleteModelResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteModelResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteModelResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *DeleteModelResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteModelResponse(%+v)", *p)
+
+}
+
+func (p *DeleteModelResponse) DeepEqual(ano *DeleteModelResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *DeleteModelResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type UpdateModelStatusRequest struct {
+	WorkspaceID *int64              `thrift:"workspace_id,1,optional" frugal:"1,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	ModelID     *int64              `thrift:"model_id,2,optional" frugal:"2,optional,i64" json:"model_id" path:"model_id" `
+	Status      *manage.ModelStatus `thrift:"status,3,optional" frugal:"3,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	Base        *base.Base          `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewUpdateModelStatusRequest() *UpdateModelStatusRequest {
+	return &UpdateModelStatusRequest{}
+}
+
+func (p *UpdateModelStatusRequest) InitDefault() {
+}
+
+var UpdateModelStatusRequest_WorkspaceID_DEFAULT int64
+
+func (p *UpdateModelStatusRequest) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return UpdateModelStatusRequest_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+var UpdateModelStatusRequest_ModelID_DEFAULT int64
+
+func (p *UpdateModelStatusRequest) GetModelID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetModelID() {
+		return UpdateModelStatusRequest_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+var UpdateModelStatusRequest_Status_DEFAULT manage.ModelStatus
+
+func (p *UpdateModelStatusRequest) GetStatus() (v manage.ModelStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return UpdateModelStatusRequest_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var UpdateModelStatusRequest_Base_DEFAULT *base.Base
+
+func (p *UpdateModelStatusRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return UpdateModelStatusRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateModelStatusRequest) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *UpdateModelStatusRequest) SetModelID(val *int64) {
+	p.ModelID = val
+}
+func (p *UpdateModelStatusRequest) SetStatus(val *manage.ModelStatus) {
+	p.Status = val
+}
+func (p *UpdateModelStatusRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_UpdateModelStatusRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "model_id",
+	3:   "status",
+	255: "Base",
+}
+
+func (p *UpdateModelStatusRequest) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *UpdateModelStatusRequest) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *UpdateModelStatusRequest) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *UpdateModelStatusRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateModelStatusRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateModelStatusRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateModelStatusRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *UpdateModelStatusRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModelID = _field
+	return nil
+}
+func (p *UpdateModelStatusRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *manage.ModelStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *UpdateModelStatusRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *UpdateModelStatusRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModelStatusRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateModelStatusRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateModelStatusRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err = oprot.WriteFieldBegin("model_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ModelID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UpdateModelStatusRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UpdateModelStatusRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateModelStatusRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateModelStatusRequest(%+v)", *p)
+
+}
+
+func (p *UpdateModelStatusRequest) DeepEqual(ano *UpdateModelStatusRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.ModelID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateModelStatusRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelStatusRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.ModelID == src {
+		return true
+	} else if p.ModelID == nil || src == nil {
+		return false
+	}
+	if *p.ModelID != *src {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelStatusRequest) Field3DeepEqual(src *manage.ModelStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UpdateModelStatusRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type UpdateModelStatusResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255" frugal:"255,default,base.BaseResp" form:"BaseResp" json:"BaseResp" query:"BaseResp"`
+}
+
+func NewUpdateModelStatusResponse() *UpdateModelStatusResponse {
+	return &UpdateModelStatusResponse{}
+}
+
+func (p *UpdateModelStatusResponse) InitDefault() {
+}
+
+var UpdateModelStatusResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *UpdateModelStatusResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return UpdateModelStatusResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateModelStatusResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_UpdateModelStatusResponse = map[int16]string{
+	255: "BaseResp",
+}
+
+func (p *UpdateModelStatusResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateModelStatusResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateModelStatusResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateModelStatusResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *UpdateModelStatusResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModelStatusResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateModelStatusResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateModelStatusResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateModelStatusResponse(%+v)", *p)
+
+}
+
+func (p *UpdateModelStatusResponse) DeepEqual(ano *UpdateModelStatusResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdateModelStatusResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
 type LLMManageService interface {
 	ListModels(ctx context.Context, req *ListModelsRequest) (r *ListModelsResponse, err error)
 
-	GetModel(ctx context.Context, req *GetModelRequest) (r *GetModelResponse, err error)
+	GetModel(ctx context.Context, req *GetModelRequest) (r *GetModelResponse, err error)
+
+	CreateModel(ctx context.Context, req *CreateModelRequest) (r *CreateModelResponse, err error)
+
+	UpdateModel(ctx context.Context, req *UpdateModelRequest) (r *UpdateModelResponse, err error)
+
+	DeleteModel(ctx context.Context, req *DeleteModelRequest) (r *DeleteModelResponse, err error)
+	// 启用或停用模型，停用后不可调用
+	UpdateModelStatus(ctx context.Context, req *UpdateModelStatusRequest) (r *UpdateModelStatusResponse, err error)
+}
+
+type LLMManageServiceClient struct {
+	c thrift.TClient
+}
+
+func NewLLMManageServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *LLMManageServiceClient {
+	return &LLMManageServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewLLMManageServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *LLMManageServiceClient {
+	return &LLMManageServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewLLMManageServiceClient(c thrift.TClient) *LLMManageServiceClient {
+	return &LLMManageServiceClient{
+		c: c,
+	}
+}
+
+func (p *LLMManageServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *LLMManageServiceClient) ListModels(ctx context.Context, req *ListModelsRequest) (r *ListModelsResponse, err error) {
+	var _args LLMManageServiceListModelsArgs
+	_args.Req = req
+	var _result LLMManageServiceListModelsResult
+	if err = p.Client_().Call(ctx, "ListModels", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) GetModel(ctx context.Context, req *GetModelRequest) (r *GetModelResponse, err error) {
+	var _args LLMManageServiceGetModelArgs
+	_args.Req = req
+	var _result LLMManageServiceGetModelResult
+	if err = p.Client_().Call(ctx, "GetModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) CreateModel(ctx context.Context, req *CreateModelRequest) (r *CreateModelResponse, err error) {
+	var _args LLMManageServiceCreateModelArgs
+	_args.Req = req
+	var _result LLMManageServiceCreateModelResult
+	if err = p.Client_().Call(ctx, "CreateModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) UpdateModel(ctx context.Context, req *UpdateModelRequest) (r *UpdateModelResponse, err error) {
+	var _args LLMManageServiceUpdateModelArgs
+	_args.Req = req
+	var _result LLMManageServiceUpdateModelResult
+	if err = p.Client_().Call(ctx, "UpdateModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) DeleteModel(ctx context.Context, req *DeleteModelRequest) (r *DeleteModelResponse, err error) {
+	var _args LLMManageServiceDeleteModelArgs
+	_args.Req = req
+	var _result LLMManageServiceDeleteModelResult
+	if err = p.Client_().Call(ctx, "DeleteModel", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *LLMManageServiceClient) UpdateModelStatus(ctx context.Context, req *UpdateModelStatusRequest) (r *UpdateModelStatusResponse, err error) {
+	var _args LLMManageServiceUpdateModelStatusArgs
+	_args.Req = req
+	var _result LLMManageServiceUpdateModelStatusResult
+	if err = p.Client_().Call(ctx, "UpdateModelStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type LLMManageServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      LLMManageService
+}
+
+func (p *LLMManageServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *LLMManageServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *LLMManageServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewLLMManageServiceProcessor(handler LLMManageService) *LLMManageServiceProcessor {
+	self := &LLMManageServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListModels", &lLMManageServiceProcessorListModels{handler: handler})
+	self.AddToProcessorMap("GetModel", &lLMManageServiceProcessorGetModel{handler: handler})
+	self.AddToProcessorMap("CreateModel", &lLMManageServiceProcessorCreateModel{handler: handler})
+	self.AddToProcessorMap("UpdateModel", &lLMManageServiceProcessorUpdateModel{handler: handler})
+	self.AddToProcessorMap("DeleteModel", &lLMManageServiceProcessorDeleteModel{handler: handler})
+	self.AddToProcessorMap("UpdateModelStatus", &lLMManageServiceProcessorUpdateModelStatus{handler: handler})
+	return self
+}
+func (p *LLMManageServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type lLMManageServiceProcessorListModels struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorListModels) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceListModelsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListModels", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceListModelsResult{}
+	var retval *ListModelsResponse
+	if retval, err2 = p.handler.ListModels(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListModels: "+err2.Error())
+		oprot.WriteMessageBegin("ListModels", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListModels", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorGetModel struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorGetModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceGetModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceGetModelResult{}
+	var retval *GetModelResponse
+	if retval, err2 = p.handler.GetModel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetModel: "+err2.Error())
+		oprot.WriteMessageBegin("GetModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorCreateModel struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorCreateModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceCreateModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceCreateModelResult{}
+	var retval *CreateModelResponse
+	if retval, err2 = p.handler.CreateModel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateModel: "+err2.Error())
+		oprot.WriteMessageBegin("CreateModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorUpdateModel struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorUpdateModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceUpdateModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceUpdateModelResult{}
+	var retval *UpdateModelResponse
+	if retval, err2 = p.handler.UpdateModel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateModel: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorDeleteModel struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorDeleteModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceDeleteModelArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceDeleteModelResult{}
+	var retval *DeleteModelResponse
+	if retval, err2 = p.handler.DeleteModel(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteModel: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteModel", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteModel", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type lLMManageServiceProcessorUpdateModelStatus struct {
+	handler LLMManageService
+}
+
+func (p *lLMManageServiceProcessorUpdateModelStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := LLMManageServiceUpdateModelStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateModelStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := LLMManageServiceUpdateModelStatusResult{}
+	var retval *UpdateModelStatusResponse
+	if retval, err2 = p.handler.UpdateModelStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateModelStatus: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateModelStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateModelStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type LLMManageServiceListModelsArgs struct {
+	Req *ListModelsRequest `thrift:"req,1" frugal:"1,default,ListModelsRequest"`
+}
+
+func NewLLMManageServiceListModelsArgs() *LLMManageServiceListModelsArgs {
+	return &LLMManageServiceListModelsArgs{}
+}
+
+func (p *LLMManageServiceListModelsArgs) InitDefault() {
+}
+
+var LLMManageServiceListModelsArgs_Req_DEFAULT *ListModelsRequest
+
+func (p *LLMManageServiceListModelsArgs) GetReq() (v *ListModelsRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMManageServiceListModelsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *LLMManageServiceListModelsArgs) SetReq(val *ListModelsRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_LLMManageServiceListModelsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMManageServiceListModelsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMManageServiceListModelsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceListModelsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListModelsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *LLMManageServiceListModelsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListModels_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceListModelsArgs(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceListModelsArgs) DeepEqual(ano *LLMManageServiceListModelsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceListModelsArgs) Field1DeepEqual(src *ListModelsRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceListModelsResult struct {
+	Success *ListModelsResponse `thrift:"success,0,optional" frugal:"0,optional,ListModelsResponse"`
+}
+
+func NewLLMManageServiceListModelsResult() *LLMManageServiceListModelsResult {
+	return &LLMManageServiceListModelsResult{}
+}
+
+func (p *LLMManageServiceListModelsResult) InitDefault() {
+}
+
+var LLMManageServiceListModelsResult_Success_DEFAULT *ListModelsResponse
+
+func (p *LLMManageServiceListModelsResult) GetSuccess() (v *ListModelsResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMManageServiceListModelsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMManageServiceListModelsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListModelsResponse)
+}
+
+var fieldIDToName_LLMManageServiceListModelsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMManageServiceListModelsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMManageServiceListModelsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceListModelsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListModelsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *LLMManageServiceListModelsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListModels_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *LLMManageServiceListModelsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceListModelsResult(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceListModelsResult) DeepEqual(ano *LLMManageServiceListModelsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceListModelsResult) Field0DeepEqual(src *ListModelsResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceGetModelArgs struct {
+	Req *GetModelRequest `thrift:"req,1" frugal:"1,default,GetModelRequest"`
+}
+
+func NewLLMManageServiceGetModelArgs() *LLMManageServiceGetModelArgs {
+	return &LLMManageServiceGetModelArgs{}
+}
+
+func (p *LLMManageServiceGetModelArgs) InitDefault() {
+}
+
+var LLMManageServiceGetModelArgs_Req_DEFAULT *GetModelRequest
+
+func (p *LLMManageServiceGetModelArgs) GetReq() (v *GetModelRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMManageServiceGetModelArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *LLMManageServiceGetModelArgs) SetReq(val *GetModelRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_LLMManageServiceGetModelArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMManageServiceGetModelArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMManageServiceGetModelArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetModelArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetModelRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *LLMManageServiceGetModelArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetModel_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceGetModelArgs(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceGetModelArgs) DeepEqual(ano *LLMManageServiceGetModelArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceGetModelArgs) Field1DeepEqual(src *GetModelRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceGetModelResult struct {
+	Success *GetModelResponse `thrift:"success,0,optional" frugal:"0,optional,GetModelResponse"`
+}
+
+func NewLLMManageServiceGetModelResult() *LLMManageServiceGetModelResult {
+	return &LLMManageServiceGetModelResult{}
+}
+
+func (p *LLMManageServiceGetModelResult) InitDefault() {
+}
+
+var LLMManageServiceGetModelResult_Success_DEFAULT *GetModelResponse
+
+func (p *LLMManageServiceGetModelResult) GetSuccess() (v *GetModelResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMManageServiceGetModelResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMManageServiceGetModelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetModelResponse)
+}
+
+var fieldIDToName_LLMManageServiceGetModelResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMManageServiceGetModelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMManageServiceGetModelResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetModelResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *LLMManageServiceGetModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetModel_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *LLMManageServiceGetModelResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceGetModelResult(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceGetModelResult) DeepEqual(ano *LLMManageServiceGetModelResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceGetModelResult) Field0DeepEqual(src *GetModelResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceCreateModelArgs struct {
+	Req *CreateModelRequest `thrift:"req,1" frugal:"1,default,CreateModelRequest"`
+}
+
+func NewLLMManageServiceCreateModelArgs() *LLMManageServiceCreateModelArgs {
+	return &LLMManageServiceCreateModelArgs{}
+}
+
+func (p *LLMManageServiceCreateModelArgs) InitDefault() {
+}
+
+var LLMManageServiceCreateModelArgs_Req_DEFAULT *CreateModelRequest
+
+func (p *LLMManageServiceCreateModelArgs) GetReq() (v *CreateModelRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMManageServiceCreateModelArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *LLMManageServiceCreateModelArgs) SetReq(val *CreateModelRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_LLMManageServiceCreateModelArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMManageServiceCreateModelArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMManageServiceCreateModelArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceCreateModelArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateModelRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *LLMManageServiceCreateModelArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateModel_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceCreateModelArgs(%+v)", *p)
+
+}
+
+func (p *LLMManageServiceCreateModelArgs) DeepEqual(ano *LLMManageServiceCreateModelArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *LLMManageServiceCreateModelArgs) Field1DeepEqual(src *CreateModelRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type LLMManageServiceCreateModelResult struct {
+	Success *CreateModelResponse `thrift:"success,0,optional" frugal:"0,optional,CreateModelResponse"`
+}
+
+func NewLLMManageServiceCreateModelResult() *LLMManageServiceCreateModelResult {
+	return &LLMManageServiceCreateModelResult{}
+}
+
+func (p *LLMManageServiceCreateModelResult) InitDefault() {
+}
+
+var LLMManageServiceCreateModelResult_Success_DEFAULT *CreateModelResponse
+
+func (p *LLMManageServiceCreateModelResult) GetSuccess() (v *CreateModelResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMManageServiceCreateModelResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMManageServiceCreateModelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateModelResponse)
+}
+
+var fieldIDToName_LLMManageServiceCreateModelResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMManageServiceCreateModelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMManageServiceCreateModelResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceCreateModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateModelResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *LLMManageServiceCreateModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateModel_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *LLMManageServiceCreateModelResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceCreateModelResult(%+v)", *p)
+
 }
 
-type LLMManageServiceClient struct {
-	c thrift.TClient
+func (p *LLMManageServiceCreateModelResult) DeepEqual(ano *LLMManageServiceCreateModelResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
 }
 
-func NewLLMManageServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *LLMManageServiceClient {
-	return &LLMManageServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *LLMManageServiceCreateModelResult) Field0DeepEqual(src *CreateModelResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
+	return true
 }
 
-func NewLLMManageServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *LLMManageServiceClient {
-	return &LLMManageServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+type LLMManageServiceUpdateModelArgs struct {
+	Req *UpdateModelRequest `thrift:"req,1" frugal:"1,default,UpdateModelRequest"`
+}
+
+func NewLLMManageServiceUpdateModelArgs() *LLMManageServiceUpdateModelArgs {
+	return &LLMManageServiceUpdateModelArgs{}
+}
+
+func (p *LLMManageServiceUpdateModelArgs) InitDefault() {
+}
+
+var LLMManageServiceUpdateModelArgs_Req_DEFAULT *UpdateModelRequest
+
+func (p *LLMManageServiceUpdateModelArgs) GetReq() (v *UpdateModelRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return LLMManageServiceUpdateModelArgs_Req_DEFAULT
 	}
+	return p.Req
+}
+func (p *LLMManageServiceUpdateModelArgs) SetReq(val *UpdateModelRequest) {
+	p.Req = val
 }
 
-func NewLLMManageServiceClient(c thrift.TClient) *LLMManageServiceClient {
-	return &LLMManageServiceClient{
-		c: c,
+var fieldIDToName_LLMManageServiceUpdateModelArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *LLMManageServiceUpdateModelArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LLMManageServiceUpdateModelArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceUpdateModelArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *LLMManageServiceUpdateModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateModelRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
 }
 
-func (p *LLMManageServiceClient) ListModels(ctx context.Context, req *ListModelsRequest) (r *ListModelsResponse, err error) {
-	var _args LLMManageServiceListModelsArgs
-	_args.Req = req
-	var _result LLMManageServiceListModelsResult
-	if err = p.Client_().Call(ctx, "ListModels", &_args, &_result); err != nil {
-		return
+func (p *LLMManageServiceUpdateModelArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModel_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *LLMManageServiceClient) GetModel(ctx context.Context, req *GetModelRequest) (r *GetModelResponse, err error) {
-	var _args LLMManageServiceGetModelArgs
-	_args.Req = req
-	var _result LLMManageServiceGetModelResult
-	if err = p.Client_().Call(ctx, "GetModel", &_args, &_result); err != nil {
-		return
+
+func (p *LLMManageServiceUpdateModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type LLMManageServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      LLMManageService
+func (p *LLMManageServiceUpdateModelArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LLMManageServiceUpdateModelArgs(%+v)", *p)
+
 }
 
-func (p *LLMManageServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *LLMManageServiceUpdateModelArgs) DeepEqual(ano *LLMManageServiceUpdateModelArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
 }
 
-func (p *LLMManageServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *LLMManageServiceUpdateModelArgs) Field1DeepEqual(src *UpdateModelRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func (p *LLMManageServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+type LLMManageServiceUpdateModelResult struct {
+	Success *UpdateModelResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateModelResponse"`
 }
 
-func NewLLMManageServiceProcessor(handler LLMManageService) *LLMManageServiceProcessor {
-	self := &LLMManageServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("ListModels", &lLMManageServiceProcessorListModels{handler: handler})
-	self.AddToProcessorMap("GetModel", &lLMManageServiceProcessorGetModel{handler: handler})
-	return self
+func NewLLMManageServiceUpdateModelResult() *LLMManageServiceUpdateModelResult {
+	return &LLMManageServiceUpdateModelResult{}
 }
-func (p *LLMManageServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+
+func (p *LLMManageServiceUpdateModelResult) InitDefault() {
+}
+
+var LLMManageServiceUpdateModelResult_Success_DEFAULT *UpdateModelResponse
+
+func (p *LLMManageServiceUpdateModelResult) GetSuccess() (v *UpdateModelResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return LLMManageServiceUpdateModelResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *LLMManageServiceUpdateModelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateModelResponse)
+}
+
+var fieldIDToName_LLMManageServiceUpdateModelResult = map[int16]string{
+	0: "success",
+}
+
+func (p *LLMManageServiceUpdateModelResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LLMManageServiceUpdateModelResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
 
-type lLMManageServiceProcessorListModels struct {
-	handler LLMManageService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceUpdateModelResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *lLMManageServiceProcessorListModels) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := LLMManageServiceListModelsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListModels", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *LLMManageServiceUpdateModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateModelResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := LLMManageServiceListModelsResult{}
-	var retval *ListModelsResponse
-	if retval, err2 = p.handler.ListModels(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListModels: "+err2.Error())
-		oprot.WriteMessageBegin("ListModels", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListModels", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *LLMManageServiceUpdateModelResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateModel_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type lLMManageServiceProcessorGetModel struct {
-	handler LLMManageService
+func (p *LLMManageServiceUpdateModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *lLMManageServiceProcessorGetModel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := LLMManageServiceGetModelArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetModel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *LLMManageServiceUpdateModelResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("LLMManageServiceUpdateModelResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := LLMManageServiceGetModelResult{}
-	var retval *GetModelResponse
-	if retval, err2 = p.handler.GetModel(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetModel: "+err2.Error())
-		oprot.WriteMessageBegin("GetModel", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetModel", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *LLMManageServiceUpdateModelResult) DeepEqual(ano *LLMManageServiceUpdateModelResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *LLMManageServiceUpdateModelResult) Field0DeepEqual(src *UpdateModelResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type LLMManageServiceListModelsArgs struct {
-	Req *ListModelsRequest `thrift:"req,1" frugal:"1,default,ListModelsRequest"`
+type LLMManageServiceDeleteModelArgs struct {
+	Req *DeleteModelRequest `thrift:"req,1" frugal:"1,default,DeleteModelRequest"`
 }
 
-func NewLLMManageServiceListModelsArgs() *LLMManageServiceListModelsArgs {
-	return &LLMManageServiceListModelsArgs{}
+func NewLLMManageServiceDeleteModelArgs() *LLMManageServiceDeleteModelArgs {
+	return &LLMManageServiceDeleteModelArgs{}
 }
 
-func (p *LLMManageServiceListModelsArgs) InitDefault() {
+func (p *LLMManageServiceDeleteModelArgs) InitDefault() {
 }
 
-var LLMManageServiceListModelsArgs_Req_DEFAULT *ListModelsRequest
+var LLMManageServiceDeleteModelArgs_Req_DEFAULT *DeleteModelRequest
 
-func (p *LLMManageServiceListModelsArgs) GetReq() (v *ListModelsRequest) {
+func (p *LLMManageServiceDeleteModelArgs) GetReq() (v *DeleteModelRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return LLMManageServiceListModelsArgs_Req_DEFAULT
+		return LLMManageServiceDeleteModelArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *LLMManageServiceListModelsArgs) SetReq(val *ListModelsRequest) {
+func (p *LLMManageServiceDeleteModelArgs) SetReq(val *DeleteModelRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_LLMManageServiceListModelsArgs = map[int16]string{
+var fieldIDToName_LLMManageServiceDeleteModelArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *LLMManageServiceListModelsArgs) IsSetReq() bool {
+func (p *LLMManageServiceDeleteModelArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *LLMManageServiceListModelsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceDeleteModelArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2836,7 +6661,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceListModelsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceDeleteModelArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2846,8 +6671,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListModelsRequest()
+func (p *LLMManageServiceDeleteModelArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteModelRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2855,9 +6680,9 @@ func (p *LLMManageServiceListModelsArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *LLMManageServiceListModelsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceDeleteModelArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListModels_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteModel_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2883,7 +6708,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceDeleteModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2900,15 +6725,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsArgs) String() string {
+func (p *LLMManageServiceDeleteModelArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMManageServiceListModelsArgs(%+v)", *p)
+	return fmt.Sprintf("LLMManageServiceDeleteModelArgs(%+v)", *p)
 
 }
 
-func (p *LLMManageServiceListModelsArgs) DeepEqual(ano *LLMManageServiceListModelsArgs) bool {
+func (p *LLMManageServiceDeleteModelArgs) DeepEqual(ano *LLMManageServiceDeleteModelArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -2920,7 +6745,7 @@ func (p *LLMManageServiceListModelsArgs) DeepEqual(ano *LLMManageServiceListMode
 	return true
 }
 
-func (p *LLMManageServiceListModelsArgs) Field1DeepEqual(src *ListModelsRequest) bool {
+func (p *LLMManageServiceDeleteModelArgs) Field1DeepEqual(src *DeleteModelRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -2928,41 +6753,41 @@ func (p *LLMManageServiceListModelsArgs) Field1DeepEqual(src *ListModelsRequest)
 	return true
 }
 
-type LLMManageServiceListModelsResult struct {
-	Success *ListModelsResponse `thrift:"success,0,optional" frugal:"0,optional,ListModelsResponse"`
+type LLMManageServiceDeleteModelResult struct {
+	Success *DeleteModelResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteModelResponse"`
 }
 
-func NewLLMManageServiceListModelsResult() *LLMManageServiceListModelsResult {
-	return &LLMManageServiceListModelsResult{}
+func NewLLMManageServiceDeleteModelResult() *LLMManageServiceDeleteModelResult {
+	return &LLMManageServiceDeleteModelResult{}
 }
 
-func (p *LLMManageServiceListModelsResult) InitDefault() {
+func (p *LLMManageServiceDeleteModelResult) InitDefault() {
 }
 
-var LLMManageServiceListModelsResult_Success_DEFAULT *ListModelsResponse
+var LLMManageServiceDeleteModelResult_Success_DEFAULT *DeleteModelResponse
 
-func (p *LLMManageServiceListModelsResult) GetSuccess() (v *ListModelsResponse) {
+func (p *LLMManageServiceDeleteModelResult) GetSuccess() (v *DeleteModelResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return LLMManageServiceListModelsResult_Success_DEFAULT
+		return LLMManageServiceDeleteModelResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *LLMManageServiceListModelsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListModelsResponse)
+func (p *LLMManageServiceDeleteModelResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteModelResponse)
 }
 
-var fieldIDToName_LLMManageServiceListModelsResult = map[int16]string{
+var fieldIDToName_LLMManageServiceDeleteModelResult = map[int16]string{
 	0: "success",
 }
 
-func (p *LLMManageServiceListModelsResult) IsSetSuccess() bool {
+func (p *LLMManageServiceDeleteModelResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *LLMManageServiceListModelsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceDeleteModelResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3007,7 +6832,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceListModelsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceDeleteModelResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3017,8 +6842,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListModelsResponse()
+func (p *LLMManageServiceDeleteModelResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteModelResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3026,9 +6851,9 @@ func (p *LLMManageServiceListModelsResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *LLMManageServiceListModelsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceDeleteModelResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListModels_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteModel_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3054,7 +6879,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceDeleteModelResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3073,15 +6898,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *LLMManageServiceListModelsResult) String() string {
+func (p *LLMManageServiceDeleteModelResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMManageServiceListModelsResult(%+v)", *p)
+	return fmt.Sprintf("LLMManageServiceDeleteModelResult(%+v)", *p)
 
 }
 
-func (p *LLMManageServiceListModelsResult) DeepEqual(ano *LLMManageServiceListModelsResult) bool {
+func (p *LLMManageServiceDeleteModelResult) DeepEqual(ano *LLMManageServiceDeleteModelResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3093,7 +6918,7 @@ func (p *LLMManageServiceListModelsResult) DeepEqual(ano *LLMManageServiceListMo
 	return true
 }
 
-func (p *LLMManageServiceListModelsResult) Field0DeepEqual(src *ListModelsResponse) bool {
+func (p *LLMManageServiceDeleteModelResult) Field0DeepEqual(src *DeleteModelResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -3101,41 +6926,41 @@ func (p *LLMManageServiceListModelsResult) Field0DeepEqual(src *ListModelsRespon
 	return true
 }
 
-type LLMManageServiceGetModelArgs struct {
-	Req *GetModelRequest `thrift:"req,1" frugal:"1,default,GetModelRequest"`
+type LLMManageServiceUpdateModelStatusArgs struct {
+	Req *UpdateModelStatusRequest `thrift:"req,1" frugal:"1,default,UpdateModelStatusRequest"`
 }
 
-func NewLLMManageServiceGetModelArgs() *LLMManageServiceGetModelArgs {
-	return &LLMManageServiceGetModelArgs{}
+func NewLLMManageServiceUpdateModelStatusArgs() *LLMManageServiceUpdateModelStatusArgs {
+	return &LLMManageServiceUpdateModelStatusArgs{}
 }
 
-func (p *LLMManageServiceGetModelArgs) InitDefault() {
+func (p *LLMManageServiceUpdateModelStatusArgs) InitDefault() {
 }
 
-var LLMManageServiceGetModelArgs_Req_DEFAULT *GetModelRequest
+var LLMManageServiceUpdateModelStatusArgs_Req_DEFAULT *UpdateModelStatusRequest
 
-func (p *LLMManageServiceGetModelArgs) GetReq() (v *GetModelRequest) {
+func (p *LLMManageServiceUpdateModelStatusArgs) GetReq() (v *UpdateModelStatusRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return LLMManageServiceGetModelArgs_Req_DEFAULT
+		return LLMManageServiceUpdateModelStatusArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *LLMManageServiceGetModelArgs) SetReq(val *GetModelRequest) {
+func (p *LLMManageServiceUpdateModelStatusArgs) SetReq(val *UpdateModelStatusRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_LLMManageServiceGetModelArgs = map[int16]string{
+var fieldIDToName_LLMManageServiceUpdateModelStatusArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *LLMManageServiceGetModelArgs) IsSetReq() bool {
+func (p *LLMManageServiceUpdateModelStatusArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *LLMManageServiceGetModelArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceUpdateModelStatusArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3180,7 +7005,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetModelArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceUpdateModelStatusArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3190,8 +7015,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetModelRequest()
+func (p *LLMManageServiceUpdateModelStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateModelStatusRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3199,9 +7024,9 @@ func (p *LLMManageServiceGetModelArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *LLMManageServiceGetModelArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceUpdateModelStatusArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetModel_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateModelStatus_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3227,7 +7052,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceUpdateModelStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3244,15 +7069,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelArgs) String() string {
+func (p *LLMManageServiceUpdateModelStatusArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMManageServiceGetModelArgs(%+v)", *p)
+	return fmt.Sprintf("LLMManageServiceUpdateModelStatusArgs(%+v)", *p)
 
 }
 
-func (p *LLMManageServiceGetModelArgs) DeepEqual(ano *LLMManageServiceGetModelArgs) bool {
+func (p *LLMManageServiceUpdateModelStatusArgs) DeepEqual(ano *LLMManageServiceUpdateModelStatusArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3264,7 +7089,7 @@ func (p *LLMManageServiceGetModelArgs) DeepEqual(ano *LLMManageServiceGetModelAr
 	return true
 }
 
-func (p *LLMManageServiceGetModelArgs) Field1DeepEqual(src *GetModelRequest) bool {
+func (p *LLMManageServiceUpdateModelStatusArgs) Field1DeepEqual(src *UpdateModelStatusRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -3272,41 +7097,41 @@ func (p *LLMManageServiceGetModelArgs) Field1DeepEqual(src *GetModelRequest) boo
 	return true
 }
 
-type LLMManageServiceGetModelResult struct {
-	Success *GetModelResponse `thrift:"success,0,optional" frugal:"0,optional,GetModelResponse"`
+type LLMManageServiceUpdateModelStatusResult struct {
+	Success *UpdateModelStatusResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateModelStatusResponse"`
 }
 
-func NewLLMManageServiceGetModelResult() *LLMManageServiceGetModelResult {
-	return &LLMManageServiceGetModelResult{}
+func NewLLMManageServiceUpdateModelStatusResult() *LLMManageServiceUpdateModelStatusResult {
+	return &LLMManageServiceUpdateModelStatusResult{}
 }
 
-func (p *LLMManageServiceGetModelResult) InitDefault() {
+func (p *LLMManageServiceUpdateModelStatusResult) InitDefault() {
 }
 
-var LLMManageServiceGetModelResult_Success_DEFAULT *GetModelResponse
+var LLMManageServiceUpdateModelStatusResult_Success_DEFAULT *UpdateModelStatusResponse
 
-func (p *LLMManageServiceGetModelResult) GetSuccess() (v *GetModelResponse) {
+func (p *LLMManageServiceUpdateModelStatusResult) GetSuccess() (v *UpdateModelStatusResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return LLMManageServiceGetModelResult_Success_DEFAULT
+		return LLMManageServiceUpdateModelStatusResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *LLMManageServiceGetModelResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetModelResponse)
+func (p *LLMManageServiceUpdateModelStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateModelStatusResponse)
 }
 
-var fieldIDToName_LLMManageServiceGetModelResult = map[int16]string{
+var fieldIDToName_LLMManageServiceUpdateModelStatusResult = map[int16]string{
 	0: "success",
 }
 
-func (p *LLMManageServiceGetModelResult) IsSetSuccess() bool {
+func (p *LLMManageServiceUpdateModelStatusResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *LLMManageServiceGetModelResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceUpdateModelStatusResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3351,7 +7176,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceGetModelResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LLMManageServiceUpdateModelStatusResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3361,8 +7186,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetModelResponse()
+func (p *LLMManageServiceUpdateModelStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateModelStatusResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3370,9 +7195,9 @@ func (p *LLMManageServiceGetModelResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *LLMManageServiceGetModelResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceUpdateModelStatusResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetModel_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateModelStatus_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3398,7 +7223,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *LLMManageServiceUpdateModelStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3417,15 +7242,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *LLMManageServiceGetModelResult) String() string {
+func (p *LLMManageServiceUpdateModelStatusResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LLMManageServiceGetModelResult(%+v)", *p)
+	return fmt.Sprintf("LLMManageServiceUpdateModelStatusResult(%+v)", *p)
 
 }
 
-func (p *LLMManageServiceGetModelResult) DeepEqual(ano *LLMManageServiceGetModelResult) bool {
+func (p *LLMManageServiceUpdateModelStatusResult) DeepEqual(ano *LLMManageServiceUpdateModelStatusResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -3437,7 +7262,7 @@ func (p *LLMManageServiceGetModelResult) DeepEqual(ano *LLMManageServiceGetModel
 	return true
 }
 
-func (p *LLMManageServiceGetModelResult) Field0DeepEqual(src *GetModelResponse) bool {
+func (p *LLMManageServiceUpdateModelStatusResult) Field0DeepEqual(src *UpdateModelStatusResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
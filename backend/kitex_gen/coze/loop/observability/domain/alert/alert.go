@@ -0,0 +1,2434 @@
+// Code generated by thriftgo (0.4.1). DO NOT EDIT.
+
+package alert
+
+import (
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
+	"strings"
+)
+
+const (
+	ConditionTypeThreshold = "threshold"
+
+	ConditionTypeAnomaly = "anomaly"
+
+	CompareOperatorGT = "gt"
+
+	CompareOperatorGTE = "gte"
+
+	CompareOperatorLT = "lt"
+
+	CompareOperatorLTE = "lte"
+
+	AlertStatusOK = "ok"
+
+	AlertStatusFiring = "firing"
+
+	AlertStatusNoData = "no_data"
+)
+
+type ConditionType = string
+
+type CompareOperator = string
+
+type AlertStatus = string
+
+type AlertCondition struct {
+	Type     ConditionType   `thrift:"type,1,required" frugal:"1,required,string" form:"type,required" json:"type,required" query:"type,required"`
+	Operator CompareOperator `thrift:"operator,2,required" frugal:"2,required,string" form:"operator,required" json:"operator,required" query:"operator,required"`
+	// 阈值条件使用
+	Threshold *float64 `thrift:"threshold,3,optional" frugal:"3,optional,double" form:"threshold" json:"threshold,omitempty" query:"threshold"`
+	// 异常条件使用，偏离历史均值超过多少倍标准差时告警
+	Deviation *float64 `thrift:"deviation,4,optional" frugal:"4,optional,double" form:"deviation" json:"deviation,omitempty" query:"deviation"`
+	// 异常条件使用，参与计算基线的历史窗口数
+	BaselineWindows *int32 `thrift:"baseline_windows,5,optional" frugal:"5,optional,i32" form:"baseline_windows" json:"baseline_windows,omitempty" query:"baseline_windows"`
+}
+
+func NewAlertCondition() *AlertCondition {
+	return &AlertCondition{}
+}
+
+func (p *AlertCondition) InitDefault() {
+}
+
+func (p *AlertCondition) GetType() (v ConditionType) {
+	if p != nil {
+		return p.Type
+	}
+	return
+}
+
+func (p *AlertCondition) GetOperator() (v CompareOperator) {
+	if p != nil {
+		return p.Operator
+	}
+	return
+}
+
+var AlertCondition_Threshold_DEFAULT float64
+
+func (p *AlertCondition) GetThreshold() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetThreshold() {
+		return AlertCondition_Threshold_DEFAULT
+	}
+	return *p.Threshold
+}
+
+var AlertCondition_Deviation_DEFAULT float64
+
+func (p *AlertCondition) GetDeviation() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDeviation() {
+		return AlertCondition_Deviation_DEFAULT
+	}
+	return *p.Deviation
+}
+
+var AlertCondition_BaselineWindows_DEFAULT int32
+
+func (p *AlertCondition) GetBaselineWindows() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaselineWindows() {
+		return AlertCondition_BaselineWindows_DEFAULT
+	}
+	return *p.BaselineWindows
+}
+func (p *AlertCondition) SetType(val ConditionType) {
+	p.Type = val
+}
+func (p *AlertCondition) SetOperator(val CompareOperator) {
+	p.Operator = val
+}
+func (p *AlertCondition) SetThreshold(val *float64) {
+	p.Threshold = val
+}
+func (p *AlertCondition) SetDeviation(val *float64) {
+	p.Deviation = val
+}
+func (p *AlertCondition) SetBaselineWindows(val *int32) {
+	p.BaselineWindows = val
+}
+
+var fieldIDToName_AlertCondition = map[int16]string{
+	1: "type",
+	2: "operator",
+	3: "threshold",
+	4: "deviation",
+	5: "baseline_windows",
+}
+
+func (p *AlertCondition) IsSetThreshold() bool {
+	return p.Threshold != nil
+}
+
+func (p *AlertCondition) IsSetDeviation() bool {
+	return p.Deviation != nil
+}
+
+func (p *AlertCondition) IsSetBaselineWindows() bool {
+	return p.BaselineWindows != nil
+}
+
+func (p *AlertCondition) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetType bool = false
+	var issetOperator bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOperator = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetType {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOperator {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AlertCondition[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AlertCondition[fieldId]))
+}
+
+func (p *AlertCondition) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field ConditionType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *AlertCondition) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field CompareOperator
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Operator = _field
+	return nil
+}
+func (p *AlertCondition) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Threshold = _field
+	return nil
+}
+func (p *AlertCondition) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Deviation = _field
+	return nil
+}
+func (p *AlertCondition) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaselineWindows = _field
+	return nil
+}
+
+func (p *AlertCondition) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AlertCondition"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AlertCondition) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Type); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AlertCondition) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("operator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Operator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AlertCondition) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetThreshold() {
+		if err = oprot.WriteFieldBegin("threshold", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Threshold); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *AlertCondition) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeviation() {
+		if err = oprot.WriteFieldBegin("deviation", thrift.DOUBLE, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Deviation); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *AlertCondition) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaselineWindows() {
+		if err = oprot.WriteFieldBegin("baseline_windows", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.BaselineWindows); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *AlertCondition) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AlertCondition(%+v)", *p)
+
+}
+
+func (p *AlertCondition) DeepEqual(ano *AlertCondition) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Type) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Operator) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Threshold) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Deviation) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.BaselineWindows) {
+		return false
+	}
+	return true
+}
+
+func (p *AlertCondition) Field1DeepEqual(src ConditionType) bool {
+
+	if strings.Compare(p.Type, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AlertCondition) Field2DeepEqual(src CompareOperator) bool {
+
+	if strings.Compare(p.Operator, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AlertCondition) Field3DeepEqual(src *float64) bool {
+
+	if p.Threshold == src {
+		return true
+	} else if p.Threshold == nil || src == nil {
+		return false
+	}
+	if *p.Threshold != *src {
+		return false
+	}
+	return true
+}
+func (p *AlertCondition) Field4DeepEqual(src *float64) bool {
+
+	if p.Deviation == src {
+		return true
+	} else if p.Deviation == nil || src == nil {
+		return false
+	}
+	if *p.Deviation != *src {
+		return false
+	}
+	return true
+}
+func (p *AlertCondition) Field5DeepEqual(src *int32) bool {
+
+	if p.BaselineWindows == src {
+		return true
+	} else if p.BaselineWindows == nil || src == nil {
+		return false
+	}
+	if *p.BaselineWindows != *src {
+		return false
+	}
+	return true
+}
+
+type AlertNotification struct {
+	// 通过消息卡片通知的用户
+	UserIds        []string          `thrift:"user_ids,1,optional" frugal:"1,optional,list<string>" form:"user_ids" json:"user_ids,omitempty" query:"user_ids"`
+	WebhookURL     *string           `thrift:"webhook_url,2,optional" frugal:"2,optional,string" form:"webhook_url" json:"webhook_url,omitempty" query:"webhook_url"`
+	WebhookHeaders map[string]string `thrift:"webhook_headers,3,optional" frugal:"3,optional,map<string:string>" form:"webhook_headers" json:"webhook_headers,omitempty" query:"webhook_headers"`
+}
+
+func NewAlertNotification() *AlertNotification {
+	return &AlertNotification{}
+}
+
+func (p *AlertNotification) InitDefault() {
+}
+
+var AlertNotification_UserIds_DEFAULT []string
+
+func (p *AlertNotification) GetUserIds() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetUserIds() {
+		return AlertNotification_UserIds_DEFAULT
+	}
+	return p.UserIds
+}
+
+var AlertNotification_WebhookURL_DEFAULT string
+
+func (p *AlertNotification) GetWebhookURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWebhookURL() {
+		return AlertNotification_WebhookURL_DEFAULT
+	}
+	return *p.WebhookURL
+}
+
+var AlertNotification_WebhookHeaders_DEFAULT map[string]string
+
+func (p *AlertNotification) GetWebhookHeaders() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWebhookHeaders() {
+		return AlertNotification_WebhookHeaders_DEFAULT
+	}
+	return p.WebhookHeaders
+}
+func (p *AlertNotification) SetUserIds(val []string) {
+	p.UserIds = val
+}
+func (p *AlertNotification) SetWebhookURL(val *string) {
+	p.WebhookURL = val
+}
+func (p *AlertNotification) SetWebhookHeaders(val map[string]string) {
+	p.WebhookHeaders = val
+}
+
+var fieldIDToName_AlertNotification = map[int16]string{
+	1: "user_ids",
+	2: "webhook_url",
+	3: "webhook_headers",
+}
+
+func (p *AlertNotification) IsSetUserIds() bool {
+	return p.UserIds != nil
+}
+
+func (p *AlertNotification) IsSetWebhookURL() bool {
+	return p.WebhookURL != nil
+}
+
+func (p *AlertNotification) IsSetWebhookHeaders() bool {
+	return p.WebhookHeaders != nil
+}
+
+func (p *AlertNotification) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AlertNotification[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AlertNotification) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.UserIds = _field
+	return nil
+}
+func (p *AlertNotification) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WebhookURL = _field
+	return nil
+}
+func (p *AlertNotification) ReadField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.WebhookHeaders = _field
+	return nil
+}
+
+func (p *AlertNotification) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AlertNotification"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AlertNotification) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserIds() {
+		if err = oprot.WriteFieldBegin("user_ids", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.UserIds)); err != nil {
+			return err
+		}
+		for _, v := range p.UserIds {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AlertNotification) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWebhookURL() {
+		if err = oprot.WriteFieldBegin("webhook_url", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.WebhookURL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AlertNotification) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWebhookHeaders() {
+		if err = oprot.WriteFieldBegin("webhook_headers", thrift.MAP, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.WebhookHeaders)); err != nil {
+			return err
+		}
+		for k, v := range p.WebhookHeaders {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AlertNotification) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AlertNotification(%+v)", *p)
+
+}
+
+func (p *AlertNotification) DeepEqual(ano *AlertNotification) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserIds) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WebhookURL) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.WebhookHeaders) {
+		return false
+	}
+	return true
+}
+
+func (p *AlertNotification) Field1DeepEqual(src []string) bool {
+
+	if len(p.UserIds) != len(src) {
+		return false
+	}
+	for i, v := range p.UserIds {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *AlertNotification) Field2DeepEqual(src *string) bool {
+
+	if p.WebhookURL == src {
+		return true
+	} else if p.WebhookURL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.WebhookURL, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AlertNotification) Field3DeepEqual(src map[string]string) bool {
+
+	if len(p.WebhookHeaders) != len(src) {
+		return false
+	}
+	for k, v := range p.WebhookHeaders {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type AlertState struct {
+	Status          *AlertStatus `thrift:"status,1,optional" frugal:"1,optional,string" form:"status" json:"status,omitempty" query:"status"`
+	LastValue       *float64     `thrift:"last_value,2,optional" frugal:"2,optional,double" form:"last_value" json:"last_value,omitempty" query:"last_value"`
+	LastEvaluatedAt *int64       `thrift:"last_evaluated_at,3,optional" frugal:"3,optional,i64" json:"last_evaluated_at" form:"last_evaluated_at" query:"last_evaluated_at"`
+	FiredAt         *int64       `thrift:"fired_at,4,optional" frugal:"4,optional,i64" json:"fired_at" form:"fired_at" query:"fired_at"`
+	ResolvedAt      *int64       `thrift:"resolved_at,5,optional" frugal:"5,optional,i64" json:"resolved_at" form:"resolved_at" query:"resolved_at"`
+}
+
+func NewAlertState() *AlertState {
+	return &AlertState{}
+}
+
+func (p *AlertState) InitDefault() {
+}
+
+var AlertState_Status_DEFAULT AlertStatus
+
+func (p *AlertState) GetStatus() (v AlertStatus) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStatus() {
+		return AlertState_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var AlertState_LastValue_DEFAULT float64
+
+func (p *AlertState) GetLastValue() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLastValue() {
+		return AlertState_LastValue_DEFAULT
+	}
+	return *p.LastValue
+}
+
+var AlertState_LastEvaluatedAt_DEFAULT int64
+
+func (p *AlertState) GetLastEvaluatedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLastEvaluatedAt() {
+		return AlertState_LastEvaluatedAt_DEFAULT
+	}
+	return *p.LastEvaluatedAt
+}
+
+var AlertState_FiredAt_DEFAULT int64
+
+func (p *AlertState) GetFiredAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFiredAt() {
+		return AlertState_FiredAt_DEFAULT
+	}
+	return *p.FiredAt
+}
+
+var AlertState_ResolvedAt_DEFAULT int64
+
+func (p *AlertState) GetResolvedAt() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetResolvedAt() {
+		return AlertState_ResolvedAt_DEFAULT
+	}
+	return *p.ResolvedAt
+}
+func (p *AlertState) SetStatus(val *AlertStatus) {
+	p.Status = val
+}
+func (p *AlertState) SetLastValue(val *float64) {
+	p.LastValue = val
+}
+func (p *AlertState) SetLastEvaluatedAt(val *int64) {
+	p.LastEvaluatedAt = val
+}
+func (p *AlertState) SetFiredAt(val *int64) {
+	p.FiredAt = val
+}
+func (p *AlertState) SetResolvedAt(val *int64) {
+	p.ResolvedAt = val
+}
+
+var fieldIDToName_AlertState = map[int16]string{
+	1: "status",
+	2: "last_value",
+	3: "last_evaluated_at",
+	4: "fired_at",
+	5: "resolved_at",
+}
+
+func (p *AlertState) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *AlertState) IsSetLastValue() bool {
+	return p.LastValue != nil
+}
+
+func (p *AlertState) IsSetLastEvaluatedAt() bool {
+	return p.LastEvaluatedAt != nil
+}
+
+func (p *AlertState) IsSetFiredAt() bool {
+	return p.FiredAt != nil
+}
+
+func (p *AlertState) IsSetResolvedAt() bool {
+	return p.ResolvedAt != nil
+}
+
+func (p *AlertState) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AlertState[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AlertState) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *AlertStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *AlertState) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LastValue = _field
+	return nil
+}
+func (p *AlertState) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LastEvaluatedAt = _field
+	return nil
+}
+func (p *AlertState) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FiredAt = _field
+	return nil
+}
+func (p *AlertState) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ResolvedAt = _field
+	return nil
+}
+
+func (p *AlertState) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AlertState"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AlertState) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AlertState) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastValue() {
+		if err = oprot.WriteFieldBegin("last_value", thrift.DOUBLE, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.LastValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AlertState) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastEvaluatedAt() {
+		if err = oprot.WriteFieldBegin("last_evaluated_at", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.LastEvaluatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *AlertState) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFiredAt() {
+		if err = oprot.WriteFieldBegin("fired_at", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.FiredAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *AlertState) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResolvedAt() {
+		if err = oprot.WriteFieldBegin("resolved_at", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ResolvedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *AlertState) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AlertState(%+v)", *p)
+
+}
+
+func (p *AlertState) DeepEqual(ano *AlertState) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.LastValue) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.LastEvaluatedAt) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.FiredAt) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.ResolvedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *AlertState) Field1DeepEqual(src *AlertStatus) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AlertState) Field2DeepEqual(src *float64) bool {
+
+	if p.LastValue == src {
+		return true
+	} else if p.LastValue == nil || src == nil {
+		return false
+	}
+	if *p.LastValue != *src {
+		return false
+	}
+	return true
+}
+func (p *AlertState) Field3DeepEqual(src *int64) bool {
+
+	if p.LastEvaluatedAt == src {
+		return true
+	} else if p.LastEvaluatedAt == nil || src == nil {
+		return false
+	}
+	if *p.LastEvaluatedAt != *src {
+		return false
+	}
+	return true
+}
+func (p *AlertState) Field4DeepEqual(src *int64) bool {
+
+	if p.FiredAt == src {
+		return true
+	} else if p.FiredAt == nil || src == nil {
+		return false
+	}
+	if *p.FiredAt != *src {
+		return false
+	}
+	return true
+}
+func (p *AlertState) Field5DeepEqual(src *int64) bool {
+
+	if p.ResolvedAt == src {
+		return true
+	} else if p.ResolvedAt == nil || src == nil {
+		return false
+	}
+	if *p.ResolvedAt != *src {
+		return false
+	}
+	return true
+}
+
+type AlertRule struct {
+	ID           *int64               `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
+	WorkspaceID  *int64               `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	Name         string               `thrift:"name,3,required" frugal:"3,required,string" form:"name,required" json:"name,required" query:"name,required"`
+	PlatformType *common.PlatformType `thrift:"platform_type,4,optional" frugal:"4,optional,string" form:"platform_type" json:"platform_type,omitempty" query:"platform_type"`
+	MetricName   string               `thrift:"metric_name,5,required" frugal:"5,required,string" form:"metric_name,required" json:"metric_name,required" query:"metric_name,required"`
+	// 时间序列指标取哪一组，饼图指标取哪一项，为空时取全部
+	GroupKey  *string              `thrift:"group_key,6,optional" frugal:"6,optional,string" form:"group_key" json:"group_key,omitempty" query:"group_key"`
+	Filters   *filter.FilterFields `thrift:"filters,7,optional" frugal:"7,optional,filter.FilterFields" form:"filters" json:"filters,omitempty" query:"filters"`
+	Condition *AlertCondition      `thrift:"condition,8,required" frugal:"8,required,AlertCondition" form:"condition,required" json:"condition,required" query:"condition,required"`
+	// 评估窗口，单位秒
+	WindowSeconds int64              `thrift:"window_seconds,9,required" frugal:"9,required,i64" json:"window_seconds" form:"window_seconds,required" query:"window_seconds,required"`
+	Enabled       *bool              `thrift:"enabled,10,optional" frugal:"10,optional,bool" form:"enabled" json:"enabled,omitempty" query:"enabled"`
+	Notification  *AlertNotification `thrift:"notification,11,optional" frugal:"11,optional,AlertNotification" form:"notification" json:"notification,omitempty" query:"notification"`
+	State         *AlertState        `thrift:"state,12,optional" frugal:"12,optional,AlertState" form:"state" json:"state,omitempty" query:"state"`
+	BaseInfo      *common.BaseInfo   `thrift:"base_info,13,optional" frugal:"13,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+}
+
+func NewAlertRule() *AlertRule {
+	return &AlertRule{}
+}
+
+func (p *AlertRule) InitDefault() {
+}
+
+var AlertRule_ID_DEFAULT int64
+
+func (p *AlertRule) GetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return AlertRule_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var AlertRule_WorkspaceID_DEFAULT int64
+
+func (p *AlertRule) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return AlertRule_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+func (p *AlertRule) GetName() (v string) {
+	if p != nil {
+		return p.Name
+	}
+	return
+}
+
+var AlertRule_PlatformType_DEFAULT common.PlatformType
+
+func (p *AlertRule) GetPlatformType() (v common.PlatformType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPlatformType() {
+		return AlertRule_PlatformType_DEFAULT
+	}
+	return *p.PlatformType
+}
+
+func (p *AlertRule) GetMetricName() (v string) {
+	if p != nil {
+		return p.MetricName
+	}
+	return
+}
+
+var AlertRule_GroupKey_DEFAULT string
+
+func (p *AlertRule) GetGroupKey() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGroupKey() {
+		return AlertRule_GroupKey_DEFAULT
+	}
+	return *p.GroupKey
+}
+
+var AlertRule_Filters_DEFAULT *filter.FilterFields
+
+func (p *AlertRule) GetFilters() (v *filter.FilterFields) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilters() {
+		return AlertRule_Filters_DEFAULT
+	}
+	return p.Filters
+}
+
+var AlertRule_Condition_DEFAULT *AlertCondition
+
+func (p *AlertRule) GetCondition() (v *AlertCondition) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCondition() {
+		return AlertRule_Condition_DEFAULT
+	}
+	return p.Condition
+}
+
+func (p *AlertRule) GetWindowSeconds() (v int64) {
+	if p != nil {
+		return p.WindowSeconds
+	}
+	return
+}
+
+var AlertRule_Enabled_DEFAULT bool
+
+func (p *AlertRule) GetEnabled() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetEnabled() {
+		return AlertRule_Enabled_DEFAULT
+	}
+	return *p.Enabled
+}
+
+var AlertRule_Notification_DEFAULT *AlertNotification
+
+func (p *AlertRule) GetNotification() (v *AlertNotification) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetNotification() {
+		return AlertRule_Notification_DEFAULT
+	}
+	return p.Notification
+}
+
+var AlertRule_State_DEFAULT *AlertState
+
+func (p *AlertRule) GetState() (v *AlertState) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetState() {
+		return AlertRule_State_DEFAULT
+	}
+	return p.State
+}
+
+var AlertRule_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *AlertRule) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return AlertRule_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+func (p *AlertRule) SetID(val *int64) {
+	p.ID = val
+}
+func (p *AlertRule) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *AlertRule) SetName(val string) {
+	p.Name = val
+}
+func (p *AlertRule) SetPlatformType(val *common.PlatformType) {
+	p.PlatformType = val
+}
+func (p *AlertRule) SetMetricName(val string) {
+	p.MetricName = val
+}
+func (p *AlertRule) SetGroupKey(val *string) {
+	p.GroupKey = val
+}
+func (p *AlertRule) SetFilters(val *filter.FilterFields) {
+	p.Filters = val
+}
+func (p *AlertRule) SetCondition(val *AlertCondition) {
+	p.Condition = val
+}
+func (p *AlertRule) SetWindowSeconds(val int64) {
+	p.WindowSeconds = val
+}
+func (p *AlertRule) SetEnabled(val *bool) {
+	p.Enabled = val
+}
+func (p *AlertRule) SetNotification(val *AlertNotification) {
+	p.Notification = val
+}
+func (p *AlertRule) SetState(val *AlertState) {
+	p.State = val
+}
+func (p *AlertRule) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+
+var fieldIDToName_AlertRule = map[int16]string{
+	1:  "id",
+	2:  "workspace_id",
+	3:  "name",
+	4:  "platform_type",
+	5:  "metric_name",
+	6:  "group_key",
+	7:  "filters",
+	8:  "condition",
+	9:  "window_seconds",
+	10: "enabled",
+	11: "notification",
+	12: "state",
+	13: "base_info",
+}
+
+func (p *AlertRule) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *AlertRule) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *AlertRule) IsSetPlatformType() bool {
+	return p.PlatformType != nil
+}
+
+func (p *AlertRule) IsSetGroupKey() bool {
+	return p.GroupKey != nil
+}
+
+func (p *AlertRule) IsSetFilters() bool {
+	return p.Filters != nil
+}
+
+func (p *AlertRule) IsSetCondition() bool {
+	return p.Condition != nil
+}
+
+func (p *AlertRule) IsSetEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *AlertRule) IsSetNotification() bool {
+	return p.Notification != nil
+}
+
+func (p *AlertRule) IsSetState() bool {
+	return p.State != nil
+}
+
+func (p *AlertRule) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *AlertRule) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+	var issetMetricName bool = false
+	var issetCondition bool = false
+	var issetWindowSeconds bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMetricName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCondition = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWindowSeconds = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetName {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMetricName {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCondition {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWindowSeconds {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AlertRule[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AlertRule[fieldId]))
+}
+
+func (p *AlertRule) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *AlertRule) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *AlertRule) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *AlertRule) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *common.PlatformType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PlatformType = _field
+	return nil
+}
+func (p *AlertRule) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MetricName = _field
+	return nil
+}
+func (p *AlertRule) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.GroupKey = _field
+	return nil
+}
+func (p *AlertRule) ReadField7(iprot thrift.TProtocol) error {
+	_field := filter.NewFilterFields()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filters = _field
+	return nil
+}
+func (p *AlertRule) ReadField8(iprot thrift.TProtocol) error {
+	_field := NewAlertCondition()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Condition = _field
+	return nil
+}
+func (p *AlertRule) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WindowSeconds = _field
+	return nil
+}
+func (p *AlertRule) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Enabled = _field
+	return nil
+}
+func (p *AlertRule) ReadField11(iprot thrift.TProtocol) error {
+	_field := NewAlertNotification()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Notification = _field
+	return nil
+}
+func (p *AlertRule) ReadField12(iprot thrift.TProtocol) error {
+	_field := NewAlertState()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.State = _field
+	return nil
+}
+func (p *AlertRule) ReadField13(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+
+func (p *AlertRule) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AlertRule"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AlertRule) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AlertRule) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AlertRule) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *AlertRule) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlatformType() {
+		if err = oprot.WriteFieldBegin("platform_type", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PlatformType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *AlertRule) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("metric_name", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MetricName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *AlertRule) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGroupKey() {
+		if err = oprot.WriteFieldBegin("group_key", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.GroupKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *AlertRule) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *AlertRule) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("condition", thrift.STRUCT, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Condition.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *AlertRule) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("window_seconds", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WindowSeconds); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *AlertRule) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnabled() {
+		if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Enabled); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *AlertRule) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNotification() {
+		if err = oprot.WriteFieldBegin("notification", thrift.STRUCT, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Notification.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *AlertRule) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetState() {
+		if err = oprot.WriteFieldBegin("state", thrift.STRUCT, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.State.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *AlertRule) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *AlertRule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AlertRule(%+v)", *p)
+
+}
+
+func (p *AlertRule) DeepEqual(ano *AlertRule) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.PlatformType) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.MetricName) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.GroupKey) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Filters) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Condition) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.WindowSeconds) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Enabled) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.Notification) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.State) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	return true
+}
+
+func (p *AlertRule) Field1DeepEqual(src *int64) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if *p.ID != *src {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field2DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field4DeepEqual(src *common.PlatformType) bool {
+
+	if p.PlatformType == src {
+		return true
+	} else if p.PlatformType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PlatformType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field5DeepEqual(src string) bool {
+
+	if strings.Compare(p.MetricName, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field6DeepEqual(src *string) bool {
+
+	if p.GroupKey == src {
+		return true
+	} else if p.GroupKey == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.GroupKey, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field7DeepEqual(src *filter.FilterFields) bool {
+
+	if !p.Filters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field8DeepEqual(src *AlertCondition) bool {
+
+	if !p.Condition.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field9DeepEqual(src int64) bool {
+
+	if p.WindowSeconds != src {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field10DeepEqual(src *bool) bool {
+
+	if p.Enabled == src {
+		return true
+	} else if p.Enabled == nil || src == nil {
+		return false
+	}
+	if *p.Enabled != *src {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field11DeepEqual(src *AlertNotification) bool {
+
+	if !p.Notification.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field12DeepEqual(src *AlertState) bool {
+
+	if !p.State.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *AlertRule) Field13DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
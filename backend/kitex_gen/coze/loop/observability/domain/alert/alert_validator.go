@@ -0,0 +1,60 @@
+// Code generated by Validator v0.2.6. DO NOT EDIT.
+
+package alert
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// unused protection
+var (
+	_ = fmt.Formatter(nil)
+	_ = (*bytes.Buffer)(nil)
+	_ = (*strings.Builder)(nil)
+	_ = reflect.Type(nil)
+	_ = (*regexp.Regexp)(nil)
+	_ = time.Nanosecond
+)
+
+func (p *AlertCondition) IsValid() error {
+	return nil
+}
+func (p *AlertNotification) IsValid() error {
+	return nil
+}
+func (p *AlertState) IsValid() error {
+	return nil
+}
+func (p *AlertRule) IsValid() error {
+	if p.Filters != nil {
+		if err := p.Filters.IsValid(); err != nil {
+			return fmt.Errorf("field Filters not valid, %w", err)
+		}
+	}
+	if p.Condition != nil {
+		if err := p.Condition.IsValid(); err != nil {
+			return fmt.Errorf("field Condition not valid, %w", err)
+		}
+	}
+	if p.Notification != nil {
+		if err := p.Notification.IsValid(); err != nil {
+			return fmt.Errorf("field Notification not valid, %w", err)
+		}
+	}
+	if p.State != nil {
+		if err := p.State.IsValid(); err != nil {
+			return fmt.Errorf("field State not valid, %w", err)
+		}
+	}
+	if p.BaseInfo != nil {
+		if err := p.BaseInfo.IsValid(); err != nil {
+			return fmt.Errorf("field BaseInfo not valid, %w", err)
+		}
+	}
+	return nil
+}
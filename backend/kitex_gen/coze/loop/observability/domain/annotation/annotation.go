@@ -20,6 +20,8 @@ const (
 
 	AnnotationTypeOpenAPIFeedback = "openapi_feedback"
 
+	AnnotationTypeAutoTag = "auto_tag"
+
 	ValueTypeString = "string"
 
 	ValueTypeCategory = "category"
@@ -0,0 +1,2513 @@
+// Code generated by thriftgo (0.4.1). DO NOT EDIT.
+
+package dashboard
+
+import (
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/metric"
+	"strings"
+)
+
+const (
+	ChartTypeLine = "line"
+
+	ChartTypeBar = "bar"
+
+	ChartTypePie = "pie"
+
+	ChartTypeNumber = "number"
+
+	ChartTypeTable = "table"
+)
+
+type ChartType = string
+
+type PanelLayout struct {
+	X      *int32 `thrift:"x,1,optional" frugal:"1,optional,i32" form:"x" json:"x,omitempty" query:"x"`
+	Y      *int32 `thrift:"y,2,optional" frugal:"2,optional,i32" form:"y" json:"y,omitempty" query:"y"`
+	Width  *int32 `thrift:"width,3,optional" frugal:"3,optional,i32" form:"width" json:"width,omitempty" query:"width"`
+	Height *int32 `thrift:"height,4,optional" frugal:"4,optional,i32" form:"height" json:"height,omitempty" query:"height"`
+}
+
+func NewPanelLayout() *PanelLayout {
+	return &PanelLayout{}
+}
+
+func (p *PanelLayout) InitDefault() {
+}
+
+var PanelLayout_X_DEFAULT int32
+
+func (p *PanelLayout) GetX() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetX() {
+		return PanelLayout_X_DEFAULT
+	}
+	return *p.X
+}
+
+var PanelLayout_Y_DEFAULT int32
+
+func (p *PanelLayout) GetY() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetY() {
+		return PanelLayout_Y_DEFAULT
+	}
+	return *p.Y
+}
+
+var PanelLayout_Width_DEFAULT int32
+
+func (p *PanelLayout) GetWidth() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWidth() {
+		return PanelLayout_Width_DEFAULT
+	}
+	return *p.Width
+}
+
+var PanelLayout_Height_DEFAULT int32
+
+func (p *PanelLayout) GetHeight() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHeight() {
+		return PanelLayout_Height_DEFAULT
+	}
+	return *p.Height
+}
+func (p *PanelLayout) SetX(val *int32) {
+	p.X = val
+}
+func (p *PanelLayout) SetY(val *int32) {
+	p.Y = val
+}
+func (p *PanelLayout) SetWidth(val *int32) {
+	p.Width = val
+}
+func (p *PanelLayout) SetHeight(val *int32) {
+	p.Height = val
+}
+
+var fieldIDToName_PanelLayout = map[int16]string{
+	1: "x",
+	2: "y",
+	3: "width",
+	4: "height",
+}
+
+func (p *PanelLayout) IsSetX() bool {
+	return p.X != nil
+}
+
+func (p *PanelLayout) IsSetY() bool {
+	return p.Y != nil
+}
+
+func (p *PanelLayout) IsSetWidth() bool {
+	return p.Width != nil
+}
+
+func (p *PanelLayout) IsSetHeight() bool {
+	return p.Height != nil
+}
+
+func (p *PanelLayout) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PanelLayout[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PanelLayout) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.X = _field
+	return nil
+}
+func (p *PanelLayout) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Y = _field
+	return nil
+}
+func (p *PanelLayout) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Width = _field
+	return nil
+}
+func (p *PanelLayout) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Height = _field
+	return nil
+}
+
+func (p *PanelLayout) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PanelLayout"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PanelLayout) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetX() {
+		if err = oprot.WriteFieldBegin("x", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.X); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *PanelLayout) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetY() {
+		if err = oprot.WriteFieldBegin("y", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Y); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *PanelLayout) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWidth() {
+		if err = oprot.WriteFieldBegin("width", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Width); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *PanelLayout) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeight() {
+		if err = oprot.WriteFieldBegin("height", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Height); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *PanelLayout) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PanelLayout(%+v)", *p)
+
+}
+
+func (p *PanelLayout) DeepEqual(ano *PanelLayout) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.X) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Y) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Width) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Height) {
+		return false
+	}
+	return true
+}
+
+func (p *PanelLayout) Field1DeepEqual(src *int32) bool {
+
+	if p.X == src {
+		return true
+	} else if p.X == nil || src == nil {
+		return false
+	}
+	if *p.X != *src {
+		return false
+	}
+	return true
+}
+func (p *PanelLayout) Field2DeepEqual(src *int32) bool {
+
+	if p.Y == src {
+		return true
+	} else if p.Y == nil || src == nil {
+		return false
+	}
+	if *p.Y != *src {
+		return false
+	}
+	return true
+}
+func (p *PanelLayout) Field3DeepEqual(src *int32) bool {
+
+	if p.Width == src {
+		return true
+	} else if p.Width == nil || src == nil {
+		return false
+	}
+	if *p.Width != *src {
+		return false
+	}
+	return true
+}
+func (p *PanelLayout) Field4DeepEqual(src *int32) bool {
+
+	if p.Height == src {
+		return true
+	} else if p.Height == nil || src == nil {
+		return false
+	}
+	if *p.Height != *src {
+		return false
+	}
+	return true
+}
+
+// 面板引用预定义指标或即席聚合, 二者只能选其一
+type DashboardPanel struct {
+	// 看板内唯一
+	ID           string                `thrift:"id,1,required" frugal:"1,required,string" form:"id,required" json:"id,required" query:"id,required"`
+	Title        string                `thrift:"title,2,required" frugal:"2,required,string" form:"title,required" json:"title,required" query:"title,required"`
+	ChartType    ChartType             `thrift:"chart_type,3,required" frugal:"3,required,string" form:"chart_type,required" json:"chart_type,required" query:"chart_type,required"`
+	MetricNames  []string              `thrift:"metric_names,4,optional" frugal:"4,optional,list<string>" form:"metric_names" json:"metric_names,omitempty" query:"metric_names"`
+	Aggregations []*metric.Aggregation `thrift:"aggregations,5,optional" frugal:"5,optional,list<metric.Aggregation>" form:"aggregations" json:"aggregations,omitempty" query:"aggregations"`
+	// 仅即席聚合使用
+	GroupBys    []*filter.FilterField `thrift:"group_bys,6,optional" frugal:"6,optional,list<filter.FilterField>" form:"group_bys" json:"group_bys,omitempty" query:"group_bys"`
+	Filters     *filter.FilterFields  `thrift:"filters,7,optional" frugal:"7,optional,filter.FilterFields" form:"filters" json:"filters,omitempty" query:"filters"`
+	Granularity *string               `thrift:"granularity,8,optional" frugal:"8,optional,string" form:"granularity" json:"granularity,omitempty" query:"granularity"`
+	// 仅即席聚合使用
+	Limit  *int32       `thrift:"limit,9,optional" frugal:"9,optional,i32" form:"limit" json:"limit,omitempty" query:"limit"`
+	Layout *PanelLayout `thrift:"layout,10,optional" frugal:"10,optional,PanelLayout" form:"layout" json:"layout,omitempty" query:"layout"`
+}
+
+func NewDashboardPanel() *DashboardPanel {
+	return &DashboardPanel{}
+}
+
+func (p *DashboardPanel) InitDefault() {
+}
+
+func (p *DashboardPanel) GetID() (v string) {
+	if p != nil {
+		return p.ID
+	}
+	return
+}
+
+func (p *DashboardPanel) GetTitle() (v string) {
+	if p != nil {
+		return p.Title
+	}
+	return
+}
+
+func (p *DashboardPanel) GetChartType() (v ChartType) {
+	if p != nil {
+		return p.ChartType
+	}
+	return
+}
+
+var DashboardPanel_MetricNames_DEFAULT []string
+
+func (p *DashboardPanel) GetMetricNames() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMetricNames() {
+		return DashboardPanel_MetricNames_DEFAULT
+	}
+	return p.MetricNames
+}
+
+var DashboardPanel_Aggregations_DEFAULT []*metric.Aggregation
+
+func (p *DashboardPanel) GetAggregations() (v []*metric.Aggregation) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAggregations() {
+		return DashboardPanel_Aggregations_DEFAULT
+	}
+	return p.Aggregations
+}
+
+var DashboardPanel_GroupBys_DEFAULT []*filter.FilterField
+
+func (p *DashboardPanel) GetGroupBys() (v []*filter.FilterField) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGroupBys() {
+		return DashboardPanel_GroupBys_DEFAULT
+	}
+	return p.GroupBys
+}
+
+var DashboardPanel_Filters_DEFAULT *filter.FilterFields
+
+func (p *DashboardPanel) GetFilters() (v *filter.FilterFields) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilters() {
+		return DashboardPanel_Filters_DEFAULT
+	}
+	return p.Filters
+}
+
+var DashboardPanel_Granularity_DEFAULT string
+
+func (p *DashboardPanel) GetGranularity() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGranularity() {
+		return DashboardPanel_Granularity_DEFAULT
+	}
+	return *p.Granularity
+}
+
+var DashboardPanel_Limit_DEFAULT int32
+
+func (p *DashboardPanel) GetLimit() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLimit() {
+		return DashboardPanel_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+
+var DashboardPanel_Layout_DEFAULT *PanelLayout
+
+func (p *DashboardPanel) GetLayout() (v *PanelLayout) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLayout() {
+		return DashboardPanel_Layout_DEFAULT
+	}
+	return p.Layout
+}
+func (p *DashboardPanel) SetID(val string) {
+	p.ID = val
+}
+func (p *DashboardPanel) SetTitle(val string) {
+	p.Title = val
+}
+func (p *DashboardPanel) SetChartType(val ChartType) {
+	p.ChartType = val
+}
+func (p *DashboardPanel) SetMetricNames(val []string) {
+	p.MetricNames = val
+}
+func (p *DashboardPanel) SetAggregations(val []*metric.Aggregation) {
+	p.Aggregations = val
+}
+func (p *DashboardPanel) SetGroupBys(val []*filter.FilterField) {
+	p.GroupBys = val
+}
+func (p *DashboardPanel) SetFilters(val *filter.FilterFields) {
+	p.Filters = val
+}
+func (p *DashboardPanel) SetGranularity(val *string) {
+	p.Granularity = val
+}
+func (p *DashboardPanel) SetLimit(val *int32) {
+	p.Limit = val
+}
+func (p *DashboardPanel) SetLayout(val *PanelLayout) {
+	p.Layout = val
+}
+
+var fieldIDToName_DashboardPanel = map[int16]string{
+	1:  "id",
+	2:  "title",
+	3:  "chart_type",
+	4:  "metric_names",
+	5:  "aggregations",
+	6:  "group_bys",
+	7:  "filters",
+	8:  "granularity",
+	9:  "limit",
+	10: "layout",
+}
+
+func (p *DashboardPanel) IsSetMetricNames() bool {
+	return p.MetricNames != nil
+}
+
+func (p *DashboardPanel) IsSetAggregations() bool {
+	return p.Aggregations != nil
+}
+
+func (p *DashboardPanel) IsSetGroupBys() bool {
+	return p.GroupBys != nil
+}
+
+func (p *DashboardPanel) IsSetFilters() bool {
+	return p.Filters != nil
+}
+
+func (p *DashboardPanel) IsSetGranularity() bool {
+	return p.Granularity != nil
+}
+
+func (p *DashboardPanel) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *DashboardPanel) IsSetLayout() bool {
+	return p.Layout != nil
+}
+
+func (p *DashboardPanel) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetTitle bool = false
+	var issetChartType bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTitle = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetChartType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTitle {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetChartType {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DashboardPanel[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DashboardPanel[fieldId]))
+}
+
+func (p *DashboardPanel) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *DashboardPanel) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Title = _field
+	return nil
+}
+func (p *DashboardPanel) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field ChartType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ChartType = _field
+	return nil
+}
+func (p *DashboardPanel) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.MetricNames = _field
+	return nil
+}
+func (p *DashboardPanel) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*metric.Aggregation, 0, size)
+	values := make([]metric.Aggregation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Aggregations = _field
+	return nil
+}
+func (p *DashboardPanel) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*filter.FilterField, 0, size)
+	values := make([]filter.FilterField, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.GroupBys = _field
+	return nil
+}
+func (p *DashboardPanel) ReadField7(iprot thrift.TProtocol) error {
+	_field := filter.NewFilterFields()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filters = _field
+	return nil
+}
+func (p *DashboardPanel) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Granularity = _field
+	return nil
+}
+func (p *DashboardPanel) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Limit = _field
+	return nil
+}
+func (p *DashboardPanel) ReadField10(iprot thrift.TProtocol) error {
+	_field := NewPanelLayout()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Layout = _field
+	return nil
+}
+
+func (p *DashboardPanel) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DashboardPanel"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DashboardPanel) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DashboardPanel) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *DashboardPanel) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("chart_type", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ChartType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *DashboardPanel) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMetricNames() {
+		if err = oprot.WriteFieldBegin("metric_names", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.MetricNames)); err != nil {
+			return err
+		}
+		for _, v := range p.MetricNames {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *DashboardPanel) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAggregations() {
+		if err = oprot.WriteFieldBegin("aggregations", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Aggregations)); err != nil {
+			return err
+		}
+		for _, v := range p.Aggregations {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *DashboardPanel) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGroupBys() {
+		if err = oprot.WriteFieldBegin("group_bys", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.GroupBys)); err != nil {
+			return err
+		}
+		for _, v := range p.GroupBys {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *DashboardPanel) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *DashboardPanel) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGranularity() {
+		if err = oprot.WriteFieldBegin("granularity", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Granularity); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *DashboardPanel) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err = oprot.WriteFieldBegin("limit", thrift.I32, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Limit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *DashboardPanel) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLayout() {
+		if err = oprot.WriteFieldBegin("layout", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Layout.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *DashboardPanel) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DashboardPanel(%+v)", *p)
+
+}
+
+func (p *DashboardPanel) DeepEqual(ano *DashboardPanel) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Title) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ChartType) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.MetricNames) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Aggregations) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.GroupBys) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Filters) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Granularity) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Limit) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Layout) {
+		return false
+	}
+	return true
+}
+
+func (p *DashboardPanel) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.ID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *DashboardPanel) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Title, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *DashboardPanel) Field3DeepEqual(src ChartType) bool {
+
+	if strings.Compare(p.ChartType, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *DashboardPanel) Field4DeepEqual(src []string) bool {
+
+	if len(p.MetricNames) != len(src) {
+		return false
+	}
+	for i, v := range p.MetricNames {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *DashboardPanel) Field5DeepEqual(src []*metric.Aggregation) bool {
+
+	if len(p.Aggregations) != len(src) {
+		return false
+	}
+	for i, v := range p.Aggregations {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *DashboardPanel) Field6DeepEqual(src []*filter.FilterField) bool {
+
+	if len(p.GroupBys) != len(src) {
+		return false
+	}
+	for i, v := range p.GroupBys {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *DashboardPanel) Field7DeepEqual(src *filter.FilterFields) bool {
+
+	if !p.Filters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *DashboardPanel) Field8DeepEqual(src *string) bool {
+
+	if p.Granularity == src {
+		return true
+	} else if p.Granularity == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Granularity, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *DashboardPanel) Field9DeepEqual(src *int32) bool {
+
+	if p.Limit == src {
+		return true
+	} else if p.Limit == nil || src == nil {
+		return false
+	}
+	if *p.Limit != *src {
+		return false
+	}
+	return true
+}
+func (p *DashboardPanel) Field10DeepEqual(src *PanelLayout) bool {
+
+	if !p.Layout.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type Dashboard struct {
+	ID           *int64               `thrift:"id,1,optional" frugal:"1,optional,i64" json:"id" form:"id" query:"id"`
+	WorkspaceID  *int64               `thrift:"workspace_id,2,optional" frugal:"2,optional,i64" json:"workspace_id" form:"workspace_id" query:"workspace_id"`
+	Name         string               `thrift:"name,3,required" frugal:"3,required,string" form:"name,required" json:"name,required" query:"name,required"`
+	Description  *string              `thrift:"description,4,optional" frugal:"4,optional,string" form:"description" json:"description,omitempty" query:"description"`
+	PlatformType *common.PlatformType `thrift:"platform_type,5,optional" frugal:"5,optional,string" form:"platform_type" json:"platform_type,omitempty" query:"platform_type"`
+	// 作用于所有面板
+	Filters  *filter.FilterFields `thrift:"filters,6,optional" frugal:"6,optional,filter.FilterFields" form:"filters" json:"filters,omitempty" query:"filters"`
+	Panels   []*DashboardPanel    `thrift:"panels,7,optional" frugal:"7,optional,list<DashboardPanel>" form:"panels" json:"panels,omitempty" query:"panels"`
+	BaseInfo *common.BaseInfo     `thrift:"base_info,8,optional" frugal:"8,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+}
+
+func NewDashboard() *Dashboard {
+	return &Dashboard{}
+}
+
+func (p *Dashboard) InitDefault() {
+}
+
+var Dashboard_ID_DEFAULT int64
+
+func (p *Dashboard) GetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetID() {
+		return Dashboard_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var Dashboard_WorkspaceID_DEFAULT int64
+
+func (p *Dashboard) GetWorkspaceID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetWorkspaceID() {
+		return Dashboard_WorkspaceID_DEFAULT
+	}
+	return *p.WorkspaceID
+}
+
+func (p *Dashboard) GetName() (v string) {
+	if p != nil {
+		return p.Name
+	}
+	return
+}
+
+var Dashboard_Description_DEFAULT string
+
+func (p *Dashboard) GetDescription() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDescription() {
+		return Dashboard_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var Dashboard_PlatformType_DEFAULT common.PlatformType
+
+func (p *Dashboard) GetPlatformType() (v common.PlatformType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPlatformType() {
+		return Dashboard_PlatformType_DEFAULT
+	}
+	return *p.PlatformType
+}
+
+var Dashboard_Filters_DEFAULT *filter.FilterFields
+
+func (p *Dashboard) GetFilters() (v *filter.FilterFields) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilters() {
+		return Dashboard_Filters_DEFAULT
+	}
+	return p.Filters
+}
+
+var Dashboard_Panels_DEFAULT []*DashboardPanel
+
+func (p *Dashboard) GetPanels() (v []*DashboardPanel) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPanels() {
+		return Dashboard_Panels_DEFAULT
+	}
+	return p.Panels
+}
+
+var Dashboard_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *Dashboard) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return Dashboard_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+func (p *Dashboard) SetID(val *int64) {
+	p.ID = val
+}
+func (p *Dashboard) SetWorkspaceID(val *int64) {
+	p.WorkspaceID = val
+}
+func (p *Dashboard) SetName(val string) {
+	p.Name = val
+}
+func (p *Dashboard) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *Dashboard) SetPlatformType(val *common.PlatformType) {
+	p.PlatformType = val
+}
+func (p *Dashboard) SetFilters(val *filter.FilterFields) {
+	p.Filters = val
+}
+func (p *Dashboard) SetPanels(val []*DashboardPanel) {
+	p.Panels = val
+}
+func (p *Dashboard) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+
+var fieldIDToName_Dashboard = map[int16]string{
+	1: "id",
+	2: "workspace_id",
+	3: "name",
+	4: "description",
+	5: "platform_type",
+	6: "filters",
+	7: "panels",
+	8: "base_info",
+}
+
+func (p *Dashboard) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *Dashboard) IsSetWorkspaceID() bool {
+	return p.WorkspaceID != nil
+}
+
+func (p *Dashboard) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *Dashboard) IsSetPlatformType() bool {
+	return p.PlatformType != nil
+}
+
+func (p *Dashboard) IsSetFilters() bool {
+	return p.Filters != nil
+}
+
+func (p *Dashboard) IsSetPanels() bool {
+	return p.Panels != nil
+}
+
+func (p *Dashboard) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *Dashboard) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetName {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Dashboard[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Dashboard[fieldId]))
+}
+
+func (p *Dashboard) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *Dashboard) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *Dashboard) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *Dashboard) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *Dashboard) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *common.PlatformType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PlatformType = _field
+	return nil
+}
+func (p *Dashboard) ReadField6(iprot thrift.TProtocol) error {
+	_field := filter.NewFilterFields()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filters = _field
+	return nil
+}
+func (p *Dashboard) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*DashboardPanel, 0, size)
+	values := make([]DashboardPanel, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Panels = _field
+	return nil
+}
+func (p *Dashboard) ReadField8(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+
+func (p *Dashboard) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Dashboard"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Dashboard) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Dashboard) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWorkspaceID() {
+		if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.WorkspaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Dashboard) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Dashboard) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Dashboard) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlatformType() {
+		if err = oprot.WriteFieldBegin("platform_type", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PlatformType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Dashboard) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *Dashboard) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPanels() {
+		if err = oprot.WriteFieldBegin("panels", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Panels)); err != nil {
+			return err
+		}
+		for _, v := range p.Panels {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *Dashboard) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *Dashboard) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Dashboard(%+v)", *p)
+
+}
+
+func (p *Dashboard) DeepEqual(ano *Dashboard) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Name) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.PlatformType) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Filters) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Panels) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	return true
+}
+
+func (p *Dashboard) Field1DeepEqual(src *int64) bool {
+
+	if p.ID == src {
+		return true
+	} else if p.ID == nil || src == nil {
+		return false
+	}
+	if *p.ID != *src {
+		return false
+	}
+	return true
+}
+func (p *Dashboard) Field2DeepEqual(src *int64) bool {
+
+	if p.WorkspaceID == src {
+		return true
+	} else if p.WorkspaceID == nil || src == nil {
+		return false
+	}
+	if *p.WorkspaceID != *src {
+		return false
+	}
+	return true
+}
+func (p *Dashboard) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Name, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Dashboard) Field4DeepEqual(src *string) bool {
+
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Dashboard) Field5DeepEqual(src *common.PlatformType) bool {
+
+	if p.PlatformType == src {
+		return true
+	} else if p.PlatformType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PlatformType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Dashboard) Field6DeepEqual(src *filter.FilterFields) bool {
+
+	if !p.Filters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Dashboard) Field7DeepEqual(src []*DashboardPanel) bool {
+
+	if len(p.Panels) != len(src) {
+		return false
+	}
+	for i, v := range p.Panels {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *Dashboard) Field8DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type RenderedPanel struct {
+	PanelID string `thrift:"panel_id,1,required" frugal:"1,required,string" form:"panel_id,required" json:"panel_id,required" query:"panel_id,required"`
+	// 预定义指标面板返回
+	Metrics map[string]*metric.Metric `thrift:"metrics,2,optional" frugal:"2,optional,map<string:metric.Metric>" form:"metrics" json:"metrics,omitempty" query:"metrics"`
+	// 即席聚合面板返回
+	Rows []*metric.AggregationRow `thrift:"rows,3,optional" frugal:"3,optional,list<metric.AggregationRow>" form:"rows" json:"rows,omitempty" query:"rows"`
+	// 单个面板查询失败不影响其他面板
+	ErrorMsg *string `thrift:"error_msg,4,optional" frugal:"4,optional,string" form:"error_msg" json:"error_msg,omitempty" query:"error_msg"`
+}
+
+func NewRenderedPanel() *RenderedPanel {
+	return &RenderedPanel{}
+}
+
+func (p *RenderedPanel) InitDefault() {
+}
+
+func (p *RenderedPanel) GetPanelID() (v string) {
+	if p != nil {
+		return p.PanelID
+	}
+	return
+}
+
+var RenderedPanel_Metrics_DEFAULT map[string]*metric.Metric
+
+func (p *RenderedPanel) GetMetrics() (v map[string]*metric.Metric) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMetrics() {
+		return RenderedPanel_Metrics_DEFAULT
+	}
+	return p.Metrics
+}
+
+var RenderedPanel_Rows_DEFAULT []*metric.AggregationRow
+
+func (p *RenderedPanel) GetRows() (v []*metric.AggregationRow) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRows() {
+		return RenderedPanel_Rows_DEFAULT
+	}
+	return p.Rows
+}
+
+var RenderedPanel_ErrorMsg_DEFAULT string
+
+func (p *RenderedPanel) GetErrorMsg() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetErrorMsg() {
+		return RenderedPanel_ErrorMsg_DEFAULT
+	}
+	return *p.ErrorMsg
+}
+func (p *RenderedPanel) SetPanelID(val string) {
+	p.PanelID = val
+}
+func (p *RenderedPanel) SetMetrics(val map[string]*metric.Metric) {
+	p.Metrics = val
+}
+func (p *RenderedPanel) SetRows(val []*metric.AggregationRow) {
+	p.Rows = val
+}
+func (p *RenderedPanel) SetErrorMsg(val *string) {
+	p.ErrorMsg = val
+}
+
+var fieldIDToName_RenderedPanel = map[int16]string{
+	1: "panel_id",
+	2: "metrics",
+	3: "rows",
+	4: "error_msg",
+}
+
+func (p *RenderedPanel) IsSetMetrics() bool {
+	return p.Metrics != nil
+}
+
+func (p *RenderedPanel) IsSetRows() bool {
+	return p.Rows != nil
+}
+
+func (p *RenderedPanel) IsSetErrorMsg() bool {
+	return p.ErrorMsg != nil
+}
+
+func (p *RenderedPanel) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPanelID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPanelID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPanelID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RenderedPanel[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RenderedPanel[fieldId]))
+}
+
+func (p *RenderedPanel) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PanelID = _field
+	return nil
+}
+func (p *RenderedPanel) ReadField2(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*metric.Metric, size)
+	values := make([]metric.Metric, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Metrics = _field
+	return nil
+}
+func (p *RenderedPanel) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*metric.AggregationRow, 0, size)
+	values := make([]metric.AggregationRow, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Rows = _field
+	return nil
+}
+func (p *RenderedPanel) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ErrorMsg = _field
+	return nil
+}
+
+func (p *RenderedPanel) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RenderedPanel"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RenderedPanel) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("panel_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PanelID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RenderedPanel) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMetrics() {
+		if err = oprot.WriteFieldBegin("metrics", thrift.MAP, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.Metrics)); err != nil {
+			return err
+		}
+		for k, v := range p.Metrics {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RenderedPanel) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRows() {
+		if err = oprot.WriteFieldBegin("rows", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Rows)); err != nil {
+			return err
+		}
+		for _, v := range p.Rows {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RenderedPanel) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorMsg() {
+		if err = oprot.WriteFieldBegin("error_msg", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ErrorMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *RenderedPanel) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RenderedPanel(%+v)", *p)
+
+}
+
+func (p *RenderedPanel) DeepEqual(ano *RenderedPanel) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.PanelID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Metrics) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Rows) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ErrorMsg) {
+		return false
+	}
+	return true
+}
+
+func (p *RenderedPanel) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.PanelID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RenderedPanel) Field2DeepEqual(src map[string]*metric.Metric) bool {
+
+	if len(p.Metrics) != len(src) {
+		return false
+	}
+	for k, v := range p.Metrics {
+		_src := src[k]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *RenderedPanel) Field3DeepEqual(src []*metric.AggregationRow) bool {
+
+	if len(p.Rows) != len(src) {
+		return false
+	}
+	for i, v := range p.Rows {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *RenderedPanel) Field4DeepEqual(src *string) bool {
+
+	if p.ErrorMsg == src {
+		return true
+	} else if p.ErrorMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ErrorMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+// Code generated by Validator v0.2.6. DO NOT EDIT.
+
+package dashboard
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// unused protection
+var (
+	_ = fmt.Formatter(nil)
+	_ = (*bytes.Buffer)(nil)
+	_ = (*strings.Builder)(nil)
+	_ = reflect.Type(nil)
+	_ = (*regexp.Regexp)(nil)
+	_ = time.Nanosecond
+)
+
+func (p *PanelLayout) IsValid() error {
+	return nil
+}
+func (p *DashboardPanel) IsValid() error {
+	if p.Filters != nil {
+		if err := p.Filters.IsValid(); err != nil {
+			return fmt.Errorf("field Filters not valid, %w", err)
+		}
+	}
+	if p.Layout != nil {
+		if err := p.Layout.IsValid(); err != nil {
+			return fmt.Errorf("field Layout not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *Dashboard) IsValid() error {
+	if p.Filters != nil {
+		if err := p.Filters.IsValid(); err != nil {
+			return fmt.Errorf("field Filters not valid, %w", err)
+		}
+	}
+	if p.BaseInfo != nil {
+		if err := p.BaseInfo.IsValid(); err != nil {
+			return fmt.Errorf("field BaseInfo not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *RenderedPanel) IsValid() error {
+	return nil
+}
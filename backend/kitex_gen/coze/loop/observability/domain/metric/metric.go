@@ -5,6 +5,7 @@ package metric
 import (
 	"fmt"
 	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
 	"strings"
 )
 
@@ -18,12 +19,32 @@ const (
 	DrillDownValueTypeToolName = "tool_name"
 
 	DrillDownValueTypeInnerModelName = "inner_model_name"
+
+	AggregationTypeCount = "count"
+
+	AggregationTypeSum = "sum"
+
+	AggregationTypeAvg = "avg"
+
+	AggregationTypeMin = "min"
+
+	AggregationTypeMax = "max"
+
+	AggregationTypeP50 = "p50"
+
+	AggregationTypeP90 = "p90"
+
+	AggregationTypeP99 = "p99"
+
+	AggregationTypeUniq = "uniq"
 )
 
 type CompareType = string
 
 type DrillDownValueType = string
 
+type AggregationType = string
+
 type Metric struct {
 	Summary    *string                   `thrift:"summary,1,optional" frugal:"1,optional,string" form:"summary" json:"summary,omitempty" query:"summary"`
 	Pie        map[string]string         `thrift:"pie,2,optional" frugal:"2,optional,map<string:string>" form:"pie" json:"pie,omitempty" query:"pie"`
@@ -703,6 +724,725 @@ func (p *MetricPoint) Field2DeepEqual(src *string) bool {
 	return true
 }
 
+// 即席聚合, count以外的聚合方式需要指定字段
+type Aggregation struct {
+	AggregationType AggregationType     `thrift:"aggregation_type,1,required" frugal:"1,required,string" form:"aggregation_type,required" json:"aggregation_type,required" query:"aggregation_type,required"`
+	Field           *filter.FilterField `thrift:"field,2,optional" frugal:"2,optional,filter.FilterField" form:"field" json:"field,omitempty" query:"field"`
+	// 结果中的名称, 为空时使用aggregation_type(field_name)
+	Alias *string `thrift:"alias,3,optional" frugal:"3,optional,string" form:"alias" json:"alias,omitempty" query:"alias"`
+}
+
+func NewAggregation() *Aggregation {
+	return &Aggregation{}
+}
+
+func (p *Aggregation) InitDefault() {
+}
+
+func (p *Aggregation) GetAggregationType() (v AggregationType) {
+	if p != nil {
+		return p.AggregationType
+	}
+	return
+}
+
+var Aggregation_Field_DEFAULT *filter.FilterField
+
+func (p *Aggregation) GetField() (v *filter.FilterField) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetField() {
+		return Aggregation_Field_DEFAULT
+	}
+	return p.Field
+}
+
+var Aggregation_Alias_DEFAULT string
+
+func (p *Aggregation) GetAlias() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAlias() {
+		return Aggregation_Alias_DEFAULT
+	}
+	return *p.Alias
+}
+func (p *Aggregation) SetAggregationType(val AggregationType) {
+	p.AggregationType = val
+}
+func (p *Aggregation) SetField(val *filter.FilterField) {
+	p.Field = val
+}
+func (p *Aggregation) SetAlias(val *string) {
+	p.Alias = val
+}
+
+var fieldIDToName_Aggregation = map[int16]string{
+	1: "aggregation_type",
+	2: "field",
+	3: "alias",
+}
+
+func (p *Aggregation) IsSetField() bool {
+	return p.Field != nil
+}
+
+func (p *Aggregation) IsSetAlias() bool {
+	return p.Alias != nil
+}
+
+func (p *Aggregation) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAggregationType bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAggregationType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAggregationType {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Aggregation[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Aggregation[fieldId]))
+}
+
+func (p *Aggregation) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field AggregationType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AggregationType = _field
+	return nil
+}
+func (p *Aggregation) ReadField2(iprot thrift.TProtocol) error {
+	_field := filter.NewFilterField()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Field = _field
+	return nil
+}
+func (p *Aggregation) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Alias = _field
+	return nil
+}
+
+func (p *Aggregation) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Aggregation"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Aggregation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("aggregation_type", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AggregationType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Aggregation) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetField() {
+		if err = oprot.WriteFieldBegin("field", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Field.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Aggregation) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlias() {
+		if err = oprot.WriteFieldBegin("alias", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Alias); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *Aggregation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Aggregation(%+v)", *p)
+
+}
+
+func (p *Aggregation) DeepEqual(ano *Aggregation) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.AggregationType) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Field) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Alias) {
+		return false
+	}
+	return true
+}
+
+func (p *Aggregation) Field1DeepEqual(src AggregationType) bool {
+
+	if strings.Compare(p.AggregationType, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Aggregation) Field2DeepEqual(src *filter.FilterField) bool {
+
+	if !p.Field.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *Aggregation) Field3DeepEqual(src *string) bool {
+
+	if p.Alias == src {
+		return true
+	} else if p.Alias == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Alias, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type AggregationRow struct {
+	// 指定时间粒度时返回
+	Timestamp *string `thrift:"timestamp,1,optional" frugal:"1,optional,string" form:"timestamp" json:"timestamp,omitempty" query:"timestamp"`
+	// key为group by的字段名
+	GroupByValues map[string]string `thrift:"group_by_values,2,optional" frugal:"2,optional,map<string:string>" form:"group_by_values" json:"group_by_values,omitempty" query:"group_by_values"`
+	// key为聚合的名称
+	Values map[string]string `thrift:"values,3,optional" frugal:"3,optional,map<string:string>" form:"values" json:"values,omitempty" query:"values"`
+}
+
+func NewAggregationRow() *AggregationRow {
+	return &AggregationRow{}
+}
+
+func (p *AggregationRow) InitDefault() {
+}
+
+var AggregationRow_Timestamp_DEFAULT string
+
+func (p *AggregationRow) GetTimestamp() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTimestamp() {
+		return AggregationRow_Timestamp_DEFAULT
+	}
+	return *p.Timestamp
+}
+
+var AggregationRow_GroupByValues_DEFAULT map[string]string
+
+func (p *AggregationRow) GetGroupByValues() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetGroupByValues() {
+		return AggregationRow_GroupByValues_DEFAULT
+	}
+	return p.GroupByValues
+}
+
+var AggregationRow_Values_DEFAULT map[string]string
+
+func (p *AggregationRow) GetValues() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetValues() {
+		return AggregationRow_Values_DEFAULT
+	}
+	return p.Values
+}
+func (p *AggregationRow) SetTimestamp(val *string) {
+	p.Timestamp = val
+}
+func (p *AggregationRow) SetGroupByValues(val map[string]string) {
+	p.GroupByValues = val
+}
+func (p *AggregationRow) SetValues(val map[string]string) {
+	p.Values = val
+}
+
+var fieldIDToName_AggregationRow = map[int16]string{
+	1: "timestamp",
+	2: "group_by_values",
+	3: "values",
+}
+
+func (p *AggregationRow) IsSetTimestamp() bool {
+	return p.Timestamp != nil
+}
+
+func (p *AggregationRow) IsSetGroupByValues() bool {
+	return p.GroupByValues != nil
+}
+
+func (p *AggregationRow) IsSetValues() bool {
+	return p.Values != nil
+}
+
+func (p *AggregationRow) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AggregationRow[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AggregationRow) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Timestamp = _field
+	return nil
+}
+func (p *AggregationRow) ReadField2(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.GroupByValues = _field
+	return nil
+}
+func (p *AggregationRow) ReadField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Values = _field
+	return nil
+}
+
+func (p *AggregationRow) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AggregationRow"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AggregationRow) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimestamp() {
+		if err = oprot.WriteFieldBegin("timestamp", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Timestamp); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AggregationRow) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGroupByValues() {
+		if err = oprot.WriteFieldBegin("group_by_values", thrift.MAP, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.GroupByValues)); err != nil {
+			return err
+		}
+		for k, v := range p.GroupByValues {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AggregationRow) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValues() {
+		if err = oprot.WriteFieldBegin("values", thrift.MAP, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Values)); err != nil {
+			return err
+		}
+		for k, v := range p.Values {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AggregationRow) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AggregationRow(%+v)", *p)
+
+}
+
+func (p *AggregationRow) DeepEqual(ano *AggregationRow) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Timestamp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.GroupByValues) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Values) {
+		return false
+	}
+	return true
+}
+
+func (p *AggregationRow) Field1DeepEqual(src *string) bool {
+
+	if p.Timestamp == src {
+		return true
+	} else if p.Timestamp == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Timestamp, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AggregationRow) Field2DeepEqual(src map[string]string) bool {
+
+	if len(p.GroupByValues) != len(src) {
+		return false
+	}
+	for k, v := range p.GroupByValues {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *AggregationRow) Field3DeepEqual(src map[string]string) bool {
+
+	if len(p.Values) != len(src) {
+		return false
+	}
+	for k, v := range p.Values {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 type Compare struct {
 	CompareType  *CompareType `thrift:"compare_type,1,optional" frugal:"1,optional,string" form:"compare_type" json:"compare_type,omitempty" query:"compare_type"`
 	ShiftSeconds *int64       `thrift:"shift_seconds,2,optional" frugal:"2,optional,i64" json:"shift_seconds" form:"shift_seconds" query:"shift_seconds"`
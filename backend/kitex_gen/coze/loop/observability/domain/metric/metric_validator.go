@@ -27,6 +27,17 @@ func (p *Metric) IsValid() error {
 func (p *MetricPoint) IsValid() error {
 	return nil
 }
+func (p *Aggregation) IsValid() error {
+	if p.Field != nil {
+		if err := p.Field.IsValid(); err != nil {
+			return fmt.Errorf("field Field not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *AggregationRow) IsValid() error {
+	return nil
+}
 func (p *Compare) IsValid() error {
 	if p.ShiftSeconds != nil {
 		if *p.ShiftSeconds <= int64(0) {
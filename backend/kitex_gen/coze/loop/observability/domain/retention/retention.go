@@ -0,0 +1,878 @@
+// Code generated by thriftgo (0.4.1). DO NOT EDIT.
+
+package retention
+
+import (
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	"strings"
+)
+
+const (
+	TTL3d = "3d"
+
+	TTL7d = "7d"
+
+	TTL30d = "30d"
+
+	TTL90d = "90d"
+
+	TTL180d = "180d"
+
+	TTL365d = "365d"
+)
+
+type TTL = string
+
+type RetentionRule struct {
+	// 命中的span类型，如model、prompt
+	SpanTypes []string `thrift:"span_types,1,required" frugal:"1,required,list<string>" form:"span_types,required" json:"span_types,required" query:"span_types,required"`
+	TTL       TTL      `thrift:"ttl,2,required" frugal:"2,required,string" form:"ttl,required" json:"ttl,required" query:"ttl,required"`
+}
+
+func NewRetentionRule() *RetentionRule {
+	return &RetentionRule{}
+}
+
+func (p *RetentionRule) InitDefault() {
+}
+
+func (p *RetentionRule) GetSpanTypes() (v []string) {
+	if p != nil {
+		return p.SpanTypes
+	}
+	return
+}
+
+func (p *RetentionRule) GetTTL() (v TTL) {
+	if p != nil {
+		return p.TTL
+	}
+	return
+}
+func (p *RetentionRule) SetSpanTypes(val []string) {
+	p.SpanTypes = val
+}
+func (p *RetentionRule) SetTTL(val TTL) {
+	p.TTL = val
+}
+
+var fieldIDToName_RetentionRule = map[int16]string{
+	1: "span_types",
+	2: "ttl",
+}
+
+func (p *RetentionRule) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSpanTypes bool = false
+	var issetTTL bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpanTypes = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTTL = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSpanTypes {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTTL {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RetentionRule[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RetentionRule[fieldId]))
+}
+
+func (p *RetentionRule) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.SpanTypes = _field
+	return nil
+}
+func (p *RetentionRule) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field TTL
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TTL = _field
+	return nil
+}
+
+func (p *RetentionRule) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RetentionRule"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RetentionRule) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("span_types", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.SpanTypes)); err != nil {
+		return err
+	}
+	for _, v := range p.SpanTypes {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RetentionRule) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("ttl", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TTL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RetentionRule) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RetentionRule(%+v)", *p)
+
+}
+
+func (p *RetentionRule) DeepEqual(ano *RetentionRule) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SpanTypes) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TTL) {
+		return false
+	}
+	return true
+}
+
+func (p *RetentionRule) Field1DeepEqual(src []string) bool {
+
+	if len(p.SpanTypes) != len(src) {
+		return false
+	}
+	for i, v := range p.SpanTypes {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *RetentionRule) Field2DeepEqual(src TTL) bool {
+
+	if strings.Compare(p.TTL, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type RetentionPolicy struct {
+	// 未命中规则的span的保留时长，为空时按空间权益保留
+	DefaultTTL *TTL `thrift:"default_ttl,1,optional" frugal:"1,optional,string" form:"default_ttl" json:"default_ttl,omitempty" query:"default_ttl"`
+	// 按顺序匹配，命中第一条规则
+	Rules []*RetentionRule `thrift:"rules,2,optional" frugal:"2,optional,list<RetentionRule>" form:"rules" json:"rules,omitempty" query:"rules"`
+	// 有标注的span在过期前转存到长期存储，转存后保留365天
+	KeepAnnotated *bool `thrift:"keep_annotated,3,optional" frugal:"3,optional,bool" form:"keep_annotated" json:"keep_annotated,omitempty" query:"keep_annotated"`
+	// 被导入数据集的span在过期前转存到长期存储，转存后保留365天
+	KeepDatasetReferenced *bool            `thrift:"keep_dataset_referenced,4,optional" frugal:"4,optional,bool" form:"keep_dataset_referenced" json:"keep_dataset_referenced,omitempty" query:"keep_dataset_referenced"`
+	BaseInfo              *common.BaseInfo `thrift:"base_info,5,optional" frugal:"5,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	// 转存的span永久保留，每次过期前重新转存；需同时开启keep_annotated或keep_dataset_referenced
+	ArchivePermanently *bool `thrift:"archive_permanently,6,optional" frugal:"6,optional,bool" form:"archive_permanently" json:"archive_permanently,omitempty" query:"archive_permanently"`
+}
+
+func NewRetentionPolicy() *RetentionPolicy {
+	return &RetentionPolicy{}
+}
+
+func (p *RetentionPolicy) InitDefault() {
+}
+
+var RetentionPolicy_DefaultTTL_DEFAULT TTL
+
+func (p *RetentionPolicy) GetDefaultTTL() (v TTL) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDefaultTTL() {
+		return RetentionPolicy_DefaultTTL_DEFAULT
+	}
+	return *p.DefaultTTL
+}
+
+var RetentionPolicy_Rules_DEFAULT []*RetentionRule
+
+func (p *RetentionPolicy) GetRules() (v []*RetentionRule) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRules() {
+		return RetentionPolicy_Rules_DEFAULT
+	}
+	return p.Rules
+}
+
+var RetentionPolicy_KeepAnnotated_DEFAULT bool
+
+func (p *RetentionPolicy) GetKeepAnnotated() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKeepAnnotated() {
+		return RetentionPolicy_KeepAnnotated_DEFAULT
+	}
+	return *p.KeepAnnotated
+}
+
+var RetentionPolicy_KeepDatasetReferenced_DEFAULT bool
+
+func (p *RetentionPolicy) GetKeepDatasetReferenced() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetKeepDatasetReferenced() {
+		return RetentionPolicy_KeepDatasetReferenced_DEFAULT
+	}
+	return *p.KeepDatasetReferenced
+}
+
+var RetentionPolicy_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *RetentionPolicy) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return RetentionPolicy_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+
+var RetentionPolicy_ArchivePermanently_DEFAULT bool
+
+func (p *RetentionPolicy) GetArchivePermanently() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetArchivePermanently() {
+		return RetentionPolicy_ArchivePermanently_DEFAULT
+	}
+	return *p.ArchivePermanently
+}
+func (p *RetentionPolicy) SetDefaultTTL(val *TTL) {
+	p.DefaultTTL = val
+}
+func (p *RetentionPolicy) SetRules(val []*RetentionRule) {
+	p.Rules = val
+}
+func (p *RetentionPolicy) SetKeepAnnotated(val *bool) {
+	p.KeepAnnotated = val
+}
+func (p *RetentionPolicy) SetKeepDatasetReferenced(val *bool) {
+	p.KeepDatasetReferenced = val
+}
+func (p *RetentionPolicy) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+func (p *RetentionPolicy) SetArchivePermanently(val *bool) {
+	p.ArchivePermanently = val
+}
+
+var fieldIDToName_RetentionPolicy = map[int16]string{
+	1: "default_ttl",
+	2: "rules",
+	3: "keep_annotated",
+	4: "keep_dataset_referenced",
+	5: "base_info",
+	6: "archive_permanently",
+}
+
+func (p *RetentionPolicy) IsSetDefaultTTL() bool {
+	return p.DefaultTTL != nil
+}
+
+func (p *RetentionPolicy) IsSetRules() bool {
+	return p.Rules != nil
+}
+
+func (p *RetentionPolicy) IsSetKeepAnnotated() bool {
+	return p.KeepAnnotated != nil
+}
+
+func (p *RetentionPolicy) IsSetKeepDatasetReferenced() bool {
+	return p.KeepDatasetReferenced != nil
+}
+
+func (p *RetentionPolicy) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *RetentionPolicy) IsSetArchivePermanently() bool {
+	return p.ArchivePermanently != nil
+}
+
+func (p *RetentionPolicy) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RetentionPolicy[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RetentionPolicy) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *TTL
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DefaultTTL = _field
+	return nil
+}
+func (p *RetentionPolicy) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*RetentionRule, 0, size)
+	values := make([]RetentionRule, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Rules = _field
+	return nil
+}
+func (p *RetentionPolicy) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KeepAnnotated = _field
+	return nil
+}
+func (p *RetentionPolicy) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KeepDatasetReferenced = _field
+	return nil
+}
+func (p *RetentionPolicy) ReadField5(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+func (p *RetentionPolicy) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ArchivePermanently = _field
+	return nil
+}
+
+func (p *RetentionPolicy) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RetentionPolicy"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RetentionPolicy) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDefaultTTL() {
+		if err = oprot.WriteFieldBegin("default_ttl", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DefaultTTL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RetentionPolicy) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRules() {
+		if err = oprot.WriteFieldBegin("rules", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Rules)); err != nil {
+			return err
+		}
+		for _, v := range p.Rules {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RetentionPolicy) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeepAnnotated() {
+		if err = oprot.WriteFieldBegin("keep_annotated", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.KeepAnnotated); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RetentionPolicy) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeepDatasetReferenced() {
+		if err = oprot.WriteFieldBegin("keep_dataset_referenced", thrift.BOOL, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.KeepDatasetReferenced); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *RetentionPolicy) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *RetentionPolicy) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetArchivePermanently() {
+		if err = oprot.WriteFieldBegin("archive_permanently", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.ArchivePermanently); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *RetentionPolicy) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RetentionPolicy(%+v)", *p)
+
+}
+
+func (p *RetentionPolicy) DeepEqual(ano *RetentionPolicy) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.DefaultTTL) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Rules) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.KeepAnnotated) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.KeepDatasetReferenced) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.ArchivePermanently) {
+		return false
+	}
+	return true
+}
+
+func (p *RetentionPolicy) Field1DeepEqual(src *TTL) bool {
+
+	if p.DefaultTTL == src {
+		return true
+	} else if p.DefaultTTL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.DefaultTTL, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RetentionPolicy) Field2DeepEqual(src []*RetentionRule) bool {
+
+	if len(p.Rules) != len(src) {
+		return false
+	}
+	for i, v := range p.Rules {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *RetentionPolicy) Field3DeepEqual(src *bool) bool {
+
+	if p.KeepAnnotated == src {
+		return true
+	} else if p.KeepAnnotated == nil || src == nil {
+		return false
+	}
+	if *p.KeepAnnotated != *src {
+		return false
+	}
+	return true
+}
+func (p *RetentionPolicy) Field4DeepEqual(src *bool) bool {
+
+	if p.KeepDatasetReferenced == src {
+		return true
+	} else if p.KeepDatasetReferenced == nil || src == nil {
+		return false
+	}
+	if *p.KeepDatasetReferenced != *src {
+		return false
+	}
+	return true
+}
+func (p *RetentionPolicy) Field5DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *RetentionPolicy) Field6DeepEqual(src *bool) bool {
+
+	if p.ArchivePermanently == src {
+		return true
+	} else if p.ArchivePermanently == nil || src == nil {
+		return false
+	}
+	if *p.ArchivePermanently != *src {
+		return false
+	}
+	return true
+}
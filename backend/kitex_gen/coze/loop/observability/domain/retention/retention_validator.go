@@ -0,0 +1,34 @@
+// Code generated by Validator v0.2.6. DO NOT EDIT.
+
+package retention
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// unused protection
+var (
+	_ = fmt.Formatter(nil)
+	_ = (*bytes.Buffer)(nil)
+	_ = (*strings.Builder)(nil)
+	_ = reflect.Type(nil)
+	_ = (*regexp.Regexp)(nil)
+	_ = time.Nanosecond
+)
+
+func (p *RetentionRule) IsValid() error {
+	return nil
+}
+func (p *RetentionPolicy) IsValid() error {
+	if p.BaseInfo != nil {
+		if err := p.BaseInfo.IsValid(); err != nil {
+			return fmt.Errorf("field BaseInfo not valid, %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1280 @@
+// Code generated by thriftgo (0.4.1). DO NOT EDIT.
+
+package session
+
+import (
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/span"
+	"strings"
+)
+
+// 会话中的一轮，对应一条trace
+type SessionTurn struct {
+	TraceID string `thrift:"trace_id,1,required" frugal:"1,required,string" form:"trace_id,required" json:"trace_id,required" query:"trace_id,required"`
+	// 轮次，从1开始
+	Turn int32 `thrift:"turn,2,required" frugal:"2,required,i32" form:"turn,required" json:"turn,required" query:"turn,required"`
+	// ms
+	StartTime int64 `thrift:"start_time,3,required" frugal:"3,required,i64" json:"start_time" form:"start_time,required" query:"start_time,required"`
+	// ms
+	Latency      int64            `thrift:"latency,4,required" frugal:"4,required,i64" json:"latency" form:"latency,required" query:"latency,required"`
+	InputTokens  int64            `thrift:"input_tokens,5,required" frugal:"5,required,i64" json:"input_tokens" form:"input_tokens,required" query:"input_tokens,required"`
+	OutputTokens int64            `thrift:"output_tokens,6,required" frugal:"6,required,i64" json:"output_tokens" form:"output_tokens,required" query:"output_tokens,required"`
+	RootSpan     *span.OutputSpan `thrift:"root_span,7,optional" frugal:"7,optional,span.OutputSpan" form:"root_span" json:"root_span,omitempty" query:"root_span"`
+}
+
+func NewSessionTurn() *SessionTurn {
+	return &SessionTurn{}
+}
+
+func (p *SessionTurn) InitDefault() {
+}
+
+func (p *SessionTurn) GetTraceID() (v string) {
+	if p != nil {
+		return p.TraceID
+	}
+	return
+}
+
+func (p *SessionTurn) GetTurn() (v int32) {
+	if p != nil {
+		return p.Turn
+	}
+	return
+}
+
+func (p *SessionTurn) GetStartTime() (v int64) {
+	if p != nil {
+		return p.StartTime
+	}
+	return
+}
+
+func (p *SessionTurn) GetLatency() (v int64) {
+	if p != nil {
+		return p.Latency
+	}
+	return
+}
+
+func (p *SessionTurn) GetInputTokens() (v int64) {
+	if p != nil {
+		return p.InputTokens
+	}
+	return
+}
+
+func (p *SessionTurn) GetOutputTokens() (v int64) {
+	if p != nil {
+		return p.OutputTokens
+	}
+	return
+}
+
+var SessionTurn_RootSpan_DEFAULT *span.OutputSpan
+
+func (p *SessionTurn) GetRootSpan() (v *span.OutputSpan) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetRootSpan() {
+		return SessionTurn_RootSpan_DEFAULT
+	}
+	return p.RootSpan
+}
+func (p *SessionTurn) SetTraceID(val string) {
+	p.TraceID = val
+}
+func (p *SessionTurn) SetTurn(val int32) {
+	p.Turn = val
+}
+func (p *SessionTurn) SetStartTime(val int64) {
+	p.StartTime = val
+}
+func (p *SessionTurn) SetLatency(val int64) {
+	p.Latency = val
+}
+func (p *SessionTurn) SetInputTokens(val int64) {
+	p.InputTokens = val
+}
+func (p *SessionTurn) SetOutputTokens(val int64) {
+	p.OutputTokens = val
+}
+func (p *SessionTurn) SetRootSpan(val *span.OutputSpan) {
+	p.RootSpan = val
+}
+
+var fieldIDToName_SessionTurn = map[int16]string{
+	1: "trace_id",
+	2: "turn",
+	3: "start_time",
+	4: "latency",
+	5: "input_tokens",
+	6: "output_tokens",
+	7: "root_span",
+}
+
+func (p *SessionTurn) IsSetRootSpan() bool {
+	return p.RootSpan != nil
+}
+
+func (p *SessionTurn) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetTraceID bool = false
+	var issetTurn bool = false
+	var issetStartTime bool = false
+	var issetLatency bool = false
+	var issetInputTokens bool = false
+	var issetOutputTokens bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTraceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTurn = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStartTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLatency = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetInputTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOutputTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetTraceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTurn {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStartTime {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLatency {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetInputTokens {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOutputTokens {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SessionTurn[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SessionTurn[fieldId]))
+}
+
+func (p *SessionTurn) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TraceID = _field
+	return nil
+}
+func (p *SessionTurn) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Turn = _field
+	return nil
+}
+func (p *SessionTurn) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *SessionTurn) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Latency = _field
+	return nil
+}
+func (p *SessionTurn) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.InputTokens = _field
+	return nil
+}
+func (p *SessionTurn) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OutputTokens = _field
+	return nil
+}
+func (p *SessionTurn) ReadField7(iprot thrift.TProtocol) error {
+	_field := span.NewOutputSpan()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.RootSpan = _field
+	return nil
+}
+
+func (p *SessionTurn) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SessionTurn"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SessionTurn) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("trace_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TraceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *SessionTurn) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("turn", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Turn); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *SessionTurn) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *SessionTurn) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("latency", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Latency); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *SessionTurn) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("input_tokens", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.InputTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *SessionTurn) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("output_tokens", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OutputTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *SessionTurn) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRootSpan() {
+		if err = oprot.WriteFieldBegin("root_span", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.RootSpan.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SessionTurn) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SessionTurn(%+v)", *p)
+
+}
+
+func (p *SessionTurn) DeepEqual(ano *SessionTurn) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.TraceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Turn) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Latency) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.InputTokens) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.OutputTokens) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.RootSpan) {
+		return false
+	}
+	return true
+}
+
+func (p *SessionTurn) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.TraceID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SessionTurn) Field2DeepEqual(src int32) bool {
+
+	if p.Turn != src {
+		return false
+	}
+	return true
+}
+func (p *SessionTurn) Field3DeepEqual(src int64) bool {
+
+	if p.StartTime != src {
+		return false
+	}
+	return true
+}
+func (p *SessionTurn) Field4DeepEqual(src int64) bool {
+
+	if p.Latency != src {
+		return false
+	}
+	return true
+}
+func (p *SessionTurn) Field5DeepEqual(src int64) bool {
+
+	if p.InputTokens != src {
+		return false
+	}
+	return true
+}
+func (p *SessionTurn) Field6DeepEqual(src int64) bool {
+
+	if p.OutputTokens != src {
+		return false
+	}
+	return true
+}
+func (p *SessionTurn) Field7DeepEqual(src *span.OutputSpan) bool {
+
+	if !p.RootSpan.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 以thread_id标识的多轮会话
+type Session struct {
+	SessionID    string `thrift:"session_id,1,required" frugal:"1,required,string" form:"session_id,required" json:"session_id,required" query:"session_id,required"`
+	TurnCount    int64  `thrift:"turn_count,2,required" frugal:"2,required,i64" json:"turn_count" form:"turn_count,required" query:"turn_count,required"`
+	InputTokens  int64  `thrift:"input_tokens,3,required" frugal:"3,required,i64" json:"input_tokens" form:"input_tokens,required" query:"input_tokens,required"`
+	OutputTokens int64  `thrift:"output_tokens,4,required" frugal:"4,required,i64" json:"output_tokens" form:"output_tokens,required" query:"output_tokens,required"`
+	// ms, 各轮耗时之和
+	Latency int64 `thrift:"latency,5,required" frugal:"5,required,i64" json:"latency" form:"latency,required" query:"latency,required"`
+	// ms
+	StartTime int64 `thrift:"start_time,6,required" frugal:"6,required,i64" json:"start_time" form:"start_time,required" query:"start_time,required"`
+	// ms
+	EndTime int64 `thrift:"end_time,7,required" frugal:"7,required,i64" json:"end_time" form:"end_time,required" query:"end_time,required"`
+	// 仅GetSession返回
+	Turns []*SessionTurn `thrift:"turns,8,optional" frugal:"8,optional,list<SessionTurn>" form:"turns" json:"turns,omitempty" query:"turns"`
+}
+
+func NewSession() *Session {
+	return &Session{}
+}
+
+func (p *Session) InitDefault() {
+}
+
+func (p *Session) GetSessionID() (v string) {
+	if p != nil {
+		return p.SessionID
+	}
+	return
+}
+
+func (p *Session) GetTurnCount() (v int64) {
+	if p != nil {
+		return p.TurnCount
+	}
+	return
+}
+
+func (p *Session) GetInputTokens() (v int64) {
+	if p != nil {
+		return p.InputTokens
+	}
+	return
+}
+
+func (p *Session) GetOutputTokens() (v int64) {
+	if p != nil {
+		return p.OutputTokens
+	}
+	return
+}
+
+func (p *Session) GetLatency() (v int64) {
+	if p != nil {
+		return p.Latency
+	}
+	return
+}
+
+func (p *Session) GetStartTime() (v int64) {
+	if p != nil {
+		return p.StartTime
+	}
+	return
+}
+
+func (p *Session) GetEndTime() (v int64) {
+	if p != nil {
+		return p.EndTime
+	}
+	return
+}
+
+var Session_Turns_DEFAULT []*SessionTurn
+
+func (p *Session) GetTurns() (v []*SessionTurn) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTurns() {
+		return Session_Turns_DEFAULT
+	}
+	return p.Turns
+}
+func (p *Session) SetSessionID(val string) {
+	p.SessionID = val
+}
+func (p *Session) SetTurnCount(val int64) {
+	p.TurnCount = val
+}
+func (p *Session) SetInputTokens(val int64) {
+	p.InputTokens = val
+}
+func (p *Session) SetOutputTokens(val int64) {
+	p.OutputTokens = val
+}
+func (p *Session) SetLatency(val int64) {
+	p.Latency = val
+}
+func (p *Session) SetStartTime(val int64) {
+	p.StartTime = val
+}
+func (p *Session) SetEndTime(val int64) {
+	p.EndTime = val
+}
+func (p *Session) SetTurns(val []*SessionTurn) {
+	p.Turns = val
+}
+
+var fieldIDToName_Session = map[int16]string{
+	1: "session_id",
+	2: "turn_count",
+	3: "input_tokens",
+	4: "output_tokens",
+	5: "latency",
+	6: "start_time",
+	7: "end_time",
+	8: "turns",
+}
+
+func (p *Session) IsSetTurns() bool {
+	return p.Turns != nil
+}
+
+func (p *Session) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSessionID bool = false
+	var issetTurnCount bool = false
+	var issetInputTokens bool = false
+	var issetOutputTokens bool = false
+	var issetLatency bool = false
+	var issetStartTime bool = false
+	var issetEndTime bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSessionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTurnCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetInputTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOutputTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLatency = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStartTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEndTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSessionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTurnCount {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetInputTokens {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOutputTokens {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLatency {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStartTime {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEndTime {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Session[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Session[fieldId]))
+}
+
+func (p *Session) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SessionID = _field
+	return nil
+}
+func (p *Session) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TurnCount = _field
+	return nil
+}
+func (p *Session) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.InputTokens = _field
+	return nil
+}
+func (p *Session) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OutputTokens = _field
+	return nil
+}
+func (p *Session) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Latency = _field
+	return nil
+}
+func (p *Session) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *Session) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *Session) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SessionTurn, 0, size)
+	values := make([]SessionTurn, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Turns = _field
+	return nil
+}
+
+func (p *Session) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Session"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Session) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("session_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SessionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Session) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("turn_count", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TurnCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Session) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("input_tokens", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.InputTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Session) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("output_tokens", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OutputTokens); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Session) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("latency", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Latency); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Session) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *Session) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EndTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *Session) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTurns() {
+		if err = oprot.WriteFieldBegin("turns", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Turns)); err != nil {
+			return err
+		}
+		for _, v := range p.Turns {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *Session) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Session(%+v)", *p)
+
+}
+
+func (p *Session) DeepEqual(ano *Session) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.SessionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TurnCount) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.InputTokens) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.OutputTokens) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Latency) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.EndTime) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Turns) {
+		return false
+	}
+	return true
+}
+
+func (p *Session) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.SessionID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Session) Field2DeepEqual(src int64) bool {
+
+	if p.TurnCount != src {
+		return false
+	}
+	return true
+}
+func (p *Session) Field3DeepEqual(src int64) bool {
+
+	if p.InputTokens != src {
+		return false
+	}
+	return true
+}
+func (p *Session) Field4DeepEqual(src int64) bool {
+
+	if p.OutputTokens != src {
+		return false
+	}
+	return true
+}
+func (p *Session) Field5DeepEqual(src int64) bool {
+
+	if p.Latency != src {
+		return false
+	}
+	return true
+}
+func (p *Session) Field6DeepEqual(src int64) bool {
+
+	if p.StartTime != src {
+		return false
+	}
+	return true
+}
+func (p *Session) Field7DeepEqual(src int64) bool {
+
+	if p.EndTime != src {
+		return false
+	}
+	return true
+}
+func (p *Session) Field8DeepEqual(src []*SessionTurn) bool {
+
+	if len(p.Turns) != len(src) {
+		return false
+	}
+	for i, v := range p.Turns {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
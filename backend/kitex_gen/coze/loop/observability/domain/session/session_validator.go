@@ -0,0 +1,34 @@
+// Code generated by Validator v0.2.6. DO NOT EDIT.
+
+package session
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// unused protection
+var (
+	_ = fmt.Formatter(nil)
+	_ = (*bytes.Buffer)(nil)
+	_ = (*strings.Builder)(nil)
+	_ = reflect.Type(nil)
+	_ = (*regexp.Regexp)(nil)
+	_ = time.Nanosecond
+)
+
+func (p *SessionTurn) IsValid() error {
+	if p.RootSpan != nil {
+		if err := p.RootSpan.IsValid(); err != nil {
+			return fmt.Errorf("field RootSpan not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *Session) IsValid() error {
+	return nil
+}
@@ -1132,6 +1132,62 @@ func (p *Sampler) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField8(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField9(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -1234,6 +1290,56 @@ func (p *Sampler) FastReadField6(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *Sampler) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *SamplingStrategy
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.SamplingStrategy = _field
+	return offset, nil
+}
+
+func (p *Sampler) FastReadField8(buf []byte) (int, error) {
+	offset := 0
+	_field := NewStratifiedSampling()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.StratifiedSampling = _field
+	return offset, nil
+}
+
+func (p *Sampler) FastReadField9(buf []byte) (int, error) {
+	offset := 0
+	_field := NewErrorBiasedSampling()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.ErrorBiasedSampling = _field
+	return offset, nil
+}
+
+func (p *Sampler) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+	_field := NewSampleDedup()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Dedup = _field
+	return offset, nil
+}
+
 func (p *Sampler) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -1247,6 +1353,10 @@ func (p *Sampler) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField8(buf[offset:], w)
+		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -1261,6 +1371,10 @@ func (p *Sampler) BLength() int {
 		l += p.field4Length()
 		l += p.field5Length()
 		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field8Length()
+		l += p.field9Length()
+		l += p.field10Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -1320,6 +1434,42 @@ func (p *Sampler) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
+func (p *Sampler) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSamplingStrategy() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.SamplingStrategy)
+	}
+	return offset
+}
+
+func (p *Sampler) fastWriteField8(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetStratifiedSampling() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 8)
+		offset += p.StratifiedSampling.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Sampler) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetErrorBiasedSampling() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 9)
+		offset += p.ErrorBiasedSampling.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *Sampler) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDedup() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 10)
+		offset += p.Dedup.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
 func (p *Sampler) field1Length() int {
 	l := 0
 	if p.IsSetSampleRate() {
@@ -1374,6 +1524,42 @@ func (p *Sampler) field6Length() int {
 	return l
 }
 
+func (p *Sampler) field7Length() int {
+	l := 0
+	if p.IsSetSamplingStrategy() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.SamplingStrategy)
+	}
+	return l
+}
+
+func (p *Sampler) field8Length() int {
+	l := 0
+	if p.IsSetStratifiedSampling() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.StratifiedSampling.BLength()
+	}
+	return l
+}
+
+func (p *Sampler) field9Length() int {
+	l := 0
+	if p.IsSetErrorBiasedSampling() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.ErrorBiasedSampling.BLength()
+	}
+	return l
+}
+
+func (p *Sampler) field10Length() int {
+	l := 0
+	if p.IsSetDedup() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Dedup.BLength()
+	}
+	return l
+}
+
 func (p *Sampler) DeepCopy(s interface{}) error {
 	src, ok := s.(*Sampler)
 	if !ok {
@@ -1410,16 +1596,50 @@ func (p *Sampler) DeepCopy(s interface{}) error {
 		p.CycleTimeUnit = &tmp
 	}
 
+	if src.SamplingStrategy != nil {
+		tmp := *src.SamplingStrategy
+		p.SamplingStrategy = &tmp
+	}
+
+	var _stratifiedSampling *StratifiedSampling
+	if src.StratifiedSampling != nil {
+		_stratifiedSampling = &StratifiedSampling{}
+		if err := _stratifiedSampling.DeepCopy(src.StratifiedSampling); err != nil {
+			return err
+		}
+	}
+	p.StratifiedSampling = _stratifiedSampling
+
+	var _errorBiasedSampling *ErrorBiasedSampling
+	if src.ErrorBiasedSampling != nil {
+		_errorBiasedSampling = &ErrorBiasedSampling{}
+		if err := _errorBiasedSampling.DeepCopy(src.ErrorBiasedSampling); err != nil {
+			return err
+		}
+	}
+	p.ErrorBiasedSampling = _errorBiasedSampling
+
+	var _dedup *SampleDedup
+	if src.Dedup != nil {
+		_dedup = &SampleDedup{}
+		if err := _dedup.DeepCopy(src.Dedup); err != nil {
+			return err
+		}
+	}
+	p.Dedup = _dedup
+
 	return nil
 }
 
-func (p *EffectiveTime) FastRead(buf []byte) (int, error) {
+func (p *StratifiedSampling) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetFieldName bool = false
+	var issetPerStratumSize bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -1431,12 +1651,13 @@ func (p *EffectiveTime) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetFieldName = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -1451,6 +1672,7 @@ func (p *EffectiveTime) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetPerStratumSize = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -1467,58 +1689,69 @@ func (p *EffectiveTime) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetFieldName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPerStratumSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EffectiveTime[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_StratifiedSampling[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_StratifiedSampling[fieldId]))
 }
 
-func (p *EffectiveTime) FastReadField1(buf []byte) (int, error) {
+func (p *StratifiedSampling) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.StartAt = _field
+	p.FieldName = _field
 	return offset, nil
 }
 
-func (p *EffectiveTime) FastReadField2(buf []byte) (int, error) {
+func (p *StratifiedSampling) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.EndAt = _field
+	p.PerStratumSize = _field
 	return offset, nil
 }
 
-func (p *EffectiveTime) FastWrite(buf []byte) int {
+func (p *StratifiedSampling) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *EffectiveTime) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *StratifiedSampling) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *EffectiveTime) BLength() int {
+func (p *StratifiedSampling) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -1528,62 +1761,50 @@ func (p *EffectiveTime) BLength() int {
 	return l
 }
 
-func (p *EffectiveTime) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *StratifiedSampling) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetStartAt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.StartAt)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 1)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.FieldName)
 	return offset
 }
 
-func (p *EffectiveTime) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *StratifiedSampling) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetEndAt() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.EndAt)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.PerStratumSize)
 	return offset
 }
 
-func (p *EffectiveTime) field1Length() int {
+func (p *StratifiedSampling) field1Length() int {
 	l := 0
-	if p.IsSetStartAt() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.FieldName)
 	return l
 }
 
-func (p *EffectiveTime) field2Length() int {
+func (p *StratifiedSampling) field2Length() int {
 	l := 0
-	if p.IsSetEndAt() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *EffectiveTime) DeepCopy(s interface{}) error {
-	src, ok := s.(*EffectiveTime)
+func (p *StratifiedSampling) DeepCopy(s interface{}) error {
+	src, ok := s.(*StratifiedSampling)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.StartAt != nil {
-		tmp := *src.StartAt
-		p.StartAt = &tmp
+	if src.FieldName != "" {
+		p.FieldName = kutils.StringDeepCopy(src.FieldName)
 	}
 
-	if src.EndAt != nil {
-		tmp := *src.EndAt
-		p.EndAt = &tmp
-	}
+	p.PerStratumSize = src.PerStratumSize
 
 	return nil
 }
 
-func (p *TaskConfig) FastRead(buf []byte) (int, error) {
+func (p *ErrorBiasedSampling) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -1601,7 +1822,7 @@ func (p *TaskConfig) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.DOUBLE {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -1614,20 +1835,6 @@ func (p *TaskConfig) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField2(buf[offset:])
-				offset += l
-				if err != nil {
-					goto ReadFieldError
-				}
-			} else {
-				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
-				offset += l
-				if err != nil {
-					goto SkipFieldError
-				}
-			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -1641,21 +1848,882 @@ func (p *TaskConfig) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TaskConfig[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ErrorBiasedSampling[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TaskConfig) FastReadField1(buf []byte) (int, error) {
+func (p *ErrorBiasedSampling) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*AutoEvaluateConfig, 0, size)
-	values := make([]AutoEvaluateConfig, size)
+	p.ErrorSampleRate = _field
+	return offset, nil
+}
+
+func (p *ErrorBiasedSampling) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ErrorBiasedSampling) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ErrorBiasedSampling) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ErrorBiasedSampling) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetErrorSampleRate() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 1)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.ErrorSampleRate)
+	}
+	return offset
+}
+
+func (p *ErrorBiasedSampling) field1Length() int {
+	l := 0
+	if p.IsSetErrorSampleRate() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
+func (p *ErrorBiasedSampling) DeepCopy(s interface{}) error {
+	src, ok := s.(*ErrorBiasedSampling)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.ErrorSampleRate != nil {
+		tmp := *src.ErrorSampleRate
+		p.ErrorSampleRate = &tmp
+	}
+
+	return nil
+}
+
+func (p *SampleDedup) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SampleDedup[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *SampleDedup) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Enabled = _field
+	return offset, nil
+}
+
+func (p *SampleDedup) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.FieldName = _field
+	return offset, nil
+}
+
+func (p *SampleDedup) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *SampleDedup) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *SampleDedup) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *SampleDedup) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEnabled() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 1)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Enabled)
+	}
+	return offset
+}
+
+func (p *SampleDedup) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.FieldName)
+	}
+	return offset
+}
+
+func (p *SampleDedup) field1Length() int {
+	l := 0
+	if p.IsSetEnabled() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *SampleDedup) field2Length() int {
+	l := 0
+	if p.IsSetFieldName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.FieldName)
+	}
+	return l
+}
+
+func (p *SampleDedup) DeepCopy(s interface{}) error {
+	src, ok := s.(*SampleDedup)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Enabled != nil {
+		tmp := *src.Enabled
+		p.Enabled = &tmp
+	}
+
+	if src.FieldName != nil {
+		var tmp string
+		if *src.FieldName != "" {
+			tmp = kutils.StringDeepCopy(*src.FieldName)
+		}
+		p.FieldName = &tmp
+	}
+
+	return nil
+}
+
+func (p *EffectiveTime) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EffectiveTime[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *EffectiveTime) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.StartAt = _field
+	return offset, nil
+}
+
+func (p *EffectiveTime) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.EndAt = _field
+	return offset, nil
+}
+
+func (p *EffectiveTime) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *EffectiveTime) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *EffectiveTime) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *EffectiveTime) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetStartAt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.StartAt)
+	}
+	return offset
+}
+
+func (p *EffectiveTime) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetEndAt() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.EndAt)
+	}
+	return offset
+}
+
+func (p *EffectiveTime) field1Length() int {
+	l := 0
+	if p.IsSetStartAt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EffectiveTime) field2Length() int {
+	l := 0
+	if p.IsSetEndAt() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *EffectiveTime) DeepCopy(s interface{}) error {
+	src, ok := s.(*EffectiveTime)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.StartAt != nil {
+		tmp := *src.StartAt
+		p.StartAt = &tmp
+	}
+
+	if src.EndAt != nil {
+		tmp := *src.EndAt
+		p.EndAt = &tmp
+	}
+
+	return nil
+}
+
+func (p *TaskConfig) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TaskConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TaskConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*AutoEvaluateConfig, 0, size)
+	values := make([]AutoEvaluateConfig, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.AutoEvaluateConfigs = _field
+	return offset, nil
+}
+
+func (p *TaskConfig) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*DataReflowConfig, 0, size)
+	values := make([]DataReflowConfig, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.DataReflowConfig = _field
+	return offset, nil
+}
+
+func (p *TaskConfig) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*AutoTagConfig, 0, size)
+	values := make([]AutoTagConfig, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.AutoTagConfigs = _field
+	return offset, nil
+}
+
+func (p *TaskConfig) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TaskConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TaskConfig) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TaskConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAutoEvaluateConfigs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.AutoEvaluateConfigs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *TaskConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDataReflowConfig() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.DataReflowConfig {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *TaskConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetAutoTagConfigs() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.AutoTagConfigs {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *TaskConfig) field1Length() int {
+	l := 0
+	if p.IsSetAutoEvaluateConfigs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.AutoEvaluateConfigs {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *TaskConfig) field2Length() int {
+	l := 0
+	if p.IsSetDataReflowConfig() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.DataReflowConfig {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *TaskConfig) field3Length() int {
+	l := 0
+	if p.IsSetAutoTagConfigs() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.AutoTagConfigs {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *TaskConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*TaskConfig)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.AutoEvaluateConfigs != nil {
+		p.AutoEvaluateConfigs = make([]*AutoEvaluateConfig, 0, len(src.AutoEvaluateConfigs))
+		for _, elem := range src.AutoEvaluateConfigs {
+			var _elem *AutoEvaluateConfig
+			if elem != nil {
+				_elem = &AutoEvaluateConfig{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.AutoEvaluateConfigs = append(p.AutoEvaluateConfigs, _elem)
+		}
+	}
+
+	if src.DataReflowConfig != nil {
+		p.DataReflowConfig = make([]*DataReflowConfig, 0, len(src.DataReflowConfig))
+		for _, elem := range src.DataReflowConfig {
+			var _elem *DataReflowConfig
+			if elem != nil {
+				_elem = &DataReflowConfig{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.DataReflowConfig = append(p.DataReflowConfig, _elem)
+		}
+	}
+
+	if src.AutoTagConfigs != nil {
+		p.AutoTagConfigs = make([]*AutoTagConfig, 0, len(src.AutoTagConfigs))
+		for _, elem := range src.AutoTagConfigs {
+			var _elem *AutoTagConfig
+			if elem != nil {
+				_elem = &AutoTagConfig{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.AutoTagConfigs = append(p.AutoTagConfigs, _elem)
+		}
+	}
+
+	return nil
+}
+
+func (p *DataReflowConfig) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataReflowConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *DataReflowConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.DatasetID = _field
+	return offset, nil
+}
+
+func (p *DataReflowConfig) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.DatasetName = _field
+	return offset, nil
+}
+
+func (p *DataReflowConfig) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := dataset.NewDatasetSchema()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.DatasetSchema = _field
+	return offset, nil
+}
+
+func (p *DataReflowConfig) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*dataset.FieldMapping, 0, size)
+	values := make([]dataset.FieldMapping, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -1665,13 +2733,297 @@ func (p *TaskConfig) FastReadField1(buf []byte) (int, error) {
 			offset += l
 		}
 
-		_field = append(_field, _elem)
+		_field = append(_field, _elem)
+	}
+	p.FieldMappings = _field
+	return offset, nil
+}
+
+func (p *DataReflowConfig) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DataReflowConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DataReflowConfig) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DataReflowConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDatasetID() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.DatasetID)
+	}
+	return offset
+}
+
+func (p *DataReflowConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDatasetName() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.DatasetName)
+	}
+	return offset
+}
+
+func (p *DataReflowConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetDatasetSchema() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+		offset += p.DatasetSchema.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DataReflowConfig) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFieldMappings() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+		listBeginOffset := offset
+		offset += thrift.Binary.ListBeginLength()
+		var length int
+		for _, v := range p.FieldMappings {
+			length++
+			offset += v.FastWriteNocopy(buf[offset:], w)
+		}
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	}
+	return offset
+}
+
+func (p *DataReflowConfig) field1Length() int {
+	l := 0
+	if p.IsSetDatasetID() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *DataReflowConfig) field2Length() int {
+	l := 0
+	if p.IsSetDatasetName() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.DatasetName)
+	}
+	return l
+}
+
+func (p *DataReflowConfig) field3Length() int {
+	l := 0
+	if p.IsSetDatasetSchema() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.DatasetSchema.BLength()
+	}
+	return l
+}
+
+func (p *DataReflowConfig) field4Length() int {
+	l := 0
+	if p.IsSetFieldMappings() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.ListBeginLength()
+		for _, v := range p.FieldMappings {
+			_ = v
+			l += v.BLength()
+		}
+	}
+	return l
+}
+
+func (p *DataReflowConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*DataReflowConfig)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.DatasetID != nil {
+		tmp := *src.DatasetID
+		p.DatasetID = &tmp
+	}
+
+	if src.DatasetName != nil {
+		var tmp string
+		if *src.DatasetName != "" {
+			tmp = kutils.StringDeepCopy(*src.DatasetName)
+		}
+		p.DatasetName = &tmp
+	}
+
+	var _datasetSchema *dataset.DatasetSchema
+	if src.DatasetSchema != nil {
+		_datasetSchema = &dataset.DatasetSchema{}
+		if err := _datasetSchema.DeepCopy(src.DatasetSchema); err != nil {
+			return err
+		}
+	}
+	p.DatasetSchema = _datasetSchema
+
+	if src.FieldMappings != nil {
+		p.FieldMappings = make([]*dataset.FieldMapping, 0, len(src.FieldMappings))
+		for _, elem := range src.FieldMappings {
+			var _elem *dataset.FieldMapping
+			if elem != nil {
+				_elem = &dataset.FieldMapping{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.FieldMappings = append(p.FieldMappings, _elem)
+		}
+	}
+
+	return nil
+}
+
+func (p *AutoEvaluateConfig) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetEvaluatorVersionID bool = false
+	var issetEvaluatorID bool = false
+	var issetFieldMappings bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorVersionID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetFieldMappings = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	p.AutoEvaluateConfigs = _field
+
+	if !issetEvaluatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFieldMappings {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AutoEvaluateConfig[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_AutoEvaluateConfig[fieldId]))
+}
+
+func (p *AutoEvaluateConfig) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
 	return offset, nil
 }
 
-func (p *TaskConfig) FastReadField2(buf []byte) (int, error) {
+func (p *AutoEvaluateConfig) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EvaluatorID = _field
+	return offset, nil
+}
+
+func (p *AutoEvaluateConfig) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -1679,8 +3031,8 @@ func (p *TaskConfig) FastReadField2(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*DataReflowConfig, 0, size)
-	values := make([]DataReflowConfig, size)
+	_field := make([]*EvaluateFieldMapping, 0, size)
+	values := make([]EvaluateFieldMapping, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -1692,138 +3044,128 @@ func (p *TaskConfig) FastReadField2(buf []byte) (int, error) {
 
 		_field = append(_field, _elem)
 	}
-	p.DataReflowConfig = _field
+	p.FieldMappings = _field
 	return offset, nil
 }
 
-func (p *TaskConfig) FastWrite(buf []byte) int {
+func (p *AutoEvaluateConfig) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TaskConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *AutoEvaluateConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *TaskConfig) BLength() int {
+func (p *AutoEvaluateConfig) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
+		l += p.field3Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *TaskConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *AutoEvaluateConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetAutoEvaluateConfigs() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.AutoEvaluateConfigs {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
 	return offset
 }
 
-func (p *TaskConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *AutoEvaluateConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDataReflowConfig() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
-		listBeginOffset := offset
-		offset += thrift.Binary.ListBeginLength()
-		var length int
-		for _, v := range p.DataReflowConfig {
-			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
-		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorID)
+	return offset
+}
+
+func (p *AutoEvaluateConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.FieldMappings {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
 	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *TaskConfig) field1Length() int {
+func (p *AutoEvaluateConfig) field1Length() int {
 	l := 0
-	if p.IsSetAutoEvaluateConfigs() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.AutoEvaluateConfigs {
-			_ = v
-			l += v.BLength()
-		}
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *TaskConfig) field2Length() int {
+func (p *AutoEvaluateConfig) field2Length() int {
 	l := 0
-	if p.IsSetDataReflowConfig() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.DataReflowConfig {
-			_ = v
-			l += v.BLength()
-		}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *AutoEvaluateConfig) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.FieldMappings {
+		_ = v
+		l += v.BLength()
 	}
 	return l
 }
 
-func (p *TaskConfig) DeepCopy(s interface{}) error {
-	src, ok := s.(*TaskConfig)
+func (p *AutoEvaluateConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*AutoEvaluateConfig)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.AutoEvaluateConfigs != nil {
-		p.AutoEvaluateConfigs = make([]*AutoEvaluateConfig, 0, len(src.AutoEvaluateConfigs))
-		for _, elem := range src.AutoEvaluateConfigs {
-			var _elem *AutoEvaluateConfig
-			if elem != nil {
-				_elem = &AutoEvaluateConfig{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+	p.EvaluatorVersionID = src.EvaluatorVersionID
 
-			p.AutoEvaluateConfigs = append(p.AutoEvaluateConfigs, _elem)
-		}
-	}
+	p.EvaluatorID = src.EvaluatorID
 
-	if src.DataReflowConfig != nil {
-		p.DataReflowConfig = make([]*DataReflowConfig, 0, len(src.DataReflowConfig))
-		for _, elem := range src.DataReflowConfig {
-			var _elem *DataReflowConfig
+	if src.FieldMappings != nil {
+		p.FieldMappings = make([]*EvaluateFieldMapping, 0, len(src.FieldMappings))
+		for _, elem := range src.FieldMappings {
+			var _elem *EvaluateFieldMapping
 			if elem != nil {
-				_elem = &DataReflowConfig{}
+				_elem = &EvaluateFieldMapping{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
 			}
 
-			p.DataReflowConfig = append(p.DataReflowConfig, _elem)
+			p.FieldMappings = append(p.FieldMappings, _elem)
 		}
 	}
 
 	return nil
 }
 
-func (p *DataReflowConfig) FastRead(buf []byte) (int, error) {
+func (p *AutoTagConfig) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetEvaluatorVersionID bool = false
+	var issetEvaluatorID bool = false
+	var issetFieldMappings bool = false
+	var issetTagKey bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -1841,6 +3183,7 @@ func (p *DataReflowConfig) FastRead(buf []byte) (int, error) {
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorVersionID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -1849,12 +3192,13 @@ func (p *DataReflowConfig) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetEvaluatorID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -1863,12 +3207,13 @@ func (p *DataReflowConfig) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetFieldMappings = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -1877,12 +3222,27 @@ func (p *DataReflowConfig) FastRead(buf []byte) (int, error) {
 				}
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
+				issetTagKey = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -1899,56 +3259,104 @@ func (p *DataReflowConfig) FastRead(buf []byte) (int, error) {
 		}
 	}
 
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFieldMappings {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTagKey {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataReflowConfig[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AutoTagConfig[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_AutoTagConfig[fieldId]))
 }
 
-func (p *DataReflowConfig) FastReadField1(buf []byte) (int, error) {
+func (p *AutoTagConfig) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
+	var _field int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.DatasetID = _field
+	p.EvaluatorVersionID = _field
 	return offset, nil
 }
 
-func (p *DataReflowConfig) FastReadField2(buf []byte) (int, error) {
+func (p *AutoTagConfig) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *string
-	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = &v
+		_field = v
 	}
-	p.DatasetName = _field
+	p.EvaluatorID = _field
 	return offset, nil
 }
 
-func (p *DataReflowConfig) FastReadField3(buf []byte) (int, error) {
+func (p *AutoTagConfig) FastReadField3(buf []byte) (int, error) {
 	offset := 0
-	_field := dataset.NewDatasetSchema()
-	if l, err := _field.FastRead(buf[offset:]); err != nil {
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*EvaluateFieldMapping, 0, size)
+	values := make([]EvaluateFieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.FieldMappings = _field
+	return offset, nil
+}
+
+func (p *AutoTagConfig) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
+		_field = v
 	}
-	p.DatasetSchema = _field
+	p.TagKey = _field
 	return offset, nil
 }
 
-func (p *DataReflowConfig) FastReadField4(buf []byte) (int, error) {
+func (p *AutoTagConfig) FastReadField5(buf []byte) (int, error) {
 	offset := 0
 
 	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
@@ -1956,168 +3364,164 @@ func (p *DataReflowConfig) FastReadField4(buf []byte) (int, error) {
 	if err != nil {
 		return offset, err
 	}
-	_field := make([]*dataset.FieldMapping, 0, size)
-	values := make([]dataset.FieldMapping, size)
+	_field := make([]string, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+		var _elem string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
 			return offset, err
 		} else {
 			offset += l
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
 	}
-	p.FieldMappings = _field
+	p.Labels = _field
 	return offset, nil
 }
 
-func (p *DataReflowConfig) FastWrite(buf []byte) int {
+func (p *AutoTagConfig) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *DataReflowConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *AutoTagConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *DataReflowConfig) BLength() int {
+func (p *AutoTagConfig) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *DataReflowConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *AutoTagConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDatasetID() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.DatasetID)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
 	return offset
 }
 
-func (p *DataReflowConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *AutoTagConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDatasetName() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
-		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.DatasetName)
-	}
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorID)
 	return offset
 }
 
-func (p *DataReflowConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *AutoTagConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetDatasetSchema() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
-		offset += p.DatasetSchema.FastWriteNocopy(buf[offset:], w)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.FieldMappings {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
 	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *DataReflowConfig) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+func (p *AutoTagConfig) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFieldMappings() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 4)
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.TagKey)
+	return offset
+}
+
+func (p *AutoTagConfig) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLabels() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 5)
 		listBeginOffset := offset
 		offset += thrift.Binary.ListBeginLength()
 		var length int
-		for _, v := range p.FieldMappings {
+		for _, v := range p.Labels {
 			length++
-			offset += v.FastWriteNocopy(buf[offset:], w)
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, v)
 		}
-		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+		thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRING, length)
 	}
 	return offset
 }
 
-func (p *DataReflowConfig) field1Length() int {
+func (p *AutoTagConfig) field1Length() int {
 	l := 0
-	if p.IsSetDatasetID() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *DataReflowConfig) field2Length() int {
+func (p *AutoTagConfig) field2Length() int {
 	l := 0
-	if p.IsSetDatasetName() {
-		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.StringLengthNocopy(*p.DatasetName)
-	}
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
 	return l
 }
 
-func (p *DataReflowConfig) field3Length() int {
+func (p *AutoTagConfig) field3Length() int {
 	l := 0
-	if p.IsSetDatasetSchema() {
-		l += thrift.Binary.FieldBeginLength()
-		l += p.DatasetSchema.BLength()
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.FieldMappings {
+		_ = v
+		l += v.BLength()
 	}
 	return l
 }
 
-func (p *DataReflowConfig) field4Length() int {
+func (p *AutoTagConfig) field4Length() int {
 	l := 0
-	if p.IsSetFieldMappings() {
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.TagKey)
+	return l
+}
+
+func (p *AutoTagConfig) field5Length() int {
+	l := 0
+	if p.IsSetLabels() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.ListBeginLength()
-		for _, v := range p.FieldMappings {
+		for _, v := range p.Labels {
 			_ = v
-			l += v.BLength()
+			l += thrift.Binary.StringLengthNocopy(v)
 		}
 	}
 	return l
 }
 
-func (p *DataReflowConfig) DeepCopy(s interface{}) error {
-	src, ok := s.(*DataReflowConfig)
+func (p *AutoTagConfig) DeepCopy(s interface{}) error {
+	src, ok := s.(*AutoTagConfig)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.DatasetID != nil {
-		tmp := *src.DatasetID
-		p.DatasetID = &tmp
-	}
-
-	if src.DatasetName != nil {
-		var tmp string
-		if *src.DatasetName != "" {
-			tmp = kutils.StringDeepCopy(*src.DatasetName)
-		}
-		p.DatasetName = &tmp
-	}
+	p.EvaluatorVersionID = src.EvaluatorVersionID
 
-	var _datasetSchema *dataset.DatasetSchema
-	if src.DatasetSchema != nil {
-		_datasetSchema = &dataset.DatasetSchema{}
-		if err := _datasetSchema.DeepCopy(src.DatasetSchema); err != nil {
-			return err
-		}
-	}
-	p.DatasetSchema = _datasetSchema
+	p.EvaluatorID = src.EvaluatorID
 
 	if src.FieldMappings != nil {
-		p.FieldMappings = make([]*dataset.FieldMapping, 0, len(src.FieldMappings))
+		p.FieldMappings = make([]*EvaluateFieldMapping, 0, len(src.FieldMappings))
 		for _, elem := range src.FieldMappings {
-			var _elem *dataset.FieldMapping
+			var _elem *EvaluateFieldMapping
 			if elem != nil {
-				_elem = &dataset.FieldMapping{}
+				_elem = &EvaluateFieldMapping{}
 				if err := _elem.DeepCopy(elem); err != nil {
 					return err
 				}
@@ -2127,19 +3531,31 @@ func (p *DataReflowConfig) DeepCopy(s interface{}) error {
 		}
 	}
 
+	if src.TagKey != "" {
+		p.TagKey = kutils.StringDeepCopy(src.TagKey)
+	}
+
+	if src.Labels != nil {
+		p.Labels = make([]string, 0, len(src.Labels))
+		for _, elem := range src.Labels {
+			var _elem string
+			if elem != "" {
+				_elem = kutils.StringDeepCopy(elem)
+			}
+			p.Labels = append(p.Labels, _elem)
+		}
+	}
+
 	return nil
 }
 
-func (p *AutoEvaluateConfig) FastRead(buf []byte) (int, error) {
+func (p *RunDetail) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
 	var l int
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetEvaluatorVersionID bool = false
-	var issetEvaluatorID bool = false
-	var issetFieldMappings bool = false
 	for {
 		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
 		offset += l
@@ -2152,12 +3568,25 @@ func (p *AutoEvaluateConfig) FastRead(buf []byte) (int, error) {
 		switch fieldId {
 		case 1:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField1(buf[offset:])
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorVersionID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -2165,14 +3594,13 @@ func (p *AutoEvaluateConfig) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 2:
+		case 3:
 			if fieldTypeId == thrift.I64 {
-				l, err = p.FastReadField2(buf[offset:])
+				l, err = p.FastReadField3(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorID = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -2180,14 +3608,13 @@ func (p *AutoEvaluateConfig) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				l, err = p.FastReadField3(buf[offset:])
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
 				offset += l
 				if err != nil {
 					goto ReadFieldError
 				}
-				issetFieldMappings = true
 			} else {
 				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 				offset += l
@@ -2204,192 +3631,203 @@ func (p *AutoEvaluateConfig) FastRead(buf []byte) (int, error) {
 		}
 	}
 
-	if !issetEvaluatorVersionID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluatorID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetFieldMappings {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return offset, nil
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AutoEvaluateConfig[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunDetail[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-RequiredFieldNotSetError:
-	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_AutoEvaluateConfig[fieldId]))
 }
 
-func (p *AutoEvaluateConfig) FastReadField1(buf []byte) (int, error) {
+func (p *RunDetail) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
+	var _field *int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.EvaluatorVersionID = _field
+	p.SuccessCount = _field
 	return offset, nil
 }
 
-func (p *AutoEvaluateConfig) FastReadField2(buf []byte) (int, error) {
+func (p *RunDetail) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
-	var _field int64
+	var _field *int64
 	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
 	} else {
 		offset += l
-		_field = v
+		_field = &v
 	}
-	p.EvaluatorID = _field
+	p.FailedCount = _field
 	return offset, nil
 }
 
-func (p *AutoEvaluateConfig) FastReadField3(buf []byte) (int, error) {
+func (p *RunDetail) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
-	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
-	offset += l
-	if err != nil {
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
 		return offset, err
+	} else {
+		offset += l
+		_field = &v
 	}
-	_field := make([]*EvaluateFieldMapping, 0, size)
-	values := make([]EvaluateFieldMapping, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-		if l, err := _elem.FastRead(buf[offset:]); err != nil {
-			return offset, err
-		} else {
-			offset += l
-		}
+	p.TotalCount = _field
+	return offset, nil
+}
 
-		_field = append(_field, _elem)
+func (p *RunDetail) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := NewSampleStats()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
 	}
-	p.FieldMappings = _field
+	p.SampleStats = _field
 	return offset, nil
 }
 
-func (p *AutoEvaluateConfig) FastWrite(buf []byte) int {
+func (p *RunDetail) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *AutoEvaluateConfig) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *RunDetail) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *AutoEvaluateConfig) BLength() int {
+func (p *RunDetail) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
 		l += p.field2Length()
 		l += p.field3Length()
+		l += p.field4Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
 }
 
-func (p *AutoEvaluateConfig) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *RunDetail) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorVersionID)
+	if p.IsSetSuccessCount() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.SuccessCount)
+	}
 	return offset
 }
 
-func (p *AutoEvaluateConfig) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *RunDetail) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-	offset += thrift.Binary.WriteI64(buf[offset:], p.EvaluatorID)
+	if p.IsSetFailedCount() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.FailedCount)
+	}
 	return offset
 }
 
-func (p *AutoEvaluateConfig) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *RunDetail) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 3)
-	listBeginOffset := offset
-	offset += thrift.Binary.ListBeginLength()
-	var length int
-	for _, v := range p.FieldMappings {
-		length++
-		offset += v.FastWriteNocopy(buf[offset:], w)
+	if p.IsSetTotalCount() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.TotalCount)
 	}
-	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
 	return offset
 }
 
-func (p *AutoEvaluateConfig) field1Length() int {
+func (p *RunDetail) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSampleStats() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.SampleStats.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *RunDetail) field1Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetSuccessCount() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
 	return l
 }
 
-func (p *AutoEvaluateConfig) field2Length() int {
+func (p *RunDetail) field2Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.I64Length()
+	if p.IsSetFailedCount() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
 	return l
 }
 
-func (p *AutoEvaluateConfig) field3Length() int {
+func (p *RunDetail) field3Length() int {
 	l := 0
-	l += thrift.Binary.FieldBeginLength()
-	l += thrift.Binary.ListBeginLength()
-	for _, v := range p.FieldMappings {
-		_ = v
-		l += v.BLength()
+	if p.IsSetTotalCount() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *AutoEvaluateConfig) DeepCopy(s interface{}) error {
-	src, ok := s.(*AutoEvaluateConfig)
+func (p *RunDetail) field4Length() int {
+	l := 0
+	if p.IsSetSampleStats() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.SampleStats.BLength()
+	}
+	return l
+}
+
+func (p *RunDetail) DeepCopy(s interface{}) error {
+	src, ok := s.(*RunDetail)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	p.EvaluatorVersionID = src.EvaluatorVersionID
+	if src.SuccessCount != nil {
+		tmp := *src.SuccessCount
+		p.SuccessCount = &tmp
+	}
 
-	p.EvaluatorID = src.EvaluatorID
+	if src.FailedCount != nil {
+		tmp := *src.FailedCount
+		p.FailedCount = &tmp
+	}
 
-	if src.FieldMappings != nil {
-		p.FieldMappings = make([]*EvaluateFieldMapping, 0, len(src.FieldMappings))
-		for _, elem := range src.FieldMappings {
-			var _elem *EvaluateFieldMapping
-			if elem != nil {
-				_elem = &EvaluateFieldMapping{}
-				if err := _elem.DeepCopy(elem); err != nil {
-					return err
-				}
-			}
+	if src.TotalCount != nil {
+		tmp := *src.TotalCount
+		p.TotalCount = &tmp
+	}
 
-			p.FieldMappings = append(p.FieldMappings, _elem)
+	var _sampleStats *SampleStats
+	if src.SampleStats != nil {
+		_sampleStats = &SampleStats{}
+		if err := _sampleStats.DeepCopy(src.SampleStats); err != nil {
+			return err
 		}
 	}
+	p.SampleStats = _sampleStats
 
 	return nil
 }
 
-func (p *RunDetail) FastRead(buf []byte) (int, error) {
+func (p *SampleStats) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -2407,7 +3845,7 @@ func (p *RunDetail) FastRead(buf []byte) (int, error) {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.MAP {
 				l, err = p.FastReadField1(buf[offset:])
 				offset += l
 				if err != nil {
@@ -2461,26 +3899,44 @@ func (p *RunDetail) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunDetail[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SampleStats[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *RunDetail) FastReadField1(buf []byte) (int, error) {
+func (p *SampleStats) FastReadField1(buf []byte) (int, error) {
 	offset := 0
 
-	var _field *int64
-	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+	_, _, size, l, err := thrift.Binary.ReadMapBegin(buf[offset:])
+	offset += l
+	if err != nil {
 		return offset, err
-	} else {
-		offset += l
-		_field = &v
 	}
-	p.SuccessCount = _field
+	_field := make(map[string]int64, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_key = v
+		}
+
+		var _val int64
+		if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	p.StratumCounts = _field
 	return offset, nil
 }
 
-func (p *RunDetail) FastReadField2(buf []byte) (int, error) {
+func (p *SampleStats) FastReadField2(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -2490,11 +3946,11 @@ func (p *RunDetail) FastReadField2(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.FailedCount = _field
+	p.ErrorCount = _field
 	return offset, nil
 }
 
-func (p *RunDetail) FastReadField3(buf []byte) (int, error) {
+func (p *SampleStats) FastReadField3(buf []byte) (int, error) {
 	offset := 0
 
 	var _field *int64
@@ -2504,26 +3960,26 @@ func (p *RunDetail) FastReadField3(buf []byte) (int, error) {
 		offset += l
 		_field = &v
 	}
-	p.TotalCount = _field
+	p.DedupSkippedCount = _field
 	return offset, nil
 }
 
-func (p *RunDetail) FastWrite(buf []byte) int {
+func (p *SampleStats) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *RunDetail) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *SampleStats) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
-		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
 }
 
-func (p *RunDetail) BLength() int {
+func (p *SampleStats) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -2534,79 +3990,103 @@ func (p *RunDetail) BLength() int {
 	return l
 }
 
-func (p *RunDetail) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *SampleStats) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetSuccessCount() {
-		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.SuccessCount)
+	if p.IsSetStratumCounts() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.MAP, 1)
+		mapBeginOffset := offset
+		offset += thrift.Binary.MapBeginLength()
+		var length int
+		for k, v := range p.StratumCounts {
+			length++
+			offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, k)
+			offset += thrift.Binary.WriteI64(buf[offset:], v)
+		}
+		thrift.Binary.WriteMapBegin(buf[mapBeginOffset:], thrift.STRING, thrift.I64, length)
 	}
 	return offset
 }
 
-func (p *RunDetail) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+func (p *SampleStats) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetFailedCount() {
+	if p.IsSetErrorCount() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.FailedCount)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.ErrorCount)
 	}
 	return offset
 }
 
-func (p *RunDetail) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+func (p *SampleStats) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
-	if p.IsSetTotalCount() {
+	if p.IsSetDedupSkippedCount() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
-		offset += thrift.Binary.WriteI64(buf[offset:], *p.TotalCount)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.DedupSkippedCount)
 	}
 	return offset
 }
 
-func (p *RunDetail) field1Length() int {
+func (p *SampleStats) field1Length() int {
 	l := 0
-	if p.IsSetSuccessCount() {
+	if p.IsSetStratumCounts() {
 		l += thrift.Binary.FieldBeginLength()
-		l += thrift.Binary.I64Length()
+		l += thrift.Binary.MapBeginLength()
+		for k, v := range p.StratumCounts {
+			_, _ = k, v
+
+			l += thrift.Binary.StringLengthNocopy(k)
+			l += thrift.Binary.I64Length()
+		}
 	}
 	return l
 }
 
-func (p *RunDetail) field2Length() int {
+func (p *SampleStats) field2Length() int {
 	l := 0
-	if p.IsSetFailedCount() {
+	if p.IsSetErrorCount() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *RunDetail) field3Length() int {
+func (p *SampleStats) field3Length() int {
 	l := 0
-	if p.IsSetTotalCount() {
+	if p.IsSetDedupSkippedCount() {
 		l += thrift.Binary.FieldBeginLength()
 		l += thrift.Binary.I64Length()
 	}
 	return l
 }
 
-func (p *RunDetail) DeepCopy(s interface{}) error {
-	src, ok := s.(*RunDetail)
+func (p *SampleStats) DeepCopy(s interface{}) error {
+	src, ok := s.(*SampleStats)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	if src.SuccessCount != nil {
-		tmp := *src.SuccessCount
-		p.SuccessCount = &tmp
+	if src.StratumCounts != nil {
+		p.StratumCounts = make(map[string]int64, len(src.StratumCounts))
+		for key, val := range src.StratumCounts {
+			var _key string
+			if key != "" {
+				_key = kutils.StringDeepCopy(key)
+			}
+
+			var _val int64
+			_val = val
+
+			p.StratumCounts[_key] = _val
+		}
 	}
 
-	if src.FailedCount != nil {
-		tmp := *src.FailedCount
-		p.FailedCount = &tmp
+	if src.ErrorCount != nil {
+		tmp := *src.ErrorCount
+		p.ErrorCount = &tmp
 	}
 
-	if src.TotalCount != nil {
-		tmp := *src.TotalCount
-		p.TotalCount = &tmp
+	if src.DedupSkippedCount != nil {
+		tmp := *src.DedupSkippedCount
+		p.DedupSkippedCount = &tmp
 	}
 
 	return nil
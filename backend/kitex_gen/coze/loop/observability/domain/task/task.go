@@ -22,6 +22,8 @@ const (
 
 	TaskTypeAutoDataReflow = "auto_data_reflow"
 
+	TaskTypeAutoTag = "auto_tag"
+
 	TaskRunTypeBackFill = "back_fill"
 
 	TaskRunTypeNewData = "new_data"
@@ -42,6 +44,12 @@ const (
 
 	RunStatusDone = "done"
 
+	SamplingStrategyUniform = "uniform"
+
+	SamplingStrategyStratified = "stratified"
+
+	SamplingStrategyErrorBiased = "error_biased"
+
 	TaskSourceUser = "user"
 
 	TaskSourceWorkflow = "workflow"
@@ -57,6 +65,8 @@ type TaskStatus = string
 
 type RunStatus = string
 
+type SamplingStrategy = string
+
 type TaskSource = string
 
 // Task
@@ -1441,6 +1451,14 @@ type Sampler struct {
 	CycleInterval *int64 `thrift:"cycle_interval,5,optional" frugal:"5,optional,i64" form:"cycle_interval" json:"cycle_interval,omitempty" query:"cycle_interval"`
 	// 循环时间单位
 	CycleTimeUnit *TimeUnit `thrift:"cycle_time_unit,6,optional" frugal:"6,optional,string" form:"cycle_time_unit" json:"cycle_time_unit,omitempty" query:"cycle_time_unit"`
+	// 采样策略, 默认uniform
+	SamplingStrategy *SamplingStrategy `thrift:"sampling_strategy,7,optional" frugal:"7,optional,string" form:"sampling_strategy" json:"sampling_strategy,omitempty" query:"sampling_strategy"`
+	// 分层采样配置, sampling_strategy为stratified时必填
+	StratifiedSampling *StratifiedSampling `thrift:"stratified_sampling,8,optional" frugal:"8,optional,StratifiedSampling" form:"stratified_sampling" json:"stratified_sampling,omitempty" query:"stratified_sampling"`
+	// 失败偏置采样配置
+	ErrorBiasedSampling *ErrorBiasedSampling `thrift:"error_biased_sampling,9,optional" frugal:"9,optional,ErrorBiasedSampling" form:"error_biased_sampling" json:"error_biased_sampling,omitempty" query:"error_biased_sampling"`
+	// 相似输入去重(simhash汉明距离不超过3视为相似), 可与任意采样策略组合
+	Dedup *SampleDedup `thrift:"dedup,10,optional" frugal:"10,optional,SampleDedup" form:"dedup" json:"dedup,omitempty" query:"dedup"`
 }
 
 func NewSampler() *Sampler {
@@ -1521,6 +1539,54 @@ func (p *Sampler) GetCycleTimeUnit() (v TimeUnit) {
 	}
 	return *p.CycleTimeUnit
 }
+
+var Sampler_SamplingStrategy_DEFAULT SamplingStrategy
+
+func (p *Sampler) GetSamplingStrategy() (v SamplingStrategy) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSamplingStrategy() {
+		return Sampler_SamplingStrategy_DEFAULT
+	}
+	return *p.SamplingStrategy
+}
+
+var Sampler_StratifiedSampling_DEFAULT *StratifiedSampling
+
+func (p *Sampler) GetStratifiedSampling() (v *StratifiedSampling) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetStratifiedSampling() {
+		return Sampler_StratifiedSampling_DEFAULT
+	}
+	return p.StratifiedSampling
+}
+
+var Sampler_ErrorBiasedSampling_DEFAULT *ErrorBiasedSampling
+
+func (p *Sampler) GetErrorBiasedSampling() (v *ErrorBiasedSampling) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetErrorBiasedSampling() {
+		return Sampler_ErrorBiasedSampling_DEFAULT
+	}
+	return p.ErrorBiasedSampling
+}
+
+var Sampler_Dedup_DEFAULT *SampleDedup
+
+func (p *Sampler) GetDedup() (v *SampleDedup) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDedup() {
+		return Sampler_Dedup_DEFAULT
+	}
+	return p.Dedup
+}
 func (p *Sampler) SetSampleRate(val *float64) {
 	p.SampleRate = val
 }
@@ -1539,14 +1605,30 @@ func (p *Sampler) SetCycleInterval(val *int64) {
 func (p *Sampler) SetCycleTimeUnit(val *TimeUnit) {
 	p.CycleTimeUnit = val
 }
+func (p *Sampler) SetSamplingStrategy(val *SamplingStrategy) {
+	p.SamplingStrategy = val
+}
+func (p *Sampler) SetStratifiedSampling(val *StratifiedSampling) {
+	p.StratifiedSampling = val
+}
+func (p *Sampler) SetErrorBiasedSampling(val *ErrorBiasedSampling) {
+	p.ErrorBiasedSampling = val
+}
+func (p *Sampler) SetDedup(val *SampleDedup) {
+	p.Dedup = val
+}
 
 var fieldIDToName_Sampler = map[int16]string{
-	1: "sample_rate",
-	2: "sample_size",
-	3: "is_cycle",
-	4: "cycle_count",
-	5: "cycle_interval",
-	6: "cycle_time_unit",
+	1:  "sample_rate",
+	2:  "sample_size",
+	3:  "is_cycle",
+	4:  "cycle_count",
+	5:  "cycle_interval",
+	6:  "cycle_time_unit",
+	7:  "sampling_strategy",
+	8:  "stratified_sampling",
+	9:  "error_biased_sampling",
+	10: "dedup",
 }
 
 func (p *Sampler) IsSetSampleRate() bool {
@@ -1573,6 +1655,22 @@ func (p *Sampler) IsSetCycleTimeUnit() bool {
 	return p.CycleTimeUnit != nil
 }
 
+func (p *Sampler) IsSetSamplingStrategy() bool {
+	return p.SamplingStrategy != nil
+}
+
+func (p *Sampler) IsSetStratifiedSampling() bool {
+	return p.StratifiedSampling != nil
+}
+
+func (p *Sampler) IsSetErrorBiasedSampling() bool {
+	return p.ErrorBiasedSampling != nil
+}
+
+func (p *Sampler) IsSetDedup() bool {
+	return p.Dedup != nil
+}
+
 func (p *Sampler) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1639,6 +1737,38 @@ func (p *Sampler) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1734,6 +1864,41 @@ func (p *Sampler) ReadField6(iprot thrift.TProtocol) error {
 	p.CycleTimeUnit = _field
 	return nil
 }
+func (p *Sampler) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *SamplingStrategy
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SamplingStrategy = _field
+	return nil
+}
+func (p *Sampler) ReadField8(iprot thrift.TProtocol) error {
+	_field := NewStratifiedSampling()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.StratifiedSampling = _field
+	return nil
+}
+func (p *Sampler) ReadField9(iprot thrift.TProtocol) error {
+	_field := NewErrorBiasedSampling()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ErrorBiasedSampling = _field
+	return nil
+}
+func (p *Sampler) ReadField10(iprot thrift.TProtocol) error {
+	_field := NewSampleDedup()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Dedup = _field
+	return nil
+}
 
 func (p *Sampler) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1765,6 +1930,22 @@ func (p *Sampler) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1891,6 +2072,78 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
+func (p *Sampler) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSamplingStrategy() {
+		if err = oprot.WriteFieldBegin("sampling_strategy", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SamplingStrategy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *Sampler) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStratifiedSampling() {
+		if err = oprot.WriteFieldBegin("stratified_sampling", thrift.STRUCT, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.StratifiedSampling.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *Sampler) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorBiasedSampling() {
+		if err = oprot.WriteFieldBegin("error_biased_sampling", thrift.STRUCT, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.ErrorBiasedSampling.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *Sampler) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDedup() {
+		if err = oprot.WriteFieldBegin("dedup", thrift.STRUCT, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Dedup.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
 
 func (p *Sampler) String() string {
 	if p == nil {
@@ -1924,6 +2177,18 @@ func (p *Sampler) DeepEqual(ano *Sampler) bool {
 	if !p.Field6DeepEqual(ano.CycleTimeUnit) {
 		return false
 	}
+	if !p.Field7DeepEqual(ano.SamplingStrategy) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.StratifiedSampling) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.ErrorBiasedSampling) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Dedup) {
+		return false
+	}
 	return true
 }
 
@@ -1999,67 +2264,84 @@ func (p *Sampler) Field6DeepEqual(src *TimeUnit) bool {
 	}
 	return true
 }
+func (p *Sampler) Field7DeepEqual(src *SamplingStrategy) bool {
 
-type EffectiveTime struct {
-	// ms timestamp
-	StartAt *int64 `thrift:"start_at,1,optional" frugal:"1,optional,i64" json:"start_at" form:"start_at" query:"start_at"`
-	// ms timestamp
-	EndAt *int64 `thrift:"end_at,2,optional" frugal:"2,optional,i64" json:"end_at" form:"end_at" query:"end_at"`
+	if p.SamplingStrategy == src {
+		return true
+	} else if p.SamplingStrategy == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SamplingStrategy, *src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *Sampler) Field8DeepEqual(src *StratifiedSampling) bool {
 
-func NewEffectiveTime() *EffectiveTime {
-	return &EffectiveTime{}
+	if !p.StratifiedSampling.DeepEqual(src) {
+		return false
+	}
+	return true
 }
+func (p *Sampler) Field9DeepEqual(src *ErrorBiasedSampling) bool {
 
-func (p *EffectiveTime) InitDefault() {
+	if !p.ErrorBiasedSampling.DeepEqual(src) {
+		return false
+	}
+	return true
 }
+func (p *Sampler) Field10DeepEqual(src *SampleDedup) bool {
 
-var EffectiveTime_StartAt_DEFAULT int64
-
-func (p *EffectiveTime) GetStartAt() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetStartAt() {
-		return EffectiveTime_StartAt_DEFAULT
+	if !p.Dedup.DeepEqual(src) {
+		return false
 	}
-	return *p.StartAt
+	return true
 }
 
-var EffectiveTime_EndAt_DEFAULT int64
-
-func (p *EffectiveTime) GetEndAt() (v int64) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetEndAt() {
-		return EffectiveTime_EndAt_DEFAULT
-	}
-	return *p.EndAt
+type StratifiedSampling struct {
+	// 分层字段, 如prompt_key、model_name
+	FieldName string `thrift:"field_name,1,required" frugal:"1,required,string" form:"field_name,required" json:"field_name,required" query:"field_name,required"`
+	// 每层采样上限
+	PerStratumSize int64 `thrift:"per_stratum_size,2,required" frugal:"2,required,i64" form:"per_stratum_size,required" json:"per_stratum_size,required" query:"per_stratum_size,required"`
 }
-func (p *EffectiveTime) SetStartAt(val *int64) {
-	p.StartAt = val
+
+func NewStratifiedSampling() *StratifiedSampling {
+	return &StratifiedSampling{}
 }
-func (p *EffectiveTime) SetEndAt(val *int64) {
-	p.EndAt = val
+
+func (p *StratifiedSampling) InitDefault() {
 }
 
-var fieldIDToName_EffectiveTime = map[int16]string{
-	1: "start_at",
-	2: "end_at",
+func (p *StratifiedSampling) GetFieldName() (v string) {
+	if p != nil {
+		return p.FieldName
+	}
+	return
 }
 
-func (p *EffectiveTime) IsSetStartAt() bool {
-	return p.StartAt != nil
+func (p *StratifiedSampling) GetPerStratumSize() (v int64) {
+	if p != nil {
+		return p.PerStratumSize
+	}
+	return
+}
+func (p *StratifiedSampling) SetFieldName(val string) {
+	p.FieldName = val
+}
+func (p *StratifiedSampling) SetPerStratumSize(val int64) {
+	p.PerStratumSize = val
 }
 
-func (p *EffectiveTime) IsSetEndAt() bool {
-	return p.EndAt != nil
+var fieldIDToName_StratifiedSampling = map[int16]string{
+	1: "field_name",
+	2: "per_stratum_size",
 }
 
-func (p *EffectiveTime) Read(iprot thrift.TProtocol) (err error) {
+func (p *StratifiedSampling) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetFieldName bool = false
+	var issetPerStratumSize bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2076,10 +2358,11 @@ func (p *EffectiveTime) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetFieldName = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2088,6 +2371,7 @@ func (p *EffectiveTime) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetPerStratumSize = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2104,13 +2388,22 @@ func (p *EffectiveTime) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetFieldName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPerStratumSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EffectiveTime[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_StratifiedSampling[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2118,34 +2411,36 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_StratifiedSampling[fieldId]))
 }
 
-func (p *EffectiveTime) ReadField1(iprot thrift.TProtocol) error {
+func (p *StratifiedSampling) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.StartAt = _field
+	p.FieldName = _field
 	return nil
 }
-func (p *EffectiveTime) ReadField2(iprot thrift.TProtocol) error {
+func (p *StratifiedSampling) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.EndAt = _field
+	p.PerStratumSize = _field
 	return nil
 }
 
-func (p *EffectiveTime) Write(oprot thrift.TProtocol) (err error) {
+func (p *StratifiedSampling) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EffectiveTime"); err != nil {
+	if err = oprot.WriteStructBegin("StratifiedSampling"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2175,17 +2470,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *EffectiveTime) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStartAt() {
-		if err = oprot.WriteFieldBegin("start_at", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.StartAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *StratifiedSampling) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field_name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FieldName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -2193,17 +2486,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *EffectiveTime) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEndAt() {
-		if err = oprot.WriteFieldBegin("end_at", thrift.I64, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.EndAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *StratifiedSampling) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("per_stratum_size", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PerStratumSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -2212,113 +2503,80 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *EffectiveTime) String() string {
+func (p *StratifiedSampling) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EffectiveTime(%+v)", *p)
+	return fmt.Sprintf("StratifiedSampling(%+v)", *p)
 
 }
 
-func (p *EffectiveTime) DeepEqual(ano *EffectiveTime) bool {
+func (p *StratifiedSampling) DeepEqual(ano *StratifiedSampling) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.StartAt) {
+	if !p.Field1DeepEqual(ano.FieldName) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.EndAt) {
+	if !p.Field2DeepEqual(ano.PerStratumSize) {
 		return false
 	}
 	return true
 }
 
-func (p *EffectiveTime) Field1DeepEqual(src *int64) bool {
+func (p *StratifiedSampling) Field1DeepEqual(src string) bool {
 
-	if p.StartAt == src {
-		return true
-	} else if p.StartAt == nil || src == nil {
-		return false
-	}
-	if *p.StartAt != *src {
+	if strings.Compare(p.FieldName, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *EffectiveTime) Field2DeepEqual(src *int64) bool {
+func (p *StratifiedSampling) Field2DeepEqual(src int64) bool {
 
-	if p.EndAt == src {
-		return true
-	} else if p.EndAt == nil || src == nil {
-		return false
-	}
-	if *p.EndAt != *src {
+	if p.PerStratumSize != src {
 		return false
 	}
 	return true
 }
 
-// TaskConfig
-type TaskConfig struct {
-	// 配置的评测规则信息
-	AutoEvaluateConfigs []*AutoEvaluateConfig `thrift:"auto_evaluate_configs,1,optional" frugal:"1,optional,list<AutoEvaluateConfig>" form:"auto_evaluate_configs" json:"auto_evaluate_configs,omitempty" query:"auto_evaluate_configs"`
-	// 配置的数据回流的数据集信息
-	DataReflowConfig []*DataReflowConfig `thrift:"data_reflow_config,2,optional" frugal:"2,optional,list<DataReflowConfig>" form:"data_reflow_config" json:"data_reflow_config,omitempty" query:"data_reflow_config"`
-}
-
-func NewTaskConfig() *TaskConfig {
-	return &TaskConfig{}
+type ErrorBiasedSampling struct {
+	// 失败span的采样率, 默认1.0; 成功span使用sample_rate
+	ErrorSampleRate *float64 `thrift:"error_sample_rate,1,optional" frugal:"1,optional,double" form:"error_sample_rate" json:"error_sample_rate,omitempty" query:"error_sample_rate"`
 }
 
-func (p *TaskConfig) InitDefault() {
+func NewErrorBiasedSampling() *ErrorBiasedSampling {
+	return &ErrorBiasedSampling{}
 }
 
-var TaskConfig_AutoEvaluateConfigs_DEFAULT []*AutoEvaluateConfig
-
-func (p *TaskConfig) GetAutoEvaluateConfigs() (v []*AutoEvaluateConfig) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetAutoEvaluateConfigs() {
-		return TaskConfig_AutoEvaluateConfigs_DEFAULT
-	}
-	return p.AutoEvaluateConfigs
+func (p *ErrorBiasedSampling) InitDefault() {
 }
 
-var TaskConfig_DataReflowConfig_DEFAULT []*DataReflowConfig
+var ErrorBiasedSampling_ErrorSampleRate_DEFAULT float64
 
-func (p *TaskConfig) GetDataReflowConfig() (v []*DataReflowConfig) {
+func (p *ErrorBiasedSampling) GetErrorSampleRate() (v float64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDataReflowConfig() {
-		return TaskConfig_DataReflowConfig_DEFAULT
+	if !p.IsSetErrorSampleRate() {
+		return ErrorBiasedSampling_ErrorSampleRate_DEFAULT
 	}
-	return p.DataReflowConfig
-}
-func (p *TaskConfig) SetAutoEvaluateConfigs(val []*AutoEvaluateConfig) {
-	p.AutoEvaluateConfigs = val
+	return *p.ErrorSampleRate
 }
-func (p *TaskConfig) SetDataReflowConfig(val []*DataReflowConfig) {
-	p.DataReflowConfig = val
-}
-
-var fieldIDToName_TaskConfig = map[int16]string{
-	1: "auto_evaluate_configs",
-	2: "data_reflow_config",
+func (p *ErrorBiasedSampling) SetErrorSampleRate(val *float64) {
+	p.ErrorSampleRate = val
 }
 
-func (p *TaskConfig) IsSetAutoEvaluateConfigs() bool {
-	return p.AutoEvaluateConfigs != nil
+var fieldIDToName_ErrorBiasedSampling = map[int16]string{
+	1: "error_sample_rate",
 }
 
-func (p *TaskConfig) IsSetDataReflowConfig() bool {
-	return p.DataReflowConfig != nil
+func (p *ErrorBiasedSampling) IsSetErrorSampleRate() bool {
+	return p.ErrorSampleRate != nil
 }
 
-func (p *TaskConfig) Read(iprot thrift.TProtocol) (err error) {
+func (p *ErrorBiasedSampling) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2337,21 +2595,13 @@ func (p *TaskConfig) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2371,7 +2621,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TaskConfig[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ErrorBiasedSampling[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2381,56 +2631,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TaskConfig) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*AutoEvaluateConfig, 0, size)
-	values := make([]AutoEvaluateConfig, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.AutoEvaluateConfigs = _field
-	return nil
-}
-func (p *TaskConfig) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*DataReflowConfig, 0, size)
-	values := make([]DataReflowConfig, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *ErrorBiasedSampling) ReadField1(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.DataReflowConfig = _field
+	p.ErrorSampleRate = _field
 	return nil
 }
 
-func (p *TaskConfig) Write(oprot thrift.TProtocol) (err error) {
+func (p *ErrorBiasedSampling) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TaskConfig"); err != nil {
+	if err = oprot.WriteStructBegin("ErrorBiasedSampling"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2438,10 +2653,6 @@ func (p *TaskConfig) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2460,20 +2671,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TaskConfig) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAutoEvaluateConfigs() {
-		if err = oprot.WriteFieldBegin("auto_evaluate_configs", thrift.LIST, 1); err != nil {
+func (p *ErrorBiasedSampling) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorSampleRate() {
+		if err = oprot.WriteFieldBegin("error_sample_rate", thrift.DOUBLE, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AutoEvaluateConfigs)); err != nil {
-			return err
-		}
-		for _, v := range p.AutoEvaluateConfigs {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteDouble(*p.ErrorSampleRate); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2486,184 +2689,97 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *TaskConfig) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDataReflowConfig() {
-		if err = oprot.WriteFieldBegin("data_reflow_config", thrift.LIST, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.DataReflowConfig)); err != nil {
-			return err
-		}
-		for _, v := range p.DataReflowConfig {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
 
-func (p *TaskConfig) String() string {
+func (p *ErrorBiasedSampling) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TaskConfig(%+v)", *p)
+	return fmt.Sprintf("ErrorBiasedSampling(%+v)", *p)
 
 }
 
-func (p *TaskConfig) DeepEqual(ano *TaskConfig) bool {
+func (p *ErrorBiasedSampling) DeepEqual(ano *ErrorBiasedSampling) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.AutoEvaluateConfigs) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.DataReflowConfig) {
+	if !p.Field1DeepEqual(ano.ErrorSampleRate) {
 		return false
 	}
 	return true
 }
 
-func (p *TaskConfig) Field1DeepEqual(src []*AutoEvaluateConfig) bool {
+func (p *ErrorBiasedSampling) Field1DeepEqual(src *float64) bool {
 
-	if len(p.AutoEvaluateConfigs) != len(src) {
+	if p.ErrorSampleRate == src {
+		return true
+	} else if p.ErrorSampleRate == nil || src == nil {
 		return false
 	}
-	for i, v := range p.AutoEvaluateConfigs {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
-}
-func (p *TaskConfig) Field2DeepEqual(src []*DataReflowConfig) bool {
-
-	if len(p.DataReflowConfig) != len(src) {
+	if *p.ErrorSampleRate != *src {
 		return false
 	}
-	for i, v := range p.DataReflowConfig {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
 	return true
 }
 
-type DataReflowConfig struct {
-	// 数据集id，新增数据集时可为空
-	DatasetID *int64 `thrift:"dataset_id,1,optional" frugal:"1,optional,i64" json:"dataset_id" form:"dataset_id" query:"dataset_id"`
-	// 数据集名称
-	DatasetName *string `thrift:"dataset_name,2,optional" frugal:"2,optional,string" form:"dataset_name" json:"dataset_name,omitempty" query:"dataset_name"`
-	// 数据集列数据schema
-	DatasetSchema *dataset.DatasetSchema  `thrift:"dataset_schema,3,optional" frugal:"3,optional,dataset.DatasetSchema" form:"dataset_schema" json:"dataset_schema,omitempty" query:"dataset_schema"`
-	FieldMappings []*dataset.FieldMapping `thrift:"field_mappings,4,optional" frugal:"4,optional,list<dataset.FieldMapping>" form:"field_mappings" json:"field_mappings,omitempty" query:"field_mappings"`
+type SampleDedup struct {
+	Enabled *bool `thrift:"enabled,1,optional" frugal:"1,optional,bool" form:"enabled" json:"enabled,omitempty" query:"enabled"`
+	// 去重字段, 默认input
+	FieldName *string `thrift:"field_name,2,optional" frugal:"2,optional,string" form:"field_name" json:"field_name,omitempty" query:"field_name"`
 }
 
-func NewDataReflowConfig() *DataReflowConfig {
-	return &DataReflowConfig{}
+func NewSampleDedup() *SampleDedup {
+	return &SampleDedup{}
 }
 
-func (p *DataReflowConfig) InitDefault() {
+func (p *SampleDedup) InitDefault() {
 }
 
-var DataReflowConfig_DatasetID_DEFAULT int64
+var SampleDedup_Enabled_DEFAULT bool
 
-func (p *DataReflowConfig) GetDatasetID() (v int64) {
+func (p *SampleDedup) GetEnabled() (v bool) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDatasetID() {
-		return DataReflowConfig_DatasetID_DEFAULT
+	if !p.IsSetEnabled() {
+		return SampleDedup_Enabled_DEFAULT
 	}
-	return *p.DatasetID
+	return *p.Enabled
 }
 
-var DataReflowConfig_DatasetName_DEFAULT string
+var SampleDedup_FieldName_DEFAULT string
 
-func (p *DataReflowConfig) GetDatasetName() (v string) {
+func (p *SampleDedup) GetFieldName() (v string) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetDatasetName() {
-		return DataReflowConfig_DatasetName_DEFAULT
+	if !p.IsSetFieldName() {
+		return SampleDedup_FieldName_DEFAULT
 	}
-	return *p.DatasetName
+	return *p.FieldName
 }
-
-var DataReflowConfig_DatasetSchema_DEFAULT *dataset.DatasetSchema
-
-func (p *DataReflowConfig) GetDatasetSchema() (v *dataset.DatasetSchema) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetDatasetSchema() {
-		return DataReflowConfig_DatasetSchema_DEFAULT
-	}
-	return p.DatasetSchema
-}
-
-var DataReflowConfig_FieldMappings_DEFAULT []*dataset.FieldMapping
-
-func (p *DataReflowConfig) GetFieldMappings() (v []*dataset.FieldMapping) {
-	if p == nil {
-		return
-	}
-	if !p.IsSetFieldMappings() {
-		return DataReflowConfig_FieldMappings_DEFAULT
-	}
-	return p.FieldMappings
-}
-func (p *DataReflowConfig) SetDatasetID(val *int64) {
-	p.DatasetID = val
-}
-func (p *DataReflowConfig) SetDatasetName(val *string) {
-	p.DatasetName = val
-}
-func (p *DataReflowConfig) SetDatasetSchema(val *dataset.DatasetSchema) {
-	p.DatasetSchema = val
+func (p *SampleDedup) SetEnabled(val *bool) {
+	p.Enabled = val
 }
-func (p *DataReflowConfig) SetFieldMappings(val []*dataset.FieldMapping) {
-	p.FieldMappings = val
-}
-
-var fieldIDToName_DataReflowConfig = map[int16]string{
-	1: "dataset_id",
-	2: "dataset_name",
-	3: "dataset_schema",
-	4: "field_mappings",
-}
-
-func (p *DataReflowConfig) IsSetDatasetID() bool {
-	return p.DatasetID != nil
+func (p *SampleDedup) SetFieldName(val *string) {
+	p.FieldName = val
 }
 
-func (p *DataReflowConfig) IsSetDatasetName() bool {
-	return p.DatasetName != nil
+var fieldIDToName_SampleDedup = map[int16]string{
+	1: "enabled",
+	2: "field_name",
 }
 
-func (p *DataReflowConfig) IsSetDatasetSchema() bool {
-	return p.DatasetSchema != nil
+func (p *SampleDedup) IsSetEnabled() bool {
+	return p.Enabled != nil
 }
 
-func (p *DataReflowConfig) IsSetFieldMappings() bool {
-	return p.FieldMappings != nil
+func (p *SampleDedup) IsSetFieldName() bool {
+	return p.FieldName != nil
 }
 
-func (p *DataReflowConfig) Read(iprot thrift.TProtocol) (err error) {
+func (p *SampleDedup) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2682,7 +2798,7 @@ func (p *DataReflowConfig) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2697,22 +2813,6 @@ func (p *DataReflowConfig) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2732,7 +2832,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataReflowConfig[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SampleDedup[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2742,18 +2842,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DataReflowConfig) ReadField1(iprot thrift.TProtocol) error {
+func (p *SampleDedup) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.DatasetID = _field
+	p.Enabled = _field
 	return nil
 }
-func (p *DataReflowConfig) ReadField2(iprot thrift.TProtocol) error {
+func (p *SampleDedup) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2761,44 +2861,13 @@ func (p *DataReflowConfig) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.DatasetName = _field
-	return nil
-}
-func (p *DataReflowConfig) ReadField3(iprot thrift.TProtocol) error {
-	_field := dataset.NewDatasetSchema()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.DatasetSchema = _field
-	return nil
-}
-func (p *DataReflowConfig) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*dataset.FieldMapping, 0, size)
-	values := make([]dataset.FieldMapping, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.FieldMappings = _field
+	p.FieldName = _field
 	return nil
 }
 
-func (p *DataReflowConfig) Write(oprot thrift.TProtocol) (err error) {
+func (p *SampleDedup) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DataReflowConfig"); err != nil {
+	if err = oprot.WriteStructBegin("SampleDedup"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2810,14 +2879,6 @@ func (p *DataReflowConfig) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2836,12 +2897,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DataReflowConfig) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDatasetID() {
-		if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 1); err != nil {
+func (p *SampleDedup) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnabled() {
+		if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.DatasetID); err != nil {
+		if err := oprot.WriteBool(*p.Enabled); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2854,12 +2915,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *DataReflowConfig) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDatasetName() {
-		if err = oprot.WriteFieldBegin("dataset_name", thrift.STRING, 2); err != nil {
+func (p *SampleDedup) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldName() {
+		if err = oprot.WriteFieldBegin("field_name", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.DatasetName); err != nil {
+		if err := oprot.WriteString(*p.FieldName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2872,180 +2933,2085 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *DataReflowConfig) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDatasetSchema() {
-		if err = oprot.WriteFieldBegin("dataset_schema", thrift.STRUCT, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.DatasetSchema.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *DataReflowConfig) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFieldMappings() {
-		if err = oprot.WriteFieldBegin("field_mappings", thrift.LIST, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldMappings)); err != nil {
-			return err
-		}
-		for _, v := range p.FieldMappings {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
 
-func (p *DataReflowConfig) String() string {
+func (p *SampleDedup) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataReflowConfig(%+v)", *p)
+	return fmt.Sprintf("SampleDedup(%+v)", *p)
 
 }
 
-func (p *DataReflowConfig) DeepEqual(ano *DataReflowConfig) bool {
+func (p *SampleDedup) DeepEqual(ano *SampleDedup) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.DatasetID) {
-		return false
-	}
-	if !p.Field2DeepEqual(ano.DatasetName) {
-		return false
-	}
-	if !p.Field3DeepEqual(ano.DatasetSchema) {
+	if !p.Field1DeepEqual(ano.Enabled) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.FieldMappings) {
+	if !p.Field2DeepEqual(ano.FieldName) {
 		return false
 	}
 	return true
 }
 
-func (p *DataReflowConfig) Field1DeepEqual(src *int64) bool {
+func (p *SampleDedup) Field1DeepEqual(src *bool) bool {
 
-	if p.DatasetID == src {
+	if p.Enabled == src {
 		return true
-	} else if p.DatasetID == nil || src == nil {
+	} else if p.Enabled == nil || src == nil {
 		return false
 	}
-	if *p.DatasetID != *src {
+	if *p.Enabled != *src {
 		return false
 	}
 	return true
 }
-func (p *DataReflowConfig) Field2DeepEqual(src *string) bool {
+func (p *SampleDedup) Field2DeepEqual(src *string) bool {
 
-	if p.DatasetName == src {
+	if p.FieldName == src {
 		return true
-	} else if p.DatasetName == nil || src == nil {
-		return false
-	}
-	if strings.Compare(*p.DatasetName, *src) != 0 {
+	} else if p.FieldName == nil || src == nil {
 		return false
 	}
-	return true
-}
-func (p *DataReflowConfig) Field3DeepEqual(src *dataset.DatasetSchema) bool {
-
-	if !p.DatasetSchema.DeepEqual(src) {
+	if strings.Compare(*p.FieldName, *src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *DataReflowConfig) Field4DeepEqual(src []*dataset.FieldMapping) bool {
 
-	if len(p.FieldMappings) != len(src) {
-		return false
-	}
-	for i, v := range p.FieldMappings {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
-	}
-	return true
+type EffectiveTime struct {
+	// ms timestamp
+	StartAt *int64 `thrift:"start_at,1,optional" frugal:"1,optional,i64" json:"start_at" form:"start_at" query:"start_at"`
+	// ms timestamp
+	EndAt *int64 `thrift:"end_at,2,optional" frugal:"2,optional,i64" json:"end_at" form:"end_at" query:"end_at"`
 }
 
-type AutoEvaluateConfig struct {
-	EvaluatorVersionID int64                   `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
-	EvaluatorID        int64                   `thrift:"evaluator_id,2,required" frugal:"2,required,i64" json:"evaluator_id" form:"evaluator_id,required" query:"evaluator_id,required"`
-	FieldMappings      []*EvaluateFieldMapping `thrift:"field_mappings,3,required" frugal:"3,required,list<EvaluateFieldMapping>" form:"field_mappings,required" json:"field_mappings,required" query:"field_mappings,required"`
+func NewEffectiveTime() *EffectiveTime {
+	return &EffectiveTime{}
 }
 
-func NewAutoEvaluateConfig() *AutoEvaluateConfig {
-	return &AutoEvaluateConfig{}
+func (p *EffectiveTime) InitDefault() {
 }
 
-func (p *AutoEvaluateConfig) InitDefault() {
-}
+var EffectiveTime_StartAt_DEFAULT int64
 
-func (p *AutoEvaluateConfig) GetEvaluatorVersionID() (v int64) {
-	if p != nil {
-		return p.EvaluatorVersionID
+func (p *EffectiveTime) GetStartAt() (v int64) {
+	if p == nil {
+		return
 	}
-	return
-}
-
-func (p *AutoEvaluateConfig) GetEvaluatorID() (v int64) {
-	if p != nil {
-		return p.EvaluatorID
+	if !p.IsSetStartAt() {
+		return EffectiveTime_StartAt_DEFAULT
 	}
-	return
+	return *p.StartAt
 }
 
-func (p *AutoEvaluateConfig) GetFieldMappings() (v []*EvaluateFieldMapping) {
-	if p != nil {
-		return p.FieldMappings
+var EffectiveTime_EndAt_DEFAULT int64
+
+func (p *EffectiveTime) GetEndAt() (v int64) {
+	if p == nil {
+		return
 	}
-	return
+	if !p.IsSetEndAt() {
+		return EffectiveTime_EndAt_DEFAULT
+	}
+	return *p.EndAt
 }
-func (p *AutoEvaluateConfig) SetEvaluatorVersionID(val int64) {
+func (p *EffectiveTime) SetStartAt(val *int64) {
+	p.StartAt = val
+}
+func (p *EffectiveTime) SetEndAt(val *int64) {
+	p.EndAt = val
+}
+
+var fieldIDToName_EffectiveTime = map[int16]string{
+	1: "start_at",
+	2: "end_at",
+}
+
+func (p *EffectiveTime) IsSetStartAt() bool {
+	return p.StartAt != nil
+}
+
+func (p *EffectiveTime) IsSetEndAt() bool {
+	return p.EndAt != nil
+}
+
+func (p *EffectiveTime) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_EffectiveTime[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *EffectiveTime) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartAt = _field
+	return nil
+}
+func (p *EffectiveTime) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndAt = _field
+	return nil
+}
+
+func (p *EffectiveTime) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EffectiveTime"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *EffectiveTime) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartAt() {
+		if err = oprot.WriteFieldBegin("start_at", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *EffectiveTime) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndAt() {
+		if err = oprot.WriteFieldBegin("end_at", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *EffectiveTime) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EffectiveTime(%+v)", *p)
+
+}
+
+func (p *EffectiveTime) DeepEqual(ano *EffectiveTime) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.StartAt) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EndAt) {
+		return false
+	}
+	return true
+}
+
+func (p *EffectiveTime) Field1DeepEqual(src *int64) bool {
+
+	if p.StartAt == src {
+		return true
+	} else if p.StartAt == nil || src == nil {
+		return false
+	}
+	if *p.StartAt != *src {
+		return false
+	}
+	return true
+}
+func (p *EffectiveTime) Field2DeepEqual(src *int64) bool {
+
+	if p.EndAt == src {
+		return true
+	} else if p.EndAt == nil || src == nil {
+		return false
+	}
+	if *p.EndAt != *src {
+		return false
+	}
+	return true
+}
+
+// TaskConfig
+type TaskConfig struct {
+	// 配置的评测规则信息
+	AutoEvaluateConfigs []*AutoEvaluateConfig `thrift:"auto_evaluate_configs,1,optional" frugal:"1,optional,list<AutoEvaluateConfig>" form:"auto_evaluate_configs" json:"auto_evaluate_configs,omitempty" query:"auto_evaluate_configs"`
+	// 配置的数据回流的数据集信息
+	DataReflowConfig []*DataReflowConfig `thrift:"data_reflow_config,2,optional" frugal:"2,optional,list<DataReflowConfig>" form:"data_reflow_config" json:"data_reflow_config,omitempty" query:"data_reflow_config"`
+	// 配置的自动打标规则信息
+	AutoTagConfigs []*AutoTagConfig `thrift:"auto_tag_configs,3,optional" frugal:"3,optional,list<AutoTagConfig>" form:"auto_tag_configs" json:"auto_tag_configs,omitempty" query:"auto_tag_configs"`
+}
+
+func NewTaskConfig() *TaskConfig {
+	return &TaskConfig{}
+}
+
+func (p *TaskConfig) InitDefault() {
+}
+
+var TaskConfig_AutoEvaluateConfigs_DEFAULT []*AutoEvaluateConfig
+
+func (p *TaskConfig) GetAutoEvaluateConfigs() (v []*AutoEvaluateConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAutoEvaluateConfigs() {
+		return TaskConfig_AutoEvaluateConfigs_DEFAULT
+	}
+	return p.AutoEvaluateConfigs
+}
+
+var TaskConfig_DataReflowConfig_DEFAULT []*DataReflowConfig
+
+func (p *TaskConfig) GetDataReflowConfig() (v []*DataReflowConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDataReflowConfig() {
+		return TaskConfig_DataReflowConfig_DEFAULT
+	}
+	return p.DataReflowConfig
+}
+
+var TaskConfig_AutoTagConfigs_DEFAULT []*AutoTagConfig
+
+func (p *TaskConfig) GetAutoTagConfigs() (v []*AutoTagConfig) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetAutoTagConfigs() {
+		return TaskConfig_AutoTagConfigs_DEFAULT
+	}
+	return p.AutoTagConfigs
+}
+func (p *TaskConfig) SetAutoEvaluateConfigs(val []*AutoEvaluateConfig) {
+	p.AutoEvaluateConfigs = val
+}
+func (p *TaskConfig) SetDataReflowConfig(val []*DataReflowConfig) {
+	p.DataReflowConfig = val
+}
+func (p *TaskConfig) SetAutoTagConfigs(val []*AutoTagConfig) {
+	p.AutoTagConfigs = val
+}
+
+var fieldIDToName_TaskConfig = map[int16]string{
+	1: "auto_evaluate_configs",
+	2: "data_reflow_config",
+	3: "auto_tag_configs",
+}
+
+func (p *TaskConfig) IsSetAutoEvaluateConfigs() bool {
+	return p.AutoEvaluateConfigs != nil
+}
+
+func (p *TaskConfig) IsSetDataReflowConfig() bool {
+	return p.DataReflowConfig != nil
+}
+
+func (p *TaskConfig) IsSetAutoTagConfigs() bool {
+	return p.AutoTagConfigs != nil
+}
+
+func (p *TaskConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TaskConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TaskConfig) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AutoEvaluateConfig, 0, size)
+	values := make([]AutoEvaluateConfig, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AutoEvaluateConfigs = _field
+	return nil
+}
+func (p *TaskConfig) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*DataReflowConfig, 0, size)
+	values := make([]DataReflowConfig, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.DataReflowConfig = _field
+	return nil
+}
+func (p *TaskConfig) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AutoTagConfig, 0, size)
+	values := make([]AutoTagConfig, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AutoTagConfigs = _field
+	return nil
+}
+
+func (p *TaskConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TaskConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TaskConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAutoEvaluateConfigs() {
+		if err = oprot.WriteFieldBegin("auto_evaluate_configs", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AutoEvaluateConfigs)); err != nil {
+			return err
+		}
+		for _, v := range p.AutoEvaluateConfigs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *TaskConfig) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDataReflowConfig() {
+		if err = oprot.WriteFieldBegin("data_reflow_config", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.DataReflowConfig)); err != nil {
+			return err
+		}
+		for _, v := range p.DataReflowConfig {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *TaskConfig) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAutoTagConfigs() {
+		if err = oprot.WriteFieldBegin("auto_tag_configs", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AutoTagConfigs)); err != nil {
+			return err
+		}
+		for _, v := range p.AutoTagConfigs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TaskConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TaskConfig(%+v)", *p)
+
+}
+
+func (p *TaskConfig) DeepEqual(ano *TaskConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.AutoEvaluateConfigs) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.DataReflowConfig) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.AutoTagConfigs) {
+		return false
+	}
+	return true
+}
+
+func (p *TaskConfig) Field1DeepEqual(src []*AutoEvaluateConfig) bool {
+
+	if len(p.AutoEvaluateConfigs) != len(src) {
+		return false
+	}
+	for i, v := range p.AutoEvaluateConfigs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *TaskConfig) Field2DeepEqual(src []*DataReflowConfig) bool {
+
+	if len(p.DataReflowConfig) != len(src) {
+		return false
+	}
+	for i, v := range p.DataReflowConfig {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *TaskConfig) Field3DeepEqual(src []*AutoTagConfig) bool {
+
+	if len(p.AutoTagConfigs) != len(src) {
+		return false
+	}
+	for i, v := range p.AutoTagConfigs {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type DataReflowConfig struct {
+	// 数据集id，新增数据集时可为空
+	DatasetID *int64 `thrift:"dataset_id,1,optional" frugal:"1,optional,i64" json:"dataset_id" form:"dataset_id" query:"dataset_id"`
+	// 数据集名称
+	DatasetName *string `thrift:"dataset_name,2,optional" frugal:"2,optional,string" form:"dataset_name" json:"dataset_name,omitempty" query:"dataset_name"`
+	// 数据集列数据schema
+	DatasetSchema *dataset.DatasetSchema  `thrift:"dataset_schema,3,optional" frugal:"3,optional,dataset.DatasetSchema" form:"dataset_schema" json:"dataset_schema,omitempty" query:"dataset_schema"`
+	FieldMappings []*dataset.FieldMapping `thrift:"field_mappings,4,optional" frugal:"4,optional,list<dataset.FieldMapping>" form:"field_mappings" json:"field_mappings,omitempty" query:"field_mappings"`
+}
+
+func NewDataReflowConfig() *DataReflowConfig {
+	return &DataReflowConfig{}
+}
+
+func (p *DataReflowConfig) InitDefault() {
+}
+
+var DataReflowConfig_DatasetID_DEFAULT int64
+
+func (p *DataReflowConfig) GetDatasetID() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDatasetID() {
+		return DataReflowConfig_DatasetID_DEFAULT
+	}
+	return *p.DatasetID
+}
+
+var DataReflowConfig_DatasetName_DEFAULT string
+
+func (p *DataReflowConfig) GetDatasetName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDatasetName() {
+		return DataReflowConfig_DatasetName_DEFAULT
+	}
+	return *p.DatasetName
+}
+
+var DataReflowConfig_DatasetSchema_DEFAULT *dataset.DatasetSchema
+
+func (p *DataReflowConfig) GetDatasetSchema() (v *dataset.DatasetSchema) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDatasetSchema() {
+		return DataReflowConfig_DatasetSchema_DEFAULT
+	}
+	return p.DatasetSchema
+}
+
+var DataReflowConfig_FieldMappings_DEFAULT []*dataset.FieldMapping
+
+func (p *DataReflowConfig) GetFieldMappings() (v []*dataset.FieldMapping) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFieldMappings() {
+		return DataReflowConfig_FieldMappings_DEFAULT
+	}
+	return p.FieldMappings
+}
+func (p *DataReflowConfig) SetDatasetID(val *int64) {
+	p.DatasetID = val
+}
+func (p *DataReflowConfig) SetDatasetName(val *string) {
+	p.DatasetName = val
+}
+func (p *DataReflowConfig) SetDatasetSchema(val *dataset.DatasetSchema) {
+	p.DatasetSchema = val
+}
+func (p *DataReflowConfig) SetFieldMappings(val []*dataset.FieldMapping) {
+	p.FieldMappings = val
+}
+
+var fieldIDToName_DataReflowConfig = map[int16]string{
+	1: "dataset_id",
+	2: "dataset_name",
+	3: "dataset_schema",
+	4: "field_mappings",
+}
+
+func (p *DataReflowConfig) IsSetDatasetID() bool {
+	return p.DatasetID != nil
+}
+
+func (p *DataReflowConfig) IsSetDatasetName() bool {
+	return p.DatasetName != nil
+}
+
+func (p *DataReflowConfig) IsSetDatasetSchema() bool {
+	return p.DatasetSchema != nil
+}
+
+func (p *DataReflowConfig) IsSetFieldMappings() bool {
+	return p.FieldMappings != nil
+}
+
+func (p *DataReflowConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DataReflowConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DataReflowConfig) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DatasetID = _field
+	return nil
+}
+func (p *DataReflowConfig) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DatasetName = _field
+	return nil
+}
+func (p *DataReflowConfig) ReadField3(iprot thrift.TProtocol) error {
+	_field := dataset.NewDatasetSchema()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.DatasetSchema = _field
+	return nil
+}
+func (p *DataReflowConfig) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*dataset.FieldMapping, 0, size)
+	values := make([]dataset.FieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldMappings = _field
+	return nil
+}
+
+func (p *DataReflowConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DataReflowConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DataReflowConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDatasetID() {
+		if err = oprot.WriteFieldBegin("dataset_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DatasetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DataReflowConfig) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDatasetName() {
+		if err = oprot.WriteFieldBegin("dataset_name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DatasetName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *DataReflowConfig) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDatasetSchema() {
+		if err = oprot.WriteFieldBegin("dataset_schema", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.DatasetSchema.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *DataReflowConfig) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldMappings() {
+		if err = oprot.WriteFieldBegin("field_mappings", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldMappings)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldMappings {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *DataReflowConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DataReflowConfig(%+v)", *p)
+
+}
+
+func (p *DataReflowConfig) DeepEqual(ano *DataReflowConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.DatasetID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.DatasetName) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.DatasetSchema) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.FieldMappings) {
+		return false
+	}
+	return true
+}
+
+func (p *DataReflowConfig) Field1DeepEqual(src *int64) bool {
+
+	if p.DatasetID == src {
+		return true
+	} else if p.DatasetID == nil || src == nil {
+		return false
+	}
+	if *p.DatasetID != *src {
+		return false
+	}
+	return true
+}
+func (p *DataReflowConfig) Field2DeepEqual(src *string) bool {
+
+	if p.DatasetName == src {
+		return true
+	} else if p.DatasetName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.DatasetName, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *DataReflowConfig) Field3DeepEqual(src *dataset.DatasetSchema) bool {
+
+	if !p.DatasetSchema.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *DataReflowConfig) Field4DeepEqual(src []*dataset.FieldMapping) bool {
+
+	if len(p.FieldMappings) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldMappings {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type AutoEvaluateConfig struct {
+	EvaluatorVersionID int64                   `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
+	EvaluatorID        int64                   `thrift:"evaluator_id,2,required" frugal:"2,required,i64" json:"evaluator_id" form:"evaluator_id,required" query:"evaluator_id,required"`
+	FieldMappings      []*EvaluateFieldMapping `thrift:"field_mappings,3,required" frugal:"3,required,list<EvaluateFieldMapping>" form:"field_mappings,required" json:"field_mappings,required" query:"field_mappings,required"`
+}
+
+func NewAutoEvaluateConfig() *AutoEvaluateConfig {
+	return &AutoEvaluateConfig{}
+}
+
+func (p *AutoEvaluateConfig) InitDefault() {
+}
+
+func (p *AutoEvaluateConfig) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
+	}
+	return
+}
+
+func (p *AutoEvaluateConfig) GetEvaluatorID() (v int64) {
+	if p != nil {
+		return p.EvaluatorID
+	}
+	return
+}
+
+func (p *AutoEvaluateConfig) GetFieldMappings() (v []*EvaluateFieldMapping) {
+	if p != nil {
+		return p.FieldMappings
+	}
+	return
+}
+func (p *AutoEvaluateConfig) SetEvaluatorVersionID(val int64) {
+	p.EvaluatorVersionID = val
+}
+func (p *AutoEvaluateConfig) SetEvaluatorID(val int64) {
+	p.EvaluatorID = val
+}
+func (p *AutoEvaluateConfig) SetFieldMappings(val []*EvaluateFieldMapping) {
+	p.FieldMappings = val
+}
+
+var fieldIDToName_AutoEvaluateConfig = map[int16]string{
+	1: "evaluator_version_id",
+	2: "evaluator_id",
+	3: "field_mappings",
+}
+
+func (p *AutoEvaluateConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetEvaluatorVersionID bool = false
+	var issetEvaluatorID bool = false
+	var issetFieldMappings bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFieldMappings = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFieldMappings {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AutoEvaluateConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AutoEvaluateConfig[fieldId]))
+}
+
+func (p *AutoEvaluateConfig) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *AutoEvaluateConfig) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorID = _field
+	return nil
+}
+func (p *AutoEvaluateConfig) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluateFieldMapping, 0, size)
+	values := make([]EvaluateFieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldMappings = _field
+	return nil
+}
+
+func (p *AutoEvaluateConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AutoEvaluateConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AutoEvaluateConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AutoEvaluateConfig) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AutoEvaluateConfig) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field_mappings", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldMappings)); err != nil {
+		return err
+	}
+	for _, v := range p.FieldMappings {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AutoEvaluateConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AutoEvaluateConfig(%+v)", *p)
+
+}
+
+func (p *AutoEvaluateConfig) DeepEqual(ano *AutoEvaluateConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.FieldMappings) {
+		return false
+	}
+	return true
+}
+
+func (p *AutoEvaluateConfig) Field1DeepEqual(src int64) bool {
+
+	if p.EvaluatorVersionID != src {
+		return false
+	}
+	return true
+}
+func (p *AutoEvaluateConfig) Field2DeepEqual(src int64) bool {
+
+	if p.EvaluatorID != src {
+		return false
+	}
+	return true
+}
+func (p *AutoEvaluateConfig) Field3DeepEqual(src []*EvaluateFieldMapping) bool {
+
+	if len(p.FieldMappings) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldMappings {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type AutoTagConfig struct {
+	EvaluatorVersionID int64                   `thrift:"evaluator_version_id,1,required" frugal:"1,required,i64" json:"evaluator_version_id" form:"evaluator_version_id,required" query:"evaluator_version_id,required"`
+	EvaluatorID        int64                   `thrift:"evaluator_id,2,required" frugal:"2,required,i64" json:"evaluator_id" form:"evaluator_id,required" query:"evaluator_id,required"`
+	FieldMappings      []*EvaluateFieldMapping `thrift:"field_mappings,3,required" frugal:"3,required,list<EvaluateFieldMapping>" form:"field_mappings,required" json:"field_mappings,required" query:"field_mappings,required"`
+	// 写回span的标签key, 如intent/topic/language/sentiment
+	TagKey string `thrift:"tag_key,4,required" frugal:"4,required,string" form:"tag_key,required" json:"tag_key,required" query:"tag_key,required"`
+	// 候选标签, 按评估器分数下标或reasoning匹配取值
+	Labels []string `thrift:"labels,5,optional" frugal:"5,optional,list<string>" form:"labels" json:"labels,omitempty" query:"labels"`
+}
+
+func NewAutoTagConfig() *AutoTagConfig {
+	return &AutoTagConfig{}
+}
+
+func (p *AutoTagConfig) InitDefault() {
+}
+
+func (p *AutoTagConfig) GetEvaluatorVersionID() (v int64) {
+	if p != nil {
+		return p.EvaluatorVersionID
+	}
+	return
+}
+
+func (p *AutoTagConfig) GetEvaluatorID() (v int64) {
+	if p != nil {
+		return p.EvaluatorID
+	}
+	return
+}
+
+func (p *AutoTagConfig) GetFieldMappings() (v []*EvaluateFieldMapping) {
+	if p != nil {
+		return p.FieldMappings
+	}
+	return
+}
+
+func (p *AutoTagConfig) GetTagKey() (v string) {
+	if p != nil {
+		return p.TagKey
+	}
+	return
+}
+
+var AutoTagConfig_Labels_DEFAULT []string
+
+func (p *AutoTagConfig) GetLabels() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLabels() {
+		return AutoTagConfig_Labels_DEFAULT
+	}
+	return p.Labels
+}
+func (p *AutoTagConfig) SetEvaluatorVersionID(val int64) {
 	p.EvaluatorVersionID = val
 }
-func (p *AutoEvaluateConfig) SetEvaluatorID(val int64) {
-	p.EvaluatorID = val
+func (p *AutoTagConfig) SetEvaluatorID(val int64) {
+	p.EvaluatorID = val
+}
+func (p *AutoTagConfig) SetFieldMappings(val []*EvaluateFieldMapping) {
+	p.FieldMappings = val
+}
+func (p *AutoTagConfig) SetTagKey(val string) {
+	p.TagKey = val
+}
+func (p *AutoTagConfig) SetLabels(val []string) {
+	p.Labels = val
+}
+
+var fieldIDToName_AutoTagConfig = map[int16]string{
+	1: "evaluator_version_id",
+	2: "evaluator_id",
+	3: "field_mappings",
+	4: "tag_key",
+	5: "labels",
+}
+
+func (p *AutoTagConfig) IsSetLabels() bool {
+	return p.Labels != nil
+}
+
+func (p *AutoTagConfig) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetEvaluatorVersionID bool = false
+	var issetEvaluatorID bool = false
+	var issetFieldMappings bool = false
+	var issetTagKey bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorVersionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEvaluatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFieldMappings = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTagKey = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetEvaluatorVersionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEvaluatorID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFieldMappings {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTagKey {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AutoTagConfig[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AutoTagConfig[fieldId]))
+}
+
+func (p *AutoTagConfig) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorVersionID = _field
+	return nil
+}
+func (p *AutoTagConfig) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EvaluatorID = _field
+	return nil
+}
+func (p *AutoTagConfig) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*EvaluateFieldMapping, 0, size)
+	values := make([]EvaluateFieldMapping, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldMappings = _field
+	return nil
+}
+func (p *AutoTagConfig) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TagKey = _field
+	return nil
+}
+func (p *AutoTagConfig) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Labels = _field
+	return nil
+}
+
+func (p *AutoTagConfig) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AutoTagConfig"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AutoTagConfig) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *AutoTagConfig) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EvaluatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AutoTagConfig) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field_mappings", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldMappings)); err != nil {
+		return err
+	}
+	for _, v := range p.FieldMappings {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *AutoTagConfig) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tag_key", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TagKey); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *AutoTagConfig) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabels() {
+		if err = oprot.WriteFieldBegin("labels", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Labels)); err != nil {
+			return err
+		}
+		for _, v := range p.Labels {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *AutoEvaluateConfig) SetFieldMappings(val []*EvaluateFieldMapping) {
-	p.FieldMappings = val
+
+func (p *AutoTagConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AutoTagConfig(%+v)", *p)
+
 }
 
-var fieldIDToName_AutoEvaluateConfig = map[int16]string{
-	1: "evaluator_version_id",
-	2: "evaluator_id",
-	3: "field_mappings",
+func (p *AutoTagConfig) DeepEqual(ano *AutoTagConfig) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.EvaluatorID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.FieldMappings) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TagKey) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Labels) {
+		return false
+	}
+	return true
 }
 
-func (p *AutoEvaluateConfig) Read(iprot thrift.TProtocol) (err error) {
+func (p *AutoTagConfig) Field1DeepEqual(src int64) bool {
+
+	if p.EvaluatorVersionID != src {
+		return false
+	}
+	return true
+}
+func (p *AutoTagConfig) Field2DeepEqual(src int64) bool {
+
+	if p.EvaluatorID != src {
+		return false
+	}
+	return true
+}
+func (p *AutoTagConfig) Field3DeepEqual(src []*EvaluateFieldMapping) bool {
+
+	if len(p.FieldMappings) != len(src) {
+		return false
+	}
+	for i, v := range p.FieldMappings {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *AutoTagConfig) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.TagKey, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *AutoTagConfig) Field5DeepEqual(src []string) bool {
+
+	if len(p.Labels) != len(src) {
+		return false
+	}
+	for i, v := range p.Labels {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// RunDetail
+type RunDetail struct {
+	SuccessCount *int64 `thrift:"success_count,1,optional" frugal:"1,optional,i64" form:"success_count" json:"success_count,omitempty" query:"success_count"`
+	FailedCount  *int64 `thrift:"failed_count,2,optional" frugal:"2,optional,i64" form:"failed_count" json:"failed_count,omitempty" query:"failed_count"`
+	TotalCount   *int64 `thrift:"total_count,3,optional" frugal:"3,optional,i64" form:"total_count" json:"total_count,omitempty" query:"total_count"`
+	// 实际采样情况
+	SampleStats *SampleStats `thrift:"sample_stats,4,optional" frugal:"4,optional,SampleStats" form:"sample_stats" json:"sample_stats,omitempty" query:"sample_stats"`
+}
+
+func NewRunDetail() *RunDetail {
+	return &RunDetail{}
+}
+
+func (p *RunDetail) InitDefault() {
+}
+
+var RunDetail_SuccessCount_DEFAULT int64
+
+func (p *RunDetail) GetSuccessCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccessCount() {
+		return RunDetail_SuccessCount_DEFAULT
+	}
+	return *p.SuccessCount
+}
+
+var RunDetail_FailedCount_DEFAULT int64
+
+func (p *RunDetail) GetFailedCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFailedCount() {
+		return RunDetail_FailedCount_DEFAULT
+	}
+	return *p.FailedCount
+}
+
+var RunDetail_TotalCount_DEFAULT int64
+
+func (p *RunDetail) GetTotalCount() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTotalCount() {
+		return RunDetail_TotalCount_DEFAULT
+	}
+	return *p.TotalCount
+}
+
+var RunDetail_SampleStats_DEFAULT *SampleStats
+
+func (p *RunDetail) GetSampleStats() (v *SampleStats) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSampleStats() {
+		return RunDetail_SampleStats_DEFAULT
+	}
+	return p.SampleStats
+}
+func (p *RunDetail) SetSuccessCount(val *int64) {
+	p.SuccessCount = val
+}
+func (p *RunDetail) SetFailedCount(val *int64) {
+	p.FailedCount = val
+}
+func (p *RunDetail) SetTotalCount(val *int64) {
+	p.TotalCount = val
+}
+func (p *RunDetail) SetSampleStats(val *SampleStats) {
+	p.SampleStats = val
+}
+
+var fieldIDToName_RunDetail = map[int16]string{
+	1: "success_count",
+	2: "failed_count",
+	3: "total_count",
+	4: "sample_stats",
+}
+
+func (p *RunDetail) IsSetSuccessCount() bool {
+	return p.SuccessCount != nil
+}
+
+func (p *RunDetail) IsSetFailedCount() bool {
+	return p.FailedCount != nil
+}
+
+func (p *RunDetail) IsSetTotalCount() bool {
+	return p.TotalCount != nil
+}
+
+func (p *RunDetail) IsSetSampleStats() bool {
+	return p.SampleStats != nil
+}
+
+func (p *RunDetail) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetEvaluatorVersionID bool = false
-	var issetEvaluatorID bool = false
-	var issetFieldMappings bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3066,7 +5032,6 @@ func (p *AutoEvaluateConfig) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorVersionID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3075,16 +5040,22 @@ func (p *AutoEvaluateConfig) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetEvaluatorID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetFieldMappings = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3101,27 +5072,13 @@ func (p *AutoEvaluateConfig) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetEvaluatorVersionID {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetEvaluatorID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetFieldMappings {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AutoEvaluateConfig[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunDetail[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3129,59 +5086,53 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AutoEvaluateConfig[fieldId]))
 }
 
-func (p *AutoEvaluateConfig) ReadField1(iprot thrift.TProtocol) error {
+func (p *RunDetail) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.EvaluatorVersionID = _field
+	p.SuccessCount = _field
 	return nil
 }
-func (p *AutoEvaluateConfig) ReadField2(iprot thrift.TProtocol) error {
+func (p *RunDetail) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
-	}
-	p.EvaluatorID = _field
-	return nil
-}
-func (p *AutoEvaluateConfig) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
+		_field = &v
 	}
-	_field := make([]*EvaluateFieldMapping, 0, size)
-	values := make([]EvaluateFieldMapping, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.FailedCount = _field
+	return nil
+}
+func (p *RunDetail) ReadField3(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	p.TotalCount = _field
+	return nil
+}
+func (p *RunDetail) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewSampleStats()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.FieldMappings = _field
+	p.SampleStats = _field
 	return nil
 }
 
-func (p *AutoEvaluateConfig) Write(oprot thrift.TProtocol) (err error) {
+func (p *RunDetail) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AutoEvaluateConfig"); err != nil {
+	if err = oprot.WriteStructBegin("RunDetail"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3197,6 +5148,10 @@ func (p *AutoEvaluateConfig) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3215,15 +5170,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AutoEvaluateConfig) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_version_id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvaluatorVersionID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *RunDetail) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccessCount() {
+		if err = oprot.WriteFieldBegin("success_count", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SuccessCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -3231,15 +5188,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AutoEvaluateConfig) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("evaluator_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EvaluatorID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *RunDetail) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFailedCount() {
+		if err = oprot.WriteFieldBegin("failed_count", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.FailedCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -3247,23 +5206,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *AutoEvaluateConfig) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("field_mappings", thrift.LIST, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldMappings)); err != nil {
-		return err
-	}
-	for _, v := range p.FieldMappings {
-		if err := v.Write(oprot); err != nil {
+func (p *RunDetail) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotalCount() {
+		if err = oprot.WriteFieldBegin("total_count", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TotalCount); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -3271,139 +5224,178 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *RunDetail) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSampleStats() {
+		if err = oprot.WriteFieldBegin("sample_stats", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.SampleStats.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
-func (p *AutoEvaluateConfig) String() string {
+func (p *RunDetail) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AutoEvaluateConfig(%+v)", *p)
+	return fmt.Sprintf("RunDetail(%+v)", *p)
 
 }
 
-func (p *AutoEvaluateConfig) DeepEqual(ano *AutoEvaluateConfig) bool {
+func (p *RunDetail) DeepEqual(ano *RunDetail) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.EvaluatorVersionID) {
+	if !p.Field1DeepEqual(ano.SuccessCount) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.EvaluatorID) {
+	if !p.Field2DeepEqual(ano.FailedCount) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.FieldMappings) {
+	if !p.Field3DeepEqual(ano.TotalCount) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.SampleStats) {
 		return false
 	}
 	return true
 }
 
-func (p *AutoEvaluateConfig) Field1DeepEqual(src int64) bool {
+func (p *RunDetail) Field1DeepEqual(src *int64) bool {
 
-	if p.EvaluatorVersionID != src {
+	if p.SuccessCount == src {
+		return true
+	} else if p.SuccessCount == nil || src == nil {
+		return false
+	}
+	if *p.SuccessCount != *src {
 		return false
 	}
 	return true
 }
-func (p *AutoEvaluateConfig) Field2DeepEqual(src int64) bool {
+func (p *RunDetail) Field2DeepEqual(src *int64) bool {
 
-	if p.EvaluatorID != src {
+	if p.FailedCount == src {
+		return true
+	} else if p.FailedCount == nil || src == nil {
+		return false
+	}
+	if *p.FailedCount != *src {
 		return false
 	}
 	return true
 }
-func (p *AutoEvaluateConfig) Field3DeepEqual(src []*EvaluateFieldMapping) bool {
+func (p *RunDetail) Field3DeepEqual(src *int64) bool {
 
-	if len(p.FieldMappings) != len(src) {
+	if p.TotalCount == src {
+		return true
+	} else if p.TotalCount == nil || src == nil {
 		return false
 	}
-	for i, v := range p.FieldMappings {
-		_src := src[i]
-		if !v.DeepEqual(_src) {
-			return false
-		}
+	if *p.TotalCount != *src {
+		return false
 	}
 	return true
 }
+func (p *RunDetail) Field4DeepEqual(src *SampleStats) bool {
 
-// RunDetail
-type RunDetail struct {
-	SuccessCount *int64 `thrift:"success_count,1,optional" frugal:"1,optional,i64" form:"success_count" json:"success_count,omitempty" query:"success_count"`
-	FailedCount  *int64 `thrift:"failed_count,2,optional" frugal:"2,optional,i64" form:"failed_count" json:"failed_count,omitempty" query:"failed_count"`
-	TotalCount   *int64 `thrift:"total_count,3,optional" frugal:"3,optional,i64" form:"total_count" json:"total_count,omitempty" query:"total_count"`
+	if !p.SampleStats.DeepEqual(src) {
+		return false
+	}
+	return true
 }
 
-func NewRunDetail() *RunDetail {
-	return &RunDetail{}
+type SampleStats struct {
+	// 分层采样各层的实际样本数
+	StratumCounts map[string]int64 `thrift:"stratum_counts,1,optional" frugal:"1,optional,map<string:i64>" form:"stratum_counts" json:"stratum_counts,omitempty" query:"stratum_counts"`
+	// 采样到的失败span数
+	ErrorCount *int64 `thrift:"error_count,2,optional" frugal:"2,optional,i64" form:"error_count" json:"error_count,omitempty" query:"error_count"`
+	// 因输入相似被跳过的span数
+	DedupSkippedCount *int64 `thrift:"dedup_skipped_count,3,optional" frugal:"3,optional,i64" form:"dedup_skipped_count" json:"dedup_skipped_count,omitempty" query:"dedup_skipped_count"`
 }
 
-func (p *RunDetail) InitDefault() {
+func NewSampleStats() *SampleStats {
+	return &SampleStats{}
 }
 
-var RunDetail_SuccessCount_DEFAULT int64
+func (p *SampleStats) InitDefault() {
+}
 
-func (p *RunDetail) GetSuccessCount() (v int64) {
+var SampleStats_StratumCounts_DEFAULT map[string]int64
+
+func (p *SampleStats) GetStratumCounts() (v map[string]int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetSuccessCount() {
-		return RunDetail_SuccessCount_DEFAULT
+	if !p.IsSetStratumCounts() {
+		return SampleStats_StratumCounts_DEFAULT
 	}
-	return *p.SuccessCount
+	return p.StratumCounts
 }
 
-var RunDetail_FailedCount_DEFAULT int64
+var SampleStats_ErrorCount_DEFAULT int64
 
-func (p *RunDetail) GetFailedCount() (v int64) {
+func (p *SampleStats) GetErrorCount() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetFailedCount() {
-		return RunDetail_FailedCount_DEFAULT
+	if !p.IsSetErrorCount() {
+		return SampleStats_ErrorCount_DEFAULT
 	}
-	return *p.FailedCount
+	return *p.ErrorCount
 }
 
-var RunDetail_TotalCount_DEFAULT int64
+var SampleStats_DedupSkippedCount_DEFAULT int64
 
-func (p *RunDetail) GetTotalCount() (v int64) {
+func (p *SampleStats) GetDedupSkippedCount() (v int64) {
 	if p == nil {
 		return
 	}
-	if !p.IsSetTotalCount() {
-		return RunDetail_TotalCount_DEFAULT
+	if !p.IsSetDedupSkippedCount() {
+		return SampleStats_DedupSkippedCount_DEFAULT
 	}
-	return *p.TotalCount
+	return *p.DedupSkippedCount
 }
-func (p *RunDetail) SetSuccessCount(val *int64) {
-	p.SuccessCount = val
+func (p *SampleStats) SetStratumCounts(val map[string]int64) {
+	p.StratumCounts = val
 }
-func (p *RunDetail) SetFailedCount(val *int64) {
-	p.FailedCount = val
+func (p *SampleStats) SetErrorCount(val *int64) {
+	p.ErrorCount = val
 }
-func (p *RunDetail) SetTotalCount(val *int64) {
-	p.TotalCount = val
+func (p *SampleStats) SetDedupSkippedCount(val *int64) {
+	p.DedupSkippedCount = val
 }
 
-var fieldIDToName_RunDetail = map[int16]string{
-	1: "success_count",
-	2: "failed_count",
-	3: "total_count",
+var fieldIDToName_SampleStats = map[int16]string{
+	1: "stratum_counts",
+	2: "error_count",
+	3: "dedup_skipped_count",
 }
 
-func (p *RunDetail) IsSetSuccessCount() bool {
-	return p.SuccessCount != nil
+func (p *SampleStats) IsSetStratumCounts() bool {
+	return p.StratumCounts != nil
 }
 
-func (p *RunDetail) IsSetFailedCount() bool {
-	return p.FailedCount != nil
+func (p *SampleStats) IsSetErrorCount() bool {
+	return p.ErrorCount != nil
 }
 
-func (p *RunDetail) IsSetTotalCount() bool {
-	return p.TotalCount != nil
+func (p *SampleStats) IsSetDedupSkippedCount() bool {
+	return p.DedupSkippedCount != nil
 }
 
-func (p *RunDetail) Read(iprot thrift.TProtocol) (err error) {
+func (p *SampleStats) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3422,7 +5414,7 @@ func (p *RunDetail) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3464,7 +5456,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RunDetail[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SampleStats[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3474,18 +5466,36 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RunDetail) ReadField1(iprot thrift.TProtocol) error {
+func (p *SampleStats) ReadField1(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]int64, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+		var _val int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.SuccessCount = _field
+	p.StratumCounts = _field
 	return nil
 }
-func (p *RunDetail) ReadField2(iprot thrift.TProtocol) error {
+func (p *SampleStats) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3493,10 +5503,10 @@ func (p *RunDetail) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.FailedCount = _field
+	p.ErrorCount = _field
 	return nil
 }
-func (p *RunDetail) ReadField3(iprot thrift.TProtocol) error {
+func (p *SampleStats) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3504,13 +5514,13 @@ func (p *RunDetail) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.TotalCount = _field
+	p.DedupSkippedCount = _field
 	return nil
 }
 
-func (p *RunDetail) Write(oprot thrift.TProtocol) (err error) {
+func (p *SampleStats) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RunDetail"); err != nil {
+	if err = oprot.WriteStructBegin("SampleStats"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3544,12 +5554,23 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RunDetail) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccessCount() {
-		if err = oprot.WriteFieldBegin("success_count", thrift.I64, 1); err != nil {
+func (p *SampleStats) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStratumCounts() {
+		if err = oprot.WriteFieldBegin("stratum_counts", thrift.MAP, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.SuccessCount); err != nil {
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.I64, len(p.StratumCounts)); err != nil {
+			return err
+		}
+		for k, v := range p.StratumCounts {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3562,12 +5583,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *RunDetail) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFailedCount() {
-		if err = oprot.WriteFieldBegin("failed_count", thrift.I64, 2); err != nil {
+func (p *SampleStats) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorCount() {
+		if err = oprot.WriteFieldBegin("error_count", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.FailedCount); err != nil {
+		if err := oprot.WriteI64(*p.ErrorCount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3580,12 +5601,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *RunDetail) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTotalCount() {
-		if err = oprot.WriteFieldBegin("total_count", thrift.I64, 3); err != nil {
+func (p *SampleStats) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDedupSkippedCount() {
+		if err = oprot.WriteFieldBegin("dedup_skipped_count", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.TotalCount); err != nil {
+		if err := oprot.WriteI64(*p.DedupSkippedCount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3599,64 +5620,65 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *RunDetail) String() string {
+func (p *SampleStats) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RunDetail(%+v)", *p)
+	return fmt.Sprintf("SampleStats(%+v)", *p)
 
 }
 
-func (p *RunDetail) DeepEqual(ano *RunDetail) bool {
+func (p *SampleStats) DeepEqual(ano *SampleStats) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.SuccessCount) {
+	if !p.Field1DeepEqual(ano.StratumCounts) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.FailedCount) {
+	if !p.Field2DeepEqual(ano.ErrorCount) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.TotalCount) {
+	if !p.Field3DeepEqual(ano.DedupSkippedCount) {
 		return false
 	}
 	return true
 }
 
-func (p *RunDetail) Field1DeepEqual(src *int64) bool {
+func (p *SampleStats) Field1DeepEqual(src map[string]int64) bool {
 
-	if p.SuccessCount == src {
-		return true
-	} else if p.SuccessCount == nil || src == nil {
+	if len(p.StratumCounts) != len(src) {
 		return false
 	}
-	if *p.SuccessCount != *src {
-		return false
+	for k, v := range p.StratumCounts {
+		_src := src[k]
+		if v != _src {
+			return false
+		}
 	}
 	return true
 }
-func (p *RunDetail) Field2DeepEqual(src *int64) bool {
+func (p *SampleStats) Field2DeepEqual(src *int64) bool {
 
-	if p.FailedCount == src {
+	if p.ErrorCount == src {
 		return true
-	} else if p.FailedCount == nil || src == nil {
+	} else if p.ErrorCount == nil || src == nil {
 		return false
 	}
-	if *p.FailedCount != *src {
+	if *p.ErrorCount != *src {
 		return false
 	}
 	return true
 }
-func (p *RunDetail) Field3DeepEqual(src *int64) bool {
+func (p *SampleStats) Field3DeepEqual(src *int64) bool {
 
-	if p.TotalCount == src {
+	if p.DedupSkippedCount == src {
 		return true
-	} else if p.TotalCount == nil || src == nil {
+	} else if p.DedupSkippedCount == nil || src == nil {
 		return false
 	}
-	if *p.TotalCount != *src {
+	if *p.DedupSkippedCount != *src {
 		return false
 	}
 	return true
@@ -73,6 +73,30 @@ func (p *Rule) IsValid() error {
 	return nil
 }
 func (p *Sampler) IsValid() error {
+	if p.StratifiedSampling != nil {
+		if err := p.StratifiedSampling.IsValid(); err != nil {
+			return fmt.Errorf("field StratifiedSampling not valid, %w", err)
+		}
+	}
+	if p.ErrorBiasedSampling != nil {
+		if err := p.ErrorBiasedSampling.IsValid(); err != nil {
+			return fmt.Errorf("field ErrorBiasedSampling not valid, %w", err)
+		}
+	}
+	if p.Dedup != nil {
+		if err := p.Dedup.IsValid(); err != nil {
+			return fmt.Errorf("field Dedup not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *StratifiedSampling) IsValid() error {
+	return nil
+}
+func (p *ErrorBiasedSampling) IsValid() error {
+	return nil
+}
+func (p *SampleDedup) IsValid() error {
 	return nil
 }
 func (p *EffectiveTime) IsValid() error {
@@ -99,7 +123,18 @@ func (p *DataReflowConfig) IsValid() error {
 func (p *AutoEvaluateConfig) IsValid() error {
 	return nil
 }
+func (p *AutoTagConfig) IsValid() error {
+	return nil
+}
 func (p *RunDetail) IsValid() error {
+	if p.SampleStats != nil {
+		if err := p.SampleStats.IsValid(); err != nil {
+			return fmt.Errorf("field SampleStats not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *SampleStats) IsValid() error {
 	return nil
 }
 func (p *BackfillDetail) IsValid() error {
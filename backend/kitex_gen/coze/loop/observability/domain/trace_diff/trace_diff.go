@@ -0,0 +1,2098 @@
+// Code generated by thriftgo (0.4.1). DO NOT EDIT.
+
+package trace_diff
+
+import (
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"strings"
+)
+
+const (
+	DiffTypeSame = "same"
+
+	DiffTypeChanged = "changed"
+
+	DiffTypeAdded = "added"
+
+	DiffTypeRemoved = "removed"
+
+	TextDiffOpEqual = "equal"
+
+	TextDiffOpInsert = "insert"
+
+	TextDiffOpDelete = "delete"
+)
+
+type DiffType = string
+
+type TextDiffOp = string
+
+type TextDiffLine struct {
+	Op   TextDiffOp `thrift:"op,1,required" frugal:"1,required,string" form:"op,required" json:"op,required" query:"op,required"`
+	Text string     `thrift:"text,2,required" frugal:"2,required,string" form:"text,required" json:"text,required" query:"text,required"`
+}
+
+func NewTextDiffLine() *TextDiffLine {
+	return &TextDiffLine{}
+}
+
+func (p *TextDiffLine) InitDefault() {
+}
+
+func (p *TextDiffLine) GetOp() (v TextDiffOp) {
+	if p != nil {
+		return p.Op
+	}
+	return
+}
+
+func (p *TextDiffLine) GetText() (v string) {
+	if p != nil {
+		return p.Text
+	}
+	return
+}
+func (p *TextDiffLine) SetOp(val TextDiffOp) {
+	p.Op = val
+}
+func (p *TextDiffLine) SetText(val string) {
+	p.Text = val
+}
+
+var fieldIDToName_TextDiffLine = map[int16]string{
+	1: "op",
+	2: "text",
+}
+
+func (p *TextDiffLine) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetOp bool = false
+	var issetText bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetText = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetOp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetText {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TextDiffLine[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TextDiffLine[fieldId]))
+}
+
+func (p *TextDiffLine) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field TextDiffOp
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Op = _field
+	return nil
+}
+func (p *TextDiffLine) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Text = _field
+	return nil
+}
+
+func (p *TextDiffLine) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TextDiffLine"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TextDiffLine) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("op", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Op); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *TextDiffLine) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Text); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TextDiffLine) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TextDiffLine(%+v)", *p)
+
+}
+
+func (p *TextDiffLine) DeepEqual(ano *TextDiffLine) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Op) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Text) {
+		return false
+	}
+	return true
+}
+
+func (p *TextDiffLine) Field1DeepEqual(src TextDiffOp) bool {
+
+	if strings.Compare(p.Op, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TextDiffLine) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Text, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type SpanDiffNode struct {
+	BaseSpanID    *string  `thrift:"base_span_id,1,optional" frugal:"1,optional,string" form:"base_span_id" json:"base_span_id,omitempty" query:"base_span_id"`
+	CompareSpanID *string  `thrift:"compare_span_id,2,optional" frugal:"2,optional,string" form:"compare_span_id" json:"compare_span_id,omitempty" query:"compare_span_id"`
+	SpanName      string   `thrift:"span_name,3,required" frugal:"3,required,string" form:"span_name,required" json:"span_name,required" query:"span_name,required"`
+	SpanType      string   `thrift:"span_type,4,required" frugal:"4,required,string" form:"span_type,required" json:"span_type,required" query:"span_type,required"`
+	DiffType      DiffType `thrift:"diff_type,5,required" frugal:"5,required,string" form:"diff_type,required" json:"diff_type,required" query:"diff_type,required"`
+	// input、output、status_code
+	ChangedFields []string `thrift:"changed_fields,6,optional" frugal:"6,optional,list<string>" form:"changed_fields" json:"changed_fields,omitempty" query:"changed_fields"`
+	// ms
+	BaseDuration *int64 `thrift:"base_duration,7,optional" frugal:"7,optional,i64" json:"base_duration" form:"base_duration" query:"base_duration"`
+	// ms
+	CompareDuration *int64 `thrift:"compare_duration,8,optional" frugal:"8,optional,i64" json:"compare_duration" form:"compare_duration" query:"compare_duration"`
+	// ms, 对比减基准
+	LatencyDelta      *int64 `thrift:"latency_delta,9,optional" frugal:"9,optional,i64" json:"latency_delta" form:"latency_delta" query:"latency_delta"`
+	InputTokensDelta  *int64 `thrift:"input_tokens_delta,10,optional" frugal:"10,optional,i64" json:"input_tokens_delta" form:"input_tokens_delta" query:"input_tokens_delta"`
+	OutputTokensDelta *int64 `thrift:"output_tokens_delta,11,optional" frugal:"11,optional,i64" json:"output_tokens_delta" form:"output_tokens_delta" query:"output_tokens_delta"`
+	// 仅在输入有变化时返回
+	InputDiff []*TextDiffLine `thrift:"input_diff,12,optional" frugal:"12,optional,list<TextDiffLine>" form:"input_diff" json:"input_diff,omitempty" query:"input_diff"`
+	// 仅在输出有变化时返回
+	OutputDiff []*TextDiffLine `thrift:"output_diff,13,optional" frugal:"13,optional,list<TextDiffLine>" form:"output_diff" json:"output_diff,omitempty" query:"output_diff"`
+	Children   []*SpanDiffNode `thrift:"children,14,optional" frugal:"14,optional,list<SpanDiffNode>" form:"children" json:"children,omitempty" query:"children"`
+}
+
+func NewSpanDiffNode() *SpanDiffNode {
+	return &SpanDiffNode{}
+}
+
+func (p *SpanDiffNode) InitDefault() {
+}
+
+var SpanDiffNode_BaseSpanID_DEFAULT string
+
+func (p *SpanDiffNode) GetBaseSpanID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseSpanID() {
+		return SpanDiffNode_BaseSpanID_DEFAULT
+	}
+	return *p.BaseSpanID
+}
+
+var SpanDiffNode_CompareSpanID_DEFAULT string
+
+func (p *SpanDiffNode) GetCompareSpanID() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCompareSpanID() {
+		return SpanDiffNode_CompareSpanID_DEFAULT
+	}
+	return *p.CompareSpanID
+}
+
+func (p *SpanDiffNode) GetSpanName() (v string) {
+	if p != nil {
+		return p.SpanName
+	}
+	return
+}
+
+func (p *SpanDiffNode) GetSpanType() (v string) {
+	if p != nil {
+		return p.SpanType
+	}
+	return
+}
+
+func (p *SpanDiffNode) GetDiffType() (v DiffType) {
+	if p != nil {
+		return p.DiffType
+	}
+	return
+}
+
+var SpanDiffNode_ChangedFields_DEFAULT []string
+
+func (p *SpanDiffNode) GetChangedFields() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetChangedFields() {
+		return SpanDiffNode_ChangedFields_DEFAULT
+	}
+	return p.ChangedFields
+}
+
+var SpanDiffNode_BaseDuration_DEFAULT int64
+
+func (p *SpanDiffNode) GetBaseDuration() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseDuration() {
+		return SpanDiffNode_BaseDuration_DEFAULT
+	}
+	return *p.BaseDuration
+}
+
+var SpanDiffNode_CompareDuration_DEFAULT int64
+
+func (p *SpanDiffNode) GetCompareDuration() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCompareDuration() {
+		return SpanDiffNode_CompareDuration_DEFAULT
+	}
+	return *p.CompareDuration
+}
+
+var SpanDiffNode_LatencyDelta_DEFAULT int64
+
+func (p *SpanDiffNode) GetLatencyDelta() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetLatencyDelta() {
+		return SpanDiffNode_LatencyDelta_DEFAULT
+	}
+	return *p.LatencyDelta
+}
+
+var SpanDiffNode_InputTokensDelta_DEFAULT int64
+
+func (p *SpanDiffNode) GetInputTokensDelta() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInputTokensDelta() {
+		return SpanDiffNode_InputTokensDelta_DEFAULT
+	}
+	return *p.InputTokensDelta
+}
+
+var SpanDiffNode_OutputTokensDelta_DEFAULT int64
+
+func (p *SpanDiffNode) GetOutputTokensDelta() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputTokensDelta() {
+		return SpanDiffNode_OutputTokensDelta_DEFAULT
+	}
+	return *p.OutputTokensDelta
+}
+
+var SpanDiffNode_InputDiff_DEFAULT []*TextDiffLine
+
+func (p *SpanDiffNode) GetInputDiff() (v []*TextDiffLine) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetInputDiff() {
+		return SpanDiffNode_InputDiff_DEFAULT
+	}
+	return p.InputDiff
+}
+
+var SpanDiffNode_OutputDiff_DEFAULT []*TextDiffLine
+
+func (p *SpanDiffNode) GetOutputDiff() (v []*TextDiffLine) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetOutputDiff() {
+		return SpanDiffNode_OutputDiff_DEFAULT
+	}
+	return p.OutputDiff
+}
+
+var SpanDiffNode_Children_DEFAULT []*SpanDiffNode
+
+func (p *SpanDiffNode) GetChildren() (v []*SpanDiffNode) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetChildren() {
+		return SpanDiffNode_Children_DEFAULT
+	}
+	return p.Children
+}
+func (p *SpanDiffNode) SetBaseSpanID(val *string) {
+	p.BaseSpanID = val
+}
+func (p *SpanDiffNode) SetCompareSpanID(val *string) {
+	p.CompareSpanID = val
+}
+func (p *SpanDiffNode) SetSpanName(val string) {
+	p.SpanName = val
+}
+func (p *SpanDiffNode) SetSpanType(val string) {
+	p.SpanType = val
+}
+func (p *SpanDiffNode) SetDiffType(val DiffType) {
+	p.DiffType = val
+}
+func (p *SpanDiffNode) SetChangedFields(val []string) {
+	p.ChangedFields = val
+}
+func (p *SpanDiffNode) SetBaseDuration(val *int64) {
+	p.BaseDuration = val
+}
+func (p *SpanDiffNode) SetCompareDuration(val *int64) {
+	p.CompareDuration = val
+}
+func (p *SpanDiffNode) SetLatencyDelta(val *int64) {
+	p.LatencyDelta = val
+}
+func (p *SpanDiffNode) SetInputTokensDelta(val *int64) {
+	p.InputTokensDelta = val
+}
+func (p *SpanDiffNode) SetOutputTokensDelta(val *int64) {
+	p.OutputTokensDelta = val
+}
+func (p *SpanDiffNode) SetInputDiff(val []*TextDiffLine) {
+	p.InputDiff = val
+}
+func (p *SpanDiffNode) SetOutputDiff(val []*TextDiffLine) {
+	p.OutputDiff = val
+}
+func (p *SpanDiffNode) SetChildren(val []*SpanDiffNode) {
+	p.Children = val
+}
+
+var fieldIDToName_SpanDiffNode = map[int16]string{
+	1:  "base_span_id",
+	2:  "compare_span_id",
+	3:  "span_name",
+	4:  "span_type",
+	5:  "diff_type",
+	6:  "changed_fields",
+	7:  "base_duration",
+	8:  "compare_duration",
+	9:  "latency_delta",
+	10: "input_tokens_delta",
+	11: "output_tokens_delta",
+	12: "input_diff",
+	13: "output_diff",
+	14: "children",
+}
+
+func (p *SpanDiffNode) IsSetBaseSpanID() bool {
+	return p.BaseSpanID != nil
+}
+
+func (p *SpanDiffNode) IsSetCompareSpanID() bool {
+	return p.CompareSpanID != nil
+}
+
+func (p *SpanDiffNode) IsSetChangedFields() bool {
+	return p.ChangedFields != nil
+}
+
+func (p *SpanDiffNode) IsSetBaseDuration() bool {
+	return p.BaseDuration != nil
+}
+
+func (p *SpanDiffNode) IsSetCompareDuration() bool {
+	return p.CompareDuration != nil
+}
+
+func (p *SpanDiffNode) IsSetLatencyDelta() bool {
+	return p.LatencyDelta != nil
+}
+
+func (p *SpanDiffNode) IsSetInputTokensDelta() bool {
+	return p.InputTokensDelta != nil
+}
+
+func (p *SpanDiffNode) IsSetOutputTokensDelta() bool {
+	return p.OutputTokensDelta != nil
+}
+
+func (p *SpanDiffNode) IsSetInputDiff() bool {
+	return p.InputDiff != nil
+}
+
+func (p *SpanDiffNode) IsSetOutputDiff() bool {
+	return p.OutputDiff != nil
+}
+
+func (p *SpanDiffNode) IsSetChildren() bool {
+	return p.Children != nil
+}
+
+func (p *SpanDiffNode) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSpanName bool = false
+	var issetSpanType bool = false
+	var issetDiffType bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpanName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSpanType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDiffType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSpanName {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSpanType {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDiffType {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SpanDiffNode[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SpanDiffNode[fieldId]))
+}
+
+func (p *SpanDiffNode) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseSpanID = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CompareSpanID = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpanName = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SpanType = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field DiffType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DiffType = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ChangedFields = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.BaseDuration = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CompareDuration = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LatencyDelta = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.InputTokensDelta = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OutputTokensDelta = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField12(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TextDiffLine, 0, size)
+	values := make([]TextDiffLine, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.InputDiff = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField13(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TextDiffLine, 0, size)
+	values := make([]TextDiffLine, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.OutputDiff = _field
+	return nil
+}
+func (p *SpanDiffNode) ReadField14(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SpanDiffNode, 0, size)
+	values := make([]SpanDiffNode, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Children = _field
+	return nil
+}
+
+func (p *SpanDiffNode) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SpanDiffNode"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SpanDiffNode) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseSpanID() {
+		if err = oprot.WriteFieldBegin("base_span_id", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.BaseSpanID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCompareSpanID() {
+		if err = oprot.WriteFieldBegin("compare_span_id", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CompareSpanID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("span_name", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SpanName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("span_type", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SpanType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("diff_type", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.DiffType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChangedFields() {
+		if err = oprot.WriteFieldBegin("changed_fields", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.ChangedFields)); err != nil {
+			return err
+		}
+		for _, v := range p.ChangedFields {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseDuration() {
+		if err = oprot.WriteFieldBegin("base_duration", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.BaseDuration); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCompareDuration() {
+		if err = oprot.WriteFieldBegin("compare_duration", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CompareDuration); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLatencyDelta() {
+		if err = oprot.WriteFieldBegin("latency_delta", thrift.I64, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.LatencyDelta); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputTokensDelta() {
+		if err = oprot.WriteFieldBegin("input_tokens_delta", thrift.I64, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.InputTokensDelta); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputTokensDelta() {
+		if err = oprot.WriteFieldBegin("output_tokens_delta", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.OutputTokensDelta); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInputDiff() {
+		if err = oprot.WriteFieldBegin("input_diff", thrift.LIST, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.InputDiff)); err != nil {
+			return err
+		}
+		for _, v := range p.InputDiff {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputDiff() {
+		if err = oprot.WriteFieldBegin("output_diff", thrift.LIST, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OutputDiff)); err != nil {
+			return err
+		}
+		for _, v := range p.OutputDiff {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *SpanDiffNode) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChildren() {
+		if err = oprot.WriteFieldBegin("children", thrift.LIST, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Children)); err != nil {
+			return err
+		}
+		for _, v := range p.Children {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *SpanDiffNode) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SpanDiffNode(%+v)", *p)
+
+}
+
+func (p *SpanDiffNode) DeepEqual(ano *SpanDiffNode) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseSpanID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.CompareSpanID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.SpanName) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.SpanType) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.DiffType) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.ChangedFields) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.BaseDuration) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.CompareDuration) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.LatencyDelta) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.InputTokensDelta) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.OutputTokensDelta) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.InputDiff) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.OutputDiff) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.Children) {
+		return false
+	}
+	return true
+}
+
+func (p *SpanDiffNode) Field1DeepEqual(src *string) bool {
+
+	if p.BaseSpanID == src {
+		return true
+	} else if p.BaseSpanID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.BaseSpanID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field2DeepEqual(src *string) bool {
+
+	if p.CompareSpanID == src {
+		return true
+	} else if p.CompareSpanID == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CompareSpanID, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.SpanName, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.SpanType, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field5DeepEqual(src DiffType) bool {
+
+	if strings.Compare(p.DiffType, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field6DeepEqual(src []string) bool {
+
+	if len(p.ChangedFields) != len(src) {
+		return false
+	}
+	for i, v := range p.ChangedFields {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *SpanDiffNode) Field7DeepEqual(src *int64) bool {
+
+	if p.BaseDuration == src {
+		return true
+	} else if p.BaseDuration == nil || src == nil {
+		return false
+	}
+	if *p.BaseDuration != *src {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field8DeepEqual(src *int64) bool {
+
+	if p.CompareDuration == src {
+		return true
+	} else if p.CompareDuration == nil || src == nil {
+		return false
+	}
+	if *p.CompareDuration != *src {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field9DeepEqual(src *int64) bool {
+
+	if p.LatencyDelta == src {
+		return true
+	} else if p.LatencyDelta == nil || src == nil {
+		return false
+	}
+	if *p.LatencyDelta != *src {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field10DeepEqual(src *int64) bool {
+
+	if p.InputTokensDelta == src {
+		return true
+	} else if p.InputTokensDelta == nil || src == nil {
+		return false
+	}
+	if *p.InputTokensDelta != *src {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field11DeepEqual(src *int64) bool {
+
+	if p.OutputTokensDelta == src {
+		return true
+	} else if p.OutputTokensDelta == nil || src == nil {
+		return false
+	}
+	if *p.OutputTokensDelta != *src {
+		return false
+	}
+	return true
+}
+func (p *SpanDiffNode) Field12DeepEqual(src []*TextDiffLine) bool {
+
+	if len(p.InputDiff) != len(src) {
+		return false
+	}
+	for i, v := range p.InputDiff {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *SpanDiffNode) Field13DeepEqual(src []*TextDiffLine) bool {
+
+	if len(p.OutputDiff) != len(src) {
+		return false
+	}
+	for i, v := range p.OutputDiff {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *SpanDiffNode) Field14DeepEqual(src []*SpanDiffNode) bool {
+
+	if len(p.Children) != len(src) {
+		return false
+	}
+	for i, v := range p.Children {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+type TraceDiffSummary struct {
+	AddedSpans       int32 `thrift:"added_spans,1,required" frugal:"1,required,i32" form:"added_spans,required" json:"added_spans,required" query:"added_spans,required"`
+	RemovedSpans     int32 `thrift:"removed_spans,2,required" frugal:"2,required,i32" form:"removed_spans,required" json:"removed_spans,required" query:"removed_spans,required"`
+	ChangedSpans     int32 `thrift:"changed_spans,3,required" frugal:"3,required,i32" form:"changed_spans,required" json:"changed_spans,required" query:"changed_spans,required"`
+	ChangedToolCalls int32 `thrift:"changed_tool_calls,4,required" frugal:"4,required,i32" form:"changed_tool_calls,required" json:"changed_tool_calls,required" query:"changed_tool_calls,required"`
+	// ms, 根节点耗时之差
+	LatencyDelta      int64 `thrift:"latency_delta,5,required" frugal:"5,required,i64" json:"latency_delta" form:"latency_delta,required" query:"latency_delta,required"`
+	InputTokensDelta  int64 `thrift:"input_tokens_delta,6,required" frugal:"6,required,i64" json:"input_tokens_delta" form:"input_tokens_delta,required" query:"input_tokens_delta,required"`
+	OutputTokensDelta int64 `thrift:"output_tokens_delta,7,required" frugal:"7,required,i64" json:"output_tokens_delta" form:"output_tokens_delta,required" query:"output_tokens_delta,required"`
+}
+
+func NewTraceDiffSummary() *TraceDiffSummary {
+	return &TraceDiffSummary{}
+}
+
+func (p *TraceDiffSummary) InitDefault() {
+}
+
+func (p *TraceDiffSummary) GetAddedSpans() (v int32) {
+	if p != nil {
+		return p.AddedSpans
+	}
+	return
+}
+
+func (p *TraceDiffSummary) GetRemovedSpans() (v int32) {
+	if p != nil {
+		return p.RemovedSpans
+	}
+	return
+}
+
+func (p *TraceDiffSummary) GetChangedSpans() (v int32) {
+	if p != nil {
+		return p.ChangedSpans
+	}
+	return
+}
+
+func (p *TraceDiffSummary) GetChangedToolCalls() (v int32) {
+	if p != nil {
+		return p.ChangedToolCalls
+	}
+	return
+}
+
+func (p *TraceDiffSummary) GetLatencyDelta() (v int64) {
+	if p != nil {
+		return p.LatencyDelta
+	}
+	return
+}
+
+func (p *TraceDiffSummary) GetInputTokensDelta() (v int64) {
+	if p != nil {
+		return p.InputTokensDelta
+	}
+	return
+}
+
+func (p *TraceDiffSummary) GetOutputTokensDelta() (v int64) {
+	if p != nil {
+		return p.OutputTokensDelta
+	}
+	return
+}
+func (p *TraceDiffSummary) SetAddedSpans(val int32) {
+	p.AddedSpans = val
+}
+func (p *TraceDiffSummary) SetRemovedSpans(val int32) {
+	p.RemovedSpans = val
+}
+func (p *TraceDiffSummary) SetChangedSpans(val int32) {
+	p.ChangedSpans = val
+}
+func (p *TraceDiffSummary) SetChangedToolCalls(val int32) {
+	p.ChangedToolCalls = val
+}
+func (p *TraceDiffSummary) SetLatencyDelta(val int64) {
+	p.LatencyDelta = val
+}
+func (p *TraceDiffSummary) SetInputTokensDelta(val int64) {
+	p.InputTokensDelta = val
+}
+func (p *TraceDiffSummary) SetOutputTokensDelta(val int64) {
+	p.OutputTokensDelta = val
+}
+
+var fieldIDToName_TraceDiffSummary = map[int16]string{
+	1: "added_spans",
+	2: "removed_spans",
+	3: "changed_spans",
+	4: "changed_tool_calls",
+	5: "latency_delta",
+	6: "input_tokens_delta",
+	7: "output_tokens_delta",
+}
+
+func (p *TraceDiffSummary) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAddedSpans bool = false
+	var issetRemovedSpans bool = false
+	var issetChangedSpans bool = false
+	var issetChangedToolCalls bool = false
+	var issetLatencyDelta bool = false
+	var issetInputTokensDelta bool = false
+	var issetOutputTokensDelta bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAddedSpans = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRemovedSpans = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetChangedSpans = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetChangedToolCalls = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLatencyDelta = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetInputTokensDelta = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOutputTokensDelta = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAddedSpans {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRemovedSpans {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetChangedSpans {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetChangedToolCalls {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLatencyDelta {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetInputTokensDelta {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetOutputTokensDelta {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceDiffSummary[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TraceDiffSummary[fieldId]))
+}
+
+func (p *TraceDiffSummary) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AddedSpans = _field
+	return nil
+}
+func (p *TraceDiffSummary) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RemovedSpans = _field
+	return nil
+}
+func (p *TraceDiffSummary) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ChangedSpans = _field
+	return nil
+}
+func (p *TraceDiffSummary) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ChangedToolCalls = _field
+	return nil
+}
+func (p *TraceDiffSummary) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LatencyDelta = _field
+	return nil
+}
+func (p *TraceDiffSummary) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.InputTokensDelta = _field
+	return nil
+}
+func (p *TraceDiffSummary) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OutputTokensDelta = _field
+	return nil
+}
+
+func (p *TraceDiffSummary) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TraceDiffSummary"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceDiffSummary) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("added_spans", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.AddedSpans); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *TraceDiffSummary) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("removed_spans", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.RemovedSpans); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *TraceDiffSummary) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("changed_spans", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ChangedSpans); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *TraceDiffSummary) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("changed_tool_calls", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ChangedToolCalls); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *TraceDiffSummary) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("latency_delta", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LatencyDelta); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *TraceDiffSummary) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("input_tokens_delta", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.InputTokensDelta); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *TraceDiffSummary) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("output_tokens_delta", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.OutputTokensDelta); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *TraceDiffSummary) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceDiffSummary(%+v)", *p)
+
+}
+
+func (p *TraceDiffSummary) DeepEqual(ano *TraceDiffSummary) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.AddedSpans) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.RemovedSpans) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ChangedSpans) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ChangedToolCalls) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.LatencyDelta) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.InputTokensDelta) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.OutputTokensDelta) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceDiffSummary) Field1DeepEqual(src int32) bool {
+
+	if p.AddedSpans != src {
+		return false
+	}
+	return true
+}
+func (p *TraceDiffSummary) Field2DeepEqual(src int32) bool {
+
+	if p.RemovedSpans != src {
+		return false
+	}
+	return true
+}
+func (p *TraceDiffSummary) Field3DeepEqual(src int32) bool {
+
+	if p.ChangedSpans != src {
+		return false
+	}
+	return true
+}
+func (p *TraceDiffSummary) Field4DeepEqual(src int32) bool {
+
+	if p.ChangedToolCalls != src {
+		return false
+	}
+	return true
+}
+func (p *TraceDiffSummary) Field5DeepEqual(src int64) bool {
+
+	if p.LatencyDelta != src {
+		return false
+	}
+	return true
+}
+func (p *TraceDiffSummary) Field6DeepEqual(src int64) bool {
+
+	if p.InputTokensDelta != src {
+		return false
+	}
+	return true
+}
+func (p *TraceDiffSummary) Field7DeepEqual(src int64) bool {
+
+	if p.OutputTokensDelta != src {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,4 @@
+package trace_export
+
+// KitexUnusedProtection is used to prevent 'imported and not used' error.
+var KitexUnusedProtection = struct{}{}
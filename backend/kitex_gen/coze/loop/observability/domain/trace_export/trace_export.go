@@ -0,0 +1,1136 @@
+// Code generated by thriftgo (0.4.1). DO NOT EDIT.
+
+package trace_export
+
+import (
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
+	"strings"
+)
+
+const (
+	ExportFileFormatJSONL = "jsonl"
+
+	ExportFileFormatOTLPJSON = "otlp_json"
+
+	ExportJobStatusRunning = "running"
+
+	ExportJobStatusSuccess = "success"
+
+	ExportJobStatusFailed = "failed"
+)
+
+type ExportFileFormat = string
+
+type ExportJobStatus = string
+
+type TraceExportJob struct {
+	ID          int64            `thrift:"id,1,required" frugal:"1,required,i64" json:"id" form:"id,required" query:"id,required"`
+	WorkspaceID int64            `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" form:"workspace_id,required" query:"workspace_id,required"`
+	Format      ExportFileFormat `thrift:"format,3,required" frugal:"3,required,string" form:"format,required" json:"format,required" query:"format,required"`
+	Status      ExportJobStatus  `thrift:"status,4,required" frugal:"4,required,string" form:"status,required" json:"status,required" query:"status,required"`
+	// ms
+	StartTime int64 `thrift:"start_time,5,required" frugal:"5,required,i64" json:"start_time" form:"start_time,required" query:"start_time,required"`
+	// ms
+	EndTime      int64                `thrift:"end_time,6,required" frugal:"6,required,i64" json:"end_time" form:"end_time,required" query:"end_time,required"`
+	Filters      *filter.FilterFields `thrift:"filters,7,optional" frugal:"7,optional,filter.FilterFields" form:"filters" json:"filters,omitempty" query:"filters"`
+	PlatformType *common.PlatformType `thrift:"platform_type,8,optional" frugal:"8,optional,string" form:"platform_type" json:"platform_type,omitempty" query:"platform_type"`
+	SpanListType *common.SpanListType `thrift:"span_list_type,9,optional" frugal:"9,optional,string" form:"span_list_type" json:"span_list_type,omitempty" query:"span_list_type"`
+	// 已导出的span数
+	ExportedCount int64 `thrift:"exported_count,10,required" frugal:"10,required,i64" json:"exported_count" form:"exported_count,required" query:"exported_count,required"`
+	// 仅导出成功时返回
+	DownloadURL *string          `thrift:"download_url,11,optional" frugal:"11,optional,string" form:"download_url" json:"download_url,omitempty" query:"download_url"`
+	ErrorMsg    *string          `thrift:"error_msg,12,optional" frugal:"12,optional,string" form:"error_msg" json:"error_msg,omitempty" query:"error_msg"`
+	BaseInfo    *common.BaseInfo `thrift:"base_info,13,optional" frugal:"13,optional,common.BaseInfo" form:"base_info" json:"base_info,omitempty" query:"base_info"`
+	// 符合条件的span超过单任务导出上限时为true, 仅导出了前面的部分
+	Truncated *bool `thrift:"truncated,14,optional" frugal:"14,optional,bool" form:"truncated" json:"truncated,omitempty" query:"truncated"`
+}
+
+func NewTraceExportJob() *TraceExportJob {
+	return &TraceExportJob{}
+}
+
+func (p *TraceExportJob) InitDefault() {
+}
+
+func (p *TraceExportJob) GetID() (v int64) {
+	if p != nil {
+		return p.ID
+	}
+	return
+}
+
+func (p *TraceExportJob) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *TraceExportJob) GetFormat() (v ExportFileFormat) {
+	if p != nil {
+		return p.Format
+	}
+	return
+}
+
+func (p *TraceExportJob) GetStatus() (v ExportJobStatus) {
+	if p != nil {
+		return p.Status
+	}
+	return
+}
+
+func (p *TraceExportJob) GetStartTime() (v int64) {
+	if p != nil {
+		return p.StartTime
+	}
+	return
+}
+
+func (p *TraceExportJob) GetEndTime() (v int64) {
+	if p != nil {
+		return p.EndTime
+	}
+	return
+}
+
+var TraceExportJob_Filters_DEFAULT *filter.FilterFields
+
+func (p *TraceExportJob) GetFilters() (v *filter.FilterFields) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilters() {
+		return TraceExportJob_Filters_DEFAULT
+	}
+	return p.Filters
+}
+
+var TraceExportJob_PlatformType_DEFAULT common.PlatformType
+
+func (p *TraceExportJob) GetPlatformType() (v common.PlatformType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPlatformType() {
+		return TraceExportJob_PlatformType_DEFAULT
+	}
+	return *p.PlatformType
+}
+
+var TraceExportJob_SpanListType_DEFAULT common.SpanListType
+
+func (p *TraceExportJob) GetSpanListType() (v common.SpanListType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSpanListType() {
+		return TraceExportJob_SpanListType_DEFAULT
+	}
+	return *p.SpanListType
+}
+
+func (p *TraceExportJob) GetExportedCount() (v int64) {
+	if p != nil {
+		return p.ExportedCount
+	}
+	return
+}
+
+var TraceExportJob_DownloadURL_DEFAULT string
+
+func (p *TraceExportJob) GetDownloadURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetDownloadURL() {
+		return TraceExportJob_DownloadURL_DEFAULT
+	}
+	return *p.DownloadURL
+}
+
+var TraceExportJob_ErrorMsg_DEFAULT string
+
+func (p *TraceExportJob) GetErrorMsg() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetErrorMsg() {
+		return TraceExportJob_ErrorMsg_DEFAULT
+	}
+	return *p.ErrorMsg
+}
+
+var TraceExportJob_BaseInfo_DEFAULT *common.BaseInfo
+
+func (p *TraceExportJob) GetBaseInfo() (v *common.BaseInfo) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseInfo() {
+		return TraceExportJob_BaseInfo_DEFAULT
+	}
+	return p.BaseInfo
+}
+
+var TraceExportJob_Truncated_DEFAULT bool
+
+func (p *TraceExportJob) GetTruncated() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTruncated() {
+		return TraceExportJob_Truncated_DEFAULT
+	}
+	return *p.Truncated
+}
+func (p *TraceExportJob) SetID(val int64) {
+	p.ID = val
+}
+func (p *TraceExportJob) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *TraceExportJob) SetFormat(val ExportFileFormat) {
+	p.Format = val
+}
+func (p *TraceExportJob) SetStatus(val ExportJobStatus) {
+	p.Status = val
+}
+func (p *TraceExportJob) SetStartTime(val int64) {
+	p.StartTime = val
+}
+func (p *TraceExportJob) SetEndTime(val int64) {
+	p.EndTime = val
+}
+func (p *TraceExportJob) SetFilters(val *filter.FilterFields) {
+	p.Filters = val
+}
+func (p *TraceExportJob) SetPlatformType(val *common.PlatformType) {
+	p.PlatformType = val
+}
+func (p *TraceExportJob) SetSpanListType(val *common.SpanListType) {
+	p.SpanListType = val
+}
+func (p *TraceExportJob) SetExportedCount(val int64) {
+	p.ExportedCount = val
+}
+func (p *TraceExportJob) SetDownloadURL(val *string) {
+	p.DownloadURL = val
+}
+func (p *TraceExportJob) SetErrorMsg(val *string) {
+	p.ErrorMsg = val
+}
+func (p *TraceExportJob) SetBaseInfo(val *common.BaseInfo) {
+	p.BaseInfo = val
+}
+func (p *TraceExportJob) SetTruncated(val *bool) {
+	p.Truncated = val
+}
+
+var fieldIDToName_TraceExportJob = map[int16]string{
+	1:  "id",
+	2:  "workspace_id",
+	3:  "format",
+	4:  "status",
+	5:  "start_time",
+	6:  "end_time",
+	7:  "filters",
+	8:  "platform_type",
+	9:  "span_list_type",
+	10: "exported_count",
+	11: "download_url",
+	12: "error_msg",
+	13: "base_info",
+	14: "truncated",
+}
+
+func (p *TraceExportJob) IsSetFilters() bool {
+	return p.Filters != nil
+}
+
+func (p *TraceExportJob) IsSetPlatformType() bool {
+	return p.PlatformType != nil
+}
+
+func (p *TraceExportJob) IsSetSpanListType() bool {
+	return p.SpanListType != nil
+}
+
+func (p *TraceExportJob) IsSetDownloadURL() bool {
+	return p.DownloadURL != nil
+}
+
+func (p *TraceExportJob) IsSetErrorMsg() bool {
+	return p.ErrorMsg != nil
+}
+
+func (p *TraceExportJob) IsSetBaseInfo() bool {
+	return p.BaseInfo != nil
+}
+
+func (p *TraceExportJob) IsSetTruncated() bool {
+	return p.Truncated != nil
+}
+
+func (p *TraceExportJob) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetWorkspaceID bool = false
+	var issetFormat bool = false
+	var issetStatus bool = false
+	var issetStartTime bool = false
+	var issetEndTime bool = false
+	var issetExportedCount bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFormat = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStartTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEndTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExportedCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFormat {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatus {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStartTime {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEndTime {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExportedCount {
+		fieldId = 10
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceExportJob[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TraceExportJob[fieldId]))
+}
+
+func (p *TraceExportJob) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field ExportFileFormat
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Format = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field ExportJobStatus
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField7(iprot thrift.TProtocol) error {
+	_field := filter.NewFilterFields()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filters = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *common.PlatformType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PlatformType = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *common.SpanListType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SpanListType = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExportedCount = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DownloadURL = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ErrorMsg = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField13(iprot thrift.TProtocol) error {
+	_field := common.NewBaseInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseInfo = _field
+	return nil
+}
+func (p *TraceExportJob) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Truncated = _field
+	return nil
+}
+
+func (p *TraceExportJob) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TraceExportJob"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceExportJob) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("format", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Format); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EndTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlatformType() {
+		if err = oprot.WriteFieldBegin("platform_type", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PlatformType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSpanListType() {
+		if err = oprot.WriteFieldBegin("span_list_type", thrift.STRING, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SpanListType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("exported_count", thrift.I64, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExportedCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDownloadURL() {
+		if err = oprot.WriteFieldBegin("download_url", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.DownloadURL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorMsg() {
+		if err = oprot.WriteFieldBegin("error_msg", thrift.STRING, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ErrorMsg); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseInfo() {
+		if err = oprot.WriteFieldBegin("base_info", thrift.STRUCT, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *TraceExportJob) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTruncated() {
+		if err = oprot.WriteFieldBegin("truncated", thrift.BOOL, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Truncated); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *TraceExportJob) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceExportJob(%+v)", *p)
+
+}
+
+func (p *TraceExportJob) DeepEqual(ano *TraceExportJob) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Format) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.EndTime) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Filters) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.PlatformType) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.SpanListType) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.ExportedCount) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.DownloadURL) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.ErrorMsg) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.BaseInfo) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.Truncated) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceExportJob) Field1DeepEqual(src int64) bool {
+
+	if p.ID != src {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field2DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field3DeepEqual(src ExportFileFormat) bool {
+
+	if strings.Compare(p.Format, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field4DeepEqual(src ExportJobStatus) bool {
+
+	if strings.Compare(p.Status, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field5DeepEqual(src int64) bool {
+
+	if p.StartTime != src {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field6DeepEqual(src int64) bool {
+
+	if p.EndTime != src {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field7DeepEqual(src *filter.FilterFields) bool {
+
+	if !p.Filters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field8DeepEqual(src *common.PlatformType) bool {
+
+	if p.PlatformType == src {
+		return true
+	} else if p.PlatformType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PlatformType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field9DeepEqual(src *common.SpanListType) bool {
+
+	if p.SpanListType == src {
+		return true
+	} else if p.SpanListType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SpanListType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field10DeepEqual(src int64) bool {
+
+	if p.ExportedCount != src {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field11DeepEqual(src *string) bool {
+
+	if p.DownloadURL == src {
+		return true
+	} else if p.DownloadURL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.DownloadURL, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field12DeepEqual(src *string) bool {
+
+	if p.ErrorMsg == src {
+		return true
+	} else if p.ErrorMsg == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ErrorMsg, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field13DeepEqual(src *common.BaseInfo) bool {
+
+	if !p.BaseInfo.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *TraceExportJob) Field14DeepEqual(src *bool) bool {
+
+	if p.Truncated == src {
+		return true
+	} else if p.Truncated == nil || src == nil {
+		return false
+	}
+	if *p.Truncated != *src {
+		return false
+	}
+	return true
+}
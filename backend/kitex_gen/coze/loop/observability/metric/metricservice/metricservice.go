@@ -34,6 +34,83 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"QueryAggregations": kitex.NewMethodInfo(
+		queryAggregationsHandler,
+		newMetricServiceQueryAggregationsArgs,
+		newMetricServiceQueryAggregationsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateAlertRule": kitex.NewMethodInfo(
+		createAlertRuleHandler,
+		newMetricServiceCreateAlertRuleArgs,
+		newMetricServiceCreateAlertRuleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateAlertRule": kitex.NewMethodInfo(
+		updateAlertRuleHandler,
+		newMetricServiceUpdateAlertRuleArgs,
+		newMetricServiceUpdateAlertRuleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteAlertRule": kitex.NewMethodInfo(
+		deleteAlertRuleHandler,
+		newMetricServiceDeleteAlertRuleArgs,
+		newMetricServiceDeleteAlertRuleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListAlertRules": kitex.NewMethodInfo(
+		listAlertRulesHandler,
+		newMetricServiceListAlertRulesArgs,
+		newMetricServiceListAlertRulesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateDashboard": kitex.NewMethodInfo(
+		createDashboardHandler,
+		newMetricServiceCreateDashboardArgs,
+		newMetricServiceCreateDashboardResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateDashboard": kitex.NewMethodInfo(
+		updateDashboardHandler,
+		newMetricServiceUpdateDashboardArgs,
+		newMetricServiceUpdateDashboardResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteDashboard": kitex.NewMethodInfo(
+		deleteDashboardHandler,
+		newMetricServiceDeleteDashboardArgs,
+		newMetricServiceDeleteDashboardResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetDashboard": kitex.NewMethodInfo(
+		getDashboardHandler,
+		newMetricServiceGetDashboardArgs,
+		newMetricServiceGetDashboardResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListDashboards": kitex.NewMethodInfo(
+		listDashboardsHandler,
+		newMetricServiceListDashboardsArgs,
+		newMetricServiceListDashboardsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RenderDashboard": kitex.NewMethodInfo(
+		renderDashboardHandler,
+		newMetricServiceRenderDashboardArgs,
+		newMetricServiceRenderDashboardResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -124,6 +201,215 @@ func newMetricServiceTraverseMetricsResult() interface{} {
 	return metric.NewMetricServiceTraverseMetricsResult()
 }
 
+func queryAggregationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceQueryAggregationsArgs)
+	realResult := result.(*metric.MetricServiceQueryAggregationsResult)
+	success, err := handler.(metric.MetricService).QueryAggregations(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceQueryAggregationsArgs() interface{} {
+	return metric.NewMetricServiceQueryAggregationsArgs()
+}
+
+func newMetricServiceQueryAggregationsResult() interface{} {
+	return metric.NewMetricServiceQueryAggregationsResult()
+}
+
+func createAlertRuleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceCreateAlertRuleArgs)
+	realResult := result.(*metric.MetricServiceCreateAlertRuleResult)
+	success, err := handler.(metric.MetricService).CreateAlertRule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceCreateAlertRuleArgs() interface{} {
+	return metric.NewMetricServiceCreateAlertRuleArgs()
+}
+
+func newMetricServiceCreateAlertRuleResult() interface{} {
+	return metric.NewMetricServiceCreateAlertRuleResult()
+}
+
+func updateAlertRuleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceUpdateAlertRuleArgs)
+	realResult := result.(*metric.MetricServiceUpdateAlertRuleResult)
+	success, err := handler.(metric.MetricService).UpdateAlertRule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceUpdateAlertRuleArgs() interface{} {
+	return metric.NewMetricServiceUpdateAlertRuleArgs()
+}
+
+func newMetricServiceUpdateAlertRuleResult() interface{} {
+	return metric.NewMetricServiceUpdateAlertRuleResult()
+}
+
+func deleteAlertRuleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceDeleteAlertRuleArgs)
+	realResult := result.(*metric.MetricServiceDeleteAlertRuleResult)
+	success, err := handler.(metric.MetricService).DeleteAlertRule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceDeleteAlertRuleArgs() interface{} {
+	return metric.NewMetricServiceDeleteAlertRuleArgs()
+}
+
+func newMetricServiceDeleteAlertRuleResult() interface{} {
+	return metric.NewMetricServiceDeleteAlertRuleResult()
+}
+
+func listAlertRulesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceListAlertRulesArgs)
+	realResult := result.(*metric.MetricServiceListAlertRulesResult)
+	success, err := handler.(metric.MetricService).ListAlertRules(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceListAlertRulesArgs() interface{} {
+	return metric.NewMetricServiceListAlertRulesArgs()
+}
+
+func newMetricServiceListAlertRulesResult() interface{} {
+	return metric.NewMetricServiceListAlertRulesResult()
+}
+
+func createDashboardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceCreateDashboardArgs)
+	realResult := result.(*metric.MetricServiceCreateDashboardResult)
+	success, err := handler.(metric.MetricService).CreateDashboard(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceCreateDashboardArgs() interface{} {
+	return metric.NewMetricServiceCreateDashboardArgs()
+}
+
+func newMetricServiceCreateDashboardResult() interface{} {
+	return metric.NewMetricServiceCreateDashboardResult()
+}
+
+func updateDashboardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceUpdateDashboardArgs)
+	realResult := result.(*metric.MetricServiceUpdateDashboardResult)
+	success, err := handler.(metric.MetricService).UpdateDashboard(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceUpdateDashboardArgs() interface{} {
+	return metric.NewMetricServiceUpdateDashboardArgs()
+}
+
+func newMetricServiceUpdateDashboardResult() interface{} {
+	return metric.NewMetricServiceUpdateDashboardResult()
+}
+
+func deleteDashboardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceDeleteDashboardArgs)
+	realResult := result.(*metric.MetricServiceDeleteDashboardResult)
+	success, err := handler.(metric.MetricService).DeleteDashboard(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceDeleteDashboardArgs() interface{} {
+	return metric.NewMetricServiceDeleteDashboardArgs()
+}
+
+func newMetricServiceDeleteDashboardResult() interface{} {
+	return metric.NewMetricServiceDeleteDashboardResult()
+}
+
+func getDashboardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceGetDashboardArgs)
+	realResult := result.(*metric.MetricServiceGetDashboardResult)
+	success, err := handler.(metric.MetricService).GetDashboard(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceGetDashboardArgs() interface{} {
+	return metric.NewMetricServiceGetDashboardArgs()
+}
+
+func newMetricServiceGetDashboardResult() interface{} {
+	return metric.NewMetricServiceGetDashboardResult()
+}
+
+func listDashboardsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceListDashboardsArgs)
+	realResult := result.(*metric.MetricServiceListDashboardsResult)
+	success, err := handler.(metric.MetricService).ListDashboards(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceListDashboardsArgs() interface{} {
+	return metric.NewMetricServiceListDashboardsArgs()
+}
+
+func newMetricServiceListDashboardsResult() interface{} {
+	return metric.NewMetricServiceListDashboardsResult()
+}
+
+func renderDashboardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*metric.MetricServiceRenderDashboardArgs)
+	realResult := result.(*metric.MetricServiceRenderDashboardResult)
+	success, err := handler.(metric.MetricService).RenderDashboard(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newMetricServiceRenderDashboardArgs() interface{} {
+	return metric.NewMetricServiceRenderDashboardArgs()
+}
+
+func newMetricServiceRenderDashboardResult() interface{} {
+	return metric.NewMetricServiceRenderDashboardResult()
+}
+
 type kClient struct {
 	c  client.Client
 	sc client.Streaming
@@ -165,3 +451,113 @@ func (p *kClient) TraverseMetrics(ctx context.Context, req *metric.TraverseMetri
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) QueryAggregations(ctx context.Context, req *metric.QueryAggregationsRequest) (r *metric.QueryAggregationsResponse, err error) {
+	var _args metric.MetricServiceQueryAggregationsArgs
+	_args.Req = req
+	var _result metric.MetricServiceQueryAggregationsResult
+	if err = p.c.Call(ctx, "QueryAggregations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAlertRule(ctx context.Context, req *metric.CreateAlertRuleRequest) (r *metric.CreateAlertRuleResponse, err error) {
+	var _args metric.MetricServiceCreateAlertRuleArgs
+	_args.Req = req
+	var _result metric.MetricServiceCreateAlertRuleResult
+	if err = p.c.Call(ctx, "CreateAlertRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAlertRule(ctx context.Context, req *metric.UpdateAlertRuleRequest) (r *metric.UpdateAlertRuleResponse, err error) {
+	var _args metric.MetricServiceUpdateAlertRuleArgs
+	_args.Req = req
+	var _result metric.MetricServiceUpdateAlertRuleResult
+	if err = p.c.Call(ctx, "UpdateAlertRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAlertRule(ctx context.Context, req *metric.DeleteAlertRuleRequest) (r *metric.DeleteAlertRuleResponse, err error) {
+	var _args metric.MetricServiceDeleteAlertRuleArgs
+	_args.Req = req
+	var _result metric.MetricServiceDeleteAlertRuleResult
+	if err = p.c.Call(ctx, "DeleteAlertRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAlertRules(ctx context.Context, req *metric.ListAlertRulesRequest) (r *metric.ListAlertRulesResponse, err error) {
+	var _args metric.MetricServiceListAlertRulesArgs
+	_args.Req = req
+	var _result metric.MetricServiceListAlertRulesResult
+	if err = p.c.Call(ctx, "ListAlertRules", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateDashboard(ctx context.Context, req *metric.CreateDashboardRequest) (r *metric.CreateDashboardResponse, err error) {
+	var _args metric.MetricServiceCreateDashboardArgs
+	_args.Req = req
+	var _result metric.MetricServiceCreateDashboardResult
+	if err = p.c.Call(ctx, "CreateDashboard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateDashboard(ctx context.Context, req *metric.UpdateDashboardRequest) (r *metric.UpdateDashboardResponse, err error) {
+	var _args metric.MetricServiceUpdateDashboardArgs
+	_args.Req = req
+	var _result metric.MetricServiceUpdateDashboardResult
+	if err = p.c.Call(ctx, "UpdateDashboard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteDashboard(ctx context.Context, req *metric.DeleteDashboardRequest) (r *metric.DeleteDashboardResponse, err error) {
+	var _args metric.MetricServiceDeleteDashboardArgs
+	_args.Req = req
+	var _result metric.MetricServiceDeleteDashboardResult
+	if err = p.c.Call(ctx, "DeleteDashboard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDashboard(ctx context.Context, req *metric.GetDashboardRequest) (r *metric.GetDashboardResponse, err error) {
+	var _args metric.MetricServiceGetDashboardArgs
+	_args.Req = req
+	var _result metric.MetricServiceGetDashboardResult
+	if err = p.c.Call(ctx, "GetDashboard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListDashboards(ctx context.Context, req *metric.ListDashboardsRequest) (r *metric.ListDashboardsResponse, err error) {
+	var _args metric.MetricServiceListDashboardsArgs
+	_args.Req = req
+	var _result metric.MetricServiceListDashboardsResult
+	if err = p.c.Call(ctx, "ListDashboards", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RenderDashboard(ctx context.Context, req *metric.RenderDashboardRequest) (r *metric.RenderDashboardResponse, err error) {
+	var _args metric.MetricServiceRenderDashboardArgs
+	_args.Req = req
+	var _result metric.MetricServiceRenderDashboardResult
+	if err = p.c.Call(ctx, "RenderDashboard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -12,7 +12,11 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
 	dataset0 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dataset"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/retention"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/session"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/span"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/trace_diff"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/trace_export"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/view"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/trajectory"
 	"strings"
@@ -20894,1490 +20898,9515 @@ func (p *ListTrajectoryResponse) Field255DeepEqual(src *base.BaseResp) bool {
 	return true
 }
 
-type TraceService interface {
-	ListSpans(ctx context.Context, req *ListSpansRequest) (r *ListSpansResponse, err error)
-
-	ListPreSpan(ctx context.Context, req *ListPreSpanRequest) (r *ListPreSpanResponse, err error)
-
-	GetTrace(ctx context.Context, req *GetTraceRequest) (r *GetTraceResponse, err error)
-
-	SearchTraceTree(ctx context.Context, req *SearchTraceTreeRequest) (r *SearchTraceTreeResponse, err error)
-
-	BatchGetTracesAdvanceInfo(ctx context.Context, req *BatchGetTracesAdvanceInfoRequest) (r *BatchGetTracesAdvanceInfoResponse, err error)
-
-	IngestTracesInner(ctx context.Context, req *IngestTracesRequest) (r *IngestTracesResponse, err error)
-
-	GetTracesMetaInfo(ctx context.Context, req *GetTracesMetaInfoRequest) (r *GetTracesMetaInfoResponse, err error)
-
-	CreateView(ctx context.Context, req *CreateViewRequest) (r *CreateViewResponse, err error)
-
-	UpdateView(ctx context.Context, req *UpdateViewRequest) (r *UpdateViewResponse, err error)
-
-	DeleteView(ctx context.Context, req *DeleteViewRequest) (r *DeleteViewResponse, err error)
-
-	ListViews(ctx context.Context, req *ListViewsRequest) (r *ListViewsResponse, err error)
+type UpsertRetentionPolicyRequest struct {
+	WorkspaceID int64                      `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
+	Policy      *retention.RetentionPolicy `thrift:"policy,2,required" frugal:"2,required,retention.RetentionPolicy" form:"policy,required" json:"policy,required"`
+	Base        *base.Base                 `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
 
-	CreateManualAnnotation(ctx context.Context, req *CreateManualAnnotationRequest) (r *CreateManualAnnotationResponse, err error)
+func NewUpsertRetentionPolicyRequest() *UpsertRetentionPolicyRequest {
+	return &UpsertRetentionPolicyRequest{}
+}
 
-	UpdateManualAnnotation(ctx context.Context, req *UpdateManualAnnotationRequest) (r *UpdateManualAnnotationResponse, err error)
+func (p *UpsertRetentionPolicyRequest) InitDefault() {
+}
 
-	DeleteManualAnnotation(ctx context.Context, req *DeleteManualAnnotationRequest) (r *DeleteManualAnnotationResponse, err error)
+func (p *UpsertRetentionPolicyRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
 
-	ListAnnotations(ctx context.Context, req *ListAnnotationsRequest) (r *ListAnnotationsResponse, err error)
+var UpsertRetentionPolicyRequest_Policy_DEFAULT *retention.RetentionPolicy
 
-	ExportTracesToDataset(ctx context.Context, req *ExportTracesToDatasetRequest) (r *ExportTracesToDatasetResponse, err error)
+func (p *UpsertRetentionPolicyRequest) GetPolicy() (v *retention.RetentionPolicy) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPolicy() {
+		return UpsertRetentionPolicyRequest_Policy_DEFAULT
+	}
+	return p.Policy
+}
 
-	PreviewExportTracesToDataset(ctx context.Context, req *PreviewExportTracesToDatasetRequest) (r *PreviewExportTracesToDatasetResponse, err error)
+var UpsertRetentionPolicyRequest_Base_DEFAULT *base.Base
 
-	ChangeEvaluatorScore(ctx context.Context, req *ChangeEvaluatorScoreRequest) (r *ChangeEvaluatorScoreResponse, err error)
+func (p *UpsertRetentionPolicyRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return UpsertRetentionPolicyRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpsertRetentionPolicyRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *UpsertRetentionPolicyRequest) SetPolicy(val *retention.RetentionPolicy) {
+	p.Policy = val
+}
+func (p *UpsertRetentionPolicyRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
 
-	ListAnnotationEvaluators(ctx context.Context, req *ListAnnotationEvaluatorsRequest) (r *ListAnnotationEvaluatorsResponse, err error)
+var fieldIDToName_UpsertRetentionPolicyRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "policy",
+	255: "Base",
+}
 
-	ExtractSpanInfo(ctx context.Context, req *ExtractSpanInfoRequest) (r *ExtractSpanInfoResponse, err error)
+func (p *UpsertRetentionPolicyRequest) IsSetPolicy() bool {
+	return p.Policy != nil
+}
 
-	UpsertTrajectoryConfig(ctx context.Context, req *UpsertTrajectoryConfigRequest) (r *UpsertTrajectoryConfigResponse, err error)
+func (p *UpsertRetentionPolicyRequest) IsSetBase() bool {
+	return p.Base != nil
+}
 
-	GetTrajectoryConfig(ctx context.Context, req *GetTrajectoryConfigRequest) (r *GetTrajectoryConfigResponse, err error)
+func (p *UpsertRetentionPolicyRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetPolicy bool = false
 
-	ListTrajectory(ctx context.Context, req *ListTrajectoryRequest) (r *ListTrajectoryResponse, err error)
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-type TraceServiceClient struct {
-	c thrift.TClient
-}
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func NewTraceServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *TraceServiceClient {
-	return &TraceServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPolicy = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-}
 
-func NewTraceServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *TraceServiceClient {
-	return &TraceServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-}
 
-func NewTraceServiceClient(c thrift.TClient) *TraceServiceClient {
-	return &TraceServiceClient{
-		c: c,
+	if !issetPolicy {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpsertRetentionPolicyRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *TraceServiceClient) Client_() thrift.TClient {
-	return p.c
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpsertRetentionPolicyRequest[fieldId]))
 }
 
-func (p *TraceServiceClient) ListSpans(ctx context.Context, req *ListSpansRequest) (r *ListSpansResponse, err error) {
-	var _args TraceServiceListSpansArgs
-	_args.Req = req
-	var _result TraceServiceListSpansResult
-	if err = p.Client_().Call(ctx, "ListSpans", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) ListPreSpan(ctx context.Context, req *ListPreSpanRequest) (r *ListPreSpanResponse, err error) {
-	var _args TraceServiceListPreSpanArgs
-	_args.Req = req
-	var _result TraceServiceListPreSpanResult
-	if err = p.Client_().Call(ctx, "ListPreSpan", &_args, &_result); err != nil {
-		return
+func (p *UpsertRetentionPolicyRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.WorkspaceID = _field
+	return nil
 }
-func (p *TraceServiceClient) GetTrace(ctx context.Context, req *GetTraceRequest) (r *GetTraceResponse, err error) {
-	var _args TraceServiceGetTraceArgs
-	_args.Req = req
-	var _result TraceServiceGetTraceResult
-	if err = p.Client_().Call(ctx, "GetTrace", &_args, &_result); err != nil {
-		return
+func (p *UpsertRetentionPolicyRequest) ReadField2(iprot thrift.TProtocol) error {
+	_field := retention.NewRetentionPolicy()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Policy = _field
+	return nil
 }
-func (p *TraceServiceClient) SearchTraceTree(ctx context.Context, req *SearchTraceTreeRequest) (r *SearchTraceTreeResponse, err error) {
-	var _args TraceServiceSearchTraceTreeArgs
-	_args.Req = req
-	var _result TraceServiceSearchTraceTreeResult
-	if err = p.Client_().Call(ctx, "SearchTraceTree", &_args, &_result); err != nil {
-		return
+func (p *UpsertRetentionPolicyRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Base = _field
+	return nil
 }
-func (p *TraceServiceClient) BatchGetTracesAdvanceInfo(ctx context.Context, req *BatchGetTracesAdvanceInfoRequest) (r *BatchGetTracesAdvanceInfoResponse, err error) {
-	var _args TraceServiceBatchGetTracesAdvanceInfoArgs
-	_args.Req = req
-	var _result TraceServiceBatchGetTracesAdvanceInfoResult
-	if err = p.Client_().Call(ctx, "BatchGetTracesAdvanceInfo", &_args, &_result); err != nil {
-		return
+
+func (p *UpsertRetentionPolicyRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpsertRetentionPolicyRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) IngestTracesInner(ctx context.Context, req *IngestTracesRequest) (r *IngestTracesResponse, err error) {
-	var _args TraceServiceIngestTracesInnerArgs
-	_args.Req = req
-	var _result TraceServiceIngestTracesInnerResult
-	if err = p.Client_().Call(ctx, "IngestTracesInner", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) GetTracesMetaInfo(ctx context.Context, req *GetTracesMetaInfoRequest) (r *GetTracesMetaInfoResponse, err error) {
-	var _args TraceServiceGetTracesMetaInfoArgs
-	_args.Req = req
-	var _result TraceServiceGetTracesMetaInfoResult
-	if err = p.Client_().Call(ctx, "GetTracesMetaInfo", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) CreateView(ctx context.Context, req *CreateViewRequest) (r *CreateViewResponse, err error) {
-	var _args TraceServiceCreateViewArgs
-	_args.Req = req
-	var _result TraceServiceCreateViewResult
-	if err = p.Client_().Call(ctx, "CreateView", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *TraceServiceClient) UpdateView(ctx context.Context, req *UpdateViewRequest) (r *UpdateViewResponse, err error) {
-	var _args TraceServiceUpdateViewArgs
-	_args.Req = req
-	var _result TraceServiceUpdateViewResult
-	if err = p.Client_().Call(ctx, "UpdateView", &_args, &_result); err != nil {
-		return
+
+func (p *UpsertRetentionPolicyRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) DeleteView(ctx context.Context, req *DeleteViewRequest) (r *DeleteViewResponse, err error) {
-	var _args TraceServiceDeleteViewArgs
-	_args.Req = req
-	var _result TraceServiceDeleteViewResult
-	if err = p.Client_().Call(ctx, "DeleteView", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) ListViews(ctx context.Context, req *ListViewsRequest) (r *ListViewsResponse, err error) {
-	var _args TraceServiceListViewsArgs
-	_args.Req = req
-	var _result TraceServiceListViewsResult
-	if err = p.Client_().Call(ctx, "ListViews", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *TraceServiceClient) CreateManualAnnotation(ctx context.Context, req *CreateManualAnnotationRequest) (r *CreateManualAnnotationResponse, err error) {
-	var _args TraceServiceCreateManualAnnotationArgs
-	_args.Req = req
-	var _result TraceServiceCreateManualAnnotationResult
-	if err = p.Client_().Call(ctx, "CreateManualAnnotation", &_args, &_result); err != nil {
-		return
+func (p *UpsertRetentionPolicyRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("policy", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) UpdateManualAnnotation(ctx context.Context, req *UpdateManualAnnotationRequest) (r *UpdateManualAnnotationResponse, err error) {
-	var _args TraceServiceUpdateManualAnnotationArgs
-	_args.Req = req
-	var _result TraceServiceUpdateManualAnnotationResult
-	if err = p.Client_().Call(ctx, "UpdateManualAnnotation", &_args, &_result); err != nil {
-		return
+	if err := p.Policy.Write(oprot); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) DeleteManualAnnotation(ctx context.Context, req *DeleteManualAnnotationRequest) (r *DeleteManualAnnotationResponse, err error) {
-	var _args TraceServiceDeleteManualAnnotationArgs
-	_args.Req = req
-	var _result TraceServiceDeleteManualAnnotationResult
-	if err = p.Client_().Call(ctx, "DeleteManualAnnotation", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *TraceServiceClient) ListAnnotations(ctx context.Context, req *ListAnnotationsRequest) (r *ListAnnotationsResponse, err error) {
-	var _args TraceServiceListAnnotationsArgs
-	_args.Req = req
-	var _result TraceServiceListAnnotationsResult
-	if err = p.Client_().Call(ctx, "ListAnnotations", &_args, &_result); err != nil {
-		return
+func (p *UpsertRetentionPolicyRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
-func (p *TraceServiceClient) ExportTracesToDataset(ctx context.Context, req *ExportTracesToDatasetRequest) (r *ExportTracesToDatasetResponse, err error) {
-	var _args TraceServiceExportTracesToDatasetArgs
-	_args.Req = req
-	var _result TraceServiceExportTracesToDatasetResult
-	if err = p.Client_().Call(ctx, "ExportTracesToDataset", &_args, &_result); err != nil {
-		return
+
+func (p *UpsertRetentionPolicyRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return _result.GetSuccess(), nil
+	return fmt.Sprintf("UpsertRetentionPolicyRequest(%+v)", *p)
+
 }
-func (p *TraceServiceClient) PreviewExportTracesToDataset(ctx context.Context, req *PreviewExportTracesToDatasetRequest) (r *PreviewExportTracesToDatasetResponse, err error) {
-	var _args TraceServicePreviewExportTracesToDatasetArgs
-	_args.Req = req
-	var _result TraceServicePreviewExportTracesToDatasetResult
-	if err = p.Client_().Call(ctx, "PreviewExportTracesToDataset", &_args, &_result); err != nil {
-		return
+
+func (p *UpsertRetentionPolicyRequest) DeepEqual(ano *UpsertRetentionPolicyRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) ChangeEvaluatorScore(ctx context.Context, req *ChangeEvaluatorScoreRequest) (r *ChangeEvaluatorScoreResponse, err error) {
-	var _args TraceServiceChangeEvaluatorScoreArgs
-	_args.Req = req
-	var _result TraceServiceChangeEvaluatorScoreResult
-	if err = p.Client_().Call(ctx, "ChangeEvaluatorScore", &_args, &_result); err != nil {
-		return
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) ListAnnotationEvaluators(ctx context.Context, req *ListAnnotationEvaluatorsRequest) (r *ListAnnotationEvaluatorsResponse, err error) {
-	var _args TraceServiceListAnnotationEvaluatorsArgs
-	_args.Req = req
-	var _result TraceServiceListAnnotationEvaluatorsResult
-	if err = p.Client_().Call(ctx, "ListAnnotationEvaluators", &_args, &_result); err != nil {
-		return
+	if !p.Field2DeepEqual(ano.Policy) {
+		return false
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *TraceServiceClient) ExtractSpanInfo(ctx context.Context, req *ExtractSpanInfoRequest) (r *ExtractSpanInfoResponse, err error) {
-	var _args TraceServiceExtractSpanInfoArgs
-	_args.Req = req
-	var _result TraceServiceExtractSpanInfoResult
-	if err = p.Client_().Call(ctx, "ExtractSpanInfo", &_args, &_result); err != nil {
-		return
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
-func (p *TraceServiceClient) UpsertTrajectoryConfig(ctx context.Context, req *UpsertTrajectoryConfigRequest) (r *UpsertTrajectoryConfigResponse, err error) {
-	var _args TraceServiceUpsertTrajectoryConfigArgs
-	_args.Req = req
-	var _result TraceServiceUpsertTrajectoryConfigResult
-	if err = p.Client_().Call(ctx, "UpsertTrajectoryConfig", &_args, &_result); err != nil {
-		return
+
+func (p *UpsertRetentionPolicyRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
-func (p *TraceServiceClient) GetTrajectoryConfig(ctx context.Context, req *GetTrajectoryConfigRequest) (r *GetTrajectoryConfigResponse, err error) {
-	var _args TraceServiceGetTrajectoryConfigArgs
-	_args.Req = req
-	var _result TraceServiceGetTrajectoryConfigResult
-	if err = p.Client_().Call(ctx, "GetTrajectoryConfig", &_args, &_result); err != nil {
-		return
+func (p *UpsertRetentionPolicyRequest) Field2DeepEqual(src *retention.RetentionPolicy) bool {
+
+	if !p.Policy.DeepEqual(src) {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
-func (p *TraceServiceClient) ListTrajectory(ctx context.Context, req *ListTrajectoryRequest) (r *ListTrajectoryResponse, err error) {
-	var _args TraceServiceListTrajectoryArgs
-	_args.Req = req
-	var _result TraceServiceListTrajectoryResult
-	if err = p.Client_().Call(ctx, "ListTrajectory", &_args, &_result); err != nil {
-		return
+func (p *UpsertRetentionPolicyRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
 	}
-	return _result.GetSuccess(), nil
+	return true
 }
 
-type TraceServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      TraceService
+type UpsertRetentionPolicyResponse struct {
+	BaseResp *base.BaseResp `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
 }
 
-func (p *TraceServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewUpsertRetentionPolicyResponse() *UpsertRetentionPolicyResponse {
+	return &UpsertRetentionPolicyResponse{}
 }
 
-func (p *TraceServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *UpsertRetentionPolicyResponse) InitDefault() {
 }
 
-func (p *TraceServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+var UpsertRetentionPolicyResponse_BaseResp_DEFAULT *base.BaseResp
 
-func NewTraceServiceProcessor(handler TraceService) *TraceServiceProcessor {
-	self := &TraceServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("ListSpans", &traceServiceProcessorListSpans{handler: handler})
-	self.AddToProcessorMap("ListPreSpan", &traceServiceProcessorListPreSpan{handler: handler})
-	self.AddToProcessorMap("GetTrace", &traceServiceProcessorGetTrace{handler: handler})
-	self.AddToProcessorMap("SearchTraceTree", &traceServiceProcessorSearchTraceTree{handler: handler})
-	self.AddToProcessorMap("BatchGetTracesAdvanceInfo", &traceServiceProcessorBatchGetTracesAdvanceInfo{handler: handler})
-	self.AddToProcessorMap("IngestTracesInner", &traceServiceProcessorIngestTracesInner{handler: handler})
-	self.AddToProcessorMap("GetTracesMetaInfo", &traceServiceProcessorGetTracesMetaInfo{handler: handler})
-	self.AddToProcessorMap("CreateView", &traceServiceProcessorCreateView{handler: handler})
-	self.AddToProcessorMap("UpdateView", &traceServiceProcessorUpdateView{handler: handler})
-	self.AddToProcessorMap("DeleteView", &traceServiceProcessorDeleteView{handler: handler})
-	self.AddToProcessorMap("ListViews", &traceServiceProcessorListViews{handler: handler})
-	self.AddToProcessorMap("CreateManualAnnotation", &traceServiceProcessorCreateManualAnnotation{handler: handler})
-	self.AddToProcessorMap("UpdateManualAnnotation", &traceServiceProcessorUpdateManualAnnotation{handler: handler})
-	self.AddToProcessorMap("DeleteManualAnnotation", &traceServiceProcessorDeleteManualAnnotation{handler: handler})
-	self.AddToProcessorMap("ListAnnotations", &traceServiceProcessorListAnnotations{handler: handler})
-	self.AddToProcessorMap("ExportTracesToDataset", &traceServiceProcessorExportTracesToDataset{handler: handler})
-	self.AddToProcessorMap("PreviewExportTracesToDataset", &traceServiceProcessorPreviewExportTracesToDataset{handler: handler})
-	self.AddToProcessorMap("ChangeEvaluatorScore", &traceServiceProcessorChangeEvaluatorScore{handler: handler})
-	self.AddToProcessorMap("ListAnnotationEvaluators", &traceServiceProcessorListAnnotationEvaluators{handler: handler})
-	self.AddToProcessorMap("ExtractSpanInfo", &traceServiceProcessorExtractSpanInfo{handler: handler})
-	self.AddToProcessorMap("UpsertTrajectoryConfig", &traceServiceProcessorUpsertTrajectoryConfig{handler: handler})
-	self.AddToProcessorMap("GetTrajectoryConfig", &traceServiceProcessorGetTrajectoryConfig{handler: handler})
-	self.AddToProcessorMap("ListTrajectory", &traceServiceProcessorListTrajectory{handler: handler})
-	return self
-}
-func (p *TraceServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+func (p *UpsertRetentionPolicyResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if !p.IsSetBaseResp() {
+		return UpsertRetentionPolicyResponse_BaseResp_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return p.BaseResp
+}
+func (p *UpsertRetentionPolicyResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
 }
 
-type traceServiceProcessorListSpans struct {
-	handler TraceService
+var fieldIDToName_UpsertRetentionPolicyResponse = map[int16]string{
+	255: "BaseResp",
 }
 
-func (p *traceServiceProcessorListSpans) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceListSpansArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListSpans", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *UpsertRetentionPolicyResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceListSpansResult{}
-	var retval *ListSpansResponse
-	if retval, err2 = p.handler.ListSpans(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSpans: "+err2.Error())
-		oprot.WriteMessageBegin("ListSpans", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListSpans", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *UpsertRetentionPolicyResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type traceServiceProcessorListPreSpan struct {
-	handler TraceService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpsertRetentionPolicyResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *traceServiceProcessorListPreSpan) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceListPreSpanArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListPreSpan", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UpsertRetentionPolicyResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.BaseResp = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceListPreSpanResult{}
-	var retval *ListPreSpanResponse
-	if retval, err2 = p.handler.ListPreSpan(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListPreSpan: "+err2.Error())
-		oprot.WriteMessageBegin("ListPreSpan", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListPreSpan", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *UpsertRetentionPolicyResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpsertRetentionPolicyResponse"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type traceServiceProcessorGetTrace struct {
-	handler TraceService
+func (p *UpsertRetentionPolicyResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *traceServiceProcessorGetTrace) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceGetTraceArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTrace", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UpsertRetentionPolicyResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("UpsertRetentionPolicyResponse(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceGetTraceResult{}
-	var retval *GetTraceResponse
-	if retval, err2 = p.handler.GetTrace(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTrace: "+err2.Error())
-		oprot.WriteMessageBegin("GetTrace", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTrace", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *UpsertRetentionPolicyResponse) DeepEqual(ano *UpsertRetentionPolicyResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *UpsertRetentionPolicyResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type traceServiceProcessorSearchTraceTree struct {
-	handler TraceService
+type GetRetentionPolicyRequest struct {
+	WorkspaceID int64      `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id,string,required" query:"workspace_id,required"`
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func (p *traceServiceProcessorSearchTraceTree) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceSearchTraceTreeArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SearchTraceTree", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func NewGetRetentionPolicyRequest() *GetRetentionPolicyRequest {
+	return &GetRetentionPolicyRequest{}
+}
+
+func (p *GetRetentionPolicyRequest) InitDefault() {
+}
+
+func (p *GetRetentionPolicyRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
 	}
+	return
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceSearchTraceTreeResult{}
-	var retval *SearchTraceTreeResponse
-	if retval, err2 = p.handler.SearchTraceTree(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchTraceTree: "+err2.Error())
-		oprot.WriteMessageBegin("SearchTraceTree", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+var GetRetentionPolicyRequest_Base_DEFAULT *base.Base
+
+func (p *GetRetentionPolicyRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
 	}
-	if err2 = oprot.WriteMessageBegin("SearchTraceTree", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if !p.IsSetBase() {
+		return GetRetentionPolicyRequest_Base_DEFAULT
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return p.Base
+}
+func (p *GetRetentionPolicyRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *GetRetentionPolicyRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_GetRetentionPolicyRequest = map[int16]string{
+	1:   "workspace_id",
+	255: "Base",
+}
+
+func (p *GetRetentionPolicyRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetRetentionPolicyRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err != nil {
-		return
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	return true, err
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRetentionPolicyRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-type traceServiceProcessorBatchGetTracesAdvanceInfo struct {
-	handler TraceService
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetRetentionPolicyRequest[fieldId]))
 }
 
-func (p *traceServiceProcessorBatchGetTracesAdvanceInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceBatchGetTracesAdvanceInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("BatchGetTracesAdvanceInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *GetRetentionPolicyRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceBatchGetTracesAdvanceInfoResult{}
-	var retval *BatchGetTracesAdvanceInfoResponse
-	if retval, err2 = p.handler.BatchGetTracesAdvanceInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetTracesAdvanceInfo: "+err2.Error())
-		oprot.WriteMessageBegin("BatchGetTracesAdvanceInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("BatchGetTracesAdvanceInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *GetRetentionPolicyRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.Base = _field
+	return nil
+}
+
+func (p *GetRetentionPolicyRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRetentionPolicyRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type traceServiceProcessorIngestTracesInner struct {
-	handler TraceService
+func (p *GetRetentionPolicyRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetRetentionPolicyRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *traceServiceProcessorIngestTracesInner) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceIngestTracesInnerArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("IngestTracesInner", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *GetRetentionPolicyRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("GetRetentionPolicyRequest(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceIngestTracesInnerResult{}
-	var retval *IngestTracesResponse
-	if retval, err2 = p.handler.IngestTracesInner(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing IngestTracesInner: "+err2.Error())
-		oprot.WriteMessageBegin("IngestTracesInner", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+}
+
+func (p *GetRetentionPolicyRequest) DeepEqual(ano *GetRetentionPolicyRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageBegin("IngestTracesInner", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+func (p *GetRetentionPolicyRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+func (p *GetRetentionPolicyRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
 	}
-	if err != nil {
+	return true
+}
+
+type GetRetentionPolicyResponse struct {
+	// 未配置时为空
+	Policy   *retention.RetentionPolicy `thrift:"policy,1,optional" frugal:"1,optional,retention.RetentionPolicy" form:"policy" json:"policy,omitempty" query:"policy"`
+	BaseResp *base.BaseResp             `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewGetRetentionPolicyResponse() *GetRetentionPolicyResponse {
+	return &GetRetentionPolicyResponse{}
+}
+
+func (p *GetRetentionPolicyResponse) InitDefault() {
+}
+
+var GetRetentionPolicyResponse_Policy_DEFAULT *retention.RetentionPolicy
+
+func (p *GetRetentionPolicyResponse) GetPolicy() (v *retention.RetentionPolicy) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetPolicy() {
+		return GetRetentionPolicyResponse_Policy_DEFAULT
+	}
+	return p.Policy
 }
 
-type traceServiceProcessorGetTracesMetaInfo struct {
-	handler TraceService
-}
+var GetRetentionPolicyResponse_BaseResp_DEFAULT *base.BaseResp
 
-func (p *traceServiceProcessorGetTracesMetaInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceGetTracesMetaInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTracesMetaInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceGetTracesMetaInfoResult{}
-	var retval *GetTracesMetaInfoResponse
-	if retval, err2 = p.handler.GetTracesMetaInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTracesMetaInfo: "+err2.Error())
-		oprot.WriteMessageBegin("GetTracesMetaInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTracesMetaInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
+func (p *GetRetentionPolicyResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetBaseResp() {
+		return GetRetentionPolicyResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
 }
-
-type traceServiceProcessorCreateView struct {
-	handler TraceService
+func (p *GetRetentionPolicyResponse) SetPolicy(val *retention.RetentionPolicy) {
+	p.Policy = val
+}
+func (p *GetRetentionPolicyResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
 }
 
-func (p *traceServiceProcessorCreateView) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceCreateViewArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateView", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var fieldIDToName_GetRetentionPolicyResponse = map[int16]string{
+	1:   "policy",
+	255: "BaseResp",
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceCreateViewResult{}
-	var retval *CreateViewResponse
-	if retval, err2 = p.handler.CreateView(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateView: "+err2.Error())
-		oprot.WriteMessageBegin("CreateView", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateView", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func (p *GetRetentionPolicyResponse) IsSetPolicy() bool {
+	return p.Policy != nil
 }
 
-type traceServiceProcessorUpdateView struct {
-	handler TraceService
+func (p *GetRetentionPolicyResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *traceServiceProcessorUpdateView) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceUpdateViewArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateView", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *GetRetentionPolicyResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceUpdateViewResult{}
-	var retval *UpdateViewResponse
-	if retval, err2 = p.handler.UpdateView(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateView: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateView", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateView", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type traceServiceProcessorDeleteView struct {
-	handler TraceService
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRetentionPolicyResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *traceServiceProcessorDeleteView) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceDeleteViewArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteView", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceDeleteViewResult{}
-	var retval *DeleteViewResponse
-	if retval, err2 = p.handler.DeleteView(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteView: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteView", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *GetRetentionPolicyResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := retention.NewRetentionPolicy()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("DeleteView", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.Policy = _field
+	return nil
+}
+func (p *GetRetentionPolicyResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *GetRetentionPolicyResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRetentionPolicyResponse"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type traceServiceProcessorListViews struct {
-	handler TraceService
+func (p *GetRetentionPolicyResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPolicy() {
+		if err = oprot.WriteFieldBegin("policy", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Policy.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
-func (p *traceServiceProcessorListViews) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceListViewsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListViews", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *GetRetentionPolicyResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceListViewsResult{}
-	var retval *ListViewsResponse
-	if retval, err2 = p.handler.ListViews(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListViews: "+err2.Error())
-		oprot.WriteMessageBegin("ListViews", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListViews", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *GetRetentionPolicyResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("GetRetentionPolicyResponse(%+v)", *p)
+
+}
+
+func (p *GetRetentionPolicyResponse) DeepEqual(ano *GetRetentionPolicyResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Policy) {
+		return false
 	}
-	if err != nil {
-		return
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type traceServiceProcessorCreateManualAnnotation struct {
-	handler TraceService
-}
+func (p *GetRetentionPolicyResponse) Field1DeepEqual(src *retention.RetentionPolicy) bool {
 
-func (p *traceServiceProcessorCreateManualAnnotation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceCreateManualAnnotationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateManualAnnotation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	if !p.Policy.DeepEqual(src) {
+		return false
 	}
+	return true
+}
+func (p *GetRetentionPolicyResponse) Field255DeepEqual(src *base.BaseResp) bool {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceCreateManualAnnotationResult{}
-	var retval *CreateManualAnnotationResponse
-	if retval, err2 = p.handler.CreateManualAnnotation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateManualAnnotation: "+err2.Error())
-		oprot.WriteMessageBegin("CreateManualAnnotation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateManualAnnotation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+	if !p.BaseResp.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type traceServiceProcessorUpdateManualAnnotation struct {
-	handler TraceService
+type DiffTracesRequest struct {
+	WorkspaceID int64 `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
+	// 基准trace
+	BaseTrace *TraceQueryParams `thrift:"base_trace,2,required" frugal:"2,required,TraceQueryParams" form:"base_trace,required" json:"base_trace,required"`
+	// 对比trace
+	CompareTrace *TraceQueryParams    `thrift:"compare_trace,3,required" frugal:"3,required,TraceQueryParams" form:"compare_trace,required" json:"compare_trace,required"`
+	PlatformType *common.PlatformType `thrift:"platform_type,4,optional" frugal:"4,optional,string" form:"platform_type" json:"platform_type,omitempty"`
+	Base         *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
 }
 
-func (p *traceServiceProcessorUpdateManualAnnotation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceUpdateManualAnnotationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateManualAnnotation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceUpdateManualAnnotationResult{}
-	var retval *UpdateManualAnnotationResponse
-	if retval, err2 = p.handler.UpdateManualAnnotation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateManualAnnotation: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateManualAnnotation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateManualAnnotation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewDiffTracesRequest() *DiffTracesRequest {
+	return &DiffTracesRequest{}
 }
 
-type traceServiceProcessorDeleteManualAnnotation struct {
-	handler TraceService
+func (p *DiffTracesRequest) InitDefault() {
 }
 
-func (p *traceServiceProcessorDeleteManualAnnotation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceDeleteManualAnnotationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteManualAnnotation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DiffTracesRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
 	}
+	return
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceDeleteManualAnnotationResult{}
-	var retval *DeleteManualAnnotationResponse
-	if retval, err2 = p.handler.DeleteManualAnnotation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteManualAnnotation: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteManualAnnotation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+var DiffTracesRequest_BaseTrace_DEFAULT *TraceQueryParams
+
+func (p *DiffTracesRequest) GetBaseTrace() (v *TraceQueryParams) {
+	if p == nil {
+		return
 	}
-	if err2 = oprot.WriteMessageBegin("DeleteManualAnnotation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if !p.IsSetBaseTrace() {
+		return DiffTracesRequest_BaseTrace_DEFAULT
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return p.BaseTrace
+}
+
+var DiffTracesRequest_CompareTrace_DEFAULT *TraceQueryParams
+
+func (p *DiffTracesRequest) GetCompareTrace() (v *TraceQueryParams) {
+	if p == nil {
+		return
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.IsSetCompareTrace() {
+		return DiffTracesRequest_CompareTrace_DEFAULT
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return p.CompareTrace
+}
+
+var DiffTracesRequest_PlatformType_DEFAULT common.PlatformType
+
+func (p *DiffTracesRequest) GetPlatformType() (v common.PlatformType) {
+	if p == nil {
+		return
 	}
-	if err != nil {
+	if !p.IsSetPlatformType() {
+		return DiffTracesRequest_PlatformType_DEFAULT
+	}
+	return *p.PlatformType
+}
+
+var DiffTracesRequest_Base_DEFAULT *base.Base
+
+func (p *DiffTracesRequest) GetBase() (v *base.Base) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetBase() {
+		return DiffTracesRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *DiffTracesRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *DiffTracesRequest) SetBaseTrace(val *TraceQueryParams) {
+	p.BaseTrace = val
+}
+func (p *DiffTracesRequest) SetCompareTrace(val *TraceQueryParams) {
+	p.CompareTrace = val
+}
+func (p *DiffTracesRequest) SetPlatformType(val *common.PlatformType) {
+	p.PlatformType = val
+}
+func (p *DiffTracesRequest) SetBase(val *base.Base) {
+	p.Base = val
 }
 
-type traceServiceProcessorListAnnotations struct {
-	handler TraceService
+var fieldIDToName_DiffTracesRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "base_trace",
+	3:   "compare_trace",
+	4:   "platform_type",
+	255: "Base",
 }
 
-func (p *traceServiceProcessorListAnnotations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceListAnnotationsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListAnnotations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *DiffTracesRequest) IsSetBaseTrace() bool {
+	return p.BaseTrace != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceListAnnotationsResult{}
-	var retval *ListAnnotationsResponse
-	if retval, err2 = p.handler.ListAnnotations(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListAnnotations: "+err2.Error())
-		oprot.WriteMessageBegin("ListAnnotations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *DiffTracesRequest) IsSetCompareTrace() bool {
+	return p.CompareTrace != nil
+}
+
+func (p *DiffTracesRequest) IsSetPlatformType() bool {
+	return p.PlatformType != nil
+}
+
+func (p *DiffTracesRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DiffTracesRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetBaseTrace bool = false
+	var issetCompareTrace bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("ListAnnotations", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseTrace = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCompareTrace = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	if !issetBaseTrace {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-	if err != nil {
-		return
+
+	if !issetCompareTrace {
+		fieldId = 3
+		goto RequiredFieldNotSetError
 	}
-	return true, err
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffTracesRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-type traceServiceProcessorExportTracesToDataset struct {
-	handler TraceService
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DiffTracesRequest[fieldId]))
 }
 
-func (p *traceServiceProcessorExportTracesToDataset) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceExportTracesToDatasetArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ExportTracesToDataset", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DiffTracesRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *DiffTracesRequest) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewTraceQueryParams()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseTrace = _field
+	return nil
+}
+func (p *DiffTracesRequest) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewTraceQueryParams()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.CompareTrace = _field
+	return nil
+}
+func (p *DiffTracesRequest) ReadField4(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceExportTracesToDatasetResult{}
-	var retval *ExportTracesToDatasetResponse
-	if retval, err2 = p.handler.ExportTracesToDataset(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportTracesToDataset: "+err2.Error())
-		oprot.WriteMessageBegin("ExportTracesToDataset", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field *common.PlatformType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = &v
 	}
-	if err2 = oprot.WriteMessageBegin("ExportTracesToDataset", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.PlatformType = _field
+	return nil
+}
+func (p *DiffTracesRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.Base = _field
+	return nil
+}
+
+func (p *DiffTracesRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DiffTracesRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type traceServiceProcessorPreviewExportTracesToDataset struct {
-	handler TraceService
+func (p *DiffTracesRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DiffTracesRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_trace", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseTrace.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *DiffTracesRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("compare_trace", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.CompareTrace.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *DiffTracesRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlatformType() {
+		if err = oprot.WriteFieldBegin("platform_type", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PlatformType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *DiffTracesRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *traceServiceProcessorPreviewExportTracesToDataset) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServicePreviewExportTracesToDatasetArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("PreviewExportTracesToDataset", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DiffTracesRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("DiffTracesRequest(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServicePreviewExportTracesToDatasetResult{}
-	var retval *PreviewExportTracesToDatasetResponse
-	if retval, err2 = p.handler.PreviewExportTracesToDataset(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PreviewExportTracesToDataset: "+err2.Error())
-		oprot.WriteMessageBegin("PreviewExportTracesToDataset", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+}
+
+func (p *DiffTracesRequest) DeepEqual(ano *DiffTracesRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.WriteMessageBegin("PreviewExportTracesToDataset", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if !p.Field2DeepEqual(ano.BaseTrace) {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if !p.Field3DeepEqual(ano.CompareTrace) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field4DeepEqual(ano.PlatformType) {
+		return false
 	}
-	if err != nil {
-		return
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type traceServiceProcessorChangeEvaluatorScore struct {
-	handler TraceService
+func (p *DiffTracesRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
 }
+func (p *DiffTracesRequest) Field2DeepEqual(src *TraceQueryParams) bool {
 
-func (p *traceServiceProcessorChangeEvaluatorScore) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceChangeEvaluatorScoreArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ChangeEvaluatorScore", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	if !p.BaseTrace.DeepEqual(src) {
+		return false
 	}
+	return true
+}
+func (p *DiffTracesRequest) Field3DeepEqual(src *TraceQueryParams) bool {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceChangeEvaluatorScoreResult{}
-	var retval *ChangeEvaluatorScoreResponse
-	if retval, err2 = p.handler.ChangeEvaluatorScore(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ChangeEvaluatorScore: "+err2.Error())
-		oprot.WriteMessageBegin("ChangeEvaluatorScore", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if !p.CompareTrace.DeepEqual(src) {
+		return false
 	}
-	if err2 = oprot.WriteMessageBegin("ChangeEvaluatorScore", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return true
+}
+func (p *DiffTracesRequest) Field4DeepEqual(src *common.PlatformType) bool {
+
+	if p.PlatformType == src {
+		return true
+	} else if p.PlatformType == nil || src == nil {
+		return false
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if strings.Compare(*p.PlatformType, *src) != 0 {
+		return false
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+func (p *DiffTracesRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return true
+}
+
+type DiffTracesResponse struct {
+	// 对齐后的span树，按根节点排列
+	Nodes    []*trace_diff.SpanDiffNode   `thrift:"nodes,1,required" frugal:"1,required,list<trace_diff.SpanDiffNode>" form:"nodes,required" json:"nodes,required" query:"nodes,required"`
+	Summary  *trace_diff.TraceDiffSummary `thrift:"summary,2,required" frugal:"2,required,trace_diff.TraceDiffSummary" form:"summary,required" json:"summary,required" query:"summary,required"`
+	BaseResp *base.BaseResp               `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewDiffTracesResponse() *DiffTracesResponse {
+	return &DiffTracesResponse{}
+}
+
+func (p *DiffTracesResponse) InitDefault() {
+}
+
+func (p *DiffTracesResponse) GetNodes() (v []*trace_diff.SpanDiffNode) {
+	if p != nil {
+		return p.Nodes
 	}
-	if err != nil {
+	return
+}
+
+var DiffTracesResponse_Summary_DEFAULT *trace_diff.TraceDiffSummary
+
+func (p *DiffTracesResponse) GetSummary() (v *trace_diff.TraceDiffSummary) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetSummary() {
+		return DiffTracesResponse_Summary_DEFAULT
+	}
+	return p.Summary
 }
 
-type traceServiceProcessorListAnnotationEvaluators struct {
-	handler TraceService
+var DiffTracesResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *DiffTracesResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return DiffTracesResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DiffTracesResponse) SetNodes(val []*trace_diff.SpanDiffNode) {
+	p.Nodes = val
+}
+func (p *DiffTracesResponse) SetSummary(val *trace_diff.TraceDiffSummary) {
+	p.Summary = val
+}
+func (p *DiffTracesResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
 }
 
-func (p *traceServiceProcessorListAnnotationEvaluators) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceListAnnotationEvaluatorsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListAnnotationEvaluators", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+var fieldIDToName_DiffTracesResponse = map[int16]string{
+	1:   "nodes",
+	2:   "summary",
+	255: "BaseResp",
+}
+
+func (p *DiffTracesResponse) IsSetSummary() bool {
+	return p.Summary != nil
+}
+
+func (p *DiffTracesResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DiffTracesResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetNodes bool = false
+	var issetSummary bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceListAnnotationEvaluatorsResult{}
-	var retval *ListAnnotationEvaluatorsResponse
-	if retval, err2 = p.handler.ListAnnotationEvaluators(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListAnnotationEvaluators: "+err2.Error())
-		oprot.WriteMessageBegin("ListAnnotationEvaluators", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNodes = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSummary = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetNodes {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSummary {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffTracesResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DiffTracesResponse[fieldId]))
+}
+
+func (p *DiffTracesResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*trace_diff.SpanDiffNode, 0, size)
+	values := make([]trace_diff.SpanDiffNode, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Nodes = _field
+	return nil
+}
+func (p *DiffTracesResponse) ReadField2(iprot thrift.TProtocol) error {
+	_field := trace_diff.NewTraceDiffSummary()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Summary = _field
+	return nil
+}
+func (p *DiffTracesResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *DiffTracesResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DiffTracesResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DiffTracesResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
+		return err
+	}
+	for _, v := range p.Nodes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DiffTracesResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("summary", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Summary.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *DiffTracesResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *DiffTracesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffTracesResponse(%+v)", *p)
+
+}
+
+func (p *DiffTracesResponse) DeepEqual(ano *DiffTracesResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Nodes) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Summary) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *DiffTracesResponse) Field1DeepEqual(src []*trace_diff.SpanDiffNode) bool {
+
+	if len(p.Nodes) != len(src) {
+		return false
+	}
+	for i, v := range p.Nodes {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *DiffTracesResponse) Field2DeepEqual(src *trace_diff.TraceDiffSummary) bool {
+
+	if !p.Summary.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *DiffTracesResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListSessionsRequest struct {
+	WorkspaceID int64 `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
+	// ms
+	StartTime int64 `thrift:"start_time,2,required" frugal:"2,required,i64" json:"start_time" form:"start_time,required" `
+	// ms
+	EndTime int64 `thrift:"end_time,3,required" frugal:"3,required,i64" json:"end_time" form:"end_time,required" `
+	// 作用于会话内的span
+	Filters      *filter.FilterFields `thrift:"filters,4,optional" frugal:"4,optional,filter.FilterFields" form:"filters" json:"filters,omitempty"`
+	PageSize     *int32               `thrift:"page_size,5,optional" frugal:"5,optional,i32" form:"page_size" json:"page_size,omitempty"`
+	PageToken    *string              `thrift:"page_token,6,optional" frugal:"6,optional,string" form:"page_token" json:"page_token,omitempty"`
+	PlatformType *common.PlatformType `thrift:"platform_type,7,optional" frugal:"7,optional,string" form:"platform_type" json:"platform_type,omitempty"`
+	Base         *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewListSessionsRequest() *ListSessionsRequest {
+	return &ListSessionsRequest{}
+}
+
+func (p *ListSessionsRequest) InitDefault() {
+}
+
+func (p *ListSessionsRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *ListSessionsRequest) GetStartTime() (v int64) {
+	if p != nil {
+		return p.StartTime
+	}
+	return
+}
+
+func (p *ListSessionsRequest) GetEndTime() (v int64) {
+	if p != nil {
+		return p.EndTime
+	}
+	return
+}
+
+var ListSessionsRequest_Filters_DEFAULT *filter.FilterFields
+
+func (p *ListSessionsRequest) GetFilters() (v *filter.FilterFields) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilters() {
+		return ListSessionsRequest_Filters_DEFAULT
+	}
+	return p.Filters
+}
+
+var ListSessionsRequest_PageSize_DEFAULT int32
+
+func (p *ListSessionsRequest) GetPageSize() (v int32) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageSize() {
+		return ListSessionsRequest_PageSize_DEFAULT
+	}
+	return *p.PageSize
+}
+
+var ListSessionsRequest_PageToken_DEFAULT string
+
+func (p *ListSessionsRequest) GetPageToken() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPageToken() {
+		return ListSessionsRequest_PageToken_DEFAULT
+	}
+	return *p.PageToken
+}
+
+var ListSessionsRequest_PlatformType_DEFAULT common.PlatformType
+
+func (p *ListSessionsRequest) GetPlatformType() (v common.PlatformType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPlatformType() {
+		return ListSessionsRequest_PlatformType_DEFAULT
+	}
+	return *p.PlatformType
+}
+
+var ListSessionsRequest_Base_DEFAULT *base.Base
+
+func (p *ListSessionsRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ListSessionsRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ListSessionsRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *ListSessionsRequest) SetStartTime(val int64) {
+	p.StartTime = val
+}
+func (p *ListSessionsRequest) SetEndTime(val int64) {
+	p.EndTime = val
+}
+func (p *ListSessionsRequest) SetFilters(val *filter.FilterFields) {
+	p.Filters = val
+}
+func (p *ListSessionsRequest) SetPageSize(val *int32) {
+	p.PageSize = val
+}
+func (p *ListSessionsRequest) SetPageToken(val *string) {
+	p.PageToken = val
+}
+func (p *ListSessionsRequest) SetPlatformType(val *common.PlatformType) {
+	p.PlatformType = val
+}
+func (p *ListSessionsRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_ListSessionsRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "start_time",
+	3:   "end_time",
+	4:   "filters",
+	5:   "page_size",
+	6:   "page_token",
+	7:   "platform_type",
+	255: "Base",
+}
+
+func (p *ListSessionsRequest) IsSetFilters() bool {
+	return p.Filters != nil
+}
+
+func (p *ListSessionsRequest) IsSetPageSize() bool {
+	return p.PageSize != nil
+}
+
+func (p *ListSessionsRequest) IsSetPageToken() bool {
+	return p.PageToken != nil
+}
+
+func (p *ListSessionsRequest) IsSetPlatformType() bool {
+	return p.PlatformType != nil
+}
+
+func (p *ListSessionsRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListSessionsRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetStartTime bool = false
+	var issetEndTime bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStartTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEndTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStartTime {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEndTime {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSessionsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListSessionsRequest[fieldId]))
+}
+
+func (p *ListSessionsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ListSessionsRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *ListSessionsRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *ListSessionsRequest) ReadField4(iprot thrift.TProtocol) error {
+	_field := filter.NewFilterFields()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filters = _field
+	return nil
+}
+func (p *ListSessionsRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *ListSessionsRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PageToken = _field
+	return nil
+}
+func (p *ListSessionsRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *common.PlatformType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PlatformType = _field
+	return nil
+}
+func (p *ListSessionsRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ListSessionsRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSessionsRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListSessionsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListSessionsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ListSessionsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EndTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ListSessionsRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ListSessionsRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageSize() {
+		if err = oprot.WriteFieldBegin("page_size", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PageSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ListSessionsRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageToken() {
+		if err = oprot.WriteFieldBegin("page_token", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PageToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ListSessionsRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlatformType() {
+		if err = oprot.WriteFieldBegin("platform_type", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PlatformType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ListSessionsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListSessionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSessionsRequest(%+v)", *p)
+
+}
+
+func (p *ListSessionsRequest) DeepEqual(ano *ListSessionsRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EndTime) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Filters) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.PageSize) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.PageToken) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.PlatformType) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ListSessionsRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsRequest) Field2DeepEqual(src int64) bool {
+
+	if p.StartTime != src {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsRequest) Field3DeepEqual(src int64) bool {
+
+	if p.EndTime != src {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsRequest) Field4DeepEqual(src *filter.FilterFields) bool {
+
+	if !p.Filters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsRequest) Field5DeepEqual(src *int32) bool {
+
+	if p.PageSize == src {
+		return true
+	} else if p.PageSize == nil || src == nil {
+		return false
+	}
+	if *p.PageSize != *src {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsRequest) Field6DeepEqual(src *string) bool {
+
+	if p.PageToken == src {
+		return true
+	} else if p.PageToken == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PageToken, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsRequest) Field7DeepEqual(src *common.PlatformType) bool {
+
+	if p.PlatformType == src {
+		return true
+	} else if p.PlatformType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PlatformType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ListSessionsResponse struct {
+	// 按最近活跃时间倒序，统计携带thread_id的span
+	Sessions      []*session.Session `thrift:"sessions,1,required" frugal:"1,required,list<session.Session>" form:"sessions,required" json:"sessions,required" query:"sessions,required"`
+	NextPageToken string             `thrift:"next_page_token,2,required" frugal:"2,required,string" form:"next_page_token,required" json:"next_page_token,required" query:"next_page_token,required"`
+	HasMore       bool               `thrift:"has_more,3,required" frugal:"3,required,bool" form:"has_more,required" json:"has_more,required" query:"has_more,required"`
+	BaseResp      *base.BaseResp     `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewListSessionsResponse() *ListSessionsResponse {
+	return &ListSessionsResponse{}
+}
+
+func (p *ListSessionsResponse) InitDefault() {
+}
+
+func (p *ListSessionsResponse) GetSessions() (v []*session.Session) {
+	if p != nil {
+		return p.Sessions
+	}
+	return
+}
+
+func (p *ListSessionsResponse) GetNextPageToken() (v string) {
+	if p != nil {
+		return p.NextPageToken
+	}
+	return
+}
+
+func (p *ListSessionsResponse) GetHasMore() (v bool) {
+	if p != nil {
+		return p.HasMore
+	}
+	return
+}
+
+var ListSessionsResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ListSessionsResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return ListSessionsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ListSessionsResponse) SetSessions(val []*session.Session) {
+	p.Sessions = val
+}
+func (p *ListSessionsResponse) SetNextPageToken(val string) {
+	p.NextPageToken = val
+}
+func (p *ListSessionsResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *ListSessionsResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ListSessionsResponse = map[int16]string{
+	1:   "sessions",
+	2:   "next_page_token",
+	3:   "has_more",
+	255: "BaseResp",
+}
+
+func (p *ListSessionsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListSessionsResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSessions bool = false
+	var issetNextPageToken bool = false
+	var issetHasMore bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSessions = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNextPageToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetHasMore = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSessions {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetNextPageToken {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetHasMore {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSessionsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListSessionsResponse[fieldId]))
+}
+
+func (p *ListSessionsResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*session.Session, 0, size)
+	values := make([]session.Session, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Sessions = _field
+	return nil
+}
+func (p *ListSessionsResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NextPageToken = _field
+	return nil
+}
+func (p *ListSessionsResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
+func (p *ListSessionsResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ListSessionsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSessionsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListSessionsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sessions", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Sessions)); err != nil {
+		return err
+	}
+	for _, v := range p.Sessions {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListSessionsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("next_page_token", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NextPageToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ListSessionsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ListSessionsResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ListSessionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSessionsResponse(%+v)", *p)
+
+}
+
+func (p *ListSessionsResponse) DeepEqual(ano *ListSessionsResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Sessions) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.NextPageToken) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.HasMore) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ListSessionsResponse) Field1DeepEqual(src []*session.Session) bool {
+
+	if len(p.Sessions) != len(src) {
+		return false
+	}
+	for i, v := range p.Sessions {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListSessionsResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.NextPageToken, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsResponse) Field3DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
+func (p *ListSessionsResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetSessionRequest struct {
+	WorkspaceID int64  `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
+	SessionID   string `thrift:"session_id,2,required" frugal:"2,required,string" form:"session_id,required" json:"session_id,required"`
+	// ms
+	StartTime int64 `thrift:"start_time,3,required" frugal:"3,required,i64" json:"start_time" form:"start_time,required" `
+	// ms
+	EndTime      int64                `thrift:"end_time,4,required" frugal:"4,required,i64" json:"end_time" form:"end_time,required" `
+	PlatformType *common.PlatformType `thrift:"platform_type,5,optional" frugal:"5,optional,string" form:"platform_type" json:"platform_type,omitempty"`
+	Base         *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewGetSessionRequest() *GetSessionRequest {
+	return &GetSessionRequest{}
+}
+
+func (p *GetSessionRequest) InitDefault() {
+}
+
+func (p *GetSessionRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *GetSessionRequest) GetSessionID() (v string) {
+	if p != nil {
+		return p.SessionID
+	}
+	return
+}
+
+func (p *GetSessionRequest) GetStartTime() (v int64) {
+	if p != nil {
+		return p.StartTime
+	}
+	return
+}
+
+func (p *GetSessionRequest) GetEndTime() (v int64) {
+	if p != nil {
+		return p.EndTime
+	}
+	return
+}
+
+var GetSessionRequest_PlatformType_DEFAULT common.PlatformType
+
+func (p *GetSessionRequest) GetPlatformType() (v common.PlatformType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPlatformType() {
+		return GetSessionRequest_PlatformType_DEFAULT
+	}
+	return *p.PlatformType
+}
+
+var GetSessionRequest_Base_DEFAULT *base.Base
+
+func (p *GetSessionRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return GetSessionRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *GetSessionRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *GetSessionRequest) SetSessionID(val string) {
+	p.SessionID = val
+}
+func (p *GetSessionRequest) SetStartTime(val int64) {
+	p.StartTime = val
+}
+func (p *GetSessionRequest) SetEndTime(val int64) {
+	p.EndTime = val
+}
+func (p *GetSessionRequest) SetPlatformType(val *common.PlatformType) {
+	p.PlatformType = val
+}
+func (p *GetSessionRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_GetSessionRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "session_id",
+	3:   "start_time",
+	4:   "end_time",
+	5:   "platform_type",
+	255: "Base",
+}
+
+func (p *GetSessionRequest) IsSetPlatformType() bool {
+	return p.PlatformType != nil
+}
+
+func (p *GetSessionRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetSessionRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetSessionID bool = false
+	var issetStartTime bool = false
+	var issetEndTime bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSessionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStartTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEndTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSessionID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStartTime {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEndTime {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSessionRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetSessionRequest[fieldId]))
+}
+
+func (p *GetSessionRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *GetSessionRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SessionID = _field
+	return nil
+}
+func (p *GetSessionRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *GetSessionRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *GetSessionRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *common.PlatformType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PlatformType = _field
+	return nil
+}
+func (p *GetSessionRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *GetSessionRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetSessionRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetSessionRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetSessionRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("session_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SessionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetSessionRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetSessionRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EndTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *GetSessionRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlatformType() {
+		if err = oprot.WriteFieldBegin("platform_type", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PlatformType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *GetSessionRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetSessionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetSessionRequest(%+v)", *p)
+
+}
+
+func (p *GetSessionRequest) DeepEqual(ano *GetSessionRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.SessionID) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.EndTime) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.PlatformType) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *GetSessionRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *GetSessionRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.SessionID, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *GetSessionRequest) Field3DeepEqual(src int64) bool {
+
+	if p.StartTime != src {
+		return false
+	}
+	return true
+}
+func (p *GetSessionRequest) Field4DeepEqual(src int64) bool {
+
+	if p.EndTime != src {
+		return false
+	}
+	return true
+}
+func (p *GetSessionRequest) Field5DeepEqual(src *common.PlatformType) bool {
+
+	if p.PlatformType == src {
+		return true
+	} else if p.PlatformType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PlatformType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *GetSessionRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetSessionResponse struct {
+	// 各轮按开始时间升序
+	Session *session.Session `thrift:"session,1,required" frugal:"1,required,session.Session" form:"session,required" json:"session,required" query:"session,required"`
+	// 会话过长时只返回最近的部分轮次
+	Truncated *bool          `thrift:"truncated,2,optional" frugal:"2,optional,bool" form:"truncated" json:"truncated,omitempty" query:"truncated"`
+	BaseResp  *base.BaseResp `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewGetSessionResponse() *GetSessionResponse {
+	return &GetSessionResponse{}
+}
+
+func (p *GetSessionResponse) InitDefault() {
+}
+
+var GetSessionResponse_Session_DEFAULT *session.Session
+
+func (p *GetSessionResponse) GetSession() (v *session.Session) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSession() {
+		return GetSessionResponse_Session_DEFAULT
+	}
+	return p.Session
+}
+
+var GetSessionResponse_Truncated_DEFAULT bool
+
+func (p *GetSessionResponse) GetTruncated() (v bool) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTruncated() {
+		return GetSessionResponse_Truncated_DEFAULT
+	}
+	return *p.Truncated
+}
+
+var GetSessionResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *GetSessionResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return GetSessionResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *GetSessionResponse) SetSession(val *session.Session) {
+	p.Session = val
+}
+func (p *GetSessionResponse) SetTruncated(val *bool) {
+	p.Truncated = val
+}
+func (p *GetSessionResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_GetSessionResponse = map[int16]string{
+	1:   "session",
+	2:   "truncated",
+	255: "BaseResp",
+}
+
+func (p *GetSessionResponse) IsSetSession() bool {
+	return p.Session != nil
+}
+
+func (p *GetSessionResponse) IsSetTruncated() bool {
+	return p.Truncated != nil
+}
+
+func (p *GetSessionResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetSessionResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSession bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSession = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetSession {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSessionResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetSessionResponse[fieldId]))
+}
+
+func (p *GetSessionResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := session.NewSession()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Session = _field
+	return nil
+}
+func (p *GetSessionResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Truncated = _field
+	return nil
+}
+func (p *GetSessionResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *GetSessionResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetSessionResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetSessionResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("session", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Session.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetSessionResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTruncated() {
+		if err = oprot.WriteFieldBegin("truncated", thrift.BOOL, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Truncated); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetSessionResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetSessionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetSessionResponse(%+v)", *p)
+
+}
+
+func (p *GetSessionResponse) DeepEqual(ano *GetSessionResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Session) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Truncated) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *GetSessionResponse) Field1DeepEqual(src *session.Session) bool {
+
+	if !p.Session.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetSessionResponse) Field2DeepEqual(src *bool) bool {
+
+	if p.Truncated == src {
+		return true
+	} else if p.Truncated == nil || src == nil {
+		return false
+	}
+	if *p.Truncated != *src {
+		return false
+	}
+	return true
+}
+func (p *GetSessionResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ExportTracesToFileRequest struct {
+	WorkspaceID int64 `thrift:"workspace_id,1,required" frugal:"1,required,i64" json:"workspace_id" form:"workspace_id,required" `
+	// ms
+	StartTime int64 `thrift:"start_time,2,required" frugal:"2,required,i64" json:"start_time" form:"start_time,required" `
+	// ms
+	EndTime      int64                         `thrift:"end_time,3,required" frugal:"3,required,i64" json:"end_time" form:"end_time,required" `
+	Format       trace_export.ExportFileFormat `thrift:"format,4,required" frugal:"4,required,string" form:"format,required" json:"format,required"`
+	Filters      *filter.FilterFields          `thrift:"filters,5,optional" frugal:"5,optional,filter.FilterFields" form:"filters" json:"filters,omitempty"`
+	PlatformType *common.PlatformType          `thrift:"platform_type,6,optional" frugal:"6,optional,string" form:"platform_type" json:"platform_type,omitempty"`
+	// 默认导出全部span
+	SpanListType *common.SpanListType `thrift:"span_list_type,7,optional" frugal:"7,optional,string" form:"span_list_type" json:"span_list_type,omitempty"`
+	Base         *base.Base           `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewExportTracesToFileRequest() *ExportTracesToFileRequest {
+	return &ExportTracesToFileRequest{}
+}
+
+func (p *ExportTracesToFileRequest) InitDefault() {
+}
+
+func (p *ExportTracesToFileRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+func (p *ExportTracesToFileRequest) GetStartTime() (v int64) {
+	if p != nil {
+		return p.StartTime
+	}
+	return
+}
+
+func (p *ExportTracesToFileRequest) GetEndTime() (v int64) {
+	if p != nil {
+		return p.EndTime
+	}
+	return
+}
+
+func (p *ExportTracesToFileRequest) GetFormat() (v trace_export.ExportFileFormat) {
+	if p != nil {
+		return p.Format
+	}
+	return
+}
+
+var ExportTracesToFileRequest_Filters_DEFAULT *filter.FilterFields
+
+func (p *ExportTracesToFileRequest) GetFilters() (v *filter.FilterFields) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetFilters() {
+		return ExportTracesToFileRequest_Filters_DEFAULT
+	}
+	return p.Filters
+}
+
+var ExportTracesToFileRequest_PlatformType_DEFAULT common.PlatformType
+
+func (p *ExportTracesToFileRequest) GetPlatformType() (v common.PlatformType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetPlatformType() {
+		return ExportTracesToFileRequest_PlatformType_DEFAULT
+	}
+	return *p.PlatformType
+}
+
+var ExportTracesToFileRequest_SpanListType_DEFAULT common.SpanListType
+
+func (p *ExportTracesToFileRequest) GetSpanListType() (v common.SpanListType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSpanListType() {
+		return ExportTracesToFileRequest_SpanListType_DEFAULT
+	}
+	return *p.SpanListType
+}
+
+var ExportTracesToFileRequest_Base_DEFAULT *base.Base
+
+func (p *ExportTracesToFileRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return ExportTracesToFileRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ExportTracesToFileRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *ExportTracesToFileRequest) SetStartTime(val int64) {
+	p.StartTime = val
+}
+func (p *ExportTracesToFileRequest) SetEndTime(val int64) {
+	p.EndTime = val
+}
+func (p *ExportTracesToFileRequest) SetFormat(val trace_export.ExportFileFormat) {
+	p.Format = val
+}
+func (p *ExportTracesToFileRequest) SetFilters(val *filter.FilterFields) {
+	p.Filters = val
+}
+func (p *ExportTracesToFileRequest) SetPlatformType(val *common.PlatformType) {
+	p.PlatformType = val
+}
+func (p *ExportTracesToFileRequest) SetSpanListType(val *common.SpanListType) {
+	p.SpanListType = val
+}
+func (p *ExportTracesToFileRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_ExportTracesToFileRequest = map[int16]string{
+	1:   "workspace_id",
+	2:   "start_time",
+	3:   "end_time",
+	4:   "format",
+	5:   "filters",
+	6:   "platform_type",
+	7:   "span_list_type",
+	255: "Base",
+}
+
+func (p *ExportTracesToFileRequest) IsSetFilters() bool {
+	return p.Filters != nil
+}
+
+func (p *ExportTracesToFileRequest) IsSetPlatformType() bool {
+	return p.PlatformType != nil
+}
+
+func (p *ExportTracesToFileRequest) IsSetSpanListType() bool {
+	return p.SpanListType != nil
+}
+
+func (p *ExportTracesToFileRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExportTracesToFileRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetStartTime bool = false
+	var issetEndTime bool = false
+	var issetFormat bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStartTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEndTime = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFormat = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStartTime {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEndTime {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFormat {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportTracesToFileRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExportTracesToFileRequest[fieldId]))
+}
+
+func (p *ExportTracesToFileRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *ExportTracesToFileRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *ExportTracesToFileRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *ExportTracesToFileRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field trace_export.ExportFileFormat
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Format = _field
+	return nil
+}
+func (p *ExportTracesToFileRequest) ReadField5(iprot thrift.TProtocol) error {
+	_field := filter.NewFilterFields()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Filters = _field
+	return nil
+}
+func (p *ExportTracesToFileRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *common.PlatformType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PlatformType = _field
+	return nil
+}
+func (p *ExportTracesToFileRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *common.SpanListType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SpanListType = _field
+	return nil
+}
+func (p *ExportTracesToFileRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ExportTracesToFileRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportTracesToFileRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExportTracesToFileRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExportTracesToFileRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExportTracesToFileRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EndTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExportTracesToFileRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("format", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Format); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExportTracesToFileRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilters() {
+		if err = oprot.WriteFieldBegin("filters", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filters.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExportTracesToFileRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlatformType() {
+		if err = oprot.WriteFieldBegin("platform_type", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PlatformType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExportTracesToFileRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSpanListType() {
+		if err = oprot.WriteFieldBegin("span_list_type", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SpanListType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ExportTracesToFileRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ExportTracesToFileRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportTracesToFileRequest(%+v)", *p)
+
+}
+
+func (p *ExportTracesToFileRequest) DeepEqual(ano *ExportTracesToFileRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.StartTime) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.EndTime) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Format) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Filters) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.PlatformType) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.SpanListType) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportTracesToFileRequest) Field1DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *ExportTracesToFileRequest) Field2DeepEqual(src int64) bool {
+
+	if p.StartTime != src {
+		return false
+	}
+	return true
+}
+func (p *ExportTracesToFileRequest) Field3DeepEqual(src int64) bool {
+
+	if p.EndTime != src {
+		return false
+	}
+	return true
+}
+func (p *ExportTracesToFileRequest) Field4DeepEqual(src trace_export.ExportFileFormat) bool {
+
+	if strings.Compare(p.Format, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExportTracesToFileRequest) Field5DeepEqual(src *filter.FilterFields) bool {
+
+	if !p.Filters.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ExportTracesToFileRequest) Field6DeepEqual(src *common.PlatformType) bool {
+
+	if p.PlatformType == src {
+		return true
+	} else if p.PlatformType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.PlatformType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExportTracesToFileRequest) Field7DeepEqual(src *common.SpanListType) bool {
+
+	if p.SpanListType == src {
+		return true
+	} else if p.SpanListType == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.SpanListType, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ExportTracesToFileRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type ExportTracesToFileResponse struct {
+	// 导出异步执行，通过GetTraceExportJob查询进度
+	JobID    int64          `thrift:"job_id,1,required" frugal:"1,required,i64" json:"job_id" form:"job_id,required" query:"job_id,required"`
+	BaseResp *base.BaseResp `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewExportTracesToFileResponse() *ExportTracesToFileResponse {
+	return &ExportTracesToFileResponse{}
+}
+
+func (p *ExportTracesToFileResponse) InitDefault() {
+}
+
+func (p *ExportTracesToFileResponse) GetJobID() (v int64) {
+	if p != nil {
+		return p.JobID
+	}
+	return
+}
+
+var ExportTracesToFileResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *ExportTracesToFileResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return ExportTracesToFileResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ExportTracesToFileResponse) SetJobID(val int64) {
+	p.JobID = val
+}
+func (p *ExportTracesToFileResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_ExportTracesToFileResponse = map[int16]string{
+	1:   "job_id",
+	255: "BaseResp",
+}
+
+func (p *ExportTracesToFileResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ExportTracesToFileResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetJobID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetJobID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetJobID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportTracesToFileResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExportTracesToFileResponse[fieldId]))
+}
+
+func (p *ExportTracesToFileResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.JobID = _field
+	return nil
+}
+func (p *ExportTracesToFileResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ExportTracesToFileResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportTracesToFileResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExportTracesToFileResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.JobID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExportTracesToFileResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ExportTracesToFileResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportTracesToFileResponse(%+v)", *p)
+
+}
+
+func (p *ExportTracesToFileResponse) DeepEqual(ano *ExportTracesToFileResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.JobID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *ExportTracesToFileResponse) Field1DeepEqual(src int64) bool {
+
+	if p.JobID != src {
+		return false
+	}
+	return true
+}
+func (p *ExportTracesToFileResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetTraceExportJobRequest struct {
+	JobID       int64      `thrift:"job_id,1,required" frugal:"1,required,i64" json:"job_id" path:"job_id,required" `
+	WorkspaceID int64      `thrift:"workspace_id,2,required" frugal:"2,required,i64" json:"workspace_id" query:"workspace_id,required" `
+	Base        *base.Base `thrift:"Base,255,optional" frugal:"255,optional,base.Base" form:"Base" json:"Base,omitempty" query:"Base"`
+}
+
+func NewGetTraceExportJobRequest() *GetTraceExportJobRequest {
+	return &GetTraceExportJobRequest{}
+}
+
+func (p *GetTraceExportJobRequest) InitDefault() {
+}
+
+func (p *GetTraceExportJobRequest) GetJobID() (v int64) {
+	if p != nil {
+		return p.JobID
+	}
+	return
+}
+
+func (p *GetTraceExportJobRequest) GetWorkspaceID() (v int64) {
+	if p != nil {
+		return p.WorkspaceID
+	}
+	return
+}
+
+var GetTraceExportJobRequest_Base_DEFAULT *base.Base
+
+func (p *GetTraceExportJobRequest) GetBase() (v *base.Base) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBase() {
+		return GetTraceExportJobRequest_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *GetTraceExportJobRequest) SetJobID(val int64) {
+	p.JobID = val
+}
+func (p *GetTraceExportJobRequest) SetWorkspaceID(val int64) {
+	p.WorkspaceID = val
+}
+func (p *GetTraceExportJobRequest) SetBase(val *base.Base) {
+	p.Base = val
+}
+
+var fieldIDToName_GetTraceExportJobRequest = map[int16]string{
+	1:   "job_id",
+	2:   "workspace_id",
+	255: "Base",
+}
+
+func (p *GetTraceExportJobRequest) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetTraceExportJobRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetJobID bool = false
+	var issetWorkspaceID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetJobID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetJobID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTraceExportJobRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetTraceExportJobRequest[fieldId]))
+}
+
+func (p *GetTraceExportJobRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.JobID = _field
+	return nil
+}
+func (p *GetTraceExportJobRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return nil
+}
+func (p *GetTraceExportJobRequest) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBase()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *GetTraceExportJobRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTraceExportJobRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetTraceExportJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.JobID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetTraceExportJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("workspace_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.WorkspaceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetTraceExportJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBase() {
+		if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Base.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetTraceExportJobRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetTraceExportJobRequest(%+v)", *p)
+
+}
+
+func (p *GetTraceExportJobRequest) DeepEqual(ano *GetTraceExportJobRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.JobID) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.WorkspaceID) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.Base) {
+		return false
+	}
+	return true
+}
+
+func (p *GetTraceExportJobRequest) Field1DeepEqual(src int64) bool {
+
+	if p.JobID != src {
+		return false
+	}
+	return true
+}
+func (p *GetTraceExportJobRequest) Field2DeepEqual(src int64) bool {
+
+	if p.WorkspaceID != src {
+		return false
+	}
+	return true
+}
+func (p *GetTraceExportJobRequest) Field255DeepEqual(src *base.Base) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type GetTraceExportJobResponse struct {
+	Job      *trace_export.TraceExportJob `thrift:"job,1,required" frugal:"1,required,trace_export.TraceExportJob" form:"job,required" json:"job,required" query:"job,required"`
+	BaseResp *base.BaseResp               `thrift:"BaseResp,255,optional" frugal:"255,optional,base.BaseResp" form:"BaseResp" json:"BaseResp,omitempty" query:"BaseResp"`
+}
+
+func NewGetTraceExportJobResponse() *GetTraceExportJobResponse {
+	return &GetTraceExportJobResponse{}
+}
+
+func (p *GetTraceExportJobResponse) InitDefault() {
+}
+
+var GetTraceExportJobResponse_Job_DEFAULT *trace_export.TraceExportJob
+
+func (p *GetTraceExportJobResponse) GetJob() (v *trace_export.TraceExportJob) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetJob() {
+		return GetTraceExportJobResponse_Job_DEFAULT
+	}
+	return p.Job
+}
+
+var GetTraceExportJobResponse_BaseResp_DEFAULT *base.BaseResp
+
+func (p *GetTraceExportJobResponse) GetBaseResp() (v *base.BaseResp) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetBaseResp() {
+		return GetTraceExportJobResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *GetTraceExportJobResponse) SetJob(val *trace_export.TraceExportJob) {
+	p.Job = val
+}
+func (p *GetTraceExportJobResponse) SetBaseResp(val *base.BaseResp) {
+	p.BaseResp = val
+}
+
+var fieldIDToName_GetTraceExportJobResponse = map[int16]string{
+	1:   "job",
+	255: "BaseResp",
+}
+
+func (p *GetTraceExportJobResponse) IsSetJob() bool {
+	return p.Job != nil
+}
+
+func (p *GetTraceExportJobResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetTraceExportJobResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetJob bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetJob = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetJob {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTraceExportJobResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetTraceExportJobResponse[fieldId]))
+}
+
+func (p *GetTraceExportJobResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := trace_export.NewTraceExportJob()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Job = _field
+	return nil
+}
+func (p *GetTraceExportJobResponse) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *GetTraceExportJobResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTraceExportJobResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetTraceExportJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Job.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetTraceExportJobResponse) writeField255(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("BaseResp", thrift.STRUCT, 255); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetTraceExportJobResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetTraceExportJobResponse(%+v)", *p)
+
+}
+
+func (p *GetTraceExportJobResponse) DeepEqual(ano *GetTraceExportJobResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Job) {
+		return false
+	}
+	if !p.Field255DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *GetTraceExportJobResponse) Field1DeepEqual(src *trace_export.TraceExportJob) bool {
+
+	if !p.Job.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetTraceExportJobResponse) Field255DeepEqual(src *base.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceService interface {
+	ListSpans(ctx context.Context, req *ListSpansRequest) (r *ListSpansResponse, err error)
+
+	ListPreSpan(ctx context.Context, req *ListPreSpanRequest) (r *ListPreSpanResponse, err error)
+
+	GetTrace(ctx context.Context, req *GetTraceRequest) (r *GetTraceResponse, err error)
+
+	SearchTraceTree(ctx context.Context, req *SearchTraceTreeRequest) (r *SearchTraceTreeResponse, err error)
+
+	BatchGetTracesAdvanceInfo(ctx context.Context, req *BatchGetTracesAdvanceInfoRequest) (r *BatchGetTracesAdvanceInfoResponse, err error)
+
+	IngestTracesInner(ctx context.Context, req *IngestTracesRequest) (r *IngestTracesResponse, err error)
+
+	GetTracesMetaInfo(ctx context.Context, req *GetTracesMetaInfoRequest) (r *GetTracesMetaInfoResponse, err error)
+
+	CreateView(ctx context.Context, req *CreateViewRequest) (r *CreateViewResponse, err error)
+
+	UpdateView(ctx context.Context, req *UpdateViewRequest) (r *UpdateViewResponse, err error)
+
+	DeleteView(ctx context.Context, req *DeleteViewRequest) (r *DeleteViewResponse, err error)
+
+	ListViews(ctx context.Context, req *ListViewsRequest) (r *ListViewsResponse, err error)
+
+	CreateManualAnnotation(ctx context.Context, req *CreateManualAnnotationRequest) (r *CreateManualAnnotationResponse, err error)
+
+	UpdateManualAnnotation(ctx context.Context, req *UpdateManualAnnotationRequest) (r *UpdateManualAnnotationResponse, err error)
+
+	DeleteManualAnnotation(ctx context.Context, req *DeleteManualAnnotationRequest) (r *DeleteManualAnnotationResponse, err error)
+
+	ListAnnotations(ctx context.Context, req *ListAnnotationsRequest) (r *ListAnnotationsResponse, err error)
+
+	ExportTracesToDataset(ctx context.Context, req *ExportTracesToDatasetRequest) (r *ExportTracesToDatasetResponse, err error)
+
+	PreviewExportTracesToDataset(ctx context.Context, req *PreviewExportTracesToDatasetRequest) (r *PreviewExportTracesToDatasetResponse, err error)
+
+	ChangeEvaluatorScore(ctx context.Context, req *ChangeEvaluatorScoreRequest) (r *ChangeEvaluatorScoreResponse, err error)
+
+	ListAnnotationEvaluators(ctx context.Context, req *ListAnnotationEvaluatorsRequest) (r *ListAnnotationEvaluatorsResponse, err error)
+
+	ExtractSpanInfo(ctx context.Context, req *ExtractSpanInfoRequest) (r *ExtractSpanInfoResponse, err error)
+
+	UpsertTrajectoryConfig(ctx context.Context, req *UpsertTrajectoryConfigRequest) (r *UpsertTrajectoryConfigResponse, err error)
+
+	GetTrajectoryConfig(ctx context.Context, req *GetTrajectoryConfigRequest) (r *GetTrajectoryConfigResponse, err error)
+
+	ListTrajectory(ctx context.Context, req *ListTrajectoryRequest) (r *ListTrajectoryResponse, err error)
+
+	UpsertRetentionPolicy(ctx context.Context, req *UpsertRetentionPolicyRequest) (r *UpsertRetentionPolicyResponse, err error)
+
+	GetRetentionPolicy(ctx context.Context, req *GetRetentionPolicyRequest) (r *GetRetentionPolicyResponse, err error)
+
+	DiffTraces(ctx context.Context, req *DiffTracesRequest) (r *DiffTracesResponse, err error)
+
+	ListSessions(ctx context.Context, req *ListSessionsRequest) (r *ListSessionsResponse, err error)
+
+	GetSession(ctx context.Context, req *GetSessionRequest) (r *GetSessionResponse, err error)
+
+	ExportTracesToFile(ctx context.Context, req *ExportTracesToFileRequest) (r *ExportTracesToFileResponse, err error)
+
+	GetTraceExportJob(ctx context.Context, req *GetTraceExportJobRequest) (r *GetTraceExportJobResponse, err error)
+}
+
+type TraceServiceClient struct {
+	c thrift.TClient
+}
+
+func NewTraceServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *TraceServiceClient {
+	return &TraceServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewTraceServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *TraceServiceClient {
+	return &TraceServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewTraceServiceClient(c thrift.TClient) *TraceServiceClient {
+	return &TraceServiceClient{
+		c: c,
+	}
+}
+
+func (p *TraceServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *TraceServiceClient) ListSpans(ctx context.Context, req *ListSpansRequest) (r *ListSpansResponse, err error) {
+	var _args TraceServiceListSpansArgs
+	_args.Req = req
+	var _result TraceServiceListSpansResult
+	if err = p.Client_().Call(ctx, "ListSpans", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ListPreSpan(ctx context.Context, req *ListPreSpanRequest) (r *ListPreSpanResponse, err error) {
+	var _args TraceServiceListPreSpanArgs
+	_args.Req = req
+	var _result TraceServiceListPreSpanResult
+	if err = p.Client_().Call(ctx, "ListPreSpan", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) GetTrace(ctx context.Context, req *GetTraceRequest) (r *GetTraceResponse, err error) {
+	var _args TraceServiceGetTraceArgs
+	_args.Req = req
+	var _result TraceServiceGetTraceResult
+	if err = p.Client_().Call(ctx, "GetTrace", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) SearchTraceTree(ctx context.Context, req *SearchTraceTreeRequest) (r *SearchTraceTreeResponse, err error) {
+	var _args TraceServiceSearchTraceTreeArgs
+	_args.Req = req
+	var _result TraceServiceSearchTraceTreeResult
+	if err = p.Client_().Call(ctx, "SearchTraceTree", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) BatchGetTracesAdvanceInfo(ctx context.Context, req *BatchGetTracesAdvanceInfoRequest) (r *BatchGetTracesAdvanceInfoResponse, err error) {
+	var _args TraceServiceBatchGetTracesAdvanceInfoArgs
+	_args.Req = req
+	var _result TraceServiceBatchGetTracesAdvanceInfoResult
+	if err = p.Client_().Call(ctx, "BatchGetTracesAdvanceInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) IngestTracesInner(ctx context.Context, req *IngestTracesRequest) (r *IngestTracesResponse, err error) {
+	var _args TraceServiceIngestTracesInnerArgs
+	_args.Req = req
+	var _result TraceServiceIngestTracesInnerResult
+	if err = p.Client_().Call(ctx, "IngestTracesInner", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) GetTracesMetaInfo(ctx context.Context, req *GetTracesMetaInfoRequest) (r *GetTracesMetaInfoResponse, err error) {
+	var _args TraceServiceGetTracesMetaInfoArgs
+	_args.Req = req
+	var _result TraceServiceGetTracesMetaInfoResult
+	if err = p.Client_().Call(ctx, "GetTracesMetaInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) CreateView(ctx context.Context, req *CreateViewRequest) (r *CreateViewResponse, err error) {
+	var _args TraceServiceCreateViewArgs
+	_args.Req = req
+	var _result TraceServiceCreateViewResult
+	if err = p.Client_().Call(ctx, "CreateView", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) UpdateView(ctx context.Context, req *UpdateViewRequest) (r *UpdateViewResponse, err error) {
+	var _args TraceServiceUpdateViewArgs
+	_args.Req = req
+	var _result TraceServiceUpdateViewResult
+	if err = p.Client_().Call(ctx, "UpdateView", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) DeleteView(ctx context.Context, req *DeleteViewRequest) (r *DeleteViewResponse, err error) {
+	var _args TraceServiceDeleteViewArgs
+	_args.Req = req
+	var _result TraceServiceDeleteViewResult
+	if err = p.Client_().Call(ctx, "DeleteView", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ListViews(ctx context.Context, req *ListViewsRequest) (r *ListViewsResponse, err error) {
+	var _args TraceServiceListViewsArgs
+	_args.Req = req
+	var _result TraceServiceListViewsResult
+	if err = p.Client_().Call(ctx, "ListViews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) CreateManualAnnotation(ctx context.Context, req *CreateManualAnnotationRequest) (r *CreateManualAnnotationResponse, err error) {
+	var _args TraceServiceCreateManualAnnotationArgs
+	_args.Req = req
+	var _result TraceServiceCreateManualAnnotationResult
+	if err = p.Client_().Call(ctx, "CreateManualAnnotation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) UpdateManualAnnotation(ctx context.Context, req *UpdateManualAnnotationRequest) (r *UpdateManualAnnotationResponse, err error) {
+	var _args TraceServiceUpdateManualAnnotationArgs
+	_args.Req = req
+	var _result TraceServiceUpdateManualAnnotationResult
+	if err = p.Client_().Call(ctx, "UpdateManualAnnotation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) DeleteManualAnnotation(ctx context.Context, req *DeleteManualAnnotationRequest) (r *DeleteManualAnnotationResponse, err error) {
+	var _args TraceServiceDeleteManualAnnotationArgs
+	_args.Req = req
+	var _result TraceServiceDeleteManualAnnotationResult
+	if err = p.Client_().Call(ctx, "DeleteManualAnnotation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ListAnnotations(ctx context.Context, req *ListAnnotationsRequest) (r *ListAnnotationsResponse, err error) {
+	var _args TraceServiceListAnnotationsArgs
+	_args.Req = req
+	var _result TraceServiceListAnnotationsResult
+	if err = p.Client_().Call(ctx, "ListAnnotations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ExportTracesToDataset(ctx context.Context, req *ExportTracesToDatasetRequest) (r *ExportTracesToDatasetResponse, err error) {
+	var _args TraceServiceExportTracesToDatasetArgs
+	_args.Req = req
+	var _result TraceServiceExportTracesToDatasetResult
+	if err = p.Client_().Call(ctx, "ExportTracesToDataset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) PreviewExportTracesToDataset(ctx context.Context, req *PreviewExportTracesToDatasetRequest) (r *PreviewExportTracesToDatasetResponse, err error) {
+	var _args TraceServicePreviewExportTracesToDatasetArgs
+	_args.Req = req
+	var _result TraceServicePreviewExportTracesToDatasetResult
+	if err = p.Client_().Call(ctx, "PreviewExportTracesToDataset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ChangeEvaluatorScore(ctx context.Context, req *ChangeEvaluatorScoreRequest) (r *ChangeEvaluatorScoreResponse, err error) {
+	var _args TraceServiceChangeEvaluatorScoreArgs
+	_args.Req = req
+	var _result TraceServiceChangeEvaluatorScoreResult
+	if err = p.Client_().Call(ctx, "ChangeEvaluatorScore", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ListAnnotationEvaluators(ctx context.Context, req *ListAnnotationEvaluatorsRequest) (r *ListAnnotationEvaluatorsResponse, err error) {
+	var _args TraceServiceListAnnotationEvaluatorsArgs
+	_args.Req = req
+	var _result TraceServiceListAnnotationEvaluatorsResult
+	if err = p.Client_().Call(ctx, "ListAnnotationEvaluators", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ExtractSpanInfo(ctx context.Context, req *ExtractSpanInfoRequest) (r *ExtractSpanInfoResponse, err error) {
+	var _args TraceServiceExtractSpanInfoArgs
+	_args.Req = req
+	var _result TraceServiceExtractSpanInfoResult
+	if err = p.Client_().Call(ctx, "ExtractSpanInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) UpsertTrajectoryConfig(ctx context.Context, req *UpsertTrajectoryConfigRequest) (r *UpsertTrajectoryConfigResponse, err error) {
+	var _args TraceServiceUpsertTrajectoryConfigArgs
+	_args.Req = req
+	var _result TraceServiceUpsertTrajectoryConfigResult
+	if err = p.Client_().Call(ctx, "UpsertTrajectoryConfig", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) GetTrajectoryConfig(ctx context.Context, req *GetTrajectoryConfigRequest) (r *GetTrajectoryConfigResponse, err error) {
+	var _args TraceServiceGetTrajectoryConfigArgs
+	_args.Req = req
+	var _result TraceServiceGetTrajectoryConfigResult
+	if err = p.Client_().Call(ctx, "GetTrajectoryConfig", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ListTrajectory(ctx context.Context, req *ListTrajectoryRequest) (r *ListTrajectoryResponse, err error) {
+	var _args TraceServiceListTrajectoryArgs
+	_args.Req = req
+	var _result TraceServiceListTrajectoryResult
+	if err = p.Client_().Call(ctx, "ListTrajectory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) UpsertRetentionPolicy(ctx context.Context, req *UpsertRetentionPolicyRequest) (r *UpsertRetentionPolicyResponse, err error) {
+	var _args TraceServiceUpsertRetentionPolicyArgs
+	_args.Req = req
+	var _result TraceServiceUpsertRetentionPolicyResult
+	if err = p.Client_().Call(ctx, "UpsertRetentionPolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) GetRetentionPolicy(ctx context.Context, req *GetRetentionPolicyRequest) (r *GetRetentionPolicyResponse, err error) {
+	var _args TraceServiceGetRetentionPolicyArgs
+	_args.Req = req
+	var _result TraceServiceGetRetentionPolicyResult
+	if err = p.Client_().Call(ctx, "GetRetentionPolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) DiffTraces(ctx context.Context, req *DiffTracesRequest) (r *DiffTracesResponse, err error) {
+	var _args TraceServiceDiffTracesArgs
+	_args.Req = req
+	var _result TraceServiceDiffTracesResult
+	if err = p.Client_().Call(ctx, "DiffTraces", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ListSessions(ctx context.Context, req *ListSessionsRequest) (r *ListSessionsResponse, err error) {
+	var _args TraceServiceListSessionsArgs
+	_args.Req = req
+	var _result TraceServiceListSessionsResult
+	if err = p.Client_().Call(ctx, "ListSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) GetSession(ctx context.Context, req *GetSessionRequest) (r *GetSessionResponse, err error) {
+	var _args TraceServiceGetSessionArgs
+	_args.Req = req
+	var _result TraceServiceGetSessionResult
+	if err = p.Client_().Call(ctx, "GetSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) ExportTracesToFile(ctx context.Context, req *ExportTracesToFileRequest) (r *ExportTracesToFileResponse, err error) {
+	var _args TraceServiceExportTracesToFileArgs
+	_args.Req = req
+	var _result TraceServiceExportTracesToFileResult
+	if err = p.Client_().Call(ctx, "ExportTracesToFile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TraceServiceClient) GetTraceExportJob(ctx context.Context, req *GetTraceExportJobRequest) (r *GetTraceExportJobResponse, err error) {
+	var _args TraceServiceGetTraceExportJobArgs
+	_args.Req = req
+	var _result TraceServiceGetTraceExportJobResult
+	if err = p.Client_().Call(ctx, "GetTraceExportJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type TraceServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      TraceService
+}
+
+func (p *TraceServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *TraceServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *TraceServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewTraceServiceProcessor(handler TraceService) *TraceServiceProcessor {
+	self := &TraceServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListSpans", &traceServiceProcessorListSpans{handler: handler})
+	self.AddToProcessorMap("ListPreSpan", &traceServiceProcessorListPreSpan{handler: handler})
+	self.AddToProcessorMap("GetTrace", &traceServiceProcessorGetTrace{handler: handler})
+	self.AddToProcessorMap("SearchTraceTree", &traceServiceProcessorSearchTraceTree{handler: handler})
+	self.AddToProcessorMap("BatchGetTracesAdvanceInfo", &traceServiceProcessorBatchGetTracesAdvanceInfo{handler: handler})
+	self.AddToProcessorMap("IngestTracesInner", &traceServiceProcessorIngestTracesInner{handler: handler})
+	self.AddToProcessorMap("GetTracesMetaInfo", &traceServiceProcessorGetTracesMetaInfo{handler: handler})
+	self.AddToProcessorMap("CreateView", &traceServiceProcessorCreateView{handler: handler})
+	self.AddToProcessorMap("UpdateView", &traceServiceProcessorUpdateView{handler: handler})
+	self.AddToProcessorMap("DeleteView", &traceServiceProcessorDeleteView{handler: handler})
+	self.AddToProcessorMap("ListViews", &traceServiceProcessorListViews{handler: handler})
+	self.AddToProcessorMap("CreateManualAnnotation", &traceServiceProcessorCreateManualAnnotation{handler: handler})
+	self.AddToProcessorMap("UpdateManualAnnotation", &traceServiceProcessorUpdateManualAnnotation{handler: handler})
+	self.AddToProcessorMap("DeleteManualAnnotation", &traceServiceProcessorDeleteManualAnnotation{handler: handler})
+	self.AddToProcessorMap("ListAnnotations", &traceServiceProcessorListAnnotations{handler: handler})
+	self.AddToProcessorMap("ExportTracesToDataset", &traceServiceProcessorExportTracesToDataset{handler: handler})
+	self.AddToProcessorMap("PreviewExportTracesToDataset", &traceServiceProcessorPreviewExportTracesToDataset{handler: handler})
+	self.AddToProcessorMap("ChangeEvaluatorScore", &traceServiceProcessorChangeEvaluatorScore{handler: handler})
+	self.AddToProcessorMap("ListAnnotationEvaluators", &traceServiceProcessorListAnnotationEvaluators{handler: handler})
+	self.AddToProcessorMap("ExtractSpanInfo", &traceServiceProcessorExtractSpanInfo{handler: handler})
+	self.AddToProcessorMap("UpsertTrajectoryConfig", &traceServiceProcessorUpsertTrajectoryConfig{handler: handler})
+	self.AddToProcessorMap("GetTrajectoryConfig", &traceServiceProcessorGetTrajectoryConfig{handler: handler})
+	self.AddToProcessorMap("ListTrajectory", &traceServiceProcessorListTrajectory{handler: handler})
+	self.AddToProcessorMap("UpsertRetentionPolicy", &traceServiceProcessorUpsertRetentionPolicy{handler: handler})
+	self.AddToProcessorMap("GetRetentionPolicy", &traceServiceProcessorGetRetentionPolicy{handler: handler})
+	self.AddToProcessorMap("DiffTraces", &traceServiceProcessorDiffTraces{handler: handler})
+	self.AddToProcessorMap("ListSessions", &traceServiceProcessorListSessions{handler: handler})
+	self.AddToProcessorMap("GetSession", &traceServiceProcessorGetSession{handler: handler})
+	self.AddToProcessorMap("ExportTracesToFile", &traceServiceProcessorExportTracesToFile{handler: handler})
+	self.AddToProcessorMap("GetTraceExportJob", &traceServiceProcessorGetTraceExportJob{handler: handler})
+	return self
+}
+func (p *TraceServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type traceServiceProcessorListSpans struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorListSpans) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceListSpansArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListSpans", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceListSpansResult{}
+	var retval *ListSpansResponse
+	if retval, err2 = p.handler.ListSpans(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSpans: "+err2.Error())
+		oprot.WriteMessageBegin("ListSpans", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListSpans", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorListPreSpan struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorListPreSpan) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceListPreSpanArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListPreSpan", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceListPreSpanResult{}
+	var retval *ListPreSpanResponse
+	if retval, err2 = p.handler.ListPreSpan(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListPreSpan: "+err2.Error())
+		oprot.WriteMessageBegin("ListPreSpan", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListPreSpan", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorGetTrace struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorGetTrace) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceGetTraceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTrace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceGetTraceResult{}
+	var retval *GetTraceResponse
+	if retval, err2 = p.handler.GetTrace(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTrace: "+err2.Error())
+		oprot.WriteMessageBegin("GetTrace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTrace", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorSearchTraceTree struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorSearchTraceTree) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceSearchTraceTreeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SearchTraceTree", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceSearchTraceTreeResult{}
+	var retval *SearchTraceTreeResponse
+	if retval, err2 = p.handler.SearchTraceTree(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchTraceTree: "+err2.Error())
+		oprot.WriteMessageBegin("SearchTraceTree", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SearchTraceTree", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorBatchGetTracesAdvanceInfo struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorBatchGetTracesAdvanceInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceBatchGetTracesAdvanceInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchGetTracesAdvanceInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceBatchGetTracesAdvanceInfoResult{}
+	var retval *BatchGetTracesAdvanceInfoResponse
+	if retval, err2 = p.handler.BatchGetTracesAdvanceInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetTracesAdvanceInfo: "+err2.Error())
+		oprot.WriteMessageBegin("BatchGetTracesAdvanceInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchGetTracesAdvanceInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorIngestTracesInner struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorIngestTracesInner) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceIngestTracesInnerArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("IngestTracesInner", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceIngestTracesInnerResult{}
+	var retval *IngestTracesResponse
+	if retval, err2 = p.handler.IngestTracesInner(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing IngestTracesInner: "+err2.Error())
+		oprot.WriteMessageBegin("IngestTracesInner", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("IngestTracesInner", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorGetTracesMetaInfo struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorGetTracesMetaInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceGetTracesMetaInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTracesMetaInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceGetTracesMetaInfoResult{}
+	var retval *GetTracesMetaInfoResponse
+	if retval, err2 = p.handler.GetTracesMetaInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTracesMetaInfo: "+err2.Error())
+		oprot.WriteMessageBegin("GetTracesMetaInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTracesMetaInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorCreateView struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorCreateView) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceCreateViewArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateView", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceCreateViewResult{}
+	var retval *CreateViewResponse
+	if retval, err2 = p.handler.CreateView(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateView: "+err2.Error())
+		oprot.WriteMessageBegin("CreateView", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateView", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorUpdateView struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorUpdateView) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceUpdateViewArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateView", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceUpdateViewResult{}
+	var retval *UpdateViewResponse
+	if retval, err2 = p.handler.UpdateView(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateView: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateView", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateView", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorDeleteView struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorDeleteView) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceDeleteViewArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteView", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceDeleteViewResult{}
+	var retval *DeleteViewResponse
+	if retval, err2 = p.handler.DeleteView(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteView: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteView", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteView", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorListViews struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorListViews) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceListViewsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListViews", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceListViewsResult{}
+	var retval *ListViewsResponse
+	if retval, err2 = p.handler.ListViews(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListViews: "+err2.Error())
+		oprot.WriteMessageBegin("ListViews", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListViews", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorCreateManualAnnotation struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorCreateManualAnnotation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceCreateManualAnnotationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateManualAnnotation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceCreateManualAnnotationResult{}
+	var retval *CreateManualAnnotationResponse
+	if retval, err2 = p.handler.CreateManualAnnotation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateManualAnnotation: "+err2.Error())
+		oprot.WriteMessageBegin("CreateManualAnnotation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateManualAnnotation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorUpdateManualAnnotation struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorUpdateManualAnnotation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceUpdateManualAnnotationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateManualAnnotation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceUpdateManualAnnotationResult{}
+	var retval *UpdateManualAnnotationResponse
+	if retval, err2 = p.handler.UpdateManualAnnotation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateManualAnnotation: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateManualAnnotation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateManualAnnotation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorDeleteManualAnnotation struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorDeleteManualAnnotation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceDeleteManualAnnotationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteManualAnnotation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceDeleteManualAnnotationResult{}
+	var retval *DeleteManualAnnotationResponse
+	if retval, err2 = p.handler.DeleteManualAnnotation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteManualAnnotation: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteManualAnnotation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteManualAnnotation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorListAnnotations struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorListAnnotations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceListAnnotationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListAnnotations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceListAnnotationsResult{}
+	var retval *ListAnnotationsResponse
+	if retval, err2 = p.handler.ListAnnotations(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListAnnotations: "+err2.Error())
+		oprot.WriteMessageBegin("ListAnnotations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListAnnotations", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorExportTracesToDataset struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorExportTracesToDataset) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceExportTracesToDatasetArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExportTracesToDataset", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceExportTracesToDatasetResult{}
+	var retval *ExportTracesToDatasetResponse
+	if retval, err2 = p.handler.ExportTracesToDataset(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportTracesToDataset: "+err2.Error())
+		oprot.WriteMessageBegin("ExportTracesToDataset", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExportTracesToDataset", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorPreviewExportTracesToDataset struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorPreviewExportTracesToDataset) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServicePreviewExportTracesToDatasetArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PreviewExportTracesToDataset", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServicePreviewExportTracesToDatasetResult{}
+	var retval *PreviewExportTracesToDatasetResponse
+	if retval, err2 = p.handler.PreviewExportTracesToDataset(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PreviewExportTracesToDataset: "+err2.Error())
+		oprot.WriteMessageBegin("PreviewExportTracesToDataset", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PreviewExportTracesToDataset", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorChangeEvaluatorScore struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorChangeEvaluatorScore) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceChangeEvaluatorScoreArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ChangeEvaluatorScore", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceChangeEvaluatorScoreResult{}
+	var retval *ChangeEvaluatorScoreResponse
+	if retval, err2 = p.handler.ChangeEvaluatorScore(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ChangeEvaluatorScore: "+err2.Error())
+		oprot.WriteMessageBegin("ChangeEvaluatorScore", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ChangeEvaluatorScore", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorListAnnotationEvaluators struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorListAnnotationEvaluators) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceListAnnotationEvaluatorsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListAnnotationEvaluators", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceListAnnotationEvaluatorsResult{}
+	var retval *ListAnnotationEvaluatorsResponse
+	if retval, err2 = p.handler.ListAnnotationEvaluators(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListAnnotationEvaluators: "+err2.Error())
+		oprot.WriteMessageBegin("ListAnnotationEvaluators", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListAnnotationEvaluators", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorExtractSpanInfo struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorExtractSpanInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceExtractSpanInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExtractSpanInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceExtractSpanInfoResult{}
+	var retval *ExtractSpanInfoResponse
+	if retval, err2 = p.handler.ExtractSpanInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExtractSpanInfo: "+err2.Error())
+		oprot.WriteMessageBegin("ExtractSpanInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExtractSpanInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorUpsertTrajectoryConfig struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorUpsertTrajectoryConfig) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceUpsertTrajectoryConfigArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpsertTrajectoryConfig", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceUpsertTrajectoryConfigResult{}
+	var retval *UpsertTrajectoryConfigResponse
+	if retval, err2 = p.handler.UpsertTrajectoryConfig(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpsertTrajectoryConfig: "+err2.Error())
+		oprot.WriteMessageBegin("UpsertTrajectoryConfig", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpsertTrajectoryConfig", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorGetTrajectoryConfig struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorGetTrajectoryConfig) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceGetTrajectoryConfigArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTrajectoryConfig", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceGetTrajectoryConfigResult{}
+	var retval *GetTrajectoryConfigResponse
+	if retval, err2 = p.handler.GetTrajectoryConfig(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTrajectoryConfig: "+err2.Error())
+		oprot.WriteMessageBegin("GetTrajectoryConfig", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTrajectoryConfig", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorListTrajectory struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorListTrajectory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceListTrajectoryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListTrajectory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceListTrajectoryResult{}
+	var retval *ListTrajectoryResponse
+	if retval, err2 = p.handler.ListTrajectory(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTrajectory: "+err2.Error())
+		oprot.WriteMessageBegin("ListTrajectory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListTrajectory", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorUpsertRetentionPolicy struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorUpsertRetentionPolicy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceUpsertRetentionPolicyArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpsertRetentionPolicy", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceUpsertRetentionPolicyResult{}
+	var retval *UpsertRetentionPolicyResponse
+	if retval, err2 = p.handler.UpsertRetentionPolicy(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpsertRetentionPolicy: "+err2.Error())
+		oprot.WriteMessageBegin("UpsertRetentionPolicy", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpsertRetentionPolicy", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorGetRetentionPolicy struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorGetRetentionPolicy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceGetRetentionPolicyArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetRetentionPolicy", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceGetRetentionPolicyResult{}
+	var retval *GetRetentionPolicyResponse
+	if retval, err2 = p.handler.GetRetentionPolicy(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRetentionPolicy: "+err2.Error())
+		oprot.WriteMessageBegin("GetRetentionPolicy", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetRetentionPolicy", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorDiffTraces struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorDiffTraces) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceDiffTracesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DiffTraces", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceDiffTracesResult{}
+	var retval *DiffTracesResponse
+	if retval, err2 = p.handler.DiffTraces(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DiffTraces: "+err2.Error())
+		oprot.WriteMessageBegin("DiffTraces", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DiffTraces", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorListSessions struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorListSessions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceListSessionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListSessions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceListSessionsResult{}
+	var retval *ListSessionsResponse
+	if retval, err2 = p.handler.ListSessions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSessions: "+err2.Error())
+		oprot.WriteMessageBegin("ListSessions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListSessions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorGetSession struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorGetSession) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceGetSessionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceGetSessionResult{}
+	var retval *GetSessionResponse
+	if retval, err2 = p.handler.GetSession(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSession: "+err2.Error())
+		oprot.WriteMessageBegin("GetSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetSession", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorExportTracesToFile struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorExportTracesToFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceExportTracesToFileArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExportTracesToFile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceExportTracesToFileResult{}
+	var retval *ExportTracesToFileResponse
+	if retval, err2 = p.handler.ExportTracesToFile(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportTracesToFile: "+err2.Error())
+		oprot.WriteMessageBegin("ExportTracesToFile", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExportTracesToFile", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type traceServiceProcessorGetTraceExportJob struct {
+	handler TraceService
+}
+
+func (p *traceServiceProcessorGetTraceExportJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TraceServiceGetTraceExportJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTraceExportJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TraceServiceGetTraceExportJobResult{}
+	var retval *GetTraceExportJobResponse
+	if retval, err2 = p.handler.GetTraceExportJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTraceExportJob: "+err2.Error())
+		oprot.WriteMessageBegin("GetTraceExportJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTraceExportJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type TraceServiceListSpansArgs struct {
+	Req *ListSpansRequest `thrift:"req,1" frugal:"1,default,ListSpansRequest"`
+}
+
+func NewTraceServiceListSpansArgs() *TraceServiceListSpansArgs {
+	return &TraceServiceListSpansArgs{}
+}
+
+func (p *TraceServiceListSpansArgs) InitDefault() {
+}
+
+var TraceServiceListSpansArgs_Req_DEFAULT *ListSpansRequest
+
+func (p *TraceServiceListSpansArgs) GetReq() (v *ListSpansRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return TraceServiceListSpansArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TraceServiceListSpansArgs) SetReq(val *ListSpansRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TraceServiceListSpansArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TraceServiceListSpansArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TraceServiceListSpansArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceListSpansArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListSpansRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *TraceServiceListSpansArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSpans_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceListSpansArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TraceServiceListSpansArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceListSpansArgs(%+v)", *p)
+
+}
+
+func (p *TraceServiceListSpansArgs) DeepEqual(ano *TraceServiceListSpansArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceListSpansArgs) Field1DeepEqual(src *ListSpansRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceListSpansResult struct {
+	Success *ListSpansResponse `thrift:"success,0,optional" frugal:"0,optional,ListSpansResponse"`
+}
+
+func NewTraceServiceListSpansResult() *TraceServiceListSpansResult {
+	return &TraceServiceListSpansResult{}
+}
+
+func (p *TraceServiceListSpansResult) InitDefault() {
+}
+
+var TraceServiceListSpansResult_Success_DEFAULT *ListSpansResponse
+
+func (p *TraceServiceListSpansResult) GetSuccess() (v *ListSpansResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return TraceServiceListSpansResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TraceServiceListSpansResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListSpansResponse)
+}
+
+var fieldIDToName_TraceServiceListSpansResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TraceServiceListSpansResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TraceServiceListSpansResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceListSpansResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListSpansResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *TraceServiceListSpansResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSpans_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceListSpansResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TraceServiceListSpansResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceListSpansResult(%+v)", *p)
+
+}
+
+func (p *TraceServiceListSpansResult) DeepEqual(ano *TraceServiceListSpansResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceListSpansResult) Field0DeepEqual(src *ListSpansResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceListPreSpanArgs struct {
+	Req *ListPreSpanRequest `thrift:"req,1" frugal:"1,default,ListPreSpanRequest"`
+}
+
+func NewTraceServiceListPreSpanArgs() *TraceServiceListPreSpanArgs {
+	return &TraceServiceListPreSpanArgs{}
+}
+
+func (p *TraceServiceListPreSpanArgs) InitDefault() {
+}
+
+var TraceServiceListPreSpanArgs_Req_DEFAULT *ListPreSpanRequest
+
+func (p *TraceServiceListPreSpanArgs) GetReq() (v *ListPreSpanRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return TraceServiceListPreSpanArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TraceServiceListPreSpanArgs) SetReq(val *ListPreSpanRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TraceServiceListPreSpanArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TraceServiceListPreSpanArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TraceServiceListPreSpanArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListPreSpanArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceListPreSpanArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListPreSpanRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *TraceServiceListPreSpanArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListPreSpan_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceListPreSpanArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TraceServiceListPreSpanArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceListPreSpanArgs(%+v)", *p)
+
+}
+
+func (p *TraceServiceListPreSpanArgs) DeepEqual(ano *TraceServiceListPreSpanArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceListPreSpanArgs) Field1DeepEqual(src *ListPreSpanRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceListPreSpanResult struct {
+	Success *ListPreSpanResponse `thrift:"success,0,optional" frugal:"0,optional,ListPreSpanResponse"`
+}
+
+func NewTraceServiceListPreSpanResult() *TraceServiceListPreSpanResult {
+	return &TraceServiceListPreSpanResult{}
+}
+
+func (p *TraceServiceListPreSpanResult) InitDefault() {
+}
+
+var TraceServiceListPreSpanResult_Success_DEFAULT *ListPreSpanResponse
+
+func (p *TraceServiceListPreSpanResult) GetSuccess() (v *ListPreSpanResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return TraceServiceListPreSpanResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TraceServiceListPreSpanResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListPreSpanResponse)
+}
+
+var fieldIDToName_TraceServiceListPreSpanResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TraceServiceListPreSpanResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TraceServiceListPreSpanResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListPreSpanResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceListPreSpanResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListPreSpanResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *TraceServiceListPreSpanResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListPreSpan_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceListPreSpanResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TraceServiceListPreSpanResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceListPreSpanResult(%+v)", *p)
+
+}
+
+func (p *TraceServiceListPreSpanResult) DeepEqual(ano *TraceServiceListPreSpanResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceListPreSpanResult) Field0DeepEqual(src *ListPreSpanResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceGetTraceArgs struct {
+	Req *GetTraceRequest `thrift:"req,1" frugal:"1,default,GetTraceRequest"`
+}
+
+func NewTraceServiceGetTraceArgs() *TraceServiceGetTraceArgs {
+	return &TraceServiceGetTraceArgs{}
+}
+
+func (p *TraceServiceGetTraceArgs) InitDefault() {
+}
+
+var TraceServiceGetTraceArgs_Req_DEFAULT *GetTraceRequest
+
+func (p *TraceServiceGetTraceArgs) GetReq() (v *GetTraceRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return TraceServiceGetTraceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TraceServiceGetTraceArgs) SetReq(val *GetTraceRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TraceServiceGetTraceArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TraceServiceGetTraceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TraceServiceGetTraceArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceGetTraceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetTraceRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *TraceServiceGetTraceArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTrace_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceGetTraceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TraceServiceGetTraceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceGetTraceArgs(%+v)", *p)
+
+}
+
+func (p *TraceServiceGetTraceArgs) DeepEqual(ano *TraceServiceGetTraceArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceGetTraceArgs) Field1DeepEqual(src *GetTraceRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceGetTraceResult struct {
+	Success *GetTraceResponse `thrift:"success,0,optional" frugal:"0,optional,GetTraceResponse"`
+}
+
+func NewTraceServiceGetTraceResult() *TraceServiceGetTraceResult {
+	return &TraceServiceGetTraceResult{}
+}
+
+func (p *TraceServiceGetTraceResult) InitDefault() {
+}
+
+var TraceServiceGetTraceResult_Success_DEFAULT *GetTraceResponse
+
+func (p *TraceServiceGetTraceResult) GetSuccess() (v *GetTraceResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return TraceServiceGetTraceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TraceServiceGetTraceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetTraceResponse)
+}
+
+var fieldIDToName_TraceServiceGetTraceResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TraceServiceGetTraceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TraceServiceGetTraceResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceGetTraceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetTraceResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *TraceServiceGetTraceResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTrace_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceGetTraceResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TraceServiceGetTraceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceGetTraceResult(%+v)", *p)
+
+}
+
+func (p *TraceServiceGetTraceResult) DeepEqual(ano *TraceServiceGetTraceResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceGetTraceResult) Field0DeepEqual(src *GetTraceResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceSearchTraceTreeArgs struct {
+	Req *SearchTraceTreeRequest `thrift:"req,1" frugal:"1,default,SearchTraceTreeRequest"`
+}
+
+func NewTraceServiceSearchTraceTreeArgs() *TraceServiceSearchTraceTreeArgs {
+	return &TraceServiceSearchTraceTreeArgs{}
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) InitDefault() {
+}
+
+var TraceServiceSearchTraceTreeArgs_Req_DEFAULT *SearchTraceTreeRequest
+
+func (p *TraceServiceSearchTraceTreeArgs) GetReq() (v *SearchTraceTreeRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return TraceServiceSearchTraceTreeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TraceServiceSearchTraceTreeArgs) SetReq(val *SearchTraceTreeRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TraceServiceSearchTraceTreeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceSearchTraceTreeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSearchTraceTreeRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SearchTraceTree_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceSearchTraceTreeArgs(%+v)", *p)
+
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) DeepEqual(ano *TraceServiceSearchTraceTreeArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) Field1DeepEqual(src *SearchTraceTreeRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceSearchTraceTreeResult struct {
+	Success *SearchTraceTreeResponse `thrift:"success,0,optional" frugal:"0,optional,SearchTraceTreeResponse"`
+}
+
+func NewTraceServiceSearchTraceTreeResult() *TraceServiceSearchTraceTreeResult {
+	return &TraceServiceSearchTraceTreeResult{}
+}
+
+func (p *TraceServiceSearchTraceTreeResult) InitDefault() {
+}
+
+var TraceServiceSearchTraceTreeResult_Success_DEFAULT *SearchTraceTreeResponse
+
+func (p *TraceServiceSearchTraceTreeResult) GetSuccess() (v *SearchTraceTreeResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return TraceServiceSearchTraceTreeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TraceServiceSearchTraceTreeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchTraceTreeResponse)
+}
+
+var fieldIDToName_TraceServiceSearchTraceTreeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TraceServiceSearchTraceTreeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TraceServiceSearchTraceTreeResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceSearchTraceTreeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceSearchTraceTreeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSearchTraceTreeResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *TraceServiceSearchTraceTreeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SearchTraceTree_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceSearchTraceTreeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TraceServiceSearchTraceTreeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceSearchTraceTreeResult(%+v)", *p)
+
+}
+
+func (p *TraceServiceSearchTraceTreeResult) DeepEqual(ano *TraceServiceSearchTraceTreeResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceSearchTraceTreeResult) Field0DeepEqual(src *SearchTraceTreeResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceBatchGetTracesAdvanceInfoArgs struct {
+	Req *BatchGetTracesAdvanceInfoRequest `thrift:"req,1" frugal:"1,default,BatchGetTracesAdvanceInfoRequest"`
+}
+
+func NewTraceServiceBatchGetTracesAdvanceInfoArgs() *TraceServiceBatchGetTracesAdvanceInfoArgs {
+	return &TraceServiceBatchGetTracesAdvanceInfoArgs{}
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) InitDefault() {
+}
+
+var TraceServiceBatchGetTracesAdvanceInfoArgs_Req_DEFAULT *BatchGetTracesAdvanceInfoRequest
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) GetReq() (v *BatchGetTracesAdvanceInfoRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return TraceServiceBatchGetTracesAdvanceInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) SetReq(val *BatchGetTracesAdvanceInfoRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchGetTracesAdvanceInfoRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetTracesAdvanceInfo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceBatchGetTracesAdvanceInfoArgs(%+v)", *p)
+
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) DeepEqual(ano *TraceServiceBatchGetTracesAdvanceInfoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) Field1DeepEqual(src *BatchGetTracesAdvanceInfoRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceBatchGetTracesAdvanceInfoResult struct {
+	Success *BatchGetTracesAdvanceInfoResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetTracesAdvanceInfoResponse"`
+}
+
+func NewTraceServiceBatchGetTracesAdvanceInfoResult() *TraceServiceBatchGetTracesAdvanceInfoResult {
+	return &TraceServiceBatchGetTracesAdvanceInfoResult{}
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) InitDefault() {
+}
+
+var TraceServiceBatchGetTracesAdvanceInfoResult_Success_DEFAULT *BatchGetTracesAdvanceInfoResponse
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) GetSuccess() (v *BatchGetTracesAdvanceInfoResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return TraceServiceBatchGetTracesAdvanceInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetTracesAdvanceInfoResponse)
+}
+
+var fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchGetTracesAdvanceInfoResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetTracesAdvanceInfo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceBatchGetTracesAdvanceInfoResult(%+v)", *p)
+
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) DeepEqual(ano *TraceServiceBatchGetTracesAdvanceInfoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) Field0DeepEqual(src *BatchGetTracesAdvanceInfoResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceIngestTracesInnerArgs struct {
+	Req *IngestTracesRequest `thrift:"req,1" frugal:"1,default,IngestTracesRequest"`
+}
+
+func NewTraceServiceIngestTracesInnerArgs() *TraceServiceIngestTracesInnerArgs {
+	return &TraceServiceIngestTracesInnerArgs{}
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) InitDefault() {
+}
+
+var TraceServiceIngestTracesInnerArgs_Req_DEFAULT *IngestTracesRequest
+
+func (p *TraceServiceIngestTracesInnerArgs) GetReq() (v *IngestTracesRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return TraceServiceIngestTracesInnerArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TraceServiceIngestTracesInnerArgs) SetReq(val *IngestTracesRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TraceServiceIngestTracesInnerArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewIngestTracesRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("IngestTracesInner_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceIngestTracesInnerArgs(%+v)", *p)
+
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) DeepEqual(ano *TraceServiceIngestTracesInnerArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) Field1DeepEqual(src *IngestTracesRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceIngestTracesInnerResult struct {
+	Success *IngestTracesResponse `thrift:"success,0,optional" frugal:"0,optional,IngestTracesResponse"`
+}
+
+func NewTraceServiceIngestTracesInnerResult() *TraceServiceIngestTracesInnerResult {
+	return &TraceServiceIngestTracesInnerResult{}
+}
+
+func (p *TraceServiceIngestTracesInnerResult) InitDefault() {
+}
+
+var TraceServiceIngestTracesInnerResult_Success_DEFAULT *IngestTracesResponse
+
+func (p *TraceServiceIngestTracesInnerResult) GetSuccess() (v *IngestTracesResponse) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetSuccess() {
+		return TraceServiceIngestTracesInnerResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TraceServiceIngestTracesInnerResult) SetSuccess(x interface{}) {
+	p.Success = x.(*IngestTracesResponse)
+}
+
+var fieldIDToName_TraceServiceIngestTracesInnerResult = map[int16]string{
+	0: "success",
+}
+
+func (p *TraceServiceIngestTracesInnerResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *TraceServiceIngestTracesInnerResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceIngestTracesInnerResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewIngestTracesResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *TraceServiceIngestTracesInnerResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("IngestTracesInner_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceIngestTracesInnerResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *TraceServiceIngestTracesInnerResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TraceServiceIngestTracesInnerResult(%+v)", *p)
+
+}
+
+func (p *TraceServiceIngestTracesInnerResult) DeepEqual(ano *TraceServiceIngestTracesInnerResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *TraceServiceIngestTracesInnerResult) Field0DeepEqual(src *IngestTracesResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type TraceServiceGetTracesMetaInfoArgs struct {
+	Req *GetTracesMetaInfoRequest `thrift:"req,1" frugal:"1,default,GetTracesMetaInfoRequest"`
+}
+
+func NewTraceServiceGetTracesMetaInfoArgs() *TraceServiceGetTracesMetaInfoArgs {
+	return &TraceServiceGetTracesMetaInfoArgs{}
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) InitDefault() {
+}
+
+var TraceServiceGetTracesMetaInfoArgs_Req_DEFAULT *GetTracesMetaInfoRequest
+
+func (p *TraceServiceGetTracesMetaInfoArgs) GetReq() (v *GetTracesMetaInfoRequest) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetReq() {
+		return TraceServiceGetTracesMetaInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *TraceServiceGetTracesMetaInfoArgs) SetReq(val *GetTracesMetaInfoRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_TraceServiceGetTracesMetaInfoArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetTracesMetaInfoRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTracesMetaInfo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("ListAnnotationEvaluators", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return fmt.Sprintf("TraceServiceGetTracesMetaInfoArgs(%+v)", *p)
+
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) DeepEqual(ano *TraceServiceGetTracesMetaInfoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) Field1DeepEqual(src *GetTracesMetaInfoRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type traceServiceProcessorExtractSpanInfo struct {
-	handler TraceService
+type TraceServiceGetTracesMetaInfoResult struct {
+	Success *GetTracesMetaInfoResponse `thrift:"success,0,optional" frugal:"0,optional,GetTracesMetaInfoResponse"`
 }
 
-func (p *traceServiceProcessorExtractSpanInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceExtractSpanInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ExtractSpanInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewTraceServiceGetTracesMetaInfoResult() *TraceServiceGetTracesMetaInfoResult {
+	return &TraceServiceGetTracesMetaInfoResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceExtractSpanInfoResult{}
-	var retval *ExtractSpanInfoResponse
-	if retval, err2 = p.handler.ExtractSpanInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExtractSpanInfo: "+err2.Error())
-		oprot.WriteMessageBegin("ExtractSpanInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ExtractSpanInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
+func (p *TraceServiceGetTracesMetaInfoResult) InitDefault() {
+}
+
+var TraceServiceGetTracesMetaInfoResult_Success_DEFAULT *GetTracesMetaInfoResponse
+
+func (p *TraceServiceGetTracesMetaInfoResult) GetSuccess() (v *GetTracesMetaInfoResponse) {
+	if p == nil {
 		return
 	}
-	return true, err
+	if !p.IsSetSuccess() {
+		return TraceServiceGetTracesMetaInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *TraceServiceGetTracesMetaInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetTracesMetaInfoResponse)
 }
 
-type traceServiceProcessorUpsertTrajectoryConfig struct {
-	handler TraceService
+var fieldIDToName_TraceServiceGetTracesMetaInfoResult = map[int16]string{
+	0: "success",
 }
 
-func (p *traceServiceProcessorUpsertTrajectoryConfig) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceUpsertTrajectoryConfigArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpsertTrajectoryConfig", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *TraceServiceGetTracesMetaInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceUpsertTrajectoryConfigResult{}
-	var retval *UpsertTrajectoryConfigResponse
-	if retval, err2 = p.handler.UpsertTrajectoryConfig(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpsertTrajectoryConfig: "+err2.Error())
-		oprot.WriteMessageBegin("UpsertTrajectoryConfig", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpsertTrajectoryConfig", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *TraceServiceGetTracesMetaInfoResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type traceServiceProcessorGetTrajectoryConfig struct {
-	handler TraceService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *traceServiceProcessorGetTrajectoryConfig) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceGetTrajectoryConfigArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTrajectoryConfig", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TraceServiceGetTracesMetaInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetTracesMetaInfoResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceGetTrajectoryConfigResult{}
-	var retval *GetTrajectoryConfigResponse
-	if retval, err2 = p.handler.GetTrajectoryConfig(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTrajectoryConfig: "+err2.Error())
-		oprot.WriteMessageBegin("GetTrajectoryConfig", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTrajectoryConfig", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *TraceServiceGetTracesMetaInfoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTracesMetaInfo_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type traceServiceProcessorListTrajectory struct {
-	handler TraceService
+func (p *TraceServiceGetTracesMetaInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *traceServiceProcessorListTrajectory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := TraceServiceListTrajectoryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListTrajectory", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *TraceServiceGetTracesMetaInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("TraceServiceGetTracesMetaInfoResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := TraceServiceListTrajectoryResult{}
-	var retval *ListTrajectoryResponse
-	if retval, err2 = p.handler.ListTrajectory(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTrajectory: "+err2.Error())
-		oprot.WriteMessageBegin("ListTrajectory", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListTrajectory", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) DeepEqual(ano *TraceServiceGetTracesMetaInfoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
 	}
-	if err != nil {
-		return
+	return true
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) Field0DeepEqual(src *GetTracesMetaInfoResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
 	}
-	return true, err
+	return true
 }
 
-type TraceServiceListSpansArgs struct {
-	Req *ListSpansRequest `thrift:"req,1" frugal:"1,default,ListSpansRequest"`
+type TraceServiceCreateViewArgs struct {
+	Req *CreateViewRequest `thrift:"req,1" frugal:"1,default,CreateViewRequest"`
 }
 
-func NewTraceServiceListSpansArgs() *TraceServiceListSpansArgs {
-	return &TraceServiceListSpansArgs{}
+func NewTraceServiceCreateViewArgs() *TraceServiceCreateViewArgs {
+	return &TraceServiceCreateViewArgs{}
 }
 
-func (p *TraceServiceListSpansArgs) InitDefault() {
+func (p *TraceServiceCreateViewArgs) InitDefault() {
 }
 
-var TraceServiceListSpansArgs_Req_DEFAULT *ListSpansRequest
+var TraceServiceCreateViewArgs_Req_DEFAULT *CreateViewRequest
 
-func (p *TraceServiceListSpansArgs) GetReq() (v *ListSpansRequest) {
+func (p *TraceServiceCreateViewArgs) GetReq() (v *CreateViewRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceListSpansArgs_Req_DEFAULT
+		return TraceServiceCreateViewArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceListSpansArgs) SetReq(val *ListSpansRequest) {
+func (p *TraceServiceCreateViewArgs) SetReq(val *CreateViewRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceListSpansArgs = map[int16]string{
+var fieldIDToName_TraceServiceCreateViewArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceListSpansArgs) IsSetReq() bool {
+func (p *TraceServiceCreateViewArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceListSpansArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateViewArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -22422,7 +30451,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -22432,8 +30461,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListSpansArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListSpansRequest()
+func (p *TraceServiceCreateViewArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateViewRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -22441,9 +30470,9 @@ func (p *TraceServiceListSpansArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceListSpansArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateViewArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListSpans_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateView_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -22469,7 +30498,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListSpansArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateViewArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -22486,15 +30515,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceListSpansArgs) String() string {
+func (p *TraceServiceCreateViewArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListSpansArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceCreateViewArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceListSpansArgs) DeepEqual(ano *TraceServiceListSpansArgs) bool {
+func (p *TraceServiceCreateViewArgs) DeepEqual(ano *TraceServiceCreateViewArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -22506,7 +30535,7 @@ func (p *TraceServiceListSpansArgs) DeepEqual(ano *TraceServiceListSpansArgs) bo
 	return true
 }
 
-func (p *TraceServiceListSpansArgs) Field1DeepEqual(src *ListSpansRequest) bool {
+func (p *TraceServiceCreateViewArgs) Field1DeepEqual(src *CreateViewRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -22514,41 +30543,41 @@ func (p *TraceServiceListSpansArgs) Field1DeepEqual(src *ListSpansRequest) bool
 	return true
 }
 
-type TraceServiceListSpansResult struct {
-	Success *ListSpansResponse `thrift:"success,0,optional" frugal:"0,optional,ListSpansResponse"`
+type TraceServiceCreateViewResult struct {
+	Success *CreateViewResponse `thrift:"success,0,optional" frugal:"0,optional,CreateViewResponse"`
 }
 
-func NewTraceServiceListSpansResult() *TraceServiceListSpansResult {
-	return &TraceServiceListSpansResult{}
+func NewTraceServiceCreateViewResult() *TraceServiceCreateViewResult {
+	return &TraceServiceCreateViewResult{}
 }
 
-func (p *TraceServiceListSpansResult) InitDefault() {
+func (p *TraceServiceCreateViewResult) InitDefault() {
 }
 
-var TraceServiceListSpansResult_Success_DEFAULT *ListSpansResponse
+var TraceServiceCreateViewResult_Success_DEFAULT *CreateViewResponse
 
-func (p *TraceServiceListSpansResult) GetSuccess() (v *ListSpansResponse) {
+func (p *TraceServiceCreateViewResult) GetSuccess() (v *CreateViewResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceListSpansResult_Success_DEFAULT
+		return TraceServiceCreateViewResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceListSpansResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListSpansResponse)
+func (p *TraceServiceCreateViewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateViewResponse)
 }
 
-var fieldIDToName_TraceServiceListSpansResult = map[int16]string{
+var fieldIDToName_TraceServiceCreateViewResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceListSpansResult) IsSetSuccess() bool {
+func (p *TraceServiceCreateViewResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceListSpansResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateViewResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -22593,7 +30622,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -22603,8 +30632,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListSpansResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListSpansResponse()
+func (p *TraceServiceCreateViewResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateViewResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -22612,9 +30641,9 @@ func (p *TraceServiceListSpansResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceListSpansResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateViewResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListSpans_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateView_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -22640,7 +30669,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListSpansResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateViewResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -22659,15 +30688,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceListSpansResult) String() string {
+func (p *TraceServiceCreateViewResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListSpansResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceCreateViewResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceListSpansResult) DeepEqual(ano *TraceServiceListSpansResult) bool {
+func (p *TraceServiceCreateViewResult) DeepEqual(ano *TraceServiceCreateViewResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -22679,7 +30708,7 @@ func (p *TraceServiceListSpansResult) DeepEqual(ano *TraceServiceListSpansResult
 	return true
 }
 
-func (p *TraceServiceListSpansResult) Field0DeepEqual(src *ListSpansResponse) bool {
+func (p *TraceServiceCreateViewResult) Field0DeepEqual(src *CreateViewResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -22687,41 +30716,41 @@ func (p *TraceServiceListSpansResult) Field0DeepEqual(src *ListSpansResponse) bo
 	return true
 }
 
-type TraceServiceListPreSpanArgs struct {
-	Req *ListPreSpanRequest `thrift:"req,1" frugal:"1,default,ListPreSpanRequest"`
+type TraceServiceUpdateViewArgs struct {
+	Req *UpdateViewRequest `thrift:"req,1" frugal:"1,default,UpdateViewRequest"`
 }
 
-func NewTraceServiceListPreSpanArgs() *TraceServiceListPreSpanArgs {
-	return &TraceServiceListPreSpanArgs{}
+func NewTraceServiceUpdateViewArgs() *TraceServiceUpdateViewArgs {
+	return &TraceServiceUpdateViewArgs{}
 }
 
-func (p *TraceServiceListPreSpanArgs) InitDefault() {
+func (p *TraceServiceUpdateViewArgs) InitDefault() {
 }
 
-var TraceServiceListPreSpanArgs_Req_DEFAULT *ListPreSpanRequest
+var TraceServiceUpdateViewArgs_Req_DEFAULT *UpdateViewRequest
 
-func (p *TraceServiceListPreSpanArgs) GetReq() (v *ListPreSpanRequest) {
+func (p *TraceServiceUpdateViewArgs) GetReq() (v *UpdateViewRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceListPreSpanArgs_Req_DEFAULT
+		return TraceServiceUpdateViewArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceListPreSpanArgs) SetReq(val *ListPreSpanRequest) {
+func (p *TraceServiceUpdateViewArgs) SetReq(val *UpdateViewRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceListPreSpanArgs = map[int16]string{
+var fieldIDToName_TraceServiceUpdateViewArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceListPreSpanArgs) IsSetReq() bool {
+func (p *TraceServiceUpdateViewArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceListPreSpanArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateViewArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -22766,7 +30795,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListPreSpanArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -22776,8 +30805,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListPreSpanArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListPreSpanRequest()
+func (p *TraceServiceUpdateViewArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateViewRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -22785,9 +30814,9 @@ func (p *TraceServiceListPreSpanArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceListPreSpanArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateViewArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListPreSpan_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateView_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -22813,7 +30842,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListPreSpanArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateViewArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -22830,15 +30859,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceListPreSpanArgs) String() string {
+func (p *TraceServiceUpdateViewArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListPreSpanArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceUpdateViewArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceListPreSpanArgs) DeepEqual(ano *TraceServiceListPreSpanArgs) bool {
+func (p *TraceServiceUpdateViewArgs) DeepEqual(ano *TraceServiceUpdateViewArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -22850,7 +30879,7 @@ func (p *TraceServiceListPreSpanArgs) DeepEqual(ano *TraceServiceListPreSpanArgs
 	return true
 }
 
-func (p *TraceServiceListPreSpanArgs) Field1DeepEqual(src *ListPreSpanRequest) bool {
+func (p *TraceServiceUpdateViewArgs) Field1DeepEqual(src *UpdateViewRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -22858,41 +30887,41 @@ func (p *TraceServiceListPreSpanArgs) Field1DeepEqual(src *ListPreSpanRequest) b
 	return true
 }
 
-type TraceServiceListPreSpanResult struct {
-	Success *ListPreSpanResponse `thrift:"success,0,optional" frugal:"0,optional,ListPreSpanResponse"`
+type TraceServiceUpdateViewResult struct {
+	Success *UpdateViewResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateViewResponse"`
 }
 
-func NewTraceServiceListPreSpanResult() *TraceServiceListPreSpanResult {
-	return &TraceServiceListPreSpanResult{}
+func NewTraceServiceUpdateViewResult() *TraceServiceUpdateViewResult {
+	return &TraceServiceUpdateViewResult{}
 }
 
-func (p *TraceServiceListPreSpanResult) InitDefault() {
+func (p *TraceServiceUpdateViewResult) InitDefault() {
 }
 
-var TraceServiceListPreSpanResult_Success_DEFAULT *ListPreSpanResponse
+var TraceServiceUpdateViewResult_Success_DEFAULT *UpdateViewResponse
 
-func (p *TraceServiceListPreSpanResult) GetSuccess() (v *ListPreSpanResponse) {
+func (p *TraceServiceUpdateViewResult) GetSuccess() (v *UpdateViewResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceListPreSpanResult_Success_DEFAULT
+		return TraceServiceUpdateViewResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceListPreSpanResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListPreSpanResponse)
+func (p *TraceServiceUpdateViewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateViewResponse)
 }
 
-var fieldIDToName_TraceServiceListPreSpanResult = map[int16]string{
+var fieldIDToName_TraceServiceUpdateViewResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceListPreSpanResult) IsSetSuccess() bool {
+func (p *TraceServiceUpdateViewResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceListPreSpanResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateViewResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -22937,7 +30966,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListPreSpanResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -22947,8 +30976,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListPreSpanResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListPreSpanResponse()
+func (p *TraceServiceUpdateViewResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateViewResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -22956,9 +30985,9 @@ func (p *TraceServiceListPreSpanResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TraceServiceListPreSpanResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateViewResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListPreSpan_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateView_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -22984,7 +31013,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListPreSpanResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateViewResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -23003,15 +31032,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceListPreSpanResult) String() string {
+func (p *TraceServiceUpdateViewResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListPreSpanResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceUpdateViewResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceListPreSpanResult) DeepEqual(ano *TraceServiceListPreSpanResult) bool {
+func (p *TraceServiceUpdateViewResult) DeepEqual(ano *TraceServiceUpdateViewResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -23023,7 +31052,7 @@ func (p *TraceServiceListPreSpanResult) DeepEqual(ano *TraceServiceListPreSpanRe
 	return true
 }
 
-func (p *TraceServiceListPreSpanResult) Field0DeepEqual(src *ListPreSpanResponse) bool {
+func (p *TraceServiceUpdateViewResult) Field0DeepEqual(src *UpdateViewResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -23031,41 +31060,41 @@ func (p *TraceServiceListPreSpanResult) Field0DeepEqual(src *ListPreSpanResponse
 	return true
 }
 
-type TraceServiceGetTraceArgs struct {
-	Req *GetTraceRequest `thrift:"req,1" frugal:"1,default,GetTraceRequest"`
+type TraceServiceDeleteViewArgs struct {
+	Req *DeleteViewRequest `thrift:"req,1" frugal:"1,default,DeleteViewRequest"`
 }
 
-func NewTraceServiceGetTraceArgs() *TraceServiceGetTraceArgs {
-	return &TraceServiceGetTraceArgs{}
+func NewTraceServiceDeleteViewArgs() *TraceServiceDeleteViewArgs {
+	return &TraceServiceDeleteViewArgs{}
 }
 
-func (p *TraceServiceGetTraceArgs) InitDefault() {
+func (p *TraceServiceDeleteViewArgs) InitDefault() {
 }
 
-var TraceServiceGetTraceArgs_Req_DEFAULT *GetTraceRequest
+var TraceServiceDeleteViewArgs_Req_DEFAULT *DeleteViewRequest
 
-func (p *TraceServiceGetTraceArgs) GetReq() (v *GetTraceRequest) {
+func (p *TraceServiceDeleteViewArgs) GetReq() (v *DeleteViewRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceGetTraceArgs_Req_DEFAULT
+		return TraceServiceDeleteViewArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceGetTraceArgs) SetReq(val *GetTraceRequest) {
+func (p *TraceServiceDeleteViewArgs) SetReq(val *DeleteViewRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceGetTraceArgs = map[int16]string{
+var fieldIDToName_TraceServiceDeleteViewArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceGetTraceArgs) IsSetReq() bool {
+func (p *TraceServiceDeleteViewArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceGetTraceArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteViewArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23110,7 +31139,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23120,8 +31149,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTraceArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetTraceRequest()
+func (p *TraceServiceDeleteViewArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteViewRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23129,9 +31158,9 @@ func (p *TraceServiceGetTraceArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceGetTraceArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteViewArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTrace_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteView_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -23157,7 +31186,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTraceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteViewArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -23174,15 +31203,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceGetTraceArgs) String() string {
+func (p *TraceServiceDeleteViewArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceGetTraceArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceDeleteViewArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceGetTraceArgs) DeepEqual(ano *TraceServiceGetTraceArgs) bool {
+func (p *TraceServiceDeleteViewArgs) DeepEqual(ano *TraceServiceDeleteViewArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -23194,7 +31223,7 @@ func (p *TraceServiceGetTraceArgs) DeepEqual(ano *TraceServiceGetTraceArgs) bool
 	return true
 }
 
-func (p *TraceServiceGetTraceArgs) Field1DeepEqual(src *GetTraceRequest) bool {
+func (p *TraceServiceDeleteViewArgs) Field1DeepEqual(src *DeleteViewRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -23202,41 +31231,41 @@ func (p *TraceServiceGetTraceArgs) Field1DeepEqual(src *GetTraceRequest) bool {
 	return true
 }
 
-type TraceServiceGetTraceResult struct {
-	Success *GetTraceResponse `thrift:"success,0,optional" frugal:"0,optional,GetTraceResponse"`
+type TraceServiceDeleteViewResult struct {
+	Success *DeleteViewResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteViewResponse"`
 }
 
-func NewTraceServiceGetTraceResult() *TraceServiceGetTraceResult {
-	return &TraceServiceGetTraceResult{}
+func NewTraceServiceDeleteViewResult() *TraceServiceDeleteViewResult {
+	return &TraceServiceDeleteViewResult{}
 }
 
-func (p *TraceServiceGetTraceResult) InitDefault() {
+func (p *TraceServiceDeleteViewResult) InitDefault() {
 }
 
-var TraceServiceGetTraceResult_Success_DEFAULT *GetTraceResponse
+var TraceServiceDeleteViewResult_Success_DEFAULT *DeleteViewResponse
 
-func (p *TraceServiceGetTraceResult) GetSuccess() (v *GetTraceResponse) {
+func (p *TraceServiceDeleteViewResult) GetSuccess() (v *DeleteViewResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceGetTraceResult_Success_DEFAULT
+		return TraceServiceDeleteViewResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceGetTraceResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetTraceResponse)
+func (p *TraceServiceDeleteViewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteViewResponse)
 }
 
-var fieldIDToName_TraceServiceGetTraceResult = map[int16]string{
+var fieldIDToName_TraceServiceDeleteViewResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceGetTraceResult) IsSetSuccess() bool {
+func (p *TraceServiceDeleteViewResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceGetTraceResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteViewResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23281,7 +31310,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23291,8 +31320,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTraceResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetTraceResponse()
+func (p *TraceServiceDeleteViewResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteViewResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23300,9 +31329,9 @@ func (p *TraceServiceGetTraceResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceGetTraceResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteViewResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTrace_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteView_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -23328,7 +31357,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTraceResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteViewResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -23347,15 +31376,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceGetTraceResult) String() string {
+func (p *TraceServiceDeleteViewResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceGetTraceResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceDeleteViewResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceGetTraceResult) DeepEqual(ano *TraceServiceGetTraceResult) bool {
+func (p *TraceServiceDeleteViewResult) DeepEqual(ano *TraceServiceDeleteViewResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -23367,7 +31396,7 @@ func (p *TraceServiceGetTraceResult) DeepEqual(ano *TraceServiceGetTraceResult)
 	return true
 }
 
-func (p *TraceServiceGetTraceResult) Field0DeepEqual(src *GetTraceResponse) bool {
+func (p *TraceServiceDeleteViewResult) Field0DeepEqual(src *DeleteViewResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -23375,41 +31404,41 @@ func (p *TraceServiceGetTraceResult) Field0DeepEqual(src *GetTraceResponse) bool
 	return true
 }
 
-type TraceServiceSearchTraceTreeArgs struct {
-	Req *SearchTraceTreeRequest `thrift:"req,1" frugal:"1,default,SearchTraceTreeRequest"`
+type TraceServiceListViewsArgs struct {
+	Req *ListViewsRequest `thrift:"req,1" frugal:"1,default,ListViewsRequest"`
 }
 
-func NewTraceServiceSearchTraceTreeArgs() *TraceServiceSearchTraceTreeArgs {
-	return &TraceServiceSearchTraceTreeArgs{}
+func NewTraceServiceListViewsArgs() *TraceServiceListViewsArgs {
+	return &TraceServiceListViewsArgs{}
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) InitDefault() {
+func (p *TraceServiceListViewsArgs) InitDefault() {
 }
 
-var TraceServiceSearchTraceTreeArgs_Req_DEFAULT *SearchTraceTreeRequest
+var TraceServiceListViewsArgs_Req_DEFAULT *ListViewsRequest
 
-func (p *TraceServiceSearchTraceTreeArgs) GetReq() (v *SearchTraceTreeRequest) {
+func (p *TraceServiceListViewsArgs) GetReq() (v *ListViewsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceSearchTraceTreeArgs_Req_DEFAULT
+		return TraceServiceListViewsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceSearchTraceTreeArgs) SetReq(val *SearchTraceTreeRequest) {
+func (p *TraceServiceListViewsArgs) SetReq(val *ListViewsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceSearchTraceTreeArgs = map[int16]string{
+var fieldIDToName_TraceServiceListViewsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) IsSetReq() bool {
+func (p *TraceServiceListViewsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListViewsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23454,7 +31483,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceSearchTraceTreeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23464,8 +31493,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSearchTraceTreeRequest()
+func (p *TraceServiceListViewsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListViewsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23473,9 +31502,9 @@ func (p *TraceServiceSearchTraceTreeArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListViewsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchTraceTree_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListViews_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -23501,7 +31530,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListViewsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -23518,15 +31547,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) String() string {
+func (p *TraceServiceListViewsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceSearchTraceTreeArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListViewsArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) DeepEqual(ano *TraceServiceSearchTraceTreeArgs) bool {
+func (p *TraceServiceListViewsArgs) DeepEqual(ano *TraceServiceListViewsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -23538,7 +31567,7 @@ func (p *TraceServiceSearchTraceTreeArgs) DeepEqual(ano *TraceServiceSearchTrace
 	return true
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) Field1DeepEqual(src *SearchTraceTreeRequest) bool {
+func (p *TraceServiceListViewsArgs) Field1DeepEqual(src *ListViewsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -23546,41 +31575,41 @@ func (p *TraceServiceSearchTraceTreeArgs) Field1DeepEqual(src *SearchTraceTreeRe
 	return true
 }
 
-type TraceServiceSearchTraceTreeResult struct {
-	Success *SearchTraceTreeResponse `thrift:"success,0,optional" frugal:"0,optional,SearchTraceTreeResponse"`
+type TraceServiceListViewsResult struct {
+	Success *ListViewsResponse `thrift:"success,0,optional" frugal:"0,optional,ListViewsResponse"`
 }
 
-func NewTraceServiceSearchTraceTreeResult() *TraceServiceSearchTraceTreeResult {
-	return &TraceServiceSearchTraceTreeResult{}
+func NewTraceServiceListViewsResult() *TraceServiceListViewsResult {
+	return &TraceServiceListViewsResult{}
 }
 
-func (p *TraceServiceSearchTraceTreeResult) InitDefault() {
+func (p *TraceServiceListViewsResult) InitDefault() {
 }
 
-var TraceServiceSearchTraceTreeResult_Success_DEFAULT *SearchTraceTreeResponse
+var TraceServiceListViewsResult_Success_DEFAULT *ListViewsResponse
 
-func (p *TraceServiceSearchTraceTreeResult) GetSuccess() (v *SearchTraceTreeResponse) {
+func (p *TraceServiceListViewsResult) GetSuccess() (v *ListViewsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceSearchTraceTreeResult_Success_DEFAULT
+		return TraceServiceListViewsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceSearchTraceTreeResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SearchTraceTreeResponse)
+func (p *TraceServiceListViewsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListViewsResponse)
 }
 
-var fieldIDToName_TraceServiceSearchTraceTreeResult = map[int16]string{
+var fieldIDToName_TraceServiceListViewsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceSearchTraceTreeResult) IsSetSuccess() bool {
+func (p *TraceServiceListViewsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceSearchTraceTreeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListViewsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23625,7 +31654,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceSearchTraceTreeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23635,8 +31664,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceSearchTraceTreeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSearchTraceTreeResponse()
+func (p *TraceServiceListViewsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListViewsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23644,9 +31673,9 @@ func (p *TraceServiceSearchTraceTreeResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *TraceServiceSearchTraceTreeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListViewsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchTraceTree_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListViews_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -23672,7 +31701,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceSearchTraceTreeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListViewsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -23691,15 +31720,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceSearchTraceTreeResult) String() string {
+func (p *TraceServiceListViewsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceSearchTraceTreeResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListViewsResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceSearchTraceTreeResult) DeepEqual(ano *TraceServiceSearchTraceTreeResult) bool {
+func (p *TraceServiceListViewsResult) DeepEqual(ano *TraceServiceListViewsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -23711,7 +31740,7 @@ func (p *TraceServiceSearchTraceTreeResult) DeepEqual(ano *TraceServiceSearchTra
 	return true
 }
 
-func (p *TraceServiceSearchTraceTreeResult) Field0DeepEqual(src *SearchTraceTreeResponse) bool {
+func (p *TraceServiceListViewsResult) Field0DeepEqual(src *ListViewsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -23719,41 +31748,41 @@ func (p *TraceServiceSearchTraceTreeResult) Field0DeepEqual(src *SearchTraceTree
 	return true
 }
 
-type TraceServiceBatchGetTracesAdvanceInfoArgs struct {
-	Req *BatchGetTracesAdvanceInfoRequest `thrift:"req,1" frugal:"1,default,BatchGetTracesAdvanceInfoRequest"`
+type TraceServiceCreateManualAnnotationArgs struct {
+	Req *CreateManualAnnotationRequest `thrift:"req,1" frugal:"1,default,CreateManualAnnotationRequest"`
 }
 
-func NewTraceServiceBatchGetTracesAdvanceInfoArgs() *TraceServiceBatchGetTracesAdvanceInfoArgs {
-	return &TraceServiceBatchGetTracesAdvanceInfoArgs{}
+func NewTraceServiceCreateManualAnnotationArgs() *TraceServiceCreateManualAnnotationArgs {
+	return &TraceServiceCreateManualAnnotationArgs{}
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) InitDefault() {
+func (p *TraceServiceCreateManualAnnotationArgs) InitDefault() {
 }
 
-var TraceServiceBatchGetTracesAdvanceInfoArgs_Req_DEFAULT *BatchGetTracesAdvanceInfoRequest
+var TraceServiceCreateManualAnnotationArgs_Req_DEFAULT *CreateManualAnnotationRequest
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) GetReq() (v *BatchGetTracesAdvanceInfoRequest) {
+func (p *TraceServiceCreateManualAnnotationArgs) GetReq() (v *CreateManualAnnotationRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceBatchGetTracesAdvanceInfoArgs_Req_DEFAULT
+		return TraceServiceCreateManualAnnotationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) SetReq(val *BatchGetTracesAdvanceInfoRequest) {
+func (p *TraceServiceCreateManualAnnotationArgs) SetReq(val *CreateManualAnnotationRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoArgs = map[int16]string{
+var fieldIDToName_TraceServiceCreateManualAnnotationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) IsSetReq() bool {
+func (p *TraceServiceCreateManualAnnotationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateManualAnnotationArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23798,7 +31827,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23808,8 +31837,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewBatchGetTracesAdvanceInfoRequest()
+func (p *TraceServiceCreateManualAnnotationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateManualAnnotationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23817,9 +31846,9 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) ReadField1(iprot thrift.TPro
 	return nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateManualAnnotationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetTracesAdvanceInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateManualAnnotation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -23845,7 +31874,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateManualAnnotationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -23862,15 +31891,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) String() string {
+func (p *TraceServiceCreateManualAnnotationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceBatchGetTracesAdvanceInfoArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceCreateManualAnnotationArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) DeepEqual(ano *TraceServiceBatchGetTracesAdvanceInfoArgs) bool {
+func (p *TraceServiceCreateManualAnnotationArgs) DeepEqual(ano *TraceServiceCreateManualAnnotationArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -23882,7 +31911,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) DeepEqual(ano *TraceServiceB
 	return true
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) Field1DeepEqual(src *BatchGetTracesAdvanceInfoRequest) bool {
+func (p *TraceServiceCreateManualAnnotationArgs) Field1DeepEqual(src *CreateManualAnnotationRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -23890,41 +31919,41 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) Field1DeepEqual(src *BatchGe
 	return true
 }
 
-type TraceServiceBatchGetTracesAdvanceInfoResult struct {
-	Success *BatchGetTracesAdvanceInfoResponse `thrift:"success,0,optional" frugal:"0,optional,BatchGetTracesAdvanceInfoResponse"`
+type TraceServiceCreateManualAnnotationResult struct {
+	Success *CreateManualAnnotationResponse `thrift:"success,0,optional" frugal:"0,optional,CreateManualAnnotationResponse"`
 }
 
-func NewTraceServiceBatchGetTracesAdvanceInfoResult() *TraceServiceBatchGetTracesAdvanceInfoResult {
-	return &TraceServiceBatchGetTracesAdvanceInfoResult{}
+func NewTraceServiceCreateManualAnnotationResult() *TraceServiceCreateManualAnnotationResult {
+	return &TraceServiceCreateManualAnnotationResult{}
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) InitDefault() {
+func (p *TraceServiceCreateManualAnnotationResult) InitDefault() {
 }
 
-var TraceServiceBatchGetTracesAdvanceInfoResult_Success_DEFAULT *BatchGetTracesAdvanceInfoResponse
+var TraceServiceCreateManualAnnotationResult_Success_DEFAULT *CreateManualAnnotationResponse
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) GetSuccess() (v *BatchGetTracesAdvanceInfoResponse) {
+func (p *TraceServiceCreateManualAnnotationResult) GetSuccess() (v *CreateManualAnnotationResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceBatchGetTracesAdvanceInfoResult_Success_DEFAULT
+		return TraceServiceCreateManualAnnotationResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetTracesAdvanceInfoResponse)
+func (p *TraceServiceCreateManualAnnotationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateManualAnnotationResponse)
 }
 
-var fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoResult = map[int16]string{
+var fieldIDToName_TraceServiceCreateManualAnnotationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) IsSetSuccess() bool {
+func (p *TraceServiceCreateManualAnnotationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateManualAnnotationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -23969,7 +31998,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -23979,8 +32008,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewBatchGetTracesAdvanceInfoResponse()
+func (p *TraceServiceCreateManualAnnotationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateManualAnnotationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -23988,9 +32017,9 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) ReadField0(iprot thrift.TP
 	return nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateManualAnnotationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchGetTracesAdvanceInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateManualAnnotation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24016,7 +32045,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceCreateManualAnnotationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -24035,15 +32064,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) String() string {
+func (p *TraceServiceCreateManualAnnotationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceBatchGetTracesAdvanceInfoResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceCreateManualAnnotationResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) DeepEqual(ano *TraceServiceBatchGetTracesAdvanceInfoResult) bool {
+func (p *TraceServiceCreateManualAnnotationResult) DeepEqual(ano *TraceServiceCreateManualAnnotationResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24055,7 +32084,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) DeepEqual(ano *TraceServic
 	return true
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) Field0DeepEqual(src *BatchGetTracesAdvanceInfoResponse) bool {
+func (p *TraceServiceCreateManualAnnotationResult) Field0DeepEqual(src *CreateManualAnnotationResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -24063,41 +32092,41 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) Field0DeepEqual(src *Batch
 	return true
 }
 
-type TraceServiceIngestTracesInnerArgs struct {
-	Req *IngestTracesRequest `thrift:"req,1" frugal:"1,default,IngestTracesRequest"`
+type TraceServiceUpdateManualAnnotationArgs struct {
+	Req *UpdateManualAnnotationRequest `thrift:"req,1" frugal:"1,default,UpdateManualAnnotationRequest"`
 }
 
-func NewTraceServiceIngestTracesInnerArgs() *TraceServiceIngestTracesInnerArgs {
-	return &TraceServiceIngestTracesInnerArgs{}
+func NewTraceServiceUpdateManualAnnotationArgs() *TraceServiceUpdateManualAnnotationArgs {
+	return &TraceServiceUpdateManualAnnotationArgs{}
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) InitDefault() {
+func (p *TraceServiceUpdateManualAnnotationArgs) InitDefault() {
 }
 
-var TraceServiceIngestTracesInnerArgs_Req_DEFAULT *IngestTracesRequest
+var TraceServiceUpdateManualAnnotationArgs_Req_DEFAULT *UpdateManualAnnotationRequest
 
-func (p *TraceServiceIngestTracesInnerArgs) GetReq() (v *IngestTracesRequest) {
+func (p *TraceServiceUpdateManualAnnotationArgs) GetReq() (v *UpdateManualAnnotationRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceIngestTracesInnerArgs_Req_DEFAULT
+		return TraceServiceUpdateManualAnnotationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceIngestTracesInnerArgs) SetReq(val *IngestTracesRequest) {
+func (p *TraceServiceUpdateManualAnnotationArgs) SetReq(val *UpdateManualAnnotationRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceIngestTracesInnerArgs = map[int16]string{
+var fieldIDToName_TraceServiceUpdateManualAnnotationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) IsSetReq() bool {
+func (p *TraceServiceUpdateManualAnnotationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateManualAnnotationArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24142,7 +32171,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24152,8 +32181,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewIngestTracesRequest()
+func (p *TraceServiceUpdateManualAnnotationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateManualAnnotationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24161,9 +32190,9 @@ func (p *TraceServiceIngestTracesInnerArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateManualAnnotationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("IngestTracesInner_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateManualAnnotation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24189,7 +32218,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateManualAnnotationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -24206,15 +32235,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) String() string {
+func (p *TraceServiceUpdateManualAnnotationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceIngestTracesInnerArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceUpdateManualAnnotationArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) DeepEqual(ano *TraceServiceIngestTracesInnerArgs) bool {
+func (p *TraceServiceUpdateManualAnnotationArgs) DeepEqual(ano *TraceServiceUpdateManualAnnotationArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24226,7 +32255,7 @@ func (p *TraceServiceIngestTracesInnerArgs) DeepEqual(ano *TraceServiceIngestTra
 	return true
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) Field1DeepEqual(src *IngestTracesRequest) bool {
+func (p *TraceServiceUpdateManualAnnotationArgs) Field1DeepEqual(src *UpdateManualAnnotationRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -24234,41 +32263,41 @@ func (p *TraceServiceIngestTracesInnerArgs) Field1DeepEqual(src *IngestTracesReq
 	return true
 }
 
-type TraceServiceIngestTracesInnerResult struct {
-	Success *IngestTracesResponse `thrift:"success,0,optional" frugal:"0,optional,IngestTracesResponse"`
+type TraceServiceUpdateManualAnnotationResult struct {
+	Success *UpdateManualAnnotationResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateManualAnnotationResponse"`
 }
 
-func NewTraceServiceIngestTracesInnerResult() *TraceServiceIngestTracesInnerResult {
-	return &TraceServiceIngestTracesInnerResult{}
+func NewTraceServiceUpdateManualAnnotationResult() *TraceServiceUpdateManualAnnotationResult {
+	return &TraceServiceUpdateManualAnnotationResult{}
 }
 
-func (p *TraceServiceIngestTracesInnerResult) InitDefault() {
+func (p *TraceServiceUpdateManualAnnotationResult) InitDefault() {
 }
 
-var TraceServiceIngestTracesInnerResult_Success_DEFAULT *IngestTracesResponse
+var TraceServiceUpdateManualAnnotationResult_Success_DEFAULT *UpdateManualAnnotationResponse
 
-func (p *TraceServiceIngestTracesInnerResult) GetSuccess() (v *IngestTracesResponse) {
+func (p *TraceServiceUpdateManualAnnotationResult) GetSuccess() (v *UpdateManualAnnotationResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceIngestTracesInnerResult_Success_DEFAULT
+		return TraceServiceUpdateManualAnnotationResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceIngestTracesInnerResult) SetSuccess(x interface{}) {
-	p.Success = x.(*IngestTracesResponse)
+func (p *TraceServiceUpdateManualAnnotationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateManualAnnotationResponse)
 }
 
-var fieldIDToName_TraceServiceIngestTracesInnerResult = map[int16]string{
+var fieldIDToName_TraceServiceUpdateManualAnnotationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceIngestTracesInnerResult) IsSetSuccess() bool {
+func (p *TraceServiceUpdateManualAnnotationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceIngestTracesInnerResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateManualAnnotationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24313,7 +32342,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24323,8 +32352,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceIngestTracesInnerResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewIngestTracesResponse()
+func (p *TraceServiceUpdateManualAnnotationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateManualAnnotationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24332,9 +32361,9 @@ func (p *TraceServiceIngestTracesInnerResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *TraceServiceIngestTracesInnerResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateManualAnnotationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("IngestTracesInner_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateManualAnnotation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24360,7 +32389,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceIngestTracesInnerResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpdateManualAnnotationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -24379,15 +32408,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceIngestTracesInnerResult) String() string {
+func (p *TraceServiceUpdateManualAnnotationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceIngestTracesInnerResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceUpdateManualAnnotationResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceIngestTracesInnerResult) DeepEqual(ano *TraceServiceIngestTracesInnerResult) bool {
+func (p *TraceServiceUpdateManualAnnotationResult) DeepEqual(ano *TraceServiceUpdateManualAnnotationResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24399,7 +32428,7 @@ func (p *TraceServiceIngestTracesInnerResult) DeepEqual(ano *TraceServiceIngestT
 	return true
 }
 
-func (p *TraceServiceIngestTracesInnerResult) Field0DeepEqual(src *IngestTracesResponse) bool {
+func (p *TraceServiceUpdateManualAnnotationResult) Field0DeepEqual(src *UpdateManualAnnotationResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -24407,41 +32436,41 @@ func (p *TraceServiceIngestTracesInnerResult) Field0DeepEqual(src *IngestTracesR
 	return true
 }
 
-type TraceServiceGetTracesMetaInfoArgs struct {
-	Req *GetTracesMetaInfoRequest `thrift:"req,1" frugal:"1,default,GetTracesMetaInfoRequest"`
+type TraceServiceDeleteManualAnnotationArgs struct {
+	Req *DeleteManualAnnotationRequest `thrift:"req,1" frugal:"1,default,DeleteManualAnnotationRequest"`
 }
 
-func NewTraceServiceGetTracesMetaInfoArgs() *TraceServiceGetTracesMetaInfoArgs {
-	return &TraceServiceGetTracesMetaInfoArgs{}
+func NewTraceServiceDeleteManualAnnotationArgs() *TraceServiceDeleteManualAnnotationArgs {
+	return &TraceServiceDeleteManualAnnotationArgs{}
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) InitDefault() {
+func (p *TraceServiceDeleteManualAnnotationArgs) InitDefault() {
 }
 
-var TraceServiceGetTracesMetaInfoArgs_Req_DEFAULT *GetTracesMetaInfoRequest
+var TraceServiceDeleteManualAnnotationArgs_Req_DEFAULT *DeleteManualAnnotationRequest
 
-func (p *TraceServiceGetTracesMetaInfoArgs) GetReq() (v *GetTracesMetaInfoRequest) {
+func (p *TraceServiceDeleteManualAnnotationArgs) GetReq() (v *DeleteManualAnnotationRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceGetTracesMetaInfoArgs_Req_DEFAULT
+		return TraceServiceDeleteManualAnnotationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceGetTracesMetaInfoArgs) SetReq(val *GetTracesMetaInfoRequest) {
+func (p *TraceServiceDeleteManualAnnotationArgs) SetReq(val *DeleteManualAnnotationRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceGetTracesMetaInfoArgs = map[int16]string{
+var fieldIDToName_TraceServiceDeleteManualAnnotationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) IsSetReq() bool {
+func (p *TraceServiceDeleteManualAnnotationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteManualAnnotationArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24486,7 +32515,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24496,8 +32525,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetTracesMetaInfoRequest()
+func (p *TraceServiceDeleteManualAnnotationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteManualAnnotationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24505,9 +32534,9 @@ func (p *TraceServiceGetTracesMetaInfoArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteManualAnnotationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTracesMetaInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteManualAnnotation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24533,7 +32562,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteManualAnnotationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -24550,15 +32579,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) String() string {
+func (p *TraceServiceDeleteManualAnnotationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceGetTracesMetaInfoArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceDeleteManualAnnotationArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) DeepEqual(ano *TraceServiceGetTracesMetaInfoArgs) bool {
+func (p *TraceServiceDeleteManualAnnotationArgs) DeepEqual(ano *TraceServiceDeleteManualAnnotationArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24570,7 +32599,7 @@ func (p *TraceServiceGetTracesMetaInfoArgs) DeepEqual(ano *TraceServiceGetTraces
 	return true
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) Field1DeepEqual(src *GetTracesMetaInfoRequest) bool {
+func (p *TraceServiceDeleteManualAnnotationArgs) Field1DeepEqual(src *DeleteManualAnnotationRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -24578,41 +32607,41 @@ func (p *TraceServiceGetTracesMetaInfoArgs) Field1DeepEqual(src *GetTracesMetaIn
 	return true
 }
 
-type TraceServiceGetTracesMetaInfoResult struct {
-	Success *GetTracesMetaInfoResponse `thrift:"success,0,optional" frugal:"0,optional,GetTracesMetaInfoResponse"`
+type TraceServiceDeleteManualAnnotationResult struct {
+	Success *DeleteManualAnnotationResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteManualAnnotationResponse"`
 }
 
-func NewTraceServiceGetTracesMetaInfoResult() *TraceServiceGetTracesMetaInfoResult {
-	return &TraceServiceGetTracesMetaInfoResult{}
+func NewTraceServiceDeleteManualAnnotationResult() *TraceServiceDeleteManualAnnotationResult {
+	return &TraceServiceDeleteManualAnnotationResult{}
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) InitDefault() {
+func (p *TraceServiceDeleteManualAnnotationResult) InitDefault() {
 }
 
-var TraceServiceGetTracesMetaInfoResult_Success_DEFAULT *GetTracesMetaInfoResponse
+var TraceServiceDeleteManualAnnotationResult_Success_DEFAULT *DeleteManualAnnotationResponse
 
-func (p *TraceServiceGetTracesMetaInfoResult) GetSuccess() (v *GetTracesMetaInfoResponse) {
+func (p *TraceServiceDeleteManualAnnotationResult) GetSuccess() (v *DeleteManualAnnotationResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceGetTracesMetaInfoResult_Success_DEFAULT
+		return TraceServiceDeleteManualAnnotationResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceGetTracesMetaInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetTracesMetaInfoResponse)
+func (p *TraceServiceDeleteManualAnnotationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteManualAnnotationResponse)
 }
 
-var fieldIDToName_TraceServiceGetTracesMetaInfoResult = map[int16]string{
+var fieldIDToName_TraceServiceDeleteManualAnnotationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) IsSetSuccess() bool {
+func (p *TraceServiceDeleteManualAnnotationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteManualAnnotationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24657,7 +32686,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24667,8 +32696,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetTracesMetaInfoResponse()
+func (p *TraceServiceDeleteManualAnnotationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteManualAnnotationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24676,9 +32705,9 @@ func (p *TraceServiceGetTracesMetaInfoResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteManualAnnotationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTracesMetaInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteManualAnnotation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24704,7 +32733,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDeleteManualAnnotationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -24723,15 +32752,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) String() string {
+func (p *TraceServiceDeleteManualAnnotationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceGetTracesMetaInfoResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceDeleteManualAnnotationResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) DeepEqual(ano *TraceServiceGetTracesMetaInfoResult) bool {
+func (p *TraceServiceDeleteManualAnnotationResult) DeepEqual(ano *TraceServiceDeleteManualAnnotationResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24743,7 +32772,7 @@ func (p *TraceServiceGetTracesMetaInfoResult) DeepEqual(ano *TraceServiceGetTrac
 	return true
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) Field0DeepEqual(src *GetTracesMetaInfoResponse) bool {
+func (p *TraceServiceDeleteManualAnnotationResult) Field0DeepEqual(src *DeleteManualAnnotationResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -24751,41 +32780,41 @@ func (p *TraceServiceGetTracesMetaInfoResult) Field0DeepEqual(src *GetTracesMeta
 	return true
 }
 
-type TraceServiceCreateViewArgs struct {
-	Req *CreateViewRequest `thrift:"req,1" frugal:"1,default,CreateViewRequest"`
+type TraceServiceListAnnotationsArgs struct {
+	Req *ListAnnotationsRequest `thrift:"req,1" frugal:"1,default,ListAnnotationsRequest"`
 }
 
-func NewTraceServiceCreateViewArgs() *TraceServiceCreateViewArgs {
-	return &TraceServiceCreateViewArgs{}
+func NewTraceServiceListAnnotationsArgs() *TraceServiceListAnnotationsArgs {
+	return &TraceServiceListAnnotationsArgs{}
 }
 
-func (p *TraceServiceCreateViewArgs) InitDefault() {
+func (p *TraceServiceListAnnotationsArgs) InitDefault() {
 }
 
-var TraceServiceCreateViewArgs_Req_DEFAULT *CreateViewRequest
+var TraceServiceListAnnotationsArgs_Req_DEFAULT *ListAnnotationsRequest
 
-func (p *TraceServiceCreateViewArgs) GetReq() (v *CreateViewRequest) {
+func (p *TraceServiceListAnnotationsArgs) GetReq() (v *ListAnnotationsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceCreateViewArgs_Req_DEFAULT
+		return TraceServiceListAnnotationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceCreateViewArgs) SetReq(val *CreateViewRequest) {
+func (p *TraceServiceListAnnotationsArgs) SetReq(val *ListAnnotationsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceCreateViewArgs = map[int16]string{
+var fieldIDToName_TraceServiceListAnnotationsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceCreateViewArgs) IsSetReq() bool {
+func (p *TraceServiceListAnnotationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceCreateViewArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -24830,7 +32859,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -24840,8 +32869,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceCreateViewArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateViewRequest()
+func (p *TraceServiceListAnnotationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListAnnotationsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -24849,9 +32878,9 @@ func (p *TraceServiceCreateViewArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceCreateViewArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateView_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListAnnotations_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -24877,7 +32906,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceCreateViewArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -24894,15 +32923,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceCreateViewArgs) String() string {
+func (p *TraceServiceListAnnotationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceCreateViewArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListAnnotationsArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceCreateViewArgs) DeepEqual(ano *TraceServiceCreateViewArgs) bool {
+func (p *TraceServiceListAnnotationsArgs) DeepEqual(ano *TraceServiceListAnnotationsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -24914,7 +32943,7 @@ func (p *TraceServiceCreateViewArgs) DeepEqual(ano *TraceServiceCreateViewArgs)
 	return true
 }
 
-func (p *TraceServiceCreateViewArgs) Field1DeepEqual(src *CreateViewRequest) bool {
+func (p *TraceServiceListAnnotationsArgs) Field1DeepEqual(src *ListAnnotationsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -24922,41 +32951,41 @@ func (p *TraceServiceCreateViewArgs) Field1DeepEqual(src *CreateViewRequest) boo
 	return true
 }
 
-type TraceServiceCreateViewResult struct {
-	Success *CreateViewResponse `thrift:"success,0,optional" frugal:"0,optional,CreateViewResponse"`
+type TraceServiceListAnnotationsResult struct {
+	Success *ListAnnotationsResponse `thrift:"success,0,optional" frugal:"0,optional,ListAnnotationsResponse"`
 }
 
-func NewTraceServiceCreateViewResult() *TraceServiceCreateViewResult {
-	return &TraceServiceCreateViewResult{}
+func NewTraceServiceListAnnotationsResult() *TraceServiceListAnnotationsResult {
+	return &TraceServiceListAnnotationsResult{}
 }
 
-func (p *TraceServiceCreateViewResult) InitDefault() {
+func (p *TraceServiceListAnnotationsResult) InitDefault() {
 }
 
-var TraceServiceCreateViewResult_Success_DEFAULT *CreateViewResponse
+var TraceServiceListAnnotationsResult_Success_DEFAULT *ListAnnotationsResponse
 
-func (p *TraceServiceCreateViewResult) GetSuccess() (v *CreateViewResponse) {
+func (p *TraceServiceListAnnotationsResult) GetSuccess() (v *ListAnnotationsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceCreateViewResult_Success_DEFAULT
+		return TraceServiceListAnnotationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceCreateViewResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateViewResponse)
+func (p *TraceServiceListAnnotationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListAnnotationsResponse)
 }
 
-var fieldIDToName_TraceServiceCreateViewResult = map[int16]string{
+var fieldIDToName_TraceServiceListAnnotationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceCreateViewResult) IsSetSuccess() bool {
+func (p *TraceServiceListAnnotationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceCreateViewResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25001,7 +33030,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25011,8 +33040,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceCreateViewResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateViewResponse()
+func (p *TraceServiceListAnnotationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListAnnotationsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25020,9 +33049,9 @@ func (p *TraceServiceCreateViewResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TraceServiceCreateViewResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateView_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListAnnotations_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25048,7 +33077,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceCreateViewResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -25067,15 +33096,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceCreateViewResult) String() string {
+func (p *TraceServiceListAnnotationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceCreateViewResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListAnnotationsResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceCreateViewResult) DeepEqual(ano *TraceServiceCreateViewResult) bool {
+func (p *TraceServiceListAnnotationsResult) DeepEqual(ano *TraceServiceListAnnotationsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25087,7 +33116,7 @@ func (p *TraceServiceCreateViewResult) DeepEqual(ano *TraceServiceCreateViewResu
 	return true
 }
 
-func (p *TraceServiceCreateViewResult) Field0DeepEqual(src *CreateViewResponse) bool {
+func (p *TraceServiceListAnnotationsResult) Field0DeepEqual(src *ListAnnotationsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -25095,41 +33124,41 @@ func (p *TraceServiceCreateViewResult) Field0DeepEqual(src *CreateViewResponse)
 	return true
 }
 
-type TraceServiceUpdateViewArgs struct {
-	Req *UpdateViewRequest `thrift:"req,1" frugal:"1,default,UpdateViewRequest"`
+type TraceServiceExportTracesToDatasetArgs struct {
+	Req *ExportTracesToDatasetRequest `thrift:"Req,1" frugal:"1,default,ExportTracesToDatasetRequest"`
 }
 
-func NewTraceServiceUpdateViewArgs() *TraceServiceUpdateViewArgs {
-	return &TraceServiceUpdateViewArgs{}
+func NewTraceServiceExportTracesToDatasetArgs() *TraceServiceExportTracesToDatasetArgs {
+	return &TraceServiceExportTracesToDatasetArgs{}
 }
 
-func (p *TraceServiceUpdateViewArgs) InitDefault() {
+func (p *TraceServiceExportTracesToDatasetArgs) InitDefault() {
 }
 
-var TraceServiceUpdateViewArgs_Req_DEFAULT *UpdateViewRequest
+var TraceServiceExportTracesToDatasetArgs_Req_DEFAULT *ExportTracesToDatasetRequest
 
-func (p *TraceServiceUpdateViewArgs) GetReq() (v *UpdateViewRequest) {
+func (p *TraceServiceExportTracesToDatasetArgs) GetReq() (v *ExportTracesToDatasetRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceUpdateViewArgs_Req_DEFAULT
+		return TraceServiceExportTracesToDatasetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceUpdateViewArgs) SetReq(val *UpdateViewRequest) {
+func (p *TraceServiceExportTracesToDatasetArgs) SetReq(val *ExportTracesToDatasetRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceUpdateViewArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_TraceServiceExportTracesToDatasetArgs = map[int16]string{
+	1: "Req",
 }
 
-func (p *TraceServiceUpdateViewArgs) IsSetReq() bool {
+func (p *TraceServiceExportTracesToDatasetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceUpdateViewArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25174,7 +33203,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25184,8 +33213,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateViewArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateViewRequest()
+func (p *TraceServiceExportTracesToDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExportTracesToDatasetRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25193,9 +33222,9 @@ func (p *TraceServiceUpdateViewArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceUpdateViewArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateView_args"); err != nil {
+	if err = oprot.WriteStructBegin("ExportTracesToDataset_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25221,8 +33250,8 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateViewArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+func (p *TraceServiceExportTracesToDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("Req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := p.Req.Write(oprot); err != nil {
@@ -25238,15 +33267,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateViewArgs) String() string {
+func (p *TraceServiceExportTracesToDatasetArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceUpdateViewArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceExportTracesToDatasetArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceUpdateViewArgs) DeepEqual(ano *TraceServiceUpdateViewArgs) bool {
+func (p *TraceServiceExportTracesToDatasetArgs) DeepEqual(ano *TraceServiceExportTracesToDatasetArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25258,7 +33287,7 @@ func (p *TraceServiceUpdateViewArgs) DeepEqual(ano *TraceServiceUpdateViewArgs)
 	return true
 }
 
-func (p *TraceServiceUpdateViewArgs) Field1DeepEqual(src *UpdateViewRequest) bool {
+func (p *TraceServiceExportTracesToDatasetArgs) Field1DeepEqual(src *ExportTracesToDatasetRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -25266,41 +33295,41 @@ func (p *TraceServiceUpdateViewArgs) Field1DeepEqual(src *UpdateViewRequest) boo
 	return true
 }
 
-type TraceServiceUpdateViewResult struct {
-	Success *UpdateViewResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateViewResponse"`
+type TraceServiceExportTracesToDatasetResult struct {
+	Success *ExportTracesToDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,ExportTracesToDatasetResponse"`
 }
 
-func NewTraceServiceUpdateViewResult() *TraceServiceUpdateViewResult {
-	return &TraceServiceUpdateViewResult{}
+func NewTraceServiceExportTracesToDatasetResult() *TraceServiceExportTracesToDatasetResult {
+	return &TraceServiceExportTracesToDatasetResult{}
 }
 
-func (p *TraceServiceUpdateViewResult) InitDefault() {
+func (p *TraceServiceExportTracesToDatasetResult) InitDefault() {
 }
 
-var TraceServiceUpdateViewResult_Success_DEFAULT *UpdateViewResponse
+var TraceServiceExportTracesToDatasetResult_Success_DEFAULT *ExportTracesToDatasetResponse
 
-func (p *TraceServiceUpdateViewResult) GetSuccess() (v *UpdateViewResponse) {
+func (p *TraceServiceExportTracesToDatasetResult) GetSuccess() (v *ExportTracesToDatasetResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceUpdateViewResult_Success_DEFAULT
+		return TraceServiceExportTracesToDatasetResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceUpdateViewResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateViewResponse)
+func (p *TraceServiceExportTracesToDatasetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportTracesToDatasetResponse)
 }
 
-var fieldIDToName_TraceServiceUpdateViewResult = map[int16]string{
+var fieldIDToName_TraceServiceExportTracesToDatasetResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceUpdateViewResult) IsSetSuccess() bool {
+func (p *TraceServiceExportTracesToDatasetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceUpdateViewResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToDatasetResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25345,7 +33374,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25355,8 +33384,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateViewResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateViewResponse()
+func (p *TraceServiceExportTracesToDatasetResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExportTracesToDatasetResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25364,9 +33393,9 @@ func (p *TraceServiceUpdateViewResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TraceServiceUpdateViewResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToDatasetResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateView_result"); err != nil {
+	if err = oprot.WriteStructBegin("ExportTracesToDataset_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25392,7 +33421,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateViewResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -25411,15 +33440,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateViewResult) String() string {
+func (p *TraceServiceExportTracesToDatasetResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceUpdateViewResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceExportTracesToDatasetResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceUpdateViewResult) DeepEqual(ano *TraceServiceUpdateViewResult) bool {
+func (p *TraceServiceExportTracesToDatasetResult) DeepEqual(ano *TraceServiceExportTracesToDatasetResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25431,7 +33460,7 @@ func (p *TraceServiceUpdateViewResult) DeepEqual(ano *TraceServiceUpdateViewResu
 	return true
 }
 
-func (p *TraceServiceUpdateViewResult) Field0DeepEqual(src *UpdateViewResponse) bool {
+func (p *TraceServiceExportTracesToDatasetResult) Field0DeepEqual(src *ExportTracesToDatasetResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -25439,41 +33468,41 @@ func (p *TraceServiceUpdateViewResult) Field0DeepEqual(src *UpdateViewResponse)
 	return true
 }
 
-type TraceServiceDeleteViewArgs struct {
-	Req *DeleteViewRequest `thrift:"req,1" frugal:"1,default,DeleteViewRequest"`
+type TraceServicePreviewExportTracesToDatasetArgs struct {
+	Req *PreviewExportTracesToDatasetRequest `thrift:"Req,1" frugal:"1,default,PreviewExportTracesToDatasetRequest"`
 }
 
-func NewTraceServiceDeleteViewArgs() *TraceServiceDeleteViewArgs {
-	return &TraceServiceDeleteViewArgs{}
+func NewTraceServicePreviewExportTracesToDatasetArgs() *TraceServicePreviewExportTracesToDatasetArgs {
+	return &TraceServicePreviewExportTracesToDatasetArgs{}
 }
 
-func (p *TraceServiceDeleteViewArgs) InitDefault() {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) InitDefault() {
 }
 
-var TraceServiceDeleteViewArgs_Req_DEFAULT *DeleteViewRequest
+var TraceServicePreviewExportTracesToDatasetArgs_Req_DEFAULT *PreviewExportTracesToDatasetRequest
 
-func (p *TraceServiceDeleteViewArgs) GetReq() (v *DeleteViewRequest) {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) GetReq() (v *PreviewExportTracesToDatasetRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceDeleteViewArgs_Req_DEFAULT
+		return TraceServicePreviewExportTracesToDatasetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceDeleteViewArgs) SetReq(val *DeleteViewRequest) {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) SetReq(val *PreviewExportTracesToDatasetRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceDeleteViewArgs = map[int16]string{
-	1: "req",
+var fieldIDToName_TraceServicePreviewExportTracesToDatasetArgs = map[int16]string{
+	1: "Req",
 }
 
-func (p *TraceServiceDeleteViewArgs) IsSetReq() bool {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceDeleteViewArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25518,7 +33547,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25528,8 +33557,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteViewArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteViewRequest()
+func (p *TraceServicePreviewExportTracesToDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPreviewExportTracesToDatasetRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25537,9 +33566,9 @@ func (p *TraceServiceDeleteViewArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceDeleteViewArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteView_args"); err != nil {
+	if err = oprot.WriteStructBegin("PreviewExportTracesToDataset_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25565,8 +33594,8 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteViewArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("Req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := p.Req.Write(oprot); err != nil {
@@ -25582,15 +33611,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteViewArgs) String() string {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceDeleteViewArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServicePreviewExportTracesToDatasetArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceDeleteViewArgs) DeepEqual(ano *TraceServiceDeleteViewArgs) bool {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) DeepEqual(ano *TraceServicePreviewExportTracesToDatasetArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25602,7 +33631,7 @@ func (p *TraceServiceDeleteViewArgs) DeepEqual(ano *TraceServiceDeleteViewArgs)
 	return true
 }
 
-func (p *TraceServiceDeleteViewArgs) Field1DeepEqual(src *DeleteViewRequest) bool {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) Field1DeepEqual(src *PreviewExportTracesToDatasetRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -25610,41 +33639,41 @@ func (p *TraceServiceDeleteViewArgs) Field1DeepEqual(src *DeleteViewRequest) boo
 	return true
 }
 
-type TraceServiceDeleteViewResult struct {
-	Success *DeleteViewResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteViewResponse"`
+type TraceServicePreviewExportTracesToDatasetResult struct {
+	Success *PreviewExportTracesToDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,PreviewExportTracesToDatasetResponse"`
 }
 
-func NewTraceServiceDeleteViewResult() *TraceServiceDeleteViewResult {
-	return &TraceServiceDeleteViewResult{}
+func NewTraceServicePreviewExportTracesToDatasetResult() *TraceServicePreviewExportTracesToDatasetResult {
+	return &TraceServicePreviewExportTracesToDatasetResult{}
 }
 
-func (p *TraceServiceDeleteViewResult) InitDefault() {
+func (p *TraceServicePreviewExportTracesToDatasetResult) InitDefault() {
 }
 
-var TraceServiceDeleteViewResult_Success_DEFAULT *DeleteViewResponse
+var TraceServicePreviewExportTracesToDatasetResult_Success_DEFAULT *PreviewExportTracesToDatasetResponse
 
-func (p *TraceServiceDeleteViewResult) GetSuccess() (v *DeleteViewResponse) {
+func (p *TraceServicePreviewExportTracesToDatasetResult) GetSuccess() (v *PreviewExportTracesToDatasetResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceDeleteViewResult_Success_DEFAULT
+		return TraceServicePreviewExportTracesToDatasetResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceDeleteViewResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteViewResponse)
+func (p *TraceServicePreviewExportTracesToDatasetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PreviewExportTracesToDatasetResponse)
 }
 
-var fieldIDToName_TraceServiceDeleteViewResult = map[int16]string{
+var fieldIDToName_TraceServicePreviewExportTracesToDatasetResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceDeleteViewResult) IsSetSuccess() bool {
+func (p *TraceServicePreviewExportTracesToDatasetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceDeleteViewResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServicePreviewExportTracesToDatasetResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25689,7 +33718,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25699,8 +33728,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteViewResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteViewResponse()
+func (p *TraceServicePreviewExportTracesToDatasetResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPreviewExportTracesToDatasetResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25708,9 +33737,9 @@ func (p *TraceServiceDeleteViewResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *TraceServiceDeleteViewResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServicePreviewExportTracesToDatasetResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteView_result"); err != nil {
+	if err = oprot.WriteStructBegin("PreviewExportTracesToDataset_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25736,7 +33765,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteViewResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServicePreviewExportTracesToDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -25755,15 +33784,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteViewResult) String() string {
+func (p *TraceServicePreviewExportTracesToDatasetResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceDeleteViewResult(%+v)", *p)
+	return fmt.Sprintf("TraceServicePreviewExportTracesToDatasetResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceDeleteViewResult) DeepEqual(ano *TraceServiceDeleteViewResult) bool {
+func (p *TraceServicePreviewExportTracesToDatasetResult) DeepEqual(ano *TraceServicePreviewExportTracesToDatasetResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25775,7 +33804,7 @@ func (p *TraceServiceDeleteViewResult) DeepEqual(ano *TraceServiceDeleteViewResu
 	return true
 }
 
-func (p *TraceServiceDeleteViewResult) Field0DeepEqual(src *DeleteViewResponse) bool {
+func (p *TraceServicePreviewExportTracesToDatasetResult) Field0DeepEqual(src *PreviewExportTracesToDatasetResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -25783,41 +33812,41 @@ func (p *TraceServiceDeleteViewResult) Field0DeepEqual(src *DeleteViewResponse)
 	return true
 }
 
-type TraceServiceListViewsArgs struct {
-	Req *ListViewsRequest `thrift:"req,1" frugal:"1,default,ListViewsRequest"`
+type TraceServiceChangeEvaluatorScoreArgs struct {
+	Req *ChangeEvaluatorScoreRequest `thrift:"req,1" frugal:"1,default,ChangeEvaluatorScoreRequest"`
 }
 
-func NewTraceServiceListViewsArgs() *TraceServiceListViewsArgs {
-	return &TraceServiceListViewsArgs{}
+func NewTraceServiceChangeEvaluatorScoreArgs() *TraceServiceChangeEvaluatorScoreArgs {
+	return &TraceServiceChangeEvaluatorScoreArgs{}
 }
 
-func (p *TraceServiceListViewsArgs) InitDefault() {
+func (p *TraceServiceChangeEvaluatorScoreArgs) InitDefault() {
 }
 
-var TraceServiceListViewsArgs_Req_DEFAULT *ListViewsRequest
+var TraceServiceChangeEvaluatorScoreArgs_Req_DEFAULT *ChangeEvaluatorScoreRequest
 
-func (p *TraceServiceListViewsArgs) GetReq() (v *ListViewsRequest) {
+func (p *TraceServiceChangeEvaluatorScoreArgs) GetReq() (v *ChangeEvaluatorScoreRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceListViewsArgs_Req_DEFAULT
+		return TraceServiceChangeEvaluatorScoreArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceListViewsArgs) SetReq(val *ListViewsRequest) {
+func (p *TraceServiceChangeEvaluatorScoreArgs) SetReq(val *ChangeEvaluatorScoreRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceListViewsArgs = map[int16]string{
+var fieldIDToName_TraceServiceChangeEvaluatorScoreArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceListViewsArgs) IsSetReq() bool {
+func (p *TraceServiceChangeEvaluatorScoreArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceListViewsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceChangeEvaluatorScoreArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -25862,7 +33891,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceChangeEvaluatorScoreArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -25872,8 +33901,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListViewsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListViewsRequest()
+func (p *TraceServiceChangeEvaluatorScoreArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChangeEvaluatorScoreRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -25881,9 +33910,9 @@ func (p *TraceServiceListViewsArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceListViewsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceChangeEvaluatorScoreArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListViews_args"); err != nil {
+	if err = oprot.WriteStructBegin("ChangeEvaluatorScore_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -25909,7 +33938,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListViewsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceChangeEvaluatorScoreArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -25926,15 +33955,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceListViewsArgs) String() string {
+func (p *TraceServiceChangeEvaluatorScoreArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListViewsArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceChangeEvaluatorScoreArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceListViewsArgs) DeepEqual(ano *TraceServiceListViewsArgs) bool {
+func (p *TraceServiceChangeEvaluatorScoreArgs) DeepEqual(ano *TraceServiceChangeEvaluatorScoreArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -25946,7 +33975,7 @@ func (p *TraceServiceListViewsArgs) DeepEqual(ano *TraceServiceListViewsArgs) bo
 	return true
 }
 
-func (p *TraceServiceListViewsArgs) Field1DeepEqual(src *ListViewsRequest) bool {
+func (p *TraceServiceChangeEvaluatorScoreArgs) Field1DeepEqual(src *ChangeEvaluatorScoreRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -25954,41 +33983,41 @@ func (p *TraceServiceListViewsArgs) Field1DeepEqual(src *ListViewsRequest) bool
 	return true
 }
 
-type TraceServiceListViewsResult struct {
-	Success *ListViewsResponse `thrift:"success,0,optional" frugal:"0,optional,ListViewsResponse"`
+type TraceServiceChangeEvaluatorScoreResult struct {
+	Success *ChangeEvaluatorScoreResponse `thrift:"success,0,optional" frugal:"0,optional,ChangeEvaluatorScoreResponse"`
 }
 
-func NewTraceServiceListViewsResult() *TraceServiceListViewsResult {
-	return &TraceServiceListViewsResult{}
+func NewTraceServiceChangeEvaluatorScoreResult() *TraceServiceChangeEvaluatorScoreResult {
+	return &TraceServiceChangeEvaluatorScoreResult{}
 }
 
-func (p *TraceServiceListViewsResult) InitDefault() {
+func (p *TraceServiceChangeEvaluatorScoreResult) InitDefault() {
 }
 
-var TraceServiceListViewsResult_Success_DEFAULT *ListViewsResponse
+var TraceServiceChangeEvaluatorScoreResult_Success_DEFAULT *ChangeEvaluatorScoreResponse
 
-func (p *TraceServiceListViewsResult) GetSuccess() (v *ListViewsResponse) {
+func (p *TraceServiceChangeEvaluatorScoreResult) GetSuccess() (v *ChangeEvaluatorScoreResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceListViewsResult_Success_DEFAULT
+		return TraceServiceChangeEvaluatorScoreResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceListViewsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListViewsResponse)
+func (p *TraceServiceChangeEvaluatorScoreResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ChangeEvaluatorScoreResponse)
 }
 
-var fieldIDToName_TraceServiceListViewsResult = map[int16]string{
+var fieldIDToName_TraceServiceChangeEvaluatorScoreResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceListViewsResult) IsSetSuccess() bool {
+func (p *TraceServiceChangeEvaluatorScoreResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceListViewsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceChangeEvaluatorScoreResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -26033,7 +34062,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceChangeEvaluatorScoreResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26043,8 +34072,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListViewsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListViewsResponse()
+func (p *TraceServiceChangeEvaluatorScoreResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewChangeEvaluatorScoreResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -26052,9 +34081,9 @@ func (p *TraceServiceListViewsResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TraceServiceListViewsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceChangeEvaluatorScoreResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListViews_result"); err != nil {
+	if err = oprot.WriteStructBegin("ChangeEvaluatorScore_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26080,7 +34109,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListViewsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceChangeEvaluatorScoreResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -26099,15 +34128,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceListViewsResult) String() string {
+func (p *TraceServiceChangeEvaluatorScoreResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListViewsResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceChangeEvaluatorScoreResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceListViewsResult) DeepEqual(ano *TraceServiceListViewsResult) bool {
+func (p *TraceServiceChangeEvaluatorScoreResult) DeepEqual(ano *TraceServiceChangeEvaluatorScoreResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -26119,7 +34148,7 @@ func (p *TraceServiceListViewsResult) DeepEqual(ano *TraceServiceListViewsResult
 	return true
 }
 
-func (p *TraceServiceListViewsResult) Field0DeepEqual(src *ListViewsResponse) bool {
+func (p *TraceServiceChangeEvaluatorScoreResult) Field0DeepEqual(src *ChangeEvaluatorScoreResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -26127,41 +34156,41 @@ func (p *TraceServiceListViewsResult) Field0DeepEqual(src *ListViewsResponse) bo
 	return true
 }
 
-type TraceServiceCreateManualAnnotationArgs struct {
-	Req *CreateManualAnnotationRequest `thrift:"req,1" frugal:"1,default,CreateManualAnnotationRequest"`
+type TraceServiceListAnnotationEvaluatorsArgs struct {
+	Req *ListAnnotationEvaluatorsRequest `thrift:"req,1" frugal:"1,default,ListAnnotationEvaluatorsRequest"`
 }
 
-func NewTraceServiceCreateManualAnnotationArgs() *TraceServiceCreateManualAnnotationArgs {
-	return &TraceServiceCreateManualAnnotationArgs{}
+func NewTraceServiceListAnnotationEvaluatorsArgs() *TraceServiceListAnnotationEvaluatorsArgs {
+	return &TraceServiceListAnnotationEvaluatorsArgs{}
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) InitDefault() {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) InitDefault() {
 }
 
-var TraceServiceCreateManualAnnotationArgs_Req_DEFAULT *CreateManualAnnotationRequest
+var TraceServiceListAnnotationEvaluatorsArgs_Req_DEFAULT *ListAnnotationEvaluatorsRequest
 
-func (p *TraceServiceCreateManualAnnotationArgs) GetReq() (v *CreateManualAnnotationRequest) {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) GetReq() (v *ListAnnotationEvaluatorsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceCreateManualAnnotationArgs_Req_DEFAULT
+		return TraceServiceListAnnotationEvaluatorsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceCreateManualAnnotationArgs) SetReq(val *CreateManualAnnotationRequest) {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) SetReq(val *ListAnnotationEvaluatorsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceCreateManualAnnotationArgs = map[int16]string{
+var fieldIDToName_TraceServiceListAnnotationEvaluatorsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) IsSetReq() bool {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -26206,7 +34235,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationEvaluatorsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26216,8 +34245,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateManualAnnotationRequest()
+func (p *TraceServiceListAnnotationEvaluatorsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListAnnotationEvaluatorsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -26225,9 +34254,9 @@ func (p *TraceServiceCreateManualAnnotationArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateManualAnnotation_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListAnnotationEvaluators_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26253,7 +34282,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -26270,15 +34299,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) String() string {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceCreateManualAnnotationArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListAnnotationEvaluatorsArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) DeepEqual(ano *TraceServiceCreateManualAnnotationArgs) bool {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) DeepEqual(ano *TraceServiceListAnnotationEvaluatorsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -26290,7 +34319,7 @@ func (p *TraceServiceCreateManualAnnotationArgs) DeepEqual(ano *TraceServiceCrea
 	return true
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) Field1DeepEqual(src *CreateManualAnnotationRequest) bool {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) Field1DeepEqual(src *ListAnnotationEvaluatorsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -26298,41 +34327,41 @@ func (p *TraceServiceCreateManualAnnotationArgs) Field1DeepEqual(src *CreateManu
 	return true
 }
 
-type TraceServiceCreateManualAnnotationResult struct {
-	Success *CreateManualAnnotationResponse `thrift:"success,0,optional" frugal:"0,optional,CreateManualAnnotationResponse"`
+type TraceServiceListAnnotationEvaluatorsResult struct {
+	Success *ListAnnotationEvaluatorsResponse `thrift:"success,0,optional" frugal:"0,optional,ListAnnotationEvaluatorsResponse"`
 }
 
-func NewTraceServiceCreateManualAnnotationResult() *TraceServiceCreateManualAnnotationResult {
-	return &TraceServiceCreateManualAnnotationResult{}
+func NewTraceServiceListAnnotationEvaluatorsResult() *TraceServiceListAnnotationEvaluatorsResult {
+	return &TraceServiceListAnnotationEvaluatorsResult{}
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) InitDefault() {
+func (p *TraceServiceListAnnotationEvaluatorsResult) InitDefault() {
 }
 
-var TraceServiceCreateManualAnnotationResult_Success_DEFAULT *CreateManualAnnotationResponse
+var TraceServiceListAnnotationEvaluatorsResult_Success_DEFAULT *ListAnnotationEvaluatorsResponse
 
-func (p *TraceServiceCreateManualAnnotationResult) GetSuccess() (v *CreateManualAnnotationResponse) {
+func (p *TraceServiceListAnnotationEvaluatorsResult) GetSuccess() (v *ListAnnotationEvaluatorsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceCreateManualAnnotationResult_Success_DEFAULT
+		return TraceServiceListAnnotationEvaluatorsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceCreateManualAnnotationResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateManualAnnotationResponse)
+func (p *TraceServiceListAnnotationEvaluatorsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListAnnotationEvaluatorsResponse)
 }
 
-var fieldIDToName_TraceServiceCreateManualAnnotationResult = map[int16]string{
+var fieldIDToName_TraceServiceListAnnotationEvaluatorsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) IsSetSuccess() bool {
+func (p *TraceServiceListAnnotationEvaluatorsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationEvaluatorsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -26377,7 +34406,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationEvaluatorsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26387,8 +34416,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateManualAnnotationResponse()
+func (p *TraceServiceListAnnotationEvaluatorsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListAnnotationEvaluatorsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -26396,9 +34425,9 @@ func (p *TraceServiceCreateManualAnnotationResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationEvaluatorsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateManualAnnotation_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListAnnotationEvaluators_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26424,7 +34453,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListAnnotationEvaluatorsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -26443,15 +34472,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) String() string {
+func (p *TraceServiceListAnnotationEvaluatorsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceCreateManualAnnotationResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListAnnotationEvaluatorsResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) DeepEqual(ano *TraceServiceCreateManualAnnotationResult) bool {
+func (p *TraceServiceListAnnotationEvaluatorsResult) DeepEqual(ano *TraceServiceListAnnotationEvaluatorsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -26463,7 +34492,7 @@ func (p *TraceServiceCreateManualAnnotationResult) DeepEqual(ano *TraceServiceCr
 	return true
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) Field0DeepEqual(src *CreateManualAnnotationResponse) bool {
+func (p *TraceServiceListAnnotationEvaluatorsResult) Field0DeepEqual(src *ListAnnotationEvaluatorsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -26471,41 +34500,41 @@ func (p *TraceServiceCreateManualAnnotationResult) Field0DeepEqual(src *CreateMa
 	return true
 }
 
-type TraceServiceUpdateManualAnnotationArgs struct {
-	Req *UpdateManualAnnotationRequest `thrift:"req,1" frugal:"1,default,UpdateManualAnnotationRequest"`
+type TraceServiceExtractSpanInfoArgs struct {
+	Req *ExtractSpanInfoRequest `thrift:"req,1" frugal:"1,default,ExtractSpanInfoRequest"`
 }
 
-func NewTraceServiceUpdateManualAnnotationArgs() *TraceServiceUpdateManualAnnotationArgs {
-	return &TraceServiceUpdateManualAnnotationArgs{}
+func NewTraceServiceExtractSpanInfoArgs() *TraceServiceExtractSpanInfoArgs {
+	return &TraceServiceExtractSpanInfoArgs{}
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) InitDefault() {
+func (p *TraceServiceExtractSpanInfoArgs) InitDefault() {
 }
 
-var TraceServiceUpdateManualAnnotationArgs_Req_DEFAULT *UpdateManualAnnotationRequest
+var TraceServiceExtractSpanInfoArgs_Req_DEFAULT *ExtractSpanInfoRequest
 
-func (p *TraceServiceUpdateManualAnnotationArgs) GetReq() (v *UpdateManualAnnotationRequest) {
+func (p *TraceServiceExtractSpanInfoArgs) GetReq() (v *ExtractSpanInfoRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceUpdateManualAnnotationArgs_Req_DEFAULT
+		return TraceServiceExtractSpanInfoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceUpdateManualAnnotationArgs) SetReq(val *UpdateManualAnnotationRequest) {
+func (p *TraceServiceExtractSpanInfoArgs) SetReq(val *ExtractSpanInfoRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceUpdateManualAnnotationArgs = map[int16]string{
+var fieldIDToName_TraceServiceExtractSpanInfoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) IsSetReq() bool {
+func (p *TraceServiceExtractSpanInfoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExtractSpanInfoArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -26550,7 +34579,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExtractSpanInfoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26560,8 +34589,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateManualAnnotationRequest()
+func (p *TraceServiceExtractSpanInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExtractSpanInfoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -26569,9 +34598,9 @@ func (p *TraceServiceUpdateManualAnnotationArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExtractSpanInfoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateManualAnnotation_args"); err != nil {
+	if err = oprot.WriteStructBegin("ExtractSpanInfo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26597,7 +34626,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExtractSpanInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -26614,15 +34643,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) String() string {
+func (p *TraceServiceExtractSpanInfoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceUpdateManualAnnotationArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceExtractSpanInfoArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) DeepEqual(ano *TraceServiceUpdateManualAnnotationArgs) bool {
+func (p *TraceServiceExtractSpanInfoArgs) DeepEqual(ano *TraceServiceExtractSpanInfoArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -26634,7 +34663,7 @@ func (p *TraceServiceUpdateManualAnnotationArgs) DeepEqual(ano *TraceServiceUpda
 	return true
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) Field1DeepEqual(src *UpdateManualAnnotationRequest) bool {
+func (p *TraceServiceExtractSpanInfoArgs) Field1DeepEqual(src *ExtractSpanInfoRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -26642,41 +34671,41 @@ func (p *TraceServiceUpdateManualAnnotationArgs) Field1DeepEqual(src *UpdateManu
 	return true
 }
 
-type TraceServiceUpdateManualAnnotationResult struct {
-	Success *UpdateManualAnnotationResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateManualAnnotationResponse"`
+type TraceServiceExtractSpanInfoResult struct {
+	Success *ExtractSpanInfoResponse `thrift:"success,0,optional" frugal:"0,optional,ExtractSpanInfoResponse"`
 }
 
-func NewTraceServiceUpdateManualAnnotationResult() *TraceServiceUpdateManualAnnotationResult {
-	return &TraceServiceUpdateManualAnnotationResult{}
+func NewTraceServiceExtractSpanInfoResult() *TraceServiceExtractSpanInfoResult {
+	return &TraceServiceExtractSpanInfoResult{}
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) InitDefault() {
+func (p *TraceServiceExtractSpanInfoResult) InitDefault() {
 }
 
-var TraceServiceUpdateManualAnnotationResult_Success_DEFAULT *UpdateManualAnnotationResponse
+var TraceServiceExtractSpanInfoResult_Success_DEFAULT *ExtractSpanInfoResponse
 
-func (p *TraceServiceUpdateManualAnnotationResult) GetSuccess() (v *UpdateManualAnnotationResponse) {
+func (p *TraceServiceExtractSpanInfoResult) GetSuccess() (v *ExtractSpanInfoResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceUpdateManualAnnotationResult_Success_DEFAULT
+		return TraceServiceExtractSpanInfoResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceUpdateManualAnnotationResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateManualAnnotationResponse)
+func (p *TraceServiceExtractSpanInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExtractSpanInfoResponse)
 }
 
-var fieldIDToName_TraceServiceUpdateManualAnnotationResult = map[int16]string{
+var fieldIDToName_TraceServiceExtractSpanInfoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) IsSetSuccess() bool {
+func (p *TraceServiceExtractSpanInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExtractSpanInfoResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -26721,7 +34750,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExtractSpanInfoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26731,8 +34760,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateManualAnnotationResponse()
+func (p *TraceServiceExtractSpanInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExtractSpanInfoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -26740,9 +34769,9 @@ func (p *TraceServiceUpdateManualAnnotationResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExtractSpanInfoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateManualAnnotation_result"); err != nil {
+	if err = oprot.WriteStructBegin("ExtractSpanInfo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26768,7 +34797,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExtractSpanInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -26787,15 +34816,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) String() string {
+func (p *TraceServiceExtractSpanInfoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceUpdateManualAnnotationResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceExtractSpanInfoResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) DeepEqual(ano *TraceServiceUpdateManualAnnotationResult) bool {
+func (p *TraceServiceExtractSpanInfoResult) DeepEqual(ano *TraceServiceExtractSpanInfoResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -26807,7 +34836,7 @@ func (p *TraceServiceUpdateManualAnnotationResult) DeepEqual(ano *TraceServiceUp
 	return true
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) Field0DeepEqual(src *UpdateManualAnnotationResponse) bool {
+func (p *TraceServiceExtractSpanInfoResult) Field0DeepEqual(src *ExtractSpanInfoResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -26815,41 +34844,41 @@ func (p *TraceServiceUpdateManualAnnotationResult) Field0DeepEqual(src *UpdateMa
 	return true
 }
 
-type TraceServiceDeleteManualAnnotationArgs struct {
-	Req *DeleteManualAnnotationRequest `thrift:"req,1" frugal:"1,default,DeleteManualAnnotationRequest"`
+type TraceServiceUpsertTrajectoryConfigArgs struct {
+	Req *UpsertTrajectoryConfigRequest `thrift:"req,1" frugal:"1,default,UpsertTrajectoryConfigRequest"`
 }
 
-func NewTraceServiceDeleteManualAnnotationArgs() *TraceServiceDeleteManualAnnotationArgs {
-	return &TraceServiceDeleteManualAnnotationArgs{}
+func NewTraceServiceUpsertTrajectoryConfigArgs() *TraceServiceUpsertTrajectoryConfigArgs {
+	return &TraceServiceUpsertTrajectoryConfigArgs{}
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) InitDefault() {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) InitDefault() {
 }
 
-var TraceServiceDeleteManualAnnotationArgs_Req_DEFAULT *DeleteManualAnnotationRequest
+var TraceServiceUpsertTrajectoryConfigArgs_Req_DEFAULT *UpsertTrajectoryConfigRequest
 
-func (p *TraceServiceDeleteManualAnnotationArgs) GetReq() (v *DeleteManualAnnotationRequest) {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) GetReq() (v *UpsertTrajectoryConfigRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceDeleteManualAnnotationArgs_Req_DEFAULT
+		return TraceServiceUpsertTrajectoryConfigArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceDeleteManualAnnotationArgs) SetReq(val *DeleteManualAnnotationRequest) {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) SetReq(val *UpsertTrajectoryConfigRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceDeleteManualAnnotationArgs = map[int16]string{
+var fieldIDToName_TraceServiceUpsertTrajectoryConfigArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) IsSetReq() bool {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -26894,7 +34923,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertTrajectoryConfigArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -26904,8 +34933,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteManualAnnotationRequest()
+func (p *TraceServiceUpsertTrajectoryConfigArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpsertTrajectoryConfigRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -26913,9 +34942,9 @@ func (p *TraceServiceDeleteManualAnnotationArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteManualAnnotation_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpsertTrajectoryConfig_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -26941,7 +34970,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -26958,15 +34987,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) String() string {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceDeleteManualAnnotationArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceUpsertTrajectoryConfigArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) DeepEqual(ano *TraceServiceDeleteManualAnnotationArgs) bool {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) DeepEqual(ano *TraceServiceUpsertTrajectoryConfigArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -26978,7 +35007,7 @@ func (p *TraceServiceDeleteManualAnnotationArgs) DeepEqual(ano *TraceServiceDele
 	return true
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) Field1DeepEqual(src *DeleteManualAnnotationRequest) bool {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) Field1DeepEqual(src *UpsertTrajectoryConfigRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -26986,41 +35015,41 @@ func (p *TraceServiceDeleteManualAnnotationArgs) Field1DeepEqual(src *DeleteManu
 	return true
 }
 
-type TraceServiceDeleteManualAnnotationResult struct {
-	Success *DeleteManualAnnotationResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteManualAnnotationResponse"`
+type TraceServiceUpsertTrajectoryConfigResult struct {
+	Success *UpsertTrajectoryConfigResponse `thrift:"success,0,optional" frugal:"0,optional,UpsertTrajectoryConfigResponse"`
 }
 
-func NewTraceServiceDeleteManualAnnotationResult() *TraceServiceDeleteManualAnnotationResult {
-	return &TraceServiceDeleteManualAnnotationResult{}
+func NewTraceServiceUpsertTrajectoryConfigResult() *TraceServiceUpsertTrajectoryConfigResult {
+	return &TraceServiceUpsertTrajectoryConfigResult{}
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) InitDefault() {
+func (p *TraceServiceUpsertTrajectoryConfigResult) InitDefault() {
 }
 
-var TraceServiceDeleteManualAnnotationResult_Success_DEFAULT *DeleteManualAnnotationResponse
+var TraceServiceUpsertTrajectoryConfigResult_Success_DEFAULT *UpsertTrajectoryConfigResponse
 
-func (p *TraceServiceDeleteManualAnnotationResult) GetSuccess() (v *DeleteManualAnnotationResponse) {
+func (p *TraceServiceUpsertTrajectoryConfigResult) GetSuccess() (v *UpsertTrajectoryConfigResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceDeleteManualAnnotationResult_Success_DEFAULT
+		return TraceServiceUpsertTrajectoryConfigResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceDeleteManualAnnotationResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteManualAnnotationResponse)
+func (p *TraceServiceUpsertTrajectoryConfigResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpsertTrajectoryConfigResponse)
 }
 
-var fieldIDToName_TraceServiceDeleteManualAnnotationResult = map[int16]string{
+var fieldIDToName_TraceServiceUpsertTrajectoryConfigResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) IsSetSuccess() bool {
+func (p *TraceServiceUpsertTrajectoryConfigResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertTrajectoryConfigResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -27065,7 +35094,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertTrajectoryConfigResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27075,8 +35104,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteManualAnnotationResponse()
+func (p *TraceServiceUpsertTrajectoryConfigResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpsertTrajectoryConfigResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -27084,9 +35113,9 @@ func (p *TraceServiceDeleteManualAnnotationResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertTrajectoryConfigResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteManualAnnotation_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpsertTrajectoryConfig_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27112,7 +35141,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertTrajectoryConfigResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -27131,15 +35160,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) String() string {
+func (p *TraceServiceUpsertTrajectoryConfigResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceDeleteManualAnnotationResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceUpsertTrajectoryConfigResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) DeepEqual(ano *TraceServiceDeleteManualAnnotationResult) bool {
+func (p *TraceServiceUpsertTrajectoryConfigResult) DeepEqual(ano *TraceServiceUpsertTrajectoryConfigResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -27151,7 +35180,7 @@ func (p *TraceServiceDeleteManualAnnotationResult) DeepEqual(ano *TraceServiceDe
 	return true
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) Field0DeepEqual(src *DeleteManualAnnotationResponse) bool {
+func (p *TraceServiceUpsertTrajectoryConfigResult) Field0DeepEqual(src *UpsertTrajectoryConfigResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -27159,41 +35188,41 @@ func (p *TraceServiceDeleteManualAnnotationResult) Field0DeepEqual(src *DeleteMa
 	return true
 }
 
-type TraceServiceListAnnotationsArgs struct {
-	Req *ListAnnotationsRequest `thrift:"req,1" frugal:"1,default,ListAnnotationsRequest"`
+type TraceServiceGetTrajectoryConfigArgs struct {
+	Req *GetTrajectoryConfigRequest `thrift:"req,1" frugal:"1,default,GetTrajectoryConfigRequest"`
 }
 
-func NewTraceServiceListAnnotationsArgs() *TraceServiceListAnnotationsArgs {
-	return &TraceServiceListAnnotationsArgs{}
+func NewTraceServiceGetTrajectoryConfigArgs() *TraceServiceGetTrajectoryConfigArgs {
+	return &TraceServiceGetTrajectoryConfigArgs{}
 }
 
-func (p *TraceServiceListAnnotationsArgs) InitDefault() {
+func (p *TraceServiceGetTrajectoryConfigArgs) InitDefault() {
 }
 
-var TraceServiceListAnnotationsArgs_Req_DEFAULT *ListAnnotationsRequest
+var TraceServiceGetTrajectoryConfigArgs_Req_DEFAULT *GetTrajectoryConfigRequest
 
-func (p *TraceServiceListAnnotationsArgs) GetReq() (v *ListAnnotationsRequest) {
+func (p *TraceServiceGetTrajectoryConfigArgs) GetReq() (v *GetTrajectoryConfigRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceListAnnotationsArgs_Req_DEFAULT
+		return TraceServiceGetTrajectoryConfigArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceListAnnotationsArgs) SetReq(val *ListAnnotationsRequest) {
+func (p *TraceServiceGetTrajectoryConfigArgs) SetReq(val *GetTrajectoryConfigRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceListAnnotationsArgs = map[int16]string{
+var fieldIDToName_TraceServiceGetTrajectoryConfigArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceListAnnotationsArgs) IsSetReq() bool {
+func (p *TraceServiceGetTrajectoryConfigArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceListAnnotationsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTrajectoryConfigArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -27238,7 +35267,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTrajectoryConfigArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27248,8 +35277,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListAnnotationsRequest()
+func (p *TraceServiceGetTrajectoryConfigArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetTrajectoryConfigRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -27257,9 +35286,9 @@ func (p *TraceServiceListAnnotationsArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *TraceServiceListAnnotationsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTrajectoryConfigArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListAnnotations_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTrajectoryConfig_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27285,7 +35314,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTrajectoryConfigArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -27302,15 +35331,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationsArgs) String() string {
+func (p *TraceServiceGetTrajectoryConfigArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListAnnotationsArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceGetTrajectoryConfigArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceListAnnotationsArgs) DeepEqual(ano *TraceServiceListAnnotationsArgs) bool {
+func (p *TraceServiceGetTrajectoryConfigArgs) DeepEqual(ano *TraceServiceGetTrajectoryConfigArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -27322,7 +35351,7 @@ func (p *TraceServiceListAnnotationsArgs) DeepEqual(ano *TraceServiceListAnnotat
 	return true
 }
 
-func (p *TraceServiceListAnnotationsArgs) Field1DeepEqual(src *ListAnnotationsRequest) bool {
+func (p *TraceServiceGetTrajectoryConfigArgs) Field1DeepEqual(src *GetTrajectoryConfigRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -27330,41 +35359,41 @@ func (p *TraceServiceListAnnotationsArgs) Field1DeepEqual(src *ListAnnotationsRe
 	return true
 }
 
-type TraceServiceListAnnotationsResult struct {
-	Success *ListAnnotationsResponse `thrift:"success,0,optional" frugal:"0,optional,ListAnnotationsResponse"`
+type TraceServiceGetTrajectoryConfigResult struct {
+	Success *GetTrajectoryConfigResponse `thrift:"success,0,optional" frugal:"0,optional,GetTrajectoryConfigResponse"`
 }
 
-func NewTraceServiceListAnnotationsResult() *TraceServiceListAnnotationsResult {
-	return &TraceServiceListAnnotationsResult{}
+func NewTraceServiceGetTrajectoryConfigResult() *TraceServiceGetTrajectoryConfigResult {
+	return &TraceServiceGetTrajectoryConfigResult{}
 }
 
-func (p *TraceServiceListAnnotationsResult) InitDefault() {
+func (p *TraceServiceGetTrajectoryConfigResult) InitDefault() {
 }
 
-var TraceServiceListAnnotationsResult_Success_DEFAULT *ListAnnotationsResponse
+var TraceServiceGetTrajectoryConfigResult_Success_DEFAULT *GetTrajectoryConfigResponse
 
-func (p *TraceServiceListAnnotationsResult) GetSuccess() (v *ListAnnotationsResponse) {
+func (p *TraceServiceGetTrajectoryConfigResult) GetSuccess() (v *GetTrajectoryConfigResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceListAnnotationsResult_Success_DEFAULT
+		return TraceServiceGetTrajectoryConfigResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceListAnnotationsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListAnnotationsResponse)
+func (p *TraceServiceGetTrajectoryConfigResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetTrajectoryConfigResponse)
 }
 
-var fieldIDToName_TraceServiceListAnnotationsResult = map[int16]string{
+var fieldIDToName_TraceServiceGetTrajectoryConfigResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceListAnnotationsResult) IsSetSuccess() bool {
+func (p *TraceServiceGetTrajectoryConfigResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceListAnnotationsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTrajectoryConfigResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -27409,7 +35438,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTrajectoryConfigResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27419,8 +35448,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListAnnotationsResponse()
+func (p *TraceServiceGetTrajectoryConfigResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetTrajectoryConfigResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -27428,9 +35457,9 @@ func (p *TraceServiceListAnnotationsResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *TraceServiceListAnnotationsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTrajectoryConfigResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListAnnotations_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTrajectoryConfig_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27456,7 +35485,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTrajectoryConfigResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -27475,15 +35504,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationsResult) String() string {
+func (p *TraceServiceGetTrajectoryConfigResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListAnnotationsResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceGetTrajectoryConfigResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceListAnnotationsResult) DeepEqual(ano *TraceServiceListAnnotationsResult) bool {
+func (p *TraceServiceGetTrajectoryConfigResult) DeepEqual(ano *TraceServiceGetTrajectoryConfigResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -27495,7 +35524,7 @@ func (p *TraceServiceListAnnotationsResult) DeepEqual(ano *TraceServiceListAnnot
 	return true
 }
 
-func (p *TraceServiceListAnnotationsResult) Field0DeepEqual(src *ListAnnotationsResponse) bool {
+func (p *TraceServiceGetTrajectoryConfigResult) Field0DeepEqual(src *GetTrajectoryConfigResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -27503,41 +35532,41 @@ func (p *TraceServiceListAnnotationsResult) Field0DeepEqual(src *ListAnnotations
 	return true
 }
 
-type TraceServiceExportTracesToDatasetArgs struct {
-	Req *ExportTracesToDatasetRequest `thrift:"Req,1" frugal:"1,default,ExportTracesToDatasetRequest"`
+type TraceServiceListTrajectoryArgs struct {
+	Req *ListTrajectoryRequest `thrift:"req,1" frugal:"1,default,ListTrajectoryRequest"`
 }
 
-func NewTraceServiceExportTracesToDatasetArgs() *TraceServiceExportTracesToDatasetArgs {
-	return &TraceServiceExportTracesToDatasetArgs{}
+func NewTraceServiceListTrajectoryArgs() *TraceServiceListTrajectoryArgs {
+	return &TraceServiceListTrajectoryArgs{}
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) InitDefault() {
+func (p *TraceServiceListTrajectoryArgs) InitDefault() {
 }
 
-var TraceServiceExportTracesToDatasetArgs_Req_DEFAULT *ExportTracesToDatasetRequest
+var TraceServiceListTrajectoryArgs_Req_DEFAULT *ListTrajectoryRequest
 
-func (p *TraceServiceExportTracesToDatasetArgs) GetReq() (v *ExportTracesToDatasetRequest) {
+func (p *TraceServiceListTrajectoryArgs) GetReq() (v *ListTrajectoryRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceExportTracesToDatasetArgs_Req_DEFAULT
+		return TraceServiceListTrajectoryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceExportTracesToDatasetArgs) SetReq(val *ExportTracesToDatasetRequest) {
+func (p *TraceServiceListTrajectoryArgs) SetReq(val *ListTrajectoryRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceExportTracesToDatasetArgs = map[int16]string{
-	1: "Req",
+var fieldIDToName_TraceServiceListTrajectoryArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) IsSetReq() bool {
+func (p *TraceServiceListTrajectoryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListTrajectoryArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -27582,7 +35611,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListTrajectoryArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27592,8 +35621,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewExportTracesToDatasetRequest()
+func (p *TraceServiceListTrajectoryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListTrajectoryRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -27601,9 +35630,9 @@ func (p *TraceServiceExportTracesToDatasetArgs) ReadField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListTrajectoryArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExportTracesToDataset_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListTrajectory_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27629,8 +35658,8 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("Req", thrift.STRUCT, 1); err != nil {
+func (p *TraceServiceListTrajectoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := p.Req.Write(oprot); err != nil {
@@ -27646,15 +35675,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) String() string {
+func (p *TraceServiceListTrajectoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceExportTracesToDatasetArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListTrajectoryArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) DeepEqual(ano *TraceServiceExportTracesToDatasetArgs) bool {
+func (p *TraceServiceListTrajectoryArgs) DeepEqual(ano *TraceServiceListTrajectoryArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -27666,7 +35695,7 @@ func (p *TraceServiceExportTracesToDatasetArgs) DeepEqual(ano *TraceServiceExpor
 	return true
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) Field1DeepEqual(src *ExportTracesToDatasetRequest) bool {
+func (p *TraceServiceListTrajectoryArgs) Field1DeepEqual(src *ListTrajectoryRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -27674,41 +35703,41 @@ func (p *TraceServiceExportTracesToDatasetArgs) Field1DeepEqual(src *ExportTrace
 	return true
 }
 
-type TraceServiceExportTracesToDatasetResult struct {
-	Success *ExportTracesToDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,ExportTracesToDatasetResponse"`
+type TraceServiceListTrajectoryResult struct {
+	Success *ListTrajectoryResponse `thrift:"success,0,optional" frugal:"0,optional,ListTrajectoryResponse"`
 }
 
-func NewTraceServiceExportTracesToDatasetResult() *TraceServiceExportTracesToDatasetResult {
-	return &TraceServiceExportTracesToDatasetResult{}
+func NewTraceServiceListTrajectoryResult() *TraceServiceListTrajectoryResult {
+	return &TraceServiceListTrajectoryResult{}
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) InitDefault() {
+func (p *TraceServiceListTrajectoryResult) InitDefault() {
 }
 
-var TraceServiceExportTracesToDatasetResult_Success_DEFAULT *ExportTracesToDatasetResponse
+var TraceServiceListTrajectoryResult_Success_DEFAULT *ListTrajectoryResponse
 
-func (p *TraceServiceExportTracesToDatasetResult) GetSuccess() (v *ExportTracesToDatasetResponse) {
+func (p *TraceServiceListTrajectoryResult) GetSuccess() (v *ListTrajectoryResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceExportTracesToDatasetResult_Success_DEFAULT
+		return TraceServiceListTrajectoryResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceExportTracesToDatasetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ExportTracesToDatasetResponse)
+func (p *TraceServiceListTrajectoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListTrajectoryResponse)
 }
 
-var fieldIDToName_TraceServiceExportTracesToDatasetResult = map[int16]string{
+var fieldIDToName_TraceServiceListTrajectoryResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) IsSetSuccess() bool {
+func (p *TraceServiceListTrajectoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListTrajectoryResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -27753,7 +35782,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListTrajectoryResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27763,8 +35792,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewExportTracesToDatasetResponse()
+func (p *TraceServiceListTrajectoryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListTrajectoryResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -27772,9 +35801,9 @@ func (p *TraceServiceExportTracesToDatasetResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListTrajectoryResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExportTracesToDataset_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListTrajectory_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27800,7 +35829,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListTrajectoryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -27819,15 +35848,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) String() string {
+func (p *TraceServiceListTrajectoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceExportTracesToDatasetResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListTrajectoryResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) DeepEqual(ano *TraceServiceExportTracesToDatasetResult) bool {
+func (p *TraceServiceListTrajectoryResult) DeepEqual(ano *TraceServiceListTrajectoryResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -27839,7 +35868,7 @@ func (p *TraceServiceExportTracesToDatasetResult) DeepEqual(ano *TraceServiceExp
 	return true
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) Field0DeepEqual(src *ExportTracesToDatasetResponse) bool {
+func (p *TraceServiceListTrajectoryResult) Field0DeepEqual(src *ListTrajectoryResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -27847,41 +35876,41 @@ func (p *TraceServiceExportTracesToDatasetResult) Field0DeepEqual(src *ExportTra
 	return true
 }
 
-type TraceServicePreviewExportTracesToDatasetArgs struct {
-	Req *PreviewExportTracesToDatasetRequest `thrift:"Req,1" frugal:"1,default,PreviewExportTracesToDatasetRequest"`
+type TraceServiceUpsertRetentionPolicyArgs struct {
+	Req *UpsertRetentionPolicyRequest `thrift:"req,1" frugal:"1,default,UpsertRetentionPolicyRequest"`
 }
 
-func NewTraceServicePreviewExportTracesToDatasetArgs() *TraceServicePreviewExportTracesToDatasetArgs {
-	return &TraceServicePreviewExportTracesToDatasetArgs{}
+func NewTraceServiceUpsertRetentionPolicyArgs() *TraceServiceUpsertRetentionPolicyArgs {
+	return &TraceServiceUpsertRetentionPolicyArgs{}
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) InitDefault() {
+func (p *TraceServiceUpsertRetentionPolicyArgs) InitDefault() {
 }
 
-var TraceServicePreviewExportTracesToDatasetArgs_Req_DEFAULT *PreviewExportTracesToDatasetRequest
+var TraceServiceUpsertRetentionPolicyArgs_Req_DEFAULT *UpsertRetentionPolicyRequest
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) GetReq() (v *PreviewExportTracesToDatasetRequest) {
+func (p *TraceServiceUpsertRetentionPolicyArgs) GetReq() (v *UpsertRetentionPolicyRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServicePreviewExportTracesToDatasetArgs_Req_DEFAULT
+		return TraceServiceUpsertRetentionPolicyArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServicePreviewExportTracesToDatasetArgs) SetReq(val *PreviewExportTracesToDatasetRequest) {
+func (p *TraceServiceUpsertRetentionPolicyArgs) SetReq(val *UpsertRetentionPolicyRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServicePreviewExportTracesToDatasetArgs = map[int16]string{
-	1: "Req",
+var fieldIDToName_TraceServiceUpsertRetentionPolicyArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) IsSetReq() bool {
+func (p *TraceServiceUpsertRetentionPolicyArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertRetentionPolicyArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -27926,7 +35955,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertRetentionPolicyArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -27936,8 +35965,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPreviewExportTracesToDatasetRequest()
+func (p *TraceServiceUpsertRetentionPolicyArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpsertRetentionPolicyRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -27945,9 +35974,9 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) ReadField1(iprot thrift.T
 	return nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertRetentionPolicyArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PreviewExportTracesToDataset_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpsertRetentionPolicy_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -27973,8 +36002,8 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("Req", thrift.STRUCT, 1); err != nil {
+func (p *TraceServiceUpsertRetentionPolicyArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := p.Req.Write(oprot); err != nil {
@@ -27990,15 +36019,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) String() string {
+func (p *TraceServiceUpsertRetentionPolicyArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServicePreviewExportTracesToDatasetArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceUpsertRetentionPolicyArgs(%+v)", *p)
 
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) DeepEqual(ano *TraceServicePreviewExportTracesToDatasetArgs) bool {
+func (p *TraceServiceUpsertRetentionPolicyArgs) DeepEqual(ano *TraceServiceUpsertRetentionPolicyArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28010,7 +36039,7 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) DeepEqual(ano *TraceServi
 	return true
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) Field1DeepEqual(src *PreviewExportTracesToDatasetRequest) bool {
+func (p *TraceServiceUpsertRetentionPolicyArgs) Field1DeepEqual(src *UpsertRetentionPolicyRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -28018,41 +36047,41 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) Field1DeepEqual(src *Prev
 	return true
 }
 
-type TraceServicePreviewExportTracesToDatasetResult struct {
-	Success *PreviewExportTracesToDatasetResponse `thrift:"success,0,optional" frugal:"0,optional,PreviewExportTracesToDatasetResponse"`
+type TraceServiceUpsertRetentionPolicyResult struct {
+	Success *UpsertRetentionPolicyResponse `thrift:"success,0,optional" frugal:"0,optional,UpsertRetentionPolicyResponse"`
 }
 
-func NewTraceServicePreviewExportTracesToDatasetResult() *TraceServicePreviewExportTracesToDatasetResult {
-	return &TraceServicePreviewExportTracesToDatasetResult{}
+func NewTraceServiceUpsertRetentionPolicyResult() *TraceServiceUpsertRetentionPolicyResult {
+	return &TraceServiceUpsertRetentionPolicyResult{}
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) InitDefault() {
+func (p *TraceServiceUpsertRetentionPolicyResult) InitDefault() {
 }
 
-var TraceServicePreviewExportTracesToDatasetResult_Success_DEFAULT *PreviewExportTracesToDatasetResponse
+var TraceServiceUpsertRetentionPolicyResult_Success_DEFAULT *UpsertRetentionPolicyResponse
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) GetSuccess() (v *PreviewExportTracesToDatasetResponse) {
+func (p *TraceServiceUpsertRetentionPolicyResult) GetSuccess() (v *UpsertRetentionPolicyResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServicePreviewExportTracesToDatasetResult_Success_DEFAULT
+		return TraceServiceUpsertRetentionPolicyResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServicePreviewExportTracesToDatasetResult) SetSuccess(x interface{}) {
-	p.Success = x.(*PreviewExportTracesToDatasetResponse)
+func (p *TraceServiceUpsertRetentionPolicyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpsertRetentionPolicyResponse)
 }
 
-var fieldIDToName_TraceServicePreviewExportTracesToDatasetResult = map[int16]string{
+var fieldIDToName_TraceServiceUpsertRetentionPolicyResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) IsSetSuccess() bool {
+func (p *TraceServiceUpsertRetentionPolicyResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertRetentionPolicyResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28097,7 +36126,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertRetentionPolicyResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28107,8 +36136,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewPreviewExportTracesToDatasetResponse()
+func (p *TraceServiceUpsertRetentionPolicyResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpsertRetentionPolicyResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28116,9 +36145,9 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) ReadField0(iprot thrift
 	return nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertRetentionPolicyResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PreviewExportTracesToDataset_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpsertRetentionPolicy_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28144,7 +36173,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceUpsertRetentionPolicyResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -28163,15 +36192,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) String() string {
+func (p *TraceServiceUpsertRetentionPolicyResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServicePreviewExportTracesToDatasetResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceUpsertRetentionPolicyResult(%+v)", *p)
 
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) DeepEqual(ano *TraceServicePreviewExportTracesToDatasetResult) bool {
+func (p *TraceServiceUpsertRetentionPolicyResult) DeepEqual(ano *TraceServiceUpsertRetentionPolicyResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28183,7 +36212,7 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) DeepEqual(ano *TraceSer
 	return true
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) Field0DeepEqual(src *PreviewExportTracesToDatasetResponse) bool {
+func (p *TraceServiceUpsertRetentionPolicyResult) Field0DeepEqual(src *UpsertRetentionPolicyResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -28191,41 +36220,41 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) Field0DeepEqual(src *Pr
 	return true
 }
 
-type TraceServiceChangeEvaluatorScoreArgs struct {
-	Req *ChangeEvaluatorScoreRequest `thrift:"req,1" frugal:"1,default,ChangeEvaluatorScoreRequest"`
+type TraceServiceGetRetentionPolicyArgs struct {
+	Req *GetRetentionPolicyRequest `thrift:"req,1" frugal:"1,default,GetRetentionPolicyRequest"`
 }
 
-func NewTraceServiceChangeEvaluatorScoreArgs() *TraceServiceChangeEvaluatorScoreArgs {
-	return &TraceServiceChangeEvaluatorScoreArgs{}
+func NewTraceServiceGetRetentionPolicyArgs() *TraceServiceGetRetentionPolicyArgs {
+	return &TraceServiceGetRetentionPolicyArgs{}
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) InitDefault() {
+func (p *TraceServiceGetRetentionPolicyArgs) InitDefault() {
 }
 
-var TraceServiceChangeEvaluatorScoreArgs_Req_DEFAULT *ChangeEvaluatorScoreRequest
+var TraceServiceGetRetentionPolicyArgs_Req_DEFAULT *GetRetentionPolicyRequest
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) GetReq() (v *ChangeEvaluatorScoreRequest) {
+func (p *TraceServiceGetRetentionPolicyArgs) GetReq() (v *GetRetentionPolicyRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceChangeEvaluatorScoreArgs_Req_DEFAULT
+		return TraceServiceGetRetentionPolicyArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceChangeEvaluatorScoreArgs) SetReq(val *ChangeEvaluatorScoreRequest) {
+func (p *TraceServiceGetRetentionPolicyArgs) SetReq(val *GetRetentionPolicyRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceChangeEvaluatorScoreArgs = map[int16]string{
+var fieldIDToName_TraceServiceGetRetentionPolicyArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) IsSetReq() bool {
+func (p *TraceServiceGetRetentionPolicyArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetRetentionPolicyArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28270,7 +36299,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceChangeEvaluatorScoreArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetRetentionPolicyArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28280,8 +36309,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewChangeEvaluatorScoreRequest()
+func (p *TraceServiceGetRetentionPolicyArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetRetentionPolicyRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28289,9 +36318,9 @@ func (p *TraceServiceChangeEvaluatorScoreArgs) ReadField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetRetentionPolicyArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChangeEvaluatorScore_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetRetentionPolicy_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28317,7 +36346,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetRetentionPolicyArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -28334,15 +36363,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) String() string {
+func (p *TraceServiceGetRetentionPolicyArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceChangeEvaluatorScoreArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceGetRetentionPolicyArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) DeepEqual(ano *TraceServiceChangeEvaluatorScoreArgs) bool {
+func (p *TraceServiceGetRetentionPolicyArgs) DeepEqual(ano *TraceServiceGetRetentionPolicyArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28354,7 +36383,7 @@ func (p *TraceServiceChangeEvaluatorScoreArgs) DeepEqual(ano *TraceServiceChange
 	return true
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) Field1DeepEqual(src *ChangeEvaluatorScoreRequest) bool {
+func (p *TraceServiceGetRetentionPolicyArgs) Field1DeepEqual(src *GetRetentionPolicyRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -28362,41 +36391,41 @@ func (p *TraceServiceChangeEvaluatorScoreArgs) Field1DeepEqual(src *ChangeEvalua
 	return true
 }
 
-type TraceServiceChangeEvaluatorScoreResult struct {
-	Success *ChangeEvaluatorScoreResponse `thrift:"success,0,optional" frugal:"0,optional,ChangeEvaluatorScoreResponse"`
+type TraceServiceGetRetentionPolicyResult struct {
+	Success *GetRetentionPolicyResponse `thrift:"success,0,optional" frugal:"0,optional,GetRetentionPolicyResponse"`
 }
 
-func NewTraceServiceChangeEvaluatorScoreResult() *TraceServiceChangeEvaluatorScoreResult {
-	return &TraceServiceChangeEvaluatorScoreResult{}
+func NewTraceServiceGetRetentionPolicyResult() *TraceServiceGetRetentionPolicyResult {
+	return &TraceServiceGetRetentionPolicyResult{}
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) InitDefault() {
+func (p *TraceServiceGetRetentionPolicyResult) InitDefault() {
 }
 
-var TraceServiceChangeEvaluatorScoreResult_Success_DEFAULT *ChangeEvaluatorScoreResponse
+var TraceServiceGetRetentionPolicyResult_Success_DEFAULT *GetRetentionPolicyResponse
 
-func (p *TraceServiceChangeEvaluatorScoreResult) GetSuccess() (v *ChangeEvaluatorScoreResponse) {
+func (p *TraceServiceGetRetentionPolicyResult) GetSuccess() (v *GetRetentionPolicyResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceChangeEvaluatorScoreResult_Success_DEFAULT
+		return TraceServiceGetRetentionPolicyResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceChangeEvaluatorScoreResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ChangeEvaluatorScoreResponse)
+func (p *TraceServiceGetRetentionPolicyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetRetentionPolicyResponse)
 }
 
-var fieldIDToName_TraceServiceChangeEvaluatorScoreResult = map[int16]string{
+var fieldIDToName_TraceServiceGetRetentionPolicyResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) IsSetSuccess() bool {
+func (p *TraceServiceGetRetentionPolicyResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetRetentionPolicyResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28441,7 +36470,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceChangeEvaluatorScoreResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetRetentionPolicyResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28451,8 +36480,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewChangeEvaluatorScoreResponse()
+func (p *TraceServiceGetRetentionPolicyResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRetentionPolicyResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28460,9 +36489,9 @@ func (p *TraceServiceChangeEvaluatorScoreResult) ReadField0(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetRetentionPolicyResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChangeEvaluatorScore_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetRetentionPolicy_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28488,7 +36517,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetRetentionPolicyResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -28507,15 +36536,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) String() string {
+func (p *TraceServiceGetRetentionPolicyResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceChangeEvaluatorScoreResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceGetRetentionPolicyResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) DeepEqual(ano *TraceServiceChangeEvaluatorScoreResult) bool {
+func (p *TraceServiceGetRetentionPolicyResult) DeepEqual(ano *TraceServiceGetRetentionPolicyResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28527,7 +36556,7 @@ func (p *TraceServiceChangeEvaluatorScoreResult) DeepEqual(ano *TraceServiceChan
 	return true
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) Field0DeepEqual(src *ChangeEvaluatorScoreResponse) bool {
+func (p *TraceServiceGetRetentionPolicyResult) Field0DeepEqual(src *GetRetentionPolicyResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -28535,41 +36564,41 @@ func (p *TraceServiceChangeEvaluatorScoreResult) Field0DeepEqual(src *ChangeEval
 	return true
 }
 
-type TraceServiceListAnnotationEvaluatorsArgs struct {
-	Req *ListAnnotationEvaluatorsRequest `thrift:"req,1" frugal:"1,default,ListAnnotationEvaluatorsRequest"`
+type TraceServiceDiffTracesArgs struct {
+	Req *DiffTracesRequest `thrift:"req,1" frugal:"1,default,DiffTracesRequest"`
 }
 
-func NewTraceServiceListAnnotationEvaluatorsArgs() *TraceServiceListAnnotationEvaluatorsArgs {
-	return &TraceServiceListAnnotationEvaluatorsArgs{}
+func NewTraceServiceDiffTracesArgs() *TraceServiceDiffTracesArgs {
+	return &TraceServiceDiffTracesArgs{}
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) InitDefault() {
+func (p *TraceServiceDiffTracesArgs) InitDefault() {
 }
 
-var TraceServiceListAnnotationEvaluatorsArgs_Req_DEFAULT *ListAnnotationEvaluatorsRequest
+var TraceServiceDiffTracesArgs_Req_DEFAULT *DiffTracesRequest
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) GetReq() (v *ListAnnotationEvaluatorsRequest) {
+func (p *TraceServiceDiffTracesArgs) GetReq() (v *DiffTracesRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceListAnnotationEvaluatorsArgs_Req_DEFAULT
+		return TraceServiceDiffTracesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceListAnnotationEvaluatorsArgs) SetReq(val *ListAnnotationEvaluatorsRequest) {
+func (p *TraceServiceDiffTracesArgs) SetReq(val *DiffTracesRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceListAnnotationEvaluatorsArgs = map[int16]string{
+var fieldIDToName_TraceServiceDiffTracesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) IsSetReq() bool {
+func (p *TraceServiceDiffTracesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDiffTracesArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28614,7 +36643,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationEvaluatorsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDiffTracesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28624,8 +36653,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListAnnotationEvaluatorsRequest()
+func (p *TraceServiceDiffTracesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDiffTracesRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28633,9 +36662,9 @@ func (p *TraceServiceListAnnotationEvaluatorsArgs) ReadField1(iprot thrift.TProt
 	return nil
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDiffTracesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListAnnotationEvaluators_args"); err != nil {
+	if err = oprot.WriteStructBegin("DiffTraces_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28661,7 +36690,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDiffTracesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -28678,15 +36707,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) String() string {
+func (p *TraceServiceDiffTracesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListAnnotationEvaluatorsArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceDiffTracesArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) DeepEqual(ano *TraceServiceListAnnotationEvaluatorsArgs) bool {
+func (p *TraceServiceDiffTracesArgs) DeepEqual(ano *TraceServiceDiffTracesArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28698,7 +36727,7 @@ func (p *TraceServiceListAnnotationEvaluatorsArgs) DeepEqual(ano *TraceServiceLi
 	return true
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) Field1DeepEqual(src *ListAnnotationEvaluatorsRequest) bool {
+func (p *TraceServiceDiffTracesArgs) Field1DeepEqual(src *DiffTracesRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -28706,41 +36735,41 @@ func (p *TraceServiceListAnnotationEvaluatorsArgs) Field1DeepEqual(src *ListAnno
 	return true
 }
 
-type TraceServiceListAnnotationEvaluatorsResult struct {
-	Success *ListAnnotationEvaluatorsResponse `thrift:"success,0,optional" frugal:"0,optional,ListAnnotationEvaluatorsResponse"`
+type TraceServiceDiffTracesResult struct {
+	Success *DiffTracesResponse `thrift:"success,0,optional" frugal:"0,optional,DiffTracesResponse"`
 }
 
-func NewTraceServiceListAnnotationEvaluatorsResult() *TraceServiceListAnnotationEvaluatorsResult {
-	return &TraceServiceListAnnotationEvaluatorsResult{}
+func NewTraceServiceDiffTracesResult() *TraceServiceDiffTracesResult {
+	return &TraceServiceDiffTracesResult{}
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) InitDefault() {
+func (p *TraceServiceDiffTracesResult) InitDefault() {
 }
 
-var TraceServiceListAnnotationEvaluatorsResult_Success_DEFAULT *ListAnnotationEvaluatorsResponse
+var TraceServiceDiffTracesResult_Success_DEFAULT *DiffTracesResponse
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) GetSuccess() (v *ListAnnotationEvaluatorsResponse) {
+func (p *TraceServiceDiffTracesResult) GetSuccess() (v *DiffTracesResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceListAnnotationEvaluatorsResult_Success_DEFAULT
+		return TraceServiceDiffTracesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceListAnnotationEvaluatorsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListAnnotationEvaluatorsResponse)
+func (p *TraceServiceDiffTracesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DiffTracesResponse)
 }
 
-var fieldIDToName_TraceServiceListAnnotationEvaluatorsResult = map[int16]string{
+var fieldIDToName_TraceServiceDiffTracesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) IsSetSuccess() bool {
+func (p *TraceServiceDiffTracesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDiffTracesResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28785,7 +36814,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationEvaluatorsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDiffTracesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28795,8 +36824,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListAnnotationEvaluatorsResponse()
+func (p *TraceServiceDiffTracesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDiffTracesResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28804,9 +36833,9 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) ReadField0(iprot thrift.TPr
 	return nil
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDiffTracesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListAnnotationEvaluators_result"); err != nil {
+	if err = oprot.WriteStructBegin("DiffTraces_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -28832,7 +36861,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceDiffTracesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -28851,15 +36880,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) String() string {
+func (p *TraceServiceDiffTracesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListAnnotationEvaluatorsResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceDiffTracesResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) DeepEqual(ano *TraceServiceListAnnotationEvaluatorsResult) bool {
+func (p *TraceServiceDiffTracesResult) DeepEqual(ano *TraceServiceDiffTracesResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -28871,7 +36900,7 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) DeepEqual(ano *TraceService
 	return true
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) Field0DeepEqual(src *ListAnnotationEvaluatorsResponse) bool {
+func (p *TraceServiceDiffTracesResult) Field0DeepEqual(src *DiffTracesResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -28879,41 +36908,41 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) Field0DeepEqual(src *ListAn
 	return true
 }
 
-type TraceServiceExtractSpanInfoArgs struct {
-	Req *ExtractSpanInfoRequest `thrift:"req,1" frugal:"1,default,ExtractSpanInfoRequest"`
+type TraceServiceListSessionsArgs struct {
+	Req *ListSessionsRequest `thrift:"req,1" frugal:"1,default,ListSessionsRequest"`
 }
 
-func NewTraceServiceExtractSpanInfoArgs() *TraceServiceExtractSpanInfoArgs {
-	return &TraceServiceExtractSpanInfoArgs{}
+func NewTraceServiceListSessionsArgs() *TraceServiceListSessionsArgs {
+	return &TraceServiceListSessionsArgs{}
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) InitDefault() {
+func (p *TraceServiceListSessionsArgs) InitDefault() {
 }
 
-var TraceServiceExtractSpanInfoArgs_Req_DEFAULT *ExtractSpanInfoRequest
+var TraceServiceListSessionsArgs_Req_DEFAULT *ListSessionsRequest
 
-func (p *TraceServiceExtractSpanInfoArgs) GetReq() (v *ExtractSpanInfoRequest) {
+func (p *TraceServiceListSessionsArgs) GetReq() (v *ListSessionsRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceExtractSpanInfoArgs_Req_DEFAULT
+		return TraceServiceListSessionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceExtractSpanInfoArgs) SetReq(val *ExtractSpanInfoRequest) {
+func (p *TraceServiceListSessionsArgs) SetReq(val *ListSessionsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceExtractSpanInfoArgs = map[int16]string{
+var fieldIDToName_TraceServiceListSessionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) IsSetReq() bool {
+func (p *TraceServiceListSessionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListSessionsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -28958,7 +36987,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExtractSpanInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSessionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -28968,8 +36997,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewExtractSpanInfoRequest()
+func (p *TraceServiceListSessionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListSessionsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -28977,9 +37006,9 @@ func (p *TraceServiceExtractSpanInfoArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListSessionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExtractSpanInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListSessions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29005,7 +37034,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListSessionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -29022,15 +37051,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) String() string {
+func (p *TraceServiceListSessionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceExtractSpanInfoArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListSessionsArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) DeepEqual(ano *TraceServiceExtractSpanInfoArgs) bool {
+func (p *TraceServiceListSessionsArgs) DeepEqual(ano *TraceServiceListSessionsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29042,7 +37071,7 @@ func (p *TraceServiceExtractSpanInfoArgs) DeepEqual(ano *TraceServiceExtractSpan
 	return true
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) Field1DeepEqual(src *ExtractSpanInfoRequest) bool {
+func (p *TraceServiceListSessionsArgs) Field1DeepEqual(src *ListSessionsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -29050,41 +37079,41 @@ func (p *TraceServiceExtractSpanInfoArgs) Field1DeepEqual(src *ExtractSpanInfoRe
 	return true
 }
 
-type TraceServiceExtractSpanInfoResult struct {
-	Success *ExtractSpanInfoResponse `thrift:"success,0,optional" frugal:"0,optional,ExtractSpanInfoResponse"`
+type TraceServiceListSessionsResult struct {
+	Success *ListSessionsResponse `thrift:"success,0,optional" frugal:"0,optional,ListSessionsResponse"`
 }
 
-func NewTraceServiceExtractSpanInfoResult() *TraceServiceExtractSpanInfoResult {
-	return &TraceServiceExtractSpanInfoResult{}
+func NewTraceServiceListSessionsResult() *TraceServiceListSessionsResult {
+	return &TraceServiceListSessionsResult{}
 }
 
-func (p *TraceServiceExtractSpanInfoResult) InitDefault() {
+func (p *TraceServiceListSessionsResult) InitDefault() {
 }
 
-var TraceServiceExtractSpanInfoResult_Success_DEFAULT *ExtractSpanInfoResponse
+var TraceServiceListSessionsResult_Success_DEFAULT *ListSessionsResponse
 
-func (p *TraceServiceExtractSpanInfoResult) GetSuccess() (v *ExtractSpanInfoResponse) {
+func (p *TraceServiceListSessionsResult) GetSuccess() (v *ListSessionsResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceExtractSpanInfoResult_Success_DEFAULT
+		return TraceServiceListSessionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceExtractSpanInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ExtractSpanInfoResponse)
+func (p *TraceServiceListSessionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListSessionsResponse)
 }
 
-var fieldIDToName_TraceServiceExtractSpanInfoResult = map[int16]string{
+var fieldIDToName_TraceServiceListSessionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceExtractSpanInfoResult) IsSetSuccess() bool {
+func (p *TraceServiceListSessionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceExtractSpanInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListSessionsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29129,7 +37158,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExtractSpanInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSessionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29139,8 +37168,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceExtractSpanInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewExtractSpanInfoResponse()
+func (p *TraceServiceListSessionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListSessionsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29148,9 +37177,9 @@ func (p *TraceServiceExtractSpanInfoResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *TraceServiceExtractSpanInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListSessionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ExtractSpanInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListSessions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29176,7 +37205,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceExtractSpanInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceListSessionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -29195,15 +37224,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceExtractSpanInfoResult) String() string {
+func (p *TraceServiceListSessionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceExtractSpanInfoResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceListSessionsResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceExtractSpanInfoResult) DeepEqual(ano *TraceServiceExtractSpanInfoResult) bool {
+func (p *TraceServiceListSessionsResult) DeepEqual(ano *TraceServiceListSessionsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29215,7 +37244,7 @@ func (p *TraceServiceExtractSpanInfoResult) DeepEqual(ano *TraceServiceExtractSp
 	return true
 }
 
-func (p *TraceServiceExtractSpanInfoResult) Field0DeepEqual(src *ExtractSpanInfoResponse) bool {
+func (p *TraceServiceListSessionsResult) Field0DeepEqual(src *ListSessionsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -29223,41 +37252,41 @@ func (p *TraceServiceExtractSpanInfoResult) Field0DeepEqual(src *ExtractSpanInfo
 	return true
 }
 
-type TraceServiceUpsertTrajectoryConfigArgs struct {
-	Req *UpsertTrajectoryConfigRequest `thrift:"req,1" frugal:"1,default,UpsertTrajectoryConfigRequest"`
+type TraceServiceGetSessionArgs struct {
+	Req *GetSessionRequest `thrift:"req,1" frugal:"1,default,GetSessionRequest"`
 }
 
-func NewTraceServiceUpsertTrajectoryConfigArgs() *TraceServiceUpsertTrajectoryConfigArgs {
-	return &TraceServiceUpsertTrajectoryConfigArgs{}
+func NewTraceServiceGetSessionArgs() *TraceServiceGetSessionArgs {
+	return &TraceServiceGetSessionArgs{}
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) InitDefault() {
+func (p *TraceServiceGetSessionArgs) InitDefault() {
 }
 
-var TraceServiceUpsertTrajectoryConfigArgs_Req_DEFAULT *UpsertTrajectoryConfigRequest
+var TraceServiceGetSessionArgs_Req_DEFAULT *GetSessionRequest
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) GetReq() (v *UpsertTrajectoryConfigRequest) {
+func (p *TraceServiceGetSessionArgs) GetReq() (v *GetSessionRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceUpsertTrajectoryConfigArgs_Req_DEFAULT
+		return TraceServiceGetSessionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceUpsertTrajectoryConfigArgs) SetReq(val *UpsertTrajectoryConfigRequest) {
+func (p *TraceServiceGetSessionArgs) SetReq(val *GetSessionRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceUpsertTrajectoryConfigArgs = map[int16]string{
+var fieldIDToName_TraceServiceGetSessionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) IsSetReq() bool {
+func (p *TraceServiceGetSessionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetSessionArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29302,7 +37331,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertTrajectoryConfigArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetSessionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29312,8 +37341,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpsertTrajectoryConfigRequest()
+func (p *TraceServiceGetSessionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetSessionRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29321,9 +37350,9 @@ func (p *TraceServiceUpsertTrajectoryConfigArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetSessionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpsertTrajectoryConfig_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetSession_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29349,7 +37378,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetSessionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -29366,15 +37395,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) String() string {
+func (p *TraceServiceGetSessionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceUpsertTrajectoryConfigArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceGetSessionArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) DeepEqual(ano *TraceServiceUpsertTrajectoryConfigArgs) bool {
+func (p *TraceServiceGetSessionArgs) DeepEqual(ano *TraceServiceGetSessionArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29386,7 +37415,7 @@ func (p *TraceServiceUpsertTrajectoryConfigArgs) DeepEqual(ano *TraceServiceUpse
 	return true
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) Field1DeepEqual(src *UpsertTrajectoryConfigRequest) bool {
+func (p *TraceServiceGetSessionArgs) Field1DeepEqual(src *GetSessionRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -29394,41 +37423,41 @@ func (p *TraceServiceUpsertTrajectoryConfigArgs) Field1DeepEqual(src *UpsertTraj
 	return true
 }
 
-type TraceServiceUpsertTrajectoryConfigResult struct {
-	Success *UpsertTrajectoryConfigResponse `thrift:"success,0,optional" frugal:"0,optional,UpsertTrajectoryConfigResponse"`
+type TraceServiceGetSessionResult struct {
+	Success *GetSessionResponse `thrift:"success,0,optional" frugal:"0,optional,GetSessionResponse"`
 }
 
-func NewTraceServiceUpsertTrajectoryConfigResult() *TraceServiceUpsertTrajectoryConfigResult {
-	return &TraceServiceUpsertTrajectoryConfigResult{}
+func NewTraceServiceGetSessionResult() *TraceServiceGetSessionResult {
+	return &TraceServiceGetSessionResult{}
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) InitDefault() {
+func (p *TraceServiceGetSessionResult) InitDefault() {
 }
 
-var TraceServiceUpsertTrajectoryConfigResult_Success_DEFAULT *UpsertTrajectoryConfigResponse
+var TraceServiceGetSessionResult_Success_DEFAULT *GetSessionResponse
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) GetSuccess() (v *UpsertTrajectoryConfigResponse) {
+func (p *TraceServiceGetSessionResult) GetSuccess() (v *GetSessionResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceUpsertTrajectoryConfigResult_Success_DEFAULT
+		return TraceServiceGetSessionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceUpsertTrajectoryConfigResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpsertTrajectoryConfigResponse)
+func (p *TraceServiceGetSessionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetSessionResponse)
 }
 
-var fieldIDToName_TraceServiceUpsertTrajectoryConfigResult = map[int16]string{
+var fieldIDToName_TraceServiceGetSessionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) IsSetSuccess() bool {
+func (p *TraceServiceGetSessionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetSessionResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29473,7 +37502,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertTrajectoryConfigResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetSessionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29483,8 +37512,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpsertTrajectoryConfigResponse()
+func (p *TraceServiceGetSessionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetSessionResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29492,9 +37521,9 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetSessionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpsertTrajectoryConfig_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetSession_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29520,7 +37549,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetSessionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -29539,15 +37568,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) String() string {
+func (p *TraceServiceGetSessionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceUpsertTrajectoryConfigResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceGetSessionResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) DeepEqual(ano *TraceServiceUpsertTrajectoryConfigResult) bool {
+func (p *TraceServiceGetSessionResult) DeepEqual(ano *TraceServiceGetSessionResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29559,7 +37588,7 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) DeepEqual(ano *TraceServiceUp
 	return true
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) Field0DeepEqual(src *UpsertTrajectoryConfigResponse) bool {
+func (p *TraceServiceGetSessionResult) Field0DeepEqual(src *GetSessionResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -29567,41 +37596,41 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) Field0DeepEqual(src *UpsertTr
 	return true
 }
 
-type TraceServiceGetTrajectoryConfigArgs struct {
-	Req *GetTrajectoryConfigRequest `thrift:"req,1" frugal:"1,default,GetTrajectoryConfigRequest"`
+type TraceServiceExportTracesToFileArgs struct {
+	Req *ExportTracesToFileRequest `thrift:"req,1" frugal:"1,default,ExportTracesToFileRequest"`
 }
 
-func NewTraceServiceGetTrajectoryConfigArgs() *TraceServiceGetTrajectoryConfigArgs {
-	return &TraceServiceGetTrajectoryConfigArgs{}
+func NewTraceServiceExportTracesToFileArgs() *TraceServiceExportTracesToFileArgs {
+	return &TraceServiceExportTracesToFileArgs{}
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) InitDefault() {
+func (p *TraceServiceExportTracesToFileArgs) InitDefault() {
 }
 
-var TraceServiceGetTrajectoryConfigArgs_Req_DEFAULT *GetTrajectoryConfigRequest
+var TraceServiceExportTracesToFileArgs_Req_DEFAULT *ExportTracesToFileRequest
 
-func (p *TraceServiceGetTrajectoryConfigArgs) GetReq() (v *GetTrajectoryConfigRequest) {
+func (p *TraceServiceExportTracesToFileArgs) GetReq() (v *ExportTracesToFileRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceGetTrajectoryConfigArgs_Req_DEFAULT
+		return TraceServiceExportTracesToFileArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceGetTrajectoryConfigArgs) SetReq(val *GetTrajectoryConfigRequest) {
+func (p *TraceServiceExportTracesToFileArgs) SetReq(val *ExportTracesToFileRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceGetTrajectoryConfigArgs = map[int16]string{
+var fieldIDToName_TraceServiceExportTracesToFileArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) IsSetReq() bool {
+func (p *TraceServiceExportTracesToFileArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToFileArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29646,7 +37675,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTrajectoryConfigArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToFileArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29656,8 +37685,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetTrajectoryConfigRequest()
+func (p *TraceServiceExportTracesToFileArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExportTracesToFileRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29665,9 +37694,9 @@ func (p *TraceServiceGetTrajectoryConfigArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToFileArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTrajectoryConfig_args"); err != nil {
+	if err = oprot.WriteStructBegin("ExportTracesToFile_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29693,7 +37722,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToFileArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -29710,15 +37739,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) String() string {
+func (p *TraceServiceExportTracesToFileArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceGetTrajectoryConfigArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceExportTracesToFileArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) DeepEqual(ano *TraceServiceGetTrajectoryConfigArgs) bool {
+func (p *TraceServiceExportTracesToFileArgs) DeepEqual(ano *TraceServiceExportTracesToFileArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29730,7 +37759,7 @@ func (p *TraceServiceGetTrajectoryConfigArgs) DeepEqual(ano *TraceServiceGetTraj
 	return true
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) Field1DeepEqual(src *GetTrajectoryConfigRequest) bool {
+func (p *TraceServiceExportTracesToFileArgs) Field1DeepEqual(src *ExportTracesToFileRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -29738,41 +37767,41 @@ func (p *TraceServiceGetTrajectoryConfigArgs) Field1DeepEqual(src *GetTrajectory
 	return true
 }
 
-type TraceServiceGetTrajectoryConfigResult struct {
-	Success *GetTrajectoryConfigResponse `thrift:"success,0,optional" frugal:"0,optional,GetTrajectoryConfigResponse"`
+type TraceServiceExportTracesToFileResult struct {
+	Success *ExportTracesToFileResponse `thrift:"success,0,optional" frugal:"0,optional,ExportTracesToFileResponse"`
 }
 
-func NewTraceServiceGetTrajectoryConfigResult() *TraceServiceGetTrajectoryConfigResult {
-	return &TraceServiceGetTrajectoryConfigResult{}
+func NewTraceServiceExportTracesToFileResult() *TraceServiceExportTracesToFileResult {
+	return &TraceServiceExportTracesToFileResult{}
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) InitDefault() {
+func (p *TraceServiceExportTracesToFileResult) InitDefault() {
 }
 
-var TraceServiceGetTrajectoryConfigResult_Success_DEFAULT *GetTrajectoryConfigResponse
+var TraceServiceExportTracesToFileResult_Success_DEFAULT *ExportTracesToFileResponse
 
-func (p *TraceServiceGetTrajectoryConfigResult) GetSuccess() (v *GetTrajectoryConfigResponse) {
+func (p *TraceServiceExportTracesToFileResult) GetSuccess() (v *ExportTracesToFileResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceGetTrajectoryConfigResult_Success_DEFAULT
+		return TraceServiceExportTracesToFileResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceGetTrajectoryConfigResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetTrajectoryConfigResponse)
+func (p *TraceServiceExportTracesToFileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportTracesToFileResponse)
 }
 
-var fieldIDToName_TraceServiceGetTrajectoryConfigResult = map[int16]string{
+var fieldIDToName_TraceServiceExportTracesToFileResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) IsSetSuccess() bool {
+func (p *TraceServiceExportTracesToFileResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToFileResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29817,7 +37846,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTrajectoryConfigResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToFileResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -29827,8 +37856,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetTrajectoryConfigResponse()
+func (p *TraceServiceExportTracesToFileResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExportTracesToFileResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -29836,9 +37865,9 @@ func (p *TraceServiceGetTrajectoryConfigResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToFileResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTrajectoryConfig_result"); err != nil {
+	if err = oprot.WriteStructBegin("ExportTracesToFile_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -29864,7 +37893,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceExportTracesToFileResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -29883,15 +37912,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) String() string {
+func (p *TraceServiceExportTracesToFileResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceGetTrajectoryConfigResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceExportTracesToFileResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) DeepEqual(ano *TraceServiceGetTrajectoryConfigResult) bool {
+func (p *TraceServiceExportTracesToFileResult) DeepEqual(ano *TraceServiceExportTracesToFileResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -29903,7 +37932,7 @@ func (p *TraceServiceGetTrajectoryConfigResult) DeepEqual(ano *TraceServiceGetTr
 	return true
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) Field0DeepEqual(src *GetTrajectoryConfigResponse) bool {
+func (p *TraceServiceExportTracesToFileResult) Field0DeepEqual(src *ExportTracesToFileResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -29911,41 +37940,41 @@ func (p *TraceServiceGetTrajectoryConfigResult) Field0DeepEqual(src *GetTrajecto
 	return true
 }
 
-type TraceServiceListTrajectoryArgs struct {
-	Req *ListTrajectoryRequest `thrift:"req,1" frugal:"1,default,ListTrajectoryRequest"`
+type TraceServiceGetTraceExportJobArgs struct {
+	Req *GetTraceExportJobRequest `thrift:"req,1" frugal:"1,default,GetTraceExportJobRequest"`
 }
 
-func NewTraceServiceListTrajectoryArgs() *TraceServiceListTrajectoryArgs {
-	return &TraceServiceListTrajectoryArgs{}
+func NewTraceServiceGetTraceExportJobArgs() *TraceServiceGetTraceExportJobArgs {
+	return &TraceServiceGetTraceExportJobArgs{}
 }
 
-func (p *TraceServiceListTrajectoryArgs) InitDefault() {
+func (p *TraceServiceGetTraceExportJobArgs) InitDefault() {
 }
 
-var TraceServiceListTrajectoryArgs_Req_DEFAULT *ListTrajectoryRequest
+var TraceServiceGetTraceExportJobArgs_Req_DEFAULT *GetTraceExportJobRequest
 
-func (p *TraceServiceListTrajectoryArgs) GetReq() (v *ListTrajectoryRequest) {
+func (p *TraceServiceGetTraceExportJobArgs) GetReq() (v *GetTraceExportJobRequest) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetReq() {
-		return TraceServiceListTrajectoryArgs_Req_DEFAULT
+		return TraceServiceGetTraceExportJobArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *TraceServiceListTrajectoryArgs) SetReq(val *ListTrajectoryRequest) {
+func (p *TraceServiceGetTraceExportJobArgs) SetReq(val *GetTraceExportJobRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_TraceServiceListTrajectoryArgs = map[int16]string{
+var fieldIDToName_TraceServiceGetTraceExportJobArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *TraceServiceListTrajectoryArgs) IsSetReq() bool {
+func (p *TraceServiceGetTraceExportJobArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *TraceServiceListTrajectoryArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTraceExportJobArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -29990,7 +38019,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListTrajectoryArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceExportJobArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30000,8 +38029,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListTrajectoryArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListTrajectoryRequest()
+func (p *TraceServiceGetTraceExportJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetTraceExportJobRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -30009,9 +38038,9 @@ func (p *TraceServiceListTrajectoryArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *TraceServiceListTrajectoryArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTraceExportJobArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListTrajectory_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTraceExportJob_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30037,7 +38066,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListTrajectoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTraceExportJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -30054,15 +38083,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TraceServiceListTrajectoryArgs) String() string {
+func (p *TraceServiceGetTraceExportJobArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListTrajectoryArgs(%+v)", *p)
+	return fmt.Sprintf("TraceServiceGetTraceExportJobArgs(%+v)", *p)
 
 }
 
-func (p *TraceServiceListTrajectoryArgs) DeepEqual(ano *TraceServiceListTrajectoryArgs) bool {
+func (p *TraceServiceGetTraceExportJobArgs) DeepEqual(ano *TraceServiceGetTraceExportJobArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -30074,7 +38103,7 @@ func (p *TraceServiceListTrajectoryArgs) DeepEqual(ano *TraceServiceListTrajecto
 	return true
 }
 
-func (p *TraceServiceListTrajectoryArgs) Field1DeepEqual(src *ListTrajectoryRequest) bool {
+func (p *TraceServiceGetTraceExportJobArgs) Field1DeepEqual(src *GetTraceExportJobRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -30082,41 +38111,41 @@ func (p *TraceServiceListTrajectoryArgs) Field1DeepEqual(src *ListTrajectoryRequ
 	return true
 }
 
-type TraceServiceListTrajectoryResult struct {
-	Success *ListTrajectoryResponse `thrift:"success,0,optional" frugal:"0,optional,ListTrajectoryResponse"`
+type TraceServiceGetTraceExportJobResult struct {
+	Success *GetTraceExportJobResponse `thrift:"success,0,optional" frugal:"0,optional,GetTraceExportJobResponse"`
 }
 
-func NewTraceServiceListTrajectoryResult() *TraceServiceListTrajectoryResult {
-	return &TraceServiceListTrajectoryResult{}
+func NewTraceServiceGetTraceExportJobResult() *TraceServiceGetTraceExportJobResult {
+	return &TraceServiceGetTraceExportJobResult{}
 }
 
-func (p *TraceServiceListTrajectoryResult) InitDefault() {
+func (p *TraceServiceGetTraceExportJobResult) InitDefault() {
 }
 
-var TraceServiceListTrajectoryResult_Success_DEFAULT *ListTrajectoryResponse
+var TraceServiceGetTraceExportJobResult_Success_DEFAULT *GetTraceExportJobResponse
 
-func (p *TraceServiceListTrajectoryResult) GetSuccess() (v *ListTrajectoryResponse) {
+func (p *TraceServiceGetTraceExportJobResult) GetSuccess() (v *GetTraceExportJobResponse) {
 	if p == nil {
 		return
 	}
 	if !p.IsSetSuccess() {
-		return TraceServiceListTrajectoryResult_Success_DEFAULT
+		return TraceServiceGetTraceExportJobResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *TraceServiceListTrajectoryResult) SetSuccess(x interface{}) {
-	p.Success = x.(*ListTrajectoryResponse)
+func (p *TraceServiceGetTraceExportJobResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetTraceExportJobResponse)
 }
 
-var fieldIDToName_TraceServiceListTrajectoryResult = map[int16]string{
+var fieldIDToName_TraceServiceGetTraceExportJobResult = map[int16]string{
 	0: "success",
 }
 
-func (p *TraceServiceListTrajectoryResult) IsSetSuccess() bool {
+func (p *TraceServiceGetTraceExportJobResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *TraceServiceListTrajectoryResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTraceExportJobResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -30161,7 +38190,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListTrajectoryResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceExportJobResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -30171,8 +38200,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TraceServiceListTrajectoryResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListTrajectoryResponse()
+func (p *TraceServiceGetTraceExportJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetTraceExportJobResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -30180,9 +38209,9 @@ func (p *TraceServiceListTrajectoryResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *TraceServiceListTrajectoryResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTraceExportJobResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListTrajectory_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTraceExportJob_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -30208,7 +38237,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TraceServiceListTrajectoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TraceServiceGetTraceExportJobResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -30227,15 +38256,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *TraceServiceListTrajectoryResult) String() string {
+func (p *TraceServiceGetTraceExportJobResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TraceServiceListTrajectoryResult(%+v)", *p)
+	return fmt.Sprintf("TraceServiceGetTraceExportJobResult(%+v)", *p)
 
 }
 
-func (p *TraceServiceListTrajectoryResult) DeepEqual(ano *TraceServiceListTrajectoryResult) bool {
+func (p *TraceServiceGetTraceExportJobResult) DeepEqual(ano *TraceServiceGetTraceExportJobResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -30247,7 +38276,7 @@ func (p *TraceServiceListTrajectoryResult) DeepEqual(ano *TraceServiceListTrajec
 	return true
 }
 
-func (p *TraceServiceListTrajectoryResult) Field0DeepEqual(src *ListTrajectoryResponse) bool {
+func (p *TraceServiceGetTraceExportJobResult) Field0DeepEqual(src *GetTraceExportJobResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -17,8 +17,12 @@ import (
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
 	dataset0 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dataset"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/retention"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/session"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/span"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/task"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/trace_diff"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/trace_export"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/view"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/trajectory"
 )
@@ -30,8 +34,12 @@ var (
 	_ = common.KitexUnusedProtection
 	_ = dataset0.KitexUnusedProtection
 	_ = filter.KitexUnusedProtection
+	_ = retention.KitexUnusedProtection
+	_ = session.KitexUnusedProtection
 	_ = span.KitexUnusedProtection
 	_ = task.KitexUnusedProtection
+	_ = trace_diff.KitexUnusedProtection
+	_ = trace_export.KitexUnusedProtection
 	_ = view.KitexUnusedProtection
 	_ = trajectory.KitexUnusedProtection
 )
@@ -15559,6 +15567,3811 @@ func (p *ListTrajectoryResponse) DeepCopy(s interface{}) error {
 	return nil
 }
 
+func (p *UpsertRetentionPolicyRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetPolicy bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetPolicy = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPolicy {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpsertRetentionPolicyRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_UpsertRetentionPolicyRequest[fieldId]))
+}
+
+func (p *UpsertRetentionPolicyRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *UpsertRetentionPolicyRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := retention.NewRetentionPolicy()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Policy = _field
+	return offset, nil
+}
+
+func (p *UpsertRetentionPolicyRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *UpsertRetentionPolicyRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpsertRetentionPolicyRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpsertRetentionPolicyRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpsertRetentionPolicyRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *UpsertRetentionPolicyRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+	offset += p.Policy.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *UpsertRetentionPolicyRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpsertRetentionPolicyRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *UpsertRetentionPolicyRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Policy.BLength()
+	return l
+}
+
+func (p *UpsertRetentionPolicyRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *UpsertRetentionPolicyRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpsertRetentionPolicyRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	var _policy *retention.RetentionPolicy
+	if src.Policy != nil {
+		_policy = &retention.RetentionPolicy{}
+		if err := _policy.DeepCopy(src.Policy); err != nil {
+			return err
+		}
+	}
+	p.Policy = _policy
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *UpsertRetentionPolicyResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpsertRetentionPolicyResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *UpsertRetentionPolicyResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *UpsertRetentionPolicyResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *UpsertRetentionPolicyResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *UpsertRetentionPolicyResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *UpsertRetentionPolicyResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *UpsertRetentionPolicyResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *UpsertRetentionPolicyResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*UpsertRetentionPolicyResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *GetRetentionPolicyRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRetentionPolicyRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetRetentionPolicyRequest[fieldId]))
+}
+
+func (p *GetRetentionPolicyRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *GetRetentionPolicyRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetRetentionPolicyRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetRetentionPolicyRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetRetentionPolicyRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetRetentionPolicyRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *GetRetentionPolicyRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetRetentionPolicyRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetRetentionPolicyRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *GetRetentionPolicyRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetRetentionPolicyRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *GetRetentionPolicyResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRetentionPolicyResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *GetRetentionPolicyResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := retention.NewRetentionPolicy()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Policy = _field
+	return offset, nil
+}
+
+func (p *GetRetentionPolicyResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetRetentionPolicyResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetRetentionPolicyResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetRetentionPolicyResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetRetentionPolicyResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPolicy() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+		offset += p.Policy.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetRetentionPolicyResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetRetentionPolicyResponse) field1Length() int {
+	l := 0
+	if p.IsSetPolicy() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Policy.BLength()
+	}
+	return l
+}
+
+func (p *GetRetentionPolicyResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *GetRetentionPolicyResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetRetentionPolicyResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _policy *retention.RetentionPolicy
+	if src.Policy != nil {
+		_policy = &retention.RetentionPolicy{}
+		if err := _policy.DeepCopy(src.Policy); err != nil {
+			return err
+		}
+	}
+	p.Policy = _policy
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *DiffTracesRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetBaseTrace bool = false
+	var issetCompareTrace bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetBaseTrace = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetCompareTrace = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetBaseTrace {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCompareTrace {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffTracesRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DiffTracesRequest[fieldId]))
+}
+
+func (p *DiffTracesRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *DiffTracesRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := NewTraceQueryParams()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseTrace = _field
+	return offset, nil
+}
+
+func (p *DiffTracesRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+	_field := NewTraceQueryParams()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.CompareTrace = _field
+	return offset, nil
+}
+
+func (p *DiffTracesRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *common.PlatformType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PlatformType = _field
+	return offset, nil
+}
+
+func (p *DiffTracesRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *DiffTracesRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DiffTracesRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DiffTracesRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DiffTracesRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *DiffTracesRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+	offset += p.BaseTrace.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *DiffTracesRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 3)
+	offset += p.CompareTrace.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *DiffTracesRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPlatformType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PlatformType)
+	}
+	return offset
+}
+
+func (p *DiffTracesRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DiffTracesRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *DiffTracesRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.BaseTrace.BLength()
+	return l
+}
+
+func (p *DiffTracesRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.CompareTrace.BLength()
+	return l
+}
+
+func (p *DiffTracesRequest) field4Length() int {
+	l := 0
+	if p.IsSetPlatformType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PlatformType)
+	}
+	return l
+}
+
+func (p *DiffTracesRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *DiffTracesRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*DiffTracesRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	var _baseTrace *TraceQueryParams
+	if src.BaseTrace != nil {
+		_baseTrace = &TraceQueryParams{}
+		if err := _baseTrace.DeepCopy(src.BaseTrace); err != nil {
+			return err
+		}
+	}
+	p.BaseTrace = _baseTrace
+
+	var _compareTrace *TraceQueryParams
+	if src.CompareTrace != nil {
+		_compareTrace = &TraceQueryParams{}
+		if err := _compareTrace.DeepCopy(src.CompareTrace); err != nil {
+			return err
+		}
+	}
+	p.CompareTrace = _compareTrace
+
+	if src.PlatformType != nil {
+		tmp := *src.PlatformType
+		p.PlatformType = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *DiffTracesResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetNodes bool = false
+	var issetSummary bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetNodes = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetSummary = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetNodes {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSummary {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffTracesResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_DiffTracesResponse[fieldId]))
+}
+
+func (p *DiffTracesResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*trace_diff.SpanDiffNode, 0, size)
+	values := make([]trace_diff.SpanDiffNode, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Nodes = _field
+	return offset, nil
+}
+
+func (p *DiffTracesResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+	_field := trace_diff.NewTraceDiffSummary()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Summary = _field
+	return offset, nil
+}
+
+func (p *DiffTracesResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *DiffTracesResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *DiffTracesResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *DiffTracesResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *DiffTracesResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.Nodes {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
+}
+
+func (p *DiffTracesResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 2)
+	offset += p.Summary.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *DiffTracesResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *DiffTracesResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.Nodes {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *DiffTracesResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Summary.BLength()
+	return l
+}
+
+func (p *DiffTracesResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *DiffTracesResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*DiffTracesResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Nodes != nil {
+		p.Nodes = make([]*trace_diff.SpanDiffNode, 0, len(src.Nodes))
+		for _, elem := range src.Nodes {
+			var _elem *trace_diff.SpanDiffNode
+			if elem != nil {
+				_elem = &trace_diff.SpanDiffNode{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Nodes = append(p.Nodes, _elem)
+		}
+	}
+
+	var _summary *trace_diff.TraceDiffSummary
+	if src.Summary != nil {
+		_summary = &trace_diff.TraceDiffSummary{}
+		if err := _summary.DeepCopy(src.Summary); err != nil {
+			return err
+		}
+	}
+	p.Summary = _summary
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ListSessionsRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetStartTime bool = false
+	var issetEndTime bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetStartTime = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEndTime = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStartTime {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEndTime {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSessionsRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListSessionsRequest[fieldId]))
+}
+
+func (p *ListSessionsRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ListSessionsRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.StartTime = _field
+	return offset, nil
+}
+
+func (p *ListSessionsRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EndTime = _field
+	return offset, nil
+}
+
+func (p *ListSessionsRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+	_field := filter.NewFilterFields()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Filters = _field
+	return offset, nil
+}
+
+func (p *ListSessionsRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageSize = _field
+	return offset, nil
+}
+
+func (p *ListSessionsRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PageToken = _field
+	return offset, nil
+}
+
+func (p *ListSessionsRequest) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *common.PlatformType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PlatformType = _field
+	return offset, nil
+}
+
+func (p *ListSessionsRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ListSessionsRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListSessionsRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListSessionsRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListSessionsRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ListSessionsRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.StartTime)
+	return offset
+}
+
+func (p *ListSessionsRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EndTime)
+	return offset
+}
+
+func (p *ListSessionsRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 4)
+		offset += p.Filters.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListSessionsRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageSize() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 5)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.PageSize)
+	}
+	return offset
+}
+
+func (p *ListSessionsRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPageToken() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PageToken)
+	}
+	return offset
+}
+
+func (p *ListSessionsRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPlatformType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PlatformType)
+	}
+	return offset
+}
+
+func (p *ListSessionsRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListSessionsRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListSessionsRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListSessionsRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ListSessionsRequest) field4Length() int {
+	l := 0
+	if p.IsSetFilters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Filters.BLength()
+	}
+	return l
+}
+
+func (p *ListSessionsRequest) field5Length() int {
+	l := 0
+	if p.IsSetPageSize() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *ListSessionsRequest) field6Length() int {
+	l := 0
+	if p.IsSetPageToken() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PageToken)
+	}
+	return l
+}
+
+func (p *ListSessionsRequest) field7Length() int {
+	l := 0
+	if p.IsSetPlatformType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PlatformType)
+	}
+	return l
+}
+
+func (p *ListSessionsRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ListSessionsRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListSessionsRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.StartTime = src.StartTime
+
+	p.EndTime = src.EndTime
+
+	var _filters *filter.FilterFields
+	if src.Filters != nil {
+		_filters = &filter.FilterFields{}
+		if err := _filters.DeepCopy(src.Filters); err != nil {
+			return err
+		}
+	}
+	p.Filters = _filters
+
+	if src.PageSize != nil {
+		tmp := *src.PageSize
+		p.PageSize = &tmp
+	}
+
+	if src.PageToken != nil {
+		var tmp string
+		if *src.PageToken != "" {
+			tmp = kutils.StringDeepCopy(*src.PageToken)
+		}
+		p.PageToken = &tmp
+	}
+
+	if src.PlatformType != nil {
+		tmp := *src.PlatformType
+		p.PlatformType = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ListSessionsResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSessions bool = false
+	var issetNextPageToken bool = false
+	var issetHasMore bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetSessions = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetNextPageToken = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetHasMore = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetSessions {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetNextPageToken {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetHasMore {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSessionsResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ListSessionsResponse[fieldId]))
+}
+
+func (p *ListSessionsResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*session.Session, 0, size)
+	values := make([]session.Session, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Sessions = _field
+	return offset, nil
+}
+
+func (p *ListSessionsResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.NextPageToken = _field
+	return offset, nil
+}
+
+func (p *ListSessionsResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.HasMore = _field
+	return offset, nil
+}
+
+func (p *ListSessionsResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ListSessionsResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ListSessionsResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ListSessionsResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ListSessionsResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 1)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.Sessions {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
+}
+
+func (p *ListSessionsResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.NextPageToken)
+	return offset
+}
+
+func (p *ListSessionsResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 3)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.HasMore)
+	return offset
+}
+
+func (p *ListSessionsResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ListSessionsResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.Sessions {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *ListSessionsResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.NextPageToken)
+	return l
+}
+
+func (p *ListSessionsResponse) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
+func (p *ListSessionsResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *ListSessionsResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ListSessionsResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	if src.Sessions != nil {
+		p.Sessions = make([]*session.Session, 0, len(src.Sessions))
+		for _, elem := range src.Sessions {
+			var _elem *session.Session
+			if elem != nil {
+				_elem = &session.Session{}
+				if err := _elem.DeepCopy(elem); err != nil {
+					return err
+				}
+			}
+
+			p.Sessions = append(p.Sessions, _elem)
+		}
+	}
+
+	if src.NextPageToken != "" {
+		p.NextPageToken = kutils.StringDeepCopy(src.NextPageToken)
+	}
+
+	p.HasMore = src.HasMore
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *GetSessionRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetSessionID bool = false
+	var issetStartTime bool = false
+	var issetEndTime bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetSessionID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetStartTime = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEndTime = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSessionID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStartTime {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEndTime {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSessionRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetSessionRequest[fieldId]))
+}
+
+func (p *GetSessionRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *GetSessionRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.SessionID = _field
+	return offset, nil
+}
+
+func (p *GetSessionRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.StartTime = _field
+	return offset, nil
+}
+
+func (p *GetSessionRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EndTime = _field
+	return offset, nil
+}
+
+func (p *GetSessionRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *common.PlatformType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PlatformType = _field
+	return offset, nil
+}
+
+func (p *GetSessionRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetSessionRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetSessionRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetSessionRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetSessionRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *GetSessionRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 2)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.SessionID)
+	return offset
+}
+
+func (p *GetSessionRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.StartTime)
+	return offset
+}
+
+func (p *GetSessionRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 4)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EndTime)
+	return offset
+}
+
+func (p *GetSessionRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPlatformType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PlatformType)
+	}
+	return offset
+}
+
+func (p *GetSessionRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetSessionRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetSessionRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.SessionID)
+	return l
+}
+
+func (p *GetSessionRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetSessionRequest) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetSessionRequest) field5Length() int {
+	l := 0
+	if p.IsSetPlatformType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PlatformType)
+	}
+	return l
+}
+
+func (p *GetSessionRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *GetSessionRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetSessionRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	if src.SessionID != "" {
+		p.SessionID = kutils.StringDeepCopy(src.SessionID)
+	}
+
+	p.StartTime = src.StartTime
+
+	p.EndTime = src.EndTime
+
+	if src.PlatformType != nil {
+		tmp := *src.PlatformType
+		p.PlatformType = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *GetSessionResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetSession bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetSession = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetSession {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSessionResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetSessionResponse[fieldId]))
+}
+
+func (p *GetSessionResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := session.NewSession()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Session = _field
+	return offset, nil
+}
+
+func (p *GetSessionResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Truncated = _field
+	return offset, nil
+}
+
+func (p *GetSessionResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetSessionResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetSessionResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetSessionResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetSessionResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Session.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetSessionResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTruncated() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 2)
+		offset += thrift.Binary.WriteBool(buf[offset:], *p.Truncated)
+	}
+	return offset
+}
+
+func (p *GetSessionResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetSessionResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Session.BLength()
+	return l
+}
+
+func (p *GetSessionResponse) field2Length() int {
+	l := 0
+	if p.IsSetTruncated() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.BoolLength()
+	}
+	return l
+}
+
+func (p *GetSessionResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *GetSessionResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetSessionResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _session *session.Session
+	if src.Session != nil {
+		_session = &session.Session{}
+		if err := _session.DeepCopy(src.Session); err != nil {
+			return err
+		}
+	}
+	p.Session = _session
+
+	if src.Truncated != nil {
+		tmp := *src.Truncated
+		p.Truncated = &tmp
+	}
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *ExportTracesToFileRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetWorkspaceID bool = false
+	var issetStartTime bool = false
+	var issetEndTime bool = false
+	var issetFormat bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetStartTime = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetEndTime = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetFormat = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField6(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStartTime {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEndTime {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFormat {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportTracesToFileRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportTracesToFileRequest[fieldId]))
+}
+
+func (p *ExportTracesToFileRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.StartTime = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileRequest) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.EndTime = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileRequest) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field trace_export.ExportFileFormat
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.Format = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+	_field := filter.NewFilterFields()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Filters = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileRequest) FastReadField6(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *common.PlatformType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.PlatformType = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileRequest) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *common.SpanListType
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.SpanListType = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExportTracesToFileRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
+		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExportTracesToFileRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+		l += p.field5Length()
+		l += p.field6Length()
+		l += p.field7Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExportTracesToFileRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *ExportTracesToFileRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.StartTime)
+	return offset
+}
+
+func (p *ExportTracesToFileRequest) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.EndTime)
+	return offset
+}
+
+func (p *ExportTracesToFileRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 4)
+	offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, p.Format)
+	return offset
+}
+
+func (p *ExportTracesToFileRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetFilters() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 5)
+		offset += p.Filters.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExportTracesToFileRequest) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetPlatformType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 6)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.PlatformType)
+	}
+	return offset
+}
+
+func (p *ExportTracesToFileRequest) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSpanListType() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 7)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.SpanListType)
+	}
+	return offset
+}
+
+func (p *ExportTracesToFileRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExportTracesToFileRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExportTracesToFileRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExportTracesToFileRequest) field3Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExportTracesToFileRequest) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.StringLengthNocopy(p.Format)
+	return l
+}
+
+func (p *ExportTracesToFileRequest) field5Length() int {
+	l := 0
+	if p.IsSetFilters() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Filters.BLength()
+	}
+	return l
+}
+
+func (p *ExportTracesToFileRequest) field6Length() int {
+	l := 0
+	if p.IsSetPlatformType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.PlatformType)
+	}
+	return l
+}
+
+func (p *ExportTracesToFileRequest) field7Length() int {
+	l := 0
+	if p.IsSetSpanListType() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.SpanListType)
+	}
+	return l
+}
+
+func (p *ExportTracesToFileRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *ExportTracesToFileRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExportTracesToFileRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.WorkspaceID = src.WorkspaceID
+
+	p.StartTime = src.StartTime
+
+	p.EndTime = src.EndTime
+
+	p.Format = src.Format
+
+	var _filters *filter.FilterFields
+	if src.Filters != nil {
+		_filters = &filter.FilterFields{}
+		if err := _filters.DeepCopy(src.Filters); err != nil {
+			return err
+		}
+	}
+	p.Filters = _filters
+
+	if src.PlatformType != nil {
+		tmp := *src.PlatformType
+		p.PlatformType = &tmp
+	}
+
+	if src.SpanListType != nil {
+		tmp := *src.SpanListType
+		p.SpanListType = &tmp
+	}
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *ExportTracesToFileResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetJobID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetJobID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetJobID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportTracesToFileResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_ExportTracesToFileResponse[fieldId]))
+}
+
+func (p *ExportTracesToFileResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.JobID = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *ExportTracesToFileResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *ExportTracesToFileResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *ExportTracesToFileResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *ExportTracesToFileResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.JobID)
+	return offset
+}
+
+func (p *ExportTracesToFileResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *ExportTracesToFileResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *ExportTracesToFileResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *ExportTracesToFileResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*ExportTracesToFileResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.JobID = src.JobID
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
+func (p *GetTraceExportJobRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetJobID bool = false
+	var issetWorkspaceID bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetJobID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetWorkspaceID = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetJobID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetWorkspaceID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTraceExportJobRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetTraceExportJobRequest[fieldId]))
+}
+
+func (p *GetTraceExportJobRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.JobID = _field
+	return offset, nil
+}
+
+func (p *GetTraceExportJobRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.WorkspaceID = _field
+	return offset, nil
+}
+
+func (p *GetTraceExportJobRequest) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBase()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *GetTraceExportJobRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetTraceExportJobRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetTraceExportJobRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetTraceExportJobRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.JobID)
+	return offset
+}
+
+func (p *GetTraceExportJobRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 2)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.WorkspaceID)
+	return offset
+}
+
+func (p *GetTraceExportJobRequest) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBase() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetTraceExportJobRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetTraceExportJobRequest) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *GetTraceExportJobRequest) field255Length() int {
+	l := 0
+	if p.IsSetBase() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Base.BLength()
+	}
+	return l
+}
+
+func (p *GetTraceExportJobRequest) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetTraceExportJobRequest)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	p.JobID = src.JobID
+
+	p.WorkspaceID = src.WorkspaceID
+
+	var _base *base.Base
+	if src.Base != nil {
+		_base = &base.Base{}
+		if err := _base.DeepCopy(src.Base); err != nil {
+			return err
+		}
+	}
+	p.Base = _base
+
+	return nil
+}
+
+func (p *GetTraceExportJobResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetJob bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetJob = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField255(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetJob {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTraceExportJobResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_GetTraceExportJobResponse[fieldId]))
+}
+
+func (p *GetTraceExportJobResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := trace_export.NewTraceExportJob()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Job = _field
+	return offset, nil
+}
+
+func (p *GetTraceExportJobResponse) FastReadField255(buf []byte) (int, error) {
+	offset := 0
+	_field := base.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.BaseResp = _field
+	return offset, nil
+}
+
+func (p *GetTraceExportJobResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *GetTraceExportJobResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField255(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *GetTraceExportJobResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field255Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *GetTraceExportJobResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Job.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *GetTraceExportJobResponse) fastWriteField255(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetBaseResp() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 255)
+		offset += p.BaseResp.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *GetTraceExportJobResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Job.BLength()
+	return l
+}
+
+func (p *GetTraceExportJobResponse) field255Length() int {
+	l := 0
+	if p.IsSetBaseResp() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.BaseResp.BLength()
+	}
+	return l
+}
+
+func (p *GetTraceExportJobResponse) DeepCopy(s interface{}) error {
+	src, ok := s.(*GetTraceExportJobResponse)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _job *trace_export.TraceExportJob
+	if src.Job != nil {
+		_job = &trace_export.TraceExportJob{}
+		if err := _job.DeepCopy(src.Job); err != nil {
+			return err
+		}
+	}
+	p.Job = _job
+
+	var _baseResp *base.BaseResp
+	if src.BaseResp != nil {
+		_baseResp = &base.BaseResp{}
+		if err := _baseResp.DeepCopy(src.BaseResp); err != nil {
+			return err
+		}
+	}
+	p.BaseResp = _baseResp
+
+	return nil
+}
+
 func (p *TraceServiceListSpansArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -15603,14 +19416,1652 @@ func (p *TraceServiceListSpansArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceListSpansArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListSpansRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceListSpansArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceListSpansArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceListSpansArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceListSpansArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceListSpansArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceListSpansArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListSpansArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *ListSpansRequest
+	if src.Req != nil {
+		_req = &ListSpansRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceListSpansResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceListSpansResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListSpansResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceListSpansResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceListSpansResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceListSpansResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceListSpansResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceListSpansResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceListSpansResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListSpansResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ListSpansResponse
+	if src.Success != nil {
+		_success = &ListSpansResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceListPreSpanArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListPreSpanArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceListPreSpanArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListPreSpanRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceListPreSpanArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceListPreSpanArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceListPreSpanArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceListPreSpanArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceListPreSpanArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceListPreSpanArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListPreSpanArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *ListPreSpanRequest
+	if src.Req != nil {
+		_req = &ListPreSpanRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceListPreSpanResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListPreSpanResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceListPreSpanResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewListPreSpanResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceListPreSpanResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceListPreSpanResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceListPreSpanResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceListPreSpanResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceListPreSpanResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceListPreSpanResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListPreSpanResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *ListPreSpanResponse
+	if src.Success != nil {
+		_success = &ListPreSpanResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceGetTraceArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceGetTraceArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetTraceRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceGetTraceArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceGetTraceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceGetTraceArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceGetTraceArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceGetTraceArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceGetTraceArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTraceArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *GetTraceRequest
+	if src.Req != nil {
+		_req = &GetTraceRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceGetTraceResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceGetTraceResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetTraceResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceGetTraceResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceGetTraceResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceGetTraceResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceGetTraceResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceGetTraceResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceGetTraceResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTraceResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *GetTraceResponse
+	if src.Success != nil {
+		_success = &GetTraceResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceSearchTraceTreeArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewSearchTraceTreeRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceSearchTraceTreeArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceSearchTraceTreeArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *SearchTraceTreeRequest
+	if src.Req != nil {
+		_req = &SearchTraceTreeRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceSearchTraceTreeResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceSearchTraceTreeResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceSearchTraceTreeResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewSearchTraceTreeResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceSearchTraceTreeResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceSearchTraceTreeResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceSearchTraceTreeResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceSearchTraceTreeResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceSearchTraceTreeResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceSearchTraceTreeResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceSearchTraceTreeResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *SearchTraceTreeResponse
+	if src.Success != nil {
+		_success = &SearchTraceTreeResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetTracesAdvanceInfoRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceBatchGetTracesAdvanceInfoArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *BatchGetTracesAdvanceInfoRequest
+	if src.Req != nil {
+		_req = &BatchGetTracesAdvanceInfoRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewBatchGetTracesAdvanceInfoResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceBatchGetTracesAdvanceInfoResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceBatchGetTracesAdvanceInfoResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *BatchGetTracesAdvanceInfoResponse
+	if src.Success != nil {
+		_success = &BatchGetTracesAdvanceInfoResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewIngestTracesRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceIngestTracesInnerArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceIngestTracesInnerArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *IngestTracesRequest
+	if src.Req != nil {
+		_req = &IngestTracesRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceIngestTracesInnerResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceIngestTracesInnerResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewIngestTracesResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceIngestTracesInnerResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceIngestTracesInnerResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceIngestTracesInnerResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceIngestTracesInnerResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceIngestTracesInnerResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceIngestTracesInnerResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceIngestTracesInnerResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *IngestTracesResponse
+	if src.Success != nil {
+		_success = &IngestTracesResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetTracesMetaInfoRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *TraceServiceGetTracesMetaInfoArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTracesMetaInfoArgs)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _req *GetTracesMetaInfoRequest
+	if src.Req != nil {
+		_req = &GetTracesMetaInfoRequest{}
+		if err := _req.DeepCopy(src.Req); err != nil {
+			return err
+		}
+	}
+	p.Req = _req
+
+	return nil
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewGetTracesMetaInfoResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *TraceServiceGetTracesMetaInfoResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTracesMetaInfoResult)
+	if !ok {
+		return fmt.Errorf("%T's type not matched %T", s, p)
+	}
+
+	var _success *GetTracesMetaInfoResponse
+	if src.Success != nil {
+		_success = &GetTracesMetaInfoResponse{}
+		if err := _success.DeepCopy(src.Success); err != nil {
+			return err
+		}
+	}
+	p.Success = _success
+
+	return nil
+}
+
+func (p *TraceServiceCreateViewArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListSpansArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceCreateViewArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListSpansRequest()
+	_field := NewCreateViewRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -15620,11 +21071,11 @@ func (p *TraceServiceListSpansArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceListSpansArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateViewArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListSpansArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -15633,7 +21084,7 @@ func (p *TraceServiceListSpansArgs) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *TraceServiceListSpansArgs) BLength() int {
+func (p *TraceServiceCreateViewArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -15642,29 +21093,29 @@ func (p *TraceServiceListSpansArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListSpansArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceListSpansArgs) field1Length() int {
+func (p *TraceServiceCreateViewArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceListSpansArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListSpansArgs)
+func (p *TraceServiceCreateViewArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateViewArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListSpansRequest
+	var _req *CreateViewRequest
 	if src.Req != nil {
-		_req = &ListSpansRequest{}
+		_req = &CreateViewRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -15674,7 +21125,7 @@ func (p *TraceServiceListSpansArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListSpansResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceCreateViewResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15718,14 +21169,14 @@ func (p *TraceServiceListSpansResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSpansResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListSpansResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceCreateViewResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListSpansResponse()
+	_field := NewCreateViewResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -15735,11 +21186,11 @@ func (p *TraceServiceListSpansResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceListSpansResult) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateViewResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListSpansResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -15748,7 +21199,7 @@ func (p *TraceServiceListSpansResult) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *TraceServiceListSpansResult) BLength() int {
+func (p *TraceServiceCreateViewResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -15757,7 +21208,7 @@ func (p *TraceServiceListSpansResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListSpansResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -15766,7 +21217,7 @@ func (p *TraceServiceListSpansResult) fastWriteField0(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *TraceServiceListSpansResult) field0Length() int {
+func (p *TraceServiceCreateViewResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -15775,15 +21226,15 @@ func (p *TraceServiceListSpansResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceListSpansResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListSpansResult)
+func (p *TraceServiceCreateViewResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateViewResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListSpansResponse
+	var _success *CreateViewResponse
 	if src.Success != nil {
-		_success = &ListSpansResponse{}
+		_success = &CreateViewResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -15793,7 +21244,7 @@ func (p *TraceServiceListSpansResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListPreSpanArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateViewArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15837,14 +21288,14 @@ func (p *TraceServiceListPreSpanArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListPreSpanArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListPreSpanArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceUpdateViewArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListPreSpanRequest()
+	_field := NewUpdateViewRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -15854,11 +21305,11 @@ func (p *TraceServiceListPreSpanArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceListPreSpanArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateViewArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListPreSpanArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -15867,7 +21318,7 @@ func (p *TraceServiceListPreSpanArgs) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *TraceServiceListPreSpanArgs) BLength() int {
+func (p *TraceServiceUpdateViewArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -15876,29 +21327,29 @@ func (p *TraceServiceListPreSpanArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListPreSpanArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceListPreSpanArgs) field1Length() int {
+func (p *TraceServiceUpdateViewArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceListPreSpanArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListPreSpanArgs)
+func (p *TraceServiceUpdateViewArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateViewArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListPreSpanRequest
+	var _req *UpdateViewRequest
 	if src.Req != nil {
-		_req = &ListPreSpanRequest{}
+		_req = &UpdateViewRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -15908,7 +21359,7 @@ func (p *TraceServiceListPreSpanArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListPreSpanResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateViewResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -15952,14 +21403,14 @@ func (p *TraceServiceListPreSpanResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListPreSpanResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListPreSpanResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceUpdateViewResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListPreSpanResponse()
+	_field := NewUpdateViewResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -15969,11 +21420,11 @@ func (p *TraceServiceListPreSpanResult) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *TraceServiceListPreSpanResult) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateViewResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListPreSpanResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -15982,7 +21433,7 @@ func (p *TraceServiceListPreSpanResult) FastWriteNocopy(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *TraceServiceListPreSpanResult) BLength() int {
+func (p *TraceServiceUpdateViewResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -15991,7 +21442,7 @@ func (p *TraceServiceListPreSpanResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListPreSpanResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -16000,7 +21451,7 @@ func (p *TraceServiceListPreSpanResult) fastWriteField0(buf []byte, w thrift.Noc
 	return offset
 }
 
-func (p *TraceServiceListPreSpanResult) field0Length() int {
+func (p *TraceServiceUpdateViewResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16009,15 +21460,15 @@ func (p *TraceServiceListPreSpanResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceListPreSpanResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListPreSpanResult)
+func (p *TraceServiceUpdateViewResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateViewResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListPreSpanResponse
+	var _success *UpdateViewResponse
 	if src.Success != nil {
-		_success = &ListPreSpanResponse{}
+		_success = &UpdateViewResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -16027,7 +21478,7 @@ func (p *TraceServiceListPreSpanResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceGetTraceArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteViewArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16071,14 +21522,14 @@ func (p *TraceServiceGetTraceArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTraceArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceDeleteViewArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTraceRequest()
+	_field := NewDeleteViewRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16088,11 +21539,11 @@ func (p *TraceServiceGetTraceArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceGetTraceArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteViewArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTraceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -16101,7 +21552,7 @@ func (p *TraceServiceGetTraceArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *TraceServiceGetTraceArgs) BLength() int {
+func (p *TraceServiceDeleteViewArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16110,29 +21561,29 @@ func (p *TraceServiceGetTraceArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTraceArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceGetTraceArgs) field1Length() int {
+func (p *TraceServiceDeleteViewArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceGetTraceArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTraceArgs)
+func (p *TraceServiceDeleteViewArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteViewArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetTraceRequest
+	var _req *DeleteViewRequest
 	if src.Req != nil {
-		_req = &GetTraceRequest{}
+		_req = &DeleteViewRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -16142,7 +21593,7 @@ func (p *TraceServiceGetTraceArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceGetTraceResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteViewResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16186,14 +21637,14 @@ func (p *TraceServiceGetTraceResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTraceResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceDeleteViewResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTraceResponse()
+	_field := NewDeleteViewResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16203,11 +21654,11 @@ func (p *TraceServiceGetTraceResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceGetTraceResult) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteViewResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTraceResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -16216,7 +21667,7 @@ func (p *TraceServiceGetTraceResult) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceGetTraceResult) BLength() int {
+func (p *TraceServiceDeleteViewResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -16225,7 +21676,7 @@ func (p *TraceServiceGetTraceResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTraceResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -16234,7 +21685,7 @@ func (p *TraceServiceGetTraceResult) fastWriteField0(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceGetTraceResult) field0Length() int {
+func (p *TraceServiceDeleteViewResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16243,15 +21694,15 @@ func (p *TraceServiceGetTraceResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceGetTraceResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTraceResult)
+func (p *TraceServiceDeleteViewResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteViewResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetTraceResponse
+	var _success *DeleteViewResponse
 	if src.Success != nil {
-		_success = &GetTraceResponse{}
+		_success = &DeleteViewResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -16261,7 +21712,7 @@ func (p *TraceServiceGetTraceResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListViewsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16305,14 +21756,14 @@ func (p *TraceServiceSearchTraceTreeArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceSearchTraceTreeArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceListViewsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSearchTraceTreeRequest()
+	_field := NewListViewsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16322,11 +21773,11 @@ func (p *TraceServiceSearchTraceTreeArgs) FastReadField1(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceListViewsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListViewsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -16335,7 +21786,7 @@ func (p *TraceServiceSearchTraceTreeArgs) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) BLength() int {
+func (p *TraceServiceListViewsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16344,29 +21795,29 @@ func (p *TraceServiceSearchTraceTreeArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListViewsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) field1Length() int {
+func (p *TraceServiceListViewsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceSearchTraceTreeArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceSearchTraceTreeArgs)
+func (p *TraceServiceListViewsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListViewsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *SearchTraceTreeRequest
+	var _req *ListViewsRequest
 	if src.Req != nil {
-		_req = &SearchTraceTreeRequest{}
+		_req = &ListViewsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -16376,7 +21827,7 @@ func (p *TraceServiceSearchTraceTreeArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceSearchTraceTreeResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListViewsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16420,14 +21871,14 @@ func (p *TraceServiceSearchTraceTreeResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceSearchTraceTreeResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceSearchTraceTreeResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceListViewsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSearchTraceTreeResponse()
+	_field := NewListViewsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16437,11 +21888,11 @@ func (p *TraceServiceSearchTraceTreeResult) FastReadField0(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *TraceServiceSearchTraceTreeResult) FastWrite(buf []byte) int {
+func (p *TraceServiceListViewsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceSearchTraceTreeResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListViewsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -16450,7 +21901,7 @@ func (p *TraceServiceSearchTraceTreeResult) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceSearchTraceTreeResult) BLength() int {
+func (p *TraceServiceListViewsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -16459,7 +21910,7 @@ func (p *TraceServiceSearchTraceTreeResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceSearchTraceTreeResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListViewsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -16468,7 +21919,7 @@ func (p *TraceServiceSearchTraceTreeResult) fastWriteField0(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceSearchTraceTreeResult) field0Length() int {
+func (p *TraceServiceListViewsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16477,15 +21928,15 @@ func (p *TraceServiceSearchTraceTreeResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceSearchTraceTreeResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceSearchTraceTreeResult)
+func (p *TraceServiceListViewsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListViewsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *SearchTraceTreeResponse
+	var _success *ListViewsResponse
 	if src.Success != nil {
-		_success = &SearchTraceTreeResponse{}
+		_success = &ListViewsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -16495,7 +21946,7 @@ func (p *TraceServiceSearchTraceTreeResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceCreateManualAnnotationArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16539,14 +21990,14 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastRead(buf []byte) (int, e
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceCreateManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetTracesAdvanceInfoRequest()
+	_field := NewCreateManualAnnotationRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16556,11 +22007,11 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastReadField1(buf []byte) (
 	return offset, nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateManualAnnotationArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -16569,7 +22020,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) BLength() int {
+func (p *TraceServiceCreateManualAnnotationArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16578,29 +22029,29 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) field1Length() int {
+func (p *TraceServiceCreateManualAnnotationArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceBatchGetTracesAdvanceInfoArgs)
+func (p *TraceServiceCreateManualAnnotationArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateManualAnnotationArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *BatchGetTracesAdvanceInfoRequest
+	var _req *CreateManualAnnotationRequest
 	if src.Req != nil {
-		_req = &BatchGetTracesAdvanceInfoRequest{}
+		_req = &CreateManualAnnotationRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -16610,7 +22061,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoArgs) DeepCopy(s interface{}) erro
 	return nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceCreateManualAnnotationResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16654,14 +22105,14 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceBatchGetTracesAdvanceInfoResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceCreateManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewBatchGetTracesAdvanceInfoResponse()
+	_field := NewCreateManualAnnotationResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16671,11 +22122,11 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWrite(buf []byte) int {
+func (p *TraceServiceCreateManualAnnotationResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -16684,7 +22135,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) FastWriteNocopy(buf []byte
 	return offset
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) BLength() int {
+func (p *TraceServiceCreateManualAnnotationResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -16693,7 +22144,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceCreateManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -16702,7 +22153,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) fastWriteField0(buf []byte
 	return offset
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) field0Length() int {
+func (p *TraceServiceCreateManualAnnotationResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16711,15 +22162,15 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceBatchGetTracesAdvanceInfoResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceBatchGetTracesAdvanceInfoResult)
+func (p *TraceServiceCreateManualAnnotationResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceCreateManualAnnotationResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *BatchGetTracesAdvanceInfoResponse
+	var _success *CreateManualAnnotationResponse
 	if src.Success != nil {
-		_success = &BatchGetTracesAdvanceInfoResponse{}
+		_success = &CreateManualAnnotationResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -16729,7 +22180,7 @@ func (p *TraceServiceBatchGetTracesAdvanceInfoResult) DeepCopy(s interface{}) er
 	return nil
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateManualAnnotationArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16773,14 +22224,14 @@ func (p *TraceServiceIngestTracesInnerArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceUpdateManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIngestTracesRequest()
+	_field := NewUpdateManualAnnotationRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16790,11 +22241,11 @@ func (p *TraceServiceIngestTracesInnerArgs) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateManualAnnotationArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -16803,7 +22254,7 @@ func (p *TraceServiceIngestTracesInnerArgs) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) BLength() int {
+func (p *TraceServiceUpdateManualAnnotationArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -16812,29 +22263,29 @@ func (p *TraceServiceIngestTracesInnerArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) field1Length() int {
+func (p *TraceServiceUpdateManualAnnotationArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceIngestTracesInnerArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceIngestTracesInnerArgs)
+func (p *TraceServiceUpdateManualAnnotationArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateManualAnnotationArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *IngestTracesRequest
+	var _req *UpdateManualAnnotationRequest
 	if src.Req != nil {
-		_req = &IngestTracesRequest{}
+		_req = &UpdateManualAnnotationRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -16844,7 +22295,7 @@ func (p *TraceServiceIngestTracesInnerArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceIngestTracesInnerResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpdateManualAnnotationResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -16888,14 +22339,14 @@ func (p *TraceServiceIngestTracesInnerResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceIngestTracesInnerResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceIngestTracesInnerResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceUpdateManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIngestTracesResponse()
+	_field := NewUpdateManualAnnotationResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -16905,11 +22356,11 @@ func (p *TraceServiceIngestTracesInnerResult) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *TraceServiceIngestTracesInnerResult) FastWrite(buf []byte) int {
+func (p *TraceServiceUpdateManualAnnotationResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceIngestTracesInnerResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -16918,7 +22369,7 @@ func (p *TraceServiceIngestTracesInnerResult) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *TraceServiceIngestTracesInnerResult) BLength() int {
+func (p *TraceServiceUpdateManualAnnotationResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -16927,7 +22378,7 @@ func (p *TraceServiceIngestTracesInnerResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceIngestTracesInnerResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpdateManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -16936,7 +22387,7 @@ func (p *TraceServiceIngestTracesInnerResult) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *TraceServiceIngestTracesInnerResult) field0Length() int {
+func (p *TraceServiceUpdateManualAnnotationResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -16945,15 +22396,15 @@ func (p *TraceServiceIngestTracesInnerResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceIngestTracesInnerResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceIngestTracesInnerResult)
+func (p *TraceServiceUpdateManualAnnotationResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpdateManualAnnotationResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *IngestTracesResponse
+	var _success *UpdateManualAnnotationResponse
 	if src.Success != nil {
-		_success = &IngestTracesResponse{}
+		_success = &UpdateManualAnnotationResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -16963,7 +22414,7 @@ func (p *TraceServiceIngestTracesInnerResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteManualAnnotationArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17007,14 +22458,14 @@ func (p *TraceServiceGetTracesMetaInfoArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceDeleteManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTracesMetaInfoRequest()
+	_field := NewDeleteManualAnnotationRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17024,11 +22475,11 @@ func (p *TraceServiceGetTracesMetaInfoArgs) FastReadField1(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteManualAnnotationArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17037,7 +22488,7 @@ func (p *TraceServiceGetTracesMetaInfoArgs) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) BLength() int {
+func (p *TraceServiceDeleteManualAnnotationArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17046,29 +22497,29 @@ func (p *TraceServiceGetTracesMetaInfoArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) field1Length() int {
+func (p *TraceServiceDeleteManualAnnotationArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceGetTracesMetaInfoArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTracesMetaInfoArgs)
+func (p *TraceServiceDeleteManualAnnotationArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteManualAnnotationArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetTracesMetaInfoRequest
+	var _req *DeleteManualAnnotationRequest
 	if src.Req != nil {
-		_req = &GetTracesMetaInfoRequest{}
+		_req = &DeleteManualAnnotationRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -17078,7 +22529,7 @@ func (p *TraceServiceGetTracesMetaInfoArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDeleteManualAnnotationResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17122,14 +22573,14 @@ func (p *TraceServiceGetTracesMetaInfoResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTracesMetaInfoResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceDeleteManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTracesMetaInfoResponse()
+	_field := NewDeleteManualAnnotationResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17139,11 +22590,11 @@ func (p *TraceServiceGetTracesMetaInfoResult) FastReadField0(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) FastWrite(buf []byte) int {
+func (p *TraceServiceDeleteManualAnnotationResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17152,7 +22603,7 @@ func (p *TraceServiceGetTracesMetaInfoResult) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) BLength() int {
+func (p *TraceServiceDeleteManualAnnotationResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17161,7 +22612,7 @@ func (p *TraceServiceGetTracesMetaInfoResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDeleteManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17170,7 +22621,7 @@ func (p *TraceServiceGetTracesMetaInfoResult) fastWriteField0(buf []byte, w thri
 	return offset
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) field0Length() int {
+func (p *TraceServiceDeleteManualAnnotationResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17179,15 +22630,15 @@ func (p *TraceServiceGetTracesMetaInfoResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceGetTracesMetaInfoResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTracesMetaInfoResult)
+func (p *TraceServiceDeleteManualAnnotationResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDeleteManualAnnotationResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetTracesMetaInfoResponse
+	var _success *DeleteManualAnnotationResponse
 	if src.Success != nil {
-		_success = &GetTracesMetaInfoResponse{}
+		_success = &DeleteManualAnnotationResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -17197,7 +22648,7 @@ func (p *TraceServiceGetTracesMetaInfoResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceCreateViewArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17241,14 +22692,14 @@ func (p *TraceServiceCreateViewArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceCreateViewArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateViewRequest()
+	_field := NewListAnnotationsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17258,11 +22709,11 @@ func (p *TraceServiceCreateViewArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceCreateViewArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceListAnnotationsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceCreateViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17271,7 +22722,7 @@ func (p *TraceServiceCreateViewArgs) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceCreateViewArgs) BLength() int {
+func (p *TraceServiceListAnnotationsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17280,29 +22731,29 @@ func (p *TraceServiceCreateViewArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceCreateViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceCreateViewArgs) field1Length() int {
+func (p *TraceServiceListAnnotationsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceCreateViewArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceCreateViewArgs)
+func (p *TraceServiceListAnnotationsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListAnnotationsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateViewRequest
+	var _req *ListAnnotationsRequest
 	if src.Req != nil {
-		_req = &CreateViewRequest{}
+		_req = &ListAnnotationsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -17312,7 +22763,7 @@ func (p *TraceServiceCreateViewArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceCreateViewResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17356,14 +22807,14 @@ func (p *TraceServiceCreateViewResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateViewResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceCreateViewResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateViewResponse()
+	_field := NewListAnnotationsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17373,11 +22824,11 @@ func (p *TraceServiceCreateViewResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceCreateViewResult) FastWrite(buf []byte) int {
+func (p *TraceServiceListAnnotationsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceCreateViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17386,7 +22837,7 @@ func (p *TraceServiceCreateViewResult) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceCreateViewResult) BLength() int {
+func (p *TraceServiceListAnnotationsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17395,7 +22846,7 @@ func (p *TraceServiceCreateViewResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceCreateViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17404,7 +22855,7 @@ func (p *TraceServiceCreateViewResult) fastWriteField0(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceCreateViewResult) field0Length() int {
+func (p *TraceServiceListAnnotationsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17413,15 +22864,15 @@ func (p *TraceServiceCreateViewResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceCreateViewResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceCreateViewResult)
+func (p *TraceServiceListAnnotationsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListAnnotationsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateViewResponse
+	var _success *ListAnnotationsResponse
 	if src.Success != nil {
-		_success = &CreateViewResponse{}
+		_success = &ListAnnotationsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -17431,7 +22882,7 @@ func (p *TraceServiceCreateViewResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceUpdateViewArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToDatasetArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17475,14 +22926,14 @@ func (p *TraceServiceUpdateViewArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpdateViewArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToDatasetArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateViewRequest()
+	_field := NewExportTracesToDatasetRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17492,11 +22943,11 @@ func (p *TraceServiceUpdateViewArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceUpdateViewArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceExportTracesToDatasetArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpdateViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17505,7 +22956,7 @@ func (p *TraceServiceUpdateViewArgs) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceUpdateViewArgs) BLength() int {
+func (p *TraceServiceExportTracesToDatasetArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17514,29 +22965,29 @@ func (p *TraceServiceUpdateViewArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpdateViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToDatasetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceUpdateViewArgs) field1Length() int {
+func (p *TraceServiceExportTracesToDatasetArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceUpdateViewArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpdateViewArgs)
+func (p *TraceServiceExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceExportTracesToDatasetArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateViewRequest
+	var _req *ExportTracesToDatasetRequest
 	if src.Req != nil {
-		_req = &UpdateViewRequest{}
+		_req = &ExportTracesToDatasetRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -17546,7 +22997,7 @@ func (p *TraceServiceUpdateViewArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceUpdateViewResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToDatasetResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17590,14 +23041,14 @@ func (p *TraceServiceUpdateViewResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateViewResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpdateViewResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToDatasetResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateViewResponse()
+	_field := NewExportTracesToDatasetResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17607,11 +23058,11 @@ func (p *TraceServiceUpdateViewResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceUpdateViewResult) FastWrite(buf []byte) int {
+func (p *TraceServiceExportTracesToDatasetResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpdateViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17620,7 +23071,7 @@ func (p *TraceServiceUpdateViewResult) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceUpdateViewResult) BLength() int {
+func (p *TraceServiceExportTracesToDatasetResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17629,7 +23080,7 @@ func (p *TraceServiceUpdateViewResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpdateViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToDatasetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17638,7 +23089,7 @@ func (p *TraceServiceUpdateViewResult) fastWriteField0(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceUpdateViewResult) field0Length() int {
+func (p *TraceServiceExportTracesToDatasetResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17647,15 +23098,15 @@ func (p *TraceServiceUpdateViewResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceUpdateViewResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpdateViewResult)
+func (p *TraceServiceExportTracesToDatasetResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceExportTracesToDatasetResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateViewResponse
+	var _success *ExportTracesToDatasetResponse
 	if src.Success != nil {
-		_success = &UpdateViewResponse{}
+		_success = &ExportTracesToDatasetResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -17665,7 +23116,7 @@ func (p *TraceServiceUpdateViewResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceDeleteViewArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17709,14 +23160,14 @@ func (p *TraceServiceDeleteViewArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceDeleteViewArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteViewRequest()
+	_field := NewPreviewExportTracesToDatasetRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17726,11 +23177,11 @@ func (p *TraceServiceDeleteViewArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceDeleteViewArgs) FastWrite(buf []byte) int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceDeleteViewArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17739,7 +23190,7 @@ func (p *TraceServiceDeleteViewArgs) FastWriteNocopy(buf []byte, w thrift.Nocopy
 	return offset
 }
 
-func (p *TraceServiceDeleteViewArgs) BLength() int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17748,29 +23199,29 @@ func (p *TraceServiceDeleteViewArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceDeleteViewArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceDeleteViewArgs) field1Length() int {
+func (p *TraceServicePreviewExportTracesToDatasetArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceDeleteViewArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceDeleteViewArgs)
+func (p *TraceServicePreviewExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServicePreviewExportTracesToDatasetArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteViewRequest
+	var _req *PreviewExportTracesToDatasetRequest
 	if src.Req != nil {
-		_req = &DeleteViewRequest{}
+		_req = &PreviewExportTracesToDatasetRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -17780,7 +23231,7 @@ func (p *TraceServiceDeleteViewArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceDeleteViewResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServicePreviewExportTracesToDatasetResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17824,14 +23275,14 @@ func (p *TraceServiceDeleteViewResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteViewResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceDeleteViewResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServicePreviewExportTracesToDatasetResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteViewResponse()
+	_field := NewPreviewExportTracesToDatasetResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17841,11 +23292,11 @@ func (p *TraceServiceDeleteViewResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceDeleteViewResult) FastWrite(buf []byte) int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceDeleteViewResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -17854,7 +23305,7 @@ func (p *TraceServiceDeleteViewResult) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceDeleteViewResult) BLength() int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -17863,7 +23314,7 @@ func (p *TraceServiceDeleteViewResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceDeleteViewResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -17872,7 +23323,7 @@ func (p *TraceServiceDeleteViewResult) fastWriteField0(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *TraceServiceDeleteViewResult) field0Length() int {
+func (p *TraceServicePreviewExportTracesToDatasetResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -17881,15 +23332,15 @@ func (p *TraceServiceDeleteViewResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceDeleteViewResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceDeleteViewResult)
+func (p *TraceServicePreviewExportTracesToDatasetResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServicePreviewExportTracesToDatasetResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteViewResponse
+	var _success *PreviewExportTracesToDatasetResponse
 	if src.Success != nil {
-		_success = &DeleteViewResponse{}
+		_success = &PreviewExportTracesToDatasetResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -17899,7 +23350,7 @@ func (p *TraceServiceDeleteViewResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListViewsArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceChangeEvaluatorScoreArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -17943,14 +23394,14 @@ func (p *TraceServiceListViewsArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceChangeEvaluatorScoreArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListViewsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceChangeEvaluatorScoreArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListViewsRequest()
+	_field := NewChangeEvaluatorScoreRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -17960,11 +23411,11 @@ func (p *TraceServiceListViewsArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceListViewsArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceChangeEvaluatorScoreArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListViewsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceChangeEvaluatorScoreArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -17973,7 +23424,7 @@ func (p *TraceServiceListViewsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *TraceServiceListViewsArgs) BLength() int {
+func (p *TraceServiceChangeEvaluatorScoreArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -17982,29 +23433,29 @@ func (p *TraceServiceListViewsArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListViewsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceChangeEvaluatorScoreArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceListViewsArgs) field1Length() int {
+func (p *TraceServiceChangeEvaluatorScoreArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceListViewsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListViewsArgs)
+func (p *TraceServiceChangeEvaluatorScoreArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceChangeEvaluatorScoreArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListViewsRequest
+	var _req *ChangeEvaluatorScoreRequest
 	if src.Req != nil {
-		_req = &ListViewsRequest{}
+		_req = &ChangeEvaluatorScoreRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -18014,7 +23465,7 @@ func (p *TraceServiceListViewsArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListViewsResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceChangeEvaluatorScoreResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18058,14 +23509,14 @@ func (p *TraceServiceListViewsResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListViewsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceChangeEvaluatorScoreResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListViewsResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceChangeEvaluatorScoreResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListViewsResponse()
+	_field := NewChangeEvaluatorScoreResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18075,11 +23526,11 @@ func (p *TraceServiceListViewsResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *TraceServiceListViewsResult) FastWrite(buf []byte) int {
+func (p *TraceServiceChangeEvaluatorScoreResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListViewsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceChangeEvaluatorScoreResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18088,7 +23539,7 @@ func (p *TraceServiceListViewsResult) FastWriteNocopy(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *TraceServiceListViewsResult) BLength() int {
+func (p *TraceServiceChangeEvaluatorScoreResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18097,7 +23548,7 @@ func (p *TraceServiceListViewsResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListViewsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceChangeEvaluatorScoreResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18106,7 +23557,7 @@ func (p *TraceServiceListViewsResult) fastWriteField0(buf []byte, w thrift.Nocop
 	return offset
 }
 
-func (p *TraceServiceListViewsResult) field0Length() int {
+func (p *TraceServiceChangeEvaluatorScoreResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18115,15 +23566,15 @@ func (p *TraceServiceListViewsResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceListViewsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListViewsResult)
+func (p *TraceServiceChangeEvaluatorScoreResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceChangeEvaluatorScoreResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListViewsResponse
+	var _success *ChangeEvaluatorScoreResponse
 	if src.Success != nil {
-		_success = &ListViewsResponse{}
+		_success = &ChangeEvaluatorScoreResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18133,7 +23584,7 @@ func (p *TraceServiceListViewsResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18177,14 +23628,14 @@ func (p *TraceServiceCreateManualAnnotationArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationEvaluatorsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateManualAnnotationRequest()
+	_field := NewListAnnotationEvaluatorsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18194,11 +23645,11 @@ func (p *TraceServiceCreateManualAnnotationArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18207,7 +23658,7 @@ func (p *TraceServiceCreateManualAnnotationArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) BLength() int {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18216,29 +23667,29 @@ func (p *TraceServiceCreateManualAnnotationArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) field1Length() int {
+func (p *TraceServiceListAnnotationEvaluatorsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceCreateManualAnnotationArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceCreateManualAnnotationArgs)
+func (p *TraceServiceListAnnotationEvaluatorsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListAnnotationEvaluatorsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *CreateManualAnnotationRequest
+	var _req *ListAnnotationEvaluatorsRequest
 	if src.Req != nil {
-		_req = &CreateManualAnnotationRequest{}
+		_req = &ListAnnotationEvaluatorsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -18248,7 +23699,7 @@ func (p *TraceServiceCreateManualAnnotationArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationEvaluatorsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18292,14 +23743,14 @@ func (p *TraceServiceCreateManualAnnotationResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceCreateManualAnnotationResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationEvaluatorsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceListAnnotationEvaluatorsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewCreateManualAnnotationResponse()
+	_field := NewListAnnotationEvaluatorsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18309,11 +23760,11 @@ func (p *TraceServiceCreateManualAnnotationResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) FastWrite(buf []byte) int {
+func (p *TraceServiceListAnnotationEvaluatorsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationEvaluatorsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18322,7 +23773,7 @@ func (p *TraceServiceCreateManualAnnotationResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) BLength() int {
+func (p *TraceServiceListAnnotationEvaluatorsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18331,7 +23782,7 @@ func (p *TraceServiceCreateManualAnnotationResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListAnnotationEvaluatorsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18340,7 +23791,7 @@ func (p *TraceServiceCreateManualAnnotationResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) field0Length() int {
+func (p *TraceServiceListAnnotationEvaluatorsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18349,15 +23800,15 @@ func (p *TraceServiceCreateManualAnnotationResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceCreateManualAnnotationResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceCreateManualAnnotationResult)
+func (p *TraceServiceListAnnotationEvaluatorsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListAnnotationEvaluatorsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *CreateManualAnnotationResponse
+	var _success *ListAnnotationEvaluatorsResponse
 	if src.Success != nil {
-		_success = &CreateManualAnnotationResponse{}
+		_success = &ListAnnotationEvaluatorsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18367,7 +23818,7 @@ func (p *TraceServiceCreateManualAnnotationResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceExtractSpanInfoArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18411,14 +23862,14 @@ func (p *TraceServiceUpdateManualAnnotationArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExtractSpanInfoArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceExtractSpanInfoArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateManualAnnotationRequest()
+	_field := NewExtractSpanInfoRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18428,11 +23879,11 @@ func (p *TraceServiceUpdateManualAnnotationArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceExtractSpanInfoArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExtractSpanInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18441,7 +23892,7 @@ func (p *TraceServiceUpdateManualAnnotationArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) BLength() int {
+func (p *TraceServiceExtractSpanInfoArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18450,29 +23901,29 @@ func (p *TraceServiceUpdateManualAnnotationArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExtractSpanInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) field1Length() int {
+func (p *TraceServiceExtractSpanInfoArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceUpdateManualAnnotationArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpdateManualAnnotationArgs)
+func (p *TraceServiceExtractSpanInfoArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceExtractSpanInfoArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpdateManualAnnotationRequest
+	var _req *ExtractSpanInfoRequest
 	if src.Req != nil {
-		_req = &UpdateManualAnnotationRequest{}
+		_req = &ExtractSpanInfoRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -18482,7 +23933,7 @@ func (p *TraceServiceUpdateManualAnnotationArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceExtractSpanInfoResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18526,14 +23977,14 @@ func (p *TraceServiceUpdateManualAnnotationResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpdateManualAnnotationResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExtractSpanInfoResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceExtractSpanInfoResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpdateManualAnnotationResponse()
+	_field := NewExtractSpanInfoResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18543,11 +23994,11 @@ func (p *TraceServiceUpdateManualAnnotationResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) FastWrite(buf []byte) int {
+func (p *TraceServiceExtractSpanInfoResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExtractSpanInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18556,7 +24007,7 @@ func (p *TraceServiceUpdateManualAnnotationResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) BLength() int {
+func (p *TraceServiceExtractSpanInfoResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18565,7 +24016,7 @@ func (p *TraceServiceUpdateManualAnnotationResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExtractSpanInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18574,7 +24025,7 @@ func (p *TraceServiceUpdateManualAnnotationResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) field0Length() int {
+func (p *TraceServiceExtractSpanInfoResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18583,15 +24034,15 @@ func (p *TraceServiceUpdateManualAnnotationResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceUpdateManualAnnotationResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpdateManualAnnotationResult)
+func (p *TraceServiceExtractSpanInfoResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceExtractSpanInfoResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpdateManualAnnotationResponse
+	var _success *ExtractSpanInfoResponse
 	if src.Success != nil {
-		_success = &UpdateManualAnnotationResponse{}
+		_success = &ExtractSpanInfoResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18601,7 +24052,7 @@ func (p *TraceServiceUpdateManualAnnotationResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18645,14 +24096,14 @@ func (p *TraceServiceDeleteManualAnnotationArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertTrajectoryConfigArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteManualAnnotationRequest()
+	_field := NewUpsertTrajectoryConfigRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18662,11 +24113,11 @@ func (p *TraceServiceDeleteManualAnnotationArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18675,7 +24126,7 @@ func (p *TraceServiceDeleteManualAnnotationArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) BLength() int {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18684,29 +24135,29 @@ func (p *TraceServiceDeleteManualAnnotationArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) field1Length() int {
+func (p *TraceServiceUpsertTrajectoryConfigArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceDeleteManualAnnotationArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceDeleteManualAnnotationArgs)
+func (p *TraceServiceUpsertTrajectoryConfigArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpsertTrajectoryConfigArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *DeleteManualAnnotationRequest
+	var _req *UpsertTrajectoryConfigRequest
 	if src.Req != nil {
-		_req = &DeleteManualAnnotationRequest{}
+		_req = &UpsertTrajectoryConfigRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -18716,7 +24167,7 @@ func (p *TraceServiceDeleteManualAnnotationArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpsertTrajectoryConfigResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18760,14 +24211,14 @@ func (p *TraceServiceDeleteManualAnnotationResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDeleteManualAnnotationResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertTrajectoryConfigResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceUpsertTrajectoryConfigResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteManualAnnotationResponse()
+	_field := NewUpsertTrajectoryConfigResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18777,11 +24228,11 @@ func (p *TraceServiceDeleteManualAnnotationResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) FastWrite(buf []byte) int {
+func (p *TraceServiceUpsertTrajectoryConfigResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpsertTrajectoryConfigResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -18790,7 +24241,7 @@ func (p *TraceServiceDeleteManualAnnotationResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) BLength() int {
+func (p *TraceServiceUpsertTrajectoryConfigResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -18799,7 +24250,7 @@ func (p *TraceServiceDeleteManualAnnotationResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpsertTrajectoryConfigResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -18808,7 +24259,7 @@ func (p *TraceServiceDeleteManualAnnotationResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) field0Length() int {
+func (p *TraceServiceUpsertTrajectoryConfigResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -18817,15 +24268,15 @@ func (p *TraceServiceDeleteManualAnnotationResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceDeleteManualAnnotationResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceDeleteManualAnnotationResult)
+func (p *TraceServiceUpsertTrajectoryConfigResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpsertTrajectoryConfigResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *DeleteManualAnnotationResponse
+	var _success *UpsertTrajectoryConfigResponse
 	if src.Success != nil {
-		_success = &DeleteManualAnnotationResponse{}
+		_success = &UpsertTrajectoryConfigResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -18835,7 +24286,7 @@ func (p *TraceServiceDeleteManualAnnotationResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServiceListAnnotationsArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceGetTrajectoryConfigArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18879,14 +24330,14 @@ func (p *TraceServiceListAnnotationsArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTrajectoryConfigArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListAnnotationsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceGetTrajectoryConfigArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListAnnotationsRequest()
+	_field := NewGetTrajectoryConfigRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -18896,11 +24347,11 @@ func (p *TraceServiceListAnnotationsArgs) FastReadField1(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *TraceServiceListAnnotationsArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceGetTrajectoryConfigArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListAnnotationsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTrajectoryConfigArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -18909,7 +24360,7 @@ func (p *TraceServiceListAnnotationsArgs) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *TraceServiceListAnnotationsArgs) BLength() int {
+func (p *TraceServiceGetTrajectoryConfigArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -18918,29 +24369,29 @@ func (p *TraceServiceListAnnotationsArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListAnnotationsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTrajectoryConfigArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceListAnnotationsArgs) field1Length() int {
+func (p *TraceServiceGetTrajectoryConfigArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceListAnnotationsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListAnnotationsArgs)
+func (p *TraceServiceGetTrajectoryConfigArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTrajectoryConfigArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListAnnotationsRequest
+	var _req *GetTrajectoryConfigRequest
 	if src.Req != nil {
-		_req = &ListAnnotationsRequest{}
+		_req = &GetTrajectoryConfigRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -18950,7 +24401,7 @@ func (p *TraceServiceListAnnotationsArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListAnnotationsResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceGetTrajectoryConfigResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -18994,14 +24445,14 @@ func (p *TraceServiceListAnnotationsResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTrajectoryConfigResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListAnnotationsResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceGetTrajectoryConfigResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListAnnotationsResponse()
+	_field := NewGetTrajectoryConfigResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19011,11 +24462,11 @@ func (p *TraceServiceListAnnotationsResult) FastReadField0(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *TraceServiceListAnnotationsResult) FastWrite(buf []byte) int {
+func (p *TraceServiceGetTrajectoryConfigResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListAnnotationsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTrajectoryConfigResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19024,7 +24475,7 @@ func (p *TraceServiceListAnnotationsResult) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceListAnnotationsResult) BLength() int {
+func (p *TraceServiceGetTrajectoryConfigResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19033,7 +24484,7 @@ func (p *TraceServiceListAnnotationsResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListAnnotationsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTrajectoryConfigResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19042,7 +24493,7 @@ func (p *TraceServiceListAnnotationsResult) fastWriteField0(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceListAnnotationsResult) field0Length() int {
+func (p *TraceServiceGetTrajectoryConfigResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19051,15 +24502,15 @@ func (p *TraceServiceListAnnotationsResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceListAnnotationsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListAnnotationsResult)
+func (p *TraceServiceGetTrajectoryConfigResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTrajectoryConfigResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListAnnotationsResponse
+	var _success *GetTrajectoryConfigResponse
 	if src.Success != nil {
-		_success = &ListAnnotationsResponse{}
+		_success = &GetTrajectoryConfigResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19069,7 +24520,7 @@ func (p *TraceServiceListAnnotationsResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListTrajectoryArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19113,14 +24564,14 @@ func (p *TraceServiceExportTracesToDatasetArgs) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListTrajectoryArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceListTrajectoryArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExportTracesToDatasetRequest()
+	_field := NewListTrajectoryRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19130,11 +24581,11 @@ func (p *TraceServiceExportTracesToDatasetArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceListTrajectoryArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListTrajectoryArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19143,7 +24594,7 @@ func (p *TraceServiceExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) BLength() int {
+func (p *TraceServiceListTrajectoryArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19152,29 +24603,29 @@ func (p *TraceServiceExportTracesToDatasetArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListTrajectoryArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) field1Length() int {
+func (p *TraceServiceListTrajectoryArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceExportTracesToDatasetArgs)
+func (p *TraceServiceListTrajectoryArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListTrajectoryArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ExportTracesToDatasetRequest
+	var _req *ListTrajectoryRequest
 	if src.Req != nil {
-		_req = &ExportTracesToDatasetRequest{}
+		_req = &ListTrajectoryRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -19184,7 +24635,7 @@ func (p *TraceServiceExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListTrajectoryResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19228,14 +24679,14 @@ func (p *TraceServiceExportTracesToDatasetResult) FastRead(buf []byte) (int, err
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToDatasetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListTrajectoryResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceListTrajectoryResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExportTracesToDatasetResponse()
+	_field := NewListTrajectoryResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19245,11 +24696,11 @@ func (p *TraceServiceExportTracesToDatasetResult) FastReadField0(buf []byte) (in
 	return offset, nil
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) FastWrite(buf []byte) int {
+func (p *TraceServiceListTrajectoryResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListTrajectoryResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19258,7 +24709,7 @@ func (p *TraceServiceExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) BLength() int {
+func (p *TraceServiceListTrajectoryResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19267,7 +24718,7 @@ func (p *TraceServiceExportTracesToDatasetResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListTrajectoryResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19276,7 +24727,7 @@ func (p *TraceServiceExportTracesToDatasetResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) field0Length() int {
+func (p *TraceServiceListTrajectoryResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19285,15 +24736,15 @@ func (p *TraceServiceExportTracesToDatasetResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceExportTracesToDatasetResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceExportTracesToDatasetResult)
+func (p *TraceServiceListTrajectoryResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListTrajectoryResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ExportTracesToDatasetResponse
+	var _success *ListTrajectoryResponse
 	if src.Success != nil {
-		_success = &ExportTracesToDatasetResponse{}
+		_success = &ListTrajectoryResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19303,7 +24754,7 @@ func (p *TraceServiceExportTracesToDatasetResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpsertRetentionPolicyArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19347,14 +24798,14 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) FastRead(buf []byte) (int
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertRetentionPolicyArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceUpsertRetentionPolicyArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPreviewExportTracesToDatasetRequest()
+	_field := NewUpsertRetentionPolicyRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19364,11 +24815,11 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) FastReadField1(buf []byte
 	return offset, nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceUpsertRetentionPolicyArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpsertRetentionPolicyArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19377,7 +24828,7 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) FastWriteNocopy(buf []byt
 	return offset
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) BLength() int {
+func (p *TraceServiceUpsertRetentionPolicyArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19386,29 +24837,29 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpsertRetentionPolicyArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) field1Length() int {
+func (p *TraceServiceUpsertRetentionPolicyArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServicePreviewExportTracesToDatasetArgs)
+func (p *TraceServiceUpsertRetentionPolicyArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpsertRetentionPolicyArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *PreviewExportTracesToDatasetRequest
+	var _req *UpsertRetentionPolicyRequest
 	if src.Req != nil {
-		_req = &PreviewExportTracesToDatasetRequest{}
+		_req = &UpsertRetentionPolicyRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -19418,7 +24869,7 @@ func (p *TraceServicePreviewExportTracesToDatasetArgs) DeepCopy(s interface{}) e
 	return nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceUpsertRetentionPolicyResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19462,14 +24913,14 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) FastRead(buf []byte) (i
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServicePreviewExportTracesToDatasetResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertRetentionPolicyResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceUpsertRetentionPolicyResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPreviewExportTracesToDatasetResponse()
+	_field := NewUpsertRetentionPolicyResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19479,11 +24930,11 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) FastReadField0(buf []by
 	return offset, nil
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) FastWrite(buf []byte) int {
+func (p *TraceServiceUpsertRetentionPolicyResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpsertRetentionPolicyResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19492,7 +24943,7 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) FastWriteNocopy(buf []b
 	return offset
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) BLength() int {
+func (p *TraceServiceUpsertRetentionPolicyResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19501,7 +24952,7 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) BLength() int {
 	return l
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceUpsertRetentionPolicyResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19510,7 +24961,7 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) fastWriteField0(buf []b
 	return offset
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) field0Length() int {
+func (p *TraceServiceUpsertRetentionPolicyResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19519,15 +24970,15 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServicePreviewExportTracesToDatasetResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServicePreviewExportTracesToDatasetResult)
+func (p *TraceServiceUpsertRetentionPolicyResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceUpsertRetentionPolicyResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *PreviewExportTracesToDatasetResponse
+	var _success *UpsertRetentionPolicyResponse
 	if src.Success != nil {
-		_success = &PreviewExportTracesToDatasetResponse{}
+		_success = &UpsertRetentionPolicyResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19537,7 +24988,7 @@ func (p *TraceServicePreviewExportTracesToDatasetResult) DeepCopy(s interface{})
 	return nil
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceGetRetentionPolicyArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19581,14 +25032,14 @@ func (p *TraceServiceChangeEvaluatorScoreArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceChangeEvaluatorScoreArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetRetentionPolicyArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceGetRetentionPolicyArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewChangeEvaluatorScoreRequest()
+	_field := NewGetRetentionPolicyRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19598,11 +25049,11 @@ func (p *TraceServiceChangeEvaluatorScoreArgs) FastReadField1(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceGetRetentionPolicyArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetRetentionPolicyArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19611,7 +25062,7 @@ func (p *TraceServiceChangeEvaluatorScoreArgs) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) BLength() int {
+func (p *TraceServiceGetRetentionPolicyArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19620,29 +25071,29 @@ func (p *TraceServiceChangeEvaluatorScoreArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetRetentionPolicyArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) field1Length() int {
+func (p *TraceServiceGetRetentionPolicyArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceChangeEvaluatorScoreArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceChangeEvaluatorScoreArgs)
+func (p *TraceServiceGetRetentionPolicyArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetRetentionPolicyArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ChangeEvaluatorScoreRequest
+	var _req *GetRetentionPolicyRequest
 	if src.Req != nil {
-		_req = &ChangeEvaluatorScoreRequest{}
+		_req = &GetRetentionPolicyRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -19652,7 +25103,7 @@ func (p *TraceServiceChangeEvaluatorScoreArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceGetRetentionPolicyResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19696,14 +25147,14 @@ func (p *TraceServiceChangeEvaluatorScoreResult) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceChangeEvaluatorScoreResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetRetentionPolicyResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceGetRetentionPolicyResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewChangeEvaluatorScoreResponse()
+	_field := NewGetRetentionPolicyResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19713,11 +25164,11 @@ func (p *TraceServiceChangeEvaluatorScoreResult) FastReadField0(buf []byte) (int
 	return offset, nil
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) FastWrite(buf []byte) int {
+func (p *TraceServiceGetRetentionPolicyResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetRetentionPolicyResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19726,7 +25177,7 @@ func (p *TraceServiceChangeEvaluatorScoreResult) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) BLength() int {
+func (p *TraceServiceGetRetentionPolicyResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19735,7 +25186,7 @@ func (p *TraceServiceChangeEvaluatorScoreResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetRetentionPolicyResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19744,7 +25195,7 @@ func (p *TraceServiceChangeEvaluatorScoreResult) fastWriteField0(buf []byte, w t
 	return offset
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) field0Length() int {
+func (p *TraceServiceGetRetentionPolicyResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19753,15 +25204,15 @@ func (p *TraceServiceChangeEvaluatorScoreResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceChangeEvaluatorScoreResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceChangeEvaluatorScoreResult)
+func (p *TraceServiceGetRetentionPolicyResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetRetentionPolicyResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ChangeEvaluatorScoreResponse
+	var _success *GetRetentionPolicyResponse
 	if src.Success != nil {
-		_success = &ChangeEvaluatorScoreResponse{}
+		_success = &GetRetentionPolicyResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -19771,7 +25222,7 @@ func (p *TraceServiceChangeEvaluatorScoreResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDiffTracesArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19815,14 +25266,14 @@ func (p *TraceServiceListAnnotationEvaluatorsArgs) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationEvaluatorsArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDiffTracesArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceDiffTracesArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListAnnotationEvaluatorsRequest()
+	_field := NewDiffTracesRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19832,11 +25283,11 @@ func (p *TraceServiceListAnnotationEvaluatorsArgs) FastReadField1(buf []byte) (i
 	return offset, nil
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceDiffTracesArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDiffTracesArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -19845,7 +25296,7 @@ func (p *TraceServiceListAnnotationEvaluatorsArgs) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) BLength() int {
+func (p *TraceServiceDiffTracesArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -19854,29 +25305,29 @@ func (p *TraceServiceListAnnotationEvaluatorsArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDiffTracesArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) field1Length() int {
+func (p *TraceServiceDiffTracesArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListAnnotationEvaluatorsArgs)
+func (p *TraceServiceDiffTracesArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDiffTracesArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListAnnotationEvaluatorsRequest
+	var _req *DiffTracesRequest
 	if src.Req != nil {
-		_req = &ListAnnotationEvaluatorsRequest{}
+		_req = &DiffTracesRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -19886,7 +25337,7 @@ func (p *TraceServiceListAnnotationEvaluatorsArgs) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceDiffTracesResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -19930,14 +25381,14 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) FastRead(buf []byte) (int,
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListAnnotationEvaluatorsResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceDiffTracesResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceDiffTracesResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListAnnotationEvaluatorsResponse()
+	_field := NewDiffTracesResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -19947,11 +25398,11 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) FastReadField0(buf []byte)
 	return offset, nil
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) FastWrite(buf []byte) int {
+func (p *TraceServiceDiffTracesResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDiffTracesResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -19960,7 +25411,7 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) FastWriteNocopy(buf []byte,
 	return offset
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) BLength() int {
+func (p *TraceServiceDiffTracesResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -19969,7 +25420,7 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceDiffTracesResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -19978,7 +25429,7 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) fastWriteField0(buf []byte,
 	return offset
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) field0Length() int {
+func (p *TraceServiceDiffTracesResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -19987,15 +25438,15 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceListAnnotationEvaluatorsResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListAnnotationEvaluatorsResult)
+func (p *TraceServiceDiffTracesResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceDiffTracesResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListAnnotationEvaluatorsResponse
+	var _success *DiffTracesResponse
 	if src.Success != nil {
-		_success = &ListAnnotationEvaluatorsResponse{}
+		_success = &DiffTracesResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20005,7 +25456,7 @@ func (p *TraceServiceListAnnotationEvaluatorsResult) DeepCopy(s interface{}) err
 	return nil
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListSessionsArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20049,14 +25500,14 @@ func (p *TraceServiceExtractSpanInfoArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExtractSpanInfoArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSessionsArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceListSessionsArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExtractSpanInfoRequest()
+	_field := NewListSessionsRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20066,11 +25517,11 @@ func (p *TraceServiceExtractSpanInfoArgs) FastReadField1(buf []byte) (int, error
 	return offset, nil
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceListSessionsArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListSessionsArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20079,7 +25530,7 @@ func (p *TraceServiceExtractSpanInfoArgs) FastWriteNocopy(buf []byte, w thrift.N
 	return offset
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) BLength() int {
+func (p *TraceServiceListSessionsArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20088,29 +25539,29 @@ func (p *TraceServiceExtractSpanInfoArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListSessionsArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) field1Length() int {
+func (p *TraceServiceListSessionsArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceExtractSpanInfoArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceExtractSpanInfoArgs)
+func (p *TraceServiceListSessionsArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListSessionsArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ExtractSpanInfoRequest
+	var _req *ListSessionsRequest
 	if src.Req != nil {
-		_req = &ExtractSpanInfoRequest{}
+		_req = &ListSessionsRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20120,7 +25571,7 @@ func (p *TraceServiceExtractSpanInfoArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceExtractSpanInfoResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceListSessionsResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20164,14 +25615,14 @@ func (p *TraceServiceExtractSpanInfoResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExtractSpanInfoResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListSessionsResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceExtractSpanInfoResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceListSessionsResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewExtractSpanInfoResponse()
+	_field := NewListSessionsResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20181,11 +25632,11 @@ func (p *TraceServiceExtractSpanInfoResult) FastReadField0(buf []byte) (int, err
 	return offset, nil
 }
 
-func (p *TraceServiceExtractSpanInfoResult) FastWrite(buf []byte) int {
+func (p *TraceServiceListSessionsResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceExtractSpanInfoResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListSessionsResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20194,7 +25645,7 @@ func (p *TraceServiceExtractSpanInfoResult) FastWriteNocopy(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceExtractSpanInfoResult) BLength() int {
+func (p *TraceServiceListSessionsResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20203,7 +25654,7 @@ func (p *TraceServiceExtractSpanInfoResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceExtractSpanInfoResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceListSessionsResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20212,7 +25663,7 @@ func (p *TraceServiceExtractSpanInfoResult) fastWriteField0(buf []byte, w thrift
 	return offset
 }
 
-func (p *TraceServiceExtractSpanInfoResult) field0Length() int {
+func (p *TraceServiceListSessionsResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20221,15 +25672,15 @@ func (p *TraceServiceExtractSpanInfoResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceExtractSpanInfoResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceExtractSpanInfoResult)
+func (p *TraceServiceListSessionsResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceListSessionsResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ExtractSpanInfoResponse
+	var _success *ListSessionsResponse
 	if src.Success != nil {
-		_success = &ExtractSpanInfoResponse{}
+		_success = &ListSessionsResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20239,7 +25690,7 @@ func (p *TraceServiceExtractSpanInfoResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceGetSessionArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20283,14 +25734,14 @@ func (p *TraceServiceUpsertTrajectoryConfigArgs) FastRead(buf []byte) (int, erro
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertTrajectoryConfigArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetSessionArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceGetSessionArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpsertTrajectoryConfigRequest()
+	_field := NewGetSessionRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20300,11 +25751,11 @@ func (p *TraceServiceUpsertTrajectoryConfigArgs) FastReadField1(buf []byte) (int
 	return offset, nil
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceGetSessionArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetSessionArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20313,7 +25764,7 @@ func (p *TraceServiceUpsertTrajectoryConfigArgs) FastWriteNocopy(buf []byte, w t
 	return offset
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) BLength() int {
+func (p *TraceServiceGetSessionArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20322,29 +25773,29 @@ func (p *TraceServiceUpsertTrajectoryConfigArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetSessionArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) field1Length() int {
+func (p *TraceServiceGetSessionArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpsertTrajectoryConfigArgs)
+func (p *TraceServiceGetSessionArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetSessionArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *UpsertTrajectoryConfigRequest
+	var _req *GetSessionRequest
 	if src.Req != nil {
-		_req = &UpsertTrajectoryConfigRequest{}
+		_req = &GetSessionRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20354,7 +25805,7 @@ func (p *TraceServiceUpsertTrajectoryConfigArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceGetSessionResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20398,14 +25849,14 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) FastRead(buf []byte) (int, er
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceUpsertTrajectoryConfigResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetSessionResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceGetSessionResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewUpsertTrajectoryConfigResponse()
+	_field := NewGetSessionResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20415,11 +25866,11 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) FastReadField0(buf []byte) (i
 	return offset, nil
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) FastWrite(buf []byte) int {
+func (p *TraceServiceGetSessionResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetSessionResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20428,7 +25879,7 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) FastWriteNocopy(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) BLength() int {
+func (p *TraceServiceGetSessionResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20437,7 +25888,7 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetSessionResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20446,7 +25897,7 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) fastWriteField0(buf []byte, w
 	return offset
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) field0Length() int {
+func (p *TraceServiceGetSessionResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20455,15 +25906,15 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceUpsertTrajectoryConfigResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceUpsertTrajectoryConfigResult)
+func (p *TraceServiceGetSessionResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetSessionResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *UpsertTrajectoryConfigResponse
+	var _success *GetSessionResponse
 	if src.Success != nil {
-		_success = &UpsertTrajectoryConfigResponse{}
+		_success = &GetSessionResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20473,7 +25924,7 @@ func (p *TraceServiceUpsertTrajectoryConfigResult) DeepCopy(s interface{}) error
 	return nil
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToFileArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20517,14 +25968,14 @@ func (p *TraceServiceGetTrajectoryConfigArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTrajectoryConfigArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToFileArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToFileArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTrajectoryConfigRequest()
+	_field := NewExportTracesToFileRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20534,11 +25985,11 @@ func (p *TraceServiceGetTrajectoryConfigArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceExportTracesToFileArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToFileArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20547,7 +25998,7 @@ func (p *TraceServiceGetTrajectoryConfigArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) BLength() int {
+func (p *TraceServiceExportTracesToFileArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20556,29 +26007,29 @@ func (p *TraceServiceGetTrajectoryConfigArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToFileArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) field1Length() int {
+func (p *TraceServiceExportTracesToFileArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceGetTrajectoryConfigArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTrajectoryConfigArgs)
+func (p *TraceServiceExportTracesToFileArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceExportTracesToFileArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *GetTrajectoryConfigRequest
+	var _req *ExportTracesToFileRequest
 	if src.Req != nil {
-		_req = &GetTrajectoryConfigRequest{}
+		_req = &ExportTracesToFileRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20588,7 +26039,7 @@ func (p *TraceServiceGetTrajectoryConfigArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToFileResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20632,14 +26083,14 @@ func (p *TraceServiceGetTrajectoryConfigResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTrajectoryConfigResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceExportTracesToFileResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceExportTracesToFileResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewGetTrajectoryConfigResponse()
+	_field := NewExportTracesToFileResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20649,11 +26100,11 @@ func (p *TraceServiceGetTrajectoryConfigResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) FastWrite(buf []byte) int {
+func (p *TraceServiceExportTracesToFileResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToFileResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20662,7 +26113,7 @@ func (p *TraceServiceGetTrajectoryConfigResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) BLength() int {
+func (p *TraceServiceExportTracesToFileResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20671,7 +26122,7 @@ func (p *TraceServiceGetTrajectoryConfigResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceExportTracesToFileResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20680,7 +26131,7 @@ func (p *TraceServiceGetTrajectoryConfigResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) field0Length() int {
+func (p *TraceServiceExportTracesToFileResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20689,15 +26140,15 @@ func (p *TraceServiceGetTrajectoryConfigResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceGetTrajectoryConfigResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceGetTrajectoryConfigResult)
+func (p *TraceServiceExportTracesToFileResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceExportTracesToFileResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *GetTrajectoryConfigResponse
+	var _success *ExportTracesToFileResponse
 	if src.Success != nil {
-		_success = &GetTrajectoryConfigResponse{}
+		_success = &ExportTracesToFileResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -20707,7 +26158,7 @@ func (p *TraceServiceGetTrajectoryConfigResult) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListTrajectoryArgs) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceGetTraceExportJobArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20751,14 +26202,14 @@ func (p *TraceServiceListTrajectoryArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListTrajectoryArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceExportJobArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListTrajectoryArgs) FastReadField1(buf []byte) (int, error) {
+func (p *TraceServiceGetTraceExportJobArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListTrajectoryRequest()
+	_field := NewGetTraceExportJobRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20768,11 +26219,11 @@ func (p *TraceServiceListTrajectoryArgs) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *TraceServiceListTrajectoryArgs) FastWrite(buf []byte) int {
+func (p *TraceServiceGetTraceExportJobArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListTrajectoryArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTraceExportJobArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -20781,7 +26232,7 @@ func (p *TraceServiceListTrajectoryArgs) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *TraceServiceListTrajectoryArgs) BLength() int {
+func (p *TraceServiceGetTraceExportJobArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -20790,29 +26241,29 @@ func (p *TraceServiceListTrajectoryArgs) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListTrajectoryArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTraceExportJobArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *TraceServiceListTrajectoryArgs) field1Length() int {
+func (p *TraceServiceGetTraceExportJobArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *TraceServiceListTrajectoryArgs) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListTrajectoryArgs)
+func (p *TraceServiceGetTraceExportJobArgs) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTraceExportJobArgs)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _req *ListTrajectoryRequest
+	var _req *GetTraceExportJobRequest
 	if src.Req != nil {
-		_req = &ListTrajectoryRequest{}
+		_req = &GetTraceExportJobRequest{}
 		if err := _req.DeepCopy(src.Req); err != nil {
 			return err
 		}
@@ -20822,7 +26273,7 @@ func (p *TraceServiceListTrajectoryArgs) DeepCopy(s interface{}) error {
 	return nil
 }
 
-func (p *TraceServiceListTrajectoryResult) FastRead(buf []byte) (int, error) {
+func (p *TraceServiceGetTraceExportJobResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -20866,14 +26317,14 @@ func (p *TraceServiceListTrajectoryResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceListTrajectoryResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TraceServiceGetTraceExportJobResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *TraceServiceListTrajectoryResult) FastReadField0(buf []byte) (int, error) {
+func (p *TraceServiceGetTraceExportJobResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewListTrajectoryResponse()
+	_field := NewGetTraceExportJobResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -20883,11 +26334,11 @@ func (p *TraceServiceListTrajectoryResult) FastReadField0(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *TraceServiceListTrajectoryResult) FastWrite(buf []byte) int {
+func (p *TraceServiceGetTraceExportJobResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *TraceServiceListTrajectoryResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTraceExportJobResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -20896,7 +26347,7 @@ func (p *TraceServiceListTrajectoryResult) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *TraceServiceListTrajectoryResult) BLength() int {
+func (p *TraceServiceGetTraceExportJobResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -20905,7 +26356,7 @@ func (p *TraceServiceListTrajectoryResult) BLength() int {
 	return l
 }
 
-func (p *TraceServiceListTrajectoryResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *TraceServiceGetTraceExportJobResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -20914,7 +26365,7 @@ func (p *TraceServiceListTrajectoryResult) fastWriteField0(buf []byte, w thrift.
 	return offset
 }
 
-func (p *TraceServiceListTrajectoryResult) field0Length() int {
+func (p *TraceServiceGetTraceExportJobResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -20923,15 +26374,15 @@ func (p *TraceServiceListTrajectoryResult) field0Length() int {
 	return l
 }
 
-func (p *TraceServiceListTrajectoryResult) DeepCopy(s interface{}) error {
-	src, ok := s.(*TraceServiceListTrajectoryResult)
+func (p *TraceServiceGetTraceExportJobResult) DeepCopy(s interface{}) error {
+	src, ok := s.(*TraceServiceGetTraceExportJobResult)
 	if !ok {
 		return fmt.Errorf("%T's type not matched %T", s, p)
 	}
 
-	var _success *ListTrajectoryResponse
+	var _success *GetTraceExportJobResponse
 	if src.Success != nil {
-		_success = &ListTrajectoryResponse{}
+		_success = &GetTraceExportJobResponse{}
 		if err := _success.DeepCopy(src.Success); err != nil {
 			return err
 		}
@@ -21124,3 +26575,59 @@ func (p *TraceServiceListTrajectoryArgs) GetFirstArgument() interface{} {
 func (p *TraceServiceListTrajectoryResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *TraceServiceUpsertRetentionPolicyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceUpsertRetentionPolicyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *TraceServiceGetRetentionPolicyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceGetRetentionPolicyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *TraceServiceDiffTracesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceDiffTracesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *TraceServiceListSessionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceListSessionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *TraceServiceGetSessionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceGetSessionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *TraceServiceExportTracesToFileArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceExportTracesToFileResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *TraceServiceGetTraceExportJobArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *TraceServiceGetTraceExportJobResult) GetResult() interface{} {
+	return p.Success
+}
@@ -174,6 +174,55 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"UpsertRetentionPolicy": kitex.NewMethodInfo(
+		upsertRetentionPolicyHandler,
+		newTraceServiceUpsertRetentionPolicyArgs,
+		newTraceServiceUpsertRetentionPolicyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetRetentionPolicy": kitex.NewMethodInfo(
+		getRetentionPolicyHandler,
+		newTraceServiceGetRetentionPolicyArgs,
+		newTraceServiceGetRetentionPolicyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DiffTraces": kitex.NewMethodInfo(
+		diffTracesHandler,
+		newTraceServiceDiffTracesArgs,
+		newTraceServiceDiffTracesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListSessions": kitex.NewMethodInfo(
+		listSessionsHandler,
+		newTraceServiceListSessionsArgs,
+		newTraceServiceListSessionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetSession": kitex.NewMethodInfo(
+		getSessionHandler,
+		newTraceServiceGetSessionArgs,
+		newTraceServiceGetSessionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ExportTracesToFile": kitex.NewMethodInfo(
+		exportTracesToFileHandler,
+		newTraceServiceExportTracesToFileArgs,
+		newTraceServiceExportTracesToFileResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetTraceExportJob": kitex.NewMethodInfo(
+		getTraceExportJobHandler,
+		newTraceServiceGetTraceExportJobArgs,
+		newTraceServiceGetTraceExportJobResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -644,6 +693,139 @@ func newTraceServiceListTrajectoryResult() interface{} {
 	return trace.NewTraceServiceListTrajectoryResult()
 }
 
+func upsertRetentionPolicyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceUpsertRetentionPolicyArgs)
+	realResult := result.(*trace.TraceServiceUpsertRetentionPolicyResult)
+	success, err := handler.(trace.TraceService).UpsertRetentionPolicy(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceUpsertRetentionPolicyArgs() interface{} {
+	return trace.NewTraceServiceUpsertRetentionPolicyArgs()
+}
+
+func newTraceServiceUpsertRetentionPolicyResult() interface{} {
+	return trace.NewTraceServiceUpsertRetentionPolicyResult()
+}
+
+func getRetentionPolicyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceGetRetentionPolicyArgs)
+	realResult := result.(*trace.TraceServiceGetRetentionPolicyResult)
+	success, err := handler.(trace.TraceService).GetRetentionPolicy(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceGetRetentionPolicyArgs() interface{} {
+	return trace.NewTraceServiceGetRetentionPolicyArgs()
+}
+
+func newTraceServiceGetRetentionPolicyResult() interface{} {
+	return trace.NewTraceServiceGetRetentionPolicyResult()
+}
+
+func diffTracesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceDiffTracesArgs)
+	realResult := result.(*trace.TraceServiceDiffTracesResult)
+	success, err := handler.(trace.TraceService).DiffTraces(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceDiffTracesArgs() interface{} {
+	return trace.NewTraceServiceDiffTracesArgs()
+}
+
+func newTraceServiceDiffTracesResult() interface{} {
+	return trace.NewTraceServiceDiffTracesResult()
+}
+
+func listSessionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceListSessionsArgs)
+	realResult := result.(*trace.TraceServiceListSessionsResult)
+	success, err := handler.(trace.TraceService).ListSessions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceListSessionsArgs() interface{} {
+	return trace.NewTraceServiceListSessionsArgs()
+}
+
+func newTraceServiceListSessionsResult() interface{} {
+	return trace.NewTraceServiceListSessionsResult()
+}
+
+func getSessionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceGetSessionArgs)
+	realResult := result.(*trace.TraceServiceGetSessionResult)
+	success, err := handler.(trace.TraceService).GetSession(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceGetSessionArgs() interface{} {
+	return trace.NewTraceServiceGetSessionArgs()
+}
+
+func newTraceServiceGetSessionResult() interface{} {
+	return trace.NewTraceServiceGetSessionResult()
+}
+
+func exportTracesToFileHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceExportTracesToFileArgs)
+	realResult := result.(*trace.TraceServiceExportTracesToFileResult)
+	success, err := handler.(trace.TraceService).ExportTracesToFile(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceExportTracesToFileArgs() interface{} {
+	return trace.NewTraceServiceExportTracesToFileArgs()
+}
+
+func newTraceServiceExportTracesToFileResult() interface{} {
+	return trace.NewTraceServiceExportTracesToFileResult()
+}
+
+func getTraceExportJobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*trace.TraceServiceGetTraceExportJobArgs)
+	realResult := result.(*trace.TraceServiceGetTraceExportJobResult)
+	success, err := handler.(trace.TraceService).GetTraceExportJob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newTraceServiceGetTraceExportJobArgs() interface{} {
+	return trace.NewTraceServiceGetTraceExportJobArgs()
+}
+
+func newTraceServiceGetTraceExportJobResult() interface{} {
+	return trace.NewTraceServiceGetTraceExportJobResult()
+}
+
 type kClient struct {
 	c  client.Client
 	sc client.Streaming
@@ -885,3 +1067,73 @@ func (p *kClient) ListTrajectory(ctx context.Context, req *trace.ListTrajectoryR
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) UpsertRetentionPolicy(ctx context.Context, req *trace.UpsertRetentionPolicyRequest) (r *trace.UpsertRetentionPolicyResponse, err error) {
+	var _args trace.TraceServiceUpsertRetentionPolicyArgs
+	_args.Req = req
+	var _result trace.TraceServiceUpsertRetentionPolicyResult
+	if err = p.c.Call(ctx, "UpsertRetentionPolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetRetentionPolicy(ctx context.Context, req *trace.GetRetentionPolicyRequest) (r *trace.GetRetentionPolicyResponse, err error) {
+	var _args trace.TraceServiceGetRetentionPolicyArgs
+	_args.Req = req
+	var _result trace.TraceServiceGetRetentionPolicyResult
+	if err = p.c.Call(ctx, "GetRetentionPolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DiffTraces(ctx context.Context, req *trace.DiffTracesRequest) (r *trace.DiffTracesResponse, err error) {
+	var _args trace.TraceServiceDiffTracesArgs
+	_args.Req = req
+	var _result trace.TraceServiceDiffTracesResult
+	if err = p.c.Call(ctx, "DiffTraces", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListSessions(ctx context.Context, req *trace.ListSessionsRequest) (r *trace.ListSessionsResponse, err error) {
+	var _args trace.TraceServiceListSessionsArgs
+	_args.Req = req
+	var _result trace.TraceServiceListSessionsResult
+	if err = p.c.Call(ctx, "ListSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSession(ctx context.Context, req *trace.GetSessionRequest) (r *trace.GetSessionResponse, err error) {
+	var _args trace.TraceServiceGetSessionArgs
+	_args.Req = req
+	var _result trace.TraceServiceGetSessionResult
+	if err = p.c.Call(ctx, "GetSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportTracesToFile(ctx context.Context, req *trace.ExportTracesToFileRequest) (r *trace.ExportTracesToFileResponse, err error) {
+	var _args trace.TraceServiceExportTracesToFileArgs
+	_args.Req = req
+	var _result trace.TraceServiceExportTracesToFileResult
+	if err = p.c.Call(ctx, "ExportTracesToFile", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTraceExportJob(ctx context.Context, req *trace.GetTraceExportJobRequest) (r *trace.GetTraceExportJobResponse, err error) {
+	var _args trace.TraceServiceGetTraceExportJobArgs
+	_args.Req = req
+	var _result trace.TraceServiceGetTraceExportJobResult
+	if err = p.c.Call(ctx, "GetTraceExportJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -25,6 +25,14 @@ const (
 
 	ToolTypeGoogleSearch = "google_search"
 
+	ToolExecutorTypeHTTP = "http"
+
+	ToolExecutorTypeMCP = "mcp"
+
+	MCPTransportStdio = "stdio"
+
+	MCPTransportHTTP = "http"
+
 	ToolChoiceTypeNone = "none"
 
 	ToolChoiceTypeAuto = "auto"
@@ -84,6 +92,10 @@ type TemplateType = string
 
 type ToolType = string
 
+type ToolExecutorType = string
+
+type MCPTransport = string
+
 type ToolChoiceType = string
 
 type Role = string
@@ -4073,6 +4085,8 @@ func (p *PromptTemplate) Field100DeepEqual(src map[string]string) bool {
 type Tool struct {
 	Type     *ToolType `thrift:"type,1,optional" frugal:"1,optional,string" form:"type" json:"type,omitempty" query:"type"`
 	Function *Function `thrift:"function,2,optional" frugal:"2,optional,Function" form:"function" json:"function,omitempty" query:"function"`
+	// 绑定的真实执行器，未绑定时使用mock_tools中的mock结果
+	Executor *ToolExecutor `thrift:"executor,3,optional" frugal:"3,optional,ToolExecutor" form:"executor" json:"executor,omitempty" query:"executor"`
 }
 
 func NewTool() *Tool {
@@ -4105,16 +4119,32 @@ func (p *Tool) GetFunction() (v *Function) {
 	}
 	return p.Function
 }
+
+var Tool_Executor_DEFAULT *ToolExecutor
+
+func (p *Tool) GetExecutor() (v *ToolExecutor) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetExecutor() {
+		return Tool_Executor_DEFAULT
+	}
+	return p.Executor
+}
 func (p *Tool) SetType(val *ToolType) {
 	p.Type = val
 }
 func (p *Tool) SetFunction(val *Function) {
 	p.Function = val
 }
+func (p *Tool) SetExecutor(val *ToolExecutor) {
+	p.Executor = val
+}
 
 var fieldIDToName_Tool = map[int16]string{
 	1: "type",
 	2: "function",
+	3: "executor",
 }
 
 func (p *Tool) IsSetType() bool {
@@ -4125,6 +4155,10 @@ func (p *Tool) IsSetFunction() bool {
 	return p.Function != nil
 }
 
+func (p *Tool) IsSetExecutor() bool {
+	return p.Executor != nil
+}
+
 func (p *Tool) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4159,6 +4193,14 @@ func (p *Tool) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4207,6 +4249,14 @@ func (p *Tool) ReadField2(iprot thrift.TProtocol) error {
 	p.Function = _field
 	return nil
 }
+func (p *Tool) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewToolExecutor()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Executor = _field
+	return nil
+}
 
 func (p *Tool) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -4222,6 +4272,10 @@ func (p *Tool) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4276,6 +4330,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *Tool) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExecutor() {
+		if err = oprot.WriteFieldBegin("executor", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Executor.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
 
 func (p *Tool) String() string {
 	if p == nil {
@@ -4297,6 +4369,9 @@ func (p *Tool) DeepEqual(ano *Tool) bool {
 	if !p.Field2DeepEqual(ano.Function) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Executor) {
+		return false
+	}
 	return true
 }
 
@@ -4319,6 +4394,13 @@ func (p *Tool) Field2DeepEqual(src *Function) bool {
 	}
 	return true
 }
+func (p *Tool) Field3DeepEqual(src *ToolExecutor) bool {
+
+	if !p.Executor.DeepEqual(src) {
+		return false
+	}
+	return true
+}
 
 type Function struct {
 	Name        *string `thrift:"name,1,optional" frugal:"1,optional,string" form:"name" json:"name,omitempty" query:"name"`
@@ -4655,6 +4737,1467 @@ func (p *Function) Field3DeepEqual(src *string) bool {
 	return true
 }
 
+type ToolExecutor struct {
+	Type *ToolExecutorType `thrift:"type,1,optional" frugal:"1,optional,string" form:"type" json:"type,omitempty" query:"type"`
+	HTTP *HTTPToolExecutor `thrift:"http,2,optional" frugal:"2,optional,HTTPToolExecutor" form:"http" json:"http,omitempty" query:"http"`
+	Mcp  *MCPToolExecutor  `thrift:"mcp,3,optional" frugal:"3,optional,MCPToolExecutor" form:"mcp" json:"mcp,omitempty" query:"mcp"`
+}
+
+func NewToolExecutor() *ToolExecutor {
+	return &ToolExecutor{}
+}
+
+func (p *ToolExecutor) InitDefault() {
+}
+
+var ToolExecutor_Type_DEFAULT ToolExecutorType
+
+func (p *ToolExecutor) GetType() (v ToolExecutorType) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetType() {
+		return ToolExecutor_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var ToolExecutor_HTTP_DEFAULT *HTTPToolExecutor
+
+func (p *ToolExecutor) GetHTTP() (v *HTTPToolExecutor) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHTTP() {
+		return ToolExecutor_HTTP_DEFAULT
+	}
+	return p.HTTP
+}
+
+var ToolExecutor_Mcp_DEFAULT *MCPToolExecutor
+
+func (p *ToolExecutor) GetMcp() (v *MCPToolExecutor) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMcp() {
+		return ToolExecutor_Mcp_DEFAULT
+	}
+	return p.Mcp
+}
+func (p *ToolExecutor) SetType(val *ToolExecutorType) {
+	p.Type = val
+}
+func (p *ToolExecutor) SetHTTP(val *HTTPToolExecutor) {
+	p.HTTP = val
+}
+func (p *ToolExecutor) SetMcp(val *MCPToolExecutor) {
+	p.Mcp = val
+}
+
+var fieldIDToName_ToolExecutor = map[int16]string{
+	1: "type",
+	2: "http",
+	3: "mcp",
+}
+
+func (p *ToolExecutor) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *ToolExecutor) IsSetHTTP() bool {
+	return p.HTTP != nil
+}
+
+func (p *ToolExecutor) IsSetMcp() bool {
+	return p.Mcp != nil
+}
+
+func (p *ToolExecutor) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ToolExecutor[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ToolExecutor) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *ToolExecutorType
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *ToolExecutor) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewHTTPToolExecutor()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.HTTP = _field
+	return nil
+}
+func (p *ToolExecutor) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewMCPToolExecutor()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Mcp = _field
+	return nil
+}
+
+func (p *ToolExecutor) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ToolExecutor"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ToolExecutor) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Type); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ToolExecutor) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHTTP() {
+		if err = oprot.WriteFieldBegin("http", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.HTTP.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ToolExecutor) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMcp() {
+		if err = oprot.WriteFieldBegin("mcp", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Mcp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ToolExecutor) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ToolExecutor(%+v)", *p)
+
+}
+
+func (p *ToolExecutor) DeepEqual(ano *ToolExecutor) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Type) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.HTTP) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Mcp) {
+		return false
+	}
+	return true
+}
+
+func (p *ToolExecutor) Field1DeepEqual(src *ToolExecutorType) bool {
+
+	if p.Type == src {
+		return true
+	} else if p.Type == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Type, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *ToolExecutor) Field2DeepEqual(src *HTTPToolExecutor) bool {
+
+	if !p.HTTP.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ToolExecutor) Field3DeepEqual(src *MCPToolExecutor) bool {
+
+	if !p.Mcp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+// 以工具调用参数作为请求体调用HTTP接口，响应体作为工具结果
+type HTTPToolExecutor struct {
+	URL *string `thrift:"url,1,optional" frugal:"1,optional,string" form:"url" json:"url,omitempty" query:"url"`
+	// 默认POST
+	Method    *string           `thrift:"method,2,optional" frugal:"2,optional,string" form:"method" json:"method,omitempty" query:"method"`
+	Headers   map[string]string `thrift:"headers,3,optional" frugal:"3,optional,map<string:string>" form:"headers" json:"headers,omitempty" query:"headers"`
+	TimeoutMs *int64            `thrift:"timeout_ms,4,optional" frugal:"4,optional,i64" json:"timeout_ms" form:"timeout_ms" query:"timeout_ms"`
+}
+
+func NewHTTPToolExecutor() *HTTPToolExecutor {
+	return &HTTPToolExecutor{}
+}
+
+func (p *HTTPToolExecutor) InitDefault() {
+}
+
+var HTTPToolExecutor_URL_DEFAULT string
+
+func (p *HTTPToolExecutor) GetURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURL() {
+		return HTTPToolExecutor_URL_DEFAULT
+	}
+	return *p.URL
+}
+
+var HTTPToolExecutor_Method_DEFAULT string
+
+func (p *HTTPToolExecutor) GetMethod() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetMethod() {
+		return HTTPToolExecutor_Method_DEFAULT
+	}
+	return *p.Method
+}
+
+var HTTPToolExecutor_Headers_DEFAULT map[string]string
+
+func (p *HTTPToolExecutor) GetHeaders() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHeaders() {
+		return HTTPToolExecutor_Headers_DEFAULT
+	}
+	return p.Headers
+}
+
+var HTTPToolExecutor_TimeoutMs_DEFAULT int64
+
+func (p *HTTPToolExecutor) GetTimeoutMs() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTimeoutMs() {
+		return HTTPToolExecutor_TimeoutMs_DEFAULT
+	}
+	return *p.TimeoutMs
+}
+func (p *HTTPToolExecutor) SetURL(val *string) {
+	p.URL = val
+}
+func (p *HTTPToolExecutor) SetMethod(val *string) {
+	p.Method = val
+}
+func (p *HTTPToolExecutor) SetHeaders(val map[string]string) {
+	p.Headers = val
+}
+func (p *HTTPToolExecutor) SetTimeoutMs(val *int64) {
+	p.TimeoutMs = val
+}
+
+var fieldIDToName_HTTPToolExecutor = map[int16]string{
+	1: "url",
+	2: "method",
+	3: "headers",
+	4: "timeout_ms",
+}
+
+func (p *HTTPToolExecutor) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *HTTPToolExecutor) IsSetMethod() bool {
+	return p.Method != nil
+}
+
+func (p *HTTPToolExecutor) IsSetHeaders() bool {
+	return p.Headers != nil
+}
+
+func (p *HTTPToolExecutor) IsSetTimeoutMs() bool {
+	return p.TimeoutMs != nil
+}
+
+func (p *HTTPToolExecutor) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HTTPToolExecutor[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *HTTPToolExecutor) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *HTTPToolExecutor) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Method = _field
+	return nil
+}
+func (p *HTTPToolExecutor) ReadField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Headers = _field
+	return nil
+}
+func (p *HTTPToolExecutor) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TimeoutMs = _field
+	return nil
+}
+
+func (p *HTTPToolExecutor) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HTTPToolExecutor"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *HTTPToolExecutor) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURL() {
+		if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *HTTPToolExecutor) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMethod() {
+		if err = oprot.WriteFieldBegin("method", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Method); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *HTTPToolExecutor) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeaders() {
+		if err = oprot.WriteFieldBegin("headers", thrift.MAP, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Headers)); err != nil {
+			return err
+		}
+		for k, v := range p.Headers {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *HTTPToolExecutor) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeoutMs() {
+		if err = oprot.WriteFieldBegin("timeout_ms", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TimeoutMs); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *HTTPToolExecutor) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HTTPToolExecutor(%+v)", *p)
+
+}
+
+func (p *HTTPToolExecutor) DeepEqual(ano *HTTPToolExecutor) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.URL) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Method) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Headers) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TimeoutMs) {
+		return false
+	}
+	return true
+}
+
+func (p *HTTPToolExecutor) Field1DeepEqual(src *string) bool {
+
+	if p.URL == src {
+		return true
+	} else if p.URL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URL, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HTTPToolExecutor) Field2DeepEqual(src *string) bool {
+
+	if p.Method == src {
+		return true
+	} else if p.Method == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Method, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *HTTPToolExecutor) Field3DeepEqual(src map[string]string) bool {
+
+	if len(p.Headers) != len(src) {
+		return false
+	}
+	for k, v := range p.Headers {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *HTTPToolExecutor) Field4DeepEqual(src *int64) bool {
+
+	if p.TimeoutMs == src {
+		return true
+	} else if p.TimeoutMs == nil || src == nil {
+		return false
+	}
+	if *p.TimeoutMs != *src {
+		return false
+	}
+	return true
+}
+
+// 通过MCP协议的tools/call调用MCP server上的工具
+type MCPToolExecutor struct {
+	Transport *MCPTransport `thrift:"transport,1,optional" frugal:"1,optional,string" form:"transport" json:"transport,omitempty" query:"transport"`
+	// http transport的服务地址
+	URL     *string           `thrift:"url,2,optional" frugal:"2,optional,string" form:"url" json:"url,omitempty" query:"url"`
+	Headers map[string]string `thrift:"headers,3,optional" frugal:"3,optional,map<string:string>" form:"headers" json:"headers,omitempty" query:"headers"`
+	// stdio transport的启动命令，需在服务端配置的白名单内
+	Command *string  `thrift:"command,4,optional" frugal:"4,optional,string" form:"command" json:"command,omitempty" query:"command"`
+	Args_   []string `thrift:"args,5,optional" frugal:"5,optional,list<string>" form:"args" json:"args,omitempty" query:"args"`
+	// MCP server上的工具名，为空时使用function name
+	ToolName  *string `thrift:"tool_name,6,optional" frugal:"6,optional,string" form:"tool_name" json:"tool_name,omitempty" query:"tool_name"`
+	TimeoutMs *int64  `thrift:"timeout_ms,7,optional" frugal:"7,optional,i64" json:"timeout_ms" form:"timeout_ms" query:"timeout_ms"`
+}
+
+func NewMCPToolExecutor() *MCPToolExecutor {
+	return &MCPToolExecutor{}
+}
+
+func (p *MCPToolExecutor) InitDefault() {
+}
+
+var MCPToolExecutor_Transport_DEFAULT MCPTransport
+
+func (p *MCPToolExecutor) GetTransport() (v MCPTransport) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTransport() {
+		return MCPToolExecutor_Transport_DEFAULT
+	}
+	return *p.Transport
+}
+
+var MCPToolExecutor_URL_DEFAULT string
+
+func (p *MCPToolExecutor) GetURL() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetURL() {
+		return MCPToolExecutor_URL_DEFAULT
+	}
+	return *p.URL
+}
+
+var MCPToolExecutor_Headers_DEFAULT map[string]string
+
+func (p *MCPToolExecutor) GetHeaders() (v map[string]string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetHeaders() {
+		return MCPToolExecutor_Headers_DEFAULT
+	}
+	return p.Headers
+}
+
+var MCPToolExecutor_Command_DEFAULT string
+
+func (p *MCPToolExecutor) GetCommand() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCommand() {
+		return MCPToolExecutor_Command_DEFAULT
+	}
+	return *p.Command
+}
+
+var MCPToolExecutor_Args__DEFAULT []string
+
+func (p *MCPToolExecutor) GetArgs_() (v []string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetArgs_() {
+		return MCPToolExecutor_Args__DEFAULT
+	}
+	return p.Args_
+}
+
+var MCPToolExecutor_ToolName_DEFAULT string
+
+func (p *MCPToolExecutor) GetToolName() (v string) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetToolName() {
+		return MCPToolExecutor_ToolName_DEFAULT
+	}
+	return *p.ToolName
+}
+
+var MCPToolExecutor_TimeoutMs_DEFAULT int64
+
+func (p *MCPToolExecutor) GetTimeoutMs() (v int64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTimeoutMs() {
+		return MCPToolExecutor_TimeoutMs_DEFAULT
+	}
+	return *p.TimeoutMs
+}
+func (p *MCPToolExecutor) SetTransport(val *MCPTransport) {
+	p.Transport = val
+}
+func (p *MCPToolExecutor) SetURL(val *string) {
+	p.URL = val
+}
+func (p *MCPToolExecutor) SetHeaders(val map[string]string) {
+	p.Headers = val
+}
+func (p *MCPToolExecutor) SetCommand(val *string) {
+	p.Command = val
+}
+func (p *MCPToolExecutor) SetArgs_(val []string) {
+	p.Args_ = val
+}
+func (p *MCPToolExecutor) SetToolName(val *string) {
+	p.ToolName = val
+}
+func (p *MCPToolExecutor) SetTimeoutMs(val *int64) {
+	p.TimeoutMs = val
+}
+
+var fieldIDToName_MCPToolExecutor = map[int16]string{
+	1: "transport",
+	2: "url",
+	3: "headers",
+	4: "command",
+	5: "args",
+	6: "tool_name",
+	7: "timeout_ms",
+}
+
+func (p *MCPToolExecutor) IsSetTransport() bool {
+	return p.Transport != nil
+}
+
+func (p *MCPToolExecutor) IsSetURL() bool {
+	return p.URL != nil
+}
+
+func (p *MCPToolExecutor) IsSetHeaders() bool {
+	return p.Headers != nil
+}
+
+func (p *MCPToolExecutor) IsSetCommand() bool {
+	return p.Command != nil
+}
+
+func (p *MCPToolExecutor) IsSetArgs_() bool {
+	return p.Args_ != nil
+}
+
+func (p *MCPToolExecutor) IsSetToolName() bool {
+	return p.ToolName != nil
+}
+
+func (p *MCPToolExecutor) IsSetTimeoutMs() bool {
+	return p.TimeoutMs != nil
+}
+
+func (p *MCPToolExecutor) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MCPToolExecutor[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MCPToolExecutor) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *MCPTransport
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Transport = _field
+	return nil
+}
+func (p *MCPToolExecutor) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *MCPToolExecutor) ReadField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Headers = _field
+	return nil
+}
+func (p *MCPToolExecutor) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Command = _field
+	return nil
+}
+func (p *MCPToolExecutor) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Args_ = _field
+	return nil
+}
+func (p *MCPToolExecutor) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ToolName = _field
+	return nil
+}
+func (p *MCPToolExecutor) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TimeoutMs = _field
+	return nil
+}
+
+func (p *MCPToolExecutor) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MCPToolExecutor"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MCPToolExecutor) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTransport() {
+		if err = oprot.WriteFieldBegin("transport", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Transport); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *MCPToolExecutor) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetURL() {
+		if err = oprot.WriteFieldBegin("url", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.URL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *MCPToolExecutor) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeaders() {
+		if err = oprot.WriteFieldBegin("headers", thrift.MAP, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Headers)); err != nil {
+			return err
+		}
+		for k, v := range p.Headers {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *MCPToolExecutor) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCommand() {
+		if err = oprot.WriteFieldBegin("command", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Command); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *MCPToolExecutor) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetArgs_() {
+		if err = oprot.WriteFieldBegin("args", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Args_)); err != nil {
+			return err
+		}
+		for _, v := range p.Args_ {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *MCPToolExecutor) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToolName() {
+		if err = oprot.WriteFieldBegin("tool_name", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ToolName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *MCPToolExecutor) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeoutMs() {
+		if err = oprot.WriteFieldBegin("timeout_ms", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TimeoutMs); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *MCPToolExecutor) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MCPToolExecutor(%+v)", *p)
+
+}
+
+func (p *MCPToolExecutor) DeepEqual(ano *MCPToolExecutor) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Transport) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.URL) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Headers) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Command) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Args_) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.ToolName) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.TimeoutMs) {
+		return false
+	}
+	return true
+}
+
+func (p *MCPToolExecutor) Field1DeepEqual(src *MCPTransport) bool {
+
+	if p.Transport == src {
+		return true
+	} else if p.Transport == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Transport, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *MCPToolExecutor) Field2DeepEqual(src *string) bool {
+
+	if p.URL == src {
+		return true
+	} else if p.URL == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.URL, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *MCPToolExecutor) Field3DeepEqual(src map[string]string) bool {
+
+	if len(p.Headers) != len(src) {
+		return false
+	}
+	for k, v := range p.Headers {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *MCPToolExecutor) Field4DeepEqual(src *string) bool {
+
+	if p.Command == src {
+		return true
+	} else if p.Command == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Command, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *MCPToolExecutor) Field5DeepEqual(src []string) bool {
+
+	if len(p.Args_) != len(src) {
+		return false
+	}
+	for i, v := range p.Args_ {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *MCPToolExecutor) Field6DeepEqual(src *string) bool {
+
+	if p.ToolName == src {
+		return true
+	} else if p.ToolName == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ToolName, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *MCPToolExecutor) Field7DeepEqual(src *int64) bool {
+
+	if p.TimeoutMs == src {
+		return true
+	} else if p.TimeoutMs == nil || src == nil {
+		return false
+	}
+	if *p.TimeoutMs != *src {
+		return false
+	}
+	return true
+}
+
 type ToolCallConfig struct {
 	ToolChoice              *ToolChoiceType          `thrift:"tool_choice,1,optional" frugal:"1,optional,string" form:"tool_choice" json:"tool_choice,omitempty" query:"tool_choice"`
 	ToolChoiceSpecification *ToolChoiceSpecification `thrift:"tool_choice_specification,2,optional" frugal:"2,optional,ToolChoiceSpecification" form:"tool_choice_specification" json:"tool_choice_specification,omitempty" query:"tool_choice_specification"`
@@ -101,11 +101,35 @@ func (p *Tool) IsValid() error {
 			return fmt.Errorf("field Function not valid, %w", err)
 		}
 	}
+	if p.Executor != nil {
+		if err := p.Executor.IsValid(); err != nil {
+			return fmt.Errorf("field Executor not valid, %w", err)
+		}
+	}
 	return nil
 }
 func (p *Function) IsValid() error {
 	return nil
 }
+func (p *ToolExecutor) IsValid() error {
+	if p.HTTP != nil {
+		if err := p.HTTP.IsValid(); err != nil {
+			return fmt.Errorf("field HTTP not valid, %w", err)
+		}
+	}
+	if p.Mcp != nil {
+		if err := p.Mcp.IsValid(); err != nil {
+			return fmt.Errorf("field Mcp not valid, %w", err)
+		}
+	}
+	return nil
+}
+func (p *HTTPToolExecutor) IsValid() error {
+	return nil
+}
+func (p *MCPToolExecutor) IsValid() error {
+	return nil
+}
 func (p *ToolCallConfig) IsValid() error {
 	if p.ToolChoiceSpecification != nil {
 		if err := p.ToolChoiceSpecification.IsValid(); err != nil {
@@ -2117,6 +2117,20 @@ func (p *ModelInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField7(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -2219,6 +2233,20 @@ func (p *ModelInfo) FastReadField6(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *ModelInfo) FastReadField7(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Cost = _field
+	return offset, nil
+}
+
 func (p *ModelInfo) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -2231,6 +2259,7 @@ func (p *ModelInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField5(buf[offset:], w)
 		offset += p.fastWriteField6(buf[offset:], w)
+		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
@@ -2246,6 +2275,7 @@ func (p *ModelInfo) BLength() int {
 		l += p.field4Length()
 		l += p.field5Length()
 		l += p.field6Length()
+		l += p.field7Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -2305,6 +2335,15 @@ func (p *ModelInfo) fastWriteField6(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
+func (p *ModelInfo) fastWriteField7(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCost() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 7)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.Cost)
+	}
+	return offset
+}
+
 func (p *ModelInfo) field1Length() int {
 	l := 0
 	if p.IsSetInputTokens() {
@@ -2359,6 +2398,15 @@ func (p *ModelInfo) field6Length() int {
 	return l
 }
 
+func (p *ModelInfo) field7Length() int {
+	l := 0
+	if p.IsSetCost() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
 func (p *ModelInfo) DeepCopy(s interface{}) error {
 	src, ok := s.(*ModelInfo)
 	if !ok {
@@ -2398,6 +2446,11 @@ func (p *ModelInfo) DeepCopy(s interface{}) error {
 		p.InputCreationCachedTokens = &tmp
 	}
 
+	if src.Cost != nil {
+		tmp := *src.Cost
+		p.Cost = &tmp
+	}
+
 	return nil
 }
 
@@ -2948,6 +3001,20 @@ func (p *MetricsInfo) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				l, err = p.FastReadField10(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -3148,6 +3215,20 @@ func (p *MetricsInfo) FastReadField9(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *MetricsInfo) FastReadField10(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *float64
+	if v, l, err := thrift.Binary.ReadDouble(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.TotalCost = _field
+	return offset, nil
+}
+
 func (p *MetricsInfo) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -3160,6 +3241,7 @@ func (p *MetricsInfo) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 		offset += p.fastWriteField7(buf[offset:], w)
 		offset += p.fastWriteField8(buf[offset:], w)
 		offset += p.fastWriteField9(buf[offset:], w)
+		offset += p.fastWriteField10(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
 		offset += p.fastWriteField3(buf[offset:], w)
@@ -3181,6 +3263,7 @@ func (p *MetricsInfo) BLength() int {
 		l += p.field7Length()
 		l += p.field8Length()
 		l += p.field9Length()
+		l += p.field10Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -3297,6 +3380,15 @@ func (p *MetricsInfo) fastWriteField9(buf []byte, w thrift.NocopyWriter) int {
 	return offset
 }
 
+func (p *MetricsInfo) fastWriteField10(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetTotalCost() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.DOUBLE, 10)
+		offset += thrift.Binary.WriteDouble(buf[offset:], *p.TotalCost)
+	}
+	return offset
+}
+
 func (p *MetricsInfo) field1Length() int {
 	l := 0
 	if p.IsSetLlmDuration() {
@@ -3398,6 +3490,15 @@ func (p *MetricsInfo) field9Length() int {
 	return l
 }
 
+func (p *MetricsInfo) field10Length() int {
+	l := 0
+	if p.IsSetTotalCost() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.DoubleLength()
+	}
+	return l
+}
+
 func (p *MetricsInfo) DeepCopy(s interface{}) error {
 	src, ok := s.(*MetricsInfo)
 	if !ok {
@@ -3489,5 +3590,10 @@ func (p *MetricsInfo) DeepCopy(s interface{}) error {
 		p.OutputTokens = &tmp
 	}
 
+	if src.TotalCost != nil {
+		tmp := *src.TotalCost
+		p.TotalCost = &tmp
+	}
+
 	return nil
 }
@@ -2697,6 +2697,8 @@ type ModelInfo struct {
 	ReasoningTokens           *int32  `thrift:"reasoning_tokens,4,optional" frugal:"4,optional,i32" form:"reasoning_tokens" json:"reasoning_tokens,omitempty" query:"reasoning_tokens"`
 	InputReadCachedTokens     *int32  `thrift:"input_read_cached_tokens,5,optional" frugal:"5,optional,i32" form:"input_read_cached_tokens" json:"input_read_cached_tokens,omitempty" query:"input_read_cached_tokens"`
 	InputCreationCachedTokens *int32  `thrift:"input_creation_cached_tokens,6,optional" frugal:"6,optional,i32" form:"input_creation_cached_tokens" json:"input_creation_cached_tokens,omitempty" query:"input_creation_cached_tokens"`
+	// 按模型价格表计算的费用
+	Cost *float64 `thrift:"cost,7,optional" frugal:"7,optional,double" form:"cost" json:"cost,omitempty" query:"cost"`
 }
 
 func NewModelInfo() *ModelInfo {
@@ -2777,6 +2779,18 @@ func (p *ModelInfo) GetInputCreationCachedTokens() (v int32) {
 	}
 	return *p.InputCreationCachedTokens
 }
+
+var ModelInfo_Cost_DEFAULT float64
+
+func (p *ModelInfo) GetCost() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetCost() {
+		return ModelInfo_Cost_DEFAULT
+	}
+	return *p.Cost
+}
 func (p *ModelInfo) SetInputTokens(val *int32) {
 	p.InputTokens = val
 }
@@ -2795,6 +2809,9 @@ func (p *ModelInfo) SetInputReadCachedTokens(val *int32) {
 func (p *ModelInfo) SetInputCreationCachedTokens(val *int32) {
 	p.InputCreationCachedTokens = val
 }
+func (p *ModelInfo) SetCost(val *float64) {
+	p.Cost = val
+}
 
 var fieldIDToName_ModelInfo = map[int16]string{
 	1: "input_tokens",
@@ -2803,6 +2820,7 @@ var fieldIDToName_ModelInfo = map[int16]string{
 	4: "reasoning_tokens",
 	5: "input_read_cached_tokens",
 	6: "input_creation_cached_tokens",
+	7: "cost",
 }
 
 func (p *ModelInfo) IsSetInputTokens() bool {
@@ -2829,6 +2847,10 @@ func (p *ModelInfo) IsSetInputCreationCachedTokens() bool {
 	return p.InputCreationCachedTokens != nil
 }
 
+func (p *ModelInfo) IsSetCost() bool {
+	return p.Cost != nil
+}
+
 func (p *ModelInfo) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2895,6 +2917,14 @@ func (p *ModelInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2990,6 +3020,17 @@ func (p *ModelInfo) ReadField6(iprot thrift.TProtocol) error {
 	p.InputCreationCachedTokens = _field
 	return nil
 }
+func (p *ModelInfo) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cost = _field
+	return nil
+}
 
 func (p *ModelInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -3021,6 +3062,10 @@ func (p *ModelInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3147,6 +3192,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
+func (p *ModelInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCost() {
+		if err = oprot.WriteFieldBegin("cost", thrift.DOUBLE, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Cost); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
 func (p *ModelInfo) String() string {
 	if p == nil {
@@ -3180,6 +3243,9 @@ func (p *ModelInfo) DeepEqual(ano *ModelInfo) bool {
 	if !p.Field6DeepEqual(ano.InputCreationCachedTokens) {
 		return false
 	}
+	if !p.Field7DeepEqual(ano.Cost) {
+		return false
+	}
 	return true
 }
 
@@ -3255,6 +3321,18 @@ func (p *ModelInfo) Field6DeepEqual(src *int32) bool {
 	}
 	return true
 }
+func (p *ModelInfo) Field7DeepEqual(src *float64) bool {
+
+	if p.Cost == src {
+		return true
+	} else if p.Cost == nil || src == nil {
+		return false
+	}
+	if *p.Cost != *src {
+		return false
+	}
+	return true
+}
 
 type BasicInfo struct {
 	// 单位毫秒
@@ -3862,6 +3940,8 @@ type MetricsInfo struct {
 	InputTokens *int32 `thrift:"input_tokens,8,optional" frugal:"8,optional,i32" form:"input_tokens" json:"input_tokens,omitempty" query:"input_tokens"`
 	// 输出token数
 	OutputTokens *int32 `thrift:"output_tokens,9,optional" frugal:"9,optional,i32" form:"output_tokens" json:"output_tokens,omitempty" query:"output_tokens"`
+	// 模型调用总费用
+	TotalCost *float64 `thrift:"total_cost,10,optional" frugal:"10,optional,double" form:"total_cost" json:"total_cost,omitempty" query:"total_cost"`
 }
 
 func NewMetricsInfo() *MetricsInfo {
@@ -3978,6 +4058,18 @@ func (p *MetricsInfo) GetOutputTokens() (v int32) {
 	}
 	return *p.OutputTokens
 }
+
+var MetricsInfo_TotalCost_DEFAULT float64
+
+func (p *MetricsInfo) GetTotalCost() (v float64) {
+	if p == nil {
+		return
+	}
+	if !p.IsSetTotalCost() {
+		return MetricsInfo_TotalCost_DEFAULT
+	}
+	return *p.TotalCost
+}
 func (p *MetricsInfo) SetLlmDuration(val *string) {
 	p.LlmDuration = val
 }
@@ -4005,17 +4097,21 @@ func (p *MetricsInfo) SetInputTokens(val *int32) {
 func (p *MetricsInfo) SetOutputTokens(val *int32) {
 	p.OutputTokens = val
 }
+func (p *MetricsInfo) SetTotalCost(val *float64) {
+	p.TotalCost = val
+}
 
 var fieldIDToName_MetricsInfo = map[int16]string{
-	1: "llm_duration",
-	2: "tool_duration",
-	3: "tool_errors",
-	4: "tool_error_rate",
-	5: "model_errors",
-	6: "model_error_rate",
-	7: "tool_step_proportion",
-	8: "input_tokens",
-	9: "output_tokens",
+	1:  "llm_duration",
+	2:  "tool_duration",
+	3:  "tool_errors",
+	4:  "tool_error_rate",
+	5:  "model_errors",
+	6:  "model_error_rate",
+	7:  "tool_step_proportion",
+	8:  "input_tokens",
+	9:  "output_tokens",
+	10: "total_cost",
 }
 
 func (p *MetricsInfo) IsSetLlmDuration() bool {
@@ -4054,6 +4150,10 @@ func (p *MetricsInfo) IsSetOutputTokens() bool {
 	return p.OutputTokens != nil
 }
 
+func (p *MetricsInfo) IsSetTotalCost() bool {
+	return p.TotalCost != nil
+}
+
 func (p *MetricsInfo) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4144,6 +4244,14 @@ func (p *MetricsInfo) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4332,6 +4440,17 @@ func (p *MetricsInfo) ReadField9(iprot thrift.TProtocol) error {
 	p.OutputTokens = _field
 	return nil
 }
+func (p *MetricsInfo) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TotalCost = _field
+	return nil
+}
 
 func (p *MetricsInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -4375,6 +4494,10 @@ func (p *MetricsInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 9
 			goto WriteFieldError
 		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4593,6 +4716,24 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
+func (p *MetricsInfo) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotalCost() {
+		if err = oprot.WriteFieldBegin("total_cost", thrift.DOUBLE, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.TotalCost); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
 
 func (p *MetricsInfo) String() string {
 	if p == nil {
@@ -4635,6 +4776,9 @@ func (p *MetricsInfo) DeepEqual(ano *MetricsInfo) bool {
 	if !p.Field9DeepEqual(ano.OutputTokens) {
 		return false
 	}
+	if !p.Field10DeepEqual(ano.TotalCost) {
+		return false
+	}
 	return true
 }
 
@@ -4760,3 +4904,15 @@ func (p *MetricsInfo) Field9DeepEqual(src *int32) bool {
 	}
 	return true
 }
+func (p *MetricsInfo) Field10DeepEqual(src *float64) bool {
+
+	if p.TotalCost == src {
+		return true
+	} else if p.TotalCost == nil || src == nil {
+		return false
+	}
+	if *p.TotalCost != *src {
+		return false
+	}
+	return true
+}
@@ -184,6 +184,29 @@ func (l *LocalDatasetService) ImportDataset(ctx context.Context, req *dataset.Im
 	return result.GetSuccess(), nil
 }
 
+// ExportDataset
+// 导出数据到文件
+func (l *LocalDatasetService) ExportDataset(ctx context.Context, req *dataset.ExportDatasetRequest, callOptions ...callopt.Option) (*dataset.ExportDatasetResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*dataset.DatasetServiceExportDatasetArgs)
+		result := out.(*dataset.DatasetServiceExportDatasetResult)
+		resp, err := l.impl.ExportDataset(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &dataset.DatasetServiceExportDatasetArgs{Req: req}
+	result := &dataset.DatasetServiceExportDatasetResult{}
+	ctx = l.injectRPCInfo(ctx, "ExportDataset")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 // GetDatasetIOJob
 // 任务(导入、导出、转换)详情
 func (l *LocalDatasetService) GetDatasetIOJob(ctx context.Context, req *dataset.GetDatasetIOJobRequest, callOptions ...callopt.Option) (*dataset.GetDatasetIOJobResponse, error) {
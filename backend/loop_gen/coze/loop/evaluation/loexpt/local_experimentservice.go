@@ -345,6 +345,29 @@ func (l *LocalExperimentService) BatchGetExperimentAggrResult_(ctx context.Conte
 	return result.GetSuccess(), nil
 }
 
+// CompareExperimentSignificance
+// 按 item/turn 配对对比实验结果并进行显著性检验
+func (l *LocalExperimentService) CompareExperimentSignificance(ctx context.Context, req *expt.CompareExperimentSignificanceRequest, callOptions ...callopt.Option) (*expt.CompareExperimentSignificanceResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*expt.ExperimentServiceCompareExperimentSignificanceArgs)
+		result := out.(*expt.ExperimentServiceCompareExperimentSignificanceResult)
+		resp, err := l.impl.CompareExperimentSignificance(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &expt.ExperimentServiceCompareExperimentSignificanceArgs{Req: req}
+	result := &expt.ExperimentServiceCompareExperimentSignificanceResult{}
+	ctx = l.injectRPCInfo(ctx, "CompareExperimentSignificance")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 // InvokeExperiment
 // 在线实验
 func (l *LocalExperimentService) InvokeExperiment(ctx context.Context, req *expt.InvokeExperimentRequest, callOptions ...callopt.Option) (*expt.InvokeExperimentResponse, error) {
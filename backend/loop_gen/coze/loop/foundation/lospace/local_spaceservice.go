@@ -68,6 +68,98 @@ func (l *LocalSpaceService) ListUserSpaces(ctx context.Context, request *space.L
 	return result.GetSuccess(), nil
 }
 
+// ListSpaceMembers
+// 空间成员列表
+func (l *LocalSpaceService) ListSpaceMembers(ctx context.Context, request *space.ListSpaceMembersRequest, callOptions ...callopt.Option) (*space.ListSpaceMembersResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*space.SpaceServiceListSpaceMembersArgs)
+		result := out.(*space.SpaceServiceListSpaceMembersResult)
+		resp, err := l.impl.ListSpaceMembers(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &space.SpaceServiceListSpaceMembersArgs{Request: request}
+	result := &space.SpaceServiceListSpaceMembersResult{}
+	ctx = l.injectRPCInfo(ctx, "ListSpaceMembers")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// AddSpaceMember
+// 邀请空间成员
+func (l *LocalSpaceService) AddSpaceMember(ctx context.Context, request *space.AddSpaceMemberRequest, callOptions ...callopt.Option) (*space.AddSpaceMemberResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*space.SpaceServiceAddSpaceMemberArgs)
+		result := out.(*space.SpaceServiceAddSpaceMemberResult)
+		resp, err := l.impl.AddSpaceMember(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &space.SpaceServiceAddSpaceMemberArgs{Request: request}
+	result := &space.SpaceServiceAddSpaceMemberResult{}
+	ctx = l.injectRPCInfo(ctx, "AddSpaceMember")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// RemoveSpaceMember
+// 移除空间成员
+func (l *LocalSpaceService) RemoveSpaceMember(ctx context.Context, request *space.RemoveSpaceMemberRequest, callOptions ...callopt.Option) (*space.RemoveSpaceMemberResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*space.SpaceServiceRemoveSpaceMemberArgs)
+		result := out.(*space.SpaceServiceRemoveSpaceMemberResult)
+		resp, err := l.impl.RemoveSpaceMember(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &space.SpaceServiceRemoveSpaceMemberArgs{Request: request}
+	result := &space.SpaceServiceRemoveSpaceMemberResult{}
+	ctx = l.injectRPCInfo(ctx, "RemoveSpaceMember")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// UpdateSpaceMemberRole
+// 修改空间成员角色
+func (l *LocalSpaceService) UpdateSpaceMemberRole(ctx context.Context, request *space.UpdateSpaceMemberRoleRequest, callOptions ...callopt.Option) (*space.UpdateSpaceMemberRoleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*space.SpaceServiceUpdateSpaceMemberRoleArgs)
+		result := out.(*space.SpaceServiceUpdateSpaceMemberRoleResult)
+		resp, err := l.impl.UpdateSpaceMemberRole(ctx, arg.Request)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &space.SpaceServiceUpdateSpaceMemberRoleArgs{Request: request}
+	result := &space.SpaceServiceUpdateSpaceMemberRoleResult{}
+	ctx = l.injectRPCInfo(ctx, "UpdateSpaceMemberRole")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalSpaceService) injectRPCInfo(ctx context.Context, method string) context.Context {
 	rpcStats := rpcinfo.AsMutableRPCStats(rpcinfo.NewRPCStats())
 	ri := rpcinfo.NewRPCInfo(
@@ -64,6 +64,92 @@ func (l *LocalLLMManageService) GetModel(ctx context.Context, req *manage.GetMod
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalLLMManageService) CreateModel(ctx context.Context, req *manage.CreateModelRequest, callOptions ...callopt.Option) (*manage.CreateModelResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.LLMManageServiceCreateModelArgs)
+		result := out.(*manage.LLMManageServiceCreateModelResult)
+		resp, err := l.impl.CreateModel(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.LLMManageServiceCreateModelArgs{Req: req}
+	result := &manage.LLMManageServiceCreateModelResult{}
+	ctx = l.injectRPCInfo(ctx, "CreateModel")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalLLMManageService) UpdateModel(ctx context.Context, req *manage.UpdateModelRequest, callOptions ...callopt.Option) (*manage.UpdateModelResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.LLMManageServiceUpdateModelArgs)
+		result := out.(*manage.LLMManageServiceUpdateModelResult)
+		resp, err := l.impl.UpdateModel(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.LLMManageServiceUpdateModelArgs{Req: req}
+	result := &manage.LLMManageServiceUpdateModelResult{}
+	ctx = l.injectRPCInfo(ctx, "UpdateModel")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalLLMManageService) DeleteModel(ctx context.Context, req *manage.DeleteModelRequest, callOptions ...callopt.Option) (*manage.DeleteModelResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.LLMManageServiceDeleteModelArgs)
+		result := out.(*manage.LLMManageServiceDeleteModelResult)
+		resp, err := l.impl.DeleteModel(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.LLMManageServiceDeleteModelArgs{Req: req}
+	result := &manage.LLMManageServiceDeleteModelResult{}
+	ctx = l.injectRPCInfo(ctx, "DeleteModel")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+// UpdateModelStatus
+// 启用或停用模型，停用后不可调用
+func (l *LocalLLMManageService) UpdateModelStatus(ctx context.Context, req *manage.UpdateModelStatusRequest, callOptions ...callopt.Option) (*manage.UpdateModelStatusResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*manage.LLMManageServiceUpdateModelStatusArgs)
+		result := out.(*manage.LLMManageServiceUpdateModelStatusResult)
+		resp, err := l.impl.UpdateModelStatus(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &manage.LLMManageServiceUpdateModelStatusArgs{Req: req}
+	result := &manage.LLMManageServiceUpdateModelStatusResult{}
+	ctx = l.injectRPCInfo(ctx, "UpdateModelStatus")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalLLMManageService) injectRPCInfo(ctx context.Context, method string) context.Context {
 	rpcStats := rpcinfo.AsMutableRPCStats(rpcinfo.NewRPCStats())
 	ri := rpcinfo.NewRPCInfo(
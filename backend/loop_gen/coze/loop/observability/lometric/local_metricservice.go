@@ -85,6 +85,237 @@ func (l *LocalMetricService) TraverseMetrics(ctx context.Context, Req *metric.Tr
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalMetricService) QueryAggregations(ctx context.Context, Req *metric.QueryAggregationsRequest, callOptions ...callopt.Option) (*metric.QueryAggregationsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceQueryAggregationsArgs)
+		result := out.(*metric.MetricServiceQueryAggregationsResult)
+		resp, err := l.impl.QueryAggregations(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceQueryAggregationsArgs{Req: Req}
+	result := &metric.MetricServiceQueryAggregationsResult{}
+	ctx = l.injectRPCInfo(ctx, "QueryAggregations")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) CreateAlertRule(ctx context.Context, Req *metric.CreateAlertRuleRequest, callOptions ...callopt.Option) (*metric.CreateAlertRuleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceCreateAlertRuleArgs)
+		result := out.(*metric.MetricServiceCreateAlertRuleResult)
+		resp, err := l.impl.CreateAlertRule(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceCreateAlertRuleArgs{Req: Req}
+	result := &metric.MetricServiceCreateAlertRuleResult{}
+	ctx = l.injectRPCInfo(ctx, "CreateAlertRule")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) UpdateAlertRule(ctx context.Context, Req *metric.UpdateAlertRuleRequest, callOptions ...callopt.Option) (*metric.UpdateAlertRuleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceUpdateAlertRuleArgs)
+		result := out.(*metric.MetricServiceUpdateAlertRuleResult)
+		resp, err := l.impl.UpdateAlertRule(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceUpdateAlertRuleArgs{Req: Req}
+	result := &metric.MetricServiceUpdateAlertRuleResult{}
+	ctx = l.injectRPCInfo(ctx, "UpdateAlertRule")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) DeleteAlertRule(ctx context.Context, Req *metric.DeleteAlertRuleRequest, callOptions ...callopt.Option) (*metric.DeleteAlertRuleResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceDeleteAlertRuleArgs)
+		result := out.(*metric.MetricServiceDeleteAlertRuleResult)
+		resp, err := l.impl.DeleteAlertRule(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceDeleteAlertRuleArgs{Req: Req}
+	result := &metric.MetricServiceDeleteAlertRuleResult{}
+	ctx = l.injectRPCInfo(ctx, "DeleteAlertRule")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) ListAlertRules(ctx context.Context, Req *metric.ListAlertRulesRequest, callOptions ...callopt.Option) (*metric.ListAlertRulesResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceListAlertRulesArgs)
+		result := out.(*metric.MetricServiceListAlertRulesResult)
+		resp, err := l.impl.ListAlertRules(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceListAlertRulesArgs{Req: Req}
+	result := &metric.MetricServiceListAlertRulesResult{}
+	ctx = l.injectRPCInfo(ctx, "ListAlertRules")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) CreateDashboard(ctx context.Context, Req *metric.CreateDashboardRequest, callOptions ...callopt.Option) (*metric.CreateDashboardResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceCreateDashboardArgs)
+		result := out.(*metric.MetricServiceCreateDashboardResult)
+		resp, err := l.impl.CreateDashboard(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceCreateDashboardArgs{Req: Req}
+	result := &metric.MetricServiceCreateDashboardResult{}
+	ctx = l.injectRPCInfo(ctx, "CreateDashboard")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) UpdateDashboard(ctx context.Context, Req *metric.UpdateDashboardRequest, callOptions ...callopt.Option) (*metric.UpdateDashboardResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceUpdateDashboardArgs)
+		result := out.(*metric.MetricServiceUpdateDashboardResult)
+		resp, err := l.impl.UpdateDashboard(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceUpdateDashboardArgs{Req: Req}
+	result := &metric.MetricServiceUpdateDashboardResult{}
+	ctx = l.injectRPCInfo(ctx, "UpdateDashboard")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) DeleteDashboard(ctx context.Context, Req *metric.DeleteDashboardRequest, callOptions ...callopt.Option) (*metric.DeleteDashboardResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceDeleteDashboardArgs)
+		result := out.(*metric.MetricServiceDeleteDashboardResult)
+		resp, err := l.impl.DeleteDashboard(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceDeleteDashboardArgs{Req: Req}
+	result := &metric.MetricServiceDeleteDashboardResult{}
+	ctx = l.injectRPCInfo(ctx, "DeleteDashboard")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) GetDashboard(ctx context.Context, Req *metric.GetDashboardRequest, callOptions ...callopt.Option) (*metric.GetDashboardResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceGetDashboardArgs)
+		result := out.(*metric.MetricServiceGetDashboardResult)
+		resp, err := l.impl.GetDashboard(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceGetDashboardArgs{Req: Req}
+	result := &metric.MetricServiceGetDashboardResult{}
+	ctx = l.injectRPCInfo(ctx, "GetDashboard")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) ListDashboards(ctx context.Context, Req *metric.ListDashboardsRequest, callOptions ...callopt.Option) (*metric.ListDashboardsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceListDashboardsArgs)
+		result := out.(*metric.MetricServiceListDashboardsResult)
+		resp, err := l.impl.ListDashboards(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceListDashboardsArgs{Req: Req}
+	result := &metric.MetricServiceListDashboardsResult{}
+	ctx = l.injectRPCInfo(ctx, "ListDashboards")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalMetricService) RenderDashboard(ctx context.Context, Req *metric.RenderDashboardRequest, callOptions ...callopt.Option) (*metric.RenderDashboardResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*metric.MetricServiceRenderDashboardArgs)
+		result := out.(*metric.MetricServiceRenderDashboardResult)
+		resp, err := l.impl.RenderDashboard(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &metric.MetricServiceRenderDashboardArgs{Req: Req}
+	result := &metric.MetricServiceRenderDashboardResult{}
+	ctx = l.injectRPCInfo(ctx, "RenderDashboard")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalMetricService) injectRPCInfo(ctx context.Context, method string) context.Context {
 	rpcStats := rpcinfo.AsMutableRPCStats(rpcinfo.NewRPCStats())
 	ri := rpcinfo.NewRPCInfo(
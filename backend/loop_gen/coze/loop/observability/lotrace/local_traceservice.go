@@ -505,6 +505,153 @@ func (l *LocalTraceService) ListTrajectory(ctx context.Context, req *trace.ListT
 	return result.GetSuccess(), nil
 }
 
+func (l *LocalTraceService) UpsertRetentionPolicy(ctx context.Context, req *trace.UpsertRetentionPolicyRequest, callOptions ...callopt.Option) (*trace.UpsertRetentionPolicyResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceUpsertRetentionPolicyArgs)
+		result := out.(*trace.TraceServiceUpsertRetentionPolicyResult)
+		resp, err := l.impl.UpsertRetentionPolicy(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceUpsertRetentionPolicyArgs{Req: req}
+	result := &trace.TraceServiceUpsertRetentionPolicyResult{}
+	ctx = l.injectRPCInfo(ctx, "UpsertRetentionPolicy")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalTraceService) GetRetentionPolicy(ctx context.Context, req *trace.GetRetentionPolicyRequest, callOptions ...callopt.Option) (*trace.GetRetentionPolicyResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceGetRetentionPolicyArgs)
+		result := out.(*trace.TraceServiceGetRetentionPolicyResult)
+		resp, err := l.impl.GetRetentionPolicy(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceGetRetentionPolicyArgs{Req: req}
+	result := &trace.TraceServiceGetRetentionPolicyResult{}
+	ctx = l.injectRPCInfo(ctx, "GetRetentionPolicy")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalTraceService) DiffTraces(ctx context.Context, req *trace.DiffTracesRequest, callOptions ...callopt.Option) (*trace.DiffTracesResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceDiffTracesArgs)
+		result := out.(*trace.TraceServiceDiffTracesResult)
+		resp, err := l.impl.DiffTraces(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceDiffTracesArgs{Req: req}
+	result := &trace.TraceServiceDiffTracesResult{}
+	ctx = l.injectRPCInfo(ctx, "DiffTraces")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalTraceService) ListSessions(ctx context.Context, req *trace.ListSessionsRequest, callOptions ...callopt.Option) (*trace.ListSessionsResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceListSessionsArgs)
+		result := out.(*trace.TraceServiceListSessionsResult)
+		resp, err := l.impl.ListSessions(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceListSessionsArgs{Req: req}
+	result := &trace.TraceServiceListSessionsResult{}
+	ctx = l.injectRPCInfo(ctx, "ListSessions")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalTraceService) GetSession(ctx context.Context, req *trace.GetSessionRequest, callOptions ...callopt.Option) (*trace.GetSessionResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceGetSessionArgs)
+		result := out.(*trace.TraceServiceGetSessionResult)
+		resp, err := l.impl.GetSession(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceGetSessionArgs{Req: req}
+	result := &trace.TraceServiceGetSessionResult{}
+	ctx = l.injectRPCInfo(ctx, "GetSession")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalTraceService) ExportTracesToFile(ctx context.Context, req *trace.ExportTracesToFileRequest, callOptions ...callopt.Option) (*trace.ExportTracesToFileResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceExportTracesToFileArgs)
+		result := out.(*trace.TraceServiceExportTracesToFileResult)
+		resp, err := l.impl.ExportTracesToFile(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceExportTracesToFileArgs{Req: req}
+	result := &trace.TraceServiceExportTracesToFileResult{}
+	ctx = l.injectRPCInfo(ctx, "ExportTracesToFile")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
+func (l *LocalTraceService) GetTraceExportJob(ctx context.Context, req *trace.GetTraceExportJobRequest, callOptions ...callopt.Option) (*trace.GetTraceExportJobResponse, error) {
+	chain := l.mds(func(ctx context.Context, in, out interface{}) error {
+		arg := in.(*trace.TraceServiceGetTraceExportJobArgs)
+		result := out.(*trace.TraceServiceGetTraceExportJobResult)
+		resp, err := l.impl.GetTraceExportJob(ctx, arg.Req)
+		if err != nil {
+			return err
+		}
+		result.SetSuccess(resp)
+		return nil
+	})
+
+	arg := &trace.TraceServiceGetTraceExportJobArgs{Req: req}
+	result := &trace.TraceServiceGetTraceExportJobResult{}
+	ctx = l.injectRPCInfo(ctx, "GetTraceExportJob")
+	if err := chain(ctx, arg, result); err != nil {
+		return nil, err
+	}
+	return result.GetSuccess(), nil
+}
+
 func (l *LocalTraceService) injectRPCInfo(ctx context.Context, method string) context.Context {
 	rpcStats := rpcinfo.AsMutableRPCStats(rpcinfo.NewRPCStats())
 	ri := rpcinfo.NewRPCInfo(
@@ -96,6 +96,94 @@ func (h *DatasetApplicationImpl) buildJob(ctx context.Context, req *dataset.Impo
 	return j
 }
 
+func (h *DatasetApplicationImpl) ExportDataset(ctx context.Context, req *dataset.ExportDatasetRequest) (r *dataset.ExportDatasetResponse, err error) {
+	// 鉴权
+	err = h.authByDatasetID(ctx, req.GetWorkspaceID(), req.GetDatasetID(), rpc.CommonActionRead)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := h.checkExportDatasetReq(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	job := h.buildExportJob(ctx, req, ds)
+	do := convertor.IOJobDTO2DO(job)
+	if err := h.svc.CreateIOJob(ctx, do); err != nil {
+		return nil, err
+	}
+
+	return &dataset.ExportDatasetResponse{JobID: gptr.Of(do.ID)}, nil
+}
+
+func (h *DatasetApplicationImpl) checkExportDatasetReq(ctx context.Context, req *dataset.ExportDatasetRequest) (*service.DatasetWithSchema, error) {
+	ds, err := h.svc.GetDataset(ctx, req.GetWorkspaceID(), req.GetDatasetID())
+	if err != nil {
+		return nil, err
+	}
+	schema := ds.Schema
+	if req.GetVersionID() > 0 {
+		version, vds, err := h.svc.GetVersionWithOpt(ctx, req.GetWorkspaceID(), req.GetVersionID(), &service.GetOpt{})
+		if err != nil {
+			return nil, err
+		}
+		if version.DatasetID != ds.ID {
+			return nil, errno.BadReqErrorf("version %d does not belong to dataset %d", req.GetVersionID(), ds.ID)
+		}
+		schema = vds.Schema
+	}
+
+	allFields := gslice.Map(schema.AvailableFields(), func(f *entity.FieldSchema) string { return f.Name })
+	mappingFields := gslice.Map(req.GetFieldMappings(), func(m *dataset_job.FieldMapping) string { return m.GetSource() })
+	if diff := gslice.Diff(mappingFields, allFields); len(diff) > 0 {
+		return nil, errno.BadReqErrorf("source field %s not found in dataset", strings.Join(diff, ", "))
+	}
+	columns := gslice.Map(req.GetFieldMappings(), func(m *dataset_job.FieldMapping) string { return m.GetTarget() })
+	if gslice.Contains(columns, "") || len(gslice.Uniq(columns)) != len(columns) {
+		return nil, errno.BadReqErrorf("target columns must be non-empty and unique")
+	}
+
+	// check file
+	format := req.GetFile().GetFormat()
+	switch format {
+	case dataset_job.FileFormat_JSONL, dataset_job.FileFormat_CSV, dataset_job.FileFormat_Parquet:
+	default:
+		return nil, errno.BadReqErrorf("unsupported export format %s", format)
+	}
+	ext := "." + strings.ToLower(format.String())
+	if req.GetFile().IsSetCompressFormat() {
+		if req.GetFile().GetCompressFormat() != dataset_job.FileFormat_ZIP {
+			return nil, errno.BadReqErrorf("unsupported compress format %s", req.GetFile().GetCompressFormat())
+		}
+		ext = "." + strings.ToLower(dataset_job.FileFormat_ZIP.String())
+	}
+	if got := strings.ToLower(filepath.Ext(req.GetFile().GetPath())); got != ext {
+		return nil, errno.BadReqErrorf("file format mismatch, want %s, got %s", ext, got)
+	}
+
+	return ds, nil
+}
+
+func (h *DatasetApplicationImpl) buildExportJob(ctx context.Context, req *dataset.ExportDatasetRequest, ds *service.DatasetWithSchema) *dataset_job.DatasetIOJob {
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	source := &dataset_job.DatasetIODataset{SpaceID: gptr.Of(ds.SpaceID), DatasetID: ds.ID}
+	if req.GetVersionID() > 0 {
+		source.VersionID = gptr.Of(req.GetVersionID())
+	}
+	return &dataset_job.DatasetIOJob{
+		AppID:         gptr.Of(ds.AppID),
+		SpaceID:       ds.SpaceID,
+		DatasetID:     ds.ID,
+		JobType:       dataset_job.JobType_ExportToFile,
+		Source:        &dataset_job.DatasetIOEndpoint{Dataset: source},
+		Target:        &dataset_job.DatasetIOEndpoint{File: req.GetFile()},
+		FieldMappings: req.GetFieldMappings(),
+		Status:        gptr.Of(dataset_job.JobStatus_Pending),
+		CreatedBy:     gptr.Of(userID),
+		UpdatedBy:     gptr.Of(userID),
+	}
+}
+
 func (h *DatasetApplicationImpl) GetDatasetIOJob(ctx context.Context, req *dataset.GetDatasetIOJobRequest) (r *dataset.GetDatasetIOJobResponse, err error) {
 	// 鉴权
 	err = h.authByJobID(ctx, req.GetWorkspaceID(), req.GetJobID(), rpc.CommonActionRead)
@@ -4,22 +4,35 @@
 package application
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"testing"
 
 	"github.com/bytedance/gg/gptr"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	mock_db "github.com/coze-dev/coze-loop/backend/infra/db/mocks"
 	mock_audit "github.com/coze-dev/coze-loop/backend/infra/external/audit/mocks"
+	mock_idgen "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	mock_lock "github.com/coze-dev/coze-loop/backend/infra/lock/mocks"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/dataset"
+	domain_dataset "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/domain/dataset"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/domain/dataset_job"
+	mock_conf "github.com/coze-dev/coze-loop/backend/modules/data/domain/component/conf/mocks"
 	mock_auth "github.com/coze-dev/coze-loop/backend/modules/data/domain/component/rpc/mocks"
+	mock_vfs "github.com/coze-dev/coze-loop/backend/modules/data/domain/component/vfs/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/component/mq"
+	mock_mq "github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/component/mq/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/repo"
 	mock_repo "github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/repo/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/service"
 	mock_dataset "github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/service/mocks"
+	common_entity "github.com/coze-dev/coze-loop/backend/modules/data/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/data/infra/vfs"
+	"github.com/coze-dev/coze-loop/backend/modules/data/pkg/pagination"
 )
 
 func TestDatasetApplicationImpl_ImportDataset(t *testing.T) {
@@ -192,3 +205,130 @@ func TestDatasetApplicationImpl_ListDatasetIOJobs(t *testing.T) {
 		})
 	}
 }
+
+func TestDatasetApplicationImpl_ExportDataset(t *testing.T) {
+	const (
+		spaceID   = int64(2)
+		datasetID = int64(1)
+		jobID     = int64(1000)
+	)
+	ctx := context.Background()
+	ds := &entity.Dataset{ID: datasetID, SpaceID: spaceID, SchemaID: 3}
+	schema := &entity.DatasetSchema{Fields: []*entity.FieldSchema{
+		{Key: "k1", Name: "input", ContentType: entity.ContentTypeText},
+		{Key: "k2", Name: "output", ContentType: entity.ContentTypeText},
+	}}
+
+	t.Run("导出任务经 job runner 写出文件", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAuth := mock_auth.NewMockIAuthProvider(ctrl)
+		mockRepo := mock_repo.NewMockIDatasetAPI(ctrl)
+		mockProducer := mock_mq.NewMockIDatasetJobPublisher(ctrl)
+		mockUnion := mock_vfs.NewMockIUnionFS(ctrl)
+		mockFS := mock_vfs.NewMockFileSystem(ctrl)
+		mockLocker := mock_lock.NewMockILocker(ctrl)
+		svc := service.NewDatasetServiceImpl(mock_db.NewMockProvider(ctrl), mock_idgen.NewMockIIDGenerator(ctrl), mockRepo,
+			mock_conf.NewMockIConfig(ctrl), mockProducer, mockUnion, mockLocker)
+		app := &DatasetApplicationImpl{auth: mockAuth, repo: mockRepo, svc: svc}
+
+		mockRepo.EXPECT().GetDataset(gomock.Any(), spaceID, datasetID, gomock.Any()).Return(ds, nil).AnyTimes()
+		mockRepo.EXPECT().GetSchema(gomock.Any(), spaceID, ds.SchemaID, gomock.Any()).Return(schema, nil).AnyTimes()
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil)
+
+		// 1. 创建任务并投递执行消息
+		var job *entity.IOJob
+		mockRepo.EXPECT().CreateIOJob(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, j *entity.IOJob, _ ...repo.Option) error {
+				j.ID = jobID
+				job = j
+				return nil
+			})
+		var msg *entity.JobRunMessage
+		mockProducer.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, m *entity.JobRunMessage, _ ...mq.MessageOpt) error {
+				msg = m
+				return nil
+			})
+
+		resp, err := app.ExportDataset(ctx, &dataset.ExportDatasetRequest{
+			WorkspaceID: gptr.Of(spaceID),
+			DatasetID:   datasetID,
+			File: &dataset_job.DatasetIOFile{
+				Provider: domain_dataset.StorageProvider_S3,
+				Path:     "export/data.csv",
+				Format:   gptr.Of(dataset_job.FileFormat_CSV),
+			},
+			FieldMappings: []*dataset_job.FieldMapping{{Source: "output", Target: "answer"}, {Source: "input", Target: "question"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, jobID, resp.GetJobID())
+		if !assert.NotNil(t, job) || !assert.NotNil(t, msg) {
+			return
+		}
+		assert.Equal(t, entity.JobType_ExportToFile, job.JobType)
+		assert.Equal(t, datasetID, job.Source.Dataset.DatasetID)
+		assert.Equal(t, jobID, msg.JobID)
+
+		// 2. job runner 消费消息并执行导出
+		mockRepo.EXPECT().GetIOJob(gomock.Any(), jobID, gomock.Any()).Return(job, nil)
+		mockLocker.EXPECT().LockBackoffWithRenew(gomock.Any(), service.FormatDatasetIOJobRunKey(jobID), gomock.Any(), gomock.Any()).
+			Return(true, ctx, func() {}, nil)
+		mockUnion.EXPECT().GetFileSystem(common_entity.ProviderS3).Return(mockFS, nil)
+		mockRepo.EXPECT().ListItems(gomock.Any(), gomock.Any()).Return([]*entity.Item{
+			{ID: 11, Data: []*entity.FieldData{{Key: "k1", Content: "q1"}, {Key: "k2", Content: "a1"}}},
+			{ID: 12, Data: []*entity.FieldData{{Key: "k1", Content: "q2"}}},
+		}, &pagination.PageResult{}, nil)
+		var statuses []entity.JobStatus
+		mockRepo.EXPECT().UpdateIOJob(gomock.Any(), jobID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ int64, delta *repo.DeltaDatasetIOJob, _ ...repo.Option) error {
+				if delta.Status != nil {
+					statuses = append(statuses, *delta.Status)
+				}
+				return nil
+			}).AnyTimes()
+		buf := &bytes.Buffer{}
+		mockFS.EXPECT().WriteFile(gomock.Any(), "export/data.csv", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, r io.Reader, _ int64) error {
+				_, err := io.Copy(buf, r)
+				return err
+			})
+
+		assert.NoError(t, svc.RunIOJob(ctx, msg))
+		assert.Equal(t, "answer,question\na1,q1\n,q2\n", buf.String())
+		assert.Equal(t, []entity.JobStatus{entity.JobStatus_Running, entity.JobStatus_Completed}, statuses)
+	})
+
+	t.Run("参数校验", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAuth := mock_auth.NewMockIAuthProvider(ctrl)
+		mockRepo := mock_repo.NewMockIDatasetAPI(ctrl)
+		mockDatasetService := mock_dataset.NewMockIDatasetAPI(ctrl)
+		app := &DatasetApplicationImpl{auth: mockAuth, repo: mockRepo, svc: mockDatasetService}
+
+		mockRepo.EXPECT().GetDataset(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ds, nil).AnyTimes()
+		mockAuth.EXPECT().AuthorizationWithoutSPI(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockDatasetService.EXPECT().GetDataset(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&service.DatasetWithSchema{Dataset: ds, Schema: schema}, nil).AnyTimes()
+		mockDatasetService.EXPECT().GetVersionWithOpt(gomock.Any(), spaceID, int64(9), gomock.Any()).
+			Return(&entity.DatasetVersion{ID: 9, DatasetID: 99}, &service.DatasetWithSchema{Dataset: ds, Schema: schema}, nil).AnyTimes()
+
+		csvFile := &dataset_job.DatasetIOFile{Path: "a.csv", Format: gptr.Of(dataset_job.FileFormat_CSV)}
+		for name, req := range map[string]*dataset.ExportDatasetRequest{
+			"未知字段":     {File: csvFile, FieldMappings: []*dataset_job.FieldMapping{{Source: "unknown", Target: "x"}}},
+			"重复列名":     {File: csvFile, FieldMappings: []*dataset_job.FieldMapping{{Source: "input", Target: "x"}, {Source: "output", Target: "x"}}},
+			"格式不支持":    {File: &dataset_job.DatasetIOFile{Path: "a.xlsx", Format: gptr.Of(dataset_job.FileFormat_XLSX)}},
+			"后缀不匹配":    {File: &dataset_job.DatasetIOFile{Path: "a.jsonl", Format: gptr.Of(dataset_job.FileFormat_CSV)}},
+			"压缩后缀不匹配":  {File: &dataset_job.DatasetIOFile{Path: "a.csv", Format: gptr.Of(dataset_job.FileFormat_CSV), CompressFormat: gptr.Of(dataset_job.FileFormat_ZIP)}},
+			"版本不属于数据集": {File: csvFile, VersionID: gptr.Of(int64(9))},
+		} {
+			req.WorkspaceID = gptr.Of(spaceID)
+			req.DatasetID = datasetID
+			_, err := app.ExportDataset(ctx, req)
+			assert.Error(t, err, name)
+		}
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vfs
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/bytedance/sonic"
+	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
+
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+)
+
+// FileWriter 按行写入数据文件，写出的文件可被 FileReader 读回。
+type FileWriter struct {
+	format entity.FileFormat
+	fields []string
+
+	csv     *csv.Writer     // 仅当 format == CSV 时有效
+	parquet *parquet.Writer // 仅当 format == Parquet 时有效
+	jsonl   *bufio.Writer   // 仅当 format == JSONL 时有效
+
+	cursor int64
+}
+
+// NewFileWriter 创建 FileWriter，fields 为列名，决定 CSV 表头与 Parquet 的列。
+func NewFileWriter(w io.Writer, fields []string, format entity.FileFormat) (*FileWriter, error) {
+	fw := &FileWriter{format: format, fields: fields}
+	switch format {
+	case entity.FileFormat_CSV:
+		fw.csv = csv.NewWriter(w)
+		if err := fw.csv.Write(fields); err != nil { // 第一行为表头
+			return nil, err
+		}
+
+	case entity.FileFormat_JSONL:
+		fw.jsonl = bufio.NewWriter(w)
+
+	case entity.FileFormat_Parquet:
+		if len(fields) == 0 {
+			return nil, errors.New("parquet file requires at least one field")
+		}
+		// 所有列均以字符串存储，与导入时的处理保持一致
+		group := make(parquet.Group, len(fields))
+		for _, field := range fields {
+			group[field] = parquet.Optional(parquet.String())
+		}
+		fw.parquet = parquet.NewWriter(w, parquet.NewSchema("dataset", group))
+
+	default:
+		return nil, errors.Errorf("unknown file format: %s", format)
+	}
+	return fw, nil
+}
+
+func (w *FileWriter) GetCursor() int64 {
+	return w.cursor
+}
+
+// Write 写入一行，缺失的列写为空字符串。
+func (w *FileWriter) Write(kv map[string]any) error {
+	var err error
+	switch w.format {
+	case entity.FileFormat_CSV:
+		err = w.writeCSV(kv)
+	case entity.FileFormat_JSONL:
+		err = w.writeJSONL(kv)
+	case entity.FileFormat_Parquet:
+		err = w.writeParquet(kv)
+	default:
+		err = errors.Errorf("unknown file format: %s", w.format)
+	}
+	if err != nil {
+		return errors.WithMessagef(err, "line_num=%d", w.cursor+1)
+	}
+	w.cursor += 1
+	return nil
+}
+
+func (w *FileWriter) writeCSV(kv map[string]any) error {
+	record := make([]string, len(w.fields))
+	for i, field := range w.fields {
+		v, err := stringifyValue(kv[field])
+		if err != nil {
+			return err
+		}
+		record[i] = v
+	}
+	return w.csv.Write(record)
+}
+
+func (w *FileWriter) writeJSONL(kv map[string]any) error {
+	row := make(map[string]any, len(w.fields))
+	for _, field := range w.fields {
+		row[field] = kv[field]
+		if row[field] == nil {
+			row[field] = ""
+		}
+	}
+	line, err := sonic.Marshal(row)
+	if err != nil {
+		return err
+	}
+	if _, err := w.jsonl.Write(line); err != nil {
+		return err
+	}
+	return w.jsonl.WriteByte('\n')
+}
+
+func (w *FileWriter) writeParquet(kv map[string]any) error {
+	row := make(map[string]any, len(w.fields))
+	for _, field := range w.fields {
+		v, err := stringifyValue(kv[field])
+		if err != nil {
+			return err
+		}
+		row[field] = v
+	}
+	return w.parquet.Write(row)
+}
+
+// Close 将缓冲的内容写出，不会关闭底层 io.Writer。
+func (w *FileWriter) Close() error {
+	switch w.format {
+	case entity.FileFormat_CSV:
+		w.csv.Flush()
+		return w.csv.Error()
+	case entity.FileFormat_JSONL:
+		return w.jsonl.Flush()
+	case entity.FileFormat_Parquet:
+		return w.parquet.Close()
+	default:
+		return nil
+	}
+}
+
+func stringifyValue(v any) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	case fmt.Stringer:
+		return val.String(), nil
+	case bool, int, int32, int64, float32, float64:
+		return fmt.Sprintf("%v", val), nil
+	default:
+		return sonic.MarshalString(val)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vfs
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+)
+
+type bytesFileInfo struct {
+	MockFileInfo
+	size int64
+}
+
+func (i *bytesFileInfo) Size() int64 { return i.size }
+
+type bytesReader struct {
+	*bytes.Reader
+}
+
+func (r *bytesReader) Close() error { return nil }
+
+func TestFileWriter_RoundTrip(t *testing.T) {
+	fields := []string{"input", "output", "extra"}
+	rows := []map[string]any{
+		{"input": "hello, \"world\"", "output": "line1\nline2"},
+		{"input": "你好", "output": "", "extra": map[string]any{"k": 1}},
+	}
+	want := []map[string]any{
+		{"input": "hello, \"world\"", "output": "line1\nline2", "extra": ""},
+		{"input": "你好", "output": "", "extra": `{"k":1}`},
+	}
+
+	for _, format := range []entity.FileFormat{entity.FileFormat_CSV, entity.FileFormat_JSONL, entity.FileFormat_Parquet} {
+		t.Run(format.String(), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w, err := NewFileWriter(buf, fields, format)
+			require.NoError(t, err)
+			for _, row := range rows {
+				require.NoError(t, w.Write(row))
+			}
+			require.NoError(t, w.Close())
+			assert.Equal(t, int64(len(rows)), w.GetCursor())
+
+			var got []map[string]any
+			if format == entity.FileFormat_Parquet {
+				pr := parquet.NewReader(bytes.NewReader(buf.Bytes()))
+				for {
+					kv := map[string]any{}
+					err := pr.Read(&kv)
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					require.NoError(t, err)
+					got = append(got, kv)
+				}
+			} else {
+				r := &bytesReader{Reader: bytes.NewReader(buf.Bytes())}
+				fr, err := NewFileReader("data", r, &bytesFileInfo{size: int64(buf.Len())}, format)
+				require.NoError(t, err)
+				for {
+					kv, err := fr.Next()
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					require.NoError(t, err)
+					got = append(got, kv)
+				}
+			}
+			if format == entity.FileFormat_JSONL {
+				// JSONL 保留原始的 JSON 类型
+				assert.Equal(t, map[string]any{"k": float64(1)}, got[1]["extra"])
+				got[1]["extra"] = `{"k":1}`
+			}
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestNewFileWriter_Error(t *testing.T) {
+	_, err := NewFileWriter(&bytes.Buffer{}, []string{"a"}, entity.FileFormat_ZIP)
+	assert.Error(t, err)
+	_, err = NewFileWriter(&bytes.Buffer{}, nil, entity.FileFormat_Parquet)
+	assert.Error(t, err)
+}
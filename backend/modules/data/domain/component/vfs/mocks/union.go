@@ -43,6 +43,21 @@ func (m *MockIUnionFS) EXPECT() *MockIUnionFSMockRecorder {
 	return m.recorder
 }
 
+// GetFileSystem mocks base method.
+func (m *MockIUnionFS) GetFileSystem(provider entity.Provider) (vfs.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileSystem", provider)
+	ret0, _ := ret[0].(vfs.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileSystem indicates an expected call of GetFileSystem.
+func (mr *MockIUnionFSMockRecorder) GetFileSystem(provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileSystem", reflect.TypeOf((*MockIUnionFS)(nil).GetFileSystem), provider)
+}
+
 // GetROFileSystem mocks base method.
 func (m *MockIUnionFS) GetROFileSystem(provider entity.Provider) (vfs.ROFileSystem, error) {
 	m.ctrl.T.Helper()
@@ -14,4 +14,5 @@ import (
 type IUnionFS interface {
 	StatFile(ctx context.Context, provider entity.Provider, path string) (fs.FileInfo, error)
 	GetROFileSystem(provider entity.Provider) (ROFileSystem, error)
+	GetFileSystem(provider entity.Provider) (FileSystem, error)
 }
@@ -0,0 +1,321 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+	"github.com/pkg/errors"
+
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/component/vfs"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/data/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/data/pkg/pagination"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const exportPageSize = 100
+
+type exportHandler struct {
+	job     *entity.IOJob
+	ds      *DatasetWithSchema
+	fsUnion vfs.IUnionFS
+	svc     IDatasetAPI
+	repo    repo.IDatasetAPI
+
+	preProcessed int64 // 已写入 DB 的处理行数，作为更新进度时的乐观锁
+}
+
+// exportColumn 导出文件中的一列，field 为数据集字段名，column 为文件列名
+type exportColumn struct {
+	field  string
+	column string
+}
+
+func (s *DatasetServiceImpl) newExportHandler(job *entity.IOJob, ds *DatasetWithSchema) *exportHandler {
+	var preProcessed *int64
+	if job.Progress != nil {
+		preProcessed = job.Progress.Processed
+	}
+	return &exportHandler{
+		job:          job,
+		ds:           ds,
+		fsUnion:      s.fsUnion,
+		svc:          s,
+		repo:         s.repo,
+		preProcessed: gptr.Indirect(preProcessed),
+	}
+}
+
+func (h *exportHandler) Handle(ctx context.Context) error {
+	err := h.run(ctx)
+	if err == nil || errno.IsRetryableErr(err) {
+		return err
+	}
+	// 不可重试的错误直接结束任务
+	return h.endJobWithError(ctx, err.Error())
+}
+
+func (h *exportHandler) run(ctx context.Context) error {
+	var target *entity.DatasetIOFile
+	if h.job.Target != nil {
+		target = h.job.Target.File
+	}
+	if target == nil || target.Path == "" {
+		return errors.New("target file is empty")
+	}
+	fs, err := h.fsUnion.GetFileSystem(target.Provider)
+	if err != nil {
+		return err
+	}
+	query, schema, err := h.buildQuery(ctx)
+	if err != nil {
+		return err
+	}
+	columns := h.resolveColumns(schema)
+	if len(columns) == 0 {
+		return errors.New("no field to export")
+	}
+
+	// 导出不支持断点续写，重新执行时从头开始并重置进度
+	if err := h.startJob(ctx); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", "dataset_export_*")
+	if err != nil {
+		return errno.NewRetryableErr(err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	total, err := h.writeItems(ctx, tmp, target, query, schema, columns)
+	if err != nil {
+		return err
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errno.NewRetryableErr(err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return errno.NewRetryableErr(err)
+	}
+	if err := fs.WriteFile(ctx, target.Path, tmp, size); err != nil {
+		logs.CtxError(ctx, "upload export file failed, job_id=%d, path=%s, err=%v", h.job.ID, target.Path, err)
+		return errno.NewRetryableErr(err)
+	}
+
+	delta := &repo.DeltaDatasetIOJob{
+		Status: gptr.Of(entity.JobStatus_Completed),
+		Total:  gptr.Of(total),
+		SubProgresses: []*entity.DatasetIOJobProgress{{
+			Name:      gptr.Of(target.Path),
+			Total:     gptr.Of(total),
+			Processed: gptr.Of(total),
+			Added:     gptr.Of(total),
+		}},
+		EndedAt: gptr.Of(time.Now()),
+	}
+	if err := h.repo.UpdateIOJob(ctx, h.job.ID, delta); err != nil {
+		return errno.NewRetryableErr(err)
+	}
+	logs.CtxInfo(ctx, "export job completed, job_id=%d, path=%s, total=%d", h.job.ID, target.Path, total)
+	return nil
+}
+
+// buildQuery 指定版本时导出该版本，否则导出草稿
+func (h *exportHandler) buildQuery(ctx context.Context) (*repo.ListItemsParams, *entity.DatasetSchema, error) {
+	var source *entity.DatasetIODataset
+	if h.job.Source != nil {
+		source = h.job.Source.Dataset
+	}
+	if source == nil || gptr.Indirect(source.VersionID) == 0 {
+		return repo.NewListItemsParamsOfDataset(h.ds.SpaceID, h.ds.ID), h.ds.Schema, nil
+	}
+
+	version, ds, err := h.svc.GetVersionWithOpt(ctx, h.ds.SpaceID, gptr.Indirect(source.VersionID), &GetOpt{})
+	if err != nil {
+		return nil, nil, errno.NewRetryableErr(err)
+	}
+	if version.DatasetID != h.ds.ID {
+		return nil, nil, errors.Errorf("version %d does not belong to dataset %d", version.ID, h.ds.ID)
+	}
+	return repo.NewListItemsParamsFromVersion(version), ds.Schema, nil
+}
+
+// resolveColumns 未指定字段映射时导出全部可用字段
+func (h *exportHandler) resolveColumns(schema *entity.DatasetSchema) []*exportColumn {
+	if schema == nil {
+		return nil
+	}
+	names := gslice.Map(schema.AvailableFields(), func(f *entity.FieldSchema) string { return f.Name })
+	if len(h.job.FieldMappings) == 0 {
+		return gslice.Map(names, func(name string) *exportColumn { return &exportColumn{field: name, column: name} })
+	}
+
+	columns := make([]*exportColumn, 0, len(h.job.FieldMappings))
+	seen := make(map[string]bool, len(h.job.FieldMappings))
+	for _, fm := range h.job.FieldMappings {
+		if !gslice.Contains(names, fm.Source) || seen[fm.Target] {
+			continue
+		}
+		seen[fm.Target] = true
+		columns = append(columns, &exportColumn{field: fm.Source, column: fm.Target})
+	}
+	return columns
+}
+
+func (h *exportHandler) startJob(ctx context.Context) error {
+	delta := &repo.DeltaDatasetIOJob{
+		Status:    gptr.Of(entity.JobStatus_Running),
+		StartedAt: gptr.Of(time.Now()),
+	}
+	if h.preProcessed != 0 {
+		logs.CtxInfo(ctx, "export job restarted, reset progress, job_id=%d, pre_processed=%d", h.job.ID, h.preProcessed)
+		delta.PreProcessed = gptr.Of(h.preProcessed)
+		delta.DeltaProcessed = -h.preProcessed
+		delta.DeltaAdded = -gptr.Indirect(h.job.Progress.Added)
+	}
+	if err := h.repo.UpdateIOJob(ctx, h.job.ID, delta); err != nil {
+		return errno.NewRetryableErr(err)
+	}
+	h.preProcessed = 0
+	return nil
+}
+
+// writeItems 分页读取 item 写入 w，返回写入的行数
+func (h *exportHandler) writeItems(ctx context.Context, w io.Writer, target *entity.DatasetIOFile, query *repo.ListItemsParams,
+	schema *entity.DatasetSchema, columns []*exportColumn,
+) (int64, error) {
+	format := gptr.Indirect(target.Format)
+	var zw *zip.Writer
+	if gptr.Indirect(target.CompressFormat) == entity.FileFormat_ZIP {
+		zw = zip.NewWriter(w)
+		entry, err := zw.Create(exportEntryName(target.Path, format))
+		if err != nil {
+			return 0, errno.NewRetryableErr(err)
+		}
+		w = entry
+	}
+	fw, err := vfs.NewFileWriter(w, gslice.Map(columns, func(c *exportColumn) string { return c.column }), format)
+	if err != nil {
+		return 0, err
+	}
+
+	var cursor string
+	for {
+		if err := ctx.Err(); err != nil {
+			logs.CtxWarn(ctx, "export items got context error, job_id=%d, err=%v", h.job.ID, err)
+			return 0, errno.NewRetryableErr(err)
+		}
+		query.Paginator = pagination.New(pagination.WithCursor(cursor), pagination.WithLimit(exportPageSize))
+		items, pr, err := h.repo.ListItems(ctx, query)
+		if err != nil {
+			return 0, errno.NewRetryableErr(err)
+		}
+		if err := h.svc.LoadItemData(ctx, items...); err != nil {
+			return 0, errno.NewRetryableErr(err)
+		}
+		SanitizeOutputItem(schema, items)
+
+		lastCursor := fw.GetCursor()
+		for _, item := range items {
+			if err := fw.Write(item2KV(item, columns)); err != nil {
+				return 0, errors.WithMessagef(err, "write item %d", item.ID)
+			}
+		}
+		if err := h.saveProgress(ctx, fw.GetCursor()-lastCursor); err != nil {
+			return 0, err
+		}
+
+		cursor = pr.Cursor
+		if cursor == "" || len(items) == 0 {
+			break
+		}
+	}
+
+	if err := fw.Close(); err != nil {
+		return 0, errno.NewRetryableErr(err)
+	}
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			return 0, errno.NewRetryableErr(err)
+		}
+	}
+	return fw.GetCursor(), nil
+}
+
+func (h *exportHandler) saveProgress(ctx context.Context, processed int64) error {
+	if processed == 0 {
+		return nil
+	}
+	delta := &repo.DeltaDatasetIOJob{
+		PreProcessed:   gptr.Of(h.preProcessed),
+		DeltaProcessed: processed,
+		DeltaAdded:     processed,
+	}
+	if err := h.repo.UpdateIOJob(ctx, h.job.ID, delta); err != nil {
+		return errno.NewRetryableErr(err)
+	}
+	h.preProcessed += processed
+	logs.CtxInfo(ctx, "export progress saved, job_id=%d, processed=%d", h.job.ID, h.preProcessed)
+	return nil
+}
+
+func (h *exportHandler) endJobWithError(ctx context.Context, errMsg string) error {
+	logs.CtxWarn(ctx, "export job failed, job_id=%d, err=%s", h.job.ID, errMsg)
+	delta := &repo.DeltaDatasetIOJob{
+		Status: gptr.Of(entity.JobStatus_Failed),
+		Errors: []*entity.ItemErrorGroup{
+			{
+				Type:    gptr.Of(entity.ItemErrorType_InternalError),
+				Summary: gptr.Of(errMsg),
+			},
+		},
+		EndedAt: gptr.Of(time.Now()),
+	}
+	return h.repo.UpdateIOJob(ctx, h.job.ID, delta)
+}
+
+// item2KV 文本字段导出为字符串，多模态字段导出为 FieldData 的 JSON 结构
+func item2KV(item *entity.Item, columns []*exportColumn) map[string]any {
+	byName := gslice.ToMap(item.Data, func(d *entity.FieldData) (string, *entity.FieldData) { return d.Name, d })
+	kv := make(map[string]any, len(columns))
+	for _, c := range columns {
+		data, ok := byName[c.field]
+		if !ok || data == nil {
+			continue
+		}
+		if !data.ContentType.IsMultiModal() && len(data.Attachments) == 0 && len(data.Parts) == 0 {
+			kv[c.column] = data.Content
+			continue
+		}
+		kv[c.column] = &entity.FieldData{
+			ContentType: data.ContentType,
+			Format:      data.Format,
+			Content:     data.Content,
+			Attachments: data.Attachments,
+			Parts:       data.Parts,
+		}
+	}
+	return kv
+}
+
+// exportEntryName 压缩包内数据文件的名称
+func exportEntryName(filename string, format entity.FileFormat) string {
+	base := strings.TrimSuffix(path.Base(filename), path.Ext(filename))
+	return base + "." + strings.ToLower(format.String())
+}
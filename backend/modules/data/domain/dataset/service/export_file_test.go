@@ -0,0 +1,187 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/sonic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mock_vfs "github.com/coze-dev/coze-loop/backend/modules/data/domain/component/vfs/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/repo"
+	mock_repo "github.com/coze-dev/coze-loop/backend/modules/data/domain/dataset/repo/mocks"
+	common_entity "github.com/coze-dev/coze-loop/backend/modules/data/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/data/pkg/pagination"
+)
+
+func TestExportHandler_Handle(t *testing.T) {
+	ds := &DatasetWithSchema{
+		Dataset: &entity.Dataset{ID: 1, SpaceID: 2},
+		Schema: &entity.DatasetSchema{
+			Fields: []*entity.FieldSchema{
+				{Key: "k1", Name: "input", ContentType: entity.ContentTypeText},
+				{Key: "k2", Name: "image", ContentType: entity.ContentTypeImage},
+				{Key: "k3", Name: "deleted", ContentType: entity.ContentTypeText, Status: entity.FieldStatusDeleted},
+			},
+		},
+	}
+	image := &entity.FieldData{Key: "k2", ContentType: entity.ContentTypeImage, Attachments: []*entity.ObjectStorage{{URI: "tos://a.png"}}}
+	pages := [][]*entity.Item{
+		{
+			{ID: 11, Data: []*entity.FieldData{{Key: "k1", Content: "q1"}, image}},
+			{ID: 12, Data: []*entity.FieldData{{Key: "k1", Content: "q2"}, {Key: "k3", Content: "x"}}},
+		},
+		{
+			{ID: 13, Data: []*entity.FieldData{{Key: "k1", Content: "q3"}}},
+		},
+	}
+
+	setup := func(t *testing.T, job *entity.IOJob) (*exportHandler, *mock_repo.MockIDatasetAPI, *mock_vfs.MockFileSystem) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockIDatasetAPI(ctrl)
+		mockUnion := mock_vfs.NewMockIUnionFS(ctrl)
+		mockFS := mock_vfs.NewMockFileSystem(ctrl)
+		mockUnion.EXPECT().GetFileSystem(common_entity.ProviderS3).Return(mockFS, nil).AnyTimes()
+		svc := &DatasetServiceImpl{repo: mockRepo, fsUnion: mockUnion}
+		return svc.newExportHandler(job, ds), mockRepo, mockFS
+	}
+	expectPages := func(mockRepo *mock_repo.MockIDatasetAPI) {
+		gomock.InOrder(
+			mockRepo.EXPECT().ListItems(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, params *repo.ListItemsParams, _ ...repo.Option) ([]*entity.Item, *pagination.PageResult, error) {
+					assert.Equal(t, ds.ID, params.DatasetID)
+					return pages[0], &pagination.PageResult{Cursor: "next"}, nil
+				}),
+			mockRepo.EXPECT().ListItems(gomock.Any(), gomock.Any()).Return(pages[1], &pagination.PageResult{}, nil),
+		)
+	}
+	captureFile := func(mockFS *mock_vfs.MockFileSystem, path string) *bytes.Buffer {
+		buf := &bytes.Buffer{}
+		mockFS.EXPECT().WriteFile(gomock.Any(), path, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, r io.Reader, size int64) error {
+				n, err := io.Copy(buf, r)
+				assert.Equal(t, size, n)
+				return err
+			})
+		return buf
+	}
+
+	t.Run("jsonl with field mappings", func(t *testing.T) {
+		job := &entity.IOJob{
+			ID:      100,
+			JobType: entity.JobType_ExportToFile,
+			Target: &entity.DatasetIOEndpoint{File: &entity.DatasetIOFile{
+				Provider: common_entity.ProviderS3,
+				Path:     "export/data.jsonl",
+				Format:   gptr.Of(entity.FileFormat_JSONL),
+			}},
+			FieldMappings: []*entity.FieldMapping{
+				{Source: "input", Target: "question"},
+				{Source: "image", Target: "picture"},
+				{Source: "deleted", Target: "deleted"},
+			},
+			// 上次运行中断，已处理 2 行
+			Progress: &entity.DatasetIOJobProgress{Processed: gptr.Of(int64(2)), Added: gptr.Of(int64(2))},
+		}
+		h, mockRepo, mockFS := setup(t, job)
+		expectPages(mockRepo)
+		buf := captureFile(mockFS, "export/data.jsonl")
+
+		var deltas []*repo.DeltaDatasetIOJob
+		mockRepo.EXPECT().UpdateIOJob(gomock.Any(), job.ID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ int64, delta *repo.DeltaDatasetIOJob, _ ...repo.Option) error {
+				deltas = append(deltas, delta)
+				return nil
+			}).Times(4)
+
+		require.NoError(t, h.Handle(context.Background()))
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 3)
+		var first map[string]any
+		require.NoError(t, sonic.UnmarshalString(lines[0], &first))
+		assert.Equal(t, "q1", first["question"])
+		assert.Equal(t, map[string]any{
+			"content_type": "image",
+			"attachments":  []any{map[string]any{"uri": "tos://a.png"}},
+		}, first["picture"])
+		assert.NotContains(t, first, "deleted")
+		assert.JSONEq(t, `{"question":"q3","picture":""}`, lines[2])
+
+		require.Len(t, deltas, 4)
+		// 重置上次的进度
+		assert.Equal(t, entity.JobStatus_Running, gptr.Indirect(deltas[0].Status))
+		assert.Equal(t, int64(2), gptr.Indirect(deltas[0].PreProcessed))
+		assert.Equal(t, int64(-2), deltas[0].DeltaProcessed)
+		assert.Equal(t, int64(-2), deltas[0].DeltaAdded)
+		// 逐页更新进度
+		assert.Equal(t, int64(0), gptr.Indirect(deltas[1].PreProcessed))
+		assert.Equal(t, int64(2), deltas[1].DeltaProcessed)
+		assert.Equal(t, int64(2), gptr.Indirect(deltas[2].PreProcessed))
+		assert.Equal(t, int64(1), deltas[2].DeltaProcessed)
+		// 完成
+		assert.Equal(t, entity.JobStatus_Completed, gptr.Indirect(deltas[3].Status))
+		assert.Equal(t, int64(3), gptr.Indirect(deltas[3].Total))
+		assert.NotNil(t, deltas[3].EndedAt)
+	})
+
+	t.Run("csv in zip with all fields", func(t *testing.T) {
+		job := &entity.IOJob{
+			ID:      101,
+			JobType: entity.JobType_ExportToFile,
+			Target: &entity.DatasetIOEndpoint{File: &entity.DatasetIOFile{
+				Provider:       common_entity.ProviderS3,
+				Path:           "export/data.zip",
+				Format:         gptr.Of(entity.FileFormat_CSV),
+				CompressFormat: gptr.Of(entity.FileFormat_ZIP),
+			}},
+		}
+		h, mockRepo, mockFS := setup(t, job)
+		expectPages(mockRepo)
+		buf := captureFile(mockFS, "export/data.zip")
+		mockRepo.EXPECT().UpdateIOJob(gomock.Any(), job.ID, gomock.Any()).Return(nil).Times(4)
+
+		require.NoError(t, h.Handle(context.Background()))
+
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		require.Len(t, zr.File, 1)
+		assert.Equal(t, "data.csv", zr.File[0].Name)
+		f, err := zr.File[0].Open()
+		require.NoError(t, err)
+		defer f.Close()
+		records, err := csv.NewReader(f).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"input", "image"},
+			{"q1", `{"content_type":"image","attachments":[{"uri":"tos://a.png"}]}`},
+			{"q2", ""},
+			{"q3", ""},
+		}, records)
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		job := &entity.IOJob{ID: 102, JobType: entity.JobType_ExportToFile}
+		h, mockRepo, _ := setup(t, job)
+		mockRepo.EXPECT().UpdateIOJob(gomock.Any(), job.ID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ int64, delta *repo.DeltaDatasetIOJob, _ ...repo.Option) error {
+				assert.Equal(t, entity.JobStatus_Failed, gptr.Indirect(delta.Status))
+				require.Len(t, delta.Errors, 1)
+				assert.Equal(t, "target file is empty", gptr.Indirect(delta.Errors[0].Summary))
+				return nil
+			})
+		assert.NoError(t, h.Handle(context.Background()))
+	})
+}
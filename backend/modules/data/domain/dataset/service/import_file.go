@@ -13,6 +13,7 @@ import (
 	"github.com/bytedance/gg/gmap"
 	"github.com/bytedance/gg/gptr"
 	"github.com/bytedance/gg/gslice"
+	"github.com/bytedance/sonic"
 	"github.com/pkg/errors"
 
 	"github.com/coze-dev/coze-loop/backend/modules/data/domain/component/vfs"
@@ -259,6 +260,10 @@ func (h *importHandler) kv2Item(kv map[string]any) *entity.Item {
 			continue
 		}
 		for _, name := range names {
+			if data, ok := h.parseMultiModalField(name, v); ok {
+				item.Data = append(item.Data, data)
+				continue
+			}
 			item.Data = append(item.Data, &entity.FieldData{
 				Name:    name,
 				Content: fmt.Sprintf("%v", v), // todo: 非 string json marshal?
@@ -268,6 +273,39 @@ func (h *importHandler) kv2Item(kv map[string]any) *entity.Item {
 	return item
 }
 
+// parseMultiModalField 解析导出时写入的多模态字段（FieldData 的 JSON 结构），
+// 仅对 schema 中的多模态字段生效。
+func (h *importHandler) parseMultiModalField(name string, v any) (*entity.FieldData, bool) {
+	if h.ds == nil || h.ds.Schema == nil {
+		return nil, false
+	}
+	field, ok := gslice.Find(h.ds.Schema.AvailableFields(), func(f *entity.FieldSchema) bool { return f.Name == name }).Get()
+	if !ok || !field.ContentType.IsMultiModal() {
+		return nil, false
+	}
+
+	var raw string
+	switch val := v.(type) {
+	case string:
+		raw = val
+	case map[string]any:
+		b, err := sonic.MarshalString(val)
+		if err != nil {
+			return nil, false
+		}
+		raw = b
+	default:
+		return nil, false
+	}
+	data := &entity.FieldData{}
+	if err := sonic.UnmarshalString(raw, data); err != nil || data.ContentType == "" {
+		return nil, false
+	}
+	data.Key = ""
+	data.Name = name
+	return data, true
+}
+
 func (h *importHandler) saveCurrentUnit(ctx context.Context) error {
 	unit := h.currentUnit
 	if unit.isEmpty() {
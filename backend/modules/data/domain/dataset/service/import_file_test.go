@@ -253,6 +253,31 @@ func TestKV2Item(t *testing.T) {
 	}
 }
 
+func TestImportHandler_kv2Item_multiModal(t *testing.T) {
+	h := &importHandler{
+		fieldMapping: map[string][]string{"question": {"input"}, "picture": {"image"}},
+		ds: &DatasetWithSchema{Schema: &entity.DatasetSchema{Fields: []*entity.FieldSchema{
+			{Key: "k1", Name: "input", ContentType: entity.ContentTypeText},
+			{Key: "k2", Name: "image", ContentType: entity.ContentTypeImage},
+		}}},
+	}
+
+	// 导出的 CSV/Parquet 中多模态字段为 JSON 字符串，JSONL 中为对象
+	for _, picture := range []any{
+		`{"content_type":"image","attachments":[{"uri":"tos://a.png"}]}`,
+		map[string]any{"content_type": "image", "attachments": []any{map[string]any{"uri": "tos://a.png"}}},
+	} {
+		item := h.kv2Item(map[string]any{"question": `{"content_type":"image"}`, "picture": picture})
+		byName := make(map[string]*entity.FieldData)
+		for _, d := range item.Data {
+			byName[d.Name] = d
+		}
+		assert.Equal(t, `{"content_type":"image"}`, byName["input"].Content)
+		assert.Equal(t, entity.ContentTypeImage, byName["image"].ContentType)
+		assert.Equal(t, []*entity.ObjectStorage{{URI: "tos://a.png"}}, byName["image"].Attachments)
+	}
+}
+
 func TestImportWorkspace_noMoreFile(t *testing.T) {
 	w := &importWorkspace{}
 	// 可根据实际情况设置 w 的属性值
@@ -79,6 +79,11 @@ func (s *DatasetServiceImpl) RunIOJob(ctx context.Context, msg *entity.JobRunMes
 		if err := h.Handle(ctx); err != nil {
 			return err
 		}
+	case entity.JobType_ExportToFile:
+		h := s.newExportHandler(job, ds)
+		if err := h.Handle(ctx); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -216,6 +216,8 @@ func TestRunIOJob(t *testing.T) {
 				mockRepo.EXPECT().GetDataset(context.Background(), int64(0), int64(0)).Return(&entity.Dataset{}, nil)
 				mockRepo.EXPECT().GetSchema(context.Background(), int64(0), int64(0), gomock.Any()).Return(&entity.DatasetSchema{}, nil)
 				mockILocker.EXPECT().LockBackoffWithRenew(context.Background(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, context.Background(), func() {}, nil)
+				// 未指定导出目标，任务直接失败
+				mockRepo.EXPECT().UpdateIOJob(context.Background(), int64(0), gomock.Any()).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -41,3 +41,16 @@ func (f *UnionFS) GetROFileSystem(provider entity.Provider) (ivfs.ROFileSystem,
 	}
 	return fs, nil
 }
+
+func (f *UnionFS) GetFileSystem(provider entity.Provider) (ivfs.FileSystem, error) {
+	var fs ivfs.FileSystem
+	switch provider {
+	case entity.ProviderS3:
+		fs = f.oss
+	default:
+	}
+	if fs == nil {
+		return nil, fmt.Errorf("unsupported provider: %s", provider)
+	}
+	return fs, nil
+}
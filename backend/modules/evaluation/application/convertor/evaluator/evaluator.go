@@ -295,7 +295,12 @@ func ConvertEvaluatorContent2DO(content *evaluatordto.EvaluatorContent, evaluato
 			AccessProtocol:        content.CustomRPCEvaluator.AccessProtocol,
 			ServiceName:           content.CustomRPCEvaluator.ServiceName,
 			Cluster:               content.CustomRPCEvaluator.Cluster,
+			InvokeHTTPInfo:        ConvertEvaluatorHTTPInfoDTO2DO(content.CustomRPCEvaluator.InvokeHTTPInfo),
 			Timeout:               content.CustomRPCEvaluator.Timeout,
+			Endpoint:              content.CustomRPCEvaluator.Endpoint,
+			Headers:               content.CustomRPCEvaluator.Headers,
+			HealthCheckHTTPInfo:   ConvertEvaluatorHTTPInfoDTO2DO(content.CustomRPCEvaluator.HealthCheckHTTPInfo),
+			MaxRetries:            content.CustomRPCEvaluator.MaxRetries,
 		}
 		if content.CustomRPCEvaluator.RateLimit != nil {
 			rateLimit, err := commonconvertor.ConvertRateLimitDTO2DO(content.CustomRPCEvaluator.RateLimit)
@@ -499,6 +504,10 @@ func ConvertCustomRPCEvaluatorVersionDTO2DO(evaluatorID, spaceID int64, dto *eva
 				customRPCEvaluatorVersion.RateLimit = rateLimit
 			}
 			customRPCEvaluatorVersion.Ext = dto.EvaluatorContent.CustomRPCEvaluator.Ext
+			customRPCEvaluatorVersion.Endpoint = dto.EvaluatorContent.CustomRPCEvaluator.Endpoint
+			customRPCEvaluatorVersion.Headers = dto.EvaluatorContent.CustomRPCEvaluator.Headers
+			customRPCEvaluatorVersion.HealthCheckHTTPInfo = ConvertEvaluatorHTTPInfoDTO2DO(dto.EvaluatorContent.CustomRPCEvaluator.HealthCheckHTTPInfo)
+			customRPCEvaluatorVersion.MaxRetries = dto.EvaluatorContent.CustomRPCEvaluator.MaxRetries
 		}
 	}
 	return customRPCEvaluatorVersion, nil
@@ -517,6 +526,7 @@ func ConvertCustomRPCEvaluatorVersionDO2DTO(do *evaluatordo.CustomRPCEvaluatorVe
 			ReceiveChatHistory: nil,
 			InputSchemas:       commonconvertor.ConvertArgsSchemaListDO2DTO(do.InputSchemas),
 			OutputSchemas:      commonconvertor.ConvertArgsSchemaListDO2DTO(do.OutputSchemas),
+			// Headers中包含鉴权信息，不对外返回
 			CustomRPCEvaluator: &evaluatordto.CustomRPCEvaluator{
 				ProviderEvaluatorCode: do.ProviderEvaluatorCode,
 				AccessProtocol:        do.AccessProtocol,
@@ -526,6 +536,9 @@ func ConvertCustomRPCEvaluatorVersionDO2DTO(do *evaluatordo.CustomRPCEvaluatorVe
 				InvokeHTTPInfo:        ConvertEvaluatorHTTPInfoDO2DTO(do.InvokeHTTPInfo),
 				RateLimit:             commonconvertor.ConvertRateLimitDO2DTO(do.RateLimit),
 				Ext:                   do.Ext,
+				Endpoint:              do.Endpoint,
+				HealthCheckHTTPInfo:   ConvertEvaluatorHTTPInfoDO2DTO(do.HealthCheckHTTPInfo),
+				MaxRetries:            do.MaxRetries,
 			},
 		},
 	}
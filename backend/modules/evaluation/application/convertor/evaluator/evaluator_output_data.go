@@ -71,6 +71,7 @@ func ConvertEvaluatorResultDTO2DO(dto *evaluatordto.EvaluatorResult_) *evaluator
 		Score:      dto.Score,
 		Correction: ConvertCorrectionDTO2DO(dto.Correction),
 		Reasoning:  dto.GetReasoning(),
+		Dimensions: ConvertEvaluatorDimensionResultsDTO2DO(dto.GetDimensions()),
 	}
 }
 
@@ -83,9 +84,50 @@ func ConvertEvaluatorResultDO2DTO(do *evaluatorentity.EvaluatorResult) *evaluato
 		Score:      do.Score,
 		Correction: ConvertCorrectionDO2DTO(do.Correction),
 		Reasoning:  gptr.Of(do.Reasoning),
+		Dimensions: ConvertEvaluatorDimensionResultsDO2DTO(do.Dimensions),
 	}
 }
 
+// ConvertEvaluatorDimensionResultsDTO2DO 将维度结果 DTO 列表转换为 evaluatorentity.EvaluatorDimensionResult 列表
+func ConvertEvaluatorDimensionResultsDTO2DO(dtos []*evaluatordto.EvaluatorDimensionResult_) []*evaluatorentity.EvaluatorDimensionResult {
+	if len(dtos) == 0 {
+		return nil
+	}
+	dos := make([]*evaluatorentity.EvaluatorDimensionResult, 0, len(dtos))
+	for _, dto := range dtos {
+		if dto == nil {
+			continue
+		}
+		dos = append(dos, &evaluatorentity.EvaluatorDimensionResult{
+			Name:      dto.GetName(),
+			Score:     dto.Score,
+			Label:     dto.GetLabel(),
+			Reasoning: dto.GetReasoning(),
+		})
+	}
+	return dos
+}
+
+// ConvertEvaluatorDimensionResultsDO2DTO 将 evaluatorentity.EvaluatorDimensionResult 列表转换为 DTO 列表
+func ConvertEvaluatorDimensionResultsDO2DTO(dos []*evaluatorentity.EvaluatorDimensionResult) []*evaluatordto.EvaluatorDimensionResult_ {
+	if len(dos) == 0 {
+		return nil
+	}
+	dtos := make([]*evaluatordto.EvaluatorDimensionResult_, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, &evaluatordto.EvaluatorDimensionResult_{
+			Name:      gptr.Of(do.Name),
+			Score:     do.Score,
+			Label:     gptr.Of(do.Label),
+			Reasoning: gptr.Of(do.Reasoning),
+		})
+	}
+	return dtos
+}
+
 // ConvertEvaluatorUsageDTO2DO 将 DTO 转换为 evaluatorentity.EvaluatorUsage 结构体
 func ConvertEvaluatorUsageDTO2DO(dto *evaluatordto.EvaluatorUsage) *evaluatorentity.EvaluatorUsage {
 	if dto == nil {
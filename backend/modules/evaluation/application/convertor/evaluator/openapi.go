@@ -298,10 +298,30 @@ func OpenAPIEvaluatorResultDO2DTO(do *entity.EvaluatorResult) *openapiEvaluator.
 		Score:      do.Score,
 		Reasoning:  gptr.Of(do.Reasoning),
 		Correction: OpenAPICorrectionDO2DTO(do.Correction),
+		Dimensions: OpenAPIEvaluatorDimensionResultsDO2DTO(do.Dimensions),
 	}
 	return dto
 }
 
+func OpenAPIEvaluatorDimensionResultsDO2DTO(dos []*entity.EvaluatorDimensionResult) []*openapiEvaluator.EvaluatorDimensionResult_ {
+	if len(dos) == 0 {
+		return nil
+	}
+	dtos := make([]*openapiEvaluator.EvaluatorDimensionResult_, 0, len(dos))
+	for _, do := range dos {
+		if do == nil {
+			continue
+		}
+		dtos = append(dtos, &openapiEvaluator.EvaluatorDimensionResult_{
+			Name:      gptr.Of(do.Name),
+			Score:     do.Score,
+			Label:     gptr.Of(do.Label),
+			Reasoning: gptr.Of(do.Reasoning),
+		})
+	}
+	return dtos
+}
+
 func OpenAPICorrectionDO2DTO(do *entity.Correction) *openapiEvaluator.Correction {
 	if do == nil {
 		return nil
@@ -55,9 +55,27 @@ func EvaluatorResultsDOToDTO(result *entity.EvaluatorAggregateResult) *domain_ex
 		AggregatorResults:  AggregatorResultDOsToDTOs(result.AggregatorResults),
 		Name:               result.Name,
 		Version:            result.Version,
+		DimensionResults:   EvaluatorDimensionResultsDOToDTO(result.DimensionResults),
 	}
 }
 
+func EvaluatorDimensionResultsDOToDTO(results []*entity.EvaluatorDimensionAggregateResult) []*domain_expt.EvaluatorDimensionAggregateResult_ {
+	if len(results) == 0 {
+		return nil
+	}
+	dtos := make([]*domain_expt.EvaluatorDimensionAggregateResult_, 0, len(results))
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		dtos = append(dtos, &domain_expt.EvaluatorDimensionAggregateResult_{
+			Name:              gptr.Of(r.Name),
+			AggregatorResults: AggregatorResultDOsToDTOs(r.AggregatorResults),
+		})
+	}
+	return dtos
+}
+
 func AnnotationResultDOToDTO(result *entity.AnnotationAggregateResult) *domain_expt.AnnotationAggregateResult_ {
 	if result == nil {
 		return nil
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"math"
+
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func ExptComparisonResultsDOToDTOs(results []*entity.ExptComparisonResult) []*domain_expt.ExptComparisonResult_ {
+	dtos := make([]*domain_expt.ExptComparisonResult_, 0, len(results))
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		evaluatorResults := make([]*domain_expt.EvaluatorComparisonResult_, 0, len(result.EvaluatorResults))
+		for _, er := range result.EvaluatorResults {
+			if er == nil {
+				continue
+			}
+			evaluatorResults = append(evaluatorResults, EvaluatorComparisonResultDOToDTO(er))
+		}
+		dtos = append(dtos, &domain_expt.ExptComparisonResult_{
+			BaseExperimentID:    result.BaseExptID,
+			CompareExperimentID: result.CompareExptID,
+			EvaluatorResults:    evaluatorResults,
+		})
+	}
+	return dtos
+}
+
+func EvaluatorComparisonResultDOToDTO(result *entity.EvaluatorComparisonResult) *domain_expt.EvaluatorComparisonResult_ {
+	testResults := make([]*domain_expt.SignificanceTestResult_, 0, len(result.TestResults))
+	for _, tr := range result.TestResults {
+		if tr == nil {
+			continue
+		}
+		testResults = append(testResults, &domain_expt.SignificanceTestResult_{
+			TestType:    gptr.Of(domain_expt.SignificanceTestType(tr.TestType)),
+			Statistic:   finiteFloat(tr.Statistic),
+			PValue:      finiteFloat(tr.PValue),
+			Significant: gptr.Of(tr.Significant),
+			CiLower:     tr.CILower,
+			CiUpper:     tr.CIUpper,
+		})
+	}
+	return &domain_expt.EvaluatorComparisonResult_{
+		EvaluatorVersionID: result.EvaluatorVersionID,
+		PairedCount:        gptr.Of(result.PairedCount),
+		BaseMean:           gptr.Of(result.BaseMean),
+		CompareMean:        gptr.Of(result.CompareMean),
+		MeanDiff:           gptr.Of(result.MeanDiff),
+		Binary:             gptr.Of(result.Binary),
+		TestResults:        testResults,
+		Verdict:            gptr.Of(domain_expt.ComparisonVerdict(result.Verdict)),
+	}
+}
+
+// finiteFloat 差值完全一致时 t 统计量为 ±Inf，无法 JSON 序列化，置空返回
+func finiteFloat(v float64) *float64 {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return nil
+	}
+	return gptr.Of(v)
+}
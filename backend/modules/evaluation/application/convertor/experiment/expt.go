@@ -17,6 +17,8 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/application/convertor/target"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/maps"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/slices"
@@ -58,6 +60,12 @@ func (e *EvalConfConvert) ConvertToEntity(cer *expt.CreateExperimentRequest, eva
 	if cer.GetItemRetryNum() > 0 {
 		ec.ItemRetryNum = gptr.Of(int(cer.GetItemRetryNum()))
 	}
+	if gateConf := ExptGateConfDTO2DO(cer.GetGateConfig()); !gateConf.IsEmpty() {
+		if err := gateConf.Valid(); err != nil {
+			return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+		}
+		ec.GateConf = gateConf
+	}
 	return ec, nil
 }
 
@@ -388,6 +396,9 @@ func ToExptDTO(experiment *entity.Experiment) *domain_expt.Experiment {
 		}
 	}
 
+	res.GateConfig = ExptGateConfDO2DTO(experiment.GetGateConf())
+	res.GateVerdict = ExptGateVerdictDO2DTO(experiment.GateVerdict)
+
 	// 关联的实验模板（仅在查询时按需填充基础信息）
 	if experiment.ExptTemplateMeta != nil {
 		res.ExptTemplateMeta = &domain_expt.ExptTemplateMeta{
@@ -463,6 +474,7 @@ func CreateEvalTargetParamDTO2DO(param *eval_target.CreateEvalTargetParam) *enti
 			Ext:       param.CustomEvalTarget.Ext,
 		}
 	}
+	res.OpenAIChat = target.OpenAIChatDTO2DO(param.OpenaiChat)
 	return res
 }
 
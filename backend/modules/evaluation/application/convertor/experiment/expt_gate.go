@@ -0,0 +1,235 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"fmt"
+
+	"github.com/bytedance/gg/gptr"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	openapiExperiment "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain_openapi/experiment"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func ExptGateConfDTO2DO(dto *domain_expt.ExptGateConfig) *entity.ExptGateConf {
+	if dto == nil {
+		return nil
+	}
+	conf := &entity.ExptGateConf{Rules: make([]*entity.ExptGateRule, 0, len(dto.GetRules()))}
+	for _, rule := range dto.GetRules() {
+		if rule == nil {
+			continue
+		}
+		conf.Rules = append(conf.Rules, exptGateRuleDTO2DO(rule))
+	}
+	return conf
+}
+
+func exptGateRuleDTO2DO(dto *domain_expt.ExptGateRule) *entity.ExptGateRule {
+	return &entity.ExptGateRule{
+		RuleType:            entity.ExptGateRuleType(dto.GetRuleType()),
+		Name:                dto.GetName(),
+		EvaluatorVersionID:  dto.GetEvaluatorVersionID(),
+		Operator:            entity.ExptGateOperator(dto.GetOperator()),
+		Threshold:           dto.GetThreshold(),
+		BaselineExptID:      dto.GetBaselineExperimentID(),
+		EvaluatorVersionIDs: dto.GetEvaluatorVersionIds(),
+		ConfidenceLevel:     dto.GetConfidenceLevel(),
+	}
+}
+
+func ExptGateConfDO2DTO(do *entity.ExptGateConf) *domain_expt.ExptGateConfig {
+	if do == nil {
+		return nil
+	}
+	dto := &domain_expt.ExptGateConfig{Rules: make([]*domain_expt.ExptGateRule, 0, len(do.Rules))}
+	for _, rule := range do.Rules {
+		if rule == nil {
+			continue
+		}
+		dto.Rules = append(dto.Rules, exptGateRuleDO2DTO(rule))
+	}
+	return dto
+}
+
+func exptGateRuleDO2DTO(do *entity.ExptGateRule) *domain_expt.ExptGateRule {
+	dto := &domain_expt.ExptGateRule{
+		RuleType:  gptr.Of(domain_expt.ExptGateRuleType(do.RuleType)),
+		Threshold: gptr.Of(do.Threshold),
+	}
+	if do.Name != "" {
+		dto.Name = gptr.Of(do.Name)
+	}
+	if do.EvaluatorVersionID > 0 {
+		dto.EvaluatorVersionID = gptr.Of(do.EvaluatorVersionID)
+	}
+	if do.Operator != "" {
+		dto.Operator = gptr.Of(domain_expt.ExptGateOperator(do.Operator))
+	}
+	if do.BaselineExptID > 0 {
+		dto.BaselineExperimentID = gptr.Of(do.BaselineExptID)
+	}
+	if len(do.EvaluatorVersionIDs) > 0 {
+		dto.EvaluatorVersionIds = do.EvaluatorVersionIDs
+	}
+	if do.ConfidenceLevel > 0 {
+		dto.ConfidenceLevel = gptr.Of(do.ConfidenceLevel)
+	}
+	return dto
+}
+
+func ExptGateVerdictDO2DTO(do *entity.ExptGateVerdict) *domain_expt.ExptGateVerdict {
+	if do == nil {
+		return nil
+	}
+	dto := &domain_expt.ExptGateVerdict{
+		Status:       gptr.Of(domain_expt.ExptGateStatus(do.Status)),
+		RuleVerdicts: make([]*domain_expt.ExptGateRuleVerdict, 0, len(do.RuleVerdicts)),
+		EvaluatedAt:  gptr.Of(do.EvaluatedAt),
+	}
+	for _, rv := range do.RuleVerdicts {
+		if rv == nil {
+			continue
+		}
+		ruleVerdict := &domain_expt.ExptGateRuleVerdict{
+			Status:      gptr.Of(domain_expt.ExptGateStatus(rv.Status)),
+			ActualValue: rv.ActualValue,
+			Message:     gptr.Of(rv.Message),
+		}
+		if rv.Rule != nil {
+			ruleVerdict.Rule = exptGateRuleDO2DTO(rv.Rule)
+		}
+		dto.RuleVerdicts = append(dto.RuleVerdicts, ruleVerdict)
+	}
+	return dto
+}
+
+var (
+	openAPIGateRuleType2Domain = map[openapiExperiment.ExperimentGateRuleType]domain_expt.ExptGateRuleType{
+		openapiExperiment.ExperimentGateRuleTypeEvaluatorScore: domain_expt.ExptGateRuleTypeEvaluatorScore,
+		openapiExperiment.ExperimentGateRuleTypeFailedTurnRate: domain_expt.ExptGateRuleTypeFailedTurnRate,
+		openapiExperiment.ExperimentGateRuleTypeNoRegression:   domain_expt.ExptGateRuleTypeNoRegression,
+	}
+	gateRuleTypeDO2OpenAPI = map[entity.ExptGateRuleType]openapiExperiment.ExperimentGateRuleType{
+		entity.ExptGateRuleType_EvaluatorScore: openapiExperiment.ExperimentGateRuleTypeEvaluatorScore,
+		entity.ExptGateRuleType_FailedTurnRate: openapiExperiment.ExperimentGateRuleTypeFailedTurnRate,
+		entity.ExptGateRuleType_NoRegression:   openapiExperiment.ExperimentGateRuleTypeNoRegression,
+	}
+	gateStatusDO2OpenAPI = map[entity.ExptGateStatus]openapiExperiment.ExperimentGateStatus{
+		entity.ExptGateStatus_Pass:  openapiExperiment.ExperimentGateStatusPass,
+		entity.ExptGateStatus_Fail:  openapiExperiment.ExperimentGateStatusFail,
+		entity.ExptGateStatus_Error: openapiExperiment.ExperimentGateStatusError,
+	}
+)
+
+// OpenAPIExptGateConfDTO2Domain 转换 OpenAPI 门禁配置，评估器按 evaluator_id + version 映射为 evaluator_version_id
+func OpenAPIExptGateConfDTO2Domain(dto *openapiExperiment.ExperimentGateConfig, evaluatorMap map[string]int64) *domain_expt.ExptGateConfig {
+	if dto == nil {
+		return nil
+	}
+	conf := &domain_expt.ExptGateConfig{Rules: make([]*domain_expt.ExptGateRule, 0, len(dto.GetRules()))}
+	for _, rule := range dto.GetRules() {
+		if rule == nil {
+			continue
+		}
+		ruleType, ok := openAPIGateRuleType2Domain[rule.GetRuleType()]
+		if !ok {
+			// 保留原值，由门禁配置校验返回错误
+			ruleType = rule.GetRuleType()
+		}
+		domainRule := &domain_expt.ExptGateRule{
+			RuleType:        gptr.Of(ruleType),
+			Name:            rule.Name,
+			Operator:        rule.Operator,
+			Threshold:       rule.Threshold,
+			ConfidenceLevel: rule.ConfidenceLevel,
+		}
+		if rule.IsSetEvaluatorID() {
+			domainRule.EvaluatorVersionID = gptr.Of(evaluatorMap[fmt.Sprintf("%d_%s", rule.GetEvaluatorID(), rule.GetVersion())])
+		}
+		if rule.IsSetBaselineExperimentID() {
+			domainRule.BaselineExperimentID = rule.BaselineExperimentID
+		}
+		conf.Rules = append(conf.Rules, domainRule)
+	}
+	return conf
+}
+
+func OpenAPIExptGateConfDO2DTO(do *entity.ExptGateConf, evaluators []*entity.Evaluator) *openapiExperiment.ExperimentGateConfig {
+	if do.IsEmpty() {
+		return nil
+	}
+	evaluatorMap := openAPIGateEvaluatorMap(evaluators)
+	dto := &openapiExperiment.ExperimentGateConfig{Rules: make([]*openapiExperiment.ExperimentGateRule, 0, len(do.Rules))}
+	for _, rule := range do.Rules {
+		if rule == nil {
+			continue
+		}
+		dto.Rules = append(dto.Rules, openAPIExptGateRuleDO2DTO(rule, evaluatorMap))
+	}
+	return dto
+}
+
+func OpenAPIExptGateVerdictDO2DTO(do *entity.ExptGateVerdict, evaluators []*entity.Evaluator) *openapiExperiment.ExperimentGateVerdict {
+	if do == nil {
+		return nil
+	}
+	evaluatorMap := openAPIGateEvaluatorMap(evaluators)
+	dto := &openapiExperiment.ExperimentGateVerdict{
+		Status:       gptr.Of(gateStatusDO2OpenAPI[do.Status]),
+		RuleVerdicts: make([]*openapiExperiment.ExperimentGateRuleVerdict, 0, len(do.RuleVerdicts)),
+		EvaluatedAt:  gptr.Of(do.EvaluatedAt),
+	}
+	for _, rv := range do.RuleVerdicts {
+		if rv == nil {
+			continue
+		}
+		ruleVerdict := &openapiExperiment.ExperimentGateRuleVerdict{
+			Status:      gptr.Of(gateStatusDO2OpenAPI[rv.Status]),
+			ActualValue: rv.ActualValue,
+			Message:     gptr.Of(rv.Message),
+		}
+		if rv.Rule != nil {
+			ruleVerdict.Rule = openAPIExptGateRuleDO2DTO(rv.Rule, evaluatorMap)
+		}
+		dto.RuleVerdicts = append(dto.RuleVerdicts, ruleVerdict)
+	}
+	return dto
+}
+
+func openAPIGateEvaluatorMap(evaluators []*entity.Evaluator) map[int64]*entity.Evaluator {
+	evaluatorMap := make(map[int64]*entity.Evaluator, len(evaluators))
+	for _, e := range evaluators {
+		if e == nil {
+			continue
+		}
+		evaluatorMap[e.GetEvaluatorVersionID()] = e
+	}
+	return evaluatorMap
+}
+
+func openAPIExptGateRuleDO2DTO(do *entity.ExptGateRule, evaluatorMap map[int64]*entity.Evaluator) *openapiExperiment.ExperimentGateRule {
+	dto := &openapiExperiment.ExperimentGateRule{
+		RuleType:  gptr.Of(gateRuleTypeDO2OpenAPI[do.RuleType]),
+		Threshold: gptr.Of(do.Threshold),
+	}
+	if do.Name != "" {
+		dto.Name = gptr.Of(do.Name)
+	}
+	if evaluator, ok := evaluatorMap[do.EvaluatorVersionID]; ok && do.EvaluatorVersionID > 0 {
+		dto.EvaluatorID = gptr.Of(evaluator.ID)
+		dto.Version = gptr.Of(evaluator.GetVersion())
+	}
+	if do.Operator != "" {
+		dto.Operator = gptr.Of(openapiExperiment.ExperimentGateOperator(do.Operator))
+	}
+	if do.BaselineExptID > 0 {
+		dto.BaselineExperimentID = gptr.Of(do.BaselineExptID)
+	}
+	if do.ConfidenceLevel > 0 {
+		dto.ConfidenceLevel = gptr.Of(do.ConfidenceLevel)
+	}
+	return dto
+}
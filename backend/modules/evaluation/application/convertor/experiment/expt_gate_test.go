@@ -0,0 +1,117 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package experiment
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain_expt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/expt"
+	openapiExperiment "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain_openapi/experiment"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+func TestExptGateConf_DTO2DO2DTO(t *testing.T) {
+	assert.Nil(t, ExptGateConfDTO2DO(nil))
+	assert.Nil(t, ExptGateConfDO2DTO(nil))
+
+	dto := &domain_expt.ExptGateConfig{Rules: []*domain_expt.ExptGateRule{
+		{
+			RuleType:           gptr.Of(domain_expt.ExptGateRuleTypeEvaluatorScore),
+			Name:               gptr.Of("accuracy"),
+			EvaluatorVersionID: gptr.Of(int64(10)),
+			Operator:           gptr.Of(domain_expt.ExptGateOperatorGT),
+			Threshold:          gptr.Of(0.8),
+		},
+		nil,
+		{
+			RuleType:             gptr.Of(domain_expt.ExptGateRuleTypeNoRegression),
+			Threshold:            gptr.Of(float64(0)),
+			BaselineExperimentID: gptr.Of(int64(1)),
+			EvaluatorVersionIds:  []int64{10, 11},
+			ConfidenceLevel:      gptr.Of(0.9),
+		},
+	}}
+	do := ExptGateConfDTO2DO(dto)
+	require.Len(t, do.Rules, 2)
+	assert.Equal(t, &entity.ExptGateRule{
+		RuleType:           entity.ExptGateRuleType_EvaluatorScore,
+		Name:               "accuracy",
+		EvaluatorVersionID: 10,
+		Operator:           entity.ExptGateOperator_GT,
+		Threshold:          0.8,
+	}, do.Rules[0])
+	assert.NoError(t, do.Valid())
+
+	got := ExptGateConfDO2DTO(do)
+	assert.Equal(t, []*domain_expt.ExptGateRule{dto.Rules[0], dto.Rules[2]}, got.Rules)
+}
+
+func TestOpenAPIExptGate(t *testing.T) {
+	evaluators := []*entity.Evaluator{{
+		ID:                     5,
+		EvaluatorType:          entity.EvaluatorTypePrompt,
+		PromptEvaluatorVersion: &entity.PromptEvaluatorVersion{ID: 10, Version: "v1"},
+	}}
+
+	t.Run("dto to domain", func(t *testing.T) {
+		assert.Nil(t, OpenAPIExptGateConfDTO2Domain(nil, nil))
+
+		conf := OpenAPIExptGateConfDTO2Domain(&openapiExperiment.ExperimentGateConfig{Rules: []*openapiExperiment.ExperimentGateRule{
+			{
+				RuleType:    gptr.Of(openapiExperiment.ExperimentGateRuleTypeEvaluatorScore),
+				EvaluatorID: gptr.Of(int64(5)),
+				Version:     gptr.Of("v1"),
+				Threshold:   gptr.Of(0.8),
+			},
+			{
+				RuleType:    gptr.Of(openapiExperiment.ExperimentGateRuleTypeEvaluatorScore),
+				EvaluatorID: gptr.Of(int64(6)),
+				Version:     gptr.Of("v1"),
+				Threshold:   gptr.Of(0.8),
+			},
+			{
+				RuleType:             gptr.Of(openapiExperiment.ExperimentGateRuleTypeNoRegression),
+				BaselineExperimentID: gptr.Of(int64(1)),
+			},
+		}}, map[string]int64{"5_v1": 10})
+		require.Len(t, conf.Rules, 3)
+		assert.Equal(t, domain_expt.ExptGateRuleTypeEvaluatorScore, conf.Rules[0].GetRuleType())
+		assert.Equal(t, int64(10), conf.Rules[0].GetEvaluatorVersionID())
+		// 未知评估器映射为 0，由门禁配置校验拦截
+		assert.Equal(t, int64(0), conf.Rules[1].GetEvaluatorVersionID())
+		assert.Error(t, ExptGateConfDTO2DO(conf).Valid())
+		assert.Equal(t, domain_expt.ExptGateRuleTypeNoRegression, conf.Rules[2].GetRuleType())
+		assert.Equal(t, int64(1), conf.Rules[2].GetBaselineExperimentID())
+	})
+
+	t.Run("domain to dto", func(t *testing.T) {
+		assert.Nil(t, OpenAPIExptGateConfDO2DTO(nil, evaluators))
+		assert.Nil(t, OpenAPIExptGateVerdictDO2DTO(nil, evaluators))
+
+		rule := &entity.ExptGateRule{RuleType: entity.ExptGateRuleType_EvaluatorScore, EvaluatorVersionID: 10, Threshold: 0.8}
+		conf := OpenAPIExptGateConfDO2DTO(&entity.ExptGateConf{Rules: []*entity.ExptGateRule{rule}}, evaluators)
+		require.Len(t, conf.Rules, 1)
+		assert.Equal(t, openapiExperiment.ExperimentGateRuleTypeEvaluatorScore, conf.Rules[0].GetRuleType())
+		assert.Equal(t, int64(5), conf.Rules[0].GetEvaluatorID())
+		assert.Equal(t, "v1", conf.Rules[0].GetVersion())
+
+		verdict := OpenAPIExptGateVerdictDO2DTO(&entity.ExptGateVerdict{
+			Status:      entity.ExptGateStatus_Fail,
+			EvaluatedAt: 1700000000000,
+			RuleVerdicts: []*entity.ExptGateRuleVerdict{
+				{Rule: rule, Status: entity.ExptGateStatus_Fail, ActualValue: gptr.Of(0.6), Message: "msg"},
+			},
+		}, evaluators)
+		assert.Equal(t, openapiExperiment.ExperimentGateStatusFail, verdict.GetStatus())
+		assert.Equal(t, int64(1700000000000), verdict.GetEvaluatedAt())
+		require.Len(t, verdict.RuleVerdicts, 1)
+		assert.Equal(t, openapiExperiment.ExperimentGateStatusFail, verdict.RuleVerdicts[0].GetStatus())
+		assert.Equal(t, 0.6, verdict.RuleVerdicts[0].GetActualValue())
+		assert.Equal(t, int64(5), verdict.RuleVerdicts[0].GetRule().GetEvaluatorID())
+	})
+}
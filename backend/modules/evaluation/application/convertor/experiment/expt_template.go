@@ -39,10 +39,74 @@ func ConvertCreateExptTemplateReq(req *expt.CreateExperimentTemplateRequest) (*e
 	applyScoreWeightsToEvaluatorConfs(evaluatorScoreWeights, evaluatorConfs)
 
 	param.TemplateConf = buildTemplateConfForCreate(param, req, targetFieldMapping, evaluatorConfs, itemConcurNum)
+	param.Schedule = ExptTemplateScheduleDTO2DO(req.GetScheduleConfig(), req.ScheduleCron)
+	param.GateConf = ExptGateConfDTO2DO(req.GetGateConfig())
 
 	return param, nil
 }
 
+// ExptTemplateScheduleDTO2DO 转换调度配置，未设置 schedule_config 时兼容仅传 schedule_cron 的旧请求
+func ExptTemplateScheduleDTO2DO(dto *domain_expt.ExptTemplateSchedule, scheduleCron *string) *entity.ExptTemplateSchedule {
+	if dto == nil {
+		if scheduleCron == nil {
+			return nil
+		}
+		return &entity.ExptTemplateSchedule{
+			Enabled: gptr.Indirect(scheduleCron) != "",
+			Cron:    gptr.Indirect(scheduleCron),
+		}
+	}
+	return &entity.ExptTemplateSchedule{
+		Enabled:               dto.GetEnabled(),
+		Cron:                  dto.GetCron(),
+		Timezone:              dto.GetTimezone(),
+		TriggerOnTargetChange: dto.GetTriggerOnTargetChange(),
+		PromptLabel:           dto.GetPromptLabel(),
+		RetentionCount:        int(dto.GetRetentionCount()),
+	}
+}
+
+// ExptTemplateScheduleDO2DTO 转换调度配置为DTO
+func ExptTemplateScheduleDO2DTO(do *entity.ExptTemplateSchedule) *domain_expt.ExptTemplateSchedule {
+	if do == nil {
+		return nil
+	}
+	return &domain_expt.ExptTemplateSchedule{
+		Enabled:               gptr.Of(do.Enabled),
+		Cron:                  gptr.Of(do.Cron),
+		Timezone:              gptr.Of(do.Timezone),
+		TriggerOnTargetChange: gptr.Of(do.TriggerOnTargetChange),
+		PromptLabel:           gptr.Of(do.PromptLabel),
+		RetentionCount:        gptr.Of(int32(do.RetentionCount)),
+	}
+}
+
+func fillExptInfoScheduleDTO(state *entity.ExptScheduleState, dto *domain_expt.ExptInfo) {
+	if state == nil {
+		return
+	}
+	if state.LastTargetVersion != "" {
+		dto.LastTargetVersion = gptr.Of(state.LastTargetVersion)
+	}
+	if len(state.History) == 0 {
+		return
+	}
+	dto.ScheduleHistory = make([]*domain_expt.ExptScheduleRecord, 0, len(state.History))
+	for _, record := range state.History {
+		if record == nil {
+			continue
+		}
+		dto.ScheduleHistory = append(dto.ScheduleHistory, &domain_expt.ExptScheduleRecord{
+			TriggerType:   gptr.Of(domain_expt.ExptScheduleTriggerType(record.TriggerType)),
+			FireAt:        gptr.Of(record.FireAt),
+			TargetVersion: gptr.Of(record.TargetVersion),
+			ExptID:        gptr.Of(record.ExptID),
+			Error:         gptr.Of(record.Error),
+			Purged:        gptr.Of(record.Purged),
+		})
+	}
+}
+
 // 拆分的子函数：填充创建模板场景下的 Meta
 func fillCreateTemplateMeta(param *entity.CreateExptTemplateParam, req *expt.CreateExperimentTemplateRequest) {
 	if req.GetMeta() == nil {
@@ -372,6 +436,8 @@ func ToExptTemplateDTO(template *entity.ExptTemplate) *domain_expt.ExptTemplate
 	dto.TripleConfig = buildTemplateTripleConfigDTO(template)
 	dto.FieldMappingConfig = buildTemplateFieldMappingDTO(template)
 	dto.ScoreWeightConfig = buildTemplateScoreWeightConfigDTO(template)
+	dto.ScheduleConfig = ExptTemplateScheduleDO2DTO(template.GetSchedule())
+	dto.GateConfig = ExptGateConfDO2DTO(template.GetGateConf())
 
 	// 填充关联数据（EvalSet、EvalTarget、Evaluators）到 TripleConfig
 	if dto.TripleConfig != nil {
@@ -407,12 +473,14 @@ func ToExptTemplateDTO(template *entity.ExptTemplate) *domain_expt.ExptTemplate
 	}
 
 	// 填充 ExptInfo
-	if template.ExptInfo != nil {
-		exptInfo := &domain_expt.ExptInfo{
-			CreatedExptCount: gptr.Of(template.ExptInfo.CreatedExptCount),
-			LatestExptID:     gptr.Of(template.ExptInfo.LatestExptID),
-			LatestExptStatus: gptr.Of(domain_expt.ExptStatus(template.ExptInfo.LatestExptStatus)),
+	if template.ExptInfo != nil || template.ScheduleState != nil {
+		exptInfo := &domain_expt.ExptInfo{}
+		if template.ExptInfo != nil {
+			exptInfo.CreatedExptCount = gptr.Of(template.ExptInfo.CreatedExptCount)
+			exptInfo.LatestExptID = gptr.Of(template.ExptInfo.LatestExptID)
+			exptInfo.LatestExptStatus = gptr.Of(domain_expt.ExptStatus(template.ExptInfo.LatestExptStatus))
 		}
+		fillExptInfoScheduleDTO(template.ScheduleState, exptInfo)
 		dto.SetExptInfo(exptInfo)
 	}
 
@@ -785,6 +853,7 @@ func TemplateToSubmitExperimentRequest(template *entity.ExptTemplate, name strin
 		WorkspaceID:    workspaceID,
 		Name:           gptr.Of(name),
 		ExptTemplateID: gptr.Of(template.Meta.ID),
+		GateConfig:     ExptGateConfDO2DTO(template.GetGateConf()),
 	}
 
 	if template.TripleConfig == nil {
@@ -978,6 +1047,7 @@ func CreateEvalTargetParamDTO2DOForTemplate(param *eval_target.CreateEvalTargetP
 			Ext:       param.CustomEvalTarget.Ext,
 		}
 	}
+	res.OpenAIChat = target.OpenAIChatDTO2DO(param.OpenaiChat)
 	return res
 }
 
@@ -1091,6 +1161,8 @@ func ConvertUpdateExptTemplateReq(req *expt.UpdateExperimentTemplateRequest) (*e
 
 		param.TemplateConf = templateConf
 	}
+	param.Schedule = ExptTemplateScheduleDTO2DO(req.GetScheduleConfig(), req.ScheduleCron)
+	param.GateConf = ExptGateConfDTO2DO(req.GetGateConfig())
 
 	return param, nil
 }
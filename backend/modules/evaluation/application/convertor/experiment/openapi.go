@@ -432,6 +432,9 @@ func OpenAPIExptDO2DTO(experiment *entity.Experiment) *openapiExperiment.Experim
 		result.EvalSet = evalsetopenapi.OpenAPIEvaluationSetDO2DTO(experiment.EvalSet)
 	}
 
+	result.GateConfig = OpenAPIExptGateConfDO2DTO(experiment.GetGateConf(), experiment.Evaluators)
+	result.GateVerdict = OpenAPIExptGateVerdictDO2DTO(experiment.GateVerdict, experiment.Evaluators)
+
 	return result
 }
 
@@ -1008,7 +1011,8 @@ func openAPIEvaluatorResultDO2DTO(result *entity.EvaluatorResult) *openapiEvalua
 			res.Reasoning = gptr.Of(result.Reasoning)
 		}
 	}
-	if res.Score == nil && res.Reasoning == nil {
+	res.Dimensions = evaluator_convertor.OpenAPIEvaluatorDimensionResultsDO2DTO(result.Dimensions)
+	if res.Score == nil && res.Reasoning == nil && len(res.Dimensions) == 0 {
 		return nil
 	}
 	return res
@@ -1088,6 +1092,26 @@ func OpenAPIAggregatorResultsDO2DTOs(results []*entity.AggregatorResult) []*open
 	return converted
 }
 
+func OpenAPIEvaluatorDimensionResultsDO2DTOs(results []*entity.EvaluatorDimensionAggregateResult) []*openapiExperiment.EvaluatorDimensionAggregateResult_ {
+	if len(results) == 0 {
+		return nil
+	}
+	converted := make([]*openapiExperiment.EvaluatorDimensionAggregateResult_, 0, len(results))
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		converted = append(converted, &openapiExperiment.EvaluatorDimensionAggregateResult_{
+			Name:              gptr.Of(result.Name),
+			AggregatorResults: OpenAPIAggregatorResultsDO2DTOs(result.AggregatorResults),
+		})
+	}
+	if len(converted) == 0 {
+		return nil
+	}
+	return converted
+}
+
 func openAPIAggregatorTypeDO2DTO(typ entity.AggregatorType) *openapiExperiment.AggregatorType {
 	var openapiType openapiExperiment.AggregatorType
 	switch typ {
@@ -1173,6 +1197,7 @@ func TargetAggrResultDO2DTO(result *entity.EvalTargetMtrAggrResult) *domainExpt.
 		InputTokens:     AggregatorResultDOsToDTOs(result.InputTokensAggrResults),
 		OutputTokens:    AggregatorResultDOsToDTOs(result.OutputTokensAggrResults),
 		TotalTokens:     AggregatorResultDOsToDTOs(result.TotalTokensAggrResults),
+		TotalCost:       AggregatorResultDOsToDTOs(result.TotalCostAggrResults),
 	}
 }
 
@@ -71,6 +71,7 @@ func EvalTargetVersionDTO2DO(targetVersionDTO *dto.EvalTargetVersion) (targetVer
 			}
 		}
 		targetVersionDO.CustomRPCServer = CustomRPCServerDTO2DO(targetVersionDTO.GetEvalTargetContent().GetCustomRPCServer())
+		targetVersionDO.OpenAIChat = OpenAIChatDTO2DO(targetVersionDTO.GetEvalTargetContent().GetOpenaiChat())
 		targetVersionDO.RuntimeParamDemo = gptr.Of(targetVersionDTO.GetEvalTargetContent().GetRuntimeParamJSONDemo())
 	}
 
@@ -197,6 +198,14 @@ func EvalTargetVersionDO2DTO(targetVersionDO *do.EvalTargetVersion) (targetVersi
 		if targetVersionDO.CustomRPCServer != nil {
 			targetVersionDTO.EvalTargetContent.CustomRPCServer = CustomRPCServerDO2DTO(targetVersionDO.CustomRPCServer)
 		}
+	case do.EvalTargetTypeOpenAIChat:
+		targetVersionDTO.EvalTargetContent = &dto.EvalTargetContent{
+			InputSchemas:  make([]*commondto.ArgsSchema, 0),
+			OutputSchemas: make([]*commondto.ArgsSchema, 0),
+		}
+		if targetVersionDO.OpenAIChat != nil {
+			targetVersionDTO.EvalTargetContent.OpenaiChat = OpenAIChatDO2DTO(targetVersionDO.OpenAIChat)
+		}
 	default:
 		targetVersionDTO.EvalTargetContent = &dto.EvalTargetContent{
 			InputSchemas:  make([]*commondto.ArgsSchema, 0),
@@ -234,6 +243,8 @@ func CustomRPCServerDO2DTO(do *do.CustomRPCServer) (dtoRes *dto.CustomRPCServer)
 		Timeout:             do.Timeout,
 		AsyncTimeout:        do.AsyncTimeout,
 		Ext:                 do.Ext,
+		// Headers可能包含鉴权信息，不对外返回
+		Endpoint: gptr.Of(do.Endpoint),
 	}
 }
 
@@ -260,6 +271,8 @@ func CustomRPCServerDTO2DO(dto *dto.CustomRPCServer) (doRes *do.CustomRPCServer)
 		Timeout:             dto.Timeout,
 		AsyncTimeout:        dto.AsyncTimeout,
 		Ext:                 dto.Ext,
+		Endpoint:            gptr.Indirect(dto.Endpoint),
+		Headers:             dto.Headers,
 	}
 }
 
@@ -320,3 +333,51 @@ func CustomEvalTargetDO2DTOs(customEvalTargetDOs []*do.CustomEvalTarget) (custom
 	}
 	return customEvalTargetDTOs
 }
+
+func OpenAIChatDO2DTO(do *do.OpenAIChat) *dto.OpenAIChat {
+	if do == nil {
+		return nil
+	}
+	templates := make([]*dto.OpenAIChatMessageTemplate, 0, len(do.MessageTemplates))
+	for _, tpl := range do.MessageTemplates {
+		if tpl == nil {
+			continue
+		}
+		templates = append(templates, &dto.OpenAIChatMessageTemplate{
+			Role:    gptr.Of(tpl.Role),
+			Content: gptr.Of(tpl.Content),
+		})
+	}
+	// Headers可能包含鉴权信息，不对外返回
+	return &dto.OpenAIChat{
+		BaseURL:          gptr.Of(do.BaseURL),
+		Model:            gptr.Of(do.Model),
+		MessageTemplates: templates,
+		Stream:           gptr.Of(do.Stream),
+		Timeout:          do.Timeout,
+	}
+}
+
+func OpenAIChatDTO2DO(dto *dto.OpenAIChat) *do.OpenAIChat {
+	if dto == nil {
+		return nil
+	}
+	templates := make([]*do.OpenAIChatMessageTemplate, 0, len(dto.MessageTemplates))
+	for _, tpl := range dto.MessageTemplates {
+		if tpl == nil {
+			continue
+		}
+		templates = append(templates, &do.OpenAIChatMessageTemplate{
+			Role:    tpl.GetRole(),
+			Content: tpl.GetContent(),
+		})
+	}
+	return &do.OpenAIChat{
+		BaseURL:          dto.GetBaseURL(),
+		Model:            dto.GetModel(),
+		Headers:          dto.Headers,
+		MessageTemplates: templates,
+		Stream:           dto.GetStream(),
+		Timeout:          dto.Timeout,
+	}
+}
@@ -232,6 +232,7 @@ func UsageDO2DTO(src *entity.EvalTargetUsage) *eval_target.EvalTargetUsage {
 		InputTokens:  src.InputTokens,
 		OutputTokens: src.OutputTokens,
 		TotalTokens:  src.TotalTokens,
+		TotalCost:    src.TotalCost,
 	}
 }
 
@@ -253,6 +254,7 @@ func UsageDTO2DO(src *eval_target.EvalTargetUsage) *entity.EvalTargetUsage {
 		InputTokens:  src.InputTokens,
 		OutputTokens: src.OutputTokens,
 		TotalTokens:  src.TotalTokens,
+		TotalCost:    src.TotalCost,
 	}
 }
 
@@ -886,6 +886,7 @@ func (e *EvalOpenAPIApplication) SubmitExperimentOApi(ctx context.Context, req *
 		TargetRuntimeParam:     experiment_convertor.OpenAPIRuntimeParamDTO2Domain(req.TargetRuntimeParam),
 		CreateEvalTargetParam:  experiment_convertor.OpenAPICreateEvalTargetParamDTO2Domain(req.EvalTargetParam),
 		EvaluatorIDVersionList: experiment_convertor.OpenAPIEvaluatorParamsDTO2Domain(req.EvaluatorParams),
+		GateConfig:             experiment_convertor.OpenAPIExptGateConfDTO2Domain(req.GateConfig, evaluatorMap),
 	}
 
 	cresp, err := e.experimentApp.SubmitExperiment(ctx, createReq)
@@ -1000,6 +1001,7 @@ func (e *EvalOpenAPIApplication) GetExperimentAggrResultOApi(ctx context.Context
 			Name:               v.Name,
 			Version:            v.Version,
 			AggregatorResults:  experiment_convertor.OpenAPIAggregatorResultsDO2DTOs(v.AggregatorResults),
+			DimensionResults:   experiment_convertor.OpenAPIEvaluatorDimensionResultsDO2DTOs(v.DimensionResults),
 		})
 	}
 	return &openapi.GetExperimentAggrResultOApiResponse{
@@ -2346,6 +2346,10 @@ func (f *fakeExperimentApp) SubmitExperiment(ctx context.Context, req *exptpb.Su
 	return &exptpb.SubmitExperimentResponse{}, nil
 }
 
+func (f *fakeExperimentApp) RunExptTemplateScheduleTask(ctx context.Context) error {
+	return nil
+}
+
 var _ IExperimentApplication = (*fakeExperimentApp)(nil)
 
 func newSuccessInvokeResultReq(workspaceID, invokeID int64) *openapi.ReportEvalTargetInvokeResultRequest {
@@ -96,6 +96,12 @@ func (e EvalTargetApplicationImpl) CreateEvalTarget(ctx context.Context, request
 			Ext:       request.GetParam().GetCustomEvalTarget().Ext,
 		}))
 	}
+	if request.GetParam().CustomRPCServer != nil {
+		opts = append(opts, entity.WithCustomRPCServer(target.CustomRPCServerDTO2DO(request.GetParam().GetCustomRPCServer())))
+	}
+	if request.GetParam().OpenaiChat != nil {
+		opts = append(opts, entity.WithOpenAIChat(target.OpenAIChatDTO2DO(request.GetParam().GetOpenaiChat())))
+	}
 	id, versionID, err := e.evalTargetService.CreateEvalTarget(ctx, request.WorkspaceID, request.Param.GetSourceTargetID(), request.Param.GetSourceTargetVersion(),
 		entity.EvalTargetType(request.Param.GetEvalTargetType()), opts...)
 	if err != nil {
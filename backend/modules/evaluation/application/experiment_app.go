@@ -13,6 +13,7 @@ import (
 
 	"github.com/coze-dev/coze-loop/backend/infra/backoff"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/infra/lock"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/base"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
@@ -46,6 +47,8 @@ type IExperimentApplication interface {
 	service.ExptAggrResultService
 	service.IExptResultExportService
 	service.IExptInsightAnalysisService
+	// RunExptTemplateScheduleTask 启动实验模板调度任务
+	RunExptTemplateScheduleTask(ctx context.Context) error
 }
 
 type experimentApplication struct {
@@ -72,6 +75,9 @@ type experimentApplication struct {
 
 	// 实验模板管理服务
 	templateManager service.IExptTemplateManager
+	// 实验模板调度
+	templateScheduleService service.IExptTemplateScheduleService
+	locker                  lock.ILocker
 }
 
 func NewExperimentApplication(
@@ -92,6 +98,8 @@ func NewExperimentApplication(
 	exptInsightAnalysisService service.IExptInsightAnalysisService,
 	evaluatorService service.EvaluatorService,
 	templateManager service.IExptTemplateManager,
+	templateScheduleService service.IExptTemplateScheduleService,
+	locker lock.ILocker,
 ) IExperimentApplication {
 	return &experimentApplication{
 		resultSvc:                   resultSvc,
@@ -111,6 +119,8 @@ func NewExperimentApplication(
 		IExptInsightAnalysisService: exptInsightAnalysisService,
 		evaluatorService:            evaluatorService,
 		templateManager:             templateManager,
+		templateScheduleService:     templateScheduleService,
+		locker:                      locker,
 	}
 }
 
@@ -462,6 +472,7 @@ func (e *experimentApplication) SubmitExperiment(ctx context.Context, req *expt.
 		EnableWeightedScore:    req.EnableWeightedScore,
 		// EvaluatorScoreWeights 会在 CreateExperiment 的 resolveEvaluatorVersionIDsFromCreateReq 中解析
 		ItemRetryNum: req.ItemRetryNum,
+		GateConfig:   req.GateConfig,
 	}
 	if req.IsSetExptTemplateID() {
 		createReq.ExptTemplateID = gptr.Of(req.GetExptTemplateID())
@@ -1198,6 +1209,33 @@ func (e *experimentApplication) BatchGetExperimentAggrResult_(ctx context.Contex
 	}, nil
 }
 
+func (e *experimentApplication) CompareExperimentSignificance(ctx context.Context, req *expt.CompareExperimentSignificanceRequest) (r *expt.CompareExperimentSignificanceResponse, err error) {
+	err = e.auth.Authorization(ctx, &rpc.AuthorizationParam{
+		ObjectID:      strconv.FormatInt(req.GetWorkspaceID(), 10),
+		SpaceID:       req.GetWorkspaceID(),
+		ActionObjects: []*rpc.ActionObject{{Action: gptr.Of(consts.ActionReadExpt), EntityType: gptr.Of(rpc.AuthEntityType_Space)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := e.CompareExptAggrResults(ctx, &entity.ExptComparisonParam{
+		SpaceID:             req.GetWorkspaceID(),
+		BaseExptID:          req.GetBaseExperimentID(),
+		CompareExptIDs:      req.GetCompareExperimentIds(),
+		EvaluatorVersionIDs: req.GetEvaluatorVersionIds(),
+		ConfidenceLevel:     req.GetConfidenceLevel(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &expt.CompareExperimentSignificanceResponse{
+		ComparisonResults: experiment.ExptComparisonResultsDOToDTOs(results),
+		BaseResp:          base.NewBaseResp(),
+	}, nil
+}
+
 func (e *experimentApplication) mPackUserInfo(ctx context.Context, expts []*domain_expt.Experiment) ([]*domain_expt.Experiment, error) {
 	if len(expts) == 0 {
 		return expts, nil
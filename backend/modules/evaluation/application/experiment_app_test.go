@@ -2538,6 +2538,8 @@ func TestExperimentApplication_RetryExperiment(t *testing.T) {
 				nil,
 				nil, // evaluatorService
 				nil, // templateManager
+				nil, // templateScheduleService
+				nil, // locker
 			)
 
 			// 执行测试
@@ -2785,6 +2787,8 @@ func TestExperimentApplication_KillExperiment(t *testing.T) {
 				nil,
 				nil, // evaluatorService
 				nil, // templateManager
+				nil, // templateScheduleService
+				nil, // locker
 			)
 
 			// 设置 context 中的 UserID，这样 entity.NewSession 才能获取到 UserID
@@ -2886,6 +2890,8 @@ func TestExperimentApplication_CreateExperimentTemplate(t *testing.T) {
 		nil,                 // exptInsightAnalysisService
 		nil,                 // evaluatorService
 		mockTemplateManager, // templateManager
+		nil,                 // templateScheduleService
+		nil,                 // locker
 	)
 
 	resp, err := app.CreateExperimentTemplate(context.Background(), req)
@@ -2986,6 +2992,8 @@ func TestExperimentApplication_BatchGetExperimentTemplate(t *testing.T) {
 				nil,                 // exptInsightAnalysisService
 				nil,                 // evaluatorService
 				mockTemplateManager, // templateManager
+				nil,                 // templateScheduleService
+				nil,                 // locker
 			)
 			resp, err := app.BatchGetExperimentTemplate(context.Background(), tt.req)
 			if tt.wantErr {
@@ -3028,6 +3036,8 @@ func TestExperimentApplication_UpdateExperimentTemplate(t *testing.T) {
 			nil,                 // exptInsightAnalysisService
 			nil,                 // evaluatorService
 			mockTemplateManager, // templateManager
+			nil,                 // templateScheduleService
+			nil,                 // locker
 		)
 		_, err := app.UpdateExperimentTemplate(context.Background(), &exptpb.UpdateExperimentTemplateRequest{})
 		assert.Error(t, err)
@@ -3096,6 +3106,8 @@ func TestExperimentApplication_UpdateExperimentTemplate(t *testing.T) {
 			nil,                 // exptInsightAnalysisService
 			nil,                 // evaluatorService
 			mockTemplateManager, // templateManager
+			nil,                 // templateScheduleService
+			nil,                 // locker
 		)
 		resp, err := app.UpdateExperimentTemplate(context.Background(), req)
 		assert.NoError(t, err)
@@ -3132,6 +3144,8 @@ func TestExperimentApplication_UpdateExperimentTemplateMeta(t *testing.T) {
 			nil,                 // exptInsightAnalysisService
 			nil,                 // evaluatorService
 			mockTemplateManager, // templateManager
+			nil,                 // templateScheduleService
+			nil,                 // locker
 		)
 		_, err := app.UpdateExperimentTemplateMeta(context.Background(), &exptpb.UpdateExperimentTemplateMetaRequest{})
 		assert.Error(t, err)
@@ -3197,6 +3211,8 @@ func TestExperimentApplication_UpdateExperimentTemplateMeta(t *testing.T) {
 			nil,                 // exptInsightAnalysisService
 			nil,                 // evaluatorService
 			mockTemplateManager, // templateManager
+			nil,                 // templateScheduleService
+			nil,                 // locker
 		)
 		resp, err := app.UpdateExperimentTemplateMeta(context.Background(), req)
 		assert.NoError(t, err)
@@ -3245,6 +3261,8 @@ func TestExperimentApplication_DeleteExperimentTemplate(t *testing.T) {
 		nil,                 // exptInsightAnalysisService
 		nil,                 // evaluatorService
 		mockTemplateManager, // templateManager
+		nil,                 // templateScheduleService
+		nil,                 // locker
 	)
 	resp, err := app.DeleteExperimentTemplate(context.Background(), req)
 	assert.NoError(t, err)
@@ -3315,6 +3333,8 @@ func TestExperimentApplication_ListExperimentTemplates(t *testing.T) {
 		nil,                 // exptInsightAnalysisService
 		nil,                 // evaluatorService
 		mockTemplateManager, // templateManager
+		nil,                 // templateScheduleService
+		nil,                 // locker
 	)
 	resp, err := app.ListExperimentTemplates(context.Background(), req)
 	assert.NoError(t, err)
@@ -3357,6 +3377,8 @@ func TestExperimentApplication_ListExperimentTemplates_FilterOptionAndDefaultSor
 		app := NewExperimentApplication(
 			nil, nil, nil, nil, nil, nil, nil,
 			mockAuth, mockUserInfo, mockEvalTargetSvc, nil, nil, nil, nil, nil, nil, mockTemplateManager,
+			nil, // templateScheduleService
+			nil, // locker
 		)
 		_, err := app.ListExperimentTemplates(context.Background(), req)
 		assert.NoError(t, err)
@@ -3384,6 +3406,8 @@ func TestExperimentApplication_ListExperimentTemplates_FilterOptionAndDefaultSor
 		app := NewExperimentApplication(
 			nil, nil, nil, nil, nil, nil, nil,
 			mockAuth, mockUserInfo, mockEvalTargetSvc, nil, nil, nil, nil, nil, nil, mockTemplateManager,
+			nil, // templateScheduleService
+			nil, // locker
 		)
 		_, err := app.ListExperimentTemplates(context.Background(), req)
 		assert.NoError(t, err)
@@ -3413,6 +3437,8 @@ func TestExperimentApplication_ListExperimentTemplates_FilterOptionAndDefaultSor
 		app := NewExperimentApplication(
 			nil, nil, nil, nil, nil, nil, nil,
 			mockAuth, mockUserInfo, mockEvalTargetSvc, nil, nil, nil, nil, nil, nil, mockTemplateManager,
+			nil, // templateScheduleService
+			nil, // locker
 		)
 		_, err := app.ListExperimentTemplates(context.Background(), req)
 		assert.NoError(t, err)
@@ -3445,6 +3471,8 @@ func TestExperimentApplication_ListExperimentTemplates_FilterOptionAndDefaultSor
 		app := NewExperimentApplication(
 			nil, nil, nil, nil, nil, nil, nil,
 			mockAuth, mockUserInfo, mockEvalTargetSvc, nil, nil, nil, nil, nil, nil, mockTemplateManager,
+			nil, // templateScheduleService
+			nil, // locker
 		)
 		// 这个测试主要验证 FilterOption 不为 nil 时会调用 Convert
 		// 具体的转换逻辑在 filter convertor 的测试中覆盖
@@ -0,0 +1,213 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	domaincommon "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/application/convertor/experiment"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	exptTemplateScheduleInterval  = time.Minute
+	exptTemplateScheduleBatchSize = 200
+	// exptTemplateScheduleMaxCatchUp cron 最大补跑窗口，停机超过该时长错过的触发不再补跑
+	exptTemplateScheduleMaxCatchUp = 24 * time.Hour
+	// exptTemplateScheduleLockTTL 调度锁不主动释放，有效期覆盖补跑窗口，过期前同一触发点不会被重复提交
+	exptTemplateScheduleLockTTL = exptTemplateScheduleMaxCatchUp + exptTemplateScheduleInterval
+)
+
+// RunExptTemplateScheduleTask 启动实验模板调度任务，每分钟扫描开启调度的模板，按 cron 或 Prompt 版本变化提交实验
+func (e *experimentApplication) RunExptTemplateScheduleTask(ctx context.Context) error {
+	goroutine.Go(ctx, func() {
+		ticker := time.NewTicker(exptTemplateScheduleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				e.runExptTemplateSchedule(ctx, now)
+			}
+		}
+	})
+	return nil
+}
+
+func (e *experimentApplication) runExptTemplateSchedule(ctx context.Context, now time.Time) {
+	var cursor int64
+	for {
+		templates, nextCursor, err := e.templateScheduleService.ListScheduled(ctx, cursor, exptTemplateScheduleBatchSize)
+		if err != nil {
+			logs.CtxError(ctx, "[ExptTemplateSchedule] list scheduled templates fail, cursor: %v, err: %v", cursor, err)
+			return
+		}
+		for _, template := range templates {
+			e.scheduleExptTemplate(ctx, template, now)
+		}
+		if nextCursor == 0 {
+			return
+		}
+		cursor = nextCursor
+	}
+}
+
+func (e *experimentApplication) scheduleExptTemplate(ctx context.Context, template *entity.ExptTemplate, now time.Time) {
+	defer goroutine.Recovery(ctx)
+
+	schedule := template.GetSchedule()
+	state := template.GetScheduleState()
+	if state == nil {
+		state = &entity.ExptScheduleState{}
+	}
+	ctx = session.WithCtxUser(ctx, &session.User{ID: template.GetCreatedBy()})
+
+	var (
+		promptID      int64
+		targetVersion string
+	)
+	if schedule.TriggerOnTargetChange {
+		var err error
+		// 解析失败时 cron 仍按模板固定版本运行，本轮跳过版本变化触发
+		if promptID, targetVersion, err = e.templateScheduleService.ResolveTrackedPromptVersion(ctx, template); err != nil {
+			logs.CtxWarn(ctx, "[ExptTemplateSchedule] resolve tracked prompt version fail, template_id: %v, err: %v", template.GetID(), err)
+		}
+	}
+
+	if schedule.Cron != "" {
+		after := now.Add(-exptTemplateScheduleInterval)
+		if state.LastCronFireAt > 0 {
+			after = time.UnixMilli(state.LastCronFireAt)
+		}
+		if earliest := now.Add(-exptTemplateScheduleMaxCatchUp); after.Before(earliest) {
+			after = earliest
+		}
+		if fireAt, ok := schedule.LatestCronFireTime(after, now); ok {
+			lockKey := fmt.Sprintf("expt_template_schedule:%d:cron:%d", template.GetID(), fireAt.Unix())
+			e.submitScheduledExpt(ctx, template, lockKey, entity.ExptScheduleTriggerTypeCron, fireAt, promptID, targetVersion)
+			// cron 运行使用最新跟踪版本，本轮无需再按版本变化触发
+			return
+		}
+	}
+
+	if targetVersion == "" || targetVersion == state.LastTargetVersion {
+		return
+	}
+	lockKey := fmt.Sprintf("expt_template_schedule:%d:target:%s", template.GetID(), targetVersion)
+	if state.LastTargetVersion == "" {
+		// 首次观察到的版本只作为基线，不触发运行
+		if locked, err := e.locker.Lock(ctx, lockKey, exptTemplateScheduleLockTTL); err != nil || !locked {
+			return
+		}
+		if _, err := e.templateScheduleService.RecordRun(ctx, template.GetID(), template.GetSpaceID(), &entity.ExptScheduleRecord{
+			TriggerType:   entity.ExptScheduleTriggerTypeTargetBaseline,
+			FireAt:        now.UnixMilli(),
+			TargetVersion: targetVersion,
+		}); err != nil {
+			logs.CtxError(ctx, "[ExptTemplateSchedule] record target baseline fail, template_id: %v, err: %v", template.GetID(), err)
+		}
+		return
+	}
+	e.submitScheduledExpt(ctx, template, lockKey, entity.ExptScheduleTriggerTypeTargetChange, now, promptID, targetVersion)
+}
+
+// submitScheduledExpt 抢占触发点的锁并推进调度游标后提交实验，最后写入调度记录。未抢到锁说明其他实例已处理该触发点；
+// 游标先于提交推进，写调度记录失败时也不会再次触发同一触发点
+func (e *experimentApplication) submitScheduledExpt(ctx context.Context, template *entity.ExptTemplate, lockKey string,
+	triggerType entity.ExptScheduleTriggerType, fireAt time.Time, promptID int64, targetVersion string,
+) {
+	locked, err := e.locker.Lock(ctx, lockKey, exptTemplateScheduleLockTTL)
+	if err != nil {
+		logs.CtxError(ctx, "[ExptTemplateSchedule] lock fail, key: %v, err: %v", lockKey, err)
+		return
+	}
+	if !locked {
+		return
+	}
+
+	record := &entity.ExptScheduleRecord{
+		TriggerType:   triggerType,
+		FireAt:        fireAt.UnixMilli(),
+		TargetVersion: targetVersion,
+	}
+	if err := e.templateScheduleService.AdvanceSchedule(ctx, template.GetID(), template.GetSpaceID(), record); err != nil {
+		logs.CtxError(ctx, "[ExptTemplateSchedule] advance schedule fail, template_id: %v, trigger: %v, err: %v", template.GetID(), triggerType, err)
+		return
+	}
+	exptID, err := e.submitExptFromTemplate(ctx, template, fireAt, promptID, targetVersion)
+	if err != nil {
+		logs.CtxError(ctx, "[ExptTemplateSchedule] submit experiment fail, template_id: %v, trigger: %v, err: %v", template.GetID(), triggerType, err)
+		record.Error = err.Error()
+	}
+	record.ExptID = exptID
+	logs.CtxInfo(ctx, "[ExptTemplateSchedule] template scheduled, template_id: %v, trigger: %v, expt_id: %v", template.GetID(), triggerType, exptID)
+
+	expiredExptIDs, err := e.templateScheduleService.RecordRun(ctx, template.GetID(), template.GetSpaceID(), record)
+	if err != nil {
+		logs.CtxError(ctx, "[ExptTemplateSchedule] record schedule run fail, template_id: %v, err: %v", template.GetID(), err)
+		return
+	}
+	if len(expiredExptIDs) > 0 {
+		if err := e.manager.MDelete(ctx, expiredExptIDs, template.GetSpaceID(), entity.NewSession(ctx)); err != nil {
+			logs.CtxError(ctx, "[ExptTemplateSchedule] delete expired experiments fail, template_id: %v, expt_ids: %v, err: %v", template.GetID(), expiredExptIDs, err)
+		}
+	}
+}
+
+func (e *experimentApplication) submitExptFromTemplate(ctx context.Context, template *entity.ExptTemplate, fireAt time.Time, promptID int64, targetVersion string) (int64, error) {
+	spaceID := template.GetSpaceID()
+	sess := entity.NewSession(ctx)
+	full, err := e.templateManager.Get(ctx, template.GetID(), spaceID, sess)
+	if err != nil {
+		return 0, err
+	}
+	if full == nil {
+		return 0, errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg("experiment template not found"))
+	}
+
+	name := fmt.Sprintf("%s_%s", full.GetName(), fireAt.Format("20060102150405"))
+	pass, err := e.manager.CheckName(ctx, name, spaceID, sess)
+	if err != nil {
+		return 0, err
+	}
+	if !pass {
+		return 0, errorx.NewByCode(errno.ExperimentNameExistedCode, errorx.WithExtraMsg(fmt.Sprintf("experiment name %s already exists", name)))
+	}
+
+	submitReq := experiment.OpenAPITemplateToSubmitExperimentRequest(full, name, spaceID)
+	if submitReq == nil {
+		return 0, errorx.NewByCode(errno.CommonInternalErrorCode, errorx.WithExtraMsg("failed to build submit request from template"))
+	}
+	// 跟踪 Prompt 版本时使用解析出的版本作为评测对象
+	if targetVersion != "" {
+		targetID, targetVersionID, err := e.evalTargetService.CreateEvalTarget(ctx, spaceID, strconv.FormatInt(promptID, 10), targetVersion, entity.EvalTargetTypeLoopPrompt)
+		if err != nil {
+			return 0, err
+		}
+		submitReq.TargetID = gptr.Of(targetID)
+		submitReq.TargetVersionID = gptr.Of(targetVersionID)
+	}
+	submitReq.Session = &domaincommon.Session{}
+	if userID, err := strconv.ParseInt(sess.UserID, 10, 64); err == nil {
+		submitReq.Session.UserID = gptr.Of(userID)
+	}
+
+	resp, err := e.SubmitExperiment(ctx, submitReq)
+	if err != nil {
+		return 0, err
+	}
+	return gptr.Indirect(resp.GetExperiment().ID), nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	lockmocks "github.com/coze-dev/coze-loop/backend/infra/lock/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	svcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func newScheduleTestTemplate(schedule *entity.ExptTemplateSchedule, state *entity.ExptScheduleState) *entity.ExptTemplate {
+	return &entity.ExptTemplate{
+		Meta:          &entity.ExptTemplateMeta{ID: 1, WorkspaceID: 100, Name: "tpl"},
+		TripleConfig:  &entity.ExptTemplateTuple{TargetID: 10, TargetType: entity.EvalTargetTypeLoopPrompt},
+		TemplateConf:  &entity.ExptTemplateConfiguration{Schedule: schedule},
+		ScheduleState: state,
+		BaseInfo:      &entity.BaseInfo{CreatedBy: &entity.UserInfo{UserID: gptr.Of("u1")}},
+	}
+}
+
+func TestExperimentApplication_scheduleExptTemplate(t *testing.T) {
+	now := time.Date(2025, 3, 14, 10, 30, 20, 0, time.UTC)
+	cronSchedule := &entity.ExptTemplateSchedule{Enabled: true, Cron: "30 * * * *", Timezone: "UTC"}
+	trackSchedule := &entity.ExptTemplateSchedule{Enabled: true, TriggerOnTargetChange: true}
+
+	tests := []struct {
+		name     string
+		template *entity.ExptTemplate
+		setup    func(scheduleSvc *svcmocks.MockIExptTemplateScheduleService, locker *lockmocks.MockILocker, templateMgr *svcmocks.MockIExptTemplateManager)
+	}{
+		{
+			name:     "cron fired but lock held by other instance",
+			template: newScheduleTestTemplate(cronSchedule, nil),
+			setup: func(scheduleSvc *svcmocks.MockIExptTemplateScheduleService, locker *lockmocks.MockILocker, templateMgr *svcmocks.MockIExptTemplateManager) {
+				locker.EXPECT().Lock(gomock.Any(), "expt_template_schedule:1:cron:1741948200", exptTemplateScheduleLockTTL).Return(false, nil)
+			},
+		},
+		{
+			name:     "cron already handled",
+			template: newScheduleTestTemplate(cronSchedule, &entity.ExptScheduleState{LastCronFireAt: time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC).UnixMilli()}),
+			setup: func(scheduleSvc *svcmocks.MockIExptTemplateScheduleService, locker *lockmocks.MockILocker, templateMgr *svcmocks.MockIExptTemplateManager) {
+			},
+		},
+		{
+			name:     "submit failure is recorded",
+			template: newScheduleTestTemplate(cronSchedule, nil),
+			setup: func(scheduleSvc *svcmocks.MockIExptTemplateScheduleService, locker *lockmocks.MockILocker, templateMgr *svcmocks.MockIExptTemplateManager) {
+				locker.EXPECT().Lock(gomock.Any(), gomock.Any(), exptTemplateScheduleLockTTL).Return(true, nil)
+				scheduleSvc.EXPECT().AdvanceSchedule(gomock.Any(), int64(1), int64(100), gomock.Any()).Return(nil)
+				templateMgr.EXPECT().Get(gomock.Any(), int64(1), int64(100), gomock.Any()).Return(nil, errors.New("db err"))
+				scheduleSvc.EXPECT().RecordRun(gomock.Any(), int64(1), int64(100), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ int64, record *entity.ExptScheduleRecord) ([]int64, error) {
+						assert.Equal(t, entity.ExptScheduleTriggerTypeCron, record.TriggerType)
+						assert.Equal(t, int64(0), record.ExptID)
+						assert.NotEmpty(t, record.Error)
+						return nil, nil
+					})
+			},
+		},
+		{
+			name:     "advance failure skips submit",
+			template: newScheduleTestTemplate(cronSchedule, nil),
+			setup: func(scheduleSvc *svcmocks.MockIExptTemplateScheduleService, locker *lockmocks.MockILocker, templateMgr *svcmocks.MockIExptTemplateManager) {
+				locker.EXPECT().Lock(gomock.Any(), gomock.Any(), exptTemplateScheduleLockTTL).Return(true, nil)
+				scheduleSvc.EXPECT().AdvanceSchedule(gomock.Any(), int64(1), int64(100), &entity.ExptScheduleRecord{
+					TriggerType: entity.ExptScheduleTriggerTypeCron,
+					FireAt:      time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC).UnixMilli(),
+				}).Return(errors.New("db err"))
+			},
+		},
+		{
+			name:     "first observed version is baseline",
+			template: newScheduleTestTemplate(trackSchedule, nil),
+			setup: func(scheduleSvc *svcmocks.MockIExptTemplateScheduleService, locker *lockmocks.MockILocker, templateMgr *svcmocks.MockIExptTemplateManager) {
+				scheduleSvc.EXPECT().ResolveTrackedPromptVersion(gomock.Any(), gomock.Any()).Return(int64(77), "0.0.1", nil)
+				locker.EXPECT().Lock(gomock.Any(), "expt_template_schedule:1:target:0.0.1", exptTemplateScheduleLockTTL).Return(true, nil)
+				scheduleSvc.EXPECT().RecordRun(gomock.Any(), int64(1), int64(100), &entity.ExptScheduleRecord{
+					TriggerType:   entity.ExptScheduleTriggerTypeTargetBaseline,
+					FireAt:        now.UnixMilli(),
+					TargetVersion: "0.0.1",
+				}).Return(nil, nil)
+			},
+		},
+		{
+			name:     "version unchanged",
+			template: newScheduleTestTemplate(trackSchedule, &entity.ExptScheduleState{LastTargetVersion: "0.0.1"}),
+			setup: func(scheduleSvc *svcmocks.MockIExptTemplateScheduleService, locker *lockmocks.MockILocker, templateMgr *svcmocks.MockIExptTemplateManager) {
+				scheduleSvc.EXPECT().ResolveTrackedPromptVersion(gomock.Any(), gomock.Any()).Return(int64(77), "0.0.1", nil)
+			},
+		},
+		{
+			name:     "resolve failure skips target trigger",
+			template: newScheduleTestTemplate(trackSchedule, &entity.ExptScheduleState{LastTargetVersion: "0.0.1"}),
+			setup: func(scheduleSvc *svcmocks.MockIExptTemplateScheduleService, locker *lockmocks.MockILocker, templateMgr *svcmocks.MockIExptTemplateManager) {
+				scheduleSvc.EXPECT().ResolveTrackedPromptVersion(gomock.Any(), gomock.Any()).Return(int64(0), "", errors.New("rpc err"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			scheduleSvc := svcmocks.NewMockIExptTemplateScheduleService(ctrl)
+			locker := lockmocks.NewMockILocker(ctrl)
+			templateMgr := svcmocks.NewMockIExptTemplateManager(ctrl)
+			tt.setup(scheduleSvc, locker, templateMgr)
+
+			app := &experimentApplication{
+				templateScheduleService: scheduleSvc,
+				locker:                  locker,
+				templateManager:         templateMgr,
+			}
+			app.scheduleExptTemplate(context.Background(), tt.template, now)
+		})
+	}
+}
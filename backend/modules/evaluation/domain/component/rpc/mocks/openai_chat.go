@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc (interfaces: IOpenAIChatAdapter)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/openai_chat.go -package=mocks . IOpenAIChatAdapter
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	rpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOpenAIChatAdapter is a mock of IOpenAIChatAdapter interface.
+type MockIOpenAIChatAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOpenAIChatAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockIOpenAIChatAdapterMockRecorder is the mock recorder for MockIOpenAIChatAdapter.
+type MockIOpenAIChatAdapterMockRecorder struct {
+	mock *MockIOpenAIChatAdapter
+}
+
+// NewMockIOpenAIChatAdapter creates a new mock instance.
+func NewMockIOpenAIChatAdapter(ctrl *gomock.Controller) *MockIOpenAIChatAdapter {
+	mock := &MockIOpenAIChatAdapter{ctrl: ctrl}
+	mock.recorder = &MockIOpenAIChatAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOpenAIChatAdapter) EXPECT() *MockIOpenAIChatAdapterMockRecorder {
+	return m.recorder
+}
+
+// ChatCompletion mocks base method.
+func (m *MockIOpenAIChatAdapter) ChatCompletion(ctx context.Context, param *rpc.OpenAIChatParam) (*rpc.OpenAIChatResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChatCompletion", ctx, param)
+	ret0, _ := ret[0].(*rpc.OpenAIChatResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChatCompletion indicates an expected call of ChatCompletion.
+func (mr *MockIOpenAIChatAdapterMockRecorder) ChatCompletion(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChatCompletion", reflect.TypeOf((*MockIOpenAIChatAdapter)(nil).ChatCompletion), ctx, param)
+}
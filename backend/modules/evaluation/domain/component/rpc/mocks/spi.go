@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc (interfaces: IEvalTargetSPIAdapter,IEvaluatorSPIAdapter)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/spi.go -package=mocks . IEvalTargetSPIAdapter,IEvaluatorSPIAdapter
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	rpc "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	entity "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIEvalTargetSPIAdapter is a mock of IEvalTargetSPIAdapter interface.
+type MockIEvalTargetSPIAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEvalTargetSPIAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockIEvalTargetSPIAdapterMockRecorder is the mock recorder for MockIEvalTargetSPIAdapter.
+type MockIEvalTargetSPIAdapterMockRecorder struct {
+	mock *MockIEvalTargetSPIAdapter
+}
+
+// NewMockIEvalTargetSPIAdapter creates a new mock instance.
+func NewMockIEvalTargetSPIAdapter(ctrl *gomock.Controller) *MockIEvalTargetSPIAdapter {
+	mock := &MockIEvalTargetSPIAdapter{ctrl: ctrl}
+	mock.recorder = &MockIEvalTargetSPIAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEvalTargetSPIAdapter) EXPECT() *MockIEvalTargetSPIAdapterMockRecorder {
+	return m.recorder
+}
+
+// AsyncInvokeEvalTarget mocks base method.
+func (m *MockIEvalTargetSPIAdapter) AsyncInvokeEvalTarget(ctx context.Context, param *rpc.InvokeEvalTargetParam, invokeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AsyncInvokeEvalTarget", ctx, param, invokeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AsyncInvokeEvalTarget indicates an expected call of AsyncInvokeEvalTarget.
+func (mr *MockIEvalTargetSPIAdapterMockRecorder) AsyncInvokeEvalTarget(ctx, param, invokeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AsyncInvokeEvalTarget", reflect.TypeOf((*MockIEvalTargetSPIAdapter)(nil).AsyncInvokeEvalTarget), ctx, param, invokeID)
+}
+
+// InvokeEvalTarget mocks base method.
+func (m *MockIEvalTargetSPIAdapter) InvokeEvalTarget(ctx context.Context, param *rpc.InvokeEvalTargetParam) (*entity.EvalTargetOutputData, entity.EvalTargetRunStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvokeEvalTarget", ctx, param)
+	ret0, _ := ret[0].(*entity.EvalTargetOutputData)
+	ret1, _ := ret[1].(entity.EvalTargetRunStatus)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InvokeEvalTarget indicates an expected call of InvokeEvalTarget.
+func (mr *MockIEvalTargetSPIAdapterMockRecorder) InvokeEvalTarget(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeEvalTarget", reflect.TypeOf((*MockIEvalTargetSPIAdapter)(nil).InvokeEvalTarget), ctx, param)
+}
+
+// SearchEvalTarget mocks base method.
+func (m *MockIEvalTargetSPIAdapter) SearchEvalTarget(ctx context.Context, param *rpc.SearchEvalTargetParam) ([]*entity.CustomEvalTarget, string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEvalTarget", ctx, param)
+	ret0, _ := ret[0].([]*entity.CustomEvalTarget)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// SearchEvalTarget indicates an expected call of SearchEvalTarget.
+func (mr *MockIEvalTargetSPIAdapterMockRecorder) SearchEvalTarget(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvalTarget", reflect.TypeOf((*MockIEvalTargetSPIAdapter)(nil).SearchEvalTarget), ctx, param)
+}
+
+// MockIEvaluatorSPIAdapter is a mock of IEvaluatorSPIAdapter interface.
+type MockIEvaluatorSPIAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEvaluatorSPIAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockIEvaluatorSPIAdapterMockRecorder is the mock recorder for MockIEvaluatorSPIAdapter.
+type MockIEvaluatorSPIAdapterMockRecorder struct {
+	mock *MockIEvaluatorSPIAdapter
+}
+
+// NewMockIEvaluatorSPIAdapter creates a new mock instance.
+func NewMockIEvaluatorSPIAdapter(ctrl *gomock.Controller) *MockIEvaluatorSPIAdapter {
+	mock := &MockIEvaluatorSPIAdapter{ctrl: ctrl}
+	mock.recorder = &MockIEvaluatorSPIAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEvaluatorSPIAdapter) EXPECT() *MockIEvaluatorSPIAdapterMockRecorder {
+	return m.recorder
+}
+
+// HealthCheck mocks base method.
+func (m *MockIEvaluatorSPIAdapter) HealthCheck(ctx context.Context, evaluator *entity.CustomRPCEvaluatorVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthCheck", ctx, evaluator)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HealthCheck indicates an expected call of HealthCheck.
+func (mr *MockIEvaluatorSPIAdapterMockRecorder) HealthCheck(ctx, evaluator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockIEvaluatorSPIAdapter)(nil).HealthCheck), ctx, evaluator)
+}
+
+// InvokeEvaluator mocks base method.
+func (m *MockIEvaluatorSPIAdapter) InvokeEvaluator(ctx context.Context, param *rpc.InvokeEvaluatorParam) (*entity.EvaluatorOutputData, entity.EvaluatorRunStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvokeEvaluator", ctx, param)
+	ret0, _ := ret[0].(*entity.EvaluatorOutputData)
+	ret1, _ := ret[1].(entity.EvaluatorRunStatus)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InvokeEvaluator indicates an expected call of InvokeEvaluator.
+func (mr *MockIEvaluatorSPIAdapterMockRecorder) InvokeEvaluator(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeEvaluator", reflect.TypeOf((*MockIEvaluatorSPIAdapter)(nil).InvokeEvaluator), ctx, param)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rpc
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// IOpenAIChatAdapter 调用OpenAI兼容的/chat/completions接口
+//
+//go:generate mockgen -destination=mocks/openai_chat.go -package=mocks . IOpenAIChatAdapter
+type IOpenAIChatAdapter interface {
+	ChatCompletion(ctx context.Context, param *OpenAIChatParam) (*OpenAIChatResult, error)
+}
+
+type OpenAIChatParam struct {
+	Chat     *entity.OpenAIChat
+	Messages []*entity.OpenAIChatMessage
+}
+
+type OpenAIChatResult struct {
+	Content          string
+	ReasoningContent string
+	ToolCalls        []*OpenAIChatToolCall
+	FinishReason     string
+	InputTokens      int64
+	OutputTokens     int64
+	// 流式调用时收到首个内容分片的耗时，单位ms，非流式调用为空
+	FirstTokenMS *int64
+}
+
+type OpenAIChatToolCall struct {
+	ID        string
+	Name      string
+	Arguments string
+}
@@ -94,6 +94,8 @@ type CommitInfo struct {
 	Description *string
 	CommittedBy *string
 	CommittedAt *int64
+	// Labels 指向该版本的标签
+	Labels []string
 }
 
 type PublishStatus int64
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rpc
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+// IEvalTargetSPIAdapter 按EvaluationSPIService协议调用用户注册的自定义评测对象服务
+//
+//go:generate mockgen -destination=mocks/spi.go -package=mocks . IEvalTargetSPIAdapter,IEvaluatorSPIAdapter
+type IEvalTargetSPIAdapter interface {
+	SearchEvalTarget(ctx context.Context, param *SearchEvalTargetParam) (targets []*entity.CustomEvalTarget, nextCursor string, hasMore bool, err error)
+	// InvokeEvalTarget 同步执行，服务返回失败时status为EvalTargetRunStatusFail且outputData中带有错误信息
+	InvokeEvalTarget(ctx context.Context, param *InvokeEvalTargetParam) (outputData *entity.EvalTargetOutputData, status entity.EvalTargetRunStatus, err error)
+	// AsyncInvokeEvalTarget 异步执行，服务需通过ReportEvalTargetInvokeResult回传invokeID对应的结果
+	AsyncInvokeEvalTarget(ctx context.Context, param *InvokeEvalTargetParam, invokeID int64) error
+}
+
+// IEvaluatorSPIAdapter 按EvaluationSPIService协议调用access_protocol为http的自定义评估器
+type IEvaluatorSPIAdapter interface {
+	// InvokeEvaluator 执行评估，调用失败时按MaxRetries重试；服务返回失败时status为EvaluatorRunStatusFail且outputData中带有错误信息
+	InvokeEvaluator(ctx context.Context, param *InvokeEvaluatorParam) (outputData *entity.EvaluatorOutputData, status entity.EvaluatorRunStatus, err error)
+	// HealthCheck 探测评估器的健康检查接口，未配置HealthCheckHTTPInfo时直接返回
+	HealthCheck(ctx context.Context, evaluator *entity.CustomRPCEvaluatorVersion) error
+}
+
+type SearchEvalTargetParam struct {
+	SpaceID   int64
+	Server    *entity.CustomRPCServer
+	Keyword   *string
+	Region    *entity.Region
+	PageSize  *int32
+	PageToken *string
+}
+
+type InvokeEvalTargetParam struct {
+	SpaceID int64
+	Server  *entity.CustomRPCServer
+	Input   *entity.EvalTargetInputData
+}
+
+type InvokeEvaluatorParam struct {
+	SpaceID   int64
+	Evaluator *entity.CustomRPCEvaluatorVersion
+	Input     *entity.EvaluatorInputData
+}
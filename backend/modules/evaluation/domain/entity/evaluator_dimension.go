@@ -0,0 +1,133 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EvaluatorDimensionResult 评估器单个维度的评估结果
+type EvaluatorDimensionResult struct {
+	Name      string   `json:"name"`
+	Score     *float64 `json:"score,omitempty"`
+	Label     string   `json:"label,omitempty"`
+	Reasoning string   `json:"reasoning,omitempty"`
+}
+
+// EvaluatorDimensionAggregateResult 评估器单个维度的聚合结果
+type EvaluatorDimensionAggregateResult struct {
+	Name              string
+	AggregatorResults []*AggregatorResult
+}
+
+// EvaluatorDimensionKey 维度聚合结果的唯一标识
+type EvaluatorDimensionKey struct {
+	EvaluatorVersionID int64
+	Name               string
+}
+
+const evaluatorDimensionFieldKeySep = ":"
+
+// FieldKey 维度聚合结果在 expt_aggr_result 表中的 field_key，格式为 {evaluator_version_id}:{dimension_name}
+func (k EvaluatorDimensionKey) FieldKey() string {
+	return strconv.FormatInt(k.EvaluatorVersionID, 10) + evaluatorDimensionFieldKeySep + k.Name
+}
+
+func ParseEvaluatorDimensionFieldKey(fieldKey string) (EvaluatorDimensionKey, error) {
+	idStr, name, found := strings.Cut(fieldKey, evaluatorDimensionFieldKeySep)
+	if !found || name == "" {
+		return EvaluatorDimensionKey{}, fmt.Errorf("invalid evaluator dimension field key %q", fieldKey)
+	}
+	evaluatorVersionID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return EvaluatorDimensionKey{}, fmt.Errorf("invalid evaluator dimension field key %q: %w", fieldKey, err)
+	}
+	return EvaluatorDimensionKey{EvaluatorVersionID: evaluatorVersionID, Name: name}, nil
+}
+
+// ParseEvaluatorDimensions 从评估器输出中解析 dimensions 字段，支持两种格式：
+//
+//	列表: [{"name": "accuracy", "score": 0.9, "label": "good", "reason": "..."}]
+//	字典: {"accuracy": 0.9, "tone": {"score": 1, "label": "polite", "reason": "..."}}
+//
+// 字典格式按维度名排序，无法识别的元素会被忽略。
+func ParseEvaluatorDimensions(raw any) []*EvaluatorDimensionResult {
+	var dimensions []*EvaluatorDimensionResult
+	switch v := raw.(type) {
+	case []any:
+		for _, item := range v {
+			obj, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			name, _ := obj["name"].(string)
+			if dim := parseEvaluatorDimension(name, obj); dim != nil {
+				dimensions = append(dimensions, dim)
+			}
+		}
+	case map[string]any:
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			var dim *EvaluatorDimensionResult
+			if obj, ok := v[name].(map[string]any); ok {
+				dim = parseEvaluatorDimension(name, obj)
+			} else if score, ok := parseDimensionScore(v[name]); ok {
+				dim = &EvaluatorDimensionResult{Name: name, Score: &score}
+			}
+			if dim != nil {
+				dimensions = append(dimensions, dim)
+			}
+		}
+	}
+	return dimensions
+}
+
+func parseEvaluatorDimension(name string, obj map[string]any) *EvaluatorDimensionResult {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil
+	}
+	dim := &EvaluatorDimensionResult{Name: name}
+	if score, ok := parseDimensionScore(obj["score"]); ok {
+		dim.Score = &score
+	}
+	if label, ok := obj["label"].(string); ok {
+		dim.Label = label
+	}
+	if reason, ok := obj["reason"].(string); ok {
+		dim.Reasoning = reason
+	} else if reason, ok := obj["reasoning"].(string); ok {
+		dim.Reasoning = reason
+	}
+	if dim.Score == nil && dim.Label == "" {
+		return nil
+	}
+	return dim
+}
+
+func parseDimensionScore(v any) (float64, bool) {
+	switch s := v.(type) {
+	case float64:
+		return s, true
+	case int:
+		return float64(s), true
+	case int64:
+		return float64(s), true
+	case interface{ Float64() (float64, error) }:
+		f, err := s.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEvaluatorDimensions(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []*EvaluatorDimensionResult
+	}{
+		{
+			name: "list form",
+			raw:  `[{"name": "accuracy", "score": 0.9, "reason": "correct"}, {"name": "tone", "label": "polite"}, {"score": 1}, "bad"]`,
+			want: []*EvaluatorDimensionResult{
+				{Name: "accuracy", Score: gptr.Of(0.9), Reasoning: "correct"},
+				{Name: "tone", Label: "polite"},
+			},
+		},
+		{
+			name: "map form sorted by name",
+			raw:  `{"tone": {"score": "1", "label": "polite", "reasoning": "fine"}, "accuracy": 0.5, "empty": {}}`,
+			want: []*EvaluatorDimensionResult{
+				{Name: "accuracy", Score: gptr.Of(0.5)},
+				{Name: "tone", Score: gptr.Of(1.0), Label: "polite", Reasoning: "fine"},
+			},
+		},
+		{
+			name: "unsupported",
+			raw:  `"accuracy"`,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var raw any
+			require.NoError(t, json.Unmarshal([]byte(tt.raw), &raw))
+			assert.Equal(t, tt.want, ParseEvaluatorDimensions(raw))
+		})
+	}
+}
+
+func TestEvaluatorDimensionKey_FieldKey(t *testing.T) {
+	key := EvaluatorDimensionKey{EvaluatorVersionID: 123, Name: "a:b"}
+	assert.Equal(t, "123:a:b", key.FieldKey())
+
+	parsed, err := ParseEvaluatorDimensionFieldKey(key.FieldKey())
+	require.NoError(t, err)
+	assert.Equal(t, key, parsed)
+
+	_, err = ParseEvaluatorDimensionFieldKey("123")
+	assert.Error(t, err)
+	_, err = ParseEvaluatorDimensionFieldKey("abc:name")
+	assert.Error(t, err)
+}
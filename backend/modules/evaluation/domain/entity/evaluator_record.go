@@ -47,6 +47,8 @@ type EvaluatorResult struct {
 	Score      *float64    `json:"score,omitempty"`
 	Correction *Correction `json:"correction,omitempty"`
 	Reasoning  string      `json:"reasoning,omitempty"`
+	// Dimensions 多维度评估结果，如 accuracy / tone / safety 各自的得分与标签
+	Dimensions []*EvaluatorDimensionResult `json:"dimensions,omitempty"`
 }
 
 type EvaluatorUsage struct {
@@ -101,3 +103,10 @@ func (e *EvaluatorRecord) GetCorrected() bool {
 	}
 	return e.EvaluatorOutputData.EvaluatorResult.Correction != nil
 }
+
+func (e *EvaluatorRecord) GetDimensions() []*EvaluatorDimensionResult {
+	if e.EvaluatorOutputData == nil || e.EvaluatorOutputData.EvaluatorResult == nil {
+		return nil
+	}
+	return e.EvaluatorOutputData.EvaluatorResult.Dimensions
+}
@@ -5,6 +5,7 @@ package entity
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/bytedance/gg/gptr"
 	"github.com/bytedance/gg/gslice"
@@ -23,6 +24,8 @@ const (
 	EvaluatorAccessProtocolRPCOld      EvaluatorAccessProtocol = "rpc_old"
 	EvaluatorAccessProtocolFaasHTTP    EvaluatorAccessProtocol = "faas_http"
 	EvaluatorAccessProtocolFaasHTTPOld EvaluatorAccessProtocol = "faas_http_old"
+	// EvaluatorAccessProtocolHTTP 按EvaluationSPIService协议通过HTTP直接调用Endpoint
+	EvaluatorAccessProtocolHTTP EvaluatorAccessProtocol = "http"
 )
 
 type EvaluatorHTTPMethod = string
@@ -60,6 +63,12 @@ type CustomRPCEvaluatorVersion struct {
 	Timeout               *int64                  `json:"timeout"`                    // timeout duration in milliseconds(ms)
 	RateLimit             *RateLimit              `json:"rate_limit,omitempty"`
 
+	// http access protocol attributes
+	Endpoint            *string            `json:"endpoint,omitempty"`
+	Headers             map[string]string  `json:"headers,omitempty"`                // request headers, e.g. Authorization
+	HealthCheckHTTPInfo *EvaluatorHTTPInfo `json:"health_check_http_info,omitempty"` // probed on validate if set
+	MaxRetries          *int32             `json:"max_retries,omitempty"`            // max retry times on invoke failure
+
 	// extra fields
 	Ext map[string]string `json:"ext,omitempty"`
 }
@@ -144,9 +153,29 @@ func (do *CustomRPCEvaluatorVersion) ValidateBaseInfo() error {
 	if lo.IsEmpty(do.AccessProtocol) {
 		return errorx.NewByCode(errno.InvalidAccessProtocolCode, errorx.WithExtraMsg("access_protocol is empty"))
 	}
+	if do.AccessProtocol == EvaluatorAccessProtocolHTTP {
+		return do.validateHTTPInfo()
+	}
 	if do.ServiceName == nil || lo.IsEmpty(*do.ServiceName) {
 		return errorx.NewByCode(errno.InvalidServiceNameCode, errorx.WithExtraMsg("service_name is empty"))
 	}
 
 	return nil
 }
+
+func (do *CustomRPCEvaluatorVersion) validateHTTPInfo() error {
+	endpoint := gptr.Indirect(do.Endpoint)
+	if endpoint == "" {
+		return errorx.NewByCode(errno.InvalidEvaluatorConfigurationCode, errorx.WithExtraMsg("endpoint is empty"))
+	}
+	if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errorx.NewByCode(errno.InvalidEvaluatorConfigurationCode, errorx.WithExtraMsg(fmt.Sprintf("invalid endpoint: %s", endpoint)))
+	}
+	if do.InvokeHTTPInfo == nil {
+		return errorx.NewByCode(errno.InvalidEvaluatorConfigurationCode, errorx.WithExtraMsg("invoke_http_info is empty"))
+	}
+	if gptr.Indirect(do.MaxRetries) < 0 {
+		return errorx.NewByCode(errno.InvalidEvaluatorConfigurationCode, errorx.WithExtraMsg("max_retries must not be negative"))
+	}
+	return nil
+}
@@ -317,6 +317,50 @@ func TestCustomRPCEvaluatorVersion_ValidateBaseInfo(t *testing.T) {
 			wantErr:     false,
 			description: "所有字段都有值应该通过验证",
 		},
+		{
+			name: "成功 - http协议无需ServiceName",
+			evaluator: &CustomRPCEvaluatorVersion{
+				AccessProtocol: EvaluatorAccessProtocolHTTP,
+				Endpoint:       gptr.Of("https://example.com/evaluator"),
+				InvokeHTTPInfo: &EvaluatorHTTPInfo{Method: gptr.Of(EvaluatorHTTPMethodPost), Path: gptr.Of("/invoke")},
+				MaxRetries:     gptr.Of(int32(3)),
+			},
+			wantErr:     false,
+			description: "http协议配置endpoint和invoke_http_info即可",
+		},
+		{
+			name: "失败 - http协议endpoint非法",
+			evaluator: &CustomRPCEvaluatorVersion{
+				AccessProtocol: EvaluatorAccessProtocolHTTP,
+				Endpoint:       gptr.Of("example.com/evaluator"),
+				InvokeHTTPInfo: &EvaluatorHTTPInfo{Path: gptr.Of("/invoke")},
+			},
+			wantErr:     true,
+			errCode:     errno.InvalidEvaluatorConfigurationCode,
+			description: "endpoint缺少scheme应该返回错误",
+		},
+		{
+			name: "失败 - http协议缺少invoke_http_info",
+			evaluator: &CustomRPCEvaluatorVersion{
+				AccessProtocol: EvaluatorAccessProtocolHTTP,
+				Endpoint:       gptr.Of("https://example.com/evaluator"),
+			},
+			wantErr:     true,
+			errCode:     errno.InvalidEvaluatorConfigurationCode,
+			description: "缺少invoke_http_info应该返回错误",
+		},
+		{
+			name: "失败 - http协议重试次数为负",
+			evaluator: &CustomRPCEvaluatorVersion{
+				AccessProtocol: EvaluatorAccessProtocolHTTP,
+				Endpoint:       gptr.Of("https://example.com/evaluator"),
+				InvokeHTTPInfo: &EvaluatorHTTPInfo{Path: gptr.Of("/invoke")},
+				MaxRetries:     gptr.Of(int32(-1)),
+			},
+			wantErr:     true,
+			errCode:     errno.InvalidEvaluatorConfigurationCode,
+			description: "max_retries为负应该返回错误",
+		},
 	}
 
 	for _, tt := range tests {
@@ -159,3 +159,14 @@ const (
 	ExportSceneDefault         ExportScene = 0
 	ExportSceneInsightAnalysis ExportScene = 1
 )
+
+// ExptGateVerdictEvent 实验完成后的门禁结论事件，供 CI 等外部系统订阅以阻断发布
+type ExptGateVerdictEvent struct {
+	ExperimentID   int64            `json:"experiment_id"`
+	SpaceID        int64            `json:"space_id"`
+	ExptName       string           `json:"expt_name"`
+	ExptTemplateID int64            `json:"expt_template_id,omitempty"`
+	ExptStatus     ExptStatus       `json:"expt_status"`
+	Passed         bool             `json:"passed"`
+	Verdict        *ExptGateVerdict `json:"verdict"`
+}
@@ -138,6 +138,7 @@ type Experiment struct {
 
 	Stats           *ExptStats
 	AggregateResult *ExptAggregateResult
+	GateVerdict     *ExptGateVerdict // 门禁结论，实验完成时根据 EvalConf.GateConf 计算
 
 	ExptTemplateMeta *ExptTemplateMeta // 关联的实验模板基础信息（仅在查询时按需填充，包含模板 ID）
 }
@@ -159,6 +160,14 @@ func (e *Experiment) ToEvaluatorRefDO() []*ExptEvaluatorRef {
 	return refs
 }
 
+// GetGateConf 获取门禁配置
+func (e *Experiment) GetGateConf() *ExptGateConf {
+	if e == nil || e.EvalConf == nil {
+		return nil
+	}
+	return e.EvalConf.GateConf
+}
+
 func (e *Experiment) AsyncExec() bool {
 	return e.AsyncCallTarget() || e.AsyncCallEvaluators()
 }
@@ -191,6 +200,8 @@ type EvaluationConfiguration struct {
 	ConnectorConf Connector
 	ItemConcurNum *int
 	ItemRetryNum  *int
+	// GateConf 门禁规则，实验完成时计算门禁结论
+	GateConf *ExptGateConf `json:",omitempty"`
 }
 
 type Connector struct {
@@ -355,6 +366,7 @@ type CreateEvalTargetParam struct {
 	CustomEvalTarget    *CustomEvalTarget // 搜索对象返回的信息
 	Region              *Region
 	Env                 *string
+	OpenAIChat          *OpenAIChat // EvalTargetType=OpenAIChat时的接口信息
 }
 
 func (c *CreateEvalTargetParam) IsNull() bool {
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+const (
+	DefaultComparisonConfidenceLevel = 0.95
+	DefaultBootstrapIterations       = 1000
+	MaxCompareExptNum                = 10
+)
+
+type SignificanceTestType string
+
+const (
+	SignificanceTestType_Bootstrap   SignificanceTestType = "Bootstrap"
+	SignificanceTestType_PairedTTest SignificanceTestType = "PairedTTest"
+	SignificanceTestType_Wilcoxon    SignificanceTestType = "Wilcoxon"
+	SignificanceTestType_McNemar     SignificanceTestType = "McNemar"
+)
+
+type ComparisonVerdict string
+
+const (
+	ComparisonVerdict_NoSignificantChange ComparisonVerdict = "NoSignificantChange"
+	ComparisonVerdict_Improvement         ComparisonVerdict = "Improvement"
+	ComparisonVerdict_Regression          ComparisonVerdict = "Regression"
+	ComparisonVerdict_InsufficientData    ComparisonVerdict = "InsufficientData"
+)
+
+type ExptComparisonParam struct {
+	SpaceID             int64
+	BaseExptID          int64
+	CompareExptIDs      []int64
+	EvaluatorVersionIDs []int64 // 为空时对比两个实验共同的全部评估器版本
+	ConfidenceLevel     float64
+}
+
+type SignificanceTestResult struct {
+	TestType    SignificanceTestType
+	Statistic   float64
+	PValue      float64
+	Significant bool
+	// 仅 Bootstrap 检验返回均值差的置信区间
+	CILower *float64
+	CIUpper *float64
+}
+
+// EvaluatorComparisonResult 单个评估器版本在基准实验与对比实验间的配对检验结果
+type EvaluatorComparisonResult struct {
+	EvaluatorVersionID int64
+	PairedCount        int64
+	BaseMean           float64
+	CompareMean        float64
+	MeanDiff           float64 // CompareMean - BaseMean
+	Binary             bool    // 所有得分均为 0/1
+	TestResults        []*SignificanceTestResult
+	Verdict            ComparisonVerdict
+}
+
+type ExptComparisonResult struct {
+	BaseExptID       int64
+	CompareExptID    int64
+	EvaluatorResults []*EvaluatorComparisonResult
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxExptGateRuleNum 单个实验的门禁规则数量上限
+const MaxExptGateRuleNum = 20
+
+type ExptGateRuleType string
+
+const (
+	// ExptGateRuleType_EvaluatorScore 评估器平均分与阈值比较
+	ExptGateRuleType_EvaluatorScore ExptGateRuleType = "EvaluatorScore"
+	// ExptGateRuleType_FailedTurnRate 未成功（失败、终止或未执行完成）的 turn 占比不超过阈值
+	ExptGateRuleType_FailedTurnRate ExptGateRuleType = "FailedTurnRate"
+	// ExptGateRuleType_NoRegression 相对基准实验无显著退化
+	ExptGateRuleType_NoRegression ExptGateRuleType = "NoRegression"
+)
+
+type ExptGateOperator string
+
+const (
+	ExptGateOperator_GTE ExptGateOperator = "gte"
+	ExptGateOperator_GT  ExptGateOperator = "gt"
+	ExptGateOperator_LTE ExptGateOperator = "lte"
+	ExptGateOperator_LT  ExptGateOperator = "lt"
+)
+
+// Compare 判断 actual 与 threshold 是否满足比较关系，空比较符按 gte 处理
+func (o ExptGateOperator) Compare(actual, threshold float64) bool {
+	switch o {
+	case ExptGateOperator_GT:
+		return actual > threshold
+	case ExptGateOperator_LTE:
+		return actual <= threshold
+	case ExptGateOperator_LT:
+		return actual < threshold
+	default:
+		return actual >= threshold
+	}
+}
+
+func (o ExptGateOperator) valid() bool {
+	switch o {
+	case "", ExptGateOperator_GTE, ExptGateOperator_GT, ExptGateOperator_LTE, ExptGateOperator_LT:
+		return true
+	default:
+		return false
+	}
+}
+
+type ExptGateStatus string
+
+const (
+	ExptGateStatus_Pass ExptGateStatus = "Pass"
+	ExptGateStatus_Fail ExptGateStatus = "Fail"
+	// ExptGateStatus_Error 规则无法计算，如无得分数据、基准实验不存在
+	ExptGateStatus_Error ExptGateStatus = "Error"
+)
+
+// ExptGateConf 实验门禁配置，存储在 eval_conf 中，实验完成时逐条规则计算结论
+type ExptGateConf struct {
+	Rules []*ExptGateRule `json:"rules,omitempty"`
+}
+
+type ExptGateRule struct {
+	RuleType ExptGateRuleType `json:"rule_type"`
+	Name     string           `json:"name,omitempty"`
+
+	// EvaluatorScore
+	EvaluatorVersionID int64            `json:"evaluator_version_id,omitempty"`
+	Operator           ExptGateOperator `json:"operator,omitempty"`
+	// Threshold EvaluatorScore 为得分阈值；FailedTurnRate 为未成功 turn 占比上限，取值 [0, 1]
+	Threshold float64 `json:"threshold"`
+
+	// NoRegression
+	BaselineExptID int64 `json:"baseline_expt_id,omitempty"`
+	// EvaluatorVersionIDs 为空时对比两个实验共同的全部评估器版本
+	EvaluatorVersionIDs []int64 `json:"evaluator_version_ids,omitempty"`
+	// ConfidenceLevel 为 0 时使用 DefaultComparisonConfidenceLevel
+	ConfidenceLevel float64 `json:"confidence_level,omitempty"`
+}
+
+// IsEmpty 是否未配置任何门禁规则
+func (c *ExptGateConf) IsEmpty() bool {
+	return c == nil || len(c.Rules) == 0
+}
+
+// Valid 校验门禁配置
+func (c *ExptGateConf) Valid() error {
+	if c == nil {
+		return nil
+	}
+	if len(c.Rules) > MaxExptGateRuleNum {
+		return fmt.Errorf("gate rules must not exceed %d", MaxExptGateRuleNum)
+	}
+	for i, rule := range c.Rules {
+		if err := rule.Valid(); err != nil {
+			return fmt.Errorf("invalid gate rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Valid 校验单条门禁规则
+func (r *ExptGateRule) Valid() error {
+	if r == nil {
+		return fmt.Errorf("nil rule")
+	}
+	switch r.RuleType {
+	case ExptGateRuleType_EvaluatorScore:
+		if r.EvaluatorVersionID <= 0 {
+			return fmt.Errorf("evaluator_version_id is required")
+		}
+		if !r.Operator.valid() {
+			return fmt.Errorf("unknown operator %q", r.Operator)
+		}
+	case ExptGateRuleType_FailedTurnRate:
+		if r.Threshold < 0 || r.Threshold > 1 {
+			return fmt.Errorf("failed turn rate threshold must be in [0, 1]")
+		}
+	case ExptGateRuleType_NoRegression:
+		if r.BaselineExptID <= 0 {
+			return fmt.Errorf("baseline_expt_id is required")
+		}
+		if r.ConfidenceLevel < 0 || r.ConfidenceLevel >= 1 {
+			return fmt.Errorf("confidence level must be in (0, 1)")
+		}
+	default:
+		return fmt.Errorf("unknown rule type %q", r.RuleType)
+	}
+	return nil
+}
+
+type ExptGateRuleVerdict struct {
+	Rule   *ExptGateRule  `json:"rule"`
+	Status ExptGateStatus `json:"status"`
+	// ActualValue EvaluatorScore 为平均分；FailedTurnRate 为未成功 turn 占比；NoRegression 为显著退化的评估器数
+	ActualValue *float64 `json:"actual_value,omitempty"`
+	Message     string   `json:"message,omitempty"`
+}
+
+// ExptGateVerdict 实验门禁结论，实验完成时计算并存储在 experiment.gate_result 中
+type ExptGateVerdict struct {
+	Status       ExptGateStatus         `json:"status"`
+	RuleVerdicts []*ExptGateRuleVerdict `json:"rule_verdicts,omitempty"`
+	// EvaluatedAt 毫秒时间戳
+	EvaluatedAt int64 `json:"evaluated_at"`
+}
+
+// NewExptGateVerdict 汇总规则结论：任一规则 Fail 则为 Fail；无 Fail 且存在 Error 时为 Error；否则为 Pass
+func NewExptGateVerdict(ruleVerdicts []*ExptGateRuleVerdict, evaluatedAt time.Time) *ExptGateVerdict {
+	status := ExptGateStatus_Pass
+	for _, rv := range ruleVerdicts {
+		switch rv.Status {
+		case ExptGateStatus_Fail:
+			status = ExptGateStatus_Fail
+		case ExptGateStatus_Error:
+			if status == ExptGateStatus_Pass {
+				status = ExptGateStatus_Error
+			}
+		default:
+		}
+	}
+	return &ExptGateVerdict{
+		Status:       status,
+		RuleVerdicts: ruleVerdicts,
+		EvaluatedAt:  evaluatedAt.UnixMilli(),
+	}
+}
+
+// IsPassed 门禁是否通过
+func (v *ExptGateVerdict) IsPassed() bool {
+	return v != nil && v.Status == ExptGateStatus_Pass
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExptGateConf_Valid(t *testing.T) {
+	assert.NoError(t, (*ExptGateConf)(nil).Valid())
+	assert.NoError(t, (&ExptGateConf{}).Valid())
+	assert.NoError(t, (&ExptGateConf{Rules: []*ExptGateRule{
+		{RuleType: ExptGateRuleType_EvaluatorScore, EvaluatorVersionID: 1, Threshold: 0.8},
+		{RuleType: ExptGateRuleType_EvaluatorScore, EvaluatorVersionID: 1, Operator: ExptGateOperator_LT, Threshold: 0.2},
+		{RuleType: ExptGateRuleType_FailedTurnRate, Threshold: 0.02},
+		{RuleType: ExptGateRuleType_NoRegression, BaselineExptID: 2, ConfidenceLevel: 0.9},
+	}}).Valid())
+
+	for _, rule := range []*ExptGateRule{
+		nil,
+		{RuleType: "Unknown"},
+		{RuleType: ExptGateRuleType_EvaluatorScore, Threshold: 0.8},
+		{RuleType: ExptGateRuleType_EvaluatorScore, EvaluatorVersionID: 1, Operator: "eq"},
+		{RuleType: ExptGateRuleType_FailedTurnRate, Threshold: 1.5},
+		{RuleType: ExptGateRuleType_NoRegression},
+		{RuleType: ExptGateRuleType_NoRegression, BaselineExptID: 2, ConfidenceLevel: 1},
+	} {
+		assert.Error(t, (&ExptGateConf{Rules: []*ExptGateRule{rule}}).Valid())
+	}
+
+	rules := make([]*ExptGateRule, MaxExptGateRuleNum+1)
+	for i := range rules {
+		rules[i] = &ExptGateRule{RuleType: ExptGateRuleType_FailedTurnRate}
+	}
+	assert.Error(t, (&ExptGateConf{Rules: rules}).Valid())
+}
+
+func TestExptGateOperator_Compare(t *testing.T) {
+	assert.True(t, ExptGateOperator("").Compare(0.8, 0.8))
+	assert.True(t, ExptGateOperator_GTE.Compare(0.8, 0.8))
+	assert.False(t, ExptGateOperator_GT.Compare(0.8, 0.8))
+	assert.True(t, ExptGateOperator_LTE.Compare(0.8, 0.8))
+	assert.False(t, ExptGateOperator_LT.Compare(0.8, 0.8))
+	assert.True(t, ExptGateOperator_LT.Compare(0.7, 0.8))
+}
+
+func TestNewExptGateVerdict(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	pass := &ExptGateRuleVerdict{Status: ExptGateStatus_Pass}
+	fail := &ExptGateRuleVerdict{Status: ExptGateStatus_Fail}
+	errored := &ExptGateRuleVerdict{Status: ExptGateStatus_Error}
+
+	v := NewExptGateVerdict([]*ExptGateRuleVerdict{pass, pass}, now)
+	assert.Equal(t, ExptGateStatus_Pass, v.Status)
+	assert.True(t, v.IsPassed())
+	assert.Equal(t, now.UnixMilli(), v.EvaluatedAt)
+
+	// 规则无法计算时不视为通过
+	v = NewExptGateVerdict([]*ExptGateRuleVerdict{pass, errored}, now)
+	assert.Equal(t, ExptGateStatus_Error, v.Status)
+	assert.False(t, v.IsPassed())
+
+	// Fail 优先于 Error
+	v = NewExptGateVerdict([]*ExptGateRuleVerdict{errored, fail, pass}, now)
+	assert.Equal(t, ExptGateStatus_Fail, v.Status)
+	assert.Len(t, v.RuleVerdicts, 3)
+}
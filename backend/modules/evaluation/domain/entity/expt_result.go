@@ -41,10 +41,14 @@ const (
 	// 加权得分, FieldKey为expt_id
 	FieldType_WeightedScore FieldType = 24
 
+	// 评估器维度得分, FieldKey为{evaluatorVersionID}:{dimensionName}
+	FieldType_EvaluatorDimensionScore FieldType = 25
+
 	FieldType_TargetLatency      FieldType = 50
 	FieldType_TargetInputTokens  FieldType = 51
 	FieldType_TargetOutputTokens FieldType = 52
 	FieldType_TargetTotalTokens  FieldType = 53
+	FieldType_TargetTotalCost    FieldType = 54
 )
 
 const (
@@ -52,6 +56,7 @@ const (
 	AggrResultFieldKey_TargetInputTokens  string = "_target_input_tokens"
 	AggrResultFieldKey_TargetOutputTokens string = "_target_output_tokens"
 	AggrResultFieldKey_TargetTotalTokens  string = "_target_total_tokens"
+	AggrResultFieldKey_TargetTotalCost    string = "_target_total_cost"
 )
 
 // aggregate result
@@ -197,6 +202,8 @@ type EvaluatorAggregateResult struct {
 	AggregatorResults  []*AggregatorResult
 	Name               *string
 	Version            *string
+	// DimensionResults 多维度评估器各维度的聚合结果，按维度名排序
+	DimensionResults []*EvaluatorDimensionAggregateResult
 }
 
 // 人工标注项粒度聚合结果
@@ -213,6 +220,8 @@ type EvalTargetMtrAggrResult struct {
 	InputTokensAggrResults  []*AggregatorResult
 	OutputTokensAggrResults []*AggregatorResult
 	TotalTokensAggrResults  []*AggregatorResult
+	// TotalCostAggrResults 其中Sum为实验的总费用
+	TotalCostAggrResults []*AggregatorResult
 }
 
 // item result
@@ -34,6 +34,8 @@ type ExptTemplate struct {
 
 	// ExptInfo 实验运行状态信息（存储在数据库的 expt_info 字段中，JSON格式）
 	ExptInfo *ExptInfo
+	// ScheduleState 调度状态与调度记录（存储在数据库的 schedule_state 字段中，JSON格式），与 ExptInfo 分开读写
+	ScheduleState *ExptScheduleState
 }
 
 // ExptInfo 实验模板关联的实验运行状态信息
@@ -132,6 +134,11 @@ type ExptTemplateConfiguration struct {
 	// 默认评估器并发数
 	EvaluatorsConcurNum *int
 	ItemRetryNum        *int
+
+	// 调度配置
+	Schedule *ExptTemplateSchedule
+	// 门禁配置，基于模板创建的实验沿用该配置
+	GateConf *ExptGateConf
 }
 
 // ToEvaluatorRefDO 转换为评估器引用DO
@@ -264,6 +271,30 @@ func (e *ExptTemplate) GetEvaluatorVersionIds() []int64 {
 	return e.TripleConfig.EvaluatorVersionIds
 }
 
+// GetSchedule 获取调度配置
+func (e *ExptTemplate) GetSchedule() *ExptTemplateSchedule {
+	if e == nil || e.TemplateConf == nil {
+		return nil
+	}
+	return e.TemplateConf.Schedule
+}
+
+// GetGateConf 获取门禁配置
+func (e *ExptTemplate) GetGateConf() *ExptGateConf {
+	if e == nil || e.TemplateConf == nil {
+		return nil
+	}
+	return e.TemplateConf.GateConf
+}
+
+// GetScheduleState 获取调度状态
+func (e *ExptTemplate) GetScheduleState() *ExptScheduleState {
+	if e == nil {
+		return nil
+	}
+	return e.ScheduleState
+}
+
 // GetEvaluatorIDVersionItems 获取评估器ID版本项列表
 func (e *ExptTemplate) GetEvaluatorIDVersionItems() []*EvaluatorIDVersionItem {
 	if e == nil || e.TripleConfig == nil {
@@ -314,7 +345,10 @@ func (c *ExptTemplateConfiguration) Valid(ctx context.Context) error {
 			return err
 		}
 	}
-	return nil
+	if err := c.GateConf.Valid(); err != nil {
+		return err
+	}
+	return c.Schedule.Valid()
 }
 
 // GetDefaultItemConcurNum 获取默认评测集并发数
@@ -348,6 +382,10 @@ type CreateExptTemplateParam struct {
 	TemplateConf            *ExptTemplateConfiguration
 	ExptType                ExptType
 	CreateEvalTargetParam   *CreateEvalTargetParam
+	// Schedule 调度配置，更新时为空表示保持原有调度配置
+	Schedule *ExptTemplateSchedule
+	// GateConf 门禁配置，更新时为空表示保持原有门禁配置
+	GateConf *ExptGateConf
 }
 
 // UpdateExptTemplateParam 更新实验模板参数
@@ -362,6 +400,10 @@ type UpdateExptTemplateParam struct {
 	TemplateConf            *ExptTemplateConfiguration
 	ExptType                ExptType
 	CreateEvalTargetParam   *CreateEvalTargetParam
+	// Schedule 调度配置，更新时为空表示保持原有调度配置
+	Schedule *ExptTemplateSchedule
+	// GateConf 门禁配置，更新时为空表示保持原有门禁配置
+	GateConf *ExptGateConf
 }
 
 // UpdateExptTemplateMetaParam 更新实验模板 Meta 参数
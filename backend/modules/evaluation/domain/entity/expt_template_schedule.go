@@ -0,0 +1,161 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/cron"
+)
+
+const (
+	// MaxExptScheduleHistory schedule_state 中保留的调度记录上限
+	MaxExptScheduleHistory = 50
+	// MaxExptScheduleRetentionCount 调度实验保留数量上限，不超过调度记录上限以保证过期实验可追溯
+	MaxExptScheduleRetentionCount = MaxExptScheduleHistory
+)
+
+// ExptTemplateSchedule 实验模板调度配置，存储在 template_conf 中
+type ExptTemplateSchedule struct {
+	Enabled bool
+	// Cron 5 段 cron 表达式（分 时 日 月 周），为空时不按时间触发
+	Cron string
+	// Timezone cron 表达式使用的时区，如 Asia/Shanghai，为空时使用服务所在时区
+	Timezone string
+	// TriggerOnTargetChange 评测对象为 Prompt 时，提交版本或标签指向的版本变化后自动运行
+	TriggerOnTargetChange bool
+	// PromptLabel 跟踪的 Prompt 标签，为空时跟踪最新提交版本
+	PromptLabel string
+	// RetentionCount 保留的调度实验数量，超出后删除最早的调度实验，0 表示不清理
+	RetentionCount int
+}
+
+// IsActive 调度是否开启且配置了至少一种触发方式
+func (s *ExptTemplateSchedule) IsActive() bool {
+	return s != nil && s.Enabled && (s.Cron != "" || s.TriggerOnTargetChange)
+}
+
+// Valid 校验调度配置
+func (s *ExptTemplateSchedule) Valid() error {
+	if s == nil {
+		return nil
+	}
+	if s.Cron != "" {
+		if _, err := cron.Parse(s.Cron); err != nil {
+			return err
+		}
+	}
+	if _, err := s.Location(); err != nil {
+		return fmt.Errorf("invalid schedule timezone %q: %w", s.Timezone, err)
+	}
+	if s.Enabled && s.Cron == "" && !s.TriggerOnTargetChange {
+		return fmt.Errorf("schedule requires a cron expression or trigger_on_target_change")
+	}
+	if s.RetentionCount < 0 || s.RetentionCount > MaxExptScheduleRetentionCount {
+		return fmt.Errorf("schedule retention_count must be in [0, %d]", MaxExptScheduleRetentionCount)
+	}
+	return nil
+}
+
+// Location 返回 cron 表达式使用的时区
+func (s *ExptTemplateSchedule) Location() (*time.Location, error) {
+	if s == nil || s.Timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(s.Timezone)
+}
+
+// LatestCronFireTime 返回 (after, now] 区间内最近一次 cron 触发时间，没有触发点时返回 false。
+// 区间内错过的多次触发只补跑最近一次。
+func (s *ExptTemplateSchedule) LatestCronFireTime(after, now time.Time) (time.Time, bool) {
+	if s == nil || s.Cron == "" {
+		return time.Time{}, false
+	}
+	c, err := cron.Parse(s.Cron)
+	if err != nil {
+		return time.Time{}, false
+	}
+	loc, err := s.Location()
+	if err != nil {
+		return time.Time{}, false
+	}
+	var latest time.Time
+	for next := c.Next(after.In(loc)); !next.IsZero() && !next.After(now); next = c.Next(next) {
+		latest = next
+	}
+	return latest, !latest.IsZero()
+}
+
+// ExptScheduleTriggerType 调度触发类型
+type ExptScheduleTriggerType string
+
+const (
+	ExptScheduleTriggerTypeCron         ExptScheduleTriggerType = "cron"
+	ExptScheduleTriggerTypeTargetChange ExptScheduleTriggerType = "target_change"
+	// ExptScheduleTriggerTypeTargetBaseline 首次记录跟踪的评测对象版本，仅更新调度状态，不运行实验也不写入调度记录
+	ExptScheduleTriggerTypeTargetBaseline ExptScheduleTriggerType = "target_baseline"
+)
+
+// ExptScheduleRecord 一次调度的执行记录
+type ExptScheduleRecord struct {
+	TriggerType ExptScheduleTriggerType `json:"trigger_type"`
+	// FireAt 触发时间点（ms），cron 触发时为 cron 触发时间
+	FireAt int64 `json:"fire_at"`
+	// TargetVersion 本次运行使用的 Prompt 提交版本
+	TargetVersion string `json:"target_version,omitempty"`
+	ExptID        int64  `json:"expt_id,omitempty"`
+	Error         string `json:"error,omitempty"`
+	// Purged 实验已因超出保留数量被删除
+	Purged bool `json:"purged,omitempty"`
+}
+
+// ExptScheduleState 实验模板的调度状态，存储在 schedule_state 中
+type ExptScheduleState struct {
+	// LastCronFireAt 最近一次处理的 cron 触发时间（ms）
+	LastCronFireAt int64 `json:"last_cron_fire_at,omitempty"`
+	// LastTargetVersion 最近一次观察到的 Prompt 提交版本
+	LastTargetVersion string `json:"last_target_version,omitempty"`
+	// History 调度记录，按时间升序，最多保留 MaxExptScheduleHistory 条
+	History []*ExptScheduleRecord `json:"history,omitempty"`
+}
+
+// Advance 将调度游标推进到 record 对应的触发点，之后不会再次触发该 cron 时间点或 Prompt 版本
+func (s *ExptScheduleState) Advance(record *ExptScheduleRecord) {
+	if record.TriggerType == ExptScheduleTriggerTypeCron && record.FireAt > s.LastCronFireAt {
+		s.LastCronFireAt = record.FireAt
+	}
+	if record.TargetVersion != "" {
+		s.LastTargetVersion = record.TargetVersion
+	}
+}
+
+// Apply 写入一次调度记录并返回超出保留数量、需要删除的实验ID
+func (s *ExptScheduleState) Apply(record *ExptScheduleRecord, retentionCount int) (expiredExptIDs []int64) {
+	s.Advance(record)
+	if record.TriggerType == ExptScheduleTriggerTypeTargetBaseline {
+		return nil
+	}
+
+	s.History = append(s.History, record)
+	if retentionCount > 0 {
+		kept := 0
+		for i := len(s.History) - 1; i >= 0; i-- {
+			r := s.History[i]
+			if r.ExptID <= 0 || r.Purged {
+				continue
+			}
+			if kept < retentionCount {
+				kept++
+				continue
+			}
+			r.Purged = true
+			expiredExptIDs = append(expiredExptIDs, r.ExptID)
+		}
+	}
+	if len(s.History) > MaxExptScheduleHistory {
+		s.History = s.History[len(s.History)-MaxExptScheduleHistory:]
+	}
+	return expiredExptIDs
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExptTemplateSchedule_Valid(t *testing.T) {
+	assert.NoError(t, (*ExptTemplateSchedule)(nil).Valid())
+	assert.NoError(t, (&ExptTemplateSchedule{}).Valid())
+	assert.NoError(t, (&ExptTemplateSchedule{Enabled: true, Cron: "0 9 * * 1-5", Timezone: "Asia/Shanghai", RetentionCount: 10}).Valid())
+	assert.NoError(t, (&ExptTemplateSchedule{Enabled: true, TriggerOnTargetChange: true}).Valid())
+
+	assert.Error(t, (&ExptTemplateSchedule{Enabled: true}).Valid())
+	assert.Error(t, (&ExptTemplateSchedule{Enabled: true, Cron: "0 9 * *"}).Valid())
+	assert.Error(t, (&ExptTemplateSchedule{Enabled: true, Cron: "0 9 * * *", Timezone: "Mars/Olympus"}).Valid())
+	assert.Error(t, (&ExptTemplateSchedule{Enabled: true, Cron: "0 9 * * *", RetentionCount: -1}).Valid())
+	assert.Error(t, (&ExptTemplateSchedule{Enabled: true, Cron: "0 9 * * *", RetentionCount: MaxExptScheduleRetentionCount + 1}).Valid())
+}
+
+func TestExptTemplateSchedule_LatestCronFireTime(t *testing.T) {
+	s := &ExptTemplateSchedule{Enabled: true, Cron: "*/10 * * * *", Timezone: "UTC"}
+	now := time.Date(2025, 3, 14, 10, 35, 0, 0, time.UTC)
+
+	// 区间内错过多次触发时只返回最近一次
+	fireAt, ok := s.LatestCronFireTime(now.Add(-time.Hour), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC), fireAt.UTC())
+
+	// 已处理的触发点不会再次返回
+	_, ok = s.LatestCronFireTime(fireAt, now)
+	assert.False(t, ok)
+
+	// 时区生效：上海 18:30 即 UTC 10:30
+	s = &ExptTemplateSchedule{Enabled: true, Cron: "30 18 * * *", Timezone: "Asia/Shanghai"}
+	fireAt, ok = s.LatestCronFireTime(now.Add(-time.Hour), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC), fireAt.UTC())
+
+	_, ok = (&ExptTemplateSchedule{TriggerOnTargetChange: true}).LatestCronFireTime(now.Add(-time.Hour), now)
+	assert.False(t, ok)
+}
+
+func TestExptScheduleState_Apply(t *testing.T) {
+	t.Run("baseline only updates state", func(t *testing.T) {
+		s := &ExptScheduleState{}
+		expired := s.Apply(&ExptScheduleRecord{TriggerType: ExptScheduleTriggerTypeTargetBaseline, FireAt: 1, TargetVersion: "v1"}, 1)
+		assert.Empty(t, expired)
+		assert.Equal(t, "v1", s.LastTargetVersion)
+		assert.Empty(t, s.History)
+		assert.Zero(t, s.LastCronFireAt)
+	})
+
+	t.Run("retention purges oldest experiments", func(t *testing.T) {
+		s := &ExptScheduleState{}
+		assert.Empty(t, s.Apply(&ExptScheduleRecord{TriggerType: ExptScheduleTriggerTypeCron, FireAt: 100, ExptID: 1}, 2))
+		assert.Empty(t, s.Apply(&ExptScheduleRecord{TriggerType: ExptScheduleTriggerTypeTargetChange, FireAt: 150, TargetVersion: "v2", ExptID: 2}, 2))
+		// 提交失败的记录不占用保留名额
+		assert.Empty(t, s.Apply(&ExptScheduleRecord{TriggerType: ExptScheduleTriggerTypeCron, FireAt: 200, Error: "submit fail"}, 2))
+		expired := s.Apply(&ExptScheduleRecord{TriggerType: ExptScheduleTriggerTypeCron, FireAt: 300, ExptID: 3}, 2)
+		assert.Equal(t, []int64{1}, expired)
+		assert.True(t, s.History[0].Purged)
+		assert.Equal(t, int64(300), s.LastCronFireAt)
+		assert.Equal(t, "v2", s.LastTargetVersion)
+		assert.Len(t, s.History, 4)
+
+		// 已删除的实验不会重复返回
+		expired = s.Apply(&ExptScheduleRecord{TriggerType: ExptScheduleTriggerTypeCron, FireAt: 400, ExptID: 4}, 2)
+		assert.Equal(t, []int64{2}, expired)
+	})
+
+	t.Run("history is capped", func(t *testing.T) {
+		s := &ExptScheduleState{}
+		for i := 1; i <= MaxExptScheduleHistory+5; i++ {
+			assert.Empty(t, s.Apply(&ExptScheduleRecord{TriggerType: ExptScheduleTriggerTypeCron, FireAt: int64(i), ExptID: int64(i)}, 0))
+		}
+		assert.Len(t, s.History, MaxExptScheduleHistory)
+		assert.Equal(t, int64(6), s.History[0].ExptID)
+	})
+}
@@ -127,6 +127,8 @@ type Opt struct {
 	CustomEvalTarget *CustomEvalTarget
 	Region           *Region
 	Env              *string
+	CustomRPCServer  *CustomRPCServer
+	OpenAIChat       *OpenAIChat
 }
 
 func WithCozeBotPublishVersion(publishVersion *string) Option {
@@ -159,6 +161,18 @@ func WithEnv(env *string) Option {
 	}
 }
 
+func WithCustomRPCServer(server *CustomRPCServer) Option {
+	return func(option *Opt) {
+		option.CustomRPCServer = server
+	}
+}
+
+func WithOpenAIChat(chat *OpenAIChat) Option {
+	return func(option *Opt) {
+		option.OpenAIChat = chat
+	}
+}
+
 type ExecuteEvalTargetParam struct {
 	ExptID              int64
 	TargetID            int64
@@ -30,6 +30,7 @@ type EvalTargetVersion struct {
 	CozeWorkflow    *CozeWorkflow
 	VolcengineAgent *VolcengineAgent
 	CustomRPCServer *CustomRPCServer
+	OpenAIChat      *OpenAIChat
 
 	InputSchema      []*ArgsSchema
 	OutputSchema     []*ArgsSchema
@@ -56,6 +57,8 @@ const (
 
 	// 火山智能体Agentkit
 	EvalTargetTypeVolcengineAgentAgentkit EvalTargetType = 7
+	// OpenAI兼容的Chat接口
+	EvalTargetTypeOpenAIChat EvalTargetType = 8
 )
 
 func (p EvalTargetType) String() string {
@@ -74,13 +77,15 @@ func (p EvalTargetType) String() string {
 		return "CustomRPCServer"
 	case EvalTargetTypeVolcengineAgentAgentkit:
 		return "VolcengineAgentKit"
+	case EvalTargetTypeOpenAIChat:
+		return "OpenAIChat"
 	}
 	return "<UNSET>"
 }
 
 func (p EvalTargetType) SupptTrajectory() bool {
 	switch p {
-	case EvalTargetTypeVolcengineAgent, EvalTargetTypeCustomRPCServer:
+	case EvalTargetTypeVolcengineAgent, EvalTargetTypeCustomRPCServer, EvalTargetTypeOpenAIChat:
 		return true
 	default:
 		return false
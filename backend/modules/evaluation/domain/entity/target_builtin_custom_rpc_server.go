@@ -37,6 +37,11 @@ type CustomRPCServer struct {
 	ExecEnv      *string // 执行环境
 	Timeout      *int64  // 执行超时，单位ms
 	AsyncTimeout *int64  // 执行超时，单位ms
+
+	// AccessProtocol为http时的服务地址，与各HTTPInfo中的Path拼接为请求url
+	Endpoint string
+	// AccessProtocol为http时附加的请求头，例如鉴权信息
+	Headers map[string]string
 }
 
 type CustomFieldSchema struct {
@@ -77,6 +82,8 @@ const (
 	AccessProtocolRPCOld      = "rpc_old"
 	AccessProtocolFaasHTTP    = "faas_http"
 	AccessProtocolFaasHTTPOld = "faas_http_old"
+	// AccessProtocolHTTP 按EvaluationSPIService协议以HTTP/JSON调用
+	AccessProtocolHTTP = "http"
 )
 
 type HTTPMethod = string
@@ -0,0 +1,126 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"regexp"
+	"strings"
+)
+
+// OpenAIChat OpenAI兼容的/v1/chat/completions接口
+type OpenAIChat struct {
+	// 例如https://api.openai.com/v1，请求地址为{BaseURL}/chat/completions
+	BaseURL string
+	Model   string
+	// 请求头，例如Authorization
+	Headers map[string]string
+	// 消息模板，Content中的{{var}}由评测集字段渲染
+	MessageTemplates []*OpenAIChatMessageTemplate
+	// 是否流式调用，流式调用时记录首包耗时
+	Stream bool
+	// 执行超时，单位ms
+	Timeout *int64
+}
+
+type OpenAIChatMessageTemplate struct {
+	Role    OpenAIChatRole
+	Content string
+}
+
+// OpenAIChatMessage 渲染后实际发送的消息
+type OpenAIChatMessage struct {
+	Role    OpenAIChatRole
+	Content string
+}
+
+type OpenAIChatRole = string
+
+const (
+	OpenAIChatRoleSystem    = "system"
+	OpenAIChatRoleUser      = "user"
+	OpenAIChatRoleAssistant = "assistant"
+	OpenAIChatRoleTool      = "tool"
+)
+
+var openAIChatVariableRegexp = regexp.MustCompile(`\{\{\s*([\w.\-]+)\s*\}\}`)
+
+// InputVariables 按出现顺序返回消息模板中引用的变量，用作评测对象的输入字段
+func (o *OpenAIChat) InputVariables() []string {
+	if o == nil {
+		return nil
+	}
+	vars := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, tpl := range o.MessageTemplates {
+		if tpl == nil {
+			continue
+		}
+		for _, match := range openAIChatVariableRegexp.FindAllStringSubmatch(tpl.Content, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				vars = append(vars, match[1])
+			}
+		}
+	}
+	return vars
+}
+
+// RenderMessages 用输入字段渲染消息模板，未提供的变量渲染为空串。
+// 多轮评测的历史消息插入在system消息之后、其余模板消息之前
+func (o *OpenAIChat) RenderMessages(input *EvalTargetInputData) []*OpenAIChatMessage {
+	if o == nil {
+		return nil
+	}
+	var fields map[string]*Content
+	var history []*Message
+	if input != nil {
+		fields = input.InputFields
+		history = input.HistoryMessages
+	}
+	render := func(content string) string {
+		return openAIChatVariableRegexp.ReplaceAllStringFunc(content, func(s string) string {
+			key := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(s, "{{"), "}}"))
+			return fields[key].GetText()
+		})
+	}
+
+	messages := make([]*OpenAIChatMessage, 0, len(o.MessageTemplates)+len(history))
+	historyInserted := len(history) == 0
+	for _, tpl := range o.MessageTemplates {
+		if tpl == nil {
+			continue
+		}
+		if !historyInserted && tpl.Role != OpenAIChatRoleSystem {
+			messages = append(messages, historyToOpenAIChatMessages(history)...)
+			historyInserted = true
+		}
+		messages = append(messages, &OpenAIChatMessage{Role: tpl.Role, Content: render(tpl.Content)})
+	}
+	if !historyInserted {
+		messages = append(messages, historyToOpenAIChatMessages(history)...)
+	}
+	return messages
+}
+
+func historyToOpenAIChatMessages(history []*Message) []*OpenAIChatMessage {
+	messages := make([]*OpenAIChatMessage, 0, len(history))
+	for _, msg := range history {
+		if msg == nil {
+			continue
+		}
+		var role OpenAIChatRole
+		switch msg.Role {
+		case RoleSystem:
+			role = OpenAIChatRoleSystem
+		case RoleAssistant:
+			role = OpenAIChatRoleAssistant
+		case RoleTool:
+			role = OpenAIChatRoleTool
+		default:
+			role = OpenAIChatRoleUser
+		}
+		messages = append(messages, &OpenAIChatMessage{Role: role, Content: msg.Content.GetText()})
+	}
+	return messages
+}
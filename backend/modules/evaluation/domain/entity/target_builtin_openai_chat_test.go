@@ -0,0 +1,55 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAIChat_InputVariables(t *testing.T) {
+	var nilChat *OpenAIChat
+	assert.Nil(t, nilChat.InputVariables())
+
+	chat := &OpenAIChat{MessageTemplates: []*OpenAIChatMessageTemplate{
+		{Role: OpenAIChatRoleSystem, Content: "You are {{ persona }}."},
+		nil,
+		{Role: OpenAIChatRoleUser, Content: "{{question}} (context: {{context}}, persona: {{persona}})"},
+	}}
+	assert.Equal(t, []string{"persona", "question", "context"}, chat.InputVariables())
+}
+
+func TestOpenAIChat_RenderMessages(t *testing.T) {
+	chat := &OpenAIChat{MessageTemplates: []*OpenAIChatMessageTemplate{
+		{Role: OpenAIChatRoleSystem, Content: "You are {{persona}}."},
+		{Role: OpenAIChatRoleUser, Content: "{{question}}{{missing}}"},
+	}}
+	input := &EvalTargetInputData{
+		InputFields: map[string]*Content{
+			"persona":  {Text: gptr.Of("a tutor")},
+			"question": {Text: gptr.Of("what is 1+1?")},
+		},
+	}
+	assert.Equal(t, []*OpenAIChatMessage{
+		{Role: OpenAIChatRoleSystem, Content: "You are a tutor."},
+		{Role: OpenAIChatRoleUser, Content: "what is 1+1?"},
+	}, chat.RenderMessages(input))
+
+	// 历史消息插入在system消息之后
+	input.HistoryMessages = []*Message{
+		{Role: RoleUser, Content: &Content{Text: gptr.Of("hi")}},
+		{Role: RoleAssistant, Content: &Content{Text: gptr.Of("hello")}},
+	}
+	assert.Equal(t, []*OpenAIChatMessage{
+		{Role: OpenAIChatRoleSystem, Content: "You are a tutor."},
+		{Role: OpenAIChatRoleUser, Content: "hi"},
+		{Role: OpenAIChatRoleAssistant, Content: "hello"},
+		{Role: OpenAIChatRoleUser, Content: "what is 1+1?"},
+	}, chat.RenderMessages(input))
+
+	systemOnly := &OpenAIChat{MessageTemplates: []*OpenAIChatMessageTemplate{{Role: OpenAIChatRoleSystem, Content: "sys"}}}
+	assert.Len(t, systemOnly.RenderMessages(input), 3)
+}
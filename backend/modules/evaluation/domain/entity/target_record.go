@@ -92,10 +92,23 @@ type EvalTargetOutputData struct {
 	TimeConsumingMS *int64
 }
 
+// SetTotalCost 记录运行费用，cost为空时不做修改
+func (e *EvalTargetOutputData) SetTotalCost(cost *float64) {
+	if e == nil || cost == nil {
+		return
+	}
+	if e.EvalTargetUsage == nil {
+		e.EvalTargetUsage = &EvalTargetUsage{}
+	}
+	e.EvalTargetUsage.TotalCost = cost
+}
+
 type EvalTargetUsage struct {
 	InputTokens  int64
 	OutputTokens int64
 	TotalTokens  int64
+	// TotalCost 按模型价格表计算的费用，从评测对象的轨迹中获取
+	TotalCost *float64
 }
 
 func (e *EvalTargetUsage) GetInputTokens() int64 {
@@ -119,6 +132,13 @@ func (e *EvalTargetUsage) GetTotalTokens() int64 {
 	return 0
 }
 
+func (e *EvalTargetUsage) GetTotalCost() *float64 {
+	if e != nil {
+		return e.TotalCost
+	}
+	return nil
+}
+
 type EvalTargetRunError struct {
 	Code    int32
 	Message string
@@ -23,6 +23,14 @@ func (t *Trajectory) IsValid() bool {
 	return true
 }
 
+// GetTotalCost 返回轨迹中模型调用的总费用，轨迹中没有费用信息时返回nil
+func (t *Trajectory) GetTotalCost() *float64 {
+	if !t.IsValid() || t.RootStep.MetricsInfo == nil {
+		return nil
+	}
+	return t.RootStep.MetricsInfo.TotalCost
+}
+
 func (t *Trajectory) ToContent(ctx context.Context) *Content {
 	if t == nil {
 		return nil
@@ -33,6 +33,29 @@ func TestTrajectory_IsValid(t *testing.T) {
 	assert.True(t, t3.IsValid())
 }
 
+func TestTrajectory_GetTotalCost(t *testing.T) {
+	var nilTraj *Trajectory
+	assert.Nil(t, nilTraj.GetTotalCost())
+
+	id := "trace-id"
+	assert.Nil(t, (&Trajectory{ID: &id, RootStep: &kitextrajectory.RootStep{}}).GetTotalCost())
+
+	cost := 0.5
+	tr := &Trajectory{
+		ID: &id,
+		RootStep: &kitextrajectory.RootStep{
+			MetricsInfo: &kitextrajectory.MetricsInfo{TotalCost: &cost},
+		},
+	}
+	assert.Equal(t, &cost, tr.GetTotalCost())
+
+	od := &EvalTargetOutputData{}
+	od.SetTotalCost(tr.GetTotalCost())
+	if assert.NotNil(t, od.EvalTargetUsage) {
+		assert.Equal(t, &cost, od.EvalTargetUsage.GetTotalCost())
+	}
+}
+
 func TestTrajectory_ToContent_Nil(t *testing.T) {
 	var nilTraj *Trajectory
 	content := nilTraj.ToContent(context.Background())
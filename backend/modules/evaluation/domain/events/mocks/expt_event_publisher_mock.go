@@ -84,6 +84,20 @@ func (mr *MockExptEventPublisherMockRecorder) PublishExptExportCSVEvent(ctx, eve
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishExptExportCSVEvent", reflect.TypeOf((*MockExptEventPublisher)(nil).PublishExptExportCSVEvent), ctx, events, duration)
 }
 
+// PublishExptGateVerdictEvent mocks base method.
+func (m *MockExptEventPublisher) PublishExptGateVerdictEvent(ctx context.Context, event *entity.ExptGateVerdictEvent, duration *time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishExptGateVerdictEvent", ctx, event, duration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishExptGateVerdictEvent indicates an expected call of PublishExptGateVerdictEvent.
+func (mr *MockExptEventPublisherMockRecorder) PublishExptGateVerdictEvent(ctx, event, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishExptGateVerdictEvent", reflect.TypeOf((*MockExptEventPublisher)(nil).PublishExptGateVerdictEvent), ctx, event, duration)
+}
+
 // PublishExptOnlineEvalResult mocks base method.
 func (m *MockExptEventPublisher) PublishExptOnlineEvalResult(ctx context.Context, events *entity.OnlineExptEvalResultEvent, duration *time.Duration) error {
 	m.ctrl.T.Helper()
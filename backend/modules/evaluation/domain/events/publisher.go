@@ -19,6 +19,7 @@ type ExptEventPublisher interface {
 	PublishExptOnlineEvalResult(ctx context.Context, events *entity.OnlineExptEvalResultEvent, duration *time.Duration) error
 	PublishExptTurnResultFilterEvent(ctx context.Context, event *entity.ExptTurnResultFilterEvent, duration *time.Duration) error
 	PublishExptExportCSVEvent(ctx context.Context, events *entity.ExportCSVEvent, duration *time.Duration) error
+	PublishExptGateVerdictEvent(ctx context.Context, event *entity.ExptGateVerdictEvent, duration *time.Duration) error
 }
 
 //go:generate mockgen -destination mocks/evaluator_event_publisher_mock.go -package mocks . EvaluatorEventPublisher
@@ -21,4 +21,6 @@ type IExptTemplateRepo interface {
 	UpdateWithRefs(ctx context.Context, template *entity.ExptTemplate, refs []*entity.ExptTemplateEvaluatorRef) error
 	Delete(ctx context.Context, id, spaceID int64) error
 	List(ctx context.Context, page, size int32, filter *entity.ExptTemplateListFilter, orders []*entity.OrderBy, spaceID int64) ([]*entity.ExptTemplate, int64, error)
+	// ScanByCursor 按ID升序跨空间扫描模板（不含评估器引用），nextCursor 为 0 表示扫描结束
+	ScanByCursor(ctx context.Context, cursor int64, limit int) (templates []*entity.ExptTemplate, nextCursor int64, err error)
 }
@@ -131,6 +131,22 @@ func (mr *MockIExptTemplateRepoMockRecorder) MGetByID(ctx, ids, spaceID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetByID", reflect.TypeOf((*MockIExptTemplateRepo)(nil).MGetByID), ctx, ids, spaceID)
 }
 
+// ScanByCursor mocks base method.
+func (m *MockIExptTemplateRepo) ScanByCursor(ctx context.Context, cursor int64, limit int) ([]*entity.ExptTemplate, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanByCursor", ctx, cursor, limit)
+	ret0, _ := ret[0].([]*entity.ExptTemplate)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ScanByCursor indicates an expected call of ScanByCursor.
+func (mr *MockIExptTemplateRepoMockRecorder) ScanByCursor(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanByCursor", reflect.TypeOf((*MockIExptTemplateRepo)(nil).ScanByCursor), ctx, cursor, limit)
+}
+
 // Update mocks base method.
 func (m *MockIExptTemplateRepo) Update(ctx context.Context, template *entity.ExptTemplate) error {
 	m.ctrl.T.Helper()
@@ -762,10 +762,10 @@ func (c *EvaluatorSourceCodeServiceImpl) convertPythonDictToJSON(pythonDict stri
 	return string(result), nil
 }
 
-// parseEvaluationRetVal 解析评估结果RetVal字段中的JSON数据（评估结果链路：提取 score、reason）
-func (c *EvaluatorSourceCodeServiceImpl) parseEvaluationRetVal(retVal string) (score *float64, reason string, err error) {
+// parseEvaluationRetVal 解析评估结果RetVal字段中的JSON数据（评估结果链路：提取 score、reason、dimensions）
+func (c *EvaluatorSourceCodeServiceImpl) parseEvaluationRetVal(retVal string) (score *float64, reason string, dimensions []*entity.EvaluatorDimensionResult, err error) {
 	if strings.TrimSpace(retVal) == "" {
-		return nil, "", nil
+		return nil, "", nil, nil
 	}
 
 	// 处理可能存在的嵌套JSON结构
@@ -778,11 +778,11 @@ func (c *EvaluatorSourceCodeServiceImpl) parseEvaluationRetVal(retVal string) (s
 		// 如果 JSON 解析失败，尝试 Python 字典格式
 		jsonStr, convertErr := c.convertPythonDictToJSON(cleanedRetVal)
 		if convertErr != nil {
-			return nil, "", errorx.NewByCode(errno.ExecutionResultParseFailedCode, errorx.WithExtraMsg(fmt.Sprintf("failed to parse RetVal: %v, jsonStr: %s", err, jsonStr)))
+			return nil, "", nil, errorx.NewByCode(errno.ExecutionResultParseFailedCode, errorx.WithExtraMsg(fmt.Sprintf("failed to parse RetVal: %v, jsonStr: %s", err, jsonStr)))
 		}
 
 		if err := json.Unmarshal([]byte(jsonStr), &result); err != nil {
-			return nil, "", errorx.NewByCode(errno.ExecutionResultParseFailedCode, errorx.WithExtraMsg(fmt.Sprintf("failed to parse converted RetVal JSON: %v, jsonStr: %s", err, jsonStr)))
+			return nil, "", nil, errorx.NewByCode(errno.ExecutionResultParseFailedCode, errorx.WithExtraMsg(fmt.Sprintf("failed to parse converted RetVal JSON: %v, jsonStr: %s", err, jsonStr)))
 		}
 	}
 
@@ -808,7 +808,12 @@ func (c *EvaluatorSourceCodeServiceImpl) parseEvaluationRetVal(retVal string) (s
 		}
 	}
 
-	return score, reason, nil
+	// 解析可选的dimensions字段
+	if dimensionsVal, ok := result["dimensions"]; ok {
+		dimensions = entity.ParseEvaluatorDimensions(dimensionsVal)
+	}
+
+	return score, reason, dimensions, nil
 }
 
 // parseEvaluationExecutionResult 解析评估器执行结果（评估结果链路：解析 score 和 reason）
@@ -817,7 +822,7 @@ func (c *EvaluatorSourceCodeServiceImpl) parseEvaluationExecutionResult(result *
 
 	// 直接从RetVal字段解析score和reason
 	if result.Output != nil && result.Output.RetVal != "" {
-		score, reason, parseErr := c.parseEvaluationRetVal(result.Output.RetVal)
+		score, reason, dimensions, parseErr := c.parseEvaluationRetVal(result.Output.RetVal)
 		if parseErr != nil {
 			logs.Error("failed to parse RetVal: %v", parseErr)
 			// 解析失败时，将 RetVal 内容作为错误信息返回
@@ -830,6 +835,7 @@ func (c *EvaluatorSourceCodeServiceImpl) parseEvaluationExecutionResult(result *
 		if reason != "" {
 			evaluatorResult.Reasoning = reason
 		}
+		evaluatorResult.Dimensions = dimensions
 		return evaluatorResult, ""
 	}
 	return nil, ""
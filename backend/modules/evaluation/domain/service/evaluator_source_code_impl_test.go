@@ -1471,7 +1471,7 @@ func TestEvaluatorSourceCodeServiceImpl_parseEvaluationRetVal(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			score, reason, err := service.parseEvaluationRetVal(tt.retVal)
+			score, reason, _, err := service.parseEvaluationRetVal(tt.retVal)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -0,0 +1,159 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/tracer"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// EvaluatorSourceCustomRPCServiceImpl 自定义RPC评估器服务实现，目前支持access_protocol为http的评估器
+type EvaluatorSourceCustomRPCServiceImpl struct {
+	spiAdapter rpc.IEvaluatorSPIAdapter
+	metric     metrics.EvaluatorExecMetrics
+}
+
+// NewEvaluatorSourceCustomRPCServiceImpl 创建自定义RPC评估器服务实例
+func NewEvaluatorSourceCustomRPCServiceImpl(
+	spiAdapter rpc.IEvaluatorSPIAdapter,
+	metric metrics.EvaluatorExecMetrics,
+) *EvaluatorSourceCustomRPCServiceImpl {
+	return &EvaluatorSourceCustomRPCServiceImpl{
+		spiAdapter: spiAdapter,
+		metric:     metric,
+	}
+}
+
+// EvaluatorType 返回评估器类型
+func (c *EvaluatorSourceCustomRPCServiceImpl) EvaluatorType() entity.EvaluatorType {
+	return entity.EvaluatorTypeCustomRPC
+}
+
+// Run 按EvaluationSPIService协议调用评估器服务
+func (c *EvaluatorSourceCustomRPCServiceImpl) Run(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64, disableTracing bool) (output *entity.EvaluatorOutputData, runStatus entity.EvaluatorRunStatus, traceID string) {
+	var err error
+	startTime := time.Now()
+	var rootSpan *evaluatorSpan
+	if !disableTracing {
+		rootSpan, ctx = newEvaluatorSpan(ctx, evaluator.Name, "LoopEvaluation", strconv.FormatInt(exptSpaceID, 10), false)
+		traceID = rootSpan.GetTraceID()
+	}
+
+	defer func() {
+		if output == nil {
+			output = &entity.EvaluatorOutputData{}
+		}
+		output.TimeConsumingMS = time.Since(startTime).Milliseconds()
+		if err != nil {
+			runStatus = entity.EvaluatorRunStatusFail
+			output.EvaluatorRunError = &entity.EvaluatorRunError{
+				Code:    errno.CustomRPCEvaluatorRunFailedCode,
+				Message: err.Error(),
+			}
+			if statusErr, ok := errorx.FromStatusError(err); ok {
+				output.EvaluatorRunError.Code = statusErr.Code()
+			}
+		}
+		if rootSpan != nil {
+			c.reportRootSpan(ctx, rootSpan, evaluator.CustomRPCEvaluatorVersion, input, output, runStatus, err)
+		}
+	}()
+
+	if err = c.validate(evaluator); err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] validate custom rpc evaluator fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	if err = evaluator.ValidateInput(input); err != nil {
+		logs.CtxInfo(ctx, "[RunEvaluator] ValidateInput fail, err: %v", err)
+		return nil, entity.EvaluatorRunStatusFail, traceID
+	}
+	defer func() {
+		c.metric.EmitRun(exptSpaceID, err, startTime, gptr.Indirect(evaluator.CustomRPCEvaluatorVersion.ProviderEvaluatorCode))
+	}()
+
+	output, runStatus, err = c.spiAdapter.InvokeEvaluator(ctx, &rpc.InvokeEvaluatorParam{
+		SpaceID:   evaluator.SpaceID,
+		Evaluator: evaluator.CustomRPCEvaluatorVersion,
+		Input:     input,
+	})
+	if err != nil {
+		logs.CtxError(ctx, "[RunEvaluator] invoke custom rpc evaluator fail, evaluator_version_id: %d, err: %v", evaluator.GetEvaluatorVersionID(), err)
+	}
+	return output, runStatus, traceID
+}
+
+// Debug 调试自定义RPC评估器
+func (c *EvaluatorSourceCustomRPCServiceImpl) Debug(ctx context.Context, evaluator *entity.Evaluator, input *entity.EvaluatorInputData, evaluatorRunConf *entity.EvaluatorRunConfig, exptSpaceID int64) (output *entity.EvaluatorOutputData, err error) {
+	output, _, _ = c.Run(ctx, evaluator, input, evaluatorRunConf, exptSpaceID, false)
+	if output != nil && output.EvaluatorRunError != nil {
+		return nil, errorx.NewByCode(output.EvaluatorRunError.Code, errorx.WithExtraMsg(output.EvaluatorRunError.Message))
+	}
+	return output, nil
+}
+
+// PreHandle 自定义RPC评估器无需预处理
+func (c *EvaluatorSourceCustomRPCServiceImpl) PreHandle(ctx context.Context, evaluator *entity.Evaluator) error {
+	if evaluator.EvaluatorType != entity.EvaluatorTypeCustomRPC || evaluator.CustomRPCEvaluatorVersion == nil {
+		return errorx.NewByCode(errno.InvalidEvaluatorTypeCode, errorx.WithExtraMsg("invalid evaluator type or custom rpc evaluator version is nil"))
+	}
+	return nil
+}
+
+// Validate 校验评估器配置，配置了健康检查接口时探测服务是否可用
+func (c *EvaluatorSourceCustomRPCServiceImpl) Validate(ctx context.Context, evaluator *entity.Evaluator) error {
+	if err := c.validate(evaluator); err != nil {
+		return err
+	}
+	return c.spiAdapter.HealthCheck(ctx, evaluator.CustomRPCEvaluatorVersion)
+}
+
+func (c *EvaluatorSourceCustomRPCServiceImpl) validate(evaluator *entity.Evaluator) error {
+	if evaluator.EvaluatorType != entity.EvaluatorTypeCustomRPC || evaluator.CustomRPCEvaluatorVersion == nil {
+		return errorx.NewByCode(errno.InvalidEvaluatorConfigurationCode, errorx.WithExtraMsg("invalid evaluator type or custom rpc evaluator version is nil"))
+	}
+	if err := evaluator.CustomRPCEvaluatorVersion.ValidateBaseInfo(); err != nil {
+		return err
+	}
+	if protocol := evaluator.CustomRPCEvaluatorVersion.AccessProtocol; protocol != entity.EvaluatorAccessProtocolHTTP {
+		return errorx.NewByCode(errno.UnsupportedCustomRPCEvaluatorCode, errorx.WithExtraMsg("unsupported access protocol: "+protocol))
+	}
+	return nil
+}
+
+func (c *EvaluatorSourceCustomRPCServiceImpl) reportRootSpan(ctx context.Context, span *evaluatorSpan, version *entity.CustomRPCEvaluatorVersion,
+	input *entity.EvaluatorInputData, output *entity.EvaluatorOutputData, runStatus entity.EvaluatorRunStatus, errInfo error,
+) {
+	span.SetInput(ctx, tracer.Convert2TraceString(input))
+	span.SetOutput(ctx, tracer.Convert2TraceString(output.EvaluatorResult))
+	if runStatus == entity.EvaluatorRunStatusFail {
+		span.SetStatusCode(ctx, int(entity.EvaluatorRunStatusFail))
+		span.SetError(ctx, tracer.SanitizeErrorForTrace(errInfo))
+	} else {
+		span.SetStatusCode(ctx, 0)
+	}
+	tags := make(map[string]interface{})
+	if version != nil {
+		tags["evaluator_id"] = version.EvaluatorID
+		tags["evaluator_version"] = version.Version
+		tags["provider_evaluator_code"] = gptr.Indirect(version.ProviderEvaluatorCode)
+	}
+	span.SetCallType("Evaluator")
+	if userID := session.UserIDInCtxOrEmpty(ctx); userID != "" {
+		span.SetUserID(ctx, userID)
+	}
+	span.SetTags(ctx, tags)
+	span.Finish(ctx)
+}
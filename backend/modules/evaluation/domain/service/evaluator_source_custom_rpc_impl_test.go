@@ -0,0 +1,114 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	metricsmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func newTestCustomRPCEvaluator() *entity.Evaluator {
+	return &entity.Evaluator{
+		ID:            1,
+		SpaceID:       100,
+		Name:          "http_evaluator",
+		EvaluatorType: entity.EvaluatorTypeCustomRPC,
+		CustomRPCEvaluatorVersion: &entity.CustomRPCEvaluatorVersion{
+			ID:                    2,
+			EvaluatorID:           1,
+			ProviderEvaluatorCode: gptr.Of("E001"),
+			AccessProtocol:        entity.EvaluatorAccessProtocolHTTP,
+			Endpoint:              gptr.Of("https://example.com/evaluator"),
+			InvokeHTTPInfo:        &entity.EvaluatorHTTPInfo{Path: gptr.Of("/invoke")},
+			HealthCheckHTTPInfo:   &entity.EvaluatorHTTPInfo{Path: gptr.Of("/health")},
+		},
+	}
+}
+
+func TestEvaluatorSourceCustomRPCServiceImpl_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAdapter := rpcmocks.NewMockIEvaluatorSPIAdapter(ctrl)
+	mockMetrics := metricsmocks.NewMockEvaluatorExecMetrics(ctrl)
+	svc := NewEvaluatorSourceCustomRPCServiceImpl(mockAdapter, mockMetrics)
+	assert.Equal(t, entity.EvaluatorTypeCustomRPC, svc.EvaluatorType())
+
+	input := &entity.EvaluatorInputData{
+		InputFields: map[string]*entity.Content{"input": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("1+1")}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockMetrics.EXPECT().EmitRun(int64(100), nil, gomock.Any(), "E001")
+		mockAdapter.EXPECT().InvokeEvaluator(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, param *rpc.InvokeEvaluatorParam) (*entity.EvaluatorOutputData, entity.EvaluatorRunStatus, error) {
+				assert.Equal(t, int64(100), param.SpaceID)
+				assert.Equal(t, input, param.Input)
+				return &entity.EvaluatorOutputData{
+					EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(1.0), Reasoning: "correct"},
+				}, entity.EvaluatorRunStatusSuccess, nil
+			})
+		output, status, _ := svc.Run(context.Background(), newTestCustomRPCEvaluator(), input, nil, 100, true)
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, 1.0, gptr.Indirect(output.EvaluatorResult.Score))
+		assert.Nil(t, output.EvaluatorRunError)
+	})
+
+	t.Run("invoke error", func(t *testing.T) {
+		mockMetrics.EXPECT().EmitRun(int64(100), gomock.Any(), gomock.Any(), "E001")
+		mockAdapter.EXPECT().InvokeEvaluator(gomock.Any(), gomock.Any()).Return(nil, entity.EvaluatorRunStatusFail, errorx.NewByCode(errno.CustomRPCEvaluatorRunFailedCode))
+		output, status, _ := svc.Run(context.Background(), newTestCustomRPCEvaluator(), input, nil, 100, true)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.Equal(t, int32(errno.CustomRPCEvaluatorRunFailedCode), output.EvaluatorRunError.Code)
+	})
+
+	t.Run("unsupported access protocol", func(t *testing.T) {
+		evaluator := newTestCustomRPCEvaluator()
+		evaluator.CustomRPCEvaluatorVersion.AccessProtocol = entity.EvaluatorAccessProtocolRPC
+		evaluator.CustomRPCEvaluatorVersion.ServiceName = gptr.Of("p.s.m")
+		output, status, _ := svc.Run(context.Background(), evaluator, input, nil, 100, true)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.Equal(t, int32(errno.UnsupportedCustomRPCEvaluatorCode), output.EvaluatorRunError.Code)
+	})
+
+	t.Run("debug returns evaluator error", func(t *testing.T) {
+		mockMetrics.EXPECT().EmitRun(int64(100), nil, gomock.Any(), "E001")
+		mockAdapter.EXPECT().InvokeEvaluator(gomock.Any(), gomock.Any()).Return(&entity.EvaluatorOutputData{
+			EvaluatorRunError: &entity.EvaluatorRunError{Code: errno.CustomRPCEvaluatorRunFailedCode, Message: "model overloaded"},
+		}, entity.EvaluatorRunStatusFail, nil)
+		_, err := svc.Debug(context.Background(), newTestCustomRPCEvaluator(), input, nil, 100)
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluatorSourceCustomRPCServiceImpl_Validate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAdapter := rpcmocks.NewMockIEvaluatorSPIAdapter(ctrl)
+	svc := NewEvaluatorSourceCustomRPCServiceImpl(mockAdapter, metricsmocks.NewMockEvaluatorExecMetrics(ctrl))
+
+	evaluator := newTestCustomRPCEvaluator()
+	mockAdapter.EXPECT().HealthCheck(gomock.Any(), evaluator.CustomRPCEvaluatorVersion).Return(nil)
+	assert.NoError(t, svc.Validate(context.Background(), evaluator))
+
+	mockAdapter.EXPECT().HealthCheck(gomock.Any(), gomock.Any()).Return(errorx.NewByCode(errno.InvalidEvaluatorConfigurationCode))
+	assert.Error(t, svc.Validate(context.Background(), newTestCustomRPCEvaluator()))
+
+	evaluator = newTestCustomRPCEvaluator()
+	evaluator.CustomRPCEvaluatorVersion.Endpoint = nil
+	assert.Error(t, svc.Validate(context.Background(), evaluator))
+
+	assert.NoError(t, svc.PreHandle(context.Background(), newTestCustomRPCEvaluator()))
+	assert.Error(t, svc.PreHandle(context.Background(), &entity.Evaluator{EvaluatorType: entity.EvaluatorTypeCustomRPC}))
+}
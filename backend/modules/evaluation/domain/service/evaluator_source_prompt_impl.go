@@ -358,8 +358,9 @@ func parseOutput(ctx context.Context, evaluatorVersion *entity.PromptEvaluatorVe
 }
 
 type outputMsgFormat struct {
-	Score  json2.Number `json:"score"`
-	Reason string       `json:"reason"`
+	Score      json2.Number `json:"score"`
+	Reason     string       `json:"reason"`
+	Dimensions any          `json:"dimensions,omitempty"`
 }
 
 // 优化后的正则表达式，支持 score 和 reason 任意顺序，score 为 number 或 string 类型
@@ -404,6 +405,7 @@ func parseDirectJSON(ctx context.Context, content string, output *entity.Evaluat
 			}
 			output.EvaluatorResult.Score = &score
 			output.EvaluatorResult.Reasoning = outputMsg.Reason
+			output.EvaluatorResult.Dimensions = entity.ParseEvaluatorDimensions(outputMsg.Dimensions)
 			return true, nil
 		}
 	}
@@ -424,6 +426,7 @@ func parseRepairedJSON(ctx context.Context, content string, output *entity.Evalu
 				}
 				output.EvaluatorResult.Score = &score
 				output.EvaluatorResult.Reasoning = outputMsg.Reason
+				output.EvaluatorResult.Dimensions = entity.ParseEvaluatorDimensions(outputMsg.Dimensions)
 				return true, nil
 			}
 		}
@@ -448,6 +451,7 @@ func parseRegexExtractedJSON(ctx context.Context, content string, output *entity
 				}
 				output.EvaluatorResult.Score = &score
 				output.EvaluatorResult.Reasoning = outputMsg.Reason
+				output.EvaluatorResult.Dimensions = entity.ParseEvaluatorDimensions(outputMsg.Dimensions)
 				return true, nil
 			}
 		}
@@ -463,6 +467,7 @@ func parseRegexExtractedJSON(ctx context.Context, content string, output *entity
 					}
 					output.EvaluatorResult.Score = &score
 					output.EvaluatorResult.Reasoning = outputMsg.Reason
+					output.EvaluatorResult.Dimensions = entity.ParseEvaluatorDimensions(outputMsg.Dimensions)
 					return true, nil
 				}
 			}
@@ -603,6 +608,10 @@ func parseFunctionCallOutput(ctx context.Context, evaluatorVersion *entity.Promp
 		logs.CtxWarn(ctx, "[RunEvaluator] parseOutput fail, repairArgs: %v, err: reason not string", repairArgs)
 		return errorx.NewByCode(errno.InvalidOutputFromModelCode)
 	}
+	// dimensions 为可选字段，缺失或格式不符时忽略
+	if dimensionsFieldValue, err := json.ExtractFieldValue(params, repairArgs, "dimensions"); err == nil {
+		output.EvaluatorResult.Dimensions = entity.ParseEvaluatorDimensions(dimensionsFieldValue)
+	}
 	return nil
 }
 
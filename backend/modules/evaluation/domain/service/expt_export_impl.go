@@ -316,6 +316,8 @@ func (e ExptResultExportService) DoExportCSV(ctx context.Context, spaceID, exptI
 		allItemResults:    allItemResults,
 		columnsEvalTarget: columnsEvalTarget,
 		evalSetItemSvc:    e.evalSetItemSvc,
+
+		colEvaluatorDimensions: collectEvaluatorDimensionColumns(allItemResults),
 	}
 
 	err = exportHelper.exportCSV(ctx)
@@ -337,6 +339,8 @@ type exportCSVHelper struct {
 	colAnnotations    []*entity.ColumnAnnotation
 	allItemResults    []*entity.ItemResult
 	columnsEvalTarget []*entity.ColumnEvalTarget
+	// colEvaluatorDimensions 各评估器版本输出的维度列，key为evaluatorVersionID
+	colEvaluatorDimensions map[int64][]*evaluatorDimensionColumn
 
 	exptRepo           repo.IExperimentRepo
 	exptTurnResultRepo repo.IExptTurnResultRepo
@@ -405,6 +409,12 @@ func (e exportCSVHelper) buildColumns(ctx context.Context) ([]string, error) {
 
 		columns = append(columns, getColumnNameEvaluator(ptr.From(colEvaluator.Name), ptr.From(colEvaluator.Version)))
 		columns = append(columns, getColumnNameEvaluatorReason(ptr.From(colEvaluator.Name), ptr.From(colEvaluator.Version)))
+		for _, dimCol := range e.colEvaluatorDimensions[colEvaluator.EvaluatorVersionID] {
+			columns = append(columns, getColumnNameEvaluatorDimension(ptr.From(colEvaluator.Name), ptr.From(colEvaluator.Version), dimCol.name))
+			if dimCol.hasLabel {
+				columns = append(columns, getColumnNameEvaluatorDimensionLabel(ptr.From(colEvaluator.Name), ptr.From(colEvaluator.Version), dimCol.name))
+			}
+		}
 	}
 
 	// 加权得分列（如果有评估器，则添加加权得分列）
@@ -439,6 +449,58 @@ func getColumnNameEvaluatorReason(evaluatorName, version string) string {
 	return fmt.Sprintf("%s<%s>_reason", evaluatorName, version)
 }
 
+func getColumnNameEvaluatorDimension(evaluatorName, version, dimension string) string {
+	return fmt.Sprintf("%s<%s>[%s]", evaluatorName, version, dimension)
+}
+
+func getColumnNameEvaluatorDimensionLabel(evaluatorName, version, dimension string) string {
+	return fmt.Sprintf("%s<%s>[%s]_label", evaluatorName, version, dimension)
+}
+
+type evaluatorDimensionColumn struct {
+	name     string
+	hasLabel bool
+}
+
+// collectEvaluatorDimensionColumns 按首次出现的顺序收集各评估器版本输出的维度，维度列随结果动态生成
+func collectEvaluatorDimensionColumns(itemResults []*entity.ItemResult) map[int64][]*evaluatorDimensionColumn {
+	columns := make(map[int64][]*evaluatorDimensionColumn)
+	index := make(map[entity.EvaluatorDimensionKey]*evaluatorDimensionColumn)
+	for _, itemResult := range itemResults {
+		if itemResult == nil {
+			continue
+		}
+		for _, turnResult := range itemResult.TurnResults {
+			if turnResult == nil || len(turnResult.ExperimentResults) == 0 || turnResult.ExperimentResults[0] == nil {
+				continue
+			}
+			payload := turnResult.ExperimentResults[0].Payload
+			if payload == nil || payload.EvaluatorOutput == nil {
+				continue
+			}
+			for evaluatorVersionID, record := range payload.EvaluatorOutput.EvaluatorRecords {
+				if record == nil {
+					continue
+				}
+				for _, dim := range record.GetDimensions() {
+					if dim == nil || dim.Name == "" {
+						continue
+					}
+					key := entity.EvaluatorDimensionKey{EvaluatorVersionID: evaluatorVersionID, Name: dim.Name}
+					col, ok := index[key]
+					if !ok {
+						col = &evaluatorDimensionColumn{name: dim.Name}
+						index[key] = col
+						columns[evaluatorVersionID] = append(columns[evaluatorVersionID], col)
+					}
+					col.hasLabel = col.hasLabel || dim.Label != ""
+				}
+			}
+		}
+	}
+	return columns
+}
+
 func (e *exportCSVHelper) buildColumnEvalTargetContent(ctx context.Context, columnName string, data *entity.EvalTargetOutputData) (string, error) {
 	if data == nil {
 		return "", nil
@@ -525,6 +587,7 @@ func (e *exportCSVHelper) buildRows(ctx context.Context) ([][]string, error) {
 				evaluatorRecord := evaluatorRecords[colEvaluator.EvaluatorVersionID]
 				rowData = append(rowData, getEvaluatorScore(evaluatorRecord))
 				rowData = append(rowData, getEvaluatorReason(evaluatorRecord))
+				rowData = append(rowData, getEvaluatorDimensionData(evaluatorRecord, e.colEvaluatorDimensions[colEvaluator.EvaluatorVersionID])...)
 			}
 
 			// 加权得分（如果有评估器，则添加加权得分数据）
@@ -709,6 +772,35 @@ func getEvaluatorReason(record *entity.EvaluatorRecord) string {
 	return record.EvaluatorOutputData.EvaluatorResult.Reasoning
 }
 
+func getEvaluatorDimensionData(record *entity.EvaluatorRecord, dimCols []*evaluatorDimensionColumn) []string {
+	if len(dimCols) == 0 {
+		return nil
+	}
+	dims := make(map[string]*entity.EvaluatorDimensionResult)
+	if record != nil {
+		for _, dim := range record.GetDimensions() {
+			if dim != nil {
+				dims[dim.Name] = dim
+			}
+		}
+	}
+	data := make([]string, 0, len(dimCols)*2)
+	for _, col := range dimCols {
+		score, label := "", ""
+		if dim, ok := dims[col.name]; ok {
+			if dim.Score != nil {
+				score = strconv.FormatFloat(*dim.Score, 'f', 2, 64)
+			}
+			label = dim.Label
+		}
+		data = append(data, score)
+		if col.hasLabel {
+			data = append(data, label)
+		}
+	}
+	return data
+}
+
 func getAnnotationData(record *entity.AnnotateRecord, columnAnnotation *entity.ColumnAnnotation) string {
 	if record == nil || record.AnnotateData == nil {
 		return ""
@@ -189,6 +189,77 @@ func TestExportCSVHelper_buildRows_EvalTargetColumns(t *testing.T) {
 	})
 }
 
+func TestExportCSVHelper_EvaluatorDimensionColumns(t *testing.T) {
+	ctx := context.Background()
+
+	makeItem := func(itemID int64, records map[int64]*entity.EvaluatorRecord) *entity.ItemResult {
+		return &entity.ItemResult{
+			ItemID:     itemID,
+			SystemInfo: &entity.ItemSystemInfo{RunState: entity.ItemRunState_Success},
+			TurnResults: []*entity.TurnResult{{
+				TurnID: 1,
+				ExperimentResults: []*entity.ExperimentResult{{
+					ExperimentID: 100,
+					Payload: &entity.ExperimentTurnPayload{
+						EvalSet:         &entity.TurnEvalSet{Turn: &entity.Turn{FieldDataList: []*entity.FieldData{}}, ItemID: itemID, EvalSetID: 1},
+						EvaluatorOutput: &entity.TurnEvaluatorOutput{EvaluatorRecords: records},
+					},
+				}},
+			}},
+		}
+	}
+	makeRecord := func(score float64, dims ...*entity.EvaluatorDimensionResult) *entity.EvaluatorRecord {
+		return &entity.EvaluatorRecord{EvaluatorOutputData: &entity.EvaluatorOutputData{
+			EvaluatorResult: &entity.EvaluatorResult{Score: ptr.Of(score), Reasoning: "r", Dimensions: dims},
+		}}
+	}
+
+	// item 1 输出 accuracy（带 label）和 fluency；item 2 缺少这两个维度，额外输出 relevance；评估器 20 没有维度
+	items := []*entity.ItemResult{
+		makeItem(1, map[int64]*entity.EvaluatorRecord{
+			10: makeRecord(0.9,
+				&entity.EvaluatorDimensionResult{Name: "accuracy", Score: ptr.Of(1.0), Label: "good"},
+				&entity.EvaluatorDimensionResult{Name: "fluency", Score: ptr.Of(0.5)},
+			),
+			20: makeRecord(0.3),
+		}),
+		makeItem(2, map[int64]*entity.EvaluatorRecord{
+			10: makeRecord(0.8, &entity.EvaluatorDimensionResult{Name: "relevance", Score: ptr.Of(0.8)}),
+		}),
+	}
+
+	helper := &exportCSVHelper{
+		colEvaluators: []*entity.ColumnEvaluator{
+			{EvaluatorVersionID: 10, Name: ptr.Of("judge"), Version: ptr.Of("v1")},
+			{EvaluatorVersionID: 20, Name: ptr.Of("other"), Version: ptr.Of("v2")},
+		},
+		allItemResults:         items,
+		colEvaluatorDimensions: collectEvaluatorDimensionColumns(items),
+	}
+
+	columns, err := helper.buildColumns(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		columnNameID, columnNameStatus,
+		"judge<v1>", "judge<v1>_reason",
+		"judge<v1>[accuracy]", "judge<v1>[accuracy]_label",
+		"judge<v1>[fluency]",
+		"judge<v1>[relevance]",
+		"other<v2>", "other<v2>_reason",
+		columnNameWeightedScore,
+	}, columns)
+
+	rows, err := helper.buildRows(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, []string{"1", "success", "0.90", "r", "1.00", "good", "0.50", "", "0.30", "r", ""}, rows[0])
+		assert.Equal(t, []string{"2", "success", "0.80", "r", "", "", "", "0.80", "", "", ""}, rows[1])
+		for _, row := range rows {
+			assert.Len(t, row, len(columns))
+		}
+	}
+}
+
 func newTestExptResultExportService(ctrl *gomock.Controller) *ExptResultExportService {
 	return &ExptResultExportService{
 		txDB:               dbMocks.NewMockProvider(ctrl),
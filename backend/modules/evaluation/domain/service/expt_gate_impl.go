@@ -0,0 +1,180 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// EvaluateExptGate 逐条计算实验门禁规则，单条规则无法计算时记为 Error，不影响其他规则。未配置规则时返回 nil。
+func (e *ExptAggrResultServiceImpl) EvaluateExptGate(ctx context.Context, spaceID, exptID int64, conf *entity.ExptGateConf) *entity.ExptGateVerdict {
+	if conf.IsEmpty() {
+		return nil
+	}
+
+	g := &exptGateEvaluator{svc: e, spaceID: spaceID, exptID: exptID}
+	ruleVerdicts := make([]*entity.ExptGateRuleVerdict, 0, len(conf.Rules))
+	for _, rule := range conf.Rules {
+		if rule == nil {
+			continue
+		}
+		rv, err := g.evaluate(ctx, rule)
+		if err != nil {
+			logs.CtxWarn(ctx, "evaluate expt gate rule fail, expt_id: %v, rule_type: %v, err: %v", exptID, rule.RuleType, err)
+			rv = &entity.ExptGateRuleVerdict{Rule: rule, Status: entity.ExptGateStatus_Error, Message: err.Error()}
+		}
+		ruleVerdicts = append(ruleVerdicts, rv)
+	}
+
+	return entity.NewExptGateVerdict(ruleVerdicts, time.Now())
+}
+
+// exptGateEvaluator 缓存同一实验多条规则共用的得分数据
+type exptGateEvaluator struct {
+	svc     *ExptAggrResultServiceImpl
+	spaceID int64
+	exptID  int64
+
+	scores exptTurnScores
+}
+
+func (g *exptGateEvaluator) evaluate(ctx context.Context, rule *entity.ExptGateRule) (*entity.ExptGateRuleVerdict, error) {
+	switch rule.RuleType {
+	case entity.ExptGateRuleType_EvaluatorScore:
+		return g.evaluateEvaluatorScore(ctx, rule)
+	case entity.ExptGateRuleType_FailedTurnRate:
+		return g.evaluateFailedTurnRate(ctx, rule)
+	case entity.ExptGateRuleType_NoRegression:
+		return g.evaluateNoRegression(ctx, rule)
+	default:
+		return nil, fmt.Errorf("unknown rule type %q", rule.RuleType)
+	}
+}
+
+// evaluateEvaluatorScore 评估器在各 turn 上得分（人工修正优先）的平均值与阈值比较，无得分的 turn 不参与计算
+func (g *exptGateEvaluator) evaluateEvaluatorScore(ctx context.Context, rule *entity.ExptGateRule) (*entity.ExptGateRuleVerdict, error) {
+	if g.scores == nil {
+		scores, err := g.svc.getExptTurnScores(ctx, g.spaceID, g.exptID, nil)
+		if err != nil {
+			return nil, err
+		}
+		g.scores = scores
+	}
+
+	turnScores := g.scores[rule.EvaluatorVersionID]
+	if len(turnScores) == 0 {
+		return nil, fmt.Errorf("no score found for evaluator_version_id %d", rule.EvaluatorVersionID)
+	}
+	var sum float64
+	for _, score := range turnScores {
+		sum += score
+	}
+	avg := sum / float64(len(turnScores))
+
+	operator := rule.Operator
+	if operator == "" {
+		operator = entity.ExptGateOperator_GTE
+	}
+	return &entity.ExptGateRuleVerdict{
+		Rule:        rule,
+		Status:      gateStatus(operator.Compare(avg, rule.Threshold)),
+		ActualValue: gptr.Of(avg),
+		Message:     fmt.Sprintf("avg score %.4f over %d turns, expect %s %v", avg, len(turnScores), operator, rule.Threshold),
+	}, nil
+}
+
+// evaluateFailedTurnRate 未成功（失败、终止或未执行完成）的 turn 占比不超过阈值
+func (g *exptGateEvaluator) evaluateFailedTurnRate(ctx context.Context, rule *entity.ExptGateRule) (*entity.ExptGateRuleVerdict, error) {
+	const limit = int64(200)
+
+	var total, unsuccessful int64
+	var cursor int64
+	for i := 0; i < 10000; i++ {
+		turnResults, nextCursor, err := g.svc.exptTurnResultRepo.ScanTurnResults(ctx, g.exptID, nil, cursor, limit, g.spaceID)
+		if err != nil {
+			return nil, err
+		}
+		for _, tr := range turnResults {
+			total++
+			if tr.Status != int32(entity.TurnRunState_Success) {
+				unsuccessful++
+			}
+		}
+		if len(turnResults) == 0 || nextCursor == 0 || nextCursor == cursor {
+			break
+		}
+		cursor = nextCursor
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("no turn result found")
+	}
+
+	rate := float64(unsuccessful) / float64(total)
+	return &entity.ExptGateRuleVerdict{
+		Rule:        rule,
+		Status:      gateStatus(rate <= rule.Threshold),
+		ActualValue: gptr.Of(rate),
+		Message:     fmt.Sprintf("%d of %d turns unsuccessful, expect rate lte %v", unsuccessful, total, rule.Threshold),
+	}, nil
+}
+
+// evaluateNoRegression 与基准实验做配对显著性检验，任一评估器显著退化则不通过；样本不足的评估器不判定为退化
+func (g *exptGateEvaluator) evaluateNoRegression(ctx context.Context, rule *entity.ExptGateRule) (*entity.ExptGateRuleVerdict, error) {
+	results, err := g.svc.CompareExptAggrResults(ctx, &entity.ExptComparisonParam{
+		SpaceID:             g.spaceID,
+		BaseExptID:          rule.BaselineExptID,
+		CompareExptIDs:      []int64{g.exptID},
+		EvaluatorVersionIDs: rule.EvaluatorVersionIDs,
+		ConfidenceLevel:     rule.ConfidenceLevel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var compared int
+	var regressed, insufficient []int64
+	for _, result := range results {
+		for _, er := range result.EvaluatorResults {
+			compared++
+			switch er.Verdict {
+			case entity.ComparisonVerdict_Regression:
+				regressed = append(regressed, er.EvaluatorVersionID)
+			case entity.ComparisonVerdict_InsufficientData:
+				insufficient = append(insufficient, er.EvaluatorVersionID)
+			default:
+			}
+		}
+	}
+	if compared == 0 {
+		return nil, fmt.Errorf("no common evaluator score with baseline experiment %d", rule.BaselineExptID)
+	}
+
+	message := fmt.Sprintf("%d of %d evaluators significantly regressed vs baseline experiment %d", len(regressed), compared, rule.BaselineExptID)
+	if len(regressed) > 0 {
+		message += fmt.Sprintf(", regressed evaluator_version_ids: %v", regressed)
+	}
+	if len(insufficient) > 0 {
+		message += fmt.Sprintf(", insufficient data evaluator_version_ids: %v", insufficient)
+	}
+	return &entity.ExptGateRuleVerdict{
+		Rule:        rule,
+		Status:      gateStatus(len(regressed) == 0),
+		ActualValue: gptr.Of(float64(len(regressed))),
+		Message:     message,
+	}, nil
+}
+
+func gateStatus(pass bool) entity.ExptGateStatus {
+	if pass {
+		return entity.ExptGateStatus_Pass
+	}
+	return entity.ExptGateStatus_Fail
+}
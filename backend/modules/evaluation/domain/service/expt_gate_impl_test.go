@@ -0,0 +1,137 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func TestExptAggrResultServiceImpl_EvaluateExptGate(t *testing.T) {
+	const (
+		spaceID     = int64(100)
+		baseExptID  = int64(1)
+		exptID      = int64(2)
+		evaluatorID = int64(10)
+		itemCount   = 30
+	)
+	ctx := context.Background()
+
+	// 基准实验全部通过；当前实验前 12 个 turn 得分为 0，且第 1 个 turn 执行失败
+	turnResults := map[int64][]*entity.ExptTurnResult{}
+	refs := map[int64][]*entity.ExptTurnEvaluatorResultRef{}
+	records := map[int64]*entity.EvaluatorRecord{}
+	for _, id := range []int64{baseExptID, exptID} {
+		for i := 0; i < itemCount; i++ {
+			turnResultID := id*1000 + int64(i)
+			resultID := id*10000 + int64(i)
+			status := entity.TurnRunState_Success
+			score := float64(1)
+			if id == exptID && i < 12 {
+				score = 0
+				if i == 0 {
+					status = entity.TurnRunState_Fail
+				}
+			}
+			turnResults[id] = append(turnResults[id], &entity.ExptTurnResult{ID: turnResultID, ExptID: id, ItemID: int64(i), Status: int32(status)})
+			refs[id] = append(refs[id], &entity.ExptTurnEvaluatorResultRef{ExptTurnResultID: turnResultID, EvaluatorVersionID: evaluatorID, EvaluatorResultID: resultID, ExptID: id})
+			records[resultID] = &entity.EvaluatorRecord{
+				ID:                  resultID,
+				EvaluatorOutputData: &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(score)}},
+			}
+		}
+	}
+	mockData := func(mockTurnRepo *repoMocks.MockIExptTurnResultRepo, mockRecordSvc *svcMocks.MockEvaluatorRecordService) {
+		mockTurnRepo.EXPECT().ScanTurnResults(gomock.Any(), gomock.Any(), gomock.Nil(), int64(0), gomock.Any(), spaceID).DoAndReturn(
+			func(_ context.Context, id int64, _ []int32, _, _, _ int64) ([]*entity.ExptTurnResult, int64, error) {
+				return turnResults[id], 0, nil
+			}).AnyTimes()
+		mockTurnRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), spaceID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, id int64) ([]*entity.ExptTurnEvaluatorResultRef, error) {
+				return refs[id], nil
+			}).AnyTimes()
+		mockRecordSvc.EXPECT().BatchGetEvaluatorRecord(gomock.Any(), gomock.Any(), false).DoAndReturn(
+			func(_ context.Context, ids []int64, _ bool) ([]*entity.EvaluatorRecord, error) {
+				res := make([]*entity.EvaluatorRecord, 0, len(ids))
+				for _, id := range ids {
+					res = append(res, records[id])
+				}
+				return res, nil
+			}).AnyTimes()
+	}
+
+	t.Run("no rule", func(t *testing.T) {
+		svc := &ExptAggrResultServiceImpl{}
+		assert.Nil(t, svc.EvaluateExptGate(ctx, spaceID, exptID, nil))
+		assert.Nil(t, svc.EvaluateExptGate(ctx, spaceID, exptID, &entity.ExptGateConf{}))
+	})
+
+	t.Run("all rule types", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockTurnRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
+		mockRecordSvc := svcMocks.NewMockEvaluatorRecordService(ctrl)
+		mockData(mockTurnRepo, mockRecordSvc)
+		svc := &ExptAggrResultServiceImpl{exptTurnResultRepo: mockTurnRepo, evaluatorRecordService: mockRecordSvc}
+
+		verdict := svc.EvaluateExptGate(ctx, spaceID, exptID, &entity.ExptGateConf{Rules: []*entity.ExptGateRule{
+			{RuleType: entity.ExptGateRuleType_EvaluatorScore, EvaluatorVersionID: evaluatorID, Threshold: 0.5},
+			{RuleType: entity.ExptGateRuleType_EvaluatorScore, EvaluatorVersionID: evaluatorID, Operator: entity.ExptGateOperator_GT, Threshold: 0.6},
+			{RuleType: entity.ExptGateRuleType_EvaluatorScore, EvaluatorVersionID: 99, Threshold: 0.5},
+			{RuleType: entity.ExptGateRuleType_FailedTurnRate, Threshold: 0.05},
+			{RuleType: entity.ExptGateRuleType_FailedTurnRate, Threshold: 0.02},
+			{RuleType: entity.ExptGateRuleType_NoRegression, BaselineExptID: baseExptID},
+		}})
+		require.NotNil(t, verdict)
+		require.Len(t, verdict.RuleVerdicts, 6)
+		assert.Equal(t, entity.ExptGateStatus_Fail, verdict.Status)
+
+		statuses := make([]entity.ExptGateStatus, 0, len(verdict.RuleVerdicts))
+		for _, rv := range verdict.RuleVerdicts {
+			statuses = append(statuses, rv.Status)
+		}
+		assert.Equal(t, []entity.ExptGateStatus{
+			entity.ExptGateStatus_Pass,
+			entity.ExptGateStatus_Fail,
+			entity.ExptGateStatus_Error,
+			entity.ExptGateStatus_Pass,
+			entity.ExptGateStatus_Fail,
+			entity.ExptGateStatus_Fail,
+		}, statuses)
+		assert.InDelta(t, 0.6, gptr.Indirect(verdict.RuleVerdicts[0].ActualValue), 1e-9)
+		assert.Nil(t, verdict.RuleVerdicts[2].ActualValue)
+		assert.InDelta(t, 1.0/itemCount, gptr.Indirect(verdict.RuleVerdicts[3].ActualValue), 1e-9)
+		assert.Equal(t, float64(1), gptr.Indirect(verdict.RuleVerdicts[5].ActualValue))
+	})
+
+	t.Run("rule error does not block others", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockTurnRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
+		svc := &ExptAggrResultServiceImpl{exptTurnResultRepo: mockTurnRepo}
+
+		mockTurnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Nil(), int64(0), gomock.Any(), spaceID).Return(nil, int64(0), errors.New("db err"))
+		verdict := svc.EvaluateExptGate(ctx, spaceID, exptID, &entity.ExptGateConf{Rules: []*entity.ExptGateRule{
+			{RuleType: entity.ExptGateRuleType_FailedTurnRate, Threshold: 0.05},
+			// 基准实验与当前实验相同，参数校验失败
+			{RuleType: entity.ExptGateRuleType_NoRegression, BaselineExptID: exptID},
+		}})
+		require.NotNil(t, verdict)
+		assert.Equal(t, entity.ExptGateStatus_Error, verdict.Status)
+		for _, rv := range verdict.RuleVerdicts {
+			assert.Equal(t, entity.ExptGateStatus_Error, rv.Status)
+			assert.NotEmpty(t, rv.Message)
+		}
+	})
+}
@@ -617,9 +617,50 @@ func (e *ExptMangerImpl) afterCompleteExpt(ctx context.Context, expt *entity.Exp
 		return nil
 	}
 
+	e.completeExptGate(ctx, expt)
+
 	return e.sendExptNotify(ctx, expt)
 }
 
+// completeExptGate 计算门禁结论并存储到实验上，同时发布门禁事件供 CI 等外部系统订阅。失败仅记录日志，不影响实验完成
+func (e *ExptMangerImpl) completeExptGate(ctx context.Context, expt *entity.Experiment) {
+	gateConf := expt.GetGateConf()
+	if gateConf.IsEmpty() {
+		return
+	}
+
+	verdict := e.exptAggrResultService.EvaluateExptGate(ctx, expt.SpaceID, expt.ID, gateConf)
+	if verdict == nil {
+		return
+	}
+	expt.GateVerdict = verdict
+	logs.CtxInfo(ctx, "[ExptEval] expt gate evaluated, expt_id: %v, verdict: %v", expt.ID, json.Jsonify(verdict))
+
+	bytes, err := json.Marshal(verdict)
+	if err != nil {
+		logs.CtxError(ctx, "[ExptEval] marshal expt gate verdict fail, expt_id: %v, err: %v", expt.ID, err)
+		return
+	}
+	if err := e.exptRepo.UpdateFields(ctx, expt.ID, map[string]any{"gate_result": bytes}); err != nil {
+		logs.CtxError(ctx, "[ExptEval] update expt gate verdict fail, expt_id: %v, err: %v", expt.ID, err)
+	}
+
+	event := &entity.ExptGateVerdictEvent{
+		ExperimentID: expt.ID,
+		SpaceID:      expt.SpaceID,
+		ExptName:     expt.Name,
+		ExptStatus:   expt.Status,
+		Passed:       verdict.IsPassed(),
+		Verdict:      verdict,
+	}
+	if expt.ExptTemplateMeta != nil {
+		event.ExptTemplateID = expt.ExptTemplateMeta.ID
+	}
+	if err := e.publisher.PublishExptGateVerdictEvent(ctx, event, nil); err != nil {
+		logs.CtxError(ctx, "[ExptEval] publish expt gate verdict event fail, expt_id: %v, err: %v", expt.ID, err)
+	}
+}
+
 func (e *ExptMangerImpl) sendExptNotify(ctx context.Context, expt *entity.Experiment) error {
 	logs.CtxInfo(ctx, "sendExptNotify, expt: %v", expt)
 
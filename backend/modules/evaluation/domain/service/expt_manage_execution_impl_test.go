@@ -2055,6 +2055,81 @@ func TestExptMangerImpl_CompleteExpt(t *testing.T) {
 	}
 }
 
+func TestExptMangerImpl_completeExptGate(t *testing.T) {
+	ctx := context.Background()
+	gateConf := &entity.ExptGateConf{Rules: []*entity.ExptGateRule{
+		{RuleType: entity.ExptGateRuleType_FailedTurnRate, Threshold: 0.02},
+	}}
+	newExpt := func(conf *entity.ExptGateConf) *entity.Experiment {
+		return &entity.Experiment{
+			ID:               123,
+			SpaceID:          789,
+			Name:             "expt",
+			Status:           entity.ExptStatus_Success,
+			EvalConf:         &entity.EvaluationConfiguration{GateConf: conf},
+			ExptTemplateMeta: &entity.ExptTemplateMeta{ID: 5},
+		}
+	}
+
+	t.Run("no gate rule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mgr := newTestExptManager(ctrl)
+		expt := newExpt(nil)
+		mgr.completeExptGate(ctx, expt)
+		assert.Nil(t, expt.GateVerdict)
+	})
+
+	t.Run("verdict stored and published", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mgr := newTestExptManager(ctrl)
+		expt := newExpt(gateConf)
+		verdict := &entity.ExptGateVerdict{Status: entity.ExptGateStatus_Fail, EvaluatedAt: 1}
+
+		mgr.exptAggrResultService.(*svcMocks.MockExptAggrResultService).EXPECT().
+			EvaluateExptGate(ctx, int64(789), int64(123), gateConf).Return(verdict)
+		mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().
+			UpdateFields(ctx, int64(123), gomock.Any()).DoAndReturn(func(_ context.Context, _ int64, ufields map[string]any) error {
+			assert.Contains(t, ufields, "gate_result")
+			return nil
+		})
+		mgr.publisher.(*eventsMocks.MockExptEventPublisher).EXPECT().
+			PublishExptGateVerdictEvent(ctx, &entity.ExptGateVerdictEvent{
+				ExperimentID:   123,
+				SpaceID:        789,
+				ExptName:       "expt",
+				ExptTemplateID: 5,
+				ExptStatus:     entity.ExptStatus_Success,
+				Passed:         false,
+				Verdict:        verdict,
+			}, gomock.Nil()).Return(nil)
+
+		mgr.completeExptGate(ctx, expt)
+		assert.Equal(t, verdict, expt.GateVerdict)
+	})
+
+	t.Run("store failure still publishes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mgr := newTestExptManager(ctrl)
+		expt := newExpt(gateConf)
+
+		mgr.exptAggrResultService.(*svcMocks.MockExptAggrResultService).EXPECT().
+			EvaluateExptGate(ctx, int64(789), int64(123), gateConf).Return(&entity.ExptGateVerdict{Status: entity.ExptGateStatus_Pass})
+		mgr.exptRepo.(*repoMocks.MockIExperimentRepo).EXPECT().
+			UpdateFields(ctx, int64(123), gomock.Any()).Return(errors.New("db err"))
+		mgr.publisher.(*eventsMocks.MockExptEventPublisher).EXPECT().
+			PublishExptGateVerdictEvent(ctx, gomock.Any(), gomock.Nil()).DoAndReturn(
+			func(_ context.Context, event *entity.ExptGateVerdictEvent, _ *time.Duration) error {
+				assert.True(t, event.Passed)
+				return nil
+			})
+
+		mgr.completeExptGate(ctx, expt)
+	})
+}
+
 func TestExptMangerImpl_SetExptTerminating(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
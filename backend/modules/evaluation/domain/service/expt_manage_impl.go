@@ -605,6 +605,9 @@ func (e *ExptMangerImpl) CreateExpt(ctx context.Context, req *entity.CreateExptP
 				Ext:       req.CreateEvalTargetParam.CustomEvalTarget.Ext,
 			}))
 		}
+		if req.CreateEvalTargetParam.OpenAIChat != nil {
+			opts = append(opts, entity.WithOpenAIChat(req.CreateEvalTargetParam.OpenAIChat))
+		}
 		targetID, targetVersionID, err := e.evalTargetService.CreateEvalTarget(ctx, req.WorkspaceID, gptr.Indirect(req.CreateEvalTargetParam.SourceTargetID), gptr.Indirect(req.CreateEvalTargetParam.SourceTargetVersion), gptr.Indirect(req.CreateEvalTargetParam.EvalTargetType),
 			opts...)
 		if err != nil {
@@ -41,4 +41,8 @@ type ExptAggrResultService interface {
 	CreateAnnotationAggrResult(ctx context.Context, param *entity.CreateSpecificFieldAggrResultParam) error
 	UpdateAnnotationAggrResult(ctx context.Context, param *entity.UpdateExptAggrResultParam) (err error)
 	PublishExptAggrResultEvent(ctx context.Context, event *entity.AggrCalculateEvent, duration *time.Duration) error
+	// Pair turn scores across experiments by item/turn and run significance tests per evaluator version.
+	CompareExptAggrResults(ctx context.Context, param *entity.ExptComparisonParam) ([]*entity.ExptComparisonResult, error)
+	// Evaluate gate rules against turn scores and the optional baseline experiment; returns nil when no rule is configured.
+	EvaluateExptGate(ctx context.Context, spaceID, exptID int64, conf *entity.ExptGateConf) *entity.ExptGateVerdict
 }
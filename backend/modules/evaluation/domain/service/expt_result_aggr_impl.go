@@ -94,6 +94,7 @@ func (e *ExptAggrResultServiceImpl) CreateExptAggrResult(ctx context.Context, sp
 	}
 
 	evaluatorVersionID2AggregatorGroup := make(map[int64]*AggregatorGroup)
+	dimension2AggregatorGroup := make(map[entity.EvaluatorDimensionKey]*AggregatorGroup)
 	if len(turnEvaluatorResultRefs) > 0 {
 		evaluatorResultIDs := make([]int64, 0)
 		evaluatorVersionID2ResultIDs := make(map[int64][]int64)
@@ -123,8 +124,11 @@ func (e *ExptAggrResultServiceImpl) CreateExptAggrResult(ctx context.Context, sp
 					continue
 				}
 				if evalResult.EvaluatorOutputData == nil ||
-					evalResult.EvaluatorOutputData.EvaluatorResult == nil ||
-					evalResult.EvaluatorOutputData.EvaluatorResult.Score == nil {
+					evalResult.EvaluatorOutputData.EvaluatorResult == nil {
+					continue
+				}
+				appendEvaluatorDimensionScores(dimension2AggregatorGroup, evaluatorVersionID, evalResult.GetDimensions())
+				if evalResult.EvaluatorOutputData.EvaluatorResult.Score == nil {
 					continue
 				}
 
@@ -138,7 +142,23 @@ func (e *ExptAggrResultServiceImpl) CreateExptAggrResult(ctx context.Context, sp
 		return err
 	}
 
-	return e.CreateOrUpdateExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup, tmag, existed)
+	return e.CreateOrUpdateExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup, dimension2AggregatorGroup, tmag, existed)
+}
+
+// appendEvaluatorDimensionScores 将评估记录中各维度的得分追加到对应维度的聚合器
+func appendEvaluatorDimensionScores(dimension2AggregatorGroup map[entity.EvaluatorDimensionKey]*AggregatorGroup, evaluatorVersionID int64, dimensions []*entity.EvaluatorDimensionResult) {
+	for _, dim := range dimensions {
+		if dim == nil || dim.Name == "" || dim.Score == nil {
+			continue
+		}
+		key := entity.EvaluatorDimensionKey{EvaluatorVersionID: evaluatorVersionID, Name: dim.Name}
+		aggregatorGroup, ok := dimension2AggregatorGroup[key]
+		if !ok {
+			aggregatorGroup = NewAggregatorGroup(WithScoreDistributionAggregator())
+			dimension2AggregatorGroup[key] = aggregatorGroup
+		}
+		aggregatorGroup.Append(*dim.Score)
+	}
 }
 
 func (e *ExptAggrResultServiceImpl) buildExptTargetMtrAggregatorGroup(ctx context.Context, spaceID, exptID int64) (*targetMtrAggrGroup, error) {
@@ -188,7 +208,8 @@ func (e *ExptAggrResultServiceImpl) buildExptTargetMtrAggregatorGroup(ctx contex
 }
 
 func (e *ExptAggrResultServiceImpl) CreateOrUpdateExptAggrResult(ctx context.Context, spaceID, experimentID int64,
-	evaluatorVersionID2AggregatorGroup map[int64]*AggregatorGroup, tmag *targetMtrAggrGroup, existedAggrResults []*entity.ExptAggrResult,
+	evaluatorVersionID2AggregatorGroup map[int64]*AggregatorGroup, dimension2AggregatorGroup map[entity.EvaluatorDimensionKey]*AggregatorGroup,
+	tmag *targetMtrAggrGroup, existedAggrResults []*entity.ExptAggrResult,
 ) error {
 	aggrResKeyFn := func(fieldType int32, fieldKey string) string { return fmt.Sprintf("%d:%s", fieldType, fieldKey) }
 	existedAggrResultsMap := gslice.ToMap(existedAggrResults, func(val *entity.ExptAggrResult) (string, *entity.ExptAggrResult) {
@@ -220,6 +241,30 @@ func (e *ExptAggrResultServiceImpl) CreateOrUpdateExptAggrResult(ctx context.Con
 		})
 	}
 
+	for dimensionKey, aggregatorGroup := range dimension2AggregatorGroup {
+		aggrResult := aggregatorGroup.Result()
+		var averageScore float64
+		for _, aggregatorResult := range aggrResult.AggregatorResults {
+			if aggregatorResult.AggregatorType == entity.Average {
+				averageScore = aggregatorResult.GetScore()
+				break
+			}
+		}
+		aggrResultBytes, err := json.Marshal(aggrResult)
+		if err != nil {
+			return err
+		}
+		aggrResults = append(aggrResults, &entity.ExptAggrResult{
+			SpaceID:      spaceID,
+			ExperimentID: experimentID,
+			FieldType:    int32(entity.FieldType_EvaluatorDimensionScore),
+			FieldKey:     dimensionKey.FieldKey(),
+			Score:        utils.RoundScoreToTwoDecimals(averageScore),
+			AggrResult:   aggrResultBytes,
+			Version:      0,
+		})
+	}
+
 	// 追加"加权得分"聚合指标（FieldType_WeightedScore）：
 	// 基于行级 WeightedScore 做聚合（加权评分的聚合），而不是对各评估器聚合结果再加权。
 	experiment, err := e.experimentRepo.GetByID(ctx, experimentID, spaceID)
@@ -556,6 +601,7 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 	results := make([]*entity.ExptAggregateResult, 0, len(expt2AggrResults))
 	for exptID, exptResult := range expt2AggrResults {
 		evaluatorResults := make(map[int64]*entity.EvaluatorAggregateResult)
+		dimensionResults := make(map[int64][]*entity.EvaluatorDimensionAggregateResult)
 		annotationResults := make(map[int64]*entity.AnnotationAggregateResult)
 		targetResults := &entity.EvalTargetMtrAggrResult{
 			TargetID:        versionedTargetIDMap[exptID].TargetID,
@@ -614,6 +660,19 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 					Version:            gptr.Of(evaluator.GetVersion()),
 				}
 				evaluatorResults[evaluatorVersionID] = &evaluatorAggrResult
+			case int32(entity.FieldType_EvaluatorDimensionScore):
+				dimensionKey, err := entity.ParseEvaluatorDimensionFieldKey(fieldResult.FieldKey)
+				if err != nil {
+					return nil, err
+				}
+				aggregateResultDO := entity.AggregateResult{}
+				if err := json.Unmarshal(fieldResult.AggrResult, &aggregateResultDO); err != nil {
+					return nil, fmt.Errorf("json.Unmarshal(%s) failed, err: %v", fieldResult.AggrResult, err)
+				}
+				dimensionResults[dimensionKey.EvaluatorVersionID] = append(dimensionResults[dimensionKey.EvaluatorVersionID], &entity.EvaluatorDimensionAggregateResult{
+					Name:              dimensionKey.Name,
+					AggregatorResults: aggregateResultDO.AggregatorResults,
+				})
 			case int32(entity.FieldType_WeightedScore):
 				aggregateResultDO := entity.AggregateResult{}
 				if err := json.Unmarshal(fieldResult.AggrResult, &aggregateResultDO); err != nil {
@@ -648,11 +707,27 @@ func (e *ExptAggrResultServiceImpl) BatchGetExptAggrResultByExperimentIDs(ctx co
 				}
 				targetResults.TotalTokensAggrResults = gslice.Clone(ar.AggregatorResults)
 
+			case int32(entity.FieldType_TargetTotalCost):
+				ar := entity.AggregateResult{}
+				if err := json.Unmarshal(fieldResult.AggrResult, &ar); err != nil {
+					return nil, errorx.Wrapf(err, "AggregateResult json.Unmarshal failed, raw: %v", string(fieldResult.AggrResult))
+				}
+				targetResults.TotalCostAggrResults = gslice.Clone(ar.AggregatorResults)
+
 			default:
 
 			}
 		}
 
+		for evaluatorVersionID, dims := range dimensionResults {
+			evaluatorAggrResult, ok := evaluatorResults[evaluatorVersionID]
+			if !ok {
+				continue
+			}
+			sort.Slice(dims, func(i, j int) bool { return dims[i].Name < dims[j].Name })
+			evaluatorAggrResult.DimensionResults = dims
+		}
+
 		exptAgg := &entity.ExptAggregateResult{
 			ExperimentID:      exptID,
 			EvaluatorResults:  evaluatorResults,
@@ -1112,6 +1187,7 @@ type targetMtrAggrGroup struct {
 	inputTokens  *AggregatorGroup
 	outputTokens *AggregatorGroup
 	totalTokens  *AggregatorGroup
+	totalCost    *AggregatorGroup
 }
 
 func (t *targetMtrAggrGroup) calcRecord(records []*entity.EvalTargetRecord) {
@@ -1125,6 +1201,13 @@ func (t *targetMtrAggrGroup) calcRecord(records []*entity.EvalTargetRecord) {
 			t.inputTokens.Append(float64(record.EvalTargetOutputData.EvalTargetUsage.InputTokens))
 			t.outputTokens.Append(float64(record.EvalTargetOutputData.EvalTargetUsage.OutputTokens))
 			t.totalTokens.Append(float64(record.EvalTargetOutputData.EvalTargetUsage.TotalTokens))
+			// 只有命中价格表的记录有费用，没有费用时不生成聚合结果，Sum即为实验的总费用
+			if cost := record.EvalTargetOutputData.EvalTargetUsage.GetTotalCost(); cost != nil {
+				if t.totalCost == nil {
+					t.totalCost = NewAggregatorGroup()
+				}
+				t.totalCost.Append(*cost)
+			}
 		}
 	}
 }
@@ -1172,6 +1255,7 @@ func (t *targetMtrAggrGroup) buildAggrResult(spaceID, exptID int64) ([]*entity.E
 		{entity.FieldType_TargetInputTokens, entity.AggrResultFieldKey_TargetInputTokens, t.inputTokens},
 		{entity.FieldType_TargetOutputTokens, entity.AggrResultFieldKey_TargetOutputTokens, t.outputTokens},
 		{entity.FieldType_TargetTotalTokens, entity.AggrResultFieldKey_TargetTotalTokens, t.totalTokens},
+		{entity.FieldType_TargetTotalCost, entity.AggrResultFieldKey_TargetTotalCost, t.totalCost},
 	} {
 		if err := builder(cfg.fieldType, cfg.fieldKey, cfg.aggr); err != nil {
 			return nil, err
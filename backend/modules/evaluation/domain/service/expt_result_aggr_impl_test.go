@@ -192,6 +192,130 @@ func TestExptAggrResultServiceImpl_CreateExptAggrResult(t *testing.T) {
 	}
 }
 
+func TestExptAggrResultServiceImpl_CreateExptAggrResult_WithDimensions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExptTurnResultRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
+	mockExptAggrResultRepo := repoMocks.NewMockIExptAggrResultRepo(ctrl)
+	mockEvaluatorRecordService := svcMocks.NewMockEvaluatorRecordService(ctrl)
+	mockMetric := metricsMocks.NewMockExptMetric(ctrl)
+	mockLocker := lockMocks.NewMockILocker(ctrl)
+	mockExperimentRepo := repoMocks.NewMockIExperimentRepo(ctrl)
+
+	svc := &ExptAggrResultServiceImpl{
+		exptTurnResultRepo:     mockExptTurnResultRepo,
+		exptAggrResultRepo:     mockExptAggrResultRepo,
+		experimentRepo:         mockExperimentRepo,
+		evaluatorRecordService: mockEvaluatorRecordService,
+		metric:                 mockMetric,
+		evalTargetSvc:          svcMocks.NewMockIEvalTargetService(ctrl),
+		locker:                 mockLocker,
+	}
+
+	// 三个 turn：turn 2 缺少 fluency 维度，turn 3 只有总分没有维度，nil 得分与空名维度不参与聚合
+	mockExptTurnResultRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), int64(100), int64(1)).
+		Return([]*entity.ExptTurnEvaluatorResultRef{
+			{EvaluatorResultID: 1, EvaluatorVersionID: 10},
+			{EvaluatorResultID: 2, EvaluatorVersionID: 10},
+			{EvaluatorResultID: 3, EvaluatorVersionID: 10},
+		}, nil)
+	mockEvaluatorRecordService.EXPECT().BatchGetEvaluatorRecord(gomock.Any(), []int64{1, 2, 3}, false).
+		Return([]*entity.EvaluatorRecord{
+			{ID: 1, EvaluatorOutputData: &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{
+				Score: gptr.Of(0.8),
+				Dimensions: []*entity.EvaluatorDimensionResult{
+					{Name: "accuracy", Score: gptr.Of(1.0)},
+					{Name: "fluency", Score: gptr.Of(0.4)},
+					{Name: "", Score: gptr.Of(1.0)},
+				},
+			}}},
+			{ID: 2, EvaluatorOutputData: &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{
+				Score: gptr.Of(0.6),
+				Dimensions: []*entity.EvaluatorDimensionResult{
+					{Name: "accuracy", Score: gptr.Of(0.5)},
+					{Name: "fluency"},
+				},
+			}}},
+			{ID: 3, EvaluatorOutputData: &entity.EvaluatorOutputData{EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(0.4)}}},
+		}, nil)
+	mockExptAggrResultRepo.EXPECT().GetExptAggrResultByExperimentID(gomock.Any(), int64(1)).Return(nil, nil)
+	mockExptTurnResultRepo.EXPECT().ScanTurnResults(gomock.Any(), int64(1), gomock.Any(), int64(0), int64(50), int64(100)).
+		Return([]*entity.ExptTurnResult{}, int64(0), nil)
+	mockExperimentRepo.EXPECT().GetByID(gomock.Any(), int64(1), int64(100)).Return((*entity.Experiment)(nil), nil)
+	mockMetric.EXPECT().EmitCalculateExptAggrResult(int64(100), int64(entity.CreateAllFields), false, gomock.Any())
+	mockLocker.EXPECT().Unlock(gomock.Any()).Return(true, nil)
+
+	var created []*entity.ExptAggrResult
+	mockExptAggrResultRepo.EXPECT().BatchCreateExptAggrResult(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, results []*entity.ExptAggrResult) error {
+			created = results
+			return nil
+		})
+
+	assert.NoError(t, svc.CreateExptAggrResult(context.Background(), 100, 1))
+
+	scores := make(map[string]float64)
+	counts := make(map[string]int64)
+	for _, ar := range created {
+		if ar.FieldType != int32(entity.FieldType_EvaluatorScore) && ar.FieldType != int32(entity.FieldType_EvaluatorDimensionScore) {
+			continue
+		}
+		key := fmt.Sprintf("%d:%s", ar.FieldType, ar.FieldKey)
+		scores[key] = ar.Score
+		aggrResult := &entity.AggregateResult{}
+		assert.NoError(t, json.Unmarshal(ar.AggrResult, aggrResult))
+		for _, r := range aggrResult.AggregatorResults {
+			if r.AggregatorType == entity.Distribution && r.Data != nil && r.Data.ScoreDistribution != nil {
+				for _, item := range r.Data.ScoreDistribution.ScoreDistributionItems {
+					counts[key] += item.Count
+				}
+			}
+		}
+	}
+
+	accuracyKey := fmt.Sprintf("%d:%s", entity.FieldType_EvaluatorDimensionScore, entity.EvaluatorDimensionKey{EvaluatorVersionID: 10, Name: "accuracy"}.FieldKey())
+	fluencyKey := fmt.Sprintf("%d:%s", entity.FieldType_EvaluatorDimensionScore, entity.EvaluatorDimensionKey{EvaluatorVersionID: 10, Name: "fluency"}.FieldKey())
+	assert.Equal(t, map[string]float64{
+		fmt.Sprintf("%d:10", entity.FieldType_EvaluatorScore): 0.6,
+		accuracyKey: 0.75,
+		fluencyKey:  0.4,
+	}, scores)
+	assert.Equal(t, int64(2), counts[accuracyKey])
+	assert.Equal(t, int64(1), counts[fluencyKey])
+}
+
+func TestAppendEvaluatorDimensionScores(t *testing.T) {
+	groups := make(map[entity.EvaluatorDimensionKey]*AggregatorGroup)
+	appendEvaluatorDimensionScores(groups, 10, nil)
+	assert.Empty(t, groups)
+
+	appendEvaluatorDimensionScores(groups, 10, []*entity.EvaluatorDimensionResult{
+		{Name: "accuracy", Score: gptr.Of(1.0)},
+		nil,
+		{Name: "fluency"},
+	})
+	// 不同评估器版本的同名维度分开聚合
+	appendEvaluatorDimensionScores(groups, 20, []*entity.EvaluatorDimensionResult{{Name: "accuracy", Score: gptr.Of(0.2)}})
+	appendEvaluatorDimensionScores(groups, 10, []*entity.EvaluatorDimensionResult{{Name: "accuracy", Score: gptr.Of(0.5)}})
+
+	assert.Len(t, groups, 2)
+	average := func(key entity.EvaluatorDimensionKey) float64 {
+		group, ok := groups[key]
+		if !assert.True(t, ok, key) {
+			return 0
+		}
+		for _, r := range group.Result().AggregatorResults {
+			if r.AggregatorType == entity.Average {
+				return r.GetScore()
+			}
+		}
+		return 0
+	}
+	assert.InDelta(t, 0.75, average(entity.EvaluatorDimensionKey{EvaluatorVersionID: 10, Name: "accuracy"}), 1e-9)
+	assert.InDelta(t, 0.2, average(entity.EvaluatorDimensionKey{EvaluatorVersionID: 20, Name: "accuracy"}), 1e-9)
+}
+
 func TestExptAggrResultServiceImpl_UpdateExptAggrResult(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1388,7 +1512,7 @@ func TestExptAggrResultServiceImpl_CreateOrUpdateExptAggrResult(t *testing.T) {
 
 			tt.setup(mockExptAggrResultRepo)
 
-			err := svc.CreateOrUpdateExptAggrResult(context.Background(), tt.spaceID, tt.exptID, tt.evaluatorVersionID2AggregatorGroup, tt.tmag, tt.existedAggrResults)
+			err := svc.CreateOrUpdateExptAggrResult(context.Background(), tt.spaceID, tt.exptID, tt.evaluatorVersionID2AggregatorGroup, nil, tt.tmag, tt.existedAggrResults)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -1442,6 +1566,41 @@ func TestTargetMtrAggrGroup_calcRecord(t *testing.T) {
 				assert.NotNil(t, tg.totalTokens)
 			},
 		},
+		{
+			name: "Sum cost of records with cost",
+			records: []*entity.EvalTargetRecord{
+				{
+					EvalTargetOutputData: &entity.EvalTargetOutputData{
+						EvalTargetUsage: &entity.EvalTargetUsage{TotalCost: gptr.Of(0.5)},
+					},
+				},
+				{
+					EvalTargetOutputData: &entity.EvalTargetOutputData{
+						EvalTargetUsage: &entity.EvalTargetUsage{TotalCost: gptr.Of(0.25)},
+					},
+				},
+				{
+					EvalTargetOutputData: &entity.EvalTargetOutputData{
+						EvalTargetUsage: &entity.EvalTargetUsage{InputTokens: 10},
+					},
+				},
+			},
+			setup: func(tg *targetMtrAggrGroup) {
+				tg.latency = NewAggregatorGroup()
+				tg.inputTokens = NewAggregatorGroup()
+				tg.outputTokens = NewAggregatorGroup()
+				tg.totalTokens = NewAggregatorGroup()
+			},
+			checkFunc: func(t *testing.T, tg *targetMtrAggrGroup) {
+				if assert.NotNil(t, tg.totalCost) {
+					for _, ar := range tg.totalCost.Result().AggregatorResults {
+						if ar.AggregatorType == entity.Sum {
+							assert.Equal(t, 0.75, ar.GetScore())
+						}
+					}
+				}
+			},
+		},
 		{
 			name:    "Empty records",
 			records: []*entity.EvalTargetRecord{},
@@ -2819,7 +2978,7 @@ func TestExptAggrResultServiceImpl_CreateOrUpdateExptAggrResult_WithWeightedScor
 				return nil
 			})
 
-		err := svc.CreateOrUpdateExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup, tmag, existedAggrResults)
+		err := svc.CreateOrUpdateExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup, nil, tmag, existedAggrResults)
 		assert.NoError(t, err)
 	})
 
@@ -2864,7 +3023,7 @@ func TestExptAggrResultServiceImpl_CreateOrUpdateExptAggrResult_WithWeightedScor
 				return nil
 			})
 
-		err := svc.CreateOrUpdateExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup, tmag, existedAggrResults)
+		err := svc.CreateOrUpdateExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup, nil, tmag, existedAggrResults)
 		assert.NoError(t, err)
 	})
 
@@ -2910,7 +3069,7 @@ func TestExptAggrResultServiceImpl_CreateOrUpdateExptAggrResult_WithWeightedScor
 			).
 			Return(nil, int64(0), errors.New("scan error"))
 
-		err := svc.CreateOrUpdateExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup, tmag, existedAggrResults)
+		err := svc.CreateOrUpdateExptAggrResult(ctx, spaceID, experimentID, evaluatorVersionID2AggregatorGroup, nil, tmag, existedAggrResults)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "scan error")
 	})
@@ -0,0 +1,276 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/bytedance/gg/gslice"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/stats"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// exptTurnScores evaluator_version_id -> item/turn -> score
+type exptTurnScores map[int64]map[entity.ItemTurnID]float64
+
+// CompareExptAggrResults 将对比实验与基准实验的评估器得分按 item_id + turn_id 配对，逐评估器版本进行显著性检验。
+// 得分越高越好：显著下降判定为 Regression，显著上升判定为 Improvement。
+func (e *ExptAggrResultServiceImpl) CompareExptAggrResults(ctx context.Context, param *entity.ExptComparisonParam) ([]*entity.ExptComparisonResult, error) {
+	if err := validateExptComparisonParam(param); err != nil {
+		return nil, err
+	}
+
+	var versionFilter map[int64]bool
+	if len(param.EvaluatorVersionIDs) > 0 {
+		versionFilter = gslice.ToMap(param.EvaluatorVersionIDs, func(id int64) (int64, bool) { return id, true })
+	}
+
+	baseScores, err := e.getExptTurnScores(ctx, param.SpaceID, param.BaseExptID, versionFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*entity.ExptComparisonResult, 0, len(param.CompareExptIDs))
+	for _, compareExptID := range param.CompareExptIDs {
+		compareScores, err := e.getExptTurnScores(ctx, param.SpaceID, compareExptID, versionFilter)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &entity.ExptComparisonResult{
+			BaseExptID:       param.BaseExptID,
+			CompareExptID:    compareExptID,
+			EvaluatorResults: compareExptTurnScores(baseScores, compareScores, param.ConfidenceLevel, param.BaseExptID^compareExptID),
+		})
+	}
+
+	return results, nil
+}
+
+func validateExptComparisonParam(param *entity.ExptComparisonParam) error {
+	if param == nil || param.BaseExptID <= 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("base experiment id is required"))
+	}
+	if len(param.CompareExptIDs) == 0 || len(param.CompareExptIDs) > entity.MaxCompareExptNum {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid compare experiment count"))
+	}
+	if gslice.Contains(param.CompareExptIDs, param.BaseExptID) {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("compare experiments must not contain the base experiment"))
+	}
+	if param.ConfidenceLevel == 0 {
+		param.ConfidenceLevel = entity.DefaultComparisonConfidenceLevel
+	}
+	if param.ConfidenceLevel <= 0 || param.ConfidenceLevel >= 1 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("confidence level must be in (0, 1)"))
+	}
+	return nil
+}
+
+// getExptTurnScores 获取实验每个 turn 在各评估器版本上的得分，人工修正后的得分优先
+func (e *ExptAggrResultServiceImpl) getExptTurnScores(ctx context.Context, spaceID, exptID int64, versionFilter map[int64]bool) (exptTurnScores, error) {
+	const limit = int64(200)
+
+	turnResultID2ItemTurn := make(map[int64]entity.ItemTurnID)
+	var cursor int64
+	for i := 0; i < 10000; i++ {
+		turnResults, nextCursor, err := e.exptTurnResultRepo.ScanTurnResults(ctx, exptID, nil, cursor, limit, spaceID)
+		if err != nil {
+			return nil, err
+		}
+		for _, tr := range turnResults {
+			turnResultID2ItemTurn[tr.ID] = entity.ItemTurnID{ItemID: tr.ItemID, TurnID: tr.TurnID}
+		}
+		if len(turnResults) == 0 || nextCursor == 0 || nextCursor == cursor {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	refs, err := e.exptTurnResultRepo.GetTurnEvaluatorResultRefByExptID(ctx, spaceID, exptID)
+	if err != nil {
+		return nil, err
+	}
+
+	type scoreRef struct {
+		evaluatorVersionID int64
+		itemTurn           entity.ItemTurnID
+	}
+	resultID2Ref := make(map[int64]scoreRef, len(refs))
+	evaluatorResultIDs := make([]int64, 0, len(refs))
+	for _, ref := range refs {
+		if versionFilter != nil && !versionFilter[ref.EvaluatorVersionID] {
+			continue
+		}
+		itemTurn, ok := turnResultID2ItemTurn[ref.ExptTurnResultID]
+		if !ok {
+			continue
+		}
+		resultID2Ref[ref.EvaluatorResultID] = scoreRef{evaluatorVersionID: ref.EvaluatorVersionID, itemTurn: itemTurn}
+		evaluatorResultIDs = append(evaluatorResultIDs, ref.EvaluatorResultID)
+	}
+
+	scores := make(exptTurnScores)
+	for _, ids := range gslice.Chunk(evaluatorResultIDs, 200) {
+		records, err := e.evaluatorRecordService.BatchGetEvaluatorRecord(ctx, ids, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			if record == nil || record.EvaluatorOutputData == nil || record.EvaluatorOutputData.EvaluatorResult == nil {
+				continue
+			}
+			ref, ok := resultID2Ref[record.ID]
+			if !ok {
+				continue
+			}
+			result := record.EvaluatorOutputData.EvaluatorResult
+			score := result.Score
+			if result.Correction != nil && result.Correction.Score != nil {
+				score = result.Correction.Score
+			}
+			if score == nil {
+				continue
+			}
+			if scores[ref.evaluatorVersionID] == nil {
+				scores[ref.evaluatorVersionID] = make(map[entity.ItemTurnID]float64)
+			}
+			scores[ref.evaluatorVersionID][ref.itemTurn] = gptr.Indirect(score)
+		}
+	}
+
+	logs.CtxInfo(ctx, "getExptTurnScores done, expt_id: %v, turn_cnt: %v, evaluator_cnt: %v", exptID, len(turnResultID2ItemTurn), len(scores))
+	return scores, nil
+}
+
+// compareExptTurnScores 对两个实验共同的评估器版本逐一做配对检验，结果按评估器版本 ID 排序
+func compareExptTurnScores(base, compare exptTurnScores, confidenceLevel float64, seed int64) []*entity.EvaluatorComparisonResult {
+	evaluatorVersionIDs := make([]int64, 0, len(base))
+	for evaluatorVersionID := range base {
+		if _, ok := compare[evaluatorVersionID]; ok {
+			evaluatorVersionIDs = append(evaluatorVersionIDs, evaluatorVersionID)
+		}
+	}
+	sort.Slice(evaluatorVersionIDs, func(i, j int) bool { return evaluatorVersionIDs[i] < evaluatorVersionIDs[j] })
+
+	results := make([]*entity.EvaluatorComparisonResult, 0, len(evaluatorVersionIDs))
+	for _, evaluatorVersionID := range evaluatorVersionIDs {
+		baseVals, compareVals := pairTurnScores(base[evaluatorVersionID], compare[evaluatorVersionID])
+		result := compareScores(baseVals, compareVals, confidenceLevel, rand.New(rand.NewSource(seed^evaluatorVersionID)))
+		result.EvaluatorVersionID = evaluatorVersionID
+		results = append(results, result)
+	}
+	return results
+}
+
+// pairTurnScores 按 item_id + turn_id 取两侧均有得分的 turn，按 item/turn 排序保证结果稳定
+func pairTurnScores(base, compare map[entity.ItemTurnID]float64) (baseVals, compareVals []float64) {
+	keys := make([]entity.ItemTurnID, 0, len(base))
+	for key := range base {
+		if _, ok := compare[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ItemID != keys[j].ItemID {
+			return keys[i].ItemID < keys[j].ItemID
+		}
+		return keys[i].TurnID < keys[j].TurnID
+	})
+
+	baseVals = make([]float64, 0, len(keys))
+	compareVals = make([]float64, 0, len(keys))
+	for _, key := range keys {
+		baseVals = append(baseVals, base[key])
+		compareVals = append(compareVals, compare[key])
+	}
+	return baseVals, compareVals
+}
+
+// compareScores 计算配对样本的检验结果。
+// 0/1 得分以 McNemar 作为判定依据，其余以配对 t 检验作为判定依据；Bootstrap 与 Wilcoxon 作为补充参考。
+func compareScores(baseVals, compareVals []float64, confidenceLevel float64, rng *rand.Rand) *entity.EvaluatorComparisonResult {
+	n := len(baseVals)
+	result := &entity.EvaluatorComparisonResult{
+		PairedCount: int64(n),
+		BaseMean:    stats.Mean(baseVals),
+		CompareMean: stats.Mean(compareVals),
+		Verdict:     entity.ComparisonVerdict_InsufficientData,
+	}
+	result.MeanDiff = result.CompareMean - result.BaseMean
+	if n < 2 {
+		return result
+	}
+
+	alpha := 1 - confidenceLevel
+	diffs := make([]float64, n)
+	binary := true
+	var baseOnly, compareOnly int64
+	for i := range baseVals {
+		diffs[i] = compareVals[i] - baseVals[i]
+		if !isBinaryScore(baseVals[i]) || !isBinaryScore(compareVals[i]) {
+			binary = false
+		}
+		if baseVals[i] > compareVals[i] {
+			baseOnly++
+		} else if baseVals[i] < compareVals[i] {
+			compareOnly++
+		}
+	}
+	result.Binary = binary
+
+	var decisive *entity.SignificanceTestResult
+	if res, ok := stats.BootstrapMeanCI(diffs, confidenceLevel, entity.DefaultBootstrapIterations, rng); ok {
+		result.TestResults = append(result.TestResults, &entity.SignificanceTestResult{
+			TestType:    entity.SignificanceTestType_Bootstrap,
+			Statistic:   result.MeanDiff,
+			PValue:      res.PValue,
+			Significant: res.Lower > 0 || res.Upper < 0,
+			CILower:     gptr.Of(res.Lower),
+			CIUpper:     gptr.Of(res.Upper),
+		})
+	}
+	if res, ok := stats.PairedTTest(diffs); ok {
+		tr := newSignificanceTestResult(entity.SignificanceTestType_PairedTTest, res, alpha)
+		result.TestResults = append(result.TestResults, tr)
+		decisive = tr
+	}
+	if res, ok := stats.WilcoxonSignedRank(diffs); ok {
+		result.TestResults = append(result.TestResults, newSignificanceTestResult(entity.SignificanceTestType_Wilcoxon, res, alpha))
+	}
+	if binary {
+		tr := newSignificanceTestResult(entity.SignificanceTestType_McNemar, stats.McNemar(baseOnly, compareOnly), alpha)
+		result.TestResults = append(result.TestResults, tr)
+		decisive = tr
+	}
+
+	switch {
+	case decisive == nil:
+	case !decisive.Significant || result.MeanDiff == 0:
+		result.Verdict = entity.ComparisonVerdict_NoSignificantChange
+	case result.MeanDiff < 0:
+		result.Verdict = entity.ComparisonVerdict_Regression
+	default:
+		result.Verdict = entity.ComparisonVerdict_Improvement
+	}
+	return result
+}
+
+func newSignificanceTestResult(testType entity.SignificanceTestType, res stats.TestResult, alpha float64) *entity.SignificanceTestResult {
+	return &entity.SignificanceTestResult{
+		TestType:    testType,
+		Statistic:   res.Statistic,
+		PValue:      res.PValue,
+		Significant: res.PValue < alpha,
+	}
+}
+
+func isBinaryScore(score float64) bool {
+	return score == 0 || score == 1
+}
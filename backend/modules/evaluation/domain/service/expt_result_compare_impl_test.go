@@ -0,0 +1,149 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repoMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcMocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+)
+
+func TestExptAggrResultServiceImpl_CompareExptAggrResults(t *testing.T) {
+	const (
+		spaceID     = int64(100)
+		baseExptID  = int64(1)
+		cmpExptID   = int64(2)
+		evaluatorID = int64(10)
+		itemCount   = 30
+	)
+
+	// 每个实验 itemCount 个 turn，基准实验全部通过，对比实验前 12 个未通过
+	mockExpt := func(mockTurnRepo *repoMocks.MockIExptTurnResultRepo, mockRecordSvc *svcMocks.MockEvaluatorRecordService, exptID int64, score func(i int) float64) {
+		turnResults := make([]*entity.ExptTurnResult, 0, itemCount)
+		refs := make([]*entity.ExptTurnEvaluatorResultRef, 0, itemCount)
+		records := make([]*entity.EvaluatorRecord, 0, itemCount)
+		for i := 0; i < itemCount; i++ {
+			turnResultID := exptID*1000 + int64(i)
+			resultID := exptID*10000 + int64(i)
+			turnResults = append(turnResults, &entity.ExptTurnResult{ID: turnResultID, ExptID: exptID, ItemID: int64(i), TurnID: 0})
+			refs = append(refs, &entity.ExptTurnEvaluatorResultRef{ExptTurnResultID: turnResultID, EvaluatorVersionID: evaluatorID, EvaluatorResultID: resultID, ExptID: exptID})
+			records = append(records, &entity.EvaluatorRecord{
+				ID: resultID,
+				EvaluatorOutputData: &entity.EvaluatorOutputData{
+					EvaluatorResult: &entity.EvaluatorResult{Score: gptr.Of(score(i))},
+				},
+			})
+		}
+		mockTurnRepo.EXPECT().ScanTurnResults(gomock.Any(), exptID, gomock.Nil(), int64(0), gomock.Any(), spaceID).Return(turnResults, int64(0), nil)
+		mockTurnRepo.EXPECT().GetTurnEvaluatorResultRefByExptID(gomock.Any(), spaceID, exptID).Return(refs, nil)
+		mockRecordSvc.EXPECT().BatchGetEvaluatorRecord(gomock.Any(), gomock.Any(), false).Return(records, nil)
+	}
+
+	t.Run("binary regression", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockTurnRepo := repoMocks.NewMockIExptTurnResultRepo(ctrl)
+		mockRecordSvc := svcMocks.NewMockEvaluatorRecordService(ctrl)
+		svc := &ExptAggrResultServiceImpl{exptTurnResultRepo: mockTurnRepo, evaluatorRecordService: mockRecordSvc}
+
+		mockExpt(mockTurnRepo, mockRecordSvc, baseExptID, func(i int) float64 { return 1 })
+		mockExpt(mockTurnRepo, mockRecordSvc, cmpExptID, func(i int) float64 {
+			if i < 12 {
+				return 0
+			}
+			return 1
+		})
+
+		results, err := svc.CompareExptAggrResults(context.Background(), &entity.ExptComparisonParam{
+			SpaceID:        spaceID,
+			BaseExptID:     baseExptID,
+			CompareExptIDs: []int64{cmpExptID},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Len(t, results[0].EvaluatorResults, 1)
+
+		er := results[0].EvaluatorResults[0]
+		assert.Equal(t, evaluatorID, er.EvaluatorVersionID)
+		assert.Equal(t, int64(itemCount), er.PairedCount)
+		assert.True(t, er.Binary)
+		assert.InDelta(t, -0.4, er.MeanDiff, 1e-9)
+		assert.Equal(t, entity.ComparisonVerdict_Regression, er.Verdict)
+
+		testTypes := make([]entity.SignificanceTestType, 0, len(er.TestResults))
+		for _, tr := range er.TestResults {
+			testTypes = append(testTypes, tr.TestType)
+		}
+		assert.Equal(t, []entity.SignificanceTestType{
+			entity.SignificanceTestType_Bootstrap,
+			entity.SignificanceTestType_PairedTTest,
+			entity.SignificanceTestType_Wilcoxon,
+			entity.SignificanceTestType_McNemar,
+		}, testTypes)
+	})
+
+	t.Run("invalid param", func(t *testing.T) {
+		svc := &ExptAggrResultServiceImpl{}
+		_, err := svc.CompareExptAggrResults(context.Background(), &entity.ExptComparisonParam{BaseExptID: baseExptID})
+		assert.Error(t, err)
+		_, err = svc.CompareExptAggrResults(context.Background(), &entity.ExptComparisonParam{BaseExptID: baseExptID, CompareExptIDs: []int64{baseExptID}})
+		assert.Error(t, err)
+		_, err = svc.CompareExptAggrResults(context.Background(), &entity.ExptComparisonParam{BaseExptID: baseExptID, CompareExptIDs: []int64{cmpExptID}, ConfidenceLevel: 1.5})
+		assert.Error(t, err)
+	})
+}
+
+func TestCompareScores(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	t.Run("insufficient data", func(t *testing.T) {
+		res := compareScores([]float64{0.5}, []float64{0.6}, 0.95, rng)
+		assert.Equal(t, entity.ComparisonVerdict_InsufficientData, res.Verdict)
+		assert.Empty(t, res.TestResults)
+	})
+
+	t.Run("continuous improvement", func(t *testing.T) {
+		base := []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.2, 0.3, 0.4, 0.1, 0.2}
+		compare := make([]float64, len(base))
+		for i := range base {
+			compare[i] = base[i] + 0.3 + float64(i%3)*0.01
+		}
+		res := compareScores(base, compare, 0.95, rng)
+		assert.False(t, res.Binary)
+		assert.Equal(t, entity.ComparisonVerdict_Improvement, res.Verdict)
+		assert.Len(t, res.TestResults, 3)
+	})
+
+	t.Run("noise", func(t *testing.T) {
+		base := []float64{0.5, 0.6, 0.7, 0.4, 0.5, 0.6}
+		compare := []float64{0.6, 0.5, 0.6, 0.5, 0.4, 0.7}
+		res := compareScores(base, compare, 0.95, rng)
+		assert.Equal(t, entity.ComparisonVerdict_NoSignificantChange, res.Verdict)
+	})
+}
+
+func TestPairTurnScores(t *testing.T) {
+	base := map[entity.ItemTurnID]float64{
+		{ItemID: 2, TurnID: 1}: 0.2,
+		{ItemID: 1, TurnID: 1}: 0.1,
+		{ItemID: 3, TurnID: 1}: 0.3,
+	}
+	compare := map[entity.ItemTurnID]float64{
+		{ItemID: 1, TurnID: 1}: 1.1,
+		{ItemID: 2, TurnID: 1}: 1.2,
+		{ItemID: 4, TurnID: 1}: 1.4,
+	}
+	baseVals, compareVals := pairTurnScores(base, compare)
+	assert.Equal(t, []float64{0.1, 0.2}, baseVals)
+	assert.Equal(t, []float64{1.1, 1.2}, compareVals)
+}
@@ -78,6 +78,19 @@ func (e *ExptTemplateManagerImpl) Create(ctx context.Context, param *entity.Crea
 		return nil, err
 	}
 
+	// 调度配置、门禁配置合并到 TemplateConf 中存储，未传入的配置保持 TemplateConf 中原有的值
+	if param.Schedule != nil || param.GateConf != nil {
+		if param.TemplateConf == nil {
+			param.TemplateConf = &entity.ExptTemplateConfiguration{}
+		}
+		if param.Schedule != nil {
+			param.TemplateConf.Schedule = param.Schedule
+		}
+		if param.GateConf != nil {
+			param.TemplateConf.GateConf = param.GateConf
+		}
+	}
+
 	// 验证模板配置
 	if param.TemplateConf != nil {
 		if err := param.TemplateConf.Valid(ctx); err != nil {
@@ -99,6 +112,9 @@ func (e *ExptTemplateManagerImpl) Create(ctx context.Context, param *entity.Crea
 	if err != nil {
 		return nil, err
 	}
+	if err := validateScheduleTarget(param.Schedule, targetType); err != nil {
+		return nil, err
+	}
 
 	// 构建模板实体
 	now := time.Now()
@@ -252,6 +268,12 @@ func (e *ExptTemplateManagerImpl) Update(ctx context.Context, param *entity.Upda
 			return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
 		}
 	}
+	if err := param.Schedule.Valid(); err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if err := param.GateConf.Valid(); err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
 
 	// 从 EvaluatorIDVersionItems 构建 evaluatorVersionRefs
 	evaluatorVersionRefs := e.buildEvaluatorVersionRefs(param.EvaluatorIDVersionItems)
@@ -288,6 +310,9 @@ func (e *ExptTemplateManagerImpl) Update(ctx context.Context, param *entity.Upda
 				Ext:       param.CreateEvalTargetParam.CustomEvalTarget.Ext,
 			}))
 		}
+		if param.CreateEvalTargetParam.OpenAIChat != nil {
+			opts = append(opts, entity.WithOpenAIChat(param.CreateEvalTargetParam.OpenAIChat))
+		}
 		targetID, targetVersionID, err := e.evalTargetService.CreateEvalTarget(ctx, param.SpaceID, sourceTargetID, gptr.Indirect(param.CreateEvalTargetParam.SourceTargetVersion), gptr.Indirect(param.CreateEvalTargetParam.EvalTargetType), opts...)
 		if err != nil {
 			return nil, errorx.Wrapf(err, "CreateEvalTarget failed, param: %v", param.CreateEvalTargetParam)
@@ -376,6 +401,33 @@ func (e *ExptTemplateManagerImpl) Update(ctx context.Context, param *entity.Upda
 		updatedTemplate.TemplateConf = existingTemplate.TemplateConf
 	}
 
+	// 未传调度配置时保持原有调度配置
+	schedule := param.Schedule
+	if schedule == nil {
+		schedule = existingTemplate.GetSchedule()
+	}
+	if schedule != nil {
+		if updatedTemplate.TemplateConf == nil {
+			updatedTemplate.TemplateConf = &entity.ExptTemplateConfiguration{}
+		}
+		updatedTemplate.TemplateConf.Schedule = schedule
+	}
+	if err := validateScheduleTarget(schedule, targetType); err != nil {
+		return nil, err
+	}
+
+	// 未传门禁配置时保持原有门禁配置
+	gateConf := param.GateConf
+	if gateConf == nil {
+		gateConf = existingTemplate.GetGateConf()
+	}
+	if gateConf != nil {
+		if updatedTemplate.TemplateConf == nil {
+			updatedTemplate.TemplateConf = &entity.ExptTemplateConfiguration{}
+		}
+		updatedTemplate.TemplateConf.GateConf = gateConf
+	}
+
 	// 从 TemplateConf 构建 FieldMappingConfig，并根据 EvaluatorConf.ScoreWeight 设置是否启用分数权重
 	e.buildFieldMappingConfigAndEnableScoreWeight(updatedTemplate, updatedTemplate.TemplateConf)
 
@@ -537,6 +589,14 @@ func (e *ExptTemplateManagerImpl) UpdateExptInfo(ctx context.Context, templateID
 	return nil
 }
 
+// validateScheduleTarget 校验版本变更触发仅用于 Prompt 评测对象
+func validateScheduleTarget(schedule *entity.ExptTemplateSchedule, targetType entity.EvalTargetType) error {
+	if schedule == nil || !schedule.TriggerOnTargetChange || targetType == entity.EvalTargetTypeLoopPrompt {
+		return nil
+	}
+	return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("trigger_on_target_change only supports prompt eval target"))
+}
+
 func (e *ExptTemplateManagerImpl) Delete(ctx context.Context, templateID, spaceID int64, session *entity.Session) error {
 	return e.templateRepo.Delete(ctx, templateID, spaceID)
 }
@@ -803,6 +863,9 @@ func (e *ExptTemplateManagerImpl) resolveTargetForCreate(ctx context.Context, pa
 				Ext:       param.CreateEvalTargetParam.CustomEvalTarget.Ext,
 			}))
 		}
+		if param.CreateEvalTargetParam.OpenAIChat != nil {
+			opts = append(opts, entity.WithOpenAIChat(param.CreateEvalTargetParam.OpenAIChat))
+		}
 		targetID, targetVersionID, err := e.evalTargetService.CreateEvalTarget(ctx, param.SpaceID, gptr.Indirect(param.CreateEvalTargetParam.SourceTargetID), gptr.Indirect(param.CreateEvalTargetParam.SourceTargetVersion), gptr.Indirect(param.CreateEvalTargetParam.EvalTargetType), opts...)
 		if err != nil {
 			return 0, 0, 0, errorx.Wrapf(err, "CreateEvalTarget failed, param: %v", param.CreateEvalTargetParam)
@@ -169,6 +169,58 @@ func TestExptTemplateManagerImpl_Create_Success(t *testing.T) {
 	assert.Equal(t, "u1", got.GetCreatedBy())
 }
 
+func TestExptTemplateManagerImpl_Create_KeepScheduleWithGateConfOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repo_mocks.NewMockIExptTemplateRepo(ctrl)
+	mockIdgen := idgenmocks.NewMockIIDGenerator(ctrl)
+	mockEvalSvc := svcmocks.NewMockEvaluatorService(ctrl)
+	mockTargetSvc := svcmocks.NewMockIEvalTargetService(ctrl)
+	mockEvalSetSvc := svcmocks.NewMockIEvaluationSetService(ctrl)
+	mockEvalSetVerSvc := svcmocks.NewMockEvaluationSetVersionService(ctrl)
+	mockLWT := lwtmocks.NewMockILatestWriteTracker(ctrl)
+
+	mgr := &ExptTemplateManagerImpl{
+		templateRepo:                mockRepo,
+		idgen:                       mockIdgen,
+		evaluatorService:            mockEvalSvc,
+		evalTargetService:           mockTargetSvc,
+		evaluationSetService:        mockEvalSetSvc,
+		evaluationSetVersionService: mockEvalSetVerSvc,
+		lwt:                         mockLWT,
+	}
+
+	ctx := context.Background()
+	schedule := &entity.ExptTemplateSchedule{Enabled: true, Cron: "0 * * * *", Timezone: "UTC"}
+	gateConf := &entity.ExptGateConf{Rules: []*entity.ExptGateRule{
+		{RuleType: entity.ExptGateRuleType_FailedTurnRate, Threshold: 0.02},
+	}}
+	param := newBasicCreateParam()
+	// 调度配置随 TemplateConf 传入，只单独传入门禁配置
+	param.TemplateConf = &entity.ExptTemplateConfiguration{Schedule: schedule}
+	param.GateConf = gateConf
+
+	mockRepo.EXPECT().GetByName(ctx, param.Name, param.SpaceID).Return(nil, false, nil)
+	mockIdgen.EXPECT().GenID(ctx).Return(int64(10001), nil)
+	mockEvalSetVerSvc.EXPECT().BatchGetEvaluationSetVersions(gomock.Any(), gptr.Of(param.SpaceID), gomock.Any(), gptr.Of(false)).Return(nil, nil).AnyTimes()
+	mockEvalSetSvc.EXPECT().BatchGetEvaluationSets(gomock.Any(), gptr.Of(param.SpaceID), gomock.Any(), gptr.Of(false)).Return(nil, nil).AnyTimes()
+	mockTargetSvc.EXPECT().BatchGetEvalTargetVersion(gomock.Any(), param.SpaceID, gomock.Any(), true).Return(nil, nil).AnyTimes()
+	mockEvalSvc.EXPECT().BatchGetEvaluatorVersion(gomock.Any(), nil, gomock.Any(), true).Return(nil, nil).AnyTimes()
+	mockRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, template *entity.ExptTemplate, _ []*entity.ExptTemplateEvaluatorRef) error {
+			assert.Equal(t, schedule, template.GetSchedule())
+			assert.Equal(t, gateConf, template.GetGateConf())
+			return nil
+		})
+	mockLWT.EXPECT().SetWriteFlag(ctx, platestwrite.ResourceTypeExptTemplate, int64(10001)).AnyTimes()
+
+	got, err := mgr.Create(ctx, param, &entity.Session{UserID: "u1"})
+	assert.NoError(t, err)
+	assert.Equal(t, schedule, got.GetSchedule())
+	assert.Equal(t, gateConf, got.GetGateConf())
+}
+
 func TestExptTemplateManagerImpl_MGet_UseWriteDBOnSingleWithFlag(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
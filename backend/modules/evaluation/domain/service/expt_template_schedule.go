@@ -0,0 +1,22 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+//go:generate  mockgen -destination  ./mocks/expt_template_schedule.go  --package mocks . IExptTemplateScheduleService
+type IExptTemplateScheduleService interface {
+	// ListScheduled 跨空间扫描开启调度的模板，nextCursor 为 0 表示扫描结束
+	ListScheduled(ctx context.Context, cursor int64, limit int) (templates []*entity.ExptTemplate, nextCursor int64, err error)
+	// ResolveTrackedPromptVersion 解析调度跟踪的 Prompt 提交版本：配置了标签时为标签指向的版本，否则为最新提交版本
+	ResolveTrackedPromptVersion(ctx context.Context, template *entity.ExptTemplate) (promptID int64, version string, err error)
+	// AdvanceSchedule 在提交实验前推进调度游标，确保同一触发点只会提交一次
+	AdvanceSchedule(ctx context.Context, templateID, spaceID int64, record *entity.ExptScheduleRecord) error
+	// RecordRun 写入调度记录，返回超出保留数量、需要删除的调度实验ID
+	RecordRun(ctx context.Context, templateID, spaceID int64, record *entity.ExptScheduleRecord) (expiredExptIDs []int64, err error)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+const (
+	promptLabelPageSize = 50
+	// promptLabelMaxPages 查找标签时最多翻页数，标签通常指向较新的提交版本
+	promptLabelMaxPages = 10
+)
+
+func NewExptTemplateScheduleService(
+	templateRepo repo.IExptTemplateRepo,
+	evalTargetService IEvalTargetService,
+	promptRPCAdapter rpc.IPromptRPCAdapter,
+) IExptTemplateScheduleService {
+	return &ExptTemplateScheduleServiceImpl{
+		templateRepo:      templateRepo,
+		evalTargetService: evalTargetService,
+		promptRPCAdapter:  promptRPCAdapter,
+	}
+}
+
+type ExptTemplateScheduleServiceImpl struct {
+	templateRepo      repo.IExptTemplateRepo
+	evalTargetService IEvalTargetService
+	promptRPCAdapter  rpc.IPromptRPCAdapter
+}
+
+func (e *ExptTemplateScheduleServiceImpl) ListScheduled(ctx context.Context, cursor int64, limit int) ([]*entity.ExptTemplate, int64, error) {
+	templates, nextCursor, err := e.templateRepo.ScanByCursor(ctx, cursor, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	scheduled := make([]*entity.ExptTemplate, 0)
+	for _, template := range templates {
+		if template.GetSchedule().IsActive() {
+			scheduled = append(scheduled, template)
+		}
+	}
+	return scheduled, nextCursor, nil
+}
+
+func (e *ExptTemplateScheduleServiceImpl) ResolveTrackedPromptVersion(ctx context.Context, template *entity.ExptTemplate) (int64, string, error) {
+	if template.GetTargetType() != entity.EvalTargetTypeLoopPrompt {
+		return 0, "", errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("only prompt eval target can be tracked"))
+	}
+	target, err := e.evalTargetService.GetEvalTarget(ctx, template.GetTargetID())
+	if err != nil {
+		return 0, "", err
+	}
+	if target == nil {
+		return 0, "", errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("eval target %d not found", template.GetTargetID())))
+	}
+	promptID, err := strconv.ParseInt(target.SourceTargetID, 10, 64)
+	if err != nil {
+		return 0, "", errorx.Wrapf(err, "invalid prompt id %s", target.SourceTargetID)
+	}
+
+	spaceID := template.GetSpaceID()
+	label := template.GetSchedule().PromptLabel
+	if label == "" {
+		prompt, err := e.promptRPCAdapter.GetPrompt(ctx, spaceID, promptID, rpc.GetPromptParams{})
+		if err != nil {
+			return 0, "", err
+		}
+		if prompt == nil || prompt.PromptBasic == nil || gptr.Indirect(prompt.PromptBasic.LatestVersion) == "" {
+			return 0, "", errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("prompt %d has no committed version", promptID)))
+		}
+		return promptID, gptr.Indirect(prompt.PromptBasic.LatestVersion), nil
+	}
+
+	var cursor *string
+	for page := 0; page < promptLabelMaxPages; page++ {
+		commits, nextCursor, err := e.promptRPCAdapter.ListPromptVersion(ctx, &rpc.ListPromptVersionParam{
+			PromptID: promptID,
+			SpaceID:  gptr.Of(spaceID),
+			Cursor:   cursor,
+			PageSize: gptr.Of(int32(promptLabelPageSize)),
+		})
+		if err != nil {
+			return 0, "", err
+		}
+		for _, commit := range commits {
+			for _, l := range commit.Labels {
+				if l == label {
+					return promptID, gptr.Indirect(commit.Version), nil
+				}
+			}
+		}
+		if nextCursor == "" {
+			break
+		}
+		cursor = gptr.Of(nextCursor)
+	}
+	return 0, "", errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("label %s not found on prompt %d", label, promptID)))
+}
+
+func (e *ExptTemplateScheduleServiceImpl) AdvanceSchedule(ctx context.Context, templateID, spaceID int64, record *entity.ExptScheduleRecord) error {
+	return e.updateScheduleState(ctx, templateID, spaceID, func(state *entity.ExptScheduleState, _ *entity.ExptTemplate) {
+		state.Advance(record)
+	})
+}
+
+func (e *ExptTemplateScheduleServiceImpl) RecordRun(ctx context.Context, templateID, spaceID int64, record *entity.ExptScheduleRecord) ([]int64, error) {
+	var expiredExptIDs []int64
+	err := e.updateScheduleState(ctx, templateID, spaceID, func(state *entity.ExptScheduleState, template *entity.ExptTemplate) {
+		retentionCount := 0
+		if schedule := template.GetSchedule(); schedule != nil {
+			retentionCount = schedule.RetentionCount
+		}
+		expiredExptIDs = state.Apply(record, retentionCount)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expiredExptIDs, nil
+}
+
+// updateScheduleState 读改写 schedule_state 字段，不影响 expt_info 中的实验运行状态
+func (e *ExptTemplateScheduleServiceImpl) updateScheduleState(ctx context.Context, templateID, spaceID int64, update func(state *entity.ExptScheduleState, template *entity.ExptTemplate)) error {
+	template, err := e.templateRepo.GetByID(ctx, templateID, &spaceID)
+	if err != nil {
+		return errorx.Wrapf(err, "get template fail, template_id: %d", templateID)
+	}
+	if template == nil {
+		return errorx.NewByCode(errno.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("template %d not found", templateID)))
+	}
+
+	state := template.ScheduleState
+	if state == nil {
+		state = &entity.ExptScheduleState{}
+	}
+	update(state, template)
+
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return errorx.Wrapf(err, "marshal ExptScheduleState fail, template_id: %d", templateID)
+	}
+	if err := e.templateRepo.UpdateFields(ctx, templateID, map[string]any{"schedule_state": stateBytes}); err != nil {
+		return errorx.Wrapf(err, "update schedule state fail, template_id: %d", templateID)
+	}
+	return nil
+}
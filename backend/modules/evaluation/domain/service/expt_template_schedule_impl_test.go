@@ -0,0 +1,185 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	repo_mocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/repo/mocks"
+	svcmocks "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/service/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func newScheduledTemplate(id int64, schedule *entity.ExptTemplateSchedule) *entity.ExptTemplate {
+	return &entity.ExptTemplate{
+		Meta: &entity.ExptTemplateMeta{ID: id, WorkspaceID: 100},
+		TripleConfig: &entity.ExptTemplateTuple{
+			TargetID:   10,
+			TargetType: entity.EvalTargetTypeLoopPrompt,
+		},
+		TemplateConf: &entity.ExptTemplateConfiguration{Schedule: schedule},
+	}
+}
+
+func TestExptTemplateScheduleServiceImpl_ListScheduled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repo_mocks.NewMockIExptTemplateRepo(ctrl)
+	svc := &ExptTemplateScheduleServiceImpl{templateRepo: mockRepo}
+	ctx := context.Background()
+
+	active := newScheduledTemplate(2, &entity.ExptTemplateSchedule{Enabled: true, Cron: "0 * * * *"})
+	mockRepo.EXPECT().ScanByCursor(ctx, int64(0), 3).Return([]*entity.ExptTemplate{
+		newScheduledTemplate(1, nil),
+		active,
+		newScheduledTemplate(3, &entity.ExptTemplateSchedule{Enabled: false, Cron: "0 * * * *"}),
+	}, int64(3), nil)
+	templates, next, err := svc.ListScheduled(ctx, 0, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), next)
+	assert.Equal(t, []*entity.ExptTemplate{active}, templates)
+
+	mockRepo.EXPECT().ScanByCursor(ctx, int64(3), 3).Return(nil, int64(0), errors.New("db err"))
+	_, _, err = svc.ListScheduled(ctx, 3, 3)
+	assert.Error(t, err)
+}
+
+func TestExptTemplateScheduleServiceImpl_ResolveTrackedPromptVersion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("latest commit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockTarget := svcmocks.NewMockIEvalTargetService(ctrl)
+		mockPrompt := rpcmocks.NewMockIPromptRPCAdapter(ctrl)
+		svc := &ExptTemplateScheduleServiceImpl{evalTargetService: mockTarget, promptRPCAdapter: mockPrompt}
+
+		mockTarget.EXPECT().GetEvalTarget(ctx, int64(10)).Return(&entity.EvalTarget{SourceTargetID: "77"}, nil)
+		mockPrompt.EXPECT().GetPrompt(ctx, int64(100), int64(77), rpc.GetPromptParams{}).
+			Return(&rpc.LoopPrompt{PromptBasic: &rpc.PromptBasic{LatestVersion: gptr.Of("0.0.3")}}, nil)
+
+		promptID, version, err := svc.ResolveTrackedPromptVersion(ctx, newScheduledTemplate(1, &entity.ExptTemplateSchedule{Enabled: true, TriggerOnTargetChange: true}))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(77), promptID)
+		assert.Equal(t, "0.0.3", version)
+	})
+
+	t.Run("label across pages", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockTarget := svcmocks.NewMockIEvalTargetService(ctrl)
+		mockPrompt := rpcmocks.NewMockIPromptRPCAdapter(ctrl)
+		svc := &ExptTemplateScheduleServiceImpl{evalTargetService: mockTarget, promptRPCAdapter: mockPrompt}
+
+		mockTarget.EXPECT().GetEvalTarget(ctx, int64(10)).Return(&entity.EvalTarget{SourceTargetID: "77"}, nil)
+		gomock.InOrder(
+			mockPrompt.EXPECT().ListPromptVersion(ctx, gomock.Any()).Return([]*rpc.CommitInfo{
+				{Version: gptr.Of("0.0.5"), Labels: []string{"dev"}},
+			}, "c1", nil),
+			mockPrompt.EXPECT().ListPromptVersion(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, param *rpc.ListPromptVersionParam) ([]*rpc.CommitInfo, string, error) {
+					assert.Equal(t, "c1", gptr.Indirect(param.Cursor))
+					return []*rpc.CommitInfo{{Version: gptr.Of("0.0.4")}, {Version: gptr.Of("0.0.2"), Labels: []string{"prod"}}}, "", nil
+				}),
+		)
+
+		_, version, err := svc.ResolveTrackedPromptVersion(ctx, newScheduledTemplate(1, &entity.ExptTemplateSchedule{Enabled: true, TriggerOnTargetChange: true, PromptLabel: "prod"}))
+		assert.NoError(t, err)
+		assert.Equal(t, "0.0.2", version)
+	})
+
+	t.Run("label not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockTarget := svcmocks.NewMockIEvalTargetService(ctrl)
+		mockPrompt := rpcmocks.NewMockIPromptRPCAdapter(ctrl)
+		svc := &ExptTemplateScheduleServiceImpl{evalTargetService: mockTarget, promptRPCAdapter: mockPrompt}
+
+		mockTarget.EXPECT().GetEvalTarget(ctx, int64(10)).Return(&entity.EvalTarget{SourceTargetID: "77"}, nil)
+		mockPrompt.EXPECT().ListPromptVersion(ctx, gomock.Any()).Return([]*rpc.CommitInfo{{Version: gptr.Of("0.0.1")}}, "", nil)
+
+		_, _, err := svc.ResolveTrackedPromptVersion(ctx, newScheduledTemplate(1, &entity.ExptTemplateSchedule{Enabled: true, TriggerOnTargetChange: true, PromptLabel: "prod"}))
+		assert.Error(t, err)
+	})
+
+	t.Run("non prompt target", func(t *testing.T) {
+		svc := &ExptTemplateScheduleServiceImpl{}
+		template := newScheduledTemplate(1, &entity.ExptTemplateSchedule{Enabled: true, TriggerOnTargetChange: true})
+		template.TripleConfig.TargetType = entity.EvalTargetTypeCustomRPCServer
+		_, _, err := svc.ResolveTrackedPromptVersion(ctx, template)
+		assert.Error(t, err)
+	})
+}
+
+func TestExptTemplateScheduleServiceImpl_RecordRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repo_mocks.NewMockIExptTemplateRepo(ctrl)
+	svc := &ExptTemplateScheduleServiceImpl{templateRepo: mockRepo}
+	ctx := context.Background()
+	spaceID := int64(100)
+
+	template := newScheduledTemplate(1, &entity.ExptTemplateSchedule{Enabled: true, Cron: "0 * * * *", RetentionCount: 1})
+	template.ExptInfo = &entity.ExptInfo{CreatedExptCount: 3}
+	template.ScheduleState = &entity.ExptScheduleState{
+		History: []*entity.ExptScheduleRecord{{TriggerType: entity.ExptScheduleTriggerTypeCron, FireAt: 1, ExptID: 11}},
+	}
+	mockRepo.EXPECT().GetByID(ctx, int64(1), &spaceID).Return(template, nil)
+	mockRepo.EXPECT().UpdateFields(ctx, int64(1), gomock.Any()).DoAndReturn(func(_ context.Context, _ int64, ufields map[string]any) error {
+		// 只写 schedule_state，不覆盖 expt_info 中的实验运行状态
+		assert.NotContains(t, ufields, "expt_info")
+		state := &entity.ExptScheduleState{}
+		assert.NoError(t, json.Unmarshal(ufields["schedule_state"].([]byte), state))
+		assert.Equal(t, int64(2), state.LastCronFireAt)
+		assert.Len(t, state.History, 2)
+		assert.True(t, state.History[0].Purged)
+		return nil
+	})
+
+	expired, err := svc.RecordRun(ctx, 1, spaceID, &entity.ExptScheduleRecord{TriggerType: entity.ExptScheduleTriggerTypeCron, FireAt: 2, ExptID: 12})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{11}, expired)
+
+	mockRepo.EXPECT().GetByID(ctx, int64(2), &spaceID).Return(nil, nil)
+	_, err = svc.RecordRun(ctx, 2, spaceID, &entity.ExptScheduleRecord{TriggerType: entity.ExptScheduleTriggerTypeCron, FireAt: 2})
+	assert.Error(t, err)
+}
+
+func TestExptTemplateScheduleServiceImpl_AdvanceSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repo_mocks.NewMockIExptTemplateRepo(ctrl)
+	svc := &ExptTemplateScheduleServiceImpl{templateRepo: mockRepo}
+	ctx := context.Background()
+	spaceID := int64(100)
+
+	template := newScheduledTemplate(1, &entity.ExptTemplateSchedule{Enabled: true, Cron: "0 * * * *"})
+	template.ScheduleState = &entity.ExptScheduleState{LastCronFireAt: 1, LastTargetVersion: "0.0.1"}
+	mockRepo.EXPECT().GetByID(ctx, int64(1), &spaceID).Return(template, nil)
+	mockRepo.EXPECT().UpdateFields(ctx, int64(1), gomock.Any()).DoAndReturn(func(_ context.Context, _ int64, ufields map[string]any) error {
+		assert.Len(t, ufields, 1)
+		state := &entity.ExptScheduleState{}
+		assert.NoError(t, json.Unmarshal(ufields["schedule_state"].([]byte), state))
+		assert.Equal(t, int64(2), state.LastCronFireAt)
+		assert.Equal(t, "0.0.2", state.LastTargetVersion)
+		// 推进游标不写入调度记录
+		assert.Empty(t, state.History)
+		return nil
+	})
+
+	err := svc.AdvanceSchedule(ctx, 1, spaceID, &entity.ExptScheduleRecord{TriggerType: entity.ExptScheduleTriggerTypeCron, FireAt: 2, TargetVersion: "0.0.2"})
+	assert.NoError(t, err)
+}
@@ -270,6 +270,21 @@ func (mr *MockExptAggrResultServiceMockRecorder) BatchGetExptAggrResultByExperim
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetExptAggrResultByExperimentIDs", reflect.TypeOf((*MockExptAggrResultService)(nil).BatchGetExptAggrResultByExperimentIDs), ctx, spaceID, experimentIDs)
 }
 
+// CompareExptAggrResults mocks base method.
+func (m *MockExptAggrResultService) CompareExptAggrResults(ctx context.Context, param *entity.ExptComparisonParam) ([]*entity.ExptComparisonResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareExptAggrResults", ctx, param)
+	ret0, _ := ret[0].([]*entity.ExptComparisonResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareExptAggrResults indicates an expected call of CompareExptAggrResults.
+func (mr *MockExptAggrResultServiceMockRecorder) CompareExptAggrResults(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareExptAggrResults", reflect.TypeOf((*MockExptAggrResultService)(nil).CompareExptAggrResults), ctx, param)
+}
+
 // CreateAnnotationAggrResult mocks base method.
 func (m *MockExptAggrResultService) CreateAnnotationAggrResult(ctx context.Context, param *entity.CreateSpecificFieldAggrResultParam) error {
 	m.ctrl.T.Helper()
@@ -298,6 +313,20 @@ func (mr *MockExptAggrResultServiceMockRecorder) CreateExptAggrResult(ctx, space
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExptAggrResult", reflect.TypeOf((*MockExptAggrResultService)(nil).CreateExptAggrResult), ctx, spaceID, experimentID)
 }
 
+// EvaluateExptGate mocks base method.
+func (m *MockExptAggrResultService) EvaluateExptGate(ctx context.Context, spaceID, exptID int64, conf *entity.ExptGateConf) *entity.ExptGateVerdict {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateExptGate", ctx, spaceID, exptID, conf)
+	ret0, _ := ret[0].(*entity.ExptGateVerdict)
+	return ret0
+}
+
+// EvaluateExptGate indicates an expected call of EvaluateExptGate.
+func (mr *MockExptAggrResultServiceMockRecorder) EvaluateExptGate(ctx, spaceID, exptID, conf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateExptGate", reflect.TypeOf((*MockExptAggrResultService)(nil).EvaluateExptGate), ctx, spaceID, exptID, conf)
+}
+
 // PublishExptAggrResultEvent mocks base method.
 func (m *MockExptAggrResultService) PublishExptAggrResultEvent(ctx context.Context, event *entity.AggrCalculateEvent, duration *time.Duration) error {
 	m.ctrl.T.Helper()
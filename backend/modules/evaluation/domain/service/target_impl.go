@@ -278,7 +278,8 @@ func (e *EvalTargetServiceImpl) ExecuteTarget(ctx context.Context, spaceID, targ
 			span.Finish(ctx)
 		}
 
-		if execErr == nil && evalTargetDO.EvalTargetType.SupptTrajectory() {
+		// 评测对象自身已产出轨迹时(如OpenAIChat)不再从trace中提取
+		if execErr == nil && evalTargetDO.EvalTargetType.SupptTrajectory() && outputData.OutputFields[consts.EvalTargetOutputFieldKeyTrajectory] == nil {
 			time.Sleep(e.configer.GetTargetTrajectoryConf(ctx).GetExtractInterval(spaceID))
 			trajectory, err := e.ExtractTrajectory(ctx, spaceID, span.GetTraceID(), nil)
 			if err != nil {
@@ -289,6 +290,7 @@ func (e *EvalTargetServiceImpl) ExecuteTarget(ctx context.Context, spaceID, targ
 					outputData.OutputFields = make(map[string]*entity.Content)
 				}
 				outputData.OutputFields[consts.EvalTargetOutputFieldKeyTrajectory] = trajectory.ToContent(ctx)
+				outputData.SetTotalCost(trajectory.GetTotalCost())
 			}
 		}
 
@@ -618,6 +620,7 @@ func (e *EvalTargetServiceImpl) ReportInvokeRecords(ctx context.Context, param *
 			od.OutputFields = map[string]*entity.Content{}
 		}
 		od.OutputFields[consts.EvalTargetOutputFieldKeyTrajectory] = trajectory.ToContent(ctx)
+		od.SetTotalCost(trajectory.GetTotalCost())
 		return e.evalTargetRepo.UpdateEvalTargetRecord(ctx, &entity.EvalTargetRecord{
 			ID:                   record.ID,
 			TraceID:              record.TraceID,
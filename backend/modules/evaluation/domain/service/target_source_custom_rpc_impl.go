@@ -0,0 +1,229 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// NewCustomRPCSourceEvalTargetServiceImpl 自定义评测对象，按SPI协议以HTTP调用用户注册的服务
+func NewCustomRPCSourceEvalTargetServiceImpl(spiAdapter rpc.IEvalTargetSPIAdapter, idgen idgen.IIDGenerator) ISourceEvalTargetOperateService {
+	return &CustomRPCSourceEvalTargetServiceImpl{
+		spiAdapter: spiAdapter,
+		idgen:      idgen,
+	}
+}
+
+type CustomRPCSourceEvalTargetServiceImpl struct {
+	spiAdapter rpc.IEvalTargetSPIAdapter
+	idgen      idgen.IIDGenerator
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) EvalType() entity.EvalTargetType {
+	return entity.EvalTargetTypeCustomRPCServer
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) RuntimeParam() entity.IRuntimeParam {
+	return entity.NewDummyRuntimeParam()
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) ValidateInput(ctx context.Context, spaceID int64, inputSchema []*entity.ArgsSchema, input *entity.EvalTargetInputData) error {
+	return input.ValidateInputSchema(inputSchema)
+}
+
+// BuildBySource 服务信息由创建请求传入并随版本落库，sourceTargetID/sourceTargetVersion由用户自行指定
+func (t *CustomRPCSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, spaceID int64, sourceTargetID, sourceTargetVersion string, opts ...entity.Option) (*entity.EvalTarget, error) {
+	opt := &entity.Opt{}
+	for _, o := range opts {
+		o(opt)
+	}
+	server := opt.CustomRPCServer
+	if err := validateHTTPCustomRPCServer(server); err != nil {
+		return nil, err
+	}
+	if opt.CustomEvalTarget != nil {
+		server.CustomEvalTarget = opt.CustomEvalTarget
+	}
+	if opt.Region != nil {
+		server.ExecRegion = *opt.Region
+	}
+	if opt.Env != nil {
+		server.ExecEnv = opt.Env
+	}
+
+	outputSchema := []*entity.ArgsSchema{
+		{
+			Key:                 gptr.Of(consts.OutputSchemaKey),
+			SupportContentTypes: []entity.ContentType{entity.ContentTypeText, entity.ContentTypeImage, entity.ContentTypeMultipart},
+			JsonSchema:          gptr.Of(consts.StringJsonSchema),
+		},
+	}
+	for _, field := range server.CustomFieldSchemas {
+		if field == nil {
+			continue
+		}
+		outputSchema = append(outputSchema, &entity.ArgsSchema{
+			Key:                 gptr.Of(field.Name),
+			SupportContentTypes: []entity.ContentType{field.ContentType},
+			JsonSchema:          gptr.Of(field.TextSchema),
+		})
+	}
+
+	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
+	baseInfo := func() *entity.BaseInfo {
+		return &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{UserID: gptr.Of(userIDInContext)},
+			UpdatedBy: &entity.UserInfo{UserID: gptr.Of(userIDInContext)},
+		}
+	}
+	return &entity.EvalTarget{
+		SpaceID:        spaceID,
+		SourceTargetID: sourceTargetID,
+		EvalTargetType: entity.EvalTargetTypeCustomRPCServer,
+		EvalTargetVersion: &entity.EvalTargetVersion{
+			SpaceID:             spaceID,
+			SourceTargetVersion: sourceTargetVersion,
+			EvalTargetType:      entity.EvalTargetTypeCustomRPCServer,
+			CustomRPCServer:     server,
+			OutputSchema:        outputSchema,
+			BaseInfo:            baseInfo(),
+		},
+		BaseInfo: baseInfo(),
+	}, nil
+}
+
+// ListSource 自定义服务没有源对象列表，服务信息在创建评测对象时注册
+func (t *CustomRPCSourceEvalTargetServiceImpl) ListSource(ctx context.Context, param *entity.ListSourceParam) (targets []*entity.EvalTarget, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, nil
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) BatchGetSource(ctx context.Context, spaceID int64, ids []string) (targets []*entity.EvalTarget, err error) {
+	return nil, nil
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) ListSourceVersion(ctx context.Context, param *entity.ListSourceVersionParam) (versions []*entity.EvalTargetVersion, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, nil
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) PackSourceInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	return nil
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) PackSourceVersionInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	return nil
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) Execute(ctx context.Context, spaceID int64, param *entity.ExecuteEvalTargetParam) (outputData *entity.EvalTargetOutputData, status entity.EvalTargetRunStatus, err error) {
+	start := time.Now()
+	defer func() {
+		if outputData == nil {
+			outputData = &entity.EvalTargetOutputData{}
+		}
+		outputData.TimeConsumingMS = gptr.Of(time.Since(start).Milliseconds())
+		if err != nil {
+			outputData.EvalTargetRunError = &entity.EvalTargetRunError{}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				outputData.EvalTargetRunError.Code = statusErr.Code()
+				outputData.EvalTargetRunError.Message = statusErr.Error()
+			} else {
+				outputData.EvalTargetRunError.Code = errno.CommonInternalErrorCode
+				outputData.EvalTargetRunError.Message = err.Error()
+			}
+		}
+	}()
+
+	server, err := customRPCServerOf(param)
+	if err != nil {
+		return nil, entity.EvalTargetRunStatusFail, err
+	}
+	return t.spiAdapter.InvokeEvalTarget(ctx, &rpc.InvokeEvalTargetParam{
+		SpaceID: spaceID,
+		Server:  server,
+		Input:   param.Input,
+	})
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) AsyncExecute(ctx context.Context, spaceID int64, param *entity.ExecuteEvalTargetParam) (invokeID int64, callee string, err error) {
+	server, err := customRPCServerOf(param)
+	if err != nil {
+		return 0, "", err
+	}
+	if server.AsyncInvokeHTTPInfo == nil {
+		return 0, "", errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("async invoke http info is empty"))
+	}
+	callee = server.ServerName
+	if callee == "" {
+		callee = server.Endpoint
+	}
+	invokeID, err = t.idgen.GenID(ctx)
+	if err != nil {
+		return 0, callee, err
+	}
+	if err := t.spiAdapter.AsyncInvokeEvalTarget(ctx, &rpc.InvokeEvalTargetParam{
+		SpaceID: spaceID,
+		Server:  server,
+		Input:   param.Input,
+	}, invokeID); err != nil {
+		return 0, callee, err
+	}
+	return invokeID, callee, nil
+}
+
+func (t *CustomRPCSourceEvalTargetServiceImpl) SearchCustomEvalTarget(ctx context.Context, param *entity.SearchCustomEvalTargetParam) (targets []*entity.CustomEvalTarget, nextCursor string, hasMore bool, err error) {
+	server := param.CustomRPCServer
+	if err := validateHTTPCustomRPCServer(server); err != nil {
+		return nil, "", false, err
+	}
+	if server.SearchHTTPInfo == nil {
+		return nil, "", false, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("search http info is empty"))
+	}
+	return t.spiAdapter.SearchEvalTarget(ctx, &rpc.SearchEvalTargetParam{
+		SpaceID:   gptr.Indirect(param.WorkspaceID),
+		Server:    server,
+		Keyword:   param.Keyword,
+		Region:    param.Region,
+		PageSize:  param.PageSize,
+		PageToken: param.PageToken,
+	})
+}
+
+func customRPCServerOf(param *entity.ExecuteEvalTargetParam) (*entity.CustomRPCServer, error) {
+	if param == nil || param.EvalTarget == nil || param.EvalTarget.EvalTargetVersion == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("eval target version is empty"))
+	}
+	server := param.EvalTarget.EvalTargetVersion.CustomRPCServer
+	if err := validateHTTPCustomRPCServer(server); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// validateHTTPCustomRPCServer 开源版本仅支持http接入协议
+func validateHTTPCustomRPCServer(server *entity.CustomRPCServer) error {
+	switch {
+	case server == nil:
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("custom rpc server is empty"))
+	case server.AccessProtocol != entity.AccessProtocolHTTP:
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("access protocol not supported: "+server.AccessProtocol))
+	case server.Endpoint == "":
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("endpoint is empty"))
+	case gptr.Indirect(server.IsAsync) && server.AsyncInvokeHTTPInfo == nil:
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("async invoke http info is empty"))
+	case !gptr.Indirect(server.IsAsync) && server.InvokeHTTPInfo == nil:
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invoke http info is empty"))
+	}
+	return nil
+}
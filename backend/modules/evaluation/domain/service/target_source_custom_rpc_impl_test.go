@@ -0,0 +1,181 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func newHTTPCustomRPCServer() *entity.CustomRPCServer {
+	return &entity.CustomRPCServer{
+		ServerName:          "my-agent",
+		AccessProtocol:      entity.AccessProtocolHTTP,
+		Endpoint:            "http://localhost:8080",
+		Headers:             map[string]string{"Authorization": "Bearer token"},
+		InvokeHTTPInfo:      &entity.HTTPInfo{Method: entity.HTTPMethodPost, Path: "/invoke"},
+		AsyncInvokeHTTPInfo: &entity.HTTPInfo{Method: entity.HTTPMethodPost, Path: "/async_invoke"},
+	}
+}
+
+func TestCustomRPCSourceEvalTargetServiceImpl_BuildBySource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := NewCustomRPCSourceEvalTargetServiceImpl(mocks.NewMockIEvalTargetSPIAdapter(ctrl), idgenmocks.NewMockIIDGenerator(ctrl))
+
+	t.Run("success", func(t *testing.T) {
+		server := newHTTPCustomRPCServer()
+		server.CustomFieldSchemas = []*entity.CustomFieldSchema{{Name: "reasoning", ContentType: entity.ContentTypeText, TextSchema: consts.StringJsonSchema}}
+		target, err := svc.BuildBySource(context.Background(), 100, "agent", "v1",
+			entity.WithCustomRPCServer(server),
+			entity.WithCustomEvalTarget(&entity.CustomEvalTarget{ID: gptr.Of("bot_1")}),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvalTargetTypeCustomRPCServer, target.EvalTargetType)
+		assert.Equal(t, "agent", target.SourceTargetID)
+		assert.Equal(t, "v1", target.EvalTargetVersion.SourceTargetVersion)
+		assert.Equal(t, "bot_1", gptr.Indirect(target.EvalTargetVersion.CustomRPCServer.CustomEvalTarget.ID))
+		if assert.Len(t, target.EvalTargetVersion.OutputSchema, 2) {
+			assert.Equal(t, consts.OutputSchemaKey, gptr.Indirect(target.EvalTargetVersion.OutputSchema[0].Key))
+			assert.Equal(t, "reasoning", gptr.Indirect(target.EvalTargetVersion.OutputSchema[1].Key))
+		}
+	})
+
+	t.Run("invalid server", func(t *testing.T) {
+		_, err := svc.BuildBySource(context.Background(), 100, "agent", "v1")
+		assert.Error(t, err)
+
+		server := newHTTPCustomRPCServer()
+		server.AccessProtocol = "rpc"
+		_, err = svc.BuildBySource(context.Background(), 100, "agent", "v1", entity.WithCustomRPCServer(server))
+		assert.Error(t, err)
+
+		server = newHTTPCustomRPCServer()
+		server.Endpoint = ""
+		_, err = svc.BuildBySource(context.Background(), 100, "agent", "v1", entity.WithCustomRPCServer(server))
+		assert.Error(t, err)
+
+		server = newHTTPCustomRPCServer()
+		server.IsAsync = gptr.Of(true)
+		server.AsyncInvokeHTTPInfo = nil
+		_, err = svc.BuildBySource(context.Background(), 100, "agent", "v1", entity.WithCustomRPCServer(server))
+		assert.Error(t, err)
+	})
+}
+
+func TestCustomRPCSourceEvalTargetServiceImpl_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAdapter := mocks.NewMockIEvalTargetSPIAdapter(ctrl)
+	svc := NewCustomRPCSourceEvalTargetServiceImpl(mockAdapter, idgenmocks.NewMockIIDGenerator(ctrl))
+
+	input := &entity.EvalTargetInputData{InputFields: map[string]*entity.Content{"question": {Text: gptr.Of("hi")}}}
+	param := &entity.ExecuteEvalTargetParam{
+		Input: input,
+		EvalTarget: &entity.EvalTarget{
+			EvalTargetVersion: &entity.EvalTargetVersion{CustomRPCServer: newHTTPCustomRPCServer()},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockAdapter.EXPECT().InvokeEvalTarget(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, p *rpc.InvokeEvalTargetParam) (*entity.EvalTargetOutputData, entity.EvalTargetRunStatus, error) {
+				assert.Equal(t, int64(100), p.SpaceID)
+				assert.Equal(t, input, p.Input)
+				return &entity.EvalTargetOutputData{
+					OutputFields:    map[string]*entity.Content{consts.OutputSchemaKey: {Text: gptr.Of("hello")}},
+					EvalTargetUsage: &entity.EvalTargetUsage{InputTokens: 1, OutputTokens: 2, TotalTokens: 3},
+				}, entity.EvalTargetRunStatusSuccess, nil
+			})
+		output, status, err := svc.Execute(context.Background(), 100, param)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvalTargetRunStatusSuccess, status)
+		assert.Equal(t, "hello", gptr.Indirect(output.OutputFields[consts.OutputSchemaKey].Text))
+		assert.Equal(t, int64(3), output.EvalTargetUsage.TotalTokens)
+		assert.NotNil(t, output.TimeConsumingMS)
+	})
+
+	t.Run("invoke error", func(t *testing.T) {
+		mockAdapter.EXPECT().InvokeEvalTarget(gomock.Any(), gomock.Any()).Return(nil, entity.EvalTargetRunStatusFail,
+			errorx.NewByCode(errno.CustomEvalTargetInvokeFailCode))
+		output, status, err := svc.Execute(context.Background(), 100, param)
+		assert.Error(t, err)
+		assert.Equal(t, entity.EvalTargetRunStatusFail, status)
+		assert.Equal(t, int32(errno.CustomEvalTargetInvokeFailCode), output.EvalTargetRunError.Code)
+	})
+
+	t.Run("missing server", func(t *testing.T) {
+		_, status, err := svc.Execute(context.Background(), 100, &entity.ExecuteEvalTargetParam{
+			EvalTarget: &entity.EvalTarget{EvalTargetVersion: &entity.EvalTargetVersion{}},
+		})
+		assert.Error(t, err)
+		assert.Equal(t, entity.EvalTargetRunStatusFail, status)
+	})
+}
+
+func TestCustomRPCSourceEvalTargetServiceImpl_AsyncExecute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAdapter := mocks.NewMockIEvalTargetSPIAdapter(ctrl)
+	mockIdgen := idgenmocks.NewMockIIDGenerator(ctrl)
+	svc := NewCustomRPCSourceEvalTargetServiceImpl(mockAdapter, mockIdgen)
+
+	param := &entity.ExecuteEvalTargetParam{
+		Input: &entity.EvalTargetInputData{},
+		EvalTarget: &entity.EvalTarget{
+			EvalTargetVersion: &entity.EvalTargetVersion{CustomRPCServer: newHTTPCustomRPCServer()},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockIdgen.EXPECT().GenID(gomock.Any()).Return(int64(999), nil)
+		mockAdapter.EXPECT().AsyncInvokeEvalTarget(gomock.Any(), gomock.Any(), int64(999)).Return(nil)
+		invokeID, callee, err := svc.AsyncExecute(context.Background(), 100, param)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(999), invokeID)
+		assert.Equal(t, "my-agent", callee)
+	})
+
+	t.Run("invoke error", func(t *testing.T) {
+		mockIdgen.EXPECT().GenID(gomock.Any()).Return(int64(999), nil)
+		mockAdapter.EXPECT().AsyncInvokeEvalTarget(gomock.Any(), gomock.Any(), int64(999)).Return(errors.New("refused"))
+		_, _, err := svc.AsyncExecute(context.Background(), 100, param)
+		assert.Error(t, err)
+	})
+}
+
+func TestCustomRPCSourceEvalTargetServiceImpl_SearchCustomEvalTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAdapter := mocks.NewMockIEvalTargetSPIAdapter(ctrl)
+	svc := NewCustomRPCSourceEvalTargetServiceImpl(mockAdapter, idgenmocks.NewMockIIDGenerator(ctrl))
+
+	server := newHTTPCustomRPCServer()
+	_, _, _, err := svc.SearchCustomEvalTarget(context.Background(), &entity.SearchCustomEvalTargetParam{CustomRPCServer: server})
+	assert.Error(t, err)
+
+	server.SearchHTTPInfo = &entity.HTTPInfo{Method: entity.HTTPMethodPost, Path: "/search"}
+	mockAdapter.EXPECT().SearchEvalTarget(gomock.Any(), gomock.Any()).Return([]*entity.CustomEvalTarget{{ID: gptr.Of("bot_1")}}, "next", true, nil)
+	targets, cursor, hasMore, err := svc.SearchCustomEvalTarget(context.Background(), &entity.SearchCustomEvalTargetParam{
+		WorkspaceID:     gptr.Of(int64(100)),
+		CustomRPCServer: server,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, targets, 1)
+	assert.Equal(t, "next", cursor)
+	assert.True(t, hasMore)
+}
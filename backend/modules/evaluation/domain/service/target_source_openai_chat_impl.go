@@ -0,0 +1,281 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/infra/looptracer"
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/trajectory"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+// NewOpenAIChatSourceEvalTargetServiceImpl OpenAI兼容Chat接口的评测对象
+func NewOpenAIChatSourceEvalTargetServiceImpl(chatAdapter rpc.IOpenAIChatAdapter) ISourceEvalTargetOperateService {
+	return &OpenAIChatSourceEvalTargetServiceImpl{
+		chatAdapter: chatAdapter,
+	}
+}
+
+type OpenAIChatSourceEvalTargetServiceImpl struct {
+	chatAdapter rpc.IOpenAIChatAdapter
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) EvalType() entity.EvalTargetType {
+	return entity.EvalTargetTypeOpenAIChat
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) RuntimeParam() entity.IRuntimeParam {
+	return entity.NewDummyRuntimeParam()
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) ValidateInput(ctx context.Context, spaceID int64, inputSchema []*entity.ArgsSchema, input *entity.EvalTargetInputData) error {
+	return input.ValidateInputSchema(inputSchema)
+}
+
+// BuildBySource 接口信息由创建请求传入并随版本落库，消息模板中的变量作为输入字段
+func (t *OpenAIChatSourceEvalTargetServiceImpl) BuildBySource(ctx context.Context, spaceID int64, sourceTargetID, sourceTargetVersion string, opts ...entity.Option) (*entity.EvalTarget, error) {
+	opt := &entity.Opt{}
+	for _, o := range opts {
+		o(opt)
+	}
+	chat := opt.OpenAIChat
+	if err := validateOpenAIChat(chat); err != nil {
+		return nil, err
+	}
+
+	inputSchema := make([]*entity.ArgsSchema, 0)
+	for _, v := range chat.InputVariables() {
+		inputSchema = append(inputSchema, &entity.ArgsSchema{
+			Key:                 gptr.Of(v),
+			SupportContentTypes: []entity.ContentType{entity.ContentTypeText},
+			JsonSchema:          gptr.Of(consts.StringJsonSchema),
+		})
+	}
+
+	userIDInContext := session.UserIDInCtxOrEmpty(ctx)
+	baseInfo := func() *entity.BaseInfo {
+		return &entity.BaseInfo{
+			CreatedBy: &entity.UserInfo{UserID: gptr.Of(userIDInContext)},
+			UpdatedBy: &entity.UserInfo{UserID: gptr.Of(userIDInContext)},
+		}
+	}
+	return &entity.EvalTarget{
+		SpaceID:        spaceID,
+		SourceTargetID: sourceTargetID,
+		EvalTargetType: entity.EvalTargetTypeOpenAIChat,
+		EvalTargetVersion: &entity.EvalTargetVersion{
+			SpaceID:             spaceID,
+			SourceTargetVersion: sourceTargetVersion,
+			EvalTargetType:      entity.EvalTargetTypeOpenAIChat,
+			OpenAIChat:          chat,
+			InputSchema:         inputSchema,
+			OutputSchema: []*entity.ArgsSchema{
+				{
+					Key:                 gptr.Of(consts.OutputSchemaKey),
+					SupportContentTypes: []entity.ContentType{entity.ContentTypeText},
+					JsonSchema:          gptr.Of(consts.StringJsonSchema),
+				},
+			},
+			BaseInfo: baseInfo(),
+		},
+		BaseInfo: baseInfo(),
+	}, nil
+}
+
+// ListSource 接口没有源对象列表，接口信息在创建评测对象时注册
+func (t *OpenAIChatSourceEvalTargetServiceImpl) ListSource(ctx context.Context, param *entity.ListSourceParam) (targets []*entity.EvalTarget, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, nil
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) BatchGetSource(ctx context.Context, spaceID int64, ids []string) (targets []*entity.EvalTarget, err error) {
+	return nil, nil
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) ListSourceVersion(ctx context.Context, param *entity.ListSourceVersionParam) (versions []*entity.EvalTargetVersion, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, nil
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) PackSourceInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	return nil
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) PackSourceVersionInfo(ctx context.Context, spaceID int64, dos []*entity.EvalTarget) (err error) {
+	return nil
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) Execute(ctx context.Context, spaceID int64, param *entity.ExecuteEvalTargetParam) (outputData *entity.EvalTargetOutputData, status entity.EvalTargetRunStatus, err error) {
+	start := time.Now()
+	defer func() {
+		if outputData == nil {
+			outputData = &entity.EvalTargetOutputData{}
+		}
+		outputData.TimeConsumingMS = gptr.Of(time.Since(start).Milliseconds())
+		if err != nil {
+			outputData.EvalTargetRunError = &entity.EvalTargetRunError{}
+			statusErr, ok := errorx.FromStatusError(err)
+			if ok {
+				outputData.EvalTargetRunError.Code = statusErr.Code()
+				outputData.EvalTargetRunError.Message = statusErr.Error()
+			} else {
+				outputData.EvalTargetRunError.Code = errno.CommonInternalErrorCode
+				outputData.EvalTargetRunError.Message = err.Error()
+			}
+		}
+	}()
+
+	if param == nil || param.EvalTarget == nil || param.EvalTarget.EvalTargetVersion == nil {
+		return nil, entity.EvalTargetRunStatusFail, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("eval target version is empty"))
+	}
+	chat := param.EvalTarget.EvalTargetVersion.OpenAIChat
+	if err := validateOpenAIChat(chat); err != nil {
+		return nil, entity.EvalTargetRunStatusFail, err
+	}
+
+	messages := chat.RenderMessages(param.Input)
+	ctx, span := looptracer.GetTracer().StartSpan(ctx, "OpenAIChat", "model")
+	span.SetInput(ctx, json.MarshalStringIgnoreErr(messages))
+	res, err := t.chatAdapter.ChatCompletion(ctx, &rpc.OpenAIChatParam{Chat: chat, Messages: messages})
+	if err != nil {
+		span.SetError(ctx, err)
+		span.Finish(ctx)
+		return nil, entity.EvalTargetRunStatusFail, err
+	}
+	span.SetOutput(ctx, res.Content)
+	span.SetInputTokens(ctx, int(res.InputTokens))
+	span.SetOutputTokens(ctx, int(res.OutputTokens))
+	span.Finish(ctx)
+
+	traj := buildOpenAIChatTrajectory(span.GetTraceID(), chat, messages, res, start, time.Since(start))
+	return &entity.EvalTargetOutputData{
+		OutputFields: map[string]*entity.Content{
+			consts.OutputSchemaKey: {
+				ContentType: gptr.Of(entity.ContentTypeText),
+				Text:        gptr.Of(res.Content),
+			},
+			consts.EvalTargetOutputFieldKeyTrajectory: traj.ToContent(ctx),
+		},
+		EvalTargetUsage: &entity.EvalTargetUsage{
+			InputTokens:  res.InputTokens,
+			OutputTokens: res.OutputTokens,
+			TotalTokens:  res.InputTokens + res.OutputTokens,
+		},
+	}, entity.EvalTargetRunStatusSuccess, nil
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) AsyncExecute(ctx context.Context, spaceID int64, param *entity.ExecuteEvalTargetParam) (int64, string, error) {
+	return 0, "", errorx.New("async execute not supported")
+}
+
+func (t *OpenAIChatSourceEvalTargetServiceImpl) SearchCustomEvalTarget(ctx context.Context, param *entity.SearchCustomEvalTargetParam) (targets []*entity.CustomEvalTarget, nextCursor string, hasMore bool, err error) {
+	return nil, "", false, nil
+}
+
+func validateOpenAIChat(chat *entity.OpenAIChat) error {
+	if chat == nil {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("openai chat is empty"))
+	}
+	u, err := url.Parse(chat.BaseURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid base url: "+chat.BaseURL))
+	}
+	if chat.Model == "" {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("model is empty"))
+	}
+	if len(chat.MessageTemplates) == 0 {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("message templates is empty"))
+	}
+	return nil
+}
+
+// buildOpenAIChatTrajectory 把一次chat调用组织为轨迹：一个模型step，返回的每个tool call各为一个tool step
+func buildOpenAIChatTrajectory(traceID string, chat *entity.OpenAIChat, messages []*entity.OpenAIChatMessage, res *rpc.OpenAIChatResult,
+	start time.Time, duration time.Duration,
+) *entity.Trajectory {
+	input := json.MarshalStringIgnoreErr(messages)
+	startedAt := strconv.FormatInt(start.UnixMilli(), 10)
+	durationMS := strconv.FormatInt(duration.Milliseconds(), 10)
+	basicInfo := &trajectory.BasicInfo{StartedAt: gptr.Of(startedAt), Duration: gptr.Of(durationMS)}
+
+	modelInfo := &trajectory.ModelInfo{
+		InputTokens:  gptr.Of(int32(res.InputTokens)),
+		OutputTokens: gptr.Of(int32(res.OutputTokens)),
+	}
+	if res.FirstTokenMS != nil {
+		modelInfo.LatencyFirstResp = gptr.Of(strconv.FormatInt(*res.FirstTokenMS, 10))
+	}
+	modelStep := &trajectory.Step{
+		ID:        gptr.Of("model"),
+		ParentID:  gptr.Of("agent"),
+		Type:      gptr.Of(trajectory.StepTypeModel),
+		Name:      gptr.Of(chat.Model),
+		Input:     gptr.Of(input),
+		Output:    gptr.Of(res.Content),
+		ModelInfo: modelInfo,
+		BasicInfo: basicInfo,
+	}
+	if res.ReasoningContent != "" || res.FinishReason != "" {
+		modelStep.Metadata = map[string]string{}
+		if res.ReasoningContent != "" {
+			modelStep.Metadata["reasoning_content"] = res.ReasoningContent
+		}
+		if res.FinishReason != "" {
+			modelStep.Metadata["finish_reason"] = res.FinishReason
+		}
+	}
+	steps := []*trajectory.Step{modelStep}
+	for i, tc := range res.ToolCalls {
+		id := tc.ID
+		if id == "" {
+			id = "tool_" + strconv.Itoa(i)
+		}
+		steps = append(steps, &trajectory.Step{
+			ID:       gptr.Of(id),
+			ParentID: gptr.Of("agent"),
+			Type:     gptr.Of(trajectory.StepTypeTool),
+			Name:     gptr.Of(tc.Name),
+			Input:    gptr.Of(tc.Arguments),
+		})
+	}
+
+	metricsInfo := &trajectory.MetricsInfo{
+		LlmDuration:        gptr.Of(durationMS),
+		InputTokens:        gptr.Of(int32(res.InputTokens)),
+		OutputTokens:       gptr.Of(int32(res.OutputTokens)),
+		ToolStepProportion: gptr.Of(float64(len(res.ToolCalls)) / float64(len(steps))),
+	}
+	return &entity.Trajectory{
+		ID: gptr.Of(traceID),
+		RootStep: &trajectory.RootStep{
+			ID:          gptr.Of("root"),
+			Name:        gptr.Of(chat.Model),
+			Input:       gptr.Of(input),
+			Output:      gptr.Of(res.Content),
+			BasicInfo:   basicInfo,
+			MetricsInfo: metricsInfo,
+		},
+		AgentSteps: []*trajectory.AgentStep{
+			{
+				ID:          gptr.Of("agent"),
+				Name:        gptr.Of(chat.Model),
+				Input:       gptr.Of(input),
+				Output:      gptr.Of(res.Content),
+				Steps:       steps,
+				BasicInfo:   basicInfo,
+				MetricsInfo: metricsInfo,
+			},
+		},
+	}
+}
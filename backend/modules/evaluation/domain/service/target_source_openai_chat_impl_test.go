@@ -0,0 +1,141 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func newTestOpenAIChat() *entity.OpenAIChat {
+	return &entity.OpenAIChat{
+		BaseURL: "https://api.example.com/v1",
+		Model:   "gpt-test",
+		Headers: map[string]string{"Authorization": "Bearer sk-test"},
+		MessageTemplates: []*entity.OpenAIChatMessageTemplate{
+			{Role: entity.OpenAIChatRoleSystem, Content: "You are {{persona}}."},
+			{Role: entity.OpenAIChatRoleUser, Content: "{{question}}"},
+		},
+		Stream: true,
+	}
+}
+
+func TestOpenAIChatSourceEvalTargetServiceImpl_BuildBySource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := NewOpenAIChatSourceEvalTargetServiceImpl(mocks.NewMockIOpenAIChatAdapter(ctrl))
+
+	target, err := svc.BuildBySource(context.Background(), 100, "my-agent", "v1", entity.WithOpenAIChat(newTestOpenAIChat()))
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EvalTargetTypeOpenAIChat, target.EvalTargetType)
+	assert.Equal(t, "gpt-test", target.EvalTargetVersion.OpenAIChat.Model)
+	if assert.Len(t, target.EvalTargetVersion.InputSchema, 2) {
+		assert.Equal(t, "persona", gptr.Indirect(target.EvalTargetVersion.InputSchema[0].Key))
+		assert.Equal(t, "question", gptr.Indirect(target.EvalTargetVersion.InputSchema[1].Key))
+	}
+	if assert.Len(t, target.EvalTargetVersion.OutputSchema, 1) {
+		assert.Equal(t, consts.OutputSchemaKey, gptr.Indirect(target.EvalTargetVersion.OutputSchema[0].Key))
+	}
+
+	for name, modify := range map[string]func(c *entity.OpenAIChat){
+		"invalid base url": func(c *entity.OpenAIChat) { c.BaseURL = "api.example.com" },
+		"empty model":      func(c *entity.OpenAIChat) { c.Model = "" },
+		"empty templates":  func(c *entity.OpenAIChat) { c.MessageTemplates = nil },
+	} {
+		t.Run(name, func(t *testing.T) {
+			chat := newTestOpenAIChat()
+			modify(chat)
+			_, err := svc.BuildBySource(context.Background(), 100, "my-agent", "v1", entity.WithOpenAIChat(chat))
+			assert.Error(t, err)
+		})
+	}
+	_, err = svc.BuildBySource(context.Background(), 100, "my-agent", "v1")
+	assert.Error(t, err)
+}
+
+func TestOpenAIChatSourceEvalTargetServiceImpl_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAdapter := mocks.NewMockIOpenAIChatAdapter(ctrl)
+	svc := NewOpenAIChatSourceEvalTargetServiceImpl(mockAdapter)
+
+	param := &entity.ExecuteEvalTargetParam{
+		Input: &entity.EvalTargetInputData{InputFields: map[string]*entity.Content{
+			"persona":  {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("a tutor")},
+			"question": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("1+1?")},
+		}},
+		EvalTarget: &entity.EvalTarget{
+			EvalTargetVersion: &entity.EvalTargetVersion{OpenAIChat: newTestOpenAIChat()},
+		},
+	}
+
+	t.Run("success with tool calls", func(t *testing.T) {
+		mockAdapter.EXPECT().ChatCompletion(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, p *rpc.OpenAIChatParam) (*rpc.OpenAIChatResult, error) {
+				assert.Equal(t, []*entity.OpenAIChatMessage{
+					{Role: entity.OpenAIChatRoleSystem, Content: "You are a tutor."},
+					{Role: entity.OpenAIChatRoleUser, Content: "1+1?"},
+				}, p.Messages)
+				return &rpc.OpenAIChatResult{
+					Content:      "2",
+					ToolCalls:    []*rpc.OpenAIChatToolCall{{ID: "call_1", Name: "calculator", Arguments: `{"expr":"1+1"}`}},
+					FinishReason: "tool_calls",
+					InputTokens:  10,
+					OutputTokens: 2,
+					FirstTokenMS: gptr.Of(int64(120)),
+				}, nil
+			})
+		output, status, err := svc.Execute(context.Background(), 100, param)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvalTargetRunStatusSuccess, status)
+		assert.Equal(t, "2", gptr.Indirect(output.OutputFields[consts.OutputSchemaKey].Text))
+		assert.Equal(t, int64(12), output.EvalTargetUsage.TotalTokens)
+		assert.NotNil(t, output.TimeConsumingMS)
+
+		traj := &entity.Trajectory{}
+		assert.NoError(t, json.Unmarshal([]byte(gptr.Indirect(output.OutputFields[consts.EvalTargetOutputFieldKeyTrajectory].Text)), traj))
+		if assert.Len(t, traj.AgentSteps, 1) && assert.Len(t, traj.AgentSteps[0].Steps, 2) {
+			modelStep := traj.AgentSteps[0].Steps[0]
+			assert.Equal(t, "120", gptr.Indirect(modelStep.ModelInfo.LatencyFirstResp))
+			assert.Equal(t, "tool_calls", modelStep.Metadata["finish_reason"])
+			toolStep := traj.AgentSteps[0].Steps[1]
+			assert.Equal(t, "calculator", gptr.Indirect(toolStep.Name))
+			assert.Equal(t, `{"expr":"1+1"}`, gptr.Indirect(toolStep.Input))
+		}
+		assert.Equal(t, 0.5, gptr.Indirect(traj.RootStep.MetricsInfo.ToolStepProportion))
+	})
+
+	t.Run("chat error", func(t *testing.T) {
+		mockAdapter.EXPECT().ChatCompletion(gomock.Any(), gomock.Any()).Return(nil, errorx.NewByCode(errno.CallTargetFailCode))
+		output, status, err := svc.Execute(context.Background(), 100, param)
+		assert.Error(t, err)
+		assert.Equal(t, entity.EvalTargetRunStatusFail, status)
+		assert.Equal(t, int32(errno.CallTargetFailCode), output.EvalTargetRunError.Code)
+	})
+
+	t.Run("missing chat", func(t *testing.T) {
+		_, status, err := svc.Execute(context.Background(), 100, &entity.ExecuteEvalTargetParam{
+			EvalTarget: &entity.EvalTarget{EvalTargetVersion: &entity.EvalTargetVersion{}},
+		})
+		assert.Error(t, err)
+		assert.Equal(t, entity.EvalTargetRunStatusFail, status)
+	})
+
+	t.Run("async not supported", func(t *testing.T) {
+		_, _, err := svc.AsyncExecute(context.Background(), 100, param)
+		assert.Error(t, err)
+	})
+}
@@ -6,6 +6,7 @@ package service
 import (
 	"github.com/google/wire"
 
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
 	mtr "github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/metrics"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
@@ -17,7 +18,9 @@ import (
 	targetrepo "github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/repo/target"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/data"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/llm"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/openai"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/prompt"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/rpc/spi"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/infra/runtime"
 	evalconf "github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/conf"
 )
@@ -34,6 +37,7 @@ var ExperimentDomainServiceSet = wire.NewSet(
 	NewInsightAnalysisService,
 	NewSchedulerModeFactory,
 	NewExptTemplateManager,
+	NewExptTemplateScheduleService,
 	NewEvaluationAnalysisService,
 	// Repo Sets
 	experimentrepo.ExperimentRepoSet,
@@ -72,6 +76,8 @@ var TargetDomainServiceSet = wire.NewSet(
 	NewSourceTargetOperators,
 	// Infrastructure Sets
 	prompt.PromptRPCSet,
+	spi.SPIRPCSet,
+	openai.OpenAIChatRPCSet,
 	// Repo Sets
 	targetrepo.TargetRepoSet,
 )
@@ -83,6 +89,7 @@ func NewEvaluatorSourceServices(
 	config evalconf.IConfiger,
 	runtimeManager component.IRuntimeManager,
 	codeBuilderFactory CodeBuilderFactory,
+	evaluatorSPIAdapter rpc.IEvaluatorSPIAdapter,
 ) map[entity.EvaluatorType]EvaluatorSourceService {
 	// 设置codeBuilderFactory的runtimeManager依赖
 	codeBuilderFactory.SetRuntimeManager(runtimeManager)
@@ -90,6 +97,7 @@ func NewEvaluatorSourceServices(
 	services := []EvaluatorSourceService{
 		NewEvaluatorSourcePromptServiceImpl(llmProvider, metric, config),
 		NewEvaluatorSourceCodeServiceImpl(runtimeManager, codeBuilderFactory, metric),
+		NewEvaluatorSourceCustomRPCServiceImpl(evaluatorSPIAdapter, metric),
 	}
 
 	serviceMap := make(map[entity.EvaluatorType]EvaluatorSourceService)
@@ -100,8 +108,10 @@ func NewEvaluatorSourceServices(
 }
 
 // NewSourceTargetOperators 创建源目标操作器映射
-func NewSourceTargetOperators(adapter rpc.IPromptRPCAdapter) map[entity.EvalTargetType]ISourceEvalTargetOperateService {
+func NewSourceTargetOperators(adapter rpc.IPromptRPCAdapter, spiAdapter rpc.IEvalTargetSPIAdapter, chatAdapter rpc.IOpenAIChatAdapter, idgen idgen.IIDGenerator) map[entity.EvalTargetType]ISourceEvalTargetOperateService {
 	return map[entity.EvalTargetType]ISourceEvalTargetOperateService{
-		entity.EvalTargetTypeLoopPrompt: NewPromptSourceEvalTargetServiceImpl(adapter),
+		entity.EvalTargetTypeLoopPrompt:      NewPromptSourceEvalTargetServiceImpl(adapter),
+		entity.EvalTargetTypeCustomRPCServer: NewCustomRPCSourceEvalTargetServiceImpl(spiAdapter, idgen),
+		entity.EvalTargetTypeOpenAIChat:      NewOpenAIChatSourceEvalTargetServiceImpl(chatAdapter),
 	}
 }
@@ -21,6 +21,7 @@ const (
 	ExptTurnResultFilterRMQKey      = "expt_turn_result_filter_rmq"
 	ExptExportCSVEventRMQKey        = "expt_export_csv_event_rmq"
 	ExptAnalysisEventRMQKey         = "expt_analysis_event_rmq"
+	ExptGateVerdictEventRMQKey      = "expt_gate_verdict_event_rmq"
 )
 
 type RMQConf struct {
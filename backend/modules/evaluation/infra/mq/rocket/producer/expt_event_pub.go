@@ -59,6 +59,7 @@ func newExptEventPublisher(ctx context.Context, cfgFactory conf.IConfigLoaderFac
 		rocket.ExptOnlineEvalResultRMQKey,
 		rocket.ExptTurnResultFilterRMQKey,
 		rocket.ExptExportCSVEventRMQKey,
+		rocket.ExptGateVerdictEventRMQKey,
 	} {
 		p := &producer{}
 
@@ -134,6 +135,10 @@ func (e *exptEventPublisher) PublishExptExportCSVEvent(ctx context.Context, even
 	return e.batchSend(ctx, rocket.ExptExportCSVEventRMQKey, []any{event}, duration)
 }
 
+func (e *exptEventPublisher) PublishExptGateVerdictEvent(ctx context.Context, event *entity.ExptGateVerdictEvent, duration *time.Duration) error {
+	return e.batchSend(ctx, rocket.ExptGateVerdictEventRMQKey, []any{event}, duration)
+}
+
 func (e *exptEventPublisher) PublishExptOnlineEvalResult(ctx context.Context, event *entity.OnlineExptEvalResultEvent, duration *time.Duration) error {
 	if len(event.TurnEvalResults) == 0 {
 		return nil
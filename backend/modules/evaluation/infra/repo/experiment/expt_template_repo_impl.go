@@ -156,6 +156,25 @@ func (e *exptTemplateRepoImpl) MGetByID(ctx context.Context, ids []int64, spaceI
 	return results, nil
 }
 
+func (e *exptTemplateRepoImpl) ScanByCursor(ctx context.Context, cursor int64, limit int) ([]*entity.ExptTemplate, int64, error) {
+	pos, err := e.templateDAO.ScanByCursor(ctx, cursor, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	templates := make([]*entity.ExptTemplate, 0, len(pos))
+	for _, po := range pos {
+		do, err := convert.NewExptTemplateConverter().PO2DO(po, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		templates = append(templates, do)
+	}
+	if len(pos) < limit {
+		return templates, 0, nil
+	}
+	return templates, pos[len(pos)-1].ID, nil
+}
+
 func (e *exptTemplateRepoImpl) Update(ctx context.Context, template *entity.ExptTemplate) error {
 	po, err := convert.NewExptTemplateConverter().DO2PO(template)
 	if err != nil {
@@ -61,6 +61,14 @@ func (ExptConverter) DO2PO(experiment *entity.Experiment) (*model.Experiment, er
 		expt.EvalConf = &bytes
 	}
 
+	if experiment.GateVerdict != nil {
+		bytes, err := json.Marshal(experiment.GateVerdict)
+		if err != nil {
+			return nil, errorx.Wrapf(err, "ExptGateVerdict json marshal fail")
+		}
+		expt.GateResult = &bytes
+	}
+
 	return expt, nil
 }
 
@@ -74,6 +82,14 @@ func (ExptConverter) PO2DO(expt *model.Experiment, refs []*model.ExptEvaluatorRe
 		return nil, errorx.Wrapf(err, "EvaluationConfiguration json unmarshal fail, expt_id: %v, raw: %v", expt.ID, conv.UnsafeBytesToString(gptr.Indirect(expt.EvalConf)))
 	}
 
+	var gateVerdict *entity.ExptGateVerdict
+	if len(gptr.Indirect(expt.GateResult)) > 0 {
+		gateVerdict = new(entity.ExptGateVerdict)
+		if err := json.Unmarshal(gptr.Indirect(expt.GateResult), gateVerdict); err != nil {
+			return nil, errorx.Wrapf(err, "ExptGateVerdict json unmarshal fail, expt_id: %v, raw: %v", expt.ID, conv.UnsafeBytesToString(gptr.Indirect(expt.GateResult)))
+		}
+	}
+
 	evaluatorVersionRef := make([]*entity.ExptEvaluatorVersionRef, 0, len(refs))
 	for _, ref := range refs {
 		evaluatorVersionRef = append(evaluatorVersionRef, &entity.ExptEvaluatorVersionRef{
@@ -105,6 +121,7 @@ func (ExptConverter) PO2DO(expt *model.Experiment, refs []*model.ExptEvaluatorRe
 		SourceID:            expt.SourceID,
 		ExptType:            entity.ExptType(expt.ExptType),
 		MaxAliveTime:        gptr.Indirect(expt.MaxAliveTime),
+		GateVerdict:         gateVerdict,
 	}
 
 	// 如果数据库中有模板 ID，则在 ExptTemplateMeta 中回填 ID，方便上层按模板 ID 查询和聚合
@@ -89,6 +89,15 @@ func (ExptTemplateConverter) DO2PO(template *entity.ExptTemplate) (*model.ExptTe
 		po.ExptInfo = &bytes
 	}
 
+	// 序列化 ScheduleState
+	if template.ScheduleState != nil {
+		bytes, err := json.Marshal(template.ScheduleState)
+		if err != nil {
+			return nil, errorx.Wrapf(err, "ExptScheduleState json marshal fail")
+		}
+		po.ScheduleState = &bytes
+	}
+
 	return po, nil
 }
 
@@ -245,6 +254,15 @@ func (ExptTemplateConverter) PO2DO(po *model.ExptTemplate, refs []*model.ExptTem
 		}
 	}
 
+	// 反序列化 ScheduleState
+	var scheduleState *entity.ExptScheduleState
+	if po.ScheduleState != nil && len(*po.ScheduleState) > 0 {
+		scheduleState = new(entity.ExptScheduleState)
+		if err := json.Unmarshal(*po.ScheduleState, scheduleState); err != nil {
+			return nil, errorx.Wrapf(err, "ExptScheduleState json unmarshal fail, template_id: %v", po.ID)
+		}
+	}
+
 	return &entity.ExptTemplate{
 		Meta:                meta,
 		TripleConfig:        tripleConfig,
@@ -253,6 +271,7 @@ func (ExptTemplateConverter) PO2DO(po *model.ExptTemplate, refs []*model.ExptTem
 		TemplateConf:        templateConf,
 		BaseInfo:            baseInfo,
 		ExptInfo:            exptInfo,
+		ScheduleState:       scheduleState,
 	}, nil
 }
 
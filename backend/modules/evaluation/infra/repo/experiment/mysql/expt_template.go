@@ -31,6 +31,8 @@ type IExptTemplateDAO interface {
 	UpdateFields(ctx context.Context, id int64, ufields map[string]any) error
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context, page, size int32, filter *entity.ExptTemplateListFilter, orders []*entity.OrderBy, spaceID int64) ([]*model.ExptTemplate, int64, error)
+	// ScanByCursor 按ID升序跨空间扫描未删除的模板，返回ID大于 cursor 的至多 limit 条
+	ScanByCursor(ctx context.Context, cursor int64, limit int) ([]*model.ExptTemplate, error)
 }
 
 func NewExptTemplateDAO(db db.Provider) IExptTemplateDAO {
@@ -120,6 +122,19 @@ func (d *exptTemplateDAOImpl) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+func (d *exptTemplateDAOImpl) ScanByCursor(ctx context.Context, cursor int64, limit int) ([]*model.ExptTemplate, error) {
+	q := query.Use(d.db.NewSession(ctx)).ExptTemplate
+	results, err := q.WithContext(ctx).
+		Where(q.ID.Gt(cursor)).
+		Order(q.ID.Asc()).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, errorx.Wrapf(err, "scan expt_template fail, cursor: %v", cursor)
+	}
+	return results, nil
+}
+
 func (d *exptTemplateDAOImpl) List(ctx context.Context, page, size int32, filter *entity.ExptTemplateListFilter, orders []*entity.OrderBy, spaceID int64) ([]*model.ExptTemplate, int64, error) {
 	var (
 		templates []*model.ExptTemplate
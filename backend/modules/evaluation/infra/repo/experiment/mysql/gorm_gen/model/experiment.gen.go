@@ -39,6 +39,7 @@ type Experiment struct {
 	SourceID         string         `gorm:"column:source_id;type:varchar(128) character set utf8mb4;not null;index:idx_source_type_source_id,priority:2;default:0;comment:实验来源id" json:"source_id"`                                                                                                                                                                                                                                                                                                                                                        // 实验来源id
 	ExptType         int32          `gorm:"column:expt_type;type:int(11) unsigned;not null;default:1;comment:实验类型，offline:1,online:2..." json:"expt_type"`                                                                                                                                                                                                                                                                                                                                                                                                 // 实验类型，offline:1,online:2...
 	MaxAliveTime     *int64         `gorm:"column:max_alive_time;type:bigint(20) unsigned;comment:最大存活时间" json:"max_alive_time"`                                                                                                                                                                                                                                                                                                                                                                                                                           // 最大存活时间
+	GateResult       *[]byte        `gorm:"column:gate_result;type:blob binary;comment:门禁结论" json:"gate_result"`                                                                                                                                                                                                                                                                                                                                                                                                                                           // 门禁结论
 }
 
 // TableName Experiment's table name
@@ -26,6 +26,7 @@ type ExptTemplate struct {
 	ExptType         int32          `gorm:"column:expt_type;type:int(11) unsigned;not null;index:idx_space_id_expt_type_deleted_at,priority:2;default:1;comment:实验类型，offline:1,online:2..." json:"expt_type"`                                                                                                                                                                                  // 实验类型，offline:1,online:2...
 	TemplateConf     *[]byte        `gorm:"column:template_conf;type:blob binary;comment:实验模板配置，包含评估器列表、字段映射、加权配置、默认并发及调度等，json" json:"template_conf"`                                                                                                                                                                                                                                         // 实验模板配置，包含评估器列表、字段映射、加权配置、默认并发及调度等，json
 	ExptInfo         *[]byte        `gorm:"column:expt_info;type:blob binary;comment:实验运行状态，包含创建实验数量，最后一次实验执行状态，json" json:"expt_info"`                                                                                                                                                                                                                                                        // 实验运行状态，包含创建实验数量，最后一次实验执行状态，json
+	ScheduleState    *[]byte        `gorm:"column:schedule_state;type:blob binary;comment:调度状态，包含最近触发时间、跟踪版本及调度记录，json" json:"schedule_state"`                                                                                                                                                                                                                                                 // 调度状态，包含最近触发时间、跟踪版本及调度记录，json
 	CreatedBy        string         `gorm:"column:created_by;type:varchar(128);not null;index:idx_space_id_created_by_deleted_at,priority:2;default:0;comment:创建人" json:"created_by"`                                                                                                                                                                                                          // 创建人
 	UpdatedBy        string         `gorm:"column:updated_by;type:varchar(128);not null;default:0;comment:更新人" json:"updated_by"`                                                                                                                                                                                                                                                              // 更新人
 	CreatedAt        time.Time      `gorm:"column:created_at;type:timestamp;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                                                                                                                                                                                                                                // 创建时间
@@ -39,6 +39,7 @@ func newExptTemplate(db *gorm.DB, opts ...gen.DOOption) exptTemplate {
 	_exptTemplate.ExptType = field.NewInt32(tableName, "expt_type")
 	_exptTemplate.TemplateConf = field.NewBytes(tableName, "template_conf")
 	_exptTemplate.ExptInfo = field.NewBytes(tableName, "expt_info")
+	_exptTemplate.ScheduleState = field.NewBytes(tableName, "schedule_state")
 	_exptTemplate.CreatedBy = field.NewString(tableName, "created_by")
 	_exptTemplate.UpdatedBy = field.NewString(tableName, "updated_by")
 	_exptTemplate.CreatedAt = field.NewTime(tableName, "created_at")
@@ -67,6 +68,7 @@ type exptTemplate struct {
 	ExptType         field.Int32  // 实验类型，offline:1,online:2...
 	TemplateConf     field.Bytes  // 实验模板配置，包含评估器列表、字段映射、加权配置、默认并发及调度等，json
 	ExptInfo         field.Bytes  // 实验运行状态，包含创建实验数量，最后一次实验执行状态，json
+	ScheduleState    field.Bytes  // 调度状态，包含最近触发时间、跟踪版本及调度记录，json
 	CreatedBy        field.String // 创建人
 	UpdatedBy        field.String // 更新人
 	CreatedAt        field.Time   // 创建时间
@@ -100,6 +102,7 @@ func (e *exptTemplate) updateTableName(table string) *exptTemplate {
 	e.ExptType = field.NewInt32(table, "expt_type")
 	e.TemplateConf = field.NewBytes(table, "template_conf")
 	e.ExptInfo = field.NewBytes(table, "expt_info")
+	e.ScheduleState = field.NewBytes(table, "schedule_state")
 	e.CreatedBy = field.NewString(table, "created_by")
 	e.UpdatedBy = field.NewString(table, "updated_by")
 	e.CreatedAt = field.NewTime(table, "created_at")
@@ -133,7 +136,7 @@ func (e *exptTemplate) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (e *exptTemplate) fillFieldMap() {
-	e.fieldMap = make(map[string]field.Expr, 17)
+	e.fieldMap = make(map[string]field.Expr, 18)
 	e.fieldMap["id"] = e.ID
 	e.fieldMap["space_id"] = e.SpaceID
 	e.fieldMap["name"] = e.Name
@@ -146,6 +149,7 @@ func (e *exptTemplate) fillFieldMap() {
 	e.fieldMap["expt_type"] = e.ExptType
 	e.fieldMap["template_conf"] = e.TemplateConf
 	e.fieldMap["expt_info"] = e.ExptInfo
+	e.fieldMap["schedule_state"] = e.ScheduleState
 	e.fieldMap["created_by"] = e.CreatedBy
 	e.fieldMap["updated_by"] = e.UpdatedBy
 	e.fieldMap["created_at"] = e.CreatedAt
@@ -137,6 +137,21 @@ func (mr *MockIExptTemplateDAOMockRecorder) MGetByID(ctx, ids any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetByID", reflect.TypeOf((*MockIExptTemplateDAO)(nil).MGetByID), varargs...)
 }
 
+// ScanByCursor mocks base method.
+func (m *MockIExptTemplateDAO) ScanByCursor(ctx context.Context, cursor int64, limit int) ([]*model.ExptTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanByCursor", ctx, cursor, limit)
+	ret0, _ := ret[0].([]*model.ExptTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanByCursor indicates an expected call of ScanByCursor.
+func (mr *MockIExptTemplateDAOMockRecorder) ScanByCursor(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanByCursor", reflect.TypeOf((*MockIExptTemplateDAO)(nil).ScanByCursor), ctx, cursor, limit)
+}
+
 // Update mocks base method.
 func (m *MockIExptTemplateDAO) Update(ctx context.Context, template *model.ExptTemplate) error {
 	m.ctrl.T.Helper()
@@ -68,6 +68,11 @@ func EvalTargetVersionDO2PO(do *entity.EvalTargetVersion) (po *model.TargetVersi
 		if err != nil {
 			return nil, err
 		}
+	case entity.EvalTargetTypeOpenAIChat:
+		meta, err = json.Marshal(do.OpenAIChat)
+		if err != nil {
+			return nil, err
+		}
 	default:
 	}
 	if do.InputSchema != nil {
@@ -211,6 +216,11 @@ func EvalTargetVersionPO2DO(targetVersionPO *model.TargetVersion, targetType ent
 			if err := json.Unmarshal(*targetVersionPO.TargetMeta, meta); err == nil {
 				targetVersionDO.CustomRPCServer = meta
 			}
+		case entity.EvalTargetTypeOpenAIChat:
+			meta := &entity.OpenAIChat{}
+			if err := json.Unmarshal(*targetVersionPO.TargetMeta, meta); err == nil {
+				targetVersionDO.OpenAIChat = meta
+			}
 		default:
 			// todo
 		}
@@ -0,0 +1,258 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+const (
+	chatCompletionsPath = "/chat/completions"
+	defaultTimeout      = 3 * time.Minute
+	maxSSELineBytes     = 4 << 20
+	maxErrorBodyBytes   = 4 << 10
+	sseDataPrefix       = "data:"
+	sseDone             = "[DONE]"
+)
+
+type OpenAIChatAdapter struct {
+	client *http.Client
+}
+
+func NewOpenAIChatAdapter() rpc.IOpenAIChatAdapter {
+	// 超时由每次调用的ctx控制，流式响应不能设置client整体超时
+	return &OpenAIChatAdapter{client: &http.Client{}}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type streamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+type chatRequest struct {
+	Model         string         `json:"model"`
+	Messages      []*chatMessage `json:"messages"`
+	Stream        bool           `json:"stream,omitempty"`
+	StreamOptions *streamOptions `json:"stream_options,omitempty"`
+}
+
+type chatToolCall struct {
+	Index    *int   `json:"index,omitempty"`
+	ID       string `json:"id,omitempty"`
+	Function struct {
+		Name      string `json:"name,omitempty"`
+		Arguments string `json:"arguments,omitempty"`
+	} `json:"function"`
+}
+
+type chatResponseMessage struct {
+	Content          string          `json:"content"`
+	ReasoningContent string          `json:"reasoning_content"`
+	ToolCalls        []*chatToolCall `json:"tool_calls"`
+}
+
+type chatChoice struct {
+	Message      *chatResponseMessage `json:"message"`
+	Delta        *chatResponseMessage `json:"delta"`
+	FinishReason *string              `json:"finish_reason"`
+}
+
+type chatUsage struct {
+	PromptTokens     int64 `json:"prompt_tokens"`
+	CompletionTokens int64 `json:"completion_tokens"`
+}
+
+type chatError struct {
+	Message string `json:"message"`
+}
+
+type chatResponse struct {
+	Choices []*chatChoice `json:"choices"`
+	Usage   *chatUsage    `json:"usage"`
+	Error   *chatError    `json:"error"`
+}
+
+func (a *OpenAIChatAdapter) ChatCompletion(ctx context.Context, param *rpc.OpenAIChatParam) (*rpc.OpenAIChatResult, error) {
+	chat := param.Chat
+	req := &chatRequest{
+		Model:    chat.Model,
+		Messages: make([]*chatMessage, 0, len(param.Messages)),
+		Stream:   chat.Stream,
+	}
+	for _, msg := range param.Messages {
+		req.Messages = append(req.Messages, &chatMessage{Role: msg.Role, Content: msg.Content})
+	}
+	if chat.Stream {
+		req.StreamOptions = &streamOptions{IncludeUsage: true}
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, errorx.Wrapf(err, "marshal chat completion request fail")
+	}
+
+	timeout := defaultTimeout
+	if t := gptr.Indirect(chat.Timeout); t > 0 {
+		timeout = time.Duration(t) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(chat.BaseURL, "/")+chatCompletionsPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg(err.Error()))
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if chat.Stream {
+		httpReq.Header.Set("Accept", "text/event-stream")
+	}
+	for k, v := range chat.Headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	start := time.Now()
+	resp, err := a.client.Do(httpReq)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.CallTargetFailCode)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return nil, errorx.NewByCode(errno.CallTargetFailCode,
+			errorx.WithExtraMsg(fmt.Sprintf("chat completion status %d: %s", resp.StatusCode, strings.TrimSpace(string(b)))))
+	}
+
+	if chat.Stream {
+		return readStream(resp.Body, start)
+	}
+	return readResponse(resp.Body)
+}
+
+func readResponse(r io.Reader) (*rpc.OpenAIChatResult, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.CallTargetFailCode)
+	}
+	resp := &chatResponse{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, errorx.WrapByCode(err, errno.CallTargetFailCode, errorx.WithExtraMsg("invalid chat completion response"))
+	}
+	if resp.Error != nil {
+		return nil, errorx.NewByCode(errno.CallTargetFailCode, errorx.WithExtraMsg(resp.Error.Message))
+	}
+	res := &rpc.OpenAIChatResult{}
+	if len(resp.Choices) > 0 && resp.Choices[0] != nil {
+		choice := resp.Choices[0]
+		if msg := choice.Message; msg != nil {
+			res.Content = msg.Content
+			res.ReasoningContent = msg.ReasoningContent
+			for _, tc := range msg.ToolCalls {
+				res.ToolCalls = append(res.ToolCalls, &rpc.OpenAIChatToolCall{ID: tc.ID, Name: tc.Function.Name, Arguments: tc.Function.Arguments})
+			}
+		}
+		res.FinishReason = gptr.Indirect(choice.FinishReason)
+	}
+	if resp.Usage != nil {
+		res.InputTokens = resp.Usage.PromptTokens
+		res.OutputTokens = resp.Usage.CompletionTokens
+	}
+	return res, nil
+}
+
+// readStream 解析SSE分片，按index聚合增量的tool call，首个内容分片到达时记录首包耗时
+func readStream(r io.Reader, start time.Time) (*rpc.OpenAIChatResult, error) {
+	res := &rpc.OpenAIChatResult{}
+	var content, reasoning strings.Builder
+	toolCalls := make(map[int]*rpc.OpenAIChatToolCall)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxSSELineBytes)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, sseDataPrefix) {
+			continue
+		}
+		data := strings.TrimSpace(strings.TrimPrefix(line, sseDataPrefix))
+		if data == sseDone {
+			break
+		}
+		chunk := &chatResponse{}
+		if err := json.Unmarshal([]byte(data), chunk); err != nil {
+			return nil, errorx.WrapByCode(err, errno.CallTargetFailCode, errorx.WithExtraMsg("invalid chat completion chunk"))
+		}
+		if chunk.Error != nil {
+			return nil, errorx.NewByCode(errno.CallTargetFailCode, errorx.WithExtraMsg(chunk.Error.Message))
+		}
+		if chunk.Usage != nil {
+			res.InputTokens = chunk.Usage.PromptTokens
+			res.OutputTokens = chunk.Usage.CompletionTokens
+		}
+		for _, choice := range chunk.Choices {
+			if choice == nil {
+				continue
+			}
+			if choice.FinishReason != nil {
+				res.FinishReason = *choice.FinishReason
+			}
+			delta := choice.Delta
+			if delta == nil {
+				continue
+			}
+			if res.FirstTokenMS == nil && (delta.Content != "" || delta.ReasoningContent != "" || len(delta.ToolCalls) > 0) {
+				res.FirstTokenMS = gptr.Of(time.Since(start).Milliseconds())
+			}
+			content.WriteString(delta.Content)
+			reasoning.WriteString(delta.ReasoningContent)
+			for i, tc := range delta.ToolCalls {
+				idx := i
+				if tc.Index != nil {
+					idx = *tc.Index
+				}
+				call, ok := toolCalls[idx]
+				if !ok {
+					call = &rpc.OpenAIChatToolCall{}
+					toolCalls[idx] = call
+				}
+				if tc.ID != "" {
+					call.ID = tc.ID
+				}
+				call.Name += tc.Function.Name
+				call.Arguments += tc.Function.Arguments
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errorx.WrapByCode(err, errno.CallTargetFailCode)
+	}
+
+	res.Content = content.String()
+	res.ReasoningContent = reasoning.String()
+	indexes := make([]int, 0, len(toolCalls))
+	for idx := range toolCalls {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+	for _, idx := range indexes {
+		res.ToolCalls = append(res.ToolCalls, toolCalls[idx])
+	}
+	return res, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openai
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func newChatServer(t *testing.T, handler func(w http.ResponseWriter, req *chatRequest)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		req := &chatRequest{}
+		assert.NoError(t, json.Unmarshal(b, req))
+		handler(w, req)
+	}))
+}
+
+func newChatParam(baseURL string, stream bool) *rpc.OpenAIChatParam {
+	return &rpc.OpenAIChatParam{
+		Chat: &entity.OpenAIChat{
+			BaseURL: baseURL + "/v1/",
+			Model:   "gpt-test",
+			Headers: map[string]string{"Authorization": "Bearer sk-test"},
+			Stream:  stream,
+		},
+		Messages: []*entity.OpenAIChatMessage{{Role: entity.OpenAIChatRoleUser, Content: "hi"}},
+	}
+}
+
+func TestOpenAIChatAdapter_ChatCompletion(t *testing.T) {
+	adapter := NewOpenAIChatAdapter()
+
+	t.Run("non-stream", func(t *testing.T) {
+		srv := newChatServer(t, func(w http.ResponseWriter, req *chatRequest) {
+			assert.Equal(t, "gpt-test", req.Model)
+			assert.False(t, req.Stream)
+			assert.Nil(t, req.StreamOptions)
+			if assert.Len(t, req.Messages, 1) {
+				assert.Equal(t, "hi", req.Messages[0].Content)
+			}
+			_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"hello","tool_calls":[{"id":"call_1","type":"function","function":{"name":"search","arguments":"{\"q\":\"x\"}"}}]},"finish_reason":"tool_calls"}],"usage":{"prompt_tokens":3,"completion_tokens":5}}`))
+		})
+		defer srv.Close()
+
+		res, err := adapter.ChatCompletion(context.Background(), newChatParam(srv.URL, false))
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", res.Content)
+		assert.Equal(t, "tool_calls", res.FinishReason)
+		assert.Equal(t, int64(3), res.InputTokens)
+		assert.Equal(t, int64(5), res.OutputTokens)
+		assert.Nil(t, res.FirstTokenMS)
+		assert.Equal(t, []*rpc.OpenAIChatToolCall{{ID: "call_1", Name: "search", Arguments: `{"q":"x"}`}}, res.ToolCalls)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		srv := newChatServer(t, func(w http.ResponseWriter, req *chatRequest) {
+			assert.True(t, req.Stream)
+			if assert.NotNil(t, req.StreamOptions) {
+				assert.True(t, req.StreamOptions.IncludeUsage)
+			}
+			w.Header().Set("Content-Type", "text/event-stream")
+			chunks := []string{
+				`{"choices":[{"delta":{"role":"assistant","content":""}}]}`,
+				`{"choices":[{"delta":{"content":"hel"}}]}`,
+				`{"choices":[{"delta":{"content":"lo","tool_calls":[{"index":0,"id":"call_1","function":{"name":"search","arguments":"{\"q\""}}]}}]}`,
+				`{"choices":[{"delta":{"tool_calls":[{"index":0,"function":{"arguments":":\"x\"}"}}]},"finish_reason":"tool_calls"}]}`,
+				`{"choices":[],"usage":{"prompt_tokens":3,"completion_tokens":5}}`,
+				`[DONE]`,
+			}
+			for _, c := range chunks {
+				_, _ = fmt.Fprintf(w, "data: %s\n\n", c)
+			}
+		})
+		defer srv.Close()
+
+		res, err := adapter.ChatCompletion(context.Background(), newChatParam(srv.URL, true))
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", res.Content)
+		assert.Equal(t, "tool_calls", res.FinishReason)
+		assert.Equal(t, int64(8), res.InputTokens+res.OutputTokens)
+		assert.NotNil(t, res.FirstTokenMS)
+		assert.Equal(t, []*rpc.OpenAIChatToolCall{{ID: "call_1", Name: "search", Arguments: `{"q":"x"}`}}, res.ToolCalls)
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		srv := newChatServer(t, func(w http.ResponseWriter, req *chatRequest) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"message":"invalid api key"}}`))
+		})
+		defer srv.Close()
+
+		_, err := adapter.ChatCompletion(context.Background(), newChatParam(srv.URL, false))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "invalid api key")
+		}
+	})
+
+	t.Run("stream error chunk", func(t *testing.T) {
+		srv := newChatServer(t, func(w http.ResponseWriter, req *chatRequest) {
+			_, _ = fmt.Fprint(w, "data: {\"error\":{\"message\":\"overloaded\"}}\n\n")
+		})
+		defer srv.Close()
+
+		_, err := adapter.ChatCompletion(context.Background(), newChatParam(srv.URL, true))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,12 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openai
+
+import (
+	"github.com/google/wire"
+)
+
+var OpenAIChatRPCSet = wire.NewSet(
+	NewOpenAIChatAdapter,
+)
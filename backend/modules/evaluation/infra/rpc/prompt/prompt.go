@@ -212,12 +212,17 @@ func (p PromptRPCAdapter) ListPromptVersion(ctx context.Context, param *rpc.List
 	}
 	res := make([]*rpc.CommitInfo, 0)
 	for _, c := range resp.GetPromptCommitInfos() {
+		var labels []string
+		for _, label := range resp.GetCommitVersionLabelMapping()[c.GetVersion()] {
+			labels = append(labels, label.GetKey())
+		}
 		res = append(res, &rpc.CommitInfo{
 			Version:     gptr.Of(c.GetVersion()),
 			BaseVersion: gptr.Of(c.GetBaseVersion()),
 			Description: gptr.Of(c.GetDescription()),
 			CommittedAt: gptr.Of(c.GetCommittedAt()),
 			CommittedBy: gptr.Of(c.GetCommittedBy()),
+			Labels:      labels,
 		})
 	}
 	if resp.NextPageToken == nil {
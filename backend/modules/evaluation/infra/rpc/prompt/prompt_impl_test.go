@@ -221,13 +221,16 @@ func TestPromptRPCAdapter_ListPromptVersion(t *testing.T) {
 			PromptCommitInfos: []*prompt.CommitInfo{
 				{Version: gptr.Of("v1")},
 			},
-			NextPageToken: gptr.Of("next"),
+			CommitVersionLabelMapping: map[string][]*prompt.Label{"v1": {{Key: gptr.Of("prod")}}},
+			NextPageToken:             gptr.Of("next"),
 		}, nil)
 
 		res, next, err := adapter.ListPromptVersion(ctx, &rpc.ListPromptVersionParam{PromptID: 1})
 		assert.NoError(t, err)
 		assert.Equal(t, "next", next)
-		assert.Len(t, res, 1)
+		if assert.Len(t, res, 1) {
+			assert.Equal(t, []string{"prod"}, res[0].Labels)
+		}
 	})
 
 	t.Run("no_next_page", func(t *testing.T) {
@@ -0,0 +1,177 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	infrahttp "github.com/coze-dev/coze-loop/backend/infra/http"
+	spidto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/spi"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	defaultEvaluatorMaxRetries = 2
+	maxEvaluatorMaxRetries     = 5
+)
+
+// evaluatorRetryBackoff 第n次重试前等待n*evaluatorRetryBackoff
+var evaluatorRetryBackoff = 500 * time.Millisecond
+
+type EvaluatorSPIAdapter struct {
+	httpClient infrahttp.IClient
+}
+
+func NewEvaluatorSPIAdapter(httpClient infrahttp.IClient) rpc.IEvaluatorSPIAdapter {
+	return &EvaluatorSPIAdapter{httpClient: httpClient}
+}
+
+func (a *EvaluatorSPIAdapter) InvokeEvaluator(ctx context.Context, param *rpc.InvokeEvaluatorParam) (*entity.EvaluatorOutputData, entity.EvaluatorRunStatus, error) {
+	evaluator := param.Evaluator
+	if evaluator == nil || evaluator.InvokeHTTPInfo == nil {
+		return nil, entity.EvaluatorRunStatusFail, errorx.NewByCode(errno.InvalidEvaluatorConfigurationCode, errorx.WithExtraMsg("invoke http info of custom rpc evaluator is empty"))
+	}
+	req := &spidto.InvokeEvaluatorRequest{
+		WorkspaceID: gptr.Of(param.SpaceID),
+		Evaluator:   &spidto.InvokeCustomEvaluator{ProviderEvaluatorCode: evaluator.ProviderEvaluatorCode},
+		InputData:   evaluatorInputDO2DTO(param.Input),
+	}
+
+	maxRetries := defaultEvaluatorMaxRetries
+	if evaluator.MaxRetries != nil {
+		maxRetries = min(int(*evaluator.MaxRetries), maxEvaluatorMaxRetries)
+	}
+	var resp *spidto.InvokeEvaluatorResponse
+	var err error
+	for attempt := 0; ; attempt++ {
+		resp = &spidto.InvokeEvaluatorResponse{}
+		err = a.httpClient.DoHTTPRequest(ctx, buildEvaluatorRequestParam(evaluator, evaluator.InvokeHTTPInfo, req, resp))
+		if err == nil || attempt >= maxRetries {
+			break
+		}
+		logs.CtxWarn(ctx, "invoke custom rpc evaluator fail, attempt: %d, err: %v", attempt+1, err)
+		select {
+		case <-ctx.Done():
+			return nil, entity.EvaluatorRunStatusFail, errorx.WrapByCode(err, errno.CustomRPCEvaluatorRunFailedCode)
+		case <-time.After(time.Duration(attempt+1) * evaluatorRetryBackoff):
+		}
+	}
+	if err != nil {
+		return nil, entity.EvaluatorRunStatusFail, errorx.WrapByCode(err, errno.CustomRPCEvaluatorRunFailedCode)
+	}
+
+	switch resp.GetStatus() {
+	case spidto.InvokeEvaluatorRunStatus_SUCCESS:
+		return evaluatorOutputDTO2DO(resp.GetOutputData()), entity.EvaluatorRunStatusSuccess, nil
+	case spidto.InvokeEvaluatorRunStatus_FAILED:
+		runErr := &entity.EvaluatorRunError{Code: errno.CustomRPCEvaluatorRunFailedCode}
+		if e := resp.GetOutputData().GetEvaluatorRunError(); e != nil {
+			runErr.Message = e.GetMessage()
+			if e.Code != nil {
+				runErr.Code = e.GetCode()
+			}
+		}
+		return &entity.EvaluatorOutputData{EvaluatorRunError: runErr}, entity.EvaluatorRunStatusFail, nil
+	default:
+		return nil, entity.EvaluatorRunStatusFail, errorx.NewByCode(errno.CustomRPCEvaluatorRunFailedCode,
+			errorx.WithExtraMsg("unknown evaluator run status "+resp.GetStatus().String()))
+	}
+}
+
+func (a *EvaluatorSPIAdapter) HealthCheck(ctx context.Context, evaluator *entity.CustomRPCEvaluatorVersion) error {
+	if evaluator == nil || evaluator.HealthCheckHTTPInfo == nil {
+		return nil
+	}
+	if err := a.httpClient.DoHTTPRequest(ctx, buildEvaluatorRequestParam(evaluator, evaluator.HealthCheckHTTPInfo, nil, nil)); err != nil {
+		return errorx.WrapByCode(err, errno.InvalidEvaluatorConfigurationCode, errorx.WithExtraMsg("health check of custom rpc evaluator failed"))
+	}
+	return nil
+}
+
+func buildEvaluatorRequestParam(evaluator *entity.CustomRPCEvaluatorVersion, httpInfo *entity.EvaluatorHTTPInfo, req, resp any) *infrahttp.RequestParam {
+	param := &infrahttp.RequestParam{
+		RequestURI: buildRequestURL(gptr.Indirect(evaluator.Endpoint), gptr.Indirect(httpInfo.Path)),
+		Method:     http.MethodPost,
+		Header:     evaluator.Headers,
+		Body:       req,
+		Response:   resp,
+	}
+	if gptr.Indirect(httpInfo.Method) == entity.EvaluatorHTTPMethodGet {
+		param.Method = http.MethodGet
+	}
+	if timeout := gptr.Indirect(evaluator.Timeout); timeout > 0 {
+		param.Timeout = time.Duration(timeout) * time.Millisecond
+	}
+	return param
+}
+
+func evaluatorInputDO2DTO(input *entity.EvaluatorInputData) *spidto.InvokeEvaluatorInputData {
+	if input == nil {
+		return nil
+	}
+	convert := func(fields map[string]*entity.Content) map[string]*spidto.Content {
+		if fields == nil {
+			return nil
+		}
+		ret := make(map[string]*spidto.Content, len(fields))
+		for k, v := range fields {
+			ret[k] = contentDO2DTO(v)
+		}
+		return ret
+	}
+	return &spidto.InvokeEvaluatorInputData{
+		InputFields:                convert(input.InputFields),
+		EvaluateDatasetFields:      convert(input.EvaluateDatasetFields),
+		EvaluateTargetOutputFields: convert(input.EvaluateTargetOutputFields),
+		Ext:                        input.Ext,
+	}
+}
+
+func evaluatorOutputDTO2DO(output *spidto.InvokeEvaluatorOutputData) *entity.EvaluatorOutputData {
+	ret := &entity.EvaluatorOutputData{}
+	if result := output.GetEvaluatorResult_(); result != nil {
+		ret.EvaluatorResult = &entity.EvaluatorResult{
+			Score:      result.Score,
+			Reasoning:  result.GetReasoning(),
+			Dimensions: evaluatorDimensionsDTO2DO(result.GetDimensions()),
+		}
+	}
+	if usage := output.GetEvaluatorUsage(); usage != nil {
+		ret.EvaluatorUsage = &entity.EvaluatorUsage{
+			InputTokens:  usage.GetInputTokens(),
+			OutputTokens: usage.GetOutputTokens(),
+		}
+	}
+	return ret
+}
+
+// evaluatorDimensionsDTO2DO 转换自定义评估器返回的多维度结果，与内置评估器一致丢弃无名称或既无得分也无标签的维度
+func evaluatorDimensionsDTO2DO(dtos []*spidto.InvokeEvaluatorDimensionResult_) []*entity.EvaluatorDimensionResult {
+	var dimensions []*entity.EvaluatorDimensionResult
+	for _, dto := range dtos {
+		if dto == nil {
+			continue
+		}
+		name := strings.TrimSpace(dto.GetName())
+		if name == "" || (dto.Score == nil && dto.GetLabel() == "") {
+			continue
+		}
+		dimensions = append(dimensions, &entity.EvaluatorDimensionResult{
+			Name:      name,
+			Score:     dto.Score,
+			Label:     dto.GetLabel(),
+			Reasoning: dto.GetReasoning(),
+		})
+	}
+	return dimensions
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	infrahttp "github.com/coze-dev/coze-loop/backend/infra/http"
+	httpmocks "github.com/coze-dev/coze-loop/backend/infra/http/mocks"
+	spidto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/spi"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func newTestEvaluator() *entity.CustomRPCEvaluatorVersion {
+	return &entity.CustomRPCEvaluatorVersion{
+		ProviderEvaluatorCode: gptr.Of("E001"),
+		AccessProtocol:        entity.EvaluatorAccessProtocolHTTP,
+		Endpoint:              gptr.Of("https://example.com/evaluator"),
+		Headers:               map[string]string{"Authorization": "Bearer token"},
+		InvokeHTTPInfo:        &entity.EvaluatorHTTPInfo{Method: gptr.Of(entity.EvaluatorHTTPMethodPost), Path: gptr.Of("/invoke")},
+		HealthCheckHTTPInfo:   &entity.EvaluatorHTTPInfo{Method: gptr.Of(entity.EvaluatorHTTPMethodGet), Path: gptr.Of("/health")},
+		Timeout:               gptr.Of(int64(3000)),
+		MaxRetries:            gptr.Of(int32(1)),
+	}
+}
+
+func TestEvaluatorSPIAdapter_InvokeEvaluator(t *testing.T) {
+	evaluatorRetryBackoff = time.Millisecond
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := httpmocks.NewMockIClient(ctrl)
+	adapter := NewEvaluatorSPIAdapter(mockClient)
+
+	param := &rpc.InvokeEvaluatorParam{
+		SpaceID:   100,
+		Evaluator: newTestEvaluator(),
+		Input: &entity.EvaluatorInputData{
+			InputFields:                map[string]*entity.Content{"input": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("1+1")}},
+			EvaluateTargetOutputFields: map[string]*entity.Content{"actual_output": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("2")}},
+		},
+	}
+
+	t.Run("success after retry", func(t *testing.T) {
+		gomock.InOrder(
+			mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).Return(errors.New("connection reset")),
+			mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, p *infrahttp.RequestParam) error {
+				assert.Equal(t, "https://example.com/evaluator/invoke", p.RequestURI)
+				assert.Equal(t, http.MethodPost, p.Method)
+				assert.Equal(t, "Bearer token", p.Header["Authorization"])
+				assert.Equal(t, 3*time.Second, p.Timeout)
+				req := p.Body.(*spidto.InvokeEvaluatorRequest)
+				assert.Equal(t, int64(100), req.GetWorkspaceID())
+				assert.Equal(t, "E001", req.GetEvaluator().GetProviderEvaluatorCode())
+				assert.Equal(t, "1+1", req.GetInputData().GetInputFields()["input"].GetText())
+				assert.Equal(t, "2", req.GetInputData().GetEvaluateTargetOutputFields()["actual_output"].GetText())
+
+				resp := p.Response.(*spidto.InvokeEvaluatorResponse)
+				resp.Status = gptr.Of(spidto.InvokeEvaluatorRunStatus_SUCCESS)
+				resp.OutputData = &spidto.InvokeEvaluatorOutputData{
+					EvaluatorResult_: &spidto.InvokeEvaluatorResult_{Score: gptr.Of(0.8), Reasoning: gptr.Of("mostly correct")},
+					EvaluatorUsage:   &spidto.InvokeEvaluatorUsage{InputTokens: gptr.Of(int64(10)), OutputTokens: gptr.Of(int64(5))},
+				}
+				return nil
+			}),
+		)
+		output, status, err := adapter.InvokeEvaluator(context.Background(), param)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvaluatorRunStatusSuccess, status)
+		assert.Equal(t, 0.8, gptr.Indirect(output.EvaluatorResult.Score))
+		assert.Equal(t, "mostly correct", output.EvaluatorResult.Reasoning)
+		assert.Equal(t, int64(10), output.EvaluatorUsage.InputTokens)
+		assert.Equal(t, int64(5), output.EvaluatorUsage.OutputTokens)
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).Return(errors.New("timeout")).Times(2)
+		_, status, err := adapter.InvokeEvaluator(context.Background(), param)
+		assert.Error(t, err)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		statusErr, ok := errorx.FromStatusError(err)
+		assert.True(t, ok)
+		assert.Equal(t, int32(errno.CustomRPCEvaluatorRunFailedCode), statusErr.Code())
+	})
+
+	t.Run("evaluator failed", func(t *testing.T) {
+		mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, p *infrahttp.RequestParam) error {
+			resp := p.Response.(*spidto.InvokeEvaluatorResponse)
+			resp.Status = gptr.Of(spidto.InvokeEvaluatorRunStatus_FAILED)
+			resp.OutputData = &spidto.InvokeEvaluatorOutputData{
+				EvaluatorRunError: &spidto.InvokeEvaluatorRunError{Message: gptr.Of("model overloaded")},
+			}
+			return nil
+		})
+		output, status, err := adapter.InvokeEvaluator(context.Background(), param)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+		assert.Equal(t, int32(errno.CustomRPCEvaluatorRunFailedCode), output.EvaluatorRunError.Code)
+		assert.Equal(t, "model overloaded", output.EvaluatorRunError.Message)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).Return(nil)
+		_, status, err := adapter.InvokeEvaluator(context.Background(), param)
+		assert.Error(t, err)
+		assert.Equal(t, entity.EvaluatorRunStatusFail, status)
+	})
+}
+
+func TestEvaluatorOutputDTO2DO_Dimensions(t *testing.T) {
+	output := evaluatorOutputDTO2DO(&spidto.InvokeEvaluatorOutputData{
+		EvaluatorResult_: &spidto.InvokeEvaluatorResult_{
+			Score: gptr.Of(0.6),
+			Dimensions: []*spidto.InvokeEvaluatorDimensionResult_{
+				{Name: gptr.Of("accuracy"), Score: gptr.Of(0.9), Reasoning: gptr.Of("facts match")},
+				{Name: gptr.Of(" tone "), Label: gptr.Of("polite")},
+				nil,
+				{Name: gptr.Of(""), Score: gptr.Of(1.0)},
+				{Name: gptr.Of("safety")},
+			},
+		},
+	})
+	assert.Equal(t, []*entity.EvaluatorDimensionResult{
+		{Name: "accuracy", Score: gptr.Of(0.9), Reasoning: "facts match"},
+		{Name: "tone", Label: "polite"},
+	}, output.EvaluatorResult.Dimensions)
+
+	output = evaluatorOutputDTO2DO(&spidto.InvokeEvaluatorOutputData{
+		EvaluatorResult_: &spidto.InvokeEvaluatorResult_{Score: gptr.Of(0.6)},
+	})
+	assert.Nil(t, output.EvaluatorResult.Dimensions)
+}
+
+func TestEvaluatorSPIAdapter_HealthCheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := httpmocks.NewMockIClient(ctrl)
+	adapter := NewEvaluatorSPIAdapter(mockClient)
+
+	mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, p *infrahttp.RequestParam) error {
+		assert.Equal(t, "https://example.com/evaluator/health", p.RequestURI)
+		assert.Equal(t, http.MethodGet, p.Method)
+		assert.Nil(t, p.Body)
+		return nil
+	})
+	assert.NoError(t, adapter.HealthCheck(context.Background(), newTestEvaluator()))
+
+	mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).Return(errors.New("HTTP request failed with status 503"))
+	assert.Error(t, adapter.HealthCheck(context.Background(), newTestEvaluator()))
+
+	evaluator := newTestEvaluator()
+	evaluator.HealthCheckHTTPInfo = nil
+	assert.NoError(t, adapter.HealthCheck(context.Background(), evaluator))
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+
+	infrahttp "github.com/coze-dev/coze-loop/backend/infra/http"
+	spidto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/spi"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+const (
+	searchExtKeyRegion  = "search_region"
+	searchExtKeySpaceID = "search_space_id"
+)
+
+type EvalTargetSPIAdapter struct {
+	httpClient infrahttp.IClient
+}
+
+func NewEvalTargetSPIAdapter(httpClient infrahttp.IClient) rpc.IEvalTargetSPIAdapter {
+	return &EvalTargetSPIAdapter{httpClient: httpClient}
+}
+
+func (a *EvalTargetSPIAdapter) SearchEvalTarget(ctx context.Context, param *rpc.SearchEvalTargetParam) ([]*entity.CustomEvalTarget, string, bool, error) {
+	req := &spidto.SearchEvalTargetRequest{
+		WorkspaceID: gptr.Of(param.SpaceID),
+		Keyword:     param.Keyword,
+		Ext:         map[string]string{searchExtKeySpaceID: strconv.FormatInt(param.SpaceID, 10)},
+		PageSize:    param.PageSize,
+		PageToken:   param.PageToken,
+	}
+	if param.Region != nil {
+		req.Ext[searchExtKeyRegion] = *param.Region
+	}
+	resp := &spidto.SearchEvalTargetResponse{}
+	if err := a.call(ctx, param.Server, param.Server.SearchHTTPInfo, req, resp); err != nil {
+		return nil, "", false, err
+	}
+	targets := make([]*entity.CustomEvalTarget, 0, len(resp.CustomEvalTargets))
+	for _, t := range resp.CustomEvalTargets {
+		if t == nil {
+			continue
+		}
+		targets = append(targets, &entity.CustomEvalTarget{
+			ID:        t.ID,
+			Name:      t.Name,
+			AvatarURL: t.AvatarURL,
+		})
+	}
+	return targets, resp.GetNextPageToken(), resp.GetHasMore(), nil
+}
+
+func (a *EvalTargetSPIAdapter) InvokeEvalTarget(ctx context.Context, param *rpc.InvokeEvalTargetParam) (*entity.EvalTargetOutputData, entity.EvalTargetRunStatus, error) {
+	req := &spidto.InvokeEvalTargetRequest{
+		WorkspaceID:      gptr.Of(param.SpaceID),
+		Input:            invokeInputDO2DTO(param.Input),
+		CustomEvalTarget: customEvalTargetDO2DTO(param.Server.CustomEvalTarget),
+	}
+	resp := &spidto.InvokeEvalTargetResponse{}
+	if err := a.call(ctx, param.Server, param.Server.InvokeHTTPInfo, req, resp); err != nil {
+		return nil, entity.EvalTargetRunStatusFail, err
+	}
+	switch resp.GetStatus() {
+	case spidto.InvokeEvalTargetStatus_SUCCESS:
+		return invokeOutputDTO2DO(resp.GetOutput(), resp.GetUsage()), entity.EvalTargetRunStatusSuccess, nil
+	case spidto.InvokeEvalTargetStatus_FAILED:
+		return &entity.EvalTargetOutputData{
+			EvalTargetRunError: &entity.EvalTargetRunError{
+				Code:    errno.CustomEvalTargetInvokeFailCode,
+				Message: resp.GetErrorMessage(),
+			},
+		}, entity.EvalTargetRunStatusFail, nil
+	default:
+		return nil, entity.EvalTargetRunStatusFail, errorx.NewByCode(errno.CustomEvalTargetInvokeFailCode,
+			errorx.WithExtraMsg("unknown invoke status "+resp.GetStatus().String()))
+	}
+}
+
+func (a *EvalTargetSPIAdapter) AsyncInvokeEvalTarget(ctx context.Context, param *rpc.InvokeEvalTargetParam, invokeID int64) error {
+	req := &spidto.AsyncInvokeEvalTargetRequest{
+		WorkspaceID:      gptr.Of(param.SpaceID),
+		InvokeID:         gptr.Of(invokeID),
+		Input:            invokeInputDO2DTO(param.Input),
+		CustomEvalTarget: customEvalTargetDO2DTO(param.Server.CustomEvalTarget),
+	}
+	return a.call(ctx, param.Server, param.Server.AsyncInvokeHTTPInfo, req, &spidto.AsyncInvokeEvalTargetResponse{})
+}
+
+func (a *EvalTargetSPIAdapter) call(ctx context.Context, server *entity.CustomRPCServer, httpInfo *entity.HTTPInfo, req, resp any) error {
+	if httpInfo == nil {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("http info of custom rpc server is empty"))
+	}
+	param := &infrahttp.RequestParam{
+		RequestURI: buildRequestURL(server.Endpoint, httpInfo.Path),
+		Method:     http.MethodPost,
+		Header:     server.Headers,
+		Body:       req,
+		Response:   resp,
+	}
+	if httpInfo.Method == entity.HTTPMethodGet {
+		param.Method = http.MethodGet
+	}
+	if timeout := gptr.Indirect(server.Timeout); timeout > 0 {
+		param.Timeout = time.Duration(timeout) * time.Millisecond
+	}
+	if err := a.httpClient.DoHTTPRequest(ctx, param); err != nil {
+		return errorx.WrapByCode(err, errno.CustomEvalTargetInvokeFailCode)
+	}
+	return nil
+}
+
+func buildRequestURL(endpoint, path string) string {
+	if path == "" {
+		return endpoint
+	}
+	return strings.TrimSuffix(endpoint, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+func customEvalTargetDO2DTO(t *entity.CustomEvalTarget) *spidto.CustomEvalTarget {
+	if t == nil {
+		return nil
+	}
+	return &spidto.CustomEvalTarget{
+		ID:        t.ID,
+		Name:      t.Name,
+		AvatarURL: t.AvatarURL,
+	}
+}
+
+func invokeInputDO2DTO(input *entity.EvalTargetInputData) *spidto.InvokeEvalTargetInput {
+	if input == nil {
+		return nil
+	}
+	fields := make(map[string]*spidto.Content, len(input.InputFields))
+	for k, v := range input.InputFields {
+		fields[k] = contentDO2DTO(v)
+	}
+	return &spidto.InvokeEvalTargetInput{
+		EvalSetFields: fields,
+		Ext:           input.Ext,
+	}
+}
+
+func invokeOutputDTO2DO(output *spidto.InvokeEvalTargetOutput, usage *spidto.InvokeEvalTargetUsage) *entity.EvalTargetOutputData {
+	outputFields := make(map[string]*entity.Content)
+	if output != nil {
+		if output.ActualOutput != nil {
+			outputFields[consts.OutputSchemaKey] = contentDTO2DO(output.ActualOutput)
+		}
+		for k, v := range output.ExtOutput {
+			outputFields[k] = contentDTO2DO(v)
+		}
+	}
+	ret := &entity.EvalTargetOutputData{OutputFields: outputFields}
+	if usage != nil {
+		ret.EvalTargetUsage = &entity.EvalTargetUsage{
+			InputTokens:  usage.GetInputTokens(),
+			OutputTokens: usage.GetOutputTokens(),
+			TotalTokens:  usage.GetInputTokens() + usage.GetOutputTokens(),
+		}
+	}
+	return ret
+}
+
+func contentDO2DTO(c *entity.Content) *spidto.Content {
+	if c == nil {
+		return nil
+	}
+	ret := &spidto.Content{Text: c.Text}
+	switch gptr.Indirect(c.ContentType) {
+	case entity.ContentTypeImage:
+		ret.ContentType = gptr.Of(spidto.ContentTypeImage)
+	case entity.ContentTypeAudio:
+		ret.ContentType = gptr.Of(spidto.ContentTypeAudio)
+	case entity.ContentTypeVideo:
+		ret.ContentType = gptr.Of(spidto.ContentTypeVideo)
+	case entity.ContentTypeMultipart:
+		ret.ContentType = gptr.Of(spidto.ContentTypeMultiPart)
+	default:
+		ret.ContentType = gptr.Of(spidto.ContentTypeText)
+	}
+	if c.Image != nil {
+		ret.Image = &spidto.Image{URL: c.Image.URL}
+	}
+	if c.Audio != nil {
+		ret.Audio = &spidto.Audio{URL: c.Audio.URL}
+	}
+	if c.Video != nil {
+		ret.Video = &spidto.Video{URL: c.Video.URL}
+	}
+	for _, part := range c.MultiPart {
+		ret.MultiPart = append(ret.MultiPart, contentDO2DTO(part))
+	}
+	return ret
+}
+
+func contentDTO2DO(c *spidto.Content) *entity.Content {
+	if c == nil {
+		return nil
+	}
+	ret := &entity.Content{Text: c.Text}
+	switch c.GetContentType() {
+	case spidto.ContentTypeImage:
+		ret.ContentType = gptr.Of(entity.ContentTypeImage)
+	case spidto.ContentTypeAudio:
+		ret.ContentType = gptr.Of(entity.ContentTypeAudio)
+	case spidto.ContentTypeVideo:
+		ret.ContentType = gptr.Of(entity.ContentTypeVideo)
+	case spidto.ContentTypeMultiPart:
+		ret.ContentType = gptr.Of(entity.ContentTypeMultipart)
+	default:
+		ret.ContentType = gptr.Of(entity.ContentTypeText)
+	}
+	if c.Image != nil {
+		ret.Image = &entity.Image{URL: c.Image.URL}
+	}
+	if c.Audio != nil {
+		ret.Audio = &entity.Audio{URL: c.Audio.URL}
+	}
+	if c.Video != nil {
+		ret.Video = &entity.Video{URL: c.Video.URL}
+	}
+	for _, part := range c.MultiPart {
+		ret.MultiPart = append(ret.MultiPart, contentDTO2DO(part))
+	}
+	return ret
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	infrahttp "github.com/coze-dev/coze-loop/backend/infra/http"
+	httpmocks "github.com/coze-dev/coze-loop/backend/infra/http/mocks"
+	spidto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/spi"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/consts"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func newTestServer() *entity.CustomRPCServer {
+	return &entity.CustomRPCServer{
+		AccessProtocol:      entity.AccessProtocolHTTP,
+		Endpoint:            "http://localhost:8080/",
+		Headers:             map[string]string{"Authorization": "Bearer token"},
+		Timeout:             gptr.Of(int64(5000)),
+		InvokeHTTPInfo:      &entity.HTTPInfo{Method: entity.HTTPMethodPost, Path: "/invoke"},
+		AsyncInvokeHTTPInfo: &entity.HTTPInfo{Method: entity.HTTPMethodPost, Path: "async_invoke"},
+		SearchHTTPInfo:      &entity.HTTPInfo{Method: entity.HTTPMethodGet, Path: "/search"},
+		CustomEvalTarget:    &entity.CustomEvalTarget{ID: gptr.Of("bot_1")},
+	}
+}
+
+func TestEvalTargetSPIAdapter_InvokeEvalTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := httpmocks.NewMockIClient(ctrl)
+	adapter := NewEvalTargetSPIAdapter(mockClient)
+
+	param := &rpc.InvokeEvalTargetParam{
+		SpaceID: 100,
+		Server:  newTestServer(),
+		Input: &entity.EvalTargetInputData{
+			InputFields: map[string]*entity.Content{"question": {ContentType: gptr.Of(entity.ContentTypeText), Text: gptr.Of("hi")}},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, p *infrahttp.RequestParam) error {
+			assert.Equal(t, "http://localhost:8080/invoke", p.RequestURI)
+			assert.Equal(t, http.MethodPost, p.Method)
+			assert.Equal(t, "Bearer token", p.Header["Authorization"])
+			assert.Equal(t, 5*time.Second, p.Timeout)
+			req := p.Body.(*spidto.InvokeEvalTargetRequest)
+			assert.Equal(t, int64(100), req.GetWorkspaceID())
+			assert.Equal(t, "hi", req.GetInput().GetEvalSetFields()["question"].GetText())
+			assert.Equal(t, "bot_1", req.GetCustomEvalTarget().GetID())
+
+			resp := p.Response.(*spidto.InvokeEvalTargetResponse)
+			resp.Status = gptr.Of(spidto.InvokeEvalTargetStatus_SUCCESS)
+			resp.Output = &spidto.InvokeEvalTargetOutput{
+				ActualOutput: &spidto.Content{ContentType: gptr.Of(spidto.ContentTypeText), Text: gptr.Of("hello")},
+				ExtOutput:    map[string]*spidto.Content{"reasoning": {Text: gptr.Of("because")}},
+			}
+			resp.Usage = &spidto.InvokeEvalTargetUsage{InputTokens: gptr.Of(int64(10)), OutputTokens: gptr.Of(int64(20))}
+			return nil
+		})
+		output, status, err := adapter.InvokeEvalTarget(context.Background(), param)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvalTargetRunStatusSuccess, status)
+		assert.Equal(t, "hello", gptr.Indirect(output.OutputFields[consts.OutputSchemaKey].Text))
+		assert.Equal(t, "because", gptr.Indirect(output.OutputFields["reasoning"].Text))
+		assert.Equal(t, int64(30), output.EvalTargetUsage.TotalTokens)
+	})
+
+	t.Run("target failed", func(t *testing.T) {
+		mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, p *infrahttp.RequestParam) error {
+			resp := p.Response.(*spidto.InvokeEvalTargetResponse)
+			resp.Status = gptr.Of(spidto.InvokeEvalTargetStatus_FAILED)
+			resp.ErrorMessage = gptr.Of("model overloaded")
+			return nil
+		})
+		output, status, err := adapter.InvokeEvalTarget(context.Background(), param)
+		assert.NoError(t, err)
+		assert.Equal(t, entity.EvalTargetRunStatusFail, status)
+		assert.Equal(t, int32(errno.CustomEvalTargetInvokeFailCode), output.EvalTargetRunError.Code)
+		assert.Equal(t, "model overloaded", output.EvalTargetRunError.Message)
+	})
+
+	t.Run("http error", func(t *testing.T) {
+		mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+		_, status, err := adapter.InvokeEvalTarget(context.Background(), param)
+		assert.Error(t, err)
+		assert.Equal(t, entity.EvalTargetRunStatusFail, status)
+		statusErr, ok := errorx.FromStatusError(err)
+		assert.True(t, ok)
+		assert.Equal(t, int32(errno.CustomEvalTargetInvokeFailCode), statusErr.Code())
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).Return(nil)
+		_, _, err := adapter.InvokeEvalTarget(context.Background(), param)
+		assert.Error(t, err)
+	})
+}
+
+func TestEvalTargetSPIAdapter_AsyncInvokeEvalTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := httpmocks.NewMockIClient(ctrl)
+	adapter := NewEvalTargetSPIAdapter(mockClient)
+
+	mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, p *infrahttp.RequestParam) error {
+		assert.Equal(t, "http://localhost:8080/async_invoke", p.RequestURI)
+		req := p.Body.(*spidto.AsyncInvokeEvalTargetRequest)
+		assert.Equal(t, int64(999), req.GetInvokeID())
+		return nil
+	})
+	err := adapter.AsyncInvokeEvalTarget(context.Background(), &rpc.InvokeEvalTargetParam{
+		SpaceID: 100,
+		Server:  newTestServer(),
+		Input:   &entity.EvalTargetInputData{},
+	}, 999)
+	assert.NoError(t, err)
+
+	server := newTestServer()
+	server.AsyncInvokeHTTPInfo = nil
+	err = adapter.AsyncInvokeEvalTarget(context.Background(), &rpc.InvokeEvalTargetParam{SpaceID: 100, Server: server}, 999)
+	assert.Error(t, err)
+}
+
+func TestEvalTargetSPIAdapter_SearchEvalTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := httpmocks.NewMockIClient(ctrl)
+	adapter := NewEvalTargetSPIAdapter(mockClient)
+
+	mockClient.EXPECT().DoHTTPRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, p *infrahttp.RequestParam) error {
+		assert.Equal(t, "http://localhost:8080/search", p.RequestURI)
+		assert.Equal(t, http.MethodGet, p.Method)
+		req := p.Body.(*spidto.SearchEvalTargetRequest)
+		assert.Equal(t, "kw", req.GetKeyword())
+		assert.Equal(t, "100", req.GetExt()[searchExtKeySpaceID])
+		assert.Equal(t, entity.RegionCN, req.GetExt()[searchExtKeyRegion])
+
+		resp := p.Response.(*spidto.SearchEvalTargetResponse)
+		resp.CustomEvalTargets = []*spidto.CustomEvalTarget{{ID: gptr.Of("bot_1"), Name: gptr.Of("Bot")}, nil}
+		resp.NextPageToken = gptr.Of("next")
+		resp.HasMore = gptr.Of(true)
+		return nil
+	})
+	targets, cursor, hasMore, err := adapter.SearchEvalTarget(context.Background(), &rpc.SearchEvalTargetParam{
+		SpaceID: 100,
+		Server:  newTestServer(),
+		Keyword: gptr.Of("kw"),
+		Region:  gptr.Of(entity.RegionCN),
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, targets, 1) {
+		assert.Equal(t, "Bot", gptr.Indirect(targets[0].Name))
+	}
+	assert.Equal(t, "next", cursor)
+	assert.True(t, hasMore)
+}
+
+func TestBuildRequestURL(t *testing.T) {
+	assert.Equal(t, "http://a/b", buildRequestURL("http://a/", "/b"))
+	assert.Equal(t, "http://a/b", buildRequestURL("http://a", "b"))
+	assert.Equal(t, "http://a", buildRequestURL("http://a", ""))
+}
@@ -0,0 +1,16 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spi
+
+import (
+	"github.com/google/wire"
+
+	infrahttp "github.com/coze-dev/coze-loop/backend/infra/http"
+)
+
+var SPIRPCSet = wire.NewSet(
+	NewEvalTargetSPIAdapter,
+	NewEvaluatorSPIAdapter,
+	infrahttp.NewHTTPClient,
+)
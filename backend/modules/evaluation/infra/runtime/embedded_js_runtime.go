@@ -0,0 +1,434 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	goruntime "runtime"
+	"runtime/metrics"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/sirupsen/logrus"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/component"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+)
+
+const (
+	// embeddedReturnValFuncName 嵌入式运行时中用于回传ret_val的Go绑定函数名
+	embeddedReturnValFuncName = "__coze_return_val"
+
+	interruptReasonTimeout  = "execution timeout"
+	interruptReasonCanceled = "execution canceled"
+	interruptReasonOutput   = "output size exceeds limit"
+	interruptReasonMemory   = "memory usage exceeds limit"
+
+	// embeddedJSDefaultTimeout 请求与沙箱配置均未指定超时时的兜底超时时间
+	embeddedJSDefaultTimeout = 30 * time.Second
+	// embeddedJSMemoryCheckInterval 内存巡检间隔
+	embeddedJSMemoryCheckInterval = 10 * time.Millisecond
+	// heapLiveMetric 最近一次GC后标记存活的堆内存大小
+	heapLiveMetric = "/gc/heap/live:bytes"
+)
+
+// EmbeddedJSRuntime 进程内JavaScript运行时实现，基于goja引擎执行代码，无需依赖外部FaaS服务
+type EmbeddedJSRuntime struct {
+	logger *logrus.Logger
+	config *entity.SandboxConfig
+	// slots 限制同时执行的脚本数量，避免CPU密集脚本挤占宿主进程
+	slots chan struct{}
+
+	totalRuns   atomic.Int64
+	failedRuns  atomic.Int64
+	timeoutRuns atomic.Int64
+}
+
+// NewEmbeddedJSRuntime 创建进程内JavaScript运行时实例
+func NewEmbeddedJSRuntime(config *entity.SandboxConfig, logger *logrus.Logger) (*EmbeddedJSRuntime, error) {
+	if config == nil {
+		config = entity.DefaultSandboxConfig()
+	}
+
+	if logger == nil {
+		logger = logrus.New()
+	}
+
+	if config.NetworkEnabled {
+		// goja 不提供任何网络、文件等宿主能力，沙箱天然处于断网状态
+		logger.Warn("嵌入式JavaScript运行时不支持网络访问，network_enabled配置将被忽略")
+	}
+
+	maxConcurrency := goruntime.GOMAXPROCS(0)
+	logger.WithFields(logrus.Fields{
+		"timeout_limit":   config.TimeoutLimit,
+		"max_output_size": config.MaxOutputSize,
+		"memory_limit":    config.MemoryLimit,
+		"max_concurrency": maxConcurrency,
+	}).Info("嵌入式JavaScript运行时创建成功")
+
+	return &EmbeddedJSRuntime{
+		logger: logger,
+		config: config,
+		slots:  make(chan struct{}, maxConcurrency),
+	}, nil
+}
+
+// GetLanguageType 获取语言类型
+func (er *EmbeddedJSRuntime) GetLanguageType() entity.LanguageType {
+	return entity.LanguageTypeJS
+}
+
+// RunCode 在独立的goja虚拟机中执行JavaScript代码
+func (er *EmbeddedJSRuntime) RunCode(ctx context.Context, code string, language string, timeoutMS int64, ext map[string]string) (*entity.ExecutionResult, error) {
+	if code == "" {
+		return nil, fmt.Errorf("代码不能为空")
+	}
+	if normalizeLanguage(language) != "js" {
+		return nil, fmt.Errorf("嵌入式运行时不支持的语言类型: %s", language)
+	}
+
+	er.totalRuns.Add(1)
+	timeout := er.resolveTimeout(timeoutMS)
+
+	select {
+	case er.slots <- struct{}{}:
+		defer func() { <-er.slots }()
+	case <-ctx.Done():
+		er.failedRuns.Add(1)
+		return nil, fmt.Errorf("等待嵌入式JavaScript执行槽位失败: %w", ctx.Err())
+	}
+
+	er.logger.WithFields(logrus.Fields{
+		"language":   language,
+		"timeout_ms": timeout.Milliseconds(),
+	}).Debug("开始执行嵌入式JavaScript代码")
+
+	vm := goja.New()
+	output := newSandboxOutput(er.config.MaxOutputSize, func() {
+		vm.Interrupt(interruptReasonOutput)
+	})
+
+	if err := er.installGlobals(vm, output); err != nil {
+		er.failedRuns.Add(1)
+		return nil, fmt.Errorf("初始化嵌入式运行时失败: %w", err)
+	}
+
+	// 超时与上下文取消均通过中断虚拟机实现
+	timer := time.AfterFunc(timeout, func() {
+		vm.Interrupt(interruptReasonTimeout)
+	})
+	defer timer.Stop()
+
+	done := make(chan struct{})
+	defer close(done)
+	go er.watch(ctx, vm, done)
+
+	start := time.Now()
+	_, runErr := vm.RunString(code)
+	duration := time.Since(start)
+
+	result := &entity.ExecutionResult{
+		Output: &entity.ExecutionOutput{
+			Stdout: output.stdout(),
+			Stderr: output.stderr(),
+			RetVal: output.retVal(),
+		},
+	}
+
+	if runErr != nil {
+		var interrupted *goja.InterruptedError
+		if errors.As(runErr, &interrupted) {
+			er.failedRuns.Add(1)
+			reason := fmt.Sprint(interrupted.Value())
+			if reason == interruptReasonTimeout {
+				er.timeoutRuns.Add(1)
+			}
+			return result, fmt.Errorf("嵌入式JavaScript执行中断: %s", reason)
+		}
+
+		// 脚本异常及语法错误与FaaS保持一致，写入stderr交由上层判断
+		result.Output.Stderr = appendLine(result.Output.Stderr, formatJSError(runErr))
+	}
+
+	er.logger.WithFields(logrus.Fields{
+		"duration_ms": duration.Milliseconds(),
+		"has_stderr":  result.Output.Stderr != "",
+	}).Debug("嵌入式JavaScript执行完成")
+
+	return result, nil
+}
+
+// watch 监听上下文取消并巡检内存增量，任一条件触发时中断虚拟机。
+// goja 与宿主共享Go堆，单个脚本的内存只能按执行期间进程存活堆的增量近似限制
+func (er *EmbeddedJSRuntime) watch(ctx context.Context, vm *goja.Runtime, done <-chan struct{}) {
+	memoryLimit := er.config.MemoryLimit << 20
+	if memoryLimit <= 0 {
+		select {
+		case <-ctx.Done():
+			vm.Interrupt(interruptReasonCanceled)
+		case <-done:
+		}
+		return
+	}
+
+	baseline := readHeapLive()
+	ticker := time.NewTicker(embeddedJSMemoryCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			vm.Interrupt(interruptReasonCanceled)
+			return
+		case <-done:
+			return
+		case <-ticker.C:
+			if int64(readHeapLive())-int64(baseline) > memoryLimit {
+				vm.Interrupt(interruptReasonMemory)
+				return
+			}
+		}
+	}
+}
+
+// readHeapLive 读取进程存活堆大小，读取开销远低于 runtime.ReadMemStats 且无需STW
+func readHeapLive() uint64 {
+	sample := []metrics.Sample{{Name: heapLiveMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
+
+// installGlobals 注入console与return_val绑定，不暴露任何网络或文件能力
+func (er *EmbeddedJSRuntime) installGlobals(vm *goja.Runtime, output *sandboxOutput) error {
+	console := vm.NewObject()
+	toStdout := func(call goja.FunctionCall) goja.Value {
+		output.writeStdout(formatConsoleArgs(call.Arguments))
+		return goja.Undefined()
+	}
+	toStderr := func(call goja.FunctionCall) goja.Value {
+		output.writeStderr(formatConsoleArgs(call.Arguments))
+		return goja.Undefined()
+	}
+	for name, fn := range map[string]func(goja.FunctionCall) goja.Value{
+		"log":   toStdout,
+		"info":  toStdout,
+		"debug": toStdout,
+		"warn":  toStderr,
+		"error": toStderr,
+	} {
+		if err := console.Set(name, fn); err != nil {
+			return err
+		}
+	}
+	if err := vm.Set("console", console); err != nil {
+		return err
+	}
+
+	return vm.Set(embeddedReturnValFuncName, func(call goja.FunctionCall) goja.Value {
+		value := call.Argument(0)
+		if goja.IsUndefined(value) || goja.IsNull(value) {
+			output.setRetVal("")
+		} else {
+			output.setRetVal(value.String())
+		}
+		return goja.Undefined()
+	})
+}
+
+// resolveTimeout 计算本次执行的超时时间，不超过沙箱配置的上限，均未指定时使用默认超时
+func (er *EmbeddedJSRuntime) resolveTimeout(timeoutMS int64) time.Duration {
+	limit := er.config.TimeoutLimit
+	if timeoutMS <= 0 {
+		if limit <= 0 {
+			return embeddedJSDefaultTimeout
+		}
+		return limit
+	}
+	timeout := time.Duration(timeoutMS) * time.Millisecond
+	if limit > 0 && timeout > limit {
+		return limit
+	}
+	return timeout
+}
+
+// ValidateCode 验证JavaScript代码语法
+func (er *EmbeddedJSRuntime) ValidateCode(ctx context.Context, code string, language string) bool {
+	if code == "" {
+		return false
+	}
+	_, err := goja.Compile("", code, false)
+	return err == nil
+}
+
+// GetSupportedLanguages 获取支持的语言类型列表
+func (er *EmbeddedJSRuntime) GetSupportedLanguages() []entity.LanguageType {
+	return []entity.LanguageType{entity.LanguageTypeJS}
+}
+
+// GetHealthStatus 获取健康状态
+func (er *EmbeddedJSRuntime) GetHealthStatus() map[string]interface{} {
+	return map[string]interface{}{
+		"status":              "healthy",
+		"language":            "javascript",
+		"mode":                JSRuntimeModeEmbedded,
+		"supported_languages": er.GetSupportedLanguages(),
+	}
+}
+
+// GetMetrics 获取运行时指标
+func (er *EmbeddedJSRuntime) GetMetrics() map[string]interface{} {
+	return map[string]interface{}{
+		"runtime_type": "embedded_javascript",
+		"language":     "javascript",
+		"total_runs":   er.totalRuns.Load(),
+		"failed_runs":  er.failedRuns.Load(),
+		"timeout_runs": er.timeoutRuns.Load(),
+	}
+}
+
+// GetReturnValFunction 获取JavaScript return_val函数实现，直接回传给宿主而非写入stdout
+func (er *EmbeddedJSRuntime) GetReturnValFunction() string {
+	return `
+// return_val函数实现
+function return_val(value) {
+    /**
+     * 嵌入式运行时的return_val函数实现 - 将ret_val直接回传给宿主
+     * @param {string} value - 要返回的值，通常是JSON字符串
+     */
+    const ret_val = (value === null || value === undefined) ? "" : String(value);
+    ` + embeddedReturnValFuncName + `(ret_val);
+}
+`
+}
+
+// sandboxOutput 收集stdout/stderr/ret_val并限制总输出大小
+type sandboxOutput struct {
+	mu        sync.Mutex
+	limit     int64
+	size      int64
+	exceeded  bool
+	onExceed  func()
+	stdoutBuf strings.Builder
+	stderrBuf strings.Builder
+	ret       string
+}
+
+func newSandboxOutput(limit int64, onExceed func()) *sandboxOutput {
+	return &sandboxOutput{limit: limit, onExceed: onExceed}
+}
+
+func (o *sandboxOutput) writeStdout(line string) {
+	o.write(&o.stdoutBuf, line)
+}
+
+func (o *sandboxOutput) writeStderr(line string) {
+	o.write(&o.stderrBuf, line)
+}
+
+func (o *sandboxOutput) write(buf *strings.Builder, line string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.exceeded {
+		return
+	}
+	if !o.grow(int64(len(line) + 1)) {
+		return
+	}
+	buf.WriteString(line)
+	buf.WriteByte('\n')
+}
+
+func (o *sandboxOutput) setRetVal(value string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.exceeded {
+		return
+	}
+	if !o.grow(int64(len(value)) - int64(len(o.ret))) {
+		return
+	}
+	o.ret = value
+}
+
+// grow 累加输出大小，超出限制时触发中断
+func (o *sandboxOutput) grow(delta int64) bool {
+	if o.limit > 0 && o.size+delta > o.limit {
+		o.exceeded = true
+		if o.onExceed != nil {
+			o.onExceed()
+		}
+		return false
+	}
+	o.size += delta
+	return true
+}
+
+func (o *sandboxOutput) stdout() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return strings.TrimSuffix(o.stdoutBuf.String(), "\n")
+}
+
+func (o *sandboxOutput) stderr() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return strings.TrimSuffix(o.stderrBuf.String(), "\n")
+}
+
+func (o *sandboxOutput) retVal() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.ret
+}
+
+// formatConsoleArgs 按console.log的习惯以空格拼接参数
+func formatConsoleArgs(args []goja.Value) string {
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == nil {
+			parts = append(parts, "undefined")
+			continue
+		}
+		parts = append(parts, arg.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatJSError 格式化脚本异常，与FaaS输出的 "ErrorName: message" 格式保持一致
+func formatJSError(err error) string {
+	var exception *goja.Exception
+	if errors.As(err, &exception) {
+		if obj, ok := exception.Value().(*goja.Object); ok {
+			name := obj.Get("name")
+			message := obj.Get("message")
+			if name != nil && message != nil && !goja.IsUndefined(name) {
+				return name.String() + ": " + message.String()
+			}
+		}
+		return exception.Value().String()
+	}
+	var syntaxErr *goja.CompilerSyntaxError
+	if errors.As(err, &syntaxErr) {
+		return "SyntaxError: " + syntaxErr.Error()
+	}
+	return err.Error()
+}
+
+func appendLine(s, line string) string {
+	if s == "" {
+		return line
+	}
+	return s + "\n" + line
+}
+
+// 确保EmbeddedJSRuntime实现IRuntime接口
+var _ component.IRuntime = (*EmbeddedJSRuntime)(nil)
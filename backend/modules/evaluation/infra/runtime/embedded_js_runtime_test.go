@@ -0,0 +1,184 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package runtime
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/conf/templates"
+)
+
+func newTestEmbeddedJSRuntime(t *testing.T, config *entity.SandboxConfig) *EmbeddedJSRuntime {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetLevel(logrus.WarnLevel)
+	runtime, err := NewEmbeddedJSRuntime(config, logger)
+	require.NoError(t, err)
+	return runtime
+}
+
+func TestEmbeddedJSRuntime_RunCode(t *testing.T) {
+	runtime := newTestEmbeddedJSRuntime(t, nil)
+
+	code := runtime.GetReturnValFunction() + `
+		console.log("hello", 1 + 2);
+		console.error("warn line");
+		return_val(JSON.stringify({score: 1, reason: "ok"}));
+	`
+	result, err := runtime.RunCode(context.Background(), code, "javascript", 1000, nil)
+	require.NoError(t, err)
+	require.NotNil(t, result.Output)
+	assert.Equal(t, "hello 3", result.Output.Stdout)
+	assert.Equal(t, "warn line", result.Output.Stderr)
+	assert.Equal(t, `{"score":1,"reason":"ok"}`, result.Output.RetVal)
+}
+
+func TestEmbeddedJSRuntime_RunCode_EvaluatorTemplate(t *testing.T) {
+	runtime := newTestEmbeddedJSRuntime(t, nil)
+
+	code := templates.JavaScriptTemplate
+	code = strings.Replace(code, "{{RETURN_VAL_FUNCTION}}", runtime.GetReturnValFunction(), 1)
+	code = strings.Replace(code, "{{TURN_DATA}}", `{"actual_output": "a", "reference_output": "a"}`, 1)
+	code = strings.Replace(code, "{{EXEC_EVALUATION_FUNCTION}}", `
+function exec_evaluation(turn) {
+    const same = turn.actual_output === turn.reference_output;
+    return new EvalOutput(same ? 1 : 0, same ? "match" : "mismatch");
+}`, 1)
+
+	result, err := runtime.RunCode(context.Background(), code, "js", 1000, nil)
+	require.NoError(t, err)
+	assert.Equal(t, `{"score":1,"reason":"match"}`, result.Output.RetVal)
+	assert.Empty(t, result.Output.Stderr)
+}
+
+func TestEmbeddedJSRuntime_RunCode_Errors(t *testing.T) {
+	runtime := newTestEmbeddedJSRuntime(t, nil)
+
+	t.Run("empty code", func(t *testing.T) {
+		_, err := runtime.RunCode(context.Background(), "", "js", 1000, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported language", func(t *testing.T) {
+		_, err := runtime.RunCode(context.Background(), "1", "python", 1000, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("uncaught exception goes to stderr", func(t *testing.T) {
+		result, err := runtime.RunCode(context.Background(), `throw new TypeError("boom")`, "js", 1000, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "TypeError: boom", result.Output.Stderr)
+	})
+
+	t.Run("syntax error goes to stderr", func(t *testing.T) {
+		result, err := runtime.RunCode(context.Background(), `function (`, "js", 1000, nil)
+		require.NoError(t, err)
+		assert.Contains(t, result.Output.Stderr, "SyntaxError")
+	})
+
+	t.Run("network primitives are unavailable", func(t *testing.T) {
+		result, err := runtime.RunCode(context.Background(), `console.log(typeof fetch, typeof XMLHttpRequest, typeof require)`, "js", 1000, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "undefined undefined undefined", result.Output.Stdout)
+	})
+}
+
+func TestEmbeddedJSRuntime_RunCode_Limits(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		runtime := newTestEmbeddedJSRuntime(t, nil)
+		start := time.Now()
+		_, err := runtime.RunCode(context.Background(), `while (true) {}`, "js", 100, nil)
+		assert.Error(t, err)
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assert.Equal(t, int64(1), runtime.GetMetrics()["timeout_runs"])
+	})
+
+	t.Run("sandbox timeout caps request timeout", func(t *testing.T) {
+		config := entity.DefaultSandboxConfig()
+		config.TimeoutLimit = 50 * time.Millisecond
+		runtime := newTestEmbeddedJSRuntime(t, config)
+		assert.Equal(t, 50*time.Millisecond, runtime.resolveTimeout(10000))
+		assert.Equal(t, 50*time.Millisecond, runtime.resolveTimeout(0))
+		assert.Equal(t, 10*time.Millisecond, runtime.resolveTimeout(10))
+	})
+
+	t.Run("default timeout when nothing configured", func(t *testing.T) {
+		config := entity.DefaultSandboxConfig()
+		config.TimeoutLimit = 0
+		runtime := newTestEmbeddedJSRuntime(t, config)
+		assert.Equal(t, embeddedJSDefaultTimeout, runtime.resolveTimeout(0))
+		assert.Equal(t, 10*time.Millisecond, runtime.resolveTimeout(10))
+	})
+
+	t.Run("memory limit", func(t *testing.T) {
+		config := entity.DefaultSandboxConfig()
+		config.MemoryLimit = 16
+		runtime := newTestEmbeddedJSRuntime(t, config)
+		_, err := runtime.RunCode(context.Background(), `const a = []; while (true) { a.push("x".repeat(1024) + a.length); }`, "js", 10000, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), interruptReasonMemory)
+	})
+
+	t.Run("concurrency limit", func(t *testing.T) {
+		runtime := newTestEmbeddedJSRuntime(t, nil)
+		runtime.slots = make(chan struct{}, 1)
+		runtime.slots <- struct{}{}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := runtime.RunCode(ctx, `1`, "js", 1000, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		<-runtime.slots
+		result, err := runtime.RunCode(context.Background(), `console.log("ok")`, "js", 1000, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", result.Output.Stdout)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		runtime := newTestEmbeddedJSRuntime(t, nil)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := runtime.RunCode(ctx, `while (true) {}`, "js", 10000, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("output size", func(t *testing.T) {
+		config := entity.DefaultSandboxConfig()
+		config.MaxOutputSize = 64
+		runtime := newTestEmbeddedJSRuntime(t, config)
+		result, err := runtime.RunCode(context.Background(), `for (let i = 0; i < 1000; i++) { console.log("0123456789"); }`, "js", 1000, nil)
+		assert.Error(t, err)
+		require.NotNil(t, result)
+		assert.LessOrEqual(t, len(result.Output.Stdout), 64)
+	})
+}
+
+func TestEmbeddedJSRuntime_ValidateCode(t *testing.T) {
+	runtime := newTestEmbeddedJSRuntime(t, nil)
+	assert.True(t, runtime.ValidateCode(context.Background(), "const a = 1;", "js"))
+	assert.False(t, runtime.ValidateCode(context.Background(), "const a = ;", "js"))
+	assert.False(t, runtime.ValidateCode(context.Background(), "", "js"))
+}
+
+func TestRuntimeFactory_CreateEmbeddedJavaScriptRuntime(t *testing.T) {
+	setEnvSafe(t, JSRuntimeModeEnv, JSRuntimeModeEmbedded)
+	defer unsetEnvSafe(t, JSRuntimeModeEnv)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.WarnLevel)
+	factory := NewRuntimeFactory(logger, entity.DefaultSandboxConfig())
+
+	runtime, err := factory.CreateRuntime(entity.LanguageTypeJS)
+	require.NoError(t, err)
+	_, ok := runtime.(*EmbeddedJSRuntime)
+	assert.True(t, ok)
+}
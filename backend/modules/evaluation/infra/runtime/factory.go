@@ -5,6 +5,8 @@ package runtime
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"sync"
 
 	"github.com/sirupsen/logrus"
@@ -13,6 +15,15 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/domain/entity"
 )
 
+const (
+	// JSRuntimeModeEnv 选择JavaScript运行时模式的环境变量
+	JSRuntimeModeEnv = "COZE_LOOP_JS_RUNTIME_MODE"
+	// JSRuntimeModeFaaS 通过HTTP FaaS服务执行JavaScript代码（默认）
+	JSRuntimeModeFaaS = "faas"
+	// JSRuntimeModeEmbedded 在进程内通过嵌入式引擎执行JavaScript代码
+	JSRuntimeModeEmbedded = "embedded"
+)
+
 // RuntimeFactory 统一的运行时工厂实现
 type RuntimeFactory struct {
 	logger        *logrus.Logger
@@ -69,7 +80,11 @@ func (f *RuntimeFactory) CreateRuntime(languageType entity.LanguageType) (compon
 		f.logger.Info("Python运行时创建成功")
 
 	case entity.LanguageTypeJS:
-		runtime, err = NewJavaScriptRuntime(f.sandboxConfig, f.logger)
+		if getJSRuntimeMode() == JSRuntimeModeEmbedded {
+			runtime, err = NewEmbeddedJSRuntime(f.sandboxConfig, f.logger)
+		} else {
+			runtime, err = NewJavaScriptRuntime(f.sandboxConfig, f.logger)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("创建JavaScript运行时失败: %w", err)
 		}
@@ -147,5 +162,14 @@ func (f *RuntimeFactory) GetMetrics() map[string]interface{} {
 	return metrics
 }
 
+// getJSRuntimeMode 获取JavaScript运行时模式，未配置时默认使用HTTP FaaS
+func getJSRuntimeMode() string {
+	mode := strings.ToLower(strings.TrimSpace(os.Getenv(JSRuntimeModeEnv)))
+	if mode == JSRuntimeModeEmbedded {
+		return JSRuntimeModeEmbedded
+	}
+	return JSRuntimeModeFaaS
+}
+
 // 确保RuntimeFactory实现IRuntimeFactory接口
 var _ component.IRuntimeFactory = (*RuntimeFactory)(nil)
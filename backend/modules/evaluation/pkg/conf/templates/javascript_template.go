@@ -15,9 +15,12 @@ const JavaScriptTemplate = `
  * 评估输出数据结构
  */
 class EvalOutput {
-    constructor(score, reason) {
+    constructor(score, reason, dimensions) {
         this.score = score;
         this.reason = reason;
+        if (dimensions !== undefined && dimensions !== null) {
+            this.dimensions = dimensions;
+        }
     }
 }
 
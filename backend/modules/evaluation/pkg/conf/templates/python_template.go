@@ -23,6 +23,7 @@ class Output(dict):
 class EvalOutput:
     score: float
     reason: str
+    dimensions: list = None
 
 
 args = {}
@@ -44,10 +45,14 @@ def main(args):
     result = exec_evaluation(turn)
 
     # Return result for sandbox - convert to dict for JSON serialization
-    return {
+    output = {
         "score": result.score,
         "reason": result.reason
     }
+    dimensions = getattr(result, "dimensions", None)
+    if dimensions:
+        output["dimensions"] = dimensions
+    return output
 
 result = None
 try:
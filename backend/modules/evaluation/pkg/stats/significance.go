@@ -0,0 +1,271 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stats 提供实验对比所需的配对显著性检验
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// TestResult 单个检验的统计量与双侧 p 值
+type TestResult struct {
+	Statistic float64
+	PValue    float64
+}
+
+// Mean 计算均值，空切片返回 0
+func Mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+// PairedTTest 对配对差值做双侧 t 检验，样本数不足 2 时返回 false
+func PairedTTest(diffs []float64) (TestResult, bool) {
+	n := len(diffs)
+	if n < 2 {
+		return TestResult{}, false
+	}
+	mean := Mean(diffs)
+	var ss float64
+	for _, d := range diffs {
+		ss += (d - mean) * (d - mean)
+	}
+	sd := math.Sqrt(ss / float64(n-1))
+	if sd == 0 {
+		// 差值完全一致：均值为 0 时无差异，否则差异确定
+		if mean == 0 {
+			return TestResult{Statistic: 0, PValue: 1}, true
+		}
+		return TestResult{Statistic: math.Copysign(math.Inf(1), mean), PValue: 0}, true
+	}
+	t := mean / (sd / math.Sqrt(float64(n)))
+	df := float64(n - 1)
+	p := regularizedIncompleteBeta(df/(df+t*t), df/2, 0.5)
+	return TestResult{Statistic: t, PValue: clampProbability(p)}, true
+}
+
+// WilcoxonSignedRank 对配对差值做 Wilcoxon 符号秩检验（正态近似，含平局与连续性校正）。
+// 统计量为 min(W+, W-)，差值为 0 的样本被剔除。
+func WilcoxonSignedRank(diffs []float64) (TestResult, bool) {
+	nonZero := make([]float64, 0, len(diffs))
+	for _, d := range diffs {
+		if d != 0 {
+			nonZero = append(nonZero, d)
+		}
+	}
+	n := len(nonZero)
+	if n == 0 {
+		if len(diffs) == 0 {
+			return TestResult{}, false
+		}
+		return TestResult{Statistic: 0, PValue: 1}, true
+	}
+
+	sort.Slice(nonZero, func(i, j int) bool { return math.Abs(nonZero[i]) < math.Abs(nonZero[j]) })
+
+	var wPlus, tieCorrection float64
+	for i := 0; i < n; {
+		j := i
+		for j+1 < n && math.Abs(nonZero[j+1]) == math.Abs(nonZero[i]) {
+			j++
+		}
+		// 平局取平均秩
+		rank := float64(i+j+2) / 2
+		for k := i; k <= j; k++ {
+			if nonZero[k] > 0 {
+				wPlus += rank
+			}
+		}
+		t := float64(j - i + 1)
+		tieCorrection += t*t*t - t
+		i = j + 1
+	}
+
+	nf := float64(n)
+	total := nf * (nf + 1) / 2
+	wMinus := total - wPlus
+	mean := total / 2
+	variance := nf*(nf+1)*(2*nf+1)/24 - tieCorrection/48
+	if variance <= 0 {
+		return TestResult{Statistic: math.Min(wPlus, wMinus), PValue: 1}, true
+	}
+	z := (math.Abs(wPlus-mean) - 0.5) / math.Sqrt(variance)
+	if z < 0 {
+		z = 0
+	}
+	return TestResult{Statistic: math.Min(wPlus, wMinus), PValue: clampProbability(normalTwoSidedP(z))}, true
+}
+
+// McNemar 对 0/1 配对结果做 McNemar 检验。
+// b 为基准通过而对比未通过的样本数，c 为基准未通过而对比通过的样本数。
+// 统计量为带连续性校正的卡方值，不一致样本少于 25 时 p 值使用精确二项检验。
+func McNemar(b, c int64) TestResult {
+	n := b + c
+	if n == 0 {
+		return TestResult{Statistic: 0, PValue: 1}
+	}
+	diff := math.Abs(float64(b-c)) - 1
+	if diff < 0 {
+		diff = 0
+	}
+	chi2 := diff * diff / float64(n)
+	if n < 25 {
+		k := b
+		if c < k {
+			k = c
+		}
+		return TestResult{Statistic: chi2, PValue: clampProbability(2 * binomialCDFHalf(k, n))}
+	}
+	return TestResult{Statistic: chi2, PValue: clampProbability(math.Erfc(math.Sqrt(chi2 / 2)))}
+}
+
+// BootstrapResult 均值的 bootstrap 置信区间。
+// PValue 为重采样均值落在 0 两侧比例较小者的两倍，用于检验均值是否为 0。
+type BootstrapResult struct {
+	Lower  float64
+	Upper  float64
+	PValue float64
+}
+
+// BootstrapMeanCI 通过重采样估计均值的置信区间
+func BootstrapMeanCI(xs []float64, confidence float64, iterations int, rng *rand.Rand) (BootstrapResult, bool) {
+	n := len(xs)
+	if n == 0 || iterations <= 0 || confidence <= 0 || confidence >= 1 {
+		return BootstrapResult{}, false
+	}
+	means := make([]float64, iterations)
+	var nonPositive, nonNegative int
+	for i := 0; i < iterations; i++ {
+		var sum float64
+		for j := 0; j < n; j++ {
+			sum += xs[rng.Intn(n)]
+		}
+		means[i] = sum / float64(n)
+		if means[i] <= 0 {
+			nonPositive++
+		}
+		if means[i] >= 0 {
+			nonNegative++
+		}
+	}
+	sort.Float64s(means)
+	alpha := 1 - confidence
+	tail := nonPositive
+	if nonNegative < tail {
+		tail = nonNegative
+	}
+	return BootstrapResult{
+		Lower:  quantile(means, alpha/2),
+		Upper:  quantile(means, 1-alpha/2),
+		PValue: clampProbability(2 * float64(tail) / float64(iterations)),
+	}, true
+}
+
+// quantile 对已排序切片做线性插值分位数
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	frac := pos - float64(lo)
+	return sorted[lo] + (sorted[hi]-sorted[lo])*frac
+}
+
+func normalTwoSidedP(z float64) float64 {
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+// binomialCDFHalf 计算 Binomial(n, 0.5) 的 P(X <= k)
+func binomialCDFHalf(k, n int64) float64 {
+	lgN, _ := math.Lgamma(float64(n + 1))
+	var p float64
+	for i := int64(0); i <= k; i++ {
+		lgI, _ := math.Lgamma(float64(i + 1))
+		lgNI, _ := math.Lgamma(float64(n - i + 1))
+		p += math.Exp(lgN - lgI - lgNI - float64(n)*math.Ln2)
+	}
+	return p
+}
+
+func clampProbability(p float64) float64 {
+	if math.IsNaN(p) {
+		return 1
+	}
+	return math.Max(0, math.Min(1, p))
+}
+
+// regularizedIncompleteBeta 计算正则化不完全 Beta 函数 I_x(a, b)
+func regularizedIncompleteBeta(x, a, b float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	lgA, _ := math.Lgamma(a)
+	lgB, _ := math.Lgamma(b)
+	lgAB, _ := math.Lgamma(a + b)
+	front := math.Exp(lgAB - lgA - lgB + a*math.Log(x) + b*math.Log(1-x))
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(x, a, b) / a
+	}
+	return 1 - front*betaContinuedFraction(1-x, b, a)/b
+}
+
+// betaContinuedFraction 使用 Lentz 算法求不完全 Beta 函数的连分式
+func betaContinuedFraction(x, a, b float64) float64 {
+	const (
+		maxIterations = 300
+		epsilon       = 1e-14
+		tiny          = 1e-300
+	)
+	qab, qap, qam := a+b, a+1, a-1
+	c, d := 1.0, 1-qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m <= maxIterations; m++ {
+		mf := float64(m)
+		m2 := 2 * mf
+		aa := mf * (b - mf) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+		aa = -(a + mf) * (qab + mf) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
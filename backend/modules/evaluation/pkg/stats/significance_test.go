@@ -0,0 +1,94 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairedTTest(t *testing.T) {
+	// scipy.stats.ttest_1samp([1, 2, 3, 4, 5, 6, 7, 8, 9, 10], 0) => t=5.7446, p=0.000278
+	res, ok := PairedTTest([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	require.True(t, ok)
+	assert.InDelta(t, 5.7446, res.Statistic, 1e-3)
+	assert.InDelta(t, 0.000278, res.PValue, 1e-5)
+
+	// scipy.stats.ttest_1samp([0.1, -0.2, 0.05, -0.05, 0.1], 0) => t=0.0, p=1.0
+	res, ok = PairedTTest([]float64{0.1, -0.2, 0.05, -0.05, 0.1})
+	require.True(t, ok)
+	assert.InDelta(t, 0, res.Statistic, 1e-9)
+	assert.InDelta(t, 1, res.PValue, 1e-9)
+
+	res, ok = PairedTTest([]float64{0.5, 0.5, 0.5})
+	require.True(t, ok)
+	assert.Equal(t, float64(0), res.PValue)
+	assert.True(t, math.IsInf(res.Statistic, 1))
+
+	_, ok = PairedTTest([]float64{1})
+	assert.False(t, ok)
+}
+
+func TestWilcoxonSignedRank(t *testing.T) {
+	// |0.5| 平局取秩 1.5，W- = 1.5 + 3 = 4.5；方差 96.25 - 6/48 = 96.125，z = (50.5 - 27.5 - 0.5) / 9.804 ≈ 2.295
+	d := []float64{1.5, 2, -0.5, 3, 4, 2.5, -1, 3.5, 5, 0.5}
+	res, ok := WilcoxonSignedRank(d)
+	require.True(t, ok)
+	assert.Equal(t, 4.5, res.Statistic)
+	assert.InDelta(t, 0.0217, res.PValue, 1e-3)
+
+	res, ok = WilcoxonSignedRank([]float64{0, 0, 0})
+	require.True(t, ok)
+	assert.Equal(t, float64(1), res.PValue)
+
+	_, ok = WilcoxonSignedRank(nil)
+	assert.False(t, ok)
+}
+
+func TestMcNemar(t *testing.T) {
+	// 精确二项：b=1, c=9 => p = 2 * P(X<=1 | n=10) = 0.02148
+	res := McNemar(1, 9)
+	assert.InDelta(t, 0.02148, res.PValue, 1e-4)
+	assert.InDelta(t, 4.9, res.Statistic, 1e-9)
+
+	// 卡方近似：b=10, c=30 => chi2 = 361/40 = 9.025, p = 0.00266
+	res = McNemar(10, 30)
+	assert.InDelta(t, 9.025, res.Statistic, 1e-9)
+	assert.InDelta(t, 0.00266, res.PValue, 1e-4)
+
+	res = McNemar(0, 0)
+	assert.Equal(t, float64(1), res.PValue)
+
+	res = McNemar(5, 5)
+	assert.Equal(t, float64(1), res.PValue)
+}
+
+func TestBootstrapMeanCI(t *testing.T) {
+	xs := make([]float64, 0, 200)
+	for i := 0; i < 200; i++ {
+		xs = append(xs, float64(i%10)/10)
+	}
+	res, ok := BootstrapMeanCI(xs, 0.95, 2000, rand.New(rand.NewSource(1)))
+	require.True(t, ok)
+	mean := Mean(xs)
+	assert.Less(t, res.Lower, mean)
+	assert.Greater(t, res.Upper, mean)
+	assert.InDelta(t, mean, (res.Lower+res.Upper)/2, 0.02)
+	assert.Equal(t, float64(0), res.PValue)
+
+	res, ok = BootstrapMeanCI([]float64{-1, 1, -1, 1, 0, 0}, 0.95, 2000, rand.New(rand.NewSource(1)))
+	require.True(t, ok)
+	assert.Less(t, res.Lower, float64(0))
+	assert.Greater(t, res.Upper, float64(0))
+	assert.Greater(t, res.PValue, 0.5)
+
+	_, ok = BootstrapMeanCI(nil, 0.95, 100, rand.New(rand.NewSource(1)))
+	assert.False(t, ok)
+	_, ok = BootstrapMeanCI(xs, 1, 100, rand.New(rand.NewSource(1)))
+	assert.False(t, ok)
+}
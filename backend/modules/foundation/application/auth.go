@@ -10,6 +10,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/auth"
 	authModel "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/domain/auth"
+	"github.com/coze-dev/coze-loop/backend/modules/foundation/domain/user/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/foundation/domain/user/repo"
 	"github.com/coze-dev/coze-loop/backend/modules/foundation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
@@ -42,15 +43,19 @@ func (a *AuthApplicationImpl) MCheckPermission(ctx context.Context, request *aut
 		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid space_id in request"))
 	}
 
-	isUserSpace, err := a.userRepo.CheckUserSpaceExist(ctx, userID, spaceID)
+	role, err := a.userRepo.GetUserSpaceRole(ctx, userID, spaceID)
 	if err != nil {
-		logs.CtxError(ctx, "failed to check user space, userID: %v, spaceID: %v, err: %v", userID, spaceID, err)
+		logs.CtxError(ctx, "failed to get user space role, userID: %v, spaceID: %v, err: %v", userID, spaceID, err)
 		return nil, err
 	}
 
 	authRes := make([]*authModel.SubjectActionObjectAuthRes, 0, len(request.Auths))
 	for _, authObject := range request.Auths {
-		isAllowed := true
+		// 按角色校验 action，未登记的 action 一律拒绝
+		if entity.RequiredSpaceUserType(authObject.GetAction()) == entity.SpaceUserTypeUnknown {
+			logs.CtxWarn(ctx, "unregistered auth action denied, action: %v, spaceID: %v", authObject.GetAction(), spaceID)
+		}
+		isAllowed := entity.AllowSpaceAction(role, authObject.GetAction())
 		for _, object := range authObject.Objects {
 			if object.SpaceID != nil && object.GetSpaceID() != strconv.FormatInt(spaceID, 10) {
 				isAllowed = false
@@ -60,7 +65,7 @@ func (a *AuthApplicationImpl) MCheckPermission(ctx context.Context, request *aut
 
 		authRes = append(authRes, &authModel.SubjectActionObjectAuthRes{
 			SubjectActionObjects: authObject,
-			IsAllowed:            ptr.Of(isUserSpace && isAllowed),
+			IsAllowed:            ptr.Of(isAllowed),
 		})
 	}
 
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/auth"
+	authModel "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/domain/auth"
+	"github.com/coze-dev/coze-loop/backend/modules/foundation/domain/user/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/foundation/domain/user/repo/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TestAuthApplicationImpl_MCheckPermission(t *testing.T) {
+	ctx := session.WithCtxUser(context.Background(), &session.User{ID: "1"})
+	req := &auth.MCheckPermissionRequest{
+		SpaceID: ptr.Of(int64(100)),
+		Auths: []*authModel.SubjectActionObjects{
+			{Action: ptr.Of("listLoopPrompt"), Objects: []*authModel.AuthEntity{{SpaceID: ptr.Of("100")}}},
+			{Action: ptr.Of("edit"), Objects: []*authModel.AuthEntity{{SpaceID: ptr.Of("100")}}},
+			{Action: ptr.Of("listLoopPrompt"), Objects: []*authModel.AuthEntity{{SpaceID: ptr.Of("200")}}},
+		},
+	}
+
+	tests := []struct {
+		name string
+		role entity.SpaceUserType
+		want []bool
+	}{
+		{name: "viewer", role: entity.SpaceUserTypeViewer, want: []bool{true, false, false}},
+		{name: "editor", role: entity.SpaceUserTypeEditor, want: []bool{true, true, false}},
+		{name: "not member", role: entity.SpaceUserTypeUnknown, want: []bool{false, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := repomocks.NewMockIUserRepo(ctrl)
+			mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(1), int64(100)).Return(tt.role, nil)
+
+			a := &AuthApplicationImpl{userRepo: mockRepo}
+			got, err := a.MCheckPermission(ctx, req)
+			assert.NoError(t, err)
+			allowed := make([]bool, 0, len(got.AuthRes))
+			for _, res := range got.AuthRes {
+				allowed = append(allowed, res.GetIsAllowed())
+			}
+			assert.Equal(t, tt.want, allowed)
+		})
+	}
+}
@@ -41,3 +41,20 @@ func SpaceDTO2DO(spaceDTO *space.Space) *entity.Space {
 		OwnerID:     userID,
 	}
 }
+
+func SpaceMembersDO2DTO(members []*entity.SpaceMember, users []*entity.User) []*space.SpaceMember {
+	userMap := make(map[int64]*entity.User, len(users))
+	for _, user := range users {
+		userMap[user.UserID] = user
+	}
+	dtos := make([]*space.SpaceMember, 0, len(members))
+	for _, member := range members {
+		dtos = append(dtos, &space.SpaceMember{
+			UserID:   ptr.Of(conv.ToString(member.UserID)),
+			RoleType: ptr.Of(space.SpaceRoleType(member.RoleType)),
+			UserInfo: UserDO2DTO(userMap[member.UserID]),
+			JoinAt:   ptr.Of(member.CreatedAt.UnixMilli()),
+		})
+	}
+	return dtos
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/space"
 	"github.com/coze-dev/coze-loop/backend/modules/foundation/application/convertor"
+	"github.com/coze-dev/coze-loop/backend/modules/foundation/domain/user/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/foundation/domain/user/repo"
 	"github.com/coze-dev/coze-loop/backend/modules/foundation/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/conv"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/slices"
 )
@@ -65,3 +67,161 @@ func (s SpaceApplicationImpl) ListUserSpaces(ctx context.Context, request *space
 	}
 	return r, nil
 }
+
+func (s SpaceApplicationImpl) ListSpaceMembers(ctx context.Context, request *space.ListSpaceMembersRequest) (r *space.ListSpaceMembersResponse, err error) {
+	if request.GetSpaceID() <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("SpaceApplicationImpl.ListSpaceMembers invalid param"))
+	}
+	if _, _, err := s.checkSpaceAction(ctx, request.GetSpaceID(), entity.ActionListSpaceMember); err != nil {
+		return nil, err
+	}
+
+	members, total, err := s.userRepo.ListSpaceMembers(ctx, request.GetSpaceID(), request.GetPageSize(), request.GetPageNumber())
+	if err != nil {
+		return nil, err
+	}
+	var users []*entity.User
+	if len(members) > 0 {
+		users, err = s.userRepo.MGetUserByIDs(ctx, slices.Map(members, func(m *entity.SpaceMember) int64 { return m.UserID }))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &space.ListSpaceMembersResponse{
+		Members: convertor.SpaceMembersDO2DTO(members, users),
+		Total:   ptr.Of(total),
+	}, nil
+}
+
+func (s SpaceApplicationImpl) AddSpaceMember(ctx context.Context, request *space.AddSpaceMemberRequest) (r *space.AddSpaceMemberResponse, err error) {
+	targetUserID, err := conv.Int64(request.GetUserID())
+	if request.GetSpaceID() <= 0 || err != nil || targetUserID <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("SpaceApplicationImpl.AddSpaceMember invalid param"))
+	}
+	_, operatorRole, err := s.checkSpaceAction(ctx, request.GetSpaceID(), entity.ActionManageSpaceMember)
+	if err != nil {
+		return nil, err
+	}
+	roleType := entity.SpaceUserType(request.GetRoleType())
+	if err := checkAssignSpaceRole(operatorRole, entity.SpaceUserTypeUnknown, roleType); err != nil {
+		return nil, err
+	}
+
+	users, err := s.userRepo.MGetUserByIDs(ctx, []int64{targetUserID})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, errorx.NewByCode(errno.UserNotExistCode)
+	}
+	targetRole, err := s.userRepo.GetUserSpaceRole(ctx, targetUserID, request.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+	if targetRole.IsValid() {
+		return nil, errorx.NewByCode(errno.CommonResourceDuplicatedCode, errorx.WithExtraMsg("user is already a member of the space"))
+	}
+
+	if err := s.userRepo.AddSpaceMember(ctx, &entity.SpaceMember{
+		SpaceID:  request.GetSpaceID(),
+		UserID:   targetUserID,
+		RoleType: roleType,
+	}); err != nil {
+		return nil, err
+	}
+	return space.NewAddSpaceMemberResponse(), nil
+}
+
+func (s SpaceApplicationImpl) RemoveSpaceMember(ctx context.Context, request *space.RemoveSpaceMemberRequest) (r *space.RemoveSpaceMemberResponse, err error) {
+	targetUserID, err := conv.Int64(request.GetUserID())
+	if request.GetSpaceID() <= 0 || err != nil || targetUserID <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("SpaceApplicationImpl.RemoveSpaceMember invalid param"))
+	}
+	operatorID, operatorRole, err := s.checkSpaceAction(ctx, request.GetSpaceID(), "")
+	if err != nil {
+		return nil, err
+	}
+	targetRole, err := s.userRepo.GetUserSpaceRole(ctx, targetUserID, request.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+	if !targetRole.IsValid() {
+		return nil, errorx.NewByCode(errno.SpaceMemberNotExistCode)
+	}
+	if targetRole == entity.SpaceUserTypeOwner {
+		return nil, errorx.NewByCode(errno.SpaceMemberRoleForbiddenCode, errorx.WithExtraMsg("space owner cannot be removed"))
+	}
+	// 成员可以主动退出空间，移除他人需要管理权限
+	if operatorID != targetUserID {
+		if !entity.AllowSpaceAction(operatorRole, entity.ActionManageSpaceMember) {
+			return nil, errorx.NewByCode(errno.CommonNoPermissionCode)
+		}
+		if err := checkAssignSpaceRole(operatorRole, targetRole, entity.SpaceUserTypeViewer); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.userRepo.RemoveSpaceMember(ctx, request.GetSpaceID(), targetUserID); err != nil {
+		return nil, err
+	}
+	return space.NewRemoveSpaceMemberResponse(), nil
+}
+
+func (s SpaceApplicationImpl) UpdateSpaceMemberRole(ctx context.Context, request *space.UpdateSpaceMemberRoleRequest) (r *space.UpdateSpaceMemberRoleResponse, err error) {
+	targetUserID, err := conv.Int64(request.GetUserID())
+	if request.GetSpaceID() <= 0 || err != nil || targetUserID <= 0 {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("SpaceApplicationImpl.UpdateSpaceMemberRole invalid param"))
+	}
+	_, operatorRole, err := s.checkSpaceAction(ctx, request.GetSpaceID(), entity.ActionManageSpaceMember)
+	if err != nil {
+		return nil, err
+	}
+	targetRole, err := s.userRepo.GetUserSpaceRole(ctx, targetUserID, request.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+	if !targetRole.IsValid() {
+		return nil, errorx.NewByCode(errno.SpaceMemberNotExistCode)
+	}
+	roleType := entity.SpaceUserType(request.GetRoleType())
+	if err := checkAssignSpaceRole(operatorRole, targetRole, roleType); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.UpdateSpaceMemberRole(ctx, request.GetSpaceID(), targetUserID, roleType); err != nil {
+		return nil, err
+	}
+	return space.NewUpdateSpaceMemberRoleResponse(), nil
+}
+
+// checkSpaceAction 校验当前用户在空间中是否可以执行 action，返回当前用户 ID 与角色
+func (s SpaceApplicationImpl) checkSpaceAction(ctx context.Context, spaceID int64, action string) (int64, entity.SpaceUserType, error) {
+	userID, err := strconv.ParseInt(session.UserIDInCtxOrEmpty(ctx), 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, entity.SpaceUserTypeUnknown, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid user_id in context"))
+	}
+	role, err := s.userRepo.GetUserSpaceRole(ctx, userID, spaceID)
+	if err != nil {
+		return 0, entity.SpaceUserTypeUnknown, err
+	}
+	if !entity.AllowSpaceAction(role, action) {
+		return 0, entity.SpaceUserTypeUnknown, errorx.NewByCode(errno.CommonNoPermissionCode)
+	}
+	return userID, role, nil
+}
+
+// checkAssignSpaceRole 校验操作者能否将成员从 from 角色调整为 to 角色。
+// 所有者角色不可变更或授予；管理员的任免只能由所有者操作。
+func checkAssignSpaceRole(operator, from, to entity.SpaceUserType) error {
+	if !to.IsValid() {
+		return errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid role type"))
+	}
+	if from == entity.SpaceUserTypeOwner || to == entity.SpaceUserTypeOwner {
+		return errorx.NewByCode(errno.SpaceMemberRoleForbiddenCode, errorx.WithExtraMsg("space owner cannot be granted or changed"))
+	}
+	if (from == entity.SpaceUserTypeAdmin || to == entity.SpaceUserTypeAdmin) && operator != entity.SpaceUserTypeOwner {
+		return errorx.NewByCode(errno.SpaceMemberRoleForbiddenCode, errorx.WithExtraMsg("only space owner can manage admins"))
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	spacedomain "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/domain/space"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/foundation/space"
 	"github.com/coze-dev/coze-loop/backend/modules/foundation/application/convertor"
 	"github.com/coze-dev/coze-loop/backend/modules/foundation/domain/user/entity"
@@ -234,3 +235,124 @@ func TestSpaceApplicationImpl_ListUserSpaces(t *testing.T) {
 		})
 	}
 }
+
+func TestSpaceApplicationImpl_SpaceMembers(t *testing.T) {
+	const spaceID = int64(100)
+	operator := &session.User{ID: "1", Name: "operator"}
+	ctx := session.WithCtxUser(context.Background(), operator)
+
+	t.Run("list members", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := repomocks.NewMockIUserRepo(ctrl)
+		members := []*entity.SpaceMember{
+			{SpaceID: spaceID, UserID: 1, RoleType: entity.SpaceUserTypeOwner},
+			{SpaceID: spaceID, UserID: 2, RoleType: entity.SpaceUserTypeViewer},
+		}
+		users := []*entity.User{{UserID: 1, UniqueName: "u1"}, {UserID: 2, UniqueName: "u2"}}
+		mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(1), spaceID).Return(entity.SpaceUserTypeViewer, nil)
+		mockRepo.EXPECT().ListSpaceMembers(gomock.Any(), spaceID, int32(10), int32(1)).Return(members, int32(2), nil)
+		mockRepo.EXPECT().MGetUserByIDs(gomock.Any(), []int64{1, 2}).Return(users, nil)
+
+		p := &SpaceApplicationImpl{userRepo: mockRepo}
+		got, err := p.ListSpaceMembers(ctx, &space.ListSpaceMembersRequest{SpaceID: spaceID, PageSize: ptr.Of(int32(10)), PageNumber: ptr.Of(int32(1))})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), got.GetTotal())
+		assert.Equal(t, convertor.SpaceMembersDO2DTO(members, users), got.Members)
+	})
+
+	t.Run("list members not in space", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := repomocks.NewMockIUserRepo(ctrl)
+		mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(1), spaceID).Return(entity.SpaceUserTypeUnknown, nil)
+
+		p := &SpaceApplicationImpl{userRepo: mockRepo}
+		_, err := p.ListSpaceMembers(ctx, &space.ListSpaceMembersRequest{SpaceID: spaceID})
+		unittest.AssertErrorEqual(t, errorx.NewByCode(errno.CommonNoPermissionCode), err)
+	})
+
+	tests := []struct {
+		name         string
+		operatorRole entity.SpaceUserType
+		targetRole   entity.SpaceUserType
+		newRole      entity.SpaceUserType
+		wantErr      error
+	}{
+		{name: "admin grants editor", operatorRole: entity.SpaceUserTypeAdmin, targetRole: entity.SpaceUserTypeViewer, newRole: entity.SpaceUserTypeEditor},
+		{name: "owner grants admin", operatorRole: entity.SpaceUserTypeOwner, targetRole: entity.SpaceUserTypeEditor, newRole: entity.SpaceUserTypeAdmin},
+		{name: "editor cannot manage", operatorRole: entity.SpaceUserTypeEditor, targetRole: entity.SpaceUserTypeViewer, newRole: entity.SpaceUserTypeEditor, wantErr: errorx.NewByCode(errno.CommonNoPermissionCode)},
+		{name: "admin cannot grant admin", operatorRole: entity.SpaceUserTypeAdmin, targetRole: entity.SpaceUserTypeEditor, newRole: entity.SpaceUserTypeAdmin, wantErr: errorx.NewByCode(errno.SpaceMemberRoleForbiddenCode)},
+		{name: "admin cannot demote admin", operatorRole: entity.SpaceUserTypeAdmin, targetRole: entity.SpaceUserTypeAdmin, newRole: entity.SpaceUserTypeViewer, wantErr: errorx.NewByCode(errno.SpaceMemberRoleForbiddenCode)},
+		{name: "owner cannot be changed", operatorRole: entity.SpaceUserTypeOwner, targetRole: entity.SpaceUserTypeOwner, newRole: entity.SpaceUserTypeAdmin, wantErr: errorx.NewByCode(errno.SpaceMemberRoleForbiddenCode)},
+		{name: "cannot grant owner", operatorRole: entity.SpaceUserTypeOwner, targetRole: entity.SpaceUserTypeEditor, newRole: entity.SpaceUserTypeOwner, wantErr: errorx.NewByCode(errno.SpaceMemberRoleForbiddenCode)},
+		{name: "target not member", operatorRole: entity.SpaceUserTypeOwner, targetRole: entity.SpaceUserTypeUnknown, newRole: entity.SpaceUserTypeEditor, wantErr: errorx.NewByCode(errno.SpaceMemberNotExistCode)},
+	}
+	for _, tt := range tests {
+		t.Run("update role: "+tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRepo := repomocks.NewMockIUserRepo(ctrl)
+			mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(1), spaceID).Return(tt.operatorRole, nil)
+			mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(2), spaceID).Return(tt.targetRole, nil).MaxTimes(1)
+			if tt.wantErr == nil {
+				mockRepo.EXPECT().UpdateSpaceMemberRole(gomock.Any(), spaceID, int64(2), tt.newRole).Return(nil)
+			}
+
+			p := &SpaceApplicationImpl{userRepo: mockRepo}
+			_, err := p.UpdateSpaceMemberRole(ctx, &space.UpdateSpaceMemberRoleRequest{
+				SpaceID:  spaceID,
+				UserID:   "2",
+				RoleType: spaceDomainRole(tt.newRole),
+			})
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+		})
+	}
+
+	t.Run("add member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := repomocks.NewMockIUserRepo(ctrl)
+		mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(1), spaceID).Return(entity.SpaceUserTypeAdmin, nil)
+		mockRepo.EXPECT().MGetUserByIDs(gomock.Any(), []int64{2}).Return([]*entity.User{{UserID: 2}}, nil)
+		mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(2), spaceID).Return(entity.SpaceUserTypeUnknown, nil)
+		mockRepo.EXPECT().AddSpaceMember(gomock.Any(), &entity.SpaceMember{SpaceID: spaceID, UserID: 2, RoleType: entity.SpaceUserTypeViewer}).Return(nil)
+
+		p := &SpaceApplicationImpl{userRepo: mockRepo}
+		_, err := p.AddSpaceMember(ctx, &space.AddSpaceMemberRequest{SpaceID: spaceID, UserID: "2", RoleType: spaceDomainRole(entity.SpaceUserTypeViewer)})
+		assert.NoError(t, err)
+	})
+
+	t.Run("add member user not exist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := repomocks.NewMockIUserRepo(ctrl)
+		mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(1), spaceID).Return(entity.SpaceUserTypeAdmin, nil)
+		mockRepo.EXPECT().MGetUserByIDs(gomock.Any(), []int64{2}).Return(nil, nil)
+
+		p := &SpaceApplicationImpl{userRepo: mockRepo}
+		_, err := p.AddSpaceMember(ctx, &space.AddSpaceMemberRequest{SpaceID: spaceID, UserID: "2", RoleType: spaceDomainRole(entity.SpaceUserTypeViewer)})
+		unittest.AssertErrorEqual(t, errorx.NewByCode(errno.UserNotExistCode), err)
+	})
+
+	t.Run("viewer leaves space", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := repomocks.NewMockIUserRepo(ctrl)
+		mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(1), spaceID).Return(entity.SpaceUserTypeViewer, nil).Times(2)
+		mockRepo.EXPECT().RemoveSpaceMember(gomock.Any(), spaceID, int64(1)).Return(nil)
+
+		p := &SpaceApplicationImpl{userRepo: mockRepo}
+		_, err := p.RemoveSpaceMember(ctx, &space.RemoveSpaceMemberRequest{SpaceID: spaceID, UserID: "1"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("viewer cannot remove others", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := repomocks.NewMockIUserRepo(ctrl)
+		mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(1), spaceID).Return(entity.SpaceUserTypeViewer, nil)
+		mockRepo.EXPECT().GetUserSpaceRole(gomock.Any(), int64(2), spaceID).Return(entity.SpaceUserTypeEditor, nil)
+
+		p := &SpaceApplicationImpl{userRepo: mockRepo}
+		_, err := p.RemoveSpaceMember(ctx, &space.RemoveSpaceMemberRequest{SpaceID: spaceID, UserID: "2"})
+		unittest.AssertErrorEqual(t, errorx.NewByCode(errno.CommonNoPermissionCode), err)
+	})
+}
+
+func spaceDomainRole(role entity.SpaceUserType) spacedomain.SpaceRoleType {
+	return spacedomain.SpaceRoleType(role)
+}
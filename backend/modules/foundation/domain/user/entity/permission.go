@@ -0,0 +1,86 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+const (
+	ActionListSpaceMember   = "listSpaceMember"   // 查看空间成员
+	ActionManageSpaceMember = "manageSpaceMember" // 邀请、移除成员及修改成员角色
+	ActionManageModel       = "manageModel"       // 注册、修改、删除、启停空间的模型
+)
+
+// spaceActionPolicy 各模块鉴权使用的权限点与所需的最低角色。新增权限点时必须在此登记，未登记的权限点一律拒绝。
+var spaceActionPolicy = map[string]SpaceUserType{
+	// 通用
+	"read":          SpaceUserTypeViewer,
+	"readItem":      SpaceUserTypeViewer,
+	"edit":          SpaceUserTypeEditor,
+	"run":           SpaceUserTypeEditor,
+	"debug":         SpaceUserTypeEditor,
+	"execute":       SpaceUserTypeEditor,
+	"addItem":       SpaceUserTypeEditor,
+	"updateItem":    SpaceUserTypeEditor,
+	"deleteItem":    SpaceUserTypeEditor,
+	"createVersion": SpaceUserTypeEditor,
+	"editSchema":    SpaceUserTypeEditor,
+
+	// 空间与模型
+	ActionListSpaceMember:   SpaceUserTypeViewer,
+	ActionManageSpaceMember: SpaceUserTypeAdmin,
+	"listModels":            SpaceUserTypeViewer,
+	"getModel":              SpaceUserTypeViewer,
+	ActionManageModel:       SpaceUserTypeAdmin,
+
+	// Prompt
+	"listLoopPrompt":   SpaceUserTypeViewer,
+	"createLoopPrompt": SpaceUserTypeEditor,
+
+	// 评测
+	"listLoopEvaluationSet":          SpaceUserTypeViewer,
+	"createLoopEvaluationSet":        SpaceUserTypeEditor,
+	"listLoopEvaluator":              SpaceUserTypeViewer,
+	"createLoopEvaluator":            SpaceUserTypeEditor,
+	"debugLoopEvaluator":             SpaceUserTypeEditor,
+	"listLoopEvaluationTarget":       SpaceUserTypeViewer,
+	"createLoopEvaluationTarget":     SpaceUserTypeEditor,
+	"debugLoopEvalTarget":            SpaceUserTypeEditor,
+	"listLoopEvaluationExperiment":   SpaceUserTypeViewer,
+	"createLoopEvaluationExperiment": SpaceUserTypeEditor,
+	"listLoopExptTemplate":           SpaceUserTypeViewer,
+	"createLoopExptTemplate":         SpaceUserTypeEditor,
+
+	// 观测
+	"listLoopTrace":             SpaceUserTypeViewer,
+	"readLoopTrace":             SpaceUserTypeViewer,
+	"readLoopIndictor":          SpaceUserTypeViewer,
+	"previewExportLoopTrace":    SpaceUserTypeViewer,
+	"exportLoopTrace":           SpaceUserTypeEditor,
+	"ingestLoopTrace":           SpaceUserTypeEditor,
+	"listLoopTask":              SpaceUserTypeViewer,
+	"createLoopTask":            SpaceUserTypeEditor,
+	"listLoopTraceView":         SpaceUserTypeViewer,
+	"createLoopTraceView":       SpaceUserTypeEditor,
+	"createLoopTraceAnnotation": SpaceUserTypeEditor,
+	"deleteLoopTraceAnnotation": SpaceUserTypeEditor,
+
+	// 文件
+	"uploadFile":     SpaceUserTypeEditor,
+	"uploadLoopFile": SpaceUserTypeEditor,
+}
+
+// RequiredSpaceUserType 返回执行 action 所需的最低空间角色。
+// action 为空时仅校验空间成员身份；未登记的 action 返回 SpaceUserTypeUnknown，任何角色均无权执行。
+func RequiredSpaceUserType(action string) SpaceUserType {
+	if action == "" {
+		return SpaceUserTypeViewer
+	}
+	if role, ok := spaceActionPolicy[action]; ok {
+		return role
+	}
+	return SpaceUserTypeUnknown
+}
+
+// AllowSpaceAction 判断空间角色是否可以执行 action
+func AllowSpaceAction(role SpaceUserType, action string) bool {
+	return role.Covers(RequiredSpaceUserType(action))
+}
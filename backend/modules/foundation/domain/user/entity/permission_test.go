@@ -0,0 +1,51 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowSpaceAction(t *testing.T) {
+	tests := []struct {
+		role   SpaceUserType
+		action string
+		want   bool
+	}{
+		{role: SpaceUserTypeUnknown, action: "", want: false},
+		{role: SpaceUserTypeViewer, action: "", want: true},
+		{role: SpaceUserTypeViewer, action: "listLoopPrompt", want: true},
+		{role: SpaceUserTypeViewer, action: "readLoopTrace", want: true},
+		{role: SpaceUserTypeViewer, action: "edit", want: false},
+		{role: SpaceUserTypeViewer, action: "createLoopEvaluationExperiment", want: false},
+		{role: SpaceUserTypeEditor, action: "edit", want: true},
+		{role: SpaceUserTypeEditor, action: ActionListSpaceMember, want: true},
+		{role: SpaceUserTypeEditor, action: ActionManageSpaceMember, want: false},
+		{role: SpaceUserTypeAdmin, action: ActionManageSpaceMember, want: true},
+		{role: SpaceUserTypeEditor, action: ActionManageModel, want: false},
+		{role: SpaceUserTypeAdmin, action: ActionManageModel, want: true},
+		{role: SpaceUserTypeOwner, action: "uploadFile", want: true},
+		{role: SpaceUserType(9), action: "read", want: false},
+		{role: SpaceUserTypeViewer, action: "previewExportLoopTrace", want: true},
+		{role: SpaceUserTypeViewer, action: "exportLoopTrace", want: false},
+		// 未登记的权限点一律拒绝，不按名称前缀推断
+		{role: SpaceUserTypeOwner, action: "getAndResetToken", want: false},
+		{role: SpaceUserTypeOwner, action: "batchGetSecrets", want: false},
+		{role: SpaceUserTypeViewer, action: "listAndPurge", want: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, AllowSpaceAction(tt.role, tt.action), "role=%d, action=%s", tt.role, tt.action)
+	}
+}
+
+func TestRequiredSpaceUserType(t *testing.T) {
+	assert.Equal(t, SpaceUserTypeViewer, RequiredSpaceUserType(""))
+	assert.Equal(t, SpaceUserTypeUnknown, RequiredSpaceUserType("unknownAction"))
+	for action, role := range spaceActionPolicy {
+		assert.True(t, role.IsValid(), action)
+		assert.Equal(t, role, RequiredSpaceUserType(action), action)
+	}
+}
@@ -27,10 +27,33 @@ type Space struct {
 	UpdatedAt   time.Time // 更新时间
 }
 
+// SpaceUserType 空间成员角色，值越小权限越大
 type SpaceUserType int32
 
 const (
-	SpaceUserTypeOwner  SpaceUserType = 1
-	SpaceUserTypeAdmin  SpaceUserType = 2
-	SpaceUserTypeMember SpaceUserType = 3
+	SpaceUserTypeUnknown SpaceUserType = 0
+	SpaceUserTypeOwner   SpaceUserType = 1 // 所有者，每个空间唯一，拥有全部权限
+	SpaceUserTypeAdmin   SpaceUserType = 2 // 管理员，可管理成员
+	SpaceUserTypeEditor  SpaceUserType = 3 // 编辑者，可创建、编辑、删除空间内的资源
+	SpaceUserTypeViewer  SpaceUserType = 4 // 查看者，只读
+
+	// SpaceUserTypeMember 兼容旧数据，等同于 SpaceUserTypeEditor
+	SpaceUserTypeMember = SpaceUserTypeEditor
 )
+
+func (t SpaceUserType) IsValid() bool {
+	return t >= SpaceUserTypeOwner && t <= SpaceUserTypeViewer
+}
+
+// Covers 判断当前角色是否不低于 required
+func (t SpaceUserType) Covers(required SpaceUserType) bool {
+	return t.IsValid() && required.IsValid() && t <= required
+}
+
+type SpaceMember struct {
+	SpaceID   int64
+	UserID    int64
+	RoleType  SpaceUserType
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
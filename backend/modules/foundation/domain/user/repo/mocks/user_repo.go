@@ -42,6 +42,20 @@ func (m *MockIUserRepo) EXPECT() *MockIUserRepoMockRecorder {
 	return m.recorder
 }
 
+// AddSpaceMember mocks base method.
+func (m *MockIUserRepo) AddSpaceMember(ctx context.Context, member *entity.SpaceMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSpaceMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSpaceMember indicates an expected call of AddSpaceMember.
+func (mr *MockIUserRepoMockRecorder) AddSpaceMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSpaceMember", reflect.TypeOf((*MockIUserRepo)(nil).AddSpaceMember), ctx, member)
+}
+
 // CheckEmailExist mocks base method.
 func (m *MockIUserRepo) CheckEmailExist(ctx context.Context, email string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +160,21 @@ func (mr *MockIUserRepoMockRecorder) GetSpaceByID(ctx, spaceID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpaceByID", reflect.TypeOf((*MockIUserRepo)(nil).GetSpaceByID), ctx, spaceID)
 }
 
+// GetSpaceMember mocks base method.
+func (m *MockIUserRepo) GetSpaceMember(ctx context.Context, spaceID, userID int64) (*entity.SpaceMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpaceMember", ctx, spaceID, userID)
+	ret0, _ := ret[0].(*entity.SpaceMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpaceMember indicates an expected call of GetSpaceMember.
+func (mr *MockIUserRepoMockRecorder) GetSpaceMember(ctx, spaceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpaceMember", reflect.TypeOf((*MockIUserRepo)(nil).GetSpaceMember), ctx, spaceID, userID)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockIUserRepo) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +205,37 @@ func (mr *MockIUserRepoMockRecorder) GetUserByID(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockIUserRepo)(nil).GetUserByID), ctx, userID)
 }
 
+// GetUserSpaceRole mocks base method.
+func (m *MockIUserRepo) GetUserSpaceRole(ctx context.Context, userID, spaceID int64) (entity.SpaceUserType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSpaceRole", ctx, userID, spaceID)
+	ret0, _ := ret[0].(entity.SpaceUserType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSpaceRole indicates an expected call of GetUserSpaceRole.
+func (mr *MockIUserRepoMockRecorder) GetUserSpaceRole(ctx, userID, spaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSpaceRole", reflect.TypeOf((*MockIUserRepo)(nil).GetUserSpaceRole), ctx, userID, spaceID)
+}
+
+// ListSpaceMembers mocks base method.
+func (m *MockIUserRepo) ListSpaceMembers(ctx context.Context, spaceID int64, pageSize, pageNum int32) ([]*entity.SpaceMember, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSpaceMembers", ctx, spaceID, pageSize, pageNum)
+	ret0, _ := ret[0].([]*entity.SpaceMember)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSpaceMembers indicates an expected call of ListSpaceMembers.
+func (mr *MockIUserRepoMockRecorder) ListSpaceMembers(ctx, spaceID, pageSize, pageNum any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSpaceMembers", reflect.TypeOf((*MockIUserRepo)(nil).ListSpaceMembers), ctx, spaceID, pageSize, pageNum)
+}
+
 // ListUserSpace mocks base method.
 func (m *MockIUserRepo) ListUserSpace(ctx context.Context, userID int64, pageSize, pageNum int32) ([]*entity.Space, int32, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +282,20 @@ func (mr *MockIUserRepoMockRecorder) MGetUserByIDs(ctx, userIDs any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGetUserByIDs", reflect.TypeOf((*MockIUserRepo)(nil).MGetUserByIDs), ctx, userIDs)
 }
 
+// RemoveSpaceMember mocks base method.
+func (m *MockIUserRepo) RemoveSpaceMember(ctx context.Context, spaceID, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSpaceMember", ctx, spaceID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSpaceMember indicates an expected call of RemoveSpaceMember.
+func (mr *MockIUserRepoMockRecorder) RemoveSpaceMember(ctx, spaceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSpaceMember", reflect.TypeOf((*MockIUserRepo)(nil).RemoveSpaceMember), ctx, spaceID, userID)
+}
+
 // UpdateAvatar mocks base method.
 func (m *MockIUserRepo) UpdateAvatar(ctx context.Context, userID int64, iconURI string) error {
 	m.ctrl.T.Helper()
@@ -278,3 +352,17 @@ func (mr *MockIUserRepoMockRecorder) UpdateSessionKey(ctx, userID, sessionKey an
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSessionKey", reflect.TypeOf((*MockIUserRepo)(nil).UpdateSessionKey), ctx, userID, sessionKey)
 }
+
+// UpdateSpaceMemberRole mocks base method.
+func (m *MockIUserRepo) UpdateSpaceMemberRole(ctx context.Context, spaceID, userID int64, roleType entity.SpaceUserType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSpaceMemberRole", ctx, spaceID, userID, roleType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSpaceMemberRole indicates an expected call of UpdateSpaceMemberRole.
+func (mr *MockIUserRepoMockRecorder) UpdateSpaceMemberRole(ctx, spaceID, userID, roleType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSpaceMemberRole", reflect.TypeOf((*MockIUserRepo)(nil).UpdateSpaceMemberRole), ctx, spaceID, userID, roleType)
+}
@@ -30,6 +30,15 @@ type IUserRepo interface {
 	GetSpaceByID(ctx context.Context, spaceID int64) (*entity.Space, error)
 	MGetSpaceByIDs(ctx context.Context, spaceIDs []int64) ([]*entity.Space, error)
 	CheckUserSpaceExist(ctx context.Context, userID, spaceID int64) (bool, error)
+
+	// GetUserSpaceRole 获取用户在空间中的角色，空间所有者恒为 Owner，非成员返回 SpaceUserTypeUnknown
+	GetUserSpaceRole(ctx context.Context, userID, spaceID int64) (entity.SpaceUserType, error)
+	// GetSpaceMember 非成员时返回 nil
+	GetSpaceMember(ctx context.Context, spaceID, userID int64) (*entity.SpaceMember, error)
+	ListSpaceMembers(ctx context.Context, spaceID int64, pageSize, pageNum int32) ([]*entity.SpaceMember, int32, error)
+	AddSpaceMember(ctx context.Context, member *entity.SpaceMember) error
+	UpdateSpaceMemberRole(ctx context.Context, spaceID, userID int64, roleType entity.SpaceUserType) error
+	RemoveSpaceMember(ctx context.Context, spaceID, userID int64) error
 }
 
 type UpdateProfileParam struct {
@@ -39,3 +39,27 @@ func SpacePO2DO(po *model.Space) *entity.Space {
 		UpdatedAt:   po.UpdatedAt,
 	}
 }
+
+func SpaceMemberDO2PO(do *entity.SpaceMember) *model.SpaceUser {
+	if do == nil {
+		return nil
+	}
+	return &model.SpaceUser{
+		SpaceID:  do.SpaceID,
+		UserID:   do.UserID,
+		RoleType: int32(do.RoleType),
+	}
+}
+
+func SpaceMemberPO2DO(po *model.SpaceUser) *entity.SpaceMember {
+	if po == nil {
+		return nil
+	}
+	return &entity.SpaceMember{
+		SpaceID:   po.SpaceID,
+		UserID:    po.UserID,
+		RoleType:  entity.SpaceUserType(po.RoleType),
+		CreatedAt: po.CreatedAt,
+		UpdatedAt: po.UpdatedAt,
+	}
+}
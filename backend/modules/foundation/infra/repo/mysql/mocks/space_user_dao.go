@@ -61,6 +61,45 @@ func (mr *MockISpaceUserDAOMockRecorder) Create(ctx, spaceUser any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockISpaceUserDAO)(nil).Create), varargs...)
 }
 
+// Delete mocks base method.
+func (m *MockISpaceUserDAO) Delete(ctx context.Context, spaceID, userID int64, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, spaceID, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockISpaceUserDAOMockRecorder) Delete(ctx, spaceID, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, spaceID, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockISpaceUserDAO)(nil).Delete), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockISpaceUserDAO) Get(ctx context.Context, spaceID, userID int64, opts ...db.Option) (*model.SpaceUser, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, spaceID, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(*model.SpaceUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockISpaceUserDAOMockRecorder) Get(ctx, spaceID, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, spaceID, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockISpaceUserDAO)(nil).Get), varargs...)
+}
+
 // List mocks base method.
 func (m *MockISpaceUserDAO) List(ctx context.Context, userID int64, pageSize, pageNumber int32, opts ...db.Option) ([]*model.SpaceUser, int32, error) {
 	m.ctrl.T.Helper()
@@ -81,3 +120,43 @@ func (mr *MockISpaceUserDAOMockRecorder) List(ctx, userID, pageSize, pageNumber
 	varargs := append([]any{ctx, userID, pageSize, pageNumber}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockISpaceUserDAO)(nil).List), varargs...)
 }
+
+// ListBySpace mocks base method.
+func (m *MockISpaceUserDAO) ListBySpace(ctx context.Context, spaceID int64, pageSize, pageNumber int32, opts ...db.Option) ([]*model.SpaceUser, int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, spaceID, pageSize, pageNumber}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBySpace", varargs...)
+	ret0, _ := ret[0].([]*model.SpaceUser)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBySpace indicates an expected call of ListBySpace.
+func (mr *MockISpaceUserDAOMockRecorder) ListBySpace(ctx, spaceID, pageSize, pageNumber any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, spaceID, pageSize, pageNumber}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySpace", reflect.TypeOf((*MockISpaceUserDAO)(nil).ListBySpace), varargs...)
+}
+
+// UpdateRole mocks base method.
+func (m *MockISpaceUserDAO) UpdateRole(ctx context.Context, spaceID, userID int64, roleType int32, opts ...db.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, spaceID, userID, roleType}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRole", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockISpaceUserDAOMockRecorder) UpdateRole(ctx, spaceID, userID, roleType any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, spaceID, userID, roleType}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockISpaceUserDAO)(nil).UpdateRole), varargs...)
+}
@@ -15,6 +15,10 @@ import (
 type ISpaceUserDAO interface {
 	Create(ctx context.Context, spaceUser *model.SpaceUser, opts ...db.Option) error
 	List(ctx context.Context, userID int64, pageSize, pageNumber int32, opts ...db.Option) ([]*model.SpaceUser, int32, error)
+	Get(ctx context.Context, spaceID, userID int64, opts ...db.Option) (*model.SpaceUser, error)
+	ListBySpace(ctx context.Context, spaceID int64, pageSize, pageNumber int32, opts ...db.Option) ([]*model.SpaceUser, int32, error)
+	UpdateRole(ctx context.Context, spaceID, userID int64, roleType int32, opts ...db.Option) error
+	Delete(ctx context.Context, spaceID, userID int64, opts ...db.Option) error
 }
 
 const (
@@ -63,3 +67,56 @@ func (dao *SpaceUserDAOImpl) List(ctx context.Context, userID int64, pageSize, p
 	}
 	return spaceUsers, int32(total), nil
 }
+
+func (dao *SpaceUserDAOImpl) Get(ctx context.Context, spaceID, userID int64, opts ...db.Option) (*model.SpaceUser, error) {
+	dao.query = query.Use(dao.db.NewSession(ctx, opts...))
+	return dao.query.SpaceUser.WithContext(ctx).Where(
+		dao.query.SpaceUser.SpaceID.Eq(spaceID),
+		dao.query.SpaceUser.UserID.Eq(userID),
+	).First()
+}
+
+func (dao *SpaceUserDAOImpl) ListBySpace(ctx context.Context, spaceID int64, pageSize, pageNumber int32, opts ...db.Option) ([]*model.SpaceUser, int32, error) {
+	dao.query = query.Use(dao.db.NewSession(ctx, opts...))
+
+	q := dao.query.SpaceUser.WithContext(ctx).Where(
+		dao.query.SpaceUser.SpaceID.Eq(spaceID),
+	)
+
+	total, err := q.Count()
+	if err != nil || total == 0 {
+		return nil, 0, err
+	}
+
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageNumber == 0 {
+		pageNumber = defaultPageNum
+	}
+	spaceUsers, err := q.Order(dao.query.SpaceUser.RoleType, dao.query.SpaceUser.ID).
+		Offset(int((pageNumber - 1) * pageSize)).Limit(int(pageSize)).Find()
+	if err != nil {
+		return nil, 0, err
+	}
+	return spaceUsers, int32(total), nil
+}
+
+func (dao *SpaceUserDAOImpl) UpdateRole(ctx context.Context, spaceID, userID int64, roleType int32, opts ...db.Option) error {
+	dao.query = query.Use(dao.db.NewSession(ctx, opts...))
+	_, err := dao.query.SpaceUser.WithContext(ctx).Where(
+		dao.query.SpaceUser.SpaceID.Eq(spaceID),
+		dao.query.SpaceUser.UserID.Eq(userID),
+	).UpdateSimple(dao.query.SpaceUser.RoleType.Value(roleType))
+	return err
+}
+
+// Delete 物理删除，避免重新邀请时与唯一索引 uk_space_user 冲突
+func (dao *SpaceUserDAOImpl) Delete(ctx context.Context, spaceID, userID int64, opts ...db.Option) error {
+	dao.query = query.Use(dao.db.NewSession(ctx, opts...))
+	_, err := dao.query.SpaceUser.WithContext(ctx).Where(
+		dao.query.SpaceUser.SpaceID.Eq(spaceID),
+		dao.query.SpaceUser.UserID.Eq(userID),
+	).Delete()
+	return err
+}
@@ -365,14 +365,75 @@ func (u UserRepoImpl) MGetSpaceByIDs(ctx context.Context, spaceIDs []int64) (spa
 }
 
 func (u UserRepoImpl) CheckUserSpaceExist(ctx context.Context, userID, spaceID int64) (bool, error) {
-	space, err := u.GetSpaceByID(ctx, spaceID)
+	role, err := u.GetUserSpaceRole(ctx, userID, spaceID)
 	if err != nil {
 		return false, err
 	}
+	return role.IsValid(), nil
+}
 
-	// OpenSource Version only check owner's space
+func (u UserRepoImpl) GetUserSpaceRole(ctx context.Context, userID, spaceID int64) (entity.SpaceUserType, error) {
+	space, err := u.GetSpaceByID(ctx, spaceID)
+	if err != nil {
+		return entity.SpaceUserTypeUnknown, err
+	}
 	if space.OwnerID == userID {
-		return true, nil
+		return entity.SpaceUserTypeOwner, nil
+	}
+
+	member, err := u.GetSpaceMember(ctx, spaceID, userID)
+	if err != nil {
+		return entity.SpaceUserTypeUnknown, err
+	}
+	if member == nil {
+		return entity.SpaceUserTypeUnknown, nil
 	}
-	return false, nil
+	return member.RoleType, nil
+}
+
+func (u UserRepoImpl) GetSpaceMember(ctx context.Context, spaceID, userID int64) (*entity.SpaceMember, error) {
+	po, err := u.spaceMemberDao.Get(ctx, spaceID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.CommonMySqlErrorCode, errorx.WithExtraMsg("spaceMemberDao.Get error"))
+	}
+	return convertor.SpaceMemberPO2DO(po), nil
+}
+
+func (u UserRepoImpl) ListSpaceMembers(ctx context.Context, spaceID int64, pageSize, pageNum int32) ([]*entity.SpaceMember, int32, error) {
+	pos, total, err := u.spaceMemberDao.ListBySpace(ctx, spaceID, pageSize, pageNum)
+	if err != nil {
+		return nil, 0, errorx.WrapByCode(err, errno.CommonMySqlErrorCode, errorx.WithExtraMsg("spaceMemberDao.ListBySpace error"))
+	}
+	return slices.Map(pos, convertor.SpaceMemberPO2DO), total, nil
+}
+
+func (u UserRepoImpl) AddSpaceMember(ctx context.Context, member *entity.SpaceMember) error {
+	if member == nil {
+		return errorx.New("UserRepoImpl.AddSpaceMember invalid param: member nil")
+	}
+	err := u.spaceMemberDao.Create(ctx, convertor.SpaceMemberDO2PO(member))
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errorx.WrapByCode(err, errno.CommonResourceDuplicatedCode)
+		}
+		return errorx.WrapByCode(err, errno.CommonMySqlErrorCode, errorx.WithExtraMsg("spaceMemberDao.Create error"))
+	}
+	return nil
+}
+
+func (u UserRepoImpl) UpdateSpaceMemberRole(ctx context.Context, spaceID, userID int64, roleType entity.SpaceUserType) error {
+	if err := u.spaceMemberDao.UpdateRole(ctx, spaceID, userID, int32(roleType)); err != nil {
+		return errorx.WrapByCode(err, errno.CommonMySqlErrorCode, errorx.WithExtraMsg("spaceMemberDao.UpdateRole error"))
+	}
+	return nil
+}
+
+func (u UserRepoImpl) RemoveSpaceMember(ctx context.Context, spaceID, userID int64) error {
+	if err := u.spaceMemberDao.Delete(ctx, spaceID, userID); err != nil {
+		return errorx.WrapByCode(err, errno.CommonMySqlErrorCode, errorx.WithExtraMsg("spaceMemberDao.Delete error"))
+	}
+	return nil
 }
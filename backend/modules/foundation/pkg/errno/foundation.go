@@ -83,6 +83,14 @@ const (
 	UserRegistrationControlBlockCode              = 602002008
 	userRegistrationControlBlockMessage           = "email address is restricted from registration based on account security protocols"
 	userRegistrationControlBlockNoAffectStability = true
+
+	SpaceMemberNotExistCode              = 602002101 // space member not exist
+	spaceMemberNotExistMessage           = "space member not exist"
+	spaceMemberNotExistNoAffectStability = true
+
+	SpaceMemberRoleForbiddenCode              = 602002102 // the role of space owner cannot be changed, and only the owner can manage admins
+	spaceMemberRoleForbiddenMessage           = "operation on space member role is not allowed"
+	spaceMemberRoleForbiddenNoAffectStability = true
 )
 
 func init() {
@@ -201,4 +209,16 @@ func init() {
 		code.WithAffectStability(!userRegistrationControlBlockNoAffectStability),
 	)
 
+	code.Register(
+		SpaceMemberNotExistCode,
+		spaceMemberNotExistMessage,
+		code.WithAffectStability(!spaceMemberNotExistNoAffectStability),
+	)
+
+	code.Register(
+		SpaceMemberRoleForbiddenCode,
+		spaceMemberRoleForbiddenMessage,
+		code.WithAffectStability(!spaceMemberRoleForbiddenNoAffectStability),
+	)
+
 }
@@ -440,3 +440,305 @@ func ListModelsFilterDTO2DO(val *manage2.Filter) *entity.ListModelsFilter {
 		Abilities:     gslice.Map(val.Abilities, AbilityEnumDTO2DO),
 	}
 }
+
+func ModelDTO2DO(dto *manage.Model) *entity.Model {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Model{
+		ID:               dto.GetModelID(),
+		WorkspaceID:      dto.GetWorkspaceID(),
+		Name:             dto.GetName(),
+		Desc:             dto.GetDesc(),
+		Ability:          AbilityDTO2DO(dto.Ability),
+		Protocol:         entity.Protocol(dto.GetProtocol()),
+		ProtocolConfig:   ProtocolConfigDTO2DO(dto.ProtocolConfig),
+		ScenarioConfigs:  ScenarioConfigMapDTO2DO(dto.ScenarioConfigs),
+		ParamConfig:      ParamConfigDTO2DO(dto.ParamConfig),
+		Identification:   dto.GetIdentification(),
+		Series:           SeriesDTO2DO(dto.Series),
+		Visibility:       VisibilityDTO2DO(dto.Visibility),
+		Icon:             dto.GetIcon(),
+		Tags:             dto.Tags,
+		Status:           ModelStatusDTO2DO(dto.GetStatus()),
+		OriginalModelURL: dto.GetOriginalModelURL(),
+	}
+}
+
+func SeriesDTO2DO(dto *manage.Series) *entity.Series {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Series{
+		Name:   dto.GetName(),
+		Icon:   dto.GetIcon(),
+		Family: FamilyDTO2DO(dto.GetFamily()),
+	}
+}
+
+func VisibilityDTO2DO(dto *manage.Visibility) *entity.Visibility {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Visibility{
+		Mode:     VisibleModeDTO2DO(dto.GetMode()),
+		SpaceIDs: dto.SpaceIDs,
+	}
+}
+
+func VisibleModeDTO2DO(val manage.VisibleMode) entity.VisibleMode {
+	switch val {
+	case manage.VisibleModeAll:
+		return entity.VisibleModelAll
+	case manage.VisibleModeSpecified:
+		return entity.VisibleModelSpecified
+	case manage.VisibleModeDefault:
+		return entity.VisibleModelDefault
+	default:
+		return entity.VisibleModeUndefined
+	}
+}
+
+func AbilityDTO2DO(dto *manage.Ability) *entity.Ability {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Ability{
+		MaxContextTokens:  dto.MaxContextTokens,
+		MaxInputTokens:    dto.MaxInputTokens,
+		MaxOutputTokens:   dto.MaxOutputTokens,
+		FunctionCall:      dto.GetFunctionCall(),
+		JsonMode:          dto.GetJSONMode(),
+		MultiModal:        dto.GetMultiModal(),
+		AbilityMultiModal: AbilityMultiModalDTO2DO(dto.AbilityMultiModal),
+	}
+}
+
+func AbilityMultiModalDTO2DO(dto *manage.AbilityMultiModal) *entity.AbilityMultiModal {
+	if dto == nil {
+		return nil
+	}
+	return &entity.AbilityMultiModal{
+		Image:        dto.GetImage(),
+		AbilityImage: AbilityImageDTO2DO(dto.AbilityImage),
+	}
+}
+
+func AbilityImageDTO2DO(dto *manage.AbilityImage) *entity.AbilityImage {
+	if dto == nil {
+		return nil
+	}
+	return &entity.AbilityImage{
+		URLEnabled:    dto.GetURLEnabled(),
+		BinaryEnabled: dto.GetBinaryEnabled(),
+		MaxImageSize:  dto.GetMaxImageSize(),
+		MaxImageCount: dto.GetMaxImageCount(),
+	}
+}
+
+func ProtocolConfigDTO2DO(dto *manage.ProtocolConfig) *entity.ProtocolConfig {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfig{
+		BaseURL:                dto.GetBaseURL(),
+		APIKey:                 dto.GetAPIKey(),
+		Model:                  dto.GetModel(),
+		ProtocolConfigArk:      ProtocolConfigArkDTO2DO(dto.ProtocolConfigArk),
+		ProtocolConfigOpenAI:   ProtocolConfigOpenaiDTO2DO(dto.ProtocolConfigOpenai),
+		ProtocolConfigClaude:   ProtocolConfigClaudeDTO2DO(dto.ProtocolConfigClaude),
+		ProtocolConfigDeepSeek: ProtocolConfigDeepSeekDTO2DO(dto.ProtocolConfigDeepseek),
+		ProtocolConfigGemini:   ProtocolConfigGeminiDTO2DO(dto.ProtocolConfigGemini),
+		ProtocolConfigOllama:   ProtocolConfigOllamaDTO2DO(dto.ProtocolConfigOllama),
+		ProtocolConfigQwen:     ProtocolConfigQwenDTO2DO(dto.ProtocolConfigQwen),
+		ProtocolConfigQianfan:  ProtocolConfigQianfanDTO2DO(dto.ProtocolConfigQianfan),
+		ProtocolConfigArkBot:   ProtocolConfigArkbotDTO2DO(dto.ProtocolConfigArkbot),
+	}
+}
+
+func ProtocolConfigArkDTO2DO(dto *manage.ProtocolConfigArk) *entity.ProtocolConfigArk {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigArk{
+		Region:        dto.GetRegion(),
+		AccessKey:     dto.GetAccessKey(),
+		SecretKey:     dto.GetSecretKey(),
+		RetryTimes:    dto.RetryTimes,
+		CustomHeaders: dto.CustomHeaders,
+	}
+}
+
+func ProtocolConfigOpenaiDTO2DO(dto *manage.ProtocolConfigOpenAI) *entity.ProtocolConfigOpenAI {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigOpenAI{
+		ByAzure:                  dto.GetByAzure(),
+		ApiVersion:               dto.GetAPIVersion(),
+		ResponseFormatType:       dto.GetResponseFormatType(),
+		ResponseFormatJsonSchema: dto.GetResponseFormatJSONSchema(),
+	}
+}
+
+func ProtocolConfigClaudeDTO2DO(dto *manage.ProtocolConfigClaude) *entity.ProtocolConfigClaude {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigClaude{
+		ByBedrock:       dto.GetByBedrock(),
+		AccessKey:       dto.GetAccessKey(),
+		SecretAccessKey: dto.GetSecretAccessKey(),
+		SessionToken:    dto.GetSessionToken(),
+		Region:          dto.GetRegion(),
+	}
+}
+
+func ProtocolConfigDeepSeekDTO2DO(dto *manage.ProtocolConfigDeepSeek) *entity.ProtocolConfigDeepSeek {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigDeepSeek{ResponseFormatType: dto.GetResponseFormatType()}
+}
+
+func ProtocolConfigGeminiDTO2DO(dto *manage.ProtocolConfigGemini) *entity.ProtocolConfigGemini {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigGemini{
+		ResponseSchema:      dto.ResponseSchema,
+		EnableCodeExecution: dto.GetEnableCodeExecution(),
+		SafetySettings: gslice.Map(dto.SafetySettings, func(s *manage.ProtocolConfigGeminiSafetySetting) entity.ProtocolConfigGeminiSafetySetting {
+			return entity.ProtocolConfigGeminiSafetySetting{
+				Category:  s.GetCategory(),
+				Threshold: s.GetThreshold(),
+			}
+		}),
+	}
+}
+
+func ProtocolConfigOllamaDTO2DO(dto *manage.ProtocolConfigOllama) *entity.ProtocolConfigOllama {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigOllama{
+		Format:      dto.Format,
+		KeepAliveMs: dto.KeepAliveMs,
+	}
+}
+
+func ProtocolConfigQwenDTO2DO(dto *manage.ProtocolConfigQwen) *entity.ProtocolConfigQwen {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigQwen{
+		ResponseFormatType:       dto.ResponseFormatType,
+		ResponseFormatJsonSchema: dto.ResponseFormatJSONSchema,
+	}
+}
+
+func ProtocolConfigQianfanDTO2DO(dto *manage.ProtocolConfigQianfan) *entity.ProtocolConfigQianfan {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigQianfan{
+		LLMRetryCount: ptr.PtrConvert(dto.LlmRetryCount, func(f int32) int {
+			return int(f)
+		}),
+		LLMRetryTimeout: ptr.PtrConvert(dto.LlmRetryTimeout, func(f float64) float32 {
+			return float32(f)
+		}),
+		LLMRetryBackoffFactor: ptr.PtrConvert(dto.LlmRetryBackoffFactor, func(f float64) float32 {
+			return float32(f)
+		}),
+		ParallelToolCalls:        dto.ParallelToolCalls,
+		ResponseFormatType:       dto.ResponseFormatType,
+		ResponseFormatJsonSchema: dto.ResponseFormatJSONSchema,
+	}
+}
+
+func ProtocolConfigArkbotDTO2DO(dto *manage.ProtocolConfigArkbot) *entity.ProtocolConfigArkBot {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ProtocolConfigArkBot{
+		Region:        dto.GetRegion(),
+		AccessKey:     dto.GetAccessKey(),
+		SecretKey:     dto.GetSecretKey(),
+		RetryTimes:    dto.RetryTimes,
+		CustomHeaders: dto.CustomHeaders,
+	}
+}
+
+func ScenarioConfigMapDTO2DO(dto map[common.Scenario]*manage.ScenarioConfig) map[entity.Scenario]*entity.ScenarioConfig {
+	if dto == nil {
+		return nil
+	}
+	res := make(map[entity.Scenario]*entity.ScenarioConfig, len(dto))
+	for k, v := range dto {
+		res[entity.Scenario(k)] = ScenarioConfigDTO2DO(v)
+	}
+	return res
+}
+
+func ScenarioConfigDTO2DO(dto *manage.ScenarioConfig) *entity.ScenarioConfig {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ScenarioConfig{
+		Scenario:    entity.Scenario(dto.GetScenario()),
+		Quota:       QuotaDTO2DO(dto.Quota),
+		Unavailable: dto.GetUnavailable(),
+	}
+}
+
+func QuotaDTO2DO(dto *manage.Quota) *entity.Quota {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Quota{
+		Qpm: dto.GetQpm(),
+		Tpm: dto.GetTpm(),
+	}
+}
+
+func ParamConfigDTO2DO(dto *manage.ParamConfig) *entity.ParamConfig {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ParamConfig{ParamSchemas: gslice.Map(dto.ParamSchemas, ParamSchemaDTO2DO)}
+}
+
+func ParamSchemaDTO2DO(dto *manage.ParamSchema) *entity.ParamSchema {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ParamSchema{
+		Name:         dto.GetName(),
+		Label:        dto.GetLabel(),
+		Desc:         dto.GetDesc(),
+		Type:         entity.ParamType(dto.GetType()),
+		Min:          dto.GetMin(),
+		Max:          dto.GetMax(),
+		DefaultValue: dto.GetDefaultValue(),
+		Options: gslice.Map(dto.Options, func(o *manage.ParamOption) *entity.ParamOption {
+			if o == nil {
+				return nil
+			}
+			return &entity.ParamOption{Value: o.GetValue(), Label: o.GetLabel()}
+		}),
+		Properties: gslice.Map(dto.Properties, ParamSchemaDTO2DO),
+		JsonPath:   dto.GetJsonpath(),
+		Reaction:   ReactionDTO2DO(dto.Reaction),
+	}
+}
+
+func ReactionDTO2DO(dto *manage.Reaction) *entity.Reaction {
+	if dto == nil {
+		return nil
+	}
+	return &entity.Reaction{
+		Dependency: dto.GetDependency(),
+		Visible:    dto.GetVisible(),
+	}
+}
@@ -5,16 +5,23 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/application/convertor"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 )
 
+// manageModelAction 注册、修改、删除、启停模型所需的空间权限点
+const manageModelAction = "manageModel"
+
 type manageApp struct {
 	manageSrv service.IManage
 	auth      rpc.IAuthProvider
@@ -45,6 +52,7 @@ func (m *manageApp) ListModels(ctx context.Context, req *manage.ListModelsReques
 	models, total, hasMore, nextPageToken, err := m.manageSrv.ListModels(ctx, entity.ListModelReq{
 		WorkspaceID: req.WorkspaceID,
 		Scenario:    scenario,
+		Filter:      convertor.ListModelsFilterDTO2DO(req.Filter),
 		PageSize:    int64(req.GetPageSize()),
 		PageToken:   pageToken,
 	})
@@ -67,6 +75,61 @@ func (m *manageApp) GetModel(ctx context.Context, req *manage.GetModelRequest) (
 	if err != nil {
 		return r, err
 	}
-	r.SetModel(convertor.ModelDO2DTO(model, true))
+	if !model.VisibleInWorkspace(req.GetWorkspaceID()) {
+		return r, errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("model id:%d not exist in workspace", model.ID)))
+	}
+	if model.WorkspaceID == 0 {
+		r.SetModel(convertor.ModelDO2DTO(model, true))
+		return r, nil
+	}
+	// 空间自己注册的模型返回协议配置用于编辑，但不返回密钥
+	model.ProtocolConfig.ClearSecrets()
+	r.SetModel(convertor.ModelDO2DTO(model, false))
+	return r, nil
+}
+
+func (m *manageApp) CreateModel(ctx context.Context, req *manage.CreateModelRequest) (r *manage.CreateModelResponse, err error) {
+	r = manage.NewCreateModelResponse()
+	if err := m.auth.CheckSpacePermission(ctx, req.GetWorkspaceID(), manageModelAction); err != nil {
+		return r, err
+	}
+	model := convertor.ModelDTO2DO(req.GetModel())
+	model.WorkspaceID = req.GetWorkspaceID()
+	model.CreatedBy = session.UserIDInCtxOrEmpty(ctx)
+	model.UpdatedBy = model.CreatedBy
+	id, err := m.manageSrv.CreateModel(ctx, model)
+	if err != nil {
+		return r, err
+	}
+	r.SetModelID(ptr.Of(id))
 	return r, nil
 }
+
+func (m *manageApp) UpdateModel(ctx context.Context, req *manage.UpdateModelRequest) (r *manage.UpdateModelResponse, err error) {
+	r = manage.NewUpdateModelResponse()
+	if err := m.auth.CheckSpacePermission(ctx, req.GetWorkspaceID(), manageModelAction); err != nil {
+		return r, err
+	}
+	model := convertor.ModelDTO2DO(req.GetModel())
+	model.ID = req.GetModelID()
+	model.WorkspaceID = req.GetWorkspaceID()
+	model.UpdatedBy = session.UserIDInCtxOrEmpty(ctx)
+	return r, m.manageSrv.UpdateModel(ctx, model)
+}
+
+func (m *manageApp) DeleteModel(ctx context.Context, req *manage.DeleteModelRequest) (r *manage.DeleteModelResponse, err error) {
+	r = manage.NewDeleteModelResponse()
+	if err := m.auth.CheckSpacePermission(ctx, req.GetWorkspaceID(), manageModelAction); err != nil {
+		return r, err
+	}
+	return r, m.manageSrv.DeleteModel(ctx, req.GetWorkspaceID(), req.GetModelID())
+}
+
+func (m *manageApp) UpdateModelStatus(ctx context.Context, req *manage.UpdateModelStatusRequest) (r *manage.UpdateModelStatusResponse, err error) {
+	r = manage.NewUpdateModelStatusResponse()
+	if err := m.auth.CheckSpacePermission(ctx, req.GetWorkspaceID(), manageModelAction); err != nil {
+		return r, err
+	}
+	return r, m.manageSrv.UpdateModelStatus(ctx, req.GetWorkspaceID(), req.GetModelID(),
+		convertor.ModelStatusDTO2DO(req.GetStatus()), session.UserIDInCtxOrEmpty(ctx))
+}
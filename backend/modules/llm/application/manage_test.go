@@ -12,6 +12,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/domain/common"
+	manage_dto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/domain/manage"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/llm/manage"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/rpc/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
@@ -98,6 +99,29 @@ func TestManageApp_GetModel(t *testing.T) {
 		assert.Equal(t, int64(100), *res.Model.ModelID)
 	})
 
+	t.Run("workspace_model_without_secrets", func(t *testing.T) {
+		mockAuth.EXPECT().CheckSpacePermission(ctx, int64(1), "getModel").Return(nil)
+		mockSrv.EXPECT().GetModelByID(ctx, int64(100)).Return(&entity.Model{
+			ID: 100, WorkspaceID: 1, Protocol: entity.ProtocolOpenAI,
+			ProtocolConfig: &entity.ProtocolConfig{APIKey: "key", Model: "gpt-4o"},
+		}, nil)
+
+		req := &manage.GetModelRequest{WorkspaceID: gptr.Of(int64(1)), ModelID: gptr.Of(int64(100))}
+		res, err := app.GetModel(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "gpt-4o", res.Model.GetProtocolConfig().GetModel())
+		assert.Equal(t, "", res.Model.GetProtocolConfig().GetAPIKey())
+	})
+
+	t.Run("other_workspace_model", func(t *testing.T) {
+		mockAuth.EXPECT().CheckSpacePermission(ctx, int64(1), "getModel").Return(nil)
+		mockSrv.EXPECT().GetModelByID(ctx, int64(100)).Return(&entity.Model{ID: 100, WorkspaceID: 2}, nil)
+
+		req := &manage.GetModelRequest{WorkspaceID: gptr.Of(int64(1)), ModelID: gptr.Of(int64(100))}
+		_, err := app.GetModel(ctx, req)
+		assert.Error(t, err)
+	})
+
 	t.Run("srv_error", func(t *testing.T) {
 		mockAuth.EXPECT().CheckSpacePermission(ctx, int64(1), "getModel").Return(nil)
 		mockSrv.EXPECT().GetModelByID(ctx, int64(100)).Return(nil, assert.AnError)
@@ -107,3 +131,59 @@ func TestManageApp_GetModel(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestManageApp_CreateModel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSrv := serviceMocks.NewMockIManage(ctrl)
+	mockAuth := mocks.NewMockIAuthProvider(ctrl)
+	app := NewManageApplication(mockSrv, mockAuth)
+
+	ctx := context.Background()
+	req := &manage.CreateModelRequest{
+		WorkspaceID: gptr.Of(int64(1)),
+		Model: &manage_dto.Model{
+			Name:     gptr.Of("my model"),
+			Protocol: gptr.Of(manage_dto.ProtocolOpenai),
+		},
+	}
+
+	t.Run("auth_error", func(t *testing.T) {
+		mockAuth.EXPECT().CheckSpacePermission(ctx, int64(1), "manageModel").Return(assert.AnError)
+		_, err := app.CreateModel(ctx, req)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockAuth.EXPECT().CheckSpacePermission(ctx, int64(1), "manageModel").Return(nil)
+		mockSrv.EXPECT().CreateModel(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, model *entity.Model) (int64, error) {
+			assert.Equal(t, int64(1), model.WorkspaceID)
+			assert.Equal(t, "my model", model.Name)
+			assert.Equal(t, entity.ProtocolOpenAI, model.Protocol)
+			return 100, nil
+		})
+		res, err := app.CreateModel(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), res.GetModelID())
+	})
+}
+
+func TestManageApp_UpdateModelStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSrv := serviceMocks.NewMockIManage(ctrl)
+	mockAuth := mocks.NewMockIAuthProvider(ctrl)
+	app := NewManageApplication(mockSrv, mockAuth)
+
+	ctx := context.Background()
+	mockAuth.EXPECT().CheckSpacePermission(ctx, int64(1), "manageModel").Return(nil)
+	mockSrv.EXPECT().UpdateModelStatus(ctx, int64(1), int64(100), entity.ModelStatusDisabled, gomock.Any()).Return(nil)
+	_, err := app.UpdateModelStatus(ctx, &manage.UpdateModelStatusRequest{
+		WorkspaceID: gptr.Of(int64(1)),
+		ModelID:     gptr.Of(int64(100)),
+		Status:      gptr.Of(manage_dto.ModelStatusUnavailable),
+	})
+	assert.NoError(t, err)
+}
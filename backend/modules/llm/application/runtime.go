@@ -62,6 +62,9 @@ func (r *runtimeApp) Chat(ctx context.Context, req *runtime.ChatRequest) (resp *
 	if err != nil {
 		return resp, err
 	}
+	if !model.VisibleInWorkspace(req.GetBizParam().GetWorkspaceID()) {
+		return resp, errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("model id:%d not exist in workspace", model.ID)))
+	}
 	// 2. model参数校验
 	if err = model.Valid(); err != nil {
 		return resp, errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(err.Error()))
@@ -78,6 +81,8 @@ func (r *runtimeApp) Chat(ctx context.Context, req *runtime.ChatRequest) (resp *
 	}
 	options := convertor.ModelAndTools2OptionDOs(req.GetModelConfig(), req.GetTools(), nil, nil)
 	var respMsg *entity.Message
+	// 实际提供服务的模型，发生降级时与请求的模型不同
+	servedModel := model
 	// 5. start span
 	var span looptracer.Span
 	ctx, span = looptracer.GetTracer().StartSpan(ctx, model.Name, tracespec.VModelSpanType, looptracer.WithSpanWorkspaceID(strconv.FormatInt(req.GetBizParam().GetWorkspaceID(), 10)))
@@ -85,25 +90,30 @@ func (r *runtimeApp) Chat(ctx context.Context, req *runtime.ChatRequest) (resp *
 	defer func() {
 		// 上报span
 		r.setAndFinishSpan(ctx, span, setSpanParam{
-			stream:     false,
-			inputMsgs:  msgs,
-			toolInfos:  convertor.ToolsDTO2DO(req.GetTools()),
-			toolChoice: convertor.ToolChoiceDTO2DO(req.GetModelConfig().ToolChoice),
-			options:    options,
-			model:      model,
-			err:        err,
-			respMsgs:   []*entity.Message{respMsg},
+			stream:         false,
+			inputMsgs:      msgs,
+			toolInfos:      convertor.ToolsDTO2DO(req.GetTools()),
+			toolChoice:     convertor.ToolChoiceDTO2DO(req.GetModelConfig().ToolChoice),
+			options:        options,
+			model:          servedModel,
+			requestedModel: model,
+			err:            err,
+			respMsgs:       []*entity.Message{respMsg},
 		})
 		// 异步记录本次模型请求
 		r.recordModelRequest(ctx, &recordModelRequestParam{
-			bizParam: req.BizParam,
-			model:    model,
-			input:    msgs,
-			lastMsg:  respMsg,
-			err:      err,
+			bizParam:       req.BizParam,
+			model:          servedModel,
+			requestedModel: model,
+			input:          msgs,
+			lastMsg:        respMsg,
+			err:            err,
 		})
 	}()
-	respMsg, err = r.runtimeSrv.Generate(ctx, model, msgs, options...)
+	respMsg, servedModel, err = r.runtimeSrv.Generate(ctx, model, msgs, options...)
+	if servedModel == nil {
+		servedModel = model
+	}
 	if err != nil {
 		return resp, err
 	}
@@ -122,6 +132,9 @@ func (r *runtimeApp) ChatStream(ctx context.Context, req *runtime.ChatRequest, s
 	if err != nil {
 		return err
 	}
+	if !model.VisibleInWorkspace(req.GetBizParam().GetWorkspaceID()) {
+		return errorx.NewByCode(llm_errorx.ResourceNotFoundCode, errorx.WithExtraMsg(fmt.Sprintf("model id:%d not exist in workspace", model.ID)))
+	}
 	// 对model参数做校验
 	if err = model.Valid(); err != nil {
 		return errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(err.Error()))
@@ -142,6 +155,7 @@ func (r *runtimeApp) ChatStream(ctx context.Context, req *runtime.ChatRequest, s
 	ctx, span = looptracer.GetTracer().StartSpan(ctx, model.Name, tracespec.VModelSpanType, looptracer.WithSpanWorkspaceID(strconv.FormatInt(req.GetBizParam().GetWorkspaceID(), 10)))
 	// 5. 调用llm.generate or llm.stream方法, 并解析流式返回
 	var parseResult entity.StreamRespParseResult
+	servedModel := model
 	beginTime := time.Now()
 	defer func() {
 		// 上报span
@@ -152,21 +166,26 @@ func (r *runtimeApp) ChatStream(ctx context.Context, req *runtime.ChatRequest, s
 			toolChoice:        convertor.ToolChoiceDTO2DO(req.GetModelConfig().ToolChoice),
 			options:           options,
 			reasoningDuration: parseResult.ReasoningDuration,
-			model:             model,
+			model:             servedModel,
+			requestedModel:    model,
 			err:               err,
 			respMsgs:          parseResult.RespMsgs,
 			firstTokenLatency: parseResult.FirstTokenLatency,
 		})
 		// 异步记录本次模型请求
 		r.recordModelRequest(ctx, &recordModelRequestParam{
-			bizParam: req.BizParam,
-			model:    model,
-			input:    msgs,
-			lastMsg:  parseResult.LastRespMsg,
-			err:      err,
+			bizParam:       req.BizParam,
+			model:          servedModel,
+			requestedModel: model,
+			input:          msgs,
+			lastMsg:        parseResult.LastRespMsg,
+			err:            err,
 		})
 	}()
-	sr, err := r.runtimeSrv.Stream(ctx, model, msgs, options...)
+	sr, servedModel, err := r.runtimeSrv.Stream(ctx, model, msgs, options...)
+	if servedModel == nil {
+		servedModel = model
+	}
 	if err != nil {
 		return err
 	}
@@ -253,11 +272,12 @@ func (r *runtimeApp) rateLimitAllow(ctx context.Context, req *runtime.ChatReques
 }
 
 type recordModelRequestParam struct {
-	bizParam *druntime.BizParam
-	model    *entity.Model
-	input    []*entity.Message
-	lastMsg  *entity.Message
-	err      error
+	bizParam       *druntime.BizParam
+	model          *entity.Model // 实际提供服务的模型
+	requestedModel *entity.Model // 请求的模型
+	input          []*entity.Message
+	lastMsg        *entity.Message
+	err            error
 }
 
 func (r *runtimeApp) recordModelRequest(ctx context.Context, param *recordModelRequestParam) {
@@ -276,6 +296,7 @@ func (r *runtimeApp) recordModelRequest(ctx context.Context, param *recordModelR
 			InputToken:          int64(param.lastMsg.GetInputToken()),
 			OutputToken:         int64(param.lastMsg.GetOutputToken()),
 			Logid:               logs.GetLogID(ctx),
+			RequestedModelID:    strconv.FormatInt(param.requestedModel.ID, 10),
 		}
 		if param.err != nil {
 			record.ErrorCode = strconv.FormatInt(int64(traceutil.GetTraceStatusCode(param.err)), 10)
@@ -295,6 +316,8 @@ type setSpanParam struct {
 	options    []entity.Option
 	model      *entity.Model
 	bizParam   *druntime.BizParam
+	// requestedModel 请求的模型，发生降级时与 model 不同
+	requestedModel *entity.Model
 
 	firstTokenLatency time.Duration
 	reasoningDuration time.Duration
@@ -318,6 +341,9 @@ func (r *runtimeApp) setAndFinishSpan(ctx context.Context, span looptracer.Span,
 	tags[consts.SpanTagModelID] = param.model.ID
 	tags[tracespec.ModelIdentification] = param.model.GetModel()
 	tags[tracespec.ModelName] = param.model.Name
+	if param.requestedModel != nil && param.requestedModel.ID != param.model.ID {
+		tags[consts.SpanTagRequestedModelID] = param.requestedModel.ID
+	}
 	if param.bizParam.GetScenario() == common.ScenarioPromptDebug {
 		tags[tracespec.PromptKey] = param.bizParam.GetScenarioEntityID()
 		tags[tracespec.PromptVersion] = param.bizParam.GetScenarioEntityVersion()
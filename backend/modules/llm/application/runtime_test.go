@@ -163,7 +163,7 @@ func Test_runtimeApp_Chat(t *testing.T) {
 					LimitKey:  "",
 				}, nil).AnyTimes()
 				mockRuntime.EXPECT().HandleMsgsPreCallModel(gomock.Any(), gomock.Any(), gomock.Any()).Return(convertor.MessagesDTO2DO(req.GetMessages()), nil)
-				mockRuntime.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(convertor.MessagesDTO2DO(req.GetMessages())[0], nil)
+				mockRuntime.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(convertor.MessagesDTO2DO(req.GetMessages())[0], nil, nil)
 				mockRuntime.EXPECT().CreateModelRequestRecord(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				return fields{
 					manageSrv:   mockManage,
@@ -391,7 +391,7 @@ func Test_runtimeApp_ChatStream(t *testing.T) {
 		mockManage.EXPECT().GetModelByID(gomock.Any(), gomock.Any()).Return(model, nil)
 		mockLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{Allowed: true}, nil).AnyTimes()
 		mockRuntime.EXPECT().HandleMsgsPreCallModel(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-		mockRuntime.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockStream, nil)
+		mockRuntime.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockStream, nil, nil)
 		mockStream.EXPECT().Recv().Return(&entity.Message{Content: "h"}, nil)
 		mockStream.EXPECT().Recv().Return(nil, io.EOF)
 		mockRuntime.EXPECT().CreateModelRequestRecord(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -426,7 +426,7 @@ func Test_runtimeApp_ChatStream(t *testing.T) {
 		mockManage.EXPECT().GetModelByID(gomock.Any(), gomock.Any()).Return(model, nil)
 		mockLimiter.EXPECT().AllowN(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&limiter.Result{Allowed: true}, nil).AnyTimes()
 		mockRuntime.EXPECT().HandleMsgsPreCallModel(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-		mockRuntime.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("err"))
+		mockRuntime.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("err"))
 		mockRuntime.EXPECT().CreateModelRequestRecord(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		err := r.ChatStream(context.Background(), req, &mockChatStreamServer{})
 		assert.Error(t, err)
@@ -12,6 +12,7 @@ import (
 	"github.com/google/wire"
 
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
 	"github.com/coze-dev/coze-loop/backend/infra/redis"
@@ -35,7 +36,9 @@ var (
 		service.NewRuntime,
 		service.NewManage,
 		repo.NewRuntimeRepo,
+		repo.NewManageRepo,
 		dao.NewModelRequestRecordDao,
+		dao.NewLlmModelDao,
 		rpc.NewAuthRPCProvider,
 	)
 	runtimeSet = wire.NewSet(
@@ -55,6 +58,7 @@ func InitRuntimeApplication(
 	db db.Provider,
 	redis redis.Cmdable,
 	factory limiter.IRateLimiterFactory,
+	kms dkms.IDKMS,
 ) (runtime.LLMRuntimeService, error) {
 	wire.Build(runtimeSet)
 	return nil, nil
@@ -62,7 +66,10 @@ func InitRuntimeApplication(
 
 func InitManageApplication(
 	ctx context.Context,
+	idGen idgen.IIDGenerator,
 	configFactory conf.IConfigLoaderFactory,
+	db db.Provider,
+	kms dkms.IDKMS,
 	authClient authservice.Client,
 ) (manage.LLMManageService, error) {
 	wire.Build(manageSet)
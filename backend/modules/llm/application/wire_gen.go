@@ -9,6 +9,7 @@ package application
 import (
 	"context"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
 	"github.com/coze-dev/coze-loop/backend/infra/redis"
@@ -27,12 +28,14 @@ import (
 
 // Injectors from wire.go:
 
-func InitRuntimeApplication(ctx context.Context, idGen idgen.IIDGenerator, configFactory conf.IConfigLoaderFactory, db2 db.Provider, redis2 redis.Cmdable, factory limiter.IRateLimiterFactory) (runtime.LLMRuntimeService, error) {
-	iConfigManage, err := config.NewManage(ctx, configFactory)
+func InitRuntimeApplication(ctx context.Context, idGen idgen.IIDGenerator, configFactory conf.IConfigLoaderFactory, db2 db.Provider, redis2 redis.Cmdable, factory limiter.IRateLimiterFactory, kms dkms.IDKMS) (runtime.LLMRuntimeService, error) {
+	iLlmModelDao := dao.NewLlmModelDao(db2)
+	iManageRepo := repo.NewManageRepo(db2, iLlmModelDao, kms)
+	iConfigManage, err := config.NewManage(ctx, configFactory, iManageRepo)
 	if err != nil {
 		return nil, err
 	}
-	iManage := service.NewManage(iConfigManage)
+	iManage := service.NewManage(iConfigManage, iManageRepo, idGen)
 	iFactory := llmfactory.NewFactory()
 	iModelRequestRecordDao := dao.NewModelRequestRecordDao(db2)
 	iRuntimeRepo := repo.NewRuntimeRepo(db2, iModelRequestRecordDao)
@@ -40,17 +43,19 @@ func InitRuntimeApplication(ctx context.Context, idGen idgen.IIDGenerator, confi
 	if err != nil {
 		return nil, err
 	}
-	iRuntime := service.NewRuntime(iFactory, idGen, iRuntimeRepo, iConfigRuntime)
+	iRuntime := service.NewRuntime(iFactory, idGen, iRuntimeRepo, iConfigRuntime, iConfigManage)
 	llmRuntimeService := NewRuntimeApplication(iManage, iRuntime, redis2, factory)
 	return llmRuntimeService, nil
 }
 
-func InitManageApplication(ctx context.Context, configFactory conf.IConfigLoaderFactory, authClient authservice.Client) (manage.LLMManageService, error) {
-	iConfigManage, err := config.NewManage(ctx, configFactory)
+func InitManageApplication(ctx context.Context, idGen idgen.IIDGenerator, configFactory conf.IConfigLoaderFactory, db2 db.Provider, kms dkms.IDKMS, authClient authservice.Client) (manage.LLMManageService, error) {
+	iLlmModelDao := dao.NewLlmModelDao(db2)
+	iManageRepo := repo.NewManageRepo(db2, iLlmModelDao, kms)
+	iConfigManage, err := config.NewManage(ctx, configFactory, iManageRepo)
 	if err != nil {
 		return nil, err
 	}
-	iManage := service.NewManage(iConfigManage)
+	iManage := service.NewManage(iConfigManage, iManageRepo, idGen)
 	iAuthProvider := rpc.NewAuthRPCProvider(authClient)
 	llmManageService := NewManageApplication(iManage, iAuthProvider)
 	return llmManageService, nil
@@ -59,7 +64,7 @@ func InitManageApplication(ctx context.Context, configFactory conf.IConfigLoader
 // wire.go:
 
 var (
-	llmDomainSet = wire.NewSet(llmfactory.NewFactory, config.NewManage, config.NewRuntime, service.NewRuntime, service.NewManage, repo.NewRuntimeRepo, dao.NewModelRequestRecordDao, rpc.NewAuthRPCProvider)
+	llmDomainSet = wire.NewSet(llmfactory.NewFactory, config.NewManage, config.NewRuntime, service.NewRuntime, service.NewManage, repo.NewRuntimeRepo, repo.NewManageRepo, dao.NewModelRequestRecordDao, dao.NewLlmModelDao, rpc.NewAuthRPCProvider)
 	runtimeSet   = wire.NewSet(
 		NewRuntimeApplication,
 		llmDomainSet,
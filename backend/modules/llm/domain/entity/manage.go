@@ -5,7 +5,9 @@ package entity
 
 import (
 	"strconv"
+	"strings"
 
+	"github.com/bytedance/gg/gslice"
 	"github.com/bytedance/sonic"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/pkg/errors"
@@ -32,6 +34,8 @@ type Model struct {
 	Status           ModelStatus                  `json:"status" yaml:"status" mapstructure:"status"`                                     // 模型状态
 	OriginalModelURL string                       `json:"original_model_url" yaml:"original_model_url" mapstructure:"original_model_url"` // 模型跳转链接
 	PresetModel      bool                         `json:"preset_model" yaml:"preset_model" mapstructure:"preset_model"`                   // 是否为预置模型
+	FallbackModelIDs []int64                      `json:"fallback_model_ids" yaml:"fallback_model_ids" mapstructure:"fallback_model_ids"` // 调用失败时按顺序降级的模型id
+	RetryPolicy      *RetryPolicy                 `json:"retry_policy" yaml:"retry_policy" mapstructure:"retry_policy"`                   // 调用失败时的重试策略
 
 	CreatedBy string `json:"created_by" yaml:"created_by" mapstructure:"created_by"` // 创建人
 	CreatedAt int64  `json:"created_at" yaml:"created_at" mapstructure:"created_at"` // 创建时间
@@ -40,6 +44,17 @@ type Model struct {
 }
 
 func (m *Model) Valid() error {
+	if err := m.ValidConfig(); err != nil {
+		return err
+	}
+	if m.GetStatus() == ModelStatusDisabled {
+		return errors.Errorf("model is disabled")
+	}
+	return nil
+}
+
+// ValidConfig 只校验模型配置，不校验模型状态
+func (m *Model) ValidConfig() error {
 	if m == nil {
 		return errors.Errorf("model is nil")
 	}
@@ -55,6 +70,14 @@ func (m *Model) Valid() error {
 	if err := m.ProtocolConfig.ValidProtocolConfig(m.Protocol); err != nil {
 		return err
 	}
+	if err := m.RetryPolicy.ValidRetryPolicy(); err != nil {
+		return err
+	}
+	for _, id := range m.FallbackModelIDs {
+		if id == m.ID {
+			return errors.Errorf("fallback model ids contain the model itself")
+		}
+	}
 	return nil
 }
 
@@ -85,6 +108,19 @@ func (p *ProtocolConfig) ValidProtocolConfig(protocol Protocol) error {
 	return nil
 }
 
+// GetStatus 未设置状态的模型视为可用
+func (m *Model) GetStatus() ModelStatus {
+	if m.Status == "" || m.Status == ModelStatusUndefined {
+		return ModelStatusEnabled
+	}
+	return m.Status
+}
+
+// VisibleInWorkspace workspace_id为0的模型对所有空间可见
+func (m *Model) VisibleInWorkspace(workspaceID int64) bool {
+	return m.WorkspaceID == 0 || m.WorkspaceID == workspaceID
+}
+
 func (m *Model) GetModel() string {
 	if m == nil || m.ProtocolConfig == nil {
 		return ""
@@ -206,6 +242,52 @@ type ProtocolConfig struct {
 	ProtocolConfigArkBot   *ProtocolConfigArkBot   `json:"protocol_config_ark_bot" yaml:"protocol_config_ark_bot" mapstructure:"protocol_config_ark_bot"`
 }
 
+// SecretFields 返回所有密钥字段的指针，顺序固定，未配置的子协议对应位置为nil
+func (p *ProtocolConfig) SecretFields() []*string {
+	if p == nil {
+		return nil
+	}
+	fields := []*string{&p.APIKey}
+	if p.ProtocolConfigArk != nil {
+		fields = append(fields, &p.ProtocolConfigArk.AccessKey, &p.ProtocolConfigArk.SecretKey)
+	} else {
+		fields = append(fields, nil, nil)
+	}
+	if p.ProtocolConfigArkBot != nil {
+		fields = append(fields, &p.ProtocolConfigArkBot.AccessKey, &p.ProtocolConfigArkBot.SecretKey)
+	} else {
+		fields = append(fields, nil, nil)
+	}
+	if p.ProtocolConfigClaude != nil {
+		fields = append(fields, &p.ProtocolConfigClaude.AccessKey, &p.ProtocolConfigClaude.SecretAccessKey, &p.ProtocolConfigClaude.SessionToken)
+	} else {
+		fields = append(fields, nil, nil, nil)
+	}
+	return fields
+}
+
+// InheritSecrets 未填写的密钥沿用old中的值
+func (p *ProtocolConfig) InheritSecrets(old *ProtocolConfig) {
+	newFields, oldFields := p.SecretFields(), old.SecretFields()
+	if len(newFields) != len(oldFields) {
+		return
+	}
+	for i := range newFields {
+		if newFields[i] != nil && *newFields[i] == "" && oldFields[i] != nil {
+			*newFields[i] = *oldFields[i]
+		}
+	}
+}
+
+// ClearSecrets 清空所有密钥，用于向前端返回配置
+func (p *ProtocolConfig) ClearSecrets() {
+	for _, f := range p.SecretFields() {
+		if f != nil {
+			*f = ""
+		}
+	}
+}
+
 type ProtocolConfigArk struct {
 	Region        string            `json:"region" yaml:"region" mapstructure:"region"`
 	AccessKey     string            `json:"access_key" yaml:"access_key" mapstructure:"access_key"`
@@ -428,6 +510,7 @@ type Series struct {
 type ListModelReq struct {
 	WorkspaceID *int64
 	Scenario    *Scenario
+	Filter      *ListModelsFilter
 	PageToken   int64
 	PageSize    int64
 }
@@ -466,6 +549,35 @@ type ListModelsFilter struct {
 	Abilities     []AbilityEnum `json:"abilities,omitempty"`
 }
 
+// Match 未指定状态时只返回可用的模型
+func (f *ListModelsFilter) Match(m *Model) bool {
+	if f == nil || len(f.ModelStatuses) == 0 {
+		if m.GetStatus() == ModelStatusDisabled {
+			return false
+		}
+	} else if !gslice.Contains(f.ModelStatuses, m.GetStatus()) {
+		return false
+	}
+	if f == nil {
+		return true
+	}
+	if f.NameLike != nil && !strings.Contains(strings.ToLower(m.Name), strings.ToLower(*f.NameLike)) {
+		return false
+	}
+	if len(f.Families) > 0 && (m.Series == nil || !gslice.Contains(f.Families, m.Series.Family)) {
+		return false
+	}
+	if len(f.Abilities) > 0 {
+		abilities := m.Ability.GetAbilityEnums()
+		for _, a := range f.Abilities {
+			if !gslice.Contains(abilities, a) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 type AbilityEnum string
 
 const (
@@ -522,3 +522,54 @@ func TestAbility_GetAbilityEnums(t *testing.T) {
 		})
 	}
 }
+
+func TestListModelsFilter_Match(t *testing.T) {
+	model := &Model{
+		Name:    "Doubao-Pro",
+		Series:  &Series{Family: FamilyDoubao},
+		Ability: &Ability{FunctionCall: true, JsonMode: true},
+	}
+	disabled := &Model{Name: "Doubao-Lite", Status: ModelStatusDisabled}
+	tests := []struct {
+		name   string
+		filter *ListModelsFilter
+		model  *Model
+		want   bool
+	}{
+		{name: "nil filter", filter: nil, model: model, want: true},
+		{name: "nil filter excludes disabled", filter: nil, model: disabled, want: false},
+		{name: "status matched", filter: &ListModelsFilter{ModelStatuses: []ModelStatus{ModelStatusDisabled}}, model: disabled, want: true},
+		{name: "status not matched", filter: &ListModelsFilter{ModelStatuses: []ModelStatus{ModelStatusDisabled}}, model: model, want: false},
+		{name: "name like ignore case", filter: &ListModelsFilter{NameLike: ptr.Of("pro")}, model: model, want: true},
+		{name: "name not like", filter: &ListModelsFilter{NameLike: ptr.Of("lite")}, model: model, want: false},
+		{name: "family matched", filter: &ListModelsFilter{Families: []Family{FamilyDoubao, FamilyKimi}}, model: model, want: true},
+		{name: "family not matched", filter: &ListModelsFilter{Families: []Family{FamilyKimi}}, model: model, want: false},
+		{name: "all abilities matched", filter: &ListModelsFilter{Abilities: []AbilityEnum{AbilityEnumFunctionCall, AbilityEnumJsonMode}}, model: model, want: true},
+		{name: "ability missing", filter: &ListModelsFilter{Abilities: []AbilityEnum{AbilityEnumFunctionCall, AbilityEnumThinking}}, model: model, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Match(tt.model))
+		})
+	}
+}
+
+func TestProtocolConfig_InheritSecrets(t *testing.T) {
+	old := &ProtocolConfig{
+		APIKey:            "old-key",
+		ProtocolConfigArk: &ProtocolConfigArk{AccessKey: "old-ak", SecretKey: "old-sk"},
+	}
+	p := &ProtocolConfig{
+		APIKey:            "",
+		ProtocolConfigArk: &ProtocolConfigArk{AccessKey: "new-ak"},
+	}
+	p.InheritSecrets(old)
+	assert.Equal(t, "old-key", p.APIKey)
+	assert.Equal(t, "new-ak", p.ProtocolConfigArk.AccessKey)
+	assert.Equal(t, "old-sk", p.ProtocolConfigArk.SecretKey)
+
+	p.ClearSecrets()
+	assert.Equal(t, "", p.APIKey)
+	assert.Equal(t, "", p.ProtocolConfigArk.AccessKey)
+	assert.Equal(t, "", p.ProtocolConfigArk.SecretKey)
+}
@@ -17,6 +17,7 @@ type ModelRequestRecord struct {
 	ModelAk             string    `json:"model_ak"`
 	ModelID             string    `json:"model_id"`
 	ModelName           string    `json:"model_name"`
+	RequestedModelID    string    `json:"requested_model_id"` // 请求的模型id，发生降级时与 ModelID 不同
 	InputToken          int64     `json:"input_token"`
 	OutputToken         int64     `json:"output_token"`
 	Logid               string    `json:"logid"`
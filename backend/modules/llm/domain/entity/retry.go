@@ -0,0 +1,198 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+// ModelErrorClass 模型调用错误的分类，用于判断是否可重试或降级
+type ModelErrorClass string
+
+const (
+	ModelErrorClassRateLimit   ModelErrorClass = "rate_limit"   // 429、限流
+	ModelErrorClassServerError ModelErrorClass = "server_error" // 5xx
+	ModelErrorClassTimeout     ModelErrorClass = "timeout"      // 请求超时
+	ModelErrorClassNetwork     ModelErrorClass = "network"      // 连接失败等网络错误
+	ModelErrorClassOther       ModelErrorClass = "other"        // 其他错误，如请求不合法，不可重试
+)
+
+var defaultRetryableErrorClasses = []ModelErrorClass{
+	ModelErrorClassRateLimit,
+	ModelErrorClassServerError,
+	ModelErrorClassTimeout,
+	ModelErrorClassNetwork,
+}
+
+type RetryPolicy struct {
+	MaxAttempts       int               `json:"max_attempts" yaml:"max_attempts" mapstructure:"max_attempts"`                      // 单个模型的最大请求次数（含首次），默认为1即不重试
+	InitialIntervalMs int64             `json:"initial_interval_ms" yaml:"initial_interval_ms" mapstructure:"initial_interval_ms"` // 首次重试的退避间隔
+	MaxIntervalMs     int64             `json:"max_interval_ms" yaml:"max_interval_ms" mapstructure:"max_interval_ms"`             // 最大退避间隔
+	RetryableErrors   []ModelErrorClass `json:"retryable_errors" yaml:"retryable_errors" mapstructure:"retryable_errors"`          // 可重试、可降级的错误类型，为空时使用默认值
+}
+
+func (p *RetryPolicy) ValidRetryPolicy() error {
+	if p == nil {
+		return nil
+	}
+	if p.MaxAttempts < 0 || p.InitialIntervalMs < 0 || p.MaxIntervalMs < 0 {
+		return pkgerrors.Errorf("retry policy values must not be negative")
+	}
+	for _, class := range p.RetryableErrors {
+		switch class {
+		case ModelErrorClassRateLimit, ModelErrorClassServerError, ModelErrorClassTimeout, ModelErrorClassNetwork:
+		default:
+			return pkgerrors.Errorf("retryable error class %s is not supported", class)
+		}
+	}
+	return nil
+}
+
+func (p *RetryPolicy) GetMaxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) GetInitialInterval() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return time.Duration(p.InitialIntervalMs) * time.Millisecond
+}
+
+func (p *RetryPolicy) GetMaxInterval() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return time.Duration(p.MaxIntervalMs) * time.Millisecond
+}
+
+// IsRetryable 判断该类错误是否可重试，也作为是否降级到下一个模型的依据
+func (p *RetryPolicy) IsRetryable(class ModelErrorClass) bool {
+	classes := defaultRetryableErrorClasses
+	if p != nil && len(p.RetryableErrors) > 0 {
+		classes = p.RetryableErrors
+	}
+	for _, c := range classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// ModelCallError 模型服务返回的错误，由模型框架适配层从各模型 SDK 的错误类型中提取
+type ModelCallError struct {
+	StatusCode   int    // HTTP 状态码，SDK 未返回时由模型服务的业务错误码换算，无法换算时为 0
+	ProviderCode string // 模型服务返回的业务错误码或错误类型
+	Err          error
+}
+
+func (e *ModelCallError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ModelCallError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	httpStatusCodeRegexp = regexp.MustCompile(`(?:status code|status|error code|code)["']?\s*[:=]?\s*(429|5\d\d)\b`)
+
+	rateLimitKeywords   = []string{"too many requests", "rate limit", "ratelimit", "rate_limit", "quota exceeded"}
+	serverErrorKeywords = []string{"internal server error", "bad gateway", "service unavailable", "gateway timeout", "overloaded"}
+	timeoutKeywords     = []string{"timeout", "timed out", "deadline exceeded"}
+	networkKeywords     = []string{"connection reset", "connection refused", "broken pipe", "no such host", "unexpected eof", "network is unreachable"}
+)
+
+// ClassifyModelError 对模型调用错误分类。优先根据模型服务返回的状态码判断，其次是超时、网络等错误类型，
+// 无法从错误类型中获取信息时才根据错误信息判断
+func ClassifyModelError(err error) ModelErrorClass {
+	if err == nil {
+		return ""
+	}
+	var callErr *ModelCallError
+	if errors.As(err, &callErr) {
+		if class := classifyStatusCode(callErr.StatusCode); class != "" {
+			return class
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ModelErrorClassTimeout
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return ModelErrorClassTimeout
+		}
+		return ModelErrorClassNetwork
+	}
+	if statusErr, ok := errorx.FromStatusError(err); ok {
+		switch statusErr.Code() {
+		case llm_errorx.CallModelTimeoutCode:
+			return ModelErrorClassTimeout
+		case llm_errorx.CallModelFailedCode:
+		default:
+			// 参数校验、构建模型等错误与模型服务状态无关
+			return ModelErrorClassOther
+		}
+	}
+
+	msg := strings.ToLower(errorx.ErrorWithoutStack(err))
+	if m := httpStatusCodeRegexp.FindStringSubmatch(msg); len(m) == 2 {
+		if m[1] == "429" {
+			return ModelErrorClassRateLimit
+		}
+		return ModelErrorClassServerError
+	}
+	switch {
+	case containsAny(msg, rateLimitKeywords):
+		return ModelErrorClassRateLimit
+	case containsAny(msg, serverErrorKeywords):
+		return ModelErrorClassServerError
+	case containsAny(msg, timeoutKeywords):
+		return ModelErrorClassTimeout
+	case containsAny(msg, networkKeywords):
+		return ModelErrorClassNetwork
+	default:
+		return ModelErrorClassOther
+	}
+}
+
+func classifyStatusCode(code int) ModelErrorClass {
+	switch {
+	case code == http.StatusTooManyRequests:
+		return ModelErrorClassRateLimit
+	case code == http.StatusRequestTimeout:
+		return ModelErrorClassTimeout
+	case code >= http.StatusInternalServerError:
+		return ModelErrorClassServerError
+	case code >= http.StatusBadRequest:
+		return ModelErrorClassOther
+	default:
+		return ""
+	}
+}
+
+func containsAny(s string, subs []string) bool {
+	for _, sub := range subs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
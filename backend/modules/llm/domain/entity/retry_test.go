@@ -0,0 +1,69 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func TestClassifyModelError(t *testing.T) {
+	callFailed := func(msg string) error {
+		return errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg(msg))
+	}
+	callFailedWith := func(err error) error {
+		return errorx.WrapByCode(err, llm_errorx.CallModelFailedCode, errorx.WithExtraMsg(err.Error()))
+	}
+	tests := []struct {
+		name string
+		err  error
+		want ModelErrorClass
+	}{
+		{name: "nil", err: nil, want: ""},
+		{name: "context deadline", err: errors.Wrap(context.DeadlineExceeded, "call model"), want: ModelErrorClassTimeout},
+		{name: "timeout code", err: errorx.NewByCode(llm_errorx.CallModelTimeoutCode), want: ModelErrorClassTimeout},
+		{name: "openai 429", err: callFailed("error, status code: 429, status: 429 Too Many Requests, message: rate limited"), want: ModelErrorClassRateLimit},
+		{name: "ark 503", err: callFailed("Error code: 503 - service is busy"), want: ModelErrorClassServerError},
+		{name: "claude overloaded", err: callFailed(`POST "https://api.anthropic.com/v1/messages": 529 {"type":"overloaded_error"}`), want: ModelErrorClassServerError},
+		{name: "gateway timeout", err: callFailed("502 Bad Gateway"), want: ModelErrorClassServerError},
+		{name: "read timeout", err: callFailed("read tcp: i/o timeout"), want: ModelErrorClassTimeout},
+		{name: "connection reset", err: callFailed("read: connection reset by peer"), want: ModelErrorClassNetwork},
+		{name: "bad request", err: callFailed("error, status code: 400, message: invalid messages"), want: ModelErrorClassOther},
+		{name: "request not valid", err: errorx.NewByCode(llm_errorx.RequestNotValidCode, errorx.WithExtraMsg("status code: 500")), want: ModelErrorClassOther},
+		{name: "status 429", err: callFailedWith(&ModelCallError{StatusCode: 429, Err: errors.New("quota")}), want: ModelErrorClassRateLimit},
+		{name: "status 529", err: callFailedWith(&ModelCallError{StatusCode: 529, Err: errors.New("overloaded")}), want: ModelErrorClassServerError},
+		{name: "status 408", err: callFailedWith(&ModelCallError{StatusCode: 408, Err: errors.New("request timeout")}), want: ModelErrorClassTimeout},
+		// 状态码优先于错误信息
+		{name: "status 400 with misleading message", err: callFailedWith(&ModelCallError{StatusCode: 400, Err: errors.New("prompt mentions rate limit and timeout")}), want: ModelErrorClassOther},
+		{name: "unknown status falls back to message", err: callFailedWith(&ModelCallError{Err: errors.New("connection reset by peer")}), want: ModelErrorClassNetwork},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyModelError(tt.err))
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var nilPolicy *RetryPolicy
+	assert.Equal(t, 1, nilPolicy.GetMaxAttempts())
+	assert.True(t, nilPolicy.IsRetryable(ModelErrorClassRateLimit))
+	assert.False(t, nilPolicy.IsRetryable(ModelErrorClassOther))
+	assert.NoError(t, nilPolicy.ValidRetryPolicy())
+
+	p := &RetryPolicy{MaxAttempts: 3, RetryableErrors: []ModelErrorClass{ModelErrorClassRateLimit}}
+	assert.Equal(t, 3, p.GetMaxAttempts())
+	assert.True(t, p.IsRetryable(ModelErrorClassRateLimit))
+	assert.False(t, p.IsRetryable(ModelErrorClassServerError))
+	assert.NoError(t, p.ValidRetryPolicy())
+
+	assert.Error(t, (&RetryPolicy{MaxAttempts: -1}).ValidRetryPolicy())
+	assert.Error(t, (&RetryPolicy{RetryableErrors: []ModelErrorClass{ModelErrorClassOther}}).ValidRetryPolicy())
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/manage.go -package=mocks . IManageRepo
+type IManageRepo interface {
+	CreateModel(ctx context.Context, model *entity.Model) (err error)
+	// SeedModels 写入不存在的模型，已存在的模型不会被覆盖
+	SeedModels(ctx context.Context, models []*entity.Model) (err error)
+	UpdateModel(ctx context.Context, model *entity.Model) (err error)
+	DeleteModel(ctx context.Context, id int64) (err error)
+	// GetModel 模型不存在时返回 gorm.ErrRecordNotFound
+	GetModel(ctx context.Context, id int64) (model *entity.Model, err error)
+	// ListModels 返回对该空间可见的全部模型，workspaceID为空时只返回公共模型
+	ListModels(ctx context.Context, workspaceID *int64) (models []*entity.Model, err error)
+}
@@ -15,17 +15,21 @@ func NewRuntime(
 	idGen idgen.IIDGenerator,
 	runtimeRepo repo.IRuntimeRepo,
 	cfg conf.IConfigRuntime,
+	manageCfg conf.IConfigManage,
 ) IRuntime {
 	return &RuntimeImpl{
 		llmFact:     llmFact,
 		idGen:       idGen,
 		runtimeRepo: runtimeRepo,
 		runtimeCfg:  cfg,
+		manageCfg:   manageCfg,
 	}
 }
 
-func NewManage(cfg conf.IConfigManage) IManage {
+func NewManage(cfg conf.IConfigManage, manageRepo repo.IManageRepo, idGen idgen.IIDGenerator) IManage {
 	return &ManageImpl{
-		conf: cfg,
+		conf:  cfg,
+		repo:  manageRepo,
+		idGen: idGen,
 	}
 }
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eino
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/baidubce/bce-qianfan-sdk/go/qianfan"
+	"github.com/cohesion-org/deepseek-go"
+	"github.com/meguminnnnnnnnn/go-openai"
+	ollamaapi "github.com/ollama/ollama/api"
+	arkmodel "github.com/volcengine/volcengine-go-sdk/service/arkruntime/model"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+)
+
+var (
+	// openai 流式返回的错误没有 HTTP 状态码，按错误码换算
+	openAIErrorCodeStatus = map[string]int{
+		"rate_limit_exceeded": http.StatusTooManyRequests,
+		"insufficient_quota":  http.StatusTooManyRequests,
+		"server_error":        http.StatusInternalServerError,
+	}
+	// 方舟错误码形如 RateLimitExceeded.EndpointRPMExceeded，按首段换算
+	arkErrorCodeStatus = map[string]int{
+		"RateLimitExceeded":    http.StatusTooManyRequests,
+		"QuotaExceeded":        http.StatusTooManyRequests,
+		"ServerOverloaded":     http.StatusServiceUnavailable,
+		"InternalServiceError": http.StatusInternalServerError,
+	}
+	// 千帆接口不返回 HTTP 状态码，按错误码换算
+	qianfanErrorCodeStatus = map[int]int{
+		qianfan.RequestLimitReachedErrCode:      http.StatusTooManyRequests,
+		qianfan.DailyLimitReachedErrCode:        http.StatusTooManyRequests,
+		qianfan.QPSLimitReachedErrCode:          http.StatusTooManyRequests,
+		qianfan.TotalRequestLimitReachedErrCode: http.StatusTooManyRequests,
+		qianfan.RPMLimitReachedErrCode:          http.StatusTooManyRequests,
+		qianfan.TPMLimitReachedErrCode:          http.StatusTooManyRequests,
+		qianfan.ServiceUnavailableErrCode:       http.StatusServiceUnavailable,
+		qianfan.InternalErrorErrCode:            http.StatusInternalServerError,
+		qianfan.ServerHighLoadErrCode:           http.StatusServiceUnavailable,
+		qianfan.ConsoleInternalErrorErrCode:     http.StatusInternalServerError,
+	}
+	grpcCodeStatus = map[codes.Code]int{
+		codes.ResourceExhausted: http.StatusTooManyRequests,
+		codes.DeadlineExceeded:  http.StatusGatewayTimeout,
+		codes.Unavailable:       http.StatusServiceUnavailable,
+		codes.Internal:          http.StatusInternalServerError,
+		codes.InvalidArgument:   http.StatusBadRequest,
+		codes.PermissionDenied:  http.StatusForbidden,
+		codes.Unauthenticated:   http.StatusUnauthorized,
+		codes.NotFound:          http.StatusNotFound,
+	}
+)
+
+// toModelCallError 从各模型 SDK 的错误类型中提取状态码与业务错误码，供重试和降级时判断错误类型。无法识别的错误原样返回
+func toModelCallError(err error) error {
+	if err == nil {
+		return nil
+	}
+	statusCode, providerCode, ok := extractProviderStatus(err)
+	if !ok {
+		return err
+	}
+	return &entity.ModelCallError{StatusCode: statusCode, ProviderCode: providerCode, Err: err}
+}
+
+func extractProviderStatus(err error) (statusCode int, providerCode string, ok bool) {
+	var (
+		openAIAPIErr     *openai.APIError
+		openAIRequestErr *openai.RequestError
+		arkAPIErr        *arkmodel.APIError
+		arkRequestErr    *arkmodel.RequestError
+		anthropicErr     *anthropic.Error
+		ollamaErr        ollamaapi.StatusError
+		qianfanErr       *qianfan.APIError
+		deepseekErr      *deepseek.APIError
+		googleErr        *googleapi.Error
+		httpCodeErr      interface{ HTTPCode() int }
+	)
+	switch {
+	case errors.As(err, &openAIAPIErr):
+		code := openAIAPIErr.Type
+		if openAIAPIErr.Code != nil {
+			code = fmt.Sprint(openAIAPIErr.Code)
+		}
+		return orMappedStatus(openAIAPIErr.HTTPStatusCode, openAIErrorCodeStatus[code]), code, true
+	case errors.As(err, &openAIRequestErr):
+		return openAIRequestErr.HTTPStatusCode, "", true
+	case errors.As(err, &arkAPIErr):
+		prefix, _, _ := strings.Cut(arkAPIErr.Code, ".")
+		return orMappedStatus(arkAPIErr.HTTPStatusCode, arkErrorCodeStatus[prefix]), arkAPIErr.Code, true
+	case errors.As(err, &arkRequestErr):
+		return arkRequestErr.HTTPStatusCode, "", true
+	case errors.As(err, &anthropicErr):
+		return anthropicErr.StatusCode, "", true
+	case errors.As(err, &ollamaErr):
+		return ollamaErr.StatusCode, "", true
+	case errors.As(err, &qianfanErr):
+		return qianfanErrorCodeStatus[qianfanErr.Code], strconv.Itoa(qianfanErr.Code), true
+	case errors.As(err, &deepseekErr):
+		return deepseekErr.StatusCode, strconv.Itoa(deepseekErr.APICode), true
+	case errors.As(err, &googleErr):
+		return googleErr.Code, "", true
+	case errors.As(err, &httpCodeErr) && httpCodeErr.HTTPCode() > 0:
+		return httpCodeErr.HTTPCode(), "", true
+	}
+	if s, isStatus := status.FromError(err); isStatus && s.Code() != codes.OK && s.Code() != codes.Unknown {
+		return grpcCodeStatus[s.Code()], s.Code().String(), true
+	}
+	return 0, "", false
+}
+
+func orMappedStatus(statusCode, mapped int) int {
+	if statusCode > 0 {
+		return statusCode
+	}
+	return mapped
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package eino
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/baidubce/bce-qianfan-sdk/go/qianfan"
+	"github.com/cohesion-org/deepseek-go"
+	"github.com/meguminnnnnnnnn/go-openai"
+	ollamaapi "github.com/ollama/ollama/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	arkmodel "github.com/volcengine/volcengine-go-sdk/service/arkruntime/model"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/llmimpl/eino/mocks"
+	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+func TestLLM_Generate_ClassifyProviderError(t *testing.T) {
+	anthropicReq, err := http.NewRequest(http.MethodPost, "https://api.anthropic.com/v1/messages", nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		err              error
+		wantStatusCode   int
+		wantProviderCode string
+		want             entity.ModelErrorClass
+	}{
+		{
+			name: "openai 429",
+			err: fmt.Errorf("failed to create chat completion: %w", &openai.APIError{
+				Code: "rate_limit_exceeded", Message: "Rate limit reached for gpt-4o", Type: "requests",
+				HTTPStatus: "429 Too Many Requests", HTTPStatusCode: http.StatusTooManyRequests,
+			}),
+			wantStatusCode:   http.StatusTooManyRequests,
+			wantProviderCode: "rate_limit_exceeded",
+			want:             entity.ModelErrorClassRateLimit,
+		},
+		{
+			name: "openai 400 mentioning timeout",
+			err: fmt.Errorf("failed to create chat completion: %w", &openai.APIError{
+				Code: "invalid_request_error", Message: "'timeout' is not a valid parameter", Type: "invalid_request_error",
+				HTTPStatus: "400 Bad Request", HTTPStatusCode: http.StatusBadRequest,
+			}),
+			wantStatusCode:   http.StatusBadRequest,
+			wantProviderCode: "invalid_request_error",
+			want:             entity.ModelErrorClassOther,
+		},
+		{
+			name:             "openai stream error without status",
+			err:              &openai.APIError{Code: "server_error", Message: "The server had an error", Type: "server_error"},
+			wantStatusCode:   http.StatusInternalServerError,
+			wantProviderCode: "server_error",
+			want:             entity.ModelErrorClassServerError,
+		},
+		{
+			name:           "openai request error",
+			err:            &openai.RequestError{HTTPStatus: "502 Bad Gateway", HTTPStatusCode: http.StatusBadGateway, Err: errors.New("upstream error")},
+			wantStatusCode: http.StatusBadGateway,
+			want:           entity.ModelErrorClassServerError,
+		},
+		{
+			name: "ark rpm exceeded",
+			err: fmt.Errorf("failed to create chat completion: %w", &arkmodel.APIError{
+				Code: "RateLimitExceeded.EndpointRPMExceeded", Message: "The request rate exceeds the endpoint limit",
+				Type: "TooManyRequests", HTTPStatusCode: http.StatusTooManyRequests,
+			}),
+			wantStatusCode:   http.StatusTooManyRequests,
+			wantProviderCode: "RateLimitExceeded.EndpointRPMExceeded",
+			want:             entity.ModelErrorClassRateLimit,
+		},
+		{
+			name:             "ark stream error without status",
+			err:              &arkmodel.APIError{Code: "ServerOverloaded", Message: "The service is overloaded"},
+			wantStatusCode:   http.StatusServiceUnavailable,
+			wantProviderCode: "ServerOverloaded",
+			want:             entity.ModelErrorClassServerError,
+		},
+		{
+			name: "claude overloaded",
+			err: fmt.Errorf("create new message fail: %w", &anthropic.Error{
+				StatusCode: 529,
+				Request:    anthropicReq,
+				Response:   &http.Response{StatusCode: 529},
+			}),
+			wantStatusCode: 529,
+			want:           entity.ModelErrorClassServerError,
+		},
+		{
+			name: "ollama 500",
+			err: fmt.Errorf("error during Chat request: %w", ollamaapi.StatusError{
+				StatusCode: http.StatusInternalServerError, Status: "500 Internal Server Error", ErrorMessage: "llama runner process has terminated",
+			}),
+			wantStatusCode: http.StatusInternalServerError,
+			want:           entity.ModelErrorClassServerError,
+		},
+		{
+			name:             "qianfan rpm limit",
+			err:              fmt.Errorf("[qianfan][Generate] ChatCompletionV2 error, %w", &qianfan.APIError{Code: qianfan.RPMLimitReachedErrCode, Msg: "Rate limit reached for RPM"}),
+			wantStatusCode:   http.StatusTooManyRequests,
+			wantProviderCode: "336501",
+			want:             entity.ModelErrorClassRateLimit,
+		},
+		{
+			name:             "qianfan invalid argument",
+			err:              &qianfan.APIError{Code: qianfan.InvalidArgumentErrCode, Msg: "the length of messages must be an odd number"},
+			wantProviderCode: "336001",
+			want:             entity.ModelErrorClassOther,
+		},
+		{
+			name:             "deepseek 503",
+			err:              fmt.Errorf("failed to create chat completion: %w", &deepseek.APIError{StatusCode: http.StatusServiceUnavailable, APICode: 503, Message: "Server overloaded"}),
+			wantStatusCode:   http.StatusServiceUnavailable,
+			wantProviderCode: "503",
+			want:             entity.ModelErrorClassServerError,
+		},
+		{
+			name:           "gemini googleapi 429",
+			err:            fmt.Errorf("send message fail: %w", &googleapi.Error{Code: http.StatusTooManyRequests, Message: "Resource has been exhausted"}),
+			wantStatusCode: http.StatusTooManyRequests,
+			want:           entity.ModelErrorClassRateLimit,
+		},
+		{
+			name:             "gemini grpc resource exhausted",
+			err:              fmt.Errorf("send message fail: %w", status.Error(codes.ResourceExhausted, "Resource has been exhausted (e.g. check quota).")),
+			wantStatusCode:   http.StatusTooManyRequests,
+			wantProviderCode: "ResourceExhausted",
+			want:             entity.ModelErrorClassRateLimit,
+		},
+		{
+			name: "untyped error falls back to message",
+			err:  errors.New("read tcp 10.0.0.1:443: connection reset by peer"),
+			want: entity.ModelErrorClassNetwork,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cm := mocks.NewMockIEinoChatModel(ctrl)
+			cm.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, tt.err)
+			l := &LLM{frame: entity.FrameEino, chatModel: cm}
+
+			_, err := l.Generate(context.Background(), []*entity.Message{{Role: entity.RoleUser, Content: "hi"}})
+			require.Error(t, err)
+			statusErr, ok := errorx.FromStatusError(err)
+			require.True(t, ok)
+			assert.Equal(t, int32(llm_errorx.CallModelFailedCode), statusErr.Code())
+			assert.ErrorIs(t, err, tt.err)
+
+			var callErr *entity.ModelCallError
+			if errors.As(err, &callErr) {
+				assert.Equal(t, tt.wantStatusCode, callErr.StatusCode)
+				assert.Equal(t, tt.wantProviderCode, callErr.ProviderCode)
+			} else {
+				assert.Zero(t, tt.wantStatusCode)
+				assert.Empty(t, tt.wantProviderCode)
+			}
+			assert.Equal(t, tt.want, entity.ClassifyModelError(err))
+		})
+	}
+}
@@ -45,7 +45,7 @@ func (l *LLM) Generate(ctx context.Context, input []*entity.Message, opts ...ent
 	// 请求模型
 	einoMsg, err := l.chatModel.Generate(ctx, entity.FromDOMessages(input), einoOpts...)
 	if err != nil {
-		return nil, errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg(err.Error()))
+		return nil, errorx.WrapByCode(toModelCallError(err), llm_errorx.CallModelFailedCode, errorx.WithExtraMsg(err.Error()))
 	}
 	// 解析模型返回结果
 	return entity.ToDOMessage(einoMsg)
@@ -74,7 +74,7 @@ func (l *LLM) Stream(ctx context.Context, input []*entity.Message, opts ...entit
 	// 请求模型
 	einoSr, err := l.chatModel.Stream(ctx, entity.FromDOMessages(input), einoOpts...)
 	if err != nil {
-		return nil, errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg(err.Error()))
+		return nil, errorx.WrapByCode(toModelCallError(err), llm_errorx.CallModelFailedCode, errorx.WithExtraMsg(err.Error()))
 	}
 	// 解析模型返回结果
 	return entity.NewStreamReader(l.frame, einoSr), nil
@@ -10,8 +10,10 @@ import (
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
 	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 )
@@ -20,10 +22,17 @@ import (
 type IManage interface {
 	ListModels(ctx context.Context, req entity.ListModelReq) (models []*entity.Model, total int64, hasMore bool, nextPageToken int64, err error)
 	GetModelByID(ctx context.Context, id int64) (model *entity.Model, err error)
+	CreateModel(ctx context.Context, model *entity.Model) (id int64, err error)
+	// UpdateModel 未填写的密钥沿用原值，模型状态需通过 UpdateModelStatus 修改
+	UpdateModel(ctx context.Context, model *entity.Model) (err error)
+	DeleteModel(ctx context.Context, workspaceID, id int64) (err error)
+	UpdateModelStatus(ctx context.Context, workspaceID, id int64, status entity.ModelStatus, userID string) (err error)
 }
 
 type ManageImpl struct {
-	conf conf.IConfigManage
+	conf  conf.IConfigManage
+	repo  repo.IManageRepo
+	idGen idgen.IIDGenerator
 }
 
 var _ IManage = (*ManageImpl)(nil)
@@ -42,3 +51,85 @@ func (m *ManageImpl) GetModelByID(ctx context.Context, id int64) (model *entity.
 	}
 	return model, nil
 }
+
+func (m *ManageImpl) CreateModel(ctx context.Context, model *entity.Model) (id int64, err error) {
+	id, err = m.idGen.GenID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	model.ID = id
+	model.PresetModel = false
+	if model.Frame == "" {
+		model.Frame = entity.FrameEino
+	}
+	if model.Status != entity.ModelStatusDisabled {
+		model.Status = entity.ModelStatusEnabled
+	}
+	if err = model.ValidConfig(); err != nil {
+		return 0, errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if err = m.repo.CreateModel(ctx, model); err != nil {
+		return 0, errorx.NewByCode(llm_errorx.CommonMySqlErrorCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return id, nil
+}
+
+func (m *ManageImpl) UpdateModel(ctx context.Context, model *entity.Model) (err error) {
+	old, err := m.getWorkspaceModel(ctx, model.WorkspaceID, model.ID)
+	if err != nil {
+		return err
+	}
+	model.ProtocolConfig.InheritSecrets(old.ProtocolConfig)
+	model.Frame = old.Frame
+	model.Status = old.Status
+	model.PresetModel = old.PresetModel
+	model.CreatedBy = old.CreatedBy
+	if err = model.ValidConfig(); err != nil {
+		return errorx.NewByCode(llm_errorx.ModelInvalidCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if err = m.repo.UpdateModel(ctx, model); err != nil {
+		return errorx.NewByCode(llm_errorx.CommonMySqlErrorCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return nil
+}
+
+func (m *ManageImpl) DeleteModel(ctx context.Context, workspaceID, id int64) (err error) {
+	if _, err = m.getWorkspaceModel(ctx, workspaceID, id); err != nil {
+		return err
+	}
+	if err = m.repo.DeleteModel(ctx, id); err != nil {
+		return errorx.NewByCode(llm_errorx.CommonMySqlErrorCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return nil
+}
+
+func (m *ManageImpl) UpdateModelStatus(ctx context.Context, workspaceID, id int64, status entity.ModelStatus, userID string) (err error) {
+	if status != entity.ModelStatusEnabled && status != entity.ModelStatusDisabled {
+		return errorx.NewByCode(llm_errorx.CommonInvalidParamCode, errorx.WithExtraMsg(fmt.Sprintf("invalid model status: %s", status)))
+	}
+	model, err := m.getWorkspaceModel(ctx, workspaceID, id)
+	if err != nil {
+		return err
+	}
+	if model.GetStatus() == status {
+		return nil
+	}
+	model.Status = status
+	model.UpdatedBy = userID
+	if err = m.repo.UpdateModel(ctx, model); err != nil {
+		return errorx.NewByCode(llm_errorx.CommonMySqlErrorCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return nil
+}
+
+// getWorkspaceModel 只能修改空间自己注册的模型，公共模型只能通过 model_config.yaml 初始化
+func (m *ManageImpl) getWorkspaceModel(ctx context.Context, workspaceID, id int64) (*entity.Model, error) {
+	model, err := m.GetModelByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if model.WorkspaceID == 0 || model.WorkspaceID != workspaceID {
+		return nil, errorx.NewByCode(llm_errorx.ModelNotEditableCode, errorx.WithExtraMsg(fmt.Sprintf("model id:%d, workspace id:%d", id, workspaceID)))
+	}
+	return model, nil
+}
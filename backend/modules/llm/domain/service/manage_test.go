@@ -12,9 +12,11 @@ import (
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 
+	idgenmocks "github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf"
 	llmconfmocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo/mocks"
 	llm_errorx "github.com/coze-dev/coze-loop/backend/modules/llm/pkg/errno"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/unittest"
@@ -159,3 +161,174 @@ func TestManageImpl_ListModels(t *testing.T) {
 		})
 	}
 }
+
+func newWorkspaceModel(id, workspaceID int64) *entity.Model {
+	return &entity.Model{
+		ID:          id,
+		WorkspaceID: workspaceID,
+		Name:        "my model",
+		Frame:       entity.FrameEino,
+		Protocol:    entity.ProtocolOpenAI,
+		Status:      entity.ModelStatusEnabled,
+		CreatedBy:   "creator",
+		ProtocolConfig: &entity.ProtocolConfig{
+			APIKey: "old-key",
+			Model:  "gpt-4o",
+		},
+	}
+}
+
+func TestManageImpl_CreateModel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		idGen := idgenmocks.NewMockIIDGenerator(ctrl)
+		repoMock := repomocks.NewMockIManageRepo(ctrl)
+		idGen.EXPECT().GenID(gomock.Any()).Return(int64(100), nil)
+		repoMock.EXPECT().CreateModel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, model *entity.Model) error {
+			assert.Equal(t, int64(100), model.ID)
+			assert.Equal(t, entity.FrameEino, model.Frame)
+			assert.Equal(t, entity.ModelStatusEnabled, model.Status)
+			assert.False(t, model.PresetModel)
+			return nil
+		})
+		m := &ManageImpl{repo: repoMock, idGen: idGen}
+		model := newWorkspaceModel(0, 1)
+		model.Frame = ""
+		model.Status = ""
+		model.PresetModel = true
+		id, err := m.CreateModel(ctx, model)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), id)
+	})
+
+	t.Run("invalid model", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		idGen := idgenmocks.NewMockIIDGenerator(ctrl)
+		idGen.EXPECT().GenID(gomock.Any()).Return(int64(100), nil)
+		m := &ManageImpl{idGen: idGen}
+		model := newWorkspaceModel(0, 1)
+		model.Protocol = ""
+		_, err := m.CreateModel(ctx, model)
+		unittest.AssertErrorEqual(t, errorx.NewByCode(llm_errorx.ModelInvalidCode), err)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		idGen := idgenmocks.NewMockIIDGenerator(ctrl)
+		repoMock := repomocks.NewMockIManageRepo(ctrl)
+		idGen.EXPECT().GenID(gomock.Any()).Return(int64(100), nil)
+		repoMock.EXPECT().CreateModel(gomock.Any(), gomock.Any()).Return(errors.New("test error"))
+		m := &ManageImpl{repo: repoMock, idGen: idGen}
+		_, err := m.CreateModel(ctx, newWorkspaceModel(0, 1))
+		unittest.AssertErrorEqual(t, errorx.NewByCode(llm_errorx.CommonMySqlErrorCode), err)
+	})
+}
+
+func TestManageImpl_UpdateModel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success inherit secrets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		repoMock := repomocks.NewMockIManageRepo(ctrl)
+		confMock.EXPECT().GetModel(gomock.Any(), int64(10)).Return(newWorkspaceModel(10, 1), nil)
+		repoMock.EXPECT().UpdateModel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, model *entity.Model) error {
+			assert.Equal(t, "old-key", model.ProtocolConfig.APIKey)
+			assert.Equal(t, "gpt-4o-mini", model.ProtocolConfig.Model)
+			assert.Equal(t, "creator", model.CreatedBy)
+			assert.Equal(t, entity.ModelStatusEnabled, model.Status)
+			return nil
+		})
+		m := &ManageImpl{conf: confMock, repo: repoMock}
+		model := newWorkspaceModel(10, 1)
+		model.CreatedBy = ""
+		model.Status = ""
+		model.ProtocolConfig = &entity.ProtocolConfig{Model: "gpt-4o-mini"}
+		assert.NoError(t, m.UpdateModel(ctx, model))
+	})
+
+	t.Run("preset model not editable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		confMock.EXPECT().GetModel(gomock.Any(), int64(10)).Return(newWorkspaceModel(10, 0), nil)
+		m := &ManageImpl{conf: confMock}
+		err := m.UpdateModel(ctx, newWorkspaceModel(10, 1))
+		unittest.AssertErrorEqual(t, errorx.NewByCode(llm_errorx.ModelNotEditableCode), err)
+	})
+
+	t.Run("other workspace not editable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		confMock.EXPECT().GetModel(gomock.Any(), int64(10)).Return(newWorkspaceModel(10, 2), nil)
+		m := &ManageImpl{conf: confMock}
+		err := m.UpdateModel(ctx, newWorkspaceModel(10, 1))
+		unittest.AssertErrorEqual(t, errorx.NewByCode(llm_errorx.ModelNotEditableCode), err)
+	})
+}
+
+func TestManageImpl_DeleteModel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		repoMock := repomocks.NewMockIManageRepo(ctrl)
+		confMock.EXPECT().GetModel(gomock.Any(), int64(10)).Return(newWorkspaceModel(10, 1), nil)
+		repoMock.EXPECT().DeleteModel(gomock.Any(), int64(10)).Return(nil)
+		m := &ManageImpl{conf: confMock, repo: repoMock}
+		assert.NoError(t, m.DeleteModel(ctx, 1, 10))
+	})
+
+	t.Run("model not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		confMock.EXPECT().GetModel(gomock.Any(), int64(10)).Return(nil, gorm.ErrRecordNotFound)
+		m := &ManageImpl{conf: confMock}
+		err := m.DeleteModel(ctx, 1, 10)
+		unittest.AssertErrorEqual(t, errorx.NewByCode(llm_errorx.ResourceNotFoundCode), err)
+	})
+}
+
+func TestManageImpl_UpdateModelStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid status", func(t *testing.T) {
+		m := &ManageImpl{}
+		err := m.UpdateModelStatus(ctx, 1, 10, entity.ModelStatusUndefined, "user")
+		unittest.AssertErrorEqual(t, errorx.NewByCode(llm_errorx.CommonInvalidParamCode), err)
+	})
+
+	t.Run("status unchanged", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		confMock.EXPECT().GetModel(gomock.Any(), int64(10)).Return(newWorkspaceModel(10, 1), nil)
+		m := &ManageImpl{conf: confMock}
+		assert.NoError(t, m.UpdateModelStatus(ctx, 1, 10, entity.ModelStatusEnabled, "user"))
+	})
+
+	t.Run("disable model", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		confMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		repoMock := repomocks.NewMockIManageRepo(ctrl)
+		confMock.EXPECT().GetModel(gomock.Any(), int64(10)).Return(newWorkspaceModel(10, 1), nil)
+		repoMock.EXPECT().UpdateModel(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, model *entity.Model) error {
+			assert.Equal(t, entity.ModelStatusDisabled, model.Status)
+			assert.Equal(t, "user", model.UpdatedBy)
+			return nil
+		})
+		m := &ManageImpl{conf: confMock, repo: repoMock}
+		assert.NoError(t, m.UpdateModelStatus(ctx, 1, 10, entity.ModelStatusDisabled, "user"))
+	})
+}
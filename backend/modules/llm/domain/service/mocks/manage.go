@@ -41,6 +41,35 @@ func (m *MockIManage) EXPECT() *MockIManageMockRecorder {
 	return m.recorder
 }
 
+// CreateModel mocks base method.
+func (m *MockIManage) CreateModel(ctx context.Context, model *entity.Model) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModel", ctx, model)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModel indicates an expected call of CreateModel.
+func (mr *MockIManageMockRecorder) CreateModel(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModel", reflect.TypeOf((*MockIManage)(nil).CreateModel), ctx, model)
+}
+
+// DeleteModel mocks base method.
+func (m *MockIManage) DeleteModel(ctx context.Context, workspaceID, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteModel", ctx, workspaceID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteModel indicates an expected call of DeleteModel.
+func (mr *MockIManageMockRecorder) DeleteModel(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModel", reflect.TypeOf((*MockIManage)(nil).DeleteModel), ctx, workspaceID, id)
+}
+
 // GetModelByID mocks base method.
 func (m *MockIManage) GetModelByID(ctx context.Context, id int64) (*entity.Model, error) {
 	m.ctrl.T.Helper()
@@ -73,3 +102,31 @@ func (mr *MockIManageMockRecorder) ListModels(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockIManage)(nil).ListModels), ctx, req)
 }
+
+// UpdateModel mocks base method.
+func (m *MockIManage) UpdateModel(ctx context.Context, model *entity.Model) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModel", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateModel indicates an expected call of UpdateModel.
+func (mr *MockIManageMockRecorder) UpdateModel(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModel", reflect.TypeOf((*MockIManage)(nil).UpdateModel), ctx, model)
+}
+
+// UpdateModelStatus mocks base method.
+func (m *MockIManage) UpdateModelStatus(ctx context.Context, workspaceID, id int64, status entity.ModelStatus, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModelStatus", ctx, workspaceID, id, status, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateModelStatus indicates an expected call of UpdateModelStatus.
+func (mr *MockIManageMockRecorder) UpdateModelStatus(ctx, workspaceID, id, status, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModelStatus", reflect.TypeOf((*MockIManage)(nil).UpdateModelStatus), ctx, workspaceID, id, status, userID)
+}
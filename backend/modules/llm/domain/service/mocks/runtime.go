@@ -56,7 +56,7 @@ func (mr *MockIRuntimeMockRecorder) CreateModelRequestRecord(ctx, record any) *g
 }
 
 // Generate mocks base method.
-func (m *MockIRuntime) Generate(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (*entity.Message, error) {
+func (m *MockIRuntime) Generate(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (*entity.Message, *entity.Model, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, model, input}
 	for _, a := range opts {
@@ -64,8 +64,9 @@ func (m *MockIRuntime) Generate(ctx context.Context, model *entity.Model, input
 	}
 	ret := m.ctrl.Call(m, "Generate", varargs...)
 	ret0, _ := ret[0].(*entity.Message)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*entity.Model)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Generate indicates an expected call of Generate.
@@ -91,7 +92,7 @@ func (mr *MockIRuntimeMockRecorder) HandleMsgsPreCallModel(ctx, model, msgs any)
 }
 
 // Stream mocks base method.
-func (m *MockIRuntime) Stream(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (entity.IStreamReader, error) {
+func (m *MockIRuntime) Stream(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (entity.IStreamReader, *entity.Model, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, model, input}
 	for _, a := range opts {
@@ -99,8 +100,9 @@ func (m *MockIRuntime) Stream(ctx context.Context, model *entity.Model, input []
 	}
 	ret := m.ctrl.Call(m, "Stream", varargs...)
 	ret0, _ := ret[0].(entity.IStreamReader)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*entity.Model)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Stream indicates an expected call of Stream.
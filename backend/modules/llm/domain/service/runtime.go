@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/coze-dev/coze-loop/backend/infra/backoff"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
@@ -17,15 +18,17 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/llm/pkg/httputil"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/localos"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
 )
 
 //go:generate mockgen -destination=mocks/runtime.go -package=mocks . IRuntime
 type IRuntime interface {
-	// Generate 非流式
-	Generate(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (*entity.Message, error)
-	// Stream 流式
+	// Generate 非流式，按模型配置自动重试和降级，返回实际提供服务的模型
+	Generate(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (
+		*entity.Message, *entity.Model, error)
+	// Stream 流式，按模型配置自动重试和降级，返回实际提供服务的模型
 	Stream(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (
-		entity.IStreamReader, error)
+		entity.IStreamReader, *entity.Model, error)
 	// CreateModelRequestRecord 记录模型请求
 	CreateModelRequestRecord(ctx context.Context, record *entity.ModelRequestRecord) (err error)
 	// HandleMsgsPreCallModel 在请求模型前处理消息，如把非公网URL转为base64
@@ -39,32 +42,126 @@ type RuntimeImpl struct {
 	idGen       idgen.IIDGenerator
 	runtimeRepo repo.IRuntimeRepo
 	runtimeCfg  conf.IConfigRuntime
+	manageCfg   conf.IConfigManage
 }
 
 var _ IRuntime = (*RuntimeImpl)(nil)
 
-func (r *RuntimeImpl) Generate(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (*entity.Message, error) {
+func (r *RuntimeImpl) Generate(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (
+	*entity.Message, *entity.Model, error,
+) {
 	if err := r.ValidModelAndRequest(ctx, model, input, opts...); err != nil {
-		return nil, err
+		return nil, model, err
 	}
-	llm, err := r.buildLLM(ctx, model, opts...)
+	var msg *entity.Message
+	servedModel, err := r.callWithFallback(ctx, model, input, opts, func(llm llminterface.ILLM) (err error) {
+		msg, err = llm.Generate(ctx, input, opts...)
+		return err
+	})
 	if err != nil {
-		return nil, err
+		return nil, servedModel, err
 	}
-	return llm.Generate(ctx, input, opts...)
+	return msg, servedModel, nil
 }
 
 func (r *RuntimeImpl) Stream(ctx context.Context, model *entity.Model, input []*entity.Message, opts ...entity.Option) (
-	entity.IStreamReader, error,
+	entity.IStreamReader, *entity.Model, error,
 ) {
 	if err := r.ValidModelAndRequest(ctx, model, input, opts...); err != nil {
-		return nil, err
+		return nil, model, err
 	}
-	llm, err := r.buildLLM(ctx, model, opts...)
+	// 流式只在建立连接阶段重试和降级，开始返回内容后的错误直接透出
+	var sr entity.IStreamReader
+	servedModel, err := r.callWithFallback(ctx, model, input, opts, func(llm llminterface.ILLM) (err error) {
+		sr, err = llm.Stream(ctx, input, opts...)
+		return err
+	})
 	if err != nil {
-		return nil, err
+		return nil, servedModel, err
+	}
+	return sr, servedModel, nil
+}
+
+// callWithFallback 依次使用请求的模型及其降级模型执行 call，直到成功或遇到不可重试的错误。
+// 每个模型都按请求模型的重试策略重试，返回实际提供服务的模型，失败时返回最后一次尝试的模型。
+func (r *RuntimeImpl) callWithFallback(ctx context.Context, model *entity.Model, input []*entity.Message, opts []entity.Option,
+	call func(llm llminterface.ILLM) error,
+) (*entity.Model, error) {
+	policy := model.RetryPolicy
+	servedModel := model
+	var lastErr error
+	for i, candidate := range r.getFallbackChain(ctx, model) {
+		if i > 0 {
+			// 降级模型需要兼容本次请求，否则跳过
+			if err := r.ValidModelAndRequest(ctx, candidate, input, opts...); err != nil {
+				logs.CtxWarn(ctx, "[callWithFallback] skip fallback model %d, err: %v", candidate.ID, err)
+				continue
+			}
+			logs.CtxInfo(ctx, "[callWithFallback] fall back from model %d to model %d, last err: %v", servedModel.ID, candidate.ID, lastErr)
+		}
+		servedModel = candidate
+		llm, err := r.buildLLM(ctx, candidate, opts...)
+		if err != nil {
+			if i == 0 {
+				return servedModel, err
+			}
+			logs.CtxWarn(ctx, "[callWithFallback] build fallback model %d failed, err: %v", candidate.ID, err)
+			lastErr = err
+			continue
+		}
+		attempt := 0
+		err = backoff.RetryWithMaxAttempts(ctx, policy.GetMaxAttempts(), policy.GetInitialInterval(), policy.GetMaxInterval(), func() error {
+			attempt++
+			err := call(llm)
+			if err == nil {
+				return nil
+			}
+			errClass := entity.ClassifyModelError(err)
+			if !policy.IsRetryable(errClass) {
+				return backoff.Permanent(err)
+			}
+			logs.CtxWarn(ctx, "[callWithFallback] call model %d failed, attempt: %d, error class: %s, err: %v", candidate.ID, attempt, errClass, err)
+			return err
+		})
+		if err == nil {
+			return servedModel, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil || !policy.IsRetryable(entity.ClassifyModelError(err)) {
+			break
+		}
+	}
+	return servedModel, lastErr
+}
+
+// getFallbackChain 返回请求的模型及其按顺序排列的降级模型，无法获取或配置不合法的降级模型会被忽略
+func (r *RuntimeImpl) getFallbackChain(ctx context.Context, model *entity.Model) []*entity.Model {
+	chain := []*entity.Model{model}
+	if len(model.FallbackModelIDs) == 0 || r.manageCfg == nil {
+		return chain
+	}
+	seen := map[int64]bool{model.ID: true}
+	for _, id := range model.FallbackModelIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		fallback, err := r.manageCfg.GetModel(ctx, id)
+		if err != nil {
+			logs.CtxWarn(ctx, "[getFallbackChain] get fallback model %d of model %d failed, err: %v", id, model.ID, err)
+			continue
+		}
+		if !fallback.VisibleInWorkspace(model.WorkspaceID) {
+			logs.CtxWarn(ctx, "[getFallbackChain] fallback model %d is not visible to model %d", id, model.ID)
+			continue
+		}
+		if err := fallback.Valid(); err != nil {
+			logs.CtxWarn(ctx, "[getFallbackChain] fallback model %d of model %d is invalid, err: %v", id, model.ID, err)
+			continue
+		}
+		chain = append(chain, fallback)
 	}
-	return llm.Stream(ctx, input, opts...)
+	return chain
 }
 
 func (r *RuntimeImpl) buildLLM(ctx context.Context, model *entity.Model, opts ...entity.Option) (llminterface.ILLM, error) {
@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"io"
 	"os"
 	"testing"
@@ -18,6 +19,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf"
 	llmconfmocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	entitymocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
 	llmrepomocks "github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/service/llmfactory"
@@ -240,7 +242,7 @@ func TestRuntimeImpl_Generate(t *testing.T) {
 				runtimeRepo: ttFields.runtimeRepo,
 				runtimeCfg:  ttFields.runtimeCfg,
 			}
-			got, err := r.Generate(tt.args.ctx, tt.args.model, tt.args.input, tt.args.opts...)
+			got, _, err := r.Generate(tt.args.ctx, tt.args.model, tt.args.input, tt.args.opts...)
 			unittest.AssertErrorEqual(t, tt.wantErr, err)
 			if err != nil {
 				return
@@ -533,7 +535,7 @@ func TestRuntimeImpl_Stream(t *testing.T) {
 				runtimeRepo: ttFields.runtimeRepo,
 				runtimeCfg:  ttFields.runtimeCfg,
 			}
-			got, err := r.Stream(tt.args.ctx, tt.args.model, tt.args.input, tt.args.opts...)
+			got, _, err := r.Stream(tt.args.ctx, tt.args.model, tt.args.input, tt.args.opts...)
 			unittest.AssertErrorEqual(t, tt.wantErr, err)
 			var content string
 			for {
@@ -548,3 +550,80 @@ func TestRuntimeImpl_Stream(t *testing.T) {
 		})
 	}
 }
+
+func TestRuntimeImpl_GenerateWithFallback(t *testing.T) {
+	input := []*entity.Message{{Role: entity.RoleUser, Content: "hi"}}
+	primary := &entity.Model{
+		ID:               1,
+		Name:             "primary",
+		Protocol:         entity.ProtocolOpenAI,
+		ProtocolConfig:   &entity.ProtocolConfig{Model: "primary"},
+		FallbackModelIDs: []int64{2, 1, 3},
+		RetryPolicy:      &entity.RetryPolicy{MaxAttempts: 2, InitialIntervalMs: 1, MaxIntervalMs: 1},
+	}
+	fallback := &entity.Model{
+		ID:             2,
+		Name:           "fallback",
+		Protocol:       entity.ProtocolOpenAI,
+		ProtocolConfig: &entity.ProtocolConfig{Model: "fallback"},
+	}
+	rateLimitErr := errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("error, status code: 429, message: too many requests"))
+	badRequestErr := errorx.NewByCode(llm_errorx.CallModelFailedCode, errorx.WithExtraMsg("error, status code: 400, message: bad request"))
+
+	t.Run("fall back after retries", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		factMock := llmfactorymocks.NewMockIFactory(ctrl)
+		manageMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		primaryLLM := llmifacemocks.NewMockILLM(ctrl)
+		fallbackLLM := llmifacemocks.NewMockILLM(ctrl)
+		manageMock.EXPECT().GetModel(gomock.Any(), int64(2)).Return(fallback, nil)
+		manageMock.EXPECT().GetModel(gomock.Any(), int64(3)).Return(nil, errors.New("not found"))
+		factMock.EXPECT().CreateLLM(gomock.Any(), primary, gomock.Any()).Return(primaryLLM, nil)
+		factMock.EXPECT().CreateLLM(gomock.Any(), fallback, gomock.Any()).Return(fallbackLLM, nil)
+		primaryLLM.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rateLimitErr).Times(2)
+		fallbackLLM.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.Message{Content: "from fallback"}, nil)
+
+		r := &RuntimeImpl{llmFact: factMock, manageCfg: manageMock}
+		got, servedModel, err := r.Generate(context.Background(), primary, input)
+		assert.NoError(t, err)
+		assert.Equal(t, "from fallback", got.Content)
+		assert.Equal(t, fallback, servedModel)
+	})
+
+	t.Run("non retryable error does not fall back", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		factMock := llmfactorymocks.NewMockIFactory(ctrl)
+		manageMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		primaryLLM := llmifacemocks.NewMockILLM(ctrl)
+		manageMock.EXPECT().GetModel(gomock.Any(), int64(2)).Return(fallback, nil)
+		manageMock.EXPECT().GetModel(gomock.Any(), int64(3)).Return(nil, errors.New("not found"))
+		factMock.EXPECT().CreateLLM(gomock.Any(), primary, gomock.Any()).Return(primaryLLM, nil)
+		primaryLLM.EXPECT().Generate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, badRequestErr)
+
+		r := &RuntimeImpl{llmFact: factMock, manageCfg: manageMock}
+		_, servedModel, err := r.Generate(context.Background(), primary, input)
+		unittest.AssertErrorEqual(t, badRequestErr, err)
+		assert.Equal(t, primary, servedModel)
+	})
+
+	t.Run("stream falls back", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		factMock := llmfactorymocks.NewMockIFactory(ctrl)
+		manageMock := llmconfmocks.NewMockIConfigManage(ctrl)
+		primaryLLM := llmifacemocks.NewMockILLM(ctrl)
+		fallbackLLM := llmifacemocks.NewMockILLM(ctrl)
+		streamMock := entitymocks.NewMockIStreamReader(ctrl)
+		manageMock.EXPECT().GetModel(gomock.Any(), int64(2)).Return(fallback, nil)
+		manageMock.EXPECT().GetModel(gomock.Any(), int64(3)).Return(nil, errors.New("not found"))
+		factMock.EXPECT().CreateLLM(gomock.Any(), primary, gomock.Any()).Return(primaryLLM, nil)
+		factMock.EXPECT().CreateLLM(gomock.Any(), fallback, gomock.Any()).Return(fallbackLLM, nil)
+		primaryLLM.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rateLimitErr).Times(2)
+		fallbackLLM.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any()).Return(streamMock, nil)
+
+		r := &RuntimeImpl{llmFact: factMock, manageCfg: manageMock}
+		sr, servedModel, err := r.Stream(context.Background(), primary, input)
+		assert.NoError(t, err)
+		assert.Equal(t, streamMock, sr)
+		assert.Equal(t, fallback, servedModel)
+	})
+}
@@ -7,38 +7,44 @@ import (
 	"context"
 	"math"
 
-	"gorm.io/gorm"
-
 	llm_conf "github.com/coze-dev/coze-loop/backend/modules/llm/domain/component/conf"
 	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
 )
 
 type ManageImpl struct {
-	loader conf.IConfigLoader
+	repo repo.IManageRepo
 }
 
-func NewManage(ctx context.Context, factory conf.IConfigLoaderFactory) (llm_conf.IConfigManage, error) {
+// NewManage 模型配置存储在数据库中，model_config.yaml 中的模型作为初始数据写入数据库，已存在的模型不会被覆盖
+func NewManage(ctx context.Context, factory conf.IConfigLoaderFactory, manageRepo repo.IManageRepo) (llm_conf.IConfigManage, error) {
 	loader, err := factory.NewConfigLoader("model_config.yaml")
 	if err != nil {
 		return nil, err
 	}
+	var seeds []*entity.Model
+	if err := loader.UnmarshalKey(ctx, "models", &seeds); err != nil {
+		return nil, err
+	}
+	if err := manageRepo.SeedModels(ctx, seeds); err != nil {
+		return nil, err
+	}
+	logs.CtxInfo(ctx, "[NewManage] seed %d models from model_config.yaml", len(seeds))
 	return &ManageImpl{
-		loader: loader,
+		repo: manageRepo,
 	}, nil
 }
 
-// ListModel 用于获得模型的配置；后续会使用数据库管理，本期先使用yaml文件管理
 func (m *ManageImpl) ListModels(ctx context.Context, req entity.ListModelReq) (models []*entity.Model, total int64, hasMore bool, nextPageToken int64, err error) {
-	modelsInCfg, err := m.readConfig(ctx)
+	all, err := m.repo.ListModels(ctx, req.WorkspaceID)
 	if err != nil {
 		return models, total, hasMore, nextPageToken, err
 	}
-	if req.Scenario != nil {
-		for _, md := range modelsInCfg {
-			if md.Available(req.Scenario) {
-				models = append(models, md)
-			}
+	for _, md := range all {
+		if md.Available(req.Scenario) && req.Filter.Match(md) {
+			models = append(models, md)
 		}
 	}
 	total = int64(len(models))
@@ -51,22 +57,5 @@ func (m *ManageImpl) ListModels(ctx context.Context, req entity.ListModelReq) (m
 }
 
 func (m *ManageImpl) GetModel(ctx context.Context, id int64) (model *entity.Model, err error) {
-	models, err := m.readConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	for _, md := range models {
-		if md.ID == id {
-			return md, nil
-		}
-	}
-	return nil, gorm.ErrRecordNotFound
-}
-
-func (m *ManageImpl) readConfig(ctx context.Context) ([]*entity.Model, error) {
-	var models []*entity.Model
-	if err := m.loader.UnmarshalKey(ctx, "models", &models); err != nil {
-		return nil, err
-	}
-	return models, nil
+	return m.repo.GetModel(ctx, id)
 }
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/bytedance/sonic"
+
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+// ModelDO2PO 完整的模型配置存储在config字段中，常用于查询的字段单独存储
+func ModelDO2PO(do *entity.Model) (*model.LlmModel, error) {
+	if do == nil {
+		return nil, nil
+	}
+	config, err := sonic.MarshalString(do)
+	if err != nil {
+		return nil, err
+	}
+	return &model.LlmModel{
+		ID:          do.ID,
+		SpaceID:     do.WorkspaceID,
+		Name:        do.Name,
+		Description: do.Desc,
+		Frame:       string(do.Frame),
+		Protocol:    string(do.Protocol),
+		Status:      string(do.Status),
+		PresetModel: do.PresetModel,
+		Config:      ptr.Of(config),
+		CreatedBy:   do.CreatedBy,
+		UpdatedBy:   do.UpdatedBy,
+	}, nil
+}
+
+func ModelPO2DO(po *model.LlmModel) (*entity.Model, error) {
+	if po == nil {
+		return nil, nil
+	}
+	do := &entity.Model{}
+	if config := ptr.From(po.Config); config != "" {
+		if err := sonic.UnmarshalString(config, do); err != nil {
+			return nil, err
+		}
+	}
+	do.ID = po.ID
+	do.WorkspaceID = po.SpaceID
+	do.Name = po.Name
+	do.Desc = po.Description
+	do.Frame = entity.Frame(po.Frame)
+	do.Protocol = entity.Protocol(po.Protocol)
+	do.Status = entity.ModelStatus(po.Status)
+	do.PresetModel = po.PresetModel
+	do.CreatedBy = po.CreatedBy
+	do.UpdatedBy = po.UpdatedBy
+	do.CreatedAt = po.CreatedAt.UnixMilli()
+	do.UpdatedAt = po.UpdatedAt.UnixMilli()
+	return do, nil
+}
@@ -24,6 +24,7 @@ func ModelReqRecordDO2PO(record *entity.ModelRequestRecord) *model.ModelRequestR
 		ModelAk:             record.ModelAk,
 		ModelID:             record.ModelID,
 		ModelName:           record.ModelName,
+		RequestedModelID:    record.RequestedModelID,
 		InputToken:          record.InputToken,
 		OutputToken:         record.OutputToken,
 		Logid:               record.Logid,
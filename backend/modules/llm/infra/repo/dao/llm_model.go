@@ -0,0 +1,69 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dao
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/query"
+)
+
+type ILlmModelDao interface {
+	Create(ctx context.Context, modelPO *model.LlmModel, opts ...db.Option) (err error)
+	BatchCreateIgnoreExisted(ctx context.Context, modelPOs []*model.LlmModel, opts ...db.Option) (err error)
+	Update(ctx context.Context, modelPO *model.LlmModel, opts ...db.Option) (err error)
+	Delete(ctx context.Context, id int64, opts ...db.Option) (err error)
+	Get(ctx context.Context, id int64, opts ...db.Option) (modelPO *model.LlmModel, err error)
+	List(ctx context.Context, spaceIDs []int64, opts ...db.Option) (modelPOs []*model.LlmModel, err error)
+}
+
+type LlmModelDaoImpl struct {
+	db db.Provider
+}
+
+func NewLlmModelDao(db db.Provider) ILlmModelDao {
+	return &LlmModelDaoImpl{db: db}
+}
+
+func (d *LlmModelDaoImpl) Create(ctx context.Context, modelPO *model.LlmModel, opts ...db.Option) (err error) {
+	q := query.Use(d.db.NewSession(ctx, opts...)).WithContext(ctx)
+	return q.LlmModel.Create(modelPO)
+}
+
+func (d *LlmModelDaoImpl) BatchCreateIgnoreExisted(ctx context.Context, modelPOs []*model.LlmModel, opts ...db.Option) (err error) {
+	if len(modelPOs) == 0 {
+		return nil
+	}
+	q := query.Use(d.db.NewSession(ctx, opts...)).WithContext(ctx)
+	return q.LlmModel.Clauses(clause.OnConflict{DoNothing: true}).Create(modelPOs...)
+}
+
+func (d *LlmModelDaoImpl) Update(ctx context.Context, modelPO *model.LlmModel, opts ...db.Option) (err error) {
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	_, err = q.WithContext(ctx).LlmModel.Where(q.LlmModel.ID.Eq(modelPO.ID)).
+		Select(q.LlmModel.Name, q.LlmModel.Description, q.LlmModel.Frame, q.LlmModel.Protocol, q.LlmModel.Status,
+			q.LlmModel.Config, q.LlmModel.UpdatedBy).
+		Updates(modelPO)
+	return err
+}
+
+func (d *LlmModelDaoImpl) Delete(ctx context.Context, id int64, opts ...db.Option) (err error) {
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	_, err = q.WithContext(ctx).LlmModel.Where(q.LlmModel.ID.Eq(id)).Delete()
+	return err
+}
+
+func (d *LlmModelDaoImpl) Get(ctx context.Context, id int64, opts ...db.Option) (modelPO *model.LlmModel, err error) {
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	return q.WithContext(ctx).LlmModel.Where(q.LlmModel.ID.Eq(id)).First()
+}
+
+func (d *LlmModelDaoImpl) List(ctx context.Context, spaceIDs []int64, opts ...db.Option) (modelPOs []*model.LlmModel, err error) {
+	q := query.Use(d.db.NewSession(ctx, opts...))
+	return q.WithContext(ctx).LlmModel.Where(q.LlmModel.SpaceID.In(spaceIDs...)).Order(q.LlmModel.ID).Find()
+}
@@ -23,6 +23,7 @@ type ModelRequestRecord struct {
 	ModelAk             string    `gorm:"column:model_ak;type:varchar(1024);not null;comment:æ¨¡åž‹çš„AK" json:"model_ak"`                                                                     // æ¨¡åž‹çš„AK
 	ModelID             string    `gorm:"column:model_id;type:varchar(256);not null;comment:model id" json:"model_id"`                                                                         // model id
 	ModelName           string    `gorm:"column:model_name;type:varchar(1024);not null;comment:æ¨¡åž‹å±•ç¤ºåç§°" json:"model_name"`                                                          // æ¨¡åž‹å±•ç¤ºåç§°
+	RequestedModelID    string    `gorm:"column:requested_model_id;type:varchar(256);not null;comment:requested model id" json:"requested_model_id"`                                           // requested model id
 	InputToken          int64     `gorm:"column:input_token;type:bigint unsigned;not null;comment:è¾“å…¥tokenæ•°é‡" json:"input_token"`                                                       // è¾“å…¥tokenæ•°é‡
 	OutputToken         int64     `gorm:"column:output_token;type:bigint unsigned;not null;comment:è¾“å‡ºtokenæ•°é‡" json:"output_token"`                                                     // è¾“å‡ºtokenæ•°é‡
 	Logid               string    `gorm:"column:logid;type:varchar(128);not null;comment:logid" json:"logid"`                                                                                  // logid
@@ -35,6 +35,7 @@ func newModelRequestRecord(db *gorm.DB, opts ...gen.DOOption) modelRequestRecord
 	_modelRequestRecord.ModelAk = field.NewString(tableName, "model_ak")
 	_modelRequestRecord.ModelID = field.NewString(tableName, "model_id")
 	_modelRequestRecord.ModelName = field.NewString(tableName, "model_name")
+	_modelRequestRecord.RequestedModelID = field.NewString(tableName, "requested_model_id")
 	_modelRequestRecord.InputToken = field.NewInt64(tableName, "input_token")
 	_modelRequestRecord.OutputToken = field.NewInt64(tableName, "output_token")
 	_modelRequestRecord.Logid = field.NewString(tableName, "logid")
@@ -64,6 +65,7 @@ type modelRequestRecord struct {
 	ModelAk             field.String // æ¨¡åž‹çš„AK
 	ModelID             field.String // model id
 	ModelName           field.String // æ¨¡åž‹å±•ç¤ºåç§°
+	RequestedModelID    field.String // requested model id
 	InputToken          field.Int64  // è¾“å…¥tokenæ•°é‡
 	OutputToken         field.Int64  // è¾“å‡ºtokenæ•°é‡
 	Logid               field.String // logid
@@ -98,6 +100,7 @@ func (m *modelRequestRecord) updateTableName(table string) *modelRequestRecord {
 	m.ModelAk = field.NewString(table, "model_ak")
 	m.ModelID = field.NewString(table, "model_id")
 	m.ModelName = field.NewString(table, "model_name")
+	m.RequestedModelID = field.NewString(table, "requested_model_id")
 	m.InputToken = field.NewInt64(table, "input_token")
 	m.OutputToken = field.NewInt64(table, "output_token")
 	m.Logid = field.NewString(table, "logid")
@@ -133,7 +136,7 @@ func (m *modelRequestRecord) GetFieldByName(fieldName string) (field.OrderExpr,
 }
 
 func (m *modelRequestRecord) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 18)
+	m.fieldMap = make(map[string]field.Expr, 19)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["space_id"] = m.SpaceID
 	m.fieldMap["user_id"] = m.UserID
@@ -145,6 +148,7 @@ func (m *modelRequestRecord) fillFieldMap() {
 	m.fieldMap["model_ak"] = m.ModelAk
 	m.fieldMap["model_id"] = m.ModelID
 	m.fieldMap["model_name"] = m.ModelName
+	m.fieldMap["requested_model_id"] = m.RequestedModelID
 	m.fieldMap["input_token"] = m.InputToken
 	m.fieldMap["output_token"] = m.OutputToken
 	m.fieldMap["logid"] = m.Logid
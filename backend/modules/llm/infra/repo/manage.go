@@ -0,0 +1,149 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+	"github.com/pkg/errors"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/infra/dkms"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/domain/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/convertor"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/dao"
+	"github.com/coze-dev/coze-loop/backend/modules/llm/infra/repo/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+// modelSecretDataKey 加密模型密钥使用的dataKey
+const modelSecretDataKey = "llm_model_protocol_config"
+
+type ManageRepoImpl struct {
+	db       db.Provider
+	modelDao dao.ILlmModelDao
+	kms      dkms.IDKMS
+}
+
+func NewManageRepo(db db.Provider, modelDao dao.ILlmModelDao, kms dkms.IDKMS) repo.IManageRepo {
+	return &ManageRepoImpl{
+		db:       db,
+		modelDao: modelDao,
+		kms:      kms,
+	}
+}
+
+func (r *ManageRepoImpl) CreateModel(ctx context.Context, model *entity.Model) (err error) {
+	po, err := r.modelDO2PO(ctx, model)
+	if err != nil {
+		return err
+	}
+	return r.modelDao.Create(ctx, po)
+}
+
+func (r *ManageRepoImpl) SeedModels(ctx context.Context, models []*entity.Model) (err error) {
+	pos := make([]*model.LlmModel, 0, len(models))
+	for _, m := range models {
+		po, err := r.modelDO2PO(ctx, m)
+		if err != nil {
+			return err
+		}
+		pos = append(pos, po)
+	}
+	return r.modelDao.BatchCreateIgnoreExisted(ctx, pos)
+}
+
+func (r *ManageRepoImpl) UpdateModel(ctx context.Context, model *entity.Model) (err error) {
+	po, err := r.modelDO2PO(ctx, model)
+	if err != nil {
+		return err
+	}
+	return r.modelDao.Update(ctx, po)
+}
+
+func (r *ManageRepoImpl) DeleteModel(ctx context.Context, id int64) (err error) {
+	return r.modelDao.Delete(ctx, id)
+}
+
+func (r *ManageRepoImpl) GetModel(ctx context.Context, id int64) (*entity.Model, error) {
+	po, err := r.modelDao.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.modelPO2DO(ctx, po)
+}
+
+func (r *ManageRepoImpl) ListModels(ctx context.Context, workspaceID *int64) ([]*entity.Model, error) {
+	spaceIDs := []int64{0}
+	if ptr.From(workspaceID) != 0 {
+		spaceIDs = append(spaceIDs, *workspaceID)
+	}
+	pos, err := r.modelDao.List(ctx, spaceIDs)
+	if err != nil {
+		return nil, err
+	}
+	models := make([]*entity.Model, 0, len(pos))
+	for _, po := range pos {
+		do, err := r.modelPO2DO(ctx, po)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, do)
+	}
+	return models, nil
+}
+
+// modelDO2PO 加密protocol_config中的密钥，不修改入参
+func (r *ManageRepoImpl) modelDO2PO(ctx context.Context, do *entity.Model) (*model.LlmModel, error) {
+	if do == nil {
+		return nil, errors.New("model is nil")
+	}
+	encrypted := *do
+	if do.ProtocolConfig != nil {
+		pc := &entity.ProtocolConfig{}
+		if err := deepCopy(do.ProtocolConfig, pc); err != nil {
+			return nil, err
+		}
+		for _, f := range pc.SecretFields() {
+			if f == nil {
+				continue
+			}
+			ciphertext, err := r.kms.Encrypt(ctx, modelSecretDataKey, *f)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "encrypt secret of model %d", do.ID)
+			}
+			*f = ciphertext
+		}
+		encrypted.ProtocolConfig = pc
+	}
+	return convertor.ModelDO2PO(&encrypted)
+}
+
+func (r *ManageRepoImpl) modelPO2DO(ctx context.Context, po *model.LlmModel) (*entity.Model, error) {
+	do, err := convertor.ModelPO2DO(po)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "unmarshal config of model %d", po.ID)
+	}
+	for _, f := range do.ProtocolConfig.SecretFields() {
+		if f == nil {
+			continue
+		}
+		plaintext, err := r.kms.Decrypt(ctx, modelSecretDataKey, *f)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "decrypt secret of model %d", po.ID)
+		}
+		*f = plaintext
+	}
+	return do, nil
+}
+
+func deepCopy(src, dst any) error {
+	b, err := sonic.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return sonic.Unmarshal(b, dst)
+}
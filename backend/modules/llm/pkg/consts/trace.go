@@ -16,16 +16,17 @@ const (
 )
 
 const (
-	SpanTagModelID         = "model_id"
-	SpanTagCallType        = "call_type"
-	SpanTagEnterpriseID    = "enterprise_id"
-	SpanTagTenant          = "tenant"
-	SpanTagDebugID         = "debug_id"
-	SpanTagPromptVariables = "prompt_variables"
-	SpanTagMessages        = "messages"
-	SpanTagStatusCode      = "_status_code"
-	SpanTagPromptTemplate  = "prompt_template"
-	SpanTagPromptID        = "prompt_id"
+	SpanTagModelID          = "model_id"
+	SpanTagRequestedModelID = "requested_model_id"
+	SpanTagCallType         = "call_type"
+	SpanTagEnterpriseID     = "enterprise_id"
+	SpanTagTenant           = "tenant"
+	SpanTagDebugID          = "debug_id"
+	SpanTagPromptVariables  = "prompt_variables"
+	SpanTagMessages         = "messages"
+	SpanTagStatusCode       = "_status_code"
+	SpanTagPromptTemplate   = "prompt_template"
+	SpanTagPromptID         = "prompt_id"
 )
 
 const (
@@ -95,6 +95,10 @@ const (
 	CallModelTimeoutCode              = 601505012
 	callModelTimeoutMessage           = "call model timeout"
 	callModelTimeoutNoAffectStability = true
+
+	ModelNotEditableCode              = 601505013
+	modelNotEditableMessage           = "model can not be edited in current workspace"
+	modelNotEditableNoAffectStability = true
 )
 
 func init() {
@@ -231,4 +235,10 @@ func init() {
 		code.WithAffectStability(!callModelTimeoutNoAffectStability),
 	)
 
+	code.Register(
+		ModelNotEditableCode,
+		modelNotEditableMessage,
+		code.WithAffectStability(!modelNotEditableNoAffectStability),
+	)
+
 }
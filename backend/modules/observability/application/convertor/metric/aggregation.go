@@ -0,0 +1,71 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/metric"
+	tconv "github.com/coze-dev/coze-loop/backend/modules/observability/application/convertor/trace"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func AggregationDTO2DO(a *metric.Aggregation) *entity.Aggregation {
+	if a == nil {
+		return nil
+	}
+	return &entity.Aggregation{
+		Type:  entity.AggregationType(a.GetAggregationType()),
+		Field: tconv.FilterFieldDTO2DO(a.Field),
+		Alias: a.GetAlias(),
+	}
+}
+
+func AggregationListDTO2DO(aggregations []*metric.Aggregation) []*entity.Aggregation {
+	ret := make([]*entity.Aggregation, 0, len(aggregations))
+	for _, a := range aggregations {
+		ret = append(ret, AggregationDTO2DO(a))
+	}
+	return ret
+}
+
+func AggregationDO2DTO(a *entity.Aggregation) *metric.Aggregation {
+	if a == nil {
+		return nil
+	}
+	ret := &metric.Aggregation{
+		AggregationType: metric.AggregationType(a.Type),
+		Field:           tconv.FilterFieldDO2DTO(a.Field),
+	}
+	if a.Alias != "" {
+		ret.Alias = ptr.Of(a.Alias)
+	}
+	return ret
+}
+
+func AggregationListDO2DTO(aggregations []*entity.Aggregation) []*metric.Aggregation {
+	ret := make([]*metric.Aggregation, 0, len(aggregations))
+	for _, a := range aggregations {
+		ret = append(ret, AggregationDO2DTO(a))
+	}
+	return ret
+}
+
+func AggregationRowDO2DTO(r *entity.AggregationRow) *metric.AggregationRow {
+	ret := &metric.AggregationRow{
+		GroupByValues: r.GroupByValues,
+		Values:        r.Values,
+	}
+	if r.Timestamp != "" {
+		ret.Timestamp = ptr.Of(r.Timestamp)
+	}
+	return ret
+}
+
+func AggregationRowListDO2DTO(rows []*entity.AggregationRow) []*metric.AggregationRow {
+	ret := make([]*metric.AggregationRow, 0, len(rows))
+	for _, r := range rows {
+		ret = append(ret, AggregationRowDO2DTO(r))
+	}
+	return ret
+}
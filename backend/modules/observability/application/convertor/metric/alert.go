@@ -0,0 +1,115 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	alertdto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/alert"
+	commdto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	tconv "github.com/coze-dev/coze-loop/backend/modules/observability/application/convertor/trace"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func AlertRuleDTO2DO(workspaceID int64, rule *alertdto.AlertRule) *entity.AlertRule {
+	if rule == nil {
+		return nil
+	}
+	ret := &entity.AlertRule{
+		ID:            rule.GetID(),
+		WorkspaceID:   workspaceID,
+		Name:          rule.GetName(),
+		PlatformType:  loop_span.PlatformType(rule.GetPlatformType()),
+		MetricName:    rule.GetMetricName(),
+		GroupKey:      rule.GetGroupKey(),
+		Filters:       tconv.FilterFieldsDTO2DO(rule.Filters),
+		WindowSeconds: rule.GetWindowSeconds(),
+		// 未指定时默认启用
+		Enabled: rule.Enabled == nil || rule.GetEnabled(),
+	}
+	if c := rule.GetCondition(); c != nil {
+		ret.Condition = &entity.AlertCondition{
+			Type:            entity.AlertConditionType(c.GetType()),
+			Operator:        entity.AlertCompareOperator(c.GetOperator()),
+			Threshold:       c.GetThreshold(),
+			Deviation:       c.GetDeviation(),
+			BaselineWindows: int(c.GetBaselineWindows()),
+		}
+	}
+	if n := rule.GetNotification(); n != nil {
+		ret.Notification = &entity.AlertNotification{
+			UserIDs:        n.GetUserIds(),
+			WebhookURL:     n.GetWebhookURL(),
+			WebhookHeaders: n.GetWebhookHeaders(),
+		}
+	}
+	return ret
+}
+
+func AlertRuleDO2DTO(rule *entity.AlertRule) *alertdto.AlertRule {
+	if rule == nil {
+		return nil
+	}
+	ret := &alertdto.AlertRule{
+		ID:            ptr.Of(rule.ID),
+		WorkspaceID:   ptr.Of(rule.WorkspaceID),
+		Name:          rule.Name,
+		MetricName:    rule.MetricName,
+		Filters:       tconv.FilterFieldsDO2DTO(rule.Filters),
+		WindowSeconds: rule.WindowSeconds,
+		Enabled:       ptr.Of(rule.Enabled),
+		State: &alertdto.AlertState{
+			LastValue:       ptr.Of(rule.State.LastValue),
+			LastEvaluatedAt: ptr.Of(rule.State.LastEvaluatedAt),
+			FiredAt:         ptr.Of(rule.State.FiredAt),
+			ResolvedAt:      ptr.Of(rule.State.ResolvedAt),
+		},
+		BaseInfo: &commdto.BaseInfo{
+			CreatedBy: &commdto.UserInfo{UserID: ptr.Of(rule.CreatedBy)},
+			UpdatedBy: &commdto.UserInfo{UserID: ptr.Of(rule.UpdatedBy)},
+			CreatedAt: ptr.Of(rule.CreatedAt.UnixMilli()),
+			UpdatedAt: ptr.Of(rule.UpdatedAt.UnixMilli()),
+		},
+	}
+	if rule.PlatformType != "" {
+		ret.PlatformType = ptr.Of(commdto.PlatformType(rule.PlatformType))
+	}
+	if rule.GroupKey != "" {
+		ret.GroupKey = ptr.Of(rule.GroupKey)
+	}
+	if rule.State.Status != "" {
+		ret.State.Status = ptr.Of(alertdto.AlertStatus(rule.State.Status))
+	}
+	if c := rule.Condition; c != nil {
+		ret.Condition = &alertdto.AlertCondition{
+			Type:     alertdto.ConditionType(c.Type),
+			Operator: alertdto.CompareOperator(c.Operator),
+		}
+		switch c.Type {
+		case entity.AlertConditionTypeThreshold:
+			ret.Condition.Threshold = ptr.Of(c.Threshold)
+		case entity.AlertConditionTypeAnomaly:
+			ret.Condition.Deviation = ptr.Of(c.Deviation)
+			ret.Condition.BaselineWindows = ptr.Of(int32(c.GetBaselineWindows()))
+		}
+	}
+	if n := rule.Notification; n != nil {
+		ret.Notification = &alertdto.AlertNotification{
+			UserIds:        n.UserIDs,
+			WebhookHeaders: n.WebhookHeaders,
+		}
+		if n.WebhookURL != "" {
+			ret.Notification.WebhookURL = ptr.Of(n.WebhookURL)
+		}
+	}
+	return ret
+}
+
+func AlertRuleListDO2DTO(rules []*entity.AlertRule) []*alertdto.AlertRule {
+	ret := make([]*alertdto.AlertRule, 0, len(rules))
+	for _, rule := range rules {
+		ret = append(ret, AlertRuleDO2DTO(rule))
+	}
+	return ret
+}
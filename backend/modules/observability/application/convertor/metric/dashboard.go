@@ -0,0 +1,159 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	commdto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	dashboarddto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dashboard"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/metric"
+	tconv "github.com/coze-dev/coze-loop/backend/modules/observability/application/convertor/trace"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func DashboardDTO2DO(workspaceID int64, d *dashboarddto.Dashboard) *entity.Dashboard {
+	if d == nil {
+		return nil
+	}
+	ret := &entity.Dashboard{
+		ID:           d.GetID(),
+		WorkspaceID:  workspaceID,
+		Name:         d.GetName(),
+		Description:  d.GetDescription(),
+		PlatformType: loop_span.PlatformType(d.GetPlatformType()),
+		Filters:      tconv.FilterFieldsDTO2DO(d.Filters),
+	}
+	for _, panel := range d.GetPanels() {
+		ret.Panels = append(ret.Panels, DashboardPanelDTO2DO(panel))
+	}
+	return ret
+}
+
+func DashboardPanelDTO2DO(p *dashboarddto.DashboardPanel) *entity.DashboardPanel {
+	if p == nil {
+		return nil
+	}
+	ret := &entity.DashboardPanel{
+		ID:          p.GetID(),
+		Title:       p.GetTitle(),
+		ChartType:   entity.ChartType(p.GetChartType()),
+		MetricNames: p.GetMetricNames(),
+		Filters:     tconv.FilterFieldsDTO2DO(p.Filters),
+		Granularity: entity.MetricGranularity(p.GetGranularity()),
+		Limit:       p.GetLimit(),
+	}
+	if len(p.GetAggregations()) > 0 {
+		ret.Aggregations = AggregationListDTO2DO(p.GetAggregations())
+	}
+	if len(p.GetGroupBys()) > 0 {
+		ret.GroupBys = tconv.FilterFieldListDTO2DO(p.GetGroupBys())
+	}
+	if l := p.GetLayout(); l != nil {
+		ret.Layout = &entity.PanelLayout{
+			X:      l.GetX(),
+			Y:      l.GetY(),
+			Width:  l.GetWidth(),
+			Height: l.GetHeight(),
+		}
+	}
+	return ret
+}
+
+func DashboardDO2DTO(d *entity.Dashboard) *dashboarddto.Dashboard {
+	if d == nil {
+		return nil
+	}
+	ret := &dashboarddto.Dashboard{
+		ID:          ptr.Of(d.ID),
+		WorkspaceID: ptr.Of(d.WorkspaceID),
+		Name:        d.Name,
+		Filters:     tconv.FilterFieldsDO2DTO(d.Filters),
+		Panels:      make([]*dashboarddto.DashboardPanel, 0, len(d.Panels)),
+		BaseInfo: &commdto.BaseInfo{
+			CreatedBy: &commdto.UserInfo{UserID: ptr.Of(d.CreatedBy)},
+			UpdatedBy: &commdto.UserInfo{UserID: ptr.Of(d.UpdatedBy)},
+			CreatedAt: ptr.Of(d.CreatedAt.UnixMilli()),
+			UpdatedAt: ptr.Of(d.UpdatedAt.UnixMilli()),
+		},
+	}
+	if d.Description != "" {
+		ret.Description = ptr.Of(d.Description)
+	}
+	if d.PlatformType != "" {
+		ret.PlatformType = ptr.Of(commdto.PlatformType(d.PlatformType))
+	}
+	for _, panel := range d.Panels {
+		ret.Panels = append(ret.Panels, DashboardPanelDO2DTO(panel))
+	}
+	return ret
+}
+
+func DashboardPanelDO2DTO(p *entity.DashboardPanel) *dashboarddto.DashboardPanel {
+	if p == nil {
+		return nil
+	}
+	ret := &dashboarddto.DashboardPanel{
+		ID:          p.ID,
+		Title:       p.Title,
+		ChartType:   dashboarddto.ChartType(p.ChartType),
+		MetricNames: p.MetricNames,
+		Filters:     tconv.FilterFieldsDO2DTO(p.Filters),
+	}
+	if len(p.Aggregations) > 0 {
+		ret.Aggregations = AggregationListDO2DTO(p.Aggregations)
+	}
+	if len(p.GroupBys) > 0 {
+		ret.GroupBys = tconv.FilterFieldListDO2DTO(p.GroupBys)
+	}
+	if p.Granularity != "" {
+		ret.Granularity = ptr.Of(string(p.Granularity))
+	}
+	if p.Limit > 0 {
+		ret.Limit = ptr.Of(p.Limit)
+	}
+	if l := p.Layout; l != nil {
+		ret.Layout = &dashboarddto.PanelLayout{
+			X:      ptr.Of(l.X),
+			Y:      ptr.Of(l.Y),
+			Width:  ptr.Of(l.Width),
+			Height: ptr.Of(l.Height),
+		}
+	}
+	return ret
+}
+
+func DashboardListDO2DTO(dashboards []*entity.Dashboard) []*dashboarddto.Dashboard {
+	ret := make([]*dashboarddto.Dashboard, 0, len(dashboards))
+	for _, d := range dashboards {
+		ret = append(ret, DashboardDO2DTO(d))
+	}
+	return ret
+}
+
+func RenderedPanelDO2DTO(r *entity.RenderedPanel) *dashboarddto.RenderedPanel {
+	ret := &dashboarddto.RenderedPanel{PanelID: r.PanelID}
+	if r.Error != "" {
+		ret.ErrorMsg = ptr.Of(r.Error)
+		return ret
+	}
+	if r.Metrics != nil {
+		ret.Metrics = make(map[string]*metric.Metric, len(r.Metrics))
+		for k, v := range r.Metrics {
+			ret.Metrics[k] = MetricDO2DTO(v)
+		}
+	}
+	if r.Rows != nil {
+		ret.Rows = AggregationRowListDO2DTO(r.Rows)
+	}
+	return ret
+}
+
+func RenderedPanelListDO2DTO(results []*entity.RenderedPanel) []*dashboarddto.RenderedPanel {
+	ret := make([]*dashboarddto.RenderedPanel, 0, len(results))
+	for _, r := range results {
+		ret = append(ret, RenderedPanelDO2DTO(r))
+	}
+	return ret
+}
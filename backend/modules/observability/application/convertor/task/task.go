@@ -43,6 +43,7 @@ func TaskDO2DTO(ctx context.Context, v *entity.ObservabilityTask, userMap map[st
 	}
 	var taskDetail *task.RunDetail
 	var totalCount, successCount, failedCount int64
+	var sampleStats *entity.SampleStats
 	for _, tr := range v.TaskRuns {
 		trDO := TaskRunDO2DTO(ctx, tr, nil)
 		if trDO.RunDetail != nil {
@@ -50,11 +51,15 @@ func TaskDO2DTO(ctx context.Context, v *entity.ObservabilityTask, userMap map[st
 			successCount += *trDO.RunDetail.SuccessCount
 			failedCount += *trDO.RunDetail.FailedCount
 		}
+		if tr.RunDetail != nil {
+			sampleStats = mergeSampleStats(sampleStats, tr.RunDetail.SampleStats)
+		}
 	}
 	taskDetail = &task.RunDetail{
 		TotalCount:   gptr.Of(totalCount),
 		SuccessCount: gptr.Of(successCount),
 		FailedCount:  gptr.Of(failedCount),
+		SampleStats:  SampleStatsDO2DTO(sampleStats),
 	}
 	taskInfo := &task.Task{
 		ID:          ptr.Of(v.ID),
@@ -117,9 +122,36 @@ func TaskConfigDO2DTO(v *entity.TaskConfig) *task.TaskConfig {
 			dataReflowConfigs = append(dataReflowConfigs, DataReflowConfigDO2DTO(config))
 		}
 	}
+	var autoTagConfigs []*task.AutoTagConfig
+	for _, config := range v.AutoTagConfigs {
+		autoTagConfigs = append(autoTagConfigs, AutoTagConfigDO2DTO(config))
+	}
 	return &task.TaskConfig{
 		AutoEvaluateConfigs: autoEvaluateConfigs,
 		DataReflowConfig:    dataReflowConfigs,
+		AutoTagConfigs:      autoTagConfigs,
+	}
+}
+
+func AutoTagConfigDO2DTO(v *entity.AutoTagConfig) *task.AutoTagConfig {
+	if v == nil {
+		return nil
+	}
+	var fieldMappings []*task.EvaluateFieldMapping
+	for _, config := range v.FieldMappings {
+		fieldMappings = append(fieldMappings, &task.EvaluateFieldMapping{
+			FieldSchema:        config.FieldSchema,
+			TraceFieldKey:      config.TraceFieldKey,
+			TraceFieldJsonpath: config.TraceFieldJsonpath,
+			EvalSetName:        config.EvalSetName,
+		})
+	}
+	return &task.AutoTagConfig{
+		EvaluatorVersionID: v.EvaluatorVersionID,
+		EvaluatorID:        v.EvaluatorID,
+		FieldMappings:      fieldMappings,
+		TagKey:             v.TagKey,
+		Labels:             v.Labels,
 	}
 }
 
@@ -210,6 +242,47 @@ func SamplerDO2DTO(sampler *entity.Sampler) *task.Sampler {
 		CycleCount:    ptr.Of(sampler.CycleCount),
 		CycleInterval: ptr.Of(sampler.CycleInterval),
 		CycleTimeUnit: ptr.Of(string(sampler.CycleTimeUnit)),
+
+		SamplingStrategy:    SamplingStrategyDO2DTO(sampler.SamplingStrategy),
+		StratifiedSampling:  StratifiedSamplingDO2DTO(sampler.StratifiedSampling),
+		ErrorBiasedSampling: ErrorBiasedSamplingDO2DTO(sampler.ErrorBiasedSampling),
+		Dedup:               SampleDedupDO2DTO(sampler.Dedup),
+	}
+}
+
+func SamplingStrategyDO2DTO(strategy entity.SamplingStrategy) *task.SamplingStrategy {
+	if strategy == "" {
+		return nil
+	}
+	return ptr.Of(string(strategy))
+}
+
+func StratifiedSamplingDO2DTO(v *entity.StratifiedSampling) *task.StratifiedSampling {
+	if v == nil {
+		return nil
+	}
+	return &task.StratifiedSampling{
+		FieldName:      v.FieldName,
+		PerStratumSize: v.PerStratumSize,
+	}
+}
+
+func ErrorBiasedSamplingDO2DTO(v *entity.ErrorBiasedSampling) *task.ErrorBiasedSampling {
+	if v == nil {
+		return nil
+	}
+	return &task.ErrorBiasedSampling{
+		ErrorSampleRate: v.ErrorSampleRate,
+	}
+}
+
+func SampleDedupDO2DTO(v *entity.SampleDedup) *task.SampleDedup {
+	if v == nil {
+		return nil
+	}
+	return &task.SampleDedup{
+		Enabled:   ptr.Of(v.Enabled),
+		FieldName: ptr.Of(v.FieldName),
 	}
 }
 
@@ -235,6 +308,37 @@ func RunDetailDO2DTO(runDetail *entity.RunDetail) *task.RunDetail {
 		SuccessCount: ptr.Of(runDetail.SuccessCount),
 		FailedCount:  ptr.Of(runDetail.FailedCount),
 		TotalCount:   ptr.Of(runDetail.TotalCount),
+		SampleStats:  SampleStatsDO2DTO(runDetail.SampleStats),
+	}
+}
+
+// mergeSampleStats 汇总各个TaskRun的采样统计
+func mergeSampleStats(dst, src *entity.SampleStats) *entity.SampleStats {
+	if src == nil {
+		return dst
+	}
+	if dst == nil {
+		dst = &entity.SampleStats{}
+	}
+	dst.ErrorCount += src.ErrorCount
+	dst.DedupSkippedCount += src.DedupSkippedCount
+	for stratum, count := range src.StratumCounts {
+		if dst.StratumCounts == nil {
+			dst.StratumCounts = make(map[string]int64)
+		}
+		dst.StratumCounts[stratum] += count
+	}
+	return dst
+}
+
+func SampleStatsDO2DTO(stats *entity.SampleStats) *task.SampleStats {
+	if stats == nil {
+		return nil
+	}
+	return &task.SampleStats{
+		StratumCounts:     stats.StratumCounts,
+		ErrorCount:        ptr.Of(stats.ErrorCount),
+		DedupSkippedCount: ptr.Of(stats.DedupSkippedCount),
 	}
 }
 
@@ -369,6 +473,18 @@ func RunDetailDTO2DO(runDetail *task.RunDetail) *entity.RunDetail {
 		SuccessCount: *runDetail.SuccessCount,
 		FailedCount:  *runDetail.FailedCount,
 		TotalCount:   *runDetail.TotalCount,
+		SampleStats:  SampleStatsDTO2DO(runDetail.SampleStats),
+	}
+}
+
+func SampleStatsDTO2DO(stats *task.SampleStats) *entity.SampleStats {
+	if stats == nil {
+		return nil
+	}
+	return &entity.SampleStats{
+		StratumCounts:     stats.StratumCounts,
+		ErrorCount:        stats.GetErrorCount(),
+		DedupSkippedCount: stats.GetDedupSkippedCount(),
 	}
 }
 
@@ -393,6 +509,40 @@ func SamplerDTO2DO(sampler *task.Sampler) *entity.Sampler {
 		CycleCount:    sampler.GetCycleCount(),
 		CycleInterval: sampler.GetCycleInterval(),
 		CycleTimeUnit: entity.TimeUnit(sampler.GetCycleTimeUnit()),
+
+		SamplingStrategy:    entity.SamplingStrategy(sampler.GetSamplingStrategy()),
+		StratifiedSampling:  StratifiedSamplingDTO2DO(sampler.StratifiedSampling),
+		ErrorBiasedSampling: ErrorBiasedSamplingDTO2DO(sampler.ErrorBiasedSampling),
+		Dedup:               SampleDedupDTO2DO(sampler.Dedup),
+	}
+}
+
+func StratifiedSamplingDTO2DO(v *task.StratifiedSampling) *entity.StratifiedSampling {
+	if v == nil {
+		return nil
+	}
+	return &entity.StratifiedSampling{
+		FieldName:      v.GetFieldName(),
+		PerStratumSize: v.GetPerStratumSize(),
+	}
+}
+
+func ErrorBiasedSamplingDTO2DO(v *task.ErrorBiasedSampling) *entity.ErrorBiasedSampling {
+	if v == nil {
+		return nil
+	}
+	return &entity.ErrorBiasedSampling{
+		ErrorSampleRate: v.ErrorSampleRate,
+	}
+}
+
+func SampleDedupDTO2DO(v *task.SampleDedup) *entity.SampleDedup {
+	if v == nil {
+		return nil
+	}
+	return &entity.SampleDedup{
+		Enabled:   v.GetEnabled(),
+		FieldName: v.GetFieldName(),
 	}
 }
 
@@ -454,9 +604,29 @@ func TaskConfigDTO2DO(taskConfig *task.TaskConfig) *entity.TaskConfig {
 			FieldMappings: fieldMappings,
 		})
 	}
+	var autoTagConfigs []*entity.AutoTagConfig
+	for _, autoTagConfig := range taskConfig.AutoTagConfigs {
+		var fieldMappings []*entity.EvaluateFieldMapping
+		for _, config := range autoTagConfig.FieldMappings {
+			fieldMappings = append(fieldMappings, &entity.EvaluateFieldMapping{
+				FieldSchema:        config.FieldSchema,
+				TraceFieldKey:      config.TraceFieldKey,
+				TraceFieldJsonpath: config.TraceFieldJsonpath,
+				EvalSetName:        config.EvalSetName,
+			})
+		}
+		autoTagConfigs = append(autoTagConfigs, &entity.AutoTagConfig{
+			EvaluatorVersionID: autoTagConfig.EvaluatorVersionID,
+			EvaluatorID:        autoTagConfig.EvaluatorID,
+			FieldMappings:      fieldMappings,
+			TagKey:             autoTagConfig.TagKey,
+			Labels:             autoTagConfig.Labels,
+		})
+	}
 	return &entity.TaskConfig{
 		AutoEvaluateConfigs: autoEvaluateConfigs,
 		DataReflowConfig:    dataReflowConfigs,
+		AutoTagConfigs:      autoTagConfigs,
 	}
 }
 
@@ -181,6 +181,8 @@ func AnnotationListDO2DTO(
 		case loop_span.AnnotationTypeCozeFeedback:
 			fallthrough
 		case loop_span.AnnotationTypeOpenAPIFeedback:
+			fallthrough
+		case loop_span.AnnotationTypeAutoTag:
 			ret = append(ret, AnnotationDO2DTO(a, userMap, evalMap, tagMap))
 		default:
 			continue
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	commdto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	retentiondto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/retention"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func RetentionPolicyDTO2DO(workspaceID int64, policy *retentiondto.RetentionPolicy) *entity.RetentionPolicy {
+	if policy == nil {
+		return nil
+	}
+	ret := &entity.RetentionPolicy{
+		WorkspaceID:           workspaceID,
+		DefaultTTL:            loop_span.TTL(policy.GetDefaultTTL()),
+		KeepAnnotated:         policy.GetKeepAnnotated(),
+		KeepDatasetReferenced: policy.GetKeepDatasetReferenced(),
+		ArchivePermanently:    policy.GetArchivePermanently(),
+	}
+	for _, rule := range policy.GetRules() {
+		if rule == nil {
+			continue
+		}
+		ret.Rules = append(ret.Rules, &entity.RetentionRule{
+			SpanTypes: rule.GetSpanTypes(),
+			TTL:       loop_span.TTL(rule.GetTTL()),
+		})
+	}
+	return ret
+}
+
+func RetentionPolicyDO2DTO(policy *entity.RetentionPolicy) *retentiondto.RetentionPolicy {
+	if policy == nil {
+		return nil
+	}
+	ret := &retentiondto.RetentionPolicy{
+		KeepAnnotated:         ptr.Of(policy.KeepAnnotated),
+		KeepDatasetReferenced: ptr.Of(policy.KeepDatasetReferenced),
+		ArchivePermanently:    ptr.Of(policy.ArchivePermanently),
+		BaseInfo: &commdto.BaseInfo{
+			CreatedBy: &commdto.UserInfo{UserID: ptr.Of(policy.CreatedBy)},
+			UpdatedBy: &commdto.UserInfo{UserID: ptr.Of(policy.UpdatedBy)},
+			CreatedAt: ptr.Of(policy.CreatedAt.UnixMilli()),
+			UpdatedAt: ptr.Of(policy.UpdatedAt.UnixMilli()),
+		},
+	}
+	if policy.DefaultTTL != "" {
+		ret.DefaultTTL = ptr.Of(retentiondto.TTL(policy.DefaultTTL))
+	}
+	for _, rule := range policy.Rules {
+		ret.Rules = append(ret.Rules, &retentiondto.RetentionRule{
+			SpanTypes: rule.SpanTypes,
+			TTL:       retentiondto.TTL(rule.TTL),
+		})
+	}
+	return ret
+}
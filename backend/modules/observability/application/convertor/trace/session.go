@@ -0,0 +1,50 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	sessiondto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/session"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+func SessionsDO2DTO(sessions []*loop_span.Session) []*sessiondto.Session {
+	ret := make([]*sessiondto.Session, 0, len(sessions))
+	for _, s := range sessions {
+		if s == nil {
+			continue
+		}
+		ret = append(ret, SessionDO2DTO(s))
+	}
+	return ret
+}
+
+func SessionDO2DTO(s *loop_span.Session) *sessiondto.Session {
+	ret := &sessiondto.Session{
+		SessionID:    s.SessionID,
+		TurnCount:    s.TurnCount,
+		InputTokens:  s.InputTokens,
+		OutputTokens: s.OutputTokens,
+		Latency:      s.Latency,
+		StartTime:    s.StartTime,
+		EndTime:      s.EndTime,
+	}
+	if s.Turns != nil {
+		ret.Turns = make([]*sessiondto.SessionTurn, 0, len(s.Turns))
+		for i, turn := range s.Turns {
+			t := &sessiondto.SessionTurn{
+				TraceID:      turn.TraceID,
+				Turn:         int32(i + 1),
+				StartTime:    turn.StartTime,
+				Latency:      turn.Latency,
+				InputTokens:  turn.InputTokens,
+				OutputTokens: turn.OutputTokens,
+			}
+			if turn.RootSpan != nil {
+				t.RootSpan = SpanDO2DTO(turn.RootSpan, nil, nil, nil, false)
+			}
+			ret.Turns = append(ret.Turns, t)
+		}
+	}
+	return ret
+}
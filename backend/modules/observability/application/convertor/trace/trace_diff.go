@@ -0,0 +1,81 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	diffdto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/trace_diff"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TraceDiffSummaryDO2DTO(summary *loop_span.TraceDiffSummary) *diffdto.TraceDiffSummary {
+	if summary == nil {
+		return diffdto.NewTraceDiffSummary()
+	}
+	return &diffdto.TraceDiffSummary{
+		AddedSpans:        summary.AddedSpans,
+		RemovedSpans:      summary.RemovedSpans,
+		ChangedSpans:      summary.ChangedSpans,
+		ChangedToolCalls:  summary.ChangedToolCalls,
+		LatencyDelta:      summary.LatencyDelta,
+		InputTokensDelta:  summary.InputTokensDelta,
+		OutputTokensDelta: summary.OutputTokensDelta,
+	}
+}
+
+func SpanDiffNodesDO2DTO(nodes []*loop_span.SpanDiffNode) []*diffdto.SpanDiffNode {
+	ret := make([]*diffdto.SpanDiffNode, 0, len(nodes))
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		ret = append(ret, SpanDiffNodeDO2DTO(node))
+	}
+	return ret
+}
+
+func SpanDiffNodeDO2DTO(node *loop_span.SpanDiffNode) *diffdto.SpanDiffNode {
+	span := node.Span()
+	ret := &diffdto.SpanDiffNode{
+		SpanName:      span.SpanName,
+		SpanType:      span.SpanType,
+		DiffType:      diffdto.DiffType(node.DiffType),
+		ChangedFields: node.ChangedFields,
+		InputDiff:     textDiffLinesDO2DTO(node.InputDiff),
+		OutputDiff:    textDiffLinesDO2DTO(node.OutputDiff),
+	}
+	if node.Base != nil {
+		ret.BaseSpanID = ptr.Of(node.Base.SpanID)
+		ret.BaseDuration = ptr.Of(node.Base.DurationMicros / 1000)
+	}
+	if node.Compare != nil {
+		ret.CompareSpanID = ptr.Of(node.Compare.SpanID)
+		ret.CompareDuration = ptr.Of(node.Compare.DurationMicros / 1000)
+	}
+	if latency, ok := node.LatencyDelta(); ok {
+		ret.LatencyDelta = ptr.Of(latency)
+	}
+	if in, out, ok := node.TokensDelta(); ok {
+		ret.InputTokensDelta = ptr.Of(in)
+		ret.OutputTokensDelta = ptr.Of(out)
+	}
+	if len(node.Children) > 0 {
+		ret.Children = SpanDiffNodesDO2DTO(node.Children)
+	}
+	return ret
+}
+
+func textDiffLinesDO2DTO(lines []*loop_span.TextDiffLine) []*diffdto.TextDiffLine {
+	if len(lines) == 0 {
+		return nil
+	}
+	ret := make([]*diffdto.TextDiffLine, 0, len(lines))
+	for _, line := range lines {
+		ret = append(ret, &diffdto.TextDiffLine{
+			Op:   diffdto.TextDiffOp(line.Op),
+			Text: line.Text,
+		})
+	}
+	return ret
+}
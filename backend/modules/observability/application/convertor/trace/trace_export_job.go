@@ -0,0 +1,47 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	commdto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/trace_export"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TraceExportJobDO2DTO(job *entity.TraceExportJob) *trace_export.TraceExportJob {
+	if job == nil {
+		return nil
+	}
+	ret := &trace_export.TraceExportJob{
+		ID:            job.ID,
+		WorkspaceID:   job.WorkspaceID,
+		Format:        trace_export.ExportFileFormat(job.Format),
+		Status:        trace_export.ExportJobStatus(job.Status),
+		StartTime:     job.StartTime,
+		EndTime:       job.EndTime,
+		Filters:       FilterFieldsDO2DTO(job.Filters),
+		ExportedCount: job.ExportedCount,
+		Truncated:     ptr.Of(job.Truncated),
+		BaseInfo: &commdto.BaseInfo{
+			CreatedBy: &commdto.UserInfo{UserID: ptr.Of(job.CreatedBy)},
+			UpdatedBy: &commdto.UserInfo{UserID: ptr.Of(job.UpdatedBy)},
+			CreatedAt: ptr.Of(job.CreatedAt.UnixMilli()),
+			UpdatedAt: ptr.Of(job.UpdatedAt.UnixMilli()),
+		},
+	}
+	if job.PlatformType != "" {
+		ret.PlatformType = ptr.Of(commdto.PlatformType(job.PlatformType))
+	}
+	if job.SpanListType != "" {
+		ret.SpanListType = ptr.Of(commdto.SpanListType(job.SpanListType))
+	}
+	if job.DownloadURL != "" {
+		ret.DownloadURL = ptr.Of(job.DownloadURL)
+	}
+	if job.ErrorMsg != "" {
+		ret.ErrorMsg = ptr.Of(job.ErrorMsg)
+	}
+	return ret
+}
@@ -97,6 +97,7 @@ func ModelInfoDO2DTO(info *loop_span.ModelInfo) *dtotrajectory.ModelInfo {
 		ReasoningTokens:           int64Ptr2int32Ptr(&info.ReasoningTokens),
 		InputReadCachedTokens:     int64Ptr2int32Ptr(&info.InputReadCachedTokens),
 		InputCreationCachedTokens: int64Ptr2int32Ptr(&info.InputCreationCachedTokens),
+		Cost:                      info.Cost,
 	}
 }
 
@@ -137,6 +138,7 @@ func MetricsInfoDO2DTO(info *loop_span.MetricsInfo) *dtotrajectory.MetricsInfo {
 		ToolStepProportion: info.ToolStepProportion,
 		InputTokens:        info.InputTokens,
 		OutputTokens:       info.OutputTokens,
+		TotalCost:          info.TotalCost,
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
 	metric2 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/metric"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/metric"
 	mconv "github.com/coze-dev/coze-loop/backend/modules/observability/application/convertor/metric"
@@ -33,20 +34,26 @@ type IMetricApplication interface {
 }
 
 type MetricApplication struct {
-	metricService  service.IMetricsService
-	tenantProvider tenant.ITenantProvider
-	authSvc        rpc.IAuthProvider
+	metricService    service.IMetricsService
+	alertService     service.IAlertService
+	dashboardService service.IDashboardService
+	tenantProvider   tenant.ITenantProvider
+	authSvc          rpc.IAuthProvider
 }
 
 func NewMetricApplication(
 	metricService service.IMetricsService,
+	alertService service.IAlertService,
+	dashboardService service.IDashboardService,
 	tenantProvider tenant.ITenantProvider,
 	authSvc rpc.IAuthProvider,
 ) (IMetricApplication, error) {
 	return &MetricApplication{
-		metricService:  metricService,
-		tenantProvider: tenantProvider,
-		authSvc:        authSvc,
+		metricService:    metricService,
+		alertService:     alertService,
+		dashboardService: dashboardService,
+		tenantProvider:   tenantProvider,
+		authSvc:          authSvc,
 	}, nil
 }
 
@@ -107,18 +114,15 @@ func (m *MetricApplication) GetMetrics(ctx context.Context, req *metric.GetMetri
 }
 
 func (m *MetricApplication) validateGetMetricsReq(ctx context.Context, req *metric.GetMetricsRequest) error {
-	if req.StartTime > req.EndTime {
+	return m.validateTimeRange(req.StartTime, req.EndTime, entity.MetricGranularity(req.GetGranularity()))
+}
+
+func (m *MetricApplication) validateTimeRange(startTime, endTime int64, granularity entity.MetricGranularity) error {
+	if startTime > endTime {
 		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("start_time cannot be greater than end_time"))
 	}
-	switch entity.MetricGranularity(req.GetGranularity()) {
-	case entity.MetricGranularity1Min:
-		if req.EndTime-req.StartTime > 3*time.Hour.Milliseconds() {
-			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid granularity"))
-		}
-	case entity.MetricGranularity1Hour:
-		if req.EndTime-req.StartTime > 6*24*time.Hour.Milliseconds() {
-			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid granularity"))
-		}
+	if err := entity.CheckGranularityRange(startTime, endTime, granularity); err != nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid granularity"))
 	}
 	return nil
 }
@@ -247,6 +251,239 @@ func (m *MetricApplication) TraverseMetrics(ctx context.Context, req *metric.Tra
 	}, nil
 }
 
+func (m *MetricApplication) QueryAggregations(ctx context.Context, req *metric.QueryAggregationsRequest) (*metric.QueryAggregationsResponse, error) {
+	granularity := entity.MetricGranularity(req.GetGranularity())
+	if err := m.validateTimeRange(req.GetStartTime(), req.GetEndTime(), granularity); err != nil {
+		return nil, err
+	}
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricRead,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	sResp, err := m.metricService.QueryAggregations(ctx, &service.QueryAggregationsReq{
+		PlatformType: loop_span.PlatformType(req.GetPlatformType()),
+		WorkspaceID:  req.GetWorkspaceID(),
+		Aggregations: mconv.AggregationListDTO2DO(req.GetAggregations()),
+		GroupBys:     tconv.FilterFieldListDTO2DO(req.GetGroupBys()),
+		FilterFields: tconv.FilterFieldsDTO2DO(req.Filters),
+		Granularity:  granularity,
+		StartTime:    req.GetStartTime(),
+		EndTime:      req.GetEndTime(),
+		Limit:        req.GetLimit(),
+	})
+	if err != nil {
+		logs.CtxError(ctx, "fail to query aggregations, %v", err)
+		return nil, err
+	}
+	return &metric.QueryAggregationsResponse{
+		Rows: mconv.AggregationRowListDO2DTO(sResp.Rows),
+	}, nil
+}
+
+func (m *MetricApplication) CreateAlertRule(ctx context.Context, req *metric.CreateAlertRuleRequest) (*metric.CreateAlertRuleResponse, error) {
+	if req == nil || req.Rule == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no alert rule provided"))
+	}
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	if userID == "" {
+		return nil, errorx.NewByCode(obErrorx.UserParseFailedCode)
+	}
+	rule := mconv.AlertRuleDTO2DO(req.GetWorkspaceID(), req.Rule)
+	rule.CreatedBy = userID
+	rule.UpdatedBy = userID
+	id, err := m.alertService.CreateAlertRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	return &metric.CreateAlertRuleResponse{ID: id}, nil
+}
+
+func (m *MetricApplication) UpdateAlertRule(ctx context.Context, req *metric.UpdateAlertRuleRequest) (*metric.UpdateAlertRuleResponse, error) {
+	if req == nil || req.Rule == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no alert rule provided"))
+	}
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	if userID == "" {
+		return nil, errorx.NewByCode(obErrorx.UserParseFailedCode)
+	}
+	rule := mconv.AlertRuleDTO2DO(req.GetWorkspaceID(), req.Rule)
+	rule.ID = req.GetID()
+	rule.UpdatedBy = userID
+	if err := m.alertService.UpdateAlertRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return metric.NewUpdateAlertRuleResponse(), nil
+}
+
+func (m *MetricApplication) DeleteAlertRule(ctx context.Context, req *metric.DeleteAlertRuleRequest) (*metric.DeleteAlertRuleResponse, error) {
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	if err := m.alertService.DeleteAlertRule(ctx, req.GetWorkspaceID(), req.GetID()); err != nil {
+		return nil, err
+	}
+	return metric.NewDeleteAlertRuleResponse(), nil
+}
+
+func (m *MetricApplication) ListAlertRules(ctx context.Context, req *metric.ListAlertRulesRequest) (*metric.ListAlertRulesResponse, error) {
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricRead,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	sResp, err := m.alertService.ListAlertRules(ctx, &service.ListAlertRulesReq{
+		WorkspaceID: req.GetWorkspaceID(),
+		MetricName:  req.GetMetricName(),
+		Status:      entity.AlertStatus(req.GetStatus()),
+		PageSize:    req.GetPageSize(),
+		PageToken:   req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &metric.ListAlertRulesResponse{
+		Rules:   mconv.AlertRuleListDO2DTO(sResp.Rules),
+		HasMore: ptr.Of(sResp.HasMore),
+	}
+	if sResp.NextPageToken != "" {
+		resp.NextPageToken = ptr.Of(sResp.NextPageToken)
+	}
+	return resp, nil
+}
+
+func (m *MetricApplication) CreateDashboard(ctx context.Context, req *metric.CreateDashboardRequest) (*metric.CreateDashboardResponse, error) {
+	if req == nil || req.Dashboard == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no dashboard provided"))
+	}
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	if userID == "" {
+		return nil, errorx.NewByCode(obErrorx.UserParseFailedCode)
+	}
+	dashboard := mconv.DashboardDTO2DO(req.GetWorkspaceID(), req.Dashboard)
+	dashboard.CreatedBy = userID
+	dashboard.UpdatedBy = userID
+	id, err := m.dashboardService.CreateDashboard(ctx, dashboard)
+	if err != nil {
+		return nil, err
+	}
+	return &metric.CreateDashboardResponse{ID: id}, nil
+}
+
+func (m *MetricApplication) UpdateDashboard(ctx context.Context, req *metric.UpdateDashboardRequest) (*metric.UpdateDashboardResponse, error) {
+	if req == nil || req.Dashboard == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no dashboard provided"))
+	}
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	if userID == "" {
+		return nil, errorx.NewByCode(obErrorx.UserParseFailedCode)
+	}
+	dashboard := mconv.DashboardDTO2DO(req.GetWorkspaceID(), req.Dashboard)
+	dashboard.ID = req.GetID()
+	dashboard.UpdatedBy = userID
+	if err := m.dashboardService.UpdateDashboard(ctx, dashboard); err != nil {
+		return nil, err
+	}
+	return metric.NewUpdateDashboardResponse(), nil
+}
+
+func (m *MetricApplication) DeleteDashboard(ctx context.Context, req *metric.DeleteDashboardRequest) (*metric.DeleteDashboardResponse, error) {
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	if err := m.dashboardService.DeleteDashboard(ctx, req.GetWorkspaceID(), req.GetID()); err != nil {
+		return nil, err
+	}
+	return metric.NewDeleteDashboardResponse(), nil
+}
+
+func (m *MetricApplication) GetDashboard(ctx context.Context, req *metric.GetDashboardRequest) (*metric.GetDashboardResponse, error) {
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricRead,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	dashboard, err := m.dashboardService.GetDashboard(ctx, req.GetWorkspaceID(), req.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return &metric.GetDashboardResponse{Dashboard: mconv.DashboardDO2DTO(dashboard)}, nil
+}
+
+func (m *MetricApplication) ListDashboards(ctx context.Context, req *metric.ListDashboardsRequest) (*metric.ListDashboardsResponse, error) {
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricRead,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	sResp, err := m.dashboardService.ListDashboards(ctx, &service.ListDashboardsReq{
+		WorkspaceID: req.GetWorkspaceID(),
+		PageSize:    req.GetPageSize(),
+		PageToken:   req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &metric.ListDashboardsResponse{
+		Dashboards: mconv.DashboardListDO2DTO(sResp.Dashboards),
+		HasMore:    ptr.Of(sResp.HasMore),
+	}
+	if sResp.NextPageToken != "" {
+		resp.NextPageToken = ptr.Of(sResp.NextPageToken)
+	}
+	return resp, nil
+}
+
+func (m *MetricApplication) RenderDashboard(ctx context.Context, req *metric.RenderDashboardRequest) (*metric.RenderDashboardResponse, error) {
+	if err := m.validateTimeRange(req.GetStartTime(), req.GetEndTime(), ""); err != nil {
+		return nil, err
+	}
+	if err := m.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceMetricRead,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	sResp, err := m.dashboardService.RenderDashboard(ctx, &service.RenderDashboardReq{
+		WorkspaceID:  req.GetWorkspaceID(),
+		DashboardID:  req.GetID(),
+		StartTime:    req.GetStartTime(),
+		EndTime:      req.GetEndTime(),
+		FilterFields: tconv.FilterFieldsDTO2DO(req.Filters),
+		PanelIDs:     req.GetPanelIds(),
+	})
+	if err != nil {
+		logs.CtxError(ctx, "fail to render dashboard %d, %v", req.GetID(), err)
+		return nil, err
+	}
+	return &metric.RenderDashboardResponse{
+		Panels: mconv.RenderedPanelListDO2DTO(sResp.Panels),
+	}, nil
+}
+
 type drillDownNode struct {
 	Key      string
 	Value    string
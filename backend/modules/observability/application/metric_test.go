@@ -10,7 +10,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	alertdto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/alert"
 	commondto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/common"
+	dashboarddto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dashboard"
+	filterdto "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
 	metricpb "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/metric"
 	metricapi "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/metric"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
@@ -19,6 +23,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service"
 	metricservicemock "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -681,3 +686,208 @@ func TestMetricApplication_validateGetDrillDownValuesReq(t *testing.T) {
 		})
 	}
 }
+
+func TestMetricApplication_QueryAggregations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		metricMock := metricservicemock.NewMockIMetricsService(ctrl)
+		authMock := rpcmock.NewMockIAuthProvider(ctrl)
+		authMock.EXPECT().CheckWorkspacePermission(gomock.Any(), rpc.AuthActionTraceMetricRead, "1", false).Return(nil)
+		metricMock.EXPECT().QueryAggregations(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *service.QueryAggregationsReq) (*service.QueryAggregationsResp, error) {
+				assert.Equal(t, int64(1), req.WorkspaceID)
+				assert.Equal(t, int32(50), req.Limit)
+				assert.Equal(t, entity.MetricGranularity1Hour, req.Granularity)
+				if assert.Len(t, req.Aggregations, 1) {
+					assert.Equal(t, entity.AggregationTypeP90, req.Aggregations[0].Type)
+					assert.Equal(t, "latency", req.Aggregations[0].Alias)
+					assert.Equal(t, loop_span.FieldTypeLong, req.Aggregations[0].Field.FieldType)
+				}
+				if assert.Len(t, req.GroupBys, 1) {
+					assert.Equal(t, "model_name", req.GroupBys[0].FieldName)
+				}
+				return &service.QueryAggregationsResp{
+					Rows: []*entity.AggregationRow{{
+						Timestamp:     "3600000",
+						GroupByValues: map[string]string{"model_name": "gpt-4o"},
+						Values:        map[string]string{"latency": "800"},
+					}},
+				}, nil
+			})
+		app := &MetricApplication{metricService: metricMock, authSvc: authMock}
+		resp, err := app.QueryAggregations(context.Background(), &metricapi.QueryAggregationsRequest{
+			WorkspaceID: 1,
+			StartTime:   0,
+			EndTime:     time.Hour.Milliseconds(),
+			Aggregations: []*metricpb.Aggregation{{
+				AggregationType: metricpb.AggregationTypeP90,
+				Field:           &filterdto.FilterField{FieldName: ptr.Of("duration"), FieldType: ptr.Of(filterdto.FieldTypeLong)},
+				Alias:           ptr.Of("latency"),
+			}},
+			GroupBys:    []*filterdto.FilterField{{FieldName: ptr.Of("model_name"), FieldType: ptr.Of(filterdto.FieldTypeString)}},
+			Granularity: ptr.Of(string(entity.MetricGranularity1Hour)),
+			Limit:       ptr.Of(int32(50)),
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, resp.Rows, 1) {
+			assert.Equal(t, "3600000", resp.Rows[0].GetTimestamp())
+			assert.Equal(t, "800", resp.Rows[0].Values["latency"])
+			assert.Equal(t, "gpt-4o", resp.Rows[0].GroupByValues["model_name"])
+		}
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		t.Parallel()
+		app := &MetricApplication{}
+		_, err := app.QueryAggregations(context.Background(), &metricapi.QueryAggregationsRequest{
+			WorkspaceID: 1,
+			StartTime:   2000,
+			EndTime:     1000,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		authMock := rpcmock.NewMockIAuthProvider(ctrl)
+		authMock.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(assert.AnError)
+		app := &MetricApplication{authSvc: authMock}
+		_, err := app.QueryAggregations(context.Background(), &metricapi.QueryAggregationsRequest{
+			WorkspaceID: 1,
+			EndTime:     1000,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestMetricApplication_CreateAlertRule(t *testing.T) {
+	t.Parallel()
+	req := &metricapi.CreateAlertRuleRequest{
+		WorkspaceID: 2,
+		Rule: &alertdto.AlertRule{
+			Name:          "model error spike",
+			MetricName:    entity.MetricNameModelTotalErrorCount,
+			WindowSeconds: 300,
+			Condition: &alertdto.AlertCondition{
+				Type:      alertdto.ConditionTypeThreshold,
+				Operator:  alertdto.CompareOperatorGT,
+				Threshold: ptr.Of(10.0),
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		authSvc := rpcmock.NewMockIAuthProvider(ctrl)
+		authSvc.EXPECT().CheckWorkspacePermission(gomock.Any(), rpc.AuthActionTraceMetricEdit, "2", false).Return(nil)
+		alertSvc := metricservicemock.NewMockIAlertService(ctrl)
+		alertSvc.EXPECT().CreateAlertRule(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, rule *entity.AlertRule) (int64, error) {
+			assert.Equal(t, int64(2), rule.WorkspaceID)
+			assert.Equal(t, "uid", rule.CreatedBy)
+			assert.True(t, rule.Enabled)
+			assert.Equal(t, entity.AlertConditionTypeThreshold, rule.Condition.Type)
+			assert.Equal(t, 10.0, rule.Condition.Threshold)
+			return 100, nil
+		})
+		app := &MetricApplication{alertService: alertSvc, authSvc: authSvc}
+		resp, err := app.CreateAlertRule(session.WithCtxUser(context.Background(), &session.User{ID: "uid"}), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), resp.GetID())
+	})
+
+	t.Run("no user", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		authSvc := rpcmock.NewMockIAuthProvider(ctrl)
+		authSvc.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		app := &MetricApplication{authSvc: authSvc}
+		_, err := app.CreateAlertRule(context.Background(), req)
+		assert.Error(t, err)
+	})
+}
+
+func TestMetricApplication_CreateDashboard(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	authSvc := rpcmock.NewMockIAuthProvider(ctrl)
+	authSvc.EXPECT().CheckWorkspacePermission(gomock.Any(), rpc.AuthActionTraceMetricEdit, "2", false).Return(nil)
+	dashboardSvc := metricservicemock.NewMockIDashboardService(ctrl)
+	dashboardSvc.EXPECT().CreateDashboard(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, d *entity.Dashboard) (int64, error) {
+		assert.Equal(t, int64(2), d.WorkspaceID)
+		assert.Equal(t, "uid", d.CreatedBy)
+		if assert.Len(t, d.Panels, 1) {
+			assert.Equal(t, entity.ChartTypeNumber, d.Panels[0].ChartType)
+			assert.Equal(t, int32(4), d.Panels[0].Layout.Width)
+		}
+		return 100, nil
+	})
+	app := &MetricApplication{dashboardService: dashboardSvc, authSvc: authSvc}
+	resp, err := app.CreateDashboard(session.WithCtxUser(context.Background(), &session.User{ID: "uid"}), &metricapi.CreateDashboardRequest{
+		WorkspaceID: 2,
+		Dashboard: &dashboarddto.Dashboard{
+			Name: "overview",
+			Panels: []*dashboarddto.DashboardPanel{{
+				ID:          "p1",
+				Title:       "errors",
+				ChartType:   dashboarddto.ChartTypeNumber,
+				MetricNames: []string{entity.MetricNameModelTotalErrorCount},
+				Layout:      &dashboarddto.PanelLayout{Width: ptr.Of(int32(4))},
+			}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), resp.GetID())
+}
+
+func TestMetricApplication_RenderDashboard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		authSvc := rpcmock.NewMockIAuthProvider(ctrl)
+		authSvc.EXPECT().CheckWorkspacePermission(gomock.Any(), rpc.AuthActionTraceMetricRead, "2", false).Return(nil)
+		dashboardSvc := metricservicemock.NewMockIDashboardService(ctrl)
+		dashboardSvc.EXPECT().RenderDashboard(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, req *service.RenderDashboardReq) (*service.RenderDashboardResp, error) {
+				assert.Equal(t, int64(1), req.DashboardID)
+				assert.Equal(t, []string{"p1", "p2"}, req.PanelIDs)
+				return &service.RenderDashboardResp{Panels: []*entity.RenderedPanel{
+					{PanelID: "p1", Metrics: map[string]*entity.Metric{"m": {Summary: "3"}}},
+					{PanelID: "p2", Error: "query failed"},
+				}}, nil
+			})
+		app := &MetricApplication{dashboardService: dashboardSvc, authSvc: authSvc}
+		resp, err := app.RenderDashboard(context.Background(), &metricapi.RenderDashboardRequest{
+			ID:          1,
+			WorkspaceID: 2,
+			EndTime:     time.Hour.Milliseconds(),
+			PanelIds:    []string{"p1", "p2"},
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, resp.Panels, 2) {
+			assert.Equal(t, "3", resp.Panels[0].Metrics["m"].GetSummary())
+			assert.Equal(t, "query failed", resp.Panels[1].GetErrorMsg())
+			assert.Nil(t, resp.Panels[1].Metrics)
+		}
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		t.Parallel()
+		app := &MetricApplication{}
+		_, err := app.RenderDashboard(context.Background(), &metricapi.RenderDashboardRequest{
+			ID:          1,
+			WorkspaceID: 2,
+			StartTime:   2000,
+			EndTime:     1000,
+		})
+		assert.Error(t, err)
+	})
+}
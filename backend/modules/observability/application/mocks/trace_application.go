@@ -132,6 +132,21 @@ func (mr *MockITraceApplicationMockRecorder) DeleteView(ctx, req any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteView", reflect.TypeOf((*MockITraceApplication)(nil).DeleteView), ctx, req)
 }
 
+// DiffTraces mocks base method.
+func (m *MockITraceApplication) DiffTraces(ctx context.Context, req *trace.DiffTracesRequest) (*trace.DiffTracesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffTraces", ctx, req)
+	ret0, _ := ret[0].(*trace.DiffTracesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffTraces indicates an expected call of DiffTraces.
+func (mr *MockITraceApplicationMockRecorder) DiffTraces(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffTraces", reflect.TypeOf((*MockITraceApplication)(nil).DiffTraces), ctx, req)
+}
+
 // ExportTracesToDataset mocks base method.
 func (m *MockITraceApplication) ExportTracesToDataset(ctx context.Context, req *trace.ExportTracesToDatasetRequest) (*trace.ExportTracesToDatasetResponse, error) {
 	m.ctrl.T.Helper()
@@ -147,6 +162,21 @@ func (mr *MockITraceApplicationMockRecorder) ExportTracesToDataset(ctx, req any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTracesToDataset", reflect.TypeOf((*MockITraceApplication)(nil).ExportTracesToDataset), ctx, req)
 }
 
+// ExportTracesToFile mocks base method.
+func (m *MockITraceApplication) ExportTracesToFile(ctx context.Context, req *trace.ExportTracesToFileRequest) (*trace.ExportTracesToFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTracesToFile", ctx, req)
+	ret0, _ := ret[0].(*trace.ExportTracesToFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportTracesToFile indicates an expected call of ExportTracesToFile.
+func (mr *MockITraceApplicationMockRecorder) ExportTracesToFile(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTracesToFile", reflect.TypeOf((*MockITraceApplication)(nil).ExportTracesToFile), ctx, req)
+}
+
 // ExtractSpanInfo mocks base method.
 func (m *MockITraceApplication) ExtractSpanInfo(ctx context.Context, req *trace.ExtractSpanInfoRequest) (*trace.ExtractSpanInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +206,36 @@ func (mr *MockITraceApplicationMockRecorder) GetDisplayInfo(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisplayInfo", reflect.TypeOf((*MockITraceApplication)(nil).GetDisplayInfo), arg0, arg1)
 }
 
+// GetRetentionPolicy mocks base method.
+func (m *MockITraceApplication) GetRetentionPolicy(ctx context.Context, req *trace.GetRetentionPolicyRequest) (*trace.GetRetentionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRetentionPolicy", ctx, req)
+	ret0, _ := ret[0].(*trace.GetRetentionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRetentionPolicy indicates an expected call of GetRetentionPolicy.
+func (mr *MockITraceApplicationMockRecorder) GetRetentionPolicy(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetentionPolicy", reflect.TypeOf((*MockITraceApplication)(nil).GetRetentionPolicy), ctx, req)
+}
+
+// GetSession mocks base method.
+func (m *MockITraceApplication) GetSession(ctx context.Context, req *trace.GetSessionRequest) (*trace.GetSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, req)
+	ret0, _ := ret[0].(*trace.GetSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockITraceApplicationMockRecorder) GetSession(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockITraceApplication)(nil).GetSession), ctx, req)
+}
+
 // GetTrace mocks base method.
 func (m *MockITraceApplication) GetTrace(ctx context.Context, req *trace.GetTraceRequest) (*trace.GetTraceResponse, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +251,21 @@ func (mr *MockITraceApplicationMockRecorder) GetTrace(ctx, req any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrace", reflect.TypeOf((*MockITraceApplication)(nil).GetTrace), ctx, req)
 }
 
+// GetTraceExportJob mocks base method.
+func (m *MockITraceApplication) GetTraceExportJob(ctx context.Context, req *trace.GetTraceExportJobRequest) (*trace.GetTraceExportJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraceExportJob", ctx, req)
+	ret0, _ := ret[0].(*trace.GetTraceExportJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTraceExportJob indicates an expected call of GetTraceExportJob.
+func (mr *MockITraceApplicationMockRecorder) GetTraceExportJob(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraceExportJob", reflect.TypeOf((*MockITraceApplication)(nil).GetTraceExportJob), ctx, req)
+}
+
 // GetTracesMetaInfo mocks base method.
 func (m *MockITraceApplication) GetTracesMetaInfo(ctx context.Context, req *trace.GetTracesMetaInfoRequest) (*trace.GetTracesMetaInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +356,21 @@ func (mr *MockITraceApplicationMockRecorder) ListPreSpan(ctx, req any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPreSpan", reflect.TypeOf((*MockITraceApplication)(nil).ListPreSpan), ctx, req)
 }
 
+// ListSessions mocks base method.
+func (m *MockITraceApplication) ListSessions(ctx context.Context, req *trace.ListSessionsRequest) (*trace.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, req)
+	ret0, _ := ret[0].(*trace.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockITraceApplicationMockRecorder) ListSessions(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockITraceApplication)(nil).ListSessions), ctx, req)
+}
+
 // ListSpans mocks base method.
 func (m *MockITraceApplication) ListSpans(ctx context.Context, req *trace.ListSpansRequest) (*trace.ListSpansResponse, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +476,21 @@ func (mr *MockITraceApplicationMockRecorder) UpdateView(ctx, req any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateView", reflect.TypeOf((*MockITraceApplication)(nil).UpdateView), ctx, req)
 }
 
+// UpsertRetentionPolicy mocks base method.
+func (m *MockITraceApplication) UpsertRetentionPolicy(ctx context.Context, req *trace.UpsertRetentionPolicyRequest) (*trace.UpsertRetentionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRetentionPolicy", ctx, req)
+	ret0, _ := ret[0].(*trace.UpsertRetentionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertRetentionPolicy indicates an expected call of UpsertRetentionPolicy.
+func (mr *MockITraceApplicationMockRecorder) UpsertRetentionPolicy(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRetentionPolicy", reflect.TypeOf((*MockITraceApplication)(nil).UpsertRetentionPolicy), ctx, req)
+}
+
 // UpsertTrajectoryConfig mocks base method.
 func (m *MockITraceApplication) UpsertTrajectoryConfig(ctx context.Context, req *trace.UpsertTrajectoryConfigRequest) (*trace.UpsertTrajectoryConfigResponse, error) {
 	m.ctrl.T.Helper()
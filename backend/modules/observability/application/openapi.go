@@ -51,9 +51,15 @@ type IAnnotationQueueConsumer interface {
 	Send(context.Context, *entity.AnnotationEvent) error
 }
 
+// IOtelTraceReceiver 接收OTLP/gRPC协议上报的trace
+type IOtelTraceReceiver interface {
+	OtelExportTraces(ctx context.Context, workspaceID string, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error)
+}
+
 type IObservabilityOpenAPIApplication interface {
 	openapi.OpenAPIService
 	IAnnotationQueueConsumer
+	IOtelTraceReceiver
 }
 
 func NewOpenAPIApplication(
@@ -235,7 +241,30 @@ func (o *OpenAPIApplication) OtelIngestTraces(ctx context.Context, req *openapi.
 	if err != nil {
 		return nil, err
 	}
-	spansMap := o.unpackOtelSpace(ctx, req.WorkspaceID, reqSpanProto)
+	respSpanProto, err := o.ingestOtelSpans(ctx, req.WorkspaceID, reqSpanProto)
+	if err != nil {
+		return nil, err
+	}
+	rawResp, err := proto.Marshal(respSpanProto)
+	if err != nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInternalErrorCodeCode, errorx.WithExtraMsg("proto Marshal err"))
+	}
+	return &openapi.OtelIngestTracesResponse{
+		Body:        rawResp,
+		ContentType: gptr.Of(otel.ContentTypeProtoBuf),
+	}, nil
+}
+
+func (o *OpenAPIApplication) OtelExportTraces(ctx context.Context, workspaceID string, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	if req == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no request provided"))
+	}
+	return o.ingestOtelSpans(ctx, workspaceID, otel.OtelTraceRequestPbToJson(req))
+}
+
+// ingestOtelSpans 按空间拆分otel span并写入，HTTP与gRPC上报共用
+func (o *OpenAPIApplication) ingestOtelSpans(ctx context.Context, outerSpaceID string, reqSpanProto *otel.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	spansMap := o.unpackOtelSpace(ctx, outerSpaceID, reqSpanProto)
 	partialFailSpanNumber := 0
 	partialErrMessage := ""
 	for workspaceId, otelSpans := range spansMap {
@@ -287,19 +316,11 @@ func (o *OpenAPIApplication) OtelIngestTraces(ctx context.Context, req *openapi.
 			}
 		}
 	}
-	respSpanProto := &coltracepb.ExportTraceServiceResponse{
+	return &coltracepb.ExportTraceServiceResponse{
 		PartialSuccess: &coltracepb.ExportTracePartialSuccess{
 			RejectedSpans: int64(partialFailSpanNumber),
 			ErrorMessage:  partialErrMessage,
 		},
-	}
-	rawResp, err := proto.Marshal(respSpanProto)
-	if err != nil {
-		return nil, errorx.NewByCode(obErrorx.CommercialCommonInternalErrorCodeCode, errorx.WithExtraMsg("proto Marshal err"))
-	}
-	return &openapi.OtelIngestTracesResponse{
-		Body:        rawResp,
-		ContentType: gptr.Of(otel.ContentTypeProtoBuf),
 	}, nil
 }
 
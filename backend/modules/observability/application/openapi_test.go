@@ -39,6 +39,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/stretchr/testify/assert"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
 	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 	"google.golang.org/protobuf/proto"
 
@@ -1818,6 +1819,69 @@ func TestOpenAPIApplication_OtelIngestTraces(t *testing.T) {
 	})
 }
 
+func TestOpenAPIApplication_OtelExportTraces(t *testing.T) {
+	newReq := func() *coltracepb.ExportTraceServiceRequest {
+		return &coltracepb.ExportTraceServiceRequest{
+			ResourceSpans: []*tracepb.ResourceSpans{{
+				Resource: &resourcepb.Resource{},
+				ScopeSpans: []*tracepb.ScopeSpans{{
+					Spans: []*tracepb.Span{{
+						TraceId:           []byte("0123456789abcdef"),
+						SpanId:            []byte("01234567"),
+						Name:              "test",
+						StartTimeUnixNano: uint64(time.Now().UnixNano()),
+						EndTimeUnixNano:   uint64(time.Now().UnixNano()),
+					}},
+				}},
+			}},
+		}
+	}
+	newApp := func(ctrl *gomock.Controller, ingestErr error) *OpenAPIApplication {
+		traceServiceMock := servicemocks.NewMockITraceService(ctrl)
+		authMock := rpcmocks.NewMockIAuthProvider(ctrl)
+		benefitMock := benefitmocks.NewMockIBenefitService(ctrl)
+		tenantMock := tenantmocks.NewMockITenantProvider(ctrl)
+		authMock.EXPECT().CheckIngestPermission(gomock.Any(), "123").Return(nil)
+		benefitMock.EXPECT().CheckTraceBenefit(gomock.Any(), gomock.Any()).Return(nil, nil)
+		tenantMock.EXPECT().GetIngestTenant(gomock.Any(), gomock.Any()).Return("tenant1")
+		traceServiceMock.EXPECT().IngestTraces(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, req *service.IngestTracesReq) error {
+				assert.Equal(t, "tenant1", req.Tenant)
+				assert.Len(t, req.Spans, 1)
+				assert.Equal(t, "123", req.Spans[0].WorkspaceID)
+				return ingestErr
+			})
+		return &OpenAPIApplication{
+			traceService: traceServiceMock,
+			auth:         authMock,
+			benefit:      benefitMock,
+			tenant:       tenantMock,
+		}
+	}
+
+	t.Run("successful export", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		resp, err := newApp(ctrl, nil).OtelExportTraces(context.Background(), "123", newReq())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), resp.GetPartialSuccess().GetRejectedSpans())
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		resp, err := newApp(ctrl, assert.AnError).OtelExportTraces(context.Background(), "123", newReq())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), resp.GetPartialSuccess().GetRejectedSpans())
+	})
+
+	t.Run("nil request", func(t *testing.T) {
+		resp, err := (&OpenAPIApplication{}).OtelExportTraces(context.Background(), "123", nil)
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
 func TestOpenAPIApplication_OtelIngestTraces_InvalidCases(t *testing.T) {
 	t.Run("invalid request", func(t *testing.T) {
 		app := &OpenAPIApplication{}
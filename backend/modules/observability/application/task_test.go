@@ -206,6 +206,11 @@ func TestTaskApplication_CreateTask(t *testing.T) {
 	taskForAuth := newValidTask()
 	taskForSvcErr := newValidTask()
 	taskForSuccess := newValidTask()
+	taskForInvalidSampler := newValidTask()
+	taskForInvalidSampler.Rule.Sampler = &taskdto.Sampler{
+		SampleRate:       gptr.Of(0.5),
+		SamplingStrategy: gptr.Of(taskdto.SamplingStrategyStratified),
+	}
 
 	tests := []struct {
 		name          string
@@ -238,6 +243,16 @@ func TestTaskApplication_CreateTask(t *testing.T) {
 				return nil, nil
 			},
 		},
+		{
+			name:          "stratified sampler without field",
+			ctx:           context.Background(),
+			req:           &taskapi.CreateTaskRequest{Task: taskForInvalidSampler},
+			expectResp:    taskapi.NewCreateTaskResponse(),
+			expectErrCode: obErrorx.CommercialCommonInvalidParamCodeCode,
+			fieldsBuilder: func(ctrl *gomock.Controller) (svc.ITaskService, rpc.IAuthProvider) {
+				return nil, nil
+			},
+		},
 		{
 			name:       "auth error",
 			ctx:        ctxWithAppID(1),
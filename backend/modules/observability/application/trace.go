@@ -22,6 +22,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/metrics"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/tenant"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
 	commdo "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/common"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
@@ -314,6 +315,181 @@ func (t *TraceApplication) buildGetTraceSvcReq(req *trace.GetTraceRequest) (*ser
 	return ret, nil
 }
 
+func (t *TraceApplication) DiffTraces(ctx context.Context, req *trace.DiffTracesRequest) (*trace.DiffTracesResponse, error) {
+	if err := t.validateDiffTracesReq(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceRead,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	spans := make([]loop_span.SpanList, 0, 2)
+	for _, q := range []*trace.TraceQueryParams{req.BaseTrace, req.CompareTrace} {
+		sReq, err := t.buildGetTraceSvcReq(&trace.GetTraceRequest{
+			WorkspaceID:  req.GetWorkspaceID(),
+			TraceID:      q.GetTraceID(),
+			StartTime:    q.GetStartTime(),
+			EndTime:      q.GetEndTime(),
+			PlatformType: req.PlatformType,
+		})
+		if err != nil {
+			return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("Diff traces req is invalid"))
+		}
+		sResp, err := t.traceService.GetTrace(ctx, sReq)
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, sResp.Spans)
+	}
+	diff := loop_span.DiffTraces(ctx, spans[0], spans[1])
+	logs.CtxInfo(ctx, "Diff traces successfully, base spans count %d, compare spans count %d", len(spans[0]), len(spans[1]))
+	return &trace.DiffTracesResponse{
+		Nodes:   tconv.SpanDiffNodesDO2DTO(diff.Nodes),
+		Summary: tconv.TraceDiffSummaryDO2DTO(diff.Summary),
+	}, nil
+}
+
+func (t *TraceApplication) validateDiffTracesReq(ctx context.Context, req *trace.DiffTracesRequest) error {
+	if req == nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no request provided"))
+	} else if req.GetWorkspaceID() <= 0 {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid workspace_id"))
+	}
+	for _, q := range []*trace.TraceQueryParams{req.BaseTrace, req.CompareTrace} {
+		if q == nil || q.GetTraceID() == "" {
+			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid trace_id"))
+		}
+		v := utils.DateValidator{
+			Start:        q.GetStartTime(),
+			End:          q.GetEndTime(),
+			EarliestDays: t.traceConfig.GetTraceDataMaxDurationDay(ctx, req.PlatformType),
+		}
+		newStartTime, newEndTime, err := v.CorrectDate()
+		if err != nil {
+			return err
+		}
+		q.SetStartTime(newStartTime)
+		q.SetEndTime(newEndTime)
+	}
+	return nil
+}
+
+func (t *TraceApplication) ListSessions(ctx context.Context, req *trace.ListSessionsRequest) (*trace.ListSessionsResponse, error) {
+	if err := t.validateListSessionsReq(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceRead,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	sReq := &service.ListSessionsReq{
+		WorkspaceID:  req.GetWorkspaceID(),
+		StartTime:    req.GetStartTime(),
+		EndTime:      req.GetEndTime(),
+		Limit:        QueryLimitDefault,
+		PageToken:    req.GetPageToken(),
+		PlatformType: loop_span.PlatformCozeLoop,
+	}
+	if req.PageSize != nil {
+		sReq.Limit = *req.PageSize
+	}
+	if req.PlatformType != nil {
+		sReq.PlatformType = loop_span.PlatformType(req.GetPlatformType())
+	}
+	if req.Filters != nil {
+		sReq.Filters = convertor.FilterFieldsDTO2DO(req.Filters)
+		if err := sReq.Filters.Validate(); err != nil {
+			return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("list sessions req is invalid"))
+		}
+	}
+	sResp, err := t.traceService.ListSessions(ctx, sReq)
+	if err != nil {
+		return nil, err
+	}
+	logs.CtxInfo(ctx, "List sessions successfully, sessions count: %d", len(sResp.Sessions))
+	return &trace.ListSessionsResponse{
+		Sessions:      tconv.SessionsDO2DTO(sResp.Sessions),
+		NextPageToken: sResp.NextPageToken,
+		HasMore:       sResp.HasMore,
+	}, nil
+}
+
+func (t *TraceApplication) validateListSessionsReq(ctx context.Context, req *trace.ListSessionsRequest) error {
+	if req == nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no request provided"))
+	} else if req.GetWorkspaceID() <= 0 {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid workspace_id"))
+	} else if pageSize := req.GetPageSize(); pageSize < 0 || pageSize > MaxListSpansLimit {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid limit"))
+	}
+	v := utils.DateValidator{
+		Start:        req.GetStartTime(),
+		End:          req.GetEndTime(),
+		EarliestDays: t.traceConfig.GetTraceDataMaxDurationDay(ctx, req.PlatformType),
+	}
+	newStartTime, newEndTime, err := v.CorrectDate()
+	if err != nil {
+		return err
+	}
+	req.SetStartTime(newStartTime)
+	req.SetEndTime(newEndTime)
+	return nil
+}
+
+func (t *TraceApplication) GetSession(ctx context.Context, req *trace.GetSessionRequest) (*trace.GetSessionResponse, error) {
+	if err := t.validateGetSessionReq(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceRead,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	sReq := &service.GetSessionReq{
+		WorkspaceID:  req.GetWorkspaceID(),
+		SessionID:    req.GetSessionID(),
+		StartTime:    req.GetStartTime(),
+		EndTime:      req.GetEndTime(),
+		PlatformType: loop_span.PlatformCozeLoop,
+	}
+	if req.PlatformType != nil {
+		sReq.PlatformType = loop_span.PlatformType(req.GetPlatformType())
+	}
+	sResp, err := t.traceService.GetSession(ctx, sReq)
+	if err != nil {
+		return nil, err
+	}
+	logs.CtxInfo(ctx, "Get session successfully, turns count: %d, truncated: %v", sResp.Session.TurnCount, sResp.Truncated)
+	return &trace.GetSessionResponse{
+		Session:   tconv.SessionDO2DTO(sResp.Session),
+		Truncated: ptr.Of(sResp.Truncated),
+	}, nil
+}
+
+func (t *TraceApplication) validateGetSessionReq(ctx context.Context, req *trace.GetSessionRequest) error {
+	if req == nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no request provided"))
+	} else if req.GetWorkspaceID() <= 0 {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid workspace_id"))
+	} else if req.GetSessionID() == "" {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid session_id"))
+	}
+	v := utils.DateValidator{
+		Start:        req.GetStartTime(),
+		End:          req.GetEndTime(),
+		EarliestDays: t.traceConfig.GetTraceDataMaxDurationDay(ctx, req.PlatformType),
+	}
+	newStartTime, newEndTime, err := v.CorrectDate()
+	if err != nil {
+		return err
+	}
+	req.SetStartTime(newStartTime)
+	req.SetEndTime(newEndTime)
+	return nil
+}
+
 func (t *TraceApplication) SearchTraceTree(ctx context.Context, req *trace.SearchTraceTreeRequest) (*trace.SearchTraceTreeResponse, error) {
 	if err := t.validateSearchTraceTreeReq(ctx, req); err != nil {
 		return nil, err
@@ -947,6 +1123,80 @@ func (t *TraceApplication) PreviewExportTracesToDataset(ctx context.Context, req
 	return tconv.PreviewResponseDO2DTO(serviceResp), nil
 }
 
+func (t *TraceApplication) ExportTracesToFile(ctx context.Context, req *trace.ExportTracesToFileRequest) (
+	r *trace.ExportTracesToFileResponse, err error,
+) {
+	if err := req.IsValid(); err != nil {
+		return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInvalidParamCodeCode)
+	} else if req.GetWorkspaceID() <= 0 {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid workspace_id"))
+	} else if !entity.ExportFileFormat(req.GetFormat()).IsValid() {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid format"))
+	}
+	v := utils.DateValidator{
+		Start:        req.GetStartTime(),
+		End:          req.GetEndTime(),
+		EarliestDays: t.traceConfig.GetTraceDataMaxDurationDay(ctx, req.PlatformType),
+	}
+	newStartTime, newEndTime, err := v.CorrectDate()
+	if err != nil {
+		return nil, err
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceExport,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	if userID == "" {
+		return nil, errorx.NewByCode(obErrorx.UserParseFailedCode)
+	}
+	sReq := &service.ExportTracesToFileReq{
+		WorkspaceID:  req.GetWorkspaceID(),
+		StartTime:    newStartTime,
+		EndTime:      newEndTime,
+		PlatformType: loop_span.PlatformCozeLoop,
+		SpanListType: loop_span.SpanListTypeAllSpan,
+		Format:       entity.ExportFileFormat(req.GetFormat()),
+		UserID:       userID,
+	}
+	if req.PlatformType != nil {
+		sReq.PlatformType = loop_span.PlatformType(req.GetPlatformType())
+	}
+	if req.SpanListType != nil {
+		sReq.SpanListType = loop_span.SpanListType(req.GetSpanListType())
+	}
+	if req.Filters != nil {
+		sReq.Filters = convertor.FilterFieldsDTO2DO(req.Filters)
+		if err := sReq.Filters.Validate(); err != nil {
+			return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("export traces to file req is invalid"))
+		}
+	}
+	jobID, err := t.traceExportService.ExportTracesToFile(ctx, sReq)
+	if err != nil {
+		return nil, err
+	}
+	return &trace.ExportTracesToFileResponse{JobID: jobID}, nil
+}
+
+func (t *TraceApplication) GetTraceExportJob(ctx context.Context, req *trace.GetTraceExportJobRequest) (
+	r *trace.GetTraceExportJobResponse, err error,
+) {
+	if req == nil || req.GetWorkspaceID() <= 0 || req.GetJobID() <= 0 {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode)
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceExport,
+		strconv.FormatInt(req.GetWorkspaceID(), 10), false); err != nil {
+		return nil, err
+	}
+	job, err := t.traceExportService.GetTraceExportJob(ctx, req.GetWorkspaceID(), req.GetJobID())
+	if err != nil {
+		return nil, err
+	}
+	return &trace.GetTraceExportJobResponse{Job: tconv.TraceExportJobDO2DTO(job)}, nil
+}
+
 func (t *TraceApplication) ChangeEvaluatorScore(ctx context.Context, req *trace.ChangeEvaluatorScoreRequest) (*trace.ChangeEvaluatorScoreResponse, error) {
 	if err := t.validateChangeEvaluatorScoreReq(ctx, req); err != nil {
 		return nil, err
@@ -1109,6 +1359,47 @@ func (t *TraceApplication) GetTrajectoryConfig(ctx context.Context, req *trace.G
 	}, nil
 }
 
+func (t *TraceApplication) UpsertRetentionPolicy(ctx context.Context, req *trace.UpsertRetentionPolicyRequest) (r *trace.UpsertRetentionPolicyResponse, err error) {
+	if req == nil || req.Policy == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no retention policy provided"))
+	}
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceViewEdit,
+		strconv.FormatInt(req.GetWorkspaceID(), 10),
+		false); err != nil {
+		return nil, err
+	}
+	userID := session.UserIDInCtxOrEmpty(ctx)
+	if userID == "" {
+		return nil, errorx.NewByCode(obErrorx.UserParseFailedCode)
+	}
+	if err := t.traceService.UpsertRetentionPolicy(ctx, &service.UpsertRetentionPolicyReq{
+		Policy: tconv.RetentionPolicyDTO2DO(req.GetWorkspaceID(), req.Policy),
+		UserID: userID,
+	}); err != nil {
+		return nil, err
+	}
+	return trace.NewUpsertRetentionPolicyResponse(), nil
+}
+
+func (t *TraceApplication) GetRetentionPolicy(ctx context.Context, req *trace.GetRetentionPolicyRequest) (r *trace.GetRetentionPolicyResponse, err error) {
+	if err := t.authSvc.CheckWorkspacePermission(ctx,
+		rpc.AuthActionTraceRead,
+		strconv.FormatInt(req.GetWorkspaceID(), 10),
+		false); err != nil {
+		return nil, err
+	}
+	resp, err := t.traceService.GetRetentionPolicy(ctx, &service.GetRetentionPolicyReq{
+		WorkspaceID: req.GetWorkspaceID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &trace.GetRetentionPolicyResponse{
+		Policy: tconv.RetentionPolicyDO2DTO(resp.Policy),
+	}, nil
+}
+
 func (t *TraceApplication) ListTrajectory(ctx context.Context, req *trace.ListTrajectoryRequest) (r *trace.ListTrajectoryResponse, err error) {
 	if err := t.authSvc.CheckWorkspacePermission(ctx,
 		rpc.AuthActionTraceRead,
@@ -17,6 +17,7 @@ import (
 	dataset0 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dataset"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/filter"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/span"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/trace_export"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/view"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/trace"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
@@ -835,6 +836,228 @@ func TestTraceApplication_GetTrace(t *testing.T) {
 	}
 }
 
+func TestTraceApplication_DiffTraces(t *testing.T) {
+	newReq := func() *trace.DiffTracesRequest {
+		return &trace.DiffTracesRequest{
+			WorkspaceID: 12,
+			BaseTrace: &trace.TraceQueryParams{
+				TraceID:   "base",
+				StartTime: time.Now().Add(-time.Hour).UnixMilli(),
+				EndTime:   time.Now().UnixMilli(),
+			},
+			CompareTrace: &trace.TraceQueryParams{
+				TraceID:   "compare",
+				StartTime: time.Now().Add(-time.Hour).UnixMilli(),
+				EndTime:   time.Now().UnixMilli(),
+			},
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := svcmock.NewMockITraceService(ctrl)
+		mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+		mockCfg := confmock.NewMockITraceConfig(ctrl)
+		mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), "12", gomock.Any()).Return(nil)
+		mockCfg.EXPECT().GetTraceDataMaxDurationDay(gomock.Any(), gomock.Any()).Return(int64(100)).Times(2)
+		mockSvc.EXPECT().GetTrace(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, req *service.GetTraceReq) (*service.GetTraceResp, error) {
+				return &service.GetTraceResp{
+					TraceId: req.TraceID,
+					Spans: loop_span.SpanList{
+						{SpanID: req.TraceID, SpanName: "agent", SpanType: "agent", Output: req.TraceID},
+					},
+				}, nil
+			}).Times(2)
+		tr := &TraceApplication{
+			traceService: mockSvc,
+			authSvc:      mockAuth,
+			traceConfig:  mockCfg,
+		}
+		got, err := tr.DiffTraces(context.Background(), newReq())
+		assert.NoError(t, err)
+		assert.Len(t, got.Nodes, 1)
+		assert.Equal(t, "base", got.Nodes[0].GetBaseSpanID())
+		assert.Equal(t, "compare", got.Nodes[0].GetCompareSpanID())
+		assert.Equal(t, []string{loop_span.DiffFieldOutput}, got.Nodes[0].ChangedFields)
+		assert.Equal(t, int32(1), got.Summary.ChangedSpans)
+	})
+
+	t.Run("get trace error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := svcmock.NewMockITraceService(ctrl)
+		mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+		mockCfg := confmock.NewMockITraceConfig(ctrl)
+		mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockCfg.EXPECT().GetTraceDataMaxDurationDay(gomock.Any(), gomock.Any()).Return(int64(100)).Times(2)
+		mockSvc.EXPECT().GetTrace(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+		tr := &TraceApplication{
+			traceService: mockSvc,
+			authSvc:      mockAuth,
+			traceConfig:  mockCfg,
+		}
+		_, err := tr.DiffTraces(context.Background(), newReq())
+		assert.Error(t, err)
+	})
+
+	t.Run("missing compare trace", func(t *testing.T) {
+		req := newReq()
+		req.CompareTrace = nil
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockCfg := confmock.NewMockITraceConfig(ctrl)
+		mockCfg.EXPECT().GetTraceDataMaxDurationDay(gomock.Any(), gomock.Any()).Return(int64(100))
+		_, err := (&TraceApplication{traceConfig: mockCfg}).DiffTraces(context.Background(), req)
+		assert.Error(t, err)
+	})
+}
+
+func TestTraceApplication_ListSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSvc := svcmock.NewMockITraceService(ctrl)
+	mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+	mockCfg := confmock.NewMockITraceConfig(ctrl)
+	mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), "12", gomock.Any()).Return(nil)
+	mockCfg.EXPECT().GetTraceDataMaxDurationDay(gomock.Any(), gomock.Any()).Return(int64(100))
+	mockSvc.EXPECT().ListSessions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *service.ListSessionsReq) (*service.ListSessionsResp, error) {
+			assert.Equal(t, int32(10), req.Limit)
+			assert.Equal(t, loop_span.PlatformCozeLoop, req.PlatformType)
+			return &service.ListSessionsResp{
+				Sessions:      []*loop_span.Session{{SessionID: "s1", TurnCount: 3, InputTokens: 10}},
+				NextPageToken: "10",
+				HasMore:       true,
+			}, nil
+		})
+	tr := &TraceApplication{
+		traceService: mockSvc,
+		authSvc:      mockAuth,
+		traceConfig:  mockCfg,
+	}
+	got, err := tr.ListSessions(context.Background(), &trace.ListSessionsRequest{
+		WorkspaceID: 12,
+		StartTime:   time.Now().Add(-time.Hour).UnixMilli(),
+		EndTime:     time.Now().UnixMilli(),
+		PageSize:    ptr.Of(int32(10)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "s1", got.Sessions[0].SessionID)
+	assert.Equal(t, int64(3), got.Sessions[0].TurnCount)
+	assert.Nil(t, got.Sessions[0].Turns)
+	assert.Equal(t, "10", got.NextPageToken)
+
+	_, err = tr.ListSessions(context.Background(), &trace.ListSessionsRequest{WorkspaceID: 12, PageSize: ptr.Of(int32(MaxListSpansLimit + 1))})
+	assert.Error(t, err)
+}
+
+func TestTraceApplication_GetSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSvc := svcmock.NewMockITraceService(ctrl)
+	mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+	mockCfg := confmock.NewMockITraceConfig(ctrl)
+	mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), "12", gomock.Any()).Return(nil)
+	mockCfg.EXPECT().GetTraceDataMaxDurationDay(gomock.Any(), gomock.Any()).Return(int64(100))
+	mockSvc.EXPECT().GetSession(gomock.Any(), gomock.Any()).Return(&service.GetSessionResp{
+		Session: &loop_span.Session{
+			SessionID: "s1",
+			TurnCount: 2,
+			Turns: []*loop_span.SessionTurn{
+				{TraceID: "t1", RootSpan: &loop_span.Span{TraceID: "t1", SpanID: "r1"}},
+				{TraceID: "t2"},
+			},
+		},
+	}, nil)
+	tr := &TraceApplication{
+		traceService: mockSvc,
+		authSvc:      mockAuth,
+		traceConfig:  mockCfg,
+	}
+	got, err := tr.GetSession(context.Background(), &trace.GetSessionRequest{
+		WorkspaceID: 12,
+		SessionID:   "s1",
+		StartTime:   time.Now().Add(-time.Hour).UnixMilli(),
+		EndTime:     time.Now().UnixMilli(),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, got.Session.Turns, 2)
+	assert.Equal(t, int32(2), got.Session.Turns[1].Turn)
+	assert.Equal(t, "r1", got.Session.Turns[0].RootSpan.SpanID)
+	assert.Nil(t, got.Session.Turns[1].RootSpan)
+	assert.False(t, got.GetTruncated())
+
+	_, err = tr.GetSession(context.Background(), &trace.GetSessionRequest{WorkspaceID: 12})
+	assert.Error(t, err)
+}
+
+func TestTraceApplication_ExportTracesToFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockExportSvc := svcmock.NewMockITraceExportService(ctrl)
+	mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+	mockCfg := confmock.NewMockITraceConfig(ctrl)
+	mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), rpc.AuthActionTraceExport, "12", gomock.Any()).Return(nil)
+	mockCfg.EXPECT().GetTraceDataMaxDurationDay(gomock.Any(), gomock.Any()).Return(int64(100)).AnyTimes()
+	mockExportSvc.EXPECT().ExportTracesToFile(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *service.ExportTracesToFileReq) (int64, error) {
+			assert.Equal(t, entity.ExportFileFormatOTLPJSON, req.Format)
+			assert.Equal(t, loop_span.SpanListTypeAllSpan, req.SpanListType)
+			assert.Equal(t, "123", req.UserID)
+			return 100, nil
+		})
+	tr := &TraceApplication{
+		traceExportService: mockExportSvc,
+		authSvc:            mockAuth,
+		traceConfig:        mockCfg,
+	}
+	ctx := session.WithCtxUser(context.Background(), &session.User{ID: "123"})
+	req := &trace.ExportTracesToFileRequest{
+		WorkspaceID: 12,
+		StartTime:   time.Now().Add(-time.Hour).UnixMilli(),
+		EndTime:     time.Now().UnixMilli(),
+		Format:      trace_export.ExportFileFormatOTLPJSON,
+	}
+	got, err := tr.ExportTracesToFile(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), got.JobID)
+
+	req.Format = "csv"
+	_, err = tr.ExportTracesToFile(ctx, req)
+	assert.Error(t, err)
+}
+
+func TestTraceApplication_GetTraceExportJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockExportSvc := svcmock.NewMockITraceExportService(ctrl)
+	mockAuth := rpcmock.NewMockIAuthProvider(ctrl)
+	mockAuth.EXPECT().CheckWorkspacePermission(gomock.Any(), gomock.Any(), "12", gomock.Any()).Return(nil)
+	mockExportSvc.EXPECT().GetTraceExportJob(gomock.Any(), int64(12), int64(100)).Return(&entity.TraceExportJob{
+		ID:            100,
+		WorkspaceID:   12,
+		Format:        entity.ExportFileFormatJSONL,
+		Status:        entity.ExportJobStatusSuccess,
+		ExportedCount: 10,
+		DownloadURL:   "https://download",
+	}, nil)
+	tr := &TraceApplication{
+		traceExportService: mockExportSvc,
+		authSvc:            mockAuth,
+	}
+	got, err := tr.GetTraceExportJob(context.Background(), &trace.GetTraceExportJobRequest{WorkspaceID: 12, JobID: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, trace_export.ExportJobStatusSuccess, got.Job.Status)
+	assert.Equal(t, int64(10), got.Job.ExportedCount)
+	assert.Equal(t, "https://download", got.Job.GetDownloadURL())
+	assert.Nil(t, got.Job.ErrorMsg)
+
+	_, err = tr.GetTraceExportJob(context.Background(), &trace.GetTraceExportJobRequest{WorkspaceID: 12})
+	assert.Error(t, err)
+}
+
 func TestTraceApplication_SearchTraceTree(t *testing.T) {
 	start := time.Now().Add(-time.Hour).UnixMilli()
 	end := time.Now().UnixMilli()
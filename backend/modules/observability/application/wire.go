@@ -10,6 +10,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
+	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
 	"github.com/coze-dev/coze-loop/backend/infra/lock"
@@ -37,6 +38,7 @@ import (
 	metric_model "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/metric/model"
 	metric_service_def "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/metric/service"
 	metric_tool "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/metric/tool"
+	metricst "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/scheduledtask"
 	task_entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/entity"
 	trepo "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/repo"
 	taskSvc "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/service"
@@ -53,6 +55,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/collector/exporter/clickhouseexporter"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/collector/processor/queueprocessor"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/collector/receiver/rmqreceiver"
+	tracest "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/scheduledtask"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_filter"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_processor"
 	obcollector "github.com/coze-dev/coze-loop/backend/modules/observability/infra/collector"
@@ -69,10 +72,12 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/evaluationset"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/evaluator"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/file"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/notify"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/tag"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/user"
 	obstorage "github.com/coze-dev/coze-loop/backend/modules/observability/infra/storage"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/tenant"
+	obwebhook "github.com/coze-dev/coze-loop/backend/modules/observability/infra/webhook"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/workspace"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
 	"github.com/google/wire"
@@ -111,12 +116,15 @@ var (
 		NewDatasetServiceAdapter,
 		redis2.NewSpansRedisDaoImpl,
 		mysqldao.NewTrajectoryConfigDaoImpl,
+		mysqldao.NewRetentionPolicyDaoImpl,
 		taskDomainSet,
 	)
 	traceSet = wire.NewSet(
 		NewTraceApplication,
 		obrepo.NewViewRepoImpl,
 		mysqldao.NewViewDaoImpl,
+		obrepo.NewTraceExportJobRepoImpl,
+		mysqldao.NewTraceExportJobDaoImpl,
 		auth.NewAuthProvider,
 		user.NewUserRPCProvider,
 		tag.NewTagRPCProvider,
@@ -132,6 +140,7 @@ var (
 		mq2.NewSpanWithAnnotationProducerImpl,
 		redis2.NewSpansRedisDaoImpl,
 		mysqldao.NewTrajectoryConfigDaoImpl,
+		mysqldao.NewRetentionPolicyDaoImpl,
 	)
 	openApiSet = wire.NewSet(
 		NewOpenAPIApplication,
@@ -147,20 +156,40 @@ var (
 		NewTaskLocker,
 		traceDomainSet,
 		taskSvc.NewTaskCallbackServiceImpl,
+		metricDomainSet,
+		alertDomainSet,
 	)
-	metricsSet = wire.NewSet(
-		NewMetricApplication,
+	metricDomainSet = wire.NewSet(
 		metric_service.NewMetricsService,
 		provideTraceMetricRepo,
 		obrepo.NewOfflineMetricRepoImpl,
+		ckdao.NewOfflineMetricDaoImpl,
+		NewMetricsPlatformConfig,
+	)
+	alertDomainSet = wire.NewSet(
+		metric_service.NewAlertServiceImpl,
+		obrepo.NewAlertRuleRepoImpl,
+		mysqldao.NewAlertRuleDaoImpl,
+		notify.NewNotifyRPCAdapter,
+		obwebhook.NewWebhookSender,
+	)
+	dashboardDomainSet = wire.NewSet(
+		metric_service.NewDashboardServiceImpl,
+		obrepo.NewDashboardRepoImpl,
+		mysqldao.NewDashboardDaoImpl,
+	)
+	metricsSet = wire.NewSet(
+		NewMetricApplication,
 		tenant.NewTenantProvider,
 		auth.NewAuthProvider,
+		user.NewUserRPCProvider,
 		NewTraceConfigLoader,
 		NewTraceProcessorBuilder,
 		obconfig.NewTraceConfigCenter,
-		ckdao.NewOfflineMetricDaoImpl,
 		file.NewFileRPCProvider,
-		NewMetricsPlatformConfig,
+		metricDomainSet,
+		alertDomainSet,
+		dashboardDomainSet,
 	)
 )
 
@@ -171,12 +200,14 @@ func provideTraceRepo(
 	ckProvider ck.Provider,
 	spanProducer mq3.ISpanProducer,
 	trajectoryConfDao mysqldao.ITrajectoryConfigDao,
+	retentionPolicyDao mysqldao.IRetentionPolicyDao,
 	idGenerator idgen.IIDGenerator,
 ) (repo.ITraceRepo, error) {
 	options, err := buildTraceRepoOptions(ckProvider)
 	if err != nil {
 		return nil, err
 	}
+	options = append(options, obrepo.WithRetentionPolicyDao(retentionPolicyDao))
 	return obrepo.NewTraceRepoImpl(traceConfig, storageProvider, spanRedisDao, spanProducer, trajectoryConfDao, idGenerator, options...)
 }
 
@@ -230,29 +261,36 @@ func NewTraceProcessorBuilder(
 			span_processor.NewCheckProcessorFactory(),
 			span_processor.NewAttrTosProcessorFactory(fileProvider),
 			span_processor.NewExpireErrorProcessorFactory(benefitSvc),
+			span_processor.NewQueryPIIRedactionProcessorFactory(traceConfig),
 		},
 		// list spans processors
 		[]span_processor.Factory{
 			span_processor.NewPlatformProcessorFactory(traceConfig),
 			span_processor.NewExpireErrorProcessorFactory(benefitSvc),
+			span_processor.NewQueryPIIRedactionProcessorFactory(traceConfig),
 		},
 		// batch get advance info processors
 		[]span_processor.Factory{
 			span_processor.NewCheckProcessorFactory(),
 		},
 		// ingest trace processors
-		[]span_processor.Factory{},
+		[]span_processor.Factory{
+			span_processor.NewPIIRedactionProcessorFactory(traceConfig),
+			span_processor.NewCostProcessorFactory(traceConfig),
+		},
 		// search trace open api processors
 		[]span_processor.Factory{
 			span_processor.NewPlatformProcessorFactory(traceConfig),
 			span_processor.NewCheckProcessorFactory(),
 			span_processor.NewAttrTosProcessorFactory(fileProvider),
 			span_processor.NewExpireErrorProcessorFactory(benefitSvc),
+			span_processor.NewQueryPIIRedactionProcessorFactory(traceConfig),
 		},
 		// list trace open api processors
 		[]span_processor.Factory{
 			span_processor.NewPlatformProcessorFactory(traceConfig),
 			span_processor.NewExpireErrorProcessorFactory(benefitSvc),
+			span_processor.NewQueryPIIRedactionProcessorFactory(traceConfig),
 		})
 }
 
@@ -271,6 +309,10 @@ func NewMetricsPlatformConfig() *metrics_entity.PlatformMetrics {
 				FieldName: loop_span.SpanFieldStatusCode,
 				FieldType: loop_span.FieldTypeLong,
 			},
+			"thread_id": &loop_span.FilterField{
+				FieldName: loop_span.SpanFieldThreadID,
+				FieldType: loop_span.FieldTypeString,
+			},
 		},
 		MetricGroups: map[string]*metrics_entity.MetricGroup{
 			"all": {
@@ -278,6 +320,7 @@ func NewMetricsPlatformConfig() *metrics_entity.PlatformMetrics {
 					metric_general.NewGeneralTotalCountMetric(),
 					metric_general.NewGeneralFailRatioMetric(),
 					metric_general.NewGeneralModelTotalTokensMetric(),
+					metric_general.NewGeneralTotalCostMetric(),
 					metric_general.NewGeneralModelLatencyMetric(),
 					metric_general.NewGeneralModelFailRatioMetric(),
 					metric_general.NewGeneralToolTotalCountMetric(),
@@ -306,6 +349,7 @@ func NewMetricsPlatformConfig() *metrics_entity.PlatformMetrics {
 					metric_model.NewModelTotalCountMetric(),
 					metric_model.NewModelTotalSuccessCountMetric(),
 					metric_model.NewModelTotalErrorCountMetricc(),
+					metric_model.NewModelCostMetric(),
 
 					metric_service_def.NewServiceDurationMetric(),
 					metric_service_def.NewServiceExecutionStepCountMetric(),
@@ -323,6 +367,7 @@ func NewMetricsPlatformConfig() *metrics_entity.PlatformMetrics {
 					metric_service_def.NewServiceTraceSuccessCountMetric(),
 					metric_service_def.NewServiceTraceErrorCountMetric(),
 					metric_service_def.NewServiceUserCountMetric(),
+					metric_service_def.NewServiceSessionCountMetric(),
 					metric_service_def.NewServiceUniqTraceMetric(),
 
 					metric_tool.NewToolDurationMetric(),
@@ -367,11 +412,12 @@ func NewDatasetServiceAdapter(evalSetService evaluationsetservice.Client, datase
 }
 
 func NewInitTaskProcessor(datasetServiceProvider *service.DatasetServiceAdaptor, evalService rpc.IEvaluatorRPCAdapter,
-	evaluationService rpc.IEvaluationRPCAdapter, taskRepo trepo.ITaskRepo,
+	evaluationService rpc.IEvaluationRPCAdapter, taskRepo trepo.ITaskRepo, traceRepo repo.ITraceRepo,
 ) *task_processor.TaskProcessor {
 	taskProcessor := task_processor.NewTaskProcessor()
 	taskProcessor.Register(task_entity.TaskTypeAutoEval, task_processor.NewAutoEvaluateProcessor(
 		0, datasetServiceProvider, evalService, evaluationService, taskRepo, &task_processor.EvalTargetBuilderImpl{}))
+	taskProcessor.Register(task_entity.TaskTypeAutoTag, task_processor.NewAutoTagProcessor(evalService, taskRepo, traceRepo))
 	return taskProcessor
 }
 
@@ -382,10 +428,15 @@ func NewScheduledTask(
 	taskService taskSvc.ITaskService,
 	taskProcessor task_processor.TaskProcessor,
 	taskRepo trepo.ITaskRepo,
+	traceRepo repo.ITraceRepo,
+	alertRepo metric_repo.IAlertRuleRepo,
+	alertService metric_service.IAlertService,
 ) []scheduledtask.ScheduledTask {
 	return []scheduledtask.ScheduledTask{
 		taskst.NewStatusCheckTask(locker, config, traceHubService, taskService, taskProcessor, taskRepo),
 		taskst.NewLocalCacheRefreshTask(traceHubService, taskRepo),
+		tracest.NewRetentionArchiveTask(locker, config, traceRepo),
+		metricst.NewAlertEvaluateTask(locker, alertRepo, alertService),
 	}
 }
 
@@ -406,6 +457,7 @@ func InitTraceApplication(
 	evalSetService evaluationsetservice.Client,
 	tagService tagservice.Client,
 	datasetService datasetservice.Client,
+	objectStorage fileserver.ObjectStorage,
 ) (ITraceApplication, error) {
 	wire.Build(traceSet)
 	return nil, nil
@@ -438,6 +490,8 @@ func InitMetricApplication(
 	benefit benefit.IBenefitService,
 	authClient authservice.Client,
 	idGenerator idgen.IIDGenerator,
+	db db.Provider,
+	userClient userservice.Client,
 ) (IMetricApplication, error) {
 	wire.Build(metricsSet)
 	return nil, nil
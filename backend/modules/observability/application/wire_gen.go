@@ -10,6 +10,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/ck"
 	"github.com/coze-dev/coze-loop/backend/infra/db"
 	"github.com/coze-dev/coze-loop/backend/infra/external/benefit"
+	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/infra/limiter"
 	"github.com/coze-dev/coze-loop/backend/infra/lock"
@@ -37,6 +38,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/metric/model"
 	service4 "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/metric/service"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/metric/tool"
+	scheduledtask4 "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/scheduledtask"
 	entity3 "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/entity"
 	repo4 "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/repo"
 	service3 "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/service"
@@ -53,6 +55,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/collector/exporter/clickhouseexporter"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/collector/processor/queueprocessor"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/collector/receiver/rmqreceiver"
+	scheduledtask3 "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/scheduledtask"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_filter"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_processor"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/collector"
@@ -69,10 +72,12 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/evaluationset"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/evaluator"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/file"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/notify"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/tag"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/user"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/storage"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/tenant"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/webhook"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/workspace"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
 	"github.com/google/wire"
@@ -80,7 +85,7 @@ import (
 
 // Injectors from wire.go:
 
-func InitTraceApplication(db2 db.Provider, ckDb ck.Provider, redis3 redis.Cmdable, persistentCmdable redis.PersistentCmdable, meter metrics.Meter, mqFactory mq.IFactory, configFactory conf.IConfigLoaderFactory, idgen2 idgen.IIDGenerator, fileClient fileservice.Client, benefit2 benefit.IBenefitService, authClient authservice.Client, userClient userservice.Client, evalService evaluatorservice.Client, evalSetService evaluationsetservice.Client, tagService tagservice.Client, datasetService datasetservice.Client) (ITraceApplication, error) {
+func InitTraceApplication(db2 db.Provider, ckDb ck.Provider, redis3 redis.Cmdable, persistentCmdable redis.PersistentCmdable, meter metrics.Meter, mqFactory mq.IFactory, configFactory conf.IConfigLoaderFactory, idgen2 idgen.IIDGenerator, fileClient fileservice.Client, benefit2 benefit.IBenefitService, authClient authservice.Client, userClient userservice.Client, evalService evaluatorservice.Client, evalSetService evaluationsetservice.Client, tagService tagservice.Client, datasetService datasetservice.Client, objectStorage fileserver.ObjectStorage) (ITraceApplication, error) {
 	iConfigLoader, err := NewTraceConfigLoader(configFactory)
 	if err != nil {
 		return nil, err
@@ -96,7 +101,8 @@ func InitTraceApplication(db2 db.Provider, ckDb ck.Provider, redis3 redis.Cmdabl
 		return nil, err
 	}
 	iTrajectoryConfigDao := mysql.NewTrajectoryConfigDaoImpl(db2)
-	iTraceRepo, err := provideTraceRepo(iTraceConfig, iStorageProvider, iSpansRedisDao, ckDb, iSpanProducer, iTrajectoryConfigDao, idgen2)
+	iRetentionPolicyDao := mysql.NewRetentionPolicyDaoImpl(db2)
+	iTraceRepo, err := provideTraceRepo(iTraceConfig, iStorageProvider, iSpansRedisDao, ckDb, iSpanProducer, iTrajectoryConfigDao, iRetentionPolicyDao, idgen2)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +129,9 @@ func InitTraceApplication(db2 db.Provider, ckDb ck.Provider, redis3 redis.Cmdabl
 		return nil, err
 	}
 	datasetServiceAdaptor := NewDatasetServiceAdapter(evalSetService, datasetService)
-	iTraceExportService, err := service.NewTraceExportServiceImpl(iTraceRepo, iStorageProvider, iTraceConfig, iTraceProducer, iAnnotationProducer, iTraceMetrics, iTenantProvider, datasetServiceAdaptor, traceFilterProcessorBuilder, iTraceService)
+	iTraceExportJobDao := mysql.NewTraceExportJobDaoImpl(db2)
+	iTraceExportJobRepo := repo.NewTraceExportJobRepoImpl(iTraceExportJobDao, idgen2)
+	iTraceExportService, err := service.NewTraceExportServiceImpl(iTraceRepo, iStorageProvider, iTraceConfig, iTraceProducer, iAnnotationProducer, iTraceMetrics, iTenantProvider, datasetServiceAdaptor, traceFilterProcessorBuilder, iTraceService, iTraceExportJobRepo, objectStorage)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +163,8 @@ func InitOpenAPIApplication(mqFactory mq.IFactory, configFactory conf.IConfigLoa
 		return nil, err
 	}
 	iTrajectoryConfigDao := mysql.NewTrajectoryConfigDaoImpl(db2)
-	iTraceRepo, err := provideTraceRepo(iTraceConfig, iStorageProvider, iSpansRedisDao, ckDb, iSpanProducer, iTrajectoryConfigDao, idgen2)
+	iRetentionPolicyDao := mysql.NewRetentionPolicyDaoImpl(db2)
+	iTraceRepo, err := provideTraceRepo(iTraceConfig, iStorageProvider, iSpansRedisDao, ckDb, iSpanProducer, iTrajectoryConfigDao, iRetentionPolicyDao, idgen2)
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +200,7 @@ func InitOpenAPIApplication(mqFactory mq.IFactory, configFactory conf.IConfigLoa
 	return iObservabilityOpenAPIApplication, nil
 }
 
-func InitMetricApplication(ckDb ck.Provider, storageProvider storage2.IStorageProvider, configFactory conf.IConfigLoaderFactory, fileClient fileservice.Client, benefit2 benefit.IBenefitService, authClient authservice.Client, idGenerator idgen.IIDGenerator) (IMetricApplication, error) {
+func InitMetricApplication(ckDb ck.Provider, storageProvider storage2.IStorageProvider, configFactory conf.IConfigLoaderFactory, fileClient fileservice.Client, benefit2 benefit.IBenefitService, authClient authservice.Client, idGenerator idgen.IIDGenerator, db2 db.Provider, userClient userservice.Client) (IMetricApplication, error) {
 	iConfigLoader, err := NewTraceConfigLoader(configFactory)
 	if err != nil {
 		return nil, err
@@ -217,8 +226,17 @@ func InitMetricApplication(ckDb ck.Provider, storageProvider storage2.IStoragePr
 	if err != nil {
 		return nil, err
 	}
+	iAlertRuleDao := mysql.NewAlertRuleDaoImpl(db2)
+	iAlertRuleRepo := repo.NewAlertRuleRepoImpl(iAlertRuleDao, idGenerator)
+	iUserProvider := user.NewUserRPCProvider(userClient)
+	iNotifyRPCAdapter := notify.NewNotifyRPCAdapter()
+	iWebhookSender := webhook.NewWebhookSender()
+	iAlertService := service2.NewAlertServiceImpl(iAlertRuleRepo, iMetricsService, iUserProvider, iNotifyRPCAdapter, iWebhookSender)
+	iDashboardDao := mysql.NewDashboardDaoImpl(db2)
+	iDashboardRepo := repo.NewDashboardRepoImpl(iDashboardDao, idGenerator)
+	iDashboardService := service2.NewDashboardServiceImpl(iDashboardRepo, iMetricsService)
 	iAuthProvider := auth.NewAuthProvider(authClient)
-	iMetricApplication, err := NewMetricApplication(iMetricsService, iTenantProvider, iAuthProvider)
+	iMetricApplication, err := NewMetricApplication(iMetricsService, iAlertService, iDashboardService, iTenantProvider, iAuthProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +258,8 @@ func InitTraceIngestionApplication(configFactory conf.IConfigLoaderFactory, stor
 		return nil, err
 	}
 	iTrajectoryConfigDao := mysql.NewTrajectoryConfigDaoImpl(db2)
-	iTraceRepo, err := provideTraceRepo(iTraceConfig, storageProvider, iSpansRedisDao, ckDb, iSpanProducer, iTrajectoryConfigDao, idGenerator)
+	iRetentionPolicyDao := mysql.NewRetentionPolicyDaoImpl(db2)
+	iTraceRepo, err := provideTraceRepo(iTraceConfig, storageProvider, iSpansRedisDao, ckDb, iSpanProducer, iTrajectoryConfigDao, iRetentionPolicyDao, idGenerator)
 	if err != nil {
 		return nil, err
 	}
@@ -271,17 +290,7 @@ func InitTaskApplication(db2 db.Provider, idgen2 idgen.IIDGenerator, configFacto
 	datasetServiceAdaptor := NewDatasetServiceAdapter(evalSetService, datasetService)
 	iEvaluatorRPCAdapter := evaluator.NewEvaluatorRPCProvider(evalService)
 	iEvaluationRPCAdapter := evaluation.NewEvaluationRPCProvider(exptService)
-	processorTaskProcessor := NewInitTaskProcessor(datasetServiceAdaptor, iEvaluatorRPCAdapter, iEvaluationRPCAdapter, iTaskRepo)
 	iStorageProvider := storage.NewTraceStorageProvider()
-	iTenantProvider := tenant.NewTenantProvider(iTraceConfig)
-	iFileProvider := file.NewFileRPCProvider(fileClient)
-	traceFilterProcessorBuilder := NewTraceProcessorBuilder(iTraceConfig, iFileProvider, benefit2)
-	iTaskService, err := service3.NewTaskServiceImpl(iTaskRepo, idgen2, iBackfillProducer, processorTaskProcessor, iStorageProvider, iTenantProvider, traceFilterProcessorBuilder)
-	if err != nil {
-		return nil, err
-	}
-	iAuthProvider := auth.NewAuthProvider(authClient)
-	iUserProvider := user.NewUserRPCProvider(userClient)
 	iSpansRedisDao, err := redis2.NewSpansRedisDaoImpl(persistentCmdable)
 	if err != nil {
 		return nil, err
@@ -291,10 +300,21 @@ func InitTaskApplication(db2 db.Provider, idgen2 idgen.IIDGenerator, configFacto
 		return nil, err
 	}
 	iTrajectoryConfigDao := mysql.NewTrajectoryConfigDaoImpl(db2)
-	iTraceRepo, err := provideTraceRepo(iTraceConfig, iStorageProvider, iSpansRedisDao, ckDb, iSpanProducer, iTrajectoryConfigDao, idgen2)
+	iRetentionPolicyDao := mysql.NewRetentionPolicyDaoImpl(db2)
+	iTraceRepo, err := provideTraceRepo(iTraceConfig, iStorageProvider, iSpansRedisDao, ckDb, iSpanProducer, iTrajectoryConfigDao, iRetentionPolicyDao, idgen2)
 	if err != nil {
 		return nil, err
 	}
+	processorTaskProcessor := NewInitTaskProcessor(datasetServiceAdaptor, iEvaluatorRPCAdapter, iEvaluationRPCAdapter, iTaskRepo, iTraceRepo)
+	iTenantProvider := tenant.NewTenantProvider(iTraceConfig)
+	iFileProvider := file.NewFileRPCProvider(fileClient)
+	traceFilterProcessorBuilder := NewTraceProcessorBuilder(iTraceConfig, iFileProvider, benefit2)
+	iTaskService, err := service3.NewTaskServiceImpl(iTaskRepo, idgen2, iBackfillProducer, processorTaskProcessor, iStorageProvider, iTenantProvider, traceFilterProcessorBuilder)
+	if err != nil {
+		return nil, err
+	}
+	iAuthProvider := auth.NewAuthProvider(authClient)
+	iUserProvider := user.NewUserRPCProvider(userClient)
 	iLocker := NewTaskLocker(redis3)
 	iTraceProducer, err := producer.NewTraceProducerImpl(iTraceConfig, mqFactory)
 	if err != nil {
@@ -314,7 +334,29 @@ func InitTaskApplication(db2 db.Provider, idgen2 idgen.IIDGenerator, configFacto
 		return nil, err
 	}
 	iTaskCallbackService := service3.NewTaskCallbackServiceImpl(iTaskRepo, iTraceRepo, taskProcessor, iTenantProvider, iTraceConfig, benefit2)
-	v := NewScheduledTask(iLocker, iTraceConfig, iTraceHubService, iTaskService, taskProcessor, iTaskRepo)
+	iAlertRuleDao := mysql.NewAlertRuleDaoImpl(db2)
+	iAlertRuleRepo := repo.NewAlertRuleRepoImpl(iAlertRuleDao, idgen2)
+	iMetricRepo, err := provideTraceMetricRepo(iTraceConfig, idgen2, iStorageProvider, ckDb)
+	if err != nil {
+		return nil, err
+	}
+	iOfflineMetricDao, err := ck2.NewOfflineMetricDaoImpl()
+	if err != nil {
+		return nil, err
+	}
+	iOfflineMetricRepo, err := repo.NewOfflineMetricRepoImpl(iOfflineMetricDao, iTraceConfig)
+	if err != nil {
+		return nil, err
+	}
+	platformMetrics := NewMetricsPlatformConfig()
+	iMetricsService, err := service2.NewMetricsService(iMetricRepo, iOfflineMetricRepo, iTenantProvider, traceFilterProcessorBuilder, iTraceConfig, platformMetrics)
+	if err != nil {
+		return nil, err
+	}
+	iNotifyRPCAdapter := notify.NewNotifyRPCAdapter()
+	iWebhookSender := webhook.NewWebhookSender()
+	iAlertService := service2.NewAlertServiceImpl(iAlertRuleRepo, iMetricsService, iUserProvider, iNotifyRPCAdapter, iWebhookSender)
+	v := NewScheduledTask(iLocker, iTraceConfig, iTraceHubService, iTaskService, taskProcessor, iTaskRepo, iTraceRepo, iAlertRuleRepo, iAlertService)
 	iTaskApplication, err := NewTaskApplication(iTaskService, iAuthProvider, iEvaluatorRPCAdapter, iEvaluationRPCAdapter, iUserProvider, iTraceHubService, taskProcessor, iTaskCallbackService, v, iTraceRepo)
 	if err != nil {
 		return nil, err
@@ -329,24 +371,31 @@ var (
 		NewInitTaskProcessor, service3.NewTaskServiceImpl, repo.NewTaskRepoImpl, mysql.NewTaskDaoImpl, redis2.NewTaskDAO, redis2.NewTaskRunDAO, mysql.NewTaskRunDaoImpl, producer.NewBackfillProducerImpl, NewScheduledTask,
 	)
 	traceDomainSet = wire.NewSet(service.NewTraceServiceImpl, service.NewTraceExportServiceImpl, provideTraceRepo, storage.NewTraceStorageProvider, metrics2.NewTraceMetricsImpl, collector.NewEventCollectorProvider, producer.NewTraceProducerImpl, producer.NewAnnotationProducerImpl, producer.NewSpanWithAnnotationProducerImpl, file.NewFileRPCProvider, NewTraceConfigLoader,
-		NewTraceProcessorBuilder, config.NewTraceConfigCenter, tenant.NewTenantProvider, workspace.NewWorkspaceProvider, evaluator.NewEvaluatorRPCProvider, NewDatasetServiceAdapter, redis2.NewSpansRedisDaoImpl, mysql.NewTrajectoryConfigDaoImpl, taskDomainSet,
+		NewTraceProcessorBuilder, config.NewTraceConfigCenter, tenant.NewTenantProvider, workspace.NewWorkspaceProvider, evaluator.NewEvaluatorRPCProvider, NewDatasetServiceAdapter, redis2.NewSpansRedisDaoImpl, mysql.NewTrajectoryConfigDaoImpl, mysql.NewRetentionPolicyDaoImpl, taskDomainSet,
 	)
 	traceSet = wire.NewSet(
 		NewTraceApplication, repo.NewViewRepoImpl, mysql.NewViewDaoImpl, auth.NewAuthProvider, user.NewUserRPCProvider, tag.NewTagRPCProvider, traceDomainSet,
 	)
 	traceIngestionSet = wire.NewSet(
 		NewIngestionApplication, service.NewIngestionServiceImpl, provideTraceRepo, config.NewTraceConfigCenter, NewTraceConfigLoader,
-		NewIngestionCollectorFactory, producer.NewSpanWithAnnotationProducerImpl, redis2.NewSpansRedisDaoImpl, mysql.NewTrajectoryConfigDaoImpl,
+		NewIngestionCollectorFactory, producer.NewSpanWithAnnotationProducerImpl, redis2.NewSpansRedisDaoImpl, mysql.NewTrajectoryConfigDaoImpl, mysql.NewRetentionPolicyDaoImpl,
 	)
 	openApiSet = wire.NewSet(
 		NewOpenAPIApplication, auth.NewAuthProvider, traceDomainSet,
 	)
 	taskSet = wire.NewSet(tracehub.NewTraceHubImpl, NewTaskApplication, auth.NewAuthProvider, user.NewUserRPCProvider, evaluation.NewEvaluationRPCProvider, NewTaskLocker,
 		traceDomainSet, service3.NewTaskCallbackServiceImpl,
+		metricDomainSet,
+		alertDomainSet,
 	)
-	metricsSet = wire.NewSet(
-		NewMetricApplication, service2.NewMetricsService, provideTraceMetricRepo, repo.NewOfflineMetricRepoImpl, tenant.NewTenantProvider, auth.NewAuthProvider, NewTraceConfigLoader,
-		NewTraceProcessorBuilder, config.NewTraceConfigCenter, ck2.NewOfflineMetricDaoImpl, file.NewFileRPCProvider, NewMetricsPlatformConfig,
+	metricDomainSet    = wire.NewSet(service2.NewMetricsService, provideTraceMetricRepo, repo.NewOfflineMetricRepoImpl, ck2.NewOfflineMetricDaoImpl, NewMetricsPlatformConfig)
+	alertDomainSet     = wire.NewSet(service2.NewAlertServiceImpl, repo.NewAlertRuleRepoImpl, mysql.NewAlertRuleDaoImpl, notify.NewNotifyRPCAdapter, webhook.NewWebhookSender)
+	dashboardDomainSet = wire.NewSet(service2.NewDashboardServiceImpl, repo.NewDashboardRepoImpl, mysql.NewDashboardDaoImpl)
+	metricsSet         = wire.NewSet(
+		NewMetricApplication, tenant.NewTenantProvider, auth.NewAuthProvider, user.NewUserRPCProvider, NewTraceConfigLoader,
+		NewTraceProcessorBuilder, config.NewTraceConfigCenter, file.NewFileRPCProvider, metricDomainSet,
+		alertDomainSet,
+		dashboardDomainSet,
 	)
 )
 
@@ -357,12 +406,14 @@ func provideTraceRepo(
 	ckProvider ck.Provider,
 	spanProducer mq2.ISpanProducer,
 	trajectoryConfDao mysql.ITrajectoryConfigDao,
+	retentionPolicyDao mysql.IRetentionPolicyDao,
 	idGenerator idgen.IIDGenerator,
 ) (repo2.ITraceRepo, error) {
 	options, err := buildTraceRepoOptions(ckProvider)
 	if err != nil {
 		return nil, err
 	}
+	options = append(options, repo.WithRetentionPolicyDao(retentionPolicyDao))
 	return repo.NewTraceRepoImpl(traceConfig, storageProvider, spanRedisDao, spanProducer, trajectoryConfDao, idGenerator, options...)
 }
 
@@ -401,17 +452,17 @@ func NewTraceProcessorBuilder(
 	benefitSvc benefit.IBenefitService,
 ) service.TraceFilterProcessorBuilder {
 	return service.NewTraceFilterProcessorBuilder(span_filter.NewPlatformFilterFactory(
-		[]span_filter.Factory{span_filter.NewCozeLoopFilterFactory(), span_filter.NewPromptFilterFactory(traceConfig), span_filter.NewEvaluatorFilterFactory(), span_filter.NewEvalTargetFilterFactory()}), []span_processor.Factory{span_processor.NewPlatformProcessorFactory(traceConfig), span_processor.NewCheckProcessorFactory(), span_processor.NewAttrTosProcessorFactory(fileProvider), span_processor.NewExpireErrorProcessorFactory(benefitSvc)},
+		[]span_filter.Factory{span_filter.NewCozeLoopFilterFactory(), span_filter.NewPromptFilterFactory(traceConfig), span_filter.NewEvaluatorFilterFactory(), span_filter.NewEvalTargetFilterFactory()}), []span_processor.Factory{span_processor.NewPlatformProcessorFactory(traceConfig), span_processor.NewCheckProcessorFactory(), span_processor.NewAttrTosProcessorFactory(fileProvider), span_processor.NewExpireErrorProcessorFactory(benefitSvc), span_processor.NewQueryPIIRedactionProcessorFactory(traceConfig)},
 
-		[]span_processor.Factory{span_processor.NewPlatformProcessorFactory(traceConfig), span_processor.NewExpireErrorProcessorFactory(benefitSvc)},
+		[]span_processor.Factory{span_processor.NewPlatformProcessorFactory(traceConfig), span_processor.NewExpireErrorProcessorFactory(benefitSvc), span_processor.NewQueryPIIRedactionProcessorFactory(traceConfig)},
 
 		[]span_processor.Factory{span_processor.NewCheckProcessorFactory()},
 
-		[]span_processor.Factory{},
+		[]span_processor.Factory{span_processor.NewPIIRedactionProcessorFactory(traceConfig), span_processor.NewCostProcessorFactory(traceConfig)},
 
-		[]span_processor.Factory{span_processor.NewPlatformProcessorFactory(traceConfig), span_processor.NewCheckProcessorFactory(), span_processor.NewAttrTosProcessorFactory(fileProvider), span_processor.NewExpireErrorProcessorFactory(benefitSvc)},
+		[]span_processor.Factory{span_processor.NewPlatformProcessorFactory(traceConfig), span_processor.NewCheckProcessorFactory(), span_processor.NewAttrTosProcessorFactory(fileProvider), span_processor.NewExpireErrorProcessorFactory(benefitSvc), span_processor.NewQueryPIIRedactionProcessorFactory(traceConfig)},
 
-		[]span_processor.Factory{span_processor.NewPlatformProcessorFactory(traceConfig), span_processor.NewExpireErrorProcessorFactory(benefitSvc)})
+		[]span_processor.Factory{span_processor.NewPlatformProcessorFactory(traceConfig), span_processor.NewExpireErrorProcessorFactory(benefitSvc), span_processor.NewQueryPIIRedactionProcessorFactory(traceConfig)})
 }
 
 func NewMetricsPlatformConfig() *entity.PlatformMetrics {
@@ -429,10 +480,14 @@ func NewMetricsPlatformConfig() *entity.PlatformMetrics {
 				FieldName: loop_span.SpanFieldStatusCode,
 				FieldType: loop_span.FieldTypeLong,
 			},
+			"thread_id": &loop_span.FilterField{
+				FieldName: loop_span.SpanFieldThreadID,
+				FieldType: loop_span.FieldTypeString,
+			},
 		},
 		MetricGroups: map[string]*entity.MetricGroup{
 			"all": {
-				MetricDefinitions: []entity.IMetricDefinition{general.NewGeneralTotalCountMetric(), general.NewGeneralFailRatioMetric(), general.NewGeneralModelTotalTokensMetric(), general.NewGeneralModelLatencyMetric(), general.NewGeneralModelFailRatioMetric(), general.NewGeneralToolTotalCountMetric(), general.NewGeneralToolLatencyMetric(), general.NewGeneralToolFailRatioMetric(), model.NewModelDurationMetric(), model.NewModelInputTokenCountMetric(), model.NewModelOutputTokenCountMetric(), model.NewModelTotalCountPieMetric(), model.NewModelQPMAllMetric(), model.NewModelQPMFailMetric(), model.NewModelQPMSuccessMetric(), model.NewModelQPSAllMetric(), model.NewModelQPSFailMetric(), model.NewModelQPSSuccessMetric(), model.NewModelSuccessRatioMetric(), model.NewModelSystemTokenCountMetric(), model.NewModelTokenCountMetric(), model.NewModelTokenCountPieMetric(), model.NewModelToolChoiceTokenCountMetric(), model.NewModelTPMMetric(), model.NewModelTPOTMetric(), model.NewModelTPSMetric(), model.NewModelTTFTMetric(), model.NewModelTotalCountMetric(), model.NewModelTotalSuccessCountMetric(), model.NewModelTotalErrorCountMetricc(), service4.NewServiceDurationMetric(), service4.NewServiceExecutionStepCountMetric(), service4.NewServiceMessageCountMetric(), service4.NewServiceQPMAllMetric(), service4.NewServiceQPMSuccessMetric(), service4.NewServiceQPMFailMetric(), service4.NewServiceQPSAllMetric(), service4.NewServiceQPSSuccessMetric(), service4.NewServiceQPSFailMetric(), service4.NewServiceSpanCountMetric(), service4.NewServiceSpanErrorCountMetric(), service4.NewServiceSuccessRatioMetric(), service4.NewServiceTraceCountMetric(), service4.NewServiceTraceSuccessCountMetric(), service4.NewServiceTraceErrorCountMetric(), service4.NewServiceUserCountMetric(), service4.NewServiceUniqTraceMetric(), tool.NewToolDurationMetric(), tool.NewToolSuccessRatioMetric(), tool.NewToolTotalCountMetric(), tool.NewToolTotalCountPieMetric(), tool.NewToolTotalSuccessCountMetric(), tool.NewToolTotalErrorCountMetric(), agent.NewAgentExecutionStepAvgMetric(), agent.NewAgentToolExecutionStepAvgMetric(), agent.NewAgentModelExecutionStepAvgMetric()},
+				MetricDefinitions: []entity.IMetricDefinition{general.NewGeneralTotalCountMetric(), general.NewGeneralFailRatioMetric(), general.NewGeneralModelTotalTokensMetric(), general.NewGeneralTotalCostMetric(), general.NewGeneralModelLatencyMetric(), general.NewGeneralModelFailRatioMetric(), general.NewGeneralToolTotalCountMetric(), general.NewGeneralToolLatencyMetric(), general.NewGeneralToolFailRatioMetric(), model.NewModelDurationMetric(), model.NewModelInputTokenCountMetric(), model.NewModelOutputTokenCountMetric(), model.NewModelTotalCountPieMetric(), model.NewModelQPMAllMetric(), model.NewModelQPMFailMetric(), model.NewModelQPMSuccessMetric(), model.NewModelQPSAllMetric(), model.NewModelQPSFailMetric(), model.NewModelQPSSuccessMetric(), model.NewModelSuccessRatioMetric(), model.NewModelSystemTokenCountMetric(), model.NewModelTokenCountMetric(), model.NewModelTokenCountPieMetric(), model.NewModelToolChoiceTokenCountMetric(), model.NewModelTPMMetric(), model.NewModelTPOTMetric(), model.NewModelTPSMetric(), model.NewModelTTFTMetric(), model.NewModelTotalCountMetric(), model.NewModelTotalSuccessCountMetric(), model.NewModelTotalErrorCountMetricc(), model.NewModelCostMetric(), service4.NewServiceDurationMetric(), service4.NewServiceExecutionStepCountMetric(), service4.NewServiceMessageCountMetric(), service4.NewServiceQPMAllMetric(), service4.NewServiceQPMSuccessMetric(), service4.NewServiceQPMFailMetric(), service4.NewServiceQPSAllMetric(), service4.NewServiceQPSSuccessMetric(), service4.NewServiceQPSFailMetric(), service4.NewServiceSpanCountMetric(), service4.NewServiceSpanErrorCountMetric(), service4.NewServiceSuccessRatioMetric(), service4.NewServiceTraceCountMetric(), service4.NewServiceTraceSuccessCountMetric(), service4.NewServiceTraceErrorCountMetric(), service4.NewServiceUserCountMetric(), service4.NewServiceSessionCountMetric(), service4.NewServiceUniqTraceMetric(), tool.NewToolDurationMetric(), tool.NewToolSuccessRatioMetric(), tool.NewToolTotalCountMetric(), tool.NewToolTotalCountPieMetric(), tool.NewToolTotalSuccessCountMetric(), tool.NewToolTotalErrorCountMetric(), agent.NewAgentExecutionStepAvgMetric(), agent.NewAgentToolExecutionStepAvgMetric(), agent.NewAgentModelExecutionStepAvgMetric()},
 			},
 		},
 	}
@@ -458,11 +513,12 @@ func NewDatasetServiceAdapter(evalSetService evaluationsetservice.Client, datase
 }
 
 func NewInitTaskProcessor(datasetServiceProvider *service.DatasetServiceAdaptor, evalService rpc.IEvaluatorRPCAdapter,
-	evaluationService rpc.IEvaluationRPCAdapter, taskRepo repo4.ITaskRepo,
+	evaluationService rpc.IEvaluationRPCAdapter, taskRepo repo4.ITaskRepo, traceRepo repo2.ITraceRepo,
 ) *processor.TaskProcessor {
 	taskProcessor := processor.NewTaskProcessor()
 	taskProcessor.Register(entity3.TaskTypeAutoEval, processor.NewAutoEvaluateProcessor(
 		0, datasetServiceProvider, evalService, evaluationService, taskRepo, &processor.EvalTargetBuilderImpl{}))
+	taskProcessor.Register(entity3.TaskTypeAutoTag, processor.NewAutoTagProcessor(evalService, taskRepo, traceRepo))
 	return taskProcessor
 }
 
@@ -473,6 +529,9 @@ func NewScheduledTask(
 	taskService service3.ITaskService,
 	taskProcessor processor.TaskProcessor,
 	taskRepo repo4.ITaskRepo,
+	traceRepo repo2.ITraceRepo,
+	alertRepo repo3.IAlertRuleRepo,
+	alertService service2.IAlertService,
 ) []scheduledtask.ScheduledTask {
-	return []scheduledtask.ScheduledTask{scheduledtask2.NewStatusCheckTask(locker, config3, traceHubService, taskService, taskProcessor, taskRepo), scheduledtask2.NewLocalCacheRefreshTask(traceHubService, taskRepo)}
+	return []scheduledtask.ScheduledTask{scheduledtask2.NewStatusCheckTask(locker, config3, traceHubService, taskService, taskProcessor, taskRepo), scheduledtask2.NewLocalCacheRefreshTask(traceHubService, taskRepo), scheduledtask3.NewRetentionArchiveTask(locker, config3, traceRepo), scheduledtask4.NewAlertEvaluateTask(locker, alertRepo, alertService)}
 }
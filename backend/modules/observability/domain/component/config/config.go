@@ -143,6 +143,8 @@ type ITraceConfig interface {
 	GetSpanWithAnnotationMqProducerCfg(ctx context.Context) (*MqProducerCfg, error)
 	GetMetricPlatformTenants(ctx context.Context) (*PlatformTenantsCfg, error)
 	GetMetricQueryConfig(ctx context.Context) *MetricQueryConfig
+	GetModelPriceCatalog(ctx context.Context) (*ModelPriceCatalog, error)
+	GetPIIRedactionConfig(ctx context.Context) (*PIIRedactionConfig, error)
 
 	conf.IConfigLoader
 }
@@ -173,6 +173,36 @@ func (mr *MockITraceConfigMockRecorder) GetMetricQueryConfig(ctx any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricQueryConfig", reflect.TypeOf((*MockITraceConfig)(nil).GetMetricQueryConfig), ctx)
 }
 
+// GetModelPriceCatalog mocks base method.
+func (m *MockITraceConfig) GetModelPriceCatalog(ctx context.Context) (*config.ModelPriceCatalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelPriceCatalog", ctx)
+	ret0, _ := ret[0].(*config.ModelPriceCatalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelPriceCatalog indicates an expected call of GetModelPriceCatalog.
+func (mr *MockITraceConfigMockRecorder) GetModelPriceCatalog(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelPriceCatalog", reflect.TypeOf((*MockITraceConfig)(nil).GetModelPriceCatalog), ctx)
+}
+
+// GetPIIRedactionConfig mocks base method.
+func (m *MockITraceConfig) GetPIIRedactionConfig(ctx context.Context) (*config.PIIRedactionConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPIIRedactionConfig", ctx)
+	ret0, _ := ret[0].(*config.PIIRedactionConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPIIRedactionConfig indicates an expected call of GetPIIRedactionConfig.
+func (mr *MockITraceConfigMockRecorder) GetPIIRedactionConfig(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPIIRedactionConfig", reflect.TypeOf((*MockITraceConfig)(nil).GetPIIRedactionConfig), ctx)
+}
+
 // GetPlatformSpansTrans mocks base method.
 func (m *MockITraceConfig) GetPlatformSpansTrans(ctx context.Context) (*config.SpanTransHandlerConfig, error) {
 	m.ctrl.T.Helper()
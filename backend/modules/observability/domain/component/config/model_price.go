@@ -0,0 +1,70 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"strings"
+)
+
+const tokensPerPriceUnit = 1_000_000
+
+// ModelPriceCatalog 模型价格表，同一模型可以配置多个生效时间不同的价格
+type ModelPriceCatalog struct {
+	Currency string        `mapstructure:"currency" json:"currency"`
+	Prices   []*ModelPrice `mapstructure:"prices" json:"prices"`
+}
+
+// ModelPrice 单价均为每百万token的价格
+type ModelPrice struct {
+	// Provider 为空时匹配所有供应商
+	Provider         string  `mapstructure:"provider" json:"provider"`
+	Model            string  `mapstructure:"model" json:"model"`
+	InputPrice       float64 `mapstructure:"input_price" json:"input_price"`
+	OutputPrice      float64 `mapstructure:"output_price" json:"output_price"`
+	CachedInputPrice float64 `mapstructure:"cached_input_price" json:"cached_input_price"`
+	EffectiveFrom    int64   `mapstructure:"effective_from" json:"effective_from"` // ms, 为0表示一直生效
+}
+
+// GetPrice 返回at时刻生效的价格，指定供应商的价格优先于通配的价格，找不到时返回nil
+func (c *ModelPriceCatalog) GetPrice(provider, model string, at int64) *ModelPrice {
+	if c == nil || model == "" {
+		return nil
+	}
+	var ret *ModelPrice
+	for _, price := range c.Prices {
+		if price == nil || !strings.EqualFold(price.Model, model) || price.EffectiveFrom > at {
+			continue
+		}
+		if price.Provider != "" && !strings.EqualFold(price.Provider, provider) {
+			continue
+		}
+		switch {
+		case ret == nil:
+			ret = price
+		case (price.Provider != "") != (ret.Provider != ""):
+			if price.Provider != "" {
+				ret = price
+			}
+		case price.EffectiveFrom > ret.EffectiveFrom:
+			ret = price
+		}
+	}
+	return ret
+}
+
+// Cost 计算一次调用的费用，cachedInputTokens包含在inputTokens中，未配置缓存单价时按输入单价计算
+func (p *ModelPrice) Cost(inputTokens, outputTokens, cachedInputTokens int64) float64 {
+	if p == nil {
+		return 0
+	}
+	cachedInputTokens = min(max(cachedInputTokens, 0), max(inputTokens, 0))
+	cachedPrice := p.CachedInputPrice
+	if cachedPrice <= 0 {
+		cachedPrice = p.InputPrice
+	}
+	cost := float64(inputTokens-cachedInputTokens)*p.InputPrice +
+		float64(cachedInputTokens)*cachedPrice +
+		float64(outputTokens)*p.OutputPrice
+	return cost / tokensPerPriceUnit
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelPriceCatalog_GetPrice(t *testing.T) {
+	wildcard := &ModelPrice{Model: "gpt-4o", InputPrice: 3}
+	old := &ModelPrice{Provider: "openai", Model: "gpt-4o", InputPrice: 5}
+	current := &ModelPrice{Provider: "openai", Model: "gpt-4o", InputPrice: 2.5, EffectiveFrom: 1000}
+	catalog := &ModelPriceCatalog{Prices: []*ModelPrice{wildcard, current, old, nil}}
+
+	tests := []struct {
+		name     string
+		catalog  *ModelPriceCatalog
+		provider string
+		model    string
+		at       int64
+		want     *ModelPrice
+	}{
+		{name: "nil catalog", catalog: nil, model: "gpt-4o", at: 2000, want: nil},
+		{name: "empty model", catalog: catalog, provider: "openai", at: 2000, want: nil},
+		{name: "unknown model", catalog: catalog, provider: "openai", model: "gpt-5", at: 2000, want: nil},
+		{name: "latest effective price", catalog: catalog, provider: "OpenAI", model: "GPT-4o", at: 2000, want: current},
+		{name: "price before effective date", catalog: catalog, provider: "openai", model: "gpt-4o", at: 999, want: old},
+		{name: "fallback to wildcard provider", catalog: catalog, provider: "azure", model: "gpt-4o", at: 2000, want: wildcard},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.catalog.GetPrice(tt.provider, tt.model, tt.at))
+		})
+	}
+}
+
+func TestModelPrice_Cost(t *testing.T) {
+	price := &ModelPrice{InputPrice: 2, OutputPrice: 8, CachedInputPrice: 0.5}
+	assert.InDelta(t, (600*2+400*0.5+100*8)/1e6, price.Cost(1000, 100, 400), 1e-12)
+	// 缓存token数超过输入token数时按输入token数计算
+	assert.InDelta(t, 1000*0.5/1e6, price.Cost(1000, 0, 2000), 1e-12)
+	// 未配置缓存单价时按输入单价计算
+	assert.InDelta(t, 1000*2/1e6, (&ModelPrice{InputPrice: 2}).Cost(1000, 0, 400), 1e-12)
+	assert.Zero(t, (*ModelPrice)(nil).Cost(1000, 100, 0))
+}
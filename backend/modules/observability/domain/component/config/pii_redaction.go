@@ -0,0 +1,53 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+// 内置的敏感信息检测器
+const (
+	PIIDetectorEmail      = "email"
+	PIIDetectorPhone      = "phone"
+	PIIDetectorCreditCard = "credit_card"
+	PIIDetectorSecret     = "secret"
+)
+
+// PIIRedactionConfig 敏感信息脱敏配置，Default对所有空间生效，Workspaces按空间覆盖
+type PIIRedactionConfig struct {
+	Default    *PIIRedactionRule            `mapstructure:"default" json:"default"`
+	Workspaces map[string]*PIIRedactionRule `mapstructure:"workspaces" json:"workspaces"`
+}
+
+type PIIRedactionRule struct {
+	// Enable 写入时脱敏，脱敏后原文不会落库
+	Enable bool `mapstructure:"enable" json:"enable"`
+	// ApplyAtQuery 查询时脱敏，用于对历史数据或未在写入时脱敏的数据做展示层保护
+	ApplyAtQuery   bool          `mapstructure:"apply_at_query" json:"apply_at_query"`
+	Detectors      []string      `mapstructure:"detectors" json:"detectors"`
+	CustomPatterns []*PIIPattern `mapstructure:"custom_patterns" json:"custom_patterns"`
+	// Fields 为空时对Input和Output全文脱敏
+	Fields []*PIIField `mapstructure:"fields" json:"fields"`
+	// Mask 为空时替换为[REDACTED:<检测器名>]
+	Mask string `mapstructure:"mask" json:"mask"`
+}
+
+type PIIPattern struct {
+	Name  string `mapstructure:"name" json:"name"`
+	Regex string `mapstructure:"regex" json:"regex"`
+}
+
+// PIIField Key取值为Input、Output或Tags.<tag_key>，JSONPath为空时对整个字段脱敏
+type PIIField struct {
+	Key      string `mapstructure:"key" json:"key"`
+	JSONPath string `mapstructure:"json_path" json:"json_path"`
+}
+
+// GetRule 返回空间生效的脱敏规则，空间未单独配置时使用默认规则
+func (c *PIIRedactionConfig) GetRule(workspaceID string) *PIIRedactionRule {
+	if c == nil {
+		return nil
+	}
+	if rule, ok := c.Workspaces[workspaceID]; ok && rule != nil {
+		return rule
+	}
+	return c.Default
+}
@@ -20,6 +20,7 @@ const (
 	AuthActionTraceTaskList      = "listLoopTask"
 	AuthActionTraceTaskEdit      = "edit"
 	AuthActionTraceMetricRead    = "readLoopIndictor"
+	AuthActionTraceMetricEdit    = "edit"
 )
 
 //go:generate mockgen -destination=mocks/auth_provider.go -package=mocks . IAuthProvider
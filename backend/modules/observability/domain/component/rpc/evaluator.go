@@ -28,10 +28,24 @@ type ListEvaluatorsParam struct {
 	WorkspaceID int64
 	Name        *string
 }
+type RunEvaluatorParam struct {
+	WorkspaceID        int64
+	EvaluatorVersionID int64
+	InputFields        map[string]string // 评估器输入字段, 均按文本传入
+	Ext                map[string]string
+}
+type EvaluatorRunResult struct {
+	EvaluatorRecordID int64
+	Score             float64
+	Reasoning         string
+	Success           bool
+	ErrorMsg          string
+}
 
 //go:generate mockgen -destination=mocks/evaluator.go -package=mocks . IEvaluatorRPCAdapter
 type IEvaluatorRPCAdapter interface {
 	BatchGetEvaluatorVersions(ctx context.Context, param *BatchGetEvaluatorVersionsParam) ([]*Evaluator, map[int64]*Evaluator, error)
 	UpdateEvaluatorRecord(ctx context.Context, param *UpdateEvaluatorRecordParam) error
 	ListEvaluators(ctx context.Context, param *ListEvaluatorsParam) ([]*Evaluator, error)
+	RunEvaluator(ctx context.Context, param *RunEvaluatorParam) (*EvaluatorRunResult, error)
 }
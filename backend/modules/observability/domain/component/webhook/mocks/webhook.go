@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/webhook (interfaces: IWebhookSender)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/webhook.go -package=mocks . IWebhookSender
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWebhookSender is a mock of IWebhookSender interface.
+type MockIWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWebhookSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockIWebhookSenderMockRecorder is the mock recorder for MockIWebhookSender.
+type MockIWebhookSenderMockRecorder struct {
+	mock *MockIWebhookSender
+}
+
+// NewMockIWebhookSender creates a new mock instance.
+func NewMockIWebhookSender(ctrl *gomock.Controller) *MockIWebhookSender {
+	mock := &MockIWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockIWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWebhookSender) EXPECT() *MockIWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockIWebhookSender) Send(ctx context.Context, url string, headers map[string]string, body any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, url, headers, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockIWebhookSenderMockRecorder) Send(ctx, url, headers, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockIWebhookSender)(nil).Send), ctx, url, headers, body)
+}
@@ -0,0 +1,12 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import "context"
+
+//go:generate mockgen -destination=mocks/webhook.go -package=mocks . IWebhookSender
+type IWebhookSender interface {
+	// Send 以json格式POST请求体到url，非2xx响应视为失败
+	Send(ctx context.Context, url string, headers map[string]string, body any) error
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+type AggregationType string
+
+const (
+	AggregationTypeCount AggregationType = "count"
+	AggregationTypeSum   AggregationType = "sum"
+	AggregationTypeAvg   AggregationType = "avg"
+	AggregationTypeMin   AggregationType = "min"
+	AggregationTypeMax   AggregationType = "max"
+	AggregationTypeP50   AggregationType = "p50"
+	AggregationTypeP90   AggregationType = "p90"
+	AggregationTypeP99   AggregationType = "p99"
+	AggregationTypeUniq  AggregationType = "uniq"
+)
+
+var aggregationExpressions = map[AggregationType]string{
+	AggregationTypeCount: "count()",
+	AggregationTypeSum:   "sum(%s)",
+	AggregationTypeAvg:   "avg(%s)",
+	AggregationTypeMin:   "min(%s)",
+	AggregationTypeMax:   "max(%s)",
+	AggregationTypeP50:   "quantile(0.5)(%s)",
+	AggregationTypeP90:   "quantile(0.9)(%s)",
+	AggregationTypeP99:   "quantile(0.99)(%s)",
+	AggregationTypeUniq:  "uniq(%s)",
+}
+
+// Aggregation 即席聚合, 不依赖预定义的指标
+type Aggregation struct {
+	Type  AggregationType        `json:"type"`
+	Field *loop_span.FilterField `json:"field,omitempty"`
+	Alias string                 `json:"alias,omitempty"`
+}
+
+type AggregationRow struct {
+	Timestamp     string
+	GroupByValues map[string]string
+	Values        map[string]string
+}
+
+func (a *Aggregation) Validate() error {
+	if _, ok := aggregationExpressions[a.Type]; !ok {
+		return fmt.Errorf("aggregation type %s not supported", a.Type)
+	}
+	if a.Type == AggregationTypeCount {
+		return nil
+	}
+	if a.Field == nil || a.Field.FieldName == "" {
+		return fmt.Errorf("aggregation %s requires field", a.Type)
+	}
+	// 数值类聚合只能作用于数值字段
+	if a.Type != AggregationTypeUniq &&
+		a.Field.FieldType != loop_span.FieldTypeLong && a.Field.FieldType != loop_span.FieldTypeDouble {
+		return fmt.Errorf("aggregation %s requires numeric field, got %s", a.Type, a.Field.FieldType)
+	}
+	return nil
+}
+
+// Expression 返回聚合的计算表达式, 调用前需先Validate
+func (a *Aggregation) Expression() *Expression {
+	expr := &Expression{Expression: aggregationExpressions[a.Type]}
+	if a.Type != AggregationTypeCount {
+		expr.Fields = []*loop_span.FilterField{a.Field}
+	}
+	return expr
+}
+
+// Name 返回聚合结果的名称
+func (a *Aggregation) Name() string {
+	if a.Alias != "" {
+		return a.Alias
+	} else if a.Type == AggregationTypeCount {
+		return string(a.Type)
+	}
+	return fmt.Sprintf("%s(%s)", a.Type, a.Field.FieldName)
+}
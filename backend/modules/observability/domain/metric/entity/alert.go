@@ -0,0 +1,250 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+type (
+	AlertConditionType   string
+	AlertCompareOperator string
+	AlertStatus          string
+)
+
+const (
+	AlertConditionTypeThreshold AlertConditionType = "threshold" // 与固定阈值比较
+	AlertConditionTypeAnomaly   AlertConditionType = "anomaly"   // 与历史窗口的均值和标准差比较
+
+	AlertCompareOperatorGT  AlertCompareOperator = "gt"
+	AlertCompareOperatorGTE AlertCompareOperator = "gte"
+	AlertCompareOperatorLT  AlertCompareOperator = "lt"
+	AlertCompareOperatorLTE AlertCompareOperator = "lte"
+
+	AlertStatusOK     AlertStatus = "ok"
+	AlertStatusFiring AlertStatus = "firing"
+	AlertStatusNoData AlertStatus = "no_data"
+
+	DefaultAlertBaselineWindows = 6
+	MaxAlertBaselineWindows     = 24
+	MinAlertWindowSeconds       = 60
+	MaxAlertWindowSeconds       = 24 * 60 * 60
+)
+
+// AlertRule 空间级别的指标告警规则，由定时任务按窗口评估
+type AlertRule struct {
+	ID           int64
+	WorkspaceID  int64
+	Name         string
+	PlatformType loop_span.PlatformType
+	MetricName   string
+	// GroupKey 时间序列指标取哪一组，饼图指标取哪一项，为空时取全部
+	GroupKey      string
+	Filters       *loop_span.FilterFields
+	Condition     *AlertCondition
+	WindowSeconds int64
+	Enabled       bool
+	Notification  *AlertNotification
+	State         AlertState
+	CreatedAt     time.Time
+	CreatedBy     string
+	UpdatedAt     time.Time
+	UpdatedBy     string
+}
+
+type AlertCondition struct {
+	Type     AlertConditionType   `json:"type"`
+	Operator AlertCompareOperator `json:"operator"`
+	// Threshold 阈值条件使用
+	Threshold float64 `json:"threshold,omitempty"`
+	// Deviation 异常条件使用，偏离历史均值超过多少倍标准差时告警
+	Deviation float64 `json:"deviation,omitempty"`
+	// BaselineWindows 异常条件使用，参与计算基线的历史窗口数
+	BaselineWindows int `json:"baseline_windows,omitempty"`
+}
+
+type AlertNotification struct {
+	UserIDs        []string          `json:"user_ids,omitempty"`
+	WebhookURL     string            `json:"webhook_url,omitempty"`
+	WebhookHeaders map[string]string `json:"webhook_headers,omitempty"`
+}
+
+type AlertState struct {
+	Status          AlertStatus
+	LastValue       float64
+	LastEvaluatedAt int64 // ms
+	FiredAt         int64 // ms
+	ResolvedAt      int64 // ms
+}
+
+func (r *AlertRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("name is empty")
+	}
+	if r.MetricName == "" {
+		return fmt.Errorf("metric name is empty")
+	}
+	if r.WindowSeconds < MinAlertWindowSeconds || r.WindowSeconds > MaxAlertWindowSeconds {
+		return fmt.Errorf("window seconds should be in [%d, %d]", MinAlertWindowSeconds, MaxAlertWindowSeconds)
+	}
+	if r.Condition == nil {
+		return fmt.Errorf("condition is empty")
+	}
+	return r.Condition.Validate()
+}
+
+func (c *AlertCondition) Validate() error {
+	switch c.Operator {
+	case AlertCompareOperatorGT, AlertCompareOperatorGTE, AlertCompareOperatorLT, AlertCompareOperatorLTE:
+	default:
+		return fmt.Errorf("invalid operator %s", c.Operator)
+	}
+	switch c.Type {
+	case AlertConditionTypeThreshold:
+	case AlertConditionTypeAnomaly:
+		if c.Deviation <= 0 {
+			return fmt.Errorf("deviation should be greater than 0")
+		}
+		if c.BaselineWindows < 0 || c.BaselineWindows > MaxAlertBaselineWindows {
+			return fmt.Errorf("baseline windows should be in [0, %d]", MaxAlertBaselineWindows)
+		}
+	default:
+		return fmt.Errorf("invalid condition type %s", c.Type)
+	}
+	return nil
+}
+
+func (c *AlertCondition) GetBaselineWindows() int {
+	if c.Type != AlertConditionTypeAnomaly {
+		return 0
+	}
+	if c.BaselineWindows <= 0 {
+		return DefaultAlertBaselineWindows
+	}
+	return c.BaselineWindows
+}
+
+// IsBreached 判断当前值是否满足告警条件，异常条件下baseline为历史窗口的值，基线不足两个窗口时不告警
+func (c *AlertCondition) IsBreached(value float64, baseline []float64) bool {
+	switch c.Type {
+	case AlertConditionTypeThreshold:
+		return c.compare(value, c.Threshold)
+	case AlertConditionTypeAnomaly:
+		if len(baseline) < 2 {
+			return false
+		}
+		mean, stddev := meanAndStddev(baseline)
+		bound := c.Deviation * stddev
+		switch c.Operator {
+		case AlertCompareOperatorGT, AlertCompareOperatorGTE:
+			return c.compare(value, mean+bound)
+		default:
+			return c.compare(value, mean-bound)
+		}
+	default:
+		return false
+	}
+}
+
+func (c *AlertCondition) compare(value, target float64) bool {
+	switch c.Operator {
+	case AlertCompareOperatorGT:
+		return value > target
+	case AlertCompareOperatorGTE:
+		return value >= target
+	case AlertCompareOperatorLT:
+		return value < target
+	case AlertCompareOperatorLTE:
+		return value <= target
+	default:
+		return false
+	}
+}
+
+func meanAndStddev(values []float64) (float64, float64) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
+
+// Transit 根据本次评估结果更新状态，value为空表示窗口内没有数据
+// 告警中的规则在没有数据时保持告警，等有数据后再判断是否恢复
+func (r *AlertRule) Transit(value *float64, breached bool, now int64) {
+	prev := r.State.Status
+	r.State.LastEvaluatedAt = now
+	switch {
+	case value == nil:
+		if prev != AlertStatusFiring {
+			r.State.Status = AlertStatusNoData
+		}
+	case breached:
+		r.State.LastValue = *value
+		r.State.Status = AlertStatusFiring
+		if prev != AlertStatusFiring {
+			r.State.FiredAt = now
+		}
+	default:
+		r.State.LastValue = *value
+		r.State.Status = AlertStatusOK
+		if prev == AlertStatusFiring {
+			r.State.ResolvedAt = now
+		}
+	}
+}
+
+// ShouldNotify 只在触发和恢复时通知，无数据状态不通知
+func (r *AlertRule) ShouldNotify(prev AlertStatus) bool {
+	switch r.State.Status {
+	case AlertStatusFiring:
+		return prev != AlertStatusFiring
+	case AlertStatusOK:
+		return prev == AlertStatusFiring
+	default:
+		return false
+	}
+}
+
+// AlertEvent 告警触发或恢复时发送的事件，同时作为webhook的请求体
+type AlertEvent struct {
+	RuleID      int64           `json:"rule_id,string"`
+	RuleName    string          `json:"rule_name"`
+	WorkspaceID int64           `json:"workspace_id,string"`
+	MetricName  string          `json:"metric_name"`
+	GroupKey    string          `json:"group_key,omitempty"`
+	Status      AlertStatus     `json:"status"`
+	Value       float64         `json:"value"`
+	Condition   *AlertCondition `json:"condition"`
+	WindowSecs  int64           `json:"window_seconds"`
+	EvaluatedAt int64           `json:"evaluated_at"`
+	FiredAt     int64           `json:"fired_at,omitempty"`
+	ResolvedAt  int64           `json:"resolved_at,omitempty"`
+}
+
+func (r *AlertRule) ToEvent() *AlertEvent {
+	return &AlertEvent{
+		RuleID:      r.ID,
+		RuleName:    r.Name,
+		WorkspaceID: r.WorkspaceID,
+		MetricName:  r.MetricName,
+		GroupKey:    r.GroupKey,
+		Status:      r.State.Status,
+		Value:       r.State.LastValue,
+		Condition:   r.Condition,
+		WindowSecs:  r.WindowSeconds,
+		EvaluatedAt: r.State.LastEvaluatedAt,
+		FiredAt:     r.State.FiredAt,
+		ResolvedAt:  r.State.ResolvedAt,
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TestAlertRule_Validate(t *testing.T) {
+	valid := func() *AlertRule {
+		return &AlertRule{
+			Name:          "model error spike",
+			MetricName:    MetricNameModelTotalErrorCount,
+			WindowSeconds: 300,
+			Condition: &AlertCondition{
+				Type:      AlertConditionTypeThreshold,
+				Operator:  AlertCompareOperatorGT,
+				Threshold: 10,
+			},
+		}
+	}
+	assert.NoError(t, valid().Validate())
+
+	tests := []struct {
+		name   string
+		modify func(r *AlertRule)
+	}{
+		{name: "empty name", modify: func(r *AlertRule) { r.Name = "" }},
+		{name: "empty metric", modify: func(r *AlertRule) { r.MetricName = "" }},
+		{name: "window too small", modify: func(r *AlertRule) { r.WindowSeconds = 10 }},
+		{name: "no condition", modify: func(r *AlertRule) { r.Condition = nil }},
+		{name: "invalid operator", modify: func(r *AlertRule) { r.Condition.Operator = "eq" }},
+		{name: "anomaly without deviation", modify: func(r *AlertRule) { r.Condition.Type = AlertConditionTypeAnomaly }},
+		{name: "too many baseline windows", modify: func(r *AlertRule) {
+			r.Condition.Type = AlertConditionTypeAnomaly
+			r.Condition.Deviation = 3
+			r.Condition.BaselineWindows = MaxAlertBaselineWindows + 1
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.modify(r)
+			assert.Error(t, r.Validate())
+		})
+	}
+}
+
+func TestAlertCondition_IsBreached(t *testing.T) {
+	threshold := &AlertCondition{Type: AlertConditionTypeThreshold, Operator: AlertCompareOperatorGTE, Threshold: 10}
+	assert.True(t, threshold.IsBreached(10, nil))
+	assert.False(t, threshold.IsBreached(9, nil))
+
+	// 基线均值为10，标准差为2
+	baseline := []float64{8, 12, 8, 12}
+	upper := &AlertCondition{Type: AlertConditionTypeAnomaly, Operator: AlertCompareOperatorGT, Deviation: 3}
+	assert.True(t, upper.IsBreached(17, baseline))
+	assert.False(t, upper.IsBreached(15, baseline))
+	assert.False(t, upper.IsBreached(3, baseline))
+	assert.False(t, upper.IsBreached(100, baseline[:1]))
+
+	lower := &AlertCondition{Type: AlertConditionTypeAnomaly, Operator: AlertCompareOperatorLT, Deviation: 3}
+	assert.True(t, lower.IsBreached(3, baseline))
+	assert.False(t, lower.IsBreached(17, baseline))
+
+	assert.Equal(t, DefaultAlertBaselineWindows, upper.GetBaselineWindows())
+	assert.Equal(t, 0, threshold.GetBaselineWindows())
+}
+
+func TestAlertRule_Transit(t *testing.T) {
+	rule := &AlertRule{}
+
+	rule.Transit(nil, false, 1)
+	assert.Equal(t, AlertStatusNoData, rule.State.Status)
+	assert.False(t, rule.ShouldNotify(""))
+
+	rule.Transit(ptr.Of(20.0), true, 2)
+	assert.Equal(t, AlertStatusFiring, rule.State.Status)
+	assert.Equal(t, int64(2), rule.State.FiredAt)
+	assert.True(t, rule.ShouldNotify(AlertStatusNoData))
+
+	// 告警中没有数据时保持告警
+	rule.Transit(nil, false, 3)
+	assert.Equal(t, AlertStatusFiring, rule.State.Status)
+	assert.Equal(t, 20.0, rule.State.LastValue)
+	assert.False(t, rule.ShouldNotify(AlertStatusFiring))
+
+	rule.Transit(ptr.Of(1.0), false, 4)
+	assert.Equal(t, AlertStatusOK, rule.State.Status)
+	assert.Equal(t, int64(4), rule.State.ResolvedAt)
+	assert.Equal(t, int64(2), rule.State.FiredAt)
+	assert.True(t, rule.ShouldNotify(AlertStatusFiring))
+	assert.False(t, rule.ShouldNotify(AlertStatusOK))
+}
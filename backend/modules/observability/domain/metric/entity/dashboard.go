@@ -0,0 +1,172 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+type ChartType string
+
+const (
+	ChartTypeLine   ChartType = "line"
+	ChartTypeBar    ChartType = "bar"
+	ChartTypePie    ChartType = "pie"
+	ChartTypeNumber ChartType = "number"
+	ChartTypeTable  ChartType = "table"
+
+	MaxDashboardPanels        = 50
+	MaxPanelAggregations      = 10
+	MaxPanelGroupBys          = 5
+	maxDashboardNameLength    = 256
+	maxDashboardPanelIDLength = 64
+)
+
+// Dashboard 空间级别的自定义看板, 由多个面板组成
+type Dashboard struct {
+	ID           int64
+	WorkspaceID  int64
+	Name         string
+	Description  string
+	PlatformType loop_span.PlatformType
+	// Filters 作用于所有面板
+	Filters   *loop_span.FilterFields
+	Panels    []*DashboardPanel
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+// DashboardPanel 引用预定义指标或即席聚合, 二者只能选其一
+type DashboardPanel struct {
+	ID           string                   `json:"id"`
+	Title        string                   `json:"title"`
+	ChartType    ChartType                `json:"chart_type"`
+	MetricNames  []string                 `json:"metric_names,omitempty"`
+	Aggregations []*Aggregation           `json:"aggregations,omitempty"`
+	GroupBys     []*loop_span.FilterField `json:"group_bys,omitempty"`
+	Filters      *loop_span.FilterFields  `json:"filters,omitempty"`
+	Granularity  MetricGranularity        `json:"granularity,omitempty"`
+	Limit        int32                    `json:"limit,omitempty"`
+	Layout       *PanelLayout             `json:"layout,omitempty"`
+}
+
+type PanelLayout struct {
+	X      int32 `json:"x"`
+	Y      int32 `json:"y"`
+	Width  int32 `json:"width"`
+	Height int32 `json:"height"`
+}
+
+// RenderedPanel 面板的渲染结果, 单个面板失败不影响其他面板
+type RenderedPanel struct {
+	PanelID string
+	Metrics map[string]*Metric
+	Rows    []*AggregationRow
+	Error   string
+}
+
+func (d *Dashboard) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("name is empty")
+	} else if len(d.Name) > maxDashboardNameLength {
+		return fmt.Errorf("name exceeds %d bytes", maxDashboardNameLength)
+	}
+	if len(d.Panels) > MaxDashboardPanels {
+		return fmt.Errorf("at most %d panels are allowed", MaxDashboardPanels)
+	}
+	panelIDs := make(map[string]bool, len(d.Panels))
+	for _, panel := range d.Panels {
+		if panel == nil {
+			return fmt.Errorf("panel is empty")
+		}
+		if err := panel.Validate(); err != nil {
+			return fmt.Errorf("panel %s: %w", panel.ID, err)
+		}
+		if panelIDs[panel.ID] {
+			return fmt.Errorf("duplicate panel id %s", panel.ID)
+		}
+		panelIDs[panel.ID] = true
+	}
+	return nil
+}
+
+// GetPanel 按ID获取面板, 不存在时返回nil
+func (d *Dashboard) GetPanel(id string) *DashboardPanel {
+	for _, panel := range d.Panels {
+		if panel.ID == id {
+			return panel
+		}
+	}
+	return nil
+}
+
+// PanelFilters 合并看板, 面板以及请求中的过滤条件, 各部分之间取交集
+func (d *Dashboard) PanelFilters(panel *DashboardPanel, extra *loop_span.FilterFields) *loop_span.FilterFields {
+	ret := &loop_span.FilterFields{QueryAndOr: ptr.Of(loop_span.QueryAndOrEnumAnd)}
+	for _, f := range []*loop_span.FilterFields{d.Filters, panel.Filters, extra} {
+		if f == nil || len(f.FilterFields) == 0 {
+			continue
+		}
+		ret.FilterFields = append(ret.FilterFields, &loop_span.FilterField{
+			QueryAndOr: ptr.Of(loop_span.QueryAndOrEnumAnd),
+			SubFilter:  f,
+		})
+	}
+	if len(ret.FilterFields) == 0 {
+		return nil
+	}
+	return ret
+}
+
+func (p *DashboardPanel) Validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("id is empty")
+	} else if len(p.ID) > maxDashboardPanelIDLength {
+		return fmt.Errorf("id exceeds %d bytes", maxDashboardPanelIDLength)
+	}
+	if p.Title == "" {
+		return fmt.Errorf("title is empty")
+	}
+	switch p.ChartType {
+	case ChartTypeLine, ChartTypeBar, ChartTypePie, ChartTypeNumber, ChartTypeTable:
+	default:
+		return fmt.Errorf("invalid chart type %s", p.ChartType)
+	}
+	switch p.Granularity {
+	case "", MetricGranularity1Min, MetricGranularity1Hour, MetricGranularity1Day, MetricGranularity1Week:
+	default:
+		return fmt.Errorf("invalid granularity %s", p.Granularity)
+	}
+	if (len(p.MetricNames) > 0) == (len(p.Aggregations) > 0) {
+		return fmt.Errorf("exactly one of metric names and aggregations should be provided")
+	}
+	if len(p.Aggregations) > MaxPanelAggregations {
+		return fmt.Errorf("at most %d aggregations are allowed", MaxPanelAggregations)
+	}
+	for _, aggregation := range p.Aggregations {
+		if aggregation == nil {
+			return fmt.Errorf("aggregation is empty")
+		}
+		if err := aggregation.Validate(); err != nil {
+			return err
+		}
+	}
+	if len(p.GroupBys) > MaxPanelGroupBys {
+		return fmt.Errorf("at most %d group bys are allowed", MaxPanelGroupBys)
+	} else if len(p.GroupBys) > 0 && len(p.Aggregations) == 0 {
+		return fmt.Errorf("group bys are only supported by aggregations")
+	}
+	return nil
+}
+
+// IsAggregation 面板是否为即席聚合面板
+func (p *DashboardPanel) IsAggregation() bool {
+	return len(p.Aggregations) > 0
+}
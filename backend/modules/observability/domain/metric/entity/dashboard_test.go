@@ -0,0 +1,85 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+func TestDashboard_Validate(t *testing.T) {
+	valid := func() *Dashboard {
+		return &Dashboard{
+			Name: "overview",
+			Panels: []*DashboardPanel{
+				{ID: "p1", Title: "errors", ChartType: ChartTypeLine, MetricNames: []string{MetricNameModelTotalErrorCount}},
+				{
+					ID:        "p2",
+					Title:     "latency by model",
+					ChartType: ChartTypeBar,
+					Aggregations: []*Aggregation{{
+						Type:  AggregationTypeP90,
+						Field: &loop_span.FilterField{FieldName: "duration", FieldType: loop_span.FieldTypeLong},
+					}},
+					GroupBys: []*loop_span.FilterField{{FieldName: "model_name", FieldType: loop_span.FieldTypeString}},
+				},
+			},
+		}
+	}
+	assert.NoError(t, valid().Validate())
+
+	tests := []struct {
+		name   string
+		modify func(d *Dashboard)
+	}{
+		{name: "empty name", modify: func(d *Dashboard) { d.Name = "" }},
+		{name: "duplicate panel id", modify: func(d *Dashboard) { d.Panels[1].ID = "p1" }},
+		{name: "invalid chart type", modify: func(d *Dashboard) { d.Panels[0].ChartType = "radar" }},
+		{name: "invalid granularity", modify: func(d *Dashboard) { d.Panels[0].Granularity = "5min" }},
+		{name: "both metrics and aggregations", modify: func(d *Dashboard) { d.Panels[1].MetricNames = []string{"m"} }},
+		{name: "neither metrics nor aggregations", modify: func(d *Dashboard) { d.Panels[0].MetricNames = nil }},
+		{name: "group by without aggregations", modify: func(d *Dashboard) {
+			d.Panels[0].GroupBys = []*loop_span.FilterField{{FieldName: "model_name"}}
+		}},
+		{name: "invalid aggregation", modify: func(d *Dashboard) { d.Panels[1].Aggregations[0].Field = nil }},
+		{name: "too many panels", modify: func(d *Dashboard) {
+			for i := 0; i < MaxDashboardPanels; i++ {
+				d.Panels = append(d.Panels, d.Panels[0])
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := valid()
+			tt.modify(d)
+			assert.Error(t, d.Validate())
+		})
+	}
+}
+
+func TestDashboard_PanelFilters(t *testing.T) {
+	status := &loop_span.FilterFields{FilterFields: []*loop_span.FilterField{
+		{FieldName: loop_span.SpanFieldStatus, FieldType: loop_span.FieldTypeString, Values: []string{"error"}},
+	}}
+	model := &loop_span.FilterFields{FilterFields: []*loop_span.FilterField{
+		{FieldName: "model_name", FieldType: loop_span.FieldTypeString, Values: []string{"gpt-4o"}},
+	}}
+
+	d := &Dashboard{}
+	panel := &DashboardPanel{}
+	assert.Nil(t, d.PanelFilters(panel, &loop_span.FilterFields{}))
+
+	d.Filters = status
+	panel.Filters = model
+	got := d.PanelFilters(panel, nil)
+	if assert.Len(t, got.FilterFields, 2) {
+		assert.Equal(t, loop_span.QueryAndOrEnumAnd, *got.QueryAndOr)
+		assert.Same(t, status, got.FilterFields[0].SubFilter)
+		assert.Same(t, model, got.FilterFields[1].SubFilter)
+	}
+	assert.Len(t, d.PanelFilters(panel, status).FilterFields, 3)
+}
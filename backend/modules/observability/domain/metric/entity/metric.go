@@ -5,6 +5,7 @@ package entity
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -45,6 +46,7 @@ const (
 	MetricNameGeneralToolTotalCount   = "general_tool_total_count"
 	MetricNameGeneralToolFailRatio    = "general_tool_fail_ratio"
 	MetricNameGeneralToolLatencyAvg   = "general_tool_latency_avg"
+	MetricNameGeneralTotalCost        = "general_total_cost"
 
 	// Model 模型统计指标
 	MetricNameModelTokenCount           = "model_token_count"
@@ -70,6 +72,7 @@ const (
 	MetricNameModelTotalErrorCount      = "model_total_error_count"
 	MetricNameModelTotalSuccessCount    = "model_success_error_count"
 	MetricNameModelErrorCodePie         = "model_error_code_pie"
+	MetricNameModelCost                 = "model_cost"
 
 	// Tool 工具统计指标
 	MetricNameToolTotalCount        = "tool_total_count"
@@ -90,6 +93,7 @@ const (
 	MetricNameServiceSpanSuccessCount   = "service_span_success_count"
 	MetricNameServiceUserCount          = "service_user_count"
 	MetricNameServiceMessageCount       = "service_message_count"
+	MetricNameServiceSessionCount       = "service_session_count"
 	MetricNameServiceQPSAll             = "service_qps_all"
 	MetricNameServiceQPSSuccess         = "service_qps_success"
 	MetricNameServiceQPSFail            = "service_qps_fail"
@@ -202,6 +206,23 @@ func GranularityToSecond(g MetricGranularity) int64 {
 	}
 }
 
+// CheckGranularityRange 粒度越细可查询的时间范围越小, 避免数据点过多
+func CheckGranularityRange(startTime, endTime int64, g MetricGranularity) error {
+	var maxRange time.Duration
+	switch g {
+	case MetricGranularity1Min:
+		maxRange = 3 * time.Hour
+	case MetricGranularity1Hour:
+		maxRange = 6 * 24 * time.Hour
+	default:
+		return nil
+	}
+	if endTime-startTime > maxRange.Milliseconds() {
+		return fmt.Errorf("time range too large for granularity %s", g)
+	}
+	return nil
+}
+
 func NewTimeIntervals(startTime, endTime int64, granularity MetricGranularity) []string {
 	var truncatedTime int64
 	intervalMills := GranularityToSecond(granularity) * 1000
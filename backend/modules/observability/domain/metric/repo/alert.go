@@ -0,0 +1,36 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+)
+
+type ListAlertRulesParam struct {
+	WorkspaceID int64
+	MetricName  string
+	Status      entity.AlertStatus
+	// CursorID 按ID升序分页，返回ID大于CursorID的规则
+	CursorID int64
+	Limit    int
+}
+
+type ListEnabledAlertRulesParam struct {
+	CursorID int64
+	Limit    int
+}
+
+//go:generate mockgen -destination=mocks/alert.go -package=mocks . IAlertRuleRepo
+type IAlertRuleRepo interface {
+	CreateAlertRule(ctx context.Context, rule *entity.AlertRule) (int64, error)
+	// UpdateAlertRule 只更新规则配置，不修改告警状态
+	UpdateAlertRule(ctx context.Context, rule *entity.AlertRule) error
+	DeleteAlertRule(ctx context.Context, workspaceID, id int64) error
+	GetAlertRule(ctx context.Context, workspaceID, id int64) (*entity.AlertRule, error)
+	ListAlertRules(ctx context.Context, param *ListAlertRulesParam) ([]*entity.AlertRule, error)
+	ListEnabledAlertRules(ctx context.Context, param *ListEnabledAlertRulesParam) ([]*entity.AlertRule, error)
+	UpdateAlertState(ctx context.Context, id int64, state entity.AlertState) error
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+)
+
+type ListDashboardsParam struct {
+	WorkspaceID int64
+	// CursorID 按ID升序分页，返回ID大于CursorID的看板
+	CursorID int64
+	Limit    int
+}
+
+//go:generate mockgen -destination=mocks/dashboard.go -package=mocks . IDashboardRepo
+type IDashboardRepo interface {
+	CreateDashboard(ctx context.Context, dashboard *entity.Dashboard) (int64, error)
+	UpdateDashboard(ctx context.Context, dashboard *entity.Dashboard) error
+	DeleteDashboard(ctx context.Context, workspaceID, id int64) error
+	GetDashboard(ctx context.Context, workspaceID, id int64) (*entity.Dashboard, error)
+	ListDashboards(ctx context.Context, param *ListDashboardsParam) ([]*entity.Dashboard, error)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/common"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
 )
 
@@ -22,6 +23,8 @@ type GetMetricsParam struct {
 	EndAt        int64
 	Granularity  entity.MetricGranularity
 	Source       string
+	OrderBys     []*common.OrderBy // 按别名排序, 为空时按时间桶排序
+	Limit        int32
 }
 
 type GetMetricsResult struct {
@@ -0,0 +1,183 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/common"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_filter"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/conv"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	defaultAggregationLimit = 100
+	maxAggregationLimit     = 1000
+
+	// 用户指定的名称不直接拼入SQL, 查询时统一使用内部别名
+	aggregationAliasPrefix = "aggr_"
+	groupByAliasPrefix     = "group_"
+)
+
+type QueryAggregationsReq struct {
+	PlatformType loop_span.PlatformType
+	WorkspaceID  int64
+	Aggregations []*entity.Aggregation
+	GroupBys     []*loop_span.FilterField
+	FilterFields *loop_span.FilterFields
+	Granularity  entity.MetricGranularity // 为空时不按时间分桶
+	StartTime    int64
+	EndTime      int64
+	Limit        int32
+}
+
+type QueryAggregationsResp struct {
+	Rows []*entity.AggregationRow
+}
+
+// QueryAggregations 对任意span字段做即席聚合, 只查询在线数据
+func (m *MetricsService) QueryAggregations(ctx context.Context, req *QueryAggregationsReq) (*QueryAggregationsResp, error) {
+	if err := m.validateAggregationsReq(req); err != nil {
+		return nil, err
+	}
+	qCfg := m.traceConfig.GetMetricQueryConfig(ctx)
+	val := qCfg.SpaceConfigs[strconv.FormatInt(req.WorkspaceID, 10)]
+	if val != nil && val.DisableQuery {
+		return &QueryAggregationsResp{}, nil
+	}
+	if req.FilterFields != nil {
+		if err := req.FilterFields.Traverse(processSpecificFilter); err != nil {
+			return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInvalidParamCodeCode)
+		}
+	}
+	filter, err := m.buildHelper.BuildPlatformRelatedFilter(ctx, req.PlatformType)
+	if err != nil {
+		return nil, err
+	}
+	tenants, err := m.tenantProvider.GetMetricTenantsByPlatformType(ctx, req.PlatformType)
+	if err != nil {
+		return nil, err
+	}
+	mFilter, err := m.buildFilter(ctx, &metricQueryBuilder{
+		filter: filter,
+		spanEnv: &span_filter.SpanEnv{
+			WorkspaceID: req.WorkspaceID,
+		},
+		requestFilter: req.FilterFields,
+		mInfo:         &metricInfo{},
+	})
+	if err != nil {
+		return nil, err
+	} else if mFilter == nil {
+		return &QueryAggregationsResp{}, nil
+	}
+	param := &repo.GetMetricsParam{
+		WorkSpaceID: strconv.FormatInt(req.WorkspaceID, 10),
+		Tenants:     tenants,
+		Filters:     mFilter,
+		StartAt:     req.StartTime,
+		EndAt:       req.EndTime,
+		Granularity: req.Granularity,
+		Limit:       req.Limit,
+	}
+	for i, aggregation := range req.Aggregations {
+		param.Aggregations = append(param.Aggregations, &entity.Dimension{
+			Expression: aggregation.Expression(),
+			Alias:      aggregationAlias(i),
+		})
+	}
+	for i, field := range req.GroupBys {
+		param.GroupBys = append(param.GroupBys, &entity.Dimension{
+			Field: field,
+			Alias: groupByAlias(i),
+		})
+	}
+	// 分桶时按时间升序; 不分桶时按第一个聚合值降序, 便于取TopN
+	if req.Granularity != "" {
+		param.OrderBys = []*common.OrderBy{{Field: timeBucketKey, IsAsc: true}}
+	} else if len(req.GroupBys) > 0 {
+		param.OrderBys = []*common.OrderBy{{Field: aggregationAlias(0)}}
+	}
+	st := time.Now()
+	result, err := m.metricRepo.GetMetrics(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	logs.CtxInfo(ctx, "query %d aggregations successfully, cost %v", len(req.Aggregations), time.Since(st))
+	return &QueryAggregationsResp{
+		Rows: m.formatAggregationRows(result.Data, req),
+	}, nil
+}
+
+func (m *MetricsService) validateAggregationsReq(req *QueryAggregationsReq) error {
+	if len(req.Aggregations) == 0 {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("no aggregation provided"))
+	}
+	names := make(map[string]bool, len(req.Aggregations))
+	for _, aggregation := range req.Aggregations {
+		if aggregation == nil {
+			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid aggregation"))
+		}
+		if err := aggregation.Validate(); err != nil {
+			return errorx.WrapByCode(err, obErrorx.CommercialCommonInvalidParamCodeCode)
+		}
+		name := aggregation.Name()
+		if names[name] {
+			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode,
+				errorx.WithExtraMsg(fmt.Sprintf("duplicate aggregation %s", name)))
+		}
+		names[name] = true
+	}
+	for _, field := range req.GroupBys {
+		if field == nil || field.FieldName == "" {
+			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid group by field"))
+		}
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultAggregationLimit
+	} else if req.Limit > maxAggregationLimit {
+		req.Limit = maxAggregationLimit
+	}
+	return nil
+}
+
+func (m *MetricsService) formatAggregationRows(data []map[string]any, req *QueryAggregationsReq) []*entity.AggregationRow {
+	rows := make([]*entity.AggregationRow, 0, len(data))
+	for _, item := range data {
+		row := &entity.AggregationRow{
+			Values: make(map[string]string, len(req.Aggregations)),
+		}
+		if req.Granularity != "" {
+			row.Timestamp = conv.ToString(item[timeBucketKey])
+		}
+		for i, aggregation := range req.Aggregations {
+			row.Values[aggregation.Name()] = getMetricValue(item[aggregationAlias(i)])
+		}
+		if len(req.GroupBys) > 0 {
+			row.GroupByValues = make(map[string]string, len(req.GroupBys))
+			for i, field := range req.GroupBys {
+				row.GroupByValues[field.FieldName] = conv.ToString(item[groupByAlias(i)])
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func aggregationAlias(i int) string {
+	return aggregationAliasPrefix + strconv.Itoa(i)
+}
+
+func groupByAlias(i int) string {
+	return groupByAliasPrefix + strconv.Itoa(i)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	configmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config/mocks"
+	tenantmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/tenant/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	traceServicemocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/mocks"
+	spanfiltermocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_filter/mocks"
+)
+
+func TestMetricsService_QueryAggregations(t *testing.T) {
+	t.Parallel()
+
+	latency := &loop_span.FilterField{FieldName: loop_span.SpanFieldDuration, FieldType: loop_span.FieldTypeLong}
+	model := &loop_span.FilterField{FieldName: "model_name", FieldType: loop_span.FieldTypeString}
+
+	t.Run("group by without granularity", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repoMock := repomocks.NewMockIMetricRepo(ctrl)
+		tenantMock := tenantmocks.NewMockITenantProvider(ctrl)
+		builderMock := traceServicemocks.NewMockTraceFilterProcessorBuilder(ctrl)
+		filterMock := spanfiltermocks.NewMockFilter(ctrl)
+		traceConfigMock := configmocks.NewMockITraceConfig(ctrl)
+
+		traceConfigMock.EXPECT().GetMetricQueryConfig(gomock.Any()).Return(&config.MetricQueryConfig{}).AnyTimes()
+		tenantMock.EXPECT().GetMetricTenantsByPlatformType(gomock.Any(), gomock.Any()).Return([]string{"tenant-1"}, nil)
+		builderMock.EXPECT().BuildPlatformRelatedFilter(gomock.Any(), gomock.Any()).Return(filterMock, nil)
+		filterMock.EXPECT().BuildBasicSpanFilter(gomock.Any(), gomock.Any()).Return([]*loop_span.FilterField{{FieldName: "workspace"}}, true, nil)
+		repoMock.EXPECT().GetMetrics(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *repo.GetMetricsParam) (*repo.GetMetricsResult, error) {
+				assert.Len(t, param.Aggregations, 2)
+				assert.Equal(t, "quantile(0.99)(%s)", param.Aggregations[0].Expression.Expression)
+				assert.Equal(t, "aggr_0", param.Aggregations[0].Alias)
+				assert.Equal(t, "group_0", param.GroupBys[0].Alias)
+				assert.Equal(t, "aggr_0", param.OrderBys[0].Field)
+				assert.False(t, param.OrderBys[0].IsAsc)
+				assert.Equal(t, int32(defaultAggregationLimit), param.Limit)
+				return &repo.GetMetricsResult{
+					Data: []map[string]any{
+						{"group_0": "gpt-4o", "aggr_0": 1200.0, "aggr_1": uint64(10)},
+						{"group_0": "gpt-4o-mini", "aggr_0": "NaN", "aggr_1": uint64(3)},
+					},
+				}, nil
+			},
+		)
+
+		svc, err := NewMetricsService(repoMock, nil, tenantMock, builderMock, traceConfigMock, &entity.PlatformMetrics{})
+		assert.NoError(t, err)
+		resp, err := svc.QueryAggregations(context.Background(), &QueryAggregationsReq{
+			PlatformType: loop_span.PlatformCozeLoop,
+			WorkspaceID:  1,
+			Aggregations: []*entity.Aggregation{
+				{Type: entity.AggregationTypeP99, Field: latency},
+				{Type: entity.AggregationTypeCount, Alias: "calls"},
+			},
+			GroupBys: []*loop_span.FilterField{model},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Rows, 2)
+		assert.Equal(t, map[string]string{"model_name": "gpt-4o"}, resp.Rows[0].GroupByValues)
+		assert.Equal(t, map[string]string{"p99(duration)": "1200", "calls": "10"}, resp.Rows[0].Values)
+		assert.Equal(t, "null", resp.Rows[1].Values["p99(duration)"])
+		assert.Empty(t, resp.Rows[0].Timestamp)
+	})
+
+	t.Run("time series", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repoMock := repomocks.NewMockIMetricRepo(ctrl)
+		tenantMock := tenantmocks.NewMockITenantProvider(ctrl)
+		builderMock := traceServicemocks.NewMockTraceFilterProcessorBuilder(ctrl)
+		filterMock := spanfiltermocks.NewMockFilter(ctrl)
+		traceConfigMock := configmocks.NewMockITraceConfig(ctrl)
+
+		traceConfigMock.EXPECT().GetMetricQueryConfig(gomock.Any()).Return(&config.MetricQueryConfig{}).AnyTimes()
+		tenantMock.EXPECT().GetMetricTenantsByPlatformType(gomock.Any(), gomock.Any()).Return([]string{"tenant-1"}, nil)
+		builderMock.EXPECT().BuildPlatformRelatedFilter(gomock.Any(), gomock.Any()).Return(filterMock, nil)
+		filterMock.EXPECT().BuildBasicSpanFilter(gomock.Any(), gomock.Any()).Return([]*loop_span.FilterField{{FieldName: "workspace"}}, true, nil)
+		repoMock.EXPECT().GetMetrics(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, param *repo.GetMetricsParam) (*repo.GetMetricsResult, error) {
+				assert.Equal(t, entity.MetricGranularity1Hour, param.Granularity)
+				assert.Equal(t, timeBucketKey, param.OrderBys[0].Field)
+				assert.True(t, param.OrderBys[0].IsAsc)
+				assert.Equal(t, int32(maxAggregationLimit), param.Limit)
+				return &repo.GetMetricsResult{
+					Data: []map[string]any{{"time_bucket": "3600000", "aggr_0": 2.5}},
+				}, nil
+			},
+		)
+
+		svc, err := NewMetricsService(repoMock, nil, tenantMock, builderMock, traceConfigMock, &entity.PlatformMetrics{})
+		assert.NoError(t, err)
+		resp, err := svc.QueryAggregations(context.Background(), &QueryAggregationsReq{
+			PlatformType: loop_span.PlatformCozeLoop,
+			WorkspaceID:  1,
+			Aggregations: []*entity.Aggregation{{Type: entity.AggregationTypeAvg, Field: latency}},
+			Granularity:  entity.MetricGranularity1Hour,
+			Limit:        5000,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Rows, 1)
+		assert.Equal(t, "3600000", resp.Rows[0].Timestamp)
+		assert.Equal(t, "2.5", resp.Rows[0].Values["avg(duration)"])
+	})
+
+	t.Run("invalid aggregations", func(t *testing.T) {
+		t.Parallel()
+		svc := &MetricsService{}
+		cases := [][]*entity.Aggregation{
+			nil,
+			{nil},
+			{{Type: "median", Field: latency}},
+			{{Type: entity.AggregationTypeSum}},
+			{{Type: entity.AggregationTypeSum, Field: model}},
+			{{Type: entity.AggregationTypeCount}, {Type: entity.AggregationTypeCount}},
+		}
+		for _, aggregations := range cases {
+			_, err := svc.QueryAggregations(context.Background(), &QueryAggregationsReq{
+				WorkspaceID:  1,
+				Aggregations: aggregations,
+			})
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("query disabled", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		traceConfigMock := configmocks.NewMockITraceConfig(ctrl)
+		traceConfigMock.EXPECT().GetMetricQueryConfig(gomock.Any()).Return(&config.MetricQueryConfig{
+			SpaceConfigs: map[string]*config.SpaceConfig{"1": {DisableQuery: true}},
+		})
+		svc := &MetricsService{traceConfig: traceConfigMock}
+		resp, err := svc.QueryAggregations(context.Background(), &QueryAggregationsReq{
+			WorkspaceID:  1,
+			Aggregations: []*entity.Aggregation{{Type: entity.AggregationTypeCount}},
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, resp.Rows)
+	})
+}
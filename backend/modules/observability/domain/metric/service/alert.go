@@ -0,0 +1,289 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/webhook"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/mem"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	AlertNotifyCardID = "observability_metric_alert"
+
+	defaultAlertPageSize = 20
+	maxAlertPageSize     = 100
+)
+
+type ListAlertRulesReq struct {
+	WorkspaceID int64
+	MetricName  string
+	Status      entity.AlertStatus
+	PageSize    int32
+	PageToken   string
+}
+
+type ListAlertRulesResp struct {
+	Rules         []*entity.AlertRule
+	NextPageToken string
+	HasMore       bool
+}
+
+//go:generate mockgen -destination=mocks/alert.go -package=mocks . IAlertService
+type IAlertService interface {
+	CreateAlertRule(ctx context.Context, rule *entity.AlertRule) (int64, error)
+	UpdateAlertRule(ctx context.Context, rule *entity.AlertRule) error
+	DeleteAlertRule(ctx context.Context, workspaceID, id int64) error
+	ListAlertRules(ctx context.Context, req *ListAlertRulesReq) (*ListAlertRulesResp, error)
+	// EvaluateAlertRule 评估截止到now的窗口，更新规则状态并在触发或恢复时发送通知
+	EvaluateAlertRule(ctx context.Context, rule *entity.AlertRule, now time.Time) error
+}
+
+type AlertServiceImpl struct {
+	alertRepo     repo.IAlertRuleRepo
+	metricService IMetricsService
+	userProvider  rpc.IUserProvider
+	notifyAdapter rpc.INotifyRPCAdapter
+	webhookSender webhook.IWebhookSender
+}
+
+func NewAlertServiceImpl(
+	alertRepo repo.IAlertRuleRepo,
+	metricService IMetricsService,
+	userProvider rpc.IUserProvider,
+	notifyAdapter rpc.INotifyRPCAdapter,
+	webhookSender webhook.IWebhookSender,
+) IAlertService {
+	return &AlertServiceImpl{
+		alertRepo:     alertRepo,
+		metricService: metricService,
+		userProvider:  userProvider,
+		notifyAdapter: notifyAdapter,
+		webhookSender: webhookSender,
+	}
+}
+
+func (a *AlertServiceImpl) CreateAlertRule(ctx context.Context, rule *entity.AlertRule) (int64, error) {
+	if err := a.validateAlertRule(rule); err != nil {
+		return 0, err
+	}
+	return a.alertRepo.CreateAlertRule(ctx, rule)
+}
+
+func (a *AlertServiceImpl) UpdateAlertRule(ctx context.Context, rule *entity.AlertRule) error {
+	if err := a.validateAlertRule(rule); err != nil {
+		return err
+	}
+	origin, err := a.alertRepo.GetAlertRule(ctx, rule.WorkspaceID, rule.ID)
+	if err != nil {
+		return err
+	} else if origin == nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("alert rule not found"))
+	}
+	return a.alertRepo.UpdateAlertRule(ctx, rule)
+}
+
+func (a *AlertServiceImpl) DeleteAlertRule(ctx context.Context, workspaceID, id int64) error {
+	return a.alertRepo.DeleteAlertRule(ctx, workspaceID, id)
+}
+
+func (a *AlertServiceImpl) ListAlertRules(ctx context.Context, req *ListAlertRulesReq) (*ListAlertRulesResp, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultAlertPageSize
+	} else if pageSize > maxAlertPageSize {
+		pageSize = maxAlertPageSize
+	}
+	var cursorID int64
+	if req.PageToken != "" {
+		id, err := strconv.ParseInt(req.PageToken, 10, 64)
+		if err != nil {
+			return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid page_token"))
+		}
+		cursorID = id
+	}
+	// 多查一条用于判断是否还有下一页
+	rules, err := a.alertRepo.ListAlertRules(ctx, &repo.ListAlertRulesParam{
+		WorkspaceID: req.WorkspaceID,
+		MetricName:  req.MetricName,
+		Status:      req.Status,
+		CursorID:    cursorID,
+		Limit:       pageSize + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &ListAlertRulesResp{Rules: rules}
+	if len(rules) > pageSize {
+		resp.Rules = rules[:pageSize]
+		resp.HasMore = true
+		resp.NextPageToken = strconv.FormatInt(resp.Rules[pageSize-1].ID, 10)
+	}
+	return resp, nil
+}
+
+func (a *AlertServiceImpl) validateAlertRule(rule *entity.AlertRule) error {
+	if err := rule.Validate(); err != nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg(err.Error()))
+	}
+	if _, err := a.metricService.GetMetricGroupBy(rule.MetricName); err != nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return nil
+}
+
+func (a *AlertServiceImpl) EvaluateAlertRule(ctx context.Context, rule *entity.AlertRule, now time.Time) error {
+	end := now.UnixMilli()
+	window := rule.WindowSeconds * 1000
+	value, err := a.queryAlertValue(ctx, rule, end-window, end)
+	if err != nil {
+		return err
+	}
+	breached := false
+	if value != nil {
+		var baseline []float64
+		for i := 1; i <= rule.Condition.GetBaselineWindows(); i++ {
+			v, err := a.queryAlertValue(ctx, rule, end-int64(i+1)*window, end-int64(i)*window)
+			if err != nil {
+				return err
+			}
+			if v != nil {
+				baseline = append(baseline, *v)
+			}
+		}
+		breached = rule.Condition.IsBreached(*value, baseline)
+	}
+	prev := rule.State.Status
+	rule.Transit(value, breached, end)
+	if err := a.alertRepo.UpdateAlertState(ctx, rule.ID, rule.State); err != nil {
+		return err
+	}
+	if rule.ShouldNotify(prev) {
+		a.notify(ctx, rule)
+	}
+	return nil
+}
+
+// queryAlertValue 把指标在窗口内的结果折算成一个值，没有数据时返回nil
+func (a *AlertServiceImpl) queryAlertValue(ctx context.Context, rule *entity.AlertRule, start, end int64) (*float64, error) {
+	// 查询过程中会改写过滤条件，每次查询使用副本
+	var filters *loop_span.FilterFields
+	if rule.Filters != nil {
+		filters = &loop_span.FilterFields{}
+		if err := mem.DeepCopy(rule.Filters, filters); err != nil {
+			return nil, err
+		}
+	}
+	granularity := entity.MetricGranularity1Min
+	if end-start > 3*time.Hour.Milliseconds() {
+		granularity = entity.MetricGranularity1Hour
+	}
+	resp, err := a.metricService.QueryMetrics(ctx, &QueryMetricsReq{
+		PlatformType: rule.PlatformType,
+		WorkspaceID:  rule.WorkspaceID,
+		MetricsNames: []string{rule.MetricName},
+		Granularity:  granularity,
+		FilterFields: filters,
+		StartTime:    start,
+		EndTime:      end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alertValueOf(resp.Metrics[rule.MetricName], rule.GroupKey), nil
+}
+
+func alertValueOf(m *entity.Metric, groupKey string) *float64 {
+	if m == nil {
+		return nil
+	}
+	parse := func(s string) (float64, bool) {
+		v, err := strconv.ParseFloat(s, 64)
+		return v, err == nil
+	}
+	switch {
+	case len(m.TimeSeries) > 0:
+		if groupKey == "" {
+			groupKey = defaultGroupKey
+		}
+		var sum float64
+		var count int
+		for _, point := range m.TimeSeries[groupKey] {
+			if v, ok := parse(point.Value); ok {
+				sum += v
+				count++
+			}
+		}
+		if count == 0 {
+			return nil
+		}
+		avg := sum / float64(count)
+		return &avg
+	case len(m.Pie) > 0:
+		if groupKey != "" {
+			if v, ok := parse(m.Pie[groupKey]); ok {
+				return &v
+			}
+			return nil
+		}
+		var sum float64
+		for _, val := range m.Pie {
+			if v, ok := parse(val); ok {
+				sum += v
+			}
+		}
+		return &sum
+	case m.Summary != "":
+		if v, ok := parse(m.Summary); ok {
+			return &v
+		}
+	}
+	return nil
+}
+
+// notify 通知失败只记录日志，不影响状态流转
+func (a *AlertServiceImpl) notify(ctx context.Context, rule *entity.AlertRule) {
+	if rule.Notification == nil {
+		return
+	}
+	event := rule.ToEvent()
+	if len(rule.Notification.UserIDs) > 0 {
+		userInfos, _, err := a.userProvider.GetUserInfo(ctx, rule.Notification.UserIDs)
+		if err != nil {
+			logs.CtxWarn(ctx, "get user info of alert rule %d failed, %v", rule.ID, err)
+		}
+		param := map[string]string{
+			"rule_name":    event.RuleName,
+			"workspace_id": strconv.FormatInt(event.WorkspaceID, 10),
+			"metric_name":  event.MetricName,
+			"group_key":    event.GroupKey,
+			"status":       string(event.Status),
+			"value":        strconv.FormatFloat(event.Value, 'f', -1, 64),
+			"evaluated_at": time.UnixMilli(event.EvaluatedAt).Format(time.DateTime),
+		}
+		for _, userInfo := range userInfos {
+			if userInfo == nil || userInfo.Email == "" {
+				continue
+			}
+			if err := a.notifyAdapter.SendMessageCard(ctx, userInfo.Email, AlertNotifyCardID, param); err != nil {
+				logs.CtxWarn(ctx, "send alert card of rule %d to %s failed, %v", rule.ID, userInfo.UserID, err)
+			}
+		}
+	}
+	if rule.Notification.WebhookURL != "" {
+		if err := a.webhookSender.Send(ctx, rule.Notification.WebhookURL, rule.Notification.WebhookHeaders, event); err != nil {
+			logs.CtxWarn(ctx, "send alert webhook of rule %d failed, %v", rule.ID, err)
+		}
+	}
+}
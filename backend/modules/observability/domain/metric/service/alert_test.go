@@ -0,0 +1,197 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc/mocks"
+	webhookmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/webhook/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/common"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+// fakeMetricsService 按查询窗口的结束时间返回指标值
+type fakeMetricsService struct {
+	IMetricsService
+	values map[int64]*entity.Metric
+	reqs   []*QueryMetricsReq
+}
+
+func (f *fakeMetricsService) QueryMetrics(ctx context.Context, req *QueryMetricsReq) (*QueryMetricsResp, error) {
+	f.reqs = append(f.reqs, req)
+	return &QueryMetricsResp{Metrics: map[string]*entity.Metric{req.MetricsNames[0]: f.values[req.EndTime]}}, nil
+}
+
+func (f *fakeMetricsService) GetMetricGroupBy(metricName string) ([]string, error) {
+	if metricName != entity.MetricNameModelTotalErrorCount {
+		return nil, fmt.Errorf("metric definition %s not found", metricName)
+	}
+	return nil, nil
+}
+
+func newTestAlertRule() *entity.AlertRule {
+	return &entity.AlertRule{
+		ID:            1,
+		WorkspaceID:   2,
+		Name:          "model error spike",
+		MetricName:    entity.MetricNameModelTotalErrorCount,
+		WindowSeconds: 300,
+		Filters: &loop_span.FilterFields{
+			FilterFields: []*loop_span.FilterField{
+				{FieldName: loop_span.SpanFieldStatus, FieldType: loop_span.FieldTypeString, Values: []string{"error"}},
+			},
+		},
+		Condition: &entity.AlertCondition{
+			Type:      entity.AlertConditionTypeThreshold,
+			Operator:  entity.AlertCompareOperatorGT,
+			Threshold: 10,
+		},
+		Enabled: true,
+		Notification: &entity.AlertNotification{
+			UserIDs:    []string{"u1"},
+			WebhookURL: "http://hook",
+		},
+		State: entity.AlertState{Status: entity.AlertStatusOK},
+	}
+}
+
+func TestAlertServiceImpl_EvaluateAlertRule(t *testing.T) {
+	now := time.UnixMilli(10 * 300 * 1000)
+	end := now.UnixMilli()
+	window := int64(300 * 1000)
+
+	t.Run("fire and notify", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		alertRepo := repomocks.NewMockIAlertRuleRepo(ctrl)
+		userProvider := rpcmocks.NewMockIUserProvider(ctrl)
+		notifyAdapter := rpcmocks.NewMockINotifyRPCAdapter(ctrl)
+		webhookSender := webhookmocks.NewMockIWebhookSender(ctrl)
+		metricSvc := &fakeMetricsService{values: map[int64]*entity.Metric{end: {Summary: "12"}}}
+
+		alertRepo.EXPECT().UpdateAlertState(gomock.Any(), int64(1), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, id int64, state entity.AlertState) error {
+				assert.Equal(t, entity.AlertStatusFiring, state.Status)
+				assert.Equal(t, 12.0, state.LastValue)
+				assert.Equal(t, end, state.FiredAt)
+				return nil
+			})
+		userProvider.EXPECT().GetUserInfo(gomock.Any(), []string{"u1"}).Return([]*common.UserInfo{{UserID: "u1", Email: "u1@example.com"}}, nil, nil)
+		notifyAdapter.EXPECT().SendMessageCard(gomock.Any(), "u1@example.com", AlertNotifyCardID, gomock.Any()).Return(nil)
+		webhookSender.EXPECT().Send(gomock.Any(), "http://hook", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, url string, headers map[string]string, body any) error {
+				event := body.(*entity.AlertEvent)
+				assert.Equal(t, entity.AlertStatusFiring, event.Status)
+				return fmt.Errorf("webhook down")
+			})
+
+		svc := NewAlertServiceImpl(alertRepo, metricSvc, userProvider, notifyAdapter, webhookSender)
+		rule := newTestAlertRule()
+		assert.NoError(t, svc.EvaluateAlertRule(context.Background(), rule, now))
+		assert.Len(t, metricSvc.reqs, 1)
+		assert.Equal(t, end-window, metricSvc.reqs[0].StartTime)
+		assert.Equal(t, entity.MetricGranularity1Min, metricSvc.reqs[0].Granularity)
+		// 查询时使用过滤条件的副本
+		assert.NotSame(t, rule.Filters, metricSvc.reqs[0].FilterFields)
+	})
+
+	t.Run("keep ok without notification", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		alertRepo := repomocks.NewMockIAlertRuleRepo(ctrl)
+		alertRepo.EXPECT().UpdateAlertState(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+		metricSvc := &fakeMetricsService{values: map[int64]*entity.Metric{end: {Summary: "3"}}}
+		svc := NewAlertServiceImpl(alertRepo, metricSvc, nil, nil, nil)
+		rule := newTestAlertRule()
+		assert.NoError(t, svc.EvaluateAlertRule(context.Background(), rule, now))
+		assert.Equal(t, entity.AlertStatusOK, rule.State.Status)
+	})
+
+	t.Run("anomaly against baseline windows", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		alertRepo := repomocks.NewMockIAlertRuleRepo(ctrl)
+		alertRepo.EXPECT().UpdateAlertState(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+		series := func(v string) *entity.Metric {
+			return &entity.Metric{TimeSeries: entity.TimeSeries{"all": {{Timestamp: "1", Value: v}}}}
+		}
+		metricSvc := &fakeMetricsService{values: map[int64]*entity.Metric{
+			end:            series("30"),
+			end - window:   series("8"),
+			end - 2*window: series("12"),
+			end - 3*window: series("10"),
+		}}
+		svc := NewAlertServiceImpl(alertRepo, metricSvc, nil, nil, nil)
+		rule := newTestAlertRule()
+		rule.Notification = nil
+		rule.Condition = &entity.AlertCondition{
+			Type:            entity.AlertConditionTypeAnomaly,
+			Operator:        entity.AlertCompareOperatorGT,
+			Deviation:       3,
+			BaselineWindows: 3,
+		}
+		assert.NoError(t, svc.EvaluateAlertRule(context.Background(), rule, now))
+		assert.Len(t, metricSvc.reqs, 4)
+		assert.Equal(t, entity.AlertStatusFiring, rule.State.Status)
+	})
+}
+
+func TestAlertValueOf(t *testing.T) {
+	assert.Nil(t, alertValueOf(nil, ""))
+	assert.Nil(t, alertValueOf(&entity.Metric{}, ""))
+	assert.Equal(t, 1.5, *alertValueOf(&entity.Metric{Summary: "1.5"}, ""))
+	pie := &entity.Metric{Pie: map[string]string{`{"code":"500"}`: "3", `{"code":"429"}`: "4"}}
+	assert.Equal(t, 7.0, *alertValueOf(pie, ""))
+	assert.Equal(t, 4.0, *alertValueOf(pie, `{"code":"429"}`))
+	assert.Nil(t, alertValueOf(pie, `{"code":"404"}`))
+	series := &entity.Metric{TimeSeries: entity.TimeSeries{
+		"all": {{Value: "1"}, {Value: "3"}, {Value: "null"}},
+		"m1":  {{Value: "5"}},
+	}}
+	assert.Equal(t, 2.0, *alertValueOf(series, ""))
+	assert.Equal(t, 5.0, *alertValueOf(series, "m1"))
+}
+
+func TestAlertServiceImpl_ListAlertRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	alertRepo := repomocks.NewMockIAlertRuleRepo(ctrl)
+	alertRepo.EXPECT().ListAlertRules(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, param *repo.ListAlertRulesParam) ([]*entity.AlertRule, error) {
+			assert.Equal(t, int64(5), param.CursorID)
+			assert.Equal(t, 3, param.Limit)
+			return []*entity.AlertRule{{ID: 6}, {ID: 7}, {ID: 8}}, nil
+		})
+	svc := NewAlertServiceImpl(alertRepo, nil, nil, nil, nil)
+	resp, err := svc.ListAlertRules(context.Background(), &ListAlertRulesReq{WorkspaceID: 2, PageSize: 2, PageToken: "5"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Rules, 2)
+	assert.True(t, resp.HasMore)
+	assert.Equal(t, "7", resp.NextPageToken)
+
+	_, err = svc.ListAlertRules(context.Background(), &ListAlertRulesReq{WorkspaceID: 2, PageToken: "abc"})
+	assert.Error(t, err)
+}
+
+func TestAlertServiceImpl_CreateAlertRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	alertRepo := repomocks.NewMockIAlertRuleRepo(ctrl)
+	alertRepo.EXPECT().CreateAlertRule(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+	svc := NewAlertServiceImpl(alertRepo, &fakeMetricsService{}, nil, nil, nil)
+
+	id, err := svc.CreateAlertRule(context.Background(), newTestAlertRule())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+
+	rule := newTestAlertRule()
+	rule.MetricName = "unknown"
+	_, err = svc.CreateAlertRule(context.Background(), rule)
+	assert.Error(t, err)
+}
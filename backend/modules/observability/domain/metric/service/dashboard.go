@@ -0,0 +1,243 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	defaultDashboardPageSize = 20
+	maxDashboardPageSize     = 100
+
+	// 渲染看板时面板查询的最大并发数
+	maxPanelRenderConcurrency = 8
+)
+
+type ListDashboardsReq struct {
+	WorkspaceID int64
+	PageSize    int32
+	PageToken   string
+}
+
+type ListDashboardsResp struct {
+	Dashboards    []*entity.Dashboard
+	NextPageToken string
+	HasMore       bool
+}
+
+type RenderDashboardReq struct {
+	WorkspaceID int64
+	DashboardID int64
+	StartTime   int64
+	EndTime     int64
+	// FilterFields 与看板和面板的过滤条件取交集
+	FilterFields *loop_span.FilterFields
+	// PanelIDs 为空时渲染全部面板
+	PanelIDs []string
+}
+
+type RenderDashboardResp struct {
+	Panels []*entity.RenderedPanel
+}
+
+//go:generate mockgen -destination=mocks/dashboard.go -package=mocks . IDashboardService
+type IDashboardService interface {
+	CreateDashboard(ctx context.Context, dashboard *entity.Dashboard) (int64, error)
+	UpdateDashboard(ctx context.Context, dashboard *entity.Dashboard) error
+	DeleteDashboard(ctx context.Context, workspaceID, id int64) error
+	GetDashboard(ctx context.Context, workspaceID, id int64) (*entity.Dashboard, error)
+	ListDashboards(ctx context.Context, req *ListDashboardsReq) (*ListDashboardsResp, error)
+	// RenderDashboard 并发查询各面板的数据, 结果顺序与面板顺序一致
+	RenderDashboard(ctx context.Context, req *RenderDashboardReq) (*RenderDashboardResp, error)
+}
+
+type DashboardServiceImpl struct {
+	dashboardRepo repo.IDashboardRepo
+	metricService IMetricsService
+}
+
+func NewDashboardServiceImpl(dashboardRepo repo.IDashboardRepo, metricService IMetricsService) IDashboardService {
+	return &DashboardServiceImpl{
+		dashboardRepo: dashboardRepo,
+		metricService: metricService,
+	}
+}
+
+func (d *DashboardServiceImpl) CreateDashboard(ctx context.Context, dashboard *entity.Dashboard) (int64, error) {
+	if err := d.validateDashboard(dashboard); err != nil {
+		return 0, err
+	}
+	return d.dashboardRepo.CreateDashboard(ctx, dashboard)
+}
+
+func (d *DashboardServiceImpl) UpdateDashboard(ctx context.Context, dashboard *entity.Dashboard) error {
+	if err := d.validateDashboard(dashboard); err != nil {
+		return err
+	}
+	if _, err := d.GetDashboard(ctx, dashboard.WorkspaceID, dashboard.ID); err != nil {
+		return err
+	}
+	return d.dashboardRepo.UpdateDashboard(ctx, dashboard)
+}
+
+func (d *DashboardServiceImpl) DeleteDashboard(ctx context.Context, workspaceID, id int64) error {
+	return d.dashboardRepo.DeleteDashboard(ctx, workspaceID, id)
+}
+
+func (d *DashboardServiceImpl) GetDashboard(ctx context.Context, workspaceID, id int64) (*entity.Dashboard, error) {
+	dashboard, err := d.dashboardRepo.GetDashboard(ctx, workspaceID, id)
+	if err != nil {
+		return nil, err
+	} else if dashboard == nil {
+		return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("dashboard not found"))
+	}
+	return dashboard, nil
+}
+
+func (d *DashboardServiceImpl) ListDashboards(ctx context.Context, req *ListDashboardsReq) (*ListDashboardsResp, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultDashboardPageSize
+	} else if pageSize > maxDashboardPageSize {
+		pageSize = maxDashboardPageSize
+	}
+	var cursorID int64
+	if req.PageToken != "" {
+		id, err := strconv.ParseInt(req.PageToken, 10, 64)
+		if err != nil {
+			return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid page_token"))
+		}
+		cursorID = id
+	}
+	// 多查一条用于判断是否还有下一页
+	dashboards, err := d.dashboardRepo.ListDashboards(ctx, &repo.ListDashboardsParam{
+		WorkspaceID: req.WorkspaceID,
+		CursorID:    cursorID,
+		Limit:       pageSize + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &ListDashboardsResp{Dashboards: dashboards}
+	if len(dashboards) > pageSize {
+		resp.Dashboards = dashboards[:pageSize]
+		resp.HasMore = true
+		resp.NextPageToken = strconv.FormatInt(resp.Dashboards[pageSize-1].ID, 10)
+	}
+	return resp, nil
+}
+
+func (d *DashboardServiceImpl) RenderDashboard(ctx context.Context, req *RenderDashboardReq) (*RenderDashboardResp, error) {
+	dashboard, err := d.GetDashboard(ctx, req.WorkspaceID, req.DashboardID)
+	if err != nil {
+		return nil, err
+	}
+	panels := dashboard.Panels
+	if len(req.PanelIDs) > 0 {
+		panels = make([]*entity.DashboardPanel, 0, len(req.PanelIDs))
+		for _, id := range req.PanelIDs {
+			panel := dashboard.GetPanel(id)
+			if panel == nil {
+				return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode,
+					errorx.WithExtraMsg(fmt.Sprintf("panel %s not found", id)))
+			}
+			panels = append(panels, panel)
+		}
+	}
+	results := make([]*entity.RenderedPanel, len(panels))
+	var eGroup errgroup.Group
+	eGroup.SetLimit(maxPanelRenderConcurrency)
+	for i, panel := range panels {
+		eGroup.Go(func() error {
+			defer goroutine.Recovery(ctx)
+			results[i] = d.renderPanel(ctx, dashboard, panel, req)
+			return nil
+		})
+	}
+	_ = eGroup.Wait()
+	for i, panel := range panels {
+		// 面板查询panic时没有结果
+		if results[i] == nil {
+			results[i] = &entity.RenderedPanel{PanelID: panel.ID, Error: "internal error"}
+		}
+	}
+	return &RenderDashboardResp{Panels: results}, nil
+}
+
+func (d *DashboardServiceImpl) renderPanel(ctx context.Context, dashboard *entity.Dashboard, panel *entity.DashboardPanel, req *RenderDashboardReq) *entity.RenderedPanel {
+	result := &entity.RenderedPanel{PanelID: panel.ID}
+	if err := entity.CheckGranularityRange(req.StartTime, req.EndTime, panel.Granularity); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	filters := dashboard.PanelFilters(panel, req.FilterFields)
+	if panel.IsAggregation() {
+		resp, err := d.metricService.QueryAggregations(ctx, &QueryAggregationsReq{
+			PlatformType: dashboard.PlatformType,
+			WorkspaceID:  dashboard.WorkspaceID,
+			Aggregations: panel.Aggregations,
+			GroupBys:     panel.GroupBys,
+			FilterFields: filters,
+			Granularity:  panel.Granularity,
+			StartTime:    req.StartTime,
+			EndTime:      req.EndTime,
+			Limit:        panel.Limit,
+		})
+		if err != nil {
+			logs.CtxWarn(ctx, "render panel %s of dashboard %d failed, %v", panel.ID, dashboard.ID, err)
+			result.Error = err.Error()
+			return result
+		}
+		result.Rows = resp.Rows
+		return result
+	}
+	granularity := panel.Granularity
+	if granularity == "" {
+		granularity = entity.MetricGranularity1Day
+	}
+	resp, err := d.metricService.QueryMetrics(ctx, &QueryMetricsReq{
+		PlatformType: dashboard.PlatformType,
+		WorkspaceID:  dashboard.WorkspaceID,
+		MetricsNames: panel.MetricNames,
+		Granularity:  granularity,
+		FilterFields: filters,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+	})
+	if err != nil {
+		logs.CtxWarn(ctx, "render panel %s of dashboard %d failed, %v", panel.ID, dashboard.ID, err)
+		result.Error = err.Error()
+		return result
+	}
+	result.Metrics = resp.Metrics
+	return result
+}
+
+func (d *DashboardServiceImpl) validateDashboard(dashboard *entity.Dashboard) error {
+	if err := dashboard.Validate(); err != nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg(err.Error()))
+	}
+	for _, panel := range dashboard.Panels {
+		for _, metricName := range panel.MetricNames {
+			if _, err := d.metricService.GetMetricGroupBy(metricName); err != nil {
+				return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode,
+					errorx.WithExtraMsg(fmt.Sprintf("panel %s: %v", panel.ID, err)))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+// fakePanelMetricsService 并发安全, 聚合查询固定失败
+type fakePanelMetricsService struct {
+	IMetricsService
+	mu   sync.Mutex
+	reqs []*QueryMetricsReq
+}
+
+func (f *fakePanelMetricsService) QueryMetrics(ctx context.Context, req *QueryMetricsReq) (*QueryMetricsResp, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reqs = append(f.reqs, req)
+	return &QueryMetricsResp{Metrics: map[string]*entity.Metric{req.MetricsNames[0]: {Summary: "1"}}}, nil
+}
+
+func (f *fakePanelMetricsService) QueryAggregations(ctx context.Context, req *QueryAggregationsReq) (*QueryAggregationsResp, error) {
+	return nil, fmt.Errorf("query failed")
+}
+
+func (f *fakePanelMetricsService) GetMetricGroupBy(metricName string) ([]string, error) {
+	if metricName != entity.MetricNameModelTotalErrorCount {
+		return nil, fmt.Errorf("metric definition %s not found", metricName)
+	}
+	return nil, nil
+}
+
+func newTestDashboard() *entity.Dashboard {
+	return &entity.Dashboard{
+		ID:          1,
+		WorkspaceID: 2,
+		Name:        "overview",
+		Filters: &loop_span.FilterFields{FilterFields: []*loop_span.FilterField{
+			{FieldName: loop_span.SpanFieldStatus, FieldType: loop_span.FieldTypeString, Values: []string{"error"}},
+		}},
+		Panels: []*entity.DashboardPanel{
+			{ID: "p1", Title: "errors", ChartType: entity.ChartTypeLine, MetricNames: []string{entity.MetricNameModelTotalErrorCount}},
+			{
+				ID:        "p2",
+				Title:     "latency",
+				ChartType: entity.ChartTypeTable,
+				Aggregations: []*entity.Aggregation{{
+					Type:  entity.AggregationTypeAvg,
+					Field: &loop_span.FilterField{FieldName: loop_span.SpanFieldDuration, FieldType: loop_span.FieldTypeLong},
+				}},
+			},
+			{
+				ID:          "p3",
+				Title:       "errors per minute",
+				ChartType:   entity.ChartTypeLine,
+				MetricNames: []string{entity.MetricNameModelTotalErrorCount},
+				Granularity: entity.MetricGranularity1Min,
+			},
+		},
+	}
+}
+
+func TestDashboardServiceImpl_RenderDashboard(t *testing.T) {
+	end := (24 * time.Hour).Milliseconds()
+
+	t.Run("partial failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		dashboardRepo := repomocks.NewMockIDashboardRepo(ctrl)
+		dashboardRepo.EXPECT().GetDashboard(gomock.Any(), int64(2), int64(1)).Return(newTestDashboard(), nil)
+		metricSvc := &fakePanelMetricsService{}
+		svc := NewDashboardServiceImpl(dashboardRepo, metricSvc)
+
+		resp, err := svc.RenderDashboard(context.Background(), &RenderDashboardReq{
+			WorkspaceID: 2,
+			DashboardID: 1,
+			StartTime:   0,
+			EndTime:     end,
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, resp.Panels, 3) {
+			assert.Equal(t, "p1", resp.Panels[0].PanelID)
+			assert.Empty(t, resp.Panels[0].Error)
+			assert.Equal(t, "1", resp.Panels[0].Metrics[entity.MetricNameModelTotalErrorCount].Summary)
+			assert.Equal(t, "p2", resp.Panels[1].PanelID)
+			assert.Equal(t, "query failed", resp.Panels[1].Error)
+			// 分钟粒度不支持一天的时间范围
+			assert.Equal(t, "p3", resp.Panels[2].PanelID)
+			assert.NotEmpty(t, resp.Panels[2].Error)
+		}
+		if assert.Len(t, metricSvc.reqs, 1) {
+			assert.Equal(t, entity.MetricGranularity1Day, metricSvc.reqs[0].Granularity)
+			assert.Len(t, metricSvc.reqs[0].FilterFields.FilterFields, 1)
+		}
+	})
+
+	t.Run("selected panels", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		dashboardRepo := repomocks.NewMockIDashboardRepo(ctrl)
+		dashboardRepo.EXPECT().GetDashboard(gomock.Any(), gomock.Any(), gomock.Any()).Return(newTestDashboard(), nil).Times(2)
+		svc := NewDashboardServiceImpl(dashboardRepo, &fakePanelMetricsService{})
+
+		resp, err := svc.RenderDashboard(context.Background(), &RenderDashboardReq{
+			WorkspaceID: 2,
+			DashboardID: 1,
+			EndTime:     end,
+			PanelIDs:    []string{"p2"},
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, resp.Panels, 1) {
+			assert.Equal(t, "p2", resp.Panels[0].PanelID)
+		}
+
+		_, err = svc.RenderDashboard(context.Background(), &RenderDashboardReq{
+			WorkspaceID: 2,
+			DashboardID: 1,
+			EndTime:     end,
+			PanelIDs:    []string{"unknown"},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("dashboard not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		dashboardRepo := repomocks.NewMockIDashboardRepo(ctrl)
+		dashboardRepo.EXPECT().GetDashboard(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		svc := NewDashboardServiceImpl(dashboardRepo, &fakePanelMetricsService{})
+		_, err := svc.RenderDashboard(context.Background(), &RenderDashboardReq{WorkspaceID: 2, DashboardID: 1, EndTime: end})
+		assert.Error(t, err)
+	})
+}
+
+func TestDashboardServiceImpl_ListDashboards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dashboardRepo := repomocks.NewMockIDashboardRepo(ctrl)
+	dashboardRepo.EXPECT().ListDashboards(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, param *repo.ListDashboardsParam) ([]*entity.Dashboard, error) {
+			assert.Equal(t, int64(5), param.CursorID)
+			assert.Equal(t, 3, param.Limit)
+			return []*entity.Dashboard{{ID: 6}, {ID: 7}, {ID: 8}}, nil
+		})
+	svc := NewDashboardServiceImpl(dashboardRepo, nil)
+	resp, err := svc.ListDashboards(context.Background(), &ListDashboardsReq{WorkspaceID: 2, PageSize: 2, PageToken: "5"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Dashboards, 2)
+	assert.True(t, resp.HasMore)
+	assert.Equal(t, "7", resp.NextPageToken)
+
+	_, err = svc.ListDashboards(context.Background(), &ListDashboardsReq{WorkspaceID: 2, PageToken: "abc"})
+	assert.Error(t, err)
+}
+
+func TestDashboardServiceImpl_CreateDashboard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dashboardRepo := repomocks.NewMockIDashboardRepo(ctrl)
+	dashboardRepo.EXPECT().CreateDashboard(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+	svc := NewDashboardServiceImpl(dashboardRepo, &fakePanelMetricsService{})
+
+	id, err := svc.CreateDashboard(context.Background(), newTestDashboard())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+
+	dashboard := newTestDashboard()
+	dashboard.Panels[0].MetricNames = []string{"unknown"}
+	_, err = svc.CreateDashboard(context.Background(), dashboard)
+	assert.Error(t, err)
+}
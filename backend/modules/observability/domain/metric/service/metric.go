@@ -82,6 +82,7 @@ type IMetricsService interface {
 	QueryMetrics(ctx context.Context, req *QueryMetricsReq) (*QueryMetricsResp, error)
 	TraverseMetrics(ctx context.Context, req *TraverseMetricsReq) (*TraverseMetricsResp, error)
 	GetMetricGroupBy(metricName string) ([]string, error)
+	QueryAggregations(ctx context.Context, req *QueryAggregationsReq) (*QueryAggregationsResp, error)
 }
 
 type MetricsService struct {
@@ -0,0 +1,56 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package general
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_filter"
+)
+
+type GeneralTotalCostMetric struct{}
+
+func (m *GeneralTotalCostMetric) Name() string {
+	return entity.MetricNameGeneralTotalCost
+}
+
+func (m *GeneralTotalCostMetric) Type() entity.MetricType {
+	return entity.MetricTypeSummary
+}
+
+func (m *GeneralTotalCostMetric) Source() entity.MetricSource {
+	return entity.MetricSourceInnerStorage
+}
+
+func (m *GeneralTotalCostMetric) Expression(granularity entity.MetricGranularity) *entity.Expression {
+	return &entity.Expression{
+		Expression: "sum(%s)",
+		Fields: []*loop_span.FilterField{
+			{
+				FieldName: loop_span.SpanFieldModelCost,
+				FieldType: loop_span.FieldTypeDouble,
+			},
+		},
+	}
+}
+
+func (m *GeneralTotalCostMetric) Where(ctx context.Context, filter span_filter.Filter, env *span_filter.SpanEnv) ([]*loop_span.FilterField, error) {
+	return filter.BuildLLMSpanFilter(ctx, env)
+}
+
+func (m *GeneralTotalCostMetric) GroupBy() []*entity.Dimension {
+	return []*entity.Dimension{}
+}
+
+func (m *GeneralTotalCostMetric) OExpression() *entity.OExpression {
+	return &entity.OExpression{
+		AggrType: entity.MetricOfflineAggrTypeSum,
+	}
+}
+
+func NewGeneralTotalCostMetric() entity.IMetricDefinition {
+	return &GeneralTotalCostMetric{}
+}
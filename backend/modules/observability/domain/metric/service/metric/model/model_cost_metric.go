@@ -0,0 +1,64 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/metric/wrapper"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_filter"
+)
+
+type ModelCostMetric struct{}
+
+func (m *ModelCostMetric) Name() string {
+	return entity.MetricNameModelCost
+}
+
+func (m *ModelCostMetric) Type() entity.MetricType {
+	return entity.MetricTypeSummary
+}
+
+func (m *ModelCostMetric) Source() entity.MetricSource {
+	return entity.MetricSourceInnerStorage
+}
+
+func (m *ModelCostMetric) Expression(granularity entity.MetricGranularity) *entity.Expression {
+	return &entity.Expression{
+		Expression: "sum(%s)",
+		Fields: []*loop_span.FilterField{
+			{
+				FieldName: loop_span.SpanFieldModelCost,
+				FieldType: loop_span.FieldTypeDouble,
+			},
+		},
+	}
+}
+
+func (m *ModelCostMetric) Where(ctx context.Context, filter span_filter.Filter, env *span_filter.SpanEnv) ([]*loop_span.FilterField, error) {
+	return filter.BuildLLMSpanFilter(ctx, env)
+}
+
+func (m *ModelCostMetric) GroupBy() []*entity.Dimension {
+	return []*entity.Dimension{}
+}
+
+func (m *ModelCostMetric) Wrappers() []entity.IMetricWrapper {
+	return []entity.IMetricWrapper{
+		wrapper.NewSelfWrapper(),
+		wrapper.NewTimeSeriesWrapper(),
+	}
+}
+
+func (m *ModelCostMetric) OExpression() *entity.OExpression {
+	return &entity.OExpression{
+		AggrType: entity.MetricOfflineAggrTypeSum,
+	}
+}
+
+func NewModelCostMetric() entity.IMetricDefinition {
+	return &ModelCostMetric{}
+}
@@ -56,6 +56,21 @@ func (mr *MockIMetricsServiceMockRecorder) GetMetricGroupBy(metricName any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricGroupBy", reflect.TypeOf((*MockIMetricsService)(nil).GetMetricGroupBy), metricName)
 }
 
+// QueryAggregations mocks base method.
+func (m *MockIMetricsService) QueryAggregations(ctx context.Context, req *service.QueryAggregationsReq) (*service.QueryAggregationsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAggregations", ctx, req)
+	ret0, _ := ret[0].(*service.QueryAggregationsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAggregations indicates an expected call of QueryAggregations.
+func (mr *MockIMetricsServiceMockRecorder) QueryAggregations(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAggregations", reflect.TypeOf((*MockIMetricsService)(nil).QueryAggregations), ctx, req)
+}
+
 // QueryMetrics mocks base method.
 func (m *MockIMetricsService) QueryMetrics(ctx context.Context, req *service.QueryMetricsReq) (*service.QueryMetricsResp, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduledtask
+
+import (
+	"context"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/lock"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/scheduledtask"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	alertEvaluateLockKey = "observability:metric:alert_evaluate"
+	alertEvaluateLockTTL = 5 * time.Minute
+
+	alertRulePageSize = 100
+)
+
+// AlertEvaluateTask 定时评估所有启用的告警规则
+type AlertEvaluateTask struct {
+	*scheduledtask.BaseScheduledTask
+
+	locker       lock.ILocker
+	alertRepo    repo.IAlertRuleRepo
+	alertService service.IAlertService
+}
+
+func NewAlertEvaluateTask(
+	locker lock.ILocker,
+	alertRepo repo.IAlertRuleRepo,
+	alertService service.IAlertService,
+) scheduledtask.ScheduledTask {
+	t := &AlertEvaluateTask{
+		BaseScheduledTask: scheduledtask.NewBaseScheduledTask("AlertEvaluateTask", time.Minute, false),
+		locker:            locker,
+		alertRepo:         alertRepo,
+		alertService:      alertService,
+	}
+	t.ScheduledTask = t
+	return t
+}
+
+func (t *AlertEvaluateTask) RunOnce(ctx context.Context) error {
+	if t.locker != nil {
+		locked, err := t.locker.Lock(ctx, alertEvaluateLockKey, alertEvaluateLockTTL)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			logs.CtxInfo(ctx, "alert evaluate lock held by others, skip execution")
+			return nil
+		}
+		defer func() { _, _ = t.locker.Unlock(alertEvaluateLockKey) }()
+	}
+	// 同一轮的规则使用相同的截止时间
+	now := time.Now()
+	var cursorID int64
+	for {
+		rules, err := t.alertRepo.ListEnabledAlertRules(ctx, &repo.ListEnabledAlertRulesParam{
+			CursorID: cursorID,
+			Limit:    alertRulePageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, rule := range rules {
+			if err := t.alertService.EvaluateAlertRule(ctx, rule, now); err != nil {
+				logs.CtxError(ctx, "evaluate alert rule %d failed, %v", rule.ID, err)
+			}
+		}
+		if len(rules) < alertRulePageSize {
+			return nil
+		}
+		cursorID = rules[len(rules)-1].ID
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduledtask
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	lockmocks "github.com/coze-dev/coze-loop/backend/infra/lock/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo/mocks"
+	svcmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/service/mocks"
+)
+
+func TestAlertEvaluateTask_RunOnce(t *testing.T) {
+	t.Run("evaluate all enabled rules", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		locker := lockmocks.NewMockILocker(ctrl)
+		locker.EXPECT().Lock(gomock.Any(), alertEvaluateLockKey, gomock.Any()).Return(true, nil)
+		locker.EXPECT().Unlock(alertEvaluateLockKey).Return(true, nil)
+		alertRepo := repomocks.NewMockIAlertRuleRepo(ctrl)
+		alertRepo.EXPECT().ListEnabledAlertRules(gomock.Any(), gomock.Any()).Return([]*entity.AlertRule{{ID: 1}, {ID: 2}}, nil)
+		alertSvc := svcmocks.NewMockIAlertService(ctrl)
+		// 单条规则失败不影响其他规则
+		alertSvc.EXPECT().EvaluateAlertRule(gomock.Any(), &entity.AlertRule{ID: 1}, gomock.Any()).Return(fmt.Errorf("ck error"))
+		alertSvc.EXPECT().EvaluateAlertRule(gomock.Any(), &entity.AlertRule{ID: 2}, gomock.Any()).Return(nil)
+		task := NewAlertEvaluateTask(locker, alertRepo, alertSvc)
+		assert.NoError(t, task.RunOnce(context.Background()))
+	})
+
+	t.Run("skip when lock held by others", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		locker := lockmocks.NewMockILocker(ctrl)
+		locker.EXPECT().Lock(gomock.Any(), alertEvaluateLockKey, gomock.Any()).Return(false, nil)
+		task := NewAlertEvaluateTask(locker, nil, nil)
+		assert.NoError(t, task.RunOnce(context.Background()))
+	})
+}
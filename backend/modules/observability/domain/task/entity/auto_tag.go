@@ -0,0 +1,69 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+const maxAutoTagLabelBytes = 128
+
+// tagKey会拼接成auto_tag_{tag_key}用于筛选, 仅允许字母、数字与下划线
+var autoTagKeyPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,63}$`)
+
+func (c *AutoTagConfig) Validate() error {
+	if c == nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("auto tag config is empty"))
+	}
+	if c.EvaluatorVersionID <= 0 {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid evaluator_version_id"))
+	}
+	if !autoTagKeyPattern.MatchString(c.TagKey) {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg(fmt.Sprintf("invalid tag_key %s", c.TagKey)))
+	}
+	for _, label := range c.Labels {
+		if strings.TrimSpace(label) == "" || len(label) > maxAutoTagLabelBytes {
+			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid label"))
+		}
+	}
+	return nil
+}
+
+// LabelOf 由评估器结果得到标签:
+// 配置了候选标签时, 优先取reasoning完全匹配或包含的标签, 其次按分数作为下标取值;
+// 未配置候选标签时直接使用reasoning. 无法得到标签时返回空串
+func (c *AutoTagConfig) LabelOf(score float64, reasoning string) string {
+	reasoning = strings.TrimSpace(reasoning)
+	if len(c.Labels) == 0 {
+		if len(reasoning) > maxAutoTagLabelBytes {
+			reasoning = strings.ToValidUTF8(reasoning[:maxAutoTagLabelBytes], "")
+		}
+		return reasoning
+	}
+	lower := strings.ToLower(reasoning)
+	for _, label := range c.Labels {
+		if strings.EqualFold(label, reasoning) {
+			return label
+		}
+	}
+	matched, matchedAt := "", -1
+	for _, label := range c.Labels {
+		if idx := strings.Index(lower, strings.ToLower(label)); idx >= 0 && (matchedAt < 0 || idx < matchedAt) {
+			matched, matchedAt = label, idx
+		}
+	}
+	if matched != "" {
+		return matched
+	}
+	if idx := int(score); score == math.Trunc(score) && idx >= 0 && idx < len(c.Labels) {
+		return c.Labels[idx]
+	}
+	return ""
+}
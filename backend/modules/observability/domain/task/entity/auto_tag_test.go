@@ -0,0 +1,45 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoTagConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *AutoTagConfig
+		wantErr bool
+	}{
+		{name: "nil config", config: nil, wantErr: true},
+		{name: "ok", config: &AutoTagConfig{EvaluatorVersionID: 1, TagKey: "intent", Labels: []string{"refund", "query"}}},
+		{name: "invalid evaluator", config: &AutoTagConfig{TagKey: "intent"}, wantErr: true},
+		{name: "empty tag key", config: &AutoTagConfig{EvaluatorVersionID: 1}, wantErr: true},
+		{name: "unsafe tag key", config: &AutoTagConfig{EvaluatorVersionID: 1, TagKey: "intent' OR 1=1"}, wantErr: true},
+		{name: "blank label", config: &AutoTagConfig{EvaluatorVersionID: 1, TagKey: "intent", Labels: []string{" "}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestAutoTagConfig_LabelOf(t *testing.T) {
+	config := &AutoTagConfig{Labels: []string{"positive", "negative", "neutral"}}
+	assert.Equal(t, "negative", config.LabelOf(0, " Negative "))
+	assert.Equal(t, "neutral", config.LabelOf(0, "the sentiment is neutral, not negative"))
+	assert.Equal(t, "negative", config.LabelOf(1, "unclear"))
+	assert.Equal(t, "", config.LabelOf(0.5, "unclear"))
+	assert.Equal(t, "", config.LabelOf(3, "unclear"))
+
+	free := &AutoTagConfig{}
+	assert.Equal(t, "zh-CN", free.LabelOf(0, " zh-CN\n"))
+	assert.Len(t, free.LabelOf(0, strings.Repeat("a", 200)), maxAutoTagLabelBytes)
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+type SamplingStrategy string
+
+const (
+	SamplingStrategyUniform     SamplingStrategy = "uniform"
+	SamplingStrategyStratified  SamplingStrategy = "stratified"
+	SamplingStrategyErrorBiased SamplingStrategy = "error_biased"
+)
+
+const (
+	// 采样统计在缓存中的计数字段
+	SampleStatFieldError         = "error"
+	SampleStatFieldDedupSkipped  = "dedup_skipped"
+	SampleStatFieldStratumPrefix = "stratum:"
+
+	// 分层字段取值为空的span归入该层
+	EmptyStratum    = "<empty>"
+	maxStratumBytes = 128
+
+	// 去重字段simhash的汉明距离不超过该值时视为相似输入
+	DedupMaxHammingDistance = 3
+	// simhash按字符n-gram提取特征
+	simHashShingleSize = 3
+	// simhash切分的段数, 比距离阈值多一段
+	simHashBandCount = DedupMaxHammingDistance + 1
+	simHashBandBits  = 64 / simHashBandCount
+)
+
+type StratifiedSampling struct {
+	FieldName      string `json:"field_name"`
+	PerStratumSize int64  `json:"per_stratum_size"`
+}
+
+type ErrorBiasedSampling struct {
+	// 失败span的采样率, 为空时全部采样
+	ErrorSampleRate *float64 `json:"error_sample_rate,omitempty"`
+}
+
+// SampleDedup 相似输入去重, 去重字段simhash的汉明距离不超过DedupMaxHammingDistance时只采样一次
+type SampleDedup struct {
+	Enabled bool `json:"enabled"`
+	// 去重字段, 为空时使用input
+	FieldName string `json:"field_name,omitempty"`
+}
+
+func (s *Sampler) GetSamplingStrategy() SamplingStrategy {
+	if s == nil || s.SamplingStrategy == "" {
+		return SamplingStrategyUniform
+	}
+	return s.SamplingStrategy
+}
+
+func (s *Sampler) Validate() error {
+	if s == nil {
+		return nil
+	}
+	switch s.GetSamplingStrategy() {
+	case SamplingStrategyUniform:
+	case SamplingStrategyStratified:
+		if s.StratifiedSampling == nil || s.StratifiedSampling.FieldName == "" {
+			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("stratified sampling requires field_name"))
+		}
+		if s.StratifiedSampling.PerStratumSize <= 0 {
+			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid per_stratum_size"))
+		}
+	case SamplingStrategyErrorBiased:
+		if s.ErrorBiasedSampling != nil && s.ErrorBiasedSampling.ErrorSampleRate != nil {
+			rate := *s.ErrorBiasedSampling.ErrorSampleRate
+			if rate < 0 || rate > 1 {
+				return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid error_sample_rate"))
+			}
+		}
+	default:
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg(fmt.Sprintf("invalid sampling_strategy %s", s.SamplingStrategy)))
+	}
+	return nil
+}
+
+// SampleRateOf 返回span适用的采样率, 失败偏置采样时失败span使用单独的采样率
+func (s *Sampler) SampleRateOf(span *loop_span.Span) float64 {
+	if s.GetSamplingStrategy() == SamplingStrategyErrorBiased && IsErrorSpan(span) {
+		if s.ErrorBiasedSampling != nil && s.ErrorBiasedSampling.ErrorSampleRate != nil {
+			return *s.ErrorBiasedSampling.ErrorSampleRate
+		}
+		return 1.0
+	}
+	return s.SampleRate
+}
+
+// StratumOf 返回span所在的层, 非分层采样时第二个返回值为false
+func (s *Sampler) StratumOf(span *loop_span.Span) (string, bool) {
+	if s.GetSamplingStrategy() != SamplingStrategyStratified || s.StratifiedSampling == nil {
+		return "", false
+	}
+	stratum := ""
+	if val := span.GetFieldValue(s.StratifiedSampling.FieldName, false, false); val != nil {
+		stratum = fmt.Sprint(val)
+	}
+	if stratum == "" {
+		return EmptyStratum, true
+	}
+	if len(stratum) > maxStratumBytes {
+		stratum = strings.ToValidUTF8(stratum[:maxStratumBytes], "")
+	}
+	return stratum, true
+}
+
+// DedupSimHash 计算span去重字段归一化后的simhash, 未开启去重或字段为空时第二个返回值为false
+func (s *Sampler) DedupSimHash(span *loop_span.Span) (uint64, bool) {
+	if s == nil || s.Dedup == nil || !s.Dedup.Enabled {
+		return 0, false
+	}
+	fieldName := s.Dedup.FieldName
+	if fieldName == "" {
+		fieldName = loop_span.SpanFieldInput
+	}
+	val := span.GetFieldValue(fieldName, false, false)
+	if val == nil {
+		return 0, false
+	}
+	text := normalizeDedupText(fmt.Sprint(val))
+	if text == "" {
+		return 0, false
+	}
+	return SimHash(text), true
+}
+
+// SimHash 以文本的字符n-gram为特征计算64位simhash, 相似文本的simhash汉明距离较小
+func SimHash(text string) uint64 {
+	runes := []rune(text)
+	var weights [64]int
+	addFeature := func(feature string) {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(feature))
+		sum := h.Sum64()
+		for i := 0; i < 64; i++ {
+			if sum&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+	if len(runes) <= simHashShingleSize {
+		addFeature(text)
+	} else {
+		for i := 0; i+simHashShingleSize <= len(runes); i++ {
+			addFeature(string(runes[i : i+simHashShingleSize]))
+		}
+	}
+	var hash uint64
+	for i, w := range weights {
+		if w > 0 {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// IsSimilarSimHash 判断两个simhash的汉明距离是否在去重阈值内
+func IsSimilarSimHash(a, b uint64) bool {
+	return bits.OnesCount64(a^b) <= DedupMaxHammingDistance
+}
+
+// SimHashBands 将simhash切分为多段作为相似查找的索引。段数比距离阈值多一, 汉明距离在阈值内的两个simhash至少有一段完全相同
+func SimHashBands(hash uint64) []string {
+	bands := make([]string, 0, simHashBandCount)
+	mask := uint64(1)<<simHashBandBits - 1
+	for i := 0; i < simHashBandCount; i++ {
+		bands = append(bands, fmt.Sprintf("%d:%x", i, (hash>>(uint(i)*simHashBandBits))&mask))
+	}
+	return bands
+}
+
+// normalizeDedupText 忽略大小写、空白与标点, 仅有格式差异的输入视为相同
+func normalizeDedupText(text string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func IsErrorSpan(span *loop_span.Span) bool {
+	return span != nil && span.StatusCode != 0
+}
+
+func StratumStatField(stratum string) string {
+	return SampleStatFieldStratumPrefix + stratum
+}
+
+// NewSampleStats 由缓存中的计数构造采样统计, 没有任何计数时返回nil
+func NewSampleStats(counts map[string]int64) *SampleStats {
+	if len(counts) == 0 {
+		return nil
+	}
+	stats := &SampleStats{}
+	for field, count := range counts {
+		switch {
+		case field == SampleStatFieldError:
+			stats.ErrorCount = count
+		case field == SampleStatFieldDedupSkipped:
+			stats.DedupSkippedCount = count
+		case strings.HasPrefix(field, SampleStatFieldStratumPrefix):
+			if stats.StratumCounts == nil {
+				stats.StratumCounts = make(map[string]int64)
+			}
+			stats.StratumCounts[strings.TrimPrefix(field, SampleStatFieldStratumPrefix)] = count
+		}
+	}
+	return stats
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func TestSampler_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sampler *Sampler
+		wantErr bool
+	}{
+		{name: "nil sampler", sampler: nil},
+		{name: "default uniform", sampler: &Sampler{SampleRate: 0.5}},
+		{name: "stratified ok", sampler: &Sampler{
+			SamplingStrategy:   SamplingStrategyStratified,
+			StratifiedSampling: &StratifiedSampling{FieldName: "prompt_key", PerStratumSize: 10},
+		}},
+		{name: "stratified without field", sampler: &Sampler{SamplingStrategy: SamplingStrategyStratified}, wantErr: true},
+		{name: "stratified invalid size", sampler: &Sampler{
+			SamplingStrategy:   SamplingStrategyStratified,
+			StratifiedSampling: &StratifiedSampling{FieldName: "prompt_key"},
+		}, wantErr: true},
+		{name: "error biased invalid rate", sampler: &Sampler{
+			SamplingStrategy:    SamplingStrategyErrorBiased,
+			ErrorBiasedSampling: &ErrorBiasedSampling{ErrorSampleRate: ptr.Of(1.5)},
+		}, wantErr: true},
+		{name: "unknown strategy", sampler: &Sampler{SamplingStrategy: "foo"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sampler.Validate()
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestSampler_SampleRateOf(t *testing.T) {
+	okSpan := &loop_span.Span{}
+	errSpan := &loop_span.Span{StatusCode: 500}
+
+	uniform := &Sampler{SampleRate: 0.1}
+	assert.Equal(t, 0.1, uniform.SampleRateOf(errSpan))
+
+	biased := &Sampler{SampleRate: 0.1, SamplingStrategy: SamplingStrategyErrorBiased}
+	assert.Equal(t, 0.1, biased.SampleRateOf(okSpan))
+	assert.Equal(t, 1.0, biased.SampleRateOf(errSpan))
+
+	biased.ErrorBiasedSampling = &ErrorBiasedSampling{ErrorSampleRate: ptr.Of(0.6)}
+	assert.Equal(t, 0.6, biased.SampleRateOf(errSpan))
+}
+
+func TestSampler_StratumOf(t *testing.T) {
+	sampler := &Sampler{
+		SamplingStrategy:   SamplingStrategyStratified,
+		StratifiedSampling: &StratifiedSampling{FieldName: "prompt_key", PerStratumSize: 1},
+	}
+	stratum, ok := sampler.StratumOf(&loop_span.Span{TagsString: map[string]string{"prompt_key": "p1"}})
+	assert.True(t, ok)
+	assert.Equal(t, "p1", stratum)
+
+	stratum, ok = sampler.StratumOf(&loop_span.Span{})
+	assert.True(t, ok)
+	assert.Equal(t, EmptyStratum, stratum)
+
+	stratum, _ = sampler.StratumOf(&loop_span.Span{TagsString: map[string]string{"prompt_key": strings.Repeat("a", 200)}})
+	assert.Len(t, stratum, maxStratumBytes)
+
+	_, ok = (&Sampler{}).StratumOf(&loop_span.Span{})
+	assert.False(t, ok)
+}
+
+func TestSampler_DedupSimHash(t *testing.T) {
+	sampler := &Sampler{Dedup: &SampleDedup{Enabled: true}}
+	a, ok := sampler.DedupSimHash(&loop_span.Span{Input: "Hello,  World!"})
+	assert.True(t, ok)
+	b, _ := sampler.DedupSimHash(&loop_span.Span{Input: "hello world"})
+	assert.Equal(t, a, b)
+	_, ok = sampler.DedupSimHash(&loop_span.Span{Input: " ?! "})
+	assert.False(t, ok)
+
+	byTag := &Sampler{Dedup: &SampleDedup{Enabled: true, FieldName: "query"}}
+	_, ok = byTag.DedupSimHash(&loop_span.Span{TagsString: map[string]string{"query": "q"}})
+	assert.True(t, ok)
+	_, ok = byTag.DedupSimHash(&loop_span.Span{Input: "q"})
+	assert.False(t, ok)
+
+	_, ok = (&Sampler{}).DedupSimHash(&loop_span.Span{Input: "q"})
+	assert.False(t, ok)
+}
+
+func TestSimHash_NearDuplicate(t *testing.T) {
+	simHashOf := func(text string) uint64 {
+		hash, _ := (&Sampler{Dedup: &SampleDedup{Enabled: true}}).DedupSimHash(&loop_span.Span{Input: text})
+		return hash
+	}
+	tests := []struct {
+		a, b    string
+		similar bool
+	}{
+		{
+			a:       "Please summarize the following article about climate change and its effects on agriculture.",
+			b:       "please summarise the following article about climate change and its effects on agriculture",
+			similar: true,
+		},
+		{
+			a:       "Translate the sentence into French: I love programming.",
+			b:       "Translate the sentence into French: I love programming!",
+			similar: true,
+		},
+		{
+			a:       "how do I reset my password",
+			b:       "what is the capital of france",
+			similar: false,
+		},
+		{
+			a:       "hello world",
+			b:       "hello there",
+			similar: false,
+		},
+	}
+	for _, tt := range tests {
+		a, b := simHashOf(tt.a), simHashOf(tt.b)
+		assert.Equal(t, tt.similar, IsSimilarSimHash(a, b), "%q vs %q", tt.a, tt.b)
+		if tt.similar {
+			// 相似的simhash至少有一段相同, 可以通过分段索引查到
+			assert.NotEmpty(t, lo.Intersect(SimHashBands(a), SimHashBands(b)))
+		}
+	}
+	assert.Len(t, SimHashBands(simHashOf("hello")), DedupMaxHammingDistance+1)
+}
+
+func TestNewSampleStats(t *testing.T) {
+	assert.Nil(t, NewSampleStats(nil))
+	stats := NewSampleStats(map[string]int64{
+		SampleStatFieldError:        3,
+		SampleStatFieldDedupSkipped: 2,
+		StratumStatField("p1"):      5,
+		StratumStatField("p2"):      4,
+		"unknown":                   1,
+	})
+	assert.Equal(t, &SampleStats{
+		StratumCounts:     map[string]int64{"p1": 5, "p2": 4},
+		ErrorCount:        3,
+		DedupSkippedCount: 2,
+	}, stats)
+}
@@ -39,6 +39,7 @@ type TaskType string
 const (
 	TaskTypeAutoEval       TaskType = "auto_evaluate"
 	TaskTypeAutoDataReflow TaskType = "auto_data_reflow"
+	TaskTypeAutoTag        TaskType = "auto_tag"
 )
 
 type TaskRunType string
@@ -84,9 +85,17 @@ type ObservabilityTask struct {
 }
 
 type RunDetail struct {
-	SuccessCount int64 `json:"success_count"`
-	FailedCount  int64 `json:"failed_count"`
-	TotalCount   int64 `json:"total_count"`
+	SuccessCount int64        `json:"success_count"`
+	FailedCount  int64        `json:"failed_count"`
+	TotalCount   int64        `json:"total_count"`
+	SampleStats  *SampleStats `json:"sample_stats,omitempty"`
+}
+
+// SampleStats 实际采样情况
+type SampleStats struct {
+	StratumCounts     map[string]int64 `json:"stratum_counts,omitempty"`
+	ErrorCount        int64            `json:"error_count,omitempty"`
+	DedupSkippedCount int64            `json:"dedup_skipped_count,omitempty"`
 }
 type SpanFilterFields struct {
 	Filters      loop_span.FilterFields `json:"filters"`
@@ -106,16 +115,31 @@ type Sampler struct {
 	CycleCount    int64    `json:"cycle_count"`
 	CycleInterval int64    `json:"cycle_interval"`
 	CycleTimeUnit TimeUnit `json:"cycle_time_unit"`
+
+	SamplingStrategy    SamplingStrategy     `json:"sampling_strategy,omitempty"`
+	StratifiedSampling  *StratifiedSampling  `json:"stratified_sampling,omitempty"`
+	ErrorBiasedSampling *ErrorBiasedSampling `json:"error_biased_sampling,omitempty"`
+	Dedup               *SampleDedup         `json:"dedup,omitempty"`
 }
 type TaskConfig struct {
 	AutoEvaluateConfigs []*AutoEvaluateConfig `json:"auto_evaluate_configs"`
 	DataReflowConfig    []*DataReflowConfig
+	AutoTagConfigs      []*AutoTagConfig `json:"auto_tag_configs,omitempty"`
 }
 type AutoEvaluateConfig struct {
 	EvaluatorVersionID int64                   `json:"evaluator_version_id"`
 	EvaluatorID        int64                   `json:"evaluator_id"`
 	FieldMappings      []*EvaluateFieldMapping `json:"field_mappings"`
 }
+type AutoTagConfig struct {
+	EvaluatorVersionID int64                   `json:"evaluator_version_id"`
+	EvaluatorID        int64                   `json:"evaluator_id"`
+	FieldMappings      []*EvaluateFieldMapping `json:"field_mappings"`
+	// 写回span的标签key, 如intent/topic/language/sentiment
+	TagKey string `json:"tag_key"`
+	// 候选标签, 为空时直接使用评估器的reasoning作为标签
+	Labels []string `json:"labels,omitempty"`
+}
 type EvaluateFieldMapping struct {
 	// 数据集字段约束
 	FieldSchema        *dataset.FieldSchema `json:"field_schema"`
@@ -14,7 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/entity"
-	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/repo"
+	repo "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/repo"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -56,6 +56,21 @@ func (mr *MockITaskRepoMockRecorder) AddNonFinalTask(ctx, spaceID, taskID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNonFinalTask", reflect.TypeOf((*MockITaskRepo)(nil).AddNonFinalTask), ctx, spaceID, taskID)
 }
 
+// AddTaskSpanSimHash mocks base method.
+func (m *MockITaskRepo) AddTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64, ttl int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTaskSpanSimHash", ctx, taskID, bands, simHash, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTaskSpanSimHash indicates an expected call of AddTaskSpanSimHash.
+func (mr *MockITaskRepoMockRecorder) AddTaskSpanSimHash(ctx, taskID, bands, simHash, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTaskSpanSimHash", reflect.TypeOf((*MockITaskRepo)(nil).AddTaskSpanSimHash), ctx, taskID, bands, simHash, ttl)
+}
+
 // CreateTask mocks base method.
 func (m *MockITaskRepo) CreateTask(ctx context.Context, do *entity.ObservabilityTask) (int64, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +262,21 @@ func (mr *MockITaskRepoMockRecorder) GetTaskRunFailCount(ctx, taskID, taskRunID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskRunFailCount", reflect.TypeOf((*MockITaskRepo)(nil).GetTaskRunFailCount), ctx, taskID, taskRunID)
 }
 
+// GetTaskRunSampleStats mocks base method.
+func (m *MockITaskRepo) GetTaskRunSampleStats(ctx context.Context, taskID, taskRunID int64) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskRunSampleStats", ctx, taskID, taskRunID)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskRunSampleStats indicates an expected call of GetTaskRunSampleStats.
+func (mr *MockITaskRepoMockRecorder) GetTaskRunSampleStats(ctx, taskID, taskRunID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskRunSampleStats", reflect.TypeOf((*MockITaskRepo)(nil).GetTaskRunSampleStats), ctx, taskID, taskRunID)
+}
+
 // GetTaskRunSuccessCount mocks base method.
 func (m *MockITaskRepo) GetTaskRunSuccessCount(ctx context.Context, taskID, taskRunID int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +334,21 @@ func (mr *MockITaskRepoMockRecorder) IncrTaskRunFailCount(ctx, taskID, taskRunID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrTaskRunFailCount", reflect.TypeOf((*MockITaskRepo)(nil).IncrTaskRunFailCount), ctx, taskID, taskRunID, ttl)
 }
 
+// IncrTaskRunSampleStat mocks base method.
+func (m *MockITaskRepo) IncrTaskRunSampleStat(ctx context.Context, taskID, taskRunID int64, field string, delta, ttl int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrTaskRunSampleStat", ctx, taskID, taskRunID, field, delta, ttl)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrTaskRunSampleStat indicates an expected call of IncrTaskRunSampleStat.
+func (mr *MockITaskRepoMockRecorder) IncrTaskRunSampleStat(ctx, taskID, taskRunID, field, delta, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrTaskRunSampleStat", reflect.TypeOf((*MockITaskRepo)(nil).IncrTaskRunSampleStat), ctx, taskID, taskRunID, field, delta, ttl)
+}
+
 // IncrTaskRunSuccessCount mocks base method.
 func (m *MockITaskRepo) IncrTaskRunSuccessCount(ctx context.Context, taskID, taskRunID, ttl int64) error {
 	m.ctrl.T.Helper()
@@ -348,6 +393,21 @@ func (mr *MockITaskRepoMockRecorder) ListNonFinalTasks(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNonFinalTasks", reflect.TypeOf((*MockITaskRepo)(nil).ListNonFinalTasks), ctx)
 }
 
+// ListTaskSpanSimHashes mocks base method.
+func (m *MockITaskRepo) ListTaskSpanSimHashes(ctx context.Context, taskID int64, bands []string) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskSpanSimHashes", ctx, taskID, bands)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskSpanSimHashes indicates an expected call of ListTaskSpanSimHashes.
+func (mr *MockITaskRepoMockRecorder) ListTaskSpanSimHashes(ctx, taskID, bands any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskSpanSimHashes", reflect.TypeOf((*MockITaskRepo)(nil).ListTaskSpanSimHashes), ctx, taskID, bands)
+}
+
 // ListTasks mocks base method.
 func (m *MockITaskRepo) ListTasks(ctx context.Context, param repo.ListTaskParam) ([]*entity.ObservabilityTask, int64, error) {
 	m.ctrl.T.Helper()
@@ -378,6 +438,20 @@ func (mr *MockITaskRepoMockRecorder) RemoveNonFinalTask(ctx, spaceID, taskID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNonFinalTask", reflect.TypeOf((*MockITaskRepo)(nil).RemoveNonFinalTask), ctx, spaceID, taskID)
 }
 
+// RemoveTaskSpanSimHash mocks base method.
+func (m *MockITaskRepo) RemoveTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTaskSpanSimHash", ctx, taskID, bands, simHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTaskSpanSimHash indicates an expected call of RemoveTaskSpanSimHash.
+func (mr *MockITaskRepoMockRecorder) RemoveTaskSpanSimHash(ctx, taskID, bands, simHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTaskSpanSimHash", reflect.TypeOf((*MockITaskRepo)(nil).RemoveTaskSpanSimHash), ctx, taskID, bands, simHash)
+}
+
 // UpdateTask mocks base method.
 func (m *MockITaskRepo) UpdateTask(ctx context.Context, do *entity.ObservabilityTask) error {
 	m.ctrl.T.Helper()
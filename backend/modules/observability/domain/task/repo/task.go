@@ -54,6 +54,15 @@ type ITaskRepo interface {
 	GetTaskRunFailCount(ctx context.Context, taskID, taskRunID int64) (int64, error)
 	IncrTaskRunFailCount(ctx context.Context, taskID, taskRunID int64, ttl int64) error
 
+	// task run sample stats, 返回增加后的计数
+	IncrTaskRunSampleStat(ctx context.Context, taskID, taskRunID int64, field string, delta int64, ttl int64) (int64, error)
+	GetTaskRunSampleStats(ctx context.Context, taskID, taskRunID int64) (map[string]int64, error)
+
+	// span去重simhash, 按分段索引记录; 添加时simhash已存在返回false
+	ListTaskSpanSimHashes(ctx context.Context, taskID int64, bands []string) ([]uint64, error)
+	AddTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64, ttl int64) (bool, error)
+	RemoveTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64) error
+
 	// 非终态task列表by spaceID，有2s内存缓存
 	ListNonFinalTaskBySpaceID(ctx context.Context, spaceID string) ([]int64, error)
 	AddNonFinalTask(ctx context.Context, spaceID string, taskID int64) error
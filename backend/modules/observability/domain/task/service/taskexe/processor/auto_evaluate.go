@@ -224,33 +224,7 @@ func (p *AutoEvaluateProcessor) OnTaskCreated(ctx context.Context, currentTask *
 }
 
 func (p *AutoEvaluateProcessor) OnTaskUpdated(ctx context.Context, currentTask *task_entity.ObservabilityTask, taskOp task_entity.TaskStatus) error {
-	switch taskOp {
-	case task_entity.TaskStatusSuccess:
-		if currentTask.TaskStatus != task_entity.TaskStatusDisabled {
-			currentTask.TaskStatus = task_entity.TaskStatusSuccess
-		}
-	case task_entity.TaskStatusRunning:
-		if currentTask.TaskStatus != task_entity.TaskStatusDisabled && currentTask.TaskStatus != task_entity.TaskStatusSuccess {
-			currentTask.TaskStatus = task_entity.TaskStatusRunning
-		}
-	case task_entity.TaskStatusDisabled:
-		if currentTask.TaskStatus != task_entity.TaskStatusDisabled {
-			currentTask.TaskStatus = task_entity.TaskStatusDisabled
-		}
-	case task_entity.TaskStatusPending:
-		if currentTask.TaskStatus == task_entity.TaskStatusPending || currentTask.TaskStatus == task_entity.TaskStatusUnstarted {
-			currentTask.TaskStatus = task_entity.TaskStatusPending
-		}
-	default:
-		return fmt.Errorf("OnUpdateChangeProcessor, valid taskOp:%s", taskOp)
-	}
-	// Step 2: update task
-	err := p.taskRepo.UpdateTask(ctx, currentTask)
-	if err != nil {
-		logs.CtxError(ctx, "[auto_task] OnUpdateChangeProcessor, UpdateTask err, taskID:%d, err:%v", currentTask.ID, err)
-		return err
-	}
-	return nil
+	return updateTaskStatus(ctx, p.taskRepo, currentTask, taskOp)
 }
 
 func (p *AutoEvaluateProcessor) OnTaskFinished(ctx context.Context, param taskexe.OnTaskFinishedReq) error {
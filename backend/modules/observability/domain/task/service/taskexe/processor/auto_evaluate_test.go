@@ -23,6 +23,7 @@ import (
 	datadataset "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/data/domain/dataset"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/expt/experimentservice"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dataset"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/task"
 	"github.com/coze-dev/coze-loop/backend/modules/evaluation/pkg/errno"
@@ -176,8 +177,9 @@ func makeSchemaJSON(t *testing.T, fieldName string, contentType common.ContentTy
 
 // ==== 以下为复用 evaluation_test.go 的内容，验证 EvaluationProvider 在 Processor 上的行为 ====
 
-// fakeExperimentClient 满足 experimentservice.Client 接口（以空桩方法实现）
+// fakeExperimentClient 满足 experimentservice.Client 接口（以空桩方法实现，未覆盖的方法由内嵌接口兜底）
 type fakeExperimentClient struct {
+	experimentservice.Client
 	invokeResp *expt.InvokeExperimentResponse
 	invokeErr  error
 }
@@ -1336,6 +1338,10 @@ func (f *fakeEvaluatorAdapter) ListEvaluators(ctx context.Context, param *rpc.Li
 	return nil, nil
 }
 
+func (f *fakeEvaluatorAdapter) RunEvaluator(ctx context.Context, param *rpc.RunEvaluatorParam) (*rpc.EvaluatorRunResult, error) {
+	return nil, nil
+}
+
 // fakeEvaluationAdapter 是 IEvaluationRPCAdapter 的fake实现
 type fakeEvaluationAdapter struct {
 	submitResp struct {
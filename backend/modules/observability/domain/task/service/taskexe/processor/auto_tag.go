@@ -0,0 +1,295 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/spf13/cast"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/task"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
+	task_entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/service/taskexe"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	tracerepo "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+var _ taskexe.Processor = (*AutoTagProcessor)(nil)
+
+// autoTagMaxConcurrency 同时运行的打标评估器上限, 达到上限时span消费等待空闲
+const autoTagMaxConcurrency = 16
+
+// AutoTagProcessor 对命中的span运行分类评估器, 并将得到的标签以annotation形式写回span,
+// 之后可通过auto_tag_{tag_key}字段筛选trace或在指标中按标签过滤
+type AutoTagProcessor struct {
+	evalSvc   rpc.IEvaluatorRPCAdapter
+	taskRepo  repo.ITaskRepo
+	traceRepo tracerepo.ITraceRepo
+	// 评估器耗时较长, 在后台执行, 不阻塞span消费
+	workers chan struct{}
+}
+
+func NewAutoTagProcessor(
+	evalService rpc.IEvaluatorRPCAdapter,
+	taskRepo repo.ITaskRepo,
+	traceRepo tracerepo.ITraceRepo,
+) *AutoTagProcessor {
+	return &AutoTagProcessor{
+		evalSvc:   evalService,
+		taskRepo:  taskRepo,
+		traceRepo: traceRepo,
+		workers:   make(chan struct{}, autoTagMaxConcurrency),
+	}
+}
+
+func (p *AutoTagProcessor) ValidateConfig(ctx context.Context, config any) error {
+	cfg, ok := config.(*task_entity.ObservabilityTask)
+	if !ok {
+		return errorx.NewByCode(obErrorx.CommonInvalidParamCode)
+	}
+	if cfg.EffectiveTime != nil {
+		startAt := cfg.EffectiveTime.StartAt
+		endAt := cfg.EffectiveTime.EndAt
+		if startAt <= time.Now().Add(-10*time.Minute).UnixMilli() {
+			return errorx.NewByCode(obErrorx.CommonInvalidParamCode)
+		}
+		if startAt >= endAt {
+			return errorx.NewByCode(obErrorx.CommonInvalidParamCode)
+		}
+	}
+	if cfg.TaskConfig == nil || len(cfg.TaskConfig.AutoTagConfigs) == 0 {
+		return errorx.NewByCode(obErrorx.CommonInvalidParamCode)
+	}
+	tagKeys := make(map[string]bool)
+	evaluatorVersionIDs := make([]int64, 0, len(cfg.TaskConfig.AutoTagConfigs))
+	for _, autoTagConfig := range cfg.TaskConfig.AutoTagConfigs {
+		if err := autoTagConfig.Validate(); err != nil {
+			return err
+		}
+		// 同一任务内tag_key重复时后写入的标签会覆盖前者
+		if tagKeys[autoTagConfig.TagKey] {
+			return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg(fmt.Sprintf("duplicate tag_key %s", autoTagConfig.TagKey)))
+		}
+		tagKeys[autoTagConfig.TagKey] = true
+		evaluatorVersionIDs = append(evaluatorVersionIDs, autoTagConfig.EvaluatorVersionID)
+	}
+	evaluators, _, err := p.evalSvc.BatchGetEvaluatorVersions(ctx, &rpc.BatchGetEvaluatorVersionsParam{
+		WorkspaceID:         cfg.WorkspaceID,
+		EvaluatorVersionIds: evaluatorVersionIDs,
+	})
+	if err != nil {
+		return errorx.NewByCode(obErrorx.CommonInvalidParamCode)
+	}
+	if len(evaluators) != len(evaluatorVersionIDs) {
+		return errorx.NewByCode(obErrorx.CommonInvalidParamCode)
+	}
+	return nil
+}
+
+func (p *AutoTagProcessor) Invoke(ctx context.Context, trigger *taskexe.Trigger) error {
+	if trigger.TaskRun == nil || trigger.Task.TaskConfig == nil {
+		return nil
+	}
+	taskRunID := trigger.TaskRun.ID
+	taskTTL := trigger.Task.GetTaskttl()
+	_ = p.taskRepo.IncrTaskCount(ctx, trigger.Task.ID, taskTTL)
+	_ = p.taskRepo.IncrTaskRunCount(ctx, trigger.Task.ID, taskRunID, taskTTL)
+	taskCount, _ := p.taskRepo.GetTaskCount(ctx, trigger.Task.ID)
+	taskRunCount, _ := p.taskRepo.GetTaskRunCount(ctx, trigger.Task.ID, taskRunID)
+	if (trigger.Task.Sampler.IsCycle && trigger.Task.Sampler.CycleCount != 0 && taskRunCount > trigger.Task.Sampler.CycleCount) ||
+		(taskCount > trigger.Task.Sampler.SampleSize) {
+		logs.CtxInfo(ctx, "[task-debug] AutoTagProcessor Invoke, subCount:%v,taskCount:%v", taskRunCount, taskCount)
+		_ = p.taskRepo.DecrTaskCount(ctx, trigger.Task.ID, taskTTL)
+		_ = p.taskRepo.DecrTaskRunCount(ctx, trigger.Task.ID, taskRunID, taskTTL)
+		return nil
+	}
+	select {
+	case p.workers <- struct{}{}:
+	case <-ctx.Done():
+		_ = p.taskRepo.DecrTaskCount(ctx, trigger.Task.ID, taskTTL)
+		_ = p.taskRepo.DecrTaskRunCount(ctx, trigger.Task.ID, taskRunID, taskTTL)
+		return ctx.Err()
+	}
+	// span消费与回填都没有登录态, 以任务创建人的身份调用评估器
+	tagCtx := session.WithCtxUser(context.WithoutCancel(ctx), &session.User{ID: trigger.Task.CreatedBy})
+	goroutine.Go(tagCtx, func() {
+		defer func() { <-p.workers }()
+		p.runAutoTags(tagCtx, trigger)
+	})
+	return nil
+}
+
+func (p *AutoTagProcessor) runAutoTags(ctx context.Context, trigger *taskexe.Trigger) {
+	taskRunID := trigger.TaskRun.ID
+	taskTTL := trigger.Task.GetTaskttl()
+	for _, autoTagConfig := range trigger.Task.TaskConfig.AutoTagConfigs {
+		if err := p.tagSpan(ctx, trigger, autoTagConfig); err != nil {
+			logs.CtxWarn(ctx, "[task-debug] AutoTagProcessor tag span failed, task_id=%d, span_id=%s, tag_key=%s, err=%v",
+				trigger.Task.ID, trigger.Span.SpanID, autoTagConfig.TagKey, err)
+			_ = p.taskRepo.IncrTaskRunFailCount(ctx, trigger.Task.ID, taskRunID, taskTTL)
+			continue
+		}
+		_ = p.taskRepo.IncrTaskRunSuccessCount(ctx, trigger.Task.ID, taskRunID, taskTTL)
+	}
+}
+
+func (p *AutoTagProcessor) tagSpan(ctx context.Context, trigger *taskexe.Trigger, autoTagConfig *task_entity.AutoTagConfig) error {
+	span := trigger.Span
+	inputFields := make(map[string]string, len(autoTagConfig.FieldMappings))
+	for _, mapping := range autoTagConfig.FieldMappings {
+		if mapping.FieldSchema == nil || mapping.FieldSchema.GetName() == "" {
+			continue
+		}
+		value, err := span.ExtractByJsonpath(ctx, mapping.TraceFieldKey, mapping.TraceFieldJsonpath)
+		if err != nil {
+			return err
+		}
+		inputFields[mapping.FieldSchema.GetName()] = value
+	}
+	result, err := p.evalSvc.RunEvaluator(ctx, &rpc.RunEvaluatorParam{
+		WorkspaceID:        trigger.Task.WorkspaceID,
+		EvaluatorVersionID: autoTagConfig.EvaluatorVersionID,
+		InputFields:        inputFields,
+		Ext: map[string]string{
+			"span_id":     span.SpanID,
+			"task_id":     cast.ToString(trigger.Task.ID),
+			"task_run_id": cast.ToString(trigger.TaskRun.ID),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if !result.Success {
+		return fmt.Errorf("evaluator run failed, record_id=%d, msg=%s", result.EvaluatorRecordID, result.ErrorMsg)
+	}
+	label := autoTagConfig.LabelOf(result.Score, result.Reasoning)
+	if label == "" {
+		return fmt.Errorf("no label matched, score=%v", result.Score)
+	}
+	// 仅写入本次的标签, 不影响span上已有的其他标注
+	tagged := *span
+	tagged.Annotations = nil
+	if _, err := tagged.AddAutoTagAnnotation(trigger.Task.ID, result.EvaluatorRecordID, autoTagConfig.EvaluatorVersionID,
+		autoTagConfig.TagKey, label, result.Reasoning, trigger.Task.CreatedBy); err != nil {
+		return err
+	}
+	return p.traceRepo.InsertAnnotations(ctx, &tracerepo.InsertAnnotationParam{
+		WorkSpaceID:    strconv.FormatInt(trigger.Task.WorkspaceID, 10),
+		Tenant:         tagged.GetTenant(),
+		TTL:            tagged.GetTTL(ctx),
+		Span:           &tagged,
+		AnnotationType: gptr.Of(loop_span.AnnotationTypeAutoTag),
+	})
+}
+
+func (p *AutoTagProcessor) OnTaskCreated(ctx context.Context, currentTask *task_entity.ObservabilityTask) error {
+	taskRuns, err := p.taskRepo.GetBackfillTaskRun(ctx, nil, currentTask.ID)
+	if err != nil {
+		logs.CtxError(ctx, "GetBackfillTaskRun failed, taskID:%d, err:%v", currentTask.ID, err)
+		return err
+	}
+	if ShouldTriggerBackfill(currentTask) && taskRuns == nil {
+		err = p.OnTaskRunCreated(ctx, taskexe.OnTaskRunCreatedReq{
+			CurrentTask: currentTask,
+			RunType:     task_entity.TaskRunTypeBackFill,
+			RunStartAt:  time.Now().UnixMilli(),
+			RunEndAt:    time.Now().UnixMilli() + (currentTask.BackfillEffectiveTime.EndAt - currentTask.BackfillEffectiveTime.StartAt),
+		})
+		if err != nil {
+			logs.CtxError(ctx, "OnTaskCreated failed, taskID:%d, err:%v", currentTask.ID, err)
+			return err
+		}
+		if err = p.OnTaskUpdated(ctx, currentTask, task.TaskStatusRunning); err != nil {
+			logs.CtxError(ctx, "OnTaskCreated failed, taskID:%d, err:%v", currentTask.ID, err)
+			return err
+		}
+	}
+	if ShouldTriggerNewData(ctx, currentTask) {
+		runStartAt, runEndAt := currentTask.GetRunTimeRange()
+		err = p.OnTaskRunCreated(ctx, taskexe.OnTaskRunCreatedReq{
+			CurrentTask: currentTask,
+			RunType:     task_entity.TaskRunTypeNewData,
+			RunStartAt:  runStartAt,
+			RunEndAt:    runEndAt,
+		})
+		if err != nil {
+			logs.CtxError(ctx, "OnTaskCreated failed, taskID:%d, err:%v", currentTask.ID, err)
+			return err
+		}
+		if err = p.OnTaskUpdated(ctx, currentTask, task.TaskStatusRunning); err != nil {
+			logs.CtxError(ctx, "OnTaskCreated failed, taskID:%d, err:%v", currentTask.ID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *AutoTagProcessor) OnTaskUpdated(ctx context.Context, currentTask *task_entity.ObservabilityTask, taskOp task_entity.TaskStatus) error {
+	return updateTaskStatus(ctx, p.taskRepo, currentTask, taskOp)
+}
+
+func (p *AutoTagProcessor) OnTaskFinished(ctx context.Context, param taskexe.OnTaskFinishedReq) error {
+	if err := p.OnTaskRunFinished(ctx, taskexe.OnTaskRunFinishedReq{
+		Task:    param.Task,
+		TaskRun: param.TaskRun,
+	}); err != nil {
+		logs.CtxError(ctx, "OnTaskRunFinished failed, taskRun:%+v, err:%v", param.TaskRun, err)
+		return err
+	}
+	if param.IsFinish {
+		if err := p.OnTaskUpdated(ctx, param.Task, task.TaskStatusSuccess); err != nil {
+			logs.CtxError(ctx, "OnUpdateChangeProcessor failed, taskID:%d, err:%v", param.Task.ID, err)
+			return err
+		}
+		if err := p.taskRepo.RemoveNonFinalTask(ctx, strconv.FormatInt(param.Task.WorkspaceID, 10), param.Task.ID); err != nil {
+			logs.CtxError(ctx, "RemoveNonFinalTask failed, taskID:%d, err:%v", param.Task.ID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// OnTaskRunCreated 打标直接调用评估器, 不需要创建评测集与实验
+func (p *AutoTagProcessor) OnTaskRunCreated(ctx context.Context, param taskexe.OnTaskRunCreatedReq) error {
+	currentTask := param.CurrentTask
+	taskRun := &task_entity.TaskRun{
+		TaskID:        currentTask.ID,
+		WorkspaceID:   currentTask.WorkspaceID,
+		TaskType:      param.RunType,
+		RunStatus:     task_entity.TaskRunStatusRunning,
+		RunStartAt:    time.UnixMilli(param.RunStartAt),
+		RunEndAt:      time.UnixMilli(param.RunEndAt),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+		TaskRunConfig: &task_entity.TaskRunConfig{},
+	}
+	if _, err := p.taskRepo.CreateTaskRun(ctx, taskRun); err != nil {
+		logs.CtxError(ctx, "[auto_task] AutoTagProcessor CreateTaskRun err, taskRun:%+v, err:%v", taskRun, err)
+		return err
+	}
+	return nil
+}
+
+func (p *AutoTagProcessor) OnTaskRunFinished(ctx context.Context, param taskexe.OnTaskRunFinishedReq) error {
+	if param.TaskRun == nil {
+		return nil
+	}
+	param.TaskRun.RunStatus = task.RunStatusDone
+	if err := p.taskRepo.UpdateTaskRun(ctx, param.TaskRun); err != nil {
+		logs.CtxError(ctx, "[auto_task] AutoTagProcessor UpdateTaskRun err, taskRunID:%d, err:%v", param.TaskRun.ID, err)
+		return err
+	}
+	return nil
+}
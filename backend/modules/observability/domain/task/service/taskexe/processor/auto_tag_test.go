@@ -0,0 +1,251 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gg/gptr"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dataset"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
+	rpcmock "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc/mocks"
+	taskentity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/entity"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/repo/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/service/taskexe"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	tracerepo "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	tracerepomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo/mocks"
+)
+
+func newAutoTagTask() *taskentity.ObservabilityTask {
+	return &taskentity.ObservabilityTask{
+		ID:          1,
+		WorkspaceID: 2,
+		TaskType:    taskentity.TaskTypeAutoTag,
+		CreatedBy:   "3",
+		Sampler:     &taskentity.Sampler{SampleSize: 10},
+		TaskConfig: &taskentity.TaskConfig{
+			AutoTagConfigs: []*taskentity.AutoTagConfig{
+				{
+					EvaluatorVersionID: 100,
+					TagKey:             "intent",
+					Labels:             []string{"refund", "query"},
+					FieldMappings: []*taskentity.EvaluateFieldMapping{
+						{
+							FieldSchema:        &dataset.FieldSchema{Name: gptr.Of("input")},
+							TraceFieldKey:      "Input",
+							TraceFieldJsonpath: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestAutoTagProcessor_ValidateConfig(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	evalAdapter := rpcmock.NewMockIEvaluatorRPCAdapter(ctrl)
+	p := NewAutoTagProcessor(evalAdapter, nil, nil)
+
+	assert.Error(t, p.ValidateConfig(context.Background(), "bad"))
+
+	noConfig := newAutoTagTask()
+	noConfig.TaskConfig.AutoTagConfigs = nil
+	assert.Error(t, p.ValidateConfig(context.Background(), noConfig))
+
+	dupKey := newAutoTagTask()
+	dupKey.TaskConfig.AutoTagConfigs = append(dupKey.TaskConfig.AutoTagConfigs, &taskentity.AutoTagConfig{EvaluatorVersionID: 101, TagKey: "intent"})
+	assert.Error(t, p.ValidateConfig(context.Background(), dupKey))
+
+	evalAdapter.EXPECT().BatchGetEvaluatorVersions(gomock.Any(), gomock.Any()).Return([]*rpc.Evaluator{{EvaluatorVersionID: 100}}, nil, nil)
+	assert.NoError(t, p.ValidateConfig(context.Background(), newAutoTagTask()))
+}
+
+func TestAutoTagProcessor_Invoke(t *testing.T) {
+	t.Parallel()
+
+	span := &loop_span.Span{
+		SpanID:      "span",
+		TraceID:     "trace",
+		WorkspaceID: "2",
+		StartTime:   time.Now().UnixMicro(),
+		Input:       "I want my money back",
+		Annotations: loop_span.AnnotationList{{AnnotationType: loop_span.AnnotationTypeManualFeedback}},
+	}
+
+	t.Run("live trigger without session user", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		taskRepo := repomocks.NewMockITaskRepo(ctrl)
+		evalAdapter := rpcmock.NewMockIEvaluatorRPCAdapter(ctrl)
+		traceRepo := tracerepomocks.NewMockITraceRepo(ctrl)
+
+		taskRepo.EXPECT().IncrTaskCount(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+		taskRepo.EXPECT().IncrTaskRunCount(gomock.Any(), int64(1), int64(5), gomock.Any()).Return(nil)
+		taskRepo.EXPECT().GetTaskCount(gomock.Any(), int64(1)).Return(int64(1), nil)
+		taskRepo.EXPECT().GetTaskRunCount(gomock.Any(), int64(1), int64(5)).Return(int64(1), nil)
+		evalAdapter.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, param *rpc.RunEvaluatorParam) (*rpc.EvaluatorRunResult, error) {
+				// span消费时ctx中没有登录用户, 以任务创建人身份调用评估器
+				assert.Equal(t, "3", session.UserIDInCtxOrEmpty(ctx))
+				assert.Equal(t, int64(100), param.EvaluatorVersionID)
+				assert.Equal(t, "I want my money back", param.InputFields["input"])
+				return &rpc.EvaluatorRunResult{EvaluatorRecordID: 9, Success: true, Reasoning: "Refund"}, nil
+			})
+		traceRepo.EXPECT().InsertAnnotations(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, param *tracerepo.InsertAnnotationParam) error {
+				assert.Equal(t, loop_span.AnnotationTypeAutoTag, *param.AnnotationType)
+				assert.Len(t, param.Span.Annotations, 1)
+				anno := param.Span.Annotations[0]
+				assert.Equal(t, "intent", anno.Key)
+				assert.Equal(t, "refund", anno.Value.StringValue)
+				assert.Equal(t, int64(9), anno.GetAutoTagMetadata().EvaluatorRecordID)
+				return nil
+			})
+		done := make(chan struct{})
+		taskRepo.EXPECT().IncrTaskRunSuccessCount(gomock.Any(), int64(1), int64(5), gomock.Any()).DoAndReturn(
+			func(context.Context, int64, int64, int64) error {
+				close(done)
+				return nil
+			})
+
+		p := NewAutoTagProcessor(evalAdapter, taskRepo, traceRepo)
+		err := p.Invoke(context.Background(), &taskexe.Trigger{
+			Task:    newAutoTagTask(),
+			Span:    span,
+			TaskRun: &taskentity.TaskRun{ID: 5},
+		})
+		assert.NoError(t, err)
+		waitAutoTag(t, done)
+		// 原span上的标注不受影响
+		assert.Len(t, span.Annotations, 1)
+	})
+
+	t.Run("evaluator failed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		taskRepo := repomocks.NewMockITaskRepo(ctrl)
+		evalAdapter := rpcmock.NewMockIEvaluatorRPCAdapter(ctrl)
+
+		taskRepo.EXPECT().IncrTaskCount(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		taskRepo.EXPECT().IncrTaskRunCount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		taskRepo.EXPECT().GetTaskCount(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		taskRepo.EXPECT().GetTaskRunCount(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		evalAdapter.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error"))
+		done := make(chan struct{})
+		taskRepo.EXPECT().IncrTaskRunFailCount(gomock.Any(), int64(1), int64(5), gomock.Any()).DoAndReturn(
+			func(context.Context, int64, int64, int64) error {
+				close(done)
+				return nil
+			})
+
+		p := NewAutoTagProcessor(evalAdapter, taskRepo, tracerepomocks.NewMockITraceRepo(ctrl))
+		assert.NoError(t, p.Invoke(context.Background(), &taskexe.Trigger{
+			Task:    newAutoTagTask(),
+			Span:    span,
+			TaskRun: &taskentity.TaskRun{ID: 5},
+		}))
+		waitAutoTag(t, done)
+	})
+
+	t.Run("evaluator runs in background", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		taskRepo := repomocks.NewMockITaskRepo(ctrl)
+		evalAdapter := rpcmock.NewMockIEvaluatorRPCAdapter(ctrl)
+
+		taskRepo.EXPECT().IncrTaskCount(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		taskRepo.EXPECT().IncrTaskRunCount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		taskRepo.EXPECT().GetTaskCount(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		taskRepo.EXPECT().GetTaskRunCount(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		release := make(chan struct{})
+		evalAdapter.EXPECT().RunEvaluator(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, *rpc.RunEvaluatorParam) (*rpc.EvaluatorRunResult, error) {
+				<-release
+				return nil, errors.New("rpc error")
+			})
+		done := make(chan struct{})
+		taskRepo.EXPECT().IncrTaskRunFailCount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, int64, int64, int64) error {
+				close(done)
+				return nil
+			})
+
+		p := NewAutoTagProcessor(evalAdapter, taskRepo, tracerepomocks.NewMockITraceRepo(ctrl))
+		// 评估器未返回时Invoke已经返回, 不阻塞span消费
+		assert.NoError(t, p.Invoke(context.Background(), &taskexe.Trigger{
+			Task:    newAutoTagTask(),
+			Span:    span,
+			TaskRun: &taskentity.TaskRun{ID: 5},
+		}))
+		close(release)
+		waitAutoTag(t, done)
+	})
+
+	t.Run("sample size exceeded", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		taskRepo := repomocks.NewMockITaskRepo(ctrl)
+
+		taskRepo.EXPECT().IncrTaskCount(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		taskRepo.EXPECT().IncrTaskRunCount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		taskRepo.EXPECT().GetTaskCount(gomock.Any(), gomock.Any()).Return(int64(11), nil)
+		taskRepo.EXPECT().GetTaskRunCount(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(11), nil)
+		taskRepo.EXPECT().DecrTaskCount(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		taskRepo.EXPECT().DecrTaskRunCount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		p := NewAutoTagProcessor(rpcmock.NewMockIEvaluatorRPCAdapter(ctrl), taskRepo, tracerepomocks.NewMockITraceRepo(ctrl))
+		assert.NoError(t, p.Invoke(context.Background(), &taskexe.Trigger{
+			Task:    newAutoTagTask(),
+			Span:    span,
+			TaskRun: &taskentity.TaskRun{ID: 5},
+		}))
+	})
+}
+
+func waitAutoTag(t *testing.T, done <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("auto tag not finished")
+	}
+}
+
+func TestAutoTagProcessor_OnTaskRunCreated(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	taskRepo := repomocks.NewMockITaskRepo(ctrl)
+	taskRepo.EXPECT().CreateTaskRun(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRun *taskentity.TaskRun) (int64, error) {
+			assert.Equal(t, taskentity.TaskRunTypeNewData, taskRun.TaskType)
+			assert.Equal(t, taskentity.TaskRunStatusRunning, taskRun.RunStatus)
+			assert.Nil(t, taskRun.TaskRunConfig.AutoEvaluateRunConfig)
+			return 1, nil
+		})
+
+	p := NewAutoTagProcessor(nil, taskRepo, nil)
+	assert.NoError(t, p.OnTaskRunCreated(context.Background(), taskexe.OnTaskRunCreatedReq{
+		CurrentTask: newAutoTagTask(),
+		RunType:     taskentity.TaskRunTypeNewData,
+		RunStartAt:  time.Now().UnixMilli(),
+		RunEndAt:    time.Now().Add(time.Hour).UnixMilli(),
+	}))
+}
@@ -5,6 +5,7 @@ package processor
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/rpc/evaluationset"
@@ -19,6 +20,7 @@ import (
 	dataset0 "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/dataset"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/observability/domain/task"
 	task_entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/task/repo"
 
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
@@ -40,7 +42,7 @@ func getCategory(taskType task.TaskType) entity.DatasetCategory {
 func ShouldTriggerBackfill(taskDO *task_entity.ObservabilityTask) bool {
 	// 检查任务类型
 	taskType := taskDO.TaskType
-	if taskType != task.TaskTypeAutoEval && taskType != task.TaskTypeAutoDataReflow {
+	if taskType != task.TaskTypeAutoEval && taskType != task.TaskTypeAutoDataReflow && taskType != task.TaskTypeAutoTag {
 		return false
 	}
 
@@ -58,7 +60,7 @@ func ShouldTriggerBackfill(taskDO *task_entity.ObservabilityTask) bool {
 func ShouldTriggerNewData(ctx context.Context, taskDO *task_entity.ObservabilityTask) bool {
 	// 检查任务类型
 	taskType := taskDO.TaskType
-	if taskType != task.TaskTypeAutoEval && taskType != task.TaskTypeAutoDataReflow {
+	if taskType != task.TaskTypeAutoEval && taskType != task.TaskTypeAutoDataReflow && taskType != task.TaskTypeAutoTag {
 		return false
 	}
 
@@ -73,6 +75,37 @@ func ShouldTriggerNewData(ctx context.Context, taskDO *task_entity.Observability
 		time.Now().After(time.UnixMilli(taskDO.EffectiveTime.StartAt))
 }
 
+// updateTaskStatus 按任务操作推进任务状态并落库, 已禁用或已完成的任务不会被改回运行中
+func updateTaskStatus(ctx context.Context, taskRepo repo.ITaskRepo, currentTask *task_entity.ObservabilityTask, taskOp task_entity.TaskStatus) error {
+	switch taskOp {
+	case task_entity.TaskStatusSuccess:
+		if currentTask.TaskStatus != task_entity.TaskStatusDisabled {
+			currentTask.TaskStatus = task_entity.TaskStatusSuccess
+		}
+	case task_entity.TaskStatusRunning:
+		if currentTask.TaskStatus != task_entity.TaskStatusDisabled && currentTask.TaskStatus != task_entity.TaskStatusSuccess {
+			currentTask.TaskStatus = task_entity.TaskStatusRunning
+		}
+	case task_entity.TaskStatusDisabled:
+		if currentTask.TaskStatus != task_entity.TaskStatusDisabled {
+			currentTask.TaskStatus = task_entity.TaskStatusDisabled
+		}
+	case task_entity.TaskStatusPending:
+		if currentTask.TaskStatus == task_entity.TaskStatusPending || currentTask.TaskStatus == task_entity.TaskStatusUnstarted {
+			currentTask.TaskStatus = task_entity.TaskStatusPending
+		}
+	default:
+		return fmt.Errorf("OnUpdateChangeProcessor, valid taskOp:%s", taskOp)
+	}
+	// Step 2: update task
+	err := taskRepo.UpdateTask(ctx, currentTask)
+	if err != nil {
+		logs.CtxError(ctx, "[auto_task] OnUpdateChangeProcessor, UpdateTask err, taskID:%d, err:%v", currentTask.ID, err)
+		return err
+	}
+	return nil
+}
+
 func ToJSONString(ctx context.Context, obj interface{}) string {
 	if obj == nil {
 		return ""
@@ -29,6 +29,7 @@ type TaskRunCountInfo struct {
 	TaskRunCount     int64
 	TaskRunSuccCount int64
 	TaskRunFailCount int64
+	SampleStats      *entity.SampleStats
 }
 
 const (
@@ -462,6 +463,14 @@ func (t *StatusCheckTask) processBatch(ctx context.Context, batch []*TaskRunCoun
 			info.TaskRunFailCount = failCount
 		}
 
+		// Read taskrun sample stats
+		sampleStats, err := t.taskRepo.GetTaskRunSampleStats(ctx, info.TaskID, info.TaskRunID)
+		if err != nil {
+			logs.CtxWarn(ctx, "Failed to get TaskRunSampleStats, taskID:%d, taskRunID:%d, err:%v", info.TaskID, info.TaskRunID, err)
+		} else {
+			info.SampleStats = entity.NewSampleStats(sampleStats)
+		}
+
 		logs.CtxDebug(ctx, "Read count data",
 			"taskID", info.TaskID,
 			"taskRunID", info.TaskRunID,
@@ -496,6 +505,9 @@ func (t *StatusCheckTask) updateTaskRunDetail(ctx context.Context, info *TaskRun
 		"success_count": info.TaskRunSuccCount,
 		"failed_count":  info.TaskRunFailCount,
 	}
+	if info.SampleStats != nil {
+		runDetail["sample_stats"] = info.SampleStats
+	}
 
 	// Update using optimistic locking
 	err := t.taskRepo.UpdateTaskRunWithOCC(ctx, info.TaskRunID, 0, map[string]interface{}{
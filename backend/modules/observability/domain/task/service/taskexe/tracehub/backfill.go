@@ -371,13 +371,33 @@ func (h *TraceHubServiceImpl) flushSpans(ctx context.Context, spans []*loop_span
 }
 
 // applySampling applies sampling logic
+// Under error-biased sampling, failed spans are sampled with their own rate and dispatched first.
 func (h *TraceHubServiceImpl) applySampling(spans []*loop_span.Span, sub *spanSubscriber) []*loop_span.Span {
 	sampler := sub.t.Sampler
 	if sampler == nil {
 		return spans
 	}
+	if sampler.GetSamplingStrategy() != entity.SamplingStrategyErrorBiased {
+		return sampleByRate(spans, sampler.SampleRate)
+	}
+
+	var errorSpans, normalSpans []*loop_span.Span
+	for _, span := range spans {
+		if entity.IsErrorSpan(span) {
+			errorSpans = append(errorSpans, span)
+		} else {
+			normalSpans = append(normalSpans, span)
+		}
+	}
+	var sampled []*loop_span.Span
+	if len(errorSpans) > 0 {
+		sampled = append(sampled, sampleByRate(errorSpans, sampler.SampleRateOf(errorSpans[0]))...)
+	}
+	sampled = append(sampled, sampleByRate(normalSpans, sampler.SampleRate)...)
+	return sampled
+}
 
-	sampleRate := sampler.SampleRate
+func sampleByRate(spans []*loop_span.Span, sampleRate float64) []*loop_span.Span {
 	if sampleRate >= 1.0 {
 		return spans // 100% sampling
 	}
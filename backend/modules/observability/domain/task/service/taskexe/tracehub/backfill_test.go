@@ -659,6 +659,25 @@ func TestTraceHubServiceImpl_ApplySampling(t *testing.T) {
 	require.Equal(t, spans[:1], resHalf)
 }
 
+func TestTraceHubServiceImpl_ApplySampling_ErrorBiased(t *testing.T) {
+	t.Parallel()
+
+	impl := &TraceHubServiceImpl{}
+	spans := []*loop_span.Span{
+		{SpanID: "1"}, {SpanID: "2", StatusCode: 1}, {SpanID: "3"}, {SpanID: "4"}, {SpanID: "5", StatusCode: 1},
+	}
+	sub := &spanSubscriber{t: &entity.ObservabilityTask{Sampler: &entity.Sampler{
+		SampleRate:       0.4,
+		SamplingStrategy: entity.SamplingStrategyErrorBiased,
+	}}}
+	res := impl.applySampling(spans, sub)
+	require.Equal(t, []*loop_span.Span{spans[1], spans[4], spans[0]}, res)
+
+	sub.t.Sampler.ErrorBiasedSampling = &entity.ErrorBiasedSampling{ErrorSampleRate: ptr.Of(0.5)}
+	res = impl.applySampling(spans, sub)
+	require.Equal(t, []*loop_span.Span{spans[1], spans[0]}, res)
+}
+
 func TestTraceHubServiceImpl_OnHandleDone(t *testing.T) {
 	t.Parallel()
 
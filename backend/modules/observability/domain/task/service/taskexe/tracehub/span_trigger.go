@@ -167,7 +167,7 @@ func (h *TraceHubServiceImpl) buildSubscriberOfSpan(ctx context.Context, span *l
 			continue
 		}
 		if ok {
-			if s.Sampled() {
+			if s.Sampled(span) {
 				subscribers[keep] = s
 				keep++
 			} else {
@@ -33,13 +33,14 @@ type spanSubscriber struct {
 }
 
 // Sampled determines whether a span is sampled based on the sampling rate; the sample size will be validated during flush.
-func (s *spanSubscriber) Sampled() bool {
+// Failed spans use a separate rate under error-biased sampling.
+func (s *spanSubscriber) Sampled(span *loop_span.Span) bool {
 	if s.t == nil || s.t.Sampler == nil {
 		return false
 	}
 
 	const base = 10000
-	threshold := int64(float64(base) * s.t.Sampler.SampleRate)
+	threshold := int64(float64(base) * s.t.Sampler.SampleRateOf(span))
 	r := rand.Int63n(base)
 	return r <= threshold
 }
@@ -208,6 +209,13 @@ func (s *spanSubscriber) AddSpan(ctx context.Context, span *loop_span.Span) erro
 		logs.CtxWarn(ctx, "span start time is before task cycle start time, trace_id=%s, span_id=%s", span.TraceID, span.SpanID)
 		return nil
 	}
+	admitted, err := s.admitSpan(ctx, span, taskRunConfig)
+	if err != nil {
+		logs.CtxWarn(ctx, "admit span failed, task_id=%d, span_id=%s, err: %v", s.t.ID, span.SpanID, err)
+		return err
+	} else if !admitted {
+		return nil
+	}
 	trigger := &taskexe.Trigger{Task: s.t, Span: span, TaskRun: taskRunConfig}
 	logs.CtxDebug(ctx, "invoke processor, trigger: %v", trigger)
 	// New Data 在这里处理
@@ -216,14 +224,102 @@ func (s *spanSubscriber) AddSpan(ctx context.Context, span *loop_span.Span) erro
 		err := s.traceService.MergeHistoryMessagesByRespIDBatch(ctx, []*loop_span.Span{span}, s.t.GetPlatformType())
 		if err != nil {
 			logs.CtxError(ctx, "merge history messages failed, task_id=%d, span_id=%s err: %v", s.t.ID, span.SpanID, err)
+			s.revokeSpan(ctx, span, taskRunConfig)
 			return err
 		}
 	}
 	err = s.processor.Invoke(ctx, trigger)
 	if err != nil {
 		logs.CtxWarn(ctx, "invoke processor failed, trace_id=%s, span_id=%s, err: %v", span.TraceID, span.SpanID, err)
+		s.revokeSpan(ctx, span, taskRunConfig)
 		return err
 	}
 
 	return nil
 }
+
+// admitSpan applies the stateful part of the sampling strategy: near-identical inputs are kept only once per task,
+// and stratified sampling caps the samples of each stratum within a task run.
+func (s *spanSubscriber) admitSpan(ctx context.Context, span *loop_span.Span, taskRun *entity.TaskRun) (bool, error) {
+	sampler := s.t.Sampler
+	if sampler == nil {
+		return true, nil
+	}
+	ttl := s.t.GetTaskttl()
+	simHash, dedup := sampler.DedupSimHash(span)
+	if dedup {
+		admitted, err := s.markSimHash(ctx, simHash, ttl)
+		if err != nil {
+			return false, err
+		}
+		if !admitted {
+			logs.CtxInfo(ctx, "similar span input already sampled, skip, task_id=%d, span_id=%s", s.t.ID, span.SpanID)
+			_, _ = s.taskRepo.IncrTaskRunSampleStat(ctx, s.t.ID, taskRun.ID, entity.SampleStatFieldDedupSkipped, 1, ttl)
+			return false, nil
+		}
+	}
+	unmark := func() {
+		if dedup {
+			s.unmarkSimHash(ctx, simHash)
+		}
+	}
+	if stratum, ok := sampler.StratumOf(span); ok {
+		field := entity.StratumStatField(stratum)
+		count, err := s.taskRepo.IncrTaskRunSampleStat(ctx, s.t.ID, taskRun.ID, field, 1, ttl)
+		if err != nil {
+			unmark()
+			return false, err
+		}
+		if count > sampler.StratifiedSampling.PerStratumSize {
+			logs.CtxInfo(ctx, "stratum is full, skip, task_id=%d, stratum=%s, span_id=%s", s.t.ID, stratum, span.SpanID)
+			_, _ = s.taskRepo.IncrTaskRunSampleStat(ctx, s.t.ID, taskRun.ID, field, -1, ttl)
+			unmark()
+			return false, nil
+		}
+	}
+	if sampler.GetSamplingStrategy() == entity.SamplingStrategyErrorBiased && entity.IsErrorSpan(span) {
+		_, _ = s.taskRepo.IncrTaskRunSampleStat(ctx, s.t.ID, taskRun.ID, entity.SampleStatFieldError, 1, ttl)
+	}
+	return true, nil
+}
+
+// revokeSpan rolls back the sampling state taken by admitSpan when the span fails to be processed.
+func (s *spanSubscriber) revokeSpan(ctx context.Context, span *loop_span.Span, taskRun *entity.TaskRun) {
+	sampler := s.t.Sampler
+	if sampler == nil {
+		return
+	}
+	ttl := s.t.GetTaskttl()
+	if simHash, ok := sampler.DedupSimHash(span); ok {
+		s.unmarkSimHash(ctx, simHash)
+	}
+	if stratum, ok := sampler.StratumOf(span); ok {
+		_, _ = s.taskRepo.IncrTaskRunSampleStat(ctx, s.t.ID, taskRun.ID, entity.StratumStatField(stratum), -1, ttl)
+	}
+	if sampler.GetSamplingStrategy() == entity.SamplingStrategyErrorBiased && entity.IsErrorSpan(span) {
+		_, _ = s.taskRepo.IncrTaskRunSampleStat(ctx, s.t.ID, taskRun.ID, entity.SampleStatFieldError, -1, ttl)
+	}
+}
+
+// markSimHash records the simhash of a span input unless a near-identical input (within
+// entity.DedupMaxHammingDistance) has already been sampled by the task. Candidates are looked up through the
+// simhash bands, since any two similar simhashes share at least one band.
+func (s *spanSubscriber) markSimHash(ctx context.Context, simHash uint64, ttl int64) (bool, error) {
+	bands := entity.SimHashBands(simHash)
+	sampled, err := s.taskRepo.ListTaskSpanSimHashes(ctx, s.t.ID, bands)
+	if err != nil {
+		return false, err
+	}
+	for _, h := range sampled {
+		if entity.IsSimilarSimHash(h, simHash) {
+			return false, nil
+		}
+	}
+	return s.taskRepo.AddTaskSpanSimHash(ctx, s.t.ID, bands, simHash, ttl)
+}
+
+func (s *spanSubscriber) unmarkSimHash(ctx context.Context, simHash uint64) {
+	if err := s.taskRepo.RemoveTaskSpanSimHash(ctx, s.t.ID, entity.SimHashBands(simHash), simHash); err != nil {
+		logs.CtxWarn(ctx, "remove span simhash failed, task_id=%d, err: %v", s.t.ID, err)
+	}
+}
@@ -136,6 +136,94 @@ func TestSpanSubscriber_AddSpan_SkipNonRunning(t *testing.T) {
 	assert.False(t, proc.invoked, "Invoke should not be called for non-running TaskRun")
 }
 
+func TestSpanSubscriber_AddSpan_SamplingStrategy(t *testing.T) {
+	t.Parallel()
+
+	newSub := func(ctrl *gomock.Controller, sampler *entity.Sampler) (*spanSubscriber, *repo_mocks.MockITaskRepo, *noopProcessor) {
+		mockRepo := repo_mocks.NewMockITaskRepo(ctrl)
+		proc := &noopProcessor{}
+		task := &entity.ObservabilityTask{ID: 42, WorkspaceID: 7, TaskStatus: entity.TaskStatusRunning, Sampler: sampler}
+		run := &entity.TaskRun{
+			ID:         1001,
+			TaskID:     task.ID,
+			TaskType:   entity.TaskRunTypeBackFill,
+			RunStatus:  entity.TaskRunStatusRunning,
+			RunStartAt: time.Now().Add(-time.Minute),
+			RunEndAt:   time.Now().Add(time.Minute),
+		}
+		mockRepo.EXPECT().GetBackfillTaskRun(gomock.Any(), gomock.Nil(), task.ID).Return(run, nil).AnyTimes()
+		return &spanSubscriber{
+			taskID:    task.ID,
+			t:         task,
+			processor: proc,
+			taskRepo:  mockRepo,
+			runType:   entity.TaskRunTypeBackFill,
+		}, mockRepo, proc
+	}
+	span := &loop_span.Span{
+		TraceID:    "trace",
+		SpanID:     "span",
+		StartTime:  time.Now().UnixMilli(),
+		Input:      "hello",
+		StatusCode: 1,
+		TagsString: map[string]string{"prompt_key": "p1"},
+	}
+
+	dedupSampler := &entity.Sampler{Dedup: &entity.SampleDedup{Enabled: true}}
+	simHash, _ := dedupSampler.DedupSimHash(span)
+
+	t.Run("similar input skipped", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		sub, mockRepo, proc := newSub(ctrl, dedupSampler)
+		// 已采样的输入与当前输入的simhash仅相差两位
+		mockRepo.EXPECT().ListTaskSpanSimHashes(gomock.Any(), int64(42), entity.SimHashBands(simHash)).Return([]uint64{simHash ^ 0b101}, nil)
+		mockRepo.EXPECT().IncrTaskRunSampleStat(gomock.Any(), int64(42), int64(1001), entity.SampleStatFieldDedupSkipped, int64(1), gomock.Any()).Return(int64(1), nil)
+		assert.NoError(t, sub.AddSpan(context.Background(), span))
+		assert.False(t, proc.invoked)
+	})
+
+	t.Run("dissimilar input admitted", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		sub, mockRepo, proc := newSub(ctrl, dedupSampler)
+		mockRepo.EXPECT().ListTaskSpanSimHashes(gomock.Any(), int64(42), gomock.Any()).Return([]uint64{simHash ^ 0xff}, nil)
+		mockRepo.EXPECT().AddTaskSpanSimHash(gomock.Any(), int64(42), entity.SimHashBands(simHash), simHash, gomock.Any()).Return(true, nil)
+		assert.NoError(t, sub.AddSpan(context.Background(), span))
+		assert.True(t, proc.invoked)
+	})
+
+	t.Run("stratum full", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		sub, mockRepo, proc := newSub(ctrl, &entity.Sampler{
+			SamplingStrategy:   entity.SamplingStrategyStratified,
+			StratifiedSampling: &entity.StratifiedSampling{FieldName: "prompt_key", PerStratumSize: 2},
+			Dedup:              &entity.SampleDedup{Enabled: true},
+		})
+		mockRepo.EXPECT().ListTaskSpanSimHashes(gomock.Any(), int64(42), gomock.Any()).Return(nil, nil)
+		mockRepo.EXPECT().AddTaskSpanSimHash(gomock.Any(), int64(42), gomock.Any(), simHash, gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().IncrTaskRunSampleStat(gomock.Any(), int64(42), int64(1001), entity.StratumStatField("p1"), int64(1), gomock.Any()).Return(int64(3), nil)
+		mockRepo.EXPECT().IncrTaskRunSampleStat(gomock.Any(), int64(42), int64(1001), entity.StratumStatField("p1"), int64(-1), gomock.Any()).Return(int64(2), nil)
+		mockRepo.EXPECT().RemoveTaskSpanSimHash(gomock.Any(), int64(42), gomock.Any(), simHash).Return(nil)
+		assert.NoError(t, sub.AddSpan(context.Background(), span))
+		assert.False(t, proc.invoked)
+	})
+
+	t.Run("admitted with error stat", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		sub, mockRepo, proc := newSub(ctrl, &entity.Sampler{SamplingStrategy: entity.SamplingStrategyErrorBiased})
+		mockRepo.EXPECT().IncrTaskRunSampleStat(gomock.Any(), int64(42), int64(1001), entity.SampleStatFieldError, int64(1), gomock.Any()).Return(int64(1), nil)
+		assert.NoError(t, sub.AddSpan(context.Background(), span))
+		assert.True(t, proc.invoked)
+	})
+}
+
 func TestSpanSubscriber_Match_PlatformAndTenant_Positive(t *testing.T) {
 	t.Parallel()
 	basic := []*loop_span.FilterField{
@@ -47,9 +47,11 @@ const (
 	AnnotationTypeCozeFeedback        AnnotationType = "coze_feedback"
 	AnnotationTypeManualDataset       AnnotationType = "manual_dataset"
 	AnnotationTypeOpenAPIFeedback     AnnotationType = "openapi_feedback"
+	AnnotationTypeAutoTag             AnnotationType = "auto_tag"
 
 	AnnotationOpenAPIFeedbackFieldPrefix = "feedback_openapi_"
 	AnnotationManualFeedbackFieldPrefix  = "manual_feedback_"
+	AnnotationAutoTagFieldPrefix         = "auto_tag_"
 )
 
 type AnnotationValue struct {
@@ -74,6 +76,12 @@ type AutoEvaluateMetadata struct {
 	EvaluatorVersionID int64 `json:"evaluator_version_id"`
 }
 
+type AutoTagMetadata struct {
+	TaskID             int64 `json:"task_id"`
+	EvaluatorRecordID  int64 `json:"evaluator_record_id"`
+	EvaluatorVersionID int64 `json:"evaluator_version_id"`
+}
+
 type AnnotationManualFeedback struct {
 	TagKeyId   int64  // 标签Key的ID
 	TagKeyName string // 标签Key的名称
@@ -139,6 +147,20 @@ func (a *Annotation) GetAutoEvaluateMetadata() *AutoEvaluateMetadata {
 	return &metadata
 }
 
+func (a *Annotation) GetAutoTagMetadata() *AutoTagMetadata {
+	if a.AnnotationType != AnnotationTypeAutoTag {
+		return nil
+	}
+	switch metadata := a.Metadata.(type) {
+	case AutoTagMetadata:
+		return &metadata
+	case *AutoTagMetadata:
+		return metadata
+	default:
+		return nil
+	}
+}
+
 func (a *Annotation) GetDatasetMetadata() *ManualDatasetMetadata {
 	if a.AnnotationType != AnnotationTypeManualEvaluationSet && a.AnnotationType != AnnotationTypeManualDataset {
 		return nil
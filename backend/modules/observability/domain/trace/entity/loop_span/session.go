@@ -0,0 +1,97 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loop_span
+
+import (
+	"context"
+	"sort"
+)
+
+// Session 以thread_id标识的多轮会话，会话内每条trace为一轮
+type Session struct {
+	SessionID    string
+	TurnCount    int64
+	InputTokens  int64
+	OutputTokens int64
+	Latency      int64 // ms, 各轮耗时之和
+	StartTime    int64 // ms, 首轮开始时间
+	EndTime      int64 // ms, 最后一个span的结束时间
+	Turns        []*SessionTurn
+}
+
+type SessionTurn struct {
+	TraceID      string
+	RootSpan     *Span // 可能为空, 根节点不在查询范围内时
+	StartTime    int64 // ms
+	Latency      int64 // ms
+	InputTokens  int64
+	OutputTokens int64
+}
+
+// BuildSession 将会话内的span按trace分组，每条trace为一轮，轮次按开始时间升序排列
+func BuildSession(ctx context.Context, sessionID string, spans SpanList) (*Session, error) {
+	groups := make(map[string]SpanList)
+	for _, span := range spans {
+		if span == nil {
+			continue
+		}
+		groups[span.TraceID] = append(groups[span.TraceID], span)
+	}
+	session := &Session{
+		SessionID: sessionID,
+		Turns:     make([]*SessionTurn, 0, len(groups)),
+	}
+	for traceID, traceSpans := range groups {
+		turn, endTime, err := buildSessionTurn(ctx, traceID, traceSpans)
+		if err != nil {
+			return nil, err
+		}
+		session.Turns = append(session.Turns, turn)
+		session.InputTokens += turn.InputTokens
+		session.OutputTokens += turn.OutputTokens
+		session.Latency += turn.Latency
+		if session.StartTime == 0 || turn.StartTime < session.StartTime {
+			session.StartTime = turn.StartTime
+		}
+		session.EndTime = max(session.EndTime, endTime)
+	}
+	sort.SliceStable(session.Turns, func(i, j int) bool {
+		if session.Turns[i].StartTime != session.Turns[j].StartTime {
+			return session.Turns[i].StartTime < session.Turns[j].StartTime
+		}
+		return session.Turns[i].TraceID < session.Turns[j].TraceID
+	})
+	session.TurnCount = int64(len(session.Turns))
+	return session, nil
+}
+
+func buildSessionTurn(ctx context.Context, traceID string, spans SpanList) (*SessionTurn, int64, error) {
+	inputTokens, outputTokens, err := spans.Stat(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	turn := &SessionTurn{
+		TraceID:      traceID,
+		InputTokens:  inputTokens,
+		OutputTokens: outputTokens,
+	}
+	var startTime, endTime int64 // us
+	for _, span := range spans {
+		if startTime == 0 || span.StartTime < startTime {
+			startTime = span.StartTime
+		}
+		endTime = max(endTime, span.StartTime+span.DurationMicros)
+		if span.ParentID == "0" || span.ParentID == "" {
+			turn.RootSpan = span
+		}
+	}
+	if turn.RootSpan != nil {
+		turn.StartTime = turn.RootSpan.StartTime / 1000
+		turn.Latency = turn.RootSpan.DurationMicros / 1000
+	} else {
+		turn.StartTime = startTime / 1000
+		turn.Latency = (endTime - startTime) / 1000
+	}
+	return turn, endTime / 1000, nil
+}
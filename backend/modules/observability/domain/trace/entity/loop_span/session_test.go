@@ -0,0 +1,41 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loop_span
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSession(t *testing.T) {
+	spans := SpanList{
+		{TraceID: "t2", SpanID: "r2", ParentID: "0", StartTime: 5000000, DurationMicros: 2000000},
+		{TraceID: "t2", SpanID: "m2", ParentID: "r2", SpanType: SpanTypeModel, StartTime: 5100000, DurationMicros: 1000000,
+			TagsLong: map[string]int64{SpanFieldInputTokens: 30, SpanFieldOutputTokens: 3}},
+		{TraceID: "t1", SpanID: "r1", ParentID: "", StartTime: 1000000, DurationMicros: 1000000},
+		{TraceID: "t1", SpanID: "m1", ParentID: "r1", SpanType: SpanTypeModel, StartTime: 1100000, DurationMicros: 500000,
+			TagsLong: map[string]int64{SpanFieldInputTokens: 10, SpanFieldOutputTokens: 1}},
+		{TraceID: "t3", SpanID: "c3", ParentID: "missing", StartTime: 9000000, DurationMicros: 500000},
+		nil,
+	}
+	session, err := BuildSession(context.Background(), "s1", spans)
+	assert.NoError(t, err)
+	assert.Equal(t, "s1", session.SessionID)
+	assert.Equal(t, int64(3), session.TurnCount)
+	assert.Equal(t, int64(40), session.InputTokens)
+	assert.Equal(t, int64(4), session.OutputTokens)
+	assert.Equal(t, int64(3500), session.Latency)
+	assert.Equal(t, int64(1000), session.StartTime)
+	assert.Equal(t, int64(9500), session.EndTime)
+
+	assert.Equal(t, []string{"t1", "t2", "t3"}, []string{session.Turns[0].TraceID, session.Turns[1].TraceID, session.Turns[2].TraceID})
+	assert.Equal(t, "r1", session.Turns[0].RootSpan.SpanID)
+	assert.Equal(t, int64(1000), session.Turns[0].Latency)
+	assert.Equal(t, int64(10), session.Turns[0].InputTokens)
+	assert.Nil(t, session.Turns[2].RootSpan)
+	assert.Equal(t, int64(9000), session.Turns[2].StartTime)
+	assert.Equal(t, int64(500), session.Turns[2].Latency)
+}
@@ -38,8 +38,12 @@ const (
 	SpanFieldOutput                  = "output"
 	SpanFieldMethod                  = "method"
 	SpanFieldModelProvider           = "model_provider"
+	SpanFieldModelName               = "model_name"
+	SpanFieldModelCost               = "model_cost"
 	SpanFieldInputTokens             = "input_tokens"
 	SpanFieldOutputTokens            = "output_tokens"
+	SpanFieldInputCachedTokens       = "input_cached_tokens"
+	SpanFieldPIIRedacted             = "pii_redacted"
 	SpanFieldTokens                  = "tokens"
 	SpanFieldStatus                  = "status"
 	SpanFieldStatusCode              = "status_code"
@@ -53,6 +57,7 @@ const (
 	SpanFieldLogicDeleteDate         = "logic_delete_date"
 	SpanFieldMessageID               = "message_id"
 	SpanFieldUserID                  = "user_id"
+	SpanFieldThreadID                = "thread_id" // 会话ID
 	SpanFieldPromptKey               = "prompt_key"
 	SpanFieldTenant                  = "tenant"
 	SpanFieldKeyPreviousResponseID   = "previous_response_id"
@@ -412,6 +417,8 @@ func (s *Span) GetFieldValue(fieldName string, isSystem, isCustom bool) any {
 			prefix = AnnotationOpenAPIFeedbackFieldPrefix
 		case AnnotationTypeManualFeedback:
 			prefix = AnnotationManualFeedbackFieldPrefix
+		case AnnotationTypeAutoTag:
+			prefix = AnnotationAutoTagFieldPrefix
 		default:
 			continue
 		}
@@ -588,6 +595,36 @@ func (s *Span) AddAutoEvalAnnotation(taskID, evaluatorRecordID, evaluatorVersion
 	return a, nil
 }
 
+// AddAutoTagAnnotation 写入自动打标结果, 同一span同一tagKey只保留最新的标签
+func (s *Span) AddAutoTagAnnotation(taskID, evaluatorRecordID, evaluatorVersionID int64, tagKey, label, reasoning, userID string) (*Annotation, error) {
+	a := &Annotation{}
+	a.SpanID = s.SpanID
+	a.TraceID = s.TraceID
+	a.StartTime = time.UnixMicro(s.StartTime)
+	a.WorkspaceID = s.WorkspaceID
+	a.AnnotationType = AnnotationTypeAutoTag
+	a.Key = tagKey
+	a.Value = NewStringValue(label)
+	a.Reasoning = reasoning
+	a.Metadata = &AutoTagMetadata{
+		TaskID:             taskID,
+		EvaluatorRecordID:  evaluatorRecordID,
+		EvaluatorVersionID: evaluatorVersionID,
+	}
+	a.Status = AnnotationStatusNormal
+	a.CreatedAt = time.Now()
+	a.CreatedBy = userID
+	a.UpdatedAt = time.Now()
+	a.UpdatedBy = userID
+
+	if err := a.GenID(); err != nil {
+		return nil, err
+	}
+
+	s.AddAnnotation(a)
+	return a, nil
+}
+
 // ExtractByJsonpath 从Span的Input/Output/Tags中提取数据，根据jsonpath返回结果。时间戳按毫秒返回。
 func (s *Span) ExtractByJsonpath(ctx context.Context, key string, jsonpath string) (string, error) {
 	jsonpath = strings.TrimPrefix(jsonpath, key)
@@ -840,6 +877,25 @@ func TTLFromInteger(i int64) TTL {
 	}
 }
 
+var ttlDays = map[TTL]int64{
+	TTL3d:   3,
+	TTL7d:   7,
+	TTL30d:  30,
+	TTL90d:  90,
+	TTL180d: 180,
+	TTL365d: 365,
+}
+
+// Days 返回保留天数，非法的TTL返回0
+func (t TTL) Days() int64 {
+	return ttlDays[t]
+}
+
+func (t TTL) IsValid() bool {
+	_, ok := ttlDays[t]
+	return ok
+}
+
 var SystemTagKeys = map[string]bool{
 	"dc":           true,
 	"pod_name":     true,
@@ -0,0 +1,344 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loop_span
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+type DiffType string
+
+const (
+	DiffTypeSame    DiffType = "same"
+	DiffTypeChanged DiffType = "changed"
+	DiffTypeAdded   DiffType = "added"   // 仅在对比trace中存在
+	DiffTypeRemoved DiffType = "removed" // 仅在基准trace中存在
+)
+
+type TextDiffOp string
+
+const (
+	TextDiffOpEqual  TextDiffOp = "equal"
+	TextDiffOpInsert TextDiffOp = "insert"
+	TextDiffOpDelete TextDiffOp = "delete"
+)
+
+const (
+	DiffFieldInput      = "input"
+	DiffFieldOutput     = "output"
+	DiffFieldStatusCode = "status_code"
+
+	// 超过该行数时不做逐行对比，直接整体替换，避免O(n*m)的开销
+	maxTextDiffLines = 2000
+	// 兄弟span超过该数量时不做LCS对齐，改为线性的贪心对齐，避免O(n*m)的开销
+	maxAlignSiblings = 500
+)
+
+var toolSpanTypes = map[string]bool{
+	"tool":           true,
+	SpanTypeFunction: true,
+	SpanTypePlugin:   true,
+}
+
+type TextDiffLine struct {
+	Op   TextDiffOp
+	Text string
+}
+
+// SpanDiffNode 对齐后的span节点，Base与Compare分别为基准trace和对比trace中的span，新增或删除的节点只有一侧
+type SpanDiffNode struct {
+	Base          *Span
+	Compare       *Span
+	DiffType      DiffType
+	ChangedFields []string
+	InputDiff     []*TextDiffLine
+	OutputDiff    []*TextDiffLine
+	Children      []*SpanDiffNode
+}
+
+func (n *SpanDiffNode) Span() *Span {
+	if n.Compare != nil {
+		return n.Compare
+	}
+	return n.Base
+}
+
+// LatencyDelta 对比减基准的耗时，ms，只有两侧都存在时有效
+func (n *SpanDiffNode) LatencyDelta() (int64, bool) {
+	if n.Base == nil || n.Compare == nil {
+		return 0, false
+	}
+	return (n.Compare.DurationMicros - n.Base.DurationMicros) / 1000, true
+}
+
+func (n *SpanDiffNode) TokensDelta() (input, output int64, ok bool) {
+	if n.Base == nil || n.Compare == nil {
+		return 0, 0, false
+	}
+	return n.Compare.TagsLong[SpanFieldInputTokens] - n.Base.TagsLong[SpanFieldInputTokens],
+		n.Compare.TagsLong[SpanFieldOutputTokens] - n.Base.TagsLong[SpanFieldOutputTokens], true
+}
+
+type TraceDiffSummary struct {
+	AddedSpans        int32
+	RemovedSpans      int32
+	ChangedSpans      int32
+	ChangedToolCalls  int32
+	LatencyDelta      int64 // ms
+	InputTokensDelta  int64
+	OutputTokensDelta int64
+}
+
+type TraceDiff struct {
+	Nodes   []*SpanDiffNode
+	Summary *TraceDiffSummary
+}
+
+// DiffTraces 对齐两棵span树并计算差异，同一父节点下的子节点按开始时间排序后，以span类型和名称做最长公共子序列对齐
+func DiffTraces(ctx context.Context, base, compare SpanList) *TraceDiff {
+	baseTree, compareTree := newSpanTree(base), newSpanTree(compare)
+	ret := &TraceDiff{
+		Nodes:   alignSpans(baseTree, compareTree, baseTree.roots, compareTree.roots),
+		Summary: &TraceDiffSummary{},
+	}
+	for _, node := range ret.Nodes {
+		ret.Summary.add(node)
+	}
+	inBase, outBase, _ := base.Stat(ctx)
+	inCompare, outCompare, _ := compare.Stat(ctx)
+	ret.Summary.InputTokensDelta = inCompare - inBase
+	ret.Summary.OutputTokensDelta = outCompare - outBase
+	if len(baseTree.roots) > 0 && len(compareTree.roots) > 0 {
+		ret.Summary.LatencyDelta = (compareTree.roots[0].DurationMicros - baseTree.roots[0].DurationMicros) / 1000
+	}
+	return ret
+}
+
+func (s *TraceDiffSummary) add(node *SpanDiffNode) {
+	isTool := toolSpanTypes[node.Span().SpanType]
+	switch node.DiffType {
+	case DiffTypeAdded:
+		s.AddedSpans++
+	case DiffTypeRemoved:
+		s.RemovedSpans++
+	case DiffTypeChanged:
+		s.ChangedSpans++
+	}
+	if isTool && node.DiffType != DiffTypeSame {
+		s.ChangedToolCalls++
+	}
+	for _, child := range node.Children {
+		s.add(child)
+	}
+}
+
+type spanTree struct {
+	roots    []*Span
+	children map[string][]*Span
+}
+
+func newSpanTree(spans SpanList) *spanTree {
+	ids := make(map[string]bool, len(spans))
+	for _, span := range spans {
+		if span != nil {
+			ids[span.SpanID] = true
+		}
+	}
+	tree := &spanTree{children: make(map[string][]*Span)}
+	for _, span := range spans {
+		if span == nil {
+			continue
+		}
+		if span.ParentID == "" || span.ParentID == "0" || !ids[span.ParentID] {
+			tree.roots = append(tree.roots, span)
+		} else {
+			tree.children[span.ParentID] = append(tree.children[span.ParentID], span)
+		}
+	}
+	sortSpans(tree.roots)
+	for _, children := range tree.children {
+		sortSpans(children)
+	}
+	return tree
+}
+
+func sortSpans(spans []*Span) {
+	sort.SliceStable(spans, func(i, j int) bool {
+		if spans[i].StartTime != spans[j].StartTime {
+			return spans[i].StartTime < spans[j].StartTime
+		}
+		return spans[i].SpanID < spans[j].SpanID
+	})
+}
+
+func spanAlignKey(span *Span) string {
+	return span.SpanType + "\x00" + span.SpanName
+}
+
+func alignSpans(baseTree, compareTree *spanTree, bases, compares []*Span) []*SpanDiffNode {
+	n, m := len(bases), len(compares)
+	if n > maxAlignSiblings || m > maxAlignSiblings {
+		return alignSpansGreedy(baseTree, compareTree, bases, compares)
+	}
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if spanAlignKey(bases[i]) == spanAlignKey(compares[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	ret := make([]*SpanDiffNode, 0, max(n, m))
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && spanAlignKey(bases[i]) == spanAlignKey(compares[j]):
+			ret = append(ret, diffSpan(baseTree, compareTree, bases[i], compares[j]))
+			i++
+			j++
+		case i < n && (j == m || lcs[i+1][j] >= lcs[i][j+1]):
+			ret = append(ret, singleSideNode(baseTree, bases[i], DiffTypeRemoved))
+			i++
+		default:
+			ret = append(ret, singleSideNode(compareTree, compares[j], DiffTypeAdded))
+			j++
+		}
+	}
+	return ret
+}
+
+// alignSpansGreedy 按顺序为每个基准span匹配对比侧尚未匹配的第一个同类span，跳过的对比span视为新增
+func alignSpansGreedy(baseTree, compareTree *spanTree, bases, compares []*Span) []*SpanDiffNode {
+	positions := make(map[string][]int)
+	for idx, span := range compares {
+		key := spanAlignKey(span)
+		positions[key] = append(positions[key], idx)
+	}
+	ret := make([]*SpanDiffNode, 0, max(len(bases), len(compares)))
+	j := 0
+	for _, base := range bases {
+		key := spanAlignKey(base)
+		queue := positions[key]
+		for len(queue) > 0 && queue[0] < j {
+			queue = queue[1:]
+		}
+		if len(queue) == 0 {
+			positions[key] = queue
+			ret = append(ret, singleSideNode(baseTree, base, DiffTypeRemoved))
+			continue
+		}
+		positions[key] = queue[1:]
+		for ; j < queue[0]; j++ {
+			ret = append(ret, singleSideNode(compareTree, compares[j], DiffTypeAdded))
+		}
+		ret = append(ret, diffSpan(baseTree, compareTree, base, compares[j]))
+		j++
+	}
+	for ; j < len(compares); j++ {
+		ret = append(ret, singleSideNode(compareTree, compares[j], DiffTypeAdded))
+	}
+	return ret
+}
+
+func diffSpan(baseTree, compareTree *spanTree, base, compare *Span) *SpanDiffNode {
+	node := &SpanDiffNode{
+		Base:     base,
+		Compare:  compare,
+		DiffType: DiffTypeSame,
+		Children: alignSpans(baseTree, compareTree, baseTree.children[base.SpanID], compareTree.children[compare.SpanID]),
+	}
+	if base.Input != compare.Input {
+		node.ChangedFields = append(node.ChangedFields, DiffFieldInput)
+		node.InputDiff = DiffText(base.Input, compare.Input)
+	}
+	if base.Output != compare.Output {
+		node.ChangedFields = append(node.ChangedFields, DiffFieldOutput)
+		node.OutputDiff = DiffText(base.Output, compare.Output)
+	}
+	if base.StatusCode != compare.StatusCode {
+		node.ChangedFields = append(node.ChangedFields, DiffFieldStatusCode)
+	}
+	if len(node.ChangedFields) > 0 {
+		node.DiffType = DiffTypeChanged
+	}
+	return node
+}
+
+func singleSideNode(tree *spanTree, span *Span, diffType DiffType) *SpanDiffNode {
+	node := &SpanDiffNode{DiffType: diffType}
+	if diffType == DiffTypeAdded {
+		node.Compare = span
+	} else {
+		node.Base = span
+	}
+	for _, child := range tree.children[span.SpanID] {
+		node.Children = append(node.Children, singleSideNode(tree, child, diffType))
+	}
+	return node
+}
+
+// DiffText 逐行对比文本，合法的JSON会先格式化为多行再对比
+func DiffText(base, compare string) []*TextDiffLine {
+	a, b := splitDiffLines(base), splitDiffLines(compare)
+	if len(a) > maxTextDiffLines || len(b) > maxTextDiffLines {
+		return []*TextDiffLine{
+			{Op: TextDiffOpDelete, Text: base},
+			{Op: TextDiffOpInsert, Text: compare},
+		}
+	}
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	ret := make([]*TextDiffLine, 0, max(n, m))
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && a[i] == b[j]:
+			ret = append(ret, &TextDiffLine{Op: TextDiffOpEqual, Text: a[i]})
+			i++
+			j++
+		case i < n && (j == m || lcs[i+1][j] >= lcs[i][j+1]):
+			ret = append(ret, &TextDiffLine{Op: TextDiffOpDelete, Text: a[i]})
+			i++
+		default:
+			ret = append(ret, &TextDiffLine{Op: TextDiffOpInsert, Text: b[j]})
+			j++
+		}
+	}
+	return ret
+}
+
+func splitDiffLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	trimmed := strings.TrimSpace(text)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, []byte(trimmed), "", "  "); err == nil {
+			text = buf.String()
+		}
+	}
+	return strings.Split(text, "\n")
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loop_span
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffTraces(t *testing.T) {
+	base := SpanList{
+		{SpanID: "r", SpanName: "agent", SpanType: SpanTypeAgent, StartTime: 1, DurationMicros: 3000000, Input: "hi", Output: "answer"},
+		{SpanID: "m", ParentID: "r", SpanName: "chat", SpanType: SpanTypeModel, StartTime: 2, DurationMicros: 1000000,
+			TagsLong: map[string]int64{SpanFieldInputTokens: 100, SpanFieldOutputTokens: 10}},
+		{SpanID: "t1", ParentID: "r", SpanName: "search", SpanType: "tool", StartTime: 3, Input: `{"q":"a"}`},
+		{SpanID: "t2", ParentID: "r", SpanName: "calc", SpanType: "tool", StartTime: 4},
+	}
+	compare := SpanList{
+		{SpanID: "R", SpanName: "agent", SpanType: SpanTypeAgent, StartTime: 1, DurationMicros: 5000000, Input: "hi", Output: "wrong"},
+		{SpanID: "M", ParentID: "R", SpanName: "chat", SpanType: SpanTypeModel, StartTime: 2, DurationMicros: 1500000,
+			TagsLong: map[string]int64{SpanFieldInputTokens: 150, SpanFieldOutputTokens: 30}},
+		{SpanID: "T1", ParentID: "R", SpanName: "search", SpanType: "tool", StartTime: 3, Input: `{"q":"b"}`},
+		{SpanID: "T3", ParentID: "R", SpanName: "browse", SpanType: "tool", StartTime: 4},
+	}
+
+	diff := DiffTraces(context.Background(), base, compare)
+	assert.Len(t, diff.Nodes, 1)
+	root := diff.Nodes[0]
+	assert.Equal(t, DiffTypeChanged, root.DiffType)
+	assert.Equal(t, []string{DiffFieldOutput}, root.ChangedFields)
+	assert.Equal(t, []*TextDiffLine{
+		{Op: TextDiffOpDelete, Text: "answer"},
+		{Op: TextDiffOpInsert, Text: "wrong"},
+	}, root.OutputDiff)
+
+	assert.Len(t, root.Children, 4)
+	model := root.Children[0]
+	assert.Equal(t, DiffTypeSame, model.DiffType)
+	latency, ok := model.LatencyDelta()
+	assert.True(t, ok)
+	assert.Equal(t, int64(500), latency)
+	in, out, ok := model.TokensDelta()
+	assert.True(t, ok)
+	assert.Equal(t, int64(50), in)
+	assert.Equal(t, int64(20), out)
+
+	search := root.Children[1]
+	assert.Equal(t, DiffTypeChanged, search.DiffType)
+	assert.Equal(t, "T1", search.Compare.SpanID)
+	assert.Contains(t, search.InputDiff, &TextDiffLine{Op: TextDiffOpDelete, Text: `  "q": "a"`})
+	assert.Contains(t, search.InputDiff, &TextDiffLine{Op: TextDiffOpInsert, Text: `  "q": "b"`})
+
+	assert.Equal(t, DiffTypeRemoved, root.Children[2].DiffType)
+	assert.Equal(t, "t2", root.Children[2].Base.SpanID)
+	assert.Equal(t, DiffTypeAdded, root.Children[3].DiffType)
+	assert.Equal(t, "T3", root.Children[3].Compare.SpanID)
+	_, ok = root.Children[3].LatencyDelta()
+	assert.False(t, ok)
+
+	assert.Equal(t, &TraceDiffSummary{
+		AddedSpans:        1,
+		RemovedSpans:      1,
+		ChangedSpans:      2,
+		ChangedToolCalls:  3,
+		LatencyDelta:      2000,
+		InputTokensDelta:  50,
+		OutputTokensDelta: 20,
+	}, diff.Summary)
+}
+
+func TestDiffText(t *testing.T) {
+	assert.Equal(t, []*TextDiffLine{
+		{Op: TextDiffOpEqual, Text: "a"},
+		{Op: TextDiffOpDelete, Text: "b"},
+		{Op: TextDiffOpInsert, Text: "c"},
+		{Op: TextDiffOpEqual, Text: "d"},
+	}, DiffText("a\nb\nd", "a\nc\nd"))
+	assert.Equal(t, []*TextDiffLine{{Op: TextDiffOpInsert, Text: "x"}}, DiffText("", "x"))
+}
+
+func TestDiffTraces_ManySiblings(t *testing.T) {
+	base := SpanList{{SpanID: "r", SpanName: "agent", SpanType: SpanTypeAgent}}
+	compare := SpanList{{SpanID: "R", SpanName: "agent", SpanType: SpanTypeAgent}}
+	for i := 0; i < maxAlignSiblings+100; i++ {
+		id := strconv.Itoa(i)
+		base = append(base, &Span{SpanID: "b" + id, ParentID: "r", SpanName: "step" + id, SpanType: "tool", StartTime: int64(i + 1)})
+		if i == 10 {
+			compare = append(compare, &Span{SpanID: "a-extra", ParentID: "R", SpanName: "browse", SpanType: "tool", StartTime: int64(i + 1)})
+		}
+		if i != 20 {
+			compare = append(compare, &Span{SpanID: "c" + id, ParentID: "R", SpanName: "step" + id, SpanType: "tool", StartTime: int64(i + 1)})
+		}
+	}
+
+	diff := DiffTraces(context.Background(), base, compare)
+	assert.Len(t, diff.Nodes, 1)
+	root := diff.Nodes[0]
+	assert.Len(t, root.Children, maxAlignSiblings+101)
+	assert.Equal(t, DiffTypeAdded, root.Children[10].DiffType)
+	assert.Equal(t, "a-extra", root.Children[10].Compare.SpanID)
+	assert.Equal(t, DiffTypeRemoved, root.Children[21].DiffType)
+	assert.Equal(t, "b20", root.Children[21].Base.SpanID)
+	assert.Equal(t, int32(1), diff.Summary.AddedSpans)
+	assert.Equal(t, int32(1), diff.Summary.RemovedSpans)
+	assert.Equal(t, int32(0), diff.Summary.ChangedSpans)
+}
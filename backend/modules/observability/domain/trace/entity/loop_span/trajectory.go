@@ -92,6 +92,8 @@ type ModelInfo struct {
 	ReasoningTokens           int64  `json:"reasoning_tokens"`
 	InputReadCachedTokens     int64  `json:"input_read_cached_tokens"`
 	InputCreationCachedTokens int64  `json:"input_creation_cached_tokens"`
+	// 按模型价格表计算的费用，未命中价格表时为空
+	Cost *float64 `json:"cost,omitempty"`
 }
 
 type BasicInfo struct {
@@ -126,6 +128,8 @@ type MetricsInfo struct {
 	InputTokens *int32 `json:"input_tokens,omitempty"`
 	// 输出token数
 	OutputTokens *int32 `json:"output_tokens,omitempty"`
+	// 模型调用总费用
+	TotalCost *float64 `json:"total_cost,omitempty"`
 }
 
 func BuildTrajectoryFromSpans(spanList SpanList) *Trajectory {
@@ -384,6 +388,9 @@ func buildModelInfo(span *Span) *ModelInfo {
 	if inputCreationCachedTokens, ok := span.TagsLong["input_creation_cached_tokens"]; ok {
 		modelInfo.InputCreationCachedTokens = inputCreationCachedTokens
 	}
+	if cost, ok := span.TagsDouble[SpanFieldModelCost]; ok {
+		modelInfo.Cost = ptr.Of(cost)
+	}
 
 	return modelInfo
 }
@@ -466,6 +473,7 @@ func calculateMetricsInfo(modelSteps, toolSteps []*Step) *MetricsInfo {
 	var modelErrorCount int64
 	var inputTokens int64
 	var outputTokens int64
+	var totalCost *float64
 	for _, modelStep := range modelSteps {
 		if modelStep.BasicInfo != nil && modelStep.BasicInfo.Duration != "" {
 			if duration, err := strconv.ParseInt(modelStep.BasicInfo.Duration, 10, 64); err == nil {
@@ -491,6 +499,9 @@ func calculateMetricsInfo(modelSteps, toolSteps []*Step) *MetricsInfo {
 			if modelStep.ModelInfo.OutputTokens > 0 {
 				outputTokens += modelStep.ModelInfo.OutputTokens
 			}
+			if modelStep.ModelInfo.Cost != nil {
+				totalCost = ptr.Of(ptr.From(totalCost) + *modelStep.ModelInfo.Cost)
+			}
 		}
 	}
 
@@ -554,6 +565,7 @@ func calculateMetricsInfo(modelSteps, toolSteps []*Step) *MetricsInfo {
 	if outputTokens > 0 {
 		metricsInfo.OutputTokens = ptr.Of(int32(outputTokens))
 	}
+	metricsInfo.TotalCost = totalCost
 
 	return metricsInfo
 }
@@ -334,6 +334,20 @@ func TestBuildModelInfo(t *testing.T) {
 	assert.Equal(t, int64(2), mi.InputCreationCachedTokens)
 }
 
+func TestCalculateMetricsInfo_TotalCost(t *testing.T) {
+	t.Parallel()
+	withCost := buildStep(&Span{SpanID: "m1", SpanType: "model", TagsDouble: map[string]float64{SpanFieldModelCost: 0.5}})
+	withoutCost := buildStep(&Span{SpanID: "m2", SpanType: "model"})
+	mi := calculateMetricsInfo([]*Step{withCost, withoutCost}, nil)
+	if assert.NotNil(t, mi) && assert.NotNil(t, mi.TotalCost) {
+		assert.Equal(t, 0.5, *mi.TotalCost)
+	}
+	mi = calculateMetricsInfo([]*Step{withoutCost}, nil)
+	if assert.NotNil(t, mi) {
+		assert.Nil(t, mi.TotalCost)
+	}
+}
+
 func TestCalculateMetricsInfo_Empty(t *testing.T) {
 	t.Parallel()
 	mi := calculateMetricsInfo(nil, nil)
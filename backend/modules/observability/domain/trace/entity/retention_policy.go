@@ -0,0 +1,103 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+// RetentionPolicy 空间级别的trace数据保留策略，在写入时决定span的保留时长
+type RetentionPolicy struct {
+	ID          int64
+	WorkspaceID int64
+	// DefaultTTL 未命中规则的span的保留时长，为空时按空间权益保留
+	DefaultTTL loop_span.TTL
+	// Rules 按span类型指定保留时长，按顺序匹配
+	Rules []*RetentionRule
+	// KeepAnnotated 有标注的span在过期前转存到长期存储，转存后保留365天
+	KeepAnnotated bool
+	// KeepDatasetReferenced 被导入数据集的span在过期前转存到长期存储，转存后保留365天
+	KeepDatasetReferenced bool
+	// ArchivePermanently 转存的span永久保留，转存的span每次过期前重新转存
+	ArchivePermanently bool
+	// ArchiveWatermark 转存任务已处理到的过期时间(us)，之前过期的span都已处理
+	ArchiveWatermark int64
+	CreatedAt        time.Time
+	CreatedBy        string
+	UpdatedAt        time.Time
+	UpdatedBy        string
+}
+
+type RetentionRule struct {
+	SpanTypes []string      `json:"span_types"`
+	TTL       loop_span.TTL `json:"ttl"`
+}
+
+func (p *RetentionPolicy) Validate() error {
+	if p.DefaultTTL != "" && !p.DefaultTTL.IsValid() {
+		return fmt.Errorf("invalid default ttl %s", p.DefaultTTL)
+	}
+	if p.ArchivePermanently && !p.NeedArchive() {
+		return fmt.Errorf("archive permanently requires keep annotated or keep dataset referenced")
+	}
+	for i, rule := range p.Rules {
+		if rule == nil || len(rule.SpanTypes) == 0 {
+			return fmt.Errorf("span types of rule %d is empty", i)
+		}
+		if !rule.TTL.IsValid() {
+			return fmt.Errorf("invalid ttl %s of rule %d", rule.TTL, i)
+		}
+	}
+	return nil
+}
+
+// GetSpanTTL 返回span按策略应保留的时长，不会超过空间权益允许的时长maxTTL
+func (p *RetentionPolicy) GetSpanTTL(span *loop_span.Span, maxTTL loop_span.TTL) loop_span.TTL {
+	if p == nil {
+		return maxTTL
+	}
+	ttl := p.DefaultTTL
+	for _, rule := range p.Rules {
+		if rule != nil && slices.Contains(rule.SpanTypes, span.SpanType) {
+			ttl = rule.TTL
+			break
+		}
+	}
+	if !ttl.IsValid() || ttl.Days() > maxTTL.Days() {
+		return maxTTL
+	}
+	return ttl
+}
+
+// NeedArchive 是否需要在span过期前转存
+func (p *RetentionPolicy) NeedArchive() bool {
+	return p != nil && (p.KeepAnnotated || p.KeepDatasetReferenced)
+}
+
+// ShouldArchive 判断span是否需要转存到长期存储，span需要带上标注信息
+func (p *RetentionPolicy) ShouldArchive(span *loop_span.Span) bool {
+	if !p.NeedArchive() {
+		return false
+	}
+	for _, anno := range span.Annotations {
+		if anno == nil || anno.IsDeleted || anno.Status == loop_span.AnnotationStatusDeleted {
+			continue
+		}
+		switch anno.AnnotationType {
+		case loop_span.AnnotationTypeManualDataset, loop_span.AnnotationTypeManualEvaluationSet:
+			if p.KeepDatasetReferenced || p.KeepAnnotated {
+				return true
+			}
+		default:
+			if p.KeepAnnotated {
+				return true
+			}
+		}
+	}
+	return false
+}
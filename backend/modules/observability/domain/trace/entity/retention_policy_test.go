@@ -0,0 +1,85 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+func TestRetentionPolicy_Validate(t *testing.T) {
+	assert.NoError(t, (&RetentionPolicy{}).Validate())
+	assert.NoError(t, (&RetentionPolicy{
+		DefaultTTL: loop_span.TTL7d,
+		Rules:      []*RetentionRule{{SpanTypes: []string{"model"}, TTL: loop_span.TTL90d}},
+	}).Validate())
+	assert.Error(t, (&RetentionPolicy{DefaultTTL: "8d"}).Validate())
+	assert.Error(t, (&RetentionPolicy{Rules: []*RetentionRule{{TTL: loop_span.TTL90d}}}).Validate())
+	assert.Error(t, (&RetentionPolicy{Rules: []*RetentionRule{{SpanTypes: []string{"model"}}}}).Validate())
+	assert.Error(t, (&RetentionPolicy{ArchivePermanently: true}).Validate())
+	assert.NoError(t, (&RetentionPolicy{KeepAnnotated: true, ArchivePermanently: true}).Validate())
+}
+
+func TestRetentionPolicy_GetSpanTTL(t *testing.T) {
+	policy := &RetentionPolicy{
+		DefaultTTL: loop_span.TTL7d,
+		Rules: []*RetentionRule{
+			{SpanTypes: []string{"model"}, TTL: loop_span.TTL90d},
+			{SpanTypes: []string{"model", "prompt"}, TTL: loop_span.TTL30d},
+		},
+	}
+	tests := []struct {
+		name     string
+		policy   *RetentionPolicy
+		spanType string
+		maxTTL   loop_span.TTL
+		want     loop_span.TTL
+	}{
+		{name: "first matched rule", policy: policy, spanType: "model", maxTTL: loop_span.TTL365d, want: loop_span.TTL90d},
+		{name: "second matched rule", policy: policy, spanType: "prompt", maxTTL: loop_span.TTL365d, want: loop_span.TTL30d},
+		{name: "default ttl", policy: policy, spanType: "tool", maxTTL: loop_span.TTL365d, want: loop_span.TTL7d},
+		{name: "capped by max ttl", policy: policy, spanType: "model", maxTTL: loop_span.TTL30d, want: loop_span.TTL30d},
+		{name: "no default ttl", policy: &RetentionPolicy{}, spanType: "tool", maxTTL: loop_span.TTL180d, want: loop_span.TTL180d},
+		{name: "nil policy", spanType: "tool", maxTTL: loop_span.TTL180d, want: loop_span.TTL180d},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.GetSpanTTL(&loop_span.Span{SpanType: tt.spanType}, tt.maxTTL))
+		})
+	}
+}
+
+func TestRetentionPolicy_ShouldArchive(t *testing.T) {
+	feedbackSpan := &loop_span.Span{Annotations: loop_span.AnnotationList{
+		{AnnotationType: loop_span.AnnotationTypeManualFeedback, Status: loop_span.AnnotationStatusNormal},
+	}}
+	datasetSpan := &loop_span.Span{Annotations: loop_span.AnnotationList{
+		{AnnotationType: loop_span.AnnotationTypeManualDataset, Status: loop_span.AnnotationStatusNormal},
+	}}
+	deletedSpan := &loop_span.Span{Annotations: loop_span.AnnotationList{
+		{AnnotationType: loop_span.AnnotationTypeManualFeedback, IsDeleted: true},
+	}}
+	tests := []struct {
+		name   string
+		policy *RetentionPolicy
+		span   *loop_span.Span
+		want   bool
+	}{
+		{name: "keep annotated", policy: &RetentionPolicy{KeepAnnotated: true}, span: feedbackSpan, want: true},
+		{name: "keep annotated includes dataset", policy: &RetentionPolicy{KeepAnnotated: true}, span: datasetSpan, want: true},
+		{name: "keep dataset referenced only", policy: &RetentionPolicy{KeepDatasetReferenced: true}, span: feedbackSpan, want: false},
+		{name: "keep dataset referenced", policy: &RetentionPolicy{KeepDatasetReferenced: true}, span: datasetSpan, want: true},
+		{name: "deleted annotation", policy: &RetentionPolicy{KeepAnnotated: true}, span: deletedSpan, want: false},
+		{name: "no annotation", policy: &RetentionPolicy{KeepAnnotated: true}, span: &loop_span.Span{}, want: false},
+		{name: "archive disabled", policy: &RetentionPolicy{}, span: feedbackSpan, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.ShouldArchive(tt.span))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+type ExportFileFormat string
+
+const (
+	// ExportFileFormatJSONL 每行一个span
+	ExportFileFormatJSONL ExportFileFormat = "jsonl"
+	// ExportFileFormatOTLPJSON 每行一个OTLP ExportTraceServiceRequest, 与OpenTelemetry file exporter格式一致
+	ExportFileFormatOTLPJSON ExportFileFormat = "otlp_json"
+)
+
+func (f ExportFileFormat) IsValid() bool {
+	return f == ExportFileFormatJSONL || f == ExportFileFormatOTLPJSON
+}
+
+type ExportJobStatus string
+
+const (
+	ExportJobStatusRunning ExportJobStatus = "running"
+	ExportJobStatusSuccess ExportJobStatus = "success"
+	ExportJobStatusFailed  ExportJobStatus = "failed"
+)
+
+// TraceExportJob 将符合条件的span导出到对象存储的异步任务
+type TraceExportJob struct {
+	ID           int64
+	WorkspaceID  int64
+	PlatformType loop_span.PlatformType
+	SpanListType loop_span.SpanListType
+	Format       ExportFileFormat
+	StartTime    int64 // ms
+	EndTime      int64 // ms
+	Filters      *loop_span.FilterFields
+	Status       ExportJobStatus
+	// ExportedCount 已写入文件的span数, 任务运行中持续更新
+	ExportedCount int64
+	// Truncated 符合条件的span超过单任务导出上限, 只导出了前面的部分
+	Truncated bool
+	// FileKey 导出文件在对象存储中的key, 导出成功后才有值
+	FileKey  string
+	ErrorMsg string
+	// DownloadURL 查询任务时按FileKey签发, 不落库
+	DownloadURL string
+	CreatedAt   time.Time
+	CreatedBy   string
+	UpdatedAt   time.Time
+	UpdatedBy   string
+}
+
+func (j *TraceExportJob) IsFinished() bool {
+	return j.Status == ExportJobStatusSuccess || j.Status == ExportJobStatusFailed
+}
+
+// BuildFileKey 返回导出文件在对象存储中的key
+func (j *TraceExportJob) BuildFileKey() string {
+	suffix := "spans.jsonl"
+	if j.Format == ExportFileFormatOTLPJSON {
+		suffix = "otlp.jsonl"
+	}
+	return fmt.Sprintf("observability/trace_export/%d/%d_%s", j.WorkspaceID, j.ID, suffix)
+}
@@ -74,6 +74,21 @@ func (mr *MockITraceRepoMockRecorder) GetPreSpanIDs(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreSpanIDs", reflect.TypeOf((*MockITraceRepo)(nil).GetPreSpanIDs), arg0, arg1)
 }
 
+// GetRetentionPolicy mocks base method.
+func (m *MockITraceRepo) GetRetentionPolicy(arg0 context.Context, arg1 repo.GetRetentionPolicyParam) (*entity.RetentionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRetentionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*entity.RetentionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRetentionPolicy indicates an expected call of GetRetentionPolicy.
+func (mr *MockITraceRepoMockRecorder) GetRetentionPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetentionPolicy", reflect.TypeOf((*MockITraceRepo)(nil).GetRetentionPolicy), arg0, arg1)
+}
+
 // GetTrace mocks base method.
 func (m *MockITraceRepo) GetTrace(arg0 context.Context, arg1 *repo.GetTraceParam) (loop_span.SpanList, error) {
 	m.ctrl.T.Helper()
@@ -147,6 +162,36 @@ func (mr *MockITraceRepoMockRecorder) ListAnnotations(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnotations", reflect.TypeOf((*MockITraceRepo)(nil).ListAnnotations), arg0, arg1)
 }
 
+// ListArchiveRetentionPolicies mocks base method.
+func (m *MockITraceRepo) ListArchiveRetentionPolicies(arg0 context.Context, arg1 repo.ListArchiveRetentionPoliciesParam) ([]*entity.RetentionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArchiveRetentionPolicies", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.RetentionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArchiveRetentionPolicies indicates an expected call of ListArchiveRetentionPolicies.
+func (mr *MockITraceRepoMockRecorder) ListArchiveRetentionPolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArchiveRetentionPolicies", reflect.TypeOf((*MockITraceRepo)(nil).ListArchiveRetentionPolicies), arg0, arg1)
+}
+
+// ListSessions mocks base method.
+func (m *MockITraceRepo) ListSessions(arg0 context.Context, arg1 *repo.ListSessionsParam) (*repo.ListSessionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].(*repo.ListSessionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockITraceRepoMockRecorder) ListSessions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockITraceRepo)(nil).ListSessions), arg0, arg1)
+}
+
 // ListSpans mocks base method.
 func (m *MockITraceRepo) ListSpans(arg0 context.Context, arg1 *repo.ListSpansParam) (*repo.ListSpansResult, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +222,34 @@ func (mr *MockITraceRepoMockRecorder) ListSpansRepeat(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSpansRepeat", reflect.TypeOf((*MockITraceRepo)(nil).ListSpansRepeat), arg0, arg1)
 }
 
+// UpdateRetentionArchiveWatermark mocks base method.
+func (m *MockITraceRepo) UpdateRetentionArchiveWatermark(arg0 context.Context, arg1 repo.UpdateRetentionArchiveWatermarkParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRetentionArchiveWatermark", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRetentionArchiveWatermark indicates an expected call of UpdateRetentionArchiveWatermark.
+func (mr *MockITraceRepoMockRecorder) UpdateRetentionArchiveWatermark(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRetentionArchiveWatermark", reflect.TypeOf((*MockITraceRepo)(nil).UpdateRetentionArchiveWatermark), arg0, arg1)
+}
+
+// UpsertRetentionPolicy mocks base method.
+func (m *MockITraceRepo) UpsertRetentionPolicy(arg0 context.Context, arg1 *repo.UpsertRetentionPolicyParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRetentionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRetentionPolicy indicates an expected call of UpsertRetentionPolicy.
+func (mr *MockITraceRepoMockRecorder) UpsertRetentionPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRetentionPolicy", reflect.TypeOf((*MockITraceRepo)(nil).UpsertRetentionPolicy), arg0, arg1)
+}
+
 // UpsertTrajectoryConfig mocks base method.
 func (m *MockITraceRepo) UpsertTrajectoryConfig(arg0 context.Context, arg1 *repo.UpsertTrajectoryConfigParam) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo (interfaces: ITraceExportJobRepo)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/trace_export_job.go -package=mocks . ITraceExportJobRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITraceExportJobRepo is a mock of ITraceExportJobRepo interface.
+type MockITraceExportJobRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockITraceExportJobRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockITraceExportJobRepoMockRecorder is the mock recorder for MockITraceExportJobRepo.
+type MockITraceExportJobRepoMockRecorder struct {
+	mock *MockITraceExportJobRepo
+}
+
+// NewMockITraceExportJobRepo creates a new mock instance.
+func NewMockITraceExportJobRepo(ctrl *gomock.Controller) *MockITraceExportJobRepo {
+	mock := &MockITraceExportJobRepo{ctrl: ctrl}
+	mock.recorder = &MockITraceExportJobRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITraceExportJobRepo) EXPECT() *MockITraceExportJobRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateTraceExportJob mocks base method.
+func (m *MockITraceExportJobRepo) CreateTraceExportJob(ctx context.Context, job *entity.TraceExportJob) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTraceExportJob", ctx, job)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTraceExportJob indicates an expected call of CreateTraceExportJob.
+func (mr *MockITraceExportJobRepoMockRecorder) CreateTraceExportJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTraceExportJob", reflect.TypeOf((*MockITraceExportJobRepo)(nil).CreateTraceExportJob), ctx, job)
+}
+
+// GetTraceExportJob mocks base method.
+func (m *MockITraceExportJobRepo) GetTraceExportJob(ctx context.Context, workspaceID, id int64) (*entity.TraceExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraceExportJob", ctx, workspaceID, id)
+	ret0, _ := ret[0].(*entity.TraceExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTraceExportJob indicates an expected call of GetTraceExportJob.
+func (mr *MockITraceExportJobRepoMockRecorder) GetTraceExportJob(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraceExportJob", reflect.TypeOf((*MockITraceExportJobRepo)(nil).GetTraceExportJob), ctx, workspaceID, id)
+}
+
+// UpdateTraceExportJob mocks base method.
+func (m *MockITraceExportJobRepo) UpdateTraceExportJob(ctx context.Context, job *entity.TraceExportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTraceExportJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTraceExportJob indicates an expected call of UpdateTraceExportJob.
+func (mr *MockITraceExportJobRepoMockRecorder) UpdateTraceExportJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTraceExportJob", reflect.TypeOf((*MockITraceExportJobRepo)(nil).UpdateTraceExportJob), ctx, job)
+}
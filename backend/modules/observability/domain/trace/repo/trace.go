@@ -45,6 +45,21 @@ type ListSpansResult struct {
 	HasMore   bool
 }
 
+type ListSessionsParam struct {
+	WorkSpaceID string
+	Tenants     []string
+	Filters     *loop_span.FilterFields
+	StartAt     int64 // ms
+	EndAt       int64 // ms
+	Limit       int32
+	Offset      int32
+}
+
+type ListSessionsResult struct {
+	Sessions []*loop_span.Session // 只有聚合统计, 不含各轮详情
+	HasMore  bool
+}
+
 type GetPreSpanIDsParam struct {
 	PreRespID string
 }
@@ -80,6 +95,7 @@ type InsertAnnotationParam struct {
 	TTL            loop_span.TTL
 	Span           *loop_span.Span
 	AnnotationType *loop_span.AnnotationType
+	SkipEvent      bool // 只写入存储，不发送标注事件
 }
 
 type UpsertTrajectoryConfigParam struct {
@@ -99,11 +115,32 @@ type ListTrajectoryParam struct {
 	StartAt     *int64 // ms
 }
 
+type UpsertRetentionPolicyParam struct {
+	Policy *entity.RetentionPolicy
+	UserID string
+}
+
+type GetRetentionPolicyParam struct {
+	WorkspaceID int64
+	UseCache    bool // 写入链路使用，允许读到短时间内的旧策略
+}
+
+type ListArchiveRetentionPoliciesParam struct {
+	CursorID int64
+	Limit    int
+}
+
+type UpdateRetentionArchiveWatermarkParam struct {
+	PolicyID  int64
+	Watermark int64 // us
+}
+
 //go:generate mockgen -destination=mocks/trace.go -package=mocks . ITraceRepo
 type ITraceRepo interface {
 	InsertSpans(context.Context, *InsertTraceParam) error
 	ListSpans(context.Context, *ListSpansParam) (*ListSpansResult, error)
 	ListSpansRepeat(context.Context, *ListSpansParam) (*ListSpansResult, error)
+	ListSessions(context.Context, *ListSessionsParam) (*ListSessionsResult, error)
 	GetPreSpanIDs(context.Context, *GetPreSpanIDsParam) (preSpanIDs, responseIDs []string, err error)
 	GetTrace(context.Context, *GetTraceParam) (loop_span.SpanList, error)
 	ListAnnotations(context.Context, *ListAnnotationsParam) (loop_span.AnnotationList, error)
@@ -111,4 +148,8 @@ type ITraceRepo interface {
 	InsertAnnotations(context.Context, *InsertAnnotationParam) error
 	UpsertTrajectoryConfig(context.Context, *UpsertTrajectoryConfigParam) error
 	GetTrajectoryConfig(context.Context, GetTrajectoryConfigParam) (*entity.TrajectoryConfig, error)
+	UpsertRetentionPolicy(context.Context, *UpsertRetentionPolicyParam) error
+	GetRetentionPolicy(context.Context, GetRetentionPolicyParam) (*entity.RetentionPolicy, error)
+	ListArchiveRetentionPolicies(context.Context, ListArchiveRetentionPoliciesParam) ([]*entity.RetentionPolicy, error)
+	UpdateRetentionArchiveWatermark(context.Context, UpdateRetentionArchiveWatermarkParam) error
 }
@@ -0,0 +1,19 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+)
+
+//go:generate mockgen -destination=mocks/trace_export_job.go -package=mocks . ITraceExportJobRepo
+type ITraceExportJobRepo interface {
+	CreateTraceExportJob(ctx context.Context, job *entity.TraceExportJob) (int64, error)
+	// UpdateTraceExportJob 更新任务的状态和进度
+	UpdateTraceExportJob(ctx context.Context, job *entity.TraceExportJob) error
+	// GetTraceExportJob 任务不存在时返回nil
+	GetTraceExportJob(ctx context.Context, workspaceID, id int64) (*entity.TraceExportJob, error)
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
 	service "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -56,6 +57,36 @@ func (mr *MockITraceExportServiceMockRecorder) ExportTracesToDataset(ctx, req an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTracesToDataset", reflect.TypeOf((*MockITraceExportService)(nil).ExportTracesToDataset), ctx, req)
 }
 
+// ExportTracesToFile mocks base method.
+func (m *MockITraceExportService) ExportTracesToFile(ctx context.Context, req *service.ExportTracesToFileReq) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTracesToFile", ctx, req)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportTracesToFile indicates an expected call of ExportTracesToFile.
+func (mr *MockITraceExportServiceMockRecorder) ExportTracesToFile(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTracesToFile", reflect.TypeOf((*MockITraceExportService)(nil).ExportTracesToFile), ctx, req)
+}
+
+// GetTraceExportJob mocks base method.
+func (m *MockITraceExportService) GetTraceExportJob(ctx context.Context, workspaceID, jobID int64) (*entity.TraceExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraceExportJob", ctx, workspaceID, jobID)
+	ret0, _ := ret[0].(*entity.TraceExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTraceExportJob indicates an expected call of GetTraceExportJob.
+func (mr *MockITraceExportServiceMockRecorder) GetTraceExportJob(ctx, workspaceID, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraceExportJob", reflect.TypeOf((*MockITraceExportService)(nil).GetTraceExportJob), ctx, workspaceID, jobID)
+}
+
 // PreviewExportTracesToDataset mocks base method.
 func (m *MockITraceExportService) PreviewExportTracesToDataset(ctx context.Context, req *service.ExportTracesToDatasetRequest) (*service.PreviewExportTracesToDatasetResponse, error) {
 	m.ctrl.T.Helper()
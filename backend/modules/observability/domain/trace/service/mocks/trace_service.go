@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -destination=modules/observability/domain/trace/service/mocks/trace_service.go -package=mocks github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service ITraceService
+//	mockgen -destination=mocks/trace_service.go -package=mocks . ITraceService
 //
 
 // Package mocks is a generated GoMock package.
@@ -23,6 +23,7 @@ import (
 type MockITraceService struct {
 	ctrl     *gomock.Controller
 	recorder *MockITraceServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockITraceServiceMockRecorder is the mock recorder for MockITraceService.
@@ -129,6 +130,36 @@ func (mr *MockITraceServiceMockRecorder) ExtractSpanInfo(ctx, req any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractSpanInfo", reflect.TypeOf((*MockITraceService)(nil).ExtractSpanInfo), ctx, req)
 }
 
+// GetRetentionPolicy mocks base method.
+func (m *MockITraceService) GetRetentionPolicy(ctx context.Context, req *service.GetRetentionPolicyReq) (*service.GetRetentionPolicyResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRetentionPolicy", ctx, req)
+	ret0, _ := ret[0].(*service.GetRetentionPolicyResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRetentionPolicy indicates an expected call of GetRetentionPolicy.
+func (mr *MockITraceServiceMockRecorder) GetRetentionPolicy(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetentionPolicy", reflect.TypeOf((*MockITraceService)(nil).GetRetentionPolicy), ctx, req)
+}
+
+// GetSession mocks base method.
+func (m *MockITraceService) GetSession(ctx context.Context, req *service.GetSessionReq) (*service.GetSessionResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, req)
+	ret0, _ := ret[0].(*service.GetSessionResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockITraceServiceMockRecorder) GetSession(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockITraceService)(nil).GetSession), ctx, req)
+}
+
 // GetTrace mocks base method.
 func (m *MockITraceService) GetTrace(ctx context.Context, req *service.GetTraceReq) (*service.GetTraceResp, error) {
 	m.ctrl.T.Helper()
@@ -175,18 +206,18 @@ func (mr *MockITraceServiceMockRecorder) GetTracesMetaInfo(ctx, req any) *gomock
 }
 
 // GetTrajectories mocks base method.
-func (m *MockITraceService) GetTrajectories(ctx context.Context, req int64, arg2 []string, arg3, arg4 int64, arg5 loop_span.PlatformType) (map[string]*loop_span.Trajectory, error) {
+func (m *MockITraceService) GetTrajectories(ctx context.Context, workspaceID int64, traceIDs []string, startTime, endTime int64, platformType loop_span.PlatformType) (map[string]*loop_span.Trajectory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrajectories", ctx, req, arg2, arg3, arg4, arg5)
+	ret := m.ctrl.Call(m, "GetTrajectories", ctx, workspaceID, traceIDs, startTime, endTime, platformType)
 	ret0, _ := ret[0].(map[string]*loop_span.Trajectory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTrajectories indicates an expected call of GetTrajectories.
-func (mr *MockITraceServiceMockRecorder) GetTrajectories(ctx, req, arg2, arg3, arg4, arg5 any) *gomock.Call {
+func (mr *MockITraceServiceMockRecorder) GetTrajectories(ctx, workspaceID, traceIDs, startTime, endTime, platformType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrajectories", reflect.TypeOf((*MockITraceService)(nil).GetTrajectories), ctx, req, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrajectories", reflect.TypeOf((*MockITraceService)(nil).GetTrajectories), ctx, workspaceID, traceIDs, startTime, endTime, platformType)
 }
 
 // GetTrajectoryConfig mocks base method.
@@ -293,6 +324,21 @@ func (mr *MockITraceServiceMockRecorder) ListPreSpanOApi(ctx, req any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPreSpanOApi", reflect.TypeOf((*MockITraceService)(nil).ListPreSpanOApi), ctx, req)
 }
 
+// ListSessions mocks base method.
+func (m *MockITraceService) ListSessions(ctx context.Context, req *service.ListSessionsReq) (*service.ListSessionsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, req)
+	ret0, _ := ret[0].(*service.ListSessionsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockITraceServiceMockRecorder) ListSessions(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockITraceService)(nil).ListSessions), ctx, req)
+}
+
 // ListSpans mocks base method.
 func (m *MockITraceService) ListSpans(ctx context.Context, req *service.ListSpansReq) (*service.ListSpansResp, error) {
 	m.ctrl.T.Helper()
@@ -368,17 +414,17 @@ func (mr *MockITraceServiceMockRecorder) SearchTraceOApi(ctx, req any) *gomock.C
 }
 
 // Send mocks base method.
-func (m *MockITraceService) Send(ctx context.Context, req *entity.AnnotationEvent) error {
+func (m *MockITraceService) Send(ctx context.Context, msg *entity.AnnotationEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", ctx, req)
+	ret := m.ctrl.Call(m, "Send", ctx, msg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockITraceServiceMockRecorder) Send(ctx, req any) *gomock.Call {
+func (mr *MockITraceServiceMockRecorder) Send(ctx, msg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockITraceService)(nil).Send), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockITraceService)(nil).Send), ctx, msg)
 }
 
 // UpdateManualAnnotation mocks base method.
@@ -395,6 +441,20 @@ func (mr *MockITraceServiceMockRecorder) UpdateManualAnnotation(ctx, req any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManualAnnotation", reflect.TypeOf((*MockITraceService)(nil).UpdateManualAnnotation), ctx, req)
 }
 
+// UpsertRetentionPolicy mocks base method.
+func (m *MockITraceService) UpsertRetentionPolicy(ctx context.Context, req *service.UpsertRetentionPolicyReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRetentionPolicy", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRetentionPolicy indicates an expected call of UpsertRetentionPolicy.
+func (mr *MockITraceServiceMockRecorder) UpsertRetentionPolicy(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRetentionPolicy", reflect.TypeOf((*MockITraceService)(nil).UpsertRetentionPolicy), ctx, req)
+}
+
 // UpsertTrajectoryConfig mocks base method.
 func (m *MockITraceService) UpsertTrajectoryConfig(ctx context.Context, req *service.UpsertTrajectoryConfigRequest) error {
 	m.ctrl.T.Helper()
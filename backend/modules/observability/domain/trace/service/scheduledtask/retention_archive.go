@@ -0,0 +1,204 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduledtask
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/infra/lock"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/scheduledtask"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+	"github.com/samber/lo"
+)
+
+const (
+	retentionArchiveLockKey = "observability:trace:retention_archive"
+	retentionArchiveLockTTL = 3 * time.Hour
+	retentionArchiveLead    = 48 * time.Hour // 提前转存即将过期的span，留出任务失败重试的时间
+	retentionArchiveTTL     = loop_span.TTL365d
+
+	policyPageSize = 100
+	spanPageSize   = 1000
+)
+
+// RetentionArchiveTask 在span过期前把有标注或被导入数据集的span转存到最长保留时长的存储中。
+// 转存的span默认保留365天，策略开启永久保留时转存的span每次过期前会被再次转存
+type RetentionArchiveTask struct {
+	*scheduledtask.BaseScheduledTask
+
+	locker      lock.ILocker
+	traceConfig config.ITraceConfig
+	traceRepo   repo.ITraceRepo
+}
+
+func NewRetentionArchiveTask(
+	locker lock.ILocker,
+	traceConfig config.ITraceConfig,
+	traceRepo repo.ITraceRepo,
+) scheduledtask.ScheduledTask {
+	t := &RetentionArchiveTask{
+		BaseScheduledTask: scheduledtask.NewBaseScheduledTask("RetentionArchiveTask", 6*time.Hour, false),
+		locker:            locker,
+		traceConfig:       traceConfig,
+		traceRepo:         traceRepo,
+	}
+	t.ScheduledTask = t
+	return t
+}
+
+func (t *RetentionArchiveTask) RunOnce(ctx context.Context) error {
+	if t.locker != nil {
+		locked, err := t.locker.Lock(ctx, retentionArchiveLockKey, retentionArchiveLockTTL)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			logs.CtxInfo(ctx, "retention archive lock held by others, skip execution")
+			return nil
+		}
+		defer func() { _, _ = t.locker.Unlock(retentionArchiveLockKey) }()
+	}
+	tenantCfg, err := t.traceConfig.GetTenantConfig(ctx)
+	if err != nil {
+		return err
+	}
+	until := time.Now().Add(retentionArchiveLead).UnixMicro()
+	var cursorID int64
+	for {
+		policies, err := t.traceRepo.ListArchiveRetentionPolicies(ctx, repo.ListArchiveRetentionPoliciesParam{
+			CursorID: cursorID,
+			Limit:    policyPageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, policy := range policies {
+			if err := t.archiveWorkspace(ctx, tenantCfg, policy, until); err != nil {
+				// 单个空间失败不更新水位，下次任务重新处理
+				logs.CtxError(ctx, "archive spans of workspace %d failed, %v", policy.WorkspaceID, err)
+			}
+		}
+		if len(policies) < policyPageSize {
+			return nil
+		}
+		cursorID = policies[len(policies)-1].ID
+	}
+}
+
+// archiveWorkspace 转存过期时间在[水位, until)之间且需要保留的span，完成后推进水位
+func (t *RetentionArchiveTask) archiveWorkspace(ctx context.Context, tenantCfg *config.TenantCfg, policy *entity.RetentionPolicy, until int64) error {
+	from := policy.ArchiveWatermark
+	if from <= 0 {
+		from = time.Now().UnixMicro()
+	}
+	if from >= until {
+		return nil
+	}
+	tenants := lo.Keys(tenantCfg.TenantTables)
+	now := time.Now()
+	startAt := now.AddDate(0, 0, -int(retentionArchiveTTL.Days())-1).UnixMilli()
+	if policy.ArchivePermanently {
+		// 再次转存的span开始时间早于最长保留时长，需要查询全部时间范围
+		startAt = 0
+	}
+	param := &repo.ListSpansParam{
+		WorkSpaceID: strconv.FormatInt(policy.WorkspaceID, 10),
+		Tenants:     tenants,
+		Filters: &loop_span.FilterFields{
+			QueryAndOr: ptr.Of(loop_span.QueryAndOrEnumAnd),
+			FilterFields: []*loop_span.FilterField{
+				{
+					FieldName: loop_span.SpanFieldSpaceId,
+					FieldType: loop_span.FieldTypeString,
+					Values:    []string{strconv.FormatInt(policy.WorkspaceID, 10)},
+					QueryType: ptr.Of(loop_span.QueryTypeEnumEq),
+				},
+				{
+					FieldName: loop_span.SpanFieldLogicDeleteDate,
+					FieldType: loop_span.FieldTypeLong,
+					Values:    []string{strconv.FormatInt(from, 10)},
+					QueryType: ptr.Of(loop_span.QueryTypeEnumGte),
+				},
+				{
+					FieldName: loop_span.SpanFieldLogicDeleteDate,
+					FieldType: loop_span.FieldTypeLong,
+					Values:    []string{strconv.FormatInt(until, 10)},
+					QueryType: ptr.Of(loop_span.QueryTypeEnumLt),
+				},
+			},
+		},
+		StartAt: startAt,
+		EndAt:   now.UnixMilli(),
+		Limit:   spanPageSize,
+	}
+	archived := 0
+	for {
+		result, err := t.traceRepo.ListSpans(ctx, param)
+		if err != nil {
+			return err
+		}
+		spans := lo.Filter(result.Spans, func(span *loop_span.Span, _ int) bool {
+			return policy.ShouldArchive(span) && (policy.ArchivePermanently || !isArchivedSpan(span))
+		})
+		if err := t.archiveSpans(ctx, tenantCfg, spans); err != nil {
+			return err
+		}
+		archived += len(spans)
+		if !result.HasMore || result.PageToken == "" {
+			break
+		}
+		param.PageToken = result.PageToken
+	}
+	logs.CtxInfo(ctx, "archive spans of workspace %d successfully, count %d", policy.WorkspaceID, archived)
+	return t.traceRepo.UpdateRetentionArchiveWatermark(ctx, repo.UpdateRetentionArchiveWatermarkParam{
+		PolicyID:  policy.ID,
+		Watermark: until,
+	})
+}
+
+// isArchivedSpan 转存写入的span过期时间晚于开始时间加最长保留时长，未开启永久保留时不再重复转存
+func isArchivedSpan(span *loop_span.Span) bool {
+	return span.LogicDeleteTime-span.StartTime > retentionArchiveTTL.Days()*24*time.Hour.Microseconds()
+}
+
+// archiveSpans 按租户重新写入span及其标注，查询时会按span_id去重
+func (t *RetentionArchiveTask) archiveSpans(ctx context.Context, tenantCfg *config.TenantCfg, spans loop_span.SpanList) error {
+	tenantSpans := lo.GroupBy(spans, func(span *loop_span.Span) string {
+		if tenant := span.GetTenant(); tenant != "" {
+			return tenant
+		}
+		return tenantCfg.DefaultIngestTenant
+	})
+	for tenant, spans := range tenantSpans {
+		if err := t.traceRepo.InsertSpans(ctx, &repo.InsertTraceParam{
+			Spans:  spans,
+			Tenant: tenant,
+			TTL:    retentionArchiveTTL,
+		}); err != nil {
+			return err
+		}
+		if !tenantCfg.TenantsSupportAnnotation[tenant] {
+			continue
+		}
+		for _, span := range spans {
+			if err := t.traceRepo.InsertAnnotations(ctx, &repo.InsertAnnotationParam{
+				WorkSpaceID: span.WorkspaceID,
+				Tenant:      tenant,
+				TTL:         retentionArchiveTTL,
+				Span:        span,
+				SkipEvent:   true,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduledtask
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	lockmocks "github.com/coze-dev/coze-loop/backend/infra/lock/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	confmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo/mocks"
+)
+
+func TestRetentionArchiveTask_RunOnce(t *testing.T) {
+	tenantCfg := &config.TenantCfg{
+		TenantTables: map[string]map[loop_span.TTL]config.TableCfg{
+			"spans": {loop_span.TTL365d: {SpanTable: "observability_spans", AnnoTable: "observability_annotations"}},
+		},
+		DefaultIngestTenant:      "spans",
+		TenantsSupportAnnotation: map[string]bool{"spans": true},
+	}
+	annotated := &loop_span.Span{
+		SpanID:      "1",
+		WorkspaceID: "1",
+		Annotations: loop_span.AnnotationList{
+			{AnnotationType: loop_span.AnnotationTypeManualFeedback, Status: loop_span.AnnotationStatusNormal},
+		},
+	}
+	plain := &loop_span.Span{SpanID: "2", WorkspaceID: "1"}
+
+	t.Run("archive annotated spans and advance watermark", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		locker := lockmocks.NewMockILocker(ctrl)
+		locker.EXPECT().Lock(gomock.Any(), retentionArchiveLockKey, gomock.Any()).Return(true, nil)
+		locker.EXPECT().Unlock(retentionArchiveLockKey).Return(true, nil)
+		confMock := confmocks.NewMockITraceConfig(ctrl)
+		confMock.EXPECT().GetTenantConfig(gomock.Any()).Return(tenantCfg, nil)
+		repoMock := repomocks.NewMockITraceRepo(ctrl)
+		repoMock.EXPECT().ListArchiveRetentionPolicies(gomock.Any(), gomock.Any()).Return([]*entity.RetentionPolicy{
+			{ID: 10, WorkspaceID: 1, KeepAnnotated: true},
+		}, nil)
+		repoMock.EXPECT().ListSpans(gomock.Any(), gomock.Any()).Return(&repo.ListSpansResult{
+			Spans:     loop_span.SpanList{annotated, plain},
+			PageToken: "token",
+			HasMore:   true,
+		}, nil)
+		repoMock.EXPECT().ListSpans(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param *repo.ListSpansParam) (*repo.ListSpansResult, error) {
+			assert.Equal(t, "token", param.PageToken)
+			return &repo.ListSpansResult{}, nil
+		})
+		repoMock.EXPECT().InsertSpans(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param *repo.InsertTraceParam) error {
+			assert.Equal(t, loop_span.SpanList{annotated}, param.Spans)
+			assert.Equal(t, "spans", param.Tenant)
+			assert.Equal(t, loop_span.TTL365d, param.TTL)
+			return nil
+		})
+		repoMock.EXPECT().InsertAnnotations(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param *repo.InsertAnnotationParam) error {
+			assert.True(t, param.SkipEvent)
+			return nil
+		})
+		repoMock.EXPECT().UpdateRetentionArchiveWatermark(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param repo.UpdateRetentionArchiveWatermarkParam) error {
+			assert.Equal(t, int64(10), param.PolicyID)
+			assert.Greater(t, param.Watermark, int64(0))
+			return nil
+		})
+		task := NewRetentionArchiveTask(locker, confMock, repoMock)
+		assert.NoError(t, task.RunOnce(context.Background()))
+	})
+
+	t.Run("re-archive archived spans only when archiving permanently", func(t *testing.T) {
+		now := time.Now()
+		archivedCopy := &loop_span.Span{
+			SpanID:          "3",
+			WorkspaceID:     "1",
+			StartTime:       now.AddDate(0, 0, -400).UnixMicro(),
+			LogicDeleteTime: now.Add(time.Hour).UnixMicro(),
+			Annotations:     annotated.Annotations,
+		}
+		for _, permanent := range []bool{false, true} {
+			ctrl := gomock.NewController(t)
+			confMock := confmocks.NewMockITraceConfig(ctrl)
+			confMock.EXPECT().GetTenantConfig(gomock.Any()).Return(tenantCfg, nil)
+			repoMock := repomocks.NewMockITraceRepo(ctrl)
+			repoMock.EXPECT().ListArchiveRetentionPolicies(gomock.Any(), gomock.Any()).Return([]*entity.RetentionPolicy{
+				{ID: 10, WorkspaceID: 1, KeepAnnotated: true, ArchivePermanently: permanent},
+			}, nil)
+			repoMock.EXPECT().ListSpans(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param *repo.ListSpansParam) (*repo.ListSpansResult, error) {
+				if permanent {
+					assert.Equal(t, int64(0), param.StartAt)
+				} else {
+					assert.Greater(t, param.StartAt, int64(0))
+				}
+				return &repo.ListSpansResult{Spans: loop_span.SpanList{annotated, archivedCopy}}, nil
+			})
+			want := loop_span.SpanList{annotated}
+			if permanent {
+				want = loop_span.SpanList{annotated, archivedCopy}
+			}
+			repoMock.EXPECT().InsertSpans(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param *repo.InsertTraceParam) error {
+				assert.Equal(t, want, param.Spans)
+				return nil
+			})
+			repoMock.EXPECT().InsertAnnotations(gomock.Any(), gomock.Any()).Return(nil).Times(len(want))
+			repoMock.EXPECT().UpdateRetentionArchiveWatermark(gomock.Any(), gomock.Any()).Return(nil)
+			task := NewRetentionArchiveTask(nil, confMock, repoMock)
+			assert.NoError(t, task.RunOnce(context.Background()))
+		}
+	})
+
+	t.Run("keep watermark when list spans failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		confMock := confmocks.NewMockITraceConfig(ctrl)
+		confMock.EXPECT().GetTenantConfig(gomock.Any()).Return(tenantCfg, nil)
+		repoMock := repomocks.NewMockITraceRepo(ctrl)
+		repoMock.EXPECT().ListArchiveRetentionPolicies(gomock.Any(), gomock.Any()).Return([]*entity.RetentionPolicy{
+			{ID: 10, WorkspaceID: 1, KeepAnnotated: true},
+		}, nil)
+		repoMock.EXPECT().ListSpans(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("ck error"))
+		task := NewRetentionArchiveTask(nil, confMock, repoMock)
+		assert.NoError(t, task.RunOnce(context.Background()))
+	})
+
+	t.Run("skip when lock held by others", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		locker := lockmocks.NewMockILocker(ctrl)
+		locker.EXPECT().Lock(gomock.Any(), retentionArchiveLockKey, gomock.Any()).Return(false, nil)
+		task := NewRetentionArchiveTask(locker, nil, nil)
+		assert.NoError(t, task.RunOnce(context.Background()))
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package span_processor
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+// CostProcessor 按模型价格表计算模型span的费用，写入model_cost标签
+type CostProcessor struct {
+	catalog *config.ModelPriceCatalog
+}
+
+func (c *CostProcessor) Transform(ctx context.Context, spans loop_span.SpanList) (loop_span.SpanList, error) {
+	if c.catalog == nil || len(c.catalog.Prices) == 0 {
+		return spans, nil
+	}
+	for _, span := range spans {
+		if span == nil || span.SpanType != loop_span.SpanTypeModel {
+			continue
+		}
+		// 上报方已经计算过费用时不覆盖
+		if _, ok := span.TagsDouble[loop_span.SpanFieldModelCost]; ok {
+			continue
+		}
+		inputTokens := span.TagsLong[loop_span.SpanFieldInputTokens]
+		outputTokens := span.TagsLong[loop_span.SpanFieldOutputTokens]
+		if inputTokens <= 0 && outputTokens <= 0 {
+			continue
+		}
+		price := c.catalog.GetPrice(span.TagsString[loop_span.SpanFieldModelProvider],
+			span.TagsString[loop_span.SpanFieldModelName], span.StartTime/1000)
+		if price == nil {
+			continue
+		}
+		if span.TagsDouble == nil {
+			span.TagsDouble = make(map[string]float64)
+		}
+		span.TagsDouble[loop_span.SpanFieldModelCost] = price.Cost(inputTokens, outputTokens,
+			span.TagsLong[loop_span.SpanFieldInputCachedTokens])
+	}
+	return spans, nil
+}
+
+type CostProcessorFactory struct {
+	traceConfig config.ITraceConfig
+}
+
+func (c *CostProcessorFactory) CreateProcessor(ctx context.Context, set Settings) (Processor, error) {
+	catalog, err := c.traceConfig.GetModelPriceCatalog(ctx)
+	if err != nil {
+		// 价格表不可用时不影响上报，只是不计算费用
+		logs.CtxWarn(ctx, "fail to get model price catalog, %v", err)
+	}
+	return &CostProcessor{
+		catalog: catalog,
+	}, nil
+}
+
+func NewCostProcessorFactory(traceConfig config.ITraceConfig) Factory {
+	return &CostProcessorFactory{
+		traceConfig: traceConfig,
+	}
+}
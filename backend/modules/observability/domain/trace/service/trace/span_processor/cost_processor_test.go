@@ -0,0 +1,72 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package span_processor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	confmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+)
+
+func TestCostProcessor_Transform(t *testing.T) {
+	catalog := &config.ModelPriceCatalog{
+		Prices: []*config.ModelPrice{
+			{Provider: "openai", Model: "gpt-4o", InputPrice: 2, OutputPrice: 8},
+		},
+	}
+	modelSpan := func(model string) *loop_span.Span {
+		return &loop_span.Span{
+			SpanType:  loop_span.SpanTypeModel,
+			StartTime: 1000,
+			TagsString: map[string]string{
+				loop_span.SpanFieldModelProvider: "openai",
+				loop_span.SpanFieldModelName:     model,
+			},
+			TagsLong: map[string]int64{
+				loop_span.SpanFieldInputTokens:  1000,
+				loop_span.SpanFieldOutputTokens: 100,
+			},
+		}
+	}
+
+	t.Run("stamp cost on model spans", func(t *testing.T) {
+		reported := modelSpan("gpt-4o")
+		reported.TagsDouble = map[string]float64{loop_span.SpanFieldModelCost: 1}
+		toolSpan := modelSpan("gpt-4o")
+		toolSpan.SpanType = loop_span.SpanTypeFunction
+		spans := loop_span.SpanList{modelSpan("gpt-4o"), modelSpan("unknown"), reported, toolSpan}
+
+		got, err := (&CostProcessor{catalog: catalog}).Transform(context.Background(), spans)
+		assert.NoError(t, err)
+		assert.InDelta(t, (1000*2+100*8)/1e6, got[0].TagsDouble[loop_span.SpanFieldModelCost], 1e-12)
+		assert.NotContains(t, got[1].TagsDouble, loop_span.SpanFieldModelCost)
+		assert.Equal(t, float64(1), got[2].TagsDouble[loop_span.SpanFieldModelCost])
+		assert.NotContains(t, got[3].TagsDouble, loop_span.SpanFieldModelCost)
+	})
+
+	t.Run("skip when catalog is empty", func(t *testing.T) {
+		spans := loop_span.SpanList{modelSpan("gpt-4o")}
+		got, err := (&CostProcessor{}).Transform(context.Background(), spans)
+		assert.NoError(t, err)
+		assert.Nil(t, got[0].TagsDouble)
+	})
+}
+
+func TestCostProcessorFactory_CreateProcessor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	confMock := confmocks.NewMockITraceConfig(ctrl)
+	confMock.EXPECT().GetModelPriceCatalog(gomock.Any()).Return(nil, fmt.Errorf("config error"))
+
+	p, err := NewCostProcessorFactory(confMock).CreateProcessor(context.Background(), Settings{})
+	assert.NoError(t, err)
+	assert.NotNil(t, p)
+}
@@ -0,0 +1,336 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package span_processor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ohler55/ojg/jp"
+	"github.com/ohler55/ojg/oj"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	piiFieldInput  = "Input"
+	piiFieldOutput = "Output"
+	piiFieldTags   = "Tags."
+)
+
+type piiDetector struct {
+	name     string
+	re       *regexp.Regexp
+	validate func(string) bool
+}
+
+var builtinPIIDetectors = map[string]*piiDetector{
+	config.PIIDetectorSecret: {
+		name: config.PIIDetectorSecret,
+		re: regexp.MustCompile(`\b(?:sk-[A-Za-z0-9_\-]{20,}|AKIA[0-9A-Z]{16}|gh[pousr]_[A-Za-z0-9]{36,}|xox[abprs]-[A-Za-z0-9\-]{10,}|AIza[0-9A-Za-z_\-]{35}|eyJ[A-Za-z0-9_\-]+\.eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+)` +
+			`|(?i:bearer)\s+[A-Za-z0-9\-._~+/]{16,}=*` +
+			`|-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+	},
+	config.PIIDetectorCreditCard: {
+		name:     config.PIIDetectorCreditCard,
+		re:       regexp.MustCompile(`\b(?:4\d{3}|5[1-5]\d{2}|2[2-7]\d{2}|3[47]\d{2}|6(?:011|5\d{2}))(?:[ \-]?\d){9,15}\b`),
+		validate: luhnValid,
+	},
+	config.PIIDetectorEmail: {
+		name: config.PIIDetectorEmail,
+		re:   regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`),
+	},
+	config.PIIDetectorPhone: {
+		name: config.PIIDetectorPhone,
+		re: regexp.MustCompile(`(?:\+?86[ \-]?)?\b1[3-9]\d{9}\b` +
+			`|(?:\+1[ .\-]?)?(?:\(\d{3}\) ?|\b\d{3}[ .\-])\d{3}[ .\-]\d{4}\b` +
+			`|\+[1-9]\d{7,14}\b`),
+	},
+}
+
+// 内置检测器按固定顺序执行，密钥和卡号先于手机号，避免长数字串被误识别为手机号
+var builtinPIIDetectorOrder = []string{
+	config.PIIDetectorSecret,
+	config.PIIDetectorCreditCard,
+	config.PIIDetectorEmail,
+	config.PIIDetectorPhone,
+}
+
+func luhnValid(s string) bool {
+	sum, n := 0, 0
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] < '0' || s[i] > '9' {
+			continue
+		}
+		d := int(s[i] - '0')
+		if n%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n >= 13 && n <= 19 && sum%10 == 0
+}
+
+type piiField struct {
+	key  string
+	expr jp.Expr
+}
+
+// piiRedactor 按一条脱敏规则编译出的检测器与目标字段
+type piiRedactor struct {
+	detectors []*piiDetector
+	fields    []*piiField
+	mask      string
+}
+
+func newPIIRedactor(ctx context.Context, rule *config.PIIRedactionRule) *piiRedactor {
+	r := &piiRedactor{mask: rule.Mask}
+	for _, name := range builtinPIIDetectorOrder {
+		for _, d := range rule.Detectors {
+			if d == name {
+				r.detectors = append(r.detectors, builtinPIIDetectors[name])
+				break
+			}
+		}
+	}
+	for _, p := range rule.CustomPatterns {
+		if p == nil || p.Regex == "" {
+			continue
+		}
+		re, err := regexp.Compile(p.Regex)
+		if err != nil {
+			logs.CtxWarn(ctx, "invalid pii custom pattern %s, %v", p.Name, err)
+			continue
+		}
+		name := p.Name
+		if name == "" {
+			name = "custom"
+		}
+		r.detectors = append(r.detectors, &piiDetector{name: name, re: re})
+	}
+	fields := rule.Fields
+	if len(fields) == 0 {
+		fields = []*config.PIIField{{Key: piiFieldInput}, {Key: piiFieldOutput}}
+	}
+	for _, f := range fields {
+		if f == nil || f.Key == "" {
+			continue
+		}
+		field := &piiField{key: f.Key}
+		if f.JSONPath != "" {
+			path := f.JSONPath
+			if !strings.HasPrefix(path, "$") {
+				path = "$." + path
+			}
+			expr, err := jp.ParseString(path)
+			if err != nil {
+				logs.CtxWarn(ctx, "invalid pii field json path %s, %v", f.JSONPath, err)
+				continue
+			}
+			field.expr = expr
+		}
+		r.fields = append(r.fields, field)
+	}
+	return r
+}
+
+func (r *piiRedactor) maskOf(name string) string {
+	if r.mask != "" {
+		return r.mask
+	}
+	return "[REDACTED:" + name + "]"
+}
+
+func (r *piiRedactor) redactText(text string, counts map[string]int) string {
+	for _, d := range r.detectors {
+		text = d.re.ReplaceAllStringFunc(text, func(m string) string {
+			if d.validate != nil && !d.validate(m) {
+				return m
+			}
+			counts[d.name]++
+			return r.maskOf(d.name)
+		})
+	}
+	return text
+}
+
+func (r *piiRedactor) redactValue(v any, counts map[string]int) (any, bool) {
+	switch val := v.(type) {
+	case string:
+		ret := r.redactText(val, counts)
+		return ret, ret != val
+	case map[string]any:
+		changed := false
+		for k, item := range val {
+			if ret, ok := r.redactValue(item, counts); ok {
+				val[k] = ret
+				changed = true
+			}
+		}
+		return val, changed
+	case []any:
+		changed := false
+		for i, item := range val {
+			if ret, ok := r.redactValue(item, counts); ok {
+				val[i] = ret
+				changed = true
+			}
+		}
+		return val, changed
+	default:
+		return v, false
+	}
+}
+
+// redactField 按JSONPath脱敏字段中的指定节点，字段不是合法JSON时整体脱敏
+func (r *piiRedactor) redactField(ctx context.Context, data string, expr jp.Expr, counts map[string]int) string {
+	if data == "" {
+		return data
+	}
+	if expr == nil {
+		return r.redactText(data, counts)
+	}
+	obj, err := oj.ParseString(data)
+	if err != nil {
+		return r.redactText(data, counts)
+	}
+	changed := false
+	obj, err = expr.Modify(obj, func(element any) (any, bool) {
+		ret, ok := r.redactValue(element, counts)
+		changed = changed || ok
+		return ret, ok
+	})
+	if err != nil || !changed {
+		return data
+	}
+	ret, err := json.MarshalString(obj)
+	if err != nil {
+		logs.CtxWarn(ctx, "fail to marshal redacted field, %v", err)
+		return r.redactText(data, counts)
+	}
+	return ret
+}
+
+func (r *piiRedactor) redactSpan(ctx context.Context, span *loop_span.Span) map[string]int {
+	counts := make(map[string]int)
+	for _, f := range r.fields {
+		switch {
+		case f.key == piiFieldInput:
+			span.Input = r.redactField(ctx, span.Input, f.expr, counts)
+		case f.key == piiFieldOutput:
+			span.Output = r.redactField(ctx, span.Output, f.expr, counts)
+		case strings.HasPrefix(f.key, piiFieldTags):
+			key := strings.TrimPrefix(f.key, piiFieldTags)
+			if val, ok := span.TagsString[key]; ok {
+				span.TagsString[key] = r.redactField(ctx, val, f.expr, counts)
+			}
+		}
+	}
+	return counts
+}
+
+// mergePIIAuditTag 将本次脱敏结果合并到审计标签，格式为detector:count,detector:count
+func mergePIIAuditTag(existing string, counts map[string]int) string {
+	merged := make(map[string]int, len(counts))
+	for _, item := range strings.Split(existing, ",") {
+		name, cnt, ok := strings.Cut(item, ":")
+		if !ok {
+			continue
+		}
+		n, _ := strconv.Atoi(cnt)
+		merged[name] += n
+	}
+	for name, n := range counts {
+		merged[name] += n
+	}
+	names := make([]string, 0, len(merged))
+	for name := range merged {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	items := make([]string, 0, len(names))
+	for _, name := range names {
+		items = append(items, fmt.Sprintf("%s:%d", name, merged[name]))
+	}
+	return strings.Join(items, ",")
+}
+
+// PIIRedactionProcessor 按空间的脱敏规则对span中的敏感信息打码，并写入pii_redacted审计标签
+type PIIRedactionProcessor struct {
+	cfg       *config.PIIRedactionConfig
+	atQuery   bool
+	redactors map[*config.PIIRedactionRule]*piiRedactor
+}
+
+func (p *PIIRedactionProcessor) Transform(ctx context.Context, spans loop_span.SpanList) (loop_span.SpanList, error) {
+	if p.cfg == nil {
+		return spans, nil
+	}
+	for _, span := range spans {
+		if span == nil {
+			continue
+		}
+		rule := p.cfg.GetRule(span.WorkspaceID)
+		if rule == nil || (p.atQuery && !rule.ApplyAtQuery) || (!p.atQuery && !rule.Enable) {
+			continue
+		}
+		redactor, ok := p.redactors[rule]
+		if !ok {
+			redactor = newPIIRedactor(ctx, rule)
+			p.redactors[rule] = redactor
+		}
+		counts := redactor.redactSpan(ctx, span)
+		if len(counts) == 0 {
+			continue
+		}
+		if span.TagsString == nil {
+			span.TagsString = make(map[string]string)
+		}
+		span.TagsString[loop_span.SpanFieldPIIRedacted] = mergePIIAuditTag(span.TagsString[loop_span.SpanFieldPIIRedacted], counts)
+	}
+	return spans, nil
+}
+
+type PIIRedactionProcessorFactory struct {
+	traceConfig config.ITraceConfig
+	atQuery     bool
+}
+
+func (p *PIIRedactionProcessorFactory) CreateProcessor(ctx context.Context, set Settings) (Processor, error) {
+	cfg, err := p.traceConfig.GetPIIRedactionConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &PIIRedactionProcessor{
+		cfg:       cfg,
+		atQuery:   p.atQuery,
+		redactors: make(map[*config.PIIRedactionRule]*piiRedactor),
+	}, nil
+}
+
+// NewPIIRedactionProcessorFactory 写入时脱敏
+func NewPIIRedactionProcessorFactory(traceConfig config.ITraceConfig) Factory {
+	return &PIIRedactionProcessorFactory{
+		traceConfig: traceConfig,
+	}
+}
+
+// NewQueryPIIRedactionProcessorFactory 查询时脱敏，仅对开启了apply_at_query的空间生效
+func NewQueryPIIRedactionProcessorFactory(traceConfig config.ITraceConfig) Factory {
+	return &PIIRedactionProcessorFactory{
+		traceConfig: traceConfig,
+		atQuery:     true,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/bytedance/gg/gptr"
+	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
 	"github.com/coze-dev/coze-loop/backend/infra/middleware/session"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/metrics"
@@ -86,6 +87,9 @@ type DatasetConfig struct {
 type ITraceExportService interface {
 	ExportTracesToDataset(ctx context.Context, req *ExportTracesToDatasetRequest) (*ExportTracesToDatasetResponse, error)
 	PreviewExportTracesToDataset(ctx context.Context, req *ExportTracesToDatasetRequest) (*PreviewExportTracesToDatasetResponse, error)
+	// ExportTracesToFile 创建异步导出任务, 返回任务id
+	ExportTracesToFile(ctx context.Context, req *ExportTracesToFileReq) (int64, error)
+	GetTraceExportJob(ctx context.Context, workspaceID, jobID int64) (*entity.TraceExportJob, error)
 }
 
 func NewTraceExportServiceImpl(
@@ -99,6 +103,8 @@ func NewTraceExportServiceImpl(
 	datasetServiceProvider *DatasetServiceAdaptor,
 	buildHelper TraceFilterProcessorBuilder,
 	traceService ITraceService,
+	exportJobRepo repo.ITraceExportJobRepo,
+	objectStorage fileserver.ObjectStorage,
 ) (ITraceExportService, error) {
 	return &TraceExportServiceImpl{
 		traceRepo:             tRepo,
@@ -110,6 +116,8 @@ func NewTraceExportServiceImpl(
 		DatasetServiceAdaptor: datasetServiceProvider,
 		buildHelper:           buildHelper,
 		traceService:          traceService,
+		exportJobRepo:         exportJobRepo,
+		objectStorage:         objectStorage,
 	}, nil
 }
 
@@ -123,6 +131,8 @@ type TraceExportServiceImpl struct {
 	DatasetServiceAdaptor *DatasetServiceAdaptor
 	buildHelper           TraceFilterProcessorBuilder
 	traceService          ITraceService
+	exportJobRepo         repo.ITraceExportJobRepo
+	objectStorage         fileserver.ObjectStorage
 }
 
 func (r *TraceExportServiceImpl) ExportTracesToDataset(ctx context.Context, req *ExportTracesToDatasetRequest) (
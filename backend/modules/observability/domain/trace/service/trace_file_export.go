@@ -0,0 +1,374 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"github.com/coze-dev/coze-loop/backend/infra/fileserver"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/lib/otel"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/goroutine"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	fileExportPageSize = 1000
+	// 单个导出任务最多导出的span数, 超过后只导出前面的部分
+	maxFileExportSpanCount = 100000
+	fileExportURLTTL       = 24 * time.Hour
+	maxExportErrorMsgLen   = 1024
+	// 运行中的任务每导出一页都会刷新更新时间, 超过该时长未更新视为执行实例已退出(如服务重启)
+	fileExportStaleTimeout = 30 * time.Minute
+	fileExportStaleMsg     = "export job interrupted, please retry"
+
+	otlpAttributeServiceName = "service.name"
+	otlpAttributeSpanType    = "cozeloop.span_type"
+	otlpAttributeInput       = "cozeloop.input"
+	otlpAttributeOutput      = "cozeloop.output"
+	otlpAttributeLogID       = "cozeloop.logid"
+	otlpAttributeStatusCode  = "cozeloop.status_code"
+	otlpScopeName            = "cozeloop"
+)
+
+type ExportTracesToFileReq struct {
+	WorkspaceID  int64
+	StartTime    int64 // ms
+	EndTime      int64 // ms
+	Filters      *loop_span.FilterFields
+	PlatformType loop_span.PlatformType
+	SpanListType loop_span.SpanListType
+	Format       entity.ExportFileFormat
+	UserID       string
+}
+
+func (r *TraceExportServiceImpl) ExportTracesToFile(ctx context.Context, req *ExportTracesToFileReq) (int64, error) {
+	if !req.Format.IsValid() {
+		return 0, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("invalid export format"))
+	}
+	job := &entity.TraceExportJob{
+		WorkspaceID:  req.WorkspaceID,
+		PlatformType: req.PlatformType,
+		SpanListType: req.SpanListType,
+		Format:       req.Format,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Filters:      req.Filters,
+		Status:       entity.ExportJobStatusRunning,
+		CreatedBy:    req.UserID,
+		UpdatedBy:    req.UserID,
+	}
+	id, err := r.exportJobRepo.CreateTraceExportJob(ctx, job)
+	if err != nil {
+		return 0, err
+	}
+	job.ID = id
+	// 导出耗时较长, 不受请求超时影响
+	asyncCtx := context.WithoutCancel(ctx)
+	goroutine.Go(asyncCtx, func() {
+		r.runFileExportJob(asyncCtx, job)
+	})
+	return id, nil
+}
+
+func (r *TraceExportServiceImpl) GetTraceExportJob(ctx context.Context, workspaceID, jobID int64) (*entity.TraceExportJob, error) {
+	job, err := r.exportJobRepo.GetTraceExportJob(ctx, workspaceID, jobID)
+	if err != nil {
+		return nil, err
+	} else if job == nil {
+		return nil, errorx.NewByCode(errno.CommonInvalidParamCode, errorx.WithExtraMsg("export job not found"))
+	}
+	if job.Status == entity.ExportJobStatusRunning && time.Since(job.UpdatedAt) > fileExportStaleTimeout {
+		logs.CtxWarn(ctx, "trace export job %d not updated since %v, mark it as failed", job.ID, job.UpdatedAt)
+		job.Status = entity.ExportJobStatusFailed
+		job.ErrorMsg = fileExportStaleMsg
+		if err := r.exportJobRepo.UpdateTraceExportJob(ctx, job); err != nil {
+			return nil, err
+		}
+	}
+	if job.Status == entity.ExportJobStatusSuccess && job.FileKey != "" {
+		url, _, err := r.objectStorage.SignDownloadReq(ctx, job.FileKey, fileserver.SignWithTTL(fileExportURLTTL))
+		if err != nil {
+			return nil, errorx.WrapByCode(err, errno.CommercialCommonRPCErrorCodeCode)
+		}
+		job.DownloadURL = url
+	}
+	return job, nil
+}
+
+func (r *TraceExportServiceImpl) runFileExportJob(ctx context.Context, job *entity.TraceExportJob) {
+	err := r.exportToFile(ctx, job)
+	if err != nil {
+		logs.CtxError(ctx, "trace export job %d failed, %v", job.ID, err)
+		job.Status = entity.ExportJobStatusFailed
+		job.ErrorMsg = lo.Substring(err.Error(), 0, maxExportErrorMsgLen)
+	} else {
+		logs.CtxInfo(ctx, "trace export job %d finished, %d spans exported", job.ID, job.ExportedCount)
+		job.Status = entity.ExportJobStatusSuccess
+	}
+	if err := r.exportJobRepo.UpdateTraceExportJob(ctx, job); err != nil {
+		logs.CtxError(ctx, "fail to update trace export job %d, %v", job.ID, err)
+	}
+}
+
+// exportToFile 分页拉取span写入本地临时文件, 全部写完后上传到对象存储
+func (r *TraceExportServiceImpl) exportToFile(ctx context.Context, job *entity.TraceExportJob) error {
+	file, err := os.CreateTemp("", "trace_export_*.jsonl")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+	writer := bufio.NewWriter(file)
+	pageToken := ""
+	for {
+		resp, err := r.traceService.ListSpans(ctx, &ListSpansReq{
+			WorkspaceID:  job.WorkspaceID,
+			StartTime:    job.StartTime,
+			EndTime:      job.EndTime,
+			Filters:      job.Filters,
+			Limit:        int32(min(fileExportPageSize, maxFileExportSpanCount-job.ExportedCount)),
+			PageToken:    pageToken,
+			PlatformType: job.PlatformType,
+			SpanListType: job.SpanListType,
+		})
+		if err != nil {
+			return err
+		}
+		if err := writeSpans(writer, job, resp.Spans); err != nil {
+			return err
+		}
+		job.ExportedCount += int64(len(resp.Spans))
+		if !resp.HasMore || resp.NextPageToken == "" {
+			break
+		}
+		if job.ExportedCount >= maxFileExportSpanCount {
+			job.Truncated = true
+			break
+		}
+		pageToken = resp.NextPageToken
+		// 进度更新失败不影响导出
+		if err := r.exportJobRepo.UpdateTraceExportJob(ctx, job); err != nil {
+			logs.CtxWarn(ctx, "fail to update progress of trace export job %d, %v", job.ID, err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	fileKey := job.BuildFileKey()
+	if err := r.objectStorage.Upload(ctx, fileKey, file, fileserver.UploadWithContentType("application/x-ndjson")); err != nil {
+		return err
+	}
+	job.FileKey = fileKey
+	return nil
+}
+
+func writeSpans(w io.Writer, job *entity.TraceExportJob, spans loop_span.SpanList) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	var lines [][]byte
+	switch job.Format {
+	case entity.ExportFileFormatOTLPJSON:
+		line, err := json.Marshal(spansToOTLPJson(job.WorkspaceID, spans))
+		if err != nil {
+			return err
+		}
+		lines = append(lines, line)
+	default:
+		for _, span := range spans {
+			line, err := json.Marshal(span)
+			if err != nil {
+				return err
+			}
+			lines = append(lines, line)
+		}
+	}
+	for _, line := range lines {
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// spansToOTLPJson 将一页span转换为一个OTLP ExportTraceServiceRequest, 按PSM划分resource
+func spansToOTLPJson(workspaceID int64, spans loop_span.SpanList) *otlpExportRequest {
+	req := &collectorpb.ExportTraceServiceRequest{}
+	resourceSpans := make(map[string]*tracepb.ResourceSpans)
+	for _, span := range spans {
+		rs, ok := resourceSpans[span.PSM]
+		if !ok {
+			attrs := []*commonpb.KeyValue{otlpStringAttribute(otel.OtelAttributeWorkSpaceID, strconv.FormatInt(workspaceID, 10))}
+			if span.PSM != "" {
+				attrs = append(attrs, otlpStringAttribute(otlpAttributeServiceName, span.PSM))
+			}
+			rs = &tracepb.ResourceSpans{
+				Resource:   &resourcepb.Resource{Attributes: attrs},
+				ScopeSpans: []*tracepb.ScopeSpans{{Scope: &commonpb.InstrumentationScope{Name: otlpScopeName}}},
+			}
+			resourceSpans[span.PSM] = rs
+			req.ResourceSpans = append(req.ResourceSpans, rs)
+		}
+		rs.ScopeSpans[0].Spans = append(rs.ScopeSpans[0].Spans, spanToOTLP(span))
+	}
+	return newOTLPExportRequest(otel.OtelTraceRequestPbToJson(req))
+}
+
+func spanToOTLP(span *loop_span.Span) *tracepb.Span {
+	ret := &tracepb.Span{
+		TraceId:           otlpID(span.TraceID),
+		SpanId:            otlpID(span.SpanID),
+		Name:              span.SpanName,
+		Kind:              tracepb.Span_SPAN_KIND_INTERNAL,
+		StartTimeUnixNano: uint64(span.StartTime) * uint64(time.Microsecond),
+		EndTimeUnixNano:   uint64(span.StartTime+span.DurationMicros) * uint64(time.Microsecond),
+		Status:            &tracepb.Status{Code: tracepb.Status_STATUS_CODE_OK},
+	}
+	if span.ParentID != "" && span.ParentID != "0" {
+		ret.ParentSpanId = otlpID(span.ParentID)
+	}
+	if span.StatusCode != 0 {
+		ret.Status.Code = tracepb.Status_STATUS_CODE_ERROR
+	}
+	ret.Attributes = append(ret.Attributes,
+		otlpStringAttribute(otlpAttributeSpanType, span.SpanType),
+		otlpStringAttribute(otlpAttributeInput, span.Input),
+		otlpStringAttribute(otlpAttributeOutput, span.Output),
+		otlpStringAttribute(otlpAttributeLogID, span.LogID),
+		&commonpb.KeyValue{Key: otlpAttributeStatusCode, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(span.StatusCode)}}},
+	)
+	for _, tags := range []map[string]string{span.SystemTagsString, span.TagsString} {
+		for _, k := range sortedKeys(tags) {
+			ret.Attributes = append(ret.Attributes, otlpStringAttribute(k, tags[k]))
+		}
+	}
+	for _, tags := range []map[string]int64{span.SystemTagsLong, span.TagsLong} {
+		for _, k := range sortedKeys(tags) {
+			ret.Attributes = append(ret.Attributes, &commonpb.KeyValue{Key: k, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: tags[k]}}})
+		}
+	}
+	for _, tags := range []map[string]float64{span.SystemTagsDouble, span.TagsDouble} {
+		for _, k := range sortedKeys(tags) {
+			ret.Attributes = append(ret.Attributes, &commonpb.KeyValue{Key: k, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: tags[k]}}})
+		}
+	}
+	for _, k := range sortedKeys(span.TagsBool) {
+		ret.Attributes = append(ret.Attributes, &commonpb.KeyValue{Key: k, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: span.TagsBool[k]}}})
+	}
+	return ret
+}
+
+// otlpID span/trace id通常为十六进制字符串, 无法解析时按原始字节导出
+func otlpID(id string) []byte {
+	if b, err := hex.DecodeString(id); err == nil {
+		return b
+	}
+	return []byte(id)
+}
+
+func otlpStringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
+
+// otel.AnyValue序列化时会多包一层Value且丢弃零值, 以下结构覆盖其中的attributes字段, 输出符合OTLP JSON规范的格式
+type otlpExportRequest struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans,omitempty"`
+}
+
+type otlpResourceSpans struct {
+	Resource   *otlpResource     `json:"resource,omitempty"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans,omitempty"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope *otel.InstrumentationScope `json:"scope,omitempty"`
+	Spans []*otlpSpan                `json:"spans,omitempty"`
+}
+
+type otlpSpan struct {
+	*otel.Span
+	Attributes []*otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string         `json:"key"`
+	Value map[string]any `json:"value"`
+}
+
+func newOTLPExportRequest(src *otel.ExportTraceServiceRequest) *otlpExportRequest {
+	ret := &otlpExportRequest{}
+	for _, rs := range src.ResourceSpans {
+		ors := &otlpResourceSpans{}
+		if rs.Resource != nil {
+			ors.Resource = &otlpResource{Attributes: newOTLPKeyValues(rs.Resource.Attributes)}
+		}
+		for _, ss := range rs.ScopeSpans {
+			oss := &otlpScopeSpans{Scope: ss.Scope}
+			for _, s := range ss.Spans {
+				oss.Spans = append(oss.Spans, &otlpSpan{Span: s, Attributes: newOTLPKeyValues(s.Attributes)})
+			}
+			ors.ScopeSpans = append(ors.ScopeSpans, oss)
+		}
+		ret.ResourceSpans = append(ret.ResourceSpans, ors)
+	}
+	return ret
+}
+
+func newOTLPKeyValues(kvs []*otel.KeyValue) []*otlpKeyValue {
+	ret := make([]*otlpKeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		if kv == nil || kv.Value == nil {
+			continue
+		}
+		// 导出时只会生成标量属性, int64按规范序列化为字符串
+		var value map[string]any
+		switch v := kv.Value.Value.(type) {
+		case *otel.AnyValue_StringValue:
+			value = map[string]any{"stringValue": v.StringValue}
+		case *otel.AnyValue_IntValue:
+			value = map[string]any{"intValue": strconv.FormatInt(v.IntValue, 10)}
+		case *otel.AnyValue_DoubleValue:
+			value = map[string]any{"doubleValue": v.DoubleValue}
+		case *otel.AnyValue_BoolValue:
+			value = map[string]any{"boolValue": v.BoolValue}
+		default:
+			continue
+		}
+		ret = append(ret, &otlpKeyValue{Key: kv.Key, Value: value})
+	}
+	return ret
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	fsmocks "github.com/coze-dev/coze-loop/backend/infra/fileserver/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+// fakeExportTraceService 按页返回span, pages为空时查询失败
+type fakeExportTraceService struct {
+	ITraceService
+	pages []loop_span.SpanList
+	reqs  []*ListSpansReq
+}
+
+func (f *fakeExportTraceService) ListSpans(ctx context.Context, req *ListSpansReq) (*ListSpansResp, error) {
+	f.reqs = append(f.reqs, req)
+	if len(f.pages) == 0 {
+		return nil, fmt.Errorf("query failed")
+	}
+	idx := len(f.reqs) - 1
+	resp := &ListSpansResp{Spans: f.pages[idx]}
+	if idx < len(f.pages)-1 {
+		resp.HasMore = true
+		resp.NextPageToken = fmt.Sprintf("token_%d", idx+1)
+	}
+	return resp, nil
+}
+
+func newExportTestSpans() []loop_span.SpanList {
+	return []loop_span.SpanList{
+		{
+			{
+				TraceID:        "0123456789abcdef0123456789abcdef",
+				SpanID:         "0123456789abcdef",
+				ParentID:       "0",
+				PSM:            "agent",
+				SpanName:       "root",
+				SpanType:       "agent",
+				StartTime:      1000,
+				DurationMicros: 500,
+				Input:          "hi",
+				TagsString:     map[string]string{"b": "2", "a": "1"},
+				TagsLong:       map[string]int64{"tokens": 0},
+			},
+		},
+		{
+			{
+				TraceID:        "0123456789abcdef0123456789abcdef",
+				SpanID:         "fedcba9876543210",
+				ParentID:       "0123456789abcdef",
+				PSM:            "agent",
+				SpanName:       "llm",
+				SpanType:       "model",
+				StatusCode:     1,
+				StartTime:      1200,
+				DurationMicros: 100,
+			},
+		},
+	}
+}
+
+func TestTraceExportServiceImpl_runFileExportJob(t *testing.T) {
+	t.Run("jsonl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		jobRepo := repomocks.NewMockITraceExportJobRepo(ctrl)
+		storage := fsmocks.NewMockObjectStorage(ctrl)
+		traceSvc := &fakeExportTraceService{pages: newExportTestSpans()}
+		r := &TraceExportServiceImpl{traceService: traceSvc, exportJobRepo: jobRepo, objectStorage: storage}
+		job := &entity.TraceExportJob{ID: 1, WorkspaceID: 2, Format: entity.ExportFileFormatJSONL, Status: entity.ExportJobStatusRunning}
+
+		var content string
+		storage.EXPECT().Upload(gomock.Any(), "observability/trace_export/2/1_spans.jsonl", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, key string, reader io.Reader, opts ...any) error {
+				b, err := io.ReadAll(reader)
+				content = string(b)
+				return err
+			})
+		// 一次进度更新, 一次完成
+		jobRepo.EXPECT().UpdateTraceExportJob(gomock.Any(), job).Return(nil).Times(2)
+
+		r.runFileExportJob(context.Background(), job)
+		assert.Equal(t, entity.ExportJobStatusSuccess, job.Status)
+		assert.Equal(t, int64(2), job.ExportedCount)
+		assert.Equal(t, "observability/trace_export/2/1_spans.jsonl", job.FileKey)
+		if assert.Len(t, traceSvc.reqs, 2) {
+			assert.Equal(t, "", traceSvc.reqs[0].PageToken)
+			assert.Equal(t, "token_1", traceSvc.reqs[1].PageToken)
+		}
+		lines := strings.Split(strings.TrimSpace(content), "\n")
+		if assert.Len(t, lines, 2) {
+			span := &loop_span.Span{}
+			assert.NoError(t, json.Unmarshal([]byte(lines[1]), span))
+			assert.Equal(t, "fedcba9876543210", span.SpanID)
+		}
+	})
+
+	t.Run("otlp json", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		jobRepo := repomocks.NewMockITraceExportJobRepo(ctrl)
+		storage := fsmocks.NewMockObjectStorage(ctrl)
+		r := &TraceExportServiceImpl{
+			traceService:  &fakeExportTraceService{pages: newExportTestSpans()[:1]},
+			exportJobRepo: jobRepo,
+			objectStorage: storage,
+		}
+		job := &entity.TraceExportJob{ID: 1, WorkspaceID: 2, Format: entity.ExportFileFormatOTLPJSON}
+
+		var content string
+		storage.EXPECT().Upload(gomock.Any(), "observability/trace_export/2/1_otlp.jsonl", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, key string, reader io.Reader, opts ...any) error {
+				b, err := io.ReadAll(reader)
+				content = string(b)
+				return err
+			})
+		jobRepo.EXPECT().UpdateTraceExportJob(gomock.Any(), job).Return(nil)
+
+		r.runFileExportJob(context.Background(), job)
+		assert.Equal(t, entity.ExportJobStatusSuccess, job.Status)
+
+		got := map[string]any{}
+		assert.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(content)), &got))
+		resourceSpans := got["resourceSpans"].([]any)[0].(map[string]any)
+		assert.Equal(t, []any{
+			map[string]any{"key": "cozeloop.workspace_id", "value": map[string]any{"stringValue": "2"}},
+			map[string]any{"key": "service.name", "value": map[string]any{"stringValue": "agent"}},
+		}, resourceSpans["resource"].(map[string]any)["attributes"])
+		span := resourceSpans["scopeSpans"].([]any)[0].(map[string]any)["spans"].([]any)[0].(map[string]any)
+		assert.Equal(t, "0123456789abcdef0123456789abcdef", span["traceId"])
+		assert.Equal(t, "0123456789abcdef", span["spanId"])
+		assert.Nil(t, span["parentSpanId"])
+		assert.Equal(t, "1000000", span["startTimeUnixNano"])
+		assert.Equal(t, "1500000", span["endTimeUnixNano"])
+		attrs := span["attributes"].([]any)
+		assert.Contains(t, attrs, map[string]any{"key": "cozeloop.input", "value": map[string]any{"stringValue": "hi"}})
+		assert.Contains(t, attrs, map[string]any{"key": "tokens", "value": map[string]any{"intValue": "0"}})
+		// 标签按key排序
+		assert.Equal(t, "a", attrs[5].(map[string]any)["key"])
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		jobRepo := repomocks.NewMockITraceExportJobRepo(ctrl)
+		storage := fsmocks.NewMockObjectStorage(ctrl)
+		span := newExportTestSpans()[0][0]
+		page := make(loop_span.SpanList, fileExportPageSize)
+		for i := range page {
+			page[i] = span
+		}
+		pages := make([]loop_span.SpanList, maxFileExportSpanCount/fileExportPageSize+1)
+		for i := range pages {
+			pages[i] = page
+		}
+		traceSvc := &fakeExportTraceService{pages: pages}
+		r := &TraceExportServiceImpl{traceService: traceSvc, exportJobRepo: jobRepo, objectStorage: storage}
+		job := &entity.TraceExportJob{ID: 1, WorkspaceID: 2, Format: entity.ExportFileFormatJSONL}
+		storage.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		jobRepo.EXPECT().UpdateTraceExportJob(gomock.Any(), job).Return(nil).AnyTimes()
+
+		r.runFileExportJob(context.Background(), job)
+		assert.Equal(t, entity.ExportJobStatusSuccess, job.Status)
+		assert.Equal(t, int64(maxFileExportSpanCount), job.ExportedCount)
+		assert.True(t, job.Truncated)
+		assert.Len(t, traceSvc.reqs, maxFileExportSpanCount/fileExportPageSize)
+	})
+
+	t.Run("query failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		jobRepo := repomocks.NewMockITraceExportJobRepo(ctrl)
+		r := &TraceExportServiceImpl{traceService: &fakeExportTraceService{}, exportJobRepo: jobRepo}
+		job := &entity.TraceExportJob{ID: 1, WorkspaceID: 2, Format: entity.ExportFileFormatJSONL}
+		jobRepo.EXPECT().UpdateTraceExportJob(gomock.Any(), job).Return(nil)
+
+		r.runFileExportJob(context.Background(), job)
+		assert.Equal(t, entity.ExportJobStatusFailed, job.Status)
+		assert.Equal(t, "query failed", job.ErrorMsg)
+		assert.Empty(t, job.FileKey)
+	})
+}
+
+func TestTraceExportServiceImpl_GetTraceExportJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	jobRepo := repomocks.NewMockITraceExportJobRepo(ctrl)
+	storage := fsmocks.NewMockObjectStorage(ctrl)
+	r := &TraceExportServiceImpl{exportJobRepo: jobRepo, objectStorage: storage}
+
+	jobRepo.EXPECT().GetTraceExportJob(gomock.Any(), int64(2), int64(1)).Return(&entity.TraceExportJob{
+		ID:      1,
+		Status:  entity.ExportJobStatusSuccess,
+		FileKey: "key",
+	}, nil)
+	storage.EXPECT().SignDownloadReq(gomock.Any(), "key", gomock.Any()).Return("https://download", nil, nil)
+	job, err := r.GetTraceExportJob(context.Background(), 2, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://download", job.DownloadURL)
+
+	jobRepo.EXPECT().GetTraceExportJob(gomock.Any(), int64(2), int64(3)).Return(&entity.TraceExportJob{
+		ID:        3,
+		Status:    entity.ExportJobStatusRunning,
+		UpdatedAt: time.Now(),
+	}, nil)
+	job, err = r.GetTraceExportJob(context.Background(), 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.ExportJobStatusRunning, job.Status)
+	assert.Empty(t, job.DownloadURL)
+
+	// 执行实例退出后任务不再更新, 查询时标记为失败
+	jobRepo.EXPECT().GetTraceExportJob(gomock.Any(), int64(2), int64(5)).Return(&entity.TraceExportJob{
+		ID:        5,
+		Status:    entity.ExportJobStatusRunning,
+		UpdatedAt: time.Now().Add(-fileExportStaleTimeout - time.Minute),
+	}, nil)
+	jobRepo.EXPECT().UpdateTraceExportJob(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, job *entity.TraceExportJob) error {
+		assert.Equal(t, entity.ExportJobStatusFailed, job.Status)
+		assert.Equal(t, fileExportStaleMsg, job.ErrorMsg)
+		return nil
+	})
+	job, err = r.GetTraceExportJob(context.Background(), 2, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.ExportJobStatusFailed, job.Status)
+
+	jobRepo.EXPECT().GetTraceExportJob(gomock.Any(), int64(2), int64(4)).Return(nil, nil)
+	_, err = r.GetTraceExportJob(context.Background(), 2, 4)
+	assert.Error(t, err)
+}
+
+func TestTraceExportServiceImpl_ExportTracesToFile(t *testing.T) {
+	r := &TraceExportServiceImpl{}
+	_, err := r.ExportTracesToFile(context.Background(), &ExportTracesToFileReq{WorkspaceID: 2, Format: "csv"})
+	assert.Error(t, err)
+}
@@ -370,6 +370,19 @@ func (t *GetTrajectoryConfigResponse) GetFiltersWithDefaultFilter() *loop_span.F
 	return resFilter
 }
 
+type UpsertRetentionPolicyReq struct {
+	Policy *entity.RetentionPolicy
+	UserID string
+}
+
+type GetRetentionPolicyReq struct {
+	WorkspaceID int64
+}
+
+type GetRetentionPolicyResp struct {
+	Policy *entity.RetentionPolicy
+}
+
 type ListTrajectoryRequest struct {
 	PlatformType loop_span.PlatformType
 	WorkspaceID  int64
@@ -413,6 +426,10 @@ type ITraceService interface {
 	GetTrajectories(ctx context.Context, workspaceID int64, traceIDs []string, startTime, endTime int64,
 		platformType loop_span.PlatformType) (map[string]*loop_span.Trajectory, error)
 	MergeHistoryMessagesByRespIDBatch(ctx context.Context, spans []*loop_span.Span, platformType loop_span.PlatformType) error
+	UpsertRetentionPolicy(ctx context.Context, req *UpsertRetentionPolicyReq) error
+	GetRetentionPolicy(ctx context.Context, req *GetRetentionPolicyReq) (*GetRetentionPolicyResp, error)
+	ListSessions(ctx context.Context, req *ListSessionsReq) (*ListSessionsResp, error)
+	GetSession(ctx context.Context, req *GetSessionReq) (*GetSessionResp, error)
 }
 
 func NewTraceServiceImpl(
@@ -1271,24 +1288,71 @@ func (r *TraceServiceImpl) IngestTraces(ctx context.Context, req *IngestTracesRe
 		}
 	}
 
-	traceData := &entity.TraceData{
-		Tenant: req.Tenant,
-		TenantInfo: entity.TenantInfo{
-			TTL:              req.TTL,
-			WorkspaceId:      req.Spans[0].WorkspaceID,
-			CozeAccountID:    req.CozeAccountId,
-			WhichIsEnough:    req.WhichIsEnough,
-			VolcanoAccountID: req.VolcanoAccountID,
-		},
-		SpanList: req.Spans,
-	}
-	if err := r.traceProducer.IngestSpans(ctx, traceData); err != nil {
-		return err
+	// 按空间的保留策略拆分span，不同保留时长的span分别写入
+	for ttl, spans := range r.groupSpansByTTL(ctx, req.Spans, req.TTL) {
+		traceData := &entity.TraceData{
+			Tenant: req.Tenant,
+			TenantInfo: entity.TenantInfo{
+				TTL:              ttl,
+				WorkspaceId:      req.Spans[0].WorkspaceID,
+				CozeAccountID:    req.CozeAccountId,
+				WhichIsEnough:    req.WhichIsEnough,
+				VolcanoAccountID: req.VolcanoAccountID,
+			},
+			SpanList: spans,
+		}
+		if err := r.traceProducer.IngestSpans(ctx, traceData); err != nil {
+			return err
+		}
+		logs.CtxInfo(ctx, "Send msg successfully, ttl %s, spans count %d", ttl, len(spans))
 	}
-	logs.CtxInfo(ctx, "Send msg successfully, spans count %d", len(req.Spans))
 	return nil
 }
 
+// groupSpansByTTL 同一批span属于同一空间，获取保留策略失败时全部按权益时长保留
+func (r *TraceServiceImpl) groupSpansByTTL(ctx context.Context, spans loop_span.SpanList, maxTTL loop_span.TTL) map[loop_span.TTL]loop_span.SpanList {
+	if len(spans) == 0 {
+		return nil
+	}
+	var policy *entity.RetentionPolicy
+	if workspaceID, err := strconv.ParseInt(spans[0].WorkspaceID, 10, 64); err == nil {
+		policy, err = r.traceRepo.GetRetentionPolicy(ctx, repo.GetRetentionPolicyParam{
+			WorkspaceID: workspaceID,
+			UseCache:    true,
+		})
+		if err != nil {
+			logs.CtxWarn(ctx, "get retention policy of workspace %d failed, %v", workspaceID, err)
+		}
+	}
+	if policy == nil {
+		return map[loop_span.TTL]loop_span.SpanList{maxTTL: spans}
+	}
+	ret := make(map[loop_span.TTL]loop_span.SpanList)
+	for _, span := range spans {
+		ttl := policy.GetSpanTTL(span, maxTTL)
+		ret[ttl] = append(ret[ttl], span)
+	}
+	return ret
+}
+
+func (r *TraceServiceImpl) GetRetentionPolicy(ctx context.Context, req *GetRetentionPolicyReq) (*GetRetentionPolicyResp, error) {
+	policy, err := r.traceRepo.GetRetentionPolicy(ctx, repo.GetRetentionPolicyParam{WorkspaceID: req.WorkspaceID})
+	if err != nil {
+		return nil, err
+	}
+	return &GetRetentionPolicyResp{Policy: policy}, nil
+}
+
+func (r *TraceServiceImpl) UpsertRetentionPolicy(ctx context.Context, req *UpsertRetentionPolicyReq) error {
+	if err := req.Policy.Validate(); err != nil {
+		return errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg(err.Error()))
+	}
+	return r.traceRepo.UpsertRetentionPolicy(ctx, &repo.UpsertRetentionPolicyParam{
+		Policy: req.Policy,
+		UserID: req.UserID,
+	})
+}
+
 func (r *TraceServiceImpl) GetTracesAdvanceInfo(ctx context.Context, req *GetTracesAdvanceInfoReq) (*GetTracesAdvanceInfoResp, error) {
 	var (
 		g                errgroup.Group
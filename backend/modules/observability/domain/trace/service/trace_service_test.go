@@ -288,7 +288,10 @@ func TestTraceServiceImpl_IngestTraces(t *testing.T) {
 				tenantProviderMock.EXPECT().GetTenantsByPlatformType(gomock.Any(), gomock.Any()).Return([]string{"spans"}, nil).AnyTimes()
 				filterFactoryMock := filtermocks.NewMockPlatformFilterFactory(ctrl)
 				buildHelper := NewTraceFilterProcessorBuilder(filterFactoryMock, nil, nil, nil, nil, nil, nil)
+				repoMock := repomocks.NewMockITraceRepo(ctrl)
+				repoMock.EXPECT().GetRetentionPolicy(gomock.Any(), gomock.Any()).Return(nil, nil)
 				return fields{
+					traceRepo:      repoMock,
 					traceProducer:  producerMock,
 					traceConfig:    confMock,
 					buildHelper:    buildHelper,
@@ -318,7 +321,10 @@ func TestTraceServiceImpl_IngestTraces(t *testing.T) {
 				tenantProviderMock.EXPECT().GetTenantsByPlatformType(gomock.Any(), gomock.Any()).Return([]string{"spans"}, nil).AnyTimes()
 				filterFactoryMock := filtermocks.NewMockPlatformFilterFactory(ctrl)
 				buildHelper := NewTraceFilterProcessorBuilder(filterFactoryMock, nil, nil, nil, nil, nil, nil)
+				repoMock := repomocks.NewMockITraceRepo(ctrl)
+				repoMock.EXPECT().GetRetentionPolicy(gomock.Any(), gomock.Any()).Return(nil, nil)
 				return fields{
+					traceRepo:      repoMock,
 					traceProducer:  producerMock,
 					traceConfig:    confMock,
 					buildHelper:    buildHelper,
@@ -338,6 +344,51 @@ func TestTraceServiceImpl_IngestTraces(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "ingest traces grouped by retention policy",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				producerMock := mqmocks.NewMockITraceProducer(ctrl)
+				producerMock.EXPECT().IngestSpans(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, td *entity.TraceData) error {
+					switch td.TenantInfo.TTL {
+					case loop_span.TTL90d:
+						assert.Equal(t, 1, len(td.SpanList))
+						assert.Equal(t, "model", td.SpanList[0].SpanType)
+					case loop_span.TTL7d:
+						assert.Equal(t, 2, len(td.SpanList))
+					default:
+						t.Fatalf("unexpected ttl %s", td.TenantInfo.TTL)
+					}
+					return nil
+				}).Times(2)
+				filterFactoryMock := filtermocks.NewMockPlatformFilterFactory(ctrl)
+				buildHelper := NewTraceFilterProcessorBuilder(filterFactoryMock, nil, nil, nil, nil, nil, nil)
+				repoMock := repomocks.NewMockITraceRepo(ctrl)
+				repoMock.EXPECT().GetRetentionPolicy(gomock.Any(), repo.GetRetentionPolicyParam{WorkspaceID: 1, UseCache: true}).Return(&entity.RetentionPolicy{
+					WorkspaceID: 1,
+					DefaultTTL:  loop_span.TTL7d,
+					Rules: []*entity.RetentionRule{
+						{SpanTypes: []string{"model"}, TTL: loop_span.TTL90d},
+					},
+				}, nil)
+				return fields{
+					traceRepo:     repoMock,
+					traceProducer: producerMock,
+					buildHelper:   buildHelper,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &IngestTracesReq{
+					TTL: loop_span.TTL365d,
+					Spans: loop_span.SpanList{
+						{TraceID: "123", SpanID: "1", WorkspaceID: "1", SpanType: "model"},
+						{TraceID: "123", SpanID: "2", WorkspaceID: "1", SpanType: "tool"},
+						{TraceID: "123", SpanID: "3", WorkspaceID: "1", SpanType: "custom"},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
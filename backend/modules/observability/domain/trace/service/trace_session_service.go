@@ -0,0 +1,246 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_filter"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_processor"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	sessionSpanPageSize = 1000
+	// 单个会话最多返回的轮数与span数, 超出部分截断
+	maxSessionTurns = 200
+	maxSessionSpans = 10000
+)
+
+type ListSessionsReq struct {
+	WorkspaceID           int64
+	ThirdPartyWorkspaceID string
+	StartTime             int64 // ms
+	EndTime               int64 // ms
+	Filters               *loop_span.FilterFields
+	Limit                 int32
+	PageToken             string
+	PlatformType          loop_span.PlatformType
+	Source                span_filter.SourceType
+}
+
+type ListSessionsResp struct {
+	Sessions      []*loop_span.Session
+	NextPageToken string
+	HasMore       bool
+}
+
+type GetSessionReq struct {
+	WorkspaceID           int64
+	ThirdPartyWorkspaceID string
+	SessionID             string
+	StartTime             int64 // ms
+	EndTime               int64 // ms
+	PlatformType          loop_span.PlatformType
+	Source                span_filter.SourceType
+}
+
+type GetSessionResp struct {
+	Session   *loop_span.Session
+	Truncated bool // 会话过长, 只返回了最近的部分轮次
+}
+
+// ListSessions 按thread_id聚合时间范围内的会话，按最近活跃时间倒序
+func (r *TraceServiceImpl) ListSessions(ctx context.Context, req *ListSessionsReq) (*ListSessionsResp, error) {
+	offset := 0
+	if req.PageToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(req.PageToken); err != nil || offset < 0 {
+			return nil, errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid page token"))
+		}
+	}
+	if req.Filters != nil {
+		if err := req.Filters.Traverse(processSpecificFilter); err != nil {
+			return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid filter"))
+		}
+	}
+	builtinFilter, err := r.buildSessionBuiltinFilters(ctx, req.WorkspaceID, req.ThirdPartyWorkspaceID, req.PlatformType, req.Source, &loop_span.FilterField{
+		FieldName: loop_span.SpanFieldThreadID,
+		FieldType: loop_span.FieldTypeString,
+		Values:    []string{""},
+		QueryType: ptr.Of(loop_span.QueryTypeEnumNotEq),
+	})
+	if err != nil {
+		return nil, err
+	} else if builtinFilter == nil {
+		return &ListSessionsResp{Sessions: []*loop_span.Session{}}, nil
+	}
+	tenants, err := r.getTenants(ctx, req.PlatformType)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.traceRepo.ListSessions(ctx, &repo.ListSessionsParam{
+		WorkSpaceID: strconv.FormatInt(req.WorkspaceID, 10),
+		Tenants:     tenants,
+		Filters:     r.combineFilters(builtinFilter, req.Filters),
+		StartAt:     req.StartTime,
+		EndAt:       req.EndTime,
+		Limit:       req.Limit,
+		Offset:      int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &ListSessionsResp{
+		Sessions: result.Sessions,
+		HasMore:  result.HasMore,
+	}
+	if result.HasMore {
+		resp.NextPageToken = strconv.Itoa(offset + len(result.Sessions))
+	}
+	return resp, nil
+}
+
+// GetSession 查询会话内的全部trace，每条trace为一轮，按开始时间升序
+// 先通过thread_id找到会话包含的trace，再按trace_id拉取完整的span，子span未携带thread_id时也能统计到token
+func (r *TraceServiceImpl) GetSession(ctx context.Context, req *GetSessionReq) (*GetSessionResp, error) {
+	tenants, err := r.getTenants(ctx, req.PlatformType)
+	if err != nil {
+		return nil, err
+	}
+	sessionFilter, err := r.buildSessionBuiltinFilters(ctx, req.WorkspaceID, req.ThirdPartyWorkspaceID, req.PlatformType, req.Source, &loop_span.FilterField{
+		FieldName: loop_span.SpanFieldThreadID,
+		FieldType: loop_span.FieldTypeString,
+		Values:    []string{req.SessionID},
+		QueryType: ptr.Of(loop_span.QueryTypeEnumEq),
+	})
+	if err != nil {
+		return nil, err
+	} else if sessionFilter == nil {
+		return &GetSessionResp{Session: &loop_span.Session{SessionID: req.SessionID, Turns: []*loop_span.SessionTurn{}}}, nil
+	}
+	resp := &GetSessionResp{}
+	traceIDs := make([]string, 0)
+	seen := make(map[string]bool)
+	_, truncated, err := r.listSessionSpans(ctx, req, tenants, sessionFilter, func(spans loop_span.SpanList) bool {
+		for _, span := range spans {
+			if seen[span.TraceID] {
+				continue
+			}
+			if len(traceIDs) >= maxSessionTurns {
+				resp.Truncated = true
+				return false
+			}
+			seen[span.TraceID] = true
+			traceIDs = append(traceIDs, span.TraceID)
+		}
+		return true
+	}, []string{loop_span.SpanFieldInput, loop_span.SpanFieldOutput})
+	if err != nil {
+		return nil, err
+	}
+	resp.Truncated = resp.Truncated || truncated
+	if len(traceIDs) == 0 {
+		resp.Session = &loop_span.Session{SessionID: req.SessionID, Turns: []*loop_span.SessionTurn{}}
+		return resp, nil
+	}
+	traceFilter, err := r.buildSessionBuiltinFilters(ctx, req.WorkspaceID, req.ThirdPartyWorkspaceID, req.PlatformType, req.Source, &loop_span.FilterField{
+		FieldName: loop_span.SpanFieldTraceId,
+		FieldType: loop_span.FieldTypeString,
+		Values:    traceIDs,
+		QueryType: ptr.Of(loop_span.QueryTypeEnumIn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	spans, truncated, err := r.listSessionSpans(ctx, req, tenants, traceFilter, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Truncated = resp.Truncated || truncated
+	processors, err := r.buildHelper.BuildListSpansProcessors(ctx, span_processor.Settings{
+		WorkspaceId:    req.WorkspaceID,
+		PlatformType:   req.PlatformType,
+		QueryStartTime: req.StartTime,
+		QueryEndTime:   req.EndTime,
+		QueryTenants:   tenants,
+	})
+	if err != nil {
+		return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInternalErrorCodeCode)
+	}
+	for _, p := range processors {
+		spans, err = p.Transform(ctx, spans)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp.Session, err = loop_span.BuildSession(ctx, req.SessionID, spans)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonInternalErrorCodeCode)
+	}
+	return resp, nil
+}
+
+// listSessionSpans 按开始时间倒序分页拉取满足条件的span，onPage返回false时提前结束，超过maxSessionSpans时截断
+func (r *TraceServiceImpl) listSessionSpans(ctx context.Context, req *GetSessionReq, tenants []string, filters *loop_span.FilterFields,
+	onPage func(loop_span.SpanList) bool, omitColumns []string,
+) (loop_span.SpanList, bool, error) {
+	ret := make(loop_span.SpanList, 0)
+	pageToken := ""
+	for {
+		result, err := r.traceRepo.ListSpans(ctx, &repo.ListSpansParam{
+			WorkSpaceID:        strconv.FormatInt(req.WorkspaceID, 10),
+			Tenants:            tenants,
+			Filters:            filters,
+			StartAt:            req.StartTime,
+			EndAt:              req.EndTime,
+			Limit:              sessionSpanPageSize,
+			DescByStartTime:    true,
+			PageToken:          pageToken,
+			NotQueryAnnotation: true,
+			OmitColumns:        omitColumns,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if onPage != nil && !onPage(result.Spans) {
+			return ret, false, nil
+		}
+		ret = append(ret, result.Spans...)
+		if !result.HasMore {
+			return ret, false, nil
+		}
+		if len(ret) >= maxSessionSpans {
+			logs.CtxWarn(ctx, "session %s has too many spans, truncated at %d", req.SessionID, len(ret))
+			return ret, true, nil
+		}
+		pageToken = result.PageToken
+	}
+}
+
+func (r *TraceServiceImpl) buildSessionBuiltinFilters(ctx context.Context, workspaceID int64, thirdPartyWorkspaceID string,
+	platformType loop_span.PlatformType, source span_filter.SourceType, sessionFilter *loop_span.FilterField,
+) (*loop_span.FilterFields, error) {
+	platformFilter, err := r.buildHelper.BuildPlatformRelatedFilter(ctx, platformType)
+	if err != nil {
+		return nil, err
+	}
+	builtinFilter, err := r.buildBuiltinFilters(ctx, platformFilter, &ListSpansReq{
+		WorkspaceID:           workspaceID,
+		ThirdPartyWorkspaceID: thirdPartyWorkspaceID,
+		SpanListType:          loop_span.SpanListTypeAllSpan,
+		Source:                source,
+	})
+	if err != nil || builtinFilter == nil {
+		return nil, err
+	}
+	builtinFilter.FilterFields = append(builtinFilter.FilterFields, sessionFilter)
+	return builtinFilter, nil
+}
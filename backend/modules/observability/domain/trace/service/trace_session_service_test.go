@@ -0,0 +1,153 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	tenantmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/tenant/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	repomocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo/mocks"
+	filtermocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/service/trace/span_filter/mocks"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+func newSessionTestService(ctrl *gomock.Controller, repoMock repo.ITraceRepo) *TraceServiceImpl {
+	tenantProviderMock := tenantmocks.NewMockITenantProvider(ctrl)
+	tenantProviderMock.EXPECT().GetTenantsByPlatformType(gomock.Any(), gomock.Any()).Return([]string{"spans"}, nil).AnyTimes()
+	filterMock := filtermocks.NewMockFilter(ctrl)
+	filterMock.EXPECT().BuildBasicSpanFilter(gomock.Any(), gomock.Any()).Return([]*loop_span.FilterField{
+		{
+			FieldName: loop_span.SpanFieldSpaceId,
+			FieldType: loop_span.FieldTypeString,
+			Values:    []string{"1"},
+			QueryType: ptr.Of(loop_span.QueryTypeEnumIn),
+		},
+	}, false, nil).AnyTimes()
+	filterMock.EXPECT().BuildALLSpanFilter(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	filterFactoryMock := filtermocks.NewMockPlatformFilterFactory(ctrl)
+	filterFactoryMock.EXPECT().GetFilter(gomock.Any(), gomock.Any()).Return(filterMock, nil).AnyTimes()
+	return &TraceServiceImpl{
+		traceRepo:      repoMock,
+		buildHelper:    NewTraceFilterProcessorBuilder(filterFactoryMock, nil, nil, nil, nil, nil, nil),
+		tenantProvider: tenantProviderMock,
+	}
+}
+
+func hasFilterField(filters *loop_span.FilterFields, name string, values []string) bool {
+	found := false
+	_ = filters.Traverse(func(f *loop_span.FilterField) error {
+		if f.FieldName == name && assert.ObjectsAreEqual(values, f.Values) {
+			found = true
+		}
+		return nil
+	})
+	return found
+}
+
+func TestTraceServiceImpl_ListSessions(t *testing.T) {
+	t.Run("list sessions with page token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		repoMock := repomocks.NewMockITraceRepo(ctrl)
+		repoMock.EXPECT().ListSessions(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, param *repo.ListSessionsParam) (*repo.ListSessionsResult, error) {
+				assert.Equal(t, int32(20), param.Offset)
+				assert.Equal(t, int32(2), param.Limit)
+				assert.True(t, hasFilterField(param.Filters, loop_span.SpanFieldThreadID, []string{""}))
+				assert.True(t, hasFilterField(param.Filters, loop_span.SpanFieldUserID, []string{"u1"}))
+				return &repo.ListSessionsResult{
+					Sessions: []*loop_span.Session{{SessionID: "s1"}, {SessionID: "s2"}},
+					HasMore:  true,
+				}, nil
+			})
+		r := newSessionTestService(ctrl, repoMock)
+		got, err := r.ListSessions(context.Background(), &ListSessionsReq{
+			WorkspaceID:  1,
+			PlatformType: loop_span.PlatformCozeLoop,
+			Limit:        2,
+			PageToken:    "20",
+			Filters: &loop_span.FilterFields{
+				FilterFields: []*loop_span.FilterField{
+					{
+						FieldName: loop_span.SpanFieldUserID,
+						FieldType: loop_span.FieldTypeString,
+						Values:    []string{"u1"},
+						QueryType: ptr.Of(loop_span.QueryTypeEnumEq),
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, got.Sessions, 2)
+		assert.True(t, got.HasMore)
+		assert.Equal(t, "22", got.NextPageToken)
+	})
+
+	t.Run("invalid page token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		r := newSessionTestService(ctrl, repomocks.NewMockITraceRepo(ctrl))
+		_, err := r.ListSessions(context.Background(), &ListSessionsReq{WorkspaceID: 1, PageToken: "abc"})
+		assert.Error(t, err)
+	})
+}
+
+func TestTraceServiceImpl_GetSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repoMock := repomocks.NewMockITraceRepo(ctrl)
+	gomock.InOrder(
+		// 第一页通过thread_id找到trace
+		repoMock.EXPECT().ListSpans(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, param *repo.ListSpansParam) (*repo.ListSpansResult, error) {
+				assert.True(t, hasFilterField(param.Filters, loop_span.SpanFieldThreadID, []string{"s1"}))
+				assert.True(t, param.DescByStartTime)
+				return &repo.ListSpansResult{
+					Spans:     loop_span.SpanList{{TraceID: "t2", SpanID: "r2"}},
+					HasMore:   true,
+					PageToken: "next",
+				}, nil
+			}),
+		repoMock.EXPECT().ListSpans(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, param *repo.ListSpansParam) (*repo.ListSpansResult, error) {
+				assert.Equal(t, "next", param.PageToken)
+				return &repo.ListSpansResult{
+					Spans: loop_span.SpanList{{TraceID: "t1", SpanID: "r1"}, {TraceID: "t2", SpanID: "c2"}},
+				}, nil
+			}),
+		// 再按trace_id拉取完整的span
+		repoMock.EXPECT().ListSpans(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, param *repo.ListSpansParam) (*repo.ListSpansResult, error) {
+				assert.True(t, hasFilterField(param.Filters, loop_span.SpanFieldTraceId, []string{"t2", "t1"}))
+				assert.Empty(t, param.OmitColumns)
+				return &repo.ListSpansResult{
+					Spans: loop_span.SpanList{
+						{TraceID: "t2", SpanID: "r2", ParentID: "0", StartTime: 3000000, DurationMicros: 1000000},
+						{TraceID: "t1", SpanID: "r1", ParentID: "0", StartTime: 1000000, DurationMicros: 2000000},
+						{TraceID: "t1", SpanID: "m1", ParentID: "r1", SpanType: loop_span.SpanTypeModel, StartTime: 1000000,
+							TagsLong: map[string]int64{loop_span.SpanFieldInputTokens: 5, loop_span.SpanFieldOutputTokens: 2}},
+					},
+				}, nil
+			}),
+	)
+	r := newSessionTestService(ctrl, repoMock)
+	got, err := r.GetSession(context.Background(), &GetSessionReq{
+		WorkspaceID:  1,
+		SessionID:    "s1",
+		PlatformType: loop_span.PlatformCozeLoop,
+	})
+	assert.NoError(t, err)
+	assert.False(t, got.Truncated)
+	assert.Equal(t, int64(2), got.Session.TurnCount)
+	assert.Equal(t, "t1", got.Session.Turns[0].TraceID)
+	assert.Equal(t, "t2", got.Session.Turns[1].TraceID)
+	assert.Equal(t, int64(5), got.Session.InputTokens)
+	assert.Equal(t, int64(3000), got.Session.Latency)
+}
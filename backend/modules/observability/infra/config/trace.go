@@ -30,6 +30,8 @@ const (
 	consumerListeningCfgKey            = "consumer_listening"
 	metricPlatformTenantCfgKey         = "metric_platform_tenants"
 	metricQueryConfigKey               = "metric_query_config"
+	modelPriceCatalogCfgKey            = "model_price_catalog"
+	piiRedactionCfgKey                 = "pii_redaction"
 )
 
 type TraceConfigCenter struct {
@@ -210,6 +212,22 @@ func (t *TraceConfigCenter) GetMetricQueryConfig(ctx context.Context) *config.Me
 	return cfg
 }
 
+func (t *TraceConfigCenter) GetModelPriceCatalog(ctx context.Context) (*config.ModelPriceCatalog, error) {
+	cfg := new(config.ModelPriceCatalog)
+	if err := t.UnmarshalKey(ctx, modelPriceCatalogCfgKey, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (t *TraceConfigCenter) GetPIIRedactionConfig(ctx context.Context) (*config.PIIRedactionConfig, error) {
+	cfg := new(config.PIIRedactionConfig)
+	if err := t.UnmarshalKey(ctx, piiRedactionCfgKey, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func NewTraceConfigCenter(confP conf.IConfigLoader) config.ITraceConfig {
 	ret := &TraceConfigCenter{
 		IConfigLoader: confP,
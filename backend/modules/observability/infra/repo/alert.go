@@ -0,0 +1,104 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	metric_repo "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/convertor"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+)
+
+func NewAlertRuleRepoImpl(alertRuleDao mysql.IAlertRuleDao, idGenerator idgen.IIDGenerator) metric_repo.IAlertRuleRepo {
+	return &AlertRuleRepoImpl{
+		alertRuleDao: alertRuleDao,
+		idGenerator:  idGenerator,
+	}
+}
+
+type AlertRuleRepoImpl struct {
+	alertRuleDao mysql.IAlertRuleDao
+	idGenerator  idgen.IIDGenerator
+}
+
+func (a *AlertRuleRepoImpl) CreateAlertRule(ctx context.Context, rule *entity.AlertRule) (int64, error) {
+	id, err := a.idGenerator.GenID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	po, err := convertor.AlertRuleDO2PO(rule)
+	if err != nil {
+		return 0, err
+	}
+	po.ID = id
+	if err := a.alertRuleDao.CreateAlertRule(ctx, po); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (a *AlertRuleRepoImpl) UpdateAlertRule(ctx context.Context, rule *entity.AlertRule) error {
+	po, err := convertor.AlertRuleDO2PO(rule)
+	if err != nil {
+		return err
+	}
+	return a.alertRuleDao.UpdateAlertRule(ctx, po)
+}
+
+func (a *AlertRuleRepoImpl) DeleteAlertRule(ctx context.Context, workspaceID, id int64) error {
+	return a.alertRuleDao.DeleteAlertRule(ctx, workspaceID, id)
+}
+
+func (a *AlertRuleRepoImpl) GetAlertRule(ctx context.Context, workspaceID, id int64) (*entity.AlertRule, error) {
+	po, err := a.alertRuleDao.GetAlertRule(ctx, workspaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertor.AlertRulePO2DO(po), nil
+}
+
+func (a *AlertRuleRepoImpl) ListAlertRules(ctx context.Context, param *metric_repo.ListAlertRulesParam) ([]*entity.AlertRule, error) {
+	pos, err := a.alertRuleDao.ListAlertRules(ctx, &mysql.ListAlertRulesParam{
+		WorkspaceID: param.WorkspaceID,
+		MetricName:  param.MetricName,
+		Status:      string(param.Status),
+		CursorID:    param.CursorID,
+		Limit:       param.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alertRulesPO2DO(pos), nil
+}
+
+func (a *AlertRuleRepoImpl) ListEnabledAlertRules(ctx context.Context, param *metric_repo.ListEnabledAlertRulesParam) ([]*entity.AlertRule, error) {
+	pos, err := a.alertRuleDao.ListEnabledAlertRules(ctx, param.CursorID, param.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return alertRulesPO2DO(pos), nil
+}
+
+func (a *AlertRuleRepoImpl) UpdateAlertState(ctx context.Context, id int64, state entity.AlertState) error {
+	return a.alertRuleDao.UpdateAlertState(ctx, &model.ObservabilityAlertRule{
+		ID:              id,
+		Status:          string(state.Status),
+		LastValue:       state.LastValue,
+		LastEvaluatedAt: state.LastEvaluatedAt,
+		FiredAt:         state.FiredAt,
+		ResolvedAt:      state.ResolvedAt,
+	})
+}
+
+func alertRulesPO2DO(pos []*model.ObservabilityAlertRule) []*entity.AlertRule {
+	res := make([]*entity.AlertRule, 0, len(pos))
+	for _, po := range pos {
+		res = append(res, convertor.AlertRulePO2DO(po))
+	}
+	return res
+}
@@ -33,6 +33,12 @@ const (
 
 	// 人工标注标签类型
 	AnnotationManualFeedbackType = "manual_feedback"
+
+	// 自动打标标签
+	AnnotationAutoTagFieldPrefix = "auto_tag_"
+
+	// 自动打标标签类型
+	AnnotationAutoTagType = "auto_tag"
 )
 
 func NewSpansCkDaoImpl(db ck.Provider) (dao.ISpansDao, error) {
@@ -110,6 +116,7 @@ func (s *SpansCkDaoImpl) buildMetricsSql(ctx context.Context, param *dao.GetMetr
 	// 直接复用现有的SQL获取所有数据, 然后再计算指标
 	sql, err := s.buildSql(ctx, &dao.QueryParam{
 		Tables:           param.Tables,
+		AnnoTableMap:     param.AnnoTableMap,
 		StartTime:        param.StartAt,
 		EndTime:          param.EndAt,
 		Filters:          param.Filters,
@@ -153,6 +160,15 @@ func (s *SpansCkDaoImpl) buildMetricsSql(ctx context.Context, param *dao.GetMetr
 			fmt.Sprintf("%s AS %s", fieldName, dimension.Alias))
 		groupByClauses = append(groupByClauses, fieldName)
 	}
+	if len(param.OrderBys) > 0 {
+		orderByClauses = make([]string, 0, len(param.OrderBys))
+		for _, orderBy := range param.OrderBys {
+			if orderBy == nil || !isSafeColumnName(orderBy.Field) {
+				return "", errorx.NewByCode(obErrorx.CommercialCommonInvalidParamCodeCode, errorx.WithExtraMsg("invalid metric order by field"))
+			}
+			orderByClauses = append(orderByClauses, orderBy.Field+lo.Ternary(orderBy.IsAsc, " ASC", " DESC"))
+		}
+	}
 	wholeSql := fmt.Sprintf(metricsSqlTemplate,
 		strings.Join(selectClauses, ", "),
 		innerQuery,
@@ -161,6 +177,9 @@ func (s *SpansCkDaoImpl) buildMetricsSql(ctx context.Context, param *dao.GetMetr
 		lo.Ternary(len(orderByClauses) == 0,
 			"", "ORDER BY "+strings.Join(orderByClauses, ", ")),
 	)
+	if param.Limit > 0 {
+		wholeSql += fmt.Sprintf(" LIMIT %d OFFSET %d", param.Limit, param.Offset)
+	}
 	return wholeSql, nil
 }
 
@@ -405,7 +424,8 @@ func (s *SpansCkDaoImpl) buildFieldCondition(ctx context.Context, db *gorm.DB, f
 }
 
 func (s *SpansCkDaoImpl) isAnnotationFilter(fieldName string) bool {
-	if strings.HasPrefix(fieldName, AnnotationManualFeedbackFieldPrefix) {
+	if strings.HasPrefix(fieldName, AnnotationManualFeedbackFieldPrefix) ||
+		strings.HasPrefix(fieldName, AnnotationAutoTagFieldPrefix) {
 		return true
 	} else {
 		return false
@@ -424,6 +444,15 @@ func (s *SpansCkDaoImpl) buildAnnotationSql(ctx context.Context, param *buildSql
 		queryChain = queryChain.
 			Where("annotation_type = ?", AnnotationManualFeedbackType).
 			Where("key = ?", tagKeyId)
+	} else if strings.HasPrefix(fieldName, AnnotationAutoTagFieldPrefix) {
+		// auto_tag_{tag_key}
+		tagKey := fieldName[len(AnnotationAutoTagFieldPrefix):]
+		if tagKey == "" {
+			return nil, fmt.Errorf("invalid auto tag field name %s", fieldName)
+		}
+		queryChain = queryChain.
+			Where("annotation_type = ?", AnnotationAutoTagType).
+			Where("key = ?", tagKey)
 	} else {
 		return nil, fmt.Errorf("field name %s not supported for annotation, not supposed to be here", fieldName)
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	ck_mocks "github.com/coze-dev/coze-loop/backend/infra/ck/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/common"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/ck/gorm_gen/model"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/dao"
@@ -453,6 +454,19 @@ func TestBuildSql(t *testing.T) {
 			},
 			expectedSql: "SELECT start_time, logid, span_id, trace_id, parent_id, duration, psm, call_type, space_id, span_type, span_name, method, status_code, input, output, object_storage, system_tags_string, system_tags_long, system_tags_float, tags_string, tags_long, tags_bool, tags_float, tags_byte, reserve_create_time, logic_delete_date FROM `observability_spans` WHERE `input` NOT like '%123%' AND start_time >= 1 AND start_time <= 2 LIMIT 100",
 		},
+		{
+			filter: &loop_span.FilterFields{
+				FilterFields: []*loop_span.FilterField{
+					{
+						FieldName: "auto_tag_intent",
+						FieldType: loop_span.FieldTypeString,
+						Values:    []string{"refund"},
+						QueryType: ptr.Of(loop_span.QueryTypeEnumIn),
+					},
+				},
+			},
+			expectedSql: "SELECT start_time, logid, span_id, trace_id, parent_id, duration, psm, call_type, space_id, span_type, span_name, method, status_code, input, output, object_storage, system_tags_string, system_tags_long, system_tags_float, tags_string, tags_long, tags_bool, tags_float, tags_byte, reserve_create_time, logic_delete_date FROM `observability_spans` WHERE span_id in (SELECT span_id FROM `observability_annotations` WHERE (annotation_type = 'auto_tag' AND key = 'intent' AND value_string IN ('refund')) AND deleted_at = 0 AND start_time >= 1 AND start_time <= 2 SETTINGS final = 1) AND start_time >= 1 AND start_time <= 2 LIMIT 100",
+		},
 		{
 			filter: &loop_span.FilterFields{
 				FilterFields: []*loop_span.FilterField{
@@ -1120,6 +1134,69 @@ func TestSpansCkDaoImpl_buildMetricsSql(t *testing.T) {
 		assert.NotContains(t, sql, "ORDER BY")
 	})
 
+	t.Run("build metrics sql with order by and limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		sqlDB, _, err := sqlmock.New()
+		if err != nil {
+			t.Fatal("Failed to create mock")
+		}
+		defer func() {
+			_ = sqlDB.Close()
+		}()
+
+		db, err := gorm.Open(clickhouse.New(clickhouse.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mockProvider := ck_mocks.NewMockProvider(ctrl)
+		mockProvider.EXPECT().NewSession(gomock.Any()).Return(db).AnyTimes()
+
+		ckDao := &SpansCkDaoImpl{db: mockProvider}
+		param := &dao.GetMetricsParam{
+			Tables: []string{"test_table"},
+			Aggregations: []*entity.Dimension{
+				{
+					Expression: &entity.Expression{
+						Expression: "max(%s)",
+						Fields: []*loop_span.FilterField{
+							{FieldName: loop_span.SpanFieldStartTime, FieldType: loop_span.FieldTypeLong},
+						},
+					},
+					Alias: "end_time",
+				},
+			},
+			GroupBys: []*entity.Dimension{
+				{
+					Field: &loop_span.FilterField{
+						FieldName: loop_span.SpanFieldThreadID,
+						FieldType: loop_span.FieldTypeString,
+					},
+					Alias: "session_id",
+				},
+			},
+			OrderBys: []*common.OrderBy{{Field: "end_time"}},
+			Limit:    21,
+			Offset:   20,
+			StartAt:  1,
+			EndAt:    2,
+		}
+
+		sql, err := ckDao.buildMetricsSql(context.Background(), param)
+		assert.NoError(t, err)
+		assert.Contains(t, sql, "tags_string['thread_id'] AS session_id")
+		assert.Contains(t, sql, "ORDER BY end_time DESC LIMIT 21 OFFSET 20")
+
+		param.OrderBys = []*common.OrderBy{{Field: "end_time; drop"}}
+		_, err = ckDao.buildMetricsSql(context.Background(), param)
+		assert.Error(t, err)
+	})
+
 	t.Run("build metrics sql with invalid field", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		t.Cleanup(ctrl.Finish)
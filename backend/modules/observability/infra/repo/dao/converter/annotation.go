@@ -56,6 +56,14 @@ func AnnotationPO2DO(annotation *dao.Annotation) *loop_span.Annotation {
 			} else {
 				ret.Metadata = metadata
 			}
+		case loop_span.AnnotationTypeAutoTag:
+			var metadata loop_span.AutoTagMetadata
+			err := json.Unmarshal([]byte(annotation.Metadata), &metadata)
+			if err != nil {
+				logs.Error("json unmarshal metadata error: %v", err)
+			} else {
+				ret.Metadata = metadata
+			}
 		case loop_span.AnnotationTypeManualEvaluationSet, loop_span.AnnotationTypeManualDataset:
 			var metadata loop_span.ManualDatasetMetadata
 			err := json.Unmarshal([]byte(annotation.Metadata), &metadata)
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	metrics_entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/common"
 	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
 )
 
@@ -36,6 +37,7 @@ type InsertParam struct {
 // GetMetricsParam 指标查询参数
 type GetMetricsParam struct {
 	Tables       []string
+	AnnoTableMap map[string]string
 	Aggregations []*metrics_entity.Dimension
 	GroupBys     []*metrics_entity.Dimension
 	Filters      *loop_span.FilterFields
@@ -44,6 +46,9 @@ type GetMetricsParam struct {
 	Granularity  metrics_entity.MetricGranularity
 	Extra        map[string]string
 	Source       string
+	OrderBys     []*common.OrderBy // 按别名排序, 为空时按时间桶排序
+	Limit        int32
+	Offset       int32
 }
 
 //go:generate mockgen -destination=mocks/spans_dao.go -package=mocks . ISpansDao
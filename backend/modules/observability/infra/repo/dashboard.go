@@ -0,0 +1,74 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	metric_repo "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/convertor"
+)
+
+func NewDashboardRepoImpl(dashboardDao mysql.IDashboardDao, idGenerator idgen.IIDGenerator) metric_repo.IDashboardRepo {
+	return &DashboardRepoImpl{
+		dashboardDao: dashboardDao,
+		idGenerator:  idGenerator,
+	}
+}
+
+type DashboardRepoImpl struct {
+	dashboardDao mysql.IDashboardDao
+	idGenerator  idgen.IIDGenerator
+}
+
+func (d *DashboardRepoImpl) CreateDashboard(ctx context.Context, dashboard *entity.Dashboard) (int64, error) {
+	id, err := d.idGenerator.GenID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	po, err := convertor.DashboardDO2PO(dashboard)
+	if err != nil {
+		return 0, err
+	}
+	po.ID = id
+	if err := d.dashboardDao.CreateDashboard(ctx, po); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (d *DashboardRepoImpl) UpdateDashboard(ctx context.Context, dashboard *entity.Dashboard) error {
+	po, err := convertor.DashboardDO2PO(dashboard)
+	if err != nil {
+		return err
+	}
+	return d.dashboardDao.UpdateDashboard(ctx, po)
+}
+
+func (d *DashboardRepoImpl) DeleteDashboard(ctx context.Context, workspaceID, id int64) error {
+	return d.dashboardDao.DeleteDashboard(ctx, workspaceID, id)
+}
+
+func (d *DashboardRepoImpl) GetDashboard(ctx context.Context, workspaceID, id int64) (*entity.Dashboard, error) {
+	po, err := d.dashboardDao.GetDashboard(ctx, workspaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertor.DashboardPO2DO(po), nil
+}
+
+func (d *DashboardRepoImpl) ListDashboards(ctx context.Context, param *metric_repo.ListDashboardsParam) ([]*entity.Dashboard, error) {
+	pos, err := d.dashboardDao.ListDashboards(ctx, param.WorkspaceID, param.CursorID, param.Limit)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*entity.Dashboard, 0, len(pos))
+	for _, po := range pos {
+		res = append(res, convertor.DashboardPO2DO(po))
+	}
+	return res, nil
+}
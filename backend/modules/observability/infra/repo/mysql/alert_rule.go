@@ -0,0 +1,142 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	genquery "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/query"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+type ListAlertRulesParam struct {
+	WorkspaceID int64
+	MetricName  string
+	Status      string
+	CursorID    int64
+	Limit       int
+}
+
+//go:generate mockgen -destination=mocks/alert_rule.go -package=mocks . IAlertRuleDao
+type IAlertRuleDao interface {
+	CreateAlertRule(ctx context.Context, po *model.ObservabilityAlertRule) error
+	UpdateAlertRule(ctx context.Context, po *model.ObservabilityAlertRule) error
+	DeleteAlertRule(ctx context.Context, workspaceID, id int64) error
+	GetAlertRule(ctx context.Context, workspaceID, id int64) (*model.ObservabilityAlertRule, error)
+	ListAlertRules(ctx context.Context, param *ListAlertRulesParam) ([]*model.ObservabilityAlertRule, error)
+	// ListEnabledAlertRules 按ID升序分页返回启用的规则
+	ListEnabledAlertRules(ctx context.Context, cursorID int64, limit int) ([]*model.ObservabilityAlertRule, error)
+	UpdateAlertState(ctx context.Context, po *model.ObservabilityAlertRule) error
+}
+
+func NewAlertRuleDaoImpl(db db.Provider) IAlertRuleDao {
+	return &AlertRuleDaoImpl{
+		dbMgr: db,
+	}
+}
+
+type AlertRuleDaoImpl struct {
+	dbMgr db.Provider
+}
+
+func (a *AlertRuleDaoImpl) CreateAlertRule(ctx context.Context, po *model.ObservabilityAlertRule) error {
+	q := genquery.Use(a.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityAlertRule
+	if err := q.WithContext(ctx).Create(po); err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (a *AlertRuleDaoImpl) UpdateAlertRule(ctx context.Context, po *model.ObservabilityAlertRule) error {
+	q := genquery.Use(a.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityAlertRule
+	_, err := q.WithContext(ctx).
+		Where(q.ID.Eq(po.ID), q.WorkspaceID.Eq(po.WorkspaceID)).
+		Select(
+			q.Name,
+			q.PlatformType,
+			q.MetricName,
+			q.GroupKey,
+			q.Filters,
+			q.AlertCondition,
+			q.WindowSeconds,
+			q.Enabled,
+			q.Notification,
+			q.UpdatedBy,
+		).
+		Updates(po)
+	if err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (a *AlertRuleDaoImpl) DeleteAlertRule(ctx context.Context, workspaceID, id int64) error {
+	q := genquery.Use(a.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityAlertRule
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id), q.WorkspaceID.Eq(workspaceID)).Delete(); err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (a *AlertRuleDaoImpl) GetAlertRule(ctx context.Context, workspaceID, id int64) (*model.ObservabilityAlertRule, error) {
+	q := genquery.Use(a.dbMgr.NewSession(ctx)).ObservabilityAlertRule
+	po, err := q.WithContext(ctx).Where(q.ID.Eq(id), q.WorkspaceID.Eq(workspaceID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return po, nil
+}
+
+func (a *AlertRuleDaoImpl) ListAlertRules(ctx context.Context, param *ListAlertRulesParam) ([]*model.ObservabilityAlertRule, error) {
+	q := genquery.Use(a.dbMgr.NewSession(ctx)).ObservabilityAlertRule
+	qd := q.WithContext(ctx).Where(q.WorkspaceID.Eq(param.WorkspaceID), q.ID.Gt(param.CursorID))
+	if param.MetricName != "" {
+		qd = qd.Where(q.MetricName.Eq(param.MetricName))
+	}
+	if param.Status != "" {
+		qd = qd.Where(q.Status.Eq(param.Status))
+	}
+	results, err := qd.Order(q.ID).Limit(param.Limit).Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return results, nil
+}
+
+func (a *AlertRuleDaoImpl) ListEnabledAlertRules(ctx context.Context, cursorID int64, limit int) ([]*model.ObservabilityAlertRule, error) {
+	q := genquery.Use(a.dbMgr.NewSession(ctx)).ObservabilityAlertRule
+	results, err := q.WithContext(ctx).
+		Where(q.Enabled.Is(true), q.ID.Gt(cursorID)).
+		Order(q.ID).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return results, nil
+}
+
+func (a *AlertRuleDaoImpl) UpdateAlertState(ctx context.Context, po *model.ObservabilityAlertRule) error {
+	q := genquery.Use(a.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityAlertRule
+	_, err := q.WithContext(ctx).Where(q.ID.Eq(po.ID)).UpdateSimple(
+		q.Status.Value(po.Status),
+		q.LastValue.Value(po.LastValue),
+		q.LastEvaluatedAt.Value(po.LastEvaluatedAt),
+		q.FiredAt.Value(po.FiredAt),
+		q.ResolvedAt.Value(po.ResolvedAt),
+	)
+	if err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func AlertRulePO2DO(po *model.ObservabilityAlertRule) *entity.AlertRule {
+	if po == nil {
+		return nil
+	}
+	res := &entity.AlertRule{
+		ID:            po.ID,
+		WorkspaceID:   po.WorkspaceID,
+		Name:          po.Name,
+		PlatformType:  loop_span.PlatformType(po.PlatformType),
+		MetricName:    po.MetricName,
+		GroupKey:      po.GroupKey,
+		WindowSeconds: po.WindowSeconds,
+		Enabled:       po.Enabled,
+		State:         AlertStatePO2DO(po),
+		CreatedAt:     po.CreatedAt,
+		CreatedBy:     po.CreatedBy,
+		UpdatedAt:     po.UpdatedAt,
+		UpdatedBy:     po.UpdatedBy,
+	}
+	if po.Filters != nil && len(*po.Filters) > 0 {
+		filters := &loop_span.FilterFields{}
+		if err := json.Unmarshal([]byte(*po.Filters), filters); err == nil {
+			res.Filters = filters
+		}
+	}
+	if po.AlertCondition != nil && len(*po.AlertCondition) > 0 {
+		condition := &entity.AlertCondition{}
+		if err := json.Unmarshal([]byte(*po.AlertCondition), condition); err == nil {
+			res.Condition = condition
+		}
+	}
+	if po.Notification != nil && len(*po.Notification) > 0 {
+		notification := &entity.AlertNotification{}
+		if err := json.Unmarshal([]byte(*po.Notification), notification); err == nil {
+			res.Notification = notification
+		}
+	}
+	return res
+}
+
+func AlertStatePO2DO(po *model.ObservabilityAlertRule) entity.AlertState {
+	return entity.AlertState{
+		Status:          entity.AlertStatus(po.Status),
+		LastValue:       po.LastValue,
+		LastEvaluatedAt: po.LastEvaluatedAt,
+		FiredAt:         po.FiredAt,
+		ResolvedAt:      po.ResolvedAt,
+	}
+}
+
+func AlertRuleDO2PO(do *entity.AlertRule) (*model.ObservabilityAlertRule, error) {
+	if do == nil {
+		return nil, nil
+	}
+	po := &model.ObservabilityAlertRule{
+		ID:              do.ID,
+		WorkspaceID:     do.WorkspaceID,
+		Name:            do.Name,
+		PlatformType:    string(do.PlatformType),
+		MetricName:      do.MetricName,
+		GroupKey:        do.GroupKey,
+		WindowSeconds:   do.WindowSeconds,
+		Enabled:         do.Enabled,
+		Status:          string(do.State.Status),
+		LastValue:       do.State.LastValue,
+		LastEvaluatedAt: do.State.LastEvaluatedAt,
+		FiredAt:         do.State.FiredAt,
+		ResolvedAt:      do.State.ResolvedAt,
+		CreatedAt:       do.CreatedAt,
+		CreatedBy:       do.CreatedBy,
+		UpdatedAt:       do.UpdatedAt,
+		UpdatedBy:       do.UpdatedBy,
+	}
+	if do.Filters != nil {
+		filters, err := json.MarshalString(do.Filters)
+		if err != nil {
+			return nil, err
+		}
+		po.Filters = &filters
+	}
+	if do.Condition != nil {
+		condition, err := json.MarshalString(do.Condition)
+		if err != nil {
+			return nil, err
+		}
+		po.AlertCondition = &condition
+	}
+	if do.Notification != nil {
+		notification, err := json.MarshalString(do.Notification)
+		if err != nil {
+			return nil, err
+		}
+		po.Notification = &notification
+	}
+	return po, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func DashboardPO2DO(po *model.ObservabilityDashboard) *entity.Dashboard {
+	if po == nil {
+		return nil
+	}
+	res := &entity.Dashboard{
+		ID:           po.ID,
+		WorkspaceID:  po.WorkspaceID,
+		Name:         po.Name,
+		Description:  po.Description,
+		PlatformType: loop_span.PlatformType(po.PlatformType),
+		CreatedAt:    po.CreatedAt,
+		CreatedBy:    po.CreatedBy,
+		UpdatedAt:    po.UpdatedAt,
+		UpdatedBy:    po.UpdatedBy,
+	}
+	if po.Filters != nil && len(*po.Filters) > 0 {
+		filters := &loop_span.FilterFields{}
+		if err := json.Unmarshal([]byte(*po.Filters), filters); err == nil {
+			res.Filters = filters
+		}
+	}
+	if po.Panels != nil && len(*po.Panels) > 0 {
+		var panels []*entity.DashboardPanel
+		if err := json.Unmarshal([]byte(*po.Panels), &panels); err == nil {
+			res.Panels = panels
+		}
+	}
+	return res
+}
+
+func DashboardDO2PO(do *entity.Dashboard) (*model.ObservabilityDashboard, error) {
+	if do == nil {
+		return nil, nil
+	}
+	po := &model.ObservabilityDashboard{
+		ID:           do.ID,
+		WorkspaceID:  do.WorkspaceID,
+		Name:         do.Name,
+		Description:  do.Description,
+		PlatformType: string(do.PlatformType),
+		CreatedAt:    do.CreatedAt,
+		CreatedBy:    do.CreatedBy,
+		UpdatedAt:    do.UpdatedAt,
+		UpdatedBy:    do.UpdatedBy,
+	}
+	if do.Filters != nil {
+		filters, err := json.MarshalString(do.Filters)
+		if err != nil {
+			return nil, err
+		}
+		po.Filters = &filters
+	}
+	panels := do.Panels
+	if panels == nil {
+		panels = []*entity.DashboardPanel{}
+	}
+	panelsStr, err := json.MarshalString(panels)
+	if err != nil {
+		return nil, err
+	}
+	po.Panels = &panelsStr
+	return po, nil
+}
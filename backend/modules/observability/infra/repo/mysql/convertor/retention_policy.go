@@ -0,0 +1,61 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func RetentionPolicyPO2DO(po *model.ObservabilityRetentionPolicy) *entity.RetentionPolicy {
+	if po == nil {
+		return nil
+	}
+	res := &entity.RetentionPolicy{
+		ID:                    po.ID,
+		WorkspaceID:           po.WorkspaceID,
+		DefaultTTL:            loop_span.TTL(po.DefaultTTL),
+		KeepAnnotated:         po.KeepAnnotated,
+		KeepDatasetReferenced: po.KeepDatasetReferenced,
+		ArchivePermanently:    po.ArchivePermanently,
+		ArchiveWatermark:      po.ArchiveWatermark,
+		CreatedAt:             po.CreatedAt,
+		CreatedBy:             po.CreatedBy,
+		UpdatedAt:             po.UpdatedAt,
+		UpdatedBy:             po.UpdatedBy,
+	}
+	if po.Rules != nil && len(*po.Rules) > 0 {
+		rules := make([]*entity.RetentionRule, 0)
+		if err := json.Unmarshal([]byte(*po.Rules), &rules); err == nil {
+			res.Rules = rules
+		}
+	}
+	return res
+}
+
+func RetentionPolicyDO2PO(do *entity.RetentionPolicy) (*model.ObservabilityRetentionPolicy, error) {
+	if do == nil {
+		return nil, nil
+	}
+	rules, err := json.MarshalString(do.Rules)
+	if err != nil {
+		return nil, err
+	}
+	return &model.ObservabilityRetentionPolicy{
+		ID:                    do.ID,
+		WorkspaceID:           do.WorkspaceID,
+		DefaultTTL:            string(do.DefaultTTL),
+		Rules:                 &rules,
+		KeepAnnotated:         do.KeepAnnotated,
+		KeepDatasetReferenced: do.KeepDatasetReferenced,
+		ArchivePermanently:    do.ArchivePermanently,
+		ArchiveWatermark:      do.ArchiveWatermark,
+		CreatedAt:             do.CreatedAt,
+		CreatedBy:             do.CreatedBy,
+		UpdatedAt:             do.UpdatedAt,
+		UpdatedBy:             do.UpdatedBy,
+	}, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package convertor
+
+import (
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+func TraceExportJobPO2DO(po *model.ObservabilityTraceExportJob) *entity.TraceExportJob {
+	if po == nil {
+		return nil
+	}
+	res := &entity.TraceExportJob{
+		ID:            po.ID,
+		WorkspaceID:   po.WorkspaceID,
+		PlatformType:  loop_span.PlatformType(po.PlatformType),
+		SpanListType:  loop_span.SpanListType(po.SpanListType),
+		Format:        entity.ExportFileFormat(po.Format),
+		StartTime:     po.StartTime,
+		EndTime:       po.EndTime,
+		Status:        entity.ExportJobStatus(po.Status),
+		ExportedCount: po.ExportedCount,
+		FileKey:       po.FileKey,
+		ErrorMsg:      po.ErrorMsg,
+		Truncated:     po.Truncated,
+		CreatedAt:     po.CreatedAt,
+		CreatedBy:     po.CreatedBy,
+		UpdatedAt:     po.UpdatedAt,
+		UpdatedBy:     po.UpdatedBy,
+	}
+	if po.Filters != nil && len(*po.Filters) > 0 {
+		filters := &loop_span.FilterFields{}
+		if err := json.Unmarshal([]byte(*po.Filters), filters); err == nil {
+			res.Filters = filters
+		}
+	}
+	return res
+}
+
+func TraceExportJobDO2PO(do *entity.TraceExportJob) (*model.ObservabilityTraceExportJob, error) {
+	if do == nil {
+		return nil, nil
+	}
+	po := &model.ObservabilityTraceExportJob{
+		ID:            do.ID,
+		WorkspaceID:   do.WorkspaceID,
+		PlatformType:  string(do.PlatformType),
+		SpanListType:  string(do.SpanListType),
+		Format:        string(do.Format),
+		StartTime:     do.StartTime,
+		EndTime:       do.EndTime,
+		Status:        string(do.Status),
+		ExportedCount: do.ExportedCount,
+		FileKey:       do.FileKey,
+		ErrorMsg:      do.ErrorMsg,
+		Truncated:     do.Truncated,
+		CreatedAt:     do.CreatedAt,
+		CreatedBy:     do.CreatedBy,
+		UpdatedAt:     do.UpdatedAt,
+		UpdatedBy:     do.UpdatedBy,
+	}
+	if do.Filters != nil {
+		filters, err := json.MarshalString(do.Filters)
+		if err != nil {
+			return nil, err
+		}
+		po.Filters = &filters
+	}
+	return po, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	genquery "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/query"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+//go:generate mockgen -destination=mocks/dashboard.go -package=mocks . IDashboardDao
+type IDashboardDao interface {
+	CreateDashboard(ctx context.Context, po *model.ObservabilityDashboard) error
+	UpdateDashboard(ctx context.Context, po *model.ObservabilityDashboard) error
+	DeleteDashboard(ctx context.Context, workspaceID, id int64) error
+	GetDashboard(ctx context.Context, workspaceID, id int64) (*model.ObservabilityDashboard, error)
+	// ListDashboards 按ID升序分页返回空间下的看板
+	ListDashboards(ctx context.Context, workspaceID, cursorID int64, limit int) ([]*model.ObservabilityDashboard, error)
+}
+
+func NewDashboardDaoImpl(db db.Provider) IDashboardDao {
+	return &DashboardDaoImpl{
+		dbMgr: db,
+	}
+}
+
+type DashboardDaoImpl struct {
+	dbMgr db.Provider
+}
+
+func (d *DashboardDaoImpl) CreateDashboard(ctx context.Context, po *model.ObservabilityDashboard) error {
+	q := genquery.Use(d.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityDashboard
+	if err := q.WithContext(ctx).Create(po); err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (d *DashboardDaoImpl) UpdateDashboard(ctx context.Context, po *model.ObservabilityDashboard) error {
+	q := genquery.Use(d.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityDashboard
+	_, err := q.WithContext(ctx).
+		Where(q.ID.Eq(po.ID), q.WorkspaceID.Eq(po.WorkspaceID)).
+		Select(
+			q.Name,
+			q.Description,
+			q.PlatformType,
+			q.Filters,
+			q.Panels,
+			q.UpdatedBy,
+		).
+		Updates(po)
+	if err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (d *DashboardDaoImpl) DeleteDashboard(ctx context.Context, workspaceID, id int64) error {
+	q := genquery.Use(d.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityDashboard
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id), q.WorkspaceID.Eq(workspaceID)).Delete(); err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (d *DashboardDaoImpl) GetDashboard(ctx context.Context, workspaceID, id int64) (*model.ObservabilityDashboard, error) {
+	q := genquery.Use(d.dbMgr.NewSession(ctx)).ObservabilityDashboard
+	po, err := q.WithContext(ctx).Where(q.ID.Eq(id), q.WorkspaceID.Eq(workspaceID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return po, nil
+}
+
+func (d *DashboardDaoImpl) ListDashboards(ctx context.Context, workspaceID, cursorID int64, limit int) ([]*model.ObservabilityDashboard, error) {
+	q := genquery.Use(d.dbMgr.NewSession(ctx)).ObservabilityDashboard
+	results, err := q.WithContext(ctx).
+		Where(q.WorkspaceID.Eq(workspaceID), q.ID.Gt(cursorID)).
+		Order(q.ID).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return results, nil
+}
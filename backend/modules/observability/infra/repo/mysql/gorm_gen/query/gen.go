@@ -18,8 +18,12 @@ import (
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:                            db,
+		ObservabilityAlertRule:        newObservabilityAlertRule(db, opts...),
+		ObservabilityDashboard:        newObservabilityDashboard(db, opts...),
+		ObservabilityRetentionPolicy:  newObservabilityRetentionPolicy(db, opts...),
 		ObservabilityTask:             newObservabilityTask(db, opts...),
 		ObservabilityTaskRun:          newObservabilityTaskRun(db, opts...),
+		ObservabilityTraceExportJob:   newObservabilityTraceExportJob(db, opts...),
 		ObservabilityTrajectoryConfig: newObservabilityTrajectoryConfig(db, opts...),
 		ObservabilityView:             newObservabilityView(db, opts...),
 	}
@@ -28,8 +32,12 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 type Query struct {
 	db *gorm.DB
 
+	ObservabilityAlertRule        observabilityAlertRule
+	ObservabilityDashboard        observabilityDashboard
+	ObservabilityRetentionPolicy  observabilityRetentionPolicy
 	ObservabilityTask             observabilityTask
 	ObservabilityTaskRun          observabilityTaskRun
+	ObservabilityTraceExportJob   observabilityTraceExportJob
 	ObservabilityTrajectoryConfig observabilityTrajectoryConfig
 	ObservabilityView             observabilityView
 }
@@ -39,8 +47,12 @@ func (q *Query) Available() bool { return q.db != nil }
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:                            db,
+		ObservabilityAlertRule:        q.ObservabilityAlertRule.clone(db),
+		ObservabilityDashboard:        q.ObservabilityDashboard.clone(db),
+		ObservabilityRetentionPolicy:  q.ObservabilityRetentionPolicy.clone(db),
 		ObservabilityTask:             q.ObservabilityTask.clone(db),
 		ObservabilityTaskRun:          q.ObservabilityTaskRun.clone(db),
+		ObservabilityTraceExportJob:   q.ObservabilityTraceExportJob.clone(db),
 		ObservabilityTrajectoryConfig: q.ObservabilityTrajectoryConfig.clone(db),
 		ObservabilityView:             q.ObservabilityView.clone(db),
 	}
@@ -57,24 +69,36 @@ func (q *Query) WriteDB() *Query {
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:                            db,
+		ObservabilityAlertRule:        q.ObservabilityAlertRule.replaceDB(db),
+		ObservabilityDashboard:        q.ObservabilityDashboard.replaceDB(db),
+		ObservabilityRetentionPolicy:  q.ObservabilityRetentionPolicy.replaceDB(db),
 		ObservabilityTask:             q.ObservabilityTask.replaceDB(db),
 		ObservabilityTaskRun:          q.ObservabilityTaskRun.replaceDB(db),
+		ObservabilityTraceExportJob:   q.ObservabilityTraceExportJob.replaceDB(db),
 		ObservabilityTrajectoryConfig: q.ObservabilityTrajectoryConfig.replaceDB(db),
 		ObservabilityView:             q.ObservabilityView.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
+	ObservabilityAlertRule        *observabilityAlertRuleDo
+	ObservabilityDashboard        *observabilityDashboardDo
+	ObservabilityRetentionPolicy  *observabilityRetentionPolicyDo
 	ObservabilityTask             *observabilityTaskDo
 	ObservabilityTaskRun          *observabilityTaskRunDo
+	ObservabilityTraceExportJob   *observabilityTraceExportJobDo
 	ObservabilityTrajectoryConfig *observabilityTrajectoryConfigDo
 	ObservabilityView             *observabilityViewDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
+		ObservabilityAlertRule:        q.ObservabilityAlertRule.WithContext(ctx),
+		ObservabilityDashboard:        q.ObservabilityDashboard.WithContext(ctx),
+		ObservabilityRetentionPolicy:  q.ObservabilityRetentionPolicy.WithContext(ctx),
 		ObservabilityTask:             q.ObservabilityTask.WithContext(ctx),
 		ObservabilityTaskRun:          q.ObservabilityTaskRun.WithContext(ctx),
+		ObservabilityTraceExportJob:   q.ObservabilityTraceExportJob.WithContext(ctx),
 		ObservabilityTrajectoryConfig: q.ObservabilityTrajectoryConfig.WithContext(ctx),
 		ObservabilityView:             q.ObservabilityView.WithContext(ctx),
 	}
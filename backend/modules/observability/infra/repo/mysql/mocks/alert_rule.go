@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql (interfaces: IAlertRuleDao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/alert_rule.go -package=mocks . IAlertRuleDao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	mysql "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql"
+	model "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAlertRuleDao is a mock of IAlertRuleDao interface.
+type MockIAlertRuleDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAlertRuleDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockIAlertRuleDaoMockRecorder is the mock recorder for MockIAlertRuleDao.
+type MockIAlertRuleDaoMockRecorder struct {
+	mock *MockIAlertRuleDao
+}
+
+// NewMockIAlertRuleDao creates a new mock instance.
+func NewMockIAlertRuleDao(ctrl *gomock.Controller) *MockIAlertRuleDao {
+	mock := &MockIAlertRuleDao{ctrl: ctrl}
+	mock.recorder = &MockIAlertRuleDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAlertRuleDao) EXPECT() *MockIAlertRuleDaoMockRecorder {
+	return m.recorder
+}
+
+// CreateAlertRule mocks base method.
+func (m *MockIAlertRuleDao) CreateAlertRule(ctx context.Context, po *model.ObservabilityAlertRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertRule", ctx, po)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAlertRule indicates an expected call of CreateAlertRule.
+func (mr *MockIAlertRuleDaoMockRecorder) CreateAlertRule(ctx, po any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertRule", reflect.TypeOf((*MockIAlertRuleDao)(nil).CreateAlertRule), ctx, po)
+}
+
+// DeleteAlertRule mocks base method.
+func (m *MockIAlertRuleDao) DeleteAlertRule(ctx context.Context, workspaceID, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertRule", ctx, workspaceID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertRule indicates an expected call of DeleteAlertRule.
+func (mr *MockIAlertRuleDaoMockRecorder) DeleteAlertRule(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertRule", reflect.TypeOf((*MockIAlertRuleDao)(nil).DeleteAlertRule), ctx, workspaceID, id)
+}
+
+// GetAlertRule mocks base method.
+func (m *MockIAlertRuleDao) GetAlertRule(ctx context.Context, workspaceID, id int64) (*model.ObservabilityAlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertRule", ctx, workspaceID, id)
+	ret0, _ := ret[0].(*model.ObservabilityAlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlertRule indicates an expected call of GetAlertRule.
+func (mr *MockIAlertRuleDaoMockRecorder) GetAlertRule(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertRule", reflect.TypeOf((*MockIAlertRuleDao)(nil).GetAlertRule), ctx, workspaceID, id)
+}
+
+// ListAlertRules mocks base method.
+func (m *MockIAlertRuleDao) ListAlertRules(ctx context.Context, param *mysql.ListAlertRulesParam) ([]*model.ObservabilityAlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertRules", ctx, param)
+	ret0, _ := ret[0].([]*model.ObservabilityAlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertRules indicates an expected call of ListAlertRules.
+func (mr *MockIAlertRuleDaoMockRecorder) ListAlertRules(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertRules", reflect.TypeOf((*MockIAlertRuleDao)(nil).ListAlertRules), ctx, param)
+}
+
+// ListEnabledAlertRules mocks base method.
+func (m *MockIAlertRuleDao) ListEnabledAlertRules(ctx context.Context, cursorID int64, limit int) ([]*model.ObservabilityAlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnabledAlertRules", ctx, cursorID, limit)
+	ret0, _ := ret[0].([]*model.ObservabilityAlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnabledAlertRules indicates an expected call of ListEnabledAlertRules.
+func (mr *MockIAlertRuleDaoMockRecorder) ListEnabledAlertRules(ctx, cursorID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnabledAlertRules", reflect.TypeOf((*MockIAlertRuleDao)(nil).ListEnabledAlertRules), ctx, cursorID, limit)
+}
+
+// UpdateAlertRule mocks base method.
+func (m *MockIAlertRuleDao) UpdateAlertRule(ctx context.Context, po *model.ObservabilityAlertRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertRule", ctx, po)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlertRule indicates an expected call of UpdateAlertRule.
+func (mr *MockIAlertRuleDaoMockRecorder) UpdateAlertRule(ctx, po any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertRule", reflect.TypeOf((*MockIAlertRuleDao)(nil).UpdateAlertRule), ctx, po)
+}
+
+// UpdateAlertState mocks base method.
+func (m *MockIAlertRuleDao) UpdateAlertState(ctx context.Context, po *model.ObservabilityAlertRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertState", ctx, po)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlertState indicates an expected call of UpdateAlertState.
+func (mr *MockIAlertRuleDaoMockRecorder) UpdateAlertState(ctx, po any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertState", reflect.TypeOf((*MockIAlertRuleDao)(nil).UpdateAlertState), ctx, po)
+}
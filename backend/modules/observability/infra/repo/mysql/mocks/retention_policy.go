@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql (interfaces: IRetentionPolicyDao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/retention_policy.go -package=mocks . IRetentionPolicyDao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRetentionPolicyDao is a mock of IRetentionPolicyDao interface.
+type MockIRetentionPolicyDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRetentionPolicyDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockIRetentionPolicyDaoMockRecorder is the mock recorder for MockIRetentionPolicyDao.
+type MockIRetentionPolicyDaoMockRecorder struct {
+	mock *MockIRetentionPolicyDao
+}
+
+// NewMockIRetentionPolicyDao creates a new mock instance.
+func NewMockIRetentionPolicyDao(ctrl *gomock.Controller) *MockIRetentionPolicyDao {
+	mock := &MockIRetentionPolicyDao{ctrl: ctrl}
+	mock.recorder = &MockIRetentionPolicyDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRetentionPolicyDao) EXPECT() *MockIRetentionPolicyDaoMockRecorder {
+	return m.recorder
+}
+
+// GetRetentionPolicy mocks base method.
+func (m *MockIRetentionPolicyDao) GetRetentionPolicy(ctx context.Context, workspaceID int64) (*model.ObservabilityRetentionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRetentionPolicy", ctx, workspaceID)
+	ret0, _ := ret[0].(*model.ObservabilityRetentionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRetentionPolicy indicates an expected call of GetRetentionPolicy.
+func (mr *MockIRetentionPolicyDaoMockRecorder) GetRetentionPolicy(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetentionPolicy", reflect.TypeOf((*MockIRetentionPolicyDao)(nil).GetRetentionPolicy), ctx, workspaceID)
+}
+
+// ListArchiveRetentionPolicies mocks base method.
+func (m *MockIRetentionPolicyDao) ListArchiveRetentionPolicies(ctx context.Context, cursorID int64, limit int) ([]*model.ObservabilityRetentionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArchiveRetentionPolicies", ctx, cursorID, limit)
+	ret0, _ := ret[0].([]*model.ObservabilityRetentionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArchiveRetentionPolicies indicates an expected call of ListArchiveRetentionPolicies.
+func (mr *MockIRetentionPolicyDaoMockRecorder) ListArchiveRetentionPolicies(ctx, cursorID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArchiveRetentionPolicies", reflect.TypeOf((*MockIRetentionPolicyDao)(nil).ListArchiveRetentionPolicies), ctx, cursorID, limit)
+}
+
+// UpdateArchiveWatermark mocks base method.
+func (m *MockIRetentionPolicyDao) UpdateArchiveWatermark(ctx context.Context, id, watermark int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArchiveWatermark", ctx, id, watermark)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateArchiveWatermark indicates an expected call of UpdateArchiveWatermark.
+func (mr *MockIRetentionPolicyDaoMockRecorder) UpdateArchiveWatermark(ctx, id, watermark any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArchiveWatermark", reflect.TypeOf((*MockIRetentionPolicyDao)(nil).UpdateArchiveWatermark), ctx, id, watermark)
+}
+
+// UpsertRetentionPolicy mocks base method.
+func (m *MockIRetentionPolicyDao) UpsertRetentionPolicy(ctx context.Context, po *model.ObservabilityRetentionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRetentionPolicy", ctx, po)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRetentionPolicy indicates an expected call of UpsertRetentionPolicy.
+func (mr *MockIRetentionPolicyDaoMockRecorder) UpsertRetentionPolicy(ctx, po any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRetentionPolicy", reflect.TypeOf((*MockIRetentionPolicyDao)(nil).UpsertRetentionPolicy), ctx, po)
+}
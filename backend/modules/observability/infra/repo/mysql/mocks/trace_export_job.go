@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql (interfaces: ITraceExportJobDao)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/trace_export_job.go -package=mocks . ITraceExportJobDao
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITraceExportJobDao is a mock of ITraceExportJobDao interface.
+type MockITraceExportJobDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockITraceExportJobDaoMockRecorder
+	isgomock struct{}
+}
+
+// MockITraceExportJobDaoMockRecorder is the mock recorder for MockITraceExportJobDao.
+type MockITraceExportJobDaoMockRecorder struct {
+	mock *MockITraceExportJobDao
+}
+
+// NewMockITraceExportJobDao creates a new mock instance.
+func NewMockITraceExportJobDao(ctrl *gomock.Controller) *MockITraceExportJobDao {
+	mock := &MockITraceExportJobDao{ctrl: ctrl}
+	mock.recorder = &MockITraceExportJobDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITraceExportJobDao) EXPECT() *MockITraceExportJobDaoMockRecorder {
+	return m.recorder
+}
+
+// CreateTraceExportJob mocks base method.
+func (m *MockITraceExportJobDao) CreateTraceExportJob(ctx context.Context, po *model.ObservabilityTraceExportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTraceExportJob", ctx, po)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTraceExportJob indicates an expected call of CreateTraceExportJob.
+func (mr *MockITraceExportJobDaoMockRecorder) CreateTraceExportJob(ctx, po any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTraceExportJob", reflect.TypeOf((*MockITraceExportJobDao)(nil).CreateTraceExportJob), ctx, po)
+}
+
+// GetTraceExportJob mocks base method.
+func (m *MockITraceExportJobDao) GetTraceExportJob(ctx context.Context, workspaceID, id int64) (*model.ObservabilityTraceExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraceExportJob", ctx, workspaceID, id)
+	ret0, _ := ret[0].(*model.ObservabilityTraceExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTraceExportJob indicates an expected call of GetTraceExportJob.
+func (mr *MockITraceExportJobDaoMockRecorder) GetTraceExportJob(ctx, workspaceID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraceExportJob", reflect.TypeOf((*MockITraceExportJobDao)(nil).GetTraceExportJob), ctx, workspaceID, id)
+}
+
+// UpdateTraceExportJob mocks base method.
+func (m *MockITraceExportJobDao) UpdateTraceExportJob(ctx context.Context, po *model.ObservabilityTraceExportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTraceExportJob", ctx, po)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTraceExportJob indicates an expected call of UpdateTraceExportJob.
+func (mr *MockITraceExportJobDaoMockRecorder) UpdateTraceExportJob(ctx, po any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTraceExportJob", reflect.TypeOf((*MockITraceExportJobDao)(nil).UpdateTraceExportJob), ctx, po)
+}
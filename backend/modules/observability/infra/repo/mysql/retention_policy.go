@@ -0,0 +1,92 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	genquery "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/query"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+//go:generate mockgen -destination=mocks/retention_policy.go -package=mocks . IRetentionPolicyDao
+type IRetentionPolicyDao interface {
+	GetRetentionPolicy(ctx context.Context, workspaceID int64) (*model.ObservabilityRetentionPolicy, error)
+	UpsertRetentionPolicy(ctx context.Context, po *model.ObservabilityRetentionPolicy) error
+	// ListArchiveRetentionPolicies 按ID升序分页返回需要转存的策略
+	ListArchiveRetentionPolicies(ctx context.Context, cursorID int64, limit int) ([]*model.ObservabilityRetentionPolicy, error)
+	UpdateArchiveWatermark(ctx context.Context, id int64, watermark int64) error
+}
+
+func NewRetentionPolicyDaoImpl(db db.Provider) IRetentionPolicyDao {
+	return &RetentionPolicyDaoImpl{
+		dbMgr: db,
+	}
+}
+
+type RetentionPolicyDaoImpl struct {
+	dbMgr db.Provider
+}
+
+func (r *RetentionPolicyDaoImpl) GetRetentionPolicy(ctx context.Context, workspaceID int64) (*model.ObservabilityRetentionPolicy, error) {
+	q := genquery.Use(r.dbMgr.NewSession(ctx)).ObservabilityRetentionPolicy
+	po, err := q.WithContext(ctx).Where(q.WorkspaceID.Eq(workspaceID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return po, nil
+}
+
+// UpsertRetentionPolicy 按空间覆盖写入，不修改转存水位和创建信息
+func (r *RetentionPolicyDaoImpl) UpsertRetentionPolicy(ctx context.Context, po *model.ObservabilityRetentionPolicy) error {
+	q := genquery.Use(r.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityRetentionPolicy
+	err := q.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: q.WorkspaceID.ColumnName().String()}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			q.DefaultTTL.ColumnName().String(),
+			q.Rules.ColumnName().String(),
+			q.KeepAnnotated.ColumnName().String(),
+			q.KeepDatasetReferenced.ColumnName().String(),
+			q.ArchivePermanently.ColumnName().String(),
+			q.UpdatedAt.ColumnName().String(),
+			q.UpdatedBy.ColumnName().String(),
+		}),
+	}).Create(po)
+	if err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (r *RetentionPolicyDaoImpl) ListArchiveRetentionPolicies(ctx context.Context, cursorID int64, limit int) ([]*model.ObservabilityRetentionPolicy, error) {
+	q := genquery.Use(r.dbMgr.NewSession(ctx)).ObservabilityRetentionPolicy
+	results, err := q.WithContext(ctx).
+		Where(q.ID.Gt(cursorID)).
+		Where(q.WithContext(ctx).Or(q.KeepAnnotated.Is(true)).Or(q.KeepDatasetReferenced.Is(true))).
+		Order(q.ID).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return results, nil
+}
+
+func (r *RetentionPolicyDaoImpl) UpdateArchiveWatermark(ctx context.Context, id int64, watermark int64) error {
+	q := genquery.Use(r.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityRetentionPolicy
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).UpdateSimple(q.ArchiveWatermark.Value(watermark)); err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
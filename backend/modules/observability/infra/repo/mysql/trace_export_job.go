@@ -0,0 +1,74 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/coze-dev/coze-loop/backend/infra/db"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/model"
+	genquery "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/gorm_gen/query"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+)
+
+//go:generate mockgen -destination=mocks/trace_export_job.go -package=mocks . ITraceExportJobDao
+type ITraceExportJobDao interface {
+	CreateTraceExportJob(ctx context.Context, po *model.ObservabilityTraceExportJob) error
+	UpdateTraceExportJob(ctx context.Context, po *model.ObservabilityTraceExportJob) error
+	GetTraceExportJob(ctx context.Context, workspaceID, id int64) (*model.ObservabilityTraceExportJob, error)
+}
+
+func NewTraceExportJobDaoImpl(db db.Provider) ITraceExportJobDao {
+	return &TraceExportJobDaoImpl{
+		dbMgr: db,
+	}
+}
+
+type TraceExportJobDaoImpl struct {
+	dbMgr db.Provider
+}
+
+func (d *TraceExportJobDaoImpl) CreateTraceExportJob(ctx context.Context, po *model.ObservabilityTraceExportJob) error {
+	q := genquery.Use(d.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityTraceExportJob
+	if err := q.WithContext(ctx).Create(po); err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (d *TraceExportJobDaoImpl) UpdateTraceExportJob(ctx context.Context, po *model.ObservabilityTraceExportJob) error {
+	q := genquery.Use(d.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityTraceExportJob
+	_, err := q.WithContext(ctx).
+		Where(q.ID.Eq(po.ID), q.WorkspaceID.Eq(po.WorkspaceID)).
+		Select(
+			q.Status,
+			q.ExportedCount,
+			q.FileKey,
+			q.ErrorMsg,
+			q.Truncated,
+			q.UpdatedBy,
+		).
+		Updates(po)
+	if err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return nil
+}
+
+func (d *TraceExportJobDaoImpl) GetTraceExportJob(ctx context.Context, workspaceID, id int64) (*model.ObservabilityTraceExportJob, error) {
+	// 任务进度持续更新, 从主库读取
+	q := genquery.Use(d.dbMgr.NewSession(ctx, db.WithMaster())).ObservabilityTraceExportJob
+	po, err := q.WithContext(ctx).Where(q.ID.Eq(id), q.WorkspaceID.Eq(workspaceID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errorx.WrapByCode(err, obErrorx.CommonMySqlErrorCode)
+	}
+	return po, nil
+}
@@ -6,8 +6,11 @@ package redis
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
+	goredis "github.com/redis/go-redis/v9"
+
 	"github.com/coze-dev/coze-loop/backend/infra/redis"
 	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
 	"github.com/coze-dev/coze-loop/backend/pkg/logs"
@@ -21,6 +24,15 @@ type ITaskRunDAO interface {
 	IncrTaskRunFailCount(ctx context.Context, taskID, taskRunID int64, ttl time.Duration) error
 	GetTaskRunSuccessCount(ctx context.Context, taskID, taskRunID int64) (int64, error)
 	GetTaskRunFailCount(ctx context.Context, taskID, taskRunID int64) (int64, error)
+
+	// 采样统计操作
+	IncrTaskRunSampleStat(ctx context.Context, taskID, taskRunID int64, field string, delta int64, ttl time.Duration) (int64, error)
+	GetTaskRunSampleStats(ctx context.Context, taskID, taskRunID int64) (map[string]int64, error)
+
+	// 相似输入去重
+	GetTaskSpanSimHashes(ctx context.Context, taskID int64, bands []string) ([]uint64, error)
+	AddTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64, ttl time.Duration) (bool, error)
+	RemTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64) error
 }
 
 type TaskRunDAOImpl struct {
@@ -43,6 +55,14 @@ func (q *TaskRunDAOImpl) makeTaskRunFailCountKey(taskID, taskRunID int64) string
 	return fmt.Sprintf("taskrun:fail_count:%d:%d", taskID, taskRunID)
 }
 
+func (q *TaskRunDAOImpl) makeTaskRunSampleStatsKey(taskID, taskRunID int64) string {
+	return fmt.Sprintf("taskrun:sample_stats:%d:%d", taskID, taskRunID)
+}
+
+func (q *TaskRunDAOImpl) makeTaskSpanSimHashKey(taskID int64, band string) string {
+	return fmt.Sprintf("task:span_simhash:%d:%s", taskID, band)
+}
+
 // IncrTaskRunSuccessCount 增加成功计数
 func (p *TaskRunDAOImpl) IncrTaskRunSuccessCount(ctx context.Context, taskID, taskRunID int64, ttl time.Duration) error {
 	key := p.makeTaskRunSuccessCountKey(taskID, taskRunID)
@@ -106,3 +126,95 @@ func (p *TaskRunDAOImpl) DecrTaskRunSuccessCount(ctx context.Context, taskID, ta
 	}
 	return nil
 }
+
+// IncrTaskRunSampleStat 增加采样统计中指定字段的计数, 返回增加后的值
+func (p *TaskRunDAOImpl) IncrTaskRunSampleStat(ctx context.Context, taskID, taskRunID int64, field string, delta int64, ttl time.Duration) (int64, error) {
+	key := p.makeTaskRunSampleStatsKey(taskID, taskRunID)
+	got, err := p.cmdable.HIncrBy(ctx, key, field, delta).Result()
+	if err != nil {
+		logs.CtxError(ctx, "redis hincrby taskrun sample stat failed, key:%v, field:%v, err:%v", key, field, err)
+		return 0, errorx.Wrapf(err, "redis hincrby taskrun sample stat key: %v", key)
+	}
+	if err := p.cmdable.Expire(ctx, key, ttl).Err(); err != nil {
+		logs.CtxError(ctx, "redis expire taskrun sample stats failed, key:%v, err:%v", key, err)
+		return 0, errorx.Wrapf(err, "redis expire taskrun sample stats key: %v", key)
+	}
+	return got, nil
+}
+
+// GetTaskRunSampleStats 获取采样统计
+func (p *TaskRunDAOImpl) GetTaskRunSampleStats(ctx context.Context, taskID, taskRunID int64) (map[string]int64, error) {
+	key := p.makeTaskRunSampleStatsKey(taskID, taskRunID)
+	got, err := p.cmdable.HGetAll(ctx, key).Result()
+	if err != nil {
+		if redis.IsNilError(err) {
+			return nil, nil
+		}
+		return nil, errorx.Wrapf(err, "redis get taskrun sample stats fail, key: %v", key)
+	}
+	stats := make(map[string]int64, len(got))
+	for field, val := range got {
+		count, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			logs.CtxWarn(ctx, "invalid taskrun sample stat, key:%v, field:%v, val:%v", key, field, val)
+			continue
+		}
+		stats[field] = count
+	}
+	return stats, nil
+}
+
+// GetTaskSpanSimHashes 获取各分段索引下已记录的simhash
+func (p *TaskRunDAOImpl) GetTaskSpanSimHashes(ctx context.Context, taskID int64, bands []string) ([]uint64, error) {
+	pipe := p.cmdable.Pipeline()
+	cmds := make([]*goredis.StringSliceCmd, 0, len(bands))
+	for _, band := range bands {
+		cmds = append(cmds, pipe.HKeys(ctx, p.makeTaskSpanSimHashKey(taskID, band)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !redis.IsNilError(err) {
+		logs.CtxError(ctx, "redis hkeys task span simhash failed, task_id:%v, err:%v", taskID, err)
+		return nil, errorx.Wrapf(err, "redis hkeys task span simhash, task_id: %v", taskID)
+	}
+	var hashes []uint64
+	for _, cmd := range cmds {
+		for _, field := range cmd.Val() {
+			hash, err := strconv.ParseUint(field, 16, 64)
+			if err != nil {
+				logs.CtxWarn(ctx, "invalid task span simhash, task_id:%v, field:%v", taskID, field)
+				continue
+			}
+			hashes = append(hashes, hash)
+		}
+	}
+	return hashes, nil
+}
+
+// AddTaskSpanSimHash 在各分段索引下记录simhash, simhash已存在时返回false
+func (p *TaskRunDAOImpl) AddTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64, ttl time.Duration) (bool, error) {
+	field := strconv.FormatUint(simHash, 16)
+	pipe := p.cmdable.Pipeline()
+	cmds := make([]*goredis.BoolCmd, 0, len(bands))
+	for _, band := range bands {
+		key := p.makeTaskSpanSimHashKey(taskID, band)
+		cmds = append(cmds, pipe.HSetNX(ctx, key, field, 1))
+		pipe.Expire(ctx, key, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		logs.CtxError(ctx, "redis hsetnx task span simhash failed, task_id:%v, err:%v", taskID, err)
+		return false, errorx.Wrapf(err, "redis hsetnx task span simhash, task_id: %v", taskID)
+	}
+	return len(cmds) > 0 && cmds[0].Val(), nil
+}
+
+func (p *TaskRunDAOImpl) RemTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64) error {
+	field := strconv.FormatUint(simHash, 16)
+	pipe := p.cmdable.Pipeline()
+	for _, band := range bands {
+		pipe.HDel(ctx, p.makeTaskSpanSimHashKey(taskID, band), field)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		logs.CtxError(ctx, "redis hdel task span simhash failed, task_id:%v, err:%v", taskID, err)
+		return errorx.Wrapf(err, "redis hdel task span simhash, task_id: %v", taskID)
+	}
+	return nil
+}
@@ -320,6 +320,26 @@ func (v *TaskRepoImpl) IncrTaskRunFailCount(ctx context.Context, taskID, taskRun
 	return v.TaskRunRedisDao.IncrTaskRunFailCount(ctx, taskID, taskRunID, time.Duration(ttl)*time.Millisecond)
 }
 
+func (v *TaskRepoImpl) IncrTaskRunSampleStat(ctx context.Context, taskID, taskRunID int64, field string, delta int64, ttl int64) (int64, error) {
+	return v.TaskRunRedisDao.IncrTaskRunSampleStat(ctx, taskID, taskRunID, field, delta, time.Duration(ttl)*time.Millisecond)
+}
+
+func (v *TaskRepoImpl) GetTaskRunSampleStats(ctx context.Context, taskID, taskRunID int64) (map[string]int64, error) {
+	return v.TaskRunRedisDao.GetTaskRunSampleStats(ctx, taskID, taskRunID)
+}
+
+func (v *TaskRepoImpl) ListTaskSpanSimHashes(ctx context.Context, taskID int64, bands []string) ([]uint64, error) {
+	return v.TaskRunRedisDao.GetTaskSpanSimHashes(ctx, taskID, bands)
+}
+
+func (v *TaskRepoImpl) AddTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64, ttl int64) (bool, error) {
+	return v.TaskRunRedisDao.AddTaskSpanSimHash(ctx, taskID, bands, simHash, time.Duration(ttl)*time.Millisecond)
+}
+
+func (v *TaskRepoImpl) RemoveTaskSpanSimHash(ctx context.Context, taskID int64, bands []string, simHash uint64) error {
+	return v.TaskRunRedisDao.RemTaskSpanSimHash(ctx, taskID, bands, simHash)
+}
+
 func (v *TaskRepoImpl) ListNonFinalTaskBySpaceID(ctx context.Context, spaceID string) ([]int64, error) {
 	cacheKey := "non_final_tasks_" + spaceID
 	if val, err := v.cache.Get([]byte(cacheKey)); err == nil {
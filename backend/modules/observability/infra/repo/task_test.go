@@ -201,6 +201,26 @@ func (stubTaskRunRedisDao) GetTaskRunFailCount(context.Context, int64, int64) (i
 	return 0, nil
 }
 
+func (stubTaskRunRedisDao) IncrTaskRunSampleStat(context.Context, int64, int64, string, int64, time.Duration) (int64, error) {
+	return 0, nil
+}
+
+func (stubTaskRunRedisDao) GetTaskRunSampleStats(context.Context, int64, int64) (map[string]int64, error) {
+	return nil, nil
+}
+
+func (stubTaskRunRedisDao) GetTaskSpanSimHashes(context.Context, int64, []string) ([]uint64, error) {
+	return nil, nil
+}
+
+func (stubTaskRunRedisDao) AddTaskSpanSimHash(context.Context, int64, []string, uint64, time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (stubTaskRunRedisDao) RemTaskSpanSimHash(context.Context, int64, []string, uint64) error {
+	return nil
+}
+
 func TestTaskRepoImpl_CreateTask(t *testing.T) {
 	t.Parallel()
 
@@ -32,6 +32,8 @@ import (
 	"github.com/coze-dev/coze-loop/backend/pkg/json"
 	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
 	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+	"github.com/coze-dev/coze-loop/backend/pkg/mcache"
+	"github.com/coze-dev/coze-loop/backend/pkg/mcache/byted"
 	time_util "github.com/coze-dev/coze-loop/backend/pkg/time"
 	"github.com/samber/lo"
 	"gorm.io/gorm/utils"
@@ -70,6 +72,12 @@ func WithTraceStorageAnnotationDao(storageType string, annoDao dao.IAnnotationDa
 	}
 }
 
+func WithRetentionPolicyDao(retentionPolicyDao mysql.IRetentionPolicyDao) TraceRepoOption {
+	return func(t *TraceRepoImpl) {
+		t.retentionPolicyDao = retentionPolicyDao
+	}
+}
+
 func NewTraceRepoImpl(
 	traceConfig config.ITraceConfig,
 	storageProvider storage.IStorageProvider,
@@ -113,6 +121,7 @@ func newTraceRepoImpl(
 		spanProducer:      spanProducer,
 		trajectoryConfDao: trajectoryConfDao,
 		idGenerator:       idGenerator,
+		cache:             byted.NewLRUCache(1024 * 1024),
 	}
 	for _, opt := range opts {
 		if opt != nil {
@@ -131,6 +140,9 @@ type TraceRepoImpl struct {
 	spanProducer      mq.ISpanProducer
 	trajectoryConfDao mysql.ITrajectoryConfigDao
 	idGenerator       idgen.IIDGenerator
+
+	retentionPolicyDao mysql.IRetentionPolicyDao
+	cache              mcache.IByteCache
 }
 
 func (t *TraceRepoImpl) GetPreSpanIDs(ctx context.Context, param *repo.GetPreSpanIDsParam) (preSpanIDs, responseIDs []string, err error) {
@@ -181,6 +193,85 @@ func (t *TraceRepoImpl) GetTrajectoryConfig(ctx context.Context, param repo.GetT
 	return convertor2.TrajectoryConfigPO2DO(trajectoryConfig), nil
 }
 
+func (t *TraceRepoImpl) UpsertRetentionPolicy(ctx context.Context, param *repo.UpsertRetentionPolicyParam) error {
+	if t.retentionPolicyDao == nil {
+		return errorx.WrapByCode(errors.New("retention policy is not supported"), obErrorx.CommercialCommonInvalidParamCodeCode)
+	}
+	id, err := t.idGenerator.GenID(ctx)
+	if err != nil {
+		return err
+	}
+	policy := *param.Policy
+	policy.ID = id
+	policy.ArchiveWatermark = 0
+	policy.CreatedAt = time.Now()
+	policy.CreatedBy = param.UserID
+	policy.UpdatedAt = time.Now()
+	policy.UpdatedBy = param.UserID
+	po, err := convertor2.RetentionPolicyDO2PO(&policy)
+	if err != nil {
+		return errorx.WrapByCode(err, obErrorx.CommercialCommonInternalErrorCodeCode)
+	}
+	// 写入链路使用本地缓存，新策略最多延迟1分钟生效
+	return t.retentionPolicyDao.UpsertRetentionPolicy(ctx, po)
+}
+
+func (t *TraceRepoImpl) GetRetentionPolicy(ctx context.Context, param repo.GetRetentionPolicyParam) (*entity.RetentionPolicy, error) {
+	if t.retentionPolicyDao == nil {
+		return nil, nil
+	}
+	cacheKey := retentionPolicyCacheKey(param.WorkspaceID)
+	if param.UseCache {
+		if val, err := t.cache.Get([]byte(cacheKey)); err == nil {
+			// 未配置策略的空间同样缓存，避免每次写入都回源
+			if len(val) == 0 {
+				return nil, nil
+			}
+			policy := &entity.RetentionPolicy{}
+			if err := json.Unmarshal(val, policy); err == nil {
+				return policy, nil
+			}
+		}
+	}
+	po, err := t.retentionPolicyDao.GetRetentionPolicy(ctx, param.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	policy := convertor2.RetentionPolicyPO2DO(po)
+	var val []byte
+	if policy != nil {
+		if val, err = json.Marshal(policy); err != nil {
+			return policy, nil
+		}
+	}
+	_ = t.cache.Set([]byte(cacheKey), val, time.Minute)
+	return policy, nil
+}
+
+func (t *TraceRepoImpl) ListArchiveRetentionPolicies(ctx context.Context, param repo.ListArchiveRetentionPoliciesParam) ([]*entity.RetentionPolicy, error) {
+	if t.retentionPolicyDao == nil {
+		return nil, nil
+	}
+	pos, err := t.retentionPolicyDao.ListArchiveRetentionPolicies(ctx, param.CursorID, param.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(pos, func(po *model2.ObservabilityRetentionPolicy, _ int) *entity.RetentionPolicy {
+		return convertor2.RetentionPolicyPO2DO(po)
+	}), nil
+}
+
+func (t *TraceRepoImpl) UpdateRetentionArchiveWatermark(ctx context.Context, param repo.UpdateRetentionArchiveWatermarkParam) error {
+	if t.retentionPolicyDao == nil {
+		return nil
+	}
+	return t.retentionPolicyDao.UpdateArchiveWatermark(ctx, param.PolicyID, param.Watermark)
+}
+
+func retentionPolicyCacheKey(workspaceID int64) string {
+	return fmt.Sprintf("retention_policy_%d", workspaceID)
+}
+
 func (t *TraceRepoImpl) InsertSpans(ctx context.Context, param *repo.InsertTraceParam) error {
 	spanDao := t.spanDaos[ck.TraceStorageTypeCK]
 	if spanDao == nil {
@@ -497,6 +588,9 @@ func (t *TraceRepoImpl) InsertAnnotations(ctx context.Context, param *repo.Inser
 	if err != nil {
 		return err
 	}
+	if param.SkipEvent {
+		return nil
+	}
 	span := param.Span
 	annotationType := ""
 	if param.AnnotationType != nil {
@@ -520,6 +614,7 @@ func (t *TraceRepoImpl) GetMetrics(ctx context.Context, param *metric_repo.GetMe
 	}
 	metrics, err := spanDao.GetMetrics(ctx, &dao.GetMetricsParam{
 		Tables:       tableCfg.SpanTables,
+		AnnoTableMap: tableCfg.AnnoTableMap,
 		Aggregations: param.Aggregations,
 		GroupBys:     param.GroupBys,
 		Filters:      param.Filters,
@@ -528,6 +623,8 @@ func (t *TraceRepoImpl) GetMetrics(ctx context.Context, param *metric_repo.GetMe
 		Granularity:  param.Granularity,
 		Extra:        spanStorage.StorageConfig,
 		Source:       param.Source,
+		OrderBys:     param.OrderBys,
+		Limit:        param.Limit,
 	})
 	if err != nil {
 		return nil, err
@@ -587,7 +684,7 @@ func (t *TraceRepoImpl) getSpanInsertTable(ctx context.Context, tenant string, t
 		logs.CtxError(ctx, "fail to get tenant config, %v", err)
 		return "", err
 	}
-	tableCfg, ok := tenantTableCfg.TenantTables[tenant][ttl]
+	tableCfg, ok := lookupTableCfg(tenantTableCfg.TenantTables[tenant], ttl)
 	if !ok {
 		return "", fmt.Errorf("no table config found for tenant %s with ttl %s", tenant, ttl)
 	} else if tableCfg.SpanTable == "" {
@@ -602,7 +699,7 @@ func (t *TraceRepoImpl) getAnnoInsertTable(ctx context.Context, tenant string, t
 		logs.CtxError(ctx, "fail to get tenant config, %v", err)
 		return "", err
 	}
-	tableCfg, ok := tenantTableCfg.TenantTables[tenant][ttl]
+	tableCfg, ok := lookupTableCfg(tenantTableCfg.TenantTables[tenant], ttl)
 	if !ok {
 		return "", nil
 	} else if tableCfg.AnnoTable == "" {
@@ -611,6 +708,25 @@ func (t *TraceRepoImpl) getAnnoInsertTable(ctx context.Context, tenant string, t
 	return tableCfg.AnnoTable, nil
 }
 
+// lookupTableCfg 没有对应保留时长的表时，写入保留时长更长的表中最短的一张，过期时间仍以span自身的ttl为准
+func lookupTableCfg(tables map[loop_span.TTL]config.TableCfg, ttl loop_span.TTL) (config.TableCfg, bool) {
+	if tableCfg, ok := tables[ttl]; ok {
+		return tableCfg, true
+	}
+	var (
+		ret   config.TableCfg
+		found bool
+		days  int64
+	)
+	for tableTTL, tableCfg := range tables {
+		if tableTTL.Days() < ttl.Days() || (found && tableTTL.Days() >= days) {
+			continue
+		}
+		ret, found, days = tableCfg, true, tableTTL.Days()
+	}
+	return ret, found
+}
+
 func (t *TraceRepoImpl) addPageTokenFilter(pageToken *PageToken, filter *loop_span.FilterFields) *loop_span.FilterFields {
 	timeStr := strconv.FormatInt(pageToken.StartTime, 10)
 	filterFields := &loop_span.FilterFields{
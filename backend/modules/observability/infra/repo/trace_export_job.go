@@ -0,0 +1,58 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/infra/idgen"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/mysql/convertor"
+)
+
+func NewTraceExportJobRepoImpl(exportJobDao mysql.ITraceExportJobDao, idGenerator idgen.IIDGenerator) repo.ITraceExportJobRepo {
+	return &TraceExportJobRepoImpl{
+		exportJobDao: exportJobDao,
+		idGenerator:  idGenerator,
+	}
+}
+
+type TraceExportJobRepoImpl struct {
+	exportJobDao mysql.ITraceExportJobDao
+	idGenerator  idgen.IIDGenerator
+}
+
+func (t *TraceExportJobRepoImpl) CreateTraceExportJob(ctx context.Context, job *entity.TraceExportJob) (int64, error) {
+	id, err := t.idGenerator.GenID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	po, err := convertor.TraceExportJobDO2PO(job)
+	if err != nil {
+		return 0, err
+	}
+	po.ID = id
+	if err := t.exportJobDao.CreateTraceExportJob(ctx, po); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (t *TraceExportJobRepoImpl) UpdateTraceExportJob(ctx context.Context, job *entity.TraceExportJob) error {
+	po, err := convertor.TraceExportJobDO2PO(job)
+	if err != nil {
+		return err
+	}
+	return t.exportJobDao.UpdateTraceExportJob(ctx, po)
+}
+
+func (t *TraceExportJobRepoImpl) GetTraceExportJob(ctx context.Context, workspaceID, id int64) (*entity.TraceExportJob, error) {
+	po, err := t.exportJobDao.GetTraceExportJob(ctx, workspaceID, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertor.TraceExportJobPO2DO(po), nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"errors"
+
+	metrics_entity "github.com/coze-dev/coze-loop/backend/modules/observability/domain/metric/entity"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/common"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/dao"
+	obErrorx "github.com/coze-dev/coze-loop/backend/modules/observability/pkg/errno"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/conv"
+	time_util "github.com/coze-dev/coze-loop/backend/pkg/time"
+)
+
+const (
+	sessionAliasID           = "session_id"
+	sessionAliasTurnCount    = "turn_count"
+	sessionAliasInputTokens  = "input_tokens"
+	sessionAliasOutputTokens = "output_tokens"
+	sessionAliasLatency      = "latency"
+	sessionAliasStartTime    = "start_time"
+	sessionAliasEndTime      = "end_time"
+)
+
+// sessionAggregations 会话维度的聚合，token只统计模型span，耗时为各轮根节点耗时之和
+var sessionAggregations = []*metrics_entity.Dimension{
+	{
+		Expression: &metrics_entity.Expression{
+			Expression: "uniq(%s)",
+			Fields: []*loop_span.FilterField{
+				{FieldName: loop_span.SpanFieldTraceId, FieldType: loop_span.FieldTypeString},
+			},
+		},
+		Alias: sessionAliasTurnCount,
+	},
+	{
+		Expression: &metrics_entity.Expression{
+			Expression: "sumIf(%s, %s IN ('model', 'LLMCall'))",
+			Fields: []*loop_span.FilterField{
+				{FieldName: loop_span.SpanFieldInputTokens, FieldType: loop_span.FieldTypeLong},
+				{FieldName: loop_span.SpanFieldSpanType, FieldType: loop_span.FieldTypeString},
+			},
+		},
+		Alias: sessionAliasInputTokens,
+	},
+	{
+		Expression: &metrics_entity.Expression{
+			Expression: "sumIf(%s, %s IN ('model', 'LLMCall'))",
+			Fields: []*loop_span.FilterField{
+				{FieldName: loop_span.SpanFieldOutputTokens, FieldType: loop_span.FieldTypeLong},
+				{FieldName: loop_span.SpanFieldSpanType, FieldType: loop_span.FieldTypeString},
+			},
+		},
+		Alias: sessionAliasOutputTokens,
+	},
+	{
+		Expression: &metrics_entity.Expression{
+			Expression: "intDiv(sumIf(%s, %s IN ('0', '')), 1000)",
+			Fields: []*loop_span.FilterField{
+				{FieldName: loop_span.SpanFieldDuration, FieldType: loop_span.FieldTypeLong},
+				{FieldName: loop_span.SpanFieldParentID, FieldType: loop_span.FieldTypeString},
+			},
+		},
+		Alias: sessionAliasLatency,
+	},
+	{
+		Expression: &metrics_entity.Expression{
+			Expression: "intDiv(min(%s), 1000)",
+			Fields: []*loop_span.FilterField{
+				{FieldName: loop_span.SpanFieldStartTime, FieldType: loop_span.FieldTypeLong},
+			},
+		},
+		Alias: sessionAliasStartTime,
+	},
+	{
+		Expression: &metrics_entity.Expression{
+			Expression: "intDiv(max(%s + %s), 1000)",
+			Fields: []*loop_span.FilterField{
+				{FieldName: loop_span.SpanFieldStartTime, FieldType: loop_span.FieldTypeLong},
+				{FieldName: loop_span.SpanFieldDuration, FieldType: loop_span.FieldTypeLong},
+			},
+		},
+		Alias: sessionAliasEndTime,
+	},
+}
+
+// ListSessions 按thread_id聚合span, 按最近活跃时间倒序返回
+func (t *TraceRepoImpl) ListSessions(ctx context.Context, param *repo.ListSessionsParam) (*repo.ListSessionsResult, error) {
+	spanStorage := t.storageProvider.GetTraceStorage(ctx, param.WorkSpaceID, param.Tenants)
+	spanDao := t.spanDaos[spanStorage.StorageName]
+	if spanDao == nil {
+		return nil, errorx.WrapByCode(errors.New("invalid storage"), obErrorx.CommercialCommonInvalidParamCodeCode)
+	}
+	tableCfg, err := t.getQueryTenantTables(ctx, param.Tenants)
+	if err != nil {
+		return nil, err
+	}
+	data, err := spanDao.GetMetrics(ctx, &dao.GetMetricsParam{
+		Tables:       tableCfg.SpanTables,
+		AnnoTableMap: tableCfg.AnnoTableMap,
+		Aggregations: sessionAggregations,
+		GroupBys: []*metrics_entity.Dimension{
+			{
+				Field: &loop_span.FilterField{
+					FieldName: loop_span.SpanFieldThreadID,
+					FieldType: loop_span.FieldTypeString,
+				},
+				Alias: sessionAliasID,
+			},
+		},
+		Filters:  param.Filters,
+		StartAt:  time_util.MillSec2MicroSec(param.StartAt),
+		EndAt:    time_util.MillSec2MicroSec(param.EndAt),
+		Extra:    spanStorage.StorageConfig,
+		OrderBys: []*common.OrderBy{{Field: sessionAliasEndTime}},
+		Limit:    param.Limit + 1,
+		Offset:   param.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &repo.ListSessionsResult{
+		Sessions: make([]*loop_span.Session, 0, len(data)),
+		HasMore:  len(data) > int(param.Limit),
+	}
+	if result.HasMore {
+		data = data[:param.Limit]
+	}
+	for _, row := range data {
+		result.Sessions = append(result.Sessions, &loop_span.Session{
+			SessionID:    conv.ToString(row[sessionAliasID]),
+			TurnCount:    sessionInt64(row[sessionAliasTurnCount]),
+			InputTokens:  sessionInt64(row[sessionAliasInputTokens]),
+			OutputTokens: sessionInt64(row[sessionAliasOutputTokens]),
+			Latency:      sessionInt64(row[sessionAliasLatency]),
+			StartTime:    sessionInt64(row[sessionAliasStartTime]),
+			EndTime:      sessionInt64(row[sessionAliasEndTime]),
+		})
+	}
+	return result, nil
+}
+
+func sessionInt64(v any) int64 {
+	ret, _ := conv.Int64(v)
+	return ret
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config"
+	confmocks "github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/config/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/entity/loop_span"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/trace/repo"
+	"github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/dao"
+	daomock "github.com/coze-dev/coze-loop/backend/modules/observability/infra/repo/dao/mocks"
+)
+
+func TestTraceRepoImpl_ListSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	spansDaoMock := daomock.NewMockISpansDao(ctrl)
+	traceConfigMock := confmocks.NewMockITraceConfig(ctrl)
+	traceConfigMock.EXPECT().GetTenantConfig(gomock.Any()).Return(&config.TenantCfg{
+		TenantTables: map[string]map[loop_span.TTL]config.TableCfg{
+			"spans": {
+				loop_span.TTL3d: {SpanTable: "observability_spans"},
+			},
+		},
+	}, nil)
+	spansDaoMock.EXPECT().GetMetrics(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, param *dao.GetMetricsParam) ([]map[string]any, error) {
+			assert.Equal(t, []string{"observability_spans"}, param.Tables)
+			assert.Equal(t, int32(3), param.Limit)
+			assert.Equal(t, int32(2), param.Offset)
+			assert.Equal(t, int64(1000000), param.StartAt)
+			assert.Equal(t, loop_span.SpanFieldThreadID, param.GroupBys[0].Field.FieldName)
+			return []map[string]any{
+				{"session_id": "s1", "turn_count": uint64(3), "input_tokens": int64(100), "output_tokens": int64(20), "latency": int64(1500), "start_time": int64(1000), "end_time": int64(9000)},
+				{"session_id": "s2", "turn_count": uint64(1)},
+				{"session_id": "s3", "turn_count": uint64(1)},
+			}, nil
+		})
+	r, err := NewTraceRepoImpl(traceConfigMock, &mockStorageProvider{}, nil, nil, nil, nil,
+		WithTraceStorageSpanDao("ck", spansDaoMock))
+	assert.NoError(t, err)
+
+	got, err := r.ListSessions(context.Background(), &repo.ListSessionsParam{
+		WorkSpaceID: "1",
+		Tenants:     []string{"spans"},
+		StartAt:     1000,
+		EndAt:       2000,
+		Limit:       2,
+		Offset:      2,
+	})
+	assert.NoError(t, err)
+	assert.True(t, got.HasMore)
+	assert.Equal(t, []*loop_span.Session{
+		{SessionID: "s1", TurnCount: 3, InputTokens: 100, OutputTokens: 20, Latency: 1500, StartTime: 1000, EndTime: 9000},
+		{SessionID: "s2", TurnCount: 1},
+	}, got.Sessions)
+}
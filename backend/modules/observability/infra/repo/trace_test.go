@@ -1465,6 +1465,37 @@ func TestTraceRepoImpl_getSpanInsertTable(t *testing.T) {
 			want:    "spans_test",
 			wantErr: false,
 		},
+		{
+			name: "fall back to table with longer ttl",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				traceConfigMock := confmocks.NewMockITraceConfig(ctrl)
+				traceConfigMock.EXPECT().GetTenantConfig(gomock.Any()).Return(&config.TenantCfg{
+					TenantTables: map[string]map[loop_span.TTL]config.TableCfg{
+						"test": {
+							loop_span.TTL3d: {
+								SpanTable: "spans_3d",
+							},
+							loop_span.TTL30d: {
+								SpanTable: "spans_30d",
+							},
+							loop_span.TTL365d: {
+								SpanTable: "spans_365d",
+							},
+						},
+					},
+				}, nil)
+				return fields{
+					traceConfig: traceConfigMock,
+				}
+			},
+			args: args{
+				ctx:    context.Background(),
+				tenant: "test",
+				ttl:    loop_span.TTL7d,
+			},
+			want:    "spans_30d",
+			wantErr: false,
+		},
 		{
 			name: "get span insert table with config error",
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
@@ -262,6 +262,26 @@ func (mr *MockClientMockRecorder) DeleteDatasetItem(ctx, req any, callOptions ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatasetItem", reflect.TypeOf((*MockClient)(nil).DeleteDatasetItem), varargs...)
 }
 
+// ExportDataset mocks base method.
+func (m *MockClient) ExportDataset(ctx context.Context, req *dataset.ExportDatasetRequest, callOptions ...callopt.Option) (*dataset.ExportDatasetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, req}
+	for _, a := range callOptions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportDataset", varargs...)
+	ret0, _ := ret[0].(*dataset.ExportDatasetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportDataset indicates an expected call of ExportDataset.
+func (mr *MockClientMockRecorder) ExportDataset(ctx, req any, callOptions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, req}, callOptions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportDataset", reflect.TypeOf((*MockClient)(nil).ExportDataset), varargs...)
+}
+
 // GetDataset mocks base method.
 func (m *MockClient) GetDataset(ctx context.Context, req *dataset.GetDatasetRequest, callOptions ...callopt.Option) (*dataset.GetDatasetResponse, error) {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/bytedance/gg/gptr"
+	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/common"
 	doevaluator "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/domain/evaluator"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/evaluator"
 	"github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/evaluation/evaluatorservice"
@@ -100,3 +101,34 @@ func (r *EvaluatorRPCAdapter) ListEvaluators(ctx context.Context, param *rpc.Lis
 	}
 	return evalInfos, nil
 }
+
+func (r *EvaluatorRPCAdapter) RunEvaluator(ctx context.Context, param *rpc.RunEvaluatorParam) (*rpc.EvaluatorRunResult, error) {
+	inputFields := make(map[string]*common.Content, len(param.InputFields))
+	for name, text := range param.InputFields {
+		inputFields[name] = &common.Content{
+			ContentType: gptr.Of(common.ContentTypeText),
+			Text:        gptr.Of(text),
+		}
+	}
+	resp, err := r.client.RunEvaluator(ctx, &evaluator.RunEvaluatorRequest{
+		WorkspaceID:        param.WorkspaceID,
+		EvaluatorVersionID: param.EvaluatorVersionID,
+		InputData: &doevaluator.EvaluatorInputData{
+			InputFields: inputFields,
+		},
+		Ext: param.Ext,
+	})
+	if err != nil {
+		logs.CtxWarn(ctx, "run evaluator failed: %v", err)
+		return nil, errorx.WrapByCode(err, obErrorx.CommercialCommonRPCErrorCodeCode)
+	}
+	record := resp.GetRecord()
+	output := record.GetEvaluatorOutputData()
+	return &rpc.EvaluatorRunResult{
+		EvaluatorRecordID: record.GetID(),
+		Score:             output.GetEvaluatorResult_().GetScore(),
+		Reasoning:         output.GetEvaluatorResult_().GetReasoning(),
+		Success:           record.GetStatus() == doevaluator.EvaluatorRunStatus_Success,
+		ErrorMsg:          output.GetEvaluatorRunError().GetMessage(),
+	}, nil
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/rpc"
+)
+
+type NotifyRPCAdapter struct{}
+
+func NewNotifyRPCAdapter() rpc.INotifyRPCAdapter {
+	return NotifyRPCAdapter{}
+}
+
+func (n NotifyRPCAdapter) SendMessageCard(ctx context.Context, userID, cardID string, param map[string]string) error {
+	return nil
+}
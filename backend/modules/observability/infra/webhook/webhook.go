@@ -0,0 +1,56 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/coze-dev/coze-loop/backend/modules/observability/domain/component/webhook"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+const (
+	defaultTimeout   = 10 * time.Second
+	maxErrorBodySize = 4096
+)
+
+type WebhookSenderImpl struct {
+	httpClient *http.Client
+}
+
+func NewWebhookSender() webhook.IWebhookSender {
+	return &WebhookSenderImpl{
+		httpClient: &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+func (w *WebhookSenderImpl) Send(ctx context.Context, url string, headers map[string]string, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return errorx.New("build webhook request failed, err=%v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return errorx.New("call webhook failed, err=%v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return errorx.New("webhook returned status %d, body=%s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
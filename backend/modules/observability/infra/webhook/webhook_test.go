@@ -0,0 +1,33 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSenderImpl_Send(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Token") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("unauthorized"))
+			return
+		}
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"status":"firing"}`, string(body))
+	}))
+	defer server.Close()
+
+	sender := NewWebhookSender()
+	body := map[string]string{"status": "firing"}
+	assert.NoError(t, sender.Send(context.Background(), server.URL, map[string]string{"X-Token": "token"}, body))
+	assert.ErrorContains(t, sender.Send(context.Background(), server.URL, nil, body), "unauthorized")
+}
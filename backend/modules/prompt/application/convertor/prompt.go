@@ -361,6 +361,45 @@ func ToolDTO2DO(dto *prompt.Tool) *entity.Tool {
 	return &entity.Tool{
 		Type:     ToolTypeDTO2DO(dto.GetType()),
 		Function: FunctionDTO2DO(dto.Function),
+		Executor: ToolExecutorDTO2DO(dto.Executor),
+	}
+}
+
+func ToolExecutorDTO2DO(dto *prompt.ToolExecutor) *entity.ToolExecutor {
+	if dto == nil {
+		return nil
+	}
+	return &entity.ToolExecutor{
+		Type: entity.ToolExecutorType(dto.GetType()),
+		HTTP: HTTPToolExecutorDTO2DO(dto.HTTP),
+		MCP:  MCPToolExecutorDTO2DO(dto.Mcp),
+	}
+}
+
+func HTTPToolExecutorDTO2DO(dto *prompt.HTTPToolExecutor) *entity.HTTPToolExecutor {
+	if dto == nil {
+		return nil
+	}
+	return &entity.HTTPToolExecutor{
+		URL:       dto.GetURL(),
+		Method:    dto.GetMethod(),
+		Headers:   dto.GetHeaders(),
+		TimeoutMS: dto.TimeoutMs,
+	}
+}
+
+func MCPToolExecutorDTO2DO(dto *prompt.MCPToolExecutor) *entity.MCPToolExecutor {
+	if dto == nil {
+		return nil
+	}
+	return &entity.MCPToolExecutor{
+		Transport: entity.MCPTransport(dto.GetTransport()),
+		URL:       dto.GetURL(),
+		Headers:   dto.GetHeaders(),
+		Command:   dto.GetCommand(),
+		Args:      dto.GetArgs_(),
+		ToolName:  dto.GetToolName(),
+		TimeoutMS: dto.TimeoutMs,
 	}
 }
 
@@ -1025,7 +1064,58 @@ func ToolDO2DTO(do *entity.Tool) *prompt.Tool {
 	return &prompt.Tool{
 		Type:     ptr.Of(prompt.ToolType(do.Type)),
 		Function: FunctionDO2DTO(do.Function),
+		Executor: ToolExecutorDO2DTO(do.Executor),
+	}
+}
+
+func ToolExecutorDO2DTO(do *entity.ToolExecutor) *prompt.ToolExecutor {
+	if do == nil {
+		return nil
+	}
+	return &prompt.ToolExecutor{
+		Type: ptr.Of(prompt.ToolExecutorType(do.Type)),
+		HTTP: HTTPToolExecutorDO2DTO(do.HTTP),
+		Mcp:  MCPToolExecutorDO2DTO(do.MCP),
+	}
+}
+
+func HTTPToolExecutorDO2DTO(do *entity.HTTPToolExecutor) *prompt.HTTPToolExecutor {
+	if do == nil {
+		return nil
+	}
+	return &prompt.HTTPToolExecutor{
+		URL:       ptr.Of(do.URL),
+		Method:    ptr.Of(do.Method),
+		Headers:   redactHeaders(do.Headers),
+		TimeoutMs: do.TimeoutMS,
+	}
+}
+
+func MCPToolExecutorDO2DTO(do *entity.MCPToolExecutor) *prompt.MCPToolExecutor {
+	if do == nil {
+		return nil
+	}
+	return &prompt.MCPToolExecutor{
+		Transport: ptr.Of(prompt.MCPTransport(do.Transport)),
+		URL:       ptr.Of(do.URL),
+		Headers:   redactHeaders(do.Headers),
+		Command:   ptr.Of(do.Command),
+		Args_:     do.Args,
+		ToolName:  ptr.Of(do.ToolName),
+		TimeoutMs: do.TimeoutMS,
+	}
+}
+
+// redactHeaders 请求头可能包含鉴权信息，仅返回请求头名称，保存时值为空的请求头沿用已保存的值
+func redactHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+	redacted := make(map[string]string, len(headers))
+	for k := range headers {
+		redacted[k] = ""
 	}
+	return redacted
 }
 
 func FunctionDO2DTO(do *entity.Function) *prompt.Function {
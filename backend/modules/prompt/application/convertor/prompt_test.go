@@ -1701,3 +1701,17 @@ func TestBatchParamConfigValueDO2DTO(t *testing.T) {
 		})
 	}
 }
+
+func TestToolExecutorDO2DTO_RedactHeaders(t *testing.T) {
+	httpDO := &entity.HTTPToolExecutor{URL: "https://a.example.com", Headers: map[string]string{"Authorization": "Bearer a"}}
+	mcpDO := &entity.MCPToolExecutor{Transport: entity.MCPTransportHTTP, URL: "https://mcp.example.com", Headers: map[string]string{"X-Api-Key": "key"}}
+
+	dto := ToolExecutorDO2DTO(&entity.ToolExecutor{Type: entity.ToolExecutorTypeHTTP, HTTP: httpDO, MCP: mcpDO})
+	assert.Equal(t, map[string]string{"Authorization": ""}, dto.GetHTTP().GetHeaders())
+	assert.Equal(t, map[string]string{"X-Api-Key": ""}, dto.GetMcp().GetHeaders())
+	// 不修改原始配置
+	assert.Equal(t, "Bearer a", httpDO.Headers["Authorization"])
+	assert.Equal(t, "key", mcpDO.Headers["X-Api-Key"])
+
+	assert.Nil(t, HTTPToolExecutorDO2DTO(&entity.HTTPToolExecutor{URL: "https://a.example.com"}).Headers)
+}
@@ -224,6 +224,11 @@ func (p *PromptDebugApplicationImpl) doDebugStreaming(ctx context.Context, req *
 	}
 	// construct prompt do
 	prompt := convertor.PromptDTO2DO(req.Prompt)
+	// 前端拿到的工具执行器请求头已脱敏
+	err = p.promptService.InheritToolExecutorSecrets(ctx, prompt, userID)
+	if err != nil {
+		return nil, err
+	}
 	// prompt hub span report
 	p.reportDebugPromptHubSpan(ctx, prompt)
 	// expand snippets
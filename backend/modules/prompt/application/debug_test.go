@@ -64,6 +64,7 @@ func TestPromptDebugApplicationImpl_DebugStreaming(t *testing.T) {
 				mockDebugLogRepo := repomocks.NewMockIDebugLogRepo(ctrl)
 				mockDebugLogRepo.EXPECT().SaveDebugLog(gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptSvc.EXPECT().InheritToolExecutorSecrets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc.EXPECT().ExpandSnippets(gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc.EXPECT().MCompleteMultiModalFileURL(gomock.Any(), gomock.Any(), nil).Return(nil)
 				mockPromptSvc.EXPECT().MConvertBase64DataURLToFileURL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -127,6 +128,7 @@ func TestPromptDebugApplicationImpl_DebugStreaming(t *testing.T) {
 				mockDebugLogRepo := repomocks.NewMockIDebugLogRepo(ctrl)
 				mockDebugLogRepo.EXPECT().SaveDebugLog(gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptSvc.EXPECT().InheritToolExecutorSecrets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc.EXPECT().ExpandSnippets(gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc.EXPECT().MCompleteMultiModalFileURL(gomock.Any(), gomock.Any(), nil).Return(nil)
 				mockPromptSvc.EXPECT().MConvertBase64DataURLToFileURL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -188,6 +190,7 @@ func TestPromptDebugApplicationImpl_DebugStreaming(t *testing.T) {
 			name: "expand snippets error",
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
 				mockPromptSvc := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptSvc.EXPECT().InheritToolExecutorSecrets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc.EXPECT().ExpandSnippets(gomock.Any(), gomock.Any()).Return(errorx.New("expand error"))
 
 				mockBenefitSvc := benefitmocks.NewMockIBenefitService(ctrl)
@@ -265,6 +268,7 @@ func TestPromptDebugApplicationImpl_DebugStreaming(t *testing.T) {
 				mockDebugLogRepo := repomocks.NewMockIDebugLogRepo(ctrl)
 				mockDebugLogRepo.EXPECT().SaveDebugLog(gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptSvc.EXPECT().InheritToolExecutorSecrets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc.EXPECT().ExpandSnippets(gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc.EXPECT().MCompleteMultiModalFileURL(gomock.Any(), gomock.Any(), nil).Return(nil)
 				mockPromptSvc.EXPECT().ExecuteStreaming(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param service.ExecuteStreamingParam) (*entity.Reply, error) {
@@ -333,6 +337,7 @@ func TestPromptDebugApplicationImpl_DebugStreaming(t *testing.T) {
 				mockDebugLogRepo := repomocks.NewMockIDebugLogRepo(ctrl)
 				mockDebugLogRepo.EXPECT().SaveDebugLog(gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc := servicemocks.NewMockIPromptService(ctrl)
+				mockPromptSvc.EXPECT().InheritToolExecutorSecrets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc.EXPECT().ExpandSnippets(gomock.Any(), gomock.Any()).Return(nil)
 				mockPromptSvc.EXPECT().MCompleteMultiModalFileURL(gomock.Any(), gomock.Any(), nil).Return(nil)
 				mockPromptSvc.EXPECT().MConvertBase64DataURLToFileURL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -534,6 +534,11 @@ func (app *PromptManageApplicationImpl) SaveDraft(ctx context.Context, request *
 	}
 	savingPromptDTO.PromptDraft.DraftInfo.UserID = ptr.Of(userID)
 	savingPromptDO := convertor.PromptDTO2DO(savingPromptDTO)
+	// 前端拿到的工具执行器请求头已脱敏
+	err = app.promptService.InheritToolExecutorSecrets(ctx, savingPromptDO, userID)
+	if err != nil {
+		return r, err
+	}
 
 	// save draft
 	draftInfoDO, err := app.promptService.SaveDraft(ctx, savingPromptDO)
@@ -2527,6 +2527,7 @@ func TestPromptManageApplicationImpl_SaveDraft(t *testing.T) {
 				auth := mocks.NewMockIAuthProvider(ctrl)
 				auth.EXPECT().MCheckPromptPermission(gomock.Any(), int64(30), []int64{int64(3)}, consts.ActionLoopPromptEdit).Return(nil)
 				promptSvc := servicemocks.NewMockIPromptService(ctrl)
+				promptSvc.EXPECT().InheritToolExecutorSecrets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				promptSvc.EXPECT().SaveDraft(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, promptDO *entity.Prompt) (*entity.DraftInfo, error) {
 					assert.Equal(t, "user", promptDO.PromptDraft.DraftInfo.UserID)
 					return &entity.DraftInfo{UserID: "user", IsModified: true}, nil
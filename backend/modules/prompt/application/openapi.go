@@ -409,6 +409,8 @@ func (p *PromptOpenAPIApplicationImpl) doExecute(ctx context.Context, req *opena
 		VariableVals: convertor.OpenAPIBatchVariableValDTO2DO(req.VariableVals),
 		SingleStep:   true,                 // PTaaS不支持非单步模式
 		Scenario:     entity.ScenarioPTaaS, // PTaaS场景
+		// 单步模式下自动执行绑定了执行器的工具
+		AutoExecuteTools: true,
 	})
 	if err != nil {
 		return promptDO, nil, err
@@ -534,6 +536,8 @@ func (p *PromptOpenAPIApplicationImpl) doExecuteStreaming(ctx context.Context, r
 				VariableVals: convertor.OpenAPIBatchVariableValDTO2DO(req.VariableVals),
 				SingleStep:   true,                 // PTaaS不支持非单步模式
 				Scenario:     entity.ScenarioPTaaS, // PTaaS场景
+				// 单步模式下自动执行绑定了执行器的工具
+				AutoExecuteTools: true,
 			},
 			ResultStream: resultStream,
 		})
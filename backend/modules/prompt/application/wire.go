@@ -31,6 +31,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/mysql"
 	rediscache "github.com/coze-dev/coze-loop/backend/modules/prompt/infra/repo/redis"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/infra/tool"
 	"github.com/coze-dev/coze-loop/backend/pkg/conf"
 )
 
@@ -61,6 +62,7 @@ var (
 		rpc.NewAuditRPCProvider,
 		collector.NewEventCollectorProvider,
 		service.NewCozeLoopSnippetParser,
+		tool.NewToolExecutor,
 	)
 	manageSet = wire.NewSet(
 		NewPromptManageApplication,
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	prompt "github.com/coze-dev/coze-loop/backend/kitex_gen/coze/loop/prompt/domain/prompt"
+	conf "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -103,6 +104,21 @@ func (mr *MockIConfigProviderMockRecorder) GetPromptLabelVersionCacheConfig(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromptLabelVersionCacheConfig", reflect.TypeOf((*MockIConfigProvider)(nil).GetPromptLabelVersionCacheConfig), ctx)
 }
 
+// GetToolExecutorConfig mocks base method.
+func (m *MockIConfigProvider) GetToolExecutorConfig(ctx context.Context) (*conf.ToolExecutorConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToolExecutorConfig", ctx)
+	ret0, _ := ret[0].(*conf.ToolExecutorConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToolExecutorConfig indicates an expected call of GetToolExecutorConfig.
+func (mr *MockIConfigProviderMockRecorder) GetToolExecutorConfig(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToolExecutorConfig", reflect.TypeOf((*MockIConfigProvider)(nil).GetToolExecutorConfig), ctx)
+}
+
 // ListPresetLabels mocks base method.
 func (m *MockIConfigProvider) ListPresetLabels() ([]string, error) {
 	m.ctrl.T.Helper()
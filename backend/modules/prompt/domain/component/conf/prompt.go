@@ -17,4 +17,13 @@ type IConfigProvider interface {
 	GetPromptDefaultConfig(ctx context.Context) (config *prompt.PromptDetail, err error)
 	ListPresetLabels() (presetLabels []string, err error)
 	GetPromptLabelVersionCacheConfig(ctx context.Context) (enable bool, ttl time.Duration, err error)
+	GetToolExecutorConfig(ctx context.Context) (config *ToolExecutorConfig, err error)
+}
+
+// ToolExecutorConfig 工具真实执行的配置
+type ToolExecutorConfig struct {
+	DefaultTimeoutMS int64    `mapstructure:"default_timeout_ms"`
+	MaxTimeoutMS     int64    `mapstructure:"max_timeout_ms"`
+	MaxResultBytes   int64    `mapstructure:"max_result_bytes"`
+	MCPStdioCommands []string `mapstructure:"mcp_stdio_commands"` // 允许以stdio方式启动的MCP server命令，为空时不允许stdio
 }
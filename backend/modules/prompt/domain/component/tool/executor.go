@@ -0,0 +1,16 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tool
+
+import (
+	"context"
+
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/executor.go -package=mocks . IToolExecutor
+type IToolExecutor interface {
+	// Execute 使用工具绑定的执行器执行一次工具调用，arguments为模型返回的调用参数，返回工具结果
+	Execute(ctx context.Context, tool *entity.Tool, arguments string) (result string, err error)
+}
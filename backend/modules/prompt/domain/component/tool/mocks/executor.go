@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/tool (interfaces: IToolExecutor)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/executor.go -package=mocks . IToolExecutor
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIToolExecutor is a mock of IToolExecutor interface.
+type MockIToolExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIToolExecutorMockRecorder
+	isgomock struct{}
+}
+
+// MockIToolExecutorMockRecorder is the mock recorder for MockIToolExecutor.
+type MockIToolExecutorMockRecorder struct {
+	mock *MockIToolExecutor
+}
+
+// NewMockIToolExecutor creates a new mock instance.
+func NewMockIToolExecutor(ctrl *gomock.Controller) *MockIToolExecutor {
+	mock := &MockIToolExecutor{ctrl: ctrl}
+	mock.recorder = &MockIToolExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIToolExecutor) EXPECT() *MockIToolExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIToolExecutor) Execute(ctx context.Context, tool *entity.Tool, arguments string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, tool, arguments)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIToolExecutorMockRecorder) Execute(ctx, tool, arguments any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIToolExecutor)(nil).Execute), ctx, tool, arguments)
+}
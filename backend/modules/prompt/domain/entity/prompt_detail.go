@@ -138,8 +138,17 @@ type VariableVal struct {
 }
 
 type Tool struct {
-	Type     ToolType  `json:"type"`
-	Function *Function `json:"function,omitempty"`
+	Type     ToolType      `json:"type"`
+	Function *Function     `json:"function,omitempty"`
+	Executor *ToolExecutor `json:"executor,omitempty"` // 绑定的真实执行器，未绑定时使用mock结果
+}
+
+// GetExecutor 仅function类型的工具支持绑定执行器
+func (t *Tool) GetExecutor() *ToolExecutor {
+	if t == nil || t.Type != ToolTypeFunction || t.Function == nil || t.Executor == nil {
+		return nil
+	}
+	return t.Executor
 }
 
 type ToolType string
@@ -155,6 +164,106 @@ type Function struct {
 	Parameters  string `json:"parameters"`
 }
 
+type ToolExecutorType string
+
+const (
+	ToolExecutorTypeHTTP ToolExecutorType = "http"
+	ToolExecutorTypeMCP  ToolExecutorType = "mcp"
+)
+
+type ToolExecutor struct {
+	Type ToolExecutorType  `json:"type"`
+	HTTP *HTTPToolExecutor `json:"http,omitempty"`
+	MCP  *MCPToolExecutor  `json:"mcp,omitempty"`
+}
+
+// endpoint 返回执行器的请求地址与请求头，请求头可能包含鉴权信息
+func (e *ToolExecutor) endpoint() (url string, headers map[string]string) {
+	switch {
+	case e == nil:
+		return "", nil
+	case e.Type == ToolExecutorTypeHTTP && e.HTTP != nil:
+		return e.HTTP.URL, e.HTTP.Headers
+	case e.Type == ToolExecutorTypeMCP && e.MCP != nil:
+		return e.MCP.URL, e.MCP.Headers
+	default:
+		return "", nil
+	}
+}
+
+// HasToolExecutorHeaders 是否有工具执行器配置了请求头
+func (d *PromptDetail) HasToolExecutorHeaders() bool {
+	if d == nil {
+		return false
+	}
+	for _, t := range d.Tools {
+		if _, headers := t.GetExecutor().endpoint(); len(headers) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// InheritToolExecutorSecrets 向前端返回的工具执行器请求头值会被清空，保存时值为空的请求头沿用old中同名工具的值。
+// 执行器类型或请求地址变化时不沿用，避免已保存的鉴权信息被发往新的地址
+func (d *PromptDetail) InheritToolExecutorSecrets(old *PromptDetail) {
+	if d == nil || old == nil {
+		return
+	}
+	oldExecutors := make(map[string]*ToolExecutor, len(old.Tools))
+	for _, t := range old.Tools {
+		if executor := t.GetExecutor(); executor != nil {
+			oldExecutors[t.Function.Name] = executor
+		}
+	}
+	for _, t := range d.Tools {
+		executor := t.GetExecutor()
+		if executor == nil {
+			continue
+		}
+		oldExecutor, ok := oldExecutors[t.Function.Name]
+		if !ok || executor.Type != oldExecutor.Type {
+			continue
+		}
+		url, headers := executor.endpoint()
+		oldURL, oldHeaders := oldExecutor.endpoint()
+		if url != oldURL {
+			continue
+		}
+		for k, v := range headers {
+			if oldV, ok := oldHeaders[k]; ok && v == "" {
+				headers[k] = oldV
+			}
+		}
+	}
+}
+
+// HTTPToolExecutor 以工具调用参数作为请求体调用HTTP接口，响应体作为工具结果
+type HTTPToolExecutor struct {
+	URL       string            `json:"url"`
+	Method    string            `json:"method,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	TimeoutMS *int64            `json:"timeout_ms,omitempty"`
+}
+
+type MCPTransport string
+
+const (
+	MCPTransportStdio MCPTransport = "stdio"
+	MCPTransportHTTP  MCPTransport = "http"
+)
+
+// MCPToolExecutor 通过Model Context Protocol的tools/call调用MCP server上的工具
+type MCPToolExecutor struct {
+	Transport MCPTransport      `json:"transport"`
+	URL       string            `json:"url,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Command   string            `json:"command,omitempty"`
+	Args      []string          `json:"args,omitempty"`
+	ToolName  string            `json:"tool_name,omitempty"` // 为空时使用function name
+	TimeoutMS *int64            `json:"timeout_ms,omitempty"`
+}
+
 type ToolCallConfig struct {
 	ToolChoice              ToolChoiceType           `json:"tool_choice"`
 	ToolChoiceSpecification *ToolChoiceSpecification `json:"tool_choice_specification,omitempty"`
@@ -1497,3 +1497,43 @@ func TestFormatText_GoTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestPromptDetail_InheritToolExecutorSecrets(t *testing.T) {
+	newTool := func(name string, executor *ToolExecutor) *Tool {
+		return &Tool{Type: ToolTypeFunction, Function: &Function{Name: name}, Executor: executor}
+	}
+	old := &PromptDetail{Tools: []*Tool{
+		newTool("http_tool", &ToolExecutor{Type: ToolExecutorTypeHTTP, HTTP: &HTTPToolExecutor{
+			URL: "https://a.example.com", Headers: map[string]string{"Authorization": "Bearer a", "X-Trace": "t"},
+		}}),
+		newTool("mcp_tool", &ToolExecutor{Type: ToolExecutorTypeMCP, MCP: &MCPToolExecutor{
+			Transport: MCPTransportHTTP, URL: "https://mcp.example.com", Headers: map[string]string{"Authorization": "Bearer mcp"},
+		}}),
+		newTool("moved_tool", &ToolExecutor{Type: ToolExecutorTypeHTTP, HTTP: &HTTPToolExecutor{
+			URL: "https://old.example.com", Headers: map[string]string{"Authorization": "Bearer old"},
+		}}),
+	}}
+	d := &PromptDetail{Tools: []*Tool{
+		newTool("http_tool", &ToolExecutor{Type: ToolExecutorTypeHTTP, HTTP: &HTTPToolExecutor{
+			URL: "https://a.example.com", Headers: map[string]string{"Authorization": "", "X-Trace": "new", "X-New": ""},
+		}}),
+		newTool("mcp_tool", &ToolExecutor{Type: ToolExecutorTypeMCP, MCP: &MCPToolExecutor{
+			Transport: MCPTransportHTTP, URL: "https://mcp.example.com", Headers: map[string]string{"Authorization": ""},
+		}}),
+		// 请求地址变化时不沿用已保存的鉴权信息
+		newTool("moved_tool", &ToolExecutor{Type: ToolExecutorTypeHTTP, HTTP: &HTTPToolExecutor{
+			URL: "https://evil.example.com", Headers: map[string]string{"Authorization": ""},
+		}}),
+		{Type: ToolTypeGoogleSearch},
+	}}
+	assert.True(t, d.HasToolExecutorHeaders())
+	assert.False(t, (&PromptDetail{Tools: []*Tool{{Type: ToolTypeGoogleSearch}}}).HasToolExecutorHeaders())
+
+	d.InheritToolExecutorSecrets(old)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer a", "X-Trace": "new", "X-New": ""}, d.Tools[0].Executor.HTTP.Headers)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer mcp"}, d.Tools[1].Executor.MCP.Headers)
+	assert.Equal(t, map[string]string{"Authorization": ""}, d.Tools[2].Executor.HTTP.Headers)
+
+	// old为空时不做处理
+	d.InheritToolExecutorSecrets(nil)
+}
@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"slices"
 	"strconv"
 	"time"
@@ -46,6 +47,8 @@ type ExecuteParam struct {
 
 	Scenario       entity.Scenario
 	DisableTracing bool
+	// AutoExecuteTools 单步模式下，模型返回的工具调用都绑定了执行器时自动执行工具并继续请求模型
+	AutoExecuteTools bool
 }
 
 type ExecuteStreamingParam struct {
@@ -86,7 +89,8 @@ func (p *PromptServiceImpl) ExecuteStreaming(ctx context.Context, param ExecuteS
 		if err != nil {
 			return nil, err
 		}
-		aggregatedReply, err = p.doStreamingIteration(ctx, param, replyItemWrapper)
+		var toolResults []*entity.Message
+		aggregatedReply, toolResults, err = p.doStreamingIteration(ctx, param, startTime, debugStep, replyItemWrapper)
 		if err != nil {
 			return nil, err
 		}
@@ -95,15 +99,13 @@ func (p *PromptServiceImpl) ExecuteStreaming(ctx context.Context, param ExecuteS
 			tokenUsage.OutputTokens += aggregatedReply.Item.TokenUsage.OutputTokens
 		}
 
-		if !shouldContinue(param.SingleStep, startTime, debugStep, aggregatedReply) {
+		// 只有需要继续执行时才会返回工具结果
+		if len(toolResults) == 0 {
 			break
 		}
 		debugStep++
 		// 多轮执行需要重新编排上下文
-		param.Messages, err = reorganizeContexts(param.Messages, param.MockTools, aggregatedReply)
-		if err != nil {
-			return nil, err
-		}
+		param.Messages = reorganizeContexts(param.Messages, aggregatedReply, toolResults)
 	}
 	if aggregatedReply != nil && aggregatedReply.Item != nil {
 		aggregatedReply.Item.TokenUsage = tokenUsage
@@ -130,7 +132,8 @@ func (p *PromptServiceImpl) Execute(ctx context.Context, param ExecuteParam) (re
 		if err != nil {
 			return nil, err
 		}
-		reply, err = p.doIteration(ctx, param, replyItemWrapper)
+		var toolResults []*entity.Message
+		reply, toolResults, err = p.doIteration(ctx, param, startTime, debugStep, replyItemWrapper)
 		if err != nil {
 			return nil, err
 		}
@@ -139,15 +142,13 @@ func (p *PromptServiceImpl) Execute(ctx context.Context, param ExecuteParam) (re
 			tokenUsage.OutputTokens += reply.Item.TokenUsage.OutputTokens
 		}
 
-		if !shouldContinue(param.SingleStep, startTime, debugStep, reply) {
+		// 只有需要继续执行时才会返回工具结果
+		if len(toolResults) == 0 {
 			break
 		}
 		debugStep++
 		// 多轮执行需要重新编排上下文
-		param.Messages, err = reorganizeContexts(param.Messages, param.MockTools, reply)
-		if err != nil {
-			return nil, err
-		}
+		param.Messages = reorganizeContexts(param.Messages, reply, toolResults)
 	}
 	if reply != nil && reply.Item != nil {
 		reply.Item.TokenUsage = tokenUsage
@@ -155,7 +156,9 @@ func (p *PromptServiceImpl) Execute(ctx context.Context, param ExecuteParam) (re
 	return reply, nil
 }
 
-func (p *PromptServiceImpl) doStreamingIteration(ctx context.Context, param ExecuteStreamingParam, replyItemWrapper func(v *entity.ReplyItem) *entity.Reply) (aggregatedReply *entity.Reply, err error) {
+func (p *PromptServiceImpl) doStreamingIteration(ctx context.Context, param ExecuteStreamingParam, startTime time.Time, debugStep int32,
+	replyItemWrapper func(v *entity.ReplyItem) *entity.Reply,
+) (aggregatedReply *entity.Reply, toolResults []*entity.Message, err error) {
 	var span cozeloop.Span
 	if !param.DisableTracing {
 		ctx, span = p.startSequenceSpan(ctx, param.Prompt, param.Messages, param.VariableVals)
@@ -166,7 +169,7 @@ func (p *PromptServiceImpl) doStreamingIteration(ctx context.Context, param Exec
 	var llmCallParam rpc.LLMCallParam
 	llmCallParam, err = p.prepareLLMCallParam(ctx, param.ExecuteParam)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	var aggregatedResult *entity.ReplyItem
 
@@ -200,18 +203,18 @@ func (p *PromptServiceImpl) doStreamingIteration(ctx context.Context, param Exec
 	select { //nolint:staticcheck
 	case err = <-errChan:
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	if !param.DisableTracing {
-		// report tool call span
-		p.reportToolSpan(ctx, param.Prompt, param.MockTools, aggregatedResult)
-	}
-	return replyItemWrapper(aggregatedResult), nil
+	aggregatedReply = replyItemWrapper(aggregatedResult)
+	toolResults = p.handleToolCalls(ctx, param.ExecuteParam, aggregatedResult, shouldContinue(param.ExecuteParam, startTime, debugStep, aggregatedReply))
+	return aggregatedReply, toolResults, nil
 }
 
-func (p *PromptServiceImpl) doIteration(ctx context.Context, param ExecuteParam, replyItemWrapper func(v *entity.ReplyItem) *entity.Reply) (aggregatedReply *entity.Reply, err error) {
+func (p *PromptServiceImpl) doIteration(ctx context.Context, param ExecuteParam, startTime time.Time, debugStep int32,
+	replyItemWrapper func(v *entity.ReplyItem) *entity.Reply,
+) (aggregatedReply *entity.Reply, toolResults []*entity.Message, err error) {
 	var span cozeloop.Span
 	if !param.DisableTracing {
 		ctx, span = p.startSequenceSpan(ctx, param.Prompt, param.Messages, param.VariableVals)
@@ -222,18 +225,16 @@ func (p *PromptServiceImpl) doIteration(ctx context.Context, param ExecuteParam,
 	var llmCallParam rpc.LLMCallParam
 	llmCallParam, err = p.prepareLLMCallParam(ctx, param)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	var aggregatedResult *entity.ReplyItem
 	aggregatedResult, err = p.llm.Call(ctx, llmCallParam)
 	if err != nil {
-		return nil, err
-	}
-	if !param.DisableTracing {
-		// tool call处理
-		p.reportToolSpan(ctx, param.Prompt, param.MockTools, aggregatedResult)
+		return nil, nil, err
 	}
-	return replyItemWrapper(aggregatedResult), nil
+	aggregatedReply = replyItemWrapper(aggregatedResult)
+	toolResults = p.handleToolCalls(ctx, param, aggregatedResult, shouldContinue(param, startTime, debugStep, aggregatedReply))
+	return aggregatedReply, toolResults, nil
 }
 
 func getReplyItemWrapper(debugID int64, debugStep int32) (func(v *entity.ReplyItem) *entity.Reply, error) {
@@ -278,62 +279,94 @@ func (p *PromptServiceImpl) getDebugIDAndStep(ctx context.Context, singleStepDeb
 	return traceID, traceStep, nil
 }
 
-func (p *PromptServiceImpl) reportToolSpan(ctx context.Context, prompt *entity.Prompt, mockTools []*entity.MockTool, result *entity.ReplyItem) {
+// handleToolCalls 处理模型返回的工具调用并上报工具span。
+// execute为true时需要继续执行，绑定了执行器的工具会被真实调用，其余工具使用mock结果，返回按调用顺序排列的工具结果；
+// execute为false时只使用mock结果上报span，不返回工具结果。
+func (p *PromptServiceImpl) handleToolCalls(ctx context.Context, param ExecuteParam, result *entity.ReplyItem, execute bool) (toolResults []*entity.Message) {
 	if result == nil || result.Message == nil || len(result.Message.ToolCalls) == 0 {
-		return
+		return nil
 	}
-	var spaceID int64
-	var promptKey, version string
-	if prompt != nil {
-		spaceID = prompt.SpaceID
-		promptKey = prompt.PromptKey
-		version = prompt.GetVersion()
-	}
-	mockToolResponseMap := loopslices.ToMap(mockTools, func(m *entity.MockTool) (string, string) {
+	mockToolResponseMap := loopslices.ToMap(param.MockTools, func(m *entity.MockTool) (string, string) {
 		if m == nil {
 			return "", ""
 		}
 		return m.Name, m.MockResponse
 	})
+	toolMap := getPromptToolMap(param.Prompt)
 	for _, toolCall := range result.Message.ToolCalls {
-		if toolCall != nil && toolCall.FunctionCall != nil {
-			var span looptracer.Span
-			ctx, span = looptracer.GetTracer().StartSpan(ctx, toolCall.FunctionCall.Name, tracespec.VToolSpanType, looptracer.WithSpanWorkspaceID(strconv.FormatInt(spaceID, 10)))
-			if span != nil {
-				span.SetPrompt(ctx, loopentity.Prompt{PromptKey: promptKey, Version: version})
-				span.SetInput(ctx, toolCall.FunctionCall.Arguments)
-				span.SetOutput(ctx, mockToolResponseMap[toolCall.FunctionCall.Name])
-				span.Finish(ctx)
+		if toolCall == nil || toolCall.FunctionCall == nil {
+			continue
+		}
+		startTime := time.Now()
+		output := mockToolResponseMap[toolCall.FunctionCall.Name]
+		var toolErr error
+		if tool := toolMap[toolCall.FunctionCall.Name]; execute && tool.GetExecutor() != nil && p.toolExecutor != nil {
+			output, toolErr = p.toolExecutor.Execute(ctx, tool, ptr.From(toolCall.FunctionCall.Arguments))
+			if toolErr != nil {
+				logs.CtxWarn(ctx, "execute tool %s failed, err=%v", toolCall.FunctionCall.Name, toolErr)
+				// 工具执行失败时把错误作为工具结果返回给模型
+				output = fmt.Sprintf("tool execution failed: %s", errorx.ErrorWithoutStack(toolErr))
 			}
 		}
+		if !param.DisableTracing {
+			p.reportToolSpan(ctx, param.Prompt, toolCall, startTime, output, toolErr)
+		}
+		if execute {
+			toolResults = append(toolResults, &entity.Message{
+				Role:       entity.RoleTool,
+				ToolCallID: ptr.Of(toolCall.ID),
+				Content:    ptr.Of(output),
+			})
+		}
+	}
+	return toolResults
+}
+
+func (p *PromptServiceImpl) reportToolSpan(ctx context.Context, prompt *entity.Prompt, toolCall *entity.ToolCall, startTime time.Time, output string, toolErr error) {
+	var spaceID int64
+	var promptKey, version string
+	if prompt != nil {
+		spaceID = prompt.SpaceID
+		promptKey = prompt.PromptKey
+		version = prompt.GetVersion()
+	}
+	_, span := looptracer.GetTracer().StartSpan(ctx, toolCall.FunctionCall.Name, tracespec.VToolSpanType,
+		looptracer.WithSpanWorkspaceID(strconv.FormatInt(spaceID, 10)), looptracer.WithStartTime(startTime))
+	if span == nil {
+		return
+	}
+	span.SetPrompt(ctx, loopentity.Prompt{PromptKey: promptKey, Version: version})
+	span.SetInput(ctx, toolCall.FunctionCall.Arguments)
+	span.SetOutput(ctx, output)
+	if toolErr != nil {
+		span.SetStatusCode(ctx, int(traceutil.GetTraceStatusCode(toolErr)))
+		span.SetError(ctx, errors.New(errorx.ErrorWithoutStack(toolErr)))
+	}
+	span.Finish(ctx)
+}
+
+// getPromptToolMap 按function name索引prompt中的工具
+func getPromptToolMap(prompt *entity.Prompt) map[string]*entity.Tool {
+	promptDetail := prompt.GetPromptDetail()
+	if promptDetail == nil {
+		return nil
+	}
+	toolMap := make(map[string]*entity.Tool, len(promptDetail.Tools))
+	for _, tool := range promptDetail.Tools {
+		if tool != nil && tool.Function != nil {
+			toolMap[tool.Function.Name] = tool
+		}
 	}
+	return toolMap
 }
 
-func reorganizeContexts(contexts []*entity.Message, mockTools []*entity.MockTool, reply *entity.Reply) ([]*entity.Message, error) {
+func reorganizeContexts(contexts []*entity.Message, reply *entity.Reply, toolResults []*entity.Message) []*entity.Message {
 	newContexts := slices.Clone(contexts)
 	if reply == nil || reply.Item == nil || reply.Item.Message == nil {
-		return newContexts, nil
+		return newContexts
 	}
 	newContexts = append(newContexts, reply.Item.Message)
-	if len(reply.Item.Message.ToolCalls) > 0 {
-		// 如果有工具调用，则需要mock response
-		mockToolResponseMap := loopslices.ToMap(mockTools, func(m *entity.MockTool) (string, string) {
-			if m == nil {
-				return "", ""
-			}
-			return m.Name, m.MockResponse
-		})
-		for _, toolCall := range reply.Item.Message.ToolCalls {
-			if toolCall.FunctionCall != nil {
-				newContexts = append(newContexts, &entity.Message{
-					Role:       entity.RoleTool,
-					ToolCallID: ptr.Of(toolCall.ID),
-					Content:    ptr.Of(mockToolResponseMap[toolCall.FunctionCall.Name]),
-				})
-			}
-		}
-	}
-	return newContexts, nil
+	return append(newContexts, toolResults...)
 }
 
 func (p *PromptServiceImpl) startSequenceSpan(ctx context.Context, prompt *entity.Prompt, messages []*entity.Message, variableVals []*entity.VariableVal) (context.Context, cozeloop.Span) {
@@ -453,8 +486,9 @@ func decodeDebugIDAndStep(debugTraceKey string) (int64, int32, error) {
 	return info.DebugID, info.DebugStep, nil
 }
 
-func shouldContinue(singleStep bool, startTime time.Time, currentStep int32, lastStepAggregatedReply *entity.Reply) bool {
-	if singleStep {
+// shouldContinue 单步模式下只有开启AutoExecuteTools且所有工具调用都绑定了执行器时才继续执行
+func shouldContinue(param ExecuteParam, startTime time.Time, currentStep int32, lastStepAggregatedReply *entity.Reply) bool {
+	if param.SingleStep && (!param.AutoExecuteTools || !allToolCallsExecutable(param.Prompt, lastStepAggregatedReply)) {
 		return false
 	}
 	if currentStep >= maxIterations {
@@ -468,3 +502,16 @@ func shouldContinue(singleStep bool, startTime time.Time, currentStep int32, las
 	}
 	return len(lastStepAggregatedReply.Item.Message.ToolCalls) > 0
 }
+
+func allToolCallsExecutable(prompt *entity.Prompt, reply *entity.Reply) bool {
+	if reply == nil || reply.Item == nil || reply.Item.Message == nil || len(reply.Item.Message.ToolCalls) == 0 {
+		return false
+	}
+	toolMap := getPromptToolMap(prompt)
+	for _, toolCall := range reply.Item.Message.ToolCalls {
+		if toolCall == nil || toolCall.FunctionCall == nil || toolMap[toolCall.FunctionCall.Name].GetExecutor() == nil {
+			return false
+		}
+	}
+	return true
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/rpc"
 	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/rpc/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/tool"
+	toolmocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/tool/mocks"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo"
 	prompterr "github.com/coze-dev/coze-loop/backend/modules/prompt/pkg/errno"
@@ -646,6 +648,7 @@ func TestPromptServiceImpl_Execute(t *testing.T) {
 		configProvider   conf.IConfigProvider
 		llm              rpc.ILLMProvider
 		file             rpc.IFileProvider
+		toolExecutor     tool.IToolExecutor
 	}
 	type args struct {
 		ctx   context.Context
@@ -832,6 +835,117 @@ func TestPromptServiceImpl_Execute(t *testing.T) {
 				DebugStep: 2,
 			},
 		},
+		{
+			name: "single step auto execute bound tools",
+			fieldsGetter: func(ctrl *gomock.Controller) fields {
+				mockIDGen := idgenmocks.NewMockIIDGenerator(ctrl)
+				mockIDGen.EXPECT().GenID(gomock.Any()).Return(int64(123456789), nil)
+				mockLLM := rpcmocks.NewMockILLMProvider(ctrl)
+				mockLLM.EXPECT().Call(gomock.Any(), gomock.Any()).Return(&entity.ReplyItem{
+					Message: &entity.Message{
+						Role: entity.RoleAssistant,
+						ToolCalls: []*entity.ToolCall{
+							{
+								Index: 0,
+								ID:    "call_123456",
+								Type:  entity.ToolTypeFunction,
+								FunctionCall: &entity.FunctionCall{
+									Name:      "get_weather",
+									Arguments: ptr.Of(`{"location": "New York"}`),
+								},
+							},
+						},
+					},
+					FinishReason: "tool_calls",
+					TokenUsage: &entity.TokenUsage{
+						InputTokens:  20,
+						OutputTokens: 10,
+					},
+				}, nil)
+				mockLLM.EXPECT().Call(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, param rpc.LLMCallParam) (*entity.ReplyItem, error) {
+					assert.Equal(t, 4, len(param.Messages))
+					assert.Equal(t, entity.RoleTool, param.Messages[3].Role)
+					assert.Equal(t, "call_123456", ptr.From(param.Messages[3].ToolCallID))
+					assert.Equal(t, `{"weather":"rainy"}`, ptr.From(param.Messages[3].Content))
+					return &entity.ReplyItem{
+						Message: &entity.Message{
+							Role:    entity.RoleAssistant,
+							Content: ptr.Of("rainy"),
+						},
+						FinishReason: "stop",
+						TokenUsage: &entity.TokenUsage{
+							InputTokens:  10,
+							OutputTokens: 5,
+						},
+					}, nil
+				})
+				mockToolExecutor := toolmocks.NewMockIToolExecutor(ctrl)
+				mockToolExecutor.EXPECT().Execute(gomock.Any(), gomock.Any(), `{"location": "New York"}`).Return(`{"weather":"rainy"}`, nil)
+				return fields{
+					llm:          mockLLM,
+					idgen:        mockIDGen,
+					toolExecutor: mockToolExecutor,
+				}
+			},
+			args: args{
+				ctx: context.Background(),
+				param: ExecuteParam{
+					Prompt: &entity.Prompt{
+						ID:        1,
+						SpaceID:   123,
+						PromptKey: "test_prompt",
+						PromptDraft: &entity.PromptDraft{
+							PromptDetail: &entity.PromptDetail{
+								PromptTemplate: &entity.PromptTemplate{
+									TemplateType: entity.TemplateTypeNormal,
+									Messages: []*entity.Message{
+										{
+											Role:    entity.RoleSystem,
+											Content: ptr.Of("You are a helpful assistant."),
+										},
+									},
+								},
+								Tools: []*entity.Tool{
+									{
+										Type: entity.ToolTypeFunction,
+										Function: &entity.Function{
+											Name: "get_weather",
+										},
+										Executor: &entity.ToolExecutor{
+											Type: entity.ToolExecutorTypeHTTP,
+											HTTP: &entity.HTTPToolExecutor{URL: "http://localhost/weather"},
+										},
+									},
+								},
+							},
+						},
+					},
+					Messages: []*entity.Message{
+						{
+							Role:    entity.RoleUser,
+							Content: ptr.Of("What's the weather in New York?"),
+						},
+					},
+					SingleStep:       true,
+					AutoExecuteTools: true,
+				},
+			},
+			wantReply: &entity.Reply{
+				Item: &entity.ReplyItem{
+					Message: &entity.Message{
+						Role:    entity.RoleAssistant,
+						Content: ptr.Of("rainy"),
+					},
+					FinishReason: "stop",
+					TokenUsage: &entity.TokenUsage{
+						InputTokens:  30,
+						OutputTokens: 15,
+					},
+				},
+				DebugID:   123456789,
+				DebugStep: 2,
+			},
+		},
 		{
 			name: "error_llm_call_failed",
 			fieldsGetter: func(ctrl *gomock.Controller) fields {
@@ -930,6 +1044,7 @@ func TestPromptServiceImpl_Execute(t *testing.T) {
 				configProvider:   ttFields.configProvider,
 				llm:              ttFields.llm,
 				file:             ttFields.file,
+				toolExecutor:     ttFields.toolExecutor,
 			}
 
 			gotReply, err := p.Execute(tt.args.ctx, tt.args.param)
@@ -468,6 +468,45 @@ func (p *PromptServiceImpl) SaveDraft(ctx context.Context, promptDO *entity.Prom
 	return draftInfo, nil
 }
 
+// InheritToolExecutorSecrets 草稿沿用用户已保存的草稿，没有草稿时沿用草稿的基线版本；提交版本沿用对应的提交
+func (p *PromptServiceImpl) InheritToolExecutorSecrets(ctx context.Context, promptDO *entity.Prompt, userID string) error {
+	if promptDO == nil || promptDO.ID <= 0 {
+		return nil
+	}
+	detail := promptDO.GetPromptDetail()
+	if !detail.HasToolExecutorHeaders() {
+		return nil
+	}
+	var commitVersion string
+	switch {
+	case promptDO.PromptDraft != nil && promptDO.PromptDraft.PromptDetail != nil:
+		if userID != "" {
+			stored, err := p.manageRepo.GetPrompt(ctx, repo.GetPromptParam{PromptID: promptDO.ID, WithDraft: true, UserID: userID})
+			if err != nil {
+				return err
+			}
+			if stored.PromptDraft != nil {
+				detail.InheritToolExecutorSecrets(stored.PromptDraft.PromptDetail)
+				return nil
+			}
+		}
+		if promptDO.PromptDraft.DraftInfo != nil {
+			commitVersion = promptDO.PromptDraft.DraftInfo.BaseVersion
+		}
+	case promptDO.PromptCommit != nil:
+		commitVersion = promptDO.GetVersion()
+	}
+	if commitVersion == "" {
+		return nil
+	}
+	stored, err := p.manageRepo.GetPrompt(ctx, repo.GetPromptParam{PromptID: promptDO.ID, WithCommit: true, CommitVersion: commitVersion})
+	if err != nil {
+		return err
+	}
+	detail.InheritToolExecutorSecrets(stored.GetPromptDetail())
+	return nil
+}
+
 // ExpandSnippets expands all snippet references in the prompt's messages
 func (p *PromptServiceImpl) ExpandSnippets(ctx context.Context, promptDO *entity.Prompt) error {
 	maxDepth := 2
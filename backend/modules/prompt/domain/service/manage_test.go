@@ -332,6 +332,111 @@ func TestPromptServiceImpl_SaveDraft(t *testing.T) {
 	}
 }
 
+func TestPromptServiceImpl_InheritToolExecutorSecrets(t *testing.T) {
+	t.Parallel()
+	detailWithHeader := func(value string) *entity.PromptDetail {
+		return &entity.PromptDetail{Tools: []*entity.Tool{{
+			Type:     entity.ToolTypeFunction,
+			Function: &entity.Function{Name: "get_weather"},
+			Executor: &entity.ToolExecutor{
+				Type: entity.ToolExecutorTypeHTTP,
+				HTTP: &entity.HTTPToolExecutor{URL: "https://weather.example.com", Headers: map[string]string{"Authorization": value}},
+			},
+		}}}
+	}
+	headerOf := func(prompt *entity.Prompt) string {
+		return prompt.GetPromptDetail().Tools[0].Executor.HTTP.Headers["Authorization"]
+	}
+	tests := []struct {
+		name           string
+		manageRepoFunc func(m *repomocks.MockIManageRepo)
+		promptDO       *entity.Prompt
+		userID         string
+		wantHeader     string
+		wantErr        error
+	}{
+		{
+			name: "draft inherits from stored draft",
+			manageRepoFunc: func(m *repomocks.MockIManageRepo) {
+				m.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{PromptID: 1, WithDraft: true, UserID: "u1"}).Return(&entity.Prompt{
+					ID:          1,
+					PromptDraft: &entity.PromptDraft{PromptDetail: detailWithHeader("Bearer draft")},
+				}, nil)
+			},
+			promptDO: &entity.Prompt{ID: 1, PromptDraft: &entity.PromptDraft{
+				DraftInfo:    &entity.DraftInfo{BaseVersion: "1.0.0"},
+				PromptDetail: detailWithHeader(""),
+			}},
+			userID:     "u1",
+			wantHeader: "Bearer draft",
+		},
+		{
+			name: "draft without stored draft inherits from base version",
+			manageRepoFunc: func(m *repomocks.MockIManageRepo) {
+				m.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{PromptID: 1, WithDraft: true, UserID: "u1"}).Return(&entity.Prompt{ID: 1}, nil)
+				m.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{PromptID: 1, WithCommit: true, CommitVersion: "1.0.0"}).Return(&entity.Prompt{
+					ID:           1,
+					PromptCommit: &entity.PromptCommit{PromptDetail: detailWithHeader("Bearer commit")},
+				}, nil)
+			},
+			promptDO: &entity.Prompt{ID: 1, PromptDraft: &entity.PromptDraft{
+				DraftInfo:    &entity.DraftInfo{BaseVersion: "1.0.0"},
+				PromptDetail: detailWithHeader(""),
+			}},
+			userID:     "u1",
+			wantHeader: "Bearer commit",
+		},
+		{
+			name: "commit inherits from stored commit",
+			manageRepoFunc: func(m *repomocks.MockIManageRepo) {
+				m.EXPECT().GetPrompt(gomock.Any(), repo.GetPromptParam{PromptID: 1, WithCommit: true, CommitVersion: "1.0.1"}).Return(&entity.Prompt{
+					ID:           1,
+					PromptCommit: &entity.PromptCommit{PromptDetail: detailWithHeader("Bearer commit")},
+				}, nil)
+			},
+			promptDO: &entity.Prompt{ID: 1, PromptCommit: &entity.PromptCommit{
+				CommitInfo:   &entity.CommitInfo{Version: "1.0.1"},
+				PromptDetail: detailWithHeader(""),
+			}},
+			userID:     "u1",
+			wantHeader: "Bearer commit",
+		},
+		{
+			name:           "new prompt without id",
+			manageRepoFunc: func(m *repomocks.MockIManageRepo) {},
+			promptDO:       &entity.Prompt{PromptDraft: &entity.PromptDraft{PromptDetail: detailWithHeader("")}},
+			userID:         "u1",
+			wantHeader:     "",
+		},
+		{
+			name: "repo error",
+			manageRepoFunc: func(m *repomocks.MockIManageRepo) {
+				m.EXPECT().GetPrompt(gomock.Any(), gomock.Any()).Return(nil, errorx.New("db error"))
+			},
+			promptDO: &entity.Prompt{ID: 1, PromptDraft: &entity.PromptDraft{PromptDetail: detailWithHeader("")}},
+			userID:   "u1",
+			wantErr:  errorx.New("db error"),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockManageRepo := repomocks.NewMockIManageRepo(ctrl)
+			tt.manageRepoFunc(mockManageRepo)
+			p := &PromptServiceImpl{manageRepo: mockManageRepo}
+
+			err := p.InheritToolExecutorSecrets(context.Background(), tt.promptDO, tt.userID)
+			unittest.AssertErrorEqual(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantHeader, headerOf(tt.promptDO))
+			}
+		})
+	}
+}
+
 func TestPromptServiceImpl_CreatePrompt(t *testing.T) {
 	t.Parallel()
 	type fields struct {
@@ -175,6 +175,20 @@ func (mr *MockIPromptServiceMockRecorder) GetPrompt(ctx, param any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrompt", reflect.TypeOf((*MockIPromptService)(nil).GetPrompt), ctx, param)
 }
 
+// InheritToolExecutorSecrets mocks base method.
+func (m *MockIPromptService) InheritToolExecutorSecrets(ctx context.Context, promptDO *entity.Prompt, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InheritToolExecutorSecrets", ctx, promptDO, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InheritToolExecutorSecrets indicates an expected call of InheritToolExecutorSecrets.
+func (mr *MockIPromptServiceMockRecorder) InheritToolExecutorSecrets(ctx, promptDO, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InheritToolExecutorSecrets", reflect.TypeOf((*MockIPromptService)(nil).InheritToolExecutorSecrets), ctx, promptDO, userID)
+}
+
 // ListLabel mocks base method.
 func (m *MockIPromptService) ListLabel(ctx context.Context, param service.ListLabelParam) ([]*entity.PromptLabel, *int64, error) {
 	m.ctrl.T.Helper()
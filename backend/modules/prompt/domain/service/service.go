@@ -9,6 +9,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/idgen"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/rpc"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/tool"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
 	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo"
 )
@@ -30,6 +31,8 @@ type IPromptService interface {
 	CreatePrompt(ctx context.Context, promptDO *entity.Prompt) (promptID int64, err error)
 	SaveDraft(ctx context.Context, promptDO *entity.Prompt) (*entity.DraftInfo, error)
 	GetPrompt(ctx context.Context, param GetPromptParam) (*entity.Prompt, error)
+	// InheritToolExecutorSecrets 前端提交的工具执行器请求头值为空时，沿用已保存版本中的值
+	InheritToolExecutorSecrets(ctx context.Context, promptDO *entity.Prompt, userID string) error
 
 	// Snippet扩展相关方法
 	ExpandSnippets(ctx context.Context, promptDO *entity.Prompt) error
@@ -85,6 +88,7 @@ type PromptServiceImpl struct {
 	llm              rpc.ILLMProvider
 	file             rpc.IFileProvider
 	snippetParser    SnippetParser
+	toolExecutor     tool.IToolExecutor
 }
 
 type GetPromptParam struct {
@@ -109,6 +113,7 @@ func NewPromptService(
 	llm rpc.ILLMProvider,
 	file rpc.IFileProvider,
 	snippetParser SnippetParser,
+	toolExecutor tool.IToolExecutor,
 ) IPromptService {
 	return &PromptServiceImpl{
 		formatter:        formatter,
@@ -121,5 +126,6 @@ func NewPromptService(
 		llm:              llm,
 		file:             file,
 		snippetParser:    snippetParser,
+		toolExecutor:     toolExecutor,
 	}
 }
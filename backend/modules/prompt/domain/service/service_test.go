@@ -12,6 +12,7 @@ import (
 	"github.com/coze-dev/coze-loop/backend/infra/idgen/mocks"
 	confmocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf/mocks"
 	rpcmocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/rpc/mocks"
+	toolmocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/tool/mocks"
 	repomocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/repo/mocks"
 )
 
@@ -30,6 +31,7 @@ func TestNewPromptService(t *testing.T) {
 		mockConfigProvider := confmocks.NewMockIConfigProvider(ctrl)
 		mockLLM := rpcmocks.NewMockILLMProvider(ctrl)
 		mockFile := rpcmocks.NewMockIFileProvider(ctrl)
+		mockToolExecutor := toolmocks.NewMockIToolExecutor(ctrl)
 
 		// Call constructor
 		service := NewPromptService(
@@ -43,6 +45,7 @@ func TestNewPromptService(t *testing.T) {
 			mockLLM,
 			mockFile,
 			NewCozeLoopSnippetParser(),
+			mockToolExecutor,
 		)
 
 		// Verify
@@ -63,6 +66,7 @@ func TestNewPromptService(t *testing.T) {
 		assert.NotNil(t, impl.configProvider)
 		assert.NotNil(t, impl.llm)
 		assert.NotNil(t, impl.file)
+		assert.NotNil(t, impl.toolExecutor)
 	})
 
 	t.Run("sets formatter correctly", func(t *testing.T) {
@@ -90,6 +94,7 @@ func TestNewPromptService(t *testing.T) {
 			mockLLM,
 			mockFile,
 			NewCozeLoopSnippetParser(),
+			nil,
 		)
 
 		impl := service.(*PromptServiceImpl)
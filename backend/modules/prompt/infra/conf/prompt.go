@@ -100,3 +100,14 @@ func (c *PromptConfigProvider) GetPromptLabelVersionCacheConfig(ctx context.Cont
 	}
 	return config.Enable, time.Duration(config.TTLSeconds) * time.Second, nil
 }
+
+// GetToolExecutorConfig returns the configuration for executing prompt tools with bound executors
+func (c *PromptConfigProvider) GetToolExecutorConfig(ctx context.Context) (config *promptconf.ToolExecutorConfig, err error) {
+	const ToolExecutorConfigKey = "tool_executor_config"
+	config = &promptconf.ToolExecutorConfig{}
+	err = c.ConfigLoader.UnmarshalKey(ctx, ToolExecutorConfigKey, config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tool
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	jsonschemav5 "github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/tool"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+const (
+	defaultTimeout        = 30 * time.Second
+	defaultMaxResultBytes = 1 << 20
+)
+
+type ToolExecutorImpl struct {
+	configProvider conf.IConfigProvider
+	httpClient     *http.Client
+}
+
+func NewToolExecutor(configProvider conf.IConfigProvider) tool.IToolExecutor {
+	return &ToolExecutorImpl{
+		configProvider: configProvider,
+		httpClient:     &http.Client{},
+	}
+}
+
+func (e *ToolExecutorImpl) Execute(ctx context.Context, t *entity.Tool, arguments string) (result string, err error) {
+	executor := t.GetExecutor()
+	if executor == nil {
+		return "", errorx.New("tool has no executor")
+	}
+	cfg, err := e.configProvider.GetToolExecutorConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(arguments) == "" {
+		arguments = "{}"
+	}
+	if err = validateArguments(t.Function.Parameters, arguments); err != nil {
+		return "", err
+	}
+	switch executor.Type {
+	case entity.ToolExecutorTypeHTTP:
+		if executor.HTTP == nil {
+			return "", errorx.New("http executor config is empty")
+		}
+		ctx, cancel := context.WithTimeout(ctx, getTimeout(cfg, executor.HTTP.TimeoutMS))
+		defer cancel()
+		result, err = e.executeHTTP(ctx, executor.HTTP, arguments, getMaxResultBytes(cfg))
+	case entity.ToolExecutorTypeMCP:
+		if executor.MCP == nil {
+			return "", errorx.New("mcp executor config is empty")
+		}
+		ctx, cancel := context.WithTimeout(ctx, getTimeout(cfg, executor.MCP.TimeoutMS))
+		defer cancel()
+		result, err = e.executeMCP(ctx, cfg, executor.MCP, t.Function.Name, arguments)
+	default:
+		return "", errorx.New("unsupported tool executor type: %s", executor.Type)
+	}
+	if err != nil {
+		return "", err
+	}
+	return truncate(result, getMaxResultBytes(cfg)), nil
+}
+
+// validateArguments 使用function的parameters校验模型返回的调用参数，未定义parameters时不校验
+func validateArguments(parameters, arguments string) error {
+	var args any
+	if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+		return errorx.New("tool arguments is not a valid json, err=%v", err)
+	}
+	if strings.TrimSpace(parameters) == "" {
+		return nil
+	}
+	compiler := jsonschemav5.NewCompiler()
+	if err := compiler.AddResource("parameters.json", strings.NewReader(parameters)); err != nil {
+		return errorx.New("invalid tool parameters schema, err=%v", err)
+	}
+	schema, err := compiler.Compile("parameters.json")
+	if err != nil {
+		return errorx.New("invalid tool parameters schema, err=%v", err)
+	}
+	if err = schema.Validate(args); err != nil {
+		return errorx.New("tool arguments do not match parameters schema, err=%v", err)
+	}
+	return nil
+}
+
+// getTimeout 未指定超时时使用默认超时，且不超过配置的最大超时
+func getTimeout(cfg *conf.ToolExecutorConfig, timeoutMS *int64) time.Duration {
+	timeout := defaultTimeout
+	if cfg.DefaultTimeoutMS > 0 {
+		timeout = time.Duration(cfg.DefaultTimeoutMS) * time.Millisecond
+	}
+	if ptr.From(timeoutMS) > 0 {
+		timeout = time.Duration(*timeoutMS) * time.Millisecond
+	}
+	if cfg.MaxTimeoutMS > 0 && timeout > time.Duration(cfg.MaxTimeoutMS)*time.Millisecond {
+		timeout = time.Duration(cfg.MaxTimeoutMS) * time.Millisecond
+	}
+	return timeout
+}
+
+func getMaxResultBytes(cfg *conf.ToolExecutorConfig) int64 {
+	if cfg.MaxResultBytes > 0 {
+		return cfg.MaxResultBytes
+	}
+	return defaultMaxResultBytes
+}
+
+func truncate(s string, maxBytes int64) string {
+	if int64(len(s)) <= maxBytes {
+		return s
+	}
+	return strings.ToValidUTF8(s[:maxBytes], "")
+}
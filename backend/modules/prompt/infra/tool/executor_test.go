@@ -0,0 +1,245 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf"
+	confmocks "github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf/mocks"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/lang/ptr"
+)
+
+const weatherParameters = `{"type":"object","properties":{"city":{"type":"string"}},"required":["city"]}`
+
+func newExecutor(t *testing.T, cfg *conf.ToolExecutorConfig) *ToolExecutorImpl {
+	ctrl := gomock.NewController(t)
+	configProvider := confmocks.NewMockIConfigProvider(ctrl)
+	configProvider.EXPECT().GetToolExecutorConfig(gomock.Any()).Return(cfg, nil).AnyTimes()
+	return NewToolExecutor(configProvider).(*ToolExecutorImpl)
+}
+
+func newTool(executor *entity.ToolExecutor) *entity.Tool {
+	return &entity.Tool{
+		Type: entity.ToolTypeFunction,
+		Function: &entity.Function{
+			Name:       "get_weather",
+			Parameters: weatherParameters,
+		},
+		Executor: executor,
+	}
+}
+
+func TestToolExecutorImpl_ExecuteHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = fmt.Fprintf(w, "GET %s", r.URL.Query().Get("city"))
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			_, _ = fmt.Fprintf(w, "POST %s", string(body))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		cfg       *conf.ToolExecutorConfig
+		executor  *entity.HTTPToolExecutor
+		arguments string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "post by default",
+			cfg:       &conf.ToolExecutorConfig{},
+			executor:  &entity.HTTPToolExecutor{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer token"}},
+			arguments: `{"city":"beijing"}`,
+			want:      `POST {"city":"beijing"}`,
+		},
+		{
+			name:      "get with query",
+			cfg:       &conf.ToolExecutorConfig{},
+			executor:  &entity.HTTPToolExecutor{URL: server.URL, Method: "get", Headers: map[string]string{"Authorization": "Bearer token"}},
+			arguments: `{"city":"shanghai"}`,
+			want:      "GET shanghai",
+		},
+		{
+			name:      "truncate result",
+			cfg:       &conf.ToolExecutorConfig{MaxResultBytes: 4},
+			executor:  &entity.HTTPToolExecutor{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer token"}},
+			arguments: `{"city":"beijing"}`,
+			want:      "POST",
+		},
+		{
+			name:      "non 2xx status",
+			cfg:       &conf.ToolExecutorConfig{},
+			executor:  &entity.HTTPToolExecutor{URL: server.URL},
+			arguments: `{"city":"beijing"}`,
+			wantErr:   true,
+		},
+		{
+			name:      "arguments not match schema",
+			cfg:       &conf.ToolExecutorConfig{},
+			executor:  &entity.HTTPToolExecutor{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer token"}},
+			arguments: `{"city":1}`,
+			wantErr:   true,
+		},
+		{
+			name:      "invalid json arguments",
+			cfg:       &conf.ToolExecutorConfig{},
+			executor:  &entity.HTTPToolExecutor{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer token"}},
+			arguments: `{"city":`,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newExecutor(t, tt.cfg)
+			got, err := e.Execute(context.Background(), newTool(&entity.ToolExecutor{
+				Type: entity.ToolExecutorTypeHTTP,
+				HTTP: tt.executor,
+			}), tt.arguments)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestToolExecutorImpl_ExecuteWithoutExecutor(t *testing.T) {
+	e := newExecutor(t, &conf.ToolExecutorConfig{})
+	_, err := e.Execute(context.Background(), newTool(nil), `{"city":"beijing"}`)
+	assert.Error(t, err)
+}
+
+func TestToolExecutorImpl_ExecuteMCPStdioNotAllowed(t *testing.T) {
+	e := newExecutor(t, &conf.ToolExecutorConfig{MCPStdioCommands: []string{"npx"}})
+	_, err := e.Execute(context.Background(), newTool(&entity.ToolExecutor{
+		Type: entity.ToolExecutorTypeMCP,
+		MCP: &entity.MCPToolExecutor{
+			Transport: entity.MCPTransportStdio,
+			Command:   "sh",
+			Args:      []string{"-c", "echo"},
+		},
+	}), `{"city":"beijing"}`)
+	assert.ErrorContains(t, err, "not allowed")
+}
+
+func newMCPServer(t *testing.T, sse bool, isError bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			assert.Equal(t, "session-1", r.Header.Get(mcpSessionIDHeader))
+			return
+		}
+		req := &jsonrpcRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var result any
+		switch req.Method {
+		case "initialize":
+			w.Header().Set(mcpSessionIDHeader, "session-1")
+			result = map[string]any{"protocolVersion": mcpProtocolVersion}
+		case "notifications/initialized":
+			assert.Equal(t, "session-1", r.Header.Get(mcpSessionIDHeader))
+			w.WriteHeader(http.StatusAccepted)
+			return
+		case "tools/call":
+			assert.Equal(t, "session-1", r.Header.Get(mcpSessionIDHeader))
+			params, _ := req.Params.(map[string]any)
+			assert.Equal(t, "weather", params["name"])
+			result = map[string]any{
+				"content": []any{
+					map[string]any{"type": "text", "text": "sunny"},
+					map[string]any{"type": "image", "data": "aGk=", "mimeType": "image/png"},
+				},
+				"isError": isError,
+			}
+		}
+		b, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+		if !sse {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(b)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprintf(w, "data: {\"jsonrpc\":\"2.0\",\"method\":\"notifications/progress\"}\n\n")
+		_, _ = fmt.Fprintf(w, "event: message\ndata: %s\n\n", string(b))
+	}))
+}
+
+func TestToolExecutorImpl_ExecuteMCPHTTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		sse     bool
+		isError bool
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "json response",
+			want: "sunny\n{\"data\":\"aGk=\",\"mimeType\":\"image/png\",\"type\":\"image\"}",
+		},
+		{
+			name: "sse response",
+			sse:  true,
+			want: "sunny\n{\"data\":\"aGk=\",\"mimeType\":\"image/png\",\"type\":\"image\"}",
+		},
+		{
+			name:    "tool returned error",
+			isError: true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newMCPServer(t, tt.sse, tt.isError)
+			defer server.Close()
+			e := newExecutor(t, &conf.ToolExecutorConfig{})
+			got, err := e.Execute(context.Background(), newTool(&entity.ToolExecutor{
+				Type: entity.ToolExecutorTypeMCP,
+				MCP: &entity.MCPToolExecutor{
+					Transport: entity.MCPTransportHTTP,
+					URL:       server.URL,
+					ToolName:  "weather",
+				},
+			}), `{"city":"beijing"}`)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetTimeout(t *testing.T) {
+	assert.Equal(t, defaultTimeout, getTimeout(&conf.ToolExecutorConfig{}, nil))
+	assert.Equal(t, 10*time.Second, getTimeout(&conf.ToolExecutorConfig{DefaultTimeoutMS: 10000}, nil))
+	assert.Equal(t, 5*time.Second, getTimeout(&conf.ToolExecutorConfig{DefaultTimeoutMS: 10000}, ptr.Of(int64(5000))))
+	assert.Equal(t, 20*time.Second, getTimeout(&conf.ToolExecutorConfig{MaxTimeoutMS: 20000}, ptr.Of(int64(60000))))
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tool
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/json"
+)
+
+// executeHTTP GET请求将参数拼接到query中，其余请求将参数作为json请求体
+func (e *ToolExecutorImpl) executeHTTP(ctx context.Context, cfg *entity.HTTPToolExecutor, arguments string, maxResultBytes int64) (string, error) {
+	method := strings.ToUpper(cfg.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+	reqURL := cfg.URL
+	var body io.Reader
+	if method == http.MethodGet {
+		var err error
+		reqURL, err = appendQuery(cfg.URL, arguments)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		body = strings.NewReader(arguments)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return "", errorx.New("build http tool request failed, err=%v", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return "", errorx.New("call http tool failed, err=%v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResultBytes))
+	if err != nil {
+		return "", errorx.New("read http tool response failed, err=%v", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return "", errorx.New("http tool returned status %d, body=%s", resp.StatusCode, string(respBody))
+	}
+	return string(respBody), nil
+}
+
+func appendQuery(rawURL, arguments string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", errorx.New("invalid http tool url, err=%v", err)
+	}
+	args := map[string]any{}
+	if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+		return "", errorx.New("tool arguments of GET request must be a json object, err=%v", err)
+	}
+	query := u.Query()
+	for k, v := range args {
+		switch val := v.(type) {
+		case string:
+			query.Set(k, val)
+		case nil:
+		default:
+			if b, err := json.Marshal(val); err == nil {
+				query.Set(k, string(b))
+			} else {
+				query.Set(k, fmt.Sprint(val))
+			}
+		}
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2025 coze-dev Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tool
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/component/conf"
+	"github.com/coze-dev/coze-loop/backend/modules/prompt/domain/entity"
+	"github.com/coze-dev/coze-loop/backend/pkg/errorx"
+	"github.com/coze-dev/coze-loop/backend/pkg/logs"
+)
+
+const (
+	mcpProtocolVersion = "2025-03-26"
+	mcpClientName      = "coze-loop"
+	mcpSessionIDHeader = "Mcp-Session-Id"
+)
+
+type jsonrpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      *int64 `json:"id,omitempty"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+type jsonrpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      *int64          `json:"id,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type mcpCallToolResult struct {
+	Content []json.RawMessage `json:"content"`
+	IsError bool              `json:"isError"`
+}
+
+type mcpTextContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// mcpTransport 一次工具调用内的MCP连接，调用结束后关闭
+type mcpTransport interface {
+	// call 发送请求并返回id相同的响应
+	call(ctx context.Context, req *jsonrpcRequest) (*jsonrpcResponse, error)
+	notify(ctx context.Context, req *jsonrpcRequest) error
+	close()
+}
+
+func (e *ToolExecutorImpl) executeMCP(ctx context.Context, cfg *conf.ToolExecutorConfig, mcpCfg *entity.MCPToolExecutor, functionName, arguments string) (string, error) {
+	var transport mcpTransport
+	switch mcpCfg.Transport {
+	case entity.MCPTransportHTTP:
+		if mcpCfg.URL == "" {
+			return "", errorx.New("mcp server url is empty")
+		}
+		transport = &mcpHTTPTransport{client: e.httpClient, url: mcpCfg.URL, headers: mcpCfg.Headers}
+	case entity.MCPTransportStdio:
+		if !slices.Contains(cfg.MCPStdioCommands, mcpCfg.Command) {
+			return "", errorx.New("mcp stdio command %q is not allowed", mcpCfg.Command)
+		}
+		t, err := startMCPStdioTransport(ctx, mcpCfg.Command, mcpCfg.Args)
+		if err != nil {
+			return "", err
+		}
+		transport = t
+	default:
+		return "", errorx.New("unsupported mcp transport: %s", mcpCfg.Transport)
+	}
+	defer transport.close()
+	toolName := mcpCfg.ToolName
+	if toolName == "" {
+		toolName = functionName
+	}
+	return callMCPTool(ctx, transport, toolName, arguments)
+}
+
+func callMCPTool(ctx context.Context, transport mcpTransport, toolName, arguments string) (string, error) {
+	var id int64
+	request := func(method string, params any) (*jsonrpcResponse, error) {
+		id++
+		resp, err := transport.call(ctx, &jsonrpcRequest{JSONRPC: "2.0", ID: &id, Method: method, Params: params})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Error != nil {
+			return nil, errorx.New("mcp %s failed, code=%d, message=%s", method, resp.Error.Code, resp.Error.Message)
+		}
+		return resp, nil
+	}
+	if _, err := request("initialize", map[string]any{
+		"protocolVersion": mcpProtocolVersion,
+		"capabilities":    map[string]any{},
+		"clientInfo":      map[string]any{"name": mcpClientName, "version": "1.0.0"},
+	}); err != nil {
+		return "", err
+	}
+	if err := transport.notify(ctx, &jsonrpcRequest{JSONRPC: "2.0", Method: "notifications/initialized"}); err != nil {
+		return "", err
+	}
+	resp, err := request("tools/call", map[string]any{
+		"name":      toolName,
+		"arguments": json.RawMessage(arguments),
+	})
+	if err != nil {
+		return "", err
+	}
+	result := &mcpCallToolResult{}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		return "", errorx.New("invalid mcp tools/call result, err=%v", err)
+	}
+	output := formatMCPContent(result.Content)
+	if result.IsError {
+		return "", errorx.New("mcp tool returned error: %s", output)
+	}
+	return output, nil
+}
+
+// formatMCPContent 文本内容直接拼接，其余类型的内容保留原始json
+func formatMCPContent(contents []json.RawMessage) string {
+	parts := make([]string, 0, len(contents))
+	for _, c := range contents {
+		text := &mcpTextContent{}
+		if err := json.Unmarshal(c, text); err == nil && text.Type == "text" {
+			parts = append(parts, text.Text)
+			continue
+		}
+		parts = append(parts, string(c))
+	}
+	return strings.Join(parts, "\n")
+}
+
+// mcpHTTPTransport Streamable HTTP传输，响应可能是json或SSE
+type mcpHTTPTransport struct {
+	client    *http.Client
+	url       string
+	headers   map[string]string
+	sessionID string
+}
+
+func (t *mcpHTTPTransport) send(ctx context.Context, method string, req *jsonrpcRequest) (*http.Response, error) {
+	var body io.Reader
+	if req != nil {
+		b, err := json.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(b)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, method, t.url, body)
+	if err != nil {
+		return nil, errorx.New("build mcp request failed, err=%v", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json, text/event-stream")
+	for k, v := range t.headers {
+		httpReq.Header.Set(k, v)
+	}
+	if t.sessionID != "" {
+		httpReq.Header.Set(mcpSessionIDHeader, t.sessionID)
+	}
+	resp, err := t.client.Do(httpReq)
+	if err != nil {
+		return nil, errorx.New("call mcp server failed, err=%v", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, errorx.New("mcp server returned status %d, body=%s", resp.StatusCode, string(b))
+	}
+	if sessionID := resp.Header.Get(mcpSessionIDHeader); sessionID != "" {
+		t.sessionID = sessionID
+	}
+	return resp, nil
+}
+
+func (t *mcpHTTPTransport) call(ctx context.Context, req *jsonrpcRequest) (*jsonrpcResponse, error) {
+	resp, err := t.send(ctx, http.MethodPost, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		res := &jsonrpcResponse{}
+		if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+			return nil, errorx.New("decode mcp response failed, err=%v", err)
+		}
+		return res, nil
+	}
+	// SSE中可能夹带服务端的通知，只取id相同的响应
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		if after, ok := strings.CutPrefix(line, "data:"); ok {
+			data.WriteString(strings.TrimPrefix(after, " "))
+			continue
+		}
+		if line != "" || data.Len() == 0 {
+			continue
+		}
+		res := &jsonrpcResponse{}
+		if err := json.Unmarshal([]byte(data.String()), res); err == nil && res.ID != nil && *res.ID == *req.ID {
+			return res, nil
+		}
+		data.Reset()
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errorx.New("read mcp event stream failed, err=%v", err)
+	}
+	return nil, errorx.New("mcp event stream closed without response of %s", req.Method)
+}
+
+func (t *mcpHTTPTransport) notify(ctx context.Context, req *jsonrpcRequest) error {
+	resp, err := t.send(ctx, http.MethodPost, req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+func (t *mcpHTTPTransport) close() {
+	if t.sessionID == "" {
+		return
+	}
+	// 主动结束会话，失败不影响工具结果
+	resp, err := t.send(context.Background(), http.MethodDelete, nil)
+	if err != nil {
+		return
+	}
+	_ = resp.Body.Close()
+}
+
+// mcpStdioTransport 每次工具调用启动一个MCP server子进程，通过换行分隔的json通信
+type mcpStdioTransport struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+}
+
+func startMCPStdioTransport(ctx context.Context, command string, args []string) (*mcpStdioTransport, error) {
+	cmd := exec.CommandContext(ctx, command, args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, errorx.New("start mcp server %q failed, err=%v", command, err)
+	}
+	return &mcpStdioTransport{cmd: cmd, stdin: stdin, stdout: bufio.NewReader(stdout)}, nil
+}
+
+func (t *mcpStdioTransport) write(req *jsonrpcRequest) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if _, err = t.stdin.Write(append(b, '\n')); err != nil {
+		return errorx.New("write to mcp server failed, err=%v", err)
+	}
+	return nil
+}
+
+func (t *mcpStdioTransport) call(ctx context.Context, req *jsonrpcRequest) (*jsonrpcResponse, error) {
+	if err := t.write(req); err != nil {
+		return nil, err
+	}
+	for {
+		line, err := t.stdout.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			res := &jsonrpcResponse{}
+			if jsonErr := json.Unmarshal(line, res); jsonErr == nil && res.ID != nil && *res.ID == *req.ID {
+				return res, nil
+			}
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, errorx.New("read from mcp server failed, err=%v", err)
+		}
+	}
+}
+
+func (t *mcpStdioTransport) notify(ctx context.Context, req *jsonrpcRequest) error {
+	return t.write(req)
+}
+
+func (t *mcpStdioTransport) close() {
+	_ = t.stdin.Close()
+	if t.cmd.Process != nil {
+		_ = t.cmd.Process.Kill()
+	}
+	if err := t.cmd.Wait(); err != nil {
+		logs.Debug("mcp server %q exited, err=%v", t.cmd.Path, err)
+	}
+}
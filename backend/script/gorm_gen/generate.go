@@ -255,11 +255,19 @@ func generateForObservability(db *gorm.DB) {
 	observabilityTask := g.GenerateModelAs("task", "ObservabilityTask")
 	observabilityTaskRun := g.GenerateModelAs("auto_task_run", "ObservabilityTaskRun")
 	observabilityTrajectoryConfig := g.GenerateModelAs("observability_trajectory_config", "ObservabilityTrajectoryConfig")
+	observabilityRetentionPolicy := g.GenerateModelAs("observability_retention_policy", "ObservabilityRetentionPolicy")
+	observabilityAlertRule := g.GenerateModelAs("observability_alert_rule", "ObservabilityAlertRule")
+	observabilityDashboard := g.GenerateModelAs("observability_dashboard", "ObservabilityDashboard")
+	observabilityTraceExportJob := g.GenerateModelAs("observability_trace_export_job", "ObservabilityTraceExportJob")
 
 	g.ApplyBasic(observabilityView)
 	g.ApplyBasic(observabilityTask)
 	g.ApplyBasic(observabilityTaskRun)
 	g.ApplyBasic(observabilityTrajectoryConfig)
+	g.ApplyBasic(observabilityRetentionPolicy)
+	g.ApplyBasic(observabilityAlertRule)
+	g.ApplyBasic(observabilityDashboard)
+	g.ApplyBasic(observabilityTraceExportJob)
 	g.Execute()
 }
 
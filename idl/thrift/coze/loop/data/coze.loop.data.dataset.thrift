@@ -130,6 +130,23 @@ struct ImportDatasetResponse {
     255: base.BaseResp BaseResp
 }
 
+struct ExportDatasetRequest {
+    1: optional i64 workspace_id (api.js_conv="true", go.tag='json:"workspace_id"', vt.not_nil = "true", vt.gt = "0")
+    2: required i64 dataset_id (api.js_conv="true", go.tag='json:"dataset_id"', api.path = "dataset_id", vt.gt = "0")
+    3: optional i64 version_id (api.js_conv="true", go.tag='json:"version_id"')          // 导出的版本，为空时导出草稿
+    4: optional dataset_job.DatasetIOFile file (vt.not_nil = "true")                      // 导出的目标文件
+    5: optional list<dataset_job.FieldMapping> field_mappings                            // source 为数据集字段，target 为文件列名；为空时导出全部字段
+
+    /*base*/
+    255: optional base.Base Base
+}
+
+struct ExportDatasetResponse {
+    1: optional i64 job_id (api.js_conv="true", go.tag='json:"job_id"')
+
+    255: base.BaseResp BaseResp
+}
+
 struct GetDatasetIOJobRequest {
     1: optional i64 workspace_id (api.query='workspace_id', api.js_conv="true", go.tag='json:"workspace_id"', vt.not_nil = "true", vt.gt = "0")
     2: required i64 job_id (api.js_conv="true", go.tag='json:"job_id"', api.path = "job_id", vt.gt = "0")
@@ -450,6 +467,8 @@ service DatasetService {
 
     // 导入数据
     ImportDatasetResponse ImportDataset(1: ImportDatasetRequest req) (api.post = "/api/data/v1/datasets/:dataset_id/import")
+    // 导出数据到文件
+    ExportDatasetResponse ExportDataset(1: ExportDatasetRequest req) (api.post = "/api/data/v1/datasets/:dataset_id/export")
     // 任务(导入、导出、转换)详情
     GetDatasetIOJobResponse GetDatasetIOJob(1: GetDatasetIOJobRequest req) (api.get = "/api/data/v1/dataset_io_jobs/:job_id")
     // 数据集任务列表
@@ -21,6 +21,8 @@ struct CreateEvalTargetParam {
     6: optional eval_target.CustomEvalTarget custom_eval_target // type=6,并且有搜索对象，搜索结果信息通过这个字段透传
     7: optional eval_target.Region region   // 有区域限制需要填充这个字段
     8: optional string env  // 有环境限制需要填充这个字段
+    9: optional eval_target.CustomRPCServer custom_rpc_server // type=CustomRPCServer且access_protocol=http时，通过这个字段注册服务信息
+    10: optional eval_target.OpenAIChat openai_chat // type=OpenAIChat时，通过这个字段注册接口信息
 }
 
 struct CreateEvalTargetResponse {
@@ -37,6 +37,8 @@ struct CreateExperimentRequest {
     42: optional map<i64, double> evaluator_score_weights (api.body = 'evaluator_score_weights', go.tag='json:"evaluator_score_weights"')
     43: optional i64 expt_template_id (api.body='expt_template_id',api.js_conv='true', go.tag='json:"expt_template_id"')
     45: optional i32 item_retry_num (api.body = 'item_retry_num')
+    // 门禁规则，实验完成时计算门禁结论
+    46: optional expt.ExptGateConfig gate_config (api.body = 'gate_config')
 
     200: optional common.Session session
 
@@ -76,6 +78,8 @@ struct SubmitExperimentRequest {
     41: optional bool enable_weighted_score (api.body = 'enable_weighted_score', go.tag='json:"enable_weighted_score"')
     42: optional i64 expt_template_id (api.body='expt_template_id',api.js_conv='true', go.tag='json:"expt_template_id"')
     45: optional i32 item_retry_num (api.body = 'item_retry_num')
+    // 门禁规则，实验完成时计算门禁结论
+    46: optional expt.ExptGateConfig gate_config (api.body = 'gate_config')
 
     100: optional map<string, string> ext (api.body = 'ext')
 
@@ -268,6 +272,22 @@ struct BatchGetExperimentAggrResultResponse {
     255: base.BaseResp BaseResp
 }
 
+struct CompareExperimentSignificanceRequest {
+    1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true', go.tag = 'json:"workspace_id"')
+    2: required i64 base_experiment_id (api.body = 'base_experiment_id', api.js_conv = 'true', go.tag = 'json:"base_experiment_id"')
+    3: required list<i64> compare_experiment_ids (api.body = 'compare_experiment_ids', api.js_conv = 'true', go.tag = 'json:"compare_experiment_ids"')
+    4: optional list<i64> evaluator_version_ids (api.body = 'evaluator_version_ids', api.js_conv = 'true', go.tag = 'json:"evaluator_version_ids"') // 为空时对比全部共同评估器
+    5: optional double confidence_level (api.body = 'confidence_level') // 默认 0.95
+
+    255: optional base.Base Base
+}
+
+struct CompareExperimentSignificanceResponse {
+    1: optional list<expt.ExptComparisonResult> comparison_results (api.body = 'comparison_results')
+
+    255: base.BaseResp BaseResp
+}
+
 struct CalculateExperimentAggrResultRequest {
     1: required i64 workspace_id (api.body = 'workspace_id', api.js_conv = 'true')
     2: required i64 expt_id (api.path = 'expt_id', api.js_conv = 'true')
@@ -375,6 +395,10 @@ struct CreateExperimentTemplateRequest {
     21: optional i32 default_evaluators_concur_num (api.body = 'default_evaluators_concur_num')
     // 调度配置（不在 ExptTemplate 结构中，保留在顶层）
     22: optional string schedule_cron (api.body = 'schedule_cron')
+    // 完整调度配置，设置后忽略 schedule_cron
+    23: optional expt.ExptTemplateSchedule schedule_config (api.body = 'schedule_config')
+    // 门禁规则，基于模板创建的实验沿用该配置
+    24: optional expt.ExptGateConfig gate_config (api.body = 'gate_config')
 
     200: optional common.Session session
     255: optional base.Base Base
@@ -432,6 +456,10 @@ struct UpdateExperimentTemplateRequest {
     21: optional i32 default_evaluators_concur_num (api.body = 'default_evaluators_concur_num')
     // 调度配置（不在 ExptTemplate 结构中，保留在顶层）
     22: optional string schedule_cron (api.body = 'schedule_cron')
+    // 完整调度配置，设置后忽略 schedule_cron
+    23: optional expt.ExptTemplateSchedule schedule_config (api.body = 'schedule_config')
+    // 门禁规则，基于模板创建的实验沿用该配置
+    24: optional expt.ExptGateConfig gate_config (api.body = 'gate_config')
 
     255: optional base.Base Base
 }
@@ -785,6 +813,11 @@ service ExperimentService {
         api.post = "/api/evaluation/v1/experiments/aggr_results/batch_get", api.op_type = 'query', api.tag = 'volc-agentkit,open', api.category = 'experiment'
     )
 
+    // 按 item/turn 配对对比实验结果并进行显著性检验
+    CompareExperimentSignificanceResponse CompareExperimentSignificance(1: CompareExperimentSignificanceRequest req) (
+        api.post = "/api/evaluation/v1/experiments/aggr_results/compare", api.op_type = 'query', api.tag = 'volc-agentkit', api.category = 'experiment'
+    )
+
     // 在线实验
     InvokeExperimentResponse InvokeExperiment(1: InvokeExperimentRequest req)
 
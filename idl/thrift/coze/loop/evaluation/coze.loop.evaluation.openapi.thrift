@@ -347,6 +347,8 @@ struct SubmitExperimentOApiRequest {
     22: optional common.RuntimeParam target_runtime_param (api.body = 'target_runtime_param')
 
     45: optional i32 item_retry_num (api.body = 'item_retry_num')
+    // 门禁规则，实验完成后计算门禁结论，可通过获取实验详情接口查询
+    46: optional experiment.ExperimentGateConfig gate_config (api.body = 'gate_config')
 
     255: optional base.Base Base
 }
@@ -146,6 +146,15 @@ struct InvokeEvaluatorOutputData {
 struct InvokeEvaluatorResult {
     1: optional double score
     2: optional string reasoning
+    3: optional list<InvokeEvaluatorDimensionResult> dimensions // 多维度评估结果，如 accuracy / tone / safety 各自的得分与标签
+}
+
+// the named sub-score of custom evaluator result
+struct InvokeEvaluatorDimensionResult {
+    1: optional string name
+    2: optional double score
+    3: optional string label
+    4: optional string reasoning
 }
 
 // the usage data structure for custom evaluator
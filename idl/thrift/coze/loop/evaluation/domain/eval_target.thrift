@@ -44,6 +44,8 @@ struct EvalTargetContent {
     104: optional VolcengineAgent volcengine_agent
     // EvalTargetType=6 时，传参此字段。 评测对象为 CustomRPCServer 时, 需要设置 CustomRPCServer 信息
     105: optional CustomRPCServer custom_rpc_server
+    // EvalTargetType=8 时，传参此字段。 评测对象为 OpenAIChat 时, 需要设置 OpenAIChat 信息
+    106: optional OpenAIChat openai_chat
 }
 
 enum EvalTargetType {
@@ -55,6 +57,7 @@ enum EvalTargetType {
     CustomRPCServer = 6 // 自定义RPC服务 for内场
 
     VolcengineAgentAgentkit = 7 // 火山智能体Agentkit
+    OpenAIChat = 8 // OpenAI兼容的/v1/chat/completions接口
 }
 
 // Agent协议类型
@@ -86,6 +89,8 @@ struct CustomRPCServer {
     21: optional string exec_env // 执行环境
     22: optional i64 timeout // 执行超时时间，单位ms
     23: optional i64 async_timeout // 异步执行超时时间，单位ms
+    24: optional string endpoint // access_protocol=http时的服务地址，与各http_info中的path拼接为请求url
+    25: optional map<string, string> headers // access_protocol=http时附加的请求头，例如鉴权信息
 
     50: optional map<string, string> ext
 
@@ -114,11 +119,26 @@ const AccessProtocol AccessProtocol_RPC = "rpc"
 const AccessProtocol AccessProtocol_RPCOld = "rpc_old"
 const AccessProtocol AccessProtocol_FaasHTTP = "faas_http"
 const AccessProtocol AccessProtocol_FaasHTTPOld = "faas_http_old"
+const AccessProtocol AccessProtocol_HTTP = "http" // 按EvaluationSPIService协议以HTTP/JSON调用
 
 typedef string HTTPMethod (ts.enum="true")
 const HTTPMethod HTTPMethod_Get = "get"
 const HTTPMethod HTTPMethod_Post = "post"
 
+// OpenAI兼容的Chat接口，按消息模板把评测集字段渲染为chat messages后调用{base_url}/chat/completions
+struct OpenAIChat {
+    1: optional string base_url // 例如https://api.openai.com/v1
+    2: optional string model
+    3: optional map<string, string> headers // 请求头，例如Authorization，不对外返回
+    4: optional list<OpenAIChatMessageTemplate> message_templates // 消息模板，content中的{{var}}会作为评测对象的输入字段
+    5: optional bool stream // 是否流式调用，流式调用时记录首包耗时
+    6: optional i64 timeout // 执行超时时间，单位ms
+}
+
+struct OpenAIChatMessageTemplate {
+    1: optional string role // system/user/assistant
+    2: optional string content
+}
 
 struct VolcengineAgent {
     1: optional i64 id (api.js_conv='true', go.tag='json:"id"')    // 罗盘应用ID
@@ -239,6 +259,7 @@ struct EvalTargetUsage {
     1: i64 input_tokens (api.js_conv='true', go.tag='json:\"input_tokens\"')
     2: i64 output_tokens (api.js_conv='true', go.tag='json:\"output_tokens\"')
     3: i64 total_tokens (api.js_conv='true', go.tag='json:\"total_tokens\"')
+    4: optional double total_cost // 按模型价格表计算的费用
 }
 
 struct EvalTargetRunError {
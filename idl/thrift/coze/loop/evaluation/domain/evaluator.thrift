@@ -60,6 +60,7 @@ const EvaluatorAccessProtocol EvaluatorAccessProtocol_RPC = "rpc"
 const EvaluatorAccessProtocol AccessProtocol_RPCOld = "rpc_old"
 const EvaluatorAccessProtocol AccessProtocol_FaasHTTP = "faas_http"
 const EvaluatorAccessProtocol AccessProtocol_FaasHTTPOld = "faas_http_old"
+const EvaluatorAccessProtocol EvaluatorAccessProtocol_HTTP = "http" // 按EvaluationSPIService协议通过HTTP直接调用endpoint
 
 typedef string EvaluatorVersionType(ts.enum="true")
 const EvaluatorVersionType EvaluatorVersionType_Latest = "Latest" // 最新版本
@@ -99,8 +100,12 @@ struct CustomRPCEvaluator {
     3: optional string service_name
     4: optional string cluster
     5: optional EvaluatorHTTPInfo invoke_http_info // 执行http信息
+    6: optional string endpoint // access_protocol为http时的服务地址，例如https://example.com/evaluator
+    7: optional map<string, string> headers // 请求头，用于鉴权，例如Authorization
+    8: optional EvaluatorHTTPInfo health_check_http_info // 健康检查http信息，配置后校验评估器时会探测该接口
 
     10: optional i64 timeout    // ms
+    13: optional i32 max_retries // 调用失败时的最大重试次数
     11: optional common.RateLimit rate_limit     // 自定义评估器的限流配置
     12: optional map<string, string> ext         // extra fields
 }
@@ -246,6 +251,15 @@ struct EvaluatorResult {
     1: optional double score
     2: optional Correction correction
     3: optional string reasoning
+    4: optional list<EvaluatorDimensionResult> dimensions // 多维度评估结果
+}
+
+// 评估器单个维度的评估结果
+struct EvaluatorDimensionResult {
+    1: optional string name
+    2: optional double score
+    3: optional string label
+    4: optional string reasoning
 }
 
 struct EvaluatorUsage {
@@ -70,6 +70,9 @@ struct Experiment {
     // 评估器得分加权配置
     61: optional ExptScoreWeight score_weight_config
     62: optional bool enable_weighted_score
+    // 门禁规则及实验完成时计算的门禁结论
+    63: optional ExptGateConfig gate_config
+    64: optional ExptGateVerdict gate_verdict
 }
 
 // 实验模板基础信息
@@ -114,14 +117,42 @@ struct ExptTemplate {
     3: optional ExptFieldMapping field_mapping_config
     4: optional ExptScoreWeight score_weight_config
     5: optional ExptInfo expt_info
+    6: optional ExptTemplateSchedule schedule_config
+    7: optional ExptGateConfig gate_config
 
     255: optional common.BaseInfo base_info
 }
 
+// 实验模板调度配置
+struct ExptTemplateSchedule {
+    1: optional bool enabled
+    2: optional string cron                       // 5 段 cron 表达式（分 时 日 月 周），为空时不按时间触发
+    3: optional string timezone                   // cron 使用的时区，如 Asia/Shanghai，为空时使用服务所在时区
+    4: optional bool trigger_on_target_change     // 评测对象为 Prompt 时，提交版本或标签指向的版本变化后自动运行
+    5: optional string prompt_label               // 跟踪的 Prompt 标签，为空时跟踪最新提交版本
+    6: optional i32 retention_count               // 保留的调度实验数量，超出后删除最早的调度实验，0 表示不清理
+}
+
+typedef string ExptScheduleTriggerType
+const ExptScheduleTriggerType ExptScheduleTriggerType_Cron = "cron"
+const ExptScheduleTriggerType ExptScheduleTriggerType_TargetChange = "target_change"
+
+// 实验模板调度记录
+struct ExptScheduleRecord {
+    1: optional ExptScheduleTriggerType trigger_type
+    2: optional i64 fire_at (api.js_conv='true', go.tag='json:"fire_at"')
+    3: optional string target_version
+    4: optional i64 expt_id (api.js_conv='true', go.tag='json:"expt_id"')
+    5: optional string error
+    6: optional bool purged                       // 实验已因超出保留数量被删除
+}
+
 struct ExptInfo {
     1: optional i64 created_expt_count
     2: optional i64 latest_expt_id (api.js_conv='true', go.tag='json:"latest_expt_id"')
     3: optional ExptStatus latest_expt_status
+    4: optional list<ExptScheduleRecord> schedule_history
+    5: optional string last_target_version        // 调度最近一次观察到的 Prompt 提交版本
 }
 
 struct TokenUsage {
@@ -464,6 +495,7 @@ struct EvalTargetAggregateResult {
     6: optional list<AggregatorResult> input_tokens
     7: optional list<AggregatorResult> output_tokens
     8: optional list<AggregatorResult> total_tokens
+    9: optional list<AggregatorResult> total_cost
 }
 
 // 评估器版本粒度聚合结果
@@ -472,6 +504,111 @@ struct EvaluatorAggregateResult {
     2: optional list<AggregatorResult> aggregator_results
     3: optional string name
     4: optional string version
+    5: optional list<EvaluatorDimensionAggregateResult> dimension_results // 多维度评估器各维度的聚合结果
+}
+
+// 评估器单个维度的聚合结果
+struct EvaluatorDimensionAggregateResult {
+    1: optional string name
+    2: optional list<AggregatorResult> aggregator_results
+}
+
+// 显著性检验方法
+typedef string SignificanceTestType(ts.enum="true")
+
+const SignificanceTestType SignificanceTestType_Bootstrap = "Bootstrap"       // 均值差的 bootstrap 置信区间
+const SignificanceTestType SignificanceTestType_PairedTTest = "PairedTTest"   // 配对 t 检验
+const SignificanceTestType SignificanceTestType_Wilcoxon = "Wilcoxon"         // Wilcoxon 符号秩检验
+const SignificanceTestType SignificanceTestType_McNemar = "McNemar"           // McNemar 检验，仅用于 0/1 得分的评估器
+
+// 实验对比结论
+typedef string ComparisonVerdict(ts.enum="true")
+
+const ComparisonVerdict ComparisonVerdict_NoSignificantChange = "NoSignificantChange"
+const ComparisonVerdict ComparisonVerdict_Improvement = "Improvement"
+const ComparisonVerdict ComparisonVerdict_Regression = "Regression"
+const ComparisonVerdict ComparisonVerdict_InsufficientData = "InsufficientData"
+
+struct SignificanceTestResult {
+    1: optional SignificanceTestType test_type
+    2: optional double statistic
+    3: optional double p_value
+    4: optional bool significant
+    5: optional double ci_lower // 仅 Bootstrap 返回
+    6: optional double ci_upper // 仅 Bootstrap 返回
+}
+
+// 评估器版本粒度的实验对比结果，得分按 item_id + turn_id 配对
+struct EvaluatorComparisonResult {
+    1: required i64 evaluator_version_id (api.js_conv = 'true', go.tag = 'json:"evaluator_version_id"')
+    2: optional i64 paired_count
+    3: optional double base_mean
+    4: optional double compare_mean
+    5: optional double mean_diff // compare_mean - base_mean
+    6: optional bool binary      // 得分均为 0/1 时使用 McNemar 检验
+    7: optional list<SignificanceTestResult> test_results
+    8: optional ComparisonVerdict verdict
+}
+
+// 对比实验相对基准实验的显著性检验结果
+struct ExptComparisonResult {
+    1: required i64 base_experiment_id (api.js_conv = 'true', go.tag = 'json:"base_experiment_id"')
+    2: required i64 compare_experiment_id (api.js_conv = 'true', go.tag = 'json:"compare_experiment_id"')
+    3: optional list<EvaluatorComparisonResult> evaluator_results
+}
+
+// 门禁规则类型
+typedef string ExptGateRuleType(ts.enum="true")
+
+const ExptGateRuleType ExptGateRuleType_EvaluatorScore = "EvaluatorScore"   // 评估器平均分与阈值比较
+const ExptGateRuleType ExptGateRuleType_FailedTurnRate = "FailedTurnRate"   // 未成功 turn 占比不超过阈值
+const ExptGateRuleType ExptGateRuleType_NoRegression = "NoRegression"       // 相对基准实验无显著退化
+
+// 门禁比较符
+typedef string ExptGateOperator(ts.enum="true")
+
+const ExptGateOperator ExptGateOperator_GTE = "gte"
+const ExptGateOperator ExptGateOperator_GT = "gt"
+const ExptGateOperator ExptGateOperator_LTE = "lte"
+const ExptGateOperator ExptGateOperator_LT = "lt"
+
+// 门禁结论
+typedef string ExptGateStatus(ts.enum="true")
+
+const ExptGateStatus ExptGateStatus_Pass = "Pass"
+const ExptGateStatus ExptGateStatus_Fail = "Fail"
+const ExptGateStatus ExptGateStatus_Error = "Error"     // 规则无法计算，如无得分数据、基准实验不存在
+
+struct ExptGateRule {
+    1: optional ExptGateRuleType rule_type
+    2: optional string name
+    // EvaluatorScore
+    3: optional i64 evaluator_version_id (api.js_conv = 'true', go.tag = 'json:"evaluator_version_id"')
+    4: optional ExptGateOperator operator   // 默认 gte
+    // EvaluatorScore 为得分阈值；FailedTurnRate 为未成功 turn 占比上限，取值 [0, 1]
+    5: optional double threshold
+    // NoRegression
+    6: optional i64 baseline_experiment_id (api.js_conv = 'true', go.tag = 'json:"baseline_experiment_id"')
+    7: optional list<i64> evaluator_version_ids (api.js_conv = 'true', go.tag = 'json:"evaluator_version_ids"') // 为空时对比两个实验共同的全部评估器版本
+    8: optional double confidence_level     // 默认 0.95
+}
+
+// 实验门禁配置，实验完成时逐条规则计算结论
+struct ExptGateConfig {
+    1: optional list<ExptGateRule> rules
+}
+
+struct ExptGateRuleVerdict {
+    1: optional ExptGateRule rule
+    2: optional ExptGateStatus status
+    3: optional double actual_value     // EvaluatorScore 为平均分；FailedTurnRate 为未成功占比；NoRegression 为显著退化的评估器数
+    4: optional string message
+}
+
+struct ExptGateVerdict {
+    1: optional ExptGateStatus status   // 任一规则 Fail 则为 Fail；无 Fail 且存在 Error 时为 Error
+    2: optional list<ExptGateRuleVerdict> rule_verdicts
+    3: optional i64 evaluated_at (api.js_conv = 'true', go.tag = 'json:"evaluated_at"') // 毫秒时间戳
 }
 
 // 人工标注项粒度聚合结果
@@ -104,6 +104,15 @@ struct EvaluatorResult {
     1: optional double score
     2: optional string reasoning
     3: optional Correction correction
+    4: optional list<EvaluatorDimensionResult> dimensions // 多维度评估结果
+}
+
+// 评估器单个维度的评估结果
+struct EvaluatorDimensionResult {
+    1: optional string name
+    2: optional double score
+    3: optional string label
+    4: optional string reasoning
 }
 
 struct Correction {
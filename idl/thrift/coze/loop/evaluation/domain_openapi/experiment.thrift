@@ -82,6 +82,13 @@ struct EvaluatorAggregateResult {
     4: optional string version
 
     20: optional list<AggregatorResult> aggregator_results
+    21: optional list<EvaluatorDimensionAggregateResult> dimension_results // 多维度评估器各维度的聚合结果
+}
+
+// 评估器单个维度的聚合结果
+struct EvaluatorDimensionAggregateResult {
+    1: optional string name
+    2: optional list<AggregatorResult> aggregator_results
 }
 
 struct EvalTargetAggregateResult {
@@ -126,6 +133,55 @@ struct ExperimentStatistics {
 }
 
 // 评测实验
+// 门禁规则类型
+typedef string ExperimentGateRuleType(ts.enum="true")
+const ExperimentGateRuleType ExperimentGateRuleType_EvaluatorScore = "evaluator_score"     // 评估器平均分与阈值比较
+const ExperimentGateRuleType ExperimentGateRuleType_FailedTurnRate = "failed_turn_rate"    // 未成功 turn 占比不超过阈值
+const ExperimentGateRuleType ExperimentGateRuleType_NoRegression = "no_regression"         // 相对基准实验无显著退化
+
+// 门禁比较符
+typedef string ExperimentGateOperator(ts.enum="true")
+const ExperimentGateOperator ExperimentGateOperator_GTE = "gte"
+const ExperimentGateOperator ExperimentGateOperator_GT = "gt"
+const ExperimentGateOperator ExperimentGateOperator_LTE = "lte"
+const ExperimentGateOperator ExperimentGateOperator_LT = "lt"
+
+// 门禁结论
+typedef string ExperimentGateStatus(ts.enum="true")
+const ExperimentGateStatus ExperimentGateStatus_Pass = "pass"
+const ExperimentGateStatus ExperimentGateStatus_Fail = "fail"
+const ExperimentGateStatus ExperimentGateStatus_Error = "error"
+
+struct ExperimentGateRule {
+    1: optional ExperimentGateRuleType rule_type
+    2: optional string name
+    // evaluator_score 规则的评估器，需包含在实验评估器中
+    3: optional i64 evaluator_id (api.js_conv="true", go.tag='json:"evaluator_id"')
+    4: optional string version
+    5: optional ExperimentGateOperator operator // 默认 gte
+    6: optional double threshold   // evaluator_score 为得分阈值；failed_turn_rate 为未成功 turn 占比上限，取值 [0, 1]
+    // no_regression 规则的基准实验，对比两个实验共同的全部评估器
+    7: optional i64 baseline_experiment_id (api.js_conv="true", go.tag='json:"baseline_experiment_id"')
+    8: optional double confidence_level // 默认 0.95
+}
+
+struct ExperimentGateConfig {
+    1: optional list<ExperimentGateRule> rules
+}
+
+struct ExperimentGateRuleVerdict {
+    1: optional ExperimentGateRule rule
+    2: optional ExperimentGateStatus status
+    3: optional double actual_value
+    4: optional string message
+}
+
+struct ExperimentGateVerdict {
+    1: optional ExperimentGateStatus status
+    2: optional list<ExperimentGateRuleVerdict> rule_verdicts
+    3: optional i64 evaluated_at (api.js_conv="true", go.tag='json:"evaluated_at"') // 毫秒时间戳
+}
+
 struct Experiment {
     // 基本信息
     1: optional i64 id (api.js_conv='true', go.tag='json:"id"')
@@ -148,6 +204,10 @@ struct Experiment {
     // 统计信息
     50: optional ExperimentStatistics expt_stats
 
+    // 门禁信息
+    60: optional ExperimentGateConfig gate_config
+    61: optional ExperimentGateVerdict gate_verdict // 实验完成后计算，CI 可据此阻断发布
+
     100: optional common.BaseInfo base_info
 }
 
@@ -33,9 +33,73 @@ struct ListUserSpaceResponse {
     255: base.BaseResp  BaseResp
 }
 
+// 空间成员列表
+struct ListSpaceMembersRequest {
+    1: required i64 space_id (api.path = "space_id", api.js_conv='true', go.tag='json:"space_id"')
+
+    101: optional i32 page_size (vt.gt = "0", vt.le = "100")   // 分页数量
+    102: optional i32 page_number (vt.gt = "0")                // 当前请求页码
+
+    255: optional base.Base Base
+}
+
+struct ListSpaceMembersResponse {
+    1: optional list<space.SpaceMember> members    // 成员列表
+    2: optional i32 total                          // 成员总数
+
+    255: base.BaseResp  BaseResp
+}
+
+// 邀请空间成员
+struct AddSpaceMemberRequest {
+    1: required i64 space_id (api.path = "space_id", api.js_conv='true', go.tag='json:"space_id"')
+    2: required string user_id                                  // 被邀请的用户ID
+    3: required space.SpaceRoleType role_type                   // 成员角色，不可为 Owner
+
+    255: optional base.Base Base
+}
+
+struct AddSpaceMemberResponse {
+    255: base.BaseResp  BaseResp
+}
+
+// 移除空间成员
+struct RemoveSpaceMemberRequest {
+    1: required i64 space_id (api.path = "space_id", api.js_conv='true', go.tag='json:"space_id"')
+    2: required string user_id (api.path = "user_id")
+
+    255: optional base.Base Base
+}
+
+struct RemoveSpaceMemberResponse {
+    255: base.BaseResp  BaseResp
+}
+
+// 修改空间成员角色
+struct UpdateSpaceMemberRoleRequest {
+    1: required i64 space_id (api.path = "space_id", api.js_conv='true', go.tag='json:"space_id"')
+    2: required string user_id (api.path = "user_id")
+    3: required space.SpaceRoleType role_type                   // 新角色，不可为 Owner
+
+    255: optional base.Base Base
+}
+
+struct UpdateSpaceMemberRoleResponse {
+    255: base.BaseResp  BaseResp
+}
+
 service SpaceService{
     // 查询空间信息
     GetSpaceResponse GetSpace(1: GetSpaceRequest request) (api.get = "/api/foundation/v1/spaces/:space_id")
     // 空间列表
     ListUserSpaceResponse ListUserSpaces(1: ListUserSpaceRequest request) (api.post = "/api/foundation/v1/spaces/list")
+
+    // 空间成员列表
+    ListSpaceMembersResponse ListSpaceMembers(1: ListSpaceMembersRequest request) (api.post = "/api/foundation/v1/spaces/:space_id/members/list")
+    // 邀请空间成员
+    AddSpaceMemberResponse AddSpaceMember(1: AddSpaceMemberRequest request) (api.post = "/api/foundation/v1/spaces/:space_id/members")
+    // 移除空间成员
+    RemoveSpaceMemberResponse RemoveSpaceMember(1: RemoveSpaceMemberRequest request) (api.delete = "/api/foundation/v1/spaces/:space_id/members/:user_id")
+    // 修改空间成员角色
+    UpdateSpaceMemberRoleResponse UpdateSpaceMemberRole(1: UpdateSpaceMemberRoleRequest request) (api.put = "/api/foundation/v1/spaces/:space_id/members/:user_id")
 }
\ No newline at end of file
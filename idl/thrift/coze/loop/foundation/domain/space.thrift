@@ -1,5 +1,7 @@
 namespace go coze.loop.foundation.domain.space
 
+include "./user.thrift"
+
 // 空间类型
 enum SpaceType {
     Undefined = 0
@@ -20,4 +22,21 @@ struct Space {
     /* 8-10 保留位 */
     15: optional string enterprise_id   // 企业ID
     16: optional string organization_id // 组织ID
+}
+
+// 空间成员角色，值越小权限越大
+enum SpaceRoleType {
+    Undefined = 0
+    Owner = 1           // 所有者
+    Admin = 2           // 管理员，可管理成员
+    Editor = 3          // 编辑者，可创建、编辑、删除资源
+    Viewer = 4          // 查看者，只读
+}
+
+// 空间成员
+struct SpaceMember {
+    1: optional string user_id                                                  // 用户ID
+    2: optional SpaceRoleType role_type                                         // 成员角色
+    3: optional user.UserInfoDetail user_info                                   // 用户信息
+    4: optional i64 join_at (api.js_conv='true', go.tag='json:"join_at"')       // 加入时间
 }
\ No newline at end of file
@@ -14,7 +14,7 @@ struct Filter {
 struct ListModelsRequest {
     1: optional i64 workspace_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"workspace_id"')
     2: optional common.Scenario scenario
-    3: optional Filter filter
+    3: optional Filter filter // 未指定statuses时不返回不可用的模型
     4: optional bool preset_model // 是否为预置模型
 
 
@@ -51,7 +51,60 @@ struct GetModelResponse {
     255: base.BaseResp BaseResp
 }
 
+struct CreateModelRequest {
+    1: optional i64 workspace_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"workspace_id"')
+    2: optional manage.Model model (vt.not_nil='true')
+
+    255: optional base.Base Base
+}
+
+struct CreateModelResponse {
+    1: optional i64 model_id (api.js_conv='true', go.tag='json:"model_id"')
+
+    255: base.BaseResp BaseResp
+}
+
+struct UpdateModelRequest {
+    1: optional i64 workspace_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"workspace_id"')
+    2: optional i64 model_id (api.js_conv='true', api.path='model_id', vt.not_nil='true', vt.gt='0', go.tag='json:"model_id"')
+    3: optional manage.Model model (vt.not_nil='true') // protocol_config中的密钥为空时保留原值
+
+    255: optional base.Base Base
+}
+
+struct UpdateModelResponse {
+    255: base.BaseResp BaseResp
+}
+
+struct DeleteModelRequest {
+    1: optional i64 workspace_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"workspace_id"')
+    2: optional i64 model_id (api.js_conv='true', api.path='model_id', vt.not_nil='true', vt.gt='0', go.tag='json:"model_id"')
+
+    255: optional base.Base Base
+}
+
+struct DeleteModelResponse {
+    255: base.BaseResp BaseResp
+}
+
+struct UpdateModelStatusRequest {
+    1: optional i64 workspace_id (api.js_conv='true', vt.not_nil='true', vt.gt='0', go.tag='json:"workspace_id"')
+    2: optional i64 model_id (api.js_conv='true', api.path='model_id', vt.not_nil='true', vt.gt='0', go.tag='json:"model_id"')
+    3: optional manage.ModelStatus status (vt.not_nil='true')
+
+    255: optional base.Base Base
+}
+
+struct UpdateModelStatusResponse {
+    255: base.BaseResp BaseResp
+}
+
 service LLMManageService {
     ListModelsResponse ListModels(1: ListModelsRequest req) (api.post="/api/llm/v1/models/list")
     GetModelResponse GetModel(1: GetModelRequest req) (api.post="/api/llm/v1/models/:model_id")
+    CreateModelResponse CreateModel(1: CreateModelRequest req) (api.post="/api/llm/v1/models")
+    UpdateModelResponse UpdateModel(1: UpdateModelRequest req) (api.put="/api/llm/v1/models/:model_id")
+    DeleteModelResponse DeleteModel(1: DeleteModelRequest req) (api.delete="/api/llm/v1/models/:model_id")
+    // 启用或停用模型，停用后不可调用
+    UpdateModelStatusResponse UpdateModelStatus(1: UpdateModelStatusRequest req) (api.post="/api/llm/v1/models/:model_id/status")
 }
\ No newline at end of file
@@ -4,6 +4,8 @@ include "../../../base.thrift"
 include "./domain/filter.thrift"
 include "./domain/common.thrift"
 include "./domain/metric.thrift"
+include "./domain/alert.thrift"
+include "./domain/dashboard.thrift"
 
 
 struct GetMetricsRequest {
@@ -72,8 +74,175 @@ struct TraverseMetricsResponse {
     255: optional base.BaseResp BaseResp
 }
 
+struct QueryAggregationsRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id", vt.gt="0")
+    2: required i64 start_time (api.js_conv='true', go.tag='json:"start_time"', api.body="start_time", vt.gt="0")
+    3: required i64 end_time (api.js_conv='true', go.tag='json:"end_time"', api.body="end_time", vt.gt="0")
+    4: required list<metric.Aggregation> aggregations (api.body="aggregations", vt.min_size="1", vt.max_size="10")
+    5: optional list<filter.FilterField> group_bys (api.body="group_bys", vt.max_size="5")
+    6: optional filter.FilterFields filters (api.body="filters")
+    7: optional common.PlatformType platform_type (api.body="platform_type")
+    8: optional string granularity (api.body="granularity")   // 为空时不按时间分桶
+    9: optional i32 limit (api.body="limit")                  // 默认100, 最大1000
+
+    255: optional base.Base Base
+}
+
+struct QueryAggregationsResponse {
+    1: optional list<metric.AggregationRow> rows
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct CreateAlertRuleRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id", vt.gt="0")
+    2: required alert.AlertRule rule (api.body="rule")
+
+    255: optional base.Base Base
+}
+
+struct CreateAlertRuleResponse {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"')
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct UpdateAlertRuleRequest {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"', api.path="rule_id")
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id", vt.gt="0")
+    3: required alert.AlertRule rule (api.body="rule")
+
+    255: optional base.Base Base
+}
+
+struct UpdateAlertRuleResponse {
+    255: optional base.BaseResp BaseResp
+}
+
+struct DeleteAlertRuleRequest {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"', api.path="rule_id")
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.query="workspace_id", vt.gt="0")
+
+    255: optional base.Base Base
+}
+
+struct DeleteAlertRuleResponse {
+    255: optional base.BaseResp BaseResp
+}
+
+struct ListAlertRulesRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id", vt.gt="0")
+    2: optional string metric_name (api.body="metric_name")
+    3: optional alert.AlertStatus status (api.body="status")
+    4: optional i32 page_size (api.body="page_size")
+    5: optional string page_token (api.body="page_token")
+
+    255: optional base.Base Base
+}
+
+struct ListAlertRulesResponse {
+    1: required list<alert.AlertRule> rules
+    2: optional string next_page_token
+    3: optional bool has_more
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct CreateDashboardRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id", vt.gt="0")
+    2: required dashboard.Dashboard dashboard (api.body="dashboard")
+
+    255: optional base.Base Base
+}
+
+struct CreateDashboardResponse {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"')
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct UpdateDashboardRequest {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"', api.path="dashboard_id")
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id", vt.gt="0")
+    3: required dashboard.Dashboard dashboard (api.body="dashboard")
+
+    255: optional base.Base Base
+}
+
+struct UpdateDashboardResponse {
+    255: optional base.BaseResp BaseResp
+}
+
+struct DeleteDashboardRequest {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"', api.path="dashboard_id")
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.query="workspace_id", vt.gt="0")
+
+    255: optional base.Base Base
+}
+
+struct DeleteDashboardResponse {
+    255: optional base.BaseResp BaseResp
+}
+
+struct GetDashboardRequest {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"', api.path="dashboard_id")
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.query="workspace_id", vt.gt="0")
+
+    255: optional base.Base Base
+}
+
+struct GetDashboardResponse {
+    1: required dashboard.Dashboard dashboard
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct ListDashboardsRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id", vt.gt="0")
+    2: optional i32 page_size (api.body="page_size")
+    3: optional string page_token (api.body="page_token")
+
+    255: optional base.Base Base
+}
+
+struct ListDashboardsResponse {
+    1: required list<dashboard.Dashboard> dashboards
+    2: optional string next_page_token
+    3: optional bool has_more
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct RenderDashboardRequest {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"', api.path="dashboard_id")
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id", vt.gt="0")
+    3: required i64 start_time (api.js_conv='true', go.tag='json:"start_time"', api.body="start_time", vt.gt="0")
+    4: required i64 end_time (api.js_conv='true', go.tag='json:"end_time"', api.body="end_time", vt.gt="0")
+    5: optional filter.FilterFields filters (api.body="filters")   // 与看板和面板的过滤条件取交集
+    6: optional list<string> panel_ids (api.body="panel_ids")     // 为空时渲染全部面板
+
+    255: optional base.Base Base
+}
+
+struct RenderDashboardResponse {
+    1: required list<dashboard.RenderedPanel> panels
+
+    255: optional base.BaseResp BaseResp
+}
+
 service MetricService {
     GetMetricsResponse GetMetrics(1: GetMetricsRequest Req) (api.post='/api/observability/v1/metrics/list')
     GetDrillDownValuesResponse GetDrillDownValues(1: GetDrillDownValuesRequest Req) (api.post='/api/observability/v1/metrics/drill_down_values')
     TraverseMetricsResponse TraverseMetrics(1: TraverseMetricsRequest Req)
+    QueryAggregationsResponse QueryAggregations(1: QueryAggregationsRequest Req) (api.post='/api/observability/v1/metrics/aggregations')
+    CreateAlertRuleResponse CreateAlertRule(1: CreateAlertRuleRequest Req) (api.post='/api/observability/v1/metrics/alert_rules')
+    UpdateAlertRuleResponse UpdateAlertRule(1: UpdateAlertRuleRequest Req) (api.put='/api/observability/v1/metrics/alert_rules/:rule_id')
+    DeleteAlertRuleResponse DeleteAlertRule(1: DeleteAlertRuleRequest Req) (api.delete='/api/observability/v1/metrics/alert_rules/:rule_id')
+    ListAlertRulesResponse ListAlertRules(1: ListAlertRulesRequest Req) (api.post='/api/observability/v1/metrics/alert_rules/list')
+    CreateDashboardResponse CreateDashboard(1: CreateDashboardRequest Req) (api.post='/api/observability/v1/metrics/dashboards')
+    UpdateDashboardResponse UpdateDashboard(1: UpdateDashboardRequest Req) (api.put='/api/observability/v1/metrics/dashboards/:dashboard_id')
+    DeleteDashboardResponse DeleteDashboard(1: DeleteDashboardRequest Req) (api.delete='/api/observability/v1/metrics/dashboards/:dashboard_id')
+    GetDashboardResponse GetDashboard(1: GetDashboardRequest Req) (api.get='/api/observability/v1/metrics/dashboards/:dashboard_id')
+    ListDashboardsResponse ListDashboards(1: ListDashboardsRequest Req) (api.post='/api/observability/v1/metrics/dashboards/list')
+    RenderDashboardResponse RenderDashboard(1: RenderDashboardRequest Req) (api.post='/api/observability/v1/metrics/dashboards/:dashboard_id/render')
 }
@@ -10,6 +10,10 @@ include "./domain/annotation.thrift"
 include "./domain/export_dataset.thrift"
 include "./domain/task.thrift"
 include "../trajectory.thrift"
+include "./domain/retention.thrift"
+include "./domain/trace_diff.thrift"
+include "./domain/session.thrift"
+include "./domain/trace_export.thrift"
 
 struct ListSpansRequest {
     1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id")
@@ -421,6 +425,113 @@ struct ListTrajectoryResponse {
     255: optional base.BaseResp BaseResp
 }
 
+struct UpsertRetentionPolicyRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"',api.body="workspace_id")
+    2: required retention.RetentionPolicy policy (api.body="policy")
+
+    255: optional base.Base Base
+}
+
+struct UpsertRetentionPolicyResponse {
+    255: optional base.BaseResp BaseResp
+}
+
+struct GetRetentionPolicyRequest {
+    1: required i64 workspace_id (api.js_conv='true',api.query='workspace_id')
+
+    255: optional base.Base Base
+}
+
+struct GetRetentionPolicyResponse {
+    1: optional retention.RetentionPolicy policy // 未配置时为空
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct DiffTracesRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id")
+    2: required TraceQueryParams base_trace (api.body="base_trace") // 基准trace
+    3: required TraceQueryParams compare_trace (api.body="compare_trace") // 对比trace
+    4: optional common.PlatformType platform_type (api.body="platform_type")
+
+    255: optional base.Base Base
+}
+
+struct DiffTracesResponse {
+    1: required list<trace_diff.SpanDiffNode> nodes // 对齐后的span树，按根节点排列
+    2: required trace_diff.TraceDiffSummary summary
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct ListSessionsRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id")
+    2: required i64 start_time (api.js_conv='true', go.tag='json:"start_time"', api.body="start_time") // ms
+    3: required i64 end_time (api.js_conv='true', go.tag='json:"end_time"', api.body="end_time")  // ms
+    4: optional filter.FilterFields filters (api.body="filters") // 作用于会话内的span
+    5: optional i32 page_size (api.body="page_size")
+    6: optional string page_token (api.body="page_token")
+    7: optional common.PlatformType platform_type (api.body="platform_type")
+
+    255: optional base.Base Base
+}
+
+struct ListSessionsResponse {
+    1: required list<session.Session> sessions // 按最近活跃时间倒序，统计携带thread_id的span
+    2: required string next_page_token
+    3: required bool has_more
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct GetSessionRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id")
+    2: required string session_id (api.body="session_id")
+    3: required i64 start_time (api.js_conv='true', go.tag='json:"start_time"', api.body="start_time") // ms
+    4: required i64 end_time (api.js_conv='true', go.tag='json:"end_time"', api.body="end_time")  // ms
+    5: optional common.PlatformType platform_type (api.body="platform_type")
+
+    255: optional base.Base Base
+}
+
+struct GetSessionResponse {
+    1: required session.Session session // 各轮按开始时间升序
+    2: optional bool truncated // 会话过长时只返回最近的部分轮次
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct ExportTracesToFileRequest {
+    1: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.body="workspace_id")
+    2: required i64 start_time (api.js_conv='true', go.tag='json:"start_time"', api.body="start_time") // ms
+    3: required i64 end_time (api.js_conv='true', go.tag='json:"end_time"', api.body="end_time")  // ms
+    4: required trace_export.ExportFileFormat format (api.body="format")
+    5: optional filter.FilterFields filters (api.body="filters")
+    6: optional common.PlatformType platform_type (api.body="platform_type")
+    7: optional common.SpanListType span_list_type (api.body="span_list_type") // 默认导出全部span
+
+    255: optional base.Base Base
+}
+
+struct ExportTracesToFileResponse {
+    1: required i64 job_id (api.js_conv='true', go.tag='json:"job_id"') // 导出异步执行，通过GetTraceExportJob查询进度
+
+    255: optional base.BaseResp BaseResp
+}
+
+struct GetTraceExportJobRequest {
+    1: required i64 job_id (api.js_conv='true', go.tag='json:"job_id"', api.path="job_id")
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"', api.query="workspace_id")
+
+    255: optional base.Base Base
+}
+
+struct GetTraceExportJobResponse {
+    1: required trace_export.TraceExportJob job
+
+    255: optional base.BaseResp BaseResp
+}
+
 service TraceService {
     ListSpansResponse ListSpans(1: ListSpansRequest req) (api.post = '/api/observability/v1/spans/list')
     ListPreSpanResponse ListPreSpan(1: ListPreSpanRequest req) (api.post = '/api/observability/v1/spans/pre_list')
@@ -445,4 +556,11 @@ service TraceService {
     UpsertTrajectoryConfigResponse UpsertTrajectoryConfig(1: UpsertTrajectoryConfigRequest req) (api.post = '/api/observability/v1/traces/trajectory_config')
     GetTrajectoryConfigResponse GetTrajectoryConfig(1: GetTrajectoryConfigRequest req) (api.get = '/api/observability/v1/traces/trajectory_config')
     ListTrajectoryResponse ListTrajectory(1: ListTrajectoryRequest req) (api.post = '/api/observability/v1/traces/trajectory')
+    UpsertRetentionPolicyResponse UpsertRetentionPolicy(1: UpsertRetentionPolicyRequest req) (api.post = '/api/observability/v1/traces/retention_policy')
+    GetRetentionPolicyResponse GetRetentionPolicy(1: GetRetentionPolicyRequest req) (api.get = '/api/observability/v1/traces/retention_policy')
+    DiffTracesResponse DiffTraces(1: DiffTracesRequest req) (api.post = '/api/observability/v1/traces/diff')
+    ListSessionsResponse ListSessions(1: ListSessionsRequest req) (api.post = '/api/observability/v1/sessions/list')
+    GetSessionResponse GetSession(1: GetSessionRequest req) (api.post = '/api/observability/v1/sessions/get')
+    ExportTracesToFileResponse ExportTracesToFile(1: ExportTracesToFileRequest req) (api.post = '/api/observability/v1/traces/export_to_file')
+    GetTraceExportJobResponse GetTraceExportJob(1: GetTraceExportJobRequest req) (api.get = '/api/observability/v1/traces/export_jobs/:job_id')
 }
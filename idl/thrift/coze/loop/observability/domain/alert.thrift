@@ -0,0 +1,63 @@
+namespace go coze.loop.observability.domain.alert
+
+include "common.thrift"
+include "filter.thrift"
+
+typedef string ConditionType (ts.enum="true")
+const ConditionType ConditionType_Threshold = "threshold" // 与固定阈值比较
+const ConditionType ConditionType_Anomaly = "anomaly"     // 与历史窗口的均值和标准差比较
+
+typedef string CompareOperator (ts.enum="true")
+const CompareOperator CompareOperator_GT = "gt"
+const CompareOperator CompareOperator_GTE = "gte"
+const CompareOperator CompareOperator_LT = "lt"
+const CompareOperator CompareOperator_LTE = "lte"
+
+typedef string AlertStatus (ts.enum="true")
+const AlertStatus AlertStatus_OK = "ok"
+const AlertStatus AlertStatus_Firing = "firing"
+const AlertStatus AlertStatus_NoData = "no_data"
+
+struct AlertCondition {
+    1: required ConditionType type
+    2: required CompareOperator operator
+    // 阈值条件使用
+    3: optional double threshold
+    // 异常条件使用，偏离历史均值超过多少倍标准差时告警
+    4: optional double deviation
+    // 异常条件使用，参与计算基线的历史窗口数
+    5: optional i32 baseline_windows
+}
+
+struct AlertNotification {
+    // 通过消息卡片通知的用户
+    1: optional list<string> user_ids
+    2: optional string webhook_url
+    3: optional map<string, string> webhook_headers
+}
+
+struct AlertState {
+    1: optional AlertStatus status
+    2: optional double last_value
+    3: optional i64 last_evaluated_at (api.js_conv='true', go.tag='json:"last_evaluated_at"')
+    4: optional i64 fired_at (api.js_conv='true', go.tag='json:"fired_at"')
+    5: optional i64 resolved_at (api.js_conv='true', go.tag='json:"resolved_at"')
+}
+
+struct AlertRule {
+    1: optional i64 id (api.js_conv='true', go.tag='json:"id"')
+    2: optional i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"')
+    3: required string name
+    4: optional common.PlatformType platform_type
+    5: required string metric_name
+    // 时间序列指标取哪一组，饼图指标取哪一项，为空时取全部
+    6: optional string group_key
+    7: optional filter.FilterFields filters
+    8: required AlertCondition condition
+    // 评估窗口，单位秒
+    9: required i64 window_seconds (api.js_conv='true', go.tag='json:"window_seconds"')
+    10: optional bool enabled
+    11: optional AlertNotification notification
+    12: optional AlertState state
+    13: optional common.BaseInfo base_info
+}
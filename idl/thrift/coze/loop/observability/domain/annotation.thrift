@@ -8,6 +8,7 @@ const AnnotationType AnnotationType_EvaluationSet = "manual_evaluation_set"
 const AnnotationType AnnotationType_ManualFeedback = "manual_feedback"
 const AnnotationType AnnotationType_CozeFeedback = "coze_feedback"
 const AnnotationType AnnotationType_OpenAPIFeedback = "openapi_feedback"
+const AnnotationType AnnotationType_AutoTag = "auto_tag"
 
 typedef string ValueType (ts.enum="true")
 const ValueType ValueType_String = "string"
@@ -0,0 +1,51 @@
+namespace go coze.loop.observability.domain.dashboard
+
+include "common.thrift"
+include "filter.thrift"
+include "metric.thrift"
+
+typedef string ChartType (ts.enum="true")
+const ChartType ChartType_Line = "line"
+const ChartType ChartType_Bar = "bar"
+const ChartType ChartType_Pie = "pie"
+const ChartType ChartType_Number = "number"
+const ChartType ChartType_Table = "table"
+
+struct PanelLayout {
+    1: optional i32 x
+    2: optional i32 y
+    3: optional i32 width
+    4: optional i32 height
+}
+
+// 面板引用预定义指标或即席聚合, 二者只能选其一
+struct DashboardPanel {
+    1: required string id                               // 看板内唯一
+    2: required string title
+    3: required ChartType chart_type
+    4: optional list<string> metric_names
+    5: optional list<metric.Aggregation> aggregations
+    6: optional list<filter.FilterField> group_bys      // 仅即席聚合使用
+    7: optional filter.FilterFields filters
+    8: optional string granularity
+    9: optional i32 limit                               // 仅即席聚合使用
+    10: optional PanelLayout layout
+}
+
+struct Dashboard {
+    1: optional i64 id (api.js_conv='true', go.tag='json:"id"')
+    2: optional i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"')
+    3: required string name
+    4: optional string description
+    5: optional common.PlatformType platform_type
+    6: optional filter.FilterFields filters                // 作用于所有面板
+    7: optional list<DashboardPanel> panels
+    8: optional common.BaseInfo base_info
+}
+
+struct RenderedPanel {
+    1: required string panel_id
+    2: optional map<string, metric.Metric> metrics           // 预定义指标面板返回
+    3: optional list<metric.AggregationRow> rows             // 即席聚合面板返回
+    4: optional string error_msg                             // 单个面板查询失败不影响其他面板
+}
@@ -1,5 +1,7 @@
 namespace go coze.loop.observability.domain.metric
 
+include "filter.thrift"
+
 typedef string CompareType (ts.enum="true")
 const CompareType CompareType_YoY = "yoy" // 同比
 const CompareType CompareType_MoM = "mom" // 环比
@@ -10,6 +12,17 @@ const DrillDownValueType DrillDownValueType_ModelName = "model_name"
 const DrillDownValueType DrillDownValueType_ToolName= "tool_name"
 const DrillDownValueType DrillDownValueType_InnerModelName= "inner_model_name"
 
+typedef string AggregationType (ts.enum="true")
+const AggregationType AggregationType_Count = "count"
+const AggregationType AggregationType_Sum = "sum"
+const AggregationType AggregationType_Avg = "avg"
+const AggregationType AggregationType_Min = "min"
+const AggregationType AggregationType_Max = "max"
+const AggregationType AggregationType_P50 = "p50"
+const AggregationType AggregationType_P90 = "p90"
+const AggregationType AggregationType_P99 = "p99"
+const AggregationType AggregationType_Uniq = "uniq"
+
 struct Metric {
     1: optional string summary
     2: optional map<string, string> pie
@@ -21,6 +34,19 @@ struct MetricPoint {
     2: optional string value
 }
 
+// 即席聚合, count以外的聚合方式需要指定字段
+struct Aggregation {
+    1: required AggregationType aggregation_type
+    2: optional filter.FilterField field
+    3: optional string alias                        // 结果中的名称, 为空时使用aggregation_type(field_name)
+}
+
+struct AggregationRow {
+    1: optional string timestamp                    // 指定时间粒度时返回
+    2: optional map<string, string> group_by_values // key为group by的字段名
+    3: optional map<string, string> values          // key为聚合的名称
+}
+
 struct Compare {
     1: optional CompareType compare_type
     2: optional i64 shift_seconds (api.js_conv='true', go.tag='json:"shift_seconds"', vt.gt='0')
@@ -0,0 +1,25 @@
+namespace go coze.loop.observability.domain.retention
+
+include "common.thrift"
+
+typedef string TTL (ts.enum="true")
+const TTL TTL_3d = "3d"
+const TTL TTL_7d = "7d"
+const TTL TTL_30d = "30d"
+const TTL TTL_90d = "90d"
+const TTL TTL_180d = "180d"
+const TTL TTL_365d = "365d"
+
+struct RetentionRule {
+    1: required list<string> span_types // 命中的span类型，如model、prompt
+    2: required TTL ttl
+}
+
+struct RetentionPolicy {
+    1: optional TTL default_ttl // 未命中规则的span的保留时长，为空时按空间权益保留
+    2: optional list<RetentionRule> rules // 按顺序匹配，命中第一条规则
+    3: optional bool keep_annotated // 有标注的span在过期前转存到长期存储，转存后保留365天
+    4: optional bool keep_dataset_referenced // 被导入数据集的span在过期前转存到长期存储，转存后保留365天
+    5: optional common.BaseInfo base_info
+    6: optional bool archive_permanently // 转存的span永久保留，每次过期前重新转存；需同时开启keep_annotated或keep_dataset_referenced
+}
@@ -0,0 +1,26 @@
+namespace go coze.loop.observability.domain.session
+
+include "span.thrift"
+
+// 会话中的一轮，对应一条trace
+struct SessionTurn {
+    1: required string trace_id
+    2: required i32 turn // 轮次，从1开始
+    3: required i64 start_time (api.js_conv='true', go.tag='json:"start_time"') // ms
+    4: required i64 latency (api.js_conv='true', go.tag='json:"latency"') // ms
+    5: required i64 input_tokens (api.js_conv='true', go.tag='json:"input_tokens"')
+    6: required i64 output_tokens (api.js_conv='true', go.tag='json:"output_tokens"')
+    7: optional span.OutputSpan root_span
+}
+
+// 以thread_id标识的多轮会话
+struct Session {
+    1: required string session_id
+    2: required i64 turn_count (api.js_conv='true', go.tag='json:"turn_count"')
+    3: required i64 input_tokens (api.js_conv='true', go.tag='json:"input_tokens"')
+    4: required i64 output_tokens (api.js_conv='true', go.tag='json:"output_tokens"')
+    5: required i64 latency (api.js_conv='true', go.tag='json:"latency"') // ms, 各轮耗时之和
+    6: required i64 start_time (api.js_conv='true', go.tag='json:"start_time"') // ms
+    7: required i64 end_time (api.js_conv='true', go.tag='json:"end_time"') // ms
+    8: optional list<SessionTurn> turns // 仅GetSession返回
+}
@@ -12,6 +12,7 @@ const TimeUnit TimeUnit_Null = "null"
 typedef string TaskType (ts.enum="true")
 const TaskType TaskType_AutoEval = "auto_evaluate"          // 自动评测
 const TaskType TaskType_AutoDataReflow = "auto_data_reflow" // 数据回流
+const TaskType TaskType_AutoTag = "auto_tag"                 // 自动打标
 
 typedef string TaskRunType (ts.enum="true")
 const TaskRunType TaskRunType_BackFill = "back_fill"     // 历史数据回填
@@ -29,6 +30,11 @@ typedef string RunStatus (ts.enum="true")
 const RunStatus RunStatus_Running = "running"       // 正在运行
 const RunStatus RunStatus_Done = "done"           // 完成运行
 
+typedef string SamplingStrategy (ts.enum="true")
+const SamplingStrategy SamplingStrategy_Uniform = "uniform"             // 按采样率均匀采样
+const SamplingStrategy SamplingStrategy_Stratified = "stratified"       // 按字段分层, 每层采样数量相同
+const SamplingStrategy SamplingStrategy_ErrorBiased = "error_biased"    // 失败span按更高的采样率采样
+
 typedef string TaskSource (ts.enum="true")
 const TaskSource TaskSource_User = "user"       // 用户创建
 const TaskSource TaskSource_Workflow = "workflow"   // 工作流创建
@@ -65,6 +71,24 @@ struct Sampler {
     4: optional i64 cycle_count                                                             // 采样单次上限
     5: optional i64 cycle_interval                                                          // 循环间隔
     6: optional TimeUnit cycle_time_unit                                                    // 循环时间单位
+    7: optional SamplingStrategy sampling_strategy                                          // 采样策略, 默认uniform
+    8: optional StratifiedSampling stratified_sampling                                      // 分层采样配置, sampling_strategy为stratified时必填
+    9: optional ErrorBiasedSampling error_biased_sampling                                   // 失败偏置采样配置
+    10: optional SampleDedup dedup                                                          // 相似输入去重(simhash汉明距离不超过3视为相似), 可与任意采样策略组合
+}
+
+struct StratifiedSampling {
+    1: required string field_name                                                           // 分层字段, 如prompt_key、model_name
+    2: required i64 per_stratum_size                                                        // 每层采样上限
+}
+
+struct ErrorBiasedSampling {
+    1: optional double error_sample_rate                                                    // 失败span的采样率, 默认1.0; 成功span使用sample_rate
+}
+
+struct SampleDedup {
+    1: optional bool enabled
+    2: optional string field_name                                                           // 去重字段, 默认input
 }
 
 struct EffectiveTime {
@@ -77,6 +101,7 @@ struct EffectiveTime {
 struct TaskConfig {
     1: optional list<AutoEvaluateConfig> auto_evaluate_configs               // 配置的评测规则信息
     2: optional list<DataReflowConfig> data_reflow_config                    // 配置的数据回流的数据集信息
+    3: optional list<AutoTagConfig> auto_tag_configs                         // 配置的自动打标规则信息
 }
 
 struct DataReflowConfig {
@@ -92,11 +117,26 @@ struct AutoEvaluateConfig {
     3: required list<EvaluateFieldMapping> field_mappings
 }
 
+struct AutoTagConfig {
+    1: required i64 evaluator_version_id (api.js_conv="true", go.tag='json:"evaluator_version_id"')
+    2: required i64 evaluator_id (api.js_conv="true", go.tag='json:"evaluator_id"')
+    3: required list<EvaluateFieldMapping> field_mappings
+    4: required string tag_key                                                              // 写回span的标签key, 如intent/topic/language/sentiment
+    5: optional list<string> labels                                                         // 候选标签, 按评估器分数下标或reasoning匹配取值
+}
+
 // RunDetail
 struct RunDetail {
     1: optional i64 success_count
     2: optional i64 failed_count
     3: optional i64 total_count
+    4: optional SampleStats sample_stats                                                    // 实际采样情况
+}
+
+struct SampleStats {
+    1: optional map<string, i64> stratum_counts                                             // 分层采样各层的实际样本数
+    2: optional i64 error_count                                                             // 采样到的失败span数
+    3: optional i64 dedup_skipped_count                                                     // 因输入相似被跳过的span数
 }
 
 struct BackfillDetail {
@@ -0,0 +1,44 @@
+namespace go coze.loop.observability.domain.trace_diff
+
+typedef string DiffType (ts.enum="true")
+const DiffType DiffType_Same = "same"
+const DiffType DiffType_Changed = "changed"
+const DiffType DiffType_Added = "added" // 仅在对比trace中存在
+const DiffType DiffType_Removed = "removed" // 仅在基准trace中存在
+
+typedef string TextDiffOp (ts.enum="true")
+const TextDiffOp TextDiffOp_Equal = "equal"
+const TextDiffOp TextDiffOp_Insert = "insert"
+const TextDiffOp TextDiffOp_Delete = "delete"
+
+struct TextDiffLine {
+    1: required TextDiffOp op
+    2: required string text
+}
+
+struct SpanDiffNode {
+    1: optional string base_span_id
+    2: optional string compare_span_id
+    3: required string span_name
+    4: required string span_type
+    5: required DiffType diff_type
+    6: optional list<string> changed_fields // input、output、status_code
+    7: optional i64 base_duration (api.js_conv='true', go.tag='json:"base_duration"') // ms
+    8: optional i64 compare_duration (api.js_conv='true', go.tag='json:"compare_duration"') // ms
+    9: optional i64 latency_delta (api.js_conv='true', go.tag='json:"latency_delta"') // ms, 对比减基准
+    10: optional i64 input_tokens_delta (api.js_conv='true', go.tag='json:"input_tokens_delta"')
+    11: optional i64 output_tokens_delta (api.js_conv='true', go.tag='json:"output_tokens_delta"')
+    12: optional list<TextDiffLine> input_diff // 仅在输入有变化时返回
+    13: optional list<TextDiffLine> output_diff // 仅在输出有变化时返回
+    14: optional list<SpanDiffNode> children
+}
+
+struct TraceDiffSummary {
+    1: required i32 added_spans
+    2: required i32 removed_spans
+    3: required i32 changed_spans
+    4: required i32 changed_tool_calls
+    5: required i64 latency_delta (api.js_conv='true', go.tag='json:"latency_delta"') // ms, 根节点耗时之差
+    6: required i64 input_tokens_delta (api.js_conv='true', go.tag='json:"input_tokens_delta"')
+    7: required i64 output_tokens_delta (api.js_conv='true', go.tag='json:"output_tokens_delta"')
+}
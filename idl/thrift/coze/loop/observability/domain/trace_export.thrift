@@ -0,0 +1,30 @@
+namespace go coze.loop.observability.domain.trace_export
+
+include "common.thrift"
+include "filter.thrift"
+
+typedef string ExportFileFormat (ts.enum="true")
+const ExportFileFormat ExportFileFormat_JSONL = "jsonl" // 每行一个span
+const ExportFileFormat ExportFileFormat_OTLPJSON = "otlp_json" // 每行一个OTLP ExportTraceServiceRequest
+
+typedef string ExportJobStatus (ts.enum="true")
+const ExportJobStatus ExportJobStatus_Running = "running"
+const ExportJobStatus ExportJobStatus_Success = "success"
+const ExportJobStatus ExportJobStatus_Failed = "failed"
+
+struct TraceExportJob {
+    1: required i64 id (api.js_conv='true', go.tag='json:"id"')
+    2: required i64 workspace_id (api.js_conv='true', go.tag='json:"workspace_id"')
+    3: required ExportFileFormat format
+    4: required ExportJobStatus status
+    5: required i64 start_time (api.js_conv='true', go.tag='json:"start_time"') // ms
+    6: required i64 end_time (api.js_conv='true', go.tag='json:"end_time"') // ms
+    7: optional filter.FilterFields filters
+    8: optional common.PlatformType platform_type
+    9: optional common.SpanListType span_list_type
+    10: required i64 exported_count (api.js_conv='true', go.tag='json:"exported_count"') // 已导出的span数
+    11: optional string download_url // 仅导出成功时返回
+    12: optional string error_msg
+    13: optional common.BaseInfo base_info
+    14: optional bool truncated // 符合条件的span超过单任务导出上限时为true, 仅导出了前面的部分
+}
@@ -81,6 +81,7 @@ const TemplateType TemplateType_CustomTemplate_M = "custom_template_m"
 struct Tool {
     1: optional ToolType type
     2: optional Function function
+    3: optional ToolExecutor executor // 绑定的真实执行器，未绑定时使用mock_tools中的mock结果
 }
 
 typedef string ToolType (ts.enum="true")
@@ -93,6 +94,39 @@ struct Function {
     3: optional string parameters
 }
 
+struct ToolExecutor {
+    1: optional ToolExecutorType type
+    2: optional HTTPToolExecutor http
+    3: optional MCPToolExecutor mcp
+}
+
+typedef string ToolExecutorType (ts.enum="true")
+const ToolExecutorType ToolExecutorType_HTTP = "http"
+const ToolExecutorType ToolExecutorType_MCP = "mcp"
+
+// 以工具调用参数作为请求体调用HTTP接口，响应体作为工具结果
+struct HTTPToolExecutor {
+    1: optional string url
+    2: optional string method // 默认POST
+    3: optional map<string, string> headers
+    4: optional i64 timeout_ms (api.js_conv="true", go.tag='json:"timeout_ms"')
+}
+
+typedef string MCPTransport (ts.enum="true")
+const MCPTransport MCPTransport_Stdio = "stdio"
+const MCPTransport MCPTransport_HTTP = "http"
+
+// 通过MCP协议的tools/call调用MCP server上的工具
+struct MCPToolExecutor {
+    1: optional MCPTransport transport
+    2: optional string url // http transport的服务地址
+    3: optional map<string, string> headers
+    4: optional string command // stdio transport的启动命令，需在服务端配置的白名单内
+    5: optional list<string> args
+    6: optional string tool_name // MCP server上的工具名，为空时使用function name
+    7: optional i64 timeout_ms (api.js_conv="true", go.tag='json:"timeout_ms"')
+}
+
 struct ToolCallConfig {
     1: optional ToolChoiceType tool_choice
     2: optional ToolChoiceSpecification tool_choice_specification
@@ -66,6 +66,7 @@ struct ModelInfo {
     4: optional i32 reasoning_tokens
     5: optional i32 input_read_cached_tokens
     6: optional i32 input_creation_cached_tokens
+    7: optional double cost // 按模型价格表计算的费用
 }
 
 struct BasicInfo {
@@ -89,4 +90,5 @@ struct MetricsInfo {
     7: optional double tool_step_proportion // Tool Step占比(分母是总子Step)
     8: optional i32 input_tokens // 输入token数
     9: optional i32 output_tokens // 输出token数
+    10: optional double total_cost // 模型调用总费用
 }
\ No newline at end of file
@@ -30,7 +30,15 @@ CREATE TABLE IF NOT EXISTS `observability_spans` (
     INDEX idx_span_type span_type TYPE bloom_filter() GRANULARITY 1,
     INDEX idx_span_name span_name TYPE bloom_filter() GRANULARITY 1,
     INDEX idx_input input TYPE tokenbf_v1(32768, 3, 0) GRANULARITY 4,
-    INDEX idx_output output TYPE tokenbf_v1(32768, 3, 0) GRANULARITY 4
+    INDEX idx_output output TYPE tokenbf_v1(32768, 3, 0) GRANULARITY 4,
+    INDEX idx_thread_id tags_string['thread_id'] TYPE bloom_filter() GRANULARITY 1
 ) ENGINE = MergeTree() Partition by toDate(start_time / 1000000)
 PRIMARY KEY (start_time)
-ORDER BY (start_time);
\ No newline at end of file
+ORDER BY (start_time)
+TTL toDateTime(intDiv(logic_delete_date, 1000000));
+
+-- 存量表补齐按 logic_delete_date 过期的TTL；初始化脚本每次启动都会执行，关闭立即物化以免重写全部分区，存量数据在合并时生效
+SET materialize_ttl_after_modify = 0;
+ALTER TABLE `observability_spans` MODIFY TTL toDateTime(intDiv(logic_delete_date, 1000000));
+
+ALTER TABLE `observability_spans` ADD INDEX IF NOT EXISTS idx_thread_id tags_string['thread_id'] TYPE bloom_filter() GRANULARITY 1;
\ No newline at end of file
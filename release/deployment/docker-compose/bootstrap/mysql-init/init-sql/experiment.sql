@@ -25,6 +25,7 @@ CREATE TABLE IF NOT EXISTS `experiment`
     `source_id`           varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '0' COMMENT '实验来源id',
     `expt_type`           int unsigned                                                   NOT NULL DEFAULT '1' COMMENT '实验类型，offline:1,online:2...',
     `max_alive_time`      bigint unsigned                                                         DEFAULT NULL COMMENT '最大存活时间',
+    `gate_result`         blob COMMENT '门禁结论',
     PRIMARY KEY (`id`),
     UNIQUE KEY `uk_expt_item_idx` (`space_id`, `name`, `deleted_at`),
     KEY `idx_space_deleted_created_by` (`space_id`, `created_by`, `deleted_at`),
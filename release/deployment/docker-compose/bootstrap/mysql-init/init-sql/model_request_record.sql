@@ -11,6 +11,7 @@ CREATE TABLE IF NOT EXISTS `model_request_record`
     `model_ak`              varchar(1024)   NOT NULL DEFAULT '' COMMENT '模型的AK',
     `model_id`              varchar(256)    NOT NULL DEFAULT '' COMMENT 'model id',
     `model_name`            varchar(1024)   NOT NULL DEFAULT '' COMMENT '模型展示名称',
+    `requested_model_id`    varchar(256)    NOT NULL DEFAULT '' COMMENT '请求的模型id，发生降级时与 model_id 不同',
     `input_token`           bigint unsigned NOT NULL DEFAULT '0' COMMENT '输入token数量',
     `output_token`          bigint unsigned NOT NULL DEFAULT '0' COMMENT '输出token数量',
     `logid`                 varchar(128)    NOT NULL DEFAULT '' COMMENT 'logid',
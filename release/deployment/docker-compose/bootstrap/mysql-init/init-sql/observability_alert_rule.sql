@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS `observability_alert_rule`
+(
+    `id`                bigint unsigned                          NOT NULL COMMENT '主键ID',
+    `workspace_id`      bigint unsigned                          NOT NULL DEFAULT '0' COMMENT '空间 ID',
+    `name`              varchar(256) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '告警规则名称',
+    `platform_type`     varchar(128)                             NOT NULL DEFAULT '' COMMENT '数据来源',
+    `metric_name`       varchar(128) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '指标名称',
+    `group_key`         varchar(1024) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '指标分组',
+    `filters`           json                                              DEFAULT NULL COMMENT '过滤条件',
+    `alert_condition`   json                                              DEFAULT NULL COMMENT '告警条件',
+    `window_seconds`    bigint                                   NOT NULL DEFAULT '0' COMMENT '评估窗口, s',
+    `enabled`           tinyint(1)                               NOT NULL DEFAULT '0' COMMENT '是否启用',
+    `notification`      json                                              DEFAULT NULL COMMENT '通知配置',
+    `status`            varchar(32) COLLATE utf8mb4_general_ci   NOT NULL DEFAULT '' COMMENT '告警状态',
+    `last_value`        double                                   NOT NULL DEFAULT '0' COMMENT '最近一次评估的指标值',
+    `last_evaluated_at` bigint                                   NOT NULL DEFAULT '0' COMMENT '最近一次评估时间, ms',
+    `fired_at`          bigint                                   NOT NULL DEFAULT '0' COMMENT '最近一次触发时间, ms',
+    `resolved_at`       bigint                                   NOT NULL DEFAULT '0' COMMENT '最近一次恢复时间, ms',
+    `created_at`        datetime                                 NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `created_by`        varchar(128) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '创建人',
+    `updated_at`        datetime                                 NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '修改时间',
+    `updated_by`        varchar(128) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '修改人',
+    PRIMARY KEY (`id`),
+    KEY `idx_workspace_id_metric_name` (`workspace_id`, `metric_name`),
+    KEY `idx_enabled` (`enabled`)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_general_ci COMMENT='观测指标告警规则';
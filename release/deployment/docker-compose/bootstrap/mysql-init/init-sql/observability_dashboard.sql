@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS `observability_dashboard`
+(
+    `id`            bigint unsigned                          NOT NULL COMMENT '主键ID',
+    `workspace_id`  bigint unsigned                          NOT NULL DEFAULT '0' COMMENT '空间 ID',
+    `name`          varchar(256) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '看板名称',
+    `description`   varchar(1024) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '看板描述',
+    `platform_type` varchar(128)                             NOT NULL DEFAULT '' COMMENT '数据来源',
+    `filters`       json                                              DEFAULT NULL COMMENT '看板级过滤条件',
+    `panels`        json                                              DEFAULT NULL COMMENT '面板配置',
+    `created_at`    datetime                                 NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `created_by`    varchar(128) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '创建人',
+    `updated_at`    datetime                                 NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '修改时间',
+    `updated_by`    varchar(128) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '修改人',
+    PRIMARY KEY (`id`),
+    KEY `idx_workspace_id` (`workspace_id`)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_general_ci COMMENT='观测自定义看板';
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS `observability_trace_export_job`
+(
+    `id`             bigint unsigned                          NOT NULL COMMENT '主键ID',
+    `workspace_id`   bigint unsigned                          NOT NULL DEFAULT '0' COMMENT '空间 ID',
+    `platform_type`  varchar(128)                             NOT NULL DEFAULT '' COMMENT '数据来源',
+    `span_list_type` varchar(128)                             NOT NULL DEFAULT '' COMMENT '导出的span类型',
+    `format`         varchar(32)                              NOT NULL DEFAULT '' COMMENT '导出文件格式',
+    `start_time`     bigint                                   NOT NULL DEFAULT '0' COMMENT '查询开始时间(ms)',
+    `end_time`       bigint                                   NOT NULL DEFAULT '0' COMMENT '查询结束时间(ms)',
+    `filters`        json                                              DEFAULT NULL COMMENT '过滤条件',
+    `status`         varchar(32)                              NOT NULL DEFAULT '' COMMENT '任务状态',
+    `exported_count` bigint                                   NOT NULL DEFAULT '0' COMMENT '已导出的span数',
+    `file_key`       varchar(512) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '导出文件key',
+    `error_msg`      varchar(1024) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '失败原因',
+    `truncated`      tinyint(1)                               NOT NULL DEFAULT '0' COMMENT '是否因超过导出上限被截断',
+    `created_at`     datetime                                 NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `created_by`     varchar(128) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '创建人',
+    `updated_at`     datetime                                 NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '修改时间',
+    `updated_by`     varchar(128) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '修改人',
+    PRIMARY KEY (`id`),
+    KEY `idx_workspace_id` (`workspace_id`)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_general_ci COMMENT='trace文件导出任务';
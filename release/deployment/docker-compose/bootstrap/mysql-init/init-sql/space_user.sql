@@ -3,7 +3,7 @@ CREATE TABLE IF NOT EXISTS `space_user`
     `id`         bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT 'Primary Key ID, Auto Increment',
     `space_id`   bigint(20) unsigned NOT NULL DEFAULT 0 COMMENT 'Space ID',
     `user_id`    bigint(20) unsigned NOT NULL DEFAULT 0 COMMENT 'User ID',
-    `role_type`  int(11)             NOT NULL DEFAULT 3 COMMENT 'Role Type: 1.owner 2.admin 3.member',
+    `role_type`  int(11)             NOT NULL DEFAULT 3 COMMENT 'Role Type: 1.owner 2.admin 3.editor 4.viewer',
     `deleted_at` bigint              NOT NULL DEFAULT '0' COMMENT '删除时间',
     `created_at` datetime            NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at` datetime            NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
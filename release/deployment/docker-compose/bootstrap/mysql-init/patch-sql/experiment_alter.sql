@@ -3,3 +3,6 @@ ALTER TABLE `experiment`
 
 ALTER TABLE `experiment`
     ADD INDEX `idx_space_expt_template_id_delete_at` (`space_id`, `expt_template_id`, `deleted_at`);
+
+ALTER TABLE `experiment`
+    ADD COLUMN `gate_result` blob COMMENT '门禁结论' AFTER `max_alive_time`;
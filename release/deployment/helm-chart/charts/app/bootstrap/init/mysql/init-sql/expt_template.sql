@@ -15,6 +15,7 @@ CREATE TABLE IF NOT EXISTS `expt_template`
 
     `template_conf`       blob COMMENT '实验模板配置，包含评估器列表、字段映射、加权配置、默认并发及调度等，json',
     `expt_info`           blob COMMENT '实验运行状态，包含创建实验数量，最后一次实验执行状态，json',
+    `schedule_state`      blob COMMENT '调度状态，包含最近触发时间、跟踪版本及调度记录，json',
 
     `created_by`           varchar(128)    NOT NULL DEFAULT '0' COMMENT '创建人',
     `updated_by`           varchar(128)    NOT NULL DEFAULT '0' COMMENT '更新人',
@@ -0,0 +1,2 @@
+ALTER TABLE `expt_template`
+    ADD COLUMN `schedule_state` blob COMMENT '调度状态，包含最近触发时间、跟踪版本及调度记录，json' AFTER `expt_info`;
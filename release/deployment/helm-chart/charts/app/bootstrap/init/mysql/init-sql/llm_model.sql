@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `llm_model`
+(
+    `id`             bigint unsigned                   NOT NULL COMMENT '模型id',
+    `space_id`       bigint unsigned                   NOT NULL DEFAULT '0' COMMENT '空间id，0表示对所有空间可见',
+    `name`           varchar(256) COLLATE utf8mb4_bin  NOT NULL DEFAULT '' COMMENT '模型展示名称',
+    `description`    varchar(1024) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT '模型描述',
+    `frame`          varchar(128)                      NOT NULL DEFAULT '' COMMENT '使用的框架，如eino',
+    `protocol`       varchar(128)                      NOT NULL DEFAULT '' COMMENT '使用的协议，如ark/deepseek等',
+    `status`         varchar(64)                       NOT NULL DEFAULT '' COMMENT '模型状态',
+    `preset_model`   tinyint(1)                        NOT NULL DEFAULT '0' COMMENT '是否为预置模型',
+    `config`         longtext COLLATE utf8mb4_bin COMMENT '模型完整配置，protocol_config中的密钥已加密',
+    `created_by`     varchar(128)                      NOT NULL DEFAULT '' COMMENT '创建人',
+    `updated_by`     varchar(128)                      NOT NULL DEFAULT '' COMMENT '更新人',
+    `created_at`     datetime                          NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at`     datetime                          NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    `deleted_at`     bigint                            NOT NULL DEFAULT '0' COMMENT '删除时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_space_id_deleted_at` (`space_id`, `deleted_at`) USING BTREE
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_general_ci COMMENT ='模型配置表';
@@ -0,0 +1,2 @@
+ALTER TABLE `model_request_record`
+    ADD COLUMN `requested_model_id` varchar(256) NOT NULL DEFAULT '' COMMENT '请求的模型id，发生降级时与 model_id 不同' AFTER `model_name`;
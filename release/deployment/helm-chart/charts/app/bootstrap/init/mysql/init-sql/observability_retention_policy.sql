@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS `observability_retention_policy`
+(
+    `id`                      bigint unsigned                         NOT NULL COMMENT '主键ID',
+    `workspace_id`            bigint unsigned                         NOT NULL DEFAULT '0' COMMENT '空间 ID',
+    `default_ttl`             varchar(16) COLLATE utf8mb4_general_ci  NOT NULL DEFAULT '' COMMENT '默认保留时长',
+    `rules`                   json                                             DEFAULT NULL COMMENT '按span类型的保留规则',
+    `keep_annotated`          tinyint(1)                              NOT NULL DEFAULT '0' COMMENT '是否长期保留有标注的span',
+    `keep_dataset_referenced` tinyint(1)                              NOT NULL DEFAULT '0' COMMENT '是否长期保留被导入数据集的span',
+    `archive_permanently`     tinyint(1)                              NOT NULL DEFAULT '0' COMMENT '转存的span是否永久保留',
+    `archive_watermark`       bigint                                  NOT NULL DEFAULT '0' COMMENT '转存任务已处理到的过期时间, us',
+    `created_at`              datetime                                NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `created_by`              varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '创建人',
+    `updated_at`              datetime                                NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '修改时间',
+    `updated_by`              varchar(128) COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '修改人',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_workspace_id` (`workspace_id`)
+) ENGINE=InnoDB
+  DEFAULT CHARSET=utf8mb4
+  COLLATE=utf8mb4_general_ci COMMENT='观测数据保留策略';